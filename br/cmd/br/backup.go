@@ -63,6 +63,19 @@ func runBackupRawCommand(command *cobra.Command, cmdName string) error {
 	return nil
 }
 
+func runBackupControlCommand(command *cobra.Command, pause bool) error {
+	cfg := task.BackupControlConfig{}
+	if err := cfg.ParseFromFlags(command.Flags()); err != nil {
+		command.SilenceUsage = false
+		return errors.Trace(err)
+	}
+	if err := task.RunBackupControl(GetDefaultContext(), tidbGlue, &cfg, pause); err != nil {
+		log.Error("failed to control the backup", zap.Error(err))
+		return errors.Trace(err)
+	}
+	return nil
+}
+
 // NewBackupCommand return a full backup subcommand.
 func NewBackupCommand() *cobra.Command {
 	command := &cobra.Command{
@@ -89,6 +102,8 @@ func NewBackupCommand() *cobra.Command {
 		newDBBackupCommand(),
 		newTableBackupCommand(),
 		newRawBackupCommand(),
+		newPauseBackupCommand(),
+		newResumeBackupCommand(),
 	)
 
 	task.DefineBackupFlags(command.PersistentFlags())
@@ -155,3 +170,31 @@ func newRawBackupCommand() *cobra.Command {
 	task.DefineRawBackupFlags(command)
 	return command
 }
+
+// newPauseBackupCommand return a subcommand to pause the running backup.
+func newPauseBackupCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "pause",
+		Short: "pause the running backup, it stops backing up the new ranges and persists the progress",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, _ []string) error {
+			return runBackupControlCommand(command, true)
+		},
+	}
+	task.DefineBackupControlFlags(command.Flags())
+	return command
+}
+
+// newResumeBackupCommand return a subcommand to resume the paused backup.
+func newResumeBackupCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "resume",
+		Short: "resume the paused backup",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, _ []string) error {
+			return runBackupControlCommand(command, false)
+		},
+	}
+	task.DefineBackupControlFlags(command.Flags())
+	return command
+}
@@ -5,6 +5,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"sync"
@@ -192,6 +193,13 @@ func startPProf(cmd *cobra.Command) error {
 		return errors.Trace(err)
 	}
 
+	// the speed limits of the restore can be updated through the status address,
+	// and the progress of the restore is served there as well.
+	http.Handle(task.RestoreSpeedLimitPath, task.RestoreSpeedLimitHandler())
+	http.Handle(task.RestoreProgressPath, task.RestoreProgressHandler())
+	// the running backup can be paused and resumed through the status address.
+	http.Handle(task.BackupPausePath, task.BackupPauseHandler())
+	http.Handle(task.BackupResumePath, task.BackupResumeHandler())
 	if statusAddr != "" {
 		return utils.StartPProfListener(statusAddr, tls)
 	}
@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/tidb/br/pkg/task"
 	"github.com/pingcap/tidb/br/pkg/utils"
 	"github.com/pingcap/tidb/br/pkg/version/build"
+	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
@@ -54,7 +55,10 @@ func NewDebugCommand() *cobra.Command {
 	meta.AddCommand(decodeBackupMetaCommand())
 	meta.AddCommand(encodeBackupMetaCommand())
 	meta.AddCommand(setPDConfigCommand())
+	meta.AddCommand(cleanTemporaryDBsCommand())
 	meta.AddCommand(searchStreamBackupCommand())
+	meta.AddCommand(newDiffBackupCommand())
+	meta.AddCommand(newAuditBackupCommand())
 	meta.Hidden = true
 
 	return meta
@@ -156,6 +160,342 @@ func newBackupMetaCommand() *cobra.Command {
 		SilenceUsage: false,
 	}
 	command.AddCommand(newBackupMetaValidateCommand())
+	command.AddCommand(newBackupMetaFlattenCommand())
+	command.AddCommand(newBackupMetaSearchCommand())
+	command.AddCommand(newBackupMetaFileCommand())
+	command.AddCommand(newBackupMetaDiffCommand())
+	return command
+}
+
+// readBackupMetaReader reads the backupmeta from the storage in the flags.
+func readBackupMetaReader(ctx context.Context, cmd *cobra.Command) (*metautil.MetaReader, error) {
+	var cfg task.Config
+	if err := cfg.ParseFromFlags(cmd.Flags()); err != nil {
+		return nil, errors.Trace(err)
+	}
+	_, s, backupMeta, err := task.ReadBackupMeta(ctx, metautil.MetaFile, &cfg)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return metautil.NewMetaReader(backupMeta, s, &cfg.CipherInfo), nil
+}
+
+func printJSON(cmd *cobra.Command, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	cmd.Println(string(data))
+	return nil
+}
+
+func newBackupMetaFlattenCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "flatten",
+		Short: "decode backupmeta with the meta files of backupmeta v2 inlined to json",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx, cancel := context.WithCancel(GetDefaultContext())
+			defer cancel()
+
+			reader, err := readBackupMetaReader(ctx, cmd)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			backupMeta, err := reader.FlattenBackupMeta(ctx)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			backupMetaJSON, err := utils.MarshalBackupMeta(backupMeta)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			var buf bytes.Buffer
+			if err = json.Indent(&buf, backupMetaJSON, "", "  "); err != nil {
+				return errors.Trace(err)
+			}
+			cmd.Println(buf.String())
+			return nil
+		},
+	}
+	return command
+}
+
+func newBackupMetaSearchCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "search",
+		Short: "list the data files overlapping the table or the key range",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx, cancel := context.WithCancel(GetDefaultContext())
+			defer cancel()
+
+			dbName, err := cmd.Flags().GetString("db")
+			if err != nil {
+				return errors.Trace(err)
+			}
+			tableName, err := cmd.Flags().GetString("table")
+			if err != nil {
+				return errors.Trace(err)
+			}
+			format, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return errors.Trace(err)
+			}
+			startKey, err := cmd.Flags().GetString("start-key")
+			if err != nil {
+				return errors.Trace(err)
+			}
+			endKey, err := cmd.Flags().GetString("end-key")
+			if err != nil {
+				return errors.Trace(err)
+			}
+
+			reader, err := readBackupMetaReader(ctx, cmd)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			var ranges []kv.KeyRange
+			if len(tableName) > 0 {
+				dbs, err := utils.LoadBackupTables(ctx, reader)
+				if err != nil {
+					return errors.Trace(err)
+				}
+				db, ok := dbs[dbName]
+				if !ok {
+					return errors.Annotatef(berrors.ErrInvalidArgument, "database %s not found in the backup", dbName)
+				}
+				table := db.GetTable(tableName)
+				if table == nil {
+					return errors.Annotatef(berrors.ErrInvalidArgument, "table %s not found in the backup",
+						utils.EncloseDBAndTable(dbName, tableName))
+				}
+				ranges = metautil.TableKeyRanges(table.Info)
+			} else {
+				var r kv.KeyRange
+				if r.StartKey, err = utils.ParseKey(format, startKey); err != nil {
+					return errors.Trace(err)
+				}
+				if r.EndKey, err = utils.ParseKey(format, endKey); err != nil {
+					return errors.Trace(err)
+				}
+				ranges = append(ranges, r)
+			}
+
+			infos := make([]*metautil.DataFileInfo, 0)
+			for _, r := range ranges {
+				files, err := reader.ReadDataFilesInRange(ctx, r.StartKey, r.EndKey)
+				if err != nil {
+					return errors.Trace(err)
+				}
+				for _, file := range files {
+					infos = append(infos, metautil.NewDataFileInfo(file))
+				}
+			}
+			return printJSON(cmd, infos)
+		},
+	}
+	command.Flags().String("db", "", "the database of the table")
+	command.Flags().String("table", "", "search the data files of the table, including its partitions")
+	command.Flags().String("format", "hex", "the format of the keys, one of raw, escaped and hex")
+	command.Flags().String("start-key", "", "the start key of the range, it's ignored if the table is specified")
+	command.Flags().String("end-key", "", "the end key of the range, empty means unbounded")
+	return command
+}
+
+func newBackupMetaFileCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "file <name>",
+		Short: "decode the metadata of a data file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithCancel(GetDefaultContext())
+			defer cancel()
+
+			reader, err := readBackupMetaReader(ctx, cmd)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			file, err := reader.ReadDataFile(ctx, args[0])
+			if err != nil {
+				return errors.Trace(err)
+			}
+			return printJSON(cmd, metautil.NewDataFileInfo(file))
+		},
+	}
+	return command
+}
+
+func newBackupMetaDiffCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "diff",
+		Short: "diff the schemas and sizes of the tables in the backup with another backup or the cluster",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx, cancel := context.WithCancel(GetDefaultContext())
+			defer cancel()
+
+			targetStorage, err := cmd.Flags().GetString("target-storage")
+			if err != nil {
+				return errors.Trace(err)
+			}
+			failOnDrift, err := cmd.Flags().GetBool("fail-on-drift")
+			if err != nil {
+				return errors.Trace(err)
+			}
+			var cfg task.Config
+			if err := cfg.ParseFromFlags(cmd.Flags()); err != nil {
+				return errors.Trace(err)
+			}
+
+			reader, err := readBackupMetaReader(ctx, cmd)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			base, err := metautil.LoadSchemaSnapshot(ctx, reader)
+			if err != nil {
+				return errors.Trace(err)
+			}
+
+			var target *metautil.SchemaSnapshot
+			if len(targetStorage) > 0 {
+				target, err = loadBackupSnapshot(ctx, cfg, targetStorage)
+				if err != nil {
+					return errors.Trace(err)
+				}
+			} else {
+				mgr, err := task.NewMgr(ctx, tidbGlue, cfg.PD, cfg.TLS, task.GetKeepalive(&cfg), cfg.CheckRequirements, true, conn.NormalVersionChecker)
+				if err != nil {
+					return errors.Trace(err)
+				}
+				defer mgr.Close()
+				// only the databases in the backup are compared, the others
+				// in the cluster aren't affected by the restore.
+				target = metautil.NewSchemaSnapshot()
+				is := mgr.GetDomain().InfoSchema()
+				for _, db := range is.AllSchemas() {
+					if !base.HasDatabase(db.Name.O) {
+						continue
+					}
+					target.AddDatabase(db)
+					for _, tbl := range is.SchemaTables(db.Name) {
+						target.AddTable(db, tbl.Meta())
+					}
+				}
+			}
+
+			report := metautil.DiffSchemaSnapshots(base, target)
+			if err := printJSON(cmd, report); err != nil {
+				return errors.Trace(err)
+			}
+			if failOnDrift && report.HasSchemaDrift() {
+				return errors.Annotate(berrors.ErrInvalidArgument, "the schemas drift")
+			}
+			return nil
+		},
+	}
+	command.Flags().String("target-storage", "",
+		"the storage of the backup to diff with, such as an incremental backup, the cluster is diffed with if it's empty")
+	command.Flags().Bool("fail-on-drift", false, "exit with an error if the schemas differ")
+	return command
+}
+
+// loadBackupSnapshot loads the schemas and the sizes of the tables in the
+// backup of the storage, the other flags such as the crypter are shared.
+func loadBackupSnapshot(ctx context.Context, cfg task.Config, storage string) (*metautil.SchemaSnapshot, error) {
+	cfg.Storage = storage
+	_, s, backupMeta, err := task.ReadBackupMeta(ctx, metautil.MetaFile, &cfg)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	snapshot, err := metautil.LoadSchemaSnapshot(ctx, metautil.NewMetaReader(backupMeta, s, &cfg.CipherInfo))
+	return snapshot, errors.Trace(err)
+}
+
+func newDiffBackupCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "diff-backup <base-storage> <target-storage>",
+		Short: "diff the schemas and sizes of the tables in two backups",
+		Long: "diff the schemas and sizes of the tables in two backups, such as a full backup and its incremental " +
+			"backup, the added and removed tables, the schema changes and the size deltas of the tables are reported",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithCancel(GetDefaultContext())
+			defer cancel()
+
+			failOnDrift, err := cmd.Flags().GetBool("fail-on-drift")
+			if err != nil {
+				return errors.Trace(err)
+			}
+			var cfg task.Config
+			if err := cfg.ParseFromFlags(cmd.Flags()); err != nil {
+				return errors.Trace(err)
+			}
+			base, err := loadBackupSnapshot(ctx, cfg, args[0])
+			if err != nil {
+				return errors.Annotatef(err, "failed to load the backup %s", args[0])
+			}
+			target, err := loadBackupSnapshot(ctx, cfg, args[1])
+			if err != nil {
+				return errors.Annotatef(err, "failed to load the backup %s", args[1])
+			}
+
+			report := metautil.DiffSchemaSnapshots(base, target)
+			if err := printJSON(cmd, report); err != nil {
+				return errors.Trace(err)
+			}
+			if failOnDrift && report.HasSchemaDrift() {
+				return errors.Annotate(berrors.ErrInvalidArgument, "the schemas drift")
+			}
+			return nil
+		},
+	}
+	command.Flags().Bool("fail-on-drift", false, "exit with an error if the schemas differ")
+	return command
+}
+
+func newAuditBackupCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "backup",
+		Short: "audit the integrity of the backup without restoring it",
+		Long: "audit the integrity of the backup without restoring it, all the data files in the backupmeta are " +
+			"checked to exist, a sample of them is downloaded to verify the checksums, and a json report is printed",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx, cancel := context.WithCancel(GetDefaultContext())
+			defer cancel()
+
+			sampleRatio, err := cmd.Flags().GetFloat64("sample-ratio")
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if sampleRatio < 0 || sampleRatio > 1 {
+				return errors.Annotatef(berrors.ErrInvalidArgument, "the sample ratio %v should be in [0, 1]", sampleRatio)
+			}
+			concurrency, err := cmd.Flags().GetUint("audit-concurrency")
+			if err != nil {
+				return errors.Trace(err)
+			}
+
+			reader, err := readBackupMetaReader(ctx, cmd)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			report, err := reader.AuditBackup(ctx, sampleRatio, concurrency)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if err := printJSON(cmd, report); err != nil {
+				return errors.Trace(err)
+			}
+			if !report.Passed() {
+				return errors.Annotate(berrors.ErrBackupChecksumMismatch, "the backup is damaged")
+			}
+			return nil
+		},
+	}
+	command.Flags().Float64("sample-ratio", 0.05, "the ratio of the data files downloaded to verify the checksums")
+	command.Flags().Uint("audit-concurrency", 4, "the number of the data files downloaded concurrently")
 	return command
 }
 
@@ -401,6 +741,47 @@ func setPDConfigCommand() *cobra.Command {
 	return pdConfigCmd
 }
 
+func cleanTemporaryDBsCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "clean-temporary-databases",
+		Short: "drop the temporary databases left by the failed restores",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithCancel(GetDefaultContext())
+			defer cancel()
+
+			age, err := cmd.Flags().GetDuration("older-than")
+			if err != nil {
+				return errors.Trace(err)
+			}
+			var cfg task.Config
+			if err := cfg.ParseFromFlags(cmd.Flags()); err != nil {
+				return errors.Trace(err)
+			}
+
+			mgr, err := task.NewMgr(ctx, tidbGlue, cfg.PD, cfg.TLS, task.GetKeepalive(&cfg), cfg.CheckRequirements, true, conn.NormalVersionChecker)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			defer mgr.Close()
+			se, err := tidbGlue.CreateSession(mgr.GetStorage())
+			if err != nil {
+				return errors.Trace(err)
+			}
+			defer se.Close()
+
+			dropped, err := restore.CleanTemporaryDBs(ctx, se, mgr.GetDomain().InfoSchema(), age, true)
+			for _, db := range dropped {
+				cmd.Printf("dropped temporary database %s\n", db)
+			}
+			return errors.Trace(err)
+		},
+	}
+	command.Flags().Duration("older-than", restore.DefaultTemporaryDBGCAge,
+		"only drop the temporary databases created at least this long ago, the ones of the running restores may be dropped if it's too small")
+	return command
+}
+
 func searchStreamBackupCommand() *cobra.Command {
 	searchBackupCMD := &cobra.Command{
 		Use:   "search-log-backup",
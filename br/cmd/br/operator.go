@@ -0,0 +1,55 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package main
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/task"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/br/pkg/version/build"
+	"github.com/spf13/cobra"
+)
+
+// NewOperatorCommand return a operator subcommand.
+func NewOperatorCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:          "operator <subcommand>",
+		Short:        "utilities for operators like tidb-operator.",
+		SilenceUsage: true,
+		PersistentPreRunE: func(c *cobra.Command, args []string) error {
+			if err := Init(c); err != nil {
+				return errors.Trace(err)
+			}
+			build.LogInfo(build.BR)
+			utils.LogEnvVariables()
+			task.LogArguments(c)
+			return nil
+		},
+	}
+	command.AddCommand(newPauseGcAndSchedulersCommand())
+	return command
+}
+
+func newPauseGcAndSchedulersCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use: "pause-gc-and-schedulers",
+		Short: "pause the GC and the schedulers which may slow down backup or restore, " +
+			"and keep them paused until exit",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx, cancel := context.WithCancel(GetDefaultContext())
+			defer cancel()
+
+			cfg := task.PauseGcAndSchedulersConfig{Config: task.Config{LogProgress: HasLogFile()}}
+			if err := cfg.ParseFromFlags(cmd.Flags()); err != nil {
+				cmd.SilenceUsage = false
+				return errors.Trace(err)
+			}
+			return task.RunPauseGcAndSchedulers(ctx, tidbGlue, cmd.CommandPath(), &cfg)
+		},
+	}
+	task.DefineOperatorPauseFlags(command.Flags())
+	return command
+}
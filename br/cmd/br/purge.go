@@ -0,0 +1,46 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package main
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/task"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/br/pkg/version/build"
+	"github.com/spf13/cobra"
+)
+
+// NewPurgeCommand return a purge subcommand.
+func NewPurgeCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use: "purge",
+		Short: "delete the expired full, incremental and log backups under the storage prefix " +
+			"by the retention policy, without breaking the restore chains",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		PersistentPreRunE: func(c *cobra.Command, args []string) error {
+			if err := Init(c); err != nil {
+				return errors.Trace(err)
+			}
+			build.LogInfo(build.BR)
+			utils.LogEnvVariables()
+			task.LogArguments(c)
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx, cancel := context.WithCancel(GetDefaultContext())
+			defer cancel()
+
+			cfg := task.PurgeConfig{Config: task.Config{LogProgress: HasLogFile()}}
+			if err := cfg.ParseFromFlags(cmd.Flags()); err != nil {
+				cmd.SilenceUsage = false
+				return errors.Trace(err)
+			}
+			return task.RunPurge(ctx, tidbGlue, cmd.CommandPath(), &cfg)
+		},
+	}
+	task.DefinePurgeFlags(command.Flags())
+	return command
+}
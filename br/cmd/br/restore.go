@@ -3,11 +3,13 @@
 package main
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/glue"
 	"github.com/pingcap/tidb/br/pkg/gluetikv"
 	"github.com/pingcap/tidb/br/pkg/summary"
 	"github.com/pingcap/tidb/br/pkg/task"
@@ -66,6 +68,18 @@ func printWorkaroundOnFullRestoreError(command *cobra.Command, err error) {
 }
 
 func runRestoreRawCommand(command *cobra.Command, cmdName string) error {
+	return runRestoreRangeCommand(command, cmdName, task.RunRestoreRaw)
+}
+
+func runRestoreTxnCommand(command *cobra.Command, cmdName string) error {
+	return runRestoreRangeCommand(command, cmdName, task.RunRestoreTxn)
+}
+
+func runRestoreRangeCommand(
+	command *cobra.Command,
+	cmdName string,
+	run func(context.Context, glue.Glue, string, *task.RestoreRawConfig) error,
+) error {
 	cfg := task.RestoreRawConfig{
 		RawKvConfig: task.RawKvConfig{Config: task.Config{LogProgress: HasLogFile()}},
 	}
@@ -80,8 +94,8 @@ func runRestoreRawCommand(command *cobra.Command, cmdName string) error {
 		ctx, store = trace.TracerStartSpan(ctx)
 		defer trace.TracerFinishSpan(ctx, store)
 	}
-	if err := task.RunRestoreRaw(GetDefaultContext(), gluetikv.Glue{}, cmdName, &cfg); err != nil {
-		log.Error("failed to restore raw kv", zap.Error(err))
+	if err := run(GetDefaultContext(), gluetikv.Glue{}, cmdName, &cfg); err != nil {
+		log.Error("failed to restore kv", zap.String("command", cmdName), zap.Error(err))
 		return errors.Trace(err)
 	}
 	return nil
@@ -111,7 +125,9 @@ func NewRestoreCommand() *cobra.Command {
 		newDBRestoreCommand(),
 		newTableRestoreCommand(),
 		newRawRestoreCommand(),
+		newTxnRestoreCommand(),
 		newStreamRestoreCommand(),
+		newTiFlashReplicaRestoreCommand(),
 	)
 	task.DefineRestoreFlags(command.PersistentFlags())
 
@@ -153,7 +169,7 @@ func newTableRestoreCommand() *cobra.Command {
 			return runRestoreCommand(cmd, task.TableRestoreCmd)
 		},
 	}
-	task.DefineTableFlags(command)
+	task.DefineRestoreTableFlags(command)
 	return command
 }
 
@@ -171,6 +187,20 @@ func newRawRestoreCommand() *cobra.Command {
 	return command
 }
 
+func newTxnRestoreCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "txn",
+		Short: "(experimental) restore a txn kv range without TiDB schemas to TiKV cluster",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runRestoreTxnCommand(cmd, task.TxnRestoreCmd)
+		},
+	}
+
+	task.DefineTxnRestoreFlags(command)
+	return command
+}
+
 func newStreamRestoreCommand() *cobra.Command {
 	command := &cobra.Command{
 		Use:   "point",
@@ -185,3 +215,16 @@ func newStreamRestoreCommand() *cobra.Command {
 	command.Hidden = true
 	return command
 }
+
+func newTiFlashReplicaRestoreCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "tiflash-replica",
+		Short: "restore the tiflash replicas recorded by an interrupted point restore or deferred by a restore",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, _ []string) error {
+			return runRestoreCommand(command, task.TiFlashReplicaRestoreCmd)
+		},
+	}
+	command.Hidden = true
+	return command
+}
@@ -49,6 +49,7 @@ func NewStreamCommand() *cobra.Command {
 		newStreamResumeCommand(),
 		newStreamStatusCommand(),
 		newStreamTruncateCommand(),
+		newStreamCompactCommand(),
 		newStreamCheckCommand(),
 		newStreamAdvancerCommand(),
 	)
@@ -147,6 +148,19 @@ func newStreamTruncateCommand() *cobra.Command {
 	return command
 }
 
+func newStreamCompactCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "compact",
+		Short: "merge the small data files of the incremental log into the larger ones.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return streamCommand(cmd, task.StreamCompact)
+		},
+	}
+	task.DefineStreamCompactFlags(command.Flags())
+	return command
+}
+
 func newStreamCheckCommand() *cobra.Command {
 	command := &cobra.Command{
 		Use:   "metadata",
@@ -197,6 +211,10 @@ func streamCommand(command *cobra.Command, cmdName string) error {
 		if err = cfg.ParseStreamTruncateFromFlags(command.Flags()); err != nil {
 			return errors.Trace(err)
 		}
+	case task.StreamCompact:
+		if err = cfg.ParseStreamCompactFromFlags(command.Flags()); err != nil {
+			return errors.Trace(err)
+		}
 	case task.StreamStatus:
 		if err = cfg.ParseStreamStatusFromFlags(command.Flags()); err != nil {
 			return errors.Trace(err)
@@ -0,0 +1,183 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/rtree"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/br/pkg/stream"
+	"github.com/pingcap/tidb/util/codec"
+	"go.uber.org/zap"
+)
+
+// ChangeTracker tracks the key ranges written in a period of time, so the
+// incremental backup only needs to scan the regions changed since the last
+// backup instead of the whole ranges.
+type ChangeTracker interface {
+	// ChangedRanges returns the key ranges with the writes committed in
+	// (sinceTS, untilTS]. It returns ErrBackupChangesUntracked if the tracker
+	// doesn't cover the whole period.
+	ChangedRanges(ctx context.Context, sinceTS, untilTS uint64) ([]rtree.Range, error)
+}
+
+// LogBackupChangeTracker tracks the changes by the data files of a log backup
+// task. TiKV observes the writes of each region and flushes them into the data
+// files of the log backup, the key ranges of the files are the ranges of the
+// regions changed. Only the ranges observed by the log backup task are
+// tracked, the others are always reported as changed.
+type LogBackupChangeTracker struct {
+	storage storage.ExternalStorage
+	// minTS and maxTS are the period covered by the log backup.
+	minTS uint64
+	maxTS uint64
+	// observed are the key ranges observed by the log backup task.
+	observed []rtree.Range
+}
+
+// NewLogBackupChangeTracker creates a tracker by the log backup in the storage,
+// which covers the changes committed in [minTS, maxTS] of the observed ranges.
+func NewLogBackupChangeTracker(s storage.ExternalStorage, minTS, maxTS uint64, observed []rtree.Range) *LogBackupChangeTracker {
+	return &LogBackupChangeTracker{storage: s, minTS: minTS, maxTS: maxTS, observed: observed}
+}
+
+// ChangedRanges implements ChangeTracker.
+func (t *LogBackupChangeTracker) ChangedRanges(ctx context.Context, sinceTS, untilTS uint64) ([]rtree.Range, error) {
+	if sinceTS < t.minTS || untilTS > t.maxTS {
+		return nil, errors.Annotatef(berrors.ErrBackupChangesUntracked,
+			"the log backup covers [%d, %d], but the changes in (%d, %d] are required",
+			t.minTS, t.maxTS, sinceTS, untilTS)
+	}
+	var (
+		mu     sync.Mutex
+		ranges []rtree.Range
+	)
+	err := stream.FastUnmarshalMetaData(ctx, t.storage, func(path string, m *backuppb.Metadata) error {
+		if m.MaxTs <= sinceTS || m.MinTs > untilTS {
+			return nil
+		}
+		for _, f := range m.Files {
+			if f.IsMeta || f.MaxTs <= sinceTS || f.MinTs > untilTS {
+				continue
+			}
+			r, err := decodeDataFileRange(f)
+			if err != nil {
+				return errors.Annotatef(err, "invalid data file %s in meta file %s", f.Path, path)
+			}
+			mu.Lock()
+			ranges = append(ranges, r)
+			mu.Unlock()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// the writes of the ranges not observed aren't in the log backup, so they
+	// must be scanned fully.
+	unobserved := unobservedRanges(t.observed)
+	ranges = mergeChangedRanges(append(ranges, unobserved...))
+	log.Info("load the changed ranges from the log backup",
+		zap.Uint64("since", sinceTS), zap.Uint64("until", untilTS), zap.Int("ranges", len(ranges)),
+		zap.Int("unobserved-ranges", len(unobserved)))
+	return ranges, nil
+}
+
+// unobservedRanges returns the gaps of the whole key space between the
+// observed ranges.
+func unobservedRanges(observed []rtree.Range) []rtree.Range {
+	observed = mergeChangedRanges(append([]rtree.Range(nil), observed...))
+	gaps := make([]rtree.Range, 0, len(observed)+1)
+	var start []byte
+	for _, r := range observed {
+		if bytes.Compare(start, r.StartKey) < 0 {
+			gaps = append(gaps, rtree.Range{StartKey: start, EndKey: r.StartKey})
+		}
+		if len(r.EndKey) == 0 {
+			return gaps
+		}
+		start = r.EndKey
+	}
+	return append(gaps, rtree.Range{StartKey: start})
+}
+
+// decodeDataFileRange decodes the range of the data file, the keys of it are
+// encoded and prefixed by 'z'.
+func decodeDataFileRange(f *backuppb.DataFileInfo) (rtree.Range, error) {
+	decode := func(key []byte) ([]byte, error) {
+		if len(key) == 0 {
+			return nil, nil
+		}
+		if key[0] != 'z' {
+			return nil, errors.Annotatef(berrors.ErrInvalidArgument, "the key %X isn't prefixed by 'z'", key)
+		}
+		_, raw, err := codec.DecodeBytes(key[1:], nil)
+		return raw, errors.Trace(err)
+	}
+	start, err := decode(f.StartKey)
+	if err != nil {
+		return rtree.Range{}, errors.Trace(err)
+	}
+	end, err := decode(f.EndKey)
+	if err != nil {
+		return rtree.Range{}, errors.Trace(err)
+	}
+	// the end key of the data file is inclusive.
+	if len(end) > 0 {
+		end = append(end, 0)
+	}
+	return rtree.Range{StartKey: start, EndKey: end}, nil
+}
+
+// mergeChangedRanges sorts the ranges and merges the overlapped or adjacent ones.
+func mergeChangedRanges(ranges []rtree.Range) []rtree.Range {
+	if len(ranges) == 0 {
+		return ranges
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return bytes.Compare(ranges[i].StartKey, ranges[j].StartKey) < 0
+	})
+	merged := ranges[:1]
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if len(last.EndKey) == 0 {
+			break
+		}
+		if bytes.Compare(r.StartKey, last.EndKey) <= 0 {
+			if len(r.EndKey) == 0 || bytes.Compare(r.EndKey, last.EndKey) > 0 {
+				last.EndKey = r.EndKey
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// FilterChangedRanges returns the parts of the ranges overlapped with the
+// changed ranges, in the order of the ranges. The changed ranges must be
+// sorted and not overlapped.
+func FilterChangedRanges(ranges []rtree.Range, changed []rtree.Range) []rtree.Range {
+	filtered := make([]rtree.Range, 0, len(ranges))
+	for _, r := range ranges {
+		i := sort.Search(len(changed), func(i int) bool {
+			return len(changed[i].EndKey) == 0 || bytes.Compare(changed[i].EndKey, r.StartKey) > 0
+		})
+		for ; i < len(changed); i++ {
+			start, end, ok := changed[i].Intersect(r.StartKey, r.EndKey)
+			if !ok {
+				break
+			}
+			filtered = append(filtered, rtree.Range{StartKey: start, EndKey: end})
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package backup_test
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/backup"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/rtree"
+	"github.com/pingcap/tidb/br/pkg/stream"
+	"github.com/pingcap/tidb/util/codec"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeLogKey(key string) []byte {
+	return append([]byte("z"), codec.EncodeBytes(nil, []byte(key))...)
+}
+
+func TestLogBackupChangeTracker(t *testing.T) {
+	ctx := context.Background()
+	s := GetRandomStorage(t)
+	dataFile := func(start, end string, minTS, maxTS uint64) *backuppb.DataFileInfo {
+		return &backuppb.DataFileInfo{
+			StartKey: encodeLogKey(start),
+			EndKey:   encodeLogKey(end),
+			MinTs:    minTS,
+			MaxTs:    maxTS,
+		}
+	}
+	metas := map[string]*backuppb.Metadata{
+		"1.meta": {
+			MinTs: 10, MaxTs: 30,
+			Files: []*backuppb.DataFileInfo{
+				dataFile("a", "b", 10, 15),
+				dataFile("c", "d", 20, 30),
+				{StartKey: encodeLogKey("m"), EndKey: encodeLogKey("n"), MinTs: 20, MaxTs: 30, IsMeta: true},
+			},
+		},
+		"2.meta": {
+			MinTs: 25, MaxTs: 40,
+			Files: []*backuppb.DataFileInfo{
+				dataFile("d", "f", 25, 40),
+				dataFile("x", "y", 25, 40),
+			},
+		},
+		"3.meta": {
+			MinTs: 60, MaxTs: 70,
+			Files: []*backuppb.DataFileInfo{dataFile("p", "q", 60, 70)},
+		},
+	}
+	for name, m := range metas {
+		data, err := m.Marshal()
+		require.NoError(t, err)
+		require.NoError(t, s.WriteFile(ctx, path.Join(stream.GetStreamBackupMetaPrefix(), name), data))
+	}
+
+	// the whole key space is observed.
+	tracker := backup.NewLogBackupChangeTracker(s, 5, 50, []rtree.Range{{}})
+	changed, err := tracker.ChangedRanges(ctx, 16, 50)
+	require.NoError(t, err)
+	require.Equal(t, []rtree.Range{
+		{StartKey: []byte("c"), EndKey: []byte("f\x00")},
+		{StartKey: []byte("x"), EndKey: []byte("y\x00")},
+	}, changed)
+
+	// the ranges not observed are always changed.
+	partial := backup.NewLogBackupChangeTracker(s, 5, 50, []rtree.Range{
+		{StartKey: []byte("b"), EndKey: []byte("g")},
+		{StartKey: []byte("m"), EndKey: []byte("w")},
+	})
+	changed, err = partial.ChangedRanges(ctx, 16, 50)
+	require.NoError(t, err)
+	require.Equal(t, []rtree.Range{
+		{EndKey: []byte("b")},
+		{StartKey: []byte("c"), EndKey: []byte("f\x00")},
+		{StartKey: []byte("g"), EndKey: []byte("m")},
+		{StartKey: []byte("w")},
+	}, changed)
+
+	// the changes out of the log backup aren't tracked.
+	_, err = tracker.ChangedRanges(ctx, 1, 50)
+	require.ErrorIs(t, err, berrors.ErrBackupChangesUntracked)
+	_, err = tracker.ChangedRanges(ctx, 16, 60)
+	require.ErrorIs(t, err, berrors.ErrBackupChangesUntracked)
+}
+
+func TestFilterChangedRanges(t *testing.T) {
+	ranges := []rtree.Range{
+		{StartKey: []byte("a"), EndKey: []byte("e")},
+		{StartKey: []byte("g"), EndKey: []byte("h")},
+		{StartKey: []byte("t"), EndKey: []byte("z")},
+	}
+	changed := []rtree.Range{
+		{StartKey: []byte("b"), EndKey: []byte("c")},
+		{StartKey: []byte("d"), EndKey: []byte("f")},
+		{StartKey: []byte("u"), EndKey: []byte("")},
+	}
+	require.Equal(t, []rtree.Range{
+		{StartKey: []byte("b"), EndKey: []byte("c")},
+		{StartKey: []byte("d"), EndKey: []byte("e")},
+		{StartKey: []byte("u"), EndKey: []byte("z")},
+	}, backup.FilterChangedRanges(ranges, changed))
+	require.Empty(t, backup.FilterChangedRanges(ranges, nil))
+}
@@ -0,0 +1,253 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/logutil"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"go.uber.org/zap"
+)
+
+const (
+	// CheckpointPrefix is the prefix of the backup checkpoint files in the backup storage.
+	CheckpointPrefix = "backup.checkpoint"
+
+	checkpointMetaFile   = CheckpointPrefix + ".meta"
+	checkpointDataPrefix = CheckpointPrefix + ".data."
+
+	checkpointFlushInterval = 30 * time.Second
+)
+
+// checkpointMeta identifies the backup which the checkpoint belongs to.
+type checkpointMeta struct {
+	TaskID       string `json:"task-id"`
+	ClusterID    uint64 `json:"cluster-id"`
+	StartVersion uint64 `json:"start-version"`
+	BackupTS     uint64 `json:"backup-ts"`
+}
+
+// checkpointRange is a range backed up with its files.
+type checkpointRange struct {
+	StartKey []byte           `json:"start-key"`
+	EndKey   []byte           `json:"end-key"`
+	Files    []*backuppb.File `json:"files"`
+}
+
+// checkpointData is the progress recorded in a checkpoint data file.
+type checkpointData struct {
+	Ranges []checkpointRange `json:"ranges"`
+}
+
+type checkpointRangeKey struct {
+	startKey, endKey string
+}
+
+// Checkpoint records the ranges backed up with their files in the backup
+// storage, so a paused or failed backup can be resumed at the same backup ts
+// by skipping the finished ranges. Like the checkpoint of restore, the
+// progress is appended to the storage as small data files.
+//
+// The methods of a nil Checkpoint do nothing. Failing to write the storage
+// disables the checkpoint rather than failing the backup.
+type Checkpoint struct {
+	storage storage.ExternalStorage
+	meta    checkpointMeta
+	resumed bool
+
+	mu       sync.Mutex
+	disabled bool
+	ranges   map[checkpointRangeKey][]*backuppb.File
+	pending  checkpointData
+	seq      int
+}
+
+// CheckpointExists returns whether the storage has the checkpoint of a backup.
+func CheckpointExists(ctx context.Context, s storage.ExternalStorage) (bool, error) {
+	exists, err := s.FileExists(ctx, checkpointMetaFile)
+	return exists, errors.Trace(err)
+}
+
+// NewCheckpoint creates the checkpoint of the backup task. If the storage has
+// the checkpoint of the same task, the progress recorded by it is loaded and
+// the backup should be resumed at its backup ts.
+func NewCheckpoint(
+	ctx context.Context,
+	s storage.ExternalStorage,
+	taskID string,
+	clusterID, startVersion uint64,
+) (*Checkpoint, error) {
+	cp := &Checkpoint{
+		storage: s,
+		meta:    checkpointMeta{TaskID: taskID, ClusterID: clusterID, StartVersion: startVersion},
+		ranges:  make(map[checkpointRangeKey][]*backuppb.File),
+	}
+	exists, err := CheckpointExists(ctx, s)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !exists {
+		return cp, nil
+	}
+	if err := cp.load(ctx); err != nil {
+		return nil, errors.Trace(err)
+	}
+	cp.resumed = true
+	log.Info("resume the backup from the checkpoint", zap.String("task-id", taskID),
+		zap.Uint64("backup-ts", cp.meta.BackupTS), zap.Int("finished-ranges", len(cp.ranges)))
+	return cp, nil
+}
+
+func (cp *Checkpoint) load(ctx context.Context) error {
+	data, err := cp.storage.ReadFile(ctx, checkpointMetaFile)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var meta checkpointMeta
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return errors.Annotatef(err, "invalid checkpoint meta %s", checkpointMetaFile)
+	}
+	if meta.TaskID != cp.meta.TaskID {
+		return errors.Annotatef(berrors.ErrInvalidArgument,
+			"the storage has the checkpoint of the backup task %s, resume it by --task-id %s "+
+				"or specify another backup directory", meta.TaskID, meta.TaskID)
+	}
+	if meta.ClusterID != cp.meta.ClusterID || meta.StartVersion != cp.meta.StartVersion {
+		return errors.Annotatef(berrors.ErrInvalidArgument,
+			"the checkpoint belongs to the backup of the cluster %d since %d", meta.ClusterID, meta.StartVersion)
+	}
+	cp.meta = meta
+	return cp.storage.WalkDir(ctx, &storage.WalkOption{ObjPrefix: checkpointDataPrefix}, func(name string, _ int64) error {
+		// the sidecars of the checksums are walked if the storage isn't wrapped by WithChecksum.
+		if storage.IsChecksumFile(name) {
+			return nil
+		}
+		content, err := cp.storage.ReadFile(ctx, name)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		var data checkpointData
+		if err := json.Unmarshal(content, &data); err != nil {
+			return errors.Annotatef(err, "invalid checkpoint data %s", name)
+		}
+		for _, r := range data.Ranges {
+			cp.ranges[checkpointRangeKey{string(r.StartKey), string(r.EndKey)}] = r.Files
+		}
+		return nil
+	})
+}
+
+// Resumed returns whether the backup is resumed from a checkpoint.
+func (cp *Checkpoint) Resumed() bool {
+	return cp != nil && cp.resumed
+}
+
+// BackupTS returns the backup ts of the backup resumed, it's 0 if the backup
+// isn't resumed.
+func (cp *Checkpoint) BackupTS() uint64 {
+	if !cp.Resumed() {
+		return 0
+	}
+	return cp.meta.BackupTS
+}
+
+// Start records the backup ts of the backup, the checkpoint is disabled if it
+// fails to be written.
+func (cp *Checkpoint) Start(ctx context.Context, backupTS uint64) {
+	if cp == nil || cp.resumed {
+		return
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	cp.meta.BackupTS = backupTS
+	data, err := json.Marshal(cp.meta)
+	if err == nil {
+		err = cp.storage.WriteFile(ctx, checkpointMetaFile, data)
+	}
+	if err != nil {
+		log.Warn("failed to write the checkpoint, the backup can't be resumed", logutil.ShortError(err))
+		cp.disabled = true
+	}
+}
+
+// FinishedFiles returns the files of the range if it's backed up by the
+// previous backup.
+func (cp *Checkpoint) FinishedFiles(startKey, endKey []byte) ([]*backuppb.File, bool) {
+	if cp == nil {
+		return nil, false
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	files, ok := cp.ranges[checkpointRangeKey{string(startKey), string(endKey)}]
+	return files, ok
+}
+
+// RecordRange records the range backed up, it's persisted on the next Flush.
+func (cp *Checkpoint) RecordRange(startKey, endKey []byte, files []*backuppb.File) {
+	if cp == nil {
+		return
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	key := checkpointRangeKey{string(startKey), string(endKey)}
+	if _, ok := cp.ranges[key]; ok {
+		return
+	}
+	cp.ranges[key] = files
+	cp.pending.Ranges = append(cp.pending.Ranges, checkpointRange{StartKey: startKey, EndKey: endKey, Files: files})
+}
+
+// Flush persists the recorded progress.
+func (cp *Checkpoint) Flush(ctx context.Context) {
+	if cp == nil {
+		return
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	if cp.disabled || len(cp.pending.Ranges) == 0 {
+		return
+	}
+	content, err := json.Marshal(cp.pending)
+	if err == nil {
+		cp.seq++
+		name := fmt.Sprintf("%s%d.%06d", checkpointDataPrefix, time.Now().UnixNano(), cp.seq)
+		err = cp.storage.WriteFile(ctx, name, content)
+	}
+	if err != nil {
+		log.Warn("failed to write the checkpoint, the backup can't be resumed", logutil.ShortError(err))
+		cp.disabled = true
+		return
+	}
+	log.Info("flush the checkpoint of the backup", zap.Int("ranges", len(cp.pending.Ranges)))
+	cp.pending = checkpointData{}
+}
+
+// Remove removes the checkpoint from the storage, it's called after the backup finishes.
+func (cp *Checkpoint) Remove(ctx context.Context) error {
+	if cp == nil {
+		return nil
+	}
+	names := make([]string, 0)
+	err := cp.storage.WalkDir(ctx, &storage.WalkOption{ObjPrefix: CheckpointPrefix}, func(name string, _ int64) error {
+		names = append(names, name)
+		return nil
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, name := range names {
+		if err := cp.storage.DeleteFile(ctx, name); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
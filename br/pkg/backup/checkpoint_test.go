@@ -0,0 +1,70 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package backup_test
+
+import (
+	"context"
+	"testing"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/backup"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	s, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	// the checkpoint written with the checksums can be resumed by the storage
+	// without them.
+	cp, err := backup.NewCheckpoint(ctx, storage.WithChecksum(s), "task", 1, 0)
+	require.NoError(t, err)
+	require.False(t, cp.Resumed())
+	cp.Start(ctx, 100)
+	files := []*backuppb.File{{Name: "1.sst", TotalKvs: 10}, {Name: "2.sst", TotalKvs: 20}}
+	cp.RecordRange([]byte("a"), []byte("b"), files)
+	cp.Flush(ctx)
+	cp.RecordRange([]byte("b"), []byte("c"), nil)
+	cp.Flush(ctx)
+	exists, err := backup.CheckpointExists(ctx, s)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	// the checkpoint of another task can't be resumed.
+	_, err = backup.NewCheckpoint(ctx, s, "another", 1, 0)
+	require.ErrorContains(t, err, "--task-id task")
+	_, err = backup.NewCheckpoint(ctx, s, "task", 2, 0)
+	require.Error(t, err)
+
+	cp, err = backup.NewCheckpoint(ctx, s, "task", 1, 0)
+	require.NoError(t, err)
+	require.True(t, cp.Resumed())
+	require.Equal(t, uint64(100), cp.BackupTS())
+	finished, ok := cp.FinishedFiles([]byte("a"), []byte("b"))
+	require.True(t, ok)
+	require.Len(t, finished, 2)
+	require.Equal(t, "1.sst", finished[0].Name)
+	require.Equal(t, uint64(20), finished[1].TotalKvs)
+	_, ok = cp.FinishedFiles([]byte("b"), []byte("c"))
+	require.True(t, ok)
+	_, ok = cp.FinishedFiles([]byte("a"), []byte("c"))
+	require.False(t, ok)
+
+	require.NoError(t, cp.Remove(ctx))
+	exists, err = backup.CheckpointExists(ctx, s)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestPauseBackup(t *testing.T) {
+	ctx := context.Background()
+	var client backup.Client
+	require.Equal(t, backup.BackupRunning, client.State())
+	// no range is being backed up, so the backup is paused at once.
+	client.Pause(ctx)
+	require.Equal(t, backup.BackupPaused, client.State())
+	client.Resume()
+	require.Equal(t, backup.BackupRunning, client.State())
+}
@@ -91,6 +91,12 @@ type Client struct {
 	apiVersion kvrpcpb.APIVersion
 
 	gcTTL int64
+
+	compressionStats *CompressionStats
+
+	checkpoint *Checkpoint
+	pauser     pauser
+	throttler  *Throttler
 }
 
 // NewBackupClient returns a new backup client.
@@ -163,6 +169,45 @@ func (bc *Client) GetGCTTL() int64 {
 	return bc.gcTTL
 }
 
+// SetCompressionStats sets the stats collecting the compression of the files
+// backed up.
+func (bc *Client) SetCompressionStats(stats *CompressionStats) {
+	bc.compressionStats = stats
+}
+
+// SetCheckpoint sets the checkpoint to record the ranges backed up, the
+// finished ranges in it are skipped by BackupRanges.
+func (bc *Client) SetCheckpoint(cp *Checkpoint) {
+	bc.checkpoint = cp
+}
+
+// SetThrottler sets the throttler adapting the number of the ranges backed up
+// concurrently to the load of the cluster.
+func (bc *Client) SetThrottler(t *Throttler) {
+	bc.throttler = t
+}
+
+// Pause stops backing up the new ranges, the progress is persisted to the
+// checkpoint once the ranges being backed up are finished.
+func (bc *Client) Pause(ctx context.Context) {
+	if bc.pauser.pause() {
+		bc.checkpoint.Flush(ctx)
+		bc.pauser.markFlushed()
+	}
+	log.Info("pause the backup")
+}
+
+// Resume resumes the paused backup.
+func (bc *Client) Resume() {
+	bc.pauser.resume()
+	log.Info("resume the backup")
+}
+
+// State returns the state of the backup.
+func (bc *Client) State() BackupState {
+	return bc.pauser.state()
+}
+
 // GetStorageBackend gets storage backupend field in client.
 func (bc *Client) GetStorageBackend() *backuppb.StorageBackend {
 	return bc.backend
@@ -190,9 +235,17 @@ func (bc *Client) SetStorage(ctx context.Context, backend *backuppb.StorageBacke
 			"there may be some backup files in the path already, "+
 			"please specify a correct backup directory!", bc.storage.URI()+"/"+metautil.MetaFile)
 	}
-	err = CheckBackupStorageIsLocked(ctx, bc.storage)
+	// the storage of a paused or failed backup is locked with the files backed
+	// up, whether the backup can be resumed is checked by its checkpoint.
+	exist, err = CheckpointExists(ctx, bc.storage)
 	if err != nil {
-		return err
+		return errors.Trace(err)
+	}
+	if !exist {
+		err = CheckBackupStorageIsLocked(ctx, bc.storage)
+		if err != nil {
+			return err
+		}
 	}
 	bc.backend = backend
 	return nil
@@ -569,17 +622,64 @@ func (bc *Client) BackupRanges(
 		ctx = opentracing.ContextWithSpan(ctx, span1)
 	}
 
+	// persist the progress periodically, and when the backup exits, e.g. it's
+	// killed while paused.
+	flushCtx, stopFlush := context.WithCancel(ctx)
+	defer func() {
+		stopFlush()
+		bc.checkpoint.Flush(context.Background())
+	}()
+	go func() {
+		ticker := time.NewTicker(checkpointFlushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-flushCtx.Done():
+				return
+			case <-ticker.C:
+				bc.checkpoint.Flush(flushCtx)
+			}
+		}
+	}()
+
 	// we collect all files in a single goroutine to avoid thread safety issues.
 	workerPool := utils.NewWorkerPool(concurrency, "Ranges")
 	eg, ectx := errgroup.WithContext(ctx)
 	for id, r := range ranges {
+		if files, ok := bc.checkpoint.FinishedFiles(r.StartKey, r.EndKey); ok {
+			// the range is backed up before the backup is resumed.
+			for _, f := range files {
+				summary.CollectSuccessUnit(summary.TotalKV, 1, f.TotalKvs)
+				summary.CollectSuccessUnit(summary.TotalBytes, 1, f.TotalBytes)
+			}
+			if bc.compressionStats != nil {
+				bc.compressionStats.Record(files)
+			}
+			if err := metaWriter.Send(files, metautil.AppendDataFile); err != nil {
+				return errors.Trace(err)
+			}
+			progressCallBack(RangeUnit)
+			continue
+		}
 		id := id
 		req := request
 		req.StartKey, req.EndKey = r.StartKey, r.EndKey
 
 		workerPool.ApplyOnErrorGroup(eg, func() error {
+			if err := bc.throttler.acquire(ectx); err != nil {
+				return errors.SuspendStack(err)
+			}
+			defer bc.throttler.release()
+			if err := bc.pauser.enter(ectx); err != nil {
+				return errors.SuspendStack(err)
+			}
 			elctx := logutil.ContextWithField(ectx, logutil.RedactAny("range-sn", id))
 			err := bc.BackupRange(elctx, req, metaWriter, progressCallBack)
+			if bc.pauser.leave() {
+				bc.checkpoint.Flush(ctx)
+				bc.pauser.markFlushed()
+				log.Info("the backup is paused")
+			}
 			if err != nil {
 				// The error due to context cancel, stack trace is meaningless, the stack shall be suspended (also clear)
 				if errors.Cause(err) == context.Canceled {
@@ -652,12 +752,17 @@ func (bc *Client) BackupRange(
 	}
 
 	var ascendErr error
+	files := make([]*backuppb.File, 0, results.Len())
 	results.Ascend(func(i btree.Item) bool {
 		r := i.(*rtree.Range)
+		files = append(files, r.Files...)
 		for _, f := range r.Files {
 			summary.CollectSuccessUnit(summary.TotalKV, 1, f.TotalKvs)
 			summary.CollectSuccessUnit(summary.TotalBytes, 1, f.TotalBytes)
 		}
+		if bc.compressionStats != nil {
+			bc.compressionStats.Record(r.Files)
+		}
 		// we need keep the files in order after we support multi_ingest sst.
 		// default_sst and write_sst need to be together.
 		if err := metaWriter.Send(r.Files, metautil.AppendDataFile); err != nil {
@@ -669,6 +774,7 @@ func (bc *Client) BackupRange(
 	if ascendErr != nil {
 		return errors.Trace(ascendErr)
 	}
+	bc.checkpoint.RecordRange(req.StartKey, req.EndKey, files)
 
 	// Check if there are duplicated files.
 	checkDupFiles(&results)
@@ -1088,6 +1194,11 @@ const (
 
 // isRetryableError represents whether we should retry reset grpc connection.
 func isRetryableError(err error) bool {
+	if berrors.IsRetryable(err) {
+		log.Warn("backup met retryable error, reset the backup stream and retry",
+			zap.Int("max attempts", backupRetryTimes), zap.Error(err))
+		return true
+	}
 	// some errors can be retried
 	// https://github.com/pingcap/tidb/issues/34350
 	switch status.Code(err) {
@@ -0,0 +1,153 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/tablecodec"
+)
+
+// CompressionStatsFile is the file of the compression stats of the backup,
+// it's written beside the backupmeta.
+const CompressionStatsFile = "backup.compression.json"
+
+// FileCompression is the compression of a backed up sst file.
+type FileCompression struct {
+	Name    string `json:"name"`
+	Cf      string `json:"cf"`
+	TableID int64  `json:"table-id"`
+	// Size is the size of the file in the storage.
+	Size uint64 `json:"size"`
+	// TotalBytes is the size of the kv pairs in the file before compressed.
+	TotalBytes uint64 `json:"total-bytes"`
+}
+
+// TableCompression is the summary of the compression of the files of a table.
+type TableCompression struct {
+	DB         string  `json:"db"`
+	Table      string  `json:"table"`
+	Files      int     `json:"files"`
+	Size       uint64  `json:"size"`
+	TotalBytes uint64  `json:"total-bytes"`
+	Ratio      float64 `json:"ratio"`
+}
+
+// CompressionStats collects the compression of the files backed up.
+type CompressionStats struct {
+	mu sync.Mutex
+
+	Type   string             `json:"type"`
+	Level  int32              `json:"level"`
+	Tables []TableCompression `json:"tables"`
+	Files  []FileCompression  `json:"files"`
+}
+
+// NewCompressionStats creates the stats of the compression algorithm and level
+// sent to TiKV.
+func NewCompressionStats(tp backuppb.CompressionType, level int32) *CompressionStats {
+	return &CompressionStats{Type: tp.String(), Level: level}
+}
+
+// Record records the files backed up.
+func (s *CompressionStats) Record(files []*backuppb.File) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, f := range files {
+		s.Files = append(s.Files, FileCompression{
+			Name:       f.Name,
+			Cf:         f.Cf,
+			TableID:    tablecodec.DecodeTableID(f.StartKey),
+			Size:       f.Size_,
+			TotalBytes: f.TotalBytes,
+		})
+	}
+}
+
+// Sizes returns the size in the storage and the size before compressed of all
+// the files.
+func (s *CompressionStats) Sizes() (size, totalBytes uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, f := range s.Files {
+		size += f.Size
+		totalBytes += f.TotalBytes
+	}
+	return size, totalBytes
+}
+
+// Ratio returns the compression ratio of all the files, it's 0 if nothing is
+// backed up.
+func (s *CompressionStats) Ratio() float64 {
+	return compressionRatio(s.Sizes())
+}
+
+// Summarize summarizes the compression of the files by the tables of the
+// schemas, the files of the partitions are counted to their tables. The
+// summaries are sorted by the ratios in ascending order, so the tables
+// compressed poorly go first.
+func (s *CompressionStats) Summarize(schemas *Schemas) []TableCompression {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	owners := make(map[int64]int)
+	tables := make([]TableCompression, 0, len(schemas.schemas))
+	for _, schema := range schemas.schemas {
+		if schema.tableInfo == nil {
+			continue
+		}
+		owners[schema.tableInfo.ID] = len(tables)
+		if pi := schema.tableInfo.GetPartitionInfo(); pi != nil {
+			for _, def := range pi.Definitions {
+				owners[def.ID] = len(tables)
+			}
+		}
+		tables = append(tables, TableCompression{DB: schema.dbInfo.Name.O, Table: schema.tableInfo.Name.O})
+	}
+	for _, f := range s.Files {
+		i, ok := owners[f.TableID]
+		if !ok {
+			continue
+		}
+		tables[i].Files++
+		tables[i].Size += f.Size
+		tables[i].TotalBytes += f.TotalBytes
+	}
+	for i := range tables {
+		tables[i].Ratio = compressionRatio(tables[i].Size, tables[i].TotalBytes)
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		if tables[i].Ratio != tables[j].Ratio {
+			return tables[i].Ratio < tables[j].Ratio
+		}
+		if tables[i].DB != tables[j].DB {
+			return tables[i].DB < tables[j].DB
+		}
+		return tables[i].Table < tables[j].Table
+	})
+	s.Tables = tables
+	return tables
+}
+
+// Write writes the stats to the storage of the backup.
+func (s *CompressionStats) Write(ctx context.Context, st storage.ExternalStorage) error {
+	s.mu.Lock()
+	data, err := json.Marshal(s)
+	s.mu.Unlock()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(st.WriteFile(ctx, CompressionStatsFile, data))
+}
+
+func compressionRatio(size, totalBytes uint64) float64 {
+	if size == 0 {
+		return 0
+	}
+	return float64(totalBytes) / float64(size)
+}
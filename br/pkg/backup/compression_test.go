@@ -0,0 +1,56 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package backup_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/backup"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressionStats(t *testing.T) {
+	db := &model.DBInfo{Name: model.NewCIStr("test")}
+	schemas := backup.NewBackupSchemas()
+	schemas.AddSchema(db, &model.TableInfo{ID: 1, Name: model.NewCIStr("t1")})
+	schemas.AddSchema(db, &model.TableInfo{ID: 2, Name: model.NewCIStr("t2"), Partition: &model.PartitionInfo{
+		Definitions: []model.PartitionDefinition{{ID: 21}, {ID: 22}},
+	}})
+
+	stats := backup.NewCompressionStats(backuppb.CompressionType_ZSTD, 3)
+	stats.Record([]*backuppb.File{
+		{Name: "1_write.sst", Cf: "write", StartKey: tablecodec.GenTableRecordPrefix(1), Size_: 10, TotalBytes: 40},
+		{Name: "1_default.sst", Cf: "default", StartKey: tablecodec.EncodeTablePrefix(1), Size_: 10, TotalBytes: 40},
+	})
+	stats.Record([]*backuppb.File{
+		{Name: "21_write.sst", Cf: "write", StartKey: tablecodec.EncodeTablePrefix(21), Size_: 30, TotalBytes: 60},
+		{Name: "22_write.sst", Cf: "write", StartKey: tablecodec.EncodeTablePrefix(22), Size_: 10, TotalBytes: 20},
+	})
+	size, totalBytes := stats.Sizes()
+	require.Equal(t, uint64(60), size)
+	require.Equal(t, uint64(160), totalBytes)
+	require.InDelta(t, 160.0/60.0, stats.Ratio(), 1e-9)
+
+	// the tables compressed poorly go first.
+	require.Equal(t, []backup.TableCompression{
+		{DB: "test", Table: "t2", Files: 2, Size: 40, TotalBytes: 80, Ratio: 2},
+		{DB: "test", Table: "t1", Files: 2, Size: 20, TotalBytes: 80, Ratio: 4},
+	}, stats.Summarize(schemas))
+
+	s := GetRandomStorage(t)
+	ctx := context.Background()
+	require.NoError(t, stats.Write(ctx, s))
+	data, err := s.ReadFile(ctx, backup.CompressionStatsFile)
+	require.NoError(t, err)
+	written := &backup.CompressionStats{}
+	require.NoError(t, json.Unmarshal(data, written))
+	require.Equal(t, "ZSTD", written.Type)
+	require.Equal(t, int32(3), written.Level)
+	require.Len(t, written.Files, 4)
+	require.Len(t, written.Tables, 2)
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package backup
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pingcap/errors"
+)
+
+// BackupState is the state of a running backup.
+type BackupState string
+
+const (
+	// BackupRunning means the backup is backing up the ranges.
+	BackupRunning BackupState = "running"
+	// BackupPausing means the backup is paused but the ranges being backed up
+	// haven't finished yet.
+	BackupPausing BackupState = "pausing"
+	// BackupPaused means no range is being backed up and the progress is
+	// persisted to the checkpoint.
+	BackupPaused BackupState = "paused"
+)
+
+// pauser stops the workers from starting to back up the new ranges while
+// the backup is paused. The ranges being backed up are always finished, since
+// a range is the unit of the checkpoint.
+type pauser struct {
+	mu       sync.Mutex
+	paused   bool
+	flushed  bool
+	resumeCh chan struct{}
+	running  int
+}
+
+// pause returns true if no range is being backed up, then the progress should
+// be persisted by the caller.
+func (p *pauser) pause() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.paused {
+		p.paused = true
+		p.flushed = false
+		p.resumeCh = make(chan struct{})
+	}
+	return p.running == 0 && !p.flushed
+}
+
+func (p *pauser) resume() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.paused {
+		p.paused = false
+		close(p.resumeCh)
+	}
+}
+
+func (p *pauser) state() BackupState {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	switch {
+	case !p.paused:
+		return BackupRunning
+	case p.running > 0 || !p.flushed:
+		return BackupPausing
+	default:
+		return BackupPaused
+	}
+}
+
+// enter waits until the backup isn't paused, then marks a range is being
+// backed up.
+func (p *pauser) enter(ctx context.Context) error {
+	for {
+		p.mu.Lock()
+		if !p.paused {
+			p.running++
+			p.mu.Unlock()
+			return nil
+		}
+		ch := p.resumeCh
+		p.mu.Unlock()
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		}
+	}
+}
+
+// leave marks a range is finished, it returns true if the backup is paused
+// and all the ranges being backed up are finished, then the progress should be
+// persisted by the caller, which calls markFlushed after that.
+func (p *pauser) leave() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.running--
+	return p.paused && p.running == 0
+}
+
+func (p *pauser) markFlushed() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.paused && p.running == 0 {
+		p.flushed = true
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/logutil"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// ReplicasFile is the file recording the locations of the replicas of the
+	// backup, it's written to the primary storage and every replica.
+	ReplicasFile = "backup.replicas.json"
+
+	replicaCopyRetryTimes  = 5
+	replicaCopyWaitTime    = time.Second
+	replicaCopyMaxWaitTime = 16 * time.Second
+	replicaCopyBufferSize  = 4 * 1024 * 1024
+)
+
+// ReplicaTarget is a storage the backup is replicated to.
+type ReplicaTarget struct {
+	// Location is the URI of the storage without the credentials.
+	Location string
+	Storage  storage.ExternalStorage
+}
+
+// Replica is the state of the backup replicated to a storage.
+type Replica struct {
+	Location string `json:"location"`
+	Files    int    `json:"files"`
+	Size     int64  `json:"size"`
+	Verified bool   `json:"verified"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Replicas records the locations of the copies of the backup.
+type Replicas struct {
+	Primary  string    `json:"primary"`
+	Replicas []Replica `json:"replicas"`
+}
+
+// ReplicateBackup copies the files of the backup in the primary storage to
+// the targets. Each target is copied and verified independently, so a failed
+// target doesn't stop the others, the failed targets are returned in the
+// error after the replicas file is written to the primary storage and the
+// targets succeeded.
+func ReplicateBackup(
+	ctx context.Context,
+	primary ReplicaTarget,
+	targets []ReplicaTarget,
+	concurrency uint,
+) (*Replicas, error) {
+	type file struct {
+		path string
+		size int64
+	}
+	var files []file
+	err := primary.Storage.WalkDir(ctx, &storage.WalkOption{}, func(path string, size int64) error {
+		if path != ReplicasFile {
+			files = append(files, file{path: path, size: size})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to list the files of the backup")
+	}
+
+	replicas := &Replicas{Primary: primary.Location, Replicas: make([]Replica, len(targets))}
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		i, target := i, target
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r := &replicas.Replicas[i]
+			r.Location = target.Location
+			pool := utils.NewWorkerPool(concurrency, "Replicate Backup")
+			eg, ectx := errgroup.WithContext(ctx)
+			for _, f := range files {
+				f := f
+				pool.ApplyOnErrorGroup(eg, func() error {
+					bo := utils.InitialRetryState(replicaCopyRetryTimes, replicaCopyWaitTime, replicaCopyMaxWaitTime)
+					return utils.WithRetry(ectx, func() error {
+						return copyAndVerify(ectx, primary.Storage, target.Storage, f.path)
+					}, &bo)
+				})
+			}
+			if err := eg.Wait(); err != nil {
+				log.Warn("failed to replicate the backup", zap.String("location", target.Location), logutil.ShortError(err))
+				r.Error = err.Error()
+				return
+			}
+			r.Verified = true
+			r.Files = len(files)
+			for _, f := range files {
+				r.Size += f.size
+			}
+			log.Info("replicate the backup", zap.String("location", target.Location),
+				zap.Int("files", r.Files), zap.Int64("size", r.Size))
+		}()
+	}
+	wg.Wait()
+
+	data, err := json.Marshal(replicas)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := primary.Storage.WriteFile(ctx, ReplicasFile, data); err != nil {
+		return nil, errors.Trace(err)
+	}
+	var failed []string
+	for i, r := range replicas.Replicas {
+		if !r.Verified {
+			failed = append(failed, r.Location)
+			continue
+		}
+		if err := targets[i].Storage.WriteFile(ctx, ReplicasFile, data); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	if len(failed) > 0 {
+		return replicas, errors.Annotatef(berrors.ErrStorageUnknown, "failed to replicate the backup to %v", failed)
+	}
+	return replicas, nil
+}
+
+// copyAndVerify copies the file to the target, then reads it back from the
+// target and compares the checksums.
+func copyAndVerify(ctx context.Context, from, to storage.ExternalStorage, path string) error {
+	reader, err := from.Open(ctx, path)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer reader.Close()
+	writer, err := to.Create(ctx, path)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	hasher := sha256.New()
+	buf := make([]byte, replicaCopyBufferSize)
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			hasher.Write(buf[:n])
+			if _, werr := writer.Write(ctx, buf[:n]); werr != nil {
+				_ = writer.Close(ctx)
+				return errors.Trace(werr)
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			_ = writer.Close(ctx)
+			return errors.Trace(err)
+		}
+	}
+	if err := writer.Close(ctx); err != nil {
+		return errors.Trace(err)
+	}
+
+	copied, err := to.Open(ctx, path)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer copied.Close()
+	verifier := sha256.New()
+	if _, err := io.Copy(verifier, copied); err != nil {
+		return errors.Trace(err)
+	}
+	if !bytes.Equal(hasher.Sum(nil), verifier.Sum(nil)) {
+		return errors.Annotatef(berrors.ErrStorageUnknown, "the checksum of the replicated file %s mismatches", path)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package backup_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/pingcap/tidb/br/pkg/backup"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicateBackup(t *testing.T) {
+	ctx := context.Background()
+	primary := GetRandomStorage(t)
+	files := map[string]string{
+		"backupmeta":    "meta",
+		"1/1_write.sst": "write",
+		"1/1_default":   "default",
+	}
+	for name, content := range files {
+		require.NoError(t, primary.WriteFile(ctx, name, []byte(content)))
+	}
+	onsite := GetRandomStorage(t)
+	offsite := GetRandomStorage(t)
+
+	replicas, err := backup.ReplicateBackup(ctx,
+		backup.ReplicaTarget{Location: "local:///primary", Storage: primary},
+		[]backup.ReplicaTarget{
+			{Location: "local:///onsite", Storage: onsite},
+			{Location: "local:///offsite", Storage: offsite},
+		}, 2)
+	require.NoError(t, err)
+	require.Equal(t, "local:///primary", replicas.Primary)
+	for _, r := range replicas.Replicas {
+		require.True(t, r.Verified)
+		require.Equal(t, 3, r.Files)
+		require.Equal(t, int64(16), r.Size)
+	}
+	for _, s := range []storage.ExternalStorage{primary, onsite, offsite} {
+		for name, content := range files {
+			data, err := s.ReadFile(ctx, name)
+			require.NoError(t, err)
+			require.Equal(t, content, string(data))
+		}
+		data, err := s.ReadFile(ctx, backup.ReplicasFile)
+		require.NoError(t, err)
+		written := &backup.Replicas{}
+		require.NoError(t, json.Unmarshal(data, written))
+		require.Len(t, written.Replicas, 2)
+	}
+}
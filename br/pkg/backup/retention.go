@@ -0,0 +1,141 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package backup
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+// BackupKind is the kind of a backup managed by the retention policy.
+type BackupKind string
+
+const (
+	// FullBackup is a snapshot backup without the last backup ts.
+	FullBackup BackupKind = "full"
+	// IncrementalBackup is a snapshot backup continuing another snapshot backup.
+	IncrementalBackup BackupKind = "incremental"
+	// LogBackup is the storage of a log backup task.
+	LogBackup BackupKind = "log"
+)
+
+// BackupEntry is a backup under the storage prefix.
+type BackupEntry struct {
+	// Path is the directory of the backup relative to the prefix.
+	Path         string     `json:"path"`
+	Kind         BackupKind `json:"kind"`
+	StartVersion uint64     `json:"start_version"`
+	EndVersion   uint64     `json:"end_version"`
+	// Base is the path of the backup the incremental backup continues.
+	Base string `json:"base,omitempty"`
+}
+
+// RetentionPolicy decides which backups are expired.
+type RetentionPolicy struct {
+	// KeepFulls is the number of the latest full backups kept, with their
+	// incremental backups.
+	KeepFulls int
+	// KeepLogs is how long the log backups are kept, 0 means forever.
+	KeepLogs time.Duration
+}
+
+// LogTruncation is the truncation of a log backup.
+type LogTruncation struct {
+	Path  string `json:"path"`
+	Until uint64 `json:"until"`
+}
+
+// PurgePlan is the backups to delete by the retention policy.
+type PurgePlan struct {
+	// Expired is the snapshot backups to delete, the later backups of a chain
+	// come first, so an interrupted purge never leaves a broken chain.
+	Expired        []*BackupEntry   `json:"expired,omitempty"`
+	Kept           []*BackupEntry   `json:"kept,omitempty"`
+	LogTruncations []*LogTruncation `json:"log_truncations,omitempty"`
+	// Warnings are the backups which can't be managed, they're always kept.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// PlanPurge plans the backups to delete by the policy. An incremental backup
+// is deleted only with the full backup its chain starts from, and the one whose
+// chain is broken is kept. The log backups are truncated to the period of
+// KeepLogs, but never beyond the oldest full backup kept, so every full backup
+// kept can still be restored to a point in time by the logs.
+func PlanPurge(entries []*BackupEntry, policy RetentionPolicy, now time.Time) *PurgePlan {
+	plan := &PurgePlan{}
+	byEndVersion := make(map[uint64]*BackupEntry)
+	var fulls, logs []*BackupEntry
+	for _, e := range entries {
+		switch e.Kind {
+		case FullBackup:
+			fulls = append(fulls, e)
+			byEndVersion[e.EndVersion] = e
+		case IncrementalBackup:
+			byEndVersion[e.EndVersion] = e
+		case LogBackup:
+			logs = append(logs, e)
+		}
+	}
+	sort.Slice(fulls, func(i, j int) bool { return fulls[i].EndVersion > fulls[j].EndVersion })
+	expiredFulls := make(map[*BackupEntry]bool, len(fulls))
+	var oldestKept uint64
+	for i, full := range fulls {
+		if policy.KeepFulls > 0 && i >= policy.KeepFulls {
+			expiredFulls[full] = true
+		} else {
+			oldestKept = full.EndVersion
+		}
+	}
+
+	// chainRoot returns the full backup the chain of the backup starts from.
+	chainRoot := func(e *BackupEntry) *BackupEntry {
+		for steps := 0; e != nil && e.Kind == IncrementalBackup && steps <= len(entries); steps++ {
+			base := byEndVersion[e.StartVersion]
+			if base != nil {
+				e.Base = base.Path
+			}
+			e = base
+		}
+		if e == nil || e.Kind != FullBackup {
+			return nil
+		}
+		return e
+	}
+	for _, e := range entries {
+		switch e.Kind {
+		case FullBackup, IncrementalBackup:
+			root := chainRoot(e)
+			if root == nil {
+				plan.Warnings = append(plan.Warnings, fmt.Sprintf(
+					"the base backup of the incremental backup %s with the start version %d isn't found, it's kept",
+					e.Path, e.StartVersion))
+				plan.Kept = append(plan.Kept, e)
+			} else if expiredFulls[root] {
+				plan.Expired = append(plan.Expired, e)
+			} else {
+				plan.Kept = append(plan.Kept, e)
+			}
+		default:
+			plan.Kept = append(plan.Kept, e)
+		}
+	}
+	sort.Slice(plan.Expired, func(i, j int) bool { return plan.Expired[i].EndVersion > plan.Expired[j].EndVersion })
+	sort.Slice(plan.Kept, func(i, j int) bool { return plan.Kept[i].EndVersion > plan.Kept[j].EndVersion })
+
+	if policy.KeepLogs <= 0 {
+		return plan
+	}
+	until := oracle.GoTimeToTS(now.Add(-policy.KeepLogs))
+	if oldestKept > 0 && oldestKept < until {
+		until = oldestKept
+	}
+	for _, l := range logs {
+		if until > l.StartVersion {
+			plan.LogTruncations = append(plan.LogTruncations, &LogTruncation{Path: l.Path, Until: until})
+		}
+	}
+	return plan
+}
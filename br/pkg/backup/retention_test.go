@@ -0,0 +1,50 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package backup_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/br/pkg/backup"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func TestPlanPurge(t *testing.T) {
+	now := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	ts := func(daysAgo int) uint64 {
+		return oracle.GoTimeToTS(now.Add(-time.Duration(daysAgo) * 24 * time.Hour))
+	}
+	entries := []*backup.BackupEntry{
+		{Path: "full-30", Kind: backup.FullBackup, EndVersion: ts(30)},
+		{Path: "inc-29", Kind: backup.IncrementalBackup, StartVersion: ts(30), EndVersion: ts(29)},
+		{Path: "inc-28", Kind: backup.IncrementalBackup, StartVersion: ts(29), EndVersion: ts(28)},
+		{Path: "full-20", Kind: backup.FullBackup, EndVersion: ts(20)},
+		{Path: "inc-19", Kind: backup.IncrementalBackup, StartVersion: ts(20), EndVersion: ts(19)},
+		{Path: "full-10", Kind: backup.FullBackup, EndVersion: ts(10)},
+		{Path: "orphan", Kind: backup.IncrementalBackup, StartVersion: ts(40), EndVersion: ts(9)},
+		{Path: "log", Kind: backup.LogBackup, StartVersion: ts(35), EndVersion: ts(0)},
+	}
+	paths := func(entries []*backup.BackupEntry) []string {
+		res := make([]string, 0, len(entries))
+		for _, e := range entries {
+			res = append(res, e.Path)
+		}
+		return res
+	}
+
+	plan := backup.PlanPurge(entries, backup.RetentionPolicy{KeepFulls: 2, KeepLogs: 7 * 24 * time.Hour}, now)
+	// the later backups of the chain are deleted first.
+	require.Equal(t, []string{"inc-28", "inc-29", "full-30"}, paths(plan.Expired))
+	require.Equal(t, []string{"log", "orphan", "full-10", "inc-19", "full-20"}, paths(plan.Kept))
+	require.Equal(t, "inc-29", entries[2].Base)
+	require.Len(t, plan.Warnings, 1)
+	require.Contains(t, plan.Warnings[0], "orphan")
+	// the logs after the oldest full backup kept are needed by the point in time restore.
+	require.Equal(t, []*backup.LogTruncation{{Path: "log", Until: ts(20)}}, plan.LogTruncations)
+
+	plan = backup.PlanPurge(entries, backup.RetentionPolicy{KeepFulls: 3, KeepLogs: 40 * 24 * time.Hour}, now)
+	require.Empty(t, plan.Expired)
+	require.Empty(t, plan.LogTruncations)
+}
@@ -0,0 +1,157 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/logutil"
+	"github.com/pingcap/tidb/br/pkg/rtree"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/tablecodec"
+	filter "github.com/pingcap/tidb/util/table-filter"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// ScheduleFile is the file of the schedule of the backup, it's written
+	// beside the backupmeta for analyzing the backup later.
+	ScheduleFile = "backup.schedule.json"
+
+	defaultEstimateConcurrency = 16
+)
+
+// SizeEstimator estimates the size in bytes of the data in the key range.
+type SizeEstimator func(ctx context.Context, startKey, endKey []byte) (uint64, error)
+
+// TableSchedule is the position of a table in the schedule of the backup.
+type TableSchedule struct {
+	Order         int    `json:"order"`
+	DB            string `json:"db"`
+	Table         string `json:"table"`
+	EstimatedSize uint64 `json:"estimated-size"`
+	Priority      bool   `json:"priority,omitempty"`
+
+	ranges []rtree.Range
+}
+
+// Schedule is the order the tables are backed up in. The tables pinned by
+// the priorities go first, then the larger tables go earlier, so the largest
+// tables don't delay the end of the backup by starting last.
+type Schedule struct {
+	Tables []TableSchedule `json:"tables"`
+}
+
+// BuildSchedule orders the tables of the schemas by the priorities and their
+// estimated sizes. The table whose size fails to be estimated is ordered as an
+// empty one.
+func BuildSchedule(
+	ctx context.Context,
+	schemas *Schemas,
+	priorities filter.Filter,
+	estimate SizeEstimator,
+) (*Schedule, error) {
+	tables := make([]TableSchedule, 0, len(schemas.schemas))
+	infos := make([]*model.TableInfo, 0, len(schemas.schemas))
+	for _, s := range schemas.schemas {
+		if s.tableInfo == nil {
+			continue
+		}
+		ranges, err := BuildTableRanges(s.tableInfo)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		t := TableSchedule{
+			DB:       s.dbInfo.Name.O,
+			Table:    s.tableInfo.Name.O,
+			Priority: priorities != nil && priorities.MatchTable(s.dbInfo.Name.O, s.tableInfo.Name.O),
+			ranges:   make([]rtree.Range, 0, len(ranges)),
+		}
+		for _, r := range ranges {
+			t.ranges = append(t.ranges, rtree.Range{StartKey: r.StartKey, EndKey: r.EndKey})
+		}
+		tables = append(tables, t)
+		infos = append(infos, s.tableInfo)
+	}
+
+	if estimate != nil {
+		eg, ectx := errgroup.WithContext(ctx)
+		workers := utils.NewWorkerPool(defaultEstimateConcurrency, "Estimate Table Sizes")
+		for i := range tables {
+			i := i
+			workers.ApplyOnErrorGroup(eg, func() error {
+				tables[i].EstimatedSize = estimateTableSize(ectx, infos[i], estimate)
+				return nil
+			})
+		}
+		if err := eg.Wait(); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
+	sort.Slice(tables, func(i, j int) bool {
+		a, b := tables[i], tables[j]
+		if a.Priority != b.Priority {
+			return a.Priority
+		}
+		if a.EstimatedSize != b.EstimatedSize {
+			return a.EstimatedSize > b.EstimatedSize
+		}
+		if a.DB != b.DB {
+			return a.DB < b.DB
+		}
+		return a.Table < b.Table
+	})
+	for i := range tables {
+		tables[i].Order = i
+	}
+	return &Schedule{Tables: tables}, nil
+}
+
+// estimateTableSize estimates the size of the table by the ranges of its
+// physical tables, including the indices.
+func estimateTableSize(ctx context.Context, info *model.TableInfo, estimate SizeEstimator) uint64 {
+	physicalIDs := []int64{info.ID}
+	if pi := info.GetPartitionInfo(); pi != nil {
+		physicalIDs = physicalIDs[:0]
+		for _, def := range pi.Definitions {
+			physicalIDs = append(physicalIDs, def.ID)
+		}
+	}
+	var total uint64
+	for _, id := range physicalIDs {
+		size, err := estimate(ctx, tablecodec.EncodeTablePrefix(id), tablecodec.EncodeTablePrefix(id+1))
+		if err != nil {
+			log.Warn("failed to estimate the size of the table, schedule it as an empty one",
+				zap.Stringer("table", info.Name), zap.Int64("physical-id", id), logutil.ShortError(err))
+			return 0
+		}
+		total += size
+	}
+	return total
+}
+
+// Ranges returns the ranges of the tables in the order of the schedule.
+func (s *Schedule) Ranges() []rtree.Range {
+	ranges := make([]rtree.Range, 0, len(s.Tables))
+	for _, t := range s.Tables {
+		ranges = append(ranges, t.ranges...)
+	}
+	return ranges
+}
+
+// Write writes the schedule to the storage of the backup.
+func (s *Schedule) Write(ctx context.Context, st storage.ExternalStorage) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(st.WriteFile(ctx, ScheduleFile, data))
+}
@@ -0,0 +1,76 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package backup_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/backup"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/tablecodec"
+	filter "github.com/pingcap/tidb/util/table-filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildSchedule(t *testing.T) {
+	db := &model.DBInfo{Name: model.NewCIStr("test")}
+	schemas := backup.NewBackupSchemas()
+	schemas.AddSchema(&model.DBInfo{Name: model.NewCIStr("empty")}, nil)
+	sizes := map[int64]uint64{1: 100, 2: 300, 3: 200, 41: 150, 42: 150}
+	for _, info := range []*model.TableInfo{
+		{ID: 1, Name: model.NewCIStr("small")},
+		{ID: 2, Name: model.NewCIStr("large")},
+		{ID: 3, Name: model.NewCIStr("pinned")},
+		{ID: 4, Name: model.NewCIStr("partitioned"), Partition: &model.PartitionInfo{
+			Definitions: []model.PartitionDefinition{{ID: 41}, {ID: 42}},
+		}},
+		{ID: 5, Name: model.NewCIStr("unknown")},
+	} {
+		schemas.AddSchema(db, info)
+	}
+	estimate := func(_ context.Context, startKey, endKey []byte) (uint64, error) {
+		id := tablecodec.DecodeTableID(startKey)
+		require.Equal(t, id+1, tablecodec.DecodeTableID(endKey))
+		size, ok := sizes[id]
+		if !ok {
+			return 0, errors.New("no region stats")
+		}
+		return size, nil
+	}
+	priorities, err := filter.Parse([]string{"test.pinned"})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	schedule, err := backup.BuildSchedule(ctx, schemas, priorities, estimate)
+	require.NoError(t, err)
+	names := make([]string, 0, len(schedule.Tables))
+	for i, tbl := range schedule.Tables {
+		require.Equal(t, i, tbl.Order)
+		names = append(names, tbl.Table)
+	}
+	require.Equal(t, []string{"pinned", "large", "partitioned", "small", "unknown"}, names)
+	require.True(t, schedule.Tables[0].Priority)
+	require.Equal(t, uint64(300), schedule.Tables[2].EstimatedSize)
+	require.Equal(t, uint64(0), schedule.Tables[4].EstimatedSize)
+
+	// the ranges of the larger tables go first.
+	ranges := schedule.Ranges()
+	require.NotEmpty(t, ranges)
+	require.Equal(t, int64(3), tablecodec.DecodeTableID(ranges[0].StartKey))
+	require.Equal(t, int64(5), tablecodec.DecodeTableID(ranges[len(ranges)-1].StartKey))
+
+	// the schedule is written beside the backupmeta.
+	s, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, schedule.Write(ctx, s))
+	data, err := s.ReadFile(ctx, backup.ScheduleFile)
+	require.NoError(t, err)
+	written := backup.Schedule{}
+	require.NoError(t, json.Unmarshal(data, &written))
+	require.Len(t, written.Tables, 5)
+	require.Equal(t, "pinned", written.Tables[0].Table)
+}
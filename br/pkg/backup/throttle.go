@@ -0,0 +1,309 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package backup
+
+import (
+	"context"
+	"crypto/tls"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/conn"
+	connutil "github.com/pingcap/tidb/br/pkg/conn/util"
+	"github.com/pingcap/tidb/br/pkg/logutil"
+	"github.com/pingcap/tidb/br/pkg/tikvmetrics"
+	"github.com/pingcap/tidb/store/pdtypes"
+	pd "github.com/tikv/pd/client"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	defaultThrottleInterval = 10 * time.Second
+
+	// slowStoreScore is the slow score above which PD takes the store as a slow
+	// one, the score is raised by TiKV once its disk IO is slow.
+	slowStoreScore = 80
+	// throttleHeadroom is the ratio of the SLOs under which the concurrency is
+	// increased, so the concurrency doesn't swing around the SLOs.
+	throttleHeadroom = 0.8
+)
+
+// userRequestTypes are the types of the gRPC requests to TiKV whose latencies
+// are felt by the users.
+var userRequestTypes = map[string]struct{}{
+	"kv_get":              {},
+	"kv_batch_get":        {},
+	"kv_scan":             {},
+	"kv_prewrite":         {},
+	"kv_commit":           {},
+	"kv_pessimistic_lock": {},
+	"coprocessor":         {},
+}
+
+// LoadSample is the load of the TiKV stores in the last interval. It's the
+// max of the stores, since the users are slowed down by the busiest store.
+type LoadSample struct {
+	// CPUUsage is the ratio of the CPU cores used.
+	CPUUsage float64
+	// Latency is the average latency of the user requests.
+	Latency time.Duration
+	// SlowScore is the slow score of the store in PD, which reflects its disk IO.
+	SlowScore uint64
+}
+
+// LoadSampler samples the load of the TiKV stores.
+type LoadSampler interface {
+	Sample(ctx context.Context) (LoadSample, error)
+}
+
+// ThrottleConfig is the SLOs of the cluster under the backup, 0 means the SLO
+// isn't checked.
+type ThrottleConfig struct {
+	// MaxLatency is the max average latency of the user requests.
+	MaxLatency time.Duration
+	// MaxCPUUsage is the max ratio of the CPU cores used by a TiKV store.
+	MaxCPUUsage float64
+	// MaxConcurrency is the max number of the ranges backed up concurrently.
+	MaxConcurrency uint
+	Interval       time.Duration
+}
+
+// Throttler adapts the number of the ranges backed up concurrently to the load
+// of the cluster, rather than a fixed rate limit. The concurrency is halved
+// once the load breaks the SLOs, and increased by one while the load is well
+// under them.
+type Throttler struct {
+	cfg     ThrottleConfig
+	sampler LoadSampler
+
+	mu      sync.Mutex
+	limit   uint
+	running uint
+	notify  chan struct{}
+}
+
+// NewThrottler creates a throttler, the concurrency starts at the max one.
+func NewThrottler(cfg ThrottleConfig, sampler LoadSampler) *Throttler {
+	if cfg.MaxConcurrency == 0 {
+		cfg.MaxConcurrency = 1
+	}
+	if cfg.Interval == 0 {
+		cfg.Interval = defaultThrottleInterval
+	}
+	return &Throttler{
+		cfg:     cfg,
+		sampler: sampler,
+		limit:   cfg.MaxConcurrency,
+		notify:  make(chan struct{}),
+	}
+}
+
+// Limit returns the current concurrency.
+func (t *Throttler) Limit() uint {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.limit
+}
+
+// Adjust adjusts the concurrency by the load sampled.
+func (t *Throttler) Adjust(sample LoadSample) {
+	overloaded := (t.cfg.MaxLatency > 0 && sample.Latency > t.cfg.MaxLatency) ||
+		(t.cfg.MaxCPUUsage > 0 && sample.CPUUsage > t.cfg.MaxCPUUsage) ||
+		sample.SlowScore >= slowStoreScore
+	idle := (t.cfg.MaxLatency == 0 || float64(sample.Latency) < float64(t.cfg.MaxLatency)*throttleHeadroom) &&
+		(t.cfg.MaxCPUUsage == 0 || sample.CPUUsage < t.cfg.MaxCPUUsage*throttleHeadroom) &&
+		sample.SlowScore < slowStoreScore
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	limit := t.limit
+	switch {
+	case overloaded && limit > 1:
+		limit /= 2
+	case idle && limit < t.cfg.MaxConcurrency:
+		limit++
+	}
+	if limit == t.limit {
+		return
+	}
+	log.Info("adjust the backup concurrency by the load of the cluster",
+		zap.Uint("from", t.limit), zap.Uint("to", limit), zap.Duration("latency", sample.Latency),
+		zap.Float64("cpu-usage", sample.CPUUsage), zap.Uint64("slow-score", sample.SlowScore))
+	t.limit = limit
+	t.wakeUp()
+}
+
+// Run samples the load and adjusts the concurrency periodically until ctx is done.
+func (t *Throttler) Run(ctx context.Context) {
+	ticker := time.NewTicker(t.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		sample, err := t.sampler.Sample(ctx)
+		if err != nil {
+			log.Warn("failed to sample the load of the cluster", logutil.ShortError(err))
+			continue
+		}
+		t.Adjust(sample)
+	}
+}
+
+func (t *Throttler) wakeUp() {
+	close(t.notify)
+	t.notify = make(chan struct{})
+}
+
+// acquire waits until the concurrency allows a new range to be backed up.
+func (t *Throttler) acquire(ctx context.Context) error {
+	if t == nil {
+		return nil
+	}
+	for {
+		t.mu.Lock()
+		if t.running < t.limit {
+			t.running++
+			t.mu.Unlock()
+			return nil
+		}
+		ch := t.notify
+		t.mu.Unlock()
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		}
+	}
+}
+
+func (t *Throttler) release() {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.running--
+	t.wakeUp()
+}
+
+// StoreLoadMgr provides the TiKV stores and their stats in PD.
+type StoreLoadMgr interface {
+	GetPDClient() pd.Client
+	GetTLSConfig() *tls.Config
+	GetStoreInfo(ctx context.Context, storeID uint64) (*pdtypes.StoreInfo, error)
+}
+
+// storeCounters are the counters scraped from the status API of a TiKV store.
+type storeCounters struct {
+	at           time.Time
+	cpuSeconds   float64
+	cpuCores     float64
+	latencySum   float64
+	latencyCount float64
+}
+
+// StoreLoadSampler samples the load of the TiKV stores by their metrics in the
+// status API, and their slow scores in PD.
+type StoreLoadSampler struct {
+	mgr     StoreLoadMgr
+	scraper *tikvmetrics.Scraper
+
+	mu   sync.Mutex
+	last map[uint64]storeCounters
+}
+
+// NewStoreLoadSampler creates a sampler of the TiKV stores.
+func NewStoreLoadSampler(mgr StoreLoadMgr) *StoreLoadSampler {
+	return &StoreLoadSampler{
+		mgr:     mgr,
+		scraper: tikvmetrics.NewScraper(mgr.GetTLSConfig()),
+		last:    make(map[uint64]storeCounters),
+	}
+}
+
+// Sample implements LoadSampler. The load is calculated by the counters since
+// the last sample, so the first sample of a store only records its counters.
+// The stores failed to be sampled are skipped.
+func (s *StoreLoadSampler) Sample(ctx context.Context) (LoadSample, error) {
+	stores, err := conn.GetAllTiKVStoresWithRetry(ctx, s.mgr.GetPDClient(), connutil.SkipTiFlash)
+	if err != nil {
+		return LoadSample{}, errors.Trace(err)
+	}
+	var (
+		mu     sync.Mutex
+		sample LoadSample
+	)
+	eg, ectx := errgroup.WithContext(ctx)
+	for _, store := range stores {
+		store := store
+		eg.Go(func() error {
+			metrics, err := s.scraper.Scrape(ectx, store.GetStatusAddress())
+			if err != nil {
+				log.Warn("failed to sample the load of the store", zap.Uint64("store", store.GetId()),
+					logutil.ShortError(err))
+				return nil
+			}
+			counters := newStoreCounters(metrics)
+			var slowScore uint64
+			if info, err := s.mgr.GetStoreInfo(ectx, store.GetId()); err != nil {
+				log.Warn("failed to get the store info", zap.Uint64("store", store.GetId()), logutil.ShortError(err))
+			} else if info.Status != nil {
+				slowScore = info.Status.SlowScore
+			}
+
+			s.mu.Lock()
+			last, ok := s.last[store.GetId()]
+			s.last[store.GetId()] = counters
+			s.mu.Unlock()
+
+			mu.Lock()
+			defer mu.Unlock()
+			if slowScore > sample.SlowScore {
+				sample.SlowScore = slowScore
+			}
+			if !ok {
+				return nil
+			}
+			if elapsed := counters.at.Sub(last.at).Seconds(); elapsed > 0 && counters.cpuCores > 0 {
+				usage := (counters.cpuSeconds - last.cpuSeconds) / elapsed / counters.cpuCores
+				if usage > sample.CPUUsage {
+					sample.CPUUsage = usage
+				}
+			}
+			if count := counters.latencyCount - last.latencyCount; count > 0 {
+				latency := time.Duration((counters.latencySum - last.latencySum) / count * float64(time.Second))
+				if latency > sample.Latency {
+					sample.Latency = latency
+				}
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return LoadSample{}, errors.Trace(err)
+	}
+	return sample, nil
+}
+
+// newStoreCounters gets the counters from the metrics of the store.
+func newStoreCounters(m *tikvmetrics.Metrics) storeCounters {
+	counters := storeCounters{
+		at:         m.At,
+		cpuSeconds: m.Sum("process_cpu_seconds_total"),
+		cpuCores:   m.Sum("tikv_server_cpu_cores_quota"),
+	}
+	for tp, h := range m.HistogramSumBy("tikv_grpc_msg_duration_seconds", "type") {
+		if _, ok := userRequestTypes[tp]; !ok {
+			continue
+		}
+		counters.latencySum += h.Sum
+		counters.latencyCount += h.Count
+	}
+	return counters
+}
@@ -0,0 +1,72 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package backup
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/br/pkg/tikvmetrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStoreCounters(t *testing.T) {
+	metrics := `# TYPE process_cpu_seconds_total counter
+process_cpu_seconds_total 120.5
+# TYPE tikv_server_cpu_cores_quota gauge
+tikv_server_cpu_cores_quota 8
+# TYPE tikv_grpc_msg_duration_seconds histogram
+tikv_grpc_msg_duration_seconds_bucket{type="kv_get",le="+Inf"} 100
+tikv_grpc_msg_duration_seconds_sum{type="kv_get"} 0.5
+tikv_grpc_msg_duration_seconds_count{type="kv_get"} 100
+tikv_grpc_msg_duration_seconds_bucket{type="coprocessor",le="+Inf"} 50
+tikv_grpc_msg_duration_seconds_sum{type="coprocessor"} 1.5
+tikv_grpc_msg_duration_seconds_count{type="coprocessor"} 50
+tikv_grpc_msg_duration_seconds_bucket{type="raft",le="+Inf"} 1000
+tikv_grpc_msg_duration_seconds_sum{type="raft"} 100
+tikv_grpc_msg_duration_seconds_count{type="raft"} 1000
+`
+	m, err := tikvmetrics.Parse(strings.NewReader(metrics))
+	require.NoError(t, err)
+	counters := newStoreCounters(m)
+	require.Equal(t, 120.5, counters.cpuSeconds)
+	require.Equal(t, 8.0, counters.cpuCores)
+	// the raft messages aren't the user requests.
+	require.Equal(t, 2.0, counters.latencySum)
+	require.Equal(t, 150.0, counters.latencyCount)
+}
+
+func TestThrottler(t *testing.T) {
+	throttler := NewThrottler(ThrottleConfig{MaxLatency: 10 * time.Millisecond, MaxCPUUsage: 0.8, MaxConcurrency: 8}, nil)
+	require.Equal(t, uint(8), throttler.Limit())
+	throttler.Adjust(LoadSample{Latency: 20 * time.Millisecond})
+	require.Equal(t, uint(4), throttler.Limit())
+	throttler.Adjust(LoadSample{CPUUsage: 0.9})
+	require.Equal(t, uint(2), throttler.Limit())
+	throttler.Adjust(LoadSample{SlowScore: 100})
+	require.Equal(t, uint(1), throttler.Limit())
+	throttler.Adjust(LoadSample{Latency: 20 * time.Millisecond})
+	require.Equal(t, uint(1), throttler.Limit())
+	// the load near the SLOs keeps the concurrency.
+	throttler.Adjust(LoadSample{Latency: 9 * time.Millisecond})
+	require.Equal(t, uint(1), throttler.Limit())
+
+	ctx := context.Background()
+	require.NoError(t, throttler.acquire(ctx))
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	require.Error(t, throttler.acquire(timeoutCtx))
+
+	acquired := make(chan struct{})
+	go func() {
+		require.NoError(t, throttler.acquire(ctx))
+		close(acquired)
+	}()
+	throttler.Adjust(LoadSample{Latency: time.Millisecond, CPUUsage: 0.1})
+	require.Equal(t, uint(2), throttler.Limit())
+	<-acquired
+	throttler.release()
+	throttler.release()
+}
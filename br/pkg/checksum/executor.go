@@ -53,11 +53,11 @@ func (builder *ExecutorBuilder) SetConcurrency(conc uint) *ExecutorBuilder {
 
 // Build builds a checksum executor.
 func (builder *ExecutorBuilder) Build() (*Executor, error) {
-	reqs, err := buildChecksumRequest(builder.table, builder.oldTable, builder.ts, builder.concurrency)
+	reqs, physicalIDs, err := buildChecksumRequest(builder.table, builder.oldTable, builder.ts, builder.concurrency)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	return &Executor{reqs: reqs}, nil
+	return &Executor{reqs: reqs, physicalIDs: physicalIDs}, nil
 }
 
 func buildChecksumRequest(
@@ -65,22 +65,26 @@ func buildChecksumRequest(
 	oldTable *metautil.Table,
 	startTS uint64,
 	concurrency uint,
-) ([]*kv.Request, error) {
+) ([]*kv.Request, []int64, error) {
 	var partDefs []model.PartitionDefinition
 	if part := newTable.Partition; part != nil {
 		partDefs = part.Definitions
 	}
 
 	reqs := make([]*kv.Request, 0, (len(newTable.Indices)+1)*(len(partDefs)+1))
+	physicalIDs := make([]int64, 0, cap(reqs))
 	var oldTableID int64
 	if oldTable != nil {
 		oldTableID = oldTable.Info.ID
 	}
 	rs, err := buildRequest(newTable, newTable.ID, oldTable, oldTableID, startTS, concurrency)
 	if err != nil {
-		return nil, errors.Trace(err)
+		return nil, nil, errors.Trace(err)
 	}
 	reqs = append(reqs, rs...)
+	for range rs {
+		physicalIDs = append(physicalIDs, newTable.ID)
+	}
 
 	for _, partDef := range partDefs {
 		var oldPartID int64
@@ -93,12 +97,15 @@ func buildChecksumRequest(
 		}
 		rs, err := buildRequest(newTable, partDef.ID, oldTable, oldPartID, startTS, concurrency)
 		if err != nil {
-			return nil, errors.Trace(err)
+			return nil, nil, errors.Trace(err)
 		}
 		reqs = append(reqs, rs...)
+		for range rs {
+			physicalIDs = append(physicalIDs, partDef.ID)
+		}
 	}
 
-	return reqs, nil
+	return reqs, physicalIDs, nil
 }
 
 func buildRequest(
@@ -263,6 +270,8 @@ func updateChecksumResponse(resp, update *tipb.ChecksumResponse) {
 // Executor is a checksum executor.
 type Executor struct {
 	reqs []*kv.Request
+	// physicalIDs are the IDs of the tables or the partitions of the requests.
+	physicalIDs []int64
 }
 
 // Len returns the total number of checksum requests.
@@ -317,3 +326,28 @@ func (exec *Executor) Execute(
 	}
 	return checksumResp, nil
 }
+
+// ExecuteByPhysicalTable executes a checksum executor, the checksums are
+// returned by the IDs of the table and its partitions.
+func (exec *Executor) ExecuteByPhysicalTable(
+	ctx context.Context,
+	client kv.Client,
+	updateFn func(),
+) (map[int64]*tipb.ChecksumResponse, error) {
+	checksumResps := make(map[int64]*tipb.ChecksumResponse)
+	for i, req := range exec.reqs {
+		killed := uint32(0)
+		resp, err := sendChecksumRequest(ctx, client, req, kv.NewVariables(&killed))
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		checksumResp, ok := checksumResps[exec.physicalIDs[i]]
+		if !ok {
+			checksumResp = &tipb.ChecksumResponse{}
+			checksumResps[exec.physicalIDs[i]] = checksumResp
+		}
+		updateChecksumResponse(checksumResp, resp)
+		updateFn()
+	}
+	return checksumResps, nil
+}
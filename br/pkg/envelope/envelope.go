@@ -0,0 +1,182 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package envelope
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+	"net/url"
+
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/kvproto/pkg/encryptionpb"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/storage"
+)
+
+// DataKeyFile is the file of the wrapped data key of the backup. It's written
+// beside the backupmeta in plaintext, because the backupmeta itself is
+// encrypted by the data key.
+const DataKeyFile = "backupmeta.datakey"
+
+// MasterKey wraps and unwraps the data keys of the backups.
+type MasterKey interface {
+	// Kind returns the kind of the master key, e.g. local, aws-kms.
+	Kind() string
+	// ID returns the ID of the master key, it's recorded for finding the
+	// master key when restoring.
+	ID() string
+	Wrap(ctx context.Context, plaintext []byte) ([]byte, error)
+	Unwrap(ctx context.Context, ciphertext []byte) ([]byte, error)
+}
+
+// DataKey is the envelope of the data key of a backup.
+type DataKey struct {
+	Method      string `json:"method"`
+	MasterKey   string `json:"master-key"`
+	MasterKeyID string `json:"master-key-id"`
+	WrappedKey  []byte `json:"wrapped-key"`
+}
+
+// NewMasterKey creates the master key by the URI, which is one of
+//   - local:///path/to/key: the file containing the hexadecimal 256-bits key.
+//   - aws-kms:///key-id?region=us-west-2&endpoint=...
+//   - gcp-kms:///projects/p/locations/l/keyRings/r/cryptoKeys/k?credentials-file=...
+func NewMasterKey(ctx context.Context, uri string) (MasterKey, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, errors.Annotatef(berrors.ErrInvalidArgument, "invalid master key %q: %v", uri, err)
+	}
+	switch u.Scheme {
+	case "local":
+		return NewLocalMasterKeyFromFile(u.Path)
+	case "aws-kms":
+		return newAWSKMSMasterKey(u)
+	case "gcp-kms":
+		return newGCPKMSMasterKey(ctx, u)
+	default:
+		return nil, errors.Annotatef(berrors.ErrInvalidArgument, "unsupported master key %q", u.Scheme)
+	}
+}
+
+// GenerateDataKey generates a random data key of the method for a backup and
+// wraps it by the master key.
+func GenerateDataKey(
+	ctx context.Context,
+	method encryptionpb.EncryptionMethod,
+	mk MasterKey,
+) (*backuppb.CipherInfo, *DataKey, error) {
+	keyLen, err := dataKeyLen(method)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	key := make([]byte, keyLen)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	wrapped, err := mk.Wrap(ctx, key)
+	if err != nil {
+		return nil, nil, errors.Annotatef(err, "failed to wrap the data key by the %s master key", mk.Kind())
+	}
+	return &backuppb.CipherInfo{CipherType: method, CipherKey: key}, &DataKey{
+		Method:      method.String(),
+		MasterKey:   mk.Kind(),
+		MasterKeyID: mk.ID(),
+		WrappedKey:  wrapped,
+	}, nil
+}
+
+// Open unwraps the data key by the master key.
+func (k *DataKey) Open(ctx context.Context, mk MasterKey) (*backuppb.CipherInfo, error) {
+	if k.MasterKey != mk.Kind() {
+		return nil, errors.Annotatef(berrors.ErrInvalidArgument,
+			"the data key is wrapped by the %s master key, but the %s master key is provided", k.MasterKey, mk.Kind())
+	}
+	method, ok := encryptionpb.EncryptionMethod_value[k.Method]
+	if !ok {
+		return nil, errors.Annotatef(berrors.ErrInvalidMetaFile, "unknown encryption method %s", k.Method)
+	}
+	key, err := mk.Unwrap(ctx, k.WrappedKey)
+	if err != nil {
+		return nil, errors.Annotatef(err, "failed to unwrap the data key by the master key %s", k.MasterKeyID)
+	}
+	info := &backuppb.CipherInfo{CipherType: encryptionpb.EncryptionMethod(method), CipherKey: key}
+	if keyLen, err := dataKeyLen(info.CipherType); err != nil || keyLen != len(key) {
+		return nil, errors.Annotate(berrors.ErrInvalidMetaFile, "the length of the data key doesn't match the method")
+	}
+	return info, nil
+}
+
+// Write writes the data key to the storage of the backup.
+func (k *DataKey) Write(ctx context.Context, s storage.ExternalStorage) error {
+	data, err := json.Marshal(k)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(s.WriteFile(ctx, DataKeyFile, data))
+}
+
+// ReadDataKey reads the data key from the storage of the backup.
+func ReadDataKey(ctx context.Context, s storage.ExternalStorage) (*DataKey, error) {
+	data, err := s.ReadFile(ctx, DataKeyFile)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to read the data key of the backup")
+	}
+	k := &DataKey{}
+	if err := json.Unmarshal(data, k); err != nil {
+		return nil, errors.Annotate(berrors.ErrInvalidMetaFile, err.Error())
+	}
+	return k, nil
+}
+
+func dataKeyLen(method encryptionpb.EncryptionMethod) (int, error) {
+	switch method {
+	case encryptionpb.EncryptionMethod_AES128_CTR:
+		return 16, nil
+	case encryptionpb.EncryptionMethod_AES192_CTR:
+		return 24, nil
+	case encryptionpb.EncryptionMethod_AES256_CTR:
+		return 32, nil
+	default:
+		return 0, errors.Annotatef(berrors.ErrInvalidArgument, "the data key can't be generated for the method %s", method)
+	}
+}
+
+// sealGCM encrypts the plaintext by AES-GCM with a random nonce, the nonce is
+// prefixed to the ciphertext.
+func sealGCM(key, plaintext []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func openGCM(key, ciphertext []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.Annotate(berrors.ErrInvalidMetaFile, "the wrapped key is too short")
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, nil)
+	return plaintext, errors.Trace(err)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,85 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package envelope_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/pingcap/kvproto/pkg/encryptionpb"
+	"github.com/pingcap/tidb/br/pkg/envelope"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalMasterKey(t *testing.T) {
+	ctx := context.Background()
+	keyFile := filepath.Join(t.TempDir(), "master.key")
+	require.NoError(t, os.WriteFile(keyFile, []byte(hex.EncodeToString(bytes.Repeat([]byte{1}, 32))+"\n"), 0o600))
+	mk, err := envelope.NewMasterKey(ctx, "local://"+keyFile)
+	require.NoError(t, err)
+
+	info, dataKey, err := envelope.GenerateDataKey(ctx, encryptionpb.EncryptionMethod_AES256_CTR, mk)
+	require.NoError(t, err)
+	require.Len(t, info.CipherKey, 32)
+	require.NotContains(t, string(dataKey.WrappedKey), string(info.CipherKey))
+
+	s, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, dataKey.Write(ctx, s))
+	read, err := envelope.ReadDataKey(ctx, s)
+	require.NoError(t, err)
+	require.Equal(t, dataKey, read)
+	opened, err := read.Open(ctx, mk)
+	require.NoError(t, err)
+	require.Equal(t, info, opened)
+
+	// the data key can't be opened by another master key.
+	other, err := envelope.NewLocalMasterKey(bytes.Repeat([]byte{2}, 32))
+	require.NoError(t, err)
+	_, err = read.Open(ctx, other)
+	require.ErrorIs(t, err, berrors.ErrInvalidArgument)
+
+	_, err = envelope.GenerateDataKey(ctx, encryptionpb.EncryptionMethod_PLAINTEXT, mk)
+	require.ErrorIs(t, err, berrors.ErrInvalidArgument)
+	_, err = envelope.NewLocalMasterKey([]byte("short"))
+	require.ErrorIs(t, err, berrors.ErrInvalidArgument)
+	_, err = envelope.NewMasterKey(ctx, "unknown:///key")
+	require.ErrorIs(t, err, berrors.ErrInvalidArgument)
+}
+
+type mockKMS struct {
+	kmsiface.KMSAPI
+	keys map[string][]byte
+}
+
+func (m *mockKMS) EncryptWithContext(_ aws.Context, in *kms.EncryptInput, _ ...request.Option) (*kms.EncryptOutput, error) {
+	blob := append([]byte(aws.StringValue(in.KeyId)+":"), in.Plaintext...)
+	m.keys[string(blob)] = in.Plaintext
+	return &kms.EncryptOutput{CiphertextBlob: blob, KeyId: in.KeyId}, nil
+}
+
+func (m *mockKMS) DecryptWithContext(_ aws.Context, in *kms.DecryptInput, _ ...request.Option) (*kms.DecryptOutput, error) {
+	return &kms.DecryptOutput{Plaintext: m.keys[string(in.CiphertextBlob)], KeyId: in.KeyId}, nil
+}
+
+func TestAWSKMSMasterKey(t *testing.T) {
+	ctx := context.Background()
+	mk := envelope.NewAWSKMSMasterKey(&mockKMS{keys: make(map[string][]byte)}, "alias/br")
+	info, dataKey, err := envelope.GenerateDataKey(ctx, encryptionpb.EncryptionMethod_AES128_CTR, mk)
+	require.NoError(t, err)
+	require.Equal(t, "aws-kms", dataKey.MasterKey)
+	require.Equal(t, "alias/br", dataKey.MasterKeyID)
+	opened, err := dataKey.Open(ctx, mk)
+	require.NoError(t, err)
+	require.Equal(t, info, opened)
+}
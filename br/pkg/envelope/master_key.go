@@ -0,0 +1,198 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package envelope
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/pingcap/errors"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/option"
+)
+
+const localMasterKeyLen = 32
+
+// LocalMasterKey is the master key kept by the user, the data keys are wrapped
+// by AES-256-GCM.
+type LocalMasterKey struct {
+	key []byte
+}
+
+// NewLocalMasterKey creates the local master key by the 256-bits key.
+func NewLocalMasterKey(key []byte) (*LocalMasterKey, error) {
+	if len(key) != localMasterKeyLen {
+		return nil, errors.Annotatef(berrors.ErrInvalidArgument,
+			"the local master key should be %d bytes, but it's %d bytes", localMasterKeyLen, len(key))
+	}
+	return &LocalMasterKey{key: key}, nil
+}
+
+// NewLocalMasterKeyFromFile creates the local master key by the hexadecimal
+// key in the file.
+func NewLocalMasterKeyFromFile(path string) (*LocalMasterKey, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to read the master key file")
+	}
+	key, err := hex.DecodeString(string(bytes.TrimSpace(content)))
+	if err != nil {
+		return nil, errors.Annotate(berrors.ErrInvalidArgument, "the master key should be a hexadecimal string")
+	}
+	return NewLocalMasterKey(key)
+}
+
+// Kind implements MasterKey.
+func (k *LocalMasterKey) Kind() string {
+	return "local"
+}
+
+// ID implements MasterKey, it's the fingerprint of the key.
+func (k *LocalMasterKey) ID() string {
+	sum := sha256.Sum256(k.key)
+	return hex.EncodeToString(sum[:8])
+}
+
+// Wrap implements MasterKey.
+func (k *LocalMasterKey) Wrap(_ context.Context, plaintext []byte) ([]byte, error) {
+	return sealGCM(k.key, plaintext)
+}
+
+// Unwrap implements MasterKey.
+func (k *LocalMasterKey) Unwrap(_ context.Context, ciphertext []byte) ([]byte, error) {
+	plaintext, err := openGCM(k.key, ciphertext)
+	if err != nil {
+		return nil, errors.Annotate(berrors.ErrInvalidArgument, "the data key isn't wrapped by the master key")
+	}
+	return plaintext, nil
+}
+
+// AWSKMSMasterKey is the master key managed by AWS KMS.
+type AWSKMSMasterKey struct {
+	client kmsiface.KMSAPI
+	keyID  string
+}
+
+func newAWSKMSMasterKey(u *url.URL) (*AWSKMSMasterKey, error) {
+	keyID := strings.TrimPrefix(u.Path, "/")
+	if len(keyID) == 0 {
+		return nil, errors.Annotate(berrors.ErrInvalidArgument, "the key id of aws kms is required")
+	}
+	cfg := aws.NewConfig()
+	if region := u.Query().Get("region"); len(region) > 0 {
+		cfg.WithRegion(region)
+	}
+	if endpoint := u.Query().Get("endpoint"); len(endpoint) > 0 {
+		cfg.WithEndpoint(endpoint)
+	}
+	ses, err := session.NewSessionWithOptions(session.Options{Config: *cfg, SharedConfigState: session.SharedConfigEnable})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return NewAWSKMSMasterKey(kms.New(ses), keyID), nil
+}
+
+// NewAWSKMSMasterKey creates the master key by the key of AWS KMS.
+func NewAWSKMSMasterKey(client kmsiface.KMSAPI, keyID string) *AWSKMSMasterKey {
+	return &AWSKMSMasterKey{client: client, keyID: keyID}
+}
+
+// Kind implements MasterKey.
+func (k *AWSKMSMasterKey) Kind() string {
+	return "aws-kms"
+}
+
+// ID implements MasterKey.
+func (k *AWSKMSMasterKey) ID() string {
+	return k.keyID
+}
+
+// Wrap implements MasterKey.
+func (k *AWSKMSMasterKey) Wrap(ctx context.Context, plaintext []byte) ([]byte, error) {
+	out, err := k.client.EncryptWithContext(ctx, &kms.EncryptInput{
+		KeyId:     aws.String(k.keyID),
+		Plaintext: plaintext,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return out.CiphertextBlob, nil
+}
+
+// Unwrap implements MasterKey.
+func (k *AWSKMSMasterKey) Unwrap(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	out, err := k.client.DecryptWithContext(ctx, &kms.DecryptInput{
+		KeyId:          aws.String(k.keyID),
+		CiphertextBlob: ciphertext,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return out.Plaintext, nil
+}
+
+// GCPKMSMasterKey is the master key managed by GCP Cloud KMS.
+type GCPKMSMasterKey struct {
+	keys *cloudkms.ProjectsLocationsKeyRingsCryptoKeysService
+	name string
+}
+
+func newGCPKMSMasterKey(ctx context.Context, u *url.URL) (*GCPKMSMasterKey, error) {
+	name := strings.TrimPrefix(u.Path, "/")
+	if !strings.HasPrefix(name, "projects/") {
+		return nil, errors.Annotate(berrors.ErrInvalidArgument,
+			"the key of gcp kms should be like projects/p/locations/l/keyRings/r/cryptoKeys/k")
+	}
+	var opts []option.ClientOption
+	if file := u.Query().Get("credentials-file"); len(file) > 0 {
+		opts = append(opts, option.WithCredentialsFile(file))
+	}
+	svc, err := cloudkms.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &GCPKMSMasterKey{keys: svc.Projects.Locations.KeyRings.CryptoKeys, name: name}, nil
+}
+
+// Kind implements MasterKey.
+func (k *GCPKMSMasterKey) Kind() string {
+	return "gcp-kms"
+}
+
+// ID implements MasterKey.
+func (k *GCPKMSMasterKey) ID() string {
+	return k.name
+}
+
+// Wrap implements MasterKey.
+func (k *GCPKMSMasterKey) Wrap(ctx context.Context, plaintext []byte) ([]byte, error) {
+	resp, err := k.keys.Encrypt(k.name, &cloudkms.EncryptRequest{
+		Plaintext: base64.StdEncoding.EncodeToString(plaintext),
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return base64.StdEncoding.DecodeString(resp.Ciphertext)
+}
+
+// Unwrap implements MasterKey.
+func (k *GCPKMSMasterKey) Unwrap(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	resp, err := k.keys.Decrypt(k.name, &cloudkms.DecryptRequest{
+		Ciphertext: base64.StdEncoding.EncodeToString(ciphertext),
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return base64.StdEncoding.DecodeString(resp.Plaintext)
+}
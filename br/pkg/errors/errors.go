@@ -5,6 +5,7 @@ package errors
 import (
 	"context"
 	stderrors "errors"
+	"strings"
 
 	"github.com/pingcap/errors"
 )
@@ -29,6 +30,67 @@ func IsContextCanceled(err error) bool {
 	return stderrors.Is(err, context.Canceled) || stderrors.Is(err, context.DeadlineExceeded)
 }
 
+// Attributes are the machine-readable attributes of a BR error class.
+type Attributes struct {
+	// Retryable indicates that the failed operation may succeed if it's retried.
+	Retryable bool
+	// UserFixable indicates that the error is caused by the arguments or the
+	// environment, and can be fixed by the user.
+	UserFixable bool
+	// Component is the component reporting the error, such as "KV" and "PD".
+	Component string
+}
+
+// errAttributes holds the attributes of the error classes, the classes not in
+// it are neither retryable nor user fixable.
+var errAttributes = make(map[errors.ErrorID]Attributes)
+
+func registerAttributes(retryable, userFixable bool, errs ...*errors.Error) {
+	for _, err := range errs {
+		attrs := errAttributes[err.ID()]
+		attrs.Retryable = attrs.Retryable || retryable
+		attrs.UserFixable = attrs.UserFixable || userFixable
+		errAttributes[err.ID()] = attrs
+	}
+}
+
+// componentOf returns the component from the RFC code like "BR:KV:ErrKVNotLeader".
+func componentOf(err *errors.Error) string {
+	parts := strings.Split(string(err.RFCCode()), ":")
+	if len(parts) < 3 {
+		return ""
+	}
+	return parts[1]
+}
+
+// GetAttributes returns the attributes of the first normalized error found in
+// the chain of err. It returns false if there isn't any normalized error.
+func GetAttributes(err error) (Attributes, bool) {
+	var normalizedErr *errors.Error
+	errors.Find(err, func(e error) bool {
+		normalizedErr, _ = e.(*errors.Error)
+		return normalizedErr != nil
+	})
+	if normalizedErr == nil {
+		return Attributes{}, false
+	}
+	attrs := errAttributes[normalizedErr.ID()]
+	attrs.Component = componentOf(normalizedErr)
+	return attrs, true
+}
+
+// IsRetryable checks whether err is caused by a retryable BR error.
+func IsRetryable(err error) bool {
+	attrs, ok := GetAttributes(err)
+	return ok && attrs.Retryable
+}
+
+// IsUserFixable checks whether err is caused by a BR error which can be fixed by the user.
+func IsUserFixable(err error) bool {
+	attrs, ok := GetAttributes(err)
+	return ok && attrs.UserFixable
+}
+
 // BR errors.
 var (
 	ErrUnknown                   = errors.Normalize("internal error", errors.RFCCodeText("BR:Common:ErrUnknown"))
@@ -49,6 +111,7 @@ var (
 	ErrBackupInvalidRange        = errors.Normalize("backup range invalid", errors.RFCCodeText("BR:Backup:ErrBackupInvalidRange"))
 	ErrBackupNoLeader            = errors.Normalize("backup no leader", errors.RFCCodeText("BR:Backup:ErrBackupNoLeader"))
 	ErrBackupGCSafepointExceeded = errors.Normalize("backup GC safepoint exceeded", errors.RFCCodeText("BR:Backup:ErrBackupGCSafepointExceeded"))
+	ErrBackupChangesUntracked    = errors.Normalize("the changes since the last backup aren't tracked", errors.RFCCodeText("BR:Backup:ErrBackupChangesUntracked"))
 
 	ErrRestoreModeMismatch     = errors.Normalize("restore mode mismatch", errors.RFCCodeText("BR:Restore:ErrRestoreModeMismatch"))
 	ErrRestoreRangeMismatch    = errors.Normalize("restore range mismatch", errors.RFCCodeText("BR:Restore:ErrRestoreRangeMismatch"))
@@ -67,6 +130,19 @@ var (
 	ErrUnsupportedSystemTable  = errors.Normalize("the system table isn't supported for restoring yet", errors.RFCCodeText("BR:Restore:ErrUnsupportedSysTable"))
 	ErrDatabasesAlreadyExisted = errors.Normalize("databases already existed in restored cluster", errors.RFCCodeText("BR:Restore:ErrDatabasesAlreadyExisted"))
 
+	ErrRestoreIncompatiblePlacement = errors.Normalize("placement policy can't be satisfied by the cluster",
+		errors.RFCCodeText("BR:Restore:ErrRestoreIncompatiblePlacement"))
+	ErrRestoreDDLDivergence = errors.Normalize("the ddl history of the cluster diverges from the backup",
+		errors.RFCCodeText("BR:Restore:ErrRestoreDDLDivergence"))
+	ErrRestorePartitionMismatch = errors.Normalize("the partition can't be exchanged into the existing table",
+		errors.RFCCodeText("BR:Restore:ErrRestorePartitionMismatch"))
+	ErrRestoreStoreUnhealthy = errors.Normalize("the stores can't restore the data",
+		errors.RFCCodeText("BR:Restore:ErrRestoreStoreUnhealthy"))
+	ErrRestoreIncompatibleCharset = errors.Normalize("the charset or the collation of the database isn't supported by the cluster",
+		errors.RFCCodeText("BR:Restore:ErrRestoreIncompatibleCharset"))
+	ErrRestoreAutoIDCollision = errors.Normalize("the next ids allocated may collide with the restored data",
+		errors.RFCCodeText("BR:Restore:ErrRestoreAutoIDCollision"))
+
 	// ErrStreamLogTaskExist is the error when stream log task already exists, because of supporting single task currently.
 	ErrStreamLogTaskExist = errors.Normalize("stream task already exists", errors.RFCCodeText("BR:Stream:ErrStreamLogTaskExist"))
 
@@ -81,6 +157,7 @@ var (
 	ErrStorageUnknown           = errors.Normalize("unknown external storage error", errors.RFCCodeText("BR:ExternalStorage:ErrStorageUnknown"))
 	ErrStorageInvalidConfig     = errors.Normalize("invalid external storage config", errors.RFCCodeText("BR:ExternalStorage:ErrStorageInvalidConfig"))
 	ErrStorageInvalidPermission = errors.Normalize("external storage permission", errors.RFCCodeText("BR:ExternalStorage:ErrStorageInvalidPermission"))
+	ErrStorageChecksumMismatch  = errors.Normalize("external storage checksum mismatch", errors.RFCCodeText("BR:ExternalStorage:ErrStorageChecksumMismatch"))
 
 	// Errors reported from TiKV.
 	ErrKVStorage           = errors.Normalize("tikv storage occur I/O error", errors.RFCCodeText("BR:KV:ErrKVStorage"))
@@ -106,4 +183,50 @@ var (
 	ErrKVDownloadFailed = errors.Normalize("download sst failed", errors.RFCCodeText("BR:KV:ErrKVDownloadFailed"))
 	// ErrKVIngestFailed indicates a generic, retryable ingest error.
 	ErrKVIngestFailed = errors.Normalize("ingest sst failed", errors.RFCCodeText("BR:KV:ErrKVIngestFailed"))
+	// ErrKVDiskFull is the error raised when download or ingestion failed since
+	// the disk of the store is full. This error cannot be retried.
+	ErrKVDiskFull = errors.Normalize("the disk of tikv is full", errors.RFCCodeText("BR:KV:ErrKVDiskFull"))
+	// ErrKVStoreCircuitOpen is the error raised when the request isn't sent since
+	// the store failed recently. This error is retryable.
+	ErrKVStoreCircuitOpen = errors.Normalize("the store failed recently", errors.RFCCodeText("BR:KV:ErrKVStoreCircuitOpen"))
 )
+
+func init() {
+	registerAttributes(true, false,
+		ErrPDLeaderNotFound,
+		ErrPDBatchScanRegion,
+		ErrBackupNoLeader,
+		ErrRestoreSplitFailed,
+		ErrKVNotLeader,
+		ErrKVEpochNotMatch,
+		ErrKVDownloadFailed,
+		ErrKVIngestFailed,
+		ErrKVStoreCircuitOpen,
+	)
+	registerAttributes(false, true,
+		ErrInvalidArgument,
+		ErrUndefinedRestoreDbOrTable,
+		ErrVersionMismatch,
+		ErrEnvNotSpecified,
+		ErrUnsupportedOperation,
+		ErrBackupGCSafepointExceeded,
+		ErrRestoreSchemaNotExists,
+		ErrRestoreNotFreshCluster,
+		ErrRestoreIncompatibleSys,
+		ErrRestoreIncompatiblePlacement,
+		ErrRestoreDDLDivergence,
+		ErrRestorePartitionMismatch,
+		ErrRestoreStoreUnhealthy,
+		ErrRestoreIncompatibleCharset,
+		ErrRestoreAutoIDCollision,
+		ErrUnsupportedSystemTable,
+		ErrDatabasesAlreadyExisted,
+		ErrStreamLogTaskExist,
+		ErrPiTRTaskNotFound,
+		ErrStorageInvalidConfig,
+		ErrStorageInvalidPermission,
+		ErrKVClusterIDMismatch,
+		ErrKVNotTiKV,
+		ErrKVDiskFull,
+	)
+}
@@ -22,3 +22,29 @@ func TestIsContextCanceled(t *testing.T) {
 	require.True(t, berrors.IsContextCanceled(&url.Error{Err: context.Canceled}))
 	require.True(t, berrors.IsContextCanceled(&url.Error{Err: context.DeadlineExceeded}))
 }
+
+func TestErrorAttributes(t *testing.T) {
+	attrs, ok := berrors.GetAttributes(berrors.ErrKVEpochNotMatch)
+	require.True(t, ok)
+	require.Equal(t, berrors.Attributes{Retryable: true, Component: "KV"}, attrs)
+
+	attrs, ok = berrors.GetAttributes(errors.Annotate(berrors.ErrStorageInvalidConfig.GenWithStack("bad url"), "failed to open storage"))
+	require.True(t, ok)
+	require.Equal(t, berrors.Attributes{UserFixable: true, Component: "ExternalStorage"}, attrs)
+
+	attrs, ok = berrors.GetAttributes(berrors.ErrKVRangeIsEmpty)
+	require.True(t, ok)
+	require.Equal(t, berrors.Attributes{Component: "KV"}, attrs)
+
+	_, ok = berrors.GetAttributes(errors.New("epoch not match"))
+	require.False(t, ok)
+	_, ok = berrors.GetAttributes(nil)
+	require.False(t, ok)
+
+	require.True(t, berrors.IsRetryable(errors.Trace(berrors.ErrPDLeaderNotFound)))
+	require.True(t, berrors.IsRetryable(berrors.ErrKVDownloadFailed.Wrap(errors.New("io error")).GenWithStackByArgs()))
+	require.False(t, berrors.IsRetryable(berrors.ErrRestoreChecksumMismatch))
+	require.False(t, berrors.IsRetryable(errors.New("download sst failed")))
+	require.True(t, berrors.IsUserFixable(berrors.ErrInvalidArgument))
+	require.False(t, berrors.IsUserFixable(berrors.ErrKVIngestFailed))
+}
@@ -4,6 +4,7 @@ package glue
 
 import (
 	"context"
+	"time"
 
 	"github.com/pingcap/tidb/domain"
 	"github.com/pingcap/tidb/kv"
@@ -54,6 +55,33 @@ type BatchCreateTableSession interface {
 	CreateTables(ctx context.Context, tables map[string][]*model.TableInfo) error
 }
 
+// BatchCreateDatabaseSession is an interface to create a batch of databases
+// by a session.
+type BatchCreateDatabaseSession interface {
+	CreateDatabases(ctx context.Context, schemas []*model.DBInfo) error
+}
+
+// ProgressRow is the progress of a task or a sub task, such as a table.
+type ProgressRow struct {
+	// Name is empty for the whole task.
+	Name    string
+	Current int64
+	Total   int64
+	Percent float64
+	Status  string
+	// ETA is the estimated seconds to finish, it's negative if unknown.
+	ETA int64
+}
+
+// ProgressRecordSession is an interface to record the progress of the tasks
+// to the cluster, so it can be queried by SQL.
+type ProgressRecordSession interface {
+	RecordProgress(ctx context.Context, taskID string, rows []ProgressRow) error
+	// RemoveStaleProgress removes the progress which isn't updated for the
+	// retention, it's the progress of the finished or the crashed tasks.
+	RemoveStaleProgress(ctx context.Context, retention time.Duration) error
+}
+
 // Progress is an interface recording the current execution progress.
 type Progress interface {
 	// Inc increases the progress. This method must be goroutine-safe, and can
@@ -63,3 +91,39 @@ type Progress interface {
 	// called.
 	Close()
 }
+
+// ProgressEvent is a structured snapshot of the progress of a task.
+type ProgressEvent struct {
+	// Phase is the step the task is performing, e.g. "Full Restore" or "Checksum".
+	Phase string
+	// Current and Total are the finished and total units of the phase.
+	Current int64
+	Total   int64
+	// Object is the object being processed, e.g. "`db`.`table`", empty if unknown.
+	Object string
+}
+
+// Percent returns the percentage of completeness of the phase.
+func (e ProgressEvent) Percent() float64 {
+	if e.Total <= 0 {
+		return 0
+	}
+	return 100.0 * float64(e.Current) / float64(e.Total)
+}
+
+// EventProgress is a Progress which can report structured progress events.
+// The Progress returned by Glue.StartProgress may optionally implement it.
+type EventProgress interface {
+	Progress
+	// SetObject records the object being processed.
+	SetObject(object string)
+	// Event returns the current progress event.
+	Event() ProgressEvent
+}
+
+// SetProgressObject records the object being processed if the progress is an EventProgress.
+func SetProgressObject(p Progress, object string) {
+	if ep, ok := p.(EventProgress); ok {
+		ep.SetObject(object)
+	}
+}
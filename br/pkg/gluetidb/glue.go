@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"strings"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
@@ -195,6 +196,37 @@ func (gs *tidbSession) CreateDatabase(ctx context.Context, schema *model.DBInfo)
 	return d.CreateSchemaWithInfo(gs.se, schema, ddl.OnExistIgnore)
 }
 
+// CreateDatabases implements glue.BatchCreateDatabaseSession.
+// There is no DDL job creating several databases, so the databases of the
+// batch are created one by one by the session, the queries of the whole batch
+// are recorded as the query string of the session.
+func (gs *tidbSession) CreateDatabases(ctx context.Context, schemas []*model.DBInfo) error {
+	d := domain.GetDomain(gs.se).DDL()
+	queryBuilder := strings.Builder{}
+	cloneSchemas := make([]*model.DBInfo, 0, len(schemas))
+	for _, schema := range schemas {
+		query, err := gs.showCreateDatabase(schema)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		queryBuilder.WriteString(query)
+		queryBuilder.WriteString(";")
+
+		schema = schema.Clone()
+		if len(schema.Charset) == 0 {
+			schema.Charset = mysql.DefaultCharset
+		}
+		cloneSchemas = append(cloneSchemas, schema)
+	}
+	gs.se.SetValue(sessionctx.QueryString, queryBuilder.String())
+	for _, schema := range cloneSchemas {
+		if err := d.CreateSchemaWithInfo(gs.se, schema, ddl.OnExistIgnore); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
 // CreatePlacementPolicy implements glue.Session.
 func (gs *tidbSession) CreatePlacementPolicy(ctx context.Context, policy *model.PolicyInfo) error {
 	d := domain.GetDomain(gs.se).DDL()
@@ -300,6 +332,37 @@ func (gs *tidbSession) showCreatePlacementPolicy(policy *model.PolicyInfo) strin
 	return executor.ConstructResultOfShowCreatePlacementPolicy(policy)
 }
 
+// progressTable is created by the bootstrap of TiDB.
+const progressTable = "mysql.tidb_br_progress"
+
+// RecordProgress implements glue.ProgressRecordSession, it upserts the rows
+// into the mysql.tidb_br_progress table.
+func (gs *tidbSession) RecordProgress(ctx context.Context, taskID string, rows []glue.ProgressRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	sql := new(strings.Builder)
+	sql.WriteString("REPLACE INTO " + progressTable +
+		" (task_id, name, current, total, percent, status, eta_seconds) VALUES ")
+	args := make([]interface{}, 0, len(rows)*7)
+	for i, row := range rows {
+		if i > 0 {
+			sql.WriteString(", ")
+		}
+		sql.WriteString("(%?, %?, %?, %?, %?, %?, %?)")
+		args = append(args, taskID, row.Name, row.Current, row.Total, row.Percent, row.Status, row.ETA)
+	}
+	return errors.Trace(gs.ExecuteInternal(ctx, sql.String(), args...))
+}
+
+// RemoveStaleProgress implements glue.ProgressRecordSession, it deletes the
+// rows which aren't updated for the retention.
+func (gs *tidbSession) RemoveStaleProgress(ctx context.Context, retention time.Duration) error {
+	return errors.Trace(gs.ExecuteInternal(ctx,
+		"DELETE FROM "+progressTable+" WHERE update_time < DATE_SUB(NOW(), INTERVAL %? SECOND)",
+		int64(retention.Seconds())))
+}
+
 // mockSession is used for test.
 type mockSession struct {
 	se session.Session
@@ -342,6 +405,12 @@ func (s *mockSession) CreateDatabase(ctx context.Context, schema *model.DBInfo)
 	return nil
 }
 
+// CreateDatabases implements glue.BatchCreateDatabaseSession.
+func (s *mockSession) CreateDatabases(ctx context.Context, schemas []*model.DBInfo) error {
+	log.Fatal("unimplemented CreateDatabase for mock session")
+	return nil
+}
+
 // CreatePlacementPolicy implements glue.Session.
 func (s *mockSession) CreatePlacementPolicy(ctx context.Context, policy *model.PolicyInfo) error {
 	log.Fatal("unimplemented CreateDatabase for mock session")
@@ -0,0 +1,279 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package importclient
+
+import (
+	"context"
+	"crypto/tls"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/kvproto/pkg/import_sstpb"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
+)
+
+const gRPCBackOffMaxDelay = 3 * time.Second
+
+// StoreGetter gets the information of a store, it's usually the PD client or the split client.
+type StoreGetter interface {
+	GetStore(ctx context.Context, storeID uint64) (*metapb.Store, error)
+}
+
+// Config is the configuration of Client.
+type Config struct {
+	TLSConfig *tls.Config
+	Keepalive keepalive.ClientParameters
+	// ConnCount is the number of the connections to each store, the RPCs are
+	// sent through them in turn. It's 1 if not positive.
+	ConnCount int
+	// Block makes dialing a store block until the connection is ready or the
+	// dialing fails permanently. Otherwise the connection is made in the background.
+	Block bool
+	// DialTimeout is the timeout of dialing a store, 0 means no timeout.
+	DialTimeout time.Duration
+	// BackOffMaxDelay is the max delay of the backoff of reconnecting, 3s if not positive.
+	BackOffMaxDelay time.Duration
+}
+
+// Client manages the connections to the import service of the stores. It's
+// shared by restore and the local backend of lightning. The clients returned
+// by it track the health of the stores, and the broken connections are rebuilt
+// automatically, e.g. after the keepalive fails.
+type Client struct {
+	stores StoreGetter
+	cfg    Config
+	dial   func(ctx context.Context, addr string) (*grpc.ClientConn, error)
+
+	mu     sync.Mutex
+	conns  map[uint64]*storeConns
+	health map[uint64]*storeHealth
+}
+
+type storeConns struct {
+	conns []*grpc.ClientConn
+	next  int
+}
+
+// New creates a Client.
+func New(stores StoreGetter, cfg Config) *Client {
+	if cfg.ConnCount <= 0 {
+		cfg.ConnCount = 1
+	}
+	if cfg.BackOffMaxDelay <= 0 {
+		cfg.BackOffMaxDelay = gRPCBackOffMaxDelay
+	}
+	c := &Client{
+		stores: stores,
+		cfg:    cfg,
+		conns:  make(map[uint64]*storeConns),
+		health: make(map[uint64]*storeHealth),
+	}
+	c.dial = c.dialStore
+	return c
+}
+
+func (c *Client) dialStore(ctx context.Context, addr string) (*grpc.ClientConn, error) {
+	opt := grpc.WithInsecure()
+	if c.cfg.TLSConfig != nil {
+		opt = grpc.WithTransportCredentials(credentials.NewTLS(c.cfg.TLSConfig))
+	}
+	if c.cfg.DialTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.DialTimeout)
+		defer cancel()
+	}
+	bfConf := backoff.DefaultConfig
+	bfConf.MaxDelay = c.cfg.BackOffMaxDelay
+	opts := []grpc.DialOption{
+		opt,
+		grpc.WithConnectParams(grpc.ConnectParams{Backoff: bfConf}),
+		grpc.WithKeepaliveParams(c.cfg.Keepalive),
+	}
+	if c.cfg.Block {
+		opts = append(opts, grpc.WithBlock(), grpc.FailOnNonTempDialError(true))
+	}
+	conn, err := grpc.DialContext(ctx, addr, opts...)
+	return conn, errors.Trace(err)
+}
+
+// GetImportClient returns the import client of the store.
+func (c *Client) GetImportClient(ctx context.Context, storeID uint64) (import_sstpb.ImportSSTClient, error) {
+	conn, err := c.getConn(ctx, storeID)
+	if err != nil {
+		if _, ok := errors.Cause(err).(*dialError); ok && ctx.Err() == nil {
+			c.record(storeID, "Dial", err)
+		}
+		return nil, errors.Trace(err)
+	}
+	return &storeClient{
+		ImportSSTClient: import_sstpb.NewImportSSTClient(conn),
+		c:               c,
+		storeID:         storeID,
+		conn:            conn,
+	}, nil
+}
+
+func (c *Client) getConn(ctx context.Context, storeID uint64) (*grpc.ClientConn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sc, ok := c.conns[storeID]
+	if !ok {
+		sc = &storeConns{conns: make([]*grpc.ClientConn, c.cfg.ConnCount)}
+		c.conns[storeID] = sc
+	}
+	i := sc.next
+	sc.next = (sc.next + 1) % len(sc.conns)
+	conn := sc.conns[i]
+	if conn != nil && conn.GetState() != connectivity.Shutdown {
+		return conn, nil
+	}
+	if conn != nil {
+		log.Info("rebuild the connection to the import service",
+			zap.Uint64("store", storeID), zap.String("target", conn.Target()))
+		connRebuildCounter.WithLabelValues(storeLabel(storeID)).Inc()
+	}
+
+	store, err := c.stores.GetStore(ctx, storeID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// we should use peer address for tiflash. for tikv, peer address is empty
+	addr := store.GetPeerAddress()
+	if addr == "" {
+		addr = store.GetAddress()
+	}
+	conn, err = c.dial(ctx, addr)
+	if err != nil {
+		return nil, errors.Trace(&dialError{addr: addr, err: err})
+	}
+	sc.conns[i] = conn
+	return conn, nil
+}
+
+// dropConn closes the broken connection, it will be rebuilt on the next use.
+func (c *Client) dropConn(storeID uint64, conn *grpc.ClientConn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sc, ok := c.conns[storeID]
+	if !ok {
+		return
+	}
+	for i, cc := range sc.conns {
+		if cc == conn {
+			if err := conn.Close(); err != nil {
+				log.Warn("failed to close the broken connection", zap.Uint64("store", storeID), zap.Error(err))
+			}
+			sc.conns[i] = nil
+		}
+	}
+}
+
+// IsStoreHealthy returns whether the store is healthy, the store is considered
+// unhealthy for a while after it fails several times in a row.
+func (c *Client) IsStoreHealthy(storeID uint64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	h, ok := c.health[storeID]
+	return !ok || h.healthy(time.Now())
+}
+
+// observe records the result of the RPC sent to the store.
+func (c *Client) observe(storeID uint64, method string, err error) {
+	if err != nil && !IsStoreError(err) {
+		// the errors like canceled are not caused by the store.
+		return
+	}
+	c.record(storeID, method, err)
+}
+
+func (c *Client) record(storeID uint64, method string, err error) {
+	store := storeLabel(storeID)
+	c.mu.Lock()
+	h, ok := c.health[storeID]
+	if !ok {
+		h = &storeHealth{}
+		c.health[storeID] = h
+	}
+	now := time.Now()
+	wasHealthy := h.healthy(now)
+	if err == nil {
+		h.onSuccess()
+	} else {
+		h.onFailure(now, err)
+	}
+	isHealthy := h.healthy(now)
+	c.mu.Unlock()
+
+	if err != nil {
+		code := codes.Unavailable
+		if _, ok := errors.Cause(err).(*dialError); !ok {
+			code = status.Code(errors.Cause(err))
+		}
+		storeErrorCounter.WithLabelValues(store, method, code.String()).Inc()
+	}
+	if wasHealthy != isHealthy {
+		log.Info("the health of the store changed", zap.Uint64("store", storeID),
+			zap.Bool("healthy", isHealthy), zap.Error(err))
+	}
+	if isHealthy {
+		storeHealthyGauge.WithLabelValues(store).Set(1)
+	} else {
+		storeHealthyGauge.WithLabelValues(store).Set(0)
+	}
+}
+
+// Close closes all the connections.
+func (c *Client) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for storeID, sc := range c.conns {
+		for _, conn := range sc.conns {
+			if conn == nil {
+				continue
+			}
+			if err := conn.Close(); err != nil {
+				log.Warn("failed to close the connection", zap.Uint64("store", storeID), zap.Error(err))
+			}
+		}
+	}
+	c.conns = make(map[uint64]*storeConns)
+}
+
+// dialError is the error of dialing a store.
+type dialError struct {
+	addr string
+	err  error
+}
+
+func (e *dialError) Error() string {
+	return "failed to dial " + e.addr + ": " + e.err.Error()
+}
+
+// IsStoreError returns whether the error is caused by the store or the
+// connection to it, rather than the request, the store can't be reached if so.
+func IsStoreError(err error) bool {
+	cause := errors.Cause(err)
+	if _, ok := cause.(*dialError); ok {
+		return true
+	}
+	switch status.Code(cause) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+func storeLabel(storeID uint64) string {
+	return strconv.FormatUint(storeID, 10)
+}
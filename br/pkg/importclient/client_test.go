@@ -0,0 +1,162 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package importclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/kvproto/pkg/import_sstpb"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/tidb/br/pkg/restore/split"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeStores struct{}
+
+func (fakeStores) GetStore(_ context.Context, storeID uint64) (*metapb.Store, error) {
+	return &metapb.Store{Id: storeID, Address: "127.0.0.1:0"}, nil
+}
+
+func TestStoreHealth(t *testing.T) {
+	h := &storeHealth{}
+	now := time.Now()
+	require.True(t, h.healthy(now))
+	for i := 0; i < unhealthyThreshold-1; i++ {
+		h.onFailure(now, errors.New("unavailable"))
+		require.True(t, h.healthy(now))
+	}
+	h.onFailure(now, errors.New("unavailable"))
+	require.False(t, h.healthy(now))
+	require.True(t, h.healthy(now.Add(minQuarantine)))
+
+	// the quarantine grows but is capped.
+	for i := 0; i < 10; i++ {
+		h.onFailure(now, errors.New("unavailable"))
+	}
+	require.False(t, h.healthy(now.Add(maxQuarantine-time.Millisecond)))
+	require.True(t, h.healthy(now.Add(maxQuarantine)))
+
+	h.onSuccess()
+	require.True(t, h.healthy(now))
+}
+
+func TestIsStoreError(t *testing.T) {
+	require.True(t, IsStoreError(status.Error(codes.Unavailable, "")))
+	require.True(t, IsStoreError(errors.Trace(status.Error(codes.DeadlineExceeded, ""))))
+	require.True(t, IsStoreError(errors.Trace(&dialError{addr: "127.0.0.1:0", err: errors.New("refused")})))
+	require.False(t, IsStoreError(status.Error(codes.Canceled, "")))
+	require.False(t, IsStoreError(errors.New("epoch not match")))
+	require.False(t, IsStoreError(nil))
+}
+
+func TestDialFailure(t *testing.T) {
+	ctx := context.Background()
+	c := New(fakeStores{}, Config{})
+	c.dial = func(context.Context, string) (*grpc.ClientConn, error) {
+		return nil, errors.New("connection refused")
+	}
+	for i := 0; i < unhealthyThreshold; i++ {
+		require.True(t, c.IsStoreHealthy(1))
+		_, err := c.GetImportClient(ctx, 1)
+		require.Error(t, err)
+		require.True(t, IsStoreError(err))
+	}
+	require.False(t, c.IsStoreHealthy(1))
+	require.True(t, c.IsStoreHealthy(2))
+
+	// the errors of the requests don't affect the health.
+	c.observe(2, "Ingest", status.Error(codes.Canceled, ""))
+	c.observe(2, "Ingest", errors.New("epoch not match"))
+	c.observe(2, "Ingest", errors.New("epoch not match"))
+	require.True(t, c.IsStoreHealthy(2))
+
+	c.observe(1, "Ingest", nil)
+	require.True(t, c.IsStoreHealthy(1))
+}
+
+func TestRebuildConn(t *testing.T) {
+	ctx := context.Background()
+	c := New(fakeStores{}, Config{ConnCount: 2})
+	dialed := 0
+	c.dial = func(ctx context.Context, addr string) (*grpc.ClientConn, error) {
+		dialed++
+		return grpc.DialContext(ctx, addr, grpc.WithInsecure())
+	}
+	defer c.Close()
+
+	for i := 0; i < 4; i++ {
+		_, err := c.GetImportClient(ctx, 1)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, dialed)
+
+	// the closed connection is rebuilt.
+	conn := c.conns[1].conns[0]
+	require.NoError(t, conn.Close())
+	for i := 0; i < 2; i++ {
+		_, err := c.GetImportClient(ctx, 1)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 3, dialed)
+
+	// the dropped connection is rebuilt.
+	c.dropConn(1, c.conns[1].conns[1])
+	for i := 0; i < 2; i++ {
+		_, err := c.GetImportClient(ctx, 1)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 4, dialed)
+}
+
+type fakeRegions map[uint64]*split.RegionInfo
+
+func (r fakeRegions) GetRegionByID(_ context.Context, regionID uint64) (*split.RegionInfo, error) {
+	return r[regionID], nil
+}
+
+func newRegion(version uint64, leaderStore uint64) *split.RegionInfo {
+	peers := []*metapb.Peer{{Id: 1, StoreId: 1}, {Id: 2, StoreId: 2}, {Id: 3, StoreId: 3}}
+	return &split.RegionInfo{
+		Region: &metapb.Region{
+			Id:          1,
+			Peers:       peers,
+			RegionEpoch: &metapb.RegionEpoch{Version: version, ConfVer: 1},
+		},
+		Leader: peers[leaderStore-1],
+	}
+}
+
+func TestIngestToLeader(t *testing.T) {
+	ctx := context.Background()
+	var stores []uint64
+	ingest := func(_ context.Context, _ *split.RegionInfo, peer *metapb.Peer) (*import_sstpb.IngestResponse, error) {
+		stores = append(stores, peer.GetStoreId())
+		if peer.GetStoreId() == 1 {
+			return nil, status.Error(codes.Unavailable, "connection refused")
+		}
+		return &import_sstpb.IngestResponse{}, nil
+	}
+
+	// the request is retried against the new leader.
+	_, err := IngestToLeader(ctx, fakeRegions{1: newRegion(1, 2)}, newRegion(1, 1), ingest)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, stores)
+
+	// the request isn't sent to the followers if the leader isn't moved.
+	stores = nil
+	_, err = IngestToLeader(ctx, fakeRegions{1: newRegion(1, 1)}, newRegion(1, 1), ingest)
+	require.True(t, IsStoreError(err))
+	require.Equal(t, []uint64{1}, stores)
+
+	// the request isn't retried if the region is changed.
+	stores = nil
+	_, err = IngestToLeader(ctx, fakeRegions{1: newRegion(2, 2)}, newRegion(1, 1), ingest)
+	require.True(t, IsStoreError(err))
+	require.Equal(t, []uint64{1}, stores)
+}
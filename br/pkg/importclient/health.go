@@ -0,0 +1,51 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package importclient
+
+import (
+	"time"
+)
+
+const (
+	// unhealthyThreshold is the number of the consecutive failures that makes a store unhealthy.
+	unhealthyThreshold = 3
+	minQuarantine      = time.Second
+	maxQuarantine      = 30 * time.Second
+)
+
+// storeHealth tracks the health of a store. A store becomes unhealthy after
+// it fails unhealthyThreshold times in a row, and is quarantined for a while,
+// the quarantine grows exponentially if it keeps failing.
+type storeHealth struct {
+	consecutiveFailures int
+	lastErr             error
+	unhealthyUntil      time.Time
+}
+
+func (h *storeHealth) healthy(now time.Time) bool {
+	return !now.Before(h.unhealthyUntil)
+}
+
+func (h *storeHealth) onSuccess() {
+	h.consecutiveFailures = 0
+	h.lastErr = nil
+	h.unhealthyUntil = time.Time{}
+}
+
+func (h *storeHealth) onFailure(now time.Time, err error) {
+	h.consecutiveFailures++
+	h.lastErr = err
+	if h.consecutiveFailures < unhealthyThreshold {
+		return
+	}
+	// cap the shift to avoid overflow, the quarantine is capped anyway.
+	n := h.consecutiveFailures - unhealthyThreshold
+	if n > 5 {
+		n = 5
+	}
+	quarantine := minQuarantine << n
+	if quarantine > maxQuarantine {
+		quarantine = maxQuarantine
+	}
+	h.unhealthyUntil = now.Add(quarantine)
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package importclient
+
+import (
+	"context"
+
+	"github.com/pingcap/kvproto/pkg/import_sstpb"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/logutil"
+	"github.com/pingcap/tidb/br/pkg/restore/split"
+	"go.uber.org/zap"
+)
+
+// RegionGetter gets the region by its ID, it's usually the split client.
+type RegionGetter interface {
+	GetRegionByID(ctx context.Context, regionID uint64) (*split.RegionInfo, error)
+}
+
+// IngestFunc sends the ingest request of the region to the peer.
+type IngestFunc func(ctx context.Context, region *split.RegionInfo, peer *metapb.Peer) (*import_sstpb.IngestResponse, error)
+
+// IngestToLeader sends the ingest request of the region to its leader. Only
+// the leader can ingest, the followers reject the request with `NotLeader`, so
+// the request is never sent to them. If the store of the leader can't be
+// reached, the region is reloaded, and the request is retried once against the
+// new leader if the leader is moved and the epoch of the region is unchanged.
+// Otherwise the error is returned, and the caller handles it as before.
+func IngestToLeader(ctx context.Context, regions RegionGetter, region *split.RegionInfo, ingest IngestFunc) (*import_sstpb.IngestResponse, error) {
+	leader := region.Leader
+	if leader == nil {
+		leader = region.Region.GetPeers()[0]
+	}
+	resp, err := ingest(ctx, region, leader)
+	if err == nil || !IsStoreError(err) || ctx.Err() != nil {
+		return resp, err
+	}
+
+	newRegion, rerr := regions.GetRegionByID(ctx, region.Region.GetId())
+	if rerr != nil {
+		log.Warn("failed to reload the region after the leader failed to ingest",
+			logutil.Region(region.Region), zap.Error(rerr))
+		return resp, err
+	}
+	if newRegion == nil || newRegion.Leader == nil ||
+		newRegion.Leader.GetStoreId() == leader.GetStoreId() ||
+		!sameEpoch(newRegion.Region.GetRegionEpoch(), region.Region.GetRegionEpoch()) {
+		return resp, err
+	}
+	log.Info("the leader failed to ingest, retry against the new leader",
+		logutil.Region(newRegion.Region), zap.Uint64("store", leader.GetStoreId()),
+		zap.Uint64("new store", newRegion.Leader.GetStoreId()), logutil.ShortError(err))
+	return ingest(ctx, newRegion, newRegion.Leader)
+}
+
+func sameEpoch(a, b *metapb.RegionEpoch) bool {
+	return a.GetVersion() == b.GetVersion() && a.GetConfVer() == b.GetConfVer()
+}
@@ -0,0 +1,39 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package importclient
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	storeErrorCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "br",
+			Subsystem: "import",
+			Name:      "store_errors_total",
+			Help:      "Counter of the errors of the import RPCs caused by the stores.",
+		}, []string{"store", "method", "code"})
+
+	storeHealthyGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "br",
+			Subsystem: "import",
+			Name:      "store_healthy",
+			Help:      "Whether the import service of the store is considered healthy.",
+		}, []string{"store"})
+
+	connRebuildCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "br",
+			Subsystem: "import",
+			Name:      "conn_rebuilds_total",
+			Help:      "Counter of the rebuilt connections to the import service.",
+		}, []string{"store"})
+)
+
+func init() { // nolint:gochecknoinits
+	prometheus.MustRegister(storeErrorCounter)
+	prometheus.MustRegister(storeHealthyGauge)
+	prometheus.MustRegister(connRebuildCounter)
+}
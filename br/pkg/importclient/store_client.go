@@ -0,0 +1,103 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package importclient
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/kvproto/pkg/import_sstpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// storeClient is the import client of a store, it reports the results of the
+// RPCs to the Client, and drops the connection once it's found broken. For the
+// streaming RPCs, only the errors of opening the streams are reported.
+type storeClient struct {
+	import_sstpb.ImportSSTClient
+
+	c       *Client
+	storeID uint64
+	conn    *grpc.ClientConn
+}
+
+func (sc *storeClient) done(method string, err error) {
+	sc.c.observe(sc.storeID, method, err)
+	if status.Code(errors.Cause(err)) == codes.Unavailable {
+		sc.c.dropConn(sc.storeID, sc.conn)
+	}
+}
+
+func (sc *storeClient) SwitchMode(ctx context.Context, in *import_sstpb.SwitchModeRequest, opts ...grpc.CallOption) (*import_sstpb.SwitchModeResponse, error) {
+	resp, err := sc.ImportSSTClient.SwitchMode(ctx, in, opts...)
+	sc.done("SwitchMode", err)
+	return resp, err
+}
+
+func (sc *storeClient) Upload(ctx context.Context, opts ...grpc.CallOption) (import_sstpb.ImportSST_UploadClient, error) {
+	stream, err := sc.ImportSSTClient.Upload(ctx, opts...)
+	sc.done("Upload", err)
+	return stream, err
+}
+
+func (sc *storeClient) Ingest(ctx context.Context, in *import_sstpb.IngestRequest, opts ...grpc.CallOption) (*import_sstpb.IngestResponse, error) {
+	resp, err := sc.ImportSSTClient.Ingest(ctx, in, opts...)
+	sc.done("Ingest", err)
+	return resp, err
+}
+
+func (sc *storeClient) Compact(ctx context.Context, in *import_sstpb.CompactRequest, opts ...grpc.CallOption) (*import_sstpb.CompactResponse, error) {
+	resp, err := sc.ImportSSTClient.Compact(ctx, in, opts...)
+	sc.done("Compact", err)
+	return resp, err
+}
+
+func (sc *storeClient) SetDownloadSpeedLimit(ctx context.Context, in *import_sstpb.SetDownloadSpeedLimitRequest, opts ...grpc.CallOption) (*import_sstpb.SetDownloadSpeedLimitResponse, error) {
+	resp, err := sc.ImportSSTClient.SetDownloadSpeedLimit(ctx, in, opts...)
+	sc.done("SetDownloadSpeedLimit", err)
+	return resp, err
+}
+
+func (sc *storeClient) Download(ctx context.Context, in *import_sstpb.DownloadRequest, opts ...grpc.CallOption) (*import_sstpb.DownloadResponse, error) {
+	resp, err := sc.ImportSSTClient.Download(ctx, in, opts...)
+	sc.done("Download", err)
+	return resp, err
+}
+
+func (sc *storeClient) Write(ctx context.Context, opts ...grpc.CallOption) (import_sstpb.ImportSST_WriteClient, error) {
+	stream, err := sc.ImportSSTClient.Write(ctx, opts...)
+	sc.done("Write", err)
+	return stream, err
+}
+
+func (sc *storeClient) RawWrite(ctx context.Context, opts ...grpc.CallOption) (import_sstpb.ImportSST_RawWriteClient, error) {
+	stream, err := sc.ImportSSTClient.RawWrite(ctx, opts...)
+	sc.done("RawWrite", err)
+	return stream, err
+}
+
+func (sc *storeClient) MultiIngest(ctx context.Context, in *import_sstpb.MultiIngestRequest, opts ...grpc.CallOption) (*import_sstpb.IngestResponse, error) {
+	resp, err := sc.ImportSSTClient.MultiIngest(ctx, in, opts...)
+	sc.done("MultiIngest", err)
+	return resp, err
+}
+
+func (sc *storeClient) DuplicateDetect(ctx context.Context, in *import_sstpb.DuplicateDetectRequest, opts ...grpc.CallOption) (import_sstpb.ImportSST_DuplicateDetectClient, error) {
+	stream, err := sc.ImportSSTClient.DuplicateDetect(ctx, in, opts...)
+	sc.done("DuplicateDetect", err)
+	return stream, err
+}
+
+func (sc *storeClient) Apply(ctx context.Context, in *import_sstpb.ApplyRequest, opts ...grpc.CallOption) (*import_sstpb.ApplyResponse, error) {
+	resp, err := sc.ImportSSTClient.Apply(ctx, in, opts...)
+	sc.done("Apply", err)
+	return resp, err
+}
+
+func (sc *storeClient) ClearFiles(ctx context.Context, in *import_sstpb.ClearRequest, opts ...grpc.CallOption) (*import_sstpb.ClearResponse, error) {
+	resp, err := sc.ImportSSTClient.ClearFiles(ctx, in, opts...)
+	sc.done("ClearFiles", err)
+	return resp, err
+}
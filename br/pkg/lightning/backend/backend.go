@@ -289,6 +289,10 @@ func (be Backend) FlushAll(ctx context.Context) error {
 	return be.abstract.FlushAllEngines(ctx)
 }
 
+func (be Backend) FlushEngine(ctx context.Context, engineUUID uuid.UUID) error {
+	return be.abstract.FlushEngine(ctx, engineUUID)
+}
+
 func (be Backend) TotalMemoryConsume() int64 {
 	return be.abstract.TotalMemoryConsume()
 }
@@ -66,6 +66,18 @@ func (t *TableKVDecoder) DecodeRawRowDataAsStr(h kv.Handle, value []byte) (res s
 // IterRawIndexKeys generates the raw index keys corresponding to the raw row,
 // and then iterate them using `fn`. The input buffer will be reused.
 func (t *TableKVDecoder) IterRawIndexKeys(h kv.Handle, rawRow []byte, fn func([]byte) error) error {
+	return t.IterRawIndexKeysWithInfo(h, rawRow, func(_ *model.IndexInfo, key []byte, _ bool) error {
+		return fn(key)
+	})
+}
+
+// IterRawIndexKeysWithInfo is like IterRawIndexKeys, and it also passes the index of the key, and whether the key is
+// distinct, i.e. the handle isn't encoded in the key but in the value. The key is only valid in fn.
+func (t *TableKVDecoder) IterRawIndexKeysWithInfo(
+	h kv.Handle,
+	rawRow []byte,
+	fn func(indexInfo *model.IndexInfo, key []byte, distinct bool) error,
+) error {
 	row, _, err := t.DecodeRawRowData(h, rawRow)
 	if err != nil {
 		return err
@@ -90,11 +102,11 @@ func (t *TableKVDecoder) IterRawIndexKeys(h kv.Handle, rawRow []byte, fn func([]
 		if err != nil {
 			return err
 		}
-		indexKey, _, err := index.GenIndexKey(t.se.vars.StmtCtx, indexValues, h, indexBuffer)
+		indexKey, distinct, err := index.GenIndexKey(t.se.vars.StmtCtx, indexValues, h, indexBuffer)
 		if err != nil {
 			return err
 		}
-		if err := fn(indexKey); err != nil {
+		if err := fn(index.Meta(), indexKey, distinct); err != nil {
 			return err
 		}
 		if len(indexKey) > len(indexBuffer) {
@@ -428,6 +428,9 @@ func (kvcodec *tableKVEncoder) Encode(
 			zap.Array("convertedRow", RowArrayMarshaler(record)),
 			log.ShortError(err),
 		)
+		// the row may be skipped by max-error, so discard the KV pairs written
+		// before the failure, otherwise they're taken with the next row.
+		kvcodec.se.takeKvPairs().Clear()
 		return nil, errors.Trace(err)
 	}
 	kvPairs := kvcodec.se.takeKvPairs()
@@ -479,7 +482,9 @@ func (kvcodec *tableKVEncoder) getActualDatum(rowID int64, colIndex int, inputDa
 	col := cols[colIndex]
 
 	isBadNullValue := false
-	if inputDatum != nil {
+	// the values of the generated columns in the source are ignored, since
+	// they're always evaluated from the other columns.
+	if inputDatum != nil && !col.IsGenerated() {
 		value, err = table.CastValue(kvcodec.se, *inputDatum, col.ToInfo(), false, false)
 		if err != nil {
 			return value, err
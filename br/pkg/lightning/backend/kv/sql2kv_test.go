@@ -74,6 +74,21 @@ func (mockTable) AddRecord(ctx sessionctx.Context, r []types.Datum, opts ...tabl
 	return kv.IntHandle(-1), errors.New("mock error")
 }
 
+// partialFailTable writes the KV pairs of the first row and then fails.
+type partialFailTable struct {
+	table.Table
+	failed bool
+}
+
+func (t *partialFailTable) AddRecord(ctx sessionctx.Context, r []types.Datum, opts ...table.AddRecordOption) (recordID kv.Handle, err error) {
+	recordID, err = t.Table.AddRecord(ctx, r, opts...)
+	if err == nil && !t.failed {
+		t.failed = true
+		return nil, errors.New("mock partial error")
+	}
+	return recordID, err
+}
+
 func TestEncode(t *testing.T) {
 	c1 := &model.ColumnInfo{ID: 1, Name: model.NewCIStr("c1"), State: model.StatePublic, Offset: 0, FieldType: *types.NewFieldType(mysql.TypeTiny)}
 	cols := []*model.ColumnInfo{c1}
@@ -127,6 +142,24 @@ func TestEncode(t *testing.T) {
 	_, err = mockMode.Encode(logger, rowsWithPk2, 2, []int{0, 1}, "1.csv", 1234)
 	require.EqualError(t, err, "mock error")
 
+	// the KV pairs of a failed row aren't taken with the next row.
+	partialMode, err := lkv.NewTableKVEncoder(&partialFailTable{Table: tbl}, &lkv.SessionOptions{
+		SQLMode:   mysql.ModeStrictAllTables,
+		Timestamp: 1234567891,
+	}, nil, logger)
+	require.NoError(t, err)
+	_, err = partialMode.Encode(logger, rowsWithPk2, 2, []int{0, 1}, "1.csv", 1234)
+	require.EqualError(t, err, "mock partial error")
+	pairs, err = partialMode.Encode(logger, []types.Datum{types.NewIntDatum(1), types.NewStringDatum("3")}, 3, []int{0, 1}, "1.csv", 1234)
+	require.NoError(t, err)
+	require.Equal(t, pairs, lkv.MakeRowFromKvPairs([]common.KvPair{
+		{
+			Key:   []uint8{0x74, 0x80, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x1, 0x5f, 0x72, 0x80, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x3},
+			Val:   []uint8{0x8, 0x2, 0x8, 0x2},
+			RowID: 3,
+		},
+	}))
+
 	// Non-strict mode
 	noneMode, err := lkv.NewTableKVEncoder(tbl, &lkv.SessionOptions{
 		SQLMode:   mysql.ModeNone,
@@ -441,6 +474,38 @@ func mockTableInfo(t *testing.T, createSQL string) *model.TableInfo {
 	return info
 }
 
+func TestEncodeGeneratedColumns(t *testing.T) {
+	tblInfo := mockTableInfo(t, "create table t (a int primary key, b int as (a + 1) stored, c int as (b * 2) virtual unique);")
+	tbl, err := tables.TableFromMeta(lkv.NewPanickingAllocators(0), tblInfo)
+	require.NoError(t, err)
+
+	logger := log.Logger{Logger: zap.NewNop()}
+	encoder, err := lkv.NewTableKVEncoder(tbl, &lkv.SessionOptions{
+		SQLMode: mysql.ModeStrictAllTables,
+		SysVars: map[string]string{
+			"tidb_row_format_version": "2",
+		},
+	}, nil, log.L())
+	require.NoError(t, err)
+
+	pairsExpect, err := encoder.Encode(logger, []types.Datum{
+		types.NewStringDatum("1"),
+	}, 1, []int{0, -1, -1}, "1.csv", 1234)
+	require.NoError(t, err)
+	// the row and the unique index on the virtual generated column.
+	require.Len(t, lkv.KvPairsFromRow(pairsExpect), 2)
+
+	// the values of the generated columns in the source are ignored, even if
+	// they can't be casted to the column types.
+	pairs, err := encoder.Encode(logger, []types.Datum{
+		types.NewStringDatum("1"),
+		types.NewStringDatum("not a number"),
+		types.NewIntDatum(100),
+	}, 1, []int{0, 1, 2}, "1.csv", 1234)
+	require.NoError(t, err)
+	require.Equal(t, pairsExpect, pairs)
+}
+
 func TestDefaultAutoRandoms(t *testing.T) {
 	tblInfo := mockTableInfo(t, "create table t (id bigint unsigned NOT NULL auto_random primary key clustered, a varchar(100));")
 	tbl, err := tables.TableFromMeta(lkv.NewPanickingAllocators(0), tblInfo)
@@ -132,6 +132,7 @@ type Engine struct {
 
 	keyAdapter         KeyAdapter
 	duplicateDetection bool
+	dupDetectOpt       dupDetectOpt
 	duplicateDB        *pebble.DB
 	errorMgr           *errormanager.ErrorManager
 
@@ -981,7 +982,7 @@ func (e *Engine) newKVIter(ctx context.Context, opts *pebble.IterOptions) Iter {
 		zap.String("table", common.UniqueTable(e.tableInfo.DB, e.tableInfo.Name)),
 		zap.Int64("tableID", e.tableInfo.ID),
 		zap.Stringer("engineUUID", e.UUID))
-	return newDupDetectIter(ctx, e.db, e.keyAdapter, opts, e.duplicateDB, logger)
+	return newDupDetectIter(ctx, e.db, e.keyAdapter, opts, e.duplicateDB, logger, e.dupDetectOpt)
 }
 
 type sstMeta struct {
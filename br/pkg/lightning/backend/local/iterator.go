@@ -69,6 +69,13 @@ var _ Iter = pebbleIter{}
 
 const maxDuplicateBatchSize = 4 << 20
 
+// dupDetectOpt is the option of detecting the duplicates in the local engines.
+type dupDetectOpt struct {
+	// keepLast is whether to ingest the last one of the duplicated keys in the
+	// order of row IDs rather than the first one.
+	keepLast bool
+}
+
 type dupDetectIter struct {
 	ctx       context.Context
 	iter      *pebble.Iterator
@@ -76,43 +83,71 @@ type dupDetectIter struct {
 	curRawKey []byte
 	curVal    []byte
 	nextKey   []byte
-	err       error
+	// valid is whether the current key is valid. The underlying iterator is
+	// positioned after the duplicates of the current key.
+	valid bool
+	err   error
 
 	keyAdapter     KeyAdapter
 	writeBatch     *pebble.Batch
 	writeBatchSize int64
 	logger         log.Logger
+	opt            dupDetectOpt
 }
 
 func (d *dupDetectIter) Seek(key []byte) bool {
 	rawKey := d.keyAdapter.Encode(nil, key, 0)
 	if d.err != nil || !d.iter.SeekGE(rawKey) {
+		d.valid = false
 		return false
 	}
 	d.fill()
-	return d.err == nil
+	return d.valid
 }
 
 func (d *dupDetectIter) First() bool {
 	if d.err != nil || !d.iter.First() {
+		d.valid = false
 		return false
 	}
 	d.fill()
-	return d.err == nil
+	return d.valid
 }
 
 func (d *dupDetectIter) Last() bool {
 	if d.err != nil || !d.iter.Last() {
+		d.valid = false
 		return false
 	}
 	d.fill()
-	return d.err == nil
+	return d.valid
 }
 
+// fill reads the key at the position of the underlying iterator, and records
+// the duplicates of it following the key.
 func (d *dupDetectIter) fill() {
 	d.curKey, d.err = d.keyAdapter.Decode(d.curKey[:0], d.iter.Key())
 	d.curRawKey = append(d.curRawKey[:0], d.iter.Key()...)
 	d.curVal = append(d.curVal[:0], d.iter.Value()...)
+	recordFirst := false
+	for d.err == nil && d.ctx.Err() == nil && d.iter.Next() {
+		d.nextKey, d.err = d.keyAdapter.Decode(d.nextKey[:0], d.iter.Key())
+		if d.err != nil || !bytes.Equal(d.nextKey, d.curKey) {
+			break
+		}
+		if !recordFirst {
+			d.record(d.curRawKey, d.curKey, d.curVal)
+			recordFirst = true
+		}
+		d.record(d.iter.Key(), d.nextKey, d.iter.Value())
+		if d.opt.keepLast {
+			d.curVal = append(d.curVal[:0], d.iter.Value()...)
+		}
+	}
+	if d.err == nil {
+		d.err = d.ctx.Err()
+	}
+	d.valid = d.err == nil
 }
 
 func (d *dupDetectIter) flush() {
@@ -137,28 +172,12 @@ func (d *dupDetectIter) record(rawKey, key, val []byte) {
 }
 
 func (d *dupDetectIter) Next() bool {
-	recordFirst := false
-	for d.err == nil && d.ctx.Err() == nil && d.iter.Next() {
-		d.nextKey, d.err = d.keyAdapter.Decode(d.nextKey[:0], d.iter.Key())
-		if d.err != nil {
-			return false
-		}
-		if !bytes.Equal(d.nextKey, d.curKey) {
-			d.curKey, d.nextKey = d.nextKey, d.curKey[:0]
-			d.curRawKey = append(d.curRawKey[:0], d.iter.Key()...)
-			d.curVal = append(d.curVal[:0], d.iter.Value()...)
-			return true
-		}
-		if !recordFirst {
-			d.record(d.curRawKey, d.curKey, d.curVal)
-			recordFirst = true
-		}
-		d.record(d.iter.Key(), d.nextKey, d.iter.Value())
-	}
-	if d.err == nil {
-		d.err = d.ctx.Err()
+	if d.err != nil || !d.valid || !d.iter.Valid() {
+		d.valid = false
+		return false
 	}
-	return false
+	d.fill()
+	return d.valid
 }
 
 func (d *dupDetectIter) Key() []byte {
@@ -170,7 +189,7 @@ func (d *dupDetectIter) Value() []byte {
 }
 
 func (d *dupDetectIter) Valid() bool {
-	return d.err == nil && d.iter.Valid()
+	return d.err == nil && d.valid
 }
 
 func (d *dupDetectIter) Error() error {
@@ -192,7 +211,7 @@ func (d *dupDetectIter) OpType() sst.Pair_OP {
 var _ Iter = &dupDetectIter{}
 
 func newDupDetectIter(ctx context.Context, db *pebble.DB, keyAdapter KeyAdapter,
-	opts *pebble.IterOptions, dupDB *pebble.DB, logger log.Logger) *dupDetectIter {
+	opts *pebble.IterOptions, dupDB *pebble.DB, logger log.Logger, opt dupDetectOpt) *dupDetectIter {
 	newOpts := &pebble.IterOptions{TableFilter: opts.TableFilter}
 	if len(opts.LowerBound) > 0 {
 		newOpts.LowerBound = keyAdapter.Encode(nil, opts.LowerBound, math.MinInt64)
@@ -206,6 +225,7 @@ func newDupDetectIter(ctx context.Context, db *pebble.DB, keyAdapter KeyAdapter,
 		keyAdapter: keyAdapter,
 		writeBatch: dupDB.NewBatch(),
 		logger:     logger,
+		opt:        opt,
 	}
 }
 
@@ -36,6 +36,7 @@ import (
 	sst "github.com/pingcap/kvproto/pkg/import_sstpb"
 	"github.com/pingcap/kvproto/pkg/kvrpcpb"
 	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/tidb/br/pkg/importclient"
 	"github.com/pingcap/tidb/br/pkg/lightning/backend"
 	"github.com/pingcap/tidb/br/pkg/lightning/backend/kv"
 	"github.com/pingcap/tidb/br/pkg/lightning/common"
@@ -54,6 +55,7 @@ import (
 	"github.com/pingcap/tidb/br/pkg/utils"
 	"github.com/pingcap/tidb/br/pkg/version"
 	"github.com/pingcap/tidb/infoschema"
+	tidbkv "github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/table"
@@ -71,10 +73,7 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/time/rate"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/backoff"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/status"
 )
@@ -127,74 +126,31 @@ type ImportClientFactory interface {
 }
 
 type importClientFactoryImpl struct {
-	conns          *common.GRPCConns
-	splitCli       split.SplitClient
-	tls            *common.TLS
-	tcpConcurrency int
+	client *importclient.Client
 }
 
 func newImportClientFactoryImpl(splitCli split.SplitClient, tls *common.TLS, tcpConcurrency int) *importClientFactoryImpl {
 	return &importClientFactoryImpl{
-		conns:          common.NewGRPCConns(),
-		splitCli:       splitCli,
-		tls:            tls,
-		tcpConcurrency: tcpConcurrency,
-	}
-}
-
-func (f *importClientFactoryImpl) makeConn(ctx context.Context, storeID uint64) (*grpc.ClientConn, error) {
-	store, err := f.splitCli.GetStore(ctx, storeID)
-	if err != nil {
-		return nil, errors.Trace(err)
-	}
-	opt := grpc.WithInsecure()
-	if f.tls.TLSConfig() != nil {
-		opt = grpc.WithTransportCredentials(credentials.NewTLS(f.tls.TLSConfig()))
-	}
-	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
-
-	bfConf := backoff.DefaultConfig
-	bfConf.MaxDelay = gRPCBackOffMaxDelay
-	// we should use peer address for tiflash. for tikv, peer address is empty
-	addr := store.GetPeerAddress()
-	if addr == "" {
-		addr = store.GetAddress()
-	}
-	conn, err := grpc.DialContext(
-		ctx,
-		addr,
-		opt,
-		grpc.WithConnectParams(grpc.ConnectParams{Backoff: bfConf}),
-		grpc.WithKeepaliveParams(keepalive.ClientParameters{
-			Time:                gRPCKeepAliveTime,
-			Timeout:             gRPCKeepAliveTimeout,
-			PermitWithoutStream: true,
+		client: importclient.New(splitCli, importclient.Config{
+			TLSConfig: tls.TLSConfig(),
+			Keepalive: keepalive.ClientParameters{
+				Time:                gRPCKeepAliveTime,
+				Timeout:             gRPCKeepAliveTimeout,
+				PermitWithoutStream: true,
+			},
+			ConnCount:       tcpConcurrency,
+			DialTimeout:     dialTimeout,
+			BackOffMaxDelay: gRPCBackOffMaxDelay,
 		}),
-	)
-	cancel()
-	if err != nil {
-		return nil, errors.Trace(err)
 	}
-	return conn, nil
-}
-
-func (f *importClientFactoryImpl) getGrpcConn(ctx context.Context, storeID uint64) (*grpc.ClientConn, error) {
-	return f.conns.GetGrpcConn(ctx, storeID, f.tcpConcurrency,
-		func(ctx context.Context) (*grpc.ClientConn, error) {
-			return f.makeConn(ctx, storeID)
-		})
 }
 
 func (f *importClientFactoryImpl) Create(ctx context.Context, storeID uint64) (sst.ImportSSTClient, error) {
-	conn, err := f.getGrpcConn(ctx, storeID)
-	if err != nil {
-		return nil, err
-	}
-	return sst.NewImportSSTClient(conn), nil
+	return f.client.GetImportClient(ctx, storeID)
 }
 
 func (f *importClientFactoryImpl) Close() {
-	f.conns.Close()
+	f.client.Close()
 }
 
 // Range record start and end key for localStoreDir.DB
@@ -363,6 +319,7 @@ type local struct {
 
 	checkTiKVAvaliable  bool
 	duplicateDetection  bool
+	dupDetectOpt        dupDetectOpt
 	duplicateDB         *pebble.DB
 	keyAdapter          KeyAdapter
 	errorMgr            *errormanager.ErrorManager
@@ -371,7 +328,11 @@ type local struct {
 	bufferPool   *membuf.Pool
 	metrics      *metric.Metrics
 	writeLimiter StoreWriteLimiter
-	logger       log.Logger
+	throttler    *ingestThrottler
+	// stopThrottler stops adjusting the throttler, it's nil if the throttler
+	// isn't enabled.
+	stopThrottler context.CancelFunc
+	logger        log.Logger
 
 	encBuilder       backend.EncodingBuilder
 	targetInfoGetter backend.TargetInfoGetter
@@ -475,6 +436,7 @@ func NewLocalBackend(
 		engineMemCacheSize:      int(cfg.TikvImporter.EngineMemCacheSize),
 		localWriterMemCacheSize: int64(cfg.TikvImporter.LocalWriterMemCacheSize),
 		duplicateDetection:      duplicateDetection,
+		dupDetectOpt:            dupDetectOpt{keepLast: cfg.TikvImporter.DuplicateResolution == config.DupeResAlgReplace},
 		checkTiKVAvaliable:      cfg.App.CheckRequirements,
 		duplicateDB:             duplicateDB,
 		keyAdapter:              keyAdapter,
@@ -492,6 +454,13 @@ func NewLocalBackend(
 	if err = local.checkMultiIngestSupport(ctx); err != nil {
 		return backend.MakeBackend(nil), common.ErrCheckMultiIngest.Wrap(err).GenWithStackByArgs()
 	}
+	if throttleCfg := cfg.TikvImporter.Throttle; throttleCfg.Enable {
+		getter := &pdStoreStatsGetter{pdCtl: pdCtl, tls: tls, fetchCPU: throttleCfg.MaxStoreCPUUsage > 0}
+		local.throttler = newIngestThrottler(throttleCfg, rangeConcurrency, getter, local.logger)
+		var throttleCtx context.Context
+		throttleCtx, local.stopThrottler = context.WithCancel(ctx)
+		go local.throttler.run(throttleCtx)
+	}
 
 	return backend.MakeBackend(local), nil
 }
@@ -627,6 +596,9 @@ func (local *local) lockAllEnginesUnless(newState, ignoreStateMask importMutexSt
 
 // Close the local backend.
 func (local *local) Close() {
+	if local.stopThrottler != nil {
+		local.stopThrottler()
+	}
 	allEngines := local.lockAllEnginesUnless(importMutexStateClose, 0)
 	local.engines = sync.Map{}
 
@@ -778,6 +750,7 @@ func (local *local) OpenEngine(ctx context.Context, cfg *backend.EngineConfig, e
 		config:             engineCfg,
 		tableInfo:          cfg.TableInfo,
 		duplicateDetection: local.duplicateDetection,
+		dupDetectOpt:       local.dupDetectOpt,
 		duplicateDB:        local.duplicateDB,
 		errorMgr:           local.errorMgr,
 		keyAdapter:         local.keyAdapter,
@@ -828,6 +801,7 @@ func (local *local) CloseEngine(ctx context.Context, cfg *backend.EngineConfig,
 			tableInfo:          cfg.TableInfo,
 			keyAdapter:         local.keyAdapter,
 			duplicateDetection: local.duplicateDetection,
+			dupDetectOpt:       local.dupDetectOpt,
 			duplicateDB:        local.duplicateDB,
 			errorMgr:           local.errorMgr,
 			logger:             log.FromContext(ctx),
@@ -1101,25 +1075,28 @@ func (local *local) WriteToTiKV(
 }
 
 func (local *local) Ingest(ctx context.Context, metas []*sst.SSTMeta, region *split.RegionInfo) (*sst.IngestResponse, error) {
-	leader := region.Leader
-	if leader == nil {
-		leader = region.Region.GetPeers()[0]
+	if !local.supportMultiIngest && len(metas) != 1 {
+		return nil, errors.New("batch ingest is not support")
 	}
 
-	cli, err := local.getImportClient(ctx, leader.StoreId)
+	return importclient.IngestToLeader(ctx, local.splitCli, region,
+		func(ctx context.Context, region *split.RegionInfo, peer *metapb.Peer) (*sst.IngestResponse, error) {
+			return local.ingestToPeer(ctx, metas, region, peer)
+		})
+}
+
+func (local *local) ingestToPeer(ctx context.Context, metas []*sst.SSTMeta, region *split.RegionInfo, peer *metapb.Peer) (*sst.IngestResponse, error) {
+	cli, err := local.getImportClient(ctx, peer.GetStoreId())
 	if err != nil {
 		return nil, err
 	}
 	reqCtx := &kvrpcpb.Context{
 		RegionId:    region.Region.GetId(),
 		RegionEpoch: region.Region.GetRegionEpoch(),
-		Peer:        leader,
+		Peer:        peer,
 	}
 
 	if !local.supportMultiIngest {
-		if len(metas) != 1 {
-			return nil, errors.New("batch ingest is not support")
-		}
 		req := &sst.IngestRequest{
 			Context: reqCtx,
 			Sst:     metas[0],
@@ -1287,9 +1264,13 @@ WriteAndIngest:
 				zap.Stringer("epoch", region.Region.GetRegionEpoch()), zap.Binary("start", region.Region.GetStartKey()),
 				zap.Binary("end", region.Region.GetEndKey()), zap.Reflect("peers", region.Region.GetPeers()))
 
+			if err = local.throttler.acquire(ctx); err != nil {
+				return err
+			}
 			w := local.ingestConcurrency.Apply()
 			err = local.writeAndIngestPairs(ctx, engine, region, pairStart, end, regionSplitSize, regionSplitKeys)
 			local.ingestConcurrency.Recycle(w)
+			local.throttler.release()
 			if err != nil {
 				if !local.isRetryableImportTiKVError(err) {
 					return err
@@ -1402,7 +1383,11 @@ loopWrite:
 					}
 				})
 				if resp == nil {
+					ingestStart := time.Now()
 					resp, err = local.Ingest(ctx, ingestMetas, region)
+					if err == nil {
+						local.throttler.observeIngest(time.Since(ingestStart), resp.GetError().GetServerIsBusy() != nil)
+					}
 				}
 				if err != nil {
 					if common.IsContextCanceledError(err) {
@@ -1667,11 +1652,18 @@ func (local *local) ResolveDuplicateRows(ctx context.Context, tbl table.Table, t
 		logger.End(zap.ErrorLevel, err)
 	}()
 
+	resolve := local.deleteDuplicateRows
 	switch algorithm {
 	case config.DupeResAlgRecord, config.DupeResAlgNone:
 		logger.Warn("[resolve-dupe] skipping resolution due to selected algorithm. this table will become inconsistent!", zap.Stringer("algorithm", algorithm))
 		return nil
+	case config.DupeResAlgErr:
+		// the import of the table fails once any duplicates are detected, and they're
+		// removed by the 'remove' algorithm before failing.
+		return nil
 	case config.DupeResAlgRemove:
+	case config.DupeResAlgReplace, config.DupeResAlgIgnore:
+		resolve = local.replaceDuplicateRows
 	default:
 		panic(fmt.Sprintf("[resolve-dupe] unknown resolution algorithm %v", algorithm))
 	}
@@ -1690,7 +1682,7 @@ func (local *local) ResolveDuplicateRows(ctx context.Context, tbl table.Table, t
 		ctx, tableName, pool,
 		func(ctx context.Context, handleRows [][2][]byte) error {
 			for {
-				err := local.deleteDuplicateRows(ctx, logger, handleRows, decoder)
+				err := resolve(ctx, logger, handleRows, decoder)
 				if err == nil {
 					return nil
 				}
@@ -1761,6 +1753,137 @@ func (local *local) deleteDuplicateRows(ctx context.Context, logger *log.Task, h
 	return nil
 }
 
+// replaceDuplicateRows keeps one of the duplicated rows, and deletes the KV pairs of the others. The row kept is the
+// one visible in TiKV, i.e. the one ingested last, or the one chosen by the duplicate detection of the local engine.
+// The row is deleted if it loses a unique index to another row, and the index keys left by the replaced version of a
+// row are deleted too.
+func (local *local) replaceDuplicateRows(ctx context.Context, logger *log.Task, handleRows [][2][]byte, decoder *kv.TableKVDecoder) (err error) {
+	txn, err := local.tikvCli.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = txn.Commit(ctx)
+		} else {
+			if rollbackErr := txn.Rollback(); rollbackErr != nil {
+				logger.Warn("failed to rollback transaction", zap.Error(rollbackErr))
+			}
+		}
+	}()
+
+	getValue := func(key []byte) ([]byte, error) {
+		val, err := txn.Get(ctx, key)
+		if tikverror.IsErrNotFound(err) {
+			return nil, nil
+		}
+		return val, err
+	}
+	// ownIndexKey checks whether the index key in TiKV belongs to the handle.
+	ownIndexKey := func(handle tidbkv.Handle, indexInfo *model.IndexInfo, key []byte, distinct bool) (bool, error) {
+		val, err := getValue(key)
+		if err != nil || val == nil {
+			return false, err
+		}
+		if !distinct {
+			return true, nil
+		}
+		h, err := decoder.DecodeHandleFromIndex(indexInfo, key, val)
+		if err != nil {
+			return false, err
+		}
+		return h.Equal(handle), nil
+	}
+	deleteKey := func(key []byte) error {
+		logger.Debug("[resolve-dupe] will delete key", logutil.Key("key", key))
+		return txn.Delete(key)
+	}
+
+	for _, handleRow := range handleRows {
+		logger.Debug("[resolve-dupe] found row to resolve",
+			logutil.Key("handle", handleRow[0]),
+			logutil.Key("row", handleRow[1]))
+
+		handle, err := decoder.DecodeHandleFromRowKey(handleRow[0])
+		if err != nil {
+			return err
+		}
+		keptRow, err := getValue(handleRow[0])
+		if err != nil {
+			return err
+		}
+		if keptRow == nil {
+			// the row has been deleted as it loses a unique index.
+			continue
+		}
+
+		if !bytes.Equal(keptRow, handleRow[1]) {
+			// the row is replaced by another version of it, delete the index keys only used by the replaced version.
+			keptKeys := make(map[string]struct{})
+			if err := decoder.IterRawIndexKeys(handle, keptRow, func(key []byte) error {
+				keptKeys[string(key)] = struct{}{}
+				return nil
+			}); err != nil {
+				return err
+			}
+			err = decoder.IterRawIndexKeysWithInfo(handle, handleRow[1], func(indexInfo *model.IndexInfo, key []byte, distinct bool) error {
+				if _, ok := keptKeys[string(key)]; ok {
+					return nil
+				}
+				own, err := ownIndexKey(handle, indexInfo, key, distinct)
+				if err != nil || !own {
+					return err
+				}
+				return deleteKey(key)
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		// the row is kept for its handle, but it's deleted if any of its unique indexes belongs to another row.
+		lost := false
+		err = decoder.IterRawIndexKeysWithInfo(handle, handleRow[1], func(indexInfo *model.IndexInfo, key []byte, distinct bool) error {
+			if lost || !distinct {
+				return nil
+			}
+			val, err := getValue(key)
+			if err != nil || val == nil {
+				return err
+			}
+			h, err := decoder.DecodeHandleFromIndex(indexInfo, key, val)
+			if err != nil {
+				return err
+			}
+			lost = !h.Equal(handle)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if !lost {
+			continue
+		}
+		if err := deleteKey(handleRow[0]); err != nil {
+			return err
+		}
+		err = decoder.IterRawIndexKeysWithInfo(handle, handleRow[1], func(indexInfo *model.IndexInfo, key []byte, distinct bool) error {
+			own, err := ownIndexKey(handle, indexInfo, key, distinct)
+			if err != nil || !own {
+				return err
+			}
+			return deleteKey(key)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	logger.Debug("[resolve-dupe] number of KV pairs to be deleted", zap.Int("count", txn.Len()))
+	return nil
+}
+
 func (local *local) ResetEngine(ctx context.Context, engineUUID uuid.UUID) error {
 	// the only way to reset the engine + reclaim the space is to delete and reopen it 🤷
 	localEngine := local.lockEngine(engineUUID, importMutexStateClose)
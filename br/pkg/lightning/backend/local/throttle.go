@@ -0,0 +1,317 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/common"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/lightning/log"
+	"github.com/pingcap/tidb/br/pkg/pdutil"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/util/engine"
+	pd "github.com/tikv/pd/client"
+	"go.uber.org/zap"
+)
+
+const (
+	// metricCPUSeconds and metricCPUQuota are the metrics of the TiKV status
+	// port to compute the CPU usage of the store.
+	metricCPUSeconds = "process_cpu_seconds_total"
+	metricCPUQuota   = "tikv_server_cpu_cores_quota"
+)
+
+// storeStats is the state of a TiKV store used to decide whether the cluster
+// is degraded.
+type storeStats struct {
+	leaderCount int
+	busy        bool
+	// cpuSeconds is the total CPU time used by the store and cpuQuota is the
+	// number of the CPU cores it can use, they're 0 if unknown.
+	cpuSeconds float64
+	cpuQuota   float64
+}
+
+type storeStatsGetter interface {
+	GetStoreStats(ctx context.Context) (map[uint64]storeStats, error)
+}
+
+// pdStoreStatsGetter gets the leader count and the busy state of the stores
+// from PD, and the CPU usage from the status port of the stores.
+type pdStoreStatsGetter struct {
+	pdCtl *pdutil.PdController
+	tls   *common.TLS
+	// fetchCPU is false if the CPU usage is ignored, so the metrics of the
+	// stores aren't fetched.
+	fetchCPU bool
+}
+
+func (g *pdStoreStatsGetter) GetStoreStats(ctx context.Context) (map[uint64]storeStats, error) {
+	stores, err := g.pdCtl.GetPDClient().GetAllStores(ctx, pd.WithExcludeTombstone())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	stats := make(map[uint64]storeStats, len(stores))
+	for _, store := range stores {
+		if engine.IsTiFlash(store) {
+			continue
+		}
+		info, err := g.pdCtl.GetStoreInfo(ctx, store.GetId())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		var s storeStats
+		if info.Status != nil {
+			s.leaderCount = info.Status.LeaderCount
+			s.busy = info.Status.IsBusy
+		}
+		if g.fetchCPU && store.StatusAddress != "" {
+			serverInfo := infoschema.ServerInfo{
+				Address:    store.Address,
+				StatusAddr: store.StatusAddress,
+			}
+			serverInfo.ResolveLoopBackAddr()
+			body, err := g.tls.WithHost(serverInfo.StatusAddr).GetBody(ctx, "/metrics")
+			if err != nil {
+				log.FromContext(ctx).Warn("get the metrics of store failed", zap.Uint64("store", store.GetId()),
+					zap.String("address", serverInfo.StatusAddr), log.ShortError(err))
+			} else {
+				s.cpuSeconds, s.cpuQuota = parseStoreCPUMetrics(body)
+			}
+		}
+		stats[store.GetId()] = s
+	}
+	return stats, nil
+}
+
+// parseStoreCPUMetrics reads the CPU metrics from the metrics of the TiKV
+// status port in the Prometheus text format.
+func parseStoreCPUMetrics(body []byte) (cpuSeconds, cpuQuota float64) {
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		fields := bytes.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		var target *float64
+		switch string(fields[0]) {
+		case metricCPUSeconds:
+			target = &cpuSeconds
+		case metricCPUQuota:
+			target = &cpuQuota
+		default:
+			continue
+		}
+		if v, err := strconv.ParseFloat(string(fields[1]), 64); err == nil {
+			*target = v
+		}
+	}
+	return cpuSeconds, cpuQuota
+}
+
+// ingestThrottler limits the number of the regions written and ingested
+// concurrently by the local backend, so that the ingestion slows down when the
+// target cluster degrades. The limit is adjusted every check interval: it's
+// halved if the cluster is degraded, which pauses the ingestion if it's already
+// 1, and it's increased by 1 once the cluster is healthy again.
+//
+// A nil *ingestThrottler never throttles.
+type ingestThrottler struct {
+	cfg      config.IngestThrottle
+	maxLimit int
+	getter   storeStatsGetter
+	logger   log.Logger
+
+	mu       sync.Mutex
+	limit    int
+	inflight int
+	// wakeCh is closed and replaced to wake up the waiters when a slot may be
+	// available.
+	wakeCh chan struct{}
+
+	// the ingest requests since the last check.
+	ingestCount   int
+	ingestLatency time.Duration
+	busyCount     int
+
+	lastStats map[uint64]storeStats
+	lastCheck time.Time
+}
+
+func newIngestThrottler(cfg config.IngestThrottle, maxLimit int, getter storeStatsGetter, logger log.Logger) *ingestThrottler {
+	return &ingestThrottler{
+		cfg:      cfg,
+		maxLimit: maxLimit,
+		getter:   getter,
+		logger:   logger,
+		limit:    maxLimit,
+		wakeCh:   make(chan struct{}),
+	}
+}
+
+// acquire waits until the region can be written and ingested.
+func (t *ingestThrottler) acquire(ctx context.Context) error {
+	if t == nil {
+		return nil
+	}
+	for {
+		t.mu.Lock()
+		if t.inflight < t.limit {
+			t.inflight++
+			t.mu.Unlock()
+			return nil
+		}
+		wakeCh := t.wakeCh
+		t.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-wakeCh:
+		}
+	}
+}
+
+func (t *ingestThrottler) release() {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	t.inflight--
+	t.wakeLocked()
+	t.mu.Unlock()
+}
+
+func (t *ingestThrottler) wakeLocked() {
+	close(t.wakeCh)
+	t.wakeCh = make(chan struct{})
+}
+
+// observeIngest records the latency of an ingest request, and whether it's
+// rejected since the store is busy.
+func (t *ingestThrottler) observeIngest(latency time.Duration, busy bool) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	t.ingestCount++
+	t.ingestLatency += latency
+	if busy {
+		t.busyCount++
+	}
+	t.mu.Unlock()
+}
+
+// currentLimit returns the number of the regions which can be written and
+// ingested concurrently, 0 means the ingestion is paused.
+func (t *ingestThrottler) currentLimit() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.limit
+}
+
+func (t *ingestThrottler) run(ctx context.Context) {
+	ticker := time.NewTicker(t.cfg.CheckInterval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.check(ctx)
+		}
+	}
+}
+
+// check adjusts the limit by the state of the cluster since the last check.
+func (t *ingestThrottler) check(ctx context.Context) {
+	now := time.Now()
+	stats, err := t.getter.GetStoreStats(ctx)
+	if err != nil {
+		// only the ingest requests are checked in this round.
+		t.logger.Warn("get the stats of stores failed", log.ShortError(err))
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	reason := t.degradedReasonLocked(stats, now.Sub(t.lastCheck))
+	t.ingestCount, t.ingestLatency, t.busyCount = 0, 0, 0
+	if stats != nil {
+		t.lastStats, t.lastCheck = stats, now
+	}
+
+	oldLimit := t.limit
+	if reason != "" {
+		t.limit /= 2
+	} else if t.limit < t.maxLimit {
+		t.limit++
+		t.wakeLocked()
+	}
+	switch {
+	case t.limit == 0:
+		t.logger.Warn("the target cluster is degraded, pause the ingestion", zap.String("reason", reason))
+	case t.limit != oldLimit:
+		t.logger.Info("adjust the ingest concurrency", zap.Int("from", oldLimit), zap.Int("to", t.limit),
+			zap.String("reason", reason))
+	}
+}
+
+// degradedReasonLocked returns why the cluster is degraded, or "" if it's
+// healthy.
+func (t *ingestThrottler) degradedReasonLocked(stats map[uint64]storeStats, elapsed time.Duration) string {
+	if t.busyCount > 0 {
+		return fmt.Sprintf("%d ingest requests are rejected as server is busy", t.busyCount)
+	}
+	if maxLatency := t.cfg.MaxIngestLatency.Duration; maxLatency > 0 && t.ingestCount > 0 {
+		if latency := t.ingestLatency / time.Duration(t.ingestCount); latency > maxLatency {
+			return fmt.Sprintf("the average ingest latency %s exceeds %s", latency, maxLatency)
+		}
+	}
+	for storeID, s := range stats {
+		if s.busy {
+			return fmt.Sprintf("store %d is busy", storeID)
+		}
+		last, ok := t.lastStats[storeID]
+		if !ok {
+			continue
+		}
+		if maxRatio := t.cfg.MaxLeaderDropRatio; maxRatio > 0 && last.leaderCount > 0 && s.leaderCount < last.leaderCount {
+			if ratio := float64(last.leaderCount-s.leaderCount) / float64(last.leaderCount); ratio > maxRatio {
+				return fmt.Sprintf("store %d drops %.0f%% of the leaders", storeID, ratio*100)
+			}
+		}
+		// the CPU time is reset if the store restarts.
+		if maxUsage := t.cfg.MaxStoreCPUUsage; maxUsage > 0 && s.cpuQuota > 0 && last.cpuSeconds > 0 &&
+			s.cpuSeconds >= last.cpuSeconds && elapsed > 0 {
+			if usage := (s.cpuSeconds - last.cpuSeconds) / elapsed.Seconds() / s.cpuQuota; usage > maxUsage {
+				return fmt.Sprintf("the CPU usage %.0f%% of store %d exceeds %.0f%%", usage*100, storeID, maxUsage*100)
+			}
+		}
+	}
+	return ""
+}
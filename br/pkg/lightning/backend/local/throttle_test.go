@@ -0,0 +1,153 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/lightning/log"
+	"github.com/stretchr/testify/require"
+)
+
+type mockStoreStatsGetter struct {
+	stats map[uint64]storeStats
+	err   error
+}
+
+func (g *mockStoreStatsGetter) GetStoreStats(context.Context) (map[uint64]storeStats, error) {
+	if g.err != nil {
+		return nil, g.err
+	}
+	return g.stats, nil
+}
+
+func TestParseStoreCPUMetrics(t *testing.T) {
+	body := []byte(`# HELP process_cpu_seconds_total Total user and system CPU time spent in seconds.
+# TYPE process_cpu_seconds_total counter
+process_cpu_seconds_total 1234.5
+process_cpu_seconds_total_other 1
+tikv_server_cpu_cores_quota 8
+`)
+	cpuSeconds, cpuQuota := parseStoreCPUMetrics(body)
+	require.Equal(t, 1234.5, cpuSeconds)
+	require.Equal(t, 8.0, cpuQuota)
+
+	cpuSeconds, cpuQuota = parseStoreCPUMetrics([]byte("tikv_server_cpu_cores_quota abc\n"))
+	require.Zero(t, cpuSeconds)
+	require.Zero(t, cpuQuota)
+}
+
+func TestIngestThrottler(t *testing.T) {
+	cfg := config.IngestThrottle{
+		Enable:             true,
+		CheckInterval:      config.Duration{Duration: time.Second},
+		MaxIngestLatency:   config.Duration{Duration: time.Second},
+		MaxStoreCPUUsage:   0.8,
+		MaxLeaderDropRatio: 0.1,
+	}
+	getter := &mockStoreStatsGetter{stats: map[uint64]storeStats{
+		1: {leaderCount: 100, cpuSeconds: 100, cpuQuota: 4},
+	}}
+	throttler := newIngestThrottler(cfg, 4, getter, log.L())
+	ctx := context.Background()
+
+	// the limit can't exceed the max limit.
+	throttler.check(ctx)
+	require.Equal(t, 4, throttler.currentLimit())
+
+	// the average ingest latency is 1.5s.
+	throttler.observeIngest(time.Second, false)
+	throttler.observeIngest(2*time.Second, false)
+	throttler.check(ctx)
+	require.Equal(t, 2, throttler.currentLimit())
+	// the signals of the ingest requests are reset after the check.
+	throttler.check(ctx)
+	require.Equal(t, 3, throttler.currentLimit())
+
+	throttler.observeIngest(time.Millisecond, true)
+	throttler.check(ctx)
+	require.Equal(t, 1, throttler.currentLimit())
+
+	// 20% of the leaders are dropped, the ingestion is paused.
+	getter.stats = map[uint64]storeStats{1: {leaderCount: 80, cpuSeconds: 100, cpuQuota: 4}}
+	throttler.check(ctx)
+	require.Equal(t, 0, throttler.currentLimit())
+
+	getter.stats = map[uint64]storeStats{1: {leaderCount: 80, busy: true}}
+	throttler.check(ctx)
+	require.Equal(t, 0, throttler.currentLimit())
+
+	// the stores can't be got, so only the ingest requests are checked.
+	getter.err = errors.New("mock error")
+	throttler.check(ctx)
+	require.Equal(t, 1, throttler.currentLimit())
+	getter.err = nil
+
+	// the CPU usage is 100% of the quota.
+	throttler.lastStats = map[uint64]storeStats{1: {leaderCount: 80, cpuSeconds: 100, cpuQuota: 4}}
+	throttler.lastCheck = time.Now().Add(-10 * time.Second)
+	getter.stats = map[uint64]storeStats{1: {leaderCount: 80, cpuSeconds: 140, cpuQuota: 4}}
+	throttler.check(ctx)
+	require.Equal(t, 0, throttler.currentLimit())
+
+	// the CPU time is reset since the store restarts.
+	getter.stats = map[uint64]storeStats{1: {leaderCount: 80, cpuSeconds: 1, cpuQuota: 4}}
+	throttler.check(ctx)
+	require.Equal(t, 1, throttler.currentLimit())
+}
+
+func TestIngestThrottlerAcquire(t *testing.T) {
+	cfg := config.IngestThrottle{Enable: true, CheckInterval: config.Duration{Duration: time.Second}}
+	getter := &mockStoreStatsGetter{stats: map[uint64]storeStats{1: {busy: true}}}
+	throttler := newIngestThrottler(cfg, 2, getter, log.L())
+	ctx := context.Background()
+
+	require.NoError(t, throttler.acquire(ctx))
+	require.NoError(t, throttler.acquire(ctx))
+	ctx1, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	require.ErrorIs(t, throttler.acquire(ctx1), context.DeadlineExceeded)
+	cancel()
+
+	// the ingestion is paused, the released slot can't be acquired.
+	throttler.check(ctx)
+	throttler.check(ctx)
+	require.Equal(t, 0, throttler.currentLimit())
+	throttler.release()
+	throttler.release()
+
+	acquired := make(chan error)
+	go func() {
+		acquired <- throttler.acquire(ctx)
+	}()
+	select {
+	case <-acquired:
+		require.FailNow(t, "the ingestion should be paused")
+	case <-time.After(10 * time.Millisecond):
+	}
+	getter.stats = map[uint64]storeStats{1: {}}
+	throttler.check(ctx)
+	require.NoError(t, <-acquired)
+	throttler.release()
+
+	// a nil throttler never throttles.
+	var nilThrottler *ingestThrottler
+	require.NoError(t, nilThrottler.acquire(ctx))
+	nilThrottler.observeIngest(time.Hour, true)
+	nilThrottler.release()
+}
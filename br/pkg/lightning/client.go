@@ -0,0 +1,140 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lightning
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/common"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/lightning/restore"
+	"github.com/pingcap/tidb/br/pkg/lightning/web"
+)
+
+// Client is a client of the HTTP API of Lightning, which controls the tables,
+// the engines and the concurrency of the running task.
+type Client struct {
+	tls *common.TLS
+}
+
+// NewClient creates a Client connecting to the status address of Lightning.
+// The security config is the `[security]` section of Lightning, it can be nil
+// if TLS isn't enabled.
+func NewClient(statusAddr string, security *config.Security) (*Client, error) {
+	var caPath, certPath, keyPath string
+	if security != nil {
+		caPath, certPath, keyPath = security.CAPath, security.CertPath, security.KeyPath
+	}
+	tls, err := common.NewTLS(caPath, certPath, keyPath, statusAddr)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &Client{tls: tls}, nil
+}
+
+// Tables gets the control status of the tables of the running task.
+func (c *Client) Tables(ctx context.Context) ([]restore.TableControlStatus, error) {
+	var result []restore.TableControlStatus
+	if err := c.tls.GetJSON(ctx, "/control/tables", &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return result, nil
+}
+
+func (c *Client) controlTable(ctx context.Context, schemaName, tableName string, op string) error {
+	query := url.Values{}
+	query.Set("t", common.UniqueTable(schemaName, tableName))
+	query.Set("op", op)
+	return c.tls.RequestJSON(ctx, http.MethodPut, "/control/table?"+query.Encode(), nil, nil)
+}
+
+// PauseTable pauses importing the table.
+func (c *Client) PauseTable(ctx context.Context, schemaName, tableName string) error {
+	return c.controlTable(ctx, schemaName, tableName, controlOpPause)
+}
+
+// ResumeTable resumes importing the table paused by PauseTable.
+func (c *Client) ResumeTable(ctx context.Context, schemaName, tableName string) error {
+	return c.controlTable(ctx, schemaName, tableName, controlOpResume)
+}
+
+// AbortTable aborts importing the table, the other tables are not affected.
+func (c *Client) AbortTable(ctx context.Context, schemaName, tableName string) error {
+	return c.controlTable(ctx, schemaName, tableName, controlOpAbort)
+}
+
+func (c *Client) controlEngine(ctx context.Context, schemaName, tableName string, engineID int32, op string) error {
+	query := url.Values{}
+	query.Set("t", common.UniqueTable(schemaName, tableName))
+	query.Set("e", strconv.FormatInt(int64(engineID), 10))
+	query.Set("op", op)
+	return c.tls.RequestJSON(ctx, http.MethodPut, "/control/engine?"+query.Encode(), nil, nil)
+}
+
+// PauseEngine pauses restoring the engine of the table.
+func (c *Client) PauseEngine(ctx context.Context, schemaName, tableName string, engineID int32) error {
+	return c.controlEngine(ctx, schemaName, tableName, engineID, controlOpPause)
+}
+
+// ResumeEngine resumes restoring the engine paused by PauseEngine.
+func (c *Client) ResumeEngine(ctx context.Context, schemaName, tableName string, engineID int32) error {
+	return c.controlEngine(ctx, schemaName, tableName, engineID, controlOpResume)
+}
+
+// AbortEngine aborts restoring the engine, which fails the import of the table.
+func (c *Client) AbortEngine(ctx context.Context, schemaName, tableName string, engineID int32) error {
+	return c.controlEngine(ctx, schemaName, tableName, engineID, controlOpAbort)
+}
+
+// Concurrency gets the concurrency of the worker pools of the running task,
+// keyed by the pool names, e.g. restore.PoolRegion.
+func (c *Client) Concurrency(ctx context.Context) (map[string]int, error) {
+	var result map[string]int
+	if err := c.tls.GetJSON(ctx, "/control/concurrency", &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return result, nil
+}
+
+// SetConcurrency adjusts the concurrency of the worker pools of the running
+// task, the pools not in `concurrency` are unchanged.
+func (c *Client) SetConcurrency(ctx context.Context, concurrency map[string]int) error {
+	return c.tls.RequestJSON(ctx, http.MethodPut, "/control/concurrency", concurrency, nil)
+}
+
+// ChunkProgress gets the progress of every chunk of the table.
+func (c *Client) ChunkProgress(ctx context.Context, schemaName, tableName string) ([]web.ChunkProgress, error) {
+	query := url.Values{}
+	query.Set("t", common.UniqueTable(schemaName, tableName))
+	var result []web.ChunkProgress
+	if err := c.tls.GetJSON(ctx, "/progress/chunks?"+query.Encode(), &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return result, nil
+}
+
+// DuplicateReport gets the duplicate report of the table exported by the
+// current or the last task, in the format of `tikv-importer.duplicate-report-format`.
+func (c *Client) DuplicateReport(ctx context.Context, schemaName, tableName string) ([]byte, error) {
+	query := url.Values{}
+	query.Set("schema", schemaName)
+	query.Set("table", tableName)
+	body, err := c.tls.GetBody(ctx, "/duplicates?"+query.Encode())
+	return body, errors.Trace(err)
+}
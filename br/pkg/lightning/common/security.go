@@ -156,6 +156,16 @@ func (tc *TLS) GetJSON(ctx context.Context, path string, v interface{}) error {
 	return GetJSON(ctx, tc.client, tc.url+path, v)
 }
 
+// RequestJSON sends a request to the path with the JSON encoded body, and
+// parses the JSON response into `v`.
+func (tc *TLS) RequestJSON(ctx context.Context, method string, path string, body interface{}, v interface{}) error {
+	return RequestJSON(ctx, tc.client, method, tc.url+path, body, v)
+}
+
+func (tc *TLS) GetBody(ctx context.Context, path string) ([]byte, error) {
+	return GetBody(ctx, tc.client, tc.url+path)
+}
+
 func (tc *TLS) ToPDSecurityOption() pd.SecurityOption {
 	return pd.SecurityOption{
 		CAPath:   tc.caPath,
@@ -16,9 +16,57 @@
 
 package common
 
+import (
+	"crypto/rand"
+	"os"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/util/mathutil"
+)
+
 // StorageSize represents the storage's capacity and available size
 // Learn from tidb-binlog source code.
 type StorageSize struct {
 	Capacity  uint64
 	Available uint64
 }
+
+// MeasureWriteThroughput writes size bytes to a temporary file in dir and
+// syncs it, then returns the write throughput in bytes per second.
+func MeasureWriteThroughput(dir string, size int64) (float64, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return 0, errors.Trace(err)
+	}
+	f, err := os.CreateTemp(dir, "lightning-calibration-*")
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	// use random data so that a compressing file system can't fake it.
+	buf := make([]byte, mathutil.Min(size, 4*units.MiB))
+	if _, err := rand.Read(buf); err != nil {
+		return 0, errors.Trace(err)
+	}
+	start := time.Now()
+	for written := int64(0); written < size; {
+		n, err := f.Write(buf[:mathutil.Min(int64(len(buf)), size-written)])
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		written += int64(n)
+	}
+	if err := f.Sync(); err != nil {
+		return 0, errors.Trace(err)
+	}
+	elapsed := time.Since(start).Seconds()
+	if elapsed <= 0 {
+		return 0, errors.New("the elapsed time of the measurement is too short")
+	}
+	return float64(size) / elapsed, nil
+}
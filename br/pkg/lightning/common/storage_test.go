@@ -15,8 +15,11 @@
 package common_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/docker/go-units"
 	"github.com/pingcap/tidb/br/pkg/lightning/common"
 	"github.com/stretchr/testify/require"
 )
@@ -29,3 +32,14 @@ func TestGetStorageSize(t *testing.T) {
 	require.Greater(t, size.Capacity, uint64(0))
 	require.Greater(t, size.Available, uint64(0))
 }
+
+func TestMeasureWriteThroughput(t *testing.T) {
+	d := filepath.Join(t.TempDir(), "sorted-kv")
+	throughput, err := common.MeasureWriteThroughput(d, 5*units.MiB+1)
+	require.NoError(t, err)
+	require.Greater(t, throughput, float64(0))
+	// the temporary file is removed.
+	files, err := os.ReadDir(d)
+	require.NoError(t, err)
+	require.Len(t, files, 0)
+}
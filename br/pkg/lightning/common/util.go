@@ -15,6 +15,7 @@
 package common
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"encoding/base64"
@@ -345,6 +346,71 @@ func GetJSON(ctx context.Context, client *http.Client, url string, v interface{}
 	return errors.Trace(json.NewDecoder(resp.Body).Decode(v))
 }
 
+// RequestJSON sends a request with the JSON encoded `body` if it isn't nil, and
+// parses the response as JSON into `v` if it isn't nil. It's used for the HTTP
+// APIs which change the states of the server.
+func RequestJSON(ctx context.Context, client *http.Client, method string, url string, body interface{}, v interface{}) error {
+	var bodyReader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		bodyReader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		return errors.Errorf("%s %s http status code != 200, message %s", method, url, string(respBody))
+	}
+	if v == nil {
+		return nil
+	}
+	return errors.Trace(json.NewDecoder(resp.Body).Decode(v))
+}
+
+// GetBody fetches a page and returns the body, it's used for the pages which
+// aren't in JSON, e.g. the metrics of the TiKV status port.
+func GetBody(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("get %s http status code != 200, message %s", url, string(body))
+	}
+	return body, nil
+}
+
 // KillMySelf sends sigint to current process, used in integration test only
 //
 // Only works on Unix. Signaling on Windows is not supported.
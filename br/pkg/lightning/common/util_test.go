@@ -85,6 +85,28 @@ func TestGetJSON(t *testing.T) {
 	require.Regexp(t, ".*http status code != 200.*", err.Error())
 }
 
+func TestGetBody(t *testing.T) {
+	ctx := context.Background()
+	status := http.StatusOK
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, _ *http.Request) {
+		res.WriteHeader(status)
+		_, err := res.Write([]byte("process_cpu_seconds_total 1.5\n"))
+		require.NoError(t, err)
+	}))
+	defer testServer.Close()
+
+	client := &http.Client{Timeout: time.Second}
+	_, err := common.GetBody(ctx, client, "http://not-exists")
+	require.Error(t, err)
+	body, err := common.GetBody(ctx, client, testServer.URL)
+	require.NoError(t, err)
+	require.Equal(t, "process_cpu_seconds_total 1.5\n", string(body))
+
+	status = http.StatusNotFound
+	_, err = common.GetBody(ctx, client, testServer.URL)
+	require.Regexp(t, ".*http status code != 200, message process_cpu_seconds_total.*", err.Error())
+}
+
 func TestToDSN(t *testing.T) {
 	param := common.MySQLConnectParam{
 		Host:             "127.0.0.1",
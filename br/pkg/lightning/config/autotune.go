@@ -0,0 +1,105 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"github.com/docker/go-units"
+	"github.com/pingcap/tidb/util/mathutil"
+)
+
+const (
+	// autoTuneMemoryRatio is the ratio of the available memory used by the
+	// buffers of the local backend, the rest is left for the encoding and the
+	// other processes.
+	autoTuneMemoryRatio = 0.75
+
+	minAutoTuneMemCacheSize            = 32 * units.MiB
+	maxAutoTuneLocalWriterMemCacheSize = 512 * units.MiB
+	maxAutoTuneEngineMemCacheSize      = 2 * units.GiB
+
+	// autoTuneRangeThroughput is the throughput of the sorted-kv-dir consumed
+	// by ingesting a range, which determines the range concurrency.
+	autoTuneRangeThroughput     = 64 * units.MiB
+	minAutoTuneRangeConcurrency = 4
+	maxAutoTuneRangeConcurrency = 32
+)
+
+// HostResources are the resources of the host measured when the import
+// starts.
+type HostResources struct {
+	CPUs int
+	// AvailableMemory is the memory in bytes which isn't used by any process.
+	AvailableMemory uint64
+	// DiskThroughput is the bytes written to the sorted-kv-dir per second, it's
+	// 0 if unknown.
+	DiskThroughput float64
+}
+
+// IsDefined returns whether the key, e.g. "lightning.region-concurrency", is
+// defined in the loaded TOML data.
+func (cfg *Config) IsDefined(key string) bool {
+	_, ok := cfg.definedKeys[key]
+	return ok
+}
+
+// AutoTune derives the concurrency and the buffer sizes of the local backend
+// from the host resources. The items defined in the TOML data are kept. It
+// returns the keys of the tuned items.
+//
+// The buffers take autoTuneMemoryRatio of the available memory, the local
+// writers and the engines share it equally. The region concurrency is limited
+// by the CPUs and by the memory so that every local writer has at least
+// minAutoTuneMemCacheSize of buffer.
+func (cfg *Config) AutoTune(res HostResources) []string {
+	var tuned []string
+	budget := int64(float64(res.AvailableMemory) * autoTuneMemoryRatio)
+
+	// every region worker writes both the data engine and the index engine.
+	const writersPerRegion = 2
+	if !cfg.IsDefined("lightning.region-concurrency") && res.CPUs > 0 {
+		concurrency := res.CPUs
+		if byMemory := int(budget / 2 / writersPerRegion / minAutoTuneMemCacheSize); budget > 0 && concurrency > byMemory {
+			concurrency = mathutil.Max(byMemory, 1)
+		}
+		cfg.App.RegionConcurrency = concurrency
+		tuned = append(tuned, "lightning.region-concurrency")
+	}
+	if !cfg.IsDefined("lightning.io-concurrency") {
+		// the encoders read the source files concurrently.
+		cfg.App.IOConcurrency = mathutil.Max(defaultIOConcurrency, cfg.App.RegionConcurrency/2)
+		tuned = append(tuned, "lightning.io-concurrency")
+	}
+
+	if budget > 0 {
+		if !cfg.IsDefined("tikv-importer.local-writer-mem-cache-size") {
+			size := budget / 2 / writersPerRegion / int64(mathutil.Max(cfg.App.RegionConcurrency, 1))
+			cfg.TikvImporter.LocalWriterMemCacheSize = ByteSize(mathutil.Clamp(size, minAutoTuneMemCacheSize, maxAutoTuneLocalWriterMemCacheSize))
+			tuned = append(tuned, "tikv-importer.local-writer-mem-cache-size")
+		}
+		if !cfg.IsDefined("tikv-importer.engine-mem-cache-size") {
+			engines := mathutil.Max(cfg.App.TableConcurrency+cfg.App.IndexConcurrency, 1)
+			size := budget / 2 / int64(engines)
+			cfg.TikvImporter.EngineMemCacheSize = ByteSize(mathutil.Clamp(size, minAutoTuneMemCacheSize, maxAutoTuneEngineMemCacheSize))
+			tuned = append(tuned, "tikv-importer.engine-mem-cache-size")
+		}
+	}
+
+	if !cfg.IsDefined("tikv-importer.range-concurrency") && res.DiskThroughput > 0 {
+		concurrency := int64(res.DiskThroughput / autoTuneRangeThroughput)
+		cfg.TikvImporter.RangeConcurrency = int(mathutil.Clamp(concurrency, minAutoTuneRangeConcurrency, maxAutoTuneRangeConcurrency))
+		tuned = append(tuned, "tikv-importer.range-concurrency")
+	}
+	return tuned
+}
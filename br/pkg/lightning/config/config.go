@@ -67,12 +67,58 @@ const (
 	// ErrorOnDup indicates using INSERT INTO to insert data, which would violate PK or UNIQUE constraint
 	ErrorOnDup = "error"
 
+	// AvroMatchCaseInsensitive matches the avro field names to the column names
+	// case-insensitively.
+	AvroMatchCaseInsensitive = "case-insensitive"
+	// AvroMatchSnakeCase matches the avro field names converted from camel case
+	// to snake case, e.g. "userId" to "user_id", to the column names.
+	AvroMatchSnakeCase = "snake-case"
+
+	// DuplicateReportCSV exports the duplicate records as CSV with a header.
+	DuplicateReportCSV = "csv"
+	// DuplicateReportJSON exports the duplicate records as JSON objects, one
+	// record per line.
+	DuplicateReportJSON = "json"
+
+	// PreDedupNone doesn't deduplicate the source rows before encoding them.
+	PreDedupNone = "none"
+	// PreDedupDrop keeps the first one of the source rows sharing the same
+	// primary key, and drops the others.
+	PreDedupDrop = "drop"
+	// PreDedupLastWins keeps the last one of the source rows sharing the same
+	// primary key, and drops the others.
+	PreDedupLastWins = "last-wins"
+
+	// SQLParserFast splits the SQL data files into rows with a token scanner,
+	// which expects the files to contain only INSERT statements and doesn't
+	// validate them.
+	SQLParserFast = "fast"
+	// SQLParserLenient parses every statement of the SQL data files, and skips
+	// the statements which don't insert rows with a warning.
+	SQLParserLenient = "lenient"
+	// SQLParserStrict parses every statement of the SQL data files, and fails
+	// the import on the statements which can't be imported as is.
+	SQLParserStrict = "strict"
+
+	// InvalidCharPolicyReplace replaces the invalid characters in the source
+	// data by `mydumper.data-invalid-char-replace`.
+	InvalidCharPolicyReplace = "replace"
+	// InvalidCharPolicyIgnore removes the invalid characters in the source data.
+	InvalidCharPolicyIgnore = "ignore"
+	// InvalidCharPolicyError fails the import if there are invalid characters
+	// in the source data.
+	InvalidCharPolicyError = "error"
+
+	// StreamSourceStdin is the stream source which reads from stdin.
+	StreamSourceStdin = "-"
+
 	defaultDistSQLScanConcurrency     = 15
 	defaultBuildStatsConcurrency      = 20
 	defaultIndexSerialScanConcurrency = 20
 	defaultChecksumTableConcurrency   = 2
 	defaultTableConcurrency           = 6
 	defaultIndexConcurrency           = 2
+	defaultIOConcurrency              = 5
 
 	// defaultMetaSchemaName is the default database name used to store lightning metadata
 	defaultMetaSchemaName     = "lightning_metadata"
@@ -89,8 +135,21 @@ const (
 	defaultEngineMemCacheSize      = 512 * units.MiB
 	defaultLocalWriterMemCacheSize = 128 * units.MiB
 
+	defaultStreamChunkSize = 16 * units.MiB
+
+	defaultThrottleCheckInterval      = 10 * time.Second
+	defaultThrottleMaxIngestLatency   = 2 * time.Second
+	defaultThrottleMaxStoreCPUUsage   = 0.8
+	defaultThrottleMaxLeaderDropRatio = 0.1
+
+	defaultPostRestoreMaxRetry     = 3
+	defaultPostRestoreRetryBackoff = time.Second
+
 	defaultCSVDataCharacterSet       = "binary"
 	defaultCSVDataInvalidCharReplace = utf8.RuneError
+	defaultPrecheckSampleRows        = 100
+	defaultDecompressConcurrency     = 4
+	defaultPreDedupPartitions        = 64
 )
 
 var (
@@ -143,6 +202,10 @@ type Config struct {
 	Security     Security            `toml:"security" json:"security"`
 
 	BWList filter.MySQLReplicationRules `toml:"black-white-list" json:"black-white-list"`
+
+	// definedKeys are the keys defined in the TOML data, they're kept by the
+	// auto-tuning.
+	definedKeys map[string]struct{}
 }
 
 func (cfg *Config) String() string {
@@ -168,6 +231,11 @@ type Lightning struct {
 
 	MaxError           MaxError `toml:"max-error" json:"max-error"`
 	TaskInfoSchemaName string   `toml:"task-info-schema-name" json:"task-info-schema-name"`
+
+	// AutoTune derives the concurrency and the buffer sizes of the local
+	// backend which aren't set explicitly from the resources of the host
+	// measured when the import starts.
+	AutoTune bool `toml:"auto-tune" json:"auto-tune"`
 }
 
 type PostOpLevel int
@@ -322,7 +390,9 @@ type MaxError struct {
 	// This includes strict-mode errors such as zero in dates, integer overflow, character string too long, etc.
 	// In TiDB backend, this also includes all possible SQL errors raised from INSERT,
 	// such as unique key conflict when `on-duplicate` is set to `error`.
+	// In local backend, this includes the errors raised when encoding the row into KV pairs.
 	// When tolerated, the row causing the error will be skipped, and adds 1 to the counter.
+	// The skipped rows are logged, and recorded into the type error table of the task info schema if it's enabled.
 	Type atomic.Int64 `toml:"type" json:"type"`
 
 	// Conflict is the maximum number of unique key conflicts in local backend accepted.
@@ -364,13 +434,30 @@ const (
 	// DupeResAlgRemove records all duplicate records like the 'record' algorithm and remove all information related to the
 	// duplicated rows. Users need to analyze the lightning_task_info.conflict_error_v1 table to add back the correct rows.
 	DupeResAlgRemove
+
+	// DupeResAlgReplace records all duplicate records like the 'record' algorithm and keeps the last one of the duplicated
+	// rows, like `REPLACE INTO`. The duplicated rows in the same engine keep the last one in the source files, and the
+	// duplicated rows across the engines or with the existing data keep the one imported last.
+	DupeResAlgReplace
+
+	// DupeResAlgIgnore records all duplicate records like the 'record' algorithm and keeps the first one of the duplicated
+	// rows in the same engine, like `INSERT IGNORE`. The duplicated rows across the engines or with the existing data keep
+	// the one imported last, since the rows overwritten by the ingestion can't be recovered.
+	DupeResAlgIgnore
+
+	// DupeResAlgErr records all duplicate records like the 'record' algorithm and fails the import of the table once any
+	// duplicated rows are detected. Since the duplicated rows are already ingested, they're removed like the 'remove'
+	// algorithm before failing, including the ones conflicting with the existing data.
+	DupeResAlgErr
 )
 
+const dupeResAlgOptions = "['record', 'none', 'remove', 'replace', 'ignore', 'error']"
+
 func (dra *DuplicateResolutionAlgorithm) UnmarshalTOML(v interface{}) error {
 	if val, ok := v.(string); ok {
 		return dra.FromStringValue(val)
 	}
-	return errors.Errorf("invalid duplicate-resolution '%v', please choose valid option between ['record', 'none', 'remove']", v)
+	return errors.Errorf("invalid duplicate-resolution '%v', please choose valid option between %s", v, dupeResAlgOptions)
 }
 
 func (dra DuplicateResolutionAlgorithm) MarshalText() ([]byte, error) {
@@ -385,8 +472,14 @@ func (dra *DuplicateResolutionAlgorithm) FromStringValue(s string) error {
 		*dra = DupeResAlgNone
 	case "remove":
 		*dra = DupeResAlgRemove
+	case "replace":
+		*dra = DupeResAlgReplace
+	case "ignore":
+		*dra = DupeResAlgIgnore
+	case "error":
+		*dra = DupeResAlgErr
 	default:
-		return errors.Errorf("invalid duplicate-resolution '%s', please choose valid option between ['record', 'none', 'remove']", s)
+		return errors.Errorf("invalid duplicate-resolution '%s', please choose valid option between %s", s, dupeResAlgOptions)
 	}
 	return nil
 }
@@ -407,6 +500,12 @@ func (dra DuplicateResolutionAlgorithm) String() string {
 		return "none"
 	case DupeResAlgRemove:
 		return "remove"
+	case DupeResAlgReplace:
+		return "replace"
+	case DupeResAlgIgnore:
+		return "ignore"
+	case DupeResAlgErr:
+		return "error"
 	default:
 		panic(fmt.Sprintf("invalid duplicate-resolution type '%d'", dra))
 	}
@@ -419,6 +518,14 @@ type PostRestore struct {
 	Level1Compact     bool        `toml:"level-1-compact" json:"level-1-compact"`
 	PostProcessAtLast bool        `toml:"post-process-at-last" json:"post-process-at-last"`
 	Compact           bool        `toml:"compact" json:"compact"`
+	// MaxRetry is the max number of the retries of the checksum and analyze of
+	// a table, and RetryBackoff is the backoff before the first retry, which is
+	// doubled for each following retry.
+	MaxRetry     int      `toml:"max-retry" json:"max-retry"`
+	RetryBackoff Duration `toml:"retry-backoff" json:"retry-backoff"`
+	// AnalyzeInBackground runs the analyze of the tables in a best-effort
+	// background queue, so a table completes once its checksum passes.
+	AnalyzeInBackground bool `toml:"analyze-in-background" json:"analyze-in-background"`
 }
 
 type CSVConfig struct {
@@ -433,6 +540,47 @@ type CSVConfig struct {
 	BackslashEscape bool   `toml:"backslash-escape" json:"backslash-escape"`
 }
 
+// AvroConfig is the config of the avro data files.
+type AvroConfig struct {
+	// RecordField is the dot-separated path of the nested record which holds
+	// the row in each avro record, e.g. "after" for the change events of
+	// Debezium. The records whose nested record is null are skipped. The whole
+	// avro record is the row if it's empty.
+	RecordField string `toml:"record-field" json:"record-field"`
+	// ColumnNameMatch decides how the avro field names are matched to the
+	// column names, it's either "case-insensitive" or "snake-case".
+	ColumnNameMatch string `toml:"column-name-match" json:"column-name-match"`
+	// ColumnMapping maps the avro field names to the column names, which takes
+	// precedence over ColumnNameMatch.
+	ColumnMapping map[string]string `toml:"column-mapping" json:"column-mapping"`
+}
+
+// StreamConfig is the config of the stream source, which imports the CSV or
+// SQL data piped from stdin or a named pipe into a table without staging them
+// on the disk.
+type StreamConfig struct {
+	// Source is "-" for stdin or the path of a named pipe. The stream source
+	// is disabled if it's empty.
+	Source string `toml:"source" json:"source"`
+	// Type is the format of the stream, either "csv" or "sql".
+	Type string `toml:"type" json:"type"`
+	// Schema and Table are the target table of the stream, which must not
+	// have any data files in the data source directory.
+	Schema string `toml:"schema" json:"schema"`
+	Table  string `toml:"table" json:"table"`
+	// ChunkSize is the size of the chunks cut from the stream at the row
+	// boundaries, each chunk is restored by a region worker.
+	ChunkSize ByteSize `toml:"chunk-size" json:"chunk-size"`
+	// BufferChunks is the number of chunks read ahead of the region workers,
+	// which bounds the memory used to buffer the stream.
+	BufferChunks int `toml:"buffer-chunks" json:"buffer-chunks"`
+}
+
+// Enabled returns whether the stream source is configured.
+func (s *StreamConfig) Enabled() bool {
+	return len(s.Source) > 0
+}
+
 type MydumperRuntime struct {
 	ReadBlockSize    ByteSize         `toml:"read-block-size" json:"read-block-size"`
 	BatchSize        ByteSize         `toml:"batch-size" json:"batch-size"`
@@ -440,6 +588,8 @@ type MydumperRuntime struct {
 	SourceDir        string           `toml:"data-source-dir" json:"data-source-dir"`
 	CharacterSet     string           `toml:"character-set" json:"character-set"`
 	CSV              CSVConfig        `toml:"csv" json:"csv"`
+	Avro             AvroConfig       `toml:"avro" json:"avro"`
+	Stream           StreamConfig     `toml:"stream" json:"stream"`
 	MaxRegionSize    ByteSize         `toml:"max-region-size" json:"max-region-size"`
 	Filter           []string         `toml:"filter" json:"filter"`
 	FileRouters      []*FileRouteRule `toml:"files" json:"files"`
@@ -449,16 +599,43 @@ type MydumperRuntime struct {
 	StrictFormat     bool             `toml:"strict-format" json:"strict-format"`
 	DefaultFileRules bool             `toml:"default-file-rules" json:"default-file-rules"`
 	IgnoreColumns    AllIgnoreColumns `toml:"ignore-data-columns" json:"ignore-data-columns"`
-	// DataCharacterSet is the character set of the source file. Only CSV files are supported now. The following options are supported.
+	// DataCharacterSet is the character set of the source file. Only CSV and SQL files are supported now. The following options are supported.
 	//   - utf8mb4
 	//   - GB18030
 	//   - GBK: an extension of the GB2312 character set and is also known as Code Page 936.
+	//   - latin1: the latin1 of MySQL, which is actually Code Page 1252.
 	//   - binary: no attempt to convert the encoding.
-	// Leave DataCharacterSet empty will make it use `binary` by default.
+	// Leave DataCharacterSet empty will make it use `binary` by default. It can be overridden for the files matched
+	// by a rule of `[[mydumper.files]]`.
 	DataCharacterSet string `toml:"data-character-set" json:"data-character-set"`
 	// DataInvalidCharReplace is the replacement characters for non-compatible characters, which shouldn't duplicate with the separators or line breaks.
 	// Changing the default value will result in increased parsing time. Non-compatible characters do not cause an increase in error.
 	DataInvalidCharReplace string `toml:"data-invalid-char-replace" json:"data-invalid-char-replace"`
+	// DataInvalidCharPolicy is how the bytes invalid in DataCharacterSet are handled while converting, one of
+	// "replace" (replaced by DataInvalidCharReplace), "ignore" (removed) and "error" (fails the import).
+	DataInvalidCharPolicy string `toml:"data-invalid-char-policy" json:"data-invalid-char-policy"`
+	// PrecheckSampleRows is the number of the rows read from the beginning of
+	// every CSV and SQL file by the precheck to find the unparseable rows, the
+	// inconsistent column counts and the invalid characters. 0 disables it.
+	PrecheckSampleRows int `toml:"precheck-sample-rows" json:"precheck-sample-rows"`
+	// DecompressConcurrency is the number of goroutines used to decompress
+	// each zstd file concurrently.
+	DecompressConcurrency int `toml:"decompress-concurrency" json:"decompress-concurrency"`
+	// PreDedup is the strategy to deduplicate the source rows sharing the same
+	// primary key within the import before encoding them, for the sources
+	// known to contain replayed rows. It's one of PreDedupNone, PreDedupDrop
+	// and PreDedupLastWins.
+	PreDedup string `toml:"pre-dedup" json:"pre-dedup"`
+	// PreDedupPartitions is the number of the partitions the primary keys are
+	// hashed into. The keys are spilled to the partition files in
+	// PreDedupDir, and only one partition is loaded into memory at a time.
+	PreDedupPartitions int `toml:"pre-dedup-partitions" json:"pre-dedup-partitions"`
+	// PreDedupDir is the directory of the partition files, the system
+	// temporary directory is used if it's empty.
+	PreDedupDir string `toml:"pre-dedup-dir" json:"pre-dedup-dir"`
+	// SQLParser is the parser of the SQL data files, it's one of
+	// SQLParserFast, SQLParserLenient and SQLParserStrict.
+	SQLParser string `toml:"sql-parser" json:"sql-parser"`
 }
 
 type AllIgnoreColumns []*IgnoreColumns
@@ -507,12 +684,16 @@ type FileRouteRule struct {
 	Type        string `json:"type" toml:"type" yaml:"type"`
 	Key         string `json:"key" toml:"key" yaml:"key"`
 	Compression string `json:"compression" toml:"compression" yaml:"compression"`
+	// Partition is the name of the partition the matched files belong to. The
+	// rows of these files are only encoded into this partition, only supported
+	// by the local backend.
+	Partition string `json:"partition" toml:"partition" yaml:"partition"`
 	// unescape the schema/table name only used in lightning's internal logic now.
 	Unescape bool `json:"-" toml:"-" yaml:"-"`
-	// TODO: DataCharacterSet here can override the same field in [mydumper.csv] with a higher level.
-	// This could provide users a more flexible usage to configure different files with
-	// different data charsets.
-	// DataCharacterSet string `toml:"data-character-set" json:"data-character-set"`
+	// DataCharacterSet overrides `mydumper.data-character-set` for the matched
+	// files, so that the files with different charsets can be imported in
+	// the same task.
+	DataCharacterSet string `json:"data-character-set" toml:"data-character-set" yaml:"data-character-set"`
 }
 
 type TikvImporter struct {
@@ -529,10 +710,50 @@ type TikvImporter struct {
 	RangeConcurrency    int                          `toml:"range-concurrency" json:"range-concurrency"`
 	DuplicateResolution DuplicateResolutionAlgorithm `toml:"duplicate-resolution" json:"duplicate-resolution"`
 	IncrementalImport   bool                         `toml:"incremental-import" json:"incremental-import"`
+	// DuplicateReportDir is the URL of the external storage to export the
+	// duplicate records detected by `duplicate-resolution` to, one report file
+	// per table. It's disabled if empty.
+	DuplicateReportDir string `toml:"duplicate-report-dir" json:"duplicate-report-dir"`
+	// DuplicateReportFormat is the format of the duplicate reports, either
+	// "csv" or "json".
+	DuplicateReportFormat string `toml:"duplicate-report-format" json:"duplicate-report-format"`
+	// AllowImportDuringLogBackup allows the local backend to import the tables
+	// observed by running log backup tasks. The ingested data isn't captured
+	// by the log backup, so a full backup is needed after the import to keep
+	// the tables restorable by PITR.
+	AllowImportDuringLogBackup bool `toml:"allow-import-during-log-backup" json:"allow-import-during-log-backup"`
 
 	EngineMemCacheSize      ByteSize `toml:"engine-mem-cache-size" json:"engine-mem-cache-size"`
 	LocalWriterMemCacheSize ByteSize `toml:"local-writer-mem-cache-size" json:"local-writer-mem-cache-size"`
 	StoreWriteBWLimit       ByteSize `toml:"store-write-bwlimit" json:"store-write-bwlimit"`
+
+	Throttle IngestThrottle `toml:"throttle" json:"throttle"`
+
+	// BackendOverrides imports the matched tables with another backend than
+	// `backend`, e.g. the small tables of a local backend task are imported by
+	// the TiDB backend.
+	BackendOverrides []*BackendOverride `toml:"backend-override" json:"backend-override"`
+}
+
+// BackendOverride is a `[[tikv-importer.backend-override]]` rule, the tables
+// matching the table filter `tables` are imported by `backend`. Only the TiDB
+// backend can override the local backend.
+type BackendOverride struct {
+	Tables  []string `toml:"tables" json:"tables"`
+	Backend string   `toml:"backend" json:"backend"`
+}
+
+// IngestThrottle is the config of the controller which slows down the ingestion
+// of the local backend when the target cluster degrades. When it's enabled,
+// `range-concurrency` is the upper bound of the concurrency instead of a fixed
+// one.
+type IngestThrottle struct {
+	Enable        bool     `toml:"enable" json:"enable"`
+	CheckInterval Duration `toml:"check-interval" json:"check-interval"`
+	// The signals are ignored if their thresholds are 0.
+	MaxIngestLatency   Duration `toml:"max-ingest-latency" json:"max-ingest-latency"`
+	MaxStoreCPUUsage   float64  `toml:"max-store-cpu-usage" json:"max-store-cpu-usage"`
+	MaxLeaderDropRatio float64  `toml:"max-leader-drop-ratio" json:"max-leader-drop-ratio"`
 }
 
 type Checkpoint struct {
@@ -614,6 +835,7 @@ const (
 	UTF8MB4
 	GB18030
 	GBK
+	Latin1
 )
 
 // String return the string value of charset
@@ -627,6 +849,8 @@ func (c Charset) String() string {
 		return "gb18030"
 	case GBK:
 		return "gbk"
+	case Latin1:
+		return "latin1"
 	default:
 		return "unknown_charset"
 	}
@@ -643,6 +867,8 @@ func ParseCharset(dataCharacterSet string) (Charset, error) {
 		return GB18030, nil
 	case "gbk":
 		return GBK, nil
+	case "latin1":
+		return Latin1, nil
 	default:
 		return Binary, errors.Errorf("found unsupported data-character-set: %s", dataCharacterSet)
 	}
@@ -654,13 +880,14 @@ func NewConfig() *Config {
 			RegionConcurrency: runtime.NumCPU(),
 			TableConcurrency:  0,
 			IndexConcurrency:  0,
-			IOConcurrency:     5,
+			IOConcurrency:     defaultIOConcurrency,
 			CheckRequirements: true,
 			MaxError: MaxError{
 				Charset:  *atomic.NewInt64(math.MaxInt64),
 				Conflict: *atomic.NewInt64(math.MaxInt64),
 			},
 			TaskInfoSchemaName: defaultTaskInfoSchemaName,
+			AutoTune:           true,
 		},
 		Checkpoint: Checkpoint{
 			Enable: true,
@@ -692,11 +919,23 @@ func NewConfig() *Config {
 				BackslashEscape: true,
 				TrimLastSep:     false,
 			},
+			Avro: AvroConfig{
+				ColumnNameMatch: AvroMatchCaseInsensitive,
+			},
+			Stream: StreamConfig{
+				ChunkSize: defaultStreamChunkSize,
+			},
 			StrictFormat:           false,
 			MaxRegionSize:          MaxRegionSize,
 			Filter:                 DefaultFilter,
 			DataCharacterSet:       defaultCSVDataCharacterSet,
 			DataInvalidCharReplace: string(defaultCSVDataInvalidCharReplace),
+			DataInvalidCharPolicy:  InvalidCharPolicyReplace,
+			PrecheckSampleRows:     defaultPrecheckSampleRows,
+			DecompressConcurrency:  defaultDecompressConcurrency,
+			PreDedup:               PreDedupNone,
+			PreDedupPartitions:     defaultPreDedupPartitions,
+			SQLParser:              SQLParserFast,
 		},
 		TikvImporter: TikvImporter{
 			Backend:             "",
@@ -706,11 +945,19 @@ func NewConfig() *Config {
 			RegionSplitSize:     0,
 			DiskQuota:           ByteSize(math.MaxInt64),
 			DuplicateResolution: DupeResAlgNone,
+			Throttle: IngestThrottle{
+				CheckInterval:      Duration{Duration: defaultThrottleCheckInterval},
+				MaxIngestLatency:   Duration{Duration: defaultThrottleMaxIngestLatency},
+				MaxStoreCPUUsage:   defaultThrottleMaxStoreCPUUsage,
+				MaxLeaderDropRatio: defaultThrottleMaxLeaderDropRatio,
+			},
 		},
 		PostRestore: PostRestore{
 			Checksum:          OpLevelRequired,
 			Analyze:           OpLevelOptional,
 			PostProcessAtLast: true,
+			MaxRetry:          defaultPostRestoreMaxRetry,
+			RetryBackoff:      Duration{Duration: defaultPostRestoreRetryBackoff},
 		},
 	}
 }
@@ -757,6 +1004,12 @@ func (cfg *Config) LoadFromTOML(data []byte) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if cfg.definedKeys == nil {
+		cfg.definedKeys = make(map[string]struct{})
+	}
+	for _, key := range metaData.Keys() {
+		cfg.definedKeys[key.String()] = struct{}{}
+	}
 
 	unusedConfigKeys := metaData.Undecoded()
 	if len(unusedConfigKeys) == 0 {
@@ -825,6 +1078,16 @@ func (cfg *Config) Adjust(ctx context.Context) error {
 		}
 	}
 
+	avro := &cfg.Mydumper.Avro
+	avro.ColumnNameMatch = strings.ToLower(avro.ColumnNameMatch)
+	switch avro.ColumnNameMatch {
+	case AvroMatchCaseInsensitive, AvroMatchSnakeCase:
+	case "":
+		avro.ColumnNameMatch = AvroMatchCaseInsensitive
+	default:
+		return common.ErrInvalidConfig.GenWithStack("unsupported `mydumper.avro.column-name-match` (%s)", avro.ColumnNameMatch)
+	}
+
 	// adjust file routing
 	for _, rule := range cfg.Mydumper.FileRouters {
 		if filepath.IsAbs(rule.Path) {
@@ -854,7 +1117,15 @@ func (cfg *Config) Adjust(ctx context.Context) error {
 	if err1 != nil {
 		return common.ErrInvalidConfig.Wrap(err1).GenWithStack("invalid `mydumper.data-character-set`")
 	}
-	if charset == GBK || charset == GB18030 {
+	cfg.Mydumper.DataInvalidCharPolicy = strings.ToLower(cfg.Mydumper.DataInvalidCharPolicy)
+	switch cfg.Mydumper.DataInvalidCharPolicy {
+	case "":
+		cfg.Mydumper.DataInvalidCharPolicy = InvalidCharPolicyReplace
+	case InvalidCharPolicyReplace, InvalidCharPolicyIgnore, InvalidCharPolicyError:
+	default:
+		return common.ErrInvalidConfig.GenWithStack("unsupported `mydumper.data-invalid-char-policy` (%s)", cfg.Mydumper.DataInvalidCharPolicy)
+	}
+	if (charset == GBK || charset == GB18030) && cfg.Mydumper.DataInvalidCharPolicy == InvalidCharPolicyReplace {
 		log.L().Warn(
 			"incompatible strings may be encountered during the transcoding process and will be replaced, please be aware of the risk of not being able to retain the original information",
 			zap.String("source-character-set", charset.String()),
@@ -888,6 +1159,18 @@ func (cfg *Config) Adjust(ctx context.Context) error {
 	}
 	cfg.AdjustMydumper()
 	cfg.AdjustCheckPoint()
+	if err := cfg.CheckAndAdjustStream(); err != nil {
+		return err
+	}
+	if err := cfg.CheckAndAdjustPreDedup(); err != nil {
+		return err
+	}
+	if err := cfg.CheckAndAdjustSQLParser(); err != nil {
+		return err
+	}
+	if err := cfg.CheckAndAdjustPostRestore(); err != nil {
+		return err
+	}
 	return cfg.CheckAndAdjustFilePath()
 }
 
@@ -915,7 +1198,8 @@ func (cfg *Config) AdjustCommon() (bool, error) {
 		return mustHaveInternalConnections, common.ErrInvalidConfig.GenWithStack("unsupported `tikv-importer.backend` (%s)", cfg.TikvImporter.Backend)
 	}
 
-	// TODO calculate these from the machine's free memory.
+	// the local backend may calculate these from the machine's free memory
+	// later, see AutoTune.
 	if cfg.TikvImporter.EngineMemCacheSize == 0 {
 		cfg.TikvImporter.EngineMemCacheSize = defaultEngineMemCacheSize
 	}
@@ -924,14 +1208,31 @@ func (cfg *Config) AdjustCommon() (bool, error) {
 	}
 
 	if cfg.TikvImporter.Backend == BackendLocal {
+		if err := cfg.CheckAndAdjustThrottle(); err != nil {
+			return mustHaveInternalConnections, err
+		}
 		if err := cfg.CheckAndAdjustForLocalBackend(); err != nil {
 			return mustHaveInternalConnections, err
 		}
+		if err := cfg.CheckAndAdjustDuplicateReport(); err != nil {
+			return mustHaveInternalConnections, err
+		}
 	} else {
 		cfg.TikvImporter.DuplicateResolution = DupeResAlgNone
+		// only the local backend encodes the rows into the given partition.
+		for _, rule := range cfg.Mydumper.FileRouters {
+			if len(rule.Partition) > 0 {
+				return mustHaveInternalConnections, common.ErrInvalidConfig.GenWithStack(
+					"`partition` in [[mydumper.files]] is only supported by the local backend")
+			}
+		}
+	}
+
+	if err := cfg.CheckAndAdjustBackendOverrides(); err != nil {
+		return mustHaveInternalConnections, err
 	}
 
-	if cfg.TikvImporter.Backend == BackendTiDB {
+	if cfg.TikvImporter.Backend == BackendTiDB || len(cfg.TikvImporter.BackendOverrides) > 0 {
 		cfg.TikvImporter.OnDuplicate = strings.ToLower(cfg.TikvImporter.OnDuplicate)
 		switch cfg.TikvImporter.OnDuplicate {
 		case ReplaceOnDup, IgnoreOnDup, ErrorOnDup:
@@ -953,6 +1254,51 @@ func (cfg *Config) AdjustCommon() (bool, error) {
 	return mustHaveInternalConnections, err
 }
 
+// CheckAndAdjustBackendOverrides checks the `[[tikv-importer.backend-override]]`
+// rules, the tables of a local backend task can only be overridden to be
+// imported by the TiDB backend.
+func (cfg *Config) CheckAndAdjustBackendOverrides() error {
+	for _, rule := range cfg.TikvImporter.BackendOverrides {
+		if cfg.TikvImporter.Backend != BackendLocal {
+			return common.ErrInvalidConfig.GenWithStack(
+				"[[tikv-importer.backend-override]] is only supported by the local backend")
+		}
+		rule.Backend = strings.ToLower(rule.Backend)
+		if rule.Backend != BackendTiDB {
+			return common.ErrInvalidConfig.GenWithStack(
+				"unsupported `backend` (%s) in [[tikv-importer.backend-override]], only 'tidb' is supported", rule.Backend)
+		}
+		if len(rule.Tables) == 0 {
+			return common.ErrInvalidConfig.GenWithStack("`tables` in [[tikv-importer.backend-override]] must not be empty")
+		}
+		if _, err := filter.Parse(rule.Tables); err != nil {
+			return common.ErrInvalidConfig.Wrap(err).GenWithStack(
+				"invalid `tables` %s in [[tikv-importer.backend-override]]", strings.Join(rule.Tables, ","))
+		}
+	}
+	return nil
+}
+
+func (cfg *Config) CheckAndAdjustThrottle() error {
+	throttle := &cfg.TikvImporter.Throttle
+	if !throttle.Enable {
+		return nil
+	}
+	if throttle.CheckInterval.Duration <= 0 {
+		throttle.CheckInterval.Duration = defaultThrottleCheckInterval
+	}
+	if throttle.MaxIngestLatency.Duration < 0 {
+		return common.ErrInvalidConfig.GenWithStack("`tikv-importer.throttle.max-ingest-latency` must not be negative")
+	}
+	if throttle.MaxStoreCPUUsage < 0 || throttle.MaxStoreCPUUsage > 1 {
+		return common.ErrInvalidConfig.GenWithStack("`tikv-importer.throttle.max-store-cpu-usage` (%v) must be in [0, 1]", throttle.MaxStoreCPUUsage)
+	}
+	if throttle.MaxLeaderDropRatio < 0 || throttle.MaxLeaderDropRatio > 1 {
+		return common.ErrInvalidConfig.GenWithStack("`tikv-importer.throttle.max-leader-drop-ratio` (%v) must be in [0, 1]", throttle.MaxLeaderDropRatio)
+	}
+	return nil
+}
+
 func (cfg *Config) CheckAndAdjustForLocalBackend() error {
 	if len(cfg.TikvImporter.SortedKVDir) == 0 {
 		return common.ErrInvalidConfig.GenWithStack("tikv-importer.sorted-kv-dir must not be empty!")
@@ -976,6 +1322,31 @@ func (cfg *Config) CheckAndAdjustForLocalBackend() error {
 	return nil
 }
 
+// CheckAndAdjustDuplicateReport checks the config of the duplicate reports.
+func (cfg *Config) CheckAndAdjustDuplicateReport() error {
+	if len(cfg.TikvImporter.DuplicateReportDir) == 0 {
+		return nil
+	}
+	if cfg.TikvImporter.DuplicateResolution == DupeResAlgNone {
+		return common.ErrInvalidConfig.GenWithStack(
+			"`tikv-importer.duplicate-report-dir` requires `tikv-importer.duplicate-resolution` to detect the duplicate records")
+	}
+	if len(cfg.App.TaskInfoSchemaName) == 0 {
+		return common.ErrInvalidConfig.GenWithStack(
+			"`tikv-importer.duplicate-report-dir` requires `app.task-info-schema-name` to record the duplicate records")
+	}
+	cfg.TikvImporter.DuplicateReportFormat = strings.ToLower(cfg.TikvImporter.DuplicateReportFormat)
+	switch cfg.TikvImporter.DuplicateReportFormat {
+	case DuplicateReportCSV, DuplicateReportJSON:
+	case "":
+		cfg.TikvImporter.DuplicateReportFormat = DuplicateReportCSV
+	default:
+		return common.ErrInvalidConfig.GenWithStack(
+			"unsupported `tikv-importer.duplicate-report-format` (%s)", cfg.TikvImporter.DuplicateReportFormat)
+	}
+	return nil
+}
+
 func (cfg *Config) DefaultVarsForTiDBBackend() {
 	if cfg.App.TableConcurrency == 0 {
 		cfg.App.TableConcurrency = cfg.App.RegionConcurrency
@@ -1131,6 +1502,9 @@ func (cfg *Config) AdjustMydumper() {
 	if len(cfg.Mydumper.CharacterSet) == 0 {
 		cfg.Mydumper.CharacterSet = "auto"
 	}
+	if cfg.Mydumper.DecompressConcurrency <= 0 {
+		cfg.Mydumper.DecompressConcurrency = defaultDecompressConcurrency
+	}
 
 	if len(cfg.Mydumper.IgnoreColumns) != 0 {
 		// Tolower columns cause we use Name.L to compare column in tidb.
@@ -1144,6 +1518,98 @@ func (cfg *Config) AdjustMydumper() {
 	}
 }
 
+func (cfg *Config) CheckAndAdjustPostRestore() error {
+	if cfg.PostRestore.MaxRetry < 0 {
+		return common.ErrInvalidConfig.GenWithStack("`post-restore.max-retry` must not be negative")
+	}
+	if cfg.PostRestore.RetryBackoff.Duration <= 0 {
+		cfg.PostRestore.RetryBackoff.Duration = defaultPostRestoreRetryBackoff
+	}
+	return nil
+}
+
+func (cfg *Config) CheckAndAdjustStream() error {
+	stream := &cfg.Mydumper.Stream
+	if !stream.Enabled() {
+		return nil
+	}
+	stream.Type = strings.ToLower(stream.Type)
+	if stream.Type != "csv" && stream.Type != "sql" {
+		return common.ErrInvalidConfig.GenWithStack("unsupported `mydumper.stream.type` (%s)", stream.Type)
+	}
+	if len(stream.Schema) == 0 || len(stream.Table) == 0 {
+		return common.ErrInvalidConfig.GenWithStack("`mydumper.stream.schema` and `mydumper.stream.table` must not be empty")
+	}
+	// the stream is cut at the row boundaries before it's decoded, but the
+	// trailing bytes of the GBK characters may be confused with '\'.
+	if charset, err := ParseCharset(cfg.Mydumper.DataCharacterSet); err == nil &&
+		(charset == GBK || charset == GB18030) && stream.Type == "csv" && cfg.Mydumper.CSV.BackslashEscape {
+		return common.ErrInvalidConfig.GenWithStack(
+			"the stream source doesn't support `mydumper.data-character-set` (%s) with `mydumper.csv.backslash-escape`", cfg.Mydumper.DataCharacterSet)
+	}
+	// the stream can be read only once, so the import can't be resumed.
+	if cfg.Checkpoint.Enable {
+		return common.ErrInvalidConfig.GenWithStack("the stream source can't be resumed from checkpoints, please set `checkpoint.enable` to false")
+	}
+	if cfg.TikvImporter.IncrementalImport {
+		return common.ErrInvalidConfig.GenWithStack("the stream source doesn't support `tikv-importer.incremental-import`")
+	}
+	if stream.ChunkSize <= 0 {
+		stream.ChunkSize = defaultStreamChunkSize
+	}
+	if stream.BufferChunks <= 0 {
+		stream.BufferChunks = cfg.App.RegionConcurrency
+	}
+	return nil
+}
+
+// CheckAndAdjustPreDedup checks the config of the pre-deduplication of the
+// source rows.
+func (cfg *Config) CheckAndAdjustPreDedup() error {
+	cfg.Mydumper.PreDedup = strings.ToLower(cfg.Mydumper.PreDedup)
+	switch cfg.Mydumper.PreDedup {
+	case PreDedupNone:
+		return nil
+	case "":
+		cfg.Mydumper.PreDedup = PreDedupNone
+		return nil
+	case PreDedupDrop, PreDedupLastWins:
+	default:
+		return common.ErrInvalidConfig.GenWithStack("unsupported `mydumper.pre-dedup` (%s)", cfg.Mydumper.PreDedup)
+	}
+	// the rows are read twice to deduplicate them, but the stream can be read
+	// only once.
+	if cfg.Mydumper.Stream.Enabled() {
+		return common.ErrInvalidConfig.GenWithStack("the stream source doesn't support `mydumper.pre-dedup`")
+	}
+	if cfg.Mydumper.PreDedupPartitions <= 0 {
+		cfg.Mydumper.PreDedupPartitions = defaultPreDedupPartitions
+	}
+	return nil
+}
+
+// CheckAndAdjustSQLParser checks the parser of the SQL data files.
+func (cfg *Config) CheckAndAdjustSQLParser() error {
+	cfg.Mydumper.SQLParser = strings.ToLower(cfg.Mydumper.SQLParser)
+	switch cfg.Mydumper.SQLParser {
+	case SQLParserFast:
+		return nil
+	case "":
+		cfg.Mydumper.SQLParser = SQLParserFast
+		return nil
+	case SQLParserLenient, SQLParserStrict:
+	default:
+		return common.ErrInvalidConfig.GenWithStack("unsupported `mydumper.sql-parser` (%s)", cfg.Mydumper.SQLParser)
+	}
+	// the rows of a multi-row statement share the offset of the statement,
+	// while the pre-deduplication identifies the rows by their offsets.
+	if cfg.Mydumper.PreDedup != PreDedupNone {
+		return common.ErrInvalidConfig.GenWithStack(
+			"`mydumper.pre-dedup` requires `mydumper.sql-parser` to be %q", SQLParserFast)
+	}
+	return nil
+}
+
 func (cfg *Config) CheckAndAdjustSecurity() error {
 	if cfg.TiDB.Security == nil {
 		cfg.TiDB.Security = &cfg.Security
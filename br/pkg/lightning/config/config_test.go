@@ -464,6 +464,20 @@ func TestInvalidCSV(t *testing.T) {
 			`,
 			err: "[Lightning:Config:ErrInvalidConfig]cannot use '\\' as CSV delimiter when `mydumper.csv.backslash-escape` is true",
 		},
+		{
+			input: `
+				[mydumper.avro]
+				column-name-match = 'Snake-Case'
+			`,
+			err: "",
+		},
+		{
+			input: `
+				[mydumper.avro]
+				column-name-match = 'camel-case'
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig]unsupported `mydumper.avro.column-name-match` (camel-case)",
+		},
 		{
 			input: `
 				[tidb]
@@ -510,6 +524,431 @@ func TestInvalidCSV(t *testing.T) {
 	}
 }
 
+func TestAdjustStream(t *testing.T) {
+	testCases := []struct {
+		input string
+		err   string
+	}{
+		{
+			input: `
+				[mydumper.stream]
+				source = "-"
+				type = "parquet"
+				schema = "db"
+				table = "tbl"
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig]unsupported `mydumper.stream.type` (parquet)",
+		},
+		{
+			input: `
+				[mydumper.stream]
+				source = "-"
+				type = "csv"
+				schema = "db"
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig]`mydumper.stream.schema` and `mydumper.stream.table` must not be empty",
+		},
+		{
+			input: `
+				[checkpoint]
+				enable = true
+				[mydumper.stream]
+				source = "-"
+				type = "csv"
+				schema = "db"
+				table = "tbl"
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig]the stream source can't be resumed from checkpoints, please set `checkpoint.enable` to false",
+		},
+		{
+			input: `
+				[checkpoint]
+				enable = false
+				[tikv-importer]
+				incremental-import = true
+				[mydumper.stream]
+				source = "-"
+				type = "csv"
+				schema = "db"
+				table = "tbl"
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig]the stream source doesn't support `tikv-importer.incremental-import`",
+		},
+	}
+
+	for _, tc := range testCases {
+		cfg := config.NewConfig()
+		assignMinimalLegalValue(cfg)
+		cfg.TiDB.DistSQLScanConcurrency = 1
+		require.NoError(t, cfg.LoadFromTOML([]byte(tc.input)))
+		require.EqualError(t, cfg.Adjust(context.Background()), tc.err, tc.input)
+	}
+
+	cfg := config.NewConfig()
+	assignMinimalLegalValue(cfg)
+	cfg.TiDB.DistSQLScanConcurrency = 1
+	cfg.App.RegionConcurrency = 1
+	require.NoError(t, cfg.LoadFromTOML([]byte(`
+		[checkpoint]
+		enable = false
+		[mydumper.stream]
+		source = "/tmp/lightning.fifo"
+		type = "SQL"
+		schema = "db"
+		table = "tbl"
+		chunk-size = 0
+	`)))
+	require.NoError(t, cfg.Adjust(context.Background()))
+	require.True(t, cfg.Mydumper.Stream.Enabled())
+	require.Equal(t, "sql", cfg.Mydumper.Stream.Type)
+	require.Equal(t, config.ByteSize(16<<20), cfg.Mydumper.Stream.ChunkSize)
+	require.Equal(t, 1, cfg.Mydumper.Stream.BufferChunks)
+}
+
+func TestAdjustPreDedup(t *testing.T) {
+	testCases := []struct {
+		input string
+		err   string
+	}{
+		{
+			input: `
+				[mydumper]
+				pre-dedup = "first-wins"
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig]unsupported `mydumper.pre-dedup` (first-wins)",
+		},
+		{
+			input: `
+				[checkpoint]
+				enable = false
+				[mydumper]
+				pre-dedup = "drop"
+				[mydumper.stream]
+				source = "-"
+				type = "csv"
+				schema = "db"
+				table = "tbl"
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig]the stream source doesn't support `mydumper.pre-dedup`",
+		},
+	}
+
+	for _, tc := range testCases {
+		cfg := config.NewConfig()
+		assignMinimalLegalValue(cfg)
+		cfg.TiDB.DistSQLScanConcurrency = 1
+		require.NoError(t, cfg.LoadFromTOML([]byte(tc.input)))
+		require.EqualError(t, cfg.Adjust(context.Background()), tc.err, tc.input)
+	}
+
+	cfg := config.NewConfig()
+	assignMinimalLegalValue(cfg)
+	cfg.TiDB.DistSQLScanConcurrency = 1
+	require.NoError(t, cfg.LoadFromTOML([]byte(`
+		[mydumper]
+		pre-dedup = "Last-Wins"
+		pre-dedup-partitions = 0
+	`)))
+	require.NoError(t, cfg.Adjust(context.Background()))
+	require.Equal(t, config.PreDedupLastWins, cfg.Mydumper.PreDedup)
+	require.Equal(t, 64, cfg.Mydumper.PreDedupPartitions)
+
+	cfg.Mydumper.PreDedup = ""
+	require.NoError(t, cfg.CheckAndAdjustPreDedup())
+	require.Equal(t, config.PreDedupNone, cfg.Mydumper.PreDedup)
+}
+
+func TestAdjustSQLParser(t *testing.T) {
+	testCases := []struct {
+		input string
+		err   string
+	}{
+		{
+			input: `
+				[mydumper]
+				sql-parser = "loose"
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig]unsupported `mydumper.sql-parser` (loose)",
+		},
+		{
+			input: `
+				[mydumper]
+				sql-parser = "strict"
+				pre-dedup = "drop"
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig]`mydumper.pre-dedup` requires `mydumper.sql-parser` to be \"fast\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		cfg := config.NewConfig()
+		assignMinimalLegalValue(cfg)
+		cfg.TiDB.DistSQLScanConcurrency = 1
+		require.NoError(t, cfg.LoadFromTOML([]byte(tc.input)))
+		require.EqualError(t, cfg.Adjust(context.Background()), tc.err, tc.input)
+	}
+
+	cfg := config.NewConfig()
+	require.Equal(t, config.SQLParserFast, cfg.Mydumper.SQLParser)
+	assignMinimalLegalValue(cfg)
+	cfg.TiDB.DistSQLScanConcurrency = 1
+	require.NoError(t, cfg.LoadFromTOML([]byte(`
+		[mydumper]
+		sql-parser = "Lenient"
+	`)))
+	require.NoError(t, cfg.Adjust(context.Background()))
+	require.Equal(t, config.SQLParserLenient, cfg.Mydumper.SQLParser)
+
+	cfg.Mydumper.SQLParser = ""
+	require.NoError(t, cfg.CheckAndAdjustSQLParser())
+	require.Equal(t, config.SQLParserFast, cfg.Mydumper.SQLParser)
+}
+
+func TestAutoTune(t *testing.T) {
+	cfg := config.NewConfig()
+	require.NoError(t, cfg.LoadFromTOML([]byte(`
+		[lightning]
+		region-concurrency = 4
+		[tikv-importer]
+		engine-mem-cache-size = "1GiB"
+	`)))
+	require.True(t, cfg.App.AutoTune)
+	require.True(t, cfg.IsDefined("lightning.region-concurrency"))
+	cfg.App.TableConcurrency, cfg.App.IndexConcurrency = 6, 2
+	tuned := cfg.AutoTune(config.HostResources{CPUs: 16, AvailableMemory: 16 << 30, DiskThroughput: 1 << 30})
+	require.Equal(t, []string{
+		"lightning.io-concurrency",
+		"tikv-importer.local-writer-mem-cache-size",
+		"tikv-importer.range-concurrency",
+	}, tuned)
+	// the explicitly set items are kept.
+	require.Equal(t, 4, cfg.App.RegionConcurrency)
+	require.Equal(t, config.ByteSize(1<<30), cfg.TikvImporter.EngineMemCacheSize)
+	require.Equal(t, 5, cfg.App.IOConcurrency)
+	require.Equal(t, config.ByteSize(512<<20), cfg.TikvImporter.LocalWriterMemCacheSize)
+	require.Equal(t, 16, cfg.TikvImporter.RangeConcurrency)
+
+	// the region concurrency is limited by the memory, and the range
+	// concurrency isn't tuned without the disk throughput.
+	cfg = config.NewConfig()
+	cfg.App.TableConcurrency, cfg.App.IndexConcurrency = 6, 2
+	tuned = cfg.AutoTune(config.HostResources{CPUs: 16, AvailableMemory: 1 << 30})
+	require.Equal(t, []string{
+		"lightning.region-concurrency",
+		"lightning.io-concurrency",
+		"tikv-importer.local-writer-mem-cache-size",
+		"tikv-importer.engine-mem-cache-size",
+	}, tuned)
+	require.Equal(t, 6, cfg.App.RegionConcurrency)
+	require.Equal(t, 5, cfg.App.IOConcurrency)
+	require.Equal(t, config.ByteSize(32<<20), cfg.TikvImporter.LocalWriterMemCacheSize)
+	require.Equal(t, config.ByteSize(48<<20), cfg.TikvImporter.EngineMemCacheSize)
+	require.Equal(t, 0, cfg.TikvImporter.RangeConcurrency)
+}
+
+func TestAdjustThrottle(t *testing.T) {
+	testCases := []struct {
+		input string
+		err   string
+	}{
+		{
+			input: `
+				[tikv-importer.throttle]
+				enable = true
+				max-store-cpu-usage = 1.5
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig]`tikv-importer.throttle.max-store-cpu-usage` (1.5) must be in [0, 1]",
+		},
+		{
+			input: `
+				[tikv-importer.throttle]
+				enable = true
+				max-leader-drop-ratio = -0.1
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig]`tikv-importer.throttle.max-leader-drop-ratio` (-0.1) must be in [0, 1]",
+		},
+		{
+			input: `
+				[tikv-importer.throttle]
+				enable = true
+				max-ingest-latency = "-1s"
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig]`tikv-importer.throttle.max-ingest-latency` must not be negative",
+		},
+	}
+
+	for _, tc := range testCases {
+		cfg := config.NewConfig()
+		assignMinimalLegalValue(cfg)
+		cfg.TiDB.DistSQLScanConcurrency = 1
+		require.NoError(t, cfg.LoadFromTOML([]byte(tc.input)))
+		require.EqualError(t, cfg.Adjust(context.Background()), tc.err, tc.input)
+	}
+
+	cfg := config.NewConfig()
+	assignMinimalLegalValue(cfg)
+	cfg.TiDB.DistSQLScanConcurrency = 1
+	require.NoError(t, cfg.LoadFromTOML([]byte(`
+		[tikv-importer.throttle]
+		enable = true
+		check-interval = "0s"
+		max-store-cpu-usage = 0
+	`)))
+	require.NoError(t, cfg.Adjust(context.Background()))
+	require.Equal(t, 10*time.Second, cfg.TikvImporter.Throttle.CheckInterval.Duration)
+	require.Equal(t, 2*time.Second, cfg.TikvImporter.Throttle.MaxIngestLatency.Duration)
+	require.Equal(t, 0.0, cfg.TikvImporter.Throttle.MaxStoreCPUUsage)
+	require.Equal(t, 0.1, cfg.TikvImporter.Throttle.MaxLeaderDropRatio)
+}
+
+func TestAdjustBackendOverrides(t *testing.T) {
+	testCases := []struct {
+		input string
+		err   string
+	}{
+		{
+			input: `
+				[[tikv-importer.backend-override]]
+				tables = ["db.small_*"]
+				backend = "local"
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig]unsupported `backend` (local) in [[tikv-importer.backend-override]], only 'tidb' is supported",
+		},
+		{
+			input: `
+				[[tikv-importer.backend-override]]
+				backend = "tidb"
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig]`tables` in [[tikv-importer.backend-override]] must not be empty",
+		},
+		{
+			input: `
+				[tikv-importer]
+				on-duplicate = "overwrite"
+				[[tikv-importer.backend-override]]
+				tables = ["db.small_*"]
+				backend = "tidb"
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig]unsupported `tikv-importer.on-duplicate` (overwrite)",
+		},
+		{
+			input: `
+				[tikv-importer]
+				backend = "tidb"
+				[[tikv-importer.backend-override]]
+				tables = ["db.small_*"]
+				backend = "tidb"
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig][[tikv-importer.backend-override]] is only supported by the local backend",
+		},
+	}
+
+	for _, tc := range testCases {
+		cfg := config.NewConfig()
+		assignMinimalLegalValue(cfg)
+		cfg.TiDB.DistSQLScanConcurrency = 1
+		require.NoError(t, cfg.LoadFromTOML([]byte(tc.input)))
+		require.EqualError(t, cfg.Adjust(context.Background()), tc.err, tc.input)
+	}
+
+	cfg := config.NewConfig()
+	assignMinimalLegalValue(cfg)
+	cfg.TiDB.DistSQLScanConcurrency = 1
+	require.NoError(t, cfg.LoadFromTOML([]byte(`
+		[[tikv-importer.backend-override]]
+		tables = ["db.small_*", "ref.*"]
+		backend = "TiDB"
+	`)))
+	require.NoError(t, cfg.Adjust(context.Background()))
+	require.Equal(t, config.BackendLocal, cfg.TikvImporter.Backend)
+	require.Equal(t, []*config.BackendOverride{{
+		Tables:  []string{"db.small_*", "ref.*"},
+		Backend: config.BackendTiDB,
+	}}, cfg.TikvImporter.BackendOverrides)
+}
+
+func TestAdjustDuplicateReport(t *testing.T) {
+	testCases := []struct {
+		input string
+		err   string
+	}{
+		{
+			input: `
+				[tikv-importer]
+				duplicate-report-dir = "s3://bucket/duplicates"
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig]`tikv-importer.duplicate-report-dir` requires `tikv-importer.duplicate-resolution` to detect the duplicate records",
+		},
+		{
+			input: `
+				[app]
+				task-info-schema-name = ""
+				[tikv-importer]
+				duplicate-resolution = "record"
+				duplicate-report-dir = "s3://bucket/duplicates"
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig]`tikv-importer.duplicate-report-dir` requires `app.task-info-schema-name` to record the duplicate records",
+		},
+		{
+			input: `
+				[tikv-importer]
+				duplicate-resolution = "record"
+				duplicate-report-dir = "s3://bucket/duplicates"
+				duplicate-report-format = "xml"
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig]unsupported `tikv-importer.duplicate-report-format` (xml)",
+		},
+	}
+
+	for _, tc := range testCases {
+		cfg := config.NewConfig()
+		assignMinimalLegalValue(cfg)
+		cfg.TiDB.DistSQLScanConcurrency = 1
+		require.NoError(t, cfg.LoadFromTOML([]byte(tc.input)))
+		require.EqualError(t, cfg.Adjust(context.Background()), tc.err, tc.input)
+	}
+
+	cfg := config.NewConfig()
+	assignMinimalLegalValue(cfg)
+	cfg.TiDB.DistSQLScanConcurrency = 1
+	require.NoError(t, cfg.LoadFromTOML([]byte(`
+		[tikv-importer]
+		duplicate-resolution = "record"
+		duplicate-report-dir = "s3://bucket/duplicates"
+	`)))
+	require.NoError(t, cfg.Adjust(context.Background()))
+	require.Equal(t, config.DuplicateReportCSV, cfg.TikvImporter.DuplicateReportFormat)
+
+	cfg.TikvImporter.DuplicateReportFormat = "JSON"
+	require.NoError(t, cfg.CheckAndAdjustDuplicateReport())
+	require.Equal(t, config.DuplicateReportJSON, cfg.TikvImporter.DuplicateReportFormat)
+}
+
+func TestAdjustPostRestore(t *testing.T) {
+	cfg := config.NewConfig()
+	assignMinimalLegalValue(cfg)
+	cfg.TiDB.DistSQLScanConcurrency = 1
+	require.NoError(t, cfg.LoadFromTOML([]byte(`
+		[post-restore]
+		max-retry = -1
+	`)))
+	require.EqualError(t, cfg.Adjust(context.Background()), "[Lightning:Config:ErrInvalidConfig]`post-restore.max-retry` must not be negative")
+
+	cfg = config.NewConfig()
+	assignMinimalLegalValue(cfg)
+	cfg.TiDB.DistSQLScanConcurrency = 1
+	require.NoError(t, cfg.LoadFromTOML([]byte(`
+		[post-restore]
+		max-retry = 0
+		retry-backoff = "0s"
+		analyze-in-background = true
+	`)))
+	require.NoError(t, cfg.Adjust(context.Background()))
+	require.Equal(t, 0, cfg.PostRestore.MaxRetry)
+	require.Equal(t, time.Second, cfg.PostRestore.RetryBackoff.Duration)
+	require.True(t, cfg.PostRestore.AnalyzeInBackground)
+}
+
 func TestInvalidTOML(t *testing.T) {
 	cfg := &config.Config{}
 	err := cfg.LoadFromTOML([]byte(`
@@ -557,10 +996,20 @@ func TestDuplicateResolutionAlgorithm(t *testing.T) {
 	require.Equal(t, config.DupeResAlgNone, dra)
 	require.NoError(t, dra.FromStringValue("remove"))
 	require.Equal(t, config.DupeResAlgRemove, dra)
+	require.NoError(t, dra.FromStringValue("Replace"))
+	require.Equal(t, config.DupeResAlgReplace, dra)
+	require.NoError(t, dra.FromStringValue("ignore"))
+	require.Equal(t, config.DupeResAlgIgnore, dra)
+	require.NoError(t, dra.FromStringValue("error"))
+	require.Equal(t, config.DupeResAlgErr, dra)
+	require.Regexp(t, "invalid duplicate-resolution 'keep-first'", dra.FromStringValue("keep-first"))
 
 	require.Equal(t, "record", config.DupeResAlgRecord.String())
 	require.Equal(t, "none", config.DupeResAlgNone.String())
 	require.Equal(t, "remove", config.DupeResAlgRemove.String())
+	require.Equal(t, "replace", config.DupeResAlgReplace.String())
+	require.Equal(t, "ignore", config.DupeResAlgIgnore.String())
+	require.Equal(t, "error", config.DupeResAlgErr.String())
 }
 
 func TestLoadConfig(t *testing.T) {
@@ -837,6 +1286,40 @@ func TestDataCharacterSet(t *testing.T) {
 			`,
 			err: "",
 		},
+		{
+			input: `
+				[mydumper]
+				data-character-set = 'latin1'
+				data-invalid-char-policy = 'Error'
+			`,
+			err: "",
+		},
+		{
+			input: `
+				[mydumper]
+				data-character-set = 'gbk'
+				data-invalid-char-policy = 'ignore'
+			`,
+			err: "",
+		},
+		{
+			input: `
+				[mydumper]
+				data-invalid-char-policy = 'skip'
+			`,
+			err: "[Lightning:Config:ErrInvalidConfig]unsupported `mydumper.data-invalid-char-policy` (skip)",
+		},
+		{
+			input: `
+				[[mydumper.files]]
+				pattern = '(?i)^(?:[^/]*/)*([^/.]+)\.([^/.]+)\.gbk\.csv$'
+				schema = '$1'
+				table = '$2'
+				type = 'csv'
+				data-character-set = 'gbk'
+			`,
+			err: "",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -914,6 +1397,8 @@ func TestLoadCharsetFromConfig(t *testing.T) {
 		"Gbk":     config.GBK,
 		"gB18030": config.GB18030,
 		"GB18030": config.GB18030,
+		"latin1":  config.Latin1,
+		"LATIN1":  config.Latin1,
 	}
 	for k, v := range cases {
 		charset, err := config.ParseCharset(k)
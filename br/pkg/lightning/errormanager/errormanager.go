@@ -112,6 +112,13 @@ const (
 		WHERE table_name = ? AND _tidb_rowid >= ? and _tidb_rowid < ?
 		ORDER BY _tidb_rowid LIMIT ?;
 	`
+
+	selectConflictRecords = `
+		SELECT index_name, key_data, row_data, raw_key, raw_handle
+		FROM %s.` + conflictErrorTableName + `
+		WHERE task_id = ? AND table_name = ?
+		ORDER BY raw_key, _tidb_rowid;
+	`
 )
 
 type ErrorManager struct {
@@ -178,7 +185,8 @@ func (em *ErrorManager) Init(ctx context.Context) error {
 	return nil
 }
 
-// RecordTypeError records a type error.
+// RecordTypeError records a type error, the row is logged, and inserted into
+// the type error table if the task info schema is enabled.
 // If the number of recorded type errors exceed the max-error count, also returns `err` directly.
 func (em *ErrorManager) RecordTypeError(
 	ctx context.Context,
@@ -199,13 +207,17 @@ func (em *ErrorManager) RecordTypeError(
 		return encodeErr
 	}
 
-	if em.db != nil {
-		errMsg := encodeErr.Error()
-		logger = logger.With(
-			zap.Int64("offset", offset),
-			zap.String("row", redact.String(rowText)),
-			zap.String("message", errMsg))
+	errMsg := encodeErr.Error()
+	logger = logger.With(
+		zap.String("path", path),
+		zap.Int64("offset", offset),
+		zap.String("row", redact.String(rowText)),
+		zap.String("message", errMsg))
+	// the skipped rows are always kept in the log, since the task info schema
+	// may be disabled.
+	logger.Warn("skip the row with type error", zap.Int64("remainingErrors", em.remainingError.Type.Load()))
 
+	if em.db != nil {
 		// put it into the database.
 		exec := common.SQLWithRetry{
 			DB:           em.db,
@@ -413,6 +425,47 @@ func (em *ErrorManager) ResolveAllConflictKeys(
 	return errors.Trace(g.Wait())
 }
 
+// ConflictRecord is a conflicted key and the row it belongs to, recorded by
+// the duplicate detection. The records of the same conflicted key are the
+// rows conflicting with each other.
+type ConflictRecord struct {
+	IndexName string
+	KeyData   string
+	RowData   string
+	RawKey    []byte
+	RawHandle []byte
+}
+
+// ReadConflictRecords reads the conflict records of the table recorded by the
+// current task, ordered by the conflicted keys.
+func (em *ErrorManager) ReadConflictRecords(
+	ctx context.Context,
+	tableName string,
+	fn func(record *ConflictRecord) error,
+) error {
+	if em.db == nil {
+		return nil
+	}
+
+	rows, err := em.db.QueryContext(ctx, fmt.Sprintf(selectConflictRecords, em.schemaEscaped), em.taskID, tableName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	//nolint: errcheck
+	defer rows.Close()
+
+	for rows.Next() {
+		var record ConflictRecord
+		if err := rows.Scan(&record.IndexName, &record.KeyData, &record.RowData, &record.RawKey, &record.RawHandle); err != nil {
+			return errors.Trace(err)
+		}
+		if err := fn(&record); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return errors.Trace(rows.Err())
+}
+
 func (em *ErrorManager) errorCount(typeVal func(*config.MaxError) int64) int64 {
 	cfgVal := typeVal(em.configError)
 	val := typeVal(&em.remainingError)
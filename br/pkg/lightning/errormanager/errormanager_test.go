@@ -25,6 +25,7 @@ import (
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/br/pkg/lightning/config"
 	"github.com/pingcap/tidb/br/pkg/lightning/log"
 	"github.com/pingcap/tidb/br/pkg/utils"
@@ -82,6 +83,35 @@ func TestInit(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestRecordTypeError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	cfg := config.NewConfig()
+	cfg.TaskID = 42
+	cfg.App.MaxError.Type.Store(2)
+	cfg.App.TaskInfoSchemaName = "lightning_errors"
+	ctx := context.Background()
+	encodeErr := errors.New("mock type error")
+
+	em := New(db, cfg, log.L())
+	mock.ExpectExec("INSERT INTO `lightning_errors`\\.type_error_v1.*").
+		WithArgs(int64(42), "`test`.`t`", "test.t.csv", int64(10), "mock type error", "(1)").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	require.NoError(t, em.RecordTypeError(ctx, log.L(), "`test`.`t`", "test.t.csv", 10, "(1)", encodeErr))
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, int64(1), em.TypeErrorsRemain())
+
+	// the rows are skipped even if the task info schema is disabled.
+	em.db = nil
+	require.NoError(t, em.RecordTypeError(ctx, log.L(), "`test`.`t`", "test.t.csv", 20, "(2)", encodeErr))
+	require.True(t, em.HasError())
+
+	// the budget is exhausted.
+	err = em.RecordTypeError(ctx, log.L(), "`test`.`t`", "test.t.csv", 30, "(3)", encodeErr)
+	require.EqualError(t, err, "meet errors exceed the max-error.type threshold '2': mock type error")
+}
+
 type mockDriver struct {
 	driver.Driver
 	totalRows int64
@@ -180,6 +210,36 @@ func TestResolveAllConflictKeys(t *testing.T) {
 	require.Equal(t, totalRows, resolved.Load())
 }
 
+func TestReadConflictRecords(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	cfg := config.NewConfig()
+	cfg.TaskID = 1
+	cfg.TikvImporter.DuplicateResolution = config.DupeResAlgRecord
+	cfg.App.TaskInfoSchemaName = "lightning_errors"
+	em := New(db, cfg, log.L())
+
+	mock.ExpectQuery("SELECT index_name, key_data, row_data, raw_key, raw_handle FROM `lightning_errors`\\.conflict_error_v1 WHERE task_id = \\? AND table_name = \\? ORDER BY raw_key, _tidb_rowid").
+		WithArgs(int64(1), "`db`.`t`").
+		WillReturnRows(sqlmock.NewRows([]string{"index_name", "key_data", "row_data", "raw_key", "raw_handle"}).
+			AddRow("uk", "1", "(1, 'a')", []byte("k1"), []byte("h1")).
+			AddRow("uk", "2", "(2, 'a')", []byte("k1"), []byte("h2")))
+
+	var records []ConflictRecord
+	err = em.ReadConflictRecords(context.Background(), "`db`.`t`", func(record *ConflictRecord) error {
+		records = append(records, *record)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []ConflictRecord{
+		{IndexName: "uk", KeyData: "1", RowData: "(1, 'a')", RawKey: []byte("k1"), RawHandle: []byte("h1")},
+		{IndexName: "uk", KeyData: "2", RowData: "(2, 'a')", RawKey: []byte("k1"), RawHandle: []byte("h2")},
+	}, records)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestErrorMgrHasError(t *testing.T) {
 	cfg := &config.Config{}
 	cfg.App.MaxError = config.MaxError{
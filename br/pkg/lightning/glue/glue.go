@@ -188,4 +188,6 @@ func (e *ExternalTiDBGlue) Record(string, uint64) {
 const (
 	RecordEstimatedChunk = "EstimatedChunk"
 	RecordFinishedChunk  = "FinishedChunk"
+	// RecordEstimatedFinishTime is the estimated unix time in seconds when the task finishes.
+	RecordEstimatedFinishTime = "EstimatedFinishTime"
 )
@@ -28,11 +28,13 @@ import (
 	"net/http"
 	"net/http/pprof"
 	"os"
+	"runtime"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/docker/go-units"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/kvproto/pkg/import_sstpb"
@@ -47,12 +49,14 @@ import (
 	"github.com/pingcap/tidb/br/pkg/lightning/restore"
 	"github.com/pingcap/tidb/br/pkg/lightning/tikv"
 	"github.com/pingcap/tidb/br/pkg/lightning/web"
+	"github.com/pingcap/tidb/br/pkg/progress"
 	"github.com/pingcap/tidb/br/pkg/redact"
 	"github.com/pingcap/tidb/br/pkg/storage"
 	"github.com/pingcap/tidb/br/pkg/utils"
 	"github.com/pingcap/tidb/br/pkg/version/build"
 	_ "github.com/pingcap/tidb/expression" // get rid of `import cycle`: just init expression.RewriteAstExpr,and called at package `backend.kv`.
 	_ "github.com/pingcap/tidb/planner/core"
+	"github.com/pingcap/tidb/util/memory"
 	"github.com/pingcap/tidb/util/promutil"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
@@ -198,6 +202,8 @@ func (l *Lightning) goServe(statusAddr string, realAddrWriter io.Writer) error {
 	registry := l.promRegistry
 	registry.MustRegister(collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
 	registry.MustRegister(collectors.NewGoCollector())
+	// export the progress of the task and the tables.
+	registry.MustRegister(progress.NewCollector("lightning", progress.DefaultRegistry, 2))
 	if gatherer, ok := registry.(prometheus.Gatherer); ok {
 		handler := promhttp.InstrumentMetricHandler(
 			registry, promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}),
@@ -224,8 +230,15 @@ func (l *Lightning) goServe(statusAddr string, realAddrWriter io.Writer) error {
 	mux.Handle("/tasks/", httpHandleWrapper(handleTasks.ServeHTTP))
 	mux.HandleFunc("/progress/task", httpHandleWrapper(handleProgressTask))
 	mux.HandleFunc("/progress/table", httpHandleWrapper(handleProgressTable))
+	mux.HandleFunc("/progress/chunks", httpHandleWrapper(handleProgressChunks))
+	mux.Handle("/progress/tree", progress.DefaultRegistry)
 	mux.HandleFunc("/pause", httpHandleWrapper(handlePause))
 	mux.HandleFunc("/resume", httpHandleWrapper(handleResume))
+	mux.HandleFunc("/control/tables", httpHandleWrapper(handleControlTables))
+	mux.HandleFunc("/control/table", httpHandleWrapper(handleControlTable))
+	mux.HandleFunc("/control/engine", httpHandleWrapper(handleControlEngine))
+	mux.HandleFunc("/control/concurrency", httpHandleWrapper(handleControlConcurrency))
+	mux.HandleFunc("/duplicates", httpHandleWrapper(l.handleDuplicates))
 	mux.HandleFunc("/loglevel", httpHandleWrapper(handleLogLevel))
 
 	mux.Handle("/web/", http.StripPrefix("/web", httpgzip.FileServer(web.Res, httpgzip.FileServerOptions{
@@ -479,6 +492,9 @@ func (l *Lightning) run(taskCtx context.Context, taskCfg *config.Config, o *opti
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if taskCfg.App.AutoTune && taskCfg.TikvImporter.Backend == config.BackendLocal {
+		autoTuneConfig(taskCfg, o.logger)
+	}
 	err = checkSystemRequirement(taskCfg, mdl.GetDatabases())
 	if err != nil {
 		o.logger.Error("check system requirements failed", zap.Error(err))
@@ -796,6 +812,60 @@ func handleProgressTable(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+func handleProgressChunks(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	tableName := req.URL.Query().Get("t")
+	res, err := web.MarshalTableChunkProgress(tableName)
+	if err == nil {
+		writeBytesCompressed(w, req, res)
+	} else {
+		if errors.IsNotFound(err) {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		_ = json.NewEncoder(w).Encode(err.Error())
+	}
+}
+
+// handleDuplicates serves the duplicate report of the table exported by the
+// current or the last task.
+func (l *Lightning) handleDuplicates(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Allow", http.MethodGet)
+		writeJSONError(w, http.StatusMethodNotAllowed, "only GET is allowed", nil)
+		return
+	}
+
+	l.cancelLock.Lock()
+	taskCfg := l.curTask
+	l.cancelLock.Unlock()
+	if taskCfg == nil {
+		w.Header().Set("Content-Type", "application/json")
+		writeJSONError(w, http.StatusNotFound, "no task has been run", nil)
+		return
+	}
+
+	query := req.URL.Query()
+	res, err := restore.ReadDuplicateReport(req.Context(), taskCfg, query.Get("schema"), query.Get("table"))
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		if errors.IsNotFound(err) {
+			writeJSONError(w, http.StatusNotFound, "duplicate report not found", err)
+		} else {
+			writeJSONError(w, http.StatusInternalServerError, "failed to read duplicate report", err)
+		}
+		return
+	}
+	if taskCfg.TikvImporter.DuplicateReportFormat == config.DuplicateReportJSON {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	} else {
+		w.Header().Set("Content-Type", "text/csv")
+	}
+	writeBytesCompressed(w, req, res)
+}
+
 func handlePause(w http.ResponseWriter, req *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 
@@ -832,6 +902,124 @@ func handleResume(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// Control operations on the tables and the engines.
+const (
+	controlOpPause  = "pause"
+	controlOpResume = "resume"
+	controlOpAbort  = "abort"
+)
+
+func writeControlResult(w http.ResponseWriter, err error) {
+	switch {
+	case err == nil:
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("{}"))
+	case errors.IsNotFound(err):
+		writeJSONError(w, http.StatusNotFound, "not found", err)
+	default:
+		writeJSONError(w, http.StatusBadRequest, "invalid control request", err)
+	}
+}
+
+func handleControlTables(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	switch req.Method {
+	case http.MethodGet:
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(restore.DefaultImportControl.Status())
+
+	default:
+		w.Header().Set("Allow", http.MethodGet)
+		writeJSONError(w, http.StatusMethodNotAllowed, "only GET is allowed", nil)
+	}
+}
+
+func handleControlTable(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if req.Method != http.MethodPut {
+		w.Header().Set("Allow", http.MethodPut)
+		writeJSONError(w, http.StatusMethodNotAllowed, "only PUT is allowed", nil)
+		return
+	}
+
+	query := req.URL.Query()
+	tableName := query.Get("t")
+	ctl := restore.DefaultImportControl
+	var err error
+	switch op := query.Get("op"); op {
+	case controlOpPause:
+		err = ctl.PauseTable(tableName)
+	case controlOpResume:
+		err = ctl.ResumeTable(tableName)
+	case controlOpAbort:
+		err = ctl.AbortTable(tableName)
+	default:
+		writeJSONError(w, http.StatusBadRequest, "unknown control operation", errors.Errorf("op '%s'", op))
+		return
+	}
+	log.L().Info("control table", zap.String("table", tableName), zap.String("op", query.Get("op")), log.ShortError(err))
+	writeControlResult(w, err)
+}
+
+func handleControlEngine(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if req.Method != http.MethodPut {
+		w.Header().Set("Allow", http.MethodPut)
+		writeJSONError(w, http.StatusMethodNotAllowed, "only PUT is allowed", nil)
+		return
+	}
+
+	query := req.URL.Query()
+	tableName := query.Get("t")
+	engineID, err := strconv.ParseInt(query.Get("e"), 10, 32)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid engine ID", err)
+		return
+	}
+	ctl := restore.DefaultImportControl
+	switch op := query.Get("op"); op {
+	case controlOpPause:
+		err = ctl.PauseEngine(tableName, int32(engineID))
+	case controlOpResume:
+		err = ctl.ResumeEngine(tableName, int32(engineID))
+	case controlOpAbort:
+		err = ctl.AbortEngine(tableName, int32(engineID))
+	default:
+		writeJSONError(w, http.StatusBadRequest, "unknown control operation", errors.Errorf("op '%s'", op))
+		return
+	}
+	log.L().Info("control engine", zap.String("table", tableName), zap.Int64("engineID", engineID),
+		zap.String("op", query.Get("op")), log.ShortError(err))
+	writeControlResult(w, err)
+}
+
+func handleControlConcurrency(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	switch req.Method {
+	case http.MethodGet:
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(restore.DefaultImportControl.Concurrency())
+
+	case http.MethodPut, http.MethodPost:
+		var concurrency map[string]int
+		if err := json.NewDecoder(req.Body).Decode(&concurrency); err != nil {
+			writeJSONError(w, http.StatusBadRequest, "invalid concurrency", err)
+			return
+		}
+		err := restore.DefaultImportControl.SetConcurrency(concurrency)
+		log.L().Info("adjust concurrency", zap.Any("concurrency", concurrency), log.ShortError(err))
+		writeControlResult(w, err)
+
+	default:
+		w.Header().Set("Allow", http.MethodGet+", "+http.MethodPut+", "+http.MethodPost)
+		writeJSONError(w, http.StatusMethodNotAllowed, "only GET, PUT and POST are allowed", nil)
+	}
+}
+
 func handleLogLevel(w http.ResponseWriter, req *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 
@@ -864,6 +1052,45 @@ func handleLogLevel(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// calibrationWriteSize is the size of data written to the sorted-kv-dir to
+// measure its throughput.
+const calibrationWriteSize = 64 * units.MiB
+
+// autoTuneConfig measures the resources of the host and tunes the items of the
+// local backend which aren't defined by the user. The failed measurements are
+// ignored.
+func autoTuneConfig(cfg *config.Config, logger log.Logger) {
+	res := config.HostResources{CPUs: runtime.NumCPU()}
+	total, err := memory.MemTotal()
+	if err == nil {
+		var used uint64
+		used, err = memory.MemUsed()
+		if err == nil && total > used {
+			res.AvailableMemory = total - used
+		}
+	}
+	if err != nil {
+		logger.Warn("failed to get the available memory, skip tuning the buffer sizes", log.ShortError(err))
+	}
+	res.DiskThroughput, err = common.MeasureWriteThroughput(cfg.TikvImporter.SortedKVDir, calibrationWriteSize)
+	if err != nil {
+		logger.Warn("failed to measure the throughput of sorted-kv-dir, skip tuning the range concurrency",
+			zap.String("dir", cfg.TikvImporter.SortedKVDir), log.ShortError(err))
+	}
+
+	tuned := cfg.AutoTune(res)
+	logger.Info("auto-tuned the config from the host resources",
+		zap.Int("cpus", res.CPUs),
+		zap.Uint64("availableMemory", res.AvailableMemory),
+		zap.Float64("diskThroughput", res.DiskThroughput),
+		zap.Strings("tunedItems", tuned),
+		zap.Int("regionConcurrency", cfg.App.RegionConcurrency),
+		zap.Int("ioConcurrency", cfg.App.IOConcurrency),
+		zap.Int64("localWriterMemCacheSize", int64(cfg.TikvImporter.LocalWriterMemCacheSize)),
+		zap.Int64("engineMemCacheSize", int64(cfg.TikvImporter.EngineMemCacheSize)),
+		zap.Int("rangeConcurrency", cfg.TikvImporter.RangeConcurrency))
+}
+
 func checkSystemRequirement(cfg *config.Config, dbsMeta []*mydump.MDDatabaseMeta) error {
 	// in local mode, we need to read&write a lot of L0 sst files, so we need to check system max open files limit
 	if cfg.TikvImporter.Backend == config.BackendLocal {
@@ -376,3 +376,24 @@ func TestHTTPAPIOutsideServerMode(t *testing.T) {
 	// ... and the task should be canceled now.
 	require.Equal(t, context.Canceled, <-errCh)
 }
+
+func TestControlAPIWithoutTable(t *testing.T) {
+	s := createSuite(t)
+	ctx := context.Background()
+
+	client, err := NewClient(s.lightning.serverAddr.String(), nil)
+	require.NoError(t, err)
+
+	_, err = client.Tables(ctx)
+	require.NoError(t, err)
+	_, err = client.Concurrency(ctx)
+	require.NoError(t, err)
+
+	// the unknown tables, engines and worker pools are not found.
+	err = client.PauseTable(ctx, "db", "no_such_table")
+	require.ErrorContains(t, err, "not found")
+	err = client.AbortEngine(ctx, "db", "no_such_table", 0)
+	require.ErrorContains(t, err, "not found")
+	err = client.SetConcurrency(ctx, map[string]int{"no_such_pool": 4})
+	require.ErrorContains(t, err, "not found")
+}
@@ -51,6 +51,16 @@ const (
 
 	BlockDeliverKindIndex = "index"
 	BlockDeliverKindData  = "data"
+
+	// phases used for the ThroughputGauge labels
+	ThroughputPhaseEncode   = "encode"
+	ThroughputPhaseSort     = "sort"
+	ThroughputPhaseIngest   = "ingest"
+	ThroughputPhaseChecksum = "checksum"
+
+	// EstimatedRemainingAll is the EstimatedRemainingSecondsGauge label of the whole task,
+	// the other labels are the table names.
+	EstimatedRemainingAll = "all"
 )
 
 type Metrics struct {
@@ -74,6 +84,8 @@ type Metrics struct {
 	ChecksumSecondsHistogram             prometheus.Histogram
 	LocalStorageUsageBytesGauge          *prometheus.GaugeVec
 	ProgressGauge                        *prometheus.GaugeVec
+	ThroughputGauge                      *prometheus.GaugeVec
+	EstimatedRemainingSecondsGauge       *prometheus.GaugeVec
 }
 
 // NewMetrics creates a new empty metrics.
@@ -231,6 +243,20 @@ func NewMetrics(factory promutil.Factory) *Metrics {
 				Name:      "progress",
 				Help:      "progress of lightning phase",
 			}, []string{"phase"}),
+
+		ThroughputGauge: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "lightning",
+				Name:      "throughput_bytes",
+				Help:      "source data bytes processed per second in the recent window of the import phase",
+			}, []string{"phase"}),
+
+		EstimatedRemainingSecondsGauge: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "lightning",
+				Name:      "estimated_remaining_seconds",
+				Help:      "estimated remaining seconds to finish importing the table or the whole task",
+			}, []string{"table"}),
 	}
 }
 
@@ -257,6 +283,8 @@ func (m *Metrics) RegisterTo(r promutil.Registry) {
 		m.ChecksumSecondsHistogram,
 		m.LocalStorageUsageBytesGauge,
 		m.ProgressGauge,
+		m.ThroughputGauge,
+		m.EstimatedRemainingSecondsGauge,
 	)
 }
 
@@ -282,6 +310,8 @@ func (m *Metrics) UnregisterFrom(r promutil.Registry) {
 	r.Unregister(m.ChecksumSecondsHistogram)
 	r.Unregister(m.LocalStorageUsageBytesGauge)
 	r.Unregister(m.ProgressGauge)
+	r.Unregister(m.ThroughputGauge)
+	r.Unregister(m.EstimatedRemainingSecondsGauge)
 }
 
 func (m *Metrics) RecordTableCount(status string, err error) {
@@ -0,0 +1,1069 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydump
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/golang/snappy"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/common"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/lightning/log"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"go.uber.org/zap"
+)
+
+// The avro data files are the object container files, which are a header
+// followed by the blocks of records. The records in a block are encoded by the
+// schema in the header, and the block might be compressed by the codec in the
+// header.
+//
+// See: https://avro.apache.org/docs/1.11.1/specification/#object-container-files
+
+const (
+	avroMagic    = "Obj\x01"
+	avroSyncSize = 16
+
+	avroSchemaKey = "avro.schema"
+	avroCodecKey  = "avro.codec"
+
+	avroCodecNull    = "null"
+	avroCodecDeflate = "deflate"
+	avroCodecSnappy  = "snappy"
+)
+
+// the types of the avro schema.
+const (
+	avroNull    = "null"
+	avroBoolean = "boolean"
+	avroInt     = "int"
+	avroLong    = "long"
+	avroFloat   = "float"
+	avroDouble  = "double"
+	avroBytes   = "bytes"
+	avroString  = "string"
+	avroRecord  = "record"
+	avroEnum    = "enum"
+	avroArray   = "array"
+	avroMap     = "map"
+	avroFixed   = "fixed"
+	avroUnion   = "union"
+)
+
+// avroSchema is a node of the schema of an avro file.
+type avroSchema struct {
+	typ       string
+	name      string
+	logical   string
+	precision int
+	scale     int
+	// size is the size of a fixed.
+	size int
+	// fields are the fields of a record.
+	fields []*avroField
+	// symbols are the symbols of an enum.
+	symbols []string
+	// items is the schema of the items of an array or the values of a map.
+	items *avroSchema
+	// branches are the schemas of a union.
+	branches []*avroSchema
+}
+
+type avroField struct {
+	name   string
+	schema *avroSchema
+}
+
+// nonNullBranch returns the only non-null branch of a union, or nil if the
+// union has more than one non-null branches.
+func (s *avroSchema) nonNullBranch() *avroSchema {
+	var res *avroSchema
+	for _, b := range s.branches {
+		if b.typ == avroNull {
+			continue
+		}
+		if res != nil {
+			return nil
+		}
+		res = b
+	}
+	return res
+}
+
+type avroSchemaParser struct {
+	// named are the named types (records, enums and fixeds) by the full names.
+	named map[string]*avroSchema
+}
+
+func parseAvroSchema(data []byte) (*avroSchema, error) {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, errors.Annotate(err, "invalid avro schema")
+	}
+	p := &avroSchemaParser{named: make(map[string]*avroSchema)}
+	return p.parse(v, "")
+}
+
+func (p *avroSchemaParser) parse(v interface{}, namespace string) (*avroSchema, error) {
+	switch t := v.(type) {
+	case string:
+		switch t {
+		case avroNull, avroBoolean, avroInt, avroLong, avroFloat, avroDouble, avroBytes, avroString:
+			return &avroSchema{typ: t}, nil
+		}
+		if s, ok := p.named[t]; ok {
+			return s, nil
+		}
+		if s, ok := p.named[namespace+"."+t]; ok && namespace != "" {
+			return s, nil
+		}
+		return nil, errors.Errorf("unknown avro type '%s'", t)
+	case []interface{}:
+		s := &avroSchema{typ: avroUnion, branches: make([]*avroSchema, 0, len(t))}
+		for _, b := range t {
+			branch, err := p.parse(b, namespace)
+			if err != nil {
+				return nil, err
+			}
+			s.branches = append(s.branches, branch)
+		}
+		return s, nil
+	case map[string]interface{}:
+		return p.parseComplex(t, namespace)
+	default:
+		return nil, errors.Errorf("invalid avro schema %v", v)
+	}
+}
+
+func (p *avroSchemaParser) parseComplex(v map[string]interface{}, namespace string) (*avroSchema, error) {
+	typ, ok := v["type"].(string)
+	if !ok {
+		// the type is a schema itself, e.g. {"type": {"type": "array", ...}}.
+		return p.parse(v["type"], namespace)
+	}
+	logical, _ := v["logicalType"].(string)
+	s := &avroSchema{typ: typ, logical: logical}
+	if precision, ok := v["precision"].(float64); ok {
+		s.precision = int(precision)
+	}
+	if scale, ok := v["scale"].(float64); ok {
+		s.scale = int(scale)
+	}
+
+	switch typ {
+	case avroRecord, "error", avroEnum, avroFixed:
+		name, _ := v["name"].(string)
+		if name == "" {
+			return nil, errors.Errorf("the avro %s has no name", typ)
+		}
+		if ns, ok := v["namespace"].(string); ok {
+			namespace = ns
+		}
+		if i := strings.LastIndexByte(name, '.'); i >= 0 {
+			namespace = name[:i]
+		} else if namespace != "" {
+			name = namespace + "." + name
+		}
+		s.name = name
+		// register the name before parsing the fields, which might refer to
+		// the type itself.
+		p.named[name] = s
+	}
+
+	switch typ {
+	case avroRecord, "error":
+		s.typ = avroRecord
+		fields, _ := v["fields"].([]interface{})
+		for _, f := range fields {
+			field, ok := f.(map[string]interface{})
+			if !ok {
+				return nil, errors.Errorf("invalid field %v of the avro record '%s'", f, s.name)
+			}
+			name, _ := field["name"].(string)
+			fieldSchema, err := p.parse(field["type"], namespace)
+			if err != nil {
+				return nil, errors.Annotatef(err, "invalid field '%s' of the avro record '%s'", name, s.name)
+			}
+			s.fields = append(s.fields, &avroField{name: name, schema: fieldSchema})
+		}
+	case avroEnum:
+		symbols, _ := v["symbols"].([]interface{})
+		for _, symbol := range symbols {
+			s.symbols = append(s.symbols, fmt.Sprint(symbol))
+		}
+	case avroFixed:
+		size, ok := v["size"].(float64)
+		if !ok {
+			return nil, errors.Errorf("the avro fixed '%s' has no size", s.name)
+		}
+		s.size = int(size)
+	case avroArray:
+		items, err := p.parse(v["items"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		s.items = items
+	case avroMap:
+		values, err := p.parse(v["values"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		s.items = values
+	case avroNull, avroBoolean, avroInt, avroLong, avroFloat, avroDouble, avroBytes, avroString:
+	default:
+		return p.parse(typ, namespace)
+	}
+	return s, nil
+}
+
+var errAvroTruncated = errors.New("the avro data is truncated")
+
+// avroDecoder decodes the values in a block of an avro file.
+type avroDecoder struct {
+	buf []byte
+	pos int
+}
+
+func (d *avroDecoder) readLong() (int64, error) {
+	// the avro long is the zig-zag varint, the same as the go varint.
+	v, n := binary.Varint(d.buf[d.pos:])
+	if n <= 0 {
+		return 0, errAvroTruncated
+	}
+	d.pos += n
+	return v, nil
+}
+
+func (d *avroDecoder) readFixed(size int) ([]byte, error) {
+	if size < 0 || len(d.buf)-d.pos < size {
+		return nil, errAvroTruncated
+	}
+	b := d.buf[d.pos : d.pos+size]
+	d.pos += size
+	return b, nil
+}
+
+func (d *avroDecoder) readBytes() ([]byte, error) {
+	size, err := d.readLong()
+	if err != nil {
+		return nil, err
+	}
+	return d.readFixed(int(size))
+}
+
+// readBlockCount reads the count of the items in the next block of an array
+// or a map.
+func (d *avroDecoder) readBlockCount() (int64, error) {
+	count, err := d.readLong()
+	if err != nil || count >= 0 {
+		return count, err
+	}
+	// the negative count is followed by the size of the block in bytes.
+	if _, err = d.readLong(); err != nil {
+		return 0, err
+	}
+	return -count, nil
+}
+
+// readValue reads a value of the schema. The values of the logical types are
+// converted to the values which TiDB accepts, the records and the maps are
+// read as map[string]interface{}, and the arrays are read as []interface{}.
+func (d *avroDecoder) readValue(s *avroSchema) (interface{}, error) {
+	switch s.typ {
+	case avroNull:
+		return nil, nil
+	case avroBoolean:
+		b, err := d.readFixed(1)
+		if err != nil {
+			return nil, err
+		}
+		return b[0] != 0, nil
+	case avroInt, avroLong:
+		v, err := d.readLong()
+		if err != nil {
+			return nil, err
+		}
+		return avroLogicalInt(v, s), nil
+	case avroFloat:
+		b, err := d.readFixed(4)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float32frombits(binary.LittleEndian.Uint32(b)), nil
+	case avroDouble:
+		b, err := d.readFixed(8)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+	case avroString:
+		b, err := d.readBytes()
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	case avroBytes, avroFixed:
+		var b []byte
+		var err error
+		if s.typ == avroFixed {
+			b, err = d.readFixed(s.size)
+		} else {
+			b, err = d.readBytes()
+		}
+		if err != nil {
+			return nil, err
+		}
+		if s.logical == "decimal" {
+			// binaryToDecimalStr modifies the bytes of the negative values.
+			return json.Number(binaryToDecimalStr(append([]byte(nil), b...), s.scale)), nil
+		}
+		return b, nil
+	case avroEnum:
+		idx, err := d.readLong()
+		if err != nil {
+			return nil, err
+		}
+		if idx < 0 || idx >= int64(len(s.symbols)) {
+			return nil, errors.Errorf("invalid index %d of the avro enum '%s'", idx, s.name)
+		}
+		return s.symbols[idx], nil
+	case avroUnion:
+		branch, err := d.readBranch(s)
+		if err != nil {
+			return nil, err
+		}
+		return d.readValue(branch)
+	case avroRecord:
+		values := make(map[string]interface{}, len(s.fields))
+		for _, f := range s.fields {
+			v, err := d.readValue(f.schema)
+			if err != nil {
+				return nil, err
+			}
+			values[f.name] = v
+		}
+		return values, nil
+	case avroArray:
+		values := make([]interface{}, 0)
+		for {
+			count, err := d.readBlockCount()
+			if err != nil {
+				return nil, err
+			}
+			if count == 0 {
+				return values, nil
+			}
+			for i := int64(0); i < count; i++ {
+				v, err := d.readValue(s.items)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, v)
+			}
+		}
+	case avroMap:
+		values := make(map[string]interface{})
+		for {
+			count, err := d.readBlockCount()
+			if err != nil {
+				return nil, err
+			}
+			if count == 0 {
+				return values, nil
+			}
+			for i := int64(0); i < count; i++ {
+				key, err := d.readBytes()
+				if err != nil {
+					return nil, err
+				}
+				v, err := d.readValue(s.items)
+				if err != nil {
+					return nil, err
+				}
+				values[string(key)] = v
+			}
+		}
+	default:
+		return nil, errors.Errorf("unknown avro type '%s'", s.typ)
+	}
+}
+
+// readBranch reads the index of the branch of a union.
+func (d *avroDecoder) readBranch(s *avroSchema) (*avroSchema, error) {
+	idx, err := d.readLong()
+	if err != nil {
+		return nil, err
+	}
+	if idx < 0 || idx >= int64(len(s.branches)) {
+		return nil, errors.Errorf("invalid branch %d of the avro union", idx)
+	}
+	return s.branches[idx], nil
+}
+
+// avroLogicalInt converts an int or a long to the value of its logical type.
+func avroLogicalInt(v int64, s *avroSchema) interface{} {
+	switch s.logical {
+	case "date":
+		return time.Unix(v*86400, 0).UTC().Format("2006-01-02")
+	case "time-millis":
+		return formatAvroTimeOfDay(time.Duration(v) * time.Millisecond)
+	case "time-micros":
+		return formatAvroTimeOfDay(time.Duration(v) * time.Microsecond)
+	case "timestamp-millis":
+		return time.UnixMilli(v).UTC().Format("2006-01-02 15:04:05.999999Z")
+	case "timestamp-micros":
+		return time.UnixMicro(v).UTC().Format("2006-01-02 15:04:05.999999Z")
+	case "local-timestamp-millis":
+		return time.UnixMilli(v).UTC().Format("2006-01-02 15:04:05.999999")
+	case "local-timestamp-micros":
+		return time.UnixMicro(v).UTC().Format("2006-01-02 15:04:05.999999")
+	default:
+		return v
+	}
+}
+
+func formatAvroTimeOfDay(d time.Duration) string {
+	return time.Unix(0, int64(d)).UTC().Format("15:04:05.999999")
+}
+
+// avroFileReader reads the blocks of an avro file.
+type avroFileReader struct {
+	r      *bufio.Reader
+	schema *avroSchema
+	codec  string
+	sync   [avroSyncSize]byte
+}
+
+func newAvroFileReader(r io.Reader) (*avroFileReader, error) {
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(avroMagic))
+	if _, err := io.ReadFull(br, magic); err != nil {
+		return nil, errors.Annotate(err, "failed to read the header of the avro file")
+	}
+	if string(magic) != avroMagic {
+		return nil, errors.New("not an avro object container file")
+	}
+	reader := &avroFileReader{r: br, codec: avroCodecNull}
+	meta, err := reader.readMeta()
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to read the header of the avro file")
+	}
+	if _, err = io.ReadFull(br, reader.sync[:]); err != nil {
+		return nil, errors.Annotate(err, "failed to read the header of the avro file")
+	}
+	if codec, ok := meta[avroCodecKey]; ok && len(codec) > 0 {
+		reader.codec = string(codec)
+	}
+	switch reader.codec {
+	case avroCodecNull, avroCodecDeflate, avroCodecSnappy:
+	default:
+		return nil, errors.Errorf("unsupported avro codec '%s'", reader.codec)
+	}
+	if reader.schema, err = parseAvroSchema(meta[avroSchemaKey]); err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+func (r *avroFileReader) readLong() (int64, error) {
+	return binary.ReadVarint(r.r)
+}
+
+func (r *avroFileReader) readBytes() ([]byte, error) {
+	size, err := r.readLong()
+	if err != nil {
+		return nil, err
+	}
+	if size < 0 {
+		return nil, errAvroTruncated
+	}
+	b := make([]byte, size)
+	_, err = io.ReadFull(r.r, b)
+	return b, err
+}
+
+// readMeta reads the metadata in the header, which is a map of bytes.
+func (r *avroFileReader) readMeta() (map[string][]byte, error) {
+	meta := make(map[string][]byte)
+	for {
+		count, err := r.readLong()
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			return meta, nil
+		}
+		if count < 0 {
+			count = -count
+			if _, err = r.readLong(); err != nil {
+				return nil, err
+			}
+		}
+		for i := int64(0); i < count; i++ {
+			key, err := r.readBytes()
+			if err != nil {
+				return nil, err
+			}
+			value, err := r.readBytes()
+			if err != nil {
+				return nil, err
+			}
+			meta[string(key)] = value
+		}
+	}
+}
+
+// readBlockHeader reads the count of the records and the size of the next
+// block, it returns io.EOF at the end of the file.
+func (r *avroFileReader) readBlockHeader() (count int64, size int64, err error) {
+	count, err = r.readLong()
+	if err != nil {
+		// the file ends at the boundary of the blocks.
+		return 0, 0, err
+	}
+	if size, err = r.readLong(); err != nil {
+		return 0, 0, errors.Trace(err)
+	}
+	if count < 0 || size < 0 {
+		return 0, 0, errors.Errorf("invalid avro block with %d records in %d bytes", count, size)
+	}
+	return count, size, nil
+}
+
+func (r *avroFileReader) checkSync() error {
+	var sync [avroSyncSize]byte
+	if _, err := io.ReadFull(r.r, sync[:]); err != nil {
+		return errors.Trace(err)
+	}
+	if sync != r.sync {
+		return errors.New("the sync marker of the avro block mismatches")
+	}
+	return nil
+}
+
+// readBlock reads the data of the next block after its header, and
+// decompresses the data.
+func (r *avroFileReader) readBlock(size int64) ([]byte, error) {
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := r.checkSync(); err != nil {
+		return nil, err
+	}
+	switch r.codec {
+	case avroCodecDeflate:
+		res, err := io.ReadAll(flate.NewReader(bytes.NewReader(data)))
+		return res, errors.Trace(err)
+	case avroCodecSnappy:
+		// the snappy block is followed by the big-endian CRC32 checksum of the
+		// uncompressed data.
+		if len(data) < 4 {
+			return nil, errAvroTruncated
+		}
+		res, err := snappy.Decode(nil, data[:len(data)-4])
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if crc32.ChecksumIEEE(res) != binary.BigEndian.Uint32(data[len(data)-4:]) {
+			return nil, errors.New("the checksum of the avro block mismatches")
+		}
+		return res, nil
+	default:
+		return data, nil
+	}
+}
+
+// skipBlock skips the data of the next block after its header.
+func (r *avroFileReader) skipBlock(size int64) error {
+	if _, err := r.r.Discard(int(size)); err != nil {
+		return errors.Trace(err)
+	}
+	return r.checkSync()
+}
+
+// ReadAvroFileRowCount reads the row count of an avro file from the headers
+// of its blocks.
+func ReadAvroFileRowCount(r io.Reader) (int64, error) {
+	reader, err := newAvroFileReader(r)
+	if err != nil {
+		return 0, err
+	}
+	var rows int64
+	for {
+		count, size, err := reader.readBlockHeader()
+		if errors.Cause(err) == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		if err = reader.skipBlock(size); err != nil {
+			return 0, err
+		}
+		rows += count
+	}
+}
+
+// avroColumnName returns the lower-case column name of an avro field.
+func avroColumnName(name string, cfg *config.AvroConfig) string {
+	if column, ok := cfg.ColumnMapping[name]; ok {
+		return strings.ToLower(column)
+	}
+	if cfg.ColumnNameMatch == config.AvroMatchSnakeCase {
+		return toSnakeCase(name)
+	}
+	return strings.ToLower(name)
+}
+
+// toSnakeCase converts a camel case name to the lower-case snake case, e.g.
+// "userID" to "user_id" and "HTTPStatus" to "http_status".
+func toSnakeCase(name string) string {
+	runes := []rune(name)
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prev != '_' && (unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower)) {
+				sb.WriteByte('_')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return sb.String()
+}
+
+// avroRowSchema returns the indices of the fields on the path of the nested
+// record which holds the row, and the schema of the nested record.
+func avroRowSchema(s *avroSchema, recordField string) ([]int, *avroSchema, error) {
+	if s.typ != avroRecord {
+		return nil, nil, errors.Errorf("the schema of the avro file is %s but not a record", s.typ)
+	}
+	if recordField == "" {
+		return nil, s, nil
+	}
+	var path []int
+	for _, name := range strings.Split(recordField, ".") {
+		idx := -1
+		for i, f := range s.fields {
+			if f.name == name {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			return nil, nil, errors.Errorf("the avro record '%s' has no field '%s'", s.name, name)
+		}
+		s = s.fields[idx].schema
+		if s.typ == avroUnion {
+			if branch := s.nonNullBranch(); branch != nil {
+				s = branch
+			}
+		}
+		if s.typ != avroRecord {
+			return nil, nil, errors.Errorf("the avro field '%s' is not a record", name)
+		}
+		path = append(path, idx)
+	}
+	return path, s, nil
+}
+
+// AvroParser parses an avro object container file for import.
+// It implements the Parser interface.
+type AvroParser struct {
+	reader *avroFileReader
+	closer io.Closer
+	// path is the indices of the fields on the path of the nested record
+	// which holds the row.
+	path      []int
+	rowSchema *avroSchema
+	columns   []string
+
+	block avroDecoder
+	// blockRemain is the count of the records which are not read in the
+	// current block.
+	blockRemain int64
+	// pos is the count of the records which are read.
+	pos     int64
+	lastRow Row
+	logger  log.Logger
+}
+
+// NewAvroParser creates an avro parser.
+func NewAvroParser(
+	ctx context.Context,
+	cfg *config.AvroConfig,
+	r storage.ReadSeekCloser,
+) (*AvroParser, error) {
+	reader, err := newAvroFileReader(r)
+	if err != nil {
+		return nil, err
+	}
+	path, rowSchema, err := avroRowSchema(reader.schema, cfg.RecordField)
+	if err != nil {
+		return nil, err
+	}
+	columns := make([]string, 0, len(rowSchema.fields))
+	for _, f := range rowSchema.fields {
+		columns = append(columns, avroColumnName(f.name, cfg))
+	}
+	return &AvroParser{
+		reader:    reader,
+		closer:    r,
+		path:      path,
+		rowSchema: rowSchema,
+		columns:   columns,
+		logger:    log.FromContext(ctx),
+	}, nil
+}
+
+// Pos returns the count of the read records and the row ID.
+// It implements the Parser interface.
+func (p *AvroParser) Pos() (pos int64, rowID int64) {
+	return p.pos, p.lastRow.RowID
+}
+
+// SetPos skips the records before the position.
+// It implements the Parser interface.
+func (p *AvroParser) SetPos(pos int64, rowID int64) error {
+	if pos < p.pos {
+		panic("don't support seek back yet")
+	}
+	p.lastRow.RowID = rowID
+	for p.pos < pos {
+		if p.blockRemain == 0 {
+			count, size, err := p.reader.readBlockHeader()
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if p.pos+count <= pos {
+				// skip the whole block without decompressing it.
+				if err = p.reader.skipBlock(size); err != nil {
+					return err
+				}
+				p.pos += count
+				continue
+			}
+			if err = p.loadBlock(count, size); err != nil {
+				return err
+			}
+		}
+		if _, err := p.block.readValue(p.reader.schema); err != nil {
+			return errors.Annotatef(err, "failed to skip the avro record at %d", p.pos)
+		}
+		p.blockRemain--
+		p.pos++
+	}
+	return nil
+}
+
+func (p *AvroParser) loadBlock(count, size int64) error {
+	data, err := p.reader.readBlock(size)
+	if err != nil {
+		return err
+	}
+	p.block = avroDecoder{buf: data}
+	p.blockRemain = count
+	return nil
+}
+
+// Close closes the avro file of the parser.
+// It implements the Parser interface.
+func (p *AvroParser) Close() error {
+	return p.closer.Close()
+}
+
+// ReadRow reads a row in the avro file by the parser, the records whose nested
+// record holding the row is null are skipped.
+// It implements the Parser interface.
+func (p *AvroParser) ReadRow() error {
+	for {
+		for p.blockRemain == 0 {
+			count, size, err := p.reader.readBlockHeader()
+			if err != nil {
+				return err
+			}
+			if err = p.loadBlock(count, size); err != nil {
+				return err
+			}
+		}
+		p.lastRow.Length = 0
+		found, err := p.readRecord(p.reader.schema, 0)
+		if err != nil {
+			return errors.Annotatef(err, "failed to read the avro record at %d", p.pos)
+		}
+		p.blockRemain--
+		p.pos++
+		if found {
+			p.lastRow.RowID++
+			return nil
+		}
+	}
+}
+
+// readRecord reads a record on the path of the nested record which holds the
+// row, and returns whether the row is found.
+func (p *AvroParser) readRecord(s *avroSchema, depth int) (bool, error) {
+	if depth == len(p.path) {
+		return true, p.readRowFields(s)
+	}
+	found := false
+	for i, f := range s.fields {
+		if i != p.path[depth] {
+			if _, err := p.block.readValue(f.schema); err != nil {
+				return false, err
+			}
+			continue
+		}
+		fieldSchema := f.schema
+		if fieldSchema.typ == avroUnion {
+			branch, err := p.block.readBranch(fieldSchema)
+			if err != nil {
+				return false, err
+			}
+			fieldSchema = branch
+		}
+		switch fieldSchema.typ {
+		case avroNull:
+		case avroRecord:
+			var err error
+			if found, err = p.readRecord(fieldSchema, depth+1); err != nil {
+				return false, err
+			}
+		default:
+			return false, errors.Errorf("the avro field '%s' is %s but not a record", f.name, fieldSchema.typ)
+		}
+	}
+	return found, nil
+}
+
+func (p *AvroParser) readRowFields(s *avroSchema) error {
+	length := len(s.fields)
+	if cap(p.lastRow.Row) < length {
+		p.lastRow.Row = make([]types.Datum, length)
+	} else {
+		p.lastRow.Row = p.lastRow.Row[:length]
+	}
+	for i, f := range s.fields {
+		v, err := p.block.readValue(f.schema)
+		if err != nil {
+			return errors.Annotatef(err, "failed to read the avro field '%s'", f.name)
+		}
+		n, err := setDatumByAvro(&p.lastRow.Row[i], v)
+		if err != nil {
+			p.logger.Error("unknown value", zap.String("field", f.name), zap.Reflect("value", v))
+			return err
+		}
+		p.lastRow.Length += n
+	}
+	return nil
+}
+
+// setDatumByAvro sets the datum to a value read by avroDecoder, and returns
+// the length of the value. The records, the arrays and the maps are converted
+// to JSON.
+func setDatumByAvro(d *types.Datum, v interface{}) (int, error) {
+	switch t := v.(type) {
+	case nil:
+		d.SetNull()
+		return 0, nil
+	case bool:
+		if t {
+			d.SetUint64(1)
+		} else {
+			d.SetUint64(0)
+		}
+	case int64:
+		d.SetInt64(t)
+	case float32:
+		d.SetFloat32(t)
+	case float64:
+		d.SetFloat64(t)
+	case string:
+		d.SetString(t, "")
+		return len(t), nil
+	case json.Number:
+		d.SetString(string(t), "")
+		return len(t), nil
+	case []byte:
+		d.SetBytes(t)
+		return len(t), nil
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(avroJSONValue(t))
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		d.SetString(string(data), "")
+		return len(data), nil
+	default:
+		return 0, errors.Errorf("unknown avro value %v", v)
+	}
+	return 8, nil
+}
+
+// avroJSONValue converts the bytes in a nested value to strings, otherwise
+// they are marshaled to base64.
+func avroJSONValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case []byte:
+		return string(t)
+	case map[string]interface{}:
+		for k, value := range t {
+			t[k] = avroJSONValue(value)
+		}
+	case []interface{}:
+		for i, value := range t {
+			t[i] = avroJSONValue(value)
+		}
+	}
+	return v
+}
+
+// LastRow gets the last row parsed by the parser.
+// It implements the Parser interface.
+func (p *AvroParser) LastRow() Row {
+	return p.lastRow
+}
+
+// RecycleRow implements the Parser interface.
+func (*AvroParser) RecycleRow(_ Row) {
+}
+
+// Columns returns the _lower-case_ column names corresponding to values in
+// the LastRow.
+func (p *AvroParser) Columns() []string {
+	return p.columns
+}
+
+// SetColumns set restored column names to parser
+func (*AvroParser) SetColumns(_ []string) {
+	// just do nothing
+}
+
+// SetLogger sets the logger used in the parser.
+// It implements the Parser interface.
+func (p *AvroParser) SetLogger(l log.Logger) {
+	p.logger = l
+}
+
+// AvroCreateTableStmt generates the CREATE TABLE statement of a table by the
+// schema of an avro file. The nullable fields, i.e. the unions with null, are
+// the nullable columns, and the records, the arrays, the maps and the unions
+// of several types are the JSON columns.
+func AvroCreateTableStmt(cfg *config.AvroConfig, r io.Reader, tableName string) (string, error) {
+	reader, err := newAvroFileReader(r)
+	if err != nil {
+		return "", err
+	}
+	_, rowSchema, err := avroRowSchema(reader.schema, cfg.RecordField)
+	if err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	sb.WriteString("CREATE TABLE ")
+	sb.WriteString(common.EscapeIdentifier(tableName))
+	sb.WriteString(" (")
+	for i, f := range rowSchema.fields {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		sb.WriteString("\n  ")
+		sb.WriteString(common.EscapeIdentifier(avroColumnName(f.name, cfg)))
+		sb.WriteByte(' ')
+		s, nullable := f.schema, false
+		if s.typ == avroUnion {
+			nullable = len(s.branches) > 1 || (len(s.branches) == 1 && s.branches[0].typ == avroNull)
+			if branch := s.nonNullBranch(); branch != nil {
+				s = branch
+			}
+		}
+		sb.WriteString(avroColumnType(s))
+		if !nullable {
+			sb.WriteString(" NOT NULL")
+		}
+	}
+	sb.WriteString("\n)")
+	return sb.String(), nil
+}
+
+// avroColumnType returns the column type of an avro type.
+func avroColumnType(s *avroSchema) string {
+	switch s.logical {
+	case "decimal":
+		// the precision and the scale out of the range of the DECIMAL of TiDB
+		// are kept as string.
+		if s.precision > 0 && s.precision <= mysql.MaxDecimalWidth && s.scale >= 0 && s.scale <= mysql.MaxDecimalScale {
+			return fmt.Sprintf("DECIMAL(%d,%d)", s.precision, s.scale)
+		}
+		return "LONGTEXT"
+	case "uuid":
+		return "CHAR(36)"
+	case "date":
+		return "DATE"
+	case "time-millis":
+		return "TIME(3)"
+	case "time-micros":
+		return "TIME(6)"
+	case "timestamp-millis", "local-timestamp-millis":
+		return "DATETIME(3)"
+	case "timestamp-micros", "local-timestamp-micros":
+		return "DATETIME(6)"
+	}
+	switch s.typ {
+	case avroBoolean:
+		return "BOOLEAN"
+	case avroInt:
+		return "INT"
+	case avroLong:
+		return "BIGINT"
+	case avroFloat:
+		return "FLOAT"
+	case avroDouble:
+		return "DOUBLE"
+	case avroString:
+		return "LONGTEXT"
+	case avroBytes:
+		return "LONGBLOB"
+	case avroFixed:
+		if s.size <= 255 {
+			return fmt.Sprintf("BINARY(%d)", s.size)
+		}
+		return "BLOB"
+	case avroEnum:
+		symbols := make([]string, 0, len(s.symbols))
+		for _, symbol := range s.symbols {
+			symbols = append(symbols, "'"+strings.ReplaceAll(symbol, "'", "''")+"'")
+		}
+		return "ENUM(" + strings.Join(symbols, ",") + ")"
+	default:
+		return "JSON"
+	}
+}
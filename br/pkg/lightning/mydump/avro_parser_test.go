@@ -0,0 +1,312 @@
+package mydump
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/types"
+	"github.com/stretchr/testify/require"
+)
+
+// writeAvroFile writes an avro object container file with the encoded
+// records, each block contains blockSize records.
+func writeAvroFile(t *testing.T, store storage.ExternalStorage, name, schema, codec string, records [][]byte, blockSize int) {
+	sync := []byte("0123456789abcdef")
+	buf := []byte(avroMagic)
+	buf = binary.AppendVarint(buf, 2)
+	for _, kv := range [][2]string{{avroSchemaKey, schema}, {avroCodecKey, codec}} {
+		buf = appendAvroString(buf, kv[0])
+		buf = appendAvroString(buf, kv[1])
+	}
+	buf = binary.AppendVarint(buf, 0)
+	buf = append(buf, sync...)
+
+	for len(records) > 0 {
+		n := blockSize
+		if n > len(records) {
+			n = len(records)
+		}
+		data := bytes.Join(records[:n], nil)
+		records = records[n:]
+		switch codec {
+		case avroCodecDeflate:
+			var b bytes.Buffer
+			w, err := flate.NewWriter(&b, flate.DefaultCompression)
+			require.NoError(t, err)
+			_, err = w.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+			data = b.Bytes()
+		case avroCodecSnappy:
+			data = binary.BigEndian.AppendUint32(snappy.Encode(nil, data), crc32.ChecksumIEEE(data))
+		}
+		buf = binary.AppendVarint(buf, int64(n))
+		buf = binary.AppendVarint(buf, int64(len(data)))
+		buf = append(buf, data...)
+		buf = append(buf, sync...)
+	}
+	require.NoError(t, store.WriteFile(context.Background(), name, buf))
+}
+
+func appendAvroString(b []byte, s string) []byte {
+	b = binary.AppendVarint(b, int64(len(s)))
+	return append(b, s...)
+}
+
+func openAvroParser(t *testing.T, store storage.ExternalStorage, name string, cfg *config.AvroConfig) *AvroParser {
+	r, err := store.Open(context.Background(), name)
+	require.NoError(t, err)
+	parser, err := NewAvroParser(context.Background(), cfg, r)
+	require.NoError(t, err)
+	return parser
+}
+
+func TestAvroParser(t *testing.T) {
+	schema := `{
+		"type": "record",
+		"name": "Item",
+		"namespace": "test",
+		"fields": [
+			{"name": "id", "type": "long"},
+			{"name": "Name", "type": ["null", "string"]},
+			{"name": "price", "type": {"type": "bytes", "logicalType": "decimal", "precision": 10, "scale": 2}},
+			{"name": "created", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+			{"name": "day", "type": {"type": "int", "logicalType": "date"}},
+			{"name": "ok", "type": "boolean"},
+			{"name": "ratio", "type": "double"},
+			{"name": "kind", "type": {"type": "enum", "name": "Kind", "symbols": ["A", "B"]}},
+			{"name": "tags", "type": {"type": "array", "items": "string"}},
+			{"name": "attrs", "type": {"type": "map", "values": "int"}},
+			{"name": "parent", "type": ["null", "Item"]}
+		]
+	}`
+	records := make([][]byte, 0, 10)
+	for i := 0; i < 10; i++ {
+		b := binary.AppendVarint(nil, int64(i))
+		if i%2 == 0 {
+			b = binary.AppendVarint(b, 0)
+		} else {
+			b = binary.AppendVarint(b, 1)
+			b = appendAvroString(b, "name")
+		}
+		// -1.23 in two's complement.
+		b = binary.AppendVarint(b, 1)
+		b = append(b, 0x85)
+		b = binary.AppendVarint(b, 1656000000123)
+		b = binary.AppendVarint(b, 19000)
+		b = append(b, 1)
+		b = binary.LittleEndian.AppendUint64(b, 0x3FF8000000000000) // 1.5
+		b = binary.AppendVarint(b, 1)
+		// the array and the map are written in two blocks.
+		b = binary.AppendVarint(b, 1)
+		b = appendAvroString(b, "x")
+		b = binary.AppendVarint(b, -1)
+		b = binary.AppendVarint(b, 2)
+		b = appendAvroString(b, "y")
+		b = binary.AppendVarint(b, 0)
+		b = binary.AppendVarint(b, 1)
+		b = appendAvroString(b, "k")
+		b = binary.AppendVarint(b, 3)
+		b = binary.AppendVarint(b, 0)
+		// a nested item without the parent.
+		b = binary.AppendVarint(b, 1)
+		b = binary.AppendVarint(b, 100)
+		b = binary.AppendVarint(b, 0)
+		b = binary.AppendVarint(b, 0)
+		b = binary.AppendVarint(b, 0)
+		b = binary.AppendVarint(b, 0)
+		b = append(b, 0)
+		b = binary.LittleEndian.AppendUint64(b, 0)
+		b = binary.AppendVarint(b, 0)
+		b = binary.AppendVarint(b, 0)
+		b = binary.AppendVarint(b, 0)
+		b = binary.AppendVarint(b, 0)
+		records = append(records, b)
+	}
+
+	store, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	cfg := &config.AvroConfig{ColumnNameMatch: config.AvroMatchCaseInsensitive}
+	for _, codec := range []string{avroCodecNull, avroCodecDeflate, avroCodecSnappy} {
+		name := "test." + codec + ".avro"
+		writeAvroFile(t, store, name, schema, codec, records, 3)
+
+		r, err := store.Open(context.Background(), name)
+		require.NoError(t, err)
+		count, err := ReadAvroFileRowCount(r)
+		require.NoError(t, err)
+		require.Equal(t, int64(10), count)
+		require.NoError(t, r.Close())
+
+		parser := openAvroParser(t, store, name, cfg)
+		require.Equal(t, []string{"id", "name", "price", "created", "day", "ok", "ratio", "kind", "tags", "attrs", "parent"}, parser.Columns())
+		// skip a whole block and a record in the next block.
+		require.NoError(t, parser.SetPos(4, 4))
+		for i := 4; i < 10; i++ {
+			require.NoError(t, parser.ReadRow())
+			pos, rowID := parser.Pos()
+			require.Equal(t, int64(i+1), pos)
+			require.Equal(t, int64(i+1), rowID)
+			row := parser.LastRow().Row
+			require.Len(t, row, 11)
+			require.Equal(t, int64(i), row[0].GetInt64())
+			if i%2 == 0 {
+				require.True(t, row[1].IsNull())
+			} else {
+				require.Equal(t, "name", row[1].GetString())
+			}
+			require.Equal(t, "-1.23", row[2].GetString())
+			require.Equal(t, "2022-06-23 16:00:00.123Z", row[3].GetString())
+			require.Equal(t, "2022-01-08", row[4].GetString())
+			require.Equal(t, uint64(1), row[5].GetUint64())
+			require.Equal(t, 1.5, row[6].GetFloat64())
+			require.Equal(t, "B", row[7].GetString())
+			require.Equal(t, `["x","y"]`, row[8].GetString())
+			require.Equal(t, `{"k":3}`, row[9].GetString())
+			require.Equal(t, `{"Name":null,"attrs":{},"created":"1970-01-01 00:00:00Z","day":"1970-01-01","id":100,"kind":"A","ok":false,"parent":null,"price":0,"ratio":0,"tags":[]}`, row[10].GetString())
+		}
+		require.ErrorIs(t, parser.ReadRow(), io.EOF)
+		require.NoError(t, parser.Close())
+	}
+
+	stmt, err := AvroCreateTableStmt(cfg, bytes.NewReader(mustReadFile(t, store, "test.null.avro")), "item")
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE `item` (\n"+
+		"  `id` BIGINT NOT NULL,\n"+
+		"  `name` LONGTEXT,\n"+
+		"  `price` DECIMAL(10,2) NOT NULL,\n"+
+		"  `created` DATETIME(3) NOT NULL,\n"+
+		"  `day` DATE NOT NULL,\n"+
+		"  `ok` BOOLEAN NOT NULL,\n"+
+		"  `ratio` DOUBLE NOT NULL,\n"+
+		"  `kind` ENUM('A','B') NOT NULL,\n"+
+		"  `tags` JSON NOT NULL,\n"+
+		"  `attrs` JSON NOT NULL,\n"+
+		"  `parent` JSON\n"+
+		")", stmt)
+}
+
+func mustReadFile(t *testing.T, store storage.ExternalStorage, name string) []byte {
+	data, err := store.ReadFile(context.Background(), name)
+	require.NoError(t, err)
+	return data
+}
+
+func TestAvroParserDebeziumEnvelope(t *testing.T) {
+	schema := `{
+		"type": "record",
+		"name": "Envelope",
+		"namespace": "dbserver.inventory.users",
+		"fields": [
+			{"name": "before", "type": ["null", {
+				"type": "record",
+				"name": "Value",
+				"fields": [
+					{"name": "userId", "type": "int"},
+					{"name": "HTTPStatus", "type": "string"},
+					{"name": "userName", "type": "string"}
+				]
+			}]},
+			{"name": "after", "type": ["null", "Value"]},
+			{"name": "op", "type": "string"}
+		]
+	}`
+	value := func(id int64, status, name string) []byte {
+		b := binary.AppendVarint(nil, 1)
+		b = binary.AppendVarint(b, id)
+		b = appendAvroString(b, status)
+		return appendAvroString(b, name)
+	}
+	null := binary.AppendVarint(nil, 0)
+	records := [][]byte{
+		bytes.Join([][]byte{null, value(1, "200", "alice"), appendAvroString(nil, "c")}, nil),
+		bytes.Join([][]byte{value(1, "200", "alice"), value(1, "404", "bob"), appendAvroString(nil, "u")}, nil),
+		// the deletion is skipped.
+		bytes.Join([][]byte{value(1, "404", "bob"), null, appendAvroString(nil, "d")}, nil),
+		bytes.Join([][]byte{null, value(2, "500", "carol"), appendAvroString(nil, "r")}, nil),
+	}
+
+	store, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	writeAvroFile(t, store, "users.avro", schema, avroCodecNull, records, 2)
+
+	cfg := &config.AvroConfig{
+		RecordField:     "after",
+		ColumnNameMatch: config.AvroMatchSnakeCase,
+		ColumnMapping:   map[string]string{"userName": "Name"},
+	}
+	parser := openAvroParser(t, store, "users.avro", cfg)
+	require.Equal(t, []string{"user_id", "http_status", "name"}, parser.Columns())
+	expected := [][]types.Datum{
+		{types.NewIntDatum(1), types.NewCollationStringDatum("200", ""), types.NewCollationStringDatum("alice", "")},
+		{types.NewIntDatum(1), types.NewCollationStringDatum("404", ""), types.NewCollationStringDatum("bob", "")},
+		{types.NewIntDatum(2), types.NewCollationStringDatum("500", ""), types.NewCollationStringDatum("carol", "")},
+	}
+	for i, row := range expected {
+		require.NoError(t, parser.ReadRow())
+		require.Equal(t, int64(i+1), parser.LastRow().RowID)
+		require.Equal(t, row, parser.LastRow().Row)
+	}
+	pos, _ := parser.Pos()
+	require.Equal(t, int64(4), pos)
+	require.ErrorIs(t, parser.ReadRow(), io.EOF)
+	require.NoError(t, parser.Close())
+
+	stmt, err := AvroCreateTableStmt(cfg, bytes.NewReader(mustReadFile(t, store, "users.avro")), "users")
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE `users` (\n"+
+		"  `user_id` INT NOT NULL,\n"+
+		"  `http_status` LONGTEXT NOT NULL,\n"+
+		"  `name` LONGTEXT NOT NULL\n"+
+		")", stmt)
+
+	cfg.RecordField = "op"
+	r, err := store.Open(context.Background(), "users.avro")
+	require.NoError(t, err)
+	_, err = NewAvroParser(context.Background(), cfg, r)
+	require.ErrorContains(t, err, "the avro field 'op' is not a record")
+	require.NoError(t, r.Close())
+}
+
+func TestToSnakeCase(t *testing.T) {
+	for name, expected := range map[string]string{
+		"id":         "id",
+		"userId":     "user_id",
+		"userID":     "user_id",
+		"HTTPStatus": "http_status",
+		"user_name":  "user_name",
+		"address2":   "address2",
+		"Address2Id": "address2_id",
+	} {
+		require.Equal(t, expected, toSnakeCase(name), name)
+	}
+}
+
+func TestAvroTableSchema(t *testing.T) {
+	schema := `{"type": "record", "name": "t", "fields": [{"name": "a", "type": "int"}]}`
+	store, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	writeAvroFile(t, store, "db.t.avro", schema, avroCodecNull, nil, 1)
+
+	cfg := config.NewConfig()
+	cfg.Mydumper.DefaultFileRules = true
+	loader, err := NewMyDumpLoaderWithStore(context.Background(), cfg, store)
+	require.NoError(t, err)
+	dbs := loader.GetDatabases()
+	require.Len(t, dbs, 1)
+	require.Len(t, dbs[0].Tables, 1)
+	// the table without the schema file is created by the schema of the avro file.
+	tbl := dbs[0].Tables[0]
+	require.True(t, tbl.HasSchema())
+	stmt, err := tbl.GetSchema(context.Background(), store)
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE `t` (\n  `a` INT NOT NULL\n)", stmt)
+}
@@ -21,16 +21,19 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/br/pkg/lightning/config"
 	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
 	"golang.org/x/text/encoding/simplifiedchinese"
 )
 
 // CharsetConvertor is used to convert a character set to utf8mb4 encoding.
-// In Lightning, we mainly use it to do the GB18030/GBK -> UTF8MB4 conversion.
+// In Lightning, we mainly use it to do the GB18030/GBK/latin1 -> UTF8MB4 conversion.
 type CharsetConvertor struct {
 	// sourceCharacterSet represents the charset that the data source uses.
 	sourceCharacterSet config.Charset
 	// invalidCharReplacement is the default replacement character bytes for the invalid content, e.g "\ufffd".
 	invalidCharReplacement string
+	// rejectInvalidChar makes Decode return an error instead of replacing the invalid content.
+	rejectInvalidChar bool
 
 	decoder *encoding.Decoder
 	encoder *encoding.Encoder
@@ -38,14 +41,34 @@ type CharsetConvertor struct {
 
 // NewCharsetConvertor creates a new CharsetConvertor.
 func NewCharsetConvertor(dataCharacterSet, dataInvalidCharReplace string) (*CharsetConvertor, error) {
+	return newCharsetConvertor(dataCharacterSet, dataInvalidCharReplace, config.InvalidCharPolicyReplace)
+}
+
+// NewFileCharsetConvertor creates a new CharsetConvertor for the data file,
+// which uses the charset of the file if it's set by `[[mydumper.files]]`, or
+// `mydumper.data-character-set` otherwise.
+func NewFileCharsetConvertor(cfg *config.MydumperRuntime, fileMeta SourceFileMeta) (*CharsetConvertor, error) {
+	dataCharacterSet := cfg.DataCharacterSet
+	if len(fileMeta.DataCharacterSet) > 0 {
+		dataCharacterSet = fileMeta.DataCharacterSet
+	}
+	return newCharsetConvertor(dataCharacterSet, cfg.DataInvalidCharReplace, cfg.DataInvalidCharPolicy)
+}
+
+func newCharsetConvertor(dataCharacterSet, dataInvalidCharReplace, dataInvalidCharPolicy string) (*CharsetConvertor, error) {
 	sourceCharacterSet, err := config.ParseCharset(dataCharacterSet)
 	if err != nil {
 		return nil, err
 	}
 	cc := &CharsetConvertor{
-		sourceCharacterSet,
-		dataInvalidCharReplace,
-		nil, nil,
+		sourceCharacterSet: sourceCharacterSet,
+	}
+	switch dataInvalidCharPolicy {
+	case config.InvalidCharPolicyIgnore:
+	case config.InvalidCharPolicyError:
+		cc.rejectInvalidChar = true
+	default:
+		cc.invalidCharReplacement = dataInvalidCharReplace
 	}
 	err = cc.initDecoder()
 	if err != nil {
@@ -68,6 +91,10 @@ func (cc *CharsetConvertor) initDecoder() error {
 	case config.GBK:
 		cc.decoder = simplifiedchinese.GBK.NewDecoder()
 		return nil
+	case config.Latin1:
+		// the latin1 of MySQL is actually Code Page 1252.
+		cc.decoder = charmap.Windows1252.NewDecoder()
+		return nil
 	}
 	return errors.Errorf("not support %s as the conversion source yet", cc.sourceCharacterSet)
 }
@@ -82,6 +109,9 @@ func (cc *CharsetConvertor) initEncoder() error {
 	case config.GBK:
 		cc.encoder = simplifiedchinese.GBK.NewEncoder()
 		return nil
+	case config.Latin1:
+		cc.encoder = charmap.Windows1252.NewEncoder()
+		return nil
 	}
 	return errors.Errorf("not support %s as the conversion source yet", cc.sourceCharacterSet)
 }
@@ -99,6 +129,12 @@ func (cc *CharsetConvertor) Decode(src string) (string, error) {
 	if err != nil {
 		return res, err
 	}
+	if !strings.ContainsRune(res, utf8.RuneError) {
+		return res, nil
+	}
+	if cc.rejectInvalidChar {
+		return res, errors.Errorf("the data contains characters invalid in %s: %q", cc.sourceCharacterSet, src)
+	}
 	return strings.ReplaceAll(res, string(utf8.RuneError), cc.invalidCharReplacement), nil
 }
 
@@ -19,6 +19,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
 	"github.com/stretchr/testify/require"
 )
 
@@ -78,3 +79,53 @@ func TestInvalidCharReplace(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, string(expectedData), gbkToUTF8Data)
 }
+
+func TestInvalidCharPolicy(t *testing.T) {
+	// Input: 你好invalid char你好
+	inputData := append(normalCharGB18030, invalidChar...)
+	inputData = append(inputData, normalCharGB18030...)
+
+	cfg := &config.MydumperRuntime{
+		DataCharacterSet:       "gb18030",
+		DataInvalidCharReplace: "?",
+		DataInvalidCharPolicy:  config.InvalidCharPolicyIgnore,
+	}
+	cc, err := NewFileCharsetConvertor(cfg, SourceFileMeta{})
+	require.NoError(t, err)
+	res, err := cc.Decode(string(inputData))
+	require.NoError(t, err)
+	require.Equal(t, string(normalCharUTF8MB4)+string(normalCharUTF8MB4), res)
+
+	cfg.DataInvalidCharPolicy = config.InvalidCharPolicyError
+	cc, err = NewFileCharsetConvertor(cfg, SourceFileMeta{})
+	require.NoError(t, err)
+	_, err = cc.Decode(string(inputData))
+	require.ErrorContains(t, err, "the data contains characters invalid in gb18030")
+	res, err = cc.Decode(string(normalCharGB18030))
+	require.NoError(t, err)
+	require.Equal(t, string(normalCharUTF8MB4), res)
+}
+
+func TestFileCharsetConvertor(t *testing.T) {
+	cfg := &config.MydumperRuntime{
+		DataCharacterSet:       "gbk",
+		DataInvalidCharReplace: "\ufffd",
+		DataInvalidCharPolicy:  config.InvalidCharPolicyReplace,
+	}
+	// "café €" in latin1.
+	latin1Data := string([]byte{'c', 'a', 'f', 0xE9, ' ', 0x80})
+	cc, err := NewFileCharsetConvertor(cfg, SourceFileMeta{DataCharacterSet: "latin1"})
+	require.NoError(t, err)
+	res, err := cc.Decode(latin1Data)
+	require.NoError(t, err)
+	require.Equal(t, "café €", res)
+	res, err = cc.Encode("café €")
+	require.NoError(t, err)
+	require.Equal(t, latin1Data, res)
+
+	cc, err = NewFileCharsetConvertor(cfg, SourceFileMeta{})
+	require.NoError(t, err)
+	res, err = cc.Decode(string(normalCharGB18030))
+	require.NoError(t, err)
+	require.Equal(t, string(normalCharUTF8MB4), res)
+}
@@ -73,6 +73,9 @@ type MDTableMeta struct {
 	TotalSize    int64
 	IndexRatio   float64
 	IsRowOrdered bool
+	// avroCfg is used to generate the schema from the avro data files if the
+	// schema file is missing.
+	avroCfg *config.AvroConfig
 }
 
 // SourceFileMeta contains some analyzed metadata for a source file by MyDumper Loader.
@@ -82,6 +85,18 @@ type SourceFileMeta struct {
 	Compression Compression
 	SortKey     string
 	FileSize    int64
+	// RealSize is the estimated size of the decompressed data of the file,
+	// which is the same as FileSize if the file isn't compressed.
+	RealSize int64
+	// IsStream marks the stream source read from stdin or a named pipe, which
+	// can be read only once and whose size is unknown.
+	IsStream bool
+	// Partition is the partition of the table the rows of the file are
+	// imported into, it's empty if the file isn't routed to a partition.
+	Partition string
+	// DataCharacterSet is the charset of the file, it's empty if the file
+	// uses `mydumper.data-character-set`.
+	DataCharacterSet string
 }
 
 // NewMDTableMeta creates an Mydumper table meta with specified character set.
@@ -91,10 +106,28 @@ func NewMDTableMeta(charSet string) *MDTableMeta {
 	}
 }
 
+// HasSchema returns whether the table-creating SQL of the table can be got,
+// i.e. the table has a schema file, or its data files are avro files which
+// have the schemas.
+func (m *MDTableMeta) HasSchema() bool {
+	return m.SchemaFile.FileMeta.Path != "" || m.avroSchemaFile() != nil
+}
+
+// avroSchemaFile returns the avro data file to generate the schema from.
+func (m *MDTableMeta) avroSchemaFile() *SourceFileMeta {
+	if m.avroCfg == nil || len(m.DataFiles) == 0 || m.DataFiles[0].FileMeta.Type != SourceTypeAvro {
+		return nil
+	}
+	return &m.DataFiles[0].FileMeta
+}
+
 // GetSchema gets the table-creating SQL for a source table.
 func (m *MDTableMeta) GetSchema(ctx context.Context, store storage.ExternalStorage) (string, error) {
 	schemaFilePath := m.SchemaFile.FileMeta.Path
 	if len(schemaFilePath) <= 0 {
+		if avroFile := m.avroSchemaFile(); avroFile != nil {
+			return m.getAvroSchema(ctx, store, avroFile)
+		}
 		return "", errors.Errorf("schema file is missing for the table '%s.%s'", m.DB, m.Name)
 	}
 	fileExists, err := store.FileExists(ctx, schemaFilePath)
@@ -115,6 +148,24 @@ func (m *MDTableMeta) GetSchema(ctx context.Context, store storage.ExternalStora
 	return string(schema), nil
 }
 
+func (m *MDTableMeta) getAvroSchema(ctx context.Context, store storage.ExternalStorage, avroFile *SourceFileMeta) (string, error) {
+	r, err := store.Open(ctx, avroFile.Path)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	//nolint: errcheck
+	defer r.Close()
+	schema, err := AvroCreateTableStmt(m.avroCfg, r, m.Name)
+	if err != nil {
+		log.FromContext(ctx).Error("failed to generate table schema from avro file",
+			zap.String("Path", avroFile.Path),
+			log.ShortError(err),
+		)
+		return "", err
+	}
+	return schema, nil
+}
+
 // MDLoaderSetupConfig stores the configs when setting up a MDLoader.
 // This can control the behavior when constructing an MDLoader.
 type MDLoaderSetupConfig struct {
@@ -149,6 +200,8 @@ type MDLoader struct {
 	router     *regexprrouter.RouteTable
 	fileRouter FileRouter
 	charSet    string
+	avroCfg    *config.AvroConfig
+	streamCfg  *config.StreamConfig
 }
 
 type mdLoaderSetup struct {
@@ -227,6 +280,8 @@ func NewMyDumpLoaderWithStore(ctx context.Context, cfg *config.Config, store sto
 		router:     r,
 		charSet:    cfg.Mydumper.CharacterSet,
 		fileRouter: fileRouter,
+		avroCfg:    &cfg.Mydumper.Avro,
+		streamCfg:  &cfg.Mydumper.Stream,
 	}
 
 	setup := mdLoaderSetup{
@@ -306,6 +361,9 @@ func (s *mdLoaderSetup) setup(ctx context.Context, store storage.ExternalStorage
 	if err := s.route(); err != nil {
 		return common.ErrTableRoute.Wrap(err).GenWithStackByArgs()
 	}
+	if err := s.addStreamSource(); err != nil {
+		return errors.Trace(err)
+	}
 
 	// setup database schema
 	if len(s.dbSchemas) != 0 {
@@ -342,7 +400,7 @@ func (s *mdLoaderSetup) setup(ctx context.Context, store storage.ExternalStorage
 		// set a dummy `FileInfo` here without file meta because we needn't restore the table schema
 		tableMeta, _, _ := s.insertTable(FileInfo{TableName: fileInfo.TableName})
 		tableMeta.DataFiles = append(tableMeta.DataFiles, fileInfo)
-		tableMeta.TotalSize += fileInfo.FileMeta.FileSize
+		tableMeta.TotalSize += fileInfo.FileMeta.RealSize
 	}
 
 	for _, dbMeta := range s.loader.dbs {
@@ -387,7 +445,7 @@ func (s *mdLoaderSetup) listFiles(ctx context.Context, store storage.ExternalSto
 
 		info := FileInfo{
 			TableName: filter.Table{Schema: res.Schema, Name: res.Name},
-			FileMeta:  SourceFileMeta{Path: path, Type: res.Type, Compression: res.Compression, SortKey: res.Key, FileSize: size},
+			FileMeta:  SourceFileMeta{Path: path, Type: res.Type, Compression: res.Compression, SortKey: res.Key, FileSize: size, RealSize: size, Partition: res.Partition, DataCharacterSet: res.DataCharacterSet},
 		}
 
 		if s.loader.shouldSkip(&info.TableName) {
@@ -403,7 +461,19 @@ func (s *mdLoaderSetup) listFiles(ctx context.Context, store storage.ExternalSto
 			s.tableSchemas = append(s.tableSchemas, info)
 		case SourceTypeViewSchema:
 			s.viewSchemas = append(s.viewSchemas, info)
-		case SourceTypeSQL, SourceTypeCSV, SourceTypeParquet:
+		case SourceTypeSQL, SourceTypeCSV:
+			if res.Compression != CompressionNone {
+				ratio, err := SampleFileCompressRatio(ctx, info.FileMeta, store)
+				if err != nil {
+					return errors.Annotatef(err, "estimate the size of compressed file '%s' failed", path)
+				}
+				info.FileMeta.RealSize = int64(float64(size) * ratio)
+			}
+			s.tableDatas = append(s.tableDatas, info)
+		case SourceTypeParquet, SourceTypeAvro:
+			if res.Compression != CompressionNone {
+				return errors.Errorf("compressed %s file '%s' isn't supported", res.Type, path)
+			}
 			s.tableDatas = append(s.tableDatas, info)
 		}
 
@@ -416,6 +486,33 @@ func (s *mdLoaderSetup) listFiles(ctx context.Context, store storage.ExternalSto
 	return errors.Trace(err)
 }
 
+// addStreamSource adds the stream source as the only data file of its target
+// table, the target table isn't affected by the filter and the table routes.
+func (s *mdLoaderSetup) addStreamSource() error {
+	streamCfg := s.loader.streamCfg
+	if streamCfg == nil || !streamCfg.Enabled() {
+		return nil
+	}
+	tp, err := parseSourceType(streamCfg.Type)
+	if err != nil || (tp != SourceTypeCSV && tp != SourceTypeSQL) {
+		return common.ErrInvalidConfig.GenWithStack("unsupported `mydumper.stream.type` (%s)", streamCfg.Type)
+	}
+	target := filter.Table{Schema: streamCfg.Schema, Name: streamCfg.Table}
+	for _, info := range s.tableDatas {
+		// the row IDs of the stream are allocated while it's read, so it can't
+		// share the table with the other data files.
+		if strings.EqualFold(info.TableName.Schema, target.Schema) && strings.EqualFold(info.TableName.Name, target.Name) {
+			return common.ErrInvalidConfig.GenWithStack(
+				"table %s has data files in the data source dir, which can't be imported with the stream source", target.String())
+		}
+	}
+	s.tableDatas = append(s.tableDatas, FileInfo{
+		TableName: target,
+		FileMeta:  SourceFileMeta{Path: streamCfg.Source, Type: tp, IsStream: true},
+	})
+	return nil
+}
+
 func (l *MDLoader) shouldSkip(table *filter.Table) bool {
 	if len(table.Name) == 0 {
 		return !l.filter.MatchSchema(table.Schema)
@@ -541,6 +638,7 @@ func (s *mdLoaderSetup) insertTable(fileInfo FileInfo) (tblMeta *MDTableMeta, db
 		charSet:      s.loader.charSet,
 		IndexRatio:   0.0,
 		IsRowOrdered: true,
+		avroCfg:      s.loader.avroCfg,
 	}
 	dbMeta.Tables = append(dbMeta.Tables, ptr)
 	return ptr, dbExists, false
@@ -990,3 +990,34 @@ func TestMaxScanFilesOption(t *testing.T) {
 	tbl = dbMeta.Tables[0]
 	require.Equal(t, maxScanFilesCount-2, len(tbl.DataFiles))
 }
+
+func TestStreamSource(t *testing.T) {
+	s := newTestMydumpLoaderSuite(t)
+	s.touch(t, "db-schema-create.sql")
+	s.touch(t, "db.tbl-schema.sql")
+	s.touch(t, "db.other.sql")
+
+	s.cfg.Mydumper.Stream = config.StreamConfig{Source: "-", Type: "csv", Schema: "db", Table: "tbl"}
+	mdl, err := md.NewMyDumpLoader(context.Background(), s.cfg)
+	require.NoError(t, err)
+	dbMetas := mdl.GetDatabases()
+	require.Len(t, dbMetas, 1)
+	require.Len(t, dbMetas[0].Tables, 2)
+	var tbl *md.MDTableMeta
+	for _, table := range dbMetas[0].Tables {
+		if table.Name == "tbl" {
+			tbl = table
+		}
+	}
+	require.NotNil(t, tbl)
+	require.True(t, tbl.HasSchema())
+	require.Equal(t, []md.FileInfo{{
+		TableName: filter.Table{Schema: "db", Name: "tbl"},
+		FileMeta:  md.SourceFileMeta{Path: "-", Type: md.SourceTypeCSV, IsStream: true},
+	}}, tbl.DataFiles)
+
+	// the stream can't share the table with the data files.
+	s.cfg.Mydumper.Stream.Table = "OTHER"
+	_, err = md.NewMyDumpLoader(context.Background(), s.cfg)
+	require.Regexp(t, "table `db`.`OTHER` has data files in the data source dir", err.Error())
+}
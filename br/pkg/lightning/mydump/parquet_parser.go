@@ -3,6 +3,8 @@ package mydump
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math/big"
@@ -34,12 +36,16 @@ type ParquetParser struct {
 	Reader      *preader.ParquetReader
 	columns     []string
 	columnMetas []*parquet.SchemaElement
-	rows        []interface{}
-	readRows    int64
-	curStart    int64
-	curIndex    int
-	lastRow     Row
-	logger      log.Logger
+	// nestedColumns are the schemas of the nested columns, i.e. the structs,
+	// the lists, the maps and the repeated fields, which are converted to JSON.
+	// It's nil for the primitive columns.
+	nestedColumns []*parquetNode
+	rows          []interface{}
+	readRows      int64
+	curStart      int64
+	curIndex      int
+	lastRow       Row
+	logger        log.Logger
 }
 
 // readerWrapper is a used for implement `source.ParquetFile`
@@ -189,33 +195,105 @@ func NewParquetParser(
 		return nil, errors.Trace(err)
 	}
 
-	columns := make([]string, 0, len(reader.Footer.Schema)-1)
-	columnMetas := make([]*parquet.SchemaElement, 0, len(reader.Footer.Schema)-1)
-	for i, c := range reader.SchemaHandler.SchemaElements {
-		if c.GetNumChildren() == 0 {
-			// we need to use the raw name, SchemaElement.Name might be prefixed with PARGO_PERFIX_
-			columns = append(columns, strings.ToLower(reader.SchemaHandler.GetExName(i)))
-			// transfer old ConvertedType to LogicalType
-			columnMeta := c
-			if c.ConvertedType != nil && c.LogicalType == nil {
-				newMeta := *c
-				columnMeta = &newMeta
-				if err := convertToLogicType(columnMeta); err != nil {
-					return nil, err
-				}
-			}
-			columnMetas = append(columnMetas, columnMeta)
+	// the rows are read as structs of the top-level columns, the nested
+	// columns are converted to JSON.
+	root, _, err := newParquetNode(reader.SchemaHandler.SchemaElements, reader.SchemaHandler.GetExName, 0)
+	if err != nil {
+		return nil, err
+	}
+	columns := make([]string, 0, len(root.children))
+	columnMetas := make([]*parquet.SchemaElement, 0, len(root.children))
+	nestedColumns := make([]*parquetNode, 0, len(root.children))
+	for _, c := range root.children {
+		// we need to use the raw name, SchemaElement.Name might be prefixed with PARGO_PERFIX_
+		columns = append(columns, strings.ToLower(c.name))
+		columnMetas = append(columnMetas, c.meta)
+		if c.isNested() {
+			nestedColumns = append(nestedColumns, c)
+		} else {
+			nestedColumns = append(nestedColumns, nil)
 		}
 	}
 
 	return &ParquetParser{
-		Reader:      reader,
-		columns:     columns,
-		columnMetas: columnMetas,
-		logger:      log.FromContext(ctx),
+		Reader:        reader,
+		columns:       columns,
+		columnMetas:   columnMetas,
+		nestedColumns: nestedColumns,
+		logger:        log.FromContext(ctx),
 	}, nil
 }
 
+// parquetNode is a node of the schema tree of a parquet file.
+type parquetNode struct {
+	name     string
+	meta     *parquet.SchemaElement
+	children []*parquetNode
+}
+
+// newParquetNode builds the schema tree rooted at the idx-th element of the
+// depth-first flattened schema elements, it returns the index of the element
+// next to the tree.
+func newParquetNode(
+	elements []*parquet.SchemaElement,
+	exName func(int) string,
+	idx int,
+) (*parquetNode, int, error) {
+	if idx >= len(elements) {
+		return nil, idx, errors.Errorf("invalid parquet schema: missing the element %d", idx)
+	}
+	se := elements[idx]
+	// transfer old ConvertedType to LogicalType
+	if se.ConvertedType != nil && se.LogicalType == nil {
+		newMeta := *se
+		se = &newMeta
+		if err := convertToLogicType(se); err != nil {
+			return nil, idx, err
+		}
+	}
+	node := &parquetNode{name: exName(idx), meta: se}
+	next := idx + 1
+	for i := 0; i < int(se.GetNumChildren()); i++ {
+		var (
+			child *parquetNode
+			err   error
+		)
+		child, next, err = newParquetNode(elements, exName, next)
+		if err != nil {
+			return nil, next, err
+		}
+		node.children = append(node.children, child)
+	}
+	return node, next, nil
+}
+
+func (n *parquetNode) isNested() bool {
+	return len(n.children) > 0 || n.meta.GetRepetitionType() == parquet.FieldRepetitionType_REPEATED
+}
+
+// elementNode returns the schema of the elements of a list or a repeated field.
+func (n *parquetNode) elementNode() *parquetNode {
+	if n.meta.LogicalType == nil || n.meta.LogicalType.LIST == nil || len(n.children) != 1 {
+		return n
+	}
+	// a list is in the form of `<list-repetition> group <name> (LIST) { repeated group list { <element> } }`,
+	// or the legacy form of `<list-repetition> group <name> (LIST) { repeated <element> }`.
+	repeated := n.children[0]
+	if len(repeated.children) == 1 {
+		return repeated.children[0]
+	}
+	return repeated
+}
+
+// keyValueNodes returns the schemas of the keys and the values of a map, which
+// is in the form of `<map-repetition> group <name> (MAP) { repeated group key_value { <key> <value> } }`.
+func (n *parquetNode) keyValueNodes() (key, value *parquetNode, err error) {
+	if len(n.children) != 1 || len(n.children[0].children) != 2 {
+		return nil, nil, errors.Errorf("invalid parquet schema of map '%s'", n.name)
+	}
+	return n.children[0].children[0], n.children[0].children[1], nil
+}
+
 func convertToLogicType(se *parquet.SchemaElement) error {
 	logicalType := &parquet.LogicalType{}
 	switch *se.ConvertedType {
@@ -302,7 +380,11 @@ func convertToLogicType(se *parquet.SchemaElement) error {
 		logicalType.JSON = &parquet.JsonType{}
 	case parquet.ConvertedType_BSON:
 		logicalType.BSON = &parquet.BsonType{}
-	// case parquet.ConvertedType_INTERVAL, parquet.ConvertedType_MAP, parquet.ConvertedType_MAP_KEY_VALUE, parquet.ConvertedType_LIST:
+	case parquet.ConvertedType_LIST:
+		logicalType.LIST = &parquet.ListType{}
+	case parquet.ConvertedType_MAP, parquet.ConvertedType_MAP_KEY_VALUE:
+		logicalType.MAP = &parquet.MapType{}
+	// case parquet.ConvertedType_INTERVAL:
 	default:
 		return errors.Errorf("unsupported type: '%s'", *se.ConvertedType)
 	}
@@ -386,6 +468,14 @@ func (pp *ParquetParser) ReadRow() error {
 		pp.lastRow.Row = pp.lastRow.Row[:length]
 	}
 	for i := 0; i < length; i++ {
+		if node := pp.nestedColumns[i]; node != nil {
+			n, err := setDatumByNested(&pp.lastRow.Row[i], v.Field(i), node, pp.logger)
+			if err != nil {
+				return err
+			}
+			pp.lastRow.Length += n
+			continue
+		}
 		pp.lastRow.Length += getDatumLen(v.Field(i))
 		if err := setDatumValue(&pp.lastRow.Row[i], v.Field(i), pp.columnMetas[i], pp.logger); err != nil {
 			return err
@@ -443,13 +533,31 @@ func setDatumValue(d *types.Datum, v reflect.Value, meta *parquet.SchemaElement,
 }
 
 func setDatumByString(d *types.Datum, v string, meta *parquet.SchemaElement) {
-	if meta.LogicalType != nil && meta.LogicalType.DECIMAL != nil {
-		v = binaryToDecimalStr([]byte(v), int(meta.LogicalType.DECIMAL.Scale))
+	logicalType := meta.LogicalType
+	switch {
+	case logicalType != nil && logicalType.DECIMAL != nil:
+		v = binaryToDecimalStr([]byte(v), int(logicalType.DECIMAL.Scale))
+	case logicalType != nil && logicalType.UUID != nil && len(v) == 16:
+		v = fmt.Sprintf("%x-%x-%x-%x-%x", v[0:4], v[4:6], v[6:8], v[8:10], v[10:16])
+	case meta.GetType() == parquet.Type_INT96 && len(v) == 12:
+		// the deprecated INT96 timestamp, which is still written by Spark and
+		// Hive, is the nanoseconds of the day followed by the julian day, both
+		// are little-endian. It's always in UTC.
+		nanos := binary.LittleEndian.Uint64([]byte(v[:8]))
+		days := int64(binary.LittleEndian.Uint32([]byte(v[8:])))
+		t := time.Unix((days-julianDayOfUnixEpoch)*86400, int64(nanos)).UTC()
+		v = t.Format("2006-01-02 15:04:05.999999Z")
 	}
 	d.SetString(v, "")
 }
 
+// julianDayOfUnixEpoch is the julian day of 1970-01-01.
+const julianDayOfUnixEpoch = 2440588
+
 func binaryToDecimalStr(rawBytes []byte, scale int) string {
+	if len(rawBytes) == 0 {
+		return "0"
+	}
 	negative := rawBytes[0] > 127
 	if negative {
 		for i := 0; i < len(rawBytes); i++ {
@@ -502,7 +610,7 @@ func setDatumByInt(d *types.Datum, v int64, meta *parquet.SchemaElement) error {
 			minLen++
 		}
 		val := fmt.Sprintf("%0*d", minLen, v)
-		dotIndex := len(val) - int(*meta.Scale)
+		dotIndex := len(val) - int(logicalType.DECIMAL.Scale)
 		d.SetString(val[:dotIndex]+"."+val[dotIndex:], "")
 	case logicalType.DATE != nil:
 		dateStr := time.Unix(v*86400, 0).Format("2006-01-02")
@@ -540,6 +648,104 @@ func formatTime(v int64, units *parquet.TimeUnit, format, utcFormat string, utc
 	return t.Format(format)
 }
 
+// setDatumByNested sets the datum to the JSON of a nested value, and returns the
+// length of the JSON.
+func setDatumByNested(d *types.Datum, v reflect.Value, node *parquetNode, logger log.Logger) (int, error) {
+	value, err := nestedValue(v, node, logger)
+	if err != nil {
+		return 0, err
+	}
+	if value == nil {
+		d.SetNull()
+		return 0, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	d.SetString(string(data), "")
+	return len(data), nil
+}
+
+// nestedValue converts a parquet value to the value marshaled to JSON. The
+// structs and the maps are converted to JSON objects, and the lists and the
+// repeated fields are converted to JSON arrays.
+func nestedValue(v reflect.Value, node *parquetNode, logger log.Logger) (interface{}, error) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return nestedValue(v.Elem(), node, logger)
+	case reflect.Slice:
+		elemNode := node.elementNode()
+		values := make([]interface{}, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			value, err := nestedValue(v.Index(i), elemNode, logger)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	case reflect.Map:
+		keyNode, valueNode, err := node.keyValueNodes()
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			key, err := nestedValue(iter.Key(), keyNode, logger)
+			if err != nil {
+				return nil, err
+			}
+			value, err := nestedValue(iter.Value(), valueNode, logger)
+			if err != nil {
+				return nil, err
+			}
+			values[fmt.Sprint(key)] = value
+		}
+		return values, nil
+	case reflect.Struct:
+		if v.NumField() != len(node.children) {
+			return nil, errors.Errorf("the struct '%s' has %d fields, but %d fields in the schema",
+				node.name, v.NumField(), len(node.children))
+		}
+		values := make(map[string]interface{}, v.NumField())
+		for i, child := range node.children {
+			value, err := nestedValue(v.Field(i), child, logger)
+			if err != nil {
+				return nil, err
+			}
+			values[child.name] = value
+		}
+		return values, nil
+	case reflect.Bool:
+		return v.Bool(), nil
+	}
+
+	var d types.Datum
+	if err := setDatumValue(&d, v, node.meta, logger); err != nil {
+		return nil, err
+	}
+	switch d.Kind() {
+	case types.KindNull:
+		return nil, nil
+	case types.KindInt64:
+		return d.GetInt64(), nil
+	case types.KindUint64:
+		return d.GetUint64(), nil
+	case types.KindFloat64:
+		return d.GetFloat64(), nil
+	default:
+		if node.meta.LogicalType != nil && node.meta.LogicalType.DECIMAL != nil {
+			return json.Number(d.GetString()), nil
+		}
+		return d.GetString(), nil
+	}
+}
+
 // LastRow gets the last row parsed by the parser.
 // It implements the Parser interface.
 func (pp *ParquetParser) LastRow() Row {
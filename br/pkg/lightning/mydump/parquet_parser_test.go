@@ -2,16 +2,21 @@ package mydump
 
 import (
 	"context"
+	"encoding/binary"
 	"io"
 	"path/filepath"
+	"reflect"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/pingcap/tidb/br/pkg/lightning/log"
 	"github.com/pingcap/tidb/br/pkg/storage"
 	"github.com/pingcap/tidb/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/parquet"
 	writer2 "github.com/xitongsys/parquet-go/writer"
 )
 
@@ -287,3 +292,91 @@ func TestParquetAurora(t *testing.T) {
 
 	require.ErrorIs(t, parser.ReadRow(), io.EOF)
 }
+
+func TestParquetNestedTypes(t *testing.T) {
+	int32Ptr := func(v int32) *int32 { return &v }
+	elements := []*parquet.SchemaElement{
+		{Name: "schema", NumChildren: int32Ptr(3)},
+		{Name: "s", NumChildren: int32Ptr(2), RepetitionType: parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_OPTIONAL)},
+		{Name: "a", Type: parquet.TypePtr(parquet.Type_INT32)},
+		{
+			Name: "b", Type: parquet.TypePtr(parquet.Type_BYTE_ARRAY), ConvertedType: parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8),
+			RepetitionType: parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_OPTIONAL),
+		},
+		{Name: "l", NumChildren: int32Ptr(1), ConvertedType: parquet.ConvertedTypePtr(parquet.ConvertedType_LIST)},
+		{Name: "list", NumChildren: int32Ptr(1), RepetitionType: parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REPEATED)},
+		{
+			Name: "element", Type: parquet.TypePtr(parquet.Type_INT32),
+			RepetitionType: parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_OPTIONAL),
+		},
+		{Name: "m", NumChildren: int32Ptr(1), ConvertedType: parquet.ConvertedTypePtr(parquet.ConvertedType_MAP)},
+		{Name: "key_value", NumChildren: int32Ptr(2), RepetitionType: parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REPEATED)},
+		{Name: "key", Type: parquet.TypePtr(parquet.Type_BYTE_ARRAY), ConvertedType: parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8)},
+		{
+			Name: "value", Type: parquet.TypePtr(parquet.Type_INT64), ConvertedType: parquet.ConvertedTypePtr(parquet.ConvertedType_DECIMAL),
+			Scale: int32Ptr(2), Precision: int32Ptr(10),
+		},
+	}
+	root, next, err := newParquetNode(elements, func(i int) string { return elements[i].Name }, 0)
+	require.NoError(t, err)
+	require.Equal(t, len(elements), next)
+	require.Len(t, root.children, 3)
+
+	type inner struct {
+		A int32
+		B *string
+	}
+	b, one, three := "x", int32(1), int32(3)
+	row := reflect.ValueOf(struct {
+		S *inner
+		L []*int32
+		M map[string]int64
+	}{
+		S: &inner{A: 1, B: &b},
+		L: []*int32{&one, nil, &three},
+		M: map[string]int64{"k": 1234},
+	})
+	expected := []string{`{"a":1,"b":"x"}`, `[1,null,3]`, `{"k":12.34}`}
+	for i, node := range root.children {
+		require.True(t, node.isNested())
+		var d types.Datum
+		n, err := setDatumByNested(&d, row.Field(i), node, log.L())
+		require.NoError(t, err)
+		require.Equal(t, expected[i], d.GetString())
+		require.Equal(t, len(expected[i]), n)
+	}
+
+	// the null struct is converted to NULL.
+	var d types.Datum
+	_, err = setDatumByNested(&d, reflect.ValueOf((*inner)(nil)), root.children[0], log.L())
+	require.NoError(t, err)
+	require.True(t, d.IsNull())
+}
+
+func TestParquetLogicalTypes(t *testing.T) {
+	var d types.Datum
+
+	// the decimal defined by the logical type only.
+	meta := &parquet.SchemaElement{
+		Type:        parquet.TypePtr(parquet.Type_INT64),
+		LogicalType: &parquet.LogicalType{DECIMAL: &parquet.DecimalType{Scale: 2, Precision: 9}},
+	}
+	require.NoError(t, setDatumByInt(&d, -12345, meta))
+	require.Equal(t, "-123.45", d.GetString())
+
+	meta = &parquet.SchemaElement{
+		Type:        parquet.TypePtr(parquet.Type_FIXED_LEN_BYTE_ARRAY),
+		LogicalType: &parquet.LogicalType{UUID: &parquet.UUIDType{}},
+	}
+	setDatumByString(&d, string([]byte{
+		0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff,
+	}), meta)
+	require.Equal(t, "00112233-4455-6677-8899-aabbccddeeff", d.GetString())
+
+	// 1970-01-02 01:00:00.123456 in the INT96 timestamp.
+	int96 := make([]byte, 12)
+	binary.LittleEndian.PutUint64(int96[:8], uint64(time.Hour+123456*time.Microsecond))
+	binary.LittleEndian.PutUint32(int96[8:], julianDayOfUnixEpoch+1)
+	setDatumByString(&d, string(int96), &parquet.SchemaElement{Type: parquet.TypePtr(parquet.Type_INT96)})
+	require.Equal(t, "1970-01-02 01:00:00.123456Z", d.GetString())
+}
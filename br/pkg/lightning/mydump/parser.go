@@ -88,6 +88,9 @@ type ChunkParser struct {
 	blockParser
 
 	escFlavor backslashEscapeFlavor
+	// charsetConvertor converts the quoted strings and the column names from
+	// the charset of the file to utf8mb4, it's nil if no conversion is needed.
+	charsetConvertor *CharsetConvertor
 }
 
 // Chunk represents a portion of the data file.
@@ -147,6 +150,7 @@ func NewChunkParser(
 	reader ReadSeekCloser,
 	blockBufSize int64,
 	ioWorkers *worker.Pool,
+	charsetConvertor *CharsetConvertor,
 ) *ChunkParser {
 	escFlavor := backslashEscapeFlavorMySQL
 	if sqlMode.HasNoBackslashEscapesMode() {
@@ -154,8 +158,9 @@ func NewChunkParser(
 	}
 	metrics, _ := metric.FromContext(ctx)
 	return &ChunkParser{
-		blockParser: makeBlockParser(reader, blockBufSize, ioWorkers, metrics, log.FromContext(ctx)),
-		escFlavor:   escFlavor,
+		blockParser:      makeBlockParser(reader, blockBufSize, ioWorkers, metrics, log.FromContext(ctx)),
+		escFlavor:        escFlavor,
+		charsetConvertor: charsetConvertor,
 	}
 }
 
@@ -317,16 +322,21 @@ func unescape(
 	return input
 }
 
-func (parser *ChunkParser) unescapeString(input string) string {
+func (parser *ChunkParser) unescapeString(input string) (string, error) {
+	// Convert the input from another charset to utf8mb4 before we return the string.
+	input, err := parser.charsetConvertor.Decode(input)
+	if err != nil {
+		return "", errors.Annotatef(err, "at offset %d", parser.pos)
+	}
 	if len(input) >= 2 {
 		switch input[0] {
 		case '\'', '"':
-			return unescape(input[1:len(input)-1], input[:1], parser.escFlavor)
+			return unescape(input[1:len(input)-1], input[:1], parser.escFlavor), nil
 		case '`':
-			return unescape(input[1:len(input)-1], "`", backslashEscapeFlavorNone)
+			return unescape(input[1:len(input)-1], "`", backslashEscapeFlavorNone), nil
 		}
 	}
-	return input
+	return input, nil
 }
 
 // ReadRow reads a row from the datafile.
@@ -457,8 +467,11 @@ func (parser *ChunkParser) ReadRow() error {
 			case tokRowEnd:
 				st = stateValues
 			case tokUnquoted, tokDoubleQuoted, tokBackQuoted:
-				columnName := strings.ToLower(parser.unescapeString(string(content)))
-				parser.columns = append(parser.columns, columnName)
+				columnName, err := parser.unescapeString(string(content))
+				if err != nil {
+					return err
+				}
+				parser.columns = append(parser.columns, strings.ToLower(columnName))
 			default:
 				return errors.Errorf(
 					"syntax error: unexpected %s (%s) at offset %d, expecting %s",
@@ -512,7 +525,11 @@ func (parser *ChunkParser) ReadRow() error {
 				// can't handle integers more than 64 bits anyway)
 				fallthrough
 			case tokUnquoted, tokSingleQuoted, tokDoubleQuoted:
-				value.SetString(parser.unescapeString(string(content)), "utf8mb4_bin")
+				str, err := parser.unescapeString(string(content))
+				if err != nil {
+					return err
+				}
+				value.SetString(str, "utf8mb4_bin")
 			case tokHexString:
 				hexLit, err := types.ParseHexStr(string(content))
 				if err != nil {
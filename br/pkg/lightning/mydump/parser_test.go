@@ -31,7 +31,7 @@ import (
 
 func runTestCases(t *testing.T, mode mysql.SQLMode, blockBufSize int64, cases []testCase) {
 	for _, tc := range cases {
-		parser := mydump.NewChunkParser(context.Background(), mode, mydump.NewStringReader(tc.input), blockBufSize, ioWorkers)
+		parser := mydump.NewChunkParser(context.Background(), mode, mydump.NewStringReader(tc.input), blockBufSize, ioWorkers, nil)
 		for i, row := range tc.expected {
 			e := parser.ReadRow()
 			comment := fmt.Sprintf("input = %q, row = %d, err = %s", tc.input, i+1, errors.ErrorStack(e))
@@ -45,7 +45,7 @@ func runTestCases(t *testing.T, mode mysql.SQLMode, blockBufSize int64, cases []
 
 func runFailingTestCases(t *testing.T, mode mysql.SQLMode, blockBufSize int64, cases []string) {
 	for _, tc := range cases {
-		parser := mydump.NewChunkParser(context.Background(), mode, mydump.NewStringReader(tc), blockBufSize, ioWorkers)
+		parser := mydump.NewChunkParser(context.Background(), mode, mydump.NewStringReader(tc), blockBufSize, ioWorkers, nil)
 		assert.Regexpf(t, "syntax error.*", parser.ReadRow().Error(), "input = %q", tc)
 	}
 }
@@ -58,7 +58,7 @@ func TestReadRow(t *testing.T) {
 			"insert another_table values (10,11e1,12, '(13)', '(', 14, ')');",
 	)
 
-	parser := mydump.NewChunkParser(context.Background(), mysql.ModeNone, reader, int64(config.ReadBlockSize), ioWorkers)
+	parser := mydump.NewChunkParser(context.Background(), mysql.ModeNone, reader, int64(config.ReadBlockSize), ioWorkers, nil)
 
 	require.NoError(t, parser.ReadRow())
 	require.Equal(t, mydump.Row{
@@ -134,7 +134,7 @@ func TestReadChunks(t *testing.T) {
 		INSERT foo VALUES (29,30,31,32),(33,34,35,36);
 	`)
 
-	parser := mydump.NewChunkParser(context.Background(), mysql.ModeNone, reader, int64(config.ReadBlockSize), ioWorkers)
+	parser := mydump.NewChunkParser(context.Background(), mysql.ModeNone, reader, int64(config.ReadBlockSize), ioWorkers, nil)
 
 	chunks, err := mydump.ReadChunks(parser, 32)
 	require.NoError(t, err)
@@ -180,7 +180,7 @@ func TestNestedRow(t *testing.T) {
 		("789",CONVERT("[]" USING UTF8MB4));
 	`)
 
-	parser := mydump.NewChunkParser(context.Background(), mysql.ModeNone, reader, int64(config.ReadBlockSize), ioWorkers)
+	parser := mydump.NewChunkParser(context.Background(), mysql.ModeNone, reader, int64(config.ReadBlockSize), ioWorkers, nil)
 	chunks, err := mydump.ReadChunks(parser, 96)
 
 	require.NoError(t, err)
@@ -413,7 +413,7 @@ func TestPseudoKeywords(t *testing.T) {
 		) VALUES ();
 	`)
 
-	parser := mydump.NewChunkParser(context.Background(), mysql.ModeNone, reader, int64(config.ReadBlockSize), ioWorkers)
+	parser := mydump.NewChunkParser(context.Background(), mysql.ModeNone, reader, int64(config.ReadBlockSize), ioWorkers, nil)
 	require.NoError(t, parser.ReadRow())
 	require.Equal(t, []string{
 		"c", "c",
@@ -878,3 +878,21 @@ func TestMoreEmptyFiles(t *testing.T) {
 	runTestCases(t, mysql.ModeNone, 1, testCases)
 	runTestCases(t, mysql.ModeNoBackslashEscapes, 1, testCases)
 }
+
+func TestReadRowWithCharset(t *testing.T) {
+	// "INSERT INTO t (`名`) VALUES ('你好'),(x'ff'),('\xff');" in GBK.
+	reader := mydump.NewStringReader("INSERT INTO t (`\xc3\xfb`) VALUES ('\xc4\xe3\xba\xc3'),(x'ff'),('\xff');")
+	charsetConvertor, err := mydump.NewCharsetConvertor("gbk", "?")
+	require.NoError(t, err)
+	parser := mydump.NewChunkParser(context.Background(), mysql.ModeNone, reader, int64(config.ReadBlockSize), ioWorkers, charsetConvertor)
+
+	require.NoError(t, parser.ReadRow())
+	require.Equal(t, []string{"名"}, parser.Columns())
+	require.Equal(t, []types.Datum{types.NewStringDatum("你好")}, parser.LastRow().Row)
+	// the binary literals aren't converted.
+	require.NoError(t, parser.ReadRow())
+	require.Equal(t, []types.Datum{types.NewBinaryLiteralDatum([]byte{0xff})}, parser.LastRow().Row)
+	require.NoError(t, parser.ReadRow())
+	require.Equal(t, []types.Datum{types.NewStringDatum("?")}, parser.LastRow().Row)
+	require.ErrorIs(t, errors.Cause(parser.ReadRow()), io.EOF)
+}
@@ -22,6 +22,7 @@ import (
 	"strings"
 	"unicode/utf8"
 
+	"github.com/docker/go-units"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/br/pkg/lightning/log"
 	"github.com/pingcap/tidb/br/pkg/lightning/worker"
@@ -36,6 +37,10 @@ var (
 	errInvalidSchemaEncoding   = errors.New("invalid schema encoding")
 )
 
+// sampleCompressedFileSize is the size of the decompressed data read from the
+// beginning of a compressed file to estimate its compression ratio.
+const sampleCompressedFileSize = 4 * units.MiB
+
 func decodeCharacterSet(data []byte, characterSet string) ([]byte, error) {
 	switch characterSet {
 	case "binary":
@@ -70,7 +75,7 @@ func decodeCharacterSet(data []byte, characterSet string) ([]byte, error) {
 
 // ExportStatement exports the SQL statement in the schema file.
 func ExportStatement(ctx context.Context, store storage.ExternalStorage, sqlFile FileInfo, characterSet string) ([]byte, error) {
-	fd, err := store.Open(ctx, sqlFile.FileMeta.Path)
+	fd, err := OpenReader(ctx, &sqlFile.FileMeta, store, storage.DecompressConfig{})
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -118,6 +123,137 @@ func ExportStatement(ctx context.Context, store storage.ExternalStorage, sqlFile
 	return data, nil
 }
 
+// ToStorageCompressType converts the compression type of the source file to
+// the compression type of the external storage.
+func ToStorageCompressType(compression Compression) (storage.CompressType, error) {
+	switch compression {
+	case CompressionNone:
+		return storage.NoCompression, nil
+	case CompressionGZ:
+		return storage.Gzip, nil
+	case CompressionZStd:
+		return storage.Zstd, nil
+	case CompressionLZ4:
+		return storage.LZ4, nil
+	case CompressionSnappy:
+		return storage.Snappy, nil
+	default:
+		return storage.NoCompression, errors.Errorf("compression type '%s' isn't supported", compression)
+	}
+}
+
+// OpenReader opens the source file. The compressed file is decompressed while
+// it's read, and it can only be seeked forward.
+func OpenReader(
+	ctx context.Context,
+	fileMeta *SourceFileMeta,
+	store storage.ExternalStorage,
+	decompressCfg storage.DecompressConfig,
+) (storage.ReadSeekCloser, error) {
+	compressType, err := ToStorageCompressType(fileMeta.Compression)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := store.Open(ctx, fileMeta.Path)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if compressType == storage.NoCompression {
+		return reader, nil
+	}
+	decompressed, err := storage.InterceptDecompressReader(reader, compressType, decompressCfg)
+	if err != nil {
+		_ = reader.Close()
+		return nil, errors.Annotatef(err, "failed to decompress file '%s'", fileMeta.Path)
+	}
+	return &decompressedReader{reader: decompressed}, nil
+}
+
+// decompressedReader reads the decompressed data of a compressed file. Since
+// the compressed file can't be seeked, seeking forward skips the data, which
+// is enough to resume the import of the file from the checkpoint.
+type decompressedReader struct {
+	reader io.ReadCloser
+	pos    int64
+}
+
+// Read implements io.Reader.
+func (r *decompressedReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.pos += int64(n)
+	return n, err
+}
+
+// Seek implements io.Seeker.
+func (r *decompressedReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		offset -= r.pos
+	case io.SeekCurrent:
+	default:
+		return r.pos, errors.Errorf("can't seek the compressed file from whence %d", whence)
+	}
+	if offset < 0 {
+		return r.pos, errors.Errorf("can't seek the compressed file backward from %d to %d", r.pos, r.pos+offset)
+	}
+	n, err := io.CopyN(io.Discard, r.reader, offset)
+	r.pos += n
+	return r.pos, errors.Trace(err)
+}
+
+// Close implements io.Closer.
+func (r *decompressedReader) Close() error {
+	return r.reader.Close()
+}
+
+// countingReader counts the bytes read from the file.
+type countingReader struct {
+	storage.ExternalFileReader
+	n int64
+}
+
+// Read implements io.Reader.
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.ExternalFileReader.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+// SampleFileCompressRatio estimates the compression ratio of the file, which
+// is the size of the decompressed data divided by the size of the compressed
+// data, by decompressing the beginning of the file.
+func SampleFileCompressRatio(ctx context.Context, fileMeta SourceFileMeta, store storage.ExternalStorage) (float64, error) {
+	if fileMeta.Compression == CompressionNone {
+		return 1, nil
+	}
+	compressType, err := ToStorageCompressType(fileMeta.Compression)
+	if err != nil {
+		return 0, err
+	}
+	reader, err := store.Open(ctx, fileMeta.Path)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	counter := &countingReader{ExternalFileReader: reader}
+	// decompress the file synchronously, so the compressed data isn't read
+	// ahead of the decompressed data.
+	decompressed, err := storage.InterceptDecompressReader(counter, compressType, storage.DecompressConfig{ZStdDecodeConcurrency: 1})
+	if err != nil {
+		_ = reader.Close()
+		return 0, errors.Annotatef(err, "failed to decompress file '%s'", fileMeta.Path)
+	}
+	//nolint: errcheck
+	defer decompressed.Close()
+	n, err := io.CopyN(io.Discard, decompressed, sampleCompressedFileSize)
+	if err != nil && err != io.EOF {
+		return 0, errors.Annotatef(err, "failed to decompress file '%s'", fileMeta.Path)
+	}
+	if counter.n == 0 {
+		return 1, nil
+	}
+	return float64(n) / float64(counter.n), nil
+}
+
 // ReadSeekCloser = Reader + Seeker + Closer
 type ReadSeekCloser interface {
 	io.Reader
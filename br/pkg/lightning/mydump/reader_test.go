@@ -17,8 +17,10 @@ package mydump_test
 import (
 	"context"
 	"errors"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -173,3 +175,42 @@ func TestExportStatementHandleNonEOFError(t *testing.T) {
 	_, err := ExportStatement(ctx, mockStorage, f, "auto")
 	require.Contains(t, err.Error(), "read error")
 }
+
+func TestOpenCompressedReader(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := storage.NewLocalStorage(dir)
+	require.NoError(t, err)
+	content := []byte(strings.Repeat("1,2,3\n", 1000))
+
+	for _, compression := range []Compression{CompressionGZ, CompressionZStd, CompressionLZ4, CompressionSnappy} {
+		compressType, err := ToStorageCompressType(compression)
+		require.NoError(t, err)
+		fileName := "db.tbl.csv." + compression.String()
+		require.NoError(t, storage.WithCompression(store, compressType).WriteFile(ctx, fileName, content))
+		stat, err := os.Stat(filepath.Join(dir, fileName))
+		require.NoError(t, err)
+		fileMeta := SourceFileMeta{Path: fileName, Type: SourceTypeCSV, Compression: compression, FileSize: stat.Size()}
+
+		reader, err := OpenReader(ctx, &fileMeta, store, storage.DecompressConfig{ZStdDecodeConcurrency: 2})
+		require.NoError(t, err)
+		// the compressed file can only be seeked forward.
+		pos, err := reader.Seek(600, io.SeekStart)
+		require.NoError(t, err)
+		require.Equal(t, int64(600), pos)
+		_, err = reader.Seek(0, io.SeekStart)
+		require.Error(t, err)
+		data, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.Equal(t, content[600:], data)
+		require.NoError(t, reader.Close())
+
+		// the file is smaller than the sample size, so it's drained.
+		ratio, err := SampleFileCompressRatio(ctx, fileMeta, store)
+		require.NoError(t, err)
+		require.InEpsilon(t, float64(len(content))/float64(stat.Size()), ratio, 0.01)
+	}
+
+	_, err = ToStorageCompressType(CompressionXZ)
+	require.EqualError(t, err, "compression type 'xz' isn't supported")
+}
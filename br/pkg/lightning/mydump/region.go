@@ -271,6 +271,16 @@ func makeSourceFileRegion(
 		}
 		return []*TableRegion{region}, []float64{float64(fi.FileMeta.FileSize)}, nil
 	}
+	if fi.FileMeta.Type == SourceTypeAvro {
+		_, region, err := makeAvroFileRegion(ctx, store, meta, fi, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		return []*TableRegion{region}, []float64{float64(fi.FileMeta.FileSize)}, nil
+	}
+	if fi.FileMeta.IsStream {
+		return []*TableRegion{makeStreamRegion(meta, fi)}, []float64{0}, nil
+	}
 
 	dataFileSize := fi.FileMeta.FileSize
 	divisor := int64(columns)
@@ -278,6 +288,9 @@ func makeSourceFileRegion(
 	if !isCsvFile {
 		divisor += 2
 	}
+	if fi.FileMeta.Compression != CompressionNone {
+		return makeCompressedFileRegion(ctx, meta, fi, divisor, cfg, store)
+	}
 	// If a csv file is overlarge, we need to split it into multiple regions.
 	// Note: We can only split a csv file whose format is strict.
 	// We increase the check threshold by 1/10 of the `max-region-size` because the source file size dumped by tools
@@ -309,6 +322,61 @@ func makeSourceFileRegion(
 	return []*TableRegion{tableRegion}, []float64{float64(fi.FileMeta.FileSize)}, nil
 }
 
+// the compressed file can't be split since it can't be seeked, and the offsets
+// of the region are the positions in the decompressed data, so the end offset
+// is got by decompressing the whole file. The files are decompressed
+// concurrently by the workers of MakeTableRegions.
+func makeCompressedFileRegion(
+	ctx context.Context,
+	meta *MDTableMeta,
+	fi FileInfo,
+	divisor int64,
+	cfg *config.Config,
+	store storage.ExternalStorage,
+) ([]*TableRegion, []float64, error) {
+	reader, err := OpenReader(ctx, &fi.FileMeta, store, storage.DecompressConfig{
+		ZStdDecodeConcurrency: cfg.Mydumper.DecompressConcurrency,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	//nolint: errcheck
+	defer reader.Close()
+	size, err := io.Copy(io.Discard, reader)
+	if err != nil {
+		return nil, nil, errors.Annotatef(err, "failed to decompress file '%s'", fi.FileMeta.Path)
+	}
+
+	tableRegion := &TableRegion{
+		DB:       meta.DB,
+		Table:    meta.Name,
+		FileMeta: fi.FileMeta,
+		Chunk: Chunk{
+			Offset:       0,
+			EndOffset:    size,
+			PrevRowIDMax: 0,
+			RowIDMax:     size / divisor,
+		},
+	}
+	return []*TableRegion{tableRegion}, []float64{float64(size)}, nil
+}
+
+// the stream can't be split in advance, it's cut into chunks while it's read,
+// so the end offset is unknown and the row IDs are allocated by the chunks.
+func makeStreamRegion(meta *MDTableMeta, dataFile FileInfo) *TableRegion {
+	return &TableRegion{
+		DB:       meta.DB,
+		Table:    meta.Name,
+		FileMeta: dataFile.FileMeta,
+		Chunk: Chunk{
+			Offset:       0,
+			EndOffset:    StreamEndOffset,
+			PrevRowIDMax: 0,
+			RowIDMax:     0,
+		},
+	}
+}
+
 // because parquet files can't seek efficiently, there is no benefit in split.
 // parquet file are column orient, so the offset is read line number
 func makeParquetFileRegion(
@@ -341,6 +409,40 @@ func makeParquetFileRegion(
 	return rowIDMax, region, nil
 }
 
+// avro files are not split either, the offset is the read record number, the
+// same as the parquet files.
+func makeAvroFileRegion(
+	ctx context.Context,
+	store storage.ExternalStorage,
+	meta *MDTableMeta,
+	dataFile FileInfo,
+	prevRowIdxMax int64,
+) (int64, *TableRegion, error) {
+	r, err := store.Open(ctx, dataFile.FileMeta.Path)
+	if err != nil {
+		return prevRowIdxMax, nil, errors.Trace(err)
+	}
+	//nolint: errcheck
+	defer r.Close()
+	numberRows, err := ReadAvroFileRowCount(r)
+	if err != nil {
+		return 0, nil, errors.Annotatef(err, "failed to read avro file %s", dataFile.FileMeta.Path)
+	}
+	rowIDMax := prevRowIdxMax + numberRows
+	region := &TableRegion{
+		DB:       meta.DB,
+		Table:    meta.Name,
+		FileMeta: dataFile.FileMeta,
+		Chunk: Chunk{
+			Offset:       0,
+			EndOffset:    numberRows,
+			PrevRowIDMax: prevRowIdxMax,
+			RowIDMax:     rowIDMax,
+		},
+	}
+	return rowIDMax, region, nil
+}
+
 // SplitLargeFile splits a large csv file into multiple regions, the size of
 // each regions is specified by `config.MaxRegionSize`.
 // Note: We split the file coarsely, thus the format of csv file is needed to be
@@ -368,7 +470,7 @@ func SplitLargeFile(
 			return 0, nil, nil, err
 		}
 		// Create a utf8mb4 convertor to encode and decode data with the charset of CSV files.
-		charsetConvertor, err := NewCharsetConvertor(cfg.Mydumper.DataCharacterSet, cfg.Mydumper.DataInvalidCharReplace)
+		charsetConvertor, err := NewFileCharsetConvertor(&cfg.Mydumper, dataFile.FileMeta)
 		if err != nil {
 			return 0, nil, nil, err
 		}
@@ -395,7 +497,7 @@ func SplitLargeFile(
 				return 0, nil, nil, err
 			}
 			// Create a utf8mb4 convertor to encode and decode data with the charset of CSV files.
-			charsetConvertor, err := NewCharsetConvertor(cfg.Mydumper.DataCharacterSet, cfg.Mydumper.DataInvalidCharReplace)
+			charsetConvertor, err := NewFileCharsetConvertor(&cfg.Mydumper, dataFile.FileMeta)
 			if err != nil {
 				return 0, nil, nil, err
 			}
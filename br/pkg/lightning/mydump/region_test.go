@@ -18,6 +18,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/pingcap/tidb/br/pkg/lightning/config"
@@ -382,3 +383,37 @@ func TestSplitLargeFileOnlyOneChunk(t *testing.T) {
 		require.Equal(t, columns, regions[i].Chunk.Columns)
 	}
 }
+
+func TestMakeCompressedFileRegion(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := storage.NewLocalStorage(dir)
+	require.NoError(t, err)
+	content := []byte(strings.Repeat("1,2,3\n", 1000))
+	fileName := "db.tbl.001.csv.zst"
+	require.NoError(t, storage.WithCompression(store, storage.Zstd).WriteFile(ctx, fileName, content))
+	stat, err := os.Stat(filepath.Join(dir, fileName))
+	require.NoError(t, err)
+
+	cfg := config.NewConfig()
+	cfg.Mydumper.StrictFormat = true
+	cfg.Mydumper.MaxRegionSize = 100
+	meta := &MDTableMeta{
+		DB:   "db",
+		Name: "tbl",
+		DataFiles: []FileInfo{{FileMeta: SourceFileMeta{
+			Path:        fileName,
+			Type:        SourceTypeCSV,
+			Compression: CompressionZStd,
+			FileSize:    stat.Size(),
+		}}},
+	}
+	ioWorkers := worker.NewPool(ctx, 1, "io")
+	regions, err := MakeTableRegions(ctx, meta, 3, cfg, ioWorkers, store)
+	require.NoError(t, err)
+	// the compressed file isn't split, and the offsets are in the decompressed data.
+	require.Len(t, regions, 1)
+	require.Equal(t, int64(0), regions[0].Chunk.Offset)
+	require.Equal(t, int64(len(content)), regions[0].Chunk.EndOffset)
+	require.Equal(t, int64(len(content)/3), regions[0].Chunk.RowIDMax)
+}
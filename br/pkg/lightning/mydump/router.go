@@ -32,6 +32,8 @@ const (
 	SourceTypeParquet
 	// SourceTypeViewSchema means this source file is a schema file for the view.
 	SourceTypeViewSchema
+	// SourceTypeAvro means this source file is an avro data file.
+	SourceTypeAvro
 )
 
 const (
@@ -47,6 +49,8 @@ const (
 	TypeCSV = "csv"
 	// TypeParquet is the source type value for parquet data file.
 	TypeParquet = "parquet"
+	// TypeAvro is the source type value for avro data file.
+	TypeAvro = "avro"
 	// TypeIgnore is the source type value for a ignored data file.
 	TypeIgnore = "ignore"
 )
@@ -65,6 +69,8 @@ const (
 	CompressionZStd
 	// CompressionXZ is the compression type that uses XZ algorithm.
 	CompressionXZ
+	// CompressionSnappy is the compression type that uses Snappy algorithm.
+	CompressionSnappy
 )
 
 func parseSourceType(t string) (SourceType, error) {
@@ -79,6 +85,8 @@ func parseSourceType(t string) (SourceType, error) {
 		return SourceTypeCSV, nil
 	case TypeParquet:
 		return SourceTypeParquet, nil
+	case TypeAvro:
+		return SourceTypeAvro, nil
 	case TypeIgnore:
 		return SourceTypeIgnore, nil
 	case ViewSchema:
@@ -100,6 +108,8 @@ func (s SourceType) String() string {
 		return TypeSQL
 	case SourceTypeParquet:
 		return TypeParquet
+	case SourceTypeAvro:
+		return TypeAvro
 	case SourceTypeViewSchema:
 		return ViewSchema
 	default:
@@ -107,16 +117,35 @@ func (s SourceType) String() string {
 	}
 }
 
+func (c Compression) String() string {
+	switch c {
+	case CompressionGZ:
+		return "gz"
+	case CompressionLZ4:
+		return "lz4"
+	case CompressionZStd:
+		return "zstd"
+	case CompressionXZ:
+		return "xz"
+	case CompressionSnappy:
+		return "snappy"
+	default:
+		return "none"
+	}
+}
+
 func parseCompressionType(t string) (Compression, error) {
 	switch strings.ToLower(strings.TrimSpace(t)) {
-	case "gz":
+	case "gz", "gzip":
 		return CompressionGZ, nil
 	case "lz4":
 		return CompressionLZ4, nil
-	case "zstd":
+	case "zstd", "zst":
 		return CompressionZStd, nil
 	case "xz":
 		return CompressionXZ, nil
+	case "snappy":
+		return CompressionSnappy, nil
 	case "":
 		return CompressionNone, nil
 	default:
@@ -135,8 +164,8 @@ var defaultFileRouteRules = []*config.FileRouteRule{
 	{Pattern: `(?i)^(?:[^/]*/)*([^/.]+)\.(.*?)-schema\.sql$`, Schema: "$1", Table: "$2", Type: TableSchema, Unescape: true},
 	// view schema create file pattern, matches files like '{schema}.{table}-schema-view.sql'
 	{Pattern: `(?i)^(?:[^/]*/)*([^/.]+)\.(.*?)-schema-view\.sql$`, Schema: "$1", Table: "$2", Type: ViewSchema, Unescape: true},
-	// source file pattern, matches files like '{schema}.{table}.0001.{sql|csv}'
-	{Pattern: `(?i)^(?:[^/]*/)*([^/.]+)\.(.*?)(?:\.([0-9]+))?\.(sql|csv|parquet)$`, Schema: "$1", Table: "$2", Type: "$4", Key: "$3", Unescape: true},
+	// source file pattern, matches files like '{schema}.{table}.0001.{sql|csv|parquet|avro}{.gz|.zst|.lz4|.snappy}'
+	{Pattern: `(?i)^(?:[^/]*/)*([^/.]+)\.(.*?)(?:\.([0-9]+))?\.(sql|csv|parquet|avro)(?:\.(gz|gzip|zst|zstd|lz4|snappy))?$`, Schema: "$1", Table: "$2", Type: "$4", Key: "$3", Compression: "$5", Unescape: true},
 }
 
 // FileRouter provides some operations to apply a rule to route file path to target schema/table
@@ -211,6 +240,12 @@ func (p regexRouterParser) Parse(r *config.FileRouteRule, logger log.Logger) (*R
 		return nil, errors.New("can't set both `path` and `pattern` field in [[mydumper.files]]")
 	}
 	if r.Path != "" {
+		// the constant path has no sub pattern to capture the partition from.
+		for _, subVar := range expandVariablePattern.FindAllString(r.Partition, -1) {
+			if subVar != "$$" {
+				return nil, errors.Errorf("can't use the sub pattern capture '%s' in the partition of the `path` rule in [[mydumper.files]]", subVar)
+			}
+		}
 		// convert constant string as a regexp pattern
 		r.Pattern = regexp.QuoteMeta(r.Path)
 		// escape all '$' by '$$' in match templates
@@ -220,6 +255,8 @@ func (p regexRouterParser) Parse(r *config.FileRouteRule, logger log.Logger) (*R
 		r.Type = quoteTmplFn(r.Type)
 		r.Compression = quoteTmplFn(r.Compression)
 		r.Key = quoteTmplFn(r.Key)
+		r.Partition = quoteTmplFn(r.Partition)
+		r.DataCharacterSet = quoteTmplFn(r.DataCharacterSet)
 	}
 	pattern, err := regexp.Compile(r.Pattern)
 	if err != nil {
@@ -287,13 +324,12 @@ func (p regexRouterParser) Parse(r *config.FileRouteRule, logger log.Logger) (*R
 
 	if len(r.Compression) > 0 {
 		err = p.parseFieldExtractor(rule, "compression", r.Compression, func(result *RouteResult, value string) error {
-			// TODO: should support restore compressed source files
 			compression, err := parseCompressionType(value)
 			if err != nil {
 				return err
 			}
-			if compression != CompressionNone {
-				return errors.New("Currently we don't support restore compressed source file yet")
+			if _, err := ToStorageCompressType(compression); err != nil {
+				return err
 			}
 			result.Compression = compression
 			return nil
@@ -303,6 +339,29 @@ func (p regexRouterParser) Parse(r *config.FileRouteRule, logger log.Logger) (*R
 		}
 	}
 
+	if len(r.Partition) > 0 {
+		err = p.parseFieldExtractor(rule, "partition", r.Partition, func(result *RouteResult, value string) error {
+			setValue(&result.Partition, value, r.Unescape)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(r.DataCharacterSet) > 0 {
+		err = p.parseFieldExtractor(rule, "data-character-set", r.DataCharacterSet, func(result *RouteResult, value string) error {
+			if _, err := config.ParseCharset(value); err != nil {
+				return err
+			}
+			result.DataCharacterSet = value
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return rule, nil
 }
 
@@ -373,4 +432,8 @@ type RouteResult struct {
 	Key         string
 	Compression Compression
 	Type        SourceType
+	Partition   string
+	// DataCharacterSet is the charset of the file, it's empty if the file
+	// uses `mydumper.data-character-set`.
+	DataCharacterSet string
 }
@@ -90,7 +90,7 @@ func TestSingleRouteRule(t *testing.T) {
 		assert.NoError(t, e)
 		ty, e := parseSourceType(fields[4])
 		assert.NoError(t, e)
-		exp := &RouteResult{filter.Table{Schema: fields[0], Name: fields[1]}, fields[2], compress, ty}
+		exp := &RouteResult{filter.Table{Schema: fields[0], Name: fields[1]}, fields[2], compress, ty, "", ""}
 		assert.Equal(t, exp, res)
 	}
 
@@ -112,7 +112,7 @@ func TestSingleRouteRule(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, r)
 	invalidMatchPaths := []string{
-		"my_schema.my_table.sql.gz",
+		"my_schema.my_table.sql.xz",
 		"my_schema.my_table.sql.rar",
 		"my_schema.my_table.txt",
 	}
@@ -143,7 +143,8 @@ func TestMultiRouteRule(t *testing.T) {
 		"/test/123/my_schema.my_table.sql": {"my_schema", "my_table", "", "", "sql"},
 		"my_dir/my_schema.my_table.csv":    {"my_schema", "my_table", "", "", "csv"},
 		"my_schema.my_table.0001.sql":      {"my_schema", "my_table", "0001", "", "sql"},
-		// "my_schema.my_table.0001.sql.gz":      {"my_schema", "my_table", "0001", "gz", "sql"},
+		"my_schema.my_table.0001.sql.gz":   {"my_schema", "my_table", "0001", "gz", "sql"},
+		"my_schema.my_table.0001.csv.zstd": {"my_schema", "my_table", "0001", "zstd", "csv"},
 	}
 	for path, fields := range inputOutputMap {
 		res, err := r.Route(path)
@@ -155,7 +156,7 @@ func TestMultiRouteRule(t *testing.T) {
 			assert.NoError(t, e)
 			ty, e := parseSourceType(fields[4])
 			assert.NoError(t, e)
-			exp := &RouteResult{filter.Table{Schema: fields[0], Name: fields[1]}, fields[2], compress, ty}
+			exp := &RouteResult{filter.Table{Schema: fields[0], Name: fields[1]}, fields[2], compress, ty, "", ""}
 			assert.Equal(t, exp, res)
 		}
 	}
@@ -176,7 +177,7 @@ func TestMultiRouteRule(t *testing.T) {
 			assert.NoError(t, e)
 			ty, e := parseSourceType(fields[4])
 			assert.NoError(t, e)
-			exp := &RouteResult{filter.Table{Schema: fields[0], Name: fields[1]}, fields[2], compress, ty}
+			exp := &RouteResult{filter.Table{Schema: fields[0], Name: fields[1]}, fields[2], compress, ty, "", ""}
 			assert.Equal(t, exp, res)
 		}
 	}
@@ -252,3 +253,56 @@ func TestRouteWithPath(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, res)
 }
+
+func TestRouteWithPartition(t *testing.T) {
+	rules := []*config.FileRouteRule{
+		{Pattern: `^(?:[^/]*/)*([^/.]+)\.([^./]+)\.(p[0-9]+)\.[0-9]+\.(csv|sql)$`, Schema: "$1", Table: "$2", Type: "$4", Partition: "$3"},
+		{Path: "db.tbl.csv", Schema: "db", Table: "tbl", Type: "csv", Partition: "p1"},
+	}
+	router, err := NewFileRouter(rules, log.L())
+	require.NoError(t, err)
+
+	res, err := router.Route("db/db.tbl.p1.000.csv")
+	require.NoError(t, err)
+	require.Equal(t, &RouteResult{filter.Table{Schema: "db", Name: "tbl"}, "", CompressionNone, SourceTypeCSV, "p1", ""}, res)
+	res, err = router.Route("db.tbl.csv")
+	require.NoError(t, err)
+	require.Equal(t, "p1", res.Partition)
+
+	// the path rule can't capture the partition.
+	pathRule := &config.FileRouteRule{Path: "db.tbl.csv", Schema: "db", Table: "tbl", Type: "csv", Partition: "p$1"}
+	_, err = NewFileRouter([]*config.FileRouteRule{pathRule}, log.L())
+	require.Regexp(t, "can't use the sub pattern capture '\\$1' in the partition of the `path` rule", err.Error())
+
+	// the invalid capture of the partition is rejected.
+	rules[0].Partition = "$5"
+	_, err = NewFileRouter(rules[:1], log.L())
+	require.Regexp(t, "sub pattern capture '\\$5' out of range", err.Error())
+}
+
+func TestRouteWithDataCharacterSet(t *testing.T) {
+	rules := []*config.FileRouteRule{
+		{Pattern: `^(?:[^/]*/)*([^/.]+)\.([^./]+)\.(gbk|latin1)\.(csv|sql)$`, Schema: "$1", Table: "$2", Type: "$4", DataCharacterSet: "$3"},
+		{Pattern: `^(?:[^/]*/)*([^/.]+)\.([^./]+)\.(csv|sql)$`, Schema: "$1", Table: "$2", Type: "$3"},
+	}
+	router, err := NewFileRouter(rules, log.L())
+	require.NoError(t, err)
+
+	res, err := router.Route("db/db.tbl.gbk.sql")
+	require.NoError(t, err)
+	require.Equal(t, "gbk", res.DataCharacterSet)
+	res, err = router.Route("db/db.tbl.latin1.csv")
+	require.NoError(t, err)
+	require.Equal(t, "latin1", res.DataCharacterSet)
+	// the files not matched by the rule use `mydumper.data-character-set`.
+	res, err = router.Route("db/db.tbl.csv")
+	require.NoError(t, err)
+	require.Equal(t, "", res.DataCharacterSet)
+
+	// the unsupported charset is rejected.
+	rules[0].Pattern = `^(?:[^/]*/)*([^/.]+)\.([^./]+)\.(\w+)\.(csv|sql)$`
+	router, err = NewFileRouter(rules, log.L())
+	require.NoError(t, err)
+	_, err = router.Route("db/db.tbl.big5.sql")
+	require.EqualError(t, err, "found unsupported data-character-set: big5")
+}
@@ -0,0 +1,472 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydump
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/backend/kv"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/lightning/log"
+	"github.com/pingcap/tidb/br/pkg/lightning/metric"
+	"github.com/pingcap/tidb/br/pkg/lightning/worker"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/mysql"
+	_ "github.com/pingcap/tidb/planner/core" // to setup expression.EvalAstExpr. Otherwise we cannot evaluate the values
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+	"go.uber.org/zap"
+)
+
+const defaultDelimiter = ";"
+
+// definerStmtRegexp matches the statements creating the stored objects with
+// DEFINER clauses, including the ones wrapped in the versioned comments like
+//
+//	/*!50001 CREATE ALGORITHM=UNDEFINED */ /*!50013 DEFINER=`root`@`%` SQL SECURITY DEFINER */ ...
+var definerStmtRegexp = regexp.MustCompile(`(?is)^(?:\s|/\*!\d*|\*/)*CREATE\b[\w\s=/*!]*?\bDEFINER\s*=`)
+
+// NewSQLParser creates a parser of the SQL data file according to
+// `mydumper.sql-parser`.
+func NewSQLParser(
+	ctx context.Context,
+	cfg *config.MydumperRuntime,
+	sqlMode mysql.SQLMode,
+	path string,
+	reader ReadSeekCloser,
+	blockBufSize int64,
+	ioWorkers *worker.Pool,
+	charsetConvertor *CharsetConvertor,
+) Parser {
+	switch cfg.SQLParser {
+	case config.SQLParserLenient, config.SQLParserStrict:
+		return NewStatementParser(ctx, sqlMode, path, reader, blockBufSize, ioWorkers, charsetConvertor,
+			cfg.SQLParser == config.SQLParserStrict)
+	default:
+		return NewChunkParser(ctx, sqlMode, reader, blockBufSize, ioWorkers, charsetConvertor)
+	}
+}
+
+// pendingRow is a row of the last parsed statement which isn't read yet.
+type pendingRow struct {
+	columns []string
+	row     []types.Datum
+}
+
+// StatementParser is a parser of the SQL data files which parses every
+// statement with the TiDB parser, so the INSERT statements with expressions
+// and the statements other than INSERT are supported. It's slower than the
+// ChunkParser.
+//
+// The rows of a statement share the offset and the row ID of the beginning of
+// the statement until the last one of them is read, so the parser can always
+// be resumed from the beginning of a statement.
+type StatementParser struct {
+	blockParser
+
+	path    string
+	strict  bool
+	escapes bool
+	// charsetConvertor converts the statements from the charset of the file to
+	// utf8mb4, it's nil if no conversion is needed.
+	charsetConvertor *CharsetConvertor
+	sqlParser        *parser.Parser
+	sctx             sessionctx.Context
+
+	delimiter string
+	// line is the line number of pos, it's unknown if the parser isn't
+	// started from the beginning of the file.
+	line      int64
+	lineKnown bool
+
+	// the position of the beginning of the last statement.
+	stmtPos   int64
+	stmtLine  int64
+	stmtRowID int64
+
+	pending []pendingRow
+}
+
+// NewStatementParser creates a new StatementParser. The statements which
+// don't insert rows, except SET, LOCK/UNLOCK TABLES and the statements with
+// DEFINER clauses, are skipped with a warning if `strict` is false, or fail
+// the parser otherwise.
+func NewStatementParser(
+	ctx context.Context,
+	sqlMode mysql.SQLMode,
+	path string,
+	reader ReadSeekCloser,
+	blockBufSize int64,
+	ioWorkers *worker.Pool,
+	charsetConvertor *CharsetConvertor,
+	strict bool,
+) *StatementParser {
+	metrics, _ := metric.FromContext(ctx)
+	logger := log.FromContext(ctx)
+	sqlParser := parser.New()
+	sqlParser.SetSQLMode(sqlMode)
+	return &StatementParser{
+		blockParser:      makeBlockParser(reader, blockBufSize, ioWorkers, metrics, logger),
+		path:             path,
+		strict:           strict,
+		escapes:          !sqlMode.HasNoBackslashEscapesMode(),
+		charsetConvertor: charsetConvertor,
+		sqlParser:        sqlParser,
+		sctx: kv.NewSession(&kv.SessionOptions{
+			SQLMode:   sqlMode,
+			Timestamp: time.Now().Unix(),
+		}, logger),
+		delimiter: defaultDelimiter,
+		line:      1,
+		lineKnown: true,
+	}
+}
+
+// SetPos changes the reported position and row ID.
+func (parser *StatementParser) SetPos(pos int64, rowID int64) error {
+	if err := parser.blockParser.SetPos(pos, rowID); err != nil {
+		return err
+	}
+	parser.pending = nil
+	parser.line = 1
+	parser.lineKnown = pos == 0
+	return nil
+}
+
+// Pos returns the current file offset, and the row ID of the last read row.
+// Both of them are of the beginning of the last statement if some of its rows
+// aren't read yet.
+func (parser *StatementParser) Pos() (pos int64, lastRowID int64) {
+	if len(parser.pending) > 0 {
+		return parser.stmtPos, parser.stmtRowID
+	}
+	return parser.pos, parser.lastRow.RowID
+}
+
+// ReadRow reads a row from the datafile.
+func (parser *StatementParser) ReadRow() error {
+	for len(parser.pending) == 0 {
+		if err := parser.readStatements(); err != nil {
+			return err
+		}
+	}
+	next := parser.pending[0]
+	parser.pending[0] = pendingRow{}
+	parser.pending = parser.pending[1:]
+
+	row := &parser.lastRow
+	row.RowID++
+	row.Row = next.row
+	row.Length = 0
+	for _, d := range next.row {
+		row.Length += len(d.GetBytes())
+	}
+	parser.columns = next.columns
+	return nil
+}
+
+// position returns the position of the beginning of the last statement.
+func (parser *StatementParser) position() string {
+	if parser.lineKnown {
+		return fmt.Sprintf("file %s, line %d, offset %d", parser.path, parser.stmtLine, parser.stmtPos)
+	}
+	return fmt.Sprintf("file %s, offset %d", parser.path, parser.stmtPos)
+}
+
+// readStatements reads the next statement, and appends its rows to the
+// pending rows.
+func (parser *StatementParser) readStatements() error {
+	parser.stmtRowID = parser.lastRow.RowID
+	text, err := parser.readStatement()
+	if err != nil {
+		return err
+	}
+
+	if definerStmtRegexp.MatchString(text) {
+		parser.Logger.Warn("skip the statement with the DEFINER clause, the stored objects aren't imported from the data files",
+			zap.String("position", parser.position()), zap.String("statement", truncateStatement(text)))
+		return nil
+	}
+
+	text, err = parser.charsetConvertor.Decode(text)
+	if err != nil {
+		return errors.Annotatef(err, "in %s", parser.position())
+	}
+	stmts, _, err := parser.sqlParser.Parse(text, "", "")
+	if err != nil {
+		return errors.Errorf("syntax error in %s: %s", parser.position(), err.Error())
+	}
+	for _, stmt := range stmts {
+		if err := parser.handleStatement(stmt, text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (parser *StatementParser) handleStatement(stmt ast.StmtNode, text string) error {
+	switch s := stmt.(type) {
+	case *ast.InsertStmt:
+		return parser.handleInsert(s, text)
+	case *ast.SetStmt, *ast.LockTablesStmt, *ast.UnlockTablesStmt, *ast.BeginStmt, *ast.CommitStmt:
+		// the session variables and the locks don't affect the rows.
+		return nil
+	case *ast.AlterTableStmt:
+		// mysqldump wraps the INSERT statements of a table with
+		// `ALTER TABLE ... DISABLE KEYS` and `ALTER TABLE ... ENABLE KEYS`.
+		keysOnly := true
+		for _, spec := range s.Specs {
+			if spec.Tp != ast.AlterTableDisableKeys && spec.Tp != ast.AlterTableEnableKeys {
+				keysOnly = false
+			}
+		}
+		if keysOnly {
+			return nil
+		}
+	}
+	return parser.unsupported("unsupported statement", text)
+}
+
+func (parser *StatementParser) handleInsert(stmt *ast.InsertStmt, text string) error {
+	if stmt.Select != nil {
+		return parser.unsupported("unsupported INSERT ... SELECT statement", text)
+	}
+	if len(stmt.OnDuplicate) > 0 {
+		if parser.strict {
+			return errors.Errorf("unsupported ON DUPLICATE KEY UPDATE clause in %s", parser.position())
+		}
+		parser.Logger.Warn("ignore the ON DUPLICATE KEY UPDATE clause",
+			zap.String("position", parser.position()))
+	}
+
+	columns := make([]string, 0, len(stmt.Columns))
+	for _, col := range stmt.Columns {
+		columns = append(columns, col.Name.L)
+	}
+	lists := stmt.Lists
+	if len(stmt.Setlist) > 0 {
+		// INSERT ... SET is a single row with the column list.
+		list := make([]ast.ExprNode, 0, len(stmt.Setlist))
+		for _, assignment := range stmt.Setlist {
+			columns = append(columns, assignment.Column.Name.L)
+			list = append(list, assignment.Expr)
+		}
+		lists = [][]ast.ExprNode{list}
+	}
+	if len(columns) == 0 {
+		columns = nil
+	}
+
+	rows := make([]pendingRow, 0, len(lists))
+	for i, list := range lists {
+		if parser.strict {
+			if columns != nil && len(list) != len(columns) {
+				return errors.Errorf("row %d has %d values in %s, expecting %d values of the column list",
+					i+1, len(list), parser.position(), len(columns))
+			}
+			if i > 0 && len(list) != len(lists[0]) {
+				return errors.Errorf("row %d has %d values in %s, expecting %d values as the first row",
+					i+1, len(list), parser.position(), len(lists[0]))
+			}
+		}
+		row := parser.acquireDatumSlice()
+		for j, expr := range list {
+			value, err := parser.evaluate(expr)
+			if err != nil {
+				return errors.Annotatef(err, "failed to evaluate the value %d of row %d in %s", j+1, i+1, parser.position())
+			}
+			row = append(row, value)
+		}
+		rows = append(rows, pendingRow{columns: columns, row: row})
+	}
+	parser.pending = append(parser.pending, rows...)
+	return nil
+}
+
+// evaluate evaluates the constant expression of a value.
+func (parser *StatementParser) evaluate(expr ast.ExprNode) (types.Datum, error) {
+	if _, ok := expr.(*ast.DefaultExpr); ok {
+		// the default values are unknown to the parser.
+		return types.Datum{}, errors.New("the DEFAULT value is unsupported")
+	}
+	return expression.EvalAstExpr(parser.sctx, expr)
+}
+
+func (parser *StatementParser) unsupported(reason string, text string) error {
+	if parser.strict {
+		return errors.Errorf("%s in %s: %s", reason, parser.position(), truncateStatement(text))
+	}
+	parser.Logger.Warn("skip the "+reason,
+		zap.String("position", parser.position()), zap.String("statement", truncateStatement(text)))
+	return nil
+}
+
+func truncateStatement(text string) string {
+	text = strings.TrimSpace(text)
+	if len(text) > 256 {
+		return text[:256] + "..."
+	}
+	return text
+}
+
+// ensure reads more blocks until the buffer has at least n bytes or the file
+// is drained.
+func (parser *StatementParser) ensure(n int) error {
+	for len(parser.buf) < n && !parser.isLastChunk {
+		if err := parser.readBlock(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// consume removes the first n bytes from the buffer.
+func (parser *StatementParser) consume(n int) {
+	parser.line += int64(bytes.Count(parser.buf[:n], []byte{'\n'}))
+	parser.buf = parser.buf[n:]
+	parser.pos += int64(n)
+}
+
+// skipSpaces skips the spaces and the `DELIMITER` commands before the next
+// statement. It returns io.EOF if nothing is left.
+func (parser *StatementParser) skipSpaces() error {
+	const delimiterCommand = "DELIMITER"
+	for {
+		if err := parser.ensure(len(delimiterCommand) + 1); err != nil {
+			return err
+		}
+		if len(parser.buf) == 0 {
+			return io.EOF
+		}
+		n := len(parser.buf) - len(bytes.TrimLeft(parser.buf, " \t\r\n"))
+		if n > 0 {
+			parser.consume(n)
+			continue
+		}
+
+		// `DELIMITER` is a command of the MySQL client rather than a
+		// statement, it's always ended by the end of the line.
+		buf := parser.buf
+		if len(buf) <= len(delimiterCommand) ||
+			!strings.EqualFold(string(buf[:len(delimiterCommand)]), delimiterCommand) ||
+			(buf[len(delimiterCommand)] != ' ' && buf[len(delimiterCommand)] != '\t') {
+			return nil
+		}
+		for bytes.IndexByte(parser.buf, '\n') < 0 && !parser.isLastChunk {
+			if err := parser.readBlock(); err != nil {
+				return err
+			}
+		}
+		end := bytes.IndexByte(parser.buf, '\n')
+		if end < 0 {
+			end = len(parser.buf)
+		}
+		delimiter := strings.TrimSpace(string(parser.buf[len(delimiterCommand):end]))
+		if len(delimiter) == 0 {
+			parser.stmtPos, parser.stmtLine = parser.pos, parser.line
+			return errors.Errorf("syntax error in %s: missing delimiter after DELIMITER", parser.position())
+		}
+		parser.delimiter = delimiter
+		parser.consume(end)
+	}
+}
+
+// readStatement reads the next statement, and returns its text without the
+// delimiter. The last statement of the file may be not ended by a delimiter.
+// It returns io.EOF if nothing is left.
+func (parser *StatementParser) readStatement() (string, error) {
+	if err := parser.skipSpaces(); err != nil {
+		return "", err
+	}
+	parser.stmtPos, parser.stmtLine = parser.pos, parser.line
+
+	type state byte
+	const (
+		stateCode state = iota
+		stateQuoted
+		stateLineComment
+		stateBlockComment
+	)
+
+	delimiter := []byte(parser.delimiter)
+	st := stateCode
+	var quote byte
+	for i := 0; ; {
+		// look ahead enough bytes to match the delimiter and the comments.
+		if err := parser.ensure(i + len(delimiter) + 2); err != nil {
+			return "", err
+		}
+		buf := parser.buf
+		if i >= len(buf) {
+			if st == stateQuoted {
+				return "", errors.Errorf("syntax error in %s: unterminated quoted string", parser.position())
+			}
+			text := string(buf)
+			parser.consume(len(buf))
+			return text, nil
+		}
+
+		c := buf[i]
+		switch st {
+		case stateCode:
+			switch {
+			case bytes.HasPrefix(buf[i:], delimiter):
+				text := string(buf[:i])
+				parser.consume(i + len(delimiter))
+				return text, nil
+			case c == '\'' || c == '"' || c == '`':
+				st, quote = stateQuoted, c
+			case c == '#':
+				st = stateLineComment
+			case c == '-' && i+1 < len(buf) && buf[i+1] == '-' && (i+2 == len(buf) || buf[i+2] <= ' '):
+				st = stateLineComment
+			case c == '/' && i+1 < len(buf) && buf[i+1] == '*':
+				st = stateBlockComment
+				i++
+			}
+		case stateQuoted:
+			switch {
+			case c == '\\' && quote != '`' && parser.escapes:
+				i++
+			case c == quote:
+				// a doubled quote is an escaped quote.
+				if i+1 < len(buf) && buf[i+1] == quote {
+					i++
+				} else {
+					st = stateCode
+				}
+			}
+		case stateLineComment:
+			if c == '\n' {
+				st = stateCode
+			}
+		case stateBlockComment:
+			if c == '*' && i+1 < len(buf) && buf[i+1] == '/' {
+				st = stateCode
+				i++
+			}
+		}
+		i++
+	}
+}
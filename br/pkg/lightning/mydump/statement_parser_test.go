@@ -0,0 +1,221 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydump_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/lightning/mydump"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStatementParser(input string, blockBufSize int64, strict bool) *mydump.StatementParser {
+	return mydump.NewStatementParser(context.Background(), mysql.ModeNone, "db.t.sql", mydump.NewStringReader(input),
+		blockBufSize, ioWorkers, nil, strict)
+}
+
+func datumStrings(t *testing.T, row []types.Datum) []string {
+	values := make([]string, 0, len(row))
+	for _, d := range row {
+		if d.IsNull() {
+			values = append(values, "NULL")
+			continue
+		}
+		s, err := d.ToString()
+		require.NoError(t, err)
+		values = append(values, s)
+	}
+	return values
+}
+
+func TestStatementParserReadRow(t *testing.T) {
+	multiRowStmt := "INSERT INTO `t` (`a`,`b`) VALUES (3,'it''s'),\n(4,\"x\\\"y\");"
+	input := "-- MySQL dump 10.13\n" +
+		"/*!40101 SET @OLD_CHARACTER_SET_CLIENT=@@CHARACTER_SET_CLIENT */;\n" +
+		"SET time_zone = '+00:00';\n" +
+		"LOCK TABLES `t` WRITE;\n" +
+		"/*!40000 ALTER TABLE `t` DISABLE KEYS */;\n" +
+		"INSERT INTO `t` VALUES (1,'a;b',NULL,-2);\n" +
+		"INSERT INTO `t` VALUES (2,CONCAT('c','d'),1+2,x'6566');\n" +
+		multiRowStmt + "\n" +
+		"INSERT INTO `t` SET `a` = 5, `b` = LOWER('E');\n" +
+		"/*!40000 ALTER TABLE `t` ENABLE KEYS */;\n" +
+		"UNLOCK TABLES;\n" +
+		"DELIMITER ;;\n" +
+		"/*!50003 CREATE*/ /*!50017 DEFINER=`root`@`%`*/ /*!50003 TRIGGER `trg` BEFORE INSERT ON `t` FOR EACH ROW BEGIN SET NEW.a = 1; END */;;\n" +
+		"DELIMITER ;\n" +
+		"INSERT INTO `t` VALUES (6,'last;',NULL,0)"
+
+	for _, blockBufSize := range []int64{1, int64(config.ReadBlockSize)} {
+		for _, strict := range []bool{false, true} {
+			parser := newTestStatementParser(input, blockBufSize, strict)
+
+			require.NoError(t, parser.ReadRow())
+			require.Equal(t, []string{"1", "a;b", "NULL", "-2"}, datumStrings(t, parser.LastRow().Row))
+			require.Len(t, parser.Columns(), 0)
+			offset, rowID := parser.Pos()
+			require.Equal(t, int64(strings.Index(input, "\nINSERT INTO `t` VALUES (2")), offset)
+			require.Equal(t, int64(1), rowID)
+
+			require.NoError(t, parser.ReadRow())
+			require.Equal(t, []string{"2", "cd", "3", "ef"}, datumStrings(t, parser.LastRow().Row))
+
+			// the rows of a statement share the position of the statement until
+			// the last one is read.
+			stmtOffset := int64(strings.Index(input, multiRowStmt))
+			require.NoError(t, parser.ReadRow())
+			require.Equal(t, []string{"3", "it's"}, datumStrings(t, parser.LastRow().Row))
+			require.Equal(t, []string{"a", "b"}, parser.Columns())
+			require.Equal(t, int64(3), parser.LastRow().RowID)
+			offset, rowID = parser.Pos()
+			require.Equal(t, stmtOffset, offset)
+			require.Equal(t, int64(2), rowID)
+
+			require.NoError(t, parser.ReadRow())
+			require.Equal(t, []string{"4", "x\"y"}, datumStrings(t, parser.LastRow().Row))
+			offset, rowID = parser.Pos()
+			require.Equal(t, stmtOffset+int64(len(multiRowStmt)), offset)
+			require.Equal(t, int64(4), rowID)
+
+			require.NoError(t, parser.ReadRow())
+			require.Equal(t, []string{"5", "e"}, datumStrings(t, parser.LastRow().Row))
+			require.Equal(t, []string{"a", "b"}, parser.Columns())
+
+			// the trigger is skipped, and the last statement isn't ended by a
+			// delimiter.
+			require.NoError(t, parser.ReadRow())
+			require.Equal(t, []string{"6", "last;", "NULL", "0"}, datumStrings(t, parser.LastRow().Row))
+			require.Len(t, parser.Columns(), 0)
+			offset, rowID = parser.Pos()
+			require.Equal(t, int64(len(input)), offset)
+			require.Equal(t, int64(6), rowID)
+
+			require.ErrorIs(t, errors.Cause(parser.ReadRow()), io.EOF)
+			require.NoError(t, parser.Close())
+		}
+	}
+}
+
+func TestStatementParserSetPos(t *testing.T) {
+	input := "INSERT INTO t VALUES (1);\nINSERT INTO t VALUES (2),(3);\nINSERT INTO t VALUES (4,;\n"
+	parser := newTestStatementParser(input, int64(config.ReadBlockSize), true)
+	require.NoError(t, parser.ReadRow())
+	require.NoError(t, parser.ReadRow())
+	offset, rowID := parser.Pos()
+	require.Equal(t, int64(26), offset)
+	require.Equal(t, int64(1), rowID)
+	require.NoError(t, parser.Close())
+
+	// resume from the beginning of the statement.
+	parser = newTestStatementParser(input, int64(config.ReadBlockSize), true)
+	require.NoError(t, parser.SetPos(offset, rowID))
+	require.NoError(t, parser.ReadRow())
+	require.Equal(t, []string{"2"}, datumStrings(t, parser.LastRow().Row))
+	require.Equal(t, int64(2), parser.LastRow().RowID)
+	require.NoError(t, parser.ReadRow())
+	require.Equal(t, []string{"3"}, datumStrings(t, parser.LastRow().Row))
+	require.Equal(t, int64(3), parser.LastRow().RowID)
+
+	// the line number is unknown after resuming.
+	require.Regexp(t, `^syntax error in file db\.t\.sql, offset 56: .*line 1 column \d+`, parser.ReadRow().Error())
+	require.NoError(t, parser.Close())
+}
+
+func TestStatementParserErrors(t *testing.T) {
+	testCases := []struct {
+		input   string
+		lenient string
+		strict  string
+	}{
+		{
+			input:  "INSERT INTO t VALUES (1);\n\nINSERT INTO t VALUES (2,;",
+			strict: `^syntax error in file db\.t\.sql, line 3, offset 27: .*line 1 column \d+`,
+		},
+		{
+			input:  "INSERT INTO t VALUES (1);\nINSERT INTO t VALUES ('2);",
+			strict: `^syntax error in file db\.t\.sql, line 2, offset 26: unterminated quoted string$`,
+		},
+		{
+			input:  "DELIMITER \nINSERT INTO t VALUES (1);",
+			strict: `^syntax error in file db\.t\.sql, line 1, offset 0: missing delimiter after DELIMITER$`,
+		},
+		{
+			input:  "INSERT INTO t VALUES (1, DEFAULT);",
+			strict: `^failed to evaluate the value 2 of row 1 in file db\.t\.sql, line 1, offset 0: the DEFAULT value is unsupported$`,
+		},
+		{
+			input:   "DROP TABLE IF EXISTS t;\nINSERT INTO t VALUES (1);",
+			lenient: "1",
+			strict:  `^unsupported statement in file db\.t\.sql, line 1, offset 0: DROP TABLE IF EXISTS t$`,
+		},
+		{
+			input:   "\n  INSERT INTO t SELECT * FROM s;\nINSERT INTO t VALUES (1);",
+			lenient: "1",
+			strict:  `^unsupported INSERT \.\.\. SELECT statement in file db\.t\.sql, line 2, offset 3: INSERT INTO t SELECT \* FROM s$`,
+		},
+		{
+			input:   "INSERT INTO t VALUES (1) ON DUPLICATE KEY UPDATE a = 2;",
+			lenient: "1",
+			strict:  `^unsupported ON DUPLICATE KEY UPDATE clause in file db\.t\.sql, line 1, offset 0$`,
+		},
+		{
+			input:   "INSERT INTO t (a, b) VALUES (1);",
+			lenient: "1",
+			strict:  `^row 1 has 1 values in file db\.t\.sql, line 1, offset 0, expecting 2 values of the column list$`,
+		},
+		{
+			input:   "INSERT INTO t VALUES (1, 2), (3);\n",
+			lenient: "1,2",
+			strict:  `^row 2 has 1 values in file db\.t\.sql, line 1, offset 0, expecting 2 values as the first row$`,
+		},
+	}
+
+	for _, tc := range testCases {
+		for _, strict := range []bool{false, true} {
+			parser := newTestStatementParser(tc.input, int64(config.ReadBlockSize), strict)
+			err := parser.ReadRow()
+			if !strict && tc.lenient != "" {
+				require.NoError(t, err, tc.input)
+				require.Equal(t, strings.Split(tc.lenient, ","), datumStrings(t, parser.LastRow().Row), tc.input)
+			} else {
+				require.Error(t, err, tc.input)
+				require.Regexp(t, tc.strict, err.Error(), tc.input)
+			}
+			require.NoError(t, parser.Close())
+		}
+	}
+}
+
+func TestNewSQLParser(t *testing.T) {
+	cfg := config.NewConfig()
+	reader := mydump.NewStringReader("INSERT INTO t VALUES (-1);")
+	parser := mydump.NewSQLParser(context.Background(), &cfg.Mydumper, mysql.ModeNone, "db.t.sql", reader,
+		int64(config.ReadBlockSize), ioWorkers, nil)
+	require.IsType(t, &mydump.ChunkParser{}, parser)
+
+	cfg.Mydumper.SQLParser = config.SQLParserStrict
+	parser = mydump.NewSQLParser(context.Background(), &cfg.Mydumper, mysql.ModeNone, "db.t.sql", reader,
+		int64(config.ReadBlockSize), ioWorkers, nil)
+	require.IsType(t, &mydump.StatementParser{}, parser)
+	require.NoError(t, parser.ReadRow())
+	require.Equal(t, []string{"-1"}, datumStrings(t, parser.LastRow().Row))
+}
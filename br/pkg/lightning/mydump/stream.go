@@ -0,0 +1,405 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydump
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"os"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/lightning/worker"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/util/mathutil"
+)
+
+// StreamEndOffset is the end offset of the stream region, since the size of
+// the stream is unknown until it's drained.
+const StreamEndOffset = math.MaxInt64
+
+// OpenStream opens the stream source, which is either stdin or a named pipe.
+func OpenStream(path string) (io.ReadCloser, error) {
+	if path == config.StreamSourceStdin {
+		return io.NopCloser(os.Stdin), nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return f, nil
+}
+
+// StreamChunk is a chunk cut from the stream at the row boundaries. The
+// offsets of the chunk are the positions in the whole stream, and the row IDs
+// are bounded by the offsets since each row takes at least one byte.
+type StreamChunk struct {
+	Chunk
+	Data []byte
+}
+
+// Reader returns a reader of the chunk, which is positioned by the offsets in
+// the stream.
+func (c *StreamChunk) Reader() storage.ReadSeekCloser {
+	return &streamChunkReader{Reader: bytes.NewReader(c.Data), base: c.Offset}
+}
+
+type streamChunkReader struct {
+	*bytes.Reader
+	base int64
+}
+
+// Seek implements io.Seeker.
+func (r *streamChunkReader) Seek(offset int64, whence int) (int64, error) {
+	if whence == io.SeekStart {
+		offset -= r.base
+	}
+	pos, err := r.Reader.Seek(offset, whence)
+	return pos + r.base, err
+}
+
+// Close implements io.Closer.
+func (*streamChunkReader) Close() error {
+	return nil
+}
+
+// StreamSource cuts a CSV or SQL stream into chunks at the row boundaries while
+// it's read. At most `buffer-chunks` chunks are read ahead, so the memory used
+// by the stream is bounded whatever its size is.
+type StreamSource struct {
+	reader          io.ReadCloser
+	tp              SourceType
+	csvCfg          *config.CSVConfig
+	header          bool
+	backslashEscape bool
+	chunkSize       int
+
+	blockBufSize     int64
+	ioWorkers        *worker.Pool
+	charsetConvertor *CharsetConvertor
+
+	chunks chan *StreamChunk
+	cancel context.CancelFunc
+	// err and offset are set before chunks is closed.
+	err    error
+	offset int64
+}
+
+// NewStreamSource creates a StreamSource which reads the stream in the
+// background, the reader is closed with the StreamSource.
+func NewStreamSource(
+	ctx context.Context,
+	cfg *config.Config,
+	reader io.ReadCloser,
+	ioWorkers *worker.Pool,
+) (*StreamSource, error) {
+	streamCfg := &cfg.Mydumper.Stream
+	tp, err := parseSourceType(streamCfg.Type)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if tp != SourceTypeCSV && tp != SourceTypeSQL {
+		return nil, errors.Errorf("unsupported stream type '%s'", streamCfg.Type)
+	}
+	bufferChunks := streamCfg.BufferChunks
+	if bufferChunks <= 0 {
+		bufferChunks = 1
+	}
+	chunkSize := int(streamCfg.ChunkSize)
+	if chunkSize <= 0 {
+		chunkSize = int(config.ReadBlockSize)
+	}
+
+	s := &StreamSource{
+		reader:          reader,
+		tp:              tp,
+		csvCfg:          &cfg.Mydumper.CSV,
+		header:          tp == SourceTypeCSV && cfg.Mydumper.CSV.Header,
+		backslashEscape: !cfg.TiDB.SQLMode.HasNoBackslashEscapesMode(),
+		chunkSize:       chunkSize,
+		blockBufSize:    int64(cfg.Mydumper.ReadBlockSize),
+		ioWorkers:       ioWorkers,
+		chunks:          make(chan *StreamChunk, bufferChunks),
+	}
+	if s.header {
+		// Create a utf8mb4 convertor to decode the header with the charset of CSV files.
+		s.charsetConvertor, err = NewFileCharsetConvertor(&cfg.Mydumper, SourceFileMeta{})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	go func() {
+		defer close(s.chunks)
+		s.err = s.cut(ctx)
+	}()
+	return s, nil
+}
+
+// Next returns the next chunk of the stream, or io.EOF if the stream is
+// drained.
+func (s *StreamSource) Next(ctx context.Context) (*StreamChunk, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case chunk, ok := <-s.chunks:
+		if ok {
+			return chunk, nil
+		}
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, io.EOF
+	}
+}
+
+// Offset returns the size of the stream, it's valid only after Next returns
+// io.EOF.
+func (s *StreamSource) Offset() int64 {
+	return s.offset
+}
+
+// Close stops reading the stream and closes the reader.
+func (s *StreamSource) Close() error {
+	s.cancel()
+	return errors.Trace(s.reader.Close())
+}
+
+func (s *StreamSource) cut(ctx context.Context) error {
+	var (
+		buf     []byte
+		columns []string
+		eof     bool
+	)
+	// fill reads the stream until the buffer has n bytes or the stream is
+	// drained.
+	fill := func(n int) error {
+		if cap(buf) < n {
+			newBuf := make([]byte, len(buf), n)
+			copy(newBuf, buf)
+			buf = newBuf
+		}
+		for len(buf) < n && !eof {
+			m, err := s.reader.Read(buf[len(buf):n])
+			buf = buf[:len(buf)+m]
+			if err == io.EOF {
+				eof = true
+			} else if err != nil {
+				return errors.Trace(err)
+			}
+		}
+		return nil
+	}
+	// readRows reads the stream until the buffer has at least one complete
+	// row, and returns the end of the first or the last complete row. The rest
+	// of the stream is treated as a row once it's drained.
+	readRows := func(first bool) (int, error) {
+		for size := s.chunkSize; ; size *= 2 {
+			if err := fill(size); err != nil {
+				return 0, err
+			}
+			if eof {
+				if first {
+					if end := s.scanRows(buf, true); end > 0 {
+						return end, nil
+					}
+				}
+				return len(buf), nil
+			}
+			if end := s.scanRows(buf, first); end > 0 {
+				return end, nil
+			}
+			// the row is larger than the chunk size.
+		}
+	}
+
+	if s.header {
+		end, err := readRows(true)
+		if err != nil {
+			return err
+		}
+		if end == 0 {
+			return nil
+		}
+		if columns, err = s.readColumns(ctx, buf[:end]); err != nil {
+			return err
+		}
+		s.offset = int64(end)
+		buf = buf[end:]
+	}
+
+	for {
+		end, err := readRows(false)
+		if err != nil {
+			return err
+		}
+		if end == 0 {
+			return nil
+		}
+		data := buf[:end]
+		// the rest may be larger than the chunk size if the buffer has grown
+		// for a large row.
+		rest := make([]byte, len(buf)-end, mathutil.Max(len(buf)-end, s.chunkSize))
+		copy(rest, buf[end:])
+		buf = rest
+
+		offset := s.offset
+		s.offset += int64(end)
+		// skip the blank lines between the statements or at the end.
+		if len(bytes.TrimSpace(data)) == 0 {
+			continue
+		}
+		chunk := &StreamChunk{
+			Chunk: Chunk{
+				Offset:       offset,
+				EndOffset:    s.offset,
+				PrevRowIDMax: offset,
+				RowIDMax:     s.offset,
+				Columns:      columns,
+			},
+			Data: data,
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case s.chunks <- chunk:
+		}
+	}
+}
+
+func (s *StreamSource) readColumns(ctx context.Context, header []byte) ([]string, error) {
+	parser, err := NewCSVParser(ctx, s.csvCfg, NewStringReader(string(header)), s.blockBufSize, s.ioWorkers, true, s.charsetConvertor)
+	if err != nil {
+		return nil, err
+	}
+	defer parser.Close()
+	if err = parser.ReadColumns(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return parser.Columns(), nil
+}
+
+// scanRows returns the end of the first or the last complete row in data, or
+// 0 if there isn't any.
+func (s *StreamSource) scanRows(data []byte, first bool) int {
+	if s.tp == SourceTypeCSV {
+		return scanCSVRows(data, s.csvCfg, first)
+	}
+	return scanSQLStatements(data, s.backslashEscape, first)
+}
+
+// scanCSVRows finds the terminators outside the quoted fields. An empty
+// terminator matches "\r", "\n" and "\r\n" like the CSV parser.
+func scanCSVRows(data []byte, cfg *config.CSVConfig, first bool) int {
+	var (
+		delimiter  = []byte(cfg.Delimiter)
+		terminator = []byte(cfg.Terminator)
+		quoted     bool
+		last       int
+	)
+	for i := 0; i < len(data); {
+		end := 0
+		switch {
+		case cfg.BackslashEscape && data[i] == '\\':
+			i += 2
+			continue
+		case len(delimiter) > 0 && bytes.HasPrefix(data[i:], delimiter):
+			quoted = !quoted
+			i += len(delimiter)
+			continue
+		case quoted:
+		case len(terminator) > 0:
+			if bytes.HasPrefix(data[i:], terminator) {
+				end = i + len(terminator)
+			}
+		case data[i] == '\n':
+			end = i + 1
+		// "\r" at the end of data may be followed by "\n", so it's a
+		// terminator only if the next byte is known.
+		case data[i] == '\r' && i+1 < len(data) && data[i+1] != '\n':
+			end = i + 1
+		}
+		if end > 0 {
+			last = end
+			if first {
+				return last
+			}
+			i = end
+			continue
+		}
+		i++
+	}
+	return last
+}
+
+// scanSQLStatements finds the semicolons outside the quoted strings and the
+// comments.
+func scanSQLStatements(data []byte, backslashEscape bool, first bool) int {
+	var (
+		quote byte
+		last  int
+	)
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		if quote != 0 {
+			switch {
+			case c == '\\' && backslashEscape && quote != '`':
+				i++
+			case c == quote:
+				quote = 0
+			}
+			continue
+		}
+		switch c {
+		case '\'', '"', '`':
+			quote = c
+		case '#', '-':
+			// "-- " starts a comment only if it's followed by a whitespace.
+			if c == '-' && (i+2 >= len(data) || data[i+1] != '-' || !isSQLSpace(data[i+2])) {
+				continue
+			}
+			j := bytes.IndexByte(data[i:], '\n')
+			if j < 0 {
+				return last
+			}
+			i += j
+		case '/':
+			if i+1 < len(data) && data[i+1] == '*' {
+				j := bytes.Index(data[i+2:], []byte("*/"))
+				if j < 0 {
+					return last
+				}
+				i += j + 3
+			}
+		case ';':
+			last = i + 1
+			if first {
+				return last
+			}
+		}
+	}
+	return last
+}
+
+func isSQLSpace(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\r', '\f', '\v':
+		return true
+	}
+	return false
+}
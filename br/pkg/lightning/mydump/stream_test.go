@@ -0,0 +1,168 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydump
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/lightning/worker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanCSVRows(t *testing.T) {
+	cfg := &config.CSVConfig{Separator: ",", Delimiter: `"`, BackslashEscape: true}
+	testCases := []struct {
+		data        string
+		first, last int
+	}{
+		{data: "a,b\nc,d\n", first: 4, last: 8},
+		{data: "a,\"b\nc\"\nd", first: 8, last: 8},
+		{data: "a,\"b\"\"\n\"\n", first: 9, last: 9},
+		{data: "a\\\nb\nc", first: 5, last: 5},
+		// the "\r" at the end may be followed by "\n".
+		{data: "a,b\r\nc,d\r", first: 5, last: 5},
+		{data: "a,b\rc,d\r\n", first: 4, last: 9},
+		{data: "\"a,b\nc", first: 0, last: 0},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.first, scanCSVRows([]byte(tc.data), cfg, true), tc.data)
+		require.Equal(t, tc.last, scanCSVRows([]byte(tc.data), cfg, false), tc.data)
+	}
+
+	cfg = &config.CSVConfig{Separator: ",", Delimiter: `'`, Terminator: "###"}
+	require.Equal(t, 6, scanCSVRows([]byte("a,b###c###d"), cfg, true))
+	require.Equal(t, 10, scanCSVRows([]byte("a,b###c###d"), cfg, false))
+	require.Equal(t, 0, scanCSVRows([]byte("'a###'b##"), cfg, false))
+	// the delimiter isn't escaped without backslash-escape.
+	require.Equal(t, 0, scanCSVRows([]byte("a\\'###b###"), cfg, true))
+}
+
+func TestScanSQLStatements(t *testing.T) {
+	stmt1 := "INSERT INTO `t;` VALUES (1,'a;\\';'),(2,\"b;\"),(3,'c'';');"
+	stmt2 := "\n/* comment; */ -- comment;\n# comment;\nINSERT INTO t VALUES (4,5--6);"
+	data := []byte(stmt1 + stmt2 + "\nINSERT INTO t VALUES ('unfinished;")
+	require.Equal(t, len(stmt1), scanSQLStatements(data, true, true))
+	require.Equal(t, len(stmt1)+len(stmt2), scanSQLStatements(data, true, false))
+
+	require.Equal(t, 0, scanSQLStatements([]byte("/* unfinished; "), true, false))
+	require.Equal(t, 0, scanSQLStatements([]byte("-- unfinished; "), true, false))
+
+	data = []byte("INSERT INTO t VALUES ('a\\');")
+	require.Equal(t, 0, scanSQLStatements(data, true, false))
+	require.Equal(t, len(data), scanSQLStatements(data, false, false))
+}
+
+func TestStreamSourceCSV(t *testing.T) {
+	ctx := context.Background()
+	ioWorkers := worker.NewPool(ctx, 5, "test_stream")
+	cfg := config.NewConfig()
+	cfg.Mydumper.CSV.Header = true
+	cfg.Mydumper.Stream = config.StreamConfig{Source: "-", Type: "csv", Schema: "db", Table: "t", ChunkSize: 16, BufferChunks: 2}
+
+	var sb strings.Builder
+	sb.WriteString("ID,Name\n")
+	for i := 0; i < 20; i++ {
+		fmt.Fprintf(&sb, "%d,\"name\n%d\"\n", i, i)
+	}
+	// the last row is larger than the chunk size and has no terminator.
+	sb.WriteString(`20,"a name which is larger than the chunk size"`)
+	stream := sb.String()
+
+	source, err := NewStreamSource(ctx, cfg, io.NopCloser(strings.NewReader(stream)), ioWorkers)
+	require.NoError(t, err)
+	offset := int64(len("ID,Name\n"))
+	var names []string
+	for {
+		chunk, err := source.Next(ctx)
+		if errors.Cause(err) == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		require.Equal(t, []string{"id", "name"}, chunk.Columns)
+		require.Equal(t, offset, chunk.Offset)
+		require.Equal(t, stream[chunk.Offset:chunk.EndOffset], string(chunk.Data))
+
+		parser, err := NewCSVParser(ctx, &cfg.Mydumper.CSV, chunk.Reader(), int64(cfg.Mydumper.ReadBlockSize), ioWorkers, false, nil)
+		require.NoError(t, err)
+		require.NoError(t, parser.SetPos(chunk.Offset, chunk.PrevRowIDMax))
+		for {
+			err := parser.ReadRow()
+			if errors.Cause(err) == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			row := parser.LastRow()
+			require.Greater(t, row.RowID, chunk.PrevRowIDMax)
+			require.LessOrEqual(t, row.RowID, chunk.RowIDMax)
+			names = append(names, row.Row[1].GetString())
+		}
+		pos, _ := parser.Pos()
+		require.Equal(t, chunk.EndOffset, pos)
+		require.NoError(t, parser.Close())
+		offset = chunk.EndOffset
+	}
+	require.Equal(t, int64(len(stream)), offset)
+	require.Equal(t, int64(len(stream)), source.Offset())
+	require.Len(t, names, 21)
+	require.Equal(t, "name\n3", names[3])
+	require.Equal(t, "a name which is larger than the chunk size", names[20])
+	require.NoError(t, source.Close())
+}
+
+func TestStreamSourceSQL(t *testing.T) {
+	ctx := context.Background()
+	ioWorkers := worker.NewPool(ctx, 5, "test_stream")
+	cfg := config.NewConfig()
+	cfg.Mydumper.Stream = config.StreamConfig{Source: "-", Type: "sql", Schema: "db", Table: "t", ChunkSize: 32, BufferChunks: 1}
+
+	stream := "INSERT INTO `t` VALUES (1,'a;b'),(2,'c');\n" +
+		"INSERT INTO `t` VALUES (3,'d');\n" +
+		"INSERT INTO `t` (`id`,`name`) VALUES (4,'e'),(5,'f');\n\n"
+	source, err := NewStreamSource(ctx, cfg, io.NopCloser(strings.NewReader(stream)), ioWorkers)
+	require.NoError(t, err)
+	var names []string
+	chunks := 0
+	for {
+		chunk, err := source.Next(ctx)
+		if errors.Cause(err) == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		require.Equal(t, stream[chunk.Offset:chunk.EndOffset], string(chunk.Data))
+		chunks++
+
+		parser := NewChunkParser(ctx, cfg.TiDB.SQLMode, chunk.Reader(), int64(cfg.Mydumper.ReadBlockSize), ioWorkers, nil)
+		require.NoError(t, parser.SetPos(chunk.Offset, chunk.PrevRowIDMax))
+		for {
+			err := parser.ReadRow()
+			if errors.Cause(err) == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			names = append(names, parser.LastRow().Row[1].GetString())
+		}
+		require.NoError(t, parser.Close())
+	}
+	require.Equal(t, 3, chunks)
+	require.Equal(t, int64(len(stream)), source.Offset())
+	require.Equal(t, []string{"a;b", "c", "d", "e", "f"}, names)
+	require.NoError(t, source.Close())
+}
@@ -143,6 +143,37 @@ func (rc *Controller) checkCSVHeader(ctx context.Context) error {
 	return rc.doPreCheckOnItem(ctx, CheckCSVHeader)
 }
 
+// checkSourceDataFormat checks whether the sampled rows of the source data files can be imported.
+func (rc *Controller) checkSourceDataFormat(ctx context.Context) error {
+	return rc.doPreCheckOnItem(ctx, CheckSourceDataFormat)
+}
+
+// checkTargetPrivileges checks whether the target user has all the privileges required by the TiDB backend.
+func (rc *Controller) checkTargetPrivileges(ctx context.Context) error {
+	if rc.cfg.TikvImporter.Backend != config.BackendTiDB {
+		return nil
+	}
+	return rc.doPreCheckOnItem(ctx, CheckTargetPrivileges)
+}
+
+// checkGeneratedColumns checks whether the generated columns and the expression indexes of the tables imported by
+// the local backend can be evaluated while encoding.
+func (rc *Controller) checkGeneratedColumns(ctx context.Context) error {
+	if rc.cfg.TikvImporter.Backend != config.BackendLocal {
+		return nil
+	}
+	return rc.doPreCheckOnItem(ctx, CheckGeneratedColumns)
+}
+
+// checkLogBackupTask checks the running log backup tasks. It's done even if `app.check-requirements` is false, since
+// the data imported while the log backup is running can't be restored by PITR.
+func (rc *Controller) checkLogBackupTask(ctx context.Context) error {
+	if !isLocalBackend(rc.cfg) || rc.noLocalBackendTable {
+		return nil
+	}
+	return rc.doPreCheckOnItem(ctx, CheckLogBackupTask)
+}
+
 func (rc *Controller) checkTableEmpty(ctx context.Context) error {
 	if rc.cfg.TikvImporter.Backend == config.BackendTiDB || rc.cfg.TikvImporter.IncrementalImport {
 		return nil
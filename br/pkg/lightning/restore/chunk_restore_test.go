@@ -36,6 +36,7 @@ import (
 	"github.com/pingcap/tidb/br/pkg/lightning/worker"
 	"github.com/pingcap/tidb/br/pkg/mock"
 	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -257,7 +258,7 @@ func (s *chunkRestoreSuite) TestEncodeLoop() {
 	require.NoError(s.T(), err)
 	cfg := config.NewConfig()
 	rc := &Controller{pauser: DeliverPauser, cfg: cfg}
-	_, _, err = s.cr.encodeLoop(ctx, kvsCh, s.tr, s.tr.logger, kvEncoder, deliverCompleteCh, rc)
+	_, _, err = s.cr.encodeLoop(ctx, kvsCh, s.tr, 0, s.tr.logger, kvEncoder, deliverCompleteCh, rc)
 	require.NoError(s.T(), err)
 	require.Len(s.T(), kvsCh, 2)
 
@@ -286,7 +287,7 @@ func (s *chunkRestoreSuite) TestEncodeLoopCanceled() {
 	go cancel()
 	cfg := config.NewConfig()
 	rc := &Controller{pauser: DeliverPauser, cfg: cfg}
-	_, _, err = s.cr.encodeLoop(ctx, kvsCh, s.tr, s.tr.logger, kvEncoder, deliverCompleteCh, rc)
+	_, _, err = s.cr.encodeLoop(ctx, kvsCh, s.tr, 0, s.tr.logger, kvEncoder, deliverCompleteCh, rc)
 	require.Equal(s.T(), context.Canceled, errors.Cause(err))
 	require.Len(s.T(), kvsCh, 0)
 }
@@ -306,7 +307,7 @@ func (s *chunkRestoreSuite) TestEncodeLoopForcedError() {
 
 	cfg := config.NewConfig()
 	rc := &Controller{pauser: DeliverPauser, cfg: cfg}
-	_, _, err = s.cr.encodeLoop(ctx, kvsCh, s.tr, s.tr.logger, kvEncoder, deliverCompleteCh, rc)
+	_, _, err = s.cr.encodeLoop(ctx, kvsCh, s.tr, 0, s.tr.logger, kvEncoder, deliverCompleteCh, rc)
 	require.Regexp(s.T(), `in file .*[/\\]?db\.table\.2\.sql:0 at offset 0:.*file already closed`, err.Error())
 	require.Len(s.T(), kvsCh, 0)
 }
@@ -342,7 +343,7 @@ func (s *chunkRestoreSuite) TestEncodeLoopDeliverLimit() {
 	require.NoError(s.T(), failpoint.Enable(
 		"github.com/pingcap/tidb/br/pkg/lightning/restore/mock-kv-size", "return(110000000)"))
 	defer failpoint.Disable("github.com/pingcap/tidb/br/pkg/lightning/restore/mock-kv-size")
-	_, _, err = s.cr.encodeLoop(ctx, kvsCh, s.tr, s.tr.logger, kvEncoder, deliverCompleteCh, rc)
+	_, _, err = s.cr.encodeLoop(ctx, kvsCh, s.tr, 0, s.tr.logger, kvEncoder, deliverCompleteCh, rc)
 	require.NoError(s.T(), err)
 
 	// we have 3 kvs total. after the failpoint injected.
@@ -385,7 +386,7 @@ func (s *chunkRestoreSuite) TestEncodeLoopDeliverErrored() {
 	}()
 	cfg := config.NewConfig()
 	rc := &Controller{pauser: DeliverPauser, cfg: cfg}
-	_, _, err = s.cr.encodeLoop(ctx, kvsCh, s.tr, s.tr.logger, kvEncoder, deliverCompleteCh, rc)
+	_, _, err = s.cr.encodeLoop(ctx, kvsCh, s.tr, 0, s.tr.logger, kvEncoder, deliverCompleteCh, rc)
 	require.Equal(s.T(), "fake deliver error", err.Error())
 	require.Len(s.T(), kvsCh, 0)
 }
@@ -428,11 +429,64 @@ func (s *chunkRestoreSuite) TestEncodeLoopColumnsMismatch() {
 	require.NoError(s.T(), err)
 	defer kvEncoder.Close()
 
-	_, _, err = s.cr.encodeLoop(ctx, kvsCh, s.tr, s.tr.logger, kvEncoder, deliverCompleteCh, rc)
+	_, _, err = s.cr.encodeLoop(ctx, kvsCh, s.tr, 0, s.tr.logger, kvEncoder, deliverCompleteCh, rc)
 	require.Equal(s.T(), "[Lightning:Restore:ErrEncodeKV]encode kv error in file db.table.2.sql:0 at offset 4: column count mismatch, expected 3, got 2", err.Error())
 	require.Len(s.T(), kvsCh, 0)
 }
 
+func (s *chunkRestoreSuite) TestEncodeLoopSkipTypeErrors() {
+	dir := s.T().TempDir()
+
+	fileName := "db.table.000.csv"
+	err := os.WriteFile(filepath.Join(dir, fileName), []byte("1,2,3\r\nx,5,6\r\n7,8,9\r\n"), 0o644)
+	require.NoError(s.T(), err)
+
+	store, err := storage.NewLocalStorage(dir)
+	require.NoError(s.T(), err)
+
+	ctx := context.Background()
+	cfg := config.NewConfig()
+	cfg.App.MaxError.Type.Store(10)
+	errorMgr := errormanager.New(nil, cfg, log.L())
+	rc := &Controller{pauser: DeliverPauser, cfg: cfg, errorMgr: errorMgr}
+
+	reader, err := store.Open(ctx, fileName)
+	require.NoError(s.T(), err)
+	w := worker.NewPool(ctx, 5, "io")
+	p, err := mydump.NewCSVParser(ctx, &cfg.Mydumper.CSV, reader, 111, w, false, nil)
+	require.NoError(s.T(), err)
+
+	err = s.cr.parser.Close()
+	require.NoError(s.T(), err)
+	s.cr.parser = p
+	// the chunk ends with the bad row, the last row belongs to the next chunk.
+	s.cr.chunk.Chunk.EndOffset = 13
+
+	kvsCh := make(chan []deliveredKVs, 3)
+	deliverCompleteCh := make(chan deliverResult)
+	kvEncoder, err := kv.NewTableKVEncoder(s.tr.encTable, &kv.SessionOptions{
+		SQLMode:   mysql.ModeStrictAllTables,
+		Timestamp: 1234567895,
+	}, nil, log.L())
+	require.NoError(s.T(), err)
+	defer kvEncoder.Close()
+
+	_, _, err = s.cr.encodeLoop(ctx, kvsCh, s.tr, 0, s.tr.logger, kvEncoder, deliverCompleteCh, rc)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(9), errorMgr.TypeErrorsRemain())
+	require.Len(s.T(), kvsCh, 2)
+
+	kvs := <-kvsCh
+	require.Len(s.T(), kvs, 1)
+	require.Equal(s.T(), int64(1), kvs[0].rowID)
+	require.Equal(s.T(), int64(6), kvs[0].offset)
+
+	kvs = <-kvsCh
+	require.Len(s.T(), kvs, 1)
+	require.Nil(s.T(), kvs[0].kvs)
+	require.Equal(s.T(), s.cr.chunk.Chunk.EndOffset, kvs[0].offset)
+}
+
 func (s *chunkRestoreSuite) TestEncodeLoopIgnoreColumnsCSV() {
 	cases := []struct {
 		s             string
@@ -528,7 +582,7 @@ func (s *chunkRestoreSuite) testEncodeLoopIgnoreColumnsCSV(
 	require.NoError(s.T(), err)
 	defer kvEncoder.Close()
 
-	_, _, err = s.cr.encodeLoop(ctx, kvsCh, s.tr, s.tr.logger, kvEncoder, deliverCompleteCh, rc)
+	_, _, err = s.cr.encodeLoop(ctx, kvsCh, s.tr, 0, s.tr.logger, kvEncoder, deliverCompleteCh, rc)
 	require.NoError(s.T(), err)
 	require.Len(s.T(), kvsCh, 2)
 
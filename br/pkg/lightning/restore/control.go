@@ -0,0 +1,392 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/common"
+	"github.com/pingcap/tidb/br/pkg/lightning/worker"
+	"golang.org/x/exp/slices"
+)
+
+// DefaultImportControl is a shared ImportControl to control the tables, the engines
+// and the concurrency of the running task through the HTTP API.
+var DefaultImportControl = NewImportControl()
+
+// The names of the worker pools whose concurrency can be adjusted at runtime.
+const (
+	PoolRegion = "region"
+	PoolIO     = "io"
+	PoolTable  = "table"
+	PoolIndex  = "index"
+)
+
+// ImportControl controls the import of the individual tables and engines of
+// the running task, i.e. pauses, resumes or aborts them, and adjusts the
+// concurrency of the worker pools at runtime.
+//
+// All the methods are safe to be called on a nil *ImportControl, which
+// controls nothing.
+type ImportControl struct {
+	mu     sync.Mutex
+	tables map[string]*tableControl
+	pools  map[string]*worker.Pool
+}
+
+type tableControl struct {
+	pauser  *common.Pauser
+	cancel  context.CancelFunc
+	aborted bool
+	engines map[int32]*engineControl
+}
+
+type engineControl struct {
+	pauser  *common.Pauser
+	cancel  context.CancelFunc
+	aborted bool
+}
+
+// EngineControlStatus is the control status of an engine.
+type EngineControlStatus struct {
+	EngineID int32 `json:"engine_id"`
+	Paused   bool  `json:"paused"`
+	Aborted  bool  `json:"aborted"`
+}
+
+// TableControlStatus is the control status of a table and its engines.
+type TableControlStatus struct {
+	Table   string                `json:"table"`
+	Paused  bool                  `json:"paused"`
+	Aborted bool                  `json:"aborted"`
+	Engines []EngineControlStatus `json:"engines"`
+}
+
+// NewImportControl creates an ImportControl.
+func NewImportControl() *ImportControl {
+	return &ImportControl{
+		tables: make(map[string]*tableControl),
+		pools:  make(map[string]*worker.Pool),
+	}
+}
+
+func newTableControl() *tableControl {
+	return &tableControl{
+		pauser:  common.NewPauser(),
+		engines: make(map[int32]*engineControl),
+	}
+}
+
+// reset forgets all the tables and the worker pools of the previous task, and
+// registers the tables of the new task.
+func (c *ImportControl) reset(tableNames []string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tables = make(map[string]*tableControl, len(tableNames))
+	for _, name := range tableNames {
+		c.tables[name] = newTableControl()
+	}
+	c.pools = make(map[string]*worker.Pool)
+}
+
+// registerPool registers a worker pool whose concurrency can be adjusted.
+func (c *ImportControl) registerPool(name string, pool *worker.Pool) {
+	if c == nil || pool == nil {
+		return
+	}
+	c.mu.Lock()
+	c.pools[name] = pool
+	c.mu.Unlock()
+}
+
+// startTable returns the context to import the table, which is canceled when
+// the table is aborted. The context isn't canceled after the table finishes,
+// since the table may still be analyzed in background.
+func (c *ImportControl) startTable(ctx context.Context, tableName string) context.Context {
+	if c == nil {
+		return ctx
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tc, ok := c.tables[tableName]
+	if !ok {
+		tc = newTableControl()
+		c.tables[tableName] = tc
+	}
+	if tc.aborted {
+		cancel()
+	}
+	tc.cancel = cancel
+	return ctx
+}
+
+// startEngine returns the context to restore the engine, which is canceled
+// when the engine is aborted.
+func (c *ImportControl) startEngine(ctx context.Context, tableName string, engineID int32) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	if c == nil {
+		return ctx, cancel
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tc, ok := c.tables[tableName]
+	if !ok {
+		tc = newTableControl()
+		c.tables[tableName] = tc
+	}
+	ec := tc.engine(engineID)
+	if ec.aborted {
+		cancel()
+	}
+	ec.cancel = cancel
+	return ctx, cancel
+}
+
+// isTableAborted checks whether the table or any of its engines is aborted.
+func (c *ImportControl) isTableAborted(tableName string) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tc, ok := c.tables[tableName]
+	if !ok {
+		return false
+	}
+	if tc.aborted {
+		return true
+	}
+	for _, ec := range tc.engines {
+		if ec.aborted {
+			return true
+		}
+	}
+	return false
+}
+
+// wait blocks until neither the table nor the engine is paused.
+func (c *ImportControl) wait(ctx context.Context, tableName string, engineID int32) error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	tc, ok := c.tables[tableName]
+	var tablePauser, enginePauser *common.Pauser
+	if ok {
+		tablePauser = tc.pauser
+		if ec, ok := tc.engines[engineID]; ok {
+			enginePauser = ec.pauser
+		}
+	}
+	c.mu.Unlock()
+
+	if tablePauser != nil {
+		if err := tablePauser.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	if enginePauser != nil {
+		return enginePauser.Wait(ctx)
+	}
+	return nil
+}
+
+func (tc *tableControl) engine(engineID int32) *engineControl {
+	ec, ok := tc.engines[engineID]
+	if !ok {
+		ec = &engineControl{pauser: common.NewPauser()}
+		tc.engines[engineID] = ec
+	}
+	return ec
+}
+
+func (c *ImportControl) getTable(tableName string) (*tableControl, error) {
+	tc, ok := c.tables[tableName]
+	if !ok {
+		return nil, errors.NotFoundf("table %s", tableName)
+	}
+	return tc, nil
+}
+
+// PauseTable pauses encoding and delivering the data of the table.
+func (c *ImportControl) PauseTable(tableName string) error {
+	if c == nil {
+		return errors.NotFoundf("table %s", tableName)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tc, err := c.getTable(tableName)
+	if err != nil {
+		return err
+	}
+	tc.pauser.Pause()
+	return nil
+}
+
+// ResumeTable resumes the table paused by PauseTable.
+func (c *ImportControl) ResumeTable(tableName string) error {
+	if c == nil {
+		return errors.NotFoundf("table %s", tableName)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tc, err := c.getTable(tableName)
+	if err != nil {
+		return err
+	}
+	tc.pauser.Resume()
+	return nil
+}
+
+// AbortTable aborts importing the table, the other tables are not affected.
+// The table is aborted as soon as it starts if it's still waiting for import.
+func (c *ImportControl) AbortTable(tableName string) error {
+	if c == nil {
+		return errors.NotFoundf("table %s", tableName)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tc, err := c.getTable(tableName)
+	if err != nil {
+		return err
+	}
+	tc.aborted = true
+	if tc.cancel != nil {
+		tc.cancel()
+	}
+	// the paused goroutines are waken up by the canceled context.
+	return nil
+}
+
+// PauseEngine pauses encoding and delivering the data of the engine.
+func (c *ImportControl) PauseEngine(tableName string, engineID int32) error {
+	if c == nil {
+		return errors.NotFoundf("table %s", tableName)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tc, err := c.getTable(tableName)
+	if err != nil {
+		return err
+	}
+	tc.engine(engineID).pauser.Pause()
+	return nil
+}
+
+// ResumeEngine resumes the engine paused by PauseEngine.
+func (c *ImportControl) ResumeEngine(tableName string, engineID int32) error {
+	if c == nil {
+		return errors.NotFoundf("table %s", tableName)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tc, err := c.getTable(tableName)
+	if err != nil {
+		return err
+	}
+	tc.engine(engineID).pauser.Resume()
+	return nil
+}
+
+// AbortEngine aborts restoring the engine, which fails the import of the
+// table it belongs to.
+func (c *ImportControl) AbortEngine(tableName string, engineID int32) error {
+	if c == nil {
+		return errors.NotFoundf("table %s", tableName)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tc, err := c.getTable(tableName)
+	if err != nil {
+		return err
+	}
+	ec := tc.engine(engineID)
+	ec.aborted = true
+	if ec.cancel != nil {
+		ec.cancel()
+	}
+	return nil
+}
+
+// Status returns the control status of all the tables, sorted by the table names.
+func (c *ImportControl) Status() []TableControlStatus {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	result := make([]TableControlStatus, 0, len(c.tables))
+	for name, tc := range c.tables {
+		status := TableControlStatus{
+			Table:   name,
+			Paused:  tc.pauser.IsPaused(),
+			Aborted: tc.aborted,
+			Engines: make([]EngineControlStatus, 0, len(tc.engines)),
+		}
+		for engineID, ec := range tc.engines {
+			status.Engines = append(status.Engines, EngineControlStatus{
+				EngineID: engineID,
+				Paused:   ec.pauser.IsPaused(),
+				Aborted:  ec.aborted,
+			})
+		}
+		slices.SortFunc(status.Engines, func(i, j EngineControlStatus) bool { return i.EngineID < j.EngineID })
+		result = append(result, status)
+	}
+	slices.SortFunc(result, func(i, j TableControlStatus) bool { return i.Table < j.Table })
+	return result
+}
+
+// Concurrency returns the concurrency of the registered worker pools.
+func (c *ImportControl) Concurrency() map[string]int {
+	result := make(map[string]int)
+	if c == nil {
+		return result
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for name, pool := range c.pools {
+		result[name] = pool.Limit()
+	}
+	return result
+}
+
+// SetConcurrency adjusts the concurrency of the registered worker pools, the
+// pools not in `concurrency` are unchanged.
+func (c *ImportControl) SetConcurrency(concurrency map[string]int) error {
+	if c == nil {
+		return errors.New("no task is running")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for name, limit := range concurrency {
+		if _, ok := c.pools[name]; !ok {
+			return errors.NotFoundf("worker pool %s", name)
+		}
+		if limit <= 0 {
+			return errors.Errorf("the concurrency of worker pool %s must be positive, got %d", name, limit)
+		}
+	}
+	for name, limit := range concurrency {
+		c.pools[name].SetLimit(limit)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/worker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportControlPauseAndAbort(t *testing.T) {
+	ctx := context.Background()
+	ctl := NewImportControl()
+	ctl.reset([]string{"`db`.`t1`", "`db`.`t2`"})
+
+	require.True(t, errors.IsNotFound(ctl.PauseTable("`db`.`t3`")))
+
+	t1Ctx := ctl.startTable(ctx, "`db`.`t1`")
+	require.NoError(t, ctl.PauseTable("`db`.`t1`"))
+	waitDone := make(chan error, 1)
+	go func() {
+		waitDone <- ctl.wait(t1Ctx, "`db`.`t1`", 0)
+	}()
+	select {
+	case <-waitDone:
+		require.FailNow(t, "the paused table should block")
+	case <-time.After(100 * time.Millisecond):
+	}
+	// the other tables are not paused
+	require.NoError(t, ctl.wait(ctx, "`db`.`t2`", 0))
+	require.NoError(t, ctl.ResumeTable("`db`.`t1`"))
+	require.NoError(t, <-waitDone)
+
+	// pause and abort an engine
+	require.NoError(t, ctl.PauseEngine("`db`.`t1`", 1))
+	engineCtx, cancel := ctl.startEngine(t1Ctx, "`db`.`t1`", 1)
+	defer cancel()
+	go func() {
+		waitDone <- ctl.wait(engineCtx, "`db`.`t1`", 1)
+	}()
+	require.NoError(t, ctl.wait(t1Ctx, "`db`.`t1`", 0))
+	require.False(t, ctl.isTableAborted("`db`.`t1`"))
+	require.NoError(t, ctl.AbortEngine("`db`.`t1`", 1))
+	require.ErrorIs(t, <-waitDone, context.Canceled)
+	require.NoError(t, t1Ctx.Err())
+	require.True(t, ctl.isTableAborted("`db`.`t1`"))
+
+	// the table waiting for import is aborted as soon as it starts
+	require.NoError(t, ctl.AbortTable("`db`.`t2`"))
+	t2Ctx := ctl.startTable(ctx, "`db`.`t2`")
+	require.ErrorIs(t, t2Ctx.Err(), context.Canceled)
+
+	require.Equal(t, []TableControlStatus{
+		{
+			Table:   "`db`.`t1`",
+			Engines: []EngineControlStatus{{EngineID: 1, Paused: true, Aborted: true}},
+		},
+		{
+			Table:   "`db`.`t2`",
+			Aborted: true,
+			Engines: []EngineControlStatus{},
+		},
+	}, ctl.Status())
+
+	// a nil ImportControl controls nothing
+	var nilCtl *ImportControl
+	require.NoError(t, nilCtl.wait(ctx, "`db`.`t1`", 0))
+	require.NoError(t, nilCtl.startTable(ctx, "`db`.`t1`").Err())
+	require.False(t, nilCtl.isTableAborted("`db`.`t1`"))
+	require.Error(t, nilCtl.AbortTable("`db`.`t1`"))
+	require.Nil(t, nilCtl.Status())
+}
+
+func TestImportControlConcurrency(t *testing.T) {
+	ctx := context.Background()
+	ctl := NewImportControl()
+	ctl.reset(nil)
+	regionWorkers := worker.NewPool(ctx, 4, "region")
+	ctl.registerPool(PoolRegion, regionWorkers)
+	ctl.registerPool(PoolIO, nil)
+	require.Equal(t, map[string]int{PoolRegion: 4}, ctl.Concurrency())
+
+	require.NoError(t, ctl.SetConcurrency(map[string]int{PoolRegion: 8}))
+	require.Equal(t, 8, regionWorkers.Limit())
+	require.True(t, errors.IsNotFound(ctl.SetConcurrency(map[string]int{PoolIO: 8})))
+	require.Error(t, ctl.SetConcurrency(map[string]int{PoolRegion: 0}))
+	require.Equal(t, map[string]int{PoolRegion: 8}, ctl.Concurrency())
+}
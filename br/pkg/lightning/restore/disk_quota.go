@@ -0,0 +1,277 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/google/uuid"
+	"github.com/pingcap/tidb/br/pkg/lightning/common"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/lightning/log"
+	"github.com/pingcap/tidb/br/pkg/lightning/metric"
+	"go.uber.org/atomic"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	diskQuotaStateIdle int32 = iota
+	diskQuotaStateChecking
+	diskQuotaStateImporting
+)
+
+const (
+	// minDiskQuotaCheckBytes is the minimum size of the KV pairs written
+	// between two disk quota checks triggered by the writers.
+	minDiskQuotaCheckBytes = 1 * units.MiB
+	// diskQuotaReservedRatio is the ratio of the free space of sorted-kv-dir
+	// reserved for the compaction of the engines and the SST files generated
+	// while importing, when the disk quota is capped by the free space.
+	diskQuotaReservedRatio = 0.1
+)
+
+// allEnginesGate is the gate which blocks the writers of all engines.
+var allEnginesGate = uuid.Nil
+
+// diskQuotaController keeps the size of the local engines below the disk
+// quota. The size of the engines is checked every `cron.check-disk-quota`, or
+// once the writers have written half of the remaining quota since the last
+// check, so the writes between two checks are bounded by the quota. If the
+// quota is exceeded, the largest engines are flushed, imported and reset, and
+// only their writers are blocked meanwhile, the writers of other engines keep
+// writing. The checks are triggered by the writers only after it's enabled.
+type diskQuotaController struct {
+	enabled bool
+	quota   int64
+	state   atomic.Int32
+
+	mu    sync.Mutex
+	gates map[uuid.UUID]*sync.RWMutex
+
+	// written is the total size of the KV pairs written by the writers, a check
+	// is triggered once it reaches nextCheck.
+	written   atomic.Int64
+	nextCheck atomic.Int64
+}
+
+// enable enables the checks triggered by the writers with the quota.
+func (dq *diskQuotaController) enable(quota int64) {
+	dq.enabled = true
+	dq.quota = quota
+	dq.scheduleNextCheck(quota)
+}
+
+func (dq *diskQuotaController) gate(engineUUID uuid.UUID) *sync.RWMutex {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	if dq.gates == nil {
+		dq.gates = make(map[uuid.UUID]*sync.RWMutex)
+	}
+	g, ok := dq.gates[engineUUID]
+	if !ok {
+		g = &sync.RWMutex{}
+		dq.gates[engineUUID] = g
+	}
+	return g
+}
+
+// tryRLock read-locks the gates of all the engines and returns them, or locks
+// none of them if any gate is locked.
+func (dq *diskQuotaController) tryRLock(engineUUIDs ...uuid.UUID) ([]*sync.RWMutex, bool) {
+	gates := make([]*sync.RWMutex, 0, len(engineUUIDs))
+	for _, engineUUID := range engineUUIDs {
+		g := dq.gate(engineUUID)
+		if !g.TryRLock() {
+			rUnlockGates(gates)
+			return nil, false
+		}
+		gates = append(gates, g)
+	}
+	return gates, true
+}
+
+func rUnlockGates(gates []*sync.RWMutex) {
+	for _, g := range gates {
+		g.RUnlock()
+	}
+}
+
+// removeGate removes the gate of the engine once it's closed.
+func (dq *diskQuotaController) removeGate(engineUUID uuid.UUID) {
+	dq.mu.Lock()
+	delete(dq.gates, engineUUID)
+	dq.mu.Unlock()
+}
+
+// addWritten records the size of the written KV pairs, and returns whether the
+// disk quota should be checked.
+func (dq *diskQuotaController) addWritten(size int64) bool {
+	if !dq.enabled {
+		return false
+	}
+	return dq.written.Add(size) >= dq.nextCheck.Load()
+}
+
+// scheduleNextCheck triggers the next check once the writers have written half
+// of the remaining quota.
+func (dq *diskQuotaController) scheduleNextCheck(remaining int64) {
+	window := remaining / 2
+	if window < minDiskQuotaCheckBytes {
+		window = minDiskQuotaCheckBytes
+	}
+	dq.nextCheck.Store(dq.written.Load() + window)
+}
+
+// capDiskQuota caps the disk quota by the space sorted-kv-dir can use, which
+// is the free space except a reserved part plus the size of the engines left
+// by the previous run, so the engines never fill up the disk.
+func capDiskQuota(ctx context.Context, cfg *config.Config) int64 {
+	quota := int64(cfg.TikvImporter.DiskQuota)
+	logger := log.FromContext(ctx)
+	storageSize, err := common.GetStorageSize(cfg.TikvImporter.SortedKVDir)
+	if err != nil {
+		logger.Warn("get the storage size of sorted-kv-dir failed, the disk quota is not capped", log.ShortError(err))
+		return quota
+	}
+	var usedSize int64
+	err = filepath.WalkDir(cfg.TikvImporter.SortedKVDir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		info, err := d.Info()
+		if err == nil {
+			usedSize += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Warn("get the size of sorted-kv-dir failed", log.ShortError(err))
+	}
+	available := int64(storageSize.Available)
+	capped := available - int64(float64(available)*diskQuotaReservedRatio) + usedSize
+	if capped < quota {
+		logger.Info("the disk quota is capped by the free space of sorted-kv-dir",
+			zap.String("disk-quota", units.BytesSize(float64(quota))),
+			zap.String("capped", units.BytesSize(float64(capped))))
+		return capped
+	}
+	return quota
+}
+
+func (rc *Controller) enforceDiskQuota(ctx context.Context) {
+	if !rc.diskQuota.state.CAS(diskQuotaStateIdle, diskQuotaStateChecking) {
+		// do not run multiple the disk quota check / import simultaneously.
+		// (we execute the lock check in background to avoid blocking the cron thread)
+		return
+	}
+
+	go func() {
+		// blocked are the gates locked when we detect the disk quota is
+		// exceeded. before the disk quota is confirmed exceeded, we keep the
+		// gates unlocked to avoid periodically interrupting the writer threads.
+		blocked := make(map[uuid.UUID]*sync.RWMutex)
+		defer func() {
+			rc.diskQuota.state.Store(diskQuotaStateIdle)
+			for _, g := range blocked {
+				g.Unlock()
+			}
+		}()
+		block := func(engineUUID uuid.UUID) {
+			if _, ok := blocked[engineUUID]; !ok {
+				g := rc.diskQuota.gate(engineUUID)
+				g.Lock()
+				blocked[engineUUID] = g
+			}
+		}
+
+		isRetrying := false
+
+		for {
+			// sleep for a cycle if we are retrying because there is nothing new to import.
+			if isRetrying {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(rc.cfg.Cron.CheckDiskQuota.Duration):
+				}
+			} else {
+				isRetrying = true
+			}
+
+			quota := rc.diskQuota.quota
+			largeEngines, inProgressLargeEngines, totalDiskSize, totalMemSize := rc.backend.CheckDiskQuota(quota)
+			if m, ok := metric.FromContext(ctx); ok {
+				m.LocalStorageUsageBytesGauge.WithLabelValues("disk").Set(float64(totalDiskSize))
+				m.LocalStorageUsageBytesGauge.WithLabelValues("mem").Set(float64(totalMemSize))
+			}
+			rc.diskQuota.scheduleNextCheck(quota - totalDiskSize - totalMemSize)
+
+			logger := log.FromContext(ctx).With(
+				zap.Int64("diskSize", totalDiskSize),
+				zap.Int64("memSize", totalMemSize),
+				zap.Int64("quota", quota),
+				zap.Int("largeEnginesCount", len(largeEngines)),
+				zap.Int("inProgressLargeEnginesCount", inProgressLargeEngines))
+
+			if len(largeEngines) == 0 && inProgressLargeEngines == 0 {
+				logger.Debug("disk quota respected")
+				return
+			}
+
+			logger.Warn("disk quota exceeded")
+			if len(largeEngines) == 0 {
+				logger.Warn("all large engines are already importing, keep blocking all writes")
+				block(allEnginesGate)
+				continue
+			}
+
+			// block the writers of the large engines only, and flush them so
+			// that the checkpoints of their chunks can be updated.
+			var flushErr error
+			for _, engineUUID := range largeEngines {
+				block(engineUUID)
+				if err := rc.backend.FlushEngine(ctx, engineUUID); err != nil {
+					flushErr = err
+					break
+				}
+			}
+			if flushErr != nil {
+				logger.Error("flush engine for disk quota failed, check again later", log.ShortError(flushErr))
+				return
+			}
+
+			// at this point, the large engines are synchronized on disk.
+			// we then import them one by one and complete.
+			// if any engine failed to import, we just try again next time, since the data are still intact.
+			rc.diskQuota.state.Store(diskQuotaStateImporting)
+			task := logger.Begin(zap.WarnLevel, "importing large engines for disk quota")
+			var importErr error
+			for _, engine := range largeEngines {
+				// Use a larger split region size to avoid split the same region by many times.
+				if err := rc.backend.UnsafeImportAndReset(ctx, engine, int64(config.SplitRegionSize)*int64(config.MaxSplitRegionSizeRatio), int64(config.SplitRegionKeys)*int64(config.MaxSplitRegionSizeRatio)); err != nil {
+					importErr = multierr.Append(importErr, err)
+				}
+			}
+			task.End(zap.ErrorLevel, importErr)
+			return
+		}
+	}()
+}
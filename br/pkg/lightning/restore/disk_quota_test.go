@@ -0,0 +1,131 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/br/pkg/lightning/backend"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskQuotaGates(t *testing.T) {
+	var dq diskQuotaController
+	engine1, engine2 := uuid.New(), uuid.New()
+
+	gates, ok := dq.tryRLock(allEnginesGate, engine1, engine2)
+	require.True(t, ok)
+	require.Len(t, gates, 3)
+	rUnlockGates(gates)
+
+	// the writers of engine2 are blocked, and the gates locked before it are
+	// released.
+	dq.gate(engine2).Lock()
+	_, ok = dq.tryRLock(allEnginesGate, engine1, engine2)
+	require.False(t, ok)
+	require.True(t, dq.gate(engine1).TryLock())
+	dq.gate(engine1).Unlock()
+	gates, ok = dq.tryRLock(allEnginesGate, engine1)
+	require.True(t, ok)
+	rUnlockGates(gates)
+	dq.gate(engine2).Unlock()
+
+	dq.removeGate(engine2)
+	require.Len(t, dq.gates, 2)
+}
+
+func TestDiskQuotaNextCheck(t *testing.T) {
+	var dq diskQuotaController
+	require.False(t, dq.addWritten(math.MaxInt32))
+
+	dq.enable(10 * units.MiB)
+	require.False(t, dq.addWritten(4*units.MiB))
+	require.True(t, dq.addWritten(1*units.MiB))
+
+	// the check is triggered at least every minDiskQuotaCheckBytes.
+	dq.scheduleNextCheck(-units.MiB)
+	require.False(t, dq.addWritten(units.MiB-1))
+	require.True(t, dq.addWritten(1))
+}
+
+func TestCapDiskQuota(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.NewConfig()
+	cfg.TikvImporter.SortedKVDir = t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(cfg.TikvImporter.SortedKVDir, "engine"), make([]byte, 100), 0o600))
+
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/br/pkg/lightning/common/GetStorageSize", "return(2048)"))
+	defer func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/br/pkg/lightning/common/GetStorageSize"))
+	}()
+
+	// 10% of the free space is reserved, and the size of the engines is counted.
+	require.Equal(t, int64(2048-204+100), capDiskQuota(ctx, cfg))
+	cfg.TikvImporter.DiskQuota = 1000
+	require.Equal(t, int64(1000), capDiskQuota(ctx, cfg))
+}
+
+func TestEnforceDiskQuota(t *testing.T) {
+	ctx := context.Background()
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	mockBackend := mock.NewMockBackend(controller)
+
+	cfg := config.NewConfig()
+	cfg.Cron.CheckDiskQuota.Duration = time.Second
+	rc := &Controller{cfg: cfg, backend: backend.MakeBackend(mockBackend)}
+	rc.diskQuota.enable(500)
+
+	smallEngine, largeEngine := uuid.New(), uuid.New()
+	mockBackend.EXPECT().EngineFileSizes().Return([]backend.EngineFileSize{
+		{UUID: smallEngine, DiskSize: 100},
+		{UUID: largeEngine, DiskSize: 1000},
+	})
+	mockBackend.EXPECT().FlushEngine(gomock.Any(), largeEngine).Return(nil)
+	mockBackend.EXPECT().ImportEngine(gomock.Any(), largeEngine, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, uuid.UUID, int64, int64) error {
+			// only the writers of the large engine are blocked.
+			_, ok := rc.diskQuota.tryRLock(allEnginesGate, largeEngine)
+			require.False(t, ok)
+			gates, ok := rc.diskQuota.tryRLock(allEnginesGate, smallEngine)
+			require.True(t, ok)
+			rUnlockGates(gates)
+			return nil
+		})
+	mockBackend.EXPECT().ResetEngine(gomock.Any(), largeEngine).Return(nil)
+
+	rc.enforceDiskQuota(ctx)
+	require.Eventually(t, func() bool {
+		return rc.diskQuota.state.Load() == diskQuotaStateIdle
+	}, 5*time.Second, 10*time.Millisecond)
+	gates, ok := rc.diskQuota.tryRLock(allEnginesGate, smallEngine, largeEngine)
+	require.True(t, ok)
+	rUnlockGates(gates)
+	// the next check is triggered after half of the remaining quota is written,
+	// which is at least minDiskQuotaCheckBytes.
+	require.False(t, rc.diskQuota.addWritten(minDiskQuotaCheckBytes-1))
+	require.True(t, rc.diskQuota.addWritten(1))
+}
@@ -0,0 +1,273 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/checkpoints"
+	"github.com/pingcap/tidb/br/pkg/lightning/common"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/lightning/errormanager"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/tablecodec"
+	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
+)
+
+// duplicateReportFlushSize is the size of the buffered records flushed to the
+// external storage at once.
+const duplicateReportFlushSize = 1 << 20
+
+var duplicateReportHeader = []string{"table", "index", "key", "key_data", "row", "source_path", "source_offset"}
+
+// DuplicateRecord is a record of the duplicate report, which is a row
+// conflicting with the other rows on a unique key.
+type DuplicateRecord struct {
+	Table string `json:"table"`
+	// Index is the name of the conflicted unique index, or "PRIMARY" if the
+	// rows conflict on the handle.
+	Index string `json:"index"`
+	// Key is the conflicted key encoded in hex, the records with the same key
+	// conflict with each other.
+	Key string `json:"key"`
+	// KeyData is the conflicted key or the handle of the row, decoded for
+	// human reading.
+	KeyData string `json:"key_data"`
+	Row     string `json:"row"`
+	// SourcePath and SourceOffset locate the chunk of the source file which
+	// the row is imported from. They are empty if the row can't be located,
+	// e.g. the handle of the table isn't the row ID.
+	SourcePath   string `json:"source_path,omitempty"`
+	SourceOffset int64  `json:"source_offset,omitempty"`
+}
+
+// DuplicateReportFileName returns the name of the duplicate report file of the
+// table in the `tikv-importer.duplicate-report-dir`.
+func DuplicateReportFileName(schemaName, tableName, format string) string {
+	return fmt.Sprintf("%s.%s.duplicates.%s", schemaName, tableName, format)
+}
+
+// ReadDuplicateReport reads the duplicate report of the table exported by the
+// task. It returns a NotFound error if the report isn't exported.
+func ReadDuplicateReport(ctx context.Context, cfg *config.Config, schemaName, tableName string) ([]byte, error) {
+	if len(cfg.TikvImporter.DuplicateReportDir) == 0 {
+		return nil, errors.NotFoundf("duplicate report of %s", common.UniqueTable(schemaName, tableName))
+	}
+	store, err := openDuplicateReportStorage(ctx, cfg.TikvImporter.DuplicateReportDir)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	name := DuplicateReportFileName(schemaName, tableName, cfg.TikvImporter.DuplicateReportFormat)
+	exists, err := store.FileExists(ctx, name)
+	if err != nil {
+		return nil, common.NormalizeOrWrapErr(common.ErrStorageUnknown, err)
+	}
+	if !exists {
+		return nil, errors.NotFoundf("duplicate report of %s", common.UniqueTable(schemaName, tableName))
+	}
+	data, err := store.ReadFile(ctx, name)
+	return data, common.NormalizeOrWrapErr(common.ErrStorageUnknown, err)
+}
+
+func openDuplicateReportStorage(ctx context.Context, dir string) (storage.ExternalStorage, error) {
+	u, err := storage.ParseBackend(dir, nil)
+	if err != nil {
+		return nil, common.NormalizeError(err)
+	}
+	store, err := storage.New(ctx, u, &storage.ExternalStorageOptions{})
+	if err != nil {
+		return nil, common.NormalizeOrWrapErr(common.ErrStorageUnknown, err)
+	}
+	return store, nil
+}
+
+// exportDuplicateReport exports the duplicate records of the table recorded by
+// the duplicate detection to the `tikv-importer.duplicate-report-dir`.
+func (rc *Controller) exportDuplicateReport(ctx context.Context, tr *TableRestore, cp *checkpoints.TableCheckpoint) error {
+	if len(rc.cfg.TikvImporter.DuplicateReportDir) == 0 {
+		return nil
+	}
+	store, err := openDuplicateReportStorage(ctx, rc.cfg.TikvImporter.DuplicateReportDir)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	name := DuplicateReportFileName(tr.dbInfo.Name, tr.tableInfo.Name, rc.cfg.TikvImporter.DuplicateReportFormat)
+	fileWriter, err := store.Create(ctx, name)
+	if err != nil {
+		return common.NormalizeOrWrapErr(common.ErrStorageUnknown, err)
+	}
+	w, err := newDuplicateReportWriter(fileWriter, rc.cfg.TikvImporter.DuplicateReportFormat)
+	if err != nil {
+		_ = fileWriter.Close(ctx)
+		return errors.Trace(err)
+	}
+
+	var locator *rowSourceLocator
+	if !rc.cfg.TikvImporter.IncrementalImport {
+		// the existing rows can't be told apart from the imported rows by the
+		// row IDs in the incremental import, so the rows aren't located.
+		locator = newRowSourceLocator(tr.tableInfo.Core, cp)
+	}
+	count := 0
+	err = rc.errorMgr.ReadConflictRecords(ctx, tr.tableName, func(r *errormanager.ConflictRecord) error {
+		record := &DuplicateRecord{
+			Table:   tr.tableName,
+			Index:   r.IndexName,
+			Key:     hex.EncodeToString(r.RawKey),
+			KeyData: r.KeyData,
+			Row:     r.RowData,
+		}
+		record.SourcePath, record.SourceOffset = locator.locate(r.RawHandle)
+		count++
+		return w.write(ctx, record)
+	})
+	if err == nil {
+		err = w.flush(ctx)
+	}
+	if closeErr := fileWriter.Close(ctx); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Annotatef(err, "failed to export the duplicate report of %s", tr.tableName)
+	}
+	tr.logger.Info("duplicate report exported", zap.String("file", name), zap.Int("records", count))
+	return nil
+}
+
+// duplicateReportWriter buffers the encoded duplicate records and flushes them
+// to the external storage.
+type duplicateReportWriter struct {
+	writer    storage.ExternalFileWriter
+	buf       bytes.Buffer
+	csvWriter *csv.Writer
+	jsonEnc   *json.Encoder
+}
+
+func newDuplicateReportWriter(writer storage.ExternalFileWriter, format string) (*duplicateReportWriter, error) {
+	w := &duplicateReportWriter{writer: writer}
+	if format == config.DuplicateReportJSON {
+		w.jsonEnc = json.NewEncoder(&w.buf)
+		return w, nil
+	}
+	w.csvWriter = csv.NewWriter(&w.buf)
+	if err := w.csvWriter.Write(duplicateReportHeader); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+func (w *duplicateReportWriter) write(ctx context.Context, record *DuplicateRecord) error {
+	if w.jsonEnc != nil {
+		if err := w.jsonEnc.Encode(record); err != nil {
+			return errors.Trace(err)
+		}
+	} else {
+		var offset string
+		if len(record.SourcePath) > 0 {
+			offset = strconv.FormatInt(record.SourceOffset, 10)
+		}
+		if err := w.csvWriter.Write([]string{
+			record.Table, record.Index, record.Key, record.KeyData, record.Row, record.SourcePath, offset,
+		}); err != nil {
+			return errors.Trace(err)
+		}
+		w.csvWriter.Flush()
+	}
+	if w.buf.Len() >= duplicateReportFlushSize {
+		return w.flush(ctx)
+	}
+	return nil
+}
+
+func (w *duplicateReportWriter) flush(ctx context.Context) error {
+	if w.csvWriter != nil {
+		w.csvWriter.Flush()
+		if err := w.csvWriter.Error(); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	if _, err := w.writer.Write(ctx, w.buf.Bytes()); err != nil {
+		return common.NormalizeOrWrapErr(common.ErrStorageUnknown, err)
+	}
+	w.buf.Reset()
+	return nil
+}
+
+// rowSourceLocator locates the source chunks of the rows by their row IDs.
+// The chunks of a table are assigned with the consecutive ranges of row IDs,
+// so a row belongs to the first chunk whose RowIDMax isn't less than its ID.
+type rowSourceLocator struct {
+	chunks []*checkpoints.ChunkCheckpoint
+}
+
+// newRowSourceLocator creates a rowSourceLocator of the table, it returns nil
+// if the handle of the table isn't the row ID.
+func newRowSourceLocator(tableInfo *model.TableInfo, cp *checkpoints.TableCheckpoint) *rowSourceLocator {
+	if !common.TableHasAutoRowID(tableInfo) {
+		return nil
+	}
+	l := &rowSourceLocator{}
+	for _, engine := range cp.Engines {
+		for _, chunk := range engine.Chunks {
+			if chunk.FileMeta.IsStream {
+				// the row IDs of the stream chunk are unknown until it's drained.
+				return nil
+			}
+			l.chunks = append(l.chunks, chunk)
+		}
+	}
+	slices.SortFunc(l.chunks, func(i, j *checkpoints.ChunkCheckpoint) bool {
+		return i.Chunk.RowIDMax < j.Chunk.RowIDMax
+	})
+	return l
+}
+
+// locate returns the path and the offset of the source chunk of the row with
+// the given raw handle, which is a record key.
+func (l *rowSourceLocator) locate(rawHandle []byte) (path string, offset int64) {
+	if l == nil || len(rawHandle) == 0 {
+		return "", 0
+	}
+	_, handle, err := tablecodec.DecodeRecordKey(rawHandle)
+	if err != nil || !handle.IsInt() {
+		return "", 0
+	}
+	rowID := handle.IntValue()
+	if rowID <= 0 {
+		return "", 0
+	}
+	i := sort.Search(len(l.chunks), func(i int) bool {
+		return l.chunks[i].Chunk.RowIDMax >= rowID
+	})
+	if i >= len(l.chunks) {
+		return "", 0
+	}
+	return l.chunks[i].Key.Path, l.chunks[i].Key.Offset
+}
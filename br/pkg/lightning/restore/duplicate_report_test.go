@@ -0,0 +1,109 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/checkpoints"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/lightning/errormanager"
+	"github.com/pingcap/tidb/br/pkg/lightning/log"
+	"github.com/pingcap/tidb/br/pkg/lightning/mydump"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportDuplicateReport(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.Background()
+	cfg := config.NewConfig()
+	cfg.TikvImporter.DuplicateResolution = config.DupeResAlgRecord
+	cfg.TikvImporter.DuplicateReportDir = t.TempDir()
+	cfg.TikvImporter.DuplicateReportFormat = config.DuplicateReportCSV
+	rc := &Controller{
+		cfg:      cfg,
+		errorMgr: errormanager.New(db, cfg, log.L()),
+	}
+	tr := &TableRestore{
+		tableName: "`db`.`t`",
+		dbInfo:    &checkpoints.TidbDBInfo{Name: "db"},
+		tableInfo: &checkpoints.TidbTableInfo{
+			Name: "t",
+			Core: &model.TableInfo{ID: 1, Name: model.NewCIStr("t")},
+		},
+		logger: log.L(),
+	}
+	cp := &checkpoints.TableCheckpoint{
+		Engines: map[int32]*checkpoints.EngineCheckpoint{
+			0: {Chunks: []*checkpoints.ChunkCheckpoint{
+				{
+					Key:   checkpoints.ChunkCheckpointKey{Path: "db.t.1.csv", Offset: 0},
+					Chunk: mydump.Chunk{PrevRowIDMax: 10, RowIDMax: 10},
+				},
+				{
+					Key:   checkpoints.ChunkCheckpointKey{Path: "db.t.1.csv", Offset: 100},
+					Chunk: mydump.Chunk{PrevRowIDMax: 20, RowIDMax: 20},
+				},
+			}},
+		},
+	}
+	rowKey := func(rowID int64) []byte {
+		return tablecodec.EncodeRowKeyWithHandle(1, kv.IntHandle(rowID))
+	}
+	expectQuery := func() {
+		mock.ExpectQuery("SELECT index_name, key_data, row_data, raw_key, raw_handle FROM `lightning_task_info`\\.conflict_error_v1").
+			WithArgs(cfg.TaskID, "`db`.`t`").
+			WillReturnRows(sqlmock.NewRows([]string{"index_name", "key_data", "row_data", "raw_key", "raw_handle"}).
+				AddRow("uk", "5", "(5, 'a')", []byte("k1"), rowKey(5)).
+				AddRow("uk", "15", "(15, 'a')", []byte("k1"), rowKey(15)).
+				AddRow("uk", "25", "(25, 'b')", []byte("k2"), rowKey(25)))
+	}
+
+	_, err = ReadDuplicateReport(ctx, cfg, "db", "t")
+	require.True(t, errors.IsNotFound(err))
+
+	expectQuery()
+	require.NoError(t, rc.exportDuplicateReport(ctx, tr, cp))
+	report, err := ReadDuplicateReport(ctx, cfg, "db", "t")
+	require.NoError(t, err)
+	require.Equal(t, "table,index,key,key_data,row,source_path,source_offset\n"+
+		"`db`.`t`,uk,6b31,5,\"(5, 'a')\",db.t.1.csv,0\n"+
+		"`db`.`t`,uk,6b31,15,\"(15, 'a')\",db.t.1.csv,100\n"+
+		"`db`.`t`,uk,6b32,25,\"(25, 'b')\",,\n", string(report))
+
+	cfg.TikvImporter.DuplicateReportFormat = config.DuplicateReportJSON
+	expectQuery()
+	require.NoError(t, rc.exportDuplicateReport(ctx, tr, cp))
+	report, err = ReadDuplicateReport(ctx, cfg, "db", "t")
+	require.NoError(t, err)
+	require.Equal(t, `{"table":"`+"`db`.`t`"+`","index":"uk","key":"6b31","key_data":"5","row":"(5, 'a')","source_path":"db.t.1.csv"}
+{"table":"`+"`db`.`t`"+`","index":"uk","key":"6b31","key_data":"15","row":"(15, 'a')","source_path":"db.t.1.csv","source_offset":100}
+{"table":"`+"`db`.`t`"+`","index":"uk","key":"6b32","key_data":"25","row":"(25, 'b')"}
+`, string(report))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// the rows can't be located if the handle isn't the row ID.
+	tr.tableInfo.Core.PKIsHandle = true
+	require.Nil(t, newRowSourceLocator(tr.tableInfo.Core, cp))
+}
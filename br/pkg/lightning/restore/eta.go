@@ -0,0 +1,381 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb/br/pkg/lightning/checkpoints"
+	"github.com/pingcap/tidb/br/pkg/lightning/glue"
+	"github.com/pingcap/tidb/br/pkg/lightning/metric"
+)
+
+const (
+	// etaWindow is the sliding window in which the throughput of the phases
+	// is measured, so the estimation follows the recent speed of the import.
+	etaWindow = 5 * time.Minute
+	// etaSampleInterval is the interval of sampling the progress of the phases
+	// and reporting the estimated remaining time.
+	etaSampleInterval = 10 * time.Second
+)
+
+// etaPhase is a phase of importing a table. The progress of every phase is
+// measured in the bytes of the source data.
+type etaPhase int
+
+const (
+	etaPhaseEncode etaPhase = iota
+	etaPhaseSort
+	etaPhaseIngest
+	etaPhaseChecksum
+	etaPhaseCount
+)
+
+var etaPhaseNames = [etaPhaseCount]string{
+	metric.ThroughputPhaseEncode,
+	metric.ThroughputPhaseSort,
+	metric.ThroughputPhaseIngest,
+	metric.ThroughputPhaseChecksum,
+}
+
+func (p etaPhase) String() string {
+	return etaPhaseNames[p]
+}
+
+type etaPhaseBytes [etaPhaseCount]int64
+
+type etaSample struct {
+	at       time.Time
+	progress etaPhaseBytes
+}
+
+// etaThroughput measures the throughput of the phases from the samples of the
+// bytes processed in this run during the sliding window.
+type etaThroughput struct {
+	progressed etaPhaseBytes
+	samples    []etaSample
+}
+
+func (t *etaThroughput) sample(now time.Time, window time.Duration) {
+	t.samples = append(t.samples, etaSample{at: now, progress: t.progressed})
+	// keep the last sample before the window, so the samples always cover the
+	// whole window once the import has run long enough.
+	for len(t.samples) > 1 && !t.samples[1].at.After(now.Add(-window)) {
+		t.samples = t.samples[1:]
+	}
+}
+
+// rate returns the bytes processed per second in the phase, or 0 if unknown.
+func (t *etaThroughput) rate(phase etaPhase) float64 {
+	if len(t.samples) < 2 {
+		return 0
+	}
+	first, last := t.samples[0], t.samples[len(t.samples)-1]
+	seconds := last.at.Sub(first.at).Seconds()
+	if seconds <= 0 {
+		return 0
+	}
+	return float64(last.progress[phase]-first.progress[phase]) / seconds
+}
+
+type etaTable struct {
+	etaThroughput
+	total int64
+	done  etaPhaseBytes
+	// engines is the bytes credited to the sort and ingest phases when the
+	// engine is closed and imported.
+	engines map[int32]int64
+	// the size of a stream source is unknown until it's drained.
+	unknownSize bool
+}
+
+// isStarted returns whether any bytes of the table are processed in this run.
+func (t *etaTable) isStarted() bool {
+	return t.progressed != etaPhaseBytes{}
+}
+
+func (t *etaTable) remaining() etaPhaseBytes {
+	var remaining etaPhaseBytes
+	for phase := range remaining {
+		if t.done[phase] < t.total {
+			remaining[phase] = t.total - t.done[phase]
+		}
+	}
+	return remaining
+}
+
+// etaEstimator estimates the remaining time of importing the tables and the
+// whole task from the throughput of the encode, sort, ingest and checksum
+// phases. The methods are safe to call on a nil etaEstimator.
+type etaEstimator struct {
+	mu     sync.Mutex
+	window time.Duration
+	global etaThroughput
+	tables map[string]*etaTable
+}
+
+func newETAEstimator(window time.Duration) *etaEstimator {
+	return &etaEstimator{
+		window: window,
+		tables: make(map[string]*etaTable),
+	}
+}
+
+// registerTable registers the table to be imported. If the chunks of the table
+// aren't populated yet, `estimatedSize` is used as its size. Otherwise, the
+// size and the finished bytes of every phase are calculated from the
+// checkpoint, so the resumed table is estimated correctly.
+func (e *etaEstimator) registerTable(tableName string, cp *checkpoints.TableCheckpoint, estimatedSize int64) {
+	if e == nil {
+		return
+	}
+	t := &etaTable{total: estimatedSize}
+	if len(cp.Engines) > 0 {
+		t = newETATableFromCheckpoint(cp)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if old, ok := e.tables[tableName]; ok {
+		t.etaThroughput = old.etaThroughput
+	}
+	e.tables[tableName] = t
+}
+
+func newETATableFromCheckpoint(cp *checkpoints.TableCheckpoint) *etaTable {
+	t := &etaTable{engines: make(map[int32]int64, len(cp.Engines))}
+	dataEngines := int64(0)
+	for engineID, engine := range cp.Engines {
+		if engineID == indexEngineID {
+			continue
+		}
+		dataEngines++
+		var size, encoded int64
+		for _, chunk := range engine.Chunks {
+			if chunk.FileMeta.IsStream && engine.Status < checkpoints.CheckpointStatusAllWritten {
+				t.unknownSize = true
+			}
+			size += chunk.Chunk.EndOffset - chunk.Key.Offset
+			encoded += chunk.Chunk.Offset - chunk.Key.Offset
+		}
+		if engine.Status >= checkpoints.CheckpointStatusAllWritten {
+			encoded = size
+		}
+		t.total += size
+		t.done[etaPhaseEncode] += encoded
+		t.engines[engineID] = size
+	}
+
+	// the index engine is sorted and ingested after all the data engines, it's
+	// weighted as one more data engine of the average size.
+	for engineID, size := range t.engines {
+		t.engines[engineID] = size * dataEngines / (dataEngines + 1)
+	}
+	t.engines[indexEngineID] = t.total / (dataEngines + 1)
+
+	for engineID, engine := range cp.Engines {
+		if engine.Status >= checkpoints.CheckpointStatusClosed {
+			t.done[etaPhaseSort] += t.engines[engineID]
+		}
+		if engine.Status >= checkpoints.CheckpointStatusImported {
+			t.done[etaPhaseIngest] += t.engines[engineID]
+		}
+	}
+	if cp.Status >= checkpoints.CheckpointStatusChecksumSkipped {
+		t.done[etaPhaseChecksum] = t.total
+	}
+	return t
+}
+
+// advance records that `size` bytes of the table are processed in the phase.
+func (e *etaEstimator) advance(tableName string, phase etaPhase, size int64) {
+	if e == nil || size <= 0 {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	t, ok := e.tables[tableName]
+	if !ok {
+		return
+	}
+	t.done[phase] += size
+	t.progressed[phase] += size
+	e.global.progressed[phase] += size
+}
+
+// finishEngine records that the engine of the table is closed or imported.
+func (e *etaEstimator) finishEngine(tableName string, phase etaPhase, engineID int32) {
+	if e == nil {
+		return
+	}
+	e.mu.Lock()
+	size := int64(0)
+	if t, ok := e.tables[tableName]; ok {
+		size = t.engines[engineID]
+	}
+	e.mu.Unlock()
+	e.advance(tableName, phase, size)
+}
+
+// finishPhase records that the phase of the table is finished, e.g. the table
+// is checksummed.
+func (e *etaEstimator) finishPhase(tableName string, phase etaPhase) {
+	if e == nil {
+		return
+	}
+	e.mu.Lock()
+	size := int64(0)
+	if t, ok := e.tables[tableName]; ok {
+		size = t.remaining()[phase]
+	}
+	e.mu.Unlock()
+	e.advance(tableName, phase, size)
+}
+
+// removeTable removes the table which is finished or failed from the
+// estimation.
+func (e *etaEstimator) removeTable(tableName string) {
+	if e == nil {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.tables, tableName)
+}
+
+// sample samples the progress of the phases of the whole task and the tables.
+func (e *etaEstimator) sample(now time.Time) {
+	if e == nil {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.global.sample(now, e.window)
+	for _, t := range e.tables {
+		if t.isStarted() {
+			t.sample(now, e.window)
+		}
+	}
+}
+
+// throughput returns the bytes processed per second of every phase of the
+// whole task.
+func (e *etaEstimator) throughput() [etaPhaseCount]float64 {
+	var rates [etaPhaseCount]float64
+	if e == nil {
+		return rates
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for phase := range rates {
+		rates[phase] = e.global.rate(etaPhase(phase))
+	}
+	return rates
+}
+
+// estimate returns the estimated remaining time of the whole task and every
+// table. The remaining time of the whole task is absent if it can't be
+// estimated yet, and so are the tables.
+func (e *etaEstimator) estimate() (time.Duration, bool, map[string]time.Duration) {
+	if e == nil {
+		return 0, false, nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var totalRemaining etaPhaseBytes
+	tables := make(map[string]time.Duration, len(e.tables))
+	for name, t := range e.tables {
+		if t.unknownSize {
+			continue
+		}
+		remaining := t.remaining()
+		for phase := range remaining {
+			totalRemaining[phase] += remaining[phase]
+		}
+		if !t.isStarted() {
+			continue
+		}
+		d, ok := estimateRemainingTime(remaining, func(phase etaPhase) float64 {
+			if rate := t.rate(phase); rate > 0 {
+				return rate
+			}
+			return e.global.rate(phase)
+		})
+		if ok {
+			tables[name] = d
+		}
+	}
+	d, ok := estimateRemainingTime(totalRemaining, e.global.rate)
+	return d, ok, tables
+}
+
+// estimateRemainingTime estimates the time of processing the remaining bytes
+// of every phase. The encode, sort and ingest phases are pipelined, so the
+// slowest one of them determines the time, while the checksum is done after
+// all of them. The phases without a measured throughput are ignored, and the
+// time can't be estimated if none of the unfinished phases are measured.
+func estimateRemainingTime(remaining etaPhaseBytes, rate func(etaPhase) float64) (time.Duration, bool) {
+	var pipelined, checksum float64
+	unfinished, measured := false, false
+	for phase := etaPhase(0); phase < etaPhaseCount; phase++ {
+		if remaining[phase] <= 0 {
+			continue
+		}
+		unfinished = true
+		r := rate(phase)
+		if r <= 0 {
+			continue
+		}
+		measured = true
+		seconds := float64(remaining[phase]) / r
+		if phase == etaPhaseChecksum {
+			checksum = seconds
+		} else {
+			pipelined = math.Max(pipelined, seconds)
+		}
+	}
+	if unfinished && !measured {
+		return 0, false
+	}
+	return time.Duration((pipelined + checksum) * float64(time.Second)).Round(time.Second), true
+}
+
+// reportETA samples the progress of the import, and reports the throughput
+// and the estimated remaining time to the metrics and the TiDB glue.
+func (rc *Controller) reportETA(ctx context.Context, now time.Time) {
+	rc.eta.sample(now)
+	remaining, ok, tables := rc.eta.estimate()
+
+	if m, exist := metric.FromContext(ctx); exist {
+		for phase, rate := range rc.eta.throughput() {
+			m.ThroughputGauge.WithLabelValues(etaPhase(phase).String()).Set(rate)
+		}
+		// the finished tables are removed from the gauge.
+		m.EstimatedRemainingSecondsGauge.Reset()
+		for name, d := range tables {
+			m.EstimatedRemainingSecondsGauge.WithLabelValues(name).Set(d.Seconds())
+		}
+		if ok {
+			m.EstimatedRemainingSecondsGauge.WithLabelValues(metric.EstimatedRemainingAll).Set(remaining.Seconds())
+		}
+	}
+	if ok {
+		rc.tidbGlue.Record(glue.RecordEstimatedFinishTime, uint64(now.Add(remaining).Unix()))
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/br/pkg/lightning/checkpoints"
+	"github.com/pingcap/tidb/br/pkg/lightning/mydump"
+	"github.com/stretchr/testify/require"
+)
+
+func newETATestChunk(start, offset, end int64) *checkpoints.ChunkCheckpoint {
+	return &checkpoints.ChunkCheckpoint{
+		Key:   checkpoints.ChunkCheckpointKey{Path: "a.csv", Offset: start},
+		Chunk: mydump.Chunk{Offset: offset, EndOffset: end},
+	}
+}
+
+func TestNewETATableFromCheckpoint(t *testing.T) {
+	cp := &checkpoints.TableCheckpoint{
+		Status: checkpoints.CheckpointStatusLoaded,
+		Engines: map[int32]*checkpoints.EngineCheckpoint{
+			indexEngineID: {Status: checkpoints.CheckpointStatusLoaded},
+			0: {
+				Status: checkpoints.CheckpointStatusImported,
+				Chunks: []*checkpoints.ChunkCheckpoint{newETATestChunk(0, 0, 300)},
+			},
+			1: {
+				Status: checkpoints.CheckpointStatusLoaded,
+				Chunks: []*checkpoints.ChunkCheckpoint{newETATestChunk(0, 100, 300), newETATestChunk(300, 300, 600)},
+			},
+		},
+	}
+	table := newETATableFromCheckpoint(cp)
+	require.Equal(t, int64(900), table.total)
+	require.Equal(t, map[int32]int64{indexEngineID: 300, 0: 200, 1: 400}, table.engines)
+	require.Equal(t, etaPhaseBytes{400, 200, 200, 0}, table.done)
+	require.False(t, table.unknownSize)
+
+	cp.Status = checkpoints.CheckpointStatusAnalyzed
+	for _, engine := range cp.Engines {
+		engine.Status = checkpoints.CheckpointStatusImported
+	}
+	table = newETATableFromCheckpoint(cp)
+	require.Equal(t, etaPhaseBytes{900, 900, 900, 900}, table.done)
+	require.Equal(t, etaPhaseBytes{}, table.remaining())
+
+	stream := newETATestChunk(0, 0, 0)
+	stream.FileMeta.IsStream = true
+	cp.Engines[1] = &checkpoints.EngineCheckpoint{
+		Status: checkpoints.CheckpointStatusLoaded,
+		Chunks: []*checkpoints.ChunkCheckpoint{stream},
+	}
+	require.True(t, newETATableFromCheckpoint(cp).unknownSize)
+}
+
+func TestETAEstimator(t *testing.T) {
+	e := newETAEstimator(time.Minute)
+	cp := &checkpoints.TableCheckpoint{
+		Engines: map[int32]*checkpoints.EngineCheckpoint{
+			indexEngineID: {Status: checkpoints.CheckpointStatusLoaded},
+			0: {
+				Status: checkpoints.CheckpointStatusLoaded,
+				Chunks: []*checkpoints.ChunkCheckpoint{newETATestChunk(0, 0, 1000)},
+			},
+		},
+	}
+	e.registerTable("`db`.`t1`", cp, 0)
+	e.registerTable("`db`.`t2`", &checkpoints.TableCheckpoint{}, 1000)
+
+	// nothing is measured yet.
+	_, ok, tables := e.estimate()
+	require.False(t, ok)
+	require.Len(t, tables, 0)
+
+	now := time.Unix(1000, 0)
+	e.advance("`db`.`t1`", etaPhaseEncode, 100)
+	e.sample(now)
+	e.advance("`db`.`t1`", etaPhaseEncode, 100)
+	e.sample(now.Add(10 * time.Second))
+
+	// the encode phase runs at 10 bytes per second, the other phases aren't
+	// measured yet.
+	require.Equal(t, [etaPhaseCount]float64{10, 0, 0, 0}, e.throughput())
+	remaining, ok, tables := e.estimate()
+	require.True(t, ok)
+	require.Equal(t, 180*time.Second, remaining)
+	require.Equal(t, map[string]time.Duration{"`db`.`t1`": 80 * time.Second}, tables)
+
+	// the engine is closed and imported, the sort and ingest phases are slower
+	// than the encode phase.
+	e.advance("`db`.`t1`", etaPhaseEncode, 800)
+	e.finishEngine("`db`.`t1`", etaPhaseSort, 0)
+	e.finishEngine("`db`.`t1`", etaPhaseIngest, 0)
+	e.sample(now.Add(110 * time.Second))
+	// the samples out of the window are dropped.
+	require.Len(t, e.global.samples, 2)
+	require.Equal(t, [etaPhaseCount]float64{8, 5, 5, 0}, e.throughput())
+	remaining, ok, tables = e.estimate()
+	require.True(t, ok)
+	require.Equal(t, 300*time.Second, remaining)
+	require.Equal(t, map[string]time.Duration{"`db`.`t1`": 100 * time.Second}, tables)
+
+	e.finishEngine("`db`.`t1`", etaPhaseSort, indexEngineID)
+	e.finishEngine("`db`.`t1`", etaPhaseIngest, indexEngineID)
+	e.finishPhase("`db`.`t1`", etaPhaseChecksum)
+	e.sample(now.Add(120 * time.Second))
+	e.removeTable("`db`.`t1`")
+	_, ok, tables = e.estimate()
+	require.True(t, ok)
+	require.Len(t, tables, 0)
+
+	// the methods are no-op on a nil estimator.
+	var nilEstimator *etaEstimator
+	nilEstimator.registerTable("`db`.`t1`", cp, 0)
+	nilEstimator.advance("`db`.`t1`", etaPhaseEncode, 100)
+	nilEstimator.sample(now)
+	_, ok, _ = nilEstimator.estimate()
+	require.False(t, ok)
+}
+
+func TestEstimateRemainingTime(t *testing.T) {
+	rates := [etaPhaseCount]float64{100, 50, 200, 0}
+	rate := func(phase etaPhase) float64 { return rates[phase] }
+
+	d, ok := estimateRemainingTime(etaPhaseBytes{}, rate)
+	require.True(t, ok)
+	require.Equal(t, time.Duration(0), d)
+
+	// the slowest pipelined phase determines the time.
+	d, ok = estimateRemainingTime(etaPhaseBytes{1000, 1000, 1000, 0}, rate)
+	require.True(t, ok)
+	require.Equal(t, 20*time.Second, d)
+
+	// the checksum isn't measured yet, so it's ignored.
+	d, ok = estimateRemainingTime(etaPhaseBytes{1000, 1000, 1000, 1000}, rate)
+	require.True(t, ok)
+	require.Equal(t, 20*time.Second, d)
+
+	rates[etaPhaseChecksum] = 100
+	d, ok = estimateRemainingTime(etaPhaseBytes{1000, 1000, 1000, 1000}, rate)
+	require.True(t, ok)
+	require.Equal(t, 30*time.Second, d)
+
+	// none of the unfinished phases are measured.
+	rates = [etaPhaseCount]float64{}
+	_, ok = estimateRemainingTime(etaPhaseBytes{1000, 1000, 1000, 1000}, rate)
+	require.False(t, ok)
+}
@@ -61,6 +61,9 @@ type EstimateSourceDataSizeResult struct {
 	SizeWithoutIndex int64
 	// HasUnsortedBigTables indicates whether the source data has unsorted big tables or not.
 	HasUnsortedBigTables bool
+	// TiFlashSize is the size replicated to TiFlash, i.e. the size without the index of
+	// the tables with TiFlash replicas multiplied by their TiFlash replica counts.
+	TiFlashSize int64
 }
 
 // PreRestoreInfoGetter defines the operations to get information from sources and target.
@@ -98,6 +101,10 @@ type TargetInfoGetter interface {
 	GetStorageInfo(ctx context.Context) (*pdtypes.StoresInfo, error)
 	// GetEmptyRegionsInfo gets the region information of all the empty regions on the target.
 	GetEmptyRegionsInfo(ctx context.Context) (*pdtypes.RegionsInfo, error)
+	// FetchCurrentUserGrants fetches the grants of the user connecting to the target.
+	FetchCurrentUserGrants(ctx context.Context) ([]string, error)
+	// IsSecurityEnhancedModeEnabled checks whether the security enhanced mode (SEM) is enabled on the target.
+	IsSecurityEnhancedModeEnabled(ctx context.Context) (bool, error)
 }
 
 type preInfoGetterKey string
@@ -268,6 +275,64 @@ func (g *TargetInfoGetterImpl) GetEmptyRegionsInfo(ctx context.Context) (*pdtype
 	return result, nil
 }
 
+// FetchCurrentUserGrants fetches the grants of the user connecting to the target.
+// It implements the TargetInfoGetter interface.
+// The grants contain the privileges of the active roles of the user.
+func (g *TargetInfoGetterImpl) FetchCurrentUserGrants(ctx context.Context) ([]string, error) {
+	db, err := g.targetDBGlue.GetDB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	exec := common.SQLWithRetry{
+		DB:     db,
+		Logger: log.FromContext(ctx),
+	}
+	var grants []string
+	err = exec.Transact(ctx, "fetch current user grants", func(c context.Context, tx *sql.Tx) error {
+		grants = grants[:0]
+		rows, e := tx.QueryContext(c, "SHOW GRANTS")
+		if e != nil {
+			return e
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var grant string
+			if e := rows.Scan(&grant); e != nil {
+				return e
+			}
+			grants = append(grants, grant)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return grants, nil
+}
+
+// IsSecurityEnhancedModeEnabled checks whether the security enhanced mode (SEM) is enabled on the target.
+// It implements the TargetInfoGetter interface.
+// The targets which don't have the SEM variable are treated as SEM disabled.
+func (g *TargetInfoGetterImpl) IsSecurityEnhancedModeEnabled(ctx context.Context) (bool, error) {
+	db, err := g.targetDBGlue.GetDB()
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	exec := common.SQLWithRetry{
+		DB:     db,
+		Logger: log.FromContext(ctx),
+	}
+	var enabled string
+	err = exec.QueryRow(ctx, "check security enhanced mode", "SELECT @@tidb_enable_enhanced_security", &enabled)
+	if err != nil {
+		if mysqlErr, ok := errors.Cause(err).(*mysql_sql_driver.MySQLError); ok && mysqlErr.Number == errno.ErrUnknownSystemVariable {
+			return false, nil
+		}
+		return false, errors.Trace(err)
+	}
+	return strings.EqualFold(enabled, "ON") || enabled == "1", nil
+}
+
 // PreRestoreInfoGetterImpl implements the operations to get information used in importing preparation.
 type PreRestoreInfoGetterImpl struct {
 	cfg              *config.Config
@@ -454,6 +519,10 @@ func (p *PreRestoreInfoGetterImpl) ReadFirstNRowsByTableName(ctx context.Context
 // ReadFirstNRowsByFileMeta reads the first N rows of an data file.
 // It implements the PreRestoreInfoGetter interface.
 func (p *PreRestoreInfoGetterImpl) ReadFirstNRowsByFileMeta(ctx context.Context, dataFileMeta mydump.SourceFileMeta, n int) ([]string, [][]types.Datum, error) {
+	// the stream source can be read only once, which is left for the import.
+	if dataFileMeta.IsStream {
+		return nil, [][]types.Datum{}, nil
+	}
 	var (
 		reader storage.ReadSeekCloser
 		err    error
@@ -461,7 +530,9 @@ func (p *PreRestoreInfoGetterImpl) ReadFirstNRowsByFileMeta(ctx context.Context,
 	if dataFileMeta.Type == mydump.SourceTypeParquet {
 		reader, err = mydump.OpenParquetReader(ctx, p.srcStorage, dataFileMeta.Path, dataFileMeta.FileSize)
 	} else {
-		reader, err = p.srcStorage.Open(ctx, dataFileMeta.Path)
+		reader, err = mydump.OpenReader(ctx, &dataFileMeta, p.srcStorage, storage.DecompressConfig{
+			ZStdDecodeConcurrency: p.cfg.Mydumper.DecompressConcurrency,
+		})
 	}
 	if err != nil {
 		return nil, nil, errors.Trace(err)
@@ -473,7 +544,7 @@ func (p *PreRestoreInfoGetterImpl) ReadFirstNRowsByFileMeta(ctx context.Context,
 	case mydump.SourceTypeCSV:
 		hasHeader := p.cfg.Mydumper.CSV.Header
 		// Create a utf8mb4 convertor to encode and decode data with the charset of CSV files.
-		charsetConvertor, err := mydump.NewCharsetConvertor(p.cfg.Mydumper.DataCharacterSet, p.cfg.Mydumper.DataInvalidCharReplace)
+		charsetConvertor, err := mydump.NewFileCharsetConvertor(&p.cfg.Mydumper, dataFileMeta)
 		if err != nil {
 			return nil, nil, errors.Trace(err)
 		}
@@ -482,12 +553,22 @@ func (p *PreRestoreInfoGetterImpl) ReadFirstNRowsByFileMeta(ctx context.Context,
 			return nil, nil, errors.Trace(err)
 		}
 	case mydump.SourceTypeSQL:
-		parser = mydump.NewChunkParser(ctx, p.cfg.TiDB.SQLMode, reader, blockBufSize, p.ioWorkers)
+		// Create a utf8mb4 convertor to decode the strings with the charset of SQL files.
+		charsetConvertor, err := mydump.NewFileCharsetConvertor(&p.cfg.Mydumper, dataFileMeta)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		parser = mydump.NewSQLParser(ctx, &p.cfg.Mydumper, p.cfg.TiDB.SQLMode, dataFileMeta.Path, reader, blockBufSize, p.ioWorkers, charsetConvertor)
 	case mydump.SourceTypeParquet:
 		parser, err = mydump.NewParquetParser(ctx, p.srcStorage, reader, dataFileMeta.Path)
 		if err != nil {
 			return nil, nil, errors.Trace(err)
 		}
+	case mydump.SourceTypeAvro:
+		parser, err = mydump.NewAvroParser(ctx, &p.cfg.Mydumper.Avro, reader)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
 	default:
 		panic(fmt.Sprintf("unknown file type '%s'", dataFileMeta.Type))
 	}
@@ -523,6 +604,7 @@ func (p *PreRestoreInfoGetterImpl) EstimateSourceDataSize(ctx context.Context) (
 	}
 	sizeWithIndex := int64(0)
 	sourceTotalSize := int64(0)
+	tiflashSize := int64(0)
 	tableCount := 0
 	unSortedBigTableCount := 0
 	errMgr := errormanager.New(nil, p.cfg, log.FromContext(ctx))
@@ -540,6 +622,9 @@ func (p *PreRestoreInfoGetterImpl) EstimateSourceDataSize(ctx context.Context) (
 			sourceTotalSize += tbl.TotalSize
 			tableInfo, ok := info.Tables[tbl.Name]
 			if ok {
+				if replica := tableInfo.Core.TiFlashReplica; replica != nil && replica.Count > 0 {
+					tiflashSize += tbl.TotalSize * int64(replica.Count)
+				}
 				// Do not sample small table because there may a large number of small table and it will take a long
 				// time to sample data for all of them.
 				if isTiDBBackend(p.cfg) || tbl.TotalSize < int64(config.SplitRegionSize) {
@@ -574,6 +659,7 @@ func (p *PreRestoreInfoGetterImpl) EstimateSourceDataSize(ctx context.Context) (
 		SizeWithIndex:        sizeWithIndex,
 		SizeWithoutIndex:     sourceTotalSize,
 		HasUnsortedBigTables: (unSortedBigTableCount > 0),
+		TiFlashSize:          tiflashSize,
 	}
 	return result, nil
 }
@@ -592,7 +678,7 @@ func (p *PreRestoreInfoGetterImpl) sampleDataFromTable(
 ) (float64, bool, error) {
 	resultIndexRatio := 1.0
 	isRowOrdered := false
-	if len(tableMeta.DataFiles) == 0 {
+	if len(tableMeta.DataFiles) == 0 || tableMeta.DataFiles[0].FileMeta.IsStream {
 		return resultIndexRatio, isRowOrdered, nil
 	}
 	sampleFile := tableMeta.DataFiles[0].FileMeta
@@ -601,7 +687,9 @@ func (p *PreRestoreInfoGetterImpl) sampleDataFromTable(
 	if sampleFile.Type == mydump.SourceTypeParquet {
 		reader, err = mydump.OpenParquetReader(ctx, p.srcStorage, sampleFile.Path, sampleFile.FileSize)
 	} else {
-		reader, err = p.srcStorage.Open(ctx, sampleFile.Path)
+		reader, err = mydump.OpenReader(ctx, &sampleFile, p.srcStorage, storage.DecompressConfig{
+			ZStdDecodeConcurrency: p.cfg.Mydumper.DecompressConcurrency,
+		})
 	}
 	if err != nil {
 		return 0.0, false, errors.Trace(err)
@@ -627,7 +715,7 @@ func (p *PreRestoreInfoGetterImpl) sampleDataFromTable(
 	case mydump.SourceTypeCSV:
 		hasHeader := p.cfg.Mydumper.CSV.Header
 		// Create a utf8mb4 convertor to encode and decode data with the charset of CSV files.
-		charsetConvertor, err := mydump.NewCharsetConvertor(p.cfg.Mydumper.DataCharacterSet, p.cfg.Mydumper.DataInvalidCharReplace)
+		charsetConvertor, err := mydump.NewFileCharsetConvertor(&p.cfg.Mydumper, sampleFile)
 		if err != nil {
 			return 0.0, false, errors.Trace(err)
 		}
@@ -636,12 +724,22 @@ func (p *PreRestoreInfoGetterImpl) sampleDataFromTable(
 			return 0.0, false, errors.Trace(err)
 		}
 	case mydump.SourceTypeSQL:
-		parser = mydump.NewChunkParser(ctx, p.cfg.TiDB.SQLMode, reader, blockBufSize, p.ioWorkers)
+		// Create a utf8mb4 convertor to decode the strings with the charset of SQL files.
+		charsetConvertor, err := mydump.NewFileCharsetConvertor(&p.cfg.Mydumper, sampleFile)
+		if err != nil {
+			return 0.0, false, errors.Trace(err)
+		}
+		parser = mydump.NewSQLParser(ctx, &p.cfg.Mydumper, p.cfg.TiDB.SQLMode, sampleFile.Path, reader, blockBufSize, p.ioWorkers, charsetConvertor)
 	case mydump.SourceTypeParquet:
 		parser, err = mydump.NewParquetParser(ctx, p.srcStorage, reader, sampleFile.Path)
 		if err != nil {
 			return 0.0, false, errors.Trace(err)
 		}
+	case mydump.SourceTypeAvro:
+		parser, err = mydump.NewAvroParser(ctx, &p.cfg.Mydumper.Avro, reader)
+		if err != nil {
+			return 0.0, false, errors.Trace(err)
+		}
 	default:
 		panic(fmt.Sprintf("file '%s' with unknown source type '%s'", sampleFile.Path, sampleFile.Type.String()))
 	}
@@ -774,6 +872,18 @@ func (p *PreRestoreInfoGetterImpl) CheckVersionRequirements(ctx context.Context)
 	return p.targetInfoGetter.CheckVersionRequirements(ctx)
 }
 
+// FetchCurrentUserGrants fetches the grants of the user connecting to the target.
+// It implements the PreRestoreInfoGetter interface.
+func (p *PreRestoreInfoGetterImpl) FetchCurrentUserGrants(ctx context.Context) ([]string, error) {
+	return p.targetInfoGetter.FetchCurrentUserGrants(ctx)
+}
+
+// IsSecurityEnhancedModeEnabled checks whether the security enhanced mode (SEM) is enabled on the target.
+// It implements the PreRestoreInfoGetter interface.
+func (p *PreRestoreInfoGetterImpl) IsSecurityEnhancedModeEnabled(ctx context.Context) (bool, error) {
+	return p.targetInfoGetter.IsSecurityEnhancedModeEnabled(ctx)
+}
+
 // GetTargetSysVariablesForImport gets some important systam variables for importing on the target.
 // It implements the PreRestoreInfoGetter interface.
 // It has caching mechanism.
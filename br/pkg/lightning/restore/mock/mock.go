@@ -159,6 +159,8 @@ type StorageInfo struct {
 	UsedSize      uint64
 	AvailableSize uint64
 	RegionCount   int
+	// IsTiFlash indicates whether the store is a TiFlash store.
+	IsTiFlash bool
 }
 
 // MockTableInfo defines a mock table structure information for a mock target.
@@ -172,8 +174,12 @@ type MockTargetInfo struct {
 	MaxReplicasPerRegion int
 	EmptyRegionCountMap  map[uint64]int
 	StorageInfos         []StorageInfo
-	sysVarMap            map[string]string
-	dbTblInfoMap         map[string]map[string]*MockTableInfo
+	// CurrentUserGrants is the result of `SHOW GRANTS` on the mock target.
+	CurrentUserGrants []string
+	// SEMEnabled indicates whether the security enhanced mode is enabled on the mock target.
+	SEMEnabled   bool
+	sysVarMap    map[string]string
+	dbTblInfoMap map[string]map[string]*MockTableInfo
 }
 
 // NewMockTargetInfo creates a MockTargetInfo object.
@@ -240,10 +246,15 @@ func (t *MockTargetInfo) GetReplicationConfig(ctx context.Context) (*pdtypes.Rep
 func (t *MockTargetInfo) GetStorageInfo(ctx context.Context) (*pdtypes.StoresInfo, error) {
 	resultStoreInfos := make([]*pdtypes.StoreInfo, len(t.StorageInfos))
 	for i, storeInfo := range t.StorageInfos {
+		var labels []*metapb.StoreLabel
+		if storeInfo.IsTiFlash {
+			labels = append(labels, &metapb.StoreLabel{Key: "engine", Value: "tiflash"})
+		}
 		resultStoreInfos[i] = &pdtypes.StoreInfo{
 			Store: &pdtypes.MetaStore{
 				Store: &metapb.Store{
-					Id: uint64(i + 1),
+					Id:     uint64(i + 1),
+					Labels: labels,
 				},
 				StateName: "Up",
 			},
@@ -311,3 +322,15 @@ func (t *MockTargetInfo) IsTableEmpty(ctx context.Context, schemaName string, ta
 func (t *MockTargetInfo) CheckVersionRequirements(ctx context.Context) error {
 	return nil
 }
+
+// FetchCurrentUserGrants fetches the grants of the user connecting to the target.
+// It implements the TargetInfoGetter interface.
+func (t *MockTargetInfo) FetchCurrentUserGrants(ctx context.Context) ([]string, error) {
+	return append([]string{}, t.CurrentUserGrants...), nil
+}
+
+// IsSecurityEnhancedModeEnabled checks whether the security enhanced mode (SEM) is enabled on the target.
+// It implements the TargetInfoGetter interface.
+func (t *MockTargetInfo) IsSecurityEnhancedModeEnabled(ctx context.Context) (bool, error) {
+	return t.SEMEnabled, nil
+}
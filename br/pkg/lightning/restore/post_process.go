@@ -0,0 +1,79 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/tidb/br/pkg/lightning/checkpoints"
+	"github.com/pingcap/tidb/br/pkg/lightning/common"
+	"github.com/pingcap/tidb/br/pkg/lightning/log"
+	"go.uber.org/zap"
+)
+
+// maxPostProcessBackoff is the max backoff between the retries of the checksum
+// and analyze of a table.
+const maxPostProcessBackoff = time.Minute
+
+// retryPostProcess runs the checksum or analyze of a table, and retries it up
+// to `post-restore.max-retry` times with an exponential backoff if it fails.
+func (rc *Controller) retryPostProcess(ctx context.Context, logger log.Logger, purpose string, action func() error) error {
+	backoff := rc.cfg.PostRestore.RetryBackoff.Duration
+	for i := 0; ; i++ {
+		err := action()
+		if err == nil || i >= rc.cfg.PostRestore.MaxRetry || common.IsContextCanceledError(err) {
+			return err
+		}
+		logger.Warn(purpose+" failed, will retry later",
+			zap.Int("retryCnt", i+1), zap.Duration("backoff", backoff), log.ShortError(err))
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > maxPostProcessBackoff {
+			backoff = maxPostProcessBackoff
+		}
+	}
+}
+
+// analyzeInBackground analyzes the table in background with a worker of the
+// checksum pool shared by all tables. The analyze is best effort, the failure
+// is only logged and the table is marked as analyze skipped.
+func (rc *Controller) analyzeInBackground(ctx context.Context, tr *TableRestore) {
+	rc.analyzeWg.Add(1)
+	go func() {
+		defer rc.analyzeWg.Done()
+		w := rc.checksumWorks.Apply()
+		defer rc.checksumWorks.Recycle(w)
+
+		err := rc.retryPostProcess(ctx, tr.logger, "analyze table", func() error {
+			return tr.analyzeTable(ctx, rc.tidbGlue.GetSQLExecutor())
+		})
+		status := checkpoints.CheckpointStatusAnalyzed
+		switch {
+		case common.IsContextCanceledError(err):
+			return
+		case err != nil:
+			tr.logger.Warn("analyze table in background failed, will skip this error and go on", log.ShortError(err))
+			status = checkpoints.CheckpointStatusAnalyzeSkipped
+		}
+		if err := rc.saveStatusCheckpoint(ctx, tr.tableName, checkpoints.WholeTableEngineID, nil, status); err != nil {
+			tr.logger.Warn("save the checkpoint of the table analyzed in background failed", log.ShortError(err))
+		}
+	}()
+}
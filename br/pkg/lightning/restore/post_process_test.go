@@ -0,0 +1,121 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/checkpoints"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/lightning/glue"
+	"github.com/pingcap/tidb/br/pkg/lightning/log"
+	"github.com/pingcap/tidb/br/pkg/lightning/worker"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPostProcess(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.NewConfig()
+	cfg.PostRestore.MaxRetry = 2
+	cfg.PostRestore.RetryBackoff.Duration = time.Millisecond
+	rc := &Controller{cfg: cfg}
+
+	calls := 0
+	err := rc.retryPostProcess(ctx, log.L(), "checksum", func() error {
+		calls++
+		if calls < 3 {
+			return errors.New("mock error")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+
+	calls = 0
+	err = rc.retryPostProcess(ctx, log.L(), "checksum", func() error {
+		calls++
+		return errors.New("mock error")
+	})
+	require.EqualError(t, err, "mock error")
+	require.Equal(t, 3, calls)
+
+	// the canceled action isn't retried.
+	calls = 0
+	err = rc.retryPostProcess(ctx, log.L(), "checksum", func() error {
+		calls++
+		return context.Canceled
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, calls)
+}
+
+func TestAnalyzeInBackground(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, mock.ExpectationsWereMet())
+	}()
+	mock.ExpectExec("ANALYZE TABLE `db`\\.`table`").
+		WillReturnError(errors.New("mock error"))
+	mock.ExpectExec("ANALYZE TABLE `db`\\.`table`").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("ANALYZE TABLE `db`\\.`table2`").
+		WillReturnError(errors.New("mock error"))
+	mock.ExpectExec("ANALYZE TABLE `db`\\.`table2`").
+		WillReturnError(errors.New("mock error"))
+	mock.ExpectClose()
+
+	ctx := context.Background()
+	defaultSQLMode, err := mysql.GetSQLMode(mysql.DefaultSQLMode)
+	require.NoError(t, err)
+	cfg := config.NewConfig()
+	cfg.PostRestore.MaxRetry = 1
+	cfg.PostRestore.RetryBackoff.Duration = time.Millisecond
+	saveCpCh := make(chan saveCp)
+	rc := &Controller{
+		cfg:           cfg,
+		tidbGlue:      glue.NewExternalTiDBGlue(db, defaultSQLMode),
+		checksumWorks: worker.NewPool(ctx, 1, "checksum"),
+		saveCpCh:      saveCpCh,
+	}
+	statuses := make(map[string]checkpoints.CheckpointStatus)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for scp := range saveCpCh {
+			statuses[scp.tableName] = scp.merger.(*checkpoints.StatusCheckpointMerger).Status
+			scp.waitCh <- nil
+		}
+	}()
+
+	rc.analyzeInBackground(ctx, &TableRestore{tableName: "`db`.`table`", logger: log.L()})
+	rc.analyzeWg.Wait()
+	// the failed analyze is skipped.
+	rc.analyzeInBackground(ctx, &TableRestore{tableName: "`db`.`table2`", logger: log.L()})
+	rc.analyzeWg.Wait()
+	close(saveCpCh)
+	<-done
+
+	require.Equal(t, map[string]checkpoints.CheckpointStatus{
+		"`db`.`table`":  checkpoints.CheckpointStatusAnalyzed,
+		"`db`.`table2`": checkpoints.CheckpointStatusAnalyzeSkipped,
+	}, statuses)
+}
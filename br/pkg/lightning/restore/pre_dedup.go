@@ -0,0 +1,434 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"hash/fnv"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/backend/kv"
+	"github.com/pingcap/tidb/br/pkg/lightning/checkpoints"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/lightning/log"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/table/tables"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/codec"
+	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
+	"golang.org/x/sync/errgroup"
+)
+
+// preDedupFlushSize is the size of the buffered keys of a partition flushed to
+// the partition file at once.
+const preDedupFlushSize = 64 * 1024
+
+// preDedupResult is the result of the pre-deduplication of a table, which maps
+// the chunks to the sorted end offsets of their dropped rows.
+type preDedupResult struct {
+	dropped map[checkpoints.ChunkCheckpointKey][]int64
+}
+
+// droppedRows returns the sorted end offsets of the dropped rows of the chunk.
+func (r *preDedupResult) droppedRows(key checkpoints.ChunkCheckpointKey) []int64 {
+	if r == nil {
+		return nil
+	}
+	return r.dropped[key]
+}
+
+// preDedupRowPos is the position of a source row. The rows are ordered by
+// their chunks, then by their offsets in the chunks.
+type preDedupRowPos struct {
+	chunk  int
+	offset int64
+}
+
+func (p preDedupRowPos) before(other preDedupRowPos) bool {
+	if p.chunk != other.chunk {
+		return p.chunk < other.chunk
+	}
+	return p.offset < other.offset
+}
+
+// preDeduplicate finds the source rows of the table sharing the same primary
+// key before they're encoded, and records the rows to drop according to
+// `mydumper.pre-dedup`. The rows are identified by their chunks and their end
+// offsets, which stay the same when the chunks are resumed from checkpoints.
+//
+// The primary keys are hashed into `mydumper.pre-dedup-partitions` partitions,
+// which are spilled to disk while the chunks are scanned, then the partitions
+// are deduplicated one by one in memory.
+func (tr *TableRestore) preDeduplicate(ctx context.Context, rc *Controller, cp *checkpoints.TableCheckpoint) error {
+	mode := rc.cfg.Mydumper.PreDedup
+	if mode != config.PreDedupDrop && mode != config.PreDedupLastWins {
+		return nil
+	}
+	pk := preDedupKeyIndex(tr.tableInfo.Core)
+	if pk == nil {
+		tr.logger.Warn("skip the pre-deduplication since the table has no primary key")
+		return nil
+	}
+
+	engineIDs := make([]int32, 0, len(cp.Engines))
+	for engineID := range cp.Engines {
+		if engineID != indexEngineID {
+			engineIDs = append(engineIDs, engineID)
+		}
+	}
+	slices.Sort(engineIDs)
+	var chunks []*checkpoints.ChunkCheckpoint
+	for _, engineID := range engineIDs {
+		for _, chunk := range cp.Engines[engineID].Chunks {
+			if chunk.FileMeta.IsStream {
+				tr.logger.Warn("skip the pre-deduplication since the stream source can be read only once")
+				return nil
+			}
+			chunks = append(chunks, chunk)
+		}
+	}
+	if len(chunks) == 0 {
+		return nil
+	}
+
+	tmpDir, err := os.MkdirTemp(rc.cfg.Mydumper.PreDedupDir, "lightning-pre-dedup-")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(tmpDir); err != nil {
+			tr.logger.Warn("failed to remove the pre-deduplication files", zap.String("dir", tmpDir), log.ShortError(err))
+		}
+	}()
+
+	task := tr.logger.Begin(zap.InfoLevel, "pre-deduplicate rows")
+	spill, err := newPreDedupSpill(tmpDir, rc.cfg.Mydumper.PreDedupPartitions)
+	if err != nil {
+		task.End(zap.ErrorLevel, err)
+		return errors.Trace(err)
+	}
+	defer spill.close()
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	concurrency := rc.cfg.App.RegionConcurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	eg.SetLimit(concurrency)
+	for i, chunk := range chunks {
+		i, chunk := i, chunk
+		eg.Go(func() error {
+			return tr.scanChunkForPreDedup(egCtx, rc, pk, i, chunk, spill)
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		task.End(zap.ErrorLevel, err)
+		return errors.Trace(err)
+	}
+
+	dropped, err := spill.resolve(mode == config.PreDedupLastWins)
+	if err != nil {
+		task.End(zap.ErrorLevel, err)
+		return errors.Trace(err)
+	}
+	result := &preDedupResult{dropped: make(map[checkpoints.ChunkCheckpointKey][]int64, len(dropped))}
+	droppedCount := 0
+	for i, offsets := range dropped {
+		slices.Sort(offsets)
+		result.dropped[chunks[i].Key] = offsets
+		droppedCount += len(offsets)
+	}
+	tr.preDedup = result
+	task.End(zap.ErrorLevel, nil, zap.Int("droppedRows", droppedCount))
+	return nil
+}
+
+// scanChunkForPreDedup reads all the rows of the chunk from its beginning and
+// spills their primary keys.
+func (tr *TableRestore) scanChunkForPreDedup(
+	ctx context.Context,
+	rc *Controller,
+	pk *model.IndexInfo,
+	index int,
+	chunk *checkpoints.ChunkCheckpoint,
+	spill *preDedupSpill,
+) error {
+	// the chunk may have been partially restored, but the rows restored after
+	// resuming may be duplicated with the rows restored before.
+	scanChunk := chunk.DeepCopy()
+	scanChunk.Chunk.Offset = scanChunk.Key.Offset
+	scanChunk.Chunk.PrevRowIDMax = 0
+	cr, err := newChunkRestore(ctx, index, rc.cfg, scanChunk, rc.ioWorkers, rc.store, tr.tableInfo)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer cr.close()
+
+	encoder := newPreDedupKeyEncoder(tr.tableInfo.Core, pk, &kv.SessionOptions{
+		SQLMode: rc.cfg.TiDB.SQLMode,
+		SysVars: rc.sysVars,
+	}, tr.logger)
+	bufs := make([][]byte, len(spill.files))
+	var key []byte
+	initialized, hasKey := false, true
+	for {
+		if offset, _ := cr.parser.Pos(); offset >= scanChunk.Chunk.EndOffset {
+			break
+		}
+		err := cr.parser.ReadRow()
+		if errors.Cause(err) == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.Annotatef(err, "failed to read %s for the pre-deduplication", &chunk.Key)
+		}
+		if !initialized {
+			if len(scanChunk.ColumnPermutation) == 0 {
+				if err := tr.initializeColumns(cr.parser.Columns(), scanChunk); err != nil {
+					return errors.Trace(err)
+				}
+			}
+			hasKey = encoder.hasKeyColumns(scanChunk.ColumnPermutation)
+			if !hasKey {
+				// the primary keys of the rows are generated by TiDB, so
+				// they never conflict.
+				tr.logger.Info("skip the pre-deduplication of the chunk without the primary key columns",
+					zap.Stringer("chunk", &chunk.Key))
+			}
+			initialized = true
+		}
+		if !hasKey {
+			break
+		}
+
+		offset, _ := cr.parser.Pos()
+		lastRow := cr.parser.LastRow()
+		var ok bool
+		key, ok = encoder.encode(lastRow.Row, scanChunk.ColumnPermutation, key[:0])
+		cr.parser.RecycleRow(lastRow)
+		if !ok {
+			// the row fails to be encoded later, or its primary key is
+			// generated by TiDB.
+			continue
+		}
+		partition := spill.partition(key)
+		bufs[partition] = appendPreDedupRecord(bufs[partition], key, index, offset)
+		if len(bufs[partition]) >= preDedupFlushSize {
+			if err := spill.write(partition, bufs[partition]); err != nil {
+				return errors.Trace(err)
+			}
+			bufs[partition] = bufs[partition][:0]
+		}
+	}
+	for partition, buf := range bufs {
+		if len(buf) == 0 {
+			continue
+		}
+		if err := spill.write(partition, buf); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// preDedupKeyIndex returns the primary key of the table as an index, or nil if
+// the table has no primary key.
+func preDedupKeyIndex(tblInfo *model.TableInfo) *model.IndexInfo {
+	if tblInfo.PKIsHandle {
+		col := tblInfo.GetPkColInfo()
+		if col == nil {
+			return nil
+		}
+		return &model.IndexInfo{
+			Name:    model.NewCIStr("PRIMARY"),
+			Primary: true,
+			Unique:  true,
+			Columns: []*model.IndexColumn{{Name: col.Name, Offset: col.Offset, Length: types.UnspecifiedLength}},
+		}
+	}
+	return tables.FindPrimaryIndex(tblInfo)
+}
+
+// preDedupKeyEncoder encodes the primary keys of the source rows, the rows
+// sharing the same encoded key conflict with each other after being imported.
+type preDedupKeyEncoder struct {
+	tblInfo *model.TableInfo
+	pk      *model.IndexInfo
+	se      sessionctx.Context
+	values  []types.Datum
+}
+
+func newPreDedupKeyEncoder(
+	tblInfo *model.TableInfo,
+	pk *model.IndexInfo,
+	options *kv.SessionOptions,
+	logger log.Logger,
+) *preDedupKeyEncoder {
+	return &preDedupKeyEncoder{
+		tblInfo: tblInfo,
+		pk:      pk,
+		se:      kv.NewSession(options, logger),
+		values:  make([]types.Datum, len(pk.Columns)),
+	}
+}
+
+// hasKeyColumns checks whether all the primary key columns are in the source
+// rows with the column permutation.
+func (e *preDedupKeyEncoder) hasKeyColumns(permutation []int) bool {
+	for _, col := range e.pk.Columns {
+		if col.Offset >= len(permutation) || permutation[col.Offset] < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// encode appends the encoded primary key of the row to buf. The values are
+// casted to the column types, so the key is compared in the same way as the
+// imported rows, e.g. with the collations and the prefix lengths. It returns
+// false if the key can't be encoded.
+func (e *preDedupKeyEncoder) encode(row []types.Datum, permutation []int, buf []byte) ([]byte, bool) {
+	for i, idxCol := range e.pk.Columns {
+		pos := permutation[idxCol.Offset]
+		if pos >= len(row) || row[pos].IsNull() {
+			return buf, false
+		}
+		casted, err := table.CastValue(e.se, row[pos], e.tblInfo.Columns[idxCol.Offset], false, false)
+		if err != nil {
+			return buf, false
+		}
+		e.values[i] = casted
+	}
+	tablecodec.TruncateIndexValues(e.tblInfo, e.pk, e.values)
+	buf, err := codec.EncodeKey(e.se.GetSessionVars().StmtCtx, buf, e.values...)
+	return buf, err == nil
+}
+
+func appendPreDedupRecord(buf []byte, key []byte, chunk int, offset int64) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(key)))
+	buf = append(buf, key...)
+	buf = binary.AppendUvarint(buf, uint64(chunk))
+	return binary.AppendUvarint(buf, uint64(offset))
+}
+
+// preDedupSpill holds the partition files of the primary keys.
+type preDedupSpill struct {
+	files []*os.File
+	mus   []sync.Mutex
+}
+
+func newPreDedupSpill(dir string, partitions int) (*preDedupSpill, error) {
+	s := &preDedupSpill{mus: make([]sync.Mutex, partitions)}
+	for i := 0; i < partitions; i++ {
+		f, err := os.CreateTemp(dir, "partition-")
+		if err != nil {
+			s.close()
+			return nil, errors.Trace(err)
+		}
+		s.files = append(s.files, f)
+	}
+	return s, nil
+}
+
+func (s *preDedupSpill) partition(key []byte) int {
+	h := fnv.New64a()
+	_, _ = h.Write(key)
+	return int(h.Sum64() % uint64(len(s.files)))
+}
+
+func (s *preDedupSpill) write(partition int, data []byte) error {
+	s.mus[partition].Lock()
+	defer s.mus[partition].Unlock()
+	_, err := s.files[partition].Write(data)
+	return errors.Trace(err)
+}
+
+// resolve deduplicates the partitions one by one, and returns the end offsets
+// of the dropped rows of every chunk.
+func (s *preDedupSpill) resolve(lastWins bool) (map[int][]int64, error) {
+	dropped := make(map[int][]int64)
+	for _, f := range s.files {
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return nil, errors.Trace(err)
+		}
+		kept := make(map[string]preDedupRowPos)
+		reader := bufio.NewReader(f)
+		for {
+			key, pos, err := readPreDedupRecord(reader)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			prev, ok := kept[string(key)]
+			if !ok {
+				kept[string(key)] = pos
+				continue
+			}
+			// the records of a partition aren't ordered since the chunks
+			// are scanned concurrently.
+			first, last := prev, pos
+			if pos.before(prev) {
+				first, last = pos, prev
+			}
+			keep, drop := first, last
+			if lastWins {
+				keep, drop = last, first
+			}
+			kept[string(key)] = keep
+			dropped[drop.chunk] = append(dropped[drop.chunk], drop.offset)
+		}
+	}
+	return dropped, nil
+}
+
+func readPreDedupRecord(reader *bufio.Reader) ([]byte, preDedupRowPos, error) {
+	var pos preDedupRowPos
+	keyLen, err := binary.ReadUvarint(reader)
+	if err != nil {
+		// io.EOF is returned only if no bytes are read.
+		return nil, pos, err
+	}
+	key := make([]byte, keyLen)
+	if _, err := io.ReadFull(reader, key); err != nil {
+		return nil, pos, errors.Trace(err)
+	}
+	chunk, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return nil, pos, errors.Trace(err)
+	}
+	offset, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return nil, pos, errors.Trace(err)
+	}
+	pos.chunk, pos.offset = int(chunk), int64(offset)
+	return key, pos, nil
+}
+
+func (s *preDedupSpill) close() {
+	for _, f := range s.files {
+		_ = f.Close()
+	}
+}
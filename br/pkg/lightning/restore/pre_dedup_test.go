@@ -0,0 +1,168 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pingcap/tidb/br/pkg/lightning/backend/kv"
+	"github.com/pingcap/tidb/br/pkg/lightning/checkpoints"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/lightning/log"
+	"github.com/pingcap/tidb/br/pkg/lightning/mydump"
+	"github.com/pingcap/tidb/br/pkg/lightning/worker"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/ddl"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/model"
+	tmock "github.com/pingcap/tidb/util/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newPreDedupTestTable(t *testing.T, createSQL string) *TableRestore {
+	node, err := parser.New().ParseOneStmt(createSQL, "", "")
+	require.NoError(t, err)
+	core, err := ddl.MockTableInfo(tmock.NewContext(), node.(*ast.CreateTableStmt), 1)
+	require.NoError(t, err)
+	core.State = model.StatePublic
+
+	tableInfo := &checkpoints.TidbTableInfo{Name: "t", DB: "db", Core: core}
+	dbInfo := &checkpoints.TidbDBInfo{Name: "db", Tables: map[string]*checkpoints.TidbTableInfo{"t": tableInfo}}
+	tr, err := NewTableRestore("`db`.`t`", nil, dbInfo, tableInfo, &checkpoints.TableCheckpoint{}, nil, nil, log.L())
+	require.NoError(t, err)
+	return tr
+}
+
+func newPreDedupTestChunk(t *testing.T, dir, name, content string) *checkpoints.ChunkCheckpoint {
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	size := int64(len(content))
+	return &checkpoints.ChunkCheckpoint{
+		Key:      checkpoints.ChunkCheckpointKey{Path: name},
+		FileMeta: mydump.SourceFileMeta{Path: name, Type: mydump.SourceTypeCSV, FileSize: size},
+		Chunk:    mydump.Chunk{EndOffset: size, RowIDMax: 100},
+	}
+}
+
+func TestPreDeduplicate(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := storage.NewLocalStorage(dir)
+	require.NoError(t, err)
+
+	chunk1 := newPreDedupTestChunk(t, dir, "db.t.1.csv", "a,b\n1,x\n2,y\n1,z\n")
+	chunk2 := newPreDedupTestChunk(t, dir, "db.t.2.csv", "a,b\n2,w\n3,v\n")
+	chunk3 := newPreDedupTestChunk(t, dir, "db.t.3.csv", "a,b\n1,xa\n2,xb\n3,ya\n")
+
+	testCases := []struct {
+		createSQL string
+		chunks    []*checkpoints.ChunkCheckpoint
+		mode      string
+		expected  map[checkpoints.ChunkCheckpointKey][]int64
+	}{
+		{
+			createSQL: "CREATE TABLE t (a INT PRIMARY KEY, b VARCHAR(10))",
+			chunks:    []*checkpoints.ChunkCheckpoint{chunk1, chunk2},
+			mode:      config.PreDedupDrop,
+			expected:  map[checkpoints.ChunkCheckpointKey][]int64{chunk1.Key: {16}, chunk2.Key: {8}},
+		},
+		{
+			createSQL: "CREATE TABLE t (a INT PRIMARY KEY, b VARCHAR(10))",
+			chunks:    []*checkpoints.ChunkCheckpoint{chunk1, chunk2},
+			mode:      config.PreDedupLastWins,
+			expected:  map[checkpoints.ChunkCheckpointKey][]int64{chunk1.Key: {8, 12}},
+		},
+		{
+			// the rows conflict on the prefix of the primary key.
+			createSQL: "CREATE TABLE t (a INT, b VARCHAR(10), PRIMARY KEY (b(1)) NONCLUSTERED)",
+			chunks:    []*checkpoints.ChunkCheckpoint{chunk3},
+			mode:      config.PreDedupDrop,
+			expected:  map[checkpoints.ChunkCheckpointKey][]int64{chunk3.Key: {14}},
+		},
+		{
+			// the rows without primary key are never deduplicated.
+			createSQL: "CREATE TABLE t (a INT, b VARCHAR(10))",
+			chunks:    []*checkpoints.ChunkCheckpoint{chunk1, chunk2},
+			mode:      config.PreDedupDrop,
+		},
+	}
+
+	for _, tc := range testCases {
+		tr := newPreDedupTestTable(t, tc.createSQL)
+		cfg := config.NewConfig()
+		cfg.App.RegionConcurrency = 2
+		cfg.Mydumper.PreDedup = tc.mode
+		cfg.Mydumper.PreDedupPartitions = 4
+		cfg.Mydumper.PreDedupDir = t.TempDir()
+		rc := &Controller{cfg: cfg, ioWorkers: worker.NewPool(ctx, 2, "io"), store: store}
+
+		cp := &checkpoints.TableCheckpoint{Engines: map[int32]*checkpoints.EngineCheckpoint{
+			indexEngineID: {Status: checkpoints.CheckpointStatusLoaded},
+		}}
+		for i, chunk := range tc.chunks {
+			cp.Engines[int32(i)] = &checkpoints.EngineCheckpoint{
+				Status: checkpoints.CheckpointStatusLoaded,
+				Chunks: []*checkpoints.ChunkCheckpoint{chunk},
+			}
+		}
+		require.NoError(t, tr.preDeduplicate(ctx, rc, cp), tc.createSQL)
+		if tc.expected == nil {
+			require.Nil(t, tr.preDedup, tc.createSQL)
+			continue
+		}
+		require.Equal(t, tc.expected, tr.preDedup.dropped, tc.createSQL)
+
+		// the partition files are removed.
+		entries, err := os.ReadDir(cfg.Mydumper.PreDedupDir)
+		require.NoError(t, err)
+		require.Len(t, entries, 0)
+	}
+}
+
+func TestEncodeLoopSkipDroppedRows(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := storage.NewLocalStorage(dir)
+	require.NoError(t, err)
+
+	tr := newPreDedupTestTable(t, "CREATE TABLE t (a INT PRIMARY KEY, b VARCHAR(10))")
+	chunk := newPreDedupTestChunk(t, dir, "db.t.1.csv", "a,b\n1,x\n2,y\n1,z\n")
+	cfg := config.NewConfig()
+	cr, err := newChunkRestore(ctx, 0, cfg, chunk, worker.NewPool(ctx, 1, "io"), store, tr.tableInfo)
+	require.NoError(t, err)
+	defer cr.close()
+	cr.droppedOffsets = []int64{16}
+
+	kvEncoder, err := kv.NewTableKVEncoder(tr.encTable, &kv.SessionOptions{SQLMode: cfg.TiDB.SQLMode}, nil, log.L())
+	require.NoError(t, err)
+	kvsCh := make(chan []deliveredKVs, 2)
+	rc := &Controller{pauser: DeliverPauser, cfg: cfg}
+	_, _, err = cr.encodeLoop(ctx, kvsCh, tr, 0, tr.logger, kvEncoder, make(chan deliverResult), rc)
+	require.NoError(t, err)
+	require.Len(t, kvsCh, 2)
+
+	kvs := <-kvsCh
+	require.Len(t, kvs, 2)
+	require.Equal(t, int64(8), kvs[0].offset)
+	require.Equal(t, int64(12), kvs[1].offset)
+
+	kvs = <-kvsCh
+	require.Len(t, kvs, 1)
+	require.Nil(t, kvs[0].kvs)
+	require.Equal(t, int64(16), kvs[0].offset)
+}
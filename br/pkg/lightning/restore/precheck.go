@@ -25,6 +25,10 @@ const (
 	CheckTargetClusterVersion     CheckItemID = "CHECK_TARGET_CLUSTER_VERSION"
 	CheckLocalDiskPlacement       CheckItemID = "CHECK_LOCAL_DISK_PLACEMENT"
 	CheckLocalTempKVDir           CheckItemID = "CHECK_LOCAL_TEMP_KV_DIR"
+	CheckSourceDataFormat         CheckItemID = "CHECK_SOURCE_DATA_FORMAT"
+	CheckTargetPrivileges         CheckItemID = "CHECK_TARGET_PRIVILEGES"
+	CheckGeneratedColumns         CheckItemID = "CHECK_GENERATED_COLUMNS"
+	CheckLogBackupTask            CheckItemID = "CHECK_LOG_BACKUP_TASK"
 )
 
 type CheckResult struct {
@@ -176,6 +180,14 @@ func (b *PrecheckItemBuilder) BuildPrecheckItem(checkID CheckItemID) (PrecheckIt
 		return NewLocalDiskPlacementCheckItem(b.cfg), nil
 	case CheckLocalTempKVDir:
 		return NewLocalTempKVDirCheckItem(b.cfg, b.preInfoGetter), nil
+	case CheckSourceDataFormat:
+		return NewSourceDataFormatCheckItem(b.cfg, b.preInfoGetter, b.dbMetas, b.checkpointsDB), nil
+	case CheckTargetPrivileges:
+		return NewTargetPrivilegesCheckItem(b.preInfoGetter, b.dbMetas), nil
+	case CheckGeneratedColumns:
+		return NewGeneratedColumnsCheckItem(b.cfg, b.preInfoGetter, b.dbMetas), nil
+	case CheckLogBackupTask:
+		return NewLogBackupCheckItem(b.cfg, b.preInfoGetter, b.dbMetas), nil
 	default:
 		return nil, errors.Errorf("unsupported check item: %v", checkID)
 	}
@@ -14,6 +14,7 @@
 package restore
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"path/filepath"
@@ -21,6 +22,8 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
+	"unicode/utf8"
 
 	"github.com/docker/go-units"
 	"github.com/pingcap/errors"
@@ -32,16 +35,26 @@ import (
 	"github.com/pingcap/tidb/br/pkg/lightning/log"
 	"github.com/pingcap/tidb/br/pkg/lightning/mydump"
 	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/br/pkg/streamhelper"
+	"github.com/pingcap/tidb/expression"
+	tidbkv "github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/store/pdtypes"
 	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/engine"
+	"github.com/pingcap/tidb/util/generatedexpr"
 	"github.com/pingcap/tidb/util/mathutil"
+	"github.com/pingcap/tidb/util/stringutil"
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 	"golang.org/x/exp/slices"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
 )
 
 type clusterResourceCheckItem struct {
@@ -58,16 +71,55 @@ func (ci *clusterResourceCheckItem) GetCheckItemID() CheckItemID {
 	return CheckTargetClusterSize
 }
 
-func (ci *clusterResourceCheckItem) getClusterAvail(ctx context.Context) (uint64, error) {
+// getStores gets the TiKV stores and the TiFlash stores which are up, i.e.
+// the stores which the imported data are replicated to.
+func (ci *clusterResourceCheckItem) getStores(ctx context.Context) (tikvStores, tiflashStores []*pdtypes.StoreInfo, err error) {
 	storeInfo, err := ci.preInfoGetter.GetStorageInfo(ctx)
 	if err != nil {
-		return 0, errors.Trace(err)
+		return nil, nil, errors.Trace(err)
 	}
-	clusterAvail := uint64(0)
 	for _, store := range storeInfo.Stores {
-		clusterAvail += uint64(store.Status.Available)
+		if metapb.StoreState(metapb.StoreState_value[store.Store.StateName]) != metapb.StoreState_Up {
+			continue
+		}
+		if engine.IsTiFlash(store.Store.Store) {
+			tiflashStores = append(tiflashStores, store)
+		} else {
+			tikvStores = append(tikvStores, store)
+		}
+	}
+	return tikvStores, tiflashStores, nil
+}
+
+func sumStoresAvail(stores []*pdtypes.StoreInfo) uint64 {
+	avail := uint64(0)
+	for _, store := range stores {
+		avail += uint64(store.Status.Available)
+	}
+	return avail
+}
+
+// checkStoresAvail checks whether each store has enough space for its share of
+// the data, which is evenly distributed by PD, and returns the shortfalls.
+func checkStoresAvail(stores []*pdtypes.StoreInfo, engineName string, totalSize uint64) []string {
+	if totalSize == 0 {
+		return nil
+	}
+	if len(stores) == 0 {
+		return []string{fmt.Sprintf("no %s store is up, but we need %s", engineName, units.BytesSize(float64(totalSize)))}
+	}
+	share := (totalSize + uint64(len(stores)) - 1) / uint64(len(stores))
+	var shortfalls []string
+	for _, store := range stores {
+		avail := uint64(store.Status.Available)
+		if avail >= share {
+			continue
+		}
+		shortfalls = append(shortfalls, fmt.Sprintf("%s store %d (%s) available is %s, but we need %s, short of %s",
+			engineName, store.Store.GetId(), store.Store.GetAddress(), units.BytesSize(float64(avail)),
+			units.BytesSize(float64(share)), units.BytesSize(float64(share-avail))))
 	}
-	return clusterAvail, nil
+	return shortfalls
 }
 
 func (ci *clusterResourceCheckItem) getReplicaCount(ctx context.Context) (uint64, error) {
@@ -91,7 +143,17 @@ func (ci *clusterResourceCheckItem) Check(ctx context.Context) (*CheckResult, er
 		clusterAvail  uint64
 		clusterSource uint64
 		taskMgr       taskMetaMgr
+		// the stores are only got when the available space isn't recorded by
+		// the other tasks, otherwise the current available space of the stores
+		// might have been consumed by the other tasks.
+		gotStores     bool
+		tikvStores    []*pdtypes.StoreInfo
+		tiflashStores []*pdtypes.StoreInfo
 	)
+	estimatedDataSizeResult, err := ci.preInfoGetter.EstimateSourceDataSize(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	taskMgrVal := ctx.Value(taskManagerKey)
 	if taskMgrVal != nil {
 		if mgr, ok := taskMgrVal.(taskMetaMgr); ok {
@@ -99,16 +161,13 @@ func (ci *clusterResourceCheckItem) Check(ctx context.Context) (*CheckResult, er
 		}
 	}
 	if taskMgr == nil {
-		var err error
-		estimatedDataSizeResult, err := ci.preInfoGetter.EstimateSourceDataSize(ctx)
-		if err != nil {
-			return nil, errors.Trace(err)
-		}
 		clusterSource = uint64(estimatedDataSizeResult.SizeWithIndex)
-		clusterAvail, err = ci.getClusterAvail(ctx)
+		tikvStores, tiflashStores, err = ci.getStores(ctx)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
+		clusterAvail = sumStoresAvail(tikvStores)
+		gotStores = true
 	} else {
 		if err := taskMgr.CheckTasksExclusively(ctx, func(tasks []taskMeta) ([]taskMeta, error) {
 			clusterAvail = 0
@@ -127,10 +186,12 @@ func (ci *clusterResourceCheckItem) Check(ctx context.Context) (*CheckResult, er
 				return nil, nil
 			}
 
-			clusterAvail, err = ci.getClusterAvail(ctx)
+			tikvStores, tiflashStores, err = ci.getStores(ctx)
 			if err != nil {
 				return nil, errors.Trace(err)
 			}
+			clusterAvail = sumStoresAvail(tikvStores)
+			gotStores = true
 			newTasks := append([]taskMeta(nil), tasks...)
 			for i := 0; i < len(newTasks); i++ {
 				newTasks[i].clusterAvail = clusterAvail
@@ -150,9 +211,29 @@ func (ci *clusterResourceCheckItem) Check(ctx context.Context) (*CheckResult, er
 		theResult.Passed = false
 		theResult.Message = fmt.Sprintf("Cluster doesn't have enough space, available is %s, but we need %s",
 			units.BytesSize(float64(clusterAvail)), units.BytesSize(float64(estimateSize)))
-	} else {
-		theResult.Message = fmt.Sprintf("Cluster available is rich, available is %s, we need %s",
-			units.BytesSize(float64(clusterAvail)), units.BytesSize(float64(estimateSize)))
+		return theResult, nil
+	}
+
+	// the cluster might still be short of space if the spaces of the stores
+	// are unbalanced, and the data of the tables with TiFlash replicas are
+	// also replicated to the TiFlash stores.
+	tiflashSize := uint64(estimatedDataSizeResult.TiFlashSize)
+	var shortfalls []string
+	if gotStores {
+		shortfalls = append(shortfalls, checkStoresAvail(tikvStores, "TiKV", estimateSize)...)
+		shortfalls = append(shortfalls, checkStoresAvail(tiflashStores, "TiFlash", tiflashSize)...)
+	}
+	if len(shortfalls) > 0 {
+		theResult.Passed = false
+		theResult.Message = fmt.Sprintf("Cluster available is %s and we need %s, but some stores don't have enough space: %s",
+			units.BytesSize(float64(clusterAvail)), units.BytesSize(float64(estimateSize)), strings.Join(shortfalls, "; "))
+		return theResult, nil
+	}
+	theResult.Message = fmt.Sprintf("Cluster available is rich, available is %s, we need %s",
+		units.BytesSize(float64(clusterAvail)), units.BytesSize(float64(estimateSize)))
+	if tiflashSize > 0 {
+		theResult.Message += fmt.Sprintf(", TiFlash available is %s, we need %s",
+			units.BytesSize(float64(sumStoresAvail(tiflashStores))), units.BytesSize(float64(tiflashSize)))
 	}
 	return theResult, nil
 }
@@ -768,7 +849,8 @@ func (ci *schemaCheckItem) SchemaIsValid(ctx context.Context, tableInfo *mydump.
 	// get columns name from data file.
 	dataFileMeta := dataFile.FileMeta
 
-	if tp := dataFileMeta.Type; tp != mydump.SourceTypeCSV && tp != mydump.SourceTypeSQL && tp != mydump.SourceTypeParquet {
+	if tp := dataFileMeta.Type; tp != mydump.SourceTypeCSV && tp != mydump.SourceTypeSQL &&
+		tp != mydump.SourceTypeParquet && tp != mydump.SourceTypeAvro {
 		msgs = append(msgs, fmt.Sprintf("file '%s' with unknown source type '%s'", dataFileMeta.Path, dataFileMeta.Type.String()))
 		return msgs, nil
 	}
@@ -1061,6 +1143,172 @@ func checkFieldCompatibility(
 	return true
 }
 
+type sourceDataFormatCheckItem struct {
+	cfg           *config.Config
+	preInfoGetter PreRestoreInfoGetter
+	dbMetas       []*mydump.MDDatabaseMeta
+	checkpointsDB checkpoints.DB
+}
+
+func NewSourceDataFormatCheckItem(cfg *config.Config, preInfoGetter PreRestoreInfoGetter, dbMetas []*mydump.MDDatabaseMeta, cpdb checkpoints.DB) PrecheckItem {
+	return &sourceDataFormatCheckItem{
+		cfg:           cfg,
+		preInfoGetter: preInfoGetter,
+		dbMetas:       dbMetas,
+		checkpointsDB: cpdb,
+	}
+}
+
+func (ci *sourceDataFormatCheckItem) GetCheckItemID() CheckItemID {
+	return CheckSourceDataFormat
+}
+
+// Check reads the first `mydumper.precheck-sample-rows` rows of every CSV and SQL file, and reports the files
+// which contain unparseable rows, rows with inconsistent column counts, or characters invalid in
+// `mydumper.data-character-set`, so that they can be fixed before the import instead of failing in the middle.
+func (ci *sourceDataFormatCheckItem) Check(ctx context.Context) (*CheckResult, error) {
+	sampleRows := ci.cfg.Mydumper.PrecheckSampleRows
+	if sampleRows <= 0 {
+		return nil, nil
+	}
+	theResult := &CheckResult{
+		Item:     ci.GetCheckItemID(),
+		Severity: Warn,
+		Passed:   true,
+		Message:  fmt.Sprintf("the first %d rows of the source data files are valid", sampleRows),
+	}
+	dbInfos, err := ci.preInfoGetter.GetAllTableStructures(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	checkMsgs := []string{}
+	for _, dbMeta := range ci.dbMetas {
+		for _, tblMeta := range dbMeta.Tables {
+			if ci.cfg.Checkpoint.Enable && ci.checkpointsDB != nil {
+				uniqueName := common.UniqueTable(tblMeta.DB, tblMeta.Name)
+				if _, err := ci.checkpointsDB.Get(ctx, uniqueName); err == nil {
+					log.FromContext(ctx).Debug("checkpoint detected, skip the data format check", zap.String("table", uniqueName))
+					continue
+				}
+			}
+			dbInfo, ok := dbInfos[tblMeta.DB]
+			if !ok {
+				continue
+			}
+			// the missing table is reported by the schema check.
+			info, ok := dbInfo.Tables[tblMeta.Name]
+			if !ok {
+				continue
+			}
+			for _, f := range tblMeta.DataFiles {
+				if tp := f.FileMeta.Type; tp != mydump.SourceTypeCSV && tp != mydump.SourceTypeSQL {
+					continue
+				}
+				problems, critical, err := ci.checkDataFile(ctx, f.FileMeta, info.Core)
+				if err != nil {
+					return nil, errors.Trace(err)
+				}
+				if len(problems) == 0 {
+					continue
+				}
+				checkMsgs = append(checkMsgs, fmt.Sprintf("file '%s': %s", f.FileMeta.Path, strings.Join(problems, "; ")))
+				if critical {
+					theResult.Severity = Critical
+				}
+			}
+		}
+	}
+	if len(checkMsgs) > 0 {
+		theResult.Passed = false
+		theResult.Message = fmt.Sprintf("the first %d rows of the following source data files have format problems, "+
+			"please fix the files or the [mydumper] config:\n%s", sampleRows, strings.Join(checkMsgs, "\n"))
+	}
+	return theResult, nil
+}
+
+// checkDataFile returns the format problems found in the sampled rows of the data file, and whether any of them
+// fails the import or corrupts the imported data.
+func (ci *sourceDataFormatCheckItem) checkDataFile(
+	ctx context.Context,
+	fileMeta mydump.SourceFileMeta,
+	tbl *model.TableInfo,
+) ([]string, bool, error) {
+	dataCharacterSet := ci.cfg.Mydumper.DataCharacterSet
+	if len(fileMeta.DataCharacterSet) > 0 {
+		dataCharacterSet = fileMeta.DataCharacterSet
+	}
+	charset, err := config.ParseCharset(dataCharacterSet)
+	if err != nil {
+		return nil, false, errors.Trace(err)
+	}
+	// the rows with invalid characters can't be parsed with the "error" policy.
+	cols, rows, err := ci.preInfoGetter.ReadFirstNRowsByFileMeta(ctx, fileMeta, ci.cfg.Mydumper.PrecheckSampleRows)
+	if err != nil {
+		if common.IsContextCanceledError(err) {
+			return nil, false, err
+		}
+		return []string{fmt.Sprintf("the rows can't be parsed: %s", err.Error())}, true, nil
+	}
+	if len(rows) == 0 {
+		return nil, false, nil
+	}
+
+	var problems []string
+	critical := false
+	// the columns of the SQL files vary with the INSERT statements, so only the
+	// columns of the CSV files are checked.
+	if fileMeta.Type == mydump.SourceTypeCSV {
+		expected, source := len(cols), "the header"
+		if expected == 0 {
+			expected, source = len(rows[0]), "the first row"
+			if expected > len(tbl.Columns) {
+				problems = append(problems, fmt.Sprintf("the first row has %d columns, but the table has only %d columns",
+					expected, len(tbl.Columns)))
+				critical = true
+			}
+		}
+		for i, row := range rows {
+			if len(row) != expected {
+				problems = append(problems, fmt.Sprintf("row %d has %d columns, but %s has %d columns",
+					i+1, len(row), source, expected))
+				critical = true
+				break
+			}
+		}
+	}
+
+	// the invalid characters are replaced or rejected by TiDB, so they're only warned.
+	replace := ci.cfg.Mydumper.DataInvalidCharReplace
+	if ci.cfg.Mydumper.DataInvalidCharPolicy == config.InvalidCharPolicyIgnore {
+		replace = ""
+	}
+outer:
+	for i, row := range rows {
+		for j := range row {
+			if row[j].Kind() != types.KindString {
+				continue
+			}
+			val := row[j].GetString()
+			switch charset {
+			case config.UTF8MB4, config.Binary:
+				// the quoted strings of the SQL files may contain binary data.
+				if fileMeta.Type == mydump.SourceTypeCSV && !utf8.ValidString(val) {
+					problems = append(problems, fmt.Sprintf("row %d column %d contains invalid UTF-8 characters", i+1, j+1))
+					break outer
+				}
+			case config.GBK, config.GB18030, config.Latin1:
+				if len(replace) > 0 && strings.Contains(val, replace) {
+					problems = append(problems, fmt.Sprintf("row %d column %d contains characters invalid in %s, which are replaced by '%s'",
+						i+1, j+1, charset, replace))
+					break outer
+				}
+			}
+		}
+	}
+	return problems, critical, nil
+}
+
 type tableEmptyCheckItem struct {
 	cfg           *config.Config
 	preInfoGetter PreRestoreInfoGetter
@@ -1165,3 +1413,507 @@ func hasDefault(col *model.ColumnInfo) bool {
 	return col.DefaultIsExpr || col.DefaultValue != nil || !mysql.HasNotNullFlag(col.GetFlag()) ||
 		col.IsGenerated() || mysql.HasAutoIncrementFlag(col.GetFlag())
 }
+
+// targetTablePrivileges are the privileges required on every importing table by the TiDB backend, which creates
+// the tables, writes the rows with INSERT, REPLACE or UPDATE statements and may alter or drop the tables.
+var targetTablePrivileges = []mysql.PrivilegeType{
+	mysql.SelectPriv,
+	mysql.InsertPriv,
+	mysql.UpdatePriv,
+	mysql.DeletePriv,
+	mysql.CreatePriv,
+	mysql.AlterPriv,
+	mysql.DropPriv,
+}
+
+// semRestrictedPrivileges are the extra privileges required when SEM is enabled on the target, since Lightning
+// reads the restricted system variables and the restricted `mysql.tidb` table.
+var semRestrictedPrivileges = []string{
+	"RESTRICTED_TABLES_ADMIN",
+	"RESTRICTED_VARIABLES_ADMIN",
+}
+
+type targetPrivilegesCheckItem struct {
+	preInfoGetter PreRestoreInfoGetter
+	dbMetas       []*mydump.MDDatabaseMeta
+}
+
+func NewTargetPrivilegesCheckItem(preInfoGetter PreRestoreInfoGetter, dbMetas []*mydump.MDDatabaseMeta) PrecheckItem {
+	return &targetPrivilegesCheckItem{
+		preInfoGetter: preInfoGetter,
+		dbMetas:       dbMetas,
+	}
+}
+
+func (ci *targetPrivilegesCheckItem) GetCheckItemID() CheckItemID {
+	return CheckTargetPrivileges
+}
+
+func (ci *targetPrivilegesCheckItem) Check(ctx context.Context) (*CheckResult, error) {
+	theResult := &CheckResult{
+		Item:     ci.GetCheckItemID(),
+		Severity: Critical,
+		Passed:   true,
+		Message:  "the target user has all the privileges required for importing",
+	}
+	grants, err := ci.preInfoGetter.FetchCurrentUserGrants(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "fetch the grants of the target user failed")
+	}
+	semEnabled, err := ci.preInfoGetter.IsSecurityEnhancedModeEnabled(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "check whether SEM is enabled on the target failed")
+	}
+	granted := parseGrantedPrivileges(ctx, grants)
+
+	missing := make([]string, 0)
+	if semEnabled {
+		privNames := make([]string, 0, len(semRestrictedPrivileges))
+		for _, priv := range semRestrictedPrivileges {
+			if _, ok := granted.dynamic[priv]; !ok {
+				privNames = append(privNames, priv)
+			}
+		}
+		if len(privNames) > 0 {
+			missing = append(missing, fmt.Sprintf("%s on *.*", strings.Join(privNames, ", ")))
+		}
+	}
+	for _, db := range ci.dbMetas {
+		if len(db.Tables) == 0 {
+			continue
+		}
+		// report the missing privileges on the schema if all its tables miss the same privileges,
+		// otherwise report them on each table.
+		tableMissing := make([]mysql.PrivilegeType, len(db.Tables))
+		sameForAll := true
+		for i, tbl := range db.Tables {
+			tableMissing[i] = granted.missingTablePrivileges(tbl.DB, tbl.Name)
+			sameForAll = sameForAll && tableMissing[i] == tableMissing[0]
+		}
+		if sameForAll {
+			if tableMissing[0] != 0 {
+				missing = append(missing, fmt.Sprintf("%s on %s.*", privilegeNames(tableMissing[0]), common.EscapeIdentifier(db.Name)))
+			}
+			continue
+		}
+		for i, tbl := range db.Tables {
+			if tableMissing[i] != 0 {
+				missing = append(missing, fmt.Sprintf("%s on %s", privilegeNames(tableMissing[i]), common.UniqueTable(tbl.DB, tbl.Name)))
+			}
+		}
+	}
+	if len(missing) > 0 {
+		theResult.Passed = false
+		theResult.Message = fmt.Sprintf("the target user lacks the privileges: %s", strings.Join(missing, "; "))
+	}
+	return theResult, nil
+}
+
+// grantedPrivileges is the privileges parsed from the grants of a user.
+type grantedPrivileges struct {
+	global  mysql.PrivilegeType
+	dynamic map[string]struct{}
+	dbs     []dbGrantedPrivileges
+	// tables is keyed by the lowercase unique table name.
+	tables map[string]mysql.PrivilegeType
+}
+
+type dbGrantedPrivileges struct {
+	patWeights []rune
+	patTypes   []byte
+	privs      mysql.PrivilegeType
+}
+
+// parseGrantedPrivileges parses the result of `SHOW GRANTS`. The grants which can't be parsed, the role grants and
+// the column level privileges are ignored.
+func parseGrantedPrivileges(ctx context.Context, grants []string) *grantedPrivileges {
+	result := &grantedPrivileges{
+		dynamic: make(map[string]struct{}),
+		tables:  make(map[string]mysql.PrivilegeType),
+	}
+	p := parser.New()
+	for _, grant := range grants {
+		stmt, err := p.ParseOneStmt(grant, "", "")
+		if err != nil {
+			log.FromContext(ctx).Warn("skip the grant which can't be parsed", zap.String("grant", grant), zap.Error(err))
+			continue
+		}
+		grantStmt, ok := stmt.(*ast.GrantStmt)
+		if !ok {
+			continue
+		}
+		var privs mysql.PrivilegeType
+		for _, elem := range grantStmt.Privs {
+			if len(elem.Cols) > 0 {
+				continue
+			}
+			switch elem.Priv {
+			case mysql.AllPriv:
+				privs |= mysql.AllPrivMask
+			case mysql.ExtendedPriv:
+				if grantStmt.Level.Level == ast.GrantLevelGlobal {
+					result.dynamic[strings.ToUpper(elem.Name)] = struct{}{}
+				}
+			default:
+				privs |= elem.Priv
+			}
+		}
+		switch grantStmt.Level.Level {
+		case ast.GrantLevelGlobal:
+			result.global |= privs
+		case ast.GrantLevelDB:
+			if grantStmt.Level.DBName == "" {
+				continue
+			}
+			patWeights, patTypes := stringutil.CompilePattern(strings.ToLower(grantStmt.Level.DBName), '\\')
+			result.dbs = append(result.dbs, dbGrantedPrivileges{
+				patWeights: patWeights,
+				patTypes:   patTypes,
+				privs:      privs,
+			})
+		case ast.GrantLevelTable:
+			if grantStmt.Level.DBName == "" {
+				continue
+			}
+			key := common.UniqueTable(strings.ToLower(grantStmt.Level.DBName), strings.ToLower(grantStmt.Level.TableName))
+			result.tables[key] |= privs
+		}
+	}
+	return result
+}
+
+// missingTablePrivileges returns the privileges in targetTablePrivileges which aren't granted on the table.
+func (g *grantedPrivileges) missingTablePrivileges(schemaName, tableName string) mysql.PrivilegeType {
+	lowerDB := strings.ToLower(schemaName)
+	privs := g.global | g.tables[common.UniqueTable(lowerDB, strings.ToLower(tableName))]
+	for _, db := range g.dbs {
+		if stringutil.DoMatch(lowerDB, db.patWeights, db.patTypes) {
+			privs |= db.privs
+		}
+	}
+	var missing mysql.PrivilegeType
+	for _, priv := range targetTablePrivileges {
+		if privs&priv == 0 {
+			missing |= priv
+		}
+	}
+	return missing
+}
+
+func privilegeNames(privs mysql.PrivilegeType) string {
+	names := make([]string, 0, len(targetTablePrivileges))
+	for _, priv := range targetTablePrivileges {
+		if privs&priv != 0 {
+			names = append(names, strings.ToUpper(priv.String()))
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// nonDeterministicFunctions are the functions which may return different results for the same arguments, besides
+// the ones disallowed in the generated columns by TiDB.
+var nonDeterministicFunctions = map[string]struct{}{
+	ast.RandomBytes: {},
+	ast.NextVal:     {},
+	ast.LastVal:     {},
+	ast.SetVal:      {},
+}
+
+type generatedColumnsCheckItem struct {
+	cfg           *config.Config
+	preInfoGetter PreRestoreInfoGetter
+	dbMetas       []*mydump.MDDatabaseMeta
+}
+
+func NewGeneratedColumnsCheckItem(cfg *config.Config, preInfoGetter PreRestoreInfoGetter, dbMetas []*mydump.MDDatabaseMeta) PrecheckItem {
+	return &generatedColumnsCheckItem{
+		cfg:           cfg,
+		preInfoGetter: preInfoGetter,
+		dbMetas:       dbMetas,
+	}
+}
+
+func (ci *generatedColumnsCheckItem) GetCheckItemID() CheckItemID {
+	return CheckGeneratedColumns
+}
+
+// Check checks the generated columns and the expression indexes of the tables imported by the local backend, which
+// are evaluated while encoding the rows. The non-deterministic expressions are rejected, since the results evaluated
+// by Lightning may differ from the ones evaluated by TiDB later, which breaks the consistency of the rows and the
+// indexes.
+func (ci *generatedColumnsCheckItem) Check(ctx context.Context) (*CheckResult, error) {
+	theResult := &CheckResult{
+		Item:     ci.GetCheckItemID(),
+		Severity: Critical,
+		Passed:   true,
+		Message:  "the generated columns and the expression indexes can be evaluated while encoding",
+	}
+	tidbBackendTables, err := getTiDBBackendTables(ci.cfg, ci.dbMetas)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	dbInfos, err := ci.preInfoGetter.GetAllTableStructures(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	msgs := make([]string, 0)
+	for _, db := range ci.dbMetas {
+		dbInfo, ok := dbInfos[db.Name]
+		if !ok {
+			continue
+		}
+		for _, tbl := range db.Tables {
+			tableName := common.UniqueTable(tbl.DB, tbl.Name)
+			if _, ok := tidbBackendTables[tableName]; ok {
+				// the rows are inserted into TiDB, which evaluates the expressions.
+				continue
+			}
+			info, ok := dbInfo.Tables[tbl.Name]
+			if !ok {
+				continue
+			}
+			msgs = append(msgs, checkGeneratedExpressions(tableName, info.Core)...)
+		}
+	}
+	if len(msgs) > 0 {
+		theResult.Passed = false
+		theResult.Message = strings.Join(msgs, "\n")
+	}
+	return theResult, nil
+}
+
+// checkGeneratedExpressions checks the expressions of the generated columns of the table, including the hidden ones
+// of the expression indexes.
+func checkGeneratedExpressions(tableName string, tableInfo *model.TableInfo) []string {
+	var msgs []string
+	for _, col := range tableInfo.Columns {
+		if len(col.GeneratedExprString) == 0 {
+			continue
+		}
+		desc := fmt.Sprintf("the generated column %s of %s", common.EscapeIdentifier(col.Name.O), tableName)
+		if col.Hidden {
+			for _, idx := range tableInfo.Indices {
+				for _, idxCol := range idx.Columns {
+					if idxCol.Offset == col.Offset {
+						desc = fmt.Sprintf("the expression index %s of %s", common.EscapeIdentifier(idx.Name.O), tableName)
+					}
+				}
+			}
+		}
+		expr, err := generatedexpr.ParseExpression(col.GeneratedExprString)
+		if err != nil {
+			msgs = append(msgs, fmt.Sprintf("failed to parse %s: %s", desc, err.Error()))
+			continue
+		}
+		var checker nonDeterministicExprChecker
+		expr.Accept(&checker)
+		if len(checker.found) > 0 {
+			msgs = append(msgs, fmt.Sprintf("%s uses the non-deterministic %s, "+
+				"please import the table with the TiDB backend or remove the expression", desc, checker.found))
+		}
+	}
+	return msgs
+}
+
+// nonDeterministicExprChecker finds the first non-deterministic function or variable in an expression.
+type nonDeterministicExprChecker struct {
+	found string
+}
+
+func (c *nonDeterministicExprChecker) Enter(in ast.Node) (ast.Node, bool) {
+	if len(c.found) > 0 {
+		return in, true
+	}
+	switch node := in.(type) {
+	case *ast.FuncCallExpr:
+		_, illegal := expression.IllegalFunctions4GeneratedColumns[node.FnName.L]
+		_, nonDeterministic := nonDeterministicFunctions[node.FnName.L]
+		if illegal || nonDeterministic {
+			c.found = fmt.Sprintf("function %s()", node.FnName.L)
+			return in, true
+		}
+	case *ast.VariableExpr:
+		c.found = fmt.Sprintf("variable @%s", node.Name)
+		if node.IsSystem {
+			c.found = fmt.Sprintf("variable @@%s", node.Name)
+		}
+		return in, true
+	}
+	return in, false
+}
+
+func (*nonDeterministicExprChecker) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+// logBackupTask is a running log backup task and the key ranges it observes.
+type logBackupTask struct {
+	name   string
+	ranges []tidbkv.KeyRange
+}
+
+type logBackupCheckItem struct {
+	cfg           *config.Config
+	preInfoGetter PreRestoreInfoGetter
+	dbMetas       []*mydump.MDDatabaseMeta
+	// getTasks gets the running log backup tasks, it's replaced in test.
+	getTasks func(ctx context.Context) ([]logBackupTask, error)
+}
+
+func NewLogBackupCheckItem(cfg *config.Config, preInfoGetter PreRestoreInfoGetter, dbMetas []*mydump.MDDatabaseMeta) PrecheckItem {
+	return &logBackupCheckItem{
+		cfg:           cfg,
+		preInfoGetter: preInfoGetter,
+		dbMetas:       dbMetas,
+		getTasks: func(ctx context.Context) ([]logBackupTask, error) {
+			return getLogBackupTasks(ctx, cfg)
+		},
+	}
+}
+
+func (ci *logBackupCheckItem) GetCheckItemID() CheckItemID {
+	return CheckLogBackupTask
+}
+
+// Check checks whether the tables imported by the local backend are observed by any running log backup task. The
+// SSTs ingested by the local backend bypass the raft log which the log backup captures, so the imported data would be
+// missing after a PITR restore. The import is rejected unless `tikv-importer.allow-import-during-log-backup` is set.
+func (ci *logBackupCheckItem) Check(ctx context.Context) (*CheckResult, error) {
+	theResult := &CheckResult{
+		Item:     ci.GetCheckItemID(),
+		Severity: Critical,
+		Passed:   true,
+		Message:  "no running log backup task observes the tables imported by the local backend",
+	}
+	tasks, err := ci.getTasks(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(tasks) == 0 {
+		return theResult, nil
+	}
+
+	tidbBackendTables, err := getTiDBBackendTables(ci.cfg, ci.dbMetas)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	dbInfos, err := ci.preInfoGetter.GetAllTableStructures(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	msgs := make([]string, 0)
+	for _, task := range tasks {
+		tables := make([]string, 0)
+		for _, db := range ci.dbMetas {
+			dbInfo, ok := dbInfos[db.Name]
+			if !ok {
+				continue
+			}
+			for _, tbl := range db.Tables {
+				tableName := common.UniqueTable(tbl.DB, tbl.Name)
+				if _, ok := tidbBackendTables[tableName]; ok {
+					// the rows inserted by the TiDB backend are captured by the log backup.
+					continue
+				}
+				info, ok := dbInfo.Tables[tbl.Name]
+				if !ok {
+					continue
+				}
+				if tableObservedByRanges(info.Core, task.ranges) {
+					tables = append(tables, tableName)
+				}
+			}
+		}
+		if len(tables) > 0 {
+			msgs = append(msgs, fmt.Sprintf("the log backup task %s observes the tables %s", task.name, strings.Join(tables, ", ")))
+		}
+	}
+	if len(msgs) == 0 {
+		return theResult, nil
+	}
+
+	theResult.Passed = false
+	msg := strings.Join(msgs, "\n")
+	if ci.cfg.TikvImporter.AllowImportDuringLogBackup {
+		// the import goes on, but the user is warned to take a full backup afterwards.
+		theResult.Severity = Warn
+		theResult.Message = fmt.Sprintf("%s, the data imported by the local backend can't be restored by PITR, "+
+			"please take a full backup after the import", msg)
+		log.FromContext(ctx).Warn("importing the tables observed by log backup tasks", zap.Strings("tasks", msgs))
+		return theResult, nil
+	}
+	theResult.Message = fmt.Sprintf("%s, the data imported by the local backend can't be restored by PITR, "+
+		"please stop the log backup tasks, import these tables with the TiDB backend, or set "+
+		"`tikv-importer.allow-import-during-log-backup` to true and take a full backup after the import", msg)
+	return theResult, nil
+}
+
+// tableObservedByRanges returns whether the key ranges overlap any physical table of the table.
+func tableObservedByRanges(tableInfo *model.TableInfo, ranges []tidbkv.KeyRange) bool {
+	physicalIDs := []int64{tableInfo.ID}
+	if pi := tableInfo.GetPartitionInfo(); pi != nil {
+		for _, def := range pi.Definitions {
+			physicalIDs = append(physicalIDs, def.ID)
+		}
+	}
+	for _, id := range physicalIDs {
+		startKey := tidbkv.Key(tablecodec.EncodeTablePrefix(id))
+		tableRange := tidbkv.KeyRange{StartKey: startKey, EndKey: startKey.PrefixNext()}
+		for _, r := range ranges {
+			if keyRangesOverlap(tableRange, r) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// keyRangesOverlap returns whether the two key ranges overlap, an empty end key means unbounded.
+func keyRangesOverlap(a, b tidbkv.KeyRange) bool {
+	return (len(b.EndKey) == 0 || bytes.Compare(a.StartKey, b.EndKey) < 0) &&
+		(len(a.EndKey) == 0 || bytes.Compare(b.StartKey, a.EndKey) < 0)
+}
+
+// getLogBackupTasks gets the running log backup tasks from the etcd of PD.
+func getLogBackupTasks(ctx context.Context, cfg *config.Config) ([]logBackupTask, error) {
+	tls, err := cfg.ToTLS()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	etcdCli, err := clientv3.New(clientv3.Config{
+		Endpoints:        []string{cfg.TiDB.PdAddr},
+		TLS:              tls.TLSConfig(),
+		AutoSyncInterval: 30 * time.Second,
+		DialTimeout:      5 * time.Second,
+		DialOptions: []grpc.DialOption{
+			grpc.WithBlock(),
+			grpc.WithReturnConnectionError(),
+		},
+		Context: ctx,
+	})
+	if err != nil {
+		return nil, common.NormalizeOrWrapErr(common.ErrCreatePDClient, err)
+	}
+	defer func() {
+		if err := etcdCli.Close(); err != nil {
+			log.FromContext(ctx).Warn("failed to close the etcd client", log.ShortError(err))
+		}
+	}()
+
+	metaCli := streamhelper.NewMetaDataClient(etcdCli)
+	tasks, err := metaCli.GetAllTasks(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to get the log backup tasks")
+	}
+	result := make([]logBackupTask, 0, len(tasks))
+	for i := range tasks {
+		ranges, err := tasks[i].Ranges(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		result = append(result, logBackupTask{name: tasks[i].Info.Name, ranges: ranges})
+	}
+	return result, nil
+}
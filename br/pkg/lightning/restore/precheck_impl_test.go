@@ -23,6 +23,8 @@ import (
 	"github.com/pingcap/tidb/br/pkg/lightning/config"
 	"github.com/pingcap/tidb/br/pkg/lightning/log"
 	"github.com/pingcap/tidb/br/pkg/lightning/restore/mock"
+	tidbkv "github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/tablecodec"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -157,6 +159,50 @@ func (s *precheckImplSuite) TestClusterResourceCheckBasic() {
 	s.Require().True(result.Passed)
 }
 
+func (s *precheckImplSuite) TestClusterResourceCheckPerStore() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = WithPreInfoGetterEstimatedSrcSizeCache(ctx, &EstimateSourceDataSizeResult{
+		SizeWithIndex: 300,
+		TiFlashSize:   200,
+	})
+	s.mockTarget.MaxReplicasPerRegion = 3
+	s.mockTarget.StorageInfos = []mock.StorageInfo{
+		{AvailableSize: 1000},
+		{AvailableSize: 1000},
+		{AvailableSize: 100},
+	}
+	ci := NewClusterResourceCheckItem(s.preInfoGetter)
+
+	// the cluster has enough space in total, but a store doesn't have enough
+	// space for its share, and there is no TiFlash store.
+	result, err := ci.Check(ctx)
+	s.Require().NoError(err)
+	s.T().Logf("check result message: %s", result.Message)
+	s.Require().False(result.Passed)
+	s.Require().Regexp("TiKV store 3 .* available is 100B, but we need 300B, short of 200B", result.Message)
+	s.Require().Regexp("no TiFlash store is up, but we need 200B", result.Message)
+
+	s.mockTarget.StorageInfos[2].AvailableSize = 1000
+	s.mockTarget.StorageInfos = append(s.mockTarget.StorageInfos,
+		mock.StorageInfo{AvailableSize: 150, IsTiFlash: true},
+		mock.StorageInfo{AvailableSize: 50, IsTiFlash: true},
+	)
+	result, err = ci.Check(ctx)
+	s.Require().NoError(err)
+	s.T().Logf("check result message: %s", result.Message)
+	s.Require().False(result.Passed)
+	s.Require().NotContains(result.Message, "TiKV store")
+	s.Require().Regexp("TiFlash store 5 .* available is 50B, but we need 100B, short of 50B", result.Message)
+
+	s.mockTarget.StorageInfos[4].AvailableSize = 200
+	result, err = ci.Check(ctx)
+	s.Require().NoError(err)
+	s.T().Logf("check result message: %s", result.Message)
+	s.Require().True(result.Passed)
+	s.Require().Regexp("TiFlash available is 350B, we need 200B", result.Message)
+}
+
 func (s *precheckImplSuite) TestClusterVersionCheckBasic() {
 	var (
 		err    error
@@ -520,6 +566,85 @@ func (s *precheckImplSuite) TestCSVHeaderCheckBasic() {
 	s.Require().False(result.Passed)
 }
 
+func (s *precheckImplSuite) TestSourceDataFormatCheckBasic() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testCases := []struct {
+		header   bool
+		data     string
+		passed   bool
+		severity CheckType
+		msg      string
+	}{
+		{
+			header: true,
+			data:   "ival,sval\n111,\"aaa\"\n222,\"bbb\"\n",
+			passed: true,
+		},
+		{
+			header:   true,
+			data:     "ival,sval\n111,\"aaa\"\n222,\"bbb\",333\n",
+			passed:   false,
+			severity: Critical,
+			msg:      "row 2 has 3 columns, but the header has 2 columns",
+		},
+		{
+			header:   false,
+			data:     "1,111,\"aaa\",444\n",
+			passed:   false,
+			severity: Critical,
+			msg:      "the first row has 4 columns, but the table has only 3 columns",
+		},
+		{
+			header:   true,
+			data:     "ival,sval\n111,\"aaa\"bbb\n",
+			passed:   false,
+			severity: Critical,
+			msg:      "the rows can't be parsed",
+		},
+		{
+			header:   true,
+			data:     "ival,sval\n111,\"a\xffa\"\n",
+			passed:   false,
+			severity: Warn,
+			msg:      "row 1 column 2 contains invalid UTF-8 characters",
+		},
+	}
+	for _, tc := range testCases {
+		s.cfg.Mydumper.CSV.Header = tc.header
+		testMockSrcData := s.generateMockData(1, 1, 1,
+			func(dbName string, tblName string) string {
+				return fmt.Sprintf("CREATE TABLE %s.%s ( id INTEGER PRIMARY KEY AUTO_INCREMENT, ival INTEGER, sval VARCHAR(64) );", dbName, tblName)
+			},
+			func(dbID int, tblID int, fileID int) ([]byte, int, string) {
+				return []byte(tc.data), len(tc.data), "csv"
+			},
+		)
+		s.Require().NoError(s.setMockImportData(testMockSrcData))
+		ci := NewSourceDataFormatCheckItem(s.cfg, s.preInfoGetter, s.mockSrc.GetAllDBFileMetas(), nil)
+		s.Require().Equal(CheckSourceDataFormat, ci.GetCheckItemID())
+		result, err := ci.Check(ctx)
+		s.Require().NoError(err)
+		s.Require().NotNil(result)
+		s.Require().Equal(ci.GetCheckItemID(), result.Item)
+		s.T().Logf("check result message: %s", result.Message)
+		s.Require().Equal(tc.passed, result.Passed, tc.data)
+		if !tc.passed {
+			s.Require().Equal(tc.severity, result.Severity, tc.data)
+			s.Require().Contains(result.Message, tc.msg)
+			s.Require().Contains(result.Message, "/db1/tbl1/data.1.csv")
+		}
+	}
+
+	// the check is skipped without sample rows.
+	s.cfg.Mydumper.PrecheckSampleRows = 0
+	ci := NewSourceDataFormatCheckItem(s.cfg, s.preInfoGetter, s.mockSrc.GetAllDBFileMetas(), nil)
+	result, err := ci.Check(ctx)
+	s.Require().NoError(err)
+	s.Require().Nil(result)
+}
+
 func (s *precheckImplSuite) TestTableEmptyCheckBasic() {
 	var (
 		err    error
@@ -557,3 +682,203 @@ func (s *precheckImplSuite) TestTableEmptyCheckBasic() {
 	s.T().Logf("check result message: %s", result.Message)
 	s.Require().False(result.Passed)
 }
+
+func (s *precheckImplSuite) TestTargetPrivilegesCheckBasic() {
+	var (
+		err    error
+		ci     PrecheckItem
+		result *CheckResult
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testMockSrcData := s.generateMockData(1, 2, 1,
+		func(dbName string, tblName string) string {
+			return fmt.Sprintf("CREATE TABLE %s.%s ( id INTEGER PRIMARY KEY AUTO_INCREMENT, ival INTEGER, sval VARCHAR(64) );", dbName, tblName)
+		},
+		func(dbID int, tblID int, fileID int) ([]byte, int, string) {
+			return []byte(nil), 100, "csv"
+		},
+	)
+	s.Require().NoError(s.setMockImportData(testMockSrcData))
+	ci = NewTargetPrivilegesCheckItem(s.preInfoGetter, s.mockSrc.GetAllDBFileMetas())
+	s.Require().Equal(CheckTargetPrivileges, ci.GetCheckItemID())
+
+	s.mockTarget.CurrentUserGrants = []string{"GRANT ALL PRIVILEGES ON *.* TO 'root'@'%'"}
+	result, err = ci.Check(ctx)
+	s.Require().NoError(err)
+	s.Require().NotNil(result)
+	s.Require().Equal(ci.GetCheckItemID(), result.Item)
+	s.T().Logf("check result message: %s", result.Message)
+	s.Require().True(result.Passed)
+
+	// the dynamic privileges aren't included in ALL PRIVILEGES
+	s.mockTarget.SEMEnabled = true
+	result, err = ci.Check(ctx)
+	s.Require().NoError(err)
+	s.T().Logf("check result message: %s", result.Message)
+	s.Require().False(result.Passed)
+	s.Require().Contains(result.Message, "RESTRICTED_TABLES_ADMIN, RESTRICTED_VARIABLES_ADMIN on *.*")
+	s.Require().NotContains(result.Message, "`db1`")
+
+	s.mockTarget.CurrentUserGrants = []string{
+		"GRANT USAGE ON *.* TO 'u'@'%'",
+		"GRANT RESTRICTED_TABLES_ADMIN,RESTRICTED_VARIABLES_ADMIN ON *.* TO 'u'@'%'",
+		"GRANT SELECT,INSERT,UPDATE,DELETE ON `db\\_1`.* TO 'u'@'%'",
+		"GRANT SELECT,INSERT,UPDATE,DELETE ON `db_`.* TO 'u'@'%'",
+		"GRANT 'r1'@'%' TO 'u'@'%'",
+	}
+	result, err = ci.Check(ctx)
+	s.Require().NoError(err)
+	s.T().Logf("check result message: %s", result.Message)
+	s.Require().False(result.Passed)
+	s.Require().Equal("the target user lacks the privileges: CREATE, ALTER, DROP on `db1`.*", result.Message)
+
+	s.mockTarget.CurrentUserGrants = append(s.mockTarget.CurrentUserGrants,
+		"GRANT CREATE,DROP,ALTER ON `db1`.`tbl1` TO 'u'@'%'",
+		"GRANT CREATE,ALTER(sval) ON `db1`.`tbl2` TO 'u'@'%'",
+	)
+	result, err = ci.Check(ctx)
+	s.Require().NoError(err)
+	s.T().Logf("check result message: %s", result.Message)
+	s.Require().False(result.Passed)
+	s.Require().Equal("the target user lacks the privileges: ALTER, DROP on `db1`.`tbl2`", result.Message)
+
+	s.mockTarget.CurrentUserGrants = append(s.mockTarget.CurrentUserGrants,
+		"GRANT DROP,ALTER ON `db1`.`tbl2` TO 'u'@'%'",
+	)
+	result, err = ci.Check(ctx)
+	s.Require().NoError(err)
+	s.T().Logf("check result message: %s", result.Message)
+	s.Require().True(result.Passed)
+}
+
+func (s *precheckImplSuite) TestGeneratedColumnsCheckBasic() {
+	var (
+		err    error
+		ci     PrecheckItem
+		result *CheckResult
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testMockSrcData := s.generateMockData(1, 1, 1,
+		func(dbName string, tblName string) string {
+			return fmt.Sprintf("CREATE TABLE %s.%s ( id INTEGER PRIMARY KEY, ival INTEGER AS (id + 1) STORED, sval VARCHAR(64) AS (CONCAT(id, '-', ival)) VIRTUAL );", dbName, tblName)
+		},
+		func(dbID int, tblID int, fileID int) ([]byte, int, string) {
+			return []byte(nil), 100, "csv"
+		},
+	)
+	s.Require().NoError(s.setMockImportData(testMockSrcData))
+	ci = NewGeneratedColumnsCheckItem(s.cfg, s.preInfoGetter, s.mockSrc.GetAllDBFileMetas())
+	s.Require().Equal(CheckGeneratedColumns, ci.GetCheckItemID())
+	result, err = ci.Check(ctx)
+	s.Require().NoError(err)
+	s.Require().NotNil(result)
+	s.Require().Equal(ci.GetCheckItemID(), result.Item)
+	s.T().Logf("check result message: %s", result.Message)
+	s.Require().True(result.Passed)
+
+	testMockSrcData = s.generateMockData(1, 1, 1,
+		func(dbName string, tblName string) string {
+			return fmt.Sprintf("CREATE TABLE %s.%s ( id INTEGER PRIMARY KEY, sval VARBINARY(64) AS (RANDOM_BYTES(8)) STORED );", dbName, tblName)
+		},
+		func(dbID int, tblID int, fileID int) ([]byte, int, string) {
+			return []byte(nil), 100, "csv"
+		},
+	)
+	s.Require().NoError(s.setMockImportData(testMockSrcData))
+	ci = NewGeneratedColumnsCheckItem(s.cfg, s.preInfoGetter, s.mockSrc.GetAllDBFileMetas())
+	result, err = ci.Check(ctx)
+	s.Require().NoError(err)
+	s.T().Logf("check result message: %s", result.Message)
+	s.Require().False(result.Passed)
+	s.Require().Contains(result.Message, "the generated column `sval` of `db1`.`tbl1` uses the non-deterministic function random_bytes()")
+}
+
+func (s *precheckImplSuite) TestLogBackupCheckBasic() {
+	var (
+		err    error
+		ci     PrecheckItem
+		result *CheckResult
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testMockSrcData := s.generateMockData(1, 2, 1,
+		func(dbName string, tblName string) string {
+			return fmt.Sprintf("CREATE TABLE %s.%s ( id INTEGER PRIMARY KEY );", dbName, tblName)
+		},
+		func(dbID int, tblID int, fileID int) ([]byte, int, string) {
+			return []byte(nil), 100, "csv"
+		},
+	)
+	s.Require().NoError(s.setMockImportData(testMockSrcData))
+	for i := 1; i <= 2; i++ {
+		tblName := fmt.Sprintf("tbl%d", i)
+		tblInfo, err := newTableInfo(fmt.Sprintf("CREATE TABLE %s ( id INTEGER PRIMARY KEY );", tblName), int64(100+i))
+		s.Require().NoError(err)
+		s.mockTarget.SetTableInfo("db1", tblName, &mock.MockTableInfo{TableModel: tblInfo})
+	}
+	tableID := int64(101)
+	recordPrefix := tablecodec.GenTableRecordPrefix(tableID)
+
+	var tasks []logBackupTask
+	newCheckItem := func() PrecheckItem {
+		ci := NewLogBackupCheckItem(s.cfg, s.preInfoGetter, s.mockSrc.GetAllDBFileMetas())
+		ci.(*logBackupCheckItem).getTasks = func(context.Context) ([]logBackupTask, error) {
+			return tasks, nil
+		}
+		return ci
+	}
+
+	// no log backup task is running.
+	ci = newCheckItem()
+	s.Require().Equal(CheckLogBackupTask, ci.GetCheckItemID())
+	result, err = ci.Check(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(ci.GetCheckItemID(), result.Item)
+	s.Require().True(result.Passed)
+
+	// the log backup task observes the other tables.
+	tasks = []logBackupTask{{name: "other", ranges: []tidbkv.KeyRange{{
+		StartKey: tablecodec.GenTableRecordPrefix(tableID + 1000),
+		EndKey:   tablecodec.GenTableRecordPrefix(tableID + 1000).PrefixNext(),
+	}}}}
+	result, err = newCheckItem().Check(ctx)
+	s.Require().NoError(err)
+	s.Require().True(result.Passed)
+
+	// the log backup task observes `db1`.`tbl1`.
+	tasks = append(tasks, logBackupTask{name: "pitr", ranges: []tidbkv.KeyRange{{
+		StartKey: recordPrefix,
+		EndKey:   recordPrefix.PrefixNext(),
+	}}})
+	result, err = newCheckItem().Check(ctx)
+	s.Require().NoError(err)
+	s.T().Logf("check result message: %s", result.Message)
+	s.Require().False(result.Passed)
+	s.Require().Equal(Critical, result.Severity)
+	s.Require().Contains(result.Message, "the log backup task pitr observes the tables `db1`.`tbl1`,")
+	s.Require().NotContains(result.Message, "`db1`.`tbl2`")
+
+	// the log backup task observes all the tables.
+	tasks = []logBackupTask{{name: "pitr", ranges: []tidbkv.KeyRange{{
+		StartKey: tablecodec.TablePrefix(),
+		EndKey:   tidbkv.Key(tablecodec.TablePrefix()).PrefixNext(),
+	}}}}
+	result, err = newCheckItem().Check(ctx)
+	s.Require().NoError(err)
+	s.Require().False(result.Passed)
+	s.Require().Contains(result.Message, "`db1`.`tbl1`")
+	s.Require().Contains(result.Message, "`db1`.`tbl2`")
+
+	// the import is allowed with a warning.
+	s.cfg.TikvImporter.AllowImportDuringLogBackup = true
+	result, err = newCheckItem().Check(ctx)
+	s.Require().NoError(err)
+	s.Require().False(result.Passed)
+	s.Require().Equal(Warn, result.Severity)
+	s.Require().Contains(result.Message, "please take a full backup after the import")
+}
@@ -59,9 +59,9 @@ import (
 	"github.com/pingcap/tidb/store/driver"
 	"github.com/pingcap/tidb/util/collate"
 	"github.com/pingcap/tidb/util/mathutil"
+	filter "github.com/pingcap/tidb/util/table-filter"
 	pd "github.com/tikv/pd/client"
 	"go.uber.org/atomic"
-	"go.uber.org/multierr"
 	"go.uber.org/zap"
 )
 
@@ -181,12 +181,6 @@ func (es *errorSummaries) record(tableName string, err error, status checkpoints
 	es.summary[tableName] = errorSummary{status: status, err: err}
 }
 
-const (
-	diskQuotaStateIdle int32 = iota
-	diskQuotaStateChecking
-	diskQuotaStateImporting
-)
-
 type Controller struct {
 	taskCtx       context.Context
 	cfg           *config.Config
@@ -197,9 +191,19 @@ type Controller struct {
 	regionWorkers *worker.Pool
 	ioWorkers     *worker.Pool
 	checksumWorks *worker.Pool
+	analyzeWg     sync.WaitGroup
 	pauser        *common.Pauser
+	importControl *ImportControl
 	backend       backend.Backend
 	tidbGlue      glue.Glue
+	// tidbBackend imports the tables in tidbBackendTables, which are overridden
+	// by `[[tikv-importer.backend-override]]` to be imported by the TiDB backend
+	// in a local backend task. It's nil if there are no such tables.
+	tidbBackend       backend.Backend
+	tidbBackendTables map[string]struct{}
+	// noLocalBackendTable is true if all the tables of the local backend task
+	// are imported by the TiDB backend, so TiKV needn't switch to import mode.
+	noLocalBackendTable bool
 
 	alterTableLock sync.Mutex
 	sysVars        map[string]string
@@ -219,10 +223,10 @@ type Controller struct {
 	errorMgr          *errormanager.ErrorManager
 	taskMgr           taskMetaMgr
 
-	diskQuotaLock  sync.RWMutex
-	diskQuotaState atomic.Int32
-	compactState   atomic.Int32
-	status         *LightningStatus
+	diskQuota    diskQuotaController
+	compactState atomic.Int32
+	status       *LightningStatus
+	eta          *etaEstimator
 
 	preInfoGetter       PreRestoreInfoGetter
 	precheckItemBuilder *PrecheckItemBuilder
@@ -245,6 +249,8 @@ type ControllerParam struct {
 	OwnExtStorage bool
 	// used by lightning server mode to pause tasks
 	Pauser *common.Pauser
+	// used by lightning server mode to control the tables, the engines and the concurrency
+	ImportControl *ImportControl
 	// lightning via SQL will implement its glue, to let lightning use host TiDB's environment
 	Glue glue.Glue
 	// storage interface to write file checkpoints
@@ -259,6 +265,7 @@ func NewRestoreController(
 	param *ControllerParam,
 ) (*Controller, error) {
 	param.Pauser = DeliverPauser
+	param.ImportControl = DefaultImportControl
 	return NewRestoreControllerWithPauser(ctx, cfg, param)
 }
 
@@ -350,6 +357,15 @@ func NewRestoreControllerWithPauser(
 		return nil, common.ErrUnknownBackend.GenWithStackByArgs(cfg.TikvImporter.Backend)
 	}
 
+	tidbBackendTables, err := getTiDBBackendTables(cfg, p.DBMetas)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var tidbBackend backend.Backend
+	if len(tidbBackendTables) > 0 {
+		tidbBackend = tidb.NewTiDBBackend(ctx, db, cfg.TikvImporter.OnDuplicate, errorMgr)
+	}
+
 	var metaBuilder metaMgrBuilder
 	isSSTImport := cfg.TikvImporter.Backend == config.BackendLocal
 	switch {
@@ -400,8 +416,14 @@ func NewRestoreControllerWithPauser(
 		ioWorkers:     ioWorkers,
 		checksumWorks: worker.NewPool(ctx, cfg.TiDB.ChecksumTableConcurrency, "checksum"),
 		pauser:        p.Pauser,
+		importControl: p.ImportControl,
 		backend:       backend,
 		tidbGlue:      p.Glue,
+
+		tidbBackend:         tidbBackend,
+		tidbBackendTables:   tidbBackendTables,
+		noLocalBackendTable: len(tidbBackendTables) > 0 && len(tidbBackendTables) == countTables(p.DBMetas),
+
 		sysVars:       defaultImportantVariables,
 		tls:           tls,
 		checkTemplate: NewSimpleTemplate(),
@@ -417,16 +439,24 @@ func NewRestoreControllerWithPauser(
 		errorMgr:       errorMgr,
 		status:         p.Status,
 		taskMgr:        nil,
+		eta:            newETAEstimator(etaWindow),
 
 		preInfoGetter:       preInfoGetter,
 		precheckItemBuilder: preCheckBuilder,
 	}
+	// only local storage has disk quota concern.
+	if isLocalBackend(cfg) && cfg.Cron.CheckDiskQuota.Duration > 0 {
+		rc.diskQuota.enable(capDiskQuota(ctx, cfg))
+	}
 
 	return rc, nil
 }
 
 func (rc *Controller) Close() {
 	rc.backend.Close()
+	if rc.tidbBackend != nil {
+		rc.tidbBackend.Close()
+	}
 	rc.tidbGlue.GetSQLExecutor().Close()
 }
 
@@ -561,7 +591,7 @@ func (worker *restoreSchemaWorker) makeJobs(
 				// we already has this table in TiDB.
 				// we should skip ddl job and let SchemaValid check.
 				continue
-			} else if tblMeta.SchemaFile.FileMeta.Path == "" {
+			} else if !tblMeta.HasSchema() {
 				return common.ErrSchemaNotExists.GenWithStackByArgs(dbMeta.Name, tblMeta.Name)
 			}
 			sql, err := tblMeta.GetSchema(worker.ctx, worker.store)
@@ -912,7 +942,8 @@ func (rc *Controller) estimateChunkCountIntoMetrics(ctx context.Context) error {
 				}
 				if fileMeta.FileMeta.Type == mydump.SourceTypeCSV {
 					cfg := rc.cfg.Mydumper
-					if fileMeta.FileMeta.FileSize > int64(cfg.MaxRegionSize) && cfg.StrictFormat && !cfg.CSV.Header {
+					if fileMeta.FileMeta.FileSize > int64(cfg.MaxRegionSize) && cfg.StrictFormat && !cfg.CSV.Header &&
+						fileMeta.FileMeta.Compression == mydump.CompressionNone {
 						estimatedChunkCount += math.Round(float64(fileMeta.FileMeta.FileSize) / float64(cfg.MaxRegionSize))
 					} else {
 						estimatedChunkCount++
@@ -1103,9 +1134,14 @@ func (rc *Controller) buildRunPeriodicActionAndCancelFunc(ctx context.Context, s
 		glueProgressTicker.Stop()
 	})
 
+	etaTicker := time.NewTicker(etaSampleInterval)
+	closeFuncs = append(closeFuncs, func() {
+		etaTicker.Stop()
+	})
+
 	var switchModeChan <-chan time.Time
 	// tidb backend don't need to switch tikv to import mode
-	if rc.cfg.TikvImporter.Backend != config.BackendTiDB && rc.cfg.Cron.SwitchMode.Duration > 0 {
+	if rc.cfg.TikvImporter.Backend != config.BackendTiDB && !rc.noLocalBackendTable && rc.cfg.Cron.SwitchMode.Duration > 0 {
 		switchModeTicker := time.NewTicker(rc.cfg.Cron.SwitchMode.Duration)
 		cancelFuncs = append(cancelFuncs, func(bool) { switchModeTicker.Stop() })
 		cancelFuncs = append(cancelFuncs, func(do bool) {
@@ -1231,8 +1267,14 @@ func (rc *Controller) buildRunPeriodicActionAndCancelFunc(ctx context.Context, s
 							totalPercent = restorePercent
 						}
 						if totalPercent < 1.0 {
-							remainNanoseconds := (1.0 - totalPercent) / totalPercent * nanoseconds
-							remaining = zap.Duration("remaining", time.Duration(remainNanoseconds).Round(time.Second))
+							// prefer the estimation from the recent throughput of the phases, it falls back to
+							// the average speed since the start if the throughput isn't measured yet.
+							if remainDuration, ok, _ := rc.eta.estimate(); ok {
+								remaining = zap.Duration("remaining", remainDuration)
+							} else {
+								remainNanoseconds := (1.0 - totalPercent) / totalPercent * nanoseconds
+								remaining = zap.Duration("remaining", time.Duration(remainNanoseconds).Round(time.Second))
+							}
 						}
 						restoreBytesField = zap.String("restore-bytes", fmt.Sprintf("%s/%s",
 							units.BytesSize(restoredBytes), units.BytesSize(totalRestoreBytes)))
@@ -1275,6 +1317,9 @@ func (rc *Controller) buildRunPeriodicActionAndCancelFunc(ctx context.Context, s
 						finished := metric.ReadCounter(m.ChunkCounter.WithLabelValues(metric.ChunkStateFinished))
 						rc.tidbGlue.Record(glue.RecordFinishedChunk, uint64(finished))
 					}
+
+				case now := <-etaTicker.C:
+					rc.reportETA(ctx, now)
 				}
 			}
 		}, func(do bool) {
@@ -1492,6 +1537,9 @@ func (rc *Controller) restoreTables(ctx context.Context) (finalErr error) {
 
 	defer close(stopPeriodicActions)
 
+	// the tables analyzed in background are waited after switching back to
+	// the normal mode.
+	defer rc.analyzeWg.Wait()
 	defer func() {
 		finishSchedulers()
 		cancelFunc(switchBack)
@@ -1529,7 +1577,11 @@ func (rc *Controller) restoreTables(ctx context.Context) (finalErr error) {
 				tableLogTask := task.tr.logger.Begin(zap.InfoLevel, "restore table")
 				web.BroadcastTableCheckpoint(task.tr.tableName, task.cp)
 
-				needPostProcess, err := task.tr.restoreTable(ctx, rc, task.cp)
+				tableCtx := rc.importControl.startTable(ctx, task.tr.tableName)
+				needPostProcess, err := task.tr.restoreTable(tableCtx, rc, task.cp)
+				if err != nil && rc.importControl.isTableAborted(task.tr.tableName) {
+					err = common.ErrTableAborted.GenWithStackByArgs(task.tr.tableName)
+				}
 
 				err = common.NormalizeOrWrapErr(common.ErrRestoreTable, err, task.tr.tableName)
 				tableLogTask.End(zap.ErrorLevel, err)
@@ -1540,6 +1592,8 @@ func (rc *Controller) restoreTables(ctx context.Context) (finalErr error) {
 				restoreErr.Set(err)
 				if needPostProcess {
 					postProcessTaskChan <- task
+				} else {
+					rc.eta.removeTable(task.tr.tableName)
 				}
 				wg.Done()
 			}
@@ -1572,10 +1626,14 @@ func (rc *Controller) restoreTables(ctx context.Context) (finalErr error) {
 			allTasks = append(allTasks, task{tr: tr, cp: cp})
 
 			if len(cp.Engines) == 0 {
+				tableSize := int64(0)
 				for _, fi := range tableMeta.DataFiles {
-					totalDataSizeToRestore += fi.FileMeta.FileSize
+					tableSize += fi.FileMeta.RealSize
 				}
+				totalDataSizeToRestore += tableSize
+				rc.eta.registerTable(tableName, cp, tableSize)
 			} else {
+				rc.eta.registerTable(tableName, cp, 0)
 				for _, eng := range cp.Engines {
 					for _, chunk := range eng.Chunks {
 						totalDataSizeToRestore += chunk.Chunk.EndOffset - chunk.Chunk.Offset
@@ -1589,6 +1647,16 @@ func (rc *Controller) restoreTables(ctx context.Context) (finalErr error) {
 		m.BytesCounter.WithLabelValues(metric.BytesStateTotalRestore).Add(float64(totalDataSizeToRestore))
 	}
 
+	tableNames := make([]string, 0, len(allTasks))
+	for _, t := range allTasks {
+		tableNames = append(tableNames, t.tr.tableName)
+	}
+	rc.importControl.reset(tableNames)
+	rc.importControl.registerPool(PoolRegion, rc.regionWorkers)
+	rc.importControl.registerPool(PoolIO, rc.ioWorkers)
+	rc.importControl.registerPool(PoolTable, rc.tableWorkers)
+	rc.importControl.registerPool(PoolIndex, rc.indexWorkers)
+
 	for i := range allTasks {
 		wg.Add(1)
 		select {
@@ -1622,6 +1690,7 @@ func (rc *Controller) restoreTables(ctx context.Context) (finalErr error) {
 					metaMgr := rc.metaMgrBuilder.TableMetaMgr(task.tr)
 					// force all the remain post-process tasks to be executed
 					_, err2 := task.tr.postProcess(ctx, rc, task.cp, true, metaMgr)
+					rc.eta.removeTable(task.tr.tableName)
 					restoreErr.Set(err2)
 				}
 			}()
@@ -1652,6 +1721,11 @@ func (tr *TableRestore) restoreTable(
 			zap.Int("enginesCnt", len(cp.Engines)),
 			zap.Int("filesCnt", cp.CountChunks()),
 		)
+		for _, engine := range cp.Engines {
+			for _, chunk := range engine.Chunks {
+				chunk.FileMeta.DataCharacterSet = tr.charsetOfFiles[chunk.Key.Path]
+			}
+		}
 	} else if cp.Status < checkpoints.CheckpointStatusAllWritten {
 		if err := tr.populateChunks(ctx, rc, cp); err != nil {
 			return false, errors.Trace(err)
@@ -1673,7 +1747,7 @@ func (tr *TableRestore) restoreTable(
 		versionInfo := version.ParseServerInfo(versionStr)
 
 		// "show table next_row_id" is only available after tidb v4.0.0
-		if versionInfo.ServerVersion.Major >= 4 && isLocalBackend(rc.cfg) {
+		if versionInfo.ServerVersion.Major >= 4 && rc.isLocalBackendTable(tr.tableName) {
 			// first, insert a new-line into meta table
 			if err = metaMgr.InitTableMeta(ctx); err != nil {
 				return false, err
@@ -1723,6 +1797,9 @@ func (tr *TableRestore) restoreTable(
 		}
 	}
 
+	// the size of the table is known after the chunks are populated.
+	rc.eta.registerTable(tr.tableName, cp, 0)
+
 	// 2. Restore engines (if still needed)
 	err := tr.restoreEngines(ctx, rc, cp)
 	if err != nil {
@@ -1783,7 +1860,7 @@ func (rc *Controller) switchToNormalMode(ctx context.Context) {
 
 func (rc *Controller) switchTiKVMode(ctx context.Context, mode sstpb.SwitchMode) {
 	// // tidb backend don't need to switch tikv to import mode
-	if isTiDBBackend(rc.cfg) {
+	if isTiDBBackend(rc.cfg) || rc.noLocalBackendTable {
 		return
 	}
 
@@ -1812,94 +1889,6 @@ func (rc *Controller) switchTiKVMode(ctx context.Context, mode sstpb.SwitchMode)
 	)
 }
 
-func (rc *Controller) enforceDiskQuota(ctx context.Context) {
-	if !rc.diskQuotaState.CAS(diskQuotaStateIdle, diskQuotaStateChecking) {
-		// do not run multiple the disk quota check / import simultaneously.
-		// (we execute the lock check in background to avoid blocking the cron thread)
-		return
-	}
-
-	go func() {
-		// locker is assigned when we detect the disk quota is exceeded.
-		// before the disk quota is confirmed exceeded, we keep the diskQuotaLock
-		// unlocked to avoid periodically interrupting the writer threads.
-		var locker sync.Locker
-		defer func() {
-			rc.diskQuotaState.Store(diskQuotaStateIdle)
-			if locker != nil {
-				locker.Unlock()
-			}
-		}()
-
-		isRetrying := false
-
-		for {
-			// sleep for a cycle if we are retrying because there is nothing new to import.
-			if isRetrying {
-				select {
-				case <-ctx.Done():
-					return
-				case <-time.After(rc.cfg.Cron.CheckDiskQuota.Duration):
-				}
-			} else {
-				isRetrying = true
-			}
-
-			quota := int64(rc.cfg.TikvImporter.DiskQuota)
-			largeEngines, inProgressLargeEngines, totalDiskSize, totalMemSize := rc.backend.CheckDiskQuota(quota)
-			if m, ok := metric.FromContext(ctx); ok {
-				m.LocalStorageUsageBytesGauge.WithLabelValues("disk").Set(float64(totalDiskSize))
-				m.LocalStorageUsageBytesGauge.WithLabelValues("mem").Set(float64(totalMemSize))
-			}
-
-			logger := log.FromContext(ctx).With(
-				zap.Int64("diskSize", totalDiskSize),
-				zap.Int64("memSize", totalMemSize),
-				zap.Int64("quota", quota),
-				zap.Int("largeEnginesCount", len(largeEngines)),
-				zap.Int("inProgressLargeEnginesCount", inProgressLargeEngines))
-
-			if len(largeEngines) == 0 && inProgressLargeEngines == 0 {
-				logger.Debug("disk quota respected")
-				return
-			}
-
-			if locker == nil {
-				// blocks all writers when we detected disk quota being exceeded.
-				rc.diskQuotaLock.Lock()
-				locker = &rc.diskQuotaLock
-			}
-
-			logger.Warn("disk quota exceeded")
-			if len(largeEngines) == 0 {
-				logger.Warn("all large engines are already importing, keep blocking all writes")
-				continue
-			}
-
-			// flush all engines so that checkpoints can be updated.
-			if err := rc.backend.FlushAll(ctx); err != nil {
-				logger.Error("flush engine for disk quota failed, check again later", log.ShortError(err))
-				return
-			}
-
-			// at this point, all engines are synchronized on disk.
-			// we then import every large engines one by one and complete.
-			// if any engine failed to import, we just try again next time, since the data are still intact.
-			rc.diskQuotaState.Store(diskQuotaStateImporting)
-			task := logger.Begin(zap.WarnLevel, "importing large engines for disk quota")
-			var importErr error
-			for _, engine := range largeEngines {
-				// Use a larger split region size to avoid split the same region by many times.
-				if err := rc.backend.UnsafeImportAndReset(ctx, engine, int64(config.SplitRegionSize)*int64(config.MaxSplitRegionSizeRatio), int64(config.SplitRegionKeys)*int64(config.MaxSplitRegionSizeRatio)); err != nil {
-					importErr = multierr.Append(importErr, err)
-				}
-			}
-			task.End(zap.ErrorLevel, importErr)
-			return
-		}
-	}()
-}
-
 func (rc *Controller) setGlobalVariables(ctx context.Context) error {
 	// skip for tidb backend to be compatible with MySQL
 	if isTiDBBackend(rc.cfg) {
@@ -1959,6 +1948,71 @@ func isTiDBBackend(cfg *config.Config) bool {
 	return cfg.TikvImporter.Backend == config.BackendTiDB
 }
 
+// getTiDBBackendTables returns the unique names of the tables matching the
+// `[[tikv-importer.backend-override]]` rules, which are imported by the TiDB
+// backend instead of the local backend.
+func getTiDBBackendTables(cfg *config.Config, dbMetas []*mydump.MDDatabaseMeta) (map[string]struct{}, error) {
+	if !isLocalBackend(cfg) || len(cfg.TikvImporter.BackendOverrides) == 0 {
+		return nil, nil
+	}
+	filters := make([]filter.Filter, 0, len(cfg.TikvImporter.BackendOverrides))
+	for _, rule := range cfg.TikvImporter.BackendOverrides {
+		f, err := filter.Parse(rule.Tables)
+		if err != nil {
+			return nil, common.ErrInvalidConfig.Wrap(err).GenWithStack(
+				"invalid `tables` %s in [[tikv-importer.backend-override]]", strings.Join(rule.Tables, ","))
+		}
+		if !cfg.Mydumper.CaseSensitive {
+			f = filter.CaseInsensitive(f)
+		}
+		filters = append(filters, f)
+	}
+
+	tables := make(map[string]struct{})
+	for _, dbMeta := range dbMetas {
+		for _, tableMeta := range dbMeta.Tables {
+			for _, f := range filters {
+				if f.MatchTable(dbMeta.Name, tableMeta.Name) {
+					tables[common.UniqueTable(dbMeta.Name, tableMeta.Name)] = struct{}{}
+					break
+				}
+			}
+		}
+	}
+	return tables, nil
+}
+
+func countTables(dbMetas []*mydump.MDDatabaseMeta) int {
+	count := 0
+	for _, dbMeta := range dbMetas {
+		count += len(dbMeta.Tables)
+	}
+	return count
+}
+
+// isTiDBBackendTable checks whether the table is imported by the TiDB backend,
+// either the task uses the TiDB backend or the table is overridden to.
+func (rc *Controller) isTiDBBackendTable(tableName string) bool {
+	if isTiDBBackend(rc.cfg) {
+		return true
+	}
+	_, ok := rc.tidbBackendTables[tableName]
+	return ok && rc.tidbBackend != nil
+}
+
+// isLocalBackendTable checks whether the table is imported by the local backend.
+func (rc *Controller) isLocalBackendTable(tableName string) bool {
+	return isLocalBackend(rc.cfg) && !rc.isTiDBBackendTable(tableName)
+}
+
+// backendOf returns the backend which imports the table.
+func (rc *Controller) backendOf(tableName string) backend.Backend {
+	if rc.tidbBackend != nil && rc.isTiDBBackendTable(tableName) {
+		return rc.tidbBackend
+	}
+	return rc.backend
+}
+
 // preCheckRequirements checks
 // 1. Cluster resource
 // 2. Local node resource
@@ -1971,12 +2025,19 @@ func (rc *Controller) preCheckRequirements(ctx context.Context) error {
 	if err := rc.DataCheck(ctx); err != nil {
 		return errors.Trace(err)
 	}
+	if err := rc.checkLogBackupTask(ctx); err != nil {
+		return errors.Trace(err)
+	}
 
 	if rc.cfg.App.CheckRequirements {
 		if err := rc.ClusterIsAvailable(ctx); err != nil {
 			return errors.Trace(err)
 		}
 
+		if err := rc.checkTargetPrivileges(ctx); err != nil {
+			return errors.Trace(err)
+		}
+
 		if rc.ownStore {
 			if err := rc.StoragePermission(ctx); err != nil {
 				return errors.Trace(err)
@@ -2085,6 +2146,12 @@ func (rc *Controller) DataCheck(ctx context.Context) error {
 		if err := rc.checkSourceSchema(ctx); err != nil {
 			return errors.Trace(err)
 		}
+		if err := rc.checkSourceDataFormat(ctx); err != nil {
+			return errors.Trace(err)
+		}
+		if err := rc.checkGeneratedColumns(ctx); err != nil {
+			return errors.Trace(err)
+		}
 	}
 
 	if err := rc.checkTableEmpty(ctx); err != nil {
@@ -2101,6 +2168,9 @@ type chunkRestore struct {
 	parser mydump.Parser
 	index  int
 	chunk  *checkpoints.ChunkCheckpoint
+	// droppedOffsets are the sorted end offsets of the rows dropped by the
+	// pre-deduplication, the rows before the current offset are trimmed.
+	droppedOffsets []int64
 }
 
 func newChunkRestore(
@@ -2112,25 +2182,42 @@ func newChunkRestore(
 	store storage.ExternalStorage,
 	tableInfo *checkpoints.TidbTableInfo,
 ) (*chunkRestore, error) {
-	blockBufSize := int64(cfg.Mydumper.ReadBlockSize)
-
 	var reader storage.ReadSeekCloser
 	var err error
 	if chunk.FileMeta.Type == mydump.SourceTypeParquet {
 		reader, err = mydump.OpenParquetReader(ctx, store, chunk.FileMeta.Path, chunk.FileMeta.FileSize)
 	} else {
-		reader, err = store.Open(ctx, chunk.FileMeta.Path)
+		reader, err = mydump.OpenReader(ctx, &chunk.FileMeta, store, storage.DecompressConfig{
+			ZStdDecodeConcurrency: cfg.Mydumper.DecompressConcurrency,
+		})
 	}
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	return newChunkRestoreWithReader(ctx, index, cfg, chunk, reader, ioWorkers, store, tableInfo)
+}
+
+// newChunkRestoreWithReader creates a chunkRestore which parses the chunk from
+// the reader, e.g. the chunks of the stream source which are held in memory.
+func newChunkRestoreWithReader(
+	ctx context.Context,
+	index int,
+	cfg *config.Config,
+	chunk *checkpoints.ChunkCheckpoint,
+	reader storage.ReadSeekCloser,
+	ioWorkers *worker.Pool,
+	store storage.ExternalStorage,
+	tableInfo *checkpoints.TidbTableInfo,
+) (*chunkRestore, error) {
+	blockBufSize := int64(cfg.Mydumper.ReadBlockSize)
 
+	var err error
 	var parser mydump.Parser
 	switch chunk.FileMeta.Type {
 	case mydump.SourceTypeCSV:
 		hasHeader := cfg.Mydumper.CSV.Header && chunk.Chunk.Offset == 0
 		// Create a utf8mb4 convertor to encode and decode data with the charset of CSV files.
-		charsetConvertor, err := mydump.NewCharsetConvertor(cfg.Mydumper.DataCharacterSet, cfg.Mydumper.DataInvalidCharReplace)
+		charsetConvertor, err := mydump.NewFileCharsetConvertor(&cfg.Mydumper, chunk.FileMeta)
 		if err != nil {
 			return nil, err
 		}
@@ -2139,12 +2226,22 @@ func newChunkRestore(
 			return nil, errors.Trace(err)
 		}
 	case mydump.SourceTypeSQL:
-		parser = mydump.NewChunkParser(ctx, cfg.TiDB.SQLMode, reader, blockBufSize, ioWorkers)
+		// Create a utf8mb4 convertor to decode the strings with the charset of SQL files.
+		charsetConvertor, err := mydump.NewFileCharsetConvertor(&cfg.Mydumper, chunk.FileMeta)
+		if err != nil {
+			return nil, err
+		}
+		parser = mydump.NewSQLParser(ctx, &cfg.Mydumper, cfg.TiDB.SQLMode, chunk.FileMeta.Path, reader, blockBufSize, ioWorkers, charsetConvertor)
 	case mydump.SourceTypeParquet:
 		parser, err = mydump.NewParquetParser(ctx, store, reader, chunk.FileMeta.Path)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
+	case mydump.SourceTypeAvro:
+		parser, err = mydump.NewAvroParser(ctx, &cfg.Mydumper.Avro, reader)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
 	default:
 		panic(fmt.Sprintf("file '%s' with unknown source type '%s'", chunk.Key.Path, chunk.FileMeta.Type.String()))
 	}
@@ -2167,6 +2264,15 @@ func (cr *chunkRestore) close() {
 	_ = cr.parser.Close()
 }
 
+// isDropped checks whether the row ending at the offset is dropped by the
+// pre-deduplication. The offsets must be checked in the increasing order.
+func (cr *chunkRestore) isDropped(offset int64) bool {
+	for len(cr.droppedOffsets) > 0 && cr.droppedOffsets[0] < offset {
+		cr.droppedOffsets = cr.droppedOffsets[1:]
+	}
+	return len(cr.droppedOffsets) > 0 && cr.droppedOffsets[0] == offset
+}
+
 func getColumnNames(tableInfo *model.TableInfo, permutation []int) []string {
 	colIndexes := make([]int, 0, len(permutation))
 	for i := 0; i < len(permutation); i++ {
@@ -2228,8 +2334,12 @@ func (cr *chunkRestore) deliverLoop(
 		zap.String("task", "deliver"),
 	)
 	// Fetch enough KV pairs from the source.
-	dataKVs := rc.backend.MakeEmptyRows()
-	indexKVs := rc.backend.MakeEmptyRows()
+	dataKVs := rc.backendOf(t.tableName).MakeEmptyRows()
+	indexKVs := rc.backendOf(t.tableName).MakeEmptyRows()
+
+	// the writers are blocked if the engines are being imported for the disk quota.
+	_, dataEngineUUID := backend.MakeUUID(t.tableName, engineID)
+	_, indexEngineUUID := backend.MakeUUID(t.tableName, indexEngineID)
 
 	dataSynced := true
 	hasMoreKVs := true
@@ -2274,14 +2384,18 @@ func (cr *chunkRestore) deliverLoop(
 			// triggered, so that we can save chunkCheckpoint as soon as possible after `FlushEngine` is called.
 			// This implementation may not be very elegant or even completely correct, but it is currently a relatively
 			// simple and effective solution.
-			for !rc.diskQuotaLock.TryRLock() {
+			for {
+				gates, ok := rc.diskQuota.tryRLock(allEnginesGate, dataEngineUUID, indexEngineUUID)
+				if ok {
+					defer rUnlockGates(gates)
+					break
+				}
 				// try to update chunk checkpoint, this can help save checkpoint after importing when disk-quota is triggered
 				if !dataSynced {
 					dataSynced = cr.maybeSaveCheckpoint(rc, t, engineID, cr.chunk, dataEngine, indexEngine)
 				}
 				time.Sleep(time.Millisecond)
 			}
-			defer rc.diskQuotaLock.RUnlock()
 
 			// Write KVs into the engine
 			start := time.Now()
@@ -2315,6 +2429,9 @@ func (cr *chunkRestore) deliverLoop(
 			return
 		}
 		dataSynced = false
+		if rc.diskQuota.addWritten(int64(dataChecksum.SumSize() + indexChecksum.SumSize())) {
+			rc.enforceDiskQuota(ctx)
+		}
 
 		dataKVs = dataKVs.Clear()
 		indexKVs = indexKVs.Clear()
@@ -2329,6 +2446,9 @@ func (cr *chunkRestore) deliverLoop(
 		cr.chunk.Chunk.Offset = currOffset
 		cr.chunk.Chunk.PrevRowIDMax = rowID
 
+		if currOffset > startOffset {
+			rc.eta.advance(t.tableName, etaPhaseEncode, currOffset-startOffset)
+		}
 		if m, ok := metric.FromContext(ctx); ok {
 			// value of currOffset comes from parser.pos which increase monotonically. the init value of parser.pos
 			// comes from chunk.Chunk.Offset. so it shouldn't happen that currOffset - startOffset < 0.
@@ -2355,7 +2475,7 @@ func (cr *chunkRestore) deliverLoop(
 		// can safely update current checkpoint.
 
 		failpoint.Inject("LocalBackendSaveCheckpoint", func() {
-			if !isLocalBackend(rc.cfg) && (dataChecksum.SumKVS() != 0 || indexChecksum.SumKVS() != 0) {
+			if !rc.isLocalBackendTable(t.tableName) && (dataChecksum.SumKVS() != 0 || indexChecksum.SumKVS() != 0) {
 				// No need to save checkpoint if nothing was delivered.
 				saveCheckpoint(rc, t, engineID, cr.chunk)
 			}
@@ -2415,6 +2535,7 @@ func (cr *chunkRestore) encodeLoop(
 	ctx context.Context,
 	kvsCh chan<- []deliveredKVs,
 	t *TableRestore,
+	engineID int32,
 	logger log.Logger,
 	kvEncoder kv.Encoder,
 	deliverCompleteCh <-chan deliverResult,
@@ -2456,6 +2577,9 @@ func (cr *chunkRestore) encodeLoop(
 		if err = pauser.Wait(ctx); err != nil {
 			return
 		}
+		if err = rc.importControl.wait(ctx, t.tableName, engineID); err != nil {
+			return
+		}
 		offset, _ := cr.parser.Pos()
 		if offset >= cr.chunk.Chunk.EndOffset {
 			break
@@ -2512,8 +2636,16 @@ func (cr *chunkRestore) encodeLoop(
 				return
 			}
 			readDur += time.Since(readDurStart)
-			encodeDurStart := time.Now()
 			lastRow := cr.parser.LastRow()
+			if cr.isDropped(newOffset) {
+				cr.parser.RecycleRow(lastRow)
+				curOffset = newOffset
+				if newOffset >= cr.chunk.Chunk.EndOffset {
+					canDeliver = true
+				}
+				continue
+			}
+			encodeDurStart := time.Now()
 			// sql -> kv
 			kvs, encodeErr := kvEncoder.Encode(logger, lastRow.Row, lastRow.RowID, cr.chunk.ColumnPermutation, cr.chunk.Key.Path, curOffset)
 			encodeDur += time.Since(encodeDurStart)
@@ -2534,6 +2666,10 @@ func (cr *chunkRestore) encodeLoop(
 				return
 			}
 			if hasIgnoredEncodeErr {
+				// the skipped row may be the last one of the chunk.
+				if newOffset >= cr.chunk.Chunk.EndOffset {
+					canDeliver = true
+				}
 				continue
 			}
 
@@ -2580,8 +2716,12 @@ func (cr *chunkRestore) restore(
 	dataEngine, indexEngine *backend.LocalEngineWriter,
 	rc *Controller,
 ) error {
+	encTable, err := t.encTableOfFile(cr.chunk.Key.Path)
+	if err != nil {
+		return err
+	}
 	// Create the encoder.
-	kvEncoder, err := rc.backend.NewEncoder(ctx, t.encTable, &kv.SessionOptions{
+	kvEncoder, err := rc.backendOf(t.tableName).NewEncoder(ctx, encTable, &kv.SessionOptions{
 		SQLMode:   rc.cfg.TiDB.SQLMode,
 		Timestamp: cr.chunk.Timestamp,
 		SysVars:   rc.sysVars,
@@ -2611,7 +2751,7 @@ func (cr *chunkRestore) restore(
 		zap.Stringer("path", &cr.chunk.Key),
 	).Begin(zap.InfoLevel, "restore file")
 
-	readTotalDur, encodeTotalDur, encodeErr := cr.encodeLoop(ctx, kvsCh, t, logTask.Logger, kvEncoder, deliverCompleteCh, rc)
+	readTotalDur, encodeTotalDur, encodeErr := cr.encodeLoop(ctx, kvsCh, t, engineID, logTask.Logger, kvEncoder, deliverCompleteCh, rc)
 	var deliverErr error
 	select {
 	case deliverResult, ok := <-deliverCompleteCh:
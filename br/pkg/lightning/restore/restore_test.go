@@ -23,6 +23,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/backend/noop"
 	"github.com/pingcap/tidb/br/pkg/lightning/checkpoints"
 	"github.com/pingcap/tidb/br/pkg/lightning/common"
 	"github.com/pingcap/tidb/br/pkg/lightning/config"
@@ -205,6 +206,55 @@ func (cp panicCheckpointDB) Initialize(context.Context, *config.Config, map[stri
 	panic("should not reach here")
 }
 
+func TestTiDBBackendTables(t *testing.T) {
+	cfg := config.NewConfig()
+	cfg.TikvImporter.Backend = config.BackendLocal
+	cfg.TikvImporter.BackendOverrides = []*config.BackendOverride{
+		{Tables: []string{"db.small_*"}, Backend: config.BackendTiDB},
+		{Tables: []string{"Ref.*", "!ref.big"}, Backend: config.BackendTiDB},
+	}
+	dbMetas := []*mydump.MDDatabaseMeta{
+		{
+			Name: "db",
+			Tables: []*mydump.MDTableMeta{
+				{DB: "db", Name: "small_t1"},
+				{DB: "db", Name: "big"},
+			},
+		},
+		{
+			Name: "ref",
+			Tables: []*mydump.MDTableMeta{
+				{DB: "ref", Name: "t2"},
+				{DB: "ref", Name: "big"},
+			},
+		},
+	}
+	tables, err := getTiDBBackendTables(cfg, dbMetas)
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{
+		"`db`.`small_t1`": {},
+		"`ref`.`t2`":      {},
+	}, tables)
+
+	rc := &Controller{
+		cfg:               cfg,
+		tidbBackend:       noop.NewNoopBackend(),
+		tidbBackendTables: tables,
+	}
+	require.True(t, rc.isTiDBBackendTable("`db`.`small_t1`"))
+	require.False(t, rc.isLocalBackendTable("`db`.`small_t1`"))
+	require.False(t, rc.isTiDBBackendTable("`ref`.`big`"))
+	require.True(t, rc.isLocalBackendTable("`ref`.`big`"))
+
+	// the overrides only take effect on the local backend.
+	cfg.TikvImporter.Backend = config.BackendTiDB
+	tables, err = getTiDBBackendTables(cfg, dbMetas)
+	require.NoError(t, err)
+	require.Len(t, tables, 0)
+	require.True(t, rc.isTiDBBackendTable("`ref`.`big`"))
+	require.False(t, rc.isLocalBackendTable("`ref`.`big`"))
+}
+
 func TestPreCheckFailed(t *testing.T) {
 	cfg := config.NewConfig()
 	cfg.TikvImporter.Backend = config.BackendTiDB
@@ -16,6 +16,7 @@ package restore
 
 import (
 	"context"
+	"io"
 	"strings"
 	"sync"
 	"time"
@@ -58,6 +59,16 @@ type TableRestore struct {
 	kvStore   tidbkv.Storage
 
 	ignoreColumns map[string]struct{}
+	// partitionOfFiles maps the path of the source files routed to a partition
+	// to the partition name. The partition isn't saved in the checkpoints, so
+	// it's looked up by the path of the chunk.
+	partitionOfFiles map[string]string
+	// charsetOfFiles maps the path of the source files with the charsets set
+	// by `[[mydumper.files]]` to the charset. The charset isn't saved in the
+	// checkpoints either.
+	charsetOfFiles map[string]string
+	// preDedup records the source rows dropped by the pre-deduplication.
+	preDedup *preDedupResult
 }
 
 func NewTableRestore(
@@ -76,16 +87,37 @@ func NewTableRestore(
 		return nil, errors.Annotatef(err, "failed to tables.TableFromMeta %s", tableName)
 	}
 
+	var partitionOfFiles, charsetOfFiles map[string]string
+	if tableMeta != nil {
+		for _, file := range tableMeta.DataFiles {
+			if len(file.FileMeta.DataCharacterSet) > 0 {
+				if charsetOfFiles == nil {
+					charsetOfFiles = make(map[string]string)
+				}
+				charsetOfFiles[file.FileMeta.Path] = file.FileMeta.DataCharacterSet
+			}
+			if len(file.FileMeta.Partition) == 0 {
+				continue
+			}
+			if partitionOfFiles == nil {
+				partitionOfFiles = make(map[string]string)
+			}
+			partitionOfFiles[file.FileMeta.Path] = file.FileMeta.Partition
+		}
+	}
+
 	return &TableRestore{
-		tableName:     tableName,
-		dbInfo:        dbInfo,
-		tableInfo:     tableInfo,
-		tableMeta:     tableMeta,
-		encTable:      tbl,
-		alloc:         idAlloc,
-		kvStore:       kvStore,
-		logger:        logger.With(zap.String("table", tableName)),
-		ignoreColumns: ignoreColumns,
+		tableName:        tableName,
+		dbInfo:           dbInfo,
+		tableInfo:        tableInfo,
+		tableMeta:        tableMeta,
+		encTable:         tbl,
+		alloc:            idAlloc,
+		kvStore:          kvStore,
+		logger:           logger.With(zap.String("table", tableName)),
+		ignoreColumns:    ignoreColumns,
+		partitionOfFiles: partitionOfFiles,
+		charsetOfFiles:   charsetOfFiles,
 	}, nil
 }
 
@@ -94,6 +126,25 @@ func (tr *TableRestore) Close() {
 	tr.logger.Info("restore done")
 }
 
+// encTableOfFile returns the table to encode the rows of the source file with.
+// If the file is routed to a partition, its rows are only encoded into this
+// partition, and the rows belonging to other partitions fail to be encoded.
+func (tr *TableRestore) encTableOfFile(path string) (table.Table, error) {
+	partition, ok := tr.partitionOfFiles[path]
+	if !ok {
+		return tr.encTable, nil
+	}
+	partitionedTable, ok := tr.encTable.(table.PartitionedTable)
+	if !ok {
+		return nil, common.ErrUnknownPartition.GenWithStackByArgs(partition, tr.tableName)
+	}
+	def := tr.tableInfo.Core.FindPartitionDefinitionByName(partition)
+	if def == nil {
+		return nil, common.ErrUnknownPartition.GenWithStackByArgs(partition, tr.tableName)
+	}
+	return tables.NewPartitionTableWithGivenSets(partitionedTable, map[int64]struct{}{def.ID: {}}), nil
+}
+
 func (tr *TableRestore) populateChunks(ctx context.Context, rc *Controller, cp *checkpoints.TableCheckpoint) error {
 	task := tr.logger.Begin(zap.InfoLevel, "load engines and files")
 	chunks, err := mydump.MakeTableRegions(ctx, tr.tableMeta, len(tr.tableInfo.Core.Columns), rc.cfg, rc.ioWorkers, rc.store)
@@ -242,7 +293,7 @@ func (tr *TableRestore) restoreEngines(pCtx context.Context, rc *Controller, cp
 		indexWorker := rc.indexWorkers.Apply()
 		defer rc.indexWorkers.Recycle(indexWorker)
 
-		if rc.cfg.TikvImporter.Backend == config.BackendLocal {
+		if rc.isLocalBackendTable(tr.tableName) {
 			// for index engine, the estimate factor is non-clustered index count
 			idxCnt := len(tr.tableInfo.Core.Indices)
 			if !common.TableHasAutoRowID(tr.tableInfo.Core) {
@@ -264,7 +315,7 @@ func (tr *TableRestore) restoreEngines(pCtx context.Context, rc *Controller, cp
 				continue
 			}
 			if engine.Status < checkpoints.CheckpointStatusAllWritten {
-				indexEngine, err = rc.backend.OpenEngine(ctx, idxEngineCfg, tr.tableName, indexEngineID)
+				indexEngine, err = rc.backendOf(tr.tableName).OpenEngine(ctx, idxEngineCfg, tr.tableName, indexEngineID)
 				if err != nil {
 					return errors.Trace(err)
 				}
@@ -272,6 +323,12 @@ func (tr *TableRestore) restoreEngines(pCtx context.Context, rc *Controller, cp
 			}
 		}
 
+		if indexEngine != nil {
+			if err := tr.preDeduplicate(ctx, rc, cp); err != nil {
+				return errors.Trace(err)
+			}
+		}
+
 		logTask := tr.logger.Begin(zap.InfoLevel, "import whole table")
 		var wg sync.WaitGroup
 		var engineErr common.OnceError
@@ -319,14 +376,16 @@ func (tr *TableRestore) restoreEngines(pCtx context.Context, rc *Controller, cp
 				restoreWorker := rc.tableWorkers.Apply()
 				go func(w *worker.Worker, eid int32, ecp *checkpoints.EngineCheckpoint) {
 					defer wg.Done()
+					engineCtx, cancel := rc.importControl.startEngine(ctx, tr.tableName, eid)
+					defer cancel()
 					engineLogTask := tr.logger.With(zap.Int32("engineNumber", eid)).Begin(zap.InfoLevel, "restore engine")
-					dataClosedEngine, err := tr.restoreEngine(ctx, rc, indexEngine, eid, ecp)
+					dataClosedEngine, err := tr.restoreEngine(engineCtx, rc, indexEngine, eid, ecp)
 					engineLogTask.End(zap.ErrorLevel, err)
 					rc.tableWorkers.Recycle(w)
 					if err == nil {
 						dataWorker := rc.closedEngineLimit.Apply()
 						defer rc.closedEngineLimit.Recycle(dataWorker)
-						err = tr.importEngine(ctx, dataClosedEngine, rc, eid, ecp)
+						err = tr.importEngine(engineCtx, dataClosedEngine, rc, eid, ecp)
 						if rc.status != nil {
 							for _, chunk := range ecp.Chunks {
 								rc.status.FinishedFileSize.Add(chunk.Chunk.EndOffset - chunk.Key.Offset)
@@ -354,17 +413,21 @@ func (tr *TableRestore) restoreEngines(pCtx context.Context, rc *Controller, cp
 
 		if indexEngine != nil {
 			closedIndexEngine, restoreErr = indexEngine.Close(ctx, idxEngineCfg)
+			rc.diskQuota.removeGate(indexEngine.GetEngineUuid())
 		} else {
-			closedIndexEngine, restoreErr = rc.backend.UnsafeCloseEngine(ctx, idxEngineCfg, tr.tableName, indexEngineID)
+			closedIndexEngine, restoreErr = rc.backendOf(tr.tableName).UnsafeCloseEngine(ctx, idxEngineCfg, tr.tableName, indexEngineID)
 		}
 
 		if err = rc.saveStatusCheckpoint(ctx, tr.tableName, indexEngineID, restoreErr, checkpoints.CheckpointStatusClosed); err != nil {
 			return errors.Trace(firstErr(restoreErr, err))
 		}
+		if restoreErr == nil {
+			rc.eta.finishEngine(tr.tableName, etaPhaseSort, indexEngineID)
+		}
 	} else if indexEngineCp.Status == checkpoints.CheckpointStatusClosed {
 		// If index engine file has been closed but not imported only if context cancel occurred
 		// when `importKV()` execution, so `UnsafeCloseEngine` and continue import it.
-		closedIndexEngine, restoreErr = rc.backend.UnsafeCloseEngine(ctx, idxEngineCfg, tr.tableName, indexEngineID)
+		closedIndexEngine, restoreErr = rc.backendOf(tr.tableName).UnsafeCloseEngine(ctx, idxEngineCfg, tr.tableName, indexEngineID)
 	}
 	if restoreErr != nil {
 		return errors.Trace(restoreErr)
@@ -401,11 +464,14 @@ func (tr *TableRestore) restoreEngine(
 		engineCfg := &backend.EngineConfig{
 			TableInfo: tr.tableInfo,
 		}
-		closedEngine, err := rc.backend.UnsafeCloseEngine(ctx, engineCfg, tr.tableName, engineID)
+		closedEngine, err := rc.backendOf(tr.tableName).UnsafeCloseEngine(ctx, engineCfg, tr.tableName, engineID)
 		// If any error occurred, recycle worker immediately
 		if err != nil {
 			return closedEngine, errors.Trace(err)
 		}
+		if cp.Status < checkpoints.CheckpointStatusClosed {
+			rc.eta.finishEngine(tr.tableName, etaPhaseSort, engineID)
+		}
 		return closedEngine, nil
 	}
 
@@ -433,7 +499,7 @@ func (tr *TableRestore) restoreEngine(
 		dataEngineCfg.Local.CompactConcurrency = 4
 		dataEngineCfg.Local.CompactThreshold = compactionUpperThreshold
 	}
-	dataEngine, err := rc.backend.OpenEngine(ctx, dataEngineCfg, tr.tableName, engineID)
+	dataEngine, err := rc.backendOf(tr.tableName).OpenEngine(ctx, dataEngineCfg, tr.tableName, engineID)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -504,6 +570,14 @@ func (tr *TableRestore) restoreEngine(
 			break
 		}
 
+		if chunk.FileMeta.IsStream {
+			if err := tr.restoreStream(ctx, rc, engineID, chunk, dataEngine, indexEngine, dataWriterCfg); err != nil {
+				setError(err)
+				break
+			}
+			continue
+		}
+
 		// Flows :
 		// 	1. read mydump file
 		// 	2. sql -> kvs
@@ -514,6 +588,7 @@ func (tr *TableRestore) restoreEngine(
 			setError(err)
 			break
 		}
+		cr.droppedOffsets = tr.preDedup.droppedRows(chunk.Key)
 		var remainChunkCnt float64
 		if chunk.Chunk.Offset < chunk.Chunk.EndOffset {
 			remainChunkCnt = float64(chunk.Chunk.EndOffset-chunk.Chunk.Offset) / float64(chunk.Chunk.EndOffset-chunk.Key.Offset)
@@ -619,14 +694,14 @@ func (tr *TableRestore) restoreEngine(
 	// in local mode, this check-point make no sense, because we don't do flush now,
 	// so there may be data lose if exit at here. So we don't write this checkpoint
 	// here like other mode.
-	if !isLocalBackend(rc.cfg) {
+	if !rc.isLocalBackendTable(tr.tableName) {
 		if saveCpErr := rc.saveStatusCheckpoint(ctx, tr.tableName, engineID, err, checkpoints.CheckpointStatusAllWritten); saveCpErr != nil {
 			return nil, errors.Trace(firstErr(err, saveCpErr))
 		}
 	}
 	if err != nil {
 		// if process is canceled, we should flush all chunk checkpoints for local backend
-		if isLocalBackend(rc.cfg) && common.IsContextCanceledError(err) {
+		if rc.isLocalBackendTable(tr.tableName) && common.IsContextCanceledError(err) {
 			// ctx is canceled, so to avoid Close engine failed, we use `context.Background()` here
 			if _, err2 := dataEngine.Close(context.Background(), dataEngineCfg); err2 != nil {
 				log.FromContext(ctx).Warn("flush all chunk checkpoints failed before manually exits", zap.Error(err2))
@@ -640,9 +715,10 @@ func (tr *TableRestore) restoreEngine(
 	}
 
 	closedDataEngine, err := dataEngine.Close(ctx, dataEngineCfg)
+	rc.diskQuota.removeGate(dataEngine.GetEngineUuid())
 	// For local backend, if checkpoint is enabled, we must flush index engine to avoid data loss.
 	// this flush action impact up to 10% of the performance, so we only do it if necessary.
-	if err == nil && rc.cfg.Checkpoint.Enable && isLocalBackend(rc.cfg) {
+	if err == nil && rc.cfg.Checkpoint.Enable && rc.isLocalBackendTable(tr.tableName) {
 		if err = indexEngine.Flush(ctx); err != nil {
 			return nil, errors.Trace(err)
 		}
@@ -655,9 +731,139 @@ func (tr *TableRestore) restoreEngine(
 		// If any error occurred, recycle worker immediately
 		return nil, errors.Trace(err)
 	}
+	rc.eta.finishEngine(tr.tableName, etaPhaseSort, engineID)
 	return closedDataEngine, nil
 }
 
+// restoreStream restores the chunk of the stream source. The stream is cut into
+// chunks at the row boundaries while it's read, and the chunks are restored by
+// the region workers. Since the stream can't be resumed, the checkpoint of the
+// stream chunk is saved only after the stream is drained.
+func (tr *TableRestore) restoreStream(
+	ctx context.Context,
+	rc *Controller,
+	engineID int32,
+	streamChunk *checkpoints.ChunkCheckpoint,
+	dataEngine, indexEngine *backend.OpenedEngine,
+	dataWriterCfg *backend.LocalWriterConfig,
+) error {
+	reader, err := mydump.OpenStream(streamChunk.FileMeta.Path)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	source, err := mydump.NewStreamSource(ctx, rc.cfg, reader, rc.ioWorkers)
+	if err != nil {
+		_ = reader.Close()
+		return errors.Trace(err)
+	}
+	defer source.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		wg       sync.WaitGroup
+		chunkErr common.OnceError
+		// checksumMu protects the checksum of the stream chunk.
+		checksumMu sync.Mutex
+	)
+	setError := func(err error) {
+		chunkErr.Set(err)
+		cancel()
+	}
+	metrics, _ := metric.FromContext(ctx)
+	permutation := streamChunk.ColumnPermutation
+
+	for index := 0; chunkErr.Get() == nil; index++ {
+		sc, err := source.Next(ctx)
+		if errors.Cause(err) == io.EOF {
+			break
+		}
+		if err != nil {
+			setError(err)
+			break
+		}
+		if len(permutation) == 0 && len(sc.Columns) > 0 {
+			permutation, err = parseColumnPermutations(tr.tableInfo.Core, sc.Columns, tr.ignoreColumns, log.FromContext(ctx))
+			if err != nil {
+				setError(err)
+				break
+			}
+		}
+		chunk := &checkpoints.ChunkCheckpoint{
+			Key: checkpoints.ChunkCheckpointKey{
+				Path:   streamChunk.Key.Path,
+				Offset: sc.Offset,
+			},
+			FileMeta:          streamChunk.FileMeta,
+			ColumnPermutation: permutation,
+			Chunk:             sc.Chunk,
+			Timestamp:         streamChunk.Timestamp,
+		}
+		chunk.Chunk.PrevRowIDMax += streamChunk.Chunk.PrevRowIDMax
+		chunk.Chunk.RowIDMax += streamChunk.Chunk.PrevRowIDMax
+
+		cr, err := newChunkRestoreWithReader(ctx, index, rc.cfg, chunk, sc.Reader(), rc.ioWorkers, rc.store, tr.tableInfo)
+		if err != nil {
+			setError(err)
+			break
+		}
+		dataWriter, err := dataEngine.LocalWriter(ctx, dataWriterCfg)
+		if err != nil {
+			cr.close()
+			setError(err)
+			break
+		}
+		indexWriter, err := indexEngine.LocalWriter(ctx, &backend.LocalWriterConfig{})
+		if err != nil {
+			_, _ = dataWriter.Close(ctx)
+			cr.close()
+			setError(err)
+			break
+		}
+
+		restoreWorker := rc.regionWorkers.Apply()
+		wg.Add(1)
+		go func(w *worker.Worker, cr *chunkRestore) {
+			defer func() {
+				cr.close()
+				wg.Done()
+				rc.regionWorkers.Recycle(w)
+			}()
+			err := cr.restore(ctx, tr, engineID, dataWriter, indexWriter, rc)
+			if err == nil {
+				_, err = dataWriter.Close(ctx)
+			}
+			if err == nil {
+				_, err = indexWriter.Close(ctx)
+			}
+			if err != nil {
+				setError(err)
+				return
+			}
+			if metrics != nil {
+				metrics.BytesCounter.WithLabelValues(metric.BytesStateRestoreWritten).Add(float64(cr.chunk.Checksum.SumSize()))
+			}
+			checksumMu.Lock()
+			streamChunk.Checksum.Add(&cr.chunk.Checksum)
+			checksumMu.Unlock()
+		}(restoreWorker, cr)
+	}
+	wg.Wait()
+
+	if err := chunkErr.Get(); err != nil {
+		return err
+	}
+	size := source.Offset()
+	tr.logger.Info("stream source drained", zap.Int32("engineNumber", engineID),
+		zap.String("path", streamChunk.Key.Path), zap.Int64("size", size))
+	streamChunk.Chunk.Offset = size
+	streamChunk.Chunk.EndOffset = size
+	streamChunk.Chunk.RowIDMax = streamChunk.Chunk.PrevRowIDMax + size
+	streamChunk.Chunk.PrevRowIDMax = streamChunk.Chunk.RowIDMax
+	saveCheckpoint(rc, tr, engineID, streamChunk)
+	return nil
+}
+
 func (tr *TableRestore) importEngine(
 	ctx context.Context,
 	closedEngine *backend.ClosedEngine,
@@ -698,7 +904,7 @@ func (tr *TableRestore) postProcess(
 	forcePostProcess bool,
 	metaMgr tableMetaMgr,
 ) (bool, error) {
-	if !rc.backend.ShouldPostProcess() {
+	if !rc.backendOf(tr.tableName).ShouldPostProcess() {
 		return false, nil
 	}
 
@@ -725,7 +931,8 @@ func (tr *TableRestore) postProcess(
 	}
 
 	// tidb backend don't need checksum & analyze
-	if rc.cfg.PostRestore.Checksum == config.OpLevelOff && rc.cfg.PostRestore.Analyze == config.OpLevelOff {
+	if rc.isTiDBBackendTable(tr.tableName) ||
+		(rc.cfg.PostRestore.Checksum == config.OpLevelOff && rc.cfg.PostRestore.Analyze == config.OpLevelOff) {
 		tr.logger.Debug("skip checksum & analyze, either because not supported by this backend or manually disabled")
 		err := rc.saveStatusCheckpoint(ctx, tr.tableName, checkpoints.WholeTableEngineID, nil, checkpoints.CheckpointStatusAnalyzeSkipped)
 		return false, errors.Trace(err)
@@ -788,6 +995,24 @@ func (tr *TableRestore) postProcess(
 			}
 		}
 
+		if hasDupe {
+			// the report is only for reconciling the conflicts offline, the
+			// import isn't failed by it.
+			if err := rc.exportDuplicateReport(ctx, tr, cp); err != nil {
+				tr.logger.Warn("export duplicate report failed", log.ShortError(err))
+			}
+		}
+
+		if hasDupe && rc.cfg.TikvImporter.DuplicateResolution == config.DupeResAlgErr {
+			// the duplicated rows are already ingested, they're removed like the
+			// 'remove' algorithm so the table is left consistent.
+			if err := rc.backend.ResolveDuplicateRows(ctx, tr.encTable, tr.tableName, config.DupeResAlgRemove); err != nil {
+				tr.logger.Error("remove duplicate rows failed", log.ShortError(err))
+				return false, err
+			}
+			return false, common.ErrFoundDuplicateRows.GenWithStackByArgs(tr.tableName)
+		}
+
 		nextStage := checkpoints.CheckpointStatusChecksummed
 		if rc.cfg.PostRestore.Checksum != config.OpLevelOff && !hasDupe && needChecksum {
 			if cp.Checksum.SumKVS() > 0 || baseTotalChecksum.SumKVS() > 0 {
@@ -797,7 +1022,11 @@ func (tr *TableRestore) postProcess(
 			}
 
 			var remoteChecksum *RemoteChecksum
-			remoteChecksum, err = DoChecksum(ctx, tr.tableInfo)
+			err = rc.retryPostProcess(ctx, tr.logger, "checksum", func() error {
+				var err error
+				remoteChecksum, err = DoChecksum(ctx, tr.tableInfo)
+				return err
+			})
 			if err != nil {
 				return false, err
 			}
@@ -834,6 +1063,7 @@ func (tr *TableRestore) postProcess(
 			return false, errors.Trace(err)
 		}
 		cp.Status = nextStage
+		rc.eta.finishPhase(tr.tableName, etaPhaseChecksum)
 	}
 
 	// 5. do table analyze
@@ -846,7 +1076,14 @@ func (tr *TableRestore) postProcess(
 			}
 			cp.Status = checkpoints.CheckpointStatusAnalyzeSkipped
 		case forcePostProcess || !rc.cfg.PostRestore.PostProcessAtLast:
-			err := tr.analyzeTable(ctx, rc.tidbGlue.GetSQLExecutor())
+			if rc.cfg.PostRestore.AnalyzeInBackground {
+				// the table completes without waiting for the analyze.
+				rc.analyzeInBackground(ctx, tr)
+				return false, nil
+			}
+			err := rc.retryPostProcess(ctx, tr.logger, "analyze table", func() error {
+				return tr.analyzeTable(ctx, rc.tidbGlue.GetSQLExecutor())
+			})
 			// witch post restore level 'optional', we will skip analyze error
 			if rc.cfg.PostRestore.Analyze == config.OpLevelOptional {
 				if err != nil {
@@ -981,6 +1218,7 @@ func (tr *TableRestore) importKV(
 	if m, ok := metric.FromContext(ctx); ok {
 		m.ImportSecondsHistogram.Observe(dur.Seconds())
 	}
+	rc.eta.finishEngine(tr.tableName, etaPhaseIngest, engineID)
 
 	failpoint.Inject("SlowDownImport", func() {})
 
@@ -1024,9 +1262,14 @@ func estimateCompactionThreshold(cp *checkpoints.TableCheckpoint, factor int64)
 				continue
 			}
 			size := chunk.FileMeta.FileSize
-			if chunk.FileMeta.Type == mydump.SourceTypeParquet {
+			switch {
+			case chunk.FileMeta.Type == mydump.SourceTypeParquet:
 				// parquet file is compressed, thus estimates with a factor of 2
 				size *= 2
+			case chunk.FileMeta.Compression != mydump.CompressionNone:
+				// the compressed file isn't split, and the chunk covers the
+				// whole decompressed data.
+				size = chunk.Chunk.EndOffset - chunk.Key.Offset
 			}
 			totalRawFileSize += size
 			lastFile = chunk.FileMeta.Path
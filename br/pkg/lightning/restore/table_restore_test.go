@@ -59,6 +59,7 @@ import (
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/store/pdtypes"
+	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/table/tables"
 	"github.com/pingcap/tidb/types"
 	tmock "github.com/pingcap/tidb/util/mock"
@@ -730,6 +731,47 @@ func (s *tableRestoreSuite) TestInitializeColumnsGenerated() {
 	}
 }
 
+func (s *tableRestoreSuite) TestEncTableOfFile() {
+	p := parser.New()
+	node, err := p.ParseOneStmt("CREATE TABLE `table` (a INT) PARTITION BY RANGE (a) (PARTITION p0 VALUES LESS THAN (10), PARTITION p1 VALUES LESS THAN MAXVALUE)", "", "")
+	require.NoError(s.T(), err)
+	core, err := ddl.BuildTableInfoFromAST(node.(*ast.CreateTableStmt))
+	require.NoError(s.T(), err)
+	core.ID = 0xabcdef
+	core.Partition.Definitions[0].ID = 0xabcdf0
+	core.Partition.Definitions[1].ID = 0xabcdf1
+	core.State = model.StatePublic
+	tableInfo := &checkpoints.TidbTableInfo{Name: "table", DB: "db", Core: core}
+	tableMeta := &mydump.MDTableMeta{
+		DB:   "db",
+		Name: "table",
+		DataFiles: []mydump.FileInfo{
+			{FileMeta: mydump.SourceFileMeta{Path: "db.table.p1.csv", Type: mydump.SourceTypeCSV, Partition: "P1"}},
+			{FileMeta: mydump.SourceFileMeta{Path: "db.table.csv", Type: mydump.SourceTypeCSV}},
+			{FileMeta: mydump.SourceFileMeta{Path: "db.table.p2.csv", Type: mydump.SourceTypeCSV, Partition: "p2"}},
+		},
+	}
+	tr, err := NewTableRestore("`db`.`table`", tableMeta, s.dbInfo, tableInfo, &checkpoints.TableCheckpoint{}, nil, nil, log.L())
+	require.NoError(s.T(), err)
+
+	// the rows are only encoded into the routed partition.
+	tbl, err := tr.encTableOfFile("db.table.p1.csv")
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []int64{0xabcdf1}, tbl.(table.PartitionedTable).GetAllPartitionIDs())
+	tbl, err = tr.encTableOfFile("db.table.csv")
+	require.NoError(s.T(), err)
+	require.Same(s.T(), tr.encTable, tbl)
+	_, err = tr.encTableOfFile("db.table.p2.csv")
+	require.True(s.T(), common.ErrUnknownPartition.Equal(err))
+
+	// the partition can't be set for the non-partitioned table.
+	tableMeta.DataFiles = tableMeta.DataFiles[:1]
+	tr, err = NewTableRestore("`db`.`table`", tableMeta, s.dbInfo, s.tableInfo, &checkpoints.TableCheckpoint{}, nil, nil, log.L())
+	require.NoError(s.T(), err)
+	_, err = tr.encTableOfFile("db.table.p1.csv")
+	require.True(s.T(), common.ErrUnknownPartition.Equal(err))
+}
+
 func (s *tableRestoreSuite) TestCompareChecksumSuccess() {
 	db, mock, err := sqlmock.New()
 	require.NoError(s.T(), err)
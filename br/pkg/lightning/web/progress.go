@@ -2,12 +2,15 @@ package web
 
 import (
 	"encoding/json"
+	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/br/pkg/lightning/checkpoints"
 	"github.com/pingcap/tidb/br/pkg/lightning/common"
 	"github.com/pingcap/tidb/br/pkg/lightning/mydump"
+	"github.com/pingcap/tidb/br/pkg/progress"
 	"go.uber.org/atomic"
 )
 
@@ -45,9 +48,16 @@ func (cpm *checkpointsMap) insert(key string, cp *checkpoints.TableCheckpoint) {
 	cpm.mu.Unlock()
 }
 
+type engineWritten struct {
+	engineID     int32
+	totalWritten int64
+	totalSize    int64
+}
+
 type totalWritten struct {
 	key          string
 	totalWritten int64
+	engines      []engineWritten
 }
 
 func (cpm *checkpointsMap) update(diffs map[string]*checkpoints.TableCheckpointDiff) []totalWritten {
@@ -61,16 +71,24 @@ func (cpm *checkpointsMap) update(diffs map[string]*checkpoints.TableCheckpointD
 		cp.Apply(diff)
 
 		tw := int64(0)
-		for _, engine := range cp.Engines {
+		engines := make([]engineWritten, 0, len(cp.Engines))
+		for engineID, engine := range cp.Engines {
+			ew := engineWritten{engineID: engineID}
 			for _, chunk := range engine.Chunks {
 				if engine.Status >= checkpoints.CheckpointStatusAllWritten {
-					tw += chunk.Chunk.EndOffset - chunk.Key.Offset
+					ew.totalWritten += chunk.Chunk.EndOffset - chunk.Key.Offset
 				} else {
-					tw += chunk.Chunk.Offset - chunk.Key.Offset
+					ew.totalWritten += chunk.Chunk.Offset - chunk.Key.Offset
 				}
+				ew.totalSize += chunk.Chunk.EndOffset - chunk.Key.Offset
 			}
+			tw += ew.totalWritten
+			engines = append(engines, ew)
 		}
-		totalWrittens = append(totalWrittens, totalWritten{key: key, totalWritten: tw})
+		sort.Slice(engines, func(i, j int) bool {
+			return engines[i].engineID < engines[j].engineID
+		})
+		totalWrittens = append(totalWrittens, totalWritten{key: key, totalWritten: tw, engines: engines})
 	}
 	return totalWrittens
 }
@@ -85,6 +103,55 @@ func (cpm *checkpointsMap) marshal(key string) ([]byte, error) {
 	return nil, errors.NotFoundf("table %s", key)
 }
 
+// ChunkProgress is the progress of restoring a chunk of a table.
+type ChunkProgress struct {
+	EngineID int32  `json:"engine_id"`
+	Path     string `json:"path"`
+	// StartOffset and EndOffset are the range of the chunk in the file.
+	StartOffset int64 `json:"start_offset"`
+	EndOffset   int64 `json:"end_offset"`
+	// Offset is the offset of the data restored so far.
+	Offset   int64 `json:"offset"`
+	RowIDMax int64 `json:"row_id_max"`
+	Finished bool  `json:"finished"`
+}
+
+func (cpm *checkpointsMap) chunkProgress(key string) ([]ChunkProgress, error) {
+	cpm.mu.RLock()
+	defer cpm.mu.RUnlock()
+
+	cp, ok := cpm.checkpoints[key]
+	if !ok {
+		return nil, errors.NotFoundf("table %s", key)
+	}
+	engineIDs := make([]int32, 0, len(cp.Engines))
+	for engineID := range cp.Engines {
+		engineIDs = append(engineIDs, engineID)
+	}
+	sort.Slice(engineIDs, func(i, j int) bool { return engineIDs[i] < engineIDs[j] })
+
+	result := make([]ChunkProgress, 0)
+	for _, engineID := range engineIDs {
+		engine := cp.Engines[engineID]
+		for _, chunk := range engine.Chunks {
+			p := ChunkProgress{
+				EngineID:    engineID,
+				Path:        chunk.Key.Path,
+				StartOffset: chunk.Key.Offset,
+				EndOffset:   chunk.Chunk.EndOffset,
+				Offset:      chunk.Chunk.Offset,
+				RowIDMax:    chunk.Chunk.RowIDMax,
+			}
+			if engine.Status >= checkpoints.CheckpointStatusAllWritten {
+				p.Offset = chunk.Chunk.EndOffset
+			}
+			p.Finished = p.Offset >= p.EndOffset
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
 type taskStatus uint8
 
 const (
@@ -107,6 +174,9 @@ type taskProgress struct {
 
 	// The contents have their own mutex for protection
 	checkpoints checkpointsMap
+	// task reports the progress of the tables and their engines through the
+	// shared progress library, it's protected by mu.
+	task *progress.Task
 }
 
 var (
@@ -130,6 +200,11 @@ func BroadcastStartTask() {
 	}
 	currentProgress.mu.Lock()
 	currentProgress.Status = taskStatusRunning
+	if currentProgress.task != nil {
+		progress.DefaultRegistry.Unregister(currentProgress.task)
+	}
+	currentProgress.task = progress.NewTask("import", 0)
+	progress.DefaultRegistry.Register(currentProgress.task)
 	currentProgress.mu.Unlock()
 
 	currentProgress.checkpoints.clear()
@@ -144,6 +219,9 @@ func BroadcastEndTask(err error) {
 	currentProgress.mu.Lock()
 	currentProgress.Status = taskStatusCompleted
 	currentProgress.Message = errString
+	if currentProgress.task != nil {
+		currentProgress.task.Finish(err)
+	}
 	currentProgress.mu.Unlock()
 }
 
@@ -162,6 +240,16 @@ func BroadcastInitProgress(databases []*mydump.MDDatabaseMeta) {
 
 	currentProgress.mu.Lock()
 	currentProgress.Tables = tables
+	if task := currentProgress.task; task != nil {
+		totalSize := int64(0)
+		for _, db := range databases {
+			for _, tbl := range db.Tables {
+				task.SubTask(common.UniqueTable(db.Name, tbl.Name), tbl.TotalSize)
+				totalSize += tbl.TotalSize
+			}
+		}
+		task.SetTotal(totalSize)
+	}
 	currentProgress.mu.Unlock()
 }
 
@@ -187,6 +275,20 @@ func BroadcastCheckpointDiff(diffs map[string]*checkpoints.TableCheckpointDiff)
 	for _, tw := range totalWrittens {
 		currentProgress.Tables[tw.key].TotalWritten = tw.totalWritten
 	}
+	if task := currentProgress.task; task != nil {
+		written := int64(0)
+		for _, tbl := range currentProgress.Tables {
+			written += tbl.TotalWritten
+		}
+		task.SetCurrent(written)
+		for _, tw := range totalWrittens {
+			tableTask := task.SubTask(tw.key, currentProgress.Tables[tw.key].TotalSize)
+			tableTask.SetCurrent(tw.totalWritten)
+			for _, ew := range tw.engines {
+				tableTask.SubTask(fmt.Sprintf("engine %d", ew.engineID), ew.totalSize).SetCurrent(ew.totalWritten)
+			}
+		}
+	}
 	currentProgress.mu.Unlock()
 }
 
@@ -200,6 +302,9 @@ func BroadcastError(tableName string, err error) {
 	if tbl := currentProgress.Tables[tableName]; tbl != nil {
 		tbl.Status = taskStatusCompleted
 		tbl.Message = errString
+		if task := currentProgress.task; task != nil {
+			task.SubTask(tableName, tbl.TotalSize).Finish(err)
+		}
 	}
 	currentProgress.mu.Unlock()
 }
@@ -219,3 +324,15 @@ func MarshalTableCheckpoints(tableName string) ([]byte, error) {
 	}
 	return currentProgress.checkpoints.marshal(tableName)
 }
+
+// MarshalTableChunkProgress marshals the progress of every chunk of the table.
+func MarshalTableChunkProgress(tableName string) ([]byte, error) {
+	if !progressEnabled.Load() {
+		return nil, errors.New("progress is not enabled")
+	}
+	chunks, err := currentProgress.checkpoints.chunkProgress(tableName)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(chunks)
+}
@@ -16,6 +16,7 @@ package worker
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/pingcap/tidb/br/pkg/lightning/metric"
@@ -23,8 +24,14 @@ import (
 
 // Pool is the worker pool.
 type Pool struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+	// limit is the maximum number of the workers applied at the same time, it
+	// can be changed by SetLimit.
 	limit   int
-	workers chan *Worker
+	inUse   int
+	idle    []*Worker
+	nextID  int64
 	name    string
 	metrics *metric.Metrics
 }
@@ -36,29 +43,45 @@ type Worker struct {
 
 // NewPool creates a new worker pool.
 func NewPool(ctx context.Context, limit int, name string) *Pool {
-	workers := make(chan *Worker, limit)
+	idle := make([]*Worker, 0, limit)
 	for i := 0; i < limit; i++ {
-		workers <- &Worker{ID: int64(i + 1)}
+		idle = append(idle, &Worker{ID: int64(i + 1)})
 	}
 
 	metrics, ok := metric.FromContext(ctx)
 	if ok {
 		metrics.IdleWorkersGauge.WithLabelValues(name).Set(float64(limit))
 	}
-	return &Pool{
+	pool := &Pool{
 		limit:   limit,
-		workers: workers,
+		idle:    idle,
+		nextID:  int64(limit),
 		name:    name,
 		metrics: metrics,
 	}
+	pool.cond = sync.NewCond(&pool.mu)
+	return pool
 }
 
 // Apply gets a worker from the pool.
 func (pool *Pool) Apply() *Worker {
 	start := time.Now()
-	worker := <-pool.workers
+	pool.mu.Lock()
+	for pool.inUse >= pool.limit {
+		pool.cond.Wait()
+	}
+	pool.inUse++
+	var worker *Worker
+	if len(pool.idle) > 0 {
+		worker = pool.idle[0]
+		pool.idle = pool.idle[1:]
+	} else {
+		pool.nextID++
+		worker = &Worker{ID: pool.nextID}
+	}
+	pool.updateIdleGaugeLocked()
+	pool.mu.Unlock()
 	if pool.metrics != nil {
-		pool.metrics.IdleWorkersGauge.WithLabelValues(pool.name).Set(float64(len(pool.workers)))
 		pool.metrics.ApplyWorkerSecondsHistogram.WithLabelValues(pool.name).Observe(time.Since(start).Seconds())
 	}
 	return worker
@@ -69,13 +92,46 @@ func (pool *Pool) Recycle(worker *Worker) {
 	if worker == nil {
 		panic("invalid restore worker")
 	}
-	pool.workers <- worker
-	if pool.metrics != nil {
-		pool.metrics.IdleWorkersGauge.WithLabelValues(pool.name).Set(float64(len(pool.workers)))
-	}
+	pool.mu.Lock()
+	pool.inUse--
+	pool.idle = append(pool.idle, worker)
+	pool.updateIdleGaugeLocked()
+	pool.mu.Unlock()
+	pool.cond.Signal()
 }
 
 // HasWorker returns whether the pool has worker.
 func (pool *Pool) HasWorker() bool {
-	return len(pool.workers) > 0
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	return pool.inUse < pool.limit
+}
+
+// Limit returns the maximum number of the workers applied at the same time.
+func (pool *Pool) Limit() int {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	return pool.limit
+}
+
+// SetLimit changes the maximum number of the workers applied at the same time.
+// If the limit is decreased, the workers in use are not interrupted, but no
+// more workers are applied until enough workers are recycled.
+func (pool *Pool) SetLimit(limit int) {
+	pool.mu.Lock()
+	pool.limit = limit
+	pool.updateIdleGaugeLocked()
+	pool.mu.Unlock()
+	pool.cond.Broadcast()
+}
+
+func (pool *Pool) updateIdleGaugeLocked() {
+	if pool.metrics == nil {
+		return
+	}
+	idle := pool.limit - pool.inUse
+	if idle < 0 {
+		idle = 0
+	}
+	pool.metrics.IdleWorkersGauge.WithLabelValues(pool.name).Set(float64(idle))
 }
@@ -17,6 +17,7 @@ package worker_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/pingcap/tidb/br/pkg/lightning/worker"
 	"github.com/stretchr/testify/require"
@@ -43,3 +44,36 @@ func TestApplyRecycle(t *testing.T) {
 
 	require.PanicsWithValue(t, "invalid restore worker", func() { pool.Recycle(nil) })
 }
+
+func TestSetLimit(t *testing.T) {
+	pool := worker.NewPool(context.Background(), 1, "test")
+	require.Equal(t, 1, pool.Limit())
+
+	w1 := pool.Apply()
+	require.False(t, pool.HasWorker())
+
+	pool.SetLimit(2)
+	require.Equal(t, 2, pool.Limit())
+	require.True(t, pool.HasWorker())
+	w2 := pool.Apply()
+	require.NotEqual(t, w1.ID, w2.ID)
+	require.False(t, pool.HasWorker())
+
+	// decreasing the limit doesn't interrupt the workers in use
+	pool.SetLimit(1)
+	pool.Recycle(w2)
+	require.False(t, pool.HasWorker())
+
+	applied := make(chan *worker.Worker)
+	go func() {
+		applied <- pool.Apply()
+	}()
+	select {
+	case <-applied:
+		require.FailNow(t, "the worker should not be applied before recycling")
+	case <-time.After(100 * time.Millisecond):
+	}
+	pool.Recycle(w1)
+	w3 := <-applied
+	require.Contains(t, []int64{w1.ID, w2.ID}, w3.ID)
+}
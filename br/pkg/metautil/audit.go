@@ -0,0 +1,191 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package metautil
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// AuditProblem is a problem of a file or a table found by the audit.
+type AuditProblem struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// AuditReport is the result of auditing the integrity of a backup.
+type AuditReport struct {
+	StartVersion uint64 `json:"start_version"`
+	EndVersion   uint64 `json:"end_version"`
+	// Files and Size are the data files in the backupmeta, all of them are
+	// checked for the existence.
+	Files int    `json:"files"`
+	Size  uint64 `json:"size"`
+	// SampledFiles and SampledSize are the data files downloaded to verify
+	// the checksums.
+	SampledFiles     int             `json:"sampled_files"`
+	SampledSize      uint64          `json:"sampled_size"`
+	MissingFiles     []*AuditProblem `json:"missing_files,omitempty"`
+	CorruptedFiles   []*AuditProblem `json:"corrupted_files,omitempty"`
+	MismatchedTables []*AuditProblem `json:"mismatched_tables,omitempty"`
+}
+
+// Passed returns whether no problem is found.
+func (r *AuditReport) Passed() bool {
+	return len(r.MissingFiles)+len(r.CorruptedFiles)+len(r.MismatchedTables) == 0
+}
+
+// AuditBackup checks the integrity of the backup without restoring it. All the
+// data files in the backupmeta are checked to exist with the recorded sizes, a
+// random sample of them by the ratio is downloaded to verify the SHA-256, and
+// the checksums of the tables are verified against their data files.
+func (reader *MetaReader) AuditBackup(ctx context.Context, sampleRatio float64, concurrency uint) (*AuditReport, error) {
+	report := &AuditReport{
+		StartVersion: reader.backupMeta.StartVersion,
+		EndVersion:   reader.backupMeta.EndVersion,
+	}
+
+	existing := make(map[string]int64)
+	err := reader.storage.WalkDir(ctx, &storage.WalkOption{}, func(path string, size int64) error {
+		existing[path] = size
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to list the files of the backup")
+	}
+	var sampled []*backuppb.File
+	err = reader.readDataFiles(ctx, func(f *backuppb.File) {
+		report.Files++
+		report.Size += f.Size_
+		size, ok := existing[f.Name]
+		switch {
+		case !ok:
+			report.MissingFiles = append(report.MissingFiles, &AuditProblem{Name: f.Name, Reason: "the file doesn't exist"})
+		case f.Size_ > 0 && uint64(size) != f.Size_:
+			report.CorruptedFiles = append(report.CorruptedFiles, &AuditProblem{
+				Name:   f.Name,
+				Reason: fmt.Sprintf("the size is %d, but %d is recorded", size, f.Size_),
+			})
+		case rand.Float64() < sampleRatio:
+			sampled = append(sampled, f)
+		}
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	if err := reader.verifySampledFiles(ctx, sampled, concurrency, report); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !reader.backupMeta.IsRawKv {
+		if err := reader.verifyTableChecksums(ctx, report); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
+	sortProblems := func(problems []*AuditProblem) {
+		sort.Slice(problems, func(i, j int) bool { return problems[i].Name < problems[j].Name })
+	}
+	sortProblems(report.MissingFiles)
+	sortProblems(report.CorruptedFiles)
+	sortProblems(report.MismatchedTables)
+	log.Info("audit the backup", zap.Int("files", report.Files), zap.Int("sampled-files", report.SampledFiles),
+		zap.Int("missing-files", len(report.MissingFiles)), zap.Int("corrupted-files", len(report.CorruptedFiles)),
+		zap.Int("mismatched-tables", len(report.MismatchedTables)))
+	return report, nil
+}
+
+func (reader *MetaReader) verifySampledFiles(
+	ctx context.Context,
+	files []*backuppb.File,
+	concurrency uint,
+	report *AuditReport,
+) error {
+	if concurrency == 0 {
+		concurrency = 1
+	}
+	var mu sync.Mutex
+	tokens := make(chan struct{}, concurrency)
+	eg, ectx := errgroup.WithContext(ctx)
+	for _, f := range files {
+		f := f
+		tokens <- struct{}{}
+		eg.Go(func() error {
+			defer func() { <-tokens }()
+			data, err := reader.storage.ReadFile(ectx, f.Name)
+			if err != nil {
+				return errors.Annotatef(err, "failed to read the data file %s", f.Name)
+			}
+			checksum := sha256.Sum256(data)
+			mu.Lock()
+			defer mu.Unlock()
+			report.SampledFiles++
+			report.SampledSize += uint64(len(data))
+			if !bytes.Equal(checksum[:], f.Sha256) {
+				report.CorruptedFiles = append(report.CorruptedFiles, &AuditProblem{
+					Name: f.Name,
+					Reason: fmt.Sprintf("the sha256 is %s, but %s is recorded",
+						hex.EncodeToString(checksum[:]), hex.EncodeToString(f.Sha256)),
+				})
+			}
+			return nil
+		})
+	}
+	return eg.Wait()
+}
+
+// verifyTableChecksums checks the checksum of each table is the combination of
+// the checksums of its data files, a mismatch means the backupmeta lost or
+// gained data files.
+func (reader *MetaReader) verifyTableChecksums(ctx context.Context, report *AuditReport) error {
+	ch := make(chan *Table)
+	errCh := make(chan error)
+	go func() {
+		if err := reader.ReadSchemasFiles(ctx, ch); err != nil {
+			errCh <- errors.Trace(err)
+		}
+		close(ch)
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case err := <-errCh:
+			return errors.Trace(err)
+		case tbl, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if tbl.Info == nil || tbl.NoChecksum() {
+				continue
+			}
+			var crc64Xor, totalKvs, totalBytes uint64
+			for _, f := range tbl.Files {
+				crc64Xor ^= f.Crc64Xor
+				totalKvs += f.TotalKvs
+				totalBytes += f.TotalBytes
+			}
+			if crc64Xor != tbl.Crc64Xor || totalKvs != tbl.TotalKvs || totalBytes != tbl.TotalBytes {
+				report.MismatchedTables = append(report.MismatchedTables, &AuditProblem{
+					Name: tableName(tbl.DB.Name.O, tbl.Info.Name.O),
+					Reason: fmt.Sprintf("the checksum of the data files is (crc64xor %d, kvs %d, bytes %d), "+
+						"but (crc64xor %d, kvs %d, bytes %d) is recorded",
+						crc64Xor, totalKvs, totalBytes, tbl.Crc64Xor, tbl.TotalKvs, tbl.TotalBytes),
+				})
+			}
+		}
+	}
+}
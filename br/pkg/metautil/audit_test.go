@@ -0,0 +1,79 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package metautil
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditBackup(t *testing.T) {
+	ctx := context.Background()
+	s, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	dataFile := func(name string, tableID int64, content string, crc64Xor uint64) *backuppb.File {
+		sum := sha256.Sum256([]byte(content))
+		return &backuppb.File{
+			Name:       name,
+			Sha256:     sum[:],
+			StartKey:   tablecodec.GenTableRecordPrefix(tableID),
+			EndKey:     tablecodec.GenTableRecordPrefix(tableID).PrefixNext(),
+			Crc64Xor:   crc64Xor,
+			TotalKvs:   1,
+			TotalBytes: 10,
+			Size_:      uint64(len(content)),
+		}
+	}
+	files := []*backuppb.File{
+		dataFile("1_good.sst", 1, "good", 1),
+		dataFile("1_corrupted.sst", 1, "corrupted", 2),
+		dataFile("2_missing.sst", 2, "missing", 4),
+		dataFile("2_resized.sst", 2, "resized", 8),
+	}
+	require.NoError(t, s.WriteFile(ctx, "1_good.sst", []byte("good")))
+	require.NoError(t, s.WriteFile(ctx, "1_corrupted.sst", []byte("CORRUPTED")))
+	require.NoError(t, s.WriteFile(ctx, "2_resized.sst", []byte("short")))
+	meta := &backuppb.BackupMeta{
+		EndVersion: 100,
+		Files:      files,
+		Schemas: []*backuppb.Schema{
+			{
+				Db: []byte(`{"id":10,"db_name":{"O":"test","L":"test"}}`), Table: []byte(`{"id":1,"name":{"O":"t1","L":"t1"}}`),
+				Crc64Xor: 1 ^ 2, TotalKvs: 2, TotalBytes: 20,
+			},
+			{
+				Db: []byte(`{"id":10,"db_name":{"O":"test","L":"test"}}`), Table: []byte(`{"id":2,"name":{"O":"t2","L":"t2"}}`),
+				Crc64Xor: 4, TotalKvs: 1, TotalBytes: 10,
+			},
+		},
+	}
+	reader := NewMetaReader(meta, s, nil)
+
+	report, err := reader.AuditBackup(ctx, 1, 2)
+	require.NoError(t, err)
+	require.False(t, report.Passed())
+	require.Equal(t, 4, report.Files)
+	require.Equal(t, 2, report.SampledFiles)
+	require.Equal(t, uint64(len("good")+len("CORRUPTED")), report.SampledSize)
+	require.Equal(t, []*AuditProblem{{Name: "2_missing.sst", Reason: "the file doesn't exist"}}, report.MissingFiles)
+	require.Len(t, report.CorruptedFiles, 2)
+	require.Equal(t, "1_corrupted.sst", report.CorruptedFiles[0].Name)
+	require.Contains(t, report.CorruptedFiles[0].Reason, "sha256")
+	require.Equal(t, "2_resized.sst", report.CorruptedFiles[1].Name)
+	require.Equal(t, "the size is 5, but 7 is recorded", report.CorruptedFiles[1].Reason)
+	require.Len(t, report.MismatchedTables, 1)
+	require.Equal(t, "`test`.`t2`", report.MismatchedTables[0].Name)
+
+	// no file is downloaded without sampling.
+	report, err = reader.AuditBackup(ctx, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, 0, report.SampledFiles)
+	require.Len(t, report.CorruptedFiles, 1)
+}
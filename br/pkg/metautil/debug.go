@@ -0,0 +1,165 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package metautil
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/tablecodec"
+)
+
+// FlattenBackupMeta returns a copy of the backupmeta with the schemas, data files,
+// raw ranges and DDLs in the meta files of backupmeta v2 inlined, so that the
+// backupmeta can be inspected as a whole.
+func (reader *MetaReader) FlattenBackupMeta(ctx context.Context) (*backuppb.BackupMeta, error) {
+	meta := proto.Clone(reader.backupMeta).(*backuppb.BackupMeta)
+	if meta.Version == MetaV1 {
+		return meta, nil
+	}
+	ddls, err := reader.ReadDDLs(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	meta.Ddls = ddls
+	err = walkLeafMetaFile(ctx, reader.storage, meta.SchemaIndex, reader.cipher, func(m *backuppb.MetaFile) {
+		meta.Schemas = append(meta.Schemas, m.Schemas...)
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	err = walkLeafMetaFile(ctx, reader.storage, meta.FileIndex, reader.cipher, func(m *backuppb.MetaFile) {
+		meta.Files = append(meta.Files, m.DataFiles...)
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	err = walkLeafMetaFile(ctx, reader.storage, meta.RawRangeIndex, reader.cipher, func(m *backuppb.MetaFile) {
+		meta.RawRanges = append(meta.RawRanges, m.RawRanges...)
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	meta.SchemaIndex = nil
+	meta.FileIndex = nil
+	meta.RawRangeIndex = nil
+	meta.DdlIndexes = nil
+	return meta, nil
+}
+
+// ReadDataFilesInRange reads the data files overlapping the key range [startKey, endKey).
+// An empty endKey means the range is unbounded.
+func (reader *MetaReader) ReadDataFilesInRange(ctx context.Context, startKey, endKey []byte) ([]*backuppb.File, error) {
+	files := make([]*backuppb.File, 0)
+	err := reader.readDataFiles(ctx, func(f *backuppb.File) {
+		if (len(endKey) == 0 || bytes.Compare(f.StartKey, endKey) < 0) &&
+			(len(f.EndKey) == 0 || bytes.Compare(startKey, f.EndKey) < 0) {
+			files = append(files, f)
+		}
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return files, nil
+}
+
+// ReadDataFile reads the data file with the name.
+func (reader *MetaReader) ReadDataFile(ctx context.Context, name string) (*backuppb.File, error) {
+	var file *backuppb.File
+	err := reader.readDataFiles(ctx, func(f *backuppb.File) {
+		if file == nil && f.Name == name {
+			file = f
+		}
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if file == nil {
+		return nil, errors.Annotatef(berrors.ErrInvalidArgument, "data file %s not found", name)
+	}
+	return file, nil
+}
+
+// TableKeyRanges returns the key ranges of the table, a partitioned table has a
+// range for each partition.
+func TableKeyRanges(tbl *model.TableInfo) []kv.KeyRange {
+	ids := []int64{tbl.ID}
+	if tbl.Partition != nil {
+		for _, p := range tbl.Partition.Definitions {
+			ids = append(ids, p.ID)
+		}
+	}
+	ranges := make([]kv.KeyRange, 0, len(ids))
+	for _, id := range ids {
+		prefix := tablecodec.GenTablePrefix(id)
+		ranges = append(ranges, kv.KeyRange{StartKey: prefix, EndKey: prefix.PrefixNext()})
+	}
+	return ranges
+}
+
+// DataFileInfo is the human-readable metadata of a data file.
+type DataFileInfo struct {
+	Name            string `json:"name"`
+	CF              string `json:"cf"`
+	SHA256          string `json:"sha256"`
+	StartKey        string `json:"start_key"`
+	EndKey          string `json:"end_key"`
+	DecodedStartKey string `json:"decoded_start_key"`
+	DecodedEndKey   string `json:"decoded_end_key"`
+	StartVersion    uint64 `json:"start_version"`
+	EndVersion      uint64 `json:"end_version"`
+	Crc64Xor        uint64 `json:"crc64xor"`
+	TotalKvs        uint64 `json:"total_kvs"`
+	TotalBytes      uint64 `json:"total_bytes"`
+	Size            uint64 `json:"size"`
+}
+
+// NewDataFileInfo creates the human-readable metadata of the data file.
+func NewDataFileInfo(file *backuppb.File) *DataFileInfo {
+	return &DataFileInfo{
+		Name:            file.Name,
+		CF:              file.Cf,
+		SHA256:          hex.EncodeToString(file.Sha256),
+		StartKey:        hex.EncodeToString(file.StartKey),
+		EndKey:          hex.EncodeToString(file.EndKey),
+		DecodedStartKey: DecodeKey(file.StartKey),
+		DecodedEndKey:   DecodeKey(file.EndKey),
+		StartVersion:    file.StartVersion,
+		EndVersion:      file.EndVersion,
+		Crc64Xor:        file.Crc64Xor,
+		TotalKvs:        file.TotalKvs,
+		TotalBytes:      file.TotalBytes,
+		Size:            file.Size_,
+	}
+}
+
+// DecodeKey describes the key in a human-readable way, such as
+// "table 100, handle 1" and "table 100, index 1, values [1]".
+func DecodeKey(key []byte) string {
+	switch {
+	case len(key) == 0:
+		return ""
+	case tablecodec.IsRecordKey(key):
+		if tableID, handle, err := tablecodec.DecodeRecordKey(key); err == nil {
+			return fmt.Sprintf("table %d, handle %s", tableID, handle)
+		}
+	case tablecodec.IsIndexKey(key):
+		if tableID, indexID, values, err := tablecodec.DecodeIndexKey(key); err == nil {
+			return fmt.Sprintf("table %d, index %d, values %v", tableID, indexID, values)
+		}
+	}
+	if len(key) >= tablecodec.TableSplitKeyLen {
+		if tableID := tablecodec.DecodeTableID(key); tableID != 0 {
+			return fmt.Sprintf("table %d", tableID)
+		}
+	}
+	return fmt.Sprintf("%q", key)
+}
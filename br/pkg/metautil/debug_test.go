@@ -0,0 +1,84 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package metautil
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/stretchr/testify/require"
+)
+
+func writeMetaFile(ctx context.Context, t *testing.T, s storage.ExternalStorage, name string, m *backuppb.MetaFile) *backuppb.MetaFile {
+	content, err := m.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, s.WriteFile(ctx, name, content))
+	sum := sha256.Sum256(content)
+	return &backuppb.MetaFile{MetaFiles: []*backuppb.File{{Name: name, Sha256: sum[:]}}}
+}
+
+func TestFlattenBackupMeta(t *testing.T) {
+	ctx := context.Background()
+	s, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	files := []*backuppb.File{
+		{Name: "1.sst", StartKey: tablecodec.EncodeRowKeyWithHandle(1, kv.IntHandle(1)), EndKey: tablecodec.EncodeRowKeyWithHandle(1, kv.IntHandle(10))},
+		{Name: "2.sst", StartKey: tablecodec.EncodeRowKeyWithHandle(2, kv.IntHandle(1)), EndKey: tablecodec.EncodeRowKeyWithHandle(2, kv.IntHandle(10))},
+		{Name: "3.sst", StartKey: tablecodec.EncodeRowKeyWithHandle(3, kv.IntHandle(1)), EndKey: tablecodec.EncodeRowKeyWithHandle(3, kv.IntHandle(10))},
+	}
+	schemas := []*backuppb.Schema{{Db: []byte(`{"id":1}`), Table: []byte(`{"id":2}`)}}
+	meta := &backuppb.BackupMeta{
+		Version:     MetaV2,
+		FileIndex:   writeMetaFile(ctx, t, s, "backupmeta.datafile.000000001", &backuppb.MetaFile{DataFiles: files}),
+		SchemaIndex: writeMetaFile(ctx, t, s, "backupmeta.schema.000000001", &backuppb.MetaFile{Schemas: schemas}),
+		DdlIndexes:  writeMetaFile(ctx, t, s, "backupmeta.ddl.000000001", &backuppb.MetaFile{Ddls: [][]byte{[]byte(`{"id":3}`)}}),
+	}
+	reader := NewMetaReader(meta, s, nil)
+
+	flattened, err := reader.FlattenBackupMeta(ctx)
+	require.NoError(t, err)
+	require.Nil(t, flattened.FileIndex)
+	require.Nil(t, flattened.SchemaIndex)
+	require.Nil(t, flattened.DdlIndexes)
+	require.Len(t, flattened.Files, 3)
+	require.Equal(t, schemas[0].Table, flattened.Schemas[0].Table)
+	require.Equal(t, `[{"id":3}]`, string(flattened.Ddls))
+	// the original backupmeta is untouched.
+	require.NotNil(t, meta.FileIndex)
+	require.Len(t, meta.Files, 0)
+
+	inRange, err := reader.ReadDataFilesInRange(ctx, tablecodec.GenTablePrefix(2), tablecodec.GenTablePrefix(2).PrefixNext())
+	require.NoError(t, err)
+	require.Len(t, inRange, 1)
+	require.Equal(t, "2.sst", inRange[0].Name)
+	inRange, err = reader.ReadDataFilesInRange(ctx, tablecodec.EncodeRowKeyWithHandle(1, kv.IntHandle(5)), nil)
+	require.NoError(t, err)
+	require.Len(t, inRange, 3)
+
+	ranges := TableKeyRanges(&model.TableInfo{ID: 2, Partition: &model.PartitionInfo{Definitions: []model.PartitionDefinition{{ID: 3}}}})
+	require.Len(t, ranges, 2)
+	require.Equal(t, tablecodec.GenTablePrefix(3), ranges[1].StartKey)
+
+	file, err := reader.ReadDataFile(ctx, "3.sst")
+	require.NoError(t, err)
+	info := NewDataFileInfo(file)
+	require.Equal(t, "table 3, handle 1", info.DecodedStartKey)
+	require.Equal(t, "table 3, handle 10", info.DecodedEndKey)
+	_, err = reader.ReadDataFile(ctx, "4.sst")
+	require.Error(t, err)
+}
+
+func TestDecodeKey(t *testing.T) {
+	require.Equal(t, "", DecodeKey(nil))
+	require.Equal(t, "table 10", DecodeKey(tablecodec.GenTablePrefix(10)))
+	require.Equal(t, "table 10, handle 1", DecodeKey(tablecodec.EncodeRowKeyWithHandle(10, kv.IntHandle(1))))
+	require.Equal(t, "table 10, index 1, values []", DecodeKey(tablecodec.EncodeTableIndexPrefix(10, 1)))
+	require.Equal(t, `"m"`, DecodeKey([]byte("m")))
+}
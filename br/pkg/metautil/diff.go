@@ -0,0 +1,389 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package metautil
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+)
+
+// SchemaSnapshot is the schemas of the tables in a backup or a cluster. The
+// tables are identified by the names, since the IDs differ between clusters.
+type SchemaSnapshot struct {
+	// StartVersion and EndVersion are the versions of the backup, they are 0
+	// for a cluster.
+	StartVersion uint64
+	EndVersion   uint64
+
+	dbs    map[string]*model.DBInfo
+	tables map[string]*snapshotTable
+}
+
+type snapshotTable struct {
+	db   *model.DBInfo
+	info *model.TableInfo
+	// the size is only known for the tables in a backup.
+	hasSize    bool
+	totalKvs   uint64
+	totalBytes uint64
+}
+
+// NewSchemaSnapshot creates an empty SchemaSnapshot.
+func NewSchemaSnapshot() *SchemaSnapshot {
+	return &SchemaSnapshot{
+		dbs:    make(map[string]*model.DBInfo),
+		tables: make(map[string]*snapshotTable),
+	}
+}
+
+// LoadSchemaSnapshot loads the schemas and the sizes of the tables in the backup.
+func LoadSchemaSnapshot(ctx context.Context, reader *MetaReader) (*SchemaSnapshot, error) {
+	s := NewSchemaSnapshot()
+	s.StartVersion = reader.backupMeta.StartVersion
+	s.EndVersion = reader.backupMeta.EndVersion
+
+	ch := make(chan *Table)
+	errCh := make(chan error)
+	go func() {
+		if err := reader.ReadSchemasFiles(ctx, ch); err != nil {
+			errCh <- errors.Trace(err)
+		}
+		close(ch)
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, errors.Trace(ctx.Err())
+		case err := <-errCh:
+			return nil, errors.Trace(err)
+		case tbl, ok := <-ch:
+			if !ok {
+				return s, nil
+			}
+			if tbl.Info == nil {
+				s.AddDatabase(tbl.DB)
+				continue
+			}
+			t := s.addTable(tbl.DB, tbl.Info)
+			t.hasSize = true
+			for _, f := range tbl.Files {
+				t.totalKvs += f.TotalKvs
+				t.totalBytes += f.TotalBytes
+			}
+		}
+	}
+}
+
+// AddDatabase adds the database to the snapshot.
+func (s *SchemaSnapshot) AddDatabase(db *model.DBInfo) {
+	s.dbs[db.Name.L] = db
+}
+
+// AddTable adds the table to the snapshot, the size of the table is unknown.
+func (s *SchemaSnapshot) AddTable(db *model.DBInfo, tbl *model.TableInfo) {
+	s.addTable(db, tbl)
+}
+
+func (s *SchemaSnapshot) addTable(db *model.DBInfo, tbl *model.TableInfo) *snapshotTable {
+	s.AddDatabase(db)
+	t := &snapshotTable{db: db, info: tbl}
+	s.tables[tableName(db.Name.O, tbl.Name.O)] = t
+	return t
+}
+
+// HasDatabase returns whether the database is in the snapshot, the name is case-insensitive.
+func (s *SchemaSnapshot) HasDatabase(name string) bool {
+	_, ok := s.dbs[strings.ToLower(name)]
+	return ok
+}
+
+func tableName(db, tbl string) string {
+	return fmt.Sprintf("`%s`.`%s`", strings.ToLower(db), strings.ToLower(tbl))
+}
+
+// SnapshotSummary is the summary of a SchemaSnapshot.
+type SnapshotSummary struct {
+	StartVersion  uint64 `json:"start_version,omitempty"`
+	EndVersion    uint64 `json:"end_version,omitempty"`
+	DatabaseCount int    `json:"database_count"`
+	TableCount    int    `json:"table_count"`
+	TotalKvs      uint64 `json:"total_kvs,omitempty"`
+	TotalBytes    uint64 `json:"total_bytes,omitempty"`
+}
+
+// ValueChange is a changed value, such as the definition of a column.
+type ValueChange struct {
+	Name   string `json:"name"`
+	Base   string `json:"base"`
+	Target string `json:"target"`
+}
+
+// TableDiff is the difference of a table between two snapshots.
+type TableDiff struct {
+	Name           string         `json:"name"`
+	AddedColumns   []string       `json:"added_columns,omitempty"`
+	DroppedColumns []string       `json:"dropped_columns,omitempty"`
+	ChangedColumns []*ValueChange `json:"changed_columns,omitempty"`
+	AddedIndexes   []string       `json:"added_indexes,omitempty"`
+	DroppedIndexes []string       `json:"dropped_indexes,omitempty"`
+	ChangedIndexes []*ValueChange `json:"changed_indexes,omitempty"`
+	// ChangedOptions is the changed table options, such as the charset and the partitioning.
+	ChangedOptions []*ValueChange `json:"changed_options,omitempty"`
+	// KvsDelta and BytesDelta are only set if the sizes of the table in both snapshots are known.
+	KvsDelta   int64 `json:"kvs_delta,omitempty"`
+	BytesDelta int64 `json:"bytes_delta,omitempty"`
+}
+
+func (d *TableDiff) schemaChanged() bool {
+	return len(d.AddedColumns)+len(d.DroppedColumns)+len(d.ChangedColumns)+
+		len(d.AddedIndexes)+len(d.DroppedIndexes)+len(d.ChangedIndexes)+len(d.ChangedOptions) > 0
+}
+
+// SchemaDiffReport is the difference between two schema snapshots, the base is
+// usually a backup, and the target is a later backup or the cluster to restore to.
+type SchemaDiffReport struct {
+	Base             SnapshotSummary `json:"base"`
+	Target           SnapshotSummary `json:"target"`
+	AddedDatabases   []string        `json:"added_databases,omitempty"`
+	DroppedDatabases []string        `json:"dropped_databases,omitempty"`
+	AddedTables      []string        `json:"added_tables,omitempty"`
+	DroppedTables    []string        `json:"dropped_tables,omitempty"`
+	ChangedTables    []*TableDiff    `json:"changed_tables,omitempty"`
+	// Warnings are the problems found, e.g. the target isn't an incremental
+	// backup continuing the base.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// HasSchemaDrift returns whether the schemas of the snapshots differ.
+func (r *SchemaDiffReport) HasSchemaDrift() bool {
+	if len(r.AddedDatabases)+len(r.DroppedDatabases)+len(r.AddedTables)+len(r.DroppedTables) > 0 {
+		return true
+	}
+	for _, t := range r.ChangedTables {
+		if t.schemaChanged() {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *SchemaSnapshot) summary() SnapshotSummary {
+	summary := SnapshotSummary{
+		StartVersion:  s.StartVersion,
+		EndVersion:    s.EndVersion,
+		DatabaseCount: len(s.dbs),
+		TableCount:    len(s.tables),
+	}
+	for _, t := range s.tables {
+		summary.TotalKvs += t.totalKvs
+		summary.TotalBytes += t.totalBytes
+	}
+	return summary
+}
+
+// DiffSchemaSnapshots returns the difference from the base snapshot to the target.
+func DiffSchemaSnapshots(base, target *SchemaSnapshot) *SchemaDiffReport {
+	report := &SchemaDiffReport{
+		Base:   base.summary(),
+		Target: target.summary(),
+	}
+	if base.EndVersion > 0 && target.EndVersion > 0 {
+		if target.StartVersion > 0 && target.StartVersion != base.EndVersion {
+			report.Warnings = append(report.Warnings, fmt.Sprintf(
+				"the start version %d of the incremental backup isn't the end version %d of the base backup",
+				target.StartVersion, base.EndVersion))
+		}
+		if target.EndVersion < base.EndVersion {
+			report.Warnings = append(report.Warnings, fmt.Sprintf(
+				"the end version %d of the target backup is older than the end version %d of the base backup",
+				target.EndVersion, base.EndVersion))
+		}
+	}
+
+	report.AddedDatabases, report.DroppedDatabases = diffKeys(dbNames(base.dbs), dbNames(target.dbs))
+	baseTables, targetTables := make(map[string]struct{}), make(map[string]struct{})
+	for name := range base.tables {
+		baseTables[name] = struct{}{}
+	}
+	for name := range target.tables {
+		targetTables[name] = struct{}{}
+	}
+	report.AddedTables, report.DroppedTables = diffKeys(baseTables, targetTables)
+
+	// the IDs of the tables only make sense between backups of the same cluster,
+	// a changed ID means the table is recreated, e.g. truncated.
+	compareIDs := base.EndVersion > 0 && target.EndVersion > 0
+	names := make([]string, 0, len(base.tables))
+	for name := range base.tables {
+		if _, ok := target.tables[name]; ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if d := diffTable(name, base.tables[name], target.tables[name], compareIDs); d != nil {
+			report.ChangedTables = append(report.ChangedTables, d)
+		}
+	}
+	return report
+}
+
+func dbNames(dbs map[string]*model.DBInfo) map[string]struct{} {
+	names := make(map[string]struct{}, len(dbs))
+	for name := range dbs {
+		names[name] = struct{}{}
+	}
+	return names
+}
+
+// diffKeys returns the sorted keys only in the target and the ones only in the base.
+func diffKeys(base, target map[string]struct{}) (added, dropped []string) {
+	for k := range target {
+		if _, ok := base[k]; !ok {
+			added = append(added, k)
+		}
+	}
+	for k := range base {
+		if _, ok := target[k]; !ok {
+			dropped = append(dropped, k)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(dropped)
+	return added, dropped
+}
+
+// diffDefinitions compares the definitions by the names.
+func diffDefinitions(base, target map[string]string) (added, dropped []string, changed []*ValueChange) {
+	baseKeys, targetKeys := make(map[string]struct{}), make(map[string]struct{})
+	for k := range base {
+		baseKeys[k] = struct{}{}
+	}
+	for k := range target {
+		targetKeys[k] = struct{}{}
+	}
+	added, dropped = diffKeys(baseKeys, targetKeys)
+	names := make([]string, 0, len(base))
+	for name, def := range base {
+		if targetDef, ok := target[name]; ok && targetDef != def {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		changed = append(changed, &ValueChange{Name: name, Base: base[name], Target: target[name]})
+	}
+	return added, dropped, changed
+}
+
+func diffTable(name string, base, target *snapshotTable, compareIDs bool) *TableDiff {
+	d := &TableDiff{Name: name}
+	d.AddedColumns, d.DroppedColumns, d.ChangedColumns = diffDefinitions(columnDefs(base.info), columnDefs(target.info))
+	d.AddedIndexes, d.DroppedIndexes, d.ChangedIndexes = diffDefinitions(indexDefs(base.info), indexDefs(target.info))
+	_, _, d.ChangedOptions = diffDefinitions(tableOptions(base.info, compareIDs), tableOptions(target.info, compareIDs))
+	if base.hasSize && target.hasSize {
+		d.KvsDelta = int64(target.totalKvs) - int64(base.totalKvs)
+		d.BytesDelta = int64(target.totalBytes) - int64(base.totalBytes)
+	}
+	if !d.schemaChanged() && d.KvsDelta == 0 && d.BytesDelta == 0 {
+		return nil
+	}
+	return d
+}
+
+func columnDefs(tbl *model.TableInfo) map[string]string {
+	defs := make(map[string]string, len(tbl.Columns))
+	for _, col := range tbl.Columns {
+		if col.State != model.StatePublic {
+			continue
+		}
+		def := col.FieldType.String()
+		if mysql.HasNotNullFlag(col.GetFlag()) {
+			def += " NOT NULL"
+		}
+		if col.IsGenerated() {
+			def += fmt.Sprintf(" GENERATED ALWAYS AS (%s)", col.GeneratedExprString)
+			if col.GeneratedStored {
+				def += " STORED"
+			}
+		} else if v := col.GetDefaultValue(); v != nil {
+			def += fmt.Sprintf(" DEFAULT %v", v)
+		}
+		if mysql.HasAutoIncrementFlag(col.GetFlag()) {
+			def += " AUTO_INCREMENT"
+		}
+		defs[col.Name.L] = def
+	}
+	return defs
+}
+
+func indexDefs(tbl *model.TableInfo) map[string]string {
+	defs := make(map[string]string, len(tbl.Indices)+1)
+	if tbl.PKIsHandle {
+		if pk := tbl.GetPkColInfo(); pk != nil {
+			defs["primary"] = fmt.Sprintf("PRIMARY KEY (%s) CLUSTERED", pk.Name.O)
+		}
+	}
+	for _, idx := range tbl.Indices {
+		if idx.State != model.StatePublic {
+			continue
+		}
+		cols := make([]string, 0, len(idx.Columns))
+		for _, col := range idx.Columns {
+			if col.Length > 0 {
+				cols = append(cols, fmt.Sprintf("%s(%d)", col.Name.O, col.Length))
+			} else {
+				cols = append(cols, col.Name.O)
+			}
+		}
+		var def string
+		switch {
+		case idx.Primary:
+			def = "PRIMARY KEY"
+		case idx.Unique:
+			def = "UNIQUE KEY"
+		default:
+			def = "KEY"
+		}
+		def += fmt.Sprintf(" (%s)", strings.Join(cols, ","))
+		if idx.Primary && tbl.IsCommonHandle {
+			def += " CLUSTERED"
+		}
+		if idx.Invisible {
+			def += " INVISIBLE"
+		}
+		defs[idx.Name.L] = def
+	}
+	return defs
+}
+
+func tableOptions(tbl *model.TableInfo, withID bool) map[string]string {
+	options := map[string]string{
+		"charset": tbl.Charset,
+		"collate": tbl.Collate,
+	}
+	if withID {
+		options["id"] = strconv.FormatInt(tbl.ID, 10)
+	}
+	if tbl.IsView() {
+		options["view"] = tbl.View.SelectStmt
+	}
+	if tbl.IsSequence() {
+		options["sequence"] = "true"
+	}
+	if pi := tbl.GetPartitionInfo(); pi != nil {
+		parts := make([]string, 0, len(pi.Definitions))
+		for _, def := range pi.Definitions {
+			parts = append(parts, def.Name.O)
+		}
+		options["partition"] = fmt.Sprintf("%s (%s) [%s]", pi.Type, pi.Expr, strings.Join(parts, ","))
+	}
+	return options
+}
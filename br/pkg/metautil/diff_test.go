@@ -0,0 +1,132 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package metautil
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/types"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/stretchr/testify/require"
+)
+
+func newDiffTestTable(id int64, name string, cols ...string) *model.TableInfo {
+	tbl := &model.TableInfo{ID: id, Name: model.NewCIStr(name), Charset: "utf8mb4", Collate: "utf8mb4_bin"}
+	for i, col := range cols {
+		tbl.Columns = append(tbl.Columns, &model.ColumnInfo{
+			ID:        int64(i + 1),
+			Name:      model.NewCIStr(col),
+			Offset:    i,
+			State:     model.StatePublic,
+			FieldType: *types.NewFieldType(mysql.TypeLonglong),
+		})
+	}
+	return tbl
+}
+
+func newDiffTestBackupMeta(t *testing.T, startVersion, endVersion uint64, db *model.DBInfo, tbls ...*model.TableInfo) *backuppb.BackupMeta {
+	meta := &backuppb.BackupMeta{StartVersion: startVersion, EndVersion: endVersion}
+	dbData, err := json.Marshal(db)
+	require.NoError(t, err)
+	for _, tbl := range tbls {
+		tblData, err := json.Marshal(tbl)
+		require.NoError(t, err)
+		meta.Schemas = append(meta.Schemas, &backuppb.Schema{Db: dbData, Table: tblData})
+		meta.Files = append(meta.Files, &backuppb.File{
+			Name:       tbl.Name.L + ".sst",
+			StartKey:   tablecodec.EncodeRowKeyWithHandle(tbl.ID, kv.IntHandle(1)),
+			EndKey:     tablecodec.EncodeRowKeyWithHandle(tbl.ID, kv.IntHandle(10)),
+			TotalKvs:   uint64(tbl.ID),
+			TotalBytes: uint64(tbl.ID) * 10,
+		})
+	}
+	return meta
+}
+
+func TestDiffSchemaSnapshots(t *testing.T) {
+	ctx := context.Background()
+	s, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	db := &model.DBInfo{ID: 1, Name: model.NewCIStr("test")}
+	t1 := newDiffTestTable(2, "t1", "a", "b")
+	t2 := newDiffTestTable(3, "t2", "a")
+	base, err := LoadSchemaSnapshot(ctx, NewMetaReader(newDiffTestBackupMeta(t, 0, 100, db, t1, t2), s, nil))
+	require.NoError(t, err)
+	require.True(t, base.HasDatabase("TEST"))
+
+	// the cluster: t1 has a dropped column, a changed column and a new index,
+	// t2 is dropped and t3 is created.
+	newT1 := newDiffTestTable(20, "t1", "a", "c")
+	newT1.Columns[0].AddFlag(mysql.NotNullFlag)
+	newT1.Indices = []*model.IndexInfo{{
+		Name:    model.NewCIStr("idx"),
+		Columns: []*model.IndexColumn{{Name: model.NewCIStr("c"), Length: types.UnspecifiedLength}},
+		State:   model.StatePublic,
+	}}
+	cluster := NewSchemaSnapshot()
+	cluster.AddTable(db, newT1)
+	cluster.AddTable(db, newDiffTestTable(40, "t3", "a"))
+	cluster.AddDatabase(&model.DBInfo{ID: 5, Name: model.NewCIStr("empty")})
+
+	report := DiffSchemaSnapshots(base, cluster)
+	require.True(t, report.HasSchemaDrift())
+	require.Equal(t, SnapshotSummary{EndVersion: 100, DatabaseCount: 1, TableCount: 2, TotalKvs: 5, TotalBytes: 50}, report.Base)
+	require.Equal(t, SnapshotSummary{DatabaseCount: 2, TableCount: 2}, report.Target)
+	require.Equal(t, []string{"empty"}, report.AddedDatabases)
+	require.Empty(t, report.DroppedDatabases)
+	require.Equal(t, []string{"`test`.`t3`"}, report.AddedTables)
+	require.Equal(t, []string{"`test`.`t2`"}, report.DroppedTables)
+	require.Empty(t, report.Warnings)
+	// the table ID and the size are ignored when diffing with a cluster.
+	require.Len(t, report.ChangedTables, 1)
+	diff := report.ChangedTables[0]
+	require.Equal(t, "`test`.`t1`", diff.Name)
+	require.Equal(t, []string{"c"}, diff.AddedColumns)
+	require.Equal(t, []string{"b"}, diff.DroppedColumns)
+	require.Equal(t, []*ValueChange{{Name: "a", Base: "bigint(20)", Target: "bigint(20) NOT NULL"}}, diff.ChangedColumns)
+	require.Equal(t, []string{"idx"}, diff.AddedIndexes)
+	require.Empty(t, diff.ChangedOptions)
+	require.Zero(t, diff.KvsDelta)
+
+	report = DiffSchemaSnapshots(base, base)
+	require.False(t, report.HasSchemaDrift())
+	require.Empty(t, report.ChangedTables)
+}
+
+func TestDiffIncrementalBackup(t *testing.T) {
+	ctx := context.Background()
+	s, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	db := &model.DBInfo{ID: 1, Name: model.NewCIStr("test")}
+	base, err := LoadSchemaSnapshot(ctx, NewMetaReader(newDiffTestBackupMeta(t, 0, 100, db, newDiffTestTable(2, "t1", "a")), s, nil))
+	require.NoError(t, err)
+
+	// t1 is truncated.
+	inc, err := LoadSchemaSnapshot(ctx, NewMetaReader(newDiffTestBackupMeta(t, 100, 200, db, newDiffTestTable(4, "t1", "a")), s, nil))
+	require.NoError(t, err)
+	report := DiffSchemaSnapshots(base, inc)
+	require.Empty(t, report.Warnings)
+	require.True(t, report.HasSchemaDrift())
+	require.Len(t, report.ChangedTables, 1)
+	diff := report.ChangedTables[0]
+	require.Equal(t, []*ValueChange{{Name: "id", Base: "2", Target: "4"}}, diff.ChangedOptions)
+	require.Equal(t, int64(2), diff.KvsDelta)
+	require.Equal(t, int64(20), diff.BytesDelta)
+
+	// the incremental backup doesn't continue the base.
+	inc, err = LoadSchemaSnapshot(ctx, NewMetaReader(newDiffTestBackupMeta(t, 150, 200, db, newDiffTestTable(2, "t1", "a")), s, nil))
+	require.NoError(t, err)
+	report = DiffSchemaSnapshots(base, inc)
+	require.Len(t, report.Warnings, 1)
+	require.False(t, report.HasSchemaDrift())
+	require.Empty(t, report.ChangedTables)
+}
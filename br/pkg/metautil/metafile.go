@@ -9,6 +9,7 @@ import (
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -53,6 +54,9 @@ const (
 	MetaV1 = iota
 	// MetaV2 represents the new version of backupmeta.
 	MetaV2
+	// MetaV3 represents the backupmeta whose schemas are sharded by the
+	// databases, the names of the databases are the keys of the index.
+	MetaV3
 )
 
 // CreateMetaFileName is the name of meta file.
@@ -166,6 +170,8 @@ type MetaReader struct {
 	storage    storage.ExternalStorage
 	backupMeta *backuppb.BackupMeta
 	cipher     *backuppb.CipherInfo
+	// dbFilter filters the schemas sharded by the databases.
+	dbFilter func(db string) bool
 }
 
 // NewMetaReader creates MetaReader.
@@ -180,6 +186,12 @@ func NewMetaReader(
 	}
 }
 
+// SetDBFilter sets the filter of the databases, the schemas of the databases
+// not matched are skipped without being loaded if the backupmeta is v3.
+func (reader *MetaReader) SetDBFilter(filter func(db string) bool) {
+	reader.dbFilter = filter
+}
+
 func (reader *MetaReader) readDDLs(ctx context.Context, output func([]byte)) error {
 	// Read backupmeta v1 metafiles.
 	// if the backupmeta equals to v1, or doesn't not exists(old version).
@@ -207,7 +219,25 @@ func (reader *MetaReader) readSchemas(ctx context.Context, output func(*backuppb
 			output(s)
 		}
 	}
-	return walkLeafMetaFile(ctx, reader.storage, reader.backupMeta.SchemaIndex, reader.cipher, outputFn)
+	if reader.backupMeta.Version < MetaV3 || reader.dbFilter == nil || reader.backupMeta.SchemaIndex == nil {
+		return walkLeafMetaFile(ctx, reader.storage, reader.backupMeta.SchemaIndex, reader.cipher, outputFn)
+	}
+	// Read backupmeta v3 metafiles, the shards of the databases not matched
+	// are skipped.
+	skipped := 0
+	for _, shard := range reader.backupMeta.SchemaIndex.MetaFiles {
+		if !reader.dbFilter(string(shard.StartKey)) {
+			skipped++
+			continue
+		}
+		node := &backuppb.MetaFile{MetaFiles: []*backuppb.File{shard}}
+		if err := walkLeafMetaFile(ctx, reader.storage, node, reader.cipher, outputFn); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	log.Info("skip the schema shards of the databases filtered",
+		zap.Int("shards", len(reader.backupMeta.SchemaIndex.MetaFiles)), zap.Int("skipped", skipped))
+	return nil
 }
 
 func (reader *MetaReader) readDataFiles(ctx context.Context, output func(*backuppb.File)) error {
@@ -492,12 +522,16 @@ type MetaWriter struct {
 	storage           storage.ExternalStorage
 	metafileSizeLimit int
 	// a flag to control whether we generate v1 or v2 meta.
-	useV2Meta  bool
+	useV2Meta bool
+	// a flag to control whether the schemas are sharded by the databases.
+	useV3Meta  bool
 	backupMeta *backuppb.BackupMeta
 	// used to generate MetaFile name.
 	metafileSizes  map[string]int
 	metafileSeqNum map[string]int
 	metafiles      *sizedMetaFile
+	// the schemas sharded by the databases, only used by v3 meta.
+	schemaShards map[string]*sizedMetaFile
 	// the start time of StartWriteMetas
 	// it's use to calculate the time costs.
 	start time.Time
@@ -547,6 +581,14 @@ func NewMetaWriter(
 	}
 }
 
+// UseMetaV3 makes the writer generate the v3 meta, the schemas are sharded by
+// the databases so the reader can skip the databases filtered.
+func (writer *MetaWriter) UseMetaV3() {
+	writer.useV2Meta = true
+	writer.useV3Meta = true
+	writer.schemaShards = make(map[string]*sizedMetaFile)
+}
+
 func (writer *MetaWriter) reset() {
 	writer.metasCh = make(chan interface{}, MaxBatchSize)
 	writer.errCh = make(chan error)
@@ -603,6 +645,12 @@ func (writer *MetaWriter) StartWriteMetasAsync(ctx context.Context, op AppendOp)
 					log.Info("write metas finished", zap.String("type", op.name()))
 					return
 				}
+				if writer.useV3Meta && op == AppendSchema {
+					if err := writer.appendSchemaShard(ctx, meta.(*backuppb.Schema)); err != nil {
+						writer.errCh <- err
+					}
+					continue
+				}
 				needFlush := writer.metafiles.append(meta, op)
 				if writer.useV2Meta && needFlush {
 					err := writer.flushMetasV2(ctx, op)
@@ -627,9 +675,14 @@ func (writer *MetaWriter) FinishWriteMetas(ctx context.Context, op AppendOp) err
 	}
 	var err error
 	// flush the buffered meta
-	if !writer.useV2Meta {
+	switch {
+	case writer.useV3Meta && op == AppendSchema:
+		if err = writer.flushSchemaShards(ctx); err != nil {
+			return errors.Trace(err)
+		}
+	case !writer.useV2Meta:
 		writer.fillMetasV1(ctx, op)
-	} else {
+	default:
 		err = writer.flushMetasV2(ctx, op)
 		if err != nil {
 			return errors.Trace(err)
@@ -648,7 +701,9 @@ func (writer *MetaWriter) FinishWriteMetas(ctx context.Context, op AppendOp) err
 // FlushBackupMeta flush the `backupMeta` to `ExternalStorage`
 func (writer *MetaWriter) FlushBackupMeta(ctx context.Context) error {
 	// Set schema version
-	if writer.useV2Meta {
+	if writer.useV3Meta {
+		writer.backupMeta.Version = MetaV3
+	} else if writer.useV2Meta {
 		writer.backupMeta.Version = MetaV2
 	} else {
 		writer.backupMeta.Version = MetaV1
@@ -686,11 +741,58 @@ func (writer *MetaWriter) fillMetasV1(_ context.Context, op AppendOp) {
 	writer.flushedItemNum += writer.metafiles.itemNum
 }
 
+// appendSchemaShard appends the schema to the shard of its database, the shard
+// is flushed once it exceeds the size limit.
+func (writer *MetaWriter) appendSchemaShard(ctx context.Context, schema *backuppb.Schema) error {
+	dbInfo := &model.DBInfo{}
+	if err := json.Unmarshal(schema.Db, dbInfo); err != nil {
+		return errors.Trace(err)
+	}
+	db := dbInfo.Name.O
+	shard, ok := writer.schemaShards[db]
+	if !ok {
+		shard = NewSizedMetaFile(writer.metafileSizeLimit)
+		writer.schemaShards[db] = shard
+	}
+	if !shard.append(schema, AppendSchema) {
+		return nil
+	}
+	delete(writer.schemaShards, db)
+	return errors.Trace(writer.flushMetaFile(ctx, AppendSchema, shard, []byte(db)))
+}
+
+// flushSchemaShards flushes the shards of all the databases by the order of
+// their names.
+func (writer *MetaWriter) flushSchemaShards(ctx context.Context) error {
+	dbs := make([]string, 0, len(writer.schemaShards))
+	for db := range writer.schemaShards {
+		dbs = append(dbs, db)
+	}
+	sort.Strings(dbs)
+	for _, db := range dbs {
+		if err := writer.flushMetaFile(ctx, AppendSchema, writer.schemaShards[db], []byte(db)); err != nil {
+			return errors.Trace(err)
+		}
+		delete(writer.schemaShards, db)
+	}
+	return nil
+}
+
 func (writer *MetaWriter) flushMetasV2(ctx context.Context, op AppendOp) error {
+	if err := writer.flushMetaFile(ctx, op, writer.metafiles, nil); err != nil {
+		return errors.Trace(err)
+	}
+	writer.metafiles = NewSizedMetaFile(writer.metafiles.sizeLimit)
+	return nil
+}
+
+// flushMetaFile flushes the metafile to the storage and appends it to the
+// index, the key is recorded as the key of the metafile in the index.
+func (writer *MetaWriter) flushMetaFile(ctx context.Context, op AppendOp, metafiles *sizedMetaFile, key []byte) error {
 	var index *backuppb.MetaFile
 	switch op {
 	case AppendSchema:
-		if len(writer.metafiles.root.Schemas) == 0 {
+		if len(metafiles.root.Schemas) == 0 {
 			return nil
 		}
 		// Add the metafile to backupmeta and reset metafiles.
@@ -699,7 +801,7 @@ func (writer *MetaWriter) flushMetasV2(ctx context.Context, op AppendOp) error {
 		}
 		index = writer.backupMeta.SchemaIndex
 	case AppendDataFile:
-		if len(writer.metafiles.root.DataFiles) == 0 {
+		if len(metafiles.root.DataFiles) == 0 {
 			return nil
 		}
 		// Add the metafile to backupmeta and reset metafiles.
@@ -708,7 +810,7 @@ func (writer *MetaWriter) flushMetasV2(ctx context.Context, op AppendOp) error {
 		}
 		index = writer.backupMeta.FileIndex
 	case AppendDDL:
-		if len(writer.metafiles.root.Ddls) == 0 {
+		if len(metafiles.root.Ddls) == 0 {
 			return nil
 		}
 		if writer.backupMeta.DdlIndexes == nil {
@@ -716,14 +818,14 @@ func (writer *MetaWriter) flushMetasV2(ctx context.Context, op AppendOp) error {
 		}
 		index = writer.backupMeta.DdlIndexes
 	}
-	content, err := writer.metafiles.root.Marshal()
+	content, err := metafiles.root.Marshal()
 	if err != nil {
 		return errors.Trace(err)
 	}
 
 	name := op.name()
-	writer.metafileSizes[name] += writer.metafiles.size
-	writer.totalDataFileSize += writer.metafiles.dataFileSize
+	writer.metafileSizes[name] += metafiles.size
+	writer.totalDataFileSize += metafiles.dataFileSize
 
 	// Flush metafiles to external storage.
 	writer.metafileSeqNum["metafiles"]++
@@ -745,9 +847,12 @@ func (writer *MetaWriter) flushMetasV2(ctx context.Context, op AppendOp) error {
 		CipherIv: iv,
 	}
 
+	if len(key) > 0 {
+		file.StartKey, file.EndKey = key, key
+	}
+
 	index.MetaFiles = append(index.MetaFiles, file)
-	writer.flushedItemNum += writer.metafiles.itemNum
-	writer.metafiles = NewSizedMetaFile(writer.metafiles.sizeLimit)
+	writer.flushedItemNum += metafiles.itemNum
 	return nil
 }
 
@@ -5,12 +5,17 @@ package metautil
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	backuppb "github.com/pingcap/kvproto/pkg/brpb"
 	"github.com/pingcap/kvproto/pkg/encryptionpb"
 	mockstorage "github.com/pingcap/tidb/br/pkg/mock/storage"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/parser/model"
 	"github.com/stretchr/testify/require"
 )
 
@@ -253,3 +258,49 @@ func TestMetaFileSize(t *testing.T) {
 	t.Logf("needFlush: %v, %+v", needFlush, metafiles)
 	require.True(t, needFlush)
 }
+
+func TestMetaV3ShardSchemasByDB(t *testing.T) {
+	ctx := context.Background()
+	s, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	cipher := &backuppb.CipherInfo{CipherType: encryptionpb.EncryptionMethod_PLAINTEXT}
+
+	writer := NewMetaWriter(s, MetaFileSize, false, "", cipher)
+	writer.UseMetaV3()
+	writer.StartWriteMetasAsync(ctx, AppendSchema)
+	for i, db := range []string{"db2", "db1", "db2", "db3", "db1"} {
+		dbInfo, err := json.Marshal(&model.DBInfo{ID: int64(len(db)), Name: model.NewCIStr(db)})
+		require.NoError(t, err)
+		tableInfo, err := json.Marshal(&model.TableInfo{ID: int64(100 + i), Name: model.NewCIStr(fmt.Sprintf("t%d", i))})
+		require.NoError(t, err)
+		require.NoError(t, writer.Send(&backuppb.Schema{Db: dbInfo, Table: tableInfo}, AppendSchema))
+	}
+	require.NoError(t, writer.FinishWriteMetas(ctx, AppendSchema))
+	writer.StartWriteMetasAsync(ctx, AppendDataFile)
+	require.NoError(t, writer.FinishWriteMetas(ctx, AppendDataFile))
+	require.NoError(t, writer.FlushBackupMeta(ctx))
+
+	meta := writer.Backupmeta()
+	require.Equal(t, int32(MetaV3), meta.Version)
+	keys := make([]string, 0, len(meta.SchemaIndex.MetaFiles))
+	for _, f := range meta.SchemaIndex.MetaFiles {
+		keys = append(keys, string(f.StartKey))
+	}
+	require.Equal(t, []string{"db1", "db2", "db3"}, keys)
+
+	readTables := func(filter func(string) bool) []string {
+		reader := NewMetaReader(meta, s, cipher)
+		reader.SetDBFilter(filter)
+		ch := make(chan *Table, 8)
+		require.NoError(t, reader.ReadSchemasFiles(ctx, ch))
+		close(ch)
+		var names []string
+		for tbl := range ch {
+			names = append(names, tbl.DB.Name.O+"."+tbl.Info.Name.O)
+		}
+		sort.Strings(names)
+		return names
+	}
+	require.Equal(t, []string{"db1.t1", "db1.t4"}, readTables(func(db string) bool { return db == "db1" }))
+	require.Len(t, readTables(nil), 5)
+}
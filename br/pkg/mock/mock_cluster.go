@@ -39,11 +39,33 @@ type Cluster struct {
 	DSN        string
 	PDClient   pd.Client
 	HttpServer *http.Server
+	// StoreIDs is the IDs of the stores bootstrapped in the cluster.
+	StoreIDs []uint64
+	// Stores simulates the stores for BR, the stores reported by PDClient are overridden by it.
+	Stores *Stores
+}
+
+type clusterOptions struct {
+	storeCount int
+}
+
+// ClusterOption configures the mock cluster.
+type ClusterOption func(*clusterOptions)
+
+// WithStores bootstraps the mock cluster with n stores, the first region has a peer on each store.
+func WithStores(n int) ClusterOption {
+	return func(o *clusterOptions) {
+		o.storeCount = n
+	}
 }
 
 // NewCluster create a new mock cluster.
-func NewCluster() (*Cluster, error) {
-	cluster := &Cluster{}
+func NewCluster(opts ...ClusterOption) (*Cluster, error) {
+	o := clusterOptions{storeCount: 1}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	cluster := &Cluster{Stores: NewStores()}
 
 	pprofOnce.Do(func() {
 		go func() {
@@ -60,7 +82,12 @@ func NewCluster() (*Cluster, error) {
 
 	storage, err := mockstore.NewMockStore(
 		mockstore.WithClusterInspector(func(c testutils.Cluster) {
-			mockstore.BootstrapWithSingleStore(c)
+			if o.storeCount > 1 {
+				cluster.StoreIDs, _, _, _ = mockstore.BootstrapWithMultiStores(c, o.storeCount)
+			} else {
+				storeID, _, _ := mockstore.BootstrapWithSingleStore(c)
+				cluster.StoreIDs = []uint64{storeID}
+			}
 			cluster.Cluster = c
 		}),
 	)
@@ -77,7 +104,10 @@ func NewCluster() (*Cluster, error) {
 	}
 	cluster.Domain = dom
 
-	cluster.PDClient = storage.(tikv.Storage).GetRegionCache().PDClient()
+	cluster.PDClient = storesPDClient{
+		Client: storage.(tikv.Storage).GetRegionCache().PDClient(),
+		stores: cluster.Stores,
+	}
 	return cluster, nil
 }
 
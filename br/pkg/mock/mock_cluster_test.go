@@ -3,8 +3,13 @@
 package mock_test
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/pingcap/kvproto/pkg/import_sstpb"
+	"github.com/pingcap/kvproto/pkg/metapb"
 	"github.com/pingcap/tidb/br/pkg/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/goleak"
@@ -22,3 +27,63 @@ func TestSmoke(t *testing.T) {
 	require.NoError(t, m.Start())
 	m.Stop()
 }
+
+func TestMultiStores(t *testing.T) {
+	m, err := mock.NewCluster(mock.WithStores(3))
+	require.NoError(t, err)
+	defer m.Stop()
+	require.Len(t, m.StoreIDs, 3)
+
+	ctx := context.Background()
+	s1, s2, s3 := m.StoreIDs[0], m.StoreIDs[1], m.StoreIDs[2]
+	m.Stores.SetLabels(s1, map[string]string{"zone": "z1", "engine": "tiflash"})
+	m.Stores.SetState(s2, metapb.StoreState_Offline)
+	stores, err := m.PDClient.GetAllStores(ctx)
+	require.NoError(t, err)
+	require.Len(t, stores, 3)
+	for _, store := range stores {
+		switch store.GetId() {
+		case s1:
+			require.Equal(t, []*metapb.StoreLabel{{Key: "engine", Value: "tiflash"}, {Key: "zone", Value: "z1"}}, store.GetLabels())
+			require.Equal(t, metapb.StoreState_Up, store.GetState())
+		case s2:
+			require.Empty(t, store.GetLabels())
+			require.Equal(t, metapb.StoreState_Offline, store.GetState())
+		}
+	}
+	store, err := m.PDClient.GetStore(ctx, s2)
+	require.NoError(t, err)
+	require.Equal(t, metapb.StoreState_Offline, store.GetState())
+
+	// inject failure and latency.
+	injectedErr := errors.New("store is down")
+	m.Stores.SetFailure(s2, injectedErr)
+	m.Stores.SetLatency(s3, 100*time.Millisecond)
+	req := &import_sstpb.SetDownloadSpeedLimitRequest{SpeedLimit: 42}
+	_, err = m.Stores.SetDownloadSpeedLimit(ctx, s1, req)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), m.Stores.SpeedLimit(s1))
+	_, err = m.Stores.SetDownloadSpeedLimit(ctx, s2, req)
+	require.ErrorIs(t, err, injectedErr)
+	require.Equal(t, uint64(0), m.Stores.SpeedLimit(s2))
+	require.Equal(t, 1, m.Stores.CallCount(s2, "SetDownloadSpeedLimit"))
+	require.False(t, m.Stores.IsStoreHealthy(s2))
+	require.True(t, m.Stores.IsStoreHealthy(s3))
+	start := time.Now()
+	_, err = m.Stores.SetDownloadSpeedLimit(ctx, s3, req)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+
+	// the latency respects the context.
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = m.Stores.IngestSST(cctx, s3, &import_sstpb.IngestRequest{})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// recover the store.
+	m.Stores.SetFailure(s2, nil)
+	require.True(t, m.Stores.IsStoreHealthy(s2))
+	support, err := m.Stores.SupportMultiIngest(ctx, []uint64{s1, s2})
+	require.NoError(t, err)
+	require.True(t, support)
+}
@@ -0,0 +1,242 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package mock
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/kvproto/pkg/import_sstpb"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	pd "github.com/tikv/pd/client"
+)
+
+// storeSim is the simulated behavior of a store.
+type storeSim struct {
+	labels     []*metapb.StoreLabel
+	hasLabels  bool
+	state      metapb.StoreState
+	hasState   bool
+	err        error
+	latency    time.Duration
+	speedLimit uint64
+	calls      map[string]int
+}
+
+// Stores simulates the TiKV stores of the mock cluster for BR.
+// It overrides the labels and states of the stores reported by the PD client of
+// the mock cluster, and serves the import RPCs sent to the stores with injectable
+// failures and latency, so it can be used as the `restore.ImporterClient`.
+type Stores struct {
+	mu     sync.Mutex
+	stores map[uint64]*storeSim
+}
+
+// NewStores creates a Stores.
+func NewStores() *Stores {
+	return &Stores{stores: make(map[uint64]*storeSim)}
+}
+
+func (s *Stores) getLocked(storeID uint64) *storeSim {
+	store, ok := s.stores[storeID]
+	if !ok {
+		store = &storeSim{calls: make(map[string]int)}
+		s.stores[storeID] = store
+	}
+	return store
+}
+
+// SetLabels overrides the labels of the store reported by PD.
+func (s *Stores) SetLabels(storeID uint64, labels map[string]string) {
+	storeLabels := make([]*metapb.StoreLabel, 0, len(labels))
+	for k, v := range labels {
+		storeLabels = append(storeLabels, &metapb.StoreLabel{Key: k, Value: v})
+	}
+	sort.Slice(storeLabels, func(i, j int) bool {
+		return storeLabels[i].Key < storeLabels[j].Key
+	})
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	store := s.getLocked(storeID)
+	store.labels, store.hasLabels = storeLabels, true
+}
+
+// SetState overrides the state of the store reported by PD.
+func (s *Stores) SetState(storeID uint64, state metapb.StoreState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	store := s.getLocked(storeID)
+	store.state, store.hasState = state, true
+}
+
+// SetFailure makes all the RPCs sent to the store fail with err, nil recovers the store.
+func (s *Stores) SetFailure(storeID uint64, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.getLocked(storeID).err = err
+}
+
+// SetLatency delays all the RPCs sent to the store.
+func (s *Stores) SetLatency(storeID uint64, latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.getLocked(storeID).latency = latency
+}
+
+// SpeedLimit returns the download speed limit set to the store.
+func (s *Stores) SpeedLimit(storeID uint64) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.getLocked(storeID).speedLimit
+}
+
+// CallCount returns how many times the RPC is sent to the store, including the failed ones.
+func (s *Stores) CallCount(storeID uint64, method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.getLocked(storeID).calls[method]
+}
+
+func (s *Stores) call(ctx context.Context, storeID uint64, method string) error {
+	s.mu.Lock()
+	store := s.getLocked(storeID)
+	store.calls[method]++
+	err, latency := store.err, store.latency
+	s.mu.Unlock()
+
+	if latency > 0 {
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-time.After(latency):
+		}
+	}
+	return err
+}
+
+func (s *Stores) overrideStore(store *metapb.Store) *metapb.Store {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sim, ok := s.stores[store.GetId()]
+	if !ok || !(sim.hasLabels || sim.hasState) {
+		return store
+	}
+	newStore := *store
+	if sim.hasLabels {
+		newStore.Labels = sim.labels
+	}
+	if sim.hasState {
+		newStore.State = sim.state
+	}
+	return &newStore
+}
+
+// ClearFiles implements restore.ImporterClient.
+func (s *Stores) ClearFiles(ctx context.Context, storeID uint64, _ *import_sstpb.ClearRequest) (*import_sstpb.ClearResponse, error) {
+	if err := s.call(ctx, storeID, "ClearFiles"); err != nil {
+		return nil, err
+	}
+	return &import_sstpb.ClearResponse{}, nil
+}
+
+// ApplyKVFile implements restore.ImporterClient.
+func (s *Stores) ApplyKVFile(ctx context.Context, storeID uint64, _ *import_sstpb.ApplyRequest) (*import_sstpb.ApplyResponse, error) {
+	if err := s.call(ctx, storeID, "ApplyKVFile"); err != nil {
+		return nil, err
+	}
+	return &import_sstpb.ApplyResponse{}, nil
+}
+
+// DownloadSST implements restore.ImporterClient.
+func (s *Stores) DownloadSST(ctx context.Context, storeID uint64, req *import_sstpb.DownloadRequest) (*import_sstpb.DownloadResponse, error) {
+	if err := s.call(ctx, storeID, "DownloadSST"); err != nil {
+		return nil, err
+	}
+	resp := &import_sstpb.DownloadResponse{}
+	if req.Sst.Range != nil {
+		resp.Range = *req.Sst.Range
+	}
+	return resp, nil
+}
+
+// IngestSST implements restore.ImporterClient.
+func (s *Stores) IngestSST(ctx context.Context, storeID uint64, _ *import_sstpb.IngestRequest) (*import_sstpb.IngestResponse, error) {
+	if err := s.call(ctx, storeID, "IngestSST"); err != nil {
+		return nil, err
+	}
+	return &import_sstpb.IngestResponse{}, nil
+}
+
+// MultiIngest implements restore.ImporterClient.
+func (s *Stores) MultiIngest(ctx context.Context, storeID uint64, _ *import_sstpb.MultiIngestRequest) (*import_sstpb.IngestResponse, error) {
+	if err := s.call(ctx, storeID, "MultiIngest"); err != nil {
+		return nil, err
+	}
+	return &import_sstpb.IngestResponse{}, nil
+}
+
+// SetDownloadSpeedLimit implements restore.ImporterClient.
+func (s *Stores) SetDownloadSpeedLimit(ctx context.Context, storeID uint64, req *import_sstpb.SetDownloadSpeedLimitRequest) (*import_sstpb.SetDownloadSpeedLimitResponse, error) {
+	if err := s.call(ctx, storeID, "SetDownloadSpeedLimit"); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.getLocked(storeID).speedLimit = req.SpeedLimit
+	s.mu.Unlock()
+	return &import_sstpb.SetDownloadSpeedLimitResponse{}, nil
+}
+
+// GetImportClient implements restore.ImporterClient.
+func (s *Stores) GetImportClient(ctx context.Context, storeID uint64) (import_sstpb.ImportSSTClient, error) {
+	if err := s.call(ctx, storeID, "GetImportClient"); err != nil {
+		return nil, err
+	}
+	return nil, errors.Errorf("the import client of mock store %d is not supported", storeID)
+}
+
+// SupportMultiIngest implements restore.ImporterClient.
+func (s *Stores) SupportMultiIngest(ctx context.Context, stores []uint64) (bool, error) {
+	for _, storeID := range stores {
+		if err := s.call(ctx, storeID, "SupportMultiIngest"); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// IsStoreHealthy implements restore.ImporterClient, the store is healthy unless a failure is set.
+func (s *Stores) IsStoreHealthy(storeID uint64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.getLocked(storeID).err == nil
+}
+
+// storesPDClient is a PD client which reports the stores overridden by Stores.
+type storesPDClient struct {
+	pd.Client
+	stores *Stores
+}
+
+// GetStore implements pd.Client.
+func (c storesPDClient) GetStore(ctx context.Context, storeID uint64) (*metapb.Store, error) {
+	store, err := c.Client.GetStore(ctx, storeID)
+	if err != nil || store == nil {
+		return store, err
+	}
+	return c.stores.overrideStore(store), nil
+}
+
+// GetAllStores implements pd.Client.
+func (c storesPDClient) GetAllStores(ctx context.Context, opts ...pd.GetStoreOption) ([]*metapb.Store, error) {
+	stores, err := c.Client.GetAllStores(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for i, store := range stores {
+		stores[i] = c.stores.overrideStore(store)
+	}
+	return stores, nil
+}
@@ -0,0 +1,246 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package pdutil
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultPauseLease is the default lease of the paused schedulers and configs.
+	DefaultPauseLease = pauseTimeout
+	// defaultLeaderCheckInterval is the interval to check whether the PD leader changed.
+	defaultLeaderCheckInterval = 5 * time.Second
+)
+
+// PauseState is the persisted state of the paused schedulers and configs. It's
+// saved before pausing so the cluster can be restored if the process crashed.
+type PauseState struct {
+	// Origin is the config to restore.
+	Origin ClusterConfig `json:"origin"`
+	// Modified is the config applied during pausing.
+	Modified ClusterConfig `json:"modified"`
+}
+
+// PauseStateStore persists the PauseState.
+type PauseStateStore interface {
+	// Load returns the saved state, or nil if there is no saved state.
+	Load(ctx context.Context) (*PauseState, error)
+	// Save saves the state.
+	Save(ctx context.Context, state *PauseState) error
+	// Clear removes the saved state.
+	Clear(ctx context.Context) error
+}
+
+// FilePauseStateStore is a PauseStateStore keeping the state in a local file.
+type FilePauseStateStore struct {
+	Path string
+}
+
+// Load implements PauseStateStore.
+func (s FilePauseStateStore) Load(context.Context) (*PauseState, error) {
+	data, err := os.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	state := &PauseState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, errors.Annotatef(err, "failed to parse pause state file %s", s.Path)
+	}
+	return state, nil
+}
+
+// Save implements PauseStateStore.
+func (s FilePauseStateStore) Save(_ context.Context, state *PauseState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.Path), 0o700); err != nil {
+		return errors.Trace(err)
+	}
+	// Write to a temporary file and rename it, so a crash won't leave a broken state.
+	tmp := s.Path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(os.Rename(tmp, s.Path))
+}
+
+// Clear implements PauseStateStore.
+func (s FilePauseStateStore) Clear(context.Context) error {
+	if err := os.Remove(s.Path); err != nil && !os.IsNotExist(err) {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// SchedulerPauser pauses the schedulers and schedule configs that may slow down
+// BR with a lease, and keeps renewing the lease until it's resumed. So PD restores
+// them automatically once the lease expired if the pauser exited unexpectedly.
+//
+// Because PD keeps the paused schedulers in memory, the pauser pauses them again
+// as soon as the PD leader changed. The origin configs are persisted by the
+// PauseStateStore before pausing, and restored by Recover in the next run.
+type SchedulerPauser struct {
+	pd    *PdController
+	lease time.Duration
+	store PauseStateStore
+
+	post                pdHTTPRequest
+	leaderAddr          func() string
+	leaderCheckInterval time.Duration
+
+	mu     sync.Mutex
+	state  *PauseState
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewSchedulerPauser creates a SchedulerPauser.
+func (p *PdController) NewSchedulerPauser(lease time.Duration, store PauseStateStore) *SchedulerPauser {
+	s := &SchedulerPauser{
+		pd:                  p,
+		lease:               lease,
+		store:               store,
+		post:                pdRequest,
+		leaderAddr:          func() string { return "" },
+		leaderCheckInterval: defaultLeaderCheckInterval,
+	}
+	if p.pdClient != nil {
+		s.leaderAddr = p.pdClient.GetLeaderAddr
+	}
+	return s
+}
+
+// Recover restores the cluster with the state left by a crashed pauser. It
+// returns whether there was such a state.
+func (s *SchedulerPauser) Recover(ctx context.Context) (bool, error) {
+	state, err := s.store.Load(ctx)
+	if err != nil || state == nil {
+		return false, errors.Trace(err)
+	}
+	log.Info("restoring schedulers and configs paused by previous run", zap.Any("origin", state.Origin))
+	if err := s.restore(ctx, state); err != nil {
+		return true, errors.Trace(err)
+	}
+	return true, errors.Trace(s.store.Clear(ctx))
+}
+
+// Pause pauses the schedulers and configs until Resume is called or ctx is done.
+func (s *SchedulerPauser) Pause(ctx context.Context) (*PauseState, error) {
+	if !s.pd.isPauseConfigEnabled() {
+		return nil, errors.Annotatef(berrors.ErrUnsupportedOperation,
+			"pausing schedulers with lease requires PD %s or later", pauseConfigVersion)
+	}
+	if _, err := s.Recover(ctx); err != nil {
+		return nil, errors.Annotate(err, "failed to restore the state of previous run")
+	}
+	origin, modified, err := s.pd.pauseTargets(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	state := &PauseState{Origin: origin, Modified: modified}
+	if err := s.start(ctx, state); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return state, nil
+}
+
+func (s *SchedulerPauser) start(ctx context.Context, state *PauseState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.state != nil {
+		return errors.Annotate(berrors.ErrUnsupportedOperation, "the schedulers are already paused")
+	}
+	if err := s.store.Save(ctx, state); err != nil {
+		return errors.Annotate(err, "failed to persist the origin configs")
+	}
+	if err := s.pause(ctx, state); err != nil {
+		if err1 := s.restore(ctx, state); err1 != nil {
+			log.Warn("failed to restore schedulers and configs, they will be restored once the lease expired",
+				zap.Error(err1))
+		} else if err1 := s.store.Clear(ctx); err1 != nil {
+			log.Warn("failed to clear the pause state", zap.Error(err1))
+		}
+		return errors.Trace(err)
+	}
+	log.Info("schedulers and configs paused", zap.Strings("schedulers", state.Modified.Schedulers),
+		zap.Any("cfg", state.Modified.ScheduleCfg), zap.Duration("lease", s.lease))
+
+	keepCtx, cancel := context.WithCancel(ctx)
+	s.state, s.cancel, s.done = state, cancel, make(chan struct{})
+	go s.keepPaused(keepCtx, state, s.done)
+	return nil
+}
+
+func (s *SchedulerPauser) pause(ctx context.Context, state *PauseState) error {
+	if _, err := s.pd.doPauseSchedulersWithTTL(ctx, state.Modified.Schedulers, s.lease, s.post); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(s.pd.doPauseConfigsWithTTL(ctx, state.Modified.ScheduleCfg, s.lease, s.post))
+}
+
+func (s *SchedulerPauser) restore(ctx context.Context, state *PauseState) error {
+	s.pd.doResumeSchedulers(ctx, state.Origin.Schedulers, s.post)
+	return errors.Trace(s.pd.restoreScheduleConfigWith(ctx, state.Origin.ScheduleCfg, s.post))
+}
+
+func (s *SchedulerPauser) keepPaused(ctx context.Context, state *PauseState, done chan<- struct{}) {
+	defer close(done)
+	renewTick := time.NewTicker(s.lease / 3)
+	defer renewTick.Stop()
+	leaderTick := time.NewTicker(s.leaderCheckInterval)
+	defer leaderTick.Stop()
+
+	leader := s.leaderAddr()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-leaderTick.C:
+			newLeader := s.leaderAddr()
+			if newLeader == leader {
+				continue
+			}
+			log.Info("PD leader changed, pause schedulers and configs again",
+				zap.String("old", leader), zap.String("new", newLeader))
+			leader = newLeader
+		case <-renewTick.C:
+		}
+		if err := s.pause(ctx, state); err != nil {
+			log.Warn("failed to renew the lease of paused schedulers and configs, retry later", zap.Error(err))
+		}
+	}
+}
+
+// Resume stops renewing the lease, restores the origin configs and clears the
+// persisted state.
+func (s *SchedulerPauser) Resume(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.state == nil {
+		return nil
+	}
+	s.cancel()
+	<-s.done
+	if err := s.restore(ctx, s.state); err != nil {
+		return errors.Trace(err)
+	}
+	s.state = nil
+	log.Info("schedulers and configs resumed")
+	return errors.Trace(s.store.Clear(ctx))
+}
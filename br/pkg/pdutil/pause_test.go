@@ -0,0 +1,105 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package pdutil
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coreos/go-semver/semver"
+	"github.com/stretchr/testify/require"
+)
+
+type recordedPDRequests struct {
+	mu   sync.Mutex
+	reqs []string
+}
+
+func (r *recordedPDRequests) post(_ context.Context, _ string, prefix string, _ *http.Client, _ string, body io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reqs = append(r.reqs, prefix+" "+string(data))
+	return nil, nil
+}
+
+func (r *recordedPDRequests) take() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	reqs := r.reqs
+	r.reqs = nil
+	return reqs
+}
+
+func TestSchedulerPauser(t *testing.T) {
+	ctx := context.Background()
+	pdController := &PdController{addrs: []string{""}, version: &semver.Version{Major: 6, Minor: 1}}
+	store := FilePauseStateStore{Path: filepath.Join(t.TempDir(), "state.json")}
+	reqs := &recordedPDRequests{}
+	var leader atomic.Value
+	leader.Store("pd-1")
+
+	pauser := pdController.NewSchedulerPauser(time.Hour, store)
+	pauser.post = reqs.post
+	pauser.leaderAddr = func() string { return leader.Load().(string) }
+	pauser.leaderCheckInterval = 10 * time.Millisecond
+
+	state := &PauseState{
+		Origin: ClusterConfig{
+			Schedulers:  []string{"balance-leader-scheduler"},
+			ScheduleCfg: map[string]interface{}{"max-merge-region-keys": float64(200000)},
+		},
+		Modified: ClusterConfig{
+			Schedulers:  []string{"balance-leader-scheduler"},
+			ScheduleCfg: map[string]interface{}{"max-merge-region-keys": float64(0)},
+		},
+	}
+	require.NoError(t, pauser.start(ctx, state))
+	require.Equal(t, []string{
+		"pd/api/v1/schedulers/balance-leader-scheduler {\"delay\":3600}",
+		"pd/api/v1/config?ttlSecond=3600 {\"schedule.max-merge-region-keys\":0}",
+	}, reqs.take())
+	saved, err := store.Load(ctx)
+	require.NoError(t, err)
+	require.Equal(t, state, saved)
+	require.Error(t, pauser.start(ctx, state))
+
+	// pause again once the leader changed.
+	leader.Store("pd-2")
+	require.Eventually(t, func() bool {
+		return len(reqs.take()) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, pauser.Resume(ctx))
+	resumeReqs := reqs.take()
+	require.Equal(t, []string{
+		"pd/api/v1/schedulers/balance-leader-scheduler {\"delay\":0}",
+		"pd/api/v1/config?ttlSecond=0 {\"schedule.max-merge-region-keys\":200000}",
+	}, resumeReqs)
+	saved, err = store.Load(ctx)
+	require.NoError(t, err)
+	require.Nil(t, saved)
+	require.NoError(t, pauser.Resume(ctx))
+
+	// recover the state left by a crashed pauser.
+	require.NoError(t, store.Save(ctx, state))
+	pauser = pdController.NewSchedulerPauser(time.Hour, store)
+	pauser.post = reqs.post
+	recovered, err := pauser.Recover(ctx)
+	require.NoError(t, err)
+	require.True(t, recovered)
+	require.Equal(t, resumeReqs, reqs.take())
+	recovered, err = pauser.Recover(ctx)
+	require.NoError(t, err)
+	require.False(t, recovered)
+	require.Empty(t, reqs.take())
+}
@@ -356,6 +356,45 @@ func (p *PdController) getRegionCountWith(
 	return 0, errors.Trace(err)
 }
 
+// GetApproximateSize returns the approximate size in bytes of the regions in
+// the specified range, it's counted by MiB.
+func (p *PdController) GetApproximateSize(ctx context.Context, startKey, endKey []byte) (uint64, error) {
+	return p.getApproximateSizeWith(ctx, pdRequest, startKey, endKey)
+}
+
+func (p *PdController) getApproximateSizeWith(
+	ctx context.Context, get pdHTTPRequest, startKey, endKey []byte,
+) (uint64, error) {
+	var start, end string
+	start = url.QueryEscape(string(codec.EncodeBytes(nil, startKey)))
+	if len(endKey) != 0 { // Empty end key means the max.
+		end = url.QueryEscape(string(codec.EncodeBytes(nil, endKey)))
+	}
+	var err error
+	for _, addr := range p.addrs {
+		query := fmt.Sprintf(
+			"%s?start_key=%s&end_key=%s",
+			regionCountPrefix, start, end)
+		v, e := get(ctx, addr, query, p.cli, http.MethodGet, nil)
+		if e != nil {
+			err = e
+			continue
+		}
+		stats := struct {
+			// StorageSize is in MiB.
+			StorageSize int64 `json:"storage_size"`
+		}{}
+		if err = json.Unmarshal(v, &stats); err != nil {
+			return 0, errors.Trace(err)
+		}
+		if stats.StorageSize < 0 {
+			return 0, nil
+		}
+		return uint64(stats.StorageSize) * units.MiB, nil
+	}
+	return 0, errors.Trace(err)
+}
+
 // GetStoreInfo returns the info of store with the specified id.
 func (p *PdController) GetStoreInfo(ctx context.Context, storeID uint64) (*pdtypes.StoreInfo, error) {
 	return p.getStoreInfoWith(ctx, pdRequest, storeID)
@@ -385,7 +424,13 @@ func (p *PdController) getStoreInfoWith(
 
 func (p *PdController) doPauseSchedulers(ctx context.Context, schedulers []string, post pdHTTPRequest) ([]string, error) {
 	// pause this scheduler with 300 seconds
-	body, err := json.Marshal(pauseSchedulerBody{Delay: int64(pauseTimeout.Seconds())})
+	return p.doPauseSchedulersWithTTL(ctx, schedulers, pauseTimeout, post)
+}
+
+func (p *PdController) doPauseSchedulersWithTTL(
+	ctx context.Context, schedulers []string, ttl time.Duration, post pdHTTPRequest,
+) ([]string, error) {
+	body, err := json.Marshal(pauseSchedulerBody{Delay: int64(ttl.Seconds())})
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -468,11 +513,17 @@ func (p *PdController) ResumeSchedulers(ctx context.Context, schedulers []string
 func (p *PdController) resumeSchedulerWith(ctx context.Context, schedulers []string, post pdHTTPRequest) (err error) {
 	log.Info("resume scheduler", zap.Strings("schedulers", schedulers))
 	p.schedulerPauseCh <- struct{}{}
+	p.doResumeSchedulers(ctx, schedulers, post)
+	// no need to return error, because the pause will timeout.
+	return nil
+}
 
+func (p *PdController) doResumeSchedulers(ctx context.Context, schedulers []string, post pdHTTPRequest) {
 	// 0 means stop pause.
 	body, err := json.Marshal(pauseSchedulerBody{Delay: 0})
 	if err != nil {
-		return errors.Trace(err)
+		log.Error("failed to marshal resume scheduler request", zap.Error(err))
+		return
 	}
 	for _, scheduler := range schedulers {
 		prefix := fmt.Sprintf("%s/%s", schedulerPrefix, scheduler)
@@ -489,8 +540,6 @@ func (p *PdController) resumeSchedulerWith(ctx context.Context, schedulers []str
 			log.Info("resume scheduler successful", zap.String("scheduler", scheduler))
 		}
 	}
-	// no need to return error, because the pause will timeout.
-	return nil
 }
 
 // ListSchedulers list all pd scheduler.
@@ -577,7 +626,13 @@ func (p *PdController) doUpdatePDScheduleConfig(
 
 func (p *PdController) doPauseConfigs(ctx context.Context, cfg map[string]interface{}, post pdHTTPRequest) error {
 	// pause this scheduler with 300 seconds
-	prefix := fmt.Sprintf("%s?ttlSecond=%.0f", configPrefix, pauseTimeout.Seconds())
+	return p.doPauseConfigsWithTTL(ctx, cfg, pauseTimeout, post)
+}
+
+func (p *PdController) doPauseConfigsWithTTL(
+	ctx context.Context, cfg map[string]interface{}, ttl time.Duration, post pdHTTPRequest,
+) error {
+	prefix := fmt.Sprintf("%s?ttlSecond=%.0f", configPrefix, ttl.Seconds())
 	return p.doUpdatePDScheduleConfig(ctx, cfg, post, prefix)
 }
 
@@ -585,10 +640,16 @@ func restoreSchedulers(ctx context.Context, pd *PdController, clusterCfg Cluster
 	if err := pd.ResumeSchedulers(ctx, clusterCfg.Schedulers); err != nil {
 		return errors.Annotate(err, "fail to add PD schedulers")
 	}
-	log.Info("restoring config", zap.Any("config", clusterCfg.ScheduleCfg))
+	return pd.restoreScheduleConfigWith(ctx, clusterCfg.ScheduleCfg, pdRequest)
+}
+
+func (p *PdController) restoreScheduleConfigWith(
+	ctx context.Context, scheduleCfg map[string]interface{}, post pdHTTPRequest,
+) error {
+	log.Info("restoring config", zap.Any("config", scheduleCfg))
 	mergeCfg := make(map[string]interface{})
 	for cfgKey := range expectPDCfg {
-		value := clusterCfg.ScheduleCfg[cfgKey]
+		value := scheduleCfg[cfgKey]
 		if value == nil {
 			// Ignore non-exist config.
 			continue
@@ -597,12 +658,12 @@ func restoreSchedulers(ctx context.Context, pd *PdController, clusterCfg Cluster
 	}
 
 	prefix := make([]string, 0, 1)
-	if pd.isPauseConfigEnabled() {
+	if p.isPauseConfigEnabled() {
 		// set config's ttl to zero, make temporary config invalid immediately.
 		prefix = append(prefix, fmt.Sprintf("%s?ttlSecond=%d", configPrefix, 0))
 	}
 	// reset config with previous value.
-	if err := pd.doUpdatePDScheduleConfig(ctx, mergeCfg, pdRequest, prefix...); err != nil {
+	if err := p.doUpdatePDScheduleConfig(ctx, mergeCfg, post, prefix...); err != nil {
 		return errors.Annotate(err, "fail to update PD merge config")
 	}
 	return nil
@@ -638,6 +699,24 @@ func (p *PdController) RemoveSchedulersWithOrigin(ctx context.Context) (origin C
 		ctx = opentracing.ContextWithSpan(ctx, span1)
 	}
 
+	originCfg, removedCfg, err := p.pauseTargets(ctx)
+	if err != nil {
+		return originCfg, removedCfg, err
+	}
+	removedSchedulers, err := p.doRemoveSchedulersWith(ctx, removedCfg.Schedulers, removedCfg.ScheduleCfg)
+	if err != nil {
+		return originCfg, removedCfg, err
+	}
+
+	originCfg.Schedulers = removedSchedulers
+	removedCfg.Schedulers = removedSchedulers
+
+	return originCfg, removedCfg, nil
+}
+
+// pauseTargets returns the origin configs of the schedulers and schedule configs
+// that may slow down BR speed, along with the configs to pause them.
+func (p *PdController) pauseTargets(ctx context.Context) (origin ClusterConfig, modified ClusterConfig, err error) {
 	originCfg := ClusterConfig{}
 	removedCfg := ClusterConfig{}
 	stores, err := p.pdClient.GetAllStores(ctx)
@@ -676,13 +755,8 @@ func (p *PdController) RemoveSchedulersWithOrigin(ctx context.Context) (origin C
 		}
 	}
 
-	removedSchedulers, err := p.doRemoveSchedulersWith(ctx, needRemoveSchedulers, disablePDCfg)
-	if err != nil {
-		return originCfg, removedCfg, err
-	}
-
-	originCfg.Schedulers = removedSchedulers
-	removedCfg.Schedulers = removedSchedulers
+	originCfg.Schedulers = needRemoveSchedulers
+	removedCfg.Schedulers = needRemoveSchedulers
 
 	return originCfg, removedCfg, nil
 }
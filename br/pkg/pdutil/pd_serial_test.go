@@ -159,6 +159,29 @@ func TestRegionCount(t *testing.T) {
 	require.Equal(t, 2, resp)
 }
 
+func TestApproximateSize(t *testing.T) {
+	mock := func(
+		_ context.Context, addr string, prefix string, _ *http.Client, _ string, _ io.Reader,
+	) ([]byte, error) {
+		u, e := url.Parse(fmt.Sprintf("%s/%s", addr, prefix))
+		require.NoError(t, e)
+		if u.Query().Get("end_key") == "" {
+			return []byte(`{"count": 3, "storage_size": 96}`), nil
+		}
+		return []byte(`{"count": 0, "storage_size": 0}`), nil
+	}
+
+	pdController := &PdController{addrs: []string{"http://mock"}}
+	ctx := context.Background()
+	size, err := pdController.getApproximateSizeWith(ctx, mock, []byte{1}, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(96*1024*1024), size)
+
+	size, err = pdController.getApproximateSizeWith(ctx, mock, []byte{1}, []byte{2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), size)
+}
+
 func TestPDVersion(t *testing.T) {
 	v := []byte("\"v4.1.0-alpha1\"\n")
 	r := parseVersion(v)
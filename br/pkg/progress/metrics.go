@@ -0,0 +1,68 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package progress
+
+import (
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// collector exports the progress of the tasks in a registry as Prometheus gauges.
+type collector struct {
+	registry *Registry
+	maxDepth int
+	current  *prometheus.Desc
+	total    *prometheus.Desc
+}
+
+// NewCollector creates a Prometheus collector exporting the finished and total
+// units of work of the tasks in the registry, labeled by the task path such as
+// "import/`db`.`t`". Only the tasks no deeper than maxDepth are exported to
+// bound the cardinality, where the root tasks are at depth 1.
+func NewCollector(namespace string, registry *Registry, maxDepth int) prometheus.Collector {
+	return &collector{
+		registry: registry,
+		maxDepth: maxDepth,
+		current: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "progress", "current"),
+			"The finished units of work of the task.",
+			[]string{"task"}, nil),
+		total: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "progress", "total"),
+			"The total units of work of the task.",
+			[]string{"task"}, nil),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.current
+	ch <- c.total
+}
+
+// Collect implements prometheus.Collector.
+func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	snapshots := c.registry.Snapshots()
+	// the tasks with the same path are exported once, the latest registered one wins.
+	seen := make(map[string]struct{})
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		c.collect(ch, &snapshots[i], nil, seen)
+	}
+}
+
+func (c *collector) collect(ch chan<- prometheus.Metric, s *Snapshot, path []string, seen map[string]struct{}) {
+	path = append(path, s.Name)
+	if len(path) > c.maxDepth {
+		return
+	}
+	task := strings.Join(path, "/")
+	if _, ok := seen[task]; !ok {
+		seen[task] = struct{}{}
+		ch <- prometheus.MustNewConstMetric(c.current, prometheus.GaugeValue, float64(s.Current), task)
+		ch <- prometheus.MustNewConstMetric(c.total, prometheus.GaugeValue, float64(s.Total), task)
+	}
+	for i := range s.Children {
+		c.collect(ch, &s.Children[i], path, seen)
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+// Package progress tracks the progress of hierarchical tasks shared by BR and
+// Lightning, e.g. task → table → engine/file, and reports it to the console,
+// Prometheus and HTTP clients.
+package progress
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Status is the status of a task.
+type Status string
+
+// The statuses of a task.
+const (
+	StatusRunning  Status = "running"
+	StatusFinished Status = "finished"
+	StatusFailed   Status = "failed"
+)
+
+// Task is a node of the progress tree. All the methods are goroutine-safe.
+type Task struct {
+	name      string
+	startTime time.Time
+	current   atomic.Int64
+	total     atomic.Int64
+
+	mu         sync.Mutex
+	status     Status
+	message    string
+	finishTime time.Time
+	children   []*Task
+}
+
+// NewTask creates a running task with the total units of work.
+func NewTask(name string, total int64) *Task {
+	t := &Task{
+		name:      name,
+		startTime: time.Now(),
+		status:    StatusRunning,
+	}
+	t.total.Store(total)
+	return t
+}
+
+// SubTask returns the sub task with the name, it's created with the total units
+// of work if it doesn't exist.
+func (t *Task) SubTask(name string, total int64) *Task {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, child := range t.children {
+		if child.name == name {
+			return child
+		}
+	}
+	child := NewTask(name, total)
+	t.children = append(t.children, child)
+	return child
+}
+
+// Name returns the name of the task.
+func (t *Task) Name() string {
+	return t.name
+}
+
+// Inc increases the finished units of work by one.
+func (t *Task) Inc() {
+	t.current.Add(1)
+}
+
+// Add increases the finished units of work by n.
+func (t *Task) Add(n int64) {
+	t.current.Add(n)
+}
+
+// SetCurrent sets the finished units of work.
+func (t *Task) SetCurrent(n int64) {
+	t.current.Store(n)
+}
+
+// Current returns the finished units of work.
+func (t *Task) Current() int64 {
+	return t.current.Load()
+}
+
+// SetTotal sets the total units of work.
+func (t *Task) SetTotal(n int64) {
+	t.total.Store(n)
+}
+
+// Total returns the total units of work.
+func (t *Task) Total() int64 {
+	return t.total.Load()
+}
+
+// Finish marks the task as finished, or failed if err is not nil. A finished
+// task is pushed to 100%.
+func (t *Task) Finish(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.status != StatusRunning {
+		return
+	}
+	t.finishTime = time.Now()
+	if err != nil {
+		t.status = StatusFailed
+		t.message = err.Error()
+		return
+	}
+	t.status = StatusFinished
+	if total := t.total.Load(); t.current.Load() < total {
+		t.current.Store(total)
+	}
+}
+
+// Snapshot returns the current progress of the task and its sub tasks.
+func (t *Task) Snapshot() Snapshot {
+	t.mu.Lock()
+	s := Snapshot{
+		Name:    t.name,
+		Status:  t.status,
+		Message: t.message,
+	}
+	end := t.finishTime
+	children := append([]*Task(nil), t.children...)
+	t.mu.Unlock()
+
+	s.Current = t.current.Load()
+	s.Total = t.total.Load()
+	if end.IsZero() {
+		end = time.Now()
+	}
+	s.Elapsed = end.Sub(t.startTime).Seconds()
+	for _, child := range children {
+		s.Children = append(s.Children, child.Snapshot())
+	}
+	return s
+}
+
+// Snapshot is the progress of a task at some point.
+type Snapshot struct {
+	Name    string `json:"name"`
+	Current int64  `json:"current"`
+	Total   int64  `json:"total"`
+	Status  Status `json:"status"`
+	Message string `json:"message,omitempty"`
+	// Elapsed is the seconds elapsed since the task started.
+	Elapsed  float64    `json:"elapsed"`
+	Children []Snapshot `json:"children,omitempty"`
+}
+
+// Percent returns the percentage of completeness of the task.
+func (s *Snapshot) Percent() float64 {
+	if s.Total <= 0 {
+		return 0
+	}
+	if s.Current >= s.Total {
+		return 100
+	}
+	return 100.0 * float64(s.Current) / float64(s.Total)
+}
+
+// Registry holds the root tasks to report.
+type Registry struct {
+	mu    sync.Mutex
+	tasks []*Task
+}
+
+// NewRegistry creates a Registry.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// DefaultRegistry is the registry of the tasks of the current process.
+var DefaultRegistry = NewRegistry()
+
+// Register adds the root task to the registry.
+func (r *Registry) Register(t *Task) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tasks = append(r.tasks, t)
+}
+
+// Unregister removes the root task from the registry.
+func (r *Registry) Unregister(t *Task) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, task := range r.tasks {
+		if task == t {
+			r.tasks = append(r.tasks[:i], r.tasks[i+1:]...)
+			return
+		}
+	}
+}
+
+// Snapshots returns the progress of the root tasks in the registered order.
+func (r *Registry) Snapshots() []Snapshot {
+	r.mu.Lock()
+	tasks := append([]*Task(nil), r.tasks...)
+	r.mu.Unlock()
+	snapshots := make([]Snapshot, 0, len(tasks))
+	for _, t := range tasks {
+		snapshots = append(snapshots, t.Snapshot())
+	}
+	return snapshots
+}
+
+// ServeHTTP implements http.Handler, it responds the progress of the tasks in JSON.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	data, err := json.Marshal(r.Snapshots())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
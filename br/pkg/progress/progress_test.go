@@ -0,0 +1,97 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package progress
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pingcap/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTask(t *testing.T) {
+	task := NewTask("import", 30)
+	t1 := task.SubTask("`db`.`t1`", 10)
+	t2 := task.SubTask("`db`.`t2`", 20)
+	require.Same(t, t1, task.SubTask("`db`.`t1`", 100))
+	e1 := t1.SubTask("engine 1", 10)
+
+	e1.Add(5)
+	t1.Inc()
+	t2.SetCurrent(15)
+	t2.SetTotal(30)
+	task.Add(10)
+
+	s := task.Snapshot()
+	require.Equal(t, "import", s.Name)
+	require.Equal(t, StatusRunning, s.Status)
+	require.Equal(t, int64(10), s.Current)
+	require.InDelta(t, 33.33, s.Percent(), 0.01)
+	require.Len(t, s.Children, 2)
+	require.Equal(t, int64(1), s.Children[0].Current)
+	require.Equal(t, int64(5), s.Children[0].Children[0].Current)
+	require.Equal(t, int64(30), s.Children[1].Total)
+	require.InDelta(t, 50, s.Children[1].Percent(), 0.01)
+
+	t1.Finish(nil)
+	t2.Finish(errors.New("mock error"))
+	t2.Finish(nil)
+	s = task.Snapshot()
+	require.Equal(t, StatusFinished, s.Children[0].Status)
+	require.Equal(t, int64(10), s.Children[0].Current)
+	require.Equal(t, StatusFailed, s.Children[1].Status)
+	require.Equal(t, "mock error", s.Children[1].Message)
+	require.Equal(t, int64(15), s.Children[1].Current)
+
+	empty := NewTask("empty", 0).Snapshot()
+	require.Equal(t, float64(0), empty.Percent())
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	backup := NewTask("Full Backup", 10)
+	checksum := NewTask("Checksum", 4)
+	r.Register(backup)
+	r.Register(checksum)
+	backup.SubTask("`db`.`t`", 10).Add(5)
+	backup.Add(5)
+	checksum.Finish(nil)
+
+	snapshots := r.Snapshots()
+	require.Len(t, snapshots, 2)
+	require.Equal(t, "Full Backup", snapshots[0].Name)
+	require.Equal(t, "Checksum", snapshots[1].Name)
+
+	var buf bytes.Buffer
+	require.NoError(t, r.Render(&buf))
+	require.Equal(t, "Full Backup [==========>.........]  50.00% (5/10) running\n"+
+		"  `db`.`t` [==========>.........]  50.00% (5/10) running\n"+
+		"Checksum [====================] 100.00% (4/4) finished\n", buf.String())
+
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, httptest.NewRequest("GET", "/progress", nil))
+	require.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+	var decoded []Snapshot
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &decoded))
+	require.Len(t, decoded, 2)
+	require.Equal(t, int64(5), decoded[0].Children[0].Current)
+
+	r.Unregister(checksum)
+	require.Len(t, r.Snapshots(), 1)
+}
+
+func TestCollector(t *testing.T) {
+	r := NewRegistry()
+	task := NewTask("import", 10)
+	r.Register(task)
+	r.Register(NewTask("import", 10))
+	task.SubTask("`db`.`t`", 10).SubTask("engine 1", 10)
+
+	require.Equal(t, 2, testutil.CollectAndCount(NewCollector("lightning", r, 1)))
+	require.Equal(t, 4, testutil.CollectAndCount(NewCollector("lightning", r, 2)))
+	require.Equal(t, 6, testutil.CollectAndCount(NewCollector("lightning", r, 3)))
+}
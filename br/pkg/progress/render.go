@@ -0,0 +1,53 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package progress
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+const barWidth = 20
+
+// Render writes the progress trees in a human-readable form, one task per line
+// and the sub tasks are indented, e.g.
+//
+//	Full Restore [==========>.........]  50.00% (5/10) running
+//	  `db`.`t1`  [====================] 100.00% (5/5) finished
+func Render(w io.Writer, snapshots []Snapshot) error {
+	for i := range snapshots {
+		if err := render(w, &snapshots[i], 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func render(w io.Writer, s *Snapshot, depth int) error {
+	percent := s.Percent()
+	filled := int(percent / 100 * barWidth)
+	bar := strings.Repeat("=", filled)
+	if filled < barWidth {
+		bar += ">" + strings.Repeat(".", barWidth-filled-1)
+	}
+	line := fmt.Sprintf("%s%s [%s] %6.2f%% (%d/%d) %s",
+		strings.Repeat("  ", depth), s.Name, bar, percent, s.Current, s.Total, s.Status)
+	if s.Message != "" {
+		line += ": " + s.Message
+	}
+	if _, err := fmt.Fprintln(w, line); err != nil {
+		return err
+	}
+	for i := range s.Children {
+		if err := render(w, &s.Children[i], depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Render writes the progress trees of the tasks in the registry, see Render.
+func (r *Registry) Render(w io.Writer) error {
+	return Render(w, r.Snapshots())
+}
@@ -0,0 +1,208 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/meta/autoid"
+	"github.com/pingcap/tidb/parser/model"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const defaultAutoIDCheckConcurrency = 16
+
+// AutoIDKind is the kind of the ID allocator of a table.
+type AutoIDKind string
+
+const (
+	// AutoIncrementID is the allocator of the auto_increment column or the row ID.
+	AutoIncrementID AutoIDKind = "auto_increment"
+	// AutoRandomID is the allocator of the auto_random column.
+	AutoRandomID AutoIDKind = "auto_random"
+	// SequenceValue is the allocator of the sequence.
+	SequenceValue AutoIDKind = "sequence"
+)
+
+// AutoIDPosition is the position of an ID allocator of a restored table.
+type AutoIDPosition struct {
+	DB    string
+	Table string
+	Kind  AutoIDKind
+	// Backup is the next global ID of the allocator captured by the backup, all
+	// the IDs of the backed up data are less than it.
+	Backup int64
+	// Current is the next global ID of the allocator of the restored table.
+	Current int64
+}
+
+// Behind returns whether the allocator may allocate the IDs of the restored data.
+func (p AutoIDPosition) Behind() bool {
+	return p.Current < p.Backup
+}
+
+// rebaseSQL returns the SQL moving the allocator to the position of the backup.
+func (p AutoIDPosition) rebaseSQL() string {
+	name := utils.EncloseDBAndTable(p.DB, p.Table)
+	switch p.Kind {
+	case SequenceValue:
+		return fmt.Sprintf("do setval(%s, %d);", name, p.Backup)
+	case AutoRandomID:
+		return fmt.Sprintf("alter table %s auto_random_base = %d;", name, p.Backup)
+	default:
+		return fmt.Sprintf("alter table %s auto_increment = %d;", name, p.Backup)
+	}
+}
+
+func (p AutoIDPosition) String() string {
+	return fmt.Sprintf("%s of %s: next id %d, backed up %d",
+		p.Kind, utils.EncloseDBAndTable(p.DB, p.Table), p.Current, p.Backup)
+}
+
+// VerifyAutoIDs verifies the ID allocators of the restored tables won't
+// allocate the IDs of the restored data, the allocators behind the positions
+// captured by the backup are rebased to them. It returns the number of the
+// allocators rebased, and an error if any of them is still behind.
+func (rc *Client) VerifyAutoIDs(ctx context.Context, tables []*metautil.Table) (int, error) {
+	if rc.dom == nil {
+		return 0, nil
+	}
+	behind, err := rc.checkAutoIDs(ctx, tables)
+	if err != nil || len(behind) == 0 {
+		return 0, errors.Trace(err)
+	}
+	for _, p := range behind {
+		log.Warn("the id allocator of the restored table is behind the backup, rebase it",
+			zap.Stringer("allocator", p))
+		if err := rc.db.se.Execute(ctx, p.rebaseSQL()); err != nil {
+			return 0, errors.Trace(err)
+		}
+	}
+
+	rebased := len(behind)
+	behind, err = rc.checkAutoIDs(ctx, tables)
+	if err != nil {
+		return rebased, errors.Trace(err)
+	}
+	if len(behind) > 0 {
+		problems := make([]string, 0, len(behind))
+		for _, p := range behind {
+			problems = append(problems, p.String())
+		}
+		return rebased, errors.Annotate(berrors.ErrRestoreAutoIDCollision, strings.Join(problems, "; "))
+	}
+	return rebased, nil
+}
+
+// checkAutoIDs returns the positions of the allocators behind the backup.
+func (rc *Client) checkAutoIDs(ctx context.Context, tables []*metautil.Table) ([]AutoIDPosition, error) {
+	var (
+		mu     sync.Mutex
+		behind []AutoIDPosition
+	)
+	infoSchema := rc.dom.InfoSchema()
+	store := rc.dom.Store()
+	eg, ectx := errgroup.WithContext(ctx)
+	workers := utils.NewWorkerPool(defaultAutoIDCheckConcurrency, "Check Auto IDs")
+	for _, t := range tables {
+		table := t
+		// the system tables are restored by the temporary database and renamed later.
+		if table.Info == nil || table.Info.IsView() || utils.IsSysDB(table.DB.Name.L) {
+			continue
+		}
+		dbInfo, ok := infoSchema.SchemaByName(table.DB.Name)
+		if !ok {
+			continue
+		}
+		newTable, err := infoSchema.TableByName(dbInfo.Name, table.Info.Name)
+		if err != nil {
+			log.Debug("the restored table isn't found, skip checking its auto ids",
+				zap.Stringer("db", table.DB.Name), zap.Stringer("table", table.Info.Name))
+			continue
+		}
+		newInfo := newTable.Meta()
+		workers.ApplyOnErrorGroup(eg, func() error {
+			if err := ectx.Err(); err != nil {
+				return errors.Trace(err)
+			}
+			positions, err := readAutoIDPositions(store, dbInfo.ID, table, newInfo)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			for _, p := range positions {
+				if p.Behind() {
+					mu.Lock()
+					behind = append(behind, p)
+					mu.Unlock()
+				}
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return behind, nil
+}
+
+// readAutoIDPositions reads the next global IDs of the allocators of the
+// restored table, as the backup captures them.
+func readAutoIDPositions(
+	store kv.Storage,
+	dbID int64,
+	table *metautil.Table,
+	newInfo *model.TableInfo,
+) ([]AutoIDPosition, error) {
+	tblVer := autoid.AllocOptionTableInfoVersion(newInfo.Version)
+	read := func(kind AutoIDKind, tp autoid.AllocatorType, backup int64) (AutoIDPosition, error) {
+		current, err := autoid.NewAllocator(store, dbID, newInfo.ID, false, tp, tblVer).NextGlobalAutoID()
+		return AutoIDPosition{
+			DB:      table.DB.Name.O,
+			Table:   table.Info.Name.O,
+			Kind:    kind,
+			Backup:  backup,
+			Current: current,
+		}, errors.Trace(err)
+	}
+
+	info := table.Info
+	positions := make([]AutoIDPosition, 0, 2)
+	switch {
+	case info.IsSequence():
+		// the position of the cycle or descending sequence is restored by the
+		// rounds, which can't be compared by the next global ID.
+		if info.Sequence.Cycle || info.Sequence.Increment < 0 || info.AutoIncID == 0 {
+			return positions, nil
+		}
+		p, err := read(SequenceValue, autoid.SequenceType, info.AutoIncID)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		positions = append(positions, p)
+		return positions, nil
+	case utils.NeedAutoID(info) && info.AutoIncID > 0:
+		p, err := read(AutoIncrementID, autoid.RowIDAllocType, info.AutoIncID)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		positions = append(positions, p)
+	}
+	if info.PKIsHandle && info.ContainsAutoRandomBits() && info.AutoRandID > 0 {
+		p, err := read(AutoRandomID, autoid.AutoRandomType, info.AutoRandID)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		positions = append(positions, p)
+	}
+	return positions, nil
+}
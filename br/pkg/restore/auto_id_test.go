@@ -0,0 +1,23 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoIDPosition(t *testing.T) {
+	p := AutoIDPosition{DB: "test", Table: "t", Kind: AutoIncrementID, Backup: 100, Current: 100}
+	require.False(t, p.Behind())
+	p.Current = 99
+	require.True(t, p.Behind())
+	require.Equal(t, "alter table `test`.`t` auto_increment = 100;", p.rebaseSQL())
+	require.Equal(t, "auto_increment of `test`.`t`: next id 99, backed up 100", p.String())
+
+	p.Kind = AutoRandomID
+	require.Equal(t, "alter table `test`.`t` auto_random_base = 100;", p.rebaseSQL())
+	p.Kind = SequenceValue
+	require.Equal(t, "do setval(`test`.`t`, 100);", p.rebaseSQL())
+}
@@ -0,0 +1,303 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/logutil"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/parser/model"
+	"go.uber.org/zap"
+)
+
+// CheckpointPrefix is the prefix of the restore checkpoint files in the backup storage.
+const CheckpointPrefix = "restore.checkpoint"
+
+// checkpointFilePrefix returns the prefix of the checkpoint files of restoring
+// to the cluster, so the backup can be restored to several clusters at the same
+// time. The files are placed in the root of the storage like the backupmeta
+// files, since the local storage doesn't create the parent directories.
+func checkpointFilePrefix(clusterID uint64) string {
+	return fmt.Sprintf("%s.%d.", CheckpointPrefix, clusterID)
+}
+
+// checkpointMeta identifies the restore which the checkpoint belongs to.
+type checkpointMeta struct {
+	ClusterID uint64 `json:"cluster-id"`
+	BackupTS  uint64 `json:"backup-ts"`
+}
+
+// checkpointData is the progress recorded in a checkpoint data file.
+type checkpointData struct {
+	Files   []string          `json:"files,omitempty"`
+	Tables  []UniqueTableName `json:"tables,omitempty"`
+	DDLJobs []int64           `json:"ddl-jobs,omitempty"`
+}
+
+// Checkpoint records the progress of a restore in the backup storage, i.e. the
+// created tables and the imported data files, so a failed restore can be resumed
+// by skipping the finished work. The progress is appended to the storage as
+// small data files, since rewriting a file is expensive in the cloud storages.
+//
+// The methods of a nil Checkpoint do nothing. Failing to write the storage,
+// e.g. it's read-only, disables the checkpoint rather than failing the restore.
+type Checkpoint struct {
+	storage    storage.ExternalStorage
+	prefix     string
+	metaFile   string
+	dataPrefix string
+	resumed    bool
+
+	mu       sync.Mutex
+	meta     checkpointMeta
+	disabled bool
+	files    map[string]struct{}
+	tables   map[UniqueTableName]struct{}
+	ddlJobs  map[int64]struct{}
+	pending  checkpointData
+	seq      int
+}
+
+// NewCheckpoint creates the checkpoint of restoring the backup to the cluster.
+// If resume is true, the progress recorded by the previous restore to the
+// cluster is loaded, otherwise its stale checkpoint is removed. The checkpoints
+// of restoring to the other clusters are kept.
+func NewCheckpoint(ctx context.Context, s storage.ExternalStorage, clusterID, backupTS uint64, resume bool) (*Checkpoint, error) {
+	prefix := checkpointFilePrefix(clusterID)
+	cp := &Checkpoint{
+		storage:    s,
+		prefix:     prefix,
+		metaFile:   prefix + "meta",
+		dataPrefix: prefix + "data.",
+		meta:       checkpointMeta{ClusterID: clusterID, BackupTS: backupTS},
+		files:      make(map[string]struct{}),
+		tables:     make(map[UniqueTableName]struct{}),
+		ddlJobs:    make(map[int64]struct{}),
+	}
+	exists, err := s.FileExists(ctx, cp.metaFile)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if exists && resume {
+		if err := cp.load(ctx); err != nil {
+			return nil, errors.Trace(err)
+		}
+		cp.resumed = true
+		log.Info("resume the restore from the checkpoint",
+			zap.Int("created-tables", len(cp.tables)), zap.Int("restored-files", len(cp.files)),
+			zap.Int("executed-ddl-jobs", len(cp.ddlJobs)))
+		return cp, nil
+	}
+	if resume {
+		log.Warn("no checkpoint found, start a new restore")
+	}
+	if exists {
+		log.Info("remove the stale checkpoint of the previous restore")
+		if err := cp.Remove(ctx); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	if err := cp.writeMeta(ctx); err != nil {
+		log.Warn("failed to write the checkpoint, the restore can't be resumed", logutil.ShortError(err))
+		cp.disabled = true
+	}
+	return cp, nil
+}
+
+func (cp *Checkpoint) load(ctx context.Context) error {
+	data, err := cp.storage.ReadFile(ctx, cp.metaFile)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var meta checkpointMeta
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return errors.Annotatef(err, "invalid checkpoint meta %s", cp.metaFile)
+	}
+	if meta.ClusterID != cp.meta.ClusterID || meta.BackupTS != cp.meta.BackupTS {
+		return errors.Annotatef(berrors.ErrInvalidArgument,
+			"the checkpoint belongs to the restore of the backup at %d to the cluster %d, "+
+				"restore without resuming to start a new restore", meta.BackupTS, meta.ClusterID)
+	}
+	cp.meta = meta
+	return cp.storage.WalkDir(ctx, &storage.WalkOption{ObjPrefix: cp.dataPrefix}, func(name string, _ int64) error {
+		// the sidecars of the checksums are walked if the storage isn't wrapped by WithChecksum.
+		if storage.IsChecksumFile(name) {
+			return nil
+		}
+		content, err := cp.storage.ReadFile(ctx, name)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		var data checkpointData
+		if err := json.Unmarshal(content, &data); err != nil {
+			return errors.Annotatef(err, "invalid checkpoint data %s", name)
+		}
+		for _, f := range data.Files {
+			cp.files[f] = struct{}{}
+		}
+		for _, t := range data.Tables {
+			cp.tables[t] = struct{}{}
+		}
+		for _, id := range data.DDLJobs {
+			cp.ddlJobs[id] = struct{}{}
+		}
+		return nil
+	})
+}
+
+func (cp *Checkpoint) writeMeta(ctx context.Context) error {
+	data, err := json.Marshal(cp.meta)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(cp.storage.WriteFile(ctx, cp.metaFile, data))
+}
+
+// Resumed returns whether the restore is resumed from a checkpoint.
+func (cp *Checkpoint) Resumed() bool {
+	return cp != nil && cp.resumed
+}
+
+func checkpointTableName(db, table string) UniqueTableName {
+	return UniqueTableName{DB: db, Table: table}
+}
+
+// IsTableCreated returns whether the table is created by the previous restore.
+func (cp *Checkpoint) IsTableCreated(table *metautil.Table) bool {
+	if cp == nil {
+		return false
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	_, ok := cp.tables[checkpointTableName(table.DB.Name.L, table.Info.Name.L)]
+	return ok
+}
+
+// IsFileRestored returns whether the data file is imported by the previous restore.
+func (cp *Checkpoint) IsFileRestored(file *backuppb.File) bool {
+	if cp == nil {
+		return false
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	_, ok := cp.files[file.Name]
+	return ok
+}
+
+// IsDDLJobExecuted returns whether the DDL job of the incremental backup is
+// executed by the previous restore.
+func (cp *Checkpoint) IsDDLJobExecuted(job *model.Job) bool {
+	if cp == nil {
+		return false
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	_, ok := cp.ddlJobs[job.ID]
+	return ok
+}
+
+// RecordTables records the created tables, they're persisted on the next Flush.
+func (cp *Checkpoint) RecordTables(tables ...*metautil.Table) {
+	if cp == nil {
+		return
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	for _, t := range tables {
+		name := checkpointTableName(t.DB.Name.L, t.Info.Name.L)
+		if _, ok := cp.tables[name]; !ok {
+			cp.tables[name] = struct{}{}
+			cp.pending.Tables = append(cp.pending.Tables, name)
+		}
+	}
+}
+
+// RecordFiles records the imported data files, they're persisted on the next Flush.
+func (cp *Checkpoint) RecordFiles(files []*backuppb.File) {
+	if cp == nil {
+		return
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	for _, f := range files {
+		if _, ok := cp.files[f.Name]; !ok {
+			cp.files[f.Name] = struct{}{}
+			cp.pending.Files = append(cp.pending.Files, f.Name)
+		}
+	}
+}
+
+// RecordDDLJob records the executed DDL job of the incremental backup, it's
+// persisted at once since executing a DDL job again may fail.
+func (cp *Checkpoint) RecordDDLJob(ctx context.Context, job *model.Job) {
+	if cp == nil {
+		return
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	if _, ok := cp.ddlJobs[job.ID]; ok {
+		return
+	}
+	cp.ddlJobs[job.ID] = struct{}{}
+	cp.pending.DDLJobs = append(cp.pending.DDLJobs, job.ID)
+	cp.flush(ctx)
+}
+
+// Flush persists the recorded progress.
+func (cp *Checkpoint) Flush(ctx context.Context) {
+	if cp == nil {
+		return
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	cp.flush(ctx)
+}
+
+func (cp *Checkpoint) flush(ctx context.Context) {
+	if cp.disabled || len(cp.pending.Files)+len(cp.pending.Tables)+len(cp.pending.DDLJobs) == 0 {
+		return
+	}
+	content, err := json.Marshal(cp.pending)
+	if err == nil {
+		cp.seq++
+		name := fmt.Sprintf("%s%d.%06d", cp.dataPrefix, time.Now().UnixNano(), cp.seq)
+		err = cp.storage.WriteFile(ctx, name, content)
+	}
+	if err != nil {
+		log.Warn("failed to write the checkpoint, the restore can't be resumed", logutil.ShortError(err))
+		cp.disabled = true
+		return
+	}
+	cp.pending = checkpointData{}
+}
+
+// Remove removes the checkpoint from the storage, it's called after the restore finishes.
+func (cp *Checkpoint) Remove(ctx context.Context) error {
+	if cp == nil {
+		return nil
+	}
+	names := make([]string, 0)
+	err := cp.storage.WalkDir(ctx, &storage.WalkOption{ObjPrefix: cp.prefix}, func(name string, _ int64) error {
+		names = append(names, name)
+		return nil
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, name := range names {
+		if err := cp.storage.DeleteFile(ctx, name); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
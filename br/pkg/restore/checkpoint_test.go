@@ -0,0 +1,85 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	s, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	db := &model.DBInfo{Name: model.NewCIStr("test")}
+	t1 := &metautil.Table{DB: db, Info: &model.TableInfo{Name: model.NewCIStr("T1")}}
+	t2 := &metautil.Table{DB: db, Info: &model.TableInfo{Name: model.NewCIStr("t2")}}
+	f1 := &backuppb.File{Name: "1.sst"}
+	f2 := &backuppb.File{Name: "2.sst"}
+	job1 := &model.Job{ID: 1}
+	job2 := &model.Job{ID: 2}
+
+	// resuming without a checkpoint starts a new restore.
+	cp, err := NewCheckpoint(ctx, s, 1, 100, true)
+	require.NoError(t, err)
+	require.False(t, cp.Resumed())
+	cp.RecordTables(t1)
+	cp.RecordFiles([]*backuppb.File{f1})
+	cp.Flush(ctx)
+	// the executed ddl jobs are persisted at once.
+	cp.RecordDDLJob(ctx, job1)
+	// the progress not flushed is lost.
+	cp.RecordFiles([]*backuppb.File{f2})
+
+	cp, err = NewCheckpoint(ctx, s, 1, 100, true)
+	require.NoError(t, err)
+	require.True(t, cp.Resumed())
+	require.True(t, cp.IsTableCreated(t1))
+	require.False(t, cp.IsTableCreated(t2))
+	require.True(t, cp.IsFileRestored(f1))
+	require.False(t, cp.IsFileRestored(f2))
+	require.True(t, cp.IsDDLJobExecuted(job1))
+	require.False(t, cp.IsDDLJobExecuted(job2))
+
+	// the checkpoint of restoring to another cluster is kept.
+	other, err := NewCheckpoint(ctx, s, 2, 100, false)
+	require.NoError(t, err)
+	other.RecordTables(t2)
+	other.Flush(ctx)
+
+	// the checkpoint of another restore can't be resumed.
+	_, err = NewCheckpoint(ctx, s, 1, 200, true)
+	require.Error(t, err)
+
+	// restoring without resuming removes the stale checkpoint.
+	cp, err = NewCheckpoint(ctx, s, 1, 200, false)
+	require.NoError(t, err)
+	require.False(t, cp.Resumed())
+	require.False(t, cp.IsTableCreated(t1))
+	require.False(t, cp.IsDDLJobExecuted(job1))
+	require.NoError(t, cp.Remove(ctx))
+	exists, err := s.FileExists(ctx, cp.metaFile)
+	require.NoError(t, err)
+	require.False(t, exists)
+	other, err = NewCheckpoint(ctx, s, 2, 100, true)
+	require.NoError(t, err)
+	require.True(t, other.Resumed())
+	require.True(t, other.IsTableCreated(t2))
+	require.False(t, other.IsTableCreated(t1))
+
+	// a nil checkpoint does nothing.
+	var nilCp *Checkpoint
+	require.False(t, nilCp.Resumed())
+	require.False(t, nilCp.IsTableCreated(t1))
+	require.False(t, nilCp.IsFileRestored(f1))
+	nilCp.RecordTables(t1)
+	nilCp.Flush(ctx)
+	require.NoError(t, nilCp.Remove(ctx))
+}
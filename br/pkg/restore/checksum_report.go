@@ -0,0 +1,219 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tipb/go-tipb"
+)
+
+// ChecksumStatus is the result of verifying the checksum of a table or a
+// partition after restoring.
+type ChecksumStatus string
+
+const (
+	// ChecksumMatched means the checksum of the restored data is the one in the backup.
+	ChecksumMatched ChecksumStatus = "matched"
+	// ChecksumMismatched means the checksum of the restored data differs from the backup.
+	ChecksumMismatched ChecksumStatus = "mismatched"
+	// ChecksumSkipped means there is no checksum in the backup to verify with.
+	ChecksumSkipped ChecksumStatus = "skipped"
+)
+
+// Checksum is the checksum of the data of a table or a partition.
+type Checksum struct {
+	Crc64Xor   uint64 `json:"crc64xor"`
+	TotalKvs   uint64 `json:"total-kvs"`
+	TotalBytes uint64 `json:"total-bytes"`
+}
+
+func (c *Checksum) update(crc64Xor, totalKvs, totalBytes uint64) {
+	c.Crc64Xor ^= crc64Xor
+	c.TotalKvs += totalKvs
+	c.TotalBytes += totalBytes
+}
+
+func compareChecksum(expected, actual Checksum) ChecksumStatus {
+	if expected == actual {
+		return ChecksumMatched
+	}
+	return ChecksumMismatched
+}
+
+// PartitionChecksum is the result of verifying the checksum of a partition.
+type PartitionChecksum struct {
+	Partition string         `json:"partition"`
+	Status    ChecksumStatus `json:"status"`
+	Expected  Checksum       `json:"expected"`
+	Actual    Checksum       `json:"actual"`
+}
+
+// TableChecksum is the result of verifying the checksum of a table, the
+// checksums of the partitions are verified by the checksums of the files in
+// the backup, they're skipped if the files have no checksums.
+type TableChecksum struct {
+	DB         string              `json:"db"`
+	Table      string              `json:"table"`
+	Status     ChecksumStatus      `json:"status"`
+	Expected   Checksum            `json:"expected"`
+	Actual     Checksum            `json:"actual"`
+	Partitions []PartitionChecksum `json:"partitions,omitempty"`
+	// Reverified is the times the checksum is verified again after mismatching.
+	Reverified int `json:"reverified,omitempty"`
+
+	table CreatedTable
+}
+
+// verifyTableChecksum compares the checksums of the restored table and its
+// partitions, by their physical IDs, with the checksums in the backup.
+func verifyTableChecksum(tbl CreatedTable, actual map[int64]*tipb.ChecksumResponse) *TableChecksum {
+	old := tbl.OldTable
+	result := &TableChecksum{
+		DB:       old.DB.Name.O,
+		Table:    old.Info.Name.O,
+		Expected: Checksum{Crc64Xor: old.Crc64Xor, TotalKvs: old.TotalKvs, TotalBytes: old.TotalBytes},
+		table:    tbl,
+	}
+	if old.NoChecksum() {
+		result.Status = ChecksumSkipped
+		return result
+	}
+	for _, resp := range actual {
+		result.Actual.update(resp.Checksum, resp.TotalKvs, resp.TotalBytes)
+	}
+	result.Status = compareChecksum(result.Expected, result.Actual)
+	if tbl.Table.Partition == nil {
+		return result
+	}
+
+	// the files of a partition are in the ranges of the partition.
+	var fromFiles Checksum
+	expected := make(map[int64]*Checksum)
+	for _, file := range old.Files {
+		physicalID := tablecodec.DecodeTableID(file.StartKey)
+		c, ok := expected[physicalID]
+		if !ok {
+			c = &Checksum{}
+			expected[physicalID] = c
+		}
+		c.update(file.Crc64Xor, file.TotalKvs, file.TotalBytes)
+		fromFiles.update(file.Crc64Xor, file.TotalKvs, file.TotalBytes)
+	}
+	filesHaveChecksums := fromFiles == result.Expected
+	for _, def := range tbl.Table.Partition.Definitions {
+		partition := PartitionChecksum{Partition: def.Name.O, Status: ChecksumSkipped}
+		if resp, ok := actual[def.ID]; ok {
+			partition.Actual.update(resp.Checksum, resp.TotalKvs, resp.TotalBytes)
+		}
+		if filesHaveChecksums && old.Info.Partition != nil {
+			for _, oldDef := range old.Info.Partition.Definitions {
+				if oldDef.Name.L == def.Name.L {
+					if c, ok := expected[oldDef.ID]; ok {
+						partition.Expected = *c
+					}
+					partition.Status = compareChecksum(partition.Expected, partition.Actual)
+					break
+				}
+			}
+		}
+		result.Partitions = append(result.Partitions, partition)
+	}
+	return result
+}
+
+// ChecksumReport collects the results of verifying the checksums of the
+// restored tables, it's safe to record the results concurrently.
+type ChecksumReport struct {
+	mu     sync.Mutex
+	tables map[UniqueTableName]*TableChecksum
+}
+
+// NewChecksumReport creates an empty ChecksumReport.
+func NewChecksumReport() *ChecksumReport {
+	return &ChecksumReport{tables: make(map[UniqueTableName]*TableChecksum)}
+}
+
+// record records the result of the table, the previous result of the table is
+// replaced if it's verified again.
+func (r *ChecksumReport) record(result *TableChecksum) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	name := UniqueTableName{DB: result.DB, Table: result.Table}
+	if prev, ok := r.tables[name]; ok {
+		result.Reverified = prev.Reverified + 1
+	}
+	r.tables[name] = result
+}
+
+// Tables returns the results of the tables, ordered by their names.
+func (r *ChecksumReport) Tables() []TableChecksum {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tables := make([]TableChecksum, 0, len(r.tables))
+	for _, t := range r.tables {
+		tables = append(tables, *t)
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		if tables[i].DB != tables[j].DB {
+			return tables[i].DB < tables[j].DB
+		}
+		return tables[i].Table < tables[j].Table
+	})
+	return tables
+}
+
+// Failed returns the results of the tables whose checksums mismatch.
+func (r *ChecksumReport) Failed() []TableChecksum {
+	failed := make([]TableChecksum, 0)
+	for _, t := range r.Tables() {
+		if t.Status == ChecksumMismatched {
+			failed = append(failed, t)
+		}
+	}
+	return failed
+}
+
+// JSON returns the report in JSON.
+func (r *ChecksumReport) JSON() ([]byte, error) {
+	tables := r.Tables()
+	data, err := json.MarshalIndent(struct {
+		Passed bool            `json:"passed"`
+		Tables []TableChecksum `json:"tables"`
+	}{
+		Passed: len(r.Failed()) == 0,
+		Tables: tables,
+	}, "", "  ")
+	return data, errors.Trace(err)
+}
+
+// Table returns the report in a text table, a row for a table or a partition.
+func (r *ChecksumReport) Table() string {
+	var buf strings.Builder
+	w := tabwriter.NewWriter(&buf, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "DATABASE\tTABLE\tPARTITION\tSTATUS\tEXPECTED CRC64XOR\tACTUAL CRC64XOR\t"+
+		"EXPECTED KVS\tACTUAL KVS\tEXPECTED BYTES\tACTUAL BYTES")
+	row := func(db, table, partition string, status ChecksumStatus, expected, actual Checksum) {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\n", db, table, partition, status,
+			expected.Crc64Xor, actual.Crc64Xor, expected.TotalKvs, actual.TotalKvs,
+			expected.TotalBytes, actual.TotalBytes)
+	}
+	for _, t := range r.Tables() {
+		row(t.DB, t.Table, "", t.Status, t.Expected, t.Actual)
+		for _, p := range t.Partitions {
+			row(t.DB, t.Table, p.Partition, p.Status, p.Expected, p.Actual)
+		}
+	}
+	_ = w.Flush()
+	return buf.String()
+}
@@ -0,0 +1,107 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tipb/go-tipb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyTableChecksum(t *testing.T) {
+	partitioned := func(tableID int64, partIDs ...int64) *model.TableInfo {
+		info := &model.TableInfo{ID: tableID, Name: model.NewCIStr("t"), Partition: &model.PartitionInfo{}}
+		for i, id := range partIDs {
+			info.Partition.Definitions = append(info.Partition.Definitions, model.PartitionDefinition{
+				ID: id, Name: model.NewCIStr(string(rune('a' + i))),
+			})
+		}
+		return info
+	}
+	fileOf := func(physicalID int64, crc, kvs, bytes uint64) *backuppb.File {
+		return &backuppb.File{
+			StartKey: tablecodec.EncodeTablePrefix(physicalID),
+			Crc64Xor: crc, TotalKvs: kvs, TotalBytes: bytes,
+		}
+	}
+	db := &model.DBInfo{Name: model.NewCIStr("test")}
+	tbl := CreatedTable{
+		Table: partitioned(100, 101, 102),
+		OldTable: &metautil.Table{
+			DB:       db,
+			Info:     partitioned(10, 11, 12),
+			Crc64Xor: 1 ^ 2, TotalKvs: 30, TotalBytes: 300,
+			Files: []*backuppb.File{fileOf(11, 1, 10, 100), fileOf(12, 2, 20, 200)},
+		},
+	}
+
+	// the partitions are verified by the checksums of the files.
+	result := verifyTableChecksum(tbl, map[int64]*tipb.ChecksumResponse{
+		101: {Checksum: 1, TotalKvs: 10, TotalBytes: 100},
+		102: {Checksum: 3, TotalKvs: 20, TotalBytes: 200},
+	})
+	require.Equal(t, ChecksumMismatched, result.Status)
+	require.Equal(t, Checksum{Crc64Xor: 1 ^ 3, TotalKvs: 30, TotalBytes: 300}, result.Actual)
+	require.Len(t, result.Partitions, 2)
+	require.Equal(t, ChecksumMatched, result.Partitions[0].Status)
+	require.Equal(t, ChecksumMismatched, result.Partitions[1].Status)
+	require.Equal(t, Checksum{Crc64Xor: 2, TotalKvs: 20, TotalBytes: 200}, result.Partitions[1].Expected)
+
+	// the partitions are skipped if the files have no checksums.
+	tbl.OldTable.Files = []*backuppb.File{fileOf(11, 0, 0, 0), fileOf(12, 0, 0, 0)}
+	result = verifyTableChecksum(tbl, map[int64]*tipb.ChecksumResponse{
+		101: {Checksum: 1, TotalKvs: 10, TotalBytes: 100},
+		102: {Checksum: 2, TotalKvs: 20, TotalBytes: 200},
+	})
+	require.Equal(t, ChecksumMatched, result.Status)
+	require.Equal(t, ChecksumSkipped, result.Partitions[0].Status)
+	require.Equal(t, ChecksumSkipped, result.Partitions[1].Status)
+
+	// the table without checksum in the backup is skipped.
+	tbl.OldTable = &metautil.Table{DB: db, Info: partitioned(10, 11, 12)}
+	result = verifyTableChecksum(tbl, nil)
+	require.Equal(t, ChecksumSkipped, result.Status)
+}
+
+func TestChecksumReport(t *testing.T) {
+	report := NewChecksumReport()
+	report.record(&TableChecksum{DB: "test", Table: "t2", Status: ChecksumMismatched,
+		Expected: Checksum{TotalKvs: 2}, Actual: Checksum{TotalKvs: 1}})
+	report.record(&TableChecksum{DB: "test", Table: "t1", Status: ChecksumMatched,
+		Partitions: []PartitionChecksum{{Partition: "p0", Status: ChecksumMatched}}})
+
+	failed := report.Failed()
+	require.Len(t, failed, 1)
+	require.Equal(t, "t2", failed[0].Table)
+	tables := report.Tables()
+	require.Len(t, tables, 2)
+	require.Equal(t, "t1", tables[0].Table)
+
+	data, err := report.JSON()
+	require.NoError(t, err)
+	var decoded struct {
+		Passed bool            `json:"passed"`
+		Tables []TableChecksum `json:"tables"`
+	}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.False(t, decoded.Passed)
+	require.Len(t, decoded.Tables, 2)
+
+	lines := strings.Split(strings.TrimSpace(report.Table()), "\n")
+	require.Len(t, lines, 4)
+	require.Contains(t, lines[1], "t1")
+	require.Contains(t, lines[2], "p0")
+	require.Contains(t, lines[3], "mismatched")
+
+	// verifying the table again replaces the result.
+	report.record(&TableChecksum{DB: "test", Table: "t2", Status: ChecksumMatched})
+	require.Empty(t, report.Failed())
+	require.Equal(t, 1, report.Tables()[1].Reverified)
+}
@@ -11,11 +11,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/docker/go-units"
 	"github.com/opentracing/opentracing-go"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
@@ -87,6 +89,8 @@ type Client struct {
 
 	databases map[string]*utils.Database
 	ddlJobs   []*model.Job
+	// kvMode is the mode of the data in the backup, which decides how it's restored.
+	kvMode KvMode
 
 	// store tables need to rebase info like auto id and random id and so on after create table
 	rebasedTablesMap map[UniqueTableName]bool
@@ -123,6 +127,46 @@ type Client struct {
 	dom          *domain.Domain
 
 	batchDdlSize uint
+	// ddlBatchSizer adjusts the batch size of creating tables during GoCreateTables.
+	ddlBatchSizer *ddlBatchSizer
+	// ddlConcurrency is the number of the sessions to create tables concurrently.
+	ddlConcurrency uint
+	// storageMirror is the mirror storage the files are downloaded from when
+	// the storage of the backup fails.
+	storageMirror *backuppb.StorageBackend
+
+	// checkpoint records the progress of the restore, it's nil if the restore
+	// can't be resumed.
+	checkpoint *Checkpoint
+	// logCheckpoint records the applied log files and meta kv batches of the
+	// log restore, it's nil if the log restore can't be resumed.
+	logCheckpoint *LogCheckpoint
+
+	// tableSizeFilter filters the tables to restore by their sizes in the backup.
+	tableSizeFilter TableSizeFilter
+	// tableRenames are the tables to restore with new names.
+	tableRenames TableRenames
+	// granularity is how the files of a batch are scheduled to restore.
+	granularity Granularity
+	// storeIngestStats collects the files ingested by every store in the
+	// coarse-grained restore.
+	storeIngestStats *storeIngestCollector
+	// restorePartition is the partition of the table to restore, it's nil if
+	// the whole tables are restored.
+	restorePartition *PartitionRestore
+	// tableSpeedLimiter throttles restoring the tables, it's nil if no table is limited.
+	tableSpeedLimiter *tableSpeedLimiter
+	// tableConcurrency caps the ranges of a table ingested concurrently, it's
+	// nil if the tables are unlimited.
+	tableConcurrency *tableConcurrencyLimiter
+	// tableIngestStats collects the files ingested by every table.
+	tableIngestStats *tableIngestCollector
+	// restoreProgress tracks the progress of restoring the data, it's nil if
+	// the progress isn't reported.
+	restoreProgress *RestoreProgress
+	// speedLimitMu protects rateLimit and hasSpeedLimited since the speed
+	// limits can be updated at runtime.
+	speedLimitMu sync.Mutex
 
 	// correspond to --tidb-placement-mode config.
 	// STRICT(default) means policy related SQL can be executed in tidb.
@@ -133,6 +177,14 @@ type Client struct {
 	policyMap *sync.Map
 
 	supportPolicy bool
+	// skipPlacementPolicy is whether to restore without the placement policies.
+	skipPlacementPolicy bool
+	// regionSplitSize is the target size of the regions split before restoring,
+	// the regions are split by every range if it's 0.
+	regionSplitSize uint64
+	// skipLoadStats is whether to restore without the statistics in the backup.
+	skipLoadStats bool
+	statsRestorer *StatsRestorer
 
 	// startTS and restoreTS are used for kv file restore.
 	// TiKV will filter the key space that don't belong to [startTS, restoreTS].
@@ -162,13 +214,28 @@ type Client struct {
 	// todo: maybe change to an enum
 	// this feature is controlled by flag with-sys-table
 	fullClusterRestore bool
-	// the query to insert rows into table `gc_delete_range`, lack of ts.
-	deleteRangeQuery          []string
-	deleteRangeQueryCh        chan string
-	deleteRangeQueryWaitGroup sync.WaitGroup
+	// the ranges of the dropped tables and indexes to insert into table `gc_delete_range`.
+	deleteRanges *DeleteRangeManager
+	// metaKVBatchSize bounds the bytes of the meta kv files restored in a batch,
+	// it's unlimited if it's 0.
+	metaKVBatchSize uint64
+	// checksumReport collects the results of verifying the checksums of the restored tables.
+	checksumReport *ChecksumReport
+	// deferredTiFlashReplicas records the TiFlash replicas which can't be satisfied by the
+	// TiFlash stores of the cluster, they're set once enough TiFlash stores join.
+	deferredTiFlashReplicas *tiflashrec.TiFlashRecorder
 
 	// see RestoreCommonConfig.WithSysTable
 	withSysTable bool
+	// keyspaceRewrite is the keyspaces to restore between, it's nil if the data
+	// isn't restored into a keyspace.
+	keyspaceRewrite *KeyspaceRewrite
+	// sysTableMergePolicy is how to merge the privilege tables in the backup
+	// into the existing ones, they aren't merged by default.
+	sysTableMergePolicy SysTableMergePolicy
+
+	// taskName describes the restore task, it's recorded with the temporary databases.
+	taskName string
 }
 
 // NewRestoreClient returns a new RestoreClient.
@@ -179,13 +246,17 @@ func NewRestoreClient(
 	isRawKv bool,
 ) *Client {
 	return &Client{
-		pdClient:           pdClient,
-		toolClient:         split.NewSplitClient(pdClient, tlsConf, isRawKv),
-		tlsConf:            tlsConf,
-		keepaliveConf:      keepaliveConf,
-		switchCh:           make(chan struct{}),
-		deleteRangeQuery:   make([]string, 0),
-		deleteRangeQueryCh: make(chan string, 10),
+		pdClient:         pdClient,
+		toolClient:       split.NewSplitClient(pdClient, tlsConf, isRawKv),
+		tlsConf:          tlsConf,
+		keepaliveConf:    keepaliveConf,
+		switchCh:         make(chan struct{}),
+		deleteRanges:     NewDeleteRangeManager(defaultDeleteRangeBatchSize),
+		metaKVBatchSize:  DefaultMetaKVBatchSize,
+		checksumReport:   NewChecksumReport(),
+		granularity:      FineGrained,
+		storeIngestStats: newStoreIngestCollector(),
+		tableIngestStats: newTableIngestCollector(),
 	}
 }
 
@@ -198,6 +269,10 @@ func (rc *Client) Init(g glue.Glue, store kv.Storage) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if rc.skipPlacementPolicy && rc.supportPolicy {
+		log.Info("restore without the placement policies")
+		rc.supportPolicy = false
+	}
 	rc.dom, err = g.GetDomain(store)
 	if err != nil {
 		return errors.Trace(err)
@@ -206,6 +281,9 @@ func (rc *Client) Init(g glue.Glue, store kv.Storage) error {
 	if rc.dom != nil {
 		rc.statsHandler = rc.dom.StatsHandle()
 	}
+	if rc.statsHandler != nil && !rc.skipLoadStats {
+		rc.statsRestorer = NewStatsRestorer(rc.statsHandler, rc.dom.InfoSchema)
+	}
 	// init backupMeta only for passing unit test
 	if rc.backupMeta == nil {
 		rc.backupMeta = new(backuppb.BackupMeta)
@@ -214,12 +292,11 @@ func (rc *Client) Init(g glue.Glue, store kv.Storage) error {
 	// Only in binary we can use multi-thread sessions to create tables.
 	// so use OwnStorage() to tell whether we are use binary or SQL.
 	if g.OwnsStorage() {
-		// Maybe allow user modify the DDL concurrency isn't necessary,
-		// because executing DDL is really I/O bound (or, algorithm bound?),
-		// and we cost most of time at waiting DDL jobs be enqueued.
-		// So these jobs won't be faster or slower when machine become faster or slower,
-		// hence make it a fixed value would be fine.
-		rc.dbPool, err = makeDBPool(defaultDDLConcurrency, func() (*DB, error) {
+		ddlConcurrency := rc.ddlConcurrency
+		if ddlConcurrency == 0 {
+			ddlConcurrency = defaultDDLConcurrency
+		}
+		rc.dbPool, err = makeDBPool(ddlConcurrency, func() (*DB, error) {
 			db, _, err := NewDB(g, store, rc.policyMode)
 			return db, err
 		})
@@ -298,6 +375,52 @@ func (rc *Client) SetSwitchModeInterval(interval time.Duration) {
 	rc.switchModeInterval = interval
 }
 
+// SetDDLConcurrency sets the number of the sessions to create tables concurrently,
+// it must be called before Init.
+func (rc *Client) SetDDLConcurrency(c uint) {
+	rc.ddlConcurrency = c
+}
+
+// SetCheckpoint sets the checkpoint to record the progress of the restore and
+// skip the work finished by the previous restore.
+func (rc *Client) SetCheckpoint(cp *Checkpoint) {
+	rc.checkpoint = cp
+}
+
+// InitLogCheckpoint creates the checkpoint of the log restore, it must be called
+// after SetRestoreRangeTS. If resume is true, the log files and the meta kv
+// batches applied by the previous log restore are skipped.
+func (rc *Client) InitLogCheckpoint(ctx context.Context, resume bool) (*LogCheckpoint, error) {
+	cp, err := NewLogCheckpoint(ctx, rc.storage, rc.db.se, rc.pdClient.GetClusterID(ctx), rc.startTS, rc.restoreTS, resume)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	rc.logCheckpoint = cp
+	return cp, nil
+}
+
+// SetRestoreProgress sets the progress to track restoring the data.
+func (rc *Client) SetRestoreProgress(p *RestoreProgress) {
+	rc.restoreProgress = p
+}
+
+// GetRestoreProgress returns the progress of restoring the data, it's nil if
+// the progress isn't tracked.
+func (rc *Client) GetRestoreProgress() *RestoreProgress {
+	return rc.restoreProgress
+}
+
+// SetTableSizeFilter sets the filter of the tables to restore by their sizes.
+func (rc *Client) SetTableSizeFilter(f TableSizeFilter) {
+	rc.tableSizeFilter = f
+}
+
+// MatchTableSize returns whether the table should be restored according to its
+// data size and row count in the backup.
+func (rc *Client) MatchTableSize(table *metautil.Table) bool {
+	return rc.tableSizeFilter.Match(table)
+}
+
 func (rc *Client) SetBatchDdlSize(batchDdlsize uint) {
 	rc.batchDdlSize = batchDdlsize
 }
@@ -306,6 +429,16 @@ func (rc *Client) GetBatchDdlSize() uint {
 	return rc.batchDdlSize
 }
 
+// GetDDLStats returns the timings of creating the tables by GoCreateTables.
+// The batch size starts from the one set by SetBatchDdlSize, and it's adjusted
+// by the latency and the failures of the batches.
+func (rc *Client) GetDDLStats() DDLStats {
+	if rc.ddlBatchSizer == nil {
+		return DDLStats{}
+	}
+	return rc.ddlBatchSizer.snapshot()
+}
+
 // Close a client.
 func (rc *Client) Close() {
 	// rc.db can be nil in raw kv mode.
@@ -332,18 +465,20 @@ func (rc *Client) SetCurrentTS(ts uint64) {
 }
 
 func (rc *Client) SetStorage(ctx context.Context, backend *backuppb.StorageBackend, opts *storage.ExternalStorageOptions) error {
-	var err error
-	rc.storage, err = storage.New(ctx, backend, opts)
+	s, err := storage.New(ctx, backend, opts)
 	if err != nil {
 		return errors.Trace(err)
 	}
+	// the metadata of the log backup is walked repeatedly during PITR.
+	rc.storage = storage.WithListingCache(s, storage.DefaultListingCacheTTL)
 	return nil
 }
 
-func (rc *Client) InitClients(backend *backuppb.StorageBackend, isRawKvMode bool) {
-	metaClient := split.NewSplitClient(rc.pdClient, rc.tlsConf, isRawKvMode)
+func (rc *Client) InitClients(backend *backuppb.StorageBackend, kvMode KvMode) {
+	metaClient := split.NewSplitClient(rc.pdClient, rc.tlsConf, kvMode == RawKvMode)
 	importCli := NewImportClient(metaClient, rc.tlsConf, rc.keepaliveConf)
-	rc.fileImporter = NewFileImporter(metaClient, importCli, backend, isRawKvMode)
+	rc.fileImporter = NewFileImporter(metaClient, importCli, backend, kvMode)
+	rc.fileImporter.mirrorBackend = rc.storageMirror
 }
 
 func (rc *Client) SetRawKVClient(c *RawKVBatchClient) {
@@ -376,11 +511,14 @@ func (rc *Client) InitBackupMeta(
 			}
 		}
 		rc.ddlJobs = ddlJobs
+		rc.upcastSchemas(backupMeta.ClusterVersion)
 	}
 	rc.backupMeta = backupMeta
+	rc.kvMode = kvModeOf(backupMeta, rc.databases)
 
-	rc.InitClients(backend, backupMeta.IsRawKv)
-	log.Info("load backupmeta", zap.Int("databases", len(rc.databases)), zap.Int("jobs", len(rc.ddlJobs)))
+	rc.InitClients(backend, rc.kvMode)
+	log.Info("load backupmeta", zap.Int("databases", len(rc.databases)), zap.Int("jobs", len(rc.ddlJobs)),
+		zap.Stringer("mode", rc.kvMode))
 	return rc.fileImporter.CheckMultiIngestSupport(c, rc.pdClient)
 }
 
@@ -389,15 +527,26 @@ func (rc *Client) IsRawKvMode() bool {
 	return rc.backupMeta.IsRawKv
 }
 
-// GetFilesInRawRange gets all files that are in the given range or intersects with the given range.
-func (rc *Client) GetFilesInRawRange(startKey []byte, endKey []byte, cf string) ([]*backuppb.File, error) {
-	if !rc.IsRawKvMode() {
-		return nil, errors.Annotate(berrors.ErrRestoreModeMismatch, "the backup data is not in raw kv mode")
+// KvMode returns the mode of the data in the backup, it's valid after InitBackupMeta.
+func (rc *Client) KvMode() KvMode {
+	return rc.kvMode
+}
+
+// GetFilesInRange gets all files that are in the given range or intersects with
+// the given range, the backup must be in the raw kv or the txn kv mode. The cf
+// is ignored in the txn kv mode, since a transaction writes both the default
+// and the write CF.
+func (rc *Client) GetFilesInRange(startKey []byte, endKey []byte, cf string) ([]*backuppb.File, error) {
+	if rc.kvMode == TiDBMode {
+		return nil, errors.Annotate(berrors.ErrRestoreModeMismatch, "the backup data of tidb can't be restored by the key range")
+	}
+	matchCF := func(c string) bool {
+		return rc.kvMode == TxnKvMode || c == cf
 	}
 
 	for _, rawRange := range rc.backupMeta.RawRanges {
 		// First check whether the given range is backup-ed. If not, we cannot perform the restore.
-		if rawRange.Cf != cf {
+		if !matchCF(rawRange.Cf) {
 			continue
 		}
 
@@ -421,7 +570,7 @@ func (rc *Client) GetFilesInRawRange(startKey []byte, endKey []byte, cf string)
 		files := make([]*backuppb.File, 0)
 
 		for _, file := range rc.backupMeta.Files {
-			if file.Cf != cf {
+			if !matchCF(file.Cf) {
 				continue
 			}
 
@@ -581,6 +730,19 @@ func (rc *Client) CreateDatabase(ctx context.Context, db *model.DBInfo) error {
 
 	log.Info("create database", zap.Stringer("name", db.Name))
 
+	if err := rc.prepareDatabase(ctx, db); err != nil {
+		return errors.Trace(err)
+	}
+	if err := rc.db.CreateDatabase(ctx, db); err != nil {
+		return errors.Trace(err)
+	}
+	rc.recordCreatedDatabase(ctx, db)
+	return nil
+}
+
+// prepareDatabase adjusts the placement policy of the database to the cluster
+// before creating it.
+func (rc *Client) prepareDatabase(ctx context.Context, db *model.DBInfo) error {
 	if !rc.supportPolicy {
 		log.Info("set placementPolicyRef to nil when target tidb not support policy",
 			zap.Stringer("database", db.Name))
@@ -592,8 +754,17 @@ func (rc *Client) CreateDatabase(ctx context.Context, db *model.DBInfo) error {
 			return errors.Trace(err)
 		}
 	}
+	return nil
+}
 
-	return rc.db.CreateDatabase(ctx, db)
+// recordCreatedDatabase records the temporary database created, so it can be
+// dropped if the restore fails.
+func (rc *Client) recordCreatedDatabase(ctx context.Context, db *model.DBInfo) {
+	if utils.IsTemporaryDB(db.Name) {
+		if err := recordTemporaryDB(ctx, rc.db.se, db.Name, rc.taskName); err != nil {
+			log.Warn("failed to record the temporary database", zap.Stringer("database", db.Name), logutil.ShortError(err))
+		}
+	}
 }
 
 // CreateTables creates multiple tables, and returns their rewrite rules.
@@ -642,10 +813,19 @@ func (rc *Client) createTables(
 	if rc.IsSkipCreateSQL() {
 		log.Info("skip create table and alter autoIncID")
 	} else {
-		err := db.CreateTables(ctx, tables, rc.GetRebasedTables(), rc.GetSupportPolicy(), rc.GetPolicyMap())
-		if err != nil {
-			return nil, errors.Trace(err)
+		toCreate := make([]*metautil.Table, 0, len(tables))
+		for _, table := range tables {
+			if !rc.checkpoint.IsTableCreated(table) {
+				toCreate = append(toCreate, table)
+			}
+		}
+		if len(toCreate) > 0 {
+			err := db.CreateTables(ctx, toCreate, rc.GetRebasedTables(), rc.GetSupportPolicy(), rc.GetPolicyMap())
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
 		}
+		rc.checkpoint.RecordTables(toCreate...)
 	}
 	cts := make([]CreatedTable, 0, len(tables))
 	for _, table := range tables {
@@ -660,7 +840,10 @@ func (rc *Client) createTables(
 				table.Info.IsCommonHandle,
 				newTableInfo.IsCommonHandle)
 		}
-		rules := GetRewriteRules(newTableInfo, table.Info, newTS, true)
+		rules, err := rc.keyspaceRewrite.encodeRules(GetRewriteRules(newTableInfo, table.Info, newTS, true))
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
 		ct := CreatedTable{
 			RewriteRule: rules,
 			Table:       newTableInfo,
@@ -681,11 +864,15 @@ func (rc *Client) createTable(
 ) (CreatedTable, error) {
 	if rc.IsSkipCreateSQL() {
 		log.Info("skip create table and alter autoIncID", zap.Stringer("table", table.Info.Name))
+	} else if rc.checkpoint.IsTableCreated(table) {
+		log.Info("skip the table created by the previous restore",
+			zap.Stringer("db", table.DB.Name), zap.Stringer("table", table.Info.Name))
 	} else {
 		err := db.CreateTable(ctx, table, rc.GetRebasedTables(), rc.GetSupportPolicy(), rc.GetPolicyMap())
 		if err != nil {
 			return CreatedTable{}, errors.Trace(err)
 		}
+		rc.checkpoint.RecordTables(table)
 	}
 	newTableInfo, err := rc.GetTableSchema(dom, table.DB.Name, table.Info.Name)
 	if err != nil {
@@ -698,7 +885,10 @@ func (rc *Client) createTable(
 			table.Info.IsCommonHandle,
 			newTableInfo.IsCommonHandle)
 	}
-	rules := GetRewriteRules(newTableInfo, table.Info, newTS, true)
+	rules, err := rc.keyspaceRewrite.encodeRules(GetRewriteRules(newTableInfo, table.Info, newTS, true))
+	if err != nil {
+		return CreatedTable{}, errors.Trace(err)
+	}
 	et := CreatedTable{
 		RewriteRule: rules,
 		Table:       newTableInfo,
@@ -710,6 +900,9 @@ func (rc *Client) createTable(
 // GoCreateTables create tables, and generate their information.
 // this function will use workers as the same number of sessionPool,
 // leave sessionPool nil to send DDLs sequential.
+// The tables are created level by level according to their dependencies, such
+// as the foreign keys, the views and the sequences, the tables in the same
+// level are created concurrently.
 func (rc *Client) GoCreateTables(
 	ctx context.Context,
 	dom *domain.Domain,
@@ -729,10 +922,21 @@ func (rc *Client) GoCreateTables(
 	outCh := make(chan CreatedTable, len(tables))
 	rater := logutil.TraceRateOver(logutil.MetricTableCreatedCounter)
 
+	levels := groupTablesByDependency(tables)
+	log.Info("group tables by dependency", zap.Int("tables", len(tables)), zap.Int("levels", len(levels)))
+	rc.ddlBatchSizer = newDDLBatchSizer(rc.batchDdlSize)
+
 	var err error
 
 	if rc.batchDdlSize > minBatchDdlSize && len(rc.dbPool) > 0 {
-		err = rc.createTablesInWorkerPool(ctx, dom, tables, newTS, outCh)
+		for len(levels) > 0 {
+			err = rc.createTablesInWorkerPool(ctx, dom, levels[0], newTS, outCh)
+			rc.checkpoint.Flush(ctx)
+			if err != nil {
+				break
+			}
+			levels = levels[1:]
+		}
 
 		if err == nil {
 			defer log.Debug("all tables are created")
@@ -741,6 +945,7 @@ func (rc *Client) GoCreateTables(
 		} else if utils.FallBack2CreateTable(err) {
 			// fall back to old create table (sequential create table)
 			log.Info("fall back to the sequential create table")
+			rc.ddlBatchSizer.fallBack()
 		} else {
 			errCh <- err
 			close(outCh)
@@ -754,7 +959,9 @@ func (rc *Client) GoCreateTables(
 			return c.Err()
 		default:
 		}
+		start := time.Now()
 		rt, err := rc.createTable(c, db, dom, t, newTS)
+		rc.ddlBatchSizer.record(1, time.Since(start), err)
 		if err != nil {
 			log.Error("create table failed",
 				zap.Error(err),
@@ -776,14 +983,18 @@ func (rc *Client) GoCreateTables(
 	go func() {
 		defer close(outCh)
 		defer log.Debug("all tables are created")
-		var err error
-		if len(rc.dbPool) > 0 {
-			err = rc.createTablesWithDBPool(ctx, createOneTable, tables)
-		} else {
-			err = rc.createTablesWithSoleDB(ctx, createOneTable, tables)
-		}
-		if err != nil {
-			errCh <- err
+		for _, level := range levels {
+			var err error
+			if len(rc.dbPool) > 0 {
+				err = rc.createTablesWithDBPool(ctx, createOneTable, level)
+			} else {
+				err = rc.createTablesWithSoleDB(ctx, createOneTable, level)
+			}
+			rc.checkpoint.Flush(ctx)
+			if err != nil {
+				errCh <- err
+				return
+			}
 		}
 	}()
 
@@ -822,19 +1033,16 @@ func (rc *Client) createTablesInWorkerPool(ctx context.Context, dom *domain.Doma
 	workers := utils.NewWorkerPool(uint(len(rc.dbPool)), "Create Tables Worker")
 	numOfTables := len(tables)
 
-	for lastSent := 0; lastSent < numOfTables; lastSent += int(rc.batchDdlSize) {
-		end := mathutil.Min(lastSent+int(rc.batchDdlSize), len(tables))
+	for lastSent := 0; lastSent < numOfTables; {
+		// the batch size may be adjusted by the batches created concurrently.
+		end := mathutil.Min(lastSent+int(rc.ddlBatchSizer.current()), len(tables))
 		log.Info("create tables", zap.Int("table start", lastSent), zap.Int("table end", end))
 
 		tableSlice := tables[lastSent:end]
+		lastSent = end
 		workers.ApplyWithIDInErrorGroup(eg, func(id uint64) error {
 			db := rc.dbPool[id%uint64(len(rc.dbPool))]
-			cts, err := rc.createTables(ectx, db, dom, tableSlice, newTS) // ddl job for [lastSent:i)
-			failpoint.Inject("restore-createtables-error", func(val failpoint.Value) {
-				if val.(bool) {
-					err = errors.New("sample error without extra message")
-				}
-			})
+			cts, err := rc.createTablesInBatch(ectx, db, dom, tableSlice, newTS)
 			if err != nil {
 				log.Error("create tables fail")
 				return err
@@ -856,12 +1064,59 @@ func (rc *Client) createTablesInWorkerPool(ctx context.Context, dom *domain.Doma
 	return eg.Wait()
 }
 
+// createTablesInBatch creates the tables by a DDL job, the batch is split and
+// retried if the DDL job is too large.
+func (rc *Client) createTablesInBatch(
+	ctx context.Context,
+	db *DB,
+	dom *domain.Domain,
+	tables []*metautil.Table,
+	newTS uint64,
+) ([]CreatedTable, error) {
+	start := time.Now()
+	cts, err := rc.createTables(ctx, db, dom, tables, newTS)
+	failpoint.Inject("restore-createtables-error", func(val failpoint.Value) {
+		if val.(bool) {
+			err = errors.New("sample error without extra message")
+		}
+	})
+	rc.ddlBatchSizer.record(len(tables), time.Since(start), err)
+	if err == nil || len(tables) <= 1 || !isDDLBatchTooLarge(err) {
+		return cts, err
+	}
+
+	log.Warn("the batch of tables is too large, create them in smaller batches",
+		zap.Int("tables", len(tables)), logutil.ShortError(err))
+	half := len(tables) / 2
+	cts, err = rc.createTablesInBatch(ctx, db, dom, tables[:half], newTS)
+	if err != nil {
+		return nil, err
+	}
+	rest, err := rc.createTablesInBatch(ctx, db, dom, tables[half:], newTS)
+	if err != nil {
+		return nil, err
+	}
+	return append(cts, rest...), nil
+}
+
 // CheckTargetClusterFresh check whether the target cluster is fresh or not
 // if there's no user dbs or tables, we take it as a fresh cluster, although
-// user may have created some users or made other changes.
+// user may have created some users or made other changes. The empty databases
+// to restore the renamed tables into are ignored, the renamed tables are
+// checked by CheckRenamedTablesNotExist.
 func (rc *Client) CheckTargetClusterFresh(ctx context.Context) error {
 	log.Info("checking whether target cluster is fresh")
 	userDBs := GetExistedUserDBs(rc.dom)
+	if len(rc.tableRenames) > 0 {
+		renamedDBs := rc.tableRenames.targetDBs()
+		nonEmpty := userDBs[:0]
+		for _, db := range userDBs {
+			if _, ok := renamedDBs[db.Name.L]; !ok || len(db.Tables) > 0 {
+				nonEmpty = append(nonEmpty, db)
+			}
+		}
+		userDBs = nonEmpty
+	}
 	if len(userDBs) == 0 {
 		return nil
 	}
@@ -908,7 +1163,22 @@ func (rc *Client) CheckSysTableCompatibility(dom *domain.Domain, tables []*metau
 			return errors.Annotate(berrors.ErrRestoreIncompatibleSys, "missed system table: "+table.Info.Name.O)
 		}
 		backupTi := table.Info
-		if len(ti.Columns) != len(backupTi.Columns) {
+		// the merged tables may miss the columns added by the newer versions,
+		// which are filled with the defaults.
+		merge := rc.sysTableMergePolicy != SysTableMergeNone && isMergeableSysTable(table.Info.Name.L)
+		if merge {
+			for _, backupCol := range backupTi.Columns {
+				if model.FindColumnInfo(ti.Columns, backupCol.Name.L) == nil {
+					log.Error("missing column in cluster",
+						zap.Stringer("table", table.Info.Name),
+						zap.String("col", fmt.Sprintf("%s %s", backupCol.Name, backupCol.FieldType.String())))
+					return errors.Annotatef(berrors.ErrRestoreIncompatibleSys,
+						"missing column in cluster, table: %s, col: %s %s",
+						table.Info.Name.O,
+						backupCol.Name, backupCol.FieldType.String())
+				}
+			}
+		} else if len(ti.Columns) != len(backupTi.Columns) {
 			log.Error("column count mismatch",
 				zap.Stringer("table", table.Info.Name),
 				zap.Int("col in cluster", len(ti.Columns)),
@@ -926,6 +1196,12 @@ func (rc *Client) CheckSysTableCompatibility(dom *domain.Domain, tables []*metau
 		for i := range ti.Columns {
 			col := ti.Columns[i]
 			backupCol := backupColMap[col.Name.L]
+			if backupCol == nil && merge && canFillByDefault(col) {
+				log.Info("missing column in backup data, fill it with the default",
+					zap.Stringer("table", table.Info.Name),
+					zap.String("col", fmt.Sprintf("%s %s", col.Name, col.FieldType.String())))
+				continue
+			}
 			if backupCol == nil {
 				log.Error("missing column in backup data",
 					zap.Stringer("table", table.Info.Name),
@@ -959,10 +1235,16 @@ func (rc *Client) ExecDDLs(ctx context.Context, ddlJobs []*model.Job) error {
 	})
 
 	for _, job := range ddlJobs {
+		if rc.checkpoint.IsDDLJobExecuted(job) {
+			log.Info("skip the ddl job executed by the previous restore",
+				zap.String("db", job.SchemaName), zap.String("query", job.Query))
+			continue
+		}
 		err := rc.db.ExecDDL(ctx, job)
 		if err != nil {
 			return errors.Trace(err)
 		}
+		rc.checkpoint.RecordDDLJob(ctx, job)
 		log.Info("execute ddl query",
 			zap.String("db", job.SchemaName),
 			zap.String("query", job.Query),
@@ -976,12 +1258,58 @@ func MockCallSetSpeedLimit(ctx context.Context, fakeImportClient ImporterClient,
 	rc.SetRateLimit(42)
 	rc.SetConcurrency(concurrency)
 	rc.hasSpeedLimited = false
-	rc.fileImporter = NewFileImporter(nil, fakeImportClient, nil, false)
+	rc.fileImporter = NewFileImporter(nil, fakeImportClient, nil, TiDBMode)
 	return rc.setSpeedLimit(ctx, rc.rateLimit)
 }
 
+// SetTableSpeedLimits sets the speed limits of restoring the tables and the
+// databases, it must be called after InitBackupMeta.
+func (rc *Client) SetTableSpeedLimits(limits TableSpeedLimits) {
+	if len(limits) == 0 {
+		return
+	}
+	rc.tableSpeedLimiter = newTableSpeedLimiter(rc.databases)
+	rc.tableSpeedLimiter.update(limits)
+}
+
+// GetSpeedLimits returns the current speed limit of the stores and the tables.
+func (rc *Client) GetSpeedLimits() (uint64, TableSpeedLimits) {
+	rc.speedLimitMu.Lock()
+	rateLimit := rc.rateLimit
+	rc.speedLimitMu.Unlock()
+	if rc.tableSpeedLimiter == nil {
+		return rateLimit, TableSpeedLimits{}
+	}
+	return rateLimit, rc.tableSpeedLimiter.currentLimits()
+}
+
+// UpdateSpeedLimit updates the speed limits during the restore. The speed
+// limit of the stores is sent to all the stores again, and the speed limits
+// of the tables take effect on the files imported later. Updating the limits
+// of the tables requires SetTableSpeedLimits to be called before restoring.
+// The limits are kept if rateLimit or limits is nil.
+func (rc *Client) UpdateSpeedLimit(ctx context.Context, rateLimit *uint64, limits TableSpeedLimits) error {
+	if limits != nil {
+		if rc.tableSpeedLimiter == nil {
+			return errors.Annotate(berrors.ErrInvalidArgument, "the table speed limits aren't enabled in this restore")
+		}
+		rc.tableSpeedLimiter.update(limits)
+	}
+	if rateLimit == nil {
+		return nil
+	}
+	rc.speedLimitMu.Lock()
+	rc.rateLimit = *rateLimit
+	rc.hasSpeedLimited = false
+	rc.speedLimitMu.Unlock()
+	log.Info("update the speed limit of the stores", zap.Uint64("rate-limit", *rateLimit))
+	return errors.Trace(rc.setSpeedLimit(ctx, *rateLimit))
+}
+
 func (rc *Client) ResetSpeedLimit(ctx context.Context) error {
+	rc.speedLimitMu.Lock()
 	rc.hasSpeedLimited = false
+	rc.speedLimitMu.Unlock()
 	err := rc.setSpeedLimit(ctx, 0)
 	if err != nil {
 		return errors.Trace(err)
@@ -990,6 +1318,8 @@ func (rc *Client) ResetSpeedLimit(ctx context.Context) error {
 }
 
 func (rc *Client) setSpeedLimit(ctx context.Context, rateLimit uint64) error {
+	rc.speedLimitMu.Lock()
+	defer rc.speedLimitMu.Unlock()
 	if !rc.hasSpeedLimited {
 		stores, err := util.GetAllTiKVStores(ctx, rc.pdClient, util.SkipTiFlash)
 		if err != nil {
@@ -1059,7 +1389,23 @@ func (rc *Client) SplitRanges(ctx context.Context,
 	rewriteRules *RewriteRules,
 	updateCh glue.Progress,
 	isRawKv bool) error {
-	return SplitRanges(ctx, rc, ranges, rewriteRules, updateCh, isRawKv)
+	if err := SplitRanges(ctx, rc, ranges, rewriteRules, updateCh, isRawKv); err != nil {
+		return errors.Trace(err)
+	}
+	rc.restoreProgress.OnRangesSplit(len(ranges))
+	return nil
+}
+
+func (rc *Client) isFilesRestored(files []*backuppb.File) bool {
+	if !rc.checkpoint.Resumed() {
+		return false
+	}
+	for _, f := range files {
+		if !rc.checkpoint.IsFileRestored(f) {
+			return false
+		}
+	}
+	return true
 }
 
 // RestoreSSTFiles tries to restore the files.
@@ -1086,71 +1432,143 @@ func (rc *Client) RestoreSSTFiles(
 		ctx = opentracing.ContextWithSpan(ctx, span1)
 	}
 
-	eg, ectx := errgroup.WithContext(ctx)
-	err = rc.setSpeedLimit(ctx, rc.rateLimit)
+	rateLimit, _ := rc.GetSpeedLimits()
+	err = rc.setSpeedLimit(ctx, rateLimit)
 	if err != nil {
 		return errors.Trace(err)
 	}
 
+	switch {
+	case rc.granularity == CoarseGrained:
+		err = rc.restoreSSTFilesByStore(ctx, files, rewriteRules, updateCh)
+	case rc.tableConcurrency != nil:
+		err = rc.restoreSSTFilesByTable(ctx, files, rewriteRules, updateCh)
+	default:
+		err = rc.restoreSSTFilesByRange(ctx, files, rewriteRules, updateCh)
+	}
+	rc.checkpoint.Flush(ctx)
+	if err != nil {
+		summary.CollectFailureUnit("file", err)
+		log.Error(
+			"restore files failed",
+			zap.Error(err),
+		)
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// restoreSSTFilesByRange restores the ranges of the files in order by the
+// worker pool.
+func (rc *Client) restoreSSTFilesByRange(
+	ctx context.Context,
+	files []*backuppb.File,
+	rewriteRules *RewriteRules,
+	updateCh glue.Progress,
+) error {
+	eg, ectx := errgroup.WithContext(ctx)
 	var rangeFiles []*backuppb.File
 	var leftFiles []*backuppb.File
 	for rangeFiles, leftFiles = drainFilesByRange(files, rc.fileImporter.supportMultiIngest); len(rangeFiles) != 0; rangeFiles, leftFiles = drainFilesByRange(leftFiles, rc.fileImporter.supportMultiIngest) {
 		filesReplica := rangeFiles
+		if rc.isFilesRestored(filesReplica) {
+			log.Info("skip the files imported by the previous restore", logutil.Files(filesReplica))
+			rc.restoreProgress.OnFilesIngested(filesReplica)
+			updateCh.Inc()
+			continue
+		}
 		rc.workerPool.ApplyOnErrorGroup(eg,
 			func() error {
-				fileStart := time.Now()
-				defer func() {
-					log.Info("import files done", logutil.Files(filesReplica),
-						zap.Duration("take", time.Since(fileStart)))
-					updateCh.Inc()
-				}()
-				return rc.fileImporter.ImportSSTFiles(ectx, filesReplica, rewriteRules, rc.cipher, rc.backupMeta.ApiVersion)
+				return rc.restoreRangeFiles(ectx, filesReplica, rewriteRules, updateCh)
 			})
 	}
+	return eg.Wait()
+}
 
-	if err := eg.Wait(); err != nil {
-		summary.CollectFailureUnit("file", err)
-		log.Error(
-			"restore files failed",
-			zap.Error(err),
-		)
+// restoreRangeFiles imports the files of a range.
+func (rc *Client) restoreRangeFiles(
+	ctx context.Context,
+	files []*backuppb.File,
+	rewriteRules *RewriteRules,
+	updateCh glue.Progress,
+) error {
+	fileStart := time.Now()
+	defer func() {
+		log.Info("import files done", logutil.Files(files),
+			zap.Duration("take", time.Since(fileStart)))
+		updateCh.Inc()
+	}()
+	if err := rc.tableSpeedLimiter.wait(ctx, files); err != nil {
 		return errors.Trace(err)
 	}
-	return nil
+	err := rc.fileImporter.ImportSSTFiles(ctx, files, rewriteRules, rc.cipher, rc.backupMeta.ApiVersion)
+	if err == nil {
+		rc.tableIngestStats.record(files, fileStart, time.Now())
+		rc.checkpoint.RecordFiles(files)
+		rc.restoreProgress.OnFilesIngested(files)
+	}
+	return err
 }
 
-// RestoreRaw tries to restore raw keys in the specified range.
-func (rc *Client) RestoreRaw(
+// RestoreRange restores the files in the key range of the backup in the raw kv
+// or the txn kv mode, the keys aren't rewritten. Like restoring the tables, the
+// files restored by the previous restore are skipped if it's resumed from the
+// checkpoint, and the speed limit and the progress apply to the files.
+func (rc *Client) RestoreRange(
 	ctx context.Context, startKey []byte, endKey []byte, files []*backuppb.File, updateCh glue.Progress,
 ) error {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		log.Info("Restore Raw",
+		log.Info("Restore range",
+			zap.Stringer("mode", rc.kvMode),
 			logutil.Key("startKey", startKey),
 			logutil.Key("endKey", endKey),
 			zap.Duration("take", elapsed))
 	}()
-	errCh := make(chan error, len(files))
+	if rc.kvMode == TiDBMode {
+		return errors.Annotate(berrors.ErrRestoreModeMismatch, "the backup data of tidb can't be restored by the key range")
+	}
 	eg, ectx := errgroup.WithContext(ctx)
-	defer close(errCh)
 
-	err := rc.fileImporter.SetRawRange(startKey, endKey)
+	err := rc.fileImporter.SetRange(startKey, endKey)
 	if err != nil {
 		return errors.Trace(err)
 	}
+	rateLimit, _ := rc.GetSpeedLimits()
+	if err := rc.setSpeedLimit(ctx, rateLimit); err != nil {
+		return errors.Trace(err)
+	}
 
+	skipped := 0
 	for _, file := range files {
 		fileReplica := file
+		if rc.checkpoint.IsFileRestored(fileReplica) {
+			skipped++
+			updateCh.Inc()
+			continue
+		}
 		rc.workerPool.ApplyOnErrorGroup(eg,
 			func() error {
 				defer updateCh.Inc()
-				return rc.fileImporter.ImportSSTFiles(ectx, []*backuppb.File{fileReplica}, EmptyRewriteRule(), rc.cipher, rc.backupMeta.ApiVersion)
+				fileReplicas := []*backuppb.File{fileReplica}
+				if err := rc.fileImporter.ImportSSTFiles(ectx, fileReplicas, EmptyRewriteRule(), rc.cipher, rc.backupMeta.ApiVersion); err != nil {
+					return errors.Trace(err)
+				}
+				rc.checkpoint.RecordFiles(fileReplicas)
+				rc.restoreProgress.OnFilesIngested(fileReplicas)
+				return nil
 			})
 	}
-	if err := eg.Wait(); err != nil {
+	if skipped > 0 {
+		log.Info("skip the files restored by the previous restore", zap.Int("count", skipped))
+	}
+	err = eg.Wait()
+	rc.checkpoint.Flush(ctx)
+	if err != nil {
 		log.Error(
-			"restore raw range failed",
+			"restore range failed",
+			zap.Stringer("mode", rc.kvMode),
 			logutil.Key("startKey", startKey),
 			logutil.Key("endKey", endKey),
 			zap.Error(err),
@@ -1158,7 +1576,8 @@ func (rc *Client) RestoreRaw(
 		return errors.Trace(err)
 	}
 	log.Info(
-		"finish to restore raw range",
+		"finish to restore range",
+		zap.Stringer("mode", rc.kvMode),
 		logutil.Key("startKey", startKey),
 		logutil.Key("endKey", endKey),
 	)
@@ -1308,6 +1727,7 @@ func (rc *Client) GoValidateChecksum(
 						elapsed := time.Since(start)
 						summary.CollectSuccessUnit("table checksum", 1, elapsed)
 					}()
+					glue.SetProgressObject(updateCh, utils.EncloseDBAndTable(tbl.OldTable.DB.Name.O, tbl.OldTable.Info.Name.O))
 					err := rc.execChecksum(ectx, tbl, kvClient, concurrency, loadStatCh)
 					if err != nil {
 						return errors.Trace(err)
@@ -1340,6 +1760,8 @@ func (rc *Client) execChecksum(
 
 	if tbl.OldTable.NoChecksum() {
 		logger.Warn("table has no checksum, skipping checksum")
+		// the statistics can be loaded without the checksum.
+		loadStatCh <- &tbl
 		return nil
 	}
 
@@ -1360,32 +1782,54 @@ func (rc *Client) execChecksum(
 	if err != nil {
 		return errors.Trace(err)
 	}
-	checksumResp, err := exe.Execute(ctx, kvClient, func() {
+	checksumResps, err := exe.ExecuteByPhysicalTable(ctx, kvClient, func() {
 		// TODO: update progress here.
 	})
 	if err != nil {
 		return errors.Trace(err)
 	}
 
-	table := tbl.OldTable
-	if checksumResp.Checksum != table.Crc64Xor ||
-		checksumResp.TotalKvs != table.TotalKvs ||
-		checksumResp.TotalBytes != table.TotalBytes {
+	result := verifyTableChecksum(tbl, checksumResps)
+	rc.checksumReport.record(result)
+	if result.Status == ChecksumMismatched {
+		// the table is reported rather than failing the restore at once, so the
+		// other tables are still verified.
 		logger.Error("failed in validate checksum",
-			zap.Uint64("origin tidb crc64", table.Crc64Xor),
-			zap.Uint64("calculated crc64", checksumResp.Checksum),
-			zap.Uint64("origin tidb total kvs", table.TotalKvs),
-			zap.Uint64("calculated total kvs", checksumResp.TotalKvs),
-			zap.Uint64("origin tidb total bytes", table.TotalBytes),
-			zap.Uint64("calculated total bytes", checksumResp.TotalBytes),
+			zap.Uint64("origin tidb crc64", result.Expected.Crc64Xor),
+			zap.Uint64("calculated crc64", result.Actual.Crc64Xor),
+			zap.Uint64("origin tidb total kvs", result.Expected.TotalKvs),
+			zap.Uint64("calculated total kvs", result.Actual.TotalKvs),
+			zap.Uint64("origin tidb total bytes", result.Expected.TotalBytes),
+			zap.Uint64("calculated total bytes", result.Actual.TotalBytes),
 		)
-		return errors.Annotate(berrors.ErrRestoreChecksumMismatch, "failed to validate checksum")
+		return nil
 	}
 
 	loadStatCh <- &tbl
 	return nil
 }
 
+// GetChecksumReport returns the results of verifying the checksums of the restored tables.
+func (rc *Client) GetChecksumReport() *ChecksumReport {
+	return rc.checksumReport
+}
+
+// ReverifyChecksum verifies the checksums of the tables mismatched again, the
+// statistics of the tables matched this time are loaded.
+func (rc *Client) ReverifyChecksum(ctx context.Context, kvClient kv.Client, concurrency uint) error {
+	failed := rc.checksumReport.Failed()
+	loadStatCh := make(chan *CreatedTable, len(failed))
+	for _, t := range failed {
+		if err := rc.execChecksum(ctx, t.table, kvClient, concurrency, loadStatCh); err != nil {
+			close(loadStatCh)
+			return errors.Trace(err)
+		}
+	}
+	close(loadStatCh)
+	rc.updateMetaAndLoadStats(ctx, loadStatCh)
+	return nil
+}
+
 func (rc *Client) updateMetaAndLoadStats(ctx context.Context, input <-chan *CreatedTable) {
 	for {
 		select {
@@ -1396,31 +1840,22 @@ func (rc *Client) updateMetaAndLoadStats(ctx context.Context, input <-chan *Crea
 				return
 			}
 
-			// Not need to return err when failed because of update analysis-meta
-			restoreTS, err := rc.GetTS(ctx)
-			if err != nil {
-				log.Error("getTS failed", zap.Error(err))
-			} else {
-				err = rc.db.UpdateStatsMeta(ctx, tbl.Table.ID, restoreTS, tbl.OldTable.TotalKvs)
+			// the row count is unknown if the table has no checksum.
+			if !tbl.OldTable.NoChecksum() {
+				// Not need to return err when failed because of update analysis-meta
+				restoreTS, err := rc.GetTS(ctx)
 				if err != nil {
-					log.Error("update stats meta failed", zap.Any("table", tbl.Table), zap.Error(err))
+					log.Error("getTS failed", zap.Error(err))
+				} else {
+					err = rc.db.UpdateStatsMeta(ctx, tbl.Table.ID, restoreTS, tbl.OldTable.TotalKvs)
+					if err != nil {
+						log.Error("update stats meta failed", zap.Any("table", tbl.Table), zap.Error(err))
+					}
 				}
 			}
 
-			table := tbl.OldTable
-			if table.Stats != nil {
-				log.Info("start loads analyze after validate checksum",
-					zap.Int64("old id", tbl.OldTable.Info.ID),
-					zap.Int64("new id", tbl.Table.ID),
-				)
-				start := time.Now()
-				if err := rc.statsHandler.LoadStatsFromJSON(rc.dom.InfoSchema(), table.Stats); err != nil {
-					log.Error("analyze table failed", zap.Any("table", table.Stats), zap.Error(err))
-				}
-				log.Info("restore stat done",
-					zap.String("table", table.Info.Name.L),
-					zap.String("db", table.DB.Name.L),
-					zap.Duration("cost", time.Since(start)))
+			if rc.statsRestorer != nil {
+				rc.statsRestorer.Restore(tbl)
 			}
 		}
 	}
@@ -1639,6 +2074,13 @@ func (rc *Client) getTiFlashNodeCount(ctx context.Context) (uint64, error) {
 	return uint64(len(tiFlashStores)), nil
 }
 
+// SetDeferredTiFlashReplicas sets the recorder of the TiFlash replicas which
+// can't be satisfied by the TiFlash stores of the cluster, they're recorded by
+// PreCheckTableTiFlashReplica rather than dropped.
+func (rc *Client) SetDeferredTiFlashReplicas(recorder *tiflashrec.TiFlashRecorder) {
+	rc.deferredTiFlashReplicas = recorder
+}
+
 // PreCheckTableTiFlashReplica checks whether TiFlash replica is less than TiFlash node.
 func (rc *Client) PreCheckTableTiFlashReplica(
 	ctx context.Context,
@@ -1652,8 +2094,13 @@ func (rc *Client) PreCheckTableTiFlashReplica(
 	for _, table := range tables {
 		if recorder != nil ||
 			(table.Info.TiFlashReplica != nil && table.Info.TiFlashReplica.Count > tiFlashStoreCount) {
-			if recorder != nil && table.Info.TiFlashReplica != nil {
-				recorder.AddTable(table.Info.ID, *table.Info.TiFlashReplica)
+			// the replicas can't be satisfied are deferred if it's not a point restore.
+			rec := recorder
+			if rec == nil {
+				rec = rc.deferredTiFlashReplicas
+			}
+			if rec != nil && table.Info.TiFlashReplica != nil {
+				rec.AddTable(table.Info.ID, *table.Info.TiFlashReplica)
 			}
 			// we cannot satisfy TiFlash replica in restore cluster. so we should
 			// set TiFlashReplica to unavailable in tableInfo, to avoid TiDB cannot sense TiFlash and make plan to TiFlash
@@ -1868,12 +2315,18 @@ func (rc *Client) RestoreKVFiles(
 
 	eg, ectx := errgroup.WithContext(ctx)
 	skipFile := 0
+	appliedFile := 0
 	deleteFiles := make([]*backuppb.DataFileInfo, 0)
 
 	applyFunc := func(file *backuppb.DataFileInfo) {
 		// get rewrite rule from table id
 		rule, ok := rules[file.TableId]
-		if !ok {
+		if rc.logCheckpoint.IsFileApplied(file) {
+			// the file is applied by the previous restore.
+			onProgress()
+			summary.CollectInt("FileApplied", 1)
+			appliedFile++
+		} else if !ok {
 			// TODO handle new created table
 			// For this version we do not handle new created table after full backup.
 			// in next version we will perform rewrite and restore meta key to restore new created tables.
@@ -1895,7 +2348,11 @@ func (rc *Client) RestoreKVFiles(
 				if file.Cf == stream.DefaultCF {
 					startTS = rc.shiftStartTS
 				}
-				return rc.fileImporter.ImportKVFiles(ectx, file, rule, startTS, rc.restoreTS)
+				if err := rc.fileImporter.ImportKVFiles(ectx, file, rule, startTS, rc.restoreTS); err != nil {
+					return errors.Trace(err)
+				}
+				rc.logCheckpoint.RecordFile(ectx, file)
+				return nil
 			})
 		}
 	}
@@ -1919,8 +2376,14 @@ func (rc *Client) RestoreKVFiles(
 	if skipFile > 0 {
 		log.Debug("table id in full backup storage", zap.Any("tables", rules))
 	}
+	if appliedFile > 0 {
+		log.Info("total skip files applied by the previous restore", zap.Int("count", appliedFile))
+	}
 
-	if err = eg.Wait(); err != nil {
+	err = eg.Wait()
+	// persist the files applied even if the restore fails, so they're skipped by the next one.
+	rc.logCheckpoint.Flush(ctx)
+	if err != nil {
 		summary.CollectFailureUnit("file", err)
 		log.Error(
 			"restore files failed",
@@ -2089,6 +2552,15 @@ func (rc *Client) RestoreMetaKVFiles(
 	return nil
 }
 
+// DefaultMetaKVBatchSize is the default bytes of the meta kv files restored in a batch.
+const DefaultMetaKVBatchSize = 64 * units.MiB
+
+// SetMetaKVBatchSize sets the bytes of the meta kv files restored in a batch, the
+// files of the overlapped ts ranges are restored in a batch if it's 0.
+func (rc *Client) SetMetaKVBatchSize(size uint64) {
+	rc.metaKVBatchSize = size
+}
+
 func (rc *Client) RestoreMetaKVFilesWithBatchMethod(
 	ctx context.Context,
 	files []*backuppb.DataFileInfo,
@@ -2099,96 +2571,136 @@ func (rc *Client) RestoreMetaKVFilesWithBatchMethod(
 		ctx context.Context,
 		files []*backuppb.DataFileInfo,
 		schemasReplace *stream.SchemasReplace,
+		kvEntries []*KvEntryWithTS,
+		filterTS uint64,
 		updateStats func(kvCount uint64, size uint64),
 		progressInc func(),
-	) error,
+	) ([]*KvEntryWithTS, error),
 ) error {
+	if len(files) == 0 {
+		return nil
+	}
 	var (
-		rangeMin uint64
-		rangeMax uint64
-		idx      int
+		rangeMax  uint64
+		batchSize uint64
+		idx       int
+		err       error
+		// the entries left by the previous batch, whose ts are not less than
+		// the min ts of the files in the current batch.
+		kvEntries []*KvEntryWithTS
 	)
 	for i, f := range files {
 		if i == 0 {
-			idx = i
 			rangeMax = f.MaxTs
-			rangeMin = f.MinTs
-		} else {
-			if f.MinTs <= rangeMax {
-				rangeMin = mathutil.Min(rangeMin, f.MinTs)
-				rangeMax = mathutil.Max(rangeMax, f.MaxTs)
-			} else {
-				err := restoreBatch(ctx, files[idx:i], schemasReplace, updateStats, progressInc)
-				if err != nil {
-					return errors.Trace(err)
-				}
-				idx = i
-				rangeMin = f.MinTs
-				rangeMax = f.MaxTs
-			}
+			batchSize = f.Length
+			continue
 		}
-
-		if i == len(files)-1 {
-			err := restoreBatch(ctx, files[idx:], schemasReplace, updateStats, progressInc)
-			if err != nil {
-				return errors.Trace(err)
-			}
+		withinLimit := rc.metaKVBatchSize == 0 || batchSize+f.Length <= rc.metaKVBatchSize
+		if f.MinTs <= rangeMax && withinLimit {
+			rangeMax = mathutil.Max(rangeMax, f.MaxTs)
+			batchSize += f.Length
+			continue
 		}
+		// the files are sorted by the min ts, so the entries of the batch whose ts
+		// are not less than f.MinTs are restored with the following files.
+		kvEntries, err = restoreBatch(ctx, files[idx:i], schemasReplace, kvEntries, f.MinTs, updateStats, progressInc)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		idx = i
+		rangeMax = f.MaxTs
+		batchSize = f.Length
+	}
+	kvEntries, err = restoreBatch(ctx, files[idx:], schemasReplace, kvEntries, math.MaxUint64, updateStats, progressInc)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(kvEntries) > 0 {
+		return errors.Annotatef(berrors.ErrInvalidMetaFile, "%d meta kv entries are left unrestored", len(kvEntries))
 	}
 	return nil
 }
 
-// the kv entry with ts, the ts is decoded from entry.
-type kvEntryWithTS struct {
+// KvEntryWithTS is the kv entry with ts, the ts is decoded from entry.
+type KvEntryWithTS struct {
 	e  kv.Entry
 	ts uint64
 }
 
+// RestoreBatchMetaKVFiles restores the entries of the files and the entries
+// left by the previous batch, whose ts are less than filterTS, in the order of
+// ts. The rest of the entries are returned for restoring with the next batch,
+// so only a batch of the entries is kept in memory at a time.
 func (rc *Client) RestoreBatchMetaKVFiles(
 	ctx context.Context,
 	files []*backuppb.DataFileInfo,
 	schemasReplace *stream.SchemasReplace,
+	kvEntries []*KvEntryWithTS,
+	filterTS uint64,
 	updateStats func(kvCount uint64, size uint64),
 	progressInc func(),
-) error {
+) ([]*KvEntryWithTS, error) {
 	if len(files) == 0 {
-		return nil
+		return kvEntries, nil
 	}
 
 	// read all of entries from files.
-	kvEntries := make([]*kvEntryWithTS, 0)
 	for _, f := range files {
 		es, err := rc.readAllEntries(ctx, f)
 		if err != nil {
-			return errors.Trace(err)
+			return nil, errors.Trace(err)
 		}
 
 		kvEntries = append(kvEntries, es...)
 	}
 
 	// sort these entries.
-	slices.SortFunc(kvEntries, func(i, j *kvEntryWithTS) bool {
+	slices.SortFunc(kvEntries, func(i, j *KvEntryWithTS) bool {
 		return i.ts < j.ts
 	})
+	n := sort.Search(len(kvEntries), func(i int) bool {
+		return kvEntries[i].ts >= filterTS
+	})
+
+	// the entries of the batch applied by the previous restore are rewritten
+	// without putting, so the schemas replace is updated as the previous one.
+	marker := metaBatchMarker(files[0].GetCf(), filterTS, files)
+	applied := rc.logCheckpoint.IsMetaBatchApplied(marker)
+	if applied {
+		log.Info("skip putting the meta kv batch applied by the previous restore",
+			zap.Int("files", len(files)), zap.Uint64("filter-ts", filterTS))
+	}
 
 	// restore these entries with rawPut() method.
-	kvCount, size, err := rc.restoreMetaKvEntries(ctx, schemasReplace, kvEntries, files[0].GetCf())
+	kvCount, size, err := rc.restoreMetaKvEntries(ctx, schemasReplace, kvEntries[:n], files[0].GetCf(), applied)
 	if err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
+	}
+	if !applied {
+		rc.logCheckpoint.RecordMetaBatch(ctx, marker, schemasReplace.IDMap())
 	}
 
 	updateStats(kvCount, size)
 	for i := 0; i < len(files); i++ {
 		progressInc()
 	}
-	return nil
+
+	// copy the entries left, so the buffers of the files can be released.
+	rest := make([]*KvEntryWithTS, 0, len(kvEntries)-n)
+	for _, entry := range kvEntries[n:] {
+		rest = append(rest, &KvEntryWithTS{
+			e:  kv.Entry{Key: slices.Clone(entry.e.Key), Value: slices.Clone(entry.e.Value)},
+			ts: entry.ts,
+		})
+	}
+	return rest, nil
 }
 
 func (rc *Client) readAllEntries(
 	ctx context.Context,
 	file *backuppb.DataFileInfo,
-) ([]*kvEntryWithTS, error) {
-	kvEntries := make([]*kvEntryWithTS, 0)
+) ([]*KvEntryWithTS, error) {
+	kvEntries := make([]*KvEntryWithTS, 0)
 
 	buff, err := rc.storage.ReadFile(ctx, file.Path)
 	if err != nil {
@@ -2230,7 +2742,7 @@ func (rc *Client) readAllEntries(
 			log.Warn("txn entry is null", zap.Uint64("key-ts", ts), zap.ByteString("tnxKey", txnEntry.Key))
 			continue
 		}
-		kvEntries = append(kvEntries, &kvEntryWithTS{e: txnEntry, ts: ts})
+		kvEntries = append(kvEntries, &KvEntryWithTS{e: txnEntry, ts: ts})
 	}
 
 	return kvEntries, nil
@@ -2239,8 +2751,9 @@ func (rc *Client) readAllEntries(
 func (rc *Client) restoreMetaKvEntries(
 	ctx context.Context,
 	sr *stream.SchemasReplace,
-	entries []*kvEntryWithTS,
+	entries []*KvEntryWithTS,
 	columnFamily string,
+	skipPut bool,
 ) (uint64, uint64, error) {
 	var (
 		kvCount uint64
@@ -2263,6 +2776,9 @@ func (rc *Client) restoreMetaKvEntries(
 		}
 		log.Debug("after rewrite entry", zap.Int("new-key-len", len(newEntry.Key)),
 			zap.Int("new-value-len", len(entry.e.Value)), zap.ByteString("new-key", newEntry.Key))
+		if skipPut {
+			continue
+		}
 
 		if err := rc.rawKVClient.Put(ctx, newEntry.Key, newEntry.Value, entry.ts); err != nil {
 			return 0, 0, errors.Trace(err)
@@ -2358,109 +2874,30 @@ func (rc *Client) UpdateSchemaVersion(ctx context.Context) error {
 	return nil
 }
 
-const (
-	insertDeleteRangeSQLPrefix = `INSERT IGNORE INTO mysql.gc_delete_range VALUES `
-	insertDeleteRangeSQLValue  = "(%d, %d, '%s', '%s', %%[1]d)"
-
-	batchInsertDeleteRangeSize = 256
-)
-
-// InsertDeleteRangeForTable generates query to insert table delete job into table `gc_delete_range`.
+// InsertDeleteRangeForTable records the ranges of the tables dropped by the job
+// to insert into table `gc_delete_range`.
 func (rc *Client) InsertDeleteRangeForTable(jobID int64, tableIDs []int64) {
-	var elementID int64 = 1
-	var tableID int64
-	for i := 0; i < len(tableIDs); i += batchInsertDeleteRangeSize {
-		batchEnd := len(tableIDs)
-		if batchEnd > i+batchInsertDeleteRangeSize {
-			batchEnd = i + batchInsertDeleteRangeSize
-		}
-
-		var buf strings.Builder
-		buf.WriteString(insertDeleteRangeSQLPrefix)
-		for j := i; j < batchEnd; j++ {
-			tableID = tableIDs[j]
-			startKey := tablecodec.EncodeTablePrefix(tableID)
-			endKey := tablecodec.EncodeTablePrefix(tableID + 1)
-			startKeyEncoded := hex.EncodeToString(startKey)
-			endKeyEncoded := hex.EncodeToString(endKey)
-			buf.WriteString(fmt.Sprintf(insertDeleteRangeSQLValue, jobID, elementID, startKeyEncoded, endKeyEncoded))
-			if j != batchEnd-1 {
-				buf.WriteString(",")
-			}
-			elementID += 1
-		}
-		rc.deleteRangeQueryCh <- buf.String()
-	}
+	rc.deleteRanges.AddTables(jobID, tableIDs)
 }
 
-// InsertDeleteRangeForIndex generates query to insert index delete job into table `gc_delete_range`.
+// InsertDeleteRangeForIndex records the ranges of the indexes dropped by the job
+// to insert into table `gc_delete_range`.
 func (rc *Client) InsertDeleteRangeForIndex(jobID int64, elementID *int64, tableID int64, indexIDs []int64) {
-	var indexID int64
-	for i := 0; i < len(indexIDs); i += batchInsertDeleteRangeSize {
-		batchEnd := len(indexIDs)
-		if batchEnd > i+batchInsertDeleteRangeSize {
-			batchEnd = i + batchInsertDeleteRangeSize
-		}
-
-		var buf strings.Builder
-		buf.WriteString(insertDeleteRangeSQLPrefix)
-		for j := i; j < batchEnd; j++ {
-			indexID = indexIDs[j]
-			startKey := tablecodec.EncodeTableIndexPrefix(tableID, indexID)
-			endKey := tablecodec.EncodeTableIndexPrefix(tableID, indexID+1)
-			startKeyEncoded := hex.EncodeToString(startKey)
-			endKeyEncoded := hex.EncodeToString(endKey)
-			buf.WriteString(fmt.Sprintf(insertDeleteRangeSQLValue, jobID, *elementID, startKeyEncoded, endKeyEncoded))
-			if j != batchEnd-1 {
-				buf.WriteString(",")
-			}
-			*elementID += 1
-		}
-		rc.deleteRangeQueryCh <- buf.String()
-	}
-}
-
-// use channel to save the delete-range query to make it thread-safety.
-func (rc *Client) RunGCRowsLoader(ctx context.Context) {
-	rc.deleteRangeQueryWaitGroup.Add(1)
-
-	go func() {
-		defer rc.deleteRangeQueryWaitGroup.Done()
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case query, ok := <-rc.deleteRangeQueryCh:
-				if !ok {
-					return
-				}
-				rc.deleteRangeQuery = append(rc.deleteRangeQuery, query)
-			}
-		}
-	}()
+	rc.deleteRanges.AddIndexes(jobID, elementID, tableID, indexIDs)
 }
 
-// InsertGCRows insert the querys into table `gc_delete_range`
+// InsertGCRows inserts the recorded ranges into table `gc_delete_range`.
 func (rc *Client) InsertGCRows(ctx context.Context) error {
-	close(rc.deleteRangeQueryCh)
-	rc.deleteRangeQueryWaitGroup.Wait()
 	ts, err := rc.GetTS(ctx)
 	if err != nil {
 		return errors.Trace(err)
 	}
-	for _, query := range rc.deleteRangeQuery {
-		if err := rc.db.se.ExecuteInternal(ctx, fmt.Sprintf(query, ts)); err != nil {
-			return errors.Trace(err)
-		}
-	}
-	return nil
+	return errors.Trace(rc.deleteRanges.Flush(ctx, rc.db.se, ts))
 }
 
-// only for unit test
-func (rc *Client) GetGCRows() []string {
-	close(rc.deleteRangeQueryCh)
-	rc.deleteRangeQueryWaitGroup.Wait()
-	return rc.deleteRangeQuery
+// GetPendingDeleteRanges returns the ranges not inserted into table `gc_delete_range` yet.
+func (rc *Client) GetPendingDeleteRanges() []DeleteRange {
+	return rc.deleteRanges.PendingRanges()
 }
 
 func (rc *Client) SaveSchemas(
@@ -2509,6 +2946,23 @@ func (rc *Client) SetWithSysTable(withSysTable bool) {
 	rc.withSysTable = withSysTable
 }
 
+// SetSysTableMergePolicy sets the policy to merge the privilege tables in the
+// backup into the existing ones.
+func (rc *Client) SetSysTableMergePolicy(policy SysTableMergePolicy) {
+	rc.sysTableMergePolicy = policy
+}
+
+// SetTaskName sets the description of the restore task, which is recorded with
+// the temporary databases it creates.
+func (rc *Client) SetTaskName(taskName string) {
+	rc.taskName = taskName
+}
+
+// CleanTemporaryDBs drops the temporary databases recorded by the failed restores at least `age` ago.
+func (rc *Client) CleanTemporaryDBs(ctx context.Context, age time.Duration) ([]string, error) {
+	return CleanTemporaryDBs(ctx, rc.db.se, rc.dom.InfoSchema(), age, false)
+}
+
 // MockClient create a fake client used to test.
 func MockClient(dbs map[string]*utils.Database) *Client {
 	return &Client{databases: dbs}
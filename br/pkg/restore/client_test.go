@@ -4,6 +4,7 @@ package restore_test
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"math"
 	"sort"
@@ -24,9 +25,11 @@ import (
 	"github.com/pingcap/tidb/br/pkg/restore/tiflashrec"
 	"github.com/pingcap/tidb/br/pkg/stream"
 	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/parser/types"
+	"github.com/pingcap/tidb/store/pdtypes"
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/stretchr/testify/require"
 	pd "github.com/tikv/pd/client"
@@ -100,6 +103,55 @@ func TestCreateTables(t *testing.T) {
 	}
 }
 
+func TestVerifyAutoIDs(t *testing.T) {
+	cluster := getStartedMockedCluster(t)
+	defer cluster.Stop()
+
+	g := gluetidb.New()
+	client := restore.NewRestoreClient(cluster.PDClient, nil, defaultKeepaliveCfg, false)
+	err := client.Init(g, cluster.Storage)
+	require.NoError(t, err)
+
+	info, err := cluster.Domain.GetSnapshotInfoSchema(math.MaxUint64)
+	require.NoError(t, err)
+	dbSchema, isExist := info.SchemaByName(model.NewCIStr("test"))
+	require.True(t, isExist)
+	intField := types.NewFieldType(mysql.TypeLong)
+	intField.SetCharset("binary")
+	table := &metautil.Table{
+		DB: dbSchema,
+		Info: &model.TableInfo{
+			ID:   1,
+			Name: model.NewCIStr("t_auto_id"),
+			Columns: []*model.ColumnInfo{{
+				ID:        1,
+				Name:      model.NewCIStr("id"),
+				FieldType: *intField,
+				State:     model.StatePublic,
+			}},
+			Charset:   "utf8mb4",
+			Collate:   "utf8mb4_bin",
+			AutoIncID: 100,
+		},
+	}
+	_, _, err = client.CreateTables(cluster.Domain, []*metautil.Table{table}, 0)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	rebased, err := client.VerifyAutoIDs(ctx, []*metautil.Table{table})
+	require.NoError(t, err)
+	require.Equal(t, 0, rebased)
+
+	// the allocator behind the backup is rebased.
+	table.Info.AutoIncID = 10000
+	rebased, err = client.VerifyAutoIDs(ctx, []*metautil.Table{table})
+	require.NoError(t, err)
+	require.Equal(t, 1, rebased)
+	rebased, err = client.VerifyAutoIDs(ctx, []*metautil.Table{table})
+	require.NoError(t, err)
+	require.Equal(t, 0, rebased)
+}
+
 func TestIsOnline(t *testing.T) {
 	m := mc
 	g := gluetidb.New()
@@ -134,10 +186,53 @@ func TestCheckTargetClusterFresh(t *testing.T) {
 	ctx := context.Background()
 	require.NoError(t, client.CheckTargetClusterFresh(ctx))
 
+	// the empty database to restore the renamed tables into is ignored.
+	renames, err := restore.ParseTableRenames([]string{"db.t:Restored_DB.t"})
+	require.NoError(t, err)
+	client.SetTableRenames(renames)
+	require.NoError(t, client.CreateDatabase(ctx, &model.DBInfo{Name: model.NewCIStr("restored_db")}))
+	require.NoError(t, client.CheckTargetClusterFresh(ctx))
+
 	require.NoError(t, client.CreateDatabase(ctx, &model.DBInfo{Name: model.NewCIStr("user_db")}))
 	require.True(t, berrors.ErrRestoreNotFreshCluster.Equal(client.CheckTargetClusterFresh(ctx)))
 }
 
+func TestCreateDatabases(t *testing.T) {
+	cluster := getStartedMockedCluster(t)
+	defer cluster.Stop()
+
+	g := gluetidb.New()
+	client := restore.NewRestoreClient(cluster.PDClient, nil, defaultKeepaliveCfg, false)
+	client.SetBatchDdlSize(2)
+	err := client.Init(g, cluster.Storage)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	dbs := []*model.DBInfo{
+		{Name: model.NewCIStr("db1")},
+		{Name: model.NewCIStr("db2"), Charset: "utf8mb4", Collate: "utf8mb4_bin"},
+		{Name: model.NewCIStr("db3")},
+	}
+	require.NoError(t, client.CreateDatabases(ctx, dbs))
+	info, err := cluster.Domain.GetSnapshotInfoSchema(math.MaxUint64)
+	require.NoError(t, err)
+	for _, db := range dbs {
+		_, ok := info.SchemaByName(db.Name)
+		require.True(t, ok, db.Name.O)
+	}
+
+	// nothing is created if any of the databases isn't supported.
+	err = client.CreateDatabases(ctx, []*model.DBInfo{
+		{Name: model.NewCIStr("db4")},
+		{Name: model.NewCIStr("db5"), Charset: "no_such_charset"},
+	})
+	require.True(t, berrors.ErrRestoreIncompatibleCharset.Equal(err))
+	info, err = cluster.Domain.GetSnapshotInfoSchema(math.MaxUint64)
+	require.NoError(t, err)
+	_, ok := info.SchemaByName(model.NewCIStr("db4"))
+	require.False(t, ok)
+}
+
 func TestCheckTargetClusterFreshWithTable(t *testing.T) {
 	// cannot use shared `mc`, other parallel case may change it.
 	cluster := getStartedMockedCluster(t)
@@ -236,6 +331,25 @@ func TestCheckSysTableCompatibility(t *testing.T) {
 		Info: mockedUserTI,
 	}})
 	require.NoError(t, err)
+
+	// the missing column with the default is filled when merging
+	client.SetSysTableMergePolicy(restore.SysTableMergeSkip)
+	mockedUserTI = userTI.Clone()
+	mockedUserTI.Columns = mockedUserTI.Columns[:len(mockedUserTI.Columns)-1]
+	err = client.CheckSysTableCompatibility(cluster.Domain, []*metautil.Table{{
+		DB:   tmpSysDB,
+		Info: mockedUserTI,
+	}})
+	require.NoError(t, err)
+
+	// the column missing in the cluster can't be merged
+	mockedUserTI = userTI.Clone()
+	mockedUserTI.Columns[0].Name = model.NewCIStr("new-name")
+	err = client.CheckSysTableCompatibility(cluster.Domain, []*metautil.Table{{
+		DB:   tmpSysDB,
+		Info: mockedUserTI,
+	}})
+	require.True(t, berrors.ErrRestoreIncompatibleSys.Equal(err))
 }
 
 func TestInitFullClusterRestore(t *testing.T) {
@@ -336,6 +450,92 @@ func (fpdc fakePDClient) GetAllStores(context.Context, ...pd.GetStoreOption) ([]
 	return append([]*metapb.Store{}, fpdc.stores...), nil
 }
 
+type fakeStoreInfoGetter map[uint64]uint64
+
+func (f fakeStoreInfoGetter) GetStoreInfo(_ context.Context, storeID uint64) (*pdtypes.StoreInfo, error) {
+	return &pdtypes.StoreInfo{Status: &pdtypes.StoreStatus{Available: pdtypes.ByteSize(f[storeID])}}, nil
+}
+
+func TestPlanRestore(t *testing.T) {
+	m := mc
+	g := gluetidb.New()
+	client := restore.NewRestoreClient(fakePDClient{stores: []*metapb.Store{
+		{Id: 1, Address: "tikv-1"},
+		{Id: 2, Labels: []*metapb.StoreLabel{{Key: "engine", Value: "tiflash"}}},
+	}}, nil, defaultKeepaliveCfg, false)
+	require.NoError(t, client.Init(g, m.Storage))
+
+	info, err := m.Domain.GetSnapshotInfoSchema(math.MaxUint64)
+	require.NoError(t, err)
+	dbSchema, isExist := info.SchemaByName(model.NewCIStr("test"))
+	require.True(t, isExist)
+	intField := types.NewFieldType(mysql.TypeLong)
+	intField.SetCharset("binary")
+	newTable := func(id int64, name string) *metautil.Table {
+		return &metautil.Table{
+			DB: dbSchema,
+			Info: &model.TableInfo{
+				ID:   id,
+				Name: model.NewCIStr(name),
+				Columns: []*model.ColumnInfo{{
+					ID:        1,
+					Name:      model.NewCIStr("id"),
+					FieldType: *intField,
+					State:     model.StatePublic,
+				}},
+				Charset: "utf8mb4",
+				Collate: "utf8mb4_bin",
+			},
+		}
+	}
+	existing := newTable(100, "test_plan_exist")
+	_, _, err = client.CreateTables(m.Domain, []*metautil.Table{existing}, 0)
+	require.NoError(t, err)
+
+	created := newTable(101, "test_plan_new")
+	created.Info.TiFlashReplica = &model.TiFlashReplicaInfo{Count: 2}
+	created.TotalKvs, created.TotalBytes = 10, 1000
+	created.Files = []*backuppb.File{{
+		Name:       "1_write.sst",
+		Cf:         "write",
+		StartKey:   tablecodec.EncodeRowKeyWithHandle(101, kv.IntHandle(1)),
+		EndKey:     tablecodec.EncodeRowKeyWithHandle(101, kv.IntHandle(10)),
+		TotalKvs:   10,
+		TotalBytes: 1000,
+	}}
+
+	tables := []*metautil.Table{existing, created}
+	plan, err := client.PlanRestore(context.Background(), m.Domain, fakeStoreInfoGetter{1: 10000}, tables, nil, 96<<20, 960000)
+	require.NoError(t, err)
+	require.True(t, plan.Passed, plan.Checks)
+	require.Len(t, plan.Warnings, 1)
+	require.Len(t, plan.Tables, 2)
+	require.True(t, plan.Tables[0].Exists)
+	require.NotEmpty(t, plan.Tables[0].RewriteRules)
+	require.False(t, plan.Tables[1].Exists)
+	require.Empty(t, plan.Tables[1].RewriteRules)
+	require.Equal(t, 1, plan.Tables[1].SplitKeys)
+	require.Equal(t, uint64(1000), plan.TotalBytes)
+	require.Equal(t, []restore.StorePlan{{StoreID: 1, Address: "tikv-1", AvailableBytes: 10000, RequiredBytes: 3000}}, plan.Stores)
+	// the TiFlash replicas of the tables aren't changed by the dry run.
+	require.NotNil(t, created.Info.TiFlashReplica)
+
+	// the stores don't have enough space.
+	plan, err = client.PlanRestore(context.Background(), m.Domain, fakeStoreInfoGetter{1: 100}, tables, nil, 96<<20, 960000)
+	require.NoError(t, err)
+	require.False(t, plan.Passed)
+	failed := plan.Checks[len(plan.Checks)-1]
+	require.Equal(t, "free-disk-space", failed.Name)
+	require.False(t, failed.Passed)
+
+	// the cluster index option mismatches.
+	existing.Info.IsCommonHandle = true
+	defer func() { existing.Info.IsCommonHandle = false }()
+	plan, err = client.PlanRestore(context.Background(), m.Domain, fakeStoreInfoGetter{1: 10000}, tables, nil, 96<<20, 960000)
+	require.NoError(t, err)
+	require.False(t, plan.Passed)
+}
+
 func TestPreCheckTableTiFlashReplicas(t *testing.T) {
 	m := mc
 	mockStores := []*metapb.Store{
@@ -401,6 +601,23 @@ func TestPreCheckTableTiFlashReplicas(t *testing.T) {
 	for i := 0; i < len(tables); i++ {
 		require.Nil(t, tables[i].Info.TiFlashReplica)
 	}
+
+	// the replicas can't be satisfied are deferred rather than dropped.
+	for i := 0; i < len(tables); i++ {
+		if i > 0 {
+			tables[i].Info.TiFlashReplica = &model.TiFlashReplicaInfo{Count: uint64(i)}
+		}
+	}
+	deferred := tiflashrec.New()
+	client.SetDeferredTiFlashReplicas(deferred)
+	require.Nil(t, client.PreCheckTableTiFlashReplica(ctx, tables, nil))
+	require.Nil(t, tables[3].Info.TiFlashReplica)
+	require.NotNil(t, tables[2].Info.TiFlashReplica)
+	replicas := map[int64]model.TiFlashReplicaInfo{}
+	deferred.Iterate(func(tableID int64, replica model.TiFlashReplicaInfo) {
+		replicas[tableID] = replica
+	})
+	require.Equal(t, map[int64]model.TiFlashReplicaInfo{3: {Count: 3}}, replicas)
 }
 
 // Mock ImporterClient interface
@@ -528,8 +745,36 @@ func TestSetSpeedLimit(t *testing.T) {
 	}
 }
 
-func TestDeleteRangeQuery(t *testing.T) {
+func TestSetSpeedLimitOnMockStores(t *testing.T) {
+	// cannot use shared `mc`, the stores of it are not simulated.
+	cluster, err := mock.NewCluster(mock.WithStores(4))
+	require.NoError(t, err)
+	defer cluster.Stop()
 	ctx := context.Background()
+	tiflashStore := cluster.StoreIDs[3]
+	cluster.Stores.SetLabels(tiflashStore, map[string]string{"engine": "tiflash"})
+	for _, storeID := range cluster.StoreIDs {
+		cluster.Stores.SetLatency(storeID, WORKING_TIME*time.Millisecond)
+	}
+
+	// 1. The speed limit is set to all the TiKV stores concurrently, TiFlash stores are skipped.
+	client := restore.NewRestoreClient(cluster.PDClient, nil, defaultKeepaliveCfg, false)
+	start := time.Now()
+	require.NoError(t, restore.MockCallSetSpeedLimit(ctx, cluster.Stores, client, 3))
+	require.Less(t, time.Since(start), 3*WORKING_TIME*time.Millisecond)
+	for _, storeID := range cluster.StoreIDs[:3] {
+		require.Equal(t, uint64(42), cluster.Stores.SpeedLimit(storeID))
+	}
+	require.Equal(t, 0, cluster.Stores.CallCount(tiflashStore, "SetDownloadSpeedLimit"))
+
+	// 2. Setting speed limit fails if any store fails.
+	cluster.Stores.SetFailure(cluster.StoreIDs[1], fmt.Errorf("store is down"))
+	client = restore.NewRestoreClient(cluster.PDClient, nil, defaultKeepaliveCfg, false)
+	require.Error(t, restore.MockCallSetSpeedLimit(ctx, cluster.Stores, client, 3))
+	require.Equal(t, 2, cluster.Stores.CallCount(cluster.StoreIDs[1], "SetDownloadSpeedLimit"))
+}
+
+func TestDeleteRangeQuery(t *testing.T) {
 	m := mc
 	mockStores := []*metapb.Store{
 		{
@@ -559,20 +804,35 @@ func TestDeleteRangeQuery(t *testing.T) {
 	err := client.Init(g, m.Storage)
 	require.NoError(t, err)
 
-	client.RunGCRowsLoader(ctx)
-
 	client.InsertDeleteRangeForTable(2, []int64{3})
 	client.InsertDeleteRangeForTable(4, []int64{5, 6})
 
 	elementID := int64(1)
 	client.InsertDeleteRangeForIndex(7, &elementID, 8, []int64{1})
 	client.InsertDeleteRangeForIndex(9, &elementID, 10, []int64{1, 2})
-
-	querys := client.GetGCRows()
-	require.Equal(t, querys[0], "INSERT IGNORE INTO mysql.gc_delete_range VALUES (2, 1, '748000000000000003', '748000000000000004', %[1]d)")
-	require.Equal(t, querys[1], "INSERT IGNORE INTO mysql.gc_delete_range VALUES (4, 1, '748000000000000005', '748000000000000006', %[1]d),(4, 2, '748000000000000006', '748000000000000007', %[1]d)")
-	require.Equal(t, querys[2], "INSERT IGNORE INTO mysql.gc_delete_range VALUES (7, 1, '7480000000000000085f698000000000000001', '7480000000000000085f698000000000000002', %[1]d)")
-	require.Equal(t, querys[3], "INSERT IGNORE INTO mysql.gc_delete_range VALUES (9, 2, '74800000000000000a5f698000000000000001', '74800000000000000a5f698000000000000002', %[1]d),(9, 3, '74800000000000000a5f698000000000000002', '74800000000000000a5f698000000000000003', %[1]d)")
+	// the ranges of the same job and element are deduplicated.
+	client.InsertDeleteRangeForTable(4, []int64{5})
+
+	ranges := client.GetPendingDeleteRanges()
+	require.Len(t, ranges, 6)
+	expected := []struct {
+		jobID, elementID int64
+		startKey         string
+		endKey           string
+	}{
+		{2, 1, "748000000000000003", "748000000000000004"},
+		{4, 1, "748000000000000005", "748000000000000006"},
+		{4, 2, "748000000000000006", "748000000000000007"},
+		{7, 1, "7480000000000000085f698000000000000001", "7480000000000000085f698000000000000002"},
+		{9, 2, "74800000000000000a5f698000000000000001", "74800000000000000a5f698000000000000002"},
+		{9, 3, "74800000000000000a5f698000000000000002", "74800000000000000a5f698000000000000003"},
+	}
+	for i, e := range expected {
+		require.Equal(t, e.jobID, ranges[i].JobID)
+		require.Equal(t, e.elementID, ranges[i].ElementID)
+		require.Equal(t, e.startKey, hex.EncodeToString(ranges[i].StartKey))
+		require.Equal(t, e.endKey, hex.EncodeToString(ranges[i].EndKey))
+	}
 }
 
 func TestRestoreMetaKVFilesWithBatchMethod1(t *testing.T) {
@@ -590,11 +850,13 @@ func TestRestoreMetaKVFilesWithBatchMethod1(t *testing.T) {
 			ctx context.Context,
 			files []*backuppb.DataFileInfo,
 			schemasReplace *stream.SchemasReplace,
+			entries []*restore.KvEntryWithTS,
+			filterTS uint64,
 			updateStats func(kvCount uint64, size uint64),
 			progressInc func(),
-		) error {
+		) ([]*restore.KvEntryWithTS, error) {
 			batchCount++
-			return nil
+			return nil, nil
 		},
 	)
 	require.Nil(t, err)
@@ -623,12 +885,14 @@ func TestRestoreMetaKVFilesWithBatchMethod2(t *testing.T) {
 			ctx context.Context,
 			fs []*backuppb.DataFileInfo,
 			schemasReplace *stream.SchemasReplace,
+			entries []*restore.KvEntryWithTS,
+			filterTS uint64,
 			updateStats func(kvCount uint64, size uint64),
 			progressInc func(),
-		) error {
+		) ([]*restore.KvEntryWithTS, error) {
 			result[batchCount] = fs
 			batchCount++
-			return nil
+			return nil, nil
 		},
 	)
 	require.Nil(t, err)
@@ -679,12 +943,14 @@ func TestRestoreMetaKVFilesWithBatchMethod3(t *testing.T) {
 			ctx context.Context,
 			fs []*backuppb.DataFileInfo,
 			schemasReplace *stream.SchemasReplace,
+			entries []*restore.KvEntryWithTS,
+			filterTS uint64,
 			updateStats func(kvCount uint64, size uint64),
 			progressInc func(),
-		) error {
+		) ([]*restore.KvEntryWithTS, error) {
 			result[batchCount] = fs
 			batchCount++
-			return nil
+			return nil, nil
 		},
 	)
 	require.Nil(t, err)
@@ -730,12 +996,14 @@ func TestRestoreMetaKVFilesWithBatchMethod4(t *testing.T) {
 			ctx context.Context,
 			fs []*backuppb.DataFileInfo,
 			schemasReplace *stream.SchemasReplace,
+			entries []*restore.KvEntryWithTS,
+			filterTS uint64,
 			updateStats func(kvCount uint64, size uint64),
 			progressInc func(),
-		) error {
+		) ([]*restore.KvEntryWithTS, error) {
 			result[batchCount] = fs
 			batchCount++
-			return nil
+			return nil, nil
 		},
 	)
 	require.Nil(t, err)
@@ -744,6 +1012,71 @@ func TestRestoreMetaKVFilesWithBatchMethod4(t *testing.T) {
 	require.Equal(t, result[1], files[2:])
 }
 
+func TestRestoreMetaKVFilesWithBatchMethodBySize(t *testing.T) {
+	files := []*backuppb.DataFileInfo{
+		{Path: "f1", MinTs: 100, MaxTs: 120, Length: 40},
+		{Path: "f2", MinTs: 100, MaxTs: 120, Length: 40},
+		{Path: "f3", MinTs: 110, MaxTs: 130, Length: 40},
+		{Path: "f4", MinTs: 140, MaxTs: 150, Length: 10},
+	}
+	result := make([][]*backuppb.DataFileInfo, 0)
+	filterTSs := make([]uint64, 0)
+	carried := make([]int, 0)
+
+	client := restore.MockClient(nil)
+	client.SetMetaKVBatchSize(100)
+	err := client.RestoreMetaKVFilesWithBatchMethod(
+		context.Background(),
+		files,
+		nil,
+		nil,
+		nil,
+		func(
+			ctx context.Context,
+			fs []*backuppb.DataFileInfo,
+			schemasReplace *stream.SchemasReplace,
+			entries []*restore.KvEntryWithTS,
+			filterTS uint64,
+			updateStats func(kvCount uint64, size uint64),
+			progressInc func(),
+		) ([]*restore.KvEntryWithTS, error) {
+			result = append(result, fs)
+			filterTSs = append(filterTSs, filterTS)
+			carried = append(carried, len(entries))
+			// the entries of the overlapped ts range are left for the next batch.
+			if len(result) == 1 {
+				return []*restore.KvEntryWithTS{{}}, nil
+			}
+			return nil, nil
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, [][]*backuppb.DataFileInfo{files[0:2], files[2:3], files[3:]}, result)
+	require.Equal(t, []uint64{110, 140, math.MaxUint64}, filterTSs)
+	require.Equal(t, []int{0, 1, 0}, carried)
+
+	// the entries left by the last batch are unexpected.
+	err = client.RestoreMetaKVFilesWithBatchMethod(
+		context.Background(),
+		files,
+		nil,
+		nil,
+		nil,
+		func(
+			ctx context.Context,
+			fs []*backuppb.DataFileInfo,
+			schemasReplace *stream.SchemasReplace,
+			entries []*restore.KvEntryWithTS,
+			filterTS uint64,
+			updateStats func(kvCount uint64, size uint64),
+			progressInc func(),
+		) ([]*restore.KvEntryWithTS, error) {
+			return []*restore.KvEntryWithTS{{}}, nil
+		},
+	)
+	require.Error(t, err)
+}
+
 func TestSortMetaKVFiles(t *testing.T) {
 	files := []*backuppb.DataFileInfo{
 		{
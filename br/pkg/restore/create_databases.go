@@ -0,0 +1,120 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/util/collate"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// CreateDatabases creates the databases in batches, the batches are created
+// concurrently by the sessions of the DB pool. The charsets and the
+// collations of all the databases are checked before creating any of them, so
+// the restore fails before changing the cluster if any of them isn't
+// supported.
+func (rc *Client) CreateDatabases(ctx context.Context, dbs []*model.DBInfo) error {
+	if rc.IsSkipCreateSQL() {
+		log.Info("skip create databases", zap.Int("databases", len(dbs)))
+		return nil
+	}
+	if err := checkDatabaseCharsets(dbs); err != nil {
+		return errors.Trace(err)
+	}
+	for _, db := range dbs {
+		if err := rc.prepareDatabase(ctx, db); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	batches := splitDatabaseBatches(dbs, rc.batchDdlSize)
+	log.Info("create databases", zap.Int("databases", len(dbs)), zap.Int("batches", len(batches)))
+	if len(rc.dbPool) == 0 {
+		for _, batch := range batches {
+			if err := rc.db.CreateDatabases(ctx, batch); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	} else {
+		eg, ectx := errgroup.WithContext(ctx)
+		workers := utils.NewWorkerPool(uint(len(rc.dbPool)), "Create Databases Worker")
+		for _, b := range batches {
+			batch := b
+			workers.ApplyWithIDInErrorGroup(eg, func(id uint64) error {
+				db := rc.dbPool[id%uint64(len(rc.dbPool))]
+				return db.CreateDatabases(ectx, batch)
+			})
+		}
+		if err := eg.Wait(); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	for _, db := range dbs {
+		rc.recordCreatedDatabase(ctx, db)
+	}
+	return nil
+}
+
+// splitDatabaseBatches splits the databases into the batches of the size.
+func splitDatabaseBatches(dbs []*model.DBInfo, size uint) [][]*model.DBInfo {
+	if size < minBatchDdlSize {
+		size = minBatchDdlSize
+	}
+	batches := make([][]*model.DBInfo, 0, (len(dbs)+int(size)-1)/int(size))
+	for len(dbs) > 0 {
+		end := int(size)
+		if end > len(dbs) {
+			end = len(dbs)
+		}
+		batches = append(batches, dbs[:end])
+		dbs = dbs[end:]
+	}
+	return batches
+}
+
+// checkDatabaseCharsets checks the charsets and the collations of the
+// databases are supported by the cluster, the problems of all the databases
+// are reported together.
+func checkDatabaseCharsets(dbs []*model.DBInfo) error {
+	problems := make([]string, 0)
+	for _, db := range dbs {
+		if err := checkDatabaseCharset(db); err != nil {
+			problems = append(problems, fmt.Sprintf("database %s: %s", db.Name, err.Error()))
+		}
+	}
+	if len(problems) > 0 {
+		return errors.Annotate(berrors.ErrRestoreIncompatibleCharset, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func checkDatabaseCharset(db *model.DBInfo) error {
+	if db.Charset != "" {
+		if _, err := charset.GetCharsetInfo(db.Charset); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if db.Collate == "" {
+		return nil
+	}
+	// the collation may be unsupported if the new collation is disabled by the cluster.
+	coll, err := collate.GetCollationByName(db.Collate)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if db.Charset != "" && !strings.EqualFold(coll.CharsetName, db.Charset) {
+		return errors.Errorf("collation %s isn't of charset %s", db.Collate, db.Charset)
+	}
+	return nil
+}
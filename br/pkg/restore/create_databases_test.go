@@ -0,0 +1,49 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"testing"
+
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitDatabaseBatches(t *testing.T) {
+	dbs := make([]*model.DBInfo, 0, 5)
+	for i := 0; i < 5; i++ {
+		dbs = append(dbs, &model.DBInfo{ID: int64(i)})
+	}
+	batches := splitDatabaseBatches(dbs, 2)
+	require.Len(t, batches, 3)
+	require.Len(t, batches[0], 2)
+	require.Len(t, batches[2], 1)
+	require.Equal(t, int64(4), batches[2][0].ID)
+
+	require.Len(t, splitDatabaseBatches(dbs, 0), 5)
+	require.Len(t, splitDatabaseBatches(dbs, 128), 1)
+	require.Empty(t, splitDatabaseBatches(nil, 128))
+}
+
+func TestCheckDatabaseCharsets(t *testing.T) {
+	db := func(name, cs, co string) *model.DBInfo {
+		return &model.DBInfo{Name: model.NewCIStr(name), Charset: cs, Collate: co}
+	}
+	require.NoError(t, checkDatabaseCharsets([]*model.DBInfo{
+		db("a", "", ""),
+		db("b", "utf8mb4", "utf8mb4_bin"),
+		db("c", "UTF8MB4", ""),
+		db("d", "", "latin1_bin"),
+	}))
+
+	err := checkDatabaseCharsets([]*model.DBInfo{
+		db("a", "utf8mb4", "utf8mb4_bin"),
+		db("b", "no_such_charset", ""),
+		db("c", "utf8mb4", "latin1_bin"),
+	})
+	require.True(t, berrors.ErrRestoreIncompatibleCharset.Equal(err))
+	require.NotContains(t, err.Error(), "database a")
+	require.Contains(t, err.Error(), "database b")
+	require.Contains(t, err.Error(), "database c: collation latin1_bin isn't of charset utf8mb4")
+}
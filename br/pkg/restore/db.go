@@ -152,6 +152,27 @@ func (db *DB) UpdateStatsMeta(ctx context.Context, tableID int64, restoreTS uint
 	return nil
 }
 
+// ExchangePartition exchanges the table with the partition of the partitioned
+// table, and drops the table holding the data of the partition then.
+func (db *DB) ExchangePartition(ctx context.Context, schema, table, partition, staging string) error {
+	err := db.se.ExecuteInternal(ctx, "SET @@session.tidb_enable_exchange_partition = 1")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = db.se.ExecuteInternal(ctx, "ALTER TABLE %n.%n EXCHANGE PARTITION %n WITH TABLE %n.%n",
+		schema, table, partition, schema, staging)
+	if err != nil {
+		log.Error("exchange partition failed",
+			zap.String("db", schema), zap.String("table", table),
+			zap.String("partition", partition), zap.String("staging", staging), zap.Error(err))
+		return errors.Trace(err)
+	}
+	log.Info("exchange partition succeed",
+		zap.String("db", schema), zap.String("table", table),
+		zap.String("partition", partition), zap.String("staging", staging))
+	return errors.Trace(db.se.ExecuteInternal(ctx, "DROP TABLE %n.%n", schema, staging))
+}
+
 // CreatePlacementPolicy check whether cluster support policy and create the policy.
 func (db *DB) CreatePlacementPolicy(ctx context.Context, policy *model.PolicyInfo) error {
 	err := db.se.CreatePlacementPolicy(ctx, policy)
@@ -171,6 +192,25 @@ func (db *DB) CreateDatabase(ctx context.Context, schema *model.DBInfo) error {
 	return errors.Trace(err)
 }
 
+// CreateDatabases creates a batch of databases by the session, they are
+// created one by one if the session can't create them in batch.
+func (db *DB) CreateDatabases(ctx context.Context, schemas []*model.DBInfo) error {
+	batchSession, ok := db.se.(glue.BatchCreateDatabaseSession)
+	if !ok {
+		for _, schema := range schemas {
+			if err := db.CreateDatabase(ctx, schema); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		return nil
+	}
+	err := batchSession.CreateDatabases(ctx, schemas)
+	if err != nil {
+		log.Error("create databases failed", zap.Int("databases", len(schemas)), zap.Error(err))
+	}
+	return errors.Trace(err)
+}
+
 func (db *DB) restoreSequence(ctx context.Context, table *metautil.Table) error {
 	var restoreMetaSQL string
 	var err error
@@ -0,0 +1,147 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/kv"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxBatchDdlSizeFactor bounds growing the batch size to the factor of the
+	// configured batch size.
+	maxBatchDdlSizeFactor = 4
+	// the batch size shrinks if creating a batch is slower than
+	// slowDDLBatchDuration, and grows if it's faster than fastDDLBatchDuration.
+	slowDDLBatchDuration = 30 * time.Second
+	fastDDLBatchDuration = 3 * time.Second
+)
+
+// DDLBatchStat is the timing of creating a batch of tables.
+type DDLBatchStat struct {
+	Tables   int           `json:"tables"`
+	Duration time.Duration `json:"duration"`
+	Failed   bool          `json:"failed"`
+}
+
+// DDLStats is the performance of creating the tables.
+type DDLStats struct {
+	Batches []DDLBatchStat `json:"batches"`
+	// FallBack is whether the cluster doesn't support creating the tables in
+	// batch, and they are created one by one.
+	FallBack         bool `json:"fall-back"`
+	InitialBatchSize uint `json:"initial-batch-size"`
+	FinalBatchSize   uint `json:"final-batch-size"`
+}
+
+// TablesCreated returns the number of the tables created.
+func (s *DDLStats) TablesCreated() int {
+	n := 0
+	for _, b := range s.Batches {
+		if !b.Failed {
+			n += b.Tables
+		}
+	}
+	return n
+}
+
+// FailedBatches returns the number of the batches failed.
+func (s *DDLStats) FailedBatches() int {
+	n := 0
+	for _, b := range s.Batches {
+		if b.Failed {
+			n++
+		}
+	}
+	return n
+}
+
+// TotalDuration returns the sum of the durations of the batches. The batches
+// are created concurrently, so it may be longer than the wall time.
+func (s *DDLStats) TotalDuration() time.Duration {
+	var d time.Duration
+	for _, b := range s.Batches {
+		d += b.Duration
+	}
+	return d
+}
+
+// ddlBatchSizer adjusts the size of the batches of creating tables by the
+// latency and the failures of the previous batches.
+type ddlBatchSizer struct {
+	mu      sync.Mutex
+	maxSize uint
+	size    uint
+	stats   DDLStats
+}
+
+func newDDLBatchSizer(size uint) *ddlBatchSizer {
+	if size < minBatchDdlSize {
+		size = minBatchDdlSize
+	}
+	return &ddlBatchSizer{
+		maxSize: size * maxBatchDdlSizeFactor,
+		size:    size,
+		stats:   DDLStats{InitialBatchSize: size, FinalBatchSize: size},
+	}
+}
+
+// current returns the size of the next batch.
+func (s *ddlBatchSizer) current() uint {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.size
+}
+
+// record records the batch is created, and adjusts the batch size by it.
+func (s *ddlBatchSizer) record(tables int, d time.Duration, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stats.Batches = append(s.stats.Batches, DDLBatchStat{Tables: tables, Duration: d, Failed: err != nil})
+	oldSize := s.size
+	switch {
+	case err != nil || d > slowDDLBatchDuration:
+		s.size /= 2
+		if s.size < minBatchDdlSize {
+			s.size = minBatchDdlSize
+		}
+	case d < fastDDLBatchDuration && tables >= int(s.size):
+		// only the full batches tell whether a larger batch is fast enough.
+		s.size *= 2
+		if s.size > s.maxSize {
+			s.size = s.maxSize
+		}
+	}
+	if s.size != oldSize {
+		log.Info("adjust the batch size of creating tables",
+			zap.Uint("from", oldSize), zap.Uint("to", s.size),
+			zap.Int("tables", tables), zap.Duration("take", d), zap.Error(err))
+	}
+	s.stats.FinalBatchSize = s.size
+}
+
+// fallBack records the tables are created one by one.
+func (s *ddlBatchSizer) fallBack() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stats.FallBack = true
+}
+
+// snapshot returns a copy of the stats.
+func (s *ddlBatchSizer) snapshot() DDLStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats := s.stats
+	stats.Batches = append([]DDLBatchStat(nil), s.stats.Batches...)
+	return stats
+}
+
+// isDDLBatchTooLarge checks whether creating the batch of tables failed for
+// the DDL job is too large, then it can be created in the smaller batches.
+func isDDLBatchTooLarge(err error) bool {
+	return kv.ErrEntryTooLarge.Equal(err) || kv.ErrTxnTooLarge.Equal(err)
+}
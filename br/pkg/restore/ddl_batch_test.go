@@ -0,0 +1,55 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDDLBatchSizer(t *testing.T) {
+	s := newDDLBatchSizer(8)
+	require.Equal(t, uint(8), s.current())
+
+	// the full and fast batches grow the size up to the max.
+	s.record(8, time.Second, nil)
+	require.Equal(t, uint(16), s.current())
+	s.record(16, time.Second, nil)
+	s.record(32, time.Second, nil)
+	require.Equal(t, uint(32), s.current())
+	// the partial batches don't grow the size.
+	s.record(10, time.Second, nil)
+	require.Equal(t, uint(32), s.current())
+
+	// the slow or failed batches shrink the size down to the min.
+	s.record(32, time.Minute, nil)
+	require.Equal(t, uint(16), s.current())
+	for i := 0; i < 5; i++ {
+		s.record(16, time.Second, errors.New("injected"))
+	}
+	require.Equal(t, uint(minBatchDdlSize), s.current())
+
+	s.fallBack()
+	stats := s.snapshot()
+	require.Len(t, stats.Batches, 10)
+	require.True(t, stats.FallBack)
+	require.Equal(t, uint(8), stats.InitialBatchSize)
+	require.Equal(t, uint(minBatchDdlSize), stats.FinalBatchSize)
+	require.Equal(t, 5, stats.FailedBatches())
+	require.Equal(t, 8+16+32+10+32, stats.TablesCreated())
+	require.Equal(t, 9*time.Second+time.Minute, stats.TotalDuration())
+
+	// the snapshot isn't changed by the later batches.
+	s.record(1, time.Second, nil)
+	require.Len(t, stats.Batches, 10)
+}
+
+func TestIsDDLBatchTooLarge(t *testing.T) {
+	require.True(t, isDDLBatchTooLarge(errors.Trace(kv.ErrEntryTooLarge.GenWithStackByArgs(1, 2))))
+	require.True(t, isDDLBatchTooLarge(kv.ErrTxnTooLarge))
+	require.False(t, isDDLBatchTooLarge(errors.New("injected")))
+}
@@ -0,0 +1,289 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/util/mathutil"
+	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// maxDDLHistoryJobsToCheck bounds the history DDL jobs of the cluster read
+	// for reconciling with the backup, the latest jobs are read first.
+	maxDDLHistoryJobsToCheck = 10000
+	ddlHistoryBatchSize      = 1024
+)
+
+// DDLDivergence is a table whose DDL history in the cluster diverges from the
+// DDL jobs in the incremental backup.
+type DDLDivergence struct {
+	DB     string
+	Table  string
+	Reason string
+	// Jobs are the DDL jobs of the table executed in the cluster since the
+	// table is created, which may cause the divergence.
+	Jobs []*model.Job
+}
+
+func (d DDLDivergence) String() string {
+	return fmt.Sprintf("%s: %s", utils.EncloseDBAndTable(d.DB, d.Table), d.Reason)
+}
+
+// CheckDDLHistory reconciles the DDL history of the cluster with the DDL jobs
+// in the incremental backup before executing them. The tables in the cluster
+// are expected to have the schemas the incremental backup starts from, or
+// executing the jobs results in the wrong schemas silently. It returns the
+// tables diverging, which is empty for the full backups.
+func (rc *Client) CheckDDLHistory(
+	dom *domain.Domain,
+	tables []*metautil.Table,
+	ddlJobs []*model.Job,
+) ([]DDLDivergence, error) {
+	if !rc.IsIncremental() {
+		return nil, nil
+	}
+	history, err := loadDDLHistory(dom.Store(), maxDDLHistoryJobsToCheck)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return reconcileDDLHistory(dom.InfoSchema(), history, tables, ddlJobs), nil
+}
+
+// DDLDivergenceError returns the error of the tables diverging, it returns nil
+// if there is no divergence.
+func DDLDivergenceError(divergences []DDLDivergence) error {
+	if len(divergences) == 0 {
+		return nil
+	}
+	reasons := make([]string, 0, len(divergences))
+	for _, d := range divergences {
+		reasons = append(reasons, d.String())
+	}
+	return errors.Annotatef(berrors.ErrRestoreDDLDivergence,
+		"%d tables diverge: %s", len(divergences), strings.Join(reasons, "; "))
+}
+
+// loadDDLHistory loads the latest history DDL jobs of the cluster, the latest
+// job is the first.
+func loadDDLHistory(store kv.Storage, limit int) ([]*model.Job, error) {
+	version, err := store.CurrentVersion(kv.GlobalTxnScope)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	m := meta.NewSnapshotMeta(store.GetSnapshot(version))
+	iter, err := m.GetLastHistoryDDLJobsIterator()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	history := make([]*model.Job, 0, ddlHistoryBatchSize)
+	for len(history) < limit {
+		jobs, err := iter.GetLastJobs(mathutil.Min(ddlHistoryBatchSize, limit-len(history)), nil)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if len(jobs) == 0 {
+			return history, nil
+		}
+		history = append(history, jobs...)
+	}
+	log.Warn("the ddl history of the cluster is too long, only the latest jobs are checked",
+		zap.Int("jobs", limit))
+	return history, nil
+}
+
+func isCreateTableJob(job *model.Job) bool {
+	switch job.Type {
+	case model.ActionCreateTable, model.ActionCreateView, model.ActionCreateTables:
+		return true
+	default:
+		return false
+	}
+}
+
+// jobTableNames returns the names of the tables the job changes, in lower case.
+func jobTableNames(job *model.Job) []UniqueTableName {
+	if job.BinlogInfo == nil {
+		return nil
+	}
+	db := strings.ToLower(job.SchemaName)
+	if job.BinlogInfo.TableInfo != nil {
+		return []UniqueTableName{{DB: db, Table: job.BinlogInfo.TableInfo.Name.L}}
+	}
+	names := make([]UniqueTableName, 0, len(job.BinlogInfo.MultipleTableInfos))
+	for _, info := range job.BinlogInfo.MultipleTableInfos {
+		names = append(names, UniqueTableName{DB: db, Table: info.Name.L})
+	}
+	return names
+}
+
+// reconcileDDLHistory finds the tables diverging from the backup:
+//  1. the table created by the backup already exists in the cluster, creating
+//     it is ignored and the table keeps the schema of the cluster.
+//  2. the DDL of the backup has been executed to the table in the cluster,
+//     i.e. the histories overlap, executing it again changes the table twice.
+//  3. the table isn't changed by the backup, but the schema in the cluster
+//     differs from the backup.
+func reconcileDDLHistory(
+	is infoschema.InfoSchema,
+	history []*model.Job,
+	tables []*metautil.Table,
+	ddlJobs []*model.Job,
+) []DDLDivergence {
+	sortedJobs := append([]*model.Job(nil), ddlJobs...)
+	slices.SortFunc(sortedJobs, func(i, j *model.Job) bool {
+		return i.BinlogInfo.SchemaVersion < j.BinlogInfo.SchemaVersion
+	})
+	// the jobs of the backup by the tables, in the order of executing.
+	backupJobs := make(map[UniqueTableName][]*model.Job)
+	for _, job := range sortedJobs {
+		for _, name := range jobTableNames(job) {
+			backupJobs[name] = append(backupJobs[name], job)
+		}
+	}
+	// the jobs of the cluster since the tables are created, the latest first.
+	clusterJobs := make(map[UniqueTableName][]*model.Job)
+	created := make(map[UniqueTableName]struct{})
+	for _, job := range history {
+		for _, name := range jobTableNames(job) {
+			if _, ok := created[name]; ok {
+				continue
+			}
+			if isCreateTableJob(job) {
+				created[name] = struct{}{}
+				continue
+			}
+			clusterJobs[name] = append(clusterJobs[name], job)
+		}
+	}
+
+	names := make([]UniqueTableName, 0, len(backupJobs))
+	for name := range backupJobs {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if names[i].DB != names[j].DB {
+			return names[i].DB < names[j].DB
+		}
+		return names[i].Table < names[j].Table
+	})
+	divergences := make([]DDLDivergence, 0)
+	for _, name := range names {
+		jobs := backupJobs[name]
+		if isCreateTableJob(jobs[0]) {
+			if is.TableExists(model.NewCIStr(name.DB), model.NewCIStr(name.Table)) {
+				divergences = append(divergences, DDLDivergence{
+					DB: name.DB, Table: name.Table,
+					Reason: "the table created by the backup already exists in the cluster",
+					Jobs:   clusterJobs[name],
+				})
+			}
+			continue
+		}
+		for _, job := range jobs {
+			if executed := findExecutedJob(clusterJobs[name], job); executed != nil {
+				divergences = append(divergences, DDLDivergence{
+					DB: name.DB, Table: name.Table,
+					Reason: fmt.Sprintf("the ddl %q of the backup has been executed in the cluster", job.Query),
+					Jobs:   []*model.Job{executed},
+				})
+				break
+			}
+		}
+	}
+
+	for _, table := range tables {
+		name := UniqueTableName{DB: table.DB.Name.L, Table: table.Info.Name.L}
+		if _, ok := backupJobs[name]; ok {
+			continue
+		}
+		existing, err := is.TableByName(table.DB.Name, table.Info.Name)
+		if err != nil {
+			// the table is created by the restore.
+			continue
+		}
+		if reason := diffTableSchema(table.Info, existing.Meta()); reason != "" {
+			divergences = append(divergences, DDLDivergence{
+				DB: name.DB, Table: name.Table,
+				Reason: reason + ", but the backup doesn't change the table",
+				Jobs:   clusterJobs[name],
+			})
+		}
+	}
+	return divergences
+}
+
+// findExecutedJob finds the job executing the same DDL as the job of the backup.
+func findExecutedJob(clusterJobs []*model.Job, job *model.Job) *model.Job {
+	query := strings.TrimSpace(job.Query)
+	if query == "" {
+		return nil
+	}
+	for _, j := range clusterJobs {
+		if j.Type == job.Type && strings.TrimSpace(j.Query) == query {
+			return j
+		}
+	}
+	return nil
+}
+
+// diffTableSchema returns how the actual schema of the table differs from the
+// expected one, it returns an empty string if they're the same.
+func diffTableSchema(expected, actual *model.TableInfo) string {
+	for _, col := range expected.Columns {
+		c := model.FindColumnInfo(actual.Columns, col.Name.L)
+		if c == nil {
+			return fmt.Sprintf("the column %s is missing", utils.EncloseName(col.Name.O))
+		}
+		if c.FieldType.String() != col.FieldType.String() {
+			return fmt.Sprintf("the column %s is %s rather than %s",
+				utils.EncloseName(col.Name.O), c.FieldType.String(), col.FieldType.String())
+		}
+	}
+	for _, c := range actual.Columns {
+		if model.FindColumnInfo(expected.Columns, c.Name.L) == nil {
+			return fmt.Sprintf("the column %s is unexpected", utils.EncloseName(c.Name.O))
+		}
+	}
+	for _, idx := range expected.Indices {
+		i := actual.FindIndexByName(idx.Name.L)
+		if i == nil {
+			return fmt.Sprintf("the index %s is missing", utils.EncloseName(idx.Name.O))
+		}
+		if i.Unique != idx.Unique || i.Primary != idx.Primary || !sameIndexColumns(i, idx) {
+			return fmt.Sprintf("the index %s is different", utils.EncloseName(idx.Name.O))
+		}
+	}
+	for _, i := range actual.Indices {
+		if expected.FindIndexByName(i.Name.L) == nil {
+			return fmt.Sprintf("the index %s is unexpected", utils.EncloseName(i.Name.O))
+		}
+	}
+	return ""
+}
+
+func sameIndexColumns(a, b *model.IndexInfo) bool {
+	if len(a.Columns) != len(b.Columns) {
+		return false
+	}
+	for i := range a.Columns {
+		if a.Columns[i].Name.L != b.Columns[i].Name.L || a.Columns[i].Length != b.Columns[i].Length {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,130 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"testing"
+
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcileDDLHistory(t *testing.T) {
+	tableOf := func(id int64, name string, cols ...string) *model.TableInfo {
+		info := &model.TableInfo{ID: id, Name: model.NewCIStr(name), State: model.StatePublic}
+		for i, col := range cols {
+			info.Columns = append(info.Columns, &model.ColumnInfo{
+				ID: int64(i + 1), Offset: i, Name: model.NewCIStr(col),
+				FieldType: *types.NewFieldType(mysql.TypeLong), State: model.StatePublic,
+			})
+		}
+		return info
+	}
+	jobOf := func(tp model.ActionType, version int64, info *model.TableInfo, query string) *model.Job {
+		return &model.Job{
+			Type: tp, SchemaName: "test", TableID: info.ID, Query: query,
+			BinlogInfo: &model.HistoryInfo{SchemaVersion: version, TableInfo: info},
+		}
+	}
+	db := &model.DBInfo{Name: model.NewCIStr("test")}
+
+	// the tables in the cluster.
+	t1 := tableOf(1, "t1", "a")
+	t2 := tableOf(2, "t2", "a", "b")
+	t3 := tableOf(3, "t3", "a")
+	t4 := tableOf(4, "t4", "a")
+	is := infoschema.MockInfoSchema([]*model.TableInfo{t1, t2, t3, t4})
+	// the history of the cluster, the latest first.
+	history := []*model.Job{
+		jobOf(model.ActionAddColumn, 13, t2, "alter table t2 add column b int"),
+		jobOf(model.ActionAddColumn, 12, tableOf(3, "t3", "a"), "alter table t3 add column a int"),
+		{Type: model.ActionCreateTables, SchemaName: "test", BinlogInfo: &model.HistoryInfo{
+			SchemaVersion: 11, MultipleTableInfos: []*model.TableInfo{t1, t2, t3, t4},
+		}},
+		// the table t3 before being created again.
+		jobOf(model.ActionAddColumn, 10, tableOf(30, "t3", "a", "c"), "alter table t3 add column c int"),
+	}
+
+	// the tables and the jobs in the backup.
+	tables := []*metautil.Table{
+		{DB: db, Info: tableOf(1, "t1", "a", "c")},
+		{DB: db, Info: tableOf(2, "t2", "a")},
+		{DB: db, Info: tableOf(3, "t3", "a", "c")},
+		{DB: db, Info: tableOf(4, "t4", "a")},
+		{DB: db, Info: tableOf(5, "t5", "a")},
+	}
+	ddlJobs := []*model.Job{
+		jobOf(model.ActionAddColumn, 23, tableOf(1, "t1", "a", "c"), "alter table t1 add column c int"),
+		// the job executed before the table is created again in the cluster.
+		jobOf(model.ActionAddColumn, 22, tableOf(3, "t3", "a", "c"), "alter table t3 add column c int"),
+		jobOf(model.ActionCreateTable, 21, tableOf(4, "t4", "a"), "create table t4 (a int)"),
+	}
+
+	divergences := reconcileDDLHistory(is, history, tables, ddlJobs)
+	require.Len(t, divergences, 2)
+	require.Equal(t, "t4", divergences[0].Table)
+	require.Equal(t, "the table created by the backup already exists in the cluster", divergences[0].Reason)
+	require.Equal(t, "t2", divergences[1].Table)
+	require.Equal(t, "the column `b` is unexpected, but the backup doesn't change the table", divergences[1].Reason)
+	require.Len(t, divergences[1].Jobs, 1)
+	require.Equal(t, "alter table t2 add column b int", divergences[1].Jobs[0].Query)
+	require.True(t, berrors.ErrRestoreDDLDivergence.Equal(DDLDivergenceError(divergences)))
+	require.NoError(t, DDLDivergenceError(nil))
+
+	// the job of the backup has been executed in the cluster.
+	history = append([]*model.Job{
+		jobOf(model.ActionAddColumn, 14, tableOf(1, "t1", "a", "c"), "alter table t1 add column c int"),
+	}, history...)
+	divergences = reconcileDDLHistory(is, history, tables[:1], ddlJobs[:1])
+	require.Len(t, divergences, 1)
+	require.Equal(t, "t1", divergences[0].Table)
+	require.Contains(t, divergences[0].Reason, "has been executed in the cluster")
+}
+
+func TestDiffTableSchema(t *testing.T) {
+	col := func(name string, tp byte) *model.ColumnInfo {
+		return &model.ColumnInfo{Name: model.NewCIStr(name), FieldType: *types.NewFieldType(tp)}
+	}
+	idx := func(name string, unique bool, cols ...string) *model.IndexInfo {
+		info := &model.IndexInfo{Name: model.NewCIStr(name), Unique: unique}
+		for _, c := range cols {
+			info.Columns = append(info.Columns, &model.IndexColumn{Name: model.NewCIStr(c), Length: -1})
+		}
+		return info
+	}
+	expected := &model.TableInfo{
+		Columns: []*model.ColumnInfo{col("a", mysql.TypeLong), col("b", mysql.TypeVarchar)},
+		Indices: []*model.IndexInfo{idx("i", false, "a")},
+	}
+	cases := []struct {
+		actual *model.TableInfo
+		diff   string
+	}{
+		{expected.Clone(), ""},
+		{&model.TableInfo{
+			Columns: []*model.ColumnInfo{col("a", mysql.TypeLong)},
+			Indices: []*model.IndexInfo{idx("i", false, "a")},
+		}, "the column `b` is missing"},
+		{&model.TableInfo{
+			Columns: []*model.ColumnInfo{col("a", mysql.TypeLonglong), col("b", mysql.TypeVarchar)},
+			Indices: []*model.IndexInfo{idx("i", false, "a")},
+		}, "the column `a` is " + types.NewFieldType(mysql.TypeLonglong).String() +
+			" rather than " + types.NewFieldType(mysql.TypeLong).String()},
+		{&model.TableInfo{
+			Columns: []*model.ColumnInfo{col("a", mysql.TypeLong), col("b", mysql.TypeVarchar)},
+			Indices: []*model.IndexInfo{idx("i", true, "a")},
+		}, "the index `i` is different"},
+		{&model.TableInfo{
+			Columns: []*model.ColumnInfo{col("a", mysql.TypeLong), col("b", mysql.TypeVarchar)},
+			Indices: []*model.IndexInfo{idx("i", false, "a"), idx("j", false, "b")},
+		}, "the index `j` is unexpected"},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.diff, diffTableSchema(expected, c.actual))
+	}
+}
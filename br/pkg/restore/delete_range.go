@@ -0,0 +1,210 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/glue"
+	"github.com/pingcap/tidb/br/pkg/logutil"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/util/mathutil"
+	"go.uber.org/zap"
+)
+
+const (
+	insertDeleteRangeSQLPrefix = "INSERT IGNORE INTO mysql.gc_delete_range VALUES "
+	insertDeleteRangeSQLValue  = "(%?, %?, %?, %?, %?)"
+
+	defaultDeleteRangeBatchSize = 256
+
+	deleteRangeRetryTimes      = 5
+	deleteRangeWaitInterval    = 100 * time.Millisecond
+	deleteRangeMaxWaitInterval = 3 * time.Second
+)
+
+// DeleteRange is a range of the table or the index dropped by the DDLs in the
+// log backup, it's written into `mysql.gc_delete_range` so the GC deletes it.
+type DeleteRange struct {
+	JobID     int64
+	ElementID int64
+	StartKey  []byte
+	EndKey    []byte
+}
+
+type deleteRangeElement struct {
+	jobID     int64
+	elementID int64
+}
+
+// DeleteRangeManager collects the ranges to delete while restoring the meta
+// kv files, and writes them into `mysql.gc_delete_range` in batched
+// transactions after the restore. The ranges of the same job and element are
+// deduplicated, and it's safe to add the ranges concurrently.
+type DeleteRangeManager struct {
+	mu sync.Mutex
+	// pending are the ranges not written yet, in the order of adding.
+	pending []DeleteRange
+	added   map[deleteRangeElement]struct{}
+
+	batchSize    int
+	newBackoffer func() utils.Backoffer
+}
+
+// NewDeleteRangeManager creates a DeleteRangeManager writing the ranges in
+// the batches of the size.
+func NewDeleteRangeManager(batchSize int) *DeleteRangeManager {
+	if batchSize <= 0 {
+		batchSize = defaultDeleteRangeBatchSize
+	}
+	return &DeleteRangeManager{
+		added:     make(map[deleteRangeElement]struct{}),
+		batchSize: batchSize,
+		newBackoffer: func() utils.Backoffer {
+			return &deleteRangeBackoffer{
+				attempt:      deleteRangeRetryTimes,
+				delayTime:    deleteRangeWaitInterval,
+				maxDelayTime: deleteRangeMaxWaitInterval,
+			}
+		},
+	}
+}
+
+// Add adds the range to delete, it returns false if the range of the job and
+// the element has been added.
+func (m *DeleteRangeManager) Add(r DeleteRange) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	element := deleteRangeElement{jobID: r.JobID, elementID: r.ElementID}
+	if _, ok := m.added[element]; ok {
+		return false
+	}
+	m.added[element] = struct{}{}
+	m.pending = append(m.pending, r)
+	return true
+}
+
+// AddTables adds the ranges of the tables dropped by the job, the element IDs
+// start from 1.
+func (m *DeleteRangeManager) AddTables(jobID int64, tableIDs []int64) {
+	for i, tableID := range tableIDs {
+		m.Add(DeleteRange{
+			JobID:     jobID,
+			ElementID: int64(i + 1),
+			StartKey:  tablecodec.EncodeTablePrefix(tableID),
+			EndKey:    tablecodec.EncodeTablePrefix(tableID + 1),
+		})
+	}
+}
+
+// AddIndexes adds the ranges of the indexes dropped by the job, the element
+// IDs start from elementID, which is advanced by the number of the indexes.
+func (m *DeleteRangeManager) AddIndexes(jobID int64, elementID *int64, tableID int64, indexIDs []int64) {
+	for _, indexID := range indexIDs {
+		m.Add(DeleteRange{
+			JobID:     jobID,
+			ElementID: *elementID,
+			StartKey:  tablecodec.EncodeTableIndexPrefix(tableID, indexID),
+			EndKey:    tablecodec.EncodeTableIndexPrefix(tableID, indexID+1),
+		})
+		*elementID += 1
+	}
+}
+
+// PendingRanges returns the ranges not written yet.
+func (m *DeleteRangeManager) PendingRanges() []DeleteRange {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]DeleteRange(nil), m.pending...)
+}
+
+// Flush writes the pending ranges into `mysql.gc_delete_range` with the ts, a
+// batch is written in a transaction, which is retried if it fails. The ranges
+// written are removed from the pending ones, so flushing again after a failure
+// continues from the first batch failed.
+func (m *DeleteRangeManager) Flush(ctx context.Context, se glue.Session, ts uint64) error {
+	for {
+		m.mu.Lock()
+		batch := m.pending[:mathutil.Min(len(m.pending), m.batchSize)]
+		m.mu.Unlock()
+		if len(batch) == 0 {
+			return nil
+		}
+
+		err := utils.WithRetry(ctx, func() error {
+			return writeDeleteRanges(ctx, se, batch, ts)
+		}, m.newBackoffer())
+		if err != nil {
+			return errors.Annotatef(err, "failed to write %d ranges into gc_delete_range", len(batch))
+		}
+
+		m.mu.Lock()
+		m.pending = m.pending[len(batch):]
+		m.mu.Unlock()
+	}
+}
+
+func writeDeleteRanges(ctx context.Context, se glue.Session, batch []DeleteRange, ts uint64) error {
+	var buf strings.Builder
+	buf.WriteString(insertDeleteRangeSQLPrefix)
+	args := make([]interface{}, 0, len(batch)*5)
+	for i, r := range batch {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString(insertDeleteRangeSQLValue)
+		args = append(args, r.JobID, r.ElementID, hex.EncodeToString(r.StartKey), hex.EncodeToString(r.EndKey), ts)
+	}
+
+	if err := se.ExecuteInternal(ctx, "BEGIN"); err != nil {
+		return errors.Trace(err)
+	}
+	err := se.ExecuteInternal(ctx, buf.String(), args...)
+	if err == nil {
+		err = se.ExecuteInternal(ctx, "COMMIT")
+	}
+	if err != nil {
+		// rollback so the session can be used by the retry.
+		if rbErr := se.ExecuteInternal(ctx, "ROLLBACK"); rbErr != nil {
+			log.Warn("failed to rollback the transaction writing gc_delete_range", logutil.ShortError(rbErr))
+		}
+		log.Warn("failed to write the ranges into gc_delete_range",
+			zap.Int("ranges", len(batch)), logutil.ShortError(err))
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// deleteRangeBackoffer retries writing the ranges unless the context is done.
+type deleteRangeBackoffer struct {
+	attempt      int
+	delayTime    time.Duration
+	maxDelayTime time.Duration
+}
+
+// NextBackoff returns a duration to wait before retrying again
+func (bo *deleteRangeBackoffer) NextBackoff(err error) time.Duration {
+	switch errors.Cause(err) { // nolint:errorlint
+	case context.Canceled, context.DeadlineExceeded:
+		bo.attempt = 0
+		return 0
+	}
+	bo.attempt--
+	bo.delayTime = 2 * bo.delayTime
+	if bo.delayTime > bo.maxDelayTime {
+		return bo.maxDelayTime
+	}
+	return bo.delayTime
+}
+
+// Attempt returns the remain attempt times
+func (bo *deleteRangeBackoffer) Attempt() int {
+	return bo.attempt
+}
@@ -0,0 +1,90 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/glue"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/stretchr/testify/require"
+)
+
+type mockDeleteRangeSession struct {
+	glue.Session
+
+	stmts []string
+	// inserted are the arguments of the insert statements committed.
+	inserted [][]interface{}
+	pending  [][]interface{}
+	// failures is the number of the insert statements to fail.
+	failures int
+}
+
+func (se *mockDeleteRangeSession) ExecuteInternal(_ context.Context, sql string, args ...interface{}) error {
+	se.stmts = append(se.stmts, sql)
+	switch {
+	case sql == "BEGIN":
+		se.pending = nil
+	case sql == "COMMIT":
+		se.inserted = append(se.inserted, se.pending...)
+		se.pending = nil
+	case sql == "ROLLBACK":
+		se.pending = nil
+	case strings.HasPrefix(sql, insertDeleteRangeSQLPrefix):
+		if se.failures > 0 {
+			se.failures--
+			return errors.New("the session is broken")
+		}
+		se.pending = append(se.pending, args)
+	}
+	return nil
+}
+
+func TestDeleteRangeManagerFlush(t *testing.T) {
+	ctx := context.Background()
+	m := NewDeleteRangeManager(2)
+	m.newBackoffer = func() utils.Backoffer {
+		return &deleteRangeBackoffer{attempt: 2}
+	}
+	m.AddTables(1, []int64{10, 11, 12})
+	elementID := int64(4)
+	m.AddIndexes(1, &elementID, 10, []int64{1})
+	require.Equal(t, int64(5), elementID)
+	require.False(t, m.Add(DeleteRange{JobID: 1, ElementID: 2}))
+	require.Len(t, m.PendingRanges(), 4)
+
+	// the failed batch is retried after rolling back.
+	se := &mockDeleteRangeSession{failures: 1}
+	require.NoError(t, m.Flush(ctx, se, 42))
+	require.Empty(t, m.PendingRanges())
+	require.Len(t, se.inserted, 2)
+	require.Len(t, se.inserted[0], 10)
+	require.Equal(t, []interface{}{int64(1), int64(1), "7480000000000000000a", "7480000000000000000b", uint64(42)},
+		se.inserted[0][:5])
+	require.Equal(t, []string{"BEGIN", "ROLLBACK", "BEGIN", "COMMIT", "BEGIN", "COMMIT"}, filterTxnStmts(se.stmts))
+
+	// the ranges are kept if the retries are exhausted, and flushed again later.
+	m.AddTables(2, []int64{20})
+	m.AddTables(1, []int64{10})
+	se = &mockDeleteRangeSession{failures: 2}
+	require.Error(t, m.Flush(ctx, se, 43))
+	require.Len(t, m.PendingRanges(), 1)
+	require.NoError(t, m.Flush(ctx, se, 43))
+	require.Empty(t, m.PendingRanges())
+	require.Len(t, se.inserted, 1)
+	require.Equal(t, int64(2), se.inserted[0][0])
+}
+
+func filterTxnStmts(stmts []string) []string {
+	txnStmts := make([]string, 0, len(stmts))
+	for _, stmt := range stmts {
+		if !strings.HasPrefix(stmt, insertDeleteRangeSQLPrefix) {
+			txnStmts = append(txnStmts, stmt)
+		}
+	}
+	return txnStmts
+}
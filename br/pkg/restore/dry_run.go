@@ -0,0 +1,274 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/store/pdtypes"
+	"github.com/pingcap/tidb/util"
+	"go.uber.org/zap"
+)
+
+// planReplicaCount is the replicas of the restored data assumed when checking
+// the free disk space, which is the default max-replicas of PD.
+const planReplicaCount = 3
+
+// StoreInfoGetter gets the status of a store from PD.
+type StoreInfoGetter interface {
+	GetStoreInfo(ctx context.Context, storeID uint64) (*pdtypes.StoreInfo, error)
+}
+
+// PlanCheck is the result of a pre-check of the restore.
+type PlanCheck struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Message string `json:"message,omitempty"`
+}
+
+// PlanRewriteRule is a rewrite rule of the keys of a table in the plan.
+type PlanRewriteRule struct {
+	OldKeyPrefix string `json:"old-key-prefix"`
+	NewKeyPrefix string `json:"new-key-prefix"`
+}
+
+// TablePlan is the plan of restoring a table.
+type TablePlan struct {
+	DB    string `json:"db"`
+	Table string `json:"table"`
+	// Exists is whether the table exists in the cluster. The IDs of the tables
+	// not existing are allocated when creating them, so their rewrite rules are
+	// only known during the restore.
+	Exists       bool              `json:"exists"`
+	Files        int               `json:"files"`
+	TotalKvs     uint64            `json:"total-kvs"`
+	TotalBytes   uint64            `json:"total-bytes"`
+	SplitKeys    int               `json:"split-keys"`
+	RewriteRules []PlanRewriteRule `json:"rewrite-rules,omitempty"`
+}
+
+// StorePlan is the free disk space of a store in the plan.
+type StorePlan struct {
+	StoreID        uint64 `json:"store-id"`
+	Address        string `json:"address"`
+	AvailableBytes uint64 `json:"available-bytes"`
+	RequiredBytes  uint64 `json:"required-bytes"`
+}
+
+// RestorePlan is the plan of a restore reported by the dry run, it contains
+// the results of the pre-checks and the work to do without importing any data.
+type RestorePlan struct {
+	Passed     bool         `json:"passed"`
+	Checks     []PlanCheck  `json:"checks"`
+	Warnings   []string     `json:"warnings,omitempty"`
+	Tables     []*TablePlan `json:"tables"`
+	Stores     []StorePlan  `json:"stores,omitempty"`
+	DDLJobs    int          `json:"ddl-jobs"`
+	Files      int          `json:"files"`
+	TotalKvs   uint64       `json:"total-kvs"`
+	TotalBytes uint64       `json:"total-bytes"`
+	SplitKeys  int          `json:"split-keys"`
+}
+
+func (p *RestorePlan) addCheck(name string, err error) {
+	check := PlanCheck{Name: name, Passed: err == nil}
+	if err != nil {
+		check.Message = err.Error()
+		p.Passed = false
+	}
+	log.Info("restore dry run check", zap.String("check", name), zap.Bool("passed", check.Passed), zap.Error(err))
+	p.Checks = append(p.Checks, check)
+}
+
+// PlanRestore performs the pre-checks of restoring the tables and computes the
+// rewrite rules and the region split plan without changing the cluster. The
+// failed checks are reported in the plan rather than returned as errors.
+func (rc *Client) PlanRestore(
+	ctx context.Context,
+	dom *domain.Domain,
+	stores StoreInfoGetter,
+	tables []*metautil.Table,
+	ddlJobs []*model.Job,
+	splitSizeBytes, splitKeyCount uint64,
+) (*RestorePlan, error) {
+	plan := &RestorePlan{Passed: true, Tables: make([]*TablePlan, 0, len(tables)), DDLJobs: len(ddlJobs)}
+
+	if rc.IsFullClusterRestore() && rc.HasBackedUpSysDB() {
+		plan.addCheck("cluster-fresh", rc.CheckTargetClusterFresh(ctx))
+		plan.addCheck("system-table-compatibility", rc.CheckSysTableCompatibility(dom, tables))
+	}
+	if rc.HasTableRenames() {
+		plan.addCheck("renamed-tables-not-exist", rc.CheckRenamedTablesNotExist(dom))
+	}
+	plan.addCheck("cluster-index", rc.PreCheckTableClusterIndex(tables, ddlJobs, dom))
+	if rc.IsIncremental() {
+		divergences, err := rc.CheckDDLHistory(dom, tables, ddlJobs)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		plan.addCheck("ddl-history", DDLDivergenceError(divergences))
+	}
+	warnings, err := rc.checkTiFlashReplicas(ctx, tables)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	plan.Warnings = append(plan.Warnings, warnings...)
+	plan.addCheck("placement-policy", rc.checkPlacementPolicies(dom, tables))
+	policies, err := rc.GetPlacementPolicies()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	plan.addCheck("placement-policy-labels", rc.CheckPlacementPolicyLabels(ctx, policies, tables, false))
+
+	is := dom.InfoSchema()
+	for _, table := range tables {
+		tp := &TablePlan{
+			DB:         table.DB.Name.O,
+			Table:      table.Info.Name.O,
+			Files:      len(table.Files),
+			TotalKvs:   table.TotalKvs,
+			TotalBytes: table.TotalBytes,
+		}
+		ranges, _, err := MergeFileRanges(table.Files, splitSizeBytes, splitKeyCount)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		tp.SplitKeys = len(ranges)
+		if existing, err := is.TableByName(table.DB.Name, table.Info.Name); err == nil {
+			tp.Exists = true
+			rules, err := rc.keyspaceRewrite.encodeRules(GetRewriteRules(existing.Meta(), table.Info, 0, true))
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			for _, rule := range rules.Data {
+				tp.RewriteRules = append(tp.RewriteRules, PlanRewriteRule{
+					OldKeyPrefix: hex.EncodeToString(rule.GetOldKeyPrefix()),
+					NewKeyPrefix: hex.EncodeToString(rule.GetNewKeyPrefix()),
+				})
+			}
+		}
+		plan.Tables = append(plan.Tables, tp)
+		plan.Files += tp.Files
+		plan.TotalKvs += tp.TotalKvs
+		plan.TotalBytes += tp.TotalBytes
+		plan.SplitKeys += tp.SplitKeys
+	}
+
+	storePlans, err := rc.planStoreSpace(ctx, stores, plan.TotalBytes)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	plan.Stores = storePlans
+	var spaceErr error
+	for _, s := range storePlans {
+		if s.AvailableBytes < s.RequiredBytes {
+			spaceErr = errors.Errorf(
+				"store %d (%s) has %d bytes available, but about %d bytes are required",
+				s.StoreID, s.Address, s.AvailableBytes, s.RequiredBytes)
+			break
+		}
+	}
+	plan.addCheck("free-disk-space", spaceErr)
+	return plan, nil
+}
+
+// checkTiFlashReplicas returns the warnings of the tables whose TiFlash replicas
+// can't be satisfied. It isn't fatal since PreCheckTableTiFlashReplica removes
+// the replicas when restoring, but unlike it the tables aren't changed.
+func (rc *Client) checkTiFlashReplicas(ctx context.Context, tables []*metautil.Table) ([]string, error) {
+	tiFlashStoreCount, err := rc.getTiFlashNodeCount(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var warnings []string
+	for _, table := range tables {
+		if table.Info.TiFlashReplica != nil && table.Info.TiFlashReplica.Count > tiFlashStoreCount {
+			warnings = append(warnings, fmt.Sprintf(
+				"the table `%s`.`%s` has %d TiFlash replicas but there are %d TiFlash stores, its replicas won't be restored",
+				table.DB.Name.O, table.Info.Name.O, table.Info.TiFlashReplica.Count, tiFlashStoreCount))
+		}
+	}
+	return warnings, nil
+}
+
+// checkPlacementPolicies checks the placement policies referenced by the tables
+// are in the backup or the cluster.
+func (rc *Client) checkPlacementPolicies(dom *domain.Domain, tables []*metautil.Table) error {
+	if !rc.GetSupportPolicy() {
+		// the placement policies are ignored.
+		return nil
+	}
+	policies, err := rc.GetPlacementPolicies()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	is := dom.InfoSchema()
+	check := func(table *metautil.Table, ref *model.PolicyRefInfo) error {
+		if ref == nil {
+			return nil
+		}
+		if _, ok := policies.Load(ref.Name.L); ok {
+			return nil
+		}
+		if _, ok := is.PolicyByName(ref.Name); ok {
+			return nil
+		}
+		return errors.Errorf(
+			"the placement policy %s of the table `%s`.`%s` is neither in the backup nor in the cluster",
+			ref.Name.O, table.DB.Name.O, table.Info.Name.O)
+	}
+	for _, table := range tables {
+		if err := check(table, table.Info.PlacementPolicyRef); err != nil {
+			return err
+		}
+		if table.Info.Partition == nil {
+			continue
+		}
+		for _, def := range table.Info.Partition.Definitions {
+			if err := check(table, def.PlacementPolicyRef); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// planStoreSpace estimates the space required by every TiKV store, assuming
+// the replicas of the data are balanced between the stores.
+func (rc *Client) planStoreSpace(ctx context.Context, getter StoreInfoGetter, totalBytes uint64) ([]StorePlan, error) {
+	stores, err := util.GetAllTiKVStores(ctx, rc.pdClient, util.SkipTiFlash)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(stores) == 0 {
+		return nil, nil
+	}
+	required := totalBytes * planReplicaCount / uint64(len(stores))
+	plans := make([]StorePlan, 0, len(stores))
+	for _, store := range stores {
+		info, err := getter.GetStoreInfo(ctx, store.GetId())
+		if err != nil {
+			return nil, errors.Annotatef(err, "failed to get the status of store %d", store.GetId())
+		}
+		var available uint64
+		if info.Status != nil {
+			available = uint64(info.Status.Available)
+		}
+		plans = append(plans, StorePlan{
+			StoreID:        store.GetId(),
+			Address:        store.GetAddress(),
+			AvailableBytes: available,
+			RequiredBytes:  required,
+		})
+	}
+	sort.Slice(plans, func(i, j int) bool { return plans[i].StoreID < plans[j].StoreID })
+	return plans, nil
+}
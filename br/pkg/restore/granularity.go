@@ -0,0 +1,276 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/glue"
+	"github.com/pingcap/tidb/br/pkg/logutil"
+	"github.com/pingcap/tidb/br/pkg/restore/split"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// Granularity is how the files of a batch are scheduled to restore.
+type Granularity string
+
+const (
+	// FineGrained restores the ranges of the files in the order of the tables
+	// by the worker pool.
+	FineGrained Granularity = "fine"
+	// CoarseGrained restores the ranges of the files by the leader stores of
+	// their regions, the ranges are balanced between the stores so all the
+	// stores are kept busy.
+	CoarseGrained Granularity = "coarse"
+)
+
+// ParseGranularity parses the granularity of the restore, it's fine-grained if
+// it's empty.
+func ParseGranularity(s string) (Granularity, error) {
+	switch g := Granularity(s); g {
+	case "":
+		return FineGrained, nil
+	case FineGrained, CoarseGrained:
+		return g, nil
+	default:
+		return "", errors.Annotatef(berrors.ErrInvalidArgument,
+			"invalid granularity %q, it should be %s or %s", s, FineGrained, CoarseGrained)
+	}
+}
+
+// SetGranularity sets how the files are scheduled to restore.
+func (rc *Client) SetGranularity(g Granularity) {
+	rc.granularity = g
+}
+
+// StoreIngestStat is the files ingested into the regions led by a store.
+type StoreIngestStat struct {
+	StoreID uint64
+	Files   int
+	Bytes   uint64
+	// Elapsed is the time from the first range of the store starting to
+	// restore to the last one done.
+	Elapsed time.Duration
+}
+
+// Throughput returns the bytes ingested per second.
+func (s StoreIngestStat) Throughput() float64 {
+	if s.Elapsed <= 0 {
+		return 0
+	}
+	return float64(s.Bytes) / s.Elapsed.Seconds()
+}
+
+type storeIngestCollector struct {
+	mu    sync.Mutex
+	stats map[uint64]*storeIngestStat
+}
+
+type storeIngestStat struct {
+	StoreIngestStat
+	start, end time.Time
+}
+
+func newStoreIngestCollector() *storeIngestCollector {
+	return &storeIngestCollector{stats: make(map[uint64]*storeIngestStat)}
+}
+
+func (c *storeIngestCollector) record(storeID uint64, files []*backuppb.File, start, end time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s, ok := c.stats[storeID]
+	if !ok {
+		s = &storeIngestStat{StoreIngestStat: StoreIngestStat{StoreID: storeID}, start: start}
+		c.stats[storeID] = s
+	}
+	if start.Before(s.start) {
+		s.start = start
+	}
+	if end.After(s.end) {
+		s.end = end
+	}
+	s.Files += len(files)
+	for _, f := range files {
+		s.Bytes += f.TotalBytes
+	}
+	s.Elapsed = s.end.Sub(s.start)
+}
+
+func (c *storeIngestCollector) result() []StoreIngestStat {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	result := make([]StoreIngestStat, 0, len(c.stats))
+	for _, s := range c.stats {
+		result = append(result, s.StoreIngestStat)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].StoreID < result[j].StoreID })
+	return result
+}
+
+// GetStoreIngestStats returns the files ingested by every store in the
+// coarse-grained restore, ordered by the store IDs.
+func (rc *Client) GetStoreIngestStats() []StoreIngestStat {
+	return rc.storeIngestStats.result()
+}
+
+// storeTask is the files of a range to restore, whose region is led by the store.
+type storeTask struct {
+	storeID uint64
+	files   []*backuppb.File
+}
+
+// storeScheduler queues the ranges by the leader stores of their regions. A
+// worker takes the ranges of its own store first, and steals the ranges of the
+// store with the most pending ones after draining them, so no worker idles
+// while any range is pending.
+type storeScheduler struct {
+	mu     sync.Mutex
+	queues map[uint64][]storeTask
+}
+
+func newStoreScheduler() *storeScheduler {
+	return &storeScheduler{queues: make(map[uint64][]storeTask)}
+}
+
+func (s *storeScheduler) push(task storeTask) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queues[task.storeID] = append(s.queues[task.storeID], task)
+}
+
+// stores returns the stores with pending ranges, ordered by their IDs.
+func (s *storeScheduler) stores() []uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stores := make([]uint64, 0, len(s.queues))
+	for storeID := range s.queues {
+		stores = append(stores, storeID)
+	}
+	sort.Slice(stores, func(i, j int) bool { return stores[i] < stores[j] })
+	return stores
+}
+
+// next returns the next range for the worker of the store, it returns false
+// if no range is pending.
+func (s *storeScheduler) next(storeID uint64) (storeTask, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.queues[storeID]) == 0 {
+		most := 0
+		for id, queue := range s.queues {
+			if len(queue) > most || (len(queue) == most && id < storeID) {
+				most, storeID = len(queue), id
+			}
+		}
+		if most == 0 {
+			return storeTask{}, false
+		}
+	}
+	queue := s.queues[storeID]
+	task := queue[0]
+	if len(queue) == 1 {
+		delete(s.queues, storeID)
+	} else {
+		s.queues[storeID] = queue[1:]
+	}
+	return task, true
+}
+
+// leaderStoreOf returns the leader store of the region the files start in,
+// it returns 0 if the region isn't found, whose ranges are taken by any worker.
+func (rc *Client) leaderStoreOf(ctx context.Context, files []*backuppb.File, rewriteRules *RewriteRules) uint64 {
+	startKey, _, err := rc.fileImporter.getKeyRangeForFiles(files, rewriteRules)
+	if err == nil {
+		var regions []*split.RegionInfo
+		regions, err = rc.toolClient.ScanRegions(ctx, startKey, []byte{}, 1)
+		if err == nil && len(regions) > 0 && regions[0].Leader != nil {
+			return regions[0].Leader.GetStoreId()
+		}
+	}
+	log.Debug("failed to find the leader store of the files", logutil.Files(files), logutil.ShortError(err))
+	return 0
+}
+
+// restoreSSTFilesByStore restores the ranges of the files by the workers of
+// the leader stores of their regions. The workers of the pool are assigned to
+// the stores evenly, and steal the ranges of the other stores once the ranges
+// of their own stores are drained.
+func (rc *Client) restoreSSTFilesByStore(
+	ctx context.Context,
+	files []*backuppb.File,
+	rewriteRules *RewriteRules,
+	updateCh glue.Progress,
+) error {
+	scheduler := newStoreScheduler()
+	var rangeFiles []*backuppb.File
+	var leftFiles []*backuppb.File
+	for rangeFiles, leftFiles = drainFilesByRange(files, rc.fileImporter.supportMultiIngest); len(rangeFiles) != 0; rangeFiles, leftFiles = drainFilesByRange(leftFiles, rc.fileImporter.supportMultiIngest) {
+		if rc.isFilesRestored(rangeFiles) {
+			log.Info("skip the files imported by the previous restore", logutil.Files(rangeFiles))
+			rc.restoreProgress.OnFilesIngested(rangeFiles)
+			updateCh.Inc()
+			continue
+		}
+		scheduler.push(storeTask{storeID: rc.leaderStoreOf(ctx, rangeFiles, rewriteRules), files: rangeFiles})
+	}
+	stores := scheduler.stores()
+	if len(stores) == 0 {
+		return nil
+	}
+
+	eg, ectx := errgroup.WithContext(ctx)
+	workers := rc.workerPool.Limit()
+	if workers < len(stores) {
+		workers = len(stores)
+	}
+	for i := 0; i < workers; i++ {
+		storeID := stores[i%len(stores)]
+		rc.workerPool.ApplyOnErrorGroup(eg, func() error {
+			for {
+				task, ok := scheduler.next(storeID)
+				if !ok {
+					return nil
+				}
+				if err := rc.restoreStoreTask(ectx, task, rewriteRules, updateCh); err != nil {
+					return errors.Trace(err)
+				}
+			}
+		})
+	}
+	return eg.Wait()
+}
+
+func (rc *Client) restoreStoreTask(
+	ctx context.Context,
+	task storeTask,
+	rewriteRules *RewriteRules,
+	updateCh glue.Progress,
+) error {
+	start := time.Now()
+	defer func() {
+		log.Info("import files done", logutil.Files(task.files),
+			zap.Uint64("store", task.storeID), zap.Duration("take", time.Since(start)))
+		updateCh.Inc()
+	}()
+	if err := rc.tableSpeedLimiter.wait(ctx, task.files); err != nil {
+		return errors.Trace(err)
+	}
+	err := rc.fileImporter.ImportSSTFiles(ctx, task.files, rewriteRules, rc.cipher, rc.backupMeta.ApiVersion)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	end := time.Now()
+	rc.storeIngestStats.record(task.storeID, task.files, start, end)
+	rc.tableIngestStats.record(task.files, start, end)
+	rc.checkpoint.RecordFiles(task.files)
+	rc.restoreProgress.OnFilesIngested(task.files)
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"testing"
+	"time"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGranularity(t *testing.T) {
+	for s, expected := range map[string]Granularity{
+		"":       FineGrained,
+		"fine":   FineGrained,
+		"coarse": CoarseGrained,
+	} {
+		g, err := ParseGranularity(s)
+		require.NoError(t, err)
+		require.Equal(t, expected, g)
+	}
+	_, err := ParseGranularity("medium")
+	require.ErrorContains(t, err, "invalid granularity")
+}
+
+func TestStoreScheduler(t *testing.T) {
+	s := newStoreScheduler()
+	task := func(storeID uint64, name string) storeTask {
+		return storeTask{storeID: storeID, files: []*backuppb.File{{Name: name}}}
+	}
+	s.push(task(1, "a"))
+	s.push(task(2, "b"))
+	s.push(task(2, "c"))
+	s.push(task(3, "d"))
+	s.push(task(3, "e"))
+	require.Equal(t, []uint64{1, 2, 3}, s.stores())
+
+	// the worker takes the ranges of its own store first.
+	next, ok := s.next(1)
+	require.True(t, ok)
+	require.Equal(t, "a", next.files[0].Name)
+	// then steals the ranges of the store with the most pending ones, the
+	// store of the smaller ID is taken first if they are the same.
+	next, ok = s.next(1)
+	require.True(t, ok)
+	require.Equal(t, "b", next.files[0].Name)
+	next, ok = s.next(1)
+	require.True(t, ok)
+	require.Equal(t, "d", next.files[0].Name)
+	next, ok = s.next(2)
+	require.True(t, ok)
+	require.Equal(t, "c", next.files[0].Name)
+	require.Equal(t, []uint64{3}, s.stores())
+	next, ok = s.next(4)
+	require.True(t, ok)
+	require.Equal(t, "e", next.files[0].Name)
+	_, ok = s.next(3)
+	require.False(t, ok)
+}
+
+func TestStoreIngestCollector(t *testing.T) {
+	c := newStoreIngestCollector()
+	start := time.Unix(100, 0)
+	files := []*backuppb.File{{TotalBytes: 100}, {TotalBytes: 300}}
+	c.record(2, files, start.Add(time.Second), start.Add(2*time.Second))
+	c.record(2, files[:1], start, start.Add(time.Second))
+	c.record(1, files[1:], start, start)
+
+	stats := c.result()
+	require.Len(t, stats, 2)
+	require.Equal(t, StoreIngestStat{StoreID: 1, Files: 1, Bytes: 300}, stats[0])
+	require.Equal(t, float64(0), stats[0].Throughput())
+	require.Equal(t, StoreIngestStat{StoreID: 2, Files: 3, Bytes: 500, Elapsed: 2 * time.Second}, stats[1])
+	require.Equal(t, float64(250), stats[1].Throughput())
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"crypto/tls"
 	"strings"
-	"sync"
 	"sync/atomic"
 	"time"
 
@@ -22,25 +21,23 @@ import (
 	"github.com/pingcap/tidb/br/pkg/conn"
 	"github.com/pingcap/tidb/br/pkg/conn/util"
 	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/importclient"
 	"github.com/pingcap/tidb/br/pkg/logutil"
 	"github.com/pingcap/tidb/br/pkg/restore/split"
 	"github.com/pingcap/tidb/br/pkg/summary"
 	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/util/codec"
 	pd "github.com/tikv/pd/client"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/backoff"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/status"
 )
 
 const (
 	importScanRegionTime = 10 * time.Second
-	gRPCBackOffMaxDelay  = 3 * time.Second
 )
 
 // ImporterClient is used to import a file to TiKV.
@@ -86,24 +83,24 @@ type ImporterClient interface {
 	) (import_sstpb.ImportSSTClient, error)
 
 	SupportMultiIngest(ctx context.Context, stores []uint64) (bool, error)
+
+	// IsStoreHealthy returns whether the store is considered healthy by the
+	// recent RPCs sent to it.
+	IsStoreHealthy(storeID uint64) bool
 }
 
 type importClient struct {
-	mu         sync.Mutex
-	metaClient split.SplitClient
-	clients    map[uint64]import_sstpb.ImportSSTClient
-	tlsConf    *tls.Config
-
-	keepaliveConf keepalive.ClientParameters
+	client *importclient.Client
 }
 
 // NewImportClient returns a new ImporterClient.
 func NewImportClient(metaClient split.SplitClient, tlsConf *tls.Config, keepaliveConf keepalive.ClientParameters) ImporterClient {
 	return &importClient{
-		metaClient:    metaClient,
-		clients:       make(map[uint64]import_sstpb.ImportSSTClient),
-		tlsConf:       tlsConf,
-		keepaliveConf: keepaliveConf,
+		client: importclient.New(metaClient, importclient.Config{
+			TLSConfig: tlsConf,
+			Keepalive: keepaliveConf,
+			Block:     true,
+		}),
 	}
 }
 
@@ -183,43 +180,14 @@ func (ic *importClient) GetImportClient(
 	ctx context.Context,
 	storeID uint64,
 ) (import_sstpb.ImportSSTClient, error) {
-	ic.mu.Lock()
-	defer ic.mu.Unlock()
-	client, ok := ic.clients[storeID]
-	if ok {
-		return client, nil
-	}
-	store, err := ic.metaClient.GetStore(ctx, storeID)
-	if err != nil {
-		return nil, errors.Trace(err)
-	}
-	opt := grpc.WithInsecure()
-	if ic.tlsConf != nil {
-		opt = grpc.WithTransportCredentials(credentials.NewTLS(ic.tlsConf))
-	}
-	addr := store.GetPeerAddress()
-	if addr == "" {
-		addr = store.GetAddress()
-	}
-	bfConf := backoff.DefaultConfig
-	bfConf.MaxDelay = gRPCBackOffMaxDelay
-	conn, err := grpc.DialContext(
-		ctx,
-		addr,
-		opt,
-		grpc.WithBlock(),
-		grpc.FailOnNonTempDialError(true),
-		grpc.WithConnectParams(grpc.ConnectParams{Backoff: bfConf}),
-		grpc.WithKeepaliveParams(ic.keepaliveConf),
-	)
-	if err != nil {
-		return nil, errors.Trace(err)
-	}
-	client = import_sstpb.NewImportSSTClient(conn)
-	ic.clients[storeID] = client
+	client, err := ic.client.GetImportClient(ctx, storeID)
 	return client, errors.Trace(err)
 }
 
+func (ic *importClient) IsStoreHealthy(storeID uint64) bool {
+	return ic.client.IsStoreHealthy(storeID)
+}
+
 func (ic *importClient) SupportMultiIngest(ctx context.Context, stores []uint64) (bool, error) {
 	for _, storeID := range stores {
 		_, err := ic.MultiIngest(ctx, storeID, &import_sstpb.MultiIngestRequest{})
@@ -241,10 +209,21 @@ type FileImporter struct {
 	importClient ImporterClient
 	backend      *backuppb.StorageBackend
 
-	isRawKvMode        bool
-	rawStartKey        []byte
-	rawEndKey          []byte
+	kvMode KvMode
+	// rangeStartKey and rangeEndKey are the key range restored in the raw kv
+	// or the txn kv mode.
+	rangeStartKey      []byte
+	rangeEndKey        []byte
 	supportMultiIngest bool
+	// keyspaceRewrite is the keyspaces to restore between, the keys of the SST
+	// files are in the source keyspace.
+	keyspaceRewrite *KeyspaceRewrite
+	// retryStats counts the retried errors of downloading and ingesting the files.
+	retryStats *ImportRetryStats
+	// mirrorBackend is the storage the files are downloaded from when the
+	// backend is throttled or fails by the server, it's nil if not set.
+	mirrorBackend *backuppb.StorageBackend
+	sourceStats   *storageSourceStats
 }
 
 // NewFileImporter returns a new file importClient.
@@ -252,13 +231,15 @@ func NewFileImporter(
 	metaClient split.SplitClient,
 	importClient ImporterClient,
 	backend *backuppb.StorageBackend,
-	isRawKvMode bool,
+	kvMode KvMode,
 ) FileImporter {
 	return FileImporter{
 		metaClient:   metaClient,
 		backend:      backend,
 		importClient: importClient,
-		isRawKvMode:  isRawKvMode,
+		kvMode:       kvMode,
+		retryStats:   NewImportRetryStats(),
+		sourceStats:  newStorageSourceStats(),
 	}
 }
 
@@ -285,13 +266,13 @@ func (importer *FileImporter) CheckMultiIngestSupport(ctx context.Context, pdCli
 	return nil
 }
 
-// SetRawRange sets the range to be restored in raw kv mode.
-func (importer *FileImporter) SetRawRange(startKey, endKey []byte) error {
-	if !importer.isRawKvMode {
-		return errors.Annotate(berrors.ErrRestoreModeMismatch, "file importer is not in raw kv mode")
+// SetRange sets the key range to be restored in the raw kv or the txn kv mode.
+func (importer *FileImporter) SetRange(startKey, endKey []byte) error {
+	if importer.kvMode == TiDBMode {
+		return errors.Annotate(berrors.ErrRestoreModeMismatch, "file importer is not in raw kv or txn kv mode")
 	}
-	importer.rawStartKey = startKey
-	importer.rawEndKey = endKey
+	importer.rangeStartKey = startKey
+	importer.rangeEndKey = endKey
 	return nil
 }
 
@@ -307,9 +288,12 @@ func (importer *FileImporter) getKeyRangeForFiles(
 	)
 
 	for _, f := range files {
-		if importer.isRawKvMode {
+		switch importer.kvMode {
+		case RawKvMode:
 			start, end = f.GetStartKey(), f.GetEndKey()
-		} else {
+		case TxnKvMode:
+			start, end = encodeRangeKey(f.GetStartKey()), encodeRangeKey(f.GetEndKey())
+		default:
 			start, end, err = GetRewriteRawKeys(f, rewriteRules)
 			if err != nil {
 				return nil, nil, errors.Trace(err)
@@ -494,7 +478,7 @@ func (importer *FileImporter) ImportSSTFiles(
 			summary.CollectSuccessUnit(summary.TotalBytes, 1, f.TotalBytes)
 		}
 		return nil
-	}, utils.NewImportSSTBackoffer())
+	}, newImportSSTRetryPolicy(importer.retryStats))
 	return errors.Trace(err)
 }
 
@@ -506,6 +490,16 @@ func (importer *FileImporter) setDownloadSpeedLimit(ctx context.Context, storeID
 	return errors.Trace(err)
 }
 
+// checkStoreCircuit fails fast if the store failed several times recently, so
+// the requests aren't sent to it until it recovers. The store is tried again
+// once its quarantine of the import client passes.
+func (importer *FileImporter) checkStoreCircuit(storeID uint64) error {
+	if importer.importClient.IsStoreHealthy(storeID) {
+		return nil
+	}
+	return errors.Annotatef(berrors.ErrKVStoreCircuitOpen, "store %d", storeID)
+}
+
 func (importer *FileImporter) download(
 	ctx context.Context,
 	regionInfo *split.RegionInfo,
@@ -522,11 +516,15 @@ func (importer *FileImporter) download(
 		var e error
 		for i, f := range remainFiles {
 			var downloadMeta *import_sstpb.SSTMeta
-			if importer.isRawKvMode {
-				downloadMeta, e = importer.downloadRawKVSST(ctx, regionInfo, f, cipher, apiVersion)
-			} else {
-				downloadMeta, e = importer.downloadSST(ctx, regionInfo, f, rewriteRules, cipher)
+			downloadFile := func(cipher *backuppb.CipherInfo) (*import_sstpb.SSTMeta, error) {
+				return importer.downloadWithFailover(f, func(backend *backuppb.StorageBackend) (*import_sstpb.SSTMeta, error) {
+					if importer.kvMode != TiDBMode {
+						return importer.downloadRangeSST(ctx, regionInfo, f, backend, cipher, apiVersion)
+					}
+					return importer.downloadSST(ctx, regionInfo, f, backend, rewriteRules, cipher)
+				})
 			}
+			downloadMeta, e = downloadFile(cipher)
 
 			failpoint.Inject("restore-storage-error", func(val failpoint.Value) {
 				msg := val.(string)
@@ -539,11 +537,7 @@ func (importer *FileImporter) download(
 			})
 			if isDecryptSstErr(e) {
 				log.Info("fail to decrypt when download sst, try again with no-crypt", logutil.File(f))
-				if importer.isRawKvMode {
-					downloadMeta, e = importer.downloadRawKVSST(ctx, regionInfo, f, nil, apiVersion)
-				} else {
-					downloadMeta, e = importer.downloadSST(ctx, regionInfo, f, rewriteRules, nil)
-				}
+				downloadMeta, e = downloadFile(nil)
 			}
 
 			if e != nil {
@@ -555,7 +549,7 @@ func (importer *FileImporter) download(
 		}
 
 		return nil
-	}, utils.NewDownloadSSTBackoffer())
+	}, newDownloadSSTRetryPolicy(importer.retryStats))
 
 	return downloadMetas, errDownload
 }
@@ -564,6 +558,7 @@ func (importer *FileImporter) downloadSST(
 	ctx context.Context,
 	regionInfo *split.RegionInfo,
 	file *backuppb.File,
+	backend *backuppb.StorageBackend,
 	rewriteRules *RewriteRules,
 	cipher *backuppb.CipherInfo,
 ) (*import_sstpb.SSTMeta, error) {
@@ -574,15 +569,19 @@ func (importer *FileImporter) downloadSST(
 	if fileRule == nil {
 		return nil, errors.Trace(berrors.ErrKVRewriteRuleNotFound)
 	}
+	oldKeyPrefix, err := importer.keyspaceRewrite.encodeOldKeyPrefix(fileRule.GetOldKeyPrefix())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	rule := import_sstpb.RewriteRule{
-		OldKeyPrefix: encodeKeyPrefix(fileRule.GetOldKeyPrefix()),
+		OldKeyPrefix: encodeKeyPrefix(oldKeyPrefix),
 		NewKeyPrefix: encodeKeyPrefix(fileRule.GetNewKeyPrefix()),
 	}
 	sstMeta := GetSSTMetaFromFile(id, file, regionInfo.Region, &rule)
 
 	req := &import_sstpb.DownloadRequest{
 		Sst:            sstMeta,
-		StorageBackend: importer.backend,
+		StorageBackend: backend,
 		Name:           file.GetName(),
 		RewriteRule:    rule,
 		CipherInfo:     cipher,
@@ -599,6 +598,9 @@ func (importer *FileImporter) downloadSST(
 	for _, p := range regionInfo.Region.GetPeers() {
 		peer := p
 		eg.Go(func() error {
+			if err := importer.checkStoreCircuit(peer.GetStoreId()); err != nil {
+				return errors.Trace(err)
+			}
 			resp, err := importer.importClient.DownloadSST(ectx, peer.GetStoreId(), req)
 			if err != nil {
 				return errors.Trace(err)
@@ -632,10 +634,22 @@ func (importer *FileImporter) downloadSST(
 	return &sstMeta, nil
 }
 
-func (importer *FileImporter) downloadRawKVSST(
+// encodeRangeKey encodes the key of the txn kv mode to compare with the keys of
+// the regions, the empty key is kept for the unbounded end.
+func encodeRangeKey(key []byte) []byte {
+	if len(key) == 0 {
+		return key
+	}
+	return codec.EncodeBytes(nil, key)
+}
+
+// downloadRangeSST downloads the SST file in the raw kv or the txn kv mode, the
+// keys aren't rewritten and the data out of the restoring range are dropped.
+func (importer *FileImporter) downloadRangeSST(
 	ctx context.Context,
 	regionInfo *split.RegionInfo,
 	file *backuppb.File,
+	backend *backuppb.StorageBackend,
 	cipher *backuppb.CipherInfo,
 	apiVersion kvrpcpb.APIVersion,
 ) (*import_sstpb.SSTMeta, error) {
@@ -645,13 +659,18 @@ func (importer *FileImporter) downloadRawKVSST(
 	var rule import_sstpb.RewriteRule
 	sstMeta := GetSSTMetaFromFile(id, file, regionInfo.Region, &rule)
 
+	isRawKv := importer.kvMode == RawKvMode
+	rangeStartKey, rangeEndKey := importer.rangeStartKey, importer.rangeEndKey
+	if !isRawKv {
+		rangeStartKey, rangeEndKey = encodeRangeKey(rangeStartKey), encodeRangeKey(rangeEndKey)
+	}
 	// Cut the SST file's range to fit in the restoring range.
-	if bytes.Compare(importer.rawStartKey, sstMeta.Range.GetStart()) > 0 {
-		sstMeta.Range.Start = importer.rawStartKey
+	if bytes.Compare(rangeStartKey, sstMeta.Range.GetStart()) > 0 {
+		sstMeta.Range.Start = rangeStartKey
 	}
-	if len(importer.rawEndKey) > 0 &&
-		(len(sstMeta.Range.GetEnd()) == 0 || bytes.Compare(importer.rawEndKey, sstMeta.Range.GetEnd()) <= 0) {
-		sstMeta.Range.End = importer.rawEndKey
+	if len(rangeEndKey) > 0 &&
+		(len(sstMeta.Range.GetEnd()) == 0 || bytes.Compare(rangeEndKey, sstMeta.Range.GetEnd()) <= 0) {
+		sstMeta.Range.End = rangeEndKey
 		sstMeta.EndKeyExclusive = true
 	}
 	if bytes.Compare(sstMeta.Range.GetStart(), sstMeta.Range.GetEnd()) > 0 {
@@ -660,10 +679,10 @@ func (importer *FileImporter) downloadRawKVSST(
 
 	req := &import_sstpb.DownloadRequest{
 		Sst:            sstMeta,
-		StorageBackend: importer.backend,
+		StorageBackend: backend,
 		Name:           file.GetName(),
 		RewriteRule:    rule,
-		IsRawKv:        true,
+		IsRawKv:        isRawKv,
 		CipherInfo:     cipher,
 	}
 	log.Debug("download SST", logutil.SSTMeta(&sstMeta), logutil.Region(regionInfo.Region))
@@ -673,6 +692,9 @@ func (importer *FileImporter) downloadRawKVSST(
 	for _, p := range regionInfo.Region.GetPeers() {
 		peer := p
 		eg.Go(func() error {
+			if err := importer.checkStoreCircuit(peer.GetStoreId()); err != nil {
+				return errors.Trace(err)
+			}
 			resp, err := importer.importClient.DownloadSST(ectx, peer.GetStoreId(), req)
 			if err != nil {
 				return errors.Trace(err)
@@ -694,6 +716,11 @@ func (importer *FileImporter) downloadRawKVSST(
 	}
 
 	downloadResp := atomicResp.Load().(*import_sstpb.DownloadResponse)
+	if !isRawKv {
+		sstMeta.Range.Start = TruncateTS(downloadResp.Range.GetStart())
+		sstMeta.Range.End = TruncateTS(downloadResp.Range.GetEnd())
+		return &sstMeta, nil
+	}
 	sstMeta.Range.Start = downloadResp.Range.GetStart()
 	sstMeta.Range.End = downloadResp.Range.GetEnd()
 	sstMeta.ApiVersion = apiVersion
@@ -754,6 +781,8 @@ func (importer *FileImporter) ingest(
 			return errors.Trace(berrors.ErrKVEpochNotMatch)
 		case errPb.KeyNotInRegion != nil:
 			return errors.Trace(berrors.ErrKVKeyNotInRegion)
+		case errPb.DiskFull != nil:
+			return errors.Annotate(berrors.ErrKVDiskFull, errPb.GetMessage())
 		default:
 			// Other errors like `ServerIsBusy`, `RegionNotFound`, etc. should be retryable
 			return errors.Annotatef(berrors.ErrKVIngestFailed, "ingest error %s", errPb)
@@ -766,27 +795,39 @@ func (importer *FileImporter) ingestSSTs(
 	sstMetas []*import_sstpb.SSTMeta,
 	regionInfo *split.RegionInfo,
 ) (*import_sstpb.IngestResponse, error) {
-	leader := regionInfo.Leader
-	if leader == nil {
-		leader = regionInfo.Region.GetPeers()[0]
+	if !importer.supportMultiIngest {
+		// TODO: not sure we need this check
+		if len(sstMetas) != 1 {
+			panic("do not support batch ingest")
+		}
 	}
+
+	resp, err := importclient.IngestToLeader(ctx, importer.metaClient, regionInfo,
+		func(ctx context.Context, regionInfo *split.RegionInfo, peer *metapb.Peer) (*import_sstpb.IngestResponse, error) {
+			return importer.ingestSSTsToPeer(ctx, sstMetas, regionInfo, peer)
+		})
+	return resp, errors.Trace(err)
+}
+
+func (importer *FileImporter) ingestSSTsToPeer(
+	ctx context.Context,
+	sstMetas []*import_sstpb.SSTMeta,
+	regionInfo *split.RegionInfo,
+	peer *metapb.Peer,
+) (*import_sstpb.IngestResponse, error) {
 	reqCtx := &kvrpcpb.Context{
 		RegionId:    regionInfo.Region.GetId(),
 		RegionEpoch: regionInfo.Region.GetRegionEpoch(),
-		Peer:        leader,
+		Peer:        peer,
 	}
 
 	if !importer.supportMultiIngest {
-		// TODO: not sure we need this check
-		if len(sstMetas) != 1 {
-			panic("do not support batch ingest")
-		}
 		req := &import_sstpb.IngestRequest{
 			Context: reqCtx,
 			Sst:     sstMetas[0],
 		}
-		log.Debug("ingest SST", logutil.SSTMeta(sstMetas[0]), logutil.Leader(leader))
-		resp, err := importer.importClient.IngestSST(ctx, leader.GetStoreId(), req)
+		log.Debug("ingest SST", logutil.SSTMeta(sstMetas[0]), logutil.Leader(peer))
+		resp, err := importer.importClient.IngestSST(ctx, peer.GetStoreId(), req)
 		return resp, errors.Trace(err)
 	}
 
@@ -794,8 +835,8 @@ func (importer *FileImporter) ingestSSTs(
 		Context: reqCtx,
 		Ssts:    sstMetas,
 	}
-	log.Debug("ingest SSTs", logutil.SSTMetas(sstMetas), logutil.Leader(leader))
-	resp, err := importer.importClient.MultiIngest(ctx, leader.GetStoreId(), req)
+	log.Debug("ingest SSTs", logutil.SSTMetas(sstMetas), logutil.Leader(peer))
+	resp, err := importer.importClient.MultiIngest(ctx, peer.GetStoreId(), req)
 	return resp, errors.Trace(err)
 }
 
@@ -236,6 +236,10 @@ func (r *RPCResult) StrategyForRetryGoError() RetryStrategy {
 		return StrategyGiveUp
 	}
 
+	if berrors.IsRetryable(r.Err) {
+		return StrategyFromThisRegion
+	}
+
 	// we should unwrap the error or we cannot get the write gRPC status.
 	if gRPCErr, ok := status.FromError(errors.Cause(r.Err)); ok {
 		switch gRPCErr.Code() {
@@ -0,0 +1,263 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// importSSTRetryTimes is the attempts of importing the files into a range,
+	// the longest time of them is about 90s-100s.
+	importSSTRetryTimes      = 16
+	importSSTWaitInterval    = 40 * time.Millisecond
+	importSSTMaxWaitInterval = 10 * time.Second
+
+	downloadSSTRetryTimes      = 8
+	downloadSSTWaitInterval    = 1 * time.Second
+	downloadSSTMaxWaitInterval = 4 * time.Second
+)
+
+// ImportErrorClass is the class of the errors of downloading and ingesting
+// the SST files, which decides whether and how they are retried.
+type ImportErrorClass int
+
+const (
+	// ImportErrorFatal is the error not retried.
+	ImportErrorFatal ImportErrorClass = iota
+	// ImportErrorSkipped is the error skipping the region, e.g. the range of
+	// the files in the region is empty.
+	ImportErrorSkipped
+	// ImportErrorEpochNotMatch is the error of the region changed, it's retried
+	// after scanning the regions again.
+	ImportErrorEpochNotMatch
+	// ImportErrorNotLeader is the error of the leader of the region changed.
+	ImportErrorNotLeader
+	// ImportErrorServerBusy is the error of the store being too busy to serve.
+	ImportErrorServerBusy
+	// ImportErrorTimeout is the error of the RPC timed out.
+	ImportErrorTimeout
+	// ImportErrorUnavailable is the error of the store unreachable.
+	ImportErrorUnavailable
+	// ImportErrorCircuitOpen is the error of the RPC not sent since the store
+	// failed recently, it's retried once the store is recovered.
+	ImportErrorCircuitOpen
+	// ImportErrorDiskFull is the error of the disk of the store full, it isn't
+	// retried since it can't be recovered soon.
+	ImportErrorDiskFull
+	// ImportErrorRetryable is the other retryable error, e.g. the retryable
+	// error of the external storage.
+	ImportErrorRetryable
+)
+
+var importErrorClassNames = map[ImportErrorClass]string{
+	ImportErrorFatal:         "fatal",
+	ImportErrorSkipped:       "skipped",
+	ImportErrorEpochNotMatch: "epoch not match",
+	ImportErrorNotLeader:     "not leader",
+	ImportErrorServerBusy:    "server busy",
+	ImportErrorTimeout:       "timeout",
+	ImportErrorUnavailable:   "unavailable",
+	ImportErrorCircuitOpen:   "circuit open",
+	ImportErrorDiskFull:      "disk full",
+	ImportErrorRetryable:     "retryable",
+}
+
+func (c ImportErrorClass) String() string {
+	if name, ok := importErrorClassNames[c]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// Retryable returns whether the errors of the class are retried.
+func (c ImportErrorClass) Retryable() bool {
+	switch c {
+	case ImportErrorFatal, ImportErrorSkipped, ImportErrorDiskFull:
+		return false
+	default:
+		return true
+	}
+}
+
+// diskFullMessages are the messages of the errors of the disk full returned by TiKV.
+var diskFullMessages = []string{"no space left", "disk full", "diskfull", "not enough disk space"}
+
+// ClassifyImportError returns the class of the error of downloading or
+// ingesting the SST files. The last error is classified if it contains the
+// errors of several attempts.
+func ClassifyImportError(err error) ImportErrorClass {
+	if errs := multierr.Errors(err); len(errs) > 1 {
+		err = errs[len(errs)-1]
+	}
+	cause := errors.Cause(err)
+	switch cause { // nolint:errorlint
+	case berrors.ErrKVRangeIsEmpty, berrors.ErrKVRewriteRuleNotFound:
+		return ImportErrorSkipped
+	case berrors.ErrKVEpochNotMatch:
+		return ImportErrorEpochNotMatch
+	case berrors.ErrKVNotLeader:
+		return ImportErrorNotLeader
+	case berrors.ErrKVDiskFull:
+		return ImportErrorDiskFull
+	case berrors.ErrKVStoreCircuitOpen:
+		return ImportErrorCircuitOpen
+	case context.DeadlineExceeded:
+		return ImportErrorTimeout
+	}
+	msg := strings.ToLower(err.Error())
+	for _, m := range diskFullMessages {
+		if strings.Contains(msg, m) {
+			return ImportErrorDiskFull
+		}
+	}
+	switch status.Code(cause) {
+	case codes.DeadlineExceeded:
+		return ImportErrorTimeout
+	case codes.Unavailable, codes.Aborted:
+		return ImportErrorUnavailable
+	case codes.ResourceExhausted:
+		return ImportErrorServerBusy
+	}
+	if berrors.IsRetryable(err) || utils.MessageIsRetryableStorageError(err.Error()) {
+		if strings.Contains(msg, "server is busy") {
+			return ImportErrorServerBusy
+		}
+		return ImportErrorRetryable
+	}
+	return ImportErrorFatal
+}
+
+// ImportRetryStats counts the retried errors of importing the SST files by
+// their classes.
+type ImportRetryStats struct {
+	mu     sync.Mutex
+	counts map[ImportErrorClass]int
+}
+
+// NewImportRetryStats creates an ImportRetryStats.
+func NewImportRetryStats() *ImportRetryStats {
+	return &ImportRetryStats{counts: make(map[ImportErrorClass]int)}
+}
+
+func (s *ImportRetryStats) record(class ImportErrorClass) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counts[class]++
+}
+
+// ImportRetryCount is the count of the retried errors of a class.
+type ImportRetryCount struct {
+	Class ImportErrorClass
+	Count int
+}
+
+// Counts returns the counts of the retried errors, ordered by the classes.
+func (s *ImportRetryStats) Counts() []ImportRetryCount {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	counts := make([]ImportRetryCount, 0, len(s.counts))
+	for class, count := range s.counts {
+		counts = append(counts, ImportRetryCount{Class: class, Count: count})
+	}
+	sort.Slice(counts, func(i, j int) bool { return counts[i].Class < counts[j].Class })
+	return counts
+}
+
+// GetImportRetryStats returns the counts of the errors retried by downloading
+// and ingesting the SST files.
+func (rc *Client) GetImportRetryStats() []ImportRetryCount {
+	return rc.fileImporter.retryStats.Counts()
+}
+
+// importRetryPolicy is the utils.Backoffer of downloading and ingesting the
+// SST files. It backs off exponentially with jitter, so the retries of the
+// concurrent workers are spread out, and gives up the errors not retryable at
+// once. The errors retried are counted into the stats.
+type importRetryPolicy struct {
+	attempt  int
+	delay    time.Duration
+	maxDelay time.Duration
+	stats    *ImportRetryStats
+	// jitter returns the actual delay of the backoff.
+	jitter func(time.Duration) time.Duration
+}
+
+func newImportRetryPolicy(attempt int, delay, maxDelay time.Duration, stats *ImportRetryStats) *importRetryPolicy {
+	return &importRetryPolicy{
+		attempt:  attempt,
+		delay:    delay,
+		maxDelay: maxDelay,
+		stats:    stats,
+		jitter:   equalJitter,
+	}
+}
+
+func newImportSSTRetryPolicy(stats *ImportRetryStats) *importRetryPolicy {
+	return newImportRetryPolicy(importSSTRetryTimes, importSSTWaitInterval, importSSTMaxWaitInterval, stats)
+}
+
+func newDownloadSSTRetryPolicy(stats *ImportRetryStats) *importRetryPolicy {
+	return newImportRetryPolicy(downloadSSTRetryTimes, downloadSSTWaitInterval, downloadSSTMaxWaitInterval, stats)
+}
+
+// equalJitter returns a random delay between the half of d and d.
+func equalJitter(d time.Duration) time.Duration {
+	if d <= 1 {
+		return d
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+// NextBackoff implements utils.Backoffer.
+func (p *importRetryPolicy) NextBackoff(err error) time.Duration {
+	class := ClassifyImportError(err)
+	if !class.Retryable() {
+		if class != ImportErrorSkipped {
+			log.Warn("unexpected error, stop to retry", zap.Stringer("class", class), zap.Error(err))
+		}
+		p.attempt = 0
+		return 0
+	}
+	p.stats.record(class)
+	p.attempt--
+	delay := p.delay
+	if class == ImportErrorCircuitOpen {
+		// wait the store to recover as long as possible.
+		delay = p.maxDelay
+	}
+	p.delay *= 2
+	if p.delay > p.maxDelay {
+		p.delay = p.maxDelay
+	}
+	if delay > p.maxDelay {
+		delay = p.maxDelay
+	}
+	return p.jitter(delay)
+}
+
+// Attempt implements utils.Backoffer.
+func (p *importRetryPolicy) Attempt() int {
+	return p.attempt
+}
@@ -0,0 +1,82 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/errors"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/multierr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClassifyImportError(t *testing.T) {
+	for _, c := range []struct {
+		err   error
+		class ImportErrorClass
+	}{
+		{errors.Trace(berrors.ErrKVEpochNotMatch), ImportErrorEpochNotMatch},
+		{errors.Annotate(berrors.ErrKVNotLeader, "region 1"), ImportErrorNotLeader},
+		{errors.Trace(berrors.ErrKVRangeIsEmpty), ImportErrorSkipped},
+		{errors.Annotate(berrors.ErrKVDiskFull, "store 1"), ImportErrorDiskFull},
+		{errors.New("download sst failed: No space left on device"), ImportErrorDiskFull},
+		{errors.Annotate(berrors.ErrKVStoreCircuitOpen, "store 1"), ImportErrorCircuitOpen},
+		{errors.Trace(context.DeadlineExceeded), ImportErrorTimeout},
+		{status.Error(codes.DeadlineExceeded, "timeout"), ImportErrorTimeout},
+		{status.Error(codes.Unavailable, "connection refused"), ImportErrorUnavailable},
+		{status.Error(codes.ResourceExhausted, "too many requests"), ImportErrorServerBusy},
+		{errors.Annotate(berrors.ErrKVIngestFailed, "server is busy"), ImportErrorServerBusy},
+		{errors.Annotate(berrors.ErrKVIngestFailed, "region not found"), ImportErrorRetryable},
+		{errors.New("unknown error"), ImportErrorFatal},
+		// the last error of the attempts is classified.
+		{multierr.Combine(berrors.ErrKVEpochNotMatch, berrors.ErrKVDiskFull), ImportErrorDiskFull},
+	} {
+		require.Equal(t, c.class, ClassifyImportError(c.err), c.err.Error())
+	}
+}
+
+func TestImportRetryPolicy(t *testing.T) {
+	stats := NewImportRetryStats()
+	p := newImportRetryPolicy(5, 10*time.Millisecond, 40*time.Millisecond, stats)
+	p.jitter = func(d time.Duration) time.Duration { return d }
+
+	// the delay doubles until the max delay.
+	require.Equal(t, 10*time.Millisecond, p.NextBackoff(berrors.ErrKVEpochNotMatch))
+	require.Equal(t, 20*time.Millisecond, p.NextBackoff(berrors.ErrKVNotLeader))
+	require.Equal(t, 40*time.Millisecond, p.NextBackoff(berrors.ErrKVEpochNotMatch))
+	require.Equal(t, 40*time.Millisecond, p.NextBackoff(berrors.ErrKVEpochNotMatch))
+	require.Equal(t, 1, p.Attempt())
+	require.Equal(t, []ImportRetryCount{
+		{Class: ImportErrorEpochNotMatch, Count: 3},
+		{Class: ImportErrorNotLeader, Count: 1},
+	}, stats.Counts())
+
+	// the store of the open circuit is waited for the max delay.
+	p = newImportRetryPolicy(5, 10*time.Millisecond, 40*time.Millisecond, stats)
+	p.jitter = func(d time.Duration) time.Duration { return d }
+	require.Equal(t, 40*time.Millisecond, p.NextBackoff(berrors.ErrKVStoreCircuitOpen))
+	require.Equal(t, 20*time.Millisecond, p.NextBackoff(berrors.ErrKVEpochNotMatch))
+
+	// the errors not retryable are given up at once, and not counted.
+	require.Equal(t, time.Duration(0), p.NextBackoff(berrors.ErrKVDiskFull))
+	require.Equal(t, 0, p.Attempt())
+	require.Equal(t, []ImportRetryCount{
+		{Class: ImportErrorEpochNotMatch, Count: 4},
+		{Class: ImportErrorNotLeader, Count: 1},
+		{Class: ImportErrorCircuitOpen, Count: 1},
+	}, stats.Counts())
+}
+
+func TestEqualJitter(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		d := equalJitter(time.Second)
+		require.GreaterOrEqual(t, d, 500*time.Millisecond)
+		require.LessOrEqual(t, d, time.Second)
+	}
+	require.Equal(t, time.Duration(0), equalJitter(0))
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/pingcap/kvproto/pkg/errorpb"
 	"github.com/pingcap/kvproto/pkg/import_sstpb"
 	"github.com/pingcap/kvproto/pkg/metapb"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
 	"github.com/pingcap/tidb/br/pkg/restore"
 	"github.com/pingcap/tidb/br/pkg/restore/split"
 	"github.com/pingcap/tidb/br/pkg/utils"
@@ -318,6 +319,10 @@ func TestWrappedError(t *testing.T) {
 	require.Equal(t, result.StrategyForRetry(), restore.StrategyFromThisRegion)
 	result = restore.RPCResultFromError(errors.Trace(status.Error(codes.Unknown, "the server said something hard to understand")))
 	require.Equal(t, result.StrategyForRetry(), restore.StrategyGiveUp)
+	result = restore.RPCResultFromError(errors.Annotate(berrors.ErrKVEpochNotMatch, "failed to apply kv file"))
+	require.Equal(t, result.StrategyForRetry(), restore.StrategyFromThisRegion)
+	result = restore.RPCResultFromError(errors.Annotate(berrors.ErrKVRewriteRuleNotFound, "failed to apply kv file"))
+	require.Equal(t, result.StrategyForRetry(), restore.StrategyGiveUp)
 }
 
 func envInt(name string, def int) int {
@@ -0,0 +1,118 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/tablecodec"
+)
+
+// KeyspaceRewrite describes restoring the data backed up from a keyspace, or
+// from a cluster without keyspaces, into a keyspace of a multi-tenant cluster.
+//
+// The keys of the backed up files are stripped of the source keyspace, so the
+// files are grouped and matched with the rewrite rules as usual. The new
+// prefixes of the rewrite rules are in the target keyspace, so the regions are
+// split and scanned in it, and the old prefixes sent to TiKV are encoded in the
+// source keyspace again to match the keys in the SST files.
+type KeyspaceRewrite struct {
+	// Source is the keyspace the backup is taken from, it's nil if the backup
+	// is taken from a cluster without keyspaces.
+	Source *uint32 `json:"source-keyspace-id,omitempty" toml:"source-keyspace-id"`
+	// Target is the keyspace to restore into.
+	Target uint32 `json:"target-keyspace-id" toml:"target-keyspace-id"`
+}
+
+// validate checks the keyspace IDs are in range.
+func (k *KeyspaceRewrite) validate() error {
+	if k.Source != nil && *k.Source > tablecodec.MaxKeyspaceID {
+		return errors.Annotatef(berrors.ErrInvalidArgument,
+			"source keyspace ID %d is out of range [0, %d]", *k.Source, tablecodec.MaxKeyspaceID)
+	}
+	if k.Target > tablecodec.MaxKeyspaceID {
+		return errors.Annotatef(berrors.ErrInvalidArgument,
+			"target keyspace ID %d is out of range [0, %d]", k.Target, tablecodec.MaxKeyspaceID)
+	}
+	return nil
+}
+
+// stripFiles removes the source keyspace from the keys of the files.
+func (k *KeyspaceRewrite) stripFiles(files []*backuppb.File) error {
+	if k == nil || k.Source == nil {
+		return nil
+	}
+	strip := func(file *backuppb.File, key []byte) ([]byte, error) {
+		if len(key) == 0 {
+			return key, nil
+		}
+		keyspaceID, keyInKeyspace, err := tablecodec.DecodeKeyspaceKey(key)
+		if err != nil || keyspaceID != *k.Source {
+			return nil, errors.Annotatef(berrors.ErrRestoreInvalidRange,
+				"the key of file %s isn't in the source keyspace %d", file.GetName(), *k.Source)
+		}
+		return keyInKeyspace, nil
+	}
+	for _, file := range files {
+		startKey, err := strip(file, file.GetStartKey())
+		if err != nil {
+			return errors.Trace(err)
+		}
+		endKey, err := strip(file, file.GetEndKey())
+		if err != nil {
+			return errors.Trace(err)
+		}
+		file.StartKey, file.EndKey = startKey, endKey
+	}
+	return nil
+}
+
+// encodeRules returns the rules whose new prefixes are in the target keyspace.
+func (k *KeyspaceRewrite) encodeRules(rules *RewriteRules) (*RewriteRules, error) {
+	if k == nil || rules == nil {
+		return rules, nil
+	}
+	encoded, err := rules.EncodeTargetKeyspace(k.Target)
+	return encoded, errors.Trace(err)
+}
+
+// encodeOldKeyPrefix encodes the old prefix of a rule in the source keyspace,
+// which is the prefix of the keys in the SST files.
+func (k *KeyspaceRewrite) encodeOldKeyPrefix(prefix []byte) ([]byte, error) {
+	if k == nil || k.Source == nil {
+		return prefix, nil
+	}
+	encoded, err := tablecodec.EncodeKeyspaceKey(*k.Source, prefix)
+	return encoded, errors.Trace(err)
+}
+
+// SetKeyspaceRewrite sets restoring the data into a keyspace. It must be called
+// after InitBackupMeta and before getting the files of the tables.
+func (rc *Client) SetKeyspaceRewrite(k *KeyspaceRewrite) error {
+	if k == nil {
+		return nil
+	}
+	if rc.IsRawKvMode() {
+		return errors.Annotate(berrors.ErrRestoreModeMismatch, "restoring raw kv into a keyspace isn't supported")
+	}
+	if err := k.validate(); err != nil {
+		return errors.Trace(err)
+	}
+	for _, db := range rc.databases {
+		for _, table := range db.Tables {
+			if err := k.stripFiles(table.Files); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+	rc.keyspaceRewrite = k
+	rc.fileImporter.keyspaceRewrite = k
+	return nil
+}
+
+// GetKeyspaceRewrite returns the keyspaces to restore between, it's nil if the
+// data isn't restored into a keyspace.
+func (rc *Client) GetKeyspaceRewrite() *KeyspaceRewrite {
+	return rc.keyspaceRewrite
+}
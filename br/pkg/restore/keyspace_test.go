@@ -0,0 +1,66 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"testing"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/kvproto/pkg/import_sstpb"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyspaceRewrite(t *testing.T) {
+	inKeyspace := func(keyspaceID uint32, key []byte) []byte {
+		encoded, err := tablecodec.EncodeKeyspaceKey(keyspaceID, key)
+		require.NoError(t, err)
+		return encoded
+	}
+	startKey := tablecodec.EncodeRowKeyWithHandle(1, kv.IntHandle(1))
+	endKey := tablecodec.EncodeRowKeyWithHandle(1, kv.IntHandle(100))
+	rules := &RewriteRules{Data: []*import_sstpb.RewriteRule{{
+		OldKeyPrefix: tablecodec.GenTableRecordPrefix(1),
+		NewKeyPrefix: tablecodec.GenTableRecordPrefix(4),
+	}}}
+
+	// from a keyspace into another one.
+	source := uint32(1)
+	k := &KeyspaceRewrite{Source: &source, Target: 2}
+	require.NoError(t, k.validate())
+	files := []*backuppb.File{{Name: "1.sst", StartKey: inKeyspace(1, startKey), EndKey: inKeyspace(1, endKey)}}
+	require.NoError(t, k.stripFiles(files))
+	require.Equal(t, []byte(startKey), files[0].StartKey)
+	require.Equal(t, []byte(endKey), files[0].EndKey)
+	require.Error(t, k.stripFiles([]*backuppb.File{{Name: "2.sst", StartKey: inKeyspace(3, startKey)}}))
+	require.Error(t, k.stripFiles([]*backuppb.File{{Name: "3.sst", StartKey: startKey}}))
+
+	encoded, err := k.encodeRules(rules)
+	require.NoError(t, err)
+	require.Equal(t, []byte(tablecodec.GenTableRecordPrefix(1)), encoded.Data[0].OldKeyPrefix)
+	require.Equal(t, inKeyspace(2, tablecodec.GenTableRecordPrefix(4)), encoded.Data[0].NewKeyPrefix)
+	require.Equal(t, encoded.Data[0], findMatchedRewriteRule(files[0], encoded))
+	oldKeyPrefix, err := k.encodeOldKeyPrefix(encoded.Data[0].OldKeyPrefix)
+	require.NoError(t, err)
+	require.Equal(t, inKeyspace(1, tablecodec.GenTableRecordPrefix(1)), oldKeyPrefix)
+
+	// from a cluster without keyspaces.
+	k = &KeyspaceRewrite{Target: 2}
+	files = []*backuppb.File{{Name: "1.sst", StartKey: startKey, EndKey: endKey}}
+	require.NoError(t, k.stripFiles(files))
+	require.Equal(t, []byte(startKey), files[0].StartKey)
+	oldKeyPrefix, err = k.encodeOldKeyPrefix(tablecodec.GenTableRecordPrefix(1))
+	require.NoError(t, err)
+	require.Equal(t, []byte(tablecodec.GenTableRecordPrefix(1)), oldKeyPrefix)
+
+	// not restored into a keyspace.
+	var nilRewrite *KeyspaceRewrite
+	encoded, err = nilRewrite.encodeRules(rules)
+	require.NoError(t, err)
+	require.Equal(t, rules, encoded)
+
+	source = tablecodec.MaxKeyspaceID + 1
+	require.Error(t, (&KeyspaceRewrite{Source: &source}).validate())
+	require.Error(t, (&KeyspaceRewrite{Target: tablecodec.MaxKeyspaceID + 1}).validate())
+}
@@ -0,0 +1,48 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/utils"
+)
+
+// KvMode is the mode of the data in a backup, which decides how it's restored.
+type KvMode int
+
+const (
+	// TiDBMode is the data of TiDB, it's restored by the tables and the keys
+	// are rewritten by the IDs of the restored tables.
+	TiDBMode KvMode = iota
+	// RawKvMode is the data written by the RawKV API of TiKV, it's restored by
+	// the key range without rewriting.
+	RawKvMode
+	// TxnKvMode is the data written by the transactional KV API of TiKV without
+	// any TiDB schemas, it's restored by the key range without rewriting.
+	TxnKvMode
+)
+
+// String implements fmt.Stringer.
+func (m KvMode) String() string {
+	switch m {
+	case RawKvMode:
+		return "raw kv"
+	case TxnKvMode:
+		return "txn kv"
+	default:
+		return "tidb"
+	}
+}
+
+// kvModeOf returns the mode of the data in the backup. The transactional
+// backup of the key ranges without any schemas is in the txn kv mode.
+func kvModeOf(backupMeta *backuppb.BackupMeta, databases map[string]*utils.Database) KvMode {
+	switch {
+	case backupMeta.IsRawKv:
+		return RawKvMode
+	case len(databases) == 0 && len(backupMeta.RawRanges) > 0:
+		return TxnKvMode
+	default:
+		return TiDBMode
+	}
+}
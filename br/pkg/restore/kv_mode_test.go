@@ -0,0 +1,26 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"testing"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKvModeOf(t *testing.T) {
+	ranges := []*backuppb.RawRange{{StartKey: []byte("a"), EndKey: []byte("z")}}
+	databases := map[string]*utils.Database{"test": {}}
+
+	require.Equal(t, RawKvMode, kvModeOf(&backuppb.BackupMeta{IsRawKv: true, RawRanges: ranges}, nil))
+	require.Equal(t, TxnKvMode, kvModeOf(&backuppb.BackupMeta{RawRanges: ranges}, nil))
+	require.Equal(t, TiDBMode, kvModeOf(&backuppb.BackupMeta{}, databases))
+	// the ranges of a backup with schemas are restored by the tables.
+	require.Equal(t, TiDBMode, kvModeOf(&backuppb.BackupMeta{RawRanges: ranges}, databases))
+
+	require.Equal(t, "raw kv", RawKvMode.String())
+	require.Equal(t, "txn kv", TxnKvMode.String())
+	require.Equal(t, "tidb", TiDBMode.String())
+}
@@ -0,0 +1,522 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/glue"
+	"github.com/pingcap/tidb/br/pkg/logutil"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/br/pkg/stream"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"go.uber.org/zap"
+)
+
+const (
+	// LogCheckpointPrefix is the prefix of the log restore checkpoint files in the log backup storage.
+	LogCheckpointPrefix     = "restore.log-checkpoint"
+	logCheckpointMetaFile   = LogCheckpointPrefix + ".meta"
+	logCheckpointIDMapFile  = LogCheckpointPrefix + ".id-map"
+	logCheckpointDataPrefix = LogCheckpointPrefix + ".data."
+
+	// LogCheckpointTable is the temporary system table in the mysql schema
+	// recording the markers of the log restore, it's dropped after the restore.
+	LogCheckpointTable = "br_log_restore_markers"
+
+	markerKindMeta      = "meta"
+	markerKindIDMap     = "id-map"
+	markerKindFile      = "file"
+	markerKindMetaBatch = "meta-batch"
+
+	// logCheckpointFlushFiles is the number of the applied log files recorded
+	// before they're persisted.
+	logCheckpointFlushFiles = 64
+)
+
+// logCheckpointMeta identifies the log restore which the checkpoint belongs to.
+type logCheckpointMeta struct {
+	ClusterID uint64 `json:"cluster-id"`
+	StartTS   uint64 `json:"start-ts"`
+	RestoreTS uint64 `json:"restore-ts"`
+}
+
+// logCheckpointData is the progress recorded in a checkpoint data file.
+type logCheckpointData struct {
+	Files       []string `json:"files,omitempty"`
+	MetaBatches []string `json:"meta-batches,omitempty"`
+}
+
+// logCheckpointIDMap is the ID mapping of the schemas after applying some meta
+// kv batches, the mapping after more batches is newer.
+type logCheckpointIDMap struct {
+	MetaBatches int                              `json:"meta-batches"`
+	DBs         map[stream.OldID]*stream.DBIDMap `json:"dbs"`
+}
+
+// logFileMarker identifies a log file, the files may share an object in the storage.
+func logFileMarker(file *backuppb.DataFileInfo) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s:%d:%d", file.Path, file.Offset, file.Length)))
+	return hex.EncodeToString(sum[:])
+}
+
+// metaBatchMarker identifies a batch of the meta kv files, the entries restored
+// with the batch are decided by the files and the filter ts.
+func metaBatchMarker(cf string, filterTS uint64, files []*backuppb.DataFileInfo) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s:%d", cf, filterTS)
+	for _, f := range files {
+		fmt.Fprintf(h, ":%s", logFileMarker(f))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// LogCheckpoint records the markers of the log files and the meta kv batches
+// fully applied by a log restore, so the restore interrupted can be resumed by
+// skipping them rather than applying them twice. The markers are persisted in
+// both the log backup storage and a temporary system table, the restore can be
+// resumed if either of them is written. The ID mapping of the schemas is
+// persisted with the meta kv batches, so the entries are rewritten to the same
+// IDs after resuming.
+//
+// The methods of a nil LogCheckpoint do nothing. Failing to write the storage
+// or the table disables it rather than failing the restore.
+type LogCheckpoint struct {
+	storage storage.ExternalStorage
+	// se is nil if the markers are only persisted in the storage.
+	se      glue.Session
+	resumed bool
+
+	mu              sync.Mutex
+	meta            logCheckpointMeta
+	storageDisabled bool
+	tableDisabled   bool
+	files           map[string]struct{}
+	metaBatches     map[string]struct{}
+	idMap           *logCheckpointIDMap
+	pending         logCheckpointData
+	seq             int
+}
+
+// NewLogCheckpoint creates the checkpoint of restoring the log from startTS to
+// restoreTS to the cluster. If resume is true, the markers recorded by the
+// previous restore are loaded, otherwise the stale checkpoint is removed.
+func NewLogCheckpoint(
+	ctx context.Context,
+	s storage.ExternalStorage,
+	se glue.Session,
+	clusterID, startTS, restoreTS uint64,
+	resume bool,
+) (*LogCheckpoint, error) {
+	cp := &LogCheckpoint{
+		storage:       s,
+		se:            se,
+		meta:          logCheckpointMeta{ClusterID: clusterID, StartTS: startTS, RestoreTS: restoreTS},
+		tableDisabled: se == nil,
+		files:         make(map[string]struct{}),
+		metaBatches:   make(map[string]struct{}),
+	}
+	exists, err := LogCheckpointExists(ctx, s, se)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if exists && resume {
+		if err := cp.load(ctx); err != nil {
+			return nil, errors.Trace(err)
+		}
+		cp.resumed = true
+		log.Info("resume the log restore from the checkpoint",
+			zap.Int("applied-files", len(cp.files)), zap.Int("applied-meta-batches", len(cp.metaBatches)))
+	} else {
+		if resume {
+			log.Warn("no log restore checkpoint found, start a new log restore")
+		}
+		if exists {
+			log.Info("remove the stale checkpoint of the previous log restore")
+			if err := cp.Remove(ctx); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+	}
+	cp.init(ctx)
+	return cp, nil
+}
+
+// LogCheckpointExists returns whether the checkpoint of a log restore exists in
+// either the storage or the system table, the session can be nil.
+func LogCheckpointExists(ctx context.Context, s storage.ExternalStorage, se glue.Session) (bool, error) {
+	exists, err := s.FileExists(ctx, logCheckpointMetaFile)
+	if err != nil || exists || se == nil {
+		return exists, errors.Trace(err)
+	}
+	rows, err := readMarkers(ctx, se, markerKindMeta)
+	return len(rows) > 0, errors.Trace(err)
+}
+
+type markerRow struct {
+	kind   string
+	marker string
+	value  string
+}
+
+// readMarkers reads the markers of the kinds from the system table, it returns
+// nothing if the table doesn't exist.
+func readMarkers(ctx context.Context, se glue.Session, kinds ...string) ([]markerRow, error) {
+	exec, ok := se.GetSessionCtx().(sqlexec.RestrictedSQLExecutor)
+	if !ok {
+		return nil, errors.New("the session doesn't support restricted SQL")
+	}
+	sql := fmt.Sprintf("SELECT kind, marker, value FROM mysql.%s", LogCheckpointTable)
+	args := make([]interface{}, 0, len(kinds))
+	if len(kinds) > 0 {
+		sql += " WHERE kind IN (%?" + strings.Repeat(", %?", len(kinds)-1) + ")"
+		for _, kind := range kinds {
+			args = append(args, kind)
+		}
+	}
+	rows, _, err := exec.ExecRestrictedSQL(kv.WithInternalSourceType(ctx, kv.InternalTxnBR), nil, sql, args...)
+	if err != nil {
+		if infoschema.ErrTableNotExists.Equal(errors.Cause(err)) {
+			return nil, nil
+		}
+		return nil, errors.Trace(err)
+	}
+	markers := make([]markerRow, 0, len(rows))
+	for _, row := range rows {
+		markers = append(markers, markerRow{kind: row.GetString(0), marker: row.GetString(1), value: row.GetString(2)})
+	}
+	return markers, nil
+}
+
+func (cp *LogCheckpoint) checkMeta(content []byte, source string) error {
+	var meta logCheckpointMeta
+	if err := json.Unmarshal(content, &meta); err != nil {
+		return errors.Annotatef(err, "invalid log restore checkpoint meta in %s", source)
+	}
+	if meta != cp.meta {
+		return errors.Annotatef(berrors.ErrInvalidArgument,
+			"the checkpoint in %s belongs to the log restore from %d to %d to the cluster %d, "+
+				"restore without resuming to start a new restore", source, meta.StartTS, meta.RestoreTS, meta.ClusterID)
+	}
+	return nil
+}
+
+func (cp *LogCheckpoint) mergeIDMap(content []byte, source string) error {
+	idMap := &logCheckpointIDMap{}
+	if err := json.Unmarshal(content, idMap); err != nil {
+		return errors.Annotatef(err, "invalid id map of the log restore checkpoint in %s", source)
+	}
+	if cp.idMap == nil || idMap.MetaBatches > cp.idMap.MetaBatches {
+		cp.idMap = idMap
+	}
+	return nil
+}
+
+// load loads the markers from both the storage and the system table.
+func (cp *LogCheckpoint) load(ctx context.Context) error {
+	if err := cp.loadFromStorage(ctx); err != nil {
+		return errors.Trace(err)
+	}
+	if cp.se == nil {
+		return nil
+	}
+	rows, err := readMarkers(ctx, cp.se)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	source := "mysql." + LogCheckpointTable
+	for _, row := range rows {
+		switch row.kind {
+		case markerKindMeta:
+			err = cp.checkMeta([]byte(row.value), source)
+		case markerKindIDMap:
+			err = cp.mergeIDMap([]byte(row.value), source)
+		case markerKindFile:
+			cp.files[row.marker] = struct{}{}
+		case markerKindMetaBatch:
+			cp.metaBatches[row.marker] = struct{}{}
+		}
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func (cp *LogCheckpoint) loadFromStorage(ctx context.Context) error {
+	exists, err := cp.storage.FileExists(ctx, logCheckpointMetaFile)
+	if err != nil || !exists {
+		return errors.Trace(err)
+	}
+	content, err := cp.storage.ReadFile(ctx, logCheckpointMetaFile)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := cp.checkMeta(content, logCheckpointMetaFile); err != nil {
+		return errors.Trace(err)
+	}
+	exists, err = cp.storage.FileExists(ctx, logCheckpointIDMapFile)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if exists {
+		content, err := cp.storage.ReadFile(ctx, logCheckpointIDMapFile)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err := cp.mergeIDMap(content, logCheckpointIDMapFile); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return cp.storage.WalkDir(ctx, &storage.WalkOption{ObjPrefix: logCheckpointDataPrefix}, func(name string, _ int64) error {
+		// the sidecars of the checksums are walked if the storage isn't wrapped by WithChecksum.
+		if storage.IsChecksumFile(name) {
+			return nil
+		}
+		content, err := cp.storage.ReadFile(ctx, name)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		var data logCheckpointData
+		if err := json.Unmarshal(content, &data); err != nil {
+			return errors.Annotatef(err, "invalid log restore checkpoint data %s", name)
+		}
+		for _, f := range data.Files {
+			cp.files[f] = struct{}{}
+		}
+		for _, b := range data.MetaBatches {
+			cp.metaBatches[b] = struct{}{}
+		}
+		return nil
+	})
+}
+
+// init writes the meta of the checkpoint to the storage and the system table.
+func (cp *LogCheckpoint) init(ctx context.Context) {
+	content, err := json.Marshal(cp.meta)
+	if err != nil {
+		log.Warn("failed to encode the log restore checkpoint", logutil.ShortError(err))
+		cp.storageDisabled, cp.tableDisabled = true, true
+		return
+	}
+	if err := cp.storage.WriteFile(ctx, logCheckpointMetaFile, content); err != nil {
+		cp.disableStorage(err)
+	}
+	if cp.tableDisabled {
+		return
+	}
+	err = cp.se.ExecuteInternal(ctx, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS mysql.%s (
+		kind VARCHAR(16) NOT NULL,
+		marker VARCHAR(64) NOT NULL,
+		value LONGTEXT NOT NULL,
+		PRIMARY KEY (kind, marker)
+	)`, LogCheckpointTable))
+	if err == nil {
+		err = cp.replaceMarkers(ctx, markerRow{kind: markerKindMeta, value: string(content)})
+	}
+	if err != nil {
+		cp.disableTable(err)
+	}
+}
+
+func (cp *LogCheckpoint) disableStorage(err error) {
+	cp.storageDisabled = true
+	log.Warn("failed to write the log restore checkpoint to the storage", logutil.ShortError(err))
+	if cp.tableDisabled {
+		log.Warn("the log restore can't be resumed")
+	}
+}
+
+func (cp *LogCheckpoint) disableTable(err error) {
+	cp.tableDisabled = true
+	log.Warn("failed to write the log restore checkpoint to the system table",
+		zap.String("table", LogCheckpointTable), logutil.ShortError(err))
+	if cp.storageDisabled {
+		log.Warn("the log restore can't be resumed")
+	}
+}
+
+func (cp *LogCheckpoint) replaceMarkers(ctx context.Context, rows ...markerRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	args := make([]interface{}, 0, len(rows)*3)
+	for _, row := range rows {
+		args = append(args, row.kind, row.marker, row.value)
+	}
+	sql := fmt.Sprintf("REPLACE INTO mysql.%s (kind, marker, value) VALUES (%%?, %%?, %%?)", LogCheckpointTable) +
+		strings.Repeat(", (%?, %?, %?)", len(rows)-1)
+	return errors.Trace(cp.se.ExecuteInternal(ctx, sql, args...))
+}
+
+// Resumed returns whether the log restore is resumed from a checkpoint.
+func (cp *LogCheckpoint) Resumed() bool {
+	return cp != nil && cp.resumed
+}
+
+// IDMap returns the ID mapping of the schemas recorded by the previous restore,
+// it's nil if no meta kv batches are applied.
+func (cp *LogCheckpoint) IDMap() map[stream.OldID]*stream.DBIDMap {
+	if cp == nil {
+		return nil
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	if cp.idMap == nil {
+		return nil
+	}
+	return cp.idMap.DBs
+}
+
+// IsFileApplied returns whether the log file is applied by the previous restore.
+func (cp *LogCheckpoint) IsFileApplied(file *backuppb.DataFileInfo) bool {
+	if cp == nil {
+		return false
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	_, ok := cp.files[logFileMarker(file)]
+	return ok
+}
+
+// IsMetaBatchApplied returns whether the meta kv batch is applied by the previous restore.
+func (cp *LogCheckpoint) IsMetaBatchApplied(marker string) bool {
+	if cp == nil {
+		return false
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	_, ok := cp.metaBatches[marker]
+	return ok
+}
+
+// RecordFile records the applied log file, the files are persisted in batches
+// or on the next Flush.
+func (cp *LogCheckpoint) RecordFile(ctx context.Context, file *backuppb.DataFileInfo) {
+	if cp == nil {
+		return
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	marker := logFileMarker(file)
+	if _, ok := cp.files[marker]; ok {
+		return
+	}
+	cp.files[marker] = struct{}{}
+	cp.pending.Files = append(cp.pending.Files, marker)
+	if len(cp.pending.Files) >= logCheckpointFlushFiles {
+		cp.flush(ctx)
+	}
+}
+
+// RecordMetaBatch records the applied meta kv batch and the ID mapping of the
+// schemas after applying it, they're persisted immediately. The mapping is
+// persisted before the batch, so a batch is never skipped without the IDs it
+// allocated.
+func (cp *LogCheckpoint) RecordMetaBatch(ctx context.Context, marker string, idMap map[stream.OldID]*stream.DBIDMap) {
+	if cp == nil {
+		return
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	if _, ok := cp.metaBatches[marker]; ok {
+		return
+	}
+	cp.metaBatches[marker] = struct{}{}
+	cp.idMap = &logCheckpointIDMap{MetaBatches: len(cp.metaBatches), DBs: idMap}
+	content, err := json.Marshal(cp.idMap)
+	if err != nil {
+		log.Warn("failed to encode the id map of the log restore", logutil.ShortError(err))
+		return
+	}
+	if !cp.storageDisabled {
+		if err := cp.storage.WriteFile(ctx, logCheckpointIDMapFile, content); err != nil {
+			cp.disableStorage(err)
+		}
+	}
+	if !cp.tableDisabled {
+		if err := cp.replaceMarkers(ctx, markerRow{kind: markerKindIDMap, value: string(content)}); err != nil {
+			cp.disableTable(err)
+		}
+	}
+	cp.pending.MetaBatches = append(cp.pending.MetaBatches, marker)
+	cp.flush(ctx)
+}
+
+// Flush persists the recorded markers.
+func (cp *LogCheckpoint) Flush(ctx context.Context) {
+	if cp == nil {
+		return
+	}
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	cp.flush(ctx)
+}
+
+func (cp *LogCheckpoint) flush(ctx context.Context) {
+	if len(cp.pending.Files)+len(cp.pending.MetaBatches) == 0 {
+		return
+	}
+	if !cp.storageDisabled {
+		content, err := json.Marshal(cp.pending)
+		if err == nil {
+			cp.seq++
+			name := fmt.Sprintf("%s%d.%06d", logCheckpointDataPrefix, time.Now().UnixNano(), cp.seq)
+			err = cp.storage.WriteFile(ctx, name, content)
+		}
+		if err != nil {
+			cp.disableStorage(err)
+		}
+	}
+	if !cp.tableDisabled {
+		rows := make([]markerRow, 0, len(cp.pending.Files)+len(cp.pending.MetaBatches))
+		for _, f := range cp.pending.Files {
+			rows = append(rows, markerRow{kind: markerKindFile, marker: f})
+		}
+		for _, b := range cp.pending.MetaBatches {
+			rows = append(rows, markerRow{kind: markerKindMetaBatch, marker: b})
+		}
+		if err := cp.replaceMarkers(ctx, rows...); err != nil {
+			cp.disableTable(err)
+		}
+	}
+	cp.pending = logCheckpointData{}
+}
+
+// Remove removes the checkpoint from the storage and drops the system table,
+// it's called after the log restore finishes.
+func (cp *LogCheckpoint) Remove(ctx context.Context) error {
+	if cp == nil {
+		return nil
+	}
+	names := make([]string, 0)
+	err := cp.storage.WalkDir(ctx, &storage.WalkOption{ObjPrefix: LogCheckpointPrefix}, func(name string, _ int64) error {
+		names = append(names, name)
+		return nil
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, name := range names {
+		if err := cp.storage.DeleteFile(ctx, name); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if cp.se == nil {
+		return nil
+	}
+	return errors.Trace(cp.se.ExecuteInternal(ctx, fmt.Sprintf("DROP TABLE IF EXISTS mysql.%s", LogCheckpointTable)))
+}
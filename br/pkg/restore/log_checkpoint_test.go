@@ -0,0 +1,91 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/br/pkg/stream"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	s, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	f1 := &backuppb.DataFileInfo{Path: "v1/1.log", Offset: 0, Length: 100}
+	// the files in the same object are identified by their ranges.
+	f2 := &backuppb.DataFileInfo{Path: "v1/1.log", Offset: 100, Length: 100}
+	batch := metaBatchMarker(stream.DefaultCF, 42, []*backuppb.DataFileInfo{f1})
+	require.NotEqual(t, batch, metaBatchMarker(stream.WriteCF, 42, []*backuppb.DataFileInfo{f1}))
+	require.NotEqual(t, batch, metaBatchMarker(stream.DefaultCF, 43, []*backuppb.DataFileInfo{f1}))
+	idMap := map[stream.OldID]*stream.DBIDMap{
+		1: {NewID: 101, Tables: map[stream.OldID]*stream.TableIDMap{2: {NewID: 102}}},
+	}
+
+	// resuming without a checkpoint starts a new restore.
+	exists, err := LogCheckpointExists(ctx, s, nil)
+	require.NoError(t, err)
+	require.False(t, exists)
+	cp, err := NewLogCheckpoint(ctx, s, nil, 1, 100, 200, true)
+	require.NoError(t, err)
+	require.False(t, cp.Resumed())
+	require.Nil(t, cp.IDMap())
+	cp.RecordMetaBatch(ctx, batch, idMap)
+	cp.RecordFile(ctx, f1)
+	cp.Flush(ctx)
+	// the files not flushed are lost.
+	cp.RecordFile(ctx, f2)
+
+	exists, err = LogCheckpointExists(ctx, s, nil)
+	require.NoError(t, err)
+	require.True(t, exists)
+	cp, err = NewLogCheckpoint(ctx, s, nil, 1, 100, 200, true)
+	require.NoError(t, err)
+	require.True(t, cp.Resumed())
+	require.True(t, cp.IsMetaBatchApplied(batch))
+	require.True(t, cp.IsFileApplied(f1))
+	require.False(t, cp.IsFileApplied(f2))
+	require.Equal(t, idMap, cp.IDMap())
+
+	// the files are persisted in batches.
+	for i := 0; i < logCheckpointFlushFiles; i++ {
+		cp.RecordFile(ctx, &backuppb.DataFileInfo{Path: fmt.Sprintf("v1/%d.log", i+2)})
+	}
+	cp, err = NewLogCheckpoint(ctx, s, nil, 1, 100, 200, true)
+	require.NoError(t, err)
+	require.True(t, cp.IsFileApplied(&backuppb.DataFileInfo{Path: "v1/2.log"}))
+
+	// the checkpoint of another restore can't be resumed.
+	_, err = NewLogCheckpoint(ctx, s, nil, 1, 100, 300, true)
+	require.Error(t, err)
+	_, err = NewLogCheckpoint(ctx, s, nil, 2, 100, 200, true)
+	require.Error(t, err)
+
+	// restoring without resuming removes the stale checkpoint.
+	cp, err = NewLogCheckpoint(ctx, s, nil, 1, 100, 300, false)
+	require.NoError(t, err)
+	require.False(t, cp.Resumed())
+	require.False(t, cp.IsFileApplied(f1))
+	require.Nil(t, cp.IDMap())
+	require.NoError(t, cp.Remove(ctx))
+	exists, err = LogCheckpointExists(ctx, s, nil)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// a nil checkpoint does nothing.
+	var nilCp *LogCheckpoint
+	require.False(t, nilCp.Resumed())
+	require.False(t, nilCp.IsFileApplied(f1))
+	require.False(t, nilCp.IsMetaBatchApplied(batch))
+	require.Nil(t, nilCp.IDMap())
+	nilCp.RecordFile(ctx, f1)
+	nilCp.RecordMetaBatch(ctx, batch, idMap)
+	nilCp.Flush(ctx)
+	require.NoError(t, nilCp.Remove(ctx))
+}
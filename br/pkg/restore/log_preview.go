@@ -0,0 +1,206 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/stream"
+	"github.com/pingcap/tidb/parser/model"
+	filter "github.com/pingcap/tidb/util/table-filter"
+	"go.uber.org/zap"
+)
+
+// ddlJobHistoryKeyPrefix prefixes the encoded keys of the DDL job history in
+// the meta kv files of the log backup.
+const ddlJobHistoryKeyPrefix = "mDDLJobH"
+
+// LogDDLJob is a DDL job in the log backup replayed by the point restore.
+type LogDDLJob struct {
+	JobID      int64  `json:"job-id"`
+	Type       string `json:"type"`
+	DB         string `json:"db"`
+	Table      string `json:"table,omitempty"`
+	FinishedTS uint64 `json:"finished-ts"`
+	Query      string `json:"query,omitempty"`
+}
+
+// LogRestorePreview is what the point restore would change up to the restore
+// ts, it's reported before any data is written so the restore point can be
+// confirmed.
+type LogRestorePreview struct {
+	StartTS   uint64 `json:"start-ts"`
+	RestoreTS uint64 `json:"restore-ts"`
+	// DDLJobs are the DDL jobs finished between the start ts and the restore
+	// ts, ordered by the finished ts.
+	DDLJobs         []LogDDLJob `json:"ddl-jobs"`
+	CreatedDBs      []string    `json:"created-dbs,omitempty"`
+	DroppedDBs      []string    `json:"dropped-dbs,omitempty"`
+	CreatedTables   []string    `json:"created-tables,omitempty"`
+	DroppedTables   []string    `json:"dropped-tables,omitempty"`
+	TruncatedTables []string    `json:"truncated-tables,omitempty"`
+	MetaFiles       int         `json:"meta-files"`
+	DataFiles       int         `json:"data-files"`
+	DataBytes       uint64      `json:"data-bytes"`
+}
+
+// PreviewLogRestore scans the meta kv files of the log backup for the DDL jobs
+// which would be replayed by restoring up to the restore ts, without writing
+// anything to the cluster. The restore range ts must be set before.
+func (rc *Client) PreviewLogRestore(
+	ctx context.Context,
+	dataFiles, metaFiles []*backuppb.DataFileInfo,
+	tableFilter filter.Filter,
+) (*LogRestorePreview, error) {
+	jobs := make([]*model.Job, 0)
+	for _, f := range metaFiles {
+		// the DDL job history is only decodable in the default CF.
+		if f.Cf != stream.DefaultCF || f.Type == backuppb.FileType_Delete {
+			continue
+		}
+		entries, err := rc.readAllEntries(ctx, f)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		for _, entry := range entries {
+			if !strings.HasPrefix(string(entry.e.Key), ddlJobHistoryKeyPrefix) {
+				continue
+			}
+			job := &model.Job{}
+			if err := job.Decode(entry.e.Value); err != nil {
+				log.Debug("failed to decode the job", zap.Uint64("key-ts", entry.ts), zap.Error(err))
+				continue
+			}
+			jobs = append(jobs, job)
+		}
+	}
+
+	preview := newLogRestorePreview(jobs, rc.startTS, rc.restoreTS, tableFilter)
+	preview.MetaFiles = len(metaFiles)
+	preview.DataFiles = len(dataFiles)
+	for _, f := range dataFiles {
+		preview.DataBytes += f.Length
+	}
+	return preview, nil
+}
+
+func newLogRestorePreview(jobs []*model.Job, startTS, restoreTS uint64, tableFilter filter.Filter) *LogRestorePreview {
+	p := &LogRestorePreview{StartTS: startTS, RestoreTS: restoreTS, DDLJobs: make([]LogDDLJob, 0)}
+	seen := make(map[int64]struct{}, len(jobs))
+	sort.SliceStable(jobs, func(i, j int) bool { return jobFinishedTS(jobs[i]) < jobFinishedTS(jobs[j]) })
+	for _, job := range jobs {
+		finishedTS := jobFinishedTS(job)
+		if finishedTS < startTS || finishedTS > restoreTS || job.IsCancelled() || job.IsRollbackDone() {
+			continue
+		}
+		// the job may be written into the history more than once by the retried transactions.
+		if _, ok := seen[job.ID]; ok {
+			continue
+		}
+		seen[job.ID] = struct{}{}
+		db, table := jobDBName(job), jobTableName(job)
+		if table == "" {
+			if db != "" && !tableFilter.MatchSchema(db) {
+				continue
+			}
+		} else if !tableFilter.MatchTable(db, table) {
+			continue
+		}
+		p.DDLJobs = append(p.DDLJobs, LogDDLJob{
+			JobID:      job.ID,
+			Type:       job.Type.String(),
+			DB:         db,
+			Table:      table,
+			FinishedTS: finishedTS,
+			Query:      job.Query,
+		})
+		p.collect(job, db, table, tableFilter)
+	}
+	return p
+}
+
+func (p *LogRestorePreview) collect(job *model.Job, db, table string, tableFilter filter.Filter) {
+	name := fmt.Sprintf("`%s`.`%s`", db, table)
+	switch job.Type {
+	case model.ActionCreateSchema:
+		p.CreatedDBs = append(p.CreatedDBs, fmt.Sprintf("`%s`", db))
+	case model.ActionDropSchema:
+		p.DroppedDBs = append(p.DroppedDBs, fmt.Sprintf("`%s`", db))
+	case model.ActionCreateTable, model.ActionCreateView, model.ActionCreateSequence, model.ActionRecoverTable:
+		p.CreatedTables = append(p.CreatedTables, name)
+	case model.ActionCreateTables:
+		for _, info := range job.BinlogInfo.MultipleTableInfos {
+			if !tableFilter.MatchTable(db, info.Name.O) {
+				continue
+			}
+			p.CreatedTables = append(p.CreatedTables, fmt.Sprintf("`%s`.`%s`", db, info.Name.O))
+		}
+	case model.ActionDropTable, model.ActionDropView, model.ActionDropSequence:
+		p.DroppedTables = append(p.DroppedTables, name)
+	case model.ActionTruncateTable, model.ActionTruncateTablePartition:
+		p.TruncatedTables = append(p.TruncatedTables, name)
+	}
+}
+
+func jobFinishedTS(job *model.Job) uint64 {
+	if job.BinlogInfo == nil {
+		return 0
+	}
+	return job.BinlogInfo.FinishedTS
+}
+
+func jobDBName(job *model.Job) string {
+	if job.SchemaName != "" {
+		return job.SchemaName
+	}
+	if job.BinlogInfo != nil && job.BinlogInfo.DBInfo != nil {
+		return job.BinlogInfo.DBInfo.Name.O
+	}
+	return ""
+}
+
+func jobTableName(job *model.Job) string {
+	if job.TableName != "" {
+		return job.TableName
+	}
+	if job.BinlogInfo != nil && job.BinlogInfo.TableInfo != nil {
+		return job.BinlogInfo.TableInfo.Name.O
+	}
+	return ""
+}
+
+// Table returns the DDL jobs of the preview in a text table, followed by the
+// databases and the tables changed by them.
+func (p *LogRestorePreview) Table() string {
+	var buf strings.Builder
+	w := tabwriter.NewWriter(&buf, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "JOB ID\tTYPE\tDATABASE\tTABLE\tFINISHED TS\tQUERY")
+	for _, job := range p.DDLJobs {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d\t%s\n", job.JobID, job.Type, job.DB, job.Table, job.FinishedTS, job.Query)
+	}
+	_ = w.Flush()
+	for _, changed := range []struct {
+		name  string
+		items []string
+	}{
+		{"created databases", p.CreatedDBs},
+		{"dropped databases", p.DroppedDBs},
+		{"created tables", p.CreatedTables},
+		{"dropped tables", p.DroppedTables},
+		{"truncated tables", p.TruncatedTables},
+	} {
+		if len(changed.items) > 0 {
+			fmt.Fprintf(&buf, "%s: %s\n", changed.name, strings.Join(changed.items, ", "))
+		}
+	}
+	fmt.Fprintf(&buf, "%d ddl jobs, %d meta files, %d data files of %d bytes to restore\n",
+		len(p.DDLJobs), p.MetaFiles, p.DataFiles, p.DataBytes)
+	return buf.String()
+}
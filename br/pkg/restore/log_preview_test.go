@@ -0,0 +1,63 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tidb/parser/model"
+	filter "github.com/pingcap/tidb/util/table-filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogRestorePreview(t *testing.T) {
+	job := func(id int64, tp model.ActionType, state model.JobState, db, table string, finishedTS uint64) *model.Job {
+		return &model.Job{
+			ID: id, Type: tp, State: state, SchemaName: db, TableName: table,
+			Query:      tp.String() + " " + table,
+			BinlogInfo: &model.HistoryInfo{FinishedTS: finishedTS},
+		}
+	}
+	jobs := []*model.Job{
+		job(5, model.ActionTruncateTable, model.JobStateSynced, "test", "t1", 50),
+		job(1, model.ActionCreateSchema, model.JobStateSynced, "test", "", 10),
+		job(2, model.ActionCreateTable, model.JobStateSynced, "test", "t1", 20),
+		// written into the history again by the retried transaction.
+		job(2, model.ActionCreateTable, model.JobStateSynced, "test", "t1", 20),
+		job(3, model.ActionCreateTable, model.JobStateRollbackDone, "test", "t2", 30),
+		job(4, model.ActionDropTable, model.JobStateSynced, "other", "t3", 40),
+		// finished before the start ts or after the restore ts.
+		job(6, model.ActionDropTable, model.JobStateSynced, "test", "t4", 5),
+		job(7, model.ActionDropTable, model.JobStateSynced, "test", "t1", 100),
+	}
+	tables := job(8, model.ActionCreateTables, model.JobStateSynced, "test", "", 60)
+	tables.BinlogInfo.MultipleTableInfos = []*model.TableInfo{
+		{Name: model.NewCIStr("t5")}, {Name: model.NewCIStr("t6")},
+	}
+	jobs = append(jobs, tables)
+
+	p := newLogRestorePreview(jobs, 10, 60, filter.All())
+	ids := make([]int64, 0, len(p.DDLJobs))
+	for _, j := range p.DDLJobs {
+		ids = append(ids, j.JobID)
+	}
+	require.Equal(t, []int64{1, 2, 4, 5, 8}, ids)
+	require.Equal(t, []string{"`test`"}, p.CreatedDBs)
+	require.Equal(t, []string{"`test`.`t1`", "`test`.`t5`", "`test`.`t6`"}, p.CreatedTables)
+	require.Equal(t, []string{"`other`.`t3`"}, p.DroppedTables)
+	require.Equal(t, []string{"`test`.`t1`"}, p.TruncatedTables)
+	require.True(t, strings.HasSuffix(p.Table(), "5 ddl jobs, 0 meta files, 0 data files of 0 bytes to restore\n"))
+
+	// only the jobs of the tables matched by the filter are previewed.
+	f, err := filter.Parse([]string{"test.t1", "test.t6"})
+	require.NoError(t, err)
+	p = newLogRestorePreview(jobs, 10, 60, f)
+	ids = ids[:0]
+	for _, j := range p.DDLJobs {
+		ids = append(ids, j.JobID)
+	}
+	require.Equal(t, []int64{1, 2, 5, 8}, ids)
+	require.Equal(t, []string{"`test`.`t1`", "`test`.`t6`"}, p.CreatedTables)
+	require.Empty(t, p.DroppedTables)
+}
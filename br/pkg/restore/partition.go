@@ -0,0 +1,172 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/tablecodec"
+	"go.uber.org/zap"
+)
+
+// stagingPartitionTablePrefix prefixes the names of the tables the partitions
+// are restored into before exchanging them into the existing tables.
+const stagingPartitionTablePrefix = "_br_partition_"
+
+// PartitionRestore is a partition of a partitioned table in the backup to
+// restore into the partition of the same name of the existing table. The
+// partition is restored into a staging table first, which is exchanged with
+// the partition of the existing table after restoring.
+type PartitionRestore struct {
+	DB        string
+	Table     string
+	Partition string
+
+	// staging is the name of the table the partition is restored into.
+	staging string
+}
+
+// SetRestorePartition sets the partition of the table to restore, the whole
+// table is restored if it's empty.
+func (rc *Client) SetRestorePartition(partition string) {
+	if partition == "" {
+		rc.restorePartition = nil
+		return
+	}
+	rc.restorePartition = &PartitionRestore{Partition: partition}
+}
+
+// HasRestorePartition returns whether only a partition of the table is restored.
+func (rc *Client) HasRestorePartition() bool {
+	return rc.restorePartition != nil
+}
+
+func findPartition(info *model.PartitionInfo, name string) *model.PartitionDefinition {
+	if info == nil {
+		return nil
+	}
+	lowerName := model.NewCIStr(name).L
+	for i := range info.Definitions {
+		if info.Definitions[i].Name.L == lowerName {
+			return &info.Definitions[i]
+		}
+	}
+	return nil
+}
+
+// checkPartitionColumns checks the columns of the table in the backup are the
+// same as the existing table's, so the partition can be exchanged into it.
+func checkPartitionColumns(backup, target *model.TableInfo) error {
+	if len(backup.Columns) != len(target.Columns) {
+		return errors.Annotatef(berrors.ErrRestorePartitionMismatch,
+			"the table has %d columns in the backup but %d columns in the cluster", len(backup.Columns), len(target.Columns))
+	}
+	for i, col := range backup.Columns {
+		targetCol := target.Columns[i]
+		if col.Name.L != targetCol.Name.L || col.GetType() != targetCol.GetType() {
+			return errors.Annotatef(berrors.ErrRestorePartitionMismatch,
+				"the column %s in the backup differs from the column %s in the cluster", col.Name, targetCol.Name)
+		}
+	}
+	return nil
+}
+
+// PreparePartitionRestore replaces the table to restore with the staging table
+// of the partition. The staging table keeps the ID of the partition in the
+// backup, so the rewrite rules generated after creating it only rewrite the
+// keys of the partition, and only the files of the partition are restored.
+func (rc *Client) PreparePartitionRestore(
+	is infoschema.InfoSchema,
+	tables []*metautil.Table,
+	resumed bool,
+) ([]*metautil.Table, error) {
+	pr := rc.restorePartition
+	if rc.IsIncremental() {
+		return nil, errors.Annotate(berrors.ErrInvalidArgument, "can't restore a partition in the incremental restore")
+	}
+	if rc.HasTableRenames() {
+		return nil, errors.Annotate(berrors.ErrInvalidArgument, "can't rename the tables when restoring a partition")
+	}
+	if len(tables) != 1 {
+		return nil, errors.Annotatef(berrors.ErrInvalidArgument,
+			"a partition is restored from a table, but %d tables are matched", len(tables))
+	}
+	t := tables[0]
+	def := findPartition(t.Info.Partition, pr.Partition)
+	if def == nil {
+		return nil, errors.Annotatef(berrors.ErrInvalidArgument,
+			"the partition %s isn't found in the table `%s`.`%s` in the backup", pr.Partition, t.DB.Name, t.Info.Name)
+	}
+	target, err := is.TableByName(t.DB.Name, t.Info.Name)
+	if err != nil {
+		return nil, errors.Annotatef(berrors.ErrRestoreSchemaNotExists,
+			"the table `%s`.`%s` to restore the partition into doesn't exist", t.DB.Name, t.Info.Name)
+	}
+	targetInfo := target.Meta()
+	targetDef := findPartition(targetInfo.Partition, pr.Partition)
+	if targetDef == nil {
+		return nil, errors.Annotatef(berrors.ErrRestorePartitionMismatch,
+			"the partition %s isn't found in the table `%s`.`%s` in the cluster", pr.Partition, t.DB.Name, t.Info.Name)
+	}
+	if err := checkPartitionColumns(t.Info, targetInfo); err != nil {
+		return nil, errors.Trace(err)
+	}
+	staging := fmt.Sprintf("%s%d_%d", stagingPartitionTablePrefix, targetInfo.ID, targetDef.ID)
+	// the staging table is created by the previous restore when resuming.
+	if !resumed && is.TableExists(t.DB.Name, model.NewCIStr(staging)) {
+		return nil, errors.Annotatef(berrors.ErrInvalidArgument,
+			"the table `%s`.`%s` to restore the partition into already exists", t.DB.Name, staging)
+	}
+
+	nt := *t
+	nt.Info = t.Info.Clone()
+	nt.Info.ID = def.ID
+	nt.Info.Name = model.NewCIStr(staging)
+	nt.Info.Partition = nil
+	nt.Info.TiFlashReplica = nil
+	nt.Info.PlacementPolicyRef = nil
+	nt.Stats = nil
+	nt.Files = make([]*backuppb.File, 0)
+	var fromFiles Checksum
+	var partition Checksum
+	for _, file := range t.Files {
+		fromFiles.update(file.Crc64Xor, file.TotalKvs, file.TotalBytes)
+		if tablecodec.DecodeTableID(file.StartKey) != def.ID {
+			continue
+		}
+		partition.update(file.Crc64Xor, file.TotalKvs, file.TotalBytes)
+		nt.Files = append(nt.Files, file)
+	}
+	// the partition is verified by the checksums of its files only if they add
+	// up to the checksum of the table.
+	nt.Crc64Xor, nt.TotalKvs, nt.TotalBytes = 0, 0, 0
+	if !t.NoChecksum() && fromFiles == (Checksum{Crc64Xor: t.Crc64Xor, TotalKvs: t.TotalKvs, TotalBytes: t.TotalBytes}) {
+		nt.Crc64Xor, nt.TotalKvs, nt.TotalBytes = partition.Crc64Xor, partition.TotalKvs, partition.TotalBytes
+	}
+
+	pr.DB, pr.Table, pr.staging = t.DB.Name.O, targetInfo.Name.O, staging
+	log.Info("restore the partition into the staging table",
+		zap.Stringer("db", t.DB.Name), zap.Stringer("table", t.Info.Name),
+		zap.String("partition", pr.Partition), zap.String("staging", staging),
+		zap.Int("files", len(nt.Files)))
+	return []*metautil.Table{&nt}, nil
+}
+
+// ExchangeRestoredPartition exchanges the restored staging table with the
+// partition of the existing table, the staging table holding the data of the
+// partition replaced is dropped then.
+func (rc *Client) ExchangeRestoredPartition(ctx context.Context) error {
+	pr := rc.restorePartition
+	if pr == nil || pr.staging == "" {
+		return nil
+	}
+	return errors.Trace(rc.db.ExchangePartition(ctx, pr.DB, pr.Table, pr.Partition, pr.staging))
+}
@@ -0,0 +1,102 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"strconv"
+	"testing"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreparePartitionRestore(t *testing.T) {
+	partitioned := func(tableID int64, partIDs ...int64) *model.TableInfo {
+		col := &model.ColumnInfo{ID: 1, Name: model.NewCIStr("id"), Offset: 0, State: model.StatePublic,
+			FieldType: *types.NewFieldType(mysql.TypeLonglong)}
+		info := &model.TableInfo{
+			ID: tableID, Name: model.NewCIStr("t"), Columns: []*model.ColumnInfo{col}, State: model.StatePublic,
+			Partition: &model.PartitionInfo{Type: model.PartitionTypeRange, Expr: "`id`", Enable: true},
+		}
+		for i, id := range partIDs {
+			lessThan := strconv.Itoa((i + 1) * 10)
+			if i == len(partIDs)-1 {
+				lessThan = "MAXVALUE"
+			}
+			info.Partition.Definitions = append(info.Partition.Definitions, model.PartitionDefinition{
+				ID: id, Name: model.NewCIStr(string(rune('a'+i)) + "0"), LessThan: []string{lessThan},
+			})
+		}
+		return info
+	}
+	fileOf := func(physicalID int64, crc, kvs, bytes uint64) *backuppb.File {
+		return &backuppb.File{
+			StartKey: tablecodec.EncodeTablePrefix(physicalID),
+			Crc64Xor: crc, TotalKvs: kvs, TotalBytes: bytes,
+		}
+	}
+	db := &model.DBInfo{ID: 1, Name: model.NewCIStr("test")}
+	backupTable := &metautil.Table{
+		DB:       db,
+		Info:     partitioned(10, 11, 12),
+		Crc64Xor: 1 ^ 2, TotalKvs: 30, TotalBytes: 300,
+		Files: []*backuppb.File{fileOf(11, 1, 10, 100), fileOf(12, 2, 20, 200)},
+	}
+	is := infoschema.MockInfoSchema([]*model.TableInfo{partitioned(100, 101, 102)})
+	rc := &Client{backupMeta: &backuppb.BackupMeta{EndVersion: 100}}
+
+	require.False(t, rc.HasRestorePartition())
+	rc.SetRestorePartition("B0")
+	require.True(t, rc.HasRestorePartition())
+	tables, err := rc.PreparePartitionRestore(is, []*metautil.Table{backupTable}, false)
+	require.NoError(t, err)
+	require.Len(t, tables, 1)
+	// the staging table keeps the ID of the partition in the backup, and only
+	// the files of the partition are restored.
+	staging := tables[0]
+	require.Equal(t, int64(12), staging.Info.ID)
+	require.Equal(t, "_br_partition_100_102", staging.Info.Name.O)
+	require.Nil(t, staging.Info.Partition)
+	require.Equal(t, []*backuppb.File{backupTable.Files[1]}, staging.Files)
+	require.Equal(t, uint64(2), staging.Crc64Xor)
+	require.Equal(t, uint64(20), staging.TotalKvs)
+	require.Equal(t, uint64(200), staging.TotalBytes)
+	require.Equal(t, "t", rc.restorePartition.Table)
+	// the table in the backup isn't changed.
+	require.NotNil(t, backupTable.Info.Partition)
+	require.Equal(t, int64(10), backupTable.Info.ID)
+
+	// the partition isn't verified if the files have no checksums.
+	noFileChecksum := *backupTable
+	noFileChecksum.Files = []*backuppb.File{fileOf(11, 0, 0, 0), fileOf(12, 0, 0, 0)}
+	tables, err = rc.PreparePartitionRestore(is, []*metautil.Table{&noFileChecksum}, false)
+	require.NoError(t, err)
+	require.True(t, tables[0].NoChecksum())
+
+	// the partition must exist in both the backup and the cluster.
+	rc.SetRestorePartition("c0")
+	_, err = rc.PreparePartitionRestore(is, []*metautil.Table{backupTable}, false)
+	require.ErrorContains(t, err, "in the backup")
+	unmatched := *backupTable
+	unmatched.Info = partitioned(10, 11, 12, 13)
+	_, err = rc.PreparePartitionRestore(is, []*metautil.Table{&unmatched}, false)
+	require.ErrorContains(t, err, "in the cluster")
+
+	// the columns must be the same.
+	rc.SetRestorePartition("a0")
+	mismatched := *backupTable
+	mismatched.Info = partitioned(10, 11, 12)
+	mismatched.Info.Columns[0].SetType(mysql.TypeVarchar)
+	_, err = rc.PreparePartitionRestore(is, []*metautil.Table{&mismatched}, false)
+	require.ErrorContains(t, err, "column")
+
+	// only a table can be restored.
+	_, err = rc.PreparePartitionRestore(is, []*metautil.Table{backupTable, backupTable}, false)
+	require.Error(t, err)
+}
@@ -210,6 +210,10 @@ type tikvSender struct {
 
 	sink TableSink
 	inCh chan<- DrainResult
+	// inflight bounds the batches split but not restored yet, so splitting the
+	// next batches overlaps with restoring the current ones without running
+	// too far ahead. It's nil if the batches aren't bounded.
+	inflight chan struct{}
 
 	wg *sync.WaitGroup
 
@@ -228,11 +232,14 @@ func (b *tikvSender) RestoreBatch(ranges DrainResult) {
 }
 
 // NewTiKVSender make a sender that send restore requests to TiKV.
+// The regions of at most pipelineDepth batches are split ahead of restoring
+// the files, the batches aren't bounded if it's 0.
 func NewTiKVSender(
 	ctx context.Context,
 	cli TiKVRestorer,
 	updateCh glue.Progress,
 	splitConcurrency uint,
+	pipelineDepth uint,
 ) (BatchSender, error) {
 	inCh := make(chan DrainResult, defaultChannelSize)
 	midCh := make(chan drainResultAndDone, defaultChannelSize)
@@ -244,6 +251,9 @@ func NewTiKVSender(
 		wg:           new(sync.WaitGroup),
 		tableWaiters: new(sync.Map),
 	}
+	if pipelineDepth > 0 {
+		sender.inflight = make(chan struct{}, pipelineDepth)
+	}
 
 	sender.wg.Add(2)
 	go sender.splitWorker(ctx, inCh, midCh, splitConcurrency)
@@ -279,9 +289,11 @@ func (b *tikvSender) splitWorker(ctx context.Context,
 	}()
 
 	start := time.Now()
+	var waitRestore time.Duration
 	defer func() {
 		elapsed := time.Since(start)
 		summary.CollectDuration("split region", elapsed)
+		summary.CollectDuration("split wait for restoring", waitRestore)
 	}()
 
 	pool := utils.NewWorkerPool(concurrency, "split")
@@ -308,11 +320,21 @@ func (b *tikvSender) splitWorker(ctx context.Context,
 			//      worker 2: {Ranges: ranges 2, BlankTablesAfterSend: table 1}
 			// And worker 2 finished its job before worker 1 done. Note the table wasn't restored fully,
 			// hence the checksum would fail.
-			done := b.registerTableIsRestoring(result.TablesToSend)
+			waitStart := time.Now()
+			if !b.acquireInflight(ectx) {
+				return
+			}
+			waitRestore += time.Since(waitStart)
+			tablesDone := b.registerTableIsRestoring(result.TablesToSend)
+			done := func() {
+				tablesDone()
+				b.releaseInflight()
+			}
 			pool.ApplyOnErrorGroup(eg, func() error {
 				err := b.client.SplitRanges(ectx, result.Ranges, result.RewriteRules, b.updateCh, false)
 				if err != nil {
 					log.Error("failed on split range", rtree.ZapRanges(result.Ranges), zap.Error(err))
+					b.releaseInflight()
 					return err
 				}
 				next <- drainResultAndDone{
@@ -325,6 +347,27 @@ func (b *tikvSender) splitWorker(ctx context.Context,
 	}
 }
 
+// acquireInflight blocks till the batches split ahead are fewer than the
+// pipeline depth, it returns false if the context is done.
+func (b *tikvSender) acquireInflight(ctx context.Context) bool {
+	if b.inflight == nil {
+		return true
+	}
+	select {
+	case <-ctx.Done():
+		return false
+	case b.inflight <- struct{}{}:
+		return true
+	}
+}
+
+// releaseInflight marks a batch split ahead is restored or abandoned.
+func (b *tikvSender) releaseInflight() {
+	if b.inflight != nil {
+		<-b.inflight
+	}
+}
+
 // registerTableIsRestoring marks some tables as 'current restoring'.
 // Returning a function that mark the restore has been done.
 func (b *tikvSender) registerTableIsRestoring(ts []CreatedTable) func() {
@@ -364,6 +407,11 @@ func (b *tikvSender) restoreWorker(ctx context.Context, ranges <-chan drainResul
 			b.sink.EmitError(err)
 		}
 		b.sink.Close()
+		// release the batches split ahead but not restored, so the split worker
+		// isn't blocked by the pipeline depth and can exit.
+		for r := range ranges {
+			r.done()
+		}
 		b.wg.Done()
 		log.Info("TiKV Sender: restore worker exits.")
 	}()
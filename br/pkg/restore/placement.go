@@ -0,0 +1,154 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/ddl/placement"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/util"
+	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
+)
+
+// SetWithPlacementPolicy sets whether to restore the placement policies and the
+// placement of the databases, the tables and the partitions. Without them, the
+// objects are restored without placement. It must be called before Init.
+func (rc *Client) SetWithPlacementPolicy(withPlacementPolicy bool) {
+	rc.skipPlacementPolicy = !withPlacementPolicy
+}
+
+// CheckPlacementPolicyLabels checks the label constraints of the placement
+// policies in the backup can be satisfied by the TiKV stores of the cluster.
+// If `all` is false, only the policies referenced by the tables are checked,
+// the policies existing in the cluster aren't checked since they're not
+// created by the restore.
+func (rc *Client) CheckPlacementPolicyLabels(
+	ctx context.Context,
+	policies *sync.Map,
+	tables []*metautil.Table,
+	all bool,
+) error {
+	if !rc.supportPolicy || policies == nil {
+		return nil
+	}
+	names := make(map[string]struct{})
+	if all {
+		policies.Range(func(key, _ interface{}) bool {
+			names[key.(string)] = struct{}{}
+			return true
+		})
+	} else {
+		addRef := func(ref *model.PolicyRefInfo) {
+			if ref != nil {
+				names[ref.Name.L] = struct{}{}
+			}
+		}
+		for _, table := range tables {
+			addRef(table.DB.PlacementPolicyRef)
+			addRef(table.Info.PlacementPolicyRef)
+			if table.Info.Partition != nil {
+				for _, def := range table.Info.Partition.Definitions {
+					addRef(def.PlacementPolicyRef)
+				}
+			}
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	stores, err := util.GetAllTiKVStores(ctx, rc.pdClient, util.SkipTiFlash)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	sortedNames := make([]string, 0, len(names))
+	for name := range names {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+	for _, name := range sortedNames {
+		policy, ok := policies.Load(name)
+		if !ok {
+			continue
+		}
+		if err := checkPolicyLabels(policy.(*model.PolicyInfo), stores); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// checkPolicyLabels checks every rule of the policy has a store to place its
+// peers, the peers may be less than the rule requires if the matched stores
+// aren't enough, which only makes a warning.
+func checkPolicyLabels(policy *model.PolicyInfo, stores []*metapb.Store) error {
+	bundle, err := placement.NewBundleFromOptions(policy.PlacementSettings)
+	if err != nil {
+		return errors.Annotatef(berrors.ErrRestoreIncompatiblePlacement,
+			"invalid placement policy %s: %v", policy.Name.O, err)
+	}
+	if bundle == nil {
+		return nil
+	}
+	for _, rule := range bundle.Rules {
+		matched := 0
+		for _, store := range stores {
+			if storeMatchConstraints(store, rule.Constraints) {
+				matched++
+			}
+		}
+		if matched == 0 {
+			constraints, _ := rule.Constraints.Restore()
+			return errors.Annotatef(berrors.ErrRestoreIncompatiblePlacement,
+				"no store matches the %s constraints %s of placement policy %s",
+				rule.Role, constraints, policy.Name.O)
+		}
+		if matched < rule.Count {
+			log.Warn("the stores aren't enough for the placement policy",
+				zap.Stringer("policy", policy.Name),
+				zap.String("role", string(rule.Role)),
+				zap.Int("required", rule.Count),
+				zap.Int("matched", matched))
+		}
+	}
+	return nil
+}
+
+func storeMatchConstraints(store *metapb.Store, constraints placement.Constraints) bool {
+	for _, c := range constraints {
+		var (
+			value string
+			found bool
+		)
+		for _, label := range store.GetLabels() {
+			if label.GetKey() == c.Key {
+				value, found = label.GetValue(), true
+				break
+			}
+		}
+		var ok bool
+		switch c.Op {
+		case placement.In:
+			ok = found && slices.Contains(c.Values, value)
+		case placement.NotIn:
+			ok = !found || !slices.Contains(c.Values, value)
+		case placement.Exists:
+			ok = found
+		case placement.NotExists:
+			ok = !found
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
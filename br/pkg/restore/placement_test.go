@@ -0,0 +1,58 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/pingcap/kvproto/pkg/metapb"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/ddl/placement"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPolicyLabels(t *testing.T) {
+	newStore := func(id uint64, labels ...string) *metapb.Store {
+		store := &metapb.Store{Id: id}
+		for i := 0; i+1 < len(labels); i += 2 {
+			store.Labels = append(store.Labels, &metapb.StoreLabel{Key: labels[i], Value: labels[i+1]})
+		}
+		return store
+	}
+	stores := []*metapb.Store{
+		newStore(1, "region", "us-east-1", "zone", "a"),
+		newStore(2, "region", "us-east-1", "zone", "b"),
+		newStore(3, "region", "us-west-1"),
+	}
+
+	require.True(t, storeMatchConstraints(stores[0], placement.Constraints{
+		placement.NewConstraintDirect("region", placement.In, "us-east-1"),
+		placement.NewConstraintDirect("zone", placement.NotIn, "b"),
+		placement.NewConstraintDirect("zone", placement.Exists),
+		placement.NewConstraintDirect("disk", placement.NotExists),
+	}))
+	require.False(t, storeMatchConstraints(stores[2], placement.Constraints{
+		placement.NewConstraintDirect("zone", placement.Exists),
+	}))
+
+	for _, c := range []struct {
+		settings   *model.PlacementSettings
+		compatible bool
+	}{
+		{&model.PlacementSettings{PrimaryRegion: "us-east-1", Regions: "us-east-1,us-west-1"}, true},
+		{&model.PlacementSettings{PrimaryRegion: "eu-west-1", Regions: "eu-west-1"}, false},
+		{&model.PlacementSettings{Constraints: "[+zone=a]"}, true},
+		{&model.PlacementSettings{Constraints: "[+zone=c]"}, false},
+		{&model.PlacementSettings{LeaderConstraints: "[+region=us-west-1]", FollowerConstraints: "[-region=us-west-1]"}, true},
+		{&model.PlacementSettings{Constraints: "[+disk=ssd]"}, false},
+	} {
+		policy := &model.PolicyInfo{Name: model.NewCIStr("p"), PlacementSettings: c.settings}
+		err := checkPolicyLabels(policy, stores)
+		if c.compatible {
+			require.NoError(t, err, c.settings)
+		} else {
+			require.True(t, berrors.ErrRestoreIncompatiblePlacement.Equal(err), c.settings)
+		}
+	}
+}
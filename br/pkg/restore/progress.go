@@ -0,0 +1,203 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/glue"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/br/pkg/progress"
+	"github.com/pingcap/tidb/tablecodec"
+)
+
+// RestoreProgress tracks the progress of restoring the data of the tables. The
+// progress of a table is measured in the bytes of its files, and it's reported
+// as a sub task of the progress tree, so it's served at the status address.
+//
+// All the methods are goroutine-safe, and the methods of a nil RestoreProgress
+// do nothing.
+type RestoreProgress struct {
+	task *progress.Task
+
+	totalFiles    int64
+	filesIngested atomic.Int64
+	bytesIngested atomic.Int64
+	rangesSplit   atomic.Int64
+
+	// tables maps the physical table IDs in the backup to the progress of the tables.
+	tables map[int64]*progress.Task
+}
+
+// NewRestoreProgress creates the progress of restoring the tables.
+func NewRestoreProgress(name string, tables []*metautil.Table) *RestoreProgress {
+	p := &RestoreProgress{
+		tables: make(map[int64]*progress.Task, len(tables)),
+	}
+	var totalBytes int64
+	for _, t := range tables {
+		totalBytes += filesBytes(t.Files)
+		p.totalFiles += int64(len(t.Files))
+	}
+	p.task = progress.NewTask(name, totalBytes)
+	for _, t := range tables {
+		tableTask := p.task.SubTask(fmt.Sprintf("`%s`.`%s`", t.DB.Name.O, t.Info.Name.O), filesBytes(t.Files))
+		p.tables[t.Info.ID] = tableTask
+		if t.Info.Partition != nil {
+			for _, def := range t.Info.Partition.Definitions {
+				p.tables[def.ID] = tableTask
+			}
+		}
+	}
+	return p
+}
+
+// NewRangeRestoreProgress creates the progress of restoring the files of a key
+// range, which has no tables.
+func NewRangeRestoreProgress(name string, files []*backuppb.File) *RestoreProgress {
+	return &RestoreProgress{
+		task:       progress.NewTask(name, filesBytes(files)),
+		totalFiles: int64(len(files)),
+	}
+}
+
+func filesBytes(files []*backuppb.File) int64 {
+	var size int64
+	for _, f := range files {
+		size += int64(f.GetTotalBytes())
+	}
+	return size
+}
+
+// Task returns the progress tree of the restore.
+func (p *RestoreProgress) Task() *progress.Task {
+	if p == nil {
+		return nil
+	}
+	return p.task
+}
+
+// OnFilesIngested records the files are ingested.
+func (p *RestoreProgress) OnFilesIngested(files []*backuppb.File) {
+	if p == nil || len(files) == 0 {
+		return
+	}
+	size := filesBytes(files)
+	p.filesIngested.Add(int64(len(files)))
+	p.bytesIngested.Add(size)
+	p.task.Add(size)
+	if tableTask, ok := p.tables[tablecodec.DecodeTableID(files[0].GetStartKey())]; ok {
+		tableTask.Add(size)
+	}
+}
+
+// OnRangesSplit records the regions are split by the ranges.
+func (p *RestoreProgress) OnRangesSplit(n int) {
+	if p == nil {
+		return
+	}
+	p.rangesSplit.Add(int64(n))
+}
+
+// Finish marks the restore as finished, or failed if err is not nil.
+func (p *RestoreProgress) Finish(err error) {
+	if p == nil {
+		return
+	}
+	p.task.Finish(err)
+}
+
+// TableProgress is the progress of restoring a table.
+type TableProgress struct {
+	Name          string  `json:"name"`
+	BytesIngested int64   `json:"bytes-ingested"`
+	TotalBytes    int64   `json:"total-bytes"`
+	Percent       float64 `json:"percent"`
+}
+
+// RestoreProgressSummary is the progress of a restore at some point.
+type RestoreProgressSummary struct {
+	Status        progress.Status `json:"status"`
+	FilesIngested int64           `json:"files-ingested"`
+	TotalFiles    int64           `json:"total-files"`
+	BytesIngested int64           `json:"bytes-ingested"`
+	TotalBytes    int64           `json:"total-bytes"`
+	RangesSplit   int64           `json:"ranges-split"`
+	Percent       float64         `json:"percent"`
+	// ElapsedSeconds is the seconds elapsed since the restore started, and
+	// ETASeconds is the estimated seconds to finish by the average speed, it's
+	// -1 if nothing is ingested yet.
+	ElapsedSeconds int64           `json:"elapsed-seconds"`
+	ETASeconds     int64           `json:"eta-seconds"`
+	Tables         []TableProgress `json:"tables"`
+}
+
+// Summary returns the current progress of the restore.
+func (p *RestoreProgress) Summary() RestoreProgressSummary {
+	snapshot := p.task.Snapshot()
+	s := RestoreProgressSummary{
+		Status:         snapshot.Status,
+		FilesIngested:  p.filesIngested.Load(),
+		TotalFiles:     p.totalFiles,
+		BytesIngested:  p.bytesIngested.Load(),
+		TotalBytes:     snapshot.Total,
+		RangesSplit:    p.rangesSplit.Load(),
+		Percent:        snapshot.Percent(),
+		ElapsedSeconds: int64(snapshot.Elapsed),
+		ETASeconds:     -1,
+		Tables:         make([]TableProgress, 0, len(snapshot.Children)),
+	}
+	switch {
+	case snapshot.Status == progress.StatusFinished:
+		s.ETASeconds = 0
+	case s.BytesIngested > 0 && snapshot.Elapsed > 0:
+		speed := float64(s.BytesIngested) / snapshot.Elapsed
+		if left := s.TotalBytes - s.BytesIngested; left > 0 {
+			s.ETASeconds = int64(float64(left) / speed)
+		} else {
+			s.ETASeconds = 0
+		}
+	}
+	for i := range snapshot.Children {
+		child := &snapshot.Children[i]
+		s.Tables = append(s.Tables, TableProgress{
+			Name:          child.Name,
+			BytesIngested: child.Current,
+			TotalBytes:    child.Total,
+			Percent:       child.Percent(),
+		})
+	}
+	return s
+}
+
+// Rows returns the progress as the rows of the progress table, the first row
+// is the whole restore and the others are the tables.
+func (s *RestoreProgressSummary) Rows() []glue.ProgressRow {
+	rows := make([]glue.ProgressRow, 0, len(s.Tables)+1)
+	rows = append(rows, glue.ProgressRow{
+		Current: s.BytesIngested,
+		Total:   s.TotalBytes,
+		Percent: s.Percent,
+		Status:  string(s.Status),
+		ETA:     s.ETASeconds,
+	})
+	for _, t := range s.Tables {
+		status := progress.StatusRunning
+		if t.BytesIngested >= t.TotalBytes {
+			status = progress.StatusFinished
+		} else if s.Status != progress.StatusRunning {
+			status = s.Status
+		}
+		rows = append(rows, glue.ProgressRow{
+			Name:    t.Name,
+			Current: t.BytesIngested,
+			Total:   t.TotalBytes,
+			Percent: t.Percent,
+			Status:  string(status),
+			ETA:     -1,
+		})
+	}
+	return rows
+}
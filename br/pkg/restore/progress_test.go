@@ -0,0 +1,72 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/br/pkg/progress"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreProgress(t *testing.T) {
+	fileOf := func(id int64, size uint64) *backuppb.File {
+		return &backuppb.File{StartKey: tablecodec.EncodeRowKeyWithHandle(id, kv.IntHandle(1)), TotalBytes: size}
+	}
+	db := &model.DBInfo{Name: model.NewCIStr("test")}
+	partitioned := &metautil.Table{DB: db, Info: &model.TableInfo{
+		ID: 10, Name: model.NewCIStr("t1"), Partition: &model.PartitionInfo{
+			Definitions: []model.PartitionDefinition{{ID: 11}, {ID: 12}},
+		}},
+		Files: []*backuppb.File{fileOf(11, 100), fileOf(12, 100)},
+	}
+	other := &metautil.Table{DB: db, Info: &model.TableInfo{ID: 20, Name: model.NewCIStr("t2")},
+		Files: []*backuppb.File{fileOf(20, 200)},
+	}
+
+	p := NewRestoreProgress("restore", []*metautil.Table{partitioned, other})
+	s := p.Summary()
+	require.Equal(t, progress.StatusRunning, s.Status)
+	require.EqualValues(t, 3, s.TotalFiles)
+	require.EqualValues(t, 400, s.TotalBytes)
+	require.EqualValues(t, -1, s.ETASeconds)
+
+	p.OnRangesSplit(3)
+	p.OnFilesIngested([]*backuppb.File{fileOf(12, 100)})
+	s = p.Summary()
+	require.EqualValues(t, 1, s.FilesIngested)
+	require.EqualValues(t, 100, s.BytesIngested)
+	require.EqualValues(t, 3, s.RangesSplit)
+	require.InDelta(t, 25, s.Percent, 0.01)
+	require.Equal(t, []TableProgress{
+		{Name: "`test`.`t1`", BytesIngested: 100, TotalBytes: 200, Percent: 50},
+		{Name: "`test`.`t2`", BytesIngested: 0, TotalBytes: 200, Percent: 0},
+	}, s.Tables)
+
+	rows := s.Rows()
+	require.Len(t, rows, 3)
+	require.Equal(t, "", rows[0].Name)
+	require.EqualValues(t, 400, rows[0].Total)
+	require.Equal(t, string(progress.StatusRunning), rows[1].Status)
+
+	p.OnFilesIngested([]*backuppb.File{fileOf(11, 100)})
+	p.Finish(errors.New("injected"))
+	s = p.Summary()
+	require.Equal(t, progress.StatusFailed, s.Status)
+	rows = s.Rows()
+	require.Equal(t, string(progress.StatusFinished), rows[1].Status)
+	require.Equal(t, string(progress.StatusFailed), rows[2].Status)
+
+	// the methods of a nil progress do nothing.
+	var nilProgress *RestoreProgress
+	nilProgress.OnFilesIngested([]*backuppb.File{fileOf(20, 200)})
+	nilProgress.OnRangesSplit(1)
+	nilProgress.Finish(nil)
+	require.Nil(t, nilProgress.Task())
+}
@@ -86,3 +86,44 @@ func EmptyRewriteRule() *RewriteRules {
 		Data: []*import_sstpb.RewriteRule{},
 	}
 }
+
+// EncodeTargetKeyspace returns the rewrite rules whose new prefixes are in the
+// keyspace `to`, the old prefixes are kept.
+func (r *RewriteRules) EncodeTargetKeyspace(to uint32) (*RewriteRules, error) {
+	rules := &RewriteRules{Data: make([]*import_sstpb.RewriteRule, 0, len(r.Data))}
+	for _, rule := range r.Data {
+		newPrefix, err := tablecodec.EncodeKeyspaceKey(to, rule.GetNewKeyPrefix())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		rules.Data = append(rules.Data, &import_sstpb.RewriteRule{
+			OldKeyPrefix: rule.GetOldKeyPrefix(),
+			NewKeyPrefix: newPrefix,
+			NewTimestamp: rule.GetNewTimestamp(),
+		})
+	}
+	return rules, nil
+}
+
+// EncodeKeyspace returns the rewrite rules for restoring the data backed up from
+// keyspace `from` into keyspace `to`: the old prefixes are in keyspace `from` and
+// the new prefixes are in keyspace `to`.
+func (r *RewriteRules) EncodeKeyspace(from, to uint32) (*RewriteRules, error) {
+	rules := &RewriteRules{Data: make([]*import_sstpb.RewriteRule, 0, len(r.Data))}
+	for _, rule := range r.Data {
+		oldPrefix, err := tablecodec.EncodeKeyspaceKey(from, rule.GetOldKeyPrefix())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		newPrefix, err := tablecodec.EncodeKeyspaceKey(to, rule.GetNewKeyPrefix())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		rules.Data = append(rules.Data, &import_sstpb.RewriteRule{
+			OldKeyPrefix: oldPrefix,
+			NewKeyPrefix: newPrefix,
+			NewTimestamp: rule.GetNewTimestamp(),
+		})
+	}
+	return rules, nil
+}
@@ -64,3 +64,35 @@ func TestSortRange(t *testing.T) {
 		{StartKey: []byte("xxe"), EndKey: []byte("xxz"), Files: nil},
 	})
 }
+
+func TestRewriteRulesEncodeKeyspace(t *testing.T) {
+	rules := &restore.RewriteRules{Data: []*import_sstpb.RewriteRule{
+		{OldKeyPrefix: tablecodec.GenTableRecordPrefix(1), NewKeyPrefix: tablecodec.GenTableRecordPrefix(4), NewTimestamp: 42},
+	}}
+	encoded, err := rules.EncodeKeyspace(1, 2)
+	require.NoError(t, err)
+	require.Len(t, encoded.Data, 1)
+	require.Equal(t, append([]byte{'x', 0, 0, 1}, tablecodec.GenTableRecordPrefix(1)...), encoded.Data[0].OldKeyPrefix)
+	require.Equal(t, append([]byte{'x', 0, 0, 2}, tablecodec.GenTableRecordPrefix(4)...), encoded.Data[0].NewKeyPrefix)
+	require.Equal(t, uint64(42), encoded.Data[0].NewTimestamp)
+	// the original rules are untouched.
+	require.Equal(t, []byte(tablecodec.GenTableRecordPrefix(1)), rules.Data[0].OldKeyPrefix)
+
+	_, err = rules.EncodeKeyspace(tablecodec.MaxKeyspaceID+1, 2)
+	require.Error(t, err)
+}
+
+func TestRewriteRulesEncodeTargetKeyspace(t *testing.T) {
+	rules := &restore.RewriteRules{Data: []*import_sstpb.RewriteRule{
+		{OldKeyPrefix: tablecodec.GenTableRecordPrefix(1), NewKeyPrefix: tablecodec.GenTableRecordPrefix(4), NewTimestamp: 42},
+	}}
+	encoded, err := rules.EncodeTargetKeyspace(2)
+	require.NoError(t, err)
+	require.Len(t, encoded.Data, 1)
+	require.Equal(t, []byte(tablecodec.GenTableRecordPrefix(1)), encoded.Data[0].OldKeyPrefix)
+	require.Equal(t, append([]byte{'x', 0, 0, 2}, tablecodec.GenTableRecordPrefix(4)...), encoded.Data[0].NewKeyPrefix)
+	require.Equal(t, uint64(42), encoded.Data[0].NewTimestamp)
+
+	_, err = rules.EncodeTargetKeyspace(tablecodec.MaxKeyspaceID + 1)
+	require.Error(t, err)
+}
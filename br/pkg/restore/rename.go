@@ -0,0 +1,147 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/parser/model"
+	"go.uber.org/zap"
+)
+
+// TableRenames maps the tables in the backup to the names to restore them as.
+// The keys are in lower case, and the values keep the case given by users.
+type TableRenames map[UniqueTableName]UniqueTableName
+
+func parseRenameTableName(s string) (UniqueTableName, bool) {
+	db, table, ok := strings.Cut(strings.TrimSpace(s), ".")
+	if !ok || db == "" || table == "" || strings.Contains(table, ".") {
+		return UniqueTableName{}, false
+	}
+	return UniqueTableName{DB: db, Table: table}, true
+}
+
+// ParseTableRenames parses the renames in the format of `old_db.old_tbl:new_db.new_tbl`.
+func ParseTableRenames(specs []string) (TableRenames, error) {
+	renames := make(TableRenames, len(specs))
+	targets := make(map[UniqueTableName]struct{}, len(specs))
+	for _, spec := range specs {
+		from, to, ok := strings.Cut(spec, ":")
+		if !ok {
+			return nil, errors.Annotatef(berrors.ErrInvalidArgument,
+				"invalid table rename %q, it should be old_db.old_tbl:new_db.new_tbl", spec)
+		}
+		source, ok1 := parseRenameTableName(from)
+		target, ok2 := parseRenameTableName(to)
+		if !ok1 || !ok2 {
+			return nil, errors.Annotatef(berrors.ErrInvalidArgument,
+				"invalid table rename %q, it should be old_db.old_tbl:new_db.new_tbl", spec)
+		}
+		if utils.IsSysDB(strings.ToLower(source.DB)) || utils.IsSysDB(strings.ToLower(target.DB)) {
+			return nil, errors.Annotatef(berrors.ErrInvalidArgument,
+				"can't rename the tables from or to the system databases: %q", spec)
+		}
+		source = UniqueTableName{DB: strings.ToLower(source.DB), Table: strings.ToLower(source.Table)}
+		lowerTarget := UniqueTableName{DB: strings.ToLower(target.DB), Table: strings.ToLower(target.Table)}
+		if _, ok := renames[source]; ok {
+			return nil, errors.Annotatef(berrors.ErrInvalidArgument, "the table `%s`.`%s` is renamed twice", source.DB, source.Table)
+		}
+		if _, ok := targets[lowerTarget]; ok {
+			return nil, errors.Annotatef(berrors.ErrInvalidArgument, "multiple tables are renamed to `%s`.`%s`", target.DB, target.Table)
+		}
+		renames[source] = target
+		targets[lowerTarget] = struct{}{}
+	}
+	return renames, nil
+}
+
+// targetDBs returns the lower case names of the databases the tables are renamed into.
+func (renames TableRenames) targetDBs() map[string]struct{} {
+	dbs := make(map[string]struct{}, len(renames))
+	for _, target := range renames {
+		dbs[strings.ToLower(target.DB)] = struct{}{}
+	}
+	return dbs
+}
+
+// SetTableRenames sets the tables to restore with new names.
+func (rc *Client) SetTableRenames(renames TableRenames) {
+	rc.tableRenames = renames
+}
+
+// HasTableRenames returns whether any table is restored with a new name.
+func (rc *Client) HasTableRenames() bool {
+	return len(rc.tableRenames) > 0
+}
+
+// RenameTables applies the renames to the tables to restore. The renamed tables
+// keep their IDs in the backup, so the rewrite rules generated after creating
+// them rewrite the keys to the new tables. It returns the renamed tables and
+// the new databases to create for them.
+func (rc *Client) RenameTables(tables []*metautil.Table) ([]*metautil.Table, []*model.DBInfo, error) {
+	if len(rc.tableRenames) == 0 {
+		return tables, nil, nil
+	}
+	if rc.IsIncremental() {
+		// the DDL jobs of the incremental backup refer to the original names.
+		return nil, nil, errors.Annotate(berrors.ErrInvalidArgument, "can't rename the tables in the incremental restore")
+	}
+	existDBs := make(map[string]struct{})
+	for _, t := range tables {
+		existDBs[t.DB.Name.L] = struct{}{}
+	}
+	renamed := make([]*metautil.Table, 0, len(tables))
+	newDBs := make([]*model.DBInfo, 0)
+	dbByName := make(map[string]*model.DBInfo)
+	matched := 0
+	for _, t := range tables {
+		target, ok := rc.tableRenames[UniqueTableName{DB: t.DB.Name.L, Table: t.Info.Name.L}]
+		if !ok {
+			renamed = append(renamed, t)
+			continue
+		}
+		matched++
+		dbName := strings.ToLower(target.DB)
+		db, ok := dbByName[dbName]
+		if !ok {
+			db = t.DB.Clone()
+			db.Name = model.NewCIStr(target.DB)
+			db.Tables = nil
+			dbByName[dbName] = db
+			if _, ok := existDBs[dbName]; !ok {
+				newDBs = append(newDBs, db)
+			}
+		}
+		nt := *t
+		nt.DB = db
+		nt.Info = t.Info.Clone()
+		nt.Info.Name = model.NewCIStr(target.Table)
+		log.Info("restore the table with a new name",
+			zap.Stringer("db", t.DB.Name), zap.Stringer("table", t.Info.Name),
+			zap.String("new-db", target.DB), zap.String("new-table", target.Table))
+		renamed = append(renamed, &nt)
+	}
+	if matched < len(rc.tableRenames) {
+		return nil, nil, errors.Annotatef(berrors.ErrInvalidArgument,
+			"%d tables to rename aren't found in the tables to restore", len(rc.tableRenames)-matched)
+	}
+	return renamed, newDBs, nil
+}
+
+// CheckRenamedTablesNotExist checks the renamed tables don't exist in the cluster.
+func (rc *Client) CheckRenamedTablesNotExist(dom *domain.Domain) error {
+	is := dom.InfoSchema()
+	for _, target := range rc.tableRenames {
+		if is.TableExists(model.NewCIStr(target.DB), model.NewCIStr(target.Table)) {
+			return errors.Annotatef(berrors.ErrInvalidArgument,
+				"the table `%s`.`%s` to restore as already exists", target.DB, target.Table)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"testing"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTableRenames(t *testing.T) {
+	renames, err := ParseTableRenames([]string{"Test.T1:new_db.New_T1", " test.t2 : test.t3 "})
+	require.NoError(t, err)
+	require.Equal(t, TableRenames{
+		{DB: "test", Table: "t1"}: {DB: "new_db", Table: "New_T1"},
+		{DB: "test", Table: "t2"}: {DB: "test", Table: "t3"},
+	}, renames)
+
+	for _, spec := range []string{
+		"test.t1",
+		"test:test.t2",
+		"test.t1:.t2",
+		"test.t1:a.b.c",
+		"mysql.user:test.user",
+	} {
+		_, err := ParseTableRenames([]string{spec})
+		require.Error(t, err, spec)
+	}
+	_, err = ParseTableRenames([]string{"test.t1:test.t3", "test.T1:test.t4"})
+	require.ErrorContains(t, err, "renamed twice")
+	_, err = ParseTableRenames([]string{"test.t1:test.t3", "test.t2:TEST.T3"})
+	require.ErrorContains(t, err, "multiple tables")
+}
+
+func TestRenameTables(t *testing.T) {
+	db := &model.DBInfo{ID: 1, Name: model.NewCIStr("test")}
+	t1 := &metautil.Table{DB: db, Info: &model.TableInfo{ID: 10, Name: model.NewCIStr("t1")}}
+	t2 := &metautil.Table{DB: db, Info: &model.TableInfo{ID: 11, Name: model.NewCIStr("t2")}}
+	t3 := &metautil.Table{DB: db, Info: &model.TableInfo{ID: 12, Name: model.NewCIStr("t3")}}
+	rc := &Client{backupMeta: &backuppb.BackupMeta{EndVersion: 100}}
+
+	// nothing to rename.
+	tables, newDBs, err := rc.RenameTables([]*metautil.Table{t1, t2})
+	require.NoError(t, err)
+	require.Equal(t, []*metautil.Table{t1, t2}, tables)
+	require.Empty(t, newDBs)
+
+	renames, err := ParseTableRenames([]string{"test.t1:New_DB.t1", "test.t2:test.t2_bak", "test.t3:new_db.t3"})
+	require.NoError(t, err)
+	rc.SetTableRenames(renames)
+	require.True(t, rc.HasTableRenames())
+	tables, newDBs, err = rc.RenameTables([]*metautil.Table{t1, t2, t3})
+	require.NoError(t, err)
+	require.Len(t, tables, 3)
+	// the renamed tables keep the IDs in the backup to generate the rewrite rules.
+	require.Equal(t, "New_DB", tables[0].DB.Name.O)
+	require.Equal(t, int64(10), tables[0].Info.ID)
+	require.Equal(t, "test", tables[1].DB.Name.O)
+	require.Equal(t, "t2_bak", tables[1].Info.Name.O)
+	require.Same(t, tables[0].DB, tables[2].DB)
+	require.Len(t, newDBs, 1)
+	require.Equal(t, "New_DB", newDBs[0].Name.O)
+	// the tables in the backup aren't changed.
+	require.Equal(t, "t1", t1.Info.Name.O)
+	require.Equal(t, "test", t1.DB.Name.O)
+
+	// the tables to rename must be restored.
+	_, _, err = rc.RenameTables([]*metautil.Table{t1})
+	require.Error(t, err)
+
+	// the incremental restore can't rename the tables.
+	rc.backupMeta.StartVersion = 50
+	_, _, err = rc.RenameTables([]*metautil.Table{t1, t2, t3})
+	require.Error(t, err)
+}
@@ -0,0 +1,152 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"github.com/coreos/go-semver/semver"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/version"
+	"github.com/pingcap/tidb/config"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/meta/autoid"
+	"github.com/pingcap/tidb/parser/model"
+	"go.uber.org/zap"
+)
+
+var (
+	// clusteredIndexVersion is the first version supporting the clustered
+	// index, the tables in the backups before it are never clustered.
+	clusteredIndexVersion = semver.New("5.0.0-rc")
+	// autoRandomRangeBitsVersion is the first version recording the range
+	// bits of the auto random columns.
+	autoRandomRangeBitsVersion = semver.New("6.3.0")
+)
+
+// schemaUpcastRule upcasts a field of the table info in the backups taken by
+// the older versions of TiDB to the format of the current version.
+type schemaUpcastRule struct {
+	name string
+	// applies returns whether the table in the backup of the version needs to
+	// be upcasted, the version is nil if it's unknown.
+	applies func(backupVersion *semver.Version, info *model.TableInfo) bool
+	upcast  func(info *model.TableInfo)
+}
+
+func backupOlderThan(backupVersion, cutOff *semver.Version) bool {
+	return backupVersion != nil && backupVersion.LessThan(*cutOff)
+}
+
+// schemaUpcastRules are applied in order, they must not change the encoding of
+// the data in the backup.
+var schemaUpcastRules = []schemaUpcastRule{
+	{
+		// TiDB before v2.1.9 may store the upper-cased charsets and collations,
+		// see model.TableInfoVersion3.
+		name: "lower-case-charset",
+		applies: func(_ *semver.Version, info *model.TableInfo) bool {
+			return info.Version < model.TableInfoVersion3
+		},
+		upcast: infoschema.ConvertCharsetCollateToLowerCaseIfNeed,
+	},
+	{
+		// TiDB before v2.1.7 treats utf8 as utf8mb4, see model.TableInfoVersion2.
+		name: "old-utf8-as-utf8mb4",
+		applies: func(_ *semver.Version, info *model.TableInfo) bool {
+			return info.Version < model.TableInfoVersion2 && config.GetGlobalConfig().TreatOldVersionUTF8AsUTF8MB4
+		},
+		upcast: infoschema.ConvertOldVersionUTF8ToUTF8MB4IfNeed,
+	},
+	{
+		// the tables created before the max shard bits is recorded.
+		name: "max-shard-row-id-bits",
+		applies: func(_ *semver.Version, info *model.TableInfo) bool {
+			return info.MaxShardRowIDBits < info.ShardRowIDBits
+		},
+		upcast: func(info *model.TableInfo) {
+			info.MaxShardRowIDBits = info.ShardRowIDBits
+		},
+	},
+	{
+		name: "clustered-index-flags",
+		applies: func(backupVersion *semver.Version, info *model.TableInfo) bool {
+			return backupOlderThan(backupVersion, clusteredIndexVersion) &&
+				(info.IsCommonHandle || info.CommonHandleVersion != 0)
+		},
+		upcast: func(info *model.TableInfo) {
+			info.IsCommonHandle = false
+			info.CommonHandleVersion = 0
+		},
+	},
+	{
+		// zero range bits means the length of BIGINT, make it explicit.
+		name: "auto-random-range-bits",
+		applies: func(backupVersion *semver.Version, info *model.TableInfo) bool {
+			return backupOlderThan(backupVersion, autoRandomRangeBitsVersion) &&
+				info.ContainsAutoRandomBits() && info.AutoRandomRangeBits == 0
+		},
+		upcast: func(info *model.TableInfo) {
+			info.AutoRandomRangeBits = autoid.AutoRandomRangeBitsDefault
+		},
+	},
+	{
+		// the backups taken before the auto random IDs are recorded apart from
+		// the row IDs, which are allocated by the same allocator then.
+		name: "legacy-auto-random-id",
+		applies: func(_ *semver.Version, info *model.TableInfo) bool {
+			return info.ContainsAutoRandomBits() && info.AutoRandID == 0 && info.AutoIncID > 0
+		},
+		upcast: func(info *model.TableInfo) {
+			info.AutoRandID = info.AutoIncID
+		},
+	},
+}
+
+// upcastTableInfo upcasts the table info in the backup of the version to the
+// format of the current version, it returns the names of the rules applied.
+func upcastTableInfo(backupVersion *semver.Version, info *model.TableInfo) []string {
+	var applied []string
+	for _, rule := range schemaUpcastRules {
+		if rule.applies(backupVersion, info) {
+			rule.upcast(info)
+			applied = append(applied, rule.name)
+		}
+	}
+	if info.Version < model.CurrLatestTableInfoVersion {
+		info.Version = model.CurrLatestTableInfoVersion
+	}
+	return applied
+}
+
+// upcastSchemas upcasts the tables and the DDL jobs in the backup taken by the
+// cluster of the version, so they're restored as if they're created by the
+// current version.
+func (rc *Client) upcastSchemas(clusterVersion string) {
+	var backupVersion *semver.Version
+	if clusterVersion != "" {
+		backupVersion = version.NormalizeBackupVersion(clusterVersion)
+	}
+	upcasted := 0
+	upcast := func(db string, info *model.TableInfo) {
+		if applied := upcastTableInfo(backupVersion, info); len(applied) > 0 {
+			upcasted++
+			log.Info("upcast the table from the older version",
+				zap.String("db", db), zap.Stringer("table", info.Name), zap.Strings("rules", applied))
+		}
+	}
+	for _, db := range rc.databases {
+		for _, table := range db.Tables {
+			if table.Info != nil {
+				upcast(table.DB.Name.O, table.Info)
+			}
+		}
+	}
+	for _, job := range rc.ddlJobs {
+		if job.BinlogInfo != nil && job.BinlogInfo.TableInfo != nil {
+			upcast(job.SchemaName, job.BinlogInfo.TableInfo)
+		}
+	}
+	if upcasted > 0 {
+		log.Info("upcast the schemas in the backup", zap.Stringer("backup-version", backupVersion),
+			zap.Int("tables", upcasted))
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/coreos/go-semver/semver"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/parser/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpcastTableInfo(t *testing.T) {
+	tableOf := func(version uint16, charset string) *model.TableInfo {
+		col := &model.ColumnInfo{Name: model.NewCIStr("a"), FieldType: *types.NewFieldType(mysql.TypeVarchar)}
+		col.SetCharset(charset)
+		return &model.TableInfo{
+			Name:    model.NewCIStr("t"),
+			Version: version,
+			Charset: charset,
+			Columns: []*model.ColumnInfo{col},
+		}
+	}
+
+	cases := []struct {
+		backupVersion string
+		info          *model.TableInfo
+		applied       []string
+		check         func(info *model.TableInfo)
+	}{
+		{
+			backupVersion: "2.1.0",
+			info:          tableOf(model.TableInfoVersion1, "UTF8"),
+			applied:       []string{"lower-case-charset", "old-utf8-as-utf8mb4"},
+			check: func(info *model.TableInfo) {
+				require.Equal(t, "utf8mb4", info.Charset)
+				require.Equal(t, "utf8mb4", info.Columns[0].GetCharset())
+			},
+		},
+		{
+			backupVersion: "2.1.8",
+			info:          tableOf(model.TableInfoVersion2, "UTF8"),
+			applied:       []string{"lower-case-charset"},
+			check: func(info *model.TableInfo) {
+				require.Equal(t, "utf8", info.Charset)
+				require.Equal(t, "utf8", info.Columns[0].GetCharset())
+			},
+		},
+		{
+			backupVersion: "3.0.0",
+			info: func() *model.TableInfo {
+				info := tableOf(model.TableInfoVersion3, "utf8mb4")
+				info.ShardRowIDBits = 4
+				return info
+			}(),
+			applied: []string{"max-shard-row-id-bits"},
+			check: func(info *model.TableInfo) {
+				require.Equal(t, uint64(4), info.MaxShardRowIDBits)
+			},
+		},
+		{
+			backupVersion: "4.0.0",
+			info: func() *model.TableInfo {
+				info := tableOf(model.TableInfoVersion3, "utf8mb4")
+				info.IsCommonHandle = true
+				info.AutoRandomBits = 5
+				info.AutoIncID = 100
+				return info
+			}(),
+			applied: []string{"clustered-index-flags", "auto-random-range-bits", "legacy-auto-random-id"},
+			check: func(info *model.TableInfo) {
+				require.False(t, info.IsCommonHandle)
+				require.Equal(t, uint64(64), info.AutoRandomRangeBits)
+				require.Equal(t, int64(100), info.AutoRandID)
+			},
+		},
+		{
+			// the clustered index of v5.0 is kept.
+			backupVersion: "5.0.0",
+			info: func() *model.TableInfo {
+				info := tableOf(model.TableInfoVersion3, "utf8mb4")
+				info.IsCommonHandle = true
+				info.AutoRandomBits = 5
+				info.AutoIncID = 100
+				info.AutoRandID = 200
+				return info
+			}(),
+			applied: []string{"auto-random-range-bits"},
+			check: func(info *model.TableInfo) {
+				require.True(t, info.IsCommonHandle)
+				require.Equal(t, int64(200), info.AutoRandID)
+			},
+		},
+		{
+			backupVersion: "6.5.0",
+			info: func() *model.TableInfo {
+				info := tableOf(model.TableInfoVersion4, "utf8mb4")
+				info.AutoRandomBits = 5
+				info.AutoRandomRangeBits = 48
+				info.AutoRandID = 200
+				return info
+			}(),
+			check: func(info *model.TableInfo) {
+				require.Equal(t, uint64(48), info.AutoRandomRangeBits)
+			},
+		},
+		{
+			// the rules by the versions are skipped if the version is unknown.
+			info: func() *model.TableInfo {
+				info := tableOf(model.TableInfoVersion4, "utf8mb4")
+				info.IsCommonHandle = true
+				info.AutoRandomBits = 5
+				info.AutoRandID = 200
+				return info
+			}(),
+			check: func(info *model.TableInfo) {
+				require.True(t, info.IsCommonHandle)
+				require.Zero(t, info.AutoRandomRangeBits)
+			},
+		},
+	}
+	for _, c := range cases {
+		var backupVersion *semver.Version
+		if c.backupVersion != "" {
+			backupVersion = semver.New(c.backupVersion)
+		}
+		applied := upcastTableInfo(backupVersion, c.info)
+		require.Equal(t, c.applied, applied, c.backupVersion)
+		require.Equal(t, model.CurrLatestTableInfoVersion, c.info.Version, c.backupVersion)
+		c.check(c.info)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"github.com/pingcap/tidb/br/pkg/metautil"
+)
+
+// TableSizeFilter filters the tables to restore by the data size and the row
+// count recorded in the backup. The zero bounds are ignored.
+type TableSizeFilter struct {
+	// SizeGreaterThan and SizeLessThan are the bounds of the bytes of the table.
+	SizeGreaterThan uint64 `json:"size-gt" toml:"size-gt"`
+	SizeLessThan    uint64 `json:"size-lt" toml:"size-lt"`
+	// RowsGreaterThan and RowsLessThan are the bounds of the estimated rows of the table.
+	RowsGreaterThan uint64 `json:"rows-gt" toml:"rows-gt"`
+	RowsLessThan    uint64 `json:"rows-lt" toml:"rows-lt"`
+}
+
+// IsEmpty returns whether the filter matches all tables.
+func (f TableSizeFilter) IsEmpty() bool {
+	return f == TableSizeFilter{}
+}
+
+// Match returns whether the table matches the filter.
+func (f TableSizeFilter) Match(table *metautil.Table) bool {
+	if f.IsEmpty() {
+		return true
+	}
+	size, rows := table.TotalBytes, EstimateTableRows(table)
+	if f.SizeGreaterThan > 0 && size <= f.SizeGreaterThan {
+		return false
+	}
+	if f.SizeLessThan > 0 && size >= f.SizeLessThan {
+		return false
+	}
+	if f.RowsGreaterThan > 0 && rows <= f.RowsGreaterThan {
+		return false
+	}
+	if f.RowsLessThan > 0 && rows >= f.RowsLessThan {
+		return false
+	}
+	return true
+}
+
+// EstimateTableRows estimates the row count of the table in the backup. It's
+// the count in the statistics if they're backed up, otherwise it's estimated
+// from the kv pairs, since every row has a record and an entry per index.
+func EstimateTableRows(table *metautil.Table) uint64 {
+	if table.Stats != nil && table.Stats.Count > 0 {
+		return uint64(table.Stats.Count)
+	}
+	kvsPerRow := uint64(1)
+	for _, idx := range table.Info.Indices {
+		// the clustered primary key is the record itself.
+		if idx.Primary && table.Info.IsCommonHandle {
+			continue
+		}
+		kvsPerRow++
+	}
+	return table.TotalKvs / kvsPerRow
+}
@@ -0,0 +1,48 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/statistics/handle"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableSizeFilter(t *testing.T) {
+	newTable := func(bytes, kvs uint64, indices ...*model.IndexInfo) *metautil.Table {
+		return &metautil.Table{
+			Info:       &model.TableInfo{Name: model.NewCIStr("t"), Indices: indices},
+			TotalBytes: bytes,
+			TotalKvs:   kvs,
+		}
+	}
+	// every row has a record and an index entry.
+	small := newTable(100, 20, &model.IndexInfo{Name: model.NewCIStr("idx")})
+	require.Equal(t, uint64(10), EstimateTableRows(small))
+	// the clustered primary key doesn't have the index entries.
+	large := newTable(10000, 1000, &model.IndexInfo{Name: model.NewCIStr("PRIMARY"), Primary: true})
+	large.Info.IsCommonHandle = true
+	require.Equal(t, uint64(1000), EstimateTableRows(large))
+	// the row count in the statistics is preferred.
+	withStats := newTable(1000, 10)
+	withStats.Stats = &handle.JSONTable{Count: 500}
+	require.Equal(t, uint64(500), EstimateTableRows(withStats))
+
+	f := TableSizeFilter{}
+	require.True(t, f.IsEmpty())
+	require.True(t, f.Match(small))
+
+	f = TableSizeFilter{SizeGreaterThan: 100}
+	require.False(t, f.Match(small))
+	require.True(t, f.Match(large))
+	f = TableSizeFilter{SizeLessThan: 1000}
+	require.True(t, f.Match(small))
+	require.False(t, f.Match(withStats))
+	f = TableSizeFilter{RowsGreaterThan: 10, RowsLessThan: 1000}
+	require.False(t, f.Match(small))
+	require.False(t, f.Match(large))
+	require.True(t, f.Match(withStats))
+}
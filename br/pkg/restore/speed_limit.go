@@ -0,0 +1,183 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/docker/go-units"
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/tablecodec"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+// TableSpeedLimits are the speed limits in bytes per second of restoring the
+// tables or the databases. The keys are `db` or `db.tbl` in lower case, which
+// are the names in the backup.
+type TableSpeedLimits map[string]uint64
+
+// ParseTableSpeedLimits parses the limits in the format of `db.tbl=50MB` or `db=100MB`.
+func ParseTableSpeedLimits(specs []string) (TableSpeedLimits, error) {
+	limits := make(TableSpeedLimits, len(specs))
+	for _, spec := range specs {
+		name, value, ok := strings.Cut(spec, "=")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !ok || name == "" || strings.Count(name, ".") > 1 {
+			return nil, errors.Annotatef(berrors.ErrInvalidArgument,
+				"invalid table speed limit %q, it should be db.tbl=50MB or db=100MB", spec)
+		}
+		limit, err := units.RAMInBytes(strings.TrimSpace(value))
+		if err != nil || limit < 0 {
+			return nil, errors.Annotatef(berrors.ErrInvalidArgument, "invalid speed limit %q of %s", value, name)
+		}
+		limits[name] = uint64(limit)
+	}
+	return limits, nil
+}
+
+// tableSpeedLimiter throttles the importing of the files by the tables which
+// they belong to, it's adjustable at runtime. The limit of the stores, which
+// is applied by TiKV, throttles all the tables together.
+type tableSpeedLimiter struct {
+	// tableNames maps the physical table IDs in the backup to the table names.
+	tableNames map[int64]UniqueTableName
+
+	mu       sync.RWMutex
+	limits   TableSpeedLimits
+	limiters map[string]*rate.Limiter
+}
+
+func newTableSpeedLimiter(databases map[string]*utils.Database) *tableSpeedLimiter {
+	return &tableSpeedLimiter{
+		tableNames: tableNamesOf(databases),
+		limits:     make(TableSpeedLimits),
+		limiters:   make(map[string]*rate.Limiter),
+	}
+}
+
+// tableNamesOf maps the physical table IDs in the backup, including the
+// partitions, to the lower-case names of the tables in the backup.
+func tableNamesOf(databases map[string]*utils.Database) map[int64]UniqueTableName {
+	names := make(map[int64]UniqueTableName)
+	for _, db := range databases {
+		for _, t := range db.Tables {
+			if t.Info == nil {
+				continue
+			}
+			name := UniqueTableName{DB: db.Info.Name.L, Table: t.Info.Name.L}
+			if sysDB, ok := utils.GetSysDBName(db.Info.Name); ok {
+				name.DB = sysDB
+			}
+			names[t.Info.ID] = name
+			if t.Info.Partition != nil {
+				for _, def := range t.Info.Partition.Definitions {
+					names[def.ID] = name
+				}
+			}
+		}
+	}
+	return names
+}
+
+func newSpeedLimiter(limit uint64) *rate.Limiter {
+	// burst in one second, so the importing of a file waits for the bytes it downloads.
+	return rate.NewLimiter(rate.Limit(limit), int(limit))
+}
+
+// update replaces the limits, the limiters of the unchanged limits are kept.
+func (l *tableSpeedLimiter) update(newLimits TableSpeedLimits) {
+	limits := make(TableSpeedLimits, len(newLimits))
+	for name, limit := range newLimits {
+		limits[strings.ToLower(name)] = limit
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for name := range l.limiters {
+		if _, ok := limits[name]; !ok {
+			delete(l.limiters, name)
+		}
+	}
+	for name, limit := range limits {
+		if limit == 0 {
+			delete(l.limiters, name)
+			continue
+		}
+		if limiter, ok := l.limiters[name]; ok {
+			limiter.SetLimit(rate.Limit(limit))
+			limiter.SetBurst(int(limit))
+		} else {
+			l.limiters[name] = newSpeedLimiter(limit)
+		}
+	}
+	l.limits = limits
+	log.Info("update the table speed limits", zap.Any("limits", l.limits))
+}
+
+// currentLimits returns a copy of the current limits.
+func (l *tableSpeedLimiter) currentLimits() TableSpeedLimits {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	limits := make(TableSpeedLimits, len(l.limits))
+	for name, limit := range l.limits {
+		limits[name] = limit
+	}
+	return limits
+}
+
+// limitersOf returns the limiters of the table and its database.
+func (l *tableSpeedLimiter) limitersOf(physicalID int64) []*rate.Limiter {
+	name, ok := l.tableNames[physicalID]
+	if !ok {
+		return nil
+	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	limiters := make([]*rate.Limiter, 0, 2)
+	if limiter, ok := l.limiters[name.DB+"."+name.Table]; ok {
+		limiters = append(limiters, limiter)
+	}
+	if limiter, ok := l.limiters[name.DB]; ok {
+		limiters = append(limiters, limiter)
+	}
+	return limiters
+}
+
+// wait waits until the files are allowed to be imported by the limits.
+func (l *tableSpeedLimiter) wait(ctx context.Context, files []*backuppb.File) error {
+	if l == nil || len(files) == 0 {
+		return nil
+	}
+	limiters := l.limitersOf(tablecodec.DecodeTableID(files[0].GetStartKey()))
+	if len(limiters) == 0 {
+		return nil
+	}
+	var size uint64
+	for _, f := range files {
+		if f.GetSize_() > 0 {
+			size += f.GetSize_()
+		} else {
+			size += f.GetTotalBytes()
+		}
+	}
+	for _, limiter := range limiters {
+		// WaitN fails if the bytes exceed the burst, so wait for them in pieces.
+		for left := size; left > 0; {
+			n := left
+			if burst := uint64(limiter.Burst()); n > burst {
+				n = burst
+			}
+			if err := limiter.WaitN(ctx, int(n)); err != nil {
+				return errors.Trace(err)
+			}
+			left -= n
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTableSpeedLimits(t *testing.T) {
+	limits, err := ParseTableSpeedLimits([]string{"Test.T1=1KB", " test = 2MiB "})
+	require.NoError(t, err)
+	require.Equal(t, TableSpeedLimits{"test.t1": 1024, "test": 2 * 1024 * 1024}, limits)
+
+	for _, spec := range []string{"test", "=1MB", "a.b.c=1MB", "test=fast"} {
+		_, err := ParseTableSpeedLimits([]string{spec})
+		require.Error(t, err, spec)
+	}
+}
+
+func TestTableSpeedLimiter(t *testing.T) {
+	ctx := context.Background()
+	db := &model.DBInfo{Name: model.NewCIStr("test")}
+	partitioned := &model.TableInfo{ID: 10, Name: model.NewCIStr("t1"), Partition: &model.PartitionInfo{
+		Definitions: []model.PartitionDefinition{{ID: 11}, {ID: 12}},
+	}}
+	other := &model.TableInfo{ID: 20, Name: model.NewCIStr("t2")}
+	l := newTableSpeedLimiter(map[string]*utils.Database{"test": {Info: db, Tables: []*metautil.Table{
+		{DB: db, Info: partitioned},
+		{DB: db, Info: other},
+	}}})
+	fileOf := func(id int64, size uint64) []*backuppb.File {
+		return []*backuppb.File{{StartKey: tablecodec.EncodeRowKeyWithHandle(id, kv.IntHandle(1)), Size_: size}}
+	}
+
+	l.update(TableSpeedLimits{"TEST.T1": 1000, "test": 1 << 30})
+	require.Equal(t, TableSpeedLimits{"test.t1": 1000, "test": 1 << 30}, l.currentLimits())
+	require.Len(t, l.limitersOf(11), 2)
+	require.Len(t, l.limitersOf(20), 1)
+	require.Empty(t, l.limitersOf(30))
+
+	// the partition waits for the bytes exceeding the burst of its table.
+	start := time.Now()
+	require.NoError(t, l.wait(ctx, fileOf(12, 1500)))
+	require.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+	start = time.Now()
+	require.NoError(t, l.wait(ctx, fileOf(20, 1500)))
+	require.Less(t, time.Since(start), 400*time.Millisecond)
+
+	// the removed or zero limits are unlimited.
+	l.update(TableSpeedLimits{"test.t1": 0})
+	require.Empty(t, l.limitersOf(11))
+	require.Equal(t, TableSpeedLimits{"test.t1": 0}, l.currentLimits())
+
+	var nilLimiter *tableSpeedLimiter
+	require.NoError(t, nilLimiter.wait(ctx, fileOf(11, 1500)))
+}
@@ -28,7 +28,8 @@ import (
 
 // RegionSplitter is a executor of region split by rules.
 type RegionSplitter struct {
-	client split.SplitClient
+	client  split.SplitClient
+	planner *SplitPlanner
 }
 
 // NewRegionSplitter returns a new RegionSplitter.
@@ -41,8 +42,15 @@ func NewRegionSplitter(client split.SplitClient) *RegionSplitter {
 // OnSplitFunc is called before split a range.
 type OnSplitFunc func(key [][]byte)
 
+// SetSplitPlanner sets the planner choosing the split keys, the regions are
+// split by the end key of each range without it.
+func (rs *RegionSplitter) SetSplitPlanner(planner *SplitPlanner) {
+	rs.planner = planner
+}
+
 // Split executes a region split. It will split regions by the rewrite rules,
-// then it will split regions by the end key of each range.
+// then it will split regions by the keys planned from the ranges, which are
+// the end key of each range without a planner.
 // tableRules includes the prefix of a table, since some ranges may have
 // a prefix with record sequence or index sequence.
 // note: all ranges and rewrite rules must have raw key.
@@ -70,6 +78,8 @@ func (rs *RegionSplitter) Split(
 	if errSplit != nil {
 		return errors.Trace(errSplit)
 	}
+	splitKeys := rs.planner.PlanSplitKeys(sortedRanges)
+	log.Info("plan split keys", zap.Int("ranges", len(sortedRanges)), zap.Int("keys", len(splitKeys)))
 	minKey := codec.EncodeBytesExt(nil, sortedRanges[0].StartKey, isRawKv)
 	maxKey := codec.EncodeBytesExt(nil, sortedRanges[len(sortedRanges)-1].EndKey, isRawKv)
 	interval := split.SplitRetryInterval
@@ -85,7 +95,7 @@ SplitRegions:
 			}
 			return errors.Trace(errScan)
 		}
-		splitKeyMap := getSplitKeys(rewriteRules, sortedRanges, regions, isRawKv)
+		splitKeyMap := getSplitKeys(splitKeys, regions, isRawKv)
 		regionMap := make(map[uint64]*split.RegionInfo)
 		for _, region := range regions {
 			regionMap[region.Region.GetId()] = region
@@ -371,14 +381,10 @@ func (rs *RegionSplitter) ScatterRegions(ctx context.Context, newRegions []*spli
 	}
 }
 
-// getSplitKeys checks if the regions should be split by the end key of
-// the ranges, groups the split keys by region id.
-func getSplitKeys(rewriteRules *RewriteRules, ranges []rtree.Range, regions []*split.RegionInfo, isRawKv bool) map[uint64][][]byte {
+// getSplitKeys checks if the regions should be split by the planned keys,
+// groups the split keys by region id.
+func getSplitKeys(checkKeys [][]byte, regions []*split.RegionInfo, isRawKv bool) map[uint64][][]byte {
 	splitKeyMap := make(map[uint64][][]byte)
-	checkKeys := make([][]byte, 0)
-	for _, rg := range ranges {
-		checkKeys = append(checkKeys, rg.EndKey)
-	}
 	for _, key := range checkKeys {
 		if region := NeedSplit(key, regions, isRawKv); region != nil {
 			splitKeys, ok := splitKeyMap[region.Region.GetId()]
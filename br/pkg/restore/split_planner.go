@@ -0,0 +1,73 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"github.com/pingcap/tidb/br/pkg/rtree"
+)
+
+// SplitPlanner plans the keys to split the regions before restoring the
+// ranges. Rather than splitting at the end of every range, it merges the small
+// adjacent ranges, even if they're in different tables, into the regions of
+// about the target size by their bytes, so restoring many small tables doesn't
+// produce lots of tiny regions.
+type SplitPlanner struct {
+	targetSize uint64
+}
+
+// NewSplitPlanner creates a SplitPlanner planning the regions of the target
+// size, it splits at the end of every range if the target size is 0.
+func NewSplitPlanner(targetSize uint64) *SplitPlanner {
+	return &SplitPlanner{targetSize: targetSize}
+}
+
+// PlanSplitKeys returns the keys to split the sorted ranges at. The ranges are
+// never split inside, and the end key of the last range is always a split key
+// so the ranges don't share the region with the data following them.
+func (p *SplitPlanner) PlanSplitKeys(sortedRanges []rtree.Range) [][]byte {
+	if len(sortedRanges) == 0 {
+		return nil
+	}
+	if p == nil || p.targetSize == 0 {
+		keys := make([][]byte, 0, len(sortedRanges))
+		for _, rg := range sortedRanges {
+			keys = append(keys, rg.EndKey)
+		}
+		return keys
+	}
+
+	sizes := make([]uint64, len(sortedRanges))
+	total := uint64(0)
+	for i := range sortedRanges {
+		sizes[i], _ = sortedRanges[i].BytesAndKeys()
+		total += sizes[i]
+	}
+	// balance the sizes of the regions rather than cutting them greedily by the
+	// target size, which leaves a tiny region at the end.
+	regions := (total + p.targetSize - 1) / p.targetSize
+	if regions == 0 {
+		regions = 1
+	}
+	regionSize := total / regions
+
+	keys := make([][]byte, 0, regions)
+	acc := uint64(0)
+	for i := range sortedRanges {
+		if acc > 0 && acc+sizes[i] > p.targetSize {
+			keys = append(keys, sortedRanges[i-1].EndKey)
+			acc = 0
+		}
+		acc += sizes[i]
+		if i == len(sortedRanges)-1 || (regionSize > 0 && acc >= regionSize) {
+			keys = append(keys, sortedRanges[i].EndKey)
+			acc = 0
+		}
+	}
+	return keys
+}
+
+// SetRegionSplitSize sets the target size of the regions split before
+// restoring, the regions are split at the end of every range if it's 0.
+func (rc *Client) SetRegionSplitSize(size uint64) {
+	rc.regionSplitSize = size
+}
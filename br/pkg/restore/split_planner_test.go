@@ -0,0 +1,58 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"testing"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/rtree"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanSplitKeys(t *testing.T) {
+	rangesOf := func(sizes ...uint64) []rtree.Range {
+		ranges := make([]rtree.Range, 0, len(sizes))
+		for i, size := range sizes {
+			ranges = append(ranges, rtree.Range{
+				StartKey: []byte{byte('a' + i)},
+				EndKey:   []byte{byte('a' + i + 1)},
+				Files:    []*backuppb.File{{TotalBytes: size}},
+			})
+		}
+		return ranges
+	}
+	keysOf := func(s string) [][]byte {
+		keys := make([][]byte, 0, len(s))
+		for i := range s {
+			keys = append(keys, []byte{s[i]})
+		}
+		return keys
+	}
+
+	cases := []struct {
+		targetSize uint64
+		sizes      []uint64
+		keys       string
+	}{
+		// split at the end of every range without the target size.
+		{0, []uint64{10, 10, 10}, "bcd"},
+		// the small ranges are merged.
+		{100, []uint64{10, 10, 10, 10}, "e"},
+		// the regions are balanced rather than leaving a tiny region at the end.
+		{100, []uint64{30, 30, 30, 30, 10}, "df"},
+		// a range larger than the target size isn't split inside.
+		{100, []uint64{10, 200, 10}, "bcd"},
+		// the regions don't exceed the target size.
+		{100, []uint64{60, 60, 60}, "bcd"},
+		{100, []uint64{0, 0}, "c"},
+	}
+	for i, c := range cases {
+		keys := NewSplitPlanner(c.targetSize).PlanSplitKeys(rangesOf(c.sizes...))
+		require.Equal(t, keysOf(c.keys), keys, "case %d", i)
+	}
+
+	var nilPlanner *SplitPlanner
+	require.Equal(t, keysOf("bc"), nilPlanner.PlanSplitKeys(rangesOf(1, 1)))
+	require.Nil(t, NewSplitPlanner(100).PlanSplitKeys(nil))
+}
@@ -693,7 +693,7 @@ func TestRestoreFailed(t *testing.T) {
 		fakeRanges("bcy", "cad", "xxy"),
 	}
 	r := &fakeRestorer{}
-	sender, err := restore.NewTiKVSender(context.TODO(), r, nil, 1)
+	sender, err := restore.NewTiKVSender(context.TODO(), r, nil, 1, 0)
 	require.NoError(t, err)
 	dctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -708,6 +708,87 @@ func TestRestoreFailed(t *testing.T) {
 	require.GreaterOrEqual(t, len(r.restoredFiles), 1)
 }
 
+type pipelineRestorer struct {
+	mu sync.Mutex
+
+	inflight    int
+	maxInflight int
+	restored    int
+	errorAt     int
+}
+
+func (p *pipelineRestorer) SplitRanges(ctx context.Context, ranges []rtree.Range, rewriteRules *restore.RewriteRules, updateCh glue.Progress, isRawKv bool) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.inflight++
+	if p.inflight > p.maxInflight {
+		p.maxInflight = p.inflight
+	}
+	return nil
+}
+
+func (p *pipelineRestorer) RestoreSSTFiles(ctx context.Context, files []*backuppb.File, rewriteRules *restore.RewriteRules, updateCh glue.Progress) error {
+	time.Sleep(10 * time.Millisecond)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.inflight--
+	p.restored++
+	if p.restored == p.errorAt {
+		return errors.Annotate(berrors.ErrRestoreWriteAndIngest, "failed to ingest")
+	}
+	return nil
+}
+
+type nopSink struct {
+	errCh chan error
+}
+
+func (s nopSink) EmitTables(tables ...restore.CreatedTable) {}
+
+func (s nopSink) EmitError(err error) {
+	s.errCh <- err
+}
+
+func (s nopSink) Close() {}
+
+func TestSenderPipelineDepth(t *testing.T) {
+	ranges := []restore.DrainResult{
+		fakeRanges("aax", "abx"),
+		fakeRanges("abx", "abz"),
+		fakeRanges("abz", "bbz"),
+		fakeRanges("bbz", "bcy"),
+		fakeRanges("bcy", "cad"),
+		fakeRanges("cad", "xxy"),
+	}
+
+	// the regions of at most 2 batches are split ahead of restoring.
+	r := &pipelineRestorer{}
+	sender, err := restore.NewTiKVSender(context.TODO(), r, nil, 4, 2)
+	require.NoError(t, err)
+	sink := nopSink{errCh: make(chan error, 1)}
+	sender.PutSink(sink)
+	for _, rg := range ranges {
+		sender.RestoreBatch(rg)
+	}
+	sender.Close()
+	require.Len(t, sink.errCh, 0)
+	require.Equal(t, len(ranges), r.restored)
+	require.LessOrEqual(t, r.maxInflight, 2)
+	require.Equal(t, 0, r.inflight)
+
+	// the sender isn't blocked by the batches split ahead after failing to restore.
+	r = &pipelineRestorer{errorAt: 1}
+	sender, err = restore.NewTiKVSender(context.TODO(), r, nil, 1, 1)
+	require.NoError(t, err)
+	sink = nopSink{errCh: make(chan error, 1)}
+	sender.PutSink(sink)
+	for _, rg := range ranges {
+		sender.RestoreBatch(rg)
+	}
+	require.Error(t, <-sink.errCh)
+	sender.Close()
+}
+
 func TestSplitFailed(t *testing.T) {
 	ranges := []restore.DrainResult{
 		fakeRanges("aax", "abx", "abz"),
@@ -715,7 +796,7 @@ func TestSplitFailed(t *testing.T) {
 		fakeRanges("bcy", "cad", "xxy"),
 	}
 	r := &fakeRestorer{errorInSplit: true}
-	sender, err := restore.NewTiKVSender(context.TODO(), r, nil, 1)
+	sender, err := restore.NewTiKVSender(context.TODO(), r, nil, 1, 0)
 	require.NoError(t, err)
 	dctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
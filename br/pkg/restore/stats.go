@@ -0,0 +1,136 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/statistics/handle"
+	"go.uber.org/zap"
+)
+
+// statsLoader loads the statistics dumped in JSON, it's implemented by the
+// stats handle.
+type statsLoader interface {
+	LoadStatsFromJSON(is infoschema.InfoSchema, jsonTbl *handle.JSONTable) error
+}
+
+// StatsRestoreSummary is the result of restoring the statistics.
+type StatsRestoreSummary struct {
+	// Loaded is the number of the tables whose statistics are loaded.
+	Loaded int64
+	// Skipped is the number of the tables without statistics in the backup.
+	Skipped int64
+	// Failed is the number of the tables failed to load the statistics, they
+	// need to be analyzed after restore.
+	Failed int64
+}
+
+// StatsRestorer loads the statistics captured at the backup time, i.e. the
+// histograms, the TopN and the CM sketches, into the stats handle after the
+// tables are created, so the restored tables needn't be analyzed again.
+type StatsRestorer struct {
+	loader     statsLoader
+	infoSchema func() infoschema.InfoSchema
+
+	loaded  atomic.Int64
+	skipped atomic.Int64
+	failed  atomic.Int64
+}
+
+// NewStatsRestorer creates a StatsRestorer loading the statistics by the handle.
+func NewStatsRestorer(h *handle.Handle, infoSchema func() infoschema.InfoSchema) *StatsRestorer {
+	return &StatsRestorer{loader: h, infoSchema: infoSchema}
+}
+
+// Restore loads the statistics of the created table. The failures are only
+// logged since the table can be analyzed after restore.
+func (r *StatsRestorer) Restore(tbl *CreatedTable) {
+	if tbl.OldTable.Stats == nil {
+		r.skipped.Add(1)
+		return
+	}
+	start := time.Now()
+	if err := r.loader.LoadStatsFromJSON(r.infoSchema(), statsOfCreatedTable(tbl)); err != nil {
+		r.failed.Add(1)
+		log.Warn("failed to restore the statistics, the table needs to be analyzed",
+			zap.Stringer("db", tbl.OldTable.DB.Name),
+			zap.Stringer("table", tbl.Table.Name),
+			zap.Error(err))
+		return
+	}
+	r.loaded.Add(1)
+	log.Info("restore stat done",
+		zap.Stringer("db", tbl.OldTable.DB.Name),
+		zap.Stringer("table", tbl.Table.Name),
+		zap.Int64("old id", tbl.OldTable.Info.ID),
+		zap.Int64("new id", tbl.Table.ID),
+		zap.Duration("cost", time.Since(start)))
+}
+
+// Summary returns the result of restoring the statistics so far.
+func (r *StatsRestorer) Summary() StatsRestoreSummary {
+	return StatsRestoreSummary{
+		Loaded:  r.loaded.Load(),
+		Skipped: r.skipped.Load(),
+		Failed:  r.failed.Load(),
+	}
+}
+
+// statsOfCreatedTable returns the statistics refer to the created table by
+// name, since the table may be renamed from the table in the backup.
+func statsOfCreatedTable(tbl *CreatedTable) *handle.JSONTable {
+	stats := *tbl.OldTable.Stats
+	stats.DatabaseName = tbl.OldTable.DB.Name.O
+	stats.TableName = tbl.Table.Name.O
+	return &stats
+}
+
+// SetLoadStats sets whether to load the statistics in the backup into the
+// restored tables. It must be called before Init.
+func (rc *Client) SetLoadStats(loadStats bool) {
+	rc.skipLoadStats = !loadStats
+}
+
+// GetStatsRestoreSummary returns the result of restoring the statistics, it
+// returns false if the statistics aren't restored.
+func (rc *Client) GetStatsRestoreSummary() (StatsRestoreSummary, bool) {
+	if rc.statsRestorer == nil {
+		return StatsRestoreSummary{}, false
+	}
+	return rc.statsRestorer.Summary(), true
+}
+
+// GoLoadStats forks a goroutine to load the statistics of the tables, and
+// passes the tables to the next stage. It's used when the checksum is skipped,
+// otherwise the statistics are loaded after the checksum is validated.
+func (rc *Client) GoLoadStats(ctx context.Context, tableStream <-chan CreatedTable) <-chan CreatedTable {
+	if rc.statsRestorer == nil {
+		return tableStream
+	}
+	outCh := make(chan CreatedTable, defaultChannelSize)
+	go func() {
+		defer close(outCh)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case tbl, ok := <-tableStream:
+				if !ok {
+					return
+				}
+				rc.statsRestorer.Restore(&tbl)
+				select {
+				case <-ctx.Done():
+					return
+				case outCh <- tbl:
+				}
+			}
+		}
+	}()
+	return outCh
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/statistics/handle"
+	"github.com/stretchr/testify/require"
+)
+
+type mockStatsLoader struct {
+	loaded []*handle.JSONTable
+	err    error
+}
+
+func (m *mockStatsLoader) LoadStatsFromJSON(_ infoschema.InfoSchema, jsonTbl *handle.JSONTable) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.loaded = append(m.loaded, jsonTbl)
+	return nil
+}
+
+func TestStatsRestorer(t *testing.T) {
+	loader := &mockStatsLoader{}
+	r := &StatsRestorer{loader: loader, infoSchema: func() infoschema.InfoSchema { return nil }}
+
+	// the table is renamed from `test`.`t` to `test2`.`t2`.
+	stats := &handle.JSONTable{DatabaseName: "test", TableName: "t", Count: 10}
+	renamed := &CreatedTable{
+		Table: &model.TableInfo{ID: 100, Name: model.NewCIStr("t2")},
+		OldTable: &metautil.Table{
+			DB:    &model.DBInfo{Name: model.NewCIStr("test2")},
+			Info:  &model.TableInfo{ID: 1, Name: model.NewCIStr("t2")},
+			Stats: stats,
+		},
+	}
+	r.Restore(renamed)
+	require.Len(t, loader.loaded, 1)
+	require.Equal(t, "test2", loader.loaded[0].DatabaseName)
+	require.Equal(t, "t2", loader.loaded[0].TableName)
+	require.EqualValues(t, 10, loader.loaded[0].Count)
+	// the statistics in the backup are unchanged.
+	require.Equal(t, "test", stats.DatabaseName)
+	require.Equal(t, "t", stats.TableName)
+
+	noStats := &CreatedTable{
+		Table: &model.TableInfo{ID: 101, Name: model.NewCIStr("t3")},
+		OldTable: &metautil.Table{
+			DB:   &model.DBInfo{Name: model.NewCIStr("test")},
+			Info: &model.TableInfo{ID: 2, Name: model.NewCIStr("t3")},
+		},
+	}
+	r.Restore(noStats)
+	require.Len(t, loader.loaded, 1)
+
+	loader.err = errors.New("injected")
+	r.Restore(renamed)
+	require.Equal(t, StatsRestoreSummary{Loaded: 1, Skipped: 1, Failed: 1}, r.Summary())
+}
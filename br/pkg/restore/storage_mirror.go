@@ -0,0 +1,147 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/kvproto/pkg/import_sstpb"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/logutil"
+)
+
+const (
+	// PrimaryStorageSource is the storage the backup is read from.
+	PrimaryStorageSource = "primary"
+	// MirrorStorageSource is the storage the files are downloaded from when
+	// the primary one fails.
+	MirrorStorageSource = "mirror"
+)
+
+// storageThrottledMessages are the messages of the errors of the storage
+// throttling the requests or failing by the server, the files may be
+// downloaded from the mirror storage then.
+var storageThrottledMessages = []string{
+	"slowdown",
+	"slow down",
+	"throttl",
+	"toomanyrequests",
+	"too many requests",
+	"requestlimitexceeded",
+	"serviceunavailable",
+	"service unavailable",
+	"internalerror",
+	"internal error",
+	"bad gateway",
+	"gateway timeout",
+}
+
+// storageServerErrorStatus matches the 5xx HTTP status in the error messages
+// of the storage, e.g. "status code: 503" of S3 and "Error 503" of GCS.
+var storageServerErrorStatus = regexp.MustCompile(`(status code|status|error)[: ]+5\d\d\b`)
+
+// isStorageThrottledError returns whether the download failed since the
+// storage is throttled or fails by the server.
+func isStorageThrottledError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, m := range storageThrottledMessages {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+	return storageServerErrorStatus.MatchString(msg)
+}
+
+// StorageSourceStat is the statistics of downloading the files from a storage.
+type StorageSourceStat struct {
+	Source    string
+	Downloads int
+	Failures  int
+	Bytes     uint64
+}
+
+// storageSourceStats collects the statistics of downloading the files by the
+// storage sources.
+type storageSourceStats struct {
+	mu    sync.Mutex
+	stats map[string]*StorageSourceStat
+}
+
+func newStorageSourceStats() *storageSourceStats {
+	return &storageSourceStats{stats: make(map[string]*StorageSourceStat)}
+}
+
+func (s *storageSourceStats) record(source string, file *backuppb.File, err error) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stat, ok := s.stats[source]
+	if !ok {
+		stat = &StorageSourceStat{Source: source}
+		s.stats[source] = stat
+	}
+	// the empty range is skipped rather than failed.
+	if err != nil && errors.Cause(err) != berrors.ErrKVRangeIsEmpty { // nolint:errorlint
+		stat.Failures++
+		return
+	}
+	stat.Downloads++
+	stat.Bytes += file.GetTotalBytes()
+}
+
+func (s *storageSourceStats) result() []StorageSourceStat {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result := make([]StorageSourceStat, 0, len(s.stats))
+	for _, stat := range s.stats {
+		result = append(result, *stat)
+	}
+	// the primary storage goes first.
+	sort.Slice(result, func(i, j int) bool { return result[i].Source > result[j].Source })
+	return result
+}
+
+// SetStorageMirror sets the mirror storage of the backup, the files are
+// downloaded from it when the primary storage is throttled or fails by the
+// server. It must be called before InitBackupMeta.
+func (rc *Client) SetStorageMirror(backend *backuppb.StorageBackend) {
+	rc.storageMirror = backend
+}
+
+// GetStorageSourceStats returns the statistics of downloading the files from
+// the storages.
+func (rc *Client) GetStorageSourceStats() []StorageSourceStat {
+	return rc.fileImporter.sourceStats.result()
+}
+
+// downloadWithFailover downloads the file from the primary storage, and from
+// the mirror storage if the primary one is throttled or fails by the server.
+func (importer *FileImporter) downloadWithFailover(
+	file *backuppb.File,
+	download func(backend *backuppb.StorageBackend) (*import_sstpb.SSTMeta, error),
+) (*import_sstpb.SSTMeta, error) {
+	meta, err := download(importer.backend)
+	importer.sourceStats.record(PrimaryStorageSource, file, err)
+	if importer.mirrorBackend == nil || !isStorageThrottledError(err) {
+		return meta, err
+	}
+	log.Warn("failed to download the file from the primary storage, fail over to the mirror",
+		logutil.File(file), logutil.ShortError(err))
+	meta, err = download(importer.mirrorBackend)
+	importer.sourceStats.record(MirrorStorageSource, file, err)
+	return meta, err
+}
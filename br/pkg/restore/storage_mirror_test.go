@@ -0,0 +1,74 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/kvproto/pkg/import_sstpb"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsStorageThrottledError(t *testing.T) {
+	for msg, throttled := range map[string]bool{
+		"SlowDown: Please reduce your request rate. status code: 503":   true,
+		"googleapi: Error 503: backend error":                           true,
+		"RESPONSE Status: 500 Internal Server Error":                    true,
+		"Throttling: Rate exceeded":                                     true,
+		"NoSuchKey: The specified key does not exist. status code: 404": false,
+		"the file 503.sst is corrupted":                                 false,
+	} {
+		err := errors.Annotate(berrors.ErrKVDownloadFailed, msg)
+		require.Equal(t, throttled, isStorageThrottledError(err), msg)
+	}
+	require.False(t, isStorageThrottledError(nil))
+}
+
+func TestDownloadWithFailover(t *testing.T) {
+	primary := &backuppb.StorageBackend{Backend: &backuppb.StorageBackend_Local{Local: &backuppb.Local{Path: "/primary"}}}
+	mirror := &backuppb.StorageBackend{Backend: &backuppb.StorageBackend_Local{Local: &backuppb.Local{Path: "/mirror"}}}
+	importer := NewFileImporter(nil, nil, primary, TiDBMode)
+	file := &backuppb.File{Name: "1.sst", TotalBytes: 100}
+
+	throttled := errors.Annotate(berrors.ErrKVDownloadFailed, "SlowDown: status code: 503")
+	downloadFrom := func(failed *backuppb.StorageBackend, err error, used *[]*backuppb.StorageBackend) func(*backuppb.StorageBackend) (*import_sstpb.SSTMeta, error) {
+		return func(backend *backuppb.StorageBackend) (*import_sstpb.SSTMeta, error) {
+			*used = append(*used, backend)
+			if backend == failed {
+				return nil, err
+			}
+			return &import_sstpb.SSTMeta{}, nil
+		}
+	}
+
+	// no mirror to fail over to.
+	var used []*backuppb.StorageBackend
+	_, err := importer.downloadWithFailover(file, downloadFrom(primary, throttled, &used))
+	require.Error(t, err)
+	require.Equal(t, []*backuppb.StorageBackend{primary}, used)
+
+	importer.mirrorBackend = mirror
+	used = nil
+	_, err = importer.downloadWithFailover(file, downloadFrom(primary, throttled, &used))
+	require.NoError(t, err)
+	require.Equal(t, []*backuppb.StorageBackend{primary, mirror}, used)
+
+	// only the throttled and the server errors fail over.
+	used = nil
+	_, err = importer.downloadWithFailover(file, downloadFrom(primary, errors.New("file not found"), &used))
+	require.Error(t, err)
+	require.Equal(t, []*backuppb.StorageBackend{primary}, used)
+
+	used = nil
+	_, err = importer.downloadWithFailover(file, downloadFrom(nil, nil, &used))
+	require.NoError(t, err)
+	require.Equal(t, []*backuppb.StorageBackend{primary}, used)
+
+	require.Equal(t, []StorageSourceStat{
+		{Source: PrimaryStorageSource, Downloads: 1, Failures: 3, Bytes: 100},
+		{Source: MirrorStorageSource, Downloads: 1, Bytes: 100},
+	}, importer.sourceStats.result())
+}
@@ -0,0 +1,166 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/kvproto/pkg/import_sstpb"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/conn/util"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/version"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StoreRequirement is what restoring the data requires from the TiKV stores,
+// the data and the regions are assumed to be balanced between the stores.
+type StoreRequirement struct {
+	// TotalBytes is the bytes of the data to restore.
+	TotalBytes uint64
+	// Regions is the number of the regions split by restoring.
+	Regions uint64
+	// MaxStoreRegions is the most regions a store can hold, the region count
+	// of the stores isn't checked if it's 0.
+	MaxStoreRegions uint64
+}
+
+// StoreHealth is the result of checking a TiKV store before restoring, the
+// store can restore the data if it has no problems.
+type StoreHealth struct {
+	StoreID        uint64   `json:"store-id"`
+	Address        string   `json:"address"`
+	Version        string   `json:"version"`
+	AvailableBytes uint64   `json:"available-bytes"`
+	RequiredBytes  uint64   `json:"required-bytes"`
+	RegionCount    uint64   `json:"region-count"`
+	NewRegions     uint64   `json:"new-regions"`
+	Problems       []string `json:"problems,omitempty"`
+}
+
+func (h *StoreHealth) addProblem(format string, args ...interface{}) {
+	h.Problems = append(h.Problems, fmt.Sprintf(format, args...))
+}
+
+// StoreHealthError reports the problems of all the stores failing the checks.
+func StoreHealthError(stores []StoreHealth) error {
+	var buf strings.Builder
+	failed := 0
+	for _, s := range stores {
+		if len(s.Problems) == 0 {
+			continue
+		}
+		failed++
+		fmt.Fprintf(&buf, "\n  store %d (%s): %s", s.StoreID, s.Address, strings.Join(s.Problems, "; "))
+	}
+	if failed == 0 {
+		return nil
+	}
+	return errors.Annotatef(berrors.ErrRestoreStoreUnhealthy,
+		"%d of %d stores failed the checks before restoring:%s", failed, len(stores), buf.String())
+}
+
+// CheckStores checks every TiKV store concurrently for the free disk space,
+// the import mode support, the version of the SST importer and the headroom
+// of the region count before restoring. It returns the results of all the
+// stores, the problems of them are reported together by StoreHealthError.
+func (rc *Client) CheckStores(ctx context.Context, getter StoreInfoGetter, req StoreRequirement) ([]StoreHealth, error) {
+	stores, err := util.GetAllTiKVStores(ctx, rc.pdClient, util.SkipTiFlash)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(stores) == 0 {
+		return nil, nil
+	}
+	requiredBytes := req.TotalBytes * planReplicaCount / uint64(len(stores))
+	newRegions := req.Regions * planReplicaCount / uint64(len(stores))
+
+	results := make([]StoreHealth, len(stores))
+	eg, ectx := errgroup.WithContext(ctx)
+	for i, store := range stores {
+		if err := ectx.Err(); err != nil {
+			return nil, errors.Trace(err)
+		}
+		i, store := i, store
+		rc.workerPool.ApplyOnErrorGroup(eg, func() error {
+			results[i] = rc.checkStore(ectx, getter, store, requiredBytes, newRegions, req.MaxStoreRegions)
+			// the problems of the store are reported after checking all the stores.
+			return errors.Trace(ectx.Err())
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return results, nil
+}
+
+func (rc *Client) checkStore(
+	ctx context.Context,
+	getter StoreInfoGetter,
+	store *metapb.Store,
+	requiredBytes, newRegions, maxRegions uint64,
+) StoreHealth {
+	h := StoreHealth{
+		StoreID:       store.GetId(),
+		Address:       store.GetAddress(),
+		Version:       store.GetVersion(),
+		RequiredBytes: requiredBytes,
+		NewRegions:    newRegions,
+	}
+	defer func() {
+		log.Info("checked the store before restoring", zap.Uint64("store", h.StoreID),
+			zap.String("address", h.Address), zap.Strings("problems", h.Problems))
+	}()
+	if store.GetState() != metapb.StoreState_Up {
+		h.addProblem("the store is %s", store.GetState())
+		return h
+	}
+	if err := version.CheckStoreVersionForBR(store); err != nil {
+		h.addProblem("the version of the sst importer is incompatible: %s", err)
+	}
+
+	info, err := getter.GetStoreInfo(ctx, store.GetId())
+	if err != nil {
+		h.addProblem("failed to get the status: %s", err)
+	} else if info.Status != nil {
+		h.AvailableBytes = uint64(info.Status.Available)
+		h.RegionCount = uint64(info.Status.RegionCount)
+		if h.AvailableBytes < requiredBytes {
+			h.addProblem("%d bytes are available, but about %d bytes are required", h.AvailableBytes, requiredBytes)
+		}
+		if maxRegions > 0 && h.RegionCount+newRegions > maxRegions {
+			h.addProblem("%d regions are on the store, about %d regions would be added but at most %d regions are allowed",
+				h.RegionCount, newRegions, maxRegions)
+		}
+	}
+
+	if err := rc.checkImportMode(ctx, store.GetId()); err != nil {
+		h.addProblem("%s", err)
+	}
+	return h
+}
+
+// checkImportMode checks the SST importer of the store can switch the mode.
+// It switches the store to the normal mode, which is harmless before
+// restoring since the stores are switched to the import mode later.
+func (rc *Client) checkImportMode(ctx context.Context, storeID uint64) error {
+	client, err := rc.fileImporter.importClient.GetImportClient(ctx, storeID)
+	if err != nil {
+		return errors.Annotate(err, "the sst importer is unreachable")
+	}
+	_, err = client.SwitchMode(ctx, &import_sstpb.SwitchModeRequest{Mode: import_sstpb.SwitchMode_Normal})
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.Unimplemented {
+			return errors.New("the import mode isn't supported")
+		}
+		return errors.Annotate(err, "failed to switch the import mode")
+	}
+	return nil
+}
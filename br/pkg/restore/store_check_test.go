@@ -0,0 +1,121 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/kvproto/pkg/import_sstpb"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/br/pkg/version/build"
+	"github.com/pingcap/tidb/store/pdtypes"
+	"github.com/stretchr/testify/require"
+	pd "github.com/tikv/pd/client"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type storeCheckPDClient struct {
+	pd.Client
+	stores []*metapb.Store
+}
+
+func (c storeCheckPDClient) GetAllStores(context.Context, ...pd.GetStoreOption) ([]*metapb.Store, error) {
+	return c.stores, nil
+}
+
+type storeCheckInfoGetter map[uint64]*pdtypes.StoreStatus
+
+func (g storeCheckInfoGetter) GetStoreInfo(_ context.Context, storeID uint64) (*pdtypes.StoreInfo, error) {
+	return &pdtypes.StoreInfo{Status: g[storeID]}, nil
+}
+
+type storeCheckImporter struct {
+	ImporterClient
+	unimplemented map[uint64]bool
+}
+
+func (i storeCheckImporter) GetImportClient(_ context.Context, storeID uint64) (import_sstpb.ImportSSTClient, error) {
+	return storeCheckImportSSTClient{unimplemented: i.unimplemented[storeID]}, nil
+}
+
+type storeCheckImportSSTClient struct {
+	import_sstpb.ImportSSTClient
+	unimplemented bool
+}
+
+func (c storeCheckImportSSTClient) SwitchMode(
+	context.Context, *import_sstpb.SwitchModeRequest, ...grpc.CallOption,
+) (*import_sstpb.SwitchModeResponse, error) {
+	if c.unimplemented {
+		return nil, status.Error(codes.Unimplemented, "unimplemented")
+	}
+	return &import_sstpb.SwitchModeResponse{}, nil
+}
+
+func TestCheckStores(t *testing.T) {
+	oldReleaseVersion := build.ReleaseVersion
+	defer func() {
+		build.ReleaseVersion = oldReleaseVersion
+	}()
+	build.ReleaseVersion = "v6.2.0"
+
+	tikv := func(id uint64, version string) *metapb.Store {
+		return &metapb.Store{Id: id, Address: "tikv", Version: version, State: metapb.StoreState_Up}
+	}
+	stores := []*metapb.Store{
+		tikv(1, "v6.2.0"),
+		tikv(2, "v6.2.0"),
+		tikv(3, "v3.0.0"),
+		tikv(4, "v6.2.0"),
+		tikv(5, "v6.2.0"),
+		{Id: 6, Version: "v6.2.0", Labels: []*metapb.StoreLabel{{Key: "engine", Value: "tiflash"}}},
+	}
+	stores[3].State = metapb.StoreState_Offline
+	rc := &Client{
+		pdClient:     storeCheckPDClient{stores: stores},
+		workerPool:   utils.NewWorkerPool(2, "test"),
+		fileImporter: FileImporter{importClient: storeCheckImporter{unimplemented: map[uint64]bool{3: true}}},
+	}
+	getter := storeCheckInfoGetter{
+		1: {Available: 1000, RegionCount: 100},
+		2: {Available: 100, RegionCount: 100},
+		3: {Available: 1000, RegionCount: 100},
+		4: {Available: 1000, RegionCount: 100},
+		5: {Available: 1000, RegionCount: 950},
+	}
+
+	// the data and the regions of the 3 replicas are balanced between the 5 TiKV stores.
+	results, err := rc.CheckStores(context.Background(), getter, StoreRequirement{
+		TotalBytes: 1000, Regions: 100, MaxStoreRegions: 1000,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+	problems := make(map[uint64]int)
+	for _, h := range results {
+		require.Equal(t, uint64(600), h.RequiredBytes)
+		require.Equal(t, uint64(60), h.NewRegions)
+		problems[h.StoreID] = len(h.Problems)
+	}
+	require.Equal(t, map[uint64]int{1: 0, 2: 1, 3: 2, 4: 1, 5: 1}, problems)
+
+	err = StoreHealthError(results)
+	require.True(t, berrors.ErrRestoreStoreUnhealthy.Equal(err))
+	require.ErrorContains(t, err, "4 of 5 stores")
+	require.ErrorContains(t, err, "import mode isn't supported")
+	require.ErrorContains(t, err, "Offline")
+	require.NoError(t, StoreHealthError(results[:1]))
+
+	// the region count isn't checked without the limit.
+	results, err = rc.CheckStores(context.Background(), getter, StoreRequirement{TotalBytes: 1000, Regions: 100})
+	require.NoError(t, err)
+	for _, h := range results {
+		if h.StoreID == 5 {
+			require.Empty(t, h.Problems)
+		}
+	}
+}
@@ -0,0 +1,290 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/logutil"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/br/pkg/stream"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// compactedDataFileDir is the directory of the data files merged by the compaction.
+	compactedDataFileDir = "v1/compacted"
+
+	defaultStreamCompactionConcurrency = 16
+)
+
+// StreamCompactionConfig is the config of compacting the data files of log backup.
+type StreamCompactionConfig struct {
+	// SmallFileSize is the size under which the data files are compacted.
+	SmallFileSize uint64
+	// TargetFileSize is the max size of the compacted data files.
+	TargetFileSize uint64
+	// Until is the ts only the data files fully before it are compacted. It's
+	// usually the global checkpoint of the log backup, so the data files
+	// still being flushed are never touched.
+	Until       uint64
+	Concurrency uint
+	DryRun      bool
+}
+
+// StreamCompactionStats is the stats of a compaction.
+type StreamCompactionStats struct {
+	InputFiles  int    `json:"input_files"`
+	InputSize   uint64 `json:"input_size"`
+	OutputFiles int    `json:"output_files"`
+	OutputSize  uint64 `json:"output_size"`
+}
+
+type compactionGroupKey struct {
+	tableID int64
+	cf      string
+	tp      backuppb.FileType
+}
+
+type compactionBatch struct {
+	files  []*backuppb.DataFileInfo
+	merged *backuppb.DataFileInfo
+}
+
+// CompactStreamFiles merges the small data files of the log backup into the
+// larger sorted ones and rewrites the metadata. It's safe to run with the
+// running log backup task, because the log backup only adds new metadata
+// files and the metadata files fully before the checkpoint are never changed
+// by it. The merged files are written with a new metadata file before the
+// origin ones are removed from the old metadata files, so the log backup is
+// always restorable even if the compaction is interrupted, the duplicated
+// entries are applied idempotently by the restore.
+func CompactStreamFiles(
+	ctx context.Context,
+	s storage.ExternalStorage,
+	cfg StreamCompactionConfig,
+) (*StreamCompactionStats, error) {
+	if cfg.TargetFileSize <= cfg.SmallFileSize {
+		return nil, errors.Annotatef(berrors.ErrInvalidArgument,
+			"the target file size %d should be larger than the small file size %d", cfg.TargetFileSize, cfg.SmallFileSize)
+	}
+	if cfg.Concurrency == 0 {
+		cfg.Concurrency = defaultStreamCompactionConcurrency
+	}
+	metas := StreamMetadataSet{}
+	if err := metas.LoadUntil(ctx, s, cfg.Until); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	groups := make(map[compactionGroupKey][]*backuppb.DataFileInfo)
+	for _, m := range metas.metadata {
+		for _, d := range m.Files {
+			if !isCompactable(d, &cfg) {
+				continue
+			}
+			key := compactionGroupKey{tableID: d.TableId, cf: d.Cf, tp: d.Type}
+			groups[key] = append(groups[key], d)
+		}
+	}
+	batches := make([]*compactionBatch, 0)
+	for _, files := range groups {
+		// merge the files close in time, so the restore can still skip the
+		// compacted files by their ts ranges.
+		sort.Slice(files, func(i, j int) bool { return files[i].MinTs < files[j].MinTs })
+		var batch *compactionBatch
+		var size uint64
+		for _, d := range files {
+			if batch == nil || size+d.Length > cfg.TargetFileSize {
+				if batch != nil && len(batch.files) > 1 {
+					batches = append(batches, batch)
+				}
+				batch, size = &compactionBatch{}, 0
+			}
+			batch.files = append(batch.files, d)
+			size += d.Length
+		}
+		if batch != nil && len(batch.files) > 1 {
+			batches = append(batches, batch)
+		}
+	}
+
+	stats := &StreamCompactionStats{OutputFiles: len(batches)}
+	for _, b := range batches {
+		stats.InputFiles += len(b.files)
+		for _, d := range b.files {
+			stats.InputSize += d.Length
+		}
+	}
+	if cfg.DryRun || len(batches) == 0 {
+		return stats, nil
+	}
+
+	var mu sync.Mutex
+	pool := utils.NewWorkerPool(cfg.Concurrency, "compact log files")
+	eg, ectx := errgroup.WithContext(ctx)
+	for _, b := range batches {
+		b := b
+		pool.ApplyOnErrorGroup(eg, func() error {
+			merged, err := mergeDataFiles(ectx, s, b.files)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			b.merged = merged
+			mu.Lock()
+			stats.OutputSize += merged.Length
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	compacted := &backuppb.Metadata{}
+	removed := make(map[string]struct{})
+	for i, b := range batches {
+		compacted.Files = append(compacted.Files, b.merged)
+		if i == 0 || b.merged.MinTs < compacted.MinTs {
+			compacted.MinTs = b.merged.MinTs
+		}
+		if b.merged.MaxTs > compacted.MaxTs {
+			compacted.MaxTs = b.merged.MaxTs
+		}
+		for _, d := range b.files {
+			removed[d.Path] = struct{}{}
+		}
+	}
+	compacted.ResolvedTs = cfg.Until
+	data, err := compacted.Marshal()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	metaPath := path.Join(stream.GetStreamBackupMetaPrefix(), fmt.Sprintf("%016X-compacted-%s.meta", cfg.Until, uuid.New()))
+	if err := s.WriteFile(ctx, metaPath, data); err != nil {
+		return nil, errors.Annotate(err, "failed to write the metadata of the compacted files")
+	}
+
+	for metaPath, m := range metas.metadata {
+		remained := make([]*backuppb.DataFileInfo, 0, len(m.Files))
+		for _, d := range m.Files {
+			if _, ok := removed[d.Path]; !ok {
+				remained = append(remained, d)
+			}
+		}
+		if len(remained) != len(m.Files) {
+			mCopy := *m
+			mCopy.Files = remained
+			metas.WriteBack(metaPath, &mCopy)
+		}
+	}
+	if err := metas.DoWriteBack(ctx, s); err != nil {
+		return nil, errors.Trace(err)
+	}
+	for p := range removed {
+		if err := s.DeleteFile(ctx, p); err != nil {
+			log.Warn("failed to delete the compacted file, you may delete it manually",
+				zap.String("path", p), logutil.ShortError(err))
+		}
+	}
+	log.Info("compact the log backup files", zap.Int("input-files", stats.InputFiles),
+		zap.Uint64("input-size", stats.InputSize), zap.Int("output-files", stats.OutputFiles),
+		zap.Uint64("output-size", stats.OutputSize), zap.String("meta", metaPath))
+	return stats, nil
+}
+
+// isCompactable checks whether the data file can be compacted. The meta kv
+// files are replayed by the order of the ts, so they aren't compacted.
+func isCompactable(d *backuppb.DataFileInfo, cfg *StreamCompactionConfig) bool {
+	return !d.IsMeta && d.MaxTs < cfg.Until && d.Length < cfg.SmallFileSize &&
+		// the files partitioned or compressed can't be read as a whole.
+		d.Offset == 0 && (d.CompressLength == 0 || d.CompressLength == d.Length)
+}
+
+// mergeDataFiles merges the kv entries of the files sorted by the keys into
+// a new data file.
+func mergeDataFiles(
+	ctx context.Context,
+	s storage.ExternalStorage,
+	files []*backuppb.DataFileInfo,
+) (*backuppb.DataFileInfo, error) {
+	type entry struct {
+		key, value []byte
+	}
+	entries := make([]entry, 0)
+	merged := &backuppb.DataFileInfo{
+		Cf:      files[0].Cf,
+		Type:    files[0].Type,
+		TableId: files[0].TableId,
+	}
+	for i, d := range files {
+		buff, err := s.ReadFile(ctx, d.Path)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if checksum := sha256.Sum256(buff); !bytes.Equal(checksum[:], d.GetSha256()) {
+			return nil, errors.Annotatef(berrors.ErrInvalidMetaFile,
+				"checksum mismatch of %s expect %x, got %x", d.Path, d.GetSha256(), checksum[:])
+		}
+		iter := stream.NewEventIterator(buff)
+		for iter.Valid() {
+			iter.Next()
+			if iter.GetError() != nil {
+				return nil, errors.Annotatef(iter.GetError(), "failed to decode %s", d.Path)
+			}
+			entries = append(entries, entry{key: iter.Key(), value: iter.Value()})
+		}
+
+		if i == 0 || d.MinTs < merged.MinTs {
+			merged.MinTs = d.MinTs
+		}
+		if d.MaxTs > merged.MaxTs {
+			merged.MaxTs = d.MaxTs
+		}
+		if i == 0 || d.ResolvedTs < merged.ResolvedTs {
+			merged.ResolvedTs = d.ResolvedTs
+		}
+		if d.MinBeginTsInDefaultCf > 0 &&
+			(merged.MinBeginTsInDefaultCf == 0 || d.MinBeginTsInDefaultCf < merged.MinBeginTsInDefaultCf) {
+			merged.MinBeginTsInDefaultCf = d.MinBeginTsInDefaultCf
+		}
+		if i == 0 || bytes.Compare(d.StartKey, merged.StartKey) < 0 {
+			merged.StartKey = d.StartKey
+		}
+		if bytes.Compare(d.EndKey, merged.EndKey) > 0 {
+			merged.EndKey = d.EndKey
+		}
+	}
+
+	// the keys are encoded with the ts, the same key means the same entry
+	// recorded more than once.
+	sort.SliceStable(entries, func(i, j int) bool { return bytes.Compare(entries[i].key, entries[j].key) < 0 })
+	buff := make([]byte, 0)
+	for i, e := range entries {
+		if i > 0 && bytes.Equal(e.key, entries[i-1].key) && len(entries[i-1].value) > 0 {
+			continue
+		}
+		buff = append(buff, stream.EncodeKVEntry(e.key, e.value)...)
+		merged.NumberOfEntries++
+	}
+	checksum := sha256.Sum256(buff)
+	merged.Sha256 = checksum[:]
+	merged.Length = uint64(len(buff))
+	merged.Path = path.Join(compactedDataFileDir, fmt.Sprintf("%016X-%s.log", merged.MinTs, uuid.New()))
+	if err := s.WriteFile(ctx, merged.Path, buff); err != nil {
+		return nil, errors.Annotatef(err, "failed to write the compacted file %s", merged.Path)
+	}
+	return merged, nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/restore"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/br/pkg/stream"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompactStreamFiles(t *testing.T) {
+	ctx := context.Background()
+	s, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	writeDataFile := func(name string, tableID int64, ts uint64, keys ...string) *backuppb.DataFileInfo {
+		buff := make([]byte, 0)
+		for _, k := range keys {
+			buff = append(buff, stream.EncodeKVEntry([]byte(k), []byte("v"+k))...)
+		}
+		require.NoError(t, s.WriteFile(ctx, name, buff))
+		checksum := sha256.Sum256(buff)
+		return &backuppb.DataFileInfo{
+			Path: name, Sha256: checksum[:], Cf: stream.WriteCF, TableId: tableID,
+			MinTs: ts, MaxTs: ts + 1, ResolvedTs: ts + 1, Length: uint64(len(buff)), NumberOfEntries: int64(len(keys)),
+			StartKey: []byte("z" + keys[0]), EndKey: []byte("z" + keys[len(keys)-1]),
+		}
+	}
+	metas := []*backuppb.Metadata{
+		{Files: []*backuppb.DataFileInfo{
+			writeDataFile("1.log", 1, 10, "b", "d"),
+			writeDataFile("2.log", 2, 10, "x"),
+		}, MinTs: 10, MaxTs: 11},
+		{Files: []*backuppb.DataFileInfo{
+			writeDataFile("3.log", 1, 20, "a", "c"),
+			// the file isn't flushed before the checkpoint.
+			writeDataFile("4.log", 1, 100, "e"),
+		}, MinTs: 20, MaxTs: 101},
+		{Files: []*backuppb.DataFileInfo{
+			// the entry recorded twice.
+			writeDataFile("5.log", 1, 30, "c"),
+		}, MinTs: 30, MaxTs: 31},
+	}
+	for i, m := range metas {
+		data, err := m.Marshal()
+		require.NoError(t, err)
+		require.NoError(t, s.WriteFile(ctx, fmt.Sprintf("%s/%04d.meta", stream.GetStreamBackupMetaPrefix(), i), data))
+	}
+
+	cfg := restore.StreamCompactionConfig{SmallFileSize: 1024, TargetFileSize: 4096, Until: 50, Concurrency: 2, DryRun: true}
+	stats, err := restore.CompactStreamFiles(ctx, s, cfg)
+	require.NoError(t, err)
+	require.Equal(t, 3, stats.InputFiles)
+	require.Equal(t, 1, stats.OutputFiles)
+	exists, err := s.FileExists(ctx, "1.log")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	cfg.DryRun = false
+	stats, err = restore.CompactStreamFiles(ctx, s, cfg)
+	require.NoError(t, err)
+	require.Equal(t, 3, stats.InputFiles)
+	require.Equal(t, 1, stats.OutputFiles)
+	for _, name := range []string{"1.log", "3.log", "5.log"} {
+		exists, err := s.FileExists(ctx, name)
+		require.NoError(t, err)
+		require.False(t, exists)
+	}
+
+	files := make(map[string]*backuppb.DataFileInfo)
+	err = stream.FastUnmarshalMetaData(ctx, s, func(_ string, m *backuppb.Metadata) error {
+		for _, d := range m.Files {
+			files[d.Path] = d
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	require.Contains(t, files, "2.log")
+	require.Contains(t, files, "4.log")
+	delete(files, "2.log")
+	delete(files, "4.log")
+	for _, merged := range files {
+		require.Equal(t, int64(1), merged.TableId)
+		require.Equal(t, uint64(10), merged.MinTs)
+		require.Equal(t, uint64(31), merged.MaxTs)
+		require.Equal(t, []byte("za"), merged.StartKey)
+		require.Equal(t, []byte("zd"), merged.EndKey)
+		require.Equal(t, int64(4), merged.NumberOfEntries)
+
+		buff, err := s.ReadFile(ctx, merged.Path)
+		require.NoError(t, err)
+		checksum := sha256.Sum256(buff)
+		require.Equal(t, checksum[:], merged.Sha256)
+		keys := make([]string, 0)
+		iter := stream.NewEventIterator(buff)
+		for iter.Valid() {
+			iter.Next()
+			require.NoError(t, iter.GetError())
+			keys = append(keys, string(iter.Key()))
+		}
+		require.Equal(t, []string{"a", "b", "c", "d"}, keys)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+)
+
+// SysTableMergePolicy is how to merge the rows of the privilege tables in the
+// backup into the existing rows of the cluster.
+type SysTableMergePolicy string
+
+const (
+	// SysTableMergeNone doesn't merge the privilege tables, the tables must be
+	// compatible with the cluster and the rows replace the existing rows.
+	SysTableMergeNone SysTableMergePolicy = ""
+	// SysTableMergeSkip keeps the existing rows when the rows conflict.
+	SysTableMergeSkip SysTableMergePolicy = "skip"
+	// SysTableMergeOverwrite overwrites the existing rows when the rows conflict.
+	SysTableMergeOverwrite SysTableMergePolicy = "overwrite"
+	// SysTableMergeError fails the restore of the table when the rows conflict.
+	SysTableMergeError SysTableMergePolicy = "error"
+)
+
+// mergeableSysTables are the system tables which can be merged, the columns
+// added by the newer versions are filled with the defaults.
+var mergeableSysTables = map[string]struct{}{
+	"user": {},
+	"db":   {},
+}
+
+func isMergeableSysTable(tableName string) bool {
+	_, ok := mergeableSysTables[tableName]
+	return ok
+}
+
+// ParseSysTableMergePolicy parses the merge policy of the privilege tables.
+func ParseSysTableMergePolicy(s string) (SysTableMergePolicy, error) {
+	switch policy := SysTableMergePolicy(strings.ToLower(strings.TrimSpace(s))); policy {
+	case SysTableMergeNone, SysTableMergeSkip, SysTableMergeOverwrite, SysTableMergeError:
+		return policy, nil
+	default:
+		return SysTableMergeNone, errors.Annotatef(berrors.ErrInvalidArgument,
+			"invalid system table merge policy %q, it should be one of skip, overwrite and error", s)
+	}
+}
+
+// canFillByDefault checks whether the column can be omitted when inserting.
+func canFillByDefault(col *model.ColumnInfo) bool {
+	return !mysql.HasNotNullFlag(col.GetFlag()) ||
+		col.GetDefaultValue() != nil || col.DefaultIsExpr ||
+		mysql.HasAutoIncrementFlag(col.GetFlag())
+}
+
+// mergeSysTableSQL returns the SQL merging the rows of the temporary table into
+// the system table by the policy. Only the columns exist in both tables are
+// inserted, so the other columns of the system table get their defaults.
+func mergeSysTableSQL(
+	policy SysTableMergePolicy,
+	backupTi, clusterTi *model.TableInfo,
+	db *database,
+	whereClause string,
+) string {
+	columnNames := make([]string, 0, len(backupTi.Columns))
+	for _, col := range backupTi.Columns {
+		if model.FindColumnInfo(clusterTi.Columns, col.Name.L) != nil {
+			columnNames = append(columnNames, utils.EncloseName(col.Name.L))
+		}
+	}
+	colListStr := strings.Join(columnNames, ",")
+	var insert string
+	switch policy {
+	case SysTableMergeSkip:
+		insert = "INSERT IGNORE INTO"
+	case SysTableMergeOverwrite:
+		insert = "REPLACE INTO"
+	default:
+		// the statement fails on the duplicated keys, and nothing is inserted.
+		insert = "INSERT INTO"
+	}
+	return fmt.Sprintf("%s %s(%s) SELECT %s FROM %s %s;",
+		insert,
+		utils.EncloseDBAndTable(db.Name.L, clusterTi.Name.L),
+		colListStr, colListStr,
+		utils.EncloseDBAndTable(db.TemporaryName.L, backupTi.Name.L),
+		whereClause)
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSysTableMergePolicy(t *testing.T) {
+	for s, expected := range map[string]SysTableMergePolicy{
+		"":           SysTableMergeNone,
+		"skip":       SysTableMergeSkip,
+		" OVERWRITE": SysTableMergeOverwrite,
+		"error":      SysTableMergeError,
+	} {
+		policy, err := ParseSysTableMergePolicy(s)
+		require.NoError(t, err)
+		require.Equal(t, expected, policy)
+	}
+	_, err := ParseSysTableMergePolicy("merge")
+	require.Error(t, err)
+}
+
+func TestMergeSysTableSQL(t *testing.T) {
+	newCol := func(name string, flag uint, defaultValue interface{}) *model.ColumnInfo {
+		col := &model.ColumnInfo{Name: model.NewCIStr(name), FieldType: *types.NewFieldType(mysql.TypeString)}
+		col.AddFlag(flag)
+		require.NoError(t, col.SetDefaultValue(defaultValue))
+		return col
+	}
+	host := newCol("Host", mysql.NotNullFlag, "")
+	user := newCol("User", mysql.NotNullFlag, "")
+	priv := newCol("Select_priv", mysql.NotNullFlag, "N")
+	noDefault := newCol("Plugin", mysql.NotNullFlag, nil)
+	nullable := newCol("Comment", 0, nil)
+	require.True(t, canFillByDefault(priv))
+	require.True(t, canFillByDefault(nullable))
+	require.False(t, canFillByDefault(noDefault))
+
+	backupTi := &model.TableInfo{Name: model.NewCIStr("user"), Columns: []*model.ColumnInfo{user, host}}
+	clusterTi := &model.TableInfo{Name: model.NewCIStr("user"), Columns: []*model.ColumnInfo{host, user, priv}}
+	db := &database{Name: model.NewCIStr(mysql.SystemDB), TemporaryName: utils.TemporaryDBName(mysql.SystemDB)}
+
+	for policy, insert := range map[SysTableMergePolicy]string{
+		SysTableMergeSkip:      "INSERT IGNORE INTO",
+		SysTableMergeOverwrite: "REPLACE INTO",
+		SysTableMergeError:     "INSERT INTO",
+	} {
+		require.Equal(t,
+			insert+" `mysql`.`user`(`user`,`host`) SELECT `user`,`host` FROM `__tidb_br_temporary_mysql`.`user` WHERE x;",
+			mergeSysTableSQL(policy, backupTi, clusterTi, db, "WHERE x"))
+	}
+}
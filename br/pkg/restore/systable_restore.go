@@ -42,6 +42,8 @@ var unRecoverableTable = map[string]struct{}{
 
 	// schema_index_usage has table id need to be rewrite.
 	"schema_index_usage": {},
+	// the progress of the tasks of the backup cluster.
+	"tidb_br_progress": {},
 }
 
 // tables in this map is restored when fullClusterRestore=true
@@ -200,6 +202,20 @@ func (rc *Client) replaceTemporaryTableToSystable(ctx context.Context, ti *model
 		return berrors.ErrUnsupportedSystemTable.GenWithStack("restoring unsupported `mysql` schema table")
 	}
 
+	if clusterTi := db.ExistingTables[tableName]; clusterTi != nil &&
+		rc.sysTableMergePolicy != SysTableMergeNone && isMergeableSysTable(tableName) {
+		whereClause := ""
+		if sysPrivilegeTableMap[tableName] != "" {
+			// cloud_admin is a special user on tidb cloud, need to skip it.
+			whereClause = fmt.Sprintf("WHERE %s", sysPrivilegeTableMap[tableName])
+		}
+		log.Info("merge into existing table",
+			zap.String("table", tableName),
+			zap.Stringer("schema", db.Name),
+			zap.String("policy", string(rc.sysTableMergePolicy)))
+		return execSQL(mergeSysTableSQL(rc.sysTableMergePolicy, ti, clusterTi, db, whereClause))
+	}
+
 	if db.ExistingTables[tableName] != nil {
 		whereClause := ""
 		if rc.fullClusterRestore && sysPrivilegeTableMap[tableName] != "" {
@@ -240,8 +256,7 @@ func (rc *Client) replaceTemporaryTableToSystable(ctx context.Context, ti *model
 func (rc *Client) cleanTemporaryDatabase(ctx context.Context, originDB string) {
 	database := utils.TemporaryDBName(originDB)
 	log.Debug("dropping temporary database", zap.Stringer("database", database))
-	sql := fmt.Sprintf("DROP DATABASE IF EXISTS %s", utils.EncloseName(database.L))
-	if err := rc.db.se.Execute(ctx, sql); err != nil {
+	if err := dropTemporaryDB(ctx, rc.db.se, database); err != nil {
 		logutil.WarnTerm("failed to drop temporary database, it should be dropped manually",
 			zap.Stringer("database", database),
 			logutil.ShortError(err),
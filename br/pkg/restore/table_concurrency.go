@@ -0,0 +1,268 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/glue"
+	"github.com/pingcap/tidb/br/pkg/logutil"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/tablecodec"
+	"golang.org/x/sync/errgroup"
+)
+
+// tableNameOf returns the table of the physical ID, the unknown tables are
+// named by their physical IDs.
+func tableNameOf(names map[int64]UniqueTableName, physicalID int64) UniqueTableName {
+	if name, ok := names[physicalID]; ok {
+		return name
+	}
+	return UniqueTableName{Table: strconv.FormatInt(physicalID, 10)}
+}
+
+// tableConcurrencyLimiter caps the ranges of a table ingested concurrently, so
+// a table whose files are slow to ingest, e.g. retried for the corrupted
+// files, can't occupy all the workers of the pool. It's shared by the batches
+// restored concurrently.
+type tableConcurrencyLimiter struct {
+	// tableNames maps the physical table IDs in the backup to the table names,
+	// so the partitions share the limit of their table.
+	tableNames map[int64]UniqueTableName
+	limit      int
+
+	mu      sync.Mutex
+	cond    *sync.Cond
+	running map[UniqueTableName]int
+}
+
+func newTableConcurrencyLimiter(databases map[string]*utils.Database, limit int) *tableConcurrencyLimiter {
+	l := &tableConcurrencyLimiter{
+		tableNames: tableNamesOf(databases),
+		limit:      limit,
+		running:    make(map[UniqueTableName]int),
+	}
+	l.cond = sync.NewCond(&l.mu)
+	return l
+}
+
+// tableQueue is the ranges of a table waiting to restore.
+type tableQueue struct {
+	table  UniqueTableName
+	ranges [][]*backuppb.File
+}
+
+// queue groups the ranges by their tables, the tables are in the order they
+// appear in the ranges.
+func (l *tableConcurrencyLimiter) queue(ranges [][]*backuppb.File) []*tableQueue {
+	queues := make([]*tableQueue, 0)
+	index := make(map[UniqueTableName]int)
+	for _, files := range ranges {
+		table := tableNameOf(l.tableNames, tablecodec.DecodeTableID(files[0].GetStartKey()))
+		i, ok := index[table]
+		if !ok {
+			i = len(queues)
+			index[table] = i
+			queues = append(queues, &tableQueue{table: table})
+		}
+		queues[i].ranges = append(queues[i].ranges, files)
+	}
+	return queues
+}
+
+// acquire takes the next range of the first table in the queues whose ranges
+// ingesting are less than the limit. It waits for any range of the tables to
+// be done if all of them reach the limit, and returns false once the queues
+// are drained or the context is done.
+func (l *tableConcurrencyLimiter) acquire(ctx context.Context, queues []*tableQueue) (UniqueTableName, []*backuppb.File, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for ctx.Err() == nil {
+		drained := true
+		for _, q := range queues {
+			if len(q.ranges) == 0 {
+				continue
+			}
+			drained = false
+			if l.running[q.table] < l.limit {
+				files := q.ranges[0]
+				q.ranges = q.ranges[1:]
+				l.running[q.table]++
+				return q.table, files, true
+			}
+		}
+		if drained {
+			break
+		}
+		// the ranges ingesting release the tables and wake it up, even if
+		// they're canceled.
+		l.cond.Wait()
+	}
+	return UniqueTableName{}, nil, false
+}
+
+// release marks a range of the table acquired is done.
+func (l *tableConcurrencyLimiter) release(table UniqueTableName) {
+	l.mu.Lock()
+	if l.running[table]--; l.running[table] <= 0 {
+		delete(l.running, table)
+	}
+	l.mu.Unlock()
+	l.cond.Broadcast()
+}
+
+// SetTableConcurrency caps the ranges of a table ingested concurrently by the
+// fine-grained restore, it's unlimited if the limit is 0. It must be called
+// after InitBackupMeta.
+func (rc *Client) SetTableConcurrency(limit uint) {
+	if limit == 0 {
+		return
+	}
+	rc.tableConcurrency = newTableConcurrencyLimiter(rc.databases, int(limit))
+}
+
+// restoreSSTFilesByTable restores the ranges of the files by the worker pool
+// like restoreSSTFilesByRange, but every table takes the workers up to the
+// table concurrency, the ranges of the other tables are taken once a table
+// reaches it.
+func (rc *Client) restoreSSTFilesByTable(
+	ctx context.Context,
+	files []*backuppb.File,
+	rewriteRules *RewriteRules,
+	updateCh glue.Progress,
+) error {
+	var ranges [][]*backuppb.File
+	var rangeFiles []*backuppb.File
+	var leftFiles []*backuppb.File
+	for rangeFiles, leftFiles = drainFilesByRange(files, rc.fileImporter.supportMultiIngest); len(rangeFiles) != 0; rangeFiles, leftFiles = drainFilesByRange(leftFiles, rc.fileImporter.supportMultiIngest) {
+		if rc.isFilesRestored(rangeFiles) {
+			log.Info("skip the files imported by the previous restore", logutil.Files(rangeFiles))
+			rc.restoreProgress.OnFilesIngested(rangeFiles)
+			updateCh.Inc()
+			continue
+		}
+		ranges = append(ranges, rangeFiles)
+	}
+	queues := rc.tableConcurrency.queue(ranges)
+
+	eg, ectx := errgroup.WithContext(ctx)
+	for {
+		table, tableFiles, ok := rc.tableConcurrency.acquire(ectx, queues)
+		if !ok {
+			break
+		}
+		rc.workerPool.ApplyOnErrorGroup(eg, func() error {
+			defer rc.tableConcurrency.release(table)
+			return rc.restoreRangeFiles(ectx, tableFiles, rewriteRules, updateCh)
+		})
+	}
+	return eg.Wait()
+}
+
+// TableIngestStat is the files of a table ingested.
+type TableIngestStat struct {
+	Table UniqueTableName
+	Files int
+	Bytes uint64
+	// Elapsed is the time from the first range of the table starting to
+	// restore to the last one done.
+	Elapsed time.Duration
+}
+
+// Throughput returns the bytes ingested per second.
+func (s TableIngestStat) Throughput() float64 {
+	if s.Elapsed <= 0 {
+		return 0
+	}
+	return float64(s.Bytes) / s.Elapsed.Seconds()
+}
+
+type tableIngestCollector struct {
+	mu sync.Mutex
+	// stats are the files ingested by the physical table IDs in the backup.
+	stats map[int64]*tableIngestStat
+}
+
+type tableIngestStat struct {
+	TableIngestStat
+	start, end time.Time
+}
+
+func (s *tableIngestStat) merge(files int, bytes uint64, start, end time.Time) {
+	if s.start.IsZero() || start.Before(s.start) {
+		s.start = start
+	}
+	if end.After(s.end) {
+		s.end = end
+	}
+	s.Files += files
+	s.Bytes += bytes
+	s.Elapsed = s.end.Sub(s.start)
+}
+
+func newTableIngestCollector() *tableIngestCollector {
+	return &tableIngestCollector{stats: make(map[int64]*tableIngestStat)}
+}
+
+func (c *tableIngestCollector) record(files []*backuppb.File, start, end time.Time) {
+	if len(files) == 0 {
+		return
+	}
+	var bytes uint64
+	for _, f := range files {
+		bytes += f.TotalBytes
+	}
+	physicalID := tablecodec.DecodeTableID(files[0].GetStartKey())
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s, ok := c.stats[physicalID]
+	if !ok {
+		s = &tableIngestStat{}
+		c.stats[physicalID] = s
+	}
+	s.merge(len(files), bytes, start, end)
+}
+
+// result returns the files ingested by the tables, the partitions are merged
+// into their tables. The slowest tables are ordered first.
+func (c *tableIngestCollector) result(names map[int64]UniqueTableName) []TableIngestStat {
+	c.mu.Lock()
+	tables := make(map[UniqueTableName]*tableIngestStat, len(c.stats))
+	for physicalID, s := range c.stats {
+		name := tableNameOf(names, physicalID)
+		t, ok := tables[name]
+		if !ok {
+			t = &tableIngestStat{TableIngestStat: TableIngestStat{Table: name}}
+			tables[name] = t
+		}
+		t.merge(s.Files, s.Bytes, s.start, s.end)
+	}
+	c.mu.Unlock()
+
+	result := make([]TableIngestStat, 0, len(tables))
+	for _, t := range tables {
+		result = append(result, t.TableIngestStat)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if ti, tj := result[i].Throughput(), result[j].Throughput(); ti != tj {
+			return ti < tj
+		}
+		if result[i].Table.DB != result[j].Table.DB {
+			return result[i].Table.DB < result[j].Table.DB
+		}
+		return result[i].Table.Table < result[j].Table.Table
+	})
+	return result
+}
+
+// GetTableIngestStats returns the files ingested by every table, the slowest
+// tables are ordered first.
+func (rc *Client) GetTableIngestStats() []TableIngestStat {
+	return rc.tableIngestStats.result(tableNamesOf(rc.databases))
+}
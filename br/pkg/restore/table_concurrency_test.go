@@ -0,0 +1,128 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/stretchr/testify/require"
+)
+
+func testTableDatabases() map[string]*utils.Database {
+	db := &model.DBInfo{Name: model.NewCIStr("test")}
+	partitioned := &model.TableInfo{ID: 10, Name: model.NewCIStr("t1"), Partition: &model.PartitionInfo{
+		Definitions: []model.PartitionDefinition{{ID: 11}, {ID: 12}},
+	}}
+	other := &model.TableInfo{ID: 20, Name: model.NewCIStr("t2")}
+	return map[string]*utils.Database{"test": {Info: db, Tables: []*metautil.Table{
+		{DB: db, Info: partitioned},
+		{DB: db, Info: other},
+	}}}
+}
+
+func testTableFiles(id int64, name string, totalBytes uint64) []*backuppb.File {
+	return []*backuppb.File{{
+		Name:       name,
+		StartKey:   tablecodec.EncodeRowKeyWithHandle(id, kv.IntHandle(1)),
+		TotalBytes: totalBytes,
+	}}
+}
+
+func TestTableConcurrencyLimiter(t *testing.T) {
+	ctx := context.Background()
+	l := newTableConcurrencyLimiter(testTableDatabases(), 2)
+	queues := l.queue([][]*backuppb.File{
+		testTableFiles(11, "a", 0),
+		testTableFiles(12, "b", 0),
+		testTableFiles(10, "c", 0),
+		testTableFiles(20, "d", 0),
+	})
+	require.Len(t, queues, 2)
+	t1 := UniqueTableName{DB: "test", Table: "t1"}
+	t2 := UniqueTableName{DB: "test", Table: "t2"}
+
+	// the partitions share the limit of their table, the ranges of the other
+	// table are taken once it's reached.
+	table, files, ok := l.acquire(ctx, queues)
+	require.True(t, ok)
+	require.Equal(t, t1, table)
+	require.Equal(t, "a", files[0].Name)
+	_, files, ok = l.acquire(ctx, queues)
+	require.True(t, ok)
+	require.Equal(t, "b", files[0].Name)
+	table, files, ok = l.acquire(ctx, queues)
+	require.True(t, ok)
+	require.Equal(t, t2, table)
+	require.Equal(t, "d", files[0].Name)
+
+	// it waits for a range of the table to be done.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		table, files, ok = l.acquire(ctx, queues)
+	}()
+	select {
+	case <-done:
+		require.FailNow(t, "the range is taken beyond the limit")
+	case <-time.After(100 * time.Millisecond):
+	}
+	l.release(t2)
+	select {
+	case <-done:
+		require.FailNow(t, "the range is taken beyond the limit")
+	case <-time.After(100 * time.Millisecond):
+	}
+	l.release(t1)
+	<-done
+	require.True(t, ok)
+	require.Equal(t, t1, table)
+	require.Equal(t, "c", files[0].Name)
+
+	// the drained queues return false.
+	_, _, ok = l.acquire(ctx, queues)
+	require.False(t, ok)
+	l.release(t1)
+	l.release(t1)
+	require.Empty(t, l.running)
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, _, ok = l.acquire(cancelCtx, l.queue([][]*backuppb.File{testTableFiles(20, "e", 0)}))
+	require.False(t, ok)
+}
+
+func TestTableIngestCollector(t *testing.T) {
+	c := newTableIngestCollector()
+	start := time.Unix(100, 0)
+	c.record(testTableFiles(11, "a", 100), start.Add(time.Second), start.Add(2*time.Second))
+	c.record(testTableFiles(12, "b", 300), start, start.Add(time.Second))
+	c.record(testTableFiles(20, "c", 600), start, start.Add(time.Second))
+	c.record(testTableFiles(30, "d", 100), start, start)
+
+	stats := c.result(tableNamesOf(testTableDatabases()))
+	require.Len(t, stats, 3)
+	require.Equal(t, TableIngestStat{Table: UniqueTableName{Table: "30"}, Files: 1, Bytes: 100}, stats[0])
+	require.Equal(t, float64(0), stats[0].Throughput())
+	require.Equal(t, TableIngestStat{
+		Table:   UniqueTableName{DB: "test", Table: "t1"},
+		Files:   2,
+		Bytes:   400,
+		Elapsed: 2 * time.Second,
+	}, stats[1])
+	require.Equal(t, float64(200), stats[1].Throughput())
+	require.Equal(t, TableIngestStat{
+		Table:   UniqueTableName{DB: "test", Table: "t2"},
+		Files:   1,
+		Bytes:   600,
+		Elapsed: time.Second,
+	}, stats[2])
+	require.Equal(t, float64(600), stats[2].Throughput())
+}
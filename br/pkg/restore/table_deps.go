@@ -0,0 +1,142 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
+)
+
+// tableNameCollector collects the table names referenced by a statement.
+type tableNameCollector struct {
+	defaultDB string
+	names     []UniqueTableName
+}
+
+// Enter implements ast.Visitor.
+func (c *tableNameCollector) Enter(n ast.Node) (ast.Node, bool) {
+	if tn, ok := n.(*ast.TableName); ok {
+		db := tn.Schema.L
+		if db == "" {
+			db = c.defaultDB
+		}
+		c.names = append(c.names, UniqueTableName{DB: db, Table: tn.Name.L})
+	}
+	return n, false
+}
+
+// Leave implements ast.Visitor.
+func (*tableNameCollector) Leave(n ast.Node) (ast.Node, bool) {
+	return n, true
+}
+
+func collectTableNames(p *parser.Parser, sql string, defaultDB string) []UniqueTableName {
+	stmt, err := p.ParseOneStmt(sql, "", "")
+	if err != nil {
+		// it only affects the order of creating tables, the error will be
+		// reported when creating the table if the SQL is really broken.
+		log.Warn("failed to parse the SQL to find the dependencies", zap.String("sql", sql), zap.Error(err))
+		return nil
+	}
+	c := &tableNameCollector{defaultDB: defaultDB}
+	stmt.Accept(c)
+	return c.names
+}
+
+// tableDependencies returns the tables the table depends on, i.e. the tables
+// referenced by the foreign keys, the tables and views selected by the view,
+// and the sequences used by the default values of the columns.
+func tableDependencies(p *parser.Parser, table *metautil.Table) []UniqueTableName {
+	db := table.DB.Name.L
+	deps := make([]UniqueTableName, 0)
+	for _, fk := range table.Info.ForeignKeys {
+		deps = append(deps, UniqueTableName{DB: db, Table: fk.RefTable.L})
+	}
+	if table.Info.IsView() {
+		deps = append(deps, collectTableNames(p, table.Info.View.SelectStmt, db)...)
+	}
+	for _, col := range table.Info.Columns {
+		if !col.DefaultIsExpr {
+			continue
+		}
+		if expr, ok := col.DefaultValue.(string); ok {
+			deps = append(deps, collectTableNames(p, "SELECT "+expr, db)...)
+		}
+	}
+	return deps
+}
+
+// groupTablesByDependency groups the tables into levels. The tables in a level
+// only depend on the ones in the previous levels, so the tables in the same
+// level can be created concurrently. The dependencies on the tables not to be
+// restored are ignored, and the order of the tables in a level is kept.
+func groupTablesByDependency(tables []*metautil.Table) [][]*metautil.Table {
+	index := make(map[UniqueTableName]int, len(tables))
+	for i, t := range tables {
+		index[UniqueTableName{DB: t.DB.Name.L, Table: t.Info.Name.L}] = i
+	}
+	p := parser.New()
+	// dependents[i] are the tables depending on tables[i].
+	dependents := make([][]int, len(tables))
+	inDegrees := make([]int, len(tables))
+	for i, t := range tables {
+		seen := make(map[int]struct{})
+		for _, dep := range tableDependencies(p, t) {
+			j, ok := index[dep]
+			if !ok || j == i {
+				continue
+			}
+			if _, ok := seen[j]; ok {
+				continue
+			}
+			seen[j] = struct{}{}
+			dependents[j] = append(dependents[j], i)
+			inDegrees[i]++
+		}
+	}
+
+	levels := make([][]*metautil.Table, 0, 1)
+	current := make([]int, 0, len(tables))
+	for i := range tables {
+		if inDegrees[i] == 0 {
+			current = append(current, i)
+		}
+	}
+	created := 0
+	for len(current) > 0 {
+		level := make([]*metautil.Table, 0, len(current))
+		next := make([]int, 0)
+		for _, i := range current {
+			level = append(level, tables[i])
+			for _, j := range dependents[i] {
+				inDegrees[j]--
+				if inDegrees[j] == 0 {
+					next = append(next, j)
+				}
+			}
+		}
+		created += len(level)
+		levels = append(levels, level)
+		// keep the original order of the tables.
+		slices.Sort(next)
+		current = next
+	}
+	if created < len(tables) {
+		// the tables in a cycle, such as the tables referencing each other by
+		// foreign keys, are created at last in the original order.
+		level := make([]*metautil.Table, 0, len(tables)-created)
+		for i, t := range tables {
+			if inDegrees[i] > 0 {
+				level = append(level, t)
+			}
+		}
+		log.Warn("found cyclic dependencies between the tables, create them at last",
+			zap.Int("count", len(level)))
+		levels = append(levels, level)
+	}
+	return levels
+}
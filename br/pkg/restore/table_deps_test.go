@@ -0,0 +1,50 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupTablesByDependency(t *testing.T) {
+	db := &model.DBInfo{Name: model.NewCIStr("test")}
+	other := &model.DBInfo{Name: model.NewCIStr("other")}
+	newTable := func(db *model.DBInfo, name string) *metautil.Table {
+		return &metautil.Table{DB: db, Info: &model.TableInfo{Name: model.NewCIStr(name)}}
+	}
+	withFK := func(tbl *metautil.Table, ref string) *metautil.Table {
+		tbl.Info.ForeignKeys = append(tbl.Info.ForeignKeys, &model.FKInfo{RefTable: model.NewCIStr(ref)})
+		return tbl
+	}
+
+	parent := newTable(db, "parent")
+	child := withFK(newTable(db, "Child"), "PARENT")
+	view := newTable(db, "v")
+	view.Info.View = &model.ViewInfo{SelectStmt: "SELECT `c`.`a` FROM `test`.`child` AS `c` JOIN `other`.`t` AS `o`"}
+	otherTable := newTable(other, "t")
+	seq := newTable(db, "seq")
+	seq.Info.Sequence = &model.SequenceInfo{}
+	useSeq := newTable(db, "use_seq")
+	useSeq.Info.Columns = []*model.ColumnInfo{{Name: model.NewCIStr("id"), DefaultIsExpr: true, DefaultValue: "nextval(`test`.`seq`)"}}
+	// the dependencies on the tables not to restore are ignored.
+	missing := withFK(newTable(db, "missing"), "not_restored")
+	cycleA := withFK(newTable(db, "a"), "b")
+	cycleB := withFK(newTable(db, "b"), "a")
+
+	levels := groupTablesByDependency([]*metautil.Table{view, useSeq, child, cycleA, parent, seq, otherTable, missing, cycleB})
+	require.Equal(t, [][]*metautil.Table{
+		{parent, seq, otherTable, missing},
+		{useSeq, child},
+		{view},
+		{cycleA, cycleB},
+	}, levels)
+
+	// the tables without dependencies are in a single level.
+	levels = groupTablesByDependency([]*metautil.Table{parent, seq})
+	require.Equal(t, [][]*metautil.Table{{parent, seq}}, levels)
+	require.Empty(t, groupTablesByDependency(nil))
+}
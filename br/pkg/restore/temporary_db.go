@@ -0,0 +1,89 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/glue"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/util/sqlexec"
+	"go.uber.org/zap"
+)
+
+// DefaultTemporaryDBGCAge is the age of the temporary databases which are
+// dropped before a full restore, they're the leftovers of the failed restores.
+const DefaultTemporaryDBGCAge = 24 * time.Hour
+
+// recordTemporaryDB records the task creating the temporary database.
+func recordTemporaryDB(ctx context.Context, se glue.Session, name model.CIStr, task string) error {
+	value, err := json.Marshal(&utils.TemporaryDBRecord{Task: task, CreateTime: time.Now()})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return se.ExecuteInternal(ctx,
+		"REPLACE INTO mysql.tidb (VARIABLE_NAME, VARIABLE_VALUE, COMMENT) VALUES (%?, %?, %?)",
+		name.L, string(value), utils.TemporaryDBRecordComment)
+}
+
+// dropTemporaryDB drops the temporary database and its record.
+func dropTemporaryDB(ctx context.Context, se glue.Session, name model.CIStr) error {
+	if err := se.Execute(ctx, fmt.Sprintf("DROP DATABASE IF EXISTS %s", utils.EncloseName(name.L))); err != nil {
+		return errors.Trace(err)
+	}
+	return se.ExecuteInternal(ctx, "DELETE FROM mysql.tidb WHERE VARIABLE_NAME = %?", name.L)
+}
+
+// CleanTemporaryDBs drops the temporary databases created by restore at least
+// `age` ago, and returns the names of the dropped databases. The databases
+// without records are created by an older BR, or by a restore failed to record
+// them, they're only dropped if dropUnrecorded is true.
+func CleanTemporaryDBs(
+	ctx context.Context, se glue.Session, is infoschema.InfoSchema, age time.Duration, dropUnrecorded bool,
+) ([]string, error) {
+	exec, ok := se.GetSessionCtx().(sqlexec.RestrictedSQLExecutor)
+	if !ok {
+		return nil, errors.New("the session doesn't support restricted SQL")
+	}
+	records, err := utils.ReadTemporaryDBRecords(ctx, exec)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	dropped := make([]string, 0)
+	for _, db := range is.AllSchemas() {
+		if !utils.IsTemporaryDB(db.Name) {
+			continue
+		}
+		record, ok := records[db.Name.L]
+		if !ok && !dropUnrecorded {
+			log.Info("skip the temporary database without the record", zap.Stringer("database", db.Name))
+			continue
+		}
+		if ok && time.Since(record.CreateTime) < age {
+			log.Info("skip the temporary database which may be in use",
+				zap.Stringer("database", db.Name), zap.String("task", record.Task), zap.Time("create-time", record.CreateTime))
+			continue
+		}
+		if err := dropTemporaryDB(ctx, se, db.Name); err != nil {
+			return dropped, errors.Trace(err)
+		}
+		log.Info("dropped the temporary database", zap.Stringer("database", db.Name))
+		dropped = append(dropped, db.Name.O)
+	}
+	// remove the records of the databases dropped manually.
+	for name := range records {
+		if _, ok := is.SchemaByName(model.NewCIStr(name)); !ok {
+			if err := se.ExecuteInternal(ctx, "DELETE FROM mysql.tidb WHERE VARIABLE_NAME = %?", name); err != nil {
+				return dropped, errors.Trace(err)
+			}
+		}
+	}
+	return dropped, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2022-present PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tiflashrec
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/parser/model"
+)
+
+// RecordFile is the name of the file persisting the records in the storage.
+// The records are persisted before setting the TiFlash replicas back, so they
+// can be re-applied if the restore exits before finishing.
+const RecordFile = "restore_tiflash_replicas.json"
+
+type persistedRecord struct {
+	TableID        int64    `json:"table_id"`
+	Count          uint64   `json:"count"`
+	LocationLabels []string `json:"location_labels,omitempty"`
+}
+
+// Len returns the number of the recorded tables.
+func (r *TiFlashRecorder) Len() int {
+	return len(r.items)
+}
+
+// Save persists the records to the storage.
+func (r *TiFlashRecorder) Save(ctx context.Context, s storage.ExternalStorage) error {
+	records := make([]persistedRecord, 0, len(r.items))
+	r.Iterate(func(tableID int64, replica model.TiFlashReplicaInfo) {
+		records = append(records, persistedRecord{
+			TableID:        tableID,
+			Count:          replica.Count,
+			LocationLabels: replica.LocationLabels,
+		})
+	})
+	sort.Slice(records, func(i, j int) bool { return records[i].TableID < records[j].TableID })
+	data, err := json.Marshal(records)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(s.WriteFile(ctx, RecordFile, data))
+}
+
+// Load loads the records persisted by Save, it returns nil if there are no records.
+func Load(ctx context.Context, s storage.ExternalStorage) (*TiFlashRecorder, error) {
+	exists, err := s.FileExists(ctx, RecordFile)
+	if err != nil || !exists {
+		return nil, errors.Trace(err)
+	}
+	data, err := s.ReadFile(ctx, RecordFile)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	records := make([]persistedRecord, 0)
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, errors.Annotatef(err, "invalid TiFlash replica records in %s", RecordFile)
+	}
+	r := New()
+	for _, record := range records {
+		r.items[record.TableID] = model.TiFlashReplicaInfo{
+			Count:          record.Count,
+			LocationLabels: record.LocationLabels,
+		}
+	}
+	return r, nil
+}
+
+// Remove removes the persisted records from the storage.
+func Remove(ctx context.Context, s storage.ExternalStorage) error {
+	exists, err := s.FileExists(ctx, RecordFile)
+	if err != nil || !exists {
+		return errors.Trace(err)
+	}
+	return errors.Trace(s.DeleteFile(ctx, RecordFile))
+}
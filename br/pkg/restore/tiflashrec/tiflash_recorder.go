@@ -79,6 +79,19 @@ func (r *TiFlashRecorder) Rewrite(oldID int64, newID int64) {
 	}
 }
 
+// TakeSatisfiable removes the tables whose TiFlash replicas can be satisfied by
+// the TiFlash stores, and returns them in a new recorder.
+func (r *TiFlashRecorder) TakeSatisfiable(tiflashStoreCount uint64) *TiFlashRecorder {
+	satisfiable := New()
+	for id, replica := range r.items {
+		if replica.Count <= tiflashStoreCount {
+			satisfiable.items[id] = replica
+			delete(r.items, id)
+		}
+	}
+	return satisfiable
+}
+
 func (r *TiFlashRecorder) GenerateAlterTableDDLs(info infoschema.InfoSchema) []string {
 	items := make([]string, 0, len(r.items))
 	r.Iterate(func(id int64, replica model.TiFlashReplicaInfo) {
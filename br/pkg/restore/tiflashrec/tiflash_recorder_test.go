@@ -15,10 +15,12 @@
 package tiflashrec_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/pingcap/tidb/br/pkg/restore/tiflashrec"
+	"github.com/pingcap/tidb/br/pkg/storage"
 	"github.com/pingcap/tidb/infoschema"
 	"github.com/pingcap/tidb/parser/model"
 	"github.com/stretchr/testify/require"
@@ -170,3 +172,63 @@ func TestGenSql(t *testing.T) {
 		"ALTER TABLE `test`.`evils` SET TIFLASH REPLICA 1 LOCATION LABELS 'kIll''; OR DROP DATABASE test --', 'dEaTh with " + `\\"quoting\\"` + "'",
 	})
 }
+
+func TestPersist(t *testing.T) {
+	ctx := context.Background()
+	s, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	rec, err := tiflashrec.Load(ctx, s)
+	require.NoError(t, err)
+	require.Nil(t, rec)
+
+	rec = tiflashrec.New()
+	rec.AddTable(42, model.TiFlashReplicaInfo{Count: 1})
+	rec.AddTable(43, model.TiFlashReplicaInfo{Count: 2, LocationLabels: []string{"zone"}, Available: true})
+	require.NoError(t, rec.Save(ctx, s))
+
+	loaded, err := tiflashrec.Load(ctx, s)
+	require.NoError(t, err)
+	require.Equal(t, 2, loaded.Len())
+	replicas := map[int64]model.TiFlashReplicaInfo{}
+	loaded.Iterate(func(tableID int64, replica model.TiFlashReplicaInfo) {
+		replicas[tableID] = replica
+	})
+	require.Equal(t, map[int64]model.TiFlashReplicaInfo{
+		42: {Count: 1},
+		43: {Count: 2, LocationLabels: []string{"zone"}},
+	}, replicas)
+
+	require.NoError(t, tiflashrec.Remove(ctx, s))
+	rec, err = tiflashrec.Load(ctx, s)
+	require.NoError(t, err)
+	require.Nil(t, rec)
+	// removing again is a no-op.
+	require.NoError(t, tiflashrec.Remove(ctx, s))
+}
+
+func TestTakeSatisfiable(t *testing.T) {
+	rec := tiflashrec.New()
+	rec.AddTable(1, model.TiFlashReplicaInfo{Count: 1})
+	rec.AddTable(2, model.TiFlashReplicaInfo{Count: 2, LocationLabels: []string{"zone"}})
+	rec.AddTable(3, model.TiFlashReplicaInfo{Count: 3})
+
+	satisfiable := rec.TakeSatisfiable(0)
+	require.Equal(t, 0, satisfiable.Len())
+	require.Equal(t, 3, rec.Len())
+
+	satisfiable = rec.TakeSatisfiable(2)
+	taken := map[int64]model.TiFlashReplicaInfo{}
+	satisfiable.Iterate(func(tableID int64, replica model.TiFlashReplicaInfo) {
+		taken[tableID] = replica
+	})
+	require.Equal(t, map[int64]model.TiFlashReplicaInfo{
+		1: {Count: 1},
+		2: {Count: 2, LocationLabels: []string{"zone"}},
+	}, taken)
+	require.Equal(t, 1, rec.Len())
+
+	satisfiable = rec.TakeSatisfiable(3)
+	require.Equal(t, 1, satisfiable.Len())
+	require.Equal(t, 0, rec.Len())
+}
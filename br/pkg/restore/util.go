@@ -483,6 +483,7 @@ func SplitRanges(
 	isRawKv bool,
 ) error {
 	splitter := NewRegionSplitter(split.NewSplitClient(client.GetPDClient(), client.GetTLSConfig(), isRawKv))
+	splitter.SetSplitPlanner(NewSplitPlanner(client.regionSplitSize))
 
 	return splitter.Split(ctx, ranges, rewriteRules, isRawKv, func(keys [][]byte) {
 		for range keys {
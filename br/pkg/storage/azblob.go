@@ -8,10 +8,15 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
 	"os"
 	"path"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
 	"github.com/google/uuid"
@@ -28,6 +33,10 @@ const (
 	azblobAccessTierOption = "azblob.access-tier"
 	azblobAccountName      = "azblob.account-name"
 	azblobAccountKey       = "azblob.account-key"
+	azblobSASToken         = "azblob.sas-token"
+	azblobSASTokenFile     = "azblob.sas-token-file"
+	azblobImmutablePeriod  = "azblob.immutability-period"
+	azblobImmutableMode    = "azblob.immutability-mode"
 )
 
 // AzblobBackendOptions is the options for Azure Blob storage.
@@ -36,6 +45,18 @@ type AzblobBackendOptions struct {
 	AccountName string `json:"account-name" toml:"account-name"`
 	AccountKey  string `json:"account-key" toml:"account-key"`
 	AccessTier  string `json:"access-tier" toml:"access-tier"`
+	// SASToken is the SAS token to access the storage by BR, TiKV accesses
+	// the storage by its own credentials.
+	SASToken string `json:"sas-token" toml:"sas-token"`
+	// SASTokenFile is the file of the SAS token, the file is read again once
+	// it's modified, so the token can be rotated during a long backup.
+	SASTokenFile string `json:"sas-token-file" toml:"sas-token-file"`
+	// ImmutabilityPeriod is the period the blobs written by BR are kept
+	// immutable, e.g. 720h. The blobs are never overwritten once it's set.
+	ImmutabilityPeriod string `json:"immutability-period" toml:"immutability-period"`
+	// ImmutabilityMode is the mode of the immutability policy, "unlocked" or
+	// "locked", the locked one is the compliance mode can't be shortened.
+	ImmutabilityMode string `json:"immutability-mode" toml:"immutability-mode"`
 }
 
 func (options *AzblobBackendOptions) apply(azblob *backuppb.AzureBlobStorage) error {
@@ -43,7 +64,48 @@ func (options *AzblobBackendOptions) apply(azblob *backuppb.AzureBlobStorage) er
 	azblob.StorageClass = options.AccessTier
 	azblob.AccountName = options.AccountName
 	azblob.SharedKey = options.AccountKey
-	return nil
+	if len(options.SASToken) > 0 && len(options.SASTokenFile) > 0 {
+		return errors.Annotate(berrors.ErrStorageInvalidConfig, "only one of the SAS token and its file can be specified")
+	}
+	_, err := options.ImmutabilityPolicy()
+	return errors.Trace(err)
+}
+
+// SASTokenProvider returns the provider of the SAS token, it's nil if no SAS
+// token is specified.
+func (options *AzblobBackendOptions) SASTokenProvider() AzureSASTokenProvider {
+	switch {
+	case len(options.SASTokenFile) > 0:
+		return NewAzureSASTokenFileProvider(options.SASTokenFile)
+	case len(options.SASToken) > 0:
+		token := strings.TrimPrefix(options.SASToken, "?")
+		return func(context.Context) (string, error) { return token, nil }
+	default:
+		return nil
+	}
+}
+
+// ImmutabilityPolicy returns the immutability policy of the blobs written by
+// BR, it's nil if no immutability period is specified.
+func (options *AzblobBackendOptions) ImmutabilityPolicy() (*AzureImmutabilityPolicy, error) {
+	if len(options.ImmutabilityPeriod) == 0 {
+		return nil, nil
+	}
+	period, err := time.ParseDuration(options.ImmutabilityPeriod)
+	if err != nil || period <= 0 {
+		return nil, errors.Annotatef(berrors.ErrStorageInvalidConfig,
+			"invalid immutability period %s of azblob", options.ImmutabilityPeriod)
+	}
+	policy := &AzureImmutabilityPolicy{Period: period, Mode: "Unlocked"}
+	switch strings.ToLower(options.ImmutabilityMode) {
+	case "", "unlocked":
+	case "locked":
+		policy.Mode = "Locked"
+	default:
+		return nil, errors.Annotatef(berrors.ErrStorageInvalidConfig,
+			"invalid immutability mode %s of azblob, it should be unlocked or locked", options.ImmutabilityMode)
+	}
+	return policy, nil
 }
 
 func defineAzblobFlags(flags *pflag.FlagSet) {
@@ -51,6 +113,14 @@ func defineAzblobFlags(flags *pflag.FlagSet) {
 	flags.String(azblobAccessTierOption, "", "Specify the storage class for azblob")
 	flags.String(azblobAccountName, "", "Specify the account name for azblob")
 	flags.String(azblobAccountKey, "", "Specify the account key for azblob")
+	flags.String(azblobSASToken, "", "Specify the SAS token for azblob, it's only used by BR")
+	flags.String(azblobSASTokenFile, "", "Specify the file of the SAS token for azblob, "+
+		"the file is read again once it's modified, so the token can be rotated during the task")
+	flags.String(azblobImmutablePeriod, "", "Set the immutability policy of the files written by BR "+
+		"for the period, e.g. 720h, the files are never overwritten. "+
+		"the version-level immutability should be enabled for the container")
+	flags.String(azblobImmutableMode, "unlocked", "Specify the mode of the immutability policy for azblob, "+
+		"unlocked or locked, the locked one is the compliance mode can't be shortened or removed")
 }
 
 func hiddenAzblobFlags(flags *pflag.FlagSet) {
@@ -58,6 +128,10 @@ func hiddenAzblobFlags(flags *pflag.FlagSet) {
 	_ = flags.MarkHidden(azblobAccessTierOption)
 	_ = flags.MarkHidden(azblobAccountName)
 	_ = flags.MarkHidden(azblobAccountKey)
+	_ = flags.MarkHidden(azblobSASToken)
+	_ = flags.MarkHidden(azblobSASTokenFile)
+	_ = flags.MarkHidden(azblobImmutablePeriod)
+	_ = flags.MarkHidden(azblobImmutableMode)
 }
 
 func (options *AzblobBackendOptions) parseFromFlags(flags *pflag.FlagSet) error {
@@ -81,6 +155,26 @@ func (options *AzblobBackendOptions) parseFromFlags(flags *pflag.FlagSet) error
 	if err != nil {
 		return errors.Trace(err)
 	}
+
+	options.SASToken, err = flags.GetString(azblobSASToken)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	options.SASTokenFile, err = flags.GetString(azblobSASTokenFile)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	options.ImmutabilityPeriod, err = flags.GetString(azblobImmutablePeriod)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	options.ImmutabilityMode, err = flags.GetString(azblobImmutableMode)
+	if err != nil {
+		return errors.Trace(err)
+	}
 	return nil
 }
 
@@ -93,13 +187,14 @@ type ClientBuilder interface {
 
 // use shared key to access azure blob storage
 type sharedKeyClientBuilder struct {
-	cred        *azblob.SharedKeyCredential
-	accountName string
-	serviceURL  string
+	cred          *azblob.SharedKeyCredential
+	accountName   string
+	serviceURL    string
+	clientOptions *azblob.ClientOptions
 }
 
 func (b *sharedKeyClientBuilder) GetServiceClient() (azblob.ServiceClient, error) {
-	return azblob.NewServiceClientWithSharedKey(b.serviceURL, b.cred, nil)
+	return azblob.NewServiceClientWithSharedKey(b.serviceURL, b.cred, b.clientOptions)
 }
 
 func (b *sharedKeyClientBuilder) GetAccountName() string {
@@ -108,19 +203,36 @@ func (b *sharedKeyClientBuilder) GetAccountName() string {
 
 // use token to access azure blob storage
 type tokenClientBuilder struct {
-	cred        *azidentity.ClientSecretCredential
-	accountName string
-	serviceURL  string
+	cred          *azidentity.ClientSecretCredential
+	accountName   string
+	serviceURL    string
+	clientOptions *azblob.ClientOptions
 }
 
 func (b *tokenClientBuilder) GetServiceClient() (azblob.ServiceClient, error) {
-	return azblob.NewServiceClient(b.serviceURL, b.cred, nil)
+	return azblob.NewServiceClient(b.serviceURL, b.cred, b.clientOptions)
 }
 
 func (b *tokenClientBuilder) GetAccountName() string {
 	return b.accountName
 }
 
+// use SAS token to access azure blob storage, the requests are signed by the
+// sasTokenPolicy in the client options.
+type sasClientBuilder struct {
+	accountName   string
+	serviceURL    string
+	clientOptions *azblob.ClientOptions
+}
+
+func (b *sasClientBuilder) GetServiceClient() (azblob.ServiceClient, error) {
+	return azblob.NewServiceClientWithNoCredential(b.serviceURL, b.clientOptions)
+}
+
+func (b *sasClientBuilder) GetAccountName() string {
+	return b.accountName
+}
+
 func getAuthorizerFromEnvironment() (clientID, tenantID, clientSecret string) {
 	return os.Getenv("AZURE_CLIENT_ID"),
 		os.Getenv("AZURE_TENANT_ID"),
@@ -133,6 +245,7 @@ func getAzureServiceClientBuilder(options *backuppb.AzureBlobStorage, opts *Exte
 		return nil, errors.New("bucket(container) cannot be empty to access azure blob storage")
 	}
 
+	clientOptions := newAzblobClientOptions(opts)
 	if len(options.AccountName) > 0 && len(options.SharedKey) > 0 {
 		serviceURL := options.Endpoint
 		if len(serviceURL) == 0 {
@@ -146,6 +259,7 @@ func getAzureServiceClientBuilder(options *backuppb.AzureBlobStorage, opts *Exte
 			cred,
 			options.AccountName,
 			serviceURL,
+			clientOptions,
 		}, nil
 	}
 
@@ -163,6 +277,19 @@ func getAzureServiceClientBuilder(options *backuppb.AzureBlobStorage, opts *Exte
 		serviceURL = fmt.Sprintf("https://%s.blob.core.windows.net", accountName)
 	}
 
+	if opts != nil && opts.AzureSASTokenProvider != nil {
+		// the SAS token isn't sent to TiKV, TiKV accesses the storage by its own credentials.
+		if opts.SendCredentials {
+			log.Warn("the SAS token isn't sent to TiKV, please make sure TiKV can access the azure blob storage")
+			options.AccountName = accountName
+		}
+		return &sasClientBuilder{
+			accountName,
+			serviceURL,
+			clientOptions,
+		}, nil
+	}
+
 	if clientID, tenantID, clientSecret := getAuthorizerFromEnvironment(); len(clientID) > 0 && len(tenantID) > 0 && len(clientSecret) > 0 {
 		cred, err := azidentity.NewClientSecretCredential(tenantID, clientID, clientSecret, nil)
 		if err == nil {
@@ -174,6 +301,7 @@ func getAzureServiceClientBuilder(options *backuppb.AzureBlobStorage, opts *Exte
 				cred,
 				accountName,
 				serviceURL,
+				clientOptions,
 			}, nil
 		}
 		log.Warn("Failed to get azure token credential but environment variables exist, try to use shared key.", zap.String("tenantId", tenantID), zap.String("clientId", clientID), zap.String("clientSecret", "?"))
@@ -201,6 +329,7 @@ func getAzureServiceClientBuilder(options *backuppb.AzureBlobStorage, opts *Exte
 		cred,
 		accountName,
 		serviceURL,
+		clientOptions,
 	}, nil
 }
 
@@ -335,17 +464,17 @@ func (s *AzureBlobStorage) WalkDir(ctx context.Context, opt *WalkOption, fn func
 	if opt == nil {
 		opt = &WalkOption{}
 	}
-	if len(opt.ObjPrefix) != 0 {
-		return errors.New("azure storage not support ObjPrefix for now")
-	}
 	prefix := path.Join(s.options.Prefix, opt.SubDir)
 	if len(prefix) > 0 && !strings.HasSuffix(prefix, "/") {
 		prefix += "/"
 	}
-
-	prefixLength := len(prefix)
+	prefix += opt.ObjPrefix
 
 	listOption := &azblob.ContainerListBlobFlatSegmentOptions{Prefix: &prefix}
+	if opt.ListCount > 0 {
+		maxResults := int32(opt.ListCount)
+		listOption.Maxresults = &maxResults
+	}
 	for {
 		respIter := s.containerClient.ListBlobsFlat(listOption)
 
@@ -363,7 +492,13 @@ func (s *AzureBlobStorage) WalkDir(ctx context.Context, opt *WalkOption, fn func
 		}
 
 		for _, blob := range respIter.PageResponse().Segment.BlobItems {
-			if err := fn((*blob.Name)[prefixLength:], *blob.Properties.ContentLength); err != nil {
+			// the path is relative to the storage, so it can be used in Open.
+			path := strings.TrimPrefix(strings.TrimPrefix(*blob.Name, s.options.Prefix), "/")
+			// the azure blob storage can't start the listing after a blob, skip the blobs before it.
+			if !opt.filter(path) {
+				continue
+			}
+			if err := fn(path, *blob.Properties.ContentLength); err != nil {
 				return errors.Trace(err)
 			}
 		}
@@ -393,7 +528,10 @@ func (s *AzureBlobStorage) Create(_ context.Context, name string) (ExternalFileW
 		accessTier: s.accessTier,
 	}
 
-	uploaderWriter := newBufferedWriter(uploader, azblob.BlockBlobMaxUploadBlobBytes, NoCompression)
+	uploaderWriter, err := newBufferedWriter(uploader, azblob.BlockBlobMaxUploadBlobBytes, NoCompression)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	return uploaderWriter, nil
 }
 
@@ -517,3 +655,124 @@ func (u *azblobUploader) Close(ctx context.Context) error {
 	_, err := u.blobClient.CommitBlockList(ctx, u.blockIDList, &azblob.CommitBlockListOptions{Tier: &u.accessTier})
 	return errors.Trace(err)
 }
+
+// AzureSASTokenProvider provides the SAS token to access the azure blob storage.
+// It's called before sending each request, so the token can be refreshed during
+// a long task rather than failing the task once the token expires.
+type AzureSASTokenProvider func(ctx context.Context) (string, error)
+
+// NewAzureSASTokenFileProvider creates a provider reading the SAS token from
+// the file, the file is read again once it's modified.
+func NewAzureSASTokenFileProvider(path string) AzureSASTokenProvider {
+	var (
+		mu      sync.Mutex
+		token   string
+		modTime time.Time
+	)
+	return func(context.Context) (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		info, err := os.Stat(path)
+		if err != nil {
+			return "", errors.Annotatef(err, "failed to stat the SAS token file %s", path)
+		}
+		if len(token) > 0 && info.ModTime().Equal(modTime) {
+			return token, nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", errors.Annotatef(err, "failed to read the SAS token file %s", path)
+		}
+		newToken := strings.TrimPrefix(strings.TrimSpace(string(data)), "?")
+		if len(newToken) == 0 {
+			return "", errors.Annotatef(berrors.ErrStorageInvalidConfig, "the SAS token file %s is empty", path)
+		}
+		if len(token) > 0 && newToken != token {
+			log.Info("the SAS token of azblob is refreshed", zap.String("file", path))
+		}
+		token, modTime = newToken, info.ModTime()
+		return token, nil
+	}
+}
+
+// AzureImmutabilityPolicy is the immutability policy set to the blobs once
+// they're written, the blobs can't be modified or deleted until the period
+// elapses, and a blob existing already is never overwritten.
+type AzureImmutabilityPolicy struct {
+	Period time.Duration
+	// Mode is "Unlocked" or "Locked".
+	Mode string
+}
+
+// sasTokenPolicy signs the requests by the SAS token from the provider.
+type sasTokenPolicy struct {
+	provider AzureSASTokenProvider
+}
+
+// Do implements policy.Policy.
+func (p sasTokenPolicy) Do(req *policy.Request) (*http.Response, error) {
+	raw := req.Raw()
+	token, err := p.provider(raw.Context())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	values, err := url.ParseQuery(token)
+	if err != nil {
+		return nil, errors.Annotate(berrors.ErrStorageInvalidConfig, "invalid SAS token of azblob")
+	}
+	query := raw.URL.Query()
+	for k, v := range values {
+		query[k] = v
+	}
+	raw.URL.RawQuery = query.Encode()
+	return req.Next()
+}
+
+// immutabilityPolicy sets the immutability policy when a blob is created, i.e.
+// a block blob is uploaded by a single request or by committing the block list.
+type immutabilityPolicy struct {
+	policy AzureImmutabilityPolicy
+	now    func() time.Time
+}
+
+// Do implements policy.Policy.
+func (p immutabilityPolicy) Do(req *policy.Request) (*http.Response, error) {
+	raw := req.Raw()
+	if isAzureBlobCreation(raw) {
+		raw.Header.Set("If-None-Match", "*")
+		raw.Header.Set("x-ms-immutability-policy-until-date", p.now().Add(p.policy.Period).UTC().Format(http.TimeFormat))
+		raw.Header.Set("x-ms-immutability-policy-mode", p.policy.Mode)
+	}
+	return req.Next()
+}
+
+func isAzureBlobCreation(req *http.Request) bool {
+	if req.Method != http.MethodPut {
+		return false
+	}
+	switch req.URL.Query().Get("comp") {
+	case "blocklist":
+		return true
+	case "":
+		return len(req.Header.Get("x-ms-blob-type")) > 0
+	default:
+		return false
+	}
+}
+
+func newAzblobClientOptions(opts *ExternalStorageOptions) *azblob.ClientOptions {
+	if opts == nil || (opts.AzureSASTokenProvider == nil && opts.AzureImmutabilityPolicy == nil) {
+		return nil
+	}
+	clientOptions := &azblob.ClientOptions{}
+	if opts.AzureImmutabilityPolicy != nil {
+		clientOptions.PerCallPolicies = append(clientOptions.PerCallPolicies,
+			immutabilityPolicy{policy: *opts.AzureImmutabilityPolicy, now: time.Now})
+	}
+	if opts.AzureSASTokenProvider != nil {
+		// sign every retry, so a retry after the token expires uses the new one.
+		clientOptions.PerRetryPolicies = append(clientOptions.PerRetryPolicies,
+			sasTokenPolicy{provider: opts.AzureSASTokenProvider})
+	}
+	return clientOptions
+}
@@ -5,9 +5,12 @@ package storage
 import (
 	"context"
 	"io"
+	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
 	backuppb "github.com/pingcap/kvproto/pkg/brpb"
@@ -298,3 +301,59 @@ func TestNewAzblobStorage(t *testing.T) {
 		require.Equal(t, "http://127.0.0.1:1000", b.serviceURL)
 	}
 }
+
+func TestAzblobSASToken(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("?sv=1&sig=a\n"), 0o600))
+	provider := NewAzureSASTokenFileProvider(tokenFile)
+	token, err := provider(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "sv=1&sig=a", token)
+
+	// the token is read again once the file is modified.
+	require.NoError(t, os.WriteFile(tokenFile, []byte("sv=1&sig=b"), 0o600))
+	require.NoError(t, os.Chtimes(tokenFile, time.Now(), time.Now().Add(time.Minute)))
+	token, err = provider(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "sv=1&sig=b", token)
+
+	options := &backuppb.AzureBlobStorage{
+		Endpoint:    "http://127.0.0.1:1000",
+		Bucket:      "test",
+		AccountName: "user",
+	}
+	builder, err := getAzureServiceClientBuilder(options, &ExternalStorageOptions{AzureSASTokenProvider: provider})
+	require.NoError(t, err)
+	b, ok := builder.(*sasClientBuilder)
+	require.True(t, ok)
+	require.Equal(t, "user", b.GetAccountName())
+	require.Len(t, b.clientOptions.PerRetryPolicies, 1)
+
+	backendOptions := AzblobBackendOptions{SASToken: "sv=1", SASTokenFile: tokenFile}
+	require.Error(t, backendOptions.apply(options))
+}
+
+func TestAzblobImmutabilityPolicy(t *testing.T) {
+	options := AzblobBackendOptions{ImmutabilityPeriod: "720h", ImmutabilityMode: "locked"}
+	policy, err := options.ImmutabilityPolicy()
+	require.NoError(t, err)
+	require.Equal(t, &AzureImmutabilityPolicy{Period: 720 * time.Hour, Mode: "Locked"}, policy)
+	options = AzblobBackendOptions{ImmutabilityPeriod: "720h", ImmutabilityMode: "compliance"}
+	require.Error(t, options.apply(&backuppb.AzureBlobStorage{}))
+	options = AzblobBackendOptions{ImmutabilityPeriod: "-1h"}
+	require.Error(t, options.apply(&backuppb.AzureBlobStorage{}))
+
+	newRequest := func(method, rawURL, blobType string) *http.Request {
+		req, err := http.NewRequest(method, rawURL, nil)
+		require.NoError(t, err)
+		if len(blobType) > 0 {
+			req.Header.Set("x-ms-blob-type", blobType)
+		}
+		return req
+	}
+	require.True(t, isAzureBlobCreation(newRequest(http.MethodPut, "http://127.0.0.1/test/a", "BlockBlob")))
+	require.True(t, isAzureBlobCreation(newRequest(http.MethodPut, "http://127.0.0.1/test/a?comp=blocklist", "")))
+	require.False(t, isAzureBlobCreation(newRequest(http.MethodPut, "http://127.0.0.1/test/a?comp=block&blockid=1", "")))
+	require.False(t, isAzureBlobCreation(newRequest(http.MethodPut, "http://127.0.0.1/test?restype=container", "")))
+	require.False(t, isAzureBlobCreation(newRequest(http.MethodGet, "http://127.0.0.1/test/a", "")))
+}
@@ -0,0 +1,261 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// ChecksumSuffix is the suffix of the sidecar file storing the SHA256 checksum
+// of the file in hex.
+const ChecksumSuffix = ".sha256"
+
+func checksumFileName(name string) string {
+	return name + ChecksumSuffix
+}
+
+// IsChecksumFile returns whether the file is the sidecar file of a checksum,
+// the walkers of the storages not wrapped by WithChecksum should skip them.
+func IsChecksumFile(name string) bool {
+	return strings.HasSuffix(name, ChecksumSuffix)
+}
+
+// withChecksum stores the SHA256 checksum of every file written in a sidecar
+// file, and verifies the file by it on reading, so the corruption introduced
+// by the proxies or the bit rot of the storage is detected. The files without
+// the sidecar, e.g. written by the older versions or TiKV, aren't verified.
+// The sidecar is removed before the file is changed and written after, so it
+// never outlives the content it's computed from, even if the writing fails.
+type withChecksum struct {
+	ExternalStorage
+}
+
+// WithChecksum returns an ExternalStorage writing and verifying the checksums
+// of the files.
+func WithChecksum(inner ExternalStorage) ExternalStorage {
+	return &withChecksum{ExternalStorage: inner}
+}
+
+func (w *withChecksum) writeChecksum(ctx context.Context, name string, sum []byte) error {
+	err := w.ExternalStorage.WriteFile(ctx, checksumFileName(name), []byte(hex.EncodeToString(sum)))
+	return errors.Annotatef(err, "failed to write the checksum of %s", name)
+}
+
+// readChecksum reads the checksum of the file, it returns nil if the file has
+// no checksum.
+func (w *withChecksum) readChecksum(ctx context.Context, name string) ([]byte, error) {
+	exists, err := w.ExternalStorage.FileExists(ctx, checksumFileName(name))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !exists {
+		log.Debug("skip verifying the file without checksum", zap.String("file", name))
+		return nil, nil
+	}
+	data, err := w.ExternalStorage.ReadFile(ctx, checksumFileName(name))
+	if err != nil {
+		return nil, errors.Annotatef(err, "failed to read the checksum of %s", name)
+	}
+	sum, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(sum) != sha256.Size {
+		return nil, errors.Annotatef(berrors.ErrStorageChecksumMismatch, "invalid checksum %q of %s", data, name)
+	}
+	return sum, nil
+}
+
+func verifyChecksum(name string, expected, actual []byte) error {
+	if !bytes.Equal(expected, actual) {
+		return errors.Annotatef(berrors.ErrStorageChecksumMismatch, "the file %s may be corrupted, expect sha256 %x, got %x",
+			name, expected, actual)
+	}
+	return nil
+}
+
+func (w *withChecksum) WriteFile(ctx context.Context, name string, data []byte) error {
+	if err := w.deleteChecksum(ctx, name); err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.ExternalStorage.WriteFile(ctx, name, data); err != nil {
+		return errors.Trace(err)
+	}
+	sum := sha256.Sum256(data)
+	return w.writeChecksum(ctx, name, sum[:])
+}
+
+func (w *withChecksum) ReadFile(ctx context.Context, name string) ([]byte, error) {
+	expected, err := w.readChecksum(ctx, name)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	data, err := w.ExternalStorage.ReadFile(ctx, name)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if expected == nil {
+		return data, nil
+	}
+	sum := sha256.Sum256(data)
+	if err := verifyChecksum(name, expected, sum[:]); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return data, nil
+}
+
+// deleteChecksum deletes the checksum of the file if it exists, since some
+// storages fail to delete the file not existing.
+func (w *withChecksum) deleteChecksum(ctx context.Context, name string) error {
+	exists, err := w.ExternalStorage.FileExists(ctx, checksumFileName(name))
+	if err != nil || !exists {
+		return errors.Trace(err)
+	}
+	return errors.Annotatef(w.ExternalStorage.DeleteFile(ctx, checksumFileName(name)),
+		"failed to delete the checksum of %s", name)
+}
+
+func (w *withChecksum) DeleteFile(ctx context.Context, name string) error {
+	if err := w.deleteChecksum(ctx, name); err != nil {
+		return errors.Trace(err)
+	}
+	return w.ExternalStorage.DeleteFile(ctx, name)
+}
+
+func (w *withChecksum) Rename(ctx context.Context, oldFileName, newFileName string) error {
+	// the stale checksum of the new file must be removed.
+	if err := w.deleteChecksum(ctx, newFileName); err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.ExternalStorage.Rename(ctx, oldFileName, newFileName); err != nil {
+		return errors.Trace(err)
+	}
+	exists, err := w.ExternalStorage.FileExists(ctx, checksumFileName(oldFileName))
+	if err != nil || !exists {
+		return errors.Trace(err)
+	}
+	return w.ExternalStorage.Rename(ctx, checksumFileName(oldFileName), checksumFileName(newFileName))
+}
+
+// WalkDir skips the checksum files.
+func (w *withChecksum) WalkDir(ctx context.Context, opt *WalkOption, fn func(path string, size int64) error) error {
+	return w.ExternalStorage.WalkDir(ctx, opt, func(path string, size int64) error {
+		if IsChecksumFile(path) {
+			return nil
+		}
+		return fn(path, size)
+	})
+}
+
+func (w *withChecksum) Open(ctx context.Context, path string) (ExternalFileReader, error) {
+	expected, err := w.readChecksum(ctx, path)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	reader, err := w.ExternalStorage.Open(ctx, path)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if expected == nil {
+		return reader, nil
+	}
+	return &checksumReader{
+		ExternalFileReader: reader,
+		name:               path,
+		expected:           expected,
+		hash:               sha256.New(),
+	}, nil
+}
+
+func (w *withChecksum) Create(ctx context.Context, path string) (ExternalFileWriter, error) {
+	if err := w.deleteChecksum(ctx, path); err != nil {
+		return nil, errors.Trace(err)
+	}
+	writer, err := w.ExternalStorage.Create(ctx, path)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &checksumWriter{
+		ExternalFileWriter: writer,
+		storage:            w,
+		name:               path,
+		hash:               sha256.New(),
+	}, nil
+}
+
+// checksumWriter writes the checksum of the data once the file is closed.
+type checksumWriter struct {
+	ExternalFileWriter
+	storage *withChecksum
+	name    string
+	hash    hash.Hash
+}
+
+func (w *checksumWriter) Write(ctx context.Context, p []byte) (int, error) {
+	n, err := w.ExternalFileWriter.Write(ctx, p)
+	// the data accepted by the writer is always written, even if it returns an error.
+	_, _ = w.hash.Write(p[:n])
+	return n, err
+}
+
+func (w *checksumWriter) Close(ctx context.Context) error {
+	if err := w.ExternalFileWriter.Close(ctx); err != nil {
+		return errors.Trace(err)
+	}
+	return w.storage.writeChecksum(ctx, w.name, w.hash.Sum(nil))
+}
+
+// checksumReader verifies the file once it's read to the end. The data are
+// hashed while they're read sequentially, the file isn't verified if some
+// data are skipped by seeking.
+type checksumReader struct {
+	ExternalFileReader
+	name     string
+	expected []byte
+
+	hash   hash.Hash
+	pos    int64
+	hashed int64
+	// skipped is set once the file can't be hashed sequentially.
+	skipped bool
+}
+
+// Read implement the io.Reader interface.
+func (r *checksumReader) Read(p []byte) (int, error) {
+	n, err := r.ExternalFileReader.Read(p)
+	if !r.skipped {
+		start, end := r.pos, r.pos+int64(n)
+		switch {
+		case start > r.hashed:
+			r.skipped = true
+		case end > r.hashed:
+			_, _ = r.hash.Write(p[r.hashed-start : n])
+			r.hashed = end
+		}
+	}
+	r.pos += int64(n)
+	if err == io.EOF && !r.skipped {
+		if verifyErr := verifyChecksum(r.name, r.expected, r.hash.Sum(nil)); verifyErr != nil {
+			return n, errors.Trace(verifyErr)
+		}
+	}
+	return n, err
+}
+
+// Seek implement the io.Seeker interface.
+func (r *checksumReader) Seek(offset int64, whence int) (int64, error) {
+	pos, err := r.ExternalFileReader.Seek(offset, whence)
+	if err != nil {
+		return pos, errors.Trace(err)
+	}
+	r.pos = pos
+	return pos, nil
+}
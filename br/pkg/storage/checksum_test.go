@@ -0,0 +1,122 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pingcap/errors"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecksumStorage(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	local, err := NewLocalStorage(dir)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "1"), 0o755))
+	s := WithChecksum(local)
+
+	require.NoError(t, s.WriteFile(ctx, "backupmeta", []byte("meta")))
+	exists, err := local.FileExists(ctx, "backupmeta"+ChecksumSuffix)
+	require.NoError(t, err)
+	require.True(t, exists)
+	data, err := s.ReadFile(ctx, "backupmeta")
+	require.NoError(t, err)
+	require.Equal(t, []byte("meta"), data)
+
+	w, err := s.Create(ctx, "1/data")
+	require.NoError(t, err)
+	_, err = w.Write(ctx, []byte("0123"))
+	require.NoError(t, err)
+	_, err = w.Write(ctx, []byte("456789"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close(ctx))
+	r, err := s.Open(ctx, "1/data")
+	require.NoError(t, err)
+	data, err = io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, []byte("0123456789"), data)
+	require.NoError(t, r.Close())
+
+	// the files without checksum aren't verified.
+	require.NoError(t, local.WriteFile(ctx, "legacy", []byte("legacy")))
+	data, err = s.ReadFile(ctx, "legacy")
+	require.NoError(t, err)
+	require.Equal(t, []byte("legacy"), data)
+
+	var paths []string
+	require.NoError(t, s.WalkDir(ctx, nil, func(path string, _ int64) error {
+		paths = append(paths, path)
+		return nil
+	}))
+	require.Equal(t, []string{"1/data", "backupmeta", "legacy"}, paths)
+
+	// the corrupted files are detected.
+	require.NoError(t, local.WriteFile(ctx, "backupmeta", []byte("mate")))
+	_, err = s.ReadFile(ctx, "backupmeta")
+	require.True(t, berrors.ErrStorageChecksumMismatch.Equal(errors.Cause(err)))
+	require.NoError(t, local.WriteFile(ctx, "1/data", []byte("0123456788")))
+	r, err = s.Open(ctx, "1/data")
+	require.NoError(t, err)
+	_, err = io.ReadAll(r)
+	require.True(t, berrors.ErrStorageChecksumMismatch.Equal(errors.Cause(err)))
+	require.NoError(t, r.Close())
+	// the file isn't verified if it's read partially.
+	r, err = s.Open(ctx, "1/data")
+	require.NoError(t, err)
+	_, err = r.Seek(5, io.SeekStart)
+	require.NoError(t, err)
+	data, err = io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, []byte("56788"), data)
+	require.NoError(t, r.Close())
+
+	// the checksums are moved and deleted with the files.
+	require.NoError(t, s.Rename(ctx, "backupmeta", "backupmeta.bak"))
+	exists, err = local.FileExists(ctx, "backupmeta.bak"+ChecksumSuffix)
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.NoError(t, s.Rename(ctx, "legacy", "backupmeta.bak"))
+	data, err = s.ReadFile(ctx, "backupmeta.bak")
+	require.NoError(t, err)
+	require.Equal(t, []byte("legacy"), data)
+	require.NoError(t, s.DeleteFile(ctx, "1/data"))
+	exists, err = local.FileExists(ctx, "1/data"+ChecksumSuffix)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+type failedWriteStorage struct {
+	ExternalStorage
+}
+
+func (failedWriteStorage) WriteFile(context.Context, string, []byte) error {
+	return errors.New("failed to write")
+}
+
+func TestChecksumNotStale(t *testing.T) {
+	ctx := context.Background()
+	local, err := NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, WithChecksum(local).WriteFile(ctx, "checkpoint", []byte("old")))
+
+	// the checksum is removed before the file is rewritten, so the file isn't
+	// verified by the stale checksum if the writing fails in the middle.
+	require.Error(t, WithChecksum(failedWriteStorage{local}).WriteFile(ctx, "checkpoint", []byte("new")))
+	exists, err := local.FileExists(ctx, "checkpoint"+ChecksumSuffix)
+	require.NoError(t, err)
+	require.False(t, exists)
+	require.NoError(t, WithChecksum(local).WriteFile(ctx, "checkpoint", []byte("new")))
+	data, err := WithChecksum(local).ReadFile(ctx, "checkpoint")
+	require.NoError(t, err)
+	require.Equal(t, []byte("new"), data)
+
+	require.True(t, IsChecksumFile("checkpoint"+ChecksumSuffix))
+	require.False(t, IsChecksumFile("checkpoint"))
+}
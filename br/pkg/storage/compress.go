@@ -26,18 +26,23 @@ func WithCompression(inner ExternalStorage, compressionType CompressType) Extern
 
 func (w *withCompression) Create(ctx context.Context, name string) (ExternalFileWriter, error) {
 	var (
-		writer ExternalFileWriter
-		err    error
+		writer    ExternalFileWriter
+		err       error
+		chunkSize = hardcodedS3ChunkSize
 	)
 	if s3Storage, ok := w.ExternalStorage.(*S3Storage); ok {
 		writer, err = s3Storage.CreateUploader(ctx, name)
+		chunkSize = s3Storage.upload.PartSize
 	} else {
 		writer, err = w.ExternalStorage.Create(ctx, name)
 	}
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	compressedWriter := newBufferedWriter(writer, hardcodedS3ChunkSize, w.compressType)
+	compressedWriter, err := newBufferedWriter(writer, chunkSize, w.compressType)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	return compressedWriter, nil
 }
 
@@ -46,7 +51,7 @@ func (w *withCompression) Open(ctx context.Context, path string) (ExternalFileRe
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	uncompressReader, err := newInterceptReader(fileReader, w.compressType)
+	uncompressReader, err := InterceptDecompressReader(fileReader, w.compressType, DecompressConfig{})
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -55,8 +60,11 @@ func (w *withCompression) Open(ctx context.Context, path string) (ExternalFileRe
 
 func (w *withCompression) WriteFile(ctx context.Context, name string, data []byte) error {
 	bf := bytes.NewBuffer(make([]byte, 0, len(data)))
-	compressBf := newCompressWriter(w.compressType, bf)
-	_, err := compressBf.Write(data)
+	compressBf, err := newCompressWriter(w.compressType, bf)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = compressBf.Write(data)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -73,7 +81,7 @@ func (w *withCompression) ReadFile(ctx context.Context, name string) ([]byte, er
 		return data, errors.Trace(err)
 	}
 	bf := bytes.NewBuffer(data)
-	compressBf, err := newCompressReader(w.compressType, bf)
+	compressBf, err := newCompressReader(w.compressType, DecompressConfig{}, bf)
 	if err != nil {
 		return nil, err
 	}
@@ -82,19 +90,23 @@ func (w *withCompression) ReadFile(ctx context.Context, name string) ([]byte, er
 
 type compressReader struct {
 	io.ReadCloser
+	fileReader io.Closer
 }
 
+// InterceptDecompressReader wraps the file reader to read the decompressed
+// data of the file, the returned reader can't be seeked.
 // nolint:interfacer
-func newInterceptReader(fileReader ExternalFileReader, compressType CompressType) (ExternalFileReader, error) {
+func InterceptDecompressReader(fileReader ExternalFileReader, compressType CompressType, cfg DecompressConfig) (ExternalFileReader, error) {
 	if compressType == NoCompression {
 		return fileReader, nil
 	}
-	r, err := newCompressReader(compressType, fileReader)
+	r, err := newCompressReader(compressType, cfg, fileReader)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 	return &compressReader{
 		ReadCloser: r,
+		fileReader: fileReader,
 	}, nil
 }
 
@@ -102,6 +114,15 @@ func (*compressReader) Seek(_ int64, _ int) (int64, error) {
 	return int64(0), errors.Annotatef(berrors.ErrStorageInvalidConfig, "compressReader doesn't support Seek now")
 }
 
+// Close closes both the decompressor and the underlying file reader.
+func (c *compressReader) Close() error {
+	err := c.ReadCloser.Close()
+	if err2 := c.fileReader.Close(); err == nil {
+		err = err2
+	}
+	return errors.Trace(err)
+}
+
 type flushStorageWriter struct {
 	writer  io.Writer
 	flusher flusher
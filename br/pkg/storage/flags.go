@@ -12,12 +12,14 @@ func DefineFlags(flags *pflag.FlagSet) {
 	defineS3Flags(flags)
 	defineGCSFlags(flags)
 	defineAzblobFlags(flags)
+	defineHDFSFlags(flags)
 }
 
 // HiddenFlagsForStream hidden flags for stream cmd.
 func HiddenFlagsForStream(flags *pflag.FlagSet) {
 	hiddenGCSFlags(flags)
 	hiddenAzblobFlags(flags)
+	hiddenHDFSFlags(flags)
 }
 
 // ParseFromFlags obtains the backend options from the flag set.
@@ -31,5 +33,8 @@ func (options *BackendOptions) ParseFromFlags(flags *pflag.FlagSet) error {
 	if err := options.Azblob.parseFromFlags(flags); err != nil {
 		return errors.Trace(err)
 	}
+	if err := options.HDFS.parseFromFlags(flags); err != nil {
+		return errors.Trace(err)
+	}
 	return nil
 }
@@ -186,20 +186,24 @@ func (s *gcsStorage) WalkDir(ctx context.Context, opt *WalkOption, fn func(strin
 	if opt == nil {
 		opt = &WalkOption{}
 	}
-	if len(opt.ObjPrefix) != 0 {
-		return errors.New("gcs storage not support ObjPrefix for now")
-	}
 	prefix := path.Join(s.gcs.Prefix, opt.SubDir)
 	if len(prefix) > 0 && !strings.HasSuffix(prefix, "/") {
 		prefix += "/"
 	}
-	query := &storage.Query{Prefix: prefix}
+	query := &storage.Query{Prefix: prefix + opt.ObjPrefix}
+	if len(opt.StartAfter) > 0 {
+		// the start offset is inclusive, the file at it is filtered out later.
+		query.StartOffset = s.objectName(opt.StartAfter)
+	}
 	// only need each object's name and size
 	err := query.SetAttrSelection([]string{"Name", "Size"})
 	if err != nil {
 		return errors.Trace(err)
 	}
 	iter := s.bucket.Objects(ctx, query)
+	if opt.ListCount > 0 {
+		iter.PageInfo().MaxSize = int(opt.ListCount)
+	}
 	for {
 		attrs, err := iter.Next()
 		if err == iterator.Done {
@@ -214,6 +218,9 @@ func (s *gcsStorage) WalkDir(ctx context.Context, opt *WalkOption, fn func(strin
 		path := strings.TrimPrefix(attrs.Name, s.gcs.Prefix)
 		// trim the prefix '/' to ensure that the path returned is consistent with the local storage
 		path = strings.TrimPrefix(path, "/")
+		if !opt.filter(path) {
+			continue
+		}
 		if err = fn(path, attrs.Size); err != nil {
 			return errors.Trace(err)
 		}
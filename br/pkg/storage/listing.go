@@ -0,0 +1,228 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package storage
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+)
+
+const (
+	// maxCachedListing is the max number of the files of a listing to be cached,
+	// the larger listing is walked from the storage every time.
+	maxCachedListing = 100000
+	// DefaultListingCacheTTL is the default time a listing is cached.
+	DefaultListingCacheTTL = 10 * time.Minute
+)
+
+// errStopWalk stops the walk once a page is filled.
+var errStopWalk = errors.New("stop walking")
+
+// FileInfo is the path and the size of a file in the storage.
+type FileInfo struct {
+	Path string
+	Size int64
+}
+
+// sortedWalker is implemented by the storages walking the files in the
+// lexicographical order of their paths.
+type sortedWalker interface {
+	walkSorted()
+}
+
+func (*S3Storage) walkSorted()        {}
+func (*gcsStorage) walkSorted()       {}
+func (*AzureBlobStorage) walkSorted() {}
+
+// fileInfoHeap is a max heap of the files by their paths.
+type fileInfoHeap []FileInfo
+
+func (h fileInfoHeap) Len() int           { return len(h) }
+func (h fileInfoHeap) Less(i, j int) bool { return h[i].Path > h[j].Path }
+func (h fileInfoHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *fileInfoHeap) Push(x interface{}) {
+	*h = append(*h, x.(FileInfo))
+}
+
+func (h *fileInfoHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// WalkPage returns a page of at most limit files after opt.StartAfter in the
+// lexicographical order of their paths, and the StartAfter of the next page,
+// which is empty if all files have been listed. So the caller can enumerate
+// the files page by page, and never holds more than a page in memory.
+//
+// The cloud storages stop listing once the page is filled, the others walk all
+// files after opt.StartAfter for each page, since they don't walk the files in
+// order.
+func WalkPage(ctx context.Context, s ExternalStorage, opt *WalkOption, limit int) ([]FileInfo, string, error) {
+	if limit <= 0 {
+		return nil, "", errors.Errorf("invalid page size %d", limit)
+	}
+	pageOpt := WalkOption{}
+	if opt != nil {
+		pageOpt = *opt
+	}
+	_, sorted := s.(sortedWalker)
+
+	page := make(fileInfoHeap, 0, limit)
+	more := false
+	err := s.WalkDir(ctx, &pageOpt, func(path string, size int64) error {
+		if len(page) < limit {
+			heap.Push(&page, FileInfo{Path: path, Size: size})
+			return nil
+		}
+		more = true
+		if sorted {
+			return errStopWalk
+		}
+		if path < page[0].Path {
+			page[0] = FileInfo{Path: path, Size: size}
+			heap.Fix(&page, 0)
+		}
+		return nil
+	})
+	if err != nil && errors.Cause(err) != errStopWalk {
+		return nil, "", errors.Trace(err)
+	}
+
+	files := make([]FileInfo, len(page))
+	for i := len(page) - 1; i >= 0; i-- {
+		files[i] = heap.Pop(&page).(FileInfo)
+	}
+	if !more {
+		return files, "", nil
+	}
+	return files, files[len(files)-1].Path, nil
+}
+
+type listingCacheEntry struct {
+	files    []FileInfo
+	cachedAt time.Time
+}
+
+// withListingCache caches the listings of the storage, so the same files are
+// enumerated once if they're walked repeatedly, e.g. the metadata of the log
+// backup during PITR. The cache is dropped once a file is written by it.
+type withListingCache struct {
+	ExternalStorage
+	ttl time.Duration
+	now func() time.Time
+
+	mu    sync.Mutex
+	cache map[WalkOption]listingCacheEntry
+}
+
+// WithListingCache returns an ExternalStorage caching the listings for ttl.
+// The files written by others during ttl may not be walked.
+func WithListingCache(inner ExternalStorage, ttl time.Duration) ExternalStorage {
+	if ttl <= 0 {
+		return inner
+	}
+	return &withListingCache{
+		ExternalStorage: inner,
+		ttl:             ttl,
+		now:             time.Now,
+		cache:           make(map[WalkOption]listingCacheEntry),
+	}
+}
+
+func (w *withListingCache) load(opt WalkOption) ([]FileInfo, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	entry, ok := w.cache[opt]
+	if !ok || w.now().Sub(entry.cachedAt) > w.ttl {
+		delete(w.cache, opt)
+		return nil, false
+	}
+	return entry.files, true
+}
+
+func (w *withListingCache) invalidate() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.cache = make(map[WalkOption]listingCacheEntry)
+}
+
+func (w *withListingCache) WalkDir(ctx context.Context, opt *WalkOption, fn func(path string, size int64) error) error {
+	key := WalkOption{}
+	if opt != nil {
+		key = *opt
+	}
+	if files, ok := w.load(key); ok {
+		for _, f := range files {
+			if err := fn(f.Path, f.Size); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		return nil
+	}
+
+	cachedAt := w.now()
+	files := make([]FileInfo, 0)
+	err := w.ExternalStorage.WalkDir(ctx, &key, func(path string, size int64) error {
+		if files != nil {
+			files = append(files, FileInfo{Path: path, Size: size})
+			if len(files) > maxCachedListing {
+				// too many files to be cached.
+				files = nil
+			}
+		}
+		return fn(path, size)
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if files != nil {
+		w.mu.Lock()
+		w.cache[key] = listingCacheEntry{files: files, cachedAt: cachedAt}
+		w.mu.Unlock()
+	}
+	return nil
+}
+
+func (w *withListingCache) WriteFile(ctx context.Context, name string, data []byte) error {
+	defer w.invalidate()
+	return w.ExternalStorage.WriteFile(ctx, name, data)
+}
+
+func (w *withListingCache) DeleteFile(ctx context.Context, name string) error {
+	defer w.invalidate()
+	return w.ExternalStorage.DeleteFile(ctx, name)
+}
+
+func (w *withListingCache) Rename(ctx context.Context, oldFileName, newFileName string) error {
+	defer w.invalidate()
+	return w.ExternalStorage.Rename(ctx, oldFileName, newFileName)
+}
+
+func (w *withListingCache) Create(ctx context.Context, path string) (ExternalFileWriter, error) {
+	w.invalidate()
+	writer, err := w.ExternalStorage.Create(ctx, path)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &invalidateOnCloseWriter{ExternalFileWriter: writer, storage: w}, nil
+}
+
+// invalidateOnCloseWriter drops the cached listings once the file is written,
+// since the file may be visible only after it's closed.
+type invalidateOnCloseWriter struct {
+	ExternalFileWriter
+	storage *withListingCache
+}
+
+func (w *invalidateOnCloseWriter) Close(ctx context.Context) error {
+	defer w.storage.invalidate()
+	return w.ExternalFileWriter.Close(ctx)
+}
@@ -0,0 +1,125 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalkOptionFilters(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s, err := NewLocalStorage(dir)
+	require.NoError(t, err)
+	for _, sub := range []string{"v1/meta", "v1/global", "v1/meta2"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, sub), 0o755))
+	}
+	for _, name := range []string{"v1/meta/1.meta", "v1/meta/2.meta", "v1/meta/2.tmp", "v1/global/1.ts", "v1/meta2/3.meta"} {
+		require.NoError(t, s.WriteFile(ctx, name, []byte(name)))
+	}
+
+	walk := func(opt *WalkOption) []string {
+		var paths []string
+		require.NoError(t, s.WalkDir(ctx, opt, func(path string, _ int64) error {
+			paths = append(paths, path)
+			return nil
+		}))
+		return paths
+	}
+	require.Equal(t, []string{"v1/meta/1.meta", "v1/meta/2.meta", "v1/meta/2.tmp"}, walk(&WalkOption{SubDir: "v1/meta"}))
+	require.Equal(t, []string{"v1/meta/1.meta", "v1/meta/2.meta"}, walk(&WalkOption{SubDir: "v1/meta", ObjSuffix: ".meta"}))
+	require.Equal(t, []string{"v1/meta/2.meta", "v1/meta/2.tmp"}, walk(&WalkOption{SubDir: "v1", ObjPrefix: "meta/2"}))
+	require.Equal(t, []string{"v1/meta/2.meta", "v1/meta/2.tmp", "v1/meta2/3.meta"},
+		walk(&WalkOption{SubDir: "v1", ObjPrefix: "meta", StartAfter: "v1/meta/1.meta"}))
+}
+
+func TestWalkPage(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s, err := NewLocalStorage(dir)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "log"), 0o755))
+	expected := make([]FileInfo, 0, 25)
+	for i := 0; i < 25; i++ {
+		name := fmt.Sprintf("log/%02d.log", i)
+		require.NoError(t, s.WriteFile(ctx, name, make([]byte, i)))
+		expected = append(expected, FileInfo{Path: name, Size: int64(i)})
+	}
+	require.NoError(t, s.WriteFile(ctx, "log/meta", nil))
+
+	_, _, err = WalkPage(ctx, s, nil, 0)
+	require.Error(t, err)
+
+	opt := &WalkOption{SubDir: "log", ObjSuffix: ".log"}
+	listed := make([]FileInfo, 0, 25)
+	pages := 0
+	for {
+		files, next, err := WalkPage(ctx, s, opt, 10)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(files), 10)
+		listed = append(listed, files...)
+		pages++
+		if len(next) == 0 {
+			break
+		}
+		opt.StartAfter = next
+	}
+	require.Equal(t, 3, pages)
+	require.Equal(t, expected, listed)
+}
+
+func TestListingCache(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	local, err := NewLocalStorage(dir)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "meta"), 0o755))
+	s := WithListingCache(local, time.Minute)
+	now := time.Now()
+	s.(*withListingCache).now = func() time.Time { return now }
+
+	walk := func() []string {
+		var paths []string
+		require.NoError(t, s.WalkDir(ctx, &WalkOption{SubDir: "meta"}, func(path string, _ int64) error {
+			paths = append(paths, path)
+			return nil
+		}))
+		return paths
+	}
+	require.NoError(t, s.WriteFile(ctx, "meta/1.meta", nil))
+	require.Equal(t, []string{"meta/1.meta"}, walk())
+
+	// the files written by others are invisible until the cache expires.
+	require.NoError(t, local.WriteFile(ctx, "meta/2.meta", nil))
+	require.Equal(t, []string{"meta/1.meta"}, walk())
+	now = now.Add(2 * time.Minute)
+	require.Equal(t, []string{"meta/1.meta", "meta/2.meta"}, walk())
+
+	// the files written by the storage are visible at once.
+	require.NoError(t, s.DeleteFile(ctx, "meta/1.meta"))
+	require.Equal(t, []string{"meta/2.meta"}, walk())
+	w, err := s.Create(ctx, "meta/3.meta")
+	require.NoError(t, err)
+	_, err = w.Write(ctx, []byte("meta"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close(ctx))
+	require.Equal(t, []string{"meta/2.meta", "meta/3.meta"}, walk())
+	require.NoError(t, s.Rename(ctx, "meta/3.meta", "meta/4.meta"))
+
+	// the walk stopped isn't cached.
+	stopped := errors.New("stopped")
+	err = s.WalkDir(ctx, &WalkOption{SubDir: "meta"}, func(string, int64) error {
+		return stopped
+	})
+	require.Equal(t, stopped, errors.Cause(err))
+	require.NoError(t, local.WriteFile(ctx, "meta/5.meta", nil))
+	require.Equal(t, []string{"meta/2.meta", "meta/4.meta", "meta/5.meta"}, walk())
+}
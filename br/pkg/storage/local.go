@@ -85,7 +85,7 @@ func (l *LocalStorage) WalkDir(_ context.Context, opt *WalkOption, fn func(strin
 		// so use Rel to convert to relative path to l.base
 		path, _ = filepath.Rel(l.base, path)
 
-		if !strings.HasPrefix(path, opt.ObjPrefix) {
+		if !opt.match(path) {
 			return nil
 		}
 
@@ -185,6 +185,9 @@ func (s *MemStorage) WalkDir(ctx context.Context, opt *WalkOption, fn func(strin
 						continue
 					}
 				}
+				if !opt.filter(fileName) {
+					continue
+				}
 			}
 			fileNames = append(fileNames, fileName)
 		}
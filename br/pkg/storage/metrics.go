@@ -0,0 +1,40 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package storage
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	s3UploadBytesCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "br",
+			Subsystem: "storage",
+			Name:      "s3_upload_bytes_total",
+			Help:      "Counter of the bytes uploaded to s3 by the multipart upload.",
+		})
+
+	s3UploadPartHistogram = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "br",
+			Subsystem: "storage",
+			Name:      "s3_upload_part_seconds",
+			Help:      "Bucketed histogram of the time to upload a part to s3.",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 16),
+		})
+
+	s3UploadPartRetryCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "br",
+			Subsystem: "storage",
+			Name:      "s3_upload_part_retries_total",
+			Help:      "Counter of the retries of the failed parts uploaded to s3.",
+		})
+)
+
+func init() { // nolint:gochecknoinits
+	prometheus.MustRegister(s3UploadBytesCounter)
+	prometheus.MustRegister(s3UploadPartHistogram)
+	prometheus.MustRegister(s3UploadPartRetryCounter)
+}
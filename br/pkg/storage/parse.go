@@ -20,6 +20,7 @@ type BackendOptions struct {
 	S3     S3BackendOptions     `json:"s3" toml:"s3"`
 	GCS    GCSBackendOptions    `json:"gcs" toml:"gcs"`
 	Azblob AzblobBackendOptions `json:"azblob" toml:"azblob"`
+	HDFS   HDFSBackendOptions   `json:"hdfs" toml:"hdfs"`
 }
 
 // ParseRawURL parse raw url to url object.
@@ -62,6 +63,17 @@ func ParseBackend(rawURL string, options *BackendOptions) (*backuppb.StorageBack
 		hdfs := &backuppb.HDFS{Remote: rawURL}
 		return &backuppb.StorageBackend{Backend: &backuppb.StorageBackend_Hdfs{Hdfs: hdfs}}, nil
 
+	case "webhdfs", "swebhdfs":
+		if u.Host == "" {
+			return nil, errors.Annotatef(berrors.ErrStorageInvalidConfig, "please specify the namenode for webhdfs in %s", rawURL)
+		}
+		if options == nil {
+			options = &BackendOptions{}
+		}
+		ExtractQueryParameters(u, &options.HDFS)
+		hdfs := &backuppb.HDFS{Remote: u.String()}
+		return &backuppb.StorageBackend{Backend: &backuppb.StorageBackend_Hdfs{Hdfs: hdfs}}, nil
+
 	case "noop":
 		noop := &backuppb.Noop{}
 		return &backuppb.StorageBackend{Backend: &backuppb.StorageBackend_Noop{Noop: noop}}, nil
@@ -174,6 +186,10 @@ func FormatBackendURL(backend *backuppb.StorageBackend) (u url.URL) {
 	case *backuppb.StorageBackend_Local:
 		u.Scheme = "local"
 		u.Path = b.Local.Path
+	case *backuppb.StorageBackend_Hdfs:
+		if remote, err := url.Parse(b.Hdfs.Remote); err == nil {
+			u = *remote
+		}
 	case *backuppb.StorageBackend_Noop:
 		u.Scheme = "noop"
 		u.Path = "/"
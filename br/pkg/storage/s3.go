@@ -5,13 +5,17 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/md5" // #nosec G501
+	"encoding/base64"
 	"fmt"
 	"io"
 	"net/url"
 	"path"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	alicred "github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
@@ -26,6 +30,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/docker/go-units"
 	"github.com/pingcap/errors"
 	backuppb "github.com/pingcap/kvproto/pkg/brpb"
 	"github.com/pingcap/log"
@@ -33,6 +38,7 @@ import (
 	"github.com/pingcap/tidb/br/pkg/logutil"
 	"github.com/spf13/pflag"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
@@ -45,6 +51,8 @@ const (
 	s3ProviderOption     = "s3.provider"
 	s3RoleARNOption      = "s3.role-arn"
 	s3ExternalIDOption   = "s3.external-id"
+	s3PartSizeOption     = "s3.part-size"
+	s3UploadConcurrency  = "s3.upload-concurrency"
 	notFound             = "NotFound"
 	// number of retries to make of operations.
 	maxRetries = 7
@@ -55,11 +63,21 @@ const (
 	// the maximum number of byte to read for seek.
 	maxSkipOffsetByRead = 1 << 16 // 64KB
 
-	// TODO make this configurable, 5 mb is a good minimum size but on low latency/high bandwidth network you can go a lot bigger
+	// hardcodedS3ChunkSize is the default part size of the multipart upload, 5 mb is a good minimum size
+	// but on low latency/high bandwidth network you can go a lot bigger by --s3.part-size.
 	hardcodedS3ChunkSize = 5 * 1024 * 1024
 	defaultRegion        = "us-east-1"
 	// to check the cloud type by endpoint tag.
 	domainAliyun = "aliyuncs.com"
+
+	// the part size of the multipart upload should be in [5MiB, 5GiB].
+	minS3PartSize = hardcodedS3ChunkSize
+	maxS3PartSize = 5 * 1024 * 1024 * 1024
+	// defaultS3UploadConcurrency is the default number of the parts uploaded concurrently.
+	defaultS3UploadConcurrency = 4
+	// maxS3PartRetries is the number of retries of a failed part, the part is
+	// retried by the uploader after the retries of the request are exhausted.
+	maxS3PartRetries = 3
 )
 
 var permissionCheckFn = map[Permission]func(*s3.S3, *backuppb.S3) error{
@@ -73,40 +91,136 @@ type S3Storage struct {
 	session *session.Session
 	svc     s3iface.S3API
 	options *backuppb.S3
+	upload  S3UploadOptions
 }
 
-// S3Uploader does multi-part upload to s3.
+// S3Uploader does multi-part upload to s3. The parts are uploaded concurrently,
+// and a failed part is retried alone rather than restarting the whole file.
 type S3Uploader struct {
-	svc           s3iface.S3API
-	createOutput  *s3.CreateMultipartUploadOutput
+	svc          s3iface.S3API
+	createOutput *s3.CreateMultipartUploadOutput
+
+	nextPart int64
+	// tokens limits the number of the parts in flight, so does the memory.
+	tokens chan struct{}
+	eg     *errgroup.Group
+	ectx   context.Context
+
+	mu            sync.Mutex
 	completeParts []*s3.CompletedPart
 }
 
+func newS3Uploader(svc s3iface.S3API, createOutput *s3.CreateMultipartUploadOutput, concurrency int) *S3Uploader {
+	return &S3Uploader{
+		svc:           svc,
+		createOutput:  createOutput,
+		tokens:        make(chan struct{}, concurrency),
+		completeParts: make([]*s3.CompletedPart, 0, 128),
+	}
+}
+
 // UploadPart update partial data to s3, we should call CreateMultipartUpload to start it,
 // and call CompleteMultipartUpload to finish it.
+//
+// The part is uploaded in background, it returns once the part is accepted, and
+// the error of the parts is returned by the later Write or Close.
 func (u *S3Uploader) Write(ctx context.Context, data []byte) (int, error) {
-	partInput := &s3.UploadPartInput{
-		Body:          bytes.NewReader(data),
-		Bucket:        u.createOutput.Bucket,
-		Key:           u.createOutput.Key,
-		PartNumber:    aws.Int64(int64(len(u.completeParts) + 1)),
-		UploadId:      u.createOutput.UploadId,
-		ContentLength: aws.Int64(int64(len(data))),
-	}
-
-	uploadResult, err := u.svc.UploadPartWithContext(ctx, partInput)
-	if err != nil {
+	if u.eg == nil {
+		u.eg, u.ectx = errgroup.WithContext(ctx)
+	}
+	select {
+	case u.tokens <- struct{}{}:
+	case <-u.ectx.Done():
+		// some part failed, the error is returned by Wait.
+		err := u.eg.Wait()
+		u.abort(ctx)
 		return 0, errors.Trace(err)
+	case <-ctx.Done():
+		return 0, errors.Trace(ctx.Err())
 	}
-	u.completeParts = append(u.completeParts, &s3.CompletedPart{
-		ETag:       uploadResult.ETag,
-		PartNumber: partInput.PartNumber,
+
+	u.nextPart++
+	partNumber := u.nextPart
+	// the data is reused by the caller once Write returns.
+	part := append([]byte(nil), data...)
+	u.eg.Go(func() error {
+		defer func() { <-u.tokens }()
+		return u.uploadPart(u.ectx, partNumber, part)
 	})
 	return len(data), nil
 }
 
+// uploadPart uploads the part with its MD5 checksum, so the corrupted part is
+// rejected by s3, and retries it if it fails.
+func (u *S3Uploader) uploadPart(ctx context.Context, partNumber int64, data []byte) error {
+	sum := md5.Sum(data) // #nosec G401
+	var err error
+	for retry := 0; retry <= maxS3PartRetries; retry++ {
+		if retry > 0 {
+			log.Warn("failed to upload the part to s3, retrying",
+				zap.Stringp("key", u.createOutput.Key), zap.Int64("part", partNumber),
+				zap.Int("retry", retry), logutil.ShortError(err))
+			s3UploadPartRetryCounter.Inc()
+			select {
+			case <-time.After(time.Duration(retry) * time.Second):
+			case <-ctx.Done():
+				return errors.Trace(ctx.Err())
+			}
+		}
+		partInput := &s3.UploadPartInput{
+			Body:          bytes.NewReader(data),
+			Bucket:        u.createOutput.Bucket,
+			Key:           u.createOutput.Key,
+			PartNumber:    aws.Int64(partNumber),
+			UploadId:      u.createOutput.UploadId,
+			ContentLength: aws.Int64(int64(len(data))),
+			ContentMD5:    aws.String(base64.StdEncoding.EncodeToString(sum[:])),
+		}
+		start := time.Now()
+		var uploadResult *s3.UploadPartOutput
+		uploadResult, err = u.svc.UploadPartWithContext(ctx, partInput)
+		if err == nil {
+			s3UploadPartHistogram.Observe(time.Since(start).Seconds())
+			s3UploadBytesCounter.Add(float64(len(data)))
+			u.mu.Lock()
+			u.completeParts = append(u.completeParts, &s3.CompletedPart{
+				ETag:       uploadResult.ETag,
+				PartNumber: partInput.PartNumber,
+			})
+			u.mu.Unlock()
+			return nil
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return errors.Annotatef(err, "failed to upload the part %d of %s", partNumber, aws.StringValue(u.createOutput.Key))
+}
+
+// abort aborts the upload, so the uploaded parts don't cost.
+func (u *S3Uploader) abort(ctx context.Context) {
+	_, err := u.svc.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   u.createOutput.Bucket,
+		Key:      u.createOutput.Key,
+		UploadId: u.createOutput.UploadId,
+	})
+	if err != nil {
+		log.Warn("failed to abort the multipart upload", zap.Stringp("key", u.createOutput.Key),
+			logutil.ShortError(err))
+	}
+}
+
 // Close complete multi upload request.
 func (u *S3Uploader) Close(ctx context.Context) error {
+	if u.eg != nil {
+		if err := u.eg.Wait(); err != nil {
+			u.abort(ctx)
+			return errors.Trace(err)
+		}
+	}
+	sort.Slice(u.completeParts, func(i, j int) bool {
+		return *u.completeParts[i].PartNumber < *u.completeParts[j].PartNumber
+	})
 	completeInput := &s3.CompleteMultipartUploadInput{
 		Bucket:   u.createOutput.Bucket,
 		Key:      u.createOutput.Key,
@@ -134,6 +248,48 @@ type S3BackendOptions struct {
 	UseAccelerateEndpoint bool   `json:"use-accelerate-endpoint" toml:"use-accelerate-endpoint"`
 	RoleARN               string `json:"role-arn" toml:"role-arn"`
 	ExternalID            string `json:"external-id" toml:"external-id"`
+	// PartSize is the size of the parts of the multipart upload, e.g. 64MiB.
+	PartSize string `json:"part-size" toml:"part-size"`
+	// UploadConcurrency is the number of the parts uploaded concurrently.
+	UploadConcurrency string `json:"upload-concurrency" toml:"upload-concurrency"`
+}
+
+// S3UploadOptions tunes the multipart upload of the s3 storage.
+type S3UploadOptions struct {
+	PartSize    int
+	Concurrency int
+}
+
+func (o *S3UploadOptions) adjust() {
+	if o.PartSize == 0 {
+		o.PartSize = hardcodedS3ChunkSize
+	}
+	if o.Concurrency == 0 {
+		o.Concurrency = defaultS3UploadConcurrency
+	}
+}
+
+// UploadOptions returns the options of the multipart upload.
+func (options *S3BackendOptions) UploadOptions() (*S3UploadOptions, error) {
+	var opts S3UploadOptions
+	if len(options.PartSize) > 0 {
+		size, err := units.RAMInBytes(options.PartSize)
+		if err != nil || size < minS3PartSize || size > maxS3PartSize {
+			return nil, errors.Annotatef(berrors.ErrStorageInvalidConfig,
+				"invalid part size %s of s3, it should be in [5MiB, 5GiB]", options.PartSize)
+		}
+		opts.PartSize = int(size)
+	}
+	if len(options.UploadConcurrency) > 0 {
+		concurrency, err := strconv.Atoi(options.UploadConcurrency)
+		if err != nil || concurrency <= 0 {
+			return nil, errors.Annotatef(berrors.ErrStorageInvalidConfig,
+				"invalid upload concurrency %s of s3", options.UploadConcurrency)
+		}
+		opts.Concurrency = concurrency
+	}
+	opts.adjust()
+	return &opts, nil
 }
 
 // Apply apply s3 options on backuppb.S3.
@@ -162,6 +318,9 @@ func (options *S3BackendOptions) Apply(s3 *backuppb.S3) error {
 	if options.AccessKey != "" && options.SecretAccessKey == "" {
 		return errors.Annotate(berrors.ErrStorageInvalidConfig, "secret_access_key not found")
 	}
+	if _, err := options.UploadOptions(); err != nil {
+		return errors.Trace(err)
+	}
 
 	s3.Endpoint = strings.TrimSuffix(options.Endpoint, "/")
 	s3.Region = options.Region
@@ -192,6 +351,10 @@ func defineS3Flags(flags *pflag.FlagSet) {
 	flags.String(s3ProviderOption, "", "(experimental) Set the S3 provider, e.g. aws, alibaba, ceph")
 	flags.String(s3RoleARNOption, "", "(experimental) Set the ARN of the IAM role to assume when accessing AWS S3")
 	flags.String(s3ExternalIDOption, "", "(experimental) Set the external ID when assuming the role to access AWS S3")
+	flags.String(s3PartSizeOption, "", "(experimental) Set the part size of the multipart upload to S3, "+
+		"e.g. 64MiB, it should be in [5MiB, 5GiB]. 5MiB by default")
+	flags.String(s3UploadConcurrency, "", "(experimental) Set the number of the parts uploaded to S3 concurrently "+
+		"for a file. 4 by default")
 }
 
 // parseFromFlags parse S3BackendOptions from command line flags.
@@ -235,16 +398,26 @@ func (options *S3BackendOptions) parseFromFlags(flags *pflag.FlagSet) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	options.PartSize, err = flags.GetString(s3PartSizeOption)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	options.UploadConcurrency, err = flags.GetString(s3UploadConcurrency)
+	if err != nil {
+		return errors.Trace(err)
+	}
 	return nil
 }
 
 // NewS3StorageForTest creates a new S3Storage for testing only.
 func NewS3StorageForTest(svc s3iface.S3API, options *backuppb.S3) *S3Storage {
-	return &S3Storage{
+	s := &S3Storage{
 		session: nil,
 		svc:     svc,
 		options: options,
 	}
+	s.upload.adjust()
+	return s
 }
 
 // NewS3Storage initialize a new s3 storage for metadata.
@@ -387,11 +560,16 @@ func newS3Storage(backend *backuppb.S3, opts *ExternalStorageOptions) (obj *S3St
 		}
 	}
 
-	return &S3Storage{
+	s := &S3Storage{
 		session: ses,
 		svc:     c,
 		options: &qs,
-	}, nil
+	}
+	if opts.S3Upload != nil {
+		s.upload = *opts.S3Upload
+	}
+	s.upload.adjust()
+	return s, nil
 }
 
 // checkBucket checks if a bucket exists.
@@ -547,6 +725,9 @@ func (rs *S3Storage) WalkDir(ctx context.Context, opt *WalkOption, fn func(strin
 		Prefix:  aws.String(prefix),
 		MaxKeys: aws.Int64(maxKeys),
 	}
+	if len(opt.StartAfter) > 0 {
+		req.Marker = aws.String(rs.options.Prefix + opt.StartAfter)
+	}
 
 	for {
 		// FIXME: We can't use ListObjectsV2, it is not universally supported.
@@ -581,6 +762,9 @@ func (rs *S3Storage) WalkDir(ctx context.Context, opt *WalkOption, fn func(strin
 				log.Info("this path is an empty directory and cannot be opened in S3.  Skip it", zap.String("path", path))
 				continue
 			}
+			if !opt.filter(path) {
+				continue
+			}
 			if err = fn(path, itemSize); err != nil {
 				return errors.Trace(err)
 			}
@@ -857,11 +1041,7 @@ func (rs *S3Storage) CreateUploader(ctx context.Context, name string) (ExternalF
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	return &S3Uploader{
-		svc:           rs.svc,
-		createOutput:  resp,
-		completeParts: make([]*s3.CompletedPart, 0, 128),
-	}, nil
+	return newS3Uploader(rs.svc, resp, rs.upload.Concurrency), nil
 }
 
 // Create creates multi upload request.
@@ -870,7 +1050,10 @@ func (rs *S3Storage) Create(ctx context.Context, name string) (ExternalFileWrite
 	if err != nil {
 		return nil, err
 	}
-	uploaderWriter := newBufferedWriter(uploader, hardcodedS3ChunkSize, NoCompression)
+	uploaderWriter, err := newBufferedWriter(uploader, rs.upload.PartSize, NoCompression)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	return uploaderWriter, nil
 }
 
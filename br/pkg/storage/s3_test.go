@@ -6,12 +6,15 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/md5" // #nosec G501
+	"encoding/base64"
 	"fmt"
 	"io"
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"sync"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -1141,3 +1144,62 @@ func TestSendCreds(t *testing.T) {
 	sentSecretAccessKey = backend.GetS3().SecretAccessKey
 	require.Equal(t, "", sentSecretAccessKey)
 }
+
+func TestS3UploaderRetryPart(t *testing.T) {
+	s := createS3Suite(t)
+	ctx := aws.BackgroundContext()
+
+	s.s3.EXPECT().
+		CreateMultipartUploadWithContext(ctx, gomock.Any()).
+		Return(&s3.CreateMultipartUploadOutput{
+			Bucket:   aws.String("bucket"),
+			Key:      aws.String("prefix/file"),
+			UploadId: aws.String("upload"),
+		}, nil)
+	var (
+		mu       sync.Mutex
+		attempts = make(map[int64]int)
+	)
+	s.s3.EXPECT().
+		UploadPartWithContext(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, input *s3.UploadPartInput, opt ...request.Option) (*s3.UploadPartOutput, error) {
+			body, err := io.ReadAll(input.Body)
+			require.NoError(t, err)
+			sum := md5.Sum(body)
+			require.Equal(t, base64.StdEncoding.EncodeToString(sum[:]), aws.StringValue(input.ContentMD5))
+
+			mu.Lock()
+			defer mu.Unlock()
+			part := aws.Int64Value(input.PartNumber)
+			attempts[part]++
+			// the second part fails once, and it's retried alone.
+			if part == 2 && attempts[part] == 1 {
+				return nil, awserr.New("RequestTimeout", "timeout", nil)
+			}
+			return &s3.UploadPartOutput{ETag: aws.String(fmt.Sprintf("etag-%s", body))}, nil
+		}).
+		Times(4)
+	s.s3.EXPECT().
+		CompleteMultipartUploadWithContext(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, input *s3.CompleteMultipartUploadInput, opt ...request.Option) (*s3.CompleteMultipartUploadOutput, error) {
+			parts := input.MultipartUpload.Parts
+			require.Len(t, parts, 3)
+			for i, part := range parts {
+				require.Equal(t, int64(i+1), aws.Int64Value(part.PartNumber))
+				require.Equal(t, fmt.Sprintf("etag-%d", i+1), aws.StringValue(part.ETag))
+			}
+			return &s3.CompleteMultipartUploadOutput{}, nil
+		})
+
+	uploader, err := s.storage.CreateUploader(ctx, "file")
+	require.NoError(t, err)
+	data := make([]byte, 1)
+	for i := 1; i <= 3; i++ {
+		// the data is reused like the buffered writer.
+		data[0] = byte('0' + i)
+		_, err = uploader.Write(ctx, data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, uploader.Close(ctx))
+	require.Equal(t, 2, attempts[2])
+}
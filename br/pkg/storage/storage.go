@@ -6,6 +6,7 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/pingcap/errors"
 	backuppb "github.com/pingcap/kvproto/pkg/brpb"
@@ -36,7 +37,16 @@ type WalkOption struct {
 	// it can save lots of time when we want find specify prefix objects in storage.
 	// For example. we have 10000 <Hash>.sst files and 10 backupmeta.(\d+) files.
 	// we can use ObjPrefix = "backupmeta" to retrieve all meta files quickly.
+	// The prefix is relative to the SubDir.
 	ObjPrefix string
+	// ObjSuffix filters the files by the suffix of their paths, e.g. ".meta".
+	// No storage supports it at server side, but the files filtered out never
+	// reach the callback.
+	ObjSuffix string
+	// StartAfter skips the files whose paths aren't after it in lexicographical
+	// order, so a listing can be resumed from the last file of the previous page.
+	// The cloud storages skip them at server side.
+	StartAfter string
 	// ListCount is the number of entries per page.
 	//
 	// In cloud storages such as S3 and GCS, the files listed and sent in pages.
@@ -51,6 +61,27 @@ type WalkOption struct {
 	ListCount int64
 }
 
+// pathPrefix returns the prefix of the paths of the files to walk.
+func (opt *WalkOption) pathPrefix() string {
+	subDir := strings.Trim(opt.SubDir, "/")
+	if len(subDir) == 0 {
+		return opt.ObjPrefix
+	}
+	return subDir + "/" + opt.ObjPrefix
+}
+
+// match checks whether the file should be walked, for the storages can't
+// filter the files at server side.
+func (opt *WalkOption) match(path string) bool {
+	return strings.HasPrefix(path, opt.pathPrefix()) && opt.filter(path)
+}
+
+// filter checks the suffix and the start of the file, for the storages filter
+// the files by the prefix at server side.
+func (opt *WalkOption) filter(path string) bool {
+	return strings.HasSuffix(path, opt.ObjSuffix) && (len(opt.StartAfter) == 0 || path > opt.StartAfter)
+}
+
 // ReadSeekCloser is the interface that groups the basic Read, Seek and Close methods.
 type ReadSeekCloser interface {
 	io.Reader
@@ -135,6 +166,26 @@ type ExternalStorageOptions struct {
 	// CheckPermissions check the given permission in New() function.
 	// make sure we can access the storage correctly before execute tasks.
 	CheckPermissions []Permission
+
+	// AzureSASTokenProvider provides the SAS token to access the azure blob
+	// storage, it's used prior to the credentials in the environment.
+	AzureSASTokenProvider AzureSASTokenProvider
+
+	// AzureImmutabilityPolicy is set to the blobs written to the azure blob
+	// storage if it isn't nil.
+	AzureImmutabilityPolicy *AzureImmutabilityPolicy
+
+	// HDFSOptions is used to access the HDFS by WebHDFS, since the options
+	// can't be expressed by the storage backend.
+	HDFSOptions *HDFSBackendOptions
+
+	// S3Upload tunes the multipart upload of the s3 storage, since the options
+	// can't be expressed by the storage backend.
+	S3Upload *S3UploadOptions
+
+	// Checksum writes the checksums of the files in the sidecar files, and
+	// verifies the files by them on reading.
+	Checksum bool
 }
 
 // Create creates ExternalStorage.
@@ -149,6 +200,14 @@ func Create(ctx context.Context, backend *backuppb.StorageBackend, sendCreds boo
 
 // New creates an ExternalStorage with options.
 func New(ctx context.Context, backend *backuppb.StorageBackend, opts *ExternalStorageOptions) (ExternalStorage, error) {
+	s, err := newStorage(ctx, backend, opts)
+	if err != nil || opts == nil || !opts.Checksum {
+		return s, err
+	}
+	return WithChecksum(s), nil
+}
+
+func newStorage(ctx context.Context, backend *backuppb.StorageBackend, opts *ExternalStorageOptions) (ExternalStorage, error) {
 	switch backend := backend.Backend.(type) {
 	case *backuppb.StorageBackend_Local:
 		if backend.Local == nil {
@@ -159,6 +218,9 @@ func New(ctx context.Context, backend *backuppb.StorageBackend, opts *ExternalSt
 		if backend.Hdfs == nil {
 			return nil, errors.Annotate(berrors.ErrStorageInvalidConfig, "hdfs config not found")
 		}
+		if IsWebHDFS(backend.Hdfs.Remote) {
+			return newWebHDFSStorage(backend.Hdfs.Remote, opts)
+		}
 		return NewHDFSStorage(backend.Hdfs.Remote), nil
 	case *backuppb.StorageBackend_S3:
 		if backend.S3 == nil {
@@ -0,0 +1,611 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/jcmturner/gokrb5/v8/client"
+	krb5config "github.com/jcmturner/gokrb5/v8/config"
+	"github.com/jcmturner/gokrb5/v8/credentials"
+	"github.com/jcmturner/gokrb5/v8/keytab"
+	"github.com/jcmturner/gokrb5/v8/spnego"
+	"github.com/pingcap/errors"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+const (
+	hdfsUserOption              = "hdfs.user"
+	hdfsKerberosPrincipalOption = "hdfs.kerberos-principal"
+	hdfsKerberosKeytabOption    = "hdfs.kerberos-keytab"
+	hdfsKrb5ConfOption          = "hdfs.krb5-conf"
+
+	webhdfsPathPrefix    = "/webhdfs/v1"
+	webhdfsMaxRedirects  = 5
+	defaultKrb5Conf      = "/etc/krb5.conf"
+	webhdfsFileNotFound  = "FileNotFoundException"
+	webhdfsTypeDirectory = "DIRECTORY"
+)
+
+// HDFSBackendOptions contains options for the WebHDFS storage.
+type HDFSBackendOptions struct {
+	// User is the user to access HDFS without Kerberos, $HADOOP_USER_NAME by default.
+	User string `json:"user" toml:"user"`
+	// KerberosPrincipal enables the Kerberos authentication, the ticket is got by
+	// the keytab if it's specified, otherwise from the ticket cache in $KRB5CCNAME.
+	KerberosPrincipal string `json:"kerberos-principal" toml:"kerberos-principal"`
+	KerberosKeytab    string `json:"kerberos-keytab" toml:"kerberos-keytab"`
+	Krb5Conf          string `json:"krb5-conf" toml:"krb5-conf"`
+}
+
+func defineHDFSFlags(flags *pflag.FlagSet) {
+	flags.String(hdfsUserOption, "", "(experimental) Set the user to access webhdfs without Kerberos, "+
+		"$HADOOP_USER_NAME by default")
+	flags.String(hdfsKerberosPrincipalOption, "", "(experimental) Set the Kerberos principal to access webhdfs, "+
+		"e.g. br/host@EXAMPLE.COM")
+	flags.String(hdfsKerberosKeytabOption, "", "(experimental) Set the keytab of the Kerberos principal, "+
+		"the ticket cache in $KRB5CCNAME is used if it's empty")
+	flags.String(hdfsKrb5ConfOption, "", "(experimental) Set the Kerberos configuration, "+
+		"$KRB5_CONFIG or /etc/krb5.conf by default")
+}
+
+func hiddenHDFSFlags(flags *pflag.FlagSet) {
+	_ = flags.MarkHidden(hdfsUserOption)
+	_ = flags.MarkHidden(hdfsKerberosPrincipalOption)
+	_ = flags.MarkHidden(hdfsKerberosKeytabOption)
+	_ = flags.MarkHidden(hdfsKrb5ConfOption)
+}
+
+func (options *HDFSBackendOptions) parseFromFlags(flags *pflag.FlagSet) error {
+	var err error
+	options.User, err = flags.GetString(hdfsUserOption)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	options.KerberosPrincipal, err = flags.GetString(hdfsKerberosPrincipalOption)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	options.KerberosKeytab, err = flags.GetString(hdfsKerberosKeytabOption)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	options.Krb5Conf, err = flags.GetString(hdfsKrb5ConfOption)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+func (options *HDFSBackendOptions) kerberosClient() (*client.Client, error) {
+	confPath := options.Krb5Conf
+	if len(confPath) == 0 {
+		confPath = os.Getenv("KRB5_CONFIG")
+	}
+	if len(confPath) == 0 {
+		confPath = defaultKrb5Conf
+	}
+	conf, err := krb5config.Load(confPath)
+	if err != nil {
+		return nil, errors.Annotatef(berrors.ErrStorageInvalidConfig, "failed to load the Kerberos configuration %s: %v", confPath, err)
+	}
+
+	if len(options.KerberosKeytab) == 0 {
+		// the principal is got from the ticket cache.
+		ccachePath := strings.TrimPrefix(os.Getenv("KRB5CCNAME"), "FILE:")
+		if len(ccachePath) == 0 {
+			ccachePath = fmt.Sprintf("/tmp/krb5cc_%d", os.Getuid())
+		}
+		ccache, err := credentials.LoadCCache(ccachePath)
+		if err != nil {
+			return nil, errors.Annotatef(berrors.ErrStorageInvalidConfig, "failed to load the Kerberos ticket cache %s: %v", ccachePath, err)
+		}
+		cl, err := client.NewFromCCache(ccache, conf, client.DisablePAFXFAST(true))
+		if err != nil {
+			return nil, errors.Annotatef(berrors.ErrStorageInvalidConfig, "invalid Kerberos ticket cache %s: %v", ccachePath, err)
+		}
+		return cl, nil
+	}
+
+	if len(options.KerberosPrincipal) == 0 {
+		return nil, errors.Annotate(berrors.ErrStorageInvalidConfig, "the Kerberos principal of the keytab is required")
+	}
+	username, realm := options.KerberosPrincipal, conf.LibDefaults.DefaultRealm
+	if i := strings.LastIndex(username, "@"); i >= 0 {
+		username, realm = username[:i], username[i+1:]
+	}
+	kt, err := keytab.Load(options.KerberosKeytab)
+	if err != nil {
+		return nil, errors.Annotatef(berrors.ErrStorageInvalidConfig, "failed to load the keytab %s: %v", options.KerberosKeytab, err)
+	}
+	cl := client.NewWithKeytab(username, realm, kt, conf, client.DisablePAFXFAST(true))
+	if err := cl.Login(); err != nil {
+		return nil, errors.Annotatef(err, "failed to login Kerberos as %s", options.KerberosPrincipal)
+	}
+	return cl, nil
+}
+
+// IsWebHDFS checks whether the HDFS remote is accessed by the WebHDFS REST API.
+func IsWebHDFS(remote string) bool {
+	return strings.HasPrefix(remote, "webhdfs://") || strings.HasPrefix(remote, "swebhdfs://")
+}
+
+// WebHDFSStorage represents the HDFS storage accessed by the WebHDFS REST API
+// of the namenode, which needs no hadoop client installed. The remote is like
+// `webhdfs://namenode:9870/path`, or `swebhdfs://` for HTTPS.
+//
+// The remote is sent to TiKV as the HDFS one, TiKV accesses it by the hadoop
+// client, which supports webhdfs:// too.
+type WebHDFSStorage struct {
+	remote   string
+	endpoint string
+	base     string
+	user     string
+
+	cli  *http.Client
+	krb5 *client.Client
+}
+
+func newWebHDFSStorage(remote string, opts *ExternalStorageOptions) (*WebHDFSStorage, error) {
+	u, err := url.Parse(remote)
+	if err != nil {
+		return nil, errors.Annotatef(berrors.ErrStorageInvalidConfig, "invalid webhdfs remote %s", remote)
+	}
+	if len(u.Host) == 0 {
+		return nil, errors.Annotatef(berrors.ErrStorageInvalidConfig, "please specify the namenode for webhdfs in %s", remote)
+	}
+	scheme := "http"
+	if u.Scheme == "swebhdfs" {
+		scheme = "https"
+	}
+
+	var options HDFSBackendOptions
+	cli := http.Client{}
+	if opts != nil {
+		if opts.HDFSOptions != nil {
+			options = *opts.HDFSOptions
+		}
+		if opts.HTTPClient != nil {
+			cli = *opts.HTTPClient
+		}
+	}
+	// the redirections are followed by WebHDFSStorage, so the data is only sent to the datanodes.
+	cli.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	// the authentication cookie `hadoop.auth` is kept, so the following
+	// requests don't need to authenticate again.
+	if cli.Jar == nil {
+		cli.Jar, err = cookiejar.New(nil)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
+	s := &WebHDFSStorage{
+		remote:   remote,
+		endpoint: fmt.Sprintf("%s://%s%s", scheme, u.Host, webhdfsPathPrefix),
+		base:     path.Join("/", u.Path),
+		cli:      &cli,
+	}
+	if len(options.KerberosPrincipal) > 0 || len(options.KerberosKeytab) > 0 {
+		s.krb5, err = options.kerberosClient()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	} else {
+		s.user = options.User
+		if len(s.user) == 0 {
+			s.user = os.Getenv("HADOOP_USER_NAME")
+		}
+	}
+	return s, nil
+}
+
+type webhdfsRemoteException struct {
+	RemoteException struct {
+		Exception string `json:"exception"`
+		Message   string `json:"message"`
+	} `json:"RemoteException"`
+}
+
+type webhdfsFileStatus struct {
+	PathSuffix string `json:"pathSuffix"`
+	Type       string `json:"type"`
+	Length     int64  `json:"length"`
+}
+
+type webhdfsError struct {
+	status    int
+	exception string
+	message   string
+}
+
+func (e *webhdfsError) Error() string {
+	return fmt.Sprintf("webhdfs responds %d %s: %s", e.status, e.exception, e.message)
+}
+
+func isWebHDFSNotFound(err error) bool {
+	e, ok := errors.Cause(err).(*webhdfsError)
+	return ok && (e.status == http.StatusNotFound || e.exception == webhdfsFileNotFound)
+}
+
+func (s *WebHDFSStorage) absPath(name string) string {
+	return path.Join(s.base, name)
+}
+
+// do sends the request of the operation on the file, and follows the
+// redirection to the datanode, to which the data is sent.
+func (s *WebHDFSStorage) do(
+	ctx context.Context,
+	method, op, name string,
+	params url.Values,
+	data []byte,
+	expectedStatus int,
+) (*http.Response, error) {
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("op", op)
+	if len(s.user) > 0 {
+		params.Set("user.name", s.user)
+	}
+	target := s.endpoint + (&url.URL{Path: s.absPath(name)}).EscapedPath() + "?" + params.Encode()
+
+	redirected := false
+	for i := 0; i <= webhdfsMaxRedirects; i++ {
+		var body io.Reader
+		if redirected && data != nil {
+			body = bytes.NewReader(data)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, target, body)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if body != nil {
+			req.Header.Set("Content-Type", "application/octet-stream")
+		}
+		// the request redirected to the datanode carries the delegation token,
+		// so it needs no authentication, the others are authenticated again.
+		if s.krb5 != nil && !hasDelegationToken(req.URL) {
+			if err := spnego.SetSPNEGOHeader(s.krb5, req, ""); err != nil {
+				return nil, errors.Annotate(err, "failed to authenticate to webhdfs by Kerberos")
+			}
+		}
+		resp, err := s.cli.Do(req)
+		if err != nil {
+			return nil, errors.Annotatef(err, "failed to %s webhdfs file %s", op, s.absPath(name))
+		}
+		if resp.StatusCode == http.StatusTemporaryRedirect {
+			target = resp.Header.Get("Location")
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			redirected = true
+			continue
+		}
+		if resp.StatusCode != expectedStatus || (data != nil && !redirected) {
+			defer resp.Body.Close()
+			return nil, errors.Annotatef(readWebHDFSError(resp), "failed to %s webhdfs file %s", op, s.absPath(name))
+		}
+		return resp, nil
+	}
+	return nil, errors.Annotatef(berrors.ErrStorageUnknown, "failed to %s webhdfs file %s: too many redirections", op, s.absPath(name))
+}
+
+func hasDelegationToken(u *url.URL) bool {
+	return len(u.Query().Get("delegation")) > 0
+}
+
+func readWebHDFSError(resp *http.Response) error {
+	e := &webhdfsError{status: resp.StatusCode}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var remoteErr webhdfsRemoteException
+	if err := json.Unmarshal(body, &remoteErr); err == nil && len(remoteErr.RemoteException.Exception) > 0 {
+		e.exception = remoteErr.RemoteException.Exception
+		e.message = remoteErr.RemoteException.Message
+	} else {
+		e.message = string(body)
+	}
+	return errors.Trace(e)
+}
+
+func (s *WebHDFSStorage) doJSON(
+	ctx context.Context,
+	method, op, name string,
+	params url.Values,
+	result interface{},
+) error {
+	resp, err := s.do(ctx, method, op, name, params, nil, http.StatusOK)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return errors.Annotatef(err, "invalid response of webhdfs %s", op)
+	}
+	return nil
+}
+
+func (s *WebHDFSStorage) create(ctx context.Context, name string, data []byte) error {
+	params := url.Values{"overwrite": []string{"true"}}
+	resp, err := s.do(ctx, http.MethodPut, "CREATE", name, params, data, http.StatusCreated)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (s *WebHDFSStorage) append(ctx context.Context, name string, data []byte) error {
+	resp, err := s.do(ctx, http.MethodPost, "APPEND", name, nil, data, http.StatusOK)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (s *WebHDFSStorage) fileStatus(ctx context.Context, name string) (*webhdfsFileStatus, error) {
+	var result struct {
+		FileStatus webhdfsFileStatus `json:"FileStatus"`
+	}
+	if err := s.doJSON(ctx, http.MethodGet, "GETFILESTATUS", name, nil, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &result.FileStatus, nil
+}
+
+// WriteFile writes a complete file to storage, similar to os.WriteFile
+func (s *WebHDFSStorage) WriteFile(ctx context.Context, name string, data []byte) error {
+	if data == nil {
+		data = []byte{}
+	}
+	return s.create(ctx, name, data)
+}
+
+// ReadFile reads a complete file from storage, similar to os.ReadFile
+func (s *WebHDFSStorage) ReadFile(ctx context.Context, name string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, "OPEN", name, nil, nil, http.StatusOK)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Annotatef(err, "failed to read webhdfs file %s", s.absPath(name))
+	}
+	return data, nil
+}
+
+// FileExists return true if file exists
+func (s *WebHDFSStorage) FileExists(ctx context.Context, name string) (bool, error) {
+	status, err := s.fileStatus(ctx, name)
+	if isWebHDFSNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return status.Type != webhdfsTypeDirectory, nil
+}
+
+// DeleteFile delete the file in storage
+func (s *WebHDFSStorage) DeleteFile(ctx context.Context, name string) error {
+	var result struct {
+		Boolean bool `json:"boolean"`
+	}
+	// the file not existing isn't an error, like the other storages.
+	return errors.Trace(s.doJSON(ctx, http.MethodDelete, "DELETE", name, nil, &result))
+}
+
+// Open a Reader by file path. path is relative path to storage base path
+func (s *WebHDFSStorage) Open(ctx context.Context, name string) (ExternalFileReader, error) {
+	status, err := s.fileStatus(ctx, name)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &webhdfsFileReader{
+		storage: s,
+		name:    name,
+		size:    status.Length,
+		ctx:     ctx,
+	}, nil
+}
+
+// WalkDir traverse all the files in a dir.
+//
+// fn is the function called for each regular file visited by WalkDir.
+// The argument `path` is the file path that can be used in `Open`
+// function; the argument `size` is the size in byte of the file determined
+// by path.
+func (s *WebHDFSStorage) WalkDir(ctx context.Context, opt *WalkOption, fn func(path string, size int64) error) error {
+	if opt == nil {
+		opt = &WalkOption{}
+	}
+	err := s.walk(ctx, opt.SubDir, opt, fn)
+	if isWebHDFSNotFound(err) {
+		// if path not exists, we should return nil to continue.
+		return nil
+	}
+	return errors.Trace(err)
+}
+
+func (s *WebHDFSStorage) walk(ctx context.Context, dir string, opt *WalkOption, fn func(path string, size int64) error) error {
+	prefix := opt.pathPrefix()
+	var result struct {
+		FileStatuses struct {
+			FileStatus []webhdfsFileStatus `json:"FileStatus"`
+		} `json:"FileStatuses"`
+	}
+	if err := s.doJSON(ctx, http.MethodGet, "LISTSTATUS", dir, nil, &result); err != nil {
+		return errors.Trace(err)
+	}
+	for _, status := range result.FileStatuses.FileStatus {
+		name := path.Join(dir, status.PathSuffix)
+		if status.Type == webhdfsTypeDirectory {
+			// skip the directories can't contain the files with the prefix.
+			if !strings.HasPrefix(name, prefix) && !strings.HasPrefix(prefix, name+"/") {
+				continue
+			}
+			if err := s.walk(ctx, name, opt, fn); err != nil {
+				return errors.Trace(err)
+			}
+			continue
+		}
+		if !opt.match(name) {
+			continue
+		}
+		if err := fn(name, status.Length); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// URI returns the base path as a URI
+func (s *WebHDFSStorage) URI() string {
+	return s.remote
+}
+
+// Create opens a file writer by path. path is relative path to storage base path
+func (s *WebHDFSStorage) Create(_ context.Context, name string) (ExternalFileWriter, error) {
+	uploader := &webhdfsUploader{
+		storage: s,
+		name:    name,
+	}
+	uploaderWriter, err := newBufferedWriter(uploader, hardcodedS3ChunkSize, NoCompression)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return uploaderWriter, nil
+}
+
+// Rename a file name from oldFileName to newFileName.
+func (s *WebHDFSStorage) Rename(ctx context.Context, oldFileName, newFileName string) error {
+	// the rename of webhdfs fails if the destination exists.
+	if err := s.DeleteFile(ctx, newFileName); err != nil {
+		return errors.Trace(err)
+	}
+	var result struct {
+		Boolean bool `json:"boolean"`
+	}
+	params := url.Values{"destination": []string{s.absPath(newFileName)}}
+	if err := s.doJSON(ctx, http.MethodPut, "RENAME", oldFileName, params, &result); err != nil {
+		return errors.Trace(err)
+	}
+	if !result.Boolean {
+		return errors.Annotatef(berrors.ErrStorageUnknown, "failed to rename webhdfs file %s to %s",
+			s.absPath(oldFileName), s.absPath(newFileName))
+	}
+	return nil
+}
+
+// webhdfsUploader creates the file by the first chunk, and appends the rest
+// chunks to it.
+type webhdfsUploader struct {
+	storage *WebHDFSStorage
+	name    string
+	created bool
+}
+
+func (u *webhdfsUploader) Write(ctx context.Context, data []byte) (int, error) {
+	if !u.created {
+		if err := u.storage.create(ctx, u.name, data); err != nil {
+			return 0, errors.Trace(err)
+		}
+		u.created = true
+		return len(data), nil
+	}
+	if err := u.storage.append(ctx, u.name, data); err != nil {
+		return 0, errors.Trace(err)
+	}
+	return len(data), nil
+}
+
+func (u *webhdfsUploader) Close(ctx context.Context) error {
+	if u.created {
+		return nil
+	}
+	// create the empty file.
+	return errors.Trace(u.storage.create(ctx, u.name, []byte{}))
+}
+
+// webhdfsFileReader reads the file by the range from the current position.
+type webhdfsFileReader struct {
+	storage *WebHDFSStorage
+	name    string
+	size    int64
+	pos     int64
+	reader  io.ReadCloser
+	ctx     context.Context
+}
+
+// Read implement the io.Reader interface.
+func (r *webhdfsFileReader) Read(p []byte) (int, error) {
+	if r.pos >= r.size {
+		return 0, io.EOF
+	}
+	if r.reader == nil {
+		params := url.Values{"offset": []string{strconv.FormatInt(r.pos, 10)}}
+		resp, err := r.storage.do(r.ctx, http.MethodGet, "OPEN", r.name, params, nil, http.StatusOK)
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		r.reader = resp.Body
+	}
+	n, err := r.reader.Read(p)
+	r.pos += int64(n)
+	return n, err
+}
+
+// Close implement the io.Closer interface.
+func (r *webhdfsFileReader) Close() error {
+	if r.reader == nil {
+		return nil
+	}
+	err := r.reader.Close()
+	r.reader = nil
+	return errors.Trace(err)
+}
+
+// Seek implement the io.Seeker interface.
+func (r *webhdfsFileReader) Seek(offset int64, whence int) (int64, error) {
+	var realOffset int64
+	switch whence {
+	case io.SeekStart:
+		realOffset = offset
+	case io.SeekCurrent:
+		realOffset = r.pos + offset
+	case io.SeekEnd:
+		realOffset = r.size + offset
+	default:
+		return 0, errors.Annotatef(berrors.ErrStorageUnknown, "Seek: invalid whence '%d'", whence)
+	}
+	if realOffset < 0 {
+		return 0, errors.Annotatef(berrors.ErrInvalidArgument, "Seek: offset '%v' out of range.", realOffset)
+	}
+
+	if realOffset == r.pos {
+		return realOffset, nil
+	}
+	if err := r.Close(); err != nil {
+		return 0, errors.Trace(err)
+	}
+	r.pos = realOffset
+	return realOffset, nil
+}
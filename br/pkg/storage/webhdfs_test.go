@@ -0,0 +1,238 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeWebHDFS serves the WebHDFS REST API, the data of the files are
+// redirected to the "datanode" path like the real namenode.
+type fakeWebHDFS struct {
+	mu    sync.Mutex
+	files map[string][]byte
+	user  string
+}
+
+func (f *fakeWebHDFS) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	query := req.URL.Query()
+	if query.Get("user.name") != f.user {
+		http.Error(w, "unexpected user", http.StatusUnauthorized)
+		return
+	}
+	name := strings.TrimPrefix(req.URL.Path, webhdfsPathPrefix)
+	datanode := query.Get("datanode") == "true"
+	redirect := func() {
+		query.Set("datanode", "true")
+		w.Header().Set("Location", fmt.Sprintf("http://%s%s?%s", req.Host, req.URL.Path, query.Encode()))
+		w.WriteHeader(http.StatusTemporaryRedirect)
+	}
+	notFound := func() {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"RemoteException":{"exception":"%s","message":"%s"}}`, webhdfsFileNotFound, name)
+	}
+
+	switch query.Get("op") {
+	case "CREATE":
+		if !datanode {
+			redirect()
+			return
+		}
+		data, _ := io.ReadAll(req.Body)
+		f.files[name] = data
+		w.WriteHeader(http.StatusCreated)
+	case "APPEND":
+		if !datanode {
+			redirect()
+			return
+		}
+		data, _ := io.ReadAll(req.Body)
+		f.files[name] = append(f.files[name], data...)
+	case "OPEN":
+		if !datanode {
+			redirect()
+			return
+		}
+		data, ok := f.files[name]
+		if !ok {
+			notFound()
+			return
+		}
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		_, _ = w.Write(data[offset:])
+	case "GETFILESTATUS":
+		data, ok := f.files[name]
+		if !ok {
+			notFound()
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"FileStatus": webhdfsFileStatus{Type: "FILE", Length: int64(len(data))},
+		})
+	case "DELETE":
+		_, ok := f.files[name]
+		delete(f.files, name)
+		_ = json.NewEncoder(w).Encode(map[string]bool{"boolean": ok})
+	case "RENAME":
+		data, ok := f.files[name]
+		if ok {
+			delete(f.files, name)
+			f.files[query.Get("destination")] = data
+		}
+		_ = json.NewEncoder(w).Encode(map[string]bool{"boolean": ok})
+	case "LISTSTATUS":
+		children := make(map[string]webhdfsFileStatus)
+		for file, data := range f.files {
+			if !strings.HasPrefix(file, name+"/") {
+				continue
+			}
+			child := strings.SplitN(strings.TrimPrefix(file, name+"/"), "/", 2)
+			status := webhdfsFileStatus{PathSuffix: child[0], Type: "FILE", Length: int64(len(data))}
+			if len(child) > 1 {
+				status = webhdfsFileStatus{PathSuffix: child[0], Type: webhdfsTypeDirectory}
+			}
+			children[child[0]] = status
+		}
+		if len(children) == 0 {
+			notFound()
+			return
+		}
+		statuses := make([]webhdfsFileStatus, 0, len(children))
+		for _, status := range children {
+			statuses = append(statuses, status)
+		}
+		sort.Slice(statuses, func(i, j int) bool { return statuses[i].PathSuffix < statuses[j].PathSuffix })
+		result := map[string]interface{}{"FileStatuses": map[string]interface{}{"FileStatus": statuses}}
+		_ = json.NewEncoder(w).Encode(result)
+	default:
+		http.Error(w, "unsupported op", http.StatusBadRequest)
+	}
+}
+
+func TestWebHDFS(t *testing.T) {
+	fake := &fakeWebHDFS{files: make(map[string][]byte), user: "br"}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	remote := "webhdfs://" + strings.TrimPrefix(server.URL, "http://") + "/backup"
+	options := &BackendOptions{}
+	backend, err := ParseBackend(remote+"?user=br", options)
+	require.NoError(t, err)
+	require.Equal(t, remote, backend.GetHdfs().GetRemote())
+	require.Equal(t, "br", options.HDFS.User)
+
+	ctx := context.Background()
+	s, err := New(ctx, backend, &ExternalStorageOptions{HDFSOptions: &options.HDFS})
+	require.NoError(t, err)
+	_, ok := s.(*WebHDFSStorage)
+	require.True(t, ok)
+	require.Equal(t, remote, s.URI())
+
+	require.NoError(t, s.WriteFile(ctx, "backupmeta", []byte("meta")))
+	require.NoError(t, s.WriteFile(ctx, "1/a.sst", []byte("0123456789")))
+	require.NoError(t, s.WriteFile(ctx, "2/b.sst", []byte("ab")))
+	require.Equal(t, []byte("meta"), fake.files["/backup/backupmeta"])
+	data, err := s.ReadFile(ctx, "1/a.sst")
+	require.NoError(t, err)
+	require.Equal(t, []byte("0123456789"), data)
+
+	exists, err := s.FileExists(ctx, "backupmeta")
+	require.NoError(t, err)
+	require.True(t, exists)
+	exists, err = s.FileExists(ctx, "not-exist")
+	require.NoError(t, err)
+	require.False(t, exists)
+	_, err = s.ReadFile(ctx, "not-exist")
+	require.Error(t, err)
+
+	walk := func(opt *WalkOption) map[string]int64 {
+		files := make(map[string]int64)
+		require.NoError(t, s.WalkDir(ctx, opt, func(name string, size int64) error {
+			files[name] = size
+			return nil
+		}))
+		return files
+	}
+	require.Equal(t, map[string]int64{"backupmeta": 4, "1/a.sst": 10, "2/b.sst": 2}, walk(nil))
+	require.Equal(t, map[string]int64{"1/a.sst": 10}, walk(&WalkOption{SubDir: "1"}))
+	require.Equal(t, map[string]int64{"2/b.sst": 2}, walk(&WalkOption{ObjPrefix: "2/"}))
+	require.Empty(t, walk(&WalkOption{SubDir: "3"}))
+
+	r, err := s.Open(ctx, "1/a.sst")
+	require.NoError(t, err)
+	buf := make([]byte, 3)
+	_, err = io.ReadFull(r, buf)
+	require.NoError(t, err)
+	require.Equal(t, "012", string(buf))
+	pos, err := r.Seek(-2, io.SeekEnd)
+	require.NoError(t, err)
+	require.Equal(t, int64(8), pos)
+	rest, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, "89", string(rest))
+	require.NoError(t, r.Close())
+
+	w, err := s.Create(ctx, "log")
+	require.NoError(t, err)
+	_, err = w.Write(ctx, []byte("hello "))
+	require.NoError(t, err)
+	require.NoError(t, w.Close(ctx))
+	require.Equal(t, []byte("hello "), fake.files["/backup/log"])
+	uploader := &webhdfsUploader{storage: s.(*WebHDFSStorage), name: "log"}
+	_, err = uploader.Write(ctx, []byte("a"))
+	require.NoError(t, err)
+	_, err = uploader.Write(ctx, []byte("b"))
+	require.NoError(t, err)
+	require.NoError(t, uploader.Close(ctx))
+	require.Equal(t, []byte("ab"), fake.files["/backup/log"])
+
+	require.NoError(t, s.Rename(ctx, "log", "2/b.sst"))
+	require.Equal(t, []byte("ab"), fake.files["/backup/2/b.sst"])
+	_, ok = fake.files["/backup/log"]
+	require.False(t, ok)
+	require.NoError(t, s.DeleteFile(ctx, "backupmeta"))
+	require.NoError(t, s.DeleteFile(ctx, "backupmeta"))
+	require.Equal(t, map[string]int64{"1/a.sst": 10, "2/b.sst": 2}, walk(nil))
+}
+
+func TestWebHDFSAuthCookie(t *testing.T) {
+	fake := &fakeWebHDFS{files: make(map[string][]byte), user: "br"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		// the redirected requests are only authenticated by the cookie.
+		if req.URL.Query().Get("datanode") == "true" {
+			if c, err := req.Cookie("hadoop.auth"); err != nil || c.Value != "token" {
+				http.Error(w, "unauthenticated", http.StatusUnauthorized)
+				return
+			}
+		} else {
+			http.SetCookie(w, &http.Cookie{Name: "hadoop.auth", Value: "token", Path: "/"})
+		}
+		fake.ServeHTTP(w, req)
+	}))
+	defer server.Close()
+
+	remote := "webhdfs://" + strings.TrimPrefix(server.URL, "http://") + "/backup"
+	s, err := newWebHDFSStorage(remote, &ExternalStorageOptions{HDFSOptions: &HDFSBackendOptions{User: "br"}})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, s.WriteFile(ctx, "backupmeta", []byte("meta")))
+	data, err := s.ReadFile(ctx, "backupmeta")
+	require.NoError(t, err)
+	require.Equal(t, []byte("meta"), data)
+}
@@ -6,6 +6,9 @@ import (
 	"context"
 	"io"
 
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4"
 	"github.com/pingcap/errors"
 )
 
@@ -17,8 +20,22 @@ const (
 	NoCompression CompressType = iota
 	// Gzip will compress given bytes in gzip format.
 	Gzip
+	// Snappy will compress given bytes in snappy format.
+	Snappy
+	// Zstd will compress given bytes in zstd format.
+	Zstd
+	// LZ4 will compress given bytes in lz4 format.
+	LZ4
 )
 
+// DecompressConfig is the config used for decompression.
+type DecompressConfig struct {
+	// ZStdDecodeConcurrency is the number of goroutines used to decompress a
+	// zstd file concurrently, the default concurrency of the zstd decoder is
+	// used if it's not positive.
+	ZStdDecodeConcurrency int
+}
+
 type flusher interface {
 	Flush() error
 }
@@ -39,26 +56,50 @@ type interceptBuffer interface {
 	Compressed() bool
 }
 
-func newInterceptBuffer(chunkSize int, compressType CompressType) interceptBuffer {
+func newInterceptBuffer(chunkSize int, compressType CompressType) (interceptBuffer, error) {
 	if compressType == NoCompression {
-		return newNoCompressionBuffer(chunkSize)
+		return newNoCompressionBuffer(chunkSize), nil
 	}
 	return newSimpleCompressBuffer(chunkSize, compressType)
 }
 
-func newCompressWriter(compressType CompressType, w io.Writer) simpleCompressWriter {
+func newCompressWriter(compressType CompressType, w io.Writer) (simpleCompressWriter, error) {
 	switch compressType {
 	case Gzip:
-		return gzip.NewWriter(w)
+		return gzip.NewWriter(w), nil
+	case Snappy:
+		return snappy.NewBufferedWriter(w), nil
+	case Zstd:
+		newWriter, err := zstd.NewWriter(w)
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to create the zstd writer")
+		}
+		return newWriter, nil
+	case LZ4:
+		return lz4.NewWriter(w), nil
 	default:
-		return nil
+		return nil, errors.Errorf("unsupported compress type %d", compressType)
 	}
 }
 
-func newCompressReader(compressType CompressType, r io.Reader) (io.ReadCloser, error) {
+func newCompressReader(compressType CompressType, cfg DecompressConfig, r io.Reader) (io.ReadCloser, error) {
 	switch compressType {
 	case Gzip:
 		return gzip.NewReader(r)
+	case Snappy:
+		return io.NopCloser(snappy.NewReader(r)), nil
+	case Zstd:
+		var options []zstd.DOption
+		if cfg.ZStdDecodeConcurrency > 0 {
+			options = append(options, zstd.WithDecoderConcurrency(cfg.ZStdDecodeConcurrency))
+		}
+		decoder, err := zstd.NewReader(r, options...)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return decoder.IOReadCloser(), nil
+	case LZ4:
+		return io.NopCloser(lz4.NewReader(r)), nil
 	default:
 		return nil, nil
 	}
@@ -124,13 +165,17 @@ func (*simpleCompressBuffer) Compressed() bool {
 	return true
 }
 
-func newSimpleCompressBuffer(chunkSize int, compressType CompressType) *simpleCompressBuffer {
+func newSimpleCompressBuffer(chunkSize int, compressType CompressType) (*simpleCompressBuffer, error) {
 	bf := bytes.NewBuffer(make([]byte, 0, chunkSize))
+	compressWriter, err := newCompressWriter(compressType, bf)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	return &simpleCompressBuffer{
 		Buffer:         bf,
 		cap:            chunkSize,
-		compressWriter: newCompressWriter(compressType, bf),
-	}
+		compressWriter: compressWriter,
+	}, nil
 }
 
 type bufferedWriter struct {
@@ -190,16 +235,24 @@ func (u *bufferedWriter) Close(ctx context.Context) error {
 }
 
 // NewUploaderWriter wraps the Writer interface over an uploader.
-func NewUploaderWriter(writer ExternalFileWriter, chunkSize int, compressType CompressType) ExternalFileWriter {
-	return newBufferedWriter(writer, chunkSize, compressType)
+func NewUploaderWriter(writer ExternalFileWriter, chunkSize int, compressType CompressType) (ExternalFileWriter, error) {
+	bufferedWriter, err := newBufferedWriter(writer, chunkSize, compressType)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return bufferedWriter, nil
 }
 
 // newBufferedWriter is used to build a buffered writer.
-func newBufferedWriter(writer ExternalFileWriter, chunkSize int, compressType CompressType) *bufferedWriter {
+func newBufferedWriter(writer ExternalFileWriter, chunkSize int, compressType CompressType) (*bufferedWriter, error) {
+	buf, err := newInterceptBuffer(chunkSize, compressType)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	return &bufferedWriter{
 		writer: writer,
-		buf:    newInterceptBuffer(chunkSize, compressType),
-	}
+		buf:    buf,
+	}, nil
 }
 
 // BytesWriter is a Writer implementation on top of bytes.Buffer that is useful for testing.
@@ -102,7 +102,7 @@ func TestCompressReaderWriter(t *testing.T) {
 		ctx := context.Background()
 		storage, err := Create(ctx, backend, true)
 		require.NoError(t, err)
-		storage = WithCompression(storage, Gzip)
+		storage = WithCompression(storage, test.compressType)
 		fileName := strings.ReplaceAll(test.name, " ", "-") + ".txt.gz"
 		writer, err := storage.Create(ctx, fileName)
 		require.NoError(t, err)
@@ -118,7 +118,7 @@ func TestCompressReaderWriter(t *testing.T) {
 		// make sure compressed file is written correctly
 		file, err := os.Open(filepath.Join(dir, fileName))
 		require.NoError(t, err)
-		r, err := newCompressReader(test.compressType, file)
+		r, err := newCompressReader(test.compressType, DecompressConfig{}, file)
 		require.NoError(t, err)
 		var bf bytes.Buffer
 		_, err = bf.ReadFrom(r)
@@ -135,7 +135,7 @@ func TestCompressReaderWriter(t *testing.T) {
 
 		require.Nil(t, file.Close())
 	}
-	compressTypeArr := []CompressType{Gzip}
+	compressTypeArr := []CompressType{Gzip, Snappy, Zstd, LZ4}
 	tests := []testcase{
 		{
 			name: "long text medium chunks",
@@ -167,3 +167,14 @@ func TestCompressReaderWriter(t *testing.T) {
 		}
 	}
 }
+
+func TestNewCompressWriterError(t *testing.T) {
+	_, err := newCompressWriter(CompressType(100), &bytes.Buffer{})
+	require.ErrorContains(t, err, "unsupported compress type")
+
+	ctx := context.Background()
+	s, err := NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	_, err = WithCompression(s, CompressType(100)).Create(ctx, "test")
+	require.Error(t, err)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/meta"
 	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/parser/mysql"
 	filter "github.com/pingcap/tidb/util/table-filter"
 	"go.uber.org/zap"
 )
@@ -54,10 +55,14 @@ type DBReplace struct {
 }
 
 type SchemasReplace struct {
-	DbMap                     map[OldID]*DBReplace
-	globalTableIdMap          map[OldID]NewID
-	RewriteTS                 uint64        // used to rewrite commit ts in meta kv.
-	TableFilter               filter.Filter // used to filter schema/table
+	DbMap            map[OldID]*DBReplace
+	globalTableIdMap map[OldID]NewID
+	RewriteTS        uint64        // used to rewrite commit ts in meta kv.
+	TableFilter      filter.Filter // used to filter schema/table
+	// filteredDBIDs and filteredTableIDs are the old IDs of the schemas and the tables not
+	// matched by the filter, whose meta kv entries are skipped.
+	filteredDBIDs             map[OldID]struct{}
+	filteredTableIDs          map[OldID]struct{}
 	genGenGlobalID            func(ctx context.Context) (int64, error)
 	genGenGlobalIDs           func(ctx context.Context, n int) ([]int64, error)
 	insertDeleteRangeForTable func(jobID int64, tableIDs []int64)
@@ -110,6 +115,8 @@ func NewSchemasReplace(
 		globalTableIdMap:          globalTableIdMap,
 		RewriteTS:                 restoreTS,
 		TableFilter:               tableFilter,
+		filteredDBIDs:             make(map[OldID]struct{}),
+		filteredTableIDs:          make(map[OldID]struct{}),
 		genGenGlobalID:            genID,
 		genGenGlobalIDs:           genIDs,
 		insertDeleteRangeForTable: insertDeleteRangeForTable,
@@ -117,6 +124,98 @@ func NewSchemasReplace(
 	}
 }
 
+// TableIDMap is the mapping of the IDs of a table, which is serializable.
+type TableIDMap struct {
+	NewID      NewID           `json:"new-id"`
+	Partitions map[OldID]NewID `json:"partitions,omitempty"`
+	Indexes    map[OldID]NewID `json:"indexes,omitempty"`
+}
+
+// DBIDMap is the mapping of the IDs of a schema and its tables, which is serializable.
+type DBIDMap struct {
+	NewID  NewID                 `json:"new-id"`
+	Tables map[OldID]*TableIDMap `json:"tables,omitempty"`
+}
+
+// IDMap returns the mapping from the old IDs to the new IDs of the schemas,
+// the tables, the partitions and the indexes, including the IDs allocated
+// while rewriting the meta kv entries.
+func (sr *SchemasReplace) IDMap() map[OldID]*DBIDMap {
+	idMap := make(map[OldID]*DBIDMap, len(sr.DbMap))
+	for dbID, dr := range sr.DbMap {
+		dbIDMap := &DBIDMap{NewID: dr.NewDBID, Tables: make(map[OldID]*TableIDMap, len(dr.TableMap))}
+		for tableID, tr := range dr.TableMap {
+			dbIDMap.Tables[tableID] = &TableIDMap{
+				NewID:      tr.NewTableID,
+				Partitions: tr.PartitionMap,
+				Indexes:    tr.IndexMap,
+			}
+		}
+		idMap[dbID] = dbIDMap
+	}
+	return idMap
+}
+
+// MergeIDMap merges the ID mapping returned by IDMap into the replace, the IDs
+// in the mapping take precedence. It's used to rewrite the meta kv entries to
+// the same IDs as the previous restore, which is interrupted after restoring
+// some of the entries.
+func (sr *SchemasReplace) MergeIDMap(idMap map[OldID]*DBIDMap) {
+	for dbID, dbIDMap := range idMap {
+		dr, exist := sr.DbMap[dbID]
+		if !exist {
+			dr = NewDBReplace(nil, dbIDMap.NewID)
+			sr.DbMap[dbID] = dr
+		}
+		dr.NewDBID = dbIDMap.NewID
+		for tableID, tableIDMap := range dbIDMap.Tables {
+			tr, exist := dr.TableMap[tableID]
+			if !exist {
+				tr = NewTableReplace(nil, tableIDMap.NewID)
+				dr.TableMap[tableID] = tr
+			}
+			tr.NewTableID = tableIDMap.NewID
+			sr.globalTableIdMap[tableID] = tableIDMap.NewID
+			for oldID, newID := range tableIDMap.Partitions {
+				tr.PartitionMap[oldID] = newID
+				sr.globalTableIdMap[oldID] = newID
+			}
+			for oldID, newID := range tableIDMap.Indexes {
+				tr.IndexMap[oldID] = newID
+			}
+		}
+	}
+}
+
+// matchSchema returns whether the meta kv entries of the schema are restored,
+// the system schema is always restored.
+func (sr *SchemasReplace) matchSchema(dbInfo *model.DBInfo) bool {
+	return sr.TableFilter == nil || dbInfo.Name.L == mysql.SystemDB || sr.TableFilter.MatchSchema(dbInfo.Name.O)
+}
+
+// matchTable returns whether the meta kv entries of the table are restored.
+// The table is restored if the name of its schema is unknown.
+func (sr *SchemasReplace) matchTable(dbID OldID, tableInfo *model.TableInfo) bool {
+	if _, filtered := sr.filteredDBIDs[dbID]; filtered {
+		return false
+	}
+	dbReplace, exist := sr.DbMap[dbID]
+	if sr.TableFilter == nil || !exist || dbReplace.OldDBInfo == nil || dbReplace.OldDBInfo.Name.L == mysql.SystemDB {
+		return true
+	}
+	return sr.TableFilter.MatchTable(dbReplace.OldDBInfo.Name.O, tableInfo.Name.O)
+}
+
+// isFiltered returns whether the meta kv entries of the schema or the table
+// are skipped, the tableID is 0 for the entries of the schema.
+func (sr *SchemasReplace) isFiltered(dbID, tableID OldID) bool {
+	if _, filtered := sr.filteredDBIDs[dbID]; filtered {
+		return true
+	}
+	_, filtered := sr.filteredTableIDs[tableID]
+	return tableID != 0 && filtered
+}
+
 func (sr *SchemasReplace) rewriteKeyForDB(key []byte, cf string) ([]byte, bool, error) {
 	rawMetaKey, err := ParseTxnMetaKeyFrom(key)
 	if err != nil {
@@ -127,6 +226,9 @@ func (sr *SchemasReplace) rewriteKeyForDB(key []byte, cf string) ([]byte, bool,
 	if err != nil {
 		return nil, false, errors.Trace(err)
 	}
+	if sr.isFiltered(dbID, 0) {
+		return nil, false, nil
+	}
 
 	dbReplace, exist := sr.DbMap[dbID]
 	if !exist {
@@ -150,6 +252,13 @@ func (sr *SchemasReplace) rewriteDBInfo(value []byte) ([]byte, bool, error) {
 	if err := json.Unmarshal(value, oldDBInfo); err != nil {
 		return nil, false, errors.Trace(err)
 	}
+	if !sr.matchSchema(oldDBInfo) {
+		log.Debug("skip the schema not matched by the filter", zap.String("dbName", oldDBInfo.Name.O),
+			zap.Int64("old ID", oldDBInfo.ID))
+		sr.filteredDBIDs[oldDBInfo.ID] = struct{}{}
+		return nil, false, nil
+	}
+	delete(sr.filteredDBIDs, oldDBInfo.ID)
 
 	dbReplace, exist := sr.DbMap[oldDBInfo.ID]
 	if !exist {
@@ -232,6 +341,9 @@ func (sr *SchemasReplace) rewriteKeyForTable(
 		log.Warn("parse table key failed", zap.ByteString("field", rawMetaKey.Field))
 		return nil, false, errors.Trace(err)
 	}
+	if sr.isFiltered(dbID, tableID) {
+		return nil, false, nil
+	}
 
 	dbReplace, exist := sr.DbMap[dbID]
 	if !exist {
@@ -275,6 +387,14 @@ func (sr *SchemasReplace) rewriteTableInfo(value []byte, dbID int64) ([]byte, bo
 	if err := json.Unmarshal(value, &tableInfo); err != nil {
 		return nil, false, errors.Trace(err)
 	}
+	// the table may be renamed into or out of the filter, so the latest name decides.
+	if !sr.matchTable(dbID, &tableInfo) {
+		log.Debug("skip the table not matched by the filter", zap.String("table-name", tableInfo.Name.String()),
+			zap.Int64("old ID", tableInfo.ID))
+		sr.filteredTableIDs[tableInfo.ID] = struct{}{}
+		return nil, false, nil
+	}
+	delete(sr.filteredTableIDs, tableInfo.ID)
 
 	// update table ID
 	dbReplace, exist := sr.DbMap[dbID]
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/pingcap/tidb/meta"
 	"github.com/pingcap/tidb/parser/model"
 	filter "github.com/pingcap/tidb/util/table-filter"
 	"github.com/stretchr/testify/require"
@@ -667,3 +668,107 @@ func TestDeleteRangeForMDDLJob(t *testing.T) {
 		require.Equal(t, iargs.indexIDs[0], int64(l+1))
 	}
 }
+
+func TestRewriteMetaKVWithTableFilter(t *testing.T) {
+	f, err := filter.Parse([]string{"db1.t1"})
+	require.NoError(t, err)
+	sr := MockEmptySchemasReplace(nil)
+	sr.TableFilter = f
+
+	// the schemas not matched by the filter are skipped with their tables.
+	value, err := produceDBInfoValue("db2", 2)
+	require.NoError(t, err)
+	_, needWrite, err := sr.rewriteDBInfo(value)
+	require.NoError(t, err)
+	require.False(t, needWrite)
+	require.NotContains(t, sr.DbMap, int64(2))
+	value, err = produceTableInfoValue("t1", 21)
+	require.NoError(t, err)
+	_, needWrite, err = sr.rewriteTableInfo(value, 2)
+	require.NoError(t, err)
+	require.False(t, needWrite)
+
+	// the system schema is always restored.
+	value, err = produceDBInfoValue("mysql", 3)
+	require.NoError(t, err)
+	_, needWrite, err = sr.rewriteDBInfo(value)
+	require.NoError(t, err)
+	require.True(t, needWrite)
+
+	value, err = produceDBInfoValue("db1", 1)
+	require.NoError(t, err)
+	_, needWrite, err = sr.rewriteDBInfo(value)
+	require.NoError(t, err)
+	require.True(t, needWrite)
+	value, err = produceTableInfoValue("t1", 11)
+	require.NoError(t, err)
+	_, needWrite, err = sr.rewriteTableInfo(value, 1)
+	require.NoError(t, err)
+	require.True(t, needWrite)
+	value, err = produceTableInfoValue("t2", 12)
+	require.NoError(t, err)
+	_, needWrite, err = sr.rewriteTableInfo(value, 1)
+	require.NoError(t, err)
+	require.False(t, needWrite)
+	require.Contains(t, sr.DbMap[1].TableMap, int64(11))
+	require.NotContains(t, sr.DbMap[1].TableMap, int64(12))
+
+	// the entries without the table info are skipped by the IDs.
+	autoIDKey := func(dbID, tableID int64) []byte {
+		return (&RawMetaKey{Key: meta.DBkey(dbID), Field: meta.AutoTableIDKey(tableID), Ts: 1}).EncodeMetaKey()
+	}
+	for _, c := range []struct {
+		dbID, tableID int64
+		needWrite     bool
+	}{{1, 11, true}, {1, 12, false}, {2, 21, false}} {
+		_, needWrite, err = sr.rewriteKeyForTable(autoIDKey(c.dbID, c.tableID), WriteCF, meta.ParseAutoTableIDKey, meta.AutoTableIDKey)
+		require.NoError(t, err)
+		require.Equal(t, c.needWrite, needWrite)
+	}
+
+	// the table renamed into the filter is restored since then.
+	value, err = produceTableInfoValue("t1", 12)
+	require.NoError(t, err)
+	_, needWrite, err = sr.rewriteTableInfo(value, 1)
+	require.NoError(t, err)
+	require.True(t, needWrite)
+	_, needWrite, err = sr.rewriteKeyForTable(autoIDKey(1, 12), WriteCF, meta.ParseAutoTableIDKey, meta.AutoTableIDKey)
+	require.NoError(t, err)
+	require.True(t, needWrite)
+}
+
+func TestMergeIDMap(t *testing.T) {
+	var (
+		dbID    int64 = 1
+		tableID int64 = 2
+	)
+	dbValue, err := produceDBInfoValue("db1", dbID)
+	require.NoError(t, err)
+	tableValue, err := produceTableInfoValue("t1", tableID)
+	require.NoError(t, err)
+
+	sr := MockEmptySchemasReplace(nil)
+	_, _, err = sr.rewriteDBInfo(dbValue)
+	require.NoError(t, err)
+	_, _, err = sr.rewriteTableInfo(tableValue, dbID)
+	require.NoError(t, err)
+	idMap := sr.IDMap()
+	content, err := json.Marshal(idMap)
+	require.NoError(t, err)
+
+	// the IDs allocated by the previous restore are reused.
+	var loaded map[OldID]*DBIDMap
+	require.NoError(t, json.Unmarshal(content, &loaded))
+	resumed := MockEmptySchemasReplace(nil)
+	resumed.MergeIDMap(loaded)
+	_, _, err = resumed.rewriteDBInfo(dbValue)
+	require.NoError(t, err)
+	newValue, _, err := resumed.rewriteTableInfo(tableValue, dbID)
+	require.NoError(t, err)
+	var tableInfo model.TableInfo
+	require.NoError(t, json.Unmarshal(newValue, &tableInfo))
+	require.Equal(t, sr.DbMap[dbID].TableMap[tableID].NewTableID, tableInfo.ID)
+	require.Equal(t, sr.DbMap[dbID].NewDBID, resumed.DbMap[dbID].NewDBID)
+	require.Equal(t, "db1", resumed.DbMap[dbID].OldDBInfo.Name.O)
+	require.Equal(t, idMap, resumed.IDMap())
+}
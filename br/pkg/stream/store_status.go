@@ -0,0 +1,205 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/log"
+	connutil "github.com/pingcap/tidb/br/pkg/conn/util"
+	"github.com/pingcap/tidb/br/pkg/logutil"
+	"github.com/pingcap/tidb/br/pkg/tikvmetrics"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// DefaultStatusSampleInterval is the interval between the two samples of
+	// the metrics, by which the speed of the log backup is calculated.
+	DefaultStatusSampleInterval = time.Second
+	// defaultFlushInterval is the default `log-backup.max-flush-interval` of TiKV.
+	defaultFlushInterval = 3 * time.Minute
+	// statusWorkerPoolSize is the max number of the stores or the tasks fetched
+	// concurrently.
+	statusWorkerPoolSize = 16
+)
+
+// StoreStatus is the status of the log backup on a TiKV store, which is shared
+// by all tasks.
+type StoreStatus struct {
+	StoreID uint64
+	// QPS is the number of the KVs observed by the log backup per second.
+	QPS float64
+	// BytesPerMinute is the size of the log files flushed per minute.
+	BytesPerMinute float64
+	// Errors counts the errors of the log backup by their types since the
+	// store started.
+	Errors map[string]uint64
+	// FlushInterval is the max interval of flushing the log files, the
+	// checkpoint of the store is expected to lag behind within it.
+	FlushInterval time.Duration
+}
+
+// FlushHealthy checks whether the checkpoint of the store lags behind as
+// expected, it's flushed at least once in two flush intervals.
+func (s StoreStatus) FlushHealthy(lag time.Duration) bool {
+	interval := s.FlushInterval
+	if interval == 0 {
+		interval = defaultFlushInterval
+	}
+	return lag <= 2*interval
+}
+
+// storeCounters are the counters of the log backup scraped from the status
+// API of a TiKV store.
+type storeCounters struct {
+	at           time.Time
+	kvCount      float64
+	flushedBytes float64
+	errors       map[string]uint64
+}
+
+// newStoreCounters gets the counters from the metrics of the store.
+func newStoreCounters(m *tikvmetrics.Metrics) storeCounters {
+	counters := storeCounters{
+		at:           m.At,
+		flushedBytes: m.HistogramSum("tikv_log_backup_flush_file_size").Sum,
+		errors:       make(map[string]uint64),
+	}
+	// the metrics are renamed from `stream` to `log_backup`.
+	for _, name := range []string{"tikv_stream_handle_kv_batch", "tikv_log_backup_handle_kv_batch"} {
+		counters.kvCount += m.HistogramSum(name).Sum
+	}
+	for tp, count := range m.SumBy("tikv_log_backup_errors", "type") {
+		counters.errors[tp] = uint64(count)
+	}
+	return counters
+}
+
+// parseFlushInterval parses `log-backup.max-flush-interval` from the config of TiKV.
+func parseFlushInterval(r io.Reader) (time.Duration, error) {
+	var config struct {
+		LogBackup struct {
+			MaxFlushInterval string `json:"max-flush-interval"`
+		} `json:"log-backup"`
+	}
+	if err := json.NewDecoder(r).Decode(&config); err != nil {
+		return 0, errors.Trace(err)
+	}
+	if len(config.LogBackup.MaxFlushInterval) == 0 {
+		return defaultFlushInterval, nil
+	}
+	interval, err := time.ParseDuration(config.LogBackup.MaxFlushInterval)
+	if err != nil {
+		return 0, errors.Annotatef(err, "invalid max-flush-interval %s", config.LogBackup.MaxFlushInterval)
+	}
+	return interval, nil
+}
+
+// storeStatusCollector collects the status of the log backup on the stores
+// from their status API.
+type storeStatusCollector struct {
+	scraper  *tikvmetrics.Scraper
+	interval time.Duration
+}
+
+func (c *storeStatusCollector) scrape(ctx context.Context, statusAddr string) (storeCounters, error) {
+	m, err := c.scraper.Scrape(ctx, statusAddr)
+	if err != nil {
+		return storeCounters{}, errors.Trace(err)
+	}
+	return newStoreCounters(m), nil
+}
+
+func (c *storeStatusCollector) flushInterval(ctx context.Context, statusAddr string) (time.Duration, error) {
+	resp, err := c.scraper.Get(ctx, statusAddr, "/config")
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	defer resp.Body.Close()
+	interval, err := parseFlushInterval(resp.Body)
+	return interval, errors.Annotatef(err, "invalid config of %s", statusAddr)
+}
+
+// collect samples the counters of the store twice, and calculates the speed by them.
+func (c *storeStatusCollector) collect(ctx context.Context, store *metapb.Store) (StoreStatus, error) {
+	status := StoreStatus{StoreID: store.GetId()}
+	statusAddr := store.GetStatusAddress()
+	interval, err := c.flushInterval(ctx, statusAddr)
+	if err != nil {
+		log.Warn("failed to get the flush interval of the store, use the default one",
+			zap.Uint64("store", store.GetId()), logutil.ShortError(err))
+		interval = defaultFlushInterval
+	}
+	status.FlushInterval = interval
+
+	c0, err := c.scrape(ctx, statusAddr)
+	if err != nil {
+		return status, errors.Trace(err)
+	}
+	select {
+	case <-ctx.Done():
+		return status, errors.Trace(ctx.Err())
+	case <-time.After(c.interval):
+	}
+	c1, err := c.scrape(ctx, statusAddr)
+	if err != nil {
+		return status, errors.Trace(err)
+	}
+	if elapsed := c1.at.Sub(c0.at).Seconds(); elapsed > 0 {
+		status.QPS = (c1.kvCount - c0.kvCount) / elapsed
+		status.BytesPerMinute = (c1.flushedBytes - c0.flushedBytes) / elapsed * 60
+	}
+	status.Errors = c1.errors
+	log.Info("collect the status of the store", zap.Uint64("store", store.GetId()),
+		zap.Float64("qps", status.QPS), zap.Float64("bytes-per-minute", status.BytesPerMinute),
+		zap.Duration("flush-interval", status.FlushInterval))
+	return status, nil
+}
+
+// collectStoreStatus collects the status of all TiKV stores concurrently, the
+// stores failed to be collected are skipped.
+func (ctl *StatusController) collectStoreStatus(ctx context.Context) (map[uint64]StoreStatus, error) {
+	stores, err := connutil.GetAllTiKVStores(ctx, ctl.mgr.GetPDClient(), connutil.SkipTiFlash)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	collector := &storeStatusCollector{
+		scraper:  tikvmetrics.NewScraper(ctl.mgr.GetTLSConfig()),
+		interval: ctl.sampleInterval,
+	}
+
+	var mu sync.Mutex
+	result := make(map[uint64]StoreStatus, len(stores))
+	pool := utils.NewWorkerPool(statusWorkerPoolSize, "store status")
+	eg, ectx := errgroup.WithContext(ctx)
+	for _, s := range stores {
+		store := s
+		if store.GetState() != metapb.StoreState_Up {
+			continue
+		}
+		pool.ApplyOnErrorGroup(eg, func() error {
+			status, err := collector.collect(ectx, store)
+			if err != nil {
+				log.Warn("failed to collect the status of the store", zap.Uint64("store", store.GetId()),
+					logutil.ShortError(err))
+				return nil
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			result[store.GetId()] = status
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package stream
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/tidb/br/pkg/streamhelper"
+	"github.com/pingcap/tidb/br/pkg/tikvmetrics"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func TestNewStoreCounters(t *testing.T) {
+	metrics := `# TYPE tikv_log_backup_handle_kv_batch histogram
+tikv_log_backup_handle_kv_batch_bucket{le="+Inf"} 10
+tikv_log_backup_handle_kv_batch_sum 1200
+tikv_log_backup_handle_kv_batch_count 10
+# TYPE tikv_log_backup_flush_file_size histogram
+tikv_log_backup_flush_file_size_bucket{le="+Inf"} 2
+tikv_log_backup_flush_file_size_sum 4096
+tikv_log_backup_flush_file_size_count 2
+# TYPE tikv_log_backup_errors counter
+tikv_log_backup_errors{type="Io"} 3
+tikv_log_backup_errors{type="Etcd"} 1
+`
+	m, err := tikvmetrics.Parse(strings.NewReader(metrics))
+	require.NoError(t, err)
+	counters := newStoreCounters(m)
+	require.Equal(t, 1200.0, counters.kvCount)
+	require.Equal(t, 4096.0, counters.flushedBytes)
+	require.Equal(t, map[string]uint64{"Io": 3, "Etcd": 1}, counters.errors)
+
+	// the older TiKV has no metrics of flushing.
+	m, err = tikvmetrics.Parse(strings.NewReader(`# TYPE tikv_stream_handle_kv_batch histogram
+tikv_stream_handle_kv_batch_bucket{le="+Inf"} 1
+tikv_stream_handle_kv_batch_sum 42
+tikv_stream_handle_kv_batch_count 1
+`))
+	require.NoError(t, err)
+	counters = newStoreCounters(m)
+	require.Equal(t, 42.0, counters.kvCount)
+	require.Equal(t, 0.0, counters.flushedBytes)
+	require.Empty(t, counters.errors)
+}
+
+func TestParseFlushInterval(t *testing.T) {
+	interval, err := parseFlushInterval(strings.NewReader(`{"log-backup":{"max-flush-interval":"5m","num-threads":8}}`))
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Minute, interval)
+	interval, err = parseFlushInterval(strings.NewReader(`{"server":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, defaultFlushInterval, interval)
+	_, err = parseFlushInterval(strings.NewReader(`{"log-backup":{"max-flush-interval":"five minutes"}}`))
+	require.Error(t, err)
+}
+
+func TestStoreLags(t *testing.T) {
+	now := time.Now()
+	task := TaskStatus{
+		Info: backuppb.StreamBackupTaskInfo{StartTs: 1},
+		Checkpoints: []streamhelper.Checkpoint{
+			{ID: 1, TS: oracle.GoTimeToTS(now.Add(-time.Minute))},
+			{ID: 2, TS: oracle.GoTimeToTS(now.Add(-10 * time.Minute))},
+		},
+		Stores: map[uint64]StoreStatus{
+			1: {StoreID: 1, BytesPerMinute: 1024, FlushInterval: 3 * time.Minute},
+			2: {StoreID: 2, BytesPerMinute: 2048},
+		},
+	}
+	lags := task.storeLags(now)
+	require.Len(t, lags, 2)
+	require.InDelta(t, time.Minute, lags[1], float64(time.Second))
+	require.True(t, task.Stores[1].FlushHealthy(lags[1]))
+	// the default flush interval is used if it's unknown.
+	require.False(t, task.Stores[2].FlushHealthy(lags[2]))
+	require.Equal(t, 3072.0, task.BytesPerMinute())
+	require.Equal(t, []uint64{1, 2}, sortedStoreIDs(task.Stores))
+}
@@ -7,22 +7,19 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
-	"io"
-	"regexp"
-	"strconv"
-	"sync"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/docker/go-units"
 	"github.com/fatih/color"
 	"github.com/pingcap/errors"
 	backuppb "github.com/pingcap/kvproto/pkg/brpb"
-	"github.com/pingcap/kvproto/pkg/metapb"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tidb/br/pkg/glue"
-	"github.com/pingcap/tidb/br/pkg/httputil"
-	"github.com/pingcap/tidb/br/pkg/logutil"
 	"github.com/pingcap/tidb/br/pkg/storage"
 	. "github.com/pingcap/tidb/br/pkg/streamhelper"
+	"github.com/pingcap/tidb/br/pkg/utils"
 	"github.com/tikv/client-go/v2/oracle"
 	pd "github.com/tikv/pd/client"
 	"go.uber.org/zap"
@@ -44,6 +41,17 @@ type TaskStatus struct {
 	QPS float64
 	// Last error reported by the store.
 	LastErrors map[uint64]backuppb.StreamBackupError
+	// Stores collects the status of the log backup on the stores.
+	Stores map[uint64]StoreStatus
+}
+
+// BytesPerMinute is the total size of the log files flushed per minute.
+func (t TaskStatus) BytesPerMinute() float64 {
+	total := 0.0
+	for _, s := range t.Stores {
+		total += s.BytesPerMinute
+	}
+	return total
 }
 
 type TaskPrinter interface {
@@ -126,6 +134,7 @@ func (p *printByTable) AddTask(task TaskStatus) {
 	s := storage.FormatBackendURL(task.Info.GetStorage())
 	table.Add("storage", s.String())
 	table.Add("speed(est.)", fmt.Sprintf("%s ops/s", color.New(color.Bold).Sprintf("%.2f", task.QPS)))
+	table.Add("flushed(est.)", fmt.Sprintf("%s/min", color.New(color.Bold).Sprint(units.HumanSize(task.BytesPerMinute()))))
 
 	now := time.Now()
 	formatTS := func(ts uint64) string {
@@ -140,6 +149,7 @@ func (p *printByTable) AddTask(task TaskStatus) {
 	}
 	table.Add("checkpoint[global]", formatTS(task.globalCheckpoint))
 	p.addCheckpoints(&task, table, formatTS)
+	p.addStores(&task, table, now)
 	for store, e := range task.LastErrors {
 		table.Add(fmt.Sprintf("error[store=%d]", store), e.ErrorCode)
 		table.Add(fmt.Sprintf("error-happen-at[store=%d]", store), formatTS(oracle.ComposeTS(int64(e.HappenAt), 0)))
@@ -160,6 +170,51 @@ func (p *printByTable) addCheckpoints(task *TaskStatus, table *glue.Table, forma
 	}
 }
 
+// addStores adds the checkpoint lag, the speed and the errors of each store.
+func (p *printByTable) addStores(task *TaskStatus, table *glue.Table, now time.Time) {
+	lags := task.storeLags(now)
+	for _, id := range sortedStoreIDs(task.Stores) {
+		store := task.Stores[id]
+		if lag, ok := lags[id]; ok {
+			health := color.GreenString("healthy")
+			if !store.FlushHealthy(lag) {
+				health = color.RedString("lagging")
+			}
+			table.Add(fmt.Sprintf("lag[store=%d]", id),
+				fmt.Sprintf("%s; flush-interval=%s; %s", lag.Round(time.Second), store.FlushInterval, health))
+		}
+		table.Add(fmt.Sprintf("flushed[store=%d]", id), fmt.Sprintf("%s/min", units.HumanSize(store.BytesPerMinute)))
+		if len(store.Errors) > 0 {
+			errs := make([]string, 0, len(store.Errors))
+			for tp, count := range store.Errors {
+				errs = append(errs, fmt.Sprintf("%s=%d", tp, count))
+			}
+			sort.Strings(errs)
+			table.Add(fmt.Sprintf("errors[store=%d]", id), strings.Join(errs, ", "))
+		}
+	}
+}
+
+func sortedStoreIDs(stores map[uint64]StoreStatus) []uint64 {
+	ids := make([]uint64, 0, len(stores))
+	for id := range stores {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// storeLags calculates how long the checkpoint of each store lags behind.
+func (t *TaskStatus) storeLags(now time.Time) map[uint64]time.Duration {
+	lags := make(map[uint64]time.Duration)
+	for _, cp := range t.Checkpoints {
+		if cp.Type() == CheckpointTypeStore {
+			lags[cp.ID] = now.Sub(oracle.GetTimeFromTS(cp.TS))
+		}
+	}
+	return lags
+}
+
 func (p *printByTable) PrintTasks() {
 	if len(p.pendingTables) == 0 {
 		p.console.Println(statusErr("No Task Yet."))
@@ -185,6 +240,16 @@ func (p *printByJSON) PrintTasks() {
 	type storeProgress struct {
 		StoreID    uint64 `json:"store_id"`
 		Checkpoint uint64 `json:"checkpoint"`
+		// LagSeconds is how long the checkpoint lags behind.
+		LagSeconds float64 `json:"lag_seconds"`
+	}
+	type storeStatus struct {
+		StoreID              uint64            `json:"store_id"`
+		EstQPS               float64           `json:"estimate_qps"`
+		BytesPerMinute       float64           `json:"bytes_per_minute"`
+		Errors               map[string]uint64 `json:"errors"`
+		FlushIntervalSeconds float64           `json:"flush_interval_seconds"`
+		FlushHealthy         *bool             `json:"flush_healthy,omitempty"`
 	}
 	type storeLastError struct {
 		StoreID   uint64                     `json:"store_id"`
@@ -200,18 +265,40 @@ func (p *printByJSON) PrintTasks() {
 		CheckpointTS uint64           `json:"checkpoint"`
 		EstQPS       float64          `json:"estimate_qps"`
 		LastErrors   []storeLastError `json:"last_errors"`
+		// BytesPerMinute is the size of the log files flushed per minute.
+		BytesPerMinute float64       `json:"bytes_per_minute"`
+		Stores         []storeStatus `json:"stores"`
 	}
+	now := time.Now()
 	taskToJSON := func(t TaskStatus) jsonTask {
 		s := storage.FormatBackendURL(t.Info.GetStorage())
+		lags := t.storeLags(now)
 		sp := make([]storeProgress, 0, len(t.Checkpoints))
 		for _, checkpoint := range t.Checkpoints {
 			if checkpoint.Type() == CheckpointTypeStore {
 				sp = append(sp, storeProgress{
 					StoreID:    checkpoint.ID,
 					Checkpoint: checkpoint.TS,
+					LagSeconds: lags[checkpoint.ID].Seconds(),
 				})
 			}
 		}
+		ss := make([]storeStatus, 0, len(t.Stores))
+		for _, id := range sortedStoreIDs(t.Stores) {
+			store := t.Stores[id]
+			status := storeStatus{
+				StoreID:              id,
+				EstQPS:               store.QPS,
+				BytesPerMinute:       store.BytesPerMinute,
+				Errors:               store.Errors,
+				FlushIntervalSeconds: store.FlushInterval.Seconds(),
+			}
+			if lag, ok := lags[id]; ok {
+				healthy := store.FlushHealthy(lag)
+				status.FlushHealthy = &healthy
+			}
+			ss = append(ss, status)
+		}
 		se := make([]storeLastError, 0, len(t.LastErrors))
 		for store, lastError := range t.LastErrors {
 			se = append(se, storeLastError{
@@ -229,6 +316,9 @@ func (p *printByJSON) PrintTasks() {
 			CheckpointTS: t.globalCheckpoint,
 			EstQPS:       t.QPS,
 			LastErrors:   se,
+
+			BytesPerMinute: t.BytesPerMinute(),
+			Stores:         ss,
 		}
 	}
 	mustMarshal := func(i interface{}) string {
@@ -246,99 +336,35 @@ func (p *printByJSON) PrintTasks() {
 	p.console.Println(mustMarshal(tasks))
 }
 
-var logCountSumRe = regexp.MustCompile(`tikv_(?:stream|log_backup)_handle_kv_batch_sum ([0-9]+)`)
-
 type PDInfoProvider interface {
 	GetPDClient() pd.Client
 	GetTLSConfig() *tls.Config
 }
 
-// MaybeQPS get a number like the QPS of last seconds for each store via the prometheus interface.
-// TODO: this is a temporary solution(aha, like in a Hackthon),
-//
-//	we MUST find a better way for providing this information.
-func MaybeQPS(ctx context.Context, mgr PDInfoProvider) (float64, error) {
-	c := mgr.GetPDClient()
-	prefix := "http://"
-	if mgr.GetTLSConfig() != nil {
-		prefix = "https://"
-	}
-	ss, err := c.GetAllStores(ctx)
-	if err != nil {
-		return 0, err
-	}
-	cli := httputil.NewClient(mgr.GetTLSConfig())
-	getCount := func(statusAddr string) (uint64, error) {
-		before, err := cli.Get(prefix + statusAddr + "/metrics")
-		if err != nil {
-			return 0, err
-		}
-		defer before.Body.Close()
-		data, err := io.ReadAll(before.Body)
-		if err != nil {
-			return 0, err
-		}
-		matches := logCountSumRe.FindSubmatch(data)
-		log.Info("get qps", zap.ByteStrings("matches", matches), logutil.Redact(zap.String("addr", statusAddr)))
-		if len(matches) < 2 {
-			return 42, nil
-		}
-		return strconv.ParseUint(string(matches[1]), 10, 64)
-	}
-	qpsMap := new(sync.Map)
-	eg, _ := errgroup.WithContext(ctx)
-	getQPS := func(s *metapb.Store) error {
-		if s.GetState() != metapb.StoreState_Up {
-			return nil
-		}
-		statusAddr := s.StatusAddress
-		c0, err := getCount(statusAddr)
-		if err != nil {
-			return errors.Annotatef(err, "failed to get count from %s", statusAddr)
-		}
-		start := time.Now()
-		time.Sleep(1 * time.Second)
-		c1, err := getCount(statusAddr)
-		if err != nil {
-			return errors.Annotatef(err, "failed to get count from %s", statusAddr)
-		}
-		elapsed := float64(time.Since(start)) / float64(time.Second)
-		log.Info("calc qps", zap.Uint64("diff", c1-c0), zap.Float64("elapsed", elapsed), zap.Uint64("c0", c0), zap.Uint64("c1", c1))
-
-		qpsMap.Store(s.GetId(), float64(c1-c0)/elapsed)
-		return nil
-	}
-
-	for _, s := range ss {
-		store := s
-		eg.Go(func() error {
-			return getQPS(store)
-		})
-	}
-	if err := eg.Wait(); err != nil {
-		log.Warn("failed to get est QPS", logutil.ShortError(err))
-	}
-	qps := 0.0
-	qpsMap.Range(func(key, value interface{}) bool {
-		qps += value.(float64)
-		return true
-	})
-	return qps, nil
-}
-
 // StatusController is the controller type (or context type) for the command `stream status`.
 type StatusController struct {
 	meta *MetaDataClient
 	mgr  PDInfoProvider
 	view TaskPrinter
+
+	sampleInterval time.Duration
 }
 
 // NewStatusContorller make a status controller via some resource accessors.
 func NewStatusController(meta *MetaDataClient, mgr PDInfoProvider, view TaskPrinter) *StatusController {
 	return &StatusController{
-		meta: meta,
-		mgr:  mgr,
-		view: view,
+		meta:           meta,
+		mgr:            mgr,
+		view:           view,
+		sampleInterval: DefaultStatusSampleInterval,
+	}
+}
+
+// SetSampleInterval sets the interval between the samples of the metrics of
+// the stores, the longer interval makes the speed more accurate.
+func (ctl *StatusController) SetSampleInterval(interval time.Duration) {
+	if interval > 0 {
+		ctl.sampleInterval = interval
 	}
 }
 
@@ -365,43 +391,64 @@ func (ctl *StatusController) fillTask(ctx context.Context, task Task) (TaskStatu
 	if err != nil {
 		return s, err
 	}
-
-	s.QPS, err = MaybeQPS(ctx, ctl.mgr)
-	if err != nil {
-		return s, errors.Annotatef(err, "failed to get QPS of task %s", s.Info.Name)
-	}
 	return s, nil
 }
 
 // getTask fetches the task by the name, if the name is the wildcard ("*"), fetch all tasks.
+// The tasks are fetched from PD and the status of the stores are fetched from TiKV concurrently.
 func (ctl *StatusController) getTask(ctx context.Context, name string) ([]TaskStatus, error) {
+	var tasks []Task
 	if name == WildCard {
 		// get status about all of tasks
-		tasks, err := ctl.meta.GetAllTasks(ctx)
+		var err error
+		tasks, err = ctl.meta.GetAllTasks(ctx)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// get status about TaskName
+		task, err := ctl.meta.GetTask(ctx, name)
 		if err != nil {
 			return nil, err
 		}
+		tasks = []Task{*task}
+	}
+	if len(tasks) == 0 {
+		return nil, nil
+	}
 
-		result := make([]TaskStatus, 0, len(tasks))
-		for _, task := range tasks {
-			status, err := ctl.fillTask(ctx, task)
+	var stores map[uint64]StoreStatus
+	result := make([]TaskStatus, len(tasks))
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		var err error
+		stores, err = ctl.collectStoreStatus(ectx)
+		return errors.Annotate(err, "failed to collect the status of the stores")
+	})
+	pool := utils.NewWorkerPool(statusWorkerPoolSize, "task status")
+	for i := range tasks {
+		i := i
+		pool.ApplyOnErrorGroup(eg, func() error {
+			status, err := ctl.fillTask(ectx, tasks[i])
 			if err != nil {
-				return nil, err
+				return err
 			}
-			result = append(result, status)
-		}
-		return result, nil
+			result[i] = status
+			return nil
+		})
 	}
-	// get status about TaskName
-	task, err := ctl.meta.GetTask(ctx, name)
-	if err != nil {
+	if err := eg.Wait(); err != nil {
 		return nil, err
 	}
-	status, err := ctl.fillTask(ctx, *task)
-	if err != nil {
-		return nil, err
+
+	// the stores are shared by the tasks, so the speed is the total one.
+	for i := range result {
+		result[i].Stores = stores
+		for _, store := range stores {
+			result[i].QPS += store.QPS
+		}
 	}
-	return []TaskStatus{status}, nil
+	return result, nil
 }
 
 func (ctl *StatusController) printToView(tasks []TaskStatus) {
@@ -5,12 +5,14 @@ package task
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/docker/go-units"
+	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
@@ -23,7 +25,9 @@ import (
 	"github.com/pingcap/tidb/br/pkg/glue"
 	"github.com/pingcap/tidb/br/pkg/logutil"
 	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/br/pkg/rtree"
 	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/br/pkg/streamhelper"
 	"github.com/pingcap/tidb/br/pkg/summary"
 	"github.com/pingcap/tidb/br/pkg/utils"
 	"github.com/pingcap/tidb/parser/mysql"
@@ -31,6 +35,7 @@ import (
 	"github.com/pingcap/tidb/statistics/handle"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/mathutil"
+	filter "github.com/pingcap/tidb/util/table-filter"
 	"github.com/spf13/pflag"
 	"github.com/tikv/client-go/v2/oracle"
 	"go.uber.org/zap"
@@ -45,6 +50,13 @@ const (
 	flagRemoveSchedulers = "remove-schedulers"
 	flagIgnoreStats      = "ignore-stats"
 	flagUseBackupMetaV2  = "use-backupmeta-v2"
+	flagUseBackupMetaV3  = "use-backupmeta-v3"
+	flagPriorityTable    = "priority-table"
+	flagChangesFrom      = "incremental-changes-from"
+	flagReplicaStorage   = "replica-storage"
+	flagTaskID           = "task-id"
+	flagSLOLatency       = "slo-latency"
+	flagSLOCPUUsage      = "slo-tikv-cpu-usage"
 
 	flagGCTTL = "gcttl"
 
@@ -76,6 +88,22 @@ type BackupConfig struct {
 	RemoveSchedulers bool          `json:"remove-schedulers" toml:"remove-schedulers"`
 	IgnoreStats      bool          `json:"ignore-stats" toml:"ignore-stats"`
 	UseBackupMetaV2  bool          `json:"use-backupmeta-v2"`
+	UseBackupMetaV3  bool          `json:"use-backupmeta-v3"`
+	// PriorityTables are the patterns of the tables backed up before the others, e.g. db.tbl
+	PriorityTables []string `json:"priority-tables" toml:"priority-tables"`
+	// ChangesFrom is the storage of the log backup tracking the changes since
+	// the last backup, the incremental backup only scans the changed regions.
+	ChangesFrom string `json:"incremental-changes-from" toml:"incremental-changes-from"`
+	// ReplicaStorages are the storages the backup is copied to after backed up
+	// to the primary storage.
+	ReplicaStorages []string `json:"replica-storages" toml:"replica-storages"`
+	// TaskID identifies the backup to pause and resume it, a failed or killed
+	// backup is resumed from its checkpoint by running it with the same ID.
+	TaskID string `json:"task-id" toml:"task-id"`
+	// SLOLatency and SLOCPUUsage are the SLOs of the cluster under the backup,
+	// the backup slows down once the load breaks them, 0 means not checked.
+	SLOLatency  time.Duration `json:"slo-latency" toml:"slo-latency"`
+	SLOCPUUsage float64       `json:"slo-tikv-cpu-usage" toml:"slo-tikv-cpu-usage"`
 	CompressionConfig
 }
 
@@ -108,6 +136,25 @@ func DefineBackupFlags(flags *pflag.FlagSet) {
 	// This flag is used for test. we should backup stats all the time.
 	_ = flags.MarkHidden(flagIgnoreStats)
 
+	flags.StringArray(flagPriorityTable, nil,
+		"the tables backed up before the others, e.g. db.tbl, it can be repeated and supports the wildcards of the "+
+			"table filter. the other tables are backed up from the largest to the smallest by their estimated sizes")
+
+	flags.String(flagChangesFrom, "", "(experimental) the storage of the log backup task running since the "+
+		"last backup, the incremental backup only scans the regions changed in the log backup")
+
+	flags.StringArray(flagReplicaStorage, nil, "the storage the backup is replicated to after backed up "+
+		"to the primary storage, e.g. s3://bucket/path, it can be repeated. each replica is verified by the checksums")
+
+	flags.String(flagTaskID, "", "the ID of the backup task, it's used to pause and resume the running backup, "+
+		"and a failed backup is resumed from its checkpoint by running it with the same ID. "+
+		"it's generated if not specified")
+
+	flags.Duration(flagSLOLatency, 0, "the max average latency of the user requests to TiKV, e.g. 20ms. "+
+		"the backup adapts its concurrency to the load of the cluster once it's set, instead of a fixed --ratelimit")
+	flags.Float64(flagSLOCPUUsage, 0, "the max ratio of the CPU cores used by a TiKV store, e.g. 0.8. "+
+		"the backup adapts its concurrency to the load of the cluster once it's set, instead of a fixed --ratelimit")
+
 	flags.Bool(flagUseBackupMetaV2, false,
 		"use backup meta v2 to store meta info")
 	// This flag will change the structure of backupmeta.
@@ -118,6 +165,10 @@ func DefineBackupFlags(flags *pflag.FlagSet) {
 	// but will generate v1 meta due to this flag is false. the behaviour is as same as v4.0.15, v4.0.16.
 	// finally v4.0.17 will set this flag to true, and generate v2 meta.
 	_ = flags.MarkHidden(flagUseBackupMetaV2)
+
+	flags.Bool(flagUseBackupMetaV3, false,
+		"use backup meta v3 to shard the schemas by the databases, so the restore loads only the schemas matched")
+	_ = flags.MarkHidden(flagUseBackupMetaV3)
 }
 
 // ParseFromFlags parses the backup-related flags from the flag set.
@@ -166,7 +217,174 @@ func (cfg *BackupConfig) ParseFromFlags(flags *pflag.FlagSet) error {
 		return errors.Trace(err)
 	}
 	cfg.UseBackupMetaV2, err = flags.GetBool(flagUseBackupMetaV2)
-	return errors.Trace(err)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if flags.Lookup(flagUseBackupMetaV3) != nil {
+		cfg.UseBackupMetaV3, err = flags.GetBool(flagUseBackupMetaV3)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if flags.Lookup(flagPriorityTable) != nil {
+		cfg.PriorityTables, err = flags.GetStringArray(flagPriorityTable)
+		if err != nil {
+			return errors.Annotatef(err, "failed to get flag %s", flagPriorityTable)
+		}
+		if _, err = filter.Parse(cfg.PriorityTables); err != nil {
+			return errors.Annotatef(berrors.ErrInvalidArgument, "invalid %s: %s", flagPriorityTable, err)
+		}
+	}
+	if flags.Lookup(flagReplicaStorage) != nil {
+		cfg.ReplicaStorages, err = flags.GetStringArray(flagReplicaStorage)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		for _, replica := range cfg.ReplicaStorages {
+			if replica == cfg.Storage {
+				return errors.Annotatef(berrors.ErrInvalidArgument,
+					"the replica storage %s is the same as the primary storage", redactStorageURI(replica))
+			}
+		}
+	}
+	if flags.Lookup(flagTaskID) != nil {
+		cfg.TaskID, err = flags.GetString(flagTaskID)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if flags.Lookup(flagSLOLatency) != nil {
+		if cfg.SLOLatency, err = flags.GetDuration(flagSLOLatency); err != nil {
+			return errors.Trace(err)
+		}
+		if cfg.SLOCPUUsage, err = flags.GetFloat64(flagSLOCPUUsage); err != nil {
+			return errors.Trace(err)
+		}
+		if cfg.SLOLatency < 0 || cfg.SLOCPUUsage < 0 || cfg.SLOCPUUsage > 1 {
+			return errors.Annotatef(berrors.ErrInvalidArgument, "invalid SLOs, --%s %s, --%s %f",
+				flagSLOLatency, cfg.SLOLatency, flagSLOCPUUsage, cfg.SLOCPUUsage)
+		}
+		if cfg.isThrottled() && cfg.RateLimit != unlimited {
+			return errors.Annotatef(berrors.ErrInvalidArgument,
+				"--%s and the SLOs can't be set at the same time", flagRateLimit)
+		}
+	}
+	if flags.Lookup(flagChangesFrom) != nil {
+		cfg.ChangesFrom, err = flags.GetString(flagChangesFrom)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if len(cfg.ChangesFrom) > 0 && cfg.LastBackupTS == 0 {
+			return errors.Annotatef(berrors.ErrInvalidArgument,
+				"%s is only available for the incremental backup, please specify %s", flagChangesFrom, flagLastBackupTS)
+		}
+	}
+	return nil
+}
+
+// filterUnchangedRanges removes the parts of the ranges without any change
+// since the last backup by the log backup. If the log backup doesn't cover the
+// period, the ranges are backed up fully, and the parts of the ranges not
+// observed by the log backup task are always backed up.
+func filterUnchangedRanges(ctx context.Context, cfg *BackupConfig, ranges []rtree.Range, backupTS uint64) []rtree.Range {
+	changesCfg := cfg.Config
+	changesCfg.Storage = cfg.ChangesFrom
+	changed, err := func() ([]rtree.Range, error) {
+		logMinTS, logMaxTS, err := getLogRange(ctx, &changesCfg)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		backend, s, err := GetStorage(ctx, cfg.ChangesFrom, &changesCfg)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		observed, err := getObservedRanges(ctx, &changesCfg, backend)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		tracker := backup.NewLogBackupChangeTracker(s, logMinTS, logMaxTS, observed)
+		return tracker.ChangedRanges(ctx, cfg.LastBackupTS, backupTS)
+	}()
+	if err != nil {
+		log.Warn("failed to load the changes since the last backup, back up the ranges fully",
+			zap.Uint64("last-backup-ts", cfg.LastBackupTS), logutil.ShortError(err))
+		return ranges
+	}
+	filtered := backup.FilterChangedRanges(ranges, changed)
+	log.Info("skip the ranges without changes since the last backup",
+		zap.Int("ranges", len(ranges)), zap.Int("changed-ranges", len(filtered)))
+	summary.CollectInt("backup changed ranges", len(filtered))
+	return filtered
+}
+
+// getObservedRanges gets the key ranges observed by the log backup task
+// writing into the storage.
+func getObservedRanges(ctx context.Context, cfg *Config, backend *backuppb.StorageBackend) ([]rtree.Range, error) {
+	etcdCLI, err := dialEtcdWithCfg(ctx, *cfg)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer etcdCLI.Close()
+	tasks, err := streamhelper.NewMetaDataClient(etcdCLI).GetAllTasks(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	storageURL := storage.FormatBackendURL(backend)
+	for i := range tasks {
+		taskURL := storage.FormatBackendURL(tasks[i].Info.Storage)
+		if taskURL.String() != storageURL.String() {
+			continue
+		}
+		kvRanges, err := tasks[i].Ranges(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		ranges := make([]rtree.Range, 0, len(kvRanges))
+		for _, r := range kvRanges {
+			ranges = append(ranges, rtree.Range{StartKey: r.StartKey, EndKey: r.EndKey})
+		}
+		log.Info("load the ranges observed by the log backup task",
+			zap.String("task", tasks[i].Info.Name), zap.Int("ranges", len(ranges)))
+		return ranges, nil
+	}
+	return nil, errors.Annotatef(berrors.ErrBackupChangesUntracked,
+		"no log backup task writes into %s", storageURL.String())
+}
+
+// redactStorageURI removes the query of the URI of the storage, which may
+// contain the credentials.
+func redactStorageURI(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "<invalid URI>"
+	}
+	u.RawQuery = ""
+	return u.String()
+}
+
+// reportCompression reports the compression ratios of the backup and its
+// tables, the tables compressed poorly are logged first.
+func reportCompression(
+	ctx context.Context,
+	g glue.Glue,
+	s storage.ExternalStorage,
+	stats *backup.CompressionStats,
+	schemas *backup.Schemas,
+) {
+	size, rawSize := stats.Sizes()
+	g.Record("RawSize", rawSize)
+	log.Info("backup compression summary", zap.String("type", stats.Type), zap.Int32("level", stats.Level),
+		zap.Uint64("size", size), zap.Uint64("total-bytes", rawSize), zap.Float64("ratio", stats.Ratio()))
+	if schemas != nil {
+		for _, t := range stats.Summarize(schemas) {
+			log.Info("table compression summary", zap.String("db", t.DB), zap.String("table", t.Table),
+				zap.Int("files", t.Files), zap.Uint64("size", t.Size), zap.Uint64("total-bytes", t.TotalBytes),
+				zap.Float64("ratio", t.Ratio))
+		}
+	}
+	if err := stats.Write(ctx, s); err != nil {
+		log.Warn("failed to write the compression stats of the backup", logutil.ShortError(err))
+	}
 }
 
 // parseCompressionFlags parses the backup-related flags from the flag set.
@@ -220,12 +438,20 @@ func (cfg *BackupConfig) adjustBackupConfig() {
 	if cfg.GCTTL == 0 {
 		cfg.GCTTL = utils.DefaultBRGCSafePointTTL
 	}
+	if len(cfg.TaskID) == 0 {
+		cfg.TaskID = uuid.New().String()
+	}
 	// Use zstd as default
 	if cfg.CompressionType == backuppb.CompressionType_UNKNOWN {
 		cfg.CompressionType = backuppb.CompressionType_ZSTD
 	}
 }
 
+// isThrottled returns whether the backup is throttled by the load of the cluster.
+func (cfg *BackupConfig) isThrottled() bool {
+	return cfg.SLOLatency > 0 || cfg.SLOCPUUsage > 0
+}
+
 func isFullBackup(cmdName string) bool {
 	return cmdName == FullBackupCmd
 }
@@ -281,19 +507,49 @@ func RunBackup(c context.Context, g glue.Glue, cmdName string, cfg *BackupConfig
 	if err != nil {
 		return errors.Trace(err)
 	}
-	opts := storage.ExternalStorageOptions{
-		NoCredentials:   cfg.NoCreds,
-		SendCredentials: cfg.SendCreds,
+	opts := storageOpts(&cfg.Config)
+	if err = client.SetStorage(ctx, u, opts); err != nil {
+		return errors.Trace(err)
+	}
+	checkpoint, err := backup.NewCheckpoint(ctx, client.GetStorage(), cfg.TaskID, client.GetClusterID(), cfg.LastBackupTS)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	client.SetCheckpoint(checkpoint)
+	log.Info("start the backup task", zap.String("task-id", cfg.TaskID), zap.Bool("resumed", checkpoint.Resumed()))
+	if checkpoint.Resumed() {
+		// the files backed up are encrypted by the data key generated before.
+		err = cfg.openDataKey(ctx, client.GetStorage())
+	} else {
+		err = cfg.generateDataKey(ctx, client.GetStorage())
 	}
-	if err = client.SetStorage(ctx, u, &opts); err != nil {
+	if err != nil {
 		return errors.Trace(err)
 	}
+	replicas := make([]backup.ReplicaTarget, 0, len(cfg.ReplicaStorages))
+	for _, replica := range cfg.ReplicaStorages {
+		_, s, err := GetStorage(ctx, replica, &cfg.Config)
+		if err != nil {
+			return errors.Annotatef(err, "failed to open the replica storage %s", redactStorageURI(replica))
+		}
+		replicas = append(replicas, backup.ReplicaTarget{Location: redactStorageURI(replica), Storage: s})
+	}
 	err = client.SetLockFile(ctx)
 	if err != nil {
 		return errors.Trace(err)
 	}
 	client.SetGCTTL(cfg.GCTTL)
+	compressionStats := backup.NewCompressionStats(cfg.CompressionType, cfg.CompressionLevel)
+	client.SetCompressionStats(compressionStats)
 
+	if checkpoint.Resumed() {
+		if cfg.BackupTS > 0 && cfg.BackupTS != checkpoint.BackupTS() {
+			log.Warn("the backup is resumed at the backup ts of the checkpoint rather than the specified one",
+				zap.Uint64("backup-ts", checkpoint.BackupTS()), zap.Uint64("specified-backup-ts", cfg.BackupTS))
+		}
+		cfg.BackupTS = checkpoint.BackupTS()
+	}
+	// the backup ts of the resumed backup is checked against the GC safepoint.
 	backupTS, err := client.GetTS(ctx, cfg.TimeAgo, cfg.BackupTS)
 	if err != nil {
 		return errors.Trace(err)
@@ -314,6 +570,10 @@ func RunBackup(c context.Context, g glue.Glue, cmdName string, cfg *BackupConfig
 	if err != nil {
 		return errors.Trace(err)
 	}
+	checkpoint.Start(ctx, backupTS)
+	// the backup can be paused and resumed through the status address.
+	runningBackup.Store(&backupTask{id: cfg.TaskID, client: client})
+	defer runningBackup.Store(nil)
 
 	isIncrementalBackup := cfg.LastBackupTS > 0
 
@@ -359,6 +619,9 @@ func RunBackup(c context.Context, g glue.Glue, cmdName string, cfg *BackupConfig
 	// Metafile size should be less than 64MB.
 	metawriter := metautil.NewMetaWriter(client.GetStorage(),
 		metautil.MetaFileSize, cfg.UseBackupMetaV2, "", &cfg.CipherInfo)
+	if cfg.UseBackupMetaV3 {
+		metawriter.UseMetaV3()
+	}
 	// Hack way to update backupmeta.
 	metawriter.Update(func(m *backuppb.BackupMeta) {
 		m.StartVersion = req.StartVersion
@@ -411,8 +674,43 @@ func RunBackup(c context.Context, g glue.Glue, cmdName string, cfg *BackupConfig
 		}
 	}
 
+	if schemas != nil {
+		var (
+			priorities filter.Filter
+			schedule   *backup.Schedule
+		)
+		if len(cfg.PriorityTables) > 0 {
+			if priorities, err = filter.Parse(cfg.PriorityTables); err != nil {
+				return errors.Trace(err)
+			}
+			priorities = filter.CaseInsensitive(priorities)
+		}
+		schedule, err = backup.BuildSchedule(ctx, schemas, priorities, mgr.GetApproximateSize)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		ranges = schedule.Ranges()
+		if err = schedule.Write(ctx, client.GetStorage()); err != nil {
+			log.Warn("failed to write the schedule of the backup", logutil.ShortError(err))
+		}
+	}
+
+	if isIncrementalBackup && len(cfg.ChangesFrom) > 0 {
+		ranges = filterUnchangedRanges(ctx, cfg, ranges, backupTS)
+	}
+
 	summary.CollectInt("backup total ranges", len(ranges))
 
+	if cfg.isThrottled() {
+		throttler := backup.NewThrottler(backup.ThrottleConfig{
+			MaxLatency:     cfg.SLOLatency,
+			MaxCPUUsage:    cfg.SLOCPUUsage,
+			MaxConcurrency: uint(cfg.Concurrency),
+		}, backup.NewStoreLoadSampler(mgr))
+		client.SetThrottler(throttler)
+		go throttler.Run(ctx)
+	}
+
 	var updateCh glue.Progress
 	var unit backup.ProgressUnit
 	if len(ranges) < 100 {
@@ -497,6 +795,9 @@ func RunBackup(c context.Context, g glue.Glue, cmdName string, cfg *BackupConfig
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if err = checkpoint.Remove(ctx); err != nil {
+		log.Warn("failed to remove the checkpoint", logutil.ShortError(err))
+	}
 
 	// Checksum has finished, close checksum progress.
 	updateCh.Close()
@@ -512,6 +813,13 @@ func RunBackup(c context.Context, g glue.Glue, cmdName string, cfg *BackupConfig
 	g.Record(summary.BackupDataSize, archiveSize)
 	//backup from tidb will fetch a general Size issue https://github.com/pingcap/tidb/issues/27247
 	g.Record("Size", archiveSize)
+	reportCompression(ctx, g, client.GetStorage(), compressionStats, schemas)
+	if len(replicas) > 0 {
+		primary := backup.ReplicaTarget{Location: redactStorageURI(cfg.Storage), Storage: client.GetStorage()}
+		if _, err = backup.ReplicateBackup(ctx, primary, replicas, uint(cfg.Concurrency)); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	failpoint.Inject("s3-outage-during-writing-file", func(v failpoint.Value) {
 		log.Info("failpoint s3-outage-during-writing-file injected, " +
 			"process will sleep for 3s and notify the shell to kill s3 service.")
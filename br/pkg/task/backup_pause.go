@@ -0,0 +1,167 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/backup"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/glue"
+	"github.com/spf13/pflag"
+)
+
+const (
+	// BackupPausePath is the path of the status address to pause the running
+	// backup by POST, and get its state by GET.
+	BackupPausePath = "/backup/pause"
+	// BackupResumePath is the path of the status address to resume the paused
+	// backup by POST, and get its state by GET.
+	BackupResumePath = "/backup/resume"
+
+	flagBackupAddr = "addr"
+
+	backupControlTimeout = 30 * time.Second
+)
+
+// backupTask is the running backup.
+type backupTask struct {
+	id     string
+	client *backup.Client
+}
+
+// runningBackup is the running backup, it's nil if no backup is running.
+var runningBackup atomic.Pointer[backupTask]
+
+// backupTaskState is the response of the pause and resume handlers.
+type backupTaskState struct {
+	TaskID string             `json:"task-id"`
+	State  backup.BackupState `json:"state"`
+}
+
+type backupControlHandler struct {
+	pause bool
+}
+
+// BackupPauseHandler returns the handler to pause the running backup.
+func BackupPauseHandler() http.Handler {
+	return backupControlHandler{pause: true}
+}
+
+// BackupResumeHandler returns the handler to resume the paused backup.
+func BackupResumeHandler() http.Handler {
+	return backupControlHandler{pause: false}
+}
+
+// ServeHTTP implements http.Handler. The backup is specified by the task-id
+// in the query, so a request never pauses another backup by mistake.
+func (h backupControlHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	task := runningBackup.Load()
+	if task == nil || task.id != req.URL.Query().Get(flagTaskID) {
+		http.Error(w, "the backup task isn't running", http.StatusNotFound)
+		return
+	}
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		if h.pause {
+			// the progress shouldn't fail to be persisted because the request is canceled.
+			ctx, cancel := context.WithTimeout(context.Background(), backupControlTimeout)
+			defer cancel()
+			task.client.Pause(ctx)
+		} else {
+			task.client.Resume()
+		}
+	default:
+		http.Error(w, "only GET and POST are supported", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(backupTaskState{TaskID: task.id, State: task.client.State()})
+}
+
+// BackupControlConfig is the configuration of `br backup pause` and `br backup resume`.
+type BackupControlConfig struct {
+	TaskID string `json:"task-id" toml:"task-id"`
+	// Addr is the status address of the br running the backup.
+	Addr string    `json:"addr" toml:"addr"`
+	TLS  TLSConfig `json:"tls" toml:"tls"`
+}
+
+// DefineBackupControlFlags defines flags used for `br backup pause` and `br backup resume`.
+func DefineBackupControlFlags(flags *pflag.FlagSet) {
+	flags.String(flagBackupAddr, "", "the status address of the br running the backup, i.e. its --status-addr")
+}
+
+// ParseFromFlags parses the config from the flag set.
+func (cfg *BackupControlConfig) ParseFromFlags(flags *pflag.FlagSet) error {
+	var err error
+	if cfg.TaskID, err = flags.GetString(flagTaskID); err != nil {
+		return errors.Trace(err)
+	}
+	if len(cfg.TaskID) == 0 {
+		return errors.Annotatef(berrors.ErrInvalidArgument, "--%s is required", flagTaskID)
+	}
+	if cfg.Addr, err = flags.GetString(flagBackupAddr); err != nil {
+		return errors.Trace(err)
+	}
+	if len(cfg.Addr) == 0 {
+		return errors.Annotatef(berrors.ErrInvalidArgument, "--%s is required", flagBackupAddr)
+	}
+	return cfg.TLS.ParseFromFlags(flags)
+}
+
+// RunBackupControl pauses or resumes the running backup through the status
+// address of the br running it. The paused backup stops backing up the new
+// ranges and persists its progress to the checkpoint, so it can be resumed
+// even if the br is killed, by running the backup with the same task ID.
+func RunBackupControl(c context.Context, g glue.Glue, cfg *BackupControlConfig, pause bool) error {
+	ctx, cancel := context.WithTimeout(c, backupControlTimeout)
+	defer cancel()
+
+	client := &http.Client{}
+	scheme := "http"
+	if cfg.TLS.IsEnabled() {
+		tlsConfig, err := cfg.TLS.ToTLSConfig()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		client.Transport = &http.Transport{TLSClientConfig: tlsConfig}
+		scheme = "https"
+	}
+	path := BackupResumePath
+	if pause {
+		path = BackupPausePath
+	}
+	u := fmt.Sprintf("%s://%s%s?%s=%s", scheme, cfg.Addr, path, flagTaskID, url.QueryEscape(cfg.TaskID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Annotatef(err, "failed to connect to the br at %s", cfg.Addr)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Annotatef(berrors.ErrInvalidArgument, "failed to control the backup %s: %s", cfg.TaskID, body)
+	}
+	var state backupTaskState
+	if err := json.Unmarshal(body, &state); err != nil {
+		return errors.Annotatef(err, "invalid response %s", body)
+	}
+	glue.GetConsole(g).Printf("the backup task %s is %s\n", state.TaskID, state.State)
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package task
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tidb/br/pkg/backup"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPauseAndResumeBackup(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle(BackupPausePath, BackupPauseHandler())
+	mux.Handle(BackupResumePath, BackupResumeHandler())
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	ctx := context.Background()
+	cfg := &BackupControlConfig{TaskID: "task", Addr: strings.TrimPrefix(server.URL, "http://")}
+	require.ErrorContains(t, RunBackupControl(ctx, nil, cfg, true), "isn't running")
+
+	client := &backup.Client{}
+	runningBackup.Store(&backupTask{id: "task", client: client})
+	defer runningBackup.Store(nil)
+	require.NoError(t, RunBackupControl(ctx, nil, cfg, true))
+	require.Equal(t, backup.BackupPaused, client.State())
+	require.NoError(t, RunBackupControl(ctx, nil, cfg, false))
+	require.Equal(t, backup.BackupRunning, client.State())
+
+	// the request to another task is rejected.
+	cfg.TaskID = "another"
+	require.Error(t, RunBackupControl(ctx, nil, cfg, true))
+	require.Equal(t, backup.BackupRunning, client.State())
+}
@@ -82,9 +82,12 @@ func (cfg *RawKvConfig) ParseFromFlags(flags *pflag.FlagSet) error {
 	if len(cfg.StartKey) > 0 && len(cfg.EndKey) > 0 && bytes.Compare(cfg.StartKey, cfg.EndKey) >= 0 {
 		return errors.Annotate(berrors.ErrBackupInvalidRange, "endKey must be greater than startKey")
 	}
-	cfg.CF, err = flags.GetString(flagTiKVColumnFamily)
-	if err != nil {
-		return errors.Trace(err)
+	// the txn kv restore has no column family to specify.
+	if flags.Lookup(flagTiKVColumnFamily) != nil {
+		cfg.CF, err = flags.GetString(flagTiKVColumnFamily)
+		if err != nil {
+			return errors.Trace(err)
+		}
 	}
 	if err = cfg.Config.ParseFromFlags(flags); err != nil {
 		return errors.Trace(err)
@@ -148,11 +151,11 @@ func RunBackupRaw(c context.Context, g glue.Glue, cmdName string, cfg *RawKvConf
 	if err != nil {
 		return errors.Trace(err)
 	}
-	opts := storage.ExternalStorageOptions{
-		NoCredentials:   cfg.NoCreds,
-		SendCredentials: cfg.SendCreds,
+	opts := storageOpts(&cfg.Config)
+	if err = client.SetStorage(ctx, u, opts); err != nil {
+		return errors.Trace(err)
 	}
-	if err = client.SetStorage(ctx, u, &opts); err != nil {
+	if err = cfg.generateDataKey(ctx, client.GetStorage()); err != nil {
 		return errors.Trace(err)
 	}
 
@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/log"
 	"github.com/pingcap/tidb/br/pkg/conn"
 	"github.com/pingcap/tidb/br/pkg/conn/util"
+	"github.com/pingcap/tidb/br/pkg/envelope"
 	berrors "github.com/pingcap/tidb/br/pkg/errors"
 	"github.com/pingcap/tidb/br/pkg/glue"
 	"github.com/pingcap/tidb/br/pkg/metautil"
@@ -76,6 +77,7 @@ const (
 	flagEnableOpenTracing = "enable-opentracing"
 	flagSkipCheckPath     = "skip-check-path"
 	flagWithSysTable      = "with-sys-table"
+	flagStorageChecksum   = "storage-checksum"
 
 	defaultSwitchInterval       = 5 * time.Minute
 	defaultGRPCKeepaliveTime    = 10 * time.Second
@@ -84,6 +86,7 @@ const (
 	flagCipherType    = "crypter.method"
 	flagCipherKey     = "crypter.key"
 	flagCipherKeyFile = "crypter.key-file"
+	flagMasterKey     = "crypter.master-key"
 
 	unlimited           = 0
 	crypterAES128KeyLen = 16
@@ -210,6 +213,12 @@ type Config struct {
 	GRPCKeepaliveTimeout time.Duration `json:"grpc-keepalive-timeout" toml:"grpc-keepalive-timeout"`
 
 	CipherInfo backuppb.CipherInfo `json:"-" toml:"-"`
+	// MasterKey is the URI of the master key wrapping the data key generated
+	// for each backup, it's used instead of the crypter key if specified.
+	MasterKey string `json:"master-key" toml:"master-key"`
+	// StorageChecksum writes the SHA256 checksums of the files written by BR to
+	// the storage, and verifies the files by them on reading.
+	StorageChecksum bool `json:"storage-checksum" toml:"storage-checksum"`
 
 	// whether there's explicit filter
 	ExplicitFilter bool `json:"-" toml:"-"`
@@ -224,7 +233,6 @@ func DefineCommonFlags(flags *pflag.FlagSet) {
 	flags.String(flagCert, "", "Certificate path for TLS connection")
 	flags.String(flagKey, "", "Private key path for TLS connection")
 	flags.Uint(flagChecksumConcurrency, variable.DefChecksumTableConcurrency, "The concurrency of table checksumming")
-	_ = flags.MarkHidden(flagChecksumConcurrency)
 
 	flags.Uint64(flagRateLimit, unlimited, "The rate limit of the task, MB/s per node")
 	flags.Bool(flagChecksum, true, "Run checksum at end of task")
@@ -254,6 +262,9 @@ func DefineCommonFlags(flags *pflag.FlagSet) {
 
 	flags.Bool(flagEnableOpenTracing, false,
 		"Set whether to enable opentracing during the backup/restore process")
+	flags.Bool(flagStorageChecksum, false,
+		"Write the sha256 checksums of the files written by BR to the storage, and verify the files by them on reading, "+
+			"the files written by TiKV are verified by the checksums in the backup meta")
 
 	flags.BoolP(flagNoCreds, "", false, "Don't load credentials")
 	_ = flags.MarkHidden(flagNoCreds)
@@ -267,6 +278,9 @@ func DefineCommonFlags(flags *pflag.FlagSet) {
 		"aes-crypter key, used to encrypt/decrypt the data "+
 			"by the hexadecimal string, eg: \"0123456789abcdef0123456789abcdef\"")
 	flags.String(flagCipherKeyFile, "", "FilePath, its content is used as the cipher-key")
+	flags.String(flagMasterKey, "", "the master key wrapping the data key generated for each backup, "+
+		"it's used instead of the cipher-key, be one of local:///path/to/key-file, "+
+		"aws-kms:///key-id?region=..., gcp-kms:///projects/.../cryptoKeys/...")
 
 	storage.DefineFlags(flags)
 }
@@ -281,6 +295,7 @@ func HiddenFlagsForStream(flags *pflag.FlagSet) {
 	_ = flags.MarkHidden(flagCipherType)
 	_ = flags.MarkHidden(flagCipherKey)
 	_ = flags.MarkHidden(flagCipherKeyFile)
+	_ = flags.MarkHidden(flagMasterKey)
 	_ = flags.MarkHidden(flagSwitchModeInterval)
 
 	storage.HiddenFlagsForStream(flags)
@@ -400,7 +415,15 @@ func (cfg *Config) parseCipherInfo(flags *pflag.FlagSet) error {
 		return errors.Trace(err)
 	}
 
+	cfg.MasterKey, err = flags.GetString(flagMasterKey)
+	if err != nil {
+		return errors.Trace(err)
+	}
 	if cfg.CipherInfo.CipherType == encryptionpb.EncryptionMethod_PLAINTEXT {
+		if len(cfg.MasterKey) > 0 {
+			return errors.Annotatef(berrors.ErrInvalidArgument,
+				"--%s requires an encryption method other than plaintext", flagMasterKey)
+		}
 		return nil
 	}
 
@@ -413,6 +436,14 @@ func (cfg *Config) parseCipherInfo(flags *pflag.FlagSet) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if len(cfg.MasterKey) > 0 {
+		// the data key is generated when backing up, or unwrapped when restoring.
+		if len(key) > 0 || len(keyFilePath) > 0 {
+			return errors.Annotatef(berrors.ErrInvalidArgument,
+				"--%s can't be used with --%s or --%s", flagMasterKey, flagCipherKey, flagCipherKeyFile)
+		}
+		return nil
+	}
 
 	cfg.CipherInfo.CipherKey, err = getCipherKeyContent(key, keyFilePath)
 	if err != nil {
@@ -426,6 +457,50 @@ func (cfg *Config) parseCipherInfo(flags *pflag.FlagSet) error {
 	return nil
 }
 
+// generateDataKey generates the data key of the backup by the master key, and
+// writes the wrapped data key to the storage.
+func (cfg *Config) generateDataKey(ctx context.Context, s storage.ExternalStorage) error {
+	if len(cfg.MasterKey) == 0 {
+		return nil
+	}
+	mk, err := envelope.NewMasterKey(ctx, cfg.MasterKey)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	info, dataKey, err := envelope.GenerateDataKey(ctx, cfg.CipherInfo.CipherType, mk)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err = dataKey.Write(ctx, s); err != nil {
+		return errors.Trace(err)
+	}
+	log.Info("generate the data key of the backup",
+		zap.String("master-key", mk.Kind()), zap.String("master-key-id", mk.ID()))
+	cfg.CipherInfo = *info
+	return nil
+}
+
+// openDataKey unwraps the data key of the backup by the master key.
+func (cfg *Config) openDataKey(ctx context.Context, s storage.ExternalStorage) error {
+	if len(cfg.MasterKey) == 0 || len(cfg.CipherInfo.CipherKey) > 0 {
+		return nil
+	}
+	mk, err := envelope.NewMasterKey(ctx, cfg.MasterKey)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	dataKey, err := envelope.ReadDataKey(ctx, s)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	info, err := dataKey.Open(ctx, mk)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	cfg.CipherInfo = *info
+	return nil
+}
+
 func (cfg *Config) normalizePDURLs() error {
 	for i := range cfg.PD {
 		var err error
@@ -529,6 +604,10 @@ func (cfg *Config) ParseFromFlags(flags *pflag.FlagSet) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	cfg.StorageChecksum, err = flags.GetBool(flagStorageChecksum)
+	if err != nil {
+		return errors.Trace(err)
+	}
 
 	if cfg.SwitchModeInterval <= 0 {
 		return errors.Annotatef(berrors.ErrInvalidArgument, "--switch-mode-interval must be positive, %s is not allowed", cfg.SwitchModeInterval)
@@ -615,10 +694,21 @@ func GetStorage(
 }
 
 func storageOpts(cfg *Config) *storage.ExternalStorageOptions {
-	return &storage.ExternalStorageOptions{
-		NoCredentials:   cfg.NoCreds,
-		SendCredentials: cfg.SendCreds,
+	opts := &storage.ExternalStorageOptions{
+		NoCredentials:         cfg.NoCreds,
+		SendCredentials:       cfg.SendCreds,
+		AzureSASTokenProvider: cfg.BackendOptions.Azblob.SASTokenProvider(),
+		HDFSOptions:           &cfg.BackendOptions.HDFS,
+		Checksum:              cfg.StorageChecksum,
 	}
+	// the options have been checked on parsing the backend.
+	if policy, err := cfg.BackendOptions.Azblob.ImmutabilityPolicy(); err == nil {
+		opts.AzureImmutabilityPolicy = policy
+	}
+	if upload, err := cfg.BackendOptions.S3.UploadOptions(); err == nil {
+		opts.S3Upload = upload
+	}
+	return opts
 }
 
 // ReadBackupMeta reads the backupmeta file from the storage.
@@ -655,6 +745,10 @@ func ReadBackupMeta(
 		}
 	}
 
+	if err = cfg.openDataKey(ctx, s); err != nil {
+		return nil, nil, nil, errors.Trace(err)
+	}
+
 	// the prefix of backupmeta file is iv(16 bytes) if encryption method is valid
 	var iv []byte
 	if cfg.CipherInfo.CipherType != encryptionpb.EncryptionMethod_PLAINTEXT {
@@ -3,12 +3,17 @@
 package task
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	backup "github.com/pingcap/kvproto/pkg/brpb"
 	"github.com/pingcap/kvproto/pkg/encryptionpb"
+	"github.com/pingcap/tidb/br/pkg/storage"
 	"github.com/pingcap/tidb/config"
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/require"
@@ -157,3 +162,30 @@ func TestCheckCipherKey(t *testing.T) {
 		}
 	}
 }
+
+func TestDataKeyByMasterKey(t *testing.T) {
+	ctx := context.Background()
+	keyFile := filepath.Join(t.TempDir(), "master.key")
+	require.NoError(t, os.WriteFile(keyFile, []byte(strings.Repeat("0f", 32)), 0o600))
+	s, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	backupCfg := &Config{MasterKey: "local://" + keyFile}
+	backupCfg.CipherInfo.CipherType = encryptionpb.EncryptionMethod_AES256_CTR
+	require.NoError(t, backupCfg.generateDataKey(ctx, s))
+	require.Len(t, backupCfg.CipherInfo.CipherKey, 32)
+
+	restoreCfg := &Config{MasterKey: "local://" + keyFile}
+	restoreCfg.CipherInfo.CipherType = encryptionpb.EncryptionMethod_AES256_CTR
+	require.NoError(t, restoreCfg.openDataKey(ctx, s))
+	require.Equal(t, backupCfg.CipherInfo, restoreCfg.CipherInfo)
+
+	// the crypter key can't be used with the master key.
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	DefineCommonFlags(flags)
+	require.NoError(t, flags.Set(flagCipherType, "aes256-ctr"))
+	require.NoError(t, flags.Set(flagMasterKey, "local://"+keyFile))
+	require.NoError(t, flags.Set(flagCipherKey, strings.Repeat("0f", 32)))
+	cfg := &Config{}
+	require.Error(t, cfg.parseCipherInfo(flags))
+}
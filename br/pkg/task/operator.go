@@ -0,0 +1,156 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package task
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/conn"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/glue"
+	"github.com/pingcap/tidb/br/pkg/pdutil"
+	"github.com/pingcap/tidb/br/pkg/summary"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/spf13/pflag"
+	"go.uber.org/zap"
+)
+
+const (
+	flagPauseLease     = "lease"
+	flagPauseStateFile = "pause-state-file"
+	flagRecoverOnly    = "recover-only"
+)
+
+// PauseGcAndSchedulersConfig is the configuration of `operator pause-gc-and-schedulers`.
+type PauseGcAndSchedulersConfig struct {
+	Config
+
+	// Lease is the lease of the GC safepoint, paused schedulers and configs.
+	// They are restored by PD once the lease expired if BR exited unexpectedly.
+	Lease time.Duration `json:"lease" toml:"lease"`
+	// StateFile persists the origin configs, which will be restored in the next run
+	// if BR crashed. It's in the home directory if it's empty.
+	StateFile string `json:"pause-state-file" toml:"pause-state-file"`
+	// RecoverOnly only restores the configs left by a crashed run.
+	RecoverOnly bool `json:"recover-only" toml:"recover-only"`
+}
+
+// DefineOperatorPauseFlags defines flags used for `operator pause-gc-and-schedulers`.
+func DefineOperatorPauseFlags(flags *pflag.FlagSet) {
+	flags.Duration(flagPauseLease, pdutil.DefaultPauseLease,
+		"The lease of the GC safepoint and the paused schedulers, "+
+			"they are restored once the lease expired if BR exited unexpectedly.")
+	definePauseStateFileFlag(flags)
+	flags.Bool(flagRecoverOnly, false,
+		"Only restore the PD configs left by a crashed run, then exit.")
+}
+
+func definePauseStateFileFlag(flags *pflag.FlagSet) {
+	flags.String(flagPauseStateFile, "",
+		"The file to persist the origin PD configs, which are restored by the next run if BR crashed. "+
+			"(default \"~/.br/pause-state-<cluster id>.json\")")
+}
+
+// resolvePauseStateFile returns the file to persist the pause state. It's in the
+// home directory and keyed by the cluster if it isn't specified, so it survives
+// the reboot and isn't shared by the clusters.
+func resolvePauseStateFile(path string, clusterID uint64) (string, error) {
+	if path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", errors.Annotatef(berrors.ErrInvalidArgument,
+			"failed to locate the home directory, please specify --%s: %v", flagPauseStateFile, err)
+	}
+	return filepath.Join(home, ".br", fmt.Sprintf("pause-state-%d.json", clusterID)), nil
+}
+
+// ParseFromFlags parses the config from the flag set.
+func (cfg *PauseGcAndSchedulersConfig) ParseFromFlags(flags *pflag.FlagSet) error {
+	var err error
+	if cfg.Lease, err = flags.GetDuration(flagPauseLease); err != nil {
+		return errors.Trace(err)
+	}
+	if cfg.Lease < 3*time.Second {
+		return errors.Annotatef(berrors.ErrInvalidArgument, "the lease %s is too short, it should be at least 3s", cfg.Lease)
+	}
+	if cfg.StateFile, err = flags.GetString(flagPauseStateFile); err != nil {
+		return errors.Trace(err)
+	}
+	if cfg.RecoverOnly, err = flags.GetBool(flagRecoverOnly); err != nil {
+		return errors.Trace(err)
+	}
+	return cfg.Config.ParseFromFlags(flags)
+}
+
+// RunPauseGcAndSchedulers pauses GC and the schedulers that may slow down BR,
+// and keeps them paused until ctx is done. It's used by the external tools which
+// do their own backup or restore.
+func RunPauseGcAndSchedulers(c context.Context, g glue.Glue, cmdName string, cfg *PauseGcAndSchedulersConfig) error {
+	ctx, cancel := context.WithCancel(c)
+	defer cancel()
+
+	mgr, err := NewMgr(ctx, g, cfg.PD, cfg.TLS, GetKeepalive(&cfg.Config), cfg.CheckRequirements, false, conn.NormalVersionChecker)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer mgr.Close()
+
+	stateFile, err := resolvePauseStateFile(cfg.StateFile, mgr.GetPDClient().GetClusterID(ctx))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	pauser := mgr.NewSchedulerPauser(cfg.Lease, pdutil.FilePauseStateStore{Path: stateFile})
+	if cfg.RecoverOnly {
+		recovered, err := pauser.Recover(ctx)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		log.Info("recover the PD configs paused by previous run", zap.Bool("recovered", recovered))
+		summary.Log(cmdName, zap.Bool("recovered", recovered))
+		return nil
+	}
+
+	ts, err := mgr.GetTS(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	sp := utils.BRServiceSafePoint{
+		ID:       utils.MakeSafePointID(),
+		TTL:      int64(cfg.Lease.Seconds()),
+		BackupTS: ts,
+	}
+	if err := utils.StartServiceSafePointKeeper(ctx, mgr.GetPDClient(), sp); err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		// Remove the service safepoint, use a new context because ctx may be canceled.
+		sp.TTL = 0
+		if err := utils.UpdateServiceSafePoint(context.Background(), mgr.GetPDClient(), sp); err != nil {
+			log.Warn("failed to remove service safe point, it will be removed once the lease expired", zap.Error(err))
+		}
+	}()
+
+	state, err := pauser.Pause(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		if err := pauser.Resume(context.Background()); err != nil {
+			log.Warn("failed to resume schedulers, they will be resumed once the lease expired", zap.Error(err))
+		}
+	}()
+
+	log.Info("GC and schedulers paused, waiting for exit",
+		zap.Object("safePoint", sp), zap.Strings("schedulers", state.Modified.Schedulers))
+	<-ctx.Done()
+	summary.Log(cmdName, zap.Uint64("safe-point", ts), zap.Strings("schedulers", state.Modified.Schedulers))
+	return nil
+}
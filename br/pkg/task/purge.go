@@ -0,0 +1,224 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/backup"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/glue"
+	"github.com/pingcap/tidb/br/pkg/logutil"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/br/pkg/stream"
+	"github.com/spf13/pflag"
+	"go.uber.org/zap"
+)
+
+const (
+	flagKeepFulls   = "keep-fulls"
+	flagKeepLogDays = "keep-log-days"
+)
+
+// PurgeConfig is the configuration of `br purge`.
+type PurgeConfig struct {
+	Config
+
+	// KeepFulls is the number of the latest full backups kept with their
+	// incremental backups.
+	KeepFulls int `json:"keep-fulls" toml:"keep-fulls"`
+	// KeepLogDays is the days of the log backups kept, 0 means forever.
+	KeepLogDays int  `json:"keep-log-days" toml:"keep-log-days"`
+	DryRun      bool `json:"dry-run" toml:"dry-run"`
+	SkipPrompt  bool `json:"skip-prompt" toml:"skip-prompt"`
+}
+
+// DefinePurgeFlags defines flags used for `br purge`.
+func DefinePurgeFlags(flags *pflag.FlagSet) {
+	flags.Int(flagKeepFulls, 7, "The number of the latest full backups kept with their incremental backups.")
+	flags.Int(flagKeepLogDays, 0, "The days of the log backups kept, the logs needed by the full backups kept "+
+		"are never truncated. 0 means the log backups are kept forever.")
+	flags.Bool(flagDryRun, false, "Print the backups to purge but don't really delete them.")
+	flags.BoolP(flagYes, "y", false, "Skip all prompts and always execute the command.")
+}
+
+// ParseFromFlags parses the config from the flag set.
+func (cfg *PurgeConfig) ParseFromFlags(flags *pflag.FlagSet) error {
+	var err error
+	if cfg.KeepFulls, err = flags.GetInt(flagKeepFulls); err != nil {
+		return errors.Trace(err)
+	}
+	if cfg.KeepFulls < 1 {
+		return errors.Annotate(berrors.ErrInvalidArgument, "at least 1 full backup should be kept")
+	}
+	if cfg.KeepLogDays, err = flags.GetInt(flagKeepLogDays); err != nil {
+		return errors.Trace(err)
+	}
+	if cfg.KeepLogDays < 0 {
+		return errors.Annotatef(berrors.ErrInvalidArgument, "invalid --%s %d", flagKeepLogDays, cfg.KeepLogDays)
+	}
+	if cfg.DryRun, err = flags.GetBool(flagDryRun); err != nil {
+		return errors.Trace(err)
+	}
+	if cfg.SkipPrompt, err = flags.GetBool(flagYes); err != nil {
+		return errors.Trace(err)
+	}
+	return cfg.Config.ParseFromFlags(flags)
+}
+
+// RunPurge deletes the expired backups under the storage prefix by the
+// retention policy, the full, incremental and log backups are recognized by
+// their backupmeta, and the restore chains are never broken.
+func RunPurge(c context.Context, g glue.Glue, cmdName string, cfg *PurgeConfig) error {
+	ctx, cancel := context.WithCancel(c)
+	defer cancel()
+
+	_, s, err := GetStorage(ctx, cfg.Storage, &cfg.Config)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	entries, err := listBackups(ctx, s, cfg)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	plan := backup.PlanPurge(entries, backup.RetentionPolicy{
+		KeepFulls: cfg.KeepFulls,
+		KeepLogs:  time.Duration(cfg.KeepLogDays) * 24 * time.Hour,
+	}, time.Now())
+	data, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	console := glue.GetConsole(g)
+	console.Println(string(data))
+	if cfg.DryRun || len(plan.Expired)+len(plan.LogTruncations) == 0 {
+		return nil
+	}
+	if !cfg.SkipPrompt && !console.PromptBool("Purge the expired backups? ") {
+		return nil
+	}
+
+	for _, e := range plan.Expired {
+		if err := deleteBackup(ctx, s, e.Path); err != nil {
+			return errors.Annotatef(err, "failed to delete the backup %s", e.Path)
+		}
+		log.Info("purge the expired backup", zap.String("path", e.Path), zap.String("kind", string(e.Kind)),
+			zap.Uint64("end-version", e.EndVersion))
+	}
+	for _, t := range plan.LogTruncations {
+		logCfg := &StreamConfig{Config: cfg.Config, Until: t.Until, SkipPrompt: true}
+		logCfg.Storage = joinStorageURI(cfg.Storage, t.Path)
+		if err := RunStreamTruncate(ctx, g, cmdName, logCfg); err != nil {
+			return errors.Annotatef(err, "failed to truncate the log backup %s", t.Path)
+		}
+	}
+	return nil
+}
+
+// listBackups finds the backups under the storage prefix, a directory with a
+// backupmeta is a snapshot backup, unless it has the metadata of log backup.
+func listBackups(ctx context.Context, s storage.ExternalStorage, cfg *PurgeConfig) ([]*backup.BackupEntry, error) {
+	snapshotDirs := make(map[string]struct{})
+	logDirs := make(map[string]struct{})
+	logMetaDir := "/" + stream.GetStreamBackupMetaPrefix() + "/"
+	err := s.WalkDir(ctx, &storage.WalkOption{}, func(p string, _ int64) error {
+		p = "/" + strings.TrimPrefix(p, "/")
+		if i := strings.Index(p, logMetaDir); i >= 0 {
+			logDirs[p[:i]] = struct{}{}
+		} else if path.Base(p) == metautil.MetaFile {
+			snapshotDirs[path.Dir(p)] = struct{}{}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to list the backups")
+	}
+	if _, ok := snapshotDirs["/"]; ok {
+		return nil, errors.Annotate(berrors.ErrInvalidArgument,
+			"the storage is a backup, please specify the prefix of the backups")
+	}
+	if _, ok := logDirs[""]; ok {
+		return nil, errors.Annotate(berrors.ErrInvalidArgument,
+			"the storage is a log backup, please specify the prefix of the backups")
+	}
+
+	entries := make([]*backup.BackupEntry, 0, len(snapshotDirs))
+	for dir := range logDirs {
+		delete(snapshotDirs, dir)
+		dir = strings.TrimPrefix(dir, "/")
+		logCfg := cfg.Config
+		logCfg.Storage = joinStorageURI(cfg.Storage, dir)
+		minTS, maxTS, err := getLogRange(ctx, &logCfg)
+		if err != nil {
+			return nil, errors.Annotatef(err, "failed to read the log backup %s", dir)
+		}
+		entries = append(entries, &backup.BackupEntry{
+			Path: dir, Kind: backup.LogBackup, StartVersion: minTS, EndVersion: maxTS,
+		})
+	}
+	for dir := range snapshotDirs {
+		dir = strings.TrimPrefix(dir, "/")
+		metaCfg := cfg.Config
+		metaCfg.Storage = joinStorageURI(cfg.Storage, dir)
+		_, _, backupMeta, err := ReadBackupMeta(ctx, metautil.MetaFile, &metaCfg)
+		if err != nil {
+			return nil, errors.Annotatef(err, "failed to read the backup %s", dir)
+		}
+		if backupMeta.IsRawKv {
+			log.Info("skip the raw kv backup", zap.String("path", dir))
+			continue
+		}
+		e := &backup.BackupEntry{
+			Path: dir, Kind: backup.FullBackup, StartVersion: backupMeta.StartVersion, EndVersion: backupMeta.EndVersion,
+		}
+		if e.StartVersion > 0 {
+			e.Kind = backup.IncrementalBackup
+		}
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Path < entries[j].Path })
+	return entries, nil
+}
+
+// deleteBackup deletes the files of the snapshot backup. The backupmeta is
+// deleted first, so a partly deleted backup is never taken as a valid one.
+func deleteBackup(ctx context.Context, s storage.ExternalStorage, dir string) error {
+	if err := s.DeleteFile(ctx, path.Join(dir, metautil.MetaFile)); err != nil {
+		return errors.Trace(err)
+	}
+	var files []string
+	err := s.WalkDir(ctx, &storage.WalkOption{SubDir: dir + "/"}, func(p string, _ int64) error {
+		files = append(files, p)
+		return nil
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, f := range files {
+		if err := s.DeleteFile(ctx, f); err != nil {
+			log.Warn("failed to delete the file, you may delete it manually", zap.String("path", f),
+				logutil.ShortError(err))
+		}
+	}
+	return nil
+}
+
+// joinStorageURI returns the URI of the directory in the storage, the options
+// in the query are kept.
+func joinStorageURI(uri, dir string) string {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return uri
+	}
+	u.Path = path.Join(u.Path, dir)
+	return u.String()
+}
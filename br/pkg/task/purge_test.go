@@ -0,0 +1,61 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package task
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	"github.com/pingcap/kvproto/pkg/encryptionpb"
+	"github.com/pingcap/tidb/br/pkg/backup"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAndDeleteBackups(t *testing.T) {
+	ctx := context.Background()
+	base := t.TempDir()
+	s, err := storage.NewLocalStorage(base)
+	require.NoError(t, err)
+	writeMeta := func(dir string, meta *backuppb.BackupMeta) {
+		data, err := meta.Marshal()
+		require.NoError(t, err)
+		require.NoError(t, s.WriteFile(ctx, path.Join(dir, metautil.MetaFile), data))
+	}
+	writeMeta("full", &backuppb.BackupMeta{EndVersion: 100})
+	require.NoError(t, s.WriteFile(ctx, "full/1.sst", []byte("data")))
+	writeMeta("daily/inc", &backuppb.BackupMeta{StartVersion: 100, EndVersion: 200})
+	writeMeta("raw", &backuppb.BackupMeta{EndVersion: 100, IsRawKv: true})
+	writeMeta("log", &backuppb.BackupMeta{StartVersion: 50})
+	require.NoError(t, s.WriteFile(ctx, "log/v1/backupmeta/1.meta", []byte{}))
+
+	cfg := &PurgeConfig{Config: Config{Storage: "local://" + base}}
+	cfg.CipherInfo.CipherType = encryptionpb.EncryptionMethod_PLAINTEXT
+	entries, err := listBackups(ctx, s, cfg)
+	require.NoError(t, err)
+	require.Equal(t, []*backup.BackupEntry{
+		{Path: "daily/inc", Kind: backup.IncrementalBackup, StartVersion: 100, EndVersion: 200},
+		{Path: "full", Kind: backup.FullBackup, EndVersion: 100},
+		{Path: "log", Kind: backup.LogBackup, StartVersion: 50, EndVersion: 50},
+	}, entries)
+
+	require.NoError(t, deleteBackup(ctx, s, "full"))
+	for _, name := range []string{"full/backupmeta", "full/1.sst"} {
+		exists, err := s.FileExists(ctx, name)
+		require.NoError(t, err)
+		require.False(t, exists)
+	}
+	exists, err := s.FileExists(ctx, "daily/inc/backupmeta")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	// the storage should be the prefix of the backups rather than a backup.
+	logStorage, err := storage.NewLocalStorage(path.Join(base, "log"))
+	require.NoError(t, err)
+	_, err = listBackups(ctx, logStorage, cfg)
+	require.Error(t, err)
+	require.Equal(t, "local://"+base+"/log/inc?a=b", joinStorageURI("local://"+base+"/log?a=b", "inc"))
+}
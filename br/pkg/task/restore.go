@@ -4,9 +4,12 @@ package task
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
+	"github.com/docker/go-units"
 	"github.com/opentracing/opentracing-go"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
@@ -19,13 +22,17 @@ import (
 	"github.com/pingcap/tidb/br/pkg/logutil"
 	"github.com/pingcap/tidb/br/pkg/metautil"
 	"github.com/pingcap/tidb/br/pkg/pdutil"
+	"github.com/pingcap/tidb/br/pkg/progress"
 	"github.com/pingcap/tidb/br/pkg/restore"
 	"github.com/pingcap/tidb/br/pkg/restore/tiflashrec"
+	"github.com/pingcap/tidb/br/pkg/storage"
 	"github.com/pingcap/tidb/br/pkg/summary"
 	"github.com/pingcap/tidb/br/pkg/utils"
 	"github.com/pingcap/tidb/br/pkg/version"
 	"github.com/pingcap/tidb/config"
+	"github.com/pingcap/tidb/domain"
 	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
 	"github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/mathutil"
 	"github.com/spf13/cobra"
@@ -44,10 +51,71 @@ const (
 	FlagMergeRegionKeyCount = "merge-region-key-count"
 	// FlagPDConcurrency controls concurrency pd-relative operations like split & scatter.
 	FlagPDConcurrency = "pd-concurrency"
+	// FlagChecksumReport prints the report of verifying the checksums of the restored tables, in json or table.
+	FlagChecksumReport = "checksum-report"
+	// FlagChecksumReverifyTimes is the times to verify the checksums of the tables mismatched again.
+	FlagChecksumReverifyTimes = "checksum-reverify-times"
+	// FlagPipelineDepth controls how many batches the regions are split and scattered for ahead of restoring the files.
+	FlagPipelineDepth = "pipeline-depth"
 	// FlagBatchFlushInterval controls after how long the restore batch would be auto sended.
 	FlagBatchFlushInterval = "batch-flush-interval"
 	// FlagDdlBatchSize controls batch ddl size to create a batch of tables
 	FlagDdlBatchSize = "ddl-batch-size"
+	// FlagDDLConcurrency controls the number of the sessions to create tables concurrently.
+	FlagDDLConcurrency = "ddl-concurrency"
+	// FlagResume controls whether to resume the restore from the checkpoint of the previous one.
+	FlagResume = "resume"
+	// FlagFilterSizeGT and FlagFilterSizeLT filter the tables to restore by their sizes in the backup.
+	FlagFilterSizeGT = "filter-size-gt"
+	FlagFilterSizeLT = "filter-size-lt"
+	// FlagFilterRowsGT and FlagFilterRowsLT filter the tables to restore by their estimated row counts.
+	FlagFilterRowsGT = "filter-rows-gt"
+	FlagFilterRowsLT = "filter-rows-lt"
+	// FlagRenameTables restores the tables with new names, in the format of old_db.old_tbl:new_db.new_tbl.
+	FlagRenameTables = "rename-tables"
+	// FlagPartition restores only the partition of the table, and exchanges it into the existing table.
+	FlagPartition = "partition"
+	// FlagTableRateLimit limits the speed of restoring the tables or the databases, e.g. db.tbl=50MB or db=100MB.
+	FlagTableRateLimit = "table-ratelimit"
+	// FlagTableConcurrency caps the ranges of a table ingested concurrently, so a slow table can't occupy all the
+	// workers of the restore.
+	FlagTableConcurrency = "table-concurrency"
+	// FlagDryRun performs the pre-checks and reports the plan of the restore without importing any data.
+	FlagDryRun = "dry-run"
+	// FlagRecordProgress records the progress of the restore to the progress table of the cluster.
+	FlagRecordProgress = "record-progress"
+	// FlagTargetKeyspaceID restores the data into the keyspace.
+	FlagTargetKeyspaceID = "target-keyspace-id"
+	// FlagSourceKeyspaceID is the keyspace the backup is taken from, it's unset if the backup is taken
+	// from a cluster without keyspaces.
+	FlagSourceKeyspaceID = "source-keyspace-id"
+	// FlagSysTableMergePolicy merges the privilege tables in the backup into the existing ones by the policy.
+	FlagSysTableMergePolicy = "sys-table-merge-policy"
+	// FlagWithPlacementPolicies restores the placement policies and the placement of the tables and the partitions.
+	FlagWithPlacementPolicies = "with-placement-policy"
+	// FlagLoadStats loads the statistics in the backup into the restored tables.
+	FlagLoadStats = "load-stats"
+	// FlagAllowDDLDivergence continues the incremental restore when the DDL history of the cluster diverges from
+	// the backup, rather than aborting it.
+	FlagAllowDDLDivergence = "allow-ddl-divergence"
+	// FlagRegionSplitSize is the target size of the regions split before restoring, the small adjacent ranges are
+	// merged into the regions of the size.
+	FlagRegionSplitSize = "region-split-size"
+	// FlagDeferTiFlashReplica records the TiFlash replicas which can't be satisfied by the TiFlash stores of the
+	// cluster and sets them once enough TiFlash stores join, rather than dropping them.
+	FlagDeferTiFlashReplica = "defer-tiflash-replica"
+	// FlagTiFlashReplicaWaitTimeout is how long to wait for the TiFlash stores to join for the deferred replicas.
+	FlagTiFlashReplicaWaitTimeout = "tiflash-replica-wait-timeout"
+	// FlagCheckStores checks the free disk space, the import mode support, the SST importer version and the
+	// region count headroom of every TiKV store before restoring.
+	FlagCheckStores = "check-stores"
+	// FlagMaxStoreRegions is the most regions a TiKV store can hold after restoring.
+	FlagMaxStoreRegions = "max-store-regions"
+	// FlagGranularity is how the files are scheduled to restore, fine or coarse.
+	FlagGranularity = "granularity"
+	// FlagStorageMirror is the mirror storage of the backup, the SST files are downloaded from it when the
+	// storage is throttled or fails by the server.
+	FlagStorageMirror = "storage-mirror"
 	// FlagWithPlacementPolicy corresponds to tidb config with-tidb-placement-mode
 	// current only support STRICT or IGNORE, the default is STRICT according to tidb.
 	FlagWithPlacementPolicy = "with-tidb-placement-mode"
@@ -57,13 +125,20 @@ const (
 	FlagStreamRestoreTS = "restored-ts"
 	// FlagStreamFullBackupStorage is used for log restore, represents the full backup storage.
 	FlagStreamFullBackupStorage = "full-backup-storage"
+	// FlagStreamMetaKVBatchSize bounds the bytes of the meta kv files restored in a batch by log restore.
+	FlagStreamMetaKVBatchSize = "meta-kv-batch-size"
+	// FlagStreamPreview prints the DDL jobs the log restore would replay up to the restore ts before restoring.
+	FlagStreamPreview = "preview"
 
 	defaultRestoreConcurrency       = 128
 	defaultRestoreStreamConcurrency = 16
 	maxRestoreBatchSizeLimit        = 10240
 	defaultPDConcurrency            = 1
+	defaultPipelineDepth            = 4
+	defaultChecksumReverifyTimes    = 1
 	defaultBatchFlushInterval       = 16 * time.Second
 	defaultFlagDdlBatchSize         = 128
+	defaultDDLConcurrency           = 16
 	resetSpeedLimitRetryTimes       = 3
 )
 
@@ -73,6 +148,9 @@ const (
 	TableRestoreCmd = "Table Restore"
 	PointRestoreCmd = "Point Restore"
 	RawRestoreCmd   = "Raw Restore"
+	TxnRestoreCmd   = "Txn Restore"
+
+	TiFlashReplicaRestoreCmd = "TiFlash Replica Restore"
 )
 
 // RestoreCommonConfig is the common configuration for all BR restore tasks.
@@ -87,6 +165,12 @@ type RestoreCommonConfig struct {
 
 	// determines whether enable restore sys table on default, see fullClusterRestore in restore/client.go
 	WithSysTable bool `json:"with-sys-table" toml:"with-sys-table"`
+	// SysTableMergePolicy is how to merge mysql.user and mysql.db in the backup into the existing ones,
+	// it's one of skip, overwrite and error. They aren't merged if it's empty.
+	SysTableMergePolicy string `json:"sys-table-merge-policy" toml:"sys-table-merge-policy"`
+	// PauseStateFile persists the origin PD configs paused during restoring, which are restored by the next
+	// run if BR crashed. It's in the home directory if it's empty.
+	PauseStateFile string `json:"pause-state-file" toml:"pause-state-file"`
 }
 
 // adjust adjusts the abnormal config value in the current config.
@@ -111,11 +195,24 @@ func DefineRestoreCommonFlags(flags *pflag.FlagSet) {
 		"the threshold of merging small regions (Default 960_000, region split key count)")
 	flags.Uint(FlagPDConcurrency, defaultPDConcurrency,
 		"concurrency pd-relative operations like split & scatter.")
+	flags.Uint(FlagPipelineDepth, defaultPipelineDepth,
+		"the number of batches whose regions are split and scattered ahead of restoring the files, "+
+			"so splitting the next batches overlaps with restoring the current one")
+	flags.String(FlagChecksumReport, "",
+		"print the report of verifying the checksums of the restored tables and partitions, in json or table")
+	flags.Uint(FlagChecksumReverifyTimes, defaultChecksumReverifyTimes,
+		"the times to verify the checksums of only the tables mismatched again before failing the restore")
 	flags.Duration(FlagBatchFlushInterval, defaultBatchFlushInterval,
 		"after how long a restore batch would be auto sended.")
 	flags.Uint(FlagDdlBatchSize, defaultFlagDdlBatchSize,
 		"batch size for ddl to create a batch of tabes once.")
+	flags.Uint(FlagDDLConcurrency, defaultDDLConcurrency,
+		"the number of the sessions to create the tables without dependencies between them concurrently.")
 	flags.Bool(flagWithSysTable, false, "whether restore system privilege tables on default setting")
+	flags.String(FlagSysTableMergePolicy, "",
+		"merge the rows of mysql.user and mysql.db in the backup into the existing ones, even if the backup "+
+			"is from an older version. the policy of the conflicted rows is one of skip, overwrite and error")
+	definePauseStateFileFlag(flags)
 	_ = flags.MarkHidden(FlagMergeRegionSizeBytes)
 	_ = flags.MarkHidden(FlagMergeRegionKeyCount)
 	_ = flags.MarkHidden(FlagPDConcurrency)
@@ -144,6 +241,18 @@ func (cfg *RestoreCommonConfig) ParseFromFlags(flags *pflag.FlagSet) error {
 			return errors.Trace(err)
 		}
 	}
+	if flags.Lookup(FlagSysTableMergePolicy) != nil {
+		cfg.SysTableMergePolicy, err = flags.GetString(FlagSysTableMergePolicy)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if flags.Lookup(flagPauseStateFile) != nil {
+		cfg.PauseStateFile, err = flags.GetString(flagPauseStateFile)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
 	return errors.Trace(err)
 }
 
@@ -157,12 +266,75 @@ type RestoreConfig struct {
 	BatchFlushInterval time.Duration `json:"batch-flush-interval" toml:"batch-flush-interval"`
 	// DdlBatchSize use to define the size of batch ddl to create tables
 	DdlBatchSize uint `json:"ddl-batch-size" toml:"ddl-batch-size"`
+	// DDLConcurrency is the number of the sessions to create tables concurrently
+	DDLConcurrency uint `json:"ddl-concurrency" toml:"ddl-concurrency"`
+	// PipelineDepth is the number of batches whose regions are split ahead of restoring the files
+	PipelineDepth uint `json:"pipeline-depth" toml:"pipeline-depth"`
+	// ChecksumReport is the format of the checksum report printed after restoring, json or table, it isn't
+	// printed if it's empty
+	ChecksumReport string `json:"checksum-report" toml:"checksum-report"`
+	// ChecksumReverifyTimes is the times to verify the checksums of the tables mismatched again
+	ChecksumReverifyTimes uint `json:"checksum-reverify-times" toml:"checksum-reverify-times"`
+	// Resume determines whether to resume the restore from the checkpoint of the previous one
+	Resume bool `json:"resume" toml:"resume"`
+	// SizeFilter filters the tables to restore by their sizes and row counts in the backup
+	SizeFilter restore.TableSizeFilter `json:"size-filter" toml:"size-filter"`
+	// TableRenames are the tables to restore with new names, in the format of old_db.old_tbl:new_db.new_tbl
+	TableRenames []string `json:"rename-tables" toml:"rename-tables"`
+	// Partition is the partition of the table to restore into the existing table, the whole table is
+	// restored if it's empty
+	Partition string `json:"partition" toml:"partition"`
+	// TableRateLimits are the speed limits of restoring the tables or the databases, in the format of db.tbl=50MB
+	TableRateLimits []string `json:"table-ratelimit" toml:"table-ratelimit"`
+	// TableConcurrency is the most ranges of a table ingested concurrently by the fine-grained restore, it's
+	// unlimited if it's 0
+	TableConcurrency uint `json:"table-concurrency" toml:"table-concurrency"`
+	// DryRun determines whether to only report the plan of the restore
+	DryRun bool `json:"dry-run" toml:"dry-run"`
+	// RecordProgress determines whether to record the progress to the progress table of the cluster
+	RecordProgress bool `json:"record-progress" toml:"record-progress"`
+	// KeyspaceRewrite is the keyspaces to restore between, it's nil if the data isn't restored into a keyspace
+	KeyspaceRewrite *restore.KeyspaceRewrite `json:"keyspace-rewrite" toml:"keyspace-rewrite"`
 
 	WithPlacementPolicy string `json:"with-tidb-placement-mode" toml:"with-tidb-placement-mode"`
+	// SkipPlacementPolicy determines whether to restore without the placement policies, it's the
+	// opposite of the flag with-placement-policy so the placement policies are restored by default.
+	SkipPlacementPolicy bool `json:"skip-placement-policy" toml:"skip-placement-policy"`
+	// SkipLoadStats determines whether to restore without the statistics in the backup, it's the
+	// opposite of the flag load-stats so the statistics are loaded by default.
+	SkipLoadStats bool `json:"skip-load-stats" toml:"skip-load-stats"`
+	// SkipStoreCheck determines whether to restore without checking the TiKV stores, it's the opposite of
+	// the flag check-stores so the stores are checked by default.
+	SkipStoreCheck bool `json:"skip-store-check" toml:"skip-store-check"`
+	// MaxStoreRegions is the most regions a TiKV store can hold after restoring, it isn't checked if it's 0
+	MaxStoreRegions uint64 `json:"max-store-regions" toml:"max-store-regions"`
+	// Granularity is how the files are scheduled to restore, the coarse-grained restore balances the ranges
+	// between the leader stores of their regions
+	Granularity restore.Granularity `json:"granularity" toml:"granularity"`
+	// StorageMirror is the URL of the mirror storage of the backup, e.g. the bucket replicated to another region
+	StorageMirror string `json:"storage-mirror" toml:"storage-mirror"`
+	// AllowDDLDivergence determines whether to only warn when the DDL history of the cluster diverges from the
+	// incremental backup
+	AllowDDLDivergence bool `json:"allow-ddl-divergence" toml:"allow-ddl-divergence"`
+	// RegionSplitSize is the target size of the regions split before restoring, it follows the region split size
+	// of the cluster if it's 0
+	RegionSplitSize uint64 `json:"region-split-size" toml:"region-split-size"`
+	// DeferTiFlashReplica determines whether to set the TiFlash replicas which can't be satisfied by the
+	// TiFlash stores of the cluster once enough TiFlash stores join, rather than dropping them
+	DeferTiFlashReplica bool `json:"defer-tiflash-replica" toml:"defer-tiflash-replica"`
+	// TiFlashReplicaWaitTimeout is how long to wait for the TiFlash stores to join after restoring, the
+	// deferred replicas not set are persisted in the storage for `br restore tiflash-replica`
+	TiFlashReplicaWaitTimeout time.Duration `json:"tiflash-replica-wait-timeout" toml:"tiflash-replica-wait-timeout"`
 
 	// FullBackupStorage is used to  run `restore full` before `restore log`.
 	// if it is empty, directly take restoring log justly.
 	FullBackupStorage string `json:"full-backup-storage" toml:"full-backup-storage"`
+	// MetaKVBatchSize bounds the bytes of the meta kv files restored in a batch by log restore, lower it
+	// to restore on the hosts with less memory.
+	MetaKVBatchSize uint64 `json:"meta-kv-batch-size" toml:"meta-kv-batch-size"`
+	// Preview determines whether to print the DDL jobs and the tables affected by the log restore up to
+	// the restore ts, and restore only if it's confirmed.
+	Preview bool `json:"preview" toml:"preview"`
 
 	// [startTs, RestoreTS] is used to `restore log` from StartTS to RestoreTS.
 	StartTS         uint64                      `json:"start-ts" toml:"start-ts"`
@@ -176,10 +348,76 @@ func DefineRestoreFlags(flags *pflag.FlagSet) {
 	// Do not expose this flag
 	_ = flags.MarkHidden(flagNoSchema)
 	flags.String(FlagWithPlacementPolicy, "STRICT", "correspond to tidb global/session variable with-tidb-placement-mode")
+	flags.Bool(FlagWithPlacementPolicies, true,
+		"restore the placement policies and the placement of the databases, the tables and the partitions. "+
+			"the label constraints of the policies are checked against the stores of the cluster before restoring")
+	flags.Bool(FlagLoadStats, true,
+		"load the statistics in the backup into the restored tables, so they needn't be analyzed after restoring. "+
+			"the backup contains the statistics only if it's taken with --ignore-stats=false")
+	flags.Bool(FlagAllowDDLDivergence, false,
+		"only warn rather than abort the incremental restore when the ddl history of the cluster diverges from the backup, "+
+			"e.g. the tables are changed by other ddls after the previous restore")
+	flags.Bool(FlagResume, false,
+		"resume the failed restore from its checkpoint in the backup storage, skip the created tables and the imported files. "+
+			"the log restore skips the log files and the meta kv batches applied, which are recorded in the log backup storage "+
+			"and the system table mysql."+restore.LogCheckpointTable)
+	flags.String(FlagRegionSplitSize, "",
+		"the target size of the regions split before restoring, e.g. 96MiB. the small adjacent ranges, even in different tables, "+
+			"are merged into the regions of about the size. it follows the region split size of tikv if it's unset")
+	flags.Bool(FlagCheckStores, true,
+		"check the free disk space, the import mode support, the sst importer version and the region count of "+
+			"every tikv store concurrently before restoring, and report the problems of all the stores together")
+	flags.Uint64(FlagMaxStoreRegions, 0,
+		"the most regions a tikv store can hold after restoring, the region count isn't checked if it's 0")
+	flags.String(FlagGranularity, string(restore.FineGrained),
+		"how the files are scheduled to restore, fine or coarse. the fine-grained restore ingests the ranges in the "+
+			"order of the tables, the coarse-grained restore ingests them by the leader stores of their regions, "+
+			"keeping all the stores busy, and reports the ingest throughput of every store")
+	flags.String(FlagStorageMirror, "",
+		"the url of the mirror storage of the backup, e.g. the bucket replicated to another region. the sst files "+
+			"are downloaded from it when the storage is throttled or fails by the server. it shares the options of the storage")
+	flags.Bool(FlagDeferTiFlashReplica, false,
+		"record the tiflash replicas which can't be satisfied by the tiflash stores of the cluster, and set them "+
+			"once enough tiflash stores join rather than dropping them")
+	flags.Duration(FlagTiFlashReplicaWaitTimeout, 0,
+		"how long to wait for the tiflash stores to join for the deferred tiflash replicas after restoring. "+
+			"the replicas not set are persisted in the backup storage, run `br restore tiflash-replica` to set them later")
+	flags.String(FlagFilterSizeGT, "", "only restore the tables whose sizes in the backup are greater than it, e.g. 10GB")
+	flags.String(FlagFilterSizeLT, "", "only restore the tables whose sizes in the backup are less than it, e.g. 100MB")
+	flags.Uint64(FlagFilterRowsGT, 0, "only restore the tables whose estimated row counts are greater than it")
+	flags.Uint64(FlagFilterRowsLT, 0, "only restore the tables whose estimated row counts are less than it")
+	flags.StringSlice(FlagRenameTables, nil,
+		"restore the tables with new names alongside the existing data, e.g. old_db.old_tbl:new_db.new_tbl")
+	flags.Bool(FlagDryRun, false,
+		"perform the pre-checks and report the plan of the restore as JSON without creating tables or importing any data")
+	flags.Uint32(FlagTargetKeyspaceID, 0,
+		"restore the data into the keyspace of a multi-tenant cluster, the keys are re-encoded in the keyspace")
+	flags.Uint32(FlagSourceKeyspaceID, 0,
+		"the keyspace the backup is taken from, leave it unset if the backup is taken from a cluster without keyspaces. "+
+			"it requires --"+FlagTargetKeyspaceID)
+	flags.Bool(FlagRecordProgress, false,
+		"record the progress of the restore to the table mysql.tidb_br_progress periodically. "+
+			"the progress is also served at "+RestoreProgressPath+" of the status address. "+
+			"the progress of the finished restores is kept for a day")
+	flags.StringSlice(FlagTableRateLimit, nil,
+		"the speed limits per second of restoring the tables or the databases, e.g. db.tbl=50MB,db=100MB. "+
+			"the limits can be updated at runtime by POST to "+RestoreSpeedLimitPath+" of the status address")
+	flags.Uint(FlagTableConcurrency, 0,
+		"the most ranges of a table ingested concurrently, the ranges of the other tables are ingested once a table "+
+			"reaches it, so a table with slow or corrupted files can't occupy all the workers. it's unlimited if it's 0, "+
+			"and requires the fine-grained restore")
 
 	DefineRestoreCommonFlags(flags)
 }
 
+// DefineRestoreTableFlags defines the flags for the restore table command.
+func DefineRestoreTableFlags(command *cobra.Command) {
+	DefineTableFlags(command)
+	command.Flags().String(FlagPartition, "",
+		"only restore the partition of the table, the partition is restored into a staging table and then "+
+			"exchanged into the partition of the same name of the existing table")
+}
+
 // DefineStreamRestoreFlags defines for the restore log command.
 func DefineStreamRestoreFlags(command *cobra.Command) {
 	command.Flags().String(FlagStreamStartTS, "", "the start timestamp which log restore from.\n"+
@@ -188,6 +426,11 @@ func DefineStreamRestoreFlags(command *cobra.Command) {
 		"support TSO or datetime, e.g. '400036290571534337' or '2018-05-11 01:42:23+0800'")
 	command.Flags().String(FlagStreamFullBackupStorage, "", "specify the backup full storage. "+
 		"fill it if want restore full backup before restore log.")
+	command.Flags().String(FlagStreamMetaKVBatchSize, units.BytesSize(restore.DefaultMetaKVBatchSize),
+		"the max bytes of the meta kv files restored in a batch, lower it to restore on the hosts with less memory")
+	command.Flags().Bool(FlagStreamPreview, false,
+		"print the ddl jobs and the tables created, dropped or truncated by the log restore up to the restore ts "+
+			"before writing any data. the restore continues only if it's confirmed in the interactive shell")
 }
 
 // ParseStreamRestoreFlags parses the `restore stream` flags from the flag set.
@@ -210,6 +453,20 @@ func (cfg *RestoreConfig) ParseStreamRestoreFlags(flags *pflag.FlagSet) error {
 	if cfg.FullBackupStorage, err = flags.GetString(FlagStreamFullBackupStorage); err != nil {
 		return errors.Trace(err)
 	}
+	value, err := flags.GetString(FlagStreamMetaKVBatchSize)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if value != "" {
+		size, err := units.RAMInBytes(value)
+		if err != nil || size <= 0 {
+			return errors.Annotatef(berrors.ErrInvalidArgument, "invalid size %q of flag %s", value, FlagStreamMetaKVBatchSize)
+		}
+		cfg.MetaKVBatchSize = uint64(size)
+	}
+	if cfg.Preview, err = flags.GetBool(FlagStreamPreview); err != nil {
+		return errors.Trace(err)
+	}
 	return nil
 }
 
@@ -220,6 +477,83 @@ func (cfg *RestoreConfig) ParseFromFlags(flags *pflag.FlagSet) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if flags.Lookup(FlagResume) != nil {
+		cfg.Resume, err = flags.GetBool(FlagResume)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if flags.Lookup(FlagRenameTables) != nil {
+		cfg.TableRenames, err = flags.GetStringSlice(FlagRenameTables)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if flags.Lookup(FlagPartition) != nil {
+		cfg.Partition, err = flags.GetString(FlagPartition)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if flags.Lookup(FlagDryRun) != nil {
+		cfg.DryRun, err = flags.GetBool(FlagDryRun)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if flags.Lookup(FlagRecordProgress) != nil {
+		cfg.RecordProgress, err = flags.GetBool(FlagRecordProgress)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if flags.Changed(FlagTargetKeyspaceID) {
+		cfg.KeyspaceRewrite = &restore.KeyspaceRewrite{}
+		cfg.KeyspaceRewrite.Target, err = flags.GetUint32(FlagTargetKeyspaceID)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if flags.Changed(FlagSourceKeyspaceID) {
+			source, err := flags.GetUint32(FlagSourceKeyspaceID)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			cfg.KeyspaceRewrite.Source = &source
+		}
+	} else if flags.Changed(FlagSourceKeyspaceID) {
+		return errors.Annotatef(berrors.ErrInvalidArgument,
+			"--%s requires --%s", FlagSourceKeyspaceID, FlagTargetKeyspaceID)
+	}
+	if flags.Lookup(FlagTableRateLimit) != nil {
+		cfg.TableRateLimits, err = flags.GetStringSlice(FlagTableRateLimit)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if flags.Lookup(FlagTableConcurrency) != nil {
+		cfg.TableConcurrency, err = flags.GetUint(FlagTableConcurrency)
+		if err != nil {
+			return errors.Annotatef(err, "failed to get flag %s", FlagTableConcurrency)
+		}
+	}
+	if flags.Lookup(FlagRegionSplitSize) != nil {
+		value, err := flags.GetString(FlagRegionSplitSize)
+		if err != nil {
+			return errors.Annotatef(err, "failed to get flag %s", FlagRegionSplitSize)
+		}
+		if value != "" {
+			size, err := units.RAMInBytes(value)
+			if err != nil || size <= 0 {
+				return errors.Annotatef(berrors.ErrInvalidArgument, "invalid size %q of flag %s", value, FlagRegionSplitSize)
+			}
+			cfg.RegionSplitSize = uint64(size)
+		}
+	}
+	if flags.Lookup(FlagFilterSizeGT) != nil {
+		if cfg.SizeFilter, err = parseTableSizeFilter(flags); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	err = cfg.Config.ParseFromFlags(flags)
 	if err != nil {
 		return errors.Trace(err)
@@ -236,6 +570,24 @@ func (cfg *RestoreConfig) ParseFromFlags(flags *pflag.FlagSet) error {
 	if err != nil {
 		return errors.Annotatef(err, "failed to get flag %s", FlagPDConcurrency)
 	}
+	cfg.PipelineDepth, err = flags.GetUint(FlagPipelineDepth)
+	if err != nil {
+		return errors.Annotatef(err, "failed to get flag %s", FlagPipelineDepth)
+	}
+	cfg.ChecksumReport, err = flags.GetString(FlagChecksumReport)
+	if err != nil {
+		return errors.Annotatef(err, "failed to get flag %s", FlagChecksumReport)
+	}
+	switch cfg.ChecksumReport {
+	case "", checksumReportJSON, checksumReportTable:
+	default:
+		return errors.Annotatef(berrors.ErrInvalidArgument, "invalid format %q of flag %s, should be %s or %s",
+			cfg.ChecksumReport, FlagChecksumReport, checksumReportJSON, checksumReportTable)
+	}
+	cfg.ChecksumReverifyTimes, err = flags.GetUint(FlagChecksumReverifyTimes)
+	if err != nil {
+		return errors.Annotatef(err, "failed to get flag %s", FlagChecksumReverifyTimes)
+	}
 	cfg.BatchFlushInterval, err = flags.GetDuration(FlagBatchFlushInterval)
 	if err != nil {
 		return errors.Annotatef(err, "failed to get flag %s", FlagBatchFlushInterval)
@@ -245,10 +597,76 @@ func (cfg *RestoreConfig) ParseFromFlags(flags *pflag.FlagSet) error {
 	if err != nil {
 		return errors.Annotatef(err, "failed to get flag %s", FlagDdlBatchSize)
 	}
+	cfg.DDLConcurrency, err = flags.GetUint(FlagDDLConcurrency)
+	if err != nil {
+		return errors.Annotatef(err, "failed to get flag %s", FlagDDLConcurrency)
+	}
 	cfg.WithPlacementPolicy, err = flags.GetString(FlagWithPlacementPolicy)
 	if err != nil {
 		return errors.Annotatef(err, "failed to get flag %s", FlagWithPlacementPolicy)
 	}
+	if flags.Lookup(FlagWithPlacementPolicies) != nil {
+		withPlacementPolicies, err := flags.GetBool(FlagWithPlacementPolicies)
+		if err != nil {
+			return errors.Annotatef(err, "failed to get flag %s", FlagWithPlacementPolicies)
+		}
+		cfg.SkipPlacementPolicy = !withPlacementPolicies
+	}
+	if flags.Lookup(FlagLoadStats) != nil {
+		loadStats, err := flags.GetBool(FlagLoadStats)
+		if err != nil {
+			return errors.Annotatef(err, "failed to get flag %s", FlagLoadStats)
+		}
+		cfg.SkipLoadStats = !loadStats
+	}
+	if flags.Lookup(FlagAllowDDLDivergence) != nil {
+		cfg.AllowDDLDivergence, err = flags.GetBool(FlagAllowDDLDivergence)
+		if err != nil {
+			return errors.Annotatef(err, "failed to get flag %s", FlagAllowDDLDivergence)
+		}
+	}
+	if flags.Lookup(FlagCheckStores) != nil {
+		checkStores, err := flags.GetBool(FlagCheckStores)
+		if err != nil {
+			return errors.Annotatef(err, "failed to get flag %s", FlagCheckStores)
+		}
+		cfg.SkipStoreCheck = !checkStores
+		cfg.MaxStoreRegions, err = flags.GetUint64(FlagMaxStoreRegions)
+		if err != nil {
+			return errors.Annotatef(err, "failed to get flag %s", FlagMaxStoreRegions)
+		}
+	}
+	if flags.Lookup(FlagGranularity) != nil {
+		granularity, err := flags.GetString(FlagGranularity)
+		if err != nil {
+			return errors.Annotatef(err, "failed to get flag %s", FlagGranularity)
+		}
+		cfg.Granularity, err = restore.ParseGranularity(granularity)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if cfg.TableConcurrency > 0 && cfg.Granularity == restore.CoarseGrained {
+		return errors.Annotatef(berrors.ErrInvalidArgument,
+			"--%s requires the fine-grained restore, the coarse-grained restore schedules the ranges by the stores",
+			FlagTableConcurrency)
+	}
+	if flags.Lookup(FlagStorageMirror) != nil {
+		cfg.StorageMirror, err = flags.GetString(FlagStorageMirror)
+		if err != nil {
+			return errors.Annotatef(err, "failed to get flag %s", FlagStorageMirror)
+		}
+	}
+	if flags.Lookup(FlagDeferTiFlashReplica) != nil {
+		cfg.DeferTiFlashReplica, err = flags.GetBool(FlagDeferTiFlashReplica)
+		if err != nil {
+			return errors.Annotatef(err, "failed to get flag %s", FlagDeferTiFlashReplica)
+		}
+		cfg.TiFlashReplicaWaitTimeout, err = flags.GetDuration(FlagTiFlashReplicaWaitTimeout)
+		if err != nil {
+			return errors.Annotatef(err, "failed to get flag %s", FlagTiFlashReplicaWaitTimeout)
+		}
+	}
 	return nil
 }
 
@@ -269,12 +687,18 @@ func (cfg *RestoreConfig) adjustRestoreConfig() {
 	if cfg.PDConcurrency == 0 {
 		cfg.PDConcurrency = defaultPDConcurrency
 	}
+	if cfg.PipelineDepth == 0 {
+		cfg.PipelineDepth = defaultPipelineDepth
+	}
 	if cfg.BatchFlushInterval == 0 {
 		cfg.BatchFlushInterval = defaultBatchFlushInterval
 	}
 	if cfg.DdlBatchSize == 0 {
 		cfg.DdlBatchSize = defaultFlagDdlBatchSize
 	}
+	if cfg.DDLConcurrency == 0 {
+		cfg.DDLConcurrency = defaultDDLConcurrency
+	}
 }
 
 func (cfg *RestoreConfig) adjustRestoreConfigForStreamRestore() {
@@ -296,10 +720,35 @@ func configureRestoreClient(ctx context.Context, client *restore.Client, cfg *Re
 	}
 	client.SetSwitchModeInterval(cfg.SwitchModeInterval)
 	client.SetBatchDdlSize(cfg.DdlBatchSize)
+	client.SetDDLConcurrency(cfg.DDLConcurrency)
+	client.SetTableSizeFilter(cfg.SizeFilter)
+	renames, err := restore.ParseTableRenames(cfg.TableRenames)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	client.SetTableRenames(renames)
+	client.SetRestorePartition(cfg.Partition)
+	if cfg.Granularity != "" {
+		client.SetGranularity(cfg.Granularity)
+	}
+	if cfg.StorageMirror != "" {
+		mirror, err := storage.ParseBackend(cfg.StorageMirror, &cfg.BackendOptions)
+		if err != nil {
+			return errors.Annotatef(err, "failed to parse the mirror storage %s", cfg.StorageMirror)
+		}
+		client.SetStorageMirror(mirror)
+	}
 	client.SetPlacementPolicyMode(cfg.WithPlacementPolicy)
+	client.SetWithPlacementPolicy(!cfg.SkipPlacementPolicy)
+	client.SetLoadStats(!cfg.SkipLoadStats)
 	client.SetWithSysTable(cfg.WithSysTable)
+	mergePolicy, err := restore.ParseSysTableMergePolicy(cfg.SysTableMergePolicy)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	client.SetSysTableMergePolicy(mergePolicy)
 
-	err := client.LoadRestoreStores(ctx)
+	err = client.LoadRestoreStores(ctx)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -398,6 +847,9 @@ func RunRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 	if IsStreamRestore(cmdName) {
 		return RunStreamRestore(c, g, cmdName, cfg)
 	}
+	if cmdName == TiFlashReplicaRestoreCmd {
+		return RunRestoreTiFlashReplica(c, g, cmdName, cfg)
+	}
 
 	cfg.adjustRestoreConfig()
 	defer summary.Summary(cmdName)
@@ -438,6 +890,11 @@ func RunRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 	if err != nil {
 		return errors.Trace(err)
 	}
+	regionSplitSize := cfg.RegionSplitSize
+	if regionSplitSize == 0 {
+		regionSplitSize = mergeRegionSize
+	}
+	client.SetRegionSplitSize(regionSplitSize)
 	// Init DB connection sessions
 	err = client.Init(g, mgr.GetStorage())
 	defer client.Close()
@@ -449,6 +906,8 @@ func RunRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 	if err != nil {
 		return errors.Trace(err)
 	}
+	backendURL := storage.FormatBackendURL(u)
+	client.SetTaskName(fmt.Sprintf("%s from %s", cmdName, backendURL.String()))
 	backupVersion := version.NormalizeBackupVersion(backupMeta.ClusterVersion)
 	if cfg.CheckRequirements && backupVersion != nil {
 		if versionErr := version.CheckClusterVersion(ctx, mgr.GetPDClient(), version.CheckVersionForBackup(backupVersion)); versionErr != nil {
@@ -460,12 +919,36 @@ func RunRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 	}
 
 	reader := metautil.NewMetaReader(backupMeta, s, &cfg.CipherInfo)
+	reader.SetDBFilter(func(db string) bool {
+		if name, ok := utils.GetSysDBName(model.NewCIStr(db)); utils.IsSysDB(name) && ok {
+			db = name
+		}
+		return cfg.TableFilter.MatchSchema(db)
+	})
 	if err = client.InitBackupMeta(c, backupMeta, u, reader); err != nil {
 		return errors.Trace(err)
 	}
+	tableSpeedLimits, err := restore.ParseTableSpeedLimits(cfg.TableRateLimits)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	client.SetTableSpeedLimits(tableSpeedLimits)
+	client.SetTableConcurrency(cfg.TableConcurrency)
+	if err = client.SetKeyspaceRewrite(cfg.KeyspaceRewrite); err != nil {
+		return errors.Trace(err)
+	}
+	// the dry run doesn't write the checkpoint to the backup storage.
+	var checkpoint *restore.Checkpoint
+	if !cfg.DryRun {
+		checkpoint, err = restore.NewCheckpoint(ctx, s, mgr.GetPDClient().GetClusterID(ctx), backupMeta.EndVersion, cfg.Resume)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		client.SetCheckpoint(checkpoint)
+	}
 
-	if client.IsRawKvMode() {
-		return errors.Annotate(berrors.ErrRestoreModeMismatch, "cannot do transactional restore from raw kv data")
+	if mode := client.KvMode(); mode != restore.TiDBMode {
+		return errors.Annotatef(berrors.ErrRestoreModeMismatch, "cannot do transactional restore from %s data", mode)
 	}
 	if err = CheckRestoreDBAndTable(client, cfg); err != nil {
 		return err
@@ -474,6 +957,20 @@ func RunRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 	if len(dbs) == 0 && len(tables) != 0 {
 		return errors.Annotate(berrors.ErrRestoreInvalidBackup, "contain tables but no databases")
 	}
+	tables, newDBs, err := client.RenameTables(tables)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, db := range newDBs {
+		dbs = append(dbs, &utils.Database{Info: db})
+	}
+	if client.HasRestorePartition() {
+		tables, err = client.PreparePartitionRestore(mgr.GetDomain().InfoSchema(), tables, checkpoint.Resumed())
+		if err != nil {
+			return errors.Trace(err)
+		}
+		files = tables[0].Files
+	}
 	archiveSize := reader.ArchiveSize(ctx, files)
 	g.Record(summary.RestoreDataSize, archiveSize)
 	//restore from tidb will fetch a general Size issue https://github.com/pingcap/tidb/issues/27247
@@ -490,15 +987,35 @@ func RunRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 	if cmdName == FullRestoreCmd && cfg.WithSysTable {
 		client.InitFullClusterRestore(cfg.ExplicitFilter)
 	}
-	if client.IsFullClusterRestore() && client.HasBackedUpSysDB() {
-		if err = client.CheckTargetClusterFresh(ctx); err != nil {
+	if cfg.DryRun {
+		return runRestoreDryRun(ctx, g, mgr, client, tables, cfg)
+	}
+
+	// the renamed tables mustn't exist.
+	if client.HasTableRenames() && !checkpoint.Resumed() {
+		if err = client.CheckRenamedTablesNotExist(mgr.GetDomain()); err != nil {
 			return errors.Trace(err)
 		}
+	}
+	if client.IsFullClusterRestore() && client.HasBackedUpSysDB() {
+		// the cluster isn't fresh when resuming, the tables are created by the previous restore.
+		if !checkpoint.Resumed() {
+			if err = client.CheckTargetClusterFresh(ctx); err != nil {
+				return errors.Trace(err)
+			}
+		}
 		if err = client.CheckSysTableCompatibility(mgr.GetDomain(), tables); err != nil {
 			return errors.Trace(err)
 		}
 	}
 
+	// the data restored by the previous restore is on the stores when resuming.
+	if !cfg.SkipStoreCheck && !checkpoint.Resumed() {
+		if err = checkStores(ctx, mgr, client, tables, files, cfg.MaxStoreRegions); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	sp := utils.BRServiceSafePoint{
 		BackupTS: restoreTS,
 		TTL:      utils.DefaultBRGCSafePointTTL,
@@ -521,6 +1038,12 @@ func RunRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 	ddlJobs := restore.FilterDDLJobs(client.GetDDLJobs(), tables)
 	ddlJobs = restore.FilterDDLJobByRules(ddlJobs, restore.DDLJobBlockListRule)
 
+	// the point restore sets all the replicas back after restoring the logs.
+	var deferredTiFlashReplicas *tiflashrec.TiFlashRecorder
+	if cfg.DeferTiFlashReplica && cfg.tiflashRecorder == nil {
+		deferredTiFlashReplicas = tiflashrec.New()
+		client.SetDeferredTiFlashReplicas(deferredTiFlashReplicas)
+	}
 	err = client.PreCheckTableTiFlashReplica(ctx, tables, cfg.tiflashRecorder)
 	if err != nil {
 		return errors.Trace(err)
@@ -541,6 +1064,13 @@ func RunRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 		if err != nil {
 			return errors.Trace(err)
 		}
+		if !checkpoint.Resumed() {
+			// the policies are created before the tables which reference them,
+			// so check they can be satisfied before creating anything.
+			if err = client.CheckPlacementPolicyLabels(ctx, policies, tables, isFullRestore(cmdName)); err != nil {
+				return errors.Trace(err)
+			}
+		}
 		if isFullRestore(cmdName) {
 			// we should restore all policies during full restoration.
 			err = client.CreatePolicies(ctx, policies)
@@ -552,6 +1082,13 @@ func RunRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 		}
 	}
 
+	if !checkpoint.Resumed() {
+		// the ddl jobs may have been executed by the restore to resume.
+		if err = checkDDLHistory(client, mgr.GetDomain(), tables, ddlJobs, cfg.AllowDDLDivergence); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	// execute DDL first
 	err = client.ExecDDLs(ctx, ddlJobs)
 	if err != nil {
@@ -566,13 +1103,31 @@ func RunRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 		return nil
 	}
 
-	for _, db := range dbs {
-		err = client.CreateDatabase(ctx, db.Info)
-		if err != nil {
-			return errors.Trace(err)
+	if cfg.WithSysTable && client.HasBackedUpSysDB() && !cfg.Resume {
+		// drop the temporary databases left by the failed restores before creating new ones,
+		// the ones of the restore to resume are still in use.
+		if _, err := client.CleanTemporaryDBs(ctx, restore.DefaultTemporaryDBGCAge); err != nil {
+			log.Warn("failed to clean the temporary databases", logutil.ShortError(err))
 		}
 	}
 
+	dbInfos := make([]*model.DBInfo, 0, len(dbs))
+	for _, db := range dbs {
+		dbInfos = append(dbInfos, db.Info)
+	}
+	if err = client.CreateDatabases(ctx, dbInfos); err != nil {
+		return errors.Trace(err)
+	}
+
+	restoreProgress := restore.NewRestoreProgress("restore data", tables)
+	progress.DefaultRegistry.Register(restoreProgress.Task())
+	client.SetRestoreProgress(restoreProgress)
+	if cfg.RecordProgress {
+		stopRecording := startRecordingRestoreProgress(
+			ctx, g, mgr.GetStorage(), fmt.Sprintf("restore-%d", restoreTS), restoreProgress)
+		defer stopRecording()
+	}
+
 	// We make bigger errCh so we won't block on multi-part failed.
 	errCh := make(chan error, 32)
 	tableStream := client.GoCreateTables(ctx, mgr.GetDomain(), tables, newTS, errCh)
@@ -582,11 +1137,14 @@ func RunRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 		// don't return immediately, wait all pipeline done.
 	}
 
-	if cfg.tiflashRecorder != nil {
+	if cfg.tiflashRecorder != nil || deferredTiFlashReplicas != nil {
 		tableStream = util.ChanMap(tableStream, func(t restore.CreatedTable) restore.CreatedTable {
 			if cfg.tiflashRecorder != nil {
 				cfg.tiflashRecorder.Rewrite(t.OldTable.Info.ID, t.Table.ID)
 			}
+			if deferredTiFlashReplicas != nil {
+				deferredTiFlashReplicas.Rewrite(t.OldTable.Info.ID, t.Table.ID)
+			}
 			return t
 		})
 	}
@@ -601,7 +1159,7 @@ func RunRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 	summary.CollectInt("restore ranges", rangeSize)
 	log.Info("range and file prepared", zap.Int("file count", len(files)), zap.Int("range count", rangeSize))
 
-	restoreSchedulers, err := restorePreWork(ctx, client, mgr, true)
+	restoreSchedulers, err := restorePreWork(ctx, client, mgr, cfg.PauseStateFile, true)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -633,7 +1191,7 @@ func RunRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 		int64(rangeSize+len(files)+len(tables)),
 		!cfg.LogProgress)
 	defer updateCh.Close()
-	sender, err := restore.NewTiKVSender(ctx, client, updateCh, cfg.PDConcurrency)
+	sender, err := restore.NewTiKVSender(ctx, client, updateCh, cfg.PDConcurrency, cfg.PipelineDepth)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -641,7 +1199,7 @@ func RunRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 	batcher, afterRestoreStream := restore.NewBatcher(ctx, sender, manager, errCh)
 	batcher.SetThreshold(batchSize)
 	batcher.EnableAutoCommit(ctx, cfg.BatchFlushInterval)
-	go restoreTableStream(ctx, rangeStream, batcher, errCh)
+	go restoreTableStream(ctx, rangeStream, batcher, updateCh, errCh)
 
 	var finish <-chan struct{}
 	// Checksum
@@ -649,10 +1207,14 @@ func RunRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 		finish = client.GoValidateChecksum(
 			ctx, afterRestoreStream, mgr.GetStorage().GetClient(), errCh, updateCh, cfg.ChecksumConcurrency)
 	} else {
-		// when user skip checksum, just collect tables, and drop them.
-		finish = dropToBlackhole(ctx, afterRestoreStream, errCh, updateCh)
+		// when user skip checksum, just load the statistics, collect tables, and drop them.
+		finish = dropToBlackhole(ctx, client.GoLoadStats(ctx, afterRestoreStream), errCh, updateCh)
 	}
 
+	// the speed limits can be updated through the status address during restoring.
+	runningRestore.Store(client)
+	defer runningRestore.CompareAndSwap(client, nil)
+
 	// Reset speed limit. ResetSpeedLimit must be called after client.InitBackupMeta has been called.
 	defer func() {
 		var resetErr error
@@ -677,21 +1239,89 @@ func RunRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 		err = multierr.Append(err, multierr.Combine(restore.Exhaust(errCh)...))
 	case <-finish:
 	}
+	restoreProgress.Finish(err)
 
 	// If any error happened, return now.
 	if err != nil {
 		return errors.Trace(err)
 	}
 
+	if cfg.Checksum {
+		if err := verifyChecksumReport(ctx, g, mgr, client, cfg); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	if client.HasRestorePartition() {
+		if err := client.ExchangeRestoredPartition(ctx); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	rebased, err := client.VerifyAutoIDs(ctx, tables)
+	if rebased > 0 {
+		summary.CollectInt("auto id allocators rebased", rebased)
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	if deferredTiFlashReplicas != nil && deferredTiFlashReplicas.Len() > 0 {
+		_, s, err := GetStorage(ctx, cfg.Storage, &cfg.Config)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		err = reconcileTiFlashReplicas(ctx, g, mgr, deferredTiFlashReplicas, s, cfg.TiFlashReplicaWaitTimeout, cfg.LogProgress)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	// The cost of rename user table / replace into system table wouldn't be so high.
 	// So leave it out of the pipeline for easier implementation.
 	client.RestoreSystemSchemas(ctx, cfg.TableFilter)
 
+	collectDDLStats(client.GetDDLStats())
+	collectStoreIngestStats(client.GetStoreIngestStats())
+	collectTableIngestStats(client.GetTableIngestStats())
+	collectImportRetryStats(client.GetImportRetryStats())
+	collectStorageSourceStats(client.GetStorageSourceStats())
+	if stats, ok := client.GetStatsRestoreSummary(); ok {
+		collectStatsRestoreSummary(stats)
+	}
+	if err := checkpoint.Remove(ctx); err != nil {
+		log.Warn("failed to remove the checkpoint", logutil.ShortError(err))
+	}
+
 	// Set task summary to success status.
 	summary.SetSuccessStatus(true)
 	return nil
 }
 
+// checkStores checks the TiKV stores can restore the data before restoring,
+// the problems of all the stores are reported together.
+func checkStores(
+	ctx context.Context,
+	mgr *conn.Mgr,
+	client *restore.Client,
+	tables []*metautil.Table,
+	files []*backuppb.File,
+	maxStoreRegions uint64,
+) error {
+	req := restore.StoreRequirement{
+		Regions:         uint64(restore.EstimateRangeSize(files)),
+		MaxStoreRegions: maxStoreRegions,
+	}
+	for _, table := range tables {
+		req.TotalBytes += table.TotalBytes
+	}
+	stores, err := client.CheckStores(ctx, mgr, req)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return restore.StoreHealthError(stores)
+}
+
 // dropToBlackhole drop all incoming tables into black hole,
 // i.e. don't execute checksum, just increase the process anyhow.
 func dropToBlackhole(
@@ -740,6 +1370,12 @@ func filterRestoreFiles(
 			if table.Info == nil || !cfg.TableFilter.MatchTable(dbName, table.Info.Name.O) {
 				continue
 			}
+			if !client.MatchTableSize(table) {
+				log.Info("skip the table filtered by the size",
+					zap.String("db", dbName), zap.Stringer("table", table.Info.Name),
+					zap.Uint64("bytes", table.TotalBytes), zap.Uint64("estimated-rows", restore.EstimateTableRows(table)))
+				continue
+			}
 			files = append(files, table.Files...)
 			tables = append(tables, table)
 		}
@@ -747,9 +1383,239 @@ func filterRestoreFiles(
 	return
 }
 
+func parseTableSizeFilter(flags *pflag.FlagSet) (f restore.TableSizeFilter, err error) {
+	parseSize := func(flag string) (uint64, error) {
+		value, err := flags.GetString(flag)
+		if err != nil || value == "" {
+			return 0, errors.Trace(err)
+		}
+		size, err := units.RAMInBytes(value)
+		if err != nil || size < 0 {
+			return 0, errors.Annotatef(berrors.ErrInvalidArgument, "invalid size %q of flag %s", value, flag)
+		}
+		return uint64(size), nil
+	}
+	if f.SizeGreaterThan, err = parseSize(FlagFilterSizeGT); err != nil {
+		return f, err
+	}
+	if f.SizeLessThan, err = parseSize(FlagFilterSizeLT); err != nil {
+		return f, err
+	}
+	if f.RowsGreaterThan, err = flags.GetUint64(FlagFilterRowsGT); err != nil {
+		return f, errors.Trace(err)
+	}
+	if f.RowsLessThan, err = flags.GetUint64(FlagFilterRowsLT); err != nil {
+		return f, errors.Trace(err)
+	}
+	return f, nil
+}
+
+// checkDDLHistory aborts the incremental restore if the DDL history of the cluster diverges
+// from the backup, unless the divergence is allowed.
+func checkDDLHistory(
+	client *restore.Client,
+	dom *domain.Domain,
+	tables []*metautil.Table,
+	ddlJobs []*model.Job,
+	allowDivergence bool,
+) error {
+	divergences, err := client.CheckDDLHistory(dom, tables, ddlJobs)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, d := range divergences {
+		queries := make([]string, 0, len(d.Jobs))
+		for _, job := range d.Jobs {
+			queries = append(queries, job.Query)
+		}
+		log.Warn("the ddl history of the cluster diverges from the backup",
+			zap.String("db", d.DB), zap.String("table", d.Table),
+			zap.String("reason", d.Reason), zap.Strings("cluster-ddls", queries))
+	}
+	if len(divergences) > 0 && allowDivergence {
+		log.Warn("continue restoring since the ddl divergence is allowed", zap.Int("tables", len(divergences)))
+		return nil
+	}
+	return restore.DDLDivergenceError(divergences)
+}
+
+// collectDDLStats adds the performance of creating the tables to the summary.
+func collectDDLStats(stats restore.DDLStats) {
+	if len(stats.Batches) == 0 {
+		return
+	}
+	summary.CollectInt("create table batches", len(stats.Batches))
+	if failed := stats.FailedBatches(); failed > 0 {
+		summary.CollectInt("failed create table batches", failed)
+	}
+	summary.CollectDuration("create tables", stats.TotalDuration())
+	summary.CollectUint("final ddl batch size", uint64(stats.FinalBatchSize))
+	log.Info("tables created",
+		zap.Int("tables", stats.TablesCreated()),
+		zap.Int("batches", len(stats.Batches)),
+		zap.Bool("fall-back", stats.FallBack),
+		zap.Uint("initial-batch-size", stats.InitialBatchSize),
+		zap.Uint("final-batch-size", stats.FinalBatchSize),
+		zap.Duration("total-take", stats.TotalDuration()))
+}
+
+// collectStoreIngestStats adds the ingest throughput of every store in the
+// coarse-grained restore to the summary.
+func collectStoreIngestStats(stats []restore.StoreIngestStat) {
+	for _, s := range stats {
+		summary.CollectUint(fmt.Sprintf("store %d ingest throughput (bytes/s)", s.StoreID), uint64(s.Throughput()))
+		log.Info("files ingested by the store",
+			zap.Uint64("store", s.StoreID),
+			zap.Int("files", s.Files),
+			zap.String("size", units.HumanSize(float64(s.Bytes))),
+			zap.Duration("take", s.Elapsed),
+			zap.String("throughput", units.HumanSize(s.Throughput())+"/s"))
+	}
+}
+
+// maxTableIngestStatsInSummary is the most tables whose ingest throughput is
+// added to the summary, the slowest ones are added.
+const maxTableIngestStatsInSummary = 10
+
+// collectTableIngestStats logs the ingest throughput of every table, and adds
+// the slowest tables to the summary.
+func collectTableIngestStats(stats []restore.TableIngestStat) {
+	for i, s := range stats {
+		name := fmt.Sprintf("`%s`.`%s`", s.Table.DB, s.Table.Table)
+		if i < maxTableIngestStatsInSummary {
+			summary.CollectUint(fmt.Sprintf("table %s ingest throughput (bytes/s)", name), uint64(s.Throughput()))
+		}
+		log.Info("files ingested of the table",
+			zap.String("table", name),
+			zap.Int("files", s.Files),
+			zap.String("size", units.HumanSize(float64(s.Bytes))),
+			zap.Duration("take", s.Elapsed),
+			zap.String("throughput", units.HumanSize(s.Throughput())+"/s"))
+	}
+}
+
+// collectImportRetryStats adds the retries of importing the files to the
+// summary by the classes of the errors.
+func collectImportRetryStats(counts []restore.ImportRetryCount) {
+	for _, c := range counts {
+		summary.CollectInt(fmt.Sprintf("import retries (%s)", c.Class), c.Count)
+		log.Info("import retried", zap.Stringer("class", c.Class), zap.Int("count", c.Count))
+	}
+}
+
+// collectStorageSourceStats adds the files downloaded from every storage to the
+// summary if the mirror storage is used.
+func collectStorageSourceStats(stats []restore.StorageSourceStat) {
+	if len(stats) < 2 {
+		return
+	}
+	for _, s := range stats {
+		summary.CollectInt(fmt.Sprintf("files downloaded from %s storage", s.Source), s.Downloads)
+		log.Info("files downloaded from the storage",
+			zap.String("source", s.Source),
+			zap.Int("downloads", s.Downloads),
+			zap.Int("failures", s.Failures),
+			zap.String("size", units.HumanSize(float64(s.Bytes))))
+	}
+}
+
+// collectStatsRestoreSummary adds the result of restoring the statistics to the summary.
+func collectStatsRestoreSummary(stats restore.StatsRestoreSummary) {
+	summary.CollectInt("tables with stats restored", int(stats.Loaded))
+	if stats.Failed > 0 {
+		summary.CollectInt("tables failed to restore stats", int(stats.Failed))
+		log.Warn("failed to restore the statistics of some tables, please analyze them after restoring",
+			zap.Int64("failed", stats.Failed))
+	}
+}
+
+const (
+	checksumReportJSON  = "json"
+	checksumReportTable = "table"
+)
+
+// verifyChecksumReport verifies the checksums of the tables mismatched again, and
+// prints the checksum report, it fails if any checksum still mismatches.
+func verifyChecksumReport(
+	ctx context.Context,
+	g glue.Glue,
+	mgr *conn.Mgr,
+	client *restore.Client,
+	cfg *RestoreConfig,
+) error {
+	report := client.GetChecksumReport()
+	for i := uint(0); i < cfg.ChecksumReverifyTimes; i++ {
+		failed := report.Failed()
+		if len(failed) == 0 {
+			break
+		}
+		log.Warn("verify the checksums of the tables mismatched again",
+			zap.Int("tables", len(failed)), zap.Uint("attempt", i+1))
+		if err := client.ReverifyChecksum(ctx, mgr.GetStorage().GetClient(), cfg.ChecksumConcurrency); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	switch cfg.ChecksumReport {
+	case checksumReportJSON:
+		data, err := report.JSON()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		glue.GetConsole(g).Println(string(data))
+	case checksumReportTable:
+		glue.GetConsole(g).Print(report.Table())
+	}
+
+	failed := report.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	summary.CollectInt("tables checksum mismatched", len(failed))
+	names := make([]string, 0, len(failed))
+	for _, t := range failed {
+		names = append(names, utils.EncloseDBAndTable(t.DB, t.Table))
+	}
+	return errors.Annotatef(berrors.ErrRestoreChecksumMismatch,
+		"failed to validate the checksums of %d tables: %s", len(failed), strings.Join(names, ", "))
+}
+
+// runRestoreDryRun reports the plan of the restore without changing the cluster,
+// it fails if any pre-check fails.
+func runRestoreDryRun(
+	ctx context.Context,
+	g glue.Glue,
+	mgr *conn.Mgr,
+	client *restore.Client,
+	tables []*metautil.Table,
+	cfg *RestoreConfig,
+) error {
+	ddlJobs := restore.FilterDDLJobs(client.GetDDLJobs(), tables)
+	ddlJobs = restore.FilterDDLJobByRules(ddlJobs, restore.DDLJobBlockListRule)
+	plan, err := client.PlanRestore(ctx, mgr.GetDomain(), mgr, tables, ddlJobs,
+		cfg.MergeSmallRegionSizeBytes, cfg.MergeSmallRegionKeyCount)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	data, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	glue.GetConsole(g).Println(string(data))
+	if !plan.Passed {
+		return errors.Annotate(berrors.ErrInvalidArgument, "the pre-checks of the restore failed, see the plan for details")
+	}
+	log.Info("the restore dry run passed",
+		zap.Int("tables", len(plan.Tables)), zap.Int("files", plan.Files), zap.Int("split-keys", plan.SplitKeys))
+	summary.SetSuccessStatus(true)
+	return nil
+}
+
 // restorePreWork executes some prepare work before restore.
 // TODO make this function returns a restore post work.
-func restorePreWork(ctx context.Context, client *restore.Client, mgr *conn.Mgr, switchToImport bool) (pdutil.UndoFunc, error) {
+func restorePreWork(
+	ctx context.Context, client *restore.Client, mgr *conn.Mgr, pauseStateFile string, switchToImport bool,
+) (pdutil.UndoFunc, error) {
 	if client.IsOnline() {
 		return pdutil.Nop, nil
 	}
@@ -759,7 +1625,20 @@ func restorePreWork(ctx context.Context, client *restore.Client, mgr *conn.Mgr,
 		client.SwitchToImportMode(ctx)
 	}
 
-	return mgr.RemoveSchedulers(ctx)
+	// Pause the schedulers with lease, so PD resumes them if BR exited unexpectedly.
+	pauseStateFile, err := resolvePauseStateFile(pauseStateFile, mgr.GetPDClient().GetClusterID(ctx))
+	if err != nil {
+		return pdutil.Nop, errors.Trace(err)
+	}
+	pauser := mgr.NewSchedulerPauser(pdutil.DefaultPauseLease, pdutil.FilePauseStateStore{Path: pauseStateFile})
+	if _, err := pauser.Pause(ctx); err != nil {
+		if !berrors.Is(err, berrors.ErrUnsupportedOperation) {
+			return pdutil.Nop, errors.Trace(err)
+		}
+		log.Warn("PD can't pause the schedulers with lease, remove them until the restore finished", zap.Error(err))
+		return mgr.RemoveSchedulers(ctx)
+	}
+	return pauser.Resume, nil
 }
 
 // restorePostWork executes some post work after restore.
@@ -802,6 +1681,7 @@ func restoreTableStream(
 	ctx context.Context,
 	inputCh <-chan restore.TableWithRange,
 	batcher *restore.Batcher,
+	updateCh glue.Progress,
 	errCh chan<- error,
 ) {
 	// We cache old tables so that we can 'batch' recover TiFlash and tables.
@@ -825,6 +1705,7 @@ func restoreTableStream(
 			}
 			oldTables = append(oldTables, t.OldTable)
 
+			glue.SetProgressObject(updateCh, utils.EncloseDBAndTable(t.OldTable.DB.Name.O, t.OldTable.Info.Name.O))
 			batcher.Add(t)
 		}
 	}
@@ -0,0 +1,113 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/glue"
+	"github.com/pingcap/tidb/br/pkg/logutil"
+	"github.com/pingcap/tidb/br/pkg/restore"
+	"github.com/pingcap/tidb/kv"
+	"go.uber.org/zap"
+)
+
+// RestoreProgressPath is the path of the status address to get the progress
+// of the running restore.
+const RestoreProgressPath = "/restore/progress"
+
+const (
+	recordProgressInterval = 10 * time.Second
+	recordProgressTimeout  = 30 * time.Second
+	// progressRetention is how long the progress of the finished tasks is kept.
+	progressRetention = 24 * time.Hour
+)
+
+type restoreProgressHandler struct{}
+
+// RestoreProgressHandler returns the handler to get the progress of the
+// running restore in JSON, such as the ingested files and bytes, the split
+// ranges, the percentages of the tables and the ETA.
+func RestoreProgressHandler() http.Handler {
+	return restoreProgressHandler{}
+}
+
+// ServeHTTP implements http.Handler.
+func (restoreProgressHandler) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	client := runningRestore.Load()
+	if client == nil || client.GetRestoreProgress() == nil {
+		http.Error(w, "no restore is running", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(client.GetRestoreProgress().Summary())
+}
+
+// startRecordingRestoreProgress records the progress of the restore to the
+// cluster periodically if the glue supports it. The returned function stops
+// recording after recording the last progress.
+func startRecordingRestoreProgress(
+	ctx context.Context,
+	g glue.Glue,
+	store kv.Storage,
+	taskID string,
+	p *restore.RestoreProgress,
+) (stop func()) {
+	se, err := g.CreateSession(store)
+	if err != nil {
+		log.Warn("failed to create the session to record the progress", logutil.ShortError(err))
+		return func() {}
+	}
+	recorder, ok := se.(glue.ProgressRecordSession)
+	if !ok {
+		log.Warn("the glue doesn't support recording the progress")
+		se.Close()
+		return func() {}
+	}
+	record := func(ctx context.Context) {
+		ctx, cancel := context.WithTimeout(ctx, recordProgressTimeout)
+		defer cancel()
+		summary := p.Summary()
+		if err := recorder.RecordProgress(ctx, taskID, summary.Rows()); err != nil {
+			log.Warn("failed to record the progress", zap.String("task", taskID), logutil.ShortError(err))
+		}
+	}
+	removeStale := func(ctx context.Context) {
+		ctx, cancel := context.WithTimeout(ctx, recordProgressTimeout)
+		defer cancel()
+		if err := recorder.RemoveStaleProgress(ctx, progressRetention); err != nil {
+			log.Warn("failed to remove the progress of the finished tasks", logutil.ShortError(err))
+		}
+	}
+	removeStale(ctx)
+
+	done := make(chan struct{})
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		ticker := time.NewTicker(recordProgressInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-done:
+				return
+			case <-ticker.C:
+				record(ctx)
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-exited
+		// the context may be canceled when the restore fails.
+		record(context.Background())
+		removeStale(context.Background())
+		se.Close()
+	}
+}
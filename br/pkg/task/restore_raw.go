@@ -11,7 +11,9 @@ import (
 	berrors "github.com/pingcap/tidb/br/pkg/errors"
 	"github.com/pingcap/tidb/br/pkg/glue"
 	"github.com/pingcap/tidb/br/pkg/httputil"
+	"github.com/pingcap/tidb/br/pkg/logutil"
 	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/br/pkg/progress"
 	"github.com/pingcap/tidb/br/pkg/restore"
 	"github.com/pingcap/tidb/br/pkg/summary"
 	"github.com/spf13/cobra"
@@ -22,6 +24,9 @@ import (
 type RestoreRawConfig struct {
 	RawKvConfig
 	RestoreCommonConfig
+
+	// Resume determines whether to resume the restore from the checkpoint of the previous one
+	Resume bool `json:"resume" toml:"resume"`
 }
 
 // DefineRawRestoreFlags defines common flags for the backup command.
@@ -34,6 +39,16 @@ func DefineRawRestoreFlags(command *cobra.Command) {
 	DefineRestoreCommonFlags(command.PersistentFlags())
 }
 
+// DefineTxnRestoreFlags defines the flags for the txn kv restore command, the
+// txn kv data is restored in both the default and the write CF.
+func DefineTxnRestoreFlags(command *cobra.Command) {
+	command.Flags().StringP(flagKeyFormat, "", "hex", "start/end key format, support raw|escaped|hex")
+	command.Flags().StringP(flagStartKey, "", "", "restore txn kv start key, key is inclusive")
+	command.Flags().StringP(flagEndKey, "", "", "restore txn kv end key, key is exclusive")
+
+	DefineRestoreCommonFlags(command.PersistentFlags())
+}
+
 // ParseFromFlags parses the backup-related flags from the flag set.
 func (cfg *RestoreRawConfig) ParseFromFlags(flags *pflag.FlagSet) error {
 	var err error
@@ -45,6 +60,13 @@ func (cfg *RestoreRawConfig) ParseFromFlags(flags *pflag.FlagSet) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	// the resume flag is inherited from the restore command.
+	if flags.Lookup(FlagResume) != nil {
+		cfg.Resume, err = flags.GetBool(FlagResume)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
 	return cfg.RawKvConfig.ParseFromFlags(flags)
 }
 
@@ -59,6 +81,18 @@ func (cfg *RestoreRawConfig) adjust() {
 
 // RunRestoreRaw starts a raw kv restore task inside the current goroutine.
 func RunRestoreRaw(c context.Context, g glue.Glue, cmdName string, cfg *RestoreRawConfig) (err error) {
+	return runRestoreRange(c, g, cmdName, cfg, restore.RawKvMode)
+}
+
+// RunRestoreTxn starts a txn kv restore task inside the current goroutine, the
+// backup of the key ranges without any TiDB schemas is restored as is.
+func RunRestoreTxn(c context.Context, g glue.Glue, cmdName string, cfg *RestoreRawConfig) (err error) {
+	return runRestoreRange(c, g, cmdName, cfg, restore.TxnKvMode)
+}
+
+// runRestoreRange restores the key range of the backup in the raw kv or the
+// txn kv mode, the keys aren't rewritten.
+func runRestoreRange(c context.Context, g glue.Glue, cmdName string, cfg *RestoreRawConfig, mode restore.KvMode) (err error) {
 	cfg.adjust()
 
 	defer summary.Summary(cmdName)
@@ -113,11 +147,11 @@ func RunRestoreRaw(c context.Context, g glue.Glue, cmdName string, cfg *RestoreR
 		return errors.Trace(err)
 	}
 
-	if !client.IsRawKvMode() {
-		return errors.Annotate(berrors.ErrRestoreModeMismatch, "cannot do raw restore from transactional data")
+	if backupMode := client.KvMode(); backupMode != mode {
+		return errors.Annotatef(berrors.ErrRestoreModeMismatch, "cannot do %s restore from %s data", mode, backupMode)
 	}
 
-	files, err := client.GetFilesInRawRange(cfg.StartKey, cfg.EndKey, cfg.CF)
+	files, err := client.GetFilesInRange(cfg.StartKey, cfg.EndKey, cfg.CF)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -136,34 +170,57 @@ func RunRestoreRaw(c context.Context, g glue.Glue, cmdName string, cfg *RestoreR
 		return errors.Trace(err)
 	}
 
+	checkpoint, err := restore.NewCheckpoint(ctx, s, mgr.GetPDClient().GetClusterID(ctx), backupMeta.EndVersion, cfg.Resume)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	client.SetCheckpoint(checkpoint)
+	restoreProgress := restore.NewRangeRestoreProgress("restore data", files)
+	progress.DefaultRegistry.Register(restoreProgress.Task())
+	client.SetRestoreProgress(restoreProgress)
+	defer func() {
+		restoreProgress.Finish(err)
+	}()
+
 	// Redirect to log if there is no log file to avoid unreadable output.
-	// TODO: How to show progress?
 	updateCh := g.StartProgress(
 		ctx,
-		"Raw Restore",
+		cmdName,
 		// Split/Scatter + Download/Ingest
 		int64(len(ranges)+len(files)),
 		!cfg.LogProgress)
 
-	// RawKV restore does not need to rewrite keys.
-	err = restore.SplitRanges(ctx, client, ranges, nil, updateCh, true)
+	// The range restore does not need to rewrite keys.
+	err = restore.SplitRanges(ctx, client, ranges, nil, updateCh, mode == restore.RawKvMode)
 	if err != nil {
 		return errors.Trace(err)
 	}
 
-	restoreSchedulers, err := restorePreWork(ctx, client, mgr, true)
+	restoreSchedulers, err := restorePreWork(ctx, client, mgr, cfg.PauseStateFile, true)
 	if err != nil {
 		return errors.Trace(err)
 	}
 	defer restorePostWork(ctx, client, restoreSchedulers)
 
-	err = client.RestoreRaw(ctx, cfg.StartKey, cfg.EndKey, files, updateCh)
+	// the speed limits can be updated through the status address during restoring.
+	runningRestore.Store(client)
+	defer runningRestore.CompareAndSwap(client, nil)
+	defer func() {
+		if resetErr := client.ResetSpeedLimit(ctx); resetErr != nil {
+			log.Warn("failed to reset speed limit", logutil.ShortError(resetErr))
+		}
+	}()
+
+	err = client.RestoreRange(ctx, cfg.StartKey, cfg.EndKey, files, updateCh)
 	if err != nil {
 		return errors.Trace(err)
 	}
 
 	// Restore has finished.
 	updateCh.Close()
+	if err := checkpoint.Remove(ctx); err != nil {
+		log.Warn("failed to remove the checkpoint", logutil.ShortError(err))
+	}
 
 	// Set task summary to success status.
 	summary.SetSuccessStatus(true)
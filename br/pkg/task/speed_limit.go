@@ -0,0 +1,80 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/restore"
+	"go.uber.org/zap"
+)
+
+// RestoreSpeedLimitPath is the path of the status address to get and update
+// the speed limits of the running restore.
+const RestoreSpeedLimitPath = "/restore/speed-limit"
+
+const updateSpeedLimitTimeout = time.Minute
+
+// runningRestore is the client of the running restore, it's nil if no
+// restore is running.
+var runningRestore atomic.Pointer[restore.Client]
+
+// restoreSpeedLimits is the body of the requests to the speed limit handler.
+type restoreSpeedLimits struct {
+	// RateLimit is the download speed limit of every store in bytes per second,
+	// the limit is kept if it's omitted.
+	RateLimit *uint64 `json:"rate-limit,omitempty"`
+	// Tables are the speed limits of the tables and the databases in bytes per
+	// second, the limits of the tables are kept if it's omitted.
+	Tables restore.TableSpeedLimits `json:"tables,omitempty"`
+}
+
+type restoreSpeedLimitHandler struct{}
+
+// RestoreSpeedLimitHandler returns the handler to get the speed limits of the
+// running restore by GET, and update them by POST.
+func RestoreSpeedLimitHandler() http.Handler {
+	return restoreSpeedLimitHandler{}
+}
+
+// ServeHTTP implements http.Handler.
+func (restoreSpeedLimitHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	client := runningRestore.Load()
+	if client == nil {
+		http.Error(w, "no restore is running", http.StatusNotFound)
+		return
+	}
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodPost:
+		var limits restoreSpeedLimits
+		if err := json.NewDecoder(req.Body).Decode(&limits); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		ctx, cancel := context.WithTimeout(req.Context(), updateSpeedLimitTimeout)
+		defer cancel()
+		if err := client.UpdateSpeedLimit(ctx, limits.RateLimit, limits.Tables); err != nil {
+			log.Warn("failed to update the speed limits", zap.Error(err))
+			code := http.StatusInternalServerError
+			if berrors.ErrInvalidArgument.Equal(err) {
+				code = http.StatusBadRequest
+			}
+			http.Error(w, err.Error(), code)
+			return
+		}
+	default:
+		http.Error(w, "only GET and POST are supported", http.StatusMethodNotAllowed)
+		return
+	}
+	rateLimit, tables := client.GetSpeedLimits()
+	limits := restoreSpeedLimits{RateLimit: &rateLimit, Tables: tables}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(limits)
+}
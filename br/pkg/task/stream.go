@@ -32,6 +32,7 @@ import (
 	"github.com/pingcap/log"
 	"github.com/pingcap/tidb/br/pkg/backup"
 	"github.com/pingcap/tidb/br/pkg/conn"
+	connutil "github.com/pingcap/tidb/br/pkg/conn/util"
 	berrors "github.com/pingcap/tidb/br/pkg/errors"
 	"github.com/pingcap/tidb/br/pkg/glue"
 	"github.com/pingcap/tidb/br/pkg/httputil"
@@ -66,6 +67,13 @@ const (
 	flagStreamStartTS    = "start-ts"
 	flagStreamEndTS      = "end-ts"
 	flagGCSafePointTTS   = "gc-ttl"
+
+	flagStreamSampleInterval = "sample-interval"
+
+	flagCompactSmallFileSize  = "small-file-size-bytes"
+	flagCompactTargetFileSize = "target-file-size-bytes"
+
+	tiflashReplicaCheckInterval = 10 * time.Second
 )
 
 var (
@@ -77,6 +85,7 @@ var (
 	StreamTruncate = "log truncate"
 	StreamMetadata = "log metadata"
 	StreamCtl      = "log ctl"
+	StreamCompact  = "log compact"
 
 	skipSummaryCommandList = map[string]struct{}{
 		StreamStatus:   {},
@@ -98,6 +107,7 @@ var StreamCommandMap = map[string]func(c context.Context, g glue.Glue, cmdName s
 	StreamTruncate: RunStreamTruncate,
 	StreamMetadata: RunStreamMetadata,
 	StreamCtl:      RunStreamAdvancer,
+	StreamCompact:  RunStreamCompact,
 }
 
 // StreamConfig specifies the configure about backup stream
@@ -119,6 +129,13 @@ type StreamConfig struct {
 
 	// Spec for the command `status`.
 	JSONOutput bool `json:"json-output" toml:"json-output"`
+	// SampleInterval is the interval between the samples of the metrics of
+	// the stores, by which the speed of the log backup is calculated.
+	SampleInterval time.Duration `json:"sample-interval" toml:"sample-interval"`
+
+	// Spec for the command `compact`.
+	CompactSmallFileSize  uint64 `json:"small-file-size" toml:"small-file-size"`
+	CompactTargetFileSize uint64 `json:"target-file-size" toml:"target-file-size"`
 
 	// Spec for the command `advancer`.
 	AdvancerCfg advancercfg.Config `json:"advancer-config" toml:"advancer-config"`
@@ -129,11 +146,8 @@ func (cfg *StreamConfig) makeStorage(ctx context.Context) (storage.ExternalStora
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	opts := storage.ExternalStorageOptions{
-		NoCredentials:   cfg.NoCreds,
-		SendCredentials: cfg.SendCreds,
-	}
-	storage, err := storage.New(ctx, u, &opts)
+	opts := storageOpts(&cfg.Config)
+	storage, err := storage.New(ctx, u, opts)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -174,6 +188,10 @@ func DefineStreamStatusCommonFlags(flags *pflag.FlagSet) {
 	flags.Bool(flagStreamJSONOutput, false,
 		"Print JSON as the output.",
 	)
+	flags.Duration(flagStreamSampleInterval, stream.DefaultStatusSampleInterval,
+		"The interval between the samples of the metrics of the stores, "+
+			"the longer interval makes the estimated speed more accurate.",
+	)
 }
 
 func DefineStreamTruncateLogFlags(flags *pflag.FlagSet) {
@@ -183,12 +201,45 @@ func DefineStreamTruncateLogFlags(flags *pflag.FlagSet) {
 	flags.BoolP(flagYes, "y", false, "Skip all prompts and always execute the command.")
 }
 
+// DefineStreamCompactFlags defines flags used for `log compact`.
+func DefineStreamCompactFlags(flags *pflag.FlagSet) {
+	flags.Uint64(flagCompactSmallFileSize, 8*units.MiB, "The data files smaller than it are compacted.")
+	flags.Uint64(flagCompactTargetFileSize, 128*units.MiB, "The max size of the compacted data files.")
+	flags.Bool(flagDryRun, false, "Print the files to compact but don't really compact them.")
+}
+
+// ParseStreamCompactFromFlags parses parameters for `log compact`.
+func (cfg *StreamConfig) ParseStreamCompactFromFlags(flags *pflag.FlagSet) error {
+	var err error
+	if cfg.CompactSmallFileSize, err = flags.GetUint64(flagCompactSmallFileSize); err != nil {
+		return errors.Trace(err)
+	}
+	if cfg.CompactTargetFileSize, err = flags.GetUint64(flagCompactTargetFileSize); err != nil {
+		return errors.Trace(err)
+	}
+	if cfg.CompactTargetFileSize <= cfg.CompactSmallFileSize {
+		return errors.Annotatef(berrors.ErrInvalidArgument, "--%s should be larger than --%s",
+			flagCompactTargetFileSize, flagCompactSmallFileSize)
+	}
+	if cfg.DryRun, err = flags.GetBool(flagDryRun); err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
 func (cfg *StreamConfig) ParseStreamStatusFromFlags(flags *pflag.FlagSet) error {
 	var err error
 	cfg.JSONOutput, err = flags.GetBool(flagStreamJSONOutput)
 	if err != nil {
 		return errors.Trace(err)
 	}
+	cfg.SampleInterval, err = flags.GetDuration(flagStreamSampleInterval)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if cfg.SampleInterval <= 0 {
+		return errors.Annotatef(berrors.ErrInvalidArgument, "--%s must be positive", flagStreamSampleInterval)
+	}
 
 	if err = cfg.ParseStreamCommonFromFlags(flags); err != nil {
 		return errors.Trace(err)
@@ -311,16 +362,13 @@ func NewStreamMgr(ctx context.Context, cfg *StreamConfig, g glue.Glue, isStreamS
 			return nil, errors.Trace(err)
 		}
 
-		opts := storage.ExternalStorageOptions{
-			NoCredentials:   cfg.NoCreds,
-			SendCredentials: cfg.SendCreds,
-		}
+		opts := storageOpts(&cfg.Config)
 		client, err := backup.NewBackupClient(ctx, mgr)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
 
-		if err = client.SetStorage(ctx, backend, &opts); err != nil {
+		if err = client.SetStorage(ctx, backend, opts); err != nil {
 			return nil, errors.Trace(err)
 		}
 		s.bc = client
@@ -817,7 +865,9 @@ func makeStatusController(ctx context.Context, cfg *StreamConfig, g glue.Glue) (
 	if err != nil {
 		return nil, err
 	}
-	return stream.NewStatusController(cli, mgr, printer), nil
+	ctl := stream.NewStatusController(cli, mgr, printer)
+	ctl.SetSampleInterval(cfg.SampleInterval)
+	return ctl, nil
 }
 
 // RunStreamStatus get status for a specific stream task
@@ -960,6 +1010,39 @@ func RunStreamTruncate(c context.Context, g glue.Glue, cmdName string, cfg *Stre
 	return nil
 }
 
+// RunStreamCompact merges the small data files of the log backup fully
+// flushed before the global checkpoint into the larger ones.
+func RunStreamCompact(c context.Context, g glue.Glue, cmdName string, cfg *StreamConfig) error {
+	ctx, cancelFn := context.WithCancel(c)
+	defer cancelFn()
+
+	storage, err := cfg.makeStorage(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	checkpoint, err := getGlobalCheckpointFromStorage(ctx, storage)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if checkpoint == 0 {
+		return errors.Annotate(berrors.ErrInvalidArgument, "the global checkpoint of the log backup isn't found")
+	}
+	stats, err := restore.CompactStreamFiles(ctx, storage, restore.StreamCompactionConfig{
+		SmallFileSize:  cfg.CompactSmallFileSize,
+		TargetFileSize: cfg.CompactTargetFileSize,
+		Until:          checkpoint,
+		Concurrency:    uint(cfg.Concurrency),
+		DryRun:         cfg.DryRun,
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	summary.Log(cmdName, zap.Bool("dry-run", cfg.DryRun), zap.Uint64("checkpoint", checkpoint),
+		zap.Int("input-files", stats.InputFiles), zap.Uint64("input-size", stats.InputSize),
+		zap.Int("output-files", stats.OutputFiles), zap.Uint64("output-size", stats.OutputSize))
+	return nil
+}
+
 // RunStreamRestore restores stream log.
 func RunStreamRestore(
 	c context.Context,
@@ -1002,11 +1085,31 @@ func RunStreamRestore(
 	if err := checkLogRange(cfg.StartTS, cfg.RestoreTS, logMinTS, logMaxTS); err != nil {
 		return errors.Trace(err)
 	}
+	if cfg.Preview {
+		confirmed, err := previewStreamRestore(ctx, g, cfg)
+		if err != nil {
+			return errors.Annotate(err, "failed to preview the log restore")
+		}
+		if !confirmed {
+			log.Info("the log restore isn't confirmed after previewing", zap.Uint64("restore-to", cfg.RestoreTS))
+			return nil
+		}
+	}
 
 	recorder := tiflashrec.New()
 	cfg.tiflashRecorder = recorder
+	// the snapshot is restored if the log restore is interrupted.
+	snapshotRestored := false
+	if len(cfg.FullBackupStorage) > 0 && cfg.Resume {
+		if snapshotRestored, err = logRestoreInterrupted(ctx, g, cfg); err != nil {
+			return errors.Trace(err)
+		}
+		if snapshotRestored {
+			log.Info("skip restoring the snapshot restored by the interrupted restore, resume restoring the log")
+		}
+	}
 	// restore full snapshot.
-	if len(cfg.FullBackupStorage) > 0 {
+	if len(cfg.FullBackupStorage) > 0 && !snapshotRestored {
 		if err := checkPiTRRequirements(ctx, g, cfg); err != nil {
 			return errors.Trace(err)
 		}
@@ -1026,6 +1129,53 @@ func RunStreamRestore(
 	return nil
 }
 
+// previewStreamRestore prints the DDL jobs and the tables affected by restoring
+// the log up to the restore ts without writing any data. It returns whether to
+// continue restoring, which is confirmed by the user in the interactive shell,
+// or false otherwise so the preview never restores unattended.
+func previewStreamRestore(ctx context.Context, g glue.Glue, cfg *RestoreConfig) (bool, error) {
+	mgr, err := NewMgr(ctx, g, cfg.PD, cfg.TLS, GetKeepalive(&cfg.Config),
+		cfg.CheckRequirements, true, conn.StreamVersionChecker)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	defer mgr.Close()
+
+	client, err := createRestoreClient(ctx, g, cfg, mgr)
+	if err != nil {
+		return false, errors.Annotate(err, "failed to create restore client")
+	}
+	defer client.Close()
+
+	shiftStartTS, err := client.GetShiftTS(ctx, cfg.StartTS, cfg.RestoreTS)
+	if err != nil {
+		return false, errors.Annotate(err, "failed to get shift TS")
+	}
+	metas, err := client.ReadStreamMetaByTS(ctx, shiftStartTS, cfg.RestoreTS)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	client.SetRestoreRangeTS(cfg.StartTS, cfg.RestoreTS, shiftStartTS)
+	dataFiles, metaFiles, err := client.ReadStreamDataFiles(ctx, metas)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	preview, err := client.PreviewLogRestore(ctx, dataFiles, metaFiles, cfg.TableFilter)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+
+	console := glue.GetConsole(g)
+	console.Printf("restore the log from %d(%s) to %d(%s)\n",
+		cfg.StartTS, stream.FormatDate(oracle.GetTimeFromTS(cfg.StartTS)),
+		cfg.RestoreTS, stream.FormatDate(oracle.GetTimeFromTS(cfg.RestoreTS)))
+	console.Print(preview.Table())
+	if !console.IsInteractive() {
+		return false, nil
+	}
+	return console.PromptBool("Restore to the point? "), nil
+}
+
 // RunStreamRestore start restore job
 func restoreStream(
 	c context.Context,
@@ -1086,7 +1236,7 @@ func restoreStream(
 	}
 	client.SetCurrentTS(currentTS)
 
-	restoreSchedulers, err := restorePreWork(ctx, client, mgr, false)
+	restoreSchedulers, err := restorePreWork(ctx, client, mgr, cfg.PauseStateFile, false)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -1110,6 +1260,13 @@ func restoreStream(
 	}
 
 	client.SetRestoreRangeTS(cfg.StartTS, cfg.RestoreTS, shiftStartTS)
+	if cfg.MetaKVBatchSize > 0 {
+		client.SetMetaKVBatchSize(cfg.MetaKVBatchSize)
+	}
+	logCheckpoint, err := client.InitLogCheckpoint(ctx, cfg.Resume)
+	if err != nil {
+		return errors.Trace(err)
+	}
 
 	// read data file by given ts.
 	dmlFiles, ddlFiles, err := client.ReadStreamDataFiles(ctx, metas)
@@ -1128,6 +1285,38 @@ func restoreStream(
 	if err != nil {
 		return errors.Trace(err)
 	}
+	// rewrite the meta kv entries to the IDs allocated by the interrupted restore.
+	if idMap := logCheckpoint.IDMap(); idMap != nil {
+		schemasReplace.MergeIDMap(idMap)
+	}
+	// persist the records of the TiFlash replicas as soon as the replicas are removed, so they
+	// can be re-applied by `br restore tiflash-replica` if we exit before setting them back.
+	var recordStorage storage.ExternalStorage
+	if cfg.tiflashRecorder != nil {
+		if _, recordStorage, err = GetStorage(ctx, cfg.Storage, &cfg.Config); err != nil {
+			log.Warn("failed to open the storage to persist the TiFlash replica records", logutil.ShortError(err))
+			recordStorage = nil
+		} else if cfg.Resume {
+			// the replicas may have been removed by the interrupted restore.
+			persisted, err := tiflashrec.Load(ctx, recordStorage)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if persisted != nil {
+				persisted.Iterate(cfg.tiflashRecorder.AddTable)
+			}
+		}
+	}
+	persistTiFlashRecords := func() {
+		if recordStorage == nil || cfg.tiflashRecorder.Len() == 0 {
+			return
+		}
+		if err := cfg.tiflashRecorder.Save(ctx, recordStorage); err != nil {
+			log.Warn("failed to persist the TiFlash replica records", logutil.ShortError(err))
+		}
+	}
+	// the replicas of the tables restored from the snapshot are removed.
+	persistTiFlashRecords()
 	schemasReplace.AfterTableRewritten = func(deleted bool, tableInfo *model.TableInfo) {
 		// When the table replica changed to 0, the tiflash replica might be set to `nil`.
 		// We should remove the table if we meet.
@@ -1148,11 +1337,12 @@ func restoreStream(
 	}
 	pm := g.StartProgress(ctx, "Restore Meta Files", int64(len(ddlFiles)), !cfg.LogProgress)
 	if err = withProgress(pm, func(p glue.Progress) error {
-		client.RunGCRowsLoader(ctx)
 		return client.RestoreMetaKVFiles(ctx, ddlFiles, schemasReplace, updateStats, p.Inc)
 	}); err != nil {
+		persistTiFlashRecords()
 		return errors.Annotate(err, "failed to restore meta files")
 	}
+	persistTiFlashRecords()
 
 	// perform restore kv files
 	rewriteRules, err := initRewriteRules(client, fullBackupTables)
@@ -1180,28 +1370,203 @@ func restoreStream(
 	if err = client.InsertGCRows(ctx); err != nil {
 		return errors.Annotate(err, "failed to insert rows into gc_delete_range")
 	}
+	if err = logCheckpoint.Remove(ctx); err != nil {
+		log.Warn("failed to remove the log restore checkpoint", logutil.ShortError(err))
+	}
 
-	if cfg.tiflashRecorder != nil {
-		sqls := cfg.tiflashRecorder.GenerateAlterTableDDLs(mgr.GetDomain().InfoSchema())
-		log.Info("Generating SQLs for restoring TiFlash Replica",
-			zap.Strings("sqls", sqls))
-		err = g.UseOneShotSession(mgr.GetStorage(), false, func(se glue.Session) error {
+	// advance the readable ts of the cluster, so the stale reads with
+	// tidb_enable_external_ts_read can read the restored data.
+	err = g.UseOneShotSession(mgr.GetStorage(), false, func(se glue.Session) error {
+		return se.ExecuteInternal(ctx, "SET GLOBAL tidb_external_ts = %?", cfg.RestoreTS)
+	})
+	if err != nil {
+		log.Warn("failed to advance the external timestamp",
+			zap.Uint64("restore-ts", cfg.RestoreTS), logutil.ShortError(err))
+	}
+
+	if cfg.tiflashRecorder != nil && cfg.tiflashRecorder.Len() > 0 {
+		// the persisted records are updated or removed after setting the replicas back.
+		if cfg.DeferTiFlashReplica {
+			err = reconcileTiFlashReplicas(ctx, g, mgr, cfg.tiflashRecorder, recordStorage, cfg.TiFlashReplicaWaitTimeout, cfg.LogProgress)
+		} else {
+			err = restoreTiFlashReplicas(ctx, g, mgr, cfg.tiflashRecorder, recordStorage, cfg.LogProgress)
+		}
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	return nil
+}
+
+// restoreTiFlashReplicas sets the recorded TiFlash replicas back. The persisted
+// records in the storage are removed once all the replicas are set, the storage
+// can be nil if the records are not persisted.
+func restoreTiFlashReplicas(
+	ctx context.Context,
+	g glue.Glue,
+	mgr *conn.Mgr,
+	recorder *tiflashrec.TiFlashRecorder,
+	s storage.ExternalStorage,
+	logProgress bool,
+) error {
+	failed, err := setTiFlashReplicas(ctx, g, mgr, recorder, logProgress)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if s == nil {
+		return nil
+	}
+	if failed > 0 {
+		logutil.WarnTerm("Some tiflash replica configs are not restored, you may run `br restore tiflash-replica` to retry.",
+			zap.Int("failed", failed),
+			zap.String("records", tiflashrec.RecordFile),
+		)
+		return nil
+	}
+	if err := tiflashrec.Remove(ctx, s); err != nil {
+		log.Warn("failed to remove the TiFlash replica records", logutil.ShortError(err))
+	}
+	return nil
+}
+
+// setTiFlashReplicas executes the DDLs setting the recorded TiFlash replicas,
+// it returns the number of the DDLs failed.
+func setTiFlashReplicas(
+	ctx context.Context,
+	g glue.Glue,
+	mgr *conn.Mgr,
+	recorder *tiflashrec.TiFlashRecorder,
+	logProgress bool,
+) (int, error) {
+	sqls := recorder.GenerateAlterTableDDLs(mgr.GetDomain().InfoSchema())
+	log.Info("Generating SQLs for restoring TiFlash Replica",
+		zap.Strings("sqls", sqls))
+	failed := 0
+	p := g.StartProgress(ctx, "Restore TiFlash Replicas", int64(len(sqls)), !logProgress)
+	err := withProgress(p, func(p glue.Progress) error {
+		return g.UseOneShotSession(mgr.GetStorage(), false, func(se glue.Session) error {
 			for _, sql := range sqls {
 				if errExec := se.ExecuteInternal(ctx, sql); errExec != nil {
 					logutil.WarnTerm("Failed to restore tiflash replica config, you may execute the sql restore it manually.",
 						logutil.ShortError(errExec),
 						zap.String("sql", sql),
 					)
+					failed++
 				}
+				p.Inc()
 			}
 			return nil
 		})
+	})
+	return failed, errors.Trace(err)
+}
+
+// reconcileTiFlashReplicas sets the deferred TiFlash replicas once there are
+// enough TiFlash stores for them, it waits for the TiFlash stores to join at
+// most the timeout. The replicas not set are persisted in the storage, so they
+// can be set by `br restore tiflash-replica` later, the storage can be nil if
+// the records are not persisted.
+func reconcileTiFlashReplicas(
+	ctx context.Context,
+	g glue.Glue,
+	mgr *conn.Mgr,
+	recorder *tiflashrec.TiFlashRecorder,
+	s storage.ExternalStorage,
+	timeout time.Duration,
+	logProgress bool,
+) error {
+	persist := func() {
+		if s == nil {
+			return
+		}
+		var err error
+		if recorder.Len() > 0 {
+			err = recorder.Save(ctx, s)
+		} else {
+			err = tiflashrec.Remove(ctx, s)
+		}
 		if err != nil {
-			return err
+			log.Warn("failed to persist the deferred TiFlash replica records", logutil.ShortError(err))
 		}
 	}
+	persist()
 
-	return nil
+	deadline := time.Now().Add(timeout)
+	for {
+		stores, err := connutil.GetAllTiKVStores(ctx, mgr.GetPDClient(), connutil.TiFlashOnly)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		satisfiable := recorder.TakeSatisfiable(uint64(len(stores)))
+		if satisfiable.Len() > 0 {
+			log.Info("setting the deferred TiFlash replicas",
+				zap.Int("tiflash-stores", len(stores)), zap.Int("tables", satisfiable.Len()))
+			failed, err := setTiFlashReplicas(ctx, g, mgr, satisfiable, logProgress)
+			if err != nil || failed > 0 {
+				// setting the replicas is idempotent, so all of them are kept to retry.
+				satisfiable.Iterate(recorder.AddTable)
+			}
+			persist()
+			if err != nil {
+				return errors.Trace(err)
+			}
+		}
+		if recorder.Len() == 0 {
+			return nil
+		}
+
+		wait := time.Until(deadline)
+		if wait <= 0 {
+			logutil.WarnTerm("Some tiflash replicas are deferred since there aren't enough tiflash stores, "+
+				"you may run `br restore tiflash-replica --defer-tiflash-replica` to set them once the tiflash stores join.",
+				zap.Int("tables", recorder.Len()),
+				zap.Int("tiflash-stores", len(stores)),
+				zap.String("records", tiflashrec.RecordFile),
+			)
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-time.After(mathutil.Min(wait, tiflashReplicaCheckInterval)):
+		}
+	}
+}
+
+// RunRestoreTiFlashReplica sets the TiFlash replicas persisted by an interrupted
+// point restore, or deferred by a restore for lacking TiFlash stores, back.
+// It's idempotent, so it can be run again if it fails.
+func RunRestoreTiFlashReplica(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConfig) error {
+	ctx, cancel := context.WithCancel(c)
+	defer cancel()
+
+	_, s, err := GetStorage(ctx, cfg.Storage, &cfg.Config)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	recorder, err := tiflashrec.Load(ctx, s)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if recorder == nil {
+		log.Info("no TiFlash replica records found, nothing to restore",
+			zap.String("storage", s.URI()), zap.String("records", tiflashrec.RecordFile))
+		return nil
+	}
+
+	mgr, err := NewMgr(ctx, g, cfg.PD, cfg.TLS, GetKeepalive(&cfg.Config),
+		cfg.CheckRequirements, true, conn.NormalVersionChecker)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer mgr.Close()
+
+	log.Info("restoring the recorded TiFlash replicas", zap.String("cmd", cmdName), zap.Int("tables", recorder.Len()))
+	if cfg.DeferTiFlashReplica {
+		return reconcileTiFlashReplicas(ctx, g, mgr, recorder, s, cfg.TiFlashReplicaWaitTimeout, cfg.LogProgress)
+	}
+	return restoreTiFlashReplicas(ctx, g, mgr, recorder, s, cfg.LogProgress)
 }
 
 func createRestoreClient(ctx context.Context, g glue.Glue, cfg *RestoreConfig, mgr *conn.Mgr) (*restore.Client, error) {
@@ -1224,18 +1589,15 @@ func createRestoreClient(ctx context.Context, g glue.Glue, cfg *RestoreConfig, m
 		return nil, errors.Trace(err)
 	}
 
-	opts := storage.ExternalStorageOptions{
-		NoCredentials:   cfg.NoCreds,
-		SendCredentials: cfg.SendCreds,
-	}
-	if err = client.SetStorage(ctx, u, &opts); err != nil {
+	opts := storageOpts(&cfg.Config)
+	if err = client.SetStorage(ctx, u, opts); err != nil {
 		return nil, errors.Trace(err)
 	}
 	client.SetRateLimit(cfg.RateLimit)
 	client.SetCrypter(&cfg.CipherInfo)
 	client.SetConcurrency(uint(cfg.Concurrency))
 	client.SetSwitchModeInterval(cfg.SwitchModeInterval)
-	client.InitClients(u, false)
+	client.InitClients(u, restore.TiDBMode)
 
 	rawKVClient, err := newRawBatchClient(ctx, cfg.PD, cfg.TLS)
 	if err != nil {
@@ -1322,12 +1684,8 @@ func getLogRange(
 
 func getGlobalCheckpointFromStorage(ctx context.Context, s storage.ExternalStorage) (uint64, error) {
 	var globalCheckPointTS uint64 = 0
-	opt := storage.WalkOption{SubDir: stream.GetStreamBackupGlobalCheckpointPrefix()}
+	opt := storage.WalkOption{SubDir: stream.GetStreamBackupGlobalCheckpointPrefix(), ObjSuffix: ".ts"}
 	err := s.WalkDir(ctx, &opt, func(path string, size int64) error {
-		if !strings.HasSuffix(path, ".ts") {
-			return nil
-		}
-
 		buff, err := s.ReadFile(ctx, path)
 		if err != nil {
 			return errors.Trace(err)
@@ -1573,6 +1931,28 @@ func buildPauseSafePointName(taskName string) string {
 	return fmt.Sprintf("%s_pause_safepoint", taskName)
 }
 
+// logRestoreInterrupted returns whether the checkpoint of an interrupted log
+// restore exists, which is created after the snapshot is restored.
+func logRestoreInterrupted(ctx context.Context, g glue.Glue, cfg *RestoreConfig) (bool, error) {
+	mgr, err := NewMgr(ctx, g, cfg.PD, cfg.TLS, GetKeepalive(&cfg.Config),
+		cfg.CheckRequirements, true, conn.StreamVersionChecker)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	defer mgr.Close()
+
+	_, s, err := GetStorage(ctx, cfg.Storage, &cfg.Config)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	var exists bool
+	err = g.UseOneShotSession(mgr.GetStorage(), false, func(se glue.Session) error {
+		exists, err = restore.LogCheckpointExists(ctx, s, se)
+		return errors.Trace(err)
+	})
+	return exists, errors.Trace(err)
+}
+
 func checkPiTRRequirements(ctx context.Context, g glue.Glue, cfg *RestoreConfig) error {
 	mgr, err := NewMgr(ctx, g, cfg.PD, cfg.TLS, GetKeepalive(&cfg.Config),
 		cfg.CheckRequirements, true, conn.StreamVersionChecker)
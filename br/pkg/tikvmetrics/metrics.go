@@ -0,0 +1,152 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package tikvmetrics
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/httputil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// Scraper scrapes the metrics of the TiKV stores from their status API.
+type Scraper struct {
+	cli    *http.Client
+	scheme string
+}
+
+// NewScraper creates a Scraper, the status API is accessed by HTTPS if tlsConf isn't nil.
+func NewScraper(tlsConf *tls.Config) *Scraper {
+	scheme := "http://"
+	if tlsConf != nil {
+		scheme = "https://"
+	}
+	return &Scraper{
+		cli:    httputil.NewClient(tlsConf),
+		scheme: scheme,
+	}
+}
+
+// Get gets the path of the status API of the store, the caller should close
+// the body of the response.
+func (s *Scraper) Get(ctx context.Context, statusAddr, path string) (*http.Response, error) {
+	url := s.scheme + statusAddr + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	resp, err := s.cli.Do(req)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, errors.Errorf("unexpected status %s of %s", resp.Status, url)
+	}
+	return resp, nil
+}
+
+// Scrape scrapes the metrics of the store.
+func (s *Scraper) Scrape(ctx context.Context, statusAddr string) (*Metrics, error) {
+	resp, err := s.Get(ctx, statusAddr, "/metrics")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer resp.Body.Close()
+	m, err := Parse(resp.Body)
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid metrics of %s", statusAddr)
+	}
+	return m, nil
+}
+
+// Metrics are the metrics of a TiKV store.
+type Metrics struct {
+	// At is the time the metrics are parsed.
+	At       time.Time
+	families map[string]*dto.MetricFamily
+}
+
+// Parse parses the metrics of TiKV in the text format.
+func Parse(r io.Reader) (*Metrics, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &Metrics{At: time.Now(), families: families}, nil
+}
+
+// Histogram is the sum and the count of the samples of histograms.
+type Histogram struct {
+	Sum   float64
+	Count float64
+}
+
+// Sum returns the sum of the counters or the gauges of the metric.
+func (m *Metrics) Sum(name string) float64 {
+	var sum float64
+	for _, metric := range m.families[name].GetMetric() {
+		sum += value(metric)
+	}
+	return sum
+}
+
+// SumBy returns the sums of the counters or the gauges of the metric grouped by the label.
+func (m *Metrics) SumBy(name, label string) map[string]float64 {
+	sums := make(map[string]float64)
+	for _, metric := range m.families[name].GetMetric() {
+		sums[LabelValue(metric, label)] += value(metric)
+	}
+	return sums
+}
+
+// HistogramSum returns the sum of the histograms of the metric.
+func (m *Metrics) HistogramSum(name string) Histogram {
+	var h Histogram
+	for _, metric := range m.families[name].GetMetric() {
+		h.Sum += metric.GetHistogram().GetSampleSum()
+		h.Count += float64(metric.GetHistogram().GetSampleCount())
+	}
+	return h
+}
+
+// HistogramSumBy returns the sums of the histograms of the metric grouped by the label.
+func (m *Metrics) HistogramSumBy(name, label string) map[string]Histogram {
+	sums := make(map[string]Histogram)
+	for _, metric := range m.families[name].GetMetric() {
+		h := sums[LabelValue(metric, label)]
+		h.Sum += metric.GetHistogram().GetSampleSum()
+		h.Count += float64(metric.GetHistogram().GetSampleCount())
+		sums[LabelValue(metric, label)] = h
+	}
+	return sums
+}
+
+func value(metric *dto.Metric) float64 {
+	switch {
+	case metric.Counter != nil:
+		return metric.GetCounter().GetValue()
+	case metric.Gauge != nil:
+		return metric.GetGauge().GetValue()
+	default:
+		return metric.GetUntyped().GetValue()
+	}
+}
+
+// LabelValue returns the value of the label of the metric, it's empty if the
+// metric has no such label.
+func LabelValue(metric *dto.Metric, name string) string {
+	for _, l := range metric.GetLabel() {
+		if l.GetName() == name {
+			return l.GetValue()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,67 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package tikvmetrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testMetrics = `# TYPE process_cpu_seconds_total counter
+process_cpu_seconds_total 120.5
+# TYPE tikv_server_cpu_cores_quota gauge
+tikv_server_cpu_cores_quota 8
+# TYPE tikv_log_backup_errors counter
+tikv_log_backup_errors{type="Io"} 3
+tikv_log_backup_errors{type="Etcd"} 1
+# TYPE tikv_grpc_msg_duration_seconds histogram
+tikv_grpc_msg_duration_seconds_bucket{type="kv_get",le="+Inf"} 100
+tikv_grpc_msg_duration_seconds_sum{type="kv_get"} 0.5
+tikv_grpc_msg_duration_seconds_count{type="kv_get"} 100
+tikv_grpc_msg_duration_seconds_bucket{type="raft",le="+Inf"} 1000
+tikv_grpc_msg_duration_seconds_sum{type="raft"} 100
+tikv_grpc_msg_duration_seconds_count{type="raft"} 1000
+`
+
+func TestParse(t *testing.T) {
+	m, err := Parse(strings.NewReader(testMetrics))
+	require.NoError(t, err)
+	require.Equal(t, 120.5, m.Sum("process_cpu_seconds_total"))
+	require.Equal(t, 8.0, m.Sum("tikv_server_cpu_cores_quota"))
+	require.Equal(t, 0.0, m.Sum("not_exist"))
+	require.Equal(t, map[string]float64{"Io": 3, "Etcd": 1}, m.SumBy("tikv_log_backup_errors", "type"))
+	require.Equal(t, Histogram{Sum: 100.5, Count: 1100}, m.HistogramSum("tikv_grpc_msg_duration_seconds"))
+	require.Equal(t, map[string]Histogram{
+		"kv_get": {Sum: 0.5, Count: 100},
+		"raft":   {Sum: 100, Count: 1000},
+	}, m.HistogramSumBy("tikv_grpc_msg_duration_seconds", "type"))
+	require.Empty(t, m.HistogramSumBy("not_exist", "type"))
+
+	_, err = Parse(strings.NewReader("invalid metrics"))
+	require.Error(t, err)
+}
+
+func TestScrape(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/metrics" {
+			http.NotFound(w, req)
+			return
+		}
+		_, _ = w.Write([]byte(testMetrics))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	s := NewScraper(nil)
+	statusAddr := strings.TrimPrefix(server.URL, "http://")
+	m, err := s.Scrape(ctx, statusAddr)
+	require.NoError(t, err)
+	require.Equal(t, 120.5, m.Sum("process_cpu_seconds_total"))
+	_, err = s.Get(ctx, statusAddr, "/config")
+	require.Regexp(t, "unexpected status 404", err.Error())
+}
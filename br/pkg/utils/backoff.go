@@ -108,30 +108,24 @@ func NewDownloadSSTBackoffer() Backoffer {
 }
 
 func (bo *importerBackoffer) NextBackoff(err error) time.Duration {
-	if MessageIsRetryableStorageError(err.Error()) {
+	switch {
+	case berrors.IsRetryable(err):
 		bo.delayTime = 2 * bo.delayTime
 		bo.attempt--
-	} else {
-		e := errors.Cause(err)
-		switch e { // nolint:errorlint
-		case berrors.ErrKVEpochNotMatch, berrors.ErrKVDownloadFailed, berrors.ErrKVIngestFailed, berrors.ErrPDLeaderNotFound:
+	case berrors.Is(err, berrors.ErrKVRangeIsEmpty), berrors.Is(err, berrors.ErrKVRewriteRuleNotFound):
+		// Excepted error, finish the operation
+		bo.delayTime = 0
+		bo.attempt = 0
+	default:
+		switch status.Code(errors.Cause(err)) {
+		case codes.Unavailable, codes.Aborted:
 			bo.delayTime = 2 * bo.delayTime
 			bo.attempt--
-		case berrors.ErrKVRangeIsEmpty, berrors.ErrKVRewriteRuleNotFound:
-			// Excepted error, finish the operation
+		default:
+			// Unexcepted error
 			bo.delayTime = 0
 			bo.attempt = 0
-		default:
-			switch status.Code(e) {
-			case codes.Unavailable, codes.Aborted:
-				bo.delayTime = 2 * bo.delayTime
-				bo.attempt--
-			default:
-				// Unexcepted error
-				bo.delayTime = 0
-				bo.attempt = 0
-				log.Warn("unexcepted error, stop to retry", zap.Error(err))
-			}
+			log.Warn("unexcepted error, stop to retry", zap.Error(err))
 		}
 	}
 	if bo.delayTime > bo.maxDelayTime {
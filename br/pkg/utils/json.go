@@ -154,8 +154,10 @@ func makeJSONBackupMeta(meta *backuppb.BackupMeta) (*jsonBackupMeta, error) {
 		}
 		result.Schemas = append(result.Schemas, s)
 	}
-	if err := json.Unmarshal(meta.Ddls, &result.DDLs); err != nil {
-		return nil, errors.Trace(err)
+	if len(meta.Ddls) > 0 {
+		if err := json.Unmarshal(meta.Ddls, &result.DDLs); err != nil {
+			return nil, errors.Trace(err)
+		}
 	}
 	return result, nil
 }
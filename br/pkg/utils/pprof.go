@@ -17,6 +17,7 @@ import (
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/log"
 	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/progress"
 	tidbutils "github.com/pingcap/tidb/util"
 	"go.uber.org/zap"
 )
@@ -26,6 +27,11 @@ var (
 	mu           sync.Mutex
 )
 
+func init() {
+	// serve the progress of the tasks at the status address along with pprof.
+	http.Handle("/progress", progress.DefaultRegistry)
+}
+
 func listen(statusAddr string) (net.Listener, error) {
 	mu.Lock()
 	defer mu.Unlock()
@@ -7,12 +7,12 @@ import (
 	"encoding/json"
 	"io"
 	"sync"
-	"sync/atomic"
 	"time"
 
 	"github.com/cheggaaa/pb/v3"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/progress"
 	"go.uber.org/zap"
 )
 
@@ -23,7 +23,7 @@ type ProgressPrinter struct {
 	name        string
 	total       int64
 	redirectLog bool
-	progress    int64
+	task        *progress.Task
 
 	closeMu sync.Mutex
 	closeCh chan struct{}
@@ -40,12 +40,13 @@ func NewProgressPrinter(
 		name:        name,
 		total:       total,
 		redirectLog: redirectLog,
+		task:        progress.NewTask(name, total),
 	}
 }
 
 // Inc increases the current progress bar.
 func (pp *ProgressPrinter) Inc() {
-	atomic.AddInt64(&pp.progress, 1)
+	pp.task.Inc()
 }
 
 // Close closes the current progress bar.
@@ -94,6 +95,7 @@ func (pp *ProgressPrinter) goPrintProgress(
 		bar.SetRefreshRate(2 * time.Second)
 	}
 	bar.Start()
+	progress.DefaultRegistry.Register(pp.task)
 
 	closeCh := make(chan struct{}, 1)
 	closed := make(chan struct{})
@@ -112,16 +114,18 @@ func (pp *ProgressPrinter) goPrintProgress(
 			case <-ctx.Done():
 				// a hacky way to adapt the old behavior:
 				// when canceled by the context, leave the progress unchanged.
+				pp.task.Finish(ctx.Err())
 				return
 			case <-closeCh:
 				// a hacky way to adapt the old behavior:
 				// when canceled by Close method (the 'internal' way), push the progress to 100%.
+				pp.task.Finish(nil)
 				bar.SetCurrent(pp.total)
 				return
 			case <-t.C:
 			}
 
-			currentProgress := atomic.LoadInt64(&pp.progress)
+			currentProgress := pp.task.Current()
 			if currentProgress <= pp.total {
 				bar.SetCurrent(currentProgress)
 			} else {
@@ -165,7 +169,7 @@ func StartProgress(
 	redirectLog bool,
 	log logFunc,
 ) *ProgressPrinter {
-	progress := NewProgressPrinter(name, total, redirectLog)
-	progress.goPrintProgress(ctx, log, nil)
-	return progress
+	printer := NewProgressPrinter(name, total, redirectLog)
+	printer.goPrintProgress(ctx, log, nil)
+	return printer
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pingcap/tidb/br/pkg/progress"
 	"github.com/stretchr/testify/require"
 )
 
@@ -41,6 +42,7 @@ func TestProgress(t *testing.T) {
 	p = <-pCh2
 	require.Contains(t, p, `"P":"100.00%"`)
 	progress2.Close()
+	require.Equal(t, progress.StatusFinished, progress2.task.Snapshot().Status)
 
 	pCh4 := make(chan string, 4)
 	progress4 := NewProgressPrinter("test", 4, false)
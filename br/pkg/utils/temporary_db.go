@@ -0,0 +1,57 @@
+// Copyright 2022 PingCAP, Inc. Licensed under Apache-2.0.
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/parser/model"
+	"github.com/pingcap/tidb/util/sqlexec"
+)
+
+// TemporaryDBRecordComment is the comment of the records of the temporary databases in mysql.tidb.
+const TemporaryDBRecordComment = "The restore task creating the temporary database, it's dropped with the database."
+
+// TemporaryDBRecord records the restore task creating a temporary database. It's
+// stored in mysql.tidb with the lower case name of the temporary database as
+// the variable name.
+type TemporaryDBRecord struct {
+	Task       string    `json:"task"`
+	CreateTime time.Time `json:"create_time"`
+}
+
+// IsTemporaryDB returns whether the database is a temporary database created by restore.
+func IsTemporaryDB(name model.CIStr) bool {
+	return strings.HasPrefix(name.O, temporaryDBNamePrefix)
+}
+
+// ReadTemporaryDBRecords reads the records of the temporary databases, the key
+// of the result is the lower case name of the temporary database.
+func ReadTemporaryDBRecords(ctx context.Context, exec sqlexec.RestrictedSQLExecutor) (map[string]*TemporaryDBRecord, error) {
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnBR)
+	rows, _, err := exec.ExecRestrictedSQL(ctx, nil,
+		"SELECT VARIABLE_NAME, VARIABLE_VALUE FROM mysql.tidb WHERE VARIABLE_NAME LIKE %?",
+		strings.ToLower(temporaryDBNamePrefix)+"%")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	records := make(map[string]*TemporaryDBRecord, len(rows))
+	for _, row := range rows {
+		name := row.GetString(0)
+		// the underscores in the prefix are wildcards of LIKE.
+		if !strings.HasPrefix(name, strings.ToLower(temporaryDBNamePrefix)) {
+			continue
+		}
+		record := &TemporaryDBRecord{}
+		if err := json.Unmarshal([]byte(row.GetString(1)), record); err != nil {
+			return nil, errors.Annotatef(err, "invalid record of temporary database %s", name)
+		}
+		records[name] = record
+	}
+	return records, nil
+}
@@ -206,6 +206,16 @@ func CheckVersionForBR(s *metapb.Store, tikvVersion *semver.Version) error {
 	return nil
 }
 
+// CheckStoreVersionForBR checks whether the version of the TiKV store is compatible with BR.
+func CheckStoreVersionForBR(s *metapb.Store) error {
+	tikvVersionString := removeVAndHash(s.Version)
+	tikvVersion, err := semver.NewVersion(tikvVersionString)
+	if err != nil {
+		return errors.Annotatef(berrors.ErrVersionMismatch, "%s: TiKV node %s version %s is invalid", err, s.Address, tikvVersionString)
+	}
+	return CheckVersionForBR(s, tikvVersion)
+}
+
 // CheckVersion checks if the actual version is within [requiredMinVersion, requiredMaxVersion).
 func CheckVersion(component string, actual, requiredMinVersion, requiredMaxVersion semver.Version) error {
 	if actual.Compare(requiredMinVersion) < 0 {
@@ -0,0 +1,88 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/traffic"
+	"go.uber.org/zap"
+)
+
+var (
+	dsn      = flag.String("dsn", "root:@tcp(127.0.0.1:4000)/", "data source name of the target cluster")
+	input    = flag.String("input", "", "storage url of the captured traffic, e.g. local:///tmp/traffic or s3://bucket/traffic")
+	speed    = flag.Float64("speed", 1, "replay speed relative to the captured speed")
+	readOnly = flag.Bool("read-only", false, "only replay the statements that don't modify data")
+	maxConns = flag.Int("max-conns", 0, "maximum number of open connections to the target cluster, 0 means unlimited")
+	logLevel = flag.String("L", "info", "log level")
+)
+
+func main() {
+	flag.Parse()
+	err := logutil.InitLogger(logutil.NewLogConfig(*logLevel, logutil.DefaultLogFormat, "", logutil.EmptyFileLogConfig, false))
+	terror.MustNil(err)
+	if *input == "" {
+		fmt.Fprintln(os.Stderr, "the input is not specified")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sc := make(chan os.Signal, 1)
+	signal.Notify(sc, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-sc
+		log.Info("got signal, stop replaying", zap.Stringer("signal", sig))
+		cancel()
+	}()
+
+	backend, err := storage.ParseBackend(*input, nil)
+	terror.MustNil(err)
+	store, err := storage.New(ctx, backend, &storage.ExternalStorageOptions{})
+	terror.MustNil(err)
+	records, err := traffic.LoadRecords(ctx, store)
+	terror.MustNil(err)
+	log.Info("traffic loaded", zap.Int("records", len(records)))
+
+	db, err := sql.Open("mysql", *dsn)
+	terror.MustNil(err)
+	defer func() {
+		terror.Log(db.Close())
+	}()
+	db.SetMaxOpenConns(*maxConns)
+
+	replayer := traffic.NewReplayer(traffic.ReplayConfig{Speed: *speed, ReadOnly: *readOnly},
+		traffic.NewSQLSessionFactory(db))
+	stats, err := replayer.Replay(ctx, records)
+	if err != nil {
+		log.Warn("replay is interrupted", zap.Error(err))
+	}
+	data, err := json.MarshalIndent(stats, "", "  ")
+	terror.MustNil(err)
+	fmt.Println(string(data))
+}
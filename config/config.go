@@ -782,6 +782,22 @@ type Plugin struct {
 type TopSQL struct {
 	// The TopSQL's data receiver address.
 	ReceiverAddress string `toml:"receiver-address" json:"receiver-address"`
+	// EnableLocalStorage stores the Top SQL data in tidb-server, which can be queried
+	// from INFORMATION_SCHEMA.TIDB_TOP_SQL without deploying ng-monitoring.
+	EnableLocalStorage bool `toml:"enable-local-storage" json:"enable-local-storage"`
+	// LocalRetention is the retention of the locally stored data in seconds.
+	LocalRetention uint `toml:"local-retention" json:"local-retention"`
+	// LocalMaxRecords is the max number of records stored locally, the oldest
+	// records are evicted when exceeded.
+	LocalMaxRecords uint `toml:"local-max-records" json:"local-max-records"`
+}
+
+// DefaultTopSQL returns the default configuration for TopSQL.
+func DefaultTopSQL() TopSQL {
+	return TopSQL{
+		LocalRetention:  3600,
+		LocalMaxRecords: 100000,
+	}
 }
 
 // IsolationRead is the config for isolation read.
@@ -959,10 +975,13 @@ var defaultConf = Config{
 	NewCollationsEnabledOnFirstBootstrap: true,
 	EnableGlobalKill:                     true,
 	TrxSummary:                           DefaultTrxSummary(),
+	TopSQL:                               DefaultTopSQL(),
 }
 
 var (
 	globalConf atomic.Value
+	// loadedConfPath is the path of the config file loaded by InitializeConfig.
+	loadedConfPath string
 )
 
 // NewConfig creates a new config instance with default value.
@@ -1056,7 +1075,9 @@ func isAllRemovedConfigItems(items []string) bool {
 // For example, if you start TiDB by the command "./tidb-server --port=3000", the port number should be
 // overwritten to 3000 and ignore the port number in the config file.
 func InitializeConfig(confPath string, configCheck, configStrict bool, enforceCmdArgs func(*Config)) {
+	loadedConfPath = confPath
 	cfg := GetGlobalConfig()
+	defaultValues := itemValues(cfg)
 	var err error
 	if confPath != "" {
 		if err = cfg.Load(confPath); err != nil {
@@ -1093,7 +1114,10 @@ func InitializeConfig(confPath string, configCheck, configStrict bool, enforceCm
 			os.Exit(1)
 		}
 	}
+	fileValues := itemValues(cfg)
 	enforceCmdArgs(cfg)
+	recordChangedItemOrigins(defaultValues, fileValues, ItemOriginFile)
+	recordChangedItemOrigins(fileValues, itemValues(cfg), ItemOriginFlag)
 
 	if err := cfg.Valid(); err != nil {
 		if !filepath.IsAbs(confPath) {
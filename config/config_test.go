@@ -759,6 +759,8 @@ deadlock-history-collect-retryable = true
 pessimistic-auto-commit = true
 [top-sql]
 receiver-address = "127.0.0.1:10100"
+enable-local-storage = true
+local-retention = 600
 [status]
 grpc-keepalive-time = 20
 grpc-keepalive-timeout = 10
@@ -815,6 +817,9 @@ max_connections = 200
 	require.True(t, conf.PessimisticTxn.DeadlockHistoryCollectRetryable)
 	require.True(t, conf.PessimisticTxn.PessimisticAutoCommit.Load())
 	require.Equal(t, "127.0.0.1:10100", conf.TopSQL.ReceiverAddress)
+	require.True(t, conf.TopSQL.EnableLocalStorage)
+	require.Equal(t, uint(600), conf.TopSQL.LocalRetention)
+	require.Equal(t, uint(100000), conf.TopSQL.LocalMaxRecords)
 	require.True(t, conf.Experimental.AllowsExpressionIndex)
 	require.Equal(t, uint(20), conf.Status.GRPCKeepAliveTime)
 	require.Equal(t, uint(10), conf.Status.GRPCKeepAliveTimeout)
@@ -15,9 +15,14 @@
 package config
 
 import (
+	"encoding"
 	"encoding/json"
+	"fmt"
 	"reflect"
+	"strings"
+	"sync"
 
+	"github.com/pingcap/errors"
 	tikvcfg "github.com/tikv/client-go/v2/config"
 )
 
@@ -52,9 +57,189 @@ var (
 		"CompatibleKillQuery":             {},
 		"TreatOldVersionUTF8AsUTF8MB4":    {},
 		"OpenTracing.Enable":              {},
+		"TokenLimit":                      {},
 	}
 )
 
+// The origins of the config items, which tell where the current values come from.
+const (
+	// ItemOriginDefault means the item has the default value.
+	ItemOriginDefault = "default"
+	// ItemOriginFile means the item is set by the config file.
+	ItemOriginFile = "file"
+	// ItemOriginFlag means the item is set by the command line flag.
+	ItemOriginFlag = "flag"
+	// ItemOriginOnline means the item is changed online by SET CONFIG.
+	ItemOriginOnline = "online"
+)
+
+var (
+	// globalConfUpdateMu serializes the updates of the global config.
+	globalConfUpdateMu sync.Mutex
+	itemOriginsMu      sync.RWMutex
+	// itemOrigins records the origins of the items not in default value, keyed by the field path.
+	itemOrigins = map[string]string{}
+)
+
+// ItemInfo is the information of a config item.
+type ItemInfo struct {
+	// Name is the name in the config file, such as "log.level".
+	Name    string
+	Value   string
+	Dynamic bool
+	Origin  string
+}
+
+// walkConfigItems calls fn for each config item with its name in the config file and
+// its field path, such as "log.level" and "Log.Level".
+func walkConfigItems(v reflect.Value, name, field string, fn func(name, field string, v reflect.Value)) {
+	t := v.Type()
+	if t.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return
+		}
+		t = t.Elem()
+		v = v.Elem()
+	}
+	// the structs which can be marshaled as text, such as AtomicBool, are items.
+	if t.Kind() != reflect.Struct || t.Implements(textMarshalerType) {
+		fn(name, field, v)
+		return
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := strings.Split(f.Tag.Get("toml"), ",")[0]
+		if !f.IsExported() || tag == "-" {
+			continue
+		}
+		if tag == "" {
+			tag = f.Name
+		}
+		fieldName, fieldPath := tag, f.Name
+		if field != "" {
+			fieldName, fieldPath = name+"."+tag, field+"."+f.Name
+		}
+		walkConfigItems(v.Field(i), fieldName, fieldPath, fn)
+	}
+}
+
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+func formatItemValue(v reflect.Value) string {
+	switch val := v.Interface().(type) {
+	case string:
+		return val
+	case encoding.TextMarshaler:
+		if text, err := val.MarshalText(); err == nil {
+			return string(text)
+		}
+	}
+	content, err := json.Marshal(v.Interface())
+	if err != nil {
+		return fmt.Sprint(v.Interface())
+	}
+	return string(content)
+}
+
+// itemValues returns the formatted values of the config items, keyed by the field path.
+func itemValues(conf *Config) map[string]string {
+	values := make(map[string]string)
+	walkConfigItems(reflect.ValueOf(conf), "", "", func(_, field string, v reflect.Value) {
+		values[field] = formatItemValue(v)
+	})
+	return values
+}
+
+// recordChangedItemOrigins sets the origin of the items whose values are changed.
+func recordChangedItemOrigins(oldValues, newValues map[string]string, origin string) {
+	itemOriginsMu.Lock()
+	defer itemOriginsMu.Unlock()
+	for field, value := range newValues {
+		if oldValues[field] != value {
+			itemOrigins[field] = origin
+		}
+	}
+}
+
+func recordItemOrigins(fields []string, origin string) {
+	itemOriginsMu.Lock()
+	defer itemOriginsMu.Unlock()
+	for _, field := range fields {
+		itemOrigins[field] = origin
+	}
+}
+
+// GetGlobalConfigItems returns the information of all the items of the global config.
+func GetGlobalConfigItems() []ItemInfo {
+	itemOriginsMu.RLock()
+	defer itemOriginsMu.RUnlock()
+	var items []ItemInfo
+	walkConfigItems(reflect.ValueOf(GetGlobalConfig()), "", "", func(name, field string, v reflect.Value) {
+		_, dynamic := dynamicConfigItems[field]
+		origin, ok := itemOrigins[field]
+		if !ok {
+			origin = ItemOriginDefault
+		}
+		items = append(items, ItemInfo{Name: name, Value: formatItemValue(v), Dynamic: dynamic, Origin: origin})
+	})
+	return items
+}
+
+// UpdateGlobalConfigItems changes the dynamic items of the global config online. The keys
+// of the items are the names in the config file, such as "log.level". It fails if any of
+// the items can't be changed online.
+func UpdateGlobalConfigItems(items map[string]interface{}, reload ConfReloadFunc) (acceptedItems []string, err error) {
+	fields := make(map[string]string)
+	walkConfigItems(reflect.ValueOf(&defaultConf), "", "", func(name, field string, _ reflect.Value) {
+		fields[name] = field
+	})
+	nested := make(map[string]interface{})
+	for name, value := range items {
+		field, ok := fields[name]
+		if !ok {
+			return nil, errors.Errorf("unknown config item %s", name)
+		}
+		if _, ok := dynamicConfigItems[field]; !ok {
+			return nil, errors.Errorf("config item %s can't be changed online", name)
+		}
+		m := nested
+		path := strings.Split(name, ".")
+		for _, p := range path[:len(path)-1] {
+			if _, ok := m[p]; !ok {
+				m[p] = make(map[string]interface{})
+			}
+			m = m[p].(map[string]interface{})
+		}
+		m[path[len(path)-1]] = value
+	}
+	content, err := json.Marshal(nested)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	globalConfUpdateMu.Lock()
+	defer globalConfUpdateMu.Unlock()
+	oldConf := GetGlobalConfig()
+	// only the scalar dynamic items are changed, so a shallow copy is enough.
+	newConf := *oldConf
+	if err := json.Unmarshal(content, &newConf); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := newConf.Valid(); err != nil {
+		return nil, err
+	}
+	dstConf := *oldConf
+	acceptedItems, _ = MergeConfigItems(&dstConf, &newConf)
+	if len(acceptedItems) > 0 {
+		StoreGlobalConfig(&dstConf)
+		recordItemOrigins(acceptedItems, ItemOriginOnline)
+		if reload != nil {
+			reload(oldConf, &dstConf)
+		}
+	}
+	return acceptedItems, nil
+}
+
 // MergeConfigItems overwrites the dynamic config items and leaves the other items unchanged.
 func MergeConfigItems(dstConf, newConf *Config) (acceptedItems, rejectedItems []string) {
 	return mergeConfigItems(reflect.ValueOf(dstConf), reflect.ValueOf(newConf), "")
@@ -103,6 +288,36 @@ func mergeConfigItems(dstConf, newConf reflect.Value, fieldPath string) (accepte
 // ConfReloadFunc is used to reload the config to make it work.
 type ConfReloadFunc func(oldConf, newConf *Config)
 
+// ReloadGlobalConfig loads the config file again and applies the changed dynamic config items
+// to the global config, the changes of the other items are rejected and left unchanged.
+func ReloadGlobalConfig(reload ConfReloadFunc) (acceptedItems, rejectedItems []string, err error) {
+	if loadedConfPath == "" {
+		return nil, nil, errors.New("no config file is specified")
+	}
+	newConf := NewConfig()
+	if err := newConf.Load(loadedConfPath); err != nil {
+		if _, ok := err.(*ErrConfigInstanceSection); !ok {
+			return nil, nil, err
+		}
+	}
+	if err := newConf.Valid(); err != nil {
+		return nil, nil, err
+	}
+	globalConfUpdateMu.Lock()
+	defer globalConfUpdateMu.Unlock()
+	oldConf := GetGlobalConfig()
+	dstConf := *oldConf
+	acceptedItems, rejectedItems = MergeConfigItems(&dstConf, newConf)
+	if len(acceptedItems) > 0 {
+		StoreGlobalConfig(&dstConf)
+		recordItemOrigins(acceptedItems, ItemOriginFile)
+		if reload != nil {
+			reload(oldConf, &dstConf)
+		}
+	}
+	return acceptedItems, rejectedItems, nil
+}
+
 // FlattenConfigItems flatten this config, see more cases in the test.
 func FlattenConfigItems(nestedConfig map[string]interface{}) map[string]interface{} {
 	flatMap := make(map[string]interface{})
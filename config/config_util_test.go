@@ -17,6 +17,8 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -83,6 +85,82 @@ func TestMergeConfigItems(t *testing.T) {
 	require.Equal(t, oriConf.AdvertiseAddress, oldConf.AdvertiseAddress)
 }
 
+func TestReloadGlobalConfig(t *testing.T) {
+	defer RestoreFunc()()
+	originPath := loadedConfPath
+	defer func() { loadedConfPath = originPath }()
+
+	loadedConfPath = ""
+	_, _, err := ReloadGlobalConfig(nil)
+	require.Error(t, err)
+
+	loadedConfPath = filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, os.WriteFile(loadedConfPath, []byte(`
+port = 2333
+[performance]
+max-procs = 7
+`), 0600))
+	var reloaded bool
+	as, rs, err := ReloadGlobalConfig(func(oldConf, newConf *Config) {
+		reloaded = true
+		require.Equal(t, uint(7), newConf.Performance.MaxProcs)
+	})
+	require.NoError(t, err)
+	require.True(t, reloaded)
+	require.Contains(t, as, "Performance.MaxProcs")
+	require.Contains(t, rs, "Port")
+	require.Equal(t, uint(7), GetGlobalConfig().Performance.MaxProcs)
+	require.NotEqual(t, uint(2333), GetGlobalConfig().Port)
+}
+
+func TestUpdateGlobalConfigItems(t *testing.T) {
+	defer RestoreFunc()()
+	itemOrigins = map[string]string{}
+	defer func() { itemOrigins = map[string]string{} }()
+
+	_, err := UpdateGlobalConfigItems(map[string]interface{}{"log.not-exist": 1}, nil)
+	require.EqualError(t, err, "unknown config item log.not-exist")
+	_, err = UpdateGlobalConfigItems(map[string]interface{}{"port": 2333}, nil)
+	require.EqualError(t, err, "config item port can't be changed online")
+	_, err = UpdateGlobalConfigItems(map[string]interface{}{"token-limit": "abc"}, nil)
+	require.Error(t, err)
+
+	var reloaded bool
+	as, err := UpdateGlobalConfigItems(map[string]interface{}{
+		"log.level":                        "warn",
+		"token-limit":                      2000,
+		"instance.tidb_slow_log_threshold": 500,
+	}, func(oldConf, newConf *Config) {
+		reloaded = true
+		require.Equal(t, uint(1000), oldConf.TokenLimit)
+		require.Equal(t, uint(2000), newConf.TokenLimit)
+	})
+	require.NoError(t, err)
+	require.True(t, reloaded)
+	require.ElementsMatch(t, []string{"Log.Level", "TokenLimit", "Instance.SlowThreshold"}, as)
+	conf := GetGlobalConfig()
+	require.Equal(t, "warn", conf.Log.Level)
+	require.Equal(t, uint(2000), conf.TokenLimit)
+	require.Equal(t, uint64(500), conf.Instance.SlowThreshold)
+
+	items := make(map[string]ItemInfo)
+	for _, item := range GetGlobalConfigItems() {
+		items[item.Name] = item
+	}
+	require.Equal(t, ItemInfo{Name: "log.level", Value: "warn", Dynamic: true, Origin: ItemOriginOnline}, items["log.level"])
+	require.Equal(t, ItemInfo{Name: "token-limit", Value: "2000", Dynamic: true, Origin: ItemOriginOnline}, items["token-limit"])
+	require.Equal(t, ItemInfo{Name: "port", Value: "4000", Dynamic: false, Origin: ItemOriginDefault}, items["port"])
+	require.Equal(t, "true", items["performance.cross-join"].Value)
+	require.Equal(t, ItemOriginDefault, items["performance.cross-join"].Origin)
+
+	// the items not changed are not accepted.
+	as, err = UpdateGlobalConfigItems(map[string]interface{}{"log.level": "warn"}, func(*Config, *Config) {
+		require.Fail(t, "unreachable")
+	})
+	require.NoError(t, err)
+	require.Len(t, as, 0)
+}
+
 func TestFlattenConfig(t *testing.T) {
 	toJSONStr := func(v interface{}) string {
 		str, err := json.Marshal(v)
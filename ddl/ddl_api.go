@@ -4187,7 +4187,8 @@ func checkModifyTypes(ctx sessionctx.Context, origin *types.FieldType, to *types
 	err = checkModifyCharsetAndCollation(to.GetCharset(), to.GetCollate(), origin.GetCharset(), origin.GetCollate(), needRewriteCollationData)
 
 	if err != nil {
-		if to.GetCharset() == charset.CharsetGBK || origin.GetCharset() == charset.CharsetGBK {
+		if to.GetCharset() == charset.CharsetGBK || origin.GetCharset() == charset.CharsetGBK ||
+			to.GetCharset() == charset.CharsetGB18030 || origin.GetCharset() == charset.CharsetGB18030 {
 			return errors.Trace(err)
 		}
 		// column type change can handle the charset change between these two types in the process of the reorg.
@@ -87,6 +87,7 @@ type Domain struct {
 	privHandle           *privileges.Handle
 	bindHandle           *bindinfo.BindHandle
 	statsHandle          unsafe.Pointer
+	statsOwner           owner.Manager
 	statsLease           time.Duration
 	ddl                  ddl.DDL
 	info                 *infosync.InfoSyncer
@@ -763,6 +764,8 @@ func NewDomain(store kv.Storage, ddlLease time.Duration, statsLease time.Duratio
 	do.expensiveQueryHandle = expensivequery.NewExpensiveQueryHandle(do.exit)
 	do.sysProcesses = SysProcesses{mu: &sync.RWMutex{}, procMap: make(map[uint64]sessionctx.Context)}
 	variable.SetStatsCacheCapacity.Store(do.SetStatsCacheCapacity)
+	variable.SetExternalTimestamp = do.SetExternalTimestamp
+	variable.GetExternalTimestamp = do.GetExternalTimestamp
 	return do
 }
 
@@ -1322,6 +1325,11 @@ func (do *Domain) StatsHandle() *handle.Handle {
 	return (*handle.Handle)(atomic.LoadPointer(&do.statsHandle))
 }
 
+// StatsOwner returns the owner manager of the stats worker, it's nil before the stats worker starts.
+func (do *Domain) StatsOwner() owner.Manager {
+	return do.statsOwner
+}
+
 // CreateStatsHandle is used only for test.
 func (do *Domain) CreateStatsHandle(ctx sessionctx.Context) error {
 	h, err := handle.NewHandle(ctx, do.statsLease, do.sysSessionPool, &do.sysProcesses, do.ServerID)
@@ -1371,6 +1379,7 @@ func (do *Domain) UpdateTableStatsLoop(ctx sessionctx.Context) error {
 		do.wg.Run(do.loadStatsWorker)
 	}
 	owner := do.newOwnerManager(handle.StatsPrompt, handle.StatsOwnerKey)
+	do.statsOwner = owner
 	if do.indexUsageSyncLease > 0 {
 		do.wg.Add(1)
 		go do.syncIndexUsageWorker(owner)
@@ -57,6 +57,9 @@ type SchemaValidator interface {
 	Reset()
 	// IsStarted indicates whether SchemaValidator is started.
 	IsStarted() bool
+	// LeaseInfo returns the latest schema version and the time when its lease expires.
+	// The expire time is zero if the schema lease is disabled.
+	LeaseInfo() (schemaVer int64, leaseExpire time.Time)
 }
 
 type deltaSchemaInfo struct {
@@ -94,6 +97,15 @@ func (s *schemaValidator) IsStarted() bool {
 	return isStarted
 }
 
+func (s *schemaValidator) LeaseInfo() (int64, time.Time) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	if s.lease == 0 {
+		return s.latestSchemaVer, time.Time{}
+	}
+	return s.latestSchemaVer, s.latestSchemaExpire
+}
+
 func (s *schemaValidator) Stop() {
 	logutil.BgLogger().Info("the schema validator stops")
 	metrics.LoadSchemaCounter.WithLabelValues(metrics.SchemaValidatorStop).Inc()
@@ -21,7 +21,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/meta"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/util/logutil"
@@ -209,3 +211,42 @@ func (do *Domain) SetStatsCacheCapacity(c int64) {
 	do.StatsHandle().SetStatsCacheCapacity(c)
 	logutil.BgLogger().Info("update stats cache capacity successfully", zap.Int64("capacity", c))
 }
+
+// GetExternalTimestamp returns the external timestamp of the cluster, it's 0 if it's never set.
+func (do *Domain) GetExternalTimestamp(ctx context.Context) (ts uint64, err error) {
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+	err = kv.RunInNewTxn(ctx, do.store, false, func(ctx context.Context, txn kv.Transaction) error {
+		ts, err = meta.NewMeta(txn).GetExternalTimestamp()
+		return err
+	})
+	return ts, errors.Trace(err)
+}
+
+// SetExternalTimestamp sets the external timestamp of the cluster. The external
+// timestamp can only be advanced, and can't be larger than the current timestamp.
+func (do *Domain) SetExternalTimestamp(ctx context.Context, ts uint64) error {
+	currentVer, err := do.store.CurrentVersion(kv.GlobalTxnScope)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if ts > currentVer.Ver {
+		return errors.Errorf("the external timestamp %d is larger than the current timestamp %d", ts, currentVer.Ver)
+	}
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+	err = kv.RunInNewTxn(ctx, do.store, true, func(ctx context.Context, txn kv.Transaction) error {
+		m := meta.NewMeta(txn)
+		current, err := m.GetExternalTimestamp()
+		if err != nil {
+			return err
+		}
+		if ts < current {
+			return errors.Errorf("the external timestamp can't be decreased from %d to %d", current, ts)
+		}
+		return m.SetExternalTimestamp(ts)
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	logutil.BgLogger().Info("update external timestamp successfully", zap.Uint64("ts", ts))
+	return nil
+}
@@ -59,6 +59,7 @@ import (
 	"github.com/pingcap/tidb/util/sqlexec"
 	"github.com/pingcap/tidb/util/stmtsummary"
 	"github.com/pingcap/tidb/util/stringutil"
+	"github.com/pingcap/tidb/util/tablemetrics"
 	"github.com/pingcap/tidb/util/topsql"
 	topsqlstate "github.com/pingcap/tidb/util/topsql/state"
 	"github.com/prometheus/client_golang/prometheus"
@@ -67,6 +68,7 @@ import (
 	"github.com/tikv/client-go/v2/util"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"golang.org/x/exp/slices"
 )
 
 // metrics option
@@ -308,7 +310,7 @@ func (a *ExecStmt) PointGet(ctx context.Context) (*recordSet, error) {
 	if raw, ok := sctx.(processinfoSetter); ok {
 		pi = raw
 		sql := a.OriginText()
-		maxExecutionTime := getMaxExecutionTime(sctx)
+		maxExecutionTime := getMaxExecutionTime(sctx, a.StmtNode)
 		// Update processinfo, ShowProcess() will use it.
 		pi.SetProcessInfo(sql, time.Now(), cmd, maxExecutionTime)
 		if sctx.GetSessionVars().StmtCtx.StmtType == "" {
@@ -482,7 +484,7 @@ func (a *ExecStmt) Exec(ctx context.Context) (_ sqlexec.RecordSet, err error) {
 				sql = ss.SecureText()
 			}
 		}
-		maxExecutionTime := getMaxExecutionTime(sctx)
+		maxExecutionTime := getMaxExecutionTime(sctx, a.StmtNode)
 		// Update processinfo, ShowProcess() will use it.
 		pi.SetProcessInfo(sql, time.Now(), cmd, maxExecutionTime)
 		if a.Ctx.GetSessionVars().StmtCtx.StmtType == "" {
@@ -596,7 +598,12 @@ func isNoResultPlan(p plannercore.Plan) bool {
 }
 
 // getMaxExecutionTime get the max execution timeout value.
-func getMaxExecutionTime(sctx sessionctx.Context) uint64 {
+// DDL statements use tidb_ddl_max_wait_time instead of max_execution_time, the DDL job is
+// canceled when the statement is killed. Other statements are rolled back when they are killed.
+func getMaxExecutionTime(sctx sessionctx.Context, stmtNode ast.StmtNode) uint64 {
+	if _, ok := stmtNode.(ast.DDLNode); ok {
+		return sctx.GetSessionVars().DDLMaxWaitTime
+	}
 	if sctx.GetSessionVars().StmtCtx.HasMaxExecutionTime {
 		return sctx.GetSessionVars().StmtCtx.MaxExecutionTime
 	}
@@ -1179,6 +1186,7 @@ func (a *ExecStmt) FinishExecuteStmt(txnTS uint64, err error, hasMoreResults boo
 	a.LogSlowQuery(txnTS, succ, hasMoreResults)
 	a.SummaryStmt(succ)
 	a.observeStmtFinishedForTopSQL()
+	a.observeStmtFinishedForTableMetrics(succ)
 	if sessVars.StmtCtx.IsTiFlash.Load() {
 		if succ {
 			totalTiFlashQuerySuccCounter.Inc()
@@ -1678,6 +1686,39 @@ func (a *ExecStmt) observeStmtFinishedForTopSQL() {
 	}
 }
 
+func (a *ExecStmt) observeStmtFinishedForTableMetrics(succ bool) {
+	vars := a.Ctx.GetSessionVars()
+	if vars == nil || vars.InRestrictedSQL || !tablemetrics.Enabled() {
+		return
+	}
+	stmtCtx := vars.StmtCtx
+	keys := make([]tablemetrics.Key, 0, len(stmtCtx.Tables)+len(stmtCtx.IndexNames))
+	for _, tbl := range stmtCtx.Tables {
+		key := tablemetrics.Key{DB: strings.ToLower(tbl.DB), Table: strings.ToLower(tbl.Table)}
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	for _, idx := range stmtCtx.IndexNames {
+		// the index name is in the format of "table:index".
+		tblName, idxName, ok := strings.Cut(idx, ":")
+		if !ok {
+			continue
+		}
+		key := tablemetrics.Key{Table: strings.ToLower(tblName), Index: strings.ToLower(idxName)}
+		for _, tbl := range stmtCtx.Tables {
+			if strings.EqualFold(tbl.Table, tblName) {
+				key.DB = strings.ToLower(tbl.DB)
+				break
+			}
+		}
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	tablemetrics.Observe(keys, time.Since(vars.StartTime)+vars.DurationParse, succ)
+}
+
 func (a *ExecStmt) getSQLPlanDigest() ([]byte, []byte) {
 	var sqlDigest, planDigest []byte
 	vars := a.Ctx.GetSessionVars()
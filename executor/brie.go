@@ -49,6 +49,7 @@ import (
 	filter "github.com/pingcap/tidb/util/table-filter"
 	"github.com/tikv/client-go/v2/oracle"
 	pd "github.com/tikv/pd/client"
+	"golang.org/x/exp/slices"
 )
 
 const clearInterval = 10 * time.Minute
@@ -64,15 +65,19 @@ type brieTaskProgress struct {
 	// this field is atomically updated outside of the lock below.
 	current int64
 
-	// lock is the mutex protected the two fields below.
+	// lock is the mutex protected the three fields below.
 	lock sync.Mutex
 	// cmd is the name of the step the BRIE task is currently performing.
 	cmd string
 	// total is the total progress of the task.
 	// the percentage of completeness is `(100%) * current / total`.
 	total int64
+	// object is the object the BRIE task is currently processing.
+	object string
 }
 
+var _ glue.EventProgress = &brieTaskProgress{}
+
 // Inc implements glue.Progress
 func (p *brieTaskProgress) Inc() {
 	atomic.AddInt64(&p.current, 1)
@@ -85,6 +90,25 @@ func (p *brieTaskProgress) Close() {
 	p.lock.Unlock()
 }
 
+// SetObject implements glue.EventProgress
+func (p *brieTaskProgress) SetObject(object string) {
+	p.lock.Lock()
+	p.object = object
+	p.lock.Unlock()
+}
+
+// Event implements glue.EventProgress
+func (p *brieTaskProgress) Event() glue.ProgressEvent {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return glue.ProgressEvent{
+		Phase:   p.cmd,
+		Current: atomic.LoadInt64(&p.current),
+		Total:   p.total,
+		Object:  p.object,
+	}
+}
+
 type brieTaskInfo struct {
 	queueTime   types.Time
 	execTime    types.Time
@@ -94,6 +118,7 @@ type brieTaskInfo struct {
 	connID      uint64
 	backupTS    uint64
 	archiveSize uint64
+	rawSize     uint64
 	message     string
 }
 
@@ -420,12 +445,10 @@ func (e *ShowExec) fetchShowBRIE(kind ast.BRIEKind) error {
 	globalBRIEQueue.tasks.Range(func(key, value interface{}) bool {
 		item := value.(*brieQueueItem)
 		if item.info.kind == kind {
-			item.progress.lock.Lock()
-			defer item.progress.lock.Unlock()
-			current := atomic.LoadInt64(&item.progress.current)
+			event := item.progress.Event()
 			e.result.AppendString(0, item.info.storage)
-			e.result.AppendString(1, item.progress.cmd)
-			e.result.AppendFloat64(2, 100.0*float64(current)/float64(item.progress.total))
+			e.result.AppendString(1, event.Phase)
+			e.result.AppendFloat64(2, event.Percent())
 			e.result.AppendTime(3, item.info.queueTime)
 			e.result.AppendTime(4, item.info.execTime)
 			e.result.AppendTime(5, item.info.finishTime)
@@ -435,6 +458,16 @@ func (e *ShowExec) fetchShowBRIE(kind ast.BRIEKind) error {
 			} else {
 				e.result.AppendNull(7)
 			}
+			if len(event.Object) > 0 {
+				e.result.AppendString(8, event.Object)
+			} else {
+				e.result.AppendNull(8)
+			}
+			if item.info.archiveSize > 0 && item.info.rawSize > 0 {
+				e.result.AppendFloat64(9, float64(item.info.rawSize)/float64(item.info.archiveSize))
+			} else {
+				e.result.AppendNull(9)
+			}
 		}
 		return true
 	})
@@ -442,6 +475,48 @@ func (e *ShowExec) fetchShowBRIE(kind ast.BRIEKind) error {
 	return nil
 }
 
+// BRIETaskStatus is the status of a BACKUP or RESTORE task.
+type BRIETaskStatus struct {
+	ID            uint64  `json:"id"`
+	Kind          string  `json:"kind"`
+	Destination   string  `json:"destination"`
+	Phase         string  `json:"phase"`
+	Percent       float64 `json:"percent"`
+	CurrentObject string  `json:"current_object,omitempty"`
+	ConnectionID  uint64  `json:"connection_id"`
+	QueueTime     string  `json:"queue_time"`
+	ExecutionTime string  `json:"execution_time"`
+	FinishTime    string  `json:"finish_time"`
+	Message       string  `json:"message,omitempty"`
+}
+
+// GetBRIETasks returns the status of the BACKUP and RESTORE tasks in this TiDB instance, ordered by the task ID.
+func GetBRIETasks() []BRIETaskStatus {
+	tasks := make([]BRIETaskStatus, 0)
+	globalBRIEQueue.tasks.Range(func(key, value interface{}) bool {
+		item := value.(*brieQueueItem)
+		event := item.progress.Event()
+		tasks = append(tasks, BRIETaskStatus{
+			ID:            key.(uint64),
+			Kind:          item.info.kind.String(),
+			Destination:   item.info.storage,
+			Phase:         event.Phase,
+			Percent:       event.Percent(),
+			CurrentObject: event.Object,
+			ConnectionID:  item.info.connID,
+			QueueTime:     item.info.queueTime.String(),
+			ExecutionTime: item.info.execTime.String(),
+			FinishTime:    item.info.finishTime.String(),
+			Message:       item.info.message,
+		})
+		return true
+	})
+	slices.SortFunc(tasks, func(a, b BRIETaskStatus) bool {
+		return a.ID < b.ID
+	})
+	return tasks
+}
+
 type tidbGlueSession struct {
 	se       sessionctx.Context
 	progress *brieTaskProgress
@@ -550,6 +625,7 @@ func (gs *tidbGlueSession) StartProgress(ctx context.Context, cmdName string, to
 	gs.progress.lock.Lock()
 	gs.progress.cmd = cmdName
 	gs.progress.total = total
+	gs.progress.object = ""
 	atomic.StoreInt64(&gs.progress.current, 0)
 	gs.progress.lock.Unlock()
 	return gs.progress
@@ -562,6 +638,8 @@ func (gs *tidbGlueSession) Record(name string, value uint64) {
 		gs.info.backupTS = value
 	case "Size":
 		gs.info.archiveSize = value
+	case "RawSize":
+		gs.info.rawSize = value
 	}
 }
 
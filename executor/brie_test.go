@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pingcap/tidb/br/pkg/glue"
 	"github.com/pingcap/tidb/infoschema"
 	"github.com/pingcap/tidb/parser"
 	"github.com/pingcap/tidb/parser/ast"
@@ -44,7 +45,7 @@ func TestGlueGetVersion(t *testing.T) {
 }
 
 func brieTaskInfoToResult(info *brieTaskInfo) string {
-	arr := make([]string, 0, 8)
+	arr := make([]string, 0, 10)
 	arr = append(arr, info.storage)
 	arr = append(arr, "Wait")
 	arr = append(arr, "0")
@@ -57,6 +58,12 @@ func brieTaskInfoToResult(info *brieTaskInfo) string {
 	} else {
 		arr = append(arr, "NULL")
 	}
+	arr = append(arr, "NULL")
+	if info.archiveSize > 0 && info.rawSize > 0 {
+		arr = append(arr, fmt.Sprintf("%g", float64(info.rawSize)/float64(info.archiveSize)))
+	} else {
+		arr = append(arr, "NULL")
+	}
 	return strings.Join(arr, ", ") + "\n"
 }
 
@@ -140,16 +147,59 @@ func TestFetchShowBRIE(t *testing.T) {
 	globalBRIEQueue.lastClearTime = time.Now().Add(-clearInterval - time.Second)
 	currTime := types.CurrentTime(tp)
 	info2 := &brieTaskInfo{
-		kind:       ast.BRIEKindBackup,
-		connID:     e.ctx.GetSessionVars().ConnectionID,
-		queueTime:  currTime,
-		execTime:   currTime,
-		finishTime: currTime,
-		storage:    "noop://test/backup2",
-		message:    "",
+		kind:        ast.BRIEKindBackup,
+		connID:      e.ctx.GetSessionVars().ConnectionID,
+		queueTime:   currTime,
+		execTime:    currTime,
+		finishTime:  currTime,
+		storage:     "noop://test/backup2",
+		message:     "",
+		archiveSize: 100,
+		rawSize:     250,
 	}
 	info2Res := brieTaskInfoToResult(info2)
 	globalBRIEQueue.registerTask(ctx, info2)
 	globalBRIEQueue.clearTask(e.ctx.GetSessionVars().StmtCtx)
 	require.Equal(t, info2Res, fetchShowBRIEResult(t, e, brieColTypes))
 }
+
+func TestBRIETaskProgressEvent(t *testing.T) {
+	ctx := context.Background()
+	info := &brieTaskInfo{
+		kind:    ast.BRIEKindRestore,
+		storage: "noop://test/restore",
+	}
+	_, taskID := globalBRIEQueue.registerTask(ctx, info)
+	defer globalBRIEQueue.tasks.Delete(taskID)
+	item, ok := globalBRIEQueue.tasks.Load(taskID)
+	require.True(t, ok)
+	gs := &tidbGlueSession{progress: item.(*brieQueueItem).progress, info: info}
+
+	progress := gs.StartProgress(ctx, "Full Restore", 4, false)
+	progress.Inc()
+	glue.SetProgressObject(progress, "`test`.`t`")
+	event := gs.progress.Event()
+	require.Equal(t, glue.ProgressEvent{Phase: "Full Restore", Current: 1, Total: 4, Object: "`test`.`t`"}, event)
+	require.Equal(t, 25.0, event.Percent())
+
+	var status *BRIETaskStatus
+	for _, task := range GetBRIETasks() {
+		if task.ID == taskID {
+			task := task
+			status = &task
+		}
+	}
+	require.NotNil(t, status)
+	require.Equal(t, "RESTORE", status.Kind)
+	require.Equal(t, "noop://test/restore", status.Destination)
+	require.Equal(t, "Full Restore", status.Phase)
+	require.Equal(t, 25.0, status.Percent)
+	require.Equal(t, "`test`.`t`", status.CurrentObject)
+
+	// A new phase resets the progress.
+	progress.Close()
+	progress = gs.StartProgress(ctx, "Checksum", 2, false)
+	require.Equal(t, glue.ProgressEvent{Phase: "Checksum", Total: 2}, gs.progress.Event())
+	progress.Close()
+	require.Equal(t, 100.0, gs.progress.Event().Percent())
+}
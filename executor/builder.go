@@ -1780,6 +1780,12 @@ func (b *executorBuilder) buildMemTable(v *plannercore.PhysicalMemTable) Executo
 			strings.ToLower(infoschema.TablePlacementPolicies),
 			strings.ToLower(infoschema.TableTrxSummary),
 			strings.ToLower(infoschema.TableVariablesInfo),
+			strings.ToLower(infoschema.TableTiDBTopSQL),
+			strings.ToLower(infoschema.ClusterTableTiDBTopSQL),
+			strings.ToLower(infoschema.TableTiDBConfigItems),
+			strings.ToLower(infoschema.TableTiDBTableMetrics),
+			strings.ToLower(infoschema.TableTiDBBRTemporaryDatabases),
+			strings.ToLower(infoschema.TableTiDBExternalTSStaleness),
 			strings.ToLower(infoschema.ClusterTableTrxSummary):
 			return &MemTableReaderExec{
 				baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID()),
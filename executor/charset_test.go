@@ -68,6 +68,33 @@ func TestCharsetFeature(t *testing.T) {
 	))
 }
 
+func TestCharsetFeatureGB18030(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+
+	tk.MustExec("set names gb18030")
+	tk.MustQuery("select @@character_set_connection").Check(testkit.Rows("gb18030"))
+	tk.MustQuery("select @@collation_connection").Check(testkit.Rows("gb18030_chinese_ci"))
+	tk.MustExec("set names utf8mb4")
+
+	tk.MustExec("create table t(a varchar(10) charset gb18030, b varchar(10) charset gb18030 collate gb18030_bin)")
+	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE TABLE `t` (\n" +
+		"  `a` varchar(10) CHARACTER SET gb18030 COLLATE gb18030_chinese_ci DEFAULT NULL,\n" +
+		"  `b` varchar(10) CHARACTER SET gb18030 COLLATE gb18030_bin DEFAULT NULL\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin",
+	))
+	tk.MustExec("insert into t values ('b', 'b'), ('A', 'A'), ('中', '中'), ('𠀀', '𠀀'), ('€', '€')")
+	tk.MustQuery("select hex(a), length(a) from t where a = '𠀀'").Check(testkit.Rows("95328236 4"))
+	tk.MustQuery("select hex(a) from t where a = '€'").Check(testkit.Rows("A2E3"))
+	tk.MustQuery("select a from t order by a").Check(testkit.Rows("A", "b", "中", "€", "𠀀"))
+	tk.MustQuery("select b from t order by b").Check(testkit.Rows("A", "b", "𠀀", "€", "中"))
+	tk.MustQuery("select a from t where a like 'a'").Check(testkit.Rows("A"))
+	tk.MustQuery("select b from t where b like 'a'").Check(testkit.Rows())
+	tk.MustQuery("select a from t where a like '%𠀀'").Check(testkit.Rows("𠀀"))
+}
+
 func TestCharsetFeatureCollation(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 
@@ -24,6 +24,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -5945,6 +5946,57 @@ func TestSummaryFailedUpdate(t *testing.T) {
 	tk.MustQuery("select stmt_type from information_schema.statements_summary where digest_text = 'update `t` set `t` . `a` = `t` . `a` - ? where `t` . `a` in ( select `a` from `t` where `a` < ? )'").Check(testkit.Rows("Update"))
 }
 
+// killSessionManager kills the session like the server does, it only sets the
+// killed flag and the running statement checks it.
+type killSessionManager struct {
+	testutil.MockSessionManager
+	se session.Session
+}
+
+func (sm *killSessionManager) ShowProcessList() map[uint64]*util.ProcessInfo {
+	pi := sm.se.ShowProcess()
+	if pi == nil {
+		return nil
+	}
+	return map[uint64]*util.ProcessInfo{pi.ID: pi}
+}
+
+func (sm *killSessionManager) Kill(uint64, bool) {
+	atomic.StoreUint32(&sm.se.GetSessionVars().Killed, 1)
+}
+
+func TestKillDMLExceedMaxExecutionTime(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int)")
+	tk.MustExec("create table t2(a int)")
+	values := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		values = append(values, fmt.Sprintf("(%d)", i))
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ","))
+
+	sm := &killSessionManager{se: tk.Session()}
+	tk.Session().SetSessionManager(sm)
+	dom.ExpensiveQueryHandle().SetSessionManager(sm)
+	go dom.ExpensiveQueryHandle().Run()
+
+	tk.MustExec("set @@max_execution_time = 100")
+	checkInterrupted := func(sql string) {
+		defer atomic.StoreUint32(&tk.Session().GetSessionVars().Killed, 0)
+		_, err := tk.Exec(sql)
+		require.Error(t, err)
+		require.Equal(t, int(executor.ErrQueryInterrupted.Code()), int(terror.ToSQLError(errors.Cause(err).(*terror.Error)).Code))
+	}
+	// The statements run for far longer than 100ms, they are killed and rolled back.
+	checkInterrupted("insert into t2 select t.a from t, t t1, t t3")
+	checkInterrupted("update t join (select t1.a from t t1, t t2, t t3) s on t.a = s.a set t.a = t.a + 100")
+	tk.MustExec("set @@max_execution_time = 0")
+	tk.MustQuery("select count(*) from t2").Check(testkit.Rows("0"))
+	tk.MustQuery("select count(*), sum(a) from t").Check(testkit.Rows("100 4950"))
+}
+
 func TestIsFastPlan(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
@@ -30,6 +30,8 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/kvproto/pkg/deadlock"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/config"
 	"github.com/pingcap/tidb/ddl/label"
 	"github.com/pingcap/tidb/domain"
 	"github.com/pingcap/tidb/domain/infosync"
@@ -71,6 +73,9 @@ import (
 	"github.com/pingcap/tidb/util/sqlexec"
 	"github.com/pingcap/tidb/util/stmtsummary"
 	"github.com/pingcap/tidb/util/stringutil"
+	"github.com/pingcap/tidb/util/tablemetrics"
+	"github.com/pingcap/tidb/util/topsql"
+	"github.com/tikv/client-go/v2/oracle"
 	"github.com/tikv/client-go/v2/txnkv/txnlock"
 	"go.uber.org/zap"
 	"golang.org/x/exp/slices"
@@ -174,6 +179,18 @@ func (e *memtableRetriever) retrieve(ctx context.Context, sctx sessionctx.Contex
 			err = e.setDataForClusterTrxSummary(sctx)
 		case infoschema.TableVariablesInfo:
 			err = e.setDataForVariablesInfo(sctx)
+		case infoschema.TableTiDBTopSQL:
+			e.setDataForTiDBTopSQL(sctx)
+		case infoschema.ClusterTableTiDBTopSQL:
+			err = e.setDataForClusterTiDBTopSQL(sctx)
+		case infoschema.TableTiDBConfigItems:
+			e.setDataForTiDBConfigItems(sctx)
+		case infoschema.TableTiDBTableMetrics:
+			e.setDataForTiDBTableMetrics(sctx)
+		case infoschema.TableTiDBBRTemporaryDatabases:
+			err = e.setDataForTiDBBRTemporaryDatabases(ctx, sctx, dbs)
+		case infoschema.TableTiDBExternalTSStaleness:
+			err = e.setDataForTiDBExternalTSStaleness(ctx, sctx, dbs)
 		}
 		if err != nil {
 			return nil, err
@@ -2362,6 +2379,168 @@ func (e *memtableRetriever) setDataForClusterTrxSummary(ctx sessionctx.Context)
 	return nil
 }
 
+func (e *memtableRetriever) setDataForTiDBTopSQL(ctx sessionctx.Context) {
+	if !hasPriv(ctx, mysql.ProcessPriv) {
+		return
+	}
+	loc := ctx.GetSessionVars().TimeZone
+	records := topsql.LocalRecords()
+	rows := make([][]types.Datum, 0, len(records))
+	for _, r := range records {
+		ts := types.NewTime(types.FromGoTime(time.Unix(int64(r.TimestampSec), 0).In(loc)), mysql.TypeDatetime, 0)
+		rows = append(rows, types.MakeDatums(
+			ts,                               // TIME
+			hex.EncodeToString(r.SQLDigest),  // SQL_DIGEST
+			hex.EncodeToString(r.PlanDigest), // PLAN_DIGEST
+			r.NormalizedSQL,                  // SQL_TEXT
+			r.NormalizedPlan,                 // PLAN
+			r.IsInternalSQL,                  // IS_INTERNAL
+			uint64(r.CPUTimeMs),              // CPU_TIME_MS
+			r.StmtExecCount,                  // EXEC_COUNT
+			r.StmtKvExecCount,                // KV_EXEC_COUNT
+			r.StmtDurationSumNs,              // SUM_LATENCY
+			r.StmtDurationCount,              // LATENCY_COUNT
+		))
+	}
+	e.rows = rows
+}
+
+func (e *memtableRetriever) setDataForClusterTiDBTopSQL(ctx sessionctx.Context) error {
+	e.setDataForTiDBTopSQL(ctx)
+	rows, err := infoschema.AppendHostInfoToRows(ctx, e.rows)
+	if err != nil {
+		return err
+	}
+	e.rows = rows
+	return nil
+}
+
+func (e *memtableRetriever) setDataForTiDBConfigItems(ctx sessionctx.Context) {
+	if !hasPriv(ctx, mysql.ConfigPriv) {
+		return
+	}
+	items := config.GetGlobalConfigItems()
+	rows := make([][]types.Datum, 0, len(items))
+	for _, item := range items {
+		isDynamic := "NO"
+		if item.Dynamic {
+			isDynamic = "YES"
+		}
+		rows = append(rows, types.MakeDatums(
+			item.Name,   // ITEM
+			item.Value,  // VALUE
+			isDynamic,   // IS_DYNAMIC
+			item.Origin, // ORIGIN
+		))
+	}
+	e.rows = rows
+}
+
+func (e *memtableRetriever) setDataForTiDBTableMetrics(ctx sessionctx.Context) {
+	if !hasPriv(ctx, mysql.ProcessPriv) {
+		return
+	}
+	stats := tablemetrics.GetStats()
+	rows := make([][]types.Datum, 0, len(stats))
+	for _, s := range stats {
+		var avgLatency uint64
+		if s.Count > 0 {
+			avgLatency = uint64(s.SumLatency) / s.Count
+		}
+		rows = append(rows, types.MakeDatums(
+			s.DB,                 // DB
+			s.Table,              // TABLE_NAME
+			s.Index,              // INDEX_NAME
+			s.Count,              // EXEC_COUNT
+			s.ErrorCount,         // ERROR_COUNT
+			uint64(s.SumLatency), // SUM_LATENCY
+			uint64(s.MaxLatency), // MAX_LATENCY
+			avgLatency,           // AVG_LATENCY
+		))
+	}
+	e.rows = rows
+}
+
+func (e *memtableRetriever) setDataForTiDBBRTemporaryDatabases(ctx context.Context, sctx sessionctx.Context, schemas []*model.DBInfo) error {
+	records, err := utils.ReadTemporaryDBRecords(ctx, sctx.(sqlexec.RestrictedSQLExecutor))
+	if err != nil {
+		return err
+	}
+	checker := privilege.GetPrivilegeManager(sctx)
+	rows := make([][]types.Datum, 0)
+	for _, schema := range schemas {
+		originalName, ok := utils.GetSysDBName(schema.Name)
+		if !ok {
+			continue
+		}
+		if checker != nil && !checker.RequestVerification(sctx.GetSessionVars().ActiveRoles, schema.Name.L, "", "", mysql.AllPrivMask) {
+			continue
+		}
+		var task, createTime interface{}
+		if record, ok := records[schema.Name.L]; ok {
+			task = record.Task
+			createTime = types.NewTime(types.FromGoTime(record.CreateTime.In(sctx.GetSessionVars().Location())), mysql.TypeDatetime, 0)
+		}
+		rows = append(rows, types.MakeDatums(
+			schema.Name.O,             // DB_NAME
+			originalName,              // ORIGINAL_DB_NAME
+			task,                      // TASK
+			createTime,                // CREATE_TIME
+			int64(len(schema.Tables)), // TABLE_COUNT
+		))
+	}
+	e.rows = rows
+	return nil
+}
+
+func (e *memtableRetriever) setDataForTiDBExternalTSStaleness(ctx context.Context, sctx sessionctx.Context, schemas []*model.DBInfo) error {
+	if variable.GetExternalTimestamp == nil {
+		return nil
+	}
+	externalTS, err := variable.GetExternalTimestamp(ctx)
+	if err != nil || externalTS == 0 {
+		return err
+	}
+	// the tables which don't exist at the external timestamp can't be read.
+	snapshotIS, err := domain.GetDomain(sctx).GetSnapshotInfoSchema(externalTS)
+	if err != nil {
+		return err
+	}
+	externalTime := oracle.GetTimeFromTS(externalTS)
+	staleness := time.Since(externalTime).Seconds()
+	externalTimeDatum := types.NewTime(types.FromGoTime(externalTime.In(sctx.GetSessionVars().Location())), mysql.TypeDatetime, 3)
+	checker := privilege.GetPrivilegeManager(sctx)
+	rows := make([][]types.Datum, 0)
+	for _, schema := range schemas {
+		if util.IsMemDB(schema.Name.L) {
+			continue
+		}
+		for _, table := range schema.Tables {
+			if !table.IsBaseTable() {
+				continue
+			}
+			if checker != nil && !checker.RequestVerification(sctx.GetSessionVars().ActiveRoles, schema.Name.L, table.Name.L, "", mysql.AllPrivMask) {
+				continue
+			}
+			readable := "NO"
+			if _, ok := snapshotIS.TableByID(table.ID); ok {
+				readable = "YES"
+			}
+			rows = append(rows, types.MakeDatums(
+				schema.Name.O,     // TABLE_SCHEMA
+				table.Name.O,      // TABLE_NAME
+				table.ID,          // TABLE_ID
+				externalTS,        // EXTERNAL_TS
+				externalTimeDatum, // EXTERNAL_TIME
+				staleness,         // STALENESS
+				readable,          // READABLE
+			))
+		}
+	}
+	e.rows = rows
+	return nil
+}
+
 type stmtSummaryTableRetriever struct {
 	dummyCloser
 	table     *model.TableInfo
@@ -1193,14 +1193,22 @@ func TestShowForNewCollations(t *testing.T) {
 	expectRows := testkit.Rows(
 		"ascii_bin ascii 65 Yes Yes 1",
 		"binary binary 63 Yes Yes 1",
+		"gb18030_bin gb18030 249  Yes 1",
+		"gb18030_chinese_ci gb18030 248 Yes Yes 1",
 		"gbk_bin gbk 87  Yes 1",
 		"gbk_chinese_ci gbk 28 Yes Yes 1",
 		"latin1_bin latin1 47 Yes Yes 1",
 		"utf8_bin utf8 83 Yes Yes 1",
 		"utf8_general_ci utf8 33  Yes 1",
 		"utf8_unicode_ci utf8 192  Yes 1",
+		"utf8mb4_0900_ai_ci utf8mb4 255  Yes 1",
+		"utf8mb4_0900_bin utf8mb4 309  Yes 1",
 		"utf8mb4_bin utf8mb4 46 Yes Yes 1",
+		"utf8mb4_de_pb_0900_ai_ci utf8mb4 256  Yes 1",
+		"utf8mb4_es_0900_ai_ci utf8mb4 263  Yes 1",
 		"utf8mb4_general_ci utf8mb4 45  Yes 1",
+		"utf8mb4_sv_0900_ai_ci utf8mb4 264  Yes 1",
+		"utf8mb4_tr_0900_ai_ci utf8mb4 265  Yes 1",
 		"utf8mb4_unicode_ci utf8mb4 224  Yes 1",
 	)
 	tk.MustQuery("show collation").Check(expectRows)
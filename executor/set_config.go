@@ -51,9 +51,6 @@ func (s *SetConfigExec) Open(ctx context.Context) error {
 		if s.p.Type != "tikv" && s.p.Type != "tidb" && s.p.Type != "pd" {
 			return errors.Errorf("unknown type %v", s.p.Type)
 		}
-		if s.p.Type == "tidb" {
-			return errors.Errorf("TiDB doesn't support to change configs online, please use SQL variables")
-		}
 	}
 	if s.p.Instance != "" {
 		s.p.Instance = strings.ToLower(s.p.Instance)
@@ -104,10 +101,8 @@ func (s *SetConfigExec) Next(ctx context.Context, req *chunk.Chunk) error {
 		switch serverInfo.ServerType {
 		case "pd":
 			url = fmt.Sprintf("%s://%s%s", util.InternalHTTPSchema(), serverInfo.StatusAddr, pdapi.Config)
-		case "tikv":
+		case "tikv", "tidb":
 			url = fmt.Sprintf("%s://%s/config", util.InternalHTTPSchema(), serverInfo.StatusAddr)
-		case "tidb":
-			return errors.Errorf("TiDB doesn't support to change configs online, please use SQL variables")
 		default:
 			return errors.Errorf("Unknown server type %s", serverInfo.ServerType)
 		}
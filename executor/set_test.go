@@ -1695,8 +1695,6 @@ func TestSetClusterConfig(t *testing.T) {
 	tk.Session().SetValue(executor.TestSetConfigServerInfoKey, serverInfoFunc)
 
 	require.EqualError(t, tk.ExecToErr("set config xxx log.level='info'"), "unknown type xxx")
-	require.EqualError(t, tk.ExecToErr("set config tidb log.level='info'"), "TiDB doesn't support to change configs online, please use SQL variables")
-	require.EqualError(t, tk.ExecToErr("set config '127.0.0.1:1111' log.level='info'"), "TiDB doesn't support to change configs online, please use SQL variables")
 	require.EqualError(t, tk.ExecToErr("set config '127.a.b.c:1234' log.level='info'"), "invalid instance 127.a.b.c:1234")                          // name doesn't resolve.
 	require.EqualError(t, tk.ExecToErr("set config 'example.com:1111' log.level='info'"), "instance example.com:1111 is not found in this cluster") // name resolves.
 	require.EqualError(t, tk.ExecToErr("set config tikv log.level=null"), "can't set config to null")
@@ -1715,6 +1713,14 @@ func TestSetClusterConfig(t *testing.T) {
 	tk.MustExec("set config '127.0.0.1:5555' log.level='info'")
 	require.Equal(t, 1, httpCnt)
 
+	httpCnt = 0
+	tk.MustExec("set config tidb log.level='info'")
+	require.Equal(t, 2, httpCnt)
+
+	httpCnt = 0
+	tk.MustExec("set config '127.0.0.1:1111' log.level='info'")
+	require.Equal(t, 1, httpCnt)
+
 	httpCnt = 0
 	tk.Session().SetValue(executor.TestSetConfigHTTPHandlerKey, func(*http.Request) (*http.Response, error) {
 		return nil, errors.New("something wrong")
@@ -1544,9 +1544,20 @@ func (e *ShowExec) fetchShowCreateUser(ctx context.Context) error {
 		authStr = fmt.Sprintf(" AS '%s'", authData)
 	}
 
+	resourceStr := ""
+	if maxUserConnections, maxQueriesPerHour := checker.GetUserResources(e.User.Username, e.User.Hostname); maxUserConnections > 0 || maxQueriesPerHour > 0 {
+		resourceStr = " WITH"
+		if maxQueriesPerHour > 0 {
+			resourceStr += fmt.Sprintf(" MAX_QUERIES_PER_HOUR %d", maxQueriesPerHour)
+		}
+		if maxUserConnections > 0 {
+			resourceStr += fmt.Sprintf(" MAX_USER_CONNECTIONS %d", maxUserConnections)
+		}
+	}
+
 	// FIXME: the returned string is not escaped safely
-	showStr := fmt.Sprintf("CREATE USER '%s'@'%s' IDENTIFIED WITH '%s'%s REQUIRE %s PASSWORD EXPIRE DEFAULT ACCOUNT UNLOCK",
-		e.User.Username, e.User.Hostname, authplugin, authStr, require)
+	showStr := fmt.Sprintf("CREATE USER '%s'@'%s' IDENTIFIED WITH '%s'%s REQUIRE %s%s PASSWORD EXPIRE DEFAULT ACCOUNT UNLOCK",
+		e.User.Username, e.User.Hostname, authplugin, authStr, require, resourceStr)
 	e.appendRow([]interface{}{showStr})
 	return nil
 }
@@ -1992,7 +2003,8 @@ func (e *ShowExec) fetchShowSessionStates(ctx context.Context) error {
 		if !e.ctx.GetSessionVars().ConnectionInfo.IsSecureTransport() {
 			return sessionstates.ErrCannotMigrateSession.GenWithStackByArgs("the token must be queried with secure transport")
 		}
-		if token, err = sessionstates.CreateSessionToken(user.Username); err != nil {
+		// Bind the token to the states so that the states can't be tampered during migration.
+		if token, err = sessionstates.CreateSessionTokenWithStates(user.Username, []byte(stateJSON.String())); err != nil {
 			return err
 		}
 	}
@@ -1889,3 +1889,21 @@ func TestShowViewWithWindowFunction(t *testing.T) {
 	tk.MustExec("create or replace view test1_v as(select id,row_number() over (partition by num) from test1);")
 	tk.MustQuery("desc test1_v;").Check(testkit.Rows("id int(0) NO  <nil> ", "row_number() over (partition by num) bigint(21) YES  <nil> "))
 }
+
+func TestShowCreateUserWithResourceOptions(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec(`CREATE USER 'test_resource'@'%' WITH MAX_QUERIES_PER_HOUR 100 MAX_USER_CONNECTIONS 2`)
+	tk.MustQuery("select max_questions, max_user_connections from mysql.user where user = 'test_resource'").Check(testkit.Rows("100 2"))
+	tk.MustQuery("show create user 'test_resource'@'%'").
+		Check(testkit.Rows(`CREATE USER 'test_resource'@'%' IDENTIFIED WITH 'mysql_native_password' AS '' REQUIRE NONE WITH MAX_QUERIES_PER_HOUR 100 MAX_USER_CONNECTIONS 2 PASSWORD EXPIRE DEFAULT ACCOUNT UNLOCK`))
+
+	// Only the specified options are changed.
+	tk.MustExec(`ALTER USER 'test_resource'@'%' WITH MAX_USER_CONNECTIONS 0`)
+	tk.MustQuery("select max_questions, max_user_connections from mysql.user where user = 'test_resource'").Check(testkit.Rows("100 0"))
+	tk.MustQuery("show create user 'test_resource'@'%'").
+		Check(testkit.Rows(`CREATE USER 'test_resource'@'%' IDENTIFIED WITH 'mysql_native_password' AS '' REQUIRE NONE WITH MAX_QUERIES_PER_HOUR 100 PASSWORD EXPIRE DEFAULT ACCOUNT UNLOCK`))
+	tk.MustExec(`ALTER USER 'test_resource'@'%' WITH MAX_QUERIES_PER_HOUR 0`)
+	tk.MustQuery("show create user 'test_resource'@'%'").
+		Check(testkit.Rows(`CREATE USER 'test_resource'@'%' IDENTIFIED WITH 'mysql_native_password' AS '' REQUIRE NONE PASSWORD EXPIRE DEFAULT ACCOUNT UNLOCK`))
+}
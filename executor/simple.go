@@ -46,6 +46,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/sessiontxn"
 	"github.com/pingcap/tidb/types"
+	typejson "github.com/pingcap/tidb/types/json"
 	"github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/collate"
@@ -766,6 +767,22 @@ func (e *SimpleExec) executeRollback(s *ast.RollbackStmt) error {
 	return nil
 }
 
+// resourceOptions2Limits returns the MAX_QUERIES_PER_HOUR and MAX_USER_CONNECTIONS in the resource options,
+// -1 means the option is not specified. MAX_UPDATES_PER_HOUR and MAX_CONNECTIONS_PER_HOUR are ignored.
+func resourceOptions2Limits(opts []*ast.ResourceOption) (maxQueriesPerHour, maxUserConnections int64) {
+	maxQueriesPerHour, maxUserConnections = -1, -1
+	// If an option appears many times, the last declaration takes effect.
+	for _, opt := range opts {
+		switch opt.Type {
+		case ast.MaxQueriesPerHour:
+			maxQueriesPerHour = opt.Count
+		case ast.MaxUserConnections:
+			maxUserConnections = opt.Count
+		}
+	}
+	return
+}
+
 func (e *SimpleExec) executeCreateUser(ctx context.Context, s *ast.CreateUserStmt) error {
 	internalCtx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnPrivilege)
 	// Check `CREATE USER` privilege.
@@ -807,11 +824,19 @@ func (e *SimpleExec) executeCreateUser(ctx context.Context, s *ast.CreateUserStm
 		}
 	}
 
+	maxQueriesPerHour, maxUserConnections := resourceOptions2Limits(s.ResourceOptions)
+	if maxQueriesPerHour < 0 {
+		maxQueriesPerHour = 0
+	}
+	if maxUserConnections < 0 {
+		maxUserConnections = 0
+	}
+
 	sql := new(strings.Builder)
 	if s.IsCreateRole || lockAccount {
-		sqlexec.MustFormatSQL(sql, `INSERT INTO %n.%n (Host, User, authentication_string, plugin, Account_locked) VALUES `, mysql.SystemDB, mysql.UserTable)
+		sqlexec.MustFormatSQL(sql, `INSERT INTO %n.%n (Host, User, authentication_string, plugin, max_questions, max_user_connections, Account_locked) VALUES `, mysql.SystemDB, mysql.UserTable)
 	} else {
-		sqlexec.MustFormatSQL(sql, `INSERT INTO %n.%n (Host, User, authentication_string, plugin) VALUES `, mysql.SystemDB, mysql.UserTable)
+		sqlexec.MustFormatSQL(sql, `INSERT INTO %n.%n (Host, User, authentication_string, plugin, max_questions, max_user_connections) VALUES `, mysql.SystemDB, mysql.UserTable)
 	}
 
 	users := make([]*auth.UserIdentity, 0, len(s.Specs))
@@ -859,9 +884,9 @@ func (e *SimpleExec) executeCreateUser(ctx context.Context, s *ast.CreateUserStm
 
 		hostName := strings.ToLower(spec.User.Hostname)
 		if s.IsCreateRole || lockAccount {
-			sqlexec.MustFormatSQL(sql, `(%?, %?, %?, %?, %?)`, hostName, spec.User.Username, pwd, authPlugin, "Y")
+			sqlexec.MustFormatSQL(sql, `(%?, %?, %?, %?, %?, %?, %?)`, hostName, spec.User.Username, pwd, authPlugin, maxQueriesPerHour, maxUserConnections, "Y")
 		} else {
-			sqlexec.MustFormatSQL(sql, `(%?, %?, %?, %?)`, hostName, spec.User.Username, pwd, authPlugin)
+			sqlexec.MustFormatSQL(sql, `(%?, %?, %?, %?, %?, %?)`, hostName, spec.User.Username, pwd, authPlugin, maxQueriesPerHour, maxUserConnections)
 		}
 		users = append(users, spec.User)
 	}
@@ -941,6 +966,8 @@ func (e *SimpleExec) executeAlterUser(ctx context.Context, s *ast.AlterUserStmt)
 		}
 	}
 
+	maxQueriesPerHour, maxUserConnections := resourceOptions2Limits(s.ResourceOptions)
+
 	privData, err := tlsOption2GlobalPriv(s.TLSOptions)
 	if err != nil {
 		return err
@@ -1036,6 +1063,24 @@ func (e *SimpleExec) executeAlterUser(ctx context.Context, s *ast.AlterUserStmt)
 			}
 		}
 
+		if maxQueriesPerHour >= 0 {
+			_, _, err := exec.ExecRestrictedSQL(ctx, nil,
+				`UPDATE %n.%n SET max_questions=%? WHERE Host=%? and User=%?;`,
+				mysql.SystemDB, mysql.UserTable, maxQueriesPerHour, spec.User.Hostname, spec.User.Username)
+			if err != nil {
+				failedUsers = append(failedUsers, spec.User.String())
+			}
+		}
+
+		if maxUserConnections >= 0 {
+			_, _, err := exec.ExecRestrictedSQL(ctx, nil,
+				`UPDATE %n.%n SET max_user_connections=%? WHERE Host=%? and User=%?;`,
+				mysql.SystemDB, mysql.UserTable, maxUserConnections, spec.User.Hostname, spec.User.Username)
+			if err != nil {
+				failedUsers = append(failedUsers, spec.User.String())
+			}
+		}
+
 		if len(privData) > 0 {
 			_, _, err := exec.ExecRestrictedSQL(ctx, nil, "INSERT INTO %n.%n (Host, User, Priv) VALUES (%?,%?,%?) ON DUPLICATE KEY UPDATE Priv = values(Priv)", mysql.SystemDB, mysql.GlobalPrivTable, spec.User.Hostname, spec.User.Username, string(hack.String(privData)))
 			if err != nil {
@@ -1735,6 +1780,18 @@ func asyncDelayShutdown(p *os.Process, delay time.Duration) {
 }
 
 func (e *SimpleExec) executeSetSessionStates(ctx context.Context, s *ast.SetSessionStatesStmt) error {
+	// If the session is authenticated by a session token, the states must be the ones bound to the token.
+	// The token is consumed once the states are set.
+	if token := e.ctx.GetSessionVars().MigrationToken; token != nil {
+		stateJSON, err := typejson.ParseBinaryFromString(s.SessionStates)
+		if err != nil {
+			return err
+		}
+		if err = token.CheckStates([]byte(stateJSON.String())); err != nil {
+			return err
+		}
+		e.ctx.GetSessionVars().MigrationToken = nil
+	}
 	var sessionStates sessionstates.SessionStates
 	decoder := json.NewDecoder(bytes.NewReader([]byte(s.SessionStates)))
 	decoder.UseNumber()
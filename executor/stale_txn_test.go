@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/config"
 	"github.com/pingcap/tidb/ddl/placement"
+	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/sessiontxn/staleread"
 	"github.com/pingcap/tidb/testkit"
 	"github.com/pingcap/tidb/types"
@@ -1369,3 +1370,42 @@ func TestIssue35686(t *testing.T) {
 	// This query should not panic
 	tk.MustQuery("select * from information_schema.ddl_jobs as of timestamp now()")
 }
+
+func TestExternalTSRead(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int)")
+	tk.MustExec("insert into t values (1)")
+
+	tk.MustQuery("select @@global.tidb_external_ts, tidb_external_ts()").Check(testkit.Rows("0 0"))
+	tk.MustExec("set @@tidb_enable_external_ts_read = on")
+	require.ErrorContains(t, tk.QueryToErr("select * from t"), "tidb_external_ts is not set")
+	tk.MustExec("set @@tidb_enable_external_ts_read = off")
+
+	ver, err := store.CurrentVersion(kv.GlobalTxnScope)
+	require.NoError(t, err)
+	tk.MustExec(fmt.Sprintf("set global tidb_external_ts = %d", ver.Ver))
+	tk.MustQuery("select @@global.tidb_external_ts, tidb_external_ts()").Check(testkit.Rows(fmt.Sprintf("%d %d", ver.Ver, ver.Ver)))
+	tk.MustExec("insert into t values (2)")
+
+	tk.MustExec("set @@tidb_enable_external_ts_read = on")
+	tk.MustQuery("select * from t").Check(testkit.Rows("1"))
+	tk.MustExec("set @@tidb_enable_external_ts_read = off")
+	tk.MustQuery("select * from t").Sort().Check(testkit.Rows("1", "2"))
+
+	// the external timestamp can only be advanced, and can't be in the future.
+	require.ErrorContains(t, tk.ExecToErr(fmt.Sprintf("set global tidb_external_ts = %d", ver.Ver-1)), "can't be decreased")
+	require.ErrorContains(t, tk.ExecToErr("set global tidb_external_ts = '2099-01-01 00:00:00'"), "larger than the current timestamp")
+	require.Error(t, tk.ExecToErr("set global tidb_external_ts = 'abc'"))
+
+	// the statement reads the external timestamp once, and the plan cache
+	// doesn't keep the folded value.
+	tk.MustExec("set @@tidb_enable_prepared_plan_cache = on")
+	tk.MustExec("prepare stmt from 'select tidb_external_ts() = tidb_external_ts(), tidb_external_ts()'")
+	tk.MustQuery("execute stmt").Check(testkit.Rows(fmt.Sprintf("1 %d", ver.Ver)))
+	ver, err = store.CurrentVersion(kv.GlobalTxnScope)
+	require.NoError(t, err)
+	tk.MustExec(fmt.Sprintf("set global tidb_external_ts = %d", ver.Ver))
+	tk.MustQuery("execute stmt").Check(testkit.Rows(fmt.Sprintf("1 %d", ver.Ver)))
+}
@@ -19,6 +19,7 @@
 package expression
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"regexp"
@@ -6447,6 +6448,61 @@ func (b *builtinTidbParseTsoSig) evalTime(row chunk.Row) (types.Time, bool, erro
 	return result, false, nil
 }
 
+// tidbExternalTSFunctionClass returns the value of tidb_external_ts.
+type tidbExternalTSFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *tidbExternalTSFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.AddFlag(mysql.UnsignedFlag)
+	sig := &builtinTiDBExternalTSSig{bf}
+	return sig, nil
+}
+
+type builtinTiDBExternalTSSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinTiDBExternalTSSig) Clone() builtinFunc {
+	newSig := &builtinTiDBExternalTSSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalInt evals a builtinTiDBExternalTSSig, it returns 0 if tidb_external_ts is never set.
+func (b *builtinTiDBExternalTSSig) evalInt(_ chunk.Row) (int64, bool, error) {
+	ts, err := GetStmtExternalTimestamp(context.Background(), b.ctx)
+	if err != nil {
+		return 0, true, err
+	}
+	return int64(ts), false, nil
+}
+
+// GetStmtExternalTimestamp returns tidb_external_ts, it's read once and cached
+// in the statement context, so tidb_external_ts() and the stale read at the
+// external timestamp in a statement agree on it.
+func GetStmtExternalTimestamp(ctx context.Context, sctx sessionctx.Context) (uint64, error) {
+	stmtCtx := sctx.GetSessionVars().StmtCtx
+	if ts, ok := stmtCtx.GetStmtCache(stmtctx.StmtExternalTSCacheKey); ok {
+		return ts.(uint64), nil
+	}
+	if variable.GetExternalTimestamp == nil {
+		return 0, errors.New("the external timestamp is not available before the domain is initialized")
+	}
+	ts, err := variable.GetExternalTimestamp(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return stmtCtx.GetOrStoreStmtCache(stmtctx.StmtExternalTSCacheKey, ts).(uint64), nil
+}
+
 // tidbBoundedStalenessFunctionClass reads a time window [a, b] and compares it with the latest SafeTS
 // to determine which TS to use in a read only transaction.
 type tidbBoundedStalenessFunctionClass struct {
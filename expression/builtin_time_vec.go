@@ -15,6 +15,7 @@
 package expression
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"strconv"
@@ -820,6 +821,24 @@ func (b *builtinTidbParseTsoSig) vecEvalTime(input *chunk.Chunk, result *chunk.C
 	return nil
 }
 
+func (b *builtinTiDBExternalTSSig) vectorized() bool {
+	return true
+}
+
+func (b *builtinTiDBExternalTSSig) vecEvalInt(input *chunk.Chunk, result *chunk.Column) error {
+	n := input.NumRows()
+	ts, err := GetStmtExternalTimestamp(context.Background(), b.ctx)
+	if err != nil {
+		return err
+	}
+	result.ResizeInt64(n, false)
+	i64s := result.Int64s()
+	for i := 0; i < n; i++ {
+		i64s[i] = int64(ts)
+	}
+	return nil
+}
+
 func (b *builtinTiDBBoundedStalenessSig) vectorized() bool {
 	return true
 }
@@ -118,6 +118,7 @@ var IllegalFunctions4GeneratedColumns = map[string]struct{}{
 	ast.SetVar:           {},
 	ast.GetVar:           {},
 	ast.ReleaseAllLocks:  {},
+	ast.TiDBExternalTS:   {},
 }
 
 // DeferredFunctions stores functions which are foldable but should be deferred as well when plan cache is enabled.
@@ -134,6 +135,7 @@ var DeferredFunctions = map[string]struct{}{
 	ast.Curdate:          {},
 	ast.CurrentDate:      {},
 	ast.UTCDate:          {},
+	ast.TiDBExternalTS:   {},
 }
 
 // AllowedPartitionFuncMap stores functions which can be used in the partition expression.
@@ -46,6 +46,8 @@ const (
 	ClusterTableDeadlocks = "CLUSTER_DEADLOCKS"
 	// ClusterTableDeadlocks is the string constant of cluster transaction summary table.
 	ClusterTableTrxSummary = "CLUSTER_TRX_SUMMARY"
+	// ClusterTableTiDBTopSQL is the string constant of cluster locally stored Top SQL table.
+	ClusterTableTiDBTopSQL = "CLUSTER_TIDB_TOP_SQL"
 )
 
 // memTableToClusterTables means add memory table to cluster table.
@@ -58,6 +60,7 @@ var memTableToClusterTables = map[string]string{
 	TableTiDBTrx:                  ClusterTableTiDBTrx,
 	TableDeadlocks:                ClusterTableDeadlocks,
 	TableTrxSummary:               ClusterTableTrxSummary,
+	TableTiDBTopSQL:               ClusterTableTiDBTopSQL,
 }
 
 func init() {
@@ -184,6 +184,16 @@ const (
 	TableTrxSummary = "TRX_SUMMARY"
 	// TableVariablesInfo is the string constant of variables_info table.
 	TableVariablesInfo = "VARIABLES_INFO"
+	// TableTiDBTopSQL is the string constant of the locally stored Top SQL table.
+	TableTiDBTopSQL = "TIDB_TOP_SQL"
+	// TableTiDBConfigItems is the string constant of the config items of the TiDB instance.
+	TableTiDBConfigItems = "TIDB_CONFIG_ITEMS"
+	// TableTiDBTableMetrics is the string constant of the per-table request metrics of the TiDB instance.
+	TableTiDBTableMetrics = "TIDB_TABLE_METRICS"
+	// TableTiDBBRTemporaryDatabases is the string constant of the temporary databases created by restore.
+	TableTiDBBRTemporaryDatabases = "TIDB_BR_TEMPORARY_DATABASES"
+	// TableTiDBExternalTSStaleness is the string constant of the staleness of the tables read at tidb_external_ts.
+	TableTiDBExternalTSStaleness = "TIDB_EXTERNAL_TS_STALENESS"
 )
 
 const (
@@ -285,6 +295,12 @@ var tableIDMap = map[string]int64{
 	TableTrxSummary:                      autoid.InformationSchemaDBID + 80,
 	ClusterTableTrxSummary:               autoid.InformationSchemaDBID + 81,
 	TableVariablesInfo:                   autoid.InformationSchemaDBID + 82,
+	TableTiDBTopSQL:                      autoid.InformationSchemaDBID + 83,
+	ClusterTableTiDBTopSQL:               autoid.InformationSchemaDBID + 84,
+	TableTiDBConfigItems:                 autoid.InformationSchemaDBID + 85,
+	TableTiDBTableMetrics:                autoid.InformationSchemaDBID + 86,
+	TableTiDBBRTemporaryDatabases:        autoid.InformationSchemaDBID + 87,
+	TableTiDBExternalTSStaleness:         autoid.InformationSchemaDBID + 88,
 }
 
 // columnInfo represents the basic column information of all kinds of INFORMATION_SCHEMA tables
@@ -1519,6 +1535,56 @@ var tableVariablesInfoCols = []columnInfo{
 	{name: "IS_NOOP", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
 }
 
+var tableTiDBTopSQLCols = []columnInfo{
+	{name: "TIME", tp: mysql.TypeDatetime, size: 19, flag: mysql.NotNullFlag, comment: "The second in which the statements are executed"},
+	{name: "SQL_DIGEST", tp: mysql.TypeVarchar, size: 64, comment: "Digest of the SQL, empty for the aggregation of the others"},
+	{name: "PLAN_DIGEST", tp: mysql.TypeVarchar, size: 64},
+	{name: "SQL_TEXT", tp: mysql.TypeBlob, size: types.UnspecifiedLength, comment: "Normalized SQL text"},
+	{name: "PLAN", tp: mysql.TypeBlob, size: types.UnspecifiedLength, comment: "Normalized execution plan"},
+	{name: "IS_INTERNAL", tp: mysql.TypeTiny, size: 1},
+	{name: "CPU_TIME_MS", tp: mysql.TypeLonglong, size: 20, flag: mysql.UnsignedFlag, comment: "CPU time consumed in milliseconds"},
+	{name: "EXEC_COUNT", tp: mysql.TypeLonglong, size: 20, flag: mysql.UnsignedFlag},
+	{name: "KV_EXEC_COUNT", tp: mysql.TypeLonglong, size: 20, flag: mysql.UnsignedFlag},
+	{name: "SUM_LATENCY", tp: mysql.TypeLonglong, size: 20, flag: mysql.UnsignedFlag, comment: "Sum latency of the finished statements in nanoseconds"},
+	{name: "LATENCY_COUNT", tp: mysql.TypeLonglong, size: 20, flag: mysql.UnsignedFlag, comment: "Count of the finished statements"},
+}
+
+var tableTiDBConfigItemsCols = []columnInfo{
+	{name: "ITEM", tp: mysql.TypeVarchar, size: 256, flag: mysql.NotNullFlag, comment: "Name of the item in the config file"},
+	{name: "VALUE", tp: mysql.TypeBlob, size: types.UnspecifiedLength},
+	{name: "IS_DYNAMIC", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag, comment: "Whether the item can be changed online"},
+	{name: "ORIGIN", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag, comment: "Where the value comes from: default, file, flag or online"},
+}
+
+var tableTiDBTableMetricsCols = []columnInfo{
+	{name: "DB", tp: mysql.TypeVarchar, size: 64},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag, comment: "Name of the table, _other for the aggregation of the tables and indexes out of the top K"},
+	{name: "INDEX_NAME", tp: mysql.TypeVarchar, size: 64, comment: "Name of the index, empty for the table itself"},
+	{name: "EXEC_COUNT", tp: mysql.TypeLonglong, size: 20, flag: mysql.UnsignedFlag, comment: "Count of the finished statements"},
+	{name: "ERROR_COUNT", tp: mysql.TypeLonglong, size: 20, flag: mysql.UnsignedFlag, comment: "Count of the failed statements"},
+	{name: "SUM_LATENCY", tp: mysql.TypeLonglong, size: 20, flag: mysql.UnsignedFlag, comment: "Sum latency of the finished statements in nanoseconds"},
+	{name: "MAX_LATENCY", tp: mysql.TypeLonglong, size: 20, flag: mysql.UnsignedFlag, comment: "Max latency of the finished statements in nanoseconds"},
+	{name: "AVG_LATENCY", tp: mysql.TypeLonglong, size: 20, flag: mysql.UnsignedFlag, comment: "Average latency of the finished statements in nanoseconds"},
+}
+
+var tableTiDBBRTemporaryDatabasesCols = []columnInfo{
+	{name: "DB_NAME", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "ORIGINAL_DB_NAME", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag, comment: "Name of the database restored through the temporary database"},
+	{name: "TASK", tp: mysql.TypeBlob, size: types.UnspecifiedLength, comment: "The restore task creating the temporary database, NULL if it's unknown"},
+	{name: "CREATE_TIME", tp: mysql.TypeDatetime, size: 19, comment: "NULL if it's unknown"},
+	{name: "TABLE_COUNT", tp: mysql.TypeLonglong, size: 21},
+}
+
+var tableTiDBExternalTSStalenessCols = []columnInfo{
+	{name: "TABLE_SCHEMA", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "TABLE_ID", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag},
+	{name: "EXTERNAL_TS", tp: mysql.TypeLonglong, size: 20, flag: mysql.NotNullFlag | mysql.UnsignedFlag},
+	{name: "EXTERNAL_TIME", tp: mysql.TypeDatetime, size: 26, decimal: 3, flag: mysql.NotNullFlag},
+	{name: "STALENESS", tp: mysql.TypeDouble, size: 22, flag: mysql.NotNullFlag, comment: "Seconds between EXTERNAL_TIME and now"},
+	{name: "READABLE", tp: mysql.TypeVarchar, size: 3, flag: mysql.NotNullFlag, comment: "Whether the table exists at EXTERNAL_TS"},
+}
+
 // GetShardingInfo returns a nil or description string for the sharding information of given TableInfo.
 // The returned description string may be:
 //   - "NOT_SHARDED": for tables that SHARD_ROW_ID_BITS is not specified.
@@ -1960,6 +2026,11 @@ var tableNameToColumns = map[string][]columnInfo{
 	TablePlacementPolicies:                  tablePlacementPoliciesCols,
 	TableTrxSummary:                         tableTrxSummaryCols,
 	TableVariablesInfo:                      tableVariablesInfoCols,
+	TableTiDBTopSQL:                         tableTiDBTopSQLCols,
+	TableTiDBConfigItems:                    tableTiDBConfigItemsCols,
+	TableTiDBTableMetrics:                   tableTiDBTableMetricsCols,
+	TableTiDBBRTemporaryDatabases:           tableTiDBBRTemporaryDatabasesCols,
+	TableTiDBExternalTSStaleness:            tableTiDBExternalTSStalenessCols,
 }
 
 func createInfoSchemaTable(_ autoid.Allocators, meta *model.TableInfo) (table.Table, error) {
@@ -1561,3 +1561,78 @@ func TestTableConstraintsContainForeignKeys(t *testing.T) {
 	tk.MustQuery("SELECT *  FROM INFORMATION_SCHEMA.table_constraints WHERE constraint_schema = 'tableconstraints' AND table_name = 't2'").Sort().Check(testkit.Rows("def tableconstraints PRIMARY tableconstraints t2 PRIMARY KEY", "def tableconstraints fk_t2_t1 tableconstraints t2 FOREIGN KEY"))
 	tk.MustQuery("SELECT *  FROM INFORMATION_SCHEMA.table_constraints WHERE constraint_schema = 'tableconstraints' AND table_name = 't1'").Sort().Check(testkit.Rows("def tableconstraints PRIMARY tableconstraints t1 PRIMARY KEY"))
 }
+
+func TestTiDBTopSQL(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use information_schema")
+
+	// the local storage is disabled by default.
+	tk.MustQuery("select * from tidb_top_sql").Check(testkit.Rows())
+	tk.MustQuery("select count(*) from information_schema.columns where table_name = 'CLUSTER_TIDB_TOP_SQL' and column_name = 'INSTANCE'").Check(testkit.Rows("1"))
+}
+
+func TestTiDBConfigItems(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+
+	tk.MustQuery("select is_dynamic, origin from information_schema.tidb_config_items where item = 'token-limit'").Check(testkit.Rows("YES default"))
+	tk.MustQuery("select value, is_dynamic from information_schema.tidb_config_items where item = 'port'").Check(testkit.Rows("4000 NO"))
+}
+
+func TestTiDBTableMetrics(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key, b int, key idx_b(b))")
+	tk.MustExec("insert into t values (1, 1), (2, 2)")
+
+	// the per-table metrics is disabled by default.
+	tk.MustQuery("select * from information_schema.tidb_table_metrics").Check(testkit.Rows())
+
+	tk.MustExec("set global tidb_enable_table_metrics = on")
+	defer tk.MustExec("set global tidb_enable_table_metrics = off")
+	tk.MustQuery("select * from t use index(idx_b) where b = 1").Check(testkit.Rows("1 1"))
+	tk.MustQuery("select * from t use index(idx_b) where b = 2").Check(testkit.Rows("2 2"))
+	tk.MustQuery("select db, table_name, index_name, exec_count, error_count from information_schema.tidb_table_metrics where table_name = 't' order by index_name").
+		Check(testkit.Rows("test t  2 0", "test t idx_b 2 0"))
+	tk.MustQuery("select count(*) from information_schema.tidb_table_metrics where table_name = 't' and sum_latency >= max_latency and max_latency >= avg_latency").Check(testkit.Rows("2"))
+
+	tk.MustExec("set global tidb_enable_table_metrics = off")
+	tk.MustQuery("select * from information_schema.tidb_table_metrics").Check(testkit.Rows())
+}
+
+func TestTiDBBRTemporaryDatabases(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("create database __TiDB_BR_Temporary_mysql")
+	tk.MustExec("create table __TiDB_BR_Temporary_mysql.user (a int)")
+	tk.MustExec("create database __TiDB_BR_Temporary_test")
+	tk.MustExec(`insert into mysql.tidb values ('__tidb_br_temporary_test', '{"task":"Full Restore from local:///tmp/backup","create_time":"2022-06-01T12:00:00Z"}', '')`)
+
+	tk.MustQuery("select db_name, original_db_name, task, create_time is null, table_count from information_schema.tidb_br_temporary_databases order by db_name").Check(testkit.Rows(
+		"__TiDB_BR_Temporary_mysql mysql <nil> 1 1",
+		"__TiDB_BR_Temporary_test test Full Restore from local:///tmp/backup 0 0",
+	))
+}
+
+func TestTiDBExternalTSStaleness(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (a int)")
+
+	// there are no rows if tidb_external_ts is not set.
+	tk.MustQuery("select * from information_schema.tidb_external_ts_staleness").Check(testkit.Rows())
+
+	ver, err := store.CurrentVersion(kv.GlobalTxnScope)
+	require.NoError(t, err)
+	tk.MustExec(fmt.Sprintf("set global tidb_external_ts = %d", ver.Ver))
+	tk.MustExec("create table t2 (a int)")
+	tk.MustExec("create view v as select * from t1")
+	tk.MustQuery("select table_name, external_ts, readable from information_schema.tidb_external_ts_staleness where table_schema = 'test' order by table_name").Check(testkit.Rows(
+		fmt.Sprintf("t1 %d YES", ver.Ver),
+		fmt.Sprintf("t2 %d NO", ver.Ver),
+	))
+	tk.MustQuery("select count(*) from information_schema.tidb_external_ts_staleness where table_schema = 'test' and staleness >= 0 and external_time = tidb_parse_tso(external_ts)").Check(testkit.Rows("2"))
+}
@@ -79,6 +79,7 @@ var (
 	mDDLTableVersion    = []byte("DDLTableVersion")
 	mConcurrentDDL      = []byte("concurrentDDL")
 	mInFlashbackCluster = []byte("InFlashbackCluster")
+	mExternalTimestamp  = []byte("ExternalTimestamp")
 )
 
 const (
@@ -629,6 +630,24 @@ func (m *Meta) IsConcurrentDDL() (bool, error) {
 	return len(val) == 0 || bytes.Equal(val, []byte("1")), nil
 }
 
+// SetExternalTimestamp sets the external timestamp of the cluster.
+func (m *Meta) SetExternalTimestamp(ts uint64) error {
+	return errors.Trace(m.txn.Set(mExternalTimestamp, []byte(strconv.FormatUint(ts, 10))))
+}
+
+// GetExternalTimestamp returns the external timestamp of the cluster, it's 0 if it's never set.
+func (m *Meta) GetExternalTimestamp() (uint64, error) {
+	val, err := m.txn.Get(mExternalTimestamp)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if len(val) == 0 {
+		return 0, nil
+	}
+	ts, err := strconv.ParseUint(string(val), 10, 64)
+	return ts, errors.Trace(err)
+}
+
 // CreateTableAndSetAutoID creates a table with tableInfo in database,
 // and rebases the table autoID.
 func (m *Meta) CreateTableAndSetAutoID(dbID int64, tableInfo *model.TableInfo, autoIncID, autoRandID int64) error {
@@ -867,3 +867,26 @@ func TestDDLTable(t *testing.T) {
 	err = txn.Rollback()
 	require.NoError(t, err)
 }
+
+func TestExternalTimestamp(t *testing.T) {
+	store, err := mockstore.NewMockStore()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, store.Close())
+	}()
+
+	txn, err := store.Begin()
+	require.NoError(t, err)
+
+	m := meta.NewMeta(txn)
+	ts, err := m.GetExternalTimestamp()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), ts)
+
+	require.NoError(t, m.SetExternalTimestamp(435893432819089409))
+	ts, err = m.GetExternalTimestamp()
+	require.NoError(t, err)
+	require.Equal(t, uint64(435893432819089409), ts)
+
+	require.NoError(t, txn.Rollback())
+}
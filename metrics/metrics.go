@@ -185,6 +185,8 @@ func RegisterMetrics() {
 	prometheus.MustRegister(TopSQLIgnoredCounter)
 	prometheus.MustRegister(TopSQLReportDurationHistogram)
 	prometheus.MustRegister(TopSQLReportDataHistogram)
+	prometheus.MustRegister(TableRequestCounter)
+	prometheus.MustRegister(TableRequestDurationHistogram)
 	prometheus.MustRegister(PDAPIExecutionHistogram)
 	prometheus.MustRegister(PDAPIRequestCounter)
 	prometheus.MustRegister(CPUProfileCounter)
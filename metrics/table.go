@@ -0,0 +1,44 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// Label constants of the per-table metrics.
+const (
+	LblTable = "table"
+	LblIndex = "index"
+)
+
+// Per-table metrics. Only the top tables and indexes are labeled to limit the cardinality,
+// see util/tablemetrics.
+var (
+	TableRequestCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tidb",
+			Subsystem: "table",
+			Name:      "request_total",
+			Help:      "Counter of the statements accessing the table or index.",
+		}, []string{LblDb, LblTable, LblIndex, LblResult})
+
+	TableRequestDurationHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "tidb",
+			Subsystem: "table",
+			Name:      "request_duration_seconds",
+			Help:      "Bucket histogram of the latency of the statements accessing the table or index.",
+			Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 20), // 0.5ms ~ 262s
+		}, []string{LblDb, LblTable, LblIndex})
+)
@@ -182,6 +182,7 @@ const (
 	// For more info, please see AsOfClause.
 	TiDBBoundedStaleness = "tidb_bounded_staleness"
 	TiDBParseTso         = "tidb_parse_tso"
+	TiDBExternalTS       = "tidb_external_ts"
 
 	// string functions
 	ASCII           = "ascii"
@@ -0,0 +1,165 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package charset
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/transform"
+)
+
+// EncodingGB18030Impl is the instance of encodingGB18030
+var EncodingGB18030Impl = &encodingGB18030{encodingBase{enc: customGB18030{}}}
+
+func init() {
+	EncodingGB18030Impl.self = EncodingGB18030Impl
+}
+
+// encodingGB18030 is GB18030 encoding.
+type encodingGB18030 struct {
+	encodingBase
+}
+
+// Name implements Encoding interface.
+func (*encodingGB18030) Name() string {
+	return CharsetGB18030
+}
+
+// Tp implements Encoding interface.
+func (*encodingGB18030) Tp() EncodingTp {
+	return EncodingTpGB18030
+}
+
+// Peek implements Encoding interface.
+func (*encodingGB18030) Peek(src []byte) []byte {
+	charLen := gb18030CharLen(src)
+	if charLen < len(src) {
+		return src[:charLen]
+	}
+	return src
+}
+
+// MbLen implements Encoding interface.
+func (*encodingGB18030) MbLen(bs string) int {
+	if len(bs) < 2 || bs[0] < 0x81 || bs[0] > 0xfe {
+		return 0
+	}
+	if (0x40 <= bs[1] && bs[1] <= 0x7e) || (0x80 <= bs[1] && bs[1] <= 0xfe) {
+		return 2
+	}
+	if len(bs) >= 4 && 0x30 <= bs[1] && bs[1] <= 0x39 &&
+		0x81 <= bs[2] && bs[2] <= 0xfe && 0x30 <= bs[3] && bs[3] <= 0x39 {
+		return 4
+	}
+	return 0
+}
+
+// gb18030CharLen returns the length of the first character in src. A character is
+// a single byte in the range 00–7F, two bytes if the second byte is not a digit,
+// or four bytes otherwise.
+func gb18030CharLen(src []byte) int {
+	if len(src) == 0 || src[0] < 0x80 {
+		return 1
+	}
+	if len(src) >= 2 && 0x30 <= src[1] && src[1] <= 0x39 {
+		return 4
+	}
+	return 2
+}
+
+// customGB18030 is a simplifiedchinese.GB18030 wrapper.
+// simplifiedchinese.GB18030 follows Code Page 936 to encode '€' as 0x80, while
+// it's 0xA2E3 in GB18030 and 0x80 is invalid.
+type customGB18030 struct{}
+
+// NewCustomGB18030Encoder return a custom GB18030 encoding.
+func NewCustomGB18030Encoder() *encoding.Encoder {
+	return customGB18030{}.NewEncoder()
+}
+
+// NewDecoder returns simplifiedchinese.GB18030.NewDecoder() except for 0x80.
+func (customGB18030) NewDecoder() *encoding.Decoder {
+	return &encoding.Decoder{
+		Transformer: customGB18030Decoder{
+			gb18030Decoder: simplifiedchinese.GB18030.NewDecoder(),
+		},
+	}
+}
+
+type customGB18030Decoder struct {
+	gb18030Decoder *encoding.Decoder
+}
+
+// Transform treats 0x80 as an invalid character.
+func (c customGB18030Decoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	if len(src) == 0 {
+		return 0, 0, nil
+	}
+	if src[0] == 0x80 {
+		return utf8.EncodeRune(dst[:], utf8.RuneError), 1, nil
+	}
+	return c.gb18030Decoder.Transform(dst, src, atEOF)
+}
+
+// Reset is same as simplifiedchinese.GB18030.Reset().
+func (c customGB18030Decoder) Reset() {
+	c.gb18030Decoder.Reset()
+}
+
+// NewEncoder returns simplifiedchinese.GB18030.NewEncoder() except for '€'.
+func (customGB18030) NewEncoder() *encoding.Encoder {
+	return &encoding.Encoder{
+		Transformer: customGB18030Encoder{
+			gb18030Encoder: simplifiedchinese.GB18030.NewEncoder(),
+		},
+	}
+}
+
+type customGB18030Encoder struct {
+	gb18030Encoder *encoding.Encoder
+}
+
+var euroUTF8 = []byte{0xe2, 0x82, 0xac}
+
+// Transform encodes '€' as 0xA2E3.
+func (c customGB18030Encoder) Transform(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	for {
+		i := bytes.Index(src[nSrc:], euroUTF8)
+		if i < 0 {
+			n, m, err := c.gb18030Encoder.Transform(dst[nDst:], src[nSrc:], atEOF)
+			return nDst + n, nSrc + m, err
+		}
+		if i > 0 {
+			n, m, err := c.gb18030Encoder.Transform(dst[nDst:], src[nSrc:nSrc+i], true)
+			nDst, nSrc = nDst+n, nSrc+m
+			if err != nil {
+				return nDst, nSrc, err
+			}
+		}
+		if len(dst)-nDst < 2 {
+			return nDst, nSrc, transform.ErrShortDst
+		}
+		dst[nDst], dst[nDst+1] = 0xa2, 0xe3
+		nDst, nSrc = nDst+2, nSrc+len(euroUTF8)
+	}
+}
+
+// Reset is same as simplifiedchinese.GB18030.Reset().
+func (c customGB18030Encoder) Reset() {
+	c.gb18030Encoder.Reset()
+}
@@ -99,6 +99,46 @@ func TestEncoding(t *testing.T) {
 	}
 }
 
+func TestEncodingGB18030(t *testing.T) {
+	enc := charset.FindEncoding(charset.CharsetGB18030)
+	require.Equal(t, charset.CharsetGB18030, enc.Name())
+
+	txt := []byte("一二三𠀀€")
+	gb18030EncodedTxt := []byte("\xd2\xbb\xb6\xfe\xc8\xfd\x95\x32\x82\x36\xa2\xe3")
+	result, err := enc.Transform(nil, txt, charset.OpEncode)
+	require.NoError(t, err)
+	require.Equal(t, gb18030EncodedTxt, result)
+	result, err = enc.Transform(nil, gb18030EncodedTxt, charset.OpDecode)
+	require.NoError(t, err)
+	require.Equal(t, txt, result)
+
+	require.Equal(t, 2, enc.MbLen("\xd2\xbb"))
+	require.Equal(t, 4, enc.MbLen("\x95\x32\x82\x36"))
+	require.Equal(t, 0, enc.MbLen("\x95\x32"))
+	require.Equal(t, 0, enc.MbLen("a"))
+
+	cases := []struct {
+		str     string
+		result  string
+		isValid bool
+	}{
+		{"\x80", "?", false},
+		{"a\x80a", "a?", false},
+		{"\x95\x32\x82\x36a", "𠀀a", true},
+		{"\x95\x32\x82", "?", false},
+	}
+	for _, tc := range cases {
+		cmt := fmt.Sprintf("%v", tc)
+		result, err := enc.Transform(nil, []byte(tc.str), charset.OpDecodeReplace)
+		if tc.isValid {
+			require.NoError(t, err, cmt)
+		} else {
+			require.Error(t, err, cmt)
+		}
+		require.Equal(t, tc.result, string(result), cmt)
+	}
+}
+
 func TestEncodingValidate(t *testing.T) {
 	oxfffefd := string([]byte{0xff, 0xfe, 0xfd})
 	testCases := []struct {
@@ -136,6 +176,10 @@ func TestEncodingValidate(t *testing.T) {
 		{charset.CharsetGBK, "À", "?", 0, false},
 		{charset.CharsetGBK, "中文À中文", "中文?中文", 6, false},
 		{charset.CharsetGBK, "asdfÀ", "asdf?", 4, false},
+		{charset.CharsetGB18030, "", "", 0, true},
+		{charset.CharsetGB18030, "asdf", "asdf", 4, true},
+		{charset.CharsetGB18030, "中文À€", "中文À€", 11, true},
+		{charset.CharsetGB18030, "😂", "😂", 4, true},
 	}
 	for _, tc := range testCases {
 		msg := fmt.Sprintf("%v", tc)
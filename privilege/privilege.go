@@ -93,6 +93,10 @@ type Manager interface {
 
 	// Get the authentication plugin for a user
 	GetAuthPlugin(user, host string) (string, error)
+
+	// GetUserResources returns the MAX_USER_CONNECTIONS and MAX_QUERIES_PER_HOUR limits of a user, 0 means no limit.
+	// Requires exact match on user name and host name.
+	GetUserResources(user, host string) (maxUserConnections, maxQueriesPerHour uint64)
 }
 
 const key keyType = 0
@@ -56,6 +56,13 @@ var dynamicPrivs = []string{
 }
 var dynamicPrivLock sync.Mutex
 
+// dataAdminTables are the system tables which can be read with the dynamic
+// privileges of the tasks recorded by them, so the tasks can be watched without
+// the privileges on the mysql schema.
+var dataAdminTables = map[string][]string{
+	"tidb_br_progress": {"BACKUP_ADMIN", "RESTORE_ADMIN"},
+}
+
 // UserPrivileges implements privilege.Manager interface.
 // This is used to check privilege for the current user.
 type UserPrivileges struct {
@@ -152,6 +159,14 @@ func (p *UserPrivileges) RequestVerification(activeRoles []*auth.RoleIdentity, d
 		}
 	}
 
+	if dbLowerName == mysql.SystemDB && priv == mysql.SelectPriv {
+		for _, dynamicPriv := range dataAdminTables[tblLowerName] {
+			if p.RequestDynamicVerification(activeRoles, dynamicPriv, false) {
+				return true
+			}
+		}
+	}
+
 	mysqlPriv := p.Handle.Get()
 	return mysqlPriv.RequestVerification(activeRoles, p.user, p.host, db, table, column, priv)
 }
@@ -244,6 +259,19 @@ func (p *UserPrivileges) GetAuthPlugin(user, host string) (string, error) {
 	return "", errors.New("Failed to get plugin for user")
 }
 
+// GetUserResources implements the Manager interface.
+func (p *UserPrivileges) GetUserResources(user, host string) (maxUserConnections, maxQueriesPerHour uint64) {
+	if SkipWithGrant {
+		return 0, 0
+	}
+	mysqlPriv := p.Handle.Get()
+	record := mysqlPriv.connectionVerification(user, host)
+	if record == nil {
+		return 0, 0
+	}
+	return record.MaxUserConnections, record.MaxQueriesPerHour
+}
+
 // MatchIdentity implements the Manager interface.
 func (p *UserPrivileges) MatchIdentity(user, host string, skipNameResolve bool) (u string, h string, success bool) {
 	if SkipWithGrant {
@@ -1683,6 +1683,40 @@ func TestDynamicPrivs(t *testing.T) {
 	tk.MustExec("SET GLOBAL wait_timeout = 87000")
 }
 
+func TestBRIEDynamicPrivs(t *testing.T) {
+	store := createStoreAndPrepareDB(t)
+
+	rootTk := testkit.NewTestKit(t, store)
+	rootTk.MustExec("CREATE USER dataadmin")
+
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "dataadmin", Hostname: "%"}, nil, nil))
+	err := tk.ExecToErr("SHOW BACKUPS")
+	require.EqualError(t, err, "[planner:1227]Access denied; you need (at least one of) the SUPER or BACKUP_ADMIN privilege(s) for this operation")
+	err = tk.ExecToErr("SHOW RESTORES")
+	require.EqualError(t, err, "[planner:1227]Access denied; you need (at least one of) the SUPER or RESTORE_ADMIN privilege(s) for this operation")
+	err = tk.ExecToErr("SELECT * FROM mysql.tidb_br_progress")
+	require.EqualError(t, err, "[planner:1142]SELECT command denied to user 'dataadmin'@'%' for table 'tidb_br_progress'")
+
+	// each privilege only allows its own kind of tasks.
+	rootTk.MustExec("GRANT RESTORE_ADMIN ON *.* TO dataadmin")
+	tk.MustQuery("SHOW RESTORES").Check(testkit.Rows())
+	// the progress of the tasks can be watched, but not modified.
+	tk.MustQuery("SELECT * FROM mysql.tidb_br_progress").Check(testkit.Rows())
+	err = tk.ExecToErr("DELETE FROM mysql.tidb_br_progress")
+	require.EqualError(t, err, "[planner:1142]DELETE command denied to user 'dataadmin'@'%' for table 'tidb_br_progress'")
+	err = tk.ExecToErr("SELECT * FROM mysql.user")
+	require.EqualError(t, err, "[planner:1142]SELECT command denied to user 'dataadmin'@'%' for table 'user'")
+	err = tk.ExecToErr("SHOW BACKUPS")
+	require.EqualError(t, err, "[planner:1227]Access denied; you need (at least one of) the SUPER or BACKUP_ADMIN privilege(s) for this operation")
+	rootTk.MustExec("GRANT BACKUP_ADMIN ON *.* TO dataadmin")
+	tk.MustQuery("SHOW BACKUPS").Check(testkit.Rows())
+	rootTk.MustQuery("SHOW GRANTS FOR dataadmin").Check(testkit.Rows(
+		"GRANT USAGE ON *.* TO 'dataadmin'@'%'",
+		"GRANT BACKUP_ADMIN,RESTORE_ADMIN ON *.* TO 'dataadmin'@'%'",
+	))
+}
+
 func TestDynamicGrantOption(t *testing.T) {
 	store := createStoreAndPrepareDB(t)
 
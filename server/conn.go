@@ -89,6 +89,7 @@ import (
 	"github.com/pingcap/tidb/util/memory"
 	tlsutil "github.com/pingcap/tidb/util/tls"
 	topsqlstate "github.com/pingcap/tidb/util/topsql/state"
+	"github.com/pingcap/tidb/util/traffic"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/tikv/client-go/v2/util"
 	"go.uber.org/zap"
@@ -207,6 +208,8 @@ type clientConn struct {
 	rsEncoder     *resultEncoder    // rsEncoder is used to encode the string result to different charsets.
 	inputDecoder  *inputDecoder     // inputDecoder is used to decode the different charsets of incoming strings to utf-8.
 	socketCredUID uint32            // UID from the other end of the Unix Socket
+	trafficRecord *traffic.Record   // the record of the dispatching command when traffic capture is running.
+	maxQueries    uint64            // MAX_QUERIES_PER_HOUR of the user, 0 means no limit.
 	// mu is used for cancelling the execution of current transaction.
 	mu struct {
 		sync.RWMutex
@@ -340,6 +343,7 @@ func (cc *clientConn) Close() error {
 
 func closeConn(cc *clientConn, connections int) error {
 	metrics.ConnGauge.Set(float64(connections))
+	cc.server.userResources.releaseConn(cc.connectionID)
 	if cc.bufReadConn != nil {
 		err := cc.bufReadConn.Close()
 		if err != nil {
@@ -805,13 +809,18 @@ func (cc *clientConn) openSessionAndDoAuth(authData []byte, authPlugin string) e
 		if !cc.ctx.AuthWithoutVerification(userIdentity) {
 			return errAccessDenied.FastGenByArgs(cc.user, host, hasPassword)
 		}
-		if err = sessionstates.ValidateSessionToken(authData, cc.user); err != nil {
+		token, err := sessionstates.ParseSessionToken(authData, cc.user)
+		if err != nil {
 			logutil.BgLogger().Warn("verify session token failed", zap.String("username", cc.user), zap.Error(err))
 			return errAccessDenied.FastGenByArgs(cc.user, host, hasPassword)
 		}
+		cc.ctx.GetSessionVars().MigrationToken = token
 	} else if err = cc.ctx.Auth(userIdentity, authData, cc.salt); err != nil {
 		return err
 	}
+	if err = cc.acquireUserResources(); err != nil {
+		return err
+	}
 	cc.ctx.SetPort(port)
 	if cc.dbname != "" {
 		err = cc.useDB(context.Background(), cc.dbname)
@@ -823,6 +832,21 @@ func (cc *clientConn) openSessionAndDoAuth(authData []byte, authPlugin string) e
 	return nil
 }
 
+// acquireUserResources loads the resource limits of the authenticated user and counts
+// the connection for the user, it fails if the user has too many connections.
+func (cc *clientConn) acquireUserResources() error {
+	user := cc.ctx.GetSessionVars().User
+	if user == nil {
+		return nil
+	}
+	var maxConns uint64
+	cc.maxQueries = 0
+	if checker := privilege.GetPrivilegeManager(cc.ctx.Session); checker != nil {
+		maxConns, cc.maxQueries = checker.GetUserResources(user.AuthUsername, user.AuthHostname)
+	}
+	return cc.server.userResources.acquireConn(cc.connectionID, accountName(user), maxConns)
+}
+
 // Check if the Authentication Plugin of the server, client and user configuration matches
 func (cc *clientConn) checkAuthPlugin(ctx context.Context, resp *handshakeResponse41) ([]byte, error) {
 	// Open a context unless this was done before.
@@ -1225,7 +1249,7 @@ func (cc *clientConn) addMetrics(cmd byte, startTime time.Time, err error) {
 // dispatch handles client request based on command which is the first byte of the data.
 // It also gets a token from server which is used to limit the concurrently handling clients.
 // The most frequently used command is ComQuery.
-func (cc *clientConn) dispatch(ctx context.Context, data []byte) error {
+func (cc *clientConn) dispatch(ctx context.Context, data []byte) (err error) {
 	defer func() {
 		// reset killed for each request
 		atomic.StoreUint32(&cc.ctx.GetSessionVars().Killed, 0)
@@ -1252,6 +1276,12 @@ func (cc *clientConn) dispatch(ctx context.Context, data []byte) error {
 	cc.lastPacket = data
 	cmd := data[0]
 	data = data[1:]
+	if capturer := traffic.GlobalCapturer(); capturer != nil {
+		cc.trafficRecord = cc.newTrafficRecord(capturer, cmd, data, t)
+		defer func() {
+			cc.finishTrafficRecord(capturer, err)
+		}()
+	}
 	if topsqlstate.TopSQLEnabled() {
 		defer pprof.SetGoroutineLabels(ctx)
 	}
@@ -1298,6 +1328,12 @@ func (cc *clientConn) dispatch(ctx context.Context, data []byte) error {
 		cc.ctx.SetCommandValue(cmd)
 	}
 
+	if cc.maxQueries > 0 && (cmd == mysql.ComQuery || cmd == mysql.ComStmtExecute) {
+		if err := cc.server.userResources.useQuery(accountName(vars.User), cc.maxQueries, t); err != nil {
+			return err
+		}
+	}
+
 	dataStr := string(hack.String(data))
 	switch cmd {
 	case mysql.ComPing, mysql.ComStmtClose, mysql.ComStmtSendLongData, mysql.ComStmtReset,
@@ -68,6 +68,9 @@ func (cc *clientConn) handleStmtPrepare(ctx context.Context, sql string) error {
 	if err != nil {
 		return err
 	}
+	if cc.trafficRecord != nil {
+		cc.trafficRecord.StmtID = uint32(stmt.ID())
+	}
 	data := make([]byte, 4, 128)
 
 	// status ok
@@ -0,0 +1,229 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/owner"
+	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/tikv/client-go/v2/oracle"
+	"github.com/tikv/client-go/v2/tikv"
+	"go.uber.org/zap"
+)
+
+const defaultHealthCheckTimeout = 3 * time.Second
+
+// healthCheck is a check of a dependency or a background subsystem.
+type healthCheck struct {
+	name string
+	// critical checks decide whether the server is ready to serve.
+	critical bool
+	check    func(ctx context.Context) (detail interface{}, err error)
+}
+
+// healthCheckResult is the result of a healthCheck.
+type healthCheckResult struct {
+	Name      string      `json:"name"`
+	Healthy   bool        `json:"healthy"`
+	Critical  bool        `json:"critical"`
+	LatencyMS float64     `json:"latency_ms"`
+	Detail    interface{} `json:"detail,omitempty"`
+	Error     string      `json:"error,omitempty"`
+}
+
+// healthDetail is the response of `/health/detail`.
+type healthDetail struct {
+	Healthy bool                `json:"healthy"`
+	Checks  []healthCheckResult `json:"checks"`
+}
+
+func (s *Server) healthChecks() []healthCheck {
+	return []healthCheck{
+		{name: "pd", critical: true, check: s.checkPD},
+		{name: "schema_lease", critical: true, check: s.checkSchemaLease},
+		{name: "region_cache", check: s.checkRegionCache},
+		{name: "ddl_owner", check: s.checkDDLOwner},
+		{name: "stats_owner", check: s.checkStatsOwner},
+	}
+}
+
+// handleHealthDetail runs all the health checks concurrently and reports the results with the latencies.
+// It responds 200 if all the critical checks pass, otherwise 503.
+func (s *Server) handleHealthDetail(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set(headerContentType, contentTypeJSON)
+	if s.inShutdownMode || s.dom == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	timeout := defaultHealthCheckTimeout
+	if val := req.FormValue("timeout"); val != "" {
+		d, err := time.ParseDuration(val)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		timeout = d
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	defer cancel()
+
+	detail := runHealthChecks(ctx, s.healthChecks())
+	js, err := json.MarshalIndent(detail, "", " ")
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logutil.BgLogger().Error("encode json failed", zap.Error(err))
+		return
+	}
+	if !detail.Healthy {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_, err = w.Write(js)
+	terror.Log(errors.Trace(err))
+}
+
+func runHealthChecks(ctx context.Context, checks []healthCheck) healthDetail {
+	results := make([]healthCheckResult, len(checks))
+	var wg sync.WaitGroup
+	for i := range checks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c := checks[i]
+			start := time.Now()
+			detail, err := c.check(ctx)
+			results[i] = healthCheckResult{
+				Name:      c.name,
+				Healthy:   err == nil,
+				Critical:  c.critical,
+				LatencyMS: float64(time.Since(start).Microseconds()) / 1000,
+				Detail:    detail,
+			}
+			if err != nil {
+				results[i].Error = err.Error()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	healthy := true
+	for _, r := range results {
+		if r.Critical && !r.Healthy {
+			healthy = false
+		}
+	}
+	return healthDetail{Healthy: healthy, Checks: results}
+}
+
+func (s *Server) checkPD(ctx context.Context) (interface{}, error) {
+	if s.dom.IsLostConnectionToPD() {
+		return nil, errors.New("lost connection to PD")
+	}
+	store := s.dom.Store()
+	ts, err := store.GetOracle().GetTimestamp(ctx, &oracle.Option{TxnScope: kv.GlobalTxnScope})
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"tso": ts}, nil
+}
+
+func (s *Server) checkSchemaLease(context.Context) (interface{}, error) {
+	validator := s.dom.SchemaValidator
+	if validator == nil {
+		return nil, errors.New("schema validator is not initialized")
+	}
+	if !validator.IsStarted() {
+		return nil, errors.New("schema validator is stopped")
+	}
+	schemaVer, expire := validator.LeaseInfo()
+	detail := map[string]interface{}{
+		"schema_version":      schemaVer,
+		"info_schema_version": s.dom.InfoSchema().SchemaMetaVersion(),
+	}
+	if expire.IsZero() {
+		// the schema lease is disabled.
+		return detail, nil
+	}
+	detail["lease_expire_at"] = expire
+	detail["lease_expires_in"] = time.Until(expire).String()
+	if time.Now().After(expire) {
+		return detail, errors.New("schema lease is expired")
+	}
+	return detail, nil
+}
+
+// checkRegionCache compares the cached region of the meta key with the region in PD.
+func (s *Server) checkRegionCache(ctx context.Context) (interface{}, error) {
+	store, ok := s.dom.Store().(tikv.Storage)
+	if !ok {
+		return map[string]interface{}{"skipped": "not a tikv store"}, nil
+	}
+	key := tablecodec.MetaPrefix()
+	regionCache := store.GetRegionCache()
+	loc, err := regionCache.LocateKey(tikv.NewBackofferWithVars(ctx, 500, nil), key)
+	if err != nil {
+		return nil, err
+	}
+	region, err := regionCache.PDClient().GetRegion(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if region == nil || region.Meta == nil {
+		return nil, errors.New("region of the meta key is not found in PD")
+	}
+	detail := map[string]interface{}{
+		"region_id":      loc.Region.GetID(),
+		"cached_version": loc.Region.GetVer(),
+		"pd_version":     region.Meta.GetRegionEpoch().GetVersion(),
+	}
+	if loc.Region.GetID() != region.Meta.GetId() || loc.Region.GetVer() < region.Meta.GetRegionEpoch().GetVersion() {
+		return detail, errors.New("region cache is stale")
+	}
+	return detail, nil
+}
+
+func (s *Server) checkDDLOwner(ctx context.Context) (interface{}, error) {
+	d := s.dom.DDL()
+	if d == nil {
+		return nil, errors.New("DDL is not initialized")
+	}
+	return ownerDetail(ctx, d.OwnerManager())
+}
+
+func (s *Server) checkStatsOwner(ctx context.Context) (interface{}, error) {
+	return ownerDetail(ctx, s.dom.StatsOwner())
+}
+
+func ownerDetail(ctx context.Context, m owner.Manager) (interface{}, error) {
+	if m == nil {
+		return nil, errors.New("owner manager is not initialized")
+	}
+	ownerID, err := m.GetOwnerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"is_owner": m.IsOwner(),
+		"owner_id": ownerID,
+	}, nil
+}
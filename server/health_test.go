@@ -0,0 +1,46 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunHealthChecks(t *testing.T) {
+	ok := func(context.Context) (interface{}, error) { return "ok", nil }
+	fail := func(context.Context) (interface{}, error) { return nil, errors.New("mock failure") }
+
+	detail := runHealthChecks(context.Background(), []healthCheck{
+		{name: "a", critical: true, check: ok},
+		{name: "b", check: fail},
+	})
+	require.True(t, detail.Healthy)
+	require.Len(t, detail.Checks, 2)
+	require.Equal(t, "a", detail.Checks[0].Name)
+	require.Equal(t, "ok", detail.Checks[0].Detail)
+	require.False(t, detail.Checks[1].Healthy)
+	require.Equal(t, "mock failure", detail.Checks[1].Error)
+
+	detail = runHealthChecks(context.Background(), []healthCheck{
+		{name: "a", critical: true, check: fail},
+		{name: "b", check: ok},
+	})
+	require.False(t, detail.Healthy)
+	require.True(t, detail.Checks[1].Healthy)
+}
@@ -395,6 +395,11 @@ type valueHandler struct {
 // labelHandler is the handler for set labels
 type labelHandler struct{}
 
+// configHandler is the handler for get config and change the dynamic config items online.
+type configHandler struct {
+	srv *Server
+}
+
 const (
 	opTableRegions     = "regions"
 	opTableRanges      = "ranges"
@@ -2190,3 +2195,27 @@ func (h labelHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 
 	writeData(w, config.GetGlobalConfig().Labels)
 }
+
+// ServeHTTP handles request of get config and change config items.
+// The POST body is a JSON object of the config items, such as {"log.level": "warn"}.
+func (h configHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		writeData(w, config.GetGlobalConfig())
+	case http.MethodPost:
+		items := make(map[string]interface{})
+		if err := json.NewDecoder(req.Body).Decode(&items); err != nil {
+			writeError(w, err)
+			return
+		}
+		accepted, err := config.UpdateGlobalConfigItems(items, h.srv.reloadConfig)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		logutil.BgLogger().Info("config changed online", zap.Strings("items", accepted))
+		writeData(w, config.GetGlobalConfig())
+	default:
+		writeError(w, errors.Errorf("This api only support GET and POST method"))
+	}
+}
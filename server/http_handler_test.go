@@ -1163,3 +1163,29 @@ func TestSetLabels(t *testing.T) {
 	// reset the global variable
 	config.GetGlobalConfig().Labels = map[string]string{}
 }
+
+func TestSetConfig(t *testing.T) {
+	ts := createBasicHTTPHandlerTestSuite()
+
+	ts.startServer(t)
+	defer ts.stopServer(t)
+	defer config.RestoreFunc()()
+
+	resp, err := ts.postStatus("/config", "application/json", bytes.NewBufferString(`{"token-limit": 10}`))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var cfg config.Config
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&cfg))
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, uint(10), cfg.TokenLimit)
+	require.Equal(t, uint(10), config.GetGlobalConfig().TokenLimit)
+	require.Equal(t, uint(10), ts.server.concurrentLimiter.Load().count)
+
+	resp, err = ts.postStatus("/config", "application/json", bytes.NewBufferString(`{"port": 4001}`))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, "config item port can't be changed online", string(body))
+}
@@ -39,6 +39,7 @@ import (
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/fn"
 	"github.com/pingcap/tidb/config"
+	"github.com/pingcap/tidb/executor"
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/parser/terror"
@@ -194,6 +195,7 @@ func (s *Server) startHTTPServer() {
 	router := mux.NewRouter()
 
 	router.HandleFunc("/status", s.handleStatus).Name("Status")
+	router.HandleFunc("/health/detail", s.handleHealthDetail).Name("HealthDetail")
 	// HTTP path for prometheus.
 	router.Handle("/metrics", promhttp.Handler()).Name("Metrics")
 
@@ -221,12 +223,18 @@ func (s *Server) startHTTPServer() {
 	router.Handle("/ddl/history", ddlHistoryJobHandler{tikvHandlerTool}).Name("DDL_History")
 	router.Handle("/ddl/owner/resign", ddlResignOwnerHandler{tikvHandlerTool.Store.(kv.Storage)}).Name("DDL_Owner_Resign")
 
-	// HTTP path for get the TiDB config
-	router.Handle("/config", fn.Wrap(func() (*config.Config, error) {
-		return config.GetGlobalConfig(), nil
-	}))
+	// HTTP path for get the TiDB config and change the dynamic config items.
+	router.Handle("/config", configHandler{s})
 	router.Handle("/labels", labelHandler{}).Name("Labels")
 
+	// HTTP path for get the progress of the BACKUP and RESTORE tasks.
+	router.Handle("/brie/tasks", fn.Wrap(func() ([]executor.BRIETaskStatus, error) {
+		return executor.GetBRIETasks(), nil
+	})).Name("BRIETasks")
+
+	// HTTP path for capturing the traffic of this server.
+	router.Handle("/traffic/capture", trafficHandler{}).Name("TrafficCapture")
+
 	// HTTP path for get server info.
 	router.Handle("/info", serverInfoHandler{tikvHandlerTool}).Name("Info")
 	router.Handle("/info/all", allServerInfoHandler{tikvHandlerTool}).Name("InfoALL")
@@ -456,6 +464,7 @@ func (s *Server) startStatusServerAndRPCServer(serverMux *http.ServeMux) {
 
 	statusServer := &http.Server{Addr: s.statusAddr, Handler: CorsHandler{handler: serverMux, cfg: s.cfg}}
 	grpcServer := NewRPCServer(s.cfg, s.dom, s)
+	registerStatusService(grpcServer, s)
 	service.RegisterChannelzServiceToServer(grpcServer)
 
 	s.statusServer = statusServer
@@ -104,6 +104,8 @@ var (
 	errNewAbortingConnection   = dbterror.ClassServer.NewStd(errno.ErrNewAbortingConnection)
 	errNotSupportedAuthMode    = dbterror.ClassServer.NewStd(errno.ErrNotSupportedAuthMode)
 	errNetPacketTooLarge       = dbterror.ClassServer.NewStd(errno.ErrNetPacketTooLarge)
+	errTooManyUserConnections  = dbterror.ClassServer.NewStd(errno.ErrTooManyUserConnections)
+	errUserLimitReached        = dbterror.ClassServer.NewStd(errno.ErrUserLimitReached)
 )
 
 // DefaultCapability is the capability of the server when it is created using the default configuration.
@@ -122,7 +124,7 @@ type Server struct {
 	listener          net.Listener
 	socket            net.Listener
 	rwlock            sync.RWMutex
-	concurrentLimiter *TokenLimiter
+	concurrentLimiter atomic.Pointer[TokenLimiter]
 	clients           map[uint64]*clientConn
 	capability        uint32
 	dom               *domain.Domain
@@ -133,9 +135,14 @@ type Server struct {
 	statusServer   *http.Server
 	grpcServer     *grpc.Server
 	inShutdownMode bool
+	// shutdown is set once the server starts shutting down, the status
+	// service can't undrain it then.
+	shutdown atomic.Bool
 
 	sessionMapMutex  sync.Mutex
 	internalSessions map[interface{}]struct{}
+
+	userResources userResources
 }
 
 // ConnectionCount gets current connection count.
@@ -148,7 +155,7 @@ func (s *Server) ConnectionCount() int {
 
 func (s *Server) getToken() *Token {
 	start := time.Now()
-	tok := s.concurrentLimiter.Get()
+	tok := s.concurrentLimiter.Load().Get()
 	metrics.TokenGauge.Inc()
 	// Note that data smaller than one microsecond is ignored, because that case can be viewed as non-block.
 	metrics.GetTokenDurationHistogram.Observe(float64(time.Since(start).Nanoseconds() / 1e3))
@@ -156,10 +163,18 @@ func (s *Server) getToken() *Token {
 }
 
 func (s *Server) releaseToken(token *Token) {
-	s.concurrentLimiter.Put(token)
+	// the token is put back to the limiter it comes from, which may have been replaced by setTokenLimit.
+	token.tl.Put(token)
 	metrics.TokenGauge.Dec()
 }
 
+// setTokenLimit replaces the token limiter with a new one of the limit. The tokens
+// already taken are still limited by the old limiter until they are released.
+func (s *Server) setTokenLimit(limit uint) {
+	s.concurrentLimiter.Store(NewTokenLimiter(limit))
+	metrics.ConfigStatus.WithLabelValues("token-limit").Set(float64(limit))
+}
+
 // SetDomain use to set the server domain.
 func (s *Server) SetDomain(dom *domain.Domain) {
 	s.dom = dom
@@ -190,13 +205,13 @@ func (s *Server) newConn(conn net.Conn) *clientConn {
 // NewServer creates a new Server.
 func NewServer(cfg *config.Config, driver IDriver) (*Server, error) {
 	s := &Server{
-		cfg:               cfg,
-		driver:            driver,
-		concurrentLimiter: NewTokenLimiter(cfg.TokenLimit),
-		clients:           make(map[uint64]*clientConn),
-		globalConnID:      util.NewGlobalConnID(0, true),
-		internalSessions:  make(map[interface{}]struct{}, 100),
+		cfg:              cfg,
+		driver:           driver,
+		clients:          make(map[uint64]*clientConn),
+		globalConnID:     util.NewGlobalConnID(0, true),
+		internalSessions: make(map[interface{}]struct{}, 100),
 	}
+	s.concurrentLimiter.Store(NewTokenLimiter(cfg.TokenLimit))
 	s.capability = defaultCapability
 	setTxnScope()
 	setSystemTimeZoneVariable()
@@ -456,6 +471,7 @@ func (s *Server) startNetworkListener(listener net.Listener, isUnixSocket bool,
 }
 
 func (s *Server) startShutdown() {
+	s.shutdown.Store(true)
 	s.rwlock.RLock()
 	logutil.BgLogger().Info("setting tidb-server to report unhealthy (shutting-down)")
 	s.inShutdownMode = true
@@ -0,0 +1,275 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/config"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/versioninfo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// statusServiceName is the full name of the gRPC status service. All the methods
+// take a google.protobuf.Struct as the request and return a google.protobuf.Struct,
+// so that the control planes can call them without generated code.
+const statusServiceName = "tidb.StatusService"
+
+const defaultDrainTimeout = 30 * time.Second
+
+// statusServiceServer is the server API of the gRPC status service.
+type statusServiceServer interface {
+	// GetStatus returns the status of the server and the schema version.
+	GetStatus(ctx context.Context, req *structpb.Struct) (*structpb.Struct, error)
+	// GetRegionCacheStats returns whether the region cache is consistent with PD.
+	GetRegionCacheStats(ctx context.Context, req *structpb.Struct) (*structpb.Struct, error)
+	// ReloadConfig reloads the config file and applies the dynamic config items.
+	ReloadConfig(ctx context.Context, req *structpb.Struct) (*structpb.Struct, error)
+	// Drain reports the server unhealthy and closes the idle connections.
+	// Fields of the request: `timeout` (duration string) to wait for the connections to be closed.
+	Drain(ctx context.Context, req *structpb.Struct) (*structpb.Struct, error)
+	// Undrain reports the server healthy again after Drain. The connections
+	// closed by Drain are not restored.
+	Undrain(ctx context.Context, req *structpb.Struct) (*structpb.Struct, error)
+}
+
+var statusServiceDesc = grpc.ServiceDesc{
+	ServiceName: statusServiceName,
+	HandlerType: (*statusServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		statusServiceMethod("GetStatus", statusServiceServer.GetStatus),
+		statusServiceMethod("GetRegionCacheStats", statusServiceServer.GetRegionCacheStats),
+		statusServiceMethod("ReloadConfig", statusServiceServer.ReloadConfig),
+		statusServiceMethod("Drain", statusServiceServer.Drain),
+		statusServiceMethod("Undrain", statusServiceServer.Undrain),
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server/status_service.go",
+}
+
+func statusServiceMethod(name string, fn func(statusServiceServer, context.Context, *structpb.Struct) (*structpb.Struct, error)) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: name,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			in := new(structpb.Struct)
+			if err := dec(in); err != nil {
+				return nil, err
+			}
+			if interceptor == nil {
+				return fn(srv.(statusServiceServer), ctx, in)
+			}
+			info := &grpc.UnaryServerInfo{
+				Server:     srv,
+				FullMethod: "/" + statusServiceName + "/" + name,
+			}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return fn(srv.(statusServiceServer), ctx, req.(*structpb.Struct))
+			}
+			return interceptor(ctx, in, info, handler)
+		},
+	}
+}
+
+// registerStatusService registers the status service of the server to the gRPC server.
+func registerStatusService(s *grpc.Server, srv *Server) {
+	s.RegisterService(&statusServiceDesc, &statusService{srv: srv})
+}
+
+type statusService struct {
+	srv *Server
+
+	mu struct {
+		sync.Mutex
+		// draining is set by Drain and cleared by Undrain.
+		draining bool
+		// cancelDrain stops Drain from closing the idle connections.
+		cancelDrain context.CancelFunc
+	}
+}
+
+// checkAdmin allows the methods changing the state of the server only if the
+// status port authenticates the clients by their certificates, i.e. the
+// cluster TLS is enabled with cluster-verify-cn.
+func (s *statusService) checkAdmin() error {
+	sec := s.srv.cfg.Security
+	if sec.ClusterSSLCA == "" || len(sec.ClusterVerifyCN) == 0 {
+		return status.Error(codes.PermissionDenied, "the status port doesn't authenticate the clients, enable cluster-ssl-ca and cluster-verify-cn to use this method")
+	}
+	return nil
+}
+
+var _ statusServiceServer = &statusService{}
+
+// GetStatus implements the statusServiceServer interface.
+func (s *statusService) GetStatus(context.Context, *structpb.Struct) (*structpb.Struct, error) {
+	st := map[string]interface{}{
+		"version":  mysql.ServerVersion,
+		"git_hash": versioninfo.TiDBGitHash,
+		"draining": s.srv.inShutdownMode,
+	}
+	// ConnectionCount acquires the lock which may be held by the shutdown process.
+	if !s.srv.inShutdownMode {
+		st["connections"] = s.srv.ConnectionCount()
+	}
+	if dom := s.srv.dom; dom != nil {
+		st["server_id"] = dom.ServerID()
+		st["schema_version"] = dom.InfoSchema().SchemaMetaVersion()
+		if validator := dom.SchemaValidator; validator != nil {
+			_, expire := validator.LeaseInfo()
+			if !expire.IsZero() {
+				st["schema_lease_expire_at"] = expire.Format(time.RFC3339Nano)
+			}
+		}
+	}
+	return newStatusStruct(st)
+}
+
+// GetRegionCacheStats implements the statusServiceServer interface.
+func (s *statusService) GetRegionCacheStats(ctx context.Context, _ *structpb.Struct) (*structpb.Struct, error) {
+	if s.srv.dom == nil {
+		return nil, status.Error(codes.Unavailable, "domain is not initialized")
+	}
+	start := time.Now()
+	detail, err := s.srv.checkRegionCache(ctx)
+	st := map[string]interface{}{
+		"latency_ms": float64(time.Since(start).Microseconds()) / 1000,
+		"consistent": err == nil,
+	}
+	if m, ok := detail.(map[string]interface{}); ok {
+		for k, v := range m {
+			st[k] = v
+		}
+	}
+	if err != nil {
+		st["error"] = err.Error()
+	}
+	return newStatusStruct(st)
+}
+
+// ReloadConfig implements the statusServiceServer interface.
+func (s *statusService) ReloadConfig(context.Context, *structpb.Struct) (*structpb.Struct, error) {
+	if err := s.checkAdmin(); err != nil {
+		return nil, err
+	}
+	accepted, rejected, err := config.ReloadGlobalConfig(s.srv.reloadConfig)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	logutil.BgLogger().Info("config reloaded", zap.Strings("accepted", accepted), zap.Strings("rejected", rejected))
+	return newStatusStruct(map[string]interface{}{
+		"accepted": stringsToValues(accepted),
+		"rejected": stringsToValues(rejected),
+	})
+}
+
+// Drain implements the statusServiceServer interface.
+func (s *statusService) Drain(ctx context.Context, req *structpb.Struct) (*structpb.Struct, error) {
+	if err := s.checkAdmin(); err != nil {
+		return nil, err
+	}
+	timeout := defaultDrainTimeout
+	if val := req.GetFields()["timeout"].GetStringValue(); val != "" {
+		d, err := time.ParseDuration(val)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		timeout = d
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	s.mu.Lock()
+	s.mu.draining = true
+	if s.mu.cancelDrain != nil {
+		s.mu.cancelDrain()
+	}
+	s.mu.cancelDrain = cancel
+	s.srv.rwlock.RLock()
+	s.srv.inShutdownMode = true
+	s.srv.rwlock.RUnlock()
+	s.mu.Unlock()
+	logutil.BgLogger().Info("setting tidb-server to report unhealthy (draining)")
+
+	done := make(chan struct{})
+	go s.srv.GracefulDown(ctx, done)
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+	return newStatusStruct(map[string]interface{}{
+		"remaining_connections": s.srv.ConnectionCount(),
+	})
+}
+
+// Undrain implements the statusServiceServer interface.
+func (s *statusService) Undrain(context.Context, *structpb.Struct) (*structpb.Struct, error) {
+	if err := s.checkAdmin(); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.mu.draining {
+		return nil, status.Error(codes.FailedPrecondition, "the server is not drained")
+	}
+	if s.srv.shutdown.Load() {
+		return nil, status.Error(codes.FailedPrecondition, "the server is shutting down")
+	}
+	s.mu.draining = false
+	s.mu.cancelDrain()
+	s.mu.cancelDrain = nil
+	s.srv.rwlock.RLock()
+	s.srv.inShutdownMode = false
+	s.srv.rwlock.RUnlock()
+	logutil.BgLogger().Info("setting tidb-server to report healthy (undrained)")
+	return newStatusStruct(map[string]interface{}{
+		"connections": s.srv.ConnectionCount(),
+	})
+}
+
+// reloadConfig makes the changed dynamic config items take effect.
+func (s *Server) reloadConfig(oldConf, newConf *config.Config) {
+	if oldConf.Log.Level != newConf.Log.Level {
+		if err := logutil.SetLevel(newConf.Log.Level); err != nil {
+			logutil.BgLogger().Warn("failed to set log level", zap.Error(err))
+		}
+	}
+	if oldConf.TokenLimit != newConf.TokenLimit {
+		s.setTokenLimit(newConf.TokenLimit)
+	}
+}
+
+func newStatusStruct(m map[string]interface{}) (*structpb.Struct, error) {
+	st, err := structpb.NewStruct(m)
+	if err != nil {
+		return nil, status.Error(codes.Internal, errors.Trace(err).Error())
+	}
+	return st, nil
+}
+
+func stringsToValues(strs []string) []interface{} {
+	vals := make([]interface{}, 0, len(strs))
+	for _, s := range strs {
+		vals = append(vals, s)
+	}
+	return vals
+}
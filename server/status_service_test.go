@@ -0,0 +1,103 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/pingcap/tidb/config"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestStatusService(t *testing.T) {
+	// the methods changing the state of the server need the clients to be
+	// authenticated by the status port.
+	svc := &statusService{srv: &Server{cfg: config.NewConfig()}}
+	_, err := svc.ReloadConfig(context.Background(), nil)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = svc.Drain(context.Background(), nil)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	cfg := config.NewConfig()
+	cfg.Security.ClusterSSLCA = "ca.pem"
+	cfg.Security.ClusterVerifyCN = []string{"tidb"}
+	srv := &Server{cfg: cfg, clients: make(map[uint64]*clientConn)}
+	grpcServer := grpc.NewServer()
+	registerStatusService(grpcServer, srv)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+	defer grpcServer.Stop()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, conn.Close())
+	}()
+	ctx := context.Background()
+	invoke := func(method string, req map[string]interface{}) (*structpb.Struct, error) {
+		in, err := structpb.NewStruct(req)
+		require.NoError(t, err)
+		out := new(structpb.Struct)
+		err = conn.Invoke(ctx, "/"+statusServiceName+"/"+method, in, out)
+		return out, err
+	}
+
+	out, err := invoke("GetStatus", nil)
+	require.NoError(t, err)
+	require.Equal(t, mysql.ServerVersion, out.Fields["version"].GetStringValue())
+	require.False(t, out.Fields["draining"].GetBoolValue())
+	require.Equal(t, float64(0), out.Fields["connections"].GetNumberValue())
+
+	_, err = invoke("GetRegionCacheStats", nil)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	_, err = invoke("Undrain", nil)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = invoke("Drain", map[string]interface{}{"timeout": "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	out, err = invoke("Drain", map[string]interface{}{"timeout": "1s"})
+	require.NoError(t, err)
+	require.Equal(t, float64(0), out.Fields["remaining_connections"].GetNumberValue())
+
+	out, err = invoke("GetStatus", nil)
+	require.NoError(t, err)
+	require.True(t, out.Fields["draining"].GetBoolValue())
+
+	_, err = invoke("Undrain", nil)
+	require.NoError(t, err)
+	out, err = invoke("GetStatus", nil)
+	require.NoError(t, err)
+	require.False(t, out.Fields["draining"].GetBoolValue())
+	_, err = invoke("Undrain", nil)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// the server shutting down can't be undrained.
+	_, err = invoke("Drain", map[string]interface{}{"timeout": "1s"})
+	require.NoError(t, err)
+	srv.shutdown.Store(true)
+	_, err = invoke("Undrain", nil)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
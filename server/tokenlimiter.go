@@ -16,6 +16,8 @@ package server
 
 // Token is used as a permission to keep on running.
 type Token struct {
+	// tl is the limiter which the token belongs to.
+	tl *TokenLimiter
 }
 
 // TokenLimiter is used to limit the number of concurrent tasks.
@@ -38,7 +40,7 @@ func (tl *TokenLimiter) Get() *Token {
 func NewTokenLimiter(count uint) *TokenLimiter {
 	tl := &TokenLimiter{count: count, ch: make(chan *Token, count)}
 	for i := uint(0); i < count; i++ {
-		tl.ch <- &Token{}
+		tl.ch <- &Token{tl: tl}
 	}
 
 	return tl
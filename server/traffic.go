@@ -0,0 +1,190 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/binary"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/parser/mysql"
+	"github.com/pingcap/tidb/util/hack"
+	"github.com/pingcap/tidb/util/traffic"
+)
+
+// newTrafficRecord creates the record of a command, it returns nil if the command is not captured.
+func (cc *clientConn) newTrafficRecord(capturer *traffic.Capturer, cmd byte, data []byte, startTime time.Time) *traffic.Record {
+	rec := &traffic.Record{
+		ConnID:    cc.connectionID,
+		StartTime: startTime,
+	}
+	vars := cc.ctx.GetSessionVars()
+	rec.DB = vars.CurrentDB
+	rec.Redact = vars.EnableRedactLog
+	switch cmd {
+	case mysql.ComQuery:
+		rec.Kind = traffic.KindQuery
+		if len(data) > 0 && data[len(data)-1] == 0 {
+			data = data[:len(data)-1]
+		}
+		rec.SQL = string(data)
+	case mysql.ComInitDB:
+		rec.Kind = traffic.KindInitDB
+		rec.DB = string(data)
+	case mysql.ComStmtPrepare:
+		rec.Kind = traffic.KindPrepare
+		rec.SQL = string(data)
+	case mysql.ComStmtExecute, mysql.ComStmtClose:
+		if len(data) < 4 {
+			return nil
+		}
+		rec.Kind = traffic.KindClose
+		rec.StmtID = binary.LittleEndian.Uint32(data[0:4])
+		if cmd == mysql.ComStmtExecute {
+			rec.Kind = traffic.KindExecute
+			rec.SQL = cc.preparedStmt2StringNoArgs(rec.StmtID)
+		}
+	case mysql.ComQuit:
+		rec.Kind = traffic.KindQuit
+	default:
+		return nil
+	}
+	if names := capturer.Vars(); len(names) > 0 {
+		rec.Vars = make(map[string]string, len(names))
+		for _, name := range names {
+			if val, err := vars.GetSessionOrGlobalSystemVar(name); err == nil {
+				rec.Vars[name] = val
+			}
+		}
+	}
+	return rec
+}
+
+// finishTrafficRecord fills the execution result of the dispatching command and sends it to the capturer.
+func (cc *clientConn) finishTrafficRecord(capturer *traffic.Capturer, err error) {
+	rec := cc.trafficRecord
+	cc.trafficRecord = nil
+	if rec == nil {
+		return
+	}
+	rec.Duration = time.Since(rec.StartTime)
+	if err != nil && err != io.EOF {
+		rec.Err = err.Error()
+	}
+	if rec.Kind == traffic.KindExecute {
+		if ctx := cc.getCtx(); ctx != nil {
+			params := ctx.GetSessionVars().PreparedParams
+			rec.Params = make([]traffic.Param, 0, len(params))
+			for i := range params {
+				if params[i].IsNull() {
+					rec.Params = append(rec.Params, traffic.Param{Null: true})
+					continue
+				}
+				val, err := params[i].ToString()
+				if err != nil {
+					val = string(hack.String(params[i].GetBytes()))
+				}
+				rec.Params = append(rec.Params, traffic.Param{Value: val})
+			}
+		}
+	}
+	capturer.Capture(rec)
+}
+
+// trafficHandler is the handler for starting and stopping traffic capture.
+type trafficHandler struct{}
+
+type trafficCaptureStatus struct {
+	Running bool                  `json:"running"`
+	Stats   *traffic.CaptureStats `json:"stats,omitempty"`
+}
+
+// ServeHTTP handles request of traffic capture.
+//
+//	GET  /traffic/capture                                       show the capture status
+//	POST /traffic/capture?action=start&output={storage-url}     start capturing
+//	     [&duration=1h][&file-size=268435456][&vars=sql_mode,time_zone]
+//	POST /traffic/capture?action=stop                           stop capturing
+func (trafficHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		status := trafficCaptureStatus{}
+		if capturer := traffic.GlobalCapturer(); capturer != nil {
+			stats := capturer.Stats()
+			status.Running, status.Stats = true, &stats
+		}
+		writeData(w, status)
+	case http.MethodPost:
+		switch action := req.FormValue("action"); action {
+		case "start":
+			cfg, err := parseTrafficCaptureConfig(req)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			if err := traffic.StartCapture(cfg); err != nil {
+				writeError(w, err)
+				return
+			}
+			writeData(w, "success!")
+		case "stop":
+			stats, err := traffic.StopCapture()
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			writeData(w, trafficCaptureStatus{Stats: &stats})
+		default:
+			writeError(w, errors.Errorf("unknown action %q", action))
+		}
+	default:
+		writeError(w, errors.Errorf("This api only support GET and POST method"))
+	}
+}
+
+func parseTrafficCaptureConfig(req *http.Request) (traffic.CaptureConfig, error) {
+	cfg := traffic.CaptureConfig{}
+	output := req.FormValue("output")
+	if output == "" {
+		return cfg, errors.New("output is not specified")
+	}
+	backend, err := storage.ParseBackend(output, nil)
+	if err != nil {
+		return cfg, err
+	}
+	cfg.Storage, err = storage.New(req.Context(), backend, &storage.ExternalStorageOptions{})
+	if err != nil {
+		return cfg, err
+	}
+	if val := req.FormValue("duration"); val != "" {
+		if cfg.Duration, err = time.ParseDuration(val); err != nil {
+			return cfg, errors.Trace(err)
+		}
+	}
+	if val := req.FormValue("file-size"); val != "" {
+		if cfg.FileSize, err = strconv.ParseInt(val, 10, 64); err != nil {
+			return cfg, errors.Trace(err)
+		}
+	}
+	if val := req.FormValue("vars"); val != "" {
+		cfg.Vars = strings.Split(val, ",")
+	}
+	return cfg, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb/parser/auth"
+)
+
+// userResources tracks the connections and queries of the accounts, to enforce the
+// MAX_USER_CONNECTIONS and MAX_QUERIES_PER_HOUR limits. The limits are enforced
+// per TiDB instance. The zero value is ready to use.
+type userResources struct {
+	mu sync.Mutex
+	// connAccounts maps the connection ID to the account it is counted for.
+	connAccounts map[uint64]string
+	conns        map[string]uint64
+	queries      map[string]*hourlyQueries
+}
+
+type hourlyQueries struct {
+	start time.Time
+	count uint64
+}
+
+func accountName(user *auth.UserIdentity) string {
+	return user.AuthUsername + "@" + user.AuthHostname
+}
+
+// acquireConn counts the connection for the account. It fails if the account
+// already has maxConns connections, 0 means no limit.
+func (r *userResources) acquireConn(connID uint64, account string, maxConns uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.releaseConnLocked(connID)
+	if maxConns > 0 && r.conns[account] >= maxConns {
+		return errTooManyUserConnections.FastGenByArgs(account)
+	}
+	if r.connAccounts == nil {
+		r.connAccounts = make(map[uint64]string)
+		r.conns = make(map[string]uint64)
+	}
+	r.connAccounts[connID] = account
+	r.conns[account]++
+	return nil
+}
+
+// releaseConn stops counting the connection, it's safe to call it many times.
+func (r *userResources) releaseConn(connID uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.releaseConnLocked(connID)
+}
+
+func (r *userResources) releaseConnLocked(connID uint64) {
+	account, ok := r.connAccounts[connID]
+	if !ok {
+		return
+	}
+	delete(r.connAccounts, connID)
+	if r.conns[account] <= 1 {
+		delete(r.conns, account)
+	} else {
+		r.conns[account]--
+	}
+}
+
+// useQuery counts a query for the account. It fails if the account has issued
+// maxQueries queries in the current hour.
+func (r *userResources) useQuery(account string, maxQueries uint64, now time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.queries == nil {
+		r.queries = make(map[string]*hourlyQueries)
+	}
+	q, ok := r.queries[account]
+	if !ok || now.Sub(q.start) >= time.Hour {
+		q = &hourlyQueries{start: now}
+		r.queries[account] = q
+	}
+	if q.count >= maxQueries {
+		return errUserLimitReached.FastGenByArgs(account, "max_questions", maxQueries)
+	}
+	q.count++
+	return nil
+}
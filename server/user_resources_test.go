@@ -0,0 +1,66 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/parser/auth"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserConnectionLimit(t *testing.T) {
+	var r userResources
+	account := accountName(&auth.UserIdentity{Username: "u1", Hostname: "127.0.0.1", AuthUsername: "u1", AuthHostname: "%"})
+	require.Equal(t, "u1@%", account)
+
+	require.NoError(t, r.acquireConn(1, account, 2))
+	require.NoError(t, r.acquireConn(2, account, 2))
+	require.True(t, errTooManyUserConnections.Equal(r.acquireConn(3, account, 2)))
+	// Other accounts and accounts without limit are not affected.
+	require.NoError(t, r.acquireConn(3, "u2@%", 1))
+	require.NoError(t, r.acquireConn(4, "u3@%", 0))
+
+	// Releasing a connection many times only counts once.
+	r.releaseConn(1)
+	r.releaseConn(1)
+	require.NoError(t, r.acquireConn(5, account, 2))
+	require.Error(t, r.acquireConn(6, account, 2))
+
+	// Re-acquiring a connection (e.g. COM_CHANGE_USER) releases the old account.
+	require.NoError(t, r.acquireConn(2, "u2@localhost", 0))
+	require.NoError(t, r.acquireConn(6, account, 2))
+
+	for _, connID := range []uint64{2, 3, 4, 5, 6} {
+		r.releaseConn(connID)
+	}
+	require.Empty(t, r.conns)
+	require.Empty(t, r.connAccounts)
+}
+
+func TestUserQueryLimit(t *testing.T) {
+	var r userResources
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, r.useQuery("u1@%", 3, now))
+	}
+	err := r.useQuery("u1@%", 3, now.Add(time.Minute))
+	require.True(t, errUserLimitReached.Equal(err))
+	require.NoError(t, r.useQuery("u2@%", 3, now))
+
+	// The counter is reset after an hour.
+	require.NoError(t, r.useQuery("u1@%", 3, now.Add(time.Hour)))
+}
@@ -1051,3 +1051,40 @@ func TestTiDBEnablePagingVariable(t *testing.T) {
 		r.Close()
 	}
 }
+
+func TestUpgradeToVer94(t *testing.T) {
+	ctx := context.Background()
+	store, dom := createStoreAndBootstrap(t)
+	defer func() { require.NoError(t, store.Close()) }()
+	defer dom.Close()
+	se := createSessionAndSetID(t, store)
+	mustExec(t, se, "alter table mysql.user drop column max_user_connections")
+	mustExec(t, se, "alter table mysql.user drop column max_questions")
+	upgradeToVer94(se, version93)
+
+	r := mustExec(t, se, `select max_questions, max_user_connections from mysql.user where user = 'root'`)
+	req := r.NewChunk(nil)
+	require.NoError(t, r.Next(ctx, req))
+	require.Equal(t, 1, req.NumRows())
+	row := req.GetRow(0)
+	require.Equal(t, uint64(0), row.GetUint64(0))
+	require.Equal(t, uint64(0), row.GetUint64(1))
+	require.NoError(t, r.Close())
+}
+
+func TestUpgradeToVer95(t *testing.T) {
+	ctx := context.Background()
+	store, dom := createStoreAndBootstrap(t)
+	defer func() { require.NoError(t, store.Close()) }()
+	defer dom.Close()
+	se := createSessionAndSetID(t, store)
+	mustExec(t, se, "drop table mysql.tidb_br_progress")
+	upgradeToVer95(se, version94)
+
+	mustExec(t, se, "insert into mysql.tidb_br_progress (task_id, name, current, total, percent, status, eta_seconds) values ('t', '', 1, 2, 50, 'running', -1)")
+	r := mustExec(t, se, `select count(*) from mysql.tidb_br_progress`)
+	req := r.NewChunk(nil)
+	require.NoError(t, r.Next(ctx, req))
+	require.Equal(t, int64(1), req.GetRow(0).GetInt64(0))
+	require.NoError(t, r.Close())
+}
@@ -280,6 +280,9 @@ func autoCommitAfterStmt(ctx context.Context, se *session, meetsErr error, sql s
 	}
 
 	if !sessVars.InTxn() {
+		// The statement is finished, avoid interrupting the commit by max_execution_time,
+		// the failed statement is rolled back above instead.
+		sessVars.StmtCtx.InCommitPhase.Store(true)
 		if err := se.CommitTxn(ctx); err != nil {
 			if _, ok := sql.(*executor.ExecStmt).StmtNode.(*ast.CommitStmt); ok {
 				err = errors.Annotatef(err, "previous statement: %s", se.GetSessionVars().PrevStmt)
@@ -15,6 +15,7 @@
 package sessionstates
 
 import (
+	"bytes"
 	"crypto"
 	"crypto/ecdsa"
 	"crypto/ed25519"
@@ -63,27 +64,82 @@ const (
 	// - server B reloads the same new cert again at 00:10:01, and it has 3 certs now.
 	// - server B receives the token at 00:10:02, so the old cert should be valid for more than 10m after replacement.
 	oldCertValidTime = 15 * time.Minute
+	// tokenVersionStates is the version since which the token can be bound to the session states.
+	// The tokens signed by the older servers have no version.
+	tokenVersionStates = 2
 )
 
 // SessionToken represents the token used to authenticate with the new server.
+//
+// The servers may be of different versions during a rolling upgrade, so Signature only covers the fields that
+// all versions know, and the older servers ignore the other fields. The fields added since tokenVersionStates
+// are covered by StatesSignature, which is only signed and verified by the servers that know them.
 type SessionToken struct {
 	Username   string    `json:"username"`
 	SignTime   time.Time `json:"sign-time"`
 	ExpireTime time.Time `json:"expire-time"`
-	Signature  []byte    `json:"signature,omitempty"`
+	// Version is the version of the token, it's 0 if the token is signed by an older server.
+	Version int `json:"version,omitempty"`
+	// StatesDigest is the digest of the session states queried together with the token.
+	// It binds the token to the states so that the states can't be tampered during migration.
+	StatesDigest []byte `json:"states-digest,omitempty"`
+	// StatesSignature is the signature of the whole token, including Version and StatesDigest.
+	StatesSignature []byte `json:"states-signature,omitempty"`
+	Signature       []byte `json:"signature,omitempty"`
+}
+
+// baseToken contains the fields of SessionToken known by all versions, it's what Signature covers.
+type baseToken struct {
+	Username   string    `json:"username"`
+	SignTime   time.Time `json:"sign-time"`
+	ExpireTime time.Time `json:"expire-time"`
+}
+
+// baseContent returns the content covered by Signature.
+func (token *SessionToken) baseContent() ([]byte, error) {
+	content, err := json.Marshal(&baseToken{
+		Username:   token.Username,
+		SignTime:   token.SignTime,
+		ExpireTime: token.ExpireTime,
+	})
+	return content, errors.Trace(err)
+}
+
+// statesContent returns the content covered by StatesSignature.
+func (token *SessionToken) statesContent() ([]byte, error) {
+	t := *token
+	t.StatesSignature, t.Signature = nil, nil
+	content, err := json.Marshal(&t)
+	return content, errors.Trace(err)
 }
 
 // CreateSessionToken creates a token for the proxy.
 func CreateSessionToken(username string) (*SessionToken, error) {
+	return CreateSessionTokenWithStates(username, nil)
+}
+
+// CreateSessionTokenWithStates creates a token for the proxy and binds it to the encoded session states.
+func CreateSessionTokenWithStates(username string, states []byte) (*SessionToken, error) {
 	now := getNow()
 	token := &SessionToken{
 		Username:   username,
 		SignTime:   now,
 		ExpireTime: now.Add(tokenLifetime),
 	}
-	tokenBytes, err := json.Marshal(token)
+	if states != nil {
+		token.Version = tokenVersionStates
+		token.StatesDigest = StatesDigest(states)
+		statesBytes, err := token.statesContent()
+		if err != nil {
+			return nil, err
+		}
+		if token.StatesSignature, err = globalSigningCert.sign(statesBytes); err != nil {
+			return nil, ErrCannotMigrateSession.GenWithStackByArgs(err.Error())
+		}
+	}
+	tokenBytes, err := token.baseContent()
 	if err != nil {
-		return nil, errors.Trace(err)
+		return nil, err
 	}
 	if token.Signature, err = globalSigningCert.sign(tokenBytes); err != nil {
 		return nil, ErrCannotMigrateSession.GenWithStackByArgs(err.Error())
@@ -92,20 +148,57 @@ func CreateSessionToken(username string) (*SessionToken, error) {
 }
 
 // ValidateSessionToken validates the token sent from the proxy.
-func ValidateSessionToken(tokenBytes []byte, username string) (err error) {
+func ValidateSessionToken(tokenBytes []byte, username string) error {
+	_, err := ParseSessionToken(tokenBytes, username)
+	return err
+}
+
+// ParseSessionToken validates the token sent from the proxy and returns the parsed token.
+func ParseSessionToken(tokenBytes []byte, username string) (*SessionToken, error) {
 	var token SessionToken
-	if err = json.Unmarshal(tokenBytes, &token); err != nil {
-		return errors.Trace(err)
+	if err := token.validate(tokenBytes, username); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// CheckStates checks whether the encoded session states are the ones bound to the token.
+// The token signed by an older server or without states isn't bound to any states.
+func (token *SessionToken) CheckStates(states []byte) error {
+	if token.Version < tokenVersionStates || len(token.StatesDigest) == 0 {
+		return nil
 	}
-	signature := token.Signature
-	// Clear the signature and marshal it again to get the original content.
-	token.Signature = nil
-	if tokenBytes, err = json.Marshal(token); err != nil {
+	if !bytes.Equal(token.StatesDigest, StatesDigest(states)) {
+		return ErrCannotMigrateSession.GenWithStackByArgs("session states do not match the session token")
+	}
+	return nil
+}
+
+// StatesDigest returns the digest of the encoded session states.
+func StatesDigest(states []byte) []byte {
+	digest := sha256.Sum256(states)
+	return digest[:]
+}
+
+func (token *SessionToken) validate(tokenBytes []byte, username string) (err error) {
+	if err = json.Unmarshal(tokenBytes, token); err != nil {
 		return errors.Trace(err)
 	}
-	if err = globalSigningCert.checkSignature(tokenBytes, signature); err != nil {
+	// Marshal the fields again to get the original content.
+	if tokenBytes, err = token.baseContent(); err != nil {
+		return err
+	}
+	if err = globalSigningCert.checkSignature(tokenBytes, token.Signature); err != nil {
 		return ErrCannotMigrateSession.GenWithStackByArgs(err.Error())
 	}
+	if token.Version >= tokenVersionStates {
+		if tokenBytes, err = token.statesContent(); err != nil {
+			return err
+		}
+		if err = globalSigningCert.checkSignature(tokenBytes, token.StatesSignature); err != nil {
+			return ErrCannotMigrateSession.GenWithStackByArgs(err.Error())
+		}
+	}
 	now := getNow()
 	if now.After(token.ExpireTime) {
 		return ErrCannotMigrateSession.GenWithStackByArgs("token expired", token.ExpireTime.String())
@@ -163,6 +163,78 @@ func TestVerifyToken(t *testing.T) {
 	require.ErrorContains(t, err, "verification error")
 }
 
+func TestTokenWithStates(t *testing.T) {
+	tempDir := t.TempDir()
+	certPath := filepath.Join(tempDir, "test1_cert.pem")
+	keyPath := filepath.Join(tempDir, "test1_key.pem")
+	createRSACert(t, certPath, keyPath)
+	SetKeyPath(keyPath)
+	SetCertPath(certPath)
+
+	states := []byte(`{"current-db": "test"}`)
+	token, err := CreateSessionTokenWithStates("test_user", states)
+	require.NoError(t, err)
+	tokenBytes, err := json.Marshal(token)
+	require.NoError(t, err)
+	parsed, err := ParseSessionToken(tokenBytes, "test_user")
+	require.NoError(t, err)
+	require.Equal(t, token.Signature, parsed.Signature)
+	require.NoError(t, parsed.CheckStates(states))
+	err = parsed.CheckStates([]byte(`{"current-db": "mysql"}`))
+	require.ErrorContains(t, err, "session states do not match")
+	// forge the states digest
+	token.StatesDigest = StatesDigest([]byte(`{"current-db": "mysql"}`))
+	tokenBytes, err = json.Marshal(token)
+	require.NoError(t, err)
+	_, err = ParseSessionToken(tokenBytes, "test_user")
+	require.ErrorContains(t, err, "verification error")
+	// the token without states can be used with any states
+	_, tokenBytes = createNewToken(t, "test_user")
+	parsed, err = ParseSessionToken(tokenBytes, "test_user")
+	require.NoError(t, err)
+	require.NoError(t, parsed.CheckStates(states))
+}
+
+func TestTokenCompatibility(t *testing.T) {
+	tempDir := t.TempDir()
+	certPath := filepath.Join(tempDir, "test1_cert.pem")
+	keyPath := filepath.Join(tempDir, "test1_key.pem")
+	createRSACert(t, certPath, keyPath)
+	SetKeyPath(keyPath)
+	SetCertPath(certPath)
+
+	// an older server verifies the token bound to the states by the fields it knows.
+	token, err := CreateSessionTokenWithStates("test_user", []byte(`{"current-db": "test"}`))
+	require.NoError(t, err)
+	tokenBytes, err := json.Marshal(token)
+	require.NoError(t, err)
+	var oldToken struct {
+		baseToken
+		Signature []byte `json:"signature,omitempty"`
+	}
+	require.NoError(t, json.Unmarshal(tokenBytes, &oldToken))
+	content, err := json.Marshal(&oldToken)
+	require.NoError(t, err)
+	require.NoError(t, globalSigningCert.checkSignature(content, oldToken.Signature))
+
+	// a newer server verifies the token signed by an older server.
+	oldToken.Signature, err = globalSigningCert.sign(content)
+	require.NoError(t, err)
+	tokenBytes, err = json.Marshal(&oldToken)
+	require.NoError(t, err)
+	parsed, err := ParseSessionToken(tokenBytes, "test_user")
+	require.NoError(t, err)
+	require.Equal(t, 0, parsed.Version)
+	require.NoError(t, parsed.CheckStates([]byte(`{"current-db": "mysql"}`)))
+
+	// the states signature is required by the version.
+	token.StatesSignature = nil
+	tokenBytes, err = json.Marshal(token)
+	require.NoError(t, err)
+	_, err = ParseSessionToken(tokenBytes, "test_user")
+	require.ErrorContains(t, err, "verification error")
+}
+
 func TestCertExpire(t *testing.T) {
 	tempDir := t.TempDir()
 	certPath := filepath.Join(tempDir, "test1_cert.pem")
@@ -312,6 +312,10 @@ type StatementContext struct {
 	// IsSQLAndPlanRegistered uses to indicate whether the SQL and plan has been registered for TopSQL.
 	IsSQLAndPlanRegistered atomic2.Bool
 
+	// InCommitPhase indicates the transaction is being committed after the statement is executed, the
+	// statement can't be interrupted by max_execution_time, otherwise the commit result may be undetermined.
+	InCommitPhase atomic2.Bool
+
 	// StatsLoadStatus records StatsLoadedStatus for the index/column which is used in query
 	StatsLoadStatus map[model.TableItemID]string
 	// IsSyncStatsFailed indicates whether any failure happened during sync stats
@@ -361,6 +365,8 @@ const (
 	StmtNowTsCacheKey StmtCacheKey = iota
 	// StmtSafeTSCacheKey is a variable for safeTS calculation/cache of one stmt.
 	StmtSafeTSCacheKey
+	// StmtExternalTSCacheKey is a variable for externalTS calculation/cache of one stmt.
+	StmtExternalTSCacheKey
 )
 
 // GetOrStoreStmtCache gets the cached value of the given key if it exists, otherwise stores the value.
@@ -376,6 +382,14 @@ func (sc *StatementContext) GetOrStoreStmtCache(key StmtCacheKey, value interfac
 	return sc.stmtCache.data[key]
 }
 
+// GetStmtCache gets the cached value of the given key if it exists.
+func (sc *StatementContext) GetStmtCache(key StmtCacheKey) (interface{}, bool) {
+	sc.stmtCache.mu.Lock()
+	defer sc.stmtCache.mu.Unlock()
+	value, ok := sc.stmtCache.data[key]
+	return value, ok
+}
+
 // ResetInStmtCache resets the cache of given key.
 func (sc *StatementContext) ResetInStmtCache(key StmtCacheKey) {
 	sc.stmtCache.mu.Lock()
@@ -937,11 +937,15 @@ type SessionVars struct {
 	// LowResolutionTSO is used for reading data with low resolution TSO which is updated once every two seconds.
 	LowResolutionTSO bool
 
-	// MaxExecutionTime is the timeout for select statement, in milliseconds.
+	// MaxExecutionTime is the timeout for select and DML statements, in milliseconds.
 	// If the value is 0, timeouts are not enabled.
 	// See https://dev.mysql.com/doc/refman/5.7/en/server-system-variables.html#sysvar_max_execution_time
 	MaxExecutionTime uint64
 
+	// DDLMaxWaitTime is the timeout for DDL statements to wait for their DDL jobs, in milliseconds.
+	// If the value is 0, timeouts are not enabled.
+	DDLMaxWaitTime uint64
+
 	// Killed is a flag to indicate that this query is killed.
 	Killed uint32
 
@@ -1077,6 +1081,10 @@ type SessionVars struct {
 	// LastDDLInfo keeps track the info of last DDL.
 	LastDDLInfo sessionstates.LastDDLInfo
 
+	// MigrationToken is the session token used to authenticate this session when it's migrated from
+	// another server. The session states set by `SET SESSION_STATES` must match the token.
+	MigrationToken *sessionstates.SessionToken
+
 	// PartitionPruneMode indicates how and when to prune partitions.
 	PartitionPruneMode atomic2.String
 
@@ -1143,6 +1151,9 @@ type SessionVars struct {
 	// ReadStaleness indicates the staleness duration for the following query
 	ReadStaleness time.Duration
 
+	// EnableExternalTSRead indicates whether the autocommit read statements read the data at tidb_external_ts.
+	EnableExternalTSRead bool
+
 	// cached is used to optimze the object allocation.
 	cached struct {
 		curr int8
@@ -15,6 +15,7 @@
 package variable
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -40,6 +41,7 @@ import (
 	"github.com/pingcap/tidb/util/mathutil"
 	"github.com/pingcap/tidb/util/memory"
 	"github.com/pingcap/tidb/util/stmtsummary"
+	"github.com/pingcap/tidb/util/tablemetrics"
 	"github.com/pingcap/tidb/util/tikvutil"
 	"github.com/pingcap/tidb/util/tls"
 	topsqlstate "github.com/pingcap/tidb/util/topsql/state"
@@ -47,6 +49,7 @@ import (
 	tikvcfg "github.com/tikv/client-go/v2/config"
 	tikvstore "github.com/tikv/client-go/v2/kv"
 	atomic2 "go.uber.org/atomic"
+	"golang.org/x/exp/slices"
 )
 
 // All system variables declared here are ordered by their scopes, which follow the order of scopes below:
@@ -139,6 +142,10 @@ var defaultSysVars = []*SysVar{
 	{Scope: ScopeSession, Name: TiDBReadStaleness, Value: strconv.Itoa(DefTiDBReadStaleness), Type: TypeInt, MinValue: math.MinInt32, MaxValue: 0, AllowEmpty: true, Hidden: false, SetSession: func(s *SessionVars, val string) error {
 		return setReadStaleness(s, val)
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBEnableExternalTSRead, Value: BoolToOnOff(DefTiDBEnableExternalTSRead), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnableExternalTSRead = TiDBOptOn(val)
+		return nil
+	}},
 	{Scope: ScopeSession, Name: TiDBEnforceMPPExecution, Type: TypeBool, Value: BoolToOnOff(config.GetGlobalConfig().Performance.EnforceMPP), Validation: func(vars *SessionVars, normalizedValue string, originalValue string, scope ScopeFlag) (string, error) {
 		if TiDBOptOn(normalizedValue) && !vars.allowMPPExecution {
 			return normalizedValue, ErrWrongValueForVar.GenWithStackByArgs("tidb_enforce_mpp", "1' but tidb_allow_mpp is 0, please activate tidb_allow_mpp at first.")
@@ -597,6 +604,27 @@ var defaultSysVars = []*SysVar{
 		return nil
 	}},
 	{Scope: ScopeGlobal, Name: TiDBEnableTelemetry, Value: BoolToOnOff(DefTiDBEnableTelemetry), Type: TypeBool},
+	{Scope: ScopeGlobal, Name: TiDBTelemetryCategories, Value: DefTiDBTelemetryCategories, Type: TypeStr, Validation: func(vars *SessionVars, normalizedValue string, originalValue string, scope ScopeFlag) (string, error) {
+		enabled := make(map[string]struct{})
+		for _, category := range strings.Split(normalizedValue, ",") {
+			category = strings.ToLower(strings.TrimSpace(category))
+			if category == "" {
+				continue
+			}
+			if !slices.Contains(TelemetryCategories, category) {
+				return normalizedValue, ErrWrongValueForVar.GenWithStackByArgs(TiDBTelemetryCategories, originalValue)
+			}
+			enabled[category] = struct{}{}
+		}
+		// the categories are formatted in the order of TelemetryCategories.
+		categories := make([]string, 0, len(enabled))
+		for _, category := range TelemetryCategories {
+			if _, ok := enabled[category]; ok {
+				categories = append(categories, category)
+			}
+		}
+		return strings.Join(categories, ","), nil
+	}},
 	{Scope: ScopeGlobal, Name: TiDBEnableHistoricalStats, Value: Off, Type: TypeBool},
 	/* tikv gc metrics */
 	{Scope: ScopeGlobal, Name: TiDBGCEnable, Value: On, Type: TypeBool, GetGlobal: func(s *SessionVars) (string, error) {
@@ -1737,6 +1765,51 @@ var defaultSysVars = []*SysVar{
 			metrics.ToggleSimplifiedMode(TiDBOptOn(s))
 			return nil
 		}},
+	{Scope: ScopeGlobal, Name: TiDBEnableTableMetrics, Value: BoolToOnOff(tablemetrics.DefTiDBEnableTableMetrics), Type: TypeBool, GetGlobal: func(s *SessionVars) (string, error) {
+		return BoolToOnOff(tablemetrics.Enabled()), nil
+	}, SetGlobal: func(vars *SessionVars, s string) error {
+		if TiDBOptOn(s) {
+			tablemetrics.Enable()
+		} else {
+			tablemetrics.Disable()
+		}
+		return nil
+	}},
+	{Scope: ScopeGlobal, Name: TiDBExternalTS, Value: strconv.Itoa(DefTiDBExternalTS), Validation: func(vars *SessionVars, normalizedValue string, originalValue string, scope ScopeFlag) (string, error) {
+		ts, err := parseTSFromNumberOrTime(vars, normalizedValue)
+		if err != nil {
+			return normalizedValue, ErrWrongValueForVar.GenWithStackByArgs(TiDBExternalTS, originalValue)
+		}
+		return strconv.FormatUint(ts, 10), nil
+	}, GetGlobal: func(s *SessionVars) (string, error) {
+		if GetExternalTimestamp == nil {
+			return strconv.Itoa(DefTiDBExternalTS), nil
+		}
+		ts, err := GetExternalTimestamp(context.Background())
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatUint(ts, 10), nil
+	}, SetGlobal: func(vars *SessionVars, s string) error {
+		ts, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		if SetExternalTimestamp == nil {
+			return errors.New("the external timestamp can't be set before the domain is initialized")
+		}
+		return SetExternalTimestamp(context.Background(), ts)
+	}},
+	{Scope: ScopeGlobal, Name: TiDBTableMetricsTopK, Value: strconv.Itoa(tablemetrics.DefTiDBTableMetricsTopK), Type: TypeInt, MinValue: 1, MaxValue: 1000, GetGlobal: func(s *SessionVars) (string, error) {
+		return strconv.Itoa(tablemetrics.TopK()), nil
+	}, SetGlobal: func(vars *SessionVars, s string) error {
+		val, err := strconv.Atoi(s)
+		if err != nil {
+			return err
+		}
+		tablemetrics.SetTopK(val)
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBMinPagingSize, Value: strconv.Itoa(DefMinPagingSize), Type: TypeUnsigned, MinValue: 1, MaxValue: math.MaxInt64, SetSession: func(s *SessionVars, val string) error {
 		s.MinPagingSize = tidbOptPositiveInt32(val, DefMinPagingSize)
 		return nil
@@ -1795,6 +1868,10 @@ var defaultSysVars = []*SysVar{
 		s.EnableTiFlashReadForWriteStmt = TiDBOptOn(val)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBDDLMaxWaitTime, Value: strconv.Itoa(DefTiDBDDLMaxWaitTime), Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxInt32, SetSession: func(s *SessionVars, val string) error {
+		s.DDLMaxWaitTime = uint64(tidbOptPositiveInt32(val, DefTiDBDDLMaxWaitTime))
+		return nil
+	}},
 }
 
 // FeedbackProbability points to the FeedbackProbability in statistics package.
@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/tidb/config"
 	"github.com/pingcap/tidb/parser/mysql"
 	"github.com/pingcap/tidb/parser/terror"
+	"github.com/pingcap/tidb/util/tablemetrics"
 	"github.com/stretchr/testify/require"
 )
 
@@ -91,6 +92,19 @@ func TestMaxExecutionTime(t *testing.T) {
 	require.Equal(t, uint64(99999), vars.MaxExecutionTime)
 }
 
+func TestDDLMaxWaitTime(t *testing.T) {
+	sv := GetSysVar(TiDBDDLMaxWaitTime)
+	vars := NewSessionVars()
+	require.Equal(t, uint64(0), vars.DDLMaxWaitTime)
+
+	val, err := sv.Validate(vars, "-10", ScopeSession)
+	require.NoError(t, err)
+	require.Equal(t, "0", val)
+
+	require.Nil(t, sv.SetSessionFromHook(vars, "3000"))
+	require.Equal(t, uint64(3000), vars.DDLMaxWaitTime)
+}
+
 func TestCollationServer(t *testing.T) {
 	sv := GetSysVar(CollationServer)
 	vars := NewSessionVars()
@@ -717,3 +731,46 @@ func TestSetTIDBDiskQuota(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, strconv.FormatInt(pb, 10), val)
 }
+
+func TestTiDBTelemetryCategories(t *testing.T) {
+	sv := GetSysVar(TiDBTelemetryCategories)
+	vars := NewSessionVars()
+	require.Equal(t, "cluster_info,hardware,feature_usage,sql_shape", sv.Value)
+
+	val, err := sv.Validate(vars, " SQL_Shape, hardware,sql_shape", ScopeGlobal)
+	require.NoError(t, err)
+	require.Equal(t, "hardware,sql_shape", val)
+
+	val, err = sv.Validate(vars, "", ScopeGlobal)
+	require.NoError(t, err)
+	require.Equal(t, "", val)
+
+	_, err = sv.Validate(vars, "hardware,unknown", ScopeGlobal)
+	require.Error(t, err)
+}
+
+func TestTiDBTableMetrics(t *testing.T) {
+	vars := NewSessionVars()
+
+	sv := GetSysVar(TiDBEnableTableMetrics)
+	require.Equal(t, Off, sv.Value)
+	require.NoError(t, sv.SetGlobalFromHook(vars, On, false))
+	require.True(t, tablemetrics.Enabled())
+	val, err := sv.GetGlobalFromHook(vars)
+	require.NoError(t, err)
+	require.Equal(t, On, val)
+	require.NoError(t, sv.SetGlobalFromHook(vars, Off, false))
+	require.False(t, tablemetrics.Enabled())
+
+	sv = GetSysVar(TiDBTableMetricsTopK)
+	require.Equal(t, "100", sv.Value)
+	val, err = sv.Validate(vars, "0", ScopeGlobal)
+	require.NoError(t, err) // truncated
+	require.Equal(t, "1", val)
+	require.NoError(t, sv.SetGlobalFromHook(vars, "10", false))
+	require.Equal(t, 10, tablemetrics.TopK())
+	val, err = sv.GetGlobalFromHook(vars)
+	require.NoError(t, err)
+	require.Equal(t, "10", val)
+	require.NoError(t, sv.SetGlobalFromHook(vars, "100", false))
+}
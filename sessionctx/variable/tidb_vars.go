@@ -15,6 +15,7 @@
 package variable
 
 import (
+	"context"
 	"math"
 
 	"github.com/pingcap/tidb/config"
@@ -220,6 +221,9 @@ const (
 	// TiDBReadStaleness indicates the staleness duration for following statement
 	TiDBReadStaleness = "tidb_read_staleness"
 
+	// TiDBEnableExternalTSRead indicates whether the autocommit read statements read the data at tidb_external_ts.
+	TiDBEnableExternalTSRead = "tidb_enable_external_ts_read"
+
 	// TiDBEnablePaging indicates whether paging is enabled in coprocessor requests.
 	TiDBEnablePaging = "tidb_enable_paging"
 
@@ -584,6 +588,9 @@ const (
 	TiDBShardAllocateStep = "tidb_shard_allocate_step"
 	// TiDBEnableTelemetry indicates that whether usage data report to PingCAP is enabled.
 	TiDBEnableTelemetry = "tidb_enable_telemetry"
+	// TiDBTelemetryCategories is the comma separated categories of the usage data reported
+	// to PingCAP, see TelemetryCategories for all the categories.
+	TiDBTelemetryCategories = "tidb_telemetry_categories"
 
 	// TiDBEnableAmendPessimisticTxn indicates if amend pessimistic transactions is enabled.
 	TiDBEnableAmendPessimisticTxn = "tidb_enable_amend_pessimistic_txn"
@@ -702,6 +709,17 @@ const (
 	// TiDBSimplifiedMetrics controls whether to unregister some unused metrics.
 	TiDBSimplifiedMetrics = "tidb_simplified_metrics"
 
+	// TiDBEnableTableMetrics indicates whether to record the per-table and per-index request metrics.
+	TiDBEnableTableMetrics = "tidb_enable_table_metrics"
+	// TiDBExternalTS is the timestamp which the stale reads with tidb_enable_external_ts_read read the data at,
+	// it's usually a consistency point of an external source, such as the restored ts of a log restore.
+	// It can only be advanced.
+	TiDBExternalTS = "tidb_external_ts"
+
+	// TiDBTableMetricsTopK is the max number of the tables and indexes whose metrics are recorded individually,
+	// the others are recorded together.
+	TiDBTableMetricsTopK = "tidb_table_metrics_top_k"
+
 	// TiDBMemoryDebugModeMinHeapInUse is used to set tidb memory debug mode trigger threshold.
 	// When set to 0, the function is disabled.
 	// When set to a negative integer, use memory debug mode to detect the issue of frequent allocation and release of memory.
@@ -736,6 +754,10 @@ const (
 
 	// TiDBConstraintCheckInPlacePessimistic controls whether to skip certain kinds of pessimistic locks.
 	TiDBConstraintCheckInPlacePessimistic = "tidb_constraint_check_in_place_pessimistic"
+
+	// TiDBDDLMaxWaitTime is the max time in milliseconds that a DDL statement waits for its DDL job,
+	// the job is canceled when it's exceeded. DDL statements are not limited by max_execution_time.
+	TiDBDDLMaxWaitTime = "tidb_ddl_max_wait_time"
 )
 
 // TiDB vars that have only global scope
@@ -946,6 +968,7 @@ const (
 	DefTiDBSuperReadOnly                           = false
 	DefTiDBShardAllocateStep                       = math.MaxInt64
 	DefTiDBEnableTelemetry                         = true
+	DefTiDBTelemetryCategories                     = TelemetryCategoryClusterInfo + "," + TelemetryCategoryHardware + "," + TelemetryCategoryFeatureUsage + "," + TelemetryCategorySQLShape
 	DefTiDBEnableParallelApply                     = false
 	DefTiDBEnableAmendPessimisticTxn               = false
 	DefTiDBPartitionPruneMode                      = "static"
@@ -991,6 +1014,8 @@ const (
 	DefTiDBRemoveOrderbyInSubquery                 = false
 	DefTiDBSkewDistinctAgg                         = false
 	DefTiDBReadStaleness                           = 0
+	DefTiDBExternalTS                              = 0
+	DefTiDBEnableExternalTSRead                    = false
 	DefTiDBGCMaxWaitTime                           = 24 * 60 * 60
 	DefMaxAllowedPacket                     uint64 = 67108864
 	DefTiDBEnableBatchDML                          = false
@@ -1032,6 +1057,7 @@ const (
 	MinDDLReorgBatchSize                     int32  = 32
 	MinExpensiveQueryTimeThreshold           uint64 = 10 // 10s
 	DefTiDBConstraintCheckInPlacePessimistic        = true
+	DefTiDBDDLMaxWaitTime                           = 0
 )
 
 // Process global variables.
@@ -1093,4 +1119,28 @@ var (
 	EnableDDL func() error = nil
 	// DisableDDL is the func registered by ddl to disable running ddl in this instance.
 	DisableDDL func() error = nil
+	// SetExternalTimestamp is the func registered by domain to set the external timestamp.
+	SetExternalTimestamp func(ctx context.Context, ts uint64) error = nil
+	// GetExternalTimestamp is the func registered by domain to get the external timestamp.
+	GetExternalTimestamp func(ctx context.Context) (uint64, error) = nil
 )
+
+// The categories of the usage data reported by telemetry.
+const (
+	// TelemetryCategoryClusterInfo is the versions and the deployment of the instances.
+	TelemetryCategoryClusterInfo = "cluster_info"
+	// TelemetryCategoryHardware is the hardware of the instances and the hosts.
+	TelemetryCategoryHardware = "hardware"
+	// TelemetryCategoryFeatureUsage is the usage of the features, such as CTE and partitioned tables.
+	TelemetryCategoryFeatureUsage = "feature_usage"
+	// TelemetryCategorySQLShape is the histograms of the statement types and the slow query durations.
+	TelemetryCategorySQLShape = "sql_shape"
+)
+
+// TelemetryCategories is all the categories of the usage data reported by telemetry.
+var TelemetryCategories = []string{
+	TelemetryCategoryClusterInfo,
+	TelemetryCategoryHardware,
+	TelemetryCategoryFeatureUsage,
+	TelemetryCategorySQLShape,
+}
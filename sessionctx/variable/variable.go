@@ -527,10 +527,12 @@ func (sv *SysVar) SkipInit() bool {
 // to not use a different table internally, but to do that we need to first
 // fix upgrade/downgrade so we know that older servers won't be in the cluster
 // which update only these values.
+// tidb_external_ts is stored in the meta and can only be advanced, so it's
+// skipped too.
 func (sv *SysVar) SkipSysvarCache() bool {
 	switch sv.Name {
 	case TiDBGCEnable, TiDBGCRunInterval, TiDBGCLifetime,
-		TiDBGCConcurrency, TiDBGCScanLockMode:
+		TiDBGCConcurrency, TiDBGCScanLockMode, TiDBExternalTS:
 		return true
 	}
 	return false
@@ -410,6 +410,23 @@ func setTxnReadTS(s *SessionVars, sVal string) error {
 	return err
 }
 
+// parseTSFromNumberOrTime parses a TSO or a timestamp in the session time zone.
+func parseTSFromNumberOrTime(s *SessionVars, sVal string) (uint64, error) {
+	if tso, err := strconv.ParseUint(sVal, 10, 64); err == nil {
+		return tso, nil
+	}
+
+	t, err := types.ParseTime(s.StmtCtx, sVal, mysql.TypeTimestamp, types.MaxFsp)
+	if err != nil {
+		return 0, err
+	}
+	t1, err := t.GoTime(s.Location())
+	if err != nil {
+		return 0, err
+	}
+	return oracle.GoTimeToTS(t1), nil
+}
+
 func setReadStaleness(s *SessionVars, sVal string) error {
 	if sVal == "" || sVal == "0" {
 		s.ReadStaleness = 0
@@ -226,6 +226,13 @@ func (p *staleReadProcessor) evaluateFromStmtTSOrSysVariable(stmtTS uint64) erro
 		return p.setEvaluatedTS(txnReadTS)
 	}
 
+	if evaluator := getTsEvaluatorFromExternalTS(p.sctx); evaluator != nil {
+		// set @@tidb_enable_external_ts_read=on;
+		// select from table
+		// Then the following select statements read the data at tidb_external_ts.
+		return p.setEvaluatedEvaluator(evaluator)
+	}
+
 	if evaluator := getTsEvaluatorFromReadStaleness(p.sctx); evaluator != nil {
 		// If both txnReadTS and stmtAsOfTS is empty while the return of getTsEvaluatorFromReadStaleness is not nil, it means we meet following situation:
 		// set @@tidb_read_staleness='-5';
@@ -266,6 +273,17 @@ func getTsEvaluatorFromReadStaleness(sctx sessionctx.Context) StalenessTSEvaluat
 	}
 }
 
+func getTsEvaluatorFromExternalTS(sctx sessionctx.Context) StalenessTSEvaluator {
+	sessVars := sctx.GetSessionVars()
+	if !sessVars.EnableExternalTSRead || sessVars.InRestrictedSQL {
+		return nil
+	}
+
+	return func(sctx sessionctx.Context) (uint64, error) {
+		return GetExternalTimestamp(context.TODO(), sctx)
+	}
+}
+
 // GetSessionSnapshotInfoSchema returns the session's information schema with specified ts
 func GetSessionSnapshotInfoSchema(sctx sessionctx.Context, snapshotTS uint64) (infoschema.InfoSchema, error) {
 	is, err := domain.GetDomain(sctx).GetSnapshotInfoSchema(snapshotTS)
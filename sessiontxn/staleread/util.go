@@ -15,6 +15,7 @@
 package staleread
 
 import (
+	"context"
 	"time"
 
 	"github.com/pingcap/tidb/expression"
@@ -61,6 +62,19 @@ func CalculateTsWithReadStaleness(sctx sessionctx.Context, readStaleness time.Du
 	return oracle.GoTimeToTS(expression.CalAppropriateTime(tsVal, nowVal, minTsVal)), nil
 }
 
+// GetExternalTimestamp returns tidb_external_ts of the statement, it returns an
+// error if it's not set.
+func GetExternalTimestamp(ctx context.Context, sctx sessionctx.Context) (uint64, error) {
+	ts, err := expression.GetStmtExternalTimestamp(ctx, sctx)
+	if err != nil {
+		return 0, err
+	}
+	if ts == 0 {
+		return 0, errAsOf.FastGenWithCause("tidb_external_ts is not set")
+	}
+	return ts, nil
+}
+
 // IsStmtStaleness indicates whether the current statement is staleness or not
 func IsStmtStaleness(sctx sessionctx.Context) bool {
 	return sctx.GetSessionVars().StmtCtx.IsStaleness
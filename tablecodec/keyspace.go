@@ -0,0 +1,126 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tablecodec
+
+import (
+	"bytes"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/kv"
+)
+
+const (
+	// KeyspaceTxnModePrefix is the mode prefix of the transactional keys in a keyspace.
+	KeyspaceTxnModePrefix byte = 'x'
+	// KeyspacePrefixLen is the length of the keyspace prefix, which is the mode
+	// prefix followed by the 3 bytes big-endian keyspace ID.
+	KeyspacePrefixLen = 4
+	// MaxKeyspaceID is the max keyspace ID.
+	MaxKeyspaceID uint32 = 1<<24 - 1
+)
+
+// KeyspacePrefix returns the prefix of the keys in the keyspace: "x[keyspaceID]".
+func KeyspacePrefix(keyspaceID uint32) (kv.Key, error) {
+	if keyspaceID > MaxKeyspaceID {
+		return nil, errors.Errorf("keyspace ID %d is out of range [0, %d]", keyspaceID, MaxKeyspaceID)
+	}
+	return kv.Key{KeyspaceTxnModePrefix, byte(keyspaceID >> 16), byte(keyspaceID >> 8), byte(keyspaceID)}, nil
+}
+
+// EncodeKeyspaceKey prepends the keyspace prefix to the key.
+func EncodeKeyspaceKey(keyspaceID uint32, key kv.Key) (kv.Key, error) {
+	prefix, err := KeyspacePrefix(keyspaceID)
+	if err != nil {
+		return nil, err
+	}
+	return append(prefix, key...), nil
+}
+
+// DecodeKeyspaceKey splits the key into the keyspace ID and the key in the keyspace.
+func DecodeKeyspaceKey(key kv.Key) (keyspaceID uint32, keyInKeyspace kv.Key, err error) {
+	if len(key) < KeyspacePrefixLen || key[0] != KeyspaceTxnModePrefix {
+		return 0, nil, errInvalidKey.GenWithStack("invalid keyspace key - %q", key)
+	}
+	keyspaceID = uint32(key[1])<<16 | uint32(key[2])<<8 | uint32(key[3])
+	return keyspaceID, key[KeyspacePrefixLen:], nil
+}
+
+// RewriteKeyspaceKey moves the key in keyspace `from` to keyspace `to`.
+func RewriteKeyspaceKey(key kv.Key, from, to uint32) (kv.Key, error) {
+	keyspaceID, keyInKeyspace, err := DecodeKeyspaceKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if keyspaceID != from {
+		return nil, errInvalidKey.GenWithStack("key %q is in keyspace %d, not %d", key, keyspaceID, from)
+	}
+	return EncodeKeyspaceKey(to, keyInKeyspace)
+}
+
+// EncodeKeyspaceRange encodes the range in the keyspace. The empty start key and
+// end key mean the start and the end of the keyspace.
+func EncodeKeyspaceRange(keyspaceID uint32, r kv.KeyRange) (kv.KeyRange, error) {
+	prefix, err := KeyspacePrefix(keyspaceID)
+	if err != nil {
+		return kv.KeyRange{}, err
+	}
+	start := append(prefix.Clone(), r.StartKey...)
+	end := prefix.PrefixNext()
+	if len(r.EndKey) > 0 {
+		end = append(prefix, r.EndKey...)
+	}
+	return kv.KeyRange{StartKey: start, EndKey: end}, nil
+}
+
+// DecodeKeyspaceRange decodes the range in the keyspace, it's the reverse of EncodeKeyspaceRange.
+func DecodeKeyspaceRange(r kv.KeyRange) (keyspaceID uint32, rangeInKeyspace kv.KeyRange, err error) {
+	keyspaceID, start, err := DecodeKeyspaceKey(r.StartKey)
+	if err != nil {
+		return 0, kv.KeyRange{}, err
+	}
+	prefix, _ := KeyspacePrefix(keyspaceID)
+	if bytes.Equal(r.EndKey, prefix.PrefixNext()) {
+		return keyspaceID, kv.KeyRange{StartKey: start}, nil
+	}
+	endKeyspaceID, end, err := DecodeKeyspaceKey(r.EndKey)
+	if err != nil {
+		return 0, kv.KeyRange{}, err
+	}
+	if endKeyspaceID != keyspaceID {
+		return 0, kv.KeyRange{}, errInvalidKey.GenWithStack("range [%q, %q) crosses keyspaces", r.StartKey, r.EndKey)
+	}
+	return keyspaceID, kv.KeyRange{StartKey: start, EndKey: end}, nil
+}
+
+// RewriteKeyspaceRanges moves the ranges in keyspace `from` to keyspace `to`.
+// A range ending at the end of keyspace `from` ends at the end of keyspace `to`.
+func RewriteKeyspaceRanges(ranges []kv.KeyRange, from, to uint32) ([]kv.KeyRange, error) {
+	newRanges := make([]kv.KeyRange, 0, len(ranges))
+	for _, r := range ranges {
+		keyspaceID, rangeInKeyspace, err := DecodeKeyspaceRange(r)
+		if err != nil {
+			return nil, err
+		}
+		if keyspaceID != from {
+			return nil, errInvalidKey.GenWithStack("range [%q, %q) is in keyspace %d, not %d", r.StartKey, r.EndKey, keyspaceID, from)
+		}
+		newRange, err := EncodeKeyspaceRange(to, rangeInKeyspace)
+		if err != nil {
+			return nil, err
+		}
+		newRanges = append(newRanges, newRange)
+	}
+	return newRanges, nil
+}
@@ -525,6 +525,48 @@ func TestRange(t *testing.T) {
 	require.Less(t, string(s2), string(e2))
 }
 
+func TestKeyspace(t *testing.T) {
+	prefix, err := KeyspacePrefix(0x010203)
+	require.NoError(t, err)
+	require.Equal(t, kv.Key{'x', 1, 2, 3}, prefix)
+	_, err = KeyspacePrefix(MaxKeyspaceID + 1)
+	require.Error(t, err)
+
+	rowKey := EncodeRowKeyWithHandle(42, kv.IntHandle(1))
+	key, err := EncodeKeyspaceKey(0x010203, rowKey)
+	require.NoError(t, err)
+	keyspaceID, rest, err := DecodeKeyspaceKey(key)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0x010203), keyspaceID)
+	require.Equal(t, rowKey, rest)
+	_, _, err = DecodeKeyspaceKey(rowKey)
+	require.Error(t, err)
+
+	key2, err := RewriteKeyspaceKey(key, 0x010203, 7)
+	require.NoError(t, err)
+	require.Equal(t, append(kv.Key{'x', 0, 0, 7}, rowKey...), key2)
+	_, err = RewriteKeyspaceKey(key, 7, 8)
+	require.Error(t, err)
+
+	start, end := GetTableHandleKeyRange(42)
+	ranges := []kv.KeyRange{{StartKey: start, EndKey: end}, {StartKey: end}}
+	for i := range ranges {
+		ranges[i], err = EncodeKeyspaceRange(MaxKeyspaceID, ranges[i])
+		require.NoError(t, err)
+	}
+	require.Equal(t, kv.Key{'y', 0, 0, 0}, ranges[1].EndKey)
+	ranges, err = RewriteKeyspaceRanges(ranges, MaxKeyspaceID, 7)
+	require.NoError(t, err)
+	require.Equal(t, []kv.KeyRange{
+		{StartKey: append(kv.Key{'x', 0, 0, 7}, start...), EndKey: append(kv.Key{'x', 0, 0, 7}, end...)},
+		{StartKey: append(kv.Key{'x', 0, 0, 7}, end...), EndKey: kv.Key{'x', 0, 0, 8}},
+	}, ranges)
+	_, err = RewriteKeyspaceRanges(ranges, 8, 9)
+	require.Error(t, err)
+	_, err = RewriteKeyspaceRanges([]kv.KeyRange{{StartKey: ranges[0].StartKey, EndKey: kv.Key{'x', 0, 0, 9, 1}}}, 7, 8)
+	require.Error(t, err)
+}
+
 func TestDecodeAutoIDMeta(t *testing.T) {
 	keyBytes := []byte{0x6d, 0x44, 0x42, 0x3a, 0x35, 0x36, 0x0, 0x0, 0x0, 0xfc, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x68, 0x54, 0x49, 0x44, 0x3a, 0x31, 0x30, 0x38, 0x0, 0xfe}
 	key, field, err := DecodeMetaKey(keyBytes)
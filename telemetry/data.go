@@ -20,6 +20,7 @@ import (
 
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
 )
 
 type telemetryData struct {
@@ -39,21 +40,29 @@ func generateTelemetryData(sctx sessionctx.Context, trackingID string) telemetry
 		ReportTimestamp: time.Now().Unix(),
 		TrackingID:      trackingID,
 	}
-	if h, err := getClusterHardware(ctx, sctx); err == nil {
-		r.Hardware = h
+	categories := getTelemetryCategories(sctx)
+	if _, ok := categories[variable.TelemetryCategoryHardware]; ok {
+		if h, err := getClusterHardware(ctx, sctx); err == nil {
+			r.Hardware = h
+		}
+		r.TelemetryHostExtra = getTelemetryHostExtraInfo()
 	}
-	if i, err := getClusterInfo(ctx, sctx); err == nil {
-		r.Instances = i
+	if _, ok := categories[variable.TelemetryCategoryClusterInfo]; ok {
+		if i, err := getClusterInfo(ctx, sctx); err == nil {
+			r.Instances = i
+		}
 	}
-	if f, err := getFeatureUsage(ctx, sctx); err == nil {
-		r.FeatureUsage = f
+	if _, ok := categories[variable.TelemetryCategoryFeatureUsage]; ok {
+		if f, err := getFeatureUsage(ctx, sctx); err == nil {
+			r.FeatureUsage = f
+		}
 	}
-	if s, err := getSlowQueryStats(); err == nil {
-		r.SlowQueryStats = s
+	if _, ok := categories[variable.TelemetryCategorySQLShape]; ok {
+		if s, err := getSlowQueryStats(); err == nil {
+			r.SlowQueryStats = s
+		}
+		r.WindowedStats = getWindowData()
 	}
-
-	r.WindowedStats = getWindowData()
-	r.TelemetryHostExtra = getTelemetryHostExtraInfo()
 	return r
 }
 
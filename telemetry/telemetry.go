@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/pingcap/errors"
@@ -52,6 +53,23 @@ func getTelemetryGlobalVariable(ctx sessionctx.Context) (bool, error) {
 	return variable.TiDBOptOn(val), err
 }
 
+// getTelemetryCategories returns the enabled categories of the usage data. No category is
+// enabled if the variable can't be read, so that nothing unexpected is reported.
+func getTelemetryCategories(ctx sessionctx.Context) map[string]struct{} {
+	categories := make(map[string]struct{})
+	val, err := ctx.GetSessionVars().GlobalVarsAccessor.GetGlobalSysVar(variable.TiDBTelemetryCategories)
+	if err != nil {
+		logutil.BgLogger().Warn("failed to get telemetry categories", zap.Error(err))
+		return categories
+	}
+	for _, category := range strings.Split(val, ",") {
+		if category != "" {
+			categories[category] = struct{}{}
+		}
+	}
+	return categories
+}
+
 // IsTelemetryEnabled check whether telemetry enabled.
 func IsTelemetryEnabled(ctx sessionctx.Context) (bool, error) {
 	if !config.GetGlobalConfig().EnableTelemetry {
@@ -88,6 +88,21 @@ func TestPreview(t *testing.T) {
 	require.Equal(t, "tikv", jsonParsed.Path("instances.1.instanceType").Data().(string))
 	require.True(t, jsonParsed.ExistsP("hardware"))
 
+	// only the enabled categories are reported.
+	_, err = se.Execute(context.Background(), "SET @@global.tidb_telemetry_categories = 'Hardware'")
+	require.NoError(t, err)
+	r, err = telemetry.PreviewUsageData(se, etcdCluster.RandClient())
+	require.NoError(t, err)
+	jsonParsed, err = gabs.ParseJSON([]byte(r))
+	require.NoError(t, err)
+	require.Equal(t, trackingID, jsonParsed.Path("trackingId").Data().(string))
+	require.True(t, jsonParsed.ExistsP("hostExtra.os"))
+	require.Nil(t, jsonParsed.Path("instances").Data())
+	require.Nil(t, jsonParsed.Path("featureUsage").Data())
+	require.Nil(t, jsonParsed.Path("windowedStats").Data())
+	_, err = se.Execute(context.Background(), "SET @@global.tidb_telemetry_categories = DEFAULT")
+	require.NoError(t, err)
+
 	_, err = se.Execute(context.Background(), "SET @@global.tidb_enable_telemetry = 0")
 	require.NoError(t, err)
 	r, err = telemetry.PreviewUsageData(se, etcdCluster.RandClient())
@@ -324,7 +324,10 @@ func runeLen(b byte) int {
 // IsCICollation returns if the collation is case-sensitive
 func IsCICollation(collate string) bool {
 	return collate == "utf8_general_ci" || collate == "utf8mb4_general_ci" ||
-		collate == "utf8_unicode_ci" || collate == "utf8mb4_unicode_ci"
+		collate == "utf8_unicode_ci" || collate == "utf8mb4_unicode_ci" ||
+		collate == "utf8mb4_0900_ai_ci" || collate == "utf8mb4_de_pb_0900_ai_ci" ||
+		collate == "utf8mb4_es_0900_ai_ci" || collate == "utf8mb4_sv_0900_ai_ci" ||
+		collate == "utf8mb4_tr_0900_ai_ci"
 }
 
 // IsBinCollation returns if the collation is 'xx_bin' or 'bin'.
@@ -397,4 +400,16 @@ func init() {
 	newCollatorIDMap[CollationName2ID(charset.CollationGBKBin)] = &gbkBinCollator{charset.NewCustomGBKEncoder()}
 	newCollatorMap[charset.CollationGBKChineseCI] = &gbkChineseCICollator{}
 	newCollatorIDMap[CollationName2ID(charset.CollationGBKChineseCI)] = &gbkChineseCICollator{}
+	newCollatorMap[charset.CollationGB18030Bin] = &gbkBinCollator{charset.NewCustomGB18030Encoder()}
+	newCollatorIDMap[CollationName2ID(charset.CollationGB18030Bin)] = &gbkBinCollator{charset.NewCustomGB18030Encoder()}
+	newCollatorMap[charset.CollationGB18030ChineseCI] = newGB18030ChineseCICollator()
+	newCollatorIDMap[CollationName2ID(charset.CollationGB18030ChineseCI)] = newGB18030ChineseCICollator()
+	newCollatorMap["utf8mb4_0900_ai_ci"] = &unicode0900AICICollator{}
+	newCollatorIDMap[CollationName2ID("utf8mb4_0900_ai_ci")] = &unicode0900AICICollator{}
+	newCollatorMap["utf8mb4_0900_bin"] = &binCollator{}
+	newCollatorIDMap[CollationName2ID("utf8mb4_0900_bin")] = &binCollator{}
+	for name, collator := range newUnicode0900Tailorings() {
+		newCollatorMap[name] = collator
+		newCollatorIDMap[CollationName2ID(name)] = collator
+	}
 }
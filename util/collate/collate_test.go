@@ -15,6 +15,7 @@
 package collate
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 
@@ -110,6 +111,114 @@ func TestUTF8CollatorKey(t *testing.T) {
 	testKeyTable(t, collations, tests)
 }
 
+func TestUnicode0900CollatorCompare(t *testing.T) {
+	SetNewCollationEnabledForTest(true)
+	defer SetNewCollationEnabledForTest(false)
+	collations := []string{"utf8mb4_0900_ai_ci", "utf8mb4_0900_bin"}
+	tests := []compareTable{
+		{"a", "b", []int{-1, -1}},
+		{"a", "A", []int{0, 1}},
+		{"À", "A", []int{0, 1}},
+		{"abc", "ab", []int{1, 1}},
+		{"😜", "😃", []int{1, 1}},
+		{"a", "a ", []int{-1, -1}},
+		{"a\t", "a", []int{1, 1}},
+		{"ß", "s", []int{1, 1}},
+		{"ß", "ss", []int{0, 1}},
+		{"ǅ", "dz", []int{0, 1}},
+		{"啊", "吧", []int{1, 1}},
+		{"中文", "汉字", []int{-1, -1}},
+		{"𠀀", "中", []int{1, 1}},
+	}
+	testCompareTable(t, collations, tests)
+}
+
+func TestUnicode0900CollatorKey(t *testing.T) {
+	SetNewCollationEnabledForTest(true)
+	defer SetNewCollationEnabledForTest(false)
+	collations := []string{"utf8mb4_0900_ai_ci", "utf8mb4_0900_bin"}
+	tests := []keyTable{
+		{"a", [][]byte{{0x1F, 0xA2}, {0x61}}},
+		{"A", [][]byte{{0x1F, 0xA2}, {0x41}}},
+		{"a ", [][]byte{{0x1F, 0xA2, 0x02, 0x09}, {0x61, 0x20}}},
+		{"Foo © bar 𝌆 baz ☃ qux", [][]byte{
+			{0x20, 0x42, 0x21, 0x3C, 0x21, 0x3C, 0x02, 0x09, 0x05, 0xD2, 0x02, 0x09, 0x1F, 0xBC, 0x1F, 0xA2, 0x21, 0x93, 0x02, 0x09, 0x10, 0x3C, 0x02, 0x09, 0x1F, 0xBC, 0x1F, 0xA2, 0x22, 0x86, 0x02, 0x09, 0x0A, 0x36, 0x02, 0x09, 0x21, 0x80, 0x22, 0x17, 0x22, 0x64},
+			{0x46, 0x6f, 0x6f, 0x20, 0xc2, 0xa9, 0x20, 0x62, 0x61, 0x72, 0x20, 0xf0, 0x9d, 0x8c, 0x86, 0x20, 0x62, 0x61, 0x7a, 0x20, 0xe2, 0x98, 0x83, 0x20, 0x71, 0x75, 0x78},
+		}},
+		{"ﷻ", [][]byte{
+			{0x26, 0x8F, 0x27, 0x0C, 0x02, 0x09, 0x26, 0x8F, 0x27, 0x0C, 0x26, 0x72, 0x27, 0x0C, 0x27, 0x22},
+			{0xEF, 0xB7, 0xBB},
+		}},
+		{"中文", [][]byte{
+			{0xFB, 0x40, 0xCE, 0x2D, 0xFB, 0x40, 0xE5, 0x87},
+			{0xE4, 0xB8, 0xAD, 0xE6, 0x96, 0x87},
+		}},
+	}
+	testKeyTable(t, collations, tests)
+}
+
+func TestUnicode0900Tailorings(t *testing.T) {
+	SetNewCollationEnabledForTest(true)
+	defer SetNewCollationEnabledForTest(false)
+	collations := []string{"utf8mb4_0900_ai_ci", "utf8mb4_es_0900_ai_ci", "utf8mb4_sv_0900_ai_ci", "utf8mb4_tr_0900_ai_ci", "utf8mb4_de_pb_0900_ai_ci"}
+	tests := []compareTable{
+		{"ñ", "n", []int{0, 1, 0, 0, 0}},
+		{"ñ", "nz", []int{-1, 1, -1, -1, -1}},
+		{"ñ", "o", []int{-1, -1, -1, -1, -1}},
+		{"Ñ", "ñ", []int{0, 0, 0, 0, 0}},
+		{"å", "z", []int{-1, -1, 1, -1, -1}},
+		{"ä", "å", []int{0, 0, 1, 0, 1}},
+		{"ö", "ä", []int{1, 1, 1, 1, 1}},
+		{"æ", "ä", []int{1, 1, 0, 1, 0}},
+		{"ø", "ö", []int{0, 0, 0, -1, -1}},
+		{"ü", "y", []int{-1, -1, 0, -1, -1}},
+		{"ı", "i", []int{1, 1, 1, -1, 1}},
+		{"I", "ı", []int{-1, -1, -1, 0, -1}},
+		{"İ", "i", []int{0, 0, 0, 0, 0}},
+		{"ç", "cz", []int{-1, -1, -1, 1, -1}},
+		{"ç", "d", []int{-1, -1, -1, -1, -1}},
+		{"ä", "ae", []int{-1, -1, 1, -1, 0}},
+		{"ö", "oe", []int{-1, -1, 1, 1, 0}},
+		{"ü", "ue", []int{-1, -1, 1, 1, 0}},
+		{"Äpfel", "Apfel", []int{0, 0, 1, 0, -1}},
+	}
+	testCompareTable(t, collations, tests)
+
+	// the keys are consistent with the comparison.
+	for _, c := range collations {
+		collator := GetCollator(c)
+		for _, test := range tests {
+			comment := fmt.Sprintf("Key Left: %v Right: %v, Using %v", test.Left, test.Right, c)
+			require.Equal(t, collator.Compare(test.Left, test.Right), bytes.Compare(collator.Key(test.Left), collator.Key(test.Right)), comment)
+		}
+	}
+}
+
+func TestGB18030Collator(t *testing.T) {
+	SetNewCollationEnabledForTest(true)
+	defer SetNewCollationEnabledForTest(false)
+	collations := []string{"gb18030_bin", "gb18030_chinese_ci"}
+	compareTests := []compareTable{
+		{"a", "A", []int{1, 0}},
+		{"a", "a ", []int{0, 0}},
+		{"啊", "吧", []int{-1, -1}},
+		{"中文", "汉字", []int{1, 1}},
+		{"€", "中", []int{-1, 1}},
+		{"𠀀", "€", []int{-1, 1}},
+		{"𠀀", "𠀁", []int{-1, -1}},
+		{"😜", "😃", []int{1, 1}},
+	}
+	testCompareTable(t, collations, compareTests)
+
+	keyTests := []keyTable{
+		{"a", [][]byte{{0x61}, {0x41}}},
+		{"中文 ", [][]byte{{0xD6, 0xD0, 0xCE, 0xC4}, {0xD3, 0x21, 0xC1, 0xAD}}},
+		{"€", [][]byte{{0xA2, 0xE3}, {0xFF, 0x00, 0xA2, 0xE3}}},
+		{"𠀀", [][]byte{{0x95, 0x32, 0x82, 0x36}, {0xFF, 0x04, 0xE2, 0x48}}},
+	}
+	testKeyTable(t, collations, keyTests)
+}
+
 func TestSetNewCollateEnabled(t *testing.T) {
 	defer SetNewCollationEnabledForTest(false)
 
@@ -177,4 +286,11 @@ func TestGetCollator(t *testing.T) {
 	defer SetNewCollationEnabledForTest(false)
 	require.IsType(t, &gbkBinCollator{}, GetCollator("gbk_bin"))
 	require.IsType(t, &gbkBinCollator{}, GetCollatorByID(87))
+	require.IsType(t, &gbkBinCollator{}, GetCollator("gb18030_bin"))
+	require.IsType(t, &gb18030ChineseCICollator{}, GetCollatorByID(248))
+	require.IsType(t, &unicode0900AICICollator{}, GetCollator("utf8mb4_0900_ai_ci"))
+	require.IsType(t, &unicode0900AICICollator{}, GetCollator("utf8mb4_sv_0900_ai_ci"))
+	require.IsType(t, &unicode0900AICICollator{}, GetCollatorByID(255))
+	require.IsType(t, &binCollator{}, GetCollator("utf8mb4_0900_bin"))
+	require.IsType(t, &binCollator{}, GetCollatorByID(309))
 }
@@ -0,0 +1,125 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collate
+
+import (
+	"unicode/utf8"
+
+	"github.com/pingcap/tidb/parser/charset"
+	"github.com/pingcap/tidb/util/stringutil"
+	"golang.org/x/text/encoding"
+)
+
+// gb18030ChineseCICollator is collator for gb18030_chinese_ci.
+// The characters in GBK are sorted as gbk_chinese_ci, and the others are sorted by
+// their GB18030 code after all the GBK characters.
+type gb18030ChineseCICollator struct {
+	e *encoding.Encoder
+}
+
+func newGB18030ChineseCICollator() *gb18030ChineseCICollator {
+	return &gb18030ChineseCICollator{e: charset.NewCustomGB18030Encoder()}
+}
+
+// Compare implements Collator interface.
+func (g *gb18030ChineseCICollator) Compare(a, b string) int {
+	a = truncateTailingSpace(a)
+	b = truncateTailingSpace(b)
+
+	r1, r2 := rune(0), rune(0)
+	ai, bi := 0, 0
+	for ai < len(a) && bi < len(b) {
+		r1, ai = decodeRune(a, ai)
+		r2, bi = decodeRune(b, bi)
+
+		k1, k2 := g.sortKey(r1), g.sortKey(r2)
+		if k1 != k2 {
+			return sign(int(k1) - int(k2))
+		}
+	}
+	return sign((len(a) - ai) - (len(b) - bi))
+}
+
+// Key implements Collator interface.
+func (g *gb18030ChineseCICollator) Key(str string) []byte {
+	return g.KeyWithoutTrimRightSpace(truncateTailingSpace(str))
+}
+
+// KeyWithoutTrimRightSpace implement Collator interface.
+func (g *gb18030ChineseCICollator) KeyWithoutTrimRightSpace(str string) []byte {
+	buf := make([]byte, 0, len(str)*2)
+	i := 0
+	r := rune(0)
+	for i < len(str) {
+		r, i = decodeRune(str, i)
+		u32 := g.sortKey(r)
+		if u32 > 0xFFFF {
+			buf = append(buf, byte(u32>>24), byte(u32>>16))
+		}
+		if u32 > 0xFF {
+			buf = append(buf, byte(u32>>8))
+		}
+		buf = append(buf, byte(u32))
+	}
+	return buf
+}
+
+// sortKey returns the sort key of the rune. The keys of the GBK characters are at most
+// 2 bytes and the first byte is never 0xFF, so 0xFF is used as the first byte of the
+// 4 bytes keys of the other characters to keep the keys in order.
+func (g *gb18030ChineseCICollator) sortKey(r rune) uint32 {
+	if k := gbkChineseCISortKey(r); k != 0x3F || r == '?' {
+		return uint32(k)
+	}
+	var src [utf8.UTFMax]byte
+	gb, err := g.e.Bytes(src[:utf8.EncodeRune(src[:], r)])
+	if err != nil {
+		return 0x3F
+	}
+	code := uint32(0)
+	switch len(gb) {
+	case 2:
+		code = uint32(gb[0])<<8 | uint32(gb[1])
+	case 4:
+		// the 4 bytes code is in the form of [81-FE][30-39][81-FE][30-39].
+		code = 0x10000 + ((uint32(gb[0]-0x81)*10+uint32(gb[1]-0x30))*126+uint32(gb[2]-0x81))*10 + uint32(gb[3]-0x30)
+	default:
+		return 0x3F
+	}
+	return 0xFF000000 | code
+}
+
+// Pattern implements Collator interface.
+func (g *gb18030ChineseCICollator) Pattern() WildcardPattern {
+	return &gb18030ChineseCIPattern{g: g}
+}
+
+type gb18030ChineseCIPattern struct {
+	g        *gb18030ChineseCICollator
+	patChars []rune
+	patTypes []byte
+}
+
+// Compile implements WildcardPattern interface.
+func (p *gb18030ChineseCIPattern) Compile(patternStr string, escape byte) {
+	p.patChars, p.patTypes = stringutil.CompilePatternInner(patternStr, escape)
+}
+
+// DoMatch implements WildcardPattern interface.
+func (p *gb18030ChineseCIPattern) DoMatch(str string) bool {
+	return stringutil.DoMatchInner(str, p.patChars, p.patTypes, func(a, b rune) bool {
+		return p.g.sortKey(a) == p.g.sortKey(b)
+	})
+}
@@ -0,0 +1,238 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collate
+
+import (
+	"sort"
+
+	"github.com/pingcap/tidb/util/stringutil"
+)
+
+// supplementaryRange0900 maps the runes in [start, end] to the primary weights
+// starting from `first`.
+type supplementaryRange0900 struct {
+	start, end rune
+	first      uint64
+}
+
+// unicode0900AICICollator implements the UCA based 0900 collations of MySQL 8.0, such as
+// utf8mb4_0900_ai_ci. Only the primary weights are compared, so it's accent and case insensitive.
+// Unlike unicode_ci, the trailing spaces are significant (NO PAD).
+// The `tailoring` overrides the weights of some runes for the language specific collations.
+type unicode0900AICICollator struct {
+	tailoring map[rune]uint64
+}
+
+// weights returns the packed primary weights of the rune. If the rune has more than
+// 4 weights, `first` is 0 and all the weights are in `rest`.
+func (uc *unicode0900AICICollator) weights(r rune) (first uint64, rest []uint64) {
+	if w, ok := uc.tailoring[r]; ok {
+		return w, nil
+	}
+	if r <= 0xFFFF {
+		if mapTable0900[r] == longRune {
+			return 0, longRuneMap0900[r]
+		}
+		return mapTable0900[r], nil
+	}
+	return convertSupplementaryRune0900(r)
+}
+
+func convertSupplementaryRune0900(r rune) (first uint64, rest []uint64) {
+	i := sort.Search(len(supplementaryRanges0900), func(i int) bool {
+		return supplementaryRanges0900[i].end >= r
+	})
+	if i < len(supplementaryRanges0900) && supplementaryRanges0900[i].start <= r {
+		rg := supplementaryRanges0900[i]
+		return rg.first + uint64(r-rg.start), nil
+	}
+	if w, ok := supplementaryMap0900[r]; ok {
+		if len(w) == 1 {
+			return w[0], nil
+		}
+		return 0, w
+	}
+	return implicitWeight0900(r), nil
+}
+
+// implicitWeight0900 computes the implicit weights of the runes not listed in DUCET.
+// see https://www.unicode.org/reports/tr10/#Implicit_Weights
+func implicitWeight0900(r rune) uint64 {
+	var base, offset uint64
+	switch {
+	case (r >= 0x17000 && r <= 0x18AFF) || (r >= 0x18D00 && r <= 0x18D8F): // Tangut
+		base, offset = 0xFB00, uint64(r-0x17000)
+	case r >= 0x1B170 && r <= 0x1B2FF: // Nushu
+		base, offset = 0xFB01, uint64(r-0x1B170)
+	case r >= 0x18B00 && r <= 0x18CFF: // Khitan Small Script
+		base, offset = 0xFB02, uint64(r-0x18B00)
+	case (r >= 0x20000 && r <= 0x2A6DD) || (r >= 0x2A700 && r <= 0x2B734) || (r >= 0x2B740 && r <= 0x2B81D) ||
+		(r >= 0x2B820 && r <= 0x2CEA1) || (r >= 0x2CEB0 && r <= 0x2EBE0) || (r >= 0x30000 && r <= 0x3134A): // CJK extensions
+		base, offset = 0xFB80+uint64(r>>15), uint64(r&0x7FFF)
+	default:
+		base, offset = 0xFBC0+uint64(r>>15), uint64(r&0x7FFF)
+	}
+	return base | (offset|0x8000)<<16
+}
+
+// unicode0900Iter iterates the primary weights of a string.
+type unicode0900Iter struct {
+	uc   *unicode0900AICICollator
+	str  string
+	idx  int
+	cur  uint64
+	rest []uint64
+}
+
+// next returns the next primary weight, 0 if there is no more weight.
+func (it *unicode0900Iter) next() uint64 {
+	for it.cur == 0 {
+		if len(it.rest) > 0 {
+			it.cur, it.rest = it.rest[0], it.rest[1:]
+			continue
+		}
+		if it.idx >= len(it.str) {
+			return 0
+		}
+		var r rune
+		r, it.idx = decodeRune(it.str, it.idx)
+		it.cur, it.rest = it.uc.weights(r)
+	}
+	w := it.cur & 0xFFFF
+	it.cur >>= 16
+	return w
+}
+
+// Compare implements Collator interface.
+func (uc *unicode0900AICICollator) Compare(a, b string) int {
+	ai := unicode0900Iter{uc: uc, str: a}
+	bi := unicode0900Iter{uc: uc, str: b}
+	for {
+		an, bn := ai.next(), bi.next()
+		if an != bn {
+			return sign(int(an) - int(bn))
+		}
+		if an == 0 {
+			return 0
+		}
+	}
+}
+
+// Key implements Collator interface.
+func (uc *unicode0900AICICollator) Key(str string) []byte {
+	return uc.KeyWithoutTrimRightSpace(str)
+}
+
+// KeyWithoutTrimRightSpace implements Collator interface.
+func (uc *unicode0900AICICollator) KeyWithoutTrimRightSpace(str string) []byte {
+	buf := make([]byte, 0, len(str)*2)
+	it := unicode0900Iter{uc: uc, str: str}
+	for w := it.next(); w != 0; w = it.next() {
+		buf = append(buf, byte(w>>8), byte(w))
+	}
+	return buf
+}
+
+// Pattern implements Collator interface.
+func (uc *unicode0900AICICollator) Pattern() WildcardPattern {
+	return &unicode0900Pattern{uc: uc}
+}
+
+type unicode0900Pattern struct {
+	uc       *unicode0900AICICollator
+	patChars []rune
+	patTypes []byte
+}
+
+// Compile implements WildcardPattern interface.
+func (p *unicode0900Pattern) Compile(patternStr string, escape byte) {
+	p.patChars, p.patTypes = stringutil.CompilePatternInner(patternStr, escape)
+}
+
+// DoMatch implements WildcardPattern interface.
+func (p *unicode0900Pattern) DoMatch(str string) bool {
+	return stringutil.DoMatchInner(str, p.patChars, p.patTypes, func(a, b rune) bool {
+		if a == b {
+			return true
+		}
+		aw, arest := p.uc.weights(a)
+		bw, brest := p.uc.weights(b)
+		// runes with more than 4 weights only match themselves.
+		return arest == nil && brest == nil && aw == bw
+	})
+}
+
+// tailoring0900 builds the weights overriding the DUCET for a language.
+type tailoring0900 map[rune]uint64
+
+// primary returns the packed primary weights of the rune in DUCET.
+func (tailoring0900) primary(r rune) uint64 {
+	return mapTable0900[r]
+}
+
+// after makes the runes sort after all the strings starting with `base`, the
+// runes are ordered as the arguments. e.g. after('n', 0, 'ñ', 'Ñ') makes 'ñ' a
+// letter between 'n' and 'o'.
+func (t tailoring0900) after(base rune, order int, runes ...rune) tailoring0900 {
+	// 0xFFFD..0xFFFF are larger than any weight following a real primary.
+	w := t.primary(base) | uint64(0xFFFD+order)<<16
+	for _, r := range runes {
+		t[r] = w
+	}
+	return t
+}
+
+// as makes the runes sort the same as `expansion`.
+func (t tailoring0900) as(expansion string, runes ...rune) tailoring0900 {
+	w, shift := uint64(0), 0
+	for _, e := range expansion {
+		w |= t.primary(e) << shift
+		shift += 16
+	}
+	for _, r := range runes {
+		t[r] = w
+	}
+	return t
+}
+
+// newUnicode0900Tailorings returns the collators of the language specific 0900 collations.
+// Only the tailored letters in precomposed form are supported, the contractions are not.
+func newUnicode0900Tailorings() map[string]*unicode0900AICICollator {
+	return map[string]*unicode0900AICICollator{
+		// Spanish: ñ is a letter after n.
+		"utf8mb4_es_0900_ai_ci": {tailoring0900{}.after('n', 0, 'ñ', 'Ñ')},
+		// Swedish: å, ä, ö are the last letters, æ is ä, ø is ö and ü is y.
+		"utf8mb4_sv_0900_ai_ci": {tailoring0900{}.
+			after('z', 0, 'å', 'Å').
+			after('z', 1, 'ä', 'Ä', 'æ', 'Æ').
+			after('z', 2, 'ö', 'Ö', 'ø', 'Ø').
+			as("y", 'ü', 'Ü')},
+		// Turkish: ç, ğ, ö, ş, ü are letters after their base letters, and ı is a letter
+		// before i. The upper case of ı is I and the upper case of i is İ.
+		"utf8mb4_tr_0900_ai_ci": {tailoring0900{}.
+			after('c', 0, 'ç', 'Ç').
+			after('g', 0, 'ğ', 'Ğ').
+			after('h', 0, 'ı', 'I').
+			as("i", 'i', 'İ').
+			after('o', 0, 'ö', 'Ö').
+			after('s', 0, 'ş', 'Ş').
+			after('u', 0, 'ü', 'Ü')},
+		// German phone book: ä is ae, ö is oe and ü is ue.
+		"utf8mb4_de_pb_0900_ai_ci": {tailoring0900{}.
+			as("ae", 'ä', 'Ä').
+			as("oe", 'ö', 'Ö').
+			as("ue", 'ü', 'Ü')},
+	}
+}
@@ -0,0 +1,2624 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collate
+
+/* Data from allkeys.txt(http://www.unicode.org/Public/UCA/13.0.0/allkeys.txt). Unicode version '13.0.0'. Primary weights only. Do not EDIT */
+var (
+	mapTable0900 = []uint64{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0x201, 0x202, 0x203, 0x204, 0x205, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x209, 0x267, 0x31D, 0x3AC, 0x1F64, 0x3AD, 0x3A9, 0x316, 0x328, 0x329, 0x3A1, 0x666, 0x223, 0x20D, 0x27E, 0x3A6, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x240, 0x23A, 0x66A, 0x66B, 0x66C, 0x26D,
+		0x3A0, 0x1FA2, 0x1FBC, 0x1FD6, 0x1FEB, 0x2007, 0x2042, 0x2051, 0x2075, 0x2090, 0x20AB, 0x20C4, 0x20D6, 0x2109, 0x2118, 0x213C, 0x216B, 0x2180, 0x2193, 0x21D2, 0x21F7, 0x2217, 0x2247, 0x2259, 0x2264, 0x2270, 0x2286, 0x32A, 0x3A7, 0x32B, 0x4B7, 0x20B,
+		0x4B4, 0x1FA2, 0x1FBC, 0x1FD6, 0x1FEB, 0x2007, 0x2042, 0x2051, 0x2075, 0x2090, 0x20AB, 0x20C4, 0x20D6, 0x2109, 0x2118, 0x213C, 0x216B, 0x2180, 0x2193, 0x21D2, 0x21F7, 0x2217, 0x2247, 0x2259, 0x2264, 0x2270, 0x2286, 0x32C, 0x66E, 0x32D, 0x670, 0,
+		0, 0, 0, 0, 0, 0x206, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x209, 0x268, 0x1F63, 0x1F65, 0x1F62, 0x1F66, 0x66F, 0x39A, 0x4BB, 0x5D2, 0x1FA2, 0x326, 0x66D, 0, 0x5D4, 0x4B8, 0x52A, 0x667, 0x1F9A, 0x1F9B, 0x4B5, 0x2330, 0x39C, 0x293, 0x4BE, 0x1F99, 0x213C, 0x327, 0x1F9C06761F99, 0x1F9A06761F99, 0x1F9C06761F9B, 0x26E,
+		0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x20071FA2, 0x1FD6, 0x2007, 0x2007, 0x2007, 0x2007, 0x2090, 0x2090, 0x2090, 0x2090, 0x1FEB, 0x2118, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x669, 0x213C, 0x2217, 0x2217, 0x2217, 0x2217, 0x2270, 0x22B5, 0x21D221D2,
+		0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x20071FA2, 0x1FD6, 0x2007, 0x2007, 0x2007, 0x2007, 0x2090, 0x2090, 0x2090, 0x2090, 0x1FEB, 0x2118, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x668, 0x213C, 0x2217, 0x2217, 0x2217, 0x2217, 0x2270, 0x22B5, 0x2270,
+		0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FD6, 0x1FD6, 0x1FD6, 0x1FD6, 0x1FD6, 0x1FD6, 0x1FD6, 0x1FD6, 0x1FEB, 0x1FEB, 0x1FEB, 0x1FEB, 0x2007, 0x2007, 0x2007, 0x2007, 0x2007, 0x2007, 0x2007, 0x2007, 0x2007, 0x2007, 0x2051, 0x2051, 0x2051, 0x2051,
+		0x2051, 0x2051, 0x2051, 0x2051, 0x2075, 0x2075, 0x2075, 0x2075, 0x2090, 0x2090, 0x2090, 0x2090, 0x2090, 0x2090, 0x2090, 0x2090, 0x2090, 0x2094, 0x20AB2090, 0x20AB2090, 0x20AB, 0x20AB, 0x20C4, 0x20C4, 0x218F, 0x20D6, 0x20D6, 0x20D6, 0x20D6, 0x20D6, 0x20D6, 0x20D6,
+		0x20D6, 0x20D6, 0x20D6, 0x2118, 0x2118, 0x2118, 0x2118, 0x2118, 0x2118, 0x211822E3, 0x2137, 0x2137, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x2007213C, 0x2007213C, 0x2193, 0x2193, 0x2193, 0x2193, 0x2193, 0x2193, 0x21D2, 0x21D2, 0x21D2, 0x21D2, 0x21D2, 0x21D2,
+		0x21D2, 0x21D2, 0x21F7, 0x21F7, 0x21F7, 0x21F7, 0x21FC, 0x21FC, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2259, 0x2259, 0x2270, 0x2270, 0x2270, 0x2286, 0x2286, 0x2286, 0x2286, 0x2286, 0x2286, 0x21D2,
+		0x1FC4, 0x1FCD, 0x1FD1, 0x1FD1, 0x22D6, 0x22D6, 0x214F, 0x1FE1, 0x1FE1, 0x1FF4, 0x1FF8, 0x1FFD, 0x1FFD, 0x22592286, 0x2015, 0x201A, 0x201F, 0x204B, 0x204B, 0x2063, 0x206D, 0x207D, 0x20A6, 0x209F, 0x20CA, 0x20CA, 0x20E1, 0x2101, 0x2238, 0x2123, 0x2127, 0x215C,
+		0x213C, 0x213C, 0x2071, 0x2071, 0x2174, 0x2174, 0x2198, 0x22CE, 0x22CE, 0x21E4, 0x21EA, 0x2202, 0x2206, 0x2206, 0x220A, 0x2217, 0x2217, 0x2242, 0x224E, 0x227C, 0x227C, 0x228B, 0x228B, 0x22A3, 0x22A8, 0x22A8, 0x22AD, 0x22C7, 0x22D2, 0x22D2, 0x21D221F7, 0x22BB,
+		0x22FE, 0x2302, 0x2306, 0x230A, 0x22861FEB, 0x22861FEB, 0x22861FEB, 0x20AB20D6, 0x20AB20D6, 0x20AB20D6, 0x20AB2118, 0x20AB2118, 0x20AB2118, 0x1FA2, 0x1FA2, 0x2090, 0x2090, 0x213C, 0x213C, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2015, 0x1FA2, 0x1FA2,
+		0x1FA2, 0x1FA2, 0x20071FA2, 0x20071FA2, 0x205E, 0x205E, 0x2051, 0x2051, 0x20C4, 0x20C4, 0x213C, 0x213C, 0x213C, 0x213C, 0x22A3, 0x22A3, 0x20AB, 0x22861FEB, 0x22861FEB, 0x22861FEB, 0x2051, 0x2051, 0x207D, 0x22BB, 0x2118, 0x2118, 0x1FA2, 0x1FA2, 0x20071FA2, 0x20071FA2, 0x213C, 0x213C,
+		0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x2007, 0x2007, 0x2007, 0x2007, 0x2090, 0x2090, 0x2090, 0x2090, 0x213C, 0x213C, 0x213C, 0x213C, 0x2193, 0x2193, 0x2193, 0x2193, 0x2217, 0x2217, 0x2217, 0x2217, 0x21D2, 0x21D2, 0x21F7, 0x21F7, 0x2282, 0x2282, 0x2075, 0x2075,
+		0x2127, 0x2001, 0x2166, 0x2166, 0x2291, 0x2291, 0x1FA2, 0x1FA2, 0x2007, 0x2007, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x2270, 0x2270, 0x20F7, 0x2131, 0x220E, 0x20AF, 0x1FBC1FEB, 0x216B2180, 0x1FA7, 0x1FDB, 0x1FDB, 0x20E1, 0x2200, 0x21DE,
+		0x229D, 0x22DE, 0x22DE, 0x1FC4, 0x2222, 0x2255, 0x200E, 0x200E, 0x20B4, 0x20B4, 0x218B, 0x218B, 0x219F, 0x219F, 0x2278, 0x2278, 0x1FAD, 0x1FB1, 0x1FB7, 0x1FCD, 0x214F, 0x1FE5, 0x1FF4, 0x1FF8, 0x2024, 0x201A, 0x2028, 0x201F, 0x202C, 0x2032, 0x2036, 0x20BC,
+		0x2063, 0x2055, 0x205A, 0x206D, 0x203E, 0x222C, 0x2082, 0x208A, 0x209F, 0x20A6, 0x2098, 0x20E6, 0x20EC, 0x20F2, 0x20FC, 0x2238, 0x223E, 0x2110, 0x2123, 0x212D, 0x211C, 0x215C, 0x2143, 0x2161, 0x217B, 0x21A4, 0x21A9, 0x21AE, 0x21B3, 0x21B7, 0x21BC, 0x21C1,
+		0x2198, 0x21CA, 0x21DA, 0x21E4, 0x20C0, 0x21EE, 0x21F3, 0x2213, 0x220A, 0x2222, 0x2242, 0x224E, 0x2255, 0x2260, 0x2105, 0x2274, 0x2295, 0x2299, 0x22A3, 0x22B1, 0x22DA, 0x22E9, 0x22FA, 0x230E, 0x2312, 0x1FC0, 0x203A, 0x2067, 0x2079, 0x20B8, 0x20D2, 0x20DA,
+		0x2187, 0x22F2, 0x22F6, 0x22861FEB, 0x22A31FEB, 0x22991FEB, 0x21D221F7, 0x21E421F7, 0x1FE521F7, 0x21372042, 0x21D220D6, 0x228620D6, 0x2316, 0x231A, 0x2230, 0x2234, 0x2075, 0x2082, 0x20AB, 0x2193, 0x21A4, 0x21AE, 0x21CA, 0x2259, 0x2270, 0x4C5, 0x4C7, 0x208E, 0x22E3, 0x208F, 0x22E5, 0x22ED,
+		0x22E2, 0x22EE, 0x4C8, 0x4C9, 0x4CA, 0x4CB, 0x4CC, 0x4CD, 0x4CE, 0x4CF, 0x4D0, 0x4D1, 0x4D2, 0x4D3, 0x4D4, 0x4D5, 0x1F46, 0x1F47, 0x4D6, 0x4D7, 0x4D8, 0x4D9, 0x4DC, 0x4DD, 0x4B9, 0x4BA, 0x4BC, 0x4BF, 0x4B6, 0x4BD, 0x4DE, 0x4DF,
+		0x206D, 0x20D6, 0x21D2, 0x2264, 0x22E9, 0x4E0, 0x4E1, 0x4E2, 0x4E3, 0x4E4, 0x4E5, 0x4E6, 0x4E7, 0x4E8, 0x22E4, 0x4E9, 0x4EA, 0x4EB, 0x4EC, 0x4ED, 0x4EE, 0x4EF, 0x4F0, 0x4F1, 0x4F2, 0x4F3, 0x4F4, 0x4F5, 0x4F6, 0x4F7, 0x4F8, 0x4F9,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0x1FA2, 0x2007, 0x2090, 0x213C, 0x2217, 0x1FD6, 0x1FEB, 0x2075, 0x2109, 0x2193, 0x21F7, 0x2247, 0x2264, 0x2328, 0x2328, 0x2349, 0x2349, 0x4C5, 0x4C6, 0x2325, 0x2325, 0x8378FBC0, 0x8379FBC0, 0x232B, 0x233E, 0x233D, 0x233F, 0x23A, 0x232C,
+		0x8380FBC0, 0x8381FBC0, 0x8382FBC0, 0x8383FBC0, 0x4B5, 0x4BB, 0x231E, 0x293, 0x2323, 0x2329, 0x232B, 0x838BFBC0, 0x2333, 0x838DFBC0, 0x2341, 0x2346, 0x232B, 0x231E, 0x231F, 0x2320, 0x2322, 0x2323, 0x2327, 0x2329, 0x232A, 0x232B, 0x232D, 0x232E, 0x2330, 0x2331, 0x2332, 0x2333,
+		0x2334, 0x2339, 0x83A2FBC0, 0x233C, 0x2340, 0x2341, 0x2342, 0x2343, 0x2344, 0x2346, 0x232B, 0x2341, 0x231E, 0x2323, 0x2329, 0x232B, 0x2341, 0x231E, 0x231F, 0x2320, 0x2322, 0x2323, 0x2327, 0x2329, 0x232A, 0x232B, 0x232D, 0x232E, 0x2330, 0x2331, 0x2332, 0x2333,
+		0x2334, 0x2339, 0x233C, 0x233C, 0x2340, 0x2341, 0x2342, 0x2343, 0x2344, 0x2346, 0x232B, 0x2341, 0x2333, 0x2341, 0x2346, 0x232B231E232D, 0x231F, 0x232A, 0x2341, 0x2341, 0x2341, 0x2342, 0x2334, 0x232B231E232D, 0x2338, 0x2338, 0x2326, 0x2326, 0x2324, 0x2324, 0x2337, 0x2337,
+		0x2348, 0x2348, 0x236A, 0x236A, 0x236F, 0x236F, 0x2370, 0x2370, 0x2373, 0x2373, 0x237A, 0x237A, 0x237D, 0x237D, 0x2381, 0x2381, 0x232D, 0x2339, 0x233C, 0x232C, 0x232A, 0x2323, 0x661, 0x234A, 0x234A, 0x233C, 0x2336, 0x2336, 0x233B, 0x233E, 0x233D, 0x233F,
+		0x23BF, 0x23BF, 0x23B5, 0x239B, 0x23C3, 0x23D9, 0x23ED, 0x23ED, 0x23F6, 0x2421, 0x2447, 0x247E, 0x23FB, 0x23E5, 0x2482, 0x24E4, 0x2387, 0x2393, 0x2397, 0x239B, 0x23AF, 0x23BF, 0x23C7, 0x23D1, 0x23E5, 0x23F2, 0x23FB, 0x2415, 0x2428, 0x2431, 0x244C, 0x2454,
+		0x2461, 0x246A, 0x2473, 0x2482, 0x2493, 0x2497, 0x24BE, 0x24C9, 0x24E8, 0x24ED, 0x24F4, 0x24F9, 0x24FD, 0x250A, 0x250E, 0x2514, 0x2387, 0x2393, 0x2397, 0x239B, 0x23AF, 0x23BF, 0x23C7, 0x23D1, 0x23E5, 0x23F2, 0x23FB, 0x2415, 0x2428, 0x2431, 0x244C, 0x2454,
+		0x2461, 0x246A, 0x2473, 0x2482, 0x2493, 0x2497, 0x24BE, 0x24C9, 0x24E8, 0x24ED, 0x24F4, 0x24F9, 0x24FD, 0x250A, 0x250E, 0x2514, 0x23BF, 0x23BF, 0x23B5, 0x239B, 0x23C3, 0x23D9, 0x23ED, 0x23ED, 0x23F6, 0x2421, 0x2447, 0x247E, 0x23FB, 0x23E5, 0x2482, 0x24E4,
+		0x24AD, 0x24AD, 0x2505, 0x2505, 0x2519, 0x2519, 0x251D, 0x251D, 0x2527, 0x2527, 0x2522, 0x2522, 0x252C, 0x252C, 0x2530, 0x2530, 0x2534, 0x2534, 0x2538, 0x2538, 0x253C, 0x253C, 0x253C, 0x253C, 0x248F, 0x248F, 0x24BA, 0x24BA, 0x24B6, 0x24B6, 0x24B1, 0x24B1,
+		0x245D, 0x245D, 0x52B, 0, 0, 0, 0, 0, 0, 0, 0x23E9, 0x23E9, 0x2501, 0x2501, 0x2465, 0x2465, 0x239B, 0x239B, 0x239F, 0x239F, 0x23A7, 0x23A7, 0x23CD, 0x23CD, 0x23BB, 0x23BB, 0x23FF, 0x23FF, 0x240F, 0x240F, 0x240B, 0x240B,
+		0x2407, 0x2407, 0x243A, 0x243A, 0x2443, 0x2443, 0x2459, 0x2459, 0x2541, 0x2541, 0x246F, 0x246F, 0x2479, 0x2479, 0x2486, 0x2486, 0x248A, 0x248A, 0x24A3, 0x24A3, 0x24C4, 0x24C4, 0x24CF, 0x24CF, 0x24D7, 0x24D7, 0x24A7, 0x24A7, 0x24DC, 0x24DC, 0x24E0, 0x24E0,
+		0x2546, 0x23C7, 0x23C7, 0x2403, 0x2403, 0x241A, 0x241A, 0x243E, 0x243E, 0x2436, 0x2436, 0x24D3, 0x24D3, 0x242C, 0x242C, 0x2546, 0x2387, 0x2387, 0x2387, 0x2387, 0x238F, 0x238F, 0x23BF, 0x23BF, 0x238B, 0x238B, 0x238B, 0x238B, 0x23C7, 0x23C7, 0x23D1, 0x23D1,
+		0x23DE, 0x23DE, 0x23E5, 0x23E5, 0x23E5, 0x23E5, 0x244C, 0x244C, 0x2450, 0x2450, 0x2450, 0x2450, 0x250A, 0x250A, 0x2482, 0x2482, 0x2482, 0x2482, 0x2482, 0x2482, 0x24C9, 0x24C9, 0x23AB, 0x23AB, 0x24F9, 0x24F9, 0x23A3, 0x23A3, 0x249B, 0x249B, 0x249F, 0x249F,
+		0x23B3, 0x23B3, 0x23BA, 0x23BA, 0x23D6, 0x23D6, 0x23E3, 0x23E3, 0x2426, 0x2426, 0x244B, 0x244B, 0x246E, 0x246E, 0x2478, 0x2478, 0x23D7, 0x23D7, 0x241F, 0x241F, 0x2427, 0x2427, 0x2469, 0x2469, 0x2518, 0x2518, 0x2414, 0x2414, 0x2545, 0x2545, 0x2413, 0x2413,
+		0x2420, 0x2420, 0x2442, 0x2442, 0x2458, 0x2458, 0x24AB, 0x24AB, 0x2435, 0x2435, 0x23CB, 0x23CB, 0x24CD, 0x24CD, 0x241E, 0x241E, 0x8530FBC0, 0x25F5, 0x25F7, 0x25F8, 0x25F9, 0x25FA, 0x25FB, 0x25FC, 0x25FD, 0x25FE, 0x25FF, 0x2600, 0x2601, 0x2602, 0x2603, 0x2604,
+		0x2605, 0x2606, 0x2607, 0x2608, 0x2609, 0x260A, 0x260C, 0x260D, 0x260E, 0x260F, 0x2610, 0x2611, 0x2612, 0x2613, 0x2614, 0x2615, 0x2616, 0x2617, 0x2618, 0x2619, 0x261A, 0x261B, 0x261C, 0x8557FBC0, 0x8558FBC0, 0x261D, 0x3E4, 0x3E5, 0x269, 0x22A, 0x270, 0x3E6,
+		0x25F6, 0x25F5, 0x25F7, 0x25F8, 0x25F9, 0x25FA, 0x25FB, 0x25FC, 0x25FD, 0x25FE, 0x25FF, 0x2600, 0x2601, 0x2602, 0x2603, 0x2604, 0x2605, 0x2606, 0x2607, 0x2608, 0x2609, 0x260A, 0x260C, 0x260D, 0x260E, 0x260F, 0x2610, 0x2611, 0x2612, 0x2613, 0x2614, 0x2615,
+		0x2616, 0x2617, 0x2618, 0x2619, 0x261A, 0x261B, 0x261C, 0x261825FA, 0x260B, 0x241, 0x20E, 0x858BFBC0, 0x858CFBC0, 0x52C, 0x52D, 0x1F67, 0x8590FBC0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x3E7, 0,
+		0x3E8, 0, 0, 0x3E9, 0, 0, 0x3EA, 0, 0x85C8FBC0, 0x85C9FBC0, 0x85CAFBC0, 0x85CBFBC0, 0x85CCFBC0, 0x85CDFBC0, 0x85CEFBC0, 0x85CFFBC0, 0x261E, 0x261F, 0x2620, 0x2621, 0x2622, 0x2623, 0x2624, 0x2625, 0x2626, 0x2627, 0x2628, 0x2628, 0x2629, 0x262A, 0x262A, 0x262B,
+		0x262B, 0x262C, 0x262D, 0x262E, 0x262E, 0x262F, 0x262F, 0x2630, 0x2631, 0x2632, 0x2633, 0x85EBFBC0, 0x85ECFBC0, 0x85EDFBC0, 0x85EEFBC0, 0x2622262326222627, 0x26232623, 0x26272623, 0x26272627, 0x3EB, 0x3EC, 0x85F5FBC0, 0x85F6FBC0, 0x85F7FBC0, 0x85F8FBC0, 0x85F9FBC0, 0x85FAFBC0, 0x85FBFBC0, 0x85FCFBC0, 0x85FDFBC0, 0x85FEFBC0, 0x85FFFBC0,
+		0, 0, 0, 0, 0, 0, 0x67D, 0x67F, 0x52E, 0x3B0, 0x3B2, 0x1F68, 0x22B, 0x22C, 0x531, 0x532, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x23B, 0, 0x861DFBC0, 0x242, 0x271,
+		0x2740, 0x2664, 0x2665, 0x2666, 0x2669, 0x266A, 0x266E, 0x2672, 0x2674, 0x2684, 0x2685, 0x2686, 0x268F, 0x2699, 0x269A, 0x26A4, 0x26A5, 0x26B3, 0x26B4, 0x26C6, 0x26C7, 0x26D1, 0x26D2, 0x26D7, 0x26D8, 0x26DB, 0x26DC, 0x2707, 0x2708, 0x273D, 0x273E, 0x273F,
+		0, 0x26E4, 0x26F0, 0x26F6, 0x270C, 0x2714, 0x2718, 0x2722, 0x2728, 0x2736, 0x2737, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x3AE, 0x22D, 0x22E, 0x3A4, 0x2673, 0x26EF, 0, 0x2668, 0x2667, 0x266B, 0x2664, 0x26642672, 0x26642728, 0x2664272C, 0x26642737, 0x2687, 0x2688, 0x2675, 0x2689, 0x268A, 0x2676, 0x268B,
+		0x2677, 0x269B, 0x269C, 0x2690, 0x2691, 0x269D, 0x2692, 0x2694, 0x26A6, 0x26A7, 0x26A8, 0x26A9, 0x26AA, 0x26AB, 0x26AD, 0x26AE, 0x26AF, 0x26B5, 0x26B6, 0x26B7, 0x26B8, 0x26B9, 0x26BA, 0x26BB, 0x26BC, 0x26BD, 0x26C8, 0x26C9, 0x26CA, 0x26D3, 0x26D5, 0x26D9,
+		0x26DD, 0x26E5, 0x26E6, 0x26E8, 0x26E9, 0x26EB, 0x26EC, 0x26F1, 0x26F3, 0x26F7, 0x26F8, 0x26F9, 0x26FA, 0x26FC, 0x26FD, 0x26FF, 0x2701, 0x2702, 0x2703, 0x2704, 0x2705, 0x270D, 0x270E, 0x270F, 0x2710, 0x271E, 0x2719, 0x271B, 0x271C, 0x271D, 0x2723, 0x2693,
+		0x2727, 0x2724, 0x2724, 0x2725, 0x2729, 0x272A, 0x272B, 0x272C, 0x272D, 0x272E, 0x272F, 0x2730, 0x2738, 0x2739, 0x273A, 0x2732, 0x273B, 0x273C, 0x2745, 0x2745, 0x280, 0x2727, 0, 0, 0, 0, 0, 0, 0, 0, 0x533, 0,
+		0, 0, 0, 0, 0, 0x2728, 0x2737, 0, 0, 0x534, 0, 0, 0, 0, 0x26B0, 0x26BE, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x26CB, 0x26D6, 0x26DF, 0x2664, 0x2714, 0x2726,
+		0x2CD, 0x281, 0x282, 0x243, 0x244, 0x245, 0x246, 0x247, 0x248, 0x272, 0x3ED, 0x3EE, 0x3EF, 0x3F0, 0x870EFBC0, 0, 0x2748, 0, 0x2749, 0x274A, 0x274A, 0x274C, 0x274B, 0x274D, 0x274E, 0x274F, 0x2751, 0x2752, 0x2752, 0x2753, 0x2754, 0x2755,
+		0x2757, 0x2758, 0x2759, 0x275A, 0x275A, 0x275B, 0x275C, 0x275C, 0x275E, 0x275F, 0x2760, 0x2761, 0x2762, 0x2749, 0x274A, 0x274C, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x874BFBC0, 0x874CFBC0, 0x2750, 0x2756, 0x275D, 0x2678, 0x2679, 0x267A, 0x267B, 0x267C, 0x267D, 0x267F, 0x269E, 0x269F, 0x26B1, 0x26B2, 0x26BF, 0x26CC, 0x26E0, 0x26E1, 0x26E2,
+		0x26ED, 0x26EE, 0x2706, 0x2709, 0x270A, 0x2715, 0x2716, 0x271F, 0x2720, 0x2721, 0x2711, 0x26C0, 0x26C1, 0x26CD, 0x26A0, 0x26A1, 0x26CE, 0x26C2, 0x26A2, 0x266C, 0x266D, 0x2741, 0x2742, 0x2743, 0x2733, 0x2734, 0x2746, 0x2747, 0x26A3, 0x26CF, 0x26D0, 0x26FB,
+		0x2787, 0x278A, 0x278B, 0x278C, 0x278E, 0x278F, 0x2790, 0x2791, 0x2794, 0x2796, 0x2797, 0x2798, 0x279A, 0x279E, 0x279F, 0x27A1, 0x27A2, 0x27A6, 0x27A7, 0x27A8, 0x27A9, 0x27AA, 0x27AB, 0x27AC, 0x279B, 0x2788, 0x2789, 0x2799, 0x278D, 0x27A3, 0x27A4, 0x27A5,
+		0x279C, 0x279D, 0x2792, 0x2793, 0x27A0, 0x2795, 0x27AE, 0x27AF, 0x27B0, 0x27B1, 0x27B2, 0x27B3, 0x27B4, 0x27B5, 0x27B6, 0x27B7, 0x27B8, 0x27AD, 0x87B2FBC0, 0x87B3FBC0, 0x87B4FBC0, 0x87B5FBC0, 0x87B6FBC0, 0x87B7FBC0, 0x87B8FBC0, 0x87B9FBC0, 0x87BAFBC0, 0x87BBFBC0, 0x87BCFBC0, 0x87BDFBC0, 0x87BEFBC0, 0x87BFFBC0,
+		0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x27B9, 0x27BA, 0x27BB, 0x27BC, 0x27BD, 0x27BE, 0x27BF, 0x27C0, 0x27C1, 0x27C2, 0x27C3, 0x27C4, 0x27C5, 0x27C6, 0x27C7, 0x27C8, 0x27C9, 0x27CA, 0x27CB, 0x27CC, 0x27CD, 0x27CE,
+		0x27CF, 0x27D0, 0x27D1, 0x27D2, 0x27D3, 0x27D4, 0x27D5, 0x27D6, 0x27C5, 0x27C6, 0x27C8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x27D7, 0x27D8, 0x546, 0x2CE, 0x22F, 0x26A, 0, 0x87FBFBC0, 0x87FCFBC0, 0, 0x1F69, 0x1F6A,
+		0x264A, 0x264B, 0x264C, 0x264D, 0x264E, 0x264F, 0x2650, 0x2651, 0x2652, 0x2653, 0x2654, 0x2655, 0x2656, 0x2657, 0x2658, 0x2659, 0x265A, 0x265B, 0x265C, 0x265D, 0x265E, 0x265F, 0x2660, 0x2661, 0, 0, 0x2662, 0x2663, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x882EFBC0, 0x882FFBC0, 0x249, 0x24A, 0x24B, 0x24C, 0x24D, 0x24E, 0x24F, 0x250, 0x251, 0x252, 0x253, 0x254, 0x255, 0x256, 0x257, 0x883FFBC0,
+		0x276E, 0x276F, 0x2770, 0x2771, 0x2772, 0x2773, 0x2774, 0x2775, 0x2776, 0x2777, 0x2778, 0x2779, 0x277A, 0x277B, 0x277C, 0x277D, 0x277E, 0x277F, 0x2780, 0x2781, 0x2782, 0x2783, 0x2784, 0x2785, 0x2786, 0, 0, 0, 0x885CFBC0, 0x885DFBC0, 0x3F1, 0x885FFBC0,
+		0x2763, 0x2764, 0x2765, 0x2766, 0x2767, 0x2768, 0x2769, 0x276A, 0x276B, 0x276C, 0x276D, 0x886BFBC0, 0x886CFBC0, 0x886DFBC0, 0x886EFBC0, 0x886FFBC0, 0x8870FBC0, 0x8871FBC0, 0x8872FBC0, 0x8873FBC0, 0x8874FBC0, 0x8875FBC0, 0x8876FBC0, 0x8877FBC0, 0x8878FBC0, 0x8879FBC0, 0x887AFBC0, 0x887BFBC0, 0x887CFBC0, 0x887DFBC0, 0x887EFBC0, 0x887FFBC0,
+		0x8880FBC0, 0x8881FBC0, 0x8882FBC0, 0x8883FBC0, 0x8884FBC0, 0x8885FBC0, 0x8886FBC0, 0x8887FBC0, 0x8888FBC0, 0x8889FBC0, 0x888AFBC0, 0x888BFBC0, 0x888CFBC0, 0x888DFBC0, 0x888EFBC0, 0x888FFBC0, 0x8890FBC0, 0x8891FBC0, 0x8892FBC0, 0x8893FBC0, 0x8894FBC0, 0x8895FBC0, 0x8896FBC0, 0x8897FBC0, 0x8898FBC0, 0x8899FBC0, 0x889AFBC0, 0x889BFBC0, 0x889CFBC0, 0x889DFBC0, 0x889EFBC0, 0x889FFBC0,
+		0x267E, 0x2681, 0x2695, 0x26DA, 0x26EA, 0x26F5, 0x2712, 0x2717, 0x266F, 0x2670, 0x26C3, 0x2735, 0x2671, 0x2672, 0x26AC, 0x26D4, 0x2700, 0x2731, 0x26C4, 0x26E3, 0x26FE, 0x88B5FBC0, 0x2682, 0x2683, 0x268C, 0x26C5, 0x2744, 0x26E7, 0x26F2, 0x271A, 0x2680, 0x268D,
+		0x268E, 0x2696, 0x270B, 0x26DE, 0x26F4, 0x2697, 0x2698, 0x2713, 0x88C8FBC0, 0x88C9FBC0, 0x88CAFBC0, 0x88CBFBC0, 0x88CCFBC0, 0x88CDFBC0, 0x88CEFBC0, 0x88CFFBC0, 0x88D0FBC0, 0x88D1FBC0, 0x88D2FBC0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0x29DA, 0x29DB, 0x29DC, 0x29E2, 0x29E3, 0x29E4, 0x29E5, 0x29E6, 0x29E8, 0x29EA, 0x29EB, 0x29EC, 0x29ED, 0x29EF, 0x29F0, 0x29F1, 0x29F2, 0x29F3, 0x29F4, 0x29F5, 0x29F7, 0x29F8, 0x29F9, 0x29FA, 0x29FB, 0x29FE, 0x29FF, 0x2A00,
+		0x2A01, 0x2A03, 0x2A05, 0x2A06, 0x2A07, 0x2A08, 0x2A09, 0x2A0A, 0x2A0B, 0x2A0B, 0x2A0C, 0x2A0D, 0x2A0E, 0x2A10, 0x2A11, 0x2A12, 0x2A14, 0x2A14, 0x2A15, 0x2A16, 0x2A16, 0x2A17, 0x2A18, 0x2A19, 0x2A1A, 0x2A1B, 0x2A24, 0x2A25, 0, 0x2A1C, 0x2A23, 0x2A29,
+		0x2A2A, 0x2A2B, 0x2A2C, 0x2A2D, 0x2A2E, 0x2A31, 0x2A33, 0x2A34, 0x2A36, 0x2A38, 0x2A39, 0x2A3A, 0x2A3B, 0x2A3C, 0x2A35, 0x2A26, 0x29D7, 0, 0, 0, 0, 0x2A32, 0x2A27, 0x2A28, 0x29F3, 0x29F4, 0x29F5, 0x29FB, 0x2A03, 0x2A05, 0x2A0D, 0x2A12,
+		0x29E7, 0x29E9, 0x2A2F, 0x2A30, 0x296, 0x297, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x400, 0x1F48, 0x29D9, 0x29DD, 0x29DE, 0x29DF, 0x29E0, 0x29E1, 0x2A02, 0x29FC, 0x2A13, 0x29F6, 0x29FD, 0x2A1D, 0x2A04, 0x2A0F,
+		0x2A3D, 0, 0, 0, 0x8984FBC0, 0x2A3E, 0x2A3F, 0x2A40, 0x2A41, 0x2A42, 0x2A43, 0x2A44, 0x2A46, 0x898DFBC0, 0x898EFBC0, 0x2A48, 0x2A49, 0x8991FBC0, 0x8992FBC0, 0x2A4A, 0x2A4B, 0x2A4C, 0x2A4D, 0x2A4E, 0x2A4F, 0x2A50, 0x2A51, 0x2A52, 0x2A53, 0x2A54, 0x2A55, 0x2A56,
+		0x2A57, 0x2A58, 0x2A59, 0x2A5A, 0x2A5B, 0x2A5C, 0x2A5D, 0x2A5E, 0x2A5F, 0x89A9FBC0, 0x2A60, 0x2A61, 0x2A62, 0x2A63, 0x2A64, 0x2A65, 0x2A66, 0x89B1FBC0, 0x2A68, 0x89B3FBC0, 0x89B4FBC0, 0x89B5FBC0, 0x2A6A, 0x2A6B, 0x2A6C, 0x2A6D, 0x89BAFBC0, 0x89BBFBC0, 0, 0x2A6E, 0x2A6F, 0x2A70,
+		0x2A71, 0x2A72, 0x2A73, 0x2A74, 0x2A75, 0x89C5FBC0, 0x89C6FBC0, 0x2A78, 0x2A79, 0x89C9FBC0, 0x89CAFBC0, 0x2A7A, 0x2A7B, 0x2A7C, 0x2A7C2A5B, 0x89CFFBC0, 0x89D0FBC0, 0x89D1FBC0, 0x89D2FBC0, 0x89D3FBC0, 0x89D4FBC0, 0x89D5FBC0, 0x89D6FBC0, 0x2A7D, 0x89D8FBC0, 0x89D9FBC0, 0x89DAFBC0, 0x89DBFBC0, 0x2A58, 0x2A59, 0x89DEFBC0, 0x2A65,
+		0x2A45, 0x2A47, 0x2A76, 0x2A77, 0x89E4FBC0, 0x89E5FBC0, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x2A67, 0x2A69, 0x1F6B, 0x1F6C, 0x1D56, 0x1D57, 0x1D58, 0x1D59, 0x1D5A, 0x1D5B, 0x547, 0x1F6D, 0x2A7E, 0x405, 0, 0x89FFFBC0,
+		0x8A00FBC0, 0, 0, 0, 0x8A04FBC0, 0x2A84, 0x2A85, 0x2A89, 0x2A8A, 0x2A81, 0x2A82, 0x8A0BFBC0, 0x8A0CFBC0, 0x8A0DFBC0, 0x8A0EFBC0, 0x2A8B, 0x2A86, 0x8A11FBC0, 0x8A12FBC0, 0x2A83, 0x2A87, 0x2A8F, 0x2A90, 0x2A91, 0x2A92, 0x2A93, 0x2A94, 0x2A95, 0x2A96, 0x2A97, 0x2A98, 0x2A99,
+		0x2A9A, 0x2A9B, 0x2A9C, 0x2A9D, 0x2A9E, 0x2A9F, 0x2AA0, 0x2AA1, 0x2AA2, 0x8A29FBC0, 0x2AA3, 0x2AA4, 0x2AA5, 0x2AA6, 0x2AA7, 0x2AA8, 0x2AAA, 0x8A31FBC0, 0x2AAB, 0x2AAB, 0x8A34FBC0, 0x2AAC, 0x2A8C, 0x8A37FBC0, 0x2A8C, 0x2A8D, 0x8A3AFBC0, 0x8A3BFBC0, 0, 0x8A3DFBC0, 0x2AAE, 0x2AAF,
+		0x2AB0, 0x2AB1, 0x2AB2, 0x8A43FBC0, 0x8A44FBC0, 0x8A45FBC0, 0x8A46FBC0, 0x2AB3, 0x2AB4, 0x8A49FBC0, 0x8A4AFBC0, 0x2AB5, 0x2AB6, 0x2AB7, 0x8A4EFBC0, 0x8A4FFBC0, 0x8A50FBC0, 0x2A8E, 0x8A52FBC0, 0x8A53FBC0, 0x8A54FBC0, 0x8A55FBC0, 0x8A56FBC0, 0x8A57FBC0, 0x8A58FBC0, 0x2A90, 0x2A91, 0x2A96, 0x2AAD, 0x8A5DFBC0, 0x2AA4, 0x8A5FFBC0,
+		0x8A60FBC0, 0x8A61FBC0, 0x8A62FBC0, 0x8A63FBC0, 0x8A64FBC0, 0x8A65FBC0, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0, 0, 0x2A88, 0x2A80, 0x2A7F, 0x2AA9, 0x406, 0x8A77FBC0, 0x8A78FBC0, 0x8A79FBC0, 0x8A7AFBC0, 0x8A7BFBC0, 0x8A7CFBC0, 0x8A7DFBC0, 0x8A7EFBC0, 0x8A7FFBC0,
+		0x8A80FBC0, 0, 0, 0, 0x8A84FBC0, 0x2AB9, 0x2ABA, 0x2ABB, 0x2ABC, 0x2ABD, 0x2ABE, 0x2ABF, 0x2AC1, 0x2AC3, 0x8A8EFBC0, 0x2AC4, 0x2AC5, 0x2AC6, 0x8A92FBC0, 0x2AC7, 0x2AC8, 0x2AC9, 0x2ACA, 0x2ACB, 0x2ACC, 0x2ACD, 0x2ACE, 0x2ACF, 0x2AD0, 0x2AD2, 0x2AD3, 0x2AD4,
+		0x2AD5, 0x2AD6, 0x2AD7, 0x2AD8, 0x2AD9, 0x2ADA, 0x2ADB, 0x2ADC, 0x2ADD, 0x8AA9FBC0, 0x2ADE, 0x2ADF, 0x2AE0, 0x2AE1, 0x2AE2, 0x2AE3, 0x2AE4, 0x8AB1FBC0, 0x2AE5, 0x2AEB, 0x8AB4FBC0, 0x2AE6, 0x2AE7, 0x2AE8, 0x2AE9, 0x2AEA, 0x8ABAFBC0, 0x8ABBFBC0, 0, 0x2AEC, 0x2AED, 0x2AEE,
+		0x2AEF, 0x2AF0, 0x2AF1, 0x2AF2, 0x2AF3, 0x2AF6, 0x8AC6FBC0, 0x2AF7, 0x2AF8, 0x2AF9, 0x8ACAFBC0, 0x2AFA, 0x2AFB, 0x2AFC, 0x8ACEFBC0, 0x8ACFFBC0, 0x2AB8, 0x8AD1FBC0, 0x8AD2FBC0, 0x8AD3FBC0, 0x8AD4FBC0, 0x8AD5FBC0, 0x8AD6FBC0, 0x8AD7FBC0, 0x8AD8FBC0, 0x8AD9FBC0, 0x8ADAFBC0, 0x8ADBFBC0, 0x8ADCFBC0, 0x8ADDFBC0, 0x8ADEFBC0, 0x8ADFFBC0,
+		0x2AC0, 0x2AC2, 0x2AF4, 0x2AF5, 0x8AE4FBC0, 0x8AE5FBC0, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x407, 0x1F6E, 0x8AF2FBC0, 0x8AF3FBC0, 0x8AF4FBC0, 0x8AF5FBC0, 0x8AF6FBC0, 0x8AF7FBC0, 0x8AF8FBC0, 0x2AD1, 0, 0, 0, 0, 0, 0,
+		0x8B00FBC0, 0, 0, 0, 0x8B04FBC0, 0x2AFD, 0x2AFE, 0x2AFF, 0x2B00, 0x2B01, 0x2B02, 0x2B03, 0x2B05, 0x8B0DFBC0, 0x8B0EFBC0, 0x2B07, 0x2B08, 0x8B11FBC0, 0x8B12FBC0, 0x2B09, 0x2B0A, 0x2B0B, 0x2B0C, 0x2B0D, 0x2B0E, 0x2B0F, 0x2B10, 0x2B11, 0x2B12, 0x2B13, 0x2B14, 0x2B15,
+		0x2B16, 0x2B17, 0x2B18, 0x2B19, 0x2B1A, 0x2B1B, 0x2B1C, 0x2B1D, 0x2B1E, 0x8B29FBC0, 0x2B1F, 0x2B20, 0x2B21, 0x2B22, 0x2B23, 0x2B24, 0x2B26, 0x8B31FBC0, 0x2B27, 0x2B28, 0x8B34FBC0, 0x2B29, 0x2B2B, 0x2B2C, 0x2B2D, 0x2B2E, 0x8B3AFBC0, 0x8B3BFBC0, 0, 0x2B2F, 0x2B30, 0x2B31,
+		0x2B32, 0x2B33, 0x2B34, 0x2B35, 0x2B36, 0x8B45FBC0, 0x8B46FBC0, 0x2B39, 0x2B3A, 0x8B49FBC0, 0x8B4AFBC0, 0x2B3B, 0x2B3C, 0x2B3D, 0x8B4EFBC0, 0x8B4FFBC0, 0x8B50FBC0, 0x8B51FBC0, 0x8B52FBC0, 0x8B53FBC0, 0x8B54FBC0, 0, 0x2B3E, 0x2B3F, 0x8B58FBC0, 0x8B59FBC0, 0x8B5AFBC0, 0x8B5BFBC0, 0x2B17, 0x2B18, 0x8B5EFBC0, 0x2B25,
+		0x2B04, 0x2B06, 0x2B37, 0x2B38, 0x8B64FBC0, 0x8B65FBC0, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x548, 0x2B2A, 0x1D5C, 0x1D5D, 0x1D5E, 0x1D5F, 0x1D60, 0x1D61, 0x8B78FBC0, 0x8B79FBC0, 0x8B7AFBC0, 0x8B7BFBC0, 0x8B7CFBC0, 0x8B7DFBC0, 0x8B7EFBC0, 0x8B7FFBC0,
+		0x8B80FBC0, 0x8B81FBC0, 0, 0x2B4D, 0x8B84FBC0, 0x2B41, 0x2B42, 0x2B43, 0x2B44, 0x2B45, 0x2B46, 0x8B8BFBC0, 0x8B8CFBC0, 0x8B8DFBC0, 0x2B47, 0x2B48, 0x2B49, 0x8B91FBC0, 0x2B4A, 0x2B4B, 0x2B4C, 0x2B4E, 0x8B96FBC0, 0x8B97FBC0, 0x8B98FBC0, 0x2B4F, 0x2B50, 0x8B9BFBC0, 0x2B60, 0x8B9DFBC0, 0x2B51, 0x2B52,
+		0x8BA0FBC0, 0x8BA1FBC0, 0x8BA2FBC0, 0x2B53, 0x2B54, 0x8BA5FBC0, 0x8BA6FBC0, 0x8BA7FBC0, 0x2B55, 0x2B5F, 0x2B56, 0x8BABFBC0, 0x8BACFBC0, 0x8BADFBC0, 0x2B57, 0x2B58, 0x2B59, 0x2B5E, 0x2B5A, 0x2B5D, 0x2B5C, 0x2B5B, 0x2B61, 0x2B62, 0x2B63, 0x2B64, 0x8BBAFBC0, 0x8BBBFBC0, 0x8BBCFBC0, 0x8BBDFBC0, 0x2B65, 0x2B66,
+		0x2B67, 0x2B68, 0x2B69, 0x8BC3FBC0, 0x8BC4FBC0, 0x8BC5FBC0, 0x2B6A, 0x2B6B, 0x2B6C, 0x8BC9FBC0, 0x2B6D, 0x2B6E, 0x2B6F, 0x2B70, 0x8BCEFBC0, 0x8BCFFBC0, 0x2B40, 0x8BD1FBC0, 0x8BD2FBC0, 0x8BD3FBC0, 0x8BD4FBC0, 0x8BD5FBC0, 0x8BD6FBC0, 0x2B71, 0x8BD8FBC0, 0x8BD9FBC0, 0x8BDAFBC0, 0x8BDBFBC0, 0x8BDCFBC0, 0x8BDDFBC0, 0x8BDEFBC0, 0x8BDFFBC0,
+		0x8BE0FBC0, 0x8BE1FBC0, 0x8BE2FBC0, 0x8BE3FBC0, 0x8BE4FBC0, 0x8BE5FBC0, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x1D68, 0x1D69, 0x1D6A, 0x549, 0x54A, 0x54B, 0x54C, 0x54D, 0x54E, 0x1F70, 0x54F, 0x8BFBFBC0, 0x8BFCFBC0, 0x8BFDFBC0, 0x8BFEFBC0, 0x8BFFFBC0,
+		0, 0, 0, 0, 0, 0x2B72, 0x2B73, 0x2B74, 0x2B75, 0x2B76, 0x2B77, 0x2B78, 0x2B7A, 0x8C0DFBC0, 0x2B7C, 0x2B7D, 0x2B7E, 0x8C11FBC0, 0x2B7F, 0x2B80, 0x2B81, 0x2B82, 0x2B83, 0x2B84, 0x2B85, 0x2B86, 0x2B87, 0x2B89, 0x2B8A, 0x2B8C, 0x2B8D, 0x2B8E,
+		0x2B8F, 0x2B90, 0x2B91, 0x2B92, 0x2B93, 0x2B94, 0x2B95, 0x2B96, 0x2B97, 0x8C29FBC0, 0x2B98, 0x2B99, 0x2B9A, 0x2B9B, 0x2B9C, 0x2B9D, 0x2B9E, 0x2B9F, 0x2BA0, 0x2BA6, 0x2BA7, 0x2BA1, 0x2BA2, 0x2BA3, 0x2BA4, 0x2BA5, 0x8C3AFBC0, 0x8C3BFBC0, 0x8C3CFBC0, 0x2BA9, 0x2BAA, 0x2BAB,
+		0x2BAC, 0x2BAD, 0x2BAE, 0x2BAF, 0x2BB0, 0x8C45FBC0, 0x2BB3, 0x2BB4, 0x2BB5, 0x8C49FBC0, 0x2BB6, 0x2BB7, 0x2BB8, 0x2BB9, 0x8C4EFBC0, 0x8C4FFBC0, 0x8C50FBC0, 0x8C51FBC0, 0x8C52FBC0, 0x8C53FBC0, 0x8C54FBC0, 0x2BBA, 0x2BBB, 0x8C57FBC0, 0x2B88, 0x2B8B, 0x2BA8, 0x8C5BFBC0, 0x8C5CFBC0, 0x8C5DFBC0, 0x8C5EFBC0, 0x8C5FFBC0,
+		0x2B79, 0x2B7B, 0x2BB1, 0x2BB2, 0x8C64FBC0, 0x8C65FBC0, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x8C70FBC0, 0x8C71FBC0, 0x8C72FBC0, 0x8C73FBC0, 0x8C74FBC0, 0x8C75FBC0, 0x8C76FBC0, 0x408, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F99, 0x1F9A, 0x1F9B, 0x569,
+		0x2BF3, 0, 0, 0, 0x409, 0x2BBC, 0x2BBD, 0x2BBE, 0x2BBF, 0x2BC0, 0x2BC1, 0x2BC2, 0x2BC4, 0x8C8DFBC0, 0x2BC6, 0x2BC7, 0x2BC8, 0x8C91FBC0, 0x2BC9, 0x2BCA, 0x2BCB, 0x2BCC, 0x2BCD, 0x2BCE, 0x2BCF, 0x2BD0, 0x2BD1, 0x2BD2, 0x2BD3, 0x2BD4, 0x2BD5, 0x2BD6,
+		0x2BD7, 0x2BD8, 0x2BD9, 0x2BDA, 0x2BDB, 0x2BDC, 0x2BDD, 0x2BDE, 0x2BDF, 0x8CA9FBC0, 0x2BE0, 0x2BE1, 0x2BE2, 0x2BE3, 0x2BE4, 0x2BE5, 0x2BE6, 0x2BE7, 0x2BE8, 0x2BEE, 0x8CB4FBC0, 0x2BE9, 0x2BEA, 0x2BEB, 0x2BEC, 0x2BED, 0x8CBAFBC0, 0x8CBBFBC0, 0, 0x2BF0, 0x2BF4, 0x2BF5,
+		0x2BF6, 0x2BF7, 0x2BF8, 0x2BF9, 0x2BFA, 0x8CC5FBC0, 0x2BFD, 0x2BFE, 0x2BFF, 0x8CC9FBC0, 0x2C00, 0x2C01, 0x2C02, 0x2C03, 0x8CCEFBC0, 0x8CCFFBC0, 0x8CD0FBC0, 0x8CD1FBC0, 0x8CD2FBC0, 0x8CD3FBC0, 0x8CD4FBC0, 0x2C04, 0x2C05, 0x8CD7FBC0, 0x8CD8FBC0, 0x8CD9FBC0, 0x8CDAFBC0, 0x8CDBFBC0, 0x8CDCFBC0, 0x8CDDFBC0, 0x2BEF, 0x8CDFFBC0,
+		0x2BC3, 0x2BC5, 0x2BFB, 0x2BFC, 0x8CE4FBC0, 0x8CE5FBC0, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x8CF0FBC0, 0x2BF1, 0x2BF2, 0x8CF3FBC0, 0x8CF4FBC0, 0x8CF5FBC0, 0x8CF6FBC0, 0x8CF7FBC0, 0x8CF8FBC0, 0x8CF9FBC0, 0x8CFAFBC0, 0x8CFBFBC0, 0x8CFCFBC0, 0x8CFDFBC0, 0x8CFEFBC0, 0x8CFFFBC0,
+		0, 0, 0, 0, 0x2C3E, 0x2C06, 0x2C07, 0x2C08, 0x2C09, 0x2C0B, 0x2C0C, 0x2C0D, 0x2C0F, 0x8D0DFBC0, 0x2C11, 0x2C12, 0x2C13, 0x8D11FBC0, 0x2C14, 0x2C15, 0x2C16, 0x2C17, 0x2C18, 0x2C19, 0x2C1A, 0x2C1B, 0x2C1C, 0x2C1D, 0x2C1E, 0x2C1F, 0x2C20, 0x2C21,
+		0x2C22, 0x2C23, 0x2C24, 0x2C25, 0x2C26, 0x2C27, 0x2C28, 0x2C29, 0x2C2A, 0x2C2B, 0x2C2C, 0x2C2D, 0x2C2E, 0x2C2F, 0x2C30, 0x2C31, 0x2C32, 0x2C3B, 0x2C33, 0x2C39, 0x2C3A, 0x2C34, 0x2C35, 0x2C36, 0x2C37, 0x2C38, 0x2C3C, 0x2C4F, 0x2C4F, 0x2C3D, 0x2C3F, 0x2C40,
+		0x2C41, 0x2C42, 0x2C43, 0x2C44, 0x2C45, 0x8D45FBC0, 0x2C48, 0x2C49, 0x2C4A, 0x8D49FBC0, 0x2C4B, 0x2C4C, 0x2C4D, 0x2C4F, 0x2C4F2C32, 0x56A, 0x8D50FBC0, 0x8D51FBC0, 0x8D52FBC0, 0x8D53FBC0, 0x2C4F2C30, 0x2C4F2C31, 0x2C4F2C3A, 0x2C4E, 0x1D80, 0x1D81, 0x1D82, 0x1D83, 0x1D84, 0x1D85, 0x1D86, 0x2C0A,
+		0x2C0E, 0x2C10, 0x2C46, 0x2C47, 0x8D64FBC0, 0x8D65FBC0, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x1D87, 0x1D88, 0x1D89, 0x1D8A, 0x1D8B, 0x1D8C, 0x1D8D, 0x1D8E, 0x1D8F, 0x56B, 0x2C4F2C25, 0x2C4F2C2A, 0x2C4F2C32, 0x2C4F2C33, 0x2C4F2C39, 0x2C4F2C17,
+		0x8D80FBC0, 0, 0, 0, 0x8D84FBC0, 0x2C50, 0x2C51, 0x2C52, 0x2C53, 0x2C54, 0x2C55, 0x2C56, 0x2C57, 0x2C58, 0x2C59, 0x2C5A, 0x2C5B, 0x2C5C, 0x2C5D, 0x2C5E, 0x2C5F, 0x2C60, 0x2C61, 0x8D97FBC0, 0x8D98FBC0, 0x8D99FBC0, 0x2C62, 0x2C63, 0x2C64, 0x2C65, 0x2C66, 0x2C67,
+		0x2C68, 0x2C69, 0x2C6A, 0x2C6B, 0x2C6C, 0x2C6D, 0x2C6E, 0x2C6F, 0x2C70, 0x2C71, 0x2C72, 0x2C73, 0x2C74, 0x2C75, 0x2C76, 0x2C77, 0x2C78, 0x2C79, 0x8DB2FBC0, 0x2C7A, 0x2C7B, 0x2C7C, 0x2C7D, 0x2C7E, 0x2C7F, 0x2C80, 0x2C81, 0x2C82, 0x8DBCFBC0, 0x2C83, 0x8DBEFBC0, 0x8DBFFBC0,
+		0x2C84, 0x2C85, 0x2C86, 0x2C87, 0x2C88, 0x2C89, 0x2C8A, 0x8DC7FBC0, 0x8DC8FBC0, 0x8DC9FBC0, 0x2C9C, 0x8DCBFBC0, 0x8DCCFBC0, 0x8DCDFBC0, 0x8DCEFBC0, 0x2C8B, 0x2C8C, 0x2C8D, 0x2C8E, 0x2C8F, 0x2C90, 0x8DD5FBC0, 0x2C91, 0x8DD7FBC0, 0x2C92, 0x2C96, 0x2C97, 0x2C98, 0x2C99, 0x2C9A, 0x2C9B, 0x2C94,
+		0x8DE0FBC0, 0x8DE1FBC0, 0x8DE2FBC0, 0x8DE3FBC0, 0x8DE4FBC0, 0x8DE5FBC0, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x8DF0FBC0, 0x8DF1FBC0, 0x2C93, 0x2C95, 0x40A, 0x8DF5FBC0, 0x8DF6FBC0, 0x8DF7FBC0, 0x8DF8FBC0, 0x8DF9FBC0, 0x8DFAFBC0, 0x8DFBFBC0, 0x8DFCFBC0, 0x8DFDFBC0, 0x8DFEFBC0, 0x8DFFFBC0,
+		0x8E00FBC0, 0x3217, 0x3218, 0x3219, 0x321A, 0x321B, 0x321C, 0x321D, 0x321E, 0x321F, 0x3220, 0x3221, 0x3222, 0x3223, 0x3224, 0x3225, 0x3226, 0x3227, 0x3228, 0x3229, 0x322A, 0x322B, 0x322C, 0x322D, 0x322E, 0x322F, 0x3230, 0x3231, 0x3232, 0x3233, 0x3234, 0x3235,
+		0x3236, 0x3237, 0x3238, 0x3239, 0x323A, 0x323B, 0x323C, 0x323D, 0x323E, 0x323F, 0x3240, 0x3241, 0x3242, 0x3243, 0x3244, 0x3245, 0x3246, 0x3247, 0x3248, 0x3249, 0x324A, 0x324B, 0x324C, 0x324D, 0x324E, 0x324F, 0x3250, 0x8E3BFBC0, 0x8E3CFBC0, 0x8E3DFBC0, 0x8E3EFBC0, 0x1F75,
+		0x3251, 0x3252, 0x3253, 0x3254, 0x3255, 0x3256, 0x1F49, 0, 0, 0, 0, 0, 0, 0, 0, 0x40B, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x40C, 0x40D, 0x8E5CFBC0, 0x8E5DFBC0, 0x8E5EFBC0, 0x8E5FFBC0,
+		0x8E60FBC0, 0x8E61FBC0, 0x8E62FBC0, 0x8E63FBC0, 0x8E64FBC0, 0x8E65FBC0, 0x8E66FBC0, 0x8E67FBC0, 0x8E68FBC0, 0x8E69FBC0, 0x8E6AFBC0, 0x8E6BFBC0, 0x8E6CFBC0, 0x8E6DFBC0, 0x8E6EFBC0, 0x8E6FFBC0, 0x8E70FBC0, 0x8E71FBC0, 0x8E72FBC0, 0x8E73FBC0, 0x8E74FBC0, 0x8E75FBC0, 0x8E76FBC0, 0x8E77FBC0, 0x8E78FBC0, 0x8E79FBC0, 0x8E7AFBC0, 0x8E7BFBC0, 0x8E7CFBC0, 0x8E7DFBC0, 0x8E7EFBC0, 0x8E7FFBC0,
+		0x8E80FBC0, 0x3258, 0x3259, 0x8E83FBC0, 0x325A, 0x8E85FBC0, 0x325B, 0x325C, 0x325D, 0x325E, 0x3260, 0x8E8BFBC0, 0x3261, 0x3264, 0x3262, 0x3265, 0x3266, 0x3267, 0x3268, 0x3269, 0x326A, 0x326B, 0x326C, 0x326D, 0x326E, 0x326F, 0x3270, 0x3271, 0x3272, 0x3273, 0x3274, 0x3275,
+		0x3276, 0x3277, 0x3278, 0x3279, 0x8EA4FBC0, 0x327A, 0x8EA6FBC0, 0x327B, 0x327C, 0x327D, 0x325F, 0x327E, 0x327F, 0x3280, 0x3281, 0x3282, 0x3283, 0x3284, 0x3285, 0x3286, 0x3287, 0x3288, 0x3289, 0x328A, 0x328B, 0x328C, 0x328D, 0x328E, 0x328F, 0x3290, 0x8EBEFBC0, 0x8EBFFBC0,
+		0x3291, 0x3292, 0x3293, 0x3294, 0x3295, 0x8EC5FBC0, 0x1F4A, 0x8EC7FBC0, 0, 0, 0, 0, 0, 0, 0x8ECEFBC0, 0x8ECFFBC0, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x8EDAFBC0, 0x8EDBFBC0, 0x326F327E, 0x3277327E, 0x3257, 0x3263,
+		0x8EE0FBC0, 0x8EE1FBC0, 0x8EE2FBC0, 0x8EE3FBC0, 0x8EE4FBC0, 0x8EE5FBC0, 0x8EE6FBC0, 0x8EE7FBC0, 0x8EE8FBC0, 0x8EE9FBC0, 0x8EEAFBC0, 0x8EEBFBC0, 0x8EECFBC0, 0x8EEDFBC0, 0x8EEEFBC0, 0x8EEFFBC0, 0x8EF0FBC0, 0x8EF1FBC0, 0x8EF2FBC0, 0x8EF3FBC0, 0x8EF4FBC0, 0x8EF5FBC0, 0x8EF6FBC0, 0x8EF7FBC0, 0x8EF8FBC0, 0x8EF9FBC0, 0x8EFAFBC0, 0x8EFBFBC0, 0x8EFCFBC0, 0x8EFDFBC0, 0x8EFEFBC0, 0x8EFFFBC0,
+		0x3336331F, 0x573, 0x574, 0x575, 0x410, 0x411, 0x412, 0x413, 0x414, 0x415, 0x416, 0x419, 0x419, 0x41A, 0x41B, 0x41C, 0x41D, 0x41E, 0x41F, 0x576, 0x25F, 0x577, 0x578, 0x579, 0, 0, 0x57A, 0x57B, 0x57C, 0x57D, 0x57E, 0x57F,
+		0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x1F98, 0x580, 0, 0x581, 0, 0x582, 0, 0x32E, 0x32F, 0x330, 0x331, 0, 0,
+		0x32D9, 0x32DC, 0x32DE, 0x331E32DE, 0x32E0, 0x32E2, 0x32E4, 0x32E6, 0x8F48FBC0, 0x32E8, 0x32EA, 0x32EC, 0x32EE, 0x331E32EE, 0x32F0, 0x32F2, 0x32F4, 0x32F6, 0x331E32F6, 0x32F8, 0x32FA, 0x32FC, 0x32FE, 0x331E32FE, 0x3300, 0x3302, 0x3304, 0x3306, 0x331E3306, 0x3308, 0x330A, 0x330C,
+		0x330E, 0x3310, 0x3312, 0x3315, 0x3317, 0x3319, 0x331B, 0x331D, 0x331F, 0x331A32D9, 0x3312, 0x32DB, 0x3314, 0x8F6DFBC0, 0x8F6EFBC0, 0x8F6FFBC0, 0x8F70FBC0, 0x3329, 0x332A, 0x332B, 0x332E, 0x332F, 0x3330, 0x3331, 0x3332, 0x3333, 0x3334, 0x3335, 0x3336, 0x3337, 0, 0,
+		0x332C, 0x332D, 0, 0, 0x3338, 0x420, 0, 0, 0x3321, 0x3323, 0x3327, 0x3328, 0x3325, 0x3322, 0x3324, 0x3326, 0x32DA, 0x32DD, 0x32DF, 0x331E32DF, 0x32E1, 0x32E3, 0x32E5, 0x32E7, 0x8F98FBC0, 0x32E9, 0x32EB, 0x32ED, 0x32EF, 0x331E32EF, 0x32F1, 0x32F3,
+		0x32F5, 0x32F7, 0x331E32F7, 0x32F9, 0x32FB, 0x32FD, 0x32FF, 0x331E32FF, 0x3301, 0x3303, 0x3305, 0x3307, 0x331E3307, 0x3309, 0x330B, 0x330D, 0x330F, 0x3311, 0x3313, 0x3316, 0x3318, 0x331A, 0x331C, 0x331E, 0x3320, 0x331A32DA, 0x3309, 0x3311, 0x3313, 0x8FBDFBC0, 0x583, 0x584,
+		0x585, 0x586, 0x587, 0x588, 0x589, 0x58A, 0, 0x58B, 0x58C, 0x58D, 0x58E, 0x58F, 0x590, 0x8FCDFBC0, 0x591, 0x592, 0x417, 0x418, 0x421, 0x422, 0x423, 0x593, 0x594, 0x595, 0x596, 0x424, 0x425, 0x8FDBFBC0, 0x8FDCFBC0, 0x8FDDFBC0, 0x8FDEFBC0, 0x8FDFFBC0,
+		0x8FE0FBC0, 0x8FE1FBC0, 0x8FE2FBC0, 0x8FE3FBC0, 0x8FE4FBC0, 0x8FE5FBC0, 0x8FE6FBC0, 0x8FE7FBC0, 0x8FE8FBC0, 0x8FE9FBC0, 0x8FEAFBC0, 0x8FEBFBC0, 0x8FECFBC0, 0x8FEDFBC0, 0x8FEEFBC0, 0x8FEFFBC0, 0x8FF0FBC0, 0x8FF1FBC0, 0x8FF2FBC0, 0x8FF3FBC0, 0x8FF4FBC0, 0x8FF5FBC0, 0x8FF6FBC0, 0x8FF7FBC0, 0x8FF8FBC0, 0x8FF9FBC0, 0x8FFAFBC0, 0x8FFBFBC0, 0x8FFCFBC0, 0x8FFDFBC0, 0x8FFEFBC0, 0x8FFFFBC0,
+		0x356C, 0x356E, 0x3570, 0x3574, 0x3577, 0x3579, 0x357C, 0x3580, 0x3585, 0x358C, 0x3590, 0x3591, 0x3593, 0x3595, 0x3598, 0x359B, 0x359F, 0x35A0, 0x35A1, 0x35A4, 0x35A7, 0x35AB, 0x35AC, 0x35B2, 0x35B5, 0x35B8, 0x35BA, 0x35BC, 0x35C0, 0x35C2, 0x35C9, 0x35CB,
+		0x35D1, 0x35D8, 0x35D9, 0x35DA, 0x35DB, 0x35DC, 0x35DD, 0x35E2, 0x35E3, 0x35E4, 0x35E5, 0x35E6, 0x35E6, 0x35EA, 0x35EC, 0x35EE, 0x35F1, 0x35F6, 0x35FA, 0x35ED, 0x35FC, 0x35F8, 0, 0, 0, 0x3602, 0x3603, 0x35BB, 0x35BF, 0x35C3, 0x35CE, 0x35C9360235C9,
+		0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x2A1, 0x2A2, 0x43B, 0x43C, 0x43D, 0x43E, 0x35C6, 0x35C7, 0x35DE, 0x35DF, 0x35E0, 0x35E1, 0x35F2, 0x35F3, 0x35F4, 0x35F5, 0x3578, 0x3586, 0x35D3, 0x35D4, 0x35AA, 0x35B9,
+		0x35C1, 0x358A, 0x35FD, 0x3604, 0x3605, 0x35C8, 0x35D7, 0x35FE, 0x35FF, 0x3606, 0x3607, 0x3608, 0x3609, 0x360A, 0x359C, 0x35D5, 0x35D6, 0x35EB, 0x35E8, 0x35EF, 0x35F0, 0x356D, 0x356F, 0x3571, 0x357A, 0x3583, 0x358D, 0x35A2, 0x35A8, 0x35AD, 0x35AE, 0x35B3,
+		0x35C5, 0x35CC, 0x35C4, 0x35E7, 0x35F7, 0x35F9, 0x3601, 0x360B, 0x360D, 0x3610, 0x3611, 0x360C, 0x360E, 0x360F, 0x35B0, 0x3612, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x3613, 0x3614, 0x35E9, 0x35FB, 0x599, 0x59A,
+		0x25A0, 0x25A2, 0x25A4, 0x25A6, 0x25A8, 0x25AA, 0x25AC, 0x25B0, 0x25B2, 0x25B4, 0x25B6, 0x25B8, 0x25BA, 0x25BE, 0x25C0, 0x25C2, 0x25C4, 0x25C6, 0x25C8, 0x25CC, 0x25CE, 0x25D0, 0x25D2, 0x25D4, 0x25D6, 0x25D8, 0x25DA, 0x25DC, 0x25DE, 0x25E0, 0x25E2, 0x25E6,
+		0x25E8, 0x25AE, 0x25BC, 0x25CA, 0x25E4, 0x25EA, 0x90C6FBC0, 0x25ED, 0x90C8FBC0, 0x90C9FBC0, 0x90CAFBC0, 0x90CBFBC0, 0x90CCFBC0, 0x25F2, 0x90CEFBC0, 0x90CFFBC0, 0x259F, 0x25A1, 0x25A3, 0x25A5, 0x25A7, 0x25A9, 0x25AB, 0x25AF, 0x25B1, 0x25B3, 0x25B5, 0x25B7, 0x25B9, 0x25BD, 0x25BF, 0x25C1,
+		0x25C3, 0x25C5, 0x25C7, 0x25CB, 0x25CD, 0x25CF, 0x25D1, 0x25D3, 0x25D5, 0x25D7, 0x25D9, 0x25DB, 0x25DD, 0x25DF, 0x25E1, 0x25E5, 0x25E7, 0x25AD, 0x25BB, 0x25C9, 0x25E3, 0x25E9, 0x25EB, 0x25EC, 0x25EE, 0x25EF, 0x25F0, 0x2CF, 0x25B9, 0x25F1, 0x25F3, 0x25F4,
+		0x4175, 0x4176, 0x4177, 0x4178, 0x4179, 0x417A, 0x417B, 0x417C, 0x417D, 0x417E, 0x417F, 0x4180, 0x4181, 0x4182, 0x4183, 0x4184, 0x4185, 0x4186, 0x4187, 0x4188, 0x4189, 0x418A, 0x418B, 0x418C, 0x418D, 0x418E, 0x418F, 0x4190, 0x4191, 0x4192, 0x4193, 0x4194,
+		0x4195, 0x4196, 0x4197, 0x4198, 0x4199, 0x419A, 0x419B, 0x419C, 0x419D, 0x419E, 0x419F, 0x41A0, 0x41A1, 0x41A2, 0x41A3, 0x41A4, 0x41A5, 0x41A6, 0x41A7, 0x41A8, 0x41A9, 0x41AA, 0x41AB, 0x41AC, 0x41AD, 0x41AE, 0x41AF, 0x41B0, 0x41B1, 0x41B2, 0x41B3, 0x41B4,
+		0x41B5, 0x41B6, 0x41B7, 0x41B8, 0x41B9, 0x41BA, 0x41BB, 0x41BC, 0x41BD, 0x41BE, 0x41BF, 0x41C0, 0x41C1, 0x41C2, 0x41C3, 0x41C4, 0x41C5, 0x41C6, 0x41C7, 0x41C8, 0x41C9, 0x41CA, 0x41CB, 0x41CC, 0x41CD, 0x41CE, 0x41CF, 0x41D0, 0x41D1, 0x41D2, 0x41D3, 0x41F1,
+		0x41F2, 0x41F3, 0x41F4, 0x41F5, 0x41F6, 0x41F7, 0x41F8, 0x41F9, 0x41FA, 0x41FB, 0x41FC, 0x41FD, 0x41FE, 0x41FF, 0x4200, 0x4201, 0x4202, 0x4203, 0x4204, 0x4205, 0x4206, 0x4207, 0x4208, 0x4209, 0x420A, 0x420B, 0x420C, 0x420D, 0x420E, 0x420F, 0x4210, 0x4211,
+		0x4212, 0x4213, 0x4214, 0x4215, 0x4216, 0x4217, 0x4218, 0x4219, 0x421A, 0x421B, 0x421C, 0x421D, 0x421E, 0x421F, 0x4220, 0x4221, 0x4222, 0x4223, 0x4224, 0x4225, 0x4226, 0x4227, 0x4228, 0x4229, 0x422A, 0x422B, 0x422C, 0x422D, 0x422E, 0x422F, 0x4230, 0x4231,
+		0x4232, 0x4233, 0x4234, 0x4235, 0x4236, 0x4237, 0x4238, 0x4239, 0x4251, 0x4252, 0x4253, 0x4254, 0x4255, 0x4256, 0x4257, 0x4258, 0x4259, 0x425A, 0x425B, 0x425C, 0x425D, 0x425E, 0x425F, 0x4260, 0x4261, 0x4262, 0x4263, 0x4264, 0x4265, 0x4266, 0x4267, 0x4268,
+		0x4269, 0x426A, 0x426B, 0x426C, 0x426D, 0x426E, 0x426F, 0x4270, 0x4271, 0x4272, 0x4273, 0x4274, 0x4275, 0x4276, 0x4277, 0x4278, 0x4279, 0x427A, 0x427B, 0x427C, 0x427D, 0x427E, 0x427F, 0x4280, 0x4281, 0x4282, 0x4283, 0x4284, 0x4285, 0x4286, 0x4287, 0x4288,
+		0x4289, 0x428A, 0x428B, 0x428C, 0x428D, 0x428E, 0x428F, 0x4290, 0x4291, 0x4292, 0x4293, 0x4294, 0x4295, 0x4296, 0x4297, 0x4298, 0x4299, 0x429A, 0x429B, 0x429C, 0x429D, 0x429E, 0x429F, 0x42A0, 0x42A1, 0x42A2, 0x42A3, 0x42A4, 0x42A5, 0x42A6, 0x42A7, 0x42A8,
+		0x2812, 0x2813, 0x2814, 0x2815, 0x2816, 0x2817, 0x2818, 0x2819, 0x281A, 0x281B, 0x281C, 0x281D, 0x281E, 0x281F, 0x2820, 0x2821, 0x2823, 0x2824, 0x2825, 0x2826, 0x2827, 0x2828, 0x2829, 0x282A, 0x282B, 0x282C, 0x282D, 0x282E, 0x282F, 0x2830, 0x2831, 0x2832,
+		0x2838, 0x2839, 0x283A, 0x283B, 0x283C, 0x283D, 0x283E, 0x283F, 0x2840, 0x2841, 0x2842, 0x2843, 0x2844, 0x2845, 0x2846, 0x2847, 0x2849, 0x284A, 0x284B, 0x284C, 0x284D, 0x284E, 0x284F, 0x2850, 0x2858, 0x2859, 0x285A, 0x285B, 0x285C, 0x285D, 0x285E, 0x285F,
+		0x2861, 0x2862, 0x2863, 0x2864, 0x2865, 0x2866, 0x2867, 0x2868, 0x2869, 0x9249FBC0, 0x286A, 0x286B, 0x286C, 0x286D, 0x924EFBC0, 0x924FFBC0, 0x286E, 0x286F, 0x2870, 0x2871, 0x2872, 0x2873, 0x2874, 0x9257FBC0, 0x2875, 0x9259FBC0, 0x2876, 0x2877, 0x2878, 0x2879, 0x925EFBC0, 0x925FFBC0,
+		0x287A, 0x287B, 0x287C, 0x287D, 0x287E, 0x287F, 0x2880, 0x2881, 0x2887, 0x2888, 0x2889, 0x288A, 0x288B, 0x288C, 0x288D, 0x288E, 0x288F, 0x2890, 0x2891, 0x2892, 0x2893, 0x2894, 0x2895, 0x2896, 0x2898, 0x2899, 0x289A, 0x289B, 0x289C, 0x289D, 0x289E, 0x289F,
+		0x28A1, 0x28A2, 0x28A3, 0x28A4, 0x28A5, 0x28A6, 0x28A7, 0x28A8, 0x28A9, 0x9289FBC0, 0x28AA, 0x28AB, 0x28AC, 0x28AD, 0x928EFBC0, 0x928FFBC0, 0x28AE, 0x28AF, 0x28B0, 0x28B1, 0x28B2, 0x28B3, 0x28B4, 0x28B5, 0x28B7, 0x28B8, 0x28B9, 0x28BA, 0x28BB, 0x28BC, 0x28BD, 0x28BE,
+		0x28C0, 0x28C1, 0x28C2, 0x28C3, 0x28C4, 0x28C5, 0x28C6, 0x28C7, 0x28C9, 0x28CA, 0x28CB, 0x28CC, 0x28CD, 0x28CE, 0x28CF, 0x28D0, 0x28D1, 0x92B1FBC0, 0x28D2, 0x28D3, 0x28D4, 0x28D5, 0x92B6FBC0, 0x92B7FBC0, 0x28D6, 0x28D7, 0x28D8, 0x28D9, 0x28DA, 0x28DB, 0x28DC, 0x92BFFBC0,
+		0x28DD, 0x92C1FBC0, 0x28DE, 0x28DF, 0x28E0, 0x28E1, 0x92C6FBC0, 0x92C7FBC0, 0x28E2, 0x28E3, 0x28E4, 0x28E5, 0x28E6, 0x28E7, 0x28E8, 0x28E9, 0x28EA, 0x28EB, 0x28EC, 0x28ED, 0x28EE, 0x28EF, 0x28F0, 0x92D7FBC0, 0x28F1, 0x28F2, 0x28F3, 0x28F4, 0x28F5, 0x28F6, 0x28F7, 0x28F8,
+		0x2900, 0x2901, 0x2902, 0x2903, 0x2904, 0x2905, 0x2906, 0x2907, 0x2908, 0x2909, 0x290A, 0x290B, 0x290C, 0x290D, 0x290E, 0x290F, 0x2910, 0x2911, 0x2912, 0x2913, 0x2914, 0x2915, 0x2916, 0x2917, 0x291F, 0x2920, 0x2921, 0x2922, 0x2923, 0x2924, 0x2925, 0x2926,
+		0x2928, 0x2929, 0x292A, 0x292B, 0x292C, 0x292D, 0x292E, 0x292F, 0x2931, 0x2932, 0x2933, 0x2934, 0x2935, 0x2936, 0x2937, 0x2938, 0x2939, 0x9311FBC0, 0x293A, 0x293B, 0x293C, 0x293D, 0x9316FBC0, 0x9317FBC0, 0x293E, 0x293F, 0x2940, 0x2941, 0x2942, 0x2943, 0x2944, 0x2945,
+		0x294A, 0x294B, 0x294C, 0x294D, 0x294E, 0x294F, 0x2950, 0x2951, 0x2953, 0x2954, 0x2955, 0x2956, 0x2957, 0x2958, 0x2959, 0x295A, 0x2963, 0x2964, 0x2965, 0x2966, 0x2967, 0x2968, 0x2969, 0x296A, 0x296C, 0x296D, 0x296E, 0x296F, 0x2970, 0x2971, 0x2972, 0x2973,
+		0x297B, 0x297C, 0x297D, 0x297E, 0x297F, 0x2980, 0x2981, 0x2982, 0x2983, 0x2984, 0x2985, 0x2986, 0x2987, 0x2988, 0x2989, 0x298A, 0x298F, 0x2990, 0x2991, 0x2992, 0x2993, 0x2994, 0x2995, 0x2996, 0x299C, 0x299D, 0x299E, 0x935BFBC0, 0x935CFBC0, 0, 0, 0,
+		0x2D0, 0x258, 0x283, 0x259, 0x25A, 0x25B, 0x25C, 0x273, 0x2D1, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x1D90, 0x1D91, 0x1D92, 0x1D93, 0x1D94, 0x1D95, 0x1D96, 0x1D97, 0x1D98, 0x1D99, 0x1D9A, 0x937DFBC0, 0x937EFBC0, 0x937FFBC0,
+		0x2833, 0x2834, 0x2835, 0x2836, 0x2882, 0x2883, 0x2884, 0x2885, 0x298B, 0x298C, 0x298D, 0x298E, 0x2997, 0x2998, 0x2999, 0x299A, 0x4FA, 0x4FB, 0x4FC, 0x4FD, 0x4FE, 0x4FF, 0x500, 0x501, 0x502, 0x503, 0x939AFBC0, 0x939BFBC0, 0x939CFBC0, 0x939DFBC0, 0x939EFBC0, 0x939FFBC0,
+		0x38DE, 0x38DF, 0x38E0, 0x38E1, 0x38E2, 0x38E3, 0x38E4, 0x38E5, 0x38E6, 0x38E7, 0x38E8, 0x38E9, 0x38EA, 0x38EB, 0x38EC, 0x38ED, 0x38EE, 0x38EF, 0x38F0, 0x38F1, 0x38F2, 0x38F3, 0x38F4, 0x38F5, 0x38F6, 0x38F7, 0x38F8, 0x38F9, 0x38FA, 0x38FB, 0x38FC, 0x38FD,
+		0x38FE, 0x38FF, 0x3900, 0x3901, 0x3902, 0x3903, 0x3904, 0x3905, 0x3906, 0x3907, 0x3908, 0x3909, 0x390A, 0x390B, 0x390C, 0x390D, 0x390E, 0x390F, 0x3910, 0x3911, 0x3912, 0x3913, 0x3914, 0x3915, 0x3916, 0x3917, 0x3918, 0x3919, 0x391A, 0x391B, 0x391C, 0x391D,
+		0x391E, 0x391F, 0x3920, 0x3921, 0x3922, 0x3923, 0x3924, 0x3925, 0x3926, 0x3927, 0x3928, 0x3929, 0x392A, 0x392B, 0x392C, 0x392D, 0x392E, 0x392F, 0x3930, 0x3931, 0x3932, 0x3933, 0x93F6FBC0, 0x93F7FBC0, 0x392E, 0x392F, 0x3930, 0x3931, 0x3932, 0x3933, 0x93FEFBC0, 0x93FFFBC0,
+		0x20F, 0x3958, 0x3959, 0x395A, 0x395B, 0x395C, 0x395D, 0x395E, 0x395F, 0x3960, 0x3961, 0x3962, 0x3963, 0x3964, 0x3965, 0x3966, 0x3967, 0x3968, 0x3969, 0x396A, 0x396B, 0x396C, 0x396D, 0x396E, 0x396F, 0x3970, 0x3971, 0x3972, 0x3973, 0x3974, 0x3975, 0x3976,
+		0x3977, 0x3978, 0x3979, 0x397A, 0x397B, 0x397C, 0x397D, 0x397E, 0x397F, 0x3980, 0x3981, 0x3982, 0x3983, 0x3984, 0x3985, 0x3986, 0x3987, 0x3988, 0x3989, 0x398A, 0x398B, 0x398C, 0x398D, 0x398E, 0x398F, 0x3990, 0x3991, 0x3992, 0x3993, 0x3994, 0x3995, 0x3996,
+		0x3997, 0x3998, 0x3999, 0x399A, 0x399B, 0x399C, 0x399D, 0x399E, 0x399F, 0x39A0, 0x39A1, 0x39A2, 0x39A3, 0x39A4, 0x39A5, 0x39A6, 0x39A7, 0x39A8, 0x39A9, 0x39AA, 0x39AB, 0x39AC, 0x39AD, 0x39AE, 0x39AF, 0x39B0, 0x39B1, 0x39B2, 0x39B3, 0x39B4, 0x39B5, 0x39B6,
+		0x39B7, 0x39B8, 0x39B9, 0x39BA, 0x39BB, 0x39BC, 0x39BD, 0x39BE, 0x39BF, 0x39C0, 0x39C1, 0x39C2, 0x39C3, 0x39C4, 0x39C5, 0x39C6, 0x39C7, 0x39C8, 0x39C9, 0x39CA, 0x39CB, 0x39CC, 0x39CD, 0x39CE, 0x39CF, 0x39D0, 0x39D1, 0x39D2, 0x39D3, 0x39D4, 0x39D5, 0x39D6,
+		0x39D7, 0x39D8, 0x39D9, 0x39DA, 0x39DB, 0x39DC, 0x39DD, 0x39DE, 0x39DF, 0x39E0, 0x39E1, 0x39E2, 0x39E3, 0x39E4, 0x39E5, 0x39E6, 0x39E7, 0x39E8, 0x39E9, 0x39EA, 0x39EB, 0x39EC, 0x39ED, 0x39EE, 0x39EF, 0x39F0, 0x39F1, 0x39F2, 0x39F3, 0x39F4, 0x39F5, 0x39F6,
+		0x39F7, 0x39F8, 0x39F9, 0x39FA, 0x39FB, 0x39FC, 0x39FD, 0x39FE, 0x39FF, 0x3A00, 0x3A01, 0x3A02, 0x3A03, 0x3A04, 0x3A05, 0x3A06, 0x3A07, 0x3A08, 0x3A09, 0x3A0A, 0x3A0B, 0x3A0C, 0x3A0D, 0x3A0E, 0x3A0F, 0x3A10, 0x3A11, 0x3A12, 0x3A13, 0x3A14, 0x3A15, 0x3A16,
+		0x3A17, 0x3A18, 0x3A19, 0x3A1A, 0x3A1B, 0x3A1C, 0x3A1D, 0x3A1E, 0x3A1F, 0x3A20, 0x3A21, 0x3A22, 0x3A23, 0x3A24, 0x3A25, 0x3A26, 0x3A27, 0x3A28, 0x3A29, 0x3A2A, 0x3A2B, 0x3A2C, 0x3A2D, 0x3A2E, 0x3A2F, 0x3A30, 0x3A31, 0x3A32, 0x3A33, 0x3A34, 0x3A35, 0x3A36,
+		0x3A37, 0x3A38, 0x3A39, 0x3A3A, 0x3A3B, 0x3A3C, 0x3A3D, 0x3A3E, 0x3A3F, 0x3A40, 0x3A41, 0x3A42, 0x3A43, 0x3A44, 0x3A45, 0x3A46, 0x3A47, 0x3A48, 0x3A49, 0x3A4A, 0x3A4B, 0x3A4C, 0x3A4D, 0x3A4E, 0x3A4F, 0x3A50, 0x3A51, 0x3A52, 0x3A53, 0x3A54, 0x3A55, 0x3A56,
+		0x3A57, 0x3A58, 0x3A59, 0x3A5A, 0x3A5B, 0x3A5C, 0x3A5D, 0x3A5E, 0x3A5F, 0x3A60, 0x3A61, 0x3A62, 0x3A63, 0x3A64, 0x3A65, 0x3A66, 0x3A67, 0x3A68, 0x3A69, 0x3A6A, 0x3A6B, 0x3A6C, 0x3A6D, 0x3A6E, 0x3A6F, 0x3A70, 0x3A71, 0x3A72, 0x3A73, 0x3A74, 0x3A75, 0x3A76,
+		0x3A77, 0x3A78, 0x3A79, 0x3A7A, 0x3A7B, 0x3A7C, 0x3A7D, 0x3A7E, 0x3A7F, 0x3A80, 0x3A81, 0x3A82, 0x3A83, 0x3A84, 0x3A85, 0x3A86, 0x3A87, 0x3A88, 0x3A89, 0x3A8A, 0x3A8B, 0x3A8C, 0x3A8D, 0x3A8E, 0x3A8F, 0x3A90, 0x3A91, 0x3A92, 0x3A93, 0x3A94, 0x3A95, 0x3A96,
+		0x3A97, 0x3A98, 0x3A99, 0x3A9A, 0x3A9B, 0x3A9C, 0x3A9D, 0x3A9E, 0x3A9F, 0x3AA0, 0x3AA1, 0x3AA2, 0x3AA3, 0x3AA4, 0x3AA5, 0x3AA6, 0x3AA7, 0x3AA8, 0x3AA9, 0x3AAA, 0x3AAB, 0x3AAC, 0x3AAD, 0x3AAE, 0x3AAF, 0x3AB0, 0x3AB1, 0x3AB2, 0x3AB3, 0x3AB4, 0x3AB5, 0x3AB6,
+		0x3AB7, 0x3AB8, 0x3AB9, 0x3ABA, 0x3ABB, 0x3ABC, 0x3ABD, 0x3ABE, 0x3ABF, 0x3AC0, 0x3AC1, 0x3AC2, 0x3AC3, 0x3AC4, 0x3AC5, 0x3AC6, 0x3AC7, 0x3AC8, 0x3AC9, 0x3ACA, 0x3ACB, 0x3ACC, 0x3ACD, 0x3ACE, 0x3ACF, 0x3AD0, 0x3AD1, 0x3AD2, 0x3B05, 0x3AD3, 0x3AD5, 0x3AD6,
+		0x3AD7, 0x3AD8, 0x3AD9, 0x3ADA, 0x3ADB, 0x3ADC, 0x3ADD, 0x3ADE, 0x3ADF, 0x3AE0, 0x3AE1, 0x3AE2, 0x3AE3, 0x3AE4, 0x3AE6, 0x3AE7, 0x3AE8, 0x3AE9, 0x3AEA, 0x3AEB, 0x3AEC, 0x3AED, 0x3AF4, 0x3AF5, 0x3AF6, 0x3AF7, 0x3AF8, 0x3AF9, 0x3AFA, 0x3AFB, 0x3AFC, 0x3AFD,
+		0x3AFE, 0x3AFF, 0x3B00, 0x3B01, 0x3B02, 0x3B03, 0x3B04, 0x3B06, 0x3B07, 0x3B08, 0x3B09, 0x3B0A, 0x3B0B, 0x3B0C, 0x3B0D, 0x3B0E, 0x3B0F, 0x3B10, 0x3B11, 0x3B12, 0x3B13, 0x3B14, 0x3B15, 0x3B16, 0x3B17, 0x3B18, 0x3B19, 0x3B1A, 0x3B1B, 0x3B1C, 0x3B1D, 0x3B1E,
+		0x3B1F, 0x3B20, 0x3B21, 0x3B22, 0x3B23, 0x3B24, 0x3B25, 0x3B26, 0x3B27, 0x3B28, 0x3B29, 0x3B2A, 0x3B2B, 0x3B2C, 0x3B2D, 0x3B2E, 0x3B2F, 0x3B30, 0x3B31, 0x3B32, 0x3B33, 0x3B34, 0x3B35, 0x3B36, 0x3B37, 0x3B38, 0x3B39, 0x3B3A, 0x3B3B, 0x3B3C, 0x3B3D, 0x3B3E,
+		0x3B3F, 0x3B40, 0x3B41, 0x3B42, 0x3B43, 0x3B44, 0x3B45, 0x3B46, 0x3B47, 0x3B48, 0x3B49, 0x3B4A, 0x3B4B, 0x3B4C, 0x3B4D, 0x3B4E, 0x3B4F, 0x3B50, 0x3B51, 0x3B52, 0x3B53, 0x3B54, 0x3B55, 0x3B56, 0x3B57, 0x3B58, 0x3B59, 0x3B5A, 0x3B5B, 0x3B5C, 0x3B5D, 0x3B5E,
+		0x3B5F, 0x3B60, 0x3B61, 0x3B62, 0x3B63, 0x3B64, 0x3B65, 0x3B66, 0x3B67, 0x3B68, 0x3B69, 0x3B6A, 0x3B6B, 0x3B6C, 0x3B6D, 0x3B6E, 0x3B6F, 0x3B70, 0x3B71, 0x3B72, 0x3B73, 0x3B74, 0x3B75, 0x3B76, 0x3B77, 0x3B78, 0x3B79, 0x3B7A, 0x3B7B, 0x3B7C, 0x3B7D, 0x3B7E,
+		0x3B7F, 0x3B80, 0x3B81, 0x3B82, 0x3B83, 0x3B84, 0x3B85, 0x3B86, 0x3B87, 0x3B88, 0x3B89, 0x3B8A, 0x3B8B, 0x3B8C, 0x3B8D, 0x3B8E, 0x3B8F, 0x3B90, 0x3B91, 0x3B92, 0x3B93, 0x3B94, 0x3B95, 0x3B96, 0x3B97, 0x3B98, 0x3B99, 0x3B9A, 0x3B9B, 0x3B9C, 0x3B9D, 0x3B9E,
+		0x3B9F, 0x3BA0, 0x3BA1, 0x3BA2, 0x3BA3, 0x3BA4, 0x3BA5, 0x3BA6, 0x3BA7, 0x3BA8, 0x3BA9, 0x3BAA, 0x3BAB, 0x3BAC, 0x3BAD, 0x3BAE, 0x3BAF, 0x3BB0, 0x3BB1, 0x3BB2, 0x3BB3, 0x3BB4, 0x3BB5, 0x3BB6, 0x3BB7, 0x3BB8, 0x3BB9, 0x3BBA, 0x3BBB, 0x3BBC, 0x3BBD, 0x3BBE,
+		0x3BBF, 0x3BC0, 0x3BC1, 0x3BC2, 0x3BC3, 0x3BC4, 0x3BC5, 0x3BC6, 0x3BC7, 0x3BC8, 0x3BC9, 0x3BCA, 0x3BCB, 0x597, 0x286, 0x3AD4, 0x3AE5, 0x3AEE, 0x3AEF, 0x3AF0, 0x3AF1, 0x3AF2, 0x3AF3, 0x3BCC, 0x3BCD, 0x3BCE, 0x3BCF, 0x3BD0, 0x3BD1, 0x3BD2, 0x3BD3, 0x3BD4,
+		0x209, 0x3C1B, 0x3C1C, 0x3C1D, 0x3C1E, 0x3C1F, 0x3C20, 0x3C21, 0x3C22, 0x3C23, 0x3C24, 0x3C25, 0x3C26, 0x3C27, 0x3C28, 0x3C29, 0x3C2A, 0x3C2B, 0x3C2C, 0x3C2D, 0x3C2E, 0x3C2F, 0x3C30, 0x3C31, 0x3C32, 0x3C33, 0x3C34, 0x332, 0x333, 0x969DFBC0, 0x969EFBC0, 0x969FFBC0,
+		0x3C35, 0x3C35, 0x3C36, 0x3C5A, 0x3C36, 0x3C36, 0x3C37, 0x3C37, 0x3C38, 0x3C38, 0x3C56, 0x3C58, 0x3C38, 0x3C38, 0x3C38, 0x3C3A, 0x3C3B, 0x3C3C, 0x3C3D, 0x3C3D, 0x3C3D, 0x3C3D, 0x3C3D, 0x3C3F, 0x3C5D, 0x3C40, 0x3C41, 0x3C41, 0x3C41, 0x3C41, 0x3C42, 0x3C42,
+		0x3C42, 0x3C43, 0x3C43, 0x3C45, 0x3C45, 0x3C46, 0x3C46, 0x3C47, 0x3C48, 0x3C49, 0x3C4A, 0x3C4A, 0x3C4A, 0x3C4A, 0x3C4A, 0x3C4C, 0x3C4C, 0x3C4C, 0x3C4D, 0x3C4D, 0x3C4D, 0x3C48, 0x3C4E, 0x3C50, 0x3C50, 0x3C50, 0x3C51, 0x3C51, 0x3C52, 0x3C52, 0x3C53, 0x3C54,
+		0x3C5B, 0x3C5F, 0x3C60, 0x3C5C, 0x3C5E, 0x3C61, 0x3C62, 0x3C62, 0x3C62, 0x3C40, 0x3C4A, 0x263, 0x264, 0x265, 0x3C513C46, 0x3C503C50, 0x3C373C37, 0x3C3E, 0x3C4B, 0x3C55, 0x3C39, 0x3C44, 0x3C4F, 0x3C57, 0x3C59, 0x96F9FBC0, 0x96FAFBC0, 0x96FBFBC0, 0x96FCFBC0, 0x96FDFBC0, 0x96FEFBC0, 0x96FFFBC0,
+		0x3486, 0x3487, 0x3488, 0x3489, 0x348A, 0x348B, 0x348C, 0x348D, 0x348E, 0x348F, 0x3490, 0x3491, 0x3492, 0x970DFBC0, 0x3493, 0x3494, 0x3495, 0x3496, 0x3497, 0x3498, 0x3499, 0x9715FBC0, 0x9716FBC0, 0x9717FBC0, 0x9718FBC0, 0x9719FBC0, 0x971AFBC0, 0x971BFBC0, 0x971CFBC0, 0x971DFBC0, 0x971EFBC0, 0x971FFBC0,
+		0x349A, 0x349B, 0x349C, 0x349D, 0x349E, 0x349F, 0x34A0, 0x34A1, 0x34A2, 0x34A3, 0x34A4, 0x34A5, 0x34A6, 0x34A7, 0x34A8, 0x34A9, 0x34AA, 0x34AB, 0x34AC, 0x34AD, 0x34AE, 0x29E, 0x29F, 0x9737FBC0, 0x9738FBC0, 0x9739FBC0, 0x973AFBC0, 0x973BFBC0, 0x973CFBC0, 0x973DFBC0, 0x973EFBC0, 0x973FFBC0,
+		0x34AF, 0x34B0, 0x34B1, 0x34B2, 0x34B3, 0x34B4, 0x34B5, 0x34B6, 0x34B7, 0x34B8, 0x34B9, 0x34BA, 0x34BB, 0x34BC, 0x34BD, 0x34BE, 0x34BF, 0x34C0, 0x34C1, 0x34C2, 0x9754FBC0, 0x9755FBC0, 0x9756FBC0, 0x9757FBC0, 0x9758FBC0, 0x9759FBC0, 0x975AFBC0, 0x975BFBC0, 0x975CFBC0, 0x975DFBC0, 0x975EFBC0, 0x975FFBC0,
+		0x34C3, 0x34C4, 0x34C5, 0x34C6, 0x34C7, 0x34C8, 0x34C9, 0x34CA, 0x34CB, 0x34CC, 0x34CD, 0x34CE, 0x34CF, 0x976DFBC0, 0x34D0, 0x34D1, 0x34D2, 0x9771FBC0, 0x34D3, 0x34D4, 0x9774FBC0, 0x9775FBC0, 0x9776FBC0, 0x9777FBC0, 0x9778FBC0, 0x9779FBC0, 0x977AFBC0, 0x977BFBC0, 0x977CFBC0, 0x977DFBC0, 0x977EFBC0, 0x977FFBC0,
+		0x3675, 0x3676, 0x3677, 0x3678, 0x3679, 0x367A, 0x367B, 0x367C, 0x367D, 0x367E, 0x367F, 0x3680, 0x3681, 0x3682, 0x3683, 0x3684, 0x3685, 0x3686, 0x3687, 0x3688, 0x3689, 0x368A, 0x368B, 0x368C, 0x368D, 0x368E, 0x368F, 0x3690, 0x3691, 0x3692, 0x3693, 0x3694,
+		0x3695, 0x3696, 0x3697, 0x3699, 0x369A, 0x369B, 0x369C, 0x369D, 0x369E, 0x369F, 0x36A0, 0x36A1, 0x36A2, 0x36A3, 0x36A4, 0x36A5, 0x36A6, 0x36A7, 0x36A8, 0x36A9, 0, 0, 0x36AA, 0x36AB, 0x36AC, 0x36AD, 0x36AE, 0x36AF, 0x36B0, 0x36B1, 0x36B2, 0x36B3,
+		0x36B4, 0x36B5, 0x36B6, 0x36B7, 0x36B8, 0x36B9, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x36BA, 0, 0x2A3, 0x2A4, 0x260, 0x1F4B, 0x43F, 0x440, 0x441, 0x1F76, 0x3698, 0, 0x97DEFBC0, 0x97DFFBC0,
+		0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x97EAFBC0, 0x97EBFBC0, 0x97ECFBC0, 0x97EDFBC0, 0x97EEFBC0, 0x97EFFBC0, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x97FAFBC0, 0x97FBFBC0, 0x97FCFBC0, 0x97FDFBC0, 0x97FEFBC0, 0x97FFFBC0,
+		0x3F2, 0x27F, 0x230, 0x284, 0x25D, 0x25E, 0x211, 0x212, 0x231, 0x285, 0, 0, 0, 0, 0, 0x980FFBC0, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x981AFBC0, 0x981BFBC0, 0x981CFBC0, 0x981DFBC0, 0x981EFBC0, 0x981FFBC0,
+		0x383E, 0x3840, 0x3843, 0x3849, 0x384B, 0x384E, 0x3850, 0x3853, 0x3854, 0x3855, 0x385A, 0x385C, 0x385F, 0x3861, 0x3866, 0x3868, 0x3869, 0x386A, 0x3871, 0x3874, 0x3877, 0x387D, 0x3881, 0x3884, 0x3886, 0x3888, 0x388B, 0x3890, 0x3891, 0x3894, 0x3898, 0x389B,
+		0x389C, 0x389D, 0x389E, 0x383D, 0x3841, 0x3844, 0x384A, 0x384C, 0x384F, 0x3851, 0x3856, 0x385B, 0x385D, 0x3860, 0x3862, 0x3867, 0x3872, 0x3875, 0x3878, 0x387E, 0x3892, 0x3882, 0x3887, 0x388C, 0x3896, 0x3899, 0x389F, 0x38A0, 0x387B, 0x3842, 0x3845, 0x3848,
+		0x3852, 0x384D, 0x3857, 0x388D, 0x3863, 0x3865, 0x385E, 0x386B, 0x3873, 0x3876, 0x387F, 0x3889, 0x3897, 0x389A, 0x3893, 0x3895, 0x38A1, 0x3879, 0x3883, 0x3846, 0x388E, 0x3885, 0x388A, 0x3880, 0x387A, 0x9879FBC0, 0x987AFBC0, 0x987BFBC0, 0x987CFBC0, 0x987DFBC0, 0x987EFBC0, 0x987FFBC0,
+		0x3836, 0x3837, 0x3838, 0x3839, 0x383A, 0x383B, 0x383C, 0x383F, 0x3847, 0x388F, 0x3858, 0x387C, 0x38A2, 0x38A4, 0x38A5, 0x38A7, 0x38A8, 0x38AB, 0x38AD, 0x38AE, 0x38B0, 0x38B2, 0x38B4, 0x38B5, 0x38A9, 0x38B3, 0x3864, 0x3859, 0x386C, 0x386D, 0x38A3, 0x38A6,
+		0x38AA, 0x38AC, 0x386E, 0x38B1, 0x386F, 0x3870, 0x38B6, 0x38B7, 0x38AF, 0x38B9, 0x38B8, 0x98ABFBC0, 0x98ACFBC0, 0x98ADFBC0, 0x98AEFBC0, 0x98AFFBC0, 0x3BD5, 0x3BD6, 0x3BD7, 0x3BD8, 0x3BD9, 0x3BDA, 0x3BDB, 0x3BDC, 0x3BDD, 0x3BDE, 0x3BDF, 0x3BE0, 0x3BE1, 0x3BE2, 0x3BE3, 0x3BE4,
+		0x3BE5, 0x3BE6, 0x3BE7, 0x3BE8, 0x3BE9, 0x3BEA, 0x3BEB, 0x3BEC, 0x3BED, 0x3BEE, 0x3BEF, 0x3BF0, 0x3BF1, 0x3BF2, 0x3BF3, 0x3BF4, 0x3BF5, 0x3BF6, 0x3BF7, 0x3BF8, 0x3BF9, 0x3BFA, 0x3BFB, 0x3BFC, 0x3BFD, 0x3BFE, 0x3BFF, 0x3C00, 0x3C01, 0x3C02, 0x3C03, 0x3C04,
+		0x3C05, 0x3C06, 0x3C07, 0x3C08, 0x3C09, 0x3C0A, 0x3C0B, 0x3C0C, 0x3C0D, 0x3C0E, 0x3C0F, 0x3C10, 0x3C11, 0x3C12, 0x3C13, 0x3C14, 0x3C15, 0x3C16, 0x3C17, 0x3C18, 0x3C19, 0x3C1A, 0x98F6FBC0, 0x98F7FBC0, 0x98F8FBC0, 0x98F9FBC0, 0x98FAFBC0, 0x98FBFBC0, 0x98FCFBC0, 0x98FDFBC0, 0x98FEFBC0, 0x98FFFBC0,
+		0x3454, 0x3455, 0x3456, 0x3457, 0x3458, 0x3459, 0x345A, 0x345B, 0x345C, 0x345D, 0x345E, 0x345F, 0x3460, 0x3461, 0x3462, 0x3463, 0x3464, 0x3465, 0x3466, 0x3467, 0x3468, 0x3469, 0x346A, 0x346B, 0x346C, 0x346D, 0x346E, 0x346F, 0x3470, 0x347A345C, 0x347B345F, 0x991FFBC0,
+		0x3471, 0x3472, 0x3473, 0x3474, 0x3475, 0x3476, 0x3477, 0x3478, 0x3479, 0x347A, 0x347B, 0x347C, 0x992CFBC0, 0x992DFBC0, 0x992EFBC0, 0x992FFBC0, 0x347D, 0x347E, 0x347F, 0x3480, 0x3481, 0x3482, 0x3483, 0x3484, 0x3485, 0, 0, 0, 0x993CFBC0, 0x993DFBC0, 0x993EFBC0, 0x993FFBC0,
+		0x598, 0x9941FBC0, 0x9942FBC0, 0x9943FBC0, 0x26B, 0x274, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x36BB, 0x36BC, 0x36BD, 0x36BE, 0x36BF, 0x36C0, 0x36C1, 0x36C2, 0x36C3, 0x36C4, 0x36C5, 0x36C6, 0x36C7, 0x36C8, 0x36C9, 0x36CA,
+		0x36CB, 0x36CC, 0x36CD, 0x36CE, 0x36CF, 0x36D0, 0x36D1, 0x36D2, 0x36D3, 0x36D4, 0x36D5, 0x36D6, 0x36D7, 0x36D8, 0x996EFBC0, 0x996FFBC0, 0x36D9, 0x36DA, 0x36DB, 0x36DC, 0x36DD, 0x9975FBC0, 0x9976FBC0, 0x9977FBC0, 0x9978FBC0, 0x9979FBC0, 0x997AFBC0, 0x997BFBC0, 0x997CFBC0, 0x997DFBC0, 0x997EFBC0, 0x997FFBC0,
+		0x36DE, 0x36DF, 0x36E0, 0x36E1, 0x36E2, 0x36E3, 0x36E4, 0x36E5, 0x36E6, 0x36E7, 0x36E8, 0x36E9, 0x36EA, 0x36EB, 0x36EC, 0x36ED, 0x36EE, 0x36EF, 0x36F0, 0x36F1, 0x36F2, 0x36F3, 0x36F4, 0x36F5, 0x36F6, 0x36F7, 0x36F8, 0x36F9, 0x36FA, 0x36FB, 0x36FC, 0x36FD,
+		0x36FE, 0x36FF, 0x3700, 0x3701, 0x3702, 0x3703, 0x3704, 0x3705, 0x3706, 0x3707, 0x3708, 0x3709, 0x99ACFBC0, 0x99ADFBC0, 0x99AEFBC0, 0x99AFFBC0, 0x370A, 0x370B, 0x370C, 0x370D, 0x370E, 0x370F, 0x3710, 0x3711, 0x3712, 0x3713, 0x3714, 0x3715, 0x3716, 0x3717, 0x3718, 0x3719,
+		0x371A, 0x371B, 0x371C, 0x371D, 0x371E, 0x371F, 0x3720, 0x3721, 0x3722, 0x3723, 0x99CAFBC0, 0x99CBFBC0, 0x99CCFBC0, 0x99CDFBC0, 0x99CEFBC0, 0x99CFFBC0, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x1F99, 0x99DBFBC0, 0x99DCFBC0, 0x99DDFBC0, 0x371036FA, 0x371B371036FA,
+		0x59F, 0x5A0, 0x5A1, 0x5A2, 0x5A3, 0x5A4, 0x5A5, 0x5A6, 0x5A7, 0x5A8, 0x5A9, 0x5AA, 0x5AB, 0x5AC, 0x5AD, 0x5AE, 0x5AF, 0x5B0, 0x5B1, 0x5B2, 0x5B3, 0x5B4, 0x5B5, 0x5B6, 0x5B7, 0x5B8, 0x5B9, 0x5BA, 0x5BB, 0x5BC, 0x5BD, 0x5BE,
+		0x34D5, 0x34D6, 0x34D7, 0x34D8, 0x34D9, 0x34DA, 0x34DB, 0x34DC, 0x34DD, 0x34DE, 0x34DF, 0x34E0, 0x34E1, 0x34E2, 0x34E3, 0x34E4, 0x34E5, 0x34E6, 0x34E7, 0x34E8, 0x34E9, 0x34EA, 0x34EB, 0x34EC, 0x34ED, 0x34EE, 0x34EF, 0x34F0, 0x9A1CFBC0, 0x9A1DFBC0, 0x2D2, 0x2D3,
+		0x3724, 0x3725, 0x3726, 0x3727, 0x3728, 0x3729, 0x372A, 0x372B, 0x372C, 0x372D, 0x372E, 0x372F, 0x3730, 0x3731, 0x3732, 0x3733, 0x3734, 0x3735, 0x3736, 0x3737, 0x3738, 0x3739, 0x373A, 0x373B, 0x373C, 0x373D, 0x373E, 0x373F, 0x3740, 0x3741, 0x3742, 0x3743,
+		0x3744, 0x3745, 0x3746, 0x3747, 0x3748, 0x3749, 0x374A, 0x374B, 0x374C, 0x374D, 0x374E, 0x374F, 0x3750, 0x3759, 0x375A, 0x375B, 0x375C, 0x375D, 0x375E, 0x3751, 0x374A3770374A, 0x3753, 0x3754, 0x3755, 0x372A, 0x372A, 0x373F, 0x373F, 0x3756, 0x3757, 0x3758, 0x9A5FFBC0,
+		0x3770, 0x375F, 0x3761, 0x3762, 0x3762, 0x3763, 0x3764, 0x3765, 0x3766, 0x3767, 0x3768, 0x3752, 0x3760, 0x376F, 0x3769, 0x376A, 0x376C, 0x376D, 0x376E, 0x376B, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x9A7DFBC0, 0x9A7EFBC0, 0,
+		0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x9A8AFBC0, 0x9A8BFBC0, 0x9A8CFBC0, 0x9A8DFBC0, 0x9A8EFBC0, 0x9A8FFBC0, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x9A9AFBC0, 0x9A9BFBC0, 0x9A9CFBC0, 0x9A9DFBC0, 0x9A9EFBC0, 0x9A9FFBC0,
+		0x442, 0x443, 0x444, 0x445, 0x446, 0x447, 0x448, 0x1F4C, 0x2A5, 0x2A6, 0x2A7, 0x2A8, 0x449, 0x44A, 0x9AAEFBC0, 0x9AAFFBC0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x2259,
+		0x2260, 0x9AC1FBC0, 0x9AC2FBC0, 0x9AC3FBC0, 0x9AC4FBC0, 0x9AC5FBC0, 0x9AC6FBC0, 0x9AC7FBC0, 0x9AC8FBC0, 0x9AC9FBC0, 0x9ACAFBC0, 0x9ACBFBC0, 0x9ACCFBC0, 0x9ACDFBC0, 0x9ACEFBC0, 0x9ACFFBC0, 0x9AD0FBC0, 0x9AD1FBC0, 0x9AD2FBC0, 0x9AD3FBC0, 0x9AD4FBC0, 0x9AD5FBC0, 0x9AD6FBC0, 0x9AD7FBC0, 0x9AD8FBC0, 0x9AD9FBC0, 0x9ADAFBC0, 0x9ADBFBC0, 0x9ADCFBC0, 0x9ADDFBC0, 0x9ADEFBC0, 0x9ADFFBC0,
+		0x9AE0FBC0, 0x9AE1FBC0, 0x9AE2FBC0, 0x9AE3FBC0, 0x9AE4FBC0, 0x9AE5FBC0, 0x9AE6FBC0, 0x9AE7FBC0, 0x9AE8FBC0, 0x9AE9FBC0, 0x9AEAFBC0, 0x9AEBFBC0, 0x9AECFBC0, 0x9AEDFBC0, 0x9AEEFBC0, 0x9AEFFBC0, 0x9AF0FBC0, 0x9AF1FBC0, 0x9AF2FBC0, 0x9AF3FBC0, 0x9AF4FBC0, 0x9AF5FBC0, 0x9AF6FBC0, 0x9AF7FBC0, 0x9AF8FBC0, 0x9AF9FBC0, 0x9AFAFBC0, 0x9AFBFBC0, 0x9AFCFBC0, 0x9AFDFBC0, 0x9AFEFBC0, 0x9AFFFBC0,
+		0, 0, 0, 0, 0, 0x37B6, 0x37B7, 0x37B8, 0x37B9, 0x37BA, 0x37BB, 0x37BC, 0x37BD, 0x37BE, 0x37BF, 0x37C0, 0x37C1, 0x37C2, 0x37C3, 0x37C4, 0x37C7, 0x37C8, 0x37C9, 0x37CA, 0x37CB, 0x37CC, 0x37CD, 0x37CE, 0x37CF, 0x37D0, 0x37D1, 0x37D2,
+		0x37D3, 0x37D4, 0x37D5, 0x37D7, 0x37D8, 0x37D9, 0x37DA, 0x37DB, 0x37DD, 0x37DE, 0x37DF, 0x37E0, 0x37E1, 0x37E2, 0x37E3, 0x37E4, 0x37E6, 0x37E7, 0x37E8, 0x37EB, 0, 0x37EC, 0x37ED, 0x37EE, 0x37EF, 0x37F0, 0x37F1, 0x37F2, 0x37F3, 0x37F4, 0x37F5, 0x37F6,
+		0x37F7, 0x37F8, 0x37F9, 0x37FA, 0x37FB, 0x37C5, 0x37C6, 0x37D6, 0x37DC, 0x37E5, 0x37E9, 0x37EA, 0x9B4CFBC0, 0x9B4DFBC0, 0x9B4EFBC0, 0x9B4FFBC0, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x2D4, 0x2D5, 0x287, 0x261, 0x2A9, 0x2AA,
+		0x210, 0x5BF, 0x5C0, 0x5C1, 0x5C2, 0x5C3, 0x5C4, 0x5C5, 0x5C6, 0x5C7, 0x5C8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x5C9, 0x5CA, 0x5CB, 0x5CC, 0x5CD, 0x5CE, 0x5CF, 0x5D0, 0x5D1, 0x9B7DFBC0, 0x9B7EFBC0, 0x9B7FFBC0,
+		0, 0, 0, 0x313A, 0x313B, 0x313C, 0x313D, 0x313E, 0x313F, 0x3140, 0x3141, 0x3143, 0x3144, 0x3145, 0x3146, 0x3147, 0x3148, 0x3149, 0x314A, 0x314B, 0x314C, 0x314D, 0x314E, 0x314F, 0x3150, 0x3152, 0x3154, 0x3156, 0x3159, 0x315C, 0x315E, 0x315F,
+		0x3161, 0x3155, 0x3157, 0x315A, 0x3162, 0x3163, 0x3164, 0x3165, 0x3166, 0x3167, 0x3168, 0x3169, 0x3153, 0x315D, 0x3142, 0x3160, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x313A, 0x3158, 0x315B, 0x3151, 0x3141, 0x3152,
+		0x3508, 0x3508, 0x3509, 0x3509, 0x3509, 0x350A, 0x350A, 0x350B, 0x350B, 0x350C, 0x350C, 0x350D, 0x350D, 0x350D, 0x350E, 0x350E, 0x350F, 0x3510, 0x3511, 0x3511, 0x3512, 0x3512, 0x3513, 0x3513, 0x3514, 0x3514, 0x3514, 0x3515, 0x3515, 0x3516, 0x3517, 0x3517,
+		0x3518, 0x3519, 0x351A, 0x351B, 0x351C, 0x351D, 0, 0x351E, 0x351E, 0x351F, 0x3520, 0x3520, 0x3521, 0x3521, 0x3522, 0x3522, 0x3523, 0x3524, 0x3525, 0x3526, 0x9BF4FBC0, 0x9BF5FBC0, 0x9BF6FBC0, 0x9BF7FBC0, 0x9BF8FBC0, 0x9BF9FBC0, 0x9BFAFBC0, 0x9BFBFBC0, 0x456, 0x457, 0x458, 0x459,
+		0x33E6, 0x33E7, 0x33E8, 0x33E9, 0x33EA, 0x33EB, 0x33EC, 0x33ED, 0x33EE, 0x33EF, 0x33F3, 0x33F4, 0x33F5, 0x33F6, 0x33F7, 0x33F8, 0x33F9, 0x33FA, 0x33FB, 0x33FC, 0x33FD, 0x33FE, 0x33FF, 0x3400, 0x3401, 0x3402, 0x3403, 0x3405, 0x3407, 0x3408, 0x3409, 0x340A,
+		0x340B, 0x340C, 0x340D, 0x340E, 0x3404, 0x3406, 0x3410, 0x3411, 0x3412, 0x3413, 0x3414, 0x3415, 0x3416, 0x3417, 0x3418, 0x3419, 0x341A, 0x341B, 0x341C, 0x341D, 0x341E, 0x341F, 0x340F, 0, 0x9C38FBC0, 0x9C39FBC0, 0x9C3AFBC0, 0x29A, 0x29B, 0x438, 0x439, 0x43A,
+		0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x9C4AFBC0, 0x9C4BFBC0, 0x9C4CFBC0, 0x33F0, 0x33F1, 0x33F2, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x38BA, 0x38BB, 0x38BC, 0x38BD, 0x38BE, 0x38BF,
+		0x38C0, 0x38C1, 0x38C2, 0x38C3, 0x38C4, 0x38C5, 0x38C6, 0x38C7, 0x38C8, 0x38C9, 0x38CA, 0x38CB, 0x38CC, 0x38CD, 0x38CE, 0x38CF, 0x38D0, 0x38D1, 0x38D2, 0x38D3, 0x38D4, 0x38D5, 0x38D6, 0x38D7, 0x38D8, 0x38D9, 0x38DA, 0x38DB, 0x38DC, 0x38DD, 0x2CB, 0x2CC,
+		0x2397, 0x23AF, 0x244C, 0x246A, 0x2473, 0x2473, 0x24F4, 0x2505, 0x248E, 0x9C89FBC0, 0x9C8AFBC0, 0x9C8BFBC0, 0x9C8CFBC0, 0x9C8DFBC0, 0x9C8EFBC0, 0x9C8FFBC0, 0x259F, 0x25A1, 0x25A3, 0x25A5, 0x25A7, 0x25A9, 0x25AB, 0x25AF, 0x25B1, 0x25B3, 0x25B5, 0x25B7, 0x25B9, 0x25BD, 0x25BF, 0x25C1,
+		0x25C3, 0x25C5, 0x25C7, 0x25CB, 0x25CD, 0x25CF, 0x25D1, 0x25D3, 0x25D5, 0x25D7, 0x25D9, 0x25DB, 0x25DD, 0x25DF, 0x25E1, 0x25E5, 0x25E7, 0x25AD, 0x25BB, 0x25C9, 0x25E3, 0x25E9, 0x25EB, 0x25EC, 0x25EE, 0x25EF, 0x25F0, 0x9CBBFBC0, 0x9CBCFBC0, 0x25F1, 0x25F3, 0x25F4,
+		0x44B, 0x44C, 0x44D, 0x44E, 0x44F, 0x450, 0x451, 0x452, 0x9CC8FBC0, 0x9CC9FBC0, 0x9CCAFBC0, 0x9CCBFBC0, 0x9CCCFBC0, 0x9CCDFBC0, 0x9CCEFBC0, 0x9CCFFBC0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0x2A1E, 0x2A1E, 0x2A1E, 0x2A1E, 0, 0x2A1E, 0x2A1E, 0x2A1E, 0x2A1E, 0, 0, 0, 0x2A1F, 0x2A20, 0, 0, 0, 0x2A1E, 0x9CFBFBC0, 0x9CFCFBC0, 0x9CFDFBC0, 0x9CFEFBC0, 0x9CFFFBC0,
+		0x1FA6, 0x1FAA, 0x1FAB, 0x1FC9, 0x1FDA, 0x1FEF, 0x1FF0, 0x200B, 0x2031, 0x209E, 0x20B3, 0x20C8, 0x20DF, 0x210D, 0x2121, 0x2140, 0x2153, 0x2141, 0x2154, 0x214D, 0x2147, 0x216A, 0x2159, 0x215A, 0x216F, 0x219E, 0x21A8, 0x21FB, 0x221B, 0x221D, 0x221E, 0x223D,
+		0x224B, 0x225D, 0x228A, 0x22A7, 0x22EF, 0x22F0, 0x2321, 0x232F, 0x2335, 0x233A, 0x2345, 0x2419, 0x1FA2, 0x20071FA2, 0x1FBC, 0x1FC8, 0x1FEB, 0x2007, 0x2015, 0x2051, 0x2075, 0x2090, 0x20AB, 0x20C4, 0x20D6, 0x2109, 0x2118, 0x2120, 0x213C, 0x2166, 0x216B, 0x2193,
+		0x21F7, 0x2217, 0x2259, 0x1FA2, 0x1FAD, 0x1FB1, 0x1FAB, 0x1FBC, 0x1FEB, 0x2007, 0x201A, 0x201F, 0x2031, 0x2051, 0x209E, 0x20C4, 0x2109, 0x2137, 0x213C, 0x214F, 0x2159, 0x215A, 0x216B, 0x21F7, 0x2217, 0x221D, 0x2238, 0x2247, 0x22F0, 0x231F, 0x2320, 0x2322,
+		0x2342, 0x2343, 0x2090, 0x2193, 0x2217, 0x2247, 0x231F, 0x2320, 0x2339, 0x2342, 0x2343, 0x221F, 0x1FCA, 0x1FF2, 0x2049, 0x210E, 0x2122, 0x2172, 0x21A3, 0x21C0, 0x21D8, 0x2201, 0x228F, 0x206B, 0x2431, 0x2051, 0x207521F7, 0x20A3, 0x20AA, 0x2170, 0x2228, 0x2246,
+		0x1FCC, 0x1FF3, 0x204A, 0x2062, 0x20C9, 0x20F1, 0x210F, 0x212C, 0x2173, 0x21AD, 0x21D9, 0x21E9, 0x224D, 0x2268, 0x2290, 0x1FA8, 0x1FB6, 0x1FFC, 0x2012, 0x2023, 0x2030, 0x201E, 0x20A4, 0x2156, 0x21F2, 0x2229, 0x22AC, 0x1FB7, 0x1FD6, 0x1FE5, 0x1FEB, 0x202C,
+		0x2042, 0x20BC, 0x2055, 0x222C, 0x209F, 0x20A6, 0x2098, 0x20A3, 0x20B8, 0x20F2, 0x20F1, 0x20DA, 0x2110, 0x223E, 0x2123, 0x212D, 0x211C, 0x215C, 0x217B, 0x21DA, 0x21E4, 0x2202, 0x2222, 0x2242, 0x221B, 0x224E, 0x2255, 0x2286, 0x2295, 0x2299, 0x22A3, 0x232A,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x2193, 0, 0, 0, 0, 0, 0, 0, 0x22C5, 0x1FA2, 0x20071FA2, 0x213C1FA2, 0x22471FA2, 0x1FD6, 0x1FEB, 0x1FEB, 0x2051, 0x205A, 0x20C4, 0x20D6, 0x20DA, 0x210D,
+		0x2118, 0x211C, 0x2198, 0x219D, 0x21D2, 0x21D2, 0x2286, 0x1FB1, 0x1FBC, 0x1FD5, 0x201A, 0x2042, 0x20EA, 0x213C, 0x216B, 0x21E4, 0x2217, 0x2259, 0x1FA2, 0x213C, 0x2217, 0, 0, 0, 0, 0, 0x9DFAFBC0, 0, 0, 0, 0, 0,
+		0x1FA2, 0x1FA2, 0x1FBC, 0x1FBC, 0x1FBC, 0x1FBC, 0x1FBC, 0x1FBC, 0x1FD6, 0x1FD6, 0x1FEB, 0x1FEB, 0x1FEB, 0x1FEB, 0x1FEB, 0x1FEB, 0x1FEB, 0x1FEB, 0x1FEB, 0x1FEB, 0x2007, 0x2007, 0x2007, 0x2007, 0x2007, 0x2007, 0x2007, 0x2007, 0x2007, 0x2007, 0x2042, 0x2042,
+		0x2051, 0x2051, 0x2075, 0x2075, 0x2075, 0x2075, 0x2075, 0x2075, 0x2075, 0x2075, 0x2075, 0x2075, 0x2090, 0x2090, 0x2090, 0x2090, 0x20C4, 0x20C4, 0x20C4, 0x20C4, 0x20C4, 0x20C4, 0x20D6, 0x20D6, 0x20D6, 0x20D6, 0x20D6, 0x20D6, 0x20D6, 0x20D6, 0x2109, 0x2109,
+		0x2109, 0x2109, 0x2109, 0x2109, 0x2118, 0x2118, 0x2118, 0x2118, 0x2118, 0x2118, 0x2118, 0x2118, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x216B, 0x216B, 0x216B, 0x216B, 0x2193, 0x2193, 0x2193, 0x2193, 0x2193, 0x2193, 0x2193, 0x2193,
+		0x21D2, 0x21D2, 0x21D2, 0x21D2, 0x21D2, 0x21D2, 0x21D2, 0x21D2, 0x21D2, 0x21D2, 0x21F7, 0x21F7, 0x21F7, 0x21F7, 0x21F7, 0x21F7, 0x21F7, 0x21F7, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2247, 0x2247, 0x2247, 0x2247,
+		0x2259, 0x2259, 0x2259, 0x2259, 0x2259, 0x2259, 0x2259, 0x2259, 0x2259, 0x2259, 0x2264, 0x2264, 0x2264, 0x2264, 0x2270, 0x2270, 0x2286, 0x2286, 0x2286, 0x2286, 0x2286, 0x2286, 0x2075, 0x21F7, 0x2259, 0x2270, 0x22E51FA2, 0x21D2, 0x21E2, 0x21E3, 0x21D221D2, 0x2006,
+		0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x1FA2, 0x2007, 0x2007, 0x2007, 0x2007, 0x2007, 0x2007, 0x2007, 0x2007,
+		0x2007, 0x2007, 0x2007, 0x2007, 0x2007, 0x2007, 0x2007, 0x2007, 0x2090, 0x2090, 0x2090, 0x2090, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C, 0x213C,
+		0x213C, 0x213C, 0x213C, 0x213C, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2217, 0x2270, 0x2270, 0x2270, 0x2270, 0x2270, 0x2270, 0x2270, 0x2270, 0x20D620D6, 0x20D620D6, 0x2254, 0x2254, 0x2280, 0x2280,
+		0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x2323, 0x2323, 0x2323, 0x2323, 0x2323, 0x2323, 0x9F16FBC0, 0x9F17FBC0, 0x2323, 0x2323, 0x2323, 0x2323, 0x2323, 0x2323, 0x9F1EFBC0, 0x9F1FFBC0,
+		0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x232B, 0x232B, 0x232B, 0x232B, 0x232B, 0x232B, 0x232B, 0x232B, 0x232B, 0x232B, 0x232B, 0x232B, 0x232B, 0x232B, 0x232B, 0x232B,
+		0x2333, 0x2333, 0x2333, 0x2333, 0x2333, 0x2333, 0x9F46FBC0, 0x9F47FBC0, 0x2333, 0x2333, 0x2333, 0x2333, 0x2333, 0x2333, 0x9F4EFBC0, 0x9F4FFBC0, 0x2341, 0x2341, 0x2341, 0x2341, 0x2341, 0x2341, 0x2341, 0x2341, 0x9F58FBC0, 0x2341, 0x9F5AFBC0, 0x2341, 0x9F5CFBC0, 0x2341, 0x9F5EFBC0, 0x2341,
+		0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x231E, 0x231E, 0x2323, 0x2323, 0x2329, 0x2329, 0x232B, 0x232B, 0x2333, 0x2333, 0x2341, 0x2341, 0x2346, 0x2346, 0x9F7EFBC0, 0x9F7FFBC0,
+		0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329, 0x2329,
+		0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x2346, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x9FB5FBC0, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x231E, 0x4C0, 0x232B, 0x4C0,
+		0x4C2, 0x4BB, 0x2329, 0x2329, 0x2329, 0x9FC5FBC0, 0x2329, 0x2329, 0x2323, 0x2323, 0x2329, 0x2329, 0x2329, 0x4C0, 0x4C0, 0x4C0, 0x232B, 0x232B, 0x232B, 0x232B, 0x9FD4FBC0, 0x9FD5FBC0, 0x232B, 0x232B, 0x232B, 0x232B, 0x232B, 0x232B, 0x9FDCFBC0, 0x4C1, 0x4C1, 0x4C1,
+		0x2341, 0x2341, 0x2341, 0x2341, 0x2339, 0x2339, 0x2341, 0x2341, 0x2341, 0x2341, 0x2341, 0x2341, 0x2339, 0x4BB, 0x4BB, 0x4B4, 0x9FF0FBC0, 0x9FF1FBC0, 0x2346, 0x2346, 0x2346, 0x9FF5FBC0, 0x2346, 0x2346, 0x2333, 0x2333, 0x2346, 0x2346, 0x2346, 0x4B5, 0x4C1, 0x9FFFFBC0,
+		0x209, 0x209, 0x209, 0x209, 0x209, 0x209, 0x209, 0x209, 0x209, 0x209, 0x209, 0, 0, 0, 0, 0, 0x213, 0x213, 0x214, 0x215, 0x216, 0x217, 0x394, 0x20C, 0x317, 0x318, 0x319, 0x31A, 0x31E, 0x31F, 0x320, 0x321,
+		0x3B3, 0x3B4, 0x3B9, 0x3BA, 0x27E, 0x27E027E, 0x27E027E027E, 0x3BB, 0x207, 0x208, 0, 0, 0, 0, 0, 0x209, 0x3AF, 0x3B1, 0x3BF, 0x3BF03BF, 0x3BF03BF03BF, 0x3C0, 0x3C003C0, 0x3C003C003C0, 0x3C3, 0x31B, 0x31C, 0x3C4, 0x2670267, 0x27C, 0x20A, 0x3C5,
+		0x3C7, 0x3C9, 0x3CA, 0x3BC, 0x676, 0x334, 0x335, 0x26D026D, 0x267026D, 0x26D0267, 0x3AA, 0x39D, 0x3BD, 0x3BE, 0x3A2, 0x23C, 0x3C8, 0x3A3, 0x672, 0x21A, 0x3C6, 0x2F9, 0x2FA, 0x3BF03BF03BF03BF, 0x2FB, 0x2FC, 0x2FD, 0x2FE, 0x2FF, 0x300, 0x301, 0x209,
+		0, 0, 0, 0, 0, 0xA065FBC0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x1F98, 0x2090, 0xA072FBC0, 0xA073FBC0, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x666, 0x671, 0x66B, 0x328, 0x329, 0x2118,
+		0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x666, 0x671, 0x66B, 0x328, 0x329, 0xA08FFBC0, 0x1FA2, 0x2007, 0x213C, 0x2264, 0x201A, 0x2075, 0x20C4, 0x20D6, 0x2109, 0x2118, 0x216B, 0x21D2, 0x21F7, 0xA09DFBC0, 0xA09EFBC0, 0xA09FFBC0,
+		0x1F78, 0x1F79, 0x1F7A, 0x1F7B, 0x1F7C, 0x1F7D, 0x1F7E, 0x1F7F, 0x21D22193, 0x1F80, 0x1F81, 0x1F82, 0x1F83, 0x1F84, 0x1F85, 0x1F86, 0x1F87, 0x1F88, 0x1F89, 0x1F8A, 0x1F8B, 0x1F8C, 0x1F8D, 0x1F8E, 0x1F8F, 0x1F90, 0x1F92, 0x1F93, 0x1F94, 0x1F95, 0x1F96, 0x1F97,
+		0xA0C0FBC0, 0xA0C1FBC0, 0xA0C2FBC0, 0xA0C3FBC0, 0xA0C4FBC0, 0xA0C5FBC0, 0xA0C6FBC0, 0xA0C7FBC0, 0xA0C8FBC0, 0xA0C9FBC0, 0xA0CAFBC0, 0xA0CBFBC0, 0xA0CCFBC0, 0xA0CDFBC0, 0xA0CEFBC0, 0xA0CFFBC0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xA0F1FBC0, 0xA0F2FBC0, 0xA0F3FBC0, 0xA0F4FBC0, 0xA0F5FBC0, 0xA0F6FBC0, 0xA0F7FBC0, 0xA0F8FBC0, 0xA0F9FBC0, 0xA0FAFBC0, 0xA0FBFBC0, 0xA0FCFBC0, 0xA0FDFBC0, 0xA0FEFBC0, 0xA0FFFBC0,
+		0x1FD603A61FA2, 0x21D203A61FA2, 0x1FD6, 0x1FD6052A, 0x5D6, 0x213C03A61FD6, 0x221703A61FD6, 0x201F, 0x5D7, 0x2042052A, 0x2051, 0x2075, 0x2075, 0x2075, 0x2075, 0x2075, 0x2090, 0x2090, 0x20D6, 0x20D6, 0x5D8, 0x2118, 0x213C2118, 0x5D9, 0x5DA, 0x216B, 0x2180, 0x2193, 0x2193, 0x2193, 0x5DB, 0x5DC,
+		0x210921D2, 0x20D6200721F7, 0x210921F7, 0x5DD, 0x2286, 0x5DE, 0x2346, 0x5DF, 0x2286, 0x5E0, 0x20C4, 0x1FA2, 0x1FBC, 0x1FD6, 0x5E1, 0x2007, 0x2007, 0x2042, 0x204F, 0x2109, 0x213C, 0x261E, 0x261F, 0x2620, 0x2621, 0x2090, 0x5E2, 0x22641FA22042, 0x2334, 0x2320, 0x2320, 0x2334,
+		0x665, 0x5E3, 0x5E4, 0x5E5, 0x5E6, 0x1FEB, 0x1FEB, 0x2007, 0x2090, 0x20AB, 0x5E7, 0x701, 0x5E8, 0x21D203A61FA2, 0x204F, 0x5E9, 0x1F9F06761F99, 0x1FA106761F99, 0x1F981F9906761F99, 0x1F9B06761F99, 0x1F9B06761F9A, 0x1F9D06761F99, 0x1F9D06761F9A, 0x1F9D06761F9B, 0x1F9D06761F9C, 0x1F9E06761F99, 0x1F9E06761F9D, 0x1FA006761F99, 0x1FA006761F9B, 0x1FA006761F9D, 0x1FA006761F9F, 0x6761F99,
+		0x2090, 0x20902090, 0x209020902090, 0x22472090, 0x2247, 0x20902247, 0x209020902247, 0x2090209020902247, 0x22642090, 0x2264, 0x20902264, 0x209020902264, 0x20D6, 0x1FD6, 0x1FEB, 0x2109, 0x2090, 0x20902090, 0x209020902090, 0x22472090, 0x2247, 0x20902247, 0x209020902247, 0x2090209020902247, 0x22642090, 0x2264, 0x20902264, 0x209020902264, 0x20D6, 0x1FD6, 0x1FEB, 0x2109,
+		0x1D9B, 0x1D9C, 0x1D9D, 0x1FE9, 0x1FE9, 0x1F9E, 0x1D9E, 0x1D9F, 0x1DA0, 0x1F9B06761F98, 0x5EA, 0x5EB, 0xA18CFBC0, 0xA18DFBC0, 0xA18EFBC0, 0xA18FFBC0, 0x5EC, 0x5EE, 0x5ED, 0x5EF, 0x5F0, 0x5F1, 0x5F2, 0x5F3, 0x5F4, 0x5F5, 0x5EC, 0x5ED, 0x5F6, 0x5F7, 0x5F8, 0x5F9,
+		0x5FA, 0x5FB, 0x5FC, 0x5FD, 0x5FE, 0x5FF, 0x600, 0x601, 0x602, 0x603, 0x604, 0x605, 0x606, 0x607, 0x5F0, 0x608, 0x609, 0x60A, 0x60B, 0x60C, 0x60D, 0x60E, 0x60F, 0x610, 0x611, 0x612, 0x613, 0x614, 0x615, 0x616, 0x617, 0x618,
+		0x619, 0x61A, 0x61B, 0x61C, 0x61D, 0x61E, 0x61F, 0x620, 0x621, 0x622, 0x623, 0x624, 0x625, 0x626, 0x62A, 0x628, 0x626, 0x627, 0x628, 0x629, 0x62A, 0x62B, 0x62C, 0x62D, 0x62E, 0x62F, 0x630, 0x631, 0x632, 0x633, 0x634, 0x635,
+		0x636, 0x637, 0x638, 0x639, 0x63A, 0x63B, 0x63C, 0x63D, 0x63E, 0x63F, 0x640, 0x641, 0x642, 0x643, 0x644, 0x645, 0x646, 0x647, 0x648, 0x649, 0x64A, 0x64B, 0x64C, 0x64D, 0x64E, 0x64F, 0x650, 0x651, 0x652, 0x653, 0x654, 0x655,
+		0x656, 0x657, 0x658, 0x659, 0x659, 0x65A, 0x65B, 0x65C, 0x65D, 0x65D, 0x65E, 0x65F, 0x65F, 0x660, 0x662, 0x663, 0x664, 0x665, 0x671, 0x673, 0x674, 0x675, 0x677, 0x678, 0x679, 0x67A, 0x67B, 0x67C, 0x67E, 0x680, 0x681, 0x682,
+		0x683, 0x684, 0x685, 0x686, 0x686, 0x687, 0x687, 0x688, 0x689, 0x68A, 0x68B, 0x68C, 0x68C068C, 0x68C068C068C, 0x68D, 0x68D068D, 0x68D068D068D, 0x68E, 0x68F, 0x690, 0x691, 0x692, 0x693, 0x694, 0x695, 0x696, 0x697, 0x698, 0x699, 0x69A, 0x69B, 0x69C,
+		0x69D, 0x699, 0x69E, 0x69F, 0x69F, 0x6A0, 0x6A1, 0x6A0, 0x6A2, 0x6A2, 0x6A3, 0x6A4, 0x6A5, 0x6A6, 0x6A7, 0x6A8, 0x6A9, 0x6AA, 0x6AB, 0x6AC, 0x6AD, 0x6AE, 0x6AF, 0x6B0, 0x6B1, 0x6B2, 0x6B3, 0x6B4, 0x6B5, 0x6B6, 0x6B7, 0x6B8,
+		0x66B, 0x6B9, 0x6B9, 0x6BA, 0x6BB, 0x6BC, 0x6BD, 0x6BE, 0x6BF, 0x6C0, 0x6C1, 0x6C2, 0x6C3, 0x6A6, 0x66A, 0x66C, 0x6BB, 0x6BC, 0x6C4, 0x6C5, 0x6C4, 0x6C5, 0x6C6, 0x6C7, 0x6C6, 0x6C7, 0x6C8, 0x6C9, 0x6CA, 0x6CB, 0x6CC, 0x6CD,
+		0x6C8, 0x6C9, 0x6CE, 0x6CF, 0x6CE, 0x6CF, 0x6D0, 0x6D1, 0x6D0, 0x6D1, 0x6D2, 0x6D3, 0x6D4, 0x6D5, 0x6D6, 0x6D7, 0x6D8, 0x6D9, 0x6DA, 0x6DB, 0x6DC, 0x6DD, 0x6DE, 0x6DF, 0x6E0, 0x6E1, 0x6E2, 0x6E3, 0x6E4, 0x6E5, 0x6E6, 0x6E7,
+		0x6E8, 0x6E9, 0x6EA, 0x6EB, 0x6EC, 0x6ED, 0x6EE, 0x6EF, 0x6F0, 0x6F1, 0x6F2, 0x6F3, 0x6EA, 0x6F0, 0x6F1, 0x6F3, 0x6F4, 0x6F5, 0x6F6, 0x6F7, 0x6F8, 0x6F9, 0x6FA, 0x6FB, 0x6FC, 0x6FD, 0x6FE, 0x6FF, 0x700, 0x702, 0x703, 0x704,
+		0x705, 0x706, 0x707, 0x708, 0x709, 0x70A, 0x70B, 0x70C, 0x70D, 0x70E, 0x70F, 0x710, 0x711, 0x712, 0x713, 0x714, 0x715, 0x716, 0x717, 0x718, 0x719, 0x71A, 0x71B, 0x71C, 0x71D, 0x71E, 0x71F, 0x720, 0x721, 0x722, 0x723, 0x724,
+		0x6CA, 0x6CB, 0x6D9, 0x6DA, 0x725, 0x726, 0x727, 0x728, 0x729, 0x72A, 0x6F6, 0x6F7, 0x6F8, 0x6F9, 0x72B, 0x72C, 0x72D, 0x72E, 0x72F, 0x730, 0x731, 0x732, 0x733, 0x734, 0x735, 0x736, 0x737, 0x738, 0x739, 0x73A, 0x73B, 0x73C,
+		0x73D, 0x73E, 0x73F, 0x740, 0x741, 0x742, 0x743, 0x744, 0x336, 0x337, 0x338, 0x339, 0x745, 0x746, 0x747, 0x748, 0x749, 0x74A, 0x74B, 0x74C, 0x74D, 0x74E, 0x74F, 0x750, 0x751, 0x752, 0x753, 0x754, 0x755, 0x756, 0x757, 0x758,
+		0x759, 0x75A, 0x75B, 0x75C, 0x75D, 0x75E, 0x75F, 0x760, 0x761, 0x380, 0x381, 0x762, 0x763, 0x764, 0x765, 0x766, 0x767, 0x768, 0x769, 0x76A, 0x76B, 0x76C, 0x76D, 0x76E, 0x76F, 0x770, 0x771, 0x772, 0x773, 0x774, 0x775, 0x776,
+		0x777, 0x778, 0x779, 0x77A, 0x77B, 0x77C, 0x77D, 0x77E, 0x77F, 0x780, 0x781, 0x782, 0x783, 0x784, 0x785, 0x786, 0x787, 0x788, 0x789, 0x78A, 0x78B, 0x78C, 0x78D, 0x78E, 0x78F, 0x790, 0x791, 0x792, 0x793, 0x794, 0x795, 0x796,
+		0x797, 0x798, 0x799, 0x79A, 0x79B, 0x79C, 0x79D, 0x79E, 0x79F, 0x7A0, 0x7A1, 0x7A2, 0x7A3, 0x7A4, 0x7A5, 0x7A6, 0x7A7, 0x7A8, 0x7A9, 0x7AA, 0x7AB, 0x7AC, 0x7AD, 0x7AE, 0x7AF, 0x7B0, 0x7B1, 0x7B2, 0x7B3, 0x7B4, 0x7B5, 0x7B6,
+		0x7B7, 0x7B8, 0x7B9, 0x7BA, 0x7BB, 0x7BC, 0x7BD, 0x7BE, 0x7BF, 0x7C0, 0x7C1, 0x7C2, 0x7C3, 0x7C4, 0x7C5, 0x7C6, 0x7C7, 0x7C8, 0x7C9, 0x7CA, 0x7CB, 0x7CC, 0x7CD, 0x7CE, 0x7CF, 0x7D0, 0x7D1, 0x7D2, 0x7D3, 0x7D4, 0x7D5, 0x7D6,
+		0x7D7, 0x7D8, 0x7D9, 0x7DA, 0x7DB, 0x7DC, 0x7DD, 0x7DE, 0x7DF, 0x7E0, 0x7E1, 0x7E2, 0x7E3, 0x7E4, 0x7E5, 0x7E6, 0x7E7, 0x7E8, 0x7E9, 0x7EA, 0x7EB, 0x7EC, 0x7ED, 0x7EE, 0x7EF, 0x7F0, 0x7F1, 0x7F2, 0x7F3, 0x7F4, 0x7F5, 0x7F6,
+		0x7F7, 0x7F8, 0x7F9, 0x7FA, 0x7FB, 0x7FC, 0x7FD, 0x7FE, 0x7FF, 0x800, 0x801, 0x802, 0x803, 0x804, 0x805, 0x806, 0x807, 0x808, 0x809, 0x80A, 0x80B, 0x80C, 0x80D, 0x80E, 0x80F, 0x810, 0x811, 0x812, 0x813, 0x814, 0x815, 0x816,
+		0x817, 0x818, 0x819, 0x81A, 0x81B, 0x81C, 0x81D, 0x81E, 0x81F, 0x820, 0x821, 0x822, 0x823, 0x824, 0x825, 0x826, 0x827, 0x828, 0x829, 0x82A, 0x82B, 0x82C, 0x82D, 0x82E, 0x82F, 0x830, 0x831, 0x832, 0x833, 0x834, 0x835, 0x836,
+		0x837, 0x838, 0x839, 0x83A, 0x83B, 0x83C, 0x83D, 0x83E, 0x83F, 0x840, 0x841, 0x842, 0x843, 0x844, 0x845, 0x846, 0x847, 0x848, 0x849, 0x84A, 0x84B, 0x84C, 0x84D, 0x84E, 0x84F, 0x850, 0x851, 0x852, 0x853, 0x854, 0x855, 0x856,
+		0x857, 0x858, 0x859, 0x85A, 0x85B, 0x85C, 0x85D, 0xA427FBC0, 0xA428FBC0, 0xA429FBC0, 0xA42AFBC0, 0xA42BFBC0, 0xA42CFBC0, 0xA42DFBC0, 0xA42EFBC0, 0xA42FFBC0, 0xA430FBC0, 0xA431FBC0, 0xA432FBC0, 0xA433FBC0, 0xA434FBC0, 0xA435FBC0, 0xA436FBC0, 0xA437FBC0, 0xA438FBC0, 0xA439FBC0, 0xA43AFBC0, 0xA43BFBC0, 0xA43CFBC0, 0xA43DFBC0, 0xA43EFBC0, 0xA43FFBC0,
+		0x85E, 0x85F, 0x860, 0x861, 0x862, 0x863, 0x864, 0x865, 0x866, 0x867, 0x868, 0xA44BFBC0, 0xA44CFBC0, 0xA44DFBC0, 0xA44EFBC0, 0xA44FFBC0, 0xA450FBC0, 0xA451FBC0, 0xA452FBC0, 0xA453FBC0, 0xA454FBC0, 0xA455FBC0, 0xA456FBC0, 0xA457FBC0, 0xA458FBC0, 0xA459FBC0, 0xA45AFBC0, 0xA45BFBC0, 0xA45CFBC0, 0xA45DFBC0, 0xA45EFBC0, 0xA45FFBC0,
+		0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x1F981F99, 0x1F991F99, 0x1F9A1F99, 0x1F9B1F99, 0x1F9C1F99, 0x1F9D1F99, 0x1F9E1F99, 0x1F9F1F99, 0x1FA01F99, 0x1FA11F99, 0x1F981F9A, 0x3291F990328, 0x3291F9A0328, 0x3291F9B0328, 0x3291F9C0328, 0x3291F9D0328, 0x3291F9E0328, 0x3291F9F0328, 0x3291FA00328, 0x3291FA10328, 0x3291F981F990328, 0x3291F991F990328, 0x3291F9A1F990328,
+		0x3291F9B1F990328, 0x3291F9C1F990328, 0x3291F9D1F990328, 0x3291F9E1F990328, 0x3291F9F1F990328, 0x3291FA01F990328, 0x3291FA11F990328, 0x3291F981F9A0328, 0x27E1F99, 0x27E1F9A, 0x27E1F9B, 0x27E1F9C, 0x27E1F9D, 0x27E1F9E, 0x27E1F9F, 0x27E1FA0, 0x27E1FA1, 0x27E1F981F99, 0x27E1F991F99, 0x27E1F9A1F99, 0x27E1F9B1F99, 0x27E1F9C1F99, 0x27E1F9D1F99, 0x27E1F9E1F99, 0x27E1F9F1F99, 0x27E1FA01F99, 0x27E1FA11F99, 0x27E1F981F9A, 0x3291FA20328, 0x3291FBC0328, 0x3291FD60328, 0x3291FEB0328,
+		0x32920070328, 0x32920420328, 0x32920510328, 0x32920750328, 0x32920900328, 0x32920AB0328, 0x32920C40328, 0x32920D60328, 0x32921090328, 0x32921180328, 0x329213C0328, 0x329216B0328, 0x32921800328, 0x32921930328, 0x32921D20328, 0x32921F70328, 0x32922170328, 0x32922470328, 0x32922590328, 0x32922640328, 0x32922700328, 0x32922860328, 0x1FA2, 0x1FBC, 0x1FD6, 0x1FEB, 0x2007, 0x2042, 0x2051, 0x2075, 0x2090, 0x20AB,
+		0x20C4, 0x20D6, 0x2109, 0x2118, 0x213C, 0x216B, 0x2180, 0x2193, 0x21D2, 0x21F7, 0x2217, 0x2247, 0x2259, 0x2264, 0x2270, 0x2286, 0x1FA2, 0x1FBC, 0x1FD6, 0x1FEB, 0x2007, 0x2042, 0x2051, 0x2075, 0x2090, 0x20AB, 0x20C4, 0x20D6, 0x2109, 0x2118, 0x213C, 0x216B,
+		0x2180, 0x2193, 0x21D2, 0x21F7, 0x2217, 0x2247, 0x2259, 0x2264, 0x2270, 0x2286, 0x1F98, 0x1F991F99, 0x1F9A1F99, 0x1F9B1F99, 0x1F9C1F99, 0x1F9D1F99, 0x1F9E1F99, 0x1F9F1F99, 0x1FA01F99, 0x1FA11F99, 0x1F981F9A, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x1F981F99, 0x1F98,
+		0x869, 0x86A, 0x86B, 0x86C, 0x86D, 0x86E, 0x86F, 0x870, 0x871, 0x872, 0x873, 0x874, 0x875, 0x876, 0x877, 0x878, 0x879, 0x87A, 0x87B, 0x87C, 0x87D, 0x87E, 0x87F, 0x880, 0x881, 0x882, 0x883, 0x884, 0x885, 0x886, 0x887, 0x888,
+		0x889, 0x88A, 0x88B, 0x88C, 0x88D, 0x88E, 0x88F, 0x890, 0x891, 0x892, 0x893, 0x894, 0x895, 0x896, 0x897, 0x898, 0x899, 0x89A, 0x89B, 0x89C, 0x89D, 0x89E, 0x89F, 0x8A0, 0x8A1, 0x8A2, 0x8A3, 0x8A4, 0x8A5, 0x8A6, 0x8A7, 0x8A8,
+		0x8A9, 0x8AA, 0x8AB, 0x8AC, 0x8AD, 0x8AE, 0x8AF, 0x8B0, 0x8B1, 0x8B2, 0x8B3, 0x8B4, 0x8B5, 0x8B6, 0x8B7, 0x8B8, 0x8B9, 0x8BA, 0x8BB, 0x8BC, 0x8BD, 0x8BE, 0x8BF, 0x8C0, 0x8C1, 0x8C2, 0x8C3, 0x8C4, 0x8C5, 0x8C6, 0x8C7, 0x8C8,
+		0x8C9, 0x8CA, 0x8CB, 0x8CC, 0x8CD, 0x8CE, 0x8CF, 0x8D0, 0x8D1, 0x8D2, 0x8D3, 0x8D4, 0x8D5, 0x8D6, 0x8D7, 0x8D8, 0x8D9, 0x8DA, 0x8DB, 0x8DC, 0x8DD, 0x8DE, 0x8DF, 0x8E0, 0x8E1, 0x8E2, 0x8E3, 0x8E4, 0x8E5, 0x8E6, 0x8E7, 0x8E8,
+		0x8E9, 0x8EA, 0x8EB, 0x8EC, 0x8ED, 0x8EE, 0x8EF, 0x8F0, 0x8F1, 0x8F2, 0x8F3, 0x8F4, 0x8F5, 0x8F6, 0x8F7, 0x8F8, 0x8F9, 0x8FA, 0x8FB, 0x8FC, 0x8FD, 0x8FE, 0x8FF, 0x900, 0x901, 0x902, 0x903, 0x904, 0x905, 0x906, 0x907, 0x908,
+		0x909, 0x90A, 0x90B, 0x90C, 0x90D, 0x90E, 0x90F, 0x910, 0x911, 0x912, 0x913, 0x914, 0x915, 0x916, 0x917, 0x918, 0x919, 0x91A, 0x91B, 0x91C, 0x91D, 0x91E, 0x91F, 0x920, 0x921, 0x922, 0x923, 0x924, 0x925, 0x926, 0x927, 0x928,
+		0x929, 0x92A, 0x92B, 0x92C, 0x92D, 0x92E, 0x92F, 0x930, 0x931, 0x932, 0x933, 0x934, 0x935, 0x936, 0x937, 0x938, 0x939, 0x93A, 0x93B, 0x93C, 0x93D, 0x93E, 0x93F, 0x940, 0x941, 0x942, 0x943, 0x944, 0x945, 0x946, 0x947, 0x948,
+		0x949, 0x94A, 0x94B, 0x94C, 0x94D, 0x94E, 0x94F, 0x950, 0x951, 0x952, 0x953, 0x954, 0x955, 0x956, 0x957, 0x958, 0x959, 0x95A, 0x95B, 0x95C, 0x95D, 0x95E, 0x95F, 0x960, 0x961, 0x962, 0x963, 0x964, 0x965, 0x966, 0x967, 0x968,
+		0xA33, 0xA34, 0xA35, 0xA36, 0xA37, 0xA38, 0xA39, 0xA3A, 0xA3B, 0xA3C, 0xA3D, 0xA3E, 0xA3F, 0xA40, 0xA41, 0xA42, 0xA43, 0xA44, 0xA45, 0xA46, 0xA47, 0xA48, 0xA49, 0xA4A, 0xA4B, 0xA4C, 0xA4D, 0xA4E, 0xA4F, 0xA50, 0xA51, 0xA52,
+		0xA53, 0xA54, 0xA55, 0xA56, 0xA57, 0xA58, 0xA59, 0xA5A, 0xA5B, 0xA5C, 0xA5D, 0xA5E, 0xA5F, 0xA60, 0xA61, 0xA62, 0xFEE, 0xFEF, 0xFF0, 0xFF1, 0xFF2, 0xFF3, 0xFF4, 0xFF5, 0xA63, 0xA64, 0xA65, 0xA66, 0xA67, 0xA68, 0xA69, 0xA6A,
+		0xA6B, 0xA6C, 0xA6D, 0xA6E, 0xA6F, 0xA70, 0xA71, 0xA72, 0xA73, 0xA74, 0xA75, 0xA76, 0xA77, 0xA78, 0xA79, 0xA7A, 0xA7B, 0xA7C, 0xA7D, 0xA7E, 0xA7F, 0xA80, 0xA81, 0xA82, 0xA83, 0xA84, 0xA85, 0xA86, 0xA87, 0xA88, 0xA89, 0xA8A,
+		0xA8B, 0xA8C, 0xA8D, 0xA8E, 0xA8F, 0xA90, 0xA91, 0xA92, 0xA93, 0xA94, 0xA95, 0xA96, 0xA97, 0x1241, 0x1242, 0x1243, 0xA98, 0xA99, 0xA9A, 0xA9B, 0xA9C, 0xA9D, 0xA9E, 0xA9F, 0xAA0, 0xAA1, 0xAA2, 0xAA3, 0xAA4, 0xAA5, 0xAA6, 0xAA7,
+		0xAA8, 0xAA9, 0xAAA, 0xAAB, 0xAAC, 0xAAD, 0xAAE, 0xAAF, 0xAB0, 0xAB1, 0xFE8, 0xFE9, 0xFEA, 0xFEB, 0xFEC, 0xFED, 0xAB2, 0xAB3, 0xAB4, 0xAB5, 0xAB6, 0xAB7, 0xAB8, 0xAB9, 0xABA, 0xABB, 0xABC, 0xABD, 0xABE, 0xABF, 0xAC0, 0xAC1,
+		0xAC2, 0xAC3, 0xAC4, 0xAC5, 0xAC6, 0xAC7, 0xAC8, 0xAC9, 0xACA, 0xACB, 0xACC, 0xACD, 0xACE, 0xACF, 0xAD0, 0xAD1, 0xAD2, 0xAD3, 0xAD4, 0xAD5, 0xAD6, 0xAD7, 0xAD8, 0xAD9, 0xADA, 0xADB, 0xADC, 0xADD, 0xADE, 0xADF, 0xAE0, 0xAE1,
+		0xAE2, 0xAE3, 0xAE4, 0xAE5, 0xAE6, 0xAE7, 0xAE8, 0xAE9, 0xAEA, 0xAEB, 0xAEC, 0xAED, 0xAEE, 0xAEF, 0xAF0, 0xAF1, 0xAF2, 0xAF3, 0xAF4, 0xAF5, 0xAF6, 0xAF7, 0xAF8, 0xAF9, 0xAFA, 0xAFB, 0xAFC, 0xAFD, 0xAFE, 0xAFF, 0xB00, 0xB01,
+		0xB02, 0xB03, 0xB04, 0xB05, 0xB06, 0xB07, 0xB08, 0xB09, 0xB0A, 0xB0B, 0xB0C, 0xB0D, 0xB0E, 0xB0F, 0xB10, 0xB11, 0xB12, 0xB13, 0xB14, 0xB15, 0xB16, 0xB17, 0xB18, 0xB19, 0xB1A, 0xB1B, 0xB1C, 0xB1D, 0xB1E, 0xB1F, 0xB20, 0xB21,
+		0xB3C, 0xB3D, 0xB3E, 0xB3F, 0xB40, 0xB41, 0xB42, 0xB43, 0xB44, 0xB45, 0xB46, 0xB47, 0xB48, 0xB49, 0xB4A, 0xB4B, 0xB4C, 0xB4D, 0xB4E, 0xB4F, 0xB50, 0xB51, 0xB52, 0xB53, 0xB54, 0xB55, 0xB56, 0xB57, 0xB58, 0xB59, 0xB5A, 0xB5B,
+		0xB5C, 0xB5F, 0xB60, 0xB61, 0xB62, 0xB63, 0xB64, 0xB65, 0xB66, 0xB67, 0xB68, 0xB69, 0xB6A, 0xB6B, 0xB6C, 0xB6D, 0xB6E, 0xB6F, 0xB70, 0xB71, 0xB72, 0xB73, 0xB74, 0xB75, 0xB76, 0xB77, 0xB78, 0xB79, 0xB7A, 0xB7B, 0xB7C, 0xB7D,
+		0xB7E, 0xB7F, 0xB80, 0xB81, 0xB82, 0xB83, 0xB84, 0xB85, 0xB86, 0xB87, 0xB88, 0xB89, 0xB8A, 0xB8B, 0xB8C, 0xB8D, 0xB8E, 0xB8F, 0xB90, 0xB91, 0xB92, 0xB93, 0xB94, 0xB95, 0xB96, 0xB97, 0xB98, 0xB99, 0xB9A, 0xB9B, 0xB9C, 0xB9D,
+		0xB9E, 0xB9F, 0xBA0, 0xBA1, 0xBA2, 0xBA3, 0xBA4, 0xBA5, 0x35E, 0x35F, 0x360, 0x361, 0x362, 0x363, 0x364, 0x365, 0x366, 0x367, 0x368, 0x369, 0x36A, 0x36B, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x1F981F99,
+		0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x1F981F99, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x1F981F99, 0xBA6, 0xBA7, 0xBA8, 0xBA9, 0xBAA, 0xBAB, 0xBAC, 0xBAD, 0xBAE, 0xBAF, 0xBB0, 0xBB1,
+		0xBB2, 0xBB3, 0xBB4, 0xBB5, 0xBB6, 0xBB7, 0xBB8, 0xBB9, 0xBBA, 0xBBB, 0xBBC, 0xBBD, 0xBBE, 0xBBF, 0xBC0, 0xBC1, 0xBC2, 0xBC3, 0xBC4, 0xBC5, 0xBC6, 0xBC7, 0xBC8, 0xBC9, 0xBCA, 0xBCB, 0xBCC, 0xBCD, 0xBCE, 0xBCF, 0xBD0, 0xBD1,
+		0xBD2, 0xBD3, 0xBD4, 0xBD5, 0xBD6, 0x352, 0x353, 0xBD7, 0xBD8, 0xBD9, 0xBDA, 0xBDB, 0xBDC, 0xBDD, 0xBDE, 0xBDF, 0xBE0, 0xBE1, 0xBE2, 0xBE3, 0xBE4, 0xBE5, 0xBE6, 0xBE7, 0xBE8, 0xBE9, 0xBEA, 0xBEB, 0xBEC, 0xBED, 0xBEE, 0xBEF,
+		0xBF0, 0xBF1, 0xBF2, 0xBF3, 0xBF4, 0xBF5, 0x354, 0x355, 0x356, 0x357, 0x358, 0x359, 0x35A, 0x35B, 0x35C, 0x35D, 0xBF6, 0xBF7, 0xBF8, 0xBF9, 0xBFA, 0xBFB, 0xBFC, 0xBFD, 0xBFE, 0xBFF, 0xC00, 0xC01, 0xC02, 0xC03, 0xC04, 0xC05,
+		0xEE8, 0xEE9, 0xEEA, 0xEEB, 0xEEC, 0xEED, 0xEEE, 0xEEF, 0xEF0, 0xEF1, 0xEF2, 0xEF3, 0xEF4, 0xEF5, 0xEF6, 0xEF7, 0xEF8, 0xEF9, 0xEFA, 0xEFB, 0xEFC, 0xEFD, 0xEFE, 0xEFF, 0xF00, 0xF01, 0xF02, 0xF03, 0xF04, 0xF05, 0xF06, 0xF07,
+		0xF08, 0xF09, 0xF0A, 0xF0B, 0xF0C, 0xF0D, 0xF0E, 0xF0F, 0xF10, 0xF11, 0xF12, 0xF13, 0xF14, 0xF15, 0xF16, 0xF17, 0xF18, 0xF19, 0xF1A, 0xF1B, 0xF1C, 0xF1D, 0xF1E, 0xF1F, 0xF20, 0xF21, 0xF22, 0xF23, 0xF24, 0xF25, 0xF26, 0xF27,
+		0xF28, 0xF29, 0xF2A, 0xF2B, 0xF2C, 0xF2D, 0xF2E, 0xF2F, 0xF30, 0xF31, 0xF32, 0xF33, 0xF34, 0xF35, 0xF36, 0xF37, 0xF38, 0xF39, 0xF3A, 0xF3B, 0xF3C, 0xF3D, 0xF3E, 0xF3F, 0xF40, 0xF41, 0xF42, 0xF43, 0xF44, 0xF45, 0xF46, 0xF47,
+		0xF48, 0xF49, 0xF4A, 0xF4B, 0xF4C, 0xF4D, 0xF4E, 0xF4F, 0xF50, 0xF51, 0xF52, 0xF53, 0xF54, 0xF55, 0xF56, 0xF57, 0xF58, 0xF59, 0xF5A, 0xF5B, 0xF5C, 0xF5D, 0xF5E, 0xF5F, 0xF60, 0xF61, 0xF62, 0xF63, 0xF64, 0xF65, 0xF66, 0xF67,
+		0xF68, 0xF69, 0xF6A, 0xF6B, 0xF6C, 0xF6D, 0xF6E, 0xF6F, 0xF70, 0xF71, 0xF72, 0xF73, 0xF74, 0xF75, 0xF76, 0xF77, 0xF78, 0xF79, 0xF7A, 0xF7B, 0xF7C, 0xF7D, 0xF7E, 0xF7F, 0xF80, 0xF81, 0xF82, 0xF83, 0xF84, 0xF85, 0xF86, 0xF87,
+		0xF88, 0xF89, 0xF8A, 0xF8B, 0xF8C, 0xF8D, 0xF8E, 0xF8F, 0xF90, 0xF91, 0xF92, 0xF93, 0xF94, 0xF95, 0xF96, 0xF97, 0xF98, 0xF99, 0xF9A, 0xF9B, 0xF9C, 0xF9D, 0xF9E, 0xF9F, 0xFA0, 0xFA1, 0xFA2, 0xFA3, 0xFA4, 0xFA5, 0xFA6, 0xFA7,
+		0xFA8, 0xFA9, 0xFAA, 0xFAB, 0xFAC, 0xFAD, 0xFAE, 0xFAF, 0xFB0, 0xFB1, 0xFB2, 0xFB3, 0xFB4, 0xFB5, 0xFB6, 0xFB7, 0xFB8, 0xFB9, 0xFBA, 0xFBB, 0xFBC, 0xFBD, 0xFBE, 0xFBF, 0xFC0, 0xFC1, 0xFC2, 0xFC3, 0xFC4, 0xFC5, 0xFC6, 0xFC7,
+		0xFC8, 0xFC9, 0xFCA, 0xFCB, 0xFCC, 0xFCD, 0xFCE, 0xFCF, 0xFD0, 0xFD1, 0xFD2, 0xFD3, 0xFD4, 0xFD5, 0xFD6, 0xFD7, 0xFD8, 0xFD9, 0xFDA, 0xFDB, 0xFDC, 0xFDD, 0xFDE, 0xFDF, 0xFE0, 0xFE1, 0xFE2, 0xFE3, 0xFE4, 0xFE5, 0xFE6, 0xFE7,
+		0xC06, 0xC07, 0xC08, 0xC09, 0xC0A, 0xC0B, 0xC0C, 0xC0D, 0xC0E, 0xC0F, 0xC10, 0xC11, 0xC12, 0xC13, 0xC14, 0xC15, 0xC16, 0xC17, 0xC18, 0xC19, 0xC1A, 0xC1B, 0xC1C, 0xC1D, 0xC1E, 0xC1F, 0xC20, 0xC21, 0xC22, 0xC23, 0xC24, 0xC25,
+		0xC26, 0xC27, 0xC28, 0xC29, 0xC2A, 0xC2B, 0xC2C, 0xC2D, 0xC2E, 0xC2F, 0xC30, 0xC31, 0xC32, 0xC33, 0xC34, 0xC35, 0xC36, 0xC37, 0xC38, 0xC39, 0xC3A, 0xC3B, 0xC3C, 0xC3D, 0xC3E, 0xC3F, 0xC40, 0xC41, 0xC42, 0xC43, 0xC44, 0xC45,
+		0xC46, 0xC47, 0xC48, 0xC49, 0xC4A, 0xC4B, 0xC4C, 0xC4D, 0xC4E, 0xC4F, 0xC50, 0xC51, 0xC52, 0xC53, 0xC54, 0xC55, 0xC56, 0xC57, 0xC58, 0xC59, 0xC5A, 0xC5B, 0xC5C, 0xC5D, 0xC5E, 0xC5F, 0xC60, 0xC61, 0xC62, 0xC63, 0xC64, 0xC65,
+		0xC66, 0xC67, 0xC68, 0xC69, 0xC6A, 0xC6B, 0xC6C, 0xC6D, 0xC6E, 0xC6F, 0xC70, 0xC71, 0xC72, 0xC73, 0xC74, 0xC75, 0xC76, 0xC77, 0xC78, 0xC79, 0xC7A, 0xC7B, 0xC7C, 0xC7D, 0xC7E, 0xC7F, 0xC80, 0xC81, 0xC82, 0xC83, 0xC84, 0xC85,
+		0xC86, 0xC87, 0xC88, 0x33C, 0x33D, 0x33E, 0x33F, 0x340, 0x341, 0x342, 0x343, 0x344, 0x345, 0x346, 0x347, 0x348, 0x349, 0x34A, 0x34B, 0x34C, 0x34D, 0x34E, 0x34F, 0x350, 0x351, 0xC89, 0xC8A, 0xC8B, 0xC8C, 0xC8D, 0xC8E, 0xC8F,
+		0xC90, 0xC91, 0xC92, 0xC93, 0xC94, 0xC95, 0xC96, 0xC97, 0xC98, 0xC99, 0xC9A, 0xC9B, 0xC9C, 0xC9D, 0xC9E, 0xC9F, 0xCA0, 0xCA1, 0xCA2, 0xCA3, 0xCA4, 0xCA5, 0xCA6, 0xCA7, 0xCA8, 0xCA9, 0xCAA, 0xCAB, 0xCAC, 0xCAD, 0xCAE, 0xCAF,
+		0xCB0, 0xCB1, 0xCB2, 0xCB3, 0xCB4, 0xCB5, 0xCB6, 0xCB7, 0xCB8, 0xCB9, 0xCBA, 0xCBB, 0xCBC, 0xCBD, 0xCBE, 0xCBF, 0xCC0, 0xCC1, 0xCC2, 0xCC3, 0xCC4, 0xCC5, 0xCC6, 0xCC7, 0x396, 0x397, 0x398, 0x399, 0xCC8, 0xCC9, 0xCCA, 0xCCB,
+		0xCCC, 0xCCD, 0xCCE, 0xCCF, 0xCD0, 0xCD1, 0xCD2, 0xCD3, 0xCD4, 0xCD5, 0xCD6, 0xCD7, 0xCD8, 0xCD9, 0xCDA, 0xCDB, 0xCDC, 0xCDD, 0xCDE, 0xCDF, 0xCE0, 0xCE1, 0xCE2, 0xCE3, 0xCE4, 0xCE5, 0xCE6, 0xCE7, 0x33A, 0x33B, 0xCE8, 0xCE9,
+		0xCEA, 0xCEB, 0xCEC, 0xCED, 0xCEE, 0xCEF, 0xCF0, 0xCF1, 0xCF2, 0xCF3, 0xCF4, 0xCF5, 0x68C068C068C068C, 0xCF6, 0xCF7, 0xCF8, 0xCF9, 0xCFA, 0xCFB, 0xCFC, 0xCFD, 0xCFE, 0xCFF, 0xD00, 0xD01, 0xD02, 0xD03, 0xD04, 0xD05, 0xD06, 0xD07, 0xD08,
+		0xD09, 0xD0A, 0xD0B, 0xD0C, 0xD0D, 0xD0E, 0xD0F, 0xD10, 0xD11, 0xD12, 0xD13, 0xD14, 0xD15, 0xD16, 0xD17, 0xD18, 0xD19, 0xD1A, 0xD1B, 0xD1C, 0xD1D, 0xD1E, 0xD1F, 0xD20, 0xD21, 0xD22, 0xD23, 0xD24, 0xD25, 0xD26, 0xD27, 0xD28,
+		0xD29, 0xD2A, 0xD2B, 0xD2C, 0xD2D, 0xD2E, 0xD2F, 0xD30, 0xD31, 0xD32, 0xD33, 0xD34, 0xD35, 0xD36, 0xD37, 0xD38, 0xD39, 0xD3A, 0xD3B, 0xD3C, 0xD3D, 0xD3E, 0xD3F, 0xD40, 0xD41, 0xD42, 0xD43, 0xD44, 0xD45, 0xD46, 0xD47, 0xD48,
+		0xD49, 0xD4A, 0xD4B, 0xD4C, 0xD4D, 0xD4E, 0xD4F, 0xD50, 0xD51, 0xD52, 0xD53, 0xD54, 0xD55, 0xD56, 0xD57, 0xD58, 0xD59, 0xD5A, 0xD5B, 0xD5C, 0x66B02400240, 0x66B066B, 0x66B066B066B, 0xD5D, 0xD5E, 0xD5F, 0xD60, 0xD61, 0xD62, 0xD63, 0xD64, 0xD65,
+		0xD66, 0xD67, 0xD68, 0xD69, 0xD6A, 0xD6B, 0xD6C, 0xD6D, 0xD6E, 0xD6F, 0xD70, 0xD71, 0xD72, 0xD73, 0xD74, 0xD75, 0xD76, 0xD77, 0xD78, 0xD79, 0xD7A, 0xD7B, 0xD7C, 0xD7D, 0xD7E, 0xD7F, 0xD80, 0xD81, 0xD82, 0xD83, 0xD84, 0xD85,
+		0xD86, 0xD87, 0xD88, 0xD89, 0xD8A, 0xD8B, 0xD8C, 0xD8D, 0xD8E, 0xD8F, 0xD90, 0xD91, 0xD92, 0xD93, 0xD94, 0xD95, 0xD96, 0xD97, 0xD98, 0xD99, 0xD9A, 0xD9B, 0xD9C, 0xD9D, 0xD9E, 0xD9F, 0xDA0, 0xDA1, 0xDA2, 0xDA3, 0xDA4, 0xDA5,
+		0xDA6, 0xDA7, 0xDA8, 0xDA9, 0xDAA, 0xDAB, 0xDAC, 0xDAD, 0xDAE, 0xDAF, 0xDB0, 0xDB1, 0xDB2, 0xDB3, 0xDB4, 0xDB5, 0xDB6, 0xDB7, 0xDB8, 0xDB9, 0xDBA, 0xDBB, 0xDBC, 0xDBD, 0xDBE, 0xDBF, 0xDC0, 0xDC1, 0xDC2, 0xDC2, 0xDC3, 0xDC4,
+		0xDC5, 0xDC6, 0xDC7, 0xDC8, 0xDC9, 0xDCA, 0xDCB, 0xDCC, 0xDCD, 0xDCE, 0xDCF, 0xDD0, 0xDD1, 0xDD2, 0xDD3, 0xDD4, 0xDD5, 0xDD6, 0xDD7, 0xDD8, 0xDD9, 0xDDA, 0xDDB, 0xDDC, 0xDDD, 0xDDE, 0xDDF, 0xDE0, 0xDE1, 0xDE2, 0xDE3, 0xDE4,
+		0xDE5, 0xDE6, 0xDE7, 0xDE8, 0xDE9, 0xDEA, 0xDEB, 0xDEC, 0xDED, 0xDEE, 0xDEF, 0xDF0, 0xDF1, 0xDF2, 0xDF3, 0xDF4, 0xDF5, 0xDF6, 0xDF7, 0xDF8, 0xDF9, 0xDFA, 0xDFB, 0xDFC, 0xDFD, 0xDFE, 0xDFF, 0xE00, 0xE01, 0xE02, 0xE03, 0xE04,
+		0xE05, 0xE06, 0xE07, 0xE08, 0xE09, 0xE0A, 0xE0B, 0xE0C, 0xE0D, 0xE0E, 0xE0F, 0xE10, 0xE11, 0xE12, 0xE13, 0xE14, 0xE15, 0xE16, 0xE17, 0xE18, 0xE19, 0xE1A, 0xE1B, 0xE1C, 0xE1D, 0xE1E, 0xE1F, 0xE20, 0xE21, 0xE22, 0xE23, 0xE24,
+		0xE25, 0xE26, 0xE27, 0xE28, 0xE29, 0xE2A, 0xE2B, 0xE2C, 0xE2D, 0xE2E, 0xE2F, 0xE30, 0xE31, 0xE32, 0xE33, 0xE34, 0xE35, 0xE36, 0xE37, 0xE38, 0xE39, 0xE3A, 0xE3B, 0xE3C, 0xE3D, 0xE3E, 0xE3F, 0xE40, 0xE41, 0xE42, 0xE43, 0xE44,
+		0xE45, 0xE46, 0xE47, 0xE48, 0xE49, 0xE4A, 0xE4B, 0xE4C, 0xE4D, 0xE4E, 0xE4F, 0xE50, 0xE51, 0xE52, 0xE53, 0xE54, 0xE55, 0xE56, 0xE57, 0xE58, 0xAB74FBC0, 0xAB75FBC0, 0xE59, 0xE5A, 0xE5B, 0xE5C, 0xE5D, 0xE5E, 0xE5F, 0xE60, 0xE61, 0xE62,
+		0xE63, 0xE64, 0xE65, 0xE66, 0xE67, 0xE68, 0xE69, 0xE6A, 0xE6B, 0xE6C, 0xE6D, 0xE6E, 0xE6F, 0xE70, 0xE71, 0xE72, 0xE73, 0xE74, 0xE75, 0xE76, 0xE77, 0xE78, 0xAB96FBC0, 0xE79, 0xE7A, 0xE7B, 0xE7C, 0xE7D, 0xE7E, 0xE7F, 0xE80, 0xE81,
+		0xE82, 0xE83, 0xE84, 0xE85, 0xE86, 0xE87, 0xE88, 0xE89, 0xE8A, 0xE8B, 0xE8C, 0xE8D, 0xE8E, 0xE8F, 0xE90, 0xE91, 0xE92, 0xE93, 0xE94, 0xE95, 0xE96, 0xE97, 0xE98, 0xE99, 0xE9A, 0xE9B, 0xE9C, 0xE9D, 0xE9E, 0xE9F, 0xEA0, 0xEA1,
+		0xEA2, 0xEA3, 0xEA4, 0xEA5, 0xEA6, 0xEA7, 0xEA8, 0xEA9, 0xEAA, 0xEAB, 0xEAC, 0xEAD, 0xEAE, 0xEAF, 0xEB0, 0xEB1, 0xEB2, 0xEB3, 0xEB4, 0xEB5, 0xEB6, 0xEB7, 0xEB8, 0xEB9, 0xEBA, 0xEBB, 0xEBC, 0xEBD, 0xEBE, 0xEBF, 0xEC0, 0xEC1,
+		0xEC2, 0xEC3, 0xEC4, 0xEC5, 0xEC6, 0xEC7, 0xEC8, 0xEC9, 0xECA, 0xECB, 0xECC, 0xECD, 0xECE, 0xECF, 0xED0, 0xED1, 0xED2, 0xED3, 0xED4, 0xED5, 0xED6, 0xED7, 0xED8, 0xED9, 0xEDA, 0xEDB, 0xEDC, 0xEDD, 0xEDE, 0xEDF, 0xEE0, 0xEE1,
+		0x254A, 0x254B, 0x254C, 0x254D, 0x254E, 0x254F, 0x2550, 0x2551, 0x2552, 0x2553, 0x2554, 0x2555, 0x2556, 0x2557, 0x2558, 0x2559, 0x255A, 0x255B, 0x255C, 0x255D, 0x255E, 0x255F, 0x2560, 0x2561, 0x2562, 0x2563, 0x2564, 0x2565, 0x2566, 0x2567, 0x2568, 0x2569,
+		0x256A, 0x256B, 0x256C, 0x256D, 0x256E, 0x256F, 0x2570, 0x2571, 0x2572, 0x2573, 0x2574, 0x2575, 0x2576, 0x2577, 0x2578, 0xAC2FFBC0, 0x254A, 0x254B, 0x254C, 0x254D, 0x254E, 0x254F, 0x2550, 0x2551, 0x2552, 0x2553, 0x2554, 0x2555, 0x2556, 0x2557, 0x2558, 0x2559,
+		0x255A, 0x255B, 0x255C, 0x255D, 0x255E, 0x255F, 0x2560, 0x2561, 0x2562, 0x2563, 0x2564, 0x2565, 0x2566, 0x2567, 0x2568, 0x2569, 0x256A, 0x256B, 0x256C, 0x256D, 0x256E, 0x256F, 0x2570, 0x2571, 0x2572, 0x2573, 0x2574, 0x2575, 0x2576, 0x2577, 0x2578, 0xAC5FFBC0,
+		0x20E5, 0x20E5, 0x20E6, 0x2170, 0x21B7, 0x1FA7, 0x2200, 0x2086, 0x2086, 0x20CE, 0x20CE, 0x22A1, 0x22A1, 0x1FB1, 0x2110, 0x1FAD, 0x1FB7, 0x2252, 0x225F, 0x225F, 0x2253, 0x2087, 0x2087, 0x217F, 0x2014, 0x21B2, 0x215B, 0x2019, 0x20AB, 0x2247, 0x21DE, 0x229D,
+		0x234B, 0x234B, 0x234C, 0x234C, 0x234D, 0x234D, 0x234E, 0x234E, 0x234F, 0x234F, 0x2351, 0x2351, 0x2352, 0x2352, 0x2353, 0x2353, 0x2354, 0x2354, 0x2355, 0x2355, 0x2356, 0x2356, 0x2358, 0x2358, 0x2359, 0x2359, 0x235A, 0x235A, 0x235D, 0x235D, 0x235E, 0x235E,
+		0x235F, 0x235F, 0x2360, 0x2360, 0x2361, 0x2361, 0x2362, 0x2362, 0x2363, 0x2363, 0x2364, 0x2364, 0x2365, 0x2365, 0x2366, 0x2366, 0x2367, 0x2367, 0x2382, 0x2382, 0x2383, 0x2383, 0x2350, 0x2350, 0x2357, 0x2357, 0x235B, 0x235B, 0x235C, 0x235C, 0x2368, 0x2368,
+		0x2369, 0x2369, 0x236C, 0x236C, 0x236D, 0x236D, 0x236E, 0x236E, 0x2372, 0x2372, 0x2374, 0x2374, 0x2375, 0x2375, 0x2376, 0x2376, 0x2377, 0x2377, 0x2378, 0x2378, 0x2379, 0x2379, 0x237C, 0x237C, 0x237E, 0x237E, 0x237F, 0x237F, 0x2380, 0x2380, 0x2384, 0x2384,
+		0x2385, 0x2385, 0x2386, 0x2386, 0x2355234B2356, 0xEE2, 0xEE3, 0xEE4, 0xEE5, 0xEE6, 0xEE7, 0x236B, 0x236B, 0x237B, 0x237B, 0, 0, 0, 0x2371, 0x2371, 0xACF4FBC0, 0xACF5FBC0, 0xACF6FBC0, 0xACF7FBC0, 0xACF8FBC0, 0x288, 0x275, 0x276, 0x307, 0x1DB7, 0x289, 0x308,
+		0x25A0, 0x25A2, 0x25A4, 0x25A6, 0x25A8, 0x25AA, 0x25AC, 0x25B0, 0x25B2, 0x25B4, 0x25B6, 0x25B8, 0x25BA, 0x25BE, 0x25C0, 0x25C2, 0x25C4, 0x25C6, 0x25C8, 0x25CC, 0x25CE, 0x25D0, 0x25D2, 0x25D4, 0x25D6, 0x25D8, 0x25DA, 0x25DC, 0x25DE, 0x25E0, 0x25E2, 0x25E6,
+		0x25E8, 0x25AE, 0x25BC, 0x25CA, 0x25E4, 0x25EA, 0xAD26FBC0, 0x25ED, 0xAD28FBC0, 0xAD29FBC0, 0xAD2AFBC0, 0xAD2BFBC0, 0xAD2CFBC0, 0x25F2, 0xAD2EFBC0, 0xAD2FFBC0, 0x27D9, 0x27DA, 0x27DB, 0x27DC, 0x27DD, 0x27DE, 0x27DF, 0x27E0, 0x27E1, 0x27E2, 0x27E3, 0x27E4, 0x27E6, 0x27E7, 0x27E8, 0x27E9,
+		0x27EA, 0x27EB, 0x27EC, 0x27ED, 0x27EE, 0x27EF, 0x27F0, 0x27F1, 0x27F2, 0x27F3, 0x27F4, 0x27F5, 0x27F6, 0x27F7, 0x27F8, 0x27F9, 0x27FA, 0x27FB, 0x27FC, 0x27FD, 0x27FF, 0x2800, 0x2801, 0x2802, 0x2803, 0x2804, 0x2805, 0x2806, 0x2807, 0x2808, 0x2809, 0x280A,
+		0x280B, 0x280C, 0x280D, 0x280E, 0x280F, 0x2810, 0x27E5, 0x27FE, 0xAD68FBC0, 0xAD69FBC0, 0xAD6AFBC0, 0xAD6BFBC0, 0xAD6CFBC0, 0xAD6DFBC0, 0xAD6EFBC0, 0x2811, 0x453, 0xAD71FBC0, 0xAD72FBC0, 0xAD73FBC0, 0xAD74FBC0, 0xAD75FBC0, 0xAD76FBC0, 0xAD77FBC0, 0xAD78FBC0, 0xAD79FBC0, 0xAD7AFBC0, 0xAD7BFBC0, 0xAD7CFBC0, 0xAD7DFBC0, 0xAD7EFBC0, 0,
+		0x2822, 0x2837, 0x2848, 0x2851, 0x2860, 0x2886, 0x2897, 0x28A0, 0x28B6, 0x28BF, 0x28C8, 0x28F9, 0x2918, 0x2927, 0x2930, 0x2952, 0x295B, 0x296B, 0x299B, 0x2946, 0x2947, 0x2948, 0x2949, 0xAD97FBC0, 0xAD98FBC0, 0xAD99FBC0, 0xAD9AFBC0, 0xAD9BFBC0, 0xAD9CFBC0, 0xAD9DFBC0, 0xAD9EFBC0, 0xAD9FFBC0,
+		0x299F, 0x29A0, 0x29A1, 0x29A2, 0x29A3, 0x29A4, 0x29A5, 0xADA7FBC0, 0x29A6, 0x29A7, 0x29A8, 0x29A9, 0x29AA, 0x29AB, 0x29AC, 0xADAFFBC0, 0x29AD, 0x29AE, 0x29AF, 0x29B0, 0x29B1, 0x29B2, 0x29B3, 0xADB7FBC0, 0x29B4, 0x29B5, 0x29B6, 0x29B7, 0x29B8, 0x29B9, 0x29BA, 0xADBFFBC0,
+		0x29BB, 0x29BC, 0x29BD, 0x29BE, 0x29BF, 0x29C0, 0x29C1, 0xADC7FBC0, 0x29C2, 0x29C3, 0x29C4, 0x29C5, 0x29C6, 0x29C7, 0x29C8, 0xADCFFBC0, 0x29C9, 0x29CA, 0x29CB, 0x29CC, 0x29CD, 0x29CE, 0x29CF, 0xADD7FBC0, 0x29D0, 0x29D1, 0x29D2, 0x29D3, 0x29D4, 0x29D5, 0x29D6, 0xADDFFBC0,
+		0x2393, 0x2397, 0x239B, 0x23AF, 0x23C7, 0x23D1, 0x23FB, 0x2415, 0x2428, 0x2431, 0x244C, 0x2454, 0x2461, 0x246A, 0x2473, 0x2497, 0x24BE, 0x24C9, 0x24E8, 0x24ED, 0x2538, 0x2473246A, 0x2387, 0x23BF, 0x23FA, 0x248E, 0x2505, 0x250E, 0x2513, 0x251D, 0x2522, 0x252C,
+		0x3CB, 0x3CC, 0x36C, 0x36D, 0x36E, 0x36F, 0x3CD, 0x3CE, 0x3CF, 0x370, 0x371, 0x3D0, 0x372, 0x373, 0x3D1, 0x3D2, 0x3D3, 0x3D4, 0x3D5, 0x3D6, 0x3D7, 0x3D8, 0x3D9, 0x21C, 0x27D, 0x309, 0x3DA, 0x3DB, 0x374, 0x375, 0x3DC, 0x3DD,
+		0x376, 0x377, 0x378, 0x379, 0x37A, 0x37B, 0x37C, 0x37D, 0x37E, 0x37F, 0x302, 0x303, 0x304, 0x305, 0x26F, 0x24F2, 0x28A, 0x294, 0x225, 0x295, 0x224, 0x23D, 0x3B5, 0x3B6, 0x3B7, 0x39B, 0x218, 0x219, 0x28B, 0x306, 0x395, 0x39F,
+		0x21D, 0x226, 0x322, 0x21B, 0x3DE, 0x3DF, 0x3E0, 0x3E1, 0x3E2, 0x23F, 0x3A8, 0x3B8, 0x227, 0x39E, 0x228, 0x229, 0xB5D, 0xB5E, 0x3AB, 0xAE53FBC0, 0xAE54FBC0, 0xAE55FBC0, 0xAE56FBC0, 0xAE57FBC0, 0xAE58FBC0, 0xAE59FBC0, 0xAE5AFBC0, 0xAE5BFBC0, 0xAE5CFBC0, 0xAE5DFBC0, 0xAE5EFBC0, 0xAE5FFBC0,
+		0xAE60FBC0, 0xAE61FBC0, 0xAE62FBC0, 0xAE63FBC0, 0xAE64FBC0, 0xAE65FBC0, 0xAE66FBC0, 0xAE67FBC0, 0xAE68FBC0, 0xAE69FBC0, 0xAE6AFBC0, 0xAE6BFBC0, 0xAE6CFBC0, 0xAE6DFBC0, 0xAE6EFBC0, 0xAE6FFBC0, 0xAE70FBC0, 0xAE71FBC0, 0xAE72FBC0, 0xAE73FBC0, 0xAE74FBC0, 0xAE75FBC0, 0xAE76FBC0, 0xAE77FBC0, 0xAE78FBC0, 0xAE79FBC0, 0xAE7AFBC0, 0xAE7BFBC0, 0xAE7CFBC0, 0xAE7DFBC0, 0xAE7EFBC0, 0xAE7FFBC0,
+		0xCE36FB40, 0xD382FB40, 0xCE5BFB40, 0xCE5AFB40, 0xCE59FB40, 0xCEBBFB40, 0xD182FB40, 0xD1E0FB40, 0xD200FB40, 0xD202FB40, 0xD35CFB40, 0xD369FB40, 0xDC0FFB40, 0xDC0FFB40, 0xDC22FB40, 0xDC23FB40, 0xDC22FB40, 0xDC23FB40, 0xDDF3FB40, 0xDE7AFB40, 0xDF51FB40, 0xDF50FB40, 0xDFC4FB40, 0xDFC3FB40, 0xE24CFB40, 0xE535FB40, 0xAE9AFBC0, 0xE5E1FB40, 0xE5E5FB40, 0xE708FB40, 0xEB7AFB40, 0xEBCDFB40,
+		0xEC11FB40, 0xEC35FB40, 0xEC3AFB40, 0xF06CFB40, 0xF22BFB40, 0xF22BFB40, 0xCE2CFB40, 0xF25BFB40, 0xF2ADFB40, 0xF38BFB40, 0xF58BFB40, 0xF6EEFB40, 0xF93AFB40, 0xF93BFB40, 0xFAF9FB40, 0xFCF9FB40, 0xFE9FFB40, 0xFF53FB40, 0xFF52FB40, 0xFF53FB40, 0xFF53FB40, 0xFF52FB40, 0xFF8AFB40, 0xFF8AFB40, 0xFF8BFB40, 0x8002FB41, 0x8080FB41, 0x807FFB41, 0x8089FB41, 0x81FCFB41, 0x8279FB41, 0x8279FB41,
+		0x8279FB41, 0x864EFB41, 0x8864FB41, 0x8980FB41, 0x897FFB41, 0x89C1FB41, 0x89D2FB41, 0x89D2FB41, 0x8BA0FB41, 0x8D1DFB41, 0x8DB3FB41, 0x8F66FB41, 0x8FB6FB41, 0x8FB6FB41, 0x8FB6FB41, 0x9091FB41, 0x9485FB41, 0x9577FB41, 0x9578FB41, 0x957FFB41, 0x95E8FB41, 0x961CFB41, 0x961DFB41, 0x96E8FB41, 0x9752FB41, 0x97E6FB41, 0x9875FB41, 0x98CEFB41, 0x98DEFB41, 0x98DFFB41, 0x98E0FB41, 0x98E0FB41,
+		0x9963FB41, 0x9996FB41, 0x9A6CFB41, 0x9AA8FB41, 0x9B3CFB41, 0x9C7CFB41, 0x9E1FFB41, 0x9E75FB41, 0x9EA6FB41, 0x9EC4FB41, 0x9EFEFB41, 0x9F4AFB41, 0x9F50FB41, 0x9F52FB41, 0x9F7FFB41, 0x9F8DFB41, 0x9F99FB41, 0x9F9CFB41, 0x9F9CFB41, 0x9F9FFB41, 0xAEF4FBC0, 0xAEF5FBC0, 0xAEF6FBC0, 0xAEF7FBC0, 0xAEF8FBC0, 0xAEF9FBC0, 0xAEFAFBC0, 0xAEFBFBC0, 0xAEFCFBC0, 0xAEFDFBC0, 0xAEFEFBC0, 0xAEFFFBC0,
+		0xCE00FB40, 0xCE28FB40, 0xCE36FB40, 0xCE3FFB40, 0xCE59FB40, 0xCE85FB40, 0xCE8CFB40, 0xCEA0FB40, 0xCEBAFB40, 0xD13FFB40, 0xD165FB40, 0xD16BFB40, 0xD182FB40, 0xD196FB40, 0xD1ABFB40, 0xD1E0FB40, 0xD1F5FB40, 0xD200FB40, 0xD29BFB40, 0xD2F9FB40, 0xD315FB40, 0xD31AFB40, 0xD338FB40, 0xD341FB40, 0xD35CFB40, 0xD369FB40, 0xD382FB40, 0xD3B6FB40, 0xD3C8FB40, 0xD3E3FB40, 0xD6D7FB40, 0xD71FFB40,
+		0xD8EBFB40, 0xD902FB40, 0xD90AFB40, 0xD915FB40, 0xD927FB40, 0xD973FB40, 0xDB50FB40, 0xDB80FB40, 0xDBF8FB40, 0xDC0FFB40, 0xDC22FB40, 0xDC38FB40, 0xDC6EFB40, 0xDC71FB40, 0xDDDBFB40, 0xDDE5FB40, 0xDDF1FB40, 0xDDFEFB40, 0xDE72FB40, 0xDE7AFB40, 0xDE7FFB40, 0xDEF4FB40, 0xDEFEFB40, 0xDF0BFB40, 0xDF13FB40, 0xDF50FB40, 0xDF61FB40, 0xDF73FB40, 0xDFC3FB40, 0xE208FB40, 0xE236FB40, 0xE24BFB40,
+		0xE52FFB40, 0xE534FB40, 0xE587FB40, 0xE597FB40, 0xE5A4FB40, 0xE5B9FB40, 0xE5E0FB40, 0xE5E5FB40, 0xE6F0FB40, 0xE708FB40, 0xE728FB40, 0xEB20FB40, 0xEB62FB40, 0xEB79FB40, 0xEBB3FB40, 0xEBCBFB40, 0xEBD4FB40, 0xEBDBFB40, 0xEC0FFB40, 0xEC14FB40, 0xEC34FB40, 0xF06BFB40, 0xF22AFB40, 0xF236FB40, 0xF23BFB40, 0xF23FFB40, 0xF247FB40, 0xF259FB40, 0xF25BFB40, 0xF2ACFB40, 0xF384FB40, 0xF389FB40,
+		0xF4DCFB40, 0xF4E6FB40, 0xF518FB40, 0xF51FFB40, 0xF528FB40, 0xF530FB40, 0xF58BFB40, 0xF592FB40, 0xF676FB40, 0xF67DFB40, 0xF6AEFB40, 0xF6BFFB40, 0xF6EEFB40, 0xF7DBFB40, 0xF7E2FB40, 0xF7F3FB40, 0xF93AFB40, 0xF9B8FB40, 0xF9BEFB40, 0xFA74FB40, 0xFACBFB40, 0xFAF9FB40, 0xFC73FB40, 0xFCF8FB40, 0xFF36FB40, 0xFF51FB40, 0xFF8AFB40, 0xFFBDFB40, 0x8001FB41, 0x800CFB41, 0x8012FB41, 0x8033FB41,
+		0x807FFB41, 0x8089FB41, 0x81E3FB41, 0x81EAFB41, 0x81F3FB41, 0x81FCFB41, 0x820CFB41, 0x821BFB41, 0x821FFB41, 0x826EFB41, 0x8272FB41, 0x8278FB41, 0x864DFB41, 0x866BFB41, 0x8840FB41, 0x884CFB41, 0x8863FB41, 0x897EFB41, 0x898BFB41, 0x89D2FB41, 0x8A00FB41, 0x8C37FB41, 0x8C46FB41, 0x8C55FB41, 0x8C78FB41, 0x8C9DFB41, 0x8D64FB41, 0x8D70FB41, 0x8DB3FB41, 0x8EABFB41, 0x8ECAFB41, 0x8F9BFB41,
+		0x8FB0FB41, 0x8FB5FB41, 0x9091FB41, 0x9149FB41, 0x91C6FB41, 0x91CCFB41, 0x91D1FB41, 0x9577FB41, 0x9580FB41, 0x961CFB41, 0x96B6FB41, 0x96B9FB41, 0x96E8FB41, 0x9751FB41, 0x975EFB41, 0x9762FB41, 0x9769FB41, 0x97CBFB41, 0x97EDFB41, 0x97F3FB41, 0x9801FB41, 0x98A8FB41, 0x98DBFB41, 0x98DFFB41, 0x9996FB41, 0x9999FB41, 0x99ACFB41, 0x9AA8FB41, 0x9AD8FB41, 0x9ADFFB41, 0x9B25FB41, 0x9B2FFB41,
+		0x9B32FB41, 0x9B3CFB41, 0x9B5AFB41, 0x9CE5FB41, 0x9E75FB41, 0x9E7FFB41, 0x9EA5FB41, 0x9EBBFB41, 0x9EC3FB41, 0x9ECDFB41, 0x9ED1FB41, 0x9EF9FB41, 0x9EFDFB41, 0x9F0EFB41, 0x9F13FB41, 0x9F20FB41, 0x9F3BFB41, 0x9F4AFB41, 0x9F52FB41, 0x9F8DFB41, 0x9F9CFB41, 0x9FA0FB41, 0xAFD6FBC0, 0xAFD7FBC0, 0xAFD8FBC0, 0xAFD9FBC0, 0xAFDAFBC0, 0xAFDBFBC0, 0xAFDCFBC0, 0xAFDDFBC0, 0xAFDEFBC0, 0xAFDFFBC0,
+		0xAFE0FBC0, 0xAFE1FBC0, 0xAFE2FBC0, 0xAFE3FBC0, 0xAFE4FBC0, 0xAFE5FBC0, 0xAFE6FBC0, 0xAFE7FBC0, 0xAFE8FBC0, 0xAFE9FBC0, 0xAFEAFBC0, 0xAFEBFBC0, 0xAFECFBC0, 0xAFEDFBC0, 0xAFEEFBC0, 0xAFEFFBC0, 0x1D1B, 0x1D1C, 0x1D1D, 0x1D1E, 0x1D1F, 0x1D20, 0x1D21, 0x1D22, 0x1D23, 0x1D24, 0x1D25, 0x1D26, 0xAFFCFBC0, 0xAFFDFBC0, 0xAFFEFBC0, 0xAFFFFBC0,
+		0x209, 0x236, 0x292, 0x3C1, 0x1D4B, 0x1F57, 0x42FC42E6, 0x1F98, 0x380, 0x381, 0x382, 0x383, 0x384, 0x385, 0x386, 0x387, 0x388, 0x389, 0x1D4C, 0x1D4D, 0x38A, 0x38B, 0x38C, 0x38D, 0x38E, 0x38F, 0x390, 0x391, 0x21E, 0x323, 0x324, 0x325,
+		0x1D4E, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0, 0, 0, 0, 0, 0, 0x21F, 0x1F5C, 0x1F5C, 0x1F5D, 0x1F5D, 0x1F5E, 0x1D4C, 0x1D4F, 0xD341FB40, 0xD344FB40, 0xD345FB40, 0x1F58, 0x42E742F9, 0x3C2, 0x1D50, 0x1D51,
+		0xB040FBC0, 0x42DA, 0x42DA, 0x42DB, 0x42DB, 0x42DC, 0x42DC, 0x42DE, 0x42DE, 0x42DF, 0x42DF, 0x42E0, 0x42E0, 0x42E1, 0x42E1, 0x42E2, 0x42E2, 0x42E3, 0x42E3, 0x42E4, 0x42E4, 0x42E5, 0x42E5, 0x42E6, 0x42E6, 0x42E7, 0x42E7, 0x42E8, 0x42E8, 0x42E9, 0x42E9, 0x42EA,
+		0x42EA, 0x42EB, 0x42EB, 0x42EC, 0x42EC, 0x42EC, 0x42ED, 0x42ED, 0x42EE, 0x42EE, 0x42EF, 0x42F0, 0x42F1, 0x42F2, 0x42F3, 0x42F4, 0x42F4, 0x42F4, 0x42F5, 0x42F5, 0x42F5, 0x42F6, 0x42F6, 0x42F6, 0x42F7, 0x42F7, 0x42F7, 0x42F8, 0x42F8, 0x42F8, 0x42F9, 0x42FA,
+		0x42FB, 0x42FC, 0x42FD, 0x42FE, 0x42FE, 0x42FF, 0x42FF, 0x4300, 0x4300, 0x4301, 0x4302, 0x4303, 0x4304, 0x4305, 0x4306, 0x4306, 0x4307, 0x4308, 0x4309, 0x430A, 0x42DC, 0x42E0, 0x42E3, 0xB097FBC0, 0xB098FBC0, 0, 0, 0x4C3, 0x4C4, 0x1F5F, 0x1F5F, 0x43024300,
+		0x220, 0x42DA, 0x42DA, 0x42DB, 0x42DB, 0x42DC, 0x42DC, 0x42DE, 0x42DE, 0x42DF, 0x42DF, 0x42E0, 0x42E0, 0x42E1, 0x42E1, 0x42E2, 0x42E2, 0x42E3, 0x42E3, 0x42E4, 0x42E4, 0x42E5, 0x42E5, 0x42E6, 0x42E6, 0x42E7, 0x42E7, 0x42E8, 0x42E8, 0x42E9, 0x42E9, 0x42EA,
+		0x42EA, 0x42EB, 0x42EB, 0x42EC, 0x42EC, 0x42EC, 0x42ED, 0x42ED, 0x42EE, 0x42EE, 0x42EF, 0x42F0, 0x42F1, 0x42F2, 0x42F3, 0x42F4, 0x42F4, 0x42F4, 0x42F5, 0x42F5, 0x42F5, 0x42F6, 0x42F6, 0x42F6, 0x42F7, 0x42F7, 0x42F7, 0x42F8, 0x42F8, 0x42F8, 0x42F9, 0x42FA,
+		0x42FB, 0x42FC, 0x42FD, 0x42FE, 0x42FE, 0x42FF, 0x42FF, 0x4300, 0x4300, 0x4301, 0x4302, 0x4303, 0x4304, 0x4305, 0x4306, 0x4306, 0x4307, 0x4308, 0x4309, 0x430A, 0x42DC, 0x42E0, 0x42E3, 0x4306, 0x4307, 0x4308, 0x4309, 0x221, 0x1F60, 0x1F61, 0x1F61, 0x42EE42E4,
+		0xB100FBC0, 0xB101FBC0, 0xB102FBC0, 0xB103FBC0, 0xB104FBC0, 0x4429, 0x442A, 0x442B, 0x442D, 0x442F, 0x4430, 0x4431, 0x4433, 0x4434, 0x4435, 0x4438, 0x4439, 0x443A, 0x443B, 0x443F, 0x4440, 0x4441, 0x4442, 0x4443, 0x4444, 0x4445, 0x4449, 0x444A, 0x444C, 0x444D, 0x444F, 0x4450,
+		0x4451, 0x4452, 0x4453, 0x4454, 0x4455, 0x4457, 0x445A, 0x445B, 0x445C, 0x445D, 0x442E, 0x4436, 0x443C, 0x445E, 0x444C, 0x4432, 0xB130FBC0, 0x4175, 0x4176, 0x4253, 0x4177, 0x4255, 0x4256, 0x4178, 0x4179, 0x417A, 0x4259, 0x425A, 0x425B, 0x425C, 0x425D, 0x425E,
+		0x418F, 0x417B, 0x417C, 0x417D, 0x4196, 0x417E, 0x417F, 0x4180, 0x4181, 0x4182, 0x4183, 0x4184, 0x4185, 0x4186, 0x4187, 0x41F3, 0x41F4, 0x41F5, 0x41F6, 0x41F7, 0x41F8, 0x41F9, 0x41FA, 0x41FB, 0x41FC, 0x41FD, 0x41FE, 0x41FF, 0x4200, 0x4201, 0x4202, 0x4203,
+		0x4204, 0x4205, 0x4206, 0x4207, 0x41F2, 0x4189, 0x418A, 0x4270, 0x4271, 0x4275, 0x4277, 0x427C, 0x4280, 0x4282, 0x4191, 0x4286, 0x4288, 0x4192, 0x4193, 0x4195, 0x4197, 0x4198, 0x419C, 0x419E, 0x41A0, 0x41A1, 0x41A2, 0x41A3, 0x41A4, 0x41A7, 0x41AB, 0x41B5,
+		0x41BC, 0x41C1, 0x429A, 0x429B, 0x41CC, 0x41CD, 0x41CE, 0x4216, 0x4217, 0x421A, 0x4223, 0x4224, 0x4226, 0x4230, 0x4233, 0xB18FFBC0, 0x1D52, 0x1D53, 0xCE00FB40, 0xCE8CFB40, 0xCE09FB40, 0xD6DBFB40, 0xCE0AFB40, 0xCE2DFB40, 0xCE0BFB40, 0xF532FB40, 0xCE59FB40, 0xCE19FB40, 0xCE01FB40, 0xD929FB40, 0xD730FB40, 0xCEBAFB40,
+		0x4429, 0x4443, 0x4439, 0x4434, 0x444E, 0x444E, 0x444B, 0x444A, 0x445C, 0x4449, 0x445B, 0x445C, 0x442C, 0x4437, 0x444F, 0x4451, 0x4458, 0x4459, 0x4456, 0x445B, 0x442A, 0x4430, 0x4435, 0x4438, 0x4446, 0x4447, 0x4448, 0x4434, 0x443D, 0x443E, 0x445F, 0x4460,
+		0x1D27, 0x1D28, 0x1D29, 0x1D2A, 0x1D2B, 0x1D2C, 0x1D2D, 0x1D2E, 0x1D2F, 0x1D30, 0x1D31, 0x1D32, 0x1D33, 0x1D34, 0x1D35, 0x1D36, 0x1D37, 0x1D38, 0x1D39, 0x1D3A, 0x1D3B, 0x1D3C, 0x1D3D, 0x1D3E, 0x1D3F, 0x1D40, 0x1D41, 0x1D42, 0x1D43, 0x1D44, 0x1D45, 0x1D46,
+		0x1D47, 0x1D48, 0x1D49, 0x1D4A, 0xB1E4FBC0, 0xB1E5FBC0, 0xB1E6FBC0, 0xB1E7FBC0, 0xB1E8FBC0, 0xB1E9FBC0, 0xB1EAFBC0, 0xB1EBFBC0, 0xB1ECFBC0, 0xB1EDFBC0, 0xB1EEFBC0, 0xB1EFFBC0, 0x42E2, 0x42E6, 0x42E7, 0x42EE, 0x42F1, 0x42F4, 0x42F5, 0x42F6, 0x42F7, 0x42F8, 0x42FB, 0x4301, 0x4302, 0x4303, 0x4304, 0x4305,
+		0x32941750328, 0x32941770328, 0x32941780328, 0x329417A0328, 0x329417B0328, 0x329417C0328, 0x329417E0328, 0x32941800328, 0x32941810328, 0x32941830328, 0x32941840328, 0x32941850328, 0x32941860328, 0x32941870328, 0x32941F341750328, 0x32941F341770328, 0x32941F341780328, 0x32941F3417A0328, 0x32941F3417B0328, 0x32941F3417C0328, 0x32941F3417E0328, 0x32941F341800328, 0x32941F341810328, 0x32941F341830328, 0x32941F341840328, 0x32941F341850328, 0x32941F341860328, 0x32941F341870328, 0x329420041810328, 0xFFFD, 0xFFFD, 0xB21FFBC0,
+		0x329CE00FB400328, 0x329CE8CFB400328, 0x329CE09FB400328, 0x329D6DBFB400328, 0x329CE94FB400328, 0x329D16DFB400328, 0x329CE03FB400328, 0x329D16BFB400328, 0x329CE5DFB400328, 0x329D341FB400328, 0x329E708FB400328, 0x329F06BFB400328, 0x329EC34FB400328, 0x329E728FB400328, 0x32991D1FB410328, 0x329D71FFB400328, 0x329E5E5FB400328, 0x329E82AFB400328, 0x329E709FB400328, 0x329F93EFB400328, 0x329D40DFB400328, 0x329F279FB400328, 0x3298CA1FB410328, 0x329F95DFB400328, 0x329D2B4FB400328, 0x329CEE3FB400328, 0x329D47CFB400328, 0x329DB66FB400328, 0x329F6E3FB400328, 0x329CF01FB400328, 0x3298CC7FB410328, 0x329D354FB400328,
+		0x329F96DFB400328, 0x329CF11FB400328, 0x32981EAFB410328, 0x32981F3FB410328, 0xD54FFB40, 0xDE7CFB40, 0xE587FB40, 0xFB8FFB40, 0x1F981F99, 0x1F981F9A, 0x1F981F9B, 0x1F981F9C, 0x1F981F9D, 0x1F981F9E, 0x1F981F9F, 0x1F981FA0, 0x200721F7216B, 0x1F991F9A, 0x1F9A1F9A, 0x1F9B1F9A, 0x1F9C1F9A, 0x1F9D1F9A, 0x1F9E1F9A, 0x1F9F1F9A, 0x1FA01F9A, 0x1FA11F9A, 0x1F981F9B, 0x1F991F9B, 0x1F9A1F9B, 0x1F9B1F9B, 0x1F9C1F9B, 0x1F9D1F9B,
+		0x4175, 0x4177, 0x4178, 0x417A, 0x417B, 0x417C, 0x417E, 0x4180, 0x4181, 0x4183, 0x4184, 0x4185, 0x4186, 0x4187, 0x41F34175, 0x41F34177, 0x41F34178, 0x41F3417A, 0x41F3417B, 0x41F3417C, 0x41F3417E, 0x41F34180, 0x41F34181, 0x41F34183, 0x41F34184, 0x41F34185, 0x41F34186, 0x41F34187, 0xFFFD, 0x4206418042004181, 0x42004180, 0x1D54,
+		0xCE00FB40, 0xCE8CFB40, 0xCE09FB40, 0xD6DBFB40, 0xCE94FB40, 0xD16DFB40, 0xCE03FB40, 0xD16BFB40, 0xCE5DFB40, 0xD341FB40, 0xE708FB40, 0xF06BFB40, 0xEC34FB40, 0xE728FB40, 0x91D1FB41, 0xD71FFB40, 0xE5E5FB40, 0xE82AFB40, 0xE709FB40, 0xF93EFB40, 0xD40DFB40, 0xF279FB40, 0x8CA1FB41, 0xF95DFB40, 0xD2B4FB40, 0xF9D8FB40, 0xF537FB40, 0xD973FB40, 0x9069FB41, 0xD12AFB40, 0xD370FB40, 0xECE8FB40,
+		0x9805FB41, 0xCF11FB40, 0xD199FB40, 0xEB63FB40, 0xCE0AFB40, 0xCE2DFB40, 0xCE0BFB40, 0xDDE6FB40, 0xD3F3FB40, 0xD33BFB40, 0xDB97FB40, 0xDB66FB40, 0xF6E3FB40, 0xCF01FB40, 0x8CC7FB41, 0xD354FB40, 0xD91CFB40, 0x1F9E1F9B, 0x1F9F1F9B, 0x1FA01F9B, 0x1FA11F9B, 0x1F981F9C, 0x1F991F9C, 0x1F9A1F9C, 0x1F9B1F9C, 0x1F9C1F9C, 0x1F9D1F9C, 0x1F9E1F9C, 0x1F9F1F9C, 0x1FA01F9C, 0x1FA11F9C, 0x1F981F9D,
+		0xE708FB401F99, 0xE708FB401F9A, 0xE708FB401F9B, 0xE708FB401F9C, 0xE708FB401F9D, 0xE708FB401F9E, 0xE708FB401F9F, 0xE708FB401FA0, 0xE708FB401FA1, 0xE708FB401F981F99, 0xE708FB401F991F99, 0xE708FB401F9A1F99, 0x20512075, 0x205121932007, 0x22472007, 0x1FEB21F720D6, 0x42DA, 0x42DB, 0x42DC, 0x42DE, 0x42DF, 0x42E0, 0x42E1, 0x42E2, 0x42E3, 0x42E4, 0x42E5, 0x42E6, 0x42E7, 0x42E8, 0x42E9, 0x42EA,
+		0x42EB, 0x42EC, 0x42ED, 0x42EE, 0x42EF, 0x42F0, 0x42F1, 0x42F2, 0x42F3, 0x42F4, 0x42F5, 0x42F6, 0x42F7, 0x42F8, 0x42F9, 0x42FA, 0x42FB, 0x42FC, 0x42FD, 0x42FE, 0x42FF, 0x4300, 0x4301, 0x4302, 0x4303, 0x4304, 0x4305, 0x4306, 0x4307, 0x4308, 0x4309, 0xD48CFB40CEE4FB40,
+		0x42EE1F6042F442DA, 0x42DA42F6430342DA, 0x42DA42F7430A42DA, 0x43031F6042DA, 0x42E2430A42F042DB, 0x42EB430A42DB, 0x430A42DF42DC, 0xFFFD, 0x1F6042E01F6042DE, 0x42E7430A42DF, 0x42FB1F6042DF, 0x430242DB42E0, 0x42EE42EC430142E0, 0x1F604302430542E0, 0x430A430542E0, 0x42F9430A42E0, 0x42E042E1, 0x1F6042F042E1, 0x1F60430242FF42E1, 0x1F6042EA430342E1, 0x430542E1, 0xFFFD, 0xFFFD, 0xFFFD, 0x42FB430142E2, 0xFFFD, 0xFFFD, 0x42F21F60430542E2, 0x42E71F6042E3, 0x42EF430342E4, 0x42F81F6042E4, 0x430342E242DB42E5,
+		0xFFFD, 0x42E2430A430242E6, 0x42EB430A42E8, 0x42EE430A42E8, 0x42E71F6042EA, 0x42E642ED, 0x430342EE, 0x430A42EE, 0x42F342EF, 0x42EE42EC42F3, 0x42EC42DB42F4, 0xFFFD, 0x42EC1F6042F4, 0x430343041F6042F4, 0xFFFD, 0x430342E242F5, 0x42E442F5, 0x430342F5, 0xFFFD, 0x42EE1F6042DB42F6, 0xFFFD, 0x430A430142F6, 0xFFFD, 0x42E942F7, 0x42F542F042F7, 0x42EC430342F7, 0x42E7430A42F7, 0x42E61F6042F7, 0x42EA1F6042F7, 0x42EE430A42DB42F8, 0x42EE430342F8, 0x430A42F8,
+		0x42EE430A42F8, 0x43031F6042F8, 0x430A1F6042F8, 0x430542E242DB42F9, 0x430342DB42F9, 0x42F442EC42F9, 0x42E2430342F9, 0xFFFD, 0x430A430542E242FA, 0x430242FA, 0xFFFD, 0x42E042FC, 0x430A42EE42E042FC, 0x430342EE1F6042FC, 0x42EE1F6042FE, 0x43031F6042FE, 0x430A42DA42FF, 0x430342EE42EC4302, 0x43014302, 0x1F6042F54303, 0x430342F61F604303, 0x42FB4304, 0xFFFD, 0x42EE42EC4306, 0xF0B9FB401F98, 0xF0B9FB401F99, 0xF0B9FB401F9A, 0xF0B9FB401F9B, 0xF0B9FB401F9C, 0xF0B9FB401F9D, 0xF0B9FB401F9E, 0xF0B9FB401F9F,
+		0xF0B9FB401FA0, 0xF0B9FB401FA1, 0xF0B9FB401F981F99, 0xF0B9FB401F991F99, 0xF0B9FB401F9A1F99, 0xF0B9FB401F9B1F99, 0xF0B9FB401F9C1F99, 0xF0B9FB401F9D1F99, 0xF0B9FB401F9E1F99, 0xF0B9FB401F9F1F99, 0xF0B9FB401FA01F99, 0xF0B9FB401FA11F99, 0xF0B9FB401F981F9A, 0xF0B9FB401F991F9A, 0xF0B9FB401F9A1F9A, 0xF0B9FB401F9B1F9A, 0xF0B9FB401F9C1F9A, 0x1FA2216B2075, 0x1FA21FEB, 0x22171FA2, 0x21931FA21FBC, 0x2247213C, 0x1FD6216B, 0x21091FEB, 0x1F9A21091FEB, 0x1F9B21091FEB, 0x22172090, 0xE210FB40DE73FB40, 0xD48CFB40E62DFB40, 0xEB63FB40D927FB40, 0xECBBFB40E60EFB40, 0xFFFD,
+		0x1FA2216B, 0x1FA22118, 0x1FA22330, 0x1FA22109, 0x1FA220C4, 0x1FBC20C4, 0x1FBC2109, 0x1FBC2051, 0x20D61FA21FD6, 0x20D61FA21FD620C4, 0x2042216B, 0x20422118, 0x20422330, 0x20512330, 0x20512109, 0x205120C4, 0x22862075, 0x2286207520C4, 0x228620752109, 0x228620752051, 0x2286207521F7, 0x20D62330, 0x20D62109, 0x20D61FEB, 0x20D620C4, 0x21092042, 0x21092118, 0x21092330, 0x21092109, 0x21091FD6, 0x210920C4, 0x1F9A21092109,
+		0x1F9A21091FD6, 0x1F9A2109, 0x1F9A210920C4, 0x1F9B21092109, 0x1F9B21091FD6, 0x1F9B2109, 0x1F9B210920C4, 0x21D206752109, 0x1F9A21D206752109, 0x1FA2216B, 0x1FA2216B20C4, 0x1FA2216B2109, 0x1FA2216B2051, 0x1FEB1FA22193, 0xFFFD, 0xFFFD, 0x21D2216B, 0x21D22118, 0x21D22330, 0x21D22109, 0x2247216B, 0x22472118, 0x22472330, 0x22472109, 0x224720C4, 0x22472109, 0x2259216B, 0x22592118, 0x22592330, 0x22592109, 0x225920C4, 0x22592109,
+		0x234620C4, 0x23462109, 0x27E2109027E1FA2, 0x21801FBC, 0x1FD61FD6, 0x1FEB1FD6, 0x205120C406751FD6, 0x27E213C1FD6, 0x1FBC1FEB, 0x22702051, 0x1FA22075, 0x216B2075, 0x21182090, 0x20C420C4, 0x210920C4, 0x21F720C4, 0x210920D6, 0x211820D6, 0x2051213C20D6, 0x226420D6, 0x1FBC2109, 0x20D620902109, 0x20D6213C2109, 0x2075216B, 0x27E2109027E216B, 0x2109216B216B, 0x2193216B, 0x219321D2, 0x224721D2, 0x1FBC2259, 0x210906752247, 0x210906751FA2,
+		0xE5E5FB401F99, 0xE5E5FB401F9A, 0xE5E5FB401F9B, 0xE5E5FB401F9C, 0xE5E5FB401F9D, 0xE5E5FB401F9E, 0xE5E5FB401F9F, 0xE5E5FB401FA0, 0xE5E5FB401FA1, 0xE5E5FB401F981F99, 0xE5E5FB401F991F99, 0xE5E5FB401F9A1F99, 0xE5E5FB401F9B1F99, 0xE5E5FB401F9C1F99, 0xE5E5FB401F9D1F99, 0xE5E5FB401F9E1F99, 0xE5E5FB401F9F1F99, 0xE5E5FB401FA01F99, 0xE5E5FB401FA11F99, 0xE5E5FB401F981F9A, 0xE5E5FB401F991F9A, 0xE5E5FB401F9A1F9A, 0xE5E5FB401F9B1F9A, 0xE5E5FB401F9C1F9A, 0xE5E5FB401F9D1F9A, 0xE5E5FB401F9E1F9A, 0xE5E5FB401F9F1F9A, 0xE5E5FB401FA01F9A, 0xE5E5FB401FA11F9A, 0xE5E5FB401F981F9B, 0xE5E5FB401F991F9B, 0x20D61FA22051,
+		0xB400FB80, 0xB401FB80, 0xB402FB80, 0xB403FB80, 0xB404FB80, 0xB405FB80, 0xB406FB80, 0xB407FB80, 0xB408FB80, 0xB409FB80, 0xB40AFB80, 0xB40BFB80, 0xB40CFB80, 0xB40DFB80, 0xB40EFB80, 0xB40FFB80, 0xB410FB80, 0xB411FB80, 0xB412FB80, 0xB413FB80, 0xB414FB80, 0xB415FB80, 0xB416FB80, 0xB417FB80, 0xB418FB80, 0xB419FB80, 0xB41AFB80, 0xB41BFB80, 0xB41CFB80, 0xB41DFB80, 0xB41EFB80, 0xB41FFB80,
+		0xB420FB80, 0xB421FB80, 0xB422FB80, 0xB423FB80, 0xB424FB80, 0xB425FB80, 0xB426FB80, 0xB427FB80, 0xB428FB80, 0xB429FB80, 0xB42AFB80, 0xB42BFB80, 0xB42CFB80, 0xB42DFB80, 0xB42EFB80, 0xB42FFB80, 0xB430FB80, 0xB431FB80, 0xB432FB80, 0xB433FB80, 0xB434FB80, 0xB435FB80, 0xB436FB80, 0xB437FB80, 0xB438FB80, 0xB439FB80, 0xB43AFB80, 0xB43BFB80, 0xB43CFB80, 0xB43DFB80, 0xB43EFB80, 0xB43FFB80,
+		0xB440FB80, 0xB441FB80, 0xB442FB80, 0xB443FB80, 0xB444FB80, 0xB445FB80, 0xB446FB80, 0xB447FB80, 0xB448FB80, 0xB449FB80, 0xB44AFB80, 0xB44BFB80, 0xB44CFB80, 0xB44DFB80, 0xB44EFB80, 0xB44FFB80, 0xB450FB80, 0xB451FB80, 0xB452FB80, 0xB453FB80, 0xB454FB80, 0xB455FB80, 0xB456FB80, 0xB457FB80, 0xB458FB80, 0xB459FB80, 0xB45AFB80, 0xB45BFB80, 0xB45CFB80, 0xB45DFB80, 0xB45EFB80, 0xB45FFB80,
+		0xB460FB80, 0xB461FB80, 0xB462FB80, 0xB463FB80, 0xB464FB80, 0xB465FB80, 0xB466FB80, 0xB467FB80, 0xB468FB80, 0xB469FB80, 0xB46AFB80, 0xB46BFB80, 0xB46CFB80, 0xB46DFB80, 0xB46EFB80, 0xB46FFB80, 0xB470FB80, 0xB471FB80, 0xB472FB80, 0xB473FB80, 0xB474FB80, 0xB475FB80, 0xB476FB80, 0xB477FB80, 0xB478FB80, 0xB479FB80, 0xB47AFB80, 0xB47BFB80, 0xB47CFB80, 0xB47DFB80, 0xB47EFB80, 0xB47FFB80,
+		0xB480FB80, 0xB481FB80, 0xB482FB80, 0xB483FB80, 0xB484FB80, 0xB485FB80, 0xB486FB80, 0xB487FB80, 0xB488FB80, 0xB489FB80, 0xB48AFB80, 0xB48BFB80, 0xB48CFB80, 0xB48DFB80, 0xB48EFB80, 0xB48FFB80, 0xB490FB80, 0xB491FB80, 0xB492FB80, 0xB493FB80, 0xB494FB80, 0xB495FB80, 0xB496FB80, 0xB497FB80, 0xB498FB80, 0xB499FB80, 0xB49AFB80, 0xB49BFB80, 0xB49CFB80, 0xB49DFB80, 0xB49EFB80, 0xB49FFB80,
+		0xB4A0FB80, 0xB4A1FB80, 0xB4A2FB80, 0xB4A3FB80, 0xB4A4FB80, 0xB4A5FB80, 0xB4A6FB80, 0xB4A7FB80, 0xB4A8FB80, 0xB4A9FB80, 0xB4AAFB80, 0xB4ABFB80, 0xB4ACFB80, 0xB4ADFB80, 0xB4AEFB80, 0xB4AFFB80, 0xB4B0FB80, 0xB4B1FB80, 0xB4B2FB80, 0xB4B3FB80, 0xB4B4FB80, 0xB4B5FB80, 0xB4B6FB80, 0xB4B7FB80, 0xB4B8FB80, 0xB4B9FB80, 0xB4BAFB80, 0xB4BBFB80, 0xB4BCFB80, 0xB4BDFB80, 0xB4BEFB80, 0xB4BFFB80,
+		0xB4C0FB80, 0xB4C1FB80, 0xB4C2FB80, 0xB4C3FB80, 0xB4C4FB80, 0xB4C5FB80, 0xB4C6FB80, 0xB4C7FB80, 0xB4C8FB80, 0xB4C9FB80, 0xB4CAFB80, 0xB4CBFB80, 0xB4CCFB80, 0xB4CDFB80, 0xB4CEFB80, 0xB4CFFB80, 0xB4D0FB80, 0xB4D1FB80, 0xB4D2FB80, 0xB4D3FB80, 0xB4D4FB80, 0xB4D5FB80, 0xB4D6FB80, 0xB4D7FB80, 0xB4D8FB80, 0xB4D9FB80, 0xB4DAFB80, 0xB4DBFB80, 0xB4DCFB80, 0xB4DDFB80, 0xB4DEFB80, 0xB4DFFB80,
+		0xB4E0FB80, 0xB4E1FB80, 0xB4E2FB80, 0xB4E3FB80, 0xB4E4FB80, 0xB4E5FB80, 0xB4E6FB80, 0xB4E7FB80, 0xB4E8FB80, 0xB4E9FB80, 0xB4EAFB80, 0xB4EBFB80, 0xB4ECFB80, 0xB4EDFB80, 0xB4EEFB80, 0xB4EFFB80, 0xB4F0FB80, 0xB4F1FB80, 0xB4F2FB80, 0xB4F3FB80, 0xB4F4FB80, 0xB4F5FB80, 0xB4F6FB80, 0xB4F7FB80, 0xB4F8FB80, 0xB4F9FB80, 0xB4FAFB80, 0xB4FBFB80, 0xB4FCFB80, 0xB4FDFB80, 0xB4FEFB80, 0xB4FFFB80,
+		0xB500FB80, 0xB501FB80, 0xB502FB80, 0xB503FB80, 0xB504FB80, 0xB505FB80, 0xB506FB80, 0xB507FB80, 0xB508FB80, 0xB509FB80, 0xB50AFB80, 0xB50BFB80, 0xB50CFB80, 0xB50DFB80, 0xB50EFB80, 0xB50FFB80, 0xB510FB80, 0xB511FB80, 0xB512FB80, 0xB513FB80, 0xB514FB80, 0xB515FB80, 0xB516FB80, 0xB517FB80, 0xB518FB80, 0xB519FB80, 0xB51AFB80, 0xB51BFB80, 0xB51CFB80, 0xB51DFB80, 0xB51EFB80, 0xB51FFB80,
+		0xB520FB80, 0xB521FB80, 0xB522FB80, 0xB523FB80, 0xB524FB80, 0xB525FB80, 0xB526FB80, 0xB527FB80, 0xB528FB80, 0xB529FB80, 0xB52AFB80, 0xB52BFB80, 0xB52CFB80, 0xB52DFB80, 0xB52EFB80, 0xB52FFB80, 0xB530FB80, 0xB531FB80, 0xB532FB80, 0xB533FB80, 0xB534FB80, 0xB535FB80, 0xB536FB80, 0xB537FB80, 0xB538FB80, 0xB539FB80, 0xB53AFB80, 0xB53BFB80, 0xB53CFB80, 0xB53DFB80, 0xB53EFB80, 0xB53FFB80,
+		0xB540FB80, 0xB541FB80, 0xB542FB80, 0xB543FB80, 0xB544FB80, 0xB545FB80, 0xB546FB80, 0xB547FB80, 0xB548FB80, 0xB549FB80, 0xB54AFB80, 0xB54BFB80, 0xB54CFB80, 0xB54DFB80, 0xB54EFB80, 0xB54FFB80, 0xB550FB80, 0xB551FB80, 0xB552FB80, 0xB553FB80, 0xB554FB80, 0xB555FB80, 0xB556FB80, 0xB557FB80, 0xB558FB80, 0xB559FB80, 0xB55AFB80, 0xB55BFB80, 0xB55CFB80, 0xB55DFB80, 0xB55EFB80, 0xB55FFB80,
+		0xB560FB80, 0xB561FB80, 0xB562FB80, 0xB563FB80, 0xB564FB80, 0xB565FB80, 0xB566FB80, 0xB567FB80, 0xB568FB80, 0xB569FB80, 0xB56AFB80, 0xB56BFB80, 0xB56CFB80, 0xB56DFB80, 0xB56EFB80, 0xB56FFB80, 0xB570FB80, 0xB571FB80, 0xB572FB80, 0xB573FB80, 0xB574FB80, 0xB575FB80, 0xB576FB80, 0xB577FB80, 0xB578FB80, 0xB579FB80, 0xB57AFB80, 0xB57BFB80, 0xB57CFB80, 0xB57DFB80, 0xB57EFB80, 0xB57FFB80,
+		0xB580FB80, 0xB581FB80, 0xB582FB80, 0xB583FB80, 0xB584FB80, 0xB585FB80, 0xB586FB80, 0xB587FB80, 0xB588FB80, 0xB589FB80, 0xB58AFB80, 0xB58BFB80, 0xB58CFB80, 0xB58DFB80, 0xB58EFB80, 0xB58FFB80, 0xB590FB80, 0xB591FB80, 0xB592FB80, 0xB593FB80, 0xB594FB80, 0xB595FB80, 0xB596FB80, 0xB597FB80, 0xB598FB80, 0xB599FB80, 0xB59AFB80, 0xB59BFB80, 0xB59CFB80, 0xB59DFB80, 0xB59EFB80, 0xB59FFB80,
+		0xB5A0FB80, 0xB5A1FB80, 0xB5A2FB80, 0xB5A3FB80, 0xB5A4FB80, 0xB5A5FB80, 0xB5A6FB80, 0xB5A7FB80, 0xB5A8FB80, 0xB5A9FB80, 0xB5AAFB80, 0xB5ABFB80, 0xB5ACFB80, 0xB5ADFB80, 0xB5AEFB80, 0xB5AFFB80, 0xB5B0FB80, 0xB5B1FB80, 0xB5B2FB80, 0xB5B3FB80, 0xB5B4FB80, 0xB5B5FB80, 0xB5B6FB80, 0xB5B7FB80, 0xB5B8FB80, 0xB5B9FB80, 0xB5BAFB80, 0xB5BBFB80, 0xB5BCFB80, 0xB5BDFB80, 0xB5BEFB80, 0xB5BFFB80,
+		0xB5C0FB80, 0xB5C1FB80, 0xB5C2FB80, 0xB5C3FB80, 0xB5C4FB80, 0xB5C5FB80, 0xB5C6FB80, 0xB5C7FB80, 0xB5C8FB80, 0xB5C9FB80, 0xB5CAFB80, 0xB5CBFB80, 0xB5CCFB80, 0xB5CDFB80, 0xB5CEFB80, 0xB5CFFB80, 0xB5D0FB80, 0xB5D1FB80, 0xB5D2FB80, 0xB5D3FB80, 0xB5D4FB80, 0xB5D5FB80, 0xB5D6FB80, 0xB5D7FB80, 0xB5D8FB80, 0xB5D9FB80, 0xB5DAFB80, 0xB5DBFB80, 0xB5DCFB80, 0xB5DDFB80, 0xB5DEFB80, 0xB5DFFB80,
+		0xB5E0FB80, 0xB5E1FB80, 0xB5E2FB80, 0xB5E3FB80, 0xB5E4FB80, 0xB5E5FB80, 0xB5E6FB80, 0xB5E7FB80, 0xB5E8FB80, 0xB5E9FB80, 0xB5EAFB80, 0xB5EBFB80, 0xB5ECFB80, 0xB5EDFB80, 0xB5EEFB80, 0xB5EFFB80, 0xB5F0FB80, 0xB5F1FB80, 0xB5F2FB80, 0xB5F3FB80, 0xB5F4FB80, 0xB5F5FB80, 0xB5F6FB80, 0xB5F7FB80, 0xB5F8FB80, 0xB5F9FB80, 0xB5FAFB80, 0xB5FBFB80, 0xB5FCFB80, 0xB5FDFB80, 0xB5FEFB80, 0xB5FFFB80,
+		0xB600FB80, 0xB601FB80, 0xB602FB80, 0xB603FB80, 0xB604FB80, 0xB605FB80, 0xB606FB80, 0xB607FB80, 0xB608FB80, 0xB609FB80, 0xB60AFB80, 0xB60BFB80, 0xB60CFB80, 0xB60DFB80, 0xB60EFB80, 0xB60FFB80, 0xB610FB80, 0xB611FB80, 0xB612FB80, 0xB613FB80, 0xB614FB80, 0xB615FB80, 0xB616FB80, 0xB617FB80, 0xB618FB80, 0xB619FB80, 0xB61AFB80, 0xB61BFB80, 0xB61CFB80, 0xB61DFB80, 0xB61EFB80, 0xB61FFB80,
+		0xB620FB80, 0xB621FB80, 0xB622FB80, 0xB623FB80, 0xB624FB80, 0xB625FB80, 0xB626FB80, 0xB627FB80, 0xB628FB80, 0xB629FB80, 0xB62AFB80, 0xB62BFB80, 0xB62CFB80, 0xB62DFB80, 0xB62EFB80, 0xB62FFB80, 0xB630FB80, 0xB631FB80, 0xB632FB80, 0xB633FB80, 0xB634FB80, 0xB635FB80, 0xB636FB80, 0xB637FB80, 0xB638FB80, 0xB639FB80, 0xB63AFB80, 0xB63BFB80, 0xB63CFB80, 0xB63DFB80, 0xB63EFB80, 0xB63FFB80,
+		0xB640FB80, 0xB641FB80, 0xB642FB80, 0xB643FB80, 0xB644FB80, 0xB645FB80, 0xB646FB80, 0xB647FB80, 0xB648FB80, 0xB649FB80, 0xB64AFB80, 0xB64BFB80, 0xB64CFB80, 0xB64DFB80, 0xB64EFB80, 0xB64FFB80, 0xB650FB80, 0xB651FB80, 0xB652FB80, 0xB653FB80, 0xB654FB80, 0xB655FB80, 0xB656FB80, 0xB657FB80, 0xB658FB80, 0xB659FB80, 0xB65AFB80, 0xB65BFB80, 0xB65CFB80, 0xB65DFB80, 0xB65EFB80, 0xB65FFB80,
+		0xB660FB80, 0xB661FB80, 0xB662FB80, 0xB663FB80, 0xB664FB80, 0xB665FB80, 0xB666FB80, 0xB667FB80, 0xB668FB80, 0xB669FB80, 0xB66AFB80, 0xB66BFB80, 0xB66CFB80, 0xB66DFB80, 0xB66EFB80, 0xB66FFB80, 0xB670FB80, 0xB671FB80, 0xB672FB80, 0xB673FB80, 0xB674FB80, 0xB675FB80, 0xB676FB80, 0xB677FB80, 0xB678FB80, 0xB679FB80, 0xB67AFB80, 0xB67BFB80, 0xB67CFB80, 0xB67DFB80, 0xB67EFB80, 0xB67FFB80,
+		0xB680FB80, 0xB681FB80, 0xB682FB80, 0xB683FB80, 0xB684FB80, 0xB685FB80, 0xB686FB80, 0xB687FB80, 0xB688FB80, 0xB689FB80, 0xB68AFB80, 0xB68BFB80, 0xB68CFB80, 0xB68DFB80, 0xB68EFB80, 0xB68FFB80, 0xB690FB80, 0xB691FB80, 0xB692FB80, 0xB693FB80, 0xB694FB80, 0xB695FB80, 0xB696FB80, 0xB697FB80, 0xB698FB80, 0xB699FB80, 0xB69AFB80, 0xB69BFB80, 0xB69CFB80, 0xB69DFB80, 0xB69EFB80, 0xB69FFB80,
+		0xB6A0FB80, 0xB6A1FB80, 0xB6A2FB80, 0xB6A3FB80, 0xB6A4FB80, 0xB6A5FB80, 0xB6A6FB80, 0xB6A7FB80, 0xB6A8FB80, 0xB6A9FB80, 0xB6AAFB80, 0xB6ABFB80, 0xB6ACFB80, 0xB6ADFB80, 0xB6AEFB80, 0xB6AFFB80, 0xB6B0FB80, 0xB6B1FB80, 0xB6B2FB80, 0xB6B3FB80, 0xB6B4FB80, 0xB6B5FB80, 0xB6B6FB80, 0xB6B7FB80, 0xB6B8FB80, 0xB6B9FB80, 0xB6BAFB80, 0xB6BBFB80, 0xB6BCFB80, 0xB6BDFB80, 0xB6BEFB80, 0xB6BFFB80,
+		0xB6C0FB80, 0xB6C1FB80, 0xB6C2FB80, 0xB6C3FB80, 0xB6C4FB80, 0xB6C5FB80, 0xB6C6FB80, 0xB6C7FB80, 0xB6C8FB80, 0xB6C9FB80, 0xB6CAFB80, 0xB6CBFB80, 0xB6CCFB80, 0xB6CDFB80, 0xB6CEFB80, 0xB6CFFB80, 0xB6D0FB80, 0xB6D1FB80, 0xB6D2FB80, 0xB6D3FB80, 0xB6D4FB80, 0xB6D5FB80, 0xB6D6FB80, 0xB6D7FB80, 0xB6D8FB80, 0xB6D9FB80, 0xB6DAFB80, 0xB6DBFB80, 0xB6DCFB80, 0xB6DDFB80, 0xB6DEFB80, 0xB6DFFB80,
+		0xB6E0FB80, 0xB6E1FB80, 0xB6E2FB80, 0xB6E3FB80, 0xB6E4FB80, 0xB6E5FB80, 0xB6E6FB80, 0xB6E7FB80, 0xB6E8FB80, 0xB6E9FB80, 0xB6EAFB80, 0xB6EBFB80, 0xB6ECFB80, 0xB6EDFB80, 0xB6EEFB80, 0xB6EFFB80, 0xB6F0FB80, 0xB6F1FB80, 0xB6F2FB80, 0xB6F3FB80, 0xB6F4FB80, 0xB6F5FB80, 0xB6F6FB80, 0xB6F7FB80, 0xB6F8FB80, 0xB6F9FB80, 0xB6FAFB80, 0xB6FBFB80, 0xB6FCFB80, 0xB6FDFB80, 0xB6FEFB80, 0xB6FFFB80,
+		0xB700FB80, 0xB701FB80, 0xB702FB80, 0xB703FB80, 0xB704FB80, 0xB705FB80, 0xB706FB80, 0xB707FB80, 0xB708FB80, 0xB709FB80, 0xB70AFB80, 0xB70BFB80, 0xB70CFB80, 0xB70DFB80, 0xB70EFB80, 0xB70FFB80, 0xB710FB80, 0xB711FB80, 0xB712FB80, 0xB713FB80, 0xB714FB80, 0xB715FB80, 0xB716FB80, 0xB717FB80, 0xB718FB80, 0xB719FB80, 0xB71AFB80, 0xB71BFB80, 0xB71CFB80, 0xB71DFB80, 0xB71EFB80, 0xB71FFB80,
+		0xB720FB80, 0xB721FB80, 0xB722FB80, 0xB723FB80, 0xB724FB80, 0xB725FB80, 0xB726FB80, 0xB727FB80, 0xB728FB80, 0xB729FB80, 0xB72AFB80, 0xB72BFB80, 0xB72CFB80, 0xB72DFB80, 0xB72EFB80, 0xB72FFB80, 0xB730FB80, 0xB731FB80, 0xB732FB80, 0xB733FB80, 0xB734FB80, 0xB735FB80, 0xB736FB80, 0xB737FB80, 0xB738FB80, 0xB739FB80, 0xB73AFB80, 0xB73BFB80, 0xB73CFB80, 0xB73DFB80, 0xB73EFB80, 0xB73FFB80,
+		0xB740FB80, 0xB741FB80, 0xB742FB80, 0xB743FB80, 0xB744FB80, 0xB745FB80, 0xB746FB80, 0xB747FB80, 0xB748FB80, 0xB749FB80, 0xB74AFB80, 0xB74BFB80, 0xB74CFB80, 0xB74DFB80, 0xB74EFB80, 0xB74FFB80, 0xB750FB80, 0xB751FB80, 0xB752FB80, 0xB753FB80, 0xB754FB80, 0xB755FB80, 0xB756FB80, 0xB757FB80, 0xB758FB80, 0xB759FB80, 0xB75AFB80, 0xB75BFB80, 0xB75CFB80, 0xB75DFB80, 0xB75EFB80, 0xB75FFB80,
+		0xB760FB80, 0xB761FB80, 0xB762FB80, 0xB763FB80, 0xB764FB80, 0xB765FB80, 0xB766FB80, 0xB767FB80, 0xB768FB80, 0xB769FB80, 0xB76AFB80, 0xB76BFB80, 0xB76CFB80, 0xB76DFB80, 0xB76EFB80, 0xB76FFB80, 0xB770FB80, 0xB771FB80, 0xB772FB80, 0xB773FB80, 0xB774FB80, 0xB775FB80, 0xB776FB80, 0xB777FB80, 0xB778FB80, 0xB779FB80, 0xB77AFB80, 0xB77BFB80, 0xB77CFB80, 0xB77DFB80, 0xB77EFB80, 0xB77FFB80,
+		0xB780FB80, 0xB781FB80, 0xB782FB80, 0xB783FB80, 0xB784FB80, 0xB785FB80, 0xB786FB80, 0xB787FB80, 0xB788FB80, 0xB789FB80, 0xB78AFB80, 0xB78BFB80, 0xB78CFB80, 0xB78DFB80, 0xB78EFB80, 0xB78FFB80, 0xB790FB80, 0xB791FB80, 0xB792FB80, 0xB793FB80, 0xB794FB80, 0xB795FB80, 0xB796FB80, 0xB797FB80, 0xB798FB80, 0xB799FB80, 0xB79AFB80, 0xB79BFB80, 0xB79CFB80, 0xB79DFB80, 0xB79EFB80, 0xB79FFB80,
+		0xB7A0FB80, 0xB7A1FB80, 0xB7A2FB80, 0xB7A3FB80, 0xB7A4FB80, 0xB7A5FB80, 0xB7A6FB80, 0xB7A7FB80, 0xB7A8FB80, 0xB7A9FB80, 0xB7AAFB80, 0xB7ABFB80, 0xB7ACFB80, 0xB7ADFB80, 0xB7AEFB80, 0xB7AFFB80, 0xB7B0FB80, 0xB7B1FB80, 0xB7B2FB80, 0xB7B3FB80, 0xB7B4FB80, 0xB7B5FB80, 0xB7B6FB80, 0xB7B7FB80, 0xB7B8FB80, 0xB7B9FB80, 0xB7BAFB80, 0xB7BBFB80, 0xB7BCFB80, 0xB7BDFB80, 0xB7BEFB80, 0xB7BFFB80,
+		0xB7C0FB80, 0xB7C1FB80, 0xB7C2FB80, 0xB7C3FB80, 0xB7C4FB80, 0xB7C5FB80, 0xB7C6FB80, 0xB7C7FB80, 0xB7C8FB80, 0xB7C9FB80, 0xB7CAFB80, 0xB7CBFB80, 0xB7CCFB80, 0xB7CDFB80, 0xB7CEFB80, 0xB7CFFB80, 0xB7D0FB80, 0xB7D1FB80, 0xB7D2FB80, 0xB7D3FB80, 0xB7D4FB80, 0xB7D5FB80, 0xB7D6FB80, 0xB7D7FB80, 0xB7D8FB80, 0xB7D9FB80, 0xB7DAFB80, 0xB7DBFB80, 0xB7DCFB80, 0xB7DDFB80, 0xB7DEFB80, 0xB7DFFB80,
+		0xB7E0FB80, 0xB7E1FB80, 0xB7E2FB80, 0xB7E3FB80, 0xB7E4FB80, 0xB7E5FB80, 0xB7E6FB80, 0xB7E7FB80, 0xB7E8FB80, 0xB7E9FB80, 0xB7EAFB80, 0xB7EBFB80, 0xB7ECFB80, 0xB7EDFB80, 0xB7EEFB80, 0xB7EFFB80, 0xB7F0FB80, 0xB7F1FB80, 0xB7F2FB80, 0xB7F3FB80, 0xB7F4FB80, 0xB7F5FB80, 0xB7F6FB80, 0xB7F7FB80, 0xB7F8FB80, 0xB7F9FB80, 0xB7FAFB80, 0xB7FBFB80, 0xB7FCFB80, 0xB7FDFB80, 0xB7FEFB80, 0xB7FFFB80,
+		0xB800FB80, 0xB801FB80, 0xB802FB80, 0xB803FB80, 0xB804FB80, 0xB805FB80, 0xB806FB80, 0xB807FB80, 0xB808FB80, 0xB809FB80, 0xB80AFB80, 0xB80BFB80, 0xB80CFB80, 0xB80DFB80, 0xB80EFB80, 0xB80FFB80, 0xB810FB80, 0xB811FB80, 0xB812FB80, 0xB813FB80, 0xB814FB80, 0xB815FB80, 0xB816FB80, 0xB817FB80, 0xB818FB80, 0xB819FB80, 0xB81AFB80, 0xB81BFB80, 0xB81CFB80, 0xB81DFB80, 0xB81EFB80, 0xB81FFB80,
+		0xB820FB80, 0xB821FB80, 0xB822FB80, 0xB823FB80, 0xB824FB80, 0xB825FB80, 0xB826FB80, 0xB827FB80, 0xB828FB80, 0xB829FB80, 0xB82AFB80, 0xB82BFB80, 0xB82CFB80, 0xB82DFB80, 0xB82EFB80, 0xB82FFB80, 0xB830FB80, 0xB831FB80, 0xB832FB80, 0xB833FB80, 0xB834FB80, 0xB835FB80, 0xB836FB80, 0xB837FB80, 0xB838FB80, 0xB839FB80, 0xB83AFB80, 0xB83BFB80, 0xB83CFB80, 0xB83DFB80, 0xB83EFB80, 0xB83FFB80,
+		0xB840FB80, 0xB841FB80, 0xB842FB80, 0xB843FB80, 0xB844FB80, 0xB845FB80, 0xB846FB80, 0xB847FB80, 0xB848FB80, 0xB849FB80, 0xB84AFB80, 0xB84BFB80, 0xB84CFB80, 0xB84DFB80, 0xB84EFB80, 0xB84FFB80, 0xB850FB80, 0xB851FB80, 0xB852FB80, 0xB853FB80, 0xB854FB80, 0xB855FB80, 0xB856FB80, 0xB857FB80, 0xB858FB80, 0xB859FB80, 0xB85AFB80, 0xB85BFB80, 0xB85CFB80, 0xB85DFB80, 0xB85EFB80, 0xB85FFB80,
+		0xB860FB80, 0xB861FB80, 0xB862FB80, 0xB863FB80, 0xB864FB80, 0xB865FB80, 0xB866FB80, 0xB867FB80, 0xB868FB80, 0xB869FB80, 0xB86AFB80, 0xB86BFB80, 0xB86CFB80, 0xB86DFB80, 0xB86EFB80, 0xB86FFB80, 0xB870FB80, 0xB871FB80, 0xB872FB80, 0xB873FB80, 0xB874FB80, 0xB875FB80, 0xB876FB80, 0xB877FB80, 0xB878FB80, 0xB879FB80, 0xB87AFB80, 0xB87BFB80, 0xB87CFB80, 0xB87DFB80, 0xB87EFB80, 0xB87FFB80,
+		0xB880FB80, 0xB881FB80, 0xB882FB80, 0xB883FB80, 0xB884FB80, 0xB885FB80, 0xB886FB80, 0xB887FB80, 0xB888FB80, 0xB889FB80, 0xB88AFB80, 0xB88BFB80, 0xB88CFB80, 0xB88DFB80, 0xB88EFB80, 0xB88FFB80, 0xB890FB80, 0xB891FB80, 0xB892FB80, 0xB893FB80, 0xB894FB80, 0xB895FB80, 0xB896FB80, 0xB897FB80, 0xB898FB80, 0xB899FB80, 0xB89AFB80, 0xB89BFB80, 0xB89CFB80, 0xB89DFB80, 0xB89EFB80, 0xB89FFB80,
+		0xB8A0FB80, 0xB8A1FB80, 0xB8A2FB80, 0xB8A3FB80, 0xB8A4FB80, 0xB8A5FB80, 0xB8A6FB80, 0xB8A7FB80, 0xB8A8FB80, 0xB8A9FB80, 0xB8AAFB80, 0xB8ABFB80, 0xB8ACFB80, 0xB8ADFB80, 0xB8AEFB80, 0xB8AFFB80, 0xB8B0FB80, 0xB8B1FB80, 0xB8B2FB80, 0xB8B3FB80, 0xB8B4FB80, 0xB8B5FB80, 0xB8B6FB80, 0xB8B7FB80, 0xB8B8FB80, 0xB8B9FB80, 0xB8BAFB80, 0xB8BBFB80, 0xB8BCFB80, 0xB8BDFB80, 0xB8BEFB80, 0xB8BFFB80,
+		0xB8C0FB80, 0xB8C1FB80, 0xB8C2FB80, 0xB8C3FB80, 0xB8C4FB80, 0xB8C5FB80, 0xB8C6FB80, 0xB8C7FB80, 0xB8C8FB80, 0xB8C9FB80, 0xB8CAFB80, 0xB8CBFB80, 0xB8CCFB80, 0xB8CDFB80, 0xB8CEFB80, 0xB8CFFB80, 0xB8D0FB80, 0xB8D1FB80, 0xB8D2FB80, 0xB8D3FB80, 0xB8D4FB80, 0xB8D5FB80, 0xB8D6FB80, 0xB8D7FB80, 0xB8D8FB80, 0xB8D9FB80, 0xB8DAFB80, 0xB8DBFB80, 0xB8DCFB80, 0xB8DDFB80, 0xB8DEFB80, 0xB8DFFB80,
+		0xB8E0FB80, 0xB8E1FB80, 0xB8E2FB80, 0xB8E3FB80, 0xB8E4FB80, 0xB8E5FB80, 0xB8E6FB80, 0xB8E7FB80, 0xB8E8FB80, 0xB8E9FB80, 0xB8EAFB80, 0xB8EBFB80, 0xB8ECFB80, 0xB8EDFB80, 0xB8EEFB80, 0xB8EFFB80, 0xB8F0FB80, 0xB8F1FB80, 0xB8F2FB80, 0xB8F3FB80, 0xB8F4FB80, 0xB8F5FB80, 0xB8F6FB80, 0xB8F7FB80, 0xB8F8FB80, 0xB8F9FB80, 0xB8FAFB80, 0xB8FBFB80, 0xB8FCFB80, 0xB8FDFB80, 0xB8FEFB80, 0xB8FFFB80,
+		0xB900FB80, 0xB901FB80, 0xB902FB80, 0xB903FB80, 0xB904FB80, 0xB905FB80, 0xB906FB80, 0xB907FB80, 0xB908FB80, 0xB909FB80, 0xB90AFB80, 0xB90BFB80, 0xB90CFB80, 0xB90DFB80, 0xB90EFB80, 0xB90FFB80, 0xB910FB80, 0xB911FB80, 0xB912FB80, 0xB913FB80, 0xB914FB80, 0xB915FB80, 0xB916FB80, 0xB917FB80, 0xB918FB80, 0xB919FB80, 0xB91AFB80, 0xB91BFB80, 0xB91CFB80, 0xB91DFB80, 0xB91EFB80, 0xB91FFB80,
+		0xB920FB80, 0xB921FB80, 0xB922FB80, 0xB923FB80, 0xB924FB80, 0xB925FB80, 0xB926FB80, 0xB927FB80, 0xB928FB80, 0xB929FB80, 0xB92AFB80, 0xB92BFB80, 0xB92CFB80, 0xB92DFB80, 0xB92EFB80, 0xB92FFB80, 0xB930FB80, 0xB931FB80, 0xB932FB80, 0xB933FB80, 0xB934FB80, 0xB935FB80, 0xB936FB80, 0xB937FB80, 0xB938FB80, 0xB939FB80, 0xB93AFB80, 0xB93BFB80, 0xB93CFB80, 0xB93DFB80, 0xB93EFB80, 0xB93FFB80,
+		0xB940FB80, 0xB941FB80, 0xB942FB80, 0xB943FB80, 0xB944FB80, 0xB945FB80, 0xB946FB80, 0xB947FB80, 0xB948FB80, 0xB949FB80, 0xB94AFB80, 0xB94BFB80, 0xB94CFB80, 0xB94DFB80, 0xB94EFB80, 0xB94FFB80, 0xB950FB80, 0xB951FB80, 0xB952FB80, 0xB953FB80, 0xB954FB80, 0xB955FB80, 0xB956FB80, 0xB957FB80, 0xB958FB80, 0xB959FB80, 0xB95AFB80, 0xB95BFB80, 0xB95CFB80, 0xB95DFB80, 0xB95EFB80, 0xB95FFB80,
+		0xB960FB80, 0xB961FB80, 0xB962FB80, 0xB963FB80, 0xB964FB80, 0xB965FB80, 0xB966FB80, 0xB967FB80, 0xB968FB80, 0xB969FB80, 0xB96AFB80, 0xB96BFB80, 0xB96CFB80, 0xB96DFB80, 0xB96EFB80, 0xB96FFB80, 0xB970FB80, 0xB971FB80, 0xB972FB80, 0xB973FB80, 0xB974FB80, 0xB975FB80, 0xB976FB80, 0xB977FB80, 0xB978FB80, 0xB979FB80, 0xB97AFB80, 0xB97BFB80, 0xB97CFB80, 0xB97DFB80, 0xB97EFB80, 0xB97FFB80,
+		0xB980FB80, 0xB981FB80, 0xB982FB80, 0xB983FB80, 0xB984FB80, 0xB985FB80, 0xB986FB80, 0xB987FB80, 0xB988FB80, 0xB989FB80, 0xB98AFB80, 0xB98BFB80, 0xB98CFB80, 0xB98DFB80, 0xB98EFB80, 0xB98FFB80, 0xB990FB80, 0xB991FB80, 0xB992FB80, 0xB993FB80, 0xB994FB80, 0xB995FB80, 0xB996FB80, 0xB997FB80, 0xB998FB80, 0xB999FB80, 0xB99AFB80, 0xB99BFB80, 0xB99CFB80, 0xB99DFB80, 0xB99EFB80, 0xB99FFB80,
+		0xB9A0FB80, 0xB9A1FB80, 0xB9A2FB80, 0xB9A3FB80, 0xB9A4FB80, 0xB9A5FB80, 0xB9A6FB80, 0xB9A7FB80, 0xB9A8FB80, 0xB9A9FB80, 0xB9AAFB80, 0xB9ABFB80, 0xB9ACFB80, 0xB9ADFB80, 0xB9AEFB80, 0xB9AFFB80, 0xB9B0FB80, 0xB9B1FB80, 0xB9B2FB80, 0xB9B3FB80, 0xB9B4FB80, 0xB9B5FB80, 0xB9B6FB80, 0xB9B7FB80, 0xB9B8FB80, 0xB9B9FB80, 0xB9BAFB80, 0xB9BBFB80, 0xB9BCFB80, 0xB9BDFB80, 0xB9BEFB80, 0xB9BFFB80,
+		0xB9C0FB80, 0xB9C1FB80, 0xB9C2FB80, 0xB9C3FB80, 0xB9C4FB80, 0xB9C5FB80, 0xB9C6FB80, 0xB9C7FB80, 0xB9C8FB80, 0xB9C9FB80, 0xB9CAFB80, 0xB9CBFB80, 0xB9CCFB80, 0xB9CDFB80, 0xB9CEFB80, 0xB9CFFB80, 0xB9D0FB80, 0xB9D1FB80, 0xB9D2FB80, 0xB9D3FB80, 0xB9D4FB80, 0xB9D5FB80, 0xB9D6FB80, 0xB9D7FB80, 0xB9D8FB80, 0xB9D9FB80, 0xB9DAFB80, 0xB9DBFB80, 0xB9DCFB80, 0xB9DDFB80, 0xB9DEFB80, 0xB9DFFB80,
+		0xB9E0FB80, 0xB9E1FB80, 0xB9E2FB80, 0xB9E3FB80, 0xB9E4FB80, 0xB9E5FB80, 0xB9E6FB80, 0xB9E7FB80, 0xB9E8FB80, 0xB9E9FB80, 0xB9EAFB80, 0xB9EBFB80, 0xB9ECFB80, 0xB9EDFB80, 0xB9EEFB80, 0xB9EFFB80, 0xB9F0FB80, 0xB9F1FB80, 0xB9F2FB80, 0xB9F3FB80, 0xB9F4FB80, 0xB9F5FB80, 0xB9F6FB80, 0xB9F7FB80, 0xB9F8FB80, 0xB9F9FB80, 0xB9FAFB80, 0xB9FBFB80, 0xB9FCFB80, 0xB9FDFB80, 0xB9FEFB80, 0xB9FFFB80,
+		0xBA00FB80, 0xBA01FB80, 0xBA02FB80, 0xBA03FB80, 0xBA04FB80, 0xBA05FB80, 0xBA06FB80, 0xBA07FB80, 0xBA08FB80, 0xBA09FB80, 0xBA0AFB80, 0xBA0BFB80, 0xBA0CFB80, 0xBA0DFB80, 0xBA0EFB80, 0xBA0FFB80, 0xBA10FB80, 0xBA11FB80, 0xBA12FB80, 0xBA13FB80, 0xBA14FB80, 0xBA15FB80, 0xBA16FB80, 0xBA17FB80, 0xBA18FB80, 0xBA19FB80, 0xBA1AFB80, 0xBA1BFB80, 0xBA1CFB80, 0xBA1DFB80, 0xBA1EFB80, 0xBA1FFB80,
+		0xBA20FB80, 0xBA21FB80, 0xBA22FB80, 0xBA23FB80, 0xBA24FB80, 0xBA25FB80, 0xBA26FB80, 0xBA27FB80, 0xBA28FB80, 0xBA29FB80, 0xBA2AFB80, 0xBA2BFB80, 0xBA2CFB80, 0xBA2DFB80, 0xBA2EFB80, 0xBA2FFB80, 0xBA30FB80, 0xBA31FB80, 0xBA32FB80, 0xBA33FB80, 0xBA34FB80, 0xBA35FB80, 0xBA36FB80, 0xBA37FB80, 0xBA38FB80, 0xBA39FB80, 0xBA3AFB80, 0xBA3BFB80, 0xBA3CFB80, 0xBA3DFB80, 0xBA3EFB80, 0xBA3FFB80,
+		0xBA40FB80, 0xBA41FB80, 0xBA42FB80, 0xBA43FB80, 0xBA44FB80, 0xBA45FB80, 0xBA46FB80, 0xBA47FB80, 0xBA48FB80, 0xBA49FB80, 0xBA4AFB80, 0xBA4BFB80, 0xBA4CFB80, 0xBA4DFB80, 0xBA4EFB80, 0xBA4FFB80, 0xBA50FB80, 0xBA51FB80, 0xBA52FB80, 0xBA53FB80, 0xBA54FB80, 0xBA55FB80, 0xBA56FB80, 0xBA57FB80, 0xBA58FB80, 0xBA59FB80, 0xBA5AFB80, 0xBA5BFB80, 0xBA5CFB80, 0xBA5DFB80, 0xBA5EFB80, 0xBA5FFB80,
+		0xBA60FB80, 0xBA61FB80, 0xBA62FB80, 0xBA63FB80, 0xBA64FB80, 0xBA65FB80, 0xBA66FB80, 0xBA67FB80, 0xBA68FB80, 0xBA69FB80, 0xBA6AFB80, 0xBA6BFB80, 0xBA6CFB80, 0xBA6DFB80, 0xBA6EFB80, 0xBA6FFB80, 0xBA70FB80, 0xBA71FB80, 0xBA72FB80, 0xBA73FB80, 0xBA74FB80, 0xBA75FB80, 0xBA76FB80, 0xBA77FB80, 0xBA78FB80, 0xBA79FB80, 0xBA7AFB80, 0xBA7BFB80, 0xBA7CFB80, 0xBA7DFB80, 0xBA7EFB80, 0xBA7FFB80,
+		0xBA80FB80, 0xBA81FB80, 0xBA82FB80, 0xBA83FB80, 0xBA84FB80, 0xBA85FB80, 0xBA86FB80, 0xBA87FB80, 0xBA88FB80, 0xBA89FB80, 0xBA8AFB80, 0xBA8BFB80, 0xBA8CFB80, 0xBA8DFB80, 0xBA8EFB80, 0xBA8FFB80, 0xBA90FB80, 0xBA91FB80, 0xBA92FB80, 0xBA93FB80, 0xBA94FB80, 0xBA95FB80, 0xBA96FB80, 0xBA97FB80, 0xBA98FB80, 0xBA99FB80, 0xBA9AFB80, 0xBA9BFB80, 0xBA9CFB80, 0xBA9DFB80, 0xBA9EFB80, 0xBA9FFB80,
+		0xBAA0FB80, 0xBAA1FB80, 0xBAA2FB80, 0xBAA3FB80, 0xBAA4FB80, 0xBAA5FB80, 0xBAA6FB80, 0xBAA7FB80, 0xBAA8FB80, 0xBAA9FB80, 0xBAAAFB80, 0xBAABFB80, 0xBAACFB80, 0xBAADFB80, 0xBAAEFB80, 0xBAAFFB80, 0xBAB0FB80, 0xBAB1FB80, 0xBAB2FB80, 0xBAB3FB80, 0xBAB4FB80, 0xBAB5FB80, 0xBAB6FB80, 0xBAB7FB80, 0xBAB8FB80, 0xBAB9FB80, 0xBABAFB80, 0xBABBFB80, 0xBABCFB80, 0xBABDFB80, 0xBABEFB80, 0xBABFFB80,
+		0xBAC0FB80, 0xBAC1FB80, 0xBAC2FB80, 0xBAC3FB80, 0xBAC4FB80, 0xBAC5FB80, 0xBAC6FB80, 0xBAC7FB80, 0xBAC8FB80, 0xBAC9FB80, 0xBACAFB80, 0xBACBFB80, 0xBACCFB80, 0xBACDFB80, 0xBACEFB80, 0xBACFFB80, 0xBAD0FB80, 0xBAD1FB80, 0xBAD2FB80, 0xBAD3FB80, 0xBAD4FB80, 0xBAD5FB80, 0xBAD6FB80, 0xBAD7FB80, 0xBAD8FB80, 0xBAD9FB80, 0xBADAFB80, 0xBADBFB80, 0xBADCFB80, 0xBADDFB80, 0xBADEFB80, 0xBADFFB80,
+		0xBAE0FB80, 0xBAE1FB80, 0xBAE2FB80, 0xBAE3FB80, 0xBAE4FB80, 0xBAE5FB80, 0xBAE6FB80, 0xBAE7FB80, 0xBAE8FB80, 0xBAE9FB80, 0xBAEAFB80, 0xBAEBFB80, 0xBAECFB80, 0xBAEDFB80, 0xBAEEFB80, 0xBAEFFB80, 0xBAF0FB80, 0xBAF1FB80, 0xBAF2FB80, 0xBAF3FB80, 0xBAF4FB80, 0xBAF5FB80, 0xBAF6FB80, 0xBAF7FB80, 0xBAF8FB80, 0xBAF9FB80, 0xBAFAFB80, 0xBAFBFB80, 0xBAFCFB80, 0xBAFDFB80, 0xBAFEFB80, 0xBAFFFB80,
+		0xBB00FB80, 0xBB01FB80, 0xBB02FB80, 0xBB03FB80, 0xBB04FB80, 0xBB05FB80, 0xBB06FB80, 0xBB07FB80, 0xBB08FB80, 0xBB09FB80, 0xBB0AFB80, 0xBB0BFB80, 0xBB0CFB80, 0xBB0DFB80, 0xBB0EFB80, 0xBB0FFB80, 0xBB10FB80, 0xBB11FB80, 0xBB12FB80, 0xBB13FB80, 0xBB14FB80, 0xBB15FB80, 0xBB16FB80, 0xBB17FB80, 0xBB18FB80, 0xBB19FB80, 0xBB1AFB80, 0xBB1BFB80, 0xBB1CFB80, 0xBB1DFB80, 0xBB1EFB80, 0xBB1FFB80,
+		0xBB20FB80, 0xBB21FB80, 0xBB22FB80, 0xBB23FB80, 0xBB24FB80, 0xBB25FB80, 0xBB26FB80, 0xBB27FB80, 0xBB28FB80, 0xBB29FB80, 0xBB2AFB80, 0xBB2BFB80, 0xBB2CFB80, 0xBB2DFB80, 0xBB2EFB80, 0xBB2FFB80, 0xBB30FB80, 0xBB31FB80, 0xBB32FB80, 0xBB33FB80, 0xBB34FB80, 0xBB35FB80, 0xBB36FB80, 0xBB37FB80, 0xBB38FB80, 0xBB39FB80, 0xBB3AFB80, 0xBB3BFB80, 0xBB3CFB80, 0xBB3DFB80, 0xBB3EFB80, 0xBB3FFB80,
+		0xBB40FB80, 0xBB41FB80, 0xBB42FB80, 0xBB43FB80, 0xBB44FB80, 0xBB45FB80, 0xBB46FB80, 0xBB47FB80, 0xBB48FB80, 0xBB49FB80, 0xBB4AFB80, 0xBB4BFB80, 0xBB4CFB80, 0xBB4DFB80, 0xBB4EFB80, 0xBB4FFB80, 0xBB50FB80, 0xBB51FB80, 0xBB52FB80, 0xBB53FB80, 0xBB54FB80, 0xBB55FB80, 0xBB56FB80, 0xBB57FB80, 0xBB58FB80, 0xBB59FB80, 0xBB5AFB80, 0xBB5BFB80, 0xBB5CFB80, 0xBB5DFB80, 0xBB5EFB80, 0xBB5FFB80,
+		0xBB60FB80, 0xBB61FB80, 0xBB62FB80, 0xBB63FB80, 0xBB64FB80, 0xBB65FB80, 0xBB66FB80, 0xBB67FB80, 0xBB68FB80, 0xBB69FB80, 0xBB6AFB80, 0xBB6BFB80, 0xBB6CFB80, 0xBB6DFB80, 0xBB6EFB80, 0xBB6FFB80, 0xBB70FB80, 0xBB71FB80, 0xBB72FB80, 0xBB73FB80, 0xBB74FB80, 0xBB75FB80, 0xBB76FB80, 0xBB77FB80, 0xBB78FB80, 0xBB79FB80, 0xBB7AFB80, 0xBB7BFB80, 0xBB7CFB80, 0xBB7DFB80, 0xBB7EFB80, 0xBB7FFB80,
+		0xBB80FB80, 0xBB81FB80, 0xBB82FB80, 0xBB83FB80, 0xBB84FB80, 0xBB85FB80, 0xBB86FB80, 0xBB87FB80, 0xBB88FB80, 0xBB89FB80, 0xBB8AFB80, 0xBB8BFB80, 0xBB8CFB80, 0xBB8DFB80, 0xBB8EFB80, 0xBB8FFB80, 0xBB90FB80, 0xBB91FB80, 0xBB92FB80, 0xBB93FB80, 0xBB94FB80, 0xBB95FB80, 0xBB96FB80, 0xBB97FB80, 0xBB98FB80, 0xBB99FB80, 0xBB9AFB80, 0xBB9BFB80, 0xBB9CFB80, 0xBB9DFB80, 0xBB9EFB80, 0xBB9FFB80,
+		0xBBA0FB80, 0xBBA1FB80, 0xBBA2FB80, 0xBBA3FB80, 0xBBA4FB80, 0xBBA5FB80, 0xBBA6FB80, 0xBBA7FB80, 0xBBA8FB80, 0xBBA9FB80, 0xBBAAFB80, 0xBBABFB80, 0xBBACFB80, 0xBBADFB80, 0xBBAEFB80, 0xBBAFFB80, 0xBBB0FB80, 0xBBB1FB80, 0xBBB2FB80, 0xBBB3FB80, 0xBBB4FB80, 0xBBB5FB80, 0xBBB6FB80, 0xBBB7FB80, 0xBBB8FB80, 0xBBB9FB80, 0xBBBAFB80, 0xBBBBFB80, 0xBBBCFB80, 0xBBBDFB80, 0xBBBEFB80, 0xBBBFFB80,
+		0xBBC0FB80, 0xBBC1FB80, 0xBBC2FB80, 0xBBC3FB80, 0xBBC4FB80, 0xBBC5FB80, 0xBBC6FB80, 0xBBC7FB80, 0xBBC8FB80, 0xBBC9FB80, 0xBBCAFB80, 0xBBCBFB80, 0xBBCCFB80, 0xBBCDFB80, 0xBBCEFB80, 0xBBCFFB80, 0xBBD0FB80, 0xBBD1FB80, 0xBBD2FB80, 0xBBD3FB80, 0xBBD4FB80, 0xBBD5FB80, 0xBBD6FB80, 0xBBD7FB80, 0xBBD8FB80, 0xBBD9FB80, 0xBBDAFB80, 0xBBDBFB80, 0xBBDCFB80, 0xBBDDFB80, 0xBBDEFB80, 0xBBDFFB80,
+		0xBBE0FB80, 0xBBE1FB80, 0xBBE2FB80, 0xBBE3FB80, 0xBBE4FB80, 0xBBE5FB80, 0xBBE6FB80, 0xBBE7FB80, 0xBBE8FB80, 0xBBE9FB80, 0xBBEAFB80, 0xBBEBFB80, 0xBBECFB80, 0xBBEDFB80, 0xBBEEFB80, 0xBBEFFB80, 0xBBF0FB80, 0xBBF1FB80, 0xBBF2FB80, 0xBBF3FB80, 0xBBF4FB80, 0xBBF5FB80, 0xBBF6FB80, 0xBBF7FB80, 0xBBF8FB80, 0xBBF9FB80, 0xBBFAFB80, 0xBBFBFB80, 0xBBFCFB80, 0xBBFDFB80, 0xBBFEFB80, 0xBBFFFB80,
+		0xBC00FB80, 0xBC01FB80, 0xBC02FB80, 0xBC03FB80, 0xBC04FB80, 0xBC05FB80, 0xBC06FB80, 0xBC07FB80, 0xBC08FB80, 0xBC09FB80, 0xBC0AFB80, 0xBC0BFB80, 0xBC0CFB80, 0xBC0DFB80, 0xBC0EFB80, 0xBC0FFB80, 0xBC10FB80, 0xBC11FB80, 0xBC12FB80, 0xBC13FB80, 0xBC14FB80, 0xBC15FB80, 0xBC16FB80, 0xBC17FB80, 0xBC18FB80, 0xBC19FB80, 0xBC1AFB80, 0xBC1BFB80, 0xBC1CFB80, 0xBC1DFB80, 0xBC1EFB80, 0xBC1FFB80,
+		0xBC20FB80, 0xBC21FB80, 0xBC22FB80, 0xBC23FB80, 0xBC24FB80, 0xBC25FB80, 0xBC26FB80, 0xBC27FB80, 0xBC28FB80, 0xBC29FB80, 0xBC2AFB80, 0xBC2BFB80, 0xBC2CFB80, 0xBC2DFB80, 0xBC2EFB80, 0xBC2FFB80, 0xBC30FB80, 0xBC31FB80, 0xBC32FB80, 0xBC33FB80, 0xBC34FB80, 0xBC35FB80, 0xBC36FB80, 0xBC37FB80, 0xBC38FB80, 0xBC39FB80, 0xBC3AFB80, 0xBC3BFB80, 0xBC3CFB80, 0xBC3DFB80, 0xBC3EFB80, 0xBC3FFB80,
+		0xBC40FB80, 0xBC41FB80, 0xBC42FB80, 0xBC43FB80, 0xBC44FB80, 0xBC45FB80, 0xBC46FB80, 0xBC47FB80, 0xBC48FB80, 0xBC49FB80, 0xBC4AFB80, 0xBC4BFB80, 0xBC4CFB80, 0xBC4DFB80, 0xBC4EFB80, 0xBC4FFB80, 0xBC50FB80, 0xBC51FB80, 0xBC52FB80, 0xBC53FB80, 0xBC54FB80, 0xBC55FB80, 0xBC56FB80, 0xBC57FB80, 0xBC58FB80, 0xBC59FB80, 0xBC5AFB80, 0xBC5BFB80, 0xBC5CFB80, 0xBC5DFB80, 0xBC5EFB80, 0xBC5FFB80,
+		0xBC60FB80, 0xBC61FB80, 0xBC62FB80, 0xBC63FB80, 0xBC64FB80, 0xBC65FB80, 0xBC66FB80, 0xBC67FB80, 0xBC68FB80, 0xBC69FB80, 0xBC6AFB80, 0xBC6BFB80, 0xBC6CFB80, 0xBC6DFB80, 0xBC6EFB80, 0xBC6FFB80, 0xBC70FB80, 0xBC71FB80, 0xBC72FB80, 0xBC73FB80, 0xBC74FB80, 0xBC75FB80, 0xBC76FB80, 0xBC77FB80, 0xBC78FB80, 0xBC79FB80, 0xBC7AFB80, 0xBC7BFB80, 0xBC7CFB80, 0xBC7DFB80, 0xBC7EFB80, 0xBC7FFB80,
+		0xBC80FB80, 0xBC81FB80, 0xBC82FB80, 0xBC83FB80, 0xBC84FB80, 0xBC85FB80, 0xBC86FB80, 0xBC87FB80, 0xBC88FB80, 0xBC89FB80, 0xBC8AFB80, 0xBC8BFB80, 0xBC8CFB80, 0xBC8DFB80, 0xBC8EFB80, 0xBC8FFB80, 0xBC90FB80, 0xBC91FB80, 0xBC92FB80, 0xBC93FB80, 0xBC94FB80, 0xBC95FB80, 0xBC96FB80, 0xBC97FB80, 0xBC98FB80, 0xBC99FB80, 0xBC9AFB80, 0xBC9BFB80, 0xBC9CFB80, 0xBC9DFB80, 0xBC9EFB80, 0xBC9FFB80,
+		0xBCA0FB80, 0xBCA1FB80, 0xBCA2FB80, 0xBCA3FB80, 0xBCA4FB80, 0xBCA5FB80, 0xBCA6FB80, 0xBCA7FB80, 0xBCA8FB80, 0xBCA9FB80, 0xBCAAFB80, 0xBCABFB80, 0xBCACFB80, 0xBCADFB80, 0xBCAEFB80, 0xBCAFFB80, 0xBCB0FB80, 0xBCB1FB80, 0xBCB2FB80, 0xBCB3FB80, 0xBCB4FB80, 0xBCB5FB80, 0xBCB6FB80, 0xBCB7FB80, 0xBCB8FB80, 0xBCB9FB80, 0xBCBAFB80, 0xBCBBFB80, 0xBCBCFB80, 0xBCBDFB80, 0xBCBEFB80, 0xBCBFFB80,
+		0xBCC0FB80, 0xBCC1FB80, 0xBCC2FB80, 0xBCC3FB80, 0xBCC4FB80, 0xBCC5FB80, 0xBCC6FB80, 0xBCC7FB80, 0xBCC8FB80, 0xBCC9FB80, 0xBCCAFB80, 0xBCCBFB80, 0xBCCCFB80, 0xBCCDFB80, 0xBCCEFB80, 0xBCCFFB80, 0xBCD0FB80, 0xBCD1FB80, 0xBCD2FB80, 0xBCD3FB80, 0xBCD4FB80, 0xBCD5FB80, 0xBCD6FB80, 0xBCD7FB80, 0xBCD8FB80, 0xBCD9FB80, 0xBCDAFB80, 0xBCDBFB80, 0xBCDCFB80, 0xBCDDFB80, 0xBCDEFB80, 0xBCDFFB80,
+		0xBCE0FB80, 0xBCE1FB80, 0xBCE2FB80, 0xBCE3FB80, 0xBCE4FB80, 0xBCE5FB80, 0xBCE6FB80, 0xBCE7FB80, 0xBCE8FB80, 0xBCE9FB80, 0xBCEAFB80, 0xBCEBFB80, 0xBCECFB80, 0xBCEDFB80, 0xBCEEFB80, 0xBCEFFB80, 0xBCF0FB80, 0xBCF1FB80, 0xBCF2FB80, 0xBCF3FB80, 0xBCF4FB80, 0xBCF5FB80, 0xBCF6FB80, 0xBCF7FB80, 0xBCF8FB80, 0xBCF9FB80, 0xBCFAFB80, 0xBCFBFB80, 0xBCFCFB80, 0xBCFDFB80, 0xBCFEFB80, 0xBCFFFB80,
+		0xBD00FB80, 0xBD01FB80, 0xBD02FB80, 0xBD03FB80, 0xBD04FB80, 0xBD05FB80, 0xBD06FB80, 0xBD07FB80, 0xBD08FB80, 0xBD09FB80, 0xBD0AFB80, 0xBD0BFB80, 0xBD0CFB80, 0xBD0DFB80, 0xBD0EFB80, 0xBD0FFB80, 0xBD10FB80, 0xBD11FB80, 0xBD12FB80, 0xBD13FB80, 0xBD14FB80, 0xBD15FB80, 0xBD16FB80, 0xBD17FB80, 0xBD18FB80, 0xBD19FB80, 0xBD1AFB80, 0xBD1BFB80, 0xBD1CFB80, 0xBD1DFB80, 0xBD1EFB80, 0xBD1FFB80,
+		0xBD20FB80, 0xBD21FB80, 0xBD22FB80, 0xBD23FB80, 0xBD24FB80, 0xBD25FB80, 0xBD26FB80, 0xBD27FB80, 0xBD28FB80, 0xBD29FB80, 0xBD2AFB80, 0xBD2BFB80, 0xBD2CFB80, 0xBD2DFB80, 0xBD2EFB80, 0xBD2FFB80, 0xBD30FB80, 0xBD31FB80, 0xBD32FB80, 0xBD33FB80, 0xBD34FB80, 0xBD35FB80, 0xBD36FB80, 0xBD37FB80, 0xBD38FB80, 0xBD39FB80, 0xBD3AFB80, 0xBD3BFB80, 0xBD3CFB80, 0xBD3DFB80, 0xBD3EFB80, 0xBD3FFB80,
+		0xBD40FB80, 0xBD41FB80, 0xBD42FB80, 0xBD43FB80, 0xBD44FB80, 0xBD45FB80, 0xBD46FB80, 0xBD47FB80, 0xBD48FB80, 0xBD49FB80, 0xBD4AFB80, 0xBD4BFB80, 0xBD4CFB80, 0xBD4DFB80, 0xBD4EFB80, 0xBD4FFB80, 0xBD50FB80, 0xBD51FB80, 0xBD52FB80, 0xBD53FB80, 0xBD54FB80, 0xBD55FB80, 0xBD56FB80, 0xBD57FB80, 0xBD58FB80, 0xBD59FB80, 0xBD5AFB80, 0xBD5BFB80, 0xBD5CFB80, 0xBD5DFB80, 0xBD5EFB80, 0xBD5FFB80,
+		0xBD60FB80, 0xBD61FB80, 0xBD62FB80, 0xBD63FB80, 0xBD64FB80, 0xBD65FB80, 0xBD66FB80, 0xBD67FB80, 0xBD68FB80, 0xBD69FB80, 0xBD6AFB80, 0xBD6BFB80, 0xBD6CFB80, 0xBD6DFB80, 0xBD6EFB80, 0xBD6FFB80, 0xBD70FB80, 0xBD71FB80, 0xBD72FB80, 0xBD73FB80, 0xBD74FB80, 0xBD75FB80, 0xBD76FB80, 0xBD77FB80, 0xBD78FB80, 0xBD79FB80, 0xBD7AFB80, 0xBD7BFB80, 0xBD7CFB80, 0xBD7DFB80, 0xBD7EFB80, 0xBD7FFB80,
+		0xBD80FB80, 0xBD81FB80, 0xBD82FB80, 0xBD83FB80, 0xBD84FB80, 0xBD85FB80, 0xBD86FB80, 0xBD87FB80, 0xBD88FB80, 0xBD89FB80, 0xBD8AFB80, 0xBD8BFB80, 0xBD8CFB80, 0xBD8DFB80, 0xBD8EFB80, 0xBD8FFB80, 0xBD90FB80, 0xBD91FB80, 0xBD92FB80, 0xBD93FB80, 0xBD94FB80, 0xBD95FB80, 0xBD96FB80, 0xBD97FB80, 0xBD98FB80, 0xBD99FB80, 0xBD9AFB80, 0xBD9BFB80, 0xBD9CFB80, 0xBD9DFB80, 0xBD9EFB80, 0xBD9FFB80,
+		0xBDA0FB80, 0xBDA1FB80, 0xBDA2FB80, 0xBDA3FB80, 0xBDA4FB80, 0xBDA5FB80, 0xBDA6FB80, 0xBDA7FB80, 0xBDA8FB80, 0xBDA9FB80, 0xBDAAFB80, 0xBDABFB80, 0xBDACFB80, 0xBDADFB80, 0xBDAEFB80, 0xBDAFFB80, 0xBDB0FB80, 0xBDB1FB80, 0xBDB2FB80, 0xBDB3FB80, 0xBDB4FB80, 0xBDB5FB80, 0xBDB6FB80, 0xBDB7FB80, 0xBDB8FB80, 0xBDB9FB80, 0xBDBAFB80, 0xBDBBFB80, 0xBDBCFB80, 0xBDBDFB80, 0xBDBEFB80, 0xBDBFFB80,
+		0xBDC0FB80, 0xBDC1FB80, 0xBDC2FB80, 0xBDC3FB80, 0xBDC4FB80, 0xBDC5FB80, 0xBDC6FB80, 0xBDC7FB80, 0xBDC8FB80, 0xBDC9FB80, 0xBDCAFB80, 0xBDCBFB80, 0xBDCCFB80, 0xBDCDFB80, 0xBDCEFB80, 0xBDCFFB80, 0xBDD0FB80, 0xBDD1FB80, 0xBDD2FB80, 0xBDD3FB80, 0xBDD4FB80, 0xBDD5FB80, 0xBDD6FB80, 0xBDD7FB80, 0xBDD8FB80, 0xBDD9FB80, 0xBDDAFB80, 0xBDDBFB80, 0xBDDCFB80, 0xBDDDFB80, 0xBDDEFB80, 0xBDDFFB80,
+		0xBDE0FB80, 0xBDE1FB80, 0xBDE2FB80, 0xBDE3FB80, 0xBDE4FB80, 0xBDE5FB80, 0xBDE6FB80, 0xBDE7FB80, 0xBDE8FB80, 0xBDE9FB80, 0xBDEAFB80, 0xBDEBFB80, 0xBDECFB80, 0xBDEDFB80, 0xBDEEFB80, 0xBDEFFB80, 0xBDF0FB80, 0xBDF1FB80, 0xBDF2FB80, 0xBDF3FB80, 0xBDF4FB80, 0xBDF5FB80, 0xBDF6FB80, 0xBDF7FB80, 0xBDF8FB80, 0xBDF9FB80, 0xBDFAFB80, 0xBDFBFB80, 0xBDFCFB80, 0xBDFDFB80, 0xBDFEFB80, 0xBDFFFB80,
+		0xBE00FB80, 0xBE01FB80, 0xBE02FB80, 0xBE03FB80, 0xBE04FB80, 0xBE05FB80, 0xBE06FB80, 0xBE07FB80, 0xBE08FB80, 0xBE09FB80, 0xBE0AFB80, 0xBE0BFB80, 0xBE0CFB80, 0xBE0DFB80, 0xBE0EFB80, 0xBE0FFB80, 0xBE10FB80, 0xBE11FB80, 0xBE12FB80, 0xBE13FB80, 0xBE14FB80, 0xBE15FB80, 0xBE16FB80, 0xBE17FB80, 0xBE18FB80, 0xBE19FB80, 0xBE1AFB80, 0xBE1BFB80, 0xBE1CFB80, 0xBE1DFB80, 0xBE1EFB80, 0xBE1FFB80,
+		0xBE20FB80, 0xBE21FB80, 0xBE22FB80, 0xBE23FB80, 0xBE24FB80, 0xBE25FB80, 0xBE26FB80, 0xBE27FB80, 0xBE28FB80, 0xBE29FB80, 0xBE2AFB80, 0xBE2BFB80, 0xBE2CFB80, 0xBE2DFB80, 0xBE2EFB80, 0xBE2FFB80, 0xBE30FB80, 0xBE31FB80, 0xBE32FB80, 0xBE33FB80, 0xBE34FB80, 0xBE35FB80, 0xBE36FB80, 0xBE37FB80, 0xBE38FB80, 0xBE39FB80, 0xBE3AFB80, 0xBE3BFB80, 0xBE3CFB80, 0xBE3DFB80, 0xBE3EFB80, 0xBE3FFB80,
+		0xBE40FB80, 0xBE41FB80, 0xBE42FB80, 0xBE43FB80, 0xBE44FB80, 0xBE45FB80, 0xBE46FB80, 0xBE47FB80, 0xBE48FB80, 0xBE49FB80, 0xBE4AFB80, 0xBE4BFB80, 0xBE4CFB80, 0xBE4DFB80, 0xBE4EFB80, 0xBE4FFB80, 0xBE50FB80, 0xBE51FB80, 0xBE52FB80, 0xBE53FB80, 0xBE54FB80, 0xBE55FB80, 0xBE56FB80, 0xBE57FB80, 0xBE58FB80, 0xBE59FB80, 0xBE5AFB80, 0xBE5BFB80, 0xBE5CFB80, 0xBE5DFB80, 0xBE5EFB80, 0xBE5FFB80,
+		0xBE60FB80, 0xBE61FB80, 0xBE62FB80, 0xBE63FB80, 0xBE64FB80, 0xBE65FB80, 0xBE66FB80, 0xBE67FB80, 0xBE68FB80, 0xBE69FB80, 0xBE6AFB80, 0xBE6BFB80, 0xBE6CFB80, 0xBE6DFB80, 0xBE6EFB80, 0xBE6FFB80, 0xBE70FB80, 0xBE71FB80, 0xBE72FB80, 0xBE73FB80, 0xBE74FB80, 0xBE75FB80, 0xBE76FB80, 0xBE77FB80, 0xBE78FB80, 0xBE79FB80, 0xBE7AFB80, 0xBE7BFB80, 0xBE7CFB80, 0xBE7DFB80, 0xBE7EFB80, 0xBE7FFB80,
+		0xBE80FB80, 0xBE81FB80, 0xBE82FB80, 0xBE83FB80, 0xBE84FB80, 0xBE85FB80, 0xBE86FB80, 0xBE87FB80, 0xBE88FB80, 0xBE89FB80, 0xBE8AFB80, 0xBE8BFB80, 0xBE8CFB80, 0xBE8DFB80, 0xBE8EFB80, 0xBE8FFB80, 0xBE90FB80, 0xBE91FB80, 0xBE92FB80, 0xBE93FB80, 0xBE94FB80, 0xBE95FB80, 0xBE96FB80, 0xBE97FB80, 0xBE98FB80, 0xBE99FB80, 0xBE9AFB80, 0xBE9BFB80, 0xBE9CFB80, 0xBE9DFB80, 0xBE9EFB80, 0xBE9FFB80,
+		0xBEA0FB80, 0xBEA1FB80, 0xBEA2FB80, 0xBEA3FB80, 0xBEA4FB80, 0xBEA5FB80, 0xBEA6FB80, 0xBEA7FB80, 0xBEA8FB80, 0xBEA9FB80, 0xBEAAFB80, 0xBEABFB80, 0xBEACFB80, 0xBEADFB80, 0xBEAEFB80, 0xBEAFFB80, 0xBEB0FB80, 0xBEB1FB80, 0xBEB2FB80, 0xBEB3FB80, 0xBEB4FB80, 0xBEB5FB80, 0xBEB6FB80, 0xBEB7FB80, 0xBEB8FB80, 0xBEB9FB80, 0xBEBAFB80, 0xBEBBFB80, 0xBEBCFB80, 0xBEBDFB80, 0xBEBEFB80, 0xBEBFFB80,
+		0xBEC0FB80, 0xBEC1FB80, 0xBEC2FB80, 0xBEC3FB80, 0xBEC4FB80, 0xBEC5FB80, 0xBEC6FB80, 0xBEC7FB80, 0xBEC8FB80, 0xBEC9FB80, 0xBECAFB80, 0xBECBFB80, 0xBECCFB80, 0xBECDFB80, 0xBECEFB80, 0xBECFFB80, 0xBED0FB80, 0xBED1FB80, 0xBED2FB80, 0xBED3FB80, 0xBED4FB80, 0xBED5FB80, 0xBED6FB80, 0xBED7FB80, 0xBED8FB80, 0xBED9FB80, 0xBEDAFB80, 0xBEDBFB80, 0xBEDCFB80, 0xBEDDFB80, 0xBEDEFB80, 0xBEDFFB80,
+		0xBEE0FB80, 0xBEE1FB80, 0xBEE2FB80, 0xBEE3FB80, 0xBEE4FB80, 0xBEE5FB80, 0xBEE6FB80, 0xBEE7FB80, 0xBEE8FB80, 0xBEE9FB80, 0xBEEAFB80, 0xBEEBFB80, 0xBEECFB80, 0xBEEDFB80, 0xBEEEFB80, 0xBEEFFB80, 0xBEF0FB80, 0xBEF1FB80, 0xBEF2FB80, 0xBEF3FB80, 0xBEF4FB80, 0xBEF5FB80, 0xBEF6FB80, 0xBEF7FB80, 0xBEF8FB80, 0xBEF9FB80, 0xBEFAFB80, 0xBEFBFB80, 0xBEFCFB80, 0xBEFDFB80, 0xBEFEFB80, 0xBEFFFB80,
+		0xBF00FB80, 0xBF01FB80, 0xBF02FB80, 0xBF03FB80, 0xBF04FB80, 0xBF05FB80, 0xBF06FB80, 0xBF07FB80, 0xBF08FB80, 0xBF09FB80, 0xBF0AFB80, 0xBF0BFB80, 0xBF0CFB80, 0xBF0DFB80, 0xBF0EFB80, 0xBF0FFB80, 0xBF10FB80, 0xBF11FB80, 0xBF12FB80, 0xBF13FB80, 0xBF14FB80, 0xBF15FB80, 0xBF16FB80, 0xBF17FB80, 0xBF18FB80, 0xBF19FB80, 0xBF1AFB80, 0xBF1BFB80, 0xBF1CFB80, 0xBF1DFB80, 0xBF1EFB80, 0xBF1FFB80,
+		0xBF20FB80, 0xBF21FB80, 0xBF22FB80, 0xBF23FB80, 0xBF24FB80, 0xBF25FB80, 0xBF26FB80, 0xBF27FB80, 0xBF28FB80, 0xBF29FB80, 0xBF2AFB80, 0xBF2BFB80, 0xBF2CFB80, 0xBF2DFB80, 0xBF2EFB80, 0xBF2FFB80, 0xBF30FB80, 0xBF31FB80, 0xBF32FB80, 0xBF33FB80, 0xBF34FB80, 0xBF35FB80, 0xBF36FB80, 0xBF37FB80, 0xBF38FB80, 0xBF39FB80, 0xBF3AFB80, 0xBF3BFB80, 0xBF3CFB80, 0xBF3DFB80, 0xBF3EFB80, 0xBF3FFB80,
+		0xBF40FB80, 0xBF41FB80, 0xBF42FB80, 0xBF43FB80, 0xBF44FB80, 0xBF45FB80, 0xBF46FB80, 0xBF47FB80, 0xBF48FB80, 0xBF49FB80, 0xBF4AFB80, 0xBF4BFB80, 0xBF4CFB80, 0xBF4DFB80, 0xBF4EFB80, 0xBF4FFB80, 0xBF50FB80, 0xBF51FB80, 0xBF52FB80, 0xBF53FB80, 0xBF54FB80, 0xBF55FB80, 0xBF56FB80, 0xBF57FB80, 0xBF58FB80, 0xBF59FB80, 0xBF5AFB80, 0xBF5BFB80, 0xBF5CFB80, 0xBF5DFB80, 0xBF5EFB80, 0xBF5FFB80,
+		0xBF60FB80, 0xBF61FB80, 0xBF62FB80, 0xBF63FB80, 0xBF64FB80, 0xBF65FB80, 0xBF66FB80, 0xBF67FB80, 0xBF68FB80, 0xBF69FB80, 0xBF6AFB80, 0xBF6BFB80, 0xBF6CFB80, 0xBF6DFB80, 0xBF6EFB80, 0xBF6FFB80, 0xBF70FB80, 0xBF71FB80, 0xBF72FB80, 0xBF73FB80, 0xBF74FB80, 0xBF75FB80, 0xBF76FB80, 0xBF77FB80, 0xBF78FB80, 0xBF79FB80, 0xBF7AFB80, 0xBF7BFB80, 0xBF7CFB80, 0xBF7DFB80, 0xBF7EFB80, 0xBF7FFB80,
+		0xBF80FB80, 0xBF81FB80, 0xBF82FB80, 0xBF83FB80, 0xBF84FB80, 0xBF85FB80, 0xBF86FB80, 0xBF87FB80, 0xBF88FB80, 0xBF89FB80, 0xBF8AFB80, 0xBF8BFB80, 0xBF8CFB80, 0xBF8DFB80, 0xBF8EFB80, 0xBF8FFB80, 0xBF90FB80, 0xBF91FB80, 0xBF92FB80, 0xBF93FB80, 0xBF94FB80, 0xBF95FB80, 0xBF96FB80, 0xBF97FB80, 0xBF98FB80, 0xBF99FB80, 0xBF9AFB80, 0xBF9BFB80, 0xBF9CFB80, 0xBF9DFB80, 0xBF9EFB80, 0xBF9FFB80,
+		0xBFA0FB80, 0xBFA1FB80, 0xBFA2FB80, 0xBFA3FB80, 0xBFA4FB80, 0xBFA5FB80, 0xBFA6FB80, 0xBFA7FB80, 0xBFA8FB80, 0xBFA9FB80, 0xBFAAFB80, 0xBFABFB80, 0xBFACFB80, 0xBFADFB80, 0xBFAEFB80, 0xBFAFFB80, 0xBFB0FB80, 0xBFB1FB80, 0xBFB2FB80, 0xBFB3FB80, 0xBFB4FB80, 0xBFB5FB80, 0xBFB6FB80, 0xBFB7FB80, 0xBFB8FB80, 0xBFB9FB80, 0xBFBAFB80, 0xBFBBFB80, 0xBFBCFB80, 0xBFBDFB80, 0xBFBEFB80, 0xBFBFFB80,
+		0xBFC0FB80, 0xBFC1FB80, 0xBFC2FB80, 0xBFC3FB80, 0xBFC4FB80, 0xBFC5FB80, 0xBFC6FB80, 0xBFC7FB80, 0xBFC8FB80, 0xBFC9FB80, 0xBFCAFB80, 0xBFCBFB80, 0xBFCCFB80, 0xBFCDFB80, 0xBFCEFB80, 0xBFCFFB80, 0xBFD0FB80, 0xBFD1FB80, 0xBFD2FB80, 0xBFD3FB80, 0xBFD4FB80, 0xBFD5FB80, 0xBFD6FB80, 0xBFD7FB80, 0xBFD8FB80, 0xBFD9FB80, 0xBFDAFB80, 0xBFDBFB80, 0xBFDCFB80, 0xBFDDFB80, 0xBFDEFB80, 0xBFDFFB80,
+		0xBFE0FB80, 0xBFE1FB80, 0xBFE2FB80, 0xBFE3FB80, 0xBFE4FB80, 0xBFE5FB80, 0xBFE6FB80, 0xBFE7FB80, 0xBFE8FB80, 0xBFE9FB80, 0xBFEAFB80, 0xBFEBFB80, 0xBFECFB80, 0xBFEDFB80, 0xBFEEFB80, 0xBFEFFB80, 0xBFF0FB80, 0xBFF1FB80, 0xBFF2FB80, 0xBFF3FB80, 0xBFF4FB80, 0xBFF5FB80, 0xBFF6FB80, 0xBFF7FB80, 0xBFF8FB80, 0xBFF9FB80, 0xBFFAFB80, 0xBFFBFB80, 0xBFFCFB80, 0xBFFDFB80, 0xBFFEFB80, 0xBFFFFB80,
+		0xC000FB80, 0xC001FB80, 0xC002FB80, 0xC003FB80, 0xC004FB80, 0xC005FB80, 0xC006FB80, 0xC007FB80, 0xC008FB80, 0xC009FB80, 0xC00AFB80, 0xC00BFB80, 0xC00CFB80, 0xC00DFB80, 0xC00EFB80, 0xC00FFB80, 0xC010FB80, 0xC011FB80, 0xC012FB80, 0xC013FB80, 0xC014FB80, 0xC015FB80, 0xC016FB80, 0xC017FB80, 0xC018FB80, 0xC019FB80, 0xC01AFB80, 0xC01BFB80, 0xC01CFB80, 0xC01DFB80, 0xC01EFB80, 0xC01FFB80,
+		0xC020FB80, 0xC021FB80, 0xC022FB80, 0xC023FB80, 0xC024FB80, 0xC025FB80, 0xC026FB80, 0xC027FB80, 0xC028FB80, 0xC029FB80, 0xC02AFB80, 0xC02BFB80, 0xC02CFB80, 0xC02DFB80, 0xC02EFB80, 0xC02FFB80, 0xC030FB80, 0xC031FB80, 0xC032FB80, 0xC033FB80, 0xC034FB80, 0xC035FB80, 0xC036FB80, 0xC037FB80, 0xC038FB80, 0xC039FB80, 0xC03AFB80, 0xC03BFB80, 0xC03CFB80, 0xC03DFB80, 0xC03EFB80, 0xC03FFB80,
+		0xC040FB80, 0xC041FB80, 0xC042FB80, 0xC043FB80, 0xC044FB80, 0xC045FB80, 0xC046FB80, 0xC047FB80, 0xC048FB80, 0xC049FB80, 0xC04AFB80, 0xC04BFB80, 0xC04CFB80, 0xC04DFB80, 0xC04EFB80, 0xC04FFB80, 0xC050FB80, 0xC051FB80, 0xC052FB80, 0xC053FB80, 0xC054FB80, 0xC055FB80, 0xC056FB80, 0xC057FB80, 0xC058FB80, 0xC059FB80, 0xC05AFB80, 0xC05BFB80, 0xC05CFB80, 0xC05DFB80, 0xC05EFB80, 0xC05FFB80,
+		0xC060FB80, 0xC061FB80, 0xC062FB80, 0xC063FB80, 0xC064FB80, 0xC065FB80, 0xC066FB80, 0xC067FB80, 0xC068FB80, 0xC069FB80, 0xC06AFB80, 0xC06BFB80, 0xC06CFB80, 0xC06DFB80, 0xC06EFB80, 0xC06FFB80, 0xC070FB80, 0xC071FB80, 0xC072FB80, 0xC073FB80, 0xC074FB80, 0xC075FB80, 0xC076FB80, 0xC077FB80, 0xC078FB80, 0xC079FB80, 0xC07AFB80, 0xC07BFB80, 0xC07CFB80, 0xC07DFB80, 0xC07EFB80, 0xC07FFB80,
+		0xC080FB80, 0xC081FB80, 0xC082FB80, 0xC083FB80, 0xC084FB80, 0xC085FB80, 0xC086FB80, 0xC087FB80, 0xC088FB80, 0xC089FB80, 0xC08AFB80, 0xC08BFB80, 0xC08CFB80, 0xC08DFB80, 0xC08EFB80, 0xC08FFB80, 0xC090FB80, 0xC091FB80, 0xC092FB80, 0xC093FB80, 0xC094FB80, 0xC095FB80, 0xC096FB80, 0xC097FB80, 0xC098FB80, 0xC099FB80, 0xC09AFB80, 0xC09BFB80, 0xC09CFB80, 0xC09DFB80, 0xC09EFB80, 0xC09FFB80,
+		0xC0A0FB80, 0xC0A1FB80, 0xC0A2FB80, 0xC0A3FB80, 0xC0A4FB80, 0xC0A5FB80, 0xC0A6FB80, 0xC0A7FB80, 0xC0A8FB80, 0xC0A9FB80, 0xC0AAFB80, 0xC0ABFB80, 0xC0ACFB80, 0xC0ADFB80, 0xC0AEFB80, 0xC0AFFB80, 0xC0B0FB80, 0xC0B1FB80, 0xC0B2FB80, 0xC0B3FB80, 0xC0B4FB80, 0xC0B5FB80, 0xC0B6FB80, 0xC0B7FB80, 0xC0B8FB80, 0xC0B9FB80, 0xC0BAFB80, 0xC0BBFB80, 0xC0BCFB80, 0xC0BDFB80, 0xC0BEFB80, 0xC0BFFB80,
+		0xC0C0FB80, 0xC0C1FB80, 0xC0C2FB80, 0xC0C3FB80, 0xC0C4FB80, 0xC0C5FB80, 0xC0C6FB80, 0xC0C7FB80, 0xC0C8FB80, 0xC0C9FB80, 0xC0CAFB80, 0xC0CBFB80, 0xC0CCFB80, 0xC0CDFB80, 0xC0CEFB80, 0xC0CFFB80, 0xC0D0FB80, 0xC0D1FB80, 0xC0D2FB80, 0xC0D3FB80, 0xC0D4FB80, 0xC0D5FB80, 0xC0D6FB80, 0xC0D7FB80, 0xC0D8FB80, 0xC0D9FB80, 0xC0DAFB80, 0xC0DBFB80, 0xC0DCFB80, 0xC0DDFB80, 0xC0DEFB80, 0xC0DFFB80,
+		0xC0E0FB80, 0xC0E1FB80, 0xC0E2FB80, 0xC0E3FB80, 0xC0E4FB80, 0xC0E5FB80, 0xC0E6FB80, 0xC0E7FB80, 0xC0E8FB80, 0xC0E9FB80, 0xC0EAFB80, 0xC0EBFB80, 0xC0ECFB80, 0xC0EDFB80, 0xC0EEFB80, 0xC0EFFB80, 0xC0F0FB80, 0xC0F1FB80, 0xC0F2FB80, 0xC0F3FB80, 0xC0F4FB80, 0xC0F5FB80, 0xC0F6FB80, 0xC0F7FB80, 0xC0F8FB80, 0xC0F9FB80, 0xC0FAFB80, 0xC0FBFB80, 0xC0FCFB80, 0xC0FDFB80, 0xC0FEFB80, 0xC0FFFB80,
+		0xC100FB80, 0xC101FB80, 0xC102FB80, 0xC103FB80, 0xC104FB80, 0xC105FB80, 0xC106FB80, 0xC107FB80, 0xC108FB80, 0xC109FB80, 0xC10AFB80, 0xC10BFB80, 0xC10CFB80, 0xC10DFB80, 0xC10EFB80, 0xC10FFB80, 0xC110FB80, 0xC111FB80, 0xC112FB80, 0xC113FB80, 0xC114FB80, 0xC115FB80, 0xC116FB80, 0xC117FB80, 0xC118FB80, 0xC119FB80, 0xC11AFB80, 0xC11BFB80, 0xC11CFB80, 0xC11DFB80, 0xC11EFB80, 0xC11FFB80,
+		0xC120FB80, 0xC121FB80, 0xC122FB80, 0xC123FB80, 0xC124FB80, 0xC125FB80, 0xC126FB80, 0xC127FB80, 0xC128FB80, 0xC129FB80, 0xC12AFB80, 0xC12BFB80, 0xC12CFB80, 0xC12DFB80, 0xC12EFB80, 0xC12FFB80, 0xC130FB80, 0xC131FB80, 0xC132FB80, 0xC133FB80, 0xC134FB80, 0xC135FB80, 0xC136FB80, 0xC137FB80, 0xC138FB80, 0xC139FB80, 0xC13AFB80, 0xC13BFB80, 0xC13CFB80, 0xC13DFB80, 0xC13EFB80, 0xC13FFB80,
+		0xC140FB80, 0xC141FB80, 0xC142FB80, 0xC143FB80, 0xC144FB80, 0xC145FB80, 0xC146FB80, 0xC147FB80, 0xC148FB80, 0xC149FB80, 0xC14AFB80, 0xC14BFB80, 0xC14CFB80, 0xC14DFB80, 0xC14EFB80, 0xC14FFB80, 0xC150FB80, 0xC151FB80, 0xC152FB80, 0xC153FB80, 0xC154FB80, 0xC155FB80, 0xC156FB80, 0xC157FB80, 0xC158FB80, 0xC159FB80, 0xC15AFB80, 0xC15BFB80, 0xC15CFB80, 0xC15DFB80, 0xC15EFB80, 0xC15FFB80,
+		0xC160FB80, 0xC161FB80, 0xC162FB80, 0xC163FB80, 0xC164FB80, 0xC165FB80, 0xC166FB80, 0xC167FB80, 0xC168FB80, 0xC169FB80, 0xC16AFB80, 0xC16BFB80, 0xC16CFB80, 0xC16DFB80, 0xC16EFB80, 0xC16FFB80, 0xC170FB80, 0xC171FB80, 0xC172FB80, 0xC173FB80, 0xC174FB80, 0xC175FB80, 0xC176FB80, 0xC177FB80, 0xC178FB80, 0xC179FB80, 0xC17AFB80, 0xC17BFB80, 0xC17CFB80, 0xC17DFB80, 0xC17EFB80, 0xC17FFB80,
+		0xC180FB80, 0xC181FB80, 0xC182FB80, 0xC183FB80, 0xC184FB80, 0xC185FB80, 0xC186FB80, 0xC187FB80, 0xC188FB80, 0xC189FB80, 0xC18AFB80, 0xC18BFB80, 0xC18CFB80, 0xC18DFB80, 0xC18EFB80, 0xC18FFB80, 0xC190FB80, 0xC191FB80, 0xC192FB80, 0xC193FB80, 0xC194FB80, 0xC195FB80, 0xC196FB80, 0xC197FB80, 0xC198FB80, 0xC199FB80, 0xC19AFB80, 0xC19BFB80, 0xC19CFB80, 0xC19DFB80, 0xC19EFB80, 0xC19FFB80,
+		0xC1A0FB80, 0xC1A1FB80, 0xC1A2FB80, 0xC1A3FB80, 0xC1A4FB80, 0xC1A5FB80, 0xC1A6FB80, 0xC1A7FB80, 0xC1A8FB80, 0xC1A9FB80, 0xC1AAFB80, 0xC1ABFB80, 0xC1ACFB80, 0xC1ADFB80, 0xC1AEFB80, 0xC1AFFB80, 0xC1B0FB80, 0xC1B1FB80, 0xC1B2FB80, 0xC1B3FB80, 0xC1B4FB80, 0xC1B5FB80, 0xC1B6FB80, 0xC1B7FB80, 0xC1B8FB80, 0xC1B9FB80, 0xC1BAFB80, 0xC1BBFB80, 0xC1BCFB80, 0xC1BDFB80, 0xC1BEFB80, 0xC1BFFB80,
+		0xC1C0FB80, 0xC1C1FB80, 0xC1C2FB80, 0xC1C3FB80, 0xC1C4FB80, 0xC1C5FB80, 0xC1C6FB80, 0xC1C7FB80, 0xC1C8FB80, 0xC1C9FB80, 0xC1CAFB80, 0xC1CBFB80, 0xC1CCFB80, 0xC1CDFB80, 0xC1CEFB80, 0xC1CFFB80, 0xC1D0FB80, 0xC1D1FB80, 0xC1D2FB80, 0xC1D3FB80, 0xC1D4FB80, 0xC1D5FB80, 0xC1D6FB80, 0xC1D7FB80, 0xC1D8FB80, 0xC1D9FB80, 0xC1DAFB80, 0xC1DBFB80, 0xC1DCFB80, 0xC1DDFB80, 0xC1DEFB80, 0xC1DFFB80,
+		0xC1E0FB80, 0xC1E1FB80, 0xC1E2FB80, 0xC1E3FB80, 0xC1E4FB80, 0xC1E5FB80, 0xC1E6FB80, 0xC1E7FB80, 0xC1E8FB80, 0xC1E9FB80, 0xC1EAFB80, 0xC1EBFB80, 0xC1ECFB80, 0xC1EDFB80, 0xC1EEFB80, 0xC1EFFB80, 0xC1F0FB80, 0xC1F1FB80, 0xC1F2FB80, 0xC1F3FB80, 0xC1F4FB80, 0xC1F5FB80, 0xC1F6FB80, 0xC1F7FB80, 0xC1F8FB80, 0xC1F9FB80, 0xC1FAFB80, 0xC1FBFB80, 0xC1FCFB80, 0xC1FDFB80, 0xC1FEFB80, 0xC1FFFB80,
+		0xC200FB80, 0xC201FB80, 0xC202FB80, 0xC203FB80, 0xC204FB80, 0xC205FB80, 0xC206FB80, 0xC207FB80, 0xC208FB80, 0xC209FB80, 0xC20AFB80, 0xC20BFB80, 0xC20CFB80, 0xC20DFB80, 0xC20EFB80, 0xC20FFB80, 0xC210FB80, 0xC211FB80, 0xC212FB80, 0xC213FB80, 0xC214FB80, 0xC215FB80, 0xC216FB80, 0xC217FB80, 0xC218FB80, 0xC219FB80, 0xC21AFB80, 0xC21BFB80, 0xC21CFB80, 0xC21DFB80, 0xC21EFB80, 0xC21FFB80,
+		0xC220FB80, 0xC221FB80, 0xC222FB80, 0xC223FB80, 0xC224FB80, 0xC225FB80, 0xC226FB80, 0xC227FB80, 0xC228FB80, 0xC229FB80, 0xC22AFB80, 0xC22BFB80, 0xC22CFB80, 0xC22DFB80, 0xC22EFB80, 0xC22FFB80, 0xC230FB80, 0xC231FB80, 0xC232FB80, 0xC233FB80, 0xC234FB80, 0xC235FB80, 0xC236FB80, 0xC237FB80, 0xC238FB80, 0xC239FB80, 0xC23AFB80, 0xC23BFB80, 0xC23CFB80, 0xC23DFB80, 0xC23EFB80, 0xC23FFB80,
+		0xC240FB80, 0xC241FB80, 0xC242FB80, 0xC243FB80, 0xC244FB80, 0xC245FB80, 0xC246FB80, 0xC247FB80, 0xC248FB80, 0xC249FB80, 0xC24AFB80, 0xC24BFB80, 0xC24CFB80, 0xC24DFB80, 0xC24EFB80, 0xC24FFB80, 0xC250FB80, 0xC251FB80, 0xC252FB80, 0xC253FB80, 0xC254FB80, 0xC255FB80, 0xC256FB80, 0xC257FB80, 0xC258FB80, 0xC259FB80, 0xC25AFB80, 0xC25BFB80, 0xC25CFB80, 0xC25DFB80, 0xC25EFB80, 0xC25FFB80,
+		0xC260FB80, 0xC261FB80, 0xC262FB80, 0xC263FB80, 0xC264FB80, 0xC265FB80, 0xC266FB80, 0xC267FB80, 0xC268FB80, 0xC269FB80, 0xC26AFB80, 0xC26BFB80, 0xC26CFB80, 0xC26DFB80, 0xC26EFB80, 0xC26FFB80, 0xC270FB80, 0xC271FB80, 0xC272FB80, 0xC273FB80, 0xC274FB80, 0xC275FB80, 0xC276FB80, 0xC277FB80, 0xC278FB80, 0xC279FB80, 0xC27AFB80, 0xC27BFB80, 0xC27CFB80, 0xC27DFB80, 0xC27EFB80, 0xC27FFB80,
+		0xC280FB80, 0xC281FB80, 0xC282FB80, 0xC283FB80, 0xC284FB80, 0xC285FB80, 0xC286FB80, 0xC287FB80, 0xC288FB80, 0xC289FB80, 0xC28AFB80, 0xC28BFB80, 0xC28CFB80, 0xC28DFB80, 0xC28EFB80, 0xC28FFB80, 0xC290FB80, 0xC291FB80, 0xC292FB80, 0xC293FB80, 0xC294FB80, 0xC295FB80, 0xC296FB80, 0xC297FB80, 0xC298FB80, 0xC299FB80, 0xC29AFB80, 0xC29BFB80, 0xC29CFB80, 0xC29DFB80, 0xC29EFB80, 0xC29FFB80,
+		0xC2A0FB80, 0xC2A1FB80, 0xC2A2FB80, 0xC2A3FB80, 0xC2A4FB80, 0xC2A5FB80, 0xC2A6FB80, 0xC2A7FB80, 0xC2A8FB80, 0xC2A9FB80, 0xC2AAFB80, 0xC2ABFB80, 0xC2ACFB80, 0xC2ADFB80, 0xC2AEFB80, 0xC2AFFB80, 0xC2B0FB80, 0xC2B1FB80, 0xC2B2FB80, 0xC2B3FB80, 0xC2B4FB80, 0xC2B5FB80, 0xC2B6FB80, 0xC2B7FB80, 0xC2B8FB80, 0xC2B9FB80, 0xC2BAFB80, 0xC2BBFB80, 0xC2BCFB80, 0xC2BDFB80, 0xC2BEFB80, 0xC2BFFB80,
+		0xC2C0FB80, 0xC2C1FB80, 0xC2C2FB80, 0xC2C3FB80, 0xC2C4FB80, 0xC2C5FB80, 0xC2C6FB80, 0xC2C7FB80, 0xC2C8FB80, 0xC2C9FB80, 0xC2CAFB80, 0xC2CBFB80, 0xC2CCFB80, 0xC2CDFB80, 0xC2CEFB80, 0xC2CFFB80, 0xC2D0FB80, 0xC2D1FB80, 0xC2D2FB80, 0xC2D3FB80, 0xC2D4FB80, 0xC2D5FB80, 0xC2D6FB80, 0xC2D7FB80, 0xC2D8FB80, 0xC2D9FB80, 0xC2DAFB80, 0xC2DBFB80, 0xC2DCFB80, 0xC2DDFB80, 0xC2DEFB80, 0xC2DFFB80,
+		0xC2E0FB80, 0xC2E1FB80, 0xC2E2FB80, 0xC2E3FB80, 0xC2E4FB80, 0xC2E5FB80, 0xC2E6FB80, 0xC2E7FB80, 0xC2E8FB80, 0xC2E9FB80, 0xC2EAFB80, 0xC2EBFB80, 0xC2ECFB80, 0xC2EDFB80, 0xC2EEFB80, 0xC2EFFB80, 0xC2F0FB80, 0xC2F1FB80, 0xC2F2FB80, 0xC2F3FB80, 0xC2F4FB80, 0xC2F5FB80, 0xC2F6FB80, 0xC2F7FB80, 0xC2F8FB80, 0xC2F9FB80, 0xC2FAFB80, 0xC2FBFB80, 0xC2FCFB80, 0xC2FDFB80, 0xC2FEFB80, 0xC2FFFB80,
+		0xC300FB80, 0xC301FB80, 0xC302FB80, 0xC303FB80, 0xC304FB80, 0xC305FB80, 0xC306FB80, 0xC307FB80, 0xC308FB80, 0xC309FB80, 0xC30AFB80, 0xC30BFB80, 0xC30CFB80, 0xC30DFB80, 0xC30EFB80, 0xC30FFB80, 0xC310FB80, 0xC311FB80, 0xC312FB80, 0xC313FB80, 0xC314FB80, 0xC315FB80, 0xC316FB80, 0xC317FB80, 0xC318FB80, 0xC319FB80, 0xC31AFB80, 0xC31BFB80, 0xC31CFB80, 0xC31DFB80, 0xC31EFB80, 0xC31FFB80,
+		0xC320FB80, 0xC321FB80, 0xC322FB80, 0xC323FB80, 0xC324FB80, 0xC325FB80, 0xC326FB80, 0xC327FB80, 0xC328FB80, 0xC329FB80, 0xC32AFB80, 0xC32BFB80, 0xC32CFB80, 0xC32DFB80, 0xC32EFB80, 0xC32FFB80, 0xC330FB80, 0xC331FB80, 0xC332FB80, 0xC333FB80, 0xC334FB80, 0xC335FB80, 0xC336FB80, 0xC337FB80, 0xC338FB80, 0xC339FB80, 0xC33AFB80, 0xC33BFB80, 0xC33CFB80, 0xC33DFB80, 0xC33EFB80, 0xC33FFB80,
+		0xC340FB80, 0xC341FB80, 0xC342FB80, 0xC343FB80, 0xC344FB80, 0xC345FB80, 0xC346FB80, 0xC347FB80, 0xC348FB80, 0xC349FB80, 0xC34AFB80, 0xC34BFB80, 0xC34CFB80, 0xC34DFB80, 0xC34EFB80, 0xC34FFB80, 0xC350FB80, 0xC351FB80, 0xC352FB80, 0xC353FB80, 0xC354FB80, 0xC355FB80, 0xC356FB80, 0xC357FB80, 0xC358FB80, 0xC359FB80, 0xC35AFB80, 0xC35BFB80, 0xC35CFB80, 0xC35DFB80, 0xC35EFB80, 0xC35FFB80,
+		0xC360FB80, 0xC361FB80, 0xC362FB80, 0xC363FB80, 0xC364FB80, 0xC365FB80, 0xC366FB80, 0xC367FB80, 0xC368FB80, 0xC369FB80, 0xC36AFB80, 0xC36BFB80, 0xC36CFB80, 0xC36DFB80, 0xC36EFB80, 0xC36FFB80, 0xC370FB80, 0xC371FB80, 0xC372FB80, 0xC373FB80, 0xC374FB80, 0xC375FB80, 0xC376FB80, 0xC377FB80, 0xC378FB80, 0xC379FB80, 0xC37AFB80, 0xC37BFB80, 0xC37CFB80, 0xC37DFB80, 0xC37EFB80, 0xC37FFB80,
+		0xC380FB80, 0xC381FB80, 0xC382FB80, 0xC383FB80, 0xC384FB80, 0xC385FB80, 0xC386FB80, 0xC387FB80, 0xC388FB80, 0xC389FB80, 0xC38AFB80, 0xC38BFB80, 0xC38CFB80, 0xC38DFB80, 0xC38EFB80, 0xC38FFB80, 0xC390FB80, 0xC391FB80, 0xC392FB80, 0xC393FB80, 0xC394FB80, 0xC395FB80, 0xC396FB80, 0xC397FB80, 0xC398FB80, 0xC399FB80, 0xC39AFB80, 0xC39BFB80, 0xC39CFB80, 0xC39DFB80, 0xC39EFB80, 0xC39FFB80,
+		0xC3A0FB80, 0xC3A1FB80, 0xC3A2FB80, 0xC3A3FB80, 0xC3A4FB80, 0xC3A5FB80, 0xC3A6FB80, 0xC3A7FB80, 0xC3A8FB80, 0xC3A9FB80, 0xC3AAFB80, 0xC3ABFB80, 0xC3ACFB80, 0xC3ADFB80, 0xC3AEFB80, 0xC3AFFB80, 0xC3B0FB80, 0xC3B1FB80, 0xC3B2FB80, 0xC3B3FB80, 0xC3B4FB80, 0xC3B5FB80, 0xC3B6FB80, 0xC3B7FB80, 0xC3B8FB80, 0xC3B9FB80, 0xC3BAFB80, 0xC3BBFB80, 0xC3BCFB80, 0xC3BDFB80, 0xC3BEFB80, 0xC3BFFB80,
+		0xC3C0FB80, 0xC3C1FB80, 0xC3C2FB80, 0xC3C3FB80, 0xC3C4FB80, 0xC3C5FB80, 0xC3C6FB80, 0xC3C7FB80, 0xC3C8FB80, 0xC3C9FB80, 0xC3CAFB80, 0xC3CBFB80, 0xC3CCFB80, 0xC3CDFB80, 0xC3CEFB80, 0xC3CFFB80, 0xC3D0FB80, 0xC3D1FB80, 0xC3D2FB80, 0xC3D3FB80, 0xC3D4FB80, 0xC3D5FB80, 0xC3D6FB80, 0xC3D7FB80, 0xC3D8FB80, 0xC3D9FB80, 0xC3DAFB80, 0xC3DBFB80, 0xC3DCFB80, 0xC3DDFB80, 0xC3DEFB80, 0xC3DFFB80,
+		0xC3E0FB80, 0xC3E1FB80, 0xC3E2FB80, 0xC3E3FB80, 0xC3E4FB80, 0xC3E5FB80, 0xC3E6FB80, 0xC3E7FB80, 0xC3E8FB80, 0xC3E9FB80, 0xC3EAFB80, 0xC3EBFB80, 0xC3ECFB80, 0xC3EDFB80, 0xC3EEFB80, 0xC3EFFB80, 0xC3F0FB80, 0xC3F1FB80, 0xC3F2FB80, 0xC3F3FB80, 0xC3F4FB80, 0xC3F5FB80, 0xC3F6FB80, 0xC3F7FB80, 0xC3F8FB80, 0xC3F9FB80, 0xC3FAFB80, 0xC3FBFB80, 0xC3FCFB80, 0xC3FDFB80, 0xC3FEFB80, 0xC3FFFB80,
+		0xC400FB80, 0xC401FB80, 0xC402FB80, 0xC403FB80, 0xC404FB80, 0xC405FB80, 0xC406FB80, 0xC407FB80, 0xC408FB80, 0xC409FB80, 0xC40AFB80, 0xC40BFB80, 0xC40CFB80, 0xC40DFB80, 0xC40EFB80, 0xC40FFB80, 0xC410FB80, 0xC411FB80, 0xC412FB80, 0xC413FB80, 0xC414FB80, 0xC415FB80, 0xC416FB80, 0xC417FB80, 0xC418FB80, 0xC419FB80, 0xC41AFB80, 0xC41BFB80, 0xC41CFB80, 0xC41DFB80, 0xC41EFB80, 0xC41FFB80,
+		0xC420FB80, 0xC421FB80, 0xC422FB80, 0xC423FB80, 0xC424FB80, 0xC425FB80, 0xC426FB80, 0xC427FB80, 0xC428FB80, 0xC429FB80, 0xC42AFB80, 0xC42BFB80, 0xC42CFB80, 0xC42DFB80, 0xC42EFB80, 0xC42FFB80, 0xC430FB80, 0xC431FB80, 0xC432FB80, 0xC433FB80, 0xC434FB80, 0xC435FB80, 0xC436FB80, 0xC437FB80, 0xC438FB80, 0xC439FB80, 0xC43AFB80, 0xC43BFB80, 0xC43CFB80, 0xC43DFB80, 0xC43EFB80, 0xC43FFB80,
+		0xC440FB80, 0xC441FB80, 0xC442FB80, 0xC443FB80, 0xC444FB80, 0xC445FB80, 0xC446FB80, 0xC447FB80, 0xC448FB80, 0xC449FB80, 0xC44AFB80, 0xC44BFB80, 0xC44CFB80, 0xC44DFB80, 0xC44EFB80, 0xC44FFB80, 0xC450FB80, 0xC451FB80, 0xC452FB80, 0xC453FB80, 0xC454FB80, 0xC455FB80, 0xC456FB80, 0xC457FB80, 0xC458FB80, 0xC459FB80, 0xC45AFB80, 0xC45BFB80, 0xC45CFB80, 0xC45DFB80, 0xC45EFB80, 0xC45FFB80,
+		0xC460FB80, 0xC461FB80, 0xC462FB80, 0xC463FB80, 0xC464FB80, 0xC465FB80, 0xC466FB80, 0xC467FB80, 0xC468FB80, 0xC469FB80, 0xC46AFB80, 0xC46BFB80, 0xC46CFB80, 0xC46DFB80, 0xC46EFB80, 0xC46FFB80, 0xC470FB80, 0xC471FB80, 0xC472FB80, 0xC473FB80, 0xC474FB80, 0xC475FB80, 0xC476FB80, 0xC477FB80, 0xC478FB80, 0xC479FB80, 0xC47AFB80, 0xC47BFB80, 0xC47CFB80, 0xC47DFB80, 0xC47EFB80, 0xC47FFB80,
+		0xC480FB80, 0xC481FB80, 0xC482FB80, 0xC483FB80, 0xC484FB80, 0xC485FB80, 0xC486FB80, 0xC487FB80, 0xC488FB80, 0xC489FB80, 0xC48AFB80, 0xC48BFB80, 0xC48CFB80, 0xC48DFB80, 0xC48EFB80, 0xC48FFB80, 0xC490FB80, 0xC491FB80, 0xC492FB80, 0xC493FB80, 0xC494FB80, 0xC495FB80, 0xC496FB80, 0xC497FB80, 0xC498FB80, 0xC499FB80, 0xC49AFB80, 0xC49BFB80, 0xC49CFB80, 0xC49DFB80, 0xC49EFB80, 0xC49FFB80,
+		0xC4A0FB80, 0xC4A1FB80, 0xC4A2FB80, 0xC4A3FB80, 0xC4A4FB80, 0xC4A5FB80, 0xC4A6FB80, 0xC4A7FB80, 0xC4A8FB80, 0xC4A9FB80, 0xC4AAFB80, 0xC4ABFB80, 0xC4ACFB80, 0xC4ADFB80, 0xC4AEFB80, 0xC4AFFB80, 0xC4B0FB80, 0xC4B1FB80, 0xC4B2FB80, 0xC4B3FB80, 0xC4B4FB80, 0xC4B5FB80, 0xC4B6FB80, 0xC4B7FB80, 0xC4B8FB80, 0xC4B9FB80, 0xC4BAFB80, 0xC4BBFB80, 0xC4BCFB80, 0xC4BDFB80, 0xC4BEFB80, 0xC4BFFB80,
+		0xC4C0FB80, 0xC4C1FB80, 0xC4C2FB80, 0xC4C3FB80, 0xC4C4FB80, 0xC4C5FB80, 0xC4C6FB80, 0xC4C7FB80, 0xC4C8FB80, 0xC4C9FB80, 0xC4CAFB80, 0xC4CBFB80, 0xC4CCFB80, 0xC4CDFB80, 0xC4CEFB80, 0xC4CFFB80, 0xC4D0FB80, 0xC4D1FB80, 0xC4D2FB80, 0xC4D3FB80, 0xC4D4FB80, 0xC4D5FB80, 0xC4D6FB80, 0xC4D7FB80, 0xC4D8FB80, 0xC4D9FB80, 0xC4DAFB80, 0xC4DBFB80, 0xC4DCFB80, 0xC4DDFB80, 0xC4DEFB80, 0xC4DFFB80,
+		0xC4E0FB80, 0xC4E1FB80, 0xC4E2FB80, 0xC4E3FB80, 0xC4E4FB80, 0xC4E5FB80, 0xC4E6FB80, 0xC4E7FB80, 0xC4E8FB80, 0xC4E9FB80, 0xC4EAFB80, 0xC4EBFB80, 0xC4ECFB80, 0xC4EDFB80, 0xC4EEFB80, 0xC4EFFB80, 0xC4F0FB80, 0xC4F1FB80, 0xC4F2FB80, 0xC4F3FB80, 0xC4F4FB80, 0xC4F5FB80, 0xC4F6FB80, 0xC4F7FB80, 0xC4F8FB80, 0xC4F9FB80, 0xC4FAFB80, 0xC4FBFB80, 0xC4FCFB80, 0xC4FDFB80, 0xC4FEFB80, 0xC4FFFB80,
+		0xC500FB80, 0xC501FB80, 0xC502FB80, 0xC503FB80, 0xC504FB80, 0xC505FB80, 0xC506FB80, 0xC507FB80, 0xC508FB80, 0xC509FB80, 0xC50AFB80, 0xC50BFB80, 0xC50CFB80, 0xC50DFB80, 0xC50EFB80, 0xC50FFB80, 0xC510FB80, 0xC511FB80, 0xC512FB80, 0xC513FB80, 0xC514FB80, 0xC515FB80, 0xC516FB80, 0xC517FB80, 0xC518FB80, 0xC519FB80, 0xC51AFB80, 0xC51BFB80, 0xC51CFB80, 0xC51DFB80, 0xC51EFB80, 0xC51FFB80,
+		0xC520FB80, 0xC521FB80, 0xC522FB80, 0xC523FB80, 0xC524FB80, 0xC525FB80, 0xC526FB80, 0xC527FB80, 0xC528FB80, 0xC529FB80, 0xC52AFB80, 0xC52BFB80, 0xC52CFB80, 0xC52DFB80, 0xC52EFB80, 0xC52FFB80, 0xC530FB80, 0xC531FB80, 0xC532FB80, 0xC533FB80, 0xC534FB80, 0xC535FB80, 0xC536FB80, 0xC537FB80, 0xC538FB80, 0xC539FB80, 0xC53AFB80, 0xC53BFB80, 0xC53CFB80, 0xC53DFB80, 0xC53EFB80, 0xC53FFB80,
+		0xC540FB80, 0xC541FB80, 0xC542FB80, 0xC543FB80, 0xC544FB80, 0xC545FB80, 0xC546FB80, 0xC547FB80, 0xC548FB80, 0xC549FB80, 0xC54AFB80, 0xC54BFB80, 0xC54CFB80, 0xC54DFB80, 0xC54EFB80, 0xC54FFB80, 0xC550FB80, 0xC551FB80, 0xC552FB80, 0xC553FB80, 0xC554FB80, 0xC555FB80, 0xC556FB80, 0xC557FB80, 0xC558FB80, 0xC559FB80, 0xC55AFB80, 0xC55BFB80, 0xC55CFB80, 0xC55DFB80, 0xC55EFB80, 0xC55FFB80,
+		0xC560FB80, 0xC561FB80, 0xC562FB80, 0xC563FB80, 0xC564FB80, 0xC565FB80, 0xC566FB80, 0xC567FB80, 0xC568FB80, 0xC569FB80, 0xC56AFB80, 0xC56BFB80, 0xC56CFB80, 0xC56DFB80, 0xC56EFB80, 0xC56FFB80, 0xC570FB80, 0xC571FB80, 0xC572FB80, 0xC573FB80, 0xC574FB80, 0xC575FB80, 0xC576FB80, 0xC577FB80, 0xC578FB80, 0xC579FB80, 0xC57AFB80, 0xC57BFB80, 0xC57CFB80, 0xC57DFB80, 0xC57EFB80, 0xC57FFB80,
+		0xC580FB80, 0xC581FB80, 0xC582FB80, 0xC583FB80, 0xC584FB80, 0xC585FB80, 0xC586FB80, 0xC587FB80, 0xC588FB80, 0xC589FB80, 0xC58AFB80, 0xC58BFB80, 0xC58CFB80, 0xC58DFB80, 0xC58EFB80, 0xC58FFB80, 0xC590FB80, 0xC591FB80, 0xC592FB80, 0xC593FB80, 0xC594FB80, 0xC595FB80, 0xC596FB80, 0xC597FB80, 0xC598FB80, 0xC599FB80, 0xC59AFB80, 0xC59BFB80, 0xC59CFB80, 0xC59DFB80, 0xC59EFB80, 0xC59FFB80,
+		0xC5A0FB80, 0xC5A1FB80, 0xC5A2FB80, 0xC5A3FB80, 0xC5A4FB80, 0xC5A5FB80, 0xC5A6FB80, 0xC5A7FB80, 0xC5A8FB80, 0xC5A9FB80, 0xC5AAFB80, 0xC5ABFB80, 0xC5ACFB80, 0xC5ADFB80, 0xC5AEFB80, 0xC5AFFB80, 0xC5B0FB80, 0xC5B1FB80, 0xC5B2FB80, 0xC5B3FB80, 0xC5B4FB80, 0xC5B5FB80, 0xC5B6FB80, 0xC5B7FB80, 0xC5B8FB80, 0xC5B9FB80, 0xC5BAFB80, 0xC5BBFB80, 0xC5BCFB80, 0xC5BDFB80, 0xC5BEFB80, 0xC5BFFB80,
+		0xC5C0FB80, 0xC5C1FB80, 0xC5C2FB80, 0xC5C3FB80, 0xC5C4FB80, 0xC5C5FB80, 0xC5C6FB80, 0xC5C7FB80, 0xC5C8FB80, 0xC5C9FB80, 0xC5CAFB80, 0xC5CBFB80, 0xC5CCFB80, 0xC5CDFB80, 0xC5CEFB80, 0xC5CFFB80, 0xC5D0FB80, 0xC5D1FB80, 0xC5D2FB80, 0xC5D3FB80, 0xC5D4FB80, 0xC5D5FB80, 0xC5D6FB80, 0xC5D7FB80, 0xC5D8FB80, 0xC5D9FB80, 0xC5DAFB80, 0xC5DBFB80, 0xC5DCFB80, 0xC5DDFB80, 0xC5DEFB80, 0xC5DFFB80,
+		0xC5E0FB80, 0xC5E1FB80, 0xC5E2FB80, 0xC5E3FB80, 0xC5E4FB80, 0xC5E5FB80, 0xC5E6FB80, 0xC5E7FB80, 0xC5E8FB80, 0xC5E9FB80, 0xC5EAFB80, 0xC5EBFB80, 0xC5ECFB80, 0xC5EDFB80, 0xC5EEFB80, 0xC5EFFB80, 0xC5F0FB80, 0xC5F1FB80, 0xC5F2FB80, 0xC5F3FB80, 0xC5F4FB80, 0xC5F5FB80, 0xC5F6FB80, 0xC5F7FB80, 0xC5F8FB80, 0xC5F9FB80, 0xC5FAFB80, 0xC5FBFB80, 0xC5FCFB80, 0xC5FDFB80, 0xC5FEFB80, 0xC5FFFB80,
+		0xC600FB80, 0xC601FB80, 0xC602FB80, 0xC603FB80, 0xC604FB80, 0xC605FB80, 0xC606FB80, 0xC607FB80, 0xC608FB80, 0xC609FB80, 0xC60AFB80, 0xC60BFB80, 0xC60CFB80, 0xC60DFB80, 0xC60EFB80, 0xC60FFB80, 0xC610FB80, 0xC611FB80, 0xC612FB80, 0xC613FB80, 0xC614FB80, 0xC615FB80, 0xC616FB80, 0xC617FB80, 0xC618FB80, 0xC619FB80, 0xC61AFB80, 0xC61BFB80, 0xC61CFB80, 0xC61DFB80, 0xC61EFB80, 0xC61FFB80,
+		0xC620FB80, 0xC621FB80, 0xC622FB80, 0xC623FB80, 0xC624FB80, 0xC625FB80, 0xC626FB80, 0xC627FB80, 0xC628FB80, 0xC629FB80, 0xC62AFB80, 0xC62BFB80, 0xC62CFB80, 0xC62DFB80, 0xC62EFB80, 0xC62FFB80, 0xC630FB80, 0xC631FB80, 0xC632FB80, 0xC633FB80, 0xC634FB80, 0xC635FB80, 0xC636FB80, 0xC637FB80, 0xC638FB80, 0xC639FB80, 0xC63AFB80, 0xC63BFB80, 0xC63CFB80, 0xC63DFB80, 0xC63EFB80, 0xC63FFB80,
+		0xC640FB80, 0xC641FB80, 0xC642FB80, 0xC643FB80, 0xC644FB80, 0xC645FB80, 0xC646FB80, 0xC647FB80, 0xC648FB80, 0xC649FB80, 0xC64AFB80, 0xC64BFB80, 0xC64CFB80, 0xC64DFB80, 0xC64EFB80, 0xC64FFB80, 0xC650FB80, 0xC651FB80, 0xC652FB80, 0xC653FB80, 0xC654FB80, 0xC655FB80, 0xC656FB80, 0xC657FB80, 0xC658FB80, 0xC659FB80, 0xC65AFB80, 0xC65BFB80, 0xC65CFB80, 0xC65DFB80, 0xC65EFB80, 0xC65FFB80,
+		0xC660FB80, 0xC661FB80, 0xC662FB80, 0xC663FB80, 0xC664FB80, 0xC665FB80, 0xC666FB80, 0xC667FB80, 0xC668FB80, 0xC669FB80, 0xC66AFB80, 0xC66BFB80, 0xC66CFB80, 0xC66DFB80, 0xC66EFB80, 0xC66FFB80, 0xC670FB80, 0xC671FB80, 0xC672FB80, 0xC673FB80, 0xC674FB80, 0xC675FB80, 0xC676FB80, 0xC677FB80, 0xC678FB80, 0xC679FB80, 0xC67AFB80, 0xC67BFB80, 0xC67CFB80, 0xC67DFB80, 0xC67EFB80, 0xC67FFB80,
+		0xC680FB80, 0xC681FB80, 0xC682FB80, 0xC683FB80, 0xC684FB80, 0xC685FB80, 0xC686FB80, 0xC687FB80, 0xC688FB80, 0xC689FB80, 0xC68AFB80, 0xC68BFB80, 0xC68CFB80, 0xC68DFB80, 0xC68EFB80, 0xC68FFB80, 0xC690FB80, 0xC691FB80, 0xC692FB80, 0xC693FB80, 0xC694FB80, 0xC695FB80, 0xC696FB80, 0xC697FB80, 0xC698FB80, 0xC699FB80, 0xC69AFB80, 0xC69BFB80, 0xC69CFB80, 0xC69DFB80, 0xC69EFB80, 0xC69FFB80,
+		0xC6A0FB80, 0xC6A1FB80, 0xC6A2FB80, 0xC6A3FB80, 0xC6A4FB80, 0xC6A5FB80, 0xC6A6FB80, 0xC6A7FB80, 0xC6A8FB80, 0xC6A9FB80, 0xC6AAFB80, 0xC6ABFB80, 0xC6ACFB80, 0xC6ADFB80, 0xC6AEFB80, 0xC6AFFB80, 0xC6B0FB80, 0xC6B1FB80, 0xC6B2FB80, 0xC6B3FB80, 0xC6B4FB80, 0xC6B5FB80, 0xC6B6FB80, 0xC6B7FB80, 0xC6B8FB80, 0xC6B9FB80, 0xC6BAFB80, 0xC6BBFB80, 0xC6BCFB80, 0xC6BDFB80, 0xC6BEFB80, 0xC6BFFB80,
+		0xC6C0FB80, 0xC6C1FB80, 0xC6C2FB80, 0xC6C3FB80, 0xC6C4FB80, 0xC6C5FB80, 0xC6C6FB80, 0xC6C7FB80, 0xC6C8FB80, 0xC6C9FB80, 0xC6CAFB80, 0xC6CBFB80, 0xC6CCFB80, 0xC6CDFB80, 0xC6CEFB80, 0xC6CFFB80, 0xC6D0FB80, 0xC6D1FB80, 0xC6D2FB80, 0xC6D3FB80, 0xC6D4FB80, 0xC6D5FB80, 0xC6D6FB80, 0xC6D7FB80, 0xC6D8FB80, 0xC6D9FB80, 0xC6DAFB80, 0xC6DBFB80, 0xC6DCFB80, 0xC6DDFB80, 0xC6DEFB80, 0xC6DFFB80,
+		0xC6E0FB80, 0xC6E1FB80, 0xC6E2FB80, 0xC6E3FB80, 0xC6E4FB80, 0xC6E5FB80, 0xC6E6FB80, 0xC6E7FB80, 0xC6E8FB80, 0xC6E9FB80, 0xC6EAFB80, 0xC6EBFB80, 0xC6ECFB80, 0xC6EDFB80, 0xC6EEFB80, 0xC6EFFB80, 0xC6F0FB80, 0xC6F1FB80, 0xC6F2FB80, 0xC6F3FB80, 0xC6F4FB80, 0xC6F5FB80, 0xC6F6FB80, 0xC6F7FB80, 0xC6F8FB80, 0xC6F9FB80, 0xC6FAFB80, 0xC6FBFB80, 0xC6FCFB80, 0xC6FDFB80, 0xC6FEFB80, 0xC6FFFB80,
+		0xC700FB80, 0xC701FB80, 0xC702FB80, 0xC703FB80, 0xC704FB80, 0xC705FB80, 0xC706FB80, 0xC707FB80, 0xC708FB80, 0xC709FB80, 0xC70AFB80, 0xC70BFB80, 0xC70CFB80, 0xC70DFB80, 0xC70EFB80, 0xC70FFB80, 0xC710FB80, 0xC711FB80, 0xC712FB80, 0xC713FB80, 0xC714FB80, 0xC715FB80, 0xC716FB80, 0xC717FB80, 0xC718FB80, 0xC719FB80, 0xC71AFB80, 0xC71BFB80, 0xC71CFB80, 0xC71DFB80, 0xC71EFB80, 0xC71FFB80,
+		0xC720FB80, 0xC721FB80, 0xC722FB80, 0xC723FB80, 0xC724FB80, 0xC725FB80, 0xC726FB80, 0xC727FB80, 0xC728FB80, 0xC729FB80, 0xC72AFB80, 0xC72BFB80, 0xC72CFB80, 0xC72DFB80, 0xC72EFB80, 0xC72FFB80, 0xC730FB80, 0xC731FB80, 0xC732FB80, 0xC733FB80, 0xC734FB80, 0xC735FB80, 0xC736FB80, 0xC737FB80, 0xC738FB80, 0xC739FB80, 0xC73AFB80, 0xC73BFB80, 0xC73CFB80, 0xC73DFB80, 0xC73EFB80, 0xC73FFB80,
+		0xC740FB80, 0xC741FB80, 0xC742FB80, 0xC743FB80, 0xC744FB80, 0xC745FB80, 0xC746FB80, 0xC747FB80, 0xC748FB80, 0xC749FB80, 0xC74AFB80, 0xC74BFB80, 0xC74CFB80, 0xC74DFB80, 0xC74EFB80, 0xC74FFB80, 0xC750FB80, 0xC751FB80, 0xC752FB80, 0xC753FB80, 0xC754FB80, 0xC755FB80, 0xC756FB80, 0xC757FB80, 0xC758FB80, 0xC759FB80, 0xC75AFB80, 0xC75BFB80, 0xC75CFB80, 0xC75DFB80, 0xC75EFB80, 0xC75FFB80,
+		0xC760FB80, 0xC761FB80, 0xC762FB80, 0xC763FB80, 0xC764FB80, 0xC765FB80, 0xC766FB80, 0xC767FB80, 0xC768FB80, 0xC769FB80, 0xC76AFB80, 0xC76BFB80, 0xC76CFB80, 0xC76DFB80, 0xC76EFB80, 0xC76FFB80, 0xC770FB80, 0xC771FB80, 0xC772FB80, 0xC773FB80, 0xC774FB80, 0xC775FB80, 0xC776FB80, 0xC777FB80, 0xC778FB80, 0xC779FB80, 0xC77AFB80, 0xC77BFB80, 0xC77CFB80, 0xC77DFB80, 0xC77EFB80, 0xC77FFB80,
+		0xC780FB80, 0xC781FB80, 0xC782FB80, 0xC783FB80, 0xC784FB80, 0xC785FB80, 0xC786FB80, 0xC787FB80, 0xC788FB80, 0xC789FB80, 0xC78AFB80, 0xC78BFB80, 0xC78CFB80, 0xC78DFB80, 0xC78EFB80, 0xC78FFB80, 0xC790FB80, 0xC791FB80, 0xC792FB80, 0xC793FB80, 0xC794FB80, 0xC795FB80, 0xC796FB80, 0xC797FB80, 0xC798FB80, 0xC799FB80, 0xC79AFB80, 0xC79BFB80, 0xC79CFB80, 0xC79DFB80, 0xC79EFB80, 0xC79FFB80,
+		0xC7A0FB80, 0xC7A1FB80, 0xC7A2FB80, 0xC7A3FB80, 0xC7A4FB80, 0xC7A5FB80, 0xC7A6FB80, 0xC7A7FB80, 0xC7A8FB80, 0xC7A9FB80, 0xC7AAFB80, 0xC7ABFB80, 0xC7ACFB80, 0xC7ADFB80, 0xC7AEFB80, 0xC7AFFB80, 0xC7B0FB80, 0xC7B1FB80, 0xC7B2FB80, 0xC7B3FB80, 0xC7B4FB80, 0xC7B5FB80, 0xC7B6FB80, 0xC7B7FB80, 0xC7B8FB80, 0xC7B9FB80, 0xC7BAFB80, 0xC7BBFB80, 0xC7BCFB80, 0xC7BDFB80, 0xC7BEFB80, 0xC7BFFB80,
+		0xC7C0FB80, 0xC7C1FB80, 0xC7C2FB80, 0xC7C3FB80, 0xC7C4FB80, 0xC7C5FB80, 0xC7C6FB80, 0xC7C7FB80, 0xC7C8FB80, 0xC7C9FB80, 0xC7CAFB80, 0xC7CBFB80, 0xC7CCFB80, 0xC7CDFB80, 0xC7CEFB80, 0xC7CFFB80, 0xC7D0FB80, 0xC7D1FB80, 0xC7D2FB80, 0xC7D3FB80, 0xC7D4FB80, 0xC7D5FB80, 0xC7D6FB80, 0xC7D7FB80, 0xC7D8FB80, 0xC7D9FB80, 0xC7DAFB80, 0xC7DBFB80, 0xC7DCFB80, 0xC7DDFB80, 0xC7DEFB80, 0xC7DFFB80,
+		0xC7E0FB80, 0xC7E1FB80, 0xC7E2FB80, 0xC7E3FB80, 0xC7E4FB80, 0xC7E5FB80, 0xC7E6FB80, 0xC7E7FB80, 0xC7E8FB80, 0xC7E9FB80, 0xC7EAFB80, 0xC7EBFB80, 0xC7ECFB80, 0xC7EDFB80, 0xC7EEFB80, 0xC7EFFB80, 0xC7F0FB80, 0xC7F1FB80, 0xC7F2FB80, 0xC7F3FB80, 0xC7F4FB80, 0xC7F5FB80, 0xC7F6FB80, 0xC7F7FB80, 0xC7F8FB80, 0xC7F9FB80, 0xC7FAFB80, 0xC7FBFB80, 0xC7FCFB80, 0xC7FDFB80, 0xC7FEFB80, 0xC7FFFB80,
+		0xC800FB80, 0xC801FB80, 0xC802FB80, 0xC803FB80, 0xC804FB80, 0xC805FB80, 0xC806FB80, 0xC807FB80, 0xC808FB80, 0xC809FB80, 0xC80AFB80, 0xC80BFB80, 0xC80CFB80, 0xC80DFB80, 0xC80EFB80, 0xC80FFB80, 0xC810FB80, 0xC811FB80, 0xC812FB80, 0xC813FB80, 0xC814FB80, 0xC815FB80, 0xC816FB80, 0xC817FB80, 0xC818FB80, 0xC819FB80, 0xC81AFB80, 0xC81BFB80, 0xC81CFB80, 0xC81DFB80, 0xC81EFB80, 0xC81FFB80,
+		0xC820FB80, 0xC821FB80, 0xC822FB80, 0xC823FB80, 0xC824FB80, 0xC825FB80, 0xC826FB80, 0xC827FB80, 0xC828FB80, 0xC829FB80, 0xC82AFB80, 0xC82BFB80, 0xC82CFB80, 0xC82DFB80, 0xC82EFB80, 0xC82FFB80, 0xC830FB80, 0xC831FB80, 0xC832FB80, 0xC833FB80, 0xC834FB80, 0xC835FB80, 0xC836FB80, 0xC837FB80, 0xC838FB80, 0xC839FB80, 0xC83AFB80, 0xC83BFB80, 0xC83CFB80, 0xC83DFB80, 0xC83EFB80, 0xC83FFB80,
+		0xC840FB80, 0xC841FB80, 0xC842FB80, 0xC843FB80, 0xC844FB80, 0xC845FB80, 0xC846FB80, 0xC847FB80, 0xC848FB80, 0xC849FB80, 0xC84AFB80, 0xC84BFB80, 0xC84CFB80, 0xC84DFB80, 0xC84EFB80, 0xC84FFB80, 0xC850FB80, 0xC851FB80, 0xC852FB80, 0xC853FB80, 0xC854FB80, 0xC855FB80, 0xC856FB80, 0xC857FB80, 0xC858FB80, 0xC859FB80, 0xC85AFB80, 0xC85BFB80, 0xC85CFB80, 0xC85DFB80, 0xC85EFB80, 0xC85FFB80,
+		0xC860FB80, 0xC861FB80, 0xC862FB80, 0xC863FB80, 0xC864FB80, 0xC865FB80, 0xC866FB80, 0xC867FB80, 0xC868FB80, 0xC869FB80, 0xC86AFB80, 0xC86BFB80, 0xC86CFB80, 0xC86DFB80, 0xC86EFB80, 0xC86FFB80, 0xC870FB80, 0xC871FB80, 0xC872FB80, 0xC873FB80, 0xC874FB80, 0xC875FB80, 0xC876FB80, 0xC877FB80, 0xC878FB80, 0xC879FB80, 0xC87AFB80, 0xC87BFB80, 0xC87CFB80, 0xC87DFB80, 0xC87EFB80, 0xC87FFB80,
+		0xC880FB80, 0xC881FB80, 0xC882FB80, 0xC883FB80, 0xC884FB80, 0xC885FB80, 0xC886FB80, 0xC887FB80, 0xC888FB80, 0xC889FB80, 0xC88AFB80, 0xC88BFB80, 0xC88CFB80, 0xC88DFB80, 0xC88EFB80, 0xC88FFB80, 0xC890FB80, 0xC891FB80, 0xC892FB80, 0xC893FB80, 0xC894FB80, 0xC895FB80, 0xC896FB80, 0xC897FB80, 0xC898FB80, 0xC899FB80, 0xC89AFB80, 0xC89BFB80, 0xC89CFB80, 0xC89DFB80, 0xC89EFB80, 0xC89FFB80,
+		0xC8A0FB80, 0xC8A1FB80, 0xC8A2FB80, 0xC8A3FB80, 0xC8A4FB80, 0xC8A5FB80, 0xC8A6FB80, 0xC8A7FB80, 0xC8A8FB80, 0xC8A9FB80, 0xC8AAFB80, 0xC8ABFB80, 0xC8ACFB80, 0xC8ADFB80, 0xC8AEFB80, 0xC8AFFB80, 0xC8B0FB80, 0xC8B1FB80, 0xC8B2FB80, 0xC8B3FB80, 0xC8B4FB80, 0xC8B5FB80, 0xC8B6FB80, 0xC8B7FB80, 0xC8B8FB80, 0xC8B9FB80, 0xC8BAFB80, 0xC8BBFB80, 0xC8BCFB80, 0xC8BDFB80, 0xC8BEFB80, 0xC8BFFB80,
+		0xC8C0FB80, 0xC8C1FB80, 0xC8C2FB80, 0xC8C3FB80, 0xC8C4FB80, 0xC8C5FB80, 0xC8C6FB80, 0xC8C7FB80, 0xC8C8FB80, 0xC8C9FB80, 0xC8CAFB80, 0xC8CBFB80, 0xC8CCFB80, 0xC8CDFB80, 0xC8CEFB80, 0xC8CFFB80, 0xC8D0FB80, 0xC8D1FB80, 0xC8D2FB80, 0xC8D3FB80, 0xC8D4FB80, 0xC8D5FB80, 0xC8D6FB80, 0xC8D7FB80, 0xC8D8FB80, 0xC8D9FB80, 0xC8DAFB80, 0xC8DBFB80, 0xC8DCFB80, 0xC8DDFB80, 0xC8DEFB80, 0xC8DFFB80,
+		0xC8E0FB80, 0xC8E1FB80, 0xC8E2FB80, 0xC8E3FB80, 0xC8E4FB80, 0xC8E5FB80, 0xC8E6FB80, 0xC8E7FB80, 0xC8E8FB80, 0xC8E9FB80, 0xC8EAFB80, 0xC8EBFB80, 0xC8ECFB80, 0xC8EDFB80, 0xC8EEFB80, 0xC8EFFB80, 0xC8F0FB80, 0xC8F1FB80, 0xC8F2FB80, 0xC8F3FB80, 0xC8F4FB80, 0xC8F5FB80, 0xC8F6FB80, 0xC8F7FB80, 0xC8F8FB80, 0xC8F9FB80, 0xC8FAFB80, 0xC8FBFB80, 0xC8FCFB80, 0xC8FDFB80, 0xC8FEFB80, 0xC8FFFB80,
+		0xC900FB80, 0xC901FB80, 0xC902FB80, 0xC903FB80, 0xC904FB80, 0xC905FB80, 0xC906FB80, 0xC907FB80, 0xC908FB80, 0xC909FB80, 0xC90AFB80, 0xC90BFB80, 0xC90CFB80, 0xC90DFB80, 0xC90EFB80, 0xC90FFB80, 0xC910FB80, 0xC911FB80, 0xC912FB80, 0xC913FB80, 0xC914FB80, 0xC915FB80, 0xC916FB80, 0xC917FB80, 0xC918FB80, 0xC919FB80, 0xC91AFB80, 0xC91BFB80, 0xC91CFB80, 0xC91DFB80, 0xC91EFB80, 0xC91FFB80,
+		0xC920FB80, 0xC921FB80, 0xC922FB80, 0xC923FB80, 0xC924FB80, 0xC925FB80, 0xC926FB80, 0xC927FB80, 0xC928FB80, 0xC929FB80, 0xC92AFB80, 0xC92BFB80, 0xC92CFB80, 0xC92DFB80, 0xC92EFB80, 0xC92FFB80, 0xC930FB80, 0xC931FB80, 0xC932FB80, 0xC933FB80, 0xC934FB80, 0xC935FB80, 0xC936FB80, 0xC937FB80, 0xC938FB80, 0xC939FB80, 0xC93AFB80, 0xC93BFB80, 0xC93CFB80, 0xC93DFB80, 0xC93EFB80, 0xC93FFB80,
+		0xC940FB80, 0xC941FB80, 0xC942FB80, 0xC943FB80, 0xC944FB80, 0xC945FB80, 0xC946FB80, 0xC947FB80, 0xC948FB80, 0xC949FB80, 0xC94AFB80, 0xC94BFB80, 0xC94CFB80, 0xC94DFB80, 0xC94EFB80, 0xC94FFB80, 0xC950FB80, 0xC951FB80, 0xC952FB80, 0xC953FB80, 0xC954FB80, 0xC955FB80, 0xC956FB80, 0xC957FB80, 0xC958FB80, 0xC959FB80, 0xC95AFB80, 0xC95BFB80, 0xC95CFB80, 0xC95DFB80, 0xC95EFB80, 0xC95FFB80,
+		0xC960FB80, 0xC961FB80, 0xC962FB80, 0xC963FB80, 0xC964FB80, 0xC965FB80, 0xC966FB80, 0xC967FB80, 0xC968FB80, 0xC969FB80, 0xC96AFB80, 0xC96BFB80, 0xC96CFB80, 0xC96DFB80, 0xC96EFB80, 0xC96FFB80, 0xC970FB80, 0xC971FB80, 0xC972FB80, 0xC973FB80, 0xC974FB80, 0xC975FB80, 0xC976FB80, 0xC977FB80, 0xC978FB80, 0xC979FB80, 0xC97AFB80, 0xC97BFB80, 0xC97CFB80, 0xC97DFB80, 0xC97EFB80, 0xC97FFB80,
+		0xC980FB80, 0xC981FB80, 0xC982FB80, 0xC983FB80, 0xC984FB80, 0xC985FB80, 0xC986FB80, 0xC987FB80, 0xC988FB80, 0xC989FB80, 0xC98AFB80, 0xC98BFB80, 0xC98CFB80, 0xC98DFB80, 0xC98EFB80, 0xC98FFB80, 0xC990FB80, 0xC991FB80, 0xC992FB80, 0xC993FB80, 0xC994FB80, 0xC995FB80, 0xC996FB80, 0xC997FB80, 0xC998FB80, 0xC999FB80, 0xC99AFB80, 0xC99BFB80, 0xC99CFB80, 0xC99DFB80, 0xC99EFB80, 0xC99FFB80,
+		0xC9A0FB80, 0xC9A1FB80, 0xC9A2FB80, 0xC9A3FB80, 0xC9A4FB80, 0xC9A5FB80, 0xC9A6FB80, 0xC9A7FB80, 0xC9A8FB80, 0xC9A9FB80, 0xC9AAFB80, 0xC9ABFB80, 0xC9ACFB80, 0xC9ADFB80, 0xC9AEFB80, 0xC9AFFB80, 0xC9B0FB80, 0xC9B1FB80, 0xC9B2FB80, 0xC9B3FB80, 0xC9B4FB80, 0xC9B5FB80, 0xC9B6FB80, 0xC9B7FB80, 0xC9B8FB80, 0xC9B9FB80, 0xC9BAFB80, 0xC9BBFB80, 0xC9BCFB80, 0xC9BDFB80, 0xC9BEFB80, 0xC9BFFB80,
+		0xC9C0FB80, 0xC9C1FB80, 0xC9C2FB80, 0xC9C3FB80, 0xC9C4FB80, 0xC9C5FB80, 0xC9C6FB80, 0xC9C7FB80, 0xC9C8FB80, 0xC9C9FB80, 0xC9CAFB80, 0xC9CBFB80, 0xC9CCFB80, 0xC9CDFB80, 0xC9CEFB80, 0xC9CFFB80, 0xC9D0FB80, 0xC9D1FB80, 0xC9D2FB80, 0xC9D3FB80, 0xC9D4FB80, 0xC9D5FB80, 0xC9D6FB80, 0xC9D7FB80, 0xC9D8FB80, 0xC9D9FB80, 0xC9DAFB80, 0xC9DBFB80, 0xC9DCFB80, 0xC9DDFB80, 0xC9DEFB80, 0xC9DFFB80,
+		0xC9E0FB80, 0xC9E1FB80, 0xC9E2FB80, 0xC9E3FB80, 0xC9E4FB80, 0xC9E5FB80, 0xC9E6FB80, 0xC9E7FB80, 0xC9E8FB80, 0xC9E9FB80, 0xC9EAFB80, 0xC9EBFB80, 0xC9ECFB80, 0xC9EDFB80, 0xC9EEFB80, 0xC9EFFB80, 0xC9F0FB80, 0xC9F1FB80, 0xC9F2FB80, 0xC9F3FB80, 0xC9F4FB80, 0xC9F5FB80, 0xC9F6FB80, 0xC9F7FB80, 0xC9F8FB80, 0xC9F9FB80, 0xC9FAFB80, 0xC9FBFB80, 0xC9FCFB80, 0xC9FDFB80, 0xC9FEFB80, 0xC9FFFB80,
+		0xCA00FB80, 0xCA01FB80, 0xCA02FB80, 0xCA03FB80, 0xCA04FB80, 0xCA05FB80, 0xCA06FB80, 0xCA07FB80, 0xCA08FB80, 0xCA09FB80, 0xCA0AFB80, 0xCA0BFB80, 0xCA0CFB80, 0xCA0DFB80, 0xCA0EFB80, 0xCA0FFB80, 0xCA10FB80, 0xCA11FB80, 0xCA12FB80, 0xCA13FB80, 0xCA14FB80, 0xCA15FB80, 0xCA16FB80, 0xCA17FB80, 0xCA18FB80, 0xCA19FB80, 0xCA1AFB80, 0xCA1BFB80, 0xCA1CFB80, 0xCA1DFB80, 0xCA1EFB80, 0xCA1FFB80,
+		0xCA20FB80, 0xCA21FB80, 0xCA22FB80, 0xCA23FB80, 0xCA24FB80, 0xCA25FB80, 0xCA26FB80, 0xCA27FB80, 0xCA28FB80, 0xCA29FB80, 0xCA2AFB80, 0xCA2BFB80, 0xCA2CFB80, 0xCA2DFB80, 0xCA2EFB80, 0xCA2FFB80, 0xCA30FB80, 0xCA31FB80, 0xCA32FB80, 0xCA33FB80, 0xCA34FB80, 0xCA35FB80, 0xCA36FB80, 0xCA37FB80, 0xCA38FB80, 0xCA39FB80, 0xCA3AFB80, 0xCA3BFB80, 0xCA3CFB80, 0xCA3DFB80, 0xCA3EFB80, 0xCA3FFB80,
+		0xCA40FB80, 0xCA41FB80, 0xCA42FB80, 0xCA43FB80, 0xCA44FB80, 0xCA45FB80, 0xCA46FB80, 0xCA47FB80, 0xCA48FB80, 0xCA49FB80, 0xCA4AFB80, 0xCA4BFB80, 0xCA4CFB80, 0xCA4DFB80, 0xCA4EFB80, 0xCA4FFB80, 0xCA50FB80, 0xCA51FB80, 0xCA52FB80, 0xCA53FB80, 0xCA54FB80, 0xCA55FB80, 0xCA56FB80, 0xCA57FB80, 0xCA58FB80, 0xCA59FB80, 0xCA5AFB80, 0xCA5BFB80, 0xCA5CFB80, 0xCA5DFB80, 0xCA5EFB80, 0xCA5FFB80,
+		0xCA60FB80, 0xCA61FB80, 0xCA62FB80, 0xCA63FB80, 0xCA64FB80, 0xCA65FB80, 0xCA66FB80, 0xCA67FB80, 0xCA68FB80, 0xCA69FB80, 0xCA6AFB80, 0xCA6BFB80, 0xCA6CFB80, 0xCA6DFB80, 0xCA6EFB80, 0xCA6FFB80, 0xCA70FB80, 0xCA71FB80, 0xCA72FB80, 0xCA73FB80, 0xCA74FB80, 0xCA75FB80, 0xCA76FB80, 0xCA77FB80, 0xCA78FB80, 0xCA79FB80, 0xCA7AFB80, 0xCA7BFB80, 0xCA7CFB80, 0xCA7DFB80, 0xCA7EFB80, 0xCA7FFB80,
+		0xCA80FB80, 0xCA81FB80, 0xCA82FB80, 0xCA83FB80, 0xCA84FB80, 0xCA85FB80, 0xCA86FB80, 0xCA87FB80, 0xCA88FB80, 0xCA89FB80, 0xCA8AFB80, 0xCA8BFB80, 0xCA8CFB80, 0xCA8DFB80, 0xCA8EFB80, 0xCA8FFB80, 0xCA90FB80, 0xCA91FB80, 0xCA92FB80, 0xCA93FB80, 0xCA94FB80, 0xCA95FB80, 0xCA96FB80, 0xCA97FB80, 0xCA98FB80, 0xCA99FB80, 0xCA9AFB80, 0xCA9BFB80, 0xCA9CFB80, 0xCA9DFB80, 0xCA9EFB80, 0xCA9FFB80,
+		0xCAA0FB80, 0xCAA1FB80, 0xCAA2FB80, 0xCAA3FB80, 0xCAA4FB80, 0xCAA5FB80, 0xCAA6FB80, 0xCAA7FB80, 0xCAA8FB80, 0xCAA9FB80, 0xCAAAFB80, 0xCAABFB80, 0xCAACFB80, 0xCAADFB80, 0xCAAEFB80, 0xCAAFFB80, 0xCAB0FB80, 0xCAB1FB80, 0xCAB2FB80, 0xCAB3FB80, 0xCAB4FB80, 0xCAB5FB80, 0xCAB6FB80, 0xCAB7FB80, 0xCAB8FB80, 0xCAB9FB80, 0xCABAFB80, 0xCABBFB80, 0xCABCFB80, 0xCABDFB80, 0xCABEFB80, 0xCABFFB80,
+		0xCAC0FB80, 0xCAC1FB80, 0xCAC2FB80, 0xCAC3FB80, 0xCAC4FB80, 0xCAC5FB80, 0xCAC6FB80, 0xCAC7FB80, 0xCAC8FB80, 0xCAC9FB80, 0xCACAFB80, 0xCACBFB80, 0xCACCFB80, 0xCACDFB80, 0xCACEFB80, 0xCACFFB80, 0xCAD0FB80, 0xCAD1FB80, 0xCAD2FB80, 0xCAD3FB80, 0xCAD4FB80, 0xCAD5FB80, 0xCAD6FB80, 0xCAD7FB80, 0xCAD8FB80, 0xCAD9FB80, 0xCADAFB80, 0xCADBFB80, 0xCADCFB80, 0xCADDFB80, 0xCADEFB80, 0xCADFFB80,
+		0xCAE0FB80, 0xCAE1FB80, 0xCAE2FB80, 0xCAE3FB80, 0xCAE4FB80, 0xCAE5FB80, 0xCAE6FB80, 0xCAE7FB80, 0xCAE8FB80, 0xCAE9FB80, 0xCAEAFB80, 0xCAEBFB80, 0xCAECFB80, 0xCAEDFB80, 0xCAEEFB80, 0xCAEFFB80, 0xCAF0FB80, 0xCAF1FB80, 0xCAF2FB80, 0xCAF3FB80, 0xCAF4FB80, 0xCAF5FB80, 0xCAF6FB80, 0xCAF7FB80, 0xCAF8FB80, 0xCAF9FB80, 0xCAFAFB80, 0xCAFBFB80, 0xCAFCFB80, 0xCAFDFB80, 0xCAFEFB80, 0xCAFFFB80,
+		0xCB00FB80, 0xCB01FB80, 0xCB02FB80, 0xCB03FB80, 0xCB04FB80, 0xCB05FB80, 0xCB06FB80, 0xCB07FB80, 0xCB08FB80, 0xCB09FB80, 0xCB0AFB80, 0xCB0BFB80, 0xCB0CFB80, 0xCB0DFB80, 0xCB0EFB80, 0xCB0FFB80, 0xCB10FB80, 0xCB11FB80, 0xCB12FB80, 0xCB13FB80, 0xCB14FB80, 0xCB15FB80, 0xCB16FB80, 0xCB17FB80, 0xCB18FB80, 0xCB19FB80, 0xCB1AFB80, 0xCB1BFB80, 0xCB1CFB80, 0xCB1DFB80, 0xCB1EFB80, 0xCB1FFB80,
+		0xCB20FB80, 0xCB21FB80, 0xCB22FB80, 0xCB23FB80, 0xCB24FB80, 0xCB25FB80, 0xCB26FB80, 0xCB27FB80, 0xCB28FB80, 0xCB29FB80, 0xCB2AFB80, 0xCB2BFB80, 0xCB2CFB80, 0xCB2DFB80, 0xCB2EFB80, 0xCB2FFB80, 0xCB30FB80, 0xCB31FB80, 0xCB32FB80, 0xCB33FB80, 0xCB34FB80, 0xCB35FB80, 0xCB36FB80, 0xCB37FB80, 0xCB38FB80, 0xCB39FB80, 0xCB3AFB80, 0xCB3BFB80, 0xCB3CFB80, 0xCB3DFB80, 0xCB3EFB80, 0xCB3FFB80,
+		0xCB40FB80, 0xCB41FB80, 0xCB42FB80, 0xCB43FB80, 0xCB44FB80, 0xCB45FB80, 0xCB46FB80, 0xCB47FB80, 0xCB48FB80, 0xCB49FB80, 0xCB4AFB80, 0xCB4BFB80, 0xCB4CFB80, 0xCB4DFB80, 0xCB4EFB80, 0xCB4FFB80, 0xCB50FB80, 0xCB51FB80, 0xCB52FB80, 0xCB53FB80, 0xCB54FB80, 0xCB55FB80, 0xCB56FB80, 0xCB57FB80, 0xCB58FB80, 0xCB59FB80, 0xCB5AFB80, 0xCB5BFB80, 0xCB5CFB80, 0xCB5DFB80, 0xCB5EFB80, 0xCB5FFB80,
+		0xCB60FB80, 0xCB61FB80, 0xCB62FB80, 0xCB63FB80, 0xCB64FB80, 0xCB65FB80, 0xCB66FB80, 0xCB67FB80, 0xCB68FB80, 0xCB69FB80, 0xCB6AFB80, 0xCB6BFB80, 0xCB6CFB80, 0xCB6DFB80, 0xCB6EFB80, 0xCB6FFB80, 0xCB70FB80, 0xCB71FB80, 0xCB72FB80, 0xCB73FB80, 0xCB74FB80, 0xCB75FB80, 0xCB76FB80, 0xCB77FB80, 0xCB78FB80, 0xCB79FB80, 0xCB7AFB80, 0xCB7BFB80, 0xCB7CFB80, 0xCB7DFB80, 0xCB7EFB80, 0xCB7FFB80,
+		0xCB80FB80, 0xCB81FB80, 0xCB82FB80, 0xCB83FB80, 0xCB84FB80, 0xCB85FB80, 0xCB86FB80, 0xCB87FB80, 0xCB88FB80, 0xCB89FB80, 0xCB8AFB80, 0xCB8BFB80, 0xCB8CFB80, 0xCB8DFB80, 0xCB8EFB80, 0xCB8FFB80, 0xCB90FB80, 0xCB91FB80, 0xCB92FB80, 0xCB93FB80, 0xCB94FB80, 0xCB95FB80, 0xCB96FB80, 0xCB97FB80, 0xCB98FB80, 0xCB99FB80, 0xCB9AFB80, 0xCB9BFB80, 0xCB9CFB80, 0xCB9DFB80, 0xCB9EFB80, 0xCB9FFB80,
+		0xCBA0FB80, 0xCBA1FB80, 0xCBA2FB80, 0xCBA3FB80, 0xCBA4FB80, 0xCBA5FB80, 0xCBA6FB80, 0xCBA7FB80, 0xCBA8FB80, 0xCBA9FB80, 0xCBAAFB80, 0xCBABFB80, 0xCBACFB80, 0xCBADFB80, 0xCBAEFB80, 0xCBAFFB80, 0xCBB0FB80, 0xCBB1FB80, 0xCBB2FB80, 0xCBB3FB80, 0xCBB4FB80, 0xCBB5FB80, 0xCBB6FB80, 0xCBB7FB80, 0xCBB8FB80, 0xCBB9FB80, 0xCBBAFB80, 0xCBBBFB80, 0xCBBCFB80, 0xCBBDFB80, 0xCBBEFB80, 0xCBBFFB80,
+		0xCBC0FB80, 0xCBC1FB80, 0xCBC2FB80, 0xCBC3FB80, 0xCBC4FB80, 0xCBC5FB80, 0xCBC6FB80, 0xCBC7FB80, 0xCBC8FB80, 0xCBC9FB80, 0xCBCAFB80, 0xCBCBFB80, 0xCBCCFB80, 0xCBCDFB80, 0xCBCEFB80, 0xCBCFFB80, 0xCBD0FB80, 0xCBD1FB80, 0xCBD2FB80, 0xCBD3FB80, 0xCBD4FB80, 0xCBD5FB80, 0xCBD6FB80, 0xCBD7FB80, 0xCBD8FB80, 0xCBD9FB80, 0xCBDAFB80, 0xCBDBFB80, 0xCBDCFB80, 0xCBDDFB80, 0xCBDEFB80, 0xCBDFFB80,
+		0xCBE0FB80, 0xCBE1FB80, 0xCBE2FB80, 0xCBE3FB80, 0xCBE4FB80, 0xCBE5FB80, 0xCBE6FB80, 0xCBE7FB80, 0xCBE8FB80, 0xCBE9FB80, 0xCBEAFB80, 0xCBEBFB80, 0xCBECFB80, 0xCBEDFB80, 0xCBEEFB80, 0xCBEFFB80, 0xCBF0FB80, 0xCBF1FB80, 0xCBF2FB80, 0xCBF3FB80, 0xCBF4FB80, 0xCBF5FB80, 0xCBF6FB80, 0xCBF7FB80, 0xCBF8FB80, 0xCBF9FB80, 0xCBFAFB80, 0xCBFBFB80, 0xCBFCFB80, 0xCBFDFB80, 0xCBFEFB80, 0xCBFFFB80,
+		0xCC00FB80, 0xCC01FB80, 0xCC02FB80, 0xCC03FB80, 0xCC04FB80, 0xCC05FB80, 0xCC06FB80, 0xCC07FB80, 0xCC08FB80, 0xCC09FB80, 0xCC0AFB80, 0xCC0BFB80, 0xCC0CFB80, 0xCC0DFB80, 0xCC0EFB80, 0xCC0FFB80, 0xCC10FB80, 0xCC11FB80, 0xCC12FB80, 0xCC13FB80, 0xCC14FB80, 0xCC15FB80, 0xCC16FB80, 0xCC17FB80, 0xCC18FB80, 0xCC19FB80, 0xCC1AFB80, 0xCC1BFB80, 0xCC1CFB80, 0xCC1DFB80, 0xCC1EFB80, 0xCC1FFB80,
+		0xCC20FB80, 0xCC21FB80, 0xCC22FB80, 0xCC23FB80, 0xCC24FB80, 0xCC25FB80, 0xCC26FB80, 0xCC27FB80, 0xCC28FB80, 0xCC29FB80, 0xCC2AFB80, 0xCC2BFB80, 0xCC2CFB80, 0xCC2DFB80, 0xCC2EFB80, 0xCC2FFB80, 0xCC30FB80, 0xCC31FB80, 0xCC32FB80, 0xCC33FB80, 0xCC34FB80, 0xCC35FB80, 0xCC36FB80, 0xCC37FB80, 0xCC38FB80, 0xCC39FB80, 0xCC3AFB80, 0xCC3BFB80, 0xCC3CFB80, 0xCC3DFB80, 0xCC3EFB80, 0xCC3FFB80,
+		0xCC40FB80, 0xCC41FB80, 0xCC42FB80, 0xCC43FB80, 0xCC44FB80, 0xCC45FB80, 0xCC46FB80, 0xCC47FB80, 0xCC48FB80, 0xCC49FB80, 0xCC4AFB80, 0xCC4BFB80, 0xCC4CFB80, 0xCC4DFB80, 0xCC4EFB80, 0xCC4FFB80, 0xCC50FB80, 0xCC51FB80, 0xCC52FB80, 0xCC53FB80, 0xCC54FB80, 0xCC55FB80, 0xCC56FB80, 0xCC57FB80, 0xCC58FB80, 0xCC59FB80, 0xCC5AFB80, 0xCC5BFB80, 0xCC5CFB80, 0xCC5DFB80, 0xCC5EFB80, 0xCC5FFB80,
+		0xCC60FB80, 0xCC61FB80, 0xCC62FB80, 0xCC63FB80, 0xCC64FB80, 0xCC65FB80, 0xCC66FB80, 0xCC67FB80, 0xCC68FB80, 0xCC69FB80, 0xCC6AFB80, 0xCC6BFB80, 0xCC6CFB80, 0xCC6DFB80, 0xCC6EFB80, 0xCC6FFB80, 0xCC70FB80, 0xCC71FB80, 0xCC72FB80, 0xCC73FB80, 0xCC74FB80, 0xCC75FB80, 0xCC76FB80, 0xCC77FB80, 0xCC78FB80, 0xCC79FB80, 0xCC7AFB80, 0xCC7BFB80, 0xCC7CFB80, 0xCC7DFB80, 0xCC7EFB80, 0xCC7FFB80,
+		0xCC80FB80, 0xCC81FB80, 0xCC82FB80, 0xCC83FB80, 0xCC84FB80, 0xCC85FB80, 0xCC86FB80, 0xCC87FB80, 0xCC88FB80, 0xCC89FB80, 0xCC8AFB80, 0xCC8BFB80, 0xCC8CFB80, 0xCC8DFB80, 0xCC8EFB80, 0xCC8FFB80, 0xCC90FB80, 0xCC91FB80, 0xCC92FB80, 0xCC93FB80, 0xCC94FB80, 0xCC95FB80, 0xCC96FB80, 0xCC97FB80, 0xCC98FB80, 0xCC99FB80, 0xCC9AFB80, 0xCC9BFB80, 0xCC9CFB80, 0xCC9DFB80, 0xCC9EFB80, 0xCC9FFB80,
+		0xCCA0FB80, 0xCCA1FB80, 0xCCA2FB80, 0xCCA3FB80, 0xCCA4FB80, 0xCCA5FB80, 0xCCA6FB80, 0xCCA7FB80, 0xCCA8FB80, 0xCCA9FB80, 0xCCAAFB80, 0xCCABFB80, 0xCCACFB80, 0xCCADFB80, 0xCCAEFB80, 0xCCAFFB80, 0xCCB0FB80, 0xCCB1FB80, 0xCCB2FB80, 0xCCB3FB80, 0xCCB4FB80, 0xCCB5FB80, 0xCCB6FB80, 0xCCB7FB80, 0xCCB8FB80, 0xCCB9FB80, 0xCCBAFB80, 0xCCBBFB80, 0xCCBCFB80, 0xCCBDFB80, 0xCCBEFB80, 0xCCBFFB80,
+		0xCCC0FB80, 0xCCC1FB80, 0xCCC2FB80, 0xCCC3FB80, 0xCCC4FB80, 0xCCC5FB80, 0xCCC6FB80, 0xCCC7FB80, 0xCCC8FB80, 0xCCC9FB80, 0xCCCAFB80, 0xCCCBFB80, 0xCCCCFB80, 0xCCCDFB80, 0xCCCEFB80, 0xCCCFFB80, 0xCCD0FB80, 0xCCD1FB80, 0xCCD2FB80, 0xCCD3FB80, 0xCCD4FB80, 0xCCD5FB80, 0xCCD6FB80, 0xCCD7FB80, 0xCCD8FB80, 0xCCD9FB80, 0xCCDAFB80, 0xCCDBFB80, 0xCCDCFB80, 0xCCDDFB80, 0xCCDEFB80, 0xCCDFFB80,
+		0xCCE0FB80, 0xCCE1FB80, 0xCCE2FB80, 0xCCE3FB80, 0xCCE4FB80, 0xCCE5FB80, 0xCCE6FB80, 0xCCE7FB80, 0xCCE8FB80, 0xCCE9FB80, 0xCCEAFB80, 0xCCEBFB80, 0xCCECFB80, 0xCCEDFB80, 0xCCEEFB80, 0xCCEFFB80, 0xCCF0FB80, 0xCCF1FB80, 0xCCF2FB80, 0xCCF3FB80, 0xCCF4FB80, 0xCCF5FB80, 0xCCF6FB80, 0xCCF7FB80, 0xCCF8FB80, 0xCCF9FB80, 0xCCFAFB80, 0xCCFBFB80, 0xCCFCFB80, 0xCCFDFB80, 0xCCFEFB80, 0xCCFFFB80,
+		0xCD00FB80, 0xCD01FB80, 0xCD02FB80, 0xCD03FB80, 0xCD04FB80, 0xCD05FB80, 0xCD06FB80, 0xCD07FB80, 0xCD08FB80, 0xCD09FB80, 0xCD0AFB80, 0xCD0BFB80, 0xCD0CFB80, 0xCD0DFB80, 0xCD0EFB80, 0xCD0FFB80, 0xCD10FB80, 0xCD11FB80, 0xCD12FB80, 0xCD13FB80, 0xCD14FB80, 0xCD15FB80, 0xCD16FB80, 0xCD17FB80, 0xCD18FB80, 0xCD19FB80, 0xCD1AFB80, 0xCD1BFB80, 0xCD1CFB80, 0xCD1DFB80, 0xCD1EFB80, 0xCD1FFB80,
+		0xCD20FB80, 0xCD21FB80, 0xCD22FB80, 0xCD23FB80, 0xCD24FB80, 0xCD25FB80, 0xCD26FB80, 0xCD27FB80, 0xCD28FB80, 0xCD29FB80, 0xCD2AFB80, 0xCD2BFB80, 0xCD2CFB80, 0xCD2DFB80, 0xCD2EFB80, 0xCD2FFB80, 0xCD30FB80, 0xCD31FB80, 0xCD32FB80, 0xCD33FB80, 0xCD34FB80, 0xCD35FB80, 0xCD36FB80, 0xCD37FB80, 0xCD38FB80, 0xCD39FB80, 0xCD3AFB80, 0xCD3BFB80, 0xCD3CFB80, 0xCD3DFB80, 0xCD3EFB80, 0xCD3FFB80,
+		0xCD40FB80, 0xCD41FB80, 0xCD42FB80, 0xCD43FB80, 0xCD44FB80, 0xCD45FB80, 0xCD46FB80, 0xCD47FB80, 0xCD48FB80, 0xCD49FB80, 0xCD4AFB80, 0xCD4BFB80, 0xCD4CFB80, 0xCD4DFB80, 0xCD4EFB80, 0xCD4FFB80, 0xCD50FB80, 0xCD51FB80, 0xCD52FB80, 0xCD53FB80, 0xCD54FB80, 0xCD55FB80, 0xCD56FB80, 0xCD57FB80, 0xCD58FB80, 0xCD59FB80, 0xCD5AFB80, 0xCD5BFB80, 0xCD5CFB80, 0xCD5DFB80, 0xCD5EFB80, 0xCD5FFB80,
+		0xCD60FB80, 0xCD61FB80, 0xCD62FB80, 0xCD63FB80, 0xCD64FB80, 0xCD65FB80, 0xCD66FB80, 0xCD67FB80, 0xCD68FB80, 0xCD69FB80, 0xCD6AFB80, 0xCD6BFB80, 0xCD6CFB80, 0xCD6DFB80, 0xCD6EFB80, 0xCD6FFB80, 0xCD70FB80, 0xCD71FB80, 0xCD72FB80, 0xCD73FB80, 0xCD74FB80, 0xCD75FB80, 0xCD76FB80, 0xCD77FB80, 0xCD78FB80, 0xCD79FB80, 0xCD7AFB80, 0xCD7BFB80, 0xCD7CFB80, 0xCD7DFB80, 0xCD7EFB80, 0xCD7FFB80,
+		0xCD80FB80, 0xCD81FB80, 0xCD82FB80, 0xCD83FB80, 0xCD84FB80, 0xCD85FB80, 0xCD86FB80, 0xCD87FB80, 0xCD88FB80, 0xCD89FB80, 0xCD8AFB80, 0xCD8BFB80, 0xCD8CFB80, 0xCD8DFB80, 0xCD8EFB80, 0xCD8FFB80, 0xCD90FB80, 0xCD91FB80, 0xCD92FB80, 0xCD93FB80, 0xCD94FB80, 0xCD95FB80, 0xCD96FB80, 0xCD97FB80, 0xCD98FB80, 0xCD99FB80, 0xCD9AFB80, 0xCD9BFB80, 0xCD9CFB80, 0xCD9DFB80, 0xCD9EFB80, 0xCD9FFB80,
+		0xCDA0FB80, 0xCDA1FB80, 0xCDA2FB80, 0xCDA3FB80, 0xCDA4FB80, 0xCDA5FB80, 0xCDA6FB80, 0xCDA7FB80, 0xCDA8FB80, 0xCDA9FB80, 0xCDAAFB80, 0xCDABFB80, 0xCDACFB80, 0xCDADFB80, 0xCDAEFB80, 0xCDAFFB80, 0xCDB0FB80, 0xCDB1FB80, 0xCDB2FB80, 0xCDB3FB80, 0xCDB4FB80, 0xCDB5FB80, 0xCDB6FB80, 0xCDB7FB80, 0xCDB8FB80, 0xCDB9FB80, 0xCDBAFB80, 0xCDBBFB80, 0xCDBCFB80, 0xCDBDFB80, 0xCDBEFB80, 0xCDBFFB80,
+		0xFF6, 0xFF7, 0xFF8, 0xFF9, 0xFFA, 0xFFB, 0xFFC, 0xFFD, 0xFFE, 0xFFF, 0x1000, 0x1001, 0x1002, 0x1003, 0x1004, 0x1005, 0x1006, 0x1007, 0x1008, 0x1009, 0x100A, 0x100B, 0x100C, 0x100D, 0x100E, 0x100F, 0x1010, 0x1011, 0x1012, 0x1013, 0x1014, 0x1015,
+		0x1016, 0x1017, 0x1018, 0x1019, 0x101A, 0x101B, 0x101C, 0x101D, 0x101E, 0x101F, 0x1020, 0x1021, 0x1022, 0x1023, 0x1024, 0x1025, 0x1026, 0x1027, 0x1028, 0x1029, 0x102A, 0x102B, 0x102C, 0x102D, 0x102E, 0x102F, 0x1030, 0x1031, 0x1032, 0x1033, 0x1034, 0x1035,
+		0xCE00FB40, 0xCE01FB40, 0xCE02FB40, 0xCE03FB40, 0xCE04FB40, 0xCE05FB40, 0xCE06FB40, 0xCE07FB40, 0xCE08FB40, 0xCE09FB40, 0xCE0AFB40, 0xCE0BFB40, 0xCE0CFB40, 0xCE0DFB40, 0xCE0EFB40, 0xCE0FFB40, 0xCE10FB40, 0xCE11FB40, 0xCE12FB40, 0xCE13FB40, 0xCE14FB40, 0xCE15FB40, 0xCE16FB40, 0xCE17FB40, 0xCE18FB40, 0xCE19FB40, 0xCE1AFB40, 0xCE1BFB40, 0xCE1CFB40, 0xCE1DFB40, 0xCE1EFB40, 0xCE1FFB40,
+		0xCE20FB40, 0xCE21FB40, 0xCE22FB40, 0xCE23FB40, 0xCE24FB40, 0xCE25FB40, 0xCE26FB40, 0xCE27FB40, 0xCE28FB40, 0xCE29FB40, 0xCE2AFB40, 0xCE2BFB40, 0xCE2CFB40, 0xCE2DFB40, 0xCE2EFB40, 0xCE2FFB40, 0xCE30FB40, 0xCE31FB40, 0xCE32FB40, 0xCE33FB40, 0xCE34FB40, 0xCE35FB40, 0xCE36FB40, 0xCE37FB40, 0xCE38FB40, 0xCE39FB40, 0xCE3AFB40, 0xCE3BFB40, 0xCE3CFB40, 0xCE3DFB40, 0xCE3EFB40, 0xCE3FFB40,
+		0xCE40FB40, 0xCE41FB40, 0xCE42FB40, 0xCE43FB40, 0xCE44FB40, 0xCE45FB40, 0xCE46FB40, 0xCE47FB40, 0xCE48FB40, 0xCE49FB40, 0xCE4AFB40, 0xCE4BFB40, 0xCE4CFB40, 0xCE4DFB40, 0xCE4EFB40, 0xCE4FFB40, 0xCE50FB40, 0xCE51FB40, 0xCE52FB40, 0xCE53FB40, 0xCE54FB40, 0xCE55FB40, 0xCE56FB40, 0xCE57FB40, 0xCE58FB40, 0xCE59FB40, 0xCE5AFB40, 0xCE5BFB40, 0xCE5CFB40, 0xCE5DFB40, 0xCE5EFB40, 0xCE5FFB40,
+		0xCE60FB40, 0xCE61FB40, 0xCE62FB40, 0xCE63FB40, 0xCE64FB40, 0xCE65FB40, 0xCE66FB40, 0xCE67FB40, 0xCE68FB40, 0xCE69FB40, 0xCE6AFB40, 0xCE6BFB40, 0xCE6CFB40, 0xCE6DFB40, 0xCE6EFB40, 0xCE6FFB40, 0xCE70FB40, 0xCE71FB40, 0xCE72FB40, 0xCE73FB40, 0xCE74FB40, 0xCE75FB40, 0xCE76FB40, 0xCE77FB40, 0xCE78FB40, 0xCE79FB40, 0xCE7AFB40, 0xCE7BFB40, 0xCE7CFB40, 0xCE7DFB40, 0xCE7EFB40, 0xCE7FFB40,
+		0xCE80FB40, 0xCE81FB40, 0xCE82FB40, 0xCE83FB40, 0xCE84FB40, 0xCE85FB40, 0xCE86FB40, 0xCE87FB40, 0xCE88FB40, 0xCE89FB40, 0xCE8AFB40, 0xCE8BFB40, 0xCE8CFB40, 0xCE8DFB40, 0xCE8EFB40, 0xCE8FFB40, 0xCE90FB40, 0xCE91FB40, 0xCE92FB40, 0xCE93FB40, 0xCE94FB40, 0xCE95FB40, 0xCE96FB40, 0xCE97FB40, 0xCE98FB40, 0xCE99FB40, 0xCE9AFB40, 0xCE9BFB40, 0xCE9CFB40, 0xCE9DFB40, 0xCE9EFB40, 0xCE9FFB40,
+		0xCEA0FB40, 0xCEA1FB40, 0xCEA2FB40, 0xCEA3FB40, 0xCEA4FB40, 0xCEA5FB40, 0xCEA6FB40, 0xCEA7FB40, 0xCEA8FB40, 0xCEA9FB40, 0xCEAAFB40, 0xCEABFB40, 0xCEACFB40, 0xCEADFB40, 0xCEAEFB40, 0xCEAFFB40, 0xCEB0FB40, 0xCEB1FB40, 0xCEB2FB40, 0xCEB3FB40, 0xCEB4FB40, 0xCEB5FB40, 0xCEB6FB40, 0xCEB7FB40, 0xCEB8FB40, 0xCEB9FB40, 0xCEBAFB40, 0xCEBBFB40, 0xCEBCFB40, 0xCEBDFB40, 0xCEBEFB40, 0xCEBFFB40,
+		0xCEC0FB40, 0xCEC1FB40, 0xCEC2FB40, 0xCEC3FB40, 0xCEC4FB40, 0xCEC5FB40, 0xCEC6FB40, 0xCEC7FB40, 0xCEC8FB40, 0xCEC9FB40, 0xCECAFB40, 0xCECBFB40, 0xCECCFB40, 0xCECDFB40, 0xCECEFB40, 0xCECFFB40, 0xCED0FB40, 0xCED1FB40, 0xCED2FB40, 0xCED3FB40, 0xCED4FB40, 0xCED5FB40, 0xCED6FB40, 0xCED7FB40, 0xCED8FB40, 0xCED9FB40, 0xCEDAFB40, 0xCEDBFB40, 0xCEDCFB40, 0xCEDDFB40, 0xCEDEFB40, 0xCEDFFB40,
+		0xCEE0FB40, 0xCEE1FB40, 0xCEE2FB40, 0xCEE3FB40, 0xCEE4FB40, 0xCEE5FB40, 0xCEE6FB40, 0xCEE7FB40, 0xCEE8FB40, 0xCEE9FB40, 0xCEEAFB40, 0xCEEBFB40, 0xCEECFB40, 0xCEEDFB40, 0xCEEEFB40, 0xCEEFFB40, 0xCEF0FB40, 0xCEF1FB40, 0xCEF2FB40, 0xCEF3FB40, 0xCEF4FB40, 0xCEF5FB40, 0xCEF6FB40, 0xCEF7FB40, 0xCEF8FB40, 0xCEF9FB40, 0xCEFAFB40, 0xCEFBFB40, 0xCEFCFB40, 0xCEFDFB40, 0xCEFEFB40, 0xCEFFFB40,
+		0xCF00FB40, 0xCF01FB40, 0xCF02FB40, 0xCF03FB40, 0xCF04FB40, 0xCF05FB40, 0xCF06FB40, 0xCF07FB40, 0xCF08FB40, 0xCF09FB40, 0xCF0AFB40, 0xCF0BFB40, 0xCF0CFB40, 0xCF0DFB40, 0xCF0EFB40, 0xCF0FFB40, 0xCF10FB40, 0xCF11FB40, 0xCF12FB40, 0xCF13FB40, 0xCF14FB40, 0xCF15FB40, 0xCF16FB40, 0xCF17FB40, 0xCF18FB40, 0xCF19FB40, 0xCF1AFB40, 0xCF1BFB40, 0xCF1CFB40, 0xCF1DFB40, 0xCF1EFB40, 0xCF1FFB40,
+		0xCF20FB40, 0xCF21FB40, 0xCF22FB40, 0xCF23FB40, 0xCF24FB40, 0xCF25FB40, 0xCF26FB40, 0xCF27FB40, 0xCF28FB40, 0xCF29FB40, 0xCF2AFB40, 0xCF2BFB40, 0xCF2CFB40, 0xCF2DFB40, 0xCF2EFB40, 0xCF2FFB40, 0xCF30FB40, 0xCF31FB40, 0xCF32FB40, 0xCF33FB40, 0xCF34FB40, 0xCF35FB40, 0xCF36FB40, 0xCF37FB40, 0xCF38FB40, 0xCF39FB40, 0xCF3AFB40, 0xCF3BFB40, 0xCF3CFB40, 0xCF3DFB40, 0xCF3EFB40, 0xCF3FFB40,
+		0xCF40FB40, 0xCF41FB40, 0xCF42FB40, 0xCF43FB40, 0xCF44FB40, 0xCF45FB40, 0xCF46FB40, 0xCF47FB40, 0xCF48FB40, 0xCF49FB40, 0xCF4AFB40, 0xCF4BFB40, 0xCF4CFB40, 0xCF4DFB40, 0xCF4EFB40, 0xCF4FFB40, 0xCF50FB40, 0xCF51FB40, 0xCF52FB40, 0xCF53FB40, 0xCF54FB40, 0xCF55FB40, 0xCF56FB40, 0xCF57FB40, 0xCF58FB40, 0xCF59FB40, 0xCF5AFB40, 0xCF5BFB40, 0xCF5CFB40, 0xCF5DFB40, 0xCF5EFB40, 0xCF5FFB40,
+		0xCF60FB40, 0xCF61FB40, 0xCF62FB40, 0xCF63FB40, 0xCF64FB40, 0xCF65FB40, 0xCF66FB40, 0xCF67FB40, 0xCF68FB40, 0xCF69FB40, 0xCF6AFB40, 0xCF6BFB40, 0xCF6CFB40, 0xCF6DFB40, 0xCF6EFB40, 0xCF6FFB40, 0xCF70FB40, 0xCF71FB40, 0xCF72FB40, 0xCF73FB40, 0xCF74FB40, 0xCF75FB40, 0xCF76FB40, 0xCF77FB40, 0xCF78FB40, 0xCF79FB40, 0xCF7AFB40, 0xCF7BFB40, 0xCF7CFB40, 0xCF7DFB40, 0xCF7EFB40, 0xCF7FFB40,
+		0xCF80FB40, 0xCF81FB40, 0xCF82FB40, 0xCF83FB40, 0xCF84FB40, 0xCF85FB40, 0xCF86FB40, 0xCF87FB40, 0xCF88FB40, 0xCF89FB40, 0xCF8AFB40, 0xCF8BFB40, 0xCF8CFB40, 0xCF8DFB40, 0xCF8EFB40, 0xCF8FFB40, 0xCF90FB40, 0xCF91FB40, 0xCF92FB40, 0xCF93FB40, 0xCF94FB40, 0xCF95FB40, 0xCF96FB40, 0xCF97FB40, 0xCF98FB40, 0xCF99FB40, 0xCF9AFB40, 0xCF9BFB40, 0xCF9CFB40, 0xCF9DFB40, 0xCF9EFB40, 0xCF9FFB40,
+		0xCFA0FB40, 0xCFA1FB40, 0xCFA2FB40, 0xCFA3FB40, 0xCFA4FB40, 0xCFA5FB40, 0xCFA6FB40, 0xCFA7FB40, 0xCFA8FB40, 0xCFA9FB40, 0xCFAAFB40, 0xCFABFB40, 0xCFACFB40, 0xCFADFB40, 0xCFAEFB40, 0xCFAFFB40, 0xCFB0FB40, 0xCFB1FB40, 0xCFB2FB40, 0xCFB3FB40, 0xCFB4FB40, 0xCFB5FB40, 0xCFB6FB40, 0xCFB7FB40, 0xCFB8FB40, 0xCFB9FB40, 0xCFBAFB40, 0xCFBBFB40, 0xCFBCFB40, 0xCFBDFB40, 0xCFBEFB40, 0xCFBFFB40,
+		0xCFC0FB40, 0xCFC1FB40, 0xCFC2FB40, 0xCFC3FB40, 0xCFC4FB40, 0xCFC5FB40, 0xCFC6FB40, 0xCFC7FB40, 0xCFC8FB40, 0xCFC9FB40, 0xCFCAFB40, 0xCFCBFB40, 0xCFCCFB40, 0xCFCDFB40, 0xCFCEFB40, 0xCFCFFB40, 0xCFD0FB40, 0xCFD1FB40, 0xCFD2FB40, 0xCFD3FB40, 0xCFD4FB40, 0xCFD5FB40, 0xCFD6FB40, 0xCFD7FB40, 0xCFD8FB40, 0xCFD9FB40, 0xCFDAFB40, 0xCFDBFB40, 0xCFDCFB40, 0xCFDDFB40, 0xCFDEFB40, 0xCFDFFB40,
+		0xCFE0FB40, 0xCFE1FB40, 0xCFE2FB40, 0xCFE3FB40, 0xCFE4FB40, 0xCFE5FB40, 0xCFE6FB40, 0xCFE7FB40, 0xCFE8FB40, 0xCFE9FB40, 0xCFEAFB40, 0xCFEBFB40, 0xCFECFB40, 0xCFEDFB40, 0xCFEEFB40, 0xCFEFFB40, 0xCFF0FB40, 0xCFF1FB40, 0xCFF2FB40, 0xCFF3FB40, 0xCFF4FB40, 0xCFF5FB40, 0xCFF6FB40, 0xCFF7FB40, 0xCFF8FB40, 0xCFF9FB40, 0xCFFAFB40, 0xCFFBFB40, 0xCFFCFB40, 0xCFFDFB40, 0xCFFEFB40, 0xCFFFFB40,
+		0xD000FB40, 0xD001FB40, 0xD002FB40, 0xD003FB40, 0xD004FB40, 0xD005FB40, 0xD006FB40, 0xD007FB40, 0xD008FB40, 0xD009FB40, 0xD00AFB40, 0xD00BFB40, 0xD00CFB40, 0xD00DFB40, 0xD00EFB40, 0xD00FFB40, 0xD010FB40, 0xD011FB40, 0xD012FB40, 0xD013FB40, 0xD014FB40, 0xD015FB40, 0xD016FB40, 0xD017FB40, 0xD018FB40, 0xD019FB40, 0xD01AFB40, 0xD01BFB40, 0xD01CFB40, 0xD01DFB40, 0xD01EFB40, 0xD01FFB40,
+		0xD020FB40, 0xD021FB40, 0xD022FB40, 0xD023FB40, 0xD024FB40, 0xD025FB40, 0xD026FB40, 0xD027FB40, 0xD028FB40, 0xD029FB40, 0xD02AFB40, 0xD02BFB40, 0xD02CFB40, 0xD02DFB40, 0xD02EFB40, 0xD02FFB40, 0xD030FB40, 0xD031FB40, 0xD032FB40, 0xD033FB40, 0xD034FB40, 0xD035FB40, 0xD036FB40, 0xD037FB40, 0xD038FB40, 0xD039FB40, 0xD03AFB40, 0xD03BFB40, 0xD03CFB40, 0xD03DFB40, 0xD03EFB40, 0xD03FFB40,
+		0xD040FB40, 0xD041FB40, 0xD042FB40, 0xD043FB40, 0xD044FB40, 0xD045FB40, 0xD046FB40, 0xD047FB40, 0xD048FB40, 0xD049FB40, 0xD04AFB40, 0xD04BFB40, 0xD04CFB40, 0xD04DFB40, 0xD04EFB40, 0xD04FFB40, 0xD050FB40, 0xD051FB40, 0xD052FB40, 0xD053FB40, 0xD054FB40, 0xD055FB40, 0xD056FB40, 0xD057FB40, 0xD058FB40, 0xD059FB40, 0xD05AFB40, 0xD05BFB40, 0xD05CFB40, 0xD05DFB40, 0xD05EFB40, 0xD05FFB40,
+		0xD060FB40, 0xD061FB40, 0xD062FB40, 0xD063FB40, 0xD064FB40, 0xD065FB40, 0xD066FB40, 0xD067FB40, 0xD068FB40, 0xD069FB40, 0xD06AFB40, 0xD06BFB40, 0xD06CFB40, 0xD06DFB40, 0xD06EFB40, 0xD06FFB40, 0xD070FB40, 0xD071FB40, 0xD072FB40, 0xD073FB40, 0xD074FB40, 0xD075FB40, 0xD076FB40, 0xD077FB40, 0xD078FB40, 0xD079FB40, 0xD07AFB40, 0xD07BFB40, 0xD07CFB40, 0xD07DFB40, 0xD07EFB40, 0xD07FFB40,
+		0xD080FB40, 0xD081FB40, 0xD082FB40, 0xD083FB40, 0xD084FB40, 0xD085FB40, 0xD086FB40, 0xD087FB40, 0xD088FB40, 0xD089FB40, 0xD08AFB40, 0xD08BFB40, 0xD08CFB40, 0xD08DFB40, 0xD08EFB40, 0xD08FFB40, 0xD090FB40, 0xD091FB40, 0xD092FB40, 0xD093FB40, 0xD094FB40, 0xD095FB40, 0xD096FB40, 0xD097FB40, 0xD098FB40, 0xD099FB40, 0xD09AFB40, 0xD09BFB40, 0xD09CFB40, 0xD09DFB40, 0xD09EFB40, 0xD09FFB40,
+		0xD0A0FB40, 0xD0A1FB40, 0xD0A2FB40, 0xD0A3FB40, 0xD0A4FB40, 0xD0A5FB40, 0xD0A6FB40, 0xD0A7FB40, 0xD0A8FB40, 0xD0A9FB40, 0xD0AAFB40, 0xD0ABFB40, 0xD0ACFB40, 0xD0ADFB40, 0xD0AEFB40, 0xD0AFFB40, 0xD0B0FB40, 0xD0B1FB40, 0xD0B2FB40, 0xD0B3FB40, 0xD0B4FB40, 0xD0B5FB40, 0xD0B6FB40, 0xD0B7FB40, 0xD0B8FB40, 0xD0B9FB40, 0xD0BAFB40, 0xD0BBFB40, 0xD0BCFB40, 0xD0BDFB40, 0xD0BEFB40, 0xD0BFFB40,
+		0xD0C0FB40, 0xD0C1FB40, 0xD0C2FB40, 0xD0C3FB40, 0xD0C4FB40, 0xD0C5FB40, 0xD0C6FB40, 0xD0C7FB40, 0xD0C8FB40, 0xD0C9FB40, 0xD0CAFB40, 0xD0CBFB40, 0xD0CCFB40, 0xD0CDFB40, 0xD0CEFB40, 0xD0CFFB40, 0xD0D0FB40, 0xD0D1FB40, 0xD0D2FB40, 0xD0D3FB40, 0xD0D4FB40, 0xD0D5FB40, 0xD0D6FB40, 0xD0D7FB40, 0xD0D8FB40, 0xD0D9FB40, 0xD0DAFB40, 0xD0DBFB40, 0xD0DCFB40, 0xD0DDFB40, 0xD0DEFB40, 0xD0DFFB40,
+		0xD0E0FB40, 0xD0E1FB40, 0xD0E2FB40, 0xD0E3FB40, 0xD0E4FB40, 0xD0E5FB40, 0xD0E6FB40, 0xD0E7FB40, 0xD0E8FB40, 0xD0E9FB40, 0xD0EAFB40, 0xD0EBFB40, 0xD0ECFB40, 0xD0EDFB40, 0xD0EEFB40, 0xD0EFFB40, 0xD0F0FB40, 0xD0F1FB40, 0xD0F2FB40, 0xD0F3FB40, 0xD0F4FB40, 0xD0F5FB40, 0xD0F6FB40, 0xD0F7FB40, 0xD0F8FB40, 0xD0F9FB40, 0xD0FAFB40, 0xD0FBFB40, 0xD0FCFB40, 0xD0FDFB40, 0xD0FEFB40, 0xD0FFFB40,
+		0xD100FB40, 0xD101FB40, 0xD102FB40, 0xD103FB40, 0xD104FB40, 0xD105FB40, 0xD106FB40, 0xD107FB40, 0xD108FB40, 0xD109FB40, 0xD10AFB40, 0xD10BFB40, 0xD10CFB40, 0xD10DFB40, 0xD10EFB40, 0xD10FFB40, 0xD110FB40, 0xD111FB40, 0xD112FB40, 0xD113FB40, 0xD114FB40, 0xD115FB40, 0xD116FB40, 0xD117FB40, 0xD118FB40, 0xD119FB40, 0xD11AFB40, 0xD11BFB40, 0xD11CFB40, 0xD11DFB40, 0xD11EFB40, 0xD11FFB40,
+		0xD120FB40, 0xD121FB40, 0xD122FB40, 0xD123FB40, 0xD124FB40, 0xD125FB40, 0xD126FB40, 0xD127FB40, 0xD128FB40, 0xD129FB40, 0xD12AFB40, 0xD12BFB40, 0xD12CFB40, 0xD12DFB40, 0xD12EFB40, 0xD12FFB40, 0xD130FB40, 0xD131FB40, 0xD132FB40, 0xD133FB40, 0xD134FB40, 0xD135FB40, 0xD136FB40, 0xD137FB40, 0xD138FB40, 0xD139FB40, 0xD13AFB40, 0xD13BFB40, 0xD13CFB40, 0xD13DFB40, 0xD13EFB40, 0xD13FFB40,
+		0xD140FB40, 0xD141FB40, 0xD142FB40, 0xD143FB40, 0xD144FB40, 0xD145FB40, 0xD146FB40, 0xD147FB40, 0xD148FB40, 0xD149FB40, 0xD14AFB40, 0xD14BFB40, 0xD14CFB40, 0xD14DFB40, 0xD14EFB40, 0xD14FFB40, 0xD150FB40, 0xD151FB40, 0xD152FB40, 0xD153FB40, 0xD154FB40, 0xD155FB40, 0xD156FB40, 0xD157FB40, 0xD158FB40, 0xD159FB40, 0xD15AFB40, 0xD15BFB40, 0xD15CFB40, 0xD15DFB40, 0xD15EFB40, 0xD15FFB40,
+		0xD160FB40, 0xD161FB40, 0xD162FB40, 0xD163FB40, 0xD164FB40, 0xD165FB40, 0xD166FB40, 0xD167FB40, 0xD168FB40, 0xD169FB40, 0xD16AFB40, 0xD16BFB40, 0xD16CFB40, 0xD16DFB40, 0xD16EFB40, 0xD16FFB40, 0xD170FB40, 0xD171FB40, 0xD172FB40, 0xD173FB40, 0xD174FB40, 0xD175FB40, 0xD176FB40, 0xD177FB40, 0xD178FB40, 0xD179FB40, 0xD17AFB40, 0xD17BFB40, 0xD17CFB40, 0xD17DFB40, 0xD17EFB40, 0xD17FFB40,
+		0xD180FB40, 0xD181FB40, 0xD182FB40, 0xD183FB40, 0xD184FB40, 0xD185FB40, 0xD186FB40, 0xD187FB40, 0xD188FB40, 0xD189FB40, 0xD18AFB40, 0xD18BFB40, 0xD18CFB40, 0xD18DFB40, 0xD18EFB40, 0xD18FFB40, 0xD190FB40, 0xD191FB40, 0xD192FB40, 0xD193FB40, 0xD194FB40, 0xD195FB40, 0xD196FB40, 0xD197FB40, 0xD198FB40, 0xD199FB40, 0xD19AFB40, 0xD19BFB40, 0xD19CFB40, 0xD19DFB40, 0xD19EFB40, 0xD19FFB40,
+		0xD1A0FB40, 0xD1A1FB40, 0xD1A2FB40, 0xD1A3FB40, 0xD1A4FB40, 0xD1A5FB40, 0xD1A6FB40, 0xD1A7FB40, 0xD1A8FB40, 0xD1A9FB40, 0xD1AAFB40, 0xD1ABFB40, 0xD1ACFB40, 0xD1ADFB40, 0xD1AEFB40, 0xD1AFFB40, 0xD1B0FB40, 0xD1B1FB40, 0xD1B2FB40, 0xD1B3FB40, 0xD1B4FB40, 0xD1B5FB40, 0xD1B6FB40, 0xD1B7FB40, 0xD1B8FB40, 0xD1B9FB40, 0xD1BAFB40, 0xD1BBFB40, 0xD1BCFB40, 0xD1BDFB40, 0xD1BEFB40, 0xD1BFFB40,
+		0xD1C0FB40, 0xD1C1FB40, 0xD1C2FB40, 0xD1C3FB40, 0xD1C4FB40, 0xD1C5FB40, 0xD1C6FB40, 0xD1C7FB40, 0xD1C8FB40, 0xD1C9FB40, 0xD1CAFB40, 0xD1CBFB40, 0xD1CCFB40, 0xD1CDFB40, 0xD1CEFB40, 0xD1CFFB40, 0xD1D0FB40, 0xD1D1FB40, 0xD1D2FB40, 0xD1D3FB40, 0xD1D4FB40, 0xD1D5FB40, 0xD1D6FB40, 0xD1D7FB40, 0xD1D8FB40, 0xD1D9FB40, 0xD1DAFB40, 0xD1DBFB40, 0xD1DCFB40, 0xD1DDFB40, 0xD1DEFB40, 0xD1DFFB40,
+		0xD1E0FB40, 0xD1E1FB40, 0xD1E2FB40, 0xD1E3FB40, 0xD1E4FB40, 0xD1E5FB40, 0xD1E6FB40, 0xD1E7FB40, 0xD1E8FB40, 0xD1E9FB40, 0xD1EAFB40, 0xD1EBFB40, 0xD1ECFB40, 0xD1EDFB40, 0xD1EEFB40, 0xD1EFFB40, 0xD1F0FB40, 0xD1F1FB40, 0xD1F2FB40, 0xD1F3FB40, 0xD1F4FB40, 0xD1F5FB40, 0xD1F6FB40, 0xD1F7FB40, 0xD1F8FB40, 0xD1F9FB40, 0xD1FAFB40, 0xD1FBFB40, 0xD1FCFB40, 0xD1FDFB40, 0xD1FEFB40, 0xD1FFFB40,
+		0xD200FB40, 0xD201FB40, 0xD202FB40, 0xD203FB40, 0xD204FB40, 0xD205FB40, 0xD206FB40, 0xD207FB40, 0xD208FB40, 0xD209FB40, 0xD20AFB40, 0xD20BFB40, 0xD20CFB40, 0xD20DFB40, 0xD20EFB40, 0xD20FFB40, 0xD210FB40, 0xD211FB40, 0xD212FB40, 0xD213FB40, 0xD214FB40, 0xD215FB40, 0xD216FB40, 0xD217FB40, 0xD218FB40, 0xD219FB40, 0xD21AFB40, 0xD21BFB40, 0xD21CFB40, 0xD21DFB40, 0xD21EFB40, 0xD21FFB40,
+		0xD220FB40, 0xD221FB40, 0xD222FB40, 0xD223FB40, 0xD224FB40, 0xD225FB40, 0xD226FB40, 0xD227FB40, 0xD228FB40, 0xD229FB40, 0xD22AFB40, 0xD22BFB40, 0xD22CFB40, 0xD22DFB40, 0xD22EFB40, 0xD22FFB40, 0xD230FB40, 0xD231FB40, 0xD232FB40, 0xD233FB40, 0xD234FB40, 0xD235FB40, 0xD236FB40, 0xD237FB40, 0xD238FB40, 0xD239FB40, 0xD23AFB40, 0xD23BFB40, 0xD23CFB40, 0xD23DFB40, 0xD23EFB40, 0xD23FFB40,
+		0xD240FB40, 0xD241FB40, 0xD242FB40, 0xD243FB40, 0xD244FB40, 0xD245FB40, 0xD246FB40, 0xD247FB40, 0xD248FB40, 0xD249FB40, 0xD24AFB40, 0xD24BFB40, 0xD24CFB40, 0xD24DFB40, 0xD24EFB40, 0xD24FFB40, 0xD250FB40, 0xD251FB40, 0xD252FB40, 0xD253FB40, 0xD254FB40, 0xD255FB40, 0xD256FB40, 0xD257FB40, 0xD258FB40, 0xD259FB40, 0xD25AFB40, 0xD25BFB40, 0xD25CFB40, 0xD25DFB40, 0xD25EFB40, 0xD25FFB40,
+		0xD260FB40, 0xD261FB40, 0xD262FB40, 0xD263FB40, 0xD264FB40, 0xD265FB40, 0xD266FB40, 0xD267FB40, 0xD268FB40, 0xD269FB40, 0xD26AFB40, 0xD26BFB40, 0xD26CFB40, 0xD26DFB40, 0xD26EFB40, 0xD26FFB40, 0xD270FB40, 0xD271FB40, 0xD272FB40, 0xD273FB40, 0xD274FB40, 0xD275FB40, 0xD276FB40, 0xD277FB40, 0xD278FB40, 0xD279FB40, 0xD27AFB40, 0xD27BFB40, 0xD27CFB40, 0xD27DFB40, 0xD27EFB40, 0xD27FFB40,
+		0xD280FB40, 0xD281FB40, 0xD282FB40, 0xD283FB40, 0xD284FB40, 0xD285FB40, 0xD286FB40, 0xD287FB40, 0xD288FB40, 0xD289FB40, 0xD28AFB40, 0xD28BFB40, 0xD28CFB40, 0xD28DFB40, 0xD28EFB40, 0xD28FFB40, 0xD290FB40, 0xD291FB40, 0xD292FB40, 0xD293FB40, 0xD294FB40, 0xD295FB40, 0xD296FB40, 0xD297FB40, 0xD298FB40, 0xD299FB40, 0xD29AFB40, 0xD29BFB40, 0xD29CFB40, 0xD29DFB40, 0xD29EFB40, 0xD29FFB40,
+		0xD2A0FB40, 0xD2A1FB40, 0xD2A2FB40, 0xD2A3FB40, 0xD2A4FB40, 0xD2A5FB40, 0xD2A6FB40, 0xD2A7FB40, 0xD2A8FB40, 0xD2A9FB40, 0xD2AAFB40, 0xD2ABFB40, 0xD2ACFB40, 0xD2ADFB40, 0xD2AEFB40, 0xD2AFFB40, 0xD2B0FB40, 0xD2B1FB40, 0xD2B2FB40, 0xD2B3FB40, 0xD2B4FB40, 0xD2B5FB40, 0xD2B6FB40, 0xD2B7FB40, 0xD2B8FB40, 0xD2B9FB40, 0xD2BAFB40, 0xD2BBFB40, 0xD2BCFB40, 0xD2BDFB40, 0xD2BEFB40, 0xD2BFFB40,
+		0xD2C0FB40, 0xD2C1FB40, 0xD2C2FB40, 0xD2C3FB40, 0xD2C4FB40, 0xD2C5FB40, 0xD2C6FB40, 0xD2C7FB40, 0xD2C8FB40, 0xD2C9FB40, 0xD2CAFB40, 0xD2CBFB40, 0xD2CCFB40, 0xD2CDFB40, 0xD2CEFB40, 0xD2CFFB40, 0xD2D0FB40, 0xD2D1FB40, 0xD2D2FB40, 0xD2D3FB40, 0xD2D4FB40, 0xD2D5FB40, 0xD2D6FB40, 0xD2D7FB40, 0xD2D8FB40, 0xD2D9FB40, 0xD2DAFB40, 0xD2DBFB40, 0xD2DCFB40, 0xD2DDFB40, 0xD2DEFB40, 0xD2DFFB40,
+		0xD2E0FB40, 0xD2E1FB40, 0xD2E2FB40, 0xD2E3FB40, 0xD2E4FB40, 0xD2E5FB40, 0xD2E6FB40, 0xD2E7FB40, 0xD2E8FB40, 0xD2E9FB40, 0xD2EAFB40, 0xD2EBFB40, 0xD2ECFB40, 0xD2EDFB40, 0xD2EEFB40, 0xD2EFFB40, 0xD2F0FB40, 0xD2F1FB40, 0xD2F2FB40, 0xD2F3FB40, 0xD2F4FB40, 0xD2F5FB40, 0xD2F6FB40, 0xD2F7FB40, 0xD2F8FB40, 0xD2F9FB40, 0xD2FAFB40, 0xD2FBFB40, 0xD2FCFB40, 0xD2FDFB40, 0xD2FEFB40, 0xD2FFFB40,
+		0xD300FB40, 0xD301FB40, 0xD302FB40, 0xD303FB40, 0xD304FB40, 0xD305FB40, 0xD306FB40, 0xD307FB40, 0xD308FB40, 0xD309FB40, 0xD30AFB40, 0xD30BFB40, 0xD30CFB40, 0xD30DFB40, 0xD30EFB40, 0xD30FFB40, 0xD310FB40, 0xD311FB40, 0xD312FB40, 0xD313FB40, 0xD314FB40, 0xD315FB40, 0xD316FB40, 0xD317FB40, 0xD318FB40, 0xD319FB40, 0xD31AFB40, 0xD31BFB40, 0xD31CFB40, 0xD31DFB40, 0xD31EFB40, 0xD31FFB40,
+		0xD320FB40, 0xD321FB40, 0xD322FB40, 0xD323FB40, 0xD324FB40, 0xD325FB40, 0xD326FB40, 0xD327FB40, 0xD328FB40, 0xD329FB40, 0xD32AFB40, 0xD32BFB40, 0xD32CFB40, 0xD32DFB40, 0xD32EFB40, 0xD32FFB40, 0xD330FB40, 0xD331FB40, 0xD332FB40, 0xD333FB40, 0xD334FB40, 0xD335FB40, 0xD336FB40, 0xD337FB40, 0xD338FB40, 0xD339FB40, 0xD33AFB40, 0xD33BFB40, 0xD33CFB40, 0xD33DFB40, 0xD33EFB40, 0xD33FFB40,
+		0xD340FB40, 0xD341FB40, 0xD342FB40, 0xD343FB40, 0xD344FB40, 0xD345FB40, 0xD346FB40, 0xD347FB40, 0xD348FB40, 0xD349FB40, 0xD34AFB40, 0xD34BFB40, 0xD34CFB40, 0xD34DFB40, 0xD34EFB40, 0xD34FFB40, 0xD350FB40, 0xD351FB40, 0xD352FB40, 0xD353FB40, 0xD354FB40, 0xD355FB40, 0xD356FB40, 0xD357FB40, 0xD358FB40, 0xD359FB40, 0xD35AFB40, 0xD35BFB40, 0xD35CFB40, 0xD35DFB40, 0xD35EFB40, 0xD35FFB40,
+		0xD360FB40, 0xD361FB40, 0xD362FB40, 0xD363FB40, 0xD364FB40, 0xD365FB40, 0xD366FB40, 0xD367FB40, 0xD368FB40, 0xD369FB40, 0xD36AFB40, 0xD36BFB40, 0xD36CFB40, 0xD36DFB40, 0xD36EFB40, 0xD36FFB40, 0xD370FB40, 0xD371FB40, 0xD372FB40, 0xD373FB40, 0xD374FB40, 0xD375FB40, 0xD376FB40, 0xD377FB40, 0xD378FB40, 0xD379FB40, 0xD37AFB40, 0xD37BFB40, 0xD37CFB40, 0xD37DFB40, 0xD37EFB40, 0xD37FFB40,
+		0xD380FB40, 0xD381FB40, 0xD382FB40, 0xD383FB40, 0xD384FB40, 0xD385FB40, 0xD386FB40, 0xD387FB40, 0xD388FB40, 0xD389FB40, 0xD38AFB40, 0xD38BFB40, 0xD38CFB40, 0xD38DFB40, 0xD38EFB40, 0xD38FFB40, 0xD390FB40, 0xD391FB40, 0xD392FB40, 0xD393FB40, 0xD394FB40, 0xD395FB40, 0xD396FB40, 0xD397FB40, 0xD398FB40, 0xD399FB40, 0xD39AFB40, 0xD39BFB40, 0xD39CFB40, 0xD39DFB40, 0xD39EFB40, 0xD39FFB40,
+		0xD3A0FB40, 0xD3A1FB40, 0xD3A2FB40, 0xD3A3FB40, 0xD3A4FB40, 0xD3A5FB40, 0xD3A6FB40, 0xD3A7FB40, 0xD3A8FB40, 0xD3A9FB40, 0xD3AAFB40, 0xD3ABFB40, 0xD3ACFB40, 0xD3ADFB40, 0xD3AEFB40, 0xD3AFFB40, 0xD3B0FB40, 0xD3B1FB40, 0xD3B2FB40, 0xD3B3FB40, 0xD3B4FB40, 0xD3B5FB40, 0xD3B6FB40, 0xD3B7FB40, 0xD3B8FB40, 0xD3B9FB40, 0xD3BAFB40, 0xD3BBFB40, 0xD3BCFB40, 0xD3BDFB40, 0xD3BEFB40, 0xD3BFFB40,
+		0xD3C0FB40, 0xD3C1FB40, 0xD3C2FB40, 0xD3C3FB40, 0xD3C4FB40, 0xD3C5FB40, 0xD3C6FB40, 0xD3C7FB40, 0xD3C8FB40, 0xD3C9FB40, 0xD3CAFB40, 0xD3CBFB40, 0xD3CCFB40, 0xD3CDFB40, 0xD3CEFB40, 0xD3CFFB40, 0xD3D0FB40, 0xD3D1FB40, 0xD3D2FB40, 0xD3D3FB40, 0xD3D4FB40, 0xD3D5FB40, 0xD3D6FB40, 0xD3D7FB40, 0xD3D8FB40, 0xD3D9FB40, 0xD3DAFB40, 0xD3DBFB40, 0xD3DCFB40, 0xD3DDFB40, 0xD3DEFB40, 0xD3DFFB40,
+		0xD3E0FB40, 0xD3E1FB40, 0xD3E2FB40, 0xD3E3FB40, 0xD3E4FB40, 0xD3E5FB40, 0xD3E6FB40, 0xD3E7FB40, 0xD3E8FB40, 0xD3E9FB40, 0xD3EAFB40, 0xD3EBFB40, 0xD3ECFB40, 0xD3EDFB40, 0xD3EEFB40, 0xD3EFFB40, 0xD3F0FB40, 0xD3F1FB40, 0xD3F2FB40, 0xD3F3FB40, 0xD3F4FB40, 0xD3F5FB40, 0xD3F6FB40, 0xD3F7FB40, 0xD3F8FB40, 0xD3F9FB40, 0xD3FAFB40, 0xD3FBFB40, 0xD3FCFB40, 0xD3FDFB40, 0xD3FEFB40, 0xD3FFFB40,
+		0xD400FB40, 0xD401FB40, 0xD402FB40, 0xD403FB40, 0xD404FB40, 0xD405FB40, 0xD406FB40, 0xD407FB40, 0xD408FB40, 0xD409FB40, 0xD40AFB40, 0xD40BFB40, 0xD40CFB40, 0xD40DFB40, 0xD40EFB40, 0xD40FFB40, 0xD410FB40, 0xD411FB40, 0xD412FB40, 0xD413FB40, 0xD414FB40, 0xD415FB40, 0xD416FB40, 0xD417FB40, 0xD418FB40, 0xD419FB40, 0xD41AFB40, 0xD41BFB40, 0xD41CFB40, 0xD41DFB40, 0xD41EFB40, 0xD41FFB40,
+		0xD420FB40, 0xD421FB40, 0xD422FB40, 0xD423FB40, 0xD424FB40, 0xD425FB40, 0xD426FB40, 0xD427FB40, 0xD428FB40, 0xD429FB40, 0xD42AFB40, 0xD42BFB40, 0xD42CFB40, 0xD42DFB40, 0xD42EFB40, 0xD42FFB40, 0xD430FB40, 0xD431FB40, 0xD432FB40, 0xD433FB40, 0xD434FB40, 0xD435FB40, 0xD436FB40, 0xD437FB40, 0xD438FB40, 0xD439FB40, 0xD43AFB40, 0xD43BFB40, 0xD43CFB40, 0xD43DFB40, 0xD43EFB40, 0xD43FFB40,
+		0xD440FB40, 0xD441FB40, 0xD442FB40, 0xD443FB40, 0xD444FB40, 0xD445FB40, 0xD446FB40, 0xD447FB40, 0xD448FB40, 0xD449FB40, 0xD44AFB40, 0xD44BFB40, 0xD44CFB40, 0xD44DFB40, 0xD44EFB40, 0xD44FFB40, 0xD450FB40, 0xD451FB40, 0xD452FB40, 0xD453FB40, 0xD454FB40, 0xD455FB40, 0xD456FB40, 0xD457FB40, 0xD458FB40, 0xD459FB40, 0xD45AFB40, 0xD45BFB40, 0xD45CFB40, 0xD45DFB40, 0xD45EFB40, 0xD45FFB40,
+		0xD460FB40, 0xD461FB40, 0xD462FB40, 0xD463FB40, 0xD464FB40, 0xD465FB40, 0xD466FB40, 0xD467FB40, 0xD468FB40, 0xD469FB40, 0xD46AFB40, 0xD46BFB40, 0xD46CFB40, 0xD46DFB40, 0xD46EFB40, 0xD46FFB40, 0xD470FB40, 0xD471FB40, 0xD472FB40, 0xD473FB40, 0xD474FB40, 0xD475FB40, 0xD476FB40, 0xD477FB40, 0xD478FB40, 0xD479FB40, 0xD47AFB40, 0xD47BFB40, 0xD47CFB40, 0xD47DFB40, 0xD47EFB40, 0xD47FFB40,
+		0xD480FB40, 0xD481FB40, 0xD482FB40, 0xD483FB40, 0xD484FB40, 0xD485FB40, 0xD486FB40, 0xD487FB40, 0xD488FB40, 0xD489FB40, 0xD48AFB40, 0xD48BFB40, 0xD48CFB40, 0xD48DFB40, 0xD48EFB40, 0xD48FFB40, 0xD490FB40, 0xD491FB40, 0xD492FB40, 0xD493FB40, 0xD494FB40, 0xD495FB40, 0xD496FB40, 0xD497FB40, 0xD498FB40, 0xD499FB40, 0xD49AFB40, 0xD49BFB40, 0xD49CFB40, 0xD49DFB40, 0xD49EFB40, 0xD49FFB40,
+		0xD4A0FB40, 0xD4A1FB40, 0xD4A2FB40, 0xD4A3FB40, 0xD4A4FB40, 0xD4A5FB40, 0xD4A6FB40, 0xD4A7FB40, 0xD4A8FB40, 0xD4A9FB40, 0xD4AAFB40, 0xD4ABFB40, 0xD4ACFB40, 0xD4ADFB40, 0xD4AEFB40, 0xD4AFFB40, 0xD4B0FB40, 0xD4B1FB40, 0xD4B2FB40, 0xD4B3FB40, 0xD4B4FB40, 0xD4B5FB40, 0xD4B6FB40, 0xD4B7FB40, 0xD4B8FB40, 0xD4B9FB40, 0xD4BAFB40, 0xD4BBFB40, 0xD4BCFB40, 0xD4BDFB40, 0xD4BEFB40, 0xD4BFFB40,
+		0xD4C0FB40, 0xD4C1FB40, 0xD4C2FB40, 0xD4C3FB40, 0xD4C4FB40, 0xD4C5FB40, 0xD4C6FB40, 0xD4C7FB40, 0xD4C8FB40, 0xD4C9FB40, 0xD4CAFB40, 0xD4CBFB40, 0xD4CCFB40, 0xD4CDFB40, 0xD4CEFB40, 0xD4CFFB40, 0xD4D0FB40, 0xD4D1FB40, 0xD4D2FB40, 0xD4D3FB40, 0xD4D4FB40, 0xD4D5FB40, 0xD4D6FB40, 0xD4D7FB40, 0xD4D8FB40, 0xD4D9FB40, 0xD4DAFB40, 0xD4DBFB40, 0xD4DCFB40, 0xD4DDFB40, 0xD4DEFB40, 0xD4DFFB40,
+		0xD4E0FB40, 0xD4E1FB40, 0xD4E2FB40, 0xD4E3FB40, 0xD4E4FB40, 0xD4E5FB40, 0xD4E6FB40, 0xD4E7FB40, 0xD4E8FB40, 0xD4E9FB40, 0xD4EAFB40, 0xD4EBFB40, 0xD4ECFB40, 0xD4EDFB40, 0xD4EEFB40, 0xD4EFFB40, 0xD4F0FB40, 0xD4F1FB40, 0xD4F2FB40, 0xD4F3FB40, 0xD4F4FB40, 0xD4F5FB40, 0xD4F6FB40, 0xD4F7FB40, 0xD4F8FB40, 0xD4F9FB40, 0xD4FAFB40, 0xD4FBFB40, 0xD4FCFB40, 0xD4FDFB40, 0xD4FEFB40, 0xD4FFFB40,
+		0xD500FB40, 0xD501FB40, 0xD502FB40, 0xD503FB40, 0xD504FB40, 0xD505FB40, 0xD506FB40, 0xD507FB40, 0xD508FB40, 0xD509FB40, 0xD50AFB40, 0xD50BFB40, 0xD50CFB40, 0xD50DFB40, 0xD50EFB40, 0xD50FFB40, 0xD510FB40, 0xD511FB40, 0xD512FB40, 0xD513FB40, 0xD514FB40, 0xD515FB40, 0xD516FB40, 0xD517FB40, 0xD518FB40, 0xD519FB40, 0xD51AFB40, 0xD51BFB40, 0xD51CFB40, 0xD51DFB40, 0xD51EFB40, 0xD51FFB40,
+		0xD520FB40, 0xD521FB40, 0xD522FB40, 0xD523FB40, 0xD524FB40, 0xD525FB40, 0xD526FB40, 0xD527FB40, 0xD528FB40, 0xD529FB40, 0xD52AFB40, 0xD52BFB40, 0xD52CFB40, 0xD52DFB40, 0xD52EFB40, 0xD52FFB40, 0xD530FB40, 0xD531FB40, 0xD532FB40, 0xD533FB40, 0xD534FB40, 0xD535FB40, 0xD536FB40, 0xD537FB40, 0xD538FB40, 0xD539FB40, 0xD53AFB40, 0xD53BFB40, 0xD53CFB40, 0xD53DFB40, 0xD53EFB40, 0xD53FFB40,
+		0xD540FB40, 0xD541FB40, 0xD542FB40, 0xD543FB40, 0xD544FB40, 0xD545FB40, 0xD546FB40, 0xD547FB40, 0xD548FB40, 0xD549FB40, 0xD54AFB40, 0xD54BFB40, 0xD54CFB40, 0xD54DFB40, 0xD54EFB40, 0xD54FFB40, 0xD550FB40, 0xD551FB40, 0xD552FB40, 0xD553FB40, 0xD554FB40, 0xD555FB40, 0xD556FB40, 0xD557FB40, 0xD558FB40, 0xD559FB40, 0xD55AFB40, 0xD55BFB40, 0xD55CFB40, 0xD55DFB40, 0xD55EFB40, 0xD55FFB40,
+		0xD560FB40, 0xD561FB40, 0xD562FB40, 0xD563FB40, 0xD564FB40, 0xD565FB40, 0xD566FB40, 0xD567FB40, 0xD568FB40, 0xD569FB40, 0xD56AFB40, 0xD56BFB40, 0xD56CFB40, 0xD56DFB40, 0xD56EFB40, 0xD56FFB40, 0xD570FB40, 0xD571FB40, 0xD572FB40, 0xD573FB40, 0xD574FB40, 0xD575FB40, 0xD576FB40, 0xD577FB40, 0xD578FB40, 0xD579FB40, 0xD57AFB40, 0xD57BFB40, 0xD57CFB40, 0xD57DFB40, 0xD57EFB40, 0xD57FFB40,
+		0xD580FB40, 0xD581FB40, 0xD582FB40, 0xD583FB40, 0xD584FB40, 0xD585FB40, 0xD586FB40, 0xD587FB40, 0xD588FB40, 0xD589FB40, 0xD58AFB40, 0xD58BFB40, 0xD58CFB40, 0xD58DFB40, 0xD58EFB40, 0xD58FFB40, 0xD590FB40, 0xD591FB40, 0xD592FB40, 0xD593FB40, 0xD594FB40, 0xD595FB40, 0xD596FB40, 0xD597FB40, 0xD598FB40, 0xD599FB40, 0xD59AFB40, 0xD59BFB40, 0xD59CFB40, 0xD59DFB40, 0xD59EFB40, 0xD59FFB40,
+		0xD5A0FB40, 0xD5A1FB40, 0xD5A2FB40, 0xD5A3FB40, 0xD5A4FB40, 0xD5A5FB40, 0xD5A6FB40, 0xD5A7FB40, 0xD5A8FB40, 0xD5A9FB40, 0xD5AAFB40, 0xD5ABFB40, 0xD5ACFB40, 0xD5ADFB40, 0xD5AEFB40, 0xD5AFFB40, 0xD5B0FB40, 0xD5B1FB40, 0xD5B2FB40, 0xD5B3FB40, 0xD5B4FB40, 0xD5B5FB40, 0xD5B6FB40, 0xD5B7FB40, 0xD5B8FB40, 0xD5B9FB40, 0xD5BAFB40, 0xD5BBFB40, 0xD5BCFB40, 0xD5BDFB40, 0xD5BEFB40, 0xD5BFFB40,
+		0xD5C0FB40, 0xD5C1FB40, 0xD5C2FB40, 0xD5C3FB40, 0xD5C4FB40, 0xD5C5FB40, 0xD5C6FB40, 0xD5C7FB40, 0xD5C8FB40, 0xD5C9FB40, 0xD5CAFB40, 0xD5CBFB40, 0xD5CCFB40, 0xD5CDFB40, 0xD5CEFB40, 0xD5CFFB40, 0xD5D0FB40, 0xD5D1FB40, 0xD5D2FB40, 0xD5D3FB40, 0xD5D4FB40, 0xD5D5FB40, 0xD5D6FB40, 0xD5D7FB40, 0xD5D8FB40, 0xD5D9FB40, 0xD5DAFB40, 0xD5DBFB40, 0xD5DCFB40, 0xD5DDFB40, 0xD5DEFB40, 0xD5DFFB40,
+		0xD5E0FB40, 0xD5E1FB40, 0xD5E2FB40, 0xD5E3FB40, 0xD5E4FB40, 0xD5E5FB40, 0xD5E6FB40, 0xD5E7FB40, 0xD5E8FB40, 0xD5E9FB40, 0xD5EAFB40, 0xD5EBFB40, 0xD5ECFB40, 0xD5EDFB40, 0xD5EEFB40, 0xD5EFFB40, 0xD5F0FB40, 0xD5F1FB40, 0xD5F2FB40, 0xD5F3FB40, 0xD5F4FB40, 0xD5F5FB40, 0xD5F6FB40, 0xD5F7FB40, 0xD5F8FB40, 0xD5F9FB40, 0xD5FAFB40, 0xD5FBFB40, 0xD5FCFB40, 0xD5FDFB40, 0xD5FEFB40, 0xD5FFFB40,
+		0xD600FB40, 0xD601FB40, 0xD602FB40, 0xD603FB40, 0xD604FB40, 0xD605FB40, 0xD606FB40, 0xD607FB40, 0xD608FB40, 0xD609FB40, 0xD60AFB40, 0xD60BFB40, 0xD60CFB40, 0xD60DFB40, 0xD60EFB40, 0xD60FFB40, 0xD610FB40, 0xD611FB40, 0xD612FB40, 0xD613FB40, 0xD614FB40, 0xD615FB40, 0xD616FB40, 0xD617FB40, 0xD618FB40, 0xD619FB40, 0xD61AFB40, 0xD61BFB40, 0xD61CFB40, 0xD61DFB40, 0xD61EFB40, 0xD61FFB40,
+		0xD620FB40, 0xD621FB40, 0xD622FB40, 0xD623FB40, 0xD624FB40, 0xD625FB40, 0xD626FB40, 0xD627FB40, 0xD628FB40, 0xD629FB40, 0xD62AFB40, 0xD62BFB40, 0xD62CFB40, 0xD62DFB40, 0xD62EFB40, 0xD62FFB40, 0xD630FB40, 0xD631FB40, 0xD632FB40, 0xD633FB40, 0xD634FB40, 0xD635FB40, 0xD636FB40, 0xD637FB40, 0xD638FB40, 0xD639FB40, 0xD63AFB40, 0xD63BFB40, 0xD63CFB40, 0xD63DFB40, 0xD63EFB40, 0xD63FFB40,
+		0xD640FB40, 0xD641FB40, 0xD642FB40, 0xD643FB40, 0xD644FB40, 0xD645FB40, 0xD646FB40, 0xD647FB40, 0xD648FB40, 0xD649FB40, 0xD64AFB40, 0xD64BFB40, 0xD64CFB40, 0xD64DFB40, 0xD64EFB40, 0xD64FFB40, 0xD650FB40, 0xD651FB40, 0xD652FB40, 0xD653FB40, 0xD654FB40, 0xD655FB40, 0xD656FB40, 0xD657FB40, 0xD658FB40, 0xD659FB40, 0xD65AFB40, 0xD65BFB40, 0xD65CFB40, 0xD65DFB40, 0xD65EFB40, 0xD65FFB40,
+		0xD660FB40, 0xD661FB40, 0xD662FB40, 0xD663FB40, 0xD664FB40, 0xD665FB40, 0xD666FB40, 0xD667FB40, 0xD668FB40, 0xD669FB40, 0xD66AFB40, 0xD66BFB40, 0xD66CFB40, 0xD66DFB40, 0xD66EFB40, 0xD66FFB40, 0xD670FB40, 0xD671FB40, 0xD672FB40, 0xD673FB40, 0xD674FB40, 0xD675FB40, 0xD676FB40, 0xD677FB40, 0xD678FB40, 0xD679FB40, 0xD67AFB40, 0xD67BFB40, 0xD67CFB40, 0xD67DFB40, 0xD67EFB40, 0xD67FFB40,
+		0xD680FB40, 0xD681FB40, 0xD682FB40, 0xD683FB40, 0xD684FB40, 0xD685FB40, 0xD686FB40, 0xD687FB40, 0xD688FB40, 0xD689FB40, 0xD68AFB40, 0xD68BFB40, 0xD68CFB40, 0xD68DFB40, 0xD68EFB40, 0xD68FFB40, 0xD690FB40, 0xD691FB40, 0xD692FB40, 0xD693FB40, 0xD694FB40, 0xD695FB40, 0xD696FB40, 0xD697FB40, 0xD698FB40, 0xD699FB40, 0xD69AFB40, 0xD69BFB40, 0xD69CFB40, 0xD69DFB40, 0xD69EFB40, 0xD69FFB40,
+		0xD6A0FB40, 0xD6A1FB40, 0xD6A2FB40, 0xD6A3FB40, 0xD6A4FB40, 0xD6A5FB40, 0xD6A6FB40, 0xD6A7FB40, 0xD6A8FB40, 0xD6A9FB40, 0xD6AAFB40, 0xD6ABFB40, 0xD6ACFB40, 0xD6ADFB40, 0xD6AEFB40, 0xD6AFFB40, 0xD6B0FB40, 0xD6B1FB40, 0xD6B2FB40, 0xD6B3FB40, 0xD6B4FB40, 0xD6B5FB40, 0xD6B6FB40, 0xD6B7FB40, 0xD6B8FB40, 0xD6B9FB40, 0xD6BAFB40, 0xD6BBFB40, 0xD6BCFB40, 0xD6BDFB40, 0xD6BEFB40, 0xD6BFFB40,
+		0xD6C0FB40, 0xD6C1FB40, 0xD6C2FB40, 0xD6C3FB40, 0xD6C4FB40, 0xD6C5FB40, 0xD6C6FB40, 0xD6C7FB40, 0xD6C8FB40, 0xD6C9FB40, 0xD6CAFB40, 0xD6CBFB40, 0xD6CCFB40, 0xD6CDFB40, 0xD6CEFB40, 0xD6CFFB40, 0xD6D0FB40, 0xD6D1FB40, 0xD6D2FB40, 0xD6D3FB40, 0xD6D4FB40, 0xD6D5FB40, 0xD6D6FB40, 0xD6D7FB40, 0xD6D8FB40, 0xD6D9FB40, 0xD6DAFB40, 0xD6DBFB40, 0xD6DCFB40, 0xD6DDFB40, 0xD6DEFB40, 0xD6DFFB40,
+		0xD6E0FB40, 0xD6E1FB40, 0xD6E2FB40, 0xD6E3FB40, 0xD6E4FB40, 0xD6E5FB40, 0xD6E6FB40, 0xD6E7FB40, 0xD6E8FB40, 0xD6E9FB40, 0xD6EAFB40, 0xD6EBFB40, 0xD6ECFB40, 0xD6EDFB40, 0xD6EEFB40, 0xD6EFFB40, 0xD6F0FB40, 0xD6F1FB40, 0xD6F2FB40, 0xD6F3FB40, 0xD6F4FB40, 0xD6F5FB40, 0xD6F6FB40, 0xD6F7FB40, 0xD6F8FB40, 0xD6F9FB40, 0xD6FAFB40, 0xD6FBFB40, 0xD6FCFB40, 0xD6FDFB40, 0xD6FEFB40, 0xD6FFFB40,
+		0xD700FB40, 0xD701FB40, 0xD702FB40, 0xD703FB40, 0xD704FB40, 0xD705FB40, 0xD706FB40, 0xD707FB40, 0xD708FB40, 0xD709FB40, 0xD70AFB40, 0xD70BFB40, 0xD70CFB40, 0xD70DFB40, 0xD70EFB40, 0xD70FFB40, 0xD710FB40, 0xD711FB40, 0xD712FB40, 0xD713FB40, 0xD714FB40, 0xD715FB40, 0xD716FB40, 0xD717FB40, 0xD718FB40, 0xD719FB40, 0xD71AFB40, 0xD71BFB40, 0xD71CFB40, 0xD71DFB40, 0xD71EFB40, 0xD71FFB40,
+		0xD720FB40, 0xD721FB40, 0xD722FB40, 0xD723FB40, 0xD724FB40, 0xD725FB40, 0xD726FB40, 0xD727FB40, 0xD728FB40, 0xD729FB40, 0xD72AFB40, 0xD72BFB40, 0xD72CFB40, 0xD72DFB40, 0xD72EFB40, 0xD72FFB40, 0xD730FB40, 0xD731FB40, 0xD732FB40, 0xD733FB40, 0xD734FB40, 0xD735FB40, 0xD736FB40, 0xD737FB40, 0xD738FB40, 0xD739FB40, 0xD73AFB40, 0xD73BFB40, 0xD73CFB40, 0xD73DFB40, 0xD73EFB40, 0xD73FFB40,
+		0xD740FB40, 0xD741FB40, 0xD742FB40, 0xD743FB40, 0xD744FB40, 0xD745FB40, 0xD746FB40, 0xD747FB40, 0xD748FB40, 0xD749FB40, 0xD74AFB40, 0xD74BFB40, 0xD74CFB40, 0xD74DFB40, 0xD74EFB40, 0xD74FFB40, 0xD750FB40, 0xD751FB40, 0xD752FB40, 0xD753FB40, 0xD754FB40, 0xD755FB40, 0xD756FB40, 0xD757FB40, 0xD758FB40, 0xD759FB40, 0xD75AFB40, 0xD75BFB40, 0xD75CFB40, 0xD75DFB40, 0xD75EFB40, 0xD75FFB40,
+		0xD760FB40, 0xD761FB40, 0xD762FB40, 0xD763FB40, 0xD764FB40, 0xD765FB40, 0xD766FB40, 0xD767FB40, 0xD768FB40, 0xD769FB40, 0xD76AFB40, 0xD76BFB40, 0xD76CFB40, 0xD76DFB40, 0xD76EFB40, 0xD76FFB40, 0xD770FB40, 0xD771FB40, 0xD772FB40, 0xD773FB40, 0xD774FB40, 0xD775FB40, 0xD776FB40, 0xD777FB40, 0xD778FB40, 0xD779FB40, 0xD77AFB40, 0xD77BFB40, 0xD77CFB40, 0xD77DFB40, 0xD77EFB40, 0xD77FFB40,
+		0xD780FB40, 0xD781FB40, 0xD782FB40, 0xD783FB40, 0xD784FB40, 0xD785FB40, 0xD786FB40, 0xD787FB40, 0xD788FB40, 0xD789FB40, 0xD78AFB40, 0xD78BFB40, 0xD78CFB40, 0xD78DFB40, 0xD78EFB40, 0xD78FFB40, 0xD790FB40, 0xD791FB40, 0xD792FB40, 0xD793FB40, 0xD794FB40, 0xD795FB40, 0xD796FB40, 0xD797FB40, 0xD798FB40, 0xD799FB40, 0xD79AFB40, 0xD79BFB40, 0xD79CFB40, 0xD79DFB40, 0xD79EFB40, 0xD79FFB40,
+		0xD7A0FB40, 0xD7A1FB40, 0xD7A2FB40, 0xD7A3FB40, 0xD7A4FB40, 0xD7A5FB40, 0xD7A6FB40, 0xD7A7FB40, 0xD7A8FB40, 0xD7A9FB40, 0xD7AAFB40, 0xD7ABFB40, 0xD7ACFB40, 0xD7ADFB40, 0xD7AEFB40, 0xD7AFFB40, 0xD7B0FB40, 0xD7B1FB40, 0xD7B2FB40, 0xD7B3FB40, 0xD7B4FB40, 0xD7B5FB40, 0xD7B6FB40, 0xD7B7FB40, 0xD7B8FB40, 0xD7B9FB40, 0xD7BAFB40, 0xD7BBFB40, 0xD7BCFB40, 0xD7BDFB40, 0xD7BEFB40, 0xD7BFFB40,
+		0xD7C0FB40, 0xD7C1FB40, 0xD7C2FB40, 0xD7C3FB40, 0xD7C4FB40, 0xD7C5FB40, 0xD7C6FB40, 0xD7C7FB40, 0xD7C8FB40, 0xD7C9FB40, 0xD7CAFB40, 0xD7CBFB40, 0xD7CCFB40, 0xD7CDFB40, 0xD7CEFB40, 0xD7CFFB40, 0xD7D0FB40, 0xD7D1FB40, 0xD7D2FB40, 0xD7D3FB40, 0xD7D4FB40, 0xD7D5FB40, 0xD7D6FB40, 0xD7D7FB40, 0xD7D8FB40, 0xD7D9FB40, 0xD7DAFB40, 0xD7DBFB40, 0xD7DCFB40, 0xD7DDFB40, 0xD7DEFB40, 0xD7DFFB40,
+		0xD7E0FB40, 0xD7E1FB40, 0xD7E2FB40, 0xD7E3FB40, 0xD7E4FB40, 0xD7E5FB40, 0xD7E6FB40, 0xD7E7FB40, 0xD7E8FB40, 0xD7E9FB40, 0xD7EAFB40, 0xD7EBFB40, 0xD7ECFB40, 0xD7EDFB40, 0xD7EEFB40, 0xD7EFFB40, 0xD7F0FB40, 0xD7F1FB40, 0xD7F2FB40, 0xD7F3FB40, 0xD7F4FB40, 0xD7F5FB40, 0xD7F6FB40, 0xD7F7FB40, 0xD7F8FB40, 0xD7F9FB40, 0xD7FAFB40, 0xD7FBFB40, 0xD7FCFB40, 0xD7FDFB40, 0xD7FEFB40, 0xD7FFFB40,
+		0xD800FB40, 0xD801FB40, 0xD802FB40, 0xD803FB40, 0xD804FB40, 0xD805FB40, 0xD806FB40, 0xD807FB40, 0xD808FB40, 0xD809FB40, 0xD80AFB40, 0xD80BFB40, 0xD80CFB40, 0xD80DFB40, 0xD80EFB40, 0xD80FFB40, 0xD810FB40, 0xD811FB40, 0xD812FB40, 0xD813FB40, 0xD814FB40, 0xD815FB40, 0xD816FB40, 0xD817FB40, 0xD818FB40, 0xD819FB40, 0xD81AFB40, 0xD81BFB40, 0xD81CFB40, 0xD81DFB40, 0xD81EFB40, 0xD81FFB40,
+		0xD820FB40, 0xD821FB40, 0xD822FB40, 0xD823FB40, 0xD824FB40, 0xD825FB40, 0xD826FB40, 0xD827FB40, 0xD828FB40, 0xD829FB40, 0xD82AFB40, 0xD82BFB40, 0xD82CFB40, 0xD82DFB40, 0xD82EFB40, 0xD82FFB40, 0xD830FB40, 0xD831FB40, 0xD832FB40, 0xD833FB40, 0xD834FB40, 0xD835FB40, 0xD836FB40, 0xD837FB40, 0xD838FB40, 0xD839FB40, 0xD83AFB40, 0xD83BFB40, 0xD83CFB40, 0xD83DFB40, 0xD83EFB40, 0xD83FFB40,
+		0xD840FB40, 0xD841FB40, 0xD842FB40, 0xD843FB40, 0xD844FB40, 0xD845FB40, 0xD846FB40, 0xD847FB40, 0xD848FB40, 0xD849FB40, 0xD84AFB40, 0xD84BFB40, 0xD84CFB40, 0xD84DFB40, 0xD84EFB40, 0xD84FFB40, 0xD850FB40, 0xD851FB40, 0xD852FB40, 0xD853FB40, 0xD854FB40, 0xD855FB40, 0xD856FB40, 0xD857FB40, 0xD858FB40, 0xD859FB40, 0xD85AFB40, 0xD85BFB40, 0xD85CFB40, 0xD85DFB40, 0xD85EFB40, 0xD85FFB40,
+		0xD860FB40, 0xD861FB40, 0xD862FB40, 0xD863FB40, 0xD864FB40, 0xD865FB40, 0xD866FB40, 0xD867FB40, 0xD868FB40, 0xD869FB40, 0xD86AFB40, 0xD86BFB40, 0xD86CFB40, 0xD86DFB40, 0xD86EFB40, 0xD86FFB40, 0xD870FB40, 0xD871FB40, 0xD872FB40, 0xD873FB40, 0xD874FB40, 0xD875FB40, 0xD876FB40, 0xD877FB40, 0xD878FB40, 0xD879FB40, 0xD87AFB40, 0xD87BFB40, 0xD87CFB40, 0xD87DFB40, 0xD87EFB40, 0xD87FFB40,
+		0xD880FB40, 0xD881FB40, 0xD882FB40, 0xD883FB40, 0xD884FB40, 0xD885FB40, 0xD886FB40, 0xD887FB40, 0xD888FB40, 0xD889FB40, 0xD88AFB40, 0xD88BFB40, 0xD88CFB40, 0xD88DFB40, 0xD88EFB40, 0xD88FFB40, 0xD890FB40, 0xD891FB40, 0xD892FB40, 0xD893FB40, 0xD894FB40, 0xD895FB40, 0xD896FB40, 0xD897FB40, 0xD898FB40, 0xD899FB40, 0xD89AFB40, 0xD89BFB40, 0xD89CFB40, 0xD89DFB40, 0xD89EFB40, 0xD89FFB40,
+		0xD8A0FB40, 0xD8A1FB40, 0xD8A2FB40, 0xD8A3FB40, 0xD8A4FB40, 0xD8A5FB40, 0xD8A6FB40, 0xD8A7FB40, 0xD8A8FB40, 0xD8A9FB40, 0xD8AAFB40, 0xD8ABFB40, 0xD8ACFB40, 0xD8ADFB40, 0xD8AEFB40, 0xD8AFFB40, 0xD8B0FB40, 0xD8B1FB40, 0xD8B2FB40, 0xD8B3FB40, 0xD8B4FB40, 0xD8B5FB40, 0xD8B6FB40, 0xD8B7FB40, 0xD8B8FB40, 0xD8B9FB40, 0xD8BAFB40, 0xD8BBFB40, 0xD8BCFB40, 0xD8BDFB40, 0xD8BEFB40, 0xD8BFFB40,
+		0xD8C0FB40, 0xD8C1FB40, 0xD8C2FB40, 0xD8C3FB40, 0xD8C4FB40, 0xD8C5FB40, 0xD8C6FB40, 0xD8C7FB40, 0xD8C8FB40, 0xD8C9FB40, 0xD8CAFB40, 0xD8CBFB40, 0xD8CCFB40, 0xD8CDFB40, 0xD8CEFB40, 0xD8CFFB40, 0xD8D0FB40, 0xD8D1FB40, 0xD8D2FB40, 0xD8D3FB40, 0xD8D4FB40, 0xD8D5FB40, 0xD8D6FB40, 0xD8D7FB40, 0xD8D8FB40, 0xD8D9FB40, 0xD8DAFB40, 0xD8DBFB40, 0xD8DCFB40, 0xD8DDFB40, 0xD8DEFB40, 0xD8DFFB40,
+		0xD8E0FB40, 0xD8E1FB40, 0xD8E2FB40, 0xD8E3FB40, 0xD8E4FB40, 0xD8E5FB40, 0xD8E6FB40, 0xD8E7FB40, 0xD8E8FB40, 0xD8E9FB40, 0xD8EAFB40, 0xD8EBFB40, 0xD8ECFB40, 0xD8EDFB40, 0xD8EEFB40, 0xD8EFFB40, 0xD8F0FB40, 0xD8F1FB40, 0xD8F2FB40, 0xD8F3FB40, 0xD8F4FB40, 0xD8F5FB40, 0xD8F6FB40, 0xD8F7FB40, 0xD8F8FB40, 0xD8F9FB40, 0xD8FAFB40, 0xD8FBFB40, 0xD8FCFB40, 0xD8FDFB40, 0xD8FEFB40, 0xD8FFFB40,
+		0xD900FB40, 0xD901FB40, 0xD902FB40, 0xD903FB40, 0xD904FB40, 0xD905FB40, 0xD906FB40, 0xD907FB40, 0xD908FB40, 0xD909FB40, 0xD90AFB40, 0xD90BFB40, 0xD90CFB40, 0xD90DFB40, 0xD90EFB40, 0xD90FFB40, 0xD910FB40, 0xD911FB40, 0xD912FB40, 0xD913FB40, 0xD914FB40, 0xD915FB40, 0xD916FB40, 0xD917FB40, 0xD918FB40, 0xD919FB40, 0xD91AFB40, 0xD91BFB40, 0xD91CFB40, 0xD91DFB40, 0xD91EFB40, 0xD91FFB40,
+		0xD920FB40, 0xD921FB40, 0xD922FB40, 0xD923FB40, 0xD924FB40, 0xD925FB40, 0xD926FB40, 0xD927FB40, 0xD928FB40, 0xD929FB40, 0xD92AFB40, 0xD92BFB40, 0xD92CFB40, 0xD92DFB40, 0xD92EFB40, 0xD92FFB40, 0xD930FB40, 0xD931FB40, 0xD932FB40, 0xD933FB40, 0xD934FB40, 0xD935FB40, 0xD936FB40, 0xD937FB40, 0xD938FB40, 0xD939FB40, 0xD93AFB40, 0xD93BFB40, 0xD93CFB40, 0xD93DFB40, 0xD93EFB40, 0xD93FFB40,
+		0xD940FB40, 0xD941FB40, 0xD942FB40, 0xD943FB40, 0xD944FB40, 0xD945FB40, 0xD946FB40, 0xD947FB40, 0xD948FB40, 0xD949FB40, 0xD94AFB40, 0xD94BFB40, 0xD94CFB40, 0xD94DFB40, 0xD94EFB40, 0xD94FFB40, 0xD950FB40, 0xD951FB40, 0xD952FB40, 0xD953FB40, 0xD954FB40, 0xD955FB40, 0xD956FB40, 0xD957FB40, 0xD958FB40, 0xD959FB40, 0xD95AFB40, 0xD95BFB40, 0xD95CFB40, 0xD95DFB40, 0xD95EFB40, 0xD95FFB40,
+		0xD960FB40, 0xD961FB40, 0xD962FB40, 0xD963FB40, 0xD964FB40, 0xD965FB40, 0xD966FB40, 0xD967FB40, 0xD968FB40, 0xD969FB40, 0xD96AFB40, 0xD96BFB40, 0xD96CFB40, 0xD96DFB40, 0xD96EFB40, 0xD96FFB40, 0xD970FB40, 0xD971FB40, 0xD972FB40, 0xD973FB40, 0xD974FB40, 0xD975FB40, 0xD976FB40, 0xD977FB40, 0xD978FB40, 0xD979FB40, 0xD97AFB40, 0xD97BFB40, 0xD97CFB40, 0xD97DFB40, 0xD97EFB40, 0xD97FFB40,
+		0xD980FB40, 0xD981FB40, 0xD982FB40, 0xD983FB40, 0xD984FB40, 0xD985FB40, 0xD986FB40, 0xD987FB40, 0xD988FB40, 0xD989FB40, 0xD98AFB40, 0xD98BFB40, 0xD98CFB40, 0xD98DFB40, 0xD98EFB40, 0xD98FFB40, 0xD990FB40, 0xD991FB40, 0xD992FB40, 0xD993FB40, 0xD994FB40, 0xD995FB40, 0xD996FB40, 0xD997FB40, 0xD998FB40, 0xD999FB40, 0xD99AFB40, 0xD99BFB40, 0xD99CFB40, 0xD99DFB40, 0xD99EFB40, 0xD99FFB40,
+		0xD9A0FB40, 0xD9A1FB40, 0xD9A2FB40, 0xD9A3FB40, 0xD9A4FB40, 0xD9A5FB40, 0xD9A6FB40, 0xD9A7FB40, 0xD9A8FB40, 0xD9A9FB40, 0xD9AAFB40, 0xD9ABFB40, 0xD9ACFB40, 0xD9ADFB40, 0xD9AEFB40, 0xD9AFFB40, 0xD9B0FB40, 0xD9B1FB40, 0xD9B2FB40, 0xD9B3FB40, 0xD9B4FB40, 0xD9B5FB40, 0xD9B6FB40, 0xD9B7FB40, 0xD9B8FB40, 0xD9B9FB40, 0xD9BAFB40, 0xD9BBFB40, 0xD9BCFB40, 0xD9BDFB40, 0xD9BEFB40, 0xD9BFFB40,
+		0xD9C0FB40, 0xD9C1FB40, 0xD9C2FB40, 0xD9C3FB40, 0xD9C4FB40, 0xD9C5FB40, 0xD9C6FB40, 0xD9C7FB40, 0xD9C8FB40, 0xD9C9FB40, 0xD9CAFB40, 0xD9CBFB40, 0xD9CCFB40, 0xD9CDFB40, 0xD9CEFB40, 0xD9CFFB40, 0xD9D0FB40, 0xD9D1FB40, 0xD9D2FB40, 0xD9D3FB40, 0xD9D4FB40, 0xD9D5FB40, 0xD9D6FB40, 0xD9D7FB40, 0xD9D8FB40, 0xD9D9FB40, 0xD9DAFB40, 0xD9DBFB40, 0xD9DCFB40, 0xD9DDFB40, 0xD9DEFB40, 0xD9DFFB40,
+		0xD9E0FB40, 0xD9E1FB40, 0xD9E2FB40, 0xD9E3FB40, 0xD9E4FB40, 0xD9E5FB40, 0xD9E6FB40, 0xD9E7FB40, 0xD9E8FB40, 0xD9E9FB40, 0xD9EAFB40, 0xD9EBFB40, 0xD9ECFB40, 0xD9EDFB40, 0xD9EEFB40, 0xD9EFFB40, 0xD9F0FB40, 0xD9F1FB40, 0xD9F2FB40, 0xD9F3FB40, 0xD9F4FB40, 0xD9F5FB40, 0xD9F6FB40, 0xD9F7FB40, 0xD9F8FB40, 0xD9F9FB40, 0xD9FAFB40, 0xD9FBFB40, 0xD9FCFB40, 0xD9FDFB40, 0xD9FEFB40, 0xD9FFFB40,
+		0xDA00FB40, 0xDA01FB40, 0xDA02FB40, 0xDA03FB40, 0xDA04FB40, 0xDA05FB40, 0xDA06FB40, 0xDA07FB40, 0xDA08FB40, 0xDA09FB40, 0xDA0AFB40, 0xDA0BFB40, 0xDA0CFB40, 0xDA0DFB40, 0xDA0EFB40, 0xDA0FFB40, 0xDA10FB40, 0xDA11FB40, 0xDA12FB40, 0xDA13FB40, 0xDA14FB40, 0xDA15FB40, 0xDA16FB40, 0xDA17FB40, 0xDA18FB40, 0xDA19FB40, 0xDA1AFB40, 0xDA1BFB40, 0xDA1CFB40, 0xDA1DFB40, 0xDA1EFB40, 0xDA1FFB40,
+		0xDA20FB40, 0xDA21FB40, 0xDA22FB40, 0xDA23FB40, 0xDA24FB40, 0xDA25FB40, 0xDA26FB40, 0xDA27FB40, 0xDA28FB40, 0xDA29FB40, 0xDA2AFB40, 0xDA2BFB40, 0xDA2CFB40, 0xDA2DFB40, 0xDA2EFB40, 0xDA2FFB40, 0xDA30FB40, 0xDA31FB40, 0xDA32FB40, 0xDA33FB40, 0xDA34FB40, 0xDA35FB40, 0xDA36FB40, 0xDA37FB40, 0xDA38FB40, 0xDA39FB40, 0xDA3AFB40, 0xDA3BFB40, 0xDA3CFB40, 0xDA3DFB40, 0xDA3EFB40, 0xDA3FFB40,
+		0xDA40FB40, 0xDA41FB40, 0xDA42FB40, 0xDA43FB40, 0xDA44FB40, 0xDA45FB40, 0xDA46FB40, 0xDA47FB40, 0xDA48FB40, 0xDA49FB40, 0xDA4AFB40, 0xDA4BFB40, 0xDA4CFB40, 0xDA4DFB40, 0xDA4EFB40, 0xDA4FFB40, 0xDA50FB40, 0xDA51FB40, 0xDA52FB40, 0xDA53FB40, 0xDA54FB40, 0xDA55FB40, 0xDA56FB40, 0xDA57FB40, 0xDA58FB40, 0xDA59FB40, 0xDA5AFB40, 0xDA5BFB40, 0xDA5CFB40, 0xDA5DFB40, 0xDA5EFB40, 0xDA5FFB40,
+		0xDA60FB40, 0xDA61FB40, 0xDA62FB40, 0xDA63FB40, 0xDA64FB40, 0xDA65FB40, 0xDA66FB40, 0xDA67FB40, 0xDA68FB40, 0xDA69FB40, 0xDA6AFB40, 0xDA6BFB40, 0xDA6CFB40, 0xDA6DFB40, 0xDA6EFB40, 0xDA6FFB40, 0xDA70FB40, 0xDA71FB40, 0xDA72FB40, 0xDA73FB40, 0xDA74FB40, 0xDA75FB40, 0xDA76FB40, 0xDA77FB40, 0xDA78FB40, 0xDA79FB40, 0xDA7AFB40, 0xDA7BFB40, 0xDA7CFB40, 0xDA7DFB40, 0xDA7EFB40, 0xDA7FFB40,
+		0xDA80FB40, 0xDA81FB40, 0xDA82FB40, 0xDA83FB40, 0xDA84FB40, 0xDA85FB40, 0xDA86FB40, 0xDA87FB40, 0xDA88FB40, 0xDA89FB40, 0xDA8AFB40, 0xDA8BFB40, 0xDA8CFB40, 0xDA8DFB40, 0xDA8EFB40, 0xDA8FFB40, 0xDA90FB40, 0xDA91FB40, 0xDA92FB40, 0xDA93FB40, 0xDA94FB40, 0xDA95FB40, 0xDA96FB40, 0xDA97FB40, 0xDA98FB40, 0xDA99FB40, 0xDA9AFB40, 0xDA9BFB40, 0xDA9CFB40, 0xDA9DFB40, 0xDA9EFB40, 0xDA9FFB40,
+		0xDAA0FB40, 0xDAA1FB40, 0xDAA2FB40, 0xDAA3FB40, 0xDAA4FB40, 0xDAA5FB40, 0xDAA6FB40, 0xDAA7FB40, 0xDAA8FB40, 0xDAA9FB40, 0xDAAAFB40, 0xDAABFB40, 0xDAACFB40, 0xDAADFB40, 0xDAAEFB40, 0xDAAFFB40, 0xDAB0FB40, 0xDAB1FB40, 0xDAB2FB40, 0xDAB3FB40, 0xDAB4FB40, 0xDAB5FB40, 0xDAB6FB40, 0xDAB7FB40, 0xDAB8FB40, 0xDAB9FB40, 0xDABAFB40, 0xDABBFB40, 0xDABCFB40, 0xDABDFB40, 0xDABEFB40, 0xDABFFB40,
+		0xDAC0FB40, 0xDAC1FB40, 0xDAC2FB40, 0xDAC3FB40, 0xDAC4FB40, 0xDAC5FB40, 0xDAC6FB40, 0xDAC7FB40, 0xDAC8FB40, 0xDAC9FB40, 0xDACAFB40, 0xDACBFB40, 0xDACCFB40, 0xDACDFB40, 0xDACEFB40, 0xDACFFB40, 0xDAD0FB40, 0xDAD1FB40, 0xDAD2FB40, 0xDAD3FB40, 0xDAD4FB40, 0xDAD5FB40, 0xDAD6FB40, 0xDAD7FB40, 0xDAD8FB40, 0xDAD9FB40, 0xDADAFB40, 0xDADBFB40, 0xDADCFB40, 0xDADDFB40, 0xDADEFB40, 0xDADFFB40,
+		0xDAE0FB40, 0xDAE1FB40, 0xDAE2FB40, 0xDAE3FB40, 0xDAE4FB40, 0xDAE5FB40, 0xDAE6FB40, 0xDAE7FB40, 0xDAE8FB40, 0xDAE9FB40, 0xDAEAFB40, 0xDAEBFB40, 0xDAECFB40, 0xDAEDFB40, 0xDAEEFB40, 0xDAEFFB40, 0xDAF0FB40, 0xDAF1FB40, 0xDAF2FB40, 0xDAF3FB40, 0xDAF4FB40, 0xDAF5FB40, 0xDAF6FB40, 0xDAF7FB40, 0xDAF8FB40, 0xDAF9FB40, 0xDAFAFB40, 0xDAFBFB40, 0xDAFCFB40, 0xDAFDFB40, 0xDAFEFB40, 0xDAFFFB40,
+		0xDB00FB40, 0xDB01FB40, 0xDB02FB40, 0xDB03FB40, 0xDB04FB40, 0xDB05FB40, 0xDB06FB40, 0xDB07FB40, 0xDB08FB40, 0xDB09FB40, 0xDB0AFB40, 0xDB0BFB40, 0xDB0CFB40, 0xDB0DFB40, 0xDB0EFB40, 0xDB0FFB40, 0xDB10FB40, 0xDB11FB40, 0xDB12FB40, 0xDB13FB40, 0xDB14FB40, 0xDB15FB40, 0xDB16FB40, 0xDB17FB40, 0xDB18FB40, 0xDB19FB40, 0xDB1AFB40, 0xDB1BFB40, 0xDB1CFB40, 0xDB1DFB40, 0xDB1EFB40, 0xDB1FFB40,
+		0xDB20FB40, 0xDB21FB40, 0xDB22FB40, 0xDB23FB40, 0xDB24FB40, 0xDB25FB40, 0xDB26FB40, 0xDB27FB40, 0xDB28FB40, 0xDB29FB40, 0xDB2AFB40, 0xDB2BFB40, 0xDB2CFB40, 0xDB2DFB40, 0xDB2EFB40, 0xDB2FFB40, 0xDB30FB40, 0xDB31FB40, 0xDB32FB40, 0xDB33FB40, 0xDB34FB40, 0xDB35FB40, 0xDB36FB40, 0xDB37FB40, 0xDB38FB40, 0xDB39FB40, 0xDB3AFB40, 0xDB3BFB40, 0xDB3CFB40, 0xDB3DFB40, 0xDB3EFB40, 0xDB3FFB40,
+		0xDB40FB40, 0xDB41FB40, 0xDB42FB40, 0xDB43FB40, 0xDB44FB40, 0xDB45FB40, 0xDB46FB40, 0xDB47FB40, 0xDB48FB40, 0xDB49FB40, 0xDB4AFB40, 0xDB4BFB40, 0xDB4CFB40, 0xDB4DFB40, 0xDB4EFB40, 0xDB4FFB40, 0xDB50FB40, 0xDB51FB40, 0xDB52FB40, 0xDB53FB40, 0xDB54FB40, 0xDB55FB40, 0xDB56FB40, 0xDB57FB40, 0xDB58FB40, 0xDB59FB40, 0xDB5AFB40, 0xDB5BFB40, 0xDB5CFB40, 0xDB5DFB40, 0xDB5EFB40, 0xDB5FFB40,
+		0xDB60FB40, 0xDB61FB40, 0xDB62FB40, 0xDB63FB40, 0xDB64FB40, 0xDB65FB40, 0xDB66FB40, 0xDB67FB40, 0xDB68FB40, 0xDB69FB40, 0xDB6AFB40, 0xDB6BFB40, 0xDB6CFB40, 0xDB6DFB40, 0xDB6EFB40, 0xDB6FFB40, 0xDB70FB40, 0xDB71FB40, 0xDB72FB40, 0xDB73FB40, 0xDB74FB40, 0xDB75FB40, 0xDB76FB40, 0xDB77FB40, 0xDB78FB40, 0xDB79FB40, 0xDB7AFB40, 0xDB7BFB40, 0xDB7CFB40, 0xDB7DFB40, 0xDB7EFB40, 0xDB7FFB40,
+		0xDB80FB40, 0xDB81FB40, 0xDB82FB40, 0xDB83FB40, 0xDB84FB40, 0xDB85FB40, 0xDB86FB40, 0xDB87FB40, 0xDB88FB40, 0xDB89FB40, 0xDB8AFB40, 0xDB8BFB40, 0xDB8CFB40, 0xDB8DFB40, 0xDB8EFB40, 0xDB8FFB40, 0xDB90FB40, 0xDB91FB40, 0xDB92FB40, 0xDB93FB40, 0xDB94FB40, 0xDB95FB40, 0xDB96FB40, 0xDB97FB40, 0xDB98FB40, 0xDB99FB40, 0xDB9AFB40, 0xDB9BFB40, 0xDB9CFB40, 0xDB9DFB40, 0xDB9EFB40, 0xDB9FFB40,
+		0xDBA0FB40, 0xDBA1FB40, 0xDBA2FB40, 0xDBA3FB40, 0xDBA4FB40, 0xDBA5FB40, 0xDBA6FB40, 0xDBA7FB40, 0xDBA8FB40, 0xDBA9FB40, 0xDBAAFB40, 0xDBABFB40, 0xDBACFB40, 0xDBADFB40, 0xDBAEFB40, 0xDBAFFB40, 0xDBB0FB40, 0xDBB1FB40, 0xDBB2FB40, 0xDBB3FB40, 0xDBB4FB40, 0xDBB5FB40, 0xDBB6FB40, 0xDBB7FB40, 0xDBB8FB40, 0xDBB9FB40, 0xDBBAFB40, 0xDBBBFB40, 0xDBBCFB40, 0xDBBDFB40, 0xDBBEFB40, 0xDBBFFB40,
+		0xDBC0FB40, 0xDBC1FB40, 0xDBC2FB40, 0xDBC3FB40, 0xDBC4FB40, 0xDBC5FB40, 0xDBC6FB40, 0xDBC7FB40, 0xDBC8FB40, 0xDBC9FB40, 0xDBCAFB40, 0xDBCBFB40, 0xDBCCFB40, 0xDBCDFB40, 0xDBCEFB40, 0xDBCFFB40, 0xDBD0FB40, 0xDBD1FB40, 0xDBD2FB40, 0xDBD3FB40, 0xDBD4FB40, 0xDBD5FB40, 0xDBD6FB40, 0xDBD7FB40, 0xDBD8FB40, 0xDBD9FB40, 0xDBDAFB40, 0xDBDBFB40, 0xDBDCFB40, 0xDBDDFB40, 0xDBDEFB40, 0xDBDFFB40,
+		0xDBE0FB40, 0xDBE1FB40, 0xDBE2FB40, 0xDBE3FB40, 0xDBE4FB40, 0xDBE5FB40, 0xDBE6FB40, 0xDBE7FB40, 0xDBE8FB40, 0xDBE9FB40, 0xDBEAFB40, 0xDBEBFB40, 0xDBECFB40, 0xDBEDFB40, 0xDBEEFB40, 0xDBEFFB40, 0xDBF0FB40, 0xDBF1FB40, 0xDBF2FB40, 0xDBF3FB40, 0xDBF4FB40, 0xDBF5FB40, 0xDBF6FB40, 0xDBF7FB40, 0xDBF8FB40, 0xDBF9FB40, 0xDBFAFB40, 0xDBFBFB40, 0xDBFCFB40, 0xDBFDFB40, 0xDBFEFB40, 0xDBFFFB40,
+		0xDC00FB40, 0xDC01FB40, 0xDC02FB40, 0xDC03FB40, 0xDC04FB40, 0xDC05FB40, 0xDC06FB40, 0xDC07FB40, 0xDC08FB40, 0xDC09FB40, 0xDC0AFB40, 0xDC0BFB40, 0xDC0CFB40, 0xDC0DFB40, 0xDC0EFB40, 0xDC0FFB40, 0xDC10FB40, 0xDC11FB40, 0xDC12FB40, 0xDC13FB40, 0xDC14FB40, 0xDC15FB40, 0xDC16FB40, 0xDC17FB40, 0xDC18FB40, 0xDC19FB40, 0xDC1AFB40, 0xDC1BFB40, 0xDC1CFB40, 0xDC1DFB40, 0xDC1EFB40, 0xDC1FFB40,
+		0xDC20FB40, 0xDC21FB40, 0xDC22FB40, 0xDC23FB40, 0xDC24FB40, 0xDC25FB40, 0xDC26FB40, 0xDC27FB40, 0xDC28FB40, 0xDC29FB40, 0xDC2AFB40, 0xDC2BFB40, 0xDC2CFB40, 0xDC2DFB40, 0xDC2EFB40, 0xDC2FFB40, 0xDC30FB40, 0xDC31FB40, 0xDC32FB40, 0xDC33FB40, 0xDC34FB40, 0xDC35FB40, 0xDC36FB40, 0xDC37FB40, 0xDC38FB40, 0xDC39FB40, 0xDC3AFB40, 0xDC3BFB40, 0xDC3CFB40, 0xDC3DFB40, 0xDC3EFB40, 0xDC3FFB40,
+		0xDC40FB40, 0xDC41FB40, 0xDC42FB40, 0xDC43FB40, 0xDC44FB40, 0xDC45FB40, 0xDC46FB40, 0xDC47FB40, 0xDC48FB40, 0xDC49FB40, 0xDC4AFB40, 0xDC4BFB40, 0xDC4CFB40, 0xDC4DFB40, 0xDC4EFB40, 0xDC4FFB40, 0xDC50FB40, 0xDC51FB40, 0xDC52FB40, 0xDC53FB40, 0xDC54FB40, 0xDC55FB40, 0xDC56FB40, 0xDC57FB40, 0xDC58FB40, 0xDC59FB40, 0xDC5AFB40, 0xDC5BFB40, 0xDC5CFB40, 0xDC5DFB40, 0xDC5EFB40, 0xDC5FFB40,
+		0xDC60FB40, 0xDC61FB40, 0xDC62FB40, 0xDC63FB40, 0xDC64FB40, 0xDC65FB40, 0xDC66FB40, 0xDC67FB40, 0xDC68FB40, 0xDC69FB40, 0xDC6AFB40, 0xDC6BFB40, 0xDC6CFB40, 0xDC6DFB40, 0xDC6EFB40, 0xDC6FFB40, 0xDC70FB40, 0xDC71FB40, 0xDC72FB40, 0xDC73FB40, 0xDC74FB40, 0xDC75FB40, 0xDC76FB40, 0xDC77FB40, 0xDC78FB40, 0xDC79FB40, 0xDC7AFB40, 0xDC7BFB40, 0xDC7CFB40, 0xDC7DFB40, 0xDC7EFB40, 0xDC7FFB40,
+		0xDC80FB40, 0xDC81FB40, 0xDC82FB40, 0xDC83FB40, 0xDC84FB40, 0xDC85FB40, 0xDC86FB40, 0xDC87FB40, 0xDC88FB40, 0xDC89FB40, 0xDC8AFB40, 0xDC8BFB40, 0xDC8CFB40, 0xDC8DFB40, 0xDC8EFB40, 0xDC8FFB40, 0xDC90FB40, 0xDC91FB40, 0xDC92FB40, 0xDC93FB40, 0xDC94FB40, 0xDC95FB40, 0xDC96FB40, 0xDC97FB40, 0xDC98FB40, 0xDC99FB40, 0xDC9AFB40, 0xDC9BFB40, 0xDC9CFB40, 0xDC9DFB40, 0xDC9EFB40, 0xDC9FFB40,
+		0xDCA0FB40, 0xDCA1FB40, 0xDCA2FB40, 0xDCA3FB40, 0xDCA4FB40, 0xDCA5FB40, 0xDCA6FB40, 0xDCA7FB40, 0xDCA8FB40, 0xDCA9FB40, 0xDCAAFB40, 0xDCABFB40, 0xDCACFB40, 0xDCADFB40, 0xDCAEFB40, 0xDCAFFB40, 0xDCB0FB40, 0xDCB1FB40, 0xDCB2FB40, 0xDCB3FB40, 0xDCB4FB40, 0xDCB5FB40, 0xDCB6FB40, 0xDCB7FB40, 0xDCB8FB40, 0xDCB9FB40, 0xDCBAFB40, 0xDCBBFB40, 0xDCBCFB40, 0xDCBDFB40, 0xDCBEFB40, 0xDCBFFB40,
+		0xDCC0FB40, 0xDCC1FB40, 0xDCC2FB40, 0xDCC3FB40, 0xDCC4FB40, 0xDCC5FB40, 0xDCC6FB40, 0xDCC7FB40, 0xDCC8FB40, 0xDCC9FB40, 0xDCCAFB40, 0xDCCBFB40, 0xDCCCFB40, 0xDCCDFB40, 0xDCCEFB40, 0xDCCFFB40, 0xDCD0FB40, 0xDCD1FB40, 0xDCD2FB40, 0xDCD3FB40, 0xDCD4FB40, 0xDCD5FB40, 0xDCD6FB40, 0xDCD7FB40, 0xDCD8FB40, 0xDCD9FB40, 0xDCDAFB40, 0xDCDBFB40, 0xDCDCFB40, 0xDCDDFB40, 0xDCDEFB40, 0xDCDFFB40,
+		0xDCE0FB40, 0xDCE1FB40, 0xDCE2FB40, 0xDCE3FB40, 0xDCE4FB40, 0xDCE5FB40, 0xDCE6FB40, 0xDCE7FB40, 0xDCE8FB40, 0xDCE9FB40, 0xDCEAFB40, 0xDCEBFB40, 0xDCECFB40, 0xDCEDFB40, 0xDCEEFB40, 0xDCEFFB40, 0xDCF0FB40, 0xDCF1FB40, 0xDCF2FB40, 0xDCF3FB40, 0xDCF4FB40, 0xDCF5FB40, 0xDCF6FB40, 0xDCF7FB40, 0xDCF8FB40, 0xDCF9FB40, 0xDCFAFB40, 0xDCFBFB40, 0xDCFCFB40, 0xDCFDFB40, 0xDCFEFB40, 0xDCFFFB40,
+		0xDD00FB40, 0xDD01FB40, 0xDD02FB40, 0xDD03FB40, 0xDD04FB40, 0xDD05FB40, 0xDD06FB40, 0xDD07FB40, 0xDD08FB40, 0xDD09FB40, 0xDD0AFB40, 0xDD0BFB40, 0xDD0CFB40, 0xDD0DFB40, 0xDD0EFB40, 0xDD0FFB40, 0xDD10FB40, 0xDD11FB40, 0xDD12FB40, 0xDD13FB40, 0xDD14FB40, 0xDD15FB40, 0xDD16FB40, 0xDD17FB40, 0xDD18FB40, 0xDD19FB40, 0xDD1AFB40, 0xDD1BFB40, 0xDD1CFB40, 0xDD1DFB40, 0xDD1EFB40, 0xDD1FFB40,
+		0xDD20FB40, 0xDD21FB40, 0xDD22FB40, 0xDD23FB40, 0xDD24FB40, 0xDD25FB40, 0xDD26FB40, 0xDD27FB40, 0xDD28FB40, 0xDD29FB40, 0xDD2AFB40, 0xDD2BFB40, 0xDD2CFB40, 0xDD2DFB40, 0xDD2EFB40, 0xDD2FFB40, 0xDD30FB40, 0xDD31FB40, 0xDD32FB40, 0xDD33FB40, 0xDD34FB40, 0xDD35FB40, 0xDD36FB40, 0xDD37FB40, 0xDD38FB40, 0xDD39FB40, 0xDD3AFB40, 0xDD3BFB40, 0xDD3CFB40, 0xDD3DFB40, 0xDD3EFB40, 0xDD3FFB40,
+		0xDD40FB40, 0xDD41FB40, 0xDD42FB40, 0xDD43FB40, 0xDD44FB40, 0xDD45FB40, 0xDD46FB40, 0xDD47FB40, 0xDD48FB40, 0xDD49FB40, 0xDD4AFB40, 0xDD4BFB40, 0xDD4CFB40, 0xDD4DFB40, 0xDD4EFB40, 0xDD4FFB40, 0xDD50FB40, 0xDD51FB40, 0xDD52FB40, 0xDD53FB40, 0xDD54FB40, 0xDD55FB40, 0xDD56FB40, 0xDD57FB40, 0xDD58FB40, 0xDD59FB40, 0xDD5AFB40, 0xDD5BFB40, 0xDD5CFB40, 0xDD5DFB40, 0xDD5EFB40, 0xDD5FFB40,
+		0xDD60FB40, 0xDD61FB40, 0xDD62FB40, 0xDD63FB40, 0xDD64FB40, 0xDD65FB40, 0xDD66FB40, 0xDD67FB40, 0xDD68FB40, 0xDD69FB40, 0xDD6AFB40, 0xDD6BFB40, 0xDD6CFB40, 0xDD6DFB40, 0xDD6EFB40, 0xDD6FFB40, 0xDD70FB40, 0xDD71FB40, 0xDD72FB40, 0xDD73FB40, 0xDD74FB40, 0xDD75FB40, 0xDD76FB40, 0xDD77FB40, 0xDD78FB40, 0xDD79FB40, 0xDD7AFB40, 0xDD7BFB40, 0xDD7CFB40, 0xDD7DFB40, 0xDD7EFB40, 0xDD7FFB40,
+		0xDD80FB40, 0xDD81FB40, 0xDD82FB40, 0xDD83FB40, 0xDD84FB40, 0xDD85FB40, 0xDD86FB40, 0xDD87FB40, 0xDD88FB40, 0xDD89FB40, 0xDD8AFB40, 0xDD8BFB40, 0xDD8CFB40, 0xDD8DFB40, 0xDD8EFB40, 0xDD8FFB40, 0xDD90FB40, 0xDD91FB40, 0xDD92FB40, 0xDD93FB40, 0xDD94FB40, 0xDD95FB40, 0xDD96FB40, 0xDD97FB40, 0xDD98FB40, 0xDD99FB40, 0xDD9AFB40, 0xDD9BFB40, 0xDD9CFB40, 0xDD9DFB40, 0xDD9EFB40, 0xDD9FFB40,
+		0xDDA0FB40, 0xDDA1FB40, 0xDDA2FB40, 0xDDA3FB40, 0xDDA4FB40, 0xDDA5FB40, 0xDDA6FB40, 0xDDA7FB40, 0xDDA8FB40, 0xDDA9FB40, 0xDDAAFB40, 0xDDABFB40, 0xDDACFB40, 0xDDADFB40, 0xDDAEFB40, 0xDDAFFB40, 0xDDB0FB40, 0xDDB1FB40, 0xDDB2FB40, 0xDDB3FB40, 0xDDB4FB40, 0xDDB5FB40, 0xDDB6FB40, 0xDDB7FB40, 0xDDB8FB40, 0xDDB9FB40, 0xDDBAFB40, 0xDDBBFB40, 0xDDBCFB40, 0xDDBDFB40, 0xDDBEFB40, 0xDDBFFB40,
+		0xDDC0FB40, 0xDDC1FB40, 0xDDC2FB40, 0xDDC3FB40, 0xDDC4FB40, 0xDDC5FB40, 0xDDC6FB40, 0xDDC7FB40, 0xDDC8FB40, 0xDDC9FB40, 0xDDCAFB40, 0xDDCBFB40, 0xDDCCFB40, 0xDDCDFB40, 0xDDCEFB40, 0xDDCFFB40, 0xDDD0FB40, 0xDDD1FB40, 0xDDD2FB40, 0xDDD3FB40, 0xDDD4FB40, 0xDDD5FB40, 0xDDD6FB40, 0xDDD7FB40, 0xDDD8FB40, 0xDDD9FB40, 0xDDDAFB40, 0xDDDBFB40, 0xDDDCFB40, 0xDDDDFB40, 0xDDDEFB40, 0xDDDFFB40,
+		0xDDE0FB40, 0xDDE1FB40, 0xDDE2FB40, 0xDDE3FB40, 0xDDE4FB40, 0xDDE5FB40, 0xDDE6FB40, 0xDDE7FB40, 0xDDE8FB40, 0xDDE9FB40, 0xDDEAFB40, 0xDDEBFB40, 0xDDECFB40, 0xDDEDFB40, 0xDDEEFB40, 0xDDEFFB40, 0xDDF0FB40, 0xDDF1FB40, 0xDDF2FB40, 0xDDF3FB40, 0xDDF4FB40, 0xDDF5FB40, 0xDDF6FB40, 0xDDF7FB40, 0xDDF8FB40, 0xDDF9FB40, 0xDDFAFB40, 0xDDFBFB40, 0xDDFCFB40, 0xDDFDFB40, 0xDDFEFB40, 0xDDFFFB40,
+		0xDE00FB40, 0xDE01FB40, 0xDE02FB40, 0xDE03FB40, 0xDE04FB40, 0xDE05FB40, 0xDE06FB40, 0xDE07FB40, 0xDE08FB40, 0xDE09FB40, 0xDE0AFB40, 0xDE0BFB40, 0xDE0CFB40, 0xDE0DFB40, 0xDE0EFB40, 0xDE0FFB40, 0xDE10FB40, 0xDE11FB40, 0xDE12FB40, 0xDE13FB40, 0xDE14FB40, 0xDE15FB40, 0xDE16FB40, 0xDE17FB40, 0xDE18FB40, 0xDE19FB40, 0xDE1AFB40, 0xDE1BFB40, 0xDE1CFB40, 0xDE1DFB40, 0xDE1EFB40, 0xDE1FFB40,
+		0xDE20FB40, 0xDE21FB40, 0xDE22FB40, 0xDE23FB40, 0xDE24FB40, 0xDE25FB40, 0xDE26FB40, 0xDE27FB40, 0xDE28FB40, 0xDE29FB40, 0xDE2AFB40, 0xDE2BFB40, 0xDE2CFB40, 0xDE2DFB40, 0xDE2EFB40, 0xDE2FFB40, 0xDE30FB40, 0xDE31FB40, 0xDE32FB40, 0xDE33FB40, 0xDE34FB40, 0xDE35FB40, 0xDE36FB40, 0xDE37FB40, 0xDE38FB40, 0xDE39FB40, 0xDE3AFB40, 0xDE3BFB40, 0xDE3CFB40, 0xDE3DFB40, 0xDE3EFB40, 0xDE3FFB40,
+		0xDE40FB40, 0xDE41FB40, 0xDE42FB40, 0xDE43FB40, 0xDE44FB40, 0xDE45FB40, 0xDE46FB40, 0xDE47FB40, 0xDE48FB40, 0xDE49FB40, 0xDE4AFB40, 0xDE4BFB40, 0xDE4CFB40, 0xDE4DFB40, 0xDE4EFB40, 0xDE4FFB40, 0xDE50FB40, 0xDE51FB40, 0xDE52FB40, 0xDE53FB40, 0xDE54FB40, 0xDE55FB40, 0xDE56FB40, 0xDE57FB40, 0xDE58FB40, 0xDE59FB40, 0xDE5AFB40, 0xDE5BFB40, 0xDE5CFB40, 0xDE5DFB40, 0xDE5EFB40, 0xDE5FFB40,
+		0xDE60FB40, 0xDE61FB40, 0xDE62FB40, 0xDE63FB40, 0xDE64FB40, 0xDE65FB40, 0xDE66FB40, 0xDE67FB40, 0xDE68FB40, 0xDE69FB40, 0xDE6AFB40, 0xDE6BFB40, 0xDE6CFB40, 0xDE6DFB40, 0xDE6EFB40, 0xDE6FFB40, 0xDE70FB40, 0xDE71FB40, 0xDE72FB40, 0xDE73FB40, 0xDE74FB40, 0xDE75FB40, 0xDE76FB40, 0xDE77FB40, 0xDE78FB40, 0xDE79FB40, 0xDE7AFB40, 0xDE7BFB40, 0xDE7CFB40, 0xDE7DFB40, 0xDE7EFB40, 0xDE7FFB40,
+		0xDE80FB40, 0xDE81FB40, 0xDE82FB40, 0xDE83FB40, 0xDE84FB40, 0xDE85FB40, 0xDE86FB40, 0xDE87FB40, 0xDE88FB40, 0xDE89FB40, 0xDE8AFB40, 0xDE8BFB40, 0xDE8CFB40, 0xDE8DFB40, 0xDE8EFB40, 0xDE8FFB40, 0xDE90FB40, 0xDE91FB40, 0xDE92FB40, 0xDE93FB40, 0xDE94FB40, 0xDE95FB40, 0xDE96FB40, 0xDE97FB40, 0xDE98FB40, 0xDE99FB40, 0xDE9AFB40, 0xDE9BFB40, 0xDE9CFB40, 0xDE9DFB40, 0xDE9EFB40, 0xDE9FFB40,
+		0xDEA0FB40, 0xDEA1FB40, 0xDEA2FB40, 0xDEA3FB40, 0xDEA4FB40, 0xDEA5FB40, 0xDEA6FB40, 0xDEA7FB40, 0xDEA8FB40, 0xDEA9FB40, 0xDEAAFB40, 0xDEABFB40, 0xDEACFB40, 0xDEADFB40, 0xDEAEFB40, 0xDEAFFB40, 0xDEB0FB40, 0xDEB1FB40, 0xDEB2FB40, 0xDEB3FB40, 0xDEB4FB40, 0xDEB5FB40, 0xDEB6FB40, 0xDEB7FB40, 0xDEB8FB40, 0xDEB9FB40, 0xDEBAFB40, 0xDEBBFB40, 0xDEBCFB40, 0xDEBDFB40, 0xDEBEFB40, 0xDEBFFB40,
+		0xDEC0FB40, 0xDEC1FB40, 0xDEC2FB40, 0xDEC3FB40, 0xDEC4FB40, 0xDEC5FB40, 0xDEC6FB40, 0xDEC7FB40, 0xDEC8FB40, 0xDEC9FB40, 0xDECAFB40, 0xDECBFB40, 0xDECCFB40, 0xDECDFB40, 0xDECEFB40, 0xDECFFB40, 0xDED0FB40, 0xDED1FB40, 0xDED2FB40, 0xDED3FB40, 0xDED4FB40, 0xDED5FB40, 0xDED6FB40, 0xDED7FB40, 0xDED8FB40, 0xDED9FB40, 0xDEDAFB40, 0xDEDBFB40, 0xDEDCFB40, 0xDEDDFB40, 0xDEDEFB40, 0xDEDFFB40,
+		0xDEE0FB40, 0xDEE1FB40, 0xDEE2FB40, 0xDEE3FB40, 0xDEE4FB40, 0xDEE5FB40, 0xDEE6FB40, 0xDEE7FB40, 0xDEE8FB40, 0xDEE9FB40, 0xDEEAFB40, 0xDEEBFB40, 0xDEECFB40, 0xDEEDFB40, 0xDEEEFB40, 0xDEEFFB40, 0xDEF0FB40, 0xDEF1FB40, 0xDEF2FB40, 0xDEF3FB40, 0xDEF4FB40, 0xDEF5FB40, 0xDEF6FB40, 0xDEF7FB40, 0xDEF8FB40, 0xDEF9FB40, 0xDEFAFB40, 0xDEFBFB40, 0xDEFCFB40, 0xDEFDFB40, 0xDEFEFB40, 0xDEFFFB40,
+		0xDF00FB40, 0xDF01FB40, 0xDF02FB40, 0xDF03FB40, 0xDF04FB40, 0xDF05FB40, 0xDF06FB40, 0xDF07FB40, 0xDF08FB40, 0xDF09FB40, 0xDF0AFB40, 0xDF0BFB40, 0xDF0CFB40, 0xDF0DFB40, 0xDF0EFB40, 0xDF0FFB40, 0xDF10FB40, 0xDF11FB40, 0xDF12FB40, 0xDF13FB40, 0xDF14FB40, 0xDF15FB40, 0xDF16FB40, 0xDF17FB40, 0xDF18FB40, 0xDF19FB40, 0xDF1AFB40, 0xDF1BFB40, 0xDF1CFB40, 0xDF1DFB40, 0xDF1EFB40, 0xDF1FFB40,
+		0xDF20FB40, 0xDF21FB40, 0xDF22FB40, 0xDF23FB40, 0xDF24FB40, 0xDF25FB40, 0xDF26FB40, 0xDF27FB40, 0xDF28FB40, 0xDF29FB40, 0xDF2AFB40, 0xDF2BFB40, 0xDF2CFB40, 0xDF2DFB40, 0xDF2EFB40, 0xDF2FFB40, 0xDF30FB40, 0xDF31FB40, 0xDF32FB40, 0xDF33FB40, 0xDF34FB40, 0xDF35FB40, 0xDF36FB40, 0xDF37FB40, 0xDF38FB40, 0xDF39FB40, 0xDF3AFB40, 0xDF3BFB40, 0xDF3CFB40, 0xDF3DFB40, 0xDF3EFB40, 0xDF3FFB40,
+		0xDF40FB40, 0xDF41FB40, 0xDF42FB40, 0xDF43FB40, 0xDF44FB40, 0xDF45FB40, 0xDF46FB40, 0xDF47FB40, 0xDF48FB40, 0xDF49FB40, 0xDF4AFB40, 0xDF4BFB40, 0xDF4CFB40, 0xDF4DFB40, 0xDF4EFB40, 0xDF4FFB40, 0xDF50FB40, 0xDF51FB40, 0xDF52FB40, 0xDF53FB40, 0xDF54FB40, 0xDF55FB40, 0xDF56FB40, 0xDF57FB40, 0xDF58FB40, 0xDF59FB40, 0xDF5AFB40, 0xDF5BFB40, 0xDF5CFB40, 0xDF5DFB40, 0xDF5EFB40, 0xDF5FFB40,
+		0xDF60FB40, 0xDF61FB40, 0xDF62FB40, 0xDF63FB40, 0xDF64FB40, 0xDF65FB40, 0xDF66FB40, 0xDF67FB40, 0xDF68FB40, 0xDF69FB40, 0xDF6AFB40, 0xDF6BFB40, 0xDF6CFB40, 0xDF6DFB40, 0xDF6EFB40, 0xDF6FFB40, 0xDF70FB40, 0xDF71FB40, 0xDF72FB40, 0xDF73FB40, 0xDF74FB40, 0xDF75FB40, 0xDF76FB40, 0xDF77FB40, 0xDF78FB40, 0xDF79FB40, 0xDF7AFB40, 0xDF7BFB40, 0xDF7CFB40, 0xDF7DFB40, 0xDF7EFB40, 0xDF7FFB40,
+		0xDF80FB40, 0xDF81FB40, 0xDF82FB40, 0xDF83FB40, 0xDF84FB40, 0xDF85FB40, 0xDF86FB40, 0xDF87FB40, 0xDF88FB40, 0xDF89FB40, 0xDF8AFB40, 0xDF8BFB40, 0xDF8CFB40, 0xDF8DFB40, 0xDF8EFB40, 0xDF8FFB40, 0xDF90FB40, 0xDF91FB40, 0xDF92FB40, 0xDF93FB40, 0xDF94FB40, 0xDF95FB40, 0xDF96FB40, 0xDF97FB40, 0xDF98FB40, 0xDF99FB40, 0xDF9AFB40, 0xDF9BFB40, 0xDF9CFB40, 0xDF9DFB40, 0xDF9EFB40, 0xDF9FFB40,
+		0xDFA0FB40, 0xDFA1FB40, 0xDFA2FB40, 0xDFA3FB40, 0xDFA4FB40, 0xDFA5FB40, 0xDFA6FB40, 0xDFA7FB40, 0xDFA8FB40, 0xDFA9FB40, 0xDFAAFB40, 0xDFABFB40, 0xDFACFB40, 0xDFADFB40, 0xDFAEFB40, 0xDFAFFB40, 0xDFB0FB40, 0xDFB1FB40, 0xDFB2FB40, 0xDFB3FB40, 0xDFB4FB40, 0xDFB5FB40, 0xDFB6FB40, 0xDFB7FB40, 0xDFB8FB40, 0xDFB9FB40, 0xDFBAFB40, 0xDFBBFB40, 0xDFBCFB40, 0xDFBDFB40, 0xDFBEFB40, 0xDFBFFB40,
+		0xDFC0FB40, 0xDFC1FB40, 0xDFC2FB40, 0xDFC3FB40, 0xDFC4FB40, 0xDFC5FB40, 0xDFC6FB40, 0xDFC7FB40, 0xDFC8FB40, 0xDFC9FB40, 0xDFCAFB40, 0xDFCBFB40, 0xDFCCFB40, 0xDFCDFB40, 0xDFCEFB40, 0xDFCFFB40, 0xDFD0FB40, 0xDFD1FB40, 0xDFD2FB40, 0xDFD3FB40, 0xDFD4FB40, 0xDFD5FB40, 0xDFD6FB40, 0xDFD7FB40, 0xDFD8FB40, 0xDFD9FB40, 0xDFDAFB40, 0xDFDBFB40, 0xDFDCFB40, 0xDFDDFB40, 0xDFDEFB40, 0xDFDFFB40,
+		0xDFE0FB40, 0xDFE1FB40, 0xDFE2FB40, 0xDFE3FB40, 0xDFE4FB40, 0xDFE5FB40, 0xDFE6FB40, 0xDFE7FB40, 0xDFE8FB40, 0xDFE9FB40, 0xDFEAFB40, 0xDFEBFB40, 0xDFECFB40, 0xDFEDFB40, 0xDFEEFB40, 0xDFEFFB40, 0xDFF0FB40, 0xDFF1FB40, 0xDFF2FB40, 0xDFF3FB40, 0xDFF4FB40, 0xDFF5FB40, 0xDFF6FB40, 0xDFF7FB40, 0xDFF8FB40, 0xDFF9FB40, 0xDFFAFB40, 0xDFFBFB40, 0xDFFCFB40, 0xDFFDFB40, 0xDFFEFB40, 0xDFFFFB40,
+		0xE000FB40, 0xE001FB40, 0xE002FB40, 0xE003FB40, 0xE004FB40, 0xE005FB40, 0xE006FB40, 0xE007FB40, 0xE008FB40, 0xE009FB40, 0xE00AFB40, 0xE00BFB40, 0xE00CFB40, 0xE00DFB40, 0xE00EFB40, 0xE00FFB40, 0xE010FB40, 0xE011FB40, 0xE012FB40, 0xE013FB40, 0xE014FB40, 0xE015FB40, 0xE016FB40, 0xE017FB40, 0xE018FB40, 0xE019FB40, 0xE01AFB40, 0xE01BFB40, 0xE01CFB40, 0xE01DFB40, 0xE01EFB40, 0xE01FFB40,
+		0xE020FB40, 0xE021FB40, 0xE022FB40, 0xE023FB40, 0xE024FB40, 0xE025FB40, 0xE026FB40, 0xE027FB40, 0xE028FB40, 0xE029FB40, 0xE02AFB40, 0xE02BFB40, 0xE02CFB40, 0xE02DFB40, 0xE02EFB40, 0xE02FFB40, 0xE030FB40, 0xE031FB40, 0xE032FB40, 0xE033FB40, 0xE034FB40, 0xE035FB40, 0xE036FB40, 0xE037FB40, 0xE038FB40, 0xE039FB40, 0xE03AFB40, 0xE03BFB40, 0xE03CFB40, 0xE03DFB40, 0xE03EFB40, 0xE03FFB40,
+		0xE040FB40, 0xE041FB40, 0xE042FB40, 0xE043FB40, 0xE044FB40, 0xE045FB40, 0xE046FB40, 0xE047FB40, 0xE048FB40, 0xE049FB40, 0xE04AFB40, 0xE04BFB40, 0xE04CFB40, 0xE04DFB40, 0xE04EFB40, 0xE04FFB40, 0xE050FB40, 0xE051FB40, 0xE052FB40, 0xE053FB40, 0xE054FB40, 0xE055FB40, 0xE056FB40, 0xE057FB40, 0xE058FB40, 0xE059FB40, 0xE05AFB40, 0xE05BFB40, 0xE05CFB40, 0xE05DFB40, 0xE05EFB40, 0xE05FFB40,
+		0xE060FB40, 0xE061FB40, 0xE062FB40, 0xE063FB40, 0xE064FB40, 0xE065FB40, 0xE066FB40, 0xE067FB40, 0xE068FB40, 0xE069FB40, 0xE06AFB40, 0xE06BFB40, 0xE06CFB40, 0xE06DFB40, 0xE06EFB40, 0xE06FFB40, 0xE070FB40, 0xE071FB40, 0xE072FB40, 0xE073FB40, 0xE074FB40, 0xE075FB40, 0xE076FB40, 0xE077FB40, 0xE078FB40, 0xE079FB40, 0xE07AFB40, 0xE07BFB40, 0xE07CFB40, 0xE07DFB40, 0xE07EFB40, 0xE07FFB40,
+		0xE080FB40, 0xE081FB40, 0xE082FB40, 0xE083FB40, 0xE084FB40, 0xE085FB40, 0xE086FB40, 0xE087FB40, 0xE088FB40, 0xE089FB40, 0xE08AFB40, 0xE08BFB40, 0xE08CFB40, 0xE08DFB40, 0xE08EFB40, 0xE08FFB40, 0xE090FB40, 0xE091FB40, 0xE092FB40, 0xE093FB40, 0xE094FB40, 0xE095FB40, 0xE096FB40, 0xE097FB40, 0xE098FB40, 0xE099FB40, 0xE09AFB40, 0xE09BFB40, 0xE09CFB40, 0xE09DFB40, 0xE09EFB40, 0xE09FFB40,
+		0xE0A0FB40, 0xE0A1FB40, 0xE0A2FB40, 0xE0A3FB40, 0xE0A4FB40, 0xE0A5FB40, 0xE0A6FB40, 0xE0A7FB40, 0xE0A8FB40, 0xE0A9FB40, 0xE0AAFB40, 0xE0ABFB40, 0xE0ACFB40, 0xE0ADFB40, 0xE0AEFB40, 0xE0AFFB40, 0xE0B0FB40, 0xE0B1FB40, 0xE0B2FB40, 0xE0B3FB40, 0xE0B4FB40, 0xE0B5FB40, 0xE0B6FB40, 0xE0B7FB40, 0xE0B8FB40, 0xE0B9FB40, 0xE0BAFB40, 0xE0BBFB40, 0xE0BCFB40, 0xE0BDFB40, 0xE0BEFB40, 0xE0BFFB40,
+		0xE0C0FB40, 0xE0C1FB40, 0xE0C2FB40, 0xE0C3FB40, 0xE0C4FB40, 0xE0C5FB40, 0xE0C6FB40, 0xE0C7FB40, 0xE0C8FB40, 0xE0C9FB40, 0xE0CAFB40, 0xE0CBFB40, 0xE0CCFB40, 0xE0CDFB40, 0xE0CEFB40, 0xE0CFFB40, 0xE0D0FB40, 0xE0D1FB40, 0xE0D2FB40, 0xE0D3FB40, 0xE0D4FB40, 0xE0D5FB40, 0xE0D6FB40, 0xE0D7FB40, 0xE0D8FB40, 0xE0D9FB40, 0xE0DAFB40, 0xE0DBFB40, 0xE0DCFB40, 0xE0DDFB40, 0xE0DEFB40, 0xE0DFFB40,
+		0xE0E0FB40, 0xE0E1FB40, 0xE0E2FB40, 0xE0E3FB40, 0xE0E4FB40, 0xE0E5FB40, 0xE0E6FB40, 0xE0E7FB40, 0xE0E8FB40, 0xE0E9FB40, 0xE0EAFB40, 0xE0EBFB40, 0xE0ECFB40, 0xE0EDFB40, 0xE0EEFB40, 0xE0EFFB40, 0xE0F0FB40, 0xE0F1FB40, 0xE0F2FB40, 0xE0F3FB40, 0xE0F4FB40, 0xE0F5FB40, 0xE0F6FB40, 0xE0F7FB40, 0xE0F8FB40, 0xE0F9FB40, 0xE0FAFB40, 0xE0FBFB40, 0xE0FCFB40, 0xE0FDFB40, 0xE0FEFB40, 0xE0FFFB40,
+		0xE100FB40, 0xE101FB40, 0xE102FB40, 0xE103FB40, 0xE104FB40, 0xE105FB40, 0xE106FB40, 0xE107FB40, 0xE108FB40, 0xE109FB40, 0xE10AFB40, 0xE10BFB40, 0xE10CFB40, 0xE10DFB40, 0xE10EFB40, 0xE10FFB40, 0xE110FB40, 0xE111FB40, 0xE112FB40, 0xE113FB40, 0xE114FB40, 0xE115FB40, 0xE116FB40, 0xE117FB40, 0xE118FB40, 0xE119FB40, 0xE11AFB40, 0xE11BFB40, 0xE11CFB40, 0xE11DFB40, 0xE11EFB40, 0xE11FFB40,
+		0xE120FB40, 0xE121FB40, 0xE122FB40, 0xE123FB40, 0xE124FB40, 0xE125FB40, 0xE126FB40, 0xE127FB40, 0xE128FB40, 0xE129FB40, 0xE12AFB40, 0xE12BFB40, 0xE12CFB40, 0xE12DFB40, 0xE12EFB40, 0xE12FFB40, 0xE130FB40, 0xE131FB40, 0xE132FB40, 0xE133FB40, 0xE134FB40, 0xE135FB40, 0xE136FB40, 0xE137FB40, 0xE138FB40, 0xE139FB40, 0xE13AFB40, 0xE13BFB40, 0xE13CFB40, 0xE13DFB40, 0xE13EFB40, 0xE13FFB40,
+		0xE140FB40, 0xE141FB40, 0xE142FB40, 0xE143FB40, 0xE144FB40, 0xE145FB40, 0xE146FB40, 0xE147FB40, 0xE148FB40, 0xE149FB40, 0xE14AFB40, 0xE14BFB40, 0xE14CFB40, 0xE14DFB40, 0xE14EFB40, 0xE14FFB40, 0xE150FB40, 0xE151FB40, 0xE152FB40, 0xE153FB40, 0xE154FB40, 0xE155FB40, 0xE156FB40, 0xE157FB40, 0xE158FB40, 0xE159FB40, 0xE15AFB40, 0xE15BFB40, 0xE15CFB40, 0xE15DFB40, 0xE15EFB40, 0xE15FFB40,
+		0xE160FB40, 0xE161FB40, 0xE162FB40, 0xE163FB40, 0xE164FB40, 0xE165FB40, 0xE166FB40, 0xE167FB40, 0xE168FB40, 0xE169FB40, 0xE16AFB40, 0xE16BFB40, 0xE16CFB40, 0xE16DFB40, 0xE16EFB40, 0xE16FFB40, 0xE170FB40, 0xE171FB40, 0xE172FB40, 0xE173FB40, 0xE174FB40, 0xE175FB40, 0xE176FB40, 0xE177FB40, 0xE178FB40, 0xE179FB40, 0xE17AFB40, 0xE17BFB40, 0xE17CFB40, 0xE17DFB40, 0xE17EFB40, 0xE17FFB40,
+		0xE180FB40, 0xE181FB40, 0xE182FB40, 0xE183FB40, 0xE184FB40, 0xE185FB40, 0xE186FB40, 0xE187FB40, 0xE188FB40, 0xE189FB40, 0xE18AFB40, 0xE18BFB40, 0xE18CFB40, 0xE18DFB40, 0xE18EFB40, 0xE18FFB40, 0xE190FB40, 0xE191FB40, 0xE192FB40, 0xE193FB40, 0xE194FB40, 0xE195FB40, 0xE196FB40, 0xE197FB40, 0xE198FB40, 0xE199FB40, 0xE19AFB40, 0xE19BFB40, 0xE19CFB40, 0xE19DFB40, 0xE19EFB40, 0xE19FFB40,
+		0xE1A0FB40, 0xE1A1FB40, 0xE1A2FB40, 0xE1A3FB40, 0xE1A4FB40, 0xE1A5FB40, 0xE1A6FB40, 0xE1A7FB40, 0xE1A8FB40, 0xE1A9FB40, 0xE1AAFB40, 0xE1ABFB40, 0xE1ACFB40, 0xE1ADFB40, 0xE1AEFB40, 0xE1AFFB40, 0xE1B0FB40, 0xE1B1FB40, 0xE1B2FB40, 0xE1B3FB40, 0xE1B4FB40, 0xE1B5FB40, 0xE1B6FB40, 0xE1B7FB40, 0xE1B8FB40, 0xE1B9FB40, 0xE1BAFB40, 0xE1BBFB40, 0xE1BCFB40, 0xE1BDFB40, 0xE1BEFB40, 0xE1BFFB40,
+		0xE1C0FB40, 0xE1C1FB40, 0xE1C2FB40, 0xE1C3FB40, 0xE1C4FB40, 0xE1C5FB40, 0xE1C6FB40, 0xE1C7FB40, 0xE1C8FB40, 0xE1C9FB40, 0xE1CAFB40, 0xE1CBFB40, 0xE1CCFB40, 0xE1CDFB40, 0xE1CEFB40, 0xE1CFFB40, 0xE1D0FB40, 0xE1D1FB40, 0xE1D2FB40, 0xE1D3FB40, 0xE1D4FB40, 0xE1D5FB40, 0xE1D6FB40, 0xE1D7FB40, 0xE1D8FB40, 0xE1D9FB40, 0xE1DAFB40, 0xE1DBFB40, 0xE1DCFB40, 0xE1DDFB40, 0xE1DEFB40, 0xE1DFFB40,
+		0xE1E0FB40, 0xE1E1FB40, 0xE1E2FB40, 0xE1E3FB40, 0xE1E4FB40, 0xE1E5FB40, 0xE1E6FB40, 0xE1E7FB40, 0xE1E8FB40, 0xE1E9FB40, 0xE1EAFB40, 0xE1EBFB40, 0xE1ECFB40, 0xE1EDFB40, 0xE1EEFB40, 0xE1EFFB40, 0xE1F0FB40, 0xE1F1FB40, 0xE1F2FB40, 0xE1F3FB40, 0xE1F4FB40, 0xE1F5FB40, 0xE1F6FB40, 0xE1F7FB40, 0xE1F8FB40, 0xE1F9FB40, 0xE1FAFB40, 0xE1FBFB40, 0xE1FCFB40, 0xE1FDFB40, 0xE1FEFB40, 0xE1FFFB40,
+		0xE200FB40, 0xE201FB40, 0xE202FB40, 0xE203FB40, 0xE204FB40, 0xE205FB40, 0xE206FB40, 0xE207FB40, 0xE208FB40, 0xE209FB40, 0xE20AFB40, 0xE20BFB40, 0xE20CFB40, 0xE20DFB40, 0xE20EFB40, 0xE20FFB40, 0xE210FB40, 0xE211FB40, 0xE212FB40, 0xE213FB40, 0xE214FB40, 0xE215FB40, 0xE216FB40, 0xE217FB40, 0xE218FB40, 0xE219FB40, 0xE21AFB40, 0xE21BFB40, 0xE21CFB40, 0xE21DFB40, 0xE21EFB40, 0xE21FFB40,
+		0xE220FB40, 0xE221FB40, 0xE222FB40, 0xE223FB40, 0xE224FB40, 0xE225FB40, 0xE226FB40, 0xE227FB40, 0xE228FB40, 0xE229FB40, 0xE22AFB40, 0xE22BFB40, 0xE22CFB40, 0xE22DFB40, 0xE22EFB40, 0xE22FFB40, 0xE230FB40, 0xE231FB40, 0xE232FB40, 0xE233FB40, 0xE234FB40, 0xE235FB40, 0xE236FB40, 0xE237FB40, 0xE238FB40, 0xE239FB40, 0xE23AFB40, 0xE23BFB40, 0xE23CFB40, 0xE23DFB40, 0xE23EFB40, 0xE23FFB40,
+		0xE240FB40, 0xE241FB40, 0xE242FB40, 0xE243FB40, 0xE244FB40, 0xE245FB40, 0xE246FB40, 0xE247FB40, 0xE248FB40, 0xE249FB40, 0xE24AFB40, 0xE24BFB40, 0xE24CFB40, 0xE24DFB40, 0xE24EFB40, 0xE24FFB40, 0xE250FB40, 0xE251FB40, 0xE252FB40, 0xE253FB40, 0xE254FB40, 0xE255FB40, 0xE256FB40, 0xE257FB40, 0xE258FB40, 0xE259FB40, 0xE25AFB40, 0xE25BFB40, 0xE25CFB40, 0xE25DFB40, 0xE25EFB40, 0xE25FFB40,
+		0xE260FB40, 0xE261FB40, 0xE262FB40, 0xE263FB40, 0xE264FB40, 0xE265FB40, 0xE266FB40, 0xE267FB40, 0xE268FB40, 0xE269FB40, 0xE26AFB40, 0xE26BFB40, 0xE26CFB40, 0xE26DFB40, 0xE26EFB40, 0xE26FFB40, 0xE270FB40, 0xE271FB40, 0xE272FB40, 0xE273FB40, 0xE274FB40, 0xE275FB40, 0xE276FB40, 0xE277FB40, 0xE278FB40, 0xE279FB40, 0xE27AFB40, 0xE27BFB40, 0xE27CFB40, 0xE27DFB40, 0xE27EFB40, 0xE27FFB40,
+		0xE280FB40, 0xE281FB40, 0xE282FB40, 0xE283FB40, 0xE284FB40, 0xE285FB40, 0xE286FB40, 0xE287FB40, 0xE288FB40, 0xE289FB40, 0xE28AFB40, 0xE28BFB40, 0xE28CFB40, 0xE28DFB40, 0xE28EFB40, 0xE28FFB40, 0xE290FB40, 0xE291FB40, 0xE292FB40, 0xE293FB40, 0xE294FB40, 0xE295FB40, 0xE296FB40, 0xE297FB40, 0xE298FB40, 0xE299FB40, 0xE29AFB40, 0xE29BFB40, 0xE29CFB40, 0xE29DFB40, 0xE29EFB40, 0xE29FFB40,
+		0xE2A0FB40, 0xE2A1FB40, 0xE2A2FB40, 0xE2A3FB40, 0xE2A4FB40, 0xE2A5FB40, 0xE2A6FB40, 0xE2A7FB40, 0xE2A8FB40, 0xE2A9FB40, 0xE2AAFB40, 0xE2ABFB40, 0xE2ACFB40, 0xE2ADFB40, 0xE2AEFB40, 0xE2AFFB40, 0xE2B0FB40, 0xE2B1FB40, 0xE2B2FB40, 0xE2B3FB40, 0xE2B4FB40, 0xE2B5FB40, 0xE2B6FB40, 0xE2B7FB40, 0xE2B8FB40, 0xE2B9FB40, 0xE2BAFB40, 0xE2BBFB40, 0xE2BCFB40, 0xE2BDFB40, 0xE2BEFB40, 0xE2BFFB40,
+		0xE2C0FB40, 0xE2C1FB40, 0xE2C2FB40, 0xE2C3FB40, 0xE2C4FB40, 0xE2C5FB40, 0xE2C6FB40, 0xE2C7FB40, 0xE2C8FB40, 0xE2C9FB40, 0xE2CAFB40, 0xE2CBFB40, 0xE2CCFB40, 0xE2CDFB40, 0xE2CEFB40, 0xE2CFFB40, 0xE2D0FB40, 0xE2D1FB40, 0xE2D2FB40, 0xE2D3FB40, 0xE2D4FB40, 0xE2D5FB40, 0xE2D6FB40, 0xE2D7FB40, 0xE2D8FB40, 0xE2D9FB40, 0xE2DAFB40, 0xE2DBFB40, 0xE2DCFB40, 0xE2DDFB40, 0xE2DEFB40, 0xE2DFFB40,
+		0xE2E0FB40, 0xE2E1FB40, 0xE2E2FB40, 0xE2E3FB40, 0xE2E4FB40, 0xE2E5FB40, 0xE2E6FB40, 0xE2E7FB40, 0xE2E8FB40, 0xE2E9FB40, 0xE2EAFB40, 0xE2EBFB40, 0xE2ECFB40, 0xE2EDFB40, 0xE2EEFB40, 0xE2EFFB40, 0xE2F0FB40, 0xE2F1FB40, 0xE2F2FB40, 0xE2F3FB40, 0xE2F4FB40, 0xE2F5FB40, 0xE2F6FB40, 0xE2F7FB40, 0xE2F8FB40, 0xE2F9FB40, 0xE2FAFB40, 0xE2FBFB40, 0xE2FCFB40, 0xE2FDFB40, 0xE2FEFB40, 0xE2FFFB40,
+		0xE300FB40, 0xE301FB40, 0xE302FB40, 0xE303FB40, 0xE304FB40, 0xE305FB40, 0xE306FB40, 0xE307FB40, 0xE308FB40, 0xE309FB40, 0xE30AFB40, 0xE30BFB40, 0xE30CFB40, 0xE30DFB40, 0xE30EFB40, 0xE30FFB40, 0xE310FB40, 0xE311FB40, 0xE312FB40, 0xE313FB40, 0xE314FB40, 0xE315FB40, 0xE316FB40, 0xE317FB40, 0xE318FB40, 0xE319FB40, 0xE31AFB40, 0xE31BFB40, 0xE31CFB40, 0xE31DFB40, 0xE31EFB40, 0xE31FFB40,
+		0xE320FB40, 0xE321FB40, 0xE322FB40, 0xE323FB40, 0xE324FB40, 0xE325FB40, 0xE326FB40, 0xE327FB40, 0xE328FB40, 0xE329FB40, 0xE32AFB40, 0xE32BFB40, 0xE32CFB40, 0xE32DFB40, 0xE32EFB40, 0xE32FFB40, 0xE330FB40, 0xE331FB40, 0xE332FB40, 0xE333FB40, 0xE334FB40, 0xE335FB40, 0xE336FB40, 0xE337FB40, 0xE338FB40, 0xE339FB40, 0xE33AFB40, 0xE33BFB40, 0xE33CFB40, 0xE33DFB40, 0xE33EFB40, 0xE33FFB40,
+		0xE340FB40, 0xE341FB40, 0xE342FB40, 0xE343FB40, 0xE344FB40, 0xE345FB40, 0xE346FB40, 0xE347FB40, 0xE348FB40, 0xE349FB40, 0xE34AFB40, 0xE34BFB40, 0xE34CFB40, 0xE34DFB40, 0xE34EFB40, 0xE34FFB40, 0xE350FB40, 0xE351FB40, 0xE352FB40, 0xE353FB40, 0xE354FB40, 0xE355FB40, 0xE356FB40, 0xE357FB40, 0xE358FB40, 0xE359FB40, 0xE35AFB40, 0xE35BFB40, 0xE35CFB40, 0xE35DFB40, 0xE35EFB40, 0xE35FFB40,
+		0xE360FB40, 0xE361FB40, 0xE362FB40, 0xE363FB40, 0xE364FB40, 0xE365FB40, 0xE366FB40, 0xE367FB40, 0xE368FB40, 0xE369FB40, 0xE36AFB40, 0xE36BFB40, 0xE36CFB40, 0xE36DFB40, 0xE36EFB40, 0xE36FFB40, 0xE370FB40, 0xE371FB40, 0xE372FB40, 0xE373FB40, 0xE374FB40, 0xE375FB40, 0xE376FB40, 0xE377FB40, 0xE378FB40, 0xE379FB40, 0xE37AFB40, 0xE37BFB40, 0xE37CFB40, 0xE37DFB40, 0xE37EFB40, 0xE37FFB40,
+		0xE380FB40, 0xE381FB40, 0xE382FB40, 0xE383FB40, 0xE384FB40, 0xE385FB40, 0xE386FB40, 0xE387FB40, 0xE388FB40, 0xE389FB40, 0xE38AFB40, 0xE38BFB40, 0xE38CFB40, 0xE38DFB40, 0xE38EFB40, 0xE38FFB40, 0xE390FB40, 0xE391FB40, 0xE392FB40, 0xE393FB40, 0xE394FB40, 0xE395FB40, 0xE396FB40, 0xE397FB40, 0xE398FB40, 0xE399FB40, 0xE39AFB40, 0xE39BFB40, 0xE39CFB40, 0xE39DFB40, 0xE39EFB40, 0xE39FFB40,
+		0xE3A0FB40, 0xE3A1FB40, 0xE3A2FB40, 0xE3A3FB40, 0xE3A4FB40, 0xE3A5FB40, 0xE3A6FB40, 0xE3A7FB40, 0xE3A8FB40, 0xE3A9FB40, 0xE3AAFB40, 0xE3ABFB40, 0xE3ACFB40, 0xE3ADFB40, 0xE3AEFB40, 0xE3AFFB40, 0xE3B0FB40, 0xE3B1FB40, 0xE3B2FB40, 0xE3B3FB40, 0xE3B4FB40, 0xE3B5FB40, 0xE3B6FB40, 0xE3B7FB40, 0xE3B8FB40, 0xE3B9FB40, 0xE3BAFB40, 0xE3BBFB40, 0xE3BCFB40, 0xE3BDFB40, 0xE3BEFB40, 0xE3BFFB40,
+		0xE3C0FB40, 0xE3C1FB40, 0xE3C2FB40, 0xE3C3FB40, 0xE3C4FB40, 0xE3C5FB40, 0xE3C6FB40, 0xE3C7FB40, 0xE3C8FB40, 0xE3C9FB40, 0xE3CAFB40, 0xE3CBFB40, 0xE3CCFB40, 0xE3CDFB40, 0xE3CEFB40, 0xE3CFFB40, 0xE3D0FB40, 0xE3D1FB40, 0xE3D2FB40, 0xE3D3FB40, 0xE3D4FB40, 0xE3D5FB40, 0xE3D6FB40, 0xE3D7FB40, 0xE3D8FB40, 0xE3D9FB40, 0xE3DAFB40, 0xE3DBFB40, 0xE3DCFB40, 0xE3DDFB40, 0xE3DEFB40, 0xE3DFFB40,
+		0xE3E0FB40, 0xE3E1FB40, 0xE3E2FB40, 0xE3E3FB40, 0xE3E4FB40, 0xE3E5FB40, 0xE3E6FB40, 0xE3E7FB40, 0xE3E8FB40, 0xE3E9FB40, 0xE3EAFB40, 0xE3EBFB40, 0xE3ECFB40, 0xE3EDFB40, 0xE3EEFB40, 0xE3EFFB40, 0xE3F0FB40, 0xE3F1FB40, 0xE3F2FB40, 0xE3F3FB40, 0xE3F4FB40, 0xE3F5FB40, 0xE3F6FB40, 0xE3F7FB40, 0xE3F8FB40, 0xE3F9FB40, 0xE3FAFB40, 0xE3FBFB40, 0xE3FCFB40, 0xE3FDFB40, 0xE3FEFB40, 0xE3FFFB40,
+		0xE400FB40, 0xE401FB40, 0xE402FB40, 0xE403FB40, 0xE404FB40, 0xE405FB40, 0xE406FB40, 0xE407FB40, 0xE408FB40, 0xE409FB40, 0xE40AFB40, 0xE40BFB40, 0xE40CFB40, 0xE40DFB40, 0xE40EFB40, 0xE40FFB40, 0xE410FB40, 0xE411FB40, 0xE412FB40, 0xE413FB40, 0xE414FB40, 0xE415FB40, 0xE416FB40, 0xE417FB40, 0xE418FB40, 0xE419FB40, 0xE41AFB40, 0xE41BFB40, 0xE41CFB40, 0xE41DFB40, 0xE41EFB40, 0xE41FFB40,
+		0xE420FB40, 0xE421FB40, 0xE422FB40, 0xE423FB40, 0xE424FB40, 0xE425FB40, 0xE426FB40, 0xE427FB40, 0xE428FB40, 0xE429FB40, 0xE42AFB40, 0xE42BFB40, 0xE42CFB40, 0xE42DFB40, 0xE42EFB40, 0xE42FFB40, 0xE430FB40, 0xE431FB40, 0xE432FB40, 0xE433FB40, 0xE434FB40, 0xE435FB40, 0xE436FB40, 0xE437FB40, 0xE438FB40, 0xE439FB40, 0xE43AFB40, 0xE43BFB40, 0xE43CFB40, 0xE43DFB40, 0xE43EFB40, 0xE43FFB40,
+		0xE440FB40, 0xE441FB40, 0xE442FB40, 0xE443FB40, 0xE444FB40, 0xE445FB40, 0xE446FB40, 0xE447FB40, 0xE448FB40, 0xE449FB40, 0xE44AFB40, 0xE44BFB40, 0xE44CFB40, 0xE44DFB40, 0xE44EFB40, 0xE44FFB40, 0xE450FB40, 0xE451FB40, 0xE452FB40, 0xE453FB40, 0xE454FB40, 0xE455FB40, 0xE456FB40, 0xE457FB40, 0xE458FB40, 0xE459FB40, 0xE45AFB40, 0xE45BFB40, 0xE45CFB40, 0xE45DFB40, 0xE45EFB40, 0xE45FFB40,
+		0xE460FB40, 0xE461FB40, 0xE462FB40, 0xE463FB40, 0xE464FB40, 0xE465FB40, 0xE466FB40, 0xE467FB40, 0xE468FB40, 0xE469FB40, 0xE46AFB40, 0xE46BFB40, 0xE46CFB40, 0xE46DFB40, 0xE46EFB40, 0xE46FFB40, 0xE470FB40, 0xE471FB40, 0xE472FB40, 0xE473FB40, 0xE474FB40, 0xE475FB40, 0xE476FB40, 0xE477FB40, 0xE478FB40, 0xE479FB40, 0xE47AFB40, 0xE47BFB40, 0xE47CFB40, 0xE47DFB40, 0xE47EFB40, 0xE47FFB40,
+		0xE480FB40, 0xE481FB40, 0xE482FB40, 0xE483FB40, 0xE484FB40, 0xE485FB40, 0xE486FB40, 0xE487FB40, 0xE488FB40, 0xE489FB40, 0xE48AFB40, 0xE48BFB40, 0xE48CFB40, 0xE48DFB40, 0xE48EFB40, 0xE48FFB40, 0xE490FB40, 0xE491FB40, 0xE492FB40, 0xE493FB40, 0xE494FB40, 0xE495FB40, 0xE496FB40, 0xE497FB40, 0xE498FB40, 0xE499FB40, 0xE49AFB40, 0xE49BFB40, 0xE49CFB40, 0xE49DFB40, 0xE49EFB40, 0xE49FFB40,
+		0xE4A0FB40, 0xE4A1FB40, 0xE4A2FB40, 0xE4A3FB40, 0xE4A4FB40, 0xE4A5FB40, 0xE4A6FB40, 0xE4A7FB40, 0xE4A8FB40, 0xE4A9FB40, 0xE4AAFB40, 0xE4ABFB40, 0xE4ACFB40, 0xE4ADFB40, 0xE4AEFB40, 0xE4AFFB40, 0xE4B0FB40, 0xE4B1FB40, 0xE4B2FB40, 0xE4B3FB40, 0xE4B4FB40, 0xE4B5FB40, 0xE4B6FB40, 0xE4B7FB40, 0xE4B8FB40, 0xE4B9FB40, 0xE4BAFB40, 0xE4BBFB40, 0xE4BCFB40, 0xE4BDFB40, 0xE4BEFB40, 0xE4BFFB40,
+		0xE4C0FB40, 0xE4C1FB40, 0xE4C2FB40, 0xE4C3FB40, 0xE4C4FB40, 0xE4C5FB40, 0xE4C6FB40, 0xE4C7FB40, 0xE4C8FB40, 0xE4C9FB40, 0xE4CAFB40, 0xE4CBFB40, 0xE4CCFB40, 0xE4CDFB40, 0xE4CEFB40, 0xE4CFFB40, 0xE4D0FB40, 0xE4D1FB40, 0xE4D2FB40, 0xE4D3FB40, 0xE4D4FB40, 0xE4D5FB40, 0xE4D6FB40, 0xE4D7FB40, 0xE4D8FB40, 0xE4D9FB40, 0xE4DAFB40, 0xE4DBFB40, 0xE4DCFB40, 0xE4DDFB40, 0xE4DEFB40, 0xE4DFFB40,
+		0xE4E0FB40, 0xE4E1FB40, 0xE4E2FB40, 0xE4E3FB40, 0xE4E4FB40, 0xE4E5FB40, 0xE4E6FB40, 0xE4E7FB40, 0xE4E8FB40, 0xE4E9FB40, 0xE4EAFB40, 0xE4EBFB40, 0xE4ECFB40, 0xE4EDFB40, 0xE4EEFB40, 0xE4EFFB40, 0xE4F0FB40, 0xE4F1FB40, 0xE4F2FB40, 0xE4F3FB40, 0xE4F4FB40, 0xE4F5FB40, 0xE4F6FB40, 0xE4F7FB40, 0xE4F8FB40, 0xE4F9FB40, 0xE4FAFB40, 0xE4FBFB40, 0xE4FCFB40, 0xE4FDFB40, 0xE4FEFB40, 0xE4FFFB40,
+		0xE500FB40, 0xE501FB40, 0xE502FB40, 0xE503FB40, 0xE504FB40, 0xE505FB40, 0xE506FB40, 0xE507FB40, 0xE508FB40, 0xE509FB40, 0xE50AFB40, 0xE50BFB40, 0xE50CFB40, 0xE50DFB40, 0xE50EFB40, 0xE50FFB40, 0xE510FB40, 0xE511FB40, 0xE512FB40, 0xE513FB40, 0xE514FB40, 0xE515FB40, 0xE516FB40, 0xE517FB40, 0xE518FB40, 0xE519FB40, 0xE51AFB40, 0xE51BFB40, 0xE51CFB40, 0xE51DFB40, 0xE51EFB40, 0xE51FFB40,
+		0xE520FB40, 0xE521FB40, 0xE522FB40, 0xE523FB40, 0xE524FB40, 0xE525FB40, 0xE526FB40, 0xE527FB40, 0xE528FB40, 0xE529FB40, 0xE52AFB40, 0xE52BFB40, 0xE52CFB40, 0xE52DFB40, 0xE52EFB40, 0xE52FFB40, 0xE530FB40, 0xE531FB40, 0xE532FB40, 0xE533FB40, 0xE534FB40, 0xE535FB40, 0xE536FB40, 0xE537FB40, 0xE538FB40, 0xE539FB40, 0xE53AFB40, 0xE53BFB40, 0xE53CFB40, 0xE53DFB40, 0xE53EFB40, 0xE53FFB40,
+		0xE540FB40, 0xE541FB40, 0xE542FB40, 0xE543FB40, 0xE544FB40, 0xE545FB40, 0xE546FB40, 0xE547FB40, 0xE548FB40, 0xE549FB40, 0xE54AFB40, 0xE54BFB40, 0xE54CFB40, 0xE54DFB40, 0xE54EFB40, 0xE54FFB40, 0xE550FB40, 0xE551FB40, 0xE552FB40, 0xE553FB40, 0xE554FB40, 0xE555FB40, 0xE556FB40, 0xE557FB40, 0xE558FB40, 0xE559FB40, 0xE55AFB40, 0xE55BFB40, 0xE55CFB40, 0xE55DFB40, 0xE55EFB40, 0xE55FFB40,
+		0xE560FB40, 0xE561FB40, 0xE562FB40, 0xE563FB40, 0xE564FB40, 0xE565FB40, 0xE566FB40, 0xE567FB40, 0xE568FB40, 0xE569FB40, 0xE56AFB40, 0xE56BFB40, 0xE56CFB40, 0xE56DFB40, 0xE56EFB40, 0xE56FFB40, 0xE570FB40, 0xE571FB40, 0xE572FB40, 0xE573FB40, 0xE574FB40, 0xE575FB40, 0xE576FB40, 0xE577FB40, 0xE578FB40, 0xE579FB40, 0xE57AFB40, 0xE57BFB40, 0xE57CFB40, 0xE57DFB40, 0xE57EFB40, 0xE57FFB40,
+		0xE580FB40, 0xE581FB40, 0xE582FB40, 0xE583FB40, 0xE584FB40, 0xE585FB40, 0xE586FB40, 0xE587FB40, 0xE588FB40, 0xE589FB40, 0xE58AFB40, 0xE58BFB40, 0xE58CFB40, 0xE58DFB40, 0xE58EFB40, 0xE58FFB40, 0xE590FB40, 0xE591FB40, 0xE592FB40, 0xE593FB40, 0xE594FB40, 0xE595FB40, 0xE596FB40, 0xE597FB40, 0xE598FB40, 0xE599FB40, 0xE59AFB40, 0xE59BFB40, 0xE59CFB40, 0xE59DFB40, 0xE59EFB40, 0xE59FFB40,
+		0xE5A0FB40, 0xE5A1FB40, 0xE5A2FB40, 0xE5A3FB40, 0xE5A4FB40, 0xE5A5FB40, 0xE5A6FB40, 0xE5A7FB40, 0xE5A8FB40, 0xE5A9FB40, 0xE5AAFB40, 0xE5ABFB40, 0xE5ACFB40, 0xE5ADFB40, 0xE5AEFB40, 0xE5AFFB40, 0xE5B0FB40, 0xE5B1FB40, 0xE5B2FB40, 0xE5B3FB40, 0xE5B4FB40, 0xE5B5FB40, 0xE5B6FB40, 0xE5B7FB40, 0xE5B8FB40, 0xE5B9FB40, 0xE5BAFB40, 0xE5BBFB40, 0xE5BCFB40, 0xE5BDFB40, 0xE5BEFB40, 0xE5BFFB40,
+		0xE5C0FB40, 0xE5C1FB40, 0xE5C2FB40, 0xE5C3FB40, 0xE5C4FB40, 0xE5C5FB40, 0xE5C6FB40, 0xE5C7FB40, 0xE5C8FB40, 0xE5C9FB40, 0xE5CAFB40, 0xE5CBFB40, 0xE5CCFB40, 0xE5CDFB40, 0xE5CEFB40, 0xE5CFFB40, 0xE5D0FB40, 0xE5D1FB40, 0xE5D2FB40, 0xE5D3FB40, 0xE5D4FB40, 0xE5D5FB40, 0xE5D6FB40, 0xE5D7FB40, 0xE5D8FB40, 0xE5D9FB40, 0xE5DAFB40, 0xE5DBFB40, 0xE5DCFB40, 0xE5DDFB40, 0xE5DEFB40, 0xE5DFFB40,
+		0xE5E0FB40, 0xE5E1FB40, 0xE5E2FB40, 0xE5E3FB40, 0xE5E4FB40, 0xE5E5FB40, 0xE5E6FB40, 0xE5E7FB40, 0xE5E8FB40, 0xE5E9FB40, 0xE5EAFB40, 0xE5EBFB40, 0xE5ECFB40, 0xE5EDFB40, 0xE5EEFB40, 0xE5EFFB40, 0xE5F0FB40, 0xE5F1FB40, 0xE5F2FB40, 0xE5F3FB40, 0xE5F4FB40, 0xE5F5FB40, 0xE5F6FB40, 0xE5F7FB40, 0xE5F8FB40, 0xE5F9FB40, 0xE5FAFB40, 0xE5FBFB40, 0xE5FCFB40, 0xE5FDFB40, 0xE5FEFB40, 0xE5FFFB40,
+		0xE600FB40, 0xE601FB40, 0xE602FB40, 0xE603FB40, 0xE604FB40, 0xE605FB40, 0xE606FB40, 0xE607FB40, 0xE608FB40, 0xE609FB40, 0xE60AFB40, 0xE60BFB40, 0xE60CFB40, 0xE60DFB40, 0xE60EFB40, 0xE60FFB40, 0xE610FB40, 0xE611FB40, 0xE612FB40, 0xE613FB40, 0xE614FB40, 0xE615FB40, 0xE616FB40, 0xE617FB40, 0xE618FB40, 0xE619FB40, 0xE61AFB40, 0xE61BFB40, 0xE61CFB40, 0xE61DFB40, 0xE61EFB40, 0xE61FFB40,
+		0xE620FB40, 0xE621FB40, 0xE622FB40, 0xE623FB40, 0xE624FB40, 0xE625FB40, 0xE626FB40, 0xE627FB40, 0xE628FB40, 0xE629FB40, 0xE62AFB40, 0xE62BFB40, 0xE62CFB40, 0xE62DFB40, 0xE62EFB40, 0xE62FFB40, 0xE630FB40, 0xE631FB40, 0xE632FB40, 0xE633FB40, 0xE634FB40, 0xE635FB40, 0xE636FB40, 0xE637FB40, 0xE638FB40, 0xE639FB40, 0xE63AFB40, 0xE63BFB40, 0xE63CFB40, 0xE63DFB40, 0xE63EFB40, 0xE63FFB40,
+		0xE640FB40, 0xE641FB40, 0xE642FB40, 0xE643FB40, 0xE644FB40, 0xE645FB40, 0xE646FB40, 0xE647FB40, 0xE648FB40, 0xE649FB40, 0xE64AFB40, 0xE64BFB40, 0xE64CFB40, 0xE64DFB40, 0xE64EFB40, 0xE64FFB40, 0xE650FB40, 0xE651FB40, 0xE652FB40, 0xE653FB40, 0xE654FB40, 0xE655FB40, 0xE656FB40, 0xE657FB40, 0xE658FB40, 0xE659FB40, 0xE65AFB40, 0xE65BFB40, 0xE65CFB40, 0xE65DFB40, 0xE65EFB40, 0xE65FFB40,
+		0xE660FB40, 0xE661FB40, 0xE662FB40, 0xE663FB40, 0xE664FB40, 0xE665FB40, 0xE666FB40, 0xE667FB40, 0xE668FB40, 0xE669FB40, 0xE66AFB40, 0xE66BFB40, 0xE66CFB40, 0xE66DFB40, 0xE66EFB40, 0xE66FFB40, 0xE670FB40, 0xE671FB40, 0xE672FB40, 0xE673FB40, 0xE674FB40, 0xE675FB40, 0xE676FB40, 0xE677FB40, 0xE678FB40, 0xE679FB40, 0xE67AFB40, 0xE67BFB40, 0xE67CFB40, 0xE67DFB40, 0xE67EFB40, 0xE67FFB40,
+		0xE680FB40, 0xE681FB40, 0xE682FB40, 0xE683FB40, 0xE684FB40, 0xE685FB40, 0xE686FB40, 0xE687FB40, 0xE688FB40, 0xE689FB40, 0xE68AFB40, 0xE68BFB40, 0xE68CFB40, 0xE68DFB40, 0xE68EFB40, 0xE68FFB40, 0xE690FB40, 0xE691FB40, 0xE692FB40, 0xE693FB40, 0xE694FB40, 0xE695FB40, 0xE696FB40, 0xE697FB40, 0xE698FB40, 0xE699FB40, 0xE69AFB40, 0xE69BFB40, 0xE69CFB40, 0xE69DFB40, 0xE69EFB40, 0xE69FFB40,
+		0xE6A0FB40, 0xE6A1FB40, 0xE6A2FB40, 0xE6A3FB40, 0xE6A4FB40, 0xE6A5FB40, 0xE6A6FB40, 0xE6A7FB40, 0xE6A8FB40, 0xE6A9FB40, 0xE6AAFB40, 0xE6ABFB40, 0xE6ACFB40, 0xE6ADFB40, 0xE6AEFB40, 0xE6AFFB40, 0xE6B0FB40, 0xE6B1FB40, 0xE6B2FB40, 0xE6B3FB40, 0xE6B4FB40, 0xE6B5FB40, 0xE6B6FB40, 0xE6B7FB40, 0xE6B8FB40, 0xE6B9FB40, 0xE6BAFB40, 0xE6BBFB40, 0xE6BCFB40, 0xE6BDFB40, 0xE6BEFB40, 0xE6BFFB40,
+		0xE6C0FB40, 0xE6C1FB40, 0xE6C2FB40, 0xE6C3FB40, 0xE6C4FB40, 0xE6C5FB40, 0xE6C6FB40, 0xE6C7FB40, 0xE6C8FB40, 0xE6C9FB40, 0xE6CAFB40, 0xE6CBFB40, 0xE6CCFB40, 0xE6CDFB40, 0xE6CEFB40, 0xE6CFFB40, 0xE6D0FB40, 0xE6D1FB40, 0xE6D2FB40, 0xE6D3FB40, 0xE6D4FB40, 0xE6D5FB40, 0xE6D6FB40, 0xE6D7FB40, 0xE6D8FB40, 0xE6D9FB40, 0xE6DAFB40, 0xE6DBFB40, 0xE6DCFB40, 0xE6DDFB40, 0xE6DEFB40, 0xE6DFFB40,
+		0xE6E0FB40, 0xE6E1FB40, 0xE6E2FB40, 0xE6E3FB40, 0xE6E4FB40, 0xE6E5FB40, 0xE6E6FB40, 0xE6E7FB40, 0xE6E8FB40, 0xE6E9FB40, 0xE6EAFB40, 0xE6EBFB40, 0xE6ECFB40, 0xE6EDFB40, 0xE6EEFB40, 0xE6EFFB40, 0xE6F0FB40, 0xE6F1FB40, 0xE6F2FB40, 0xE6F3FB40, 0xE6F4FB40, 0xE6F5FB40, 0xE6F6FB40, 0xE6F7FB40, 0xE6F8FB40, 0xE6F9FB40, 0xE6FAFB40, 0xE6FBFB40, 0xE6FCFB40, 0xE6FDFB40, 0xE6FEFB40, 0xE6FFFB40,
+		0xE700FB40, 0xE701FB40, 0xE702FB40, 0xE703FB40, 0xE704FB40, 0xE705FB40, 0xE706FB40, 0xE707FB40, 0xE708FB40, 0xE709FB40, 0xE70AFB40, 0xE70BFB40, 0xE70CFB40, 0xE70DFB40, 0xE70EFB40, 0xE70FFB40, 0xE710FB40, 0xE711FB40, 0xE712FB40, 0xE713FB40, 0xE714FB40, 0xE715FB40, 0xE716FB40, 0xE717FB40, 0xE718FB40, 0xE719FB40, 0xE71AFB40, 0xE71BFB40, 0xE71CFB40, 0xE71DFB40, 0xE71EFB40, 0xE71FFB40,
+		0xE720FB40, 0xE721FB40, 0xE722FB40, 0xE723FB40, 0xE724FB40, 0xE725FB40, 0xE726FB40, 0xE727FB40, 0xE728FB40, 0xE729FB40, 0xE72AFB40, 0xE72BFB40, 0xE72CFB40, 0xE72DFB40, 0xE72EFB40, 0xE72FFB40, 0xE730FB40, 0xE731FB40, 0xE732FB40, 0xE733FB40, 0xE734FB40, 0xE735FB40, 0xE736FB40, 0xE737FB40, 0xE738FB40, 0xE739FB40, 0xE73AFB40, 0xE73BFB40, 0xE73CFB40, 0xE73DFB40, 0xE73EFB40, 0xE73FFB40,
+		0xE740FB40, 0xE741FB40, 0xE742FB40, 0xE743FB40, 0xE744FB40, 0xE745FB40, 0xE746FB40, 0xE747FB40, 0xE748FB40, 0xE749FB40, 0xE74AFB40, 0xE74BFB40, 0xE74CFB40, 0xE74DFB40, 0xE74EFB40, 0xE74FFB40, 0xE750FB40, 0xE751FB40, 0xE752FB40, 0xE753FB40, 0xE754FB40, 0xE755FB40, 0xE756FB40, 0xE757FB40, 0xE758FB40, 0xE759FB40, 0xE75AFB40, 0xE75BFB40, 0xE75CFB40, 0xE75DFB40, 0xE75EFB40, 0xE75FFB40,
+		0xE760FB40, 0xE761FB40, 0xE762FB40, 0xE763FB40, 0xE764FB40, 0xE765FB40, 0xE766FB40, 0xE767FB40, 0xE768FB40, 0xE769FB40, 0xE76AFB40, 0xE76BFB40, 0xE76CFB40, 0xE76DFB40, 0xE76EFB40, 0xE76FFB40, 0xE770FB40, 0xE771FB40, 0xE772FB40, 0xE773FB40, 0xE774FB40, 0xE775FB40, 0xE776FB40, 0xE777FB40, 0xE778FB40, 0xE779FB40, 0xE77AFB40, 0xE77BFB40, 0xE77CFB40, 0xE77DFB40, 0xE77EFB40, 0xE77FFB40,
+		0xE780FB40, 0xE781FB40, 0xE782FB40, 0xE783FB40, 0xE784FB40, 0xE785FB40, 0xE786FB40, 0xE787FB40, 0xE788FB40, 0xE789FB40, 0xE78AFB40, 0xE78BFB40, 0xE78CFB40, 0xE78DFB40, 0xE78EFB40, 0xE78FFB40, 0xE790FB40, 0xE791FB40, 0xE792FB40, 0xE793FB40, 0xE794FB40, 0xE795FB40, 0xE796FB40, 0xE797FB40, 0xE798FB40, 0xE799FB40, 0xE79AFB40, 0xE79BFB40, 0xE79CFB40, 0xE79DFB40, 0xE79EFB40, 0xE79FFB40,
+		0xE7A0FB40, 0xE7A1FB40, 0xE7A2FB40, 0xE7A3FB40, 0xE7A4FB40, 0xE7A5FB40, 0xE7A6FB40, 0xE7A7FB40, 0xE7A8FB40, 0xE7A9FB40, 0xE7AAFB40, 0xE7ABFB40, 0xE7ACFB40, 0xE7ADFB40, 0xE7AEFB40, 0xE7AFFB40, 0xE7B0FB40, 0xE7B1FB40, 0xE7B2FB40, 0xE7B3FB40, 0xE7B4FB40, 0xE7B5FB40, 0xE7B6FB40, 0xE7B7FB40, 0xE7B8FB40, 0xE7B9FB40, 0xE7BAFB40, 0xE7BBFB40, 0xE7BCFB40, 0xE7BDFB40, 0xE7BEFB40, 0xE7BFFB40,
+		0xE7C0FB40, 0xE7C1FB40, 0xE7C2FB40, 0xE7C3FB40, 0xE7C4FB40, 0xE7C5FB40, 0xE7C6FB40, 0xE7C7FB40, 0xE7C8FB40, 0xE7C9FB40, 0xE7CAFB40, 0xE7CBFB40, 0xE7CCFB40, 0xE7CDFB40, 0xE7CEFB40, 0xE7CFFB40, 0xE7D0FB40, 0xE7D1FB40, 0xE7D2FB40, 0xE7D3FB40, 0xE7D4FB40, 0xE7D5FB40, 0xE7D6FB40, 0xE7D7FB40, 0xE7D8FB40, 0xE7D9FB40, 0xE7DAFB40, 0xE7DBFB40, 0xE7DCFB40, 0xE7DDFB40, 0xE7DEFB40, 0xE7DFFB40,
+		0xE7E0FB40, 0xE7E1FB40, 0xE7E2FB40, 0xE7E3FB40, 0xE7E4FB40, 0xE7E5FB40, 0xE7E6FB40, 0xE7E7FB40, 0xE7E8FB40, 0xE7E9FB40, 0xE7EAFB40, 0xE7EBFB40, 0xE7ECFB40, 0xE7EDFB40, 0xE7EEFB40, 0xE7EFFB40, 0xE7F0FB40, 0xE7F1FB40, 0xE7F2FB40, 0xE7F3FB40, 0xE7F4FB40, 0xE7F5FB40, 0xE7F6FB40, 0xE7F7FB40, 0xE7F8FB40, 0xE7F9FB40, 0xE7FAFB40, 0xE7FBFB40, 0xE7FCFB40, 0xE7FDFB40, 0xE7FEFB40, 0xE7FFFB40,
+		0xE800FB40, 0xE801FB40, 0xE802FB40, 0xE803FB40, 0xE804FB40, 0xE805FB40, 0xE806FB40, 0xE807FB40, 0xE808FB40, 0xE809FB40, 0xE80AFB40, 0xE80BFB40, 0xE80CFB40, 0xE80DFB40, 0xE80EFB40, 0xE80FFB40, 0xE810FB40, 0xE811FB40, 0xE812FB40, 0xE813FB40, 0xE814FB40, 0xE815FB40, 0xE816FB40, 0xE817FB40, 0xE818FB40, 0xE819FB40, 0xE81AFB40, 0xE81BFB40, 0xE81CFB40, 0xE81DFB40, 0xE81EFB40, 0xE81FFB40,
+		0xE820FB40, 0xE821FB40, 0xE822FB40, 0xE823FB40, 0xE824FB40, 0xE825FB40, 0xE826FB40, 0xE827FB40, 0xE828FB40, 0xE829FB40, 0xE82AFB40, 0xE82BFB40, 0xE82CFB40, 0xE82DFB40, 0xE82EFB40, 0xE82FFB40, 0xE830FB40, 0xE831FB40, 0xE832FB40, 0xE833FB40, 0xE834FB40, 0xE835FB40, 0xE836FB40, 0xE837FB40, 0xE838FB40, 0xE839FB40, 0xE83AFB40, 0xE83BFB40, 0xE83CFB40, 0xE83DFB40, 0xE83EFB40, 0xE83FFB40,
+		0xE840FB40, 0xE841FB40, 0xE842FB40, 0xE843FB40, 0xE844FB40, 0xE845FB40, 0xE846FB40, 0xE847FB40, 0xE848FB40, 0xE849FB40, 0xE84AFB40, 0xE84BFB40, 0xE84CFB40, 0xE84DFB40, 0xE84EFB40, 0xE84FFB40, 0xE850FB40, 0xE851FB40, 0xE852FB40, 0xE853FB40, 0xE854FB40, 0xE855FB40, 0xE856FB40, 0xE857FB40, 0xE858FB40, 0xE859FB40, 0xE85AFB40, 0xE85BFB40, 0xE85CFB40, 0xE85DFB40, 0xE85EFB40, 0xE85FFB40,
+		0xE860FB40, 0xE861FB40, 0xE862FB40, 0xE863FB40, 0xE864FB40, 0xE865FB40, 0xE866FB40, 0xE867FB40, 0xE868FB40, 0xE869FB40, 0xE86AFB40, 0xE86BFB40, 0xE86CFB40, 0xE86DFB40, 0xE86EFB40, 0xE86FFB40, 0xE870FB40, 0xE871FB40, 0xE872FB40, 0xE873FB40, 0xE874FB40, 0xE875FB40, 0xE876FB40, 0xE877FB40, 0xE878FB40, 0xE879FB40, 0xE87AFB40, 0xE87BFB40, 0xE87CFB40, 0xE87DFB40, 0xE87EFB40, 0xE87FFB40,
+		0xE880FB40, 0xE881FB40, 0xE882FB40, 0xE883FB40, 0xE884FB40, 0xE885FB40, 0xE886FB40, 0xE887FB40, 0xE888FB40, 0xE889FB40, 0xE88AFB40, 0xE88BFB40, 0xE88CFB40, 0xE88DFB40, 0xE88EFB40, 0xE88FFB40, 0xE890FB40, 0xE891FB40, 0xE892FB40, 0xE893FB40, 0xE894FB40, 0xE895FB40, 0xE896FB40, 0xE897FB40, 0xE898FB40, 0xE899FB40, 0xE89AFB40, 0xE89BFB40, 0xE89CFB40, 0xE89DFB40, 0xE89EFB40, 0xE89FFB40,
+		0xE8A0FB40, 0xE8A1FB40, 0xE8A2FB40, 0xE8A3FB40, 0xE8A4FB40, 0xE8A5FB40, 0xE8A6FB40, 0xE8A7FB40, 0xE8A8FB40, 0xE8A9FB40, 0xE8AAFB40, 0xE8ABFB40, 0xE8ACFB40, 0xE8ADFB40, 0xE8AEFB40, 0xE8AFFB40, 0xE8B0FB40, 0xE8B1FB40, 0xE8B2FB40, 0xE8B3FB40, 0xE8B4FB40, 0xE8B5FB40, 0xE8B6FB40, 0xE8B7FB40, 0xE8B8FB40, 0xE8B9FB40, 0xE8BAFB40, 0xE8BBFB40, 0xE8BCFB40, 0xE8BDFB40, 0xE8BEFB40, 0xE8BFFB40,
+		0xE8C0FB40, 0xE8C1FB40, 0xE8C2FB40, 0xE8C3FB40, 0xE8C4FB40, 0xE8C5FB40, 0xE8C6FB40, 0xE8C7FB40, 0xE8C8FB40, 0xE8C9FB40, 0xE8CAFB40, 0xE8CBFB40, 0xE8CCFB40, 0xE8CDFB40, 0xE8CEFB40, 0xE8CFFB40, 0xE8D0FB40, 0xE8D1FB40, 0xE8D2FB40, 0xE8D3FB40, 0xE8D4FB40, 0xE8D5FB40, 0xE8D6FB40, 0xE8D7FB40, 0xE8D8FB40, 0xE8D9FB40, 0xE8DAFB40, 0xE8DBFB40, 0xE8DCFB40, 0xE8DDFB40, 0xE8DEFB40, 0xE8DFFB40,
+		0xE8E0FB40, 0xE8E1FB40, 0xE8E2FB40, 0xE8E3FB40, 0xE8E4FB40, 0xE8E5FB40, 0xE8E6FB40, 0xE8E7FB40, 0xE8E8FB40, 0xE8E9FB40, 0xE8EAFB40, 0xE8EBFB40, 0xE8ECFB40, 0xE8EDFB40, 0xE8EEFB40, 0xE8EFFB40, 0xE8F0FB40, 0xE8F1FB40, 0xE8F2FB40, 0xE8F3FB40, 0xE8F4FB40, 0xE8F5FB40, 0xE8F6FB40, 0xE8F7FB40, 0xE8F8FB40, 0xE8F9FB40, 0xE8FAFB40, 0xE8FBFB40, 0xE8FCFB40, 0xE8FDFB40, 0xE8FEFB40, 0xE8FFFB40,
+		0xE900FB40, 0xE901FB40, 0xE902FB40, 0xE903FB40, 0xE904FB40, 0xE905FB40, 0xE906FB40, 0xE907FB40, 0xE908FB40, 0xE909FB40, 0xE90AFB40, 0xE90BFB40, 0xE90CFB40, 0xE90DFB40, 0xE90EFB40, 0xE90FFB40, 0xE910FB40, 0xE911FB40, 0xE912FB40, 0xE913FB40, 0xE914FB40, 0xE915FB40, 0xE916FB40, 0xE917FB40, 0xE918FB40, 0xE919FB40, 0xE91AFB40, 0xE91BFB40, 0xE91CFB40, 0xE91DFB40, 0xE91EFB40, 0xE91FFB40,
+		0xE920FB40, 0xE921FB40, 0xE922FB40, 0xE923FB40, 0xE924FB40, 0xE925FB40, 0xE926FB40, 0xE927FB40, 0xE928FB40, 0xE929FB40, 0xE92AFB40, 0xE92BFB40, 0xE92CFB40, 0xE92DFB40, 0xE92EFB40, 0xE92FFB40, 0xE930FB40, 0xE931FB40, 0xE932FB40, 0xE933FB40, 0xE934FB40, 0xE935FB40, 0xE936FB40, 0xE937FB40, 0xE938FB40, 0xE939FB40, 0xE93AFB40, 0xE93BFB40, 0xE93CFB40, 0xE93DFB40, 0xE93EFB40, 0xE93FFB40,
+		0xE940FB40, 0xE941FB40, 0xE942FB40, 0xE943FB40, 0xE944FB40, 0xE945FB40, 0xE946FB40, 0xE947FB40, 0xE948FB40, 0xE949FB40, 0xE94AFB40, 0xE94BFB40, 0xE94CFB40, 0xE94DFB40, 0xE94EFB40, 0xE94FFB40, 0xE950FB40, 0xE951FB40, 0xE952FB40, 0xE953FB40, 0xE954FB40, 0xE955FB40, 0xE956FB40, 0xE957FB40, 0xE958FB40, 0xE959FB40, 0xE95AFB40, 0xE95BFB40, 0xE95CFB40, 0xE95DFB40, 0xE95EFB40, 0xE95FFB40,
+		0xE960FB40, 0xE961FB40, 0xE962FB40, 0xE963FB40, 0xE964FB40, 0xE965FB40, 0xE966FB40, 0xE967FB40, 0xE968FB40, 0xE969FB40, 0xE96AFB40, 0xE96BFB40, 0xE96CFB40, 0xE96DFB40, 0xE96EFB40, 0xE96FFB40, 0xE970FB40, 0xE971FB40, 0xE972FB40, 0xE973FB40, 0xE974FB40, 0xE975FB40, 0xE976FB40, 0xE977FB40, 0xE978FB40, 0xE979FB40, 0xE97AFB40, 0xE97BFB40, 0xE97CFB40, 0xE97DFB40, 0xE97EFB40, 0xE97FFB40,
+		0xE980FB40, 0xE981FB40, 0xE982FB40, 0xE983FB40, 0xE984FB40, 0xE985FB40, 0xE986FB40, 0xE987FB40, 0xE988FB40, 0xE989FB40, 0xE98AFB40, 0xE98BFB40, 0xE98CFB40, 0xE98DFB40, 0xE98EFB40, 0xE98FFB40, 0xE990FB40, 0xE991FB40, 0xE992FB40, 0xE993FB40, 0xE994FB40, 0xE995FB40, 0xE996FB40, 0xE997FB40, 0xE998FB40, 0xE999FB40, 0xE99AFB40, 0xE99BFB40, 0xE99CFB40, 0xE99DFB40, 0xE99EFB40, 0xE99FFB40,
+		0xE9A0FB40, 0xE9A1FB40, 0xE9A2FB40, 0xE9A3FB40, 0xE9A4FB40, 0xE9A5FB40, 0xE9A6FB40, 0xE9A7FB40, 0xE9A8FB40, 0xE9A9FB40, 0xE9AAFB40, 0xE9ABFB40, 0xE9ACFB40, 0xE9ADFB40, 0xE9AEFB40, 0xE9AFFB40, 0xE9B0FB40, 0xE9B1FB40, 0xE9B2FB40, 0xE9B3FB40, 0xE9B4FB40, 0xE9B5FB40, 0xE9B6FB40, 0xE9B7FB40, 0xE9B8FB40, 0xE9B9FB40, 0xE9BAFB40, 0xE9BBFB40, 0xE9BCFB40, 0xE9BDFB40, 0xE9BEFB40, 0xE9BFFB40,
+		0xE9C0FB40, 0xE9C1FB40, 0xE9C2FB40, 0xE9C3FB40, 0xE9C4FB40, 0xE9C5FB40, 0xE9C6FB40, 0xE9C7FB40, 0xE9C8FB40, 0xE9C9FB40, 0xE9CAFB40, 0xE9CBFB40, 0xE9CCFB40, 0xE9CDFB40, 0xE9CEFB40, 0xE9CFFB40, 0xE9D0FB40, 0xE9D1FB40, 0xE9D2FB40, 0xE9D3FB40, 0xE9D4FB40, 0xE9D5FB40, 0xE9D6FB40, 0xE9D7FB40, 0xE9D8FB40, 0xE9D9FB40, 0xE9DAFB40, 0xE9DBFB40, 0xE9DCFB40, 0xE9DDFB40, 0xE9DEFB40, 0xE9DFFB40,
+		0xE9E0FB40, 0xE9E1FB40, 0xE9E2FB40, 0xE9E3FB40, 0xE9E4FB40, 0xE9E5FB40, 0xE9E6FB40, 0xE9E7FB40, 0xE9E8FB40, 0xE9E9FB40, 0xE9EAFB40, 0xE9EBFB40, 0xE9ECFB40, 0xE9EDFB40, 0xE9EEFB40, 0xE9EFFB40, 0xE9F0FB40, 0xE9F1FB40, 0xE9F2FB40, 0xE9F3FB40, 0xE9F4FB40, 0xE9F5FB40, 0xE9F6FB40, 0xE9F7FB40, 0xE9F8FB40, 0xE9F9FB40, 0xE9FAFB40, 0xE9FBFB40, 0xE9FCFB40, 0xE9FDFB40, 0xE9FEFB40, 0xE9FFFB40,
+		0xEA00FB40, 0xEA01FB40, 0xEA02FB40, 0xEA03FB40, 0xEA04FB40, 0xEA05FB40, 0xEA06FB40, 0xEA07FB40, 0xEA08FB40, 0xEA09FB40, 0xEA0AFB40, 0xEA0BFB40, 0xEA0CFB40, 0xEA0DFB40, 0xEA0EFB40, 0xEA0FFB40, 0xEA10FB40, 0xEA11FB40, 0xEA12FB40, 0xEA13FB40, 0xEA14FB40, 0xEA15FB40, 0xEA16FB40, 0xEA17FB40, 0xEA18FB40, 0xEA19FB40, 0xEA1AFB40, 0xEA1BFB40, 0xEA1CFB40, 0xEA1DFB40, 0xEA1EFB40, 0xEA1FFB40,
+		0xEA20FB40, 0xEA21FB40, 0xEA22FB40, 0xEA23FB40, 0xEA24FB40, 0xEA25FB40, 0xEA26FB40, 0xEA27FB40, 0xEA28FB40, 0xEA29FB40, 0xEA2AFB40, 0xEA2BFB40, 0xEA2CFB40, 0xEA2DFB40, 0xEA2EFB40, 0xEA2FFB40, 0xEA30FB40, 0xEA31FB40, 0xEA32FB40, 0xEA33FB40, 0xEA34FB40, 0xEA35FB40, 0xEA36FB40, 0xEA37FB40, 0xEA38FB40, 0xEA39FB40, 0xEA3AFB40, 0xEA3BFB40, 0xEA3CFB40, 0xEA3DFB40, 0xEA3EFB40, 0xEA3FFB40,
+		0xEA40FB40, 0xEA41FB40, 0xEA42FB40, 0xEA43FB40, 0xEA44FB40, 0xEA45FB40, 0xEA46FB40, 0xEA47FB40, 0xEA48FB40, 0xEA49FB40, 0xEA4AFB40, 0xEA4BFB40, 0xEA4CFB40, 0xEA4DFB40, 0xEA4EFB40, 0xEA4FFB40, 0xEA50FB40, 0xEA51FB40, 0xEA52FB40, 0xEA53FB40, 0xEA54FB40, 0xEA55FB40, 0xEA56FB40, 0xEA57FB40, 0xEA58FB40, 0xEA59FB40, 0xEA5AFB40, 0xEA5BFB40, 0xEA5CFB40, 0xEA5DFB40, 0xEA5EFB40, 0xEA5FFB40,
+		0xEA60FB40, 0xEA61FB40, 0xEA62FB40, 0xEA63FB40, 0xEA64FB40, 0xEA65FB40, 0xEA66FB40, 0xEA67FB40, 0xEA68FB40, 0xEA69FB40, 0xEA6AFB40, 0xEA6BFB40, 0xEA6CFB40, 0xEA6DFB40, 0xEA6EFB40, 0xEA6FFB40, 0xEA70FB40, 0xEA71FB40, 0xEA72FB40, 0xEA73FB40, 0xEA74FB40, 0xEA75FB40, 0xEA76FB40, 0xEA77FB40, 0xEA78FB40, 0xEA79FB40, 0xEA7AFB40, 0xEA7BFB40, 0xEA7CFB40, 0xEA7DFB40, 0xEA7EFB40, 0xEA7FFB40,
+		0xEA80FB40, 0xEA81FB40, 0xEA82FB40, 0xEA83FB40, 0xEA84FB40, 0xEA85FB40, 0xEA86FB40, 0xEA87FB40, 0xEA88FB40, 0xEA89FB40, 0xEA8AFB40, 0xEA8BFB40, 0xEA8CFB40, 0xEA8DFB40, 0xEA8EFB40, 0xEA8FFB40, 0xEA90FB40, 0xEA91FB40, 0xEA92FB40, 0xEA93FB40, 0xEA94FB40, 0xEA95FB40, 0xEA96FB40, 0xEA97FB40, 0xEA98FB40, 0xEA99FB40, 0xEA9AFB40, 0xEA9BFB40, 0xEA9CFB40, 0xEA9DFB40, 0xEA9EFB40, 0xEA9FFB40,
+		0xEAA0FB40, 0xEAA1FB40, 0xEAA2FB40, 0xEAA3FB40, 0xEAA4FB40, 0xEAA5FB40, 0xEAA6FB40, 0xEAA7FB40, 0xEAA8FB40, 0xEAA9FB40, 0xEAAAFB40, 0xEAABFB40, 0xEAACFB40, 0xEAADFB40, 0xEAAEFB40, 0xEAAFFB40, 0xEAB0FB40, 0xEAB1FB40, 0xEAB2FB40, 0xEAB3FB40, 0xEAB4FB40, 0xEAB5FB40, 0xEAB6FB40, 0xEAB7FB40, 0xEAB8FB40, 0xEAB9FB40, 0xEABAFB40, 0xEABBFB40, 0xEABCFB40, 0xEABDFB40, 0xEABEFB40, 0xEABFFB40,
+		0xEAC0FB40, 0xEAC1FB40, 0xEAC2FB40, 0xEAC3FB40, 0xEAC4FB40, 0xEAC5FB40, 0xEAC6FB40, 0xEAC7FB40, 0xEAC8FB40, 0xEAC9FB40, 0xEACAFB40, 0xEACBFB40, 0xEACCFB40, 0xEACDFB40, 0xEACEFB40, 0xEACFFB40, 0xEAD0FB40, 0xEAD1FB40, 0xEAD2FB40, 0xEAD3FB40, 0xEAD4FB40, 0xEAD5FB40, 0xEAD6FB40, 0xEAD7FB40, 0xEAD8FB40, 0xEAD9FB40, 0xEADAFB40, 0xEADBFB40, 0xEADCFB40, 0xEADDFB40, 0xEADEFB40, 0xEADFFB40,
+		0xEAE0FB40, 0xEAE1FB40, 0xEAE2FB40, 0xEAE3FB40, 0xEAE4FB40, 0xEAE5FB40, 0xEAE6FB40, 0xEAE7FB40, 0xEAE8FB40, 0xEAE9FB40, 0xEAEAFB40, 0xEAEBFB40, 0xEAECFB40, 0xEAEDFB40, 0xEAEEFB40, 0xEAEFFB40, 0xEAF0FB40, 0xEAF1FB40, 0xEAF2FB40, 0xEAF3FB40, 0xEAF4FB40, 0xEAF5FB40, 0xEAF6FB40, 0xEAF7FB40, 0xEAF8FB40, 0xEAF9FB40, 0xEAFAFB40, 0xEAFBFB40, 0xEAFCFB40, 0xEAFDFB40, 0xEAFEFB40, 0xEAFFFB40,
+		0xEB00FB40, 0xEB01FB40, 0xEB02FB40, 0xEB03FB40, 0xEB04FB40, 0xEB05FB40, 0xEB06FB40, 0xEB07FB40, 0xEB08FB40, 0xEB09FB40, 0xEB0AFB40, 0xEB0BFB40, 0xEB0CFB40, 0xEB0DFB40, 0xEB0EFB40, 0xEB0FFB40, 0xEB10FB40, 0xEB11FB40, 0xEB12FB40, 0xEB13FB40, 0xEB14FB40, 0xEB15FB40, 0xEB16FB40, 0xEB17FB40, 0xEB18FB40, 0xEB19FB40, 0xEB1AFB40, 0xEB1BFB40, 0xEB1CFB40, 0xEB1DFB40, 0xEB1EFB40, 0xEB1FFB40,
+		0xEB20FB40, 0xEB21FB40, 0xEB22FB40, 0xEB23FB40, 0xEB24FB40, 0xEB25FB40, 0xEB26FB40, 0xEB27FB40, 0xEB28FB40, 0xEB29FB40, 0xEB2AFB40, 0xEB2BFB40, 0xEB2CFB40, 0xEB2DFB40, 0xEB2EFB40, 0xEB2FFB40, 0xEB30FB40, 0xEB31FB40, 0xEB32FB40, 0xEB33FB40, 0xEB34FB40, 0xEB35FB40, 0xEB36FB40, 0xEB37FB40, 0xEB38FB40, 0xEB39FB40, 0xEB3AFB40, 0xEB3BFB40, 0xEB3CFB40, 0xEB3DFB40, 0xEB3EFB40, 0xEB3FFB40,
+		0xEB40FB40, 0xEB41FB40, 0xEB42FB40, 0xEB43FB40, 0xEB44FB40, 0xEB45FB40, 0xEB46FB40, 0xEB47FB40, 0xEB48FB40, 0xEB49FB40, 0xEB4AFB40, 0xEB4BFB40, 0xEB4CFB40, 0xEB4DFB40, 0xEB4EFB40, 0xEB4FFB40, 0xEB50FB40, 0xEB51FB40, 0xEB52FB40, 0xEB53FB40, 0xEB54FB40, 0xEB55FB40, 0xEB56FB40, 0xEB57FB40, 0xEB58FB40, 0xEB59FB40, 0xEB5AFB40, 0xEB5BFB40, 0xEB5CFB40, 0xEB5DFB40, 0xEB5EFB40, 0xEB5FFB40,
+		0xEB60FB40, 0xEB61FB40, 0xEB62FB40, 0xEB63FB40, 0xEB64FB40, 0xEB65FB40, 0xEB66FB40, 0xEB67FB40, 0xEB68FB40, 0xEB69FB40, 0xEB6AFB40, 0xEB6BFB40, 0xEB6CFB40, 0xEB6DFB40, 0xEB6EFB40, 0xEB6FFB40, 0xEB70FB40, 0xEB71FB40, 0xEB72FB40, 0xEB73FB40, 0xEB74FB40, 0xEB75FB40, 0xEB76FB40, 0xEB77FB40, 0xEB78FB40, 0xEB79FB40, 0xEB7AFB40, 0xEB7BFB40, 0xEB7CFB40, 0xEB7DFB40, 0xEB7EFB40, 0xEB7FFB40,
+		0xEB80FB40, 0xEB81FB40, 0xEB82FB40, 0xEB83FB40, 0xEB84FB40, 0xEB85FB40, 0xEB86FB40, 0xEB87FB40, 0xEB88FB40, 0xEB89FB40, 0xEB8AFB40, 0xEB8BFB40, 0xEB8CFB40, 0xEB8DFB40, 0xEB8EFB40, 0xEB8FFB40, 0xEB90FB40, 0xEB91FB40, 0xEB92FB40, 0xEB93FB40, 0xEB94FB40, 0xEB95FB40, 0xEB96FB40, 0xEB97FB40, 0xEB98FB40, 0xEB99FB40, 0xEB9AFB40, 0xEB9BFB40, 0xEB9CFB40, 0xEB9DFB40, 0xEB9EFB40, 0xEB9FFB40,
+		0xEBA0FB40, 0xEBA1FB40, 0xEBA2FB40, 0xEBA3FB40, 0xEBA4FB40, 0xEBA5FB40, 0xEBA6FB40, 0xEBA7FB40, 0xEBA8FB40, 0xEBA9FB40, 0xEBAAFB40, 0xEBABFB40, 0xEBACFB40, 0xEBADFB40, 0xEBAEFB40, 0xEBAFFB40, 0xEBB0FB40, 0xEBB1FB40, 0xEBB2FB40, 0xEBB3FB40, 0xEBB4FB40, 0xEBB5FB40, 0xEBB6FB40, 0xEBB7FB40, 0xEBB8FB40, 0xEBB9FB40, 0xEBBAFB40, 0xEBBBFB40, 0xEBBCFB40, 0xEBBDFB40, 0xEBBEFB40, 0xEBBFFB40,
+		0xEBC0FB40, 0xEBC1FB40, 0xEBC2FB40, 0xEBC3FB40, 0xEBC4FB40, 0xEBC5FB40, 0xEBC6FB40, 0xEBC7FB40, 0xEBC8FB40, 0xEBC9FB40, 0xEBCAFB40, 0xEBCBFB40, 0xEBCCFB40, 0xEBCDFB40, 0xEBCEFB40, 0xEBCFFB40, 0xEBD0FB40, 0xEBD1FB40, 0xEBD2FB40, 0xEBD3FB40, 0xEBD4FB40, 0xEBD5FB40, 0xEBD6FB40, 0xEBD7FB40, 0xEBD8FB40, 0xEBD9FB40, 0xEBDAFB40, 0xEBDBFB40, 0xEBDCFB40, 0xEBDDFB40, 0xEBDEFB40, 0xEBDFFB40,
+		0xEBE0FB40, 0xEBE1FB40, 0xEBE2FB40, 0xEBE3FB40, 0xEBE4FB40, 0xEBE5FB40, 0xEBE6FB40, 0xEBE7FB40, 0xEBE8FB40, 0xEBE9FB40, 0xEBEAFB40, 0xEBEBFB40, 0xEBECFB40, 0xEBEDFB40, 0xEBEEFB40, 0xEBEFFB40, 0xEBF0FB40, 0xEBF1FB40, 0xEBF2FB40, 0xEBF3FB40, 0xEBF4FB40, 0xEBF5FB40, 0xEBF6FB40, 0xEBF7FB40, 0xEBF8FB40, 0xEBF9FB40, 0xEBFAFB40, 0xEBFBFB40, 0xEBFCFB40, 0xEBFDFB40, 0xEBFEFB40, 0xEBFFFB40,
+		0xEC00FB40, 0xEC01FB40, 0xEC02FB40, 0xEC03FB40, 0xEC04FB40, 0xEC05FB40, 0xEC06FB40, 0xEC07FB40, 0xEC08FB40, 0xEC09FB40, 0xEC0AFB40, 0xEC0BFB40, 0xEC0CFB40, 0xEC0DFB40, 0xEC0EFB40, 0xEC0FFB40, 0xEC10FB40, 0xEC11FB40, 0xEC12FB40, 0xEC13FB40, 0xEC14FB40, 0xEC15FB40, 0xEC16FB40, 0xEC17FB40, 0xEC18FB40, 0xEC19FB40, 0xEC1AFB40, 0xEC1BFB40, 0xEC1CFB40, 0xEC1DFB40, 0xEC1EFB40, 0xEC1FFB40,
+		0xEC20FB40, 0xEC21FB40, 0xEC22FB40, 0xEC23FB40, 0xEC24FB40, 0xEC25FB40, 0xEC26FB40, 0xEC27FB40, 0xEC28FB40, 0xEC29FB40, 0xEC2AFB40, 0xEC2BFB40, 0xEC2CFB40, 0xEC2DFB40, 0xEC2EFB40, 0xEC2FFB40, 0xEC30FB40, 0xEC31FB40, 0xEC32FB40, 0xEC33FB40, 0xEC34FB40, 0xEC35FB40, 0xEC36FB40, 0xEC37FB40, 0xEC38FB40, 0xEC39FB40, 0xEC3AFB40, 0xEC3BFB40, 0xEC3CFB40, 0xEC3DFB40, 0xEC3EFB40, 0xEC3FFB40,
+		0xEC40FB40, 0xEC41FB40, 0xEC42FB40, 0xEC43FB40, 0xEC44FB40, 0xEC45FB40, 0xEC46FB40, 0xEC47FB40, 0xEC48FB40, 0xEC49FB40, 0xEC4AFB40, 0xEC4BFB40, 0xEC4CFB40, 0xEC4DFB40, 0xEC4EFB40, 0xEC4FFB40, 0xEC50FB40, 0xEC51FB40, 0xEC52FB40, 0xEC53FB40, 0xEC54FB40, 0xEC55FB40, 0xEC56FB40, 0xEC57FB40, 0xEC58FB40, 0xEC59FB40, 0xEC5AFB40, 0xEC5BFB40, 0xEC5CFB40, 0xEC5DFB40, 0xEC5EFB40, 0xEC5FFB40,
+		0xEC60FB40, 0xEC61FB40, 0xEC62FB40, 0xEC63FB40, 0xEC64FB40, 0xEC65FB40, 0xEC66FB40, 0xEC67FB40, 0xEC68FB40, 0xEC69FB40, 0xEC6AFB40, 0xEC6BFB40, 0xEC6CFB40, 0xEC6DFB40, 0xEC6EFB40, 0xEC6FFB40, 0xEC70FB40, 0xEC71FB40, 0xEC72FB40, 0xEC73FB40, 0xEC74FB40, 0xEC75FB40, 0xEC76FB40, 0xEC77FB40, 0xEC78FB40, 0xEC79FB40, 0xEC7AFB40, 0xEC7BFB40, 0xEC7CFB40, 0xEC7DFB40, 0xEC7EFB40, 0xEC7FFB40,
+		0xEC80FB40, 0xEC81FB40, 0xEC82FB40, 0xEC83FB40, 0xEC84FB40, 0xEC85FB40, 0xEC86FB40, 0xEC87FB40, 0xEC88FB40, 0xEC89FB40, 0xEC8AFB40, 0xEC8BFB40, 0xEC8CFB40, 0xEC8DFB40, 0xEC8EFB40, 0xEC8FFB40, 0xEC90FB40, 0xEC91FB40, 0xEC92FB40, 0xEC93FB40, 0xEC94FB40, 0xEC95FB40, 0xEC96FB40, 0xEC97FB40, 0xEC98FB40, 0xEC99FB40, 0xEC9AFB40, 0xEC9BFB40, 0xEC9CFB40, 0xEC9DFB40, 0xEC9EFB40, 0xEC9FFB40,
+		0xECA0FB40, 0xECA1FB40, 0xECA2FB40, 0xECA3FB40, 0xECA4FB40, 0xECA5FB40, 0xECA6FB40, 0xECA7FB40, 0xECA8FB40, 0xECA9FB40, 0xECAAFB40, 0xECABFB40, 0xECACFB40, 0xECADFB40, 0xECAEFB40, 0xECAFFB40, 0xECB0FB40, 0xECB1FB40, 0xECB2FB40, 0xECB3FB40, 0xECB4FB40, 0xECB5FB40, 0xECB6FB40, 0xECB7FB40, 0xECB8FB40, 0xECB9FB40, 0xECBAFB40, 0xECBBFB40, 0xECBCFB40, 0xECBDFB40, 0xECBEFB40, 0xECBFFB40,
+		0xECC0FB40, 0xECC1FB40, 0xECC2FB40, 0xECC3FB40, 0xECC4FB40, 0xECC5FB40, 0xECC6FB40, 0xECC7FB40, 0xECC8FB40, 0xECC9FB40, 0xECCAFB40, 0xECCBFB40, 0xECCCFB40, 0xECCDFB40, 0xECCEFB40, 0xECCFFB40, 0xECD0FB40, 0xECD1FB40, 0xECD2FB40, 0xECD3FB40, 0xECD4FB40, 0xECD5FB40, 0xECD6FB40, 0xECD7FB40, 0xECD8FB40, 0xECD9FB40, 0xECDAFB40, 0xECDBFB40, 0xECDCFB40, 0xECDDFB40, 0xECDEFB40, 0xECDFFB40,
+		0xECE0FB40, 0xECE1FB40, 0xECE2FB40, 0xECE3FB40, 0xECE4FB40, 0xECE5FB40, 0xECE6FB40, 0xECE7FB40, 0xECE8FB40, 0xECE9FB40, 0xECEAFB40, 0xECEBFB40, 0xECECFB40, 0xECEDFB40, 0xECEEFB40, 0xECEFFB40, 0xECF0FB40, 0xECF1FB40, 0xECF2FB40, 0xECF3FB40, 0xECF4FB40, 0xECF5FB40, 0xECF6FB40, 0xECF7FB40, 0xECF8FB40, 0xECF9FB40, 0xECFAFB40, 0xECFBFB40, 0xECFCFB40, 0xECFDFB40, 0xECFEFB40, 0xECFFFB40,
+		0xED00FB40, 0xED01FB40, 0xED02FB40, 0xED03FB40, 0xED04FB40, 0xED05FB40, 0xED06FB40, 0xED07FB40, 0xED08FB40, 0xED09FB40, 0xED0AFB40, 0xED0BFB40, 0xED0CFB40, 0xED0DFB40, 0xED0EFB40, 0xED0FFB40, 0xED10FB40, 0xED11FB40, 0xED12FB40, 0xED13FB40, 0xED14FB40, 0xED15FB40, 0xED16FB40, 0xED17FB40, 0xED18FB40, 0xED19FB40, 0xED1AFB40, 0xED1BFB40, 0xED1CFB40, 0xED1DFB40, 0xED1EFB40, 0xED1FFB40,
+		0xED20FB40, 0xED21FB40, 0xED22FB40, 0xED23FB40, 0xED24FB40, 0xED25FB40, 0xED26FB40, 0xED27FB40, 0xED28FB40, 0xED29FB40, 0xED2AFB40, 0xED2BFB40, 0xED2CFB40, 0xED2DFB40, 0xED2EFB40, 0xED2FFB40, 0xED30FB40, 0xED31FB40, 0xED32FB40, 0xED33FB40, 0xED34FB40, 0xED35FB40, 0xED36FB40, 0xED37FB40, 0xED38FB40, 0xED39FB40, 0xED3AFB40, 0xED3BFB40, 0xED3CFB40, 0xED3DFB40, 0xED3EFB40, 0xED3FFB40,
+		0xED40FB40, 0xED41FB40, 0xED42FB40, 0xED43FB40, 0xED44FB40, 0xED45FB40, 0xED46FB40, 0xED47FB40, 0xED48FB40, 0xED49FB40, 0xED4AFB40, 0xED4BFB40, 0xED4CFB40, 0xED4DFB40, 0xED4EFB40, 0xED4FFB40, 0xED50FB40, 0xED51FB40, 0xED52FB40, 0xED53FB40, 0xED54FB40, 0xED55FB40, 0xED56FB40, 0xED57FB40, 0xED58FB40, 0xED59FB40, 0xED5AFB40, 0xED5BFB40, 0xED5CFB40, 0xED5DFB40, 0xED5EFB40, 0xED5FFB40,
+		0xED60FB40, 0xED61FB40, 0xED62FB40, 0xED63FB40, 0xED64FB40, 0xED65FB40, 0xED66FB40, 0xED67FB40, 0xED68FB40, 0xED69FB40, 0xED6AFB40, 0xED6BFB40, 0xED6CFB40, 0xED6DFB40, 0xED6EFB40, 0xED6FFB40, 0xED70FB40, 0xED71FB40, 0xED72FB40, 0xED73FB40, 0xED74FB40, 0xED75FB40, 0xED76FB40, 0xED77FB40, 0xED78FB40, 0xED79FB40, 0xED7AFB40, 0xED7BFB40, 0xED7CFB40, 0xED7DFB40, 0xED7EFB40, 0xED7FFB40,
+		0xED80FB40, 0xED81FB40, 0xED82FB40, 0xED83FB40, 0xED84FB40, 0xED85FB40, 0xED86FB40, 0xED87FB40, 0xED88FB40, 0xED89FB40, 0xED8AFB40, 0xED8BFB40, 0xED8CFB40, 0xED8DFB40, 0xED8EFB40, 0xED8FFB40, 0xED90FB40, 0xED91FB40, 0xED92FB40, 0xED93FB40, 0xED94FB40, 0xED95FB40, 0xED96FB40, 0xED97FB40, 0xED98FB40, 0xED99FB40, 0xED9AFB40, 0xED9BFB40, 0xED9CFB40, 0xED9DFB40, 0xED9EFB40, 0xED9FFB40,
+		0xEDA0FB40, 0xEDA1FB40, 0xEDA2FB40, 0xEDA3FB40, 0xEDA4FB40, 0xEDA5FB40, 0xEDA6FB40, 0xEDA7FB40, 0xEDA8FB40, 0xEDA9FB40, 0xEDAAFB40, 0xEDABFB40, 0xEDACFB40, 0xEDADFB40, 0xEDAEFB40, 0xEDAFFB40, 0xEDB0FB40, 0xEDB1FB40, 0xEDB2FB40, 0xEDB3FB40, 0xEDB4FB40, 0xEDB5FB40, 0xEDB6FB40, 0xEDB7FB40, 0xEDB8FB40, 0xEDB9FB40, 0xEDBAFB40, 0xEDBBFB40, 0xEDBCFB40, 0xEDBDFB40, 0xEDBEFB40, 0xEDBFFB40,
+		0xEDC0FB40, 0xEDC1FB40, 0xEDC2FB40, 0xEDC3FB40, 0xEDC4FB40, 0xEDC5FB40, 0xEDC6FB40, 0xEDC7FB40, 0xEDC8FB40, 0xEDC9FB40, 0xEDCAFB40, 0xEDCBFB40, 0xEDCCFB40, 0xEDCDFB40, 0xEDCEFB40, 0xEDCFFB40, 0xEDD0FB40, 0xEDD1FB40, 0xEDD2FB40, 0xEDD3FB40, 0xEDD4FB40, 0xEDD5FB40, 0xEDD6FB40, 0xEDD7FB40, 0xEDD8FB40, 0xEDD9FB40, 0xEDDAFB40, 0xEDDBFB40, 0xEDDCFB40, 0xEDDDFB40, 0xEDDEFB40, 0xEDDFFB40,
+		0xEDE0FB40, 0xEDE1FB40, 0xEDE2FB40, 0xEDE3FB40, 0xEDE4FB40, 0xEDE5FB40, 0xEDE6FB40, 0xEDE7FB40, 0xEDE8FB40, 0xEDE9FB40, 0xEDEAFB40, 0xEDEBFB40, 0xEDECFB40, 0xEDEDFB40, 0xEDEEFB40, 0xEDEFFB40, 0xEDF0FB40, 0xEDF1FB40, 0xEDF2FB40, 0xEDF3FB40, 0xEDF4FB40, 0xEDF5FB40, 0xEDF6FB40, 0xEDF7FB40, 0xEDF8FB40, 0xEDF9FB40, 0xEDFAFB40, 0xEDFBFB40, 0xEDFCFB40, 0xEDFDFB40, 0xEDFEFB40, 0xEDFFFB40,
+		0xEE00FB40, 0xEE01FB40, 0xEE02FB40, 0xEE03FB40, 0xEE04FB40, 0xEE05FB40, 0xEE06FB40, 0xEE07FB40, 0xEE08FB40, 0xEE09FB40, 0xEE0AFB40, 0xEE0BFB40, 0xEE0CFB40, 0xEE0DFB40, 0xEE0EFB40, 0xEE0FFB40, 0xEE10FB40, 0xEE11FB40, 0xEE12FB40, 0xEE13FB40, 0xEE14FB40, 0xEE15FB40, 0xEE16FB40, 0xEE17FB40, 0xEE18FB40, 0xEE19FB40, 0xEE1AFB40, 0xEE1BFB40, 0xEE1CFB40, 0xEE1DFB40, 0xEE1EFB40, 0xEE1FFB40,
+		0xEE20FB40, 0xEE21FB40, 0xEE22FB40, 0xEE23FB40, 0xEE24FB40, 0xEE25FB40, 0xEE26FB40, 0xEE27FB40, 0xEE28FB40, 0xEE29FB40, 0xEE2AFB40, 0xEE2BFB40, 0xEE2CFB40, 0xEE2DFB40, 0xEE2EFB40, 0xEE2FFB40, 0xEE30FB40, 0xEE31FB40, 0xEE32FB40, 0xEE33FB40, 0xEE34FB40, 0xEE35FB40, 0xEE36FB40, 0xEE37FB40, 0xEE38FB40, 0xEE39FB40, 0xEE3AFB40, 0xEE3BFB40, 0xEE3CFB40, 0xEE3DFB40, 0xEE3EFB40, 0xEE3FFB40,
+		0xEE40FB40, 0xEE41FB40, 0xEE42FB40, 0xEE43FB40, 0xEE44FB40, 0xEE45FB40, 0xEE46FB40, 0xEE47FB40, 0xEE48FB40, 0xEE49FB40, 0xEE4AFB40, 0xEE4BFB40, 0xEE4CFB40, 0xEE4DFB40, 0xEE4EFB40, 0xEE4FFB40, 0xEE50FB40, 0xEE51FB40, 0xEE52FB40, 0xEE53FB40, 0xEE54FB40, 0xEE55FB40, 0xEE56FB40, 0xEE57FB40, 0xEE58FB40, 0xEE59FB40, 0xEE5AFB40, 0xEE5BFB40, 0xEE5CFB40, 0xEE5DFB40, 0xEE5EFB40, 0xEE5FFB40,
+		0xEE60FB40, 0xEE61FB40, 0xEE62FB40, 0xEE63FB40, 0xEE64FB40, 0xEE65FB40, 0xEE66FB40, 0xEE67FB40, 0xEE68FB40, 0xEE69FB40, 0xEE6AFB40, 0xEE6BFB40, 0xEE6CFB40, 0xEE6DFB40, 0xEE6EFB40, 0xEE6FFB40, 0xEE70FB40, 0xEE71FB40, 0xEE72FB40, 0xEE73FB40, 0xEE74FB40, 0xEE75FB40, 0xEE76FB40, 0xEE77FB40, 0xEE78FB40, 0xEE79FB40, 0xEE7AFB40, 0xEE7BFB40, 0xEE7CFB40, 0xEE7DFB40, 0xEE7EFB40, 0xEE7FFB40,
+		0xEE80FB40, 0xEE81FB40, 0xEE82FB40, 0xEE83FB40, 0xEE84FB40, 0xEE85FB40, 0xEE86FB40, 0xEE87FB40, 0xEE88FB40, 0xEE89FB40, 0xEE8AFB40, 0xEE8BFB40, 0xEE8CFB40, 0xEE8DFB40, 0xEE8EFB40, 0xEE8FFB40, 0xEE90FB40, 0xEE91FB40, 0xEE92FB40, 0xEE93FB40, 0xEE94FB40, 0xEE95FB40, 0xEE96FB40, 0xEE97FB40, 0xEE98FB40, 0xEE99FB40, 0xEE9AFB40, 0xEE9BFB40, 0xEE9CFB40, 0xEE9DFB40, 0xEE9EFB40, 0xEE9FFB40,
+		0xEEA0FB40, 0xEEA1FB40, 0xEEA2FB40, 0xEEA3FB40, 0xEEA4FB40, 0xEEA5FB40, 0xEEA6FB40, 0xEEA7FB40, 0xEEA8FB40, 0xEEA9FB40, 0xEEAAFB40, 0xEEABFB40, 0xEEACFB40, 0xEEADFB40, 0xEEAEFB40, 0xEEAFFB40, 0xEEB0FB40, 0xEEB1FB40, 0xEEB2FB40, 0xEEB3FB40, 0xEEB4FB40, 0xEEB5FB40, 0xEEB6FB40, 0xEEB7FB40, 0xEEB8FB40, 0xEEB9FB40, 0xEEBAFB40, 0xEEBBFB40, 0xEEBCFB40, 0xEEBDFB40, 0xEEBEFB40, 0xEEBFFB40,
+		0xEEC0FB40, 0xEEC1FB40, 0xEEC2FB40, 0xEEC3FB40, 0xEEC4FB40, 0xEEC5FB40, 0xEEC6FB40, 0xEEC7FB40, 0xEEC8FB40, 0xEEC9FB40, 0xEECAFB40, 0xEECBFB40, 0xEECCFB40, 0xEECDFB40, 0xEECEFB40, 0xEECFFB40, 0xEED0FB40, 0xEED1FB40, 0xEED2FB40, 0xEED3FB40, 0xEED4FB40, 0xEED5FB40, 0xEED6FB40, 0xEED7FB40, 0xEED8FB40, 0xEED9FB40, 0xEEDAFB40, 0xEEDBFB40, 0xEEDCFB40, 0xEEDDFB40, 0xEEDEFB40, 0xEEDFFB40,
+		0xEEE0FB40, 0xEEE1FB40, 0xEEE2FB40, 0xEEE3FB40, 0xEEE4FB40, 0xEEE5FB40, 0xEEE6FB40, 0xEEE7FB40, 0xEEE8FB40, 0xEEE9FB40, 0xEEEAFB40, 0xEEEBFB40, 0xEEECFB40, 0xEEEDFB40, 0xEEEEFB40, 0xEEEFFB40, 0xEEF0FB40, 0xEEF1FB40, 0xEEF2FB40, 0xEEF3FB40, 0xEEF4FB40, 0xEEF5FB40, 0xEEF6FB40, 0xEEF7FB40, 0xEEF8FB40, 0xEEF9FB40, 0xEEFAFB40, 0xEEFBFB40, 0xEEFCFB40, 0xEEFDFB40, 0xEEFEFB40, 0xEEFFFB40,
+		0xEF00FB40, 0xEF01FB40, 0xEF02FB40, 0xEF03FB40, 0xEF04FB40, 0xEF05FB40, 0xEF06FB40, 0xEF07FB40, 0xEF08FB40, 0xEF09FB40, 0xEF0AFB40, 0xEF0BFB40, 0xEF0CFB40, 0xEF0DFB40, 0xEF0EFB40, 0xEF0FFB40, 0xEF10FB40, 0xEF11FB40, 0xEF12FB40, 0xEF13FB40, 0xEF14FB40, 0xEF15FB40, 0xEF16FB40, 0xEF17FB40, 0xEF18FB40, 0xEF19FB40, 0xEF1AFB40, 0xEF1BFB40, 0xEF1CFB40, 0xEF1DFB40, 0xEF1EFB40, 0xEF1FFB40,
+		0xEF20FB40, 0xEF21FB40, 0xEF22FB40, 0xEF23FB40, 0xEF24FB40, 0xEF25FB40, 0xEF26FB40, 0xEF27FB40, 0xEF28FB40, 0xEF29FB40, 0xEF2AFB40, 0xEF2BFB40, 0xEF2CFB40, 0xEF2DFB40, 0xEF2EFB40, 0xEF2FFB40, 0xEF30FB40, 0xEF31FB40, 0xEF32FB40, 0xEF33FB40, 0xEF34FB40, 0xEF35FB40, 0xEF36FB40, 0xEF37FB40, 0xEF38FB40, 0xEF39FB40, 0xEF3AFB40, 0xEF3BFB40, 0xEF3CFB40, 0xEF3DFB40, 0xEF3EFB40, 0xEF3FFB40,
+		0xEF40FB40, 0xEF41FB40, 0xEF42FB40, 0xEF43FB40, 0xEF44FB40, 0xEF45FB40, 0xEF46FB40, 0xEF47FB40, 0xEF48FB40, 0xEF49FB40, 0xEF4AFB40, 0xEF4BFB40, 0xEF4CFB40, 0xEF4DFB40, 0xEF4EFB40, 0xEF4FFB40, 0xEF50FB40, 0xEF51FB40, 0xEF52FB40, 0xEF53FB40, 0xEF54FB40, 0xEF55FB40, 0xEF56FB40, 0xEF57FB40, 0xEF58FB40, 0xEF59FB40, 0xEF5AFB40, 0xEF5BFB40, 0xEF5CFB40, 0xEF5DFB40, 0xEF5EFB40, 0xEF5FFB40,
+		0xEF60FB40, 0xEF61FB40, 0xEF62FB40, 0xEF63FB40, 0xEF64FB40, 0xEF65FB40, 0xEF66FB40, 0xEF67FB40, 0xEF68FB40, 0xEF69FB40, 0xEF6AFB40, 0xEF6BFB40, 0xEF6CFB40, 0xEF6DFB40, 0xEF6EFB40, 0xEF6FFB40, 0xEF70FB40, 0xEF71FB40, 0xEF72FB40, 0xEF73FB40, 0xEF74FB40, 0xEF75FB40, 0xEF76FB40, 0xEF77FB40, 0xEF78FB40, 0xEF79FB40, 0xEF7AFB40, 0xEF7BFB40, 0xEF7CFB40, 0xEF7DFB40, 0xEF7EFB40, 0xEF7FFB40,
+		0xEF80FB40, 0xEF81FB40, 0xEF82FB40, 0xEF83FB40, 0xEF84FB40, 0xEF85FB40, 0xEF86FB40, 0xEF87FB40, 0xEF88FB40, 0xEF89FB40, 0xEF8AFB40, 0xEF8BFB40, 0xEF8CFB40, 0xEF8DFB40, 0xEF8EFB40, 0xEF8FFB40, 0xEF90FB40, 0xEF91FB40, 0xEF92FB40, 0xEF93FB40, 0xEF94FB40, 0xEF95FB40, 0xEF96FB40, 0xEF97FB40, 0xEF98FB40, 0xEF99FB40, 0xEF9AFB40, 0xEF9BFB40, 0xEF9CFB40, 0xEF9DFB40, 0xEF9EFB40, 0xEF9FFB40,
+		0xEFA0FB40, 0xEFA1FB40, 0xEFA2FB40, 0xEFA3FB40, 0xEFA4FB40, 0xEFA5FB40, 0xEFA6FB40, 0xEFA7FB40, 0xEFA8FB40, 0xEFA9FB40, 0xEFAAFB40, 0xEFABFB40, 0xEFACFB40, 0xEFADFB40, 0xEFAEFB40, 0xEFAFFB40, 0xEFB0FB40, 0xEFB1FB40, 0xEFB2FB40, 0xEFB3FB40, 0xEFB4FB40, 0xEFB5FB40, 0xEFB6FB40, 0xEFB7FB40, 0xEFB8FB40, 0xEFB9FB40, 0xEFBAFB40, 0xEFBBFB40, 0xEFBCFB40, 0xEFBDFB40, 0xEFBEFB40, 0xEFBFFB40,
+		0xEFC0FB40, 0xEFC1FB40, 0xEFC2FB40, 0xEFC3FB40, 0xEFC4FB40, 0xEFC5FB40, 0xEFC6FB40, 0xEFC7FB40, 0xEFC8FB40, 0xEFC9FB40, 0xEFCAFB40, 0xEFCBFB40, 0xEFCCFB40, 0xEFCDFB40, 0xEFCEFB40, 0xEFCFFB40, 0xEFD0FB40, 0xEFD1FB40, 0xEFD2FB40, 0xEFD3FB40, 0xEFD4FB40, 0xEFD5FB40, 0xEFD6FB40, 0xEFD7FB40, 0xEFD8FB40, 0xEFD9FB40, 0xEFDAFB40, 0xEFDBFB40, 0xEFDCFB40, 0xEFDDFB40, 0xEFDEFB40, 0xEFDFFB40,
+		0xEFE0FB40, 0xEFE1FB40, 0xEFE2FB40, 0xEFE3FB40, 0xEFE4FB40, 0xEFE5FB40, 0xEFE6FB40, 0xEFE7FB40, 0xEFE8FB40, 0xEFE9FB40, 0xEFEAFB40, 0xEFEBFB40, 0xEFECFB40, 0xEFEDFB40, 0xEFEEFB40, 0xEFEFFB40, 0xEFF0FB40, 0xEFF1FB40, 0xEFF2FB40, 0xEFF3FB40, 0xEFF4FB40, 0xEFF5FB40, 0xEFF6FB40, 0xEFF7FB40, 0xEFF8FB40, 0xEFF9FB40, 0xEFFAFB40, 0xEFFBFB40, 0xEFFCFB40, 0xEFFDFB40, 0xEFFEFB40, 0xEFFFFB40,
+		0xF000FB40, 0xF001FB40, 0xF002FB40, 0xF003FB40, 0xF004FB40, 0xF005FB40, 0xF006FB40, 0xF007FB40, 0xF008FB40, 0xF009FB40, 0xF00AFB40, 0xF00BFB40, 0xF00CFB40, 0xF00DFB40, 0xF00EFB40, 0xF00FFB40, 0xF010FB40, 0xF011FB40, 0xF012FB40, 0xF013FB40, 0xF014FB40, 0xF015FB40, 0xF016FB40, 0xF017FB40, 0xF018FB40, 0xF019FB40, 0xF01AFB40, 0xF01BFB40, 0xF01CFB40, 0xF01DFB40, 0xF01EFB40, 0xF01FFB40,
+		0xF020FB40, 0xF021FB40, 0xF022FB40, 0xF023FB40, 0xF024FB40, 0xF025FB40, 0xF026FB40, 0xF027FB40, 0xF028FB40, 0xF029FB40, 0xF02AFB40, 0xF02BFB40, 0xF02CFB40, 0xF02DFB40, 0xF02EFB40, 0xF02FFB40, 0xF030FB40, 0xF031FB40, 0xF032FB40, 0xF033FB40, 0xF034FB40, 0xF035FB40, 0xF036FB40, 0xF037FB40, 0xF038FB40, 0xF039FB40, 0xF03AFB40, 0xF03BFB40, 0xF03CFB40, 0xF03DFB40, 0xF03EFB40, 0xF03FFB40,
+		0xF040FB40, 0xF041FB40, 0xF042FB40, 0xF043FB40, 0xF044FB40, 0xF045FB40, 0xF046FB40, 0xF047FB40, 0xF048FB40, 0xF049FB40, 0xF04AFB40, 0xF04BFB40, 0xF04CFB40, 0xF04DFB40, 0xF04EFB40, 0xF04FFB40, 0xF050FB40, 0xF051FB40, 0xF052FB40, 0xF053FB40, 0xF054FB40, 0xF055FB40, 0xF056FB40, 0xF057FB40, 0xF058FB40, 0xF059FB40, 0xF05AFB40, 0xF05BFB40, 0xF05CFB40, 0xF05DFB40, 0xF05EFB40, 0xF05FFB40,
+		0xF060FB40, 0xF061FB40, 0xF062FB40, 0xF063FB40, 0xF064FB40, 0xF065FB40, 0xF066FB40, 0xF067FB40, 0xF068FB40, 0xF069FB40, 0xF06AFB40, 0xF06BFB40, 0xF06CFB40, 0xF06DFB40, 0xF06EFB40, 0xF06FFB40, 0xF070FB40, 0xF071FB40, 0xF072FB40, 0xF073FB40, 0xF074FB40, 0xF075FB40, 0xF076FB40, 0xF077FB40, 0xF078FB40, 0xF079FB40, 0xF07AFB40, 0xF07BFB40, 0xF07CFB40, 0xF07DFB40, 0xF07EFB40, 0xF07FFB40,
+		0xF080FB40, 0xF081FB40, 0xF082FB40, 0xF083FB40, 0xF084FB40, 0xF085FB40, 0xF086FB40, 0xF087FB40, 0xF088FB40, 0xF089FB40, 0xF08AFB40, 0xF08BFB40, 0xF08CFB40, 0xF08DFB40, 0xF08EFB40, 0xF08FFB40, 0xF090FB40, 0xF091FB40, 0xF092FB40, 0xF093FB40, 0xF094FB40, 0xF095FB40, 0xF096FB40, 0xF097FB40, 0xF098FB40, 0xF099FB40, 0xF09AFB40, 0xF09BFB40, 0xF09CFB40, 0xF09DFB40, 0xF09EFB40, 0xF09FFB40,
+		0xF0A0FB40, 0xF0A1FB40, 0xF0A2FB40, 0xF0A3FB40, 0xF0A4FB40, 0xF0A5FB40, 0xF0A6FB40, 0xF0A7FB40, 0xF0A8FB40, 0xF0A9FB40, 0xF0AAFB40, 0xF0ABFB40, 0xF0ACFB40, 0xF0ADFB40, 0xF0AEFB40, 0xF0AFFB40, 0xF0B0FB40, 0xF0B1FB40, 0xF0B2FB40, 0xF0B3FB40, 0xF0B4FB40, 0xF0B5FB40, 0xF0B6FB40, 0xF0B7FB40, 0xF0B8FB40, 0xF0B9FB40, 0xF0BAFB40, 0xF0BBFB40, 0xF0BCFB40, 0xF0BDFB40, 0xF0BEFB40, 0xF0BFFB40,
+		0xF0C0FB40, 0xF0C1FB40, 0xF0C2FB40, 0xF0C3FB40, 0xF0C4FB40, 0xF0C5FB40, 0xF0C6FB40, 0xF0C7FB40, 0xF0C8FB40, 0xF0C9FB40, 0xF0CAFB40, 0xF0CBFB40, 0xF0CCFB40, 0xF0CDFB40, 0xF0CEFB40, 0xF0CFFB40, 0xF0D0FB40, 0xF0D1FB40, 0xF0D2FB40, 0xF0D3FB40, 0xF0D4FB40, 0xF0D5FB40, 0xF0D6FB40, 0xF0D7FB40, 0xF0D8FB40, 0xF0D9FB40, 0xF0DAFB40, 0xF0DBFB40, 0xF0DCFB40, 0xF0DDFB40, 0xF0DEFB40, 0xF0DFFB40,
+		0xF0E0FB40, 0xF0E1FB40, 0xF0E2FB40, 0xF0E3FB40, 0xF0E4FB40, 0xF0E5FB40, 0xF0E6FB40, 0xF0E7FB40, 0xF0E8FB40, 0xF0E9FB40, 0xF0EAFB40, 0xF0EBFB40, 0xF0ECFB40, 0xF0EDFB40, 0xF0EEFB40, 0xF0EFFB40, 0xF0F0FB40, 0xF0F1FB40, 0xF0F2FB40, 0xF0F3FB40, 0xF0F4FB40, 0xF0F5FB40, 0xF0F6FB40, 0xF0F7FB40, 0xF0F8FB40, 0xF0F9FB40, 0xF0FAFB40, 0xF0FBFB40, 0xF0FCFB40, 0xF0FDFB40, 0xF0FEFB40, 0xF0FFFB40,
+		0xF100FB40, 0xF101FB40, 0xF102FB40, 0xF103FB40, 0xF104FB40, 0xF105FB40, 0xF106FB40, 0xF107FB40, 0xF108FB40, 0xF109FB40, 0xF10AFB40, 0xF10BFB40, 0xF10CFB40, 0xF10DFB40, 0xF10EFB40, 0xF10FFB40, 0xF110FB40, 0xF111FB40, 0xF112FB40, 0xF113FB40, 0xF114FB40, 0xF115FB40, 0xF116FB40, 0xF117FB40, 0xF118FB40, 0xF119FB40, 0xF11AFB40, 0xF11BFB40, 0xF11CFB40, 0xF11DFB40, 0xF11EFB40, 0xF11FFB40,
+		0xF120FB40, 0xF121FB40, 0xF122FB40, 0xF123FB40, 0xF124FB40, 0xF125FB40, 0xF126FB40, 0xF127FB40, 0xF128FB40, 0xF129FB40, 0xF12AFB40, 0xF12BFB40, 0xF12CFB40, 0xF12DFB40, 0xF12EFB40, 0xF12FFB40, 0xF130FB40, 0xF131FB40, 0xF132FB40, 0xF133FB40, 0xF134FB40, 0xF135FB40, 0xF136FB40, 0xF137FB40, 0xF138FB40, 0xF139FB40, 0xF13AFB40, 0xF13BFB40, 0xF13CFB40, 0xF13DFB40, 0xF13EFB40, 0xF13FFB40,
+		0xF140FB40, 0xF141FB40, 0xF142FB40, 0xF143FB40, 0xF144FB40, 0xF145FB40, 0xF146FB40, 0xF147FB40, 0xF148FB40, 0xF149FB40, 0xF14AFB40, 0xF14BFB40, 0xF14CFB40, 0xF14DFB40, 0xF14EFB40, 0xF14FFB40, 0xF150FB40, 0xF151FB40, 0xF152FB40, 0xF153FB40, 0xF154FB40, 0xF155FB40, 0xF156FB40, 0xF157FB40, 0xF158FB40, 0xF159FB40, 0xF15AFB40, 0xF15BFB40, 0xF15CFB40, 0xF15DFB40, 0xF15EFB40, 0xF15FFB40,
+		0xF160FB40, 0xF161FB40, 0xF162FB40, 0xF163FB40, 0xF164FB40, 0xF165FB40, 0xF166FB40, 0xF167FB40, 0xF168FB40, 0xF169FB40, 0xF16AFB40, 0xF16BFB40, 0xF16CFB40, 0xF16DFB40, 0xF16EFB40, 0xF16FFB40, 0xF170FB40, 0xF171FB40, 0xF172FB40, 0xF173FB40, 0xF174FB40, 0xF175FB40, 0xF176FB40, 0xF177FB40, 0xF178FB40, 0xF179FB40, 0xF17AFB40, 0xF17BFB40, 0xF17CFB40, 0xF17DFB40, 0xF17EFB40, 0xF17FFB40,
+		0xF180FB40, 0xF181FB40, 0xF182FB40, 0xF183FB40, 0xF184FB40, 0xF185FB40, 0xF186FB40, 0xF187FB40, 0xF188FB40, 0xF189FB40, 0xF18AFB40, 0xF18BFB40, 0xF18CFB40, 0xF18DFB40, 0xF18EFB40, 0xF18FFB40, 0xF190FB40, 0xF191FB40, 0xF192FB40, 0xF193FB40, 0xF194FB40, 0xF195FB40, 0xF196FB40, 0xF197FB40, 0xF198FB40, 0xF199FB40, 0xF19AFB40, 0xF19BFB40, 0xF19CFB40, 0xF19DFB40, 0xF19EFB40, 0xF19FFB40,
+		0xF1A0FB40, 0xF1A1FB40, 0xF1A2FB40, 0xF1A3FB40, 0xF1A4FB40, 0xF1A5FB40, 0xF1A6FB40, 0xF1A7FB40, 0xF1A8FB40, 0xF1A9FB40, 0xF1AAFB40, 0xF1ABFB40, 0xF1ACFB40, 0xF1ADFB40, 0xF1AEFB40, 0xF1AFFB40, 0xF1B0FB40, 0xF1B1FB40, 0xF1B2FB40, 0xF1B3FB40, 0xF1B4FB40, 0xF1B5FB40, 0xF1B6FB40, 0xF1B7FB40, 0xF1B8FB40, 0xF1B9FB40, 0xF1BAFB40, 0xF1BBFB40, 0xF1BCFB40, 0xF1BDFB40, 0xF1BEFB40, 0xF1BFFB40,
+		0xF1C0FB40, 0xF1C1FB40, 0xF1C2FB40, 0xF1C3FB40, 0xF1C4FB40, 0xF1C5FB40, 0xF1C6FB40, 0xF1C7FB40, 0xF1C8FB40, 0xF1C9FB40, 0xF1CAFB40, 0xF1CBFB40, 0xF1CCFB40, 0xF1CDFB40, 0xF1CEFB40, 0xF1CFFB40, 0xF1D0FB40, 0xF1D1FB40, 0xF1D2FB40, 0xF1D3FB40, 0xF1D4FB40, 0xF1D5FB40, 0xF1D6FB40, 0xF1D7FB40, 0xF1D8FB40, 0xF1D9FB40, 0xF1DAFB40, 0xF1DBFB40, 0xF1DCFB40, 0xF1DDFB40, 0xF1DEFB40, 0xF1DFFB40,
+		0xF1E0FB40, 0xF1E1FB40, 0xF1E2FB40, 0xF1E3FB40, 0xF1E4FB40, 0xF1E5FB40, 0xF1E6FB40, 0xF1E7FB40, 0xF1E8FB40, 0xF1E9FB40, 0xF1EAFB40, 0xF1EBFB40, 0xF1ECFB40, 0xF1EDFB40, 0xF1EEFB40, 0xF1EFFB40, 0xF1F0FB40, 0xF1F1FB40, 0xF1F2FB40, 0xF1F3FB40, 0xF1F4FB40, 0xF1F5FB40, 0xF1F6FB40, 0xF1F7FB40, 0xF1F8FB40, 0xF1F9FB40, 0xF1FAFB40, 0xF1FBFB40, 0xF1FCFB40, 0xF1FDFB40, 0xF1FEFB40, 0xF1FFFB40,
+		0xF200FB40, 0xF201FB40, 0xF202FB40, 0xF203FB40, 0xF204FB40, 0xF205FB40, 0xF206FB40, 0xF207FB40, 0xF208FB40, 0xF209FB40, 0xF20AFB40, 0xF20BFB40, 0xF20CFB40, 0xF20DFB40, 0xF20EFB40, 0xF20FFB40, 0xF210FB40, 0xF211FB40, 0xF212FB40, 0xF213FB40, 0xF214FB40, 0xF215FB40, 0xF216FB40, 0xF217FB40, 0xF218FB40, 0xF219FB40, 0xF21AFB40, 0xF21BFB40, 0xF21CFB40, 0xF21DFB40, 0xF21EFB40, 0xF21FFB40,
+		0xF220FB40, 0xF221FB40, 0xF222FB40, 0xF223FB40, 0xF224FB40, 0xF225FB40, 0xF226FB40, 0xF227FB40, 0xF228FB40, 0xF229FB40, 0xF22AFB40, 0xF22BFB40, 0xF22CFB40, 0xF22DFB40, 0xF22EFB40, 0xF22FFB40, 0xF230FB40, 0xF231FB40, 0xF232FB40, 0xF233FB40, 0xF234FB40, 0xF235FB40, 0xF236FB40, 0xF237FB40, 0xF238FB40, 0xF239FB40, 0xF23AFB40, 0xF23BFB40, 0xF23CFB40, 0xF23DFB40, 0xF23EFB40, 0xF23FFB40,
+		0xF240FB40, 0xF241FB40, 0xF242FB40, 0xF243FB40, 0xF244FB40, 0xF245FB40, 0xF246FB40, 0xF247FB40, 0xF248FB40, 0xF249FB40, 0xF24AFB40, 0xF24BFB40, 0xF24CFB40, 0xF24DFB40, 0xF24EFB40, 0xF24FFB40, 0xF250FB40, 0xF251FB40, 0xF252FB40, 0xF253FB40, 0xF254FB40, 0xF255FB40, 0xF256FB40, 0xF257FB40, 0xF258FB40, 0xF259FB40, 0xF25AFB40, 0xF25BFB40, 0xF25CFB40, 0xF25DFB40, 0xF25EFB40, 0xF25FFB40,
+		0xF260FB40, 0xF261FB40, 0xF262FB40, 0xF263FB40, 0xF264FB40, 0xF265FB40, 0xF266FB40, 0xF267FB40, 0xF268FB40, 0xF269FB40, 0xF26AFB40, 0xF26BFB40, 0xF26CFB40, 0xF26DFB40, 0xF26EFB40, 0xF26FFB40, 0xF270FB40, 0xF271FB40, 0xF272FB40, 0xF273FB40, 0xF274FB40, 0xF275FB40, 0xF276FB40, 0xF277FB40, 0xF278FB40, 0xF279FB40, 0xF27AFB40, 0xF27BFB40, 0xF27CFB40, 0xF27DFB40, 0xF27EFB40, 0xF27FFB40,
+		0xF280FB40, 0xF281FB40, 0xF282FB40, 0xF283FB40, 0xF284FB40, 0xF285FB40, 0xF286FB40, 0xF287FB40, 0xF288FB40, 0xF289FB40, 0xF28AFB40, 0xF28BFB40, 0xF28CFB40, 0xF28DFB40, 0xF28EFB40, 0xF28FFB40, 0xF290FB40, 0xF291FB40, 0xF292FB40, 0xF293FB40, 0xF294FB40, 0xF295FB40, 0xF296FB40, 0xF297FB40, 0xF298FB40, 0xF299FB40, 0xF29AFB40, 0xF29BFB40, 0xF29CFB40, 0xF29DFB40, 0xF29EFB40, 0xF29FFB40,
+		0xF2A0FB40, 0xF2A1FB40, 0xF2A2FB40, 0xF2A3FB40, 0xF2A4FB40, 0xF2A5FB40, 0xF2A6FB40, 0xF2A7FB40, 0xF2A8FB40, 0xF2A9FB40, 0xF2AAFB40, 0xF2ABFB40, 0xF2ACFB40, 0xF2ADFB40, 0xF2AEFB40, 0xF2AFFB40, 0xF2B0FB40, 0xF2B1FB40, 0xF2B2FB40, 0xF2B3FB40, 0xF2B4FB40, 0xF2B5FB40, 0xF2B6FB40, 0xF2B7FB40, 0xF2B8FB40, 0xF2B9FB40, 0xF2BAFB40, 0xF2BBFB40, 0xF2BCFB40, 0xF2BDFB40, 0xF2BEFB40, 0xF2BFFB40,
+		0xF2C0FB40, 0xF2C1FB40, 0xF2C2FB40, 0xF2C3FB40, 0xF2C4FB40, 0xF2C5FB40, 0xF2C6FB40, 0xF2C7FB40, 0xF2C8FB40, 0xF2C9FB40, 0xF2CAFB40, 0xF2CBFB40, 0xF2CCFB40, 0xF2CDFB40, 0xF2CEFB40, 0xF2CFFB40, 0xF2D0FB40, 0xF2D1FB40, 0xF2D2FB40, 0xF2D3FB40, 0xF2D4FB40, 0xF2D5FB40, 0xF2D6FB40, 0xF2D7FB40, 0xF2D8FB40, 0xF2D9FB40, 0xF2DAFB40, 0xF2DBFB40, 0xF2DCFB40, 0xF2DDFB40, 0xF2DEFB40, 0xF2DFFB40,
+		0xF2E0FB40, 0xF2E1FB40, 0xF2E2FB40, 0xF2E3FB40, 0xF2E4FB40, 0xF2E5FB40, 0xF2E6FB40, 0xF2E7FB40, 0xF2E8FB40, 0xF2E9FB40, 0xF2EAFB40, 0xF2EBFB40, 0xF2ECFB40, 0xF2EDFB40, 0xF2EEFB40, 0xF2EFFB40, 0xF2F0FB40, 0xF2F1FB40, 0xF2F2FB40, 0xF2F3FB40, 0xF2F4FB40, 0xF2F5FB40, 0xF2F6FB40, 0xF2F7FB40, 0xF2F8FB40, 0xF2F9FB40, 0xF2FAFB40, 0xF2FBFB40, 0xF2FCFB40, 0xF2FDFB40, 0xF2FEFB40, 0xF2FFFB40,
+		0xF300FB40, 0xF301FB40, 0xF302FB40, 0xF303FB40, 0xF304FB40, 0xF305FB40, 0xF306FB40, 0xF307FB40, 0xF308FB40, 0xF309FB40, 0xF30AFB40, 0xF30BFB40, 0xF30CFB40, 0xF30DFB40, 0xF30EFB40, 0xF30FFB40, 0xF310FB40, 0xF311FB40, 0xF312FB40, 0xF313FB40, 0xF314FB40, 0xF315FB40, 0xF316FB40, 0xF317FB40, 0xF318FB40, 0xF319FB40, 0xF31AFB40, 0xF31BFB40, 0xF31CFB40, 0xF31DFB40, 0xF31EFB40, 0xF31FFB40,
+		0xF320FB40, 0xF321FB40, 0xF322FB40, 0xF323FB40, 0xF324FB40, 0xF325FB40, 0xF326FB40, 0xF327FB40, 0xF328FB40, 0xF329FB40, 0xF32AFB40, 0xF32BFB40, 0xF32CFB40, 0xF32DFB40, 0xF32EFB40, 0xF32FFB40, 0xF330FB40, 0xF331FB40, 0xF332FB40, 0xF333FB40, 0xF334FB40, 0xF335FB40, 0xF336FB40, 0xF337FB40, 0xF338FB40, 0xF339FB40, 0xF33AFB40, 0xF33BFB40, 0xF33CFB40, 0xF33DFB40, 0xF33EFB40, 0xF33FFB40,
+		0xF340FB40, 0xF341FB40, 0xF342FB40, 0xF343FB40, 0xF344FB40, 0xF345FB40, 0xF346FB40, 0xF347FB40, 0xF348FB40, 0xF349FB40, 0xF34AFB40, 0xF34BFB40, 0xF34CFB40, 0xF34DFB40, 0xF34EFB40, 0xF34FFB40, 0xF350FB40, 0xF351FB40, 0xF352FB40, 0xF353FB40, 0xF354FB40, 0xF355FB40, 0xF356FB40, 0xF357FB40, 0xF358FB40, 0xF359FB40, 0xF35AFB40, 0xF35BFB40, 0xF35CFB40, 0xF35DFB40, 0xF35EFB40, 0xF35FFB40,
+		0xF360FB40, 0xF361FB40, 0xF362FB40, 0xF363FB40, 0xF364FB40, 0xF365FB40, 0xF366FB40, 0xF367FB40, 0xF368FB40, 0xF369FB40, 0xF36AFB40, 0xF36BFB40, 0xF36CFB40, 0xF36DFB40, 0xF36EFB40, 0xF36FFB40, 0xF370FB40, 0xF371FB40, 0xF372FB40, 0xF373FB40, 0xF374FB40, 0xF375FB40, 0xF376FB40, 0xF377FB40, 0xF378FB40, 0xF379FB40, 0xF37AFB40, 0xF37BFB40, 0xF37CFB40, 0xF37DFB40, 0xF37EFB40, 0xF37FFB40,
+		0xF380FB40, 0xF381FB40, 0xF382FB40, 0xF383FB40, 0xF384FB40, 0xF385FB40, 0xF386FB40, 0xF387FB40, 0xF388FB40, 0xF389FB40, 0xF38AFB40, 0xF38BFB40, 0xF38CFB40, 0xF38DFB40, 0xF38EFB40, 0xF38FFB40, 0xF390FB40, 0xF391FB40, 0xF392FB40, 0xF393FB40, 0xF394FB40, 0xF395FB40, 0xF396FB40, 0xF397FB40, 0xF398FB40, 0xF399FB40, 0xF39AFB40, 0xF39BFB40, 0xF39CFB40, 0xF39DFB40, 0xF39EFB40, 0xF39FFB40,
+		0xF3A0FB40, 0xF3A1FB40, 0xF3A2FB40, 0xF3A3FB40, 0xF3A4FB40, 0xF3A5FB40, 0xF3A6FB40, 0xF3A7FB40, 0xF3A8FB40, 0xF3A9FB40, 0xF3AAFB40, 0xF3ABFB40, 0xF3ACFB40, 0xF3ADFB40, 0xF3AEFB40, 0xF3AFFB40, 0xF3B0FB40, 0xF3B1FB40, 0xF3B2FB40, 0xF3B3FB40, 0xF3B4FB40, 0xF3B5FB40, 0xF3B6FB40, 0xF3B7FB40, 0xF3B8FB40, 0xF3B9FB40, 0xF3BAFB40, 0xF3BBFB40, 0xF3BCFB40, 0xF3BDFB40, 0xF3BEFB40, 0xF3BFFB40,
+		0xF3C0FB40, 0xF3C1FB40, 0xF3C2FB40, 0xF3C3FB40, 0xF3C4FB40, 0xF3C5FB40, 0xF3C6FB40, 0xF3C7FB40, 0xF3C8FB40, 0xF3C9FB40, 0xF3CAFB40, 0xF3CBFB40, 0xF3CCFB40, 0xF3CDFB40, 0xF3CEFB40, 0xF3CFFB40, 0xF3D0FB40, 0xF3D1FB40, 0xF3D2FB40, 0xF3D3FB40, 0xF3D4FB40, 0xF3D5FB40, 0xF3D6FB40, 0xF3D7FB40, 0xF3D8FB40, 0xF3D9FB40, 0xF3DAFB40, 0xF3DBFB40, 0xF3DCFB40, 0xF3DDFB40, 0xF3DEFB40, 0xF3DFFB40,
+		0xF3E0FB40, 0xF3E1FB40, 0xF3E2FB40, 0xF3E3FB40, 0xF3E4FB40, 0xF3E5FB40, 0xF3E6FB40, 0xF3E7FB40, 0xF3E8FB40, 0xF3E9FB40, 0xF3EAFB40, 0xF3EBFB40, 0xF3ECFB40, 0xF3EDFB40, 0xF3EEFB40, 0xF3EFFB40, 0xF3F0FB40, 0xF3F1FB40, 0xF3F2FB40, 0xF3F3FB40, 0xF3F4FB40, 0xF3F5FB40, 0xF3F6FB40, 0xF3F7FB40, 0xF3F8FB40, 0xF3F9FB40, 0xF3FAFB40, 0xF3FBFB40, 0xF3FCFB40, 0xF3FDFB40, 0xF3FEFB40, 0xF3FFFB40,
+		0xF400FB40, 0xF401FB40, 0xF402FB40, 0xF403FB40, 0xF404FB40, 0xF405FB40, 0xF406FB40, 0xF407FB40, 0xF408FB40, 0xF409FB40, 0xF40AFB40, 0xF40BFB40, 0xF40CFB40, 0xF40DFB40, 0xF40EFB40, 0xF40FFB40, 0xF410FB40, 0xF411FB40, 0xF412FB40, 0xF413FB40, 0xF414FB40, 0xF415FB40, 0xF416FB40, 0xF417FB40, 0xF418FB40, 0xF419FB40, 0xF41AFB40, 0xF41BFB40, 0xF41CFB40, 0xF41DFB40, 0xF41EFB40, 0xF41FFB40,
+		0xF420FB40, 0xF421FB40, 0xF422FB40, 0xF423FB40, 0xF424FB40, 0xF425FB40, 0xF426FB40, 0xF427FB40, 0xF428FB40, 0xF429FB40, 0xF42AFB40, 0xF42BFB40, 0xF42CFB40, 0xF42DFB40, 0xF42EFB40, 0xF42FFB40, 0xF430FB40, 0xF431FB40, 0xF432FB40, 0xF433FB40, 0xF434FB40, 0xF435FB40, 0xF436FB40, 0xF437FB40, 0xF438FB40, 0xF439FB40, 0xF43AFB40, 0xF43BFB40, 0xF43CFB40, 0xF43DFB40, 0xF43EFB40, 0xF43FFB40,
+		0xF440FB40, 0xF441FB40, 0xF442FB40, 0xF443FB40, 0xF444FB40, 0xF445FB40, 0xF446FB40, 0xF447FB40, 0xF448FB40, 0xF449FB40, 0xF44AFB40, 0xF44BFB40, 0xF44CFB40, 0xF44DFB40, 0xF44EFB40, 0xF44FFB40, 0xF450FB40, 0xF451FB40, 0xF452FB40, 0xF453FB40, 0xF454FB40, 0xF455FB40, 0xF456FB40, 0xF457FB40, 0xF458FB40, 0xF459FB40, 0xF45AFB40, 0xF45BFB40, 0xF45CFB40, 0xF45DFB40, 0xF45EFB40, 0xF45FFB40,
+		0xF460FB40, 0xF461FB40, 0xF462FB40, 0xF463FB40, 0xF464FB40, 0xF465FB40, 0xF466FB40, 0xF467FB40, 0xF468FB40, 0xF469FB40, 0xF46AFB40, 0xF46BFB40, 0xF46CFB40, 0xF46DFB40, 0xF46EFB40, 0xF46FFB40, 0xF470FB40, 0xF471FB40, 0xF472FB40, 0xF473FB40, 0xF474FB40, 0xF475FB40, 0xF476FB40, 0xF477FB40, 0xF478FB40, 0xF479FB40, 0xF47AFB40, 0xF47BFB40, 0xF47CFB40, 0xF47DFB40, 0xF47EFB40, 0xF47FFB40,
+		0xF480FB40, 0xF481FB40, 0xF482FB40, 0xF483FB40, 0xF484FB40, 0xF485FB40, 0xF486FB40, 0xF487FB40, 0xF488FB40, 0xF489FB40, 0xF48AFB40, 0xF48BFB40, 0xF48CFB40, 0xF48DFB40, 0xF48EFB40, 0xF48FFB40, 0xF490FB40, 0xF491FB40, 0xF492FB40, 0xF493FB40, 0xF494FB40, 0xF495FB40, 0xF496FB40, 0xF497FB40, 0xF498FB40, 0xF499FB40, 0xF49AFB40, 0xF49BFB40, 0xF49CFB40, 0xF49DFB40, 0xF49EFB40, 0xF49FFB40,
+		0xF4A0FB40, 0xF4A1FB40, 0xF4A2FB40, 0xF4A3FB40, 0xF4A4FB40, 0xF4A5FB40, 0xF4A6FB40, 0xF4A7FB40, 0xF4A8FB40, 0xF4A9FB40, 0xF4AAFB40, 0xF4ABFB40, 0xF4ACFB40, 0xF4ADFB40, 0xF4AEFB40, 0xF4AFFB40, 0xF4B0FB40, 0xF4B1FB40, 0xF4B2FB40, 0xF4B3FB40, 0xF4B4FB40, 0xF4B5FB40, 0xF4B6FB40, 0xF4B7FB40, 0xF4B8FB40, 0xF4B9FB40, 0xF4BAFB40, 0xF4BBFB40, 0xF4BCFB40, 0xF4BDFB40, 0xF4BEFB40, 0xF4BFFB40,
+		0xF4C0FB40, 0xF4C1FB40, 0xF4C2FB40, 0xF4C3FB40, 0xF4C4FB40, 0xF4C5FB40, 0xF4C6FB40, 0xF4C7FB40, 0xF4C8FB40, 0xF4C9FB40, 0xF4CAFB40, 0xF4CBFB40, 0xF4CCFB40, 0xF4CDFB40, 0xF4CEFB40, 0xF4CFFB40, 0xF4D0FB40, 0xF4D1FB40, 0xF4D2FB40, 0xF4D3FB40, 0xF4D4FB40, 0xF4D5FB40, 0xF4D6FB40, 0xF4D7FB40, 0xF4D8FB40, 0xF4D9FB40, 0xF4DAFB40, 0xF4DBFB40, 0xF4DCFB40, 0xF4DDFB40, 0xF4DEFB40, 0xF4DFFB40,
+		0xF4E0FB40, 0xF4E1FB40, 0xF4E2FB40, 0xF4E3FB40, 0xF4E4FB40, 0xF4E5FB40, 0xF4E6FB40, 0xF4E7FB40, 0xF4E8FB40, 0xF4E9FB40, 0xF4EAFB40, 0xF4EBFB40, 0xF4ECFB40, 0xF4EDFB40, 0xF4EEFB40, 0xF4EFFB40, 0xF4F0FB40, 0xF4F1FB40, 0xF4F2FB40, 0xF4F3FB40, 0xF4F4FB40, 0xF4F5FB40, 0xF4F6FB40, 0xF4F7FB40, 0xF4F8FB40, 0xF4F9FB40, 0xF4FAFB40, 0xF4FBFB40, 0xF4FCFB40, 0xF4FDFB40, 0xF4FEFB40, 0xF4FFFB40,
+		0xF500FB40, 0xF501FB40, 0xF502FB40, 0xF503FB40, 0xF504FB40, 0xF505FB40, 0xF506FB40, 0xF507FB40, 0xF508FB40, 0xF509FB40, 0xF50AFB40, 0xF50BFB40, 0xF50CFB40, 0xF50DFB40, 0xF50EFB40, 0xF50FFB40, 0xF510FB40, 0xF511FB40, 0xF512FB40, 0xF513FB40, 0xF514FB40, 0xF515FB40, 0xF516FB40, 0xF517FB40, 0xF518FB40, 0xF519FB40, 0xF51AFB40, 0xF51BFB40, 0xF51CFB40, 0xF51DFB40, 0xF51EFB40, 0xF51FFB40,
+		0xF520FB40, 0xF521FB40, 0xF522FB40, 0xF523FB40, 0xF524FB40, 0xF525FB40, 0xF526FB40, 0xF527FB40, 0xF528FB40, 0xF529FB40, 0xF52AFB40, 0xF52BFB40, 0xF52CFB40, 0xF52DFB40, 0xF52EFB40, 0xF52FFB40, 0xF530FB40, 0xF531FB40, 0xF532FB40, 0xF533FB40, 0xF534FB40, 0xF535FB40, 0xF536FB40, 0xF537FB40, 0xF538FB40, 0xF539FB40, 0xF53AFB40, 0xF53BFB40, 0xF53CFB40, 0xF53DFB40, 0xF53EFB40, 0xF53FFB40,
+		0xF540FB40, 0xF541FB40, 0xF542FB40, 0xF543FB40, 0xF544FB40, 0xF545FB40, 0xF546FB40, 0xF547FB40, 0xF548FB40, 0xF549FB40, 0xF54AFB40, 0xF54BFB40, 0xF54CFB40, 0xF54DFB40, 0xF54EFB40, 0xF54FFB40, 0xF550FB40, 0xF551FB40, 0xF552FB40, 0xF553FB40, 0xF554FB40, 0xF555FB40, 0xF556FB40, 0xF557FB40, 0xF558FB40, 0xF559FB40, 0xF55AFB40, 0xF55BFB40, 0xF55CFB40, 0xF55DFB40, 0xF55EFB40, 0xF55FFB40,
+		0xF560FB40, 0xF561FB40, 0xF562FB40, 0xF563FB40, 0xF564FB40, 0xF565FB40, 0xF566FB40, 0xF567FB40, 0xF568FB40, 0xF569FB40, 0xF56AFB40, 0xF56BFB40, 0xF56CFB40, 0xF56DFB40, 0xF56EFB40, 0xF56FFB40, 0xF570FB40, 0xF571FB40, 0xF572FB40, 0xF573FB40, 0xF574FB40, 0xF575FB40, 0xF576FB40, 0xF577FB40, 0xF578FB40, 0xF579FB40, 0xF57AFB40, 0xF57BFB40, 0xF57CFB40, 0xF57DFB40, 0xF57EFB40, 0xF57FFB40,
+		0xF580FB40, 0xF581FB40, 0xF582FB40, 0xF583FB40, 0xF584FB40, 0xF585FB40, 0xF586FB40, 0xF587FB40, 0xF588FB40, 0xF589FB40, 0xF58AFB40, 0xF58BFB40, 0xF58CFB40, 0xF58DFB40, 0xF58EFB40, 0xF58FFB40, 0xF590FB40, 0xF591FB40, 0xF592FB40, 0xF593FB40, 0xF594FB40, 0xF595FB40, 0xF596FB40, 0xF597FB40, 0xF598FB40, 0xF599FB40, 0xF59AFB40, 0xF59BFB40, 0xF59CFB40, 0xF59DFB40, 0xF59EFB40, 0xF59FFB40,
+		0xF5A0FB40, 0xF5A1FB40, 0xF5A2FB40, 0xF5A3FB40, 0xF5A4FB40, 0xF5A5FB40, 0xF5A6FB40, 0xF5A7FB40, 0xF5A8FB40, 0xF5A9FB40, 0xF5AAFB40, 0xF5ABFB40, 0xF5ACFB40, 0xF5ADFB40, 0xF5AEFB40, 0xF5AFFB40, 0xF5B0FB40, 0xF5B1FB40, 0xF5B2FB40, 0xF5B3FB40, 0xF5B4FB40, 0xF5B5FB40, 0xF5B6FB40, 0xF5B7FB40, 0xF5B8FB40, 0xF5B9FB40, 0xF5BAFB40, 0xF5BBFB40, 0xF5BCFB40, 0xF5BDFB40, 0xF5BEFB40, 0xF5BFFB40,
+		0xF5C0FB40, 0xF5C1FB40, 0xF5C2FB40, 0xF5C3FB40, 0xF5C4FB40, 0xF5C5FB40, 0xF5C6FB40, 0xF5C7FB40, 0xF5C8FB40, 0xF5C9FB40, 0xF5CAFB40, 0xF5CBFB40, 0xF5CCFB40, 0xF5CDFB40, 0xF5CEFB40, 0xF5CFFB40, 0xF5D0FB40, 0xF5D1FB40, 0xF5D2FB40, 0xF5D3FB40, 0xF5D4FB40, 0xF5D5FB40, 0xF5D6FB40, 0xF5D7FB40, 0xF5D8FB40, 0xF5D9FB40, 0xF5DAFB40, 0xF5DBFB40, 0xF5DCFB40, 0xF5DDFB40, 0xF5DEFB40, 0xF5DFFB40,
+		0xF5E0FB40, 0xF5E1FB40, 0xF5E2FB40, 0xF5E3FB40, 0xF5E4FB40, 0xF5E5FB40, 0xF5E6FB40, 0xF5E7FB40, 0xF5E8FB40, 0xF5E9FB40, 0xF5EAFB40, 0xF5EBFB40, 0xF5ECFB40, 0xF5EDFB40, 0xF5EEFB40, 0xF5EFFB40, 0xF5F0FB40, 0xF5F1FB40, 0xF5F2FB40, 0xF5F3FB40, 0xF5F4FB40, 0xF5F5FB40, 0xF5F6FB40, 0xF5F7FB40, 0xF5F8FB40, 0xF5F9FB40, 0xF5FAFB40, 0xF5FBFB40, 0xF5FCFB40, 0xF5FDFB40, 0xF5FEFB40, 0xF5FFFB40,
+		0xF600FB40, 0xF601FB40, 0xF602FB40, 0xF603FB40, 0xF604FB40, 0xF605FB40, 0xF606FB40, 0xF607FB40, 0xF608FB40, 0xF609FB40, 0xF60AFB40, 0xF60BFB40, 0xF60CFB40, 0xF60DFB40, 0xF60EFB40, 0xF60FFB40, 0xF610FB40, 0xF611FB40, 0xF612FB40, 0xF613FB40, 0xF614FB40, 0xF615FB40, 0xF616FB40, 0xF617FB40, 0xF618FB40, 0xF619FB40, 0xF61AFB40, 0xF61BFB40, 0xF61CFB40, 0xF61DFB40, 0xF61EFB40, 0xF61FFB40,
+		0xF620FB40, 0xF621FB40, 0xF622FB40, 0xF623FB40, 0xF624FB40, 0xF625FB40, 0xF626FB40, 0xF627FB40, 0xF628FB40, 0xF629FB40, 0xF62AFB40, 0xF62BFB40, 0xF62CFB40, 0xF62DFB40, 0xF62EFB40, 0xF62FFB40, 0xF630FB40, 0xF631FB40, 0xF632FB40, 0xF633FB40, 0xF634FB40, 0xF635FB40, 0xF636FB40, 0xF637FB40, 0xF638FB40, 0xF639FB40, 0xF63AFB40, 0xF63BFB40, 0xF63CFB40, 0xF63DFB40, 0xF63EFB40, 0xF63FFB40,
+		0xF640FB40, 0xF641FB40, 0xF642FB40, 0xF643FB40, 0xF644FB40, 0xF645FB40, 0xF646FB40, 0xF647FB40, 0xF648FB40, 0xF649FB40, 0xF64AFB40, 0xF64BFB40, 0xF64CFB40, 0xF64DFB40, 0xF64EFB40, 0xF64FFB40, 0xF650FB40, 0xF651FB40, 0xF652FB40, 0xF653FB40, 0xF654FB40, 0xF655FB40, 0xF656FB40, 0xF657FB40, 0xF658FB40, 0xF659FB40, 0xF65AFB40, 0xF65BFB40, 0xF65CFB40, 0xF65DFB40, 0xF65EFB40, 0xF65FFB40,
+		0xF660FB40, 0xF661FB40, 0xF662FB40, 0xF663FB40, 0xF664FB40, 0xF665FB40, 0xF666FB40, 0xF667FB40, 0xF668FB40, 0xF669FB40, 0xF66AFB40, 0xF66BFB40, 0xF66CFB40, 0xF66DFB40, 0xF66EFB40, 0xF66FFB40, 0xF670FB40, 0xF671FB40, 0xF672FB40, 0xF673FB40, 0xF674FB40, 0xF675FB40, 0xF676FB40, 0xF677FB40, 0xF678FB40, 0xF679FB40, 0xF67AFB40, 0xF67BFB40, 0xF67CFB40, 0xF67DFB40, 0xF67EFB40, 0xF67FFB40,
+		0xF680FB40, 0xF681FB40, 0xF682FB40, 0xF683FB40, 0xF684FB40, 0xF685FB40, 0xF686FB40, 0xF687FB40, 0xF688FB40, 0xF689FB40, 0xF68AFB40, 0xF68BFB40, 0xF68CFB40, 0xF68DFB40, 0xF68EFB40, 0xF68FFB40, 0xF690FB40, 0xF691FB40, 0xF692FB40, 0xF693FB40, 0xF694FB40, 0xF695FB40, 0xF696FB40, 0xF697FB40, 0xF698FB40, 0xF699FB40, 0xF69AFB40, 0xF69BFB40, 0xF69CFB40, 0xF69DFB40, 0xF69EFB40, 0xF69FFB40,
+		0xF6A0FB40, 0xF6A1FB40, 0xF6A2FB40, 0xF6A3FB40, 0xF6A4FB40, 0xF6A5FB40, 0xF6A6FB40, 0xF6A7FB40, 0xF6A8FB40, 0xF6A9FB40, 0xF6AAFB40, 0xF6ABFB40, 0xF6ACFB40, 0xF6ADFB40, 0xF6AEFB40, 0xF6AFFB40, 0xF6B0FB40, 0xF6B1FB40, 0xF6B2FB40, 0xF6B3FB40, 0xF6B4FB40, 0xF6B5FB40, 0xF6B6FB40, 0xF6B7FB40, 0xF6B8FB40, 0xF6B9FB40, 0xF6BAFB40, 0xF6BBFB40, 0xF6BCFB40, 0xF6BDFB40, 0xF6BEFB40, 0xF6BFFB40,
+		0xF6C0FB40, 0xF6C1FB40, 0xF6C2FB40, 0xF6C3FB40, 0xF6C4FB40, 0xF6C5FB40, 0xF6C6FB40, 0xF6C7FB40, 0xF6C8FB40, 0xF6C9FB40, 0xF6CAFB40, 0xF6CBFB40, 0xF6CCFB40, 0xF6CDFB40, 0xF6CEFB40, 0xF6CFFB40, 0xF6D0FB40, 0xF6D1FB40, 0xF6D2FB40, 0xF6D3FB40, 0xF6D4FB40, 0xF6D5FB40, 0xF6D6FB40, 0xF6D7FB40, 0xF6D8FB40, 0xF6D9FB40, 0xF6DAFB40, 0xF6DBFB40, 0xF6DCFB40, 0xF6DDFB40, 0xF6DEFB40, 0xF6DFFB40,
+		0xF6E0FB40, 0xF6E1FB40, 0xF6E2FB40, 0xF6E3FB40, 0xF6E4FB40, 0xF6E5FB40, 0xF6E6FB40, 0xF6E7FB40, 0xF6E8FB40, 0xF6E9FB40, 0xF6EAFB40, 0xF6EBFB40, 0xF6ECFB40, 0xF6EDFB40, 0xF6EEFB40, 0xF6EFFB40, 0xF6F0FB40, 0xF6F1FB40, 0xF6F2FB40, 0xF6F3FB40, 0xF6F4FB40, 0xF6F5FB40, 0xF6F6FB40, 0xF6F7FB40, 0xF6F8FB40, 0xF6F9FB40, 0xF6FAFB40, 0xF6FBFB40, 0xF6FCFB40, 0xF6FDFB40, 0xF6FEFB40, 0xF6FFFB40,
+		0xF700FB40, 0xF701FB40, 0xF702FB40, 0xF703FB40, 0xF704FB40, 0xF705FB40, 0xF706FB40, 0xF707FB40, 0xF708FB40, 0xF709FB40, 0xF70AFB40, 0xF70BFB40, 0xF70CFB40, 0xF70DFB40, 0xF70EFB40, 0xF70FFB40, 0xF710FB40, 0xF711FB40, 0xF712FB40, 0xF713FB40, 0xF714FB40, 0xF715FB40, 0xF716FB40, 0xF717FB40, 0xF718FB40, 0xF719FB40, 0xF71AFB40, 0xF71BFB40, 0xF71CFB40, 0xF71DFB40, 0xF71EFB40, 0xF71FFB40,
+		0xF720FB40, 0xF721FB40, 0xF722FB40, 0xF723FB40, 0xF724FB40, 0xF725FB40, 0xF726FB40, 0xF727FB40, 0xF728FB40, 0xF729FB40, 0xF72AFB40, 0xF72BFB40, 0xF72CFB40, 0xF72DFB40, 0xF72EFB40, 0xF72FFB40, 0xF730FB40, 0xF731FB40, 0xF732FB40, 0xF733FB40, 0xF734FB40, 0xF735FB40, 0xF736FB40, 0xF737FB40, 0xF738FB40, 0xF739FB40, 0xF73AFB40, 0xF73BFB40, 0xF73CFB40, 0xF73DFB40, 0xF73EFB40, 0xF73FFB40,
+		0xF740FB40, 0xF741FB40, 0xF742FB40, 0xF743FB40, 0xF744FB40, 0xF745FB40, 0xF746FB40, 0xF747FB40, 0xF748FB40, 0xF749FB40, 0xF74AFB40, 0xF74BFB40, 0xF74CFB40, 0xF74DFB40, 0xF74EFB40, 0xF74FFB40, 0xF750FB40, 0xF751FB40, 0xF752FB40, 0xF753FB40, 0xF754FB40, 0xF755FB40, 0xF756FB40, 0xF757FB40, 0xF758FB40, 0xF759FB40, 0xF75AFB40, 0xF75BFB40, 0xF75CFB40, 0xF75DFB40, 0xF75EFB40, 0xF75FFB40,
+		0xF760FB40, 0xF761FB40, 0xF762FB40, 0xF763FB40, 0xF764FB40, 0xF765FB40, 0xF766FB40, 0xF767FB40, 0xF768FB40, 0xF769FB40, 0xF76AFB40, 0xF76BFB40, 0xF76CFB40, 0xF76DFB40, 0xF76EFB40, 0xF76FFB40, 0xF770FB40, 0xF771FB40, 0xF772FB40, 0xF773FB40, 0xF774FB40, 0xF775FB40, 0xF776FB40, 0xF777FB40, 0xF778FB40, 0xF779FB40, 0xF77AFB40, 0xF77BFB40, 0xF77CFB40, 0xF77DFB40, 0xF77EFB40, 0xF77FFB40,
+		0xF780FB40, 0xF781FB40, 0xF782FB40, 0xF783FB40, 0xF784FB40, 0xF785FB40, 0xF786FB40, 0xF787FB40, 0xF788FB40, 0xF789FB40, 0xF78AFB40, 0xF78BFB40, 0xF78CFB40, 0xF78DFB40, 0xF78EFB40, 0xF78FFB40, 0xF790FB40, 0xF791FB40, 0xF792FB40, 0xF793FB40, 0xF794FB40, 0xF795FB40, 0xF796FB40, 0xF797FB40, 0xF798FB40, 0xF799FB40, 0xF79AFB40, 0xF79BFB40, 0xF79CFB40, 0xF79DFB40, 0xF79EFB40, 0xF79FFB40,
+		0xF7A0FB40, 0xF7A1FB40, 0xF7A2FB40, 0xF7A3FB40, 0xF7A4FB40, 0xF7A5FB40, 0xF7A6FB40, 0xF7A7FB40, 0xF7A8FB40, 0xF7A9FB40, 0xF7AAFB40, 0xF7ABFB40, 0xF7ACFB40, 0xF7ADFB40, 0xF7AEFB40, 0xF7AFFB40, 0xF7B0FB40, 0xF7B1FB40, 0xF7B2FB40, 0xF7B3FB40, 0xF7B4FB40, 0xF7B5FB40, 0xF7B6FB40, 0xF7B7FB40, 0xF7B8FB40, 0xF7B9FB40, 0xF7BAFB40, 0xF7BBFB40, 0xF7BCFB40, 0xF7BDFB40, 0xF7BEFB40, 0xF7BFFB40,
+		0xF7C0FB40, 0xF7C1FB40, 0xF7C2FB40, 0xF7C3FB40, 0xF7C4FB40, 0xF7C5FB40, 0xF7C6FB40, 0xF7C7FB40, 0xF7C8FB40, 0xF7C9FB40, 0xF7CAFB40, 0xF7CBFB40, 0xF7CCFB40, 0xF7CDFB40, 0xF7CEFB40, 0xF7CFFB40, 0xF7D0FB40, 0xF7D1FB40, 0xF7D2FB40, 0xF7D3FB40, 0xF7D4FB40, 0xF7D5FB40, 0xF7D6FB40, 0xF7D7FB40, 0xF7D8FB40, 0xF7D9FB40, 0xF7DAFB40, 0xF7DBFB40, 0xF7DCFB40, 0xF7DDFB40, 0xF7DEFB40, 0xF7DFFB40,
+		0xF7E0FB40, 0xF7E1FB40, 0xF7E2FB40, 0xF7E3FB40, 0xF7E4FB40, 0xF7E5FB40, 0xF7E6FB40, 0xF7E7FB40, 0xF7E8FB40, 0xF7E9FB40, 0xF7EAFB40, 0xF7EBFB40, 0xF7ECFB40, 0xF7EDFB40, 0xF7EEFB40, 0xF7EFFB40, 0xF7F0FB40, 0xF7F1FB40, 0xF7F2FB40, 0xF7F3FB40, 0xF7F4FB40, 0xF7F5FB40, 0xF7F6FB40, 0xF7F7FB40, 0xF7F8FB40, 0xF7F9FB40, 0xF7FAFB40, 0xF7FBFB40, 0xF7FCFB40, 0xF7FDFB40, 0xF7FEFB40, 0xF7FFFB40,
+		0xF800FB40, 0xF801FB40, 0xF802FB40, 0xF803FB40, 0xF804FB40, 0xF805FB40, 0xF806FB40, 0xF807FB40, 0xF808FB40, 0xF809FB40, 0xF80AFB40, 0xF80BFB40, 0xF80CFB40, 0xF80DFB40, 0xF80EFB40, 0xF80FFB40, 0xF810FB40, 0xF811FB40, 0xF812FB40, 0xF813FB40, 0xF814FB40, 0xF815FB40, 0xF816FB40, 0xF817FB40, 0xF818FB40, 0xF819FB40, 0xF81AFB40, 0xF81BFB40, 0xF81CFB40, 0xF81DFB40, 0xF81EFB40, 0xF81FFB40,
+		0xF820FB40, 0xF821FB40, 0xF822FB40, 0xF823FB40, 0xF824FB40, 0xF825FB40, 0xF826FB40, 0xF827FB40, 0xF828FB40, 0xF829FB40, 0xF82AFB40, 0xF82BFB40, 0xF82CFB40, 0xF82DFB40, 0xF82EFB40, 0xF82FFB40, 0xF830FB40, 0xF831FB40, 0xF832FB40, 0xF833FB40, 0xF834FB40, 0xF835FB40, 0xF836FB40, 0xF837FB40, 0xF838FB40, 0xF839FB40, 0xF83AFB40, 0xF83BFB40, 0xF83CFB40, 0xF83DFB40, 0xF83EFB40, 0xF83FFB40,
+		0xF840FB40, 0xF841FB40, 0xF842FB40, 0xF843FB40, 0xF844FB40, 0xF845FB40, 0xF846FB40, 0xF847FB40, 0xF848FB40, 0xF849FB40, 0xF84AFB40, 0xF84BFB40, 0xF84CFB40, 0xF84DFB40, 0xF84EFB40, 0xF84FFB40, 0xF850FB40, 0xF851FB40, 0xF852FB40, 0xF853FB40, 0xF854FB40, 0xF855FB40, 0xF856FB40, 0xF857FB40, 0xF858FB40, 0xF859FB40, 0xF85AFB40, 0xF85BFB40, 0xF85CFB40, 0xF85DFB40, 0xF85EFB40, 0xF85FFB40,
+		0xF860FB40, 0xF861FB40, 0xF862FB40, 0xF863FB40, 0xF864FB40, 0xF865FB40, 0xF866FB40, 0xF867FB40, 0xF868FB40, 0xF869FB40, 0xF86AFB40, 0xF86BFB40, 0xF86CFB40, 0xF86DFB40, 0xF86EFB40, 0xF86FFB40, 0xF870FB40, 0xF871FB40, 0xF872FB40, 0xF873FB40, 0xF874FB40, 0xF875FB40, 0xF876FB40, 0xF877FB40, 0xF878FB40, 0xF879FB40, 0xF87AFB40, 0xF87BFB40, 0xF87CFB40, 0xF87DFB40, 0xF87EFB40, 0xF87FFB40,
+		0xF880FB40, 0xF881FB40, 0xF882FB40, 0xF883FB40, 0xF884FB40, 0xF885FB40, 0xF886FB40, 0xF887FB40, 0xF888FB40, 0xF889FB40, 0xF88AFB40, 0xF88BFB40, 0xF88CFB40, 0xF88DFB40, 0xF88EFB40, 0xF88FFB40, 0xF890FB40, 0xF891FB40, 0xF892FB40, 0xF893FB40, 0xF894FB40, 0xF895FB40, 0xF896FB40, 0xF897FB40, 0xF898FB40, 0xF899FB40, 0xF89AFB40, 0xF89BFB40, 0xF89CFB40, 0xF89DFB40, 0xF89EFB40, 0xF89FFB40,
+		0xF8A0FB40, 0xF8A1FB40, 0xF8A2FB40, 0xF8A3FB40, 0xF8A4FB40, 0xF8A5FB40, 0xF8A6FB40, 0xF8A7FB40, 0xF8A8FB40, 0xF8A9FB40, 0xF8AAFB40, 0xF8ABFB40, 0xF8ACFB40, 0xF8ADFB40, 0xF8AEFB40, 0xF8AFFB40, 0xF8B0FB40, 0xF8B1FB40, 0xF8B2FB40, 0xF8B3FB40, 0xF8B4FB40, 0xF8B5FB40, 0xF8B6FB40, 0xF8B7FB40, 0xF8B8FB40, 0xF8B9FB40, 0xF8BAFB40, 0xF8BBFB40, 0xF8BCFB40, 0xF8BDFB40, 0xF8BEFB40, 0xF8BFFB40,
+		0xF8C0FB40, 0xF8C1FB40, 0xF8C2FB40, 0xF8C3FB40, 0xF8C4FB40, 0xF8C5FB40, 0xF8C6FB40, 0xF8C7FB40, 0xF8C8FB40, 0xF8C9FB40, 0xF8CAFB40, 0xF8CBFB40, 0xF8CCFB40, 0xF8CDFB40, 0xF8CEFB40, 0xF8CFFB40, 0xF8D0FB40, 0xF8D1FB40, 0xF8D2FB40, 0xF8D3FB40, 0xF8D4FB40, 0xF8D5FB40, 0xF8D6FB40, 0xF8D7FB40, 0xF8D8FB40, 0xF8D9FB40, 0xF8DAFB40, 0xF8DBFB40, 0xF8DCFB40, 0xF8DDFB40, 0xF8DEFB40, 0xF8DFFB40,
+		0xF8E0FB40, 0xF8E1FB40, 0xF8E2FB40, 0xF8E3FB40, 0xF8E4FB40, 0xF8E5FB40, 0xF8E6FB40, 0xF8E7FB40, 0xF8E8FB40, 0xF8E9FB40, 0xF8EAFB40, 0xF8EBFB40, 0xF8ECFB40, 0xF8EDFB40, 0xF8EEFB40, 0xF8EFFB40, 0xF8F0FB40, 0xF8F1FB40, 0xF8F2FB40, 0xF8F3FB40, 0xF8F4FB40, 0xF8F5FB40, 0xF8F6FB40, 0xF8F7FB40, 0xF8F8FB40, 0xF8F9FB40, 0xF8FAFB40, 0xF8FBFB40, 0xF8FCFB40, 0xF8FDFB40, 0xF8FEFB40, 0xF8FFFB40,
+		0xF900FB40, 0xF901FB40, 0xF902FB40, 0xF903FB40, 0xF904FB40, 0xF905FB40, 0xF906FB40, 0xF907FB40, 0xF908FB40, 0xF909FB40, 0xF90AFB40, 0xF90BFB40, 0xF90CFB40, 0xF90DFB40, 0xF90EFB40, 0xF90FFB40, 0xF910FB40, 0xF911FB40, 0xF912FB40, 0xF913FB40, 0xF914FB40, 0xF915FB40, 0xF916FB40, 0xF917FB40, 0xF918FB40, 0xF919FB40, 0xF91AFB40, 0xF91BFB40, 0xF91CFB40, 0xF91DFB40, 0xF91EFB40, 0xF91FFB40,
+		0xF920FB40, 0xF921FB40, 0xF922FB40, 0xF923FB40, 0xF924FB40, 0xF925FB40, 0xF926FB40, 0xF927FB40, 0xF928FB40, 0xF929FB40, 0xF92AFB40, 0xF92BFB40, 0xF92CFB40, 0xF92DFB40, 0xF92EFB40, 0xF92FFB40, 0xF930FB40, 0xF931FB40, 0xF932FB40, 0xF933FB40, 0xF934FB40, 0xF935FB40, 0xF936FB40, 0xF937FB40, 0xF938FB40, 0xF939FB40, 0xF93AFB40, 0xF93BFB40, 0xF93CFB40, 0xF93DFB40, 0xF93EFB40, 0xF93FFB40,
+		0xF940FB40, 0xF941FB40, 0xF942FB40, 0xF943FB40, 0xF944FB40, 0xF945FB40, 0xF946FB40, 0xF947FB40, 0xF948FB40, 0xF949FB40, 0xF94AFB40, 0xF94BFB40, 0xF94CFB40, 0xF94DFB40, 0xF94EFB40, 0xF94FFB40, 0xF950FB40, 0xF951FB40, 0xF952FB40, 0xF953FB40, 0xF954FB40, 0xF955FB40, 0xF956FB40, 0xF957FB40, 0xF958FB40, 0xF959FB40, 0xF95AFB40, 0xF95BFB40, 0xF95CFB40, 0xF95DFB40, 0xF95EFB40, 0xF95FFB40,
+		0xF960FB40, 0xF961FB40, 0xF962FB40, 0xF963FB40, 0xF964FB40, 0xF965FB40, 0xF966FB40, 0xF967FB40, 0xF968FB40, 0xF969FB40, 0xF96AFB40, 0xF96BFB40, 0xF96CFB40, 0xF96DFB40, 0xF96EFB40, 0xF96FFB40, 0xF970FB40, 0xF971FB40, 0xF972FB40, 0xF973FB40, 0xF974FB40, 0xF975FB40, 0xF976FB40, 0xF977FB40, 0xF978FB40, 0xF979FB40, 0xF97AFB40, 0xF97BFB40, 0xF97CFB40, 0xF97DFB40, 0xF97EFB40, 0xF97FFB40,
+		0xF980FB40, 0xF981FB40, 0xF982FB40, 0xF983FB40, 0xF984FB40, 0xF985FB40, 0xF986FB40, 0xF987FB40, 0xF988FB40, 0xF989FB40, 0xF98AFB40, 0xF98BFB40, 0xF98CFB40, 0xF98DFB40, 0xF98EFB40, 0xF98FFB40, 0xF990FB40, 0xF991FB40, 0xF992FB40, 0xF993FB40, 0xF994FB40, 0xF995FB40, 0xF996FB40, 0xF997FB40, 0xF998FB40, 0xF999FB40, 0xF99AFB40, 0xF99BFB40, 0xF99CFB40, 0xF99DFB40, 0xF99EFB40, 0xF99FFB40,
+		0xF9A0FB40, 0xF9A1FB40, 0xF9A2FB40, 0xF9A3FB40, 0xF9A4FB40, 0xF9A5FB40, 0xF9A6FB40, 0xF9A7FB40, 0xF9A8FB40, 0xF9A9FB40, 0xF9AAFB40, 0xF9ABFB40, 0xF9ACFB40, 0xF9ADFB40, 0xF9AEFB40, 0xF9AFFB40, 0xF9B0FB40, 0xF9B1FB40, 0xF9B2FB40, 0xF9B3FB40, 0xF9B4FB40, 0xF9B5FB40, 0xF9B6FB40, 0xF9B7FB40, 0xF9B8FB40, 0xF9B9FB40, 0xF9BAFB40, 0xF9BBFB40, 0xF9BCFB40, 0xF9BDFB40, 0xF9BEFB40, 0xF9BFFB40,
+		0xF9C0FB40, 0xF9C1FB40, 0xF9C2FB40, 0xF9C3FB40, 0xF9C4FB40, 0xF9C5FB40, 0xF9C6FB40, 0xF9C7FB40, 0xF9C8FB40, 0xF9C9FB40, 0xF9CAFB40, 0xF9CBFB40, 0xF9CCFB40, 0xF9CDFB40, 0xF9CEFB40, 0xF9CFFB40, 0xF9D0FB40, 0xF9D1FB40, 0xF9D2FB40, 0xF9D3FB40, 0xF9D4FB40, 0xF9D5FB40, 0xF9D6FB40, 0xF9D7FB40, 0xF9D8FB40, 0xF9D9FB40, 0xF9DAFB40, 0xF9DBFB40, 0xF9DCFB40, 0xF9DDFB40, 0xF9DEFB40, 0xF9DFFB40,
+		0xF9E0FB40, 0xF9E1FB40, 0xF9E2FB40, 0xF9E3FB40, 0xF9E4FB40, 0xF9E5FB40, 0xF9E6FB40, 0xF9E7FB40, 0xF9E8FB40, 0xF9E9FB40, 0xF9EAFB40, 0xF9EBFB40, 0xF9ECFB40, 0xF9EDFB40, 0xF9EEFB40, 0xF9EFFB40, 0xF9F0FB40, 0xF9F1FB40, 0xF9F2FB40, 0xF9F3FB40, 0xF9F4FB40, 0xF9F5FB40, 0xF9F6FB40, 0xF9F7FB40, 0xF9F8FB40, 0xF9F9FB40, 0xF9FAFB40, 0xF9FBFB40, 0xF9FCFB40, 0xF9FDFB40, 0xF9FEFB40, 0xF9FFFB40,
+		0xFA00FB40, 0xFA01FB40, 0xFA02FB40, 0xFA03FB40, 0xFA04FB40, 0xFA05FB40, 0xFA06FB40, 0xFA07FB40, 0xFA08FB40, 0xFA09FB40, 0xFA0AFB40, 0xFA0BFB40, 0xFA0CFB40, 0xFA0DFB40, 0xFA0EFB40, 0xFA0FFB40, 0xFA10FB40, 0xFA11FB40, 0xFA12FB40, 0xFA13FB40, 0xFA14FB40, 0xFA15FB40, 0xFA16FB40, 0xFA17FB40, 0xFA18FB40, 0xFA19FB40, 0xFA1AFB40, 0xFA1BFB40, 0xFA1CFB40, 0xFA1DFB40, 0xFA1EFB40, 0xFA1FFB40,
+		0xFA20FB40, 0xFA21FB40, 0xFA22FB40, 0xFA23FB40, 0xFA24FB40, 0xFA25FB40, 0xFA26FB40, 0xFA27FB40, 0xFA28FB40, 0xFA29FB40, 0xFA2AFB40, 0xFA2BFB40, 0xFA2CFB40, 0xFA2DFB40, 0xFA2EFB40, 0xFA2FFB40, 0xFA30FB40, 0xFA31FB40, 0xFA32FB40, 0xFA33FB40, 0xFA34FB40, 0xFA35FB40, 0xFA36FB40, 0xFA37FB40, 0xFA38FB40, 0xFA39FB40, 0xFA3AFB40, 0xFA3BFB40, 0xFA3CFB40, 0xFA3DFB40, 0xFA3EFB40, 0xFA3FFB40,
+		0xFA40FB40, 0xFA41FB40, 0xFA42FB40, 0xFA43FB40, 0xFA44FB40, 0xFA45FB40, 0xFA46FB40, 0xFA47FB40, 0xFA48FB40, 0xFA49FB40, 0xFA4AFB40, 0xFA4BFB40, 0xFA4CFB40, 0xFA4DFB40, 0xFA4EFB40, 0xFA4FFB40, 0xFA50FB40, 0xFA51FB40, 0xFA52FB40, 0xFA53FB40, 0xFA54FB40, 0xFA55FB40, 0xFA56FB40, 0xFA57FB40, 0xFA58FB40, 0xFA59FB40, 0xFA5AFB40, 0xFA5BFB40, 0xFA5CFB40, 0xFA5DFB40, 0xFA5EFB40, 0xFA5FFB40,
+		0xFA60FB40, 0xFA61FB40, 0xFA62FB40, 0xFA63FB40, 0xFA64FB40, 0xFA65FB40, 0xFA66FB40, 0xFA67FB40, 0xFA68FB40, 0xFA69FB40, 0xFA6AFB40, 0xFA6BFB40, 0xFA6CFB40, 0xFA6DFB40, 0xFA6EFB40, 0xFA6FFB40, 0xFA70FB40, 0xFA71FB40, 0xFA72FB40, 0xFA73FB40, 0xFA74FB40, 0xFA75FB40, 0xFA76FB40, 0xFA77FB40, 0xFA78FB40, 0xFA79FB40, 0xFA7AFB40, 0xFA7BFB40, 0xFA7CFB40, 0xFA7DFB40, 0xFA7EFB40, 0xFA7FFB40,
+		0xFA80FB40, 0xFA81FB40, 0xFA82FB40, 0xFA83FB40, 0xFA84FB40, 0xFA85FB40, 0xFA86FB40, 0xFA87FB40, 0xFA88FB40, 0xFA89FB40, 0xFA8AFB40, 0xFA8BFB40, 0xFA8CFB40, 0xFA8DFB40, 0xFA8EFB40, 0xFA8FFB40, 0xFA90FB40, 0xFA91FB40, 0xFA92FB40, 0xFA93FB40, 0xFA94FB40, 0xFA95FB40, 0xFA96FB40, 0xFA97FB40, 0xFA98FB40, 0xFA99FB40, 0xFA9AFB40, 0xFA9BFB40, 0xFA9CFB40, 0xFA9DFB40, 0xFA9EFB40, 0xFA9FFB40,
+		0xFAA0FB40, 0xFAA1FB40, 0xFAA2FB40, 0xFAA3FB40, 0xFAA4FB40, 0xFAA5FB40, 0xFAA6FB40, 0xFAA7FB40, 0xFAA8FB40, 0xFAA9FB40, 0xFAAAFB40, 0xFAABFB40, 0xFAACFB40, 0xFAADFB40, 0xFAAEFB40, 0xFAAFFB40, 0xFAB0FB40, 0xFAB1FB40, 0xFAB2FB40, 0xFAB3FB40, 0xFAB4FB40, 0xFAB5FB40, 0xFAB6FB40, 0xFAB7FB40, 0xFAB8FB40, 0xFAB9FB40, 0xFABAFB40, 0xFABBFB40, 0xFABCFB40, 0xFABDFB40, 0xFABEFB40, 0xFABFFB40,
+		0xFAC0FB40, 0xFAC1FB40, 0xFAC2FB40, 0xFAC3FB40, 0xFAC4FB40, 0xFAC5FB40, 0xFAC6FB40, 0xFAC7FB40, 0xFAC8FB40, 0xFAC9FB40, 0xFACAFB40, 0xFACBFB40, 0xFACCFB40, 0xFACDFB40, 0xFACEFB40, 0xFACFFB40, 0xFAD0FB40, 0xFAD1FB40, 0xFAD2FB40, 0xFAD3FB40, 0xFAD4FB40, 0xFAD5FB40, 0xFAD6FB40, 0xFAD7FB40, 0xFAD8FB40, 0xFAD9FB40, 0xFADAFB40, 0xFADBFB40, 0xFADCFB40, 0xFADDFB40, 0xFADEFB40, 0xFADFFB40,
+		0xFAE0FB40, 0xFAE1FB40, 0xFAE2FB40, 0xFAE3FB40, 0xFAE4FB40, 0xFAE5FB40, 0xFAE6FB40, 0xFAE7FB40, 0xFAE8FB40, 0xFAE9FB40, 0xFAEAFB40, 0xFAEBFB40, 0xFAECFB40, 0xFAEDFB40, 0xFAEEFB40, 0xFAEFFB40, 0xFAF0FB40, 0xFAF1FB40, 0xFAF2FB40, 0xFAF3FB40, 0xFAF4FB40, 0xFAF5FB40, 0xFAF6FB40, 0xFAF7FB40, 0xFAF8FB40, 0xFAF9FB40, 0xFAFAFB40, 0xFAFBFB40, 0xFAFCFB40, 0xFAFDFB40, 0xFAFEFB40, 0xFAFFFB40,
+		0xFB00FB40, 0xFB01FB40, 0xFB02FB40, 0xFB03FB40, 0xFB04FB40, 0xFB05FB40, 0xFB06FB40, 0xFB07FB40, 0xFB08FB40, 0xFB09FB40, 0xFB0AFB40, 0xFB0BFB40, 0xFB0CFB40, 0xFB0DFB40, 0xFB0EFB40, 0xFB0FFB40, 0xFB10FB40, 0xFB11FB40, 0xFB12FB40, 0xFB13FB40, 0xFB14FB40, 0xFB15FB40, 0xFB16FB40, 0xFB17FB40, 0xFB18FB40, 0xFB19FB40, 0xFB1AFB40, 0xFB1BFB40, 0xFB1CFB40, 0xFB1DFB40, 0xFB1EFB40, 0xFB1FFB40,
+		0xFB20FB40, 0xFB21FB40, 0xFB22FB40, 0xFB23FB40, 0xFB24FB40, 0xFB25FB40, 0xFB26FB40, 0xFB27FB40, 0xFB28FB40, 0xFB29FB40, 0xFB2AFB40, 0xFB2BFB40, 0xFB2CFB40, 0xFB2DFB40, 0xFB2EFB40, 0xFB2FFB40, 0xFB30FB40, 0xFB31FB40, 0xFB32FB40, 0xFB33FB40, 0xFB34FB40, 0xFB35FB40, 0xFB36FB40, 0xFB37FB40, 0xFB38FB40, 0xFB39FB40, 0xFB3AFB40, 0xFB3BFB40, 0xFB3CFB40, 0xFB3DFB40, 0xFB3EFB40, 0xFB3FFB40,
+		0xFB40FB40, 0xFB41FB40, 0xFB42FB40, 0xFB43FB40, 0xFB44FB40, 0xFB45FB40, 0xFB46FB40, 0xFB47FB40, 0xFB48FB40, 0xFB49FB40, 0xFB4AFB40, 0xFB4BFB40, 0xFB4CFB40, 0xFB4DFB40, 0xFB4EFB40, 0xFB4FFB40, 0xFB50FB40, 0xFB51FB40, 0xFB52FB40, 0xFB53FB40, 0xFB54FB40, 0xFB55FB40, 0xFB56FB40, 0xFB57FB40, 0xFB58FB40, 0xFB59FB40, 0xFB5AFB40, 0xFB5BFB40, 0xFB5CFB40, 0xFB5DFB40, 0xFB5EFB40, 0xFB5FFB40,
+		0xFB60FB40, 0xFB61FB40, 0xFB62FB40, 0xFB63FB40, 0xFB64FB40, 0xFB65FB40, 0xFB66FB40, 0xFB67FB40, 0xFB68FB40, 0xFB69FB40, 0xFB6AFB40, 0xFB6BFB40, 0xFB6CFB40, 0xFB6DFB40, 0xFB6EFB40, 0xFB6FFB40, 0xFB70FB40, 0xFB71FB40, 0xFB72FB40, 0xFB73FB40, 0xFB74FB40, 0xFB75FB40, 0xFB76FB40, 0xFB77FB40, 0xFB78FB40, 0xFB79FB40, 0xFB7AFB40, 0xFB7BFB40, 0xFB7CFB40, 0xFB7DFB40, 0xFB7EFB40, 0xFB7FFB40,
+		0xFB80FB40, 0xFB81FB40, 0xFB82FB40, 0xFB83FB40, 0xFB84FB40, 0xFB85FB40, 0xFB86FB40, 0xFB87FB40, 0xFB88FB40, 0xFB89FB40, 0xFB8AFB40, 0xFB8BFB40, 0xFB8CFB40, 0xFB8DFB40, 0xFB8EFB40, 0xFB8FFB40, 0xFB90FB40, 0xFB91FB40, 0xFB92FB40, 0xFB93FB40, 0xFB94FB40, 0xFB95FB40, 0xFB96FB40, 0xFB97FB40, 0xFB98FB40, 0xFB99FB40, 0xFB9AFB40, 0xFB9BFB40, 0xFB9CFB40, 0xFB9DFB40, 0xFB9EFB40, 0xFB9FFB40,
+		0xFBA0FB40, 0xFBA1FB40, 0xFBA2FB40, 0xFBA3FB40, 0xFBA4FB40, 0xFBA5FB40, 0xFBA6FB40, 0xFBA7FB40, 0xFBA8FB40, 0xFBA9FB40, 0xFBAAFB40, 0xFBABFB40, 0xFBACFB40, 0xFBADFB40, 0xFBAEFB40, 0xFBAFFB40, 0xFBB0FB40, 0xFBB1FB40, 0xFBB2FB40, 0xFBB3FB40, 0xFBB4FB40, 0xFBB5FB40, 0xFBB6FB40, 0xFBB7FB40, 0xFBB8FB40, 0xFBB9FB40, 0xFBBAFB40, 0xFBBBFB40, 0xFBBCFB40, 0xFBBDFB40, 0xFBBEFB40, 0xFBBFFB40,
+		0xFBC0FB40, 0xFBC1FB40, 0xFBC2FB40, 0xFBC3FB40, 0xFBC4FB40, 0xFBC5FB40, 0xFBC6FB40, 0xFBC7FB40, 0xFBC8FB40, 0xFBC9FB40, 0xFBCAFB40, 0xFBCBFB40, 0xFBCCFB40, 0xFBCDFB40, 0xFBCEFB40, 0xFBCFFB40, 0xFBD0FB40, 0xFBD1FB40, 0xFBD2FB40, 0xFBD3FB40, 0xFBD4FB40, 0xFBD5FB40, 0xFBD6FB40, 0xFBD7FB40, 0xFBD8FB40, 0xFBD9FB40, 0xFBDAFB40, 0xFBDBFB40, 0xFBDCFB40, 0xFBDDFB40, 0xFBDEFB40, 0xFBDFFB40,
+		0xFBE0FB40, 0xFBE1FB40, 0xFBE2FB40, 0xFBE3FB40, 0xFBE4FB40, 0xFBE5FB40, 0xFBE6FB40, 0xFBE7FB40, 0xFBE8FB40, 0xFBE9FB40, 0xFBEAFB40, 0xFBEBFB40, 0xFBECFB40, 0xFBEDFB40, 0xFBEEFB40, 0xFBEFFB40, 0xFBF0FB40, 0xFBF1FB40, 0xFBF2FB40, 0xFBF3FB40, 0xFBF4FB40, 0xFBF5FB40, 0xFBF6FB40, 0xFBF7FB40, 0xFBF8FB40, 0xFBF9FB40, 0xFBFAFB40, 0xFBFBFB40, 0xFBFCFB40, 0xFBFDFB40, 0xFBFEFB40, 0xFBFFFB40,
+		0xFC00FB40, 0xFC01FB40, 0xFC02FB40, 0xFC03FB40, 0xFC04FB40, 0xFC05FB40, 0xFC06FB40, 0xFC07FB40, 0xFC08FB40, 0xFC09FB40, 0xFC0AFB40, 0xFC0BFB40, 0xFC0CFB40, 0xFC0DFB40, 0xFC0EFB40, 0xFC0FFB40, 0xFC10FB40, 0xFC11FB40, 0xFC12FB40, 0xFC13FB40, 0xFC14FB40, 0xFC15FB40, 0xFC16FB40, 0xFC17FB40, 0xFC18FB40, 0xFC19FB40, 0xFC1AFB40, 0xFC1BFB40, 0xFC1CFB40, 0xFC1DFB40, 0xFC1EFB40, 0xFC1FFB40,
+		0xFC20FB40, 0xFC21FB40, 0xFC22FB40, 0xFC23FB40, 0xFC24FB40, 0xFC25FB40, 0xFC26FB40, 0xFC27FB40, 0xFC28FB40, 0xFC29FB40, 0xFC2AFB40, 0xFC2BFB40, 0xFC2CFB40, 0xFC2DFB40, 0xFC2EFB40, 0xFC2FFB40, 0xFC30FB40, 0xFC31FB40, 0xFC32FB40, 0xFC33FB40, 0xFC34FB40, 0xFC35FB40, 0xFC36FB40, 0xFC37FB40, 0xFC38FB40, 0xFC39FB40, 0xFC3AFB40, 0xFC3BFB40, 0xFC3CFB40, 0xFC3DFB40, 0xFC3EFB40, 0xFC3FFB40,
+		0xFC40FB40, 0xFC41FB40, 0xFC42FB40, 0xFC43FB40, 0xFC44FB40, 0xFC45FB40, 0xFC46FB40, 0xFC47FB40, 0xFC48FB40, 0xFC49FB40, 0xFC4AFB40, 0xFC4BFB40, 0xFC4CFB40, 0xFC4DFB40, 0xFC4EFB40, 0xFC4FFB40, 0xFC50FB40, 0xFC51FB40, 0xFC52FB40, 0xFC53FB40, 0xFC54FB40, 0xFC55FB40, 0xFC56FB40, 0xFC57FB40, 0xFC58FB40, 0xFC59FB40, 0xFC5AFB40, 0xFC5BFB40, 0xFC5CFB40, 0xFC5DFB40, 0xFC5EFB40, 0xFC5FFB40,
+		0xFC60FB40, 0xFC61FB40, 0xFC62FB40, 0xFC63FB40, 0xFC64FB40, 0xFC65FB40, 0xFC66FB40, 0xFC67FB40, 0xFC68FB40, 0xFC69FB40, 0xFC6AFB40, 0xFC6BFB40, 0xFC6CFB40, 0xFC6DFB40, 0xFC6EFB40, 0xFC6FFB40, 0xFC70FB40, 0xFC71FB40, 0xFC72FB40, 0xFC73FB40, 0xFC74FB40, 0xFC75FB40, 0xFC76FB40, 0xFC77FB40, 0xFC78FB40, 0xFC79FB40, 0xFC7AFB40, 0xFC7BFB40, 0xFC7CFB40, 0xFC7DFB40, 0xFC7EFB40, 0xFC7FFB40,
+		0xFC80FB40, 0xFC81FB40, 0xFC82FB40, 0xFC83FB40, 0xFC84FB40, 0xFC85FB40, 0xFC86FB40, 0xFC87FB40, 0xFC88FB40, 0xFC89FB40, 0xFC8AFB40, 0xFC8BFB40, 0xFC8CFB40, 0xFC8DFB40, 0xFC8EFB40, 0xFC8FFB40, 0xFC90FB40, 0xFC91FB40, 0xFC92FB40, 0xFC93FB40, 0xFC94FB40, 0xFC95FB40, 0xFC96FB40, 0xFC97FB40, 0xFC98FB40, 0xFC99FB40, 0xFC9AFB40, 0xFC9BFB40, 0xFC9CFB40, 0xFC9DFB40, 0xFC9EFB40, 0xFC9FFB40,
+		0xFCA0FB40, 0xFCA1FB40, 0xFCA2FB40, 0xFCA3FB40, 0xFCA4FB40, 0xFCA5FB40, 0xFCA6FB40, 0xFCA7FB40, 0xFCA8FB40, 0xFCA9FB40, 0xFCAAFB40, 0xFCABFB40, 0xFCACFB40, 0xFCADFB40, 0xFCAEFB40, 0xFCAFFB40, 0xFCB0FB40, 0xFCB1FB40, 0xFCB2FB40, 0xFCB3FB40, 0xFCB4FB40, 0xFCB5FB40, 0xFCB6FB40, 0xFCB7FB40, 0xFCB8FB40, 0xFCB9FB40, 0xFCBAFB40, 0xFCBBFB40, 0xFCBCFB40, 0xFCBDFB40, 0xFCBEFB40, 0xFCBFFB40,
+		0xFCC0FB40, 0xFCC1FB40, 0xFCC2FB40, 0xFCC3FB40, 0xFCC4FB40, 0xFCC5FB40, 0xFCC6FB40, 0xFCC7FB40, 0xFCC8FB40, 0xFCC9FB40, 0xFCCAFB40, 0xFCCBFB40, 0xFCCCFB40, 0xFCCDFB40, 0xFCCEFB40, 0xFCCFFB40, 0xFCD0FB40, 0xFCD1FB40, 0xFCD2FB40, 0xFCD3FB40, 0xFCD4FB40, 0xFCD5FB40, 0xFCD6FB40, 0xFCD7FB40, 0xFCD8FB40, 0xFCD9FB40, 0xFCDAFB40, 0xFCDBFB40, 0xFCDCFB40, 0xFCDDFB40, 0xFCDEFB40, 0xFCDFFB40,
+		0xFCE0FB40, 0xFCE1FB40, 0xFCE2FB40, 0xFCE3FB40, 0xFCE4FB40, 0xFCE5FB40, 0xFCE6FB40, 0xFCE7FB40, 0xFCE8FB40, 0xFCE9FB40, 0xFCEAFB40, 0xFCEBFB40, 0xFCECFB40, 0xFCEDFB40, 0xFCEEFB40, 0xFCEFFB40, 0xFCF0FB40, 0xFCF1FB40, 0xFCF2FB40, 0xFCF3FB40, 0xFCF4FB40, 0xFCF5FB40, 0xFCF6FB40, 0xFCF7FB40, 0xFCF8FB40, 0xFCF9FB40, 0xFCFAFB40, 0xFCFBFB40, 0xFCFCFB40, 0xFCFDFB40, 0xFCFEFB40, 0xFCFFFB40,
+		0xFD00FB40, 0xFD01FB40, 0xFD02FB40, 0xFD03FB40, 0xFD04FB40, 0xFD05FB40, 0xFD06FB40, 0xFD07FB40, 0xFD08FB40, 0xFD09FB40, 0xFD0AFB40, 0xFD0BFB40, 0xFD0CFB40, 0xFD0DFB40, 0xFD0EFB40, 0xFD0FFB40, 0xFD10FB40, 0xFD11FB40, 0xFD12FB40, 0xFD13FB40, 0xFD14FB40, 0xFD15FB40, 0xFD16FB40, 0xFD17FB40, 0xFD18FB40, 0xFD19FB40, 0xFD1AFB40, 0xFD1BFB40, 0xFD1CFB40, 0xFD1DFB40, 0xFD1EFB40, 0xFD1FFB40,
+		0xFD20FB40, 0xFD21FB40, 0xFD22FB40, 0xFD23FB40, 0xFD24FB40, 0xFD25FB40, 0xFD26FB40, 0xFD27FB40, 0xFD28FB40, 0xFD29FB40, 0xFD2AFB40, 0xFD2BFB40, 0xFD2CFB40, 0xFD2DFB40, 0xFD2EFB40, 0xFD2FFB40, 0xFD30FB40, 0xFD31FB40, 0xFD32FB40, 0xFD33FB40, 0xFD34FB40, 0xFD35FB40, 0xFD36FB40, 0xFD37FB40, 0xFD38FB40, 0xFD39FB40, 0xFD3AFB40, 0xFD3BFB40, 0xFD3CFB40, 0xFD3DFB40, 0xFD3EFB40, 0xFD3FFB40,
+		0xFD40FB40, 0xFD41FB40, 0xFD42FB40, 0xFD43FB40, 0xFD44FB40, 0xFD45FB40, 0xFD46FB40, 0xFD47FB40, 0xFD48FB40, 0xFD49FB40, 0xFD4AFB40, 0xFD4BFB40, 0xFD4CFB40, 0xFD4DFB40, 0xFD4EFB40, 0xFD4FFB40, 0xFD50FB40, 0xFD51FB40, 0xFD52FB40, 0xFD53FB40, 0xFD54FB40, 0xFD55FB40, 0xFD56FB40, 0xFD57FB40, 0xFD58FB40, 0xFD59FB40, 0xFD5AFB40, 0xFD5BFB40, 0xFD5CFB40, 0xFD5DFB40, 0xFD5EFB40, 0xFD5FFB40,
+		0xFD60FB40, 0xFD61FB40, 0xFD62FB40, 0xFD63FB40, 0xFD64FB40, 0xFD65FB40, 0xFD66FB40, 0xFD67FB40, 0xFD68FB40, 0xFD69FB40, 0xFD6AFB40, 0xFD6BFB40, 0xFD6CFB40, 0xFD6DFB40, 0xFD6EFB40, 0xFD6FFB40, 0xFD70FB40, 0xFD71FB40, 0xFD72FB40, 0xFD73FB40, 0xFD74FB40, 0xFD75FB40, 0xFD76FB40, 0xFD77FB40, 0xFD78FB40, 0xFD79FB40, 0xFD7AFB40, 0xFD7BFB40, 0xFD7CFB40, 0xFD7DFB40, 0xFD7EFB40, 0xFD7FFB40,
+		0xFD80FB40, 0xFD81FB40, 0xFD82FB40, 0xFD83FB40, 0xFD84FB40, 0xFD85FB40, 0xFD86FB40, 0xFD87FB40, 0xFD88FB40, 0xFD89FB40, 0xFD8AFB40, 0xFD8BFB40, 0xFD8CFB40, 0xFD8DFB40, 0xFD8EFB40, 0xFD8FFB40, 0xFD90FB40, 0xFD91FB40, 0xFD92FB40, 0xFD93FB40, 0xFD94FB40, 0xFD95FB40, 0xFD96FB40, 0xFD97FB40, 0xFD98FB40, 0xFD99FB40, 0xFD9AFB40, 0xFD9BFB40, 0xFD9CFB40, 0xFD9DFB40, 0xFD9EFB40, 0xFD9FFB40,
+		0xFDA0FB40, 0xFDA1FB40, 0xFDA2FB40, 0xFDA3FB40, 0xFDA4FB40, 0xFDA5FB40, 0xFDA6FB40, 0xFDA7FB40, 0xFDA8FB40, 0xFDA9FB40, 0xFDAAFB40, 0xFDABFB40, 0xFDACFB40, 0xFDADFB40, 0xFDAEFB40, 0xFDAFFB40, 0xFDB0FB40, 0xFDB1FB40, 0xFDB2FB40, 0xFDB3FB40, 0xFDB4FB40, 0xFDB5FB40, 0xFDB6FB40, 0xFDB7FB40, 0xFDB8FB40, 0xFDB9FB40, 0xFDBAFB40, 0xFDBBFB40, 0xFDBCFB40, 0xFDBDFB40, 0xFDBEFB40, 0xFDBFFB40,
+		0xFDC0FB40, 0xFDC1FB40, 0xFDC2FB40, 0xFDC3FB40, 0xFDC4FB40, 0xFDC5FB40, 0xFDC6FB40, 0xFDC7FB40, 0xFDC8FB40, 0xFDC9FB40, 0xFDCAFB40, 0xFDCBFB40, 0xFDCCFB40, 0xFDCDFB40, 0xFDCEFB40, 0xFDCFFB40, 0xFDD0FB40, 0xFDD1FB40, 0xFDD2FB40, 0xFDD3FB40, 0xFDD4FB40, 0xFDD5FB40, 0xFDD6FB40, 0xFDD7FB40, 0xFDD8FB40, 0xFDD9FB40, 0xFDDAFB40, 0xFDDBFB40, 0xFDDCFB40, 0xFDDDFB40, 0xFDDEFB40, 0xFDDFFB40,
+		0xFDE0FB40, 0xFDE1FB40, 0xFDE2FB40, 0xFDE3FB40, 0xFDE4FB40, 0xFDE5FB40, 0xFDE6FB40, 0xFDE7FB40, 0xFDE8FB40, 0xFDE9FB40, 0xFDEAFB40, 0xFDEBFB40, 0xFDECFB40, 0xFDEDFB40, 0xFDEEFB40, 0xFDEFFB40, 0xFDF0FB40, 0xFDF1FB40, 0xFDF2FB40, 0xFDF3FB40, 0xFDF4FB40, 0xFDF5FB40, 0xFDF6FB40, 0xFDF7FB40, 0xFDF8FB40, 0xFDF9FB40, 0xFDFAFB40, 0xFDFBFB40, 0xFDFCFB40, 0xFDFDFB40, 0xFDFEFB40, 0xFDFFFB40,
+		0xFE00FB40, 0xFE01FB40, 0xFE02FB40, 0xFE03FB40, 0xFE04FB40, 0xFE05FB40, 0xFE06FB40, 0xFE07FB40, 0xFE08FB40, 0xFE09FB40, 0xFE0AFB40, 0xFE0BFB40, 0xFE0CFB40, 0xFE0DFB40, 0xFE0EFB40, 0xFE0FFB40, 0xFE10FB40, 0xFE11FB40, 0xFE12FB40, 0xFE13FB40, 0xFE14FB40, 0xFE15FB40, 0xFE16FB40, 0xFE17FB40, 0xFE18FB40, 0xFE19FB40, 0xFE1AFB40, 0xFE1BFB40, 0xFE1CFB40, 0xFE1DFB40, 0xFE1EFB40, 0xFE1FFB40,
+		0xFE20FB40, 0xFE21FB40, 0xFE22FB40, 0xFE23FB40, 0xFE24FB40, 0xFE25FB40, 0xFE26FB40, 0xFE27FB40, 0xFE28FB40, 0xFE29FB40, 0xFE2AFB40, 0xFE2BFB40, 0xFE2CFB40, 0xFE2DFB40, 0xFE2EFB40, 0xFE2FFB40, 0xFE30FB40, 0xFE31FB40, 0xFE32FB40, 0xFE33FB40, 0xFE34FB40, 0xFE35FB40, 0xFE36FB40, 0xFE37FB40, 0xFE38FB40, 0xFE39FB40, 0xFE3AFB40, 0xFE3BFB40, 0xFE3CFB40, 0xFE3DFB40, 0xFE3EFB40, 0xFE3FFB40,
+		0xFE40FB40, 0xFE41FB40, 0xFE42FB40, 0xFE43FB40, 0xFE44FB40, 0xFE45FB40, 0xFE46FB40, 0xFE47FB40, 0xFE48FB40, 0xFE49FB40, 0xFE4AFB40, 0xFE4BFB40, 0xFE4CFB40, 0xFE4DFB40, 0xFE4EFB40, 0xFE4FFB40, 0xFE50FB40, 0xFE51FB40, 0xFE52FB40, 0xFE53FB40, 0xFE54FB40, 0xFE55FB40, 0xFE56FB40, 0xFE57FB40, 0xFE58FB40, 0xFE59FB40, 0xFE5AFB40, 0xFE5BFB40, 0xFE5CFB40, 0xFE5DFB40, 0xFE5EFB40, 0xFE5FFB40,
+		0xFE60FB40, 0xFE61FB40, 0xFE62FB40, 0xFE63FB40, 0xFE64FB40, 0xFE65FB40, 0xFE66FB40, 0xFE67FB40, 0xFE68FB40, 0xFE69FB40, 0xFE6AFB40, 0xFE6BFB40, 0xFE6CFB40, 0xFE6DFB40, 0xFE6EFB40, 0xFE6FFB40, 0xFE70FB40, 0xFE71FB40, 0xFE72FB40, 0xFE73FB40, 0xFE74FB40, 0xFE75FB40, 0xFE76FB40, 0xFE77FB40, 0xFE78FB40, 0xFE79FB40, 0xFE7AFB40, 0xFE7BFB40, 0xFE7CFB40, 0xFE7DFB40, 0xFE7EFB40, 0xFE7FFB40,
+		0xFE80FB40, 0xFE81FB40, 0xFE82FB40, 0xFE83FB40, 0xFE84FB40, 0xFE85FB40, 0xFE86FB40, 0xFE87FB40, 0xFE88FB40, 0xFE89FB40, 0xFE8AFB40, 0xFE8BFB40, 0xFE8CFB40, 0xFE8DFB40, 0xFE8EFB40, 0xFE8FFB40, 0xFE90FB40, 0xFE91FB40, 0xFE92FB40, 0xFE93FB40, 0xFE94FB40, 0xFE95FB40, 0xFE96FB40, 0xFE97FB40, 0xFE98FB40, 0xFE99FB40, 0xFE9AFB40, 0xFE9BFB40, 0xFE9CFB40, 0xFE9DFB40, 0xFE9EFB40, 0xFE9FFB40,
+		0xFEA0FB40, 0xFEA1FB40, 0xFEA2FB40, 0xFEA3FB40, 0xFEA4FB40, 0xFEA5FB40, 0xFEA6FB40, 0xFEA7FB40, 0xFEA8FB40, 0xFEA9FB40, 0xFEAAFB40, 0xFEABFB40, 0xFEACFB40, 0xFEADFB40, 0xFEAEFB40, 0xFEAFFB40, 0xFEB0FB40, 0xFEB1FB40, 0xFEB2FB40, 0xFEB3FB40, 0xFEB4FB40, 0xFEB5FB40, 0xFEB6FB40, 0xFEB7FB40, 0xFEB8FB40, 0xFEB9FB40, 0xFEBAFB40, 0xFEBBFB40, 0xFEBCFB40, 0xFEBDFB40, 0xFEBEFB40, 0xFEBFFB40,
+		0xFEC0FB40, 0xFEC1FB40, 0xFEC2FB40, 0xFEC3FB40, 0xFEC4FB40, 0xFEC5FB40, 0xFEC6FB40, 0xFEC7FB40, 0xFEC8FB40, 0xFEC9FB40, 0xFECAFB40, 0xFECBFB40, 0xFECCFB40, 0xFECDFB40, 0xFECEFB40, 0xFECFFB40, 0xFED0FB40, 0xFED1FB40, 0xFED2FB40, 0xFED3FB40, 0xFED4FB40, 0xFED5FB40, 0xFED6FB40, 0xFED7FB40, 0xFED8FB40, 0xFED9FB40, 0xFEDAFB40, 0xFEDBFB40, 0xFEDCFB40, 0xFEDDFB40, 0xFEDEFB40, 0xFEDFFB40,
+		0xFEE0FB40, 0xFEE1FB40, 0xFEE2FB40, 0xFEE3FB40, 0xFEE4FB40, 0xFEE5FB40, 0xFEE6FB40, 0xFEE7FB40, 0xFEE8FB40, 0xFEE9FB40, 0xFEEAFB40, 0xFEEBFB40, 0xFEECFB40, 0xFEEDFB40, 0xFEEEFB40, 0xFEEFFB40, 0xFEF0FB40, 0xFEF1FB40, 0xFEF2FB40, 0xFEF3FB40, 0xFEF4FB40, 0xFEF5FB40, 0xFEF6FB40, 0xFEF7FB40, 0xFEF8FB40, 0xFEF9FB40, 0xFEFAFB40, 0xFEFBFB40, 0xFEFCFB40, 0xFEFDFB40, 0xFEFEFB40, 0xFEFFFB40,
+		0xFF00FB40, 0xFF01FB40, 0xFF02FB40, 0xFF03FB40, 0xFF04FB40, 0xFF05FB40, 0xFF06FB40, 0xFF07FB40, 0xFF08FB40, 0xFF09FB40, 0xFF0AFB40, 0xFF0BFB40, 0xFF0CFB40, 0xFF0DFB40, 0xFF0EFB40, 0xFF0FFB40, 0xFF10FB40, 0xFF11FB40, 0xFF12FB40, 0xFF13FB40, 0xFF14FB40, 0xFF15FB40, 0xFF16FB40, 0xFF17FB40, 0xFF18FB40, 0xFF19FB40, 0xFF1AFB40, 0xFF1BFB40, 0xFF1CFB40, 0xFF1DFB40, 0xFF1EFB40, 0xFF1FFB40,
+		0xFF20FB40, 0xFF21FB40, 0xFF22FB40, 0xFF23FB40, 0xFF24FB40, 0xFF25FB40, 0xFF26FB40, 0xFF27FB40, 0xFF28FB40, 0xFF29FB40, 0xFF2AFB40, 0xFF2BFB40, 0xFF2CFB40, 0xFF2DFB40, 0xFF2EFB40, 0xFF2FFB40, 0xFF30FB40, 0xFF31FB40, 0xFF32FB40, 0xFF33FB40, 0xFF34FB40, 0xFF35FB40, 0xFF36FB40, 0xFF37FB40, 0xFF38FB40, 0xFF39FB40, 0xFF3AFB40, 0xFF3BFB40, 0xFF3CFB40, 0xFF3DFB40, 0xFF3EFB40, 0xFF3FFB40,
+		0xFF40FB40, 0xFF41FB40, 0xFF42FB40, 0xFF43FB40, 0xFF44FB40, 0xFF45FB40, 0xFF46FB40, 0xFF47FB40, 0xFF48FB40, 0xFF49FB40, 0xFF4AFB40, 0xFF4BFB40, 0xFF4CFB40, 0xFF4DFB40, 0xFF4EFB40, 0xFF4FFB40, 0xFF50FB40, 0xFF51FB40, 0xFF52FB40, 0xFF53FB40, 0xFF54FB40, 0xFF55FB40, 0xFF56FB40, 0xFF57FB40, 0xFF58FB40, 0xFF59FB40, 0xFF5AFB40, 0xFF5BFB40, 0xFF5CFB40, 0xFF5DFB40, 0xFF5EFB40, 0xFF5FFB40,
+		0xFF60FB40, 0xFF61FB40, 0xFF62FB40, 0xFF63FB40, 0xFF64FB40, 0xFF65FB40, 0xFF66FB40, 0xFF67FB40, 0xFF68FB40, 0xFF69FB40, 0xFF6AFB40, 0xFF6BFB40, 0xFF6CFB40, 0xFF6DFB40, 0xFF6EFB40, 0xFF6FFB40, 0xFF70FB40, 0xFF71FB40, 0xFF72FB40, 0xFF73FB40, 0xFF74FB40, 0xFF75FB40, 0xFF76FB40, 0xFF77FB40, 0xFF78FB40, 0xFF79FB40, 0xFF7AFB40, 0xFF7BFB40, 0xFF7CFB40, 0xFF7DFB40, 0xFF7EFB40, 0xFF7FFB40,
+		0xFF80FB40, 0xFF81FB40, 0xFF82FB40, 0xFF83FB40, 0xFF84FB40, 0xFF85FB40, 0xFF86FB40, 0xFF87FB40, 0xFF88FB40, 0xFF89FB40, 0xFF8AFB40, 0xFF8BFB40, 0xFF8CFB40, 0xFF8DFB40, 0xFF8EFB40, 0xFF8FFB40, 0xFF90FB40, 0xFF91FB40, 0xFF92FB40, 0xFF93FB40, 0xFF94FB40, 0xFF95FB40, 0xFF96FB40, 0xFF97FB40, 0xFF98FB40, 0xFF99FB40, 0xFF9AFB40, 0xFF9BFB40, 0xFF9CFB40, 0xFF9DFB40, 0xFF9EFB40, 0xFF9FFB40,
+		0xFFA0FB40, 0xFFA1FB40, 0xFFA2FB40, 0xFFA3FB40, 0xFFA4FB40, 0xFFA5FB40, 0xFFA6FB40, 0xFFA7FB40, 0xFFA8FB40, 0xFFA9FB40, 0xFFAAFB40, 0xFFABFB40, 0xFFACFB40, 0xFFADFB40, 0xFFAEFB40, 0xFFAFFB40, 0xFFB0FB40, 0xFFB1FB40, 0xFFB2FB40, 0xFFB3FB40, 0xFFB4FB40, 0xFFB5FB40, 0xFFB6FB40, 0xFFB7FB40, 0xFFB8FB40, 0xFFB9FB40, 0xFFBAFB40, 0xFFBBFB40, 0xFFBCFB40, 0xFFBDFB40, 0xFFBEFB40, 0xFFBFFB40,
+		0xFFC0FB40, 0xFFC1FB40, 0xFFC2FB40, 0xFFC3FB40, 0xFFC4FB40, 0xFFC5FB40, 0xFFC6FB40, 0xFFC7FB40, 0xFFC8FB40, 0xFFC9FB40, 0xFFCAFB40, 0xFFCBFB40, 0xFFCCFB40, 0xFFCDFB40, 0xFFCEFB40, 0xFFCFFB40, 0xFFD0FB40, 0xFFD1FB40, 0xFFD2FB40, 0xFFD3FB40, 0xFFD4FB40, 0xFFD5FB40, 0xFFD6FB40, 0xFFD7FB40, 0xFFD8FB40, 0xFFD9FB40, 0xFFDAFB40, 0xFFDBFB40, 0xFFDCFB40, 0xFFDDFB40, 0xFFDEFB40, 0xFFDFFB40,
+		0xFFE0FB40, 0xFFE1FB40, 0xFFE2FB40, 0xFFE3FB40, 0xFFE4FB40, 0xFFE5FB40, 0xFFE6FB40, 0xFFE7FB40, 0xFFE8FB40, 0xFFE9FB40, 0xFFEAFB40, 0xFFEBFB40, 0xFFECFB40, 0xFFEDFB40, 0xFFEEFB40, 0xFFEFFB40, 0xFFF0FB40, 0xFFF1FB40, 0xFFF2FB40, 0xFFF3FB40, 0xFFF4FB40, 0xFFF5FB40, 0xFFF6FB40, 0xFFF7FB40, 0xFFF8FB40, 0xFFF9FB40, 0xFFFAFB40, 0xFFFBFB40, 0xFFFCFB40, 0xFFFDFB40, 0xFFFEFB40, 0xFFFFFB40,
+		0x8000FB41, 0x8001FB41, 0x8002FB41, 0x8003FB41, 0x8004FB41, 0x8005FB41, 0x8006FB41, 0x8007FB41, 0x8008FB41, 0x8009FB41, 0x800AFB41, 0x800BFB41, 0x800CFB41, 0x800DFB41, 0x800EFB41, 0x800FFB41, 0x8010FB41, 0x8011FB41, 0x8012FB41, 0x8013FB41, 0x8014FB41, 0x8015FB41, 0x8016FB41, 0x8017FB41, 0x8018FB41, 0x8019FB41, 0x801AFB41, 0x801BFB41, 0x801CFB41, 0x801DFB41, 0x801EFB41, 0x801FFB41,
+		0x8020FB41, 0x8021FB41, 0x8022FB41, 0x8023FB41, 0x8024FB41, 0x8025FB41, 0x8026FB41, 0x8027FB41, 0x8028FB41, 0x8029FB41, 0x802AFB41, 0x802BFB41, 0x802CFB41, 0x802DFB41, 0x802EFB41, 0x802FFB41, 0x8030FB41, 0x8031FB41, 0x8032FB41, 0x8033FB41, 0x8034FB41, 0x8035FB41, 0x8036FB41, 0x8037FB41, 0x8038FB41, 0x8039FB41, 0x803AFB41, 0x803BFB41, 0x803CFB41, 0x803DFB41, 0x803EFB41, 0x803FFB41,
+		0x8040FB41, 0x8041FB41, 0x8042FB41, 0x8043FB41, 0x8044FB41, 0x8045FB41, 0x8046FB41, 0x8047FB41, 0x8048FB41, 0x8049FB41, 0x804AFB41, 0x804BFB41, 0x804CFB41, 0x804DFB41, 0x804EFB41, 0x804FFB41, 0x8050FB41, 0x8051FB41, 0x8052FB41, 0x8053FB41, 0x8054FB41, 0x8055FB41, 0x8056FB41, 0x8057FB41, 0x8058FB41, 0x8059FB41, 0x805AFB41, 0x805BFB41, 0x805CFB41, 0x805DFB41, 0x805EFB41, 0x805FFB41,
+		0x8060FB41, 0x8061FB41, 0x8062FB41, 0x8063FB41, 0x8064FB41, 0x8065FB41, 0x8066FB41, 0x8067FB41, 0x8068FB41, 0x8069FB41, 0x806AFB41, 0x806BFB41, 0x806CFB41, 0x806DFB41, 0x806EFB41, 0x806FFB41, 0x8070FB41, 0x8071FB41, 0x8072FB41, 0x8073FB41, 0x8074FB41, 0x8075FB41, 0x8076FB41, 0x8077FB41, 0x8078FB41, 0x8079FB41, 0x807AFB41, 0x807BFB41, 0x807CFB41, 0x807DFB41, 0x807EFB41, 0x807FFB41,
+		0x8080FB41, 0x8081FB41, 0x8082FB41, 0x8083FB41, 0x8084FB41, 0x8085FB41, 0x8086FB41, 0x8087FB41, 0x8088FB41, 0x8089FB41, 0x808AFB41, 0x808BFB41, 0x808CFB41, 0x808DFB41, 0x808EFB41, 0x808FFB41, 0x8090FB41, 0x8091FB41, 0x8092FB41, 0x8093FB41, 0x8094FB41, 0x8095FB41, 0x8096FB41, 0x8097FB41, 0x8098FB41, 0x8099FB41, 0x809AFB41, 0x809BFB41, 0x809CFB41, 0x809DFB41, 0x809EFB41, 0x809FFB41,
+		0x80A0FB41, 0x80A1FB41, 0x80A2FB41, 0x80A3FB41, 0x80A4FB41, 0x80A5FB41, 0x80A6FB41, 0x80A7FB41, 0x80A8FB41, 0x80A9FB41, 0x80AAFB41, 0x80ABFB41, 0x80ACFB41, 0x80ADFB41, 0x80AEFB41, 0x80AFFB41, 0x80B0FB41, 0x80B1FB41, 0x80B2FB41, 0x80B3FB41, 0x80B4FB41, 0x80B5FB41, 0x80B6FB41, 0x80B7FB41, 0x80B8FB41, 0x80B9FB41, 0x80BAFB41, 0x80BBFB41, 0x80BCFB41, 0x80BDFB41, 0x80BEFB41, 0x80BFFB41,
+		0x80C0FB41, 0x80C1FB41, 0x80C2FB41, 0x80C3FB41, 0x80C4FB41, 0x80C5FB41, 0x80C6FB41, 0x80C7FB41, 0x80C8FB41, 0x80C9FB41, 0x80CAFB41, 0x80CBFB41, 0x80CCFB41, 0x80CDFB41, 0x80CEFB41, 0x80CFFB41, 0x80D0FB41, 0x80D1FB41, 0x80D2FB41, 0x80D3FB41, 0x80D4FB41, 0x80D5FB41, 0x80D6FB41, 0x80D7FB41, 0x80D8FB41, 0x80D9FB41, 0x80DAFB41, 0x80DBFB41, 0x80DCFB41, 0x80DDFB41, 0x80DEFB41, 0x80DFFB41,
+		0x80E0FB41, 0x80E1FB41, 0x80E2FB41, 0x80E3FB41, 0x80E4FB41, 0x80E5FB41, 0x80E6FB41, 0x80E7FB41, 0x80E8FB41, 0x80E9FB41, 0x80EAFB41, 0x80EBFB41, 0x80ECFB41, 0x80EDFB41, 0x80EEFB41, 0x80EFFB41, 0x80F0FB41, 0x80F1FB41, 0x80F2FB41, 0x80F3FB41, 0x80F4FB41, 0x80F5FB41, 0x80F6FB41, 0x80F7FB41, 0x80F8FB41, 0x80F9FB41, 0x80FAFB41, 0x80FBFB41, 0x80FCFB41, 0x80FDFB41, 0x80FEFB41, 0x80FFFB41,
+		0x8100FB41, 0x8101FB41, 0x8102FB41, 0x8103FB41, 0x8104FB41, 0x8105FB41, 0x8106FB41, 0x8107FB41, 0x8108FB41, 0x8109FB41, 0x810AFB41, 0x810BFB41, 0x810CFB41, 0x810DFB41, 0x810EFB41, 0x810FFB41, 0x8110FB41, 0x8111FB41, 0x8112FB41, 0x8113FB41, 0x8114FB41, 0x8115FB41, 0x8116FB41, 0x8117FB41, 0x8118FB41, 0x8119FB41, 0x811AFB41, 0x811BFB41, 0x811CFB41, 0x811DFB41, 0x811EFB41, 0x811FFB41,
+		0x8120FB41, 0x8121FB41, 0x8122FB41, 0x8123FB41, 0x8124FB41, 0x8125FB41, 0x8126FB41, 0x8127FB41, 0x8128FB41, 0x8129FB41, 0x812AFB41, 0x812BFB41, 0x812CFB41, 0x812DFB41, 0x812EFB41, 0x812FFB41, 0x8130FB41, 0x8131FB41, 0x8132FB41, 0x8133FB41, 0x8134FB41, 0x8135FB41, 0x8136FB41, 0x8137FB41, 0x8138FB41, 0x8139FB41, 0x813AFB41, 0x813BFB41, 0x813CFB41, 0x813DFB41, 0x813EFB41, 0x813FFB41,
+		0x8140FB41, 0x8141FB41, 0x8142FB41, 0x8143FB41, 0x8144FB41, 0x8145FB41, 0x8146FB41, 0x8147FB41, 0x8148FB41, 0x8149FB41, 0x814AFB41, 0x814BFB41, 0x814CFB41, 0x814DFB41, 0x814EFB41, 0x814FFB41, 0x8150FB41, 0x8151FB41, 0x8152FB41, 0x8153FB41, 0x8154FB41, 0x8155FB41, 0x8156FB41, 0x8157FB41, 0x8158FB41, 0x8159FB41, 0x815AFB41, 0x815BFB41, 0x815CFB41, 0x815DFB41, 0x815EFB41, 0x815FFB41,
+		0x8160FB41, 0x8161FB41, 0x8162FB41, 0x8163FB41, 0x8164FB41, 0x8165FB41, 0x8166FB41, 0x8167FB41, 0x8168FB41, 0x8169FB41, 0x816AFB41, 0x816BFB41, 0x816CFB41, 0x816DFB41, 0x816EFB41, 0x816FFB41, 0x8170FB41, 0x8171FB41, 0x8172FB41, 0x8173FB41, 0x8174FB41, 0x8175FB41, 0x8176FB41, 0x8177FB41, 0x8178FB41, 0x8179FB41, 0x817AFB41, 0x817BFB41, 0x817CFB41, 0x817DFB41, 0x817EFB41, 0x817FFB41,
+		0x8180FB41, 0x8181FB41, 0x8182FB41, 0x8183FB41, 0x8184FB41, 0x8185FB41, 0x8186FB41, 0x8187FB41, 0x8188FB41, 0x8189FB41, 0x818AFB41, 0x818BFB41, 0x818CFB41, 0x818DFB41, 0x818EFB41, 0x818FFB41, 0x8190FB41, 0x8191FB41, 0x8192FB41, 0x8193FB41, 0x8194FB41, 0x8195FB41, 0x8196FB41, 0x8197FB41, 0x8198FB41, 0x8199FB41, 0x819AFB41, 0x819BFB41, 0x819CFB41, 0x819DFB41, 0x819EFB41, 0x819FFB41,
+		0x81A0FB41, 0x81A1FB41, 0x81A2FB41, 0x81A3FB41, 0x81A4FB41, 0x81A5FB41, 0x81A6FB41, 0x81A7FB41, 0x81A8FB41, 0x81A9FB41, 0x81AAFB41, 0x81ABFB41, 0x81ACFB41, 0x81ADFB41, 0x81AEFB41, 0x81AFFB41, 0x81B0FB41, 0x81B1FB41, 0x81B2FB41, 0x81B3FB41, 0x81B4FB41, 0x81B5FB41, 0x81B6FB41, 0x81B7FB41, 0x81B8FB41, 0x81B9FB41, 0x81BAFB41, 0x81BBFB41, 0x81BCFB41, 0x81BDFB41, 0x81BEFB41, 0x81BFFB41,
+		0x81C0FB41, 0x81C1FB41, 0x81C2FB41, 0x81C3FB41, 0x81C4FB41, 0x81C5FB41, 0x81C6FB41, 0x81C7FB41, 0x81C8FB41, 0x81C9FB41, 0x81CAFB41, 0x81CBFB41, 0x81CCFB41, 0x81CDFB41, 0x81CEFB41, 0x81CFFB41, 0x81D0FB41, 0x81D1FB41, 0x81D2FB41, 0x81D3FB41, 0x81D4FB41, 0x81D5FB41, 0x81D6FB41, 0x81D7FB41, 0x81D8FB41, 0x81D9FB41, 0x81DAFB41, 0x81DBFB41, 0x81DCFB41, 0x81DDFB41, 0x81DEFB41, 0x81DFFB41,
+		0x81E0FB41, 0x81E1FB41, 0x81E2FB41, 0x81E3FB41, 0x81E4FB41, 0x81E5FB41, 0x81E6FB41, 0x81E7FB41, 0x81E8FB41, 0x81E9FB41, 0x81EAFB41, 0x81EBFB41, 0x81ECFB41, 0x81EDFB41, 0x81EEFB41, 0x81EFFB41, 0x81F0FB41, 0x81F1FB41, 0x81F2FB41, 0x81F3FB41, 0x81F4FB41, 0x81F5FB41, 0x81F6FB41, 0x81F7FB41, 0x81F8FB41, 0x81F9FB41, 0x81FAFB41, 0x81FBFB41, 0x81FCFB41, 0x81FDFB41, 0x81FEFB41, 0x81FFFB41,
+		0x8200FB41, 0x8201FB41, 0x8202FB41, 0x8203FB41, 0x8204FB41, 0x8205FB41, 0x8206FB41, 0x8207FB41, 0x8208FB41, 0x8209FB41, 0x820AFB41, 0x820BFB41, 0x820CFB41, 0x820DFB41, 0x820EFB41, 0x820FFB41, 0x8210FB41, 0x8211FB41, 0x8212FB41, 0x8213FB41, 0x8214FB41, 0x8215FB41, 0x8216FB41, 0x8217FB41, 0x8218FB41, 0x8219FB41, 0x821AFB41, 0x821BFB41, 0x821CFB41, 0x821DFB41, 0x821EFB41, 0x821FFB41,
+		0x8220FB41, 0x8221FB41, 0x8222FB41, 0x8223FB41, 0x8224FB41, 0x8225FB41, 0x8226FB41, 0x8227FB41, 0x8228FB41, 0x8229FB41, 0x822AFB41, 0x822BFB41, 0x822CFB41, 0x822DFB41, 0x822EFB41, 0x822FFB41, 0x8230FB41, 0x8231FB41, 0x8232FB41, 0x8233FB41, 0x8234FB41, 0x8235FB41, 0x8236FB41, 0x8237FB41, 0x8238FB41, 0x8239FB41, 0x823AFB41, 0x823BFB41, 0x823CFB41, 0x823DFB41, 0x823EFB41, 0x823FFB41,
+		0x8240FB41, 0x8241FB41, 0x8242FB41, 0x8243FB41, 0x8244FB41, 0x8245FB41, 0x8246FB41, 0x8247FB41, 0x8248FB41, 0x8249FB41, 0x824AFB41, 0x824BFB41, 0x824CFB41, 0x824DFB41, 0x824EFB41, 0x824FFB41, 0x8250FB41, 0x8251FB41, 0x8252FB41, 0x8253FB41, 0x8254FB41, 0x8255FB41, 0x8256FB41, 0x8257FB41, 0x8258FB41, 0x8259FB41, 0x825AFB41, 0x825BFB41, 0x825CFB41, 0x825DFB41, 0x825EFB41, 0x825FFB41,
+		0x8260FB41, 0x8261FB41, 0x8262FB41, 0x8263FB41, 0x8264FB41, 0x8265FB41, 0x8266FB41, 0x8267FB41, 0x8268FB41, 0x8269FB41, 0x826AFB41, 0x826BFB41, 0x826CFB41, 0x826DFB41, 0x826EFB41, 0x826FFB41, 0x8270FB41, 0x8271FB41, 0x8272FB41, 0x8273FB41, 0x8274FB41, 0x8275FB41, 0x8276FB41, 0x8277FB41, 0x8278FB41, 0x8279FB41, 0x827AFB41, 0x827BFB41, 0x827CFB41, 0x827DFB41, 0x827EFB41, 0x827FFB41,
+		0x8280FB41, 0x8281FB41, 0x8282FB41, 0x8283FB41, 0x8284FB41, 0x8285FB41, 0x8286FB41, 0x8287FB41, 0x8288FB41, 0x8289FB41, 0x828AFB41, 0x828BFB41, 0x828CFB41, 0x828DFB41, 0x828EFB41, 0x828FFB41, 0x8290FB41, 0x8291FB41, 0x8292FB41, 0x8293FB41, 0x8294FB41, 0x8295FB41, 0x8296FB41, 0x8297FB41, 0x8298FB41, 0x8299FB41, 0x829AFB41, 0x829BFB41, 0x829CFB41, 0x829DFB41, 0x829EFB41, 0x829FFB41,
+		0x82A0FB41, 0x82A1FB41, 0x82A2FB41, 0x82A3FB41, 0x82A4FB41, 0x82A5FB41, 0x82A6FB41, 0x82A7FB41, 0x82A8FB41, 0x82A9FB41, 0x82AAFB41, 0x82ABFB41, 0x82ACFB41, 0x82ADFB41, 0x82AEFB41, 0x82AFFB41, 0x82B0FB41, 0x82B1FB41, 0x82B2FB41, 0x82B3FB41, 0x82B4FB41, 0x82B5FB41, 0x82B6FB41, 0x82B7FB41, 0x82B8FB41, 0x82B9FB41, 0x82BAFB41, 0x82BBFB41, 0x82BCFB41, 0x82BDFB41, 0x82BEFB41, 0x82BFFB41,
+		0x82C0FB41, 0x82C1FB41, 0x82C2FB41, 0x82C3FB41, 0x82C4FB41, 0x82C5FB41, 0x82C6FB41, 0x82C7FB41, 0x82C8FB41, 0x82C9FB41, 0x82CAFB41, 0x82CBFB41, 0x82CCFB41, 0x82CDFB41, 0x82CEFB41, 0x82CFFB41, 0x82D0FB41, 0x82D1FB41, 0x82D2FB41, 0x82D3FB41, 0x82D4FB41, 0x82D5FB41, 0x82D6FB41, 0x82D7FB41, 0x82D8FB41, 0x82D9FB41, 0x82DAFB41, 0x82DBFB41, 0x82DCFB41, 0x82DDFB41, 0x82DEFB41, 0x82DFFB41,
+		0x82E0FB41, 0x82E1FB41, 0x82E2FB41, 0x82E3FB41, 0x82E4FB41, 0x82E5FB41, 0x82E6FB41, 0x82E7FB41, 0x82E8FB41, 0x82E9FB41, 0x82EAFB41, 0x82EBFB41, 0x82ECFB41, 0x82EDFB41, 0x82EEFB41, 0x82EFFB41, 0x82F0FB41, 0x82F1FB41, 0x82F2FB41, 0x82F3FB41, 0x82F4FB41, 0x82F5FB41, 0x82F6FB41, 0x82F7FB41, 0x82F8FB41, 0x82F9FB41, 0x82FAFB41, 0x82FBFB41, 0x82FCFB41, 0x82FDFB41, 0x82FEFB41, 0x82FFFB41,
+		0x8300FB41, 0x8301FB41, 0x8302FB41, 0x8303FB41, 0x8304FB41, 0x8305FB41, 0x8306FB41, 0x8307FB41, 0x8308FB41, 0x8309FB41, 0x830AFB41, 0x830BFB41, 0x830CFB41, 0x830DFB41, 0x830EFB41, 0x830FFB41, 0x8310FB41, 0x8311FB41, 0x8312FB41, 0x8313FB41, 0x8314FB41, 0x8315FB41, 0x8316FB41, 0x8317FB41, 0x8318FB41, 0x8319FB41, 0x831AFB41, 0x831BFB41, 0x831CFB41, 0x831DFB41, 0x831EFB41, 0x831FFB41,
+		0x8320FB41, 0x8321FB41, 0x8322FB41, 0x8323FB41, 0x8324FB41, 0x8325FB41, 0x8326FB41, 0x8327FB41, 0x8328FB41, 0x8329FB41, 0x832AFB41, 0x832BFB41, 0x832CFB41, 0x832DFB41, 0x832EFB41, 0x832FFB41, 0x8330FB41, 0x8331FB41, 0x8332FB41, 0x8333FB41, 0x8334FB41, 0x8335FB41, 0x8336FB41, 0x8337FB41, 0x8338FB41, 0x8339FB41, 0x833AFB41, 0x833BFB41, 0x833CFB41, 0x833DFB41, 0x833EFB41, 0x833FFB41,
+		0x8340FB41, 0x8341FB41, 0x8342FB41, 0x8343FB41, 0x8344FB41, 0x8345FB41, 0x8346FB41, 0x8347FB41, 0x8348FB41, 0x8349FB41, 0x834AFB41, 0x834BFB41, 0x834CFB41, 0x834DFB41, 0x834EFB41, 0x834FFB41, 0x8350FB41, 0x8351FB41, 0x8352FB41, 0x8353FB41, 0x8354FB41, 0x8355FB41, 0x8356FB41, 0x8357FB41, 0x8358FB41, 0x8359FB41, 0x835AFB41, 0x835BFB41, 0x835CFB41, 0x835DFB41, 0x835EFB41, 0x835FFB41,
+		0x8360FB41, 0x8361FB41, 0x8362FB41, 0x8363FB41, 0x8364FB41, 0x8365FB41, 0x8366FB41, 0x8367FB41, 0x8368FB41, 0x8369FB41, 0x836AFB41, 0x836BFB41, 0x836CFB41, 0x836DFB41, 0x836EFB41, 0x836FFB41, 0x8370FB41, 0x8371FB41, 0x8372FB41, 0x8373FB41, 0x8374FB41, 0x8375FB41, 0x8376FB41, 0x8377FB41, 0x8378FB41, 0x8379FB41, 0x837AFB41, 0x837BFB41, 0x837CFB41, 0x837DFB41, 0x837EFB41, 0x837FFB41,
+		0x8380FB41, 0x8381FB41, 0x8382FB41, 0x8383FB41, 0x8384FB41, 0x8385FB41, 0x8386FB41, 0x8387FB41, 0x8388FB41, 0x8389FB41, 0x838AFB41, 0x838BFB41, 0x838CFB41, 0x838DFB41, 0x838EFB41, 0x838FFB41, 0x8390FB41, 0x8391FB41, 0x8392FB41, 0x8393FB41, 0x8394FB41, 0x8395FB41, 0x8396FB41, 0x8397FB41, 0x8398FB41, 0x8399FB41, 0x839AFB41, 0x839BFB41, 0x839CFB41, 0x839DFB41, 0x839EFB41, 0x839FFB41,
+		0x83A0FB41, 0x83A1FB41, 0x83A2FB41, 0x83A3FB41, 0x83A4FB41, 0x83A5FB41, 0x83A6FB41, 0x83A7FB41, 0x83A8FB41, 0x83A9FB41, 0x83AAFB41, 0x83ABFB41, 0x83ACFB41, 0x83ADFB41, 0x83AEFB41, 0x83AFFB41, 0x83B0FB41, 0x83B1FB41, 0x83B2FB41, 0x83B3FB41, 0x83B4FB41, 0x83B5FB41, 0x83B6FB41, 0x83B7FB41, 0x83B8FB41, 0x83B9FB41, 0x83BAFB41, 0x83BBFB41, 0x83BCFB41, 0x83BDFB41, 0x83BEFB41, 0x83BFFB41,
+		0x83C0FB41, 0x83C1FB41, 0x83C2FB41, 0x83C3FB41, 0x83C4FB41, 0x83C5FB41, 0x83C6FB41, 0x83C7FB41, 0x83C8FB41, 0x83C9FB41, 0x83CAFB41, 0x83CBFB41, 0x83CCFB41, 0x83CDFB41, 0x83CEFB41, 0x83CFFB41, 0x83D0FB41, 0x83D1FB41, 0x83D2FB41, 0x83D3FB41, 0x83D4FB41, 0x83D5FB41, 0x83D6FB41, 0x83D7FB41, 0x83D8FB41, 0x83D9FB41, 0x83DAFB41, 0x83DBFB41, 0x83DCFB41, 0x83DDFB41, 0x83DEFB41, 0x83DFFB41,
+		0x83E0FB41, 0x83E1FB41, 0x83E2FB41, 0x83E3FB41, 0x83E4FB41, 0x83E5FB41, 0x83E6FB41, 0x83E7FB41, 0x83E8FB41, 0x83E9FB41, 0x83EAFB41, 0x83EBFB41, 0x83ECFB41, 0x83EDFB41, 0x83EEFB41, 0x83EFFB41, 0x83F0FB41, 0x83F1FB41, 0x83F2FB41, 0x83F3FB41, 0x83F4FB41, 0x83F5FB41, 0x83F6FB41, 0x83F7FB41, 0x83F8FB41, 0x83F9FB41, 0x83FAFB41, 0x83FBFB41, 0x83FCFB41, 0x83FDFB41, 0x83FEFB41, 0x83FFFB41,
+		0x8400FB41, 0x8401FB41, 0x8402FB41, 0x8403FB41, 0x8404FB41, 0x8405FB41, 0x8406FB41, 0x8407FB41, 0x8408FB41, 0x8409FB41, 0x840AFB41, 0x840BFB41, 0x840CFB41, 0x840DFB41, 0x840EFB41, 0x840FFB41, 0x8410FB41, 0x8411FB41, 0x8412FB41, 0x8413FB41, 0x8414FB41, 0x8415FB41, 0x8416FB41, 0x8417FB41, 0x8418FB41, 0x8419FB41, 0x841AFB41, 0x841BFB41, 0x841CFB41, 0x841DFB41, 0x841EFB41, 0x841FFB41,
+		0x8420FB41, 0x8421FB41, 0x8422FB41, 0x8423FB41, 0x8424FB41, 0x8425FB41, 0x8426FB41, 0x8427FB41, 0x8428FB41, 0x8429FB41, 0x842AFB41, 0x842BFB41, 0x842CFB41, 0x842DFB41, 0x842EFB41, 0x842FFB41, 0x8430FB41, 0x8431FB41, 0x8432FB41, 0x8433FB41, 0x8434FB41, 0x8435FB41, 0x8436FB41, 0x8437FB41, 0x8438FB41, 0x8439FB41, 0x843AFB41, 0x843BFB41, 0x843CFB41, 0x843DFB41, 0x843EFB41, 0x843FFB41,
+		0x8440FB41, 0x8441FB41, 0x8442FB41, 0x8443FB41, 0x8444FB41, 0x8445FB41, 0x8446FB41, 0x8447FB41, 0x8448FB41, 0x8449FB41, 0x844AFB41, 0x844BFB41, 0x844CFB41, 0x844DFB41, 0x844EFB41, 0x844FFB41, 0x8450FB41, 0x8451FB41, 0x8452FB41, 0x8453FB41, 0x8454FB41, 0x8455FB41, 0x8456FB41, 0x8457FB41, 0x8458FB41, 0x8459FB41, 0x845AFB41, 0x845BFB41, 0x845CFB41, 0x845DFB41, 0x845EFB41, 0x845FFB41,
+		0x8460FB41, 0x8461FB41, 0x8462FB41, 0x8463FB41, 0x8464FB41, 0x8465FB41, 0x8466FB41, 0x8467FB41, 0x8468FB41, 0x8469FB41, 0x846AFB41, 0x846BFB41, 0x846CFB41, 0x846DFB41, 0x846EFB41, 0x846FFB41, 0x8470FB41, 0x8471FB41, 0x8472FB41, 0x8473FB41, 0x8474FB41, 0x8475FB41, 0x8476FB41, 0x8477FB41, 0x8478FB41, 0x8479FB41, 0x847AFB41, 0x847BFB41, 0x847CFB41, 0x847DFB41, 0x847EFB41, 0x847FFB41,
+		0x8480FB41, 0x8481FB41, 0x8482FB41, 0x8483FB41, 0x8484FB41, 0x8485FB41, 0x8486FB41, 0x8487FB41, 0x8488FB41, 0x8489FB41, 0x848AFB41, 0x848BFB41, 0x848CFB41, 0x848DFB41, 0x848EFB41, 0x848FFB41, 0x8490FB41, 0x8491FB41, 0x8492FB41, 0x8493FB41, 0x8494FB41, 0x8495FB41, 0x8496FB41, 0x8497FB41, 0x8498FB41, 0x8499FB41, 0x849AFB41, 0x849BFB41, 0x849CFB41, 0x849DFB41, 0x849EFB41, 0x849FFB41,
+		0x84A0FB41, 0x84A1FB41, 0x84A2FB41, 0x84A3FB41, 0x84A4FB41, 0x84A5FB41, 0x84A6FB41, 0x84A7FB41, 0x84A8FB41, 0x84A9FB41, 0x84AAFB41, 0x84ABFB41, 0x84ACFB41, 0x84ADFB41, 0x84AEFB41, 0x84AFFB41, 0x84B0FB41, 0x84B1FB41, 0x84B2FB41, 0x84B3FB41, 0x84B4FB41, 0x84B5FB41, 0x84B6FB41, 0x84B7FB41, 0x84B8FB41, 0x84B9FB41, 0x84BAFB41, 0x84BBFB41, 0x84BCFB41, 0x84BDFB41, 0x84BEFB41, 0x84BFFB41,
+		0x84C0FB41, 0x84C1FB41, 0x84C2FB41, 0x84C3FB41, 0x84C4FB41, 0x84C5FB41, 0x84C6FB41, 0x84C7FB41, 0x84C8FB41, 0x84C9FB41, 0x84CAFB41, 0x84CBFB41, 0x84CCFB41, 0x84CDFB41, 0x84CEFB41, 0x84CFFB41, 0x84D0FB41, 0x84D1FB41, 0x84D2FB41, 0x84D3FB41, 0x84D4FB41, 0x84D5FB41, 0x84D6FB41, 0x84D7FB41, 0x84D8FB41, 0x84D9FB41, 0x84DAFB41, 0x84DBFB41, 0x84DCFB41, 0x84DDFB41, 0x84DEFB41, 0x84DFFB41,
+		0x84E0FB41, 0x84E1FB41, 0x84E2FB41, 0x84E3FB41, 0x84E4FB41, 0x84E5FB41, 0x84E6FB41, 0x84E7FB41, 0x84E8FB41, 0x84E9FB41, 0x84EAFB41, 0x84EBFB41, 0x84ECFB41, 0x84EDFB41, 0x84EEFB41, 0x84EFFB41, 0x84F0FB41, 0x84F1FB41, 0x84F2FB41, 0x84F3FB41, 0x84F4FB41, 0x84F5FB41, 0x84F6FB41, 0x84F7FB41, 0x84F8FB41, 0x84F9FB41, 0x84FAFB41, 0x84FBFB41, 0x84FCFB41, 0x84FDFB41, 0x84FEFB41, 0x84FFFB41,
+		0x8500FB41, 0x8501FB41, 0x8502FB41, 0x8503FB41, 0x8504FB41, 0x8505FB41, 0x8506FB41, 0x8507FB41, 0x8508FB41, 0x8509FB41, 0x850AFB41, 0x850BFB41, 0x850CFB41, 0x850DFB41, 0x850EFB41, 0x850FFB41, 0x8510FB41, 0x8511FB41, 0x8512FB41, 0x8513FB41, 0x8514FB41, 0x8515FB41, 0x8516FB41, 0x8517FB41, 0x8518FB41, 0x8519FB41, 0x851AFB41, 0x851BFB41, 0x851CFB41, 0x851DFB41, 0x851EFB41, 0x851FFB41,
+		0x8520FB41, 0x8521FB41, 0x8522FB41, 0x8523FB41, 0x8524FB41, 0x8525FB41, 0x8526FB41, 0x8527FB41, 0x8528FB41, 0x8529FB41, 0x852AFB41, 0x852BFB41, 0x852CFB41, 0x852DFB41, 0x852EFB41, 0x852FFB41, 0x8530FB41, 0x8531FB41, 0x8532FB41, 0x8533FB41, 0x8534FB41, 0x8535FB41, 0x8536FB41, 0x8537FB41, 0x8538FB41, 0x8539FB41, 0x853AFB41, 0x853BFB41, 0x853CFB41, 0x853DFB41, 0x853EFB41, 0x853FFB41,
+		0x8540FB41, 0x8541FB41, 0x8542FB41, 0x8543FB41, 0x8544FB41, 0x8545FB41, 0x8546FB41, 0x8547FB41, 0x8548FB41, 0x8549FB41, 0x854AFB41, 0x854BFB41, 0x854CFB41, 0x854DFB41, 0x854EFB41, 0x854FFB41, 0x8550FB41, 0x8551FB41, 0x8552FB41, 0x8553FB41, 0x8554FB41, 0x8555FB41, 0x8556FB41, 0x8557FB41, 0x8558FB41, 0x8559FB41, 0x855AFB41, 0x855BFB41, 0x855CFB41, 0x855DFB41, 0x855EFB41, 0x855FFB41,
+		0x8560FB41, 0x8561FB41, 0x8562FB41, 0x8563FB41, 0x8564FB41, 0x8565FB41, 0x8566FB41, 0x8567FB41, 0x8568FB41, 0x8569FB41, 0x856AFB41, 0x856BFB41, 0x856CFB41, 0x856DFB41, 0x856EFB41, 0x856FFB41, 0x8570FB41, 0x8571FB41, 0x8572FB41, 0x8573FB41, 0x8574FB41, 0x8575FB41, 0x8576FB41, 0x8577FB41, 0x8578FB41, 0x8579FB41, 0x857AFB41, 0x857BFB41, 0x857CFB41, 0x857DFB41, 0x857EFB41, 0x857FFB41,
+		0x8580FB41, 0x8581FB41, 0x8582FB41, 0x8583FB41, 0x8584FB41, 0x8585FB41, 0x8586FB41, 0x8587FB41, 0x8588FB41, 0x8589FB41, 0x858AFB41, 0x858BFB41, 0x858CFB41, 0x858DFB41, 0x858EFB41, 0x858FFB41, 0x8590FB41, 0x8591FB41, 0x8592FB41, 0x8593FB41, 0x8594FB41, 0x8595FB41, 0x8596FB41, 0x8597FB41, 0x8598FB41, 0x8599FB41, 0x859AFB41, 0x859BFB41, 0x859CFB41, 0x859DFB41, 0x859EFB41, 0x859FFB41,
+		0x85A0FB41, 0x85A1FB41, 0x85A2FB41, 0x85A3FB41, 0x85A4FB41, 0x85A5FB41, 0x85A6FB41, 0x85A7FB41, 0x85A8FB41, 0x85A9FB41, 0x85AAFB41, 0x85ABFB41, 0x85ACFB41, 0x85ADFB41, 0x85AEFB41, 0x85AFFB41, 0x85B0FB41, 0x85B1FB41, 0x85B2FB41, 0x85B3FB41, 0x85B4FB41, 0x85B5FB41, 0x85B6FB41, 0x85B7FB41, 0x85B8FB41, 0x85B9FB41, 0x85BAFB41, 0x85BBFB41, 0x85BCFB41, 0x85BDFB41, 0x85BEFB41, 0x85BFFB41,
+		0x85C0FB41, 0x85C1FB41, 0x85C2FB41, 0x85C3FB41, 0x85C4FB41, 0x85C5FB41, 0x85C6FB41, 0x85C7FB41, 0x85C8FB41, 0x85C9FB41, 0x85CAFB41, 0x85CBFB41, 0x85CCFB41, 0x85CDFB41, 0x85CEFB41, 0x85CFFB41, 0x85D0FB41, 0x85D1FB41, 0x85D2FB41, 0x85D3FB41, 0x85D4FB41, 0x85D5FB41, 0x85D6FB41, 0x85D7FB41, 0x85D8FB41, 0x85D9FB41, 0x85DAFB41, 0x85DBFB41, 0x85DCFB41, 0x85DDFB41, 0x85DEFB41, 0x85DFFB41,
+		0x85E0FB41, 0x85E1FB41, 0x85E2FB41, 0x85E3FB41, 0x85E4FB41, 0x85E5FB41, 0x85E6FB41, 0x85E7FB41, 0x85E8FB41, 0x85E9FB41, 0x85EAFB41, 0x85EBFB41, 0x85ECFB41, 0x85EDFB41, 0x85EEFB41, 0x85EFFB41, 0x85F0FB41, 0x85F1FB41, 0x85F2FB41, 0x85F3FB41, 0x85F4FB41, 0x85F5FB41, 0x85F6FB41, 0x85F7FB41, 0x85F8FB41, 0x85F9FB41, 0x85FAFB41, 0x85FBFB41, 0x85FCFB41, 0x85FDFB41, 0x85FEFB41, 0x85FFFB41,
+		0x8600FB41, 0x8601FB41, 0x8602FB41, 0x8603FB41, 0x8604FB41, 0x8605FB41, 0x8606FB41, 0x8607FB41, 0x8608FB41, 0x8609FB41, 0x860AFB41, 0x860BFB41, 0x860CFB41, 0x860DFB41, 0x860EFB41, 0x860FFB41, 0x8610FB41, 0x8611FB41, 0x8612FB41, 0x8613FB41, 0x8614FB41, 0x8615FB41, 0x8616FB41, 0x8617FB41, 0x8618FB41, 0x8619FB41, 0x861AFB41, 0x861BFB41, 0x861CFB41, 0x861DFB41, 0x861EFB41, 0x861FFB41,
+		0x8620FB41, 0x8621FB41, 0x8622FB41, 0x8623FB41, 0x8624FB41, 0x8625FB41, 0x8626FB41, 0x8627FB41, 0x8628FB41, 0x8629FB41, 0x862AFB41, 0x862BFB41, 0x862CFB41, 0x862DFB41, 0x862EFB41, 0x862FFB41, 0x8630FB41, 0x8631FB41, 0x8632FB41, 0x8633FB41, 0x8634FB41, 0x8635FB41, 0x8636FB41, 0x8637FB41, 0x8638FB41, 0x8639FB41, 0x863AFB41, 0x863BFB41, 0x863CFB41, 0x863DFB41, 0x863EFB41, 0x863FFB41,
+		0x8640FB41, 0x8641FB41, 0x8642FB41, 0x8643FB41, 0x8644FB41, 0x8645FB41, 0x8646FB41, 0x8647FB41, 0x8648FB41, 0x8649FB41, 0x864AFB41, 0x864BFB41, 0x864CFB41, 0x864DFB41, 0x864EFB41, 0x864FFB41, 0x8650FB41, 0x8651FB41, 0x8652FB41, 0x8653FB41, 0x8654FB41, 0x8655FB41, 0x8656FB41, 0x8657FB41, 0x8658FB41, 0x8659FB41, 0x865AFB41, 0x865BFB41, 0x865CFB41, 0x865DFB41, 0x865EFB41, 0x865FFB41,
+		0x8660FB41, 0x8661FB41, 0x8662FB41, 0x8663FB41, 0x8664FB41, 0x8665FB41, 0x8666FB41, 0x8667FB41, 0x8668FB41, 0x8669FB41, 0x866AFB41, 0x866BFB41, 0x866CFB41, 0x866DFB41, 0x866EFB41, 0x866FFB41, 0x8670FB41, 0x8671FB41, 0x8672FB41, 0x8673FB41, 0x8674FB41, 0x8675FB41, 0x8676FB41, 0x8677FB41, 0x8678FB41, 0x8679FB41, 0x867AFB41, 0x867BFB41, 0x867CFB41, 0x867DFB41, 0x867EFB41, 0x867FFB41,
+		0x8680FB41, 0x8681FB41, 0x8682FB41, 0x8683FB41, 0x8684FB41, 0x8685FB41, 0x8686FB41, 0x8687FB41, 0x8688FB41, 0x8689FB41, 0x868AFB41, 0x868BFB41, 0x868CFB41, 0x868DFB41, 0x868EFB41, 0x868FFB41, 0x8690FB41, 0x8691FB41, 0x8692FB41, 0x8693FB41, 0x8694FB41, 0x8695FB41, 0x8696FB41, 0x8697FB41, 0x8698FB41, 0x8699FB41, 0x869AFB41, 0x869BFB41, 0x869CFB41, 0x869DFB41, 0x869EFB41, 0x869FFB41,
+		0x86A0FB41, 0x86A1FB41, 0x86A2FB41, 0x86A3FB41, 0x86A4FB41, 0x86A5FB41, 0x86A6FB41, 0x86A7FB41, 0x86A8FB41, 0x86A9FB41, 0x86AAFB41, 0x86ABFB41, 0x86ACFB41, 0x86ADFB41, 0x86AEFB41, 0x86AFFB41, 0x86B0FB41, 0x86B1FB41, 0x86B2FB41, 0x86B3FB41, 0x86B4FB41, 0x86B5FB41, 0x86B6FB41, 0x86B7FB41, 0x86B8FB41, 0x86B9FB41, 0x86BAFB41, 0x86BBFB41, 0x86BCFB41, 0x86BDFB41, 0x86BEFB41, 0x86BFFB41,
+		0x86C0FB41, 0x86C1FB41, 0x86C2FB41, 0x86C3FB41, 0x86C4FB41, 0x86C5FB41, 0x86C6FB41, 0x86C7FB41, 0x86C8FB41, 0x86C9FB41, 0x86CAFB41, 0x86CBFB41, 0x86CCFB41, 0x86CDFB41, 0x86CEFB41, 0x86CFFB41, 0x86D0FB41, 0x86D1FB41, 0x86D2FB41, 0x86D3FB41, 0x86D4FB41, 0x86D5FB41, 0x86D6FB41, 0x86D7FB41, 0x86D8FB41, 0x86D9FB41, 0x86DAFB41, 0x86DBFB41, 0x86DCFB41, 0x86DDFB41, 0x86DEFB41, 0x86DFFB41,
+		0x86E0FB41, 0x86E1FB41, 0x86E2FB41, 0x86E3FB41, 0x86E4FB41, 0x86E5FB41, 0x86E6FB41, 0x86E7FB41, 0x86E8FB41, 0x86E9FB41, 0x86EAFB41, 0x86EBFB41, 0x86ECFB41, 0x86EDFB41, 0x86EEFB41, 0x86EFFB41, 0x86F0FB41, 0x86F1FB41, 0x86F2FB41, 0x86F3FB41, 0x86F4FB41, 0x86F5FB41, 0x86F6FB41, 0x86F7FB41, 0x86F8FB41, 0x86F9FB41, 0x86FAFB41, 0x86FBFB41, 0x86FCFB41, 0x86FDFB41, 0x86FEFB41, 0x86FFFB41,
+		0x8700FB41, 0x8701FB41, 0x8702FB41, 0x8703FB41, 0x8704FB41, 0x8705FB41, 0x8706FB41, 0x8707FB41, 0x8708FB41, 0x8709FB41, 0x870AFB41, 0x870BFB41, 0x870CFB41, 0x870DFB41, 0x870EFB41, 0x870FFB41, 0x8710FB41, 0x8711FB41, 0x8712FB41, 0x8713FB41, 0x8714FB41, 0x8715FB41, 0x8716FB41, 0x8717FB41, 0x8718FB41, 0x8719FB41, 0x871AFB41, 0x871BFB41, 0x871CFB41, 0x871DFB41, 0x871EFB41, 0x871FFB41,
+		0x8720FB41, 0x8721FB41, 0x8722FB41, 0x8723FB41, 0x8724FB41, 0x8725FB41, 0x8726FB41, 0x8727FB41, 0x8728FB41, 0x8729FB41, 0x872AFB41, 0x872BFB41, 0x872CFB41, 0x872DFB41, 0x872EFB41, 0x872FFB41, 0x8730FB41, 0x8731FB41, 0x8732FB41, 0x8733FB41, 0x8734FB41, 0x8735FB41, 0x8736FB41, 0x8737FB41, 0x8738FB41, 0x8739FB41, 0x873AFB41, 0x873BFB41, 0x873CFB41, 0x873DFB41, 0x873EFB41, 0x873FFB41,
+		0x8740FB41, 0x8741FB41, 0x8742FB41, 0x8743FB41, 0x8744FB41, 0x8745FB41, 0x8746FB41, 0x8747FB41, 0x8748FB41, 0x8749FB41, 0x874AFB41, 0x874BFB41, 0x874CFB41, 0x874DFB41, 0x874EFB41, 0x874FFB41, 0x8750FB41, 0x8751FB41, 0x8752FB41, 0x8753FB41, 0x8754FB41, 0x8755FB41, 0x8756FB41, 0x8757FB41, 0x8758FB41, 0x8759FB41, 0x875AFB41, 0x875BFB41, 0x875CFB41, 0x875DFB41, 0x875EFB41, 0x875FFB41,
+		0x8760FB41, 0x8761FB41, 0x8762FB41, 0x8763FB41, 0x8764FB41, 0x8765FB41, 0x8766FB41, 0x8767FB41, 0x8768FB41, 0x8769FB41, 0x876AFB41, 0x876BFB41, 0x876CFB41, 0x876DFB41, 0x876EFB41, 0x876FFB41, 0x8770FB41, 0x8771FB41, 0x8772FB41, 0x8773FB41, 0x8774FB41, 0x8775FB41, 0x8776FB41, 0x8777FB41, 0x8778FB41, 0x8779FB41, 0x877AFB41, 0x877BFB41, 0x877CFB41, 0x877DFB41, 0x877EFB41, 0x877FFB41,
+		0x8780FB41, 0x8781FB41, 0x8782FB41, 0x8783FB41, 0x8784FB41, 0x8785FB41, 0x8786FB41, 0x8787FB41, 0x8788FB41, 0x8789FB41, 0x878AFB41, 0x878BFB41, 0x878CFB41, 0x878DFB41, 0x878EFB41, 0x878FFB41, 0x8790FB41, 0x8791FB41, 0x8792FB41, 0x8793FB41, 0x8794FB41, 0x8795FB41, 0x8796FB41, 0x8797FB41, 0x8798FB41, 0x8799FB41, 0x879AFB41, 0x879BFB41, 0x879CFB41, 0x879DFB41, 0x879EFB41, 0x879FFB41,
+		0x87A0FB41, 0x87A1FB41, 0x87A2FB41, 0x87A3FB41, 0x87A4FB41, 0x87A5FB41, 0x87A6FB41, 0x87A7FB41, 0x87A8FB41, 0x87A9FB41, 0x87AAFB41, 0x87ABFB41, 0x87ACFB41, 0x87ADFB41, 0x87AEFB41, 0x87AFFB41, 0x87B0FB41, 0x87B1FB41, 0x87B2FB41, 0x87B3FB41, 0x87B4FB41, 0x87B5FB41, 0x87B6FB41, 0x87B7FB41, 0x87B8FB41, 0x87B9FB41, 0x87BAFB41, 0x87BBFB41, 0x87BCFB41, 0x87BDFB41, 0x87BEFB41, 0x87BFFB41,
+		0x87C0FB41, 0x87C1FB41, 0x87C2FB41, 0x87C3FB41, 0x87C4FB41, 0x87C5FB41, 0x87C6FB41, 0x87C7FB41, 0x87C8FB41, 0x87C9FB41, 0x87CAFB41, 0x87CBFB41, 0x87CCFB41, 0x87CDFB41, 0x87CEFB41, 0x87CFFB41, 0x87D0FB41, 0x87D1FB41, 0x87D2FB41, 0x87D3FB41, 0x87D4FB41, 0x87D5FB41, 0x87D6FB41, 0x87D7FB41, 0x87D8FB41, 0x87D9FB41, 0x87DAFB41, 0x87DBFB41, 0x87DCFB41, 0x87DDFB41, 0x87DEFB41, 0x87DFFB41,
+		0x87E0FB41, 0x87E1FB41, 0x87E2FB41, 0x87E3FB41, 0x87E4FB41, 0x87E5FB41, 0x87E6FB41, 0x87E7FB41, 0x87E8FB41, 0x87E9FB41, 0x87EAFB41, 0x87EBFB41, 0x87ECFB41, 0x87EDFB41, 0x87EEFB41, 0x87EFFB41, 0x87F0FB41, 0x87F1FB41, 0x87F2FB41, 0x87F3FB41, 0x87F4FB41, 0x87F5FB41, 0x87F6FB41, 0x87F7FB41, 0x87F8FB41, 0x87F9FB41, 0x87FAFB41, 0x87FBFB41, 0x87FCFB41, 0x87FDFB41, 0x87FEFB41, 0x87FFFB41,
+		0x8800FB41, 0x8801FB41, 0x8802FB41, 0x8803FB41, 0x8804FB41, 0x8805FB41, 0x8806FB41, 0x8807FB41, 0x8808FB41, 0x8809FB41, 0x880AFB41, 0x880BFB41, 0x880CFB41, 0x880DFB41, 0x880EFB41, 0x880FFB41, 0x8810FB41, 0x8811FB41, 0x8812FB41, 0x8813FB41, 0x8814FB41, 0x8815FB41, 0x8816FB41, 0x8817FB41, 0x8818FB41, 0x8819FB41, 0x881AFB41, 0x881BFB41, 0x881CFB41, 0x881DFB41, 0x881EFB41, 0x881FFB41,
+		0x8820FB41, 0x8821FB41, 0x8822FB41, 0x8823FB41, 0x8824FB41, 0x8825FB41, 0x8826FB41, 0x8827FB41, 0x8828FB41, 0x8829FB41, 0x882AFB41, 0x882BFB41, 0x882CFB41, 0x882DFB41, 0x882EFB41, 0x882FFB41, 0x8830FB41, 0x8831FB41, 0x8832FB41, 0x8833FB41, 0x8834FB41, 0x8835FB41, 0x8836FB41, 0x8837FB41, 0x8838FB41, 0x8839FB41, 0x883AFB41, 0x883BFB41, 0x883CFB41, 0x883DFB41, 0x883EFB41, 0x883FFB41,
+		0x8840FB41, 0x8841FB41, 0x8842FB41, 0x8843FB41, 0x8844FB41, 0x8845FB41, 0x8846FB41, 0x8847FB41, 0x8848FB41, 0x8849FB41, 0x884AFB41, 0x884BFB41, 0x884CFB41, 0x884DFB41, 0x884EFB41, 0x884FFB41, 0x8850FB41, 0x8851FB41, 0x8852FB41, 0x8853FB41, 0x8854FB41, 0x8855FB41, 0x8856FB41, 0x8857FB41, 0x8858FB41, 0x8859FB41, 0x885AFB41, 0x885BFB41, 0x885CFB41, 0x885DFB41, 0x885EFB41, 0x885FFB41,
+		0x8860FB41, 0x8861FB41, 0x8862FB41, 0x8863FB41, 0x8864FB41, 0x8865FB41, 0x8866FB41, 0x8867FB41, 0x8868FB41, 0x8869FB41, 0x886AFB41, 0x886BFB41, 0x886CFB41, 0x886DFB41, 0x886EFB41, 0x886FFB41, 0x8870FB41, 0x8871FB41, 0x8872FB41, 0x8873FB41, 0x8874FB41, 0x8875FB41, 0x8876FB41, 0x8877FB41, 0x8878FB41, 0x8879FB41, 0x887AFB41, 0x887BFB41, 0x887CFB41, 0x887DFB41, 0x887EFB41, 0x887FFB41,
+		0x8880FB41, 0x8881FB41, 0x8882FB41, 0x8883FB41, 0x8884FB41, 0x8885FB41, 0x8886FB41, 0x8887FB41, 0x8888FB41, 0x8889FB41, 0x888AFB41, 0x888BFB41, 0x888CFB41, 0x888DFB41, 0x888EFB41, 0x888FFB41, 0x8890FB41, 0x8891FB41, 0x8892FB41, 0x8893FB41, 0x8894FB41, 0x8895FB41, 0x8896FB41, 0x8897FB41, 0x8898FB41, 0x8899FB41, 0x889AFB41, 0x889BFB41, 0x889CFB41, 0x889DFB41, 0x889EFB41, 0x889FFB41,
+		0x88A0FB41, 0x88A1FB41, 0x88A2FB41, 0x88A3FB41, 0x88A4FB41, 0x88A5FB41, 0x88A6FB41, 0x88A7FB41, 0x88A8FB41, 0x88A9FB41, 0x88AAFB41, 0x88ABFB41, 0x88ACFB41, 0x88ADFB41, 0x88AEFB41, 0x88AFFB41, 0x88B0FB41, 0x88B1FB41, 0x88B2FB41, 0x88B3FB41, 0x88B4FB41, 0x88B5FB41, 0x88B6FB41, 0x88B7FB41, 0x88B8FB41, 0x88B9FB41, 0x88BAFB41, 0x88BBFB41, 0x88BCFB41, 0x88BDFB41, 0x88BEFB41, 0x88BFFB41,
+		0x88C0FB41, 0x88C1FB41, 0x88C2FB41, 0x88C3FB41, 0x88C4FB41, 0x88C5FB41, 0x88C6FB41, 0x88C7FB41, 0x88C8FB41, 0x88C9FB41, 0x88CAFB41, 0x88CBFB41, 0x88CCFB41, 0x88CDFB41, 0x88CEFB41, 0x88CFFB41, 0x88D0FB41, 0x88D1FB41, 0x88D2FB41, 0x88D3FB41, 0x88D4FB41, 0x88D5FB41, 0x88D6FB41, 0x88D7FB41, 0x88D8FB41, 0x88D9FB41, 0x88DAFB41, 0x88DBFB41, 0x88DCFB41, 0x88DDFB41, 0x88DEFB41, 0x88DFFB41,
+		0x88E0FB41, 0x88E1FB41, 0x88E2FB41, 0x88E3FB41, 0x88E4FB41, 0x88E5FB41, 0x88E6FB41, 0x88E7FB41, 0x88E8FB41, 0x88E9FB41, 0x88EAFB41, 0x88EBFB41, 0x88ECFB41, 0x88EDFB41, 0x88EEFB41, 0x88EFFB41, 0x88F0FB41, 0x88F1FB41, 0x88F2FB41, 0x88F3FB41, 0x88F4FB41, 0x88F5FB41, 0x88F6FB41, 0x88F7FB41, 0x88F8FB41, 0x88F9FB41, 0x88FAFB41, 0x88FBFB41, 0x88FCFB41, 0x88FDFB41, 0x88FEFB41, 0x88FFFB41,
+		0x8900FB41, 0x8901FB41, 0x8902FB41, 0x8903FB41, 0x8904FB41, 0x8905FB41, 0x8906FB41, 0x8907FB41, 0x8908FB41, 0x8909FB41, 0x890AFB41, 0x890BFB41, 0x890CFB41, 0x890DFB41, 0x890EFB41, 0x890FFB41, 0x8910FB41, 0x8911FB41, 0x8912FB41, 0x8913FB41, 0x8914FB41, 0x8915FB41, 0x8916FB41, 0x8917FB41, 0x8918FB41, 0x8919FB41, 0x891AFB41, 0x891BFB41, 0x891CFB41, 0x891DFB41, 0x891EFB41, 0x891FFB41,
+		0x8920FB41, 0x8921FB41, 0x8922FB41, 0x8923FB41, 0x8924FB41, 0x8925FB41, 0x8926FB41, 0x8927FB41, 0x8928FB41, 0x8929FB41, 0x892AFB41, 0x892BFB41, 0x892CFB41, 0x892DFB41, 0x892EFB41, 0x892FFB41, 0x8930FB41, 0x8931FB41, 0x8932FB41, 0x8933FB41, 0x8934FB41, 0x8935FB41, 0x8936FB41, 0x8937FB41, 0x8938FB41, 0x8939FB41, 0x893AFB41, 0x893BFB41, 0x893CFB41, 0x893DFB41, 0x893EFB41, 0x893FFB41,
+		0x8940FB41, 0x8941FB41, 0x8942FB41, 0x8943FB41, 0x8944FB41, 0x8945FB41, 0x8946FB41, 0x8947FB41, 0x8948FB41, 0x8949FB41, 0x894AFB41, 0x894BFB41, 0x894CFB41, 0x894DFB41, 0x894EFB41, 0x894FFB41, 0x8950FB41, 0x8951FB41, 0x8952FB41, 0x8953FB41, 0x8954FB41, 0x8955FB41, 0x8956FB41, 0x8957FB41, 0x8958FB41, 0x8959FB41, 0x895AFB41, 0x895BFB41, 0x895CFB41, 0x895DFB41, 0x895EFB41, 0x895FFB41,
+		0x8960FB41, 0x8961FB41, 0x8962FB41, 0x8963FB41, 0x8964FB41, 0x8965FB41, 0x8966FB41, 0x8967FB41, 0x8968FB41, 0x8969FB41, 0x896AFB41, 0x896BFB41, 0x896CFB41, 0x896DFB41, 0x896EFB41, 0x896FFB41, 0x8970FB41, 0x8971FB41, 0x8972FB41, 0x8973FB41, 0x8974FB41, 0x8975FB41, 0x8976FB41, 0x8977FB41, 0x8978FB41, 0x8979FB41, 0x897AFB41, 0x897BFB41, 0x897CFB41, 0x897DFB41, 0x897EFB41, 0x897FFB41,
+		0x8980FB41, 0x8981FB41, 0x8982FB41, 0x8983FB41, 0x8984FB41, 0x8985FB41, 0x8986FB41, 0x8987FB41, 0x8988FB41, 0x8989FB41, 0x898AFB41, 0x898BFB41, 0x898CFB41, 0x898DFB41, 0x898EFB41, 0x898FFB41, 0x8990FB41, 0x8991FB41, 0x8992FB41, 0x8993FB41, 0x8994FB41, 0x8995FB41, 0x8996FB41, 0x8997FB41, 0x8998FB41, 0x8999FB41, 0x899AFB41, 0x899BFB41, 0x899CFB41, 0x899DFB41, 0x899EFB41, 0x899FFB41,
+		0x89A0FB41, 0x89A1FB41, 0x89A2FB41, 0x89A3FB41, 0x89A4FB41, 0x89A5FB41, 0x89A6FB41, 0x89A7FB41, 0x89A8FB41, 0x89A9FB41, 0x89AAFB41, 0x89ABFB41, 0x89ACFB41, 0x89ADFB41, 0x89AEFB41, 0x89AFFB41, 0x89B0FB41, 0x89B1FB41, 0x89B2FB41, 0x89B3FB41, 0x89B4FB41, 0x89B5FB41, 0x89B6FB41, 0x89B7FB41, 0x89B8FB41, 0x89B9FB41, 0x89BAFB41, 0x89BBFB41, 0x89BCFB41, 0x89BDFB41, 0x89BEFB41, 0x89BFFB41,
+		0x89C0FB41, 0x89C1FB41, 0x89C2FB41, 0x89C3FB41, 0x89C4FB41, 0x89C5FB41, 0x89C6FB41, 0x89C7FB41, 0x89C8FB41, 0x89C9FB41, 0x89CAFB41, 0x89CBFB41, 0x89CCFB41, 0x89CDFB41, 0x89CEFB41, 0x89CFFB41, 0x89D0FB41, 0x89D1FB41, 0x89D2FB41, 0x89D3FB41, 0x89D4FB41, 0x89D5FB41, 0x89D6FB41, 0x89D7FB41, 0x89D8FB41, 0x89D9FB41, 0x89DAFB41, 0x89DBFB41, 0x89DCFB41, 0x89DDFB41, 0x89DEFB41, 0x89DFFB41,
+		0x89E0FB41, 0x89E1FB41, 0x89E2FB41, 0x89E3FB41, 0x89E4FB41, 0x89E5FB41, 0x89E6FB41, 0x89E7FB41, 0x89E8FB41, 0x89E9FB41, 0x89EAFB41, 0x89EBFB41, 0x89ECFB41, 0x89EDFB41, 0x89EEFB41, 0x89EFFB41, 0x89F0FB41, 0x89F1FB41, 0x89F2FB41, 0x89F3FB41, 0x89F4FB41, 0x89F5FB41, 0x89F6FB41, 0x89F7FB41, 0x89F8FB41, 0x89F9FB41, 0x89FAFB41, 0x89FBFB41, 0x89FCFB41, 0x89FDFB41, 0x89FEFB41, 0x89FFFB41,
+		0x8A00FB41, 0x8A01FB41, 0x8A02FB41, 0x8A03FB41, 0x8A04FB41, 0x8A05FB41, 0x8A06FB41, 0x8A07FB41, 0x8A08FB41, 0x8A09FB41, 0x8A0AFB41, 0x8A0BFB41, 0x8A0CFB41, 0x8A0DFB41, 0x8A0EFB41, 0x8A0FFB41, 0x8A10FB41, 0x8A11FB41, 0x8A12FB41, 0x8A13FB41, 0x8A14FB41, 0x8A15FB41, 0x8A16FB41, 0x8A17FB41, 0x8A18FB41, 0x8A19FB41, 0x8A1AFB41, 0x8A1BFB41, 0x8A1CFB41, 0x8A1DFB41, 0x8A1EFB41, 0x8A1FFB41,
+		0x8A20FB41, 0x8A21FB41, 0x8A22FB41, 0x8A23FB41, 0x8A24FB41, 0x8A25FB41, 0x8A26FB41, 0x8A27FB41, 0x8A28FB41, 0x8A29FB41, 0x8A2AFB41, 0x8A2BFB41, 0x8A2CFB41, 0x8A2DFB41, 0x8A2EFB41, 0x8A2FFB41, 0x8A30FB41, 0x8A31FB41, 0x8A32FB41, 0x8A33FB41, 0x8A34FB41, 0x8A35FB41, 0x8A36FB41, 0x8A37FB41, 0x8A38FB41, 0x8A39FB41, 0x8A3AFB41, 0x8A3BFB41, 0x8A3CFB41, 0x8A3DFB41, 0x8A3EFB41, 0x8A3FFB41,
+		0x8A40FB41, 0x8A41FB41, 0x8A42FB41, 0x8A43FB41, 0x8A44FB41, 0x8A45FB41, 0x8A46FB41, 0x8A47FB41, 0x8A48FB41, 0x8A49FB41, 0x8A4AFB41, 0x8A4BFB41, 0x8A4CFB41, 0x8A4DFB41, 0x8A4EFB41, 0x8A4FFB41, 0x8A50FB41, 0x8A51FB41, 0x8A52FB41, 0x8A53FB41, 0x8A54FB41, 0x8A55FB41, 0x8A56FB41, 0x8A57FB41, 0x8A58FB41, 0x8A59FB41, 0x8A5AFB41, 0x8A5BFB41, 0x8A5CFB41, 0x8A5DFB41, 0x8A5EFB41, 0x8A5FFB41,
+		0x8A60FB41, 0x8A61FB41, 0x8A62FB41, 0x8A63FB41, 0x8A64FB41, 0x8A65FB41, 0x8A66FB41, 0x8A67FB41, 0x8A68FB41, 0x8A69FB41, 0x8A6AFB41, 0x8A6BFB41, 0x8A6CFB41, 0x8A6DFB41, 0x8A6EFB41, 0x8A6FFB41, 0x8A70FB41, 0x8A71FB41, 0x8A72FB41, 0x8A73FB41, 0x8A74FB41, 0x8A75FB41, 0x8A76FB41, 0x8A77FB41, 0x8A78FB41, 0x8A79FB41, 0x8A7AFB41, 0x8A7BFB41, 0x8A7CFB41, 0x8A7DFB41, 0x8A7EFB41, 0x8A7FFB41,
+		0x8A80FB41, 0x8A81FB41, 0x8A82FB41, 0x8A83FB41, 0x8A84FB41, 0x8A85FB41, 0x8A86FB41, 0x8A87FB41, 0x8A88FB41, 0x8A89FB41, 0x8A8AFB41, 0x8A8BFB41, 0x8A8CFB41, 0x8A8DFB41, 0x8A8EFB41, 0x8A8FFB41, 0x8A90FB41, 0x8A91FB41, 0x8A92FB41, 0x8A93FB41, 0x8A94FB41, 0x8A95FB41, 0x8A96FB41, 0x8A97FB41, 0x8A98FB41, 0x8A99FB41, 0x8A9AFB41, 0x8A9BFB41, 0x8A9CFB41, 0x8A9DFB41, 0x8A9EFB41, 0x8A9FFB41,
+		0x8AA0FB41, 0x8AA1FB41, 0x8AA2FB41, 0x8AA3FB41, 0x8AA4FB41, 0x8AA5FB41, 0x8AA6FB41, 0x8AA7FB41, 0x8AA8FB41, 0x8AA9FB41, 0x8AAAFB41, 0x8AABFB41, 0x8AACFB41, 0x8AADFB41, 0x8AAEFB41, 0x8AAFFB41, 0x8AB0FB41, 0x8AB1FB41, 0x8AB2FB41, 0x8AB3FB41, 0x8AB4FB41, 0x8AB5FB41, 0x8AB6FB41, 0x8AB7FB41, 0x8AB8FB41, 0x8AB9FB41, 0x8ABAFB41, 0x8ABBFB41, 0x8ABCFB41, 0x8ABDFB41, 0x8ABEFB41, 0x8ABFFB41,
+		0x8AC0FB41, 0x8AC1FB41, 0x8AC2FB41, 0x8AC3FB41, 0x8AC4FB41, 0x8AC5FB41, 0x8AC6FB41, 0x8AC7FB41, 0x8AC8FB41, 0x8AC9FB41, 0x8ACAFB41, 0x8ACBFB41, 0x8ACCFB41, 0x8ACDFB41, 0x8ACEFB41, 0x8ACFFB41, 0x8AD0FB41, 0x8AD1FB41, 0x8AD2FB41, 0x8AD3FB41, 0x8AD4FB41, 0x8AD5FB41, 0x8AD6FB41, 0x8AD7FB41, 0x8AD8FB41, 0x8AD9FB41, 0x8ADAFB41, 0x8ADBFB41, 0x8ADCFB41, 0x8ADDFB41, 0x8ADEFB41, 0x8ADFFB41,
+		0x8AE0FB41, 0x8AE1FB41, 0x8AE2FB41, 0x8AE3FB41, 0x8AE4FB41, 0x8AE5FB41, 0x8AE6FB41, 0x8AE7FB41, 0x8AE8FB41, 0x8AE9FB41, 0x8AEAFB41, 0x8AEBFB41, 0x8AECFB41, 0x8AEDFB41, 0x8AEEFB41, 0x8AEFFB41, 0x8AF0FB41, 0x8AF1FB41, 0x8AF2FB41, 0x8AF3FB41, 0x8AF4FB41, 0x8AF5FB41, 0x8AF6FB41, 0x8AF7FB41, 0x8AF8FB41, 0x8AF9FB41, 0x8AFAFB41, 0x8AFBFB41, 0x8AFCFB41, 0x8AFDFB41, 0x8AFEFB41, 0x8AFFFB41,
+		0x8B00FB41, 0x8B01FB41, 0x8B02FB41, 0x8B03FB41, 0x8B04FB41, 0x8B05FB41, 0x8B06FB41, 0x8B07FB41, 0x8B08FB41, 0x8B09FB41, 0x8B0AFB41, 0x8B0BFB41, 0x8B0CFB41, 0x8B0DFB41, 0x8B0EFB41, 0x8B0FFB41, 0x8B10FB41, 0x8B11FB41, 0x8B12FB41, 0x8B13FB41, 0x8B14FB41, 0x8B15FB41, 0x8B16FB41, 0x8B17FB41, 0x8B18FB41, 0x8B19FB41, 0x8B1AFB41, 0x8B1BFB41, 0x8B1CFB41, 0x8B1DFB41, 0x8B1EFB41, 0x8B1FFB41,
+		0x8B20FB41, 0x8B21FB41, 0x8B22FB41, 0x8B23FB41, 0x8B24FB41, 0x8B25FB41, 0x8B26FB41, 0x8B27FB41, 0x8B28FB41, 0x8B29FB41, 0x8B2AFB41, 0x8B2BFB41, 0x8B2CFB41, 0x8B2DFB41, 0x8B2EFB41, 0x8B2FFB41, 0x8B30FB41, 0x8B31FB41, 0x8B32FB41, 0x8B33FB41, 0x8B34FB41, 0x8B35FB41, 0x8B36FB41, 0x8B37FB41, 0x8B38FB41, 0x8B39FB41, 0x8B3AFB41, 0x8B3BFB41, 0x8B3CFB41, 0x8B3DFB41, 0x8B3EFB41, 0x8B3FFB41,
+		0x8B40FB41, 0x8B41FB41, 0x8B42FB41, 0x8B43FB41, 0x8B44FB41, 0x8B45FB41, 0x8B46FB41, 0x8B47FB41, 0x8B48FB41, 0x8B49FB41, 0x8B4AFB41, 0x8B4BFB41, 0x8B4CFB41, 0x8B4DFB41, 0x8B4EFB41, 0x8B4FFB41, 0x8B50FB41, 0x8B51FB41, 0x8B52FB41, 0x8B53FB41, 0x8B54FB41, 0x8B55FB41, 0x8B56FB41, 0x8B57FB41, 0x8B58FB41, 0x8B59FB41, 0x8B5AFB41, 0x8B5BFB41, 0x8B5CFB41, 0x8B5DFB41, 0x8B5EFB41, 0x8B5FFB41,
+		0x8B60FB41, 0x8B61FB41, 0x8B62FB41, 0x8B63FB41, 0x8B64FB41, 0x8B65FB41, 0x8B66FB41, 0x8B67FB41, 0x8B68FB41, 0x8B69FB41, 0x8B6AFB41, 0x8B6BFB41, 0x8B6CFB41, 0x8B6DFB41, 0x8B6EFB41, 0x8B6FFB41, 0x8B70FB41, 0x8B71FB41, 0x8B72FB41, 0x8B73FB41, 0x8B74FB41, 0x8B75FB41, 0x8B76FB41, 0x8B77FB41, 0x8B78FB41, 0x8B79FB41, 0x8B7AFB41, 0x8B7BFB41, 0x8B7CFB41, 0x8B7DFB41, 0x8B7EFB41, 0x8B7FFB41,
+		0x8B80FB41, 0x8B81FB41, 0x8B82FB41, 0x8B83FB41, 0x8B84FB41, 0x8B85FB41, 0x8B86FB41, 0x8B87FB41, 0x8B88FB41, 0x8B89FB41, 0x8B8AFB41, 0x8B8BFB41, 0x8B8CFB41, 0x8B8DFB41, 0x8B8EFB41, 0x8B8FFB41, 0x8B90FB41, 0x8B91FB41, 0x8B92FB41, 0x8B93FB41, 0x8B94FB41, 0x8B95FB41, 0x8B96FB41, 0x8B97FB41, 0x8B98FB41, 0x8B99FB41, 0x8B9AFB41, 0x8B9BFB41, 0x8B9CFB41, 0x8B9DFB41, 0x8B9EFB41, 0x8B9FFB41,
+		0x8BA0FB41, 0x8BA1FB41, 0x8BA2FB41, 0x8BA3FB41, 0x8BA4FB41, 0x8BA5FB41, 0x8BA6FB41, 0x8BA7FB41, 0x8BA8FB41, 0x8BA9FB41, 0x8BAAFB41, 0x8BABFB41, 0x8BACFB41, 0x8BADFB41, 0x8BAEFB41, 0x8BAFFB41, 0x8BB0FB41, 0x8BB1FB41, 0x8BB2FB41, 0x8BB3FB41, 0x8BB4FB41, 0x8BB5FB41, 0x8BB6FB41, 0x8BB7FB41, 0x8BB8FB41, 0x8BB9FB41, 0x8BBAFB41, 0x8BBBFB41, 0x8BBCFB41, 0x8BBDFB41, 0x8BBEFB41, 0x8BBFFB41,
+		0x8BC0FB41, 0x8BC1FB41, 0x8BC2FB41, 0x8BC3FB41, 0x8BC4FB41, 0x8BC5FB41, 0x8BC6FB41, 0x8BC7FB41, 0x8BC8FB41, 0x8BC9FB41, 0x8BCAFB41, 0x8BCBFB41, 0x8BCCFB41, 0x8BCDFB41, 0x8BCEFB41, 0x8BCFFB41, 0x8BD0FB41, 0x8BD1FB41, 0x8BD2FB41, 0x8BD3FB41, 0x8BD4FB41, 0x8BD5FB41, 0x8BD6FB41, 0x8BD7FB41, 0x8BD8FB41, 0x8BD9FB41, 0x8BDAFB41, 0x8BDBFB41, 0x8BDCFB41, 0x8BDDFB41, 0x8BDEFB41, 0x8BDFFB41,
+		0x8BE0FB41, 0x8BE1FB41, 0x8BE2FB41, 0x8BE3FB41, 0x8BE4FB41, 0x8BE5FB41, 0x8BE6FB41, 0x8BE7FB41, 0x8BE8FB41, 0x8BE9FB41, 0x8BEAFB41, 0x8BEBFB41, 0x8BECFB41, 0x8BEDFB41, 0x8BEEFB41, 0x8BEFFB41, 0x8BF0FB41, 0x8BF1FB41, 0x8BF2FB41, 0x8BF3FB41, 0x8BF4FB41, 0x8BF5FB41, 0x8BF6FB41, 0x8BF7FB41, 0x8BF8FB41, 0x8BF9FB41, 0x8BFAFB41, 0x8BFBFB41, 0x8BFCFB41, 0x8BFDFB41, 0x8BFEFB41, 0x8BFFFB41,
+		0x8C00FB41, 0x8C01FB41, 0x8C02FB41, 0x8C03FB41, 0x8C04FB41, 0x8C05FB41, 0x8C06FB41, 0x8C07FB41, 0x8C08FB41, 0x8C09FB41, 0x8C0AFB41, 0x8C0BFB41, 0x8C0CFB41, 0x8C0DFB41, 0x8C0EFB41, 0x8C0FFB41, 0x8C10FB41, 0x8C11FB41, 0x8C12FB41, 0x8C13FB41, 0x8C14FB41, 0x8C15FB41, 0x8C16FB41, 0x8C17FB41, 0x8C18FB41, 0x8C19FB41, 0x8C1AFB41, 0x8C1BFB41, 0x8C1CFB41, 0x8C1DFB41, 0x8C1EFB41, 0x8C1FFB41,
+		0x8C20FB41, 0x8C21FB41, 0x8C22FB41, 0x8C23FB41, 0x8C24FB41, 0x8C25FB41, 0x8C26FB41, 0x8C27FB41, 0x8C28FB41, 0x8C29FB41, 0x8C2AFB41, 0x8C2BFB41, 0x8C2CFB41, 0x8C2DFB41, 0x8C2EFB41, 0x8C2FFB41, 0x8C30FB41, 0x8C31FB41, 0x8C32FB41, 0x8C33FB41, 0x8C34FB41, 0x8C35FB41, 0x8C36FB41, 0x8C37FB41, 0x8C38FB41, 0x8C39FB41, 0x8C3AFB41, 0x8C3BFB41, 0x8C3CFB41, 0x8C3DFB41, 0x8C3EFB41, 0x8C3FFB41,
+		0x8C40FB41, 0x8C41FB41, 0x8C42FB41, 0x8C43FB41, 0x8C44FB41, 0x8C45FB41, 0x8C46FB41, 0x8C47FB41, 0x8C48FB41, 0x8C49FB41, 0x8C4AFB41, 0x8C4BFB41, 0x8C4CFB41, 0x8C4DFB41, 0x8C4EFB41, 0x8C4FFB41, 0x8C50FB41, 0x8C51FB41, 0x8C52FB41, 0x8C53FB41, 0x8C54FB41, 0x8C55FB41, 0x8C56FB41, 0x8C57FB41, 0x8C58FB41, 0x8C59FB41, 0x8C5AFB41, 0x8C5BFB41, 0x8C5CFB41, 0x8C5DFB41, 0x8C5EFB41, 0x8C5FFB41,
+		0x8C60FB41, 0x8C61FB41, 0x8C62FB41, 0x8C63FB41, 0x8C64FB41, 0x8C65FB41, 0x8C66FB41, 0x8C67FB41, 0x8C68FB41, 0x8C69FB41, 0x8C6AFB41, 0x8C6BFB41, 0x8C6CFB41, 0x8C6DFB41, 0x8C6EFB41, 0x8C6FFB41, 0x8C70FB41, 0x8C71FB41, 0x8C72FB41, 0x8C73FB41, 0x8C74FB41, 0x8C75FB41, 0x8C76FB41, 0x8C77FB41, 0x8C78FB41, 0x8C79FB41, 0x8C7AFB41, 0x8C7BFB41, 0x8C7CFB41, 0x8C7DFB41, 0x8C7EFB41, 0x8C7FFB41,
+		0x8C80FB41, 0x8C81FB41, 0x8C82FB41, 0x8C83FB41, 0x8C84FB41, 0x8C85FB41, 0x8C86FB41, 0x8C87FB41, 0x8C88FB41, 0x8C89FB41, 0x8C8AFB41, 0x8C8BFB41, 0x8C8CFB41, 0x8C8DFB41, 0x8C8EFB41, 0x8C8FFB41, 0x8C90FB41, 0x8C91FB41, 0x8C92FB41, 0x8C93FB41, 0x8C94FB41, 0x8C95FB41, 0x8C96FB41, 0x8C97FB41, 0x8C98FB41, 0x8C99FB41, 0x8C9AFB41, 0x8C9BFB41, 0x8C9CFB41, 0x8C9DFB41, 0x8C9EFB41, 0x8C9FFB41,
+		0x8CA0FB41, 0x8CA1FB41, 0x8CA2FB41, 0x8CA3FB41, 0x8CA4FB41, 0x8CA5FB41, 0x8CA6FB41, 0x8CA7FB41, 0x8CA8FB41, 0x8CA9FB41, 0x8CAAFB41, 0x8CABFB41, 0x8CACFB41, 0x8CADFB41, 0x8CAEFB41, 0x8CAFFB41, 0x8CB0FB41, 0x8CB1FB41, 0x8CB2FB41, 0x8CB3FB41, 0x8CB4FB41, 0x8CB5FB41, 0x8CB6FB41, 0x8CB7FB41, 0x8CB8FB41, 0x8CB9FB41, 0x8CBAFB41, 0x8CBBFB41, 0x8CBCFB41, 0x8CBDFB41, 0x8CBEFB41, 0x8CBFFB41,
+		0x8CC0FB41, 0x8CC1FB41, 0x8CC2FB41, 0x8CC3FB41, 0x8CC4FB41, 0x8CC5FB41, 0x8CC6FB41, 0x8CC7FB41, 0x8CC8FB41, 0x8CC9FB41, 0x8CCAFB41, 0x8CCBFB41, 0x8CCCFB41, 0x8CCDFB41, 0x8CCEFB41, 0x8CCFFB41, 0x8CD0FB41, 0x8CD1FB41, 0x8CD2FB41, 0x8CD3FB41, 0x8CD4FB41, 0x8CD5FB41, 0x8CD6FB41, 0x8CD7FB41, 0x8CD8FB41, 0x8CD9FB41, 0x8CDAFB41, 0x8CDBFB41, 0x8CDCFB41, 0x8CDDFB41, 0x8CDEFB41, 0x8CDFFB41,
+		0x8CE0FB41, 0x8CE1FB41, 0x8CE2FB41, 0x8CE3FB41, 0x8CE4FB41, 0x8CE5FB41, 0x8CE6FB41, 0x8CE7FB41, 0x8CE8FB41, 0x8CE9FB41, 0x8CEAFB41, 0x8CEBFB41, 0x8CECFB41, 0x8CEDFB41, 0x8CEEFB41, 0x8CEFFB41, 0x8CF0FB41, 0x8CF1FB41, 0x8CF2FB41, 0x8CF3FB41, 0x8CF4FB41, 0x8CF5FB41, 0x8CF6FB41, 0x8CF7FB41, 0x8CF8FB41, 0x8CF9FB41, 0x8CFAFB41, 0x8CFBFB41, 0x8CFCFB41, 0x8CFDFB41, 0x8CFEFB41, 0x8CFFFB41,
+		0x8D00FB41, 0x8D01FB41, 0x8D02FB41, 0x8D03FB41, 0x8D04FB41, 0x8D05FB41, 0x8D06FB41, 0x8D07FB41, 0x8D08FB41, 0x8D09FB41, 0x8D0AFB41, 0x8D0BFB41, 0x8D0CFB41, 0x8D0DFB41, 0x8D0EFB41, 0x8D0FFB41, 0x8D10FB41, 0x8D11FB41, 0x8D12FB41, 0x8D13FB41, 0x8D14FB41, 0x8D15FB41, 0x8D16FB41, 0x8D17FB41, 0x8D18FB41, 0x8D19FB41, 0x8D1AFB41, 0x8D1BFB41, 0x8D1CFB41, 0x8D1DFB41, 0x8D1EFB41, 0x8D1FFB41,
+		0x8D20FB41, 0x8D21FB41, 0x8D22FB41, 0x8D23FB41, 0x8D24FB41, 0x8D25FB41, 0x8D26FB41, 0x8D27FB41, 0x8D28FB41, 0x8D29FB41, 0x8D2AFB41, 0x8D2BFB41, 0x8D2CFB41, 0x8D2DFB41, 0x8D2EFB41, 0x8D2FFB41, 0x8D30FB41, 0x8D31FB41, 0x8D32FB41, 0x8D33FB41, 0x8D34FB41, 0x8D35FB41, 0x8D36FB41, 0x8D37FB41, 0x8D38FB41, 0x8D39FB41, 0x8D3AFB41, 0x8D3BFB41, 0x8D3CFB41, 0x8D3DFB41, 0x8D3EFB41, 0x8D3FFB41,
+		0x8D40FB41, 0x8D41FB41, 0x8D42FB41, 0x8D43FB41, 0x8D44FB41, 0x8D45FB41, 0x8D46FB41, 0x8D47FB41, 0x8D48FB41, 0x8D49FB41, 0x8D4AFB41, 0x8D4BFB41, 0x8D4CFB41, 0x8D4DFB41, 0x8D4EFB41, 0x8D4FFB41, 0x8D50FB41, 0x8D51FB41, 0x8D52FB41, 0x8D53FB41, 0x8D54FB41, 0x8D55FB41, 0x8D56FB41, 0x8D57FB41, 0x8D58FB41, 0x8D59FB41, 0x8D5AFB41, 0x8D5BFB41, 0x8D5CFB41, 0x8D5DFB41, 0x8D5EFB41, 0x8D5FFB41,
+		0x8D60FB41, 0x8D61FB41, 0x8D62FB41, 0x8D63FB41, 0x8D64FB41, 0x8D65FB41, 0x8D66FB41, 0x8D67FB41, 0x8D68FB41, 0x8D69FB41, 0x8D6AFB41, 0x8D6BFB41, 0x8D6CFB41, 0x8D6DFB41, 0x8D6EFB41, 0x8D6FFB41, 0x8D70FB41, 0x8D71FB41, 0x8D72FB41, 0x8D73FB41, 0x8D74FB41, 0x8D75FB41, 0x8D76FB41, 0x8D77FB41, 0x8D78FB41, 0x8D79FB41, 0x8D7AFB41, 0x8D7BFB41, 0x8D7CFB41, 0x8D7DFB41, 0x8D7EFB41, 0x8D7FFB41,
+		0x8D80FB41, 0x8D81FB41, 0x8D82FB41, 0x8D83FB41, 0x8D84FB41, 0x8D85FB41, 0x8D86FB41, 0x8D87FB41, 0x8D88FB41, 0x8D89FB41, 0x8D8AFB41, 0x8D8BFB41, 0x8D8CFB41, 0x8D8DFB41, 0x8D8EFB41, 0x8D8FFB41, 0x8D90FB41, 0x8D91FB41, 0x8D92FB41, 0x8D93FB41, 0x8D94FB41, 0x8D95FB41, 0x8D96FB41, 0x8D97FB41, 0x8D98FB41, 0x8D99FB41, 0x8D9AFB41, 0x8D9BFB41, 0x8D9CFB41, 0x8D9DFB41, 0x8D9EFB41, 0x8D9FFB41,
+		0x8DA0FB41, 0x8DA1FB41, 0x8DA2FB41, 0x8DA3FB41, 0x8DA4FB41, 0x8DA5FB41, 0x8DA6FB41, 0x8DA7FB41, 0x8DA8FB41, 0x8DA9FB41, 0x8DAAFB41, 0x8DABFB41, 0x8DACFB41, 0x8DADFB41, 0x8DAEFB41, 0x8DAFFB41, 0x8DB0FB41, 0x8DB1FB41, 0x8DB2FB41, 0x8DB3FB41, 0x8DB4FB41, 0x8DB5FB41, 0x8DB6FB41, 0x8DB7FB41, 0x8DB8FB41, 0x8DB9FB41, 0x8DBAFB41, 0x8DBBFB41, 0x8DBCFB41, 0x8DBDFB41, 0x8DBEFB41, 0x8DBFFB41,
+		0x8DC0FB41, 0x8DC1FB41, 0x8DC2FB41, 0x8DC3FB41, 0x8DC4FB41, 0x8DC5FB41, 0x8DC6FB41, 0x8DC7FB41, 0x8DC8FB41, 0x8DC9FB41, 0x8DCAFB41, 0x8DCBFB41, 0x8DCCFB41, 0x8DCDFB41, 0x8DCEFB41, 0x8DCFFB41, 0x8DD0FB41, 0x8DD1FB41, 0x8DD2FB41, 0x8DD3FB41, 0x8DD4FB41, 0x8DD5FB41, 0x8DD6FB41, 0x8DD7FB41, 0x8DD8FB41, 0x8DD9FB41, 0x8DDAFB41, 0x8DDBFB41, 0x8DDCFB41, 0x8DDDFB41, 0x8DDEFB41, 0x8DDFFB41,
+		0x8DE0FB41, 0x8DE1FB41, 0x8DE2FB41, 0x8DE3FB41, 0x8DE4FB41, 0x8DE5FB41, 0x8DE6FB41, 0x8DE7FB41, 0x8DE8FB41, 0x8DE9FB41, 0x8DEAFB41, 0x8DEBFB41, 0x8DECFB41, 0x8DEDFB41, 0x8DEEFB41, 0x8DEFFB41, 0x8DF0FB41, 0x8DF1FB41, 0x8DF2FB41, 0x8DF3FB41, 0x8DF4FB41, 0x8DF5FB41, 0x8DF6FB41, 0x8DF7FB41, 0x8DF8FB41, 0x8DF9FB41, 0x8DFAFB41, 0x8DFBFB41, 0x8DFCFB41, 0x8DFDFB41, 0x8DFEFB41, 0x8DFFFB41,
+		0x8E00FB41, 0x8E01FB41, 0x8E02FB41, 0x8E03FB41, 0x8E04FB41, 0x8E05FB41, 0x8E06FB41, 0x8E07FB41, 0x8E08FB41, 0x8E09FB41, 0x8E0AFB41, 0x8E0BFB41, 0x8E0CFB41, 0x8E0DFB41, 0x8E0EFB41, 0x8E0FFB41, 0x8E10FB41, 0x8E11FB41, 0x8E12FB41, 0x8E13FB41, 0x8E14FB41, 0x8E15FB41, 0x8E16FB41, 0x8E17FB41, 0x8E18FB41, 0x8E19FB41, 0x8E1AFB41, 0x8E1BFB41, 0x8E1CFB41, 0x8E1DFB41, 0x8E1EFB41, 0x8E1FFB41,
+		0x8E20FB41, 0x8E21FB41, 0x8E22FB41, 0x8E23FB41, 0x8E24FB41, 0x8E25FB41, 0x8E26FB41, 0x8E27FB41, 0x8E28FB41, 0x8E29FB41, 0x8E2AFB41, 0x8E2BFB41, 0x8E2CFB41, 0x8E2DFB41, 0x8E2EFB41, 0x8E2FFB41, 0x8E30FB41, 0x8E31FB41, 0x8E32FB41, 0x8E33FB41, 0x8E34FB41, 0x8E35FB41, 0x8E36FB41, 0x8E37FB41, 0x8E38FB41, 0x8E39FB41, 0x8E3AFB41, 0x8E3BFB41, 0x8E3CFB41, 0x8E3DFB41, 0x8E3EFB41, 0x8E3FFB41,
+		0x8E40FB41, 0x8E41FB41, 0x8E42FB41, 0x8E43FB41, 0x8E44FB41, 0x8E45FB41, 0x8E46FB41, 0x8E47FB41, 0x8E48FB41, 0x8E49FB41, 0x8E4AFB41, 0x8E4BFB41, 0x8E4CFB41, 0x8E4DFB41, 0x8E4EFB41, 0x8E4FFB41, 0x8E50FB41, 0x8E51FB41, 0x8E52FB41, 0x8E53FB41, 0x8E54FB41, 0x8E55FB41, 0x8E56FB41, 0x8E57FB41, 0x8E58FB41, 0x8E59FB41, 0x8E5AFB41, 0x8E5BFB41, 0x8E5CFB41, 0x8E5DFB41, 0x8E5EFB41, 0x8E5FFB41,
+		0x8E60FB41, 0x8E61FB41, 0x8E62FB41, 0x8E63FB41, 0x8E64FB41, 0x8E65FB41, 0x8E66FB41, 0x8E67FB41, 0x8E68FB41, 0x8E69FB41, 0x8E6AFB41, 0x8E6BFB41, 0x8E6CFB41, 0x8E6DFB41, 0x8E6EFB41, 0x8E6FFB41, 0x8E70FB41, 0x8E71FB41, 0x8E72FB41, 0x8E73FB41, 0x8E74FB41, 0x8E75FB41, 0x8E76FB41, 0x8E77FB41, 0x8E78FB41, 0x8E79FB41, 0x8E7AFB41, 0x8E7BFB41, 0x8E7CFB41, 0x8E7DFB41, 0x8E7EFB41, 0x8E7FFB41,
+		0x8E80FB41, 0x8E81FB41, 0x8E82FB41, 0x8E83FB41, 0x8E84FB41, 0x8E85FB41, 0x8E86FB41, 0x8E87FB41, 0x8E88FB41, 0x8E89FB41, 0x8E8AFB41, 0x8E8BFB41, 0x8E8CFB41, 0x8E8DFB41, 0x8E8EFB41, 0x8E8FFB41, 0x8E90FB41, 0x8E91FB41, 0x8E92FB41, 0x8E93FB41, 0x8E94FB41, 0x8E95FB41, 0x8E96FB41, 0x8E97FB41, 0x8E98FB41, 0x8E99FB41, 0x8E9AFB41, 0x8E9BFB41, 0x8E9CFB41, 0x8E9DFB41, 0x8E9EFB41, 0x8E9FFB41,
+		0x8EA0FB41, 0x8EA1FB41, 0x8EA2FB41, 0x8EA3FB41, 0x8EA4FB41, 0x8EA5FB41, 0x8EA6FB41, 0x8EA7FB41, 0x8EA8FB41, 0x8EA9FB41, 0x8EAAFB41, 0x8EABFB41, 0x8EACFB41, 0x8EADFB41, 0x8EAEFB41, 0x8EAFFB41, 0x8EB0FB41, 0x8EB1FB41, 0x8EB2FB41, 0x8EB3FB41, 0x8EB4FB41, 0x8EB5FB41, 0x8EB6FB41, 0x8EB7FB41, 0x8EB8FB41, 0x8EB9FB41, 0x8EBAFB41, 0x8EBBFB41, 0x8EBCFB41, 0x8EBDFB41, 0x8EBEFB41, 0x8EBFFB41,
+		0x8EC0FB41, 0x8EC1FB41, 0x8EC2FB41, 0x8EC3FB41, 0x8EC4FB41, 0x8EC5FB41, 0x8EC6FB41, 0x8EC7FB41, 0x8EC8FB41, 0x8EC9FB41, 0x8ECAFB41, 0x8ECBFB41, 0x8ECCFB41, 0x8ECDFB41, 0x8ECEFB41, 0x8ECFFB41, 0x8ED0FB41, 0x8ED1FB41, 0x8ED2FB41, 0x8ED3FB41, 0x8ED4FB41, 0x8ED5FB41, 0x8ED6FB41, 0x8ED7FB41, 0x8ED8FB41, 0x8ED9FB41, 0x8EDAFB41, 0x8EDBFB41, 0x8EDCFB41, 0x8EDDFB41, 0x8EDEFB41, 0x8EDFFB41,
+		0x8EE0FB41, 0x8EE1FB41, 0x8EE2FB41, 0x8EE3FB41, 0x8EE4FB41, 0x8EE5FB41, 0x8EE6FB41, 0x8EE7FB41, 0x8EE8FB41, 0x8EE9FB41, 0x8EEAFB41, 0x8EEBFB41, 0x8EECFB41, 0x8EEDFB41, 0x8EEEFB41, 0x8EEFFB41, 0x8EF0FB41, 0x8EF1FB41, 0x8EF2FB41, 0x8EF3FB41, 0x8EF4FB41, 0x8EF5FB41, 0x8EF6FB41, 0x8EF7FB41, 0x8EF8FB41, 0x8EF9FB41, 0x8EFAFB41, 0x8EFBFB41, 0x8EFCFB41, 0x8EFDFB41, 0x8EFEFB41, 0x8EFFFB41,
+		0x8F00FB41, 0x8F01FB41, 0x8F02FB41, 0x8F03FB41, 0x8F04FB41, 0x8F05FB41, 0x8F06FB41, 0x8F07FB41, 0x8F08FB41, 0x8F09FB41, 0x8F0AFB41, 0x8F0BFB41, 0x8F0CFB41, 0x8F0DFB41, 0x8F0EFB41, 0x8F0FFB41, 0x8F10FB41, 0x8F11FB41, 0x8F12FB41, 0x8F13FB41, 0x8F14FB41, 0x8F15FB41, 0x8F16FB41, 0x8F17FB41, 0x8F18FB41, 0x8F19FB41, 0x8F1AFB41, 0x8F1BFB41, 0x8F1CFB41, 0x8F1DFB41, 0x8F1EFB41, 0x8F1FFB41,
+		0x8F20FB41, 0x8F21FB41, 0x8F22FB41, 0x8F23FB41, 0x8F24FB41, 0x8F25FB41, 0x8F26FB41, 0x8F27FB41, 0x8F28FB41, 0x8F29FB41, 0x8F2AFB41, 0x8F2BFB41, 0x8F2CFB41, 0x8F2DFB41, 0x8F2EFB41, 0x8F2FFB41, 0x8F30FB41, 0x8F31FB41, 0x8F32FB41, 0x8F33FB41, 0x8F34FB41, 0x8F35FB41, 0x8F36FB41, 0x8F37FB41, 0x8F38FB41, 0x8F39FB41, 0x8F3AFB41, 0x8F3BFB41, 0x8F3CFB41, 0x8F3DFB41, 0x8F3EFB41, 0x8F3FFB41,
+		0x8F40FB41, 0x8F41FB41, 0x8F42FB41, 0x8F43FB41, 0x8F44FB41, 0x8F45FB41, 0x8F46FB41, 0x8F47FB41, 0x8F48FB41, 0x8F49FB41, 0x8F4AFB41, 0x8F4BFB41, 0x8F4CFB41, 0x8F4DFB41, 0x8F4EFB41, 0x8F4FFB41, 0x8F50FB41, 0x8F51FB41, 0x8F52FB41, 0x8F53FB41, 0x8F54FB41, 0x8F55FB41, 0x8F56FB41, 0x8F57FB41, 0x8F58FB41, 0x8F59FB41, 0x8F5AFB41, 0x8F5BFB41, 0x8F5CFB41, 0x8F5DFB41, 0x8F5EFB41, 0x8F5FFB41,
+		0x8F60FB41, 0x8F61FB41, 0x8F62FB41, 0x8F63FB41, 0x8F64FB41, 0x8F65FB41, 0x8F66FB41, 0x8F67FB41, 0x8F68FB41, 0x8F69FB41, 0x8F6AFB41, 0x8F6BFB41, 0x8F6CFB41, 0x8F6DFB41, 0x8F6EFB41, 0x8F6FFB41, 0x8F70FB41, 0x8F71FB41, 0x8F72FB41, 0x8F73FB41, 0x8F74FB41, 0x8F75FB41, 0x8F76FB41, 0x8F77FB41, 0x8F78FB41, 0x8F79FB41, 0x8F7AFB41, 0x8F7BFB41, 0x8F7CFB41, 0x8F7DFB41, 0x8F7EFB41, 0x8F7FFB41,
+		0x8F80FB41, 0x8F81FB41, 0x8F82FB41, 0x8F83FB41, 0x8F84FB41, 0x8F85FB41, 0x8F86FB41, 0x8F87FB41, 0x8F88FB41, 0x8F89FB41, 0x8F8AFB41, 0x8F8BFB41, 0x8F8CFB41, 0x8F8DFB41, 0x8F8EFB41, 0x8F8FFB41, 0x8F90FB41, 0x8F91FB41, 0x8F92FB41, 0x8F93FB41, 0x8F94FB41, 0x8F95FB41, 0x8F96FB41, 0x8F97FB41, 0x8F98FB41, 0x8F99FB41, 0x8F9AFB41, 0x8F9BFB41, 0x8F9CFB41, 0x8F9DFB41, 0x8F9EFB41, 0x8F9FFB41,
+		0x8FA0FB41, 0x8FA1FB41, 0x8FA2FB41, 0x8FA3FB41, 0x8FA4FB41, 0x8FA5FB41, 0x8FA6FB41, 0x8FA7FB41, 0x8FA8FB41, 0x8FA9FB41, 0x8FAAFB41, 0x8FABFB41, 0x8FACFB41, 0x8FADFB41, 0x8FAEFB41, 0x8FAFFB41, 0x8FB0FB41, 0x8FB1FB41, 0x8FB2FB41, 0x8FB3FB41, 0x8FB4FB41, 0x8FB5FB41, 0x8FB6FB41, 0x8FB7FB41, 0x8FB8FB41, 0x8FB9FB41, 0x8FBAFB41, 0x8FBBFB41, 0x8FBCFB41, 0x8FBDFB41, 0x8FBEFB41, 0x8FBFFB41,
+		0x8FC0FB41, 0x8FC1FB41, 0x8FC2FB41, 0x8FC3FB41, 0x8FC4FB41, 0x8FC5FB41, 0x8FC6FB41, 0x8FC7FB41, 0x8FC8FB41, 0x8FC9FB41, 0x8FCAFB41, 0x8FCBFB41, 0x8FCCFB41, 0x8FCDFB41, 0x8FCEFB41, 0x8FCFFB41, 0x8FD0FB41, 0x8FD1FB41, 0x8FD2FB41, 0x8FD3FB41, 0x8FD4FB41, 0x8FD5FB41, 0x8FD6FB41, 0x8FD7FB41, 0x8FD8FB41, 0x8FD9FB41, 0x8FDAFB41, 0x8FDBFB41, 0x8FDCFB41, 0x8FDDFB41, 0x8FDEFB41, 0x8FDFFB41,
+		0x8FE0FB41, 0x8FE1FB41, 0x8FE2FB41, 0x8FE3FB41, 0x8FE4FB41, 0x8FE5FB41, 0x8FE6FB41, 0x8FE7FB41, 0x8FE8FB41, 0x8FE9FB41, 0x8FEAFB41, 0x8FEBFB41, 0x8FECFB41, 0x8FEDFB41, 0x8FEEFB41, 0x8FEFFB41, 0x8FF0FB41, 0x8FF1FB41, 0x8FF2FB41, 0x8FF3FB41, 0x8FF4FB41, 0x8FF5FB41, 0x8FF6FB41, 0x8FF7FB41, 0x8FF8FB41, 0x8FF9FB41, 0x8FFAFB41, 0x8FFBFB41, 0x8FFCFB41, 0x8FFDFB41, 0x8FFEFB41, 0x8FFFFB41,
+		0x9000FB41, 0x9001FB41, 0x9002FB41, 0x9003FB41, 0x9004FB41, 0x9005FB41, 0x9006FB41, 0x9007FB41, 0x9008FB41, 0x9009FB41, 0x900AFB41, 0x900BFB41, 0x900CFB41, 0x900DFB41, 0x900EFB41, 0x900FFB41, 0x9010FB41, 0x9011FB41, 0x9012FB41, 0x9013FB41, 0x9014FB41, 0x9015FB41, 0x9016FB41, 0x9017FB41, 0x9018FB41, 0x9019FB41, 0x901AFB41, 0x901BFB41, 0x901CFB41, 0x901DFB41, 0x901EFB41, 0x901FFB41,
+		0x9020FB41, 0x9021FB41, 0x9022FB41, 0x9023FB41, 0x9024FB41, 0x9025FB41, 0x9026FB41, 0x9027FB41, 0x9028FB41, 0x9029FB41, 0x902AFB41, 0x902BFB41, 0x902CFB41, 0x902DFB41, 0x902EFB41, 0x902FFB41, 0x9030FB41, 0x9031FB41, 0x9032FB41, 0x9033FB41, 0x9034FB41, 0x9035FB41, 0x9036FB41, 0x9037FB41, 0x9038FB41, 0x9039FB41, 0x903AFB41, 0x903BFB41, 0x903CFB41, 0x903DFB41, 0x903EFB41, 0x903FFB41,
+		0x9040FB41, 0x9041FB41, 0x9042FB41, 0x9043FB41, 0x9044FB41, 0x9045FB41, 0x9046FB41, 0x9047FB41, 0x9048FB41, 0x9049FB41, 0x904AFB41, 0x904BFB41, 0x904CFB41, 0x904DFB41, 0x904EFB41, 0x904FFB41, 0x9050FB41, 0x9051FB41, 0x9052FB41, 0x9053FB41, 0x9054FB41, 0x9055FB41, 0x9056FB41, 0x9057FB41, 0x9058FB41, 0x9059FB41, 0x905AFB41, 0x905BFB41, 0x905CFB41, 0x905DFB41, 0x905EFB41, 0x905FFB41,
+		0x9060FB41, 0x9061FB41, 0x9062FB41, 0x9063FB41, 0x9064FB41, 0x9065FB41, 0x9066FB41, 0x9067FB41, 0x9068FB41, 0x9069FB41, 0x906AFB41, 0x906BFB41, 0x906CFB41, 0x906DFB41, 0x906EFB41, 0x906FFB41, 0x9070FB41, 0x9071FB41, 0x9072FB41, 0x9073FB41, 0x9074FB41, 0x9075FB41, 0x9076FB41, 0x9077FB41, 0x9078FB41, 0x9079FB41, 0x907AFB41, 0x907BFB41, 0x907CFB41, 0x907DFB41, 0x907EFB41, 0x907FFB41,
+		0x9080FB41, 0x9081FB41, 0x9082FB41, 0x9083FB41, 0x9084FB41, 0x9085FB41, 0x9086FB41, 0x9087FB41, 0x9088FB41, 0x9089FB41, 0x908AFB41, 0x908BFB41, 0x908CFB41, 0x908DFB41, 0x908EFB41, 0x908FFB41, 0x9090FB41, 0x9091FB41, 0x9092FB41, 0x9093FB41, 0x9094FB41, 0x9095FB41, 0x9096FB41, 0x9097FB41, 0x9098FB41, 0x9099FB41, 0x909AFB41, 0x909BFB41, 0x909CFB41, 0x909DFB41, 0x909EFB41, 0x909FFB41,
+		0x90A0FB41, 0x90A1FB41, 0x90A2FB41, 0x90A3FB41, 0x90A4FB41, 0x90A5FB41, 0x90A6FB41, 0x90A7FB41, 0x90A8FB41, 0x90A9FB41, 0x90AAFB41, 0x90ABFB41, 0x90ACFB41, 0x90ADFB41, 0x90AEFB41, 0x90AFFB41, 0x90B0FB41, 0x90B1FB41, 0x90B2FB41, 0x90B3FB41, 0x90B4FB41, 0x90B5FB41, 0x90B6FB41, 0x90B7FB41, 0x90B8FB41, 0x90B9FB41, 0x90BAFB41, 0x90BBFB41, 0x90BCFB41, 0x90BDFB41, 0x90BEFB41, 0x90BFFB41,
+		0x90C0FB41, 0x90C1FB41, 0x90C2FB41, 0x90C3FB41, 0x90C4FB41, 0x90C5FB41, 0x90C6FB41, 0x90C7FB41, 0x90C8FB41, 0x90C9FB41, 0x90CAFB41, 0x90CBFB41, 0x90CCFB41, 0x90CDFB41, 0x90CEFB41, 0x90CFFB41, 0x90D0FB41, 0x90D1FB41, 0x90D2FB41, 0x90D3FB41, 0x90D4FB41, 0x90D5FB41, 0x90D6FB41, 0x90D7FB41, 0x90D8FB41, 0x90D9FB41, 0x90DAFB41, 0x90DBFB41, 0x90DCFB41, 0x90DDFB41, 0x90DEFB41, 0x90DFFB41,
+		0x90E0FB41, 0x90E1FB41, 0x90E2FB41, 0x90E3FB41, 0x90E4FB41, 0x90E5FB41, 0x90E6FB41, 0x90E7FB41, 0x90E8FB41, 0x90E9FB41, 0x90EAFB41, 0x90EBFB41, 0x90ECFB41, 0x90EDFB41, 0x90EEFB41, 0x90EFFB41, 0x90F0FB41, 0x90F1FB41, 0x90F2FB41, 0x90F3FB41, 0x90F4FB41, 0x90F5FB41, 0x90F6FB41, 0x90F7FB41, 0x90F8FB41, 0x90F9FB41, 0x90FAFB41, 0x90FBFB41, 0x90FCFB41, 0x90FDFB41, 0x90FEFB41, 0x90FFFB41,
+		0x9100FB41, 0x9101FB41, 0x9102FB41, 0x9103FB41, 0x9104FB41, 0x9105FB41, 0x9106FB41, 0x9107FB41, 0x9108FB41, 0x9109FB41, 0x910AFB41, 0x910BFB41, 0x910CFB41, 0x910DFB41, 0x910EFB41, 0x910FFB41, 0x9110FB41, 0x9111FB41, 0x9112FB41, 0x9113FB41, 0x9114FB41, 0x9115FB41, 0x9116FB41, 0x9117FB41, 0x9118FB41, 0x9119FB41, 0x911AFB41, 0x911BFB41, 0x911CFB41, 0x911DFB41, 0x911EFB41, 0x911FFB41,
+		0x9120FB41, 0x9121FB41, 0x9122FB41, 0x9123FB41, 0x9124FB41, 0x9125FB41, 0x9126FB41, 0x9127FB41, 0x9128FB41, 0x9129FB41, 0x912AFB41, 0x912BFB41, 0x912CFB41, 0x912DFB41, 0x912EFB41, 0x912FFB41, 0x9130FB41, 0x9131FB41, 0x9132FB41, 0x9133FB41, 0x9134FB41, 0x9135FB41, 0x9136FB41, 0x9137FB41, 0x9138FB41, 0x9139FB41, 0x913AFB41, 0x913BFB41, 0x913CFB41, 0x913DFB41, 0x913EFB41, 0x913FFB41,
+		0x9140FB41, 0x9141FB41, 0x9142FB41, 0x9143FB41, 0x9144FB41, 0x9145FB41, 0x9146FB41, 0x9147FB41, 0x9148FB41, 0x9149FB41, 0x914AFB41, 0x914BFB41, 0x914CFB41, 0x914DFB41, 0x914EFB41, 0x914FFB41, 0x9150FB41, 0x9151FB41, 0x9152FB41, 0x9153FB41, 0x9154FB41, 0x9155FB41, 0x9156FB41, 0x9157FB41, 0x9158FB41, 0x9159FB41, 0x915AFB41, 0x915BFB41, 0x915CFB41, 0x915DFB41, 0x915EFB41, 0x915FFB41,
+		0x9160FB41, 0x9161FB41, 0x9162FB41, 0x9163FB41, 0x9164FB41, 0x9165FB41, 0x9166FB41, 0x9167FB41, 0x9168FB41, 0x9169FB41, 0x916AFB41, 0x916BFB41, 0x916CFB41, 0x916DFB41, 0x916EFB41, 0x916FFB41, 0x9170FB41, 0x9171FB41, 0x9172FB41, 0x9173FB41, 0x9174FB41, 0x9175FB41, 0x9176FB41, 0x9177FB41, 0x9178FB41, 0x9179FB41, 0x917AFB41, 0x917BFB41, 0x917CFB41, 0x917DFB41, 0x917EFB41, 0x917FFB41,
+		0x9180FB41, 0x9181FB41, 0x9182FB41, 0x9183FB41, 0x9184FB41, 0x9185FB41, 0x9186FB41, 0x9187FB41, 0x9188FB41, 0x9189FB41, 0x918AFB41, 0x918BFB41, 0x918CFB41, 0x918DFB41, 0x918EFB41, 0x918FFB41, 0x9190FB41, 0x9191FB41, 0x9192FB41, 0x9193FB41, 0x9194FB41, 0x9195FB41, 0x9196FB41, 0x9197FB41, 0x9198FB41, 0x9199FB41, 0x919AFB41, 0x919BFB41, 0x919CFB41, 0x919DFB41, 0x919EFB41, 0x919FFB41,
+		0x91A0FB41, 0x91A1FB41, 0x91A2FB41, 0x91A3FB41, 0x91A4FB41, 0x91A5FB41, 0x91A6FB41, 0x91A7FB41, 0x91A8FB41, 0x91A9FB41, 0x91AAFB41, 0x91ABFB41, 0x91ACFB41, 0x91ADFB41, 0x91AEFB41, 0x91AFFB41, 0x91B0FB41, 0x91B1FB41, 0x91B2FB41, 0x91B3FB41, 0x91B4FB41, 0x91B5FB41, 0x91B6FB41, 0x91B7FB41, 0x91B8FB41, 0x91B9FB41, 0x91BAFB41, 0x91BBFB41, 0x91BCFB41, 0x91BDFB41, 0x91BEFB41, 0x91BFFB41,
+		0x91C0FB41, 0x91C1FB41, 0x91C2FB41, 0x91C3FB41, 0x91C4FB41, 0x91C5FB41, 0x91C6FB41, 0x91C7FB41, 0x91C8FB41, 0x91C9FB41, 0x91CAFB41, 0x91CBFB41, 0x91CCFB41, 0x91CDFB41, 0x91CEFB41, 0x91CFFB41, 0x91D0FB41, 0x91D1FB41, 0x91D2FB41, 0x91D3FB41, 0x91D4FB41, 0x91D5FB41, 0x91D6FB41, 0x91D7FB41, 0x91D8FB41, 0x91D9FB41, 0x91DAFB41, 0x91DBFB41, 0x91DCFB41, 0x91DDFB41, 0x91DEFB41, 0x91DFFB41,
+		0x91E0FB41, 0x91E1FB41, 0x91E2FB41, 0x91E3FB41, 0x91E4FB41, 0x91E5FB41, 0x91E6FB41, 0x91E7FB41, 0x91E8FB41, 0x91E9FB41, 0x91EAFB41, 0x91EBFB41, 0x91ECFB41, 0x91EDFB41, 0x91EEFB41, 0x91EFFB41, 0x91F0FB41, 0x91F1FB41, 0x91F2FB41, 0x91F3FB41, 0x91F4FB41, 0x91F5FB41, 0x91F6FB41, 0x91F7FB41, 0x91F8FB41, 0x91F9FB41, 0x91FAFB41, 0x91FBFB41, 0x91FCFB41, 0x91FDFB41, 0x91FEFB41, 0x91FFFB41,
+		0x9200FB41, 0x9201FB41, 0x9202FB41, 0x9203FB41, 0x9204FB41, 0x9205FB41, 0x9206FB41, 0x9207FB41, 0x9208FB41, 0x9209FB41, 0x920AFB41, 0x920BFB41, 0x920CFB41, 0x920DFB41, 0x920EFB41, 0x920FFB41, 0x9210FB41, 0x9211FB41, 0x9212FB41, 0x9213FB41, 0x9214FB41, 0x9215FB41, 0x9216FB41, 0x9217FB41, 0x9218FB41, 0x9219FB41, 0x921AFB41, 0x921BFB41, 0x921CFB41, 0x921DFB41, 0x921EFB41, 0x921FFB41,
+		0x9220FB41, 0x9221FB41, 0x9222FB41, 0x9223FB41, 0x9224FB41, 0x9225FB41, 0x9226FB41, 0x9227FB41, 0x9228FB41, 0x9229FB41, 0x922AFB41, 0x922BFB41, 0x922CFB41, 0x922DFB41, 0x922EFB41, 0x922FFB41, 0x9230FB41, 0x9231FB41, 0x9232FB41, 0x9233FB41, 0x9234FB41, 0x9235FB41, 0x9236FB41, 0x9237FB41, 0x9238FB41, 0x9239FB41, 0x923AFB41, 0x923BFB41, 0x923CFB41, 0x923DFB41, 0x923EFB41, 0x923FFB41,
+		0x9240FB41, 0x9241FB41, 0x9242FB41, 0x9243FB41, 0x9244FB41, 0x9245FB41, 0x9246FB41, 0x9247FB41, 0x9248FB41, 0x9249FB41, 0x924AFB41, 0x924BFB41, 0x924CFB41, 0x924DFB41, 0x924EFB41, 0x924FFB41, 0x9250FB41, 0x9251FB41, 0x9252FB41, 0x9253FB41, 0x9254FB41, 0x9255FB41, 0x9256FB41, 0x9257FB41, 0x9258FB41, 0x9259FB41, 0x925AFB41, 0x925BFB41, 0x925CFB41, 0x925DFB41, 0x925EFB41, 0x925FFB41,
+		0x9260FB41, 0x9261FB41, 0x9262FB41, 0x9263FB41, 0x9264FB41, 0x9265FB41, 0x9266FB41, 0x9267FB41, 0x9268FB41, 0x9269FB41, 0x926AFB41, 0x926BFB41, 0x926CFB41, 0x926DFB41, 0x926EFB41, 0x926FFB41, 0x9270FB41, 0x9271FB41, 0x9272FB41, 0x9273FB41, 0x9274FB41, 0x9275FB41, 0x9276FB41, 0x9277FB41, 0x9278FB41, 0x9279FB41, 0x927AFB41, 0x927BFB41, 0x927CFB41, 0x927DFB41, 0x927EFB41, 0x927FFB41,
+		0x9280FB41, 0x9281FB41, 0x9282FB41, 0x9283FB41, 0x9284FB41, 0x9285FB41, 0x9286FB41, 0x9287FB41, 0x9288FB41, 0x9289FB41, 0x928AFB41, 0x928BFB41, 0x928CFB41, 0x928DFB41, 0x928EFB41, 0x928FFB41, 0x9290FB41, 0x9291FB41, 0x9292FB41, 0x9293FB41, 0x9294FB41, 0x9295FB41, 0x9296FB41, 0x9297FB41, 0x9298FB41, 0x9299FB41, 0x929AFB41, 0x929BFB41, 0x929CFB41, 0x929DFB41, 0x929EFB41, 0x929FFB41,
+		0x92A0FB41, 0x92A1FB41, 0x92A2FB41, 0x92A3FB41, 0x92A4FB41, 0x92A5FB41, 0x92A6FB41, 0x92A7FB41, 0x92A8FB41, 0x92A9FB41, 0x92AAFB41, 0x92ABFB41, 0x92ACFB41, 0x92ADFB41, 0x92AEFB41, 0x92AFFB41, 0x92B0FB41, 0x92B1FB41, 0x92B2FB41, 0x92B3FB41, 0x92B4FB41, 0x92B5FB41, 0x92B6FB41, 0x92B7FB41, 0x92B8FB41, 0x92B9FB41, 0x92BAFB41, 0x92BBFB41, 0x92BCFB41, 0x92BDFB41, 0x92BEFB41, 0x92BFFB41,
+		0x92C0FB41, 0x92C1FB41, 0x92C2FB41, 0x92C3FB41, 0x92C4FB41, 0x92C5FB41, 0x92C6FB41, 0x92C7FB41, 0x92C8FB41, 0x92C9FB41, 0x92CAFB41, 0x92CBFB41, 0x92CCFB41, 0x92CDFB41, 0x92CEFB41, 0x92CFFB41, 0x92D0FB41, 0x92D1FB41, 0x92D2FB41, 0x92D3FB41, 0x92D4FB41, 0x92D5FB41, 0x92D6FB41, 0x92D7FB41, 0x92D8FB41, 0x92D9FB41, 0x92DAFB41, 0x92DBFB41, 0x92DCFB41, 0x92DDFB41, 0x92DEFB41, 0x92DFFB41,
+		0x92E0FB41, 0x92E1FB41, 0x92E2FB41, 0x92E3FB41, 0x92E4FB41, 0x92E5FB41, 0x92E6FB41, 0x92E7FB41, 0x92E8FB41, 0x92E9FB41, 0x92EAFB41, 0x92EBFB41, 0x92ECFB41, 0x92EDFB41, 0x92EEFB41, 0x92EFFB41, 0x92F0FB41, 0x92F1FB41, 0x92F2FB41, 0x92F3FB41, 0x92F4FB41, 0x92F5FB41, 0x92F6FB41, 0x92F7FB41, 0x92F8FB41, 0x92F9FB41, 0x92FAFB41, 0x92FBFB41, 0x92FCFB41, 0x92FDFB41, 0x92FEFB41, 0x92FFFB41,
+		0x9300FB41, 0x9301FB41, 0x9302FB41, 0x9303FB41, 0x9304FB41, 0x9305FB41, 0x9306FB41, 0x9307FB41, 0x9308FB41, 0x9309FB41, 0x930AFB41, 0x930BFB41, 0x930CFB41, 0x930DFB41, 0x930EFB41, 0x930FFB41, 0x9310FB41, 0x9311FB41, 0x9312FB41, 0x9313FB41, 0x9314FB41, 0x9315FB41, 0x9316FB41, 0x9317FB41, 0x9318FB41, 0x9319FB41, 0x931AFB41, 0x931BFB41, 0x931CFB41, 0x931DFB41, 0x931EFB41, 0x931FFB41,
+		0x9320FB41, 0x9321FB41, 0x9322FB41, 0x9323FB41, 0x9324FB41, 0x9325FB41, 0x9326FB41, 0x9327FB41, 0x9328FB41, 0x9329FB41, 0x932AFB41, 0x932BFB41, 0x932CFB41, 0x932DFB41, 0x932EFB41, 0x932FFB41, 0x9330FB41, 0x9331FB41, 0x9332FB41, 0x9333FB41, 0x9334FB41, 0x9335FB41, 0x9336FB41, 0x9337FB41, 0x9338FB41, 0x9339FB41, 0x933AFB41, 0x933BFB41, 0x933CFB41, 0x933DFB41, 0x933EFB41, 0x933FFB41,
+		0x9340FB41, 0x9341FB41, 0x9342FB41, 0x9343FB41, 0x9344FB41, 0x9345FB41, 0x9346FB41, 0x9347FB41, 0x9348FB41, 0x9349FB41, 0x934AFB41, 0x934BFB41, 0x934CFB41, 0x934DFB41, 0x934EFB41, 0x934FFB41, 0x9350FB41, 0x9351FB41, 0x9352FB41, 0x9353FB41, 0x9354FB41, 0x9355FB41, 0x9356FB41, 0x9357FB41, 0x9358FB41, 0x9359FB41, 0x935AFB41, 0x935BFB41, 0x935CFB41, 0x935DFB41, 0x935EFB41, 0x935FFB41,
+		0x9360FB41, 0x9361FB41, 0x9362FB41, 0x9363FB41, 0x9364FB41, 0x9365FB41, 0x9366FB41, 0x9367FB41, 0x9368FB41, 0x9369FB41, 0x936AFB41, 0x936BFB41, 0x936CFB41, 0x936DFB41, 0x936EFB41, 0x936FFB41, 0x9370FB41, 0x9371FB41, 0x9372FB41, 0x9373FB41, 0x9374FB41, 0x9375FB41, 0x9376FB41, 0x9377FB41, 0x9378FB41, 0x9379FB41, 0x937AFB41, 0x937BFB41, 0x937CFB41, 0x937DFB41, 0x937EFB41, 0x937FFB41,
+		0x9380FB41, 0x9381FB41, 0x9382FB41, 0x9383FB41, 0x9384FB41, 0x9385FB41, 0x9386FB41, 0x9387FB41, 0x9388FB41, 0x9389FB41, 0x938AFB41, 0x938BFB41, 0x938CFB41, 0x938DFB41, 0x938EFB41, 0x938FFB41, 0x9390FB41, 0x9391FB41, 0x9392FB41, 0x9393FB41, 0x9394FB41, 0x9395FB41, 0x9396FB41, 0x9397FB41, 0x9398FB41, 0x9399FB41, 0x939AFB41, 0x939BFB41, 0x939CFB41, 0x939DFB41, 0x939EFB41, 0x939FFB41,
+		0x93A0FB41, 0x93A1FB41, 0x93A2FB41, 0x93A3FB41, 0x93A4FB41, 0x93A5FB41, 0x93A6FB41, 0x93A7FB41, 0x93A8FB41, 0x93A9FB41, 0x93AAFB41, 0x93ABFB41, 0x93ACFB41, 0x93ADFB41, 0x93AEFB41, 0x93AFFB41, 0x93B0FB41, 0x93B1FB41, 0x93B2FB41, 0x93B3FB41, 0x93B4FB41, 0x93B5FB41, 0x93B6FB41, 0x93B7FB41, 0x93B8FB41, 0x93B9FB41, 0x93BAFB41, 0x93BBFB41, 0x93BCFB41, 0x93BDFB41, 0x93BEFB41, 0x93BFFB41,
+		0x93C0FB41, 0x93C1FB41, 0x93C2FB41, 0x93C3FB41, 0x93C4FB41, 0x93C5FB41, 0x93C6FB41, 0x93C7FB41, 0x93C8FB41, 0x93C9FB41, 0x93CAFB41, 0x93CBFB41, 0x93CCFB41, 0x93CDFB41, 0x93CEFB41, 0x93CFFB41, 0x93D0FB41, 0x93D1FB41, 0x93D2FB41, 0x93D3FB41, 0x93D4FB41, 0x93D5FB41, 0x93D6FB41, 0x93D7FB41, 0x93D8FB41, 0x93D9FB41, 0x93DAFB41, 0x93DBFB41, 0x93DCFB41, 0x93DDFB41, 0x93DEFB41, 0x93DFFB41,
+		0x93E0FB41, 0x93E1FB41, 0x93E2FB41, 0x93E3FB41, 0x93E4FB41, 0x93E5FB41, 0x93E6FB41, 0x93E7FB41, 0x93E8FB41, 0x93E9FB41, 0x93EAFB41, 0x93EBFB41, 0x93ECFB41, 0x93EDFB41, 0x93EEFB41, 0x93EFFB41, 0x93F0FB41, 0x93F1FB41, 0x93F2FB41, 0x93F3FB41, 0x93F4FB41, 0x93F5FB41, 0x93F6FB41, 0x93F7FB41, 0x93F8FB41, 0x93F9FB41, 0x93FAFB41, 0x93FBFB41, 0x93FCFB41, 0x93FDFB41, 0x93FEFB41, 0x93FFFB41,
+		0x9400FB41, 0x9401FB41, 0x9402FB41, 0x9403FB41, 0x9404FB41, 0x9405FB41, 0x9406FB41, 0x9407FB41, 0x9408FB41, 0x9409FB41, 0x940AFB41, 0x940BFB41, 0x940CFB41, 0x940DFB41, 0x940EFB41, 0x940FFB41, 0x9410FB41, 0x9411FB41, 0x9412FB41, 0x9413FB41, 0x9414FB41, 0x9415FB41, 0x9416FB41, 0x9417FB41, 0x9418FB41, 0x9419FB41, 0x941AFB41, 0x941BFB41, 0x941CFB41, 0x941DFB41, 0x941EFB41, 0x941FFB41,
+		0x9420FB41, 0x9421FB41, 0x9422FB41, 0x9423FB41, 0x9424FB41, 0x9425FB41, 0x9426FB41, 0x9427FB41, 0x9428FB41, 0x9429FB41, 0x942AFB41, 0x942BFB41, 0x942CFB41, 0x942DFB41, 0x942EFB41, 0x942FFB41, 0x9430FB41, 0x9431FB41, 0x9432FB41, 0x9433FB41, 0x9434FB41, 0x9435FB41, 0x9436FB41, 0x9437FB41, 0x9438FB41, 0x9439FB41, 0x943AFB41, 0x943BFB41, 0x943CFB41, 0x943DFB41, 0x943EFB41, 0x943FFB41,
+		0x9440FB41, 0x9441FB41, 0x9442FB41, 0x9443FB41, 0x9444FB41, 0x9445FB41, 0x9446FB41, 0x9447FB41, 0x9448FB41, 0x9449FB41, 0x944AFB41, 0x944BFB41, 0x944CFB41, 0x944DFB41, 0x944EFB41, 0x944FFB41, 0x9450FB41, 0x9451FB41, 0x9452FB41, 0x9453FB41, 0x9454FB41, 0x9455FB41, 0x9456FB41, 0x9457FB41, 0x9458FB41, 0x9459FB41, 0x945AFB41, 0x945BFB41, 0x945CFB41, 0x945DFB41, 0x945EFB41, 0x945FFB41,
+		0x9460FB41, 0x9461FB41, 0x9462FB41, 0x9463FB41, 0x9464FB41, 0x9465FB41, 0x9466FB41, 0x9467FB41, 0x9468FB41, 0x9469FB41, 0x946AFB41, 0x946BFB41, 0x946CFB41, 0x946DFB41, 0x946EFB41, 0x946FFB41, 0x9470FB41, 0x9471FB41, 0x9472FB41, 0x9473FB41, 0x9474FB41, 0x9475FB41, 0x9476FB41, 0x9477FB41, 0x9478FB41, 0x9479FB41, 0x947AFB41, 0x947BFB41, 0x947CFB41, 0x947DFB41, 0x947EFB41, 0x947FFB41,
+		0x9480FB41, 0x9481FB41, 0x9482FB41, 0x9483FB41, 0x9484FB41, 0x9485FB41, 0x9486FB41, 0x9487FB41, 0x9488FB41, 0x9489FB41, 0x948AFB41, 0x948BFB41, 0x948CFB41, 0x948DFB41, 0x948EFB41, 0x948FFB41, 0x9490FB41, 0x9491FB41, 0x9492FB41, 0x9493FB41, 0x9494FB41, 0x9495FB41, 0x9496FB41, 0x9497FB41, 0x9498FB41, 0x9499FB41, 0x949AFB41, 0x949BFB41, 0x949CFB41, 0x949DFB41, 0x949EFB41, 0x949FFB41,
+		0x94A0FB41, 0x94A1FB41, 0x94A2FB41, 0x94A3FB41, 0x94A4FB41, 0x94A5FB41, 0x94A6FB41, 0x94A7FB41, 0x94A8FB41, 0x94A9FB41, 0x94AAFB41, 0x94ABFB41, 0x94ACFB41, 0x94ADFB41, 0x94AEFB41, 0x94AFFB41, 0x94B0FB41, 0x94B1FB41, 0x94B2FB41, 0x94B3FB41, 0x94B4FB41, 0x94B5FB41, 0x94B6FB41, 0x94B7FB41, 0x94B8FB41, 0x94B9FB41, 0x94BAFB41, 0x94BBFB41, 0x94BCFB41, 0x94BDFB41, 0x94BEFB41, 0x94BFFB41,
+		0x94C0FB41, 0x94C1FB41, 0x94C2FB41, 0x94C3FB41, 0x94C4FB41, 0x94C5FB41, 0x94C6FB41, 0x94C7FB41, 0x94C8FB41, 0x94C9FB41, 0x94CAFB41, 0x94CBFB41, 0x94CCFB41, 0x94CDFB41, 0x94CEFB41, 0x94CFFB41, 0x94D0FB41, 0x94D1FB41, 0x94D2FB41, 0x94D3FB41, 0x94D4FB41, 0x94D5FB41, 0x94D6FB41, 0x94D7FB41, 0x94D8FB41, 0x94D9FB41, 0x94DAFB41, 0x94DBFB41, 0x94DCFB41, 0x94DDFB41, 0x94DEFB41, 0x94DFFB41,
+		0x94E0FB41, 0x94E1FB41, 0x94E2FB41, 0x94E3FB41, 0x94E4FB41, 0x94E5FB41, 0x94E6FB41, 0x94E7FB41, 0x94E8FB41, 0x94E9FB41, 0x94EAFB41, 0x94EBFB41, 0x94ECFB41, 0x94EDFB41, 0x94EEFB41, 0x94EFFB41, 0x94F0FB41, 0x94F1FB41, 0x94F2FB41, 0x94F3FB41, 0x94F4FB41, 0x94F5FB41, 0x94F6FB41, 0x94F7FB41, 0x94F8FB41, 0x94F9FB41, 0x94FAFB41, 0x94FBFB41, 0x94FCFB41, 0x94FDFB41, 0x94FEFB41, 0x94FFFB41,
+		0x9500FB41, 0x9501FB41, 0x9502FB41, 0x9503FB41, 0x9504FB41, 0x9505FB41, 0x9506FB41, 0x9507FB41, 0x9508FB41, 0x9509FB41, 0x950AFB41, 0x950BFB41, 0x950CFB41, 0x950DFB41, 0x950EFB41, 0x950FFB41, 0x9510FB41, 0x9511FB41, 0x9512FB41, 0x9513FB41, 0x9514FB41, 0x9515FB41, 0x9516FB41, 0x9517FB41, 0x9518FB41, 0x9519FB41, 0x951AFB41, 0x951BFB41, 0x951CFB41, 0x951DFB41, 0x951EFB41, 0x951FFB41,
+		0x9520FB41, 0x9521FB41, 0x9522FB41, 0x9523FB41, 0x9524FB41, 0x9525FB41, 0x9526FB41, 0x9527FB41, 0x9528FB41, 0x9529FB41, 0x952AFB41, 0x952BFB41, 0x952CFB41, 0x952DFB41, 0x952EFB41, 0x952FFB41, 0x9530FB41, 0x9531FB41, 0x9532FB41, 0x9533FB41, 0x9534FB41, 0x9535FB41, 0x9536FB41, 0x9537FB41, 0x9538FB41, 0x9539FB41, 0x953AFB41, 0x953BFB41, 0x953CFB41, 0x953DFB41, 0x953EFB41, 0x953FFB41,
+		0x9540FB41, 0x9541FB41, 0x9542FB41, 0x9543FB41, 0x9544FB41, 0x9545FB41, 0x9546FB41, 0x9547FB41, 0x9548FB41, 0x9549FB41, 0x954AFB41, 0x954BFB41, 0x954CFB41, 0x954DFB41, 0x954EFB41, 0x954FFB41, 0x9550FB41, 0x9551FB41, 0x9552FB41, 0x9553FB41, 0x9554FB41, 0x9555FB41, 0x9556FB41, 0x9557FB41, 0x9558FB41, 0x9559FB41, 0x955AFB41, 0x955BFB41, 0x955CFB41, 0x955DFB41, 0x955EFB41, 0x955FFB41,
+		0x9560FB41, 0x9561FB41, 0x9562FB41, 0x9563FB41, 0x9564FB41, 0x9565FB41, 0x9566FB41, 0x9567FB41, 0x9568FB41, 0x9569FB41, 0x956AFB41, 0x956BFB41, 0x956CFB41, 0x956DFB41, 0x956EFB41, 0x956FFB41, 0x9570FB41, 0x9571FB41, 0x9572FB41, 0x9573FB41, 0x9574FB41, 0x9575FB41, 0x9576FB41, 0x9577FB41, 0x9578FB41, 0x9579FB41, 0x957AFB41, 0x957BFB41, 0x957CFB41, 0x957DFB41, 0x957EFB41, 0x957FFB41,
+		0x9580FB41, 0x9581FB41, 0x9582FB41, 0x9583FB41, 0x9584FB41, 0x9585FB41, 0x9586FB41, 0x9587FB41, 0x9588FB41, 0x9589FB41, 0x958AFB41, 0x958BFB41, 0x958CFB41, 0x958DFB41, 0x958EFB41, 0x958FFB41, 0x9590FB41, 0x9591FB41, 0x9592FB41, 0x9593FB41, 0x9594FB41, 0x9595FB41, 0x9596FB41, 0x9597FB41, 0x9598FB41, 0x9599FB41, 0x959AFB41, 0x959BFB41, 0x959CFB41, 0x959DFB41, 0x959EFB41, 0x959FFB41,
+		0x95A0FB41, 0x95A1FB41, 0x95A2FB41, 0x95A3FB41, 0x95A4FB41, 0x95A5FB41, 0x95A6FB41, 0x95A7FB41, 0x95A8FB41, 0x95A9FB41, 0x95AAFB41, 0x95ABFB41, 0x95ACFB41, 0x95ADFB41, 0x95AEFB41, 0x95AFFB41, 0x95B0FB41, 0x95B1FB41, 0x95B2FB41, 0x95B3FB41, 0x95B4FB41, 0x95B5FB41, 0x95B6FB41, 0x95B7FB41, 0x95B8FB41, 0x95B9FB41, 0x95BAFB41, 0x95BBFB41, 0x95BCFB41, 0x95BDFB41, 0x95BEFB41, 0x95BFFB41,
+		0x95C0FB41, 0x95C1FB41, 0x95C2FB41, 0x95C3FB41, 0x95C4FB41, 0x95C5FB41, 0x95C6FB41, 0x95C7FB41, 0x95C8FB41, 0x95C9FB41, 0x95CAFB41, 0x95CBFB41, 0x95CCFB41, 0x95CDFB41, 0x95CEFB41, 0x95CFFB41, 0x95D0FB41, 0x95D1FB41, 0x95D2FB41, 0x95D3FB41, 0x95D4FB41, 0x95D5FB41, 0x95D6FB41, 0x95D7FB41, 0x95D8FB41, 0x95D9FB41, 0x95DAFB41, 0x95DBFB41, 0x95DCFB41, 0x95DDFB41, 0x95DEFB41, 0x95DFFB41,
+		0x95E0FB41, 0x95E1FB41, 0x95E2FB41, 0x95E3FB41, 0x95E4FB41, 0x95E5FB41, 0x95E6FB41, 0x95E7FB41, 0x95E8FB41, 0x95E9FB41, 0x95EAFB41, 0x95EBFB41, 0x95ECFB41, 0x95EDFB41, 0x95EEFB41, 0x95EFFB41, 0x95F0FB41, 0x95F1FB41, 0x95F2FB41, 0x95F3FB41, 0x95F4FB41, 0x95F5FB41, 0x95F6FB41, 0x95F7FB41, 0x95F8FB41, 0x95F9FB41, 0x95FAFB41, 0x95FBFB41, 0x95FCFB41, 0x95FDFB41, 0x95FEFB41, 0x95FFFB41,
+		0x9600FB41, 0x9601FB41, 0x9602FB41, 0x9603FB41, 0x9604FB41, 0x9605FB41, 0x9606FB41, 0x9607FB41, 0x9608FB41, 0x9609FB41, 0x960AFB41, 0x960BFB41, 0x960CFB41, 0x960DFB41, 0x960EFB41, 0x960FFB41, 0x9610FB41, 0x9611FB41, 0x9612FB41, 0x9613FB41, 0x9614FB41, 0x9615FB41, 0x9616FB41, 0x9617FB41, 0x9618FB41, 0x9619FB41, 0x961AFB41, 0x961BFB41, 0x961CFB41, 0x961DFB41, 0x961EFB41, 0x961FFB41,
+		0x9620FB41, 0x9621FB41, 0x9622FB41, 0x9623FB41, 0x9624FB41, 0x9625FB41, 0x9626FB41, 0x9627FB41, 0x9628FB41, 0x9629FB41, 0x962AFB41, 0x962BFB41, 0x962CFB41, 0x962DFB41, 0x962EFB41, 0x962FFB41, 0x9630FB41, 0x9631FB41, 0x9632FB41, 0x9633FB41, 0x9634FB41, 0x9635FB41, 0x9636FB41, 0x9637FB41, 0x9638FB41, 0x9639FB41, 0x963AFB41, 0x963BFB41, 0x963CFB41, 0x963DFB41, 0x963EFB41, 0x963FFB41,
+		0x9640FB41, 0x9641FB41, 0x9642FB41, 0x9643FB41, 0x9644FB41, 0x9645FB41, 0x9646FB41, 0x9647FB41, 0x9648FB41, 0x9649FB41, 0x964AFB41, 0x964BFB41, 0x964CFB41, 0x964DFB41, 0x964EFB41, 0x964FFB41, 0x9650FB41, 0x9651FB41, 0x9652FB41, 0x9653FB41, 0x9654FB41, 0x9655FB41, 0x9656FB41, 0x9657FB41, 0x9658FB41, 0x9659FB41, 0x965AFB41, 0x965BFB41, 0x965CFB41, 0x965DFB41, 0x965EFB41, 0x965FFB41,
+		0x9660FB41, 0x9661FB41, 0x9662FB41, 0x9663FB41, 0x9664FB41, 0x9665FB41, 0x9666FB41, 0x9667FB41, 0x9668FB41, 0x9669FB41, 0x966AFB41, 0x966BFB41, 0x966CFB41, 0x966DFB41, 0x966EFB41, 0x966FFB41, 0x9670FB41, 0x9671FB41, 0x9672FB41, 0x9673FB41, 0x9674FB41, 0x9675FB41, 0x9676FB41, 0x9677FB41, 0x9678FB41, 0x9679FB41, 0x967AFB41, 0x967BFB41, 0x967CFB41, 0x967DFB41, 0x967EFB41, 0x967FFB41,
+		0x9680FB41, 0x9681FB41, 0x9682FB41, 0x9683FB41, 0x9684FB41, 0x9685FB41, 0x9686FB41, 0x9687FB41, 0x9688FB41, 0x9689FB41, 0x968AFB41, 0x968BFB41, 0x968CFB41, 0x968DFB41, 0x968EFB41, 0x968FFB41, 0x9690FB41, 0x9691FB41, 0x9692FB41, 0x9693FB41, 0x9694FB41, 0x9695FB41, 0x9696FB41, 0x9697FB41, 0x9698FB41, 0x9699FB41, 0x969AFB41, 0x969BFB41, 0x969CFB41, 0x969DFB41, 0x969EFB41, 0x969FFB41,
+		0x96A0FB41, 0x96A1FB41, 0x96A2FB41, 0x96A3FB41, 0x96A4FB41, 0x96A5FB41, 0x96A6FB41, 0x96A7FB41, 0x96A8FB41, 0x96A9FB41, 0x96AAFB41, 0x96ABFB41, 0x96ACFB41, 0x96ADFB41, 0x96AEFB41, 0x96AFFB41, 0x96B0FB41, 0x96B1FB41, 0x96B2FB41, 0x96B3FB41, 0x96B4FB41, 0x96B5FB41, 0x96B6FB41, 0x96B7FB41, 0x96B8FB41, 0x96B9FB41, 0x96BAFB41, 0x96BBFB41, 0x96BCFB41, 0x96BDFB41, 0x96BEFB41, 0x96BFFB41,
+		0x96C0FB41, 0x96C1FB41, 0x96C2FB41, 0x96C3FB41, 0x96C4FB41, 0x96C5FB41, 0x96C6FB41, 0x96C7FB41, 0x96C8FB41, 0x96C9FB41, 0x96CAFB41, 0x96CBFB41, 0x96CCFB41, 0x96CDFB41, 0x96CEFB41, 0x96CFFB41, 0x96D0FB41, 0x96D1FB41, 0x96D2FB41, 0x96D3FB41, 0x96D4FB41, 0x96D5FB41, 0x96D6FB41, 0x96D7FB41, 0x96D8FB41, 0x96D9FB41, 0x96DAFB41, 0x96DBFB41, 0x96DCFB41, 0x96DDFB41, 0x96DEFB41, 0x96DFFB41,
+		0x96E0FB41, 0x96E1FB41, 0x96E2FB41, 0x96E3FB41, 0x96E4FB41, 0x96E5FB41, 0x96E6FB41, 0x96E7FB41, 0x96E8FB41, 0x96E9FB41, 0x96EAFB41, 0x96EBFB41, 0x96ECFB41, 0x96EDFB41, 0x96EEFB41, 0x96EFFB41, 0x96F0FB41, 0x96F1FB41, 0x96F2FB41, 0x96F3FB41, 0x96F4FB41, 0x96F5FB41, 0x96F6FB41, 0x96F7FB41, 0x96F8FB41, 0x96F9FB41, 0x96FAFB41, 0x96FBFB41, 0x96FCFB41, 0x96FDFB41, 0x96FEFB41, 0x96FFFB41,
+		0x9700FB41, 0x9701FB41, 0x9702FB41, 0x9703FB41, 0x9704FB41, 0x9705FB41, 0x9706FB41, 0x9707FB41, 0x9708FB41, 0x9709FB41, 0x970AFB41, 0x970BFB41, 0x970CFB41, 0x970DFB41, 0x970EFB41, 0x970FFB41, 0x9710FB41, 0x9711FB41, 0x9712FB41, 0x9713FB41, 0x9714FB41, 0x9715FB41, 0x9716FB41, 0x9717FB41, 0x9718FB41, 0x9719FB41, 0x971AFB41, 0x971BFB41, 0x971CFB41, 0x971DFB41, 0x971EFB41, 0x971FFB41,
+		0x9720FB41, 0x9721FB41, 0x9722FB41, 0x9723FB41, 0x9724FB41, 0x9725FB41, 0x9726FB41, 0x9727FB41, 0x9728FB41, 0x9729FB41, 0x972AFB41, 0x972BFB41, 0x972CFB41, 0x972DFB41, 0x972EFB41, 0x972FFB41, 0x9730FB41, 0x9731FB41, 0x9732FB41, 0x9733FB41, 0x9734FB41, 0x9735FB41, 0x9736FB41, 0x9737FB41, 0x9738FB41, 0x9739FB41, 0x973AFB41, 0x973BFB41, 0x973CFB41, 0x973DFB41, 0x973EFB41, 0x973FFB41,
+		0x9740FB41, 0x9741FB41, 0x9742FB41, 0x9743FB41, 0x9744FB41, 0x9745FB41, 0x9746FB41, 0x9747FB41, 0x9748FB41, 0x9749FB41, 0x974AFB41, 0x974BFB41, 0x974CFB41, 0x974DFB41, 0x974EFB41, 0x974FFB41, 0x9750FB41, 0x9751FB41, 0x9752FB41, 0x9753FB41, 0x9754FB41, 0x9755FB41, 0x9756FB41, 0x9757FB41, 0x9758FB41, 0x9759FB41, 0x975AFB41, 0x975BFB41, 0x975CFB41, 0x975DFB41, 0x975EFB41, 0x975FFB41,
+		0x9760FB41, 0x9761FB41, 0x9762FB41, 0x9763FB41, 0x9764FB41, 0x9765FB41, 0x9766FB41, 0x9767FB41, 0x9768FB41, 0x9769FB41, 0x976AFB41, 0x976BFB41, 0x976CFB41, 0x976DFB41, 0x976EFB41, 0x976FFB41, 0x9770FB41, 0x9771FB41, 0x9772FB41, 0x9773FB41, 0x9774FB41, 0x9775FB41, 0x9776FB41, 0x9777FB41, 0x9778FB41, 0x9779FB41, 0x977AFB41, 0x977BFB41, 0x977CFB41, 0x977DFB41, 0x977EFB41, 0x977FFB41,
+		0x9780FB41, 0x9781FB41, 0x9782FB41, 0x9783FB41, 0x9784FB41, 0x9785FB41, 0x9786FB41, 0x9787FB41, 0x9788FB41, 0x9789FB41, 0x978AFB41, 0x978BFB41, 0x978CFB41, 0x978DFB41, 0x978EFB41, 0x978FFB41, 0x9790FB41, 0x9791FB41, 0x9792FB41, 0x9793FB41, 0x9794FB41, 0x9795FB41, 0x9796FB41, 0x9797FB41, 0x9798FB41, 0x9799FB41, 0x979AFB41, 0x979BFB41, 0x979CFB41, 0x979DFB41, 0x979EFB41, 0x979FFB41,
+		0x97A0FB41, 0x97A1FB41, 0x97A2FB41, 0x97A3FB41, 0x97A4FB41, 0x97A5FB41, 0x97A6FB41, 0x97A7FB41, 0x97A8FB41, 0x97A9FB41, 0x97AAFB41, 0x97ABFB41, 0x97ACFB41, 0x97ADFB41, 0x97AEFB41, 0x97AFFB41, 0x97B0FB41, 0x97B1FB41, 0x97B2FB41, 0x97B3FB41, 0x97B4FB41, 0x97B5FB41, 0x97B6FB41, 0x97B7FB41, 0x97B8FB41, 0x97B9FB41, 0x97BAFB41, 0x97BBFB41, 0x97BCFB41, 0x97BDFB41, 0x97BEFB41, 0x97BFFB41,
+		0x97C0FB41, 0x97C1FB41, 0x97C2FB41, 0x97C3FB41, 0x97C4FB41, 0x97C5FB41, 0x97C6FB41, 0x97C7FB41, 0x97C8FB41, 0x97C9FB41, 0x97CAFB41, 0x97CBFB41, 0x97CCFB41, 0x97CDFB41, 0x97CEFB41, 0x97CFFB41, 0x97D0FB41, 0x97D1FB41, 0x97D2FB41, 0x97D3FB41, 0x97D4FB41, 0x97D5FB41, 0x97D6FB41, 0x97D7FB41, 0x97D8FB41, 0x97D9FB41, 0x97DAFB41, 0x97DBFB41, 0x97DCFB41, 0x97DDFB41, 0x97DEFB41, 0x97DFFB41,
+		0x97E0FB41, 0x97E1FB41, 0x97E2FB41, 0x97E3FB41, 0x97E4FB41, 0x97E5FB41, 0x97E6FB41, 0x97E7FB41, 0x97E8FB41, 0x97E9FB41, 0x97EAFB41, 0x97EBFB41, 0x97ECFB41, 0x97EDFB41, 0x97EEFB41, 0x97EFFB41, 0x97F0FB41, 0x97F1FB41, 0x97F2FB41, 0x97F3FB41, 0x97F4FB41, 0x97F5FB41, 0x97F6FB41, 0x97F7FB41, 0x97F8FB41, 0x97F9FB41, 0x97FAFB41, 0x97FBFB41, 0x97FCFB41, 0x97FDFB41, 0x97FEFB41, 0x97FFFB41,
+		0x9800FB41, 0x9801FB41, 0x9802FB41, 0x9803FB41, 0x9804FB41, 0x9805FB41, 0x9806FB41, 0x9807FB41, 0x9808FB41, 0x9809FB41, 0x980AFB41, 0x980BFB41, 0x980CFB41, 0x980DFB41, 0x980EFB41, 0x980FFB41, 0x9810FB41, 0x9811FB41, 0x9812FB41, 0x9813FB41, 0x9814FB41, 0x9815FB41, 0x9816FB41, 0x9817FB41, 0x9818FB41, 0x9819FB41, 0x981AFB41, 0x981BFB41, 0x981CFB41, 0x981DFB41, 0x981EFB41, 0x981FFB41,
+		0x9820FB41, 0x9821FB41, 0x9822FB41, 0x9823FB41, 0x9824FB41, 0x9825FB41, 0x9826FB41, 0x9827FB41, 0x9828FB41, 0x9829FB41, 0x982AFB41, 0x982BFB41, 0x982CFB41, 0x982DFB41, 0x982EFB41, 0x982FFB41, 0x9830FB41, 0x9831FB41, 0x9832FB41, 0x9833FB41, 0x9834FB41, 0x9835FB41, 0x9836FB41, 0x9837FB41, 0x9838FB41, 0x9839FB41, 0x983AFB41, 0x983BFB41, 0x983CFB41, 0x983DFB41, 0x983EFB41, 0x983FFB41,
+		0x9840FB41, 0x9841FB41, 0x9842FB41, 0x9843FB41, 0x9844FB41, 0x9845FB41, 0x9846FB41, 0x9847FB41, 0x9848FB41, 0x9849FB41, 0x984AFB41, 0x984BFB41, 0x984CFB41, 0x984DFB41, 0x984EFB41, 0x984FFB41, 0x9850FB41, 0x9851FB41, 0x9852FB41, 0x9853FB41, 0x9854FB41, 0x9855FB41, 0x9856FB41, 0x9857FB41, 0x9858FB41, 0x9859FB41, 0x985AFB41, 0x985BFB41, 0x985CFB41, 0x985DFB41, 0x985EFB41, 0x985FFB41,
+		0x9860FB41, 0x9861FB41, 0x9862FB41, 0x9863FB41, 0x9864FB41, 0x9865FB41, 0x9866FB41, 0x9867FB41, 0x9868FB41, 0x9869FB41, 0x986AFB41, 0x986BFB41, 0x986CFB41, 0x986DFB41, 0x986EFB41, 0x986FFB41, 0x9870FB41, 0x9871FB41, 0x9872FB41, 0x9873FB41, 0x9874FB41, 0x9875FB41, 0x9876FB41, 0x9877FB41, 0x9878FB41, 0x9879FB41, 0x987AFB41, 0x987BFB41, 0x987CFB41, 0x987DFB41, 0x987EFB41, 0x987FFB41,
+		0x9880FB41, 0x9881FB41, 0x9882FB41, 0x9883FB41, 0x9884FB41, 0x9885FB41, 0x9886FB41, 0x9887FB41, 0x9888FB41, 0x9889FB41, 0x988AFB41, 0x988BFB41, 0x988CFB41, 0x988DFB41, 0x988EFB41, 0x988FFB41, 0x9890FB41, 0x9891FB41, 0x9892FB41, 0x9893FB41, 0x9894FB41, 0x9895FB41, 0x9896FB41, 0x9897FB41, 0x9898FB41, 0x9899FB41, 0x989AFB41, 0x989BFB41, 0x989CFB41, 0x989DFB41, 0x989EFB41, 0x989FFB41,
+		0x98A0FB41, 0x98A1FB41, 0x98A2FB41, 0x98A3FB41, 0x98A4FB41, 0x98A5FB41, 0x98A6FB41, 0x98A7FB41, 0x98A8FB41, 0x98A9FB41, 0x98AAFB41, 0x98ABFB41, 0x98ACFB41, 0x98ADFB41, 0x98AEFB41, 0x98AFFB41, 0x98B0FB41, 0x98B1FB41, 0x98B2FB41, 0x98B3FB41, 0x98B4FB41, 0x98B5FB41, 0x98B6FB41, 0x98B7FB41, 0x98B8FB41, 0x98B9FB41, 0x98BAFB41, 0x98BBFB41, 0x98BCFB41, 0x98BDFB41, 0x98BEFB41, 0x98BFFB41,
+		0x98C0FB41, 0x98C1FB41, 0x98C2FB41, 0x98C3FB41, 0x98C4FB41, 0x98C5FB41, 0x98C6FB41, 0x98C7FB41, 0x98C8FB41, 0x98C9FB41, 0x98CAFB41, 0x98CBFB41, 0x98CCFB41, 0x98CDFB41, 0x98CEFB41, 0x98CFFB41, 0x98D0FB41, 0x98D1FB41, 0x98D2FB41, 0x98D3FB41, 0x98D4FB41, 0x98D5FB41, 0x98D6FB41, 0x98D7FB41, 0x98D8FB41, 0x98D9FB41, 0x98DAFB41, 0x98DBFB41, 0x98DCFB41, 0x98DDFB41, 0x98DEFB41, 0x98DFFB41,
+		0x98E0FB41, 0x98E1FB41, 0x98E2FB41, 0x98E3FB41, 0x98E4FB41, 0x98E5FB41, 0x98E6FB41, 0x98E7FB41, 0x98E8FB41, 0x98E9FB41, 0x98EAFB41, 0x98EBFB41, 0x98ECFB41, 0x98EDFB41, 0x98EEFB41, 0x98EFFB41, 0x98F0FB41, 0x98F1FB41, 0x98F2FB41, 0x98F3FB41, 0x98F4FB41, 0x98F5FB41, 0x98F6FB41, 0x98F7FB41, 0x98F8FB41, 0x98F9FB41, 0x98FAFB41, 0x98FBFB41, 0x98FCFB41, 0x98FDFB41, 0x98FEFB41, 0x98FFFB41,
+		0x9900FB41, 0x9901FB41, 0x9902FB41, 0x9903FB41, 0x9904FB41, 0x9905FB41, 0x9906FB41, 0x9907FB41, 0x9908FB41, 0x9909FB41, 0x990AFB41, 0x990BFB41, 0x990CFB41, 0x990DFB41, 0x990EFB41, 0x990FFB41, 0x9910FB41, 0x9911FB41, 0x9912FB41, 0x9913FB41, 0x9914FB41, 0x9915FB41, 0x9916FB41, 0x9917FB41, 0x9918FB41, 0x9919FB41, 0x991AFB41, 0x991BFB41, 0x991CFB41, 0x991DFB41, 0x991EFB41, 0x991FFB41,
+		0x9920FB41, 0x9921FB41, 0x9922FB41, 0x9923FB41, 0x9924FB41, 0x9925FB41, 0x9926FB41, 0x9927FB41, 0x9928FB41, 0x9929FB41, 0x992AFB41, 0x992BFB41, 0x992CFB41, 0x992DFB41, 0x992EFB41, 0x992FFB41, 0x9930FB41, 0x9931FB41, 0x9932FB41, 0x9933FB41, 0x9934FB41, 0x9935FB41, 0x9936FB41, 0x9937FB41, 0x9938FB41, 0x9939FB41, 0x993AFB41, 0x993BFB41, 0x993CFB41, 0x993DFB41, 0x993EFB41, 0x993FFB41,
+		0x9940FB41, 0x9941FB41, 0x9942FB41, 0x9943FB41, 0x9944FB41, 0x9945FB41, 0x9946FB41, 0x9947FB41, 0x9948FB41, 0x9949FB41, 0x994AFB41, 0x994BFB41, 0x994CFB41, 0x994DFB41, 0x994EFB41, 0x994FFB41, 0x9950FB41, 0x9951FB41, 0x9952FB41, 0x9953FB41, 0x9954FB41, 0x9955FB41, 0x9956FB41, 0x9957FB41, 0x9958FB41, 0x9959FB41, 0x995AFB41, 0x995BFB41, 0x995CFB41, 0x995DFB41, 0x995EFB41, 0x995FFB41,
+		0x9960FB41, 0x9961FB41, 0x9962FB41, 0x9963FB41, 0x9964FB41, 0x9965FB41, 0x9966FB41, 0x9967FB41, 0x9968FB41, 0x9969FB41, 0x996AFB41, 0x996BFB41, 0x996CFB41, 0x996DFB41, 0x996EFB41, 0x996FFB41, 0x9970FB41, 0x9971FB41, 0x9972FB41, 0x9973FB41, 0x9974FB41, 0x9975FB41, 0x9976FB41, 0x9977FB41, 0x9978FB41, 0x9979FB41, 0x997AFB41, 0x997BFB41, 0x997CFB41, 0x997DFB41, 0x997EFB41, 0x997FFB41,
+		0x9980FB41, 0x9981FB41, 0x9982FB41, 0x9983FB41, 0x9984FB41, 0x9985FB41, 0x9986FB41, 0x9987FB41, 0x9988FB41, 0x9989FB41, 0x998AFB41, 0x998BFB41, 0x998CFB41, 0x998DFB41, 0x998EFB41, 0x998FFB41, 0x9990FB41, 0x9991FB41, 0x9992FB41, 0x9993FB41, 0x9994FB41, 0x9995FB41, 0x9996FB41, 0x9997FB41, 0x9998FB41, 0x9999FB41, 0x999AFB41, 0x999BFB41, 0x999CFB41, 0x999DFB41, 0x999EFB41, 0x999FFB41,
+		0x99A0FB41, 0x99A1FB41, 0x99A2FB41, 0x99A3FB41, 0x99A4FB41, 0x99A5FB41, 0x99A6FB41, 0x99A7FB41, 0x99A8FB41, 0x99A9FB41, 0x99AAFB41, 0x99ABFB41, 0x99ACFB41, 0x99ADFB41, 0x99AEFB41, 0x99AFFB41, 0x99B0FB41, 0x99B1FB41, 0x99B2FB41, 0x99B3FB41, 0x99B4FB41, 0x99B5FB41, 0x99B6FB41, 0x99B7FB41, 0x99B8FB41, 0x99B9FB41, 0x99BAFB41, 0x99BBFB41, 0x99BCFB41, 0x99BDFB41, 0x99BEFB41, 0x99BFFB41,
+		0x99C0FB41, 0x99C1FB41, 0x99C2FB41, 0x99C3FB41, 0x99C4FB41, 0x99C5FB41, 0x99C6FB41, 0x99C7FB41, 0x99C8FB41, 0x99C9FB41, 0x99CAFB41, 0x99CBFB41, 0x99CCFB41, 0x99CDFB41, 0x99CEFB41, 0x99CFFB41, 0x99D0FB41, 0x99D1FB41, 0x99D2FB41, 0x99D3FB41, 0x99D4FB41, 0x99D5FB41, 0x99D6FB41, 0x99D7FB41, 0x99D8FB41, 0x99D9FB41, 0x99DAFB41, 0x99DBFB41, 0x99DCFB41, 0x99DDFB41, 0x99DEFB41, 0x99DFFB41,
+		0x99E0FB41, 0x99E1FB41, 0x99E2FB41, 0x99E3FB41, 0x99E4FB41, 0x99E5FB41, 0x99E6FB41, 0x99E7FB41, 0x99E8FB41, 0x99E9FB41, 0x99EAFB41, 0x99EBFB41, 0x99ECFB41, 0x99EDFB41, 0x99EEFB41, 0x99EFFB41, 0x99F0FB41, 0x99F1FB41, 0x99F2FB41, 0x99F3FB41, 0x99F4FB41, 0x99F5FB41, 0x99F6FB41, 0x99F7FB41, 0x99F8FB41, 0x99F9FB41, 0x99FAFB41, 0x99FBFB41, 0x99FCFB41, 0x99FDFB41, 0x99FEFB41, 0x99FFFB41,
+		0x9A00FB41, 0x9A01FB41, 0x9A02FB41, 0x9A03FB41, 0x9A04FB41, 0x9A05FB41, 0x9A06FB41, 0x9A07FB41, 0x9A08FB41, 0x9A09FB41, 0x9A0AFB41, 0x9A0BFB41, 0x9A0CFB41, 0x9A0DFB41, 0x9A0EFB41, 0x9A0FFB41, 0x9A10FB41, 0x9A11FB41, 0x9A12FB41, 0x9A13FB41, 0x9A14FB41, 0x9A15FB41, 0x9A16FB41, 0x9A17FB41, 0x9A18FB41, 0x9A19FB41, 0x9A1AFB41, 0x9A1BFB41, 0x9A1CFB41, 0x9A1DFB41, 0x9A1EFB41, 0x9A1FFB41,
+		0x9A20FB41, 0x9A21FB41, 0x9A22FB41, 0x9A23FB41, 0x9A24FB41, 0x9A25FB41, 0x9A26FB41, 0x9A27FB41, 0x9A28FB41, 0x9A29FB41, 0x9A2AFB41, 0x9A2BFB41, 0x9A2CFB41, 0x9A2DFB41, 0x9A2EFB41, 0x9A2FFB41, 0x9A30FB41, 0x9A31FB41, 0x9A32FB41, 0x9A33FB41, 0x9A34FB41, 0x9A35FB41, 0x9A36FB41, 0x9A37FB41, 0x9A38FB41, 0x9A39FB41, 0x9A3AFB41, 0x9A3BFB41, 0x9A3CFB41, 0x9A3DFB41, 0x9A3EFB41, 0x9A3FFB41,
+		0x9A40FB41, 0x9A41FB41, 0x9A42FB41, 0x9A43FB41, 0x9A44FB41, 0x9A45FB41, 0x9A46FB41, 0x9A47FB41, 0x9A48FB41, 0x9A49FB41, 0x9A4AFB41, 0x9A4BFB41, 0x9A4CFB41, 0x9A4DFB41, 0x9A4EFB41, 0x9A4FFB41, 0x9A50FB41, 0x9A51FB41, 0x9A52FB41, 0x9A53FB41, 0x9A54FB41, 0x9A55FB41, 0x9A56FB41, 0x9A57FB41, 0x9A58FB41, 0x9A59FB41, 0x9A5AFB41, 0x9A5BFB41, 0x9A5CFB41, 0x9A5DFB41, 0x9A5EFB41, 0x9A5FFB41,
+		0x9A60FB41, 0x9A61FB41, 0x9A62FB41, 0x9A63FB41, 0x9A64FB41, 0x9A65FB41, 0x9A66FB41, 0x9A67FB41, 0x9A68FB41, 0x9A69FB41, 0x9A6AFB41, 0x9A6BFB41, 0x9A6CFB41, 0x9A6DFB41, 0x9A6EFB41, 0x9A6FFB41, 0x9A70FB41, 0x9A71FB41, 0x9A72FB41, 0x9A73FB41, 0x9A74FB41, 0x9A75FB41, 0x9A76FB41, 0x9A77FB41, 0x9A78FB41, 0x9A79FB41, 0x9A7AFB41, 0x9A7BFB41, 0x9A7CFB41, 0x9A7DFB41, 0x9A7EFB41, 0x9A7FFB41,
+		0x9A80FB41, 0x9A81FB41, 0x9A82FB41, 0x9A83FB41, 0x9A84FB41, 0x9A85FB41, 0x9A86FB41, 0x9A87FB41, 0x9A88FB41, 0x9A89FB41, 0x9A8AFB41, 0x9A8BFB41, 0x9A8CFB41, 0x9A8DFB41, 0x9A8EFB41, 0x9A8FFB41, 0x9A90FB41, 0x9A91FB41, 0x9A92FB41, 0x9A93FB41, 0x9A94FB41, 0x9A95FB41, 0x9A96FB41, 0x9A97FB41, 0x9A98FB41, 0x9A99FB41, 0x9A9AFB41, 0x9A9BFB41, 0x9A9CFB41, 0x9A9DFB41, 0x9A9EFB41, 0x9A9FFB41,
+		0x9AA0FB41, 0x9AA1FB41, 0x9AA2FB41, 0x9AA3FB41, 0x9AA4FB41, 0x9AA5FB41, 0x9AA6FB41, 0x9AA7FB41, 0x9AA8FB41, 0x9AA9FB41, 0x9AAAFB41, 0x9AABFB41, 0x9AACFB41, 0x9AADFB41, 0x9AAEFB41, 0x9AAFFB41, 0x9AB0FB41, 0x9AB1FB41, 0x9AB2FB41, 0x9AB3FB41, 0x9AB4FB41, 0x9AB5FB41, 0x9AB6FB41, 0x9AB7FB41, 0x9AB8FB41, 0x9AB9FB41, 0x9ABAFB41, 0x9ABBFB41, 0x9ABCFB41, 0x9ABDFB41, 0x9ABEFB41, 0x9ABFFB41,
+		0x9AC0FB41, 0x9AC1FB41, 0x9AC2FB41, 0x9AC3FB41, 0x9AC4FB41, 0x9AC5FB41, 0x9AC6FB41, 0x9AC7FB41, 0x9AC8FB41, 0x9AC9FB41, 0x9ACAFB41, 0x9ACBFB41, 0x9ACCFB41, 0x9ACDFB41, 0x9ACEFB41, 0x9ACFFB41, 0x9AD0FB41, 0x9AD1FB41, 0x9AD2FB41, 0x9AD3FB41, 0x9AD4FB41, 0x9AD5FB41, 0x9AD6FB41, 0x9AD7FB41, 0x9AD8FB41, 0x9AD9FB41, 0x9ADAFB41, 0x9ADBFB41, 0x9ADCFB41, 0x9ADDFB41, 0x9ADEFB41, 0x9ADFFB41,
+		0x9AE0FB41, 0x9AE1FB41, 0x9AE2FB41, 0x9AE3FB41, 0x9AE4FB41, 0x9AE5FB41, 0x9AE6FB41, 0x9AE7FB41, 0x9AE8FB41, 0x9AE9FB41, 0x9AEAFB41, 0x9AEBFB41, 0x9AECFB41, 0x9AEDFB41, 0x9AEEFB41, 0x9AEFFB41, 0x9AF0FB41, 0x9AF1FB41, 0x9AF2FB41, 0x9AF3FB41, 0x9AF4FB41, 0x9AF5FB41, 0x9AF6FB41, 0x9AF7FB41, 0x9AF8FB41, 0x9AF9FB41, 0x9AFAFB41, 0x9AFBFB41, 0x9AFCFB41, 0x9AFDFB41, 0x9AFEFB41, 0x9AFFFB41,
+		0x9B00FB41, 0x9B01FB41, 0x9B02FB41, 0x9B03FB41, 0x9B04FB41, 0x9B05FB41, 0x9B06FB41, 0x9B07FB41, 0x9B08FB41, 0x9B09FB41, 0x9B0AFB41, 0x9B0BFB41, 0x9B0CFB41, 0x9B0DFB41, 0x9B0EFB41, 0x9B0FFB41, 0x9B10FB41, 0x9B11FB41, 0x9B12FB41, 0x9B13FB41, 0x9B14FB41, 0x9B15FB41, 0x9B16FB41, 0x9B17FB41, 0x9B18FB41, 0x9B19FB41, 0x9B1AFB41, 0x9B1BFB41, 0x9B1CFB41, 0x9B1DFB41, 0x9B1EFB41, 0x9B1FFB41,
+		0x9B20FB41, 0x9B21FB41, 0x9B22FB41, 0x9B23FB41, 0x9B24FB41, 0x9B25FB41, 0x9B26FB41, 0x9B27FB41, 0x9B28FB41, 0x9B29FB41, 0x9B2AFB41, 0x9B2BFB41, 0x9B2CFB41, 0x9B2DFB41, 0x9B2EFB41, 0x9B2FFB41, 0x9B30FB41, 0x9B31FB41, 0x9B32FB41, 0x9B33FB41, 0x9B34FB41, 0x9B35FB41, 0x9B36FB41, 0x9B37FB41, 0x9B38FB41, 0x9B39FB41, 0x9B3AFB41, 0x9B3BFB41, 0x9B3CFB41, 0x9B3DFB41, 0x9B3EFB41, 0x9B3FFB41,
+		0x9B40FB41, 0x9B41FB41, 0x9B42FB41, 0x9B43FB41, 0x9B44FB41, 0x9B45FB41, 0x9B46FB41, 0x9B47FB41, 0x9B48FB41, 0x9B49FB41, 0x9B4AFB41, 0x9B4BFB41, 0x9B4CFB41, 0x9B4DFB41, 0x9B4EFB41, 0x9B4FFB41, 0x9B50FB41, 0x9B51FB41, 0x9B52FB41, 0x9B53FB41, 0x9B54FB41, 0x9B55FB41, 0x9B56FB41, 0x9B57FB41, 0x9B58FB41, 0x9B59FB41, 0x9B5AFB41, 0x9B5BFB41, 0x9B5CFB41, 0x9B5DFB41, 0x9B5EFB41, 0x9B5FFB41,
+		0x9B60FB41, 0x9B61FB41, 0x9B62FB41, 0x9B63FB41, 0x9B64FB41, 0x9B65FB41, 0x9B66FB41, 0x9B67FB41, 0x9B68FB41, 0x9B69FB41, 0x9B6AFB41, 0x9B6BFB41, 0x9B6CFB41, 0x9B6DFB41, 0x9B6EFB41, 0x9B6FFB41, 0x9B70FB41, 0x9B71FB41, 0x9B72FB41, 0x9B73FB41, 0x9B74FB41, 0x9B75FB41, 0x9B76FB41, 0x9B77FB41, 0x9B78FB41, 0x9B79FB41, 0x9B7AFB41, 0x9B7BFB41, 0x9B7CFB41, 0x9B7DFB41, 0x9B7EFB41, 0x9B7FFB41,
+		0x9B80FB41, 0x9B81FB41, 0x9B82FB41, 0x9B83FB41, 0x9B84FB41, 0x9B85FB41, 0x9B86FB41, 0x9B87FB41, 0x9B88FB41, 0x9B89FB41, 0x9B8AFB41, 0x9B8BFB41, 0x9B8CFB41, 0x9B8DFB41, 0x9B8EFB41, 0x9B8FFB41, 0x9B90FB41, 0x9B91FB41, 0x9B92FB41, 0x9B93FB41, 0x9B94FB41, 0x9B95FB41, 0x9B96FB41, 0x9B97FB41, 0x9B98FB41, 0x9B99FB41, 0x9B9AFB41, 0x9B9BFB41, 0x9B9CFB41, 0x9B9DFB41, 0x9B9EFB41, 0x9B9FFB41,
+		0x9BA0FB41, 0x9BA1FB41, 0x9BA2FB41, 0x9BA3FB41, 0x9BA4FB41, 0x9BA5FB41, 0x9BA6FB41, 0x9BA7FB41, 0x9BA8FB41, 0x9BA9FB41, 0x9BAAFB41, 0x9BABFB41, 0x9BACFB41, 0x9BADFB41, 0x9BAEFB41, 0x9BAFFB41, 0x9BB0FB41, 0x9BB1FB41, 0x9BB2FB41, 0x9BB3FB41, 0x9BB4FB41, 0x9BB5FB41, 0x9BB6FB41, 0x9BB7FB41, 0x9BB8FB41, 0x9BB9FB41, 0x9BBAFB41, 0x9BBBFB41, 0x9BBCFB41, 0x9BBDFB41, 0x9BBEFB41, 0x9BBFFB41,
+		0x9BC0FB41, 0x9BC1FB41, 0x9BC2FB41, 0x9BC3FB41, 0x9BC4FB41, 0x9BC5FB41, 0x9BC6FB41, 0x9BC7FB41, 0x9BC8FB41, 0x9BC9FB41, 0x9BCAFB41, 0x9BCBFB41, 0x9BCCFB41, 0x9BCDFB41, 0x9BCEFB41, 0x9BCFFB41, 0x9BD0FB41, 0x9BD1FB41, 0x9BD2FB41, 0x9BD3FB41, 0x9BD4FB41, 0x9BD5FB41, 0x9BD6FB41, 0x9BD7FB41, 0x9BD8FB41, 0x9BD9FB41, 0x9BDAFB41, 0x9BDBFB41, 0x9BDCFB41, 0x9BDDFB41, 0x9BDEFB41, 0x9BDFFB41,
+		0x9BE0FB41, 0x9BE1FB41, 0x9BE2FB41, 0x9BE3FB41, 0x9BE4FB41, 0x9BE5FB41, 0x9BE6FB41, 0x9BE7FB41, 0x9BE8FB41, 0x9BE9FB41, 0x9BEAFB41, 0x9BEBFB41, 0x9BECFB41, 0x9BEDFB41, 0x9BEEFB41, 0x9BEFFB41, 0x9BF0FB41, 0x9BF1FB41, 0x9BF2FB41, 0x9BF3FB41, 0x9BF4FB41, 0x9BF5FB41, 0x9BF6FB41, 0x9BF7FB41, 0x9BF8FB41, 0x9BF9FB41, 0x9BFAFB41, 0x9BFBFB41, 0x9BFCFB41, 0x9BFDFB41, 0x9BFEFB41, 0x9BFFFB41,
+		0x9C00FB41, 0x9C01FB41, 0x9C02FB41, 0x9C03FB41, 0x9C04FB41, 0x9C05FB41, 0x9C06FB41, 0x9C07FB41, 0x9C08FB41, 0x9C09FB41, 0x9C0AFB41, 0x9C0BFB41, 0x9C0CFB41, 0x9C0DFB41, 0x9C0EFB41, 0x9C0FFB41, 0x9C10FB41, 0x9C11FB41, 0x9C12FB41, 0x9C13FB41, 0x9C14FB41, 0x9C15FB41, 0x9C16FB41, 0x9C17FB41, 0x9C18FB41, 0x9C19FB41, 0x9C1AFB41, 0x9C1BFB41, 0x9C1CFB41, 0x9C1DFB41, 0x9C1EFB41, 0x9C1FFB41,
+		0x9C20FB41, 0x9C21FB41, 0x9C22FB41, 0x9C23FB41, 0x9C24FB41, 0x9C25FB41, 0x9C26FB41, 0x9C27FB41, 0x9C28FB41, 0x9C29FB41, 0x9C2AFB41, 0x9C2BFB41, 0x9C2CFB41, 0x9C2DFB41, 0x9C2EFB41, 0x9C2FFB41, 0x9C30FB41, 0x9C31FB41, 0x9C32FB41, 0x9C33FB41, 0x9C34FB41, 0x9C35FB41, 0x9C36FB41, 0x9C37FB41, 0x9C38FB41, 0x9C39FB41, 0x9C3AFB41, 0x9C3BFB41, 0x9C3CFB41, 0x9C3DFB41, 0x9C3EFB41, 0x9C3FFB41,
+		0x9C40FB41, 0x9C41FB41, 0x9C42FB41, 0x9C43FB41, 0x9C44FB41, 0x9C45FB41, 0x9C46FB41, 0x9C47FB41, 0x9C48FB41, 0x9C49FB41, 0x9C4AFB41, 0x9C4BFB41, 0x9C4CFB41, 0x9C4DFB41, 0x9C4EFB41, 0x9C4FFB41, 0x9C50FB41, 0x9C51FB41, 0x9C52FB41, 0x9C53FB41, 0x9C54FB41, 0x9C55FB41, 0x9C56FB41, 0x9C57FB41, 0x9C58FB41, 0x9C59FB41, 0x9C5AFB41, 0x9C5BFB41, 0x9C5CFB41, 0x9C5DFB41, 0x9C5EFB41, 0x9C5FFB41,
+		0x9C60FB41, 0x9C61FB41, 0x9C62FB41, 0x9C63FB41, 0x9C64FB41, 0x9C65FB41, 0x9C66FB41, 0x9C67FB41, 0x9C68FB41, 0x9C69FB41, 0x9C6AFB41, 0x9C6BFB41, 0x9C6CFB41, 0x9C6DFB41, 0x9C6EFB41, 0x9C6FFB41, 0x9C70FB41, 0x9C71FB41, 0x9C72FB41, 0x9C73FB41, 0x9C74FB41, 0x9C75FB41, 0x9C76FB41, 0x9C77FB41, 0x9C78FB41, 0x9C79FB41, 0x9C7AFB41, 0x9C7BFB41, 0x9C7CFB41, 0x9C7DFB41, 0x9C7EFB41, 0x9C7FFB41,
+		0x9C80FB41, 0x9C81FB41, 0x9C82FB41, 0x9C83FB41, 0x9C84FB41, 0x9C85FB41, 0x9C86FB41, 0x9C87FB41, 0x9C88FB41, 0x9C89FB41, 0x9C8AFB41, 0x9C8BFB41, 0x9C8CFB41, 0x9C8DFB41, 0x9C8EFB41, 0x9C8FFB41, 0x9C90FB41, 0x9C91FB41, 0x9C92FB41, 0x9C93FB41, 0x9C94FB41, 0x9C95FB41, 0x9C96FB41, 0x9C97FB41, 0x9C98FB41, 0x9C99FB41, 0x9C9AFB41, 0x9C9BFB41, 0x9C9CFB41, 0x9C9DFB41, 0x9C9EFB41, 0x9C9FFB41,
+		0x9CA0FB41, 0x9CA1FB41, 0x9CA2FB41, 0x9CA3FB41, 0x9CA4FB41, 0x9CA5FB41, 0x9CA6FB41, 0x9CA7FB41, 0x9CA8FB41, 0x9CA9FB41, 0x9CAAFB41, 0x9CABFB41, 0x9CACFB41, 0x9CADFB41, 0x9CAEFB41, 0x9CAFFB41, 0x9CB0FB41, 0x9CB1FB41, 0x9CB2FB41, 0x9CB3FB41, 0x9CB4FB41, 0x9CB5FB41, 0x9CB6FB41, 0x9CB7FB41, 0x9CB8FB41, 0x9CB9FB41, 0x9CBAFB41, 0x9CBBFB41, 0x9CBCFB41, 0x9CBDFB41, 0x9CBEFB41, 0x9CBFFB41,
+		0x9CC0FB41, 0x9CC1FB41, 0x9CC2FB41, 0x9CC3FB41, 0x9CC4FB41, 0x9CC5FB41, 0x9CC6FB41, 0x9CC7FB41, 0x9CC8FB41, 0x9CC9FB41, 0x9CCAFB41, 0x9CCBFB41, 0x9CCCFB41, 0x9CCDFB41, 0x9CCEFB41, 0x9CCFFB41, 0x9CD0FB41, 0x9CD1FB41, 0x9CD2FB41, 0x9CD3FB41, 0x9CD4FB41, 0x9CD5FB41, 0x9CD6FB41, 0x9CD7FB41, 0x9CD8FB41, 0x9CD9FB41, 0x9CDAFB41, 0x9CDBFB41, 0x9CDCFB41, 0x9CDDFB41, 0x9CDEFB41, 0x9CDFFB41,
+		0x9CE0FB41, 0x9CE1FB41, 0x9CE2FB41, 0x9CE3FB41, 0x9CE4FB41, 0x9CE5FB41, 0x9CE6FB41, 0x9CE7FB41, 0x9CE8FB41, 0x9CE9FB41, 0x9CEAFB41, 0x9CEBFB41, 0x9CECFB41, 0x9CEDFB41, 0x9CEEFB41, 0x9CEFFB41, 0x9CF0FB41, 0x9CF1FB41, 0x9CF2FB41, 0x9CF3FB41, 0x9CF4FB41, 0x9CF5FB41, 0x9CF6FB41, 0x9CF7FB41, 0x9CF8FB41, 0x9CF9FB41, 0x9CFAFB41, 0x9CFBFB41, 0x9CFCFB41, 0x9CFDFB41, 0x9CFEFB41, 0x9CFFFB41,
+		0x9D00FB41, 0x9D01FB41, 0x9D02FB41, 0x9D03FB41, 0x9D04FB41, 0x9D05FB41, 0x9D06FB41, 0x9D07FB41, 0x9D08FB41, 0x9D09FB41, 0x9D0AFB41, 0x9D0BFB41, 0x9D0CFB41, 0x9D0DFB41, 0x9D0EFB41, 0x9D0FFB41, 0x9D10FB41, 0x9D11FB41, 0x9D12FB41, 0x9D13FB41, 0x9D14FB41, 0x9D15FB41, 0x9D16FB41, 0x9D17FB41, 0x9D18FB41, 0x9D19FB41, 0x9D1AFB41, 0x9D1BFB41, 0x9D1CFB41, 0x9D1DFB41, 0x9D1EFB41, 0x9D1FFB41,
+		0x9D20FB41, 0x9D21FB41, 0x9D22FB41, 0x9D23FB41, 0x9D24FB41, 0x9D25FB41, 0x9D26FB41, 0x9D27FB41, 0x9D28FB41, 0x9D29FB41, 0x9D2AFB41, 0x9D2BFB41, 0x9D2CFB41, 0x9D2DFB41, 0x9D2EFB41, 0x9D2FFB41, 0x9D30FB41, 0x9D31FB41, 0x9D32FB41, 0x9D33FB41, 0x9D34FB41, 0x9D35FB41, 0x9D36FB41, 0x9D37FB41, 0x9D38FB41, 0x9D39FB41, 0x9D3AFB41, 0x9D3BFB41, 0x9D3CFB41, 0x9D3DFB41, 0x9D3EFB41, 0x9D3FFB41,
+		0x9D40FB41, 0x9D41FB41, 0x9D42FB41, 0x9D43FB41, 0x9D44FB41, 0x9D45FB41, 0x9D46FB41, 0x9D47FB41, 0x9D48FB41, 0x9D49FB41, 0x9D4AFB41, 0x9D4BFB41, 0x9D4CFB41, 0x9D4DFB41, 0x9D4EFB41, 0x9D4FFB41, 0x9D50FB41, 0x9D51FB41, 0x9D52FB41, 0x9D53FB41, 0x9D54FB41, 0x9D55FB41, 0x9D56FB41, 0x9D57FB41, 0x9D58FB41, 0x9D59FB41, 0x9D5AFB41, 0x9D5BFB41, 0x9D5CFB41, 0x9D5DFB41, 0x9D5EFB41, 0x9D5FFB41,
+		0x9D60FB41, 0x9D61FB41, 0x9D62FB41, 0x9D63FB41, 0x9D64FB41, 0x9D65FB41, 0x9D66FB41, 0x9D67FB41, 0x9D68FB41, 0x9D69FB41, 0x9D6AFB41, 0x9D6BFB41, 0x9D6CFB41, 0x9D6DFB41, 0x9D6EFB41, 0x9D6FFB41, 0x9D70FB41, 0x9D71FB41, 0x9D72FB41, 0x9D73FB41, 0x9D74FB41, 0x9D75FB41, 0x9D76FB41, 0x9D77FB41, 0x9D78FB41, 0x9D79FB41, 0x9D7AFB41, 0x9D7BFB41, 0x9D7CFB41, 0x9D7DFB41, 0x9D7EFB41, 0x9D7FFB41,
+		0x9D80FB41, 0x9D81FB41, 0x9D82FB41, 0x9D83FB41, 0x9D84FB41, 0x9D85FB41, 0x9D86FB41, 0x9D87FB41, 0x9D88FB41, 0x9D89FB41, 0x9D8AFB41, 0x9D8BFB41, 0x9D8CFB41, 0x9D8DFB41, 0x9D8EFB41, 0x9D8FFB41, 0x9D90FB41, 0x9D91FB41, 0x9D92FB41, 0x9D93FB41, 0x9D94FB41, 0x9D95FB41, 0x9D96FB41, 0x9D97FB41, 0x9D98FB41, 0x9D99FB41, 0x9D9AFB41, 0x9D9BFB41, 0x9D9CFB41, 0x9D9DFB41, 0x9D9EFB41, 0x9D9FFB41,
+		0x9DA0FB41, 0x9DA1FB41, 0x9DA2FB41, 0x9DA3FB41, 0x9DA4FB41, 0x9DA5FB41, 0x9DA6FB41, 0x9DA7FB41, 0x9DA8FB41, 0x9DA9FB41, 0x9DAAFB41, 0x9DABFB41, 0x9DACFB41, 0x9DADFB41, 0x9DAEFB41, 0x9DAFFB41, 0x9DB0FB41, 0x9DB1FB41, 0x9DB2FB41, 0x9DB3FB41, 0x9DB4FB41, 0x9DB5FB41, 0x9DB6FB41, 0x9DB7FB41, 0x9DB8FB41, 0x9DB9FB41, 0x9DBAFB41, 0x9DBBFB41, 0x9DBCFB41, 0x9DBDFB41, 0x9DBEFB41, 0x9DBFFB41,
+		0x9DC0FB41, 0x9DC1FB41, 0x9DC2FB41, 0x9DC3FB41, 0x9DC4FB41, 0x9DC5FB41, 0x9DC6FB41, 0x9DC7FB41, 0x9DC8FB41, 0x9DC9FB41, 0x9DCAFB41, 0x9DCBFB41, 0x9DCCFB41, 0x9DCDFB41, 0x9DCEFB41, 0x9DCFFB41, 0x9DD0FB41, 0x9DD1FB41, 0x9DD2FB41, 0x9DD3FB41, 0x9DD4FB41, 0x9DD5FB41, 0x9DD6FB41, 0x9DD7FB41, 0x9DD8FB41, 0x9DD9FB41, 0x9DDAFB41, 0x9DDBFB41, 0x9DDCFB41, 0x9DDDFB41, 0x9DDEFB41, 0x9DDFFB41,
+		0x9DE0FB41, 0x9DE1FB41, 0x9DE2FB41, 0x9DE3FB41, 0x9DE4FB41, 0x9DE5FB41, 0x9DE6FB41, 0x9DE7FB41, 0x9DE8FB41, 0x9DE9FB41, 0x9DEAFB41, 0x9DEBFB41, 0x9DECFB41, 0x9DEDFB41, 0x9DEEFB41, 0x9DEFFB41, 0x9DF0FB41, 0x9DF1FB41, 0x9DF2FB41, 0x9DF3FB41, 0x9DF4FB41, 0x9DF5FB41, 0x9DF6FB41, 0x9DF7FB41, 0x9DF8FB41, 0x9DF9FB41, 0x9DFAFB41, 0x9DFBFB41, 0x9DFCFB41, 0x9DFDFB41, 0x9DFEFB41, 0x9DFFFB41,
+		0x9E00FB41, 0x9E01FB41, 0x9E02FB41, 0x9E03FB41, 0x9E04FB41, 0x9E05FB41, 0x9E06FB41, 0x9E07FB41, 0x9E08FB41, 0x9E09FB41, 0x9E0AFB41, 0x9E0BFB41, 0x9E0CFB41, 0x9E0DFB41, 0x9E0EFB41, 0x9E0FFB41, 0x9E10FB41, 0x9E11FB41, 0x9E12FB41, 0x9E13FB41, 0x9E14FB41, 0x9E15FB41, 0x9E16FB41, 0x9E17FB41, 0x9E18FB41, 0x9E19FB41, 0x9E1AFB41, 0x9E1BFB41, 0x9E1CFB41, 0x9E1DFB41, 0x9E1EFB41, 0x9E1FFB41,
+		0x9E20FB41, 0x9E21FB41, 0x9E22FB41, 0x9E23FB41, 0x9E24FB41, 0x9E25FB41, 0x9E26FB41, 0x9E27FB41, 0x9E28FB41, 0x9E29FB41, 0x9E2AFB41, 0x9E2BFB41, 0x9E2CFB41, 0x9E2DFB41, 0x9E2EFB41, 0x9E2FFB41, 0x9E30FB41, 0x9E31FB41, 0x9E32FB41, 0x9E33FB41, 0x9E34FB41, 0x9E35FB41, 0x9E36FB41, 0x9E37FB41, 0x9E38FB41, 0x9E39FB41, 0x9E3AFB41, 0x9E3BFB41, 0x9E3CFB41, 0x9E3DFB41, 0x9E3EFB41, 0x9E3FFB41,
+		0x9E40FB41, 0x9E41FB41, 0x9E42FB41, 0x9E43FB41, 0x9E44FB41, 0x9E45FB41, 0x9E46FB41, 0x9E47FB41, 0x9E48FB41, 0x9E49FB41, 0x9E4AFB41, 0x9E4BFB41, 0x9E4CFB41, 0x9E4DFB41, 0x9E4EFB41, 0x9E4FFB41, 0x9E50FB41, 0x9E51FB41, 0x9E52FB41, 0x9E53FB41, 0x9E54FB41, 0x9E55FB41, 0x9E56FB41, 0x9E57FB41, 0x9E58FB41, 0x9E59FB41, 0x9E5AFB41, 0x9E5BFB41, 0x9E5CFB41, 0x9E5DFB41, 0x9E5EFB41, 0x9E5FFB41,
+		0x9E60FB41, 0x9E61FB41, 0x9E62FB41, 0x9E63FB41, 0x9E64FB41, 0x9E65FB41, 0x9E66FB41, 0x9E67FB41, 0x9E68FB41, 0x9E69FB41, 0x9E6AFB41, 0x9E6BFB41, 0x9E6CFB41, 0x9E6DFB41, 0x9E6EFB41, 0x9E6FFB41, 0x9E70FB41, 0x9E71FB41, 0x9E72FB41, 0x9E73FB41, 0x9E74FB41, 0x9E75FB41, 0x9E76FB41, 0x9E77FB41, 0x9E78FB41, 0x9E79FB41, 0x9E7AFB41, 0x9E7BFB41, 0x9E7CFB41, 0x9E7DFB41, 0x9E7EFB41, 0x9E7FFB41,
+		0x9E80FB41, 0x9E81FB41, 0x9E82FB41, 0x9E83FB41, 0x9E84FB41, 0x9E85FB41, 0x9E86FB41, 0x9E87FB41, 0x9E88FB41, 0x9E89FB41, 0x9E8AFB41, 0x9E8BFB41, 0x9E8CFB41, 0x9E8DFB41, 0x9E8EFB41, 0x9E8FFB41, 0x9E90FB41, 0x9E91FB41, 0x9E92FB41, 0x9E93FB41, 0x9E94FB41, 0x9E95FB41, 0x9E96FB41, 0x9E97FB41, 0x9E98FB41, 0x9E99FB41, 0x9E9AFB41, 0x9E9BFB41, 0x9E9CFB41, 0x9E9DFB41, 0x9E9EFB41, 0x9E9FFB41,
+		0x9EA0FB41, 0x9EA1FB41, 0x9EA2FB41, 0x9EA3FB41, 0x9EA4FB41, 0x9EA5FB41, 0x9EA6FB41, 0x9EA7FB41, 0x9EA8FB41, 0x9EA9FB41, 0x9EAAFB41, 0x9EABFB41, 0x9EACFB41, 0x9EADFB41, 0x9EAEFB41, 0x9EAFFB41, 0x9EB0FB41, 0x9EB1FB41, 0x9EB2FB41, 0x9EB3FB41, 0x9EB4FB41, 0x9EB5FB41, 0x9EB6FB41, 0x9EB7FB41, 0x9EB8FB41, 0x9EB9FB41, 0x9EBAFB41, 0x9EBBFB41, 0x9EBCFB41, 0x9EBDFB41, 0x9EBEFB41, 0x9EBFFB41,
+		0x9EC0FB41, 0x9EC1FB41, 0x9EC2FB41, 0x9EC3FB41, 0x9EC4FB41, 0x9EC5FB41, 0x9EC6FB41, 0x9EC7FB41, 0x9EC8FB41, 0x9EC9FB41, 0x9ECAFB41, 0x9ECBFB41, 0x9ECCFB41, 0x9ECDFB41, 0x9ECEFB41, 0x9ECFFB41, 0x9ED0FB41, 0x9ED1FB41, 0x9ED2FB41, 0x9ED3FB41, 0x9ED4FB41, 0x9ED5FB41, 0x9ED6FB41, 0x9ED7FB41, 0x9ED8FB41, 0x9ED9FB41, 0x9EDAFB41, 0x9EDBFB41, 0x9EDCFB41, 0x9EDDFB41, 0x9EDEFB41, 0x9EDFFB41,
+		0x9EE0FB41, 0x9EE1FB41, 0x9EE2FB41, 0x9EE3FB41, 0x9EE4FB41, 0x9EE5FB41, 0x9EE6FB41, 0x9EE7FB41, 0x9EE8FB41, 0x9EE9FB41, 0x9EEAFB41, 0x9EEBFB41, 0x9EECFB41, 0x9EEDFB41, 0x9EEEFB41, 0x9EEFFB41, 0x9EF0FB41, 0x9EF1FB41, 0x9EF2FB41, 0x9EF3FB41, 0x9EF4FB41, 0x9EF5FB41, 0x9EF6FB41, 0x9EF7FB41, 0x9EF8FB41, 0x9EF9FB41, 0x9EFAFB41, 0x9EFBFB41, 0x9EFCFB41, 0x9EFDFB41, 0x9EFEFB41, 0x9EFFFB41,
+		0x9F00FB41, 0x9F01FB41, 0x9F02FB41, 0x9F03FB41, 0x9F04FB41, 0x9F05FB41, 0x9F06FB41, 0x9F07FB41, 0x9F08FB41, 0x9F09FB41, 0x9F0AFB41, 0x9F0BFB41, 0x9F0CFB41, 0x9F0DFB41, 0x9F0EFB41, 0x9F0FFB41, 0x9F10FB41, 0x9F11FB41, 0x9F12FB41, 0x9F13FB41, 0x9F14FB41, 0x9F15FB41, 0x9F16FB41, 0x9F17FB41, 0x9F18FB41, 0x9F19FB41, 0x9F1AFB41, 0x9F1BFB41, 0x9F1CFB41, 0x9F1DFB41, 0x9F1EFB41, 0x9F1FFB41,
+		0x9F20FB41, 0x9F21FB41, 0x9F22FB41, 0x9F23FB41, 0x9F24FB41, 0x9F25FB41, 0x9F26FB41, 0x9F27FB41, 0x9F28FB41, 0x9F29FB41, 0x9F2AFB41, 0x9F2BFB41, 0x9F2CFB41, 0x9F2DFB41, 0x9F2EFB41, 0x9F2FFB41, 0x9F30FB41, 0x9F31FB41, 0x9F32FB41, 0x9F33FB41, 0x9F34FB41, 0x9F35FB41, 0x9F36FB41, 0x9F37FB41, 0x9F38FB41, 0x9F39FB41, 0x9F3AFB41, 0x9F3BFB41, 0x9F3CFB41, 0x9F3DFB41, 0x9F3EFB41, 0x9F3FFB41,
+		0x9F40FB41, 0x9F41FB41, 0x9F42FB41, 0x9F43FB41, 0x9F44FB41, 0x9F45FB41, 0x9F46FB41, 0x9F47FB41, 0x9F48FB41, 0x9F49FB41, 0x9F4AFB41, 0x9F4BFB41, 0x9F4CFB41, 0x9F4DFB41, 0x9F4EFB41, 0x9F4FFB41, 0x9F50FB41, 0x9F51FB41, 0x9F52FB41, 0x9F53FB41, 0x9F54FB41, 0x9F55FB41, 0x9F56FB41, 0x9F57FB41, 0x9F58FB41, 0x9F59FB41, 0x9F5AFB41, 0x9F5BFB41, 0x9F5CFB41, 0x9F5DFB41, 0x9F5EFB41, 0x9F5FFB41,
+		0x9F60FB41, 0x9F61FB41, 0x9F62FB41, 0x9F63FB41, 0x9F64FB41, 0x9F65FB41, 0x9F66FB41, 0x9F67FB41, 0x9F68FB41, 0x9F69FB41, 0x9F6AFB41, 0x9F6BFB41, 0x9F6CFB41, 0x9F6DFB41, 0x9F6EFB41, 0x9F6FFB41, 0x9F70FB41, 0x9F71FB41, 0x9F72FB41, 0x9F73FB41, 0x9F74FB41, 0x9F75FB41, 0x9F76FB41, 0x9F77FB41, 0x9F78FB41, 0x9F79FB41, 0x9F7AFB41, 0x9F7BFB41, 0x9F7CFB41, 0x9F7DFB41, 0x9F7EFB41, 0x9F7FFB41,
+		0x9F80FB41, 0x9F81FB41, 0x9F82FB41, 0x9F83FB41, 0x9F84FB41, 0x9F85FB41, 0x9F86FB41, 0x9F87FB41, 0x9F88FB41, 0x9F89FB41, 0x9F8AFB41, 0x9F8BFB41, 0x9F8CFB41, 0x9F8DFB41, 0x9F8EFB41, 0x9F8FFB41, 0x9F90FB41, 0x9F91FB41, 0x9F92FB41, 0x9F93FB41, 0x9F94FB41, 0x9F95FB41, 0x9F96FB41, 0x9F97FB41, 0x9F98FB41, 0x9F99FB41, 0x9F9AFB41, 0x9F9BFB41, 0x9F9CFB41, 0x9F9DFB41, 0x9F9EFB41, 0x9F9FFB41,
+		0x9FA0FB41, 0x9FA1FB41, 0x9FA2FB41, 0x9FA3FB41, 0x9FA4FB41, 0x9FA5FB41, 0x9FA6FB41, 0x9FA7FB41, 0x9FA8FB41, 0x9FA9FB41, 0x9FAAFB41, 0x9FABFB41, 0x9FACFB41, 0x9FADFB41, 0x9FAEFB41, 0x9FAFFB41, 0x9FB0FB41, 0x9FB1FB41, 0x9FB2FB41, 0x9FB3FB41, 0x9FB4FB41, 0x9FB5FB41, 0x9FB6FB41, 0x9FB7FB41, 0x9FB8FB41, 0x9FB9FB41, 0x9FBAFB41, 0x9FBBFB41, 0x9FBCFB41, 0x9FBDFB41, 0x9FBEFB41, 0x9FBFFB41,
+		0x9FC0FB41, 0x9FC1FB41, 0x9FC2FB41, 0x9FC3FB41, 0x9FC4FB41, 0x9FC5FB41, 0x9FC6FB41, 0x9FC7FB41, 0x9FC8FB41, 0x9FC9FB41, 0x9FCAFB41, 0x9FCBFB41, 0x9FCCFB41, 0x9FCDFB41, 0x9FCEFB41, 0x9FCFFB41, 0x9FD0FB41, 0x9FD1FB41, 0x9FD2FB41, 0x9FD3FB41, 0x9FD4FB41, 0x9FD5FB41, 0x9FD6FB41, 0x9FD7FB41, 0x9FD8FB41, 0x9FD9FB41, 0x9FDAFB41, 0x9FDBFB41, 0x9FDCFB41, 0x9FDDFB41, 0x9FDEFB41, 0x9FDFFB41,
+		0x9FE0FB41, 0x9FE1FB41, 0x9FE2FB41, 0x9FE3FB41, 0x9FE4FB41, 0x9FE5FB41, 0x9FE6FB41, 0x9FE7FB41, 0x9FE8FB41, 0x9FE9FB41, 0x9FEAFB41, 0x9FEBFB41, 0x9FECFB41, 0x9FEDFB41, 0x9FEEFB41, 0x9FEFFB41, 0x9FF0FB41, 0x9FF1FB41, 0x9FF2FB41, 0x9FF3FB41, 0x9FF4FB41, 0x9FF5FB41, 0x9FF6FB41, 0x9FF7FB41, 0x9FF8FB41, 0x9FF9FB41, 0x9FFAFB41, 0x9FFBFB41, 0x9FFCFB41, 0x9FFDFBC1, 0x9FFEFBC1, 0x9FFFFBC1,
+		0x4461, 0x4462, 0x4463, 0x4464, 0x4465, 0x4466, 0x4467, 0x4468, 0x4469, 0x446A, 0x446B, 0x446C, 0x446D, 0x446E, 0x446F, 0x4470, 0x4471, 0x4472, 0x4473, 0x4474, 0x4475, 0x4476, 0x4477, 0x4478, 0x4479, 0x447A, 0x447B, 0x447C, 0x447D, 0x447E, 0x447F, 0x4480,
+		0x4481, 0x4482, 0x4483, 0x4484, 0x4485, 0x4486, 0x4487, 0x4488, 0x4489, 0x448A, 0x448B, 0x448C, 0x448D, 0x448E, 0x448F, 0x4490, 0x4491, 0x4492, 0x4493, 0x4494, 0x4495, 0x4496, 0x4497, 0x4498, 0x4499, 0x449A, 0x449B, 0x449C, 0x449D, 0x449E, 0x449F, 0x44A0,
+		0x44A1, 0x44A2, 0x44A3, 0x44A4, 0x44A5, 0x44A6, 0x44A7, 0x44A8, 0x44A9, 0x44AA, 0x44AB, 0x44AC, 0x44AD, 0x44AE, 0x44AF, 0x44B0, 0x44B1, 0x44B2, 0x44B3, 0x44B4, 0x44B5, 0x44B6, 0x44B7, 0x44B8, 0x44B9, 0x44BA, 0x44BB, 0x44BC, 0x44BD, 0x44BE, 0x44BF, 0x44C0,
+		0x44C1, 0x44C2, 0x44C3, 0x44C4, 0x44C5, 0x44C6, 0x44C7, 0x44C8, 0x44C9, 0x44CA, 0x44CB, 0x44CC, 0x44CD, 0x44CE, 0x44CF, 0x44D0, 0x44D1, 0x44D2, 0x44D3, 0x44D4, 0x44D5, 0x44D6, 0x44D7, 0x44D8, 0x44D9, 0x44DA, 0x44DB, 0x44DC, 0x44DD, 0x44DE, 0x44DF, 0x44E0,
+		0x44E1, 0x44E2, 0x44E3, 0x44E4, 0x44E5, 0x44E6, 0x44E7, 0x44E8, 0x44E9, 0x44EA, 0x44EB, 0x44EC, 0x44ED, 0x44EE, 0x44EF, 0x44F0, 0x44F1, 0x44F2, 0x44F3, 0x44F4, 0x44F5, 0x44F6, 0x44F7, 0x44F8, 0x44F9, 0x44FA, 0x44FB, 0x44FC, 0x44FD, 0x44FE, 0x44FF, 0x4500,
+		0x4501, 0x4502, 0x4503, 0x4504, 0x4505, 0x4506, 0x4507, 0x4508, 0x4509, 0x450A, 0x450B, 0x450C, 0x450D, 0x450E, 0x450F, 0x4510, 0x4511, 0x4512, 0x4513, 0x4514, 0x4515, 0x4516, 0x4517, 0x4518, 0x4519, 0x451A, 0x451B, 0x451C, 0x451D, 0x451E, 0x451F, 0x4520,
+		0x4521, 0x4522, 0x4523, 0x4524, 0x4525, 0x4526, 0x4527, 0x4528, 0x4529, 0x452A, 0x452B, 0x452C, 0x452D, 0x452E, 0x452F, 0x4530, 0x4531, 0x4532, 0x4533, 0x4534, 0x4535, 0x4536, 0x4537, 0x4538, 0x4539, 0x453A, 0x453B, 0x453C, 0x453D, 0x453E, 0x453F, 0x4540,
+		0x4541, 0x4542, 0x4543, 0x4544, 0x4545, 0x4546, 0x4547, 0x4548, 0x4549, 0x454A, 0x454B, 0x454C, 0x454D, 0x454E, 0x454F, 0x4550, 0x4551, 0x4552, 0x4553, 0x4554, 0x4555, 0x4556, 0x4557, 0x4558, 0x4559, 0x455A, 0x455B, 0x455C, 0x455D, 0x455E, 0x455F, 0x4560,
+		0x4561, 0x4562, 0x4563, 0x4564, 0x4565, 0x4566, 0x4567, 0x4568, 0x4569, 0x456A, 0x456B, 0x456C, 0x456D, 0x456E, 0x456F, 0x4570, 0x4571, 0x4572, 0x4573, 0x4574, 0x4575, 0x4576, 0x4577, 0x4578, 0x4579, 0x457A, 0x457B, 0x457C, 0x457D, 0x457E, 0x457F, 0x4580,
+		0x4581, 0x4582, 0x4583, 0x4584, 0x4585, 0x4586, 0x4587, 0x4588, 0x4589, 0x458A, 0x458B, 0x458C, 0x458D, 0x458E, 0x458F, 0x4590, 0x4591, 0x4592, 0x4593, 0x4594, 0x4595, 0x4596, 0x4597, 0x4598, 0x4599, 0x459A, 0x459B, 0x459C, 0x459D, 0x459E, 0x459F, 0x45A0,
+		0x45A1, 0x45A2, 0x45A3, 0x45A4, 0x45A5, 0x45A6, 0x45A7, 0x45A8, 0x45A9, 0x45AA, 0x45AB, 0x45AC, 0x45AD, 0x45AE, 0x45AF, 0x45B0, 0x45B1, 0x45B2, 0x45B3, 0x45B4, 0x45B5, 0x45B6, 0x45B7, 0x45B8, 0x45B9, 0x45BA, 0x45BB, 0x45BC, 0x45BD, 0x45BE, 0x45BF, 0x45C0,
+		0x45C1, 0x45C2, 0x45C3, 0x45C4, 0x45C5, 0x45C6, 0x45C7, 0x45C8, 0x45C9, 0x45CA, 0x45CB, 0x45CC, 0x45CD, 0x45CE, 0x45CF, 0x45D0, 0x45D1, 0x45D2, 0x45D3, 0x45D4, 0x45D5, 0x45D6, 0x45D7, 0x45D8, 0x45D9, 0x45DA, 0x45DB, 0x45DC, 0x45DD, 0x45DE, 0x45DF, 0x45E0,
+		0x45E1, 0x45E2, 0x45E3, 0x45E4, 0x45E5, 0x45E6, 0x45E7, 0x45E8, 0x45E9, 0x45EA, 0x45EB, 0x45EC, 0x45ED, 0x45EE, 0x45EF, 0x45F0, 0x45F1, 0x45F2, 0x45F3, 0x45F4, 0x45F5, 0x45F6, 0x45F7, 0x45F8, 0x45F9, 0x45FA, 0x45FB, 0x45FC, 0x45FD, 0x45FE, 0x45FF, 0x4600,
+		0x4601, 0x4602, 0x4603, 0x4604, 0x4605, 0x4606, 0x4607, 0x4608, 0x4609, 0x460A, 0x460B, 0x460C, 0x460D, 0x460E, 0x460F, 0x4610, 0x4611, 0x4612, 0x4613, 0x4614, 0x4615, 0x4616, 0x4617, 0x4618, 0x4619, 0x461A, 0x461B, 0x461C, 0x461D, 0x461E, 0x461F, 0x4620,
+		0x4621, 0x4622, 0x4623, 0x4624, 0x4625, 0x4626, 0x4627, 0x4628, 0x4629, 0x462A, 0x462B, 0x462C, 0x462D, 0x462E, 0x462F, 0x4630, 0x4631, 0x4632, 0x4633, 0x4634, 0x4635, 0x4636, 0x4637, 0x4638, 0x4639, 0x463A, 0x463B, 0x463C, 0x463D, 0x463E, 0x463F, 0x4640,
+		0x4641, 0x4642, 0x4643, 0x4644, 0x4645, 0x4646, 0x4647, 0x4648, 0x4649, 0x464A, 0x464B, 0x464C, 0x464D, 0x464E, 0x464F, 0x4650, 0x4651, 0x4652, 0x4653, 0x4654, 0x4655, 0x4656, 0x4657, 0x4658, 0x4659, 0x465A, 0x465B, 0x465C, 0x465D, 0x465E, 0x465F, 0x4660,
+		0x4661, 0x4662, 0x4663, 0x4664, 0x4665, 0x4666, 0x4667, 0x4668, 0x4669, 0x466A, 0x466B, 0x466C, 0x466D, 0x466E, 0x466F, 0x4670, 0x4671, 0x4672, 0x4673, 0x4674, 0x4675, 0x4676, 0x4677, 0x4678, 0x4679, 0x467A, 0x467B, 0x467C, 0x467D, 0x467E, 0x467F, 0x4680,
+		0x4681, 0x4682, 0x4683, 0x4684, 0x4685, 0x4686, 0x4687, 0x4688, 0x4689, 0x468A, 0x468B, 0x468C, 0x468D, 0x468E, 0x468F, 0x4690, 0x4691, 0x4692, 0x4693, 0x4694, 0x4695, 0x4696, 0x4697, 0x4698, 0x4699, 0x469A, 0x469B, 0x469C, 0x469D, 0x469E, 0x469F, 0x46A0,
+		0x46A1, 0x46A2, 0x46A3, 0x46A4, 0x46A5, 0x46A6, 0x46A7, 0x46A8, 0x46A9, 0x46AA, 0x46AB, 0x46AC, 0x46AD, 0x46AE, 0x46AF, 0x46B0, 0x46B1, 0x46B2, 0x46B3, 0x46B4, 0x46B5, 0x46B6, 0x46B7, 0x46B8, 0x46B9, 0x46BA, 0x46BB, 0x46BC, 0x46BD, 0x46BE, 0x46BF, 0x46C0,
+		0x46C1, 0x46C2, 0x46C3, 0x46C4, 0x46C5, 0x46C6, 0x46C7, 0x46C8, 0x46C9, 0x46CA, 0x46CB, 0x46CC, 0x46CD, 0x46CE, 0x46CF, 0x46D0, 0x46D1, 0x46D2, 0x46D3, 0x46D4, 0x46D5, 0x46D6, 0x46D7, 0x46D8, 0x46D9, 0x46DA, 0x46DB, 0x46DC, 0x46DD, 0x46DE, 0x46DF, 0x46E0,
+		0x46E1, 0x46E2, 0x46E3, 0x46E4, 0x46E5, 0x46E6, 0x46E7, 0x46E8, 0x46E9, 0x46EA, 0x46EB, 0x46EC, 0x46ED, 0x46EE, 0x46EF, 0x46F0, 0x46F1, 0x46F2, 0x46F3, 0x46F4, 0x46F5, 0x46F6, 0x46F7, 0x46F8, 0x46F9, 0x46FA, 0x46FB, 0x46FC, 0x46FD, 0x46FE, 0x46FF, 0x4700,
+		0x4701, 0x4702, 0x4703, 0x4704, 0x4705, 0x4706, 0x4707, 0x4708, 0x4709, 0x470A, 0x470B, 0x470C, 0x470D, 0x470E, 0x470F, 0x4710, 0x4711, 0x4712, 0x4713, 0x4714, 0x4715, 0x4716, 0x4717, 0x4718, 0x4719, 0x471A, 0x471B, 0x471C, 0x471D, 0x471E, 0x471F, 0x4720,
+		0x4721, 0x4722, 0x4723, 0x4724, 0x4725, 0x4726, 0x4727, 0x4728, 0x4729, 0x472A, 0x472B, 0x472C, 0x472D, 0x472E, 0x472F, 0x4730, 0x4731, 0x4732, 0x4733, 0x4734, 0x4735, 0x4736, 0x4737, 0x4738, 0x4739, 0x473A, 0x473B, 0x473C, 0x473D, 0x473E, 0x473F, 0x4740,
+		0x4741, 0x4742, 0x4743, 0x4744, 0x4745, 0x4746, 0x4747, 0x4748, 0x4749, 0x474A, 0x474B, 0x474C, 0x474D, 0x474E, 0x474F, 0x4750, 0x4751, 0x4752, 0x4753, 0x4754, 0x4755, 0x4756, 0x4757, 0x4758, 0x4759, 0x475A, 0x475B, 0x475C, 0x475D, 0x475E, 0x475F, 0x4760,
+		0x4761, 0x4762, 0x4763, 0x4764, 0x4765, 0x4766, 0x4767, 0x4768, 0x4769, 0x476A, 0x476B, 0x476C, 0x476D, 0x476E, 0x476F, 0x4770, 0x4771, 0x4772, 0x4773, 0x4774, 0x4775, 0x4776, 0x4777, 0x4778, 0x4779, 0x477A, 0x477B, 0x477C, 0x477D, 0x477E, 0x477F, 0x4780,
+		0x4781, 0x4782, 0x4783, 0x4784, 0x4785, 0x4786, 0x4787, 0x4788, 0x4789, 0x478A, 0x478B, 0x478C, 0x478D, 0x478E, 0x478F, 0x4790, 0x4791, 0x4792, 0x4793, 0x4794, 0x4795, 0x4796, 0x4797, 0x4798, 0x4799, 0x479A, 0x479B, 0x479C, 0x479D, 0x479E, 0x479F, 0x47A0,
+		0x47A1, 0x47A2, 0x47A3, 0x47A4, 0x47A5, 0x47A6, 0x47A7, 0x47A8, 0x47A9, 0x47AA, 0x47AB, 0x47AC, 0x47AD, 0x47AE, 0x47AF, 0x47B0, 0x47B1, 0x47B2, 0x47B3, 0x47B4, 0x47B5, 0x47B6, 0x47B7, 0x47B8, 0x47B9, 0x47BA, 0x47BB, 0x47BC, 0x47BD, 0x47BE, 0x47BF, 0x47C0,
+		0x47C1, 0x47C2, 0x47C3, 0x47C4, 0x47C5, 0x47C6, 0x47C7, 0x47C8, 0x47C9, 0x47CA, 0x47CB, 0x47CC, 0x47CD, 0x47CE, 0x47CF, 0x47D0, 0x47D1, 0x47D2, 0x47D3, 0x47D4, 0x47D5, 0x47D6, 0x47D7, 0x47D8, 0x47D9, 0x47DA, 0x47DB, 0x47DC, 0x47DD, 0x47DE, 0x47DF, 0x47E0,
+		0x47E1, 0x47E2, 0x47E3, 0x47E4, 0x47E5, 0x47E6, 0x47E7, 0x47E8, 0x47E9, 0x47EA, 0x47EB, 0x47EC, 0x47ED, 0x47EE, 0x47EF, 0x47F0, 0x47F1, 0x47F2, 0x47F3, 0x47F4, 0x47F5, 0x47F6, 0x47F7, 0x47F8, 0x47F9, 0x47FA, 0x47FB, 0x47FC, 0x47FD, 0x47FE, 0x47FF, 0x4800,
+		0x4801, 0x4802, 0x4803, 0x4804, 0x4805, 0x4806, 0x4807, 0x4808, 0x4809, 0x480A, 0x480B, 0x480C, 0x480D, 0x480E, 0x480F, 0x4810, 0x4811, 0x4812, 0x4813, 0x4814, 0x4815, 0x4816, 0x4817, 0x4818, 0x4819, 0x481A, 0x481B, 0x481C, 0x481D, 0x481E, 0x481F, 0x4820,
+		0x4821, 0x4822, 0x4823, 0x4824, 0x4825, 0x4826, 0x4827, 0x4828, 0x4829, 0x482A, 0x482B, 0x482C, 0x482D, 0x482E, 0x482F, 0x4830, 0x4831, 0x4832, 0x4833, 0x4834, 0x4835, 0x4836, 0x4837, 0x4838, 0x4839, 0x483A, 0x483B, 0x483C, 0x483D, 0x483E, 0x483F, 0x4840,
+		0x4841, 0x4842, 0x4843, 0x4844, 0x4845, 0x4846, 0x4847, 0x4848, 0x4849, 0x484A, 0x484B, 0x484C, 0x484D, 0x484E, 0x484F, 0x4850, 0x4851, 0x4852, 0x4853, 0x4854, 0x4855, 0x4856, 0x4857, 0x4858, 0x4859, 0x485A, 0x485B, 0x485C, 0x485D, 0x485E, 0x485F, 0x4860,
+		0x4861, 0x4862, 0x4863, 0x4864, 0x4865, 0x4866, 0x4867, 0x4868, 0x4869, 0x486A, 0x486B, 0x486C, 0x486D, 0x486E, 0x486F, 0x4870, 0x4871, 0x4872, 0x4873, 0x4874, 0x4875, 0x4876, 0x4877, 0x4878, 0x4879, 0x487A, 0x487B, 0x487C, 0x487D, 0x487E, 0x487F, 0x4880,
+		0x4881, 0x4882, 0x4883, 0x4884, 0x4885, 0x4886, 0x4887, 0x4888, 0x4889, 0x488A, 0x488B, 0x488C, 0x488D, 0x488E, 0x488F, 0x4890, 0x4891, 0x4892, 0x4893, 0x4894, 0x4895, 0x4896, 0x4897, 0x4898, 0x4899, 0x489A, 0x489B, 0x489C, 0x489D, 0x489E, 0x489F, 0x48A0,
+		0x48A1, 0x48A2, 0x48A3, 0x48A4, 0x48A5, 0x48A6, 0x48A7, 0x48A8, 0x48A9, 0x48AA, 0x48AB, 0x48AC, 0x48AD, 0x48AE, 0x48AF, 0x48B0, 0x48B1, 0x48B2, 0x48B3, 0x48B4, 0x48B5, 0x48B6, 0x48B7, 0x48B8, 0x48B9, 0x48BA, 0x48BB, 0x48BC, 0x48BD, 0x48BE, 0x48BF, 0x48C0,
+		0x48C1, 0x48C2, 0x48C3, 0x48C4, 0x48C5, 0x48C6, 0x48C7, 0x48C8, 0x48C9, 0x48CA, 0x48CB, 0x48CC, 0x48CD, 0x48CE, 0x48CF, 0x48D0, 0x48D1, 0x48D2, 0x48D3, 0x48D4, 0x48D5, 0x48D6, 0x48D7, 0x48D8, 0x48D9, 0x48DA, 0x48DB, 0x48DC, 0x48DD, 0x48DE, 0x48DF, 0x48E0,
+		0x48E1, 0x48E2, 0x48E3, 0x48E4, 0x48E5, 0x48E6, 0x48E7, 0x48E8, 0x48E9, 0x48EA, 0x48EB, 0x48EC, 0x48ED, 0xA48DFBC1, 0xA48EFBC1, 0xA48FFBC1, 0x108D, 0x108E, 0x108F, 0x1090, 0x1091, 0x1092, 0x1093, 0x1094, 0x1095, 0x1096, 0x1097, 0x1098, 0x1099, 0x109A, 0x109B, 0x109C,
+		0x109D, 0x109E, 0x109F, 0x10A0, 0x10A1, 0x10A2, 0x10A3, 0x10A4, 0x10A5, 0x10A6, 0x10A7, 0x10A8, 0x10A9, 0x10AA, 0x10AB, 0x10AC, 0x10AD, 0x10AE, 0x10AF, 0x10B0, 0x10B1, 0x10B2, 0x10B3, 0x10B4, 0x10B5, 0x10B6, 0x10B7, 0x10B8, 0x10B9, 0x10BA, 0x10BB, 0x10BC,
+		0x10BD, 0x10BE, 0x10BF, 0x10C0, 0x10C1, 0x10C2, 0x10C3, 0xA4C7FBC1, 0xA4C8FBC1, 0xA4C9FBC1, 0xA4CAFBC1, 0xA4CBFBC1, 0xA4CCFBC1, 0xA4CDFBC1, 0xA4CEFBC1, 0xA4CFFBC1, 0x48F4, 0x48F5, 0x48F6, 0x48F7, 0x48F8, 0x48F9, 0x48FA, 0x48FB, 0x48FC, 0x48FD, 0x48FE, 0x48FF, 0x4900, 0x4901, 0x4902, 0x4903,
+		0x4904, 0x4905, 0x4906, 0x4907, 0x4908, 0x4909, 0x490A, 0x490B, 0x490C, 0x490D, 0x4910, 0x490E, 0x4911, 0x490F, 0x4913, 0x4914, 0x4915, 0x4916, 0x4917, 0x4918, 0x4919, 0x491A, 0x491B, 0x491C, 0x48EE, 0x48EF, 0x48F0, 0x48F1, 0x48F3, 0x48F2, 0x232, 0x28C,
+		0x3CB9, 0x3CBA, 0x3CBB, 0x3CBC, 0x3CBD, 0x3CBE, 0x3CBF, 0x3CC0, 0x3CC1, 0x3CC2, 0x3CC3, 0x3CC4, 0x3CC5, 0x3CC6, 0x3CC7, 0x3CC8, 0x3CC9, 0x3CCA, 0x3CCB, 0x3CCC, 0x3CCD, 0x3CCE, 0x3CCF, 0x3CD0, 0x3CD1, 0x3CD2, 0x3CD3, 0x3CD4, 0x3CD5, 0x3CD6, 0x3CD7, 0x3CD8,
+		0x3CD9, 0x3CDA, 0x3CDB, 0x3CDC, 0x3CDD, 0x3CDE, 0x3CDF, 0x3CE0, 0x3CE1, 0x3CE2, 0x3CE3, 0x3CE4, 0x3CE5, 0x3CE6, 0x3CE7, 0x3CE8, 0x3CE9, 0x3CEA, 0x3CEB, 0x3CEC, 0x3CED, 0x3CEE, 0x3CEF, 0x3CF0, 0x3CF1, 0x3CF2, 0x3CF3, 0x3CF4, 0x3CF5, 0x3CF6, 0x3CF7, 0x3CF8,
+		0x3CF9, 0x3CFA, 0x3CFB, 0x3CFC, 0x3CFD, 0x3CFE, 0x3CFF, 0x3D00, 0x3D01, 0x3D02, 0x3D03, 0x3D04, 0x3D05, 0x3D06, 0x3D07, 0x3D08, 0x3D09, 0x3D0A, 0x3D0B, 0x3D0C, 0x3D0D, 0x3D0E, 0x3D0F, 0x3D10, 0x3D11, 0x3D12, 0x3D13, 0x3D14, 0x3D15, 0x3D16, 0x3D17, 0x3D18,
+		0x3D19, 0x3D1A, 0x3D1B, 0x3D1C, 0x3D1D, 0x3D1E, 0x3D1F, 0x3D20, 0x3D21, 0x3D22, 0x3D23, 0x3D24, 0x3D25, 0x3D26, 0x3D27, 0x3D28, 0x3D29, 0x3D2A, 0x3D2B, 0x3D2C, 0x3D2D, 0x3D2E, 0x3D2F, 0x3D30, 0x3D31, 0x3D32, 0x3D33, 0x3D34, 0x3D35, 0x3D36, 0x3D37, 0x3D38,
+		0x3D39, 0x3D3A, 0x3D3B, 0x3D3C, 0x3D3D, 0x3D3E, 0x3D3F, 0x3D40, 0x3D41, 0x3D42, 0x3D43, 0x3D44, 0x3D45, 0x3D46, 0x3D47, 0x3D48, 0x3D49, 0x3D4A, 0x3D4B, 0x3D4C, 0x3D4D, 0x3D4E, 0x3D4F, 0x3D50, 0x3D51, 0x3D52, 0x3D53, 0x3D54, 0x3D55, 0x3D56, 0x3D57, 0x3D58,
+		0x3D59, 0x3D5A, 0x3D5B, 0x3D5C, 0x3D5D, 0x3D5E, 0x3D5F, 0x3D60, 0x3D61, 0x3D62, 0x3D63, 0x3D64, 0x3D65, 0x3D66, 0x3D67, 0x3D68, 0x3D69, 0x3D6A, 0x3D6B, 0x3D6C, 0x3D6D, 0x3D6E, 0x3D6F, 0x3D70, 0x3D71, 0x3D72, 0x3D73, 0x3D74, 0x3D75, 0x3D76, 0x3D77, 0x3D78,
+		0x3D79, 0x3D7A, 0x3D7B, 0x3D7C, 0x3D7D, 0x3D7E, 0x3D7F, 0x3D80, 0x3D81, 0x3D82, 0x3D83, 0x3D84, 0x3D85, 0x3D86, 0x3D87, 0x3D88, 0x3D89, 0x3D8A, 0x3D8B, 0x3D8C, 0x3D8D, 0x3D8E, 0x3D8F, 0x3D90, 0x3D91, 0x3D92, 0x3D93, 0x3D94, 0x3D95, 0x3D96, 0x3D97, 0x3D98,
+		0x3D99, 0x3D9A, 0x3D9B, 0x3D9C, 0x3D9D, 0x3D9E, 0x3D9F, 0x3DA0, 0x3DA1, 0x3DA2, 0x3DA3, 0x3DA4, 0x3DA5, 0x3DA6, 0x3DA7, 0x3DA8, 0x3DA9, 0x3DAA, 0x3DAB, 0x3DAC, 0x3DAD, 0x3DAE, 0x3DAF, 0x3DB0, 0x3DB1, 0x3DB2, 0x3DB3, 0x3DB4, 0x3DB5, 0x3DB6, 0x3DB7, 0x3DB8,
+		0x3DB9, 0x3DBA, 0x3DBB, 0x3DBC, 0x3DBD, 0x3DBE, 0x3DBF, 0x3DC0, 0x3DC1, 0x3DC2, 0x3DC3, 0x3DC4, 0x3DC5, 0x233, 0x28D, 0x277, 0x3D11, 0x3D23, 0x3D40, 0x3DC43CC5, 0x3DC43CD7, 0x3DC43CEC, 0x3DC53D00, 0x3DC43D0B, 0x3DC53D11, 0x3DC53D13, 0x3DC43D19, 0x3DC43D3E, 0x3DC43D6D, 0x3DC43D84, 0x3DC53D8A, 0x3DC43D91,
+		0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x3D27, 0x3D8A, 0xA62CFBC1, 0xA62DFBC1, 0xA62EFBC1, 0xA62FFBC1, 0xA630FBC1, 0xA631FBC1, 0xA632FBC1, 0xA633FBC1, 0xA634FBC1, 0xA635FBC1, 0xA636FBC1, 0xA637FBC1, 0xA638FBC1, 0xA639FBC1, 0xA63AFBC1, 0xA63BFBC1, 0xA63CFBC1, 0xA63DFBC1, 0xA63EFBC1, 0xA63FFBC1,
+		0x23D5, 0x23D5, 0x23D8, 0x23D8, 0x23DD, 0x23DD, 0x23F1, 0x23F1, 0x23FA, 0x23FA, 0x248E, 0x248E, 0x24B5, 0x24B5, 0x24F1, 0x24F1, 0x24F8, 0x24F8, 0x2509, 0x2509, 0x2512, 0x2512, 0x2513, 0x2513, 0x2521, 0x2521, 0x2526, 0x2526, 0x252B, 0x252B, 0x2540, 0x2540,
+		0x24C2, 0x24C2, 0x23B9, 0x23B9, 0x2425, 0x2425, 0x2430, 0x2430, 0x244C, 0x244C, 0x244C, 0x244C, 0x244C, 0x244C, 0x244C, 0, 0, 0, 0, 0x3A5, 0x23C3, 0x23E5, 0x23ED, 0x2482, 0x24F4, 0x24F9, 0x24FD, 0x24AD, 0, 0, 0x3E3, 0x24F3,
+		0x23B4, 0x23B4, 0x23E4, 0x23E4, 0x23CC, 0x23CC, 0x24DB, 0x24DB, 0x23E2, 0x23E2, 0x247D, 0x247D, 0x2477, 0x2477, 0x24C3, 0x24C3, 0x24C8, 0x24C8, 0x24CE, 0x24CE, 0x24AC, 0x24AC, 0x24EC, 0x24EC, 0x244C, 0x244C, 0x244C, 0x244C, 0x24F4, 0x24FD, 0x2493, 0x2519,
+		0x3DC6, 0x3DC7, 0x3DC8, 0x3DC9, 0x3DCA, 0x3DCB, 0x3DCC, 0x3DCD, 0x3DCE, 0x3DCF, 0x3DD0, 0x3DD1, 0x3DD2, 0x3DD3, 0x3DD4, 0x3DD5, 0x3DD6, 0x3DD7, 0x3DD8, 0x3DD9, 0x3DDA, 0x3DDB, 0x3DDC, 0x3DDD, 0x3DDE, 0x3DDF, 0x3DE0, 0x3DE1, 0x3DE2, 0x3DE3, 0x3DE4, 0x3DE5,
+		0x3DE6, 0x3DE7, 0x3DE8, 0x3DE9, 0x3DEA, 0x3DEB, 0x3DEC, 0x3DED, 0x3DEE, 0x3DEF, 0x3DF0, 0x3DF1, 0x3DF2, 0x3DF3, 0x3DF4, 0x3DF5, 0x3DF6, 0x3DF7, 0x3DF8, 0x3DF9, 0x3DFA, 0x3DFB, 0x3DFC, 0x3DFD, 0x3DFE, 0x3DFF, 0x3E00, 0x3E01, 0x3E02, 0x3E03, 0x3E04, 0x3E05,
+		0x3E06, 0x3E07, 0x3E08, 0x3E09, 0x3E0A, 0x3E0B, 0x3E0C, 0x3E0D, 0x3E0E, 0x3E0F, 0x3E10, 0x3E11, 0x3E12, 0x3E13, 0x3E14, 0x3E15, 0, 0, 0x2E0, 0x28E, 0x266, 0x234, 0x23E, 0x278, 0xA6F8FBC1, 0xA6F9FBC1, 0xA6FAFBC1, 0xA6FBFBC1, 0xA6FCFBC1, 0xA6FDFBC1, 0xA6FEFBC1, 0xA6FFFBC1,
+		0x504, 0x505, 0x506, 0x507, 0x508, 0x509, 0x50A, 0x50B, 0x50C, 0x50D, 0x50E, 0x50F, 0x510, 0x511, 0x512, 0x513, 0x514, 0x515, 0x516, 0x517, 0x518, 0x519, 0x51A, 0x51B, 0x51C, 0x51D, 0x51E, 0x51F, 0x520, 0x521, 0x522, 0x523,
+		0x524, 0x525, 0x22E6, 0x22E6, 0x22F1, 0x22F1, 0x2089, 0x2089, 0x228621F7, 0x228621F7, 0x22CB, 0x22CB, 0x22CC, 0x22CC, 0x22CD, 0x22CD, 0x2046, 0x21D6, 0x1FA21FA2, 0x1FA21FA2, 0x213C1FA2, 0x213C1FA2, 0x22171FA2, 0x22171FA2, 0x22471FA2, 0x22471FA2, 0x22471FA2, 0x22471FA2, 0x22701FA2, 0x22701FA2, 0x1FEA, 0x1FEA,
+		0x20CF, 0x20CF, 0x20D0, 0x20D0, 0x20D1, 0x20D1, 0x20DE, 0x20DE, 0x20E0, 0x20E0, 0x2160, 0x2160, 0x2158, 0x2158, 0x213C213C, 0x213C213C, 0x2171, 0x2171, 0x2178, 0x2178, 0x2179, 0x2179, 0x2185, 0x2185, 0x2186, 0x2186, 0x219D, 0x219D, 0x21D1, 0x21D1, 0x224C, 0x224C,
+		0x22702247, 0x22702247, 0x22A2, 0x22A2, 0x22B9, 0x22B9, 0x22BA, 0x22BA, 0x22BF, 0x22BF, 0x22C3, 0x22C3, 0x22C4, 0x22C4, 0x22C5, 0x22C5, 0x22C5, 0x2005, 0x20FB, 0x2117, 0x2136, 0x21CF, 0x21D0, 0x2212, 0x22C6, 0x1FEB, 0x1FEB, 0x2042, 0x2042, 0x2051, 0x206C, 0x206C,
+		0x2100, 0x2100, 0x2193, 0x2193, 0x21D2, 0x21D2, 0x21F7, 0x21F7, 0x526, 0x527, 0x528, 0x22E7, 0x22E7, 0x222C, 0x20F6, 0x22E8, 0x212B, 0x212B, 0x1FDF, 0x1FDF, 0x1FE0, 0x2081, 0x1FCB, 0x1FCB, 0x2048, 0x2048, 0x1FA2, 0x1FA2, 0x213C, 0x213C, 0x2217, 0x2217,
+		0x2051, 0x2051, 0x20C4, 0x20C4, 0x2118, 0x2118, 0x2193, 0x2193, 0x21D2, 0x21D2, 0x2082, 0x202C, 0x2055, 0x20EC, 0x2098, 0x2184, 0x20D2, 0x2213, 0x20B8, 0x226D, 0x1FD5, 0x1FD5, 0x2165, 0x2165, 0x2227, 0x2227, 0x1FA9, 0x1FA9, 0x20A5, 0x20A5, 0x222B, 0x222B,
+		0xA7C0FBC1, 0xA7C1FBC1, 0x225E, 0x225E, 0x1FE0, 0x21DA, 0x2290, 0x1FF1, 0x1FF1, 0x21D7, 0x21D7, 0xA7CBFBC1, 0xA7CCFBC1, 0xA7CDFBC1, 0xA7CEFBC1, 0xA7CFFBC1, 0xA7D0FBC1, 0xA7D1FBC1, 0xA7D2FBC1, 0xA7D3FBC1, 0xA7D4FBC1, 0xA7D5FBC1, 0xA7D6FBC1, 0xA7D7FBC1, 0xA7D8FBC1, 0xA7D9FBC1, 0xA7DAFBC1, 0xA7DBFBC1, 0xA7DCFBC1, 0xA7DDFBC1, 0xA7DEFBC1, 0xA7DFFBC1,
+		0xA7E0FBC1, 0xA7E1FBC1, 0xA7E2FBC1, 0xA7E3FBC1, 0xA7E4FBC1, 0xA7E5FBC1, 0xA7E6FBC1, 0xA7E7FBC1, 0xA7E8FBC1, 0xA7E9FBC1, 0xA7EAFBC1, 0xA7EBFBC1, 0xA7ECFBC1, 0xA7EDFBC1, 0xA7EEFBC1, 0xA7EFFBC1, 0xA7F0FBC1, 0xA7F1FBC1, 0xA7F2FBC1, 0xA7F3FBC1, 0xA7F4FBC1, 0x2088, 0x2088, 0x209D, 0x2075, 0x2007213C, 0x223C, 0x2050, 0x217A, 0x2115, 0x209C, 0x2116,
+		0x2CDC, 0x2CDD, 0x2CDE, 0x2CDF, 0x2CE0, 0x2CE1, 0x2CE2, 0x2CE4, 0x2CE5, 0x2CE6, 0x2CE7, 0, 0x2CE8, 0x2CE9, 0x2CEA, 0x2CEB, 0x2CEC, 0x2CED, 0x2CEE, 0x2CEF, 0x2CF0, 0x2CF1, 0x2CF2, 0x2CF3, 0x2CF4, 0x2CF5, 0x2CF6, 0x2CF7, 0x2CF8, 0x2CF9, 0x2CFA, 0x2CFB,
+		0x2CFC, 0x2CFD, 0x2CFE, 0x2CFF, 0x2D00, 0x2D01, 0x2D02, 0x2D03, 0x56C, 0x56D, 0x56E, 0x56F, 0x2CE3, 0xA82DFBC1, 0xA82EFBC1, 0xA82FFBC1, 0x1D62, 0x1D63, 0x1D64, 0x1D65, 0x1D66, 0x1D67, 0x570, 0x571, 0x1F6F, 0x572, 0xA83AFBC1, 0xA83BFBC1, 0xA83CFBC1, 0xA83DFBC1, 0xA83EFBC1, 0xA83FFBC1,
+		0x3420, 0x3421, 0x3422, 0x3423, 0x3424, 0x3425, 0x3426, 0x3427, 0x342C, 0x342D, 0x342E, 0x342F, 0x3430, 0x3431, 0x3432, 0x3433, 0x3434, 0x3435, 0x3436, 0x3437, 0x3439, 0x343A, 0x343B, 0x343C, 0x343F, 0x3442, 0x3443, 0x3445, 0x3446, 0x3449, 0x344E, 0x344F,
+		0x3450, 0x3451, 0x344A, 0x344B, 0x344C, 0x344D, 0x3452, 0x3438, 0x343D, 0x3428, 0x3429, 0x342A, 0x342B, 0x343E, 0x3444, 0x3447, 0x3448, 0x3440, 0x3441, 0x3453, 0x454, 0x455, 0x29C, 0x29D, 0xA878FBC1, 0xA879FBC1, 0xA87AFBC1, 0xA87BFBC1, 0xA87CFBC1, 0xA87DFBC1, 0xA87EFBC1, 0xA87FFBC1,
+		0, 0, 0x2D04, 0x2D05, 0x2D06, 0x2D07, 0x2D08, 0x2D09, 0x2D0A, 0x2D0B, 0x2D0C, 0x2D0D, 0x2D0E, 0x2D0F, 0x2D10, 0x2D11, 0x2D12, 0x2D13, 0x2D14, 0x2D15, 0x2D16, 0x2D17, 0x2D18, 0x2D19, 0x2D1A, 0x2D1B, 0x2D1C, 0x2D1D, 0x2D1E, 0x2D1F, 0x2D20, 0x2D21,
+		0x2D22, 0x2D23, 0x2D24, 0x2D25, 0x2D26, 0x2D27, 0x2D28, 0x2D29, 0x2D2A, 0x2D2B, 0x2D2C, 0x2D2D, 0x2D2E, 0x2D2F, 0x2D30, 0x2D31, 0x2D32, 0x2D33, 0x2D34, 0x2D35, 0x2D36, 0x2D37, 0x2D38, 0x2D39, 0x2D3A, 0x2D3B, 0x2D3C, 0x2D3D, 0x2D3E, 0x2D3F, 0x2D40, 0x2D41,
+		0x2D42, 0x2D43, 0x2D44, 0x2D45, 0x2D46, 0, 0xA8C6FBC1, 0xA8C7FBC1, 0xA8C8FBC1, 0xA8C9FBC1, 0xA8CAFBC1, 0xA8CBFBC1, 0xA8CCFBC1, 0xA8CDFBC1, 0x298, 0x299, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0xA8DAFBC1, 0xA8DBFBC1, 0xA8DCFBC1, 0xA8DDFBC1, 0xA8DEFBC1, 0xA8DFFBC1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x2A21, 0x2A21, 0x2A21, 0x2A21, 0x2A21, 0x2A21, 0x401, 0x402, 0x403, 0x2A22, 0x404, 0x29D8, 0x29EE, 0x2A37,
+		0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x354B, 0x354C, 0x354D, 0x354E, 0x354F, 0x3550, 0x3551, 0x3552, 0x3553, 0x3554, 0x3555, 0x3556, 0x3557, 0x3558, 0x3559, 0x355A, 0x355B, 0x355C, 0x355D, 0x355E, 0x355F, 0x3560,
+		0x3561, 0x3562, 0x3563, 0x3564, 0x3565, 0x3566, 0x3567, 0x3568, 0x3569, 0x356A, 0x356B, 0, 0, 0, 0x45A, 0x2A0, 0x3527, 0x3528, 0x3529, 0x352A, 0x352B, 0x352C, 0x352D, 0x352E, 0x352F, 0x3530, 0x3531, 0x3532, 0x3533, 0x3534, 0x3535, 0x3536,
+		0x3537, 0x3538, 0x3539, 0x353A, 0x353B, 0x353C, 0x353D, 0x353E, 0x353F, 0x3540, 0x3541, 0x3542, 0x3543, 0x3544, 0x3545, 0x3546, 0x3547, 0x3548, 0x3549, 0x354A, 0xA954FBC1, 0xA955FBC1, 0xA956FBC1, 0xA957FBC1, 0xA958FBC1, 0xA959FBC1, 0xA95AFBC1, 0xA95BFBC1, 0xA95CFBC1, 0xA95DFBC1, 0xA95EFBC1, 0x2E1,
+		0x41D4, 0x41D5, 0x41D6, 0x41D7, 0x41D8, 0x41D9, 0x41DA, 0x41DB, 0x41DC, 0x41DD, 0x41DE, 0x41DF, 0x41E0, 0x41E1, 0x41E2, 0x41E3, 0x41E4, 0x41E5, 0x41E6, 0x41E7, 0x41E8, 0x41E9, 0x41EA, 0x41EB, 0x41EC, 0x41ED, 0x41EE, 0x41EF, 0x41F0, 0xA97DFBC1, 0xA97EFBC1, 0xA97FFBC1,
+		0, 0, 0, 0, 0x37FC, 0x37FD, 0x37FE, 0x37FF, 0x3800, 0x3801, 0x3802, 0x3803, 0x3804, 0x3805, 0x3806, 0x3807, 0x3808, 0x3809, 0x380A, 0x380B, 0x380C, 0x380D, 0x380E, 0x380F, 0x3810, 0x3811, 0x3812, 0x3813, 0x3814, 0x3815, 0x3816, 0x3817,
+		0x3818, 0x3819, 0x381A, 0x381B, 0x381C, 0x381D, 0x381E, 0x381F, 0x3820, 0x3821, 0x3822, 0x3824, 0x3824, 0x3826, 0x3827, 0x3828, 0x3829, 0x382A, 0x382B, 0, 0x382C, 0x382C, 0x382E, 0x382F, 0x3830, 0x3831, 0x3833, 0x3834, 0x382D, 0x3832, 0x3823, 0x3825,
+		0x3835, 0x2D6, 0x2D7, 0x2D8, 0x2D9, 0x2DA, 0x2DB, 0x262, 0x2AB, 0x2AC, 0x2DC, 0x2DD, 0x2DE, 0x2DF, 0xA9CEFBC1, 0x1F4D, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0xA9DAFBC1, 0xA9DBFBC1, 0xA9DCFBC1, 0xA9DDFBC1, 0x45B, 0x45C,
+		0x3575, 0x357D, 0x3587, 0x359D, 0x35B6, 0x3600, 0x1F4E, 0x358F, 0x35B1, 0x3573, 0x3576, 0x3582, 0x3589, 0x3597, 0x359A, 0x359E, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x35D2, 0x35A3, 0x35A6, 0x35B4, 0x35B7, 0xA9FFFBC1,
+		0x3771, 0x3772, 0x3773, 0x3774, 0x3775, 0x3776, 0x3777, 0x3778, 0x3779, 0x377A, 0x377B, 0x377C, 0x377D, 0x377E, 0x377F, 0x3780, 0x3781, 0x3782, 0x3783, 0x3784, 0x3785, 0x3786, 0x3787, 0x3788, 0x3789, 0x378A, 0x378B, 0x378C, 0x378D, 0x378E, 0x378F, 0x3790,
+		0x3791, 0x3792, 0x3793, 0x3794, 0x3795, 0x3796, 0x3797, 0x3798, 0x3799, 0x379E, 0x379F, 0x37A0, 0x37A1, 0x37A2, 0x37A3, 0x37A4, 0x37A5, 0x37A6, 0x37A7, 0x379A, 0x379B, 0x379C, 0x379D, 0xAA37FBC1, 0xAA38FBC1, 0xAA39FBC1, 0xAA3AFBC1, 0xAA3BFBC1, 0xAA3CFBC1, 0xAA3DFBC1, 0xAA3EFBC1, 0xAA3FFBC1,
+		0x37A8, 0x37A9, 0x37AA, 0x37AB, 0x37AC, 0x37AD, 0x37AE, 0x37AF, 0x37B0, 0x37B1, 0x37B2, 0x37B3, 0x37B4, 0x37B5, 0xAA4EFBC1, 0xAA4FFBC1, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0xAA5AFBC1, 0xAA5BFBC1, 0x45D, 0x2AD, 0x2AE, 0x2AF,
+		0x3572, 0x357B, 0x357E, 0x3581, 0x3588, 0x358E, 0x3592, 0x3594, 0x3596, 0x3599, 0x35A5, 0x35A9, 0x35CA, 0x35CD, 0x35CF, 0x35AF, 0x1F4F, 0x35D0, 0x3584, 0x35BD, 0x3618, 0x3619, 0x361A, 0x59B, 0x59C, 0x59D, 0x35BE, 0x3615, 0x3616, 0x3617, 0x357F, 0x358B,
+		0x3296, 0x3297, 0x3298, 0x3299, 0x329A, 0x329B, 0x329C, 0x329D, 0x329E, 0x329F, 0x32A0, 0x32A1, 0x32A2, 0x32A3, 0x32A4, 0x32A5, 0x32A6, 0x32A7, 0x32A8, 0x32A9, 0x32AA, 0x32AB, 0x32AC, 0x32AD, 0x32AE, 0x32AF, 0x32B0, 0x32B1, 0x32B2, 0x32B3, 0x32B4, 0x32B5,
+		0x32B6, 0x32B7, 0x32B8, 0x32B9, 0x32BA, 0x32BB, 0x32BC, 0x32BD, 0x32BE, 0x32BF, 0x32C0, 0x32C1, 0x32C2, 0x32C3, 0x32C4, 0x32C5, 0x32C6, 0x32C7, 0x32C8, 0x32C9, 0x32CA, 0x32CB, 0x32CC, 0x32CD, 0x32CE, 0x32CF, 0x32D0, 0x32D1, 0x32D2, 0x32D3, 0x32D4, 0,
+		0x32D5, 0, 0x32D6, 0xAAC3FBC1, 0xAAC4FBC1, 0xAAC5FBC1, 0xAAC6FBC1, 0xAAC7FBC1, 0xAAC8FBC1, 0xAAC9FBC1, 0xAACAFBC1, 0xAACBFBC1, 0xAACCFBC1, 0xAACDFBC1, 0xAACEFBC1, 0xAACFFBC1, 0xAAD0FBC1, 0xAAD1FBC1, 0xAAD2FBC1, 0xAAD3FBC1, 0xAAD4FBC1, 0xAAD5FBC1, 0xAAD6FBC1, 0xAAD7FBC1, 0xAAD8FBC1, 0xAAD9FBC1, 0xAADAFBC1, 0x32D7, 0x32D8, 0x1F50, 0x40E, 0x40F,
+		0x2CB9, 0x2CBA, 0x2CBB, 0x2CBC, 0x2CBD, 0x2CBE, 0x2CBF, 0x2CC0, 0x2CC1, 0x2CC2, 0x2CC3, 0x2CCC, 0x2CCD, 0x2CCE, 0x2CCF, 0x2CD0, 0x2B0, 0x279, 0x2C9D, 0x1F51, 0x1F52, 0x2CD1, 0x2CDB, 0xAAF7FBC1, 0xAAF8FBC1, 0xAAF9FBC1, 0xAAFAFBC1, 0xAAFBFBC1, 0xAAFCFBC1, 0xAAFDFBC1, 0xAAFEFBC1, 0xAAFFFBC1,
+		0xAB00FBC1, 0x2852, 0x2853, 0x2854, 0x2855, 0x2856, 0x2857, 0xAB07FBC1, 0xAB08FBC1, 0x2919, 0x291A, 0x291B, 0x291C, 0x291D, 0x291E, 0xAB0FFBC1, 0xAB10FBC1, 0x28FA, 0x28FB, 0x28FC, 0x28FD, 0x28FE, 0x28FF, 0xAB17FBC1, 0xAB18FBC1, 0xAB19FBC1, 0xAB1AFBC1, 0xAB1BFBC1, 0xAB1CFBC1, 0xAB1DFBC1, 0xAB1EFBC1, 0xAB1FFBC1,
+		0x295C, 0x295D, 0x295E, 0x295F, 0x2960, 0x2961, 0x2962, 0xAB27FBC1, 0x2974, 0x2975, 0x2976, 0x2977, 0x2978, 0x2979, 0x297A, 0xAB2FFBC1, 0x1FB5, 0x1FAC, 0x200C, 0x200D, 0x2013, 0x2047, 0x2059, 0x20F0, 0x20EA, 0x20EB, 0x2114, 0x2135, 0x213B, 0x2142, 0x214E, 0x2155,
+		0x214A, 0x2148, 0x2149, 0x214B, 0x214C, 0x2197, 0x219C, 0x21C5, 0x21C6, 0x21BB, 0x21C7, 0x21C8, 0x21C9, 0x21E8, 0x221C, 0x2226, 0x2220, 0x2221, 0x222A, 0x226D, 0x226E, 0x226F, 0x2269, 0x226A, 0x226B, 0x226C, 0x2281, 0x529, 0x2089, 0x20F0, 0x20E6, 0x222A,
+		0x22C0, 0x22C1, 0x2157, 0x22C2, 0x1FBB, 0x2347, 0x22951FEB, 0x21DA21F7, 0x21CE, 0x2260, 0x4DA, 0x4DB, 0xAB6CFBC1, 0xAB6DFBC1, 0xAB6EFBC1, 0xAB6FFBC1, 0x38DE, 0x38DF, 0x38E0, 0x38E1, 0x38E2, 0x38E3, 0x38E4, 0x38E5, 0x38E6, 0x38E7, 0x38E8, 0x38E9, 0x38EA, 0x38EB, 0x38EC, 0x38ED,
+		0x38EE, 0x38EF, 0x38F0, 0x38F1, 0x38F2, 0x38F3, 0x38F4, 0x38F5, 0x38F6, 0x38F7, 0x38F8, 0x38F9, 0x38FA, 0x38FB, 0x38FC, 0x38FD, 0x38FE, 0x38FF, 0x3900, 0x3901, 0x3902, 0x3903, 0x3904, 0x3905, 0x3906, 0x3907, 0x3908, 0x3909, 0x390A, 0x390B, 0x390C, 0x390D,
+		0x390E, 0x390F, 0x3910, 0x3911, 0x3912, 0x3913, 0x3914, 0x3915, 0x3916, 0x3917, 0x3918, 0x3919, 0x391A, 0x391B, 0x391C, 0x391D, 0x391E, 0x391F, 0x3920, 0x3921, 0x3922, 0x3923, 0x3924, 0x3925, 0x3926, 0x3927, 0x3928, 0x3929, 0x392A, 0x392B, 0x392C, 0x392D,
+		0x2C9E, 0x2C9F, 0x2CA0, 0x2CA1, 0x2CA2, 0x2CA3, 0x2CA4, 0x2CA5, 0x2CA6, 0x2CA7, 0x2CA8, 0x2CA9, 0x2CAA, 0x2CAB, 0x2CAC, 0x2CAD, 0x2CAE, 0x2CAF, 0x2CB0, 0x2CB1, 0x2CB2, 0x2CB3, 0x2CB4, 0x2CB5, 0x2CB6, 0x2CB7, 0x2CB8, 0x2CD2, 0x2CD3, 0x2CD4, 0x2CD5, 0x2CD6,
+		0x2CD7, 0x2CD8, 0x2CD9, 0x2CC4, 0x2CC5, 0x2CC6, 0x2CC7, 0x2CC8, 0x2CC9, 0x2CCA, 0x2CCB, 0x2B1, 0, 0x2CDA, 0xABEEFBC1, 0xABEFFBC1, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0xABFAFBC1, 0xABFBFBC1, 0xABFCFBC1, 0xABFDFBC1, 0xABFEFBC1, 0xABFFFBC1,
+		0x41F34175, 0x425141F34175, 0x425241F34175, 0x425341F34175, 0x425441F34175, 0x425541F34175, 0x425641F34175, 0x425741F34175, 0x425841F34175, 0x425941F34175, 0x425A41F34175, 0x425B41F34175, 0x425C41F34175, 0x425D41F34175, 0x425E41F34175, 0x425F41F34175, 0x426041F34175, 0x426141F34175, 0x426241F34175, 0x426341F34175, 0x426441F34175, 0x426541F34175, 0x426641F34175, 0x426741F34175, 0x426841F34175, 0x426941F34175, 0x426A41F34175, 0x426B41F34175, 0x41F44175, 0x425141F44175, 0x425241F44175, 0x425341F44175,
+		0x425441F44175, 0x425541F44175, 0x425641F44175, 0x425741F44175, 0x425841F44175, 0x425941F44175, 0x425A41F44175, 0x425B41F44175, 0x425C41F44175, 0x425D41F44175, 0x425E41F44175, 0x425F41F44175, 0x426041F44175, 0x426141F44175, 0x426241F44175, 0x426341F44175, 0x426441F44175, 0x426541F44175, 0x426641F44175, 0x426741F44175, 0x426841F44175, 0x426941F44175, 0x426A41F44175, 0x426B41F44175, 0x41F54175, 0x425141F54175, 0x425241F54175, 0x425341F54175, 0x425441F54175, 0x425541F54175, 0x425641F54175, 0x425741F54175,
+		0x425841F54175, 0x425941F54175, 0x425A41F54175, 0x425B41F54175, 0x425C41F54175, 0x425D41F54175, 0x425E41F54175, 0x425F41F54175, 0x426041F54175, 0x426141F54175, 0x426241F54175, 0x426341F54175, 0x426441F54175, 0x426541F54175, 0x426641F54175, 0x426741F54175, 0x426841F54175, 0x426941F54175, 0x426A41F54175, 0x426B41F54175, 0x41F64175, 0x425141F64175, 0x425241F64175, 0x425341F64175, 0x425441F64175, 0x425541F64175, 0x425641F64175, 0x425741F64175, 0x425841F64175, 0x425941F64175, 0x425A41F64175, 0x425B41F64175,
+		0x425C41F64175, 0x425D41F64175, 0x425E41F64175, 0x425F41F64175, 0x426041F64175, 0x426141F64175, 0x426241F64175, 0x426341F64175, 0x426441F64175, 0x426541F64175, 0x426641F64175, 0x426741F64175, 0x426841F64175, 0x426941F64175, 0x426A41F64175, 0x426B41F64175, 0x41F74175, 0x425141F74175, 0x425241F74175, 0x425341F74175, 0x425441F74175, 0x425541F74175, 0x425641F74175, 0x425741F74175, 0x425841F74175, 0x425941F74175, 0x425A41F74175, 0x425B41F74175, 0x425C41F74175, 0x425D41F74175, 0x425E41F74175, 0x425F41F74175,
+		0x426041F74175, 0x426141F74175, 0x426241F74175, 0x426341F74175, 0x426441F74175, 0x426541F74175, 0x426641F74175, 0x426741F74175, 0x426841F74175, 0x426941F74175, 0x426A41F74175, 0x426B41F74175, 0x41F84175, 0x425141F84175, 0x425241F84175, 0x425341F84175, 0x425441F84175, 0x425541F84175, 0x425641F84175, 0x425741F84175, 0x425841F84175, 0x425941F84175, 0x425A41F84175, 0x425B41F84175, 0x425C41F84175, 0x425D41F84175, 0x425E41F84175, 0x425F41F84175, 0x426041F84175, 0x426141F84175, 0x426241F84175, 0x426341F84175,
+		0x426441F84175, 0x426541F84175, 0x426641F84175, 0x426741F84175, 0x426841F84175, 0x426941F84175, 0x426A41F84175, 0x426B41F84175, 0x41F94175, 0x425141F94175, 0x425241F94175, 0x425341F94175, 0x425441F94175, 0x425541F94175, 0x425641F94175, 0x425741F94175, 0x425841F94175, 0x425941F94175, 0x425A41F94175, 0x425B41F94175, 0x425C41F94175, 0x425D41F94175, 0x425E41F94175, 0x425F41F94175, 0x426041F94175, 0x426141F94175, 0x426241F94175, 0x426341F94175, 0x426441F94175, 0x426541F94175, 0x426641F94175, 0x426741F94175,
+		0x426841F94175, 0x426941F94175, 0x426A41F94175, 0x426B41F94175, 0x41FA4175, 0x425141FA4175, 0x425241FA4175, 0x425341FA4175, 0x425441FA4175, 0x425541FA4175, 0x425641FA4175, 0x425741FA4175, 0x425841FA4175, 0x425941FA4175, 0x425A41FA4175, 0x425B41FA4175, 0x425C41FA4175, 0x425D41FA4175, 0x425E41FA4175, 0x425F41FA4175, 0x426041FA4175, 0x426141FA4175, 0x426241FA4175, 0x426341FA4175, 0x426441FA4175, 0x426541FA4175, 0x426641FA4175, 0x426741FA4175, 0x426841FA4175, 0x426941FA4175, 0x426A41FA4175, 0x426B41FA4175,
+		0x41FB4175, 0x425141FB4175, 0x425241FB4175, 0x425341FB4175, 0x425441FB4175, 0x425541FB4175, 0x425641FB4175, 0x425741FB4175, 0x425841FB4175, 0x425941FB4175, 0x425A41FB4175, 0x425B41FB4175, 0x425C41FB4175, 0x425D41FB4175, 0x425E41FB4175, 0x425F41FB4175, 0x426041FB4175, 0x426141FB4175, 0x426241FB4175, 0x426341FB4175, 0x426441FB4175, 0x426541FB4175, 0x426641FB4175, 0x426741FB4175, 0x426841FB4175, 0x426941FB4175, 0x426A41FB4175, 0x426B41FB4175, 0x41FC4175, 0x425141FC4175, 0x425241FC4175, 0x425341FC4175,
+		0x425441FC4175, 0x425541FC4175, 0x425641FC4175, 0x425741FC4175, 0x425841FC4175, 0x425941FC4175, 0x425A41FC4175, 0x425B41FC4175, 0x425C41FC4175, 0x425D41FC4175, 0x425E41FC4175, 0x425F41FC4175, 0x426041FC4175, 0x426141FC4175, 0x426241FC4175, 0x426341FC4175, 0x426441FC4175, 0x426541FC4175, 0x426641FC4175, 0x426741FC4175, 0x426841FC4175, 0x426941FC4175, 0x426A41FC4175, 0x426B41FC4175, 0x41FD4175, 0x425141FD4175, 0x425241FD4175, 0x425341FD4175, 0x425441FD4175, 0x425541FD4175, 0x425641FD4175, 0x425741FD4175,
+		0x425841FD4175, 0x425941FD4175, 0x425A41FD4175, 0x425B41FD4175, 0x425C41FD4175, 0x425D41FD4175, 0x425E41FD4175, 0x425F41FD4175, 0x426041FD4175, 0x426141FD4175, 0x426241FD4175, 0x426341FD4175, 0x426441FD4175, 0x426541FD4175, 0x426641FD4175, 0x426741FD4175, 0x426841FD4175, 0x426941FD4175, 0x426A41FD4175, 0x426B41FD4175, 0x41FE4175, 0x425141FE4175, 0x425241FE4175, 0x425341FE4175, 0x425441FE4175, 0x425541FE4175, 0x425641FE4175, 0x425741FE4175, 0x425841FE4175, 0x425941FE4175, 0x425A41FE4175, 0x425B41FE4175,
+		0x425C41FE4175, 0x425D41FE4175, 0x425E41FE4175, 0x425F41FE4175, 0x426041FE4175, 0x426141FE4175, 0x426241FE4175, 0x426341FE4175, 0x426441FE4175, 0x426541FE4175, 0x426641FE4175, 0x426741FE4175, 0x426841FE4175, 0x426941FE4175, 0x426A41FE4175, 0x426B41FE4175, 0x41FF4175, 0x425141FF4175, 0x425241FF4175, 0x425341FF4175, 0x425441FF4175, 0x425541FF4175, 0x425641FF4175, 0x425741FF4175, 0x425841FF4175, 0x425941FF4175, 0x425A41FF4175, 0x425B41FF4175, 0x425C41FF4175, 0x425D41FF4175, 0x425E41FF4175, 0x425F41FF4175,
+		0x426041FF4175, 0x426141FF4175, 0x426241FF4175, 0x426341FF4175, 0x426441FF4175, 0x426541FF4175, 0x426641FF4175, 0x426741FF4175, 0x426841FF4175, 0x426941FF4175, 0x426A41FF4175, 0x426B41FF4175, 0x42004175, 0x425142004175, 0x425242004175, 0x425342004175, 0x425442004175, 0x425542004175, 0x425642004175, 0x425742004175, 0x425842004175, 0x425942004175, 0x425A42004175, 0x425B42004175, 0x425C42004175, 0x425D42004175, 0x425E42004175, 0x425F42004175, 0x426042004175, 0x426142004175, 0x426242004175, 0x426342004175,
+		0x426442004175, 0x426542004175, 0x426642004175, 0x426742004175, 0x426842004175, 0x426942004175, 0x426A42004175, 0x426B42004175, 0x42014175, 0x425142014175, 0x425242014175, 0x425342014175, 0x425442014175, 0x425542014175, 0x425642014175, 0x425742014175, 0x425842014175, 0x425942014175, 0x425A42014175, 0x425B42014175, 0x425C42014175, 0x425D42014175, 0x425E42014175, 0x425F42014175, 0x426042014175, 0x426142014175, 0x426242014175, 0x426342014175, 0x426442014175, 0x426542014175, 0x426642014175, 0x426742014175,
+		0x426842014175, 0x426942014175, 0x426A42014175, 0x426B42014175, 0x42024175, 0x425142024175, 0x425242024175, 0x425342024175, 0x425442024175, 0x425542024175, 0x425642024175, 0x425742024175, 0x425842024175, 0x425942024175, 0x425A42024175, 0x425B42024175, 0x425C42024175, 0x425D42024175, 0x425E42024175, 0x425F42024175, 0x426042024175, 0x426142024175, 0x426242024175, 0x426342024175, 0x426442024175, 0x426542024175, 0x426642024175, 0x426742024175, 0x426842024175, 0x426942024175, 0x426A42024175, 0x426B42024175,
+		0x42034175, 0x425142034175, 0x425242034175, 0x425342034175, 0x425442034175, 0x425542034175, 0x425642034175, 0x425742034175, 0x425842034175, 0x425942034175, 0x425A42034175, 0x425B42034175, 0x425C42034175, 0x425D42034175, 0x425E42034175, 0x425F42034175, 0x426042034175, 0x426142034175, 0x426242034175, 0x426342034175, 0x426442034175, 0x426542034175, 0x426642034175, 0x426742034175, 0x426842034175, 0x426942034175, 0x426A42034175, 0x426B42034175, 0x42044175, 0x425142044175, 0x425242044175, 0x425342044175,
+		0x425442044175, 0x425542044175, 0x425642044175, 0x425742044175, 0x425842044175, 0x425942044175, 0x425A42044175, 0x425B42044175, 0x425C42044175, 0x425D42044175, 0x425E42044175, 0x425F42044175, 0x426042044175, 0x426142044175, 0x426242044175, 0x426342044175, 0x426442044175, 0x426542044175, 0x426642044175, 0x426742044175, 0x426842044175, 0x426942044175, 0x426A42044175, 0x426B42044175, 0x42054175, 0x425142054175, 0x425242054175, 0x425342054175, 0x425442054175, 0x425542054175, 0x425642054175, 0x425742054175,
+		0x425842054175, 0x425942054175, 0x425A42054175, 0x425B42054175, 0x425C42054175, 0x425D42054175, 0x425E42054175, 0x425F42054175, 0x426042054175, 0x426142054175, 0x426242054175, 0x426342054175, 0x426442054175, 0x426542054175, 0x426642054175, 0x426742054175, 0x426842054175, 0x426942054175, 0x426A42054175, 0x426B42054175, 0x42064175, 0x425142064175, 0x425242064175, 0x425342064175, 0x425442064175, 0x425542064175, 0x425642064175, 0x425742064175, 0x425842064175, 0x425942064175, 0x425A42064175, 0x425B42064175,
+		0x425C42064175, 0x425D42064175, 0x425E42064175, 0x425F42064175, 0x426042064175, 0x426142064175, 0x426242064175, 0x426342064175, 0x426442064175, 0x426542064175, 0x426642064175, 0x426742064175, 0x426842064175, 0x426942064175, 0x426A42064175, 0x426B42064175, 0x42074175, 0x425142074175, 0x425242074175, 0x425342074175, 0x425442074175, 0x425542074175, 0x425642074175, 0x425742074175, 0x425842074175, 0x425942074175, 0x425A42074175, 0x425B42074175, 0x425C42074175, 0x425D42074175, 0x425E42074175, 0x425F42074175,
+		0x426042074175, 0x426142074175, 0x426242074175, 0x426342074175, 0x426442074175, 0x426542074175, 0x426642074175, 0x426742074175, 0x426842074175, 0x426942074175, 0x426A42074175, 0x426B42074175, 0x41F34176, 0x425141F34176, 0x425241F34176, 0x425341F34176, 0x425441F34176, 0x425541F34176, 0x425641F34176, 0x425741F34176, 0x425841F34176, 0x425941F34176, 0x425A41F34176, 0x425B41F34176, 0x425C41F34176, 0x425D41F34176, 0x425E41F34176, 0x425F41F34176, 0x426041F34176, 0x426141F34176, 0x426241F34176, 0x426341F34176,
+		0x426441F34176, 0x426541F34176, 0x426641F34176, 0x426741F34176, 0x426841F34176, 0x426941F34176, 0x426A41F34176, 0x426B41F34176, 0x41F44176, 0x425141F44176, 0x425241F44176, 0x425341F44176, 0x425441F44176, 0x425541F44176, 0x425641F44176, 0x425741F44176, 0x425841F44176, 0x425941F44176, 0x425A41F44176, 0x425B41F44176, 0x425C41F44176, 0x425D41F44176, 0x425E41F44176, 0x425F41F44176, 0x426041F44176, 0x426141F44176, 0x426241F44176, 0x426341F44176, 0x426441F44176, 0x426541F44176, 0x426641F44176, 0x426741F44176,
+		0x426841F44176, 0x426941F44176, 0x426A41F44176, 0x426B41F44176, 0x41F54176, 0x425141F54176, 0x425241F54176, 0x425341F54176, 0x425441F54176, 0x425541F54176, 0x425641F54176, 0x425741F54176, 0x425841F54176, 0x425941F54176, 0x425A41F54176, 0x425B41F54176, 0x425C41F54176, 0x425D41F54176, 0x425E41F54176, 0x425F41F54176, 0x426041F54176, 0x426141F54176, 0x426241F54176, 0x426341F54176, 0x426441F54176, 0x426541F54176, 0x426641F54176, 0x426741F54176, 0x426841F54176, 0x426941F54176, 0x426A41F54176, 0x426B41F54176,
+		0x41F64176, 0x425141F64176, 0x425241F64176, 0x425341F64176, 0x425441F64176, 0x425541F64176, 0x425641F64176, 0x425741F64176, 0x425841F64176, 0x425941F64176, 0x425A41F64176, 0x425B41F64176, 0x425C41F64176, 0x425D41F64176, 0x425E41F64176, 0x425F41F64176, 0x426041F64176, 0x426141F64176, 0x426241F64176, 0x426341F64176, 0x426441F64176, 0x426541F64176, 0x426641F64176, 0x426741F64176, 0x426841F64176, 0x426941F64176, 0x426A41F64176, 0x426B41F64176, 0x41F74176, 0x425141F74176, 0x425241F74176, 0x425341F74176,
+		0x425441F74176, 0x425541F74176, 0x425641F74176, 0x425741F74176, 0x425841F74176, 0x425941F74176, 0x425A41F74176, 0x425B41F74176, 0x425C41F74176, 0x425D41F74176, 0x425E41F74176, 0x425F41F74176, 0x426041F74176, 0x426141F74176, 0x426241F74176, 0x426341F74176, 0x426441F74176, 0x426541F74176, 0x426641F74176, 0x426741F74176, 0x426841F74176, 0x426941F74176, 0x426A41F74176, 0x426B41F74176, 0x41F84176, 0x425141F84176, 0x425241F84176, 0x425341F84176, 0x425441F84176, 0x425541F84176, 0x425641F84176, 0x425741F84176,
+		0x425841F84176, 0x425941F84176, 0x425A41F84176, 0x425B41F84176, 0x425C41F84176, 0x425D41F84176, 0x425E41F84176, 0x425F41F84176, 0x426041F84176, 0x426141F84176, 0x426241F84176, 0x426341F84176, 0x426441F84176, 0x426541F84176, 0x426641F84176, 0x426741F84176, 0x426841F84176, 0x426941F84176, 0x426A41F84176, 0x426B41F84176, 0x41F94176, 0x425141F94176, 0x425241F94176, 0x425341F94176, 0x425441F94176, 0x425541F94176, 0x425641F94176, 0x425741F94176, 0x425841F94176, 0x425941F94176, 0x425A41F94176, 0x425B41F94176,
+		0x425C41F94176, 0x425D41F94176, 0x425E41F94176, 0x425F41F94176, 0x426041F94176, 0x426141F94176, 0x426241F94176, 0x426341F94176, 0x426441F94176, 0x426541F94176, 0x426641F94176, 0x426741F94176, 0x426841F94176, 0x426941F94176, 0x426A41F94176, 0x426B41F94176, 0x41FA4176, 0x425141FA4176, 0x425241FA4176, 0x425341FA4176, 0x425441FA4176, 0x425541FA4176, 0x425641FA4176, 0x425741FA4176, 0x425841FA4176, 0x425941FA4176, 0x425A41FA4176, 0x425B41FA4176, 0x425C41FA4176, 0x425D41FA4176, 0x425E41FA4176, 0x425F41FA4176,
+		0x426041FA4176, 0x426141FA4176, 0x426241FA4176, 0x426341FA4176, 0x426441FA4176, 0x426541FA4176, 0x426641FA4176, 0x426741FA4176, 0x426841FA4176, 0x426941FA4176, 0x426A41FA4176, 0x426B41FA4176, 0x41FB4176, 0x425141FB4176, 0x425241FB4176, 0x425341FB4176, 0x425441FB4176, 0x425541FB4176, 0x425641FB4176, 0x425741FB4176, 0x425841FB4176, 0x425941FB4176, 0x425A41FB4176, 0x425B41FB4176, 0x425C41FB4176, 0x425D41FB4176, 0x425E41FB4176, 0x425F41FB4176, 0x426041FB4176, 0x426141FB4176, 0x426241FB4176, 0x426341FB4176,
+		0x426441FB4176, 0x426541FB4176, 0x426641FB4176, 0x426741FB4176, 0x426841FB4176, 0x426941FB4176, 0x426A41FB4176, 0x426B41FB4176, 0x41FC4176, 0x425141FC4176, 0x425241FC4176, 0x425341FC4176, 0x425441FC4176, 0x425541FC4176, 0x425641FC4176, 0x425741FC4176, 0x425841FC4176, 0x425941FC4176, 0x425A41FC4176, 0x425B41FC4176, 0x425C41FC4176, 0x425D41FC4176, 0x425E41FC4176, 0x425F41FC4176, 0x426041FC4176, 0x426141FC4176, 0x426241FC4176, 0x426341FC4176, 0x426441FC4176, 0x426541FC4176, 0x426641FC4176, 0x426741FC4176,
+		0x426841FC4176, 0x426941FC4176, 0x426A41FC4176, 0x426B41FC4176, 0x41FD4176, 0x425141FD4176, 0x425241FD4176, 0x425341FD4176, 0x425441FD4176, 0x425541FD4176, 0x425641FD4176, 0x425741FD4176, 0x425841FD4176, 0x425941FD4176, 0x425A41FD4176, 0x425B41FD4176, 0x425C41FD4176, 0x425D41FD4176, 0x425E41FD4176, 0x425F41FD4176, 0x426041FD4176, 0x426141FD4176, 0x426241FD4176, 0x426341FD4176, 0x426441FD4176, 0x426541FD4176, 0x426641FD4176, 0x426741FD4176, 0x426841FD4176, 0x426941FD4176, 0x426A41FD4176, 0x426B41FD4176,
+		0x41FE4176, 0x425141FE4176, 0x425241FE4176, 0x425341FE4176, 0x425441FE4176, 0x425541FE4176, 0x425641FE4176, 0x425741FE4176, 0x425841FE4176, 0x425941FE4176, 0x425A41FE4176, 0x425B41FE4176, 0x425C41FE4176, 0x425D41FE4176, 0x425E41FE4176, 0x425F41FE4176, 0x426041FE4176, 0x426141FE4176, 0x426241FE4176, 0x426341FE4176, 0x426441FE4176, 0x426541FE4176, 0x426641FE4176, 0x426741FE4176, 0x426841FE4176, 0x426941FE4176, 0x426A41FE4176, 0x426B41FE4176, 0x41FF4176, 0x425141FF4176, 0x425241FF4176, 0x425341FF4176,
+		0x425441FF4176, 0x425541FF4176, 0x425641FF4176, 0x425741FF4176, 0x425841FF4176, 0x425941FF4176, 0x425A41FF4176, 0x425B41FF4176, 0x425C41FF4176, 0x425D41FF4176, 0x425E41FF4176, 0x425F41FF4176, 0x426041FF4176, 0x426141FF4176, 0x426241FF4176, 0x426341FF4176, 0x426441FF4176, 0x426541FF4176, 0x426641FF4176, 0x426741FF4176, 0x426841FF4176, 0x426941FF4176, 0x426A41FF4176, 0x426B41FF4176, 0x42004176, 0x425142004176, 0x425242004176, 0x425342004176, 0x425442004176, 0x425542004176, 0x425642004176, 0x425742004176,
+		0x425842004176, 0x425942004176, 0x425A42004176, 0x425B42004176, 0x425C42004176, 0x425D42004176, 0x425E42004176, 0x425F42004176, 0x426042004176, 0x426142004176, 0x426242004176, 0x426342004176, 0x426442004176, 0x426542004176, 0x426642004176, 0x426742004176, 0x426842004176, 0x426942004176, 0x426A42004176, 0x426B42004176, 0x42014176, 0x425142014176, 0x425242014176, 0x425342014176, 0x425442014176, 0x425542014176, 0x425642014176, 0x425742014176, 0x425842014176, 0x425942014176, 0x425A42014176, 0x425B42014176,
+		0x425C42014176, 0x425D42014176, 0x425E42014176, 0x425F42014176, 0x426042014176, 0x426142014176, 0x426242014176, 0x426342014176, 0x426442014176, 0x426542014176, 0x426642014176, 0x426742014176, 0x426842014176, 0x426942014176, 0x426A42014176, 0x426B42014176, 0x42024176, 0x425142024176, 0x425242024176, 0x425342024176, 0x425442024176, 0x425542024176, 0x425642024176, 0x425742024176, 0x425842024176, 0x425942024176, 0x425A42024176, 0x425B42024176, 0x425C42024176, 0x425D42024176, 0x425E42024176, 0x425F42024176,
+		0x426042024176, 0x426142024176, 0x426242024176, 0x426342024176, 0x426442024176, 0x426542024176, 0x426642024176, 0x426742024176, 0x426842024176, 0x426942024176, 0x426A42024176, 0x426B42024176, 0x42034176, 0x425142034176, 0x425242034176, 0x425342034176, 0x425442034176, 0x425542034176, 0x425642034176, 0x425742034176, 0x425842034176, 0x425942034176, 0x425A42034176, 0x425B42034176, 0x425C42034176, 0x425D42034176, 0x425E42034176, 0x425F42034176, 0x426042034176, 0x426142034176, 0x426242034176, 0x426342034176,
+		0x426442034176, 0x426542034176, 0x426642034176, 0x426742034176, 0x426842034176, 0x426942034176, 0x426A42034176, 0x426B42034176, 0x42044176, 0x425142044176, 0x425242044176, 0x425342044176, 0x425442044176, 0x425542044176, 0x425642044176, 0x425742044176, 0x425842044176, 0x425942044176, 0x425A42044176, 0x425B42044176, 0x425C42044176, 0x425D42044176, 0x425E42044176, 0x425F42044176, 0x426042044176, 0x426142044176, 0x426242044176, 0x426342044176, 0x426442044176, 0x426542044176, 0x426642044176, 0x426742044176,
+		0x426842044176, 0x426942044176, 0x426A42044176, 0x426B42044176, 0x42054176, 0x425142054176, 0x425242054176, 0x425342054176, 0x425442054176, 0x425542054176, 0x425642054176, 0x425742054176, 0x425842054176, 0x425942054176, 0x425A42054176, 0x425B42054176, 0x425C42054176, 0x425D42054176, 0x425E42054176, 0x425F42054176, 0x426042054176, 0x426142054176, 0x426242054176, 0x426342054176, 0x426442054176, 0x426542054176, 0x426642054176, 0x426742054176, 0x426842054176, 0x426942054176, 0x426A42054176, 0x426B42054176,
+		0x42064176, 0x425142064176, 0x425242064176, 0x425342064176, 0x425442064176, 0x425542064176, 0x425642064176, 0x425742064176, 0x425842064176, 0x425942064176, 0x425A42064176, 0x425B42064176, 0x425C42064176, 0x425D42064176, 0x425E42064176, 0x425F42064176, 0x426042064176, 0x426142064176, 0x426242064176, 0x426342064176, 0x426442064176, 0x426542064176, 0x426642064176, 0x426742064176, 0x426842064176, 0x426942064176, 0x426A42064176, 0x426B42064176, 0x42074176, 0x425142074176, 0x425242074176, 0x425342074176,
+		0x425442074176, 0x425542074176, 0x425642074176, 0x425742074176, 0x425842074176, 0x425942074176, 0x425A42074176, 0x425B42074176, 0x425C42074176, 0x425D42074176, 0x425E42074176, 0x425F42074176, 0x426042074176, 0x426142074176, 0x426242074176, 0x426342074176, 0x426442074176, 0x426542074176, 0x426642074176, 0x426742074176, 0x426842074176, 0x426942074176, 0x426A42074176, 0x426B42074176, 0x41F34177, 0x425141F34177, 0x425241F34177, 0x425341F34177, 0x425441F34177, 0x425541F34177, 0x425641F34177, 0x425741F34177,
+		0x425841F34177, 0x425941F34177, 0x425A41F34177, 0x425B41F34177, 0x425C41F34177, 0x425D41F34177, 0x425E41F34177, 0x425F41F34177, 0x426041F34177, 0x426141F34177, 0x426241F34177, 0x426341F34177, 0x426441F34177, 0x426541F34177, 0x426641F34177, 0x426741F34177, 0x426841F34177, 0x426941F34177, 0x426A41F34177, 0x426B41F34177, 0x41F44177, 0x425141F44177, 0x425241F44177, 0x425341F44177, 0x425441F44177, 0x425541F44177, 0x425641F44177, 0x425741F44177, 0x425841F44177, 0x425941F44177, 0x425A41F44177, 0x425B41F44177,
+		0x425C41F44177, 0x425D41F44177, 0x425E41F44177, 0x425F41F44177, 0x426041F44177, 0x426141F44177, 0x426241F44177, 0x426341F44177, 0x426441F44177, 0x426541F44177, 0x426641F44177, 0x426741F44177, 0x426841F44177, 0x426941F44177, 0x426A41F44177, 0x426B41F44177, 0x41F54177, 0x425141F54177, 0x425241F54177, 0x425341F54177, 0x425441F54177, 0x425541F54177, 0x425641F54177, 0x425741F54177, 0x425841F54177, 0x425941F54177, 0x425A41F54177, 0x425B41F54177, 0x425C41F54177, 0x425D41F54177, 0x425E41F54177, 0x425F41F54177,
+		0x426041F54177, 0x426141F54177, 0x426241F54177, 0x426341F54177, 0x426441F54177, 0x426541F54177, 0x426641F54177, 0x426741F54177, 0x426841F54177, 0x426941F54177, 0x426A41F54177, 0x426B41F54177, 0x41F64177, 0x425141F64177, 0x425241F64177, 0x425341F64177, 0x425441F64177, 0x425541F64177, 0x425641F64177, 0x425741F64177, 0x425841F64177, 0x425941F64177, 0x425A41F64177, 0x425B41F64177, 0x425C41F64177, 0x425D41F64177, 0x425E41F64177, 0x425F41F64177, 0x426041F64177, 0x426141F64177, 0x426241F64177, 0x426341F64177,
+		0x426441F64177, 0x426541F64177, 0x426641F64177, 0x426741F64177, 0x426841F64177, 0x426941F64177, 0x426A41F64177, 0x426B41F64177, 0x41F74177, 0x425141F74177, 0x425241F74177, 0x425341F74177, 0x425441F74177, 0x425541F74177, 0x425641F74177, 0x425741F74177, 0x425841F74177, 0x425941F74177, 0x425A41F74177, 0x425B41F74177, 0x425C41F74177, 0x425D41F74177, 0x425E41F74177, 0x425F41F74177, 0x426041F74177, 0x426141F74177, 0x426241F74177, 0x426341F74177, 0x426441F74177, 0x426541F74177, 0x426641F74177, 0x426741F74177,
+		0x426841F74177, 0x426941F74177, 0x426A41F74177, 0x426B41F74177, 0x41F84177, 0x425141F84177, 0x425241F84177, 0x425341F84177, 0x425441F84177, 0x425541F84177, 0x425641F84177, 0x425741F84177, 0x425841F84177, 0x425941F84177, 0x425A41F84177, 0x425B41F84177, 0x425C41F84177, 0x425D41F84177, 0x425E41F84177, 0x425F41F84177, 0x426041F84177, 0x426141F84177, 0x426241F84177, 0x426341F84177, 0x426441F84177, 0x426541F84177, 0x426641F84177, 0x426741F84177, 0x426841F84177, 0x426941F84177, 0x426A41F84177, 0x426B41F84177,
+		0x41F94177, 0x425141F94177, 0x425241F94177, 0x425341F94177, 0x425441F94177, 0x425541F94177, 0x425641F94177, 0x425741F94177, 0x425841F94177, 0x425941F94177, 0x425A41F94177, 0x425B41F94177, 0x425C41F94177, 0x425D41F94177, 0x425E41F94177, 0x425F41F94177, 0x426041F94177, 0x426141F94177, 0x426241F94177, 0x426341F94177, 0x426441F94177, 0x426541F94177, 0x426641F94177, 0x426741F94177, 0x426841F94177, 0x426941F94177, 0x426A41F94177, 0x426B41F94177, 0x41FA4177, 0x425141FA4177, 0x425241FA4177, 0x425341FA4177,
+		0x425441FA4177, 0x425541FA4177, 0x425641FA4177, 0x425741FA4177, 0x425841FA4177, 0x425941FA4177, 0x425A41FA4177, 0x425B41FA4177, 0x425C41FA4177, 0x425D41FA4177, 0x425E41FA4177, 0x425F41FA4177, 0x426041FA4177, 0x426141FA4177, 0x426241FA4177, 0x426341FA4177, 0x426441FA4177, 0x426541FA4177, 0x426641FA4177, 0x426741FA4177, 0x426841FA4177, 0x426941FA4177, 0x426A41FA4177, 0x426B41FA4177, 0x41FB4177, 0x425141FB4177, 0x425241FB4177, 0x425341FB4177, 0x425441FB4177, 0x425541FB4177, 0x425641FB4177, 0x425741FB4177,
+		0x425841FB4177, 0x425941FB4177, 0x425A41FB4177, 0x425B41FB4177, 0x425C41FB4177, 0x425D41FB4177, 0x425E41FB4177, 0x425F41FB4177, 0x426041FB4177, 0x426141FB4177, 0x426241FB4177, 0x426341FB4177, 0x426441FB4177, 0x426541FB4177, 0x426641FB4177, 0x426741FB4177, 0x426841FB4177, 0x426941FB4177, 0x426A41FB4177, 0x426B41FB4177, 0x41FC4177, 0x425141FC4177, 0x425241FC4177, 0x425341FC4177, 0x425441FC4177, 0x425541FC4177, 0x425641FC4177, 0x425741FC4177, 0x425841FC4177, 0x425941FC4177, 0x425A41FC4177, 0x425B41FC4177,
+		0x425C41FC4177, 0x425D41FC4177, 0x425E41FC4177, 0x425F41FC4177, 0x426041FC4177, 0x426141FC4177, 0x426241FC4177, 0x426341FC4177, 0x426441FC4177, 0x426541FC4177, 0x426641FC4177, 0x426741FC4177, 0x426841FC4177, 0x426941FC4177, 0x426A41FC4177, 0x426B41FC4177, 0x41FD4177, 0x425141FD4177, 0x425241FD4177, 0x425341FD4177, 0x425441FD4177, 0x425541FD4177, 0x425641FD4177, 0x425741FD4177, 0x425841FD4177, 0x425941FD4177, 0x425A41FD4177, 0x425B41FD4177, 0x425C41FD4177, 0x425D41FD4177, 0x425E41FD4177, 0x425F41FD4177,
+		0x426041FD4177, 0x426141FD4177, 0x426241FD4177, 0x426341FD4177, 0x426441FD4177, 0x426541FD4177, 0x426641FD4177, 0x426741FD4177, 0x426841FD4177, 0x426941FD4177, 0x426A41FD4177, 0x426B41FD4177, 0x41FE4177, 0x425141FE4177, 0x425241FE4177, 0x425341FE4177, 0x425441FE4177, 0x425541FE4177, 0x425641FE4177, 0x425741FE4177, 0x425841FE4177, 0x425941FE4177, 0x425A41FE4177, 0x425B41FE4177, 0x425C41FE4177, 0x425D41FE4177, 0x425E41FE4177, 0x425F41FE4177, 0x426041FE4177, 0x426141FE4177, 0x426241FE4177, 0x426341FE4177,
+		0x426441FE4177, 0x426541FE4177, 0x426641FE4177, 0x426741FE4177, 0x426841FE4177, 0x426941FE4177, 0x426A41FE4177, 0x426B41FE4177, 0x41FF4177, 0x425141FF4177, 0x425241FF4177, 0x425341FF4177, 0x425441FF4177, 0x425541FF4177, 0x425641FF4177, 0x425741FF4177, 0x425841FF4177, 0x425941FF4177, 0x425A41FF4177, 0x425B41FF4177, 0x425C41FF4177, 0x425D41FF4177, 0x425E41FF4177, 0x425F41FF4177, 0x426041FF4177, 0x426141FF4177, 0x426241FF4177, 0x426341FF4177, 0x426441FF4177, 0x426541FF4177, 0x426641FF4177, 0x426741FF4177,
+		0x426841FF4177, 0x426941FF4177, 0x426A41FF4177, 0x426B41FF4177, 0x42004177, 0x425142004177, 0x425242004177, 0x425342004177, 0x425442004177, 0x425542004177, 0x425642004177, 0x425742004177, 0x425842004177, 0x425942004177, 0x425A42004177, 0x425B42004177, 0x425C42004177, 0x425D42004177, 0x425E42004177, 0x425F42004177, 0x426042004177, 0x426142004177, 0x426242004177, 0x426342004177, 0x426442004177, 0x426542004177, 0x426642004177, 0x426742004177, 0x426842004177, 0x426942004177, 0x426A42004177, 0x426B42004177,
+		0x42014177, 0x425142014177, 0x425242014177, 0x425342014177, 0x425442014177, 0x425542014177, 0x425642014177, 0x425742014177, 0x425842014177, 0x425942014177, 0x425A42014177, 0x425B42014177, 0x425C42014177, 0x425D42014177, 0x425E42014177, 0x425F42014177, 0x426042014177, 0x426142014177, 0x426242014177, 0x426342014177, 0x426442014177, 0x426542014177, 0x426642014177, 0x426742014177, 0x426842014177, 0x426942014177, 0x426A42014177, 0x426B42014177, 0x42024177, 0x425142024177, 0x425242024177, 0x425342024177,
+		0x425442024177, 0x425542024177, 0x425642024177, 0x425742024177, 0x425842024177, 0x425942024177, 0x425A42024177, 0x425B42024177, 0x425C42024177, 0x425D42024177, 0x425E42024177, 0x425F42024177, 0x426042024177, 0x426142024177, 0x426242024177, 0x426342024177, 0x426442024177, 0x426542024177, 0x426642024177, 0x426742024177, 0x426842024177, 0x426942024177, 0x426A42024177, 0x426B42024177, 0x42034177, 0x425142034177, 0x425242034177, 0x425342034177, 0x425442034177, 0x425542034177, 0x425642034177, 0x425742034177,
+		0x425842034177, 0x425942034177, 0x425A42034177, 0x425B42034177, 0x425C42034177, 0x425D42034177, 0x425E42034177, 0x425F42034177, 0x426042034177, 0x426142034177, 0x426242034177, 0x426342034177, 0x426442034177, 0x426542034177, 0x426642034177, 0x426742034177, 0x426842034177, 0x426942034177, 0x426A42034177, 0x426B42034177, 0x42044177, 0x425142044177, 0x425242044177, 0x425342044177, 0x425442044177, 0x425542044177, 0x425642044177, 0x425742044177, 0x425842044177, 0x425942044177, 0x425A42044177, 0x425B42044177,
+		0x425C42044177, 0x425D42044177, 0x425E42044177, 0x425F42044177, 0x426042044177, 0x426142044177, 0x426242044177, 0x426342044177, 0x426442044177, 0x426542044177, 0x426642044177, 0x426742044177, 0x426842044177, 0x426942044177, 0x426A42044177, 0x426B42044177, 0x42054177, 0x425142054177, 0x425242054177, 0x425342054177, 0x425442054177, 0x425542054177, 0x425642054177, 0x425742054177, 0x425842054177, 0x425942054177, 0x425A42054177, 0x425B42054177, 0x425C42054177, 0x425D42054177, 0x425E42054177, 0x425F42054177,
+		0x426042054177, 0x426142054177, 0x426242054177, 0x426342054177, 0x426442054177, 0x426542054177, 0x426642054177, 0x426742054177, 0x426842054177, 0x426942054177, 0x426A42054177, 0x426B42054177, 0x42064177, 0x425142064177, 0x425242064177, 0x425342064177, 0x425442064177, 0x425542064177, 0x425642064177, 0x425742064177, 0x425842064177, 0x425942064177, 0x425A42064177, 0x425B42064177, 0x425C42064177, 0x425D42064177, 0x425E42064177, 0x425F42064177, 0x426042064177, 0x426142064177, 0x426242064177, 0x426342064177,
+		0x426442064177, 0x426542064177, 0x426642064177, 0x426742064177, 0x426842064177, 0x426942064177, 0x426A42064177, 0x426B42064177, 0x42074177, 0x425142074177, 0x425242074177, 0x425342074177, 0x425442074177, 0x425542074177, 0x425642074177, 0x425742074177, 0x425842074177, 0x425942074177, 0x425A42074177, 0x425B42074177, 0x425C42074177, 0x425D42074177, 0x425E42074177, 0x425F42074177, 0x426042074177, 0x426142074177, 0x426242074177, 0x426342074177, 0x426442074177, 0x426542074177, 0x426642074177, 0x426742074177,
+		0x426842074177, 0x426942074177, 0x426A42074177, 0x426B42074177, 0x41F34178, 0x425141F34178, 0x425241F34178, 0x425341F34178, 0x425441F34178, 0x425541F34178, 0x425641F34178, 0x425741F34178, 0x425841F34178, 0x425941F34178, 0x425A41F34178, 0x425B41F34178, 0x425C41F34178, 0x425D41F34178, 0x425E41F34178, 0x425F41F34178, 0x426041F34178, 0x426141F34178, 0x426241F34178, 0x426341F34178, 0x426441F34178, 0x426541F34178, 0x426641F34178, 0x426741F34178, 0x426841F34178, 0x426941F34178, 0x426A41F34178, 0x426B41F34178,
+		0x41F44178, 0x425141F44178, 0x425241F44178, 0x425341F44178, 0x425441F44178, 0x425541F44178, 0x425641F44178, 0x425741F44178, 0x425841F44178, 0x425941F44178, 0x425A41F44178, 0x425B41F44178, 0x425C41F44178, 0x425D41F44178, 0x425E41F44178, 0x425F41F44178, 0x426041F44178, 0x426141F44178, 0x426241F44178, 0x426341F44178, 0x426441F44178, 0x426541F44178, 0x426641F44178, 0x426741F44178, 0x426841F44178, 0x426941F44178, 0x426A41F44178, 0x426B41F44178, 0x41F54178, 0x425141F54178, 0x425241F54178, 0x425341F54178,
+		0x425441F54178, 0x425541F54178, 0x425641F54178, 0x425741F54178, 0x425841F54178, 0x425941F54178, 0x425A41F54178, 0x425B41F54178, 0x425C41F54178, 0x425D41F54178, 0x425E41F54178, 0x425F41F54178, 0x426041F54178, 0x426141F54178, 0x426241F54178, 0x426341F54178, 0x426441F54178, 0x426541F54178, 0x426641F54178, 0x426741F54178, 0x426841F54178, 0x426941F54178, 0x426A41F54178, 0x426B41F54178, 0x41F64178, 0x425141F64178, 0x425241F64178, 0x425341F64178, 0x425441F64178, 0x425541F64178, 0x425641F64178, 0x425741F64178,
+		0x425841F64178, 0x425941F64178, 0x425A41F64178, 0x425B41F64178, 0x425C41F64178, 0x425D41F64178, 0x425E41F64178, 0x425F41F64178, 0x426041F64178, 0x426141F64178, 0x426241F64178, 0x426341F64178, 0x426441F64178, 0x426541F64178, 0x426641F64178, 0x426741F64178, 0x426841F64178, 0x426941F64178, 0x426A41F64178, 0x426B41F64178, 0x41F74178, 0x425141F74178, 0x425241F74178, 0x425341F74178, 0x425441F74178, 0x425541F74178, 0x425641F74178, 0x425741F74178, 0x425841F74178, 0x425941F74178, 0x425A41F74178, 0x425B41F74178,
+		0x425C41F74178, 0x425D41F74178, 0x425E41F74178, 0x425F41F74178, 0x426041F74178, 0x426141F74178, 0x426241F74178, 0x426341F74178, 0x426441F74178, 0x426541F74178, 0x426641F74178, 0x426741F74178, 0x426841F74178, 0x426941F74178, 0x426A41F74178, 0x426B41F74178, 0x41F84178, 0x425141F84178, 0x425241F84178, 0x425341F84178, 0x425441F84178, 0x425541F84178, 0x425641F84178, 0x425741F84178, 0x425841F84178, 0x425941F84178, 0x425A41F84178, 0x425B41F84178, 0x425C41F84178, 0x425D41F84178, 0x425E41F84178, 0x425F41F84178,
+		0x426041F84178, 0x426141F84178, 0x426241F84178, 0x426341F84178, 0x426441F84178, 0x426541F84178, 0x426641F84178, 0x426741F84178, 0x426841F84178, 0x426941F84178, 0x426A41F84178, 0x426B41F84178, 0x41F94178, 0x425141F94178, 0x425241F94178, 0x425341F94178, 0x425441F94178, 0x425541F94178, 0x425641F94178, 0x425741F94178, 0x425841F94178, 0x425941F94178, 0x425A41F94178, 0x425B41F94178, 0x425C41F94178, 0x425D41F94178, 0x425E41F94178, 0x425F41F94178, 0x426041F94178, 0x426141F94178, 0x426241F94178, 0x426341F94178,
+		0x426441F94178, 0x426541F94178, 0x426641F94178, 0x426741F94178, 0x426841F94178, 0x426941F94178, 0x426A41F94178, 0x426B41F94178, 0x41FA4178, 0x425141FA4178, 0x425241FA4178, 0x425341FA4178, 0x425441FA4178, 0x425541FA4178, 0x425641FA4178, 0x425741FA4178, 0x425841FA4178, 0x425941FA4178, 0x425A41FA4178, 0x425B41FA4178, 0x425C41FA4178, 0x425D41FA4178, 0x425E41FA4178, 0x425F41FA4178, 0x426041FA4178, 0x426141FA4178, 0x426241FA4178, 0x426341FA4178, 0x426441FA4178, 0x426541FA4178, 0x426641FA4178, 0x426741FA4178,
+		0x426841FA4178, 0x426941FA4178, 0x426A41FA4178, 0x426B41FA4178, 0x41FB4178, 0x425141FB4178, 0x425241FB4178, 0x425341FB4178, 0x425441FB4178, 0x425541FB4178, 0x425641FB4178, 0x425741FB4178, 0x425841FB4178, 0x425941FB4178, 0x425A41FB4178, 0x425B41FB4178, 0x425C41FB4178, 0x425D41FB4178, 0x425E41FB4178, 0x425F41FB4178, 0x426041FB4178, 0x426141FB4178, 0x426241FB4178, 0x426341FB4178, 0x426441FB4178, 0x426541FB4178, 0x426641FB4178, 0x426741FB4178, 0x426841FB4178, 0x426941FB4178, 0x426A41FB4178, 0x426B41FB4178,
+		0x41FC4178, 0x425141FC4178, 0x425241FC4178, 0x425341FC4178, 0x425441FC4178, 0x425541FC4178, 0x425641FC4178, 0x425741FC4178, 0x425841FC4178, 0x425941FC4178, 0x425A41FC4178, 0x425B41FC4178, 0x425C41FC4178, 0x425D41FC4178, 0x425E41FC4178, 0x425F41FC4178, 0x426041FC4178, 0x426141FC4178, 0x426241FC4178, 0x426341FC4178, 0x426441FC4178, 0x426541FC4178, 0x426641FC4178, 0x426741FC4178, 0x426841FC4178, 0x426941FC4178, 0x426A41FC4178, 0x426B41FC4178, 0x41FD4178, 0x425141FD4178, 0x425241FD4178, 0x425341FD4178,
+		0x425441FD4178, 0x425541FD4178, 0x425641FD4178, 0x425741FD4178, 0x425841FD4178, 0x425941FD4178, 0x425A41FD4178, 0x425B41FD4178, 0x425C41FD4178, 0x425D41FD4178, 0x425E41FD4178, 0x425F41FD4178, 0x426041FD4178, 0x426141FD4178, 0x426241FD4178, 0x426341FD4178, 0x426441FD4178, 0x426541FD4178, 0x426641FD4178, 0x426741FD4178, 0x426841FD4178, 0x426941FD4178, 0x426A41FD4178, 0x426B41FD4178, 0x41FE4178, 0x425141FE4178, 0x425241FE4178, 0x425341FE4178, 0x425441FE4178, 0x425541FE4178, 0x425641FE4178, 0x425741FE4178,
+		0x425841FE4178, 0x425941FE4178, 0x425A41FE4178, 0x425B41FE4178, 0x425C41FE4178, 0x425D41FE4178, 0x425E41FE4178, 0x425F41FE4178, 0x426041FE4178, 0x426141FE4178, 0x426241FE4178, 0x426341FE4178, 0x426441FE4178, 0x426541FE4178, 0x426641FE4178, 0x426741FE4178, 0x426841FE4178, 0x426941FE4178, 0x426A41FE4178, 0x426B41FE4178, 0x41FF4178, 0x425141FF4178, 0x425241FF4178, 0x425341FF4178, 0x425441FF4178, 0x425541FF4178, 0x425641FF4178, 0x425741FF4178, 0x425841FF4178, 0x425941FF4178, 0x425A41FF4178, 0x425B41FF4178,
+		0x425C41FF4178, 0x425D41FF4178, 0x425E41FF4178, 0x425F41FF4178, 0x426041FF4178, 0x426141FF4178, 0x426241FF4178, 0x426341FF4178, 0x426441FF4178, 0x426541FF4178, 0x426641FF4178, 0x426741FF4178, 0x426841FF4178, 0x426941FF4178, 0x426A41FF4178, 0x426B41FF4178, 0x42004178, 0x425142004178, 0x425242004178, 0x425342004178, 0x425442004178, 0x425542004178, 0x425642004178, 0x425742004178, 0x425842004178, 0x425942004178, 0x425A42004178, 0x425B42004178, 0x425C42004178, 0x425D42004178, 0x425E42004178, 0x425F42004178,
+		0x426042004178, 0x426142004178, 0x426242004178, 0x426342004178, 0x426442004178, 0x426542004178, 0x426642004178, 0x426742004178, 0x426842004178, 0x426942004178, 0x426A42004178, 0x426B42004178, 0x42014178, 0x425142014178, 0x425242014178, 0x425342014178, 0x425442014178, 0x425542014178, 0x425642014178, 0x425742014178, 0x425842014178, 0x425942014178, 0x425A42014178, 0x425B42014178, 0x425C42014178, 0x425D42014178, 0x425E42014178, 0x425F42014178, 0x426042014178, 0x426142014178, 0x426242014178, 0x426342014178,
+		0x426442014178, 0x426542014178, 0x426642014178, 0x426742014178, 0x426842014178, 0x426942014178, 0x426A42014178, 0x426B42014178, 0x42024178, 0x425142024178, 0x425242024178, 0x425342024178, 0x425442024178, 0x425542024178, 0x425642024178, 0x425742024178, 0x425842024178, 0x425942024178, 0x425A42024178, 0x425B42024178, 0x425C42024178, 0x425D42024178, 0x425E42024178, 0x425F42024178, 0x426042024178, 0x426142024178, 0x426242024178, 0x426342024178, 0x426442024178, 0x426542024178, 0x426642024178, 0x426742024178,
+		0x426842024178, 0x426942024178, 0x426A42024178, 0x426B42024178, 0x42034178, 0x425142034178, 0x425242034178, 0x425342034178, 0x425442034178, 0x425542034178, 0x425642034178, 0x425742034178, 0x425842034178, 0x425942034178, 0x425A42034178, 0x425B42034178, 0x425C42034178, 0x425D42034178, 0x425E42034178, 0x425F42034178, 0x426042034178, 0x426142034178, 0x426242034178, 0x426342034178, 0x426442034178, 0x426542034178, 0x426642034178, 0x426742034178, 0x426842034178, 0x426942034178, 0x426A42034178, 0x426B42034178,
+		0x42044178, 0x425142044178, 0x425242044178, 0x425342044178, 0x425442044178, 0x425542044178, 0x425642044178, 0x425742044178, 0x425842044178, 0x425942044178, 0x425A42044178, 0x425B42044178, 0x425C42044178, 0x425D42044178, 0x425E42044178, 0x425F42044178, 0x426042044178, 0x426142044178, 0x426242044178, 0x426342044178, 0x426442044178, 0x426542044178, 0x426642044178, 0x426742044178, 0x426842044178, 0x426942044178, 0x426A42044178, 0x426B42044178, 0x42054178, 0x425142054178, 0x425242054178, 0x425342054178,
+		0x425442054178, 0x425542054178, 0x425642054178, 0x425742054178, 0x425842054178, 0x425942054178, 0x425A42054178, 0x425B42054178, 0x425C42054178, 0x425D42054178, 0x425E42054178, 0x425F42054178, 0x426042054178, 0x426142054178, 0x426242054178, 0x426342054178, 0x426442054178, 0x426542054178, 0x426642054178, 0x426742054178, 0x426842054178, 0x426942054178, 0x426A42054178, 0x426B42054178, 0x42064178, 0x425142064178, 0x425242064178, 0x425342064178, 0x425442064178, 0x425542064178, 0x425642064178, 0x425742064178,
+		0x425842064178, 0x425942064178, 0x425A42064178, 0x425B42064178, 0x425C42064178, 0x425D42064178, 0x425E42064178, 0x425F42064178, 0x426042064178, 0x426142064178, 0x426242064178, 0x426342064178, 0x426442064178, 0x426542064178, 0x426642064178, 0x426742064178, 0x426842064178, 0x426942064178, 0x426A42064178, 0x426B42064178, 0x42074178, 0x425142074178, 0x425242074178, 0x425342074178, 0x425442074178, 0x425542074178, 0x425642074178, 0x425742074178, 0x425842074178, 0x425942074178, 0x425A42074178, 0x425B42074178,
+		0x425C42074178, 0x425D42074178, 0x425E42074178, 0x425F42074178, 0x426042074178, 0x426142074178, 0x426242074178, 0x426342074178, 0x426442074178, 0x426542074178, 0x426642074178, 0x426742074178, 0x426842074178, 0x426942074178, 0x426A42074178, 0x426B42074178, 0x41F34179, 0x425141F34179, 0x425241F34179, 0x425341F34179, 0x425441F34179, 0x425541F34179, 0x425641F34179, 0x425741F34179, 0x425841F34179, 0x425941F34179, 0x425A41F34179, 0x425B41F34179, 0x425C41F34179, 0x425D41F34179, 0x425E41F34179, 0x425F41F34179,
+		0x426041F34179, 0x426141F34179, 0x426241F34179, 0x426341F34179, 0x426441F34179, 0x426541F34179, 0x426641F34179, 0x426741F34179, 0x426841F34179, 0x426941F34179, 0x426A41F34179, 0x426B41F34179, 0x41F44179, 0x425141F44179, 0x425241F44179, 0x425341F44179, 0x425441F44179, 0x425541F44179, 0x425641F44179, 0x425741F44179, 0x425841F44179, 0x425941F44179, 0x425A41F44179, 0x425B41F44179, 0x425C41F44179, 0x425D41F44179, 0x425E41F44179, 0x425F41F44179, 0x426041F44179, 0x426141F44179, 0x426241F44179, 0x426341F44179,
+		0x426441F44179, 0x426541F44179, 0x426641F44179, 0x426741F44179, 0x426841F44179, 0x426941F44179, 0x426A41F44179, 0x426B41F44179, 0x41F54179, 0x425141F54179, 0x425241F54179, 0x425341F54179, 0x425441F54179, 0x425541F54179, 0x425641F54179, 0x425741F54179, 0x425841F54179, 0x425941F54179, 0x425A41F54179, 0x425B41F54179, 0x425C41F54179, 0x425D41F54179, 0x425E41F54179, 0x425F41F54179, 0x426041F54179, 0x426141F54179, 0x426241F54179, 0x426341F54179, 0x426441F54179, 0x426541F54179, 0x426641F54179, 0x426741F54179,
+		0x426841F54179, 0x426941F54179, 0x426A41F54179, 0x426B41F54179, 0x41F64179, 0x425141F64179, 0x425241F64179, 0x425341F64179, 0x425441F64179, 0x425541F64179, 0x425641F64179, 0x425741F64179, 0x425841F64179, 0x425941F64179, 0x425A41F64179, 0x425B41F64179, 0x425C41F64179, 0x425D41F64179, 0x425E41F64179, 0x425F41F64179, 0x426041F64179, 0x426141F64179, 0x426241F64179, 0x426341F64179, 0x426441F64179, 0x426541F64179, 0x426641F64179, 0x426741F64179, 0x426841F64179, 0x426941F64179, 0x426A41F64179, 0x426B41F64179,
+		0x41F74179, 0x425141F74179, 0x425241F74179, 0x425341F74179, 0x425441F74179, 0x425541F74179, 0x425641F74179, 0x425741F74179, 0x425841F74179, 0x425941F74179, 0x425A41F74179, 0x425B41F74179, 0x425C41F74179, 0x425D41F74179, 0x425E41F74179, 0x425F41F74179, 0x426041F74179, 0x426141F74179, 0x426241F74179, 0x426341F74179, 0x426441F74179, 0x426541F74179, 0x426641F74179, 0x426741F74179, 0x426841F74179, 0x426941F74179, 0x426A41F74179, 0x426B41F74179, 0x41F84179, 0x425141F84179, 0x425241F84179, 0x425341F84179,
+		0x425441F84179, 0x425541F84179, 0x425641F84179, 0x425741F84179, 0x425841F84179, 0x425941F84179, 0x425A41F84179, 0x425B41F84179, 0x425C41F84179, 0x425D41F84179, 0x425E41F84179, 0x425F41F84179, 0x426041F84179, 0x426141F84179, 0x426241F84179, 0x426341F84179, 0x426441F84179, 0x426541F84179, 0x426641F84179, 0x426741F84179, 0x426841F84179, 0x426941F84179, 0x426A41F84179, 0x426B41F84179, 0x41F94179, 0x425141F94179, 0x425241F94179, 0x425341F94179, 0x425441F94179, 0x425541F94179, 0x425641F94179, 0x425741F94179,
+		0x425841F94179, 0x425941F94179, 0x425A41F94179, 0x425B41F94179, 0x425C41F94179, 0x425D41F94179, 0x425E41F94179, 0x425F41F94179, 0x426041F94179, 0x426141F94179, 0x426241F94179, 0x426341F94179, 0x426441F94179, 0x426541F94179, 0x426641F94179, 0x426741F94179, 0x426841F94179, 0x426941F94179, 0x426A41F94179, 0x426B41F94179, 0x41FA4179, 0x425141FA4179, 0x425241FA4179, 0x425341FA4179, 0x425441FA4179, 0x425541FA4179, 0x425641FA4179, 0x425741FA4179, 0x425841FA4179, 0x425941FA4179, 0x425A41FA4179, 0x425B41FA4179,
+		0x425C41FA4179, 0x425D41FA4179, 0x425E41FA4179, 0x425F41FA4179, 0x426041FA4179, 0x426141FA4179, 0x426241FA4179, 0x426341FA4179, 0x426441FA4179, 0x426541FA4179, 0x426641FA4179, 0x426741FA4179, 0x426841FA4179, 0x426941FA4179, 0x426A41FA4179, 0x426B41FA4179, 0x41FB4179, 0x425141FB4179, 0x425241FB4179, 0x425341FB4179, 0x425441FB4179, 0x425541FB4179, 0x425641FB4179, 0x425741FB4179, 0x425841FB4179, 0x425941FB4179, 0x425A41FB4179, 0x425B41FB4179, 0x425C41FB4179, 0x425D41FB4179, 0x425E41FB4179, 0x425F41FB4179,
+		0x426041FB4179, 0x426141FB4179, 0x426241FB4179, 0x426341FB4179, 0x426441FB4179, 0x426541FB4179, 0x426641FB4179, 0x426741FB4179, 0x426841FB4179, 0x426941FB4179, 0x426A41FB4179, 0x426B41FB4179, 0x41FC4179, 0x425141FC4179, 0x425241FC4179, 0x425341FC4179, 0x425441FC4179, 0x425541FC4179, 0x425641FC4179, 0x425741FC4179, 0x425841FC4179, 0x425941FC4179, 0x425A41FC4179, 0x425B41FC4179, 0x425C41FC4179, 0x425D41FC4179, 0x425E41FC4179, 0x425F41FC4179, 0x426041FC4179, 0x426141FC4179, 0x426241FC4179, 0x426341FC4179,
+		0x426441FC4179, 0x426541FC4179, 0x426641FC4179, 0x426741FC4179, 0x426841FC4179, 0x426941FC4179, 0x426A41FC4179, 0x426B41FC4179, 0x41FD4179, 0x425141FD4179, 0x425241FD4179, 0x425341FD4179, 0x425441FD4179, 0x425541FD4179, 0x425641FD4179, 0x425741FD4179, 0x425841FD4179, 0x425941FD4179, 0x425A41FD4179, 0x425B41FD4179, 0x425C41FD4179, 0x425D41FD4179, 0x425E41FD4179, 0x425F41FD4179, 0x426041FD4179, 0x426141FD4179, 0x426241FD4179, 0x426341FD4179, 0x426441FD4179, 0x426541FD4179, 0x426641FD4179, 0x426741FD4179,
+		0x426841FD4179, 0x426941FD4179, 0x426A41FD4179, 0x426B41FD4179, 0x41FE4179, 0x425141FE4179, 0x425241FE4179, 0x425341FE4179, 0x425441FE4179, 0x425541FE4179, 0x425641FE4179, 0x425741FE4179, 0x425841FE4179, 0x425941FE4179, 0x425A41FE4179, 0x425B41FE4179, 0x425C41FE4179, 0x425D41FE4179, 0x425E41FE4179, 0x425F41FE4179, 0x426041FE4179, 0x426141FE4179, 0x426241FE4179, 0x426341FE4179, 0x426441FE4179, 0x426541FE4179, 0x426641FE4179, 0x426741FE4179, 0x426841FE4179, 0x426941FE4179, 0x426A41FE4179, 0x426B41FE4179,
+		0x41FF4179, 0x425141FF4179, 0x425241FF4179, 0x425341FF4179, 0x425441FF4179, 0x425541FF4179, 0x425641FF4179, 0x425741FF4179, 0x425841FF4179, 0x425941FF4179, 0x425A41FF4179, 0x425B41FF4179, 0x425C41FF4179, 0x425D41FF4179, 0x425E41FF4179, 0x425F41FF4179, 0x426041FF4179, 0x426141FF4179, 0x426241FF4179, 0x426341FF4179, 0x426441FF4179, 0x426541FF4179, 0x426641FF4179, 0x426741FF4179, 0x426841FF4179, 0x426941FF4179, 0x426A41FF4179, 0x426B41FF4179, 0x42004179, 0x425142004179, 0x425242004179, 0x425342004179,
+		0x425442004179, 0x425542004179, 0x425642004179, 0x425742004179, 0x425842004179, 0x425942004179, 0x425A42004179, 0x425B42004179, 0x425C42004179, 0x425D42004179, 0x425E42004179, 0x425F42004179, 0x426042004179, 0x426142004179, 0x426242004179, 0x426342004179, 0x426442004179, 0x426542004179, 0x426642004179, 0x426742004179, 0x426842004179, 0x426942004179, 0x426A42004179, 0x426B42004179, 0x42014179, 0x425142014179, 0x425242014179, 0x425342014179, 0x425442014179, 0x425542014179, 0x425642014179, 0x425742014179,
+		0x425842014179, 0x425942014179, 0x425A42014179, 0x425B42014179, 0x425C42014179, 0x425D42014179, 0x425E42014179, 0x425F42014179, 0x426042014179, 0x426142014179, 0x426242014179, 0x426342014179, 0x426442014179, 0x426542014179, 0x426642014179, 0x426742014179, 0x426842014179, 0x426942014179, 0x426A42014179, 0x426B42014179, 0x42024179, 0x425142024179, 0x425242024179, 0x425342024179, 0x425442024179, 0x425542024179, 0x425642024179, 0x425742024179, 0x425842024179, 0x425942024179, 0x425A42024179, 0x425B42024179,
+		0x425C42024179, 0x425D42024179, 0x425E42024179, 0x425F42024179, 0x426042024179, 0x426142024179, 0x426242024179, 0x426342024179, 0x426442024179, 0x426542024179, 0x426642024179, 0x426742024179, 0x426842024179, 0x426942024179, 0x426A42024179, 0x426B42024179, 0x42034179, 0x425142034179, 0x425242034179, 0x425342034179, 0x425442034179, 0x425542034179, 0x425642034179, 0x425742034179, 0x425842034179, 0x425942034179, 0x425A42034179, 0x425B42034179, 0x425C42034179, 0x425D42034179, 0x425E42034179, 0x425F42034179,
+		0x426042034179, 0x426142034179, 0x426242034179, 0x426342034179, 0x426442034179, 0x426542034179, 0x426642034179, 0x426742034179, 0x426842034179, 0x426942034179, 0x426A42034179, 0x426B42034179, 0x42044179, 0x425142044179, 0x425242044179, 0x425342044179, 0x425442044179, 0x425542044179, 0x425642044179, 0x425742044179, 0x425842044179, 0x425942044179, 0x425A42044179, 0x425B42044179, 0x425C42044179, 0x425D42044179, 0x425E42044179, 0x425F42044179, 0x426042044179, 0x426142044179, 0x426242044179, 0x426342044179,
+		0x426442044179, 0x426542044179, 0x426642044179, 0x426742044179, 0x426842044179, 0x426942044179, 0x426A42044179, 0x426B42044179, 0x42054179, 0x425142054179, 0x425242054179, 0x425342054179, 0x425442054179, 0x425542054179, 0x425642054179, 0x425742054179, 0x425842054179, 0x425942054179, 0x425A42054179, 0x425B42054179, 0x425C42054179, 0x425D42054179, 0x425E42054179, 0x425F42054179, 0x426042054179, 0x426142054179, 0x426242054179, 0x426342054179, 0x426442054179, 0x426542054179, 0x426642054179, 0x426742054179,
+		0x426842054179, 0x426942054179, 0x426A42054179, 0x426B42054179, 0x42064179, 0x425142064179, 0x425242064179, 0x425342064179, 0x425442064179, 0x425542064179, 0x425642064179, 0x425742064179, 0x425842064179, 0x425942064179, 0x425A42064179, 0x425B42064179, 0x425C42064179, 0x425D42064179, 0x425E42064179, 0x425F42064179, 0x426042064179, 0x426142064179, 0x426242064179, 0x426342064179, 0x426442064179, 0x426542064179, 0x426642064179, 0x426742064179, 0x426842064179, 0x426942064179, 0x426A42064179, 0x426B42064179,
+		0x42074179, 0x425142074179, 0x425242074179, 0x425342074179, 0x425442074179, 0x425542074179, 0x425642074179, 0x425742074179, 0x425842074179, 0x425942074179, 0x425A42074179, 0x425B42074179, 0x425C42074179, 0x425D42074179, 0x425E42074179, 0x425F42074179, 0x426042074179, 0x426142074179, 0x426242074179, 0x426342074179, 0x426442074179, 0x426542074179, 0x426642074179, 0x426742074179, 0x426842074179, 0x426942074179, 0x426A42074179, 0x426B42074179, 0x41F3417A, 0x425141F3417A, 0x425241F3417A, 0x425341F3417A,
+		0x425441F3417A, 0x425541F3417A, 0x425641F3417A, 0x425741F3417A, 0x425841F3417A, 0x425941F3417A, 0x425A41F3417A, 0x425B41F3417A, 0x425C41F3417A, 0x425D41F3417A, 0x425E41F3417A, 0x425F41F3417A, 0x426041F3417A, 0x426141F3417A, 0x426241F3417A, 0x426341F3417A, 0x426441F3417A, 0x426541F3417A, 0x426641F3417A, 0x426741F3417A, 0x426841F3417A, 0x426941F3417A, 0x426A41F3417A, 0x426B41F3417A, 0x41F4417A, 0x425141F4417A, 0x425241F4417A, 0x425341F4417A, 0x425441F4417A, 0x425541F4417A, 0x425641F4417A, 0x425741F4417A,
+		0x425841F4417A, 0x425941F4417A, 0x425A41F4417A, 0x425B41F4417A, 0x425C41F4417A, 0x425D41F4417A, 0x425E41F4417A, 0x425F41F4417A, 0x426041F4417A, 0x426141F4417A, 0x426241F4417A, 0x426341F4417A, 0x426441F4417A, 0x426541F4417A, 0x426641F4417A, 0x426741F4417A, 0x426841F4417A, 0x426941F4417A, 0x426A41F4417A, 0x426B41F4417A, 0x41F5417A, 0x425141F5417A, 0x425241F5417A, 0x425341F5417A, 0x425441F5417A, 0x425541F5417A, 0x425641F5417A, 0x425741F5417A, 0x425841F5417A, 0x425941F5417A, 0x425A41F5417A, 0x425B41F5417A,
+		0x425C41F5417A, 0x425D41F5417A, 0x425E41F5417A, 0x425F41F5417A, 0x426041F5417A, 0x426141F5417A, 0x426241F5417A, 0x426341F5417A, 0x426441F5417A, 0x426541F5417A, 0x426641F5417A, 0x426741F5417A, 0x426841F5417A, 0x426941F5417A, 0x426A41F5417A, 0x426B41F5417A, 0x41F6417A, 0x425141F6417A, 0x425241F6417A, 0x425341F6417A, 0x425441F6417A, 0x425541F6417A, 0x425641F6417A, 0x425741F6417A, 0x425841F6417A, 0x425941F6417A, 0x425A41F6417A, 0x425B41F6417A, 0x425C41F6417A, 0x425D41F6417A, 0x425E41F6417A, 0x425F41F6417A,
+		0x426041F6417A, 0x426141F6417A, 0x426241F6417A, 0x426341F6417A, 0x426441F6417A, 0x426541F6417A, 0x426641F6417A, 0x426741F6417A, 0x426841F6417A, 0x426941F6417A, 0x426A41F6417A, 0x426B41F6417A, 0x41F7417A, 0x425141F7417A, 0x425241F7417A, 0x425341F7417A, 0x425441F7417A, 0x425541F7417A, 0x425641F7417A, 0x425741F7417A, 0x425841F7417A, 0x425941F7417A, 0x425A41F7417A, 0x425B41F7417A, 0x425C41F7417A, 0x425D41F7417A, 0x425E41F7417A, 0x425F41F7417A, 0x426041F7417A, 0x426141F7417A, 0x426241F7417A, 0x426341F7417A,
+		0x426441F7417A, 0x426541F7417A, 0x426641F7417A, 0x426741F7417A, 0x426841F7417A, 0x426941F7417A, 0x426A41F7417A, 0x426B41F7417A, 0x41F8417A, 0x425141F8417A, 0x425241F8417A, 0x425341F8417A, 0x425441F8417A, 0x425541F8417A, 0x425641F8417A, 0x425741F8417A, 0x425841F8417A, 0x425941F8417A, 0x425A41F8417A, 0x425B41F8417A, 0x425C41F8417A, 0x425D41F8417A, 0x425E41F8417A, 0x425F41F8417A, 0x426041F8417A, 0x426141F8417A, 0x426241F8417A, 0x426341F8417A, 0x426441F8417A, 0x426541F8417A, 0x426641F8417A, 0x426741F8417A,
+		0x426841F8417A, 0x426941F8417A, 0x426A41F8417A, 0x426B41F8417A, 0x41F9417A, 0x425141F9417A, 0x425241F9417A, 0x425341F9417A, 0x425441F9417A, 0x425541F9417A, 0x425641F9417A, 0x425741F9417A, 0x425841F9417A, 0x425941F9417A, 0x425A41F9417A, 0x425B41F9417A, 0x425C41F9417A, 0x425D41F9417A, 0x425E41F9417A, 0x425F41F9417A, 0x426041F9417A, 0x426141F9417A, 0x426241F9417A, 0x426341F9417A, 0x426441F9417A, 0x426541F9417A, 0x426641F9417A, 0x426741F9417A, 0x426841F9417A, 0x426941F9417A, 0x426A41F9417A, 0x426B41F9417A,
+		0x41FA417A, 0x425141FA417A, 0x425241FA417A, 0x425341FA417A, 0x425441FA417A, 0x425541FA417A, 0x425641FA417A, 0x425741FA417A, 0x425841FA417A, 0x425941FA417A, 0x425A41FA417A, 0x425B41FA417A, 0x425C41FA417A, 0x425D41FA417A, 0x425E41FA417A, 0x425F41FA417A, 0x426041FA417A, 0x426141FA417A, 0x426241FA417A, 0x426341FA417A, 0x426441FA417A, 0x426541FA417A, 0x426641FA417A, 0x426741FA417A, 0x426841FA417A, 0x426941FA417A, 0x426A41FA417A, 0x426B41FA417A, 0x41FB417A, 0x425141FB417A, 0x425241FB417A, 0x425341FB417A,
+		0x425441FB417A, 0x425541FB417A, 0x425641FB417A, 0x425741FB417A, 0x425841FB417A, 0x425941FB417A, 0x425A41FB417A, 0x425B41FB417A, 0x425C41FB417A, 0x425D41FB417A, 0x425E41FB417A, 0x425F41FB417A, 0x426041FB417A, 0x426141FB417A, 0x426241FB417A, 0x426341FB417A, 0x426441FB417A, 0x426541FB417A, 0x426641FB417A, 0x426741FB417A, 0x426841FB417A, 0x426941FB417A, 0x426A41FB417A, 0x426B41FB417A, 0x41FC417A, 0x425141FC417A, 0x425241FC417A, 0x425341FC417A, 0x425441FC417A, 0x425541FC417A, 0x425641FC417A, 0x425741FC417A,
+		0x425841FC417A, 0x425941FC417A, 0x425A41FC417A, 0x425B41FC417A, 0x425C41FC417A, 0x425D41FC417A, 0x425E41FC417A, 0x425F41FC417A, 0x426041FC417A, 0x426141FC417A, 0x426241FC417A, 0x426341FC417A, 0x426441FC417A, 0x426541FC417A, 0x426641FC417A, 0x426741FC417A, 0x426841FC417A, 0x426941FC417A, 0x426A41FC417A, 0x426B41FC417A, 0x41FD417A, 0x425141FD417A, 0x425241FD417A, 0x425341FD417A, 0x425441FD417A, 0x425541FD417A, 0x425641FD417A, 0x425741FD417A, 0x425841FD417A, 0x425941FD417A, 0x425A41FD417A, 0x425B41FD417A,
+		0x425C41FD417A, 0x425D41FD417A, 0x425E41FD417A, 0x425F41FD417A, 0x426041FD417A, 0x426141FD417A, 0x426241FD417A, 0x426341FD417A, 0x426441FD417A, 0x426541FD417A, 0x426641FD417A, 0x426741FD417A, 0x426841FD417A, 0x426941FD417A, 0x426A41FD417A, 0x426B41FD417A, 0x41FE417A, 0x425141FE417A, 0x425241FE417A, 0x425341FE417A, 0x425441FE417A, 0x425541FE417A, 0x425641FE417A, 0x425741FE417A, 0x425841FE417A, 0x425941FE417A, 0x425A41FE417A, 0x425B41FE417A, 0x425C41FE417A, 0x425D41FE417A, 0x425E41FE417A, 0x425F41FE417A,
+		0x426041FE417A, 0x426141FE417A, 0x426241FE417A, 0x426341FE417A, 0x426441FE417A, 0x426541FE417A, 0x426641FE417A, 0x426741FE417A, 0x426841FE417A, 0x426941FE417A, 0x426A41FE417A, 0x426B41FE417A, 0x41FF417A, 0x425141FF417A, 0x425241FF417A, 0x425341FF417A, 0x425441FF417A, 0x425541FF417A, 0x425641FF417A, 0x425741FF417A, 0x425841FF417A, 0x425941FF417A, 0x425A41FF417A, 0x425B41FF417A, 0x425C41FF417A, 0x425D41FF417A, 0x425E41FF417A, 0x425F41FF417A, 0x426041FF417A, 0x426141FF417A, 0x426241FF417A, 0x426341FF417A,
+		0x426441FF417A, 0x426541FF417A, 0x426641FF417A, 0x426741FF417A, 0x426841FF417A, 0x426941FF417A, 0x426A41FF417A, 0x426B41FF417A, 0x4200417A, 0x42514200417A, 0x42524200417A, 0x42534200417A, 0x42544200417A, 0x42554200417A, 0x42564200417A, 0x42574200417A, 0x42584200417A, 0x42594200417A, 0x425A4200417A, 0x425B4200417A, 0x425C4200417A, 0x425D4200417A, 0x425E4200417A, 0x425F4200417A, 0x42604200417A, 0x42614200417A, 0x42624200417A, 0x42634200417A, 0x42644200417A, 0x42654200417A, 0x42664200417A, 0x42674200417A,
+		0x42684200417A, 0x42694200417A, 0x426A4200417A, 0x426B4200417A, 0x4201417A, 0x42514201417A, 0x42524201417A, 0x42534201417A, 0x42544201417A, 0x42554201417A, 0x42564201417A, 0x42574201417A, 0x42584201417A, 0x42594201417A, 0x425A4201417A, 0x425B4201417A, 0x425C4201417A, 0x425D4201417A, 0x425E4201417A, 0x425F4201417A, 0x42604201417A, 0x42614201417A, 0x42624201417A, 0x42634201417A, 0x42644201417A, 0x42654201417A, 0x42664201417A, 0x42674201417A, 0x42684201417A, 0x42694201417A, 0x426A4201417A, 0x426B4201417A,
+		0x4202417A, 0x42514202417A, 0x42524202417A, 0x42534202417A, 0x42544202417A, 0x42554202417A, 0x42564202417A, 0x42574202417A, 0x42584202417A, 0x42594202417A, 0x425A4202417A, 0x425B4202417A, 0x425C4202417A, 0x425D4202417A, 0x425E4202417A, 0x425F4202417A, 0x42604202417A, 0x42614202417A, 0x42624202417A, 0x42634202417A, 0x42644202417A, 0x42654202417A, 0x42664202417A, 0x42674202417A, 0x42684202417A, 0x42694202417A, 0x426A4202417A, 0x426B4202417A, 0x4203417A, 0x42514203417A, 0x42524203417A, 0x42534203417A,
+		0x42544203417A, 0x42554203417A, 0x42564203417A, 0x42574203417A, 0x42584203417A, 0x42594203417A, 0x425A4203417A, 0x425B4203417A, 0x425C4203417A, 0x425D4203417A, 0x425E4203417A, 0x425F4203417A, 0x42604203417A, 0x42614203417A, 0x42624203417A, 0x42634203417A, 0x42644203417A, 0x42654203417A, 0x42664203417A, 0x42674203417A, 0x42684203417A, 0x42694203417A, 0x426A4203417A, 0x426B4203417A, 0x4204417A, 0x42514204417A, 0x42524204417A, 0x42534204417A, 0x42544204417A, 0x42554204417A, 0x42564204417A, 0x42574204417A,
+		0x42584204417A, 0x42594204417A, 0x425A4204417A, 0x425B4204417A, 0x425C4204417A, 0x425D4204417A, 0x425E4204417A, 0x425F4204417A, 0x42604204417A, 0x42614204417A, 0x42624204417A, 0x42634204417A, 0x42644204417A, 0x42654204417A, 0x42664204417A, 0x42674204417A, 0x42684204417A, 0x42694204417A, 0x426A4204417A, 0x426B4204417A, 0x4205417A, 0x42514205417A, 0x42524205417A, 0x42534205417A, 0x42544205417A, 0x42554205417A, 0x42564205417A, 0x42574205417A, 0x42584205417A, 0x42594205417A, 0x425A4205417A, 0x425B4205417A,
+		0x425C4205417A, 0x425D4205417A, 0x425E4205417A, 0x425F4205417A, 0x42604205417A, 0x42614205417A, 0x42624205417A, 0x42634205417A, 0x42644205417A, 0x42654205417A, 0x42664205417A, 0x42674205417A, 0x42684205417A, 0x42694205417A, 0x426A4205417A, 0x426B4205417A, 0x4206417A, 0x42514206417A, 0x42524206417A, 0x42534206417A, 0x42544206417A, 0x42554206417A, 0x42564206417A, 0x42574206417A, 0x42584206417A, 0x42594206417A, 0x425A4206417A, 0x425B4206417A, 0x425C4206417A, 0x425D4206417A, 0x425E4206417A, 0x425F4206417A,
+		0x42604206417A, 0x42614206417A, 0x42624206417A, 0x42634206417A, 0x42644206417A, 0x42654206417A, 0x42664206417A, 0x42674206417A, 0x42684206417A, 0x42694206417A, 0x426A4206417A, 0x426B4206417A, 0x4207417A, 0x42514207417A, 0x42524207417A, 0x42534207417A, 0x42544207417A, 0x42554207417A, 0x42564207417A, 0x42574207417A, 0x42584207417A, 0x42594207417A, 0x425A4207417A, 0x425B4207417A, 0x425C4207417A, 0x425D4207417A, 0x425E4207417A, 0x425F4207417A, 0x42604207417A, 0x42614207417A, 0x42624207417A, 0x42634207417A,
+		0x42644207417A, 0x42654207417A, 0x42664207417A, 0x42674207417A, 0x42684207417A, 0x42694207417A, 0x426A4207417A, 0x426B4207417A, 0x41F3417B, 0x425141F3417B, 0x425241F3417B, 0x425341F3417B, 0x425441F3417B, 0x425541F3417B, 0x425641F3417B, 0x425741F3417B, 0x425841F3417B, 0x425941F3417B, 0x425A41F3417B, 0x425B41F3417B, 0x425C41F3417B, 0x425D41F3417B, 0x425E41F3417B, 0x425F41F3417B, 0x426041F3417B, 0x426141F3417B, 0x426241F3417B, 0x426341F3417B, 0x426441F3417B, 0x426541F3417B, 0x426641F3417B, 0x426741F3417B,
+		0x426841F3417B, 0x426941F3417B, 0x426A41F3417B, 0x426B41F3417B, 0x41F4417B, 0x425141F4417B, 0x425241F4417B, 0x425341F4417B, 0x425441F4417B, 0x425541F4417B, 0x425641F4417B, 0x425741F4417B, 0x425841F4417B, 0x425941F4417B, 0x425A41F4417B, 0x425B41F4417B, 0x425C41F4417B, 0x425D41F4417B, 0x425E41F4417B, 0x425F41F4417B, 0x426041F4417B, 0x426141F4417B, 0x426241F4417B, 0x426341F4417B, 0x426441F4417B, 0x426541F4417B, 0x426641F4417B, 0x426741F4417B, 0x426841F4417B, 0x426941F4417B, 0x426A41F4417B, 0x426B41F4417B,
+		0x41F5417B, 0x425141F5417B, 0x425241F5417B, 0x425341F5417B, 0x425441F5417B, 0x425541F5417B, 0x425641F5417B, 0x425741F5417B, 0x425841F5417B, 0x425941F5417B, 0x425A41F5417B, 0x425B41F5417B, 0x425C41F5417B, 0x425D41F5417B, 0x425E41F5417B, 0x425F41F5417B, 0x426041F5417B, 0x426141F5417B, 0x426241F5417B, 0x426341F5417B, 0x426441F5417B, 0x426541F5417B, 0x426641F5417B, 0x426741F5417B, 0x426841F5417B, 0x426941F5417B, 0x426A41F5417B, 0x426B41F5417B, 0x41F6417B, 0x425141F6417B, 0x425241F6417B, 0x425341F6417B,
+		0x425441F6417B, 0x425541F6417B, 0x425641F6417B, 0x425741F6417B, 0x425841F6417B, 0x425941F6417B, 0x425A41F6417B, 0x425B41F6417B, 0x425C41F6417B, 0x425D41F6417B, 0x425E41F6417B, 0x425F41F6417B, 0x426041F6417B, 0x426141F6417B, 0x426241F6417B, 0x426341F6417B, 0x426441F6417B, 0x426541F6417B, 0x426641F6417B, 0x426741F6417B, 0x426841F6417B, 0x426941F6417B, 0x426A41F6417B, 0x426B41F6417B, 0x41F7417B, 0x425141F7417B, 0x425241F7417B, 0x425341F7417B, 0x425441F7417B, 0x425541F7417B, 0x425641F7417B, 0x425741F7417B,
+		0x425841F7417B, 0x425941F7417B, 0x425A41F7417B, 0x425B41F7417B, 0x425C41F7417B, 0x425D41F7417B, 0x425E41F7417B, 0x425F41F7417B, 0x426041F7417B, 0x426141F7417B, 0x426241F7417B, 0x426341F7417B, 0x426441F7417B, 0x426541F7417B, 0x426641F7417B, 0x426741F7417B, 0x426841F7417B, 0x426941F7417B, 0x426A41F7417B, 0x426B41F7417B, 0x41F8417B, 0x425141F8417B, 0x425241F8417B, 0x425341F8417B, 0x425441F8417B, 0x425541F8417B, 0x425641F8417B, 0x425741F8417B, 0x425841F8417B, 0x425941F8417B, 0x425A41F8417B, 0x425B41F8417B,
+		0x425C41F8417B, 0x425D41F8417B, 0x425E41F8417B, 0x425F41F8417B, 0x426041F8417B, 0x426141F8417B, 0x426241F8417B, 0x426341F8417B, 0x426441F8417B, 0x426541F8417B, 0x426641F8417B, 0x426741F8417B, 0x426841F8417B, 0x426941F8417B, 0x426A41F8417B, 0x426B41F8417B, 0x41F9417B, 0x425141F9417B, 0x425241F9417B, 0x425341F9417B, 0x425441F9417B, 0x425541F9417B, 0x425641F9417B, 0x425741F9417B, 0x425841F9417B, 0x425941F9417B, 0x425A41F9417B, 0x425B41F9417B, 0x425C41F9417B, 0x425D41F9417B, 0x425E41F9417B, 0x425F41F9417B,
+		0x426041F9417B, 0x426141F9417B, 0x426241F9417B, 0x426341F9417B, 0x426441F9417B, 0x426541F9417B, 0x426641F9417B, 0x426741F9417B, 0x426841F9417B, 0x426941F9417B, 0x426A41F9417B, 0x426B41F9417B, 0x41FA417B, 0x425141FA417B, 0x425241FA417B, 0x425341FA417B, 0x425441FA417B, 0x425541FA417B, 0x425641FA417B, 0x425741FA417B, 0x425841FA417B, 0x425941FA417B, 0x425A41FA417B, 0x425B41FA417B, 0x425C41FA417B, 0x425D41FA417B, 0x425E41FA417B, 0x425F41FA417B, 0x426041FA417B, 0x426141FA417B, 0x426241FA417B, 0x426341FA417B,
+		0x426441FA417B, 0x426541FA417B, 0x426641FA417B, 0x426741FA417B, 0x426841FA417B, 0x426941FA417B, 0x426A41FA417B, 0x426B41FA417B, 0x41FB417B, 0x425141FB417B, 0x425241FB417B, 0x425341FB417B, 0x425441FB417B, 0x425541FB417B, 0x425641FB417B, 0x425741FB417B, 0x425841FB417B, 0x425941FB417B, 0x425A41FB417B, 0x425B41FB417B, 0x425C41FB417B, 0x425D41FB417B, 0x425E41FB417B, 0x425F41FB417B, 0x426041FB417B, 0x426141FB417B, 0x426241FB417B, 0x426341FB417B, 0x426441FB417B, 0x426541FB417B, 0x426641FB417B, 0x426741FB417B,
+		0x426841FB417B, 0x426941FB417B, 0x426A41FB417B, 0x426B41FB417B, 0x41FC417B, 0x425141FC417B, 0x425241FC417B, 0x425341FC417B, 0x425441FC417B, 0x425541FC417B, 0x425641FC417B, 0x425741FC417B, 0x425841FC417B, 0x425941FC417B, 0x425A41FC417B, 0x425B41FC417B, 0x425C41FC417B, 0x425D41FC417B, 0x425E41FC417B, 0x425F41FC417B, 0x426041FC417B, 0x426141FC417B, 0x426241FC417B, 0x426341FC417B, 0x426441FC417B, 0x426541FC417B, 0x426641FC417B, 0x426741FC417B, 0x426841FC417B, 0x426941FC417B, 0x426A41FC417B, 0x426B41FC417B,
+		0x41FD417B, 0x425141FD417B, 0x425241FD417B, 0x425341FD417B, 0x425441FD417B, 0x425541FD417B, 0x425641FD417B, 0x425741FD417B, 0x425841FD417B, 0x425941FD417B, 0x425A41FD417B, 0x425B41FD417B, 0x425C41FD417B, 0x425D41FD417B, 0x425E41FD417B, 0x425F41FD417B, 0x426041FD417B, 0x426141FD417B, 0x426241FD417B, 0x426341FD417B, 0x426441FD417B, 0x426541FD417B, 0x426641FD417B, 0x426741FD417B, 0x426841FD417B, 0x426941FD417B, 0x426A41FD417B, 0x426B41FD417B, 0x41FE417B, 0x425141FE417B, 0x425241FE417B, 0x425341FE417B,
+		0x425441FE417B, 0x425541FE417B, 0x425641FE417B, 0x425741FE417B, 0x425841FE417B, 0x425941FE417B, 0x425A41FE417B, 0x425B41FE417B, 0x425C41FE417B, 0x425D41FE417B, 0x425E41FE417B, 0x425F41FE417B, 0x426041FE417B, 0x426141FE417B, 0x426241FE417B, 0x426341FE417B, 0x426441FE417B, 0x426541FE417B, 0x426641FE417B, 0x426741FE417B, 0x426841FE417B, 0x426941FE417B, 0x426A41FE417B, 0x426B41FE417B, 0x41FF417B, 0x425141FF417B, 0x425241FF417B, 0x425341FF417B, 0x425441FF417B, 0x425541FF417B, 0x425641FF417B, 0x425741FF417B,
+		0x425841FF417B, 0x425941FF417B, 0x425A41FF417B, 0x425B41FF417B, 0x425C41FF417B, 0x425D41FF417B, 0x425E41FF417B, 0x425F41FF417B, 0x426041FF417B, 0x426141FF417B, 0x426241FF417B, 0x426341FF417B, 0x426441FF417B, 0x426541FF417B, 0x426641FF417B, 0x426741FF417B, 0x426841FF417B, 0x426941FF417B, 0x426A41FF417B, 0x426B41FF417B, 0x4200417B, 0x42514200417B, 0x42524200417B, 0x42534200417B, 0x42544200417B, 0x42554200417B, 0x42564200417B, 0x42574200417B, 0x42584200417B, 0x42594200417B, 0x425A4200417B, 0x425B4200417B,
+		0x425C4200417B, 0x425D4200417B, 0x425E4200417B, 0x425F4200417B, 0x42604200417B, 0x42614200417B, 0x42624200417B, 0x42634200417B, 0x42644200417B, 0x42654200417B, 0x42664200417B, 0x42674200417B, 0x42684200417B, 0x42694200417B, 0x426A4200417B, 0x426B4200417B, 0x4201417B, 0x42514201417B, 0x42524201417B, 0x42534201417B, 0x42544201417B, 0x42554201417B, 0x42564201417B, 0x42574201417B, 0x42584201417B, 0x42594201417B, 0x425A4201417B, 0x425B4201417B, 0x425C4201417B, 0x425D4201417B, 0x425E4201417B, 0x425F4201417B,
+		0x42604201417B, 0x42614201417B, 0x42624201417B, 0x42634201417B, 0x42644201417B, 0x42654201417B, 0x42664201417B, 0x42674201417B, 0x42684201417B, 0x42694201417B, 0x426A4201417B, 0x426B4201417B, 0x4202417B, 0x42514202417B, 0x42524202417B, 0x42534202417B, 0x42544202417B, 0x42554202417B, 0x42564202417B, 0x42574202417B, 0x42584202417B, 0x42594202417B, 0x425A4202417B, 0x425B4202417B, 0x425C4202417B, 0x425D4202417B, 0x425E4202417B, 0x425F4202417B, 0x42604202417B, 0x42614202417B, 0x42624202417B, 0x42634202417B,
+		0x42644202417B, 0x42654202417B, 0x42664202417B, 0x42674202417B, 0x42684202417B, 0x42694202417B, 0x426A4202417B, 0x426B4202417B, 0x4203417B, 0x42514203417B, 0x42524203417B, 0x42534203417B, 0x42544203417B, 0x42554203417B, 0x42564203417B, 0x42574203417B, 0x42584203417B, 0x42594203417B, 0x425A4203417B, 0x425B4203417B, 0x425C4203417B, 0x425D4203417B, 0x425E4203417B, 0x425F4203417B, 0x42604203417B, 0x42614203417B, 0x42624203417B, 0x42634203417B, 0x42644203417B, 0x42654203417B, 0x42664203417B, 0x42674203417B,
+		0x42684203417B, 0x42694203417B, 0x426A4203417B, 0x426B4203417B, 0x4204417B, 0x42514204417B, 0x42524204417B, 0x42534204417B, 0x42544204417B, 0x42554204417B, 0x42564204417B, 0x42574204417B, 0x42584204417B, 0x42594204417B, 0x425A4204417B, 0x425B4204417B, 0x425C4204417B, 0x425D4204417B, 0x425E4204417B, 0x425F4204417B, 0x42604204417B, 0x42614204417B, 0x42624204417B, 0x42634204417B, 0x42644204417B, 0x42654204417B, 0x42664204417B, 0x42674204417B, 0x42684204417B, 0x42694204417B, 0x426A4204417B, 0x426B4204417B,
+		0x4205417B, 0x42514205417B, 0x42524205417B, 0x42534205417B, 0x42544205417B, 0x42554205417B, 0x42564205417B, 0x42574205417B, 0x42584205417B, 0x42594205417B, 0x425A4205417B, 0x425B4205417B, 0x425C4205417B, 0x425D4205417B, 0x425E4205417B, 0x425F4205417B, 0x42604205417B, 0x42614205417B, 0x42624205417B, 0x42634205417B, 0x42644205417B, 0x42654205417B, 0x42664205417B, 0x42674205417B, 0x42684205417B, 0x42694205417B, 0x426A4205417B, 0x426B4205417B, 0x4206417B, 0x42514206417B, 0x42524206417B, 0x42534206417B,
+		0x42544206417B, 0x42554206417B, 0x42564206417B, 0x42574206417B, 0x42584206417B, 0x42594206417B, 0x425A4206417B, 0x425B4206417B, 0x425C4206417B, 0x425D4206417B, 0x425E4206417B, 0x425F4206417B, 0x42604206417B, 0x42614206417B, 0x42624206417B, 0x42634206417B, 0x42644206417B, 0x42654206417B, 0x42664206417B, 0x42674206417B, 0x42684206417B, 0x42694206417B, 0x426A4206417B, 0x426B4206417B, 0x4207417B, 0x42514207417B, 0x42524207417B, 0x42534207417B, 0x42544207417B, 0x42554207417B, 0x42564207417B, 0x42574207417B,
+		0x42584207417B, 0x42594207417B, 0x425A4207417B, 0x425B4207417B, 0x425C4207417B, 0x425D4207417B, 0x425E4207417B, 0x425F4207417B, 0x42604207417B, 0x42614207417B, 0x42624207417B, 0x42634207417B, 0x42644207417B, 0x42654207417B, 0x42664207417B, 0x42674207417B, 0x42684207417B, 0x42694207417B, 0x426A4207417B, 0x426B4207417B, 0x41F3417C, 0x425141F3417C, 0x425241F3417C, 0x425341F3417C, 0x425441F3417C, 0x425541F3417C, 0x425641F3417C, 0x425741F3417C, 0x425841F3417C, 0x425941F3417C, 0x425A41F3417C, 0x425B41F3417C,
+		0x425C41F3417C, 0x425D41F3417C, 0x425E41F3417C, 0x425F41F3417C, 0x426041F3417C, 0x426141F3417C, 0x426241F3417C, 0x426341F3417C, 0x426441F3417C, 0x426541F3417C, 0x426641F3417C, 0x426741F3417C, 0x426841F3417C, 0x426941F3417C, 0x426A41F3417C, 0x426B41F3417C, 0x41F4417C, 0x425141F4417C, 0x425241F4417C, 0x425341F4417C, 0x425441F4417C, 0x425541F4417C, 0x425641F4417C, 0x425741F4417C, 0x425841F4417C, 0x425941F4417C, 0x425A41F4417C, 0x425B41F4417C, 0x425C41F4417C, 0x425D41F4417C, 0x425E41F4417C, 0x425F41F4417C,
+		0x426041F4417C, 0x426141F4417C, 0x426241F4417C, 0x426341F4417C, 0x426441F4417C, 0x426541F4417C, 0x426641F4417C, 0x426741F4417C, 0x426841F4417C, 0x426941F4417C, 0x426A41F4417C, 0x426B41F4417C, 0x41F5417C, 0x425141F5417C, 0x425241F5417C, 0x425341F5417C, 0x425441F5417C, 0x425541F5417C, 0x425641F5417C, 0x425741F5417C, 0x425841F5417C, 0x425941F5417C, 0x425A41F5417C, 0x425B41F5417C, 0x425C41F5417C, 0x425D41F5417C, 0x425E41F5417C, 0x425F41F5417C, 0x426041F5417C, 0x426141F5417C, 0x426241F5417C, 0x426341F5417C,
+		0x426441F5417C, 0x426541F5417C, 0x426641F5417C, 0x426741F5417C, 0x426841F5417C, 0x426941F5417C, 0x426A41F5417C, 0x426B41F5417C, 0x41F6417C, 0x425141F6417C, 0x425241F6417C, 0x425341F6417C, 0x425441F6417C, 0x425541F6417C, 0x425641F6417C, 0x425741F6417C, 0x425841F6417C, 0x425941F6417C, 0x425A41F6417C, 0x425B41F6417C, 0x425C41F6417C, 0x425D41F6417C, 0x425E41F6417C, 0x425F41F6417C, 0x426041F6417C, 0x426141F6417C, 0x426241F6417C, 0x426341F6417C, 0x426441F6417C, 0x426541F6417C, 0x426641F6417C, 0x426741F6417C,
+		0x426841F6417C, 0x426941F6417C, 0x426A41F6417C, 0x426B41F6417C, 0x41F7417C, 0x425141F7417C, 0x425241F7417C, 0x425341F7417C, 0x425441F7417C, 0x425541F7417C, 0x425641F7417C, 0x425741F7417C, 0x425841F7417C, 0x425941F7417C, 0x425A41F7417C, 0x425B41F7417C, 0x425C41F7417C, 0x425D41F7417C, 0x425E41F7417C, 0x425F41F7417C, 0x426041F7417C, 0x426141F7417C, 0x426241F7417C, 0x426341F7417C, 0x426441F7417C, 0x426541F7417C, 0x426641F7417C, 0x426741F7417C, 0x426841F7417C, 0x426941F7417C, 0x426A41F7417C, 0x426B41F7417C,
+		0x41F8417C, 0x425141F8417C, 0x425241F8417C, 0x425341F8417C, 0x425441F8417C, 0x425541F8417C, 0x425641F8417C, 0x425741F8417C, 0x425841F8417C, 0x425941F8417C, 0x425A41F8417C, 0x425B41F8417C, 0x425C41F8417C, 0x425D41F8417C, 0x425E41F8417C, 0x425F41F8417C, 0x426041F8417C, 0x426141F8417C, 0x426241F8417C, 0x426341F8417C, 0x426441F8417C, 0x426541F8417C, 0x426641F8417C, 0x426741F8417C, 0x426841F8417C, 0x426941F8417C, 0x426A41F8417C, 0x426B41F8417C, 0x41F9417C, 0x425141F9417C, 0x425241F9417C, 0x425341F9417C,
+		0x425441F9417C, 0x425541F9417C, 0x425641F9417C, 0x425741F9417C, 0x425841F9417C, 0x425941F9417C, 0x425A41F9417C, 0x425B41F9417C, 0x425C41F9417C, 0x425D41F9417C, 0x425E41F9417C, 0x425F41F9417C, 0x426041F9417C, 0x426141F9417C, 0x426241F9417C, 0x426341F9417C, 0x426441F9417C, 0x426541F9417C, 0x426641F9417C, 0x426741F9417C, 0x426841F9417C, 0x426941F9417C, 0x426A41F9417C, 0x426B41F9417C, 0x41FA417C, 0x425141FA417C, 0x425241FA417C, 0x425341FA417C, 0x425441FA417C, 0x425541FA417C, 0x425641FA417C, 0x425741FA417C,
+		0x425841FA417C, 0x425941FA417C, 0x425A41FA417C, 0x425B41FA417C, 0x425C41FA417C, 0x425D41FA417C, 0x425E41FA417C, 0x425F41FA417C, 0x426041FA417C, 0x426141FA417C, 0x426241FA417C, 0x426341FA417C, 0x426441FA417C, 0x426541FA417C, 0x426641FA417C, 0x426741FA417C, 0x426841FA417C, 0x426941FA417C, 0x426A41FA417C, 0x426B41FA417C, 0x41FB417C, 0x425141FB417C, 0x425241FB417C, 0x425341FB417C, 0x425441FB417C, 0x425541FB417C, 0x425641FB417C, 0x425741FB417C, 0x425841FB417C, 0x425941FB417C, 0x425A41FB417C, 0x425B41FB417C,
+		0x425C41FB417C, 0x425D41FB417C, 0x425E41FB417C, 0x425F41FB417C, 0x426041FB417C, 0x426141FB417C, 0x426241FB417C, 0x426341FB417C, 0x426441FB417C, 0x426541FB417C, 0x426641FB417C, 0x426741FB417C, 0x426841FB417C, 0x426941FB417C, 0x426A41FB417C, 0x426B41FB417C, 0x41FC417C, 0x425141FC417C, 0x425241FC417C, 0x425341FC417C, 0x425441FC417C, 0x425541FC417C, 0x425641FC417C, 0x425741FC417C, 0x425841FC417C, 0x425941FC417C, 0x425A41FC417C, 0x425B41FC417C, 0x425C41FC417C, 0x425D41FC417C, 0x425E41FC417C, 0x425F41FC417C,
+		0x426041FC417C, 0x426141FC417C, 0x426241FC417C, 0x426341FC417C, 0x426441FC417C, 0x426541FC417C, 0x426641FC417C, 0x426741FC417C, 0x426841FC417C, 0x426941FC417C, 0x426A41FC417C, 0x426B41FC417C, 0x41FD417C, 0x425141FD417C, 0x425241FD417C, 0x425341FD417C, 0x425441FD417C, 0x425541FD417C, 0x425641FD417C, 0x425741FD417C, 0x425841FD417C, 0x425941FD417C, 0x425A41FD417C, 0x425B41FD417C, 0x425C41FD417C, 0x425D41FD417C, 0x425E41FD417C, 0x425F41FD417C, 0x426041FD417C, 0x426141FD417C, 0x426241FD417C, 0x426341FD417C,
+		0x426441FD417C, 0x426541FD417C, 0x426641FD417C, 0x426741FD417C, 0x426841FD417C, 0x426941FD417C, 0x426A41FD417C, 0x426B41FD417C, 0x41FE417C, 0x425141FE417C, 0x425241FE417C, 0x425341FE417C, 0x425441FE417C, 0x425541FE417C, 0x425641FE417C, 0x425741FE417C, 0x425841FE417C, 0x425941FE417C, 0x425A41FE417C, 0x425B41FE417C, 0x425C41FE417C, 0x425D41FE417C, 0x425E41FE417C, 0x425F41FE417C, 0x426041FE417C, 0x426141FE417C, 0x426241FE417C, 0x426341FE417C, 0x426441FE417C, 0x426541FE417C, 0x426641FE417C, 0x426741FE417C,
+		0x426841FE417C, 0x426941FE417C, 0x426A41FE417C, 0x426B41FE417C, 0x41FF417C, 0x425141FF417C, 0x425241FF417C, 0x425341FF417C, 0x425441FF417C, 0x425541FF417C, 0x425641FF417C, 0x425741FF417C, 0x425841FF417C, 0x425941FF417C, 0x425A41FF417C, 0x425B41FF417C, 0x425C41FF417C, 0x425D41FF417C, 0x425E41FF417C, 0x425F41FF417C, 0x426041FF417C, 0x426141FF417C, 0x426241FF417C, 0x426341FF417C, 0x426441FF417C, 0x426541FF417C, 0x426641FF417C, 0x426741FF417C, 0x426841FF417C, 0x426941FF417C, 0x426A41FF417C, 0x426B41FF417C,
+		0x4200417C, 0x42514200417C, 0x42524200417C, 0x42534200417C, 0x42544200417C, 0x42554200417C, 0x42564200417C, 0x42574200417C, 0x42584200417C, 0x42594200417C, 0x425A4200417C, 0x425B4200417C, 0x425C4200417C, 0x425D4200417C, 0x425E4200417C, 0x425F4200417C, 0x42604200417C, 0x42614200417C, 0x42624200417C, 0x42634200417C, 0x42644200417C, 0x42654200417C, 0x42664200417C, 0x42674200417C, 0x42684200417C, 0x42694200417C, 0x426A4200417C, 0x426B4200417C, 0x4201417C, 0x42514201417C, 0x42524201417C, 0x42534201417C,
+		0x42544201417C, 0x42554201417C, 0x42564201417C, 0x42574201417C, 0x42584201417C, 0x42594201417C, 0x425A4201417C, 0x425B4201417C, 0x425C4201417C, 0x425D4201417C, 0x425E4201417C, 0x425F4201417C, 0x42604201417C, 0x42614201417C, 0x42624201417C, 0x42634201417C, 0x42644201417C, 0x42654201417C, 0x42664201417C, 0x42674201417C, 0x42684201417C, 0x42694201417C, 0x426A4201417C, 0x426B4201417C, 0x4202417C, 0x42514202417C, 0x42524202417C, 0x42534202417C, 0x42544202417C, 0x42554202417C, 0x42564202417C, 0x42574202417C,
+		0x42584202417C, 0x42594202417C, 0x425A4202417C, 0x425B4202417C, 0x425C4202417C, 0x425D4202417C, 0x425E4202417C, 0x425F4202417C, 0x42604202417C, 0x42614202417C, 0x42624202417C, 0x42634202417C, 0x42644202417C, 0x42654202417C, 0x42664202417C, 0x42674202417C, 0x42684202417C, 0x42694202417C, 0x426A4202417C, 0x426B4202417C, 0x4203417C, 0x42514203417C, 0x42524203417C, 0x42534203417C, 0x42544203417C, 0x42554203417C, 0x42564203417C, 0x42574203417C, 0x42584203417C, 0x42594203417C, 0x425A4203417C, 0x425B4203417C,
+		0x425C4203417C, 0x425D4203417C, 0x425E4203417C, 0x425F4203417C, 0x42604203417C, 0x42614203417C, 0x42624203417C, 0x42634203417C, 0x42644203417C, 0x42654203417C, 0x42664203417C, 0x42674203417C, 0x42684203417C, 0x42694203417C, 0x426A4203417C, 0x426B4203417C, 0x4204417C, 0x42514204417C, 0x42524204417C, 0x42534204417C, 0x42544204417C, 0x42554204417C, 0x42564204417C, 0x42574204417C, 0x42584204417C, 0x42594204417C, 0x425A4204417C, 0x425B4204417C, 0x425C4204417C, 0x425D4204417C, 0x425E4204417C, 0x425F4204417C,
+		0x42604204417C, 0x42614204417C, 0x42624204417C, 0x42634204417C, 0x42644204417C, 0x42654204417C, 0x42664204417C, 0x42674204417C, 0x42684204417C, 0x42694204417C, 0x426A4204417C, 0x426B4204417C, 0x4205417C, 0x42514205417C, 0x42524205417C, 0x42534205417C, 0x42544205417C, 0x42554205417C, 0x42564205417C, 0x42574205417C, 0x42584205417C, 0x42594205417C, 0x425A4205417C, 0x425B4205417C, 0x425C4205417C, 0x425D4205417C, 0x425E4205417C, 0x425F4205417C, 0x42604205417C, 0x42614205417C, 0x42624205417C, 0x42634205417C,
+		0x42644205417C, 0x42654205417C, 0x42664205417C, 0x42674205417C, 0x42684205417C, 0x42694205417C, 0x426A4205417C, 0x426B4205417C, 0x4206417C, 0x42514206417C, 0x42524206417C, 0x42534206417C, 0x42544206417C, 0x42554206417C, 0x42564206417C, 0x42574206417C, 0x42584206417C, 0x42594206417C, 0x425A4206417C, 0x425B4206417C, 0x425C4206417C, 0x425D4206417C, 0x425E4206417C, 0x425F4206417C, 0x42604206417C, 0x42614206417C, 0x42624206417C, 0x42634206417C, 0x42644206417C, 0x42654206417C, 0x42664206417C, 0x42674206417C,
+		0x42684206417C, 0x42694206417C, 0x426A4206417C, 0x426B4206417C, 0x4207417C, 0x42514207417C, 0x42524207417C, 0x42534207417C, 0x42544207417C, 0x42554207417C, 0x42564207417C, 0x42574207417C, 0x42584207417C, 0x42594207417C, 0x425A4207417C, 0x425B4207417C, 0x425C4207417C, 0x425D4207417C, 0x425E4207417C, 0x425F4207417C, 0x42604207417C, 0x42614207417C, 0x42624207417C, 0x42634207417C, 0x42644207417C, 0x42654207417C, 0x42664207417C, 0x42674207417C, 0x42684207417C, 0x42694207417C, 0x426A4207417C, 0x426B4207417C,
+		0x41F3417D, 0x425141F3417D, 0x425241F3417D, 0x425341F3417D, 0x425441F3417D, 0x425541F3417D, 0x425641F3417D, 0x425741F3417D, 0x425841F3417D, 0x425941F3417D, 0x425A41F3417D, 0x425B41F3417D, 0x425C41F3417D, 0x425D41F3417D, 0x425E41F3417D, 0x425F41F3417D, 0x426041F3417D, 0x426141F3417D, 0x426241F3417D, 0x426341F3417D, 0x426441F3417D, 0x426541F3417D, 0x426641F3417D, 0x426741F3417D, 0x426841F3417D, 0x426941F3417D, 0x426A41F3417D, 0x426B41F3417D, 0x41F4417D, 0x425141F4417D, 0x425241F4417D, 0x425341F4417D,
+		0x425441F4417D, 0x425541F4417D, 0x425641F4417D, 0x425741F4417D, 0x425841F4417D, 0x425941F4417D, 0x425A41F4417D, 0x425B41F4417D, 0x425C41F4417D, 0x425D41F4417D, 0x425E41F4417D, 0x425F41F4417D, 0x426041F4417D, 0x426141F4417D, 0x426241F4417D, 0x426341F4417D, 0x426441F4417D, 0x426541F4417D, 0x426641F4417D, 0x426741F4417D, 0x426841F4417D, 0x426941F4417D, 0x426A41F4417D, 0x426B41F4417D, 0x41F5417D, 0x425141F5417D, 0x425241F5417D, 0x425341F5417D, 0x425441F5417D, 0x425541F5417D, 0x425641F5417D, 0x425741F5417D,
+		0x425841F5417D, 0x425941F5417D, 0x425A41F5417D, 0x425B41F5417D, 0x425C41F5417D, 0x425D41F5417D, 0x425E41F5417D, 0x425F41F5417D, 0x426041F5417D, 0x426141F5417D, 0x426241F5417D, 0x426341F5417D, 0x426441F5417D, 0x426541F5417D, 0x426641F5417D, 0x426741F5417D, 0x426841F5417D, 0x426941F5417D, 0x426A41F5417D, 0x426B41F5417D, 0x41F6417D, 0x425141F6417D, 0x425241F6417D, 0x425341F6417D, 0x425441F6417D, 0x425541F6417D, 0x425641F6417D, 0x425741F6417D, 0x425841F6417D, 0x425941F6417D, 0x425A41F6417D, 0x425B41F6417D,
+		0x425C41F6417D, 0x425D41F6417D, 0x425E41F6417D, 0x425F41F6417D, 0x426041F6417D, 0x426141F6417D, 0x426241F6417D, 0x426341F6417D, 0x426441F6417D, 0x426541F6417D, 0x426641F6417D, 0x426741F6417D, 0x426841F6417D, 0x426941F6417D, 0x426A41F6417D, 0x426B41F6417D, 0x41F7417D, 0x425141F7417D, 0x425241F7417D, 0x425341F7417D, 0x425441F7417D, 0x425541F7417D, 0x425641F7417D, 0x425741F7417D, 0x425841F7417D, 0x425941F7417D, 0x425A41F7417D, 0x425B41F7417D, 0x425C41F7417D, 0x425D41F7417D, 0x425E41F7417D, 0x425F41F7417D,
+		0x426041F7417D, 0x426141F7417D, 0x426241F7417D, 0x426341F7417D, 0x426441F7417D, 0x426541F7417D, 0x426641F7417D, 0x426741F7417D, 0x426841F7417D, 0x426941F7417D, 0x426A41F7417D, 0x426B41F7417D, 0x41F8417D, 0x425141F8417D, 0x425241F8417D, 0x425341F8417D, 0x425441F8417D, 0x425541F8417D, 0x425641F8417D, 0x425741F8417D, 0x425841F8417D, 0x425941F8417D, 0x425A41F8417D, 0x425B41F8417D, 0x425C41F8417D, 0x425D41F8417D, 0x425E41F8417D, 0x425F41F8417D, 0x426041F8417D, 0x426141F8417D, 0x426241F8417D, 0x426341F8417D,
+		0x426441F8417D, 0x426541F8417D, 0x426641F8417D, 0x426741F8417D, 0x426841F8417D, 0x426941F8417D, 0x426A41F8417D, 0x426B41F8417D, 0x41F9417D, 0x425141F9417D, 0x425241F9417D, 0x425341F9417D, 0x425441F9417D, 0x425541F9417D, 0x425641F9417D, 0x425741F9417D, 0x425841F9417D, 0x425941F9417D, 0x425A41F9417D, 0x425B41F9417D, 0x425C41F9417D, 0x425D41F9417D, 0x425E41F9417D, 0x425F41F9417D, 0x426041F9417D, 0x426141F9417D, 0x426241F9417D, 0x426341F9417D, 0x426441F9417D, 0x426541F9417D, 0x426641F9417D, 0x426741F9417D,
+		0x426841F9417D, 0x426941F9417D, 0x426A41F9417D, 0x426B41F9417D, 0x41FA417D, 0x425141FA417D, 0x425241FA417D, 0x425341FA417D, 0x425441FA417D, 0x425541FA417D, 0x425641FA417D, 0x425741FA417D, 0x425841FA417D, 0x425941FA417D, 0x425A41FA417D, 0x425B41FA417D, 0x425C41FA417D, 0x425D41FA417D, 0x425E41FA417D, 0x425F41FA417D, 0x426041FA417D, 0x426141FA417D, 0x426241FA417D, 0x426341FA417D, 0x426441FA417D, 0x426541FA417D, 0x426641FA417D, 0x426741FA417D, 0x426841FA417D, 0x426941FA417D, 0x426A41FA417D, 0x426B41FA417D,
+		0x41FB417D, 0x425141FB417D, 0x425241FB417D, 0x425341FB417D, 0x425441FB417D, 0x425541FB417D, 0x425641FB417D, 0x425741FB417D, 0x425841FB417D, 0x425941FB417D, 0x425A41FB417D, 0x425B41FB417D, 0x425C41FB417D, 0x425D41FB417D, 0x425E41FB417D, 0x425F41FB417D, 0x426041FB417D, 0x426141FB417D, 0x426241FB417D, 0x426341FB417D, 0x426441FB417D, 0x426541FB417D, 0x426641FB417D, 0x426741FB417D, 0x426841FB417D, 0x426941FB417D, 0x426A41FB417D, 0x426B41FB417D, 0x41FC417D, 0x425141FC417D, 0x425241FC417D, 0x425341FC417D,
+		0x425441FC417D, 0x425541FC417D, 0x425641FC417D, 0x425741FC417D, 0x425841FC417D, 0x425941FC417D, 0x425A41FC417D, 0x425B41FC417D, 0x425C41FC417D, 0x425D41FC417D, 0x425E41FC417D, 0x425F41FC417D, 0x426041FC417D, 0x426141FC417D, 0x426241FC417D, 0x426341FC417D, 0x426441FC417D, 0x426541FC417D, 0x426641FC417D, 0x426741FC417D, 0x426841FC417D, 0x426941FC417D, 0x426A41FC417D, 0x426B41FC417D, 0x41FD417D, 0x425141FD417D, 0x425241FD417D, 0x425341FD417D, 0x425441FD417D, 0x425541FD417D, 0x425641FD417D, 0x425741FD417D,
+		0x425841FD417D, 0x425941FD417D, 0x425A41FD417D, 0x425B41FD417D, 0x425C41FD417D, 0x425D41FD417D, 0x425E41FD417D, 0x425F41FD417D, 0x426041FD417D, 0x426141FD417D, 0x426241FD417D, 0x426341FD417D, 0x426441FD417D, 0x426541FD417D, 0x426641FD417D, 0x426741FD417D, 0x426841FD417D, 0x426941FD417D, 0x426A41FD417D, 0x426B41FD417D, 0x41FE417D, 0x425141FE417D, 0x425241FE417D, 0x425341FE417D, 0x425441FE417D, 0x425541FE417D, 0x425641FE417D, 0x425741FE417D, 0x425841FE417D, 0x425941FE417D, 0x425A41FE417D, 0x425B41FE417D,
+		0x425C41FE417D, 0x425D41FE417D, 0x425E41FE417D, 0x425F41FE417D, 0x426041FE417D, 0x426141FE417D, 0x426241FE417D, 0x426341FE417D, 0x426441FE417D, 0x426541FE417D, 0x426641FE417D, 0x426741FE417D, 0x426841FE417D, 0x426941FE417D, 0x426A41FE417D, 0x426B41FE417D, 0x41FF417D, 0x425141FF417D, 0x425241FF417D, 0x425341FF417D, 0x425441FF417D, 0x425541FF417D, 0x425641FF417D, 0x425741FF417D, 0x425841FF417D, 0x425941FF417D, 0x425A41FF417D, 0x425B41FF417D, 0x425C41FF417D, 0x425D41FF417D, 0x425E41FF417D, 0x425F41FF417D,
+		0x426041FF417D, 0x426141FF417D, 0x426241FF417D, 0x426341FF417D, 0x426441FF417D, 0x426541FF417D, 0x426641FF417D, 0x426741FF417D, 0x426841FF417D, 0x426941FF417D, 0x426A41FF417D, 0x426B41FF417D, 0x4200417D, 0x42514200417D, 0x42524200417D, 0x42534200417D, 0x42544200417D, 0x42554200417D, 0x42564200417D, 0x42574200417D, 0x42584200417D, 0x42594200417D, 0x425A4200417D, 0x425B4200417D, 0x425C4200417D, 0x425D4200417D, 0x425E4200417D, 0x425F4200417D, 0x42604200417D, 0x42614200417D, 0x42624200417D, 0x42634200417D,
+		0x42644200417D, 0x42654200417D, 0x42664200417D, 0x42674200417D, 0x42684200417D, 0x42694200417D, 0x426A4200417D, 0x426B4200417D, 0x4201417D, 0x42514201417D, 0x42524201417D, 0x42534201417D, 0x42544201417D, 0x42554201417D, 0x42564201417D, 0x42574201417D, 0x42584201417D, 0x42594201417D, 0x425A4201417D, 0x425B4201417D, 0x425C4201417D, 0x425D4201417D, 0x425E4201417D, 0x425F4201417D, 0x42604201417D, 0x42614201417D, 0x42624201417D, 0x42634201417D, 0x42644201417D, 0x42654201417D, 0x42664201417D, 0x42674201417D,
+		0x42684201417D, 0x42694201417D, 0x426A4201417D, 0x426B4201417D, 0x4202417D, 0x42514202417D, 0x42524202417D, 0x42534202417D, 0x42544202417D, 0x42554202417D, 0x42564202417D, 0x42574202417D, 0x42584202417D, 0x42594202417D, 0x425A4202417D, 0x425B4202417D, 0x425C4202417D, 0x425D4202417D, 0x425E4202417D, 0x425F4202417D, 0x42604202417D, 0x42614202417D, 0x42624202417D, 0x42634202417D, 0x42644202417D, 0x42654202417D, 0x42664202417D, 0x42674202417D, 0x42684202417D, 0x42694202417D, 0x426A4202417D, 0x426B4202417D,
+		0x4203417D, 0x42514203417D, 0x42524203417D, 0x42534203417D, 0x42544203417D, 0x42554203417D, 0x42564203417D, 0x42574203417D, 0x42584203417D, 0x42594203417D, 0x425A4203417D, 0x425B4203417D, 0x425C4203417D, 0x425D4203417D, 0x425E4203417D, 0x425F4203417D, 0x42604203417D, 0x42614203417D, 0x42624203417D, 0x42634203417D, 0x42644203417D, 0x42654203417D, 0x42664203417D, 0x42674203417D, 0x42684203417D, 0x42694203417D, 0x426A4203417D, 0x426B4203417D, 0x4204417D, 0x42514204417D, 0x42524204417D, 0x42534204417D,
+		0x42544204417D, 0x42554204417D, 0x42564204417D, 0x42574204417D, 0x42584204417D, 0x42594204417D, 0x425A4204417D, 0x425B4204417D, 0x425C4204417D, 0x425D4204417D, 0x425E4204417D, 0x425F4204417D, 0x42604204417D, 0x42614204417D, 0x42624204417D, 0x42634204417D, 0x42644204417D, 0x42654204417D, 0x42664204417D, 0x42674204417D, 0x42684204417D, 0x42694204417D, 0x426A4204417D, 0x426B4204417D, 0x4205417D, 0x42514205417D, 0x42524205417D, 0x42534205417D, 0x42544205417D, 0x42554205417D, 0x42564205417D, 0x42574205417D,
+		0x42584205417D, 0x42594205417D, 0x425A4205417D, 0x425B4205417D, 0x425C4205417D, 0x425D4205417D, 0x425E4205417D, 0x425F4205417D, 0x42604205417D, 0x42614205417D, 0x42624205417D, 0x42634205417D, 0x42644205417D, 0x42654205417D, 0x42664205417D, 0x42674205417D, 0x42684205417D, 0x42694205417D, 0x426A4205417D, 0x426B4205417D, 0x4206417D, 0x42514206417D, 0x42524206417D, 0x42534206417D, 0x42544206417D, 0x42554206417D, 0x42564206417D, 0x42574206417D, 0x42584206417D, 0x42594206417D, 0x425A4206417D, 0x425B4206417D,
+		0x425C4206417D, 0x425D4206417D, 0x425E4206417D, 0x425F4206417D, 0x42604206417D, 0x42614206417D, 0x42624206417D, 0x42634206417D, 0x42644206417D, 0x42654206417D, 0x42664206417D, 0x42674206417D, 0x42684206417D, 0x42694206417D, 0x426A4206417D, 0x426B4206417D, 0x4207417D, 0x42514207417D, 0x42524207417D, 0x42534207417D, 0x42544207417D, 0x42554207417D, 0x42564207417D, 0x42574207417D, 0x42584207417D, 0x42594207417D, 0x425A4207417D, 0x425B4207417D, 0x425C4207417D, 0x425D4207417D, 0x425E4207417D, 0x425F4207417D,
+		0x42604207417D, 0x42614207417D, 0x42624207417D, 0x42634207417D, 0x42644207417D, 0x42654207417D, 0x42664207417D, 0x42674207417D, 0x42684207417D, 0x42694207417D, 0x426A4207417D, 0x426B4207417D, 0x41F3417E, 0x425141F3417E, 0x425241F3417E, 0x425341F3417E, 0x425441F3417E, 0x425541F3417E, 0x425641F3417E, 0x425741F3417E, 0x425841F3417E, 0x425941F3417E, 0x425A41F3417E, 0x425B41F3417E, 0x425C41F3417E, 0x425D41F3417E, 0x425E41F3417E, 0x425F41F3417E, 0x426041F3417E, 0x426141F3417E, 0x426241F3417E, 0x426341F3417E,
+		0x426441F3417E, 0x426541F3417E, 0x426641F3417E, 0x426741F3417E, 0x426841F3417E, 0x426941F3417E, 0x426A41F3417E, 0x426B41F3417E, 0x41F4417E, 0x425141F4417E, 0x425241F4417E, 0x425341F4417E, 0x425441F4417E, 0x425541F4417E, 0x425641F4417E, 0x425741F4417E, 0x425841F4417E, 0x425941F4417E, 0x425A41F4417E, 0x425B41F4417E, 0x425C41F4417E, 0x425D41F4417E, 0x425E41F4417E, 0x425F41F4417E, 0x426041F4417E, 0x426141F4417E, 0x426241F4417E, 0x426341F4417E, 0x426441F4417E, 0x426541F4417E, 0x426641F4417E, 0x426741F4417E,
+		0x426841F4417E, 0x426941F4417E, 0x426A41F4417E, 0x426B41F4417E, 0x41F5417E, 0x425141F5417E, 0x425241F5417E, 0x425341F5417E, 0x425441F5417E, 0x425541F5417E, 0x425641F5417E, 0x425741F5417E, 0x425841F5417E, 0x425941F5417E, 0x425A41F5417E, 0x425B41F5417E, 0x425C41F5417E, 0x425D41F5417E, 0x425E41F5417E, 0x425F41F5417E, 0x426041F5417E, 0x426141F5417E, 0x426241F5417E, 0x426341F5417E, 0x426441F5417E, 0x426541F5417E, 0x426641F5417E, 0x426741F5417E, 0x426841F5417E, 0x426941F5417E, 0x426A41F5417E, 0x426B41F5417E,
+		0x41F6417E, 0x425141F6417E, 0x425241F6417E, 0x425341F6417E, 0x425441F6417E, 0x425541F6417E, 0x425641F6417E, 0x425741F6417E, 0x425841F6417E, 0x425941F6417E, 0x425A41F6417E, 0x425B41F6417E, 0x425C41F6417E, 0x425D41F6417E, 0x425E41F6417E, 0x425F41F6417E, 0x426041F6417E, 0x426141F6417E, 0x426241F6417E, 0x426341F6417E, 0x426441F6417E, 0x426541F6417E, 0x426641F6417E, 0x426741F6417E, 0x426841F6417E, 0x426941F6417E, 0x426A41F6417E, 0x426B41F6417E, 0x41F7417E, 0x425141F7417E, 0x425241F7417E, 0x425341F7417E,
+		0x425441F7417E, 0x425541F7417E, 0x425641F7417E, 0x425741F7417E, 0x425841F7417E, 0x425941F7417E, 0x425A41F7417E, 0x425B41F7417E, 0x425C41F7417E, 0x425D41F7417E, 0x425E41F7417E, 0x425F41F7417E, 0x426041F7417E, 0x426141F7417E, 0x426241F7417E, 0x426341F7417E, 0x426441F7417E, 0x426541F7417E, 0x426641F7417E, 0x426741F7417E, 0x426841F7417E, 0x426941F7417E, 0x426A41F7417E, 0x426B41F7417E, 0x41F8417E, 0x425141F8417E, 0x425241F8417E, 0x425341F8417E, 0x425441F8417E, 0x425541F8417E, 0x425641F8417E, 0x425741F8417E,
+		0x425841F8417E, 0x425941F8417E, 0x425A41F8417E, 0x425B41F8417E, 0x425C41F8417E, 0x425D41F8417E, 0x425E41F8417E, 0x425F41F8417E, 0x426041F8417E, 0x426141F8417E, 0x426241F8417E, 0x426341F8417E, 0x426441F8417E, 0x426541F8417E, 0x426641F8417E, 0x426741F8417E, 0x426841F8417E, 0x426941F8417E, 0x426A41F8417E, 0x426B41F8417E, 0x41F9417E, 0x425141F9417E, 0x425241F9417E, 0x425341F9417E, 0x425441F9417E, 0x425541F9417E, 0x425641F9417E, 0x425741F9417E, 0x425841F9417E, 0x425941F9417E, 0x425A41F9417E, 0x425B41F9417E,
+		0x425C41F9417E, 0x425D41F9417E, 0x425E41F9417E, 0x425F41F9417E, 0x426041F9417E, 0x426141F9417E, 0x426241F9417E, 0x426341F9417E, 0x426441F9417E, 0x426541F9417E, 0x426641F9417E, 0x426741F9417E, 0x426841F9417E, 0x426941F9417E, 0x426A41F9417E, 0x426B41F9417E, 0x41FA417E, 0x425141FA417E, 0x425241FA417E, 0x425341FA417E, 0x425441FA417E, 0x425541FA417E, 0x425641FA417E, 0x425741FA417E, 0x425841FA417E, 0x425941FA417E, 0x425A41FA417E, 0x425B41FA417E, 0x425C41FA417E, 0x425D41FA417E, 0x425E41FA417E, 0x425F41FA417E,
+		0x426041FA417E, 0x426141FA417E, 0x426241FA417E, 0x426341FA417E, 0x426441FA417E, 0x426541FA417E, 0x426641FA417E, 0x426741FA417E, 0x426841FA417E, 0x426941FA417E, 0x426A41FA417E, 0x426B41FA417E, 0x41FB417E, 0x425141FB417E, 0x425241FB417E, 0x425341FB417E, 0x425441FB417E, 0x425541FB417E, 0x425641FB417E, 0x425741FB417E, 0x425841FB417E, 0x425941FB417E, 0x425A41FB417E, 0x425B41FB417E, 0x425C41FB417E, 0x425D41FB417E, 0x425E41FB417E, 0x425F41FB417E, 0x426041FB417E, 0x426141FB417E, 0x426241FB417E, 0x426341FB417E,
+		0x426441FB417E, 0x426541FB417E, 0x426641FB417E, 0x426741FB417E, 0x426841FB417E, 0x426941FB417E, 0x426A41FB417E, 0x426B41FB417E, 0x41FC417E, 0x425141FC417E, 0x425241FC417E, 0x425341FC417E, 0x425441FC417E, 0x425541FC417E, 0x425641FC417E, 0x425741FC417E, 0x425841FC417E, 0x425941FC417E, 0x425A41FC417E, 0x425B41FC417E, 0x425C41FC417E, 0x425D41FC417E, 0x425E41FC417E, 0x425F41FC417E, 0x426041FC417E, 0x426141FC417E, 0x426241FC417E, 0x426341FC417E, 0x426441FC417E, 0x426541FC417E, 0x426641FC417E, 0x426741FC417E,
+		0x426841FC417E, 0x426941FC417E, 0x426A41FC417E, 0x426B41FC417E, 0x41FD417E, 0x425141FD417E, 0x425241FD417E, 0x425341FD417E, 0x425441FD417E, 0x425541FD417E, 0x425641FD417E, 0x425741FD417E, 0x425841FD417E, 0x425941FD417E, 0x425A41FD417E, 0x425B41FD417E, 0x425C41FD417E, 0x425D41FD417E, 0x425E41FD417E, 0x425F41FD417E, 0x426041FD417E, 0x426141FD417E, 0x426241FD417E, 0x426341FD417E, 0x426441FD417E, 0x426541FD417E, 0x426641FD417E, 0x426741FD417E, 0x426841FD417E, 0x426941FD417E, 0x426A41FD417E, 0x426B41FD417E,
+		0x41FE417E, 0x425141FE417E, 0x425241FE417E, 0x425341FE417E, 0x425441FE417E, 0x425541FE417E, 0x425641FE417E, 0x425741FE417E, 0x425841FE417E, 0x425941FE417E, 0x425A41FE417E, 0x425B41FE417E, 0x425C41FE417E, 0x425D41FE417E, 0x425E41FE417E, 0x425F41FE417E, 0x426041FE417E, 0x426141FE417E, 0x426241FE417E, 0x426341FE417E, 0x426441FE417E, 0x426541FE417E, 0x426641FE417E, 0x426741FE417E, 0x426841FE417E, 0x426941FE417E, 0x426A41FE417E, 0x426B41FE417E, 0x41FF417E, 0x425141FF417E, 0x425241FF417E, 0x425341FF417E,
+		0x425441FF417E, 0x425541FF417E, 0x425641FF417E, 0x425741FF417E, 0x425841FF417E, 0x425941FF417E, 0x425A41FF417E, 0x425B41FF417E, 0x425C41FF417E, 0x425D41FF417E, 0x425E41FF417E, 0x425F41FF417E, 0x426041FF417E, 0x426141FF417E, 0x426241FF417E, 0x426341FF417E, 0x426441FF417E, 0x426541FF417E, 0x426641FF417E, 0x426741FF417E, 0x426841FF417E, 0x426941FF417E, 0x426A41FF417E, 0x426B41FF417E, 0x4200417E, 0x42514200417E, 0x42524200417E, 0x42534200417E, 0x42544200417E, 0x42554200417E, 0x42564200417E, 0x42574200417E,
+		0x42584200417E, 0x42594200417E, 0x425A4200417E, 0x425B4200417E, 0x425C4200417E, 0x425D4200417E, 0x425E4200417E, 0x425F4200417E, 0x42604200417E, 0x42614200417E, 0x42624200417E, 0x42634200417E, 0x42644200417E, 0x42654200417E, 0x42664200417E, 0x42674200417E, 0x42684200417E, 0x42694200417E, 0x426A4200417E, 0x426B4200417E, 0x4201417E, 0x42514201417E, 0x42524201417E, 0x42534201417E, 0x42544201417E, 0x42554201417E, 0x42564201417E, 0x42574201417E, 0x42584201417E, 0x42594201417E, 0x425A4201417E, 0x425B4201417E,
+		0x425C4201417E, 0x425D4201417E, 0x425E4201417E, 0x425F4201417E, 0x42604201417E, 0x42614201417E, 0x42624201417E, 0x42634201417E, 0x42644201417E, 0x42654201417E, 0x42664201417E, 0x42674201417E, 0x42684201417E, 0x42694201417E, 0x426A4201417E, 0x426B4201417E, 0x4202417E, 0x42514202417E, 0x42524202417E, 0x42534202417E, 0x42544202417E, 0x42554202417E, 0x42564202417E, 0x42574202417E, 0x42584202417E, 0x42594202417E, 0x425A4202417E, 0x425B4202417E, 0x425C4202417E, 0x425D4202417E, 0x425E4202417E, 0x425F4202417E,
+		0x42604202417E, 0x42614202417E, 0x42624202417E, 0x42634202417E, 0x42644202417E, 0x42654202417E, 0x42664202417E, 0x42674202417E, 0x42684202417E, 0x42694202417E, 0x426A4202417E, 0x426B4202417E, 0x4203417E, 0x42514203417E, 0x42524203417E, 0x42534203417E, 0x42544203417E, 0x42554203417E, 0x42564203417E, 0x42574203417E, 0x42584203417E, 0x42594203417E, 0x425A4203417E, 0x425B4203417E, 0x425C4203417E, 0x425D4203417E, 0x425E4203417E, 0x425F4203417E, 0x42604203417E, 0x42614203417E, 0x42624203417E, 0x42634203417E,
+		0x42644203417E, 0x42654203417E, 0x42664203417E, 0x42674203417E, 0x42684203417E, 0x42694203417E, 0x426A4203417E, 0x426B4203417E, 0x4204417E, 0x42514204417E, 0x42524204417E, 0x42534204417E, 0x42544204417E, 0x42554204417E, 0x42564204417E, 0x42574204417E, 0x42584204417E, 0x42594204417E, 0x425A4204417E, 0x425B4204417E, 0x425C4204417E, 0x425D4204417E, 0x425E4204417E, 0x425F4204417E, 0x42604204417E, 0x42614204417E, 0x42624204417E, 0x42634204417E, 0x42644204417E, 0x42654204417E, 0x42664204417E, 0x42674204417E,
+		0x42684204417E, 0x42694204417E, 0x426A4204417E, 0x426B4204417E, 0x4205417E, 0x42514205417E, 0x42524205417E, 0x42534205417E, 0x42544205417E, 0x42554205417E, 0x42564205417E, 0x42574205417E, 0x42584205417E, 0x42594205417E, 0x425A4205417E, 0x425B4205417E, 0x425C4205417E, 0x425D4205417E, 0x425E4205417E, 0x425F4205417E, 0x42604205417E, 0x42614205417E, 0x42624205417E, 0x42634205417E, 0x42644205417E, 0x42654205417E, 0x42664205417E, 0x42674205417E, 0x42684205417E, 0x42694205417E, 0x426A4205417E, 0x426B4205417E,
+		0x4206417E, 0x42514206417E, 0x42524206417E, 0x42534206417E, 0x42544206417E, 0x42554206417E, 0x42564206417E, 0x42574206417E, 0x42584206417E, 0x42594206417E, 0x425A4206417E, 0x425B4206417E, 0x425C4206417E, 0x425D4206417E, 0x425E4206417E, 0x425F4206417E, 0x42604206417E, 0x42614206417E, 0x42624206417E, 0x42634206417E, 0x42644206417E, 0x42654206417E, 0x42664206417E, 0x42674206417E, 0x42684206417E, 0x42694206417E, 0x426A4206417E, 0x426B4206417E, 0x4207417E, 0x42514207417E, 0x42524207417E, 0x42534207417E,
+		0x42544207417E, 0x42554207417E, 0x42564207417E, 0x42574207417E, 0x42584207417E, 0x42594207417E, 0x425A4207417E, 0x425B4207417E, 0x425C4207417E, 0x425D4207417E, 0x425E4207417E, 0x425F4207417E, 0x42604207417E, 0x42614207417E, 0x42624207417E, 0x42634207417E, 0x42644207417E, 0x42654207417E, 0x42664207417E, 0x42674207417E, 0x42684207417E, 0x42694207417E, 0x426A4207417E, 0x426B4207417E, 0x41F3417F, 0x425141F3417F, 0x425241F3417F, 0x425341F3417F, 0x425441F3417F, 0x425541F3417F, 0x425641F3417F, 0x425741F3417F,
+		0x425841F3417F, 0x425941F3417F, 0x425A41F3417F, 0x425B41F3417F, 0x425C41F3417F, 0x425D41F3417F, 0x425E41F3417F, 0x425F41F3417F, 0x426041F3417F, 0x426141F3417F, 0x426241F3417F, 0x426341F3417F, 0x426441F3417F, 0x426541F3417F, 0x426641F3417F, 0x426741F3417F, 0x426841F3417F, 0x426941F3417F, 0x426A41F3417F, 0x426B41F3417F, 0x41F4417F, 0x425141F4417F, 0x425241F4417F, 0x425341F4417F, 0x425441F4417F, 0x425541F4417F, 0x425641F4417F, 0x425741F4417F, 0x425841F4417F, 0x425941F4417F, 0x425A41F4417F, 0x425B41F4417F,
+		0x425C41F4417F, 0x425D41F4417F, 0x425E41F4417F, 0x425F41F4417F, 0x426041F4417F, 0x426141F4417F, 0x426241F4417F, 0x426341F4417F, 0x426441F4417F, 0x426541F4417F, 0x426641F4417F, 0x426741F4417F, 0x426841F4417F, 0x426941F4417F, 0x426A41F4417F, 0x426B41F4417F, 0x41F5417F, 0x425141F5417F, 0x425241F5417F, 0x425341F5417F, 0x425441F5417F, 0x425541F5417F, 0x425641F5417F, 0x425741F5417F, 0x425841F5417F, 0x425941F5417F, 0x425A41F5417F, 0x425B41F5417F, 0x425C41F5417F, 0x425D41F5417F, 0x425E41F5417F, 0x425F41F5417F,
+		0x426041F5417F, 0x426141F5417F, 0x426241F5417F, 0x426341F5417F, 0x426441F5417F, 0x426541F5417F, 0x426641F5417F, 0x426741F5417F, 0x426841F5417F, 0x426941F5417F, 0x426A41F5417F, 0x426B41F5417F, 0x41F6417F, 0x425141F6417F, 0x425241F6417F, 0x425341F6417F, 0x425441F6417F, 0x425541F6417F, 0x425641F6417F, 0x425741F6417F, 0x425841F6417F, 0x425941F6417F, 0x425A41F6417F, 0x425B41F6417F, 0x425C41F6417F, 0x425D41F6417F, 0x425E41F6417F, 0x425F41F6417F, 0x426041F6417F, 0x426141F6417F, 0x426241F6417F, 0x426341F6417F,
+		0x426441F6417F, 0x426541F6417F, 0x426641F6417F, 0x426741F6417F, 0x426841F6417F, 0x426941F6417F, 0x426A41F6417F, 0x426B41F6417F, 0x41F7417F, 0x425141F7417F, 0x425241F7417F, 0x425341F7417F, 0x425441F7417F, 0x425541F7417F, 0x425641F7417F, 0x425741F7417F, 0x425841F7417F, 0x425941F7417F, 0x425A41F7417F, 0x425B41F7417F, 0x425C41F7417F, 0x425D41F7417F, 0x425E41F7417F, 0x425F41F7417F, 0x426041F7417F, 0x426141F7417F, 0x426241F7417F, 0x426341F7417F, 0x426441F7417F, 0x426541F7417F, 0x426641F7417F, 0x426741F7417F,
+		0x426841F7417F, 0x426941F7417F, 0x426A41F7417F, 0x426B41F7417F, 0x41F8417F, 0x425141F8417F, 0x425241F8417F, 0x425341F8417F, 0x425441F8417F, 0x425541F8417F, 0x425641F8417F, 0x425741F8417F, 0x425841F8417F, 0x425941F8417F, 0x425A41F8417F, 0x425B41F8417F, 0x425C41F8417F, 0x425D41F8417F, 0x425E41F8417F, 0x425F41F8417F, 0x426041F8417F, 0x426141F8417F, 0x426241F8417F, 0x426341F8417F, 0x426441F8417F, 0x426541F8417F, 0x426641F8417F, 0x426741F8417F, 0x426841F8417F, 0x426941F8417F, 0x426A41F8417F, 0x426B41F8417F,
+		0x41F9417F, 0x425141F9417F, 0x425241F9417F, 0x425341F9417F, 0x425441F9417F, 0x425541F9417F, 0x425641F9417F, 0x425741F9417F, 0x425841F9417F, 0x425941F9417F, 0x425A41F9417F, 0x425B41F9417F, 0x425C41F9417F, 0x425D41F9417F, 0x425E41F9417F, 0x425F41F9417F, 0x426041F9417F, 0x426141F9417F, 0x426241F9417F, 0x426341F9417F, 0x426441F9417F, 0x426541F9417F, 0x426641F9417F, 0x426741F9417F, 0x426841F9417F, 0x426941F9417F, 0x426A41F9417F, 0x426B41F9417F, 0x41FA417F, 0x425141FA417F, 0x425241FA417F, 0x425341FA417F,
+		0x425441FA417F, 0x425541FA417F, 0x425641FA417F, 0x425741FA417F, 0x425841FA417F, 0x425941FA417F, 0x425A41FA417F, 0x425B41FA417F, 0x425C41FA417F, 0x425D41FA417F, 0x425E41FA417F, 0x425F41FA417F, 0x426041FA417F, 0x426141FA417F, 0x426241FA417F, 0x426341FA417F, 0x426441FA417F, 0x426541FA417F, 0x426641FA417F, 0x426741FA417F, 0x426841FA417F, 0x426941FA417F, 0x426A41FA417F, 0x426B41FA417F, 0x41FB417F, 0x425141FB417F, 0x425241FB417F, 0x425341FB417F, 0x425441FB417F, 0x425541FB417F, 0x425641FB417F, 0x425741FB417F,
+		0x425841FB417F, 0x425941FB417F, 0x425A41FB417F, 0x425B41FB417F, 0x425C41FB417F, 0x425D41FB417F, 0x425E41FB417F, 0x425F41FB417F, 0x426041FB417F, 0x426141FB417F, 0x426241FB417F, 0x426341FB417F, 0x426441FB417F, 0x426541FB417F, 0x426641FB417F, 0x426741FB417F, 0x426841FB417F, 0x426941FB417F, 0x426A41FB417F, 0x426B41FB417F, 0x41FC417F, 0x425141FC417F, 0x425241FC417F, 0x425341FC417F, 0x425441FC417F, 0x425541FC417F, 0x425641FC417F, 0x425741FC417F, 0x425841FC417F, 0x425941FC417F, 0x425A41FC417F, 0x425B41FC417F,
+		0x425C41FC417F, 0x425D41FC417F, 0x425E41FC417F, 0x425F41FC417F, 0x426041FC417F, 0x426141FC417F, 0x426241FC417F, 0x426341FC417F, 0x426441FC417F, 0x426541FC417F, 0x426641FC417F, 0x426741FC417F, 0x426841FC417F, 0x426941FC417F, 0x426A41FC417F, 0x426B41FC417F, 0x41FD417F, 0x425141FD417F, 0x425241FD417F, 0x425341FD417F, 0x425441FD417F, 0x425541FD417F, 0x425641FD417F, 0x425741FD417F, 0x425841FD417F, 0x425941FD417F, 0x425A41FD417F, 0x425B41FD417F, 0x425C41FD417F, 0x425D41FD417F, 0x425E41FD417F, 0x425F41FD417F,
+		0x426041FD417F, 0x426141FD417F, 0x426241FD417F, 0x426341FD417F, 0x426441FD417F, 0x426541FD417F, 0x426641FD417F, 0x426741FD417F, 0x426841FD417F, 0x426941FD417F, 0x426A41FD417F, 0x426B41FD417F, 0x41FE417F, 0x425141FE417F, 0x425241FE417F, 0x425341FE417F, 0x425441FE417F, 0x425541FE417F, 0x425641FE417F, 0x425741FE417F, 0x425841FE417F, 0x425941FE417F, 0x425A41FE417F, 0x425B41FE417F, 0x425C41FE417F, 0x425D41FE417F, 0x425E41FE417F, 0x425F41FE417F, 0x426041FE417F, 0x426141FE417F, 0x426241FE417F, 0x426341FE417F,
+		0x426441FE417F, 0x426541FE417F, 0x426641FE417F, 0x426741FE417F, 0x426841FE417F, 0x426941FE417F, 0x426A41FE417F, 0x426B41FE417F, 0x41FF417F, 0x425141FF417F, 0x425241FF417F, 0x425341FF417F, 0x425441FF417F, 0x425541FF417F, 0x425641FF417F, 0x425741FF417F, 0x425841FF417F, 0x425941FF417F, 0x425A41FF417F, 0x425B41FF417F, 0x425C41FF417F, 0x425D41FF417F, 0x425E41FF417F, 0x425F41FF417F, 0x426041FF417F, 0x426141FF417F, 0x426241FF417F, 0x426341FF417F, 0x426441FF417F, 0x426541FF417F, 0x426641FF417F, 0x426741FF417F,
+		0x426841FF417F, 0x426941FF417F, 0x426A41FF417F, 0x426B41FF417F, 0x4200417F, 0x42514200417F, 0x42524200417F, 0x42534200417F, 0x42544200417F, 0x42554200417F, 0x42564200417F, 0x42574200417F, 0x42584200417F, 0x42594200417F, 0x425A4200417F, 0x425B4200417F, 0x425C4200417F, 0x425D4200417F, 0x425E4200417F, 0x425F4200417F, 0x42604200417F, 0x42614200417F, 0x42624200417F, 0x42634200417F, 0x42644200417F, 0x42654200417F, 0x42664200417F, 0x42674200417F, 0x42684200417F, 0x42694200417F, 0x426A4200417F, 0x426B4200417F,
+		0x4201417F, 0x42514201417F, 0x42524201417F, 0x42534201417F, 0x42544201417F, 0x42554201417F, 0x42564201417F, 0x42574201417F, 0x42584201417F, 0x42594201417F, 0x425A4201417F, 0x425B4201417F, 0x425C4201417F, 0x425D4201417F, 0x425E4201417F, 0x425F4201417F, 0x42604201417F, 0x42614201417F, 0x42624201417F, 0x42634201417F, 0x42644201417F, 0x42654201417F, 0x42664201417F, 0x42674201417F, 0x42684201417F, 0x42694201417F, 0x426A4201417F, 0x426B4201417F, 0x4202417F, 0x42514202417F, 0x42524202417F, 0x42534202417F,
+		0x42544202417F, 0x42554202417F, 0x42564202417F, 0x42574202417F, 0x42584202417F, 0x42594202417F, 0x425A4202417F, 0x425B4202417F, 0x425C4202417F, 0x425D4202417F, 0x425E4202417F, 0x425F4202417F, 0x42604202417F, 0x42614202417F, 0x42624202417F, 0x42634202417F, 0x42644202417F, 0x42654202417F, 0x42664202417F, 0x42674202417F, 0x42684202417F, 0x42694202417F, 0x426A4202417F, 0x426B4202417F, 0x4203417F, 0x42514203417F, 0x42524203417F, 0x42534203417F, 0x42544203417F, 0x42554203417F, 0x42564203417F, 0x42574203417F,
+		0x42584203417F, 0x42594203417F, 0x425A4203417F, 0x425B4203417F, 0x425C4203417F, 0x425D4203417F, 0x425E4203417F, 0x425F4203417F, 0x42604203417F, 0x42614203417F, 0x42624203417F, 0x42634203417F, 0x42644203417F, 0x42654203417F, 0x42664203417F, 0x42674203417F, 0x42684203417F, 0x42694203417F, 0x426A4203417F, 0x426B4203417F, 0x4204417F, 0x42514204417F, 0x42524204417F, 0x42534204417F, 0x42544204417F, 0x42554204417F, 0x42564204417F, 0x42574204417F, 0x42584204417F, 0x42594204417F, 0x425A4204417F, 0x425B4204417F,
+		0x425C4204417F, 0x425D4204417F, 0x425E4204417F, 0x425F4204417F, 0x42604204417F, 0x42614204417F, 0x42624204417F, 0x42634204417F, 0x42644204417F, 0x42654204417F, 0x42664204417F, 0x42674204417F, 0x42684204417F, 0x42694204417F, 0x426A4204417F, 0x426B4204417F, 0x4205417F, 0x42514205417F, 0x42524205417F, 0x42534205417F, 0x42544205417F, 0x42554205417F, 0x42564205417F, 0x42574205417F, 0x42584205417F, 0x42594205417F, 0x425A4205417F, 0x425B4205417F, 0x425C4205417F, 0x425D4205417F, 0x425E4205417F, 0x425F4205417F,
+		0x42604205417F, 0x42614205417F, 0x42624205417F, 0x42634205417F, 0x42644205417F, 0x42654205417F, 0x42664205417F, 0x42674205417F, 0x42684205417F, 0x42694205417F, 0x426A4205417F, 0x426B4205417F, 0x4206417F, 0x42514206417F, 0x42524206417F, 0x42534206417F, 0x42544206417F, 0x42554206417F, 0x42564206417F, 0x42574206417F, 0x42584206417F, 0x42594206417F, 0x425A4206417F, 0x425B4206417F, 0x425C4206417F, 0x425D4206417F, 0x425E4206417F, 0x425F4206417F, 0x42604206417F, 0x42614206417F, 0x42624206417F, 0x42634206417F,
+		0x42644206417F, 0x42654206417F, 0x42664206417F, 0x42674206417F, 0x42684206417F, 0x42694206417F, 0x426A4206417F, 0x426B4206417F, 0x4207417F, 0x42514207417F, 0x42524207417F, 0x42534207417F, 0x42544207417F, 0x42554207417F, 0x42564207417F, 0x42574207417F, 0x42584207417F, 0x42594207417F, 0x425A4207417F, 0x425B4207417F, 0x425C4207417F, 0x425D4207417F, 0x425E4207417F, 0x425F4207417F, 0x42604207417F, 0x42614207417F, 0x42624207417F, 0x42634207417F, 0x42644207417F, 0x42654207417F, 0x42664207417F, 0x42674207417F,
+		0x42684207417F, 0x42694207417F, 0x426A4207417F, 0x426B4207417F, 0x41F34180, 0x425141F34180, 0x425241F34180, 0x425341F34180, 0x425441F34180, 0x425541F34180, 0x425641F34180, 0x425741F34180, 0x425841F34180, 0x425941F34180, 0x425A41F34180, 0x425B41F34180, 0x425C41F34180, 0x425D41F34180, 0x425E41F34180, 0x425F41F34180, 0x426041F34180, 0x426141F34180, 0x426241F34180, 0x426341F34180, 0x426441F34180, 0x426541F34180, 0x426641F34180, 0x426741F34180, 0x426841F34180, 0x426941F34180, 0x426A41F34180, 0x426B41F34180,
+		0x41F44180, 0x425141F44180, 0x425241F44180, 0x425341F44180, 0x425441F44180, 0x425541F44180, 0x425641F44180, 0x425741F44180, 0x425841F44180, 0x425941F44180, 0x425A41F44180, 0x425B41F44180, 0x425C41F44180, 0x425D41F44180, 0x425E41F44180, 0x425F41F44180, 0x426041F44180, 0x426141F44180, 0x426241F44180, 0x426341F44180, 0x426441F44180, 0x426541F44180, 0x426641F44180, 0x426741F44180, 0x426841F44180, 0x426941F44180, 0x426A41F44180, 0x426B41F44180, 0x41F54180, 0x425141F54180, 0x425241F54180, 0x425341F54180,
+		0x425441F54180, 0x425541F54180, 0x425641F54180, 0x425741F54180, 0x425841F54180, 0x425941F54180, 0x425A41F54180, 0x425B41F54180, 0x425C41F54180, 0x425D41F54180, 0x425E41F54180, 0x425F41F54180, 0x426041F54180, 0x426141F54180, 0x426241F54180, 0x426341F54180, 0x426441F54180, 0x426541F54180, 0x426641F54180, 0x426741F54180, 0x426841F54180, 0x426941F54180, 0x426A41F54180, 0x426B41F54180, 0x41F64180, 0x425141F64180, 0x425241F64180, 0x425341F64180, 0x425441F64180, 0x425541F64180, 0x425641F64180, 0x425741F64180,
+		0x425841F64180, 0x425941F64180, 0x425A41F64180, 0x425B41F64180, 0x425C41F64180, 0x425D41F64180, 0x425E41F64180, 0x425F41F64180, 0x426041F64180, 0x426141F64180, 0x426241F64180, 0x426341F64180, 0x426441F64180, 0x426541F64180, 0x426641F64180, 0x426741F64180, 0x426841F64180, 0x426941F64180, 0x426A41F64180, 0x426B41F64180, 0x41F74180, 0x425141F74180, 0x425241F74180, 0x425341F74180, 0x425441F74180, 0x425541F74180, 0x425641F74180, 0x425741F74180, 0x425841F74180, 0x425941F74180, 0x425A41F74180, 0x425B41F74180,
+		0x425C41F74180, 0x425D41F74180, 0x425E41F74180, 0x425F41F74180, 0x426041F74180, 0x426141F74180, 0x426241F74180, 0x426341F74180, 0x426441F74180, 0x426541F74180, 0x426641F74180, 0x426741F74180, 0x426841F74180, 0x426941F74180, 0x426A41F74180, 0x426B41F74180, 0x41F84180, 0x425141F84180, 0x425241F84180, 0x425341F84180, 0x425441F84180, 0x425541F84180, 0x425641F84180, 0x425741F84180, 0x425841F84180, 0x425941F84180, 0x425A41F84180, 0x425B41F84180, 0x425C41F84180, 0x425D41F84180, 0x425E41F84180, 0x425F41F84180,
+		0x426041F84180, 0x426141F84180, 0x426241F84180, 0x426341F84180, 0x426441F84180, 0x426541F84180, 0x426641F84180, 0x426741F84180, 0x426841F84180, 0x426941F84180, 0x426A41F84180, 0x426B41F84180, 0x41F94180, 0x425141F94180, 0x425241F94180, 0x425341F94180, 0x425441F94180, 0x425541F94180, 0x425641F94180, 0x425741F94180, 0x425841F94180, 0x425941F94180, 0x425A41F94180, 0x425B41F94180, 0x425C41F94180, 0x425D41F94180, 0x425E41F94180, 0x425F41F94180, 0x426041F94180, 0x426141F94180, 0x426241F94180, 0x426341F94180,
+		0x426441F94180, 0x426541F94180, 0x426641F94180, 0x426741F94180, 0x426841F94180, 0x426941F94180, 0x426A41F94180, 0x426B41F94180, 0x41FA4180, 0x425141FA4180, 0x425241FA4180, 0x425341FA4180, 0x425441FA4180, 0x425541FA4180, 0x425641FA4180, 0x425741FA4180, 0x425841FA4180, 0x425941FA4180, 0x425A41FA4180, 0x425B41FA4180, 0x425C41FA4180, 0x425D41FA4180, 0x425E41FA4180, 0x425F41FA4180, 0x426041FA4180, 0x426141FA4180, 0x426241FA4180, 0x426341FA4180, 0x426441FA4180, 0x426541FA4180, 0x426641FA4180, 0x426741FA4180,
+		0x426841FA4180, 0x426941FA4180, 0x426A41FA4180, 0x426B41FA4180, 0x41FB4180, 0x425141FB4180, 0x425241FB4180, 0x425341FB4180, 0x425441FB4180, 0x425541FB4180, 0x425641FB4180, 0x425741FB4180, 0x425841FB4180, 0x425941FB4180, 0x425A41FB4180, 0x425B41FB4180, 0x425C41FB4180, 0x425D41FB4180, 0x425E41FB4180, 0x425F41FB4180, 0x426041FB4180, 0x426141FB4180, 0x426241FB4180, 0x426341FB4180, 0x426441FB4180, 0x426541FB4180, 0x426641FB4180, 0x426741FB4180, 0x426841FB4180, 0x426941FB4180, 0x426A41FB4180, 0x426B41FB4180,
+		0x41FC4180, 0x425141FC4180, 0x425241FC4180, 0x425341FC4180, 0x425441FC4180, 0x425541FC4180, 0x425641FC4180, 0x425741FC4180, 0x425841FC4180, 0x425941FC4180, 0x425A41FC4180, 0x425B41FC4180, 0x425C41FC4180, 0x425D41FC4180, 0x425E41FC4180, 0x425F41FC4180, 0x426041FC4180, 0x426141FC4180, 0x426241FC4180, 0x426341FC4180, 0x426441FC4180, 0x426541FC4180, 0x426641FC4180, 0x426741FC4180, 0x426841FC4180, 0x426941FC4180, 0x426A41FC4180, 0x426B41FC4180, 0x41FD4180, 0x425141FD4180, 0x425241FD4180, 0x425341FD4180,
+		0x425441FD4180, 0x425541FD4180, 0x425641FD4180, 0x425741FD4180, 0x425841FD4180, 0x425941FD4180, 0x425A41FD4180, 0x425B41FD4180, 0x425C41FD4180, 0x425D41FD4180, 0x425E41FD4180, 0x425F41FD4180, 0x426041FD4180, 0x426141FD4180, 0x426241FD4180, 0x426341FD4180, 0x426441FD4180, 0x426541FD4180, 0x426641FD4180, 0x426741FD4180, 0x426841FD4180, 0x426941FD4180, 0x426A41FD4180, 0x426B41FD4180, 0x41FE4180, 0x425141FE4180, 0x425241FE4180, 0x425341FE4180, 0x425441FE4180, 0x425541FE4180, 0x425641FE4180, 0x425741FE4180,
+		0x425841FE4180, 0x425941FE4180, 0x425A41FE4180, 0x425B41FE4180, 0x425C41FE4180, 0x425D41FE4180, 0x425E41FE4180, 0x425F41FE4180, 0x426041FE4180, 0x426141FE4180, 0x426241FE4180, 0x426341FE4180, 0x426441FE4180, 0x426541FE4180, 0x426641FE4180, 0x426741FE4180, 0x426841FE4180, 0x426941FE4180, 0x426A41FE4180, 0x426B41FE4180, 0x41FF4180, 0x425141FF4180, 0x425241FF4180, 0x425341FF4180, 0x425441FF4180, 0x425541FF4180, 0x425641FF4180, 0x425741FF4180, 0x425841FF4180, 0x425941FF4180, 0x425A41FF4180, 0x425B41FF4180,
+		0x425C41FF4180, 0x425D41FF4180, 0x425E41FF4180, 0x425F41FF4180, 0x426041FF4180, 0x426141FF4180, 0x426241FF4180, 0x426341FF4180, 0x426441FF4180, 0x426541FF4180, 0x426641FF4180, 0x426741FF4180, 0x426841FF4180, 0x426941FF4180, 0x426A41FF4180, 0x426B41FF4180, 0x42004180, 0x425142004180, 0x425242004180, 0x425342004180, 0x425442004180, 0x425542004180, 0x425642004180, 0x425742004180, 0x425842004180, 0x425942004180, 0x425A42004180, 0x425B42004180, 0x425C42004180, 0x425D42004180, 0x425E42004180, 0x425F42004180,
+		0x426042004180, 0x426142004180, 0x426242004180, 0x426342004180, 0x426442004180, 0x426542004180, 0x426642004180, 0x426742004180, 0x426842004180, 0x426942004180, 0x426A42004180, 0x426B42004180, 0x42014180, 0x425142014180, 0x425242014180, 0x425342014180, 0x425442014180, 0x425542014180, 0x425642014180, 0x425742014180, 0x425842014180, 0x425942014180, 0x425A42014180, 0x425B42014180, 0x425C42014180, 0x425D42014180, 0x425E42014180, 0x425F42014180, 0x426042014180, 0x426142014180, 0x426242014180, 0x426342014180,
+		0x426442014180, 0x426542014180, 0x426642014180, 0x426742014180, 0x426842014180, 0x426942014180, 0x426A42014180, 0x426B42014180, 0x42024180, 0x425142024180, 0x425242024180, 0x425342024180, 0x425442024180, 0x425542024180, 0x425642024180, 0x425742024180, 0x425842024180, 0x425942024180, 0x425A42024180, 0x425B42024180, 0x425C42024180, 0x425D42024180, 0x425E42024180, 0x425F42024180, 0x426042024180, 0x426142024180, 0x426242024180, 0x426342024180, 0x426442024180, 0x426542024180, 0x426642024180, 0x426742024180,
+		0x426842024180, 0x426942024180, 0x426A42024180, 0x426B42024180, 0x42034180, 0x425142034180, 0x425242034180, 0x425342034180, 0x425442034180, 0x425542034180, 0x425642034180, 0x425742034180, 0x425842034180, 0x425942034180, 0x425A42034180, 0x425B42034180, 0x425C42034180, 0x425D42034180, 0x425E42034180, 0x425F42034180, 0x426042034180, 0x426142034180, 0x426242034180, 0x426342034180, 0x426442034180, 0x426542034180, 0x426642034180, 0x426742034180, 0x426842034180, 0x426942034180, 0x426A42034180, 0x426B42034180,
+		0x42044180, 0x425142044180, 0x425242044180, 0x425342044180, 0x425442044180, 0x425542044180, 0x425642044180, 0x425742044180, 0x425842044180, 0x425942044180, 0x425A42044180, 0x425B42044180, 0x425C42044180, 0x425D42044180, 0x425E42044180, 0x425F42044180, 0x426042044180, 0x426142044180, 0x426242044180, 0x426342044180, 0x426442044180, 0x426542044180, 0x426642044180, 0x426742044180, 0x426842044180, 0x426942044180, 0x426A42044180, 0x426B42044180, 0x42054180, 0x425142054180, 0x425242054180, 0x425342054180,
+		0x425442054180, 0x425542054180, 0x425642054180, 0x425742054180, 0x425842054180, 0x425942054180, 0x425A42054180, 0x425B42054180, 0x425C42054180, 0x425D42054180, 0x425E42054180, 0x425F42054180, 0x426042054180, 0x426142054180, 0x426242054180, 0x426342054180, 0x426442054180, 0x426542054180, 0x426642054180, 0x426742054180, 0x426842054180, 0x426942054180, 0x426A42054180, 0x426B42054180, 0x42064180, 0x425142064180, 0x425242064180, 0x425342064180, 0x425442064180, 0x425542064180, 0x425642064180, 0x425742064180,
+		0x425842064180, 0x425942064180, 0x425A42064180, 0x425B42064180, 0x425C42064180, 0x425D42064180, 0x425E42064180, 0x425F42064180, 0x426042064180, 0x426142064180, 0x426242064180, 0x426342064180, 0x426442064180, 0x426542064180, 0x426642064180, 0x426742064180, 0x426842064180, 0x426942064180, 0x426A42064180, 0x426B42064180, 0x42074180, 0x425142074180, 0x425242074180, 0x425342074180, 0x425442074180, 0x425542074180, 0x425642074180, 0x425742074180, 0x425842074180, 0x425942074180, 0x425A42074180, 0x425B42074180,
+		0x425C42074180, 0x425D42074180, 0x425E42074180, 0x425F42074180, 0x426042074180, 0x426142074180, 0x426242074180, 0x426342074180, 0x426442074180, 0x426542074180, 0x426642074180, 0x426742074180, 0x426842074180, 0x426942074180, 0x426A42074180, 0x426B42074180, 0x41F34181, 0x425141F34181, 0x425241F34181, 0x425341F34181, 0x425441F34181, 0x425541F34181, 0x425641F34181, 0x425741F34181, 0x425841F34181, 0x425941F34181, 0x425A41F34181, 0x425B41F34181, 0x425C41F34181, 0x425D41F34181, 0x425E41F34181, 0x425F41F34181,
+		0x426041F34181, 0x426141F34181, 0x426241F34181, 0x426341F34181, 0x426441F34181, 0x426541F34181, 0x426641F34181, 0x426741F34181, 0x426841F34181, 0x426941F34181, 0x426A41F34181, 0x426B41F34181, 0x41F44181, 0x425141F44181, 0x425241F44181, 0x425341F44181, 0x425441F44181, 0x425541F44181, 0x425641F44181, 0x425741F44181, 0x425841F44181, 0x425941F44181, 0x425A41F44181, 0x425B41F44181, 0x425C41F44181, 0x425D41F44181, 0x425E41F44181, 0x425F41F44181, 0x426041F44181, 0x426141F44181, 0x426241F44181, 0x426341F44181,
+		0x426441F44181, 0x426541F44181, 0x426641F44181, 0x426741F44181, 0x426841F44181, 0x426941F44181, 0x426A41F44181, 0x426B41F44181, 0x41F54181, 0x425141F54181, 0x425241F54181, 0x425341F54181, 0x425441F54181, 0x425541F54181, 0x425641F54181, 0x425741F54181, 0x425841F54181, 0x425941F54181, 0x425A41F54181, 0x425B41F54181, 0x425C41F54181, 0x425D41F54181, 0x425E41F54181, 0x425F41F54181, 0x426041F54181, 0x426141F54181, 0x426241F54181, 0x426341F54181, 0x426441F54181, 0x426541F54181, 0x426641F54181, 0x426741F54181,
+		0x426841F54181, 0x426941F54181, 0x426A41F54181, 0x426B41F54181, 0x41F64181, 0x425141F64181, 0x425241F64181, 0x425341F64181, 0x425441F64181, 0x425541F64181, 0x425641F64181, 0x425741F64181, 0x425841F64181, 0x425941F64181, 0x425A41F64181, 0x425B41F64181, 0x425C41F64181, 0x425D41F64181, 0x425E41F64181, 0x425F41F64181, 0x426041F64181, 0x426141F64181, 0x426241F64181, 0x426341F64181, 0x426441F64181, 0x426541F64181, 0x426641F64181, 0x426741F64181, 0x426841F64181, 0x426941F64181, 0x426A41F64181, 0x426B41F64181,
+		0x41F74181, 0x425141F74181, 0x425241F74181, 0x425341F74181, 0x425441F74181, 0x425541F74181, 0x425641F74181, 0x425741F74181, 0x425841F74181, 0x425941F74181, 0x425A41F74181, 0x425B41F74181, 0x425C41F74181, 0x425D41F74181, 0x425E41F74181, 0x425F41F74181, 0x426041F74181, 0x426141F74181, 0x426241F74181, 0x426341F74181, 0x426441F74181, 0x426541F74181, 0x426641F74181, 0x426741F74181, 0x426841F74181, 0x426941F74181, 0x426A41F74181, 0x426B41F74181, 0x41F84181, 0x425141F84181, 0x425241F84181, 0x425341F84181,
+		0x425441F84181, 0x425541F84181, 0x425641F84181, 0x425741F84181, 0x425841F84181, 0x425941F84181, 0x425A41F84181, 0x425B41F84181, 0x425C41F84181, 0x425D41F84181, 0x425E41F84181, 0x425F41F84181, 0x426041F84181, 0x426141F84181, 0x426241F84181, 0x426341F84181, 0x426441F84181, 0x426541F84181, 0x426641F84181, 0x426741F84181, 0x426841F84181, 0x426941F84181, 0x426A41F84181, 0x426B41F84181, 0x41F94181, 0x425141F94181, 0x425241F94181, 0x425341F94181, 0x425441F94181, 0x425541F94181, 0x425641F94181, 0x425741F94181,
+		0x425841F94181, 0x425941F94181, 0x425A41F94181, 0x425B41F94181, 0x425C41F94181, 0x425D41F94181, 0x425E41F94181, 0x425F41F94181, 0x426041F94181, 0x426141F94181, 0x426241F94181, 0x426341F94181, 0x426441F94181, 0x426541F94181, 0x426641F94181, 0x426741F94181, 0x426841F94181, 0x426941F94181, 0x426A41F94181, 0x426B41F94181, 0x41FA4181, 0x425141FA4181, 0x425241FA4181, 0x425341FA4181, 0x425441FA4181, 0x425541FA4181, 0x425641FA4181, 0x425741FA4181, 0x425841FA4181, 0x425941FA4181, 0x425A41FA4181, 0x425B41FA4181,
+		0x425C41FA4181, 0x425D41FA4181, 0x425E41FA4181, 0x425F41FA4181, 0x426041FA4181, 0x426141FA4181, 0x426241FA4181, 0x426341FA4181, 0x426441FA4181, 0x426541FA4181, 0x426641FA4181, 0x426741FA4181, 0x426841FA4181, 0x426941FA4181, 0x426A41FA4181, 0x426B41FA4181, 0x41FB4181, 0x425141FB4181, 0x425241FB4181, 0x425341FB4181, 0x425441FB4181, 0x425541FB4181, 0x425641FB4181, 0x425741FB4181, 0x425841FB4181, 0x425941FB4181, 0x425A41FB4181, 0x425B41FB4181, 0x425C41FB4181, 0x425D41FB4181, 0x425E41FB4181, 0x425F41FB4181,
+		0x426041FB4181, 0x426141FB4181, 0x426241FB4181, 0x426341FB4181, 0x426441FB4181, 0x426541FB4181, 0x426641FB4181, 0x426741FB4181, 0x426841FB4181, 0x426941FB4181, 0x426A41FB4181, 0x426B41FB4181, 0x41FC4181, 0x425141FC4181, 0x425241FC4181, 0x425341FC4181, 0x425441FC4181, 0x425541FC4181, 0x425641FC4181, 0x425741FC4181, 0x425841FC4181, 0x425941FC4181, 0x425A41FC4181, 0x425B41FC4181, 0x425C41FC4181, 0x425D41FC4181, 0x425E41FC4181, 0x425F41FC4181, 0x426041FC4181, 0x426141FC4181, 0x426241FC4181, 0x426341FC4181,
+		0x426441FC4181, 0x426541FC4181, 0x426641FC4181, 0x426741FC4181, 0x426841FC4181, 0x426941FC4181, 0x426A41FC4181, 0x426B41FC4181, 0x41FD4181, 0x425141FD4181, 0x425241FD4181, 0x425341FD4181, 0x425441FD4181, 0x425541FD4181, 0x425641FD4181, 0x425741FD4181, 0x425841FD4181, 0x425941FD4181, 0x425A41FD4181, 0x425B41FD4181, 0x425C41FD4181, 0x425D41FD4181, 0x425E41FD4181, 0x425F41FD4181, 0x426041FD4181, 0x426141FD4181, 0x426241FD4181, 0x426341FD4181, 0x426441FD4181, 0x426541FD4181, 0x426641FD4181, 0x426741FD4181,
+		0x426841FD4181, 0x426941FD4181, 0x426A41FD4181, 0x426B41FD4181, 0x41FE4181, 0x425141FE4181, 0x425241FE4181, 0x425341FE4181, 0x425441FE4181, 0x425541FE4181, 0x425641FE4181, 0x425741FE4181, 0x425841FE4181, 0x425941FE4181, 0x425A41FE4181, 0x425B41FE4181, 0x425C41FE4181, 0x425D41FE4181, 0x425E41FE4181, 0x425F41FE4181, 0x426041FE4181, 0x426141FE4181, 0x426241FE4181, 0x426341FE4181, 0x426441FE4181, 0x426541FE4181, 0x426641FE4181, 0x426741FE4181, 0x426841FE4181, 0x426941FE4181, 0x426A41FE4181, 0x426B41FE4181,
+		0x41FF4181, 0x425141FF4181, 0x425241FF4181, 0x425341FF4181, 0x425441FF4181, 0x425541FF4181, 0x425641FF4181, 0x425741FF4181, 0x425841FF4181, 0x425941FF4181, 0x425A41FF4181, 0x425B41FF4181, 0x425C41FF4181, 0x425D41FF4181, 0x425E41FF4181, 0x425F41FF4181, 0x426041FF4181, 0x426141FF4181, 0x426241FF4181, 0x426341FF4181, 0x426441FF4181, 0x426541FF4181, 0x426641FF4181, 0x426741FF4181, 0x426841FF4181, 0x426941FF4181, 0x426A41FF4181, 0x426B41FF4181, 0x42004181, 0x425142004181, 0x425242004181, 0x425342004181,
+		0x425442004181, 0x425542004181, 0x425642004181, 0x425742004181, 0x425842004181, 0x425942004181, 0x425A42004181, 0x425B42004181, 0x425C42004181, 0x425D42004181, 0x425E42004181, 0x425F42004181, 0x426042004181, 0x426142004181, 0x426242004181, 0x426342004181, 0x426442004181, 0x426542004181, 0x426642004181, 0x426742004181, 0x426842004181, 0x426942004181, 0x426A42004181, 0x426B42004181, 0x42014181, 0x425142014181, 0x425242014181, 0x425342014181, 0x425442014181, 0x425542014181, 0x425642014181, 0x425742014181,
+		0x425842014181, 0x425942014181, 0x425A42014181, 0x425B42014181, 0x425C42014181, 0x425D42014181, 0x425E42014181, 0x425F42014181, 0x426042014181, 0x426142014181, 0x426242014181, 0x426342014181, 0x426442014181, 0x426542014181, 0x426642014181, 0x426742014181, 0x426842014181, 0x426942014181, 0x426A42014181, 0x426B42014181, 0x42024181, 0x425142024181, 0x425242024181, 0x425342024181, 0x425442024181, 0x425542024181, 0x425642024181, 0x425742024181, 0x425842024181, 0x425942024181, 0x425A42024181, 0x425B42024181,
+		0x425C42024181, 0x425D42024181, 0x425E42024181, 0x425F42024181, 0x426042024181, 0x426142024181, 0x426242024181, 0x426342024181, 0x426442024181, 0x426542024181, 0x426642024181, 0x426742024181, 0x426842024181, 0x426942024181, 0x426A42024181, 0x426B42024181, 0x42034181, 0x425142034181, 0x425242034181, 0x425342034181, 0x425442034181, 0x425542034181, 0x425642034181, 0x425742034181, 0x425842034181, 0x425942034181, 0x425A42034181, 0x425B42034181, 0x425C42034181, 0x425D42034181, 0x425E42034181, 0x425F42034181,
+		0x426042034181, 0x426142034181, 0x426242034181, 0x426342034181, 0x426442034181, 0x426542034181, 0x426642034181, 0x426742034181, 0x426842034181, 0x426942034181, 0x426A42034181, 0x426B42034181, 0x42044181, 0x425142044181, 0x425242044181, 0x425342044181, 0x425442044181, 0x425542044181, 0x425642044181, 0x425742044181, 0x425842044181, 0x425942044181, 0x425A42044181, 0x425B42044181, 0x425C42044181, 0x425D42044181, 0x425E42044181, 0x425F42044181, 0x426042044181, 0x426142044181, 0x426242044181, 0x426342044181,
+		0x426442044181, 0x426542044181, 0x426642044181, 0x426742044181, 0x426842044181, 0x426942044181, 0x426A42044181, 0x426B42044181, 0x42054181, 0x425142054181, 0x425242054181, 0x425342054181, 0x425442054181, 0x425542054181, 0x425642054181, 0x425742054181, 0x425842054181, 0x425942054181, 0x425A42054181, 0x425B42054181, 0x425C42054181, 0x425D42054181, 0x425E42054181, 0x425F42054181, 0x426042054181, 0x426142054181, 0x426242054181, 0x426342054181, 0x426442054181, 0x426542054181, 0x426642054181, 0x426742054181,
+		0x426842054181, 0x426942054181, 0x426A42054181, 0x426B42054181, 0x42064181, 0x425142064181, 0x425242064181, 0x425342064181, 0x425442064181, 0x425542064181, 0x425642064181, 0x425742064181, 0x425842064181, 0x425942064181, 0x425A42064181, 0x425B42064181, 0x425C42064181, 0x425D42064181, 0x425E42064181, 0x425F42064181, 0x426042064181, 0x426142064181, 0x426242064181, 0x426342064181, 0x426442064181, 0x426542064181, 0x426642064181, 0x426742064181, 0x426842064181, 0x426942064181, 0x426A42064181, 0x426B42064181,
+		0x42074181, 0x425142074181, 0x425242074181, 0x425342074181, 0x425442074181, 0x425542074181, 0x425642074181, 0x425742074181, 0x425842074181, 0x425942074181, 0x425A42074181, 0x425B42074181, 0x425C42074181, 0x425D42074181, 0x425E42074181, 0x425F42074181, 0x426042074181, 0x426142074181, 0x426242074181, 0x426342074181, 0x426442074181, 0x426542074181, 0x426642074181, 0x426742074181, 0x426842074181, 0x426942074181, 0x426A42074181, 0x426B42074181, 0x41F34182, 0x425141F34182, 0x425241F34182, 0x425341F34182,
+		0x425441F34182, 0x425541F34182, 0x425641F34182, 0x425741F34182, 0x425841F34182, 0x425941F34182, 0x425A41F34182, 0x425B41F34182, 0x425C41F34182, 0x425D41F34182, 0x425E41F34182, 0x425F41F34182, 0x426041F34182, 0x426141F34182, 0x426241F34182, 0x426341F34182, 0x426441F34182, 0x426541F34182, 0x426641F34182, 0x426741F34182, 0x426841F34182, 0x426941F34182, 0x426A41F34182, 0x426B41F34182, 0x41F44182, 0x425141F44182, 0x425241F44182, 0x425341F44182, 0x425441F44182, 0x425541F44182, 0x425641F44182, 0x425741F44182,
+		0x425841F44182, 0x425941F44182, 0x425A41F44182, 0x425B41F44182, 0x425C41F44182, 0x425D41F44182, 0x425E41F44182, 0x425F41F44182, 0x426041F44182, 0x426141F44182, 0x426241F44182, 0x426341F44182, 0x426441F44182, 0x426541F44182, 0x426641F44182, 0x426741F44182, 0x426841F44182, 0x426941F44182, 0x426A41F44182, 0x426B41F44182, 0x41F54182, 0x425141F54182, 0x425241F54182, 0x425341F54182, 0x425441F54182, 0x425541F54182, 0x425641F54182, 0x425741F54182, 0x425841F54182, 0x425941F54182, 0x425A41F54182, 0x425B41F54182,
+		0x425C41F54182, 0x425D41F54182, 0x425E41F54182, 0x425F41F54182, 0x426041F54182, 0x426141F54182, 0x426241F54182, 0x426341F54182, 0x426441F54182, 0x426541F54182, 0x426641F54182, 0x426741F54182, 0x426841F54182, 0x426941F54182, 0x426A41F54182, 0x426B41F54182, 0x41F64182, 0x425141F64182, 0x425241F64182, 0x425341F64182, 0x425441F64182, 0x425541F64182, 0x425641F64182, 0x425741F64182, 0x425841F64182, 0x425941F64182, 0x425A41F64182, 0x425B41F64182, 0x425C41F64182, 0x425D41F64182, 0x425E41F64182, 0x425F41F64182,
+		0x426041F64182, 0x426141F64182, 0x426241F64182, 0x426341F64182, 0x426441F64182, 0x426541F64182, 0x426641F64182, 0x426741F64182, 0x426841F64182, 0x426941F64182, 0x426A41F64182, 0x426B41F64182, 0x41F74182, 0x425141F74182, 0x425241F74182, 0x425341F74182, 0x425441F74182, 0x425541F74182, 0x425641F74182, 0x425741F74182, 0x425841F74182, 0x425941F74182, 0x425A41F74182, 0x425B41F74182, 0x425C41F74182, 0x425D41F74182, 0x425E41F74182, 0x425F41F74182, 0x426041F74182, 0x426141F74182, 0x426241F74182, 0x426341F74182,
+		0x426441F74182, 0x426541F74182, 0x426641F74182, 0x426741F74182, 0x426841F74182, 0x426941F74182, 0x426A41F74182, 0x426B41F74182, 0x41F84182, 0x425141F84182, 0x425241F84182, 0x425341F84182, 0x425441F84182, 0x425541F84182, 0x425641F84182, 0x425741F84182, 0x425841F84182, 0x425941F84182, 0x425A41F84182, 0x425B41F84182, 0x425C41F84182, 0x425D41F84182, 0x425E41F84182, 0x425F41F84182, 0x426041F84182, 0x426141F84182, 0x426241F84182, 0x426341F84182, 0x426441F84182, 0x426541F84182, 0x426641F84182, 0x426741F84182,
+		0x426841F84182, 0x426941F84182, 0x426A41F84182, 0x426B41F84182, 0x41F94182, 0x425141F94182, 0x425241F94182, 0x425341F94182, 0x425441F94182, 0x425541F94182, 0x425641F94182, 0x425741F94182, 0x425841F94182, 0x425941F94182, 0x425A41F94182, 0x425B41F94182, 0x425C41F94182, 0x425D41F94182, 0x425E41F94182, 0x425F41F94182, 0x426041F94182, 0x426141F94182, 0x426241F94182, 0x426341F94182, 0x426441F94182, 0x426541F94182, 0x426641F94182, 0x426741F94182, 0x426841F94182, 0x426941F94182, 0x426A41F94182, 0x426B41F94182,
+		0x41FA4182, 0x425141FA4182, 0x425241FA4182, 0x425341FA4182, 0x425441FA4182, 0x425541FA4182, 0x425641FA4182, 0x425741FA4182, 0x425841FA4182, 0x425941FA4182, 0x425A41FA4182, 0x425B41FA4182, 0x425C41FA4182, 0x425D41FA4182, 0x425E41FA4182, 0x425F41FA4182, 0x426041FA4182, 0x426141FA4182, 0x426241FA4182, 0x426341FA4182, 0x426441FA4182, 0x426541FA4182, 0x426641FA4182, 0x426741FA4182, 0x426841FA4182, 0x426941FA4182, 0x426A41FA4182, 0x426B41FA4182, 0x41FB4182, 0x425141FB4182, 0x425241FB4182, 0x425341FB4182,
+		0x425441FB4182, 0x425541FB4182, 0x425641FB4182, 0x425741FB4182, 0x425841FB4182, 0x425941FB4182, 0x425A41FB4182, 0x425B41FB4182, 0x425C41FB4182, 0x425D41FB4182, 0x425E41FB4182, 0x425F41FB4182, 0x426041FB4182, 0x426141FB4182, 0x426241FB4182, 0x426341FB4182, 0x426441FB4182, 0x426541FB4182, 0x426641FB4182, 0x426741FB4182, 0x426841FB4182, 0x426941FB4182, 0x426A41FB4182, 0x426B41FB4182, 0x41FC4182, 0x425141FC4182, 0x425241FC4182, 0x425341FC4182, 0x425441FC4182, 0x425541FC4182, 0x425641FC4182, 0x425741FC4182,
+		0x425841FC4182, 0x425941FC4182, 0x425A41FC4182, 0x425B41FC4182, 0x425C41FC4182, 0x425D41FC4182, 0x425E41FC4182, 0x425F41FC4182, 0x426041FC4182, 0x426141FC4182, 0x426241FC4182, 0x426341FC4182, 0x426441FC4182, 0x426541FC4182, 0x426641FC4182, 0x426741FC4182, 0x426841FC4182, 0x426941FC4182, 0x426A41FC4182, 0x426B41FC4182, 0x41FD4182, 0x425141FD4182, 0x425241FD4182, 0x425341FD4182, 0x425441FD4182, 0x425541FD4182, 0x425641FD4182, 0x425741FD4182, 0x425841FD4182, 0x425941FD4182, 0x425A41FD4182, 0x425B41FD4182,
+		0x425C41FD4182, 0x425D41FD4182, 0x425E41FD4182, 0x425F41FD4182, 0x426041FD4182, 0x426141FD4182, 0x426241FD4182, 0x426341FD4182, 0x426441FD4182, 0x426541FD4182, 0x426641FD4182, 0x426741FD4182, 0x426841FD4182, 0x426941FD4182, 0x426A41FD4182, 0x426B41FD4182, 0x41FE4182, 0x425141FE4182, 0x425241FE4182, 0x425341FE4182, 0x425441FE4182, 0x425541FE4182, 0x425641FE4182, 0x425741FE4182, 0x425841FE4182, 0x425941FE4182, 0x425A41FE4182, 0x425B41FE4182, 0x425C41FE4182, 0x425D41FE4182, 0x425E41FE4182, 0x425F41FE4182,
+		0x426041FE4182, 0x426141FE4182, 0x426241FE4182, 0x426341FE4182, 0x426441FE4182, 0x426541FE4182, 0x426641FE4182, 0x426741FE4182, 0x426841FE4182, 0x426941FE4182, 0x426A41FE4182, 0x426B41FE4182, 0x41FF4182, 0x425141FF4182, 0x425241FF4182, 0x425341FF4182, 0x425441FF4182, 0x425541FF4182, 0x425641FF4182, 0x425741FF4182, 0x425841FF4182, 0x425941FF4182, 0x425A41FF4182, 0x425B41FF4182, 0x425C41FF4182, 0x425D41FF4182, 0x425E41FF4182, 0x425F41FF4182, 0x426041FF4182, 0x426141FF4182, 0x426241FF4182, 0x426341FF4182,
+		0x426441FF4182, 0x426541FF4182, 0x426641FF4182, 0x426741FF4182, 0x426841FF4182, 0x426941FF4182, 0x426A41FF4182, 0x426B41FF4182, 0x42004182, 0x425142004182, 0x425242004182, 0x425342004182, 0x425442004182, 0x425542004182, 0x425642004182, 0x425742004182, 0x425842004182, 0x425942004182, 0x425A42004182, 0x425B42004182, 0x425C42004182, 0x425D42004182, 0x425E42004182, 0x425F42004182, 0x426042004182, 0x426142004182, 0x426242004182, 0x426342004182, 0x426442004182, 0x426542004182, 0x426642004182, 0x426742004182,
+		0x426842004182, 0x426942004182, 0x426A42004182, 0x426B42004182, 0x42014182, 0x425142014182, 0x425242014182, 0x425342014182, 0x425442014182, 0x425542014182, 0x425642014182, 0x425742014182, 0x425842014182, 0x425942014182, 0x425A42014182, 0x425B42014182, 0x425C42014182, 0x425D42014182, 0x425E42014182, 0x425F42014182, 0x426042014182, 0x426142014182, 0x426242014182, 0x426342014182, 0x426442014182, 0x426542014182, 0x426642014182, 0x426742014182, 0x426842014182, 0x426942014182, 0x426A42014182, 0x426B42014182,
+		0x42024182, 0x425142024182, 0x425242024182, 0x425342024182, 0x425442024182, 0x425542024182, 0x425642024182, 0x425742024182, 0x425842024182, 0x425942024182, 0x425A42024182, 0x425B42024182, 0x425C42024182, 0x425D42024182, 0x425E42024182, 0x425F42024182, 0x426042024182, 0x426142024182, 0x426242024182, 0x426342024182, 0x426442024182, 0x426542024182, 0x426642024182, 0x426742024182, 0x426842024182, 0x426942024182, 0x426A42024182, 0x426B42024182, 0x42034182, 0x425142034182, 0x425242034182, 0x425342034182,
+		0x425442034182, 0x425542034182, 0x425642034182, 0x425742034182, 0x425842034182, 0x425942034182, 0x425A42034182, 0x425B42034182, 0x425C42034182, 0x425D42034182, 0x425E42034182, 0x425F42034182, 0x426042034182, 0x426142034182, 0x426242034182, 0x426342034182, 0x426442034182, 0x426542034182, 0x426642034182, 0x426742034182, 0x426842034182, 0x426942034182, 0x426A42034182, 0x426B42034182, 0x42044182, 0x425142044182, 0x425242044182, 0x425342044182, 0x425442044182, 0x425542044182, 0x425642044182, 0x425742044182,
+		0x425842044182, 0x425942044182, 0x425A42044182, 0x425B42044182, 0x425C42044182, 0x425D42044182, 0x425E42044182, 0x425F42044182, 0x426042044182, 0x426142044182, 0x426242044182, 0x426342044182, 0x426442044182, 0x426542044182, 0x426642044182, 0x426742044182, 0x426842044182, 0x426942044182, 0x426A42044182, 0x426B42044182, 0x42054182, 0x425142054182, 0x425242054182, 0x425342054182, 0x425442054182, 0x425542054182, 0x425642054182, 0x425742054182, 0x425842054182, 0x425942054182, 0x425A42054182, 0x425B42054182,
+		0x425C42054182, 0x425D42054182, 0x425E42054182, 0x425F42054182, 0x426042054182, 0x426142054182, 0x426242054182, 0x426342054182, 0x426442054182, 0x426542054182, 0x426642054182, 0x426742054182, 0x426842054182, 0x426942054182, 0x426A42054182, 0x426B42054182, 0x42064182, 0x425142064182, 0x425242064182, 0x425342064182, 0x425442064182, 0x425542064182, 0x425642064182, 0x425742064182, 0x425842064182, 0x425942064182, 0x425A42064182, 0x425B42064182, 0x425C42064182, 0x425D42064182, 0x425E42064182, 0x425F42064182,
+		0x426042064182, 0x426142064182, 0x426242064182, 0x426342064182, 0x426442064182, 0x426542064182, 0x426642064182, 0x426742064182, 0x426842064182, 0x426942064182, 0x426A42064182, 0x426B42064182, 0x42074182, 0x425142074182, 0x425242074182, 0x425342074182, 0x425442074182, 0x425542074182, 0x425642074182, 0x425742074182, 0x425842074182, 0x425942074182, 0x425A42074182, 0x425B42074182, 0x425C42074182, 0x425D42074182, 0x425E42074182, 0x425F42074182, 0x426042074182, 0x426142074182, 0x426242074182, 0x426342074182,
+		0x426442074182, 0x426542074182, 0x426642074182, 0x426742074182, 0x426842074182, 0x426942074182, 0x426A42074182, 0x426B42074182, 0x41F34183, 0x425141F34183, 0x425241F34183, 0x425341F34183, 0x425441F34183, 0x425541F34183, 0x425641F34183, 0x425741F34183, 0x425841F34183, 0x425941F34183, 0x425A41F34183, 0x425B41F34183, 0x425C41F34183, 0x425D41F34183, 0x425E41F34183, 0x425F41F34183, 0x426041F34183, 0x426141F34183, 0x426241F34183, 0x426341F34183, 0x426441F34183, 0x426541F34183, 0x426641F34183, 0x426741F34183,
+		0x426841F34183, 0x426941F34183, 0x426A41F34183, 0x426B41F34183, 0x41F44183, 0x425141F44183, 0x425241F44183, 0x425341F44183, 0x425441F44183, 0x425541F44183, 0x425641F44183, 0x425741F44183, 0x425841F44183, 0x425941F44183, 0x425A41F44183, 0x425B41F44183, 0x425C41F44183, 0x425D41F44183, 0x425E41F44183, 0x425F41F44183, 0x426041F44183, 0x426141F44183, 0x426241F44183, 0x426341F44183, 0x426441F44183, 0x426541F44183, 0x426641F44183, 0x426741F44183, 0x426841F44183, 0x426941F44183, 0x426A41F44183, 0x426B41F44183,
+		0x41F54183, 0x425141F54183, 0x425241F54183, 0x425341F54183, 0x425441F54183, 0x425541F54183, 0x425641F54183, 0x425741F54183, 0x425841F54183, 0x425941F54183, 0x425A41F54183, 0x425B41F54183, 0x425C41F54183, 0x425D41F54183, 0x425E41F54183, 0x425F41F54183, 0x426041F54183, 0x426141F54183, 0x426241F54183, 0x426341F54183, 0x426441F54183, 0x426541F54183, 0x426641F54183, 0x426741F54183, 0x426841F54183, 0x426941F54183, 0x426A41F54183, 0x426B41F54183, 0x41F64183, 0x425141F64183, 0x425241F64183, 0x425341F64183,
+		0x425441F64183, 0x425541F64183, 0x425641F64183, 0x425741F64183, 0x425841F64183, 0x425941F64183, 0x425A41F64183, 0x425B41F64183, 0x425C41F64183, 0x425D41F64183, 0x425E41F64183, 0x425F41F64183, 0x426041F64183, 0x426141F64183, 0x426241F64183, 0x426341F64183, 0x426441F64183, 0x426541F64183, 0x426641F64183, 0x426741F64183, 0x426841F64183, 0x426941F64183, 0x426A41F64183, 0x426B41F64183, 0x41F74183, 0x425141F74183, 0x425241F74183, 0x425341F74183, 0x425441F74183, 0x425541F74183, 0x425641F74183, 0x425741F74183,
+		0x425841F74183, 0x425941F74183, 0x425A41F74183, 0x425B41F74183, 0x425C41F74183, 0x425D41F74183, 0x425E41F74183, 0x425F41F74183, 0x426041F74183, 0x426141F74183, 0x426241F74183, 0x426341F74183, 0x426441F74183, 0x426541F74183, 0x426641F74183, 0x426741F74183, 0x426841F74183, 0x426941F74183, 0x426A41F74183, 0x426B41F74183, 0x41F84183, 0x425141F84183, 0x425241F84183, 0x425341F84183, 0x425441F84183, 0x425541F84183, 0x425641F84183, 0x425741F84183, 0x425841F84183, 0x425941F84183, 0x425A41F84183, 0x425B41F84183,
+		0x425C41F84183, 0x425D41F84183, 0x425E41F84183, 0x425F41F84183, 0x426041F84183, 0x426141F84183, 0x426241F84183, 0x426341F84183, 0x426441F84183, 0x426541F84183, 0x426641F84183, 0x426741F84183, 0x426841F84183, 0x426941F84183, 0x426A41F84183, 0x426B41F84183, 0x41F94183, 0x425141F94183, 0x425241F94183, 0x425341F94183, 0x425441F94183, 0x425541F94183, 0x425641F94183, 0x425741F94183, 0x425841F94183, 0x425941F94183, 0x425A41F94183, 0x425B41F94183, 0x425C41F94183, 0x425D41F94183, 0x425E41F94183, 0x425F41F94183,
+		0x426041F94183, 0x426141F94183, 0x426241F94183, 0x426341F94183, 0x426441F94183, 0x426541F94183, 0x426641F94183, 0x426741F94183, 0x426841F94183, 0x426941F94183, 0x426A41F94183, 0x426B41F94183, 0x41FA4183, 0x425141FA4183, 0x425241FA4183, 0x425341FA4183, 0x425441FA4183, 0x425541FA4183, 0x425641FA4183, 0x425741FA4183, 0x425841FA4183, 0x425941FA4183, 0x425A41FA4183, 0x425B41FA4183, 0x425C41FA4183, 0x425D41FA4183, 0x425E41FA4183, 0x425F41FA4183, 0x426041FA4183, 0x426141FA4183, 0x426241FA4183, 0x426341FA4183,
+		0x426441FA4183, 0x426541FA4183, 0x426641FA4183, 0x426741FA4183, 0x426841FA4183, 0x426941FA4183, 0x426A41FA4183, 0x426B41FA4183, 0x41FB4183, 0x425141FB4183, 0x425241FB4183, 0x425341FB4183, 0x425441FB4183, 0x425541FB4183, 0x425641FB4183, 0x425741FB4183, 0x425841FB4183, 0x425941FB4183, 0x425A41FB4183, 0x425B41FB4183, 0x425C41FB4183, 0x425D41FB4183, 0x425E41FB4183, 0x425F41FB4183, 0x426041FB4183, 0x426141FB4183, 0x426241FB4183, 0x426341FB4183, 0x426441FB4183, 0x426541FB4183, 0x426641FB4183, 0x426741FB4183,
+		0x426841FB4183, 0x426941FB4183, 0x426A41FB4183, 0x426B41FB4183, 0x41FC4183, 0x425141FC4183, 0x425241FC4183, 0x425341FC4183, 0x425441FC4183, 0x425541FC4183, 0x425641FC4183, 0x425741FC4183, 0x425841FC4183, 0x425941FC4183, 0x425A41FC4183, 0x425B41FC4183, 0x425C41FC4183, 0x425D41FC4183, 0x425E41FC4183, 0x425F41FC4183, 0x426041FC4183, 0x426141FC4183, 0x426241FC4183, 0x426341FC4183, 0x426441FC4183, 0x426541FC4183, 0x426641FC4183, 0x426741FC4183, 0x426841FC4183, 0x426941FC4183, 0x426A41FC4183, 0x426B41FC4183,
+		0x41FD4183, 0x425141FD4183, 0x425241FD4183, 0x425341FD4183, 0x425441FD4183, 0x425541FD4183, 0x425641FD4183, 0x425741FD4183, 0x425841FD4183, 0x425941FD4183, 0x425A41FD4183, 0x425B41FD4183, 0x425C41FD4183, 0x425D41FD4183, 0x425E41FD4183, 0x425F41FD4183, 0x426041FD4183, 0x426141FD4183, 0x426241FD4183, 0x426341FD4183, 0x426441FD4183, 0x426541FD4183, 0x426641FD4183, 0x426741FD4183, 0x426841FD4183, 0x426941FD4183, 0x426A41FD4183, 0x426B41FD4183, 0x41FE4183, 0x425141FE4183, 0x425241FE4183, 0x425341FE4183,
+		0x425441FE4183, 0x425541FE4183, 0x425641FE4183, 0x425741FE4183, 0x425841FE4183, 0x425941FE4183, 0x425A41FE4183, 0x425B41FE4183, 0x425C41FE4183, 0x425D41FE4183, 0x425E41FE4183, 0x425F41FE4183, 0x426041FE4183, 0x426141FE4183, 0x426241FE4183, 0x426341FE4183, 0x426441FE4183, 0x426541FE4183, 0x426641FE4183, 0x426741FE4183, 0x426841FE4183, 0x426941FE4183, 0x426A41FE4183, 0x426B41FE4183, 0x41FF4183, 0x425141FF4183, 0x425241FF4183, 0x425341FF4183, 0x425441FF4183, 0x425541FF4183, 0x425641FF4183, 0x425741FF4183,
+		0x425841FF4183, 0x425941FF4183, 0x425A41FF4183, 0x425B41FF4183, 0x425C41FF4183, 0x425D41FF4183, 0x425E41FF4183, 0x425F41FF4183, 0x426041FF4183, 0x426141FF4183, 0x426241FF4183, 0x426341FF4183, 0x426441FF4183, 0x426541FF4183, 0x426641FF4183, 0x426741FF4183, 0x426841FF4183, 0x426941FF4183, 0x426A41FF4183, 0x426B41FF4183, 0x42004183, 0x425142004183, 0x425242004183, 0x425342004183, 0x425442004183, 0x425542004183, 0x425642004183, 0x425742004183, 0x425842004183, 0x425942004183, 0x425A42004183, 0x425B42004183,
+		0x425C42004183, 0x425D42004183, 0x425E42004183, 0x425F42004183, 0x426042004183, 0x426142004183, 0x426242004183, 0x426342004183, 0x426442004183, 0x426542004183, 0x426642004183, 0x426742004183, 0x426842004183, 0x426942004183, 0x426A42004183, 0x426B42004183, 0x42014183, 0x425142014183, 0x425242014183, 0x425342014183, 0x425442014183, 0x425542014183, 0x425642014183, 0x425742014183, 0x425842014183, 0x425942014183, 0x425A42014183, 0x425B42014183, 0x425C42014183, 0x425D42014183, 0x425E42014183, 0x425F42014183,
+		0x426042014183, 0x426142014183, 0x426242014183, 0x426342014183, 0x426442014183, 0x426542014183, 0x426642014183, 0x426742014183, 0x426842014183, 0x426942014183, 0x426A42014183, 0x426B42014183, 0x42024183, 0x425142024183, 0x425242024183, 0x425342024183, 0x425442024183, 0x425542024183, 0x425642024183, 0x425742024183, 0x425842024183, 0x425942024183, 0x425A42024183, 0x425B42024183, 0x425C42024183, 0x425D42024183, 0x425E42024183, 0x425F42024183, 0x426042024183, 0x426142024183, 0x426242024183, 0x426342024183,
+		0x426442024183, 0x426542024183, 0x426642024183, 0x426742024183, 0x426842024183, 0x426942024183, 0x426A42024183, 0x426B42024183, 0x42034183, 0x425142034183, 0x425242034183, 0x425342034183, 0x425442034183, 0x425542034183, 0x425642034183, 0x425742034183, 0x425842034183, 0x425942034183, 0x425A42034183, 0x425B42034183, 0x425C42034183, 0x425D42034183, 0x425E42034183, 0x425F42034183, 0x426042034183, 0x426142034183, 0x426242034183, 0x426342034183, 0x426442034183, 0x426542034183, 0x426642034183, 0x426742034183,
+		0x426842034183, 0x426942034183, 0x426A42034183, 0x426B42034183, 0x42044183, 0x425142044183, 0x425242044183, 0x425342044183, 0x425442044183, 0x425542044183, 0x425642044183, 0x425742044183, 0x425842044183, 0x425942044183, 0x425A42044183, 0x425B42044183, 0x425C42044183, 0x425D42044183, 0x425E42044183, 0x425F42044183, 0x426042044183, 0x426142044183, 0x426242044183, 0x426342044183, 0x426442044183, 0x426542044183, 0x426642044183, 0x426742044183, 0x426842044183, 0x426942044183, 0x426A42044183, 0x426B42044183,
+		0x42054183, 0x425142054183, 0x425242054183, 0x425342054183, 0x425442054183, 0x425542054183, 0x425642054183, 0x425742054183, 0x425842054183, 0x425942054183, 0x425A42054183, 0x425B42054183, 0x425C42054183, 0x425D42054183, 0x425E42054183, 0x425F42054183, 0x426042054183, 0x426142054183, 0x426242054183, 0x426342054183, 0x426442054183, 0x426542054183, 0x426642054183, 0x426742054183, 0x426842054183, 0x426942054183, 0x426A42054183, 0x426B42054183, 0x42064183, 0x425142064183, 0x425242064183, 0x425342064183,
+		0x425442064183, 0x425542064183, 0x425642064183, 0x425742064183, 0x425842064183, 0x425942064183, 0x425A42064183, 0x425B42064183, 0x425C42064183, 0x425D42064183, 0x425E42064183, 0x425F42064183, 0x426042064183, 0x426142064183, 0x426242064183, 0x426342064183, 0x426442064183, 0x426542064183, 0x426642064183, 0x426742064183, 0x426842064183, 0x426942064183, 0x426A42064183, 0x426B42064183, 0x42074183, 0x425142074183, 0x425242074183, 0x425342074183, 0x425442074183, 0x425542074183, 0x425642074183, 0x425742074183,
+		0x425842074183, 0x425942074183, 0x425A42074183, 0x425B42074183, 0x425C42074183, 0x425D42074183, 0x425E42074183, 0x425F42074183, 0x426042074183, 0x426142074183, 0x426242074183, 0x426342074183, 0x426442074183, 0x426542074183, 0x426642074183, 0x426742074183, 0x426842074183, 0x426942074183, 0x426A42074183, 0x426B42074183, 0x41F34184, 0x425141F34184, 0x425241F34184, 0x425341F34184, 0x425441F34184, 0x425541F34184, 0x425641F34184, 0x425741F34184, 0x425841F34184, 0x425941F34184, 0x425A41F34184, 0x425B41F34184,
+		0x425C41F34184, 0x425D41F34184, 0x425E41F34184, 0x425F41F34184, 0x426041F34184, 0x426141F34184, 0x426241F34184, 0x426341F34184, 0x426441F34184, 0x426541F34184, 0x426641F34184, 0x426741F34184, 0x426841F34184, 0x426941F34184, 0x426A41F34184, 0x426B41F34184, 0x41F44184, 0x425141F44184, 0x425241F44184, 0x425341F44184, 0x425441F44184, 0x425541F44184, 0x425641F44184, 0x425741F44184, 0x425841F44184, 0x425941F44184, 0x425A41F44184, 0x425B41F44184, 0x425C41F44184, 0x425D41F44184, 0x425E41F44184, 0x425F41F44184,
+		0x426041F44184, 0x426141F44184, 0x426241F44184, 0x426341F44184, 0x426441F44184, 0x426541F44184, 0x426641F44184, 0x426741F44184, 0x426841F44184, 0x426941F44184, 0x426A41F44184, 0x426B41F44184, 0x41F54184, 0x425141F54184, 0x425241F54184, 0x425341F54184, 0x425441F54184, 0x425541F54184, 0x425641F54184, 0x425741F54184, 0x425841F54184, 0x425941F54184, 0x425A41F54184, 0x425B41F54184, 0x425C41F54184, 0x425D41F54184, 0x425E41F54184, 0x425F41F54184, 0x426041F54184, 0x426141F54184, 0x426241F54184, 0x426341F54184,
+		0x426441F54184, 0x426541F54184, 0x426641F54184, 0x426741F54184, 0x426841F54184, 0x426941F54184, 0x426A41F54184, 0x426B41F54184, 0x41F64184, 0x425141F64184, 0x425241F64184, 0x425341F64184, 0x425441F64184, 0x425541F64184, 0x425641F64184, 0x425741F64184, 0x425841F64184, 0x425941F64184, 0x425A41F64184, 0x425B41F64184, 0x425C41F64184, 0x425D41F64184, 0x425E41F64184, 0x425F41F64184, 0x426041F64184, 0x426141F64184, 0x426241F64184, 0x426341F64184, 0x426441F64184, 0x426541F64184, 0x426641F64184, 0x426741F64184,
+		0x426841F64184, 0x426941F64184, 0x426A41F64184, 0x426B41F64184, 0x41F74184, 0x425141F74184, 0x425241F74184, 0x425341F74184, 0x425441F74184, 0x425541F74184, 0x425641F74184, 0x425741F74184, 0x425841F74184, 0x425941F74184, 0x425A41F74184, 0x425B41F74184, 0x425C41F74184, 0x425D41F74184, 0x425E41F74184, 0x425F41F74184, 0x426041F74184, 0x426141F74184, 0x426241F74184, 0x426341F74184, 0x426441F74184, 0x426541F74184, 0x426641F74184, 0x426741F74184, 0x426841F74184, 0x426941F74184, 0x426A41F74184, 0x426B41F74184,
+		0x41F84184, 0x425141F84184, 0x425241F84184, 0x425341F84184, 0x425441F84184, 0x425541F84184, 0x425641F84184, 0x425741F84184, 0x425841F84184, 0x425941F84184, 0x425A41F84184, 0x425B41F84184, 0x425C41F84184, 0x425D41F84184, 0x425E41F84184, 0x425F41F84184, 0x426041F84184, 0x426141F84184, 0x426241F84184, 0x426341F84184, 0x426441F84184, 0x426541F84184, 0x426641F84184, 0x426741F84184, 0x426841F84184, 0x426941F84184, 0x426A41F84184, 0x426B41F84184, 0x41F94184, 0x425141F94184, 0x425241F94184, 0x425341F94184,
+		0x425441F94184, 0x425541F94184, 0x425641F94184, 0x425741F94184, 0x425841F94184, 0x425941F94184, 0x425A41F94184, 0x425B41F94184, 0x425C41F94184, 0x425D41F94184, 0x425E41F94184, 0x425F41F94184, 0x426041F94184, 0x426141F94184, 0x426241F94184, 0x426341F94184, 0x426441F94184, 0x426541F94184, 0x426641F94184, 0x426741F94184, 0x426841F94184, 0x426941F94184, 0x426A41F94184, 0x426B41F94184, 0x41FA4184, 0x425141FA4184, 0x425241FA4184, 0x425341FA4184, 0x425441FA4184, 0x425541FA4184, 0x425641FA4184, 0x425741FA4184,
+		0x425841FA4184, 0x425941FA4184, 0x425A41FA4184, 0x425B41FA4184, 0x425C41FA4184, 0x425D41FA4184, 0x425E41FA4184, 0x425F41FA4184, 0x426041FA4184, 0x426141FA4184, 0x426241FA4184, 0x426341FA4184, 0x426441FA4184, 0x426541FA4184, 0x426641FA4184, 0x426741FA4184, 0x426841FA4184, 0x426941FA4184, 0x426A41FA4184, 0x426B41FA4184, 0x41FB4184, 0x425141FB4184, 0x425241FB4184, 0x425341FB4184, 0x425441FB4184, 0x425541FB4184, 0x425641FB4184, 0x425741FB4184, 0x425841FB4184, 0x425941FB4184, 0x425A41FB4184, 0x425B41FB4184,
+		0x425C41FB4184, 0x425D41FB4184, 0x425E41FB4184, 0x425F41FB4184, 0x426041FB4184, 0x426141FB4184, 0x426241FB4184, 0x426341FB4184, 0x426441FB4184, 0x426541FB4184, 0x426641FB4184, 0x426741FB4184, 0x426841FB4184, 0x426941FB4184, 0x426A41FB4184, 0x426B41FB4184, 0x41FC4184, 0x425141FC4184, 0x425241FC4184, 0x425341FC4184, 0x425441FC4184, 0x425541FC4184, 0x425641FC4184, 0x425741FC4184, 0x425841FC4184, 0x425941FC4184, 0x425A41FC4184, 0x425B41FC4184, 0x425C41FC4184, 0x425D41FC4184, 0x425E41FC4184, 0x425F41FC4184,
+		0x426041FC4184, 0x426141FC4184, 0x426241FC4184, 0x426341FC4184, 0x426441FC4184, 0x426541FC4184, 0x426641FC4184, 0x426741FC4184, 0x426841FC4184, 0x426941FC4184, 0x426A41FC4184, 0x426B41FC4184, 0x41FD4184, 0x425141FD4184, 0x425241FD4184, 0x425341FD4184, 0x425441FD4184, 0x425541FD4184, 0x425641FD4184, 0x425741FD4184, 0x425841FD4184, 0x425941FD4184, 0x425A41FD4184, 0x425B41FD4184, 0x425C41FD4184, 0x425D41FD4184, 0x425E41FD4184, 0x425F41FD4184, 0x426041FD4184, 0x426141FD4184, 0x426241FD4184, 0x426341FD4184,
+		0x426441FD4184, 0x426541FD4184, 0x426641FD4184, 0x426741FD4184, 0x426841FD4184, 0x426941FD4184, 0x426A41FD4184, 0x426B41FD4184, 0x41FE4184, 0x425141FE4184, 0x425241FE4184, 0x425341FE4184, 0x425441FE4184, 0x425541FE4184, 0x425641FE4184, 0x425741FE4184, 0x425841FE4184, 0x425941FE4184, 0x425A41FE4184, 0x425B41FE4184, 0x425C41FE4184, 0x425D41FE4184, 0x425E41FE4184, 0x425F41FE4184, 0x426041FE4184, 0x426141FE4184, 0x426241FE4184, 0x426341FE4184, 0x426441FE4184, 0x426541FE4184, 0x426641FE4184, 0x426741FE4184,
+		0x426841FE4184, 0x426941FE4184, 0x426A41FE4184, 0x426B41FE4184, 0x41FF4184, 0x425141FF4184, 0x425241FF4184, 0x425341FF4184, 0x425441FF4184, 0x425541FF4184, 0x425641FF4184, 0x425741FF4184, 0x425841FF4184, 0x425941FF4184, 0x425A41FF4184, 0x425B41FF4184, 0x425C41FF4184, 0x425D41FF4184, 0x425E41FF4184, 0x425F41FF4184, 0x426041FF4184, 0x426141FF4184, 0x426241FF4184, 0x426341FF4184, 0x426441FF4184, 0x426541FF4184, 0x426641FF4184, 0x426741FF4184, 0x426841FF4184, 0x426941FF4184, 0x426A41FF4184, 0x426B41FF4184,
+		0x42004184, 0x425142004184, 0x425242004184, 0x425342004184, 0x425442004184, 0x425542004184, 0x425642004184, 0x425742004184, 0x425842004184, 0x425942004184, 0x425A42004184, 0x425B42004184, 0x425C42004184, 0x425D42004184, 0x425E42004184, 0x425F42004184, 0x426042004184, 0x426142004184, 0x426242004184, 0x426342004184, 0x426442004184, 0x426542004184, 0x426642004184, 0x426742004184, 0x426842004184, 0x426942004184, 0x426A42004184, 0x426B42004184, 0x42014184, 0x425142014184, 0x425242014184, 0x425342014184,
+		0x425442014184, 0x425542014184, 0x425642014184, 0x425742014184, 0x425842014184, 0x425942014184, 0x425A42014184, 0x425B42014184, 0x425C42014184, 0x425D42014184, 0x425E42014184, 0x425F42014184, 0x426042014184, 0x426142014184, 0x426242014184, 0x426342014184, 0x426442014184, 0x426542014184, 0x426642014184, 0x426742014184, 0x426842014184, 0x426942014184, 0x426A42014184, 0x426B42014184, 0x42024184, 0x425142024184, 0x425242024184, 0x425342024184, 0x425442024184, 0x425542024184, 0x425642024184, 0x425742024184,
+		0x425842024184, 0x425942024184, 0x425A42024184, 0x425B42024184, 0x425C42024184, 0x425D42024184, 0x425E42024184, 0x425F42024184, 0x426042024184, 0x426142024184, 0x426242024184, 0x426342024184, 0x426442024184, 0x426542024184, 0x426642024184, 0x426742024184, 0x426842024184, 0x426942024184, 0x426A42024184, 0x426B42024184, 0x42034184, 0x425142034184, 0x425242034184, 0x425342034184, 0x425442034184, 0x425542034184, 0x425642034184, 0x425742034184, 0x425842034184, 0x425942034184, 0x425A42034184, 0x425B42034184,
+		0x425C42034184, 0x425D42034184, 0x425E42034184, 0x425F42034184, 0x426042034184, 0x426142034184, 0x426242034184, 0x426342034184, 0x426442034184, 0x426542034184, 0x426642034184, 0x426742034184, 0x426842034184, 0x426942034184, 0x426A42034184, 0x426B42034184, 0x42044184, 0x425142044184, 0x425242044184, 0x425342044184, 0x425442044184, 0x425542044184, 0x425642044184, 0x425742044184, 0x425842044184, 0x425942044184, 0x425A42044184, 0x425B42044184, 0x425C42044184, 0x425D42044184, 0x425E42044184, 0x425F42044184,
+		0x426042044184, 0x426142044184, 0x426242044184, 0x426342044184, 0x426442044184, 0x426542044184, 0x426642044184, 0x426742044184, 0x426842044184, 0x426942044184, 0x426A42044184, 0x426B42044184, 0x42054184, 0x425142054184, 0x425242054184, 0x425342054184, 0x425442054184, 0x425542054184, 0x425642054184, 0x425742054184, 0x425842054184, 0x425942054184, 0x425A42054184, 0x425B42054184, 0x425C42054184, 0x425D42054184, 0x425E42054184, 0x425F42054184, 0x426042054184, 0x426142054184, 0x426242054184, 0x426342054184,
+		0x426442054184, 0x426542054184, 0x426642054184, 0x426742054184, 0x426842054184, 0x426942054184, 0x426A42054184, 0x426B42054184, 0x42064184, 0x425142064184, 0x425242064184, 0x425342064184, 0x425442064184, 0x425542064184, 0x425642064184, 0x425742064184, 0x425842064184, 0x425942064184, 0x425A42064184, 0x425B42064184, 0x425C42064184, 0x425D42064184, 0x425E42064184, 0x425F42064184, 0x426042064184, 0x426142064184, 0x426242064184, 0x426342064184, 0x426442064184, 0x426542064184, 0x426642064184, 0x426742064184,
+		0x426842064184, 0x426942064184, 0x426A42064184, 0x426B42064184, 0x42074184, 0x425142074184, 0x425242074184, 0x425342074184, 0x425442074184, 0x425542074184, 0x425642074184, 0x425742074184, 0x425842074184, 0x425942074184, 0x425A42074184, 0x425B42074184, 0x425C42074184, 0x425D42074184, 0x425E42074184, 0x425F42074184, 0x426042074184, 0x426142074184, 0x426242074184, 0x426342074184, 0x426442074184, 0x426542074184, 0x426642074184, 0x426742074184, 0x426842074184, 0x426942074184, 0x426A42074184, 0x426B42074184,
+		0x41F34185, 0x425141F34185, 0x425241F34185, 0x425341F34185, 0x425441F34185, 0x425541F34185, 0x425641F34185, 0x425741F34185, 0x425841F34185, 0x425941F34185, 0x425A41F34185, 0x425B41F34185, 0x425C41F34185, 0x425D41F34185, 0x425E41F34185, 0x425F41F34185, 0x426041F34185, 0x426141F34185, 0x426241F34185, 0x426341F34185, 0x426441F34185, 0x426541F34185, 0x426641F34185, 0x426741F34185, 0x426841F34185, 0x426941F34185, 0x426A41F34185, 0x426B41F34185, 0x41F44185, 0x425141F44185, 0x425241F44185, 0x425341F44185,
+		0x425441F44185, 0x425541F44185, 0x425641F44185, 0x425741F44185, 0x425841F44185, 0x425941F44185, 0x425A41F44185, 0x425B41F44185, 0x425C41F44185, 0x425D41F44185, 0x425E41F44185, 0x425F41F44185, 0x426041F44185, 0x426141F44185, 0x426241F44185, 0x426341F44185, 0x426441F44185, 0x426541F44185, 0x426641F44185, 0x426741F44185, 0x426841F44185, 0x426941F44185, 0x426A41F44185, 0x426B41F44185, 0x41F54185, 0x425141F54185, 0x425241F54185, 0x425341F54185, 0x425441F54185, 0x425541F54185, 0x425641F54185, 0x425741F54185,
+		0x425841F54185, 0x425941F54185, 0x425A41F54185, 0x425B41F54185, 0x425C41F54185, 0x425D41F54185, 0x425E41F54185, 0x425F41F54185, 0x426041F54185, 0x426141F54185, 0x426241F54185, 0x426341F54185, 0x426441F54185, 0x426541F54185, 0x426641F54185, 0x426741F54185, 0x426841F54185, 0x426941F54185, 0x426A41F54185, 0x426B41F54185, 0x41F64185, 0x425141F64185, 0x425241F64185, 0x425341F64185, 0x425441F64185, 0x425541F64185, 0x425641F64185, 0x425741F64185, 0x425841F64185, 0x425941F64185, 0x425A41F64185, 0x425B41F64185,
+		0x425C41F64185, 0x425D41F64185, 0x425E41F64185, 0x425F41F64185, 0x426041F64185, 0x426141F64185, 0x426241F64185, 0x426341F64185, 0x426441F64185, 0x426541F64185, 0x426641F64185, 0x426741F64185, 0x426841F64185, 0x426941F64185, 0x426A41F64185, 0x426B41F64185, 0x41F74185, 0x425141F74185, 0x425241F74185, 0x425341F74185, 0x425441F74185, 0x425541F74185, 0x425641F74185, 0x425741F74185, 0x425841F74185, 0x425941F74185, 0x425A41F74185, 0x425B41F74185, 0x425C41F74185, 0x425D41F74185, 0x425E41F74185, 0x425F41F74185,
+		0x426041F74185, 0x426141F74185, 0x426241F74185, 0x426341F74185, 0x426441F74185, 0x426541F74185, 0x426641F74185, 0x426741F74185, 0x426841F74185, 0x426941F74185, 0x426A41F74185, 0x426B41F74185, 0x41F84185, 0x425141F84185, 0x425241F84185, 0x425341F84185, 0x425441F84185, 0x425541F84185, 0x425641F84185, 0x425741F84185, 0x425841F84185, 0x425941F84185, 0x425A41F84185, 0x425B41F84185, 0x425C41F84185, 0x425D41F84185, 0x425E41F84185, 0x425F41F84185, 0x426041F84185, 0x426141F84185, 0x426241F84185, 0x426341F84185,
+		0x426441F84185, 0x426541F84185, 0x426641F84185, 0x426741F84185, 0x426841F84185, 0x426941F84185, 0x426A41F84185, 0x426B41F84185, 0x41F94185, 0x425141F94185, 0x425241F94185, 0x425341F94185, 0x425441F94185, 0x425541F94185, 0x425641F94185, 0x425741F94185, 0x425841F94185, 0x425941F94185, 0x425A41F94185, 0x425B41F94185, 0x425C41F94185, 0x425D41F94185, 0x425E41F94185, 0x425F41F94185, 0x426041F94185, 0x426141F94185, 0x426241F94185, 0x426341F94185, 0x426441F94185, 0x426541F94185, 0x426641F94185, 0x426741F94185,
+		0x426841F94185, 0x426941F94185, 0x426A41F94185, 0x426B41F94185, 0x41FA4185, 0x425141FA4185, 0x425241FA4185, 0x425341FA4185, 0x425441FA4185, 0x425541FA4185, 0x425641FA4185, 0x425741FA4185, 0x425841FA4185, 0x425941FA4185, 0x425A41FA4185, 0x425B41FA4185, 0x425C41FA4185, 0x425D41FA4185, 0x425E41FA4185, 0x425F41FA4185, 0x426041FA4185, 0x426141FA4185, 0x426241FA4185, 0x426341FA4185, 0x426441FA4185, 0x426541FA4185, 0x426641FA4185, 0x426741FA4185, 0x426841FA4185, 0x426941FA4185, 0x426A41FA4185, 0x426B41FA4185,
+		0x41FB4185, 0x425141FB4185, 0x425241FB4185, 0x425341FB4185, 0x425441FB4185, 0x425541FB4185, 0x425641FB4185, 0x425741FB4185, 0x425841FB4185, 0x425941FB4185, 0x425A41FB4185, 0x425B41FB4185, 0x425C41FB4185, 0x425D41FB4185, 0x425E41FB4185, 0x425F41FB4185, 0x426041FB4185, 0x426141FB4185, 0x426241FB4185, 0x426341FB4185, 0x426441FB4185, 0x426541FB4185, 0x426641FB4185, 0x426741FB4185, 0x426841FB4185, 0x426941FB4185, 0x426A41FB4185, 0x426B41FB4185, 0x41FC4185, 0x425141FC4185, 0x425241FC4185, 0x425341FC4185,
+		0x425441FC4185, 0x425541FC4185, 0x425641FC4185, 0x425741FC4185, 0x425841FC4185, 0x425941FC4185, 0x425A41FC4185, 0x425B41FC4185, 0x425C41FC4185, 0x425D41FC4185, 0x425E41FC4185, 0x425F41FC4185, 0x426041FC4185, 0x426141FC4185, 0x426241FC4185, 0x426341FC4185, 0x426441FC4185, 0x426541FC4185, 0x426641FC4185, 0x426741FC4185, 0x426841FC4185, 0x426941FC4185, 0x426A41FC4185, 0x426B41FC4185, 0x41FD4185, 0x425141FD4185, 0x425241FD4185, 0x425341FD4185, 0x425441FD4185, 0x425541FD4185, 0x425641FD4185, 0x425741FD4185,
+		0x425841FD4185, 0x425941FD4185, 0x425A41FD4185, 0x425B41FD4185, 0x425C41FD4185, 0x425D41FD4185, 0x425E41FD4185, 0x425F41FD4185, 0x426041FD4185, 0x426141FD4185, 0x426241FD4185, 0x426341FD4185, 0x426441FD4185, 0x426541FD4185, 0x426641FD4185, 0x426741FD4185, 0x426841FD4185, 0x426941FD4185, 0x426A41FD4185, 0x426B41FD4185, 0x41FE4185, 0x425141FE4185, 0x425241FE4185, 0x425341FE4185, 0x425441FE4185, 0x425541FE4185, 0x425641FE4185, 0x425741FE4185, 0x425841FE4185, 0x425941FE4185, 0x425A41FE4185, 0x425B41FE4185,
+		0x425C41FE4185, 0x425D41FE4185, 0x425E41FE4185, 0x425F41FE4185, 0x426041FE4185, 0x426141FE4185, 0x426241FE4185, 0x426341FE4185, 0x426441FE4185, 0x426541FE4185, 0x426641FE4185, 0x426741FE4185, 0x426841FE4185, 0x426941FE4185, 0x426A41FE4185, 0x426B41FE4185, 0x41FF4185, 0x425141FF4185, 0x425241FF4185, 0x425341FF4185, 0x425441FF4185, 0x425541FF4185, 0x425641FF4185, 0x425741FF4185, 0x425841FF4185, 0x425941FF4185, 0x425A41FF4185, 0x425B41FF4185, 0x425C41FF4185, 0x425D41FF4185, 0x425E41FF4185, 0x425F41FF4185,
+		0x426041FF4185, 0x426141FF4185, 0x426241FF4185, 0x426341FF4185, 0x426441FF4185, 0x426541FF4185, 0x426641FF4185, 0x426741FF4185, 0x426841FF4185, 0x426941FF4185, 0x426A41FF4185, 0x426B41FF4185, 0x42004185, 0x425142004185, 0x425242004185, 0x425342004185, 0x425442004185, 0x425542004185, 0x425642004185, 0x425742004185, 0x425842004185, 0x425942004185, 0x425A42004185, 0x425B42004185, 0x425C42004185, 0x425D42004185, 0x425E42004185, 0x425F42004185, 0x426042004185, 0x426142004185, 0x426242004185, 0x426342004185,
+		0x426442004185, 0x426542004185, 0x426642004185, 0x426742004185, 0x426842004185, 0x426942004185, 0x426A42004185, 0x426B42004185, 0x42014185, 0x425142014185, 0x425242014185, 0x425342014185, 0x425442014185, 0x425542014185, 0x425642014185, 0x425742014185, 0x425842014185, 0x425942014185, 0x425A42014185, 0x425B42014185, 0x425C42014185, 0x425D42014185, 0x425E42014185, 0x425F42014185, 0x426042014185, 0x426142014185, 0x426242014185, 0x426342014185, 0x426442014185, 0x426542014185, 0x426642014185, 0x426742014185,
+		0x426842014185, 0x426942014185, 0x426A42014185, 0x426B42014185, 0x42024185, 0x425142024185, 0x425242024185, 0x425342024185, 0x425442024185, 0x425542024185, 0x425642024185, 0x425742024185, 0x425842024185, 0x425942024185, 0x425A42024185, 0x425B42024185, 0x425C42024185, 0x425D42024185, 0x425E42024185, 0x425F42024185, 0x426042024185, 0x426142024185, 0x426242024185, 0x426342024185, 0x426442024185, 0x426542024185, 0x426642024185, 0x426742024185, 0x426842024185, 0x426942024185, 0x426A42024185, 0x426B42024185,
+		0x42034185, 0x425142034185, 0x425242034185, 0x425342034185, 0x425442034185, 0x425542034185, 0x425642034185, 0x425742034185, 0x425842034185, 0x425942034185, 0x425A42034185, 0x425B42034185, 0x425C42034185, 0x425D42034185, 0x425E42034185, 0x425F42034185, 0x426042034185, 0x426142034185, 0x426242034185, 0x426342034185, 0x426442034185, 0x426542034185, 0x426642034185, 0x426742034185, 0x426842034185, 0x426942034185, 0x426A42034185, 0x426B42034185, 0x42044185, 0x425142044185, 0x425242044185, 0x425342044185,
+		0x425442044185, 0x425542044185, 0x425642044185, 0x425742044185, 0x425842044185, 0x425942044185, 0x425A42044185, 0x425B42044185, 0x425C42044185, 0x425D42044185, 0x425E42044185, 0x425F42044185, 0x426042044185, 0x426142044185, 0x426242044185, 0x426342044185, 0x426442044185, 0x426542044185, 0x426642044185, 0x426742044185, 0x426842044185, 0x426942044185, 0x426A42044185, 0x426B42044185, 0x42054185, 0x425142054185, 0x425242054185, 0x425342054185, 0x425442054185, 0x425542054185, 0x425642054185, 0x425742054185,
+		0x425842054185, 0x425942054185, 0x425A42054185, 0x425B42054185, 0x425C42054185, 0x425D42054185, 0x425E42054185, 0x425F42054185, 0x426042054185, 0x426142054185, 0x426242054185, 0x426342054185, 0x426442054185, 0x426542054185, 0x426642054185, 0x426742054185, 0x426842054185, 0x426942054185, 0x426A42054185, 0x426B42054185, 0x42064185, 0x425142064185, 0x425242064185, 0x425342064185, 0x425442064185, 0x425542064185, 0x425642064185, 0x425742064185, 0x425842064185, 0x425942064185, 0x425A42064185, 0x425B42064185,
+		0x425C42064185, 0x425D42064185, 0x425E42064185, 0x425F42064185, 0x426042064185, 0x426142064185, 0x426242064185, 0x426342064185, 0x426442064185, 0x426542064185, 0x426642064185, 0x426742064185, 0x426842064185, 0x426942064185, 0x426A42064185, 0x426B42064185, 0x42074185, 0x425142074185, 0x425242074185, 0x425342074185, 0x425442074185, 0x425542074185, 0x425642074185, 0x425742074185, 0x425842074185, 0x425942074185, 0x425A42074185, 0x425B42074185, 0x425C42074185, 0x425D42074185, 0x425E42074185, 0x425F42074185,
+		0x426042074185, 0x426142074185, 0x426242074185, 0x426342074185, 0x426442074185, 0x426542074185, 0x426642074185, 0x426742074185, 0x426842074185, 0x426942074185, 0x426A42074185, 0x426B42074185, 0x41F34186, 0x425141F34186, 0x425241F34186, 0x425341F34186, 0x425441F34186, 0x425541F34186, 0x425641F34186, 0x425741F34186, 0x425841F34186, 0x425941F34186, 0x425A41F34186, 0x425B41F34186, 0x425C41F34186, 0x425D41F34186, 0x425E41F34186, 0x425F41F34186, 0x426041F34186, 0x426141F34186, 0x426241F34186, 0x426341F34186,
+		0x426441F34186, 0x426541F34186, 0x426641F34186, 0x426741F34186, 0x426841F34186, 0x426941F34186, 0x426A41F34186, 0x426B41F34186, 0x41F44186, 0x425141F44186, 0x425241F44186, 0x425341F44186, 0x425441F44186, 0x425541F44186, 0x425641F44186, 0x425741F44186, 0x425841F44186, 0x425941F44186, 0x425A41F44186, 0x425B41F44186, 0x425C41F44186, 0x425D41F44186, 0x425E41F44186, 0x425F41F44186, 0x426041F44186, 0x426141F44186, 0x426241F44186, 0x426341F44186, 0x426441F44186, 0x426541F44186, 0x426641F44186, 0x426741F44186,
+		0x426841F44186, 0x426941F44186, 0x426A41F44186, 0x426B41F44186, 0x41F54186, 0x425141F54186, 0x425241F54186, 0x425341F54186, 0x425441F54186, 0x425541F54186, 0x425641F54186, 0x425741F54186, 0x425841F54186, 0x425941F54186, 0x425A41F54186, 0x425B41F54186, 0x425C41F54186, 0x425D41F54186, 0x425E41F54186, 0x425F41F54186, 0x426041F54186, 0x426141F54186, 0x426241F54186, 0x426341F54186, 0x426441F54186, 0x426541F54186, 0x426641F54186, 0x426741F54186, 0x426841F54186, 0x426941F54186, 0x426A41F54186, 0x426B41F54186,
+		0x41F64186, 0x425141F64186, 0x425241F64186, 0x425341F64186, 0x425441F64186, 0x425541F64186, 0x425641F64186, 0x425741F64186, 0x425841F64186, 0x425941F64186, 0x425A41F64186, 0x425B41F64186, 0x425C41F64186, 0x425D41F64186, 0x425E41F64186, 0x425F41F64186, 0x426041F64186, 0x426141F64186, 0x426241F64186, 0x426341F64186, 0x426441F64186, 0x426541F64186, 0x426641F64186, 0x426741F64186, 0x426841F64186, 0x426941F64186, 0x426A41F64186, 0x426B41F64186, 0x41F74186, 0x425141F74186, 0x425241F74186, 0x425341F74186,
+		0x425441F74186, 0x425541F74186, 0x425641F74186, 0x425741F74186, 0x425841F74186, 0x425941F74186, 0x425A41F74186, 0x425B41F74186, 0x425C41F74186, 0x425D41F74186, 0x425E41F74186, 0x425F41F74186, 0x426041F74186, 0x426141F74186, 0x426241F74186, 0x426341F74186, 0x426441F74186, 0x426541F74186, 0x426641F74186, 0x426741F74186, 0x426841F74186, 0x426941F74186, 0x426A41F74186, 0x426B41F74186, 0x41F84186, 0x425141F84186, 0x425241F84186, 0x425341F84186, 0x425441F84186, 0x425541F84186, 0x425641F84186, 0x425741F84186,
+		0x425841F84186, 0x425941F84186, 0x425A41F84186, 0x425B41F84186, 0x425C41F84186, 0x425D41F84186, 0x425E41F84186, 0x425F41F84186, 0x426041F84186, 0x426141F84186, 0x426241F84186, 0x426341F84186, 0x426441F84186, 0x426541F84186, 0x426641F84186, 0x426741F84186, 0x426841F84186, 0x426941F84186, 0x426A41F84186, 0x426B41F84186, 0x41F94186, 0x425141F94186, 0x425241F94186, 0x425341F94186, 0x425441F94186, 0x425541F94186, 0x425641F94186, 0x425741F94186, 0x425841F94186, 0x425941F94186, 0x425A41F94186, 0x425B41F94186,
+		0x425C41F94186, 0x425D41F94186, 0x425E41F94186, 0x425F41F94186, 0x426041F94186, 0x426141F94186, 0x426241F94186, 0x426341F94186, 0x426441F94186, 0x426541F94186, 0x426641F94186, 0x426741F94186, 0x426841F94186, 0x426941F94186, 0x426A41F94186, 0x426B41F94186, 0x41FA4186, 0x425141FA4186, 0x425241FA4186, 0x425341FA4186, 0x425441FA4186, 0x425541FA4186, 0x425641FA4186, 0x425741FA4186, 0x425841FA4186, 0x425941FA4186, 0x425A41FA4186, 0x425B41FA4186, 0x425C41FA4186, 0x425D41FA4186, 0x425E41FA4186, 0x425F41FA4186,
+		0x426041FA4186, 0x426141FA4186, 0x426241FA4186, 0x426341FA4186, 0x426441FA4186, 0x426541FA4186, 0x426641FA4186, 0x426741FA4186, 0x426841FA4186, 0x426941FA4186, 0x426A41FA4186, 0x426B41FA4186, 0x41FB4186, 0x425141FB4186, 0x425241FB4186, 0x425341FB4186, 0x425441FB4186, 0x425541FB4186, 0x425641FB4186, 0x425741FB4186, 0x425841FB4186, 0x425941FB4186, 0x425A41FB4186, 0x425B41FB4186, 0x425C41FB4186, 0x425D41FB4186, 0x425E41FB4186, 0x425F41FB4186, 0x426041FB4186, 0x426141FB4186, 0x426241FB4186, 0x426341FB4186,
+		0x426441FB4186, 0x426541FB4186, 0x426641FB4186, 0x426741FB4186, 0x426841FB4186, 0x426941FB4186, 0x426A41FB4186, 0x426B41FB4186, 0x41FC4186, 0x425141FC4186, 0x425241FC4186, 0x425341FC4186, 0x425441FC4186, 0x425541FC4186, 0x425641FC4186, 0x425741FC4186, 0x425841FC4186, 0x425941FC4186, 0x425A41FC4186, 0x425B41FC4186, 0x425C41FC4186, 0x425D41FC4186, 0x425E41FC4186, 0x425F41FC4186, 0x426041FC4186, 0x426141FC4186, 0x426241FC4186, 0x426341FC4186, 0x426441FC4186, 0x426541FC4186, 0x426641FC4186, 0x426741FC4186,
+		0x426841FC4186, 0x426941FC4186, 0x426A41FC4186, 0x426B41FC4186, 0x41FD4186, 0x425141FD4186, 0x425241FD4186, 0x425341FD4186, 0x425441FD4186, 0x425541FD4186, 0x425641FD4186, 0x425741FD4186, 0x425841FD4186, 0x425941FD4186, 0x425A41FD4186, 0x425B41FD4186, 0x425C41FD4186, 0x425D41FD4186, 0x425E41FD4186, 0x425F41FD4186, 0x426041FD4186, 0x426141FD4186, 0x426241FD4186, 0x426341FD4186, 0x426441FD4186, 0x426541FD4186, 0x426641FD4186, 0x426741FD4186, 0x426841FD4186, 0x426941FD4186, 0x426A41FD4186, 0x426B41FD4186,
+		0x41FE4186, 0x425141FE4186, 0x425241FE4186, 0x425341FE4186, 0x425441FE4186, 0x425541FE4186, 0x425641FE4186, 0x425741FE4186, 0x425841FE4186, 0x425941FE4186, 0x425A41FE4186, 0x425B41FE4186, 0x425C41FE4186, 0x425D41FE4186, 0x425E41FE4186, 0x425F41FE4186, 0x426041FE4186, 0x426141FE4186, 0x426241FE4186, 0x426341FE4186, 0x426441FE4186, 0x426541FE4186, 0x426641FE4186, 0x426741FE4186, 0x426841FE4186, 0x426941FE4186, 0x426A41FE4186, 0x426B41FE4186, 0x41FF4186, 0x425141FF4186, 0x425241FF4186, 0x425341FF4186,
+		0x425441FF4186, 0x425541FF4186, 0x425641FF4186, 0x425741FF4186, 0x425841FF4186, 0x425941FF4186, 0x425A41FF4186, 0x425B41FF4186, 0x425C41FF4186, 0x425D41FF4186, 0x425E41FF4186, 0x425F41FF4186, 0x426041FF4186, 0x426141FF4186, 0x426241FF4186, 0x426341FF4186, 0x426441FF4186, 0x426541FF4186, 0x426641FF4186, 0x426741FF4186, 0x426841FF4186, 0x426941FF4186, 0x426A41FF4186, 0x426B41FF4186, 0x42004186, 0x425142004186, 0x425242004186, 0x425342004186, 0x425442004186, 0x425542004186, 0x425642004186, 0x425742004186,
+		0x425842004186, 0x425942004186, 0x425A42004186, 0x425B42004186, 0x425C42004186, 0x425D42004186, 0x425E42004186, 0x425F42004186, 0x426042004186, 0x426142004186, 0x426242004186, 0x426342004186, 0x426442004186, 0x426542004186, 0x426642004186, 0x426742004186, 0x426842004186, 0x426942004186, 0x426A42004186, 0x426B42004186, 0x42014186, 0x425142014186, 0x425242014186, 0x425342014186, 0x425442014186, 0x425542014186, 0x425642014186, 0x425742014186, 0x425842014186, 0x425942014186, 0x425A42014186, 0x425B42014186,
+		0x425C42014186, 0x425D42014186, 0x425E42014186, 0x425F42014186, 0x426042014186, 0x426142014186, 0x426242014186, 0x426342014186, 0x426442014186, 0x426542014186, 0x426642014186, 0x426742014186, 0x426842014186, 0x426942014186, 0x426A42014186, 0x426B42014186, 0x42024186, 0x425142024186, 0x425242024186, 0x425342024186, 0x425442024186, 0x425542024186, 0x425642024186, 0x425742024186, 0x425842024186, 0x425942024186, 0x425A42024186, 0x425B42024186, 0x425C42024186, 0x425D42024186, 0x425E42024186, 0x425F42024186,
+		0x426042024186, 0x426142024186, 0x426242024186, 0x426342024186, 0x426442024186, 0x426542024186, 0x426642024186, 0x426742024186, 0x426842024186, 0x426942024186, 0x426A42024186, 0x426B42024186, 0x42034186, 0x425142034186, 0x425242034186, 0x425342034186, 0x425442034186, 0x425542034186, 0x425642034186, 0x425742034186, 0x425842034186, 0x425942034186, 0x425A42034186, 0x425B42034186, 0x425C42034186, 0x425D42034186, 0x425E42034186, 0x425F42034186, 0x426042034186, 0x426142034186, 0x426242034186, 0x426342034186,
+		0x426442034186, 0x426542034186, 0x426642034186, 0x426742034186, 0x426842034186, 0x426942034186, 0x426A42034186, 0x426B42034186, 0x42044186, 0x425142044186, 0x425242044186, 0x425342044186, 0x425442044186, 0x425542044186, 0x425642044186, 0x425742044186, 0x425842044186, 0x425942044186, 0x425A42044186, 0x425B42044186, 0x425C42044186, 0x425D42044186, 0x425E42044186, 0x425F42044186, 0x426042044186, 0x426142044186, 0x426242044186, 0x426342044186, 0x426442044186, 0x426542044186, 0x426642044186, 0x426742044186,
+		0x426842044186, 0x426942044186, 0x426A42044186, 0x426B42044186, 0x42054186, 0x425142054186, 0x425242054186, 0x425342054186, 0x425442054186, 0x425542054186, 0x425642054186, 0x425742054186, 0x425842054186, 0x425942054186, 0x425A42054186, 0x425B42054186, 0x425C42054186, 0x425D42054186, 0x425E42054186, 0x425F42054186, 0x426042054186, 0x426142054186, 0x426242054186, 0x426342054186, 0x426442054186, 0x426542054186, 0x426642054186, 0x426742054186, 0x426842054186, 0x426942054186, 0x426A42054186, 0x426B42054186,
+		0x42064186, 0x425142064186, 0x425242064186, 0x425342064186, 0x425442064186, 0x425542064186, 0x425642064186, 0x425742064186, 0x425842064186, 0x425942064186, 0x425A42064186, 0x425B42064186, 0x425C42064186, 0x425D42064186, 0x425E42064186, 0x425F42064186, 0x426042064186, 0x426142064186, 0x426242064186, 0x426342064186, 0x426442064186, 0x426542064186, 0x426642064186, 0x426742064186, 0x426842064186, 0x426942064186, 0x426A42064186, 0x426B42064186, 0x42074186, 0x425142074186, 0x425242074186, 0x425342074186,
+		0x425442074186, 0x425542074186, 0x425642074186, 0x425742074186, 0x425842074186, 0x425942074186, 0x425A42074186, 0x425B42074186, 0x425C42074186, 0x425D42074186, 0x425E42074186, 0x425F42074186, 0x426042074186, 0x426142074186, 0x426242074186, 0x426342074186, 0x426442074186, 0x426542074186, 0x426642074186, 0x426742074186, 0x426842074186, 0x426942074186, 0x426A42074186, 0x426B42074186, 0x41F34187, 0x425141F34187, 0x425241F34187, 0x425341F34187, 0x425441F34187, 0x425541F34187, 0x425641F34187, 0x425741F34187,
+		0x425841F34187, 0x425941F34187, 0x425A41F34187, 0x425B41F34187, 0x425C41F34187, 0x425D41F34187, 0x425E41F34187, 0x425F41F34187, 0x426041F34187, 0x426141F34187, 0x426241F34187, 0x426341F34187, 0x426441F34187, 0x426541F34187, 0x426641F34187, 0x426741F34187, 0x426841F34187, 0x426941F34187, 0x426A41F34187, 0x426B41F34187, 0x41F44187, 0x425141F44187, 0x425241F44187, 0x425341F44187, 0x425441F44187, 0x425541F44187, 0x425641F44187, 0x425741F44187, 0x425841F44187, 0x425941F44187, 0x425A41F44187, 0x425B41F44187,
+		0x425C41F44187, 0x425D41F44187, 0x425E41F44187, 0x425F41F44187, 0x426041F44187, 0x426141F44187, 0x426241F44187, 0x426341F44187, 0x426441F44187, 0x426541F44187, 0x426641F44187, 0x426741F44187, 0x426841F44187, 0x426941F44187, 0x426A41F44187, 0x426B41F44187, 0x41F54187, 0x425141F54187, 0x425241F54187, 0x425341F54187, 0x425441F54187, 0x425541F54187, 0x425641F54187, 0x425741F54187, 0x425841F54187, 0x425941F54187, 0x425A41F54187, 0x425B41F54187, 0x425C41F54187, 0x425D41F54187, 0x425E41F54187, 0x425F41F54187,
+		0x426041F54187, 0x426141F54187, 0x426241F54187, 0x426341F54187, 0x426441F54187, 0x426541F54187, 0x426641F54187, 0x426741F54187, 0x426841F54187, 0x426941F54187, 0x426A41F54187, 0x426B41F54187, 0x41F64187, 0x425141F64187, 0x425241F64187, 0x425341F64187, 0x425441F64187, 0x425541F64187, 0x425641F64187, 0x425741F64187, 0x425841F64187, 0x425941F64187, 0x425A41F64187, 0x425B41F64187, 0x425C41F64187, 0x425D41F64187, 0x425E41F64187, 0x425F41F64187, 0x426041F64187, 0x426141F64187, 0x426241F64187, 0x426341F64187,
+		0x426441F64187, 0x426541F64187, 0x426641F64187, 0x426741F64187, 0x426841F64187, 0x426941F64187, 0x426A41F64187, 0x426B41F64187, 0x41F74187, 0x425141F74187, 0x425241F74187, 0x425341F74187, 0x425441F74187, 0x425541F74187, 0x425641F74187, 0x425741F74187, 0x425841F74187, 0x425941F74187, 0x425A41F74187, 0x425B41F74187, 0x425C41F74187, 0x425D41F74187, 0x425E41F74187, 0x425F41F74187, 0x426041F74187, 0x426141F74187, 0x426241F74187, 0x426341F74187, 0x426441F74187, 0x426541F74187, 0x426641F74187, 0x426741F74187,
+		0x426841F74187, 0x426941F74187, 0x426A41F74187, 0x426B41F74187, 0x41F84187, 0x425141F84187, 0x425241F84187, 0x425341F84187, 0x425441F84187, 0x425541F84187, 0x425641F84187, 0x425741F84187, 0x425841F84187, 0x425941F84187, 0x425A41F84187, 0x425B41F84187, 0x425C41F84187, 0x425D41F84187, 0x425E41F84187, 0x425F41F84187, 0x426041F84187, 0x426141F84187, 0x426241F84187, 0x426341F84187, 0x426441F84187, 0x426541F84187, 0x426641F84187, 0x426741F84187, 0x426841F84187, 0x426941F84187, 0x426A41F84187, 0x426B41F84187,
+		0x41F94187, 0x425141F94187, 0x425241F94187, 0x425341F94187, 0x425441F94187, 0x425541F94187, 0x425641F94187, 0x425741F94187, 0x425841F94187, 0x425941F94187, 0x425A41F94187, 0x425B41F94187, 0x425C41F94187, 0x425D41F94187, 0x425E41F94187, 0x425F41F94187, 0x426041F94187, 0x426141F94187, 0x426241F94187, 0x426341F94187, 0x426441F94187, 0x426541F94187, 0x426641F94187, 0x426741F94187, 0x426841F94187, 0x426941F94187, 0x426A41F94187, 0x426B41F94187, 0x41FA4187, 0x425141FA4187, 0x425241FA4187, 0x425341FA4187,
+		0x425441FA4187, 0x425541FA4187, 0x425641FA4187, 0x425741FA4187, 0x425841FA4187, 0x425941FA4187, 0x425A41FA4187, 0x425B41FA4187, 0x425C41FA4187, 0x425D41FA4187, 0x425E41FA4187, 0x425F41FA4187, 0x426041FA4187, 0x426141FA4187, 0x426241FA4187, 0x426341FA4187, 0x426441FA4187, 0x426541FA4187, 0x426641FA4187, 0x426741FA4187, 0x426841FA4187, 0x426941FA4187, 0x426A41FA4187, 0x426B41FA4187, 0x41FB4187, 0x425141FB4187, 0x425241FB4187, 0x425341FB4187, 0x425441FB4187, 0x425541FB4187, 0x425641FB4187, 0x425741FB4187,
+		0x425841FB4187, 0x425941FB4187, 0x425A41FB4187, 0x425B41FB4187, 0x425C41FB4187, 0x425D41FB4187, 0x425E41FB4187, 0x425F41FB4187, 0x426041FB4187, 0x426141FB4187, 0x426241FB4187, 0x426341FB4187, 0x426441FB4187, 0x426541FB4187, 0x426641FB4187, 0x426741FB4187, 0x426841FB4187, 0x426941FB4187, 0x426A41FB4187, 0x426B41FB4187, 0x41FC4187, 0x425141FC4187, 0x425241FC4187, 0x425341FC4187, 0x425441FC4187, 0x425541FC4187, 0x425641FC4187, 0x425741FC4187, 0x425841FC4187, 0x425941FC4187, 0x425A41FC4187, 0x425B41FC4187,
+		0x425C41FC4187, 0x425D41FC4187, 0x425E41FC4187, 0x425F41FC4187, 0x426041FC4187, 0x426141FC4187, 0x426241FC4187, 0x426341FC4187, 0x426441FC4187, 0x426541FC4187, 0x426641FC4187, 0x426741FC4187, 0x426841FC4187, 0x426941FC4187, 0x426A41FC4187, 0x426B41FC4187, 0x41FD4187, 0x425141FD4187, 0x425241FD4187, 0x425341FD4187, 0x425441FD4187, 0x425541FD4187, 0x425641FD4187, 0x425741FD4187, 0x425841FD4187, 0x425941FD4187, 0x425A41FD4187, 0x425B41FD4187, 0x425C41FD4187, 0x425D41FD4187, 0x425E41FD4187, 0x425F41FD4187,
+		0x426041FD4187, 0x426141FD4187, 0x426241FD4187, 0x426341FD4187, 0x426441FD4187, 0x426541FD4187, 0x426641FD4187, 0x426741FD4187, 0x426841FD4187, 0x426941FD4187, 0x426A41FD4187, 0x426B41FD4187, 0x41FE4187, 0x425141FE4187, 0x425241FE4187, 0x425341FE4187, 0x425441FE4187, 0x425541FE4187, 0x425641FE4187, 0x425741FE4187, 0x425841FE4187, 0x425941FE4187, 0x425A41FE4187, 0x425B41FE4187, 0x425C41FE4187, 0x425D41FE4187, 0x425E41FE4187, 0x425F41FE4187, 0x426041FE4187, 0x426141FE4187, 0x426241FE4187, 0x426341FE4187,
+		0x426441FE4187, 0x426541FE4187, 0x426641FE4187, 0x426741FE4187, 0x426841FE4187, 0x426941FE4187, 0x426A41FE4187, 0x426B41FE4187, 0x41FF4187, 0x425141FF4187, 0x425241FF4187, 0x425341FF4187, 0x425441FF4187, 0x425541FF4187, 0x425641FF4187, 0x425741FF4187, 0x425841FF4187, 0x425941FF4187, 0x425A41FF4187, 0x425B41FF4187, 0x425C41FF4187, 0x425D41FF4187, 0x425E41FF4187, 0x425F41FF4187, 0x426041FF4187, 0x426141FF4187, 0x426241FF4187, 0x426341FF4187, 0x426441FF4187, 0x426541FF4187, 0x426641FF4187, 0x426741FF4187,
+		0x426841FF4187, 0x426941FF4187, 0x426A41FF4187, 0x426B41FF4187, 0x42004187, 0x425142004187, 0x425242004187, 0x425342004187, 0x425442004187, 0x425542004187, 0x425642004187, 0x425742004187, 0x425842004187, 0x425942004187, 0x425A42004187, 0x425B42004187, 0x425C42004187, 0x425D42004187, 0x425E42004187, 0x425F42004187, 0x426042004187, 0x426142004187, 0x426242004187, 0x426342004187, 0x426442004187, 0x426542004187, 0x426642004187, 0x426742004187, 0x426842004187, 0x426942004187, 0x426A42004187, 0x426B42004187,
+		0x42014187, 0x425142014187, 0x425242014187, 0x425342014187, 0x425442014187, 0x425542014187, 0x425642014187, 0x425742014187, 0x425842014187, 0x425942014187, 0x425A42014187, 0x425B42014187, 0x425C42014187, 0x425D42014187, 0x425E42014187, 0x425F42014187, 0x426042014187, 0x426142014187, 0x426242014187, 0x426342014187, 0x426442014187, 0x426542014187, 0x426642014187, 0x426742014187, 0x426842014187, 0x426942014187, 0x426A42014187, 0x426B42014187, 0x42024187, 0x425142024187, 0x425242024187, 0x425342024187,
+		0x425442024187, 0x425542024187, 0x425642024187, 0x425742024187, 0x425842024187, 0x425942024187, 0x425A42024187, 0x425B42024187, 0x425C42024187, 0x425D42024187, 0x425E42024187, 0x425F42024187, 0x426042024187, 0x426142024187, 0x426242024187, 0x426342024187, 0x426442024187, 0x426542024187, 0x426642024187, 0x426742024187, 0x426842024187, 0x426942024187, 0x426A42024187, 0x426B42024187, 0x42034187, 0x425142034187, 0x425242034187, 0x425342034187, 0x425442034187, 0x425542034187, 0x425642034187, 0x425742034187,
+		0x425842034187, 0x425942034187, 0x425A42034187, 0x425B42034187, 0x425C42034187, 0x425D42034187, 0x425E42034187, 0x425F42034187, 0x426042034187, 0x426142034187, 0x426242034187, 0x426342034187, 0x426442034187, 0x426542034187, 0x426642034187, 0x426742034187, 0x426842034187, 0x426942034187, 0x426A42034187, 0x426B42034187, 0x42044187, 0x425142044187, 0x425242044187, 0x425342044187, 0x425442044187, 0x425542044187, 0x425642044187, 0x425742044187, 0x425842044187, 0x425942044187, 0x425A42044187, 0x425B42044187,
+		0x425C42044187, 0x425D42044187, 0x425E42044187, 0x425F42044187, 0x426042044187, 0x426142044187, 0x426242044187, 0x426342044187, 0x426442044187, 0x426542044187, 0x426642044187, 0x426742044187, 0x426842044187, 0x426942044187, 0x426A42044187, 0x426B42044187, 0x42054187, 0x425142054187, 0x425242054187, 0x425342054187, 0x425442054187, 0x425542054187, 0x425642054187, 0x425742054187, 0x425842054187, 0x425942054187, 0x425A42054187, 0x425B42054187, 0x425C42054187, 0x425D42054187, 0x425E42054187, 0x425F42054187,
+		0x426042054187, 0x426142054187, 0x426242054187, 0x426342054187, 0x426442054187, 0x426542054187, 0x426642054187, 0x426742054187, 0x426842054187, 0x426942054187, 0x426A42054187, 0x426B42054187, 0x42064187, 0x425142064187, 0x425242064187, 0x425342064187, 0x425442064187, 0x425542064187, 0x425642064187, 0x425742064187, 0x425842064187, 0x425942064187, 0x425A42064187, 0x425B42064187, 0x425C42064187, 0x425D42064187, 0x425E42064187, 0x425F42064187, 0x426042064187, 0x426142064187, 0x426242064187, 0x426342064187,
+		0x426442064187, 0x426542064187, 0x426642064187, 0x426742064187, 0x426842064187, 0x426942064187, 0x426A42064187, 0x426B42064187, 0x42074187, 0x425142074187, 0x425242074187, 0x425342074187, 0x425442074187, 0x425542074187, 0x425642074187, 0x425742074187, 0x425842074187, 0x425942074187, 0x425A42074187, 0x425B42074187, 0x425C42074187, 0x425D42074187, 0x425E42074187, 0x425F42074187, 0x426042074187, 0x426142074187, 0x426242074187, 0x426342074187, 0x426442074187, 0x426542074187, 0x426642074187, 0x426742074187,
+		0x426842074187, 0x426942074187, 0x426A42074187, 0x426B42074187, 0xD7A4FBC1, 0xD7A5FBC1, 0xD7A6FBC1, 0xD7A7FBC1, 0xD7A8FBC1, 0xD7A9FBC1, 0xD7AAFBC1, 0xD7ABFBC1, 0xD7ACFBC1, 0xD7ADFBC1, 0xD7AEFBC1, 0xD7AFFBC1, 0x423A, 0x423B, 0x423C, 0x423D, 0x423E, 0x423F, 0x4240, 0x4241, 0x4242, 0x4243, 0x4244, 0x4245, 0x4246, 0x4247, 0x4248, 0x4249,
+		0x424A, 0x424B, 0x424C, 0x424D, 0x424E, 0x424F, 0x4250, 0xD7C7FBC1, 0xD7C8FBC1, 0xD7C9FBC1, 0xD7CAFBC1, 0x42A9, 0x42AA, 0x42AB, 0x42AC, 0x42AD, 0x42AE, 0x42AF, 0x42B0, 0x42B1, 0x42B2, 0x42B3, 0x42B4, 0x42B5, 0x42B6, 0x42B7, 0x42B8, 0x42B9, 0x42BA, 0x42BB, 0x42BC, 0x42BD,
+		0x42BE, 0x42BF, 0x42C0, 0x42C1, 0x42C2, 0x42C3, 0x42C4, 0x42C5, 0x42C6, 0x42C7, 0x42C8, 0x42C9, 0x42CA, 0x42CB, 0x42CC, 0x42CD, 0x42CE, 0x42CF, 0x42D0, 0x42D1, 0x42D2, 0x42D3, 0x42D4, 0x42D5, 0x42D6, 0x42D7, 0x42D8, 0x42D9, 0xD7FCFBC1, 0xD7FDFBC1, 0xD7FEFBC1, 0xD7FFFBC1,
+		0xD800FBC1, 0xD801FBC1, 0xD802FBC1, 0xD803FBC1, 0xD804FBC1, 0xD805FBC1, 0xD806FBC1, 0xD807FBC1, 0xD808FBC1, 0xD809FBC1, 0xD80AFBC1, 0xD80BFBC1, 0xD80CFBC1, 0xD80DFBC1, 0xD80EFBC1, 0xD80FFBC1, 0xD810FBC1, 0xD811FBC1, 0xD812FBC1, 0xD813FBC1, 0xD814FBC1, 0xD815FBC1, 0xD816FBC1, 0xD817FBC1, 0xD818FBC1, 0xD819FBC1, 0xD81AFBC1, 0xD81BFBC1, 0xD81CFBC1, 0xD81DFBC1, 0xD81EFBC1, 0xD81FFBC1,
+		0xD820FBC1, 0xD821FBC1, 0xD822FBC1, 0xD823FBC1, 0xD824FBC1, 0xD825FBC1, 0xD826FBC1, 0xD827FBC1, 0xD828FBC1, 0xD829FBC1, 0xD82AFBC1, 0xD82BFBC1, 0xD82CFBC1, 0xD82DFBC1, 0xD82EFBC1, 0xD82FFBC1, 0xD830FBC1, 0xD831FBC1, 0xD832FBC1, 0xD833FBC1, 0xD834FBC1, 0xD835FBC1, 0xD836FBC1, 0xD837FBC1, 0xD838FBC1, 0xD839FBC1, 0xD83AFBC1, 0xD83BFBC1, 0xD83CFBC1, 0xD83DFBC1, 0xD83EFBC1, 0xD83FFBC1,
+		0xD840FBC1, 0xD841FBC1, 0xD842FBC1, 0xD843FBC1, 0xD844FBC1, 0xD845FBC1, 0xD846FBC1, 0xD847FBC1, 0xD848FBC1, 0xD849FBC1, 0xD84AFBC1, 0xD84BFBC1, 0xD84CFBC1, 0xD84DFBC1, 0xD84EFBC1, 0xD84FFBC1, 0xD850FBC1, 0xD851FBC1, 0xD852FBC1, 0xD853FBC1, 0xD854FBC1, 0xD855FBC1, 0xD856FBC1, 0xD857FBC1, 0xD858FBC1, 0xD859FBC1, 0xD85AFBC1, 0xD85BFBC1, 0xD85CFBC1, 0xD85DFBC1, 0xD85EFBC1, 0xD85FFBC1,
+		0xD860FBC1, 0xD861FBC1, 0xD862FBC1, 0xD863FBC1, 0xD864FBC1, 0xD865FBC1, 0xD866FBC1, 0xD867FBC1, 0xD868FBC1, 0xD869FBC1, 0xD86AFBC1, 0xD86BFBC1, 0xD86CFBC1, 0xD86DFBC1, 0xD86EFBC1, 0xD86FFBC1, 0xD870FBC1, 0xD871FBC1, 0xD872FBC1, 0xD873FBC1, 0xD874FBC1, 0xD875FBC1, 0xD876FBC1, 0xD877FBC1, 0xD878FBC1, 0xD879FBC1, 0xD87AFBC1, 0xD87BFBC1, 0xD87CFBC1, 0xD87DFBC1, 0xD87EFBC1, 0xD87FFBC1,
+		0xD880FBC1, 0xD881FBC1, 0xD882FBC1, 0xD883FBC1, 0xD884FBC1, 0xD885FBC1, 0xD886FBC1, 0xD887FBC1, 0xD888FBC1, 0xD889FBC1, 0xD88AFBC1, 0xD88BFBC1, 0xD88CFBC1, 0xD88DFBC1, 0xD88EFBC1, 0xD88FFBC1, 0xD890FBC1, 0xD891FBC1, 0xD892FBC1, 0xD893FBC1, 0xD894FBC1, 0xD895FBC1, 0xD896FBC1, 0xD897FBC1, 0xD898FBC1, 0xD899FBC1, 0xD89AFBC1, 0xD89BFBC1, 0xD89CFBC1, 0xD89DFBC1, 0xD89EFBC1, 0xD89FFBC1,
+		0xD8A0FBC1, 0xD8A1FBC1, 0xD8A2FBC1, 0xD8A3FBC1, 0xD8A4FBC1, 0xD8A5FBC1, 0xD8A6FBC1, 0xD8A7FBC1, 0xD8A8FBC1, 0xD8A9FBC1, 0xD8AAFBC1, 0xD8ABFBC1, 0xD8ACFBC1, 0xD8ADFBC1, 0xD8AEFBC1, 0xD8AFFBC1, 0xD8B0FBC1, 0xD8B1FBC1, 0xD8B2FBC1, 0xD8B3FBC1, 0xD8B4FBC1, 0xD8B5FBC1, 0xD8B6FBC1, 0xD8B7FBC1, 0xD8B8FBC1, 0xD8B9FBC1, 0xD8BAFBC1, 0xD8BBFBC1, 0xD8BCFBC1, 0xD8BDFBC1, 0xD8BEFBC1, 0xD8BFFBC1,
+		0xD8C0FBC1, 0xD8C1FBC1, 0xD8C2FBC1, 0xD8C3FBC1, 0xD8C4FBC1, 0xD8C5FBC1, 0xD8C6FBC1, 0xD8C7FBC1, 0xD8C8FBC1, 0xD8C9FBC1, 0xD8CAFBC1, 0xD8CBFBC1, 0xD8CCFBC1, 0xD8CDFBC1, 0xD8CEFBC1, 0xD8CFFBC1, 0xD8D0FBC1, 0xD8D1FBC1, 0xD8D2FBC1, 0xD8D3FBC1, 0xD8D4FBC1, 0xD8D5FBC1, 0xD8D6FBC1, 0xD8D7FBC1, 0xD8D8FBC1, 0xD8D9FBC1, 0xD8DAFBC1, 0xD8DBFBC1, 0xD8DCFBC1, 0xD8DDFBC1, 0xD8DEFBC1, 0xD8DFFBC1,
+		0xD8E0FBC1, 0xD8E1FBC1, 0xD8E2FBC1, 0xD8E3FBC1, 0xD8E4FBC1, 0xD8E5FBC1, 0xD8E6FBC1, 0xD8E7FBC1, 0xD8E8FBC1, 0xD8E9FBC1, 0xD8EAFBC1, 0xD8EBFBC1, 0xD8ECFBC1, 0xD8EDFBC1, 0xD8EEFBC1, 0xD8EFFBC1, 0xD8F0FBC1, 0xD8F1FBC1, 0xD8F2FBC1, 0xD8F3FBC1, 0xD8F4FBC1, 0xD8F5FBC1, 0xD8F6FBC1, 0xD8F7FBC1, 0xD8F8FBC1, 0xD8F9FBC1, 0xD8FAFBC1, 0xD8FBFBC1, 0xD8FCFBC1, 0xD8FDFBC1, 0xD8FEFBC1, 0xD8FFFBC1,
+		0xD900FBC1, 0xD901FBC1, 0xD902FBC1, 0xD903FBC1, 0xD904FBC1, 0xD905FBC1, 0xD906FBC1, 0xD907FBC1, 0xD908FBC1, 0xD909FBC1, 0xD90AFBC1, 0xD90BFBC1, 0xD90CFBC1, 0xD90DFBC1, 0xD90EFBC1, 0xD90FFBC1, 0xD910FBC1, 0xD911FBC1, 0xD912FBC1, 0xD913FBC1, 0xD914FBC1, 0xD915FBC1, 0xD916FBC1, 0xD917FBC1, 0xD918FBC1, 0xD919FBC1, 0xD91AFBC1, 0xD91BFBC1, 0xD91CFBC1, 0xD91DFBC1, 0xD91EFBC1, 0xD91FFBC1,
+		0xD920FBC1, 0xD921FBC1, 0xD922FBC1, 0xD923FBC1, 0xD924FBC1, 0xD925FBC1, 0xD926FBC1, 0xD927FBC1, 0xD928FBC1, 0xD929FBC1, 0xD92AFBC1, 0xD92BFBC1, 0xD92CFBC1, 0xD92DFBC1, 0xD92EFBC1, 0xD92FFBC1, 0xD930FBC1, 0xD931FBC1, 0xD932FBC1, 0xD933FBC1, 0xD934FBC1, 0xD935FBC1, 0xD936FBC1, 0xD937FBC1, 0xD938FBC1, 0xD939FBC1, 0xD93AFBC1, 0xD93BFBC1, 0xD93CFBC1, 0xD93DFBC1, 0xD93EFBC1, 0xD93FFBC1,
+		0xD940FBC1, 0xD941FBC1, 0xD942FBC1, 0xD943FBC1, 0xD944FBC1, 0xD945FBC1, 0xD946FBC1, 0xD947FBC1, 0xD948FBC1, 0xD949FBC1, 0xD94AFBC1, 0xD94BFBC1, 0xD94CFBC1, 0xD94DFBC1, 0xD94EFBC1, 0xD94FFBC1, 0xD950FBC1, 0xD951FBC1, 0xD952FBC1, 0xD953FBC1, 0xD954FBC1, 0xD955FBC1, 0xD956FBC1, 0xD957FBC1, 0xD958FBC1, 0xD959FBC1, 0xD95AFBC1, 0xD95BFBC1, 0xD95CFBC1, 0xD95DFBC1, 0xD95EFBC1, 0xD95FFBC1,
+		0xD960FBC1, 0xD961FBC1, 0xD962FBC1, 0xD963FBC1, 0xD964FBC1, 0xD965FBC1, 0xD966FBC1, 0xD967FBC1, 0xD968FBC1, 0xD969FBC1, 0xD96AFBC1, 0xD96BFBC1, 0xD96CFBC1, 0xD96DFBC1, 0xD96EFBC1, 0xD96FFBC1, 0xD970FBC1, 0xD971FBC1, 0xD972FBC1, 0xD973FBC1, 0xD974FBC1, 0xD975FBC1, 0xD976FBC1, 0xD977FBC1, 0xD978FBC1, 0xD979FBC1, 0xD97AFBC1, 0xD97BFBC1, 0xD97CFBC1, 0xD97DFBC1, 0xD97EFBC1, 0xD97FFBC1,
+		0xD980FBC1, 0xD981FBC1, 0xD982FBC1, 0xD983FBC1, 0xD984FBC1, 0xD985FBC1, 0xD986FBC1, 0xD987FBC1, 0xD988FBC1, 0xD989FBC1, 0xD98AFBC1, 0xD98BFBC1, 0xD98CFBC1, 0xD98DFBC1, 0xD98EFBC1, 0xD98FFBC1, 0xD990FBC1, 0xD991FBC1, 0xD992FBC1, 0xD993FBC1, 0xD994FBC1, 0xD995FBC1, 0xD996FBC1, 0xD997FBC1, 0xD998FBC1, 0xD999FBC1, 0xD99AFBC1, 0xD99BFBC1, 0xD99CFBC1, 0xD99DFBC1, 0xD99EFBC1, 0xD99FFBC1,
+		0xD9A0FBC1, 0xD9A1FBC1, 0xD9A2FBC1, 0xD9A3FBC1, 0xD9A4FBC1, 0xD9A5FBC1, 0xD9A6FBC1, 0xD9A7FBC1, 0xD9A8FBC1, 0xD9A9FBC1, 0xD9AAFBC1, 0xD9ABFBC1, 0xD9ACFBC1, 0xD9ADFBC1, 0xD9AEFBC1, 0xD9AFFBC1, 0xD9B0FBC1, 0xD9B1FBC1, 0xD9B2FBC1, 0xD9B3FBC1, 0xD9B4FBC1, 0xD9B5FBC1, 0xD9B6FBC1, 0xD9B7FBC1, 0xD9B8FBC1, 0xD9B9FBC1, 0xD9BAFBC1, 0xD9BBFBC1, 0xD9BCFBC1, 0xD9BDFBC1, 0xD9BEFBC1, 0xD9BFFBC1,
+		0xD9C0FBC1, 0xD9C1FBC1, 0xD9C2FBC1, 0xD9C3FBC1, 0xD9C4FBC1, 0xD9C5FBC1, 0xD9C6FBC1, 0xD9C7FBC1, 0xD9C8FBC1, 0xD9C9FBC1, 0xD9CAFBC1, 0xD9CBFBC1, 0xD9CCFBC1, 0xD9CDFBC1, 0xD9CEFBC1, 0xD9CFFBC1, 0xD9D0FBC1, 0xD9D1FBC1, 0xD9D2FBC1, 0xD9D3FBC1, 0xD9D4FBC1, 0xD9D5FBC1, 0xD9D6FBC1, 0xD9D7FBC1, 0xD9D8FBC1, 0xD9D9FBC1, 0xD9DAFBC1, 0xD9DBFBC1, 0xD9DCFBC1, 0xD9DDFBC1, 0xD9DEFBC1, 0xD9DFFBC1,
+		0xD9E0FBC1, 0xD9E1FBC1, 0xD9E2FBC1, 0xD9E3FBC1, 0xD9E4FBC1, 0xD9E5FBC1, 0xD9E6FBC1, 0xD9E7FBC1, 0xD9E8FBC1, 0xD9E9FBC1, 0xD9EAFBC1, 0xD9EBFBC1, 0xD9ECFBC1, 0xD9EDFBC1, 0xD9EEFBC1, 0xD9EFFBC1, 0xD9F0FBC1, 0xD9F1FBC1, 0xD9F2FBC1, 0xD9F3FBC1, 0xD9F4FBC1, 0xD9F5FBC1, 0xD9F6FBC1, 0xD9F7FBC1, 0xD9F8FBC1, 0xD9F9FBC1, 0xD9FAFBC1, 0xD9FBFBC1, 0xD9FCFBC1, 0xD9FDFBC1, 0xD9FEFBC1, 0xD9FFFBC1,
+		0xDA00FBC1, 0xDA01FBC1, 0xDA02FBC1, 0xDA03FBC1, 0xDA04FBC1, 0xDA05FBC1, 0xDA06FBC1, 0xDA07FBC1, 0xDA08FBC1, 0xDA09FBC1, 0xDA0AFBC1, 0xDA0BFBC1, 0xDA0CFBC1, 0xDA0DFBC1, 0xDA0EFBC1, 0xDA0FFBC1, 0xDA10FBC1, 0xDA11FBC1, 0xDA12FBC1, 0xDA13FBC1, 0xDA14FBC1, 0xDA15FBC1, 0xDA16FBC1, 0xDA17FBC1, 0xDA18FBC1, 0xDA19FBC1, 0xDA1AFBC1, 0xDA1BFBC1, 0xDA1CFBC1, 0xDA1DFBC1, 0xDA1EFBC1, 0xDA1FFBC1,
+		0xDA20FBC1, 0xDA21FBC1, 0xDA22FBC1, 0xDA23FBC1, 0xDA24FBC1, 0xDA25FBC1, 0xDA26FBC1, 0xDA27FBC1, 0xDA28FBC1, 0xDA29FBC1, 0xDA2AFBC1, 0xDA2BFBC1, 0xDA2CFBC1, 0xDA2DFBC1, 0xDA2EFBC1, 0xDA2FFBC1, 0xDA30FBC1, 0xDA31FBC1, 0xDA32FBC1, 0xDA33FBC1, 0xDA34FBC1, 0xDA35FBC1, 0xDA36FBC1, 0xDA37FBC1, 0xDA38FBC1, 0xDA39FBC1, 0xDA3AFBC1, 0xDA3BFBC1, 0xDA3CFBC1, 0xDA3DFBC1, 0xDA3EFBC1, 0xDA3FFBC1,
+		0xDA40FBC1, 0xDA41FBC1, 0xDA42FBC1, 0xDA43FBC1, 0xDA44FBC1, 0xDA45FBC1, 0xDA46FBC1, 0xDA47FBC1, 0xDA48FBC1, 0xDA49FBC1, 0xDA4AFBC1, 0xDA4BFBC1, 0xDA4CFBC1, 0xDA4DFBC1, 0xDA4EFBC1, 0xDA4FFBC1, 0xDA50FBC1, 0xDA51FBC1, 0xDA52FBC1, 0xDA53FBC1, 0xDA54FBC1, 0xDA55FBC1, 0xDA56FBC1, 0xDA57FBC1, 0xDA58FBC1, 0xDA59FBC1, 0xDA5AFBC1, 0xDA5BFBC1, 0xDA5CFBC1, 0xDA5DFBC1, 0xDA5EFBC1, 0xDA5FFBC1,
+		0xDA60FBC1, 0xDA61FBC1, 0xDA62FBC1, 0xDA63FBC1, 0xDA64FBC1, 0xDA65FBC1, 0xDA66FBC1, 0xDA67FBC1, 0xDA68FBC1, 0xDA69FBC1, 0xDA6AFBC1, 0xDA6BFBC1, 0xDA6CFBC1, 0xDA6DFBC1, 0xDA6EFBC1, 0xDA6FFBC1, 0xDA70FBC1, 0xDA71FBC1, 0xDA72FBC1, 0xDA73FBC1, 0xDA74FBC1, 0xDA75FBC1, 0xDA76FBC1, 0xDA77FBC1, 0xDA78FBC1, 0xDA79FBC1, 0xDA7AFBC1, 0xDA7BFBC1, 0xDA7CFBC1, 0xDA7DFBC1, 0xDA7EFBC1, 0xDA7FFBC1,
+		0xDA80FBC1, 0xDA81FBC1, 0xDA82FBC1, 0xDA83FBC1, 0xDA84FBC1, 0xDA85FBC1, 0xDA86FBC1, 0xDA87FBC1, 0xDA88FBC1, 0xDA89FBC1, 0xDA8AFBC1, 0xDA8BFBC1, 0xDA8CFBC1, 0xDA8DFBC1, 0xDA8EFBC1, 0xDA8FFBC1, 0xDA90FBC1, 0xDA91FBC1, 0xDA92FBC1, 0xDA93FBC1, 0xDA94FBC1, 0xDA95FBC1, 0xDA96FBC1, 0xDA97FBC1, 0xDA98FBC1, 0xDA99FBC1, 0xDA9AFBC1, 0xDA9BFBC1, 0xDA9CFBC1, 0xDA9DFBC1, 0xDA9EFBC1, 0xDA9FFBC1,
+		0xDAA0FBC1, 0xDAA1FBC1, 0xDAA2FBC1, 0xDAA3FBC1, 0xDAA4FBC1, 0xDAA5FBC1, 0xDAA6FBC1, 0xDAA7FBC1, 0xDAA8FBC1, 0xDAA9FBC1, 0xDAAAFBC1, 0xDAABFBC1, 0xDAACFBC1, 0xDAADFBC1, 0xDAAEFBC1, 0xDAAFFBC1, 0xDAB0FBC1, 0xDAB1FBC1, 0xDAB2FBC1, 0xDAB3FBC1, 0xDAB4FBC1, 0xDAB5FBC1, 0xDAB6FBC1, 0xDAB7FBC1, 0xDAB8FBC1, 0xDAB9FBC1, 0xDABAFBC1, 0xDABBFBC1, 0xDABCFBC1, 0xDABDFBC1, 0xDABEFBC1, 0xDABFFBC1,
+		0xDAC0FBC1, 0xDAC1FBC1, 0xDAC2FBC1, 0xDAC3FBC1, 0xDAC4FBC1, 0xDAC5FBC1, 0xDAC6FBC1, 0xDAC7FBC1, 0xDAC8FBC1, 0xDAC9FBC1, 0xDACAFBC1, 0xDACBFBC1, 0xDACCFBC1, 0xDACDFBC1, 0xDACEFBC1, 0xDACFFBC1, 0xDAD0FBC1, 0xDAD1FBC1, 0xDAD2FBC1, 0xDAD3FBC1, 0xDAD4FBC1, 0xDAD5FBC1, 0xDAD6FBC1, 0xDAD7FBC1, 0xDAD8FBC1, 0xDAD9FBC1, 0xDADAFBC1, 0xDADBFBC1, 0xDADCFBC1, 0xDADDFBC1, 0xDADEFBC1, 0xDADFFBC1,
+		0xDAE0FBC1, 0xDAE1FBC1, 0xDAE2FBC1, 0xDAE3FBC1, 0xDAE4FBC1, 0xDAE5FBC1, 0xDAE6FBC1, 0xDAE7FBC1, 0xDAE8FBC1, 0xDAE9FBC1, 0xDAEAFBC1, 0xDAEBFBC1, 0xDAECFBC1, 0xDAEDFBC1, 0xDAEEFBC1, 0xDAEFFBC1, 0xDAF0FBC1, 0xDAF1FBC1, 0xDAF2FBC1, 0xDAF3FBC1, 0xDAF4FBC1, 0xDAF5FBC1, 0xDAF6FBC1, 0xDAF7FBC1, 0xDAF8FBC1, 0xDAF9FBC1, 0xDAFAFBC1, 0xDAFBFBC1, 0xDAFCFBC1, 0xDAFDFBC1, 0xDAFEFBC1, 0xDAFFFBC1,
+		0xDB00FBC1, 0xDB01FBC1, 0xDB02FBC1, 0xDB03FBC1, 0xDB04FBC1, 0xDB05FBC1, 0xDB06FBC1, 0xDB07FBC1, 0xDB08FBC1, 0xDB09FBC1, 0xDB0AFBC1, 0xDB0BFBC1, 0xDB0CFBC1, 0xDB0DFBC1, 0xDB0EFBC1, 0xDB0FFBC1, 0xDB10FBC1, 0xDB11FBC1, 0xDB12FBC1, 0xDB13FBC1, 0xDB14FBC1, 0xDB15FBC1, 0xDB16FBC1, 0xDB17FBC1, 0xDB18FBC1, 0xDB19FBC1, 0xDB1AFBC1, 0xDB1BFBC1, 0xDB1CFBC1, 0xDB1DFBC1, 0xDB1EFBC1, 0xDB1FFBC1,
+		0xDB20FBC1, 0xDB21FBC1, 0xDB22FBC1, 0xDB23FBC1, 0xDB24FBC1, 0xDB25FBC1, 0xDB26FBC1, 0xDB27FBC1, 0xDB28FBC1, 0xDB29FBC1, 0xDB2AFBC1, 0xDB2BFBC1, 0xDB2CFBC1, 0xDB2DFBC1, 0xDB2EFBC1, 0xDB2FFBC1, 0xDB30FBC1, 0xDB31FBC1, 0xDB32FBC1, 0xDB33FBC1, 0xDB34FBC1, 0xDB35FBC1, 0xDB36FBC1, 0xDB37FBC1, 0xDB38FBC1, 0xDB39FBC1, 0xDB3AFBC1, 0xDB3BFBC1, 0xDB3CFBC1, 0xDB3DFBC1, 0xDB3EFBC1, 0xDB3FFBC1,
+		0xDB40FBC1, 0xDB41FBC1, 0xDB42FBC1, 0xDB43FBC1, 0xDB44FBC1, 0xDB45FBC1, 0xDB46FBC1, 0xDB47FBC1, 0xDB48FBC1, 0xDB49FBC1, 0xDB4AFBC1, 0xDB4BFBC1, 0xDB4CFBC1, 0xDB4DFBC1, 0xDB4EFBC1, 0xDB4FFBC1, 0xDB50FBC1, 0xDB51FBC1, 0xDB52FBC1, 0xDB53FBC1, 0xDB54FBC1, 0xDB55FBC1, 0xDB56FBC1, 0xDB57FBC1, 0xDB58FBC1, 0xDB59FBC1, 0xDB5AFBC1, 0xDB5BFBC1, 0xDB5CFBC1, 0xDB5DFBC1, 0xDB5EFBC1, 0xDB5FFBC1,
+		0xDB60FBC1, 0xDB61FBC1, 0xDB62FBC1, 0xDB63FBC1, 0xDB64FBC1, 0xDB65FBC1, 0xDB66FBC1, 0xDB67FBC1, 0xDB68FBC1, 0xDB69FBC1, 0xDB6AFBC1, 0xDB6BFBC1, 0xDB6CFBC1, 0xDB6DFBC1, 0xDB6EFBC1, 0xDB6FFBC1, 0xDB70FBC1, 0xDB71FBC1, 0xDB72FBC1, 0xDB73FBC1, 0xDB74FBC1, 0xDB75FBC1, 0xDB76FBC1, 0xDB77FBC1, 0xDB78FBC1, 0xDB79FBC1, 0xDB7AFBC1, 0xDB7BFBC1, 0xDB7CFBC1, 0xDB7DFBC1, 0xDB7EFBC1, 0xDB7FFBC1,
+		0xDB80FBC1, 0xDB81FBC1, 0xDB82FBC1, 0xDB83FBC1, 0xDB84FBC1, 0xDB85FBC1, 0xDB86FBC1, 0xDB87FBC1, 0xDB88FBC1, 0xDB89FBC1, 0xDB8AFBC1, 0xDB8BFBC1, 0xDB8CFBC1, 0xDB8DFBC1, 0xDB8EFBC1, 0xDB8FFBC1, 0xDB90FBC1, 0xDB91FBC1, 0xDB92FBC1, 0xDB93FBC1, 0xDB94FBC1, 0xDB95FBC1, 0xDB96FBC1, 0xDB97FBC1, 0xDB98FBC1, 0xDB99FBC1, 0xDB9AFBC1, 0xDB9BFBC1, 0xDB9CFBC1, 0xDB9DFBC1, 0xDB9EFBC1, 0xDB9FFBC1,
+		0xDBA0FBC1, 0xDBA1FBC1, 0xDBA2FBC1, 0xDBA3FBC1, 0xDBA4FBC1, 0xDBA5FBC1, 0xDBA6FBC1, 0xDBA7FBC1, 0xDBA8FBC1, 0xDBA9FBC1, 0xDBAAFBC1, 0xDBABFBC1, 0xDBACFBC1, 0xDBADFBC1, 0xDBAEFBC1, 0xDBAFFBC1, 0xDBB0FBC1, 0xDBB1FBC1, 0xDBB2FBC1, 0xDBB3FBC1, 0xDBB4FBC1, 0xDBB5FBC1, 0xDBB6FBC1, 0xDBB7FBC1, 0xDBB8FBC1, 0xDBB9FBC1, 0xDBBAFBC1, 0xDBBBFBC1, 0xDBBCFBC1, 0xDBBDFBC1, 0xDBBEFBC1, 0xDBBFFBC1,
+		0xDBC0FBC1, 0xDBC1FBC1, 0xDBC2FBC1, 0xDBC3FBC1, 0xDBC4FBC1, 0xDBC5FBC1, 0xDBC6FBC1, 0xDBC7FBC1, 0xDBC8FBC1, 0xDBC9FBC1, 0xDBCAFBC1, 0xDBCBFBC1, 0xDBCCFBC1, 0xDBCDFBC1, 0xDBCEFBC1, 0xDBCFFBC1, 0xDBD0FBC1, 0xDBD1FBC1, 0xDBD2FBC1, 0xDBD3FBC1, 0xDBD4FBC1, 0xDBD5FBC1, 0xDBD6FBC1, 0xDBD7FBC1, 0xDBD8FBC1, 0xDBD9FBC1, 0xDBDAFBC1, 0xDBDBFBC1, 0xDBDCFBC1, 0xDBDDFBC1, 0xDBDEFBC1, 0xDBDFFBC1,
+		0xDBE0FBC1, 0xDBE1FBC1, 0xDBE2FBC1, 0xDBE3FBC1, 0xDBE4FBC1, 0xDBE5FBC1, 0xDBE6FBC1, 0xDBE7FBC1, 0xDBE8FBC1, 0xDBE9FBC1, 0xDBEAFBC1, 0xDBEBFBC1, 0xDBECFBC1, 0xDBEDFBC1, 0xDBEEFBC1, 0xDBEFFBC1, 0xDBF0FBC1, 0xDBF1FBC1, 0xDBF2FBC1, 0xDBF3FBC1, 0xDBF4FBC1, 0xDBF5FBC1, 0xDBF6FBC1, 0xDBF7FBC1, 0xDBF8FBC1, 0xDBF9FBC1, 0xDBFAFBC1, 0xDBFBFBC1, 0xDBFCFBC1, 0xDBFDFBC1, 0xDBFEFBC1, 0xDBFFFBC1,
+		0xDC00FBC1, 0xDC01FBC1, 0xDC02FBC1, 0xDC03FBC1, 0xDC04FBC1, 0xDC05FBC1, 0xDC06FBC1, 0xDC07FBC1, 0xDC08FBC1, 0xDC09FBC1, 0xDC0AFBC1, 0xDC0BFBC1, 0xDC0CFBC1, 0xDC0DFBC1, 0xDC0EFBC1, 0xDC0FFBC1, 0xDC10FBC1, 0xDC11FBC1, 0xDC12FBC1, 0xDC13FBC1, 0xDC14FBC1, 0xDC15FBC1, 0xDC16FBC1, 0xDC17FBC1, 0xDC18FBC1, 0xDC19FBC1, 0xDC1AFBC1, 0xDC1BFBC1, 0xDC1CFBC1, 0xDC1DFBC1, 0xDC1EFBC1, 0xDC1FFBC1,
+		0xDC20FBC1, 0xDC21FBC1, 0xDC22FBC1, 0xDC23FBC1, 0xDC24FBC1, 0xDC25FBC1, 0xDC26FBC1, 0xDC27FBC1, 0xDC28FBC1, 0xDC29FBC1, 0xDC2AFBC1, 0xDC2BFBC1, 0xDC2CFBC1, 0xDC2DFBC1, 0xDC2EFBC1, 0xDC2FFBC1, 0xDC30FBC1, 0xDC31FBC1, 0xDC32FBC1, 0xDC33FBC1, 0xDC34FBC1, 0xDC35FBC1, 0xDC36FBC1, 0xDC37FBC1, 0xDC38FBC1, 0xDC39FBC1, 0xDC3AFBC1, 0xDC3BFBC1, 0xDC3CFBC1, 0xDC3DFBC1, 0xDC3EFBC1, 0xDC3FFBC1,
+		0xDC40FBC1, 0xDC41FBC1, 0xDC42FBC1, 0xDC43FBC1, 0xDC44FBC1, 0xDC45FBC1, 0xDC46FBC1, 0xDC47FBC1, 0xDC48FBC1, 0xDC49FBC1, 0xDC4AFBC1, 0xDC4BFBC1, 0xDC4CFBC1, 0xDC4DFBC1, 0xDC4EFBC1, 0xDC4FFBC1, 0xDC50FBC1, 0xDC51FBC1, 0xDC52FBC1, 0xDC53FBC1, 0xDC54FBC1, 0xDC55FBC1, 0xDC56FBC1, 0xDC57FBC1, 0xDC58FBC1, 0xDC59FBC1, 0xDC5AFBC1, 0xDC5BFBC1, 0xDC5CFBC1, 0xDC5DFBC1, 0xDC5EFBC1, 0xDC5FFBC1,
+		0xDC60FBC1, 0xDC61FBC1, 0xDC62FBC1, 0xDC63FBC1, 0xDC64FBC1, 0xDC65FBC1, 0xDC66FBC1, 0xDC67FBC1, 0xDC68FBC1, 0xDC69FBC1, 0xDC6AFBC1, 0xDC6BFBC1, 0xDC6CFBC1, 0xDC6DFBC1, 0xDC6EFBC1, 0xDC6FFBC1, 0xDC70FBC1, 0xDC71FBC1, 0xDC72FBC1, 0xDC73FBC1, 0xDC74FBC1, 0xDC75FBC1, 0xDC76FBC1, 0xDC77FBC1, 0xDC78FBC1, 0xDC79FBC1, 0xDC7AFBC1, 0xDC7BFBC1, 0xDC7CFBC1, 0xDC7DFBC1, 0xDC7EFBC1, 0xDC7FFBC1,
+		0xDC80FBC1, 0xDC81FBC1, 0xDC82FBC1, 0xDC83FBC1, 0xDC84FBC1, 0xDC85FBC1, 0xDC86FBC1, 0xDC87FBC1, 0xDC88FBC1, 0xDC89FBC1, 0xDC8AFBC1, 0xDC8BFBC1, 0xDC8CFBC1, 0xDC8DFBC1, 0xDC8EFBC1, 0xDC8FFBC1, 0xDC90FBC1, 0xDC91FBC1, 0xDC92FBC1, 0xDC93FBC1, 0xDC94FBC1, 0xDC95FBC1, 0xDC96FBC1, 0xDC97FBC1, 0xDC98FBC1, 0xDC99FBC1, 0xDC9AFBC1, 0xDC9BFBC1, 0xDC9CFBC1, 0xDC9DFBC1, 0xDC9EFBC1, 0xDC9FFBC1,
+		0xDCA0FBC1, 0xDCA1FBC1, 0xDCA2FBC1, 0xDCA3FBC1, 0xDCA4FBC1, 0xDCA5FBC1, 0xDCA6FBC1, 0xDCA7FBC1, 0xDCA8FBC1, 0xDCA9FBC1, 0xDCAAFBC1, 0xDCABFBC1, 0xDCACFBC1, 0xDCADFBC1, 0xDCAEFBC1, 0xDCAFFBC1, 0xDCB0FBC1, 0xDCB1FBC1, 0xDCB2FBC1, 0xDCB3FBC1, 0xDCB4FBC1, 0xDCB5FBC1, 0xDCB6FBC1, 0xDCB7FBC1, 0xDCB8FBC1, 0xDCB9FBC1, 0xDCBAFBC1, 0xDCBBFBC1, 0xDCBCFBC1, 0xDCBDFBC1, 0xDCBEFBC1, 0xDCBFFBC1,
+		0xDCC0FBC1, 0xDCC1FBC1, 0xDCC2FBC1, 0xDCC3FBC1, 0xDCC4FBC1, 0xDCC5FBC1, 0xDCC6FBC1, 0xDCC7FBC1, 0xDCC8FBC1, 0xDCC9FBC1, 0xDCCAFBC1, 0xDCCBFBC1, 0xDCCCFBC1, 0xDCCDFBC1, 0xDCCEFBC1, 0xDCCFFBC1, 0xDCD0FBC1, 0xDCD1FBC1, 0xDCD2FBC1, 0xDCD3FBC1, 0xDCD4FBC1, 0xDCD5FBC1, 0xDCD6FBC1, 0xDCD7FBC1, 0xDCD8FBC1, 0xDCD9FBC1, 0xDCDAFBC1, 0xDCDBFBC1, 0xDCDCFBC1, 0xDCDDFBC1, 0xDCDEFBC1, 0xDCDFFBC1,
+		0xDCE0FBC1, 0xDCE1FBC1, 0xDCE2FBC1, 0xDCE3FBC1, 0xDCE4FBC1, 0xDCE5FBC1, 0xDCE6FBC1, 0xDCE7FBC1, 0xDCE8FBC1, 0xDCE9FBC1, 0xDCEAFBC1, 0xDCEBFBC1, 0xDCECFBC1, 0xDCEDFBC1, 0xDCEEFBC1, 0xDCEFFBC1, 0xDCF0FBC1, 0xDCF1FBC1, 0xDCF2FBC1, 0xDCF3FBC1, 0xDCF4FBC1, 0xDCF5FBC1, 0xDCF6FBC1, 0xDCF7FBC1, 0xDCF8FBC1, 0xDCF9FBC1, 0xDCFAFBC1, 0xDCFBFBC1, 0xDCFCFBC1, 0xDCFDFBC1, 0xDCFEFBC1, 0xDCFFFBC1,
+		0xDD00FBC1, 0xDD01FBC1, 0xDD02FBC1, 0xDD03FBC1, 0xDD04FBC1, 0xDD05FBC1, 0xDD06FBC1, 0xDD07FBC1, 0xDD08FBC1, 0xDD09FBC1, 0xDD0AFBC1, 0xDD0BFBC1, 0xDD0CFBC1, 0xDD0DFBC1, 0xDD0EFBC1, 0xDD0FFBC1, 0xDD10FBC1, 0xDD11FBC1, 0xDD12FBC1, 0xDD13FBC1, 0xDD14FBC1, 0xDD15FBC1, 0xDD16FBC1, 0xDD17FBC1, 0xDD18FBC1, 0xDD19FBC1, 0xDD1AFBC1, 0xDD1BFBC1, 0xDD1CFBC1, 0xDD1DFBC1, 0xDD1EFBC1, 0xDD1FFBC1,
+		0xDD20FBC1, 0xDD21FBC1, 0xDD22FBC1, 0xDD23FBC1, 0xDD24FBC1, 0xDD25FBC1, 0xDD26FBC1, 0xDD27FBC1, 0xDD28FBC1, 0xDD29FBC1, 0xDD2AFBC1, 0xDD2BFBC1, 0xDD2CFBC1, 0xDD2DFBC1, 0xDD2EFBC1, 0xDD2FFBC1, 0xDD30FBC1, 0xDD31FBC1, 0xDD32FBC1, 0xDD33FBC1, 0xDD34FBC1, 0xDD35FBC1, 0xDD36FBC1, 0xDD37FBC1, 0xDD38FBC1, 0xDD39FBC1, 0xDD3AFBC1, 0xDD3BFBC1, 0xDD3CFBC1, 0xDD3DFBC1, 0xDD3EFBC1, 0xDD3FFBC1,
+		0xDD40FBC1, 0xDD41FBC1, 0xDD42FBC1, 0xDD43FBC1, 0xDD44FBC1, 0xDD45FBC1, 0xDD46FBC1, 0xDD47FBC1, 0xDD48FBC1, 0xDD49FBC1, 0xDD4AFBC1, 0xDD4BFBC1, 0xDD4CFBC1, 0xDD4DFBC1, 0xDD4EFBC1, 0xDD4FFBC1, 0xDD50FBC1, 0xDD51FBC1, 0xDD52FBC1, 0xDD53FBC1, 0xDD54FBC1, 0xDD55FBC1, 0xDD56FBC1, 0xDD57FBC1, 0xDD58FBC1, 0xDD59FBC1, 0xDD5AFBC1, 0xDD5BFBC1, 0xDD5CFBC1, 0xDD5DFBC1, 0xDD5EFBC1, 0xDD5FFBC1,
+		0xDD60FBC1, 0xDD61FBC1, 0xDD62FBC1, 0xDD63FBC1, 0xDD64FBC1, 0xDD65FBC1, 0xDD66FBC1, 0xDD67FBC1, 0xDD68FBC1, 0xDD69FBC1, 0xDD6AFBC1, 0xDD6BFBC1, 0xDD6CFBC1, 0xDD6DFBC1, 0xDD6EFBC1, 0xDD6FFBC1, 0xDD70FBC1, 0xDD71FBC1, 0xDD72FBC1, 0xDD73FBC1, 0xDD74FBC1, 0xDD75FBC1, 0xDD76FBC1, 0xDD77FBC1, 0xDD78FBC1, 0xDD79FBC1, 0xDD7AFBC1, 0xDD7BFBC1, 0xDD7CFBC1, 0xDD7DFBC1, 0xDD7EFBC1, 0xDD7FFBC1,
+		0xDD80FBC1, 0xDD81FBC1, 0xDD82FBC1, 0xDD83FBC1, 0xDD84FBC1, 0xDD85FBC1, 0xDD86FBC1, 0xDD87FBC1, 0xDD88FBC1, 0xDD89FBC1, 0xDD8AFBC1, 0xDD8BFBC1, 0xDD8CFBC1, 0xDD8DFBC1, 0xDD8EFBC1, 0xDD8FFBC1, 0xDD90FBC1, 0xDD91FBC1, 0xDD92FBC1, 0xDD93FBC1, 0xDD94FBC1, 0xDD95FBC1, 0xDD96FBC1, 0xDD97FBC1, 0xDD98FBC1, 0xDD99FBC1, 0xDD9AFBC1, 0xDD9BFBC1, 0xDD9CFBC1, 0xDD9DFBC1, 0xDD9EFBC1, 0xDD9FFBC1,
+		0xDDA0FBC1, 0xDDA1FBC1, 0xDDA2FBC1, 0xDDA3FBC1, 0xDDA4FBC1, 0xDDA5FBC1, 0xDDA6FBC1, 0xDDA7FBC1, 0xDDA8FBC1, 0xDDA9FBC1, 0xDDAAFBC1, 0xDDABFBC1, 0xDDACFBC1, 0xDDADFBC1, 0xDDAEFBC1, 0xDDAFFBC1, 0xDDB0FBC1, 0xDDB1FBC1, 0xDDB2FBC1, 0xDDB3FBC1, 0xDDB4FBC1, 0xDDB5FBC1, 0xDDB6FBC1, 0xDDB7FBC1, 0xDDB8FBC1, 0xDDB9FBC1, 0xDDBAFBC1, 0xDDBBFBC1, 0xDDBCFBC1, 0xDDBDFBC1, 0xDDBEFBC1, 0xDDBFFBC1,
+		0xDDC0FBC1, 0xDDC1FBC1, 0xDDC2FBC1, 0xDDC3FBC1, 0xDDC4FBC1, 0xDDC5FBC1, 0xDDC6FBC1, 0xDDC7FBC1, 0xDDC8FBC1, 0xDDC9FBC1, 0xDDCAFBC1, 0xDDCBFBC1, 0xDDCCFBC1, 0xDDCDFBC1, 0xDDCEFBC1, 0xDDCFFBC1, 0xDDD0FBC1, 0xDDD1FBC1, 0xDDD2FBC1, 0xDDD3FBC1, 0xDDD4FBC1, 0xDDD5FBC1, 0xDDD6FBC1, 0xDDD7FBC1, 0xDDD8FBC1, 0xDDD9FBC1, 0xDDDAFBC1, 0xDDDBFBC1, 0xDDDCFBC1, 0xDDDDFBC1, 0xDDDEFBC1, 0xDDDFFBC1,
+		0xDDE0FBC1, 0xDDE1FBC1, 0xDDE2FBC1, 0xDDE3FBC1, 0xDDE4FBC1, 0xDDE5FBC1, 0xDDE6FBC1, 0xDDE7FBC1, 0xDDE8FBC1, 0xDDE9FBC1, 0xDDEAFBC1, 0xDDEBFBC1, 0xDDECFBC1, 0xDDEDFBC1, 0xDDEEFBC1, 0xDDEFFBC1, 0xDDF0FBC1, 0xDDF1FBC1, 0xDDF2FBC1, 0xDDF3FBC1, 0xDDF4FBC1, 0xDDF5FBC1, 0xDDF6FBC1, 0xDDF7FBC1, 0xDDF8FBC1, 0xDDF9FBC1, 0xDDFAFBC1, 0xDDFBFBC1, 0xDDFCFBC1, 0xDDFDFBC1, 0xDDFEFBC1, 0xDDFFFBC1,
+		0xDE00FBC1, 0xDE01FBC1, 0xDE02FBC1, 0xDE03FBC1, 0xDE04FBC1, 0xDE05FBC1, 0xDE06FBC1, 0xDE07FBC1, 0xDE08FBC1, 0xDE09FBC1, 0xDE0AFBC1, 0xDE0BFBC1, 0xDE0CFBC1, 0xDE0DFBC1, 0xDE0EFBC1, 0xDE0FFBC1, 0xDE10FBC1, 0xDE11FBC1, 0xDE12FBC1, 0xDE13FBC1, 0xDE14FBC1, 0xDE15FBC1, 0xDE16FBC1, 0xDE17FBC1, 0xDE18FBC1, 0xDE19FBC1, 0xDE1AFBC1, 0xDE1BFBC1, 0xDE1CFBC1, 0xDE1DFBC1, 0xDE1EFBC1, 0xDE1FFBC1,
+		0xDE20FBC1, 0xDE21FBC1, 0xDE22FBC1, 0xDE23FBC1, 0xDE24FBC1, 0xDE25FBC1, 0xDE26FBC1, 0xDE27FBC1, 0xDE28FBC1, 0xDE29FBC1, 0xDE2AFBC1, 0xDE2BFBC1, 0xDE2CFBC1, 0xDE2DFBC1, 0xDE2EFBC1, 0xDE2FFBC1, 0xDE30FBC1, 0xDE31FBC1, 0xDE32FBC1, 0xDE33FBC1, 0xDE34FBC1, 0xDE35FBC1, 0xDE36FBC1, 0xDE37FBC1, 0xDE38FBC1, 0xDE39FBC1, 0xDE3AFBC1, 0xDE3BFBC1, 0xDE3CFBC1, 0xDE3DFBC1, 0xDE3EFBC1, 0xDE3FFBC1,
+		0xDE40FBC1, 0xDE41FBC1, 0xDE42FBC1, 0xDE43FBC1, 0xDE44FBC1, 0xDE45FBC1, 0xDE46FBC1, 0xDE47FBC1, 0xDE48FBC1, 0xDE49FBC1, 0xDE4AFBC1, 0xDE4BFBC1, 0xDE4CFBC1, 0xDE4DFBC1, 0xDE4EFBC1, 0xDE4FFBC1, 0xDE50FBC1, 0xDE51FBC1, 0xDE52FBC1, 0xDE53FBC1, 0xDE54FBC1, 0xDE55FBC1, 0xDE56FBC1, 0xDE57FBC1, 0xDE58FBC1, 0xDE59FBC1, 0xDE5AFBC1, 0xDE5BFBC1, 0xDE5CFBC1, 0xDE5DFBC1, 0xDE5EFBC1, 0xDE5FFBC1,
+		0xDE60FBC1, 0xDE61FBC1, 0xDE62FBC1, 0xDE63FBC1, 0xDE64FBC1, 0xDE65FBC1, 0xDE66FBC1, 0xDE67FBC1, 0xDE68FBC1, 0xDE69FBC1, 0xDE6AFBC1, 0xDE6BFBC1, 0xDE6CFBC1, 0xDE6DFBC1, 0xDE6EFBC1, 0xDE6FFBC1, 0xDE70FBC1, 0xDE71FBC1, 0xDE72FBC1, 0xDE73FBC1, 0xDE74FBC1, 0xDE75FBC1, 0xDE76FBC1, 0xDE77FBC1, 0xDE78FBC1, 0xDE79FBC1, 0xDE7AFBC1, 0xDE7BFBC1, 0xDE7CFBC1, 0xDE7DFBC1, 0xDE7EFBC1, 0xDE7FFBC1,
+		0xDE80FBC1, 0xDE81FBC1, 0xDE82FBC1, 0xDE83FBC1, 0xDE84FBC1, 0xDE85FBC1, 0xDE86FBC1, 0xDE87FBC1, 0xDE88FBC1, 0xDE89FBC1, 0xDE8AFBC1, 0xDE8BFBC1, 0xDE8CFBC1, 0xDE8DFBC1, 0xDE8EFBC1, 0xDE8FFBC1, 0xDE90FBC1, 0xDE91FBC1, 0xDE92FBC1, 0xDE93FBC1, 0xDE94FBC1, 0xDE95FBC1, 0xDE96FBC1, 0xDE97FBC1, 0xDE98FBC1, 0xDE99FBC1, 0xDE9AFBC1, 0xDE9BFBC1, 0xDE9CFBC1, 0xDE9DFBC1, 0xDE9EFBC1, 0xDE9FFBC1,
+		0xDEA0FBC1, 0xDEA1FBC1, 0xDEA2FBC1, 0xDEA3FBC1, 0xDEA4FBC1, 0xDEA5FBC1, 0xDEA6FBC1, 0xDEA7FBC1, 0xDEA8FBC1, 0xDEA9FBC1, 0xDEAAFBC1, 0xDEABFBC1, 0xDEACFBC1, 0xDEADFBC1, 0xDEAEFBC1, 0xDEAFFBC1, 0xDEB0FBC1, 0xDEB1FBC1, 0xDEB2FBC1, 0xDEB3FBC1, 0xDEB4FBC1, 0xDEB5FBC1, 0xDEB6FBC1, 0xDEB7FBC1, 0xDEB8FBC1, 0xDEB9FBC1, 0xDEBAFBC1, 0xDEBBFBC1, 0xDEBCFBC1, 0xDEBDFBC1, 0xDEBEFBC1, 0xDEBFFBC1,
+		0xDEC0FBC1, 0xDEC1FBC1, 0xDEC2FBC1, 0xDEC3FBC1, 0xDEC4FBC1, 0xDEC5FBC1, 0xDEC6FBC1, 0xDEC7FBC1, 0xDEC8FBC1, 0xDEC9FBC1, 0xDECAFBC1, 0xDECBFBC1, 0xDECCFBC1, 0xDECDFBC1, 0xDECEFBC1, 0xDECFFBC1, 0xDED0FBC1, 0xDED1FBC1, 0xDED2FBC1, 0xDED3FBC1, 0xDED4FBC1, 0xDED5FBC1, 0xDED6FBC1, 0xDED7FBC1, 0xDED8FBC1, 0xDED9FBC1, 0xDEDAFBC1, 0xDEDBFBC1, 0xDEDCFBC1, 0xDEDDFBC1, 0xDEDEFBC1, 0xDEDFFBC1,
+		0xDEE0FBC1, 0xDEE1FBC1, 0xDEE2FBC1, 0xDEE3FBC1, 0xDEE4FBC1, 0xDEE5FBC1, 0xDEE6FBC1, 0xDEE7FBC1, 0xDEE8FBC1, 0xDEE9FBC1, 0xDEEAFBC1, 0xDEEBFBC1, 0xDEECFBC1, 0xDEEDFBC1, 0xDEEEFBC1, 0xDEEFFBC1, 0xDEF0FBC1, 0xDEF1FBC1, 0xDEF2FBC1, 0xDEF3FBC1, 0xDEF4FBC1, 0xDEF5FBC1, 0xDEF6FBC1, 0xDEF7FBC1, 0xDEF8FBC1, 0xDEF9FBC1, 0xDEFAFBC1, 0xDEFBFBC1, 0xDEFCFBC1, 0xDEFDFBC1, 0xDEFEFBC1, 0xDEFFFBC1,
+		0xDF00FBC1, 0xDF01FBC1, 0xDF02FBC1, 0xDF03FBC1, 0xDF04FBC1, 0xDF05FBC1, 0xDF06FBC1, 0xDF07FBC1, 0xDF08FBC1, 0xDF09FBC1, 0xDF0AFBC1, 0xDF0BFBC1, 0xDF0CFBC1, 0xDF0DFBC1, 0xDF0EFBC1, 0xDF0FFBC1, 0xDF10FBC1, 0xDF11FBC1, 0xDF12FBC1, 0xDF13FBC1, 0xDF14FBC1, 0xDF15FBC1, 0xDF16FBC1, 0xDF17FBC1, 0xDF18FBC1, 0xDF19FBC1, 0xDF1AFBC1, 0xDF1BFBC1, 0xDF1CFBC1, 0xDF1DFBC1, 0xDF1EFBC1, 0xDF1FFBC1,
+		0xDF20FBC1, 0xDF21FBC1, 0xDF22FBC1, 0xDF23FBC1, 0xDF24FBC1, 0xDF25FBC1, 0xDF26FBC1, 0xDF27FBC1, 0xDF28FBC1, 0xDF29FBC1, 0xDF2AFBC1, 0xDF2BFBC1, 0xDF2CFBC1, 0xDF2DFBC1, 0xDF2EFBC1, 0xDF2FFBC1, 0xDF30FBC1, 0xDF31FBC1, 0xDF32FBC1, 0xDF33FBC1, 0xDF34FBC1, 0xDF35FBC1, 0xDF36FBC1, 0xDF37FBC1, 0xDF38FBC1, 0xDF39FBC1, 0xDF3AFBC1, 0xDF3BFBC1, 0xDF3CFBC1, 0xDF3DFBC1, 0xDF3EFBC1, 0xDF3FFBC1,
+		0xDF40FBC1, 0xDF41FBC1, 0xDF42FBC1, 0xDF43FBC1, 0xDF44FBC1, 0xDF45FBC1, 0xDF46FBC1, 0xDF47FBC1, 0xDF48FBC1, 0xDF49FBC1, 0xDF4AFBC1, 0xDF4BFBC1, 0xDF4CFBC1, 0xDF4DFBC1, 0xDF4EFBC1, 0xDF4FFBC1, 0xDF50FBC1, 0xDF51FBC1, 0xDF52FBC1, 0xDF53FBC1, 0xDF54FBC1, 0xDF55FBC1, 0xDF56FBC1, 0xDF57FBC1, 0xDF58FBC1, 0xDF59FBC1, 0xDF5AFBC1, 0xDF5BFBC1, 0xDF5CFBC1, 0xDF5DFBC1, 0xDF5EFBC1, 0xDF5FFBC1,
+		0xDF60FBC1, 0xDF61FBC1, 0xDF62FBC1, 0xDF63FBC1, 0xDF64FBC1, 0xDF65FBC1, 0xDF66FBC1, 0xDF67FBC1, 0xDF68FBC1, 0xDF69FBC1, 0xDF6AFBC1, 0xDF6BFBC1, 0xDF6CFBC1, 0xDF6DFBC1, 0xDF6EFBC1, 0xDF6FFBC1, 0xDF70FBC1, 0xDF71FBC1, 0xDF72FBC1, 0xDF73FBC1, 0xDF74FBC1, 0xDF75FBC1, 0xDF76FBC1, 0xDF77FBC1, 0xDF78FBC1, 0xDF79FBC1, 0xDF7AFBC1, 0xDF7BFBC1, 0xDF7CFBC1, 0xDF7DFBC1, 0xDF7EFBC1, 0xDF7FFBC1,
+		0xDF80FBC1, 0xDF81FBC1, 0xDF82FBC1, 0xDF83FBC1, 0xDF84FBC1, 0xDF85FBC1, 0xDF86FBC1, 0xDF87FBC1, 0xDF88FBC1, 0xDF89FBC1, 0xDF8AFBC1, 0xDF8BFBC1, 0xDF8CFBC1, 0xDF8DFBC1, 0xDF8EFBC1, 0xDF8FFBC1, 0xDF90FBC1, 0xDF91FBC1, 0xDF92FBC1, 0xDF93FBC1, 0xDF94FBC1, 0xDF95FBC1, 0xDF96FBC1, 0xDF97FBC1, 0xDF98FBC1, 0xDF99FBC1, 0xDF9AFBC1, 0xDF9BFBC1, 0xDF9CFBC1, 0xDF9DFBC1, 0xDF9EFBC1, 0xDF9FFBC1,
+		0xDFA0FBC1, 0xDFA1FBC1, 0xDFA2FBC1, 0xDFA3FBC1, 0xDFA4FBC1, 0xDFA5FBC1, 0xDFA6FBC1, 0xDFA7FBC1, 0xDFA8FBC1, 0xDFA9FBC1, 0xDFAAFBC1, 0xDFABFBC1, 0xDFACFBC1, 0xDFADFBC1, 0xDFAEFBC1, 0xDFAFFBC1, 0xDFB0FBC1, 0xDFB1FBC1, 0xDFB2FBC1, 0xDFB3FBC1, 0xDFB4FBC1, 0xDFB5FBC1, 0xDFB6FBC1, 0xDFB7FBC1, 0xDFB8FBC1, 0xDFB9FBC1, 0xDFBAFBC1, 0xDFBBFBC1, 0xDFBCFBC1, 0xDFBDFBC1, 0xDFBEFBC1, 0xDFBFFBC1,
+		0xDFC0FBC1, 0xDFC1FBC1, 0xDFC2FBC1, 0xDFC3FBC1, 0xDFC4FBC1, 0xDFC5FBC1, 0xDFC6FBC1, 0xDFC7FBC1, 0xDFC8FBC1, 0xDFC9FBC1, 0xDFCAFBC1, 0xDFCBFBC1, 0xDFCCFBC1, 0xDFCDFBC1, 0xDFCEFBC1, 0xDFCFFBC1, 0xDFD0FBC1, 0xDFD1FBC1, 0xDFD2FBC1, 0xDFD3FBC1, 0xDFD4FBC1, 0xDFD5FBC1, 0xDFD6FBC1, 0xDFD7FBC1, 0xDFD8FBC1, 0xDFD9FBC1, 0xDFDAFBC1, 0xDFDBFBC1, 0xDFDCFBC1, 0xDFDDFBC1, 0xDFDEFBC1, 0xDFDFFBC1,
+		0xDFE0FBC1, 0xDFE1FBC1, 0xDFE2FBC1, 0xDFE3FBC1, 0xDFE4FBC1, 0xDFE5FBC1, 0xDFE6FBC1, 0xDFE7FBC1, 0xDFE8FBC1, 0xDFE9FBC1, 0xDFEAFBC1, 0xDFEBFBC1, 0xDFECFBC1, 0xDFEDFBC1, 0xDFEEFBC1, 0xDFEFFBC1, 0xDFF0FBC1, 0xDFF1FBC1, 0xDFF2FBC1, 0xDFF3FBC1, 0xDFF4FBC1, 0xDFF5FBC1, 0xDFF6FBC1, 0xDFF7FBC1, 0xDFF8FBC1, 0xDFF9FBC1, 0xDFFAFBC1, 0xDFFBFBC1, 0xDFFCFBC1, 0xDFFDFBC1, 0xDFFEFBC1, 0xDFFFFBC1,
+		0xE000FBC1, 0xE001FBC1, 0xE002FBC1, 0xE003FBC1, 0xE004FBC1, 0xE005FBC1, 0xE006FBC1, 0xE007FBC1, 0xE008FBC1, 0xE009FBC1, 0xE00AFBC1, 0xE00BFBC1, 0xE00CFBC1, 0xE00DFBC1, 0xE00EFBC1, 0xE00FFBC1, 0xE010FBC1, 0xE011FBC1, 0xE012FBC1, 0xE013FBC1, 0xE014FBC1, 0xE015FBC1, 0xE016FBC1, 0xE017FBC1, 0xE018FBC1, 0xE019FBC1, 0xE01AFBC1, 0xE01BFBC1, 0xE01CFBC1, 0xE01DFBC1, 0xE01EFBC1, 0xE01FFBC1,
+		0xE020FBC1, 0xE021FBC1, 0xE022FBC1, 0xE023FBC1, 0xE024FBC1, 0xE025FBC1, 0xE026FBC1, 0xE027FBC1, 0xE028FBC1, 0xE029FBC1, 0xE02AFBC1, 0xE02BFBC1, 0xE02CFBC1, 0xE02DFBC1, 0xE02EFBC1, 0xE02FFBC1, 0xE030FBC1, 0xE031FBC1, 0xE032FBC1, 0xE033FBC1, 0xE034FBC1, 0xE035FBC1, 0xE036FBC1, 0xE037FBC1, 0xE038FBC1, 0xE039FBC1, 0xE03AFBC1, 0xE03BFBC1, 0xE03CFBC1, 0xE03DFBC1, 0xE03EFBC1, 0xE03FFBC1,
+		0xE040FBC1, 0xE041FBC1, 0xE042FBC1, 0xE043FBC1, 0xE044FBC1, 0xE045FBC1, 0xE046FBC1, 0xE047FBC1, 0xE048FBC1, 0xE049FBC1, 0xE04AFBC1, 0xE04BFBC1, 0xE04CFBC1, 0xE04DFBC1, 0xE04EFBC1, 0xE04FFBC1, 0xE050FBC1, 0xE051FBC1, 0xE052FBC1, 0xE053FBC1, 0xE054FBC1, 0xE055FBC1, 0xE056FBC1, 0xE057FBC1, 0xE058FBC1, 0xE059FBC1, 0xE05AFBC1, 0xE05BFBC1, 0xE05CFBC1, 0xE05DFBC1, 0xE05EFBC1, 0xE05FFBC1,
+		0xE060FBC1, 0xE061FBC1, 0xE062FBC1, 0xE063FBC1, 0xE064FBC1, 0xE065FBC1, 0xE066FBC1, 0xE067FBC1, 0xE068FBC1, 0xE069FBC1, 0xE06AFBC1, 0xE06BFBC1, 0xE06CFBC1, 0xE06DFBC1, 0xE06EFBC1, 0xE06FFBC1, 0xE070FBC1, 0xE071FBC1, 0xE072FBC1, 0xE073FBC1, 0xE074FBC1, 0xE075FBC1, 0xE076FBC1, 0xE077FBC1, 0xE078FBC1, 0xE079FBC1, 0xE07AFBC1, 0xE07BFBC1, 0xE07CFBC1, 0xE07DFBC1, 0xE07EFBC1, 0xE07FFBC1,
+		0xE080FBC1, 0xE081FBC1, 0xE082FBC1, 0xE083FBC1, 0xE084FBC1, 0xE085FBC1, 0xE086FBC1, 0xE087FBC1, 0xE088FBC1, 0xE089FBC1, 0xE08AFBC1, 0xE08BFBC1, 0xE08CFBC1, 0xE08DFBC1, 0xE08EFBC1, 0xE08FFBC1, 0xE090FBC1, 0xE091FBC1, 0xE092FBC1, 0xE093FBC1, 0xE094FBC1, 0xE095FBC1, 0xE096FBC1, 0xE097FBC1, 0xE098FBC1, 0xE099FBC1, 0xE09AFBC1, 0xE09BFBC1, 0xE09CFBC1, 0xE09DFBC1, 0xE09EFBC1, 0xE09FFBC1,
+		0xE0A0FBC1, 0xE0A1FBC1, 0xE0A2FBC1, 0xE0A3FBC1, 0xE0A4FBC1, 0xE0A5FBC1, 0xE0A6FBC1, 0xE0A7FBC1, 0xE0A8FBC1, 0xE0A9FBC1, 0xE0AAFBC1, 0xE0ABFBC1, 0xE0ACFBC1, 0xE0ADFBC1, 0xE0AEFBC1, 0xE0AFFBC1, 0xE0B0FBC1, 0xE0B1FBC1, 0xE0B2FBC1, 0xE0B3FBC1, 0xE0B4FBC1, 0xE0B5FBC1, 0xE0B6FBC1, 0xE0B7FBC1, 0xE0B8FBC1, 0xE0B9FBC1, 0xE0BAFBC1, 0xE0BBFBC1, 0xE0BCFBC1, 0xE0BDFBC1, 0xE0BEFBC1, 0xE0BFFBC1,
+		0xE0C0FBC1, 0xE0C1FBC1, 0xE0C2FBC1, 0xE0C3FBC1, 0xE0C4FBC1, 0xE0C5FBC1, 0xE0C6FBC1, 0xE0C7FBC1, 0xE0C8FBC1, 0xE0C9FBC1, 0xE0CAFBC1, 0xE0CBFBC1, 0xE0CCFBC1, 0xE0CDFBC1, 0xE0CEFBC1, 0xE0CFFBC1, 0xE0D0FBC1, 0xE0D1FBC1, 0xE0D2FBC1, 0xE0D3FBC1, 0xE0D4FBC1, 0xE0D5FBC1, 0xE0D6FBC1, 0xE0D7FBC1, 0xE0D8FBC1, 0xE0D9FBC1, 0xE0DAFBC1, 0xE0DBFBC1, 0xE0DCFBC1, 0xE0DDFBC1, 0xE0DEFBC1, 0xE0DFFBC1,
+		0xE0E0FBC1, 0xE0E1FBC1, 0xE0E2FBC1, 0xE0E3FBC1, 0xE0E4FBC1, 0xE0E5FBC1, 0xE0E6FBC1, 0xE0E7FBC1, 0xE0E8FBC1, 0xE0E9FBC1, 0xE0EAFBC1, 0xE0EBFBC1, 0xE0ECFBC1, 0xE0EDFBC1, 0xE0EEFBC1, 0xE0EFFBC1, 0xE0F0FBC1, 0xE0F1FBC1, 0xE0F2FBC1, 0xE0F3FBC1, 0xE0F4FBC1, 0xE0F5FBC1, 0xE0F6FBC1, 0xE0F7FBC1, 0xE0F8FBC1, 0xE0F9FBC1, 0xE0FAFBC1, 0xE0FBFBC1, 0xE0FCFBC1, 0xE0FDFBC1, 0xE0FEFBC1, 0xE0FFFBC1,
+		0xE100FBC1, 0xE101FBC1, 0xE102FBC1, 0xE103FBC1, 0xE104FBC1, 0xE105FBC1, 0xE106FBC1, 0xE107FBC1, 0xE108FBC1, 0xE109FBC1, 0xE10AFBC1, 0xE10BFBC1, 0xE10CFBC1, 0xE10DFBC1, 0xE10EFBC1, 0xE10FFBC1, 0xE110FBC1, 0xE111FBC1, 0xE112FBC1, 0xE113FBC1, 0xE114FBC1, 0xE115FBC1, 0xE116FBC1, 0xE117FBC1, 0xE118FBC1, 0xE119FBC1, 0xE11AFBC1, 0xE11BFBC1, 0xE11CFBC1, 0xE11DFBC1, 0xE11EFBC1, 0xE11FFBC1,
+		0xE120FBC1, 0xE121FBC1, 0xE122FBC1, 0xE123FBC1, 0xE124FBC1, 0xE125FBC1, 0xE126FBC1, 0xE127FBC1, 0xE128FBC1, 0xE129FBC1, 0xE12AFBC1, 0xE12BFBC1, 0xE12CFBC1, 0xE12DFBC1, 0xE12EFBC1, 0xE12FFBC1, 0xE130FBC1, 0xE131FBC1, 0xE132FBC1, 0xE133FBC1, 0xE134FBC1, 0xE135FBC1, 0xE136FBC1, 0xE137FBC1, 0xE138FBC1, 0xE139FBC1, 0xE13AFBC1, 0xE13BFBC1, 0xE13CFBC1, 0xE13DFBC1, 0xE13EFBC1, 0xE13FFBC1,
+		0xE140FBC1, 0xE141FBC1, 0xE142FBC1, 0xE143FBC1, 0xE144FBC1, 0xE145FBC1, 0xE146FBC1, 0xE147FBC1, 0xE148FBC1, 0xE149FBC1, 0xE14AFBC1, 0xE14BFBC1, 0xE14CFBC1, 0xE14DFBC1, 0xE14EFBC1, 0xE14FFBC1, 0xE150FBC1, 0xE151FBC1, 0xE152FBC1, 0xE153FBC1, 0xE154FBC1, 0xE155FBC1, 0xE156FBC1, 0xE157FBC1, 0xE158FBC1, 0xE159FBC1, 0xE15AFBC1, 0xE15BFBC1, 0xE15CFBC1, 0xE15DFBC1, 0xE15EFBC1, 0xE15FFBC1,
+		0xE160FBC1, 0xE161FBC1, 0xE162FBC1, 0xE163FBC1, 0xE164FBC1, 0xE165FBC1, 0xE166FBC1, 0xE167FBC1, 0xE168FBC1, 0xE169FBC1, 0xE16AFBC1, 0xE16BFBC1, 0xE16CFBC1, 0xE16DFBC1, 0xE16EFBC1, 0xE16FFBC1, 0xE170FBC1, 0xE171FBC1, 0xE172FBC1, 0xE173FBC1, 0xE174FBC1, 0xE175FBC1, 0xE176FBC1, 0xE177FBC1, 0xE178FBC1, 0xE179FBC1, 0xE17AFBC1, 0xE17BFBC1, 0xE17CFBC1, 0xE17DFBC1, 0xE17EFBC1, 0xE17FFBC1,
+		0xE180FBC1, 0xE181FBC1, 0xE182FBC1, 0xE183FBC1, 0xE184FBC1, 0xE185FBC1, 0xE186FBC1, 0xE187FBC1, 0xE188FBC1, 0xE189FBC1, 0xE18AFBC1, 0xE18BFBC1, 0xE18CFBC1, 0xE18DFBC1, 0xE18EFBC1, 0xE18FFBC1, 0xE190FBC1, 0xE191FBC1, 0xE192FBC1, 0xE193FBC1, 0xE194FBC1, 0xE195FBC1, 0xE196FBC1, 0xE197FBC1, 0xE198FBC1, 0xE199FBC1, 0xE19AFBC1, 0xE19BFBC1, 0xE19CFBC1, 0xE19DFBC1, 0xE19EFBC1, 0xE19FFBC1,
+		0xE1A0FBC1, 0xE1A1FBC1, 0xE1A2FBC1, 0xE1A3FBC1, 0xE1A4FBC1, 0xE1A5FBC1, 0xE1A6FBC1, 0xE1A7FBC1, 0xE1A8FBC1, 0xE1A9FBC1, 0xE1AAFBC1, 0xE1ABFBC1, 0xE1ACFBC1, 0xE1ADFBC1, 0xE1AEFBC1, 0xE1AFFBC1, 0xE1B0FBC1, 0xE1B1FBC1, 0xE1B2FBC1, 0xE1B3FBC1, 0xE1B4FBC1, 0xE1B5FBC1, 0xE1B6FBC1, 0xE1B7FBC1, 0xE1B8FBC1, 0xE1B9FBC1, 0xE1BAFBC1, 0xE1BBFBC1, 0xE1BCFBC1, 0xE1BDFBC1, 0xE1BEFBC1, 0xE1BFFBC1,
+		0xE1C0FBC1, 0xE1C1FBC1, 0xE1C2FBC1, 0xE1C3FBC1, 0xE1C4FBC1, 0xE1C5FBC1, 0xE1C6FBC1, 0xE1C7FBC1, 0xE1C8FBC1, 0xE1C9FBC1, 0xE1CAFBC1, 0xE1CBFBC1, 0xE1CCFBC1, 0xE1CDFBC1, 0xE1CEFBC1, 0xE1CFFBC1, 0xE1D0FBC1, 0xE1D1FBC1, 0xE1D2FBC1, 0xE1D3FBC1, 0xE1D4FBC1, 0xE1D5FBC1, 0xE1D6FBC1, 0xE1D7FBC1, 0xE1D8FBC1, 0xE1D9FBC1, 0xE1DAFBC1, 0xE1DBFBC1, 0xE1DCFBC1, 0xE1DDFBC1, 0xE1DEFBC1, 0xE1DFFBC1,
+		0xE1E0FBC1, 0xE1E1FBC1, 0xE1E2FBC1, 0xE1E3FBC1, 0xE1E4FBC1, 0xE1E5FBC1, 0xE1E6FBC1, 0xE1E7FBC1, 0xE1E8FBC1, 0xE1E9FBC1, 0xE1EAFBC1, 0xE1EBFBC1, 0xE1ECFBC1, 0xE1EDFBC1, 0xE1EEFBC1, 0xE1EFFBC1, 0xE1F0FBC1, 0xE1F1FBC1, 0xE1F2FBC1, 0xE1F3FBC1, 0xE1F4FBC1, 0xE1F5FBC1, 0xE1F6FBC1, 0xE1F7FBC1, 0xE1F8FBC1, 0xE1F9FBC1, 0xE1FAFBC1, 0xE1FBFBC1, 0xE1FCFBC1, 0xE1FDFBC1, 0xE1FEFBC1, 0xE1FFFBC1,
+		0xE200FBC1, 0xE201FBC1, 0xE202FBC1, 0xE203FBC1, 0xE204FBC1, 0xE205FBC1, 0xE206FBC1, 0xE207FBC1, 0xE208FBC1, 0xE209FBC1, 0xE20AFBC1, 0xE20BFBC1, 0xE20CFBC1, 0xE20DFBC1, 0xE20EFBC1, 0xE20FFBC1, 0xE210FBC1, 0xE211FBC1, 0xE212FBC1, 0xE213FBC1, 0xE214FBC1, 0xE215FBC1, 0xE216FBC1, 0xE217FBC1, 0xE218FBC1, 0xE219FBC1, 0xE21AFBC1, 0xE21BFBC1, 0xE21CFBC1, 0xE21DFBC1, 0xE21EFBC1, 0xE21FFBC1,
+		0xE220FBC1, 0xE221FBC1, 0xE222FBC1, 0xE223FBC1, 0xE224FBC1, 0xE225FBC1, 0xE226FBC1, 0xE227FBC1, 0xE228FBC1, 0xE229FBC1, 0xE22AFBC1, 0xE22BFBC1, 0xE22CFBC1, 0xE22DFBC1, 0xE22EFBC1, 0xE22FFBC1, 0xE230FBC1, 0xE231FBC1, 0xE232FBC1, 0xE233FBC1, 0xE234FBC1, 0xE235FBC1, 0xE236FBC1, 0xE237FBC1, 0xE238FBC1, 0xE239FBC1, 0xE23AFBC1, 0xE23BFBC1, 0xE23CFBC1, 0xE23DFBC1, 0xE23EFBC1, 0xE23FFBC1,
+		0xE240FBC1, 0xE241FBC1, 0xE242FBC1, 0xE243FBC1, 0xE244FBC1, 0xE245FBC1, 0xE246FBC1, 0xE247FBC1, 0xE248FBC1, 0xE249FBC1, 0xE24AFBC1, 0xE24BFBC1, 0xE24CFBC1, 0xE24DFBC1, 0xE24EFBC1, 0xE24FFBC1, 0xE250FBC1, 0xE251FBC1, 0xE252FBC1, 0xE253FBC1, 0xE254FBC1, 0xE255FBC1, 0xE256FBC1, 0xE257FBC1, 0xE258FBC1, 0xE259FBC1, 0xE25AFBC1, 0xE25BFBC1, 0xE25CFBC1, 0xE25DFBC1, 0xE25EFBC1, 0xE25FFBC1,
+		0xE260FBC1, 0xE261FBC1, 0xE262FBC1, 0xE263FBC1, 0xE264FBC1, 0xE265FBC1, 0xE266FBC1, 0xE267FBC1, 0xE268FBC1, 0xE269FBC1, 0xE26AFBC1, 0xE26BFBC1, 0xE26CFBC1, 0xE26DFBC1, 0xE26EFBC1, 0xE26FFBC1, 0xE270FBC1, 0xE271FBC1, 0xE272FBC1, 0xE273FBC1, 0xE274FBC1, 0xE275FBC1, 0xE276FBC1, 0xE277FBC1, 0xE278FBC1, 0xE279FBC1, 0xE27AFBC1, 0xE27BFBC1, 0xE27CFBC1, 0xE27DFBC1, 0xE27EFBC1, 0xE27FFBC1,
+		0xE280FBC1, 0xE281FBC1, 0xE282FBC1, 0xE283FBC1, 0xE284FBC1, 0xE285FBC1, 0xE286FBC1, 0xE287FBC1, 0xE288FBC1, 0xE289FBC1, 0xE28AFBC1, 0xE28BFBC1, 0xE28CFBC1, 0xE28DFBC1, 0xE28EFBC1, 0xE28FFBC1, 0xE290FBC1, 0xE291FBC1, 0xE292FBC1, 0xE293FBC1, 0xE294FBC1, 0xE295FBC1, 0xE296FBC1, 0xE297FBC1, 0xE298FBC1, 0xE299FBC1, 0xE29AFBC1, 0xE29BFBC1, 0xE29CFBC1, 0xE29DFBC1, 0xE29EFBC1, 0xE29FFBC1,
+		0xE2A0FBC1, 0xE2A1FBC1, 0xE2A2FBC1, 0xE2A3FBC1, 0xE2A4FBC1, 0xE2A5FBC1, 0xE2A6FBC1, 0xE2A7FBC1, 0xE2A8FBC1, 0xE2A9FBC1, 0xE2AAFBC1, 0xE2ABFBC1, 0xE2ACFBC1, 0xE2ADFBC1, 0xE2AEFBC1, 0xE2AFFBC1, 0xE2B0FBC1, 0xE2B1FBC1, 0xE2B2FBC1, 0xE2B3FBC1, 0xE2B4FBC1, 0xE2B5FBC1, 0xE2B6FBC1, 0xE2B7FBC1, 0xE2B8FBC1, 0xE2B9FBC1, 0xE2BAFBC1, 0xE2BBFBC1, 0xE2BCFBC1, 0xE2BDFBC1, 0xE2BEFBC1, 0xE2BFFBC1,
+		0xE2C0FBC1, 0xE2C1FBC1, 0xE2C2FBC1, 0xE2C3FBC1, 0xE2C4FBC1, 0xE2C5FBC1, 0xE2C6FBC1, 0xE2C7FBC1, 0xE2C8FBC1, 0xE2C9FBC1, 0xE2CAFBC1, 0xE2CBFBC1, 0xE2CCFBC1, 0xE2CDFBC1, 0xE2CEFBC1, 0xE2CFFBC1, 0xE2D0FBC1, 0xE2D1FBC1, 0xE2D2FBC1, 0xE2D3FBC1, 0xE2D4FBC1, 0xE2D5FBC1, 0xE2D6FBC1, 0xE2D7FBC1, 0xE2D8FBC1, 0xE2D9FBC1, 0xE2DAFBC1, 0xE2DBFBC1, 0xE2DCFBC1, 0xE2DDFBC1, 0xE2DEFBC1, 0xE2DFFBC1,
+		0xE2E0FBC1, 0xE2E1FBC1, 0xE2E2FBC1, 0xE2E3FBC1, 0xE2E4FBC1, 0xE2E5FBC1, 0xE2E6FBC1, 0xE2E7FBC1, 0xE2E8FBC1, 0xE2E9FBC1, 0xE2EAFBC1, 0xE2EBFBC1, 0xE2ECFBC1, 0xE2EDFBC1, 0xE2EEFBC1, 0xE2EFFBC1, 0xE2F0FBC1, 0xE2F1FBC1, 0xE2F2FBC1, 0xE2F3FBC1, 0xE2F4FBC1, 0xE2F5FBC1, 0xE2F6FBC1, 0xE2F7FBC1, 0xE2F8FBC1, 0xE2F9FBC1, 0xE2FAFBC1, 0xE2FBFBC1, 0xE2FCFBC1, 0xE2FDFBC1, 0xE2FEFBC1, 0xE2FFFBC1,
+		0xE300FBC1, 0xE301FBC1, 0xE302FBC1, 0xE303FBC1, 0xE304FBC1, 0xE305FBC1, 0xE306FBC1, 0xE307FBC1, 0xE308FBC1, 0xE309FBC1, 0xE30AFBC1, 0xE30BFBC1, 0xE30CFBC1, 0xE30DFBC1, 0xE30EFBC1, 0xE30FFBC1, 0xE310FBC1, 0xE311FBC1, 0xE312FBC1, 0xE313FBC1, 0xE314FBC1, 0xE315FBC1, 0xE316FBC1, 0xE317FBC1, 0xE318FBC1, 0xE319FBC1, 0xE31AFBC1, 0xE31BFBC1, 0xE31CFBC1, 0xE31DFBC1, 0xE31EFBC1, 0xE31FFBC1,
+		0xE320FBC1, 0xE321FBC1, 0xE322FBC1, 0xE323FBC1, 0xE324FBC1, 0xE325FBC1, 0xE326FBC1, 0xE327FBC1, 0xE328FBC1, 0xE329FBC1, 0xE32AFBC1, 0xE32BFBC1, 0xE32CFBC1, 0xE32DFBC1, 0xE32EFBC1, 0xE32FFBC1, 0xE330FBC1, 0xE331FBC1, 0xE332FBC1, 0xE333FBC1, 0xE334FBC1, 0xE335FBC1, 0xE336FBC1, 0xE337FBC1, 0xE338FBC1, 0xE339FBC1, 0xE33AFBC1, 0xE33BFBC1, 0xE33CFBC1, 0xE33DFBC1, 0xE33EFBC1, 0xE33FFBC1,
+		0xE340FBC1, 0xE341FBC1, 0xE342FBC1, 0xE343FBC1, 0xE344FBC1, 0xE345FBC1, 0xE346FBC1, 0xE347FBC1, 0xE348FBC1, 0xE349FBC1, 0xE34AFBC1, 0xE34BFBC1, 0xE34CFBC1, 0xE34DFBC1, 0xE34EFBC1, 0xE34FFBC1, 0xE350FBC1, 0xE351FBC1, 0xE352FBC1, 0xE353FBC1, 0xE354FBC1, 0xE355FBC1, 0xE356FBC1, 0xE357FBC1, 0xE358FBC1, 0xE359FBC1, 0xE35AFBC1, 0xE35BFBC1, 0xE35CFBC1, 0xE35DFBC1, 0xE35EFBC1, 0xE35FFBC1,
+		0xE360FBC1, 0xE361FBC1, 0xE362FBC1, 0xE363FBC1, 0xE364FBC1, 0xE365FBC1, 0xE366FBC1, 0xE367FBC1, 0xE368FBC1, 0xE369FBC1, 0xE36AFBC1, 0xE36BFBC1, 0xE36CFBC1, 0xE36DFBC1, 0xE36EFBC1, 0xE36FFBC1, 0xE370FBC1, 0xE371FBC1, 0xE372FBC1, 0xE373FBC1, 0xE374FBC1, 0xE375FBC1, 0xE376FBC1, 0xE377FBC1, 0xE378FBC1, 0xE379FBC1, 0xE37AFBC1, 0xE37BFBC1, 0xE37CFBC1, 0xE37DFBC1, 0xE37EFBC1, 0xE37FFBC1,
+		0xE380FBC1, 0xE381FBC1, 0xE382FBC1, 0xE383FBC1, 0xE384FBC1, 0xE385FBC1, 0xE386FBC1, 0xE387FBC1, 0xE388FBC1, 0xE389FBC1, 0xE38AFBC1, 0xE38BFBC1, 0xE38CFBC1, 0xE38DFBC1, 0xE38EFBC1, 0xE38FFBC1, 0xE390FBC1, 0xE391FBC1, 0xE392FBC1, 0xE393FBC1, 0xE394FBC1, 0xE395FBC1, 0xE396FBC1, 0xE397FBC1, 0xE398FBC1, 0xE399FBC1, 0xE39AFBC1, 0xE39BFBC1, 0xE39CFBC1, 0xE39DFBC1, 0xE39EFBC1, 0xE39FFBC1,
+		0xE3A0FBC1, 0xE3A1FBC1, 0xE3A2FBC1, 0xE3A3FBC1, 0xE3A4FBC1, 0xE3A5FBC1, 0xE3A6FBC1, 0xE3A7FBC1, 0xE3A8FBC1, 0xE3A9FBC1, 0xE3AAFBC1, 0xE3ABFBC1, 0xE3ACFBC1, 0xE3ADFBC1, 0xE3AEFBC1, 0xE3AFFBC1, 0xE3B0FBC1, 0xE3B1FBC1, 0xE3B2FBC1, 0xE3B3FBC1, 0xE3B4FBC1, 0xE3B5FBC1, 0xE3B6FBC1, 0xE3B7FBC1, 0xE3B8FBC1, 0xE3B9FBC1, 0xE3BAFBC1, 0xE3BBFBC1, 0xE3BCFBC1, 0xE3BDFBC1, 0xE3BEFBC1, 0xE3BFFBC1,
+		0xE3C0FBC1, 0xE3C1FBC1, 0xE3C2FBC1, 0xE3C3FBC1, 0xE3C4FBC1, 0xE3C5FBC1, 0xE3C6FBC1, 0xE3C7FBC1, 0xE3C8FBC1, 0xE3C9FBC1, 0xE3CAFBC1, 0xE3CBFBC1, 0xE3CCFBC1, 0xE3CDFBC1, 0xE3CEFBC1, 0xE3CFFBC1, 0xE3D0FBC1, 0xE3D1FBC1, 0xE3D2FBC1, 0xE3D3FBC1, 0xE3D4FBC1, 0xE3D5FBC1, 0xE3D6FBC1, 0xE3D7FBC1, 0xE3D8FBC1, 0xE3D9FBC1, 0xE3DAFBC1, 0xE3DBFBC1, 0xE3DCFBC1, 0xE3DDFBC1, 0xE3DEFBC1, 0xE3DFFBC1,
+		0xE3E0FBC1, 0xE3E1FBC1, 0xE3E2FBC1, 0xE3E3FBC1, 0xE3E4FBC1, 0xE3E5FBC1, 0xE3E6FBC1, 0xE3E7FBC1, 0xE3E8FBC1, 0xE3E9FBC1, 0xE3EAFBC1, 0xE3EBFBC1, 0xE3ECFBC1, 0xE3EDFBC1, 0xE3EEFBC1, 0xE3EFFBC1, 0xE3F0FBC1, 0xE3F1FBC1, 0xE3F2FBC1, 0xE3F3FBC1, 0xE3F4FBC1, 0xE3F5FBC1, 0xE3F6FBC1, 0xE3F7FBC1, 0xE3F8FBC1, 0xE3F9FBC1, 0xE3FAFBC1, 0xE3FBFBC1, 0xE3FCFBC1, 0xE3FDFBC1, 0xE3FEFBC1, 0xE3FFFBC1,
+		0xE400FBC1, 0xE401FBC1, 0xE402FBC1, 0xE403FBC1, 0xE404FBC1, 0xE405FBC1, 0xE406FBC1, 0xE407FBC1, 0xE408FBC1, 0xE409FBC1, 0xE40AFBC1, 0xE40BFBC1, 0xE40CFBC1, 0xE40DFBC1, 0xE40EFBC1, 0xE40FFBC1, 0xE410FBC1, 0xE411FBC1, 0xE412FBC1, 0xE413FBC1, 0xE414FBC1, 0xE415FBC1, 0xE416FBC1, 0xE417FBC1, 0xE418FBC1, 0xE419FBC1, 0xE41AFBC1, 0xE41BFBC1, 0xE41CFBC1, 0xE41DFBC1, 0xE41EFBC1, 0xE41FFBC1,
+		0xE420FBC1, 0xE421FBC1, 0xE422FBC1, 0xE423FBC1, 0xE424FBC1, 0xE425FBC1, 0xE426FBC1, 0xE427FBC1, 0xE428FBC1, 0xE429FBC1, 0xE42AFBC1, 0xE42BFBC1, 0xE42CFBC1, 0xE42DFBC1, 0xE42EFBC1, 0xE42FFBC1, 0xE430FBC1, 0xE431FBC1, 0xE432FBC1, 0xE433FBC1, 0xE434FBC1, 0xE435FBC1, 0xE436FBC1, 0xE437FBC1, 0xE438FBC1, 0xE439FBC1, 0xE43AFBC1, 0xE43BFBC1, 0xE43CFBC1, 0xE43DFBC1, 0xE43EFBC1, 0xE43FFBC1,
+		0xE440FBC1, 0xE441FBC1, 0xE442FBC1, 0xE443FBC1, 0xE444FBC1, 0xE445FBC1, 0xE446FBC1, 0xE447FBC1, 0xE448FBC1, 0xE449FBC1, 0xE44AFBC1, 0xE44BFBC1, 0xE44CFBC1, 0xE44DFBC1, 0xE44EFBC1, 0xE44FFBC1, 0xE450FBC1, 0xE451FBC1, 0xE452FBC1, 0xE453FBC1, 0xE454FBC1, 0xE455FBC1, 0xE456FBC1, 0xE457FBC1, 0xE458FBC1, 0xE459FBC1, 0xE45AFBC1, 0xE45BFBC1, 0xE45CFBC1, 0xE45DFBC1, 0xE45EFBC1, 0xE45FFBC1,
+		0xE460FBC1, 0xE461FBC1, 0xE462FBC1, 0xE463FBC1, 0xE464FBC1, 0xE465FBC1, 0xE466FBC1, 0xE467FBC1, 0xE468FBC1, 0xE469FBC1, 0xE46AFBC1, 0xE46BFBC1, 0xE46CFBC1, 0xE46DFBC1, 0xE46EFBC1, 0xE46FFBC1, 0xE470FBC1, 0xE471FBC1, 0xE472FBC1, 0xE473FBC1, 0xE474FBC1, 0xE475FBC1, 0xE476FBC1, 0xE477FBC1, 0xE478FBC1, 0xE479FBC1, 0xE47AFBC1, 0xE47BFBC1, 0xE47CFBC1, 0xE47DFBC1, 0xE47EFBC1, 0xE47FFBC1,
+		0xE480FBC1, 0xE481FBC1, 0xE482FBC1, 0xE483FBC1, 0xE484FBC1, 0xE485FBC1, 0xE486FBC1, 0xE487FBC1, 0xE488FBC1, 0xE489FBC1, 0xE48AFBC1, 0xE48BFBC1, 0xE48CFBC1, 0xE48DFBC1, 0xE48EFBC1, 0xE48FFBC1, 0xE490FBC1, 0xE491FBC1, 0xE492FBC1, 0xE493FBC1, 0xE494FBC1, 0xE495FBC1, 0xE496FBC1, 0xE497FBC1, 0xE498FBC1, 0xE499FBC1, 0xE49AFBC1, 0xE49BFBC1, 0xE49CFBC1, 0xE49DFBC1, 0xE49EFBC1, 0xE49FFBC1,
+		0xE4A0FBC1, 0xE4A1FBC1, 0xE4A2FBC1, 0xE4A3FBC1, 0xE4A4FBC1, 0xE4A5FBC1, 0xE4A6FBC1, 0xE4A7FBC1, 0xE4A8FBC1, 0xE4A9FBC1, 0xE4AAFBC1, 0xE4ABFBC1, 0xE4ACFBC1, 0xE4ADFBC1, 0xE4AEFBC1, 0xE4AFFBC1, 0xE4B0FBC1, 0xE4B1FBC1, 0xE4B2FBC1, 0xE4B3FBC1, 0xE4B4FBC1, 0xE4B5FBC1, 0xE4B6FBC1, 0xE4B7FBC1, 0xE4B8FBC1, 0xE4B9FBC1, 0xE4BAFBC1, 0xE4BBFBC1, 0xE4BCFBC1, 0xE4BDFBC1, 0xE4BEFBC1, 0xE4BFFBC1,
+		0xE4C0FBC1, 0xE4C1FBC1, 0xE4C2FBC1, 0xE4C3FBC1, 0xE4C4FBC1, 0xE4C5FBC1, 0xE4C6FBC1, 0xE4C7FBC1, 0xE4C8FBC1, 0xE4C9FBC1, 0xE4CAFBC1, 0xE4CBFBC1, 0xE4CCFBC1, 0xE4CDFBC1, 0xE4CEFBC1, 0xE4CFFBC1, 0xE4D0FBC1, 0xE4D1FBC1, 0xE4D2FBC1, 0xE4D3FBC1, 0xE4D4FBC1, 0xE4D5FBC1, 0xE4D6FBC1, 0xE4D7FBC1, 0xE4D8FBC1, 0xE4D9FBC1, 0xE4DAFBC1, 0xE4DBFBC1, 0xE4DCFBC1, 0xE4DDFBC1, 0xE4DEFBC1, 0xE4DFFBC1,
+		0xE4E0FBC1, 0xE4E1FBC1, 0xE4E2FBC1, 0xE4E3FBC1, 0xE4E4FBC1, 0xE4E5FBC1, 0xE4E6FBC1, 0xE4E7FBC1, 0xE4E8FBC1, 0xE4E9FBC1, 0xE4EAFBC1, 0xE4EBFBC1, 0xE4ECFBC1, 0xE4EDFBC1, 0xE4EEFBC1, 0xE4EFFBC1, 0xE4F0FBC1, 0xE4F1FBC1, 0xE4F2FBC1, 0xE4F3FBC1, 0xE4F4FBC1, 0xE4F5FBC1, 0xE4F6FBC1, 0xE4F7FBC1, 0xE4F8FBC1, 0xE4F9FBC1, 0xE4FAFBC1, 0xE4FBFBC1, 0xE4FCFBC1, 0xE4FDFBC1, 0xE4FEFBC1, 0xE4FFFBC1,
+		0xE500FBC1, 0xE501FBC1, 0xE502FBC1, 0xE503FBC1, 0xE504FBC1, 0xE505FBC1, 0xE506FBC1, 0xE507FBC1, 0xE508FBC1, 0xE509FBC1, 0xE50AFBC1, 0xE50BFBC1, 0xE50CFBC1, 0xE50DFBC1, 0xE50EFBC1, 0xE50FFBC1, 0xE510FBC1, 0xE511FBC1, 0xE512FBC1, 0xE513FBC1, 0xE514FBC1, 0xE515FBC1, 0xE516FBC1, 0xE517FBC1, 0xE518FBC1, 0xE519FBC1, 0xE51AFBC1, 0xE51BFBC1, 0xE51CFBC1, 0xE51DFBC1, 0xE51EFBC1, 0xE51FFBC1,
+		0xE520FBC1, 0xE521FBC1, 0xE522FBC1, 0xE523FBC1, 0xE524FBC1, 0xE525FBC1, 0xE526FBC1, 0xE527FBC1, 0xE528FBC1, 0xE529FBC1, 0xE52AFBC1, 0xE52BFBC1, 0xE52CFBC1, 0xE52DFBC1, 0xE52EFBC1, 0xE52FFBC1, 0xE530FBC1, 0xE531FBC1, 0xE532FBC1, 0xE533FBC1, 0xE534FBC1, 0xE535FBC1, 0xE536FBC1, 0xE537FBC1, 0xE538FBC1, 0xE539FBC1, 0xE53AFBC1, 0xE53BFBC1, 0xE53CFBC1, 0xE53DFBC1, 0xE53EFBC1, 0xE53FFBC1,
+		0xE540FBC1, 0xE541FBC1, 0xE542FBC1, 0xE543FBC1, 0xE544FBC1, 0xE545FBC1, 0xE546FBC1, 0xE547FBC1, 0xE548FBC1, 0xE549FBC1, 0xE54AFBC1, 0xE54BFBC1, 0xE54CFBC1, 0xE54DFBC1, 0xE54EFBC1, 0xE54FFBC1, 0xE550FBC1, 0xE551FBC1, 0xE552FBC1, 0xE553FBC1, 0xE554FBC1, 0xE555FBC1, 0xE556FBC1, 0xE557FBC1, 0xE558FBC1, 0xE559FBC1, 0xE55AFBC1, 0xE55BFBC1, 0xE55CFBC1, 0xE55DFBC1, 0xE55EFBC1, 0xE55FFBC1,
+		0xE560FBC1, 0xE561FBC1, 0xE562FBC1, 0xE563FBC1, 0xE564FBC1, 0xE565FBC1, 0xE566FBC1, 0xE567FBC1, 0xE568FBC1, 0xE569FBC1, 0xE56AFBC1, 0xE56BFBC1, 0xE56CFBC1, 0xE56DFBC1, 0xE56EFBC1, 0xE56FFBC1, 0xE570FBC1, 0xE571FBC1, 0xE572FBC1, 0xE573FBC1, 0xE574FBC1, 0xE575FBC1, 0xE576FBC1, 0xE577FBC1, 0xE578FBC1, 0xE579FBC1, 0xE57AFBC1, 0xE57BFBC1, 0xE57CFBC1, 0xE57DFBC1, 0xE57EFBC1, 0xE57FFBC1,
+		0xE580FBC1, 0xE581FBC1, 0xE582FBC1, 0xE583FBC1, 0xE584FBC1, 0xE585FBC1, 0xE586FBC1, 0xE587FBC1, 0xE588FBC1, 0xE589FBC1, 0xE58AFBC1, 0xE58BFBC1, 0xE58CFBC1, 0xE58DFBC1, 0xE58EFBC1, 0xE58FFBC1, 0xE590FBC1, 0xE591FBC1, 0xE592FBC1, 0xE593FBC1, 0xE594FBC1, 0xE595FBC1, 0xE596FBC1, 0xE597FBC1, 0xE598FBC1, 0xE599FBC1, 0xE59AFBC1, 0xE59BFBC1, 0xE59CFBC1, 0xE59DFBC1, 0xE59EFBC1, 0xE59FFBC1,
+		0xE5A0FBC1, 0xE5A1FBC1, 0xE5A2FBC1, 0xE5A3FBC1, 0xE5A4FBC1, 0xE5A5FBC1, 0xE5A6FBC1, 0xE5A7FBC1, 0xE5A8FBC1, 0xE5A9FBC1, 0xE5AAFBC1, 0xE5ABFBC1, 0xE5ACFBC1, 0xE5ADFBC1, 0xE5AEFBC1, 0xE5AFFBC1, 0xE5B0FBC1, 0xE5B1FBC1, 0xE5B2FBC1, 0xE5B3FBC1, 0xE5B4FBC1, 0xE5B5FBC1, 0xE5B6FBC1, 0xE5B7FBC1, 0xE5B8FBC1, 0xE5B9FBC1, 0xE5BAFBC1, 0xE5BBFBC1, 0xE5BCFBC1, 0xE5BDFBC1, 0xE5BEFBC1, 0xE5BFFBC1,
+		0xE5C0FBC1, 0xE5C1FBC1, 0xE5C2FBC1, 0xE5C3FBC1, 0xE5C4FBC1, 0xE5C5FBC1, 0xE5C6FBC1, 0xE5C7FBC1, 0xE5C8FBC1, 0xE5C9FBC1, 0xE5CAFBC1, 0xE5CBFBC1, 0xE5CCFBC1, 0xE5CDFBC1, 0xE5CEFBC1, 0xE5CFFBC1, 0xE5D0FBC1, 0xE5D1FBC1, 0xE5D2FBC1, 0xE5D3FBC1, 0xE5D4FBC1, 0xE5D5FBC1, 0xE5D6FBC1, 0xE5D7FBC1, 0xE5D8FBC1, 0xE5D9FBC1, 0xE5DAFBC1, 0xE5DBFBC1, 0xE5DCFBC1, 0xE5DDFBC1, 0xE5DEFBC1, 0xE5DFFBC1,
+		0xE5E0FBC1, 0xE5E1FBC1, 0xE5E2FBC1, 0xE5E3FBC1, 0xE5E4FBC1, 0xE5E5FBC1, 0xE5E6FBC1, 0xE5E7FBC1, 0xE5E8FBC1, 0xE5E9FBC1, 0xE5EAFBC1, 0xE5EBFBC1, 0xE5ECFBC1, 0xE5EDFBC1, 0xE5EEFBC1, 0xE5EFFBC1, 0xE5F0FBC1, 0xE5F1FBC1, 0xE5F2FBC1, 0xE5F3FBC1, 0xE5F4FBC1, 0xE5F5FBC1, 0xE5F6FBC1, 0xE5F7FBC1, 0xE5F8FBC1, 0xE5F9FBC1, 0xE5FAFBC1, 0xE5FBFBC1, 0xE5FCFBC1, 0xE5FDFBC1, 0xE5FEFBC1, 0xE5FFFBC1,
+		0xE600FBC1, 0xE601FBC1, 0xE602FBC1, 0xE603FBC1, 0xE604FBC1, 0xE605FBC1, 0xE606FBC1, 0xE607FBC1, 0xE608FBC1, 0xE609FBC1, 0xE60AFBC1, 0xE60BFBC1, 0xE60CFBC1, 0xE60DFBC1, 0xE60EFBC1, 0xE60FFBC1, 0xE610FBC1, 0xE611FBC1, 0xE612FBC1, 0xE613FBC1, 0xE614FBC1, 0xE615FBC1, 0xE616FBC1, 0xE617FBC1, 0xE618FBC1, 0xE619FBC1, 0xE61AFBC1, 0xE61BFBC1, 0xE61CFBC1, 0xE61DFBC1, 0xE61EFBC1, 0xE61FFBC1,
+		0xE620FBC1, 0xE621FBC1, 0xE622FBC1, 0xE623FBC1, 0xE624FBC1, 0xE625FBC1, 0xE626FBC1, 0xE627FBC1, 0xE628FBC1, 0xE629FBC1, 0xE62AFBC1, 0xE62BFBC1, 0xE62CFBC1, 0xE62DFBC1, 0xE62EFBC1, 0xE62FFBC1, 0xE630FBC1, 0xE631FBC1, 0xE632FBC1, 0xE633FBC1, 0xE634FBC1, 0xE635FBC1, 0xE636FBC1, 0xE637FBC1, 0xE638FBC1, 0xE639FBC1, 0xE63AFBC1, 0xE63BFBC1, 0xE63CFBC1, 0xE63DFBC1, 0xE63EFBC1, 0xE63FFBC1,
+		0xE640FBC1, 0xE641FBC1, 0xE642FBC1, 0xE643FBC1, 0xE644FBC1, 0xE645FBC1, 0xE646FBC1, 0xE647FBC1, 0xE648FBC1, 0xE649FBC1, 0xE64AFBC1, 0xE64BFBC1, 0xE64CFBC1, 0xE64DFBC1, 0xE64EFBC1, 0xE64FFBC1, 0xE650FBC1, 0xE651FBC1, 0xE652FBC1, 0xE653FBC1, 0xE654FBC1, 0xE655FBC1, 0xE656FBC1, 0xE657FBC1, 0xE658FBC1, 0xE659FBC1, 0xE65AFBC1, 0xE65BFBC1, 0xE65CFBC1, 0xE65DFBC1, 0xE65EFBC1, 0xE65FFBC1,
+		0xE660FBC1, 0xE661FBC1, 0xE662FBC1, 0xE663FBC1, 0xE664FBC1, 0xE665FBC1, 0xE666FBC1, 0xE667FBC1, 0xE668FBC1, 0xE669FBC1, 0xE66AFBC1, 0xE66BFBC1, 0xE66CFBC1, 0xE66DFBC1, 0xE66EFBC1, 0xE66FFBC1, 0xE670FBC1, 0xE671FBC1, 0xE672FBC1, 0xE673FBC1, 0xE674FBC1, 0xE675FBC1, 0xE676FBC1, 0xE677FBC1, 0xE678FBC1, 0xE679FBC1, 0xE67AFBC1, 0xE67BFBC1, 0xE67CFBC1, 0xE67DFBC1, 0xE67EFBC1, 0xE67FFBC1,
+		0xE680FBC1, 0xE681FBC1, 0xE682FBC1, 0xE683FBC1, 0xE684FBC1, 0xE685FBC1, 0xE686FBC1, 0xE687FBC1, 0xE688FBC1, 0xE689FBC1, 0xE68AFBC1, 0xE68BFBC1, 0xE68CFBC1, 0xE68DFBC1, 0xE68EFBC1, 0xE68FFBC1, 0xE690FBC1, 0xE691FBC1, 0xE692FBC1, 0xE693FBC1, 0xE694FBC1, 0xE695FBC1, 0xE696FBC1, 0xE697FBC1, 0xE698FBC1, 0xE699FBC1, 0xE69AFBC1, 0xE69BFBC1, 0xE69CFBC1, 0xE69DFBC1, 0xE69EFBC1, 0xE69FFBC1,
+		0xE6A0FBC1, 0xE6A1FBC1, 0xE6A2FBC1, 0xE6A3FBC1, 0xE6A4FBC1, 0xE6A5FBC1, 0xE6A6FBC1, 0xE6A7FBC1, 0xE6A8FBC1, 0xE6A9FBC1, 0xE6AAFBC1, 0xE6ABFBC1, 0xE6ACFBC1, 0xE6ADFBC1, 0xE6AEFBC1, 0xE6AFFBC1, 0xE6B0FBC1, 0xE6B1FBC1, 0xE6B2FBC1, 0xE6B3FBC1, 0xE6B4FBC1, 0xE6B5FBC1, 0xE6B6FBC1, 0xE6B7FBC1, 0xE6B8FBC1, 0xE6B9FBC1, 0xE6BAFBC1, 0xE6BBFBC1, 0xE6BCFBC1, 0xE6BDFBC1, 0xE6BEFBC1, 0xE6BFFBC1,
+		0xE6C0FBC1, 0xE6C1FBC1, 0xE6C2FBC1, 0xE6C3FBC1, 0xE6C4FBC1, 0xE6C5FBC1, 0xE6C6FBC1, 0xE6C7FBC1, 0xE6C8FBC1, 0xE6C9FBC1, 0xE6CAFBC1, 0xE6CBFBC1, 0xE6CCFBC1, 0xE6CDFBC1, 0xE6CEFBC1, 0xE6CFFBC1, 0xE6D0FBC1, 0xE6D1FBC1, 0xE6D2FBC1, 0xE6D3FBC1, 0xE6D4FBC1, 0xE6D5FBC1, 0xE6D6FBC1, 0xE6D7FBC1, 0xE6D8FBC1, 0xE6D9FBC1, 0xE6DAFBC1, 0xE6DBFBC1, 0xE6DCFBC1, 0xE6DDFBC1, 0xE6DEFBC1, 0xE6DFFBC1,
+		0xE6E0FBC1, 0xE6E1FBC1, 0xE6E2FBC1, 0xE6E3FBC1, 0xE6E4FBC1, 0xE6E5FBC1, 0xE6E6FBC1, 0xE6E7FBC1, 0xE6E8FBC1, 0xE6E9FBC1, 0xE6EAFBC1, 0xE6EBFBC1, 0xE6ECFBC1, 0xE6EDFBC1, 0xE6EEFBC1, 0xE6EFFBC1, 0xE6F0FBC1, 0xE6F1FBC1, 0xE6F2FBC1, 0xE6F3FBC1, 0xE6F4FBC1, 0xE6F5FBC1, 0xE6F6FBC1, 0xE6F7FBC1, 0xE6F8FBC1, 0xE6F9FBC1, 0xE6FAFBC1, 0xE6FBFBC1, 0xE6FCFBC1, 0xE6FDFBC1, 0xE6FEFBC1, 0xE6FFFBC1,
+		0xE700FBC1, 0xE701FBC1, 0xE702FBC1, 0xE703FBC1, 0xE704FBC1, 0xE705FBC1, 0xE706FBC1, 0xE707FBC1, 0xE708FBC1, 0xE709FBC1, 0xE70AFBC1, 0xE70BFBC1, 0xE70CFBC1, 0xE70DFBC1, 0xE70EFBC1, 0xE70FFBC1, 0xE710FBC1, 0xE711FBC1, 0xE712FBC1, 0xE713FBC1, 0xE714FBC1, 0xE715FBC1, 0xE716FBC1, 0xE717FBC1, 0xE718FBC1, 0xE719FBC1, 0xE71AFBC1, 0xE71BFBC1, 0xE71CFBC1, 0xE71DFBC1, 0xE71EFBC1, 0xE71FFBC1,
+		0xE720FBC1, 0xE721FBC1, 0xE722FBC1, 0xE723FBC1, 0xE724FBC1, 0xE725FBC1, 0xE726FBC1, 0xE727FBC1, 0xE728FBC1, 0xE729FBC1, 0xE72AFBC1, 0xE72BFBC1, 0xE72CFBC1, 0xE72DFBC1, 0xE72EFBC1, 0xE72FFBC1, 0xE730FBC1, 0xE731FBC1, 0xE732FBC1, 0xE733FBC1, 0xE734FBC1, 0xE735FBC1, 0xE736FBC1, 0xE737FBC1, 0xE738FBC1, 0xE739FBC1, 0xE73AFBC1, 0xE73BFBC1, 0xE73CFBC1, 0xE73DFBC1, 0xE73EFBC1, 0xE73FFBC1,
+		0xE740FBC1, 0xE741FBC1, 0xE742FBC1, 0xE743FBC1, 0xE744FBC1, 0xE745FBC1, 0xE746FBC1, 0xE747FBC1, 0xE748FBC1, 0xE749FBC1, 0xE74AFBC1, 0xE74BFBC1, 0xE74CFBC1, 0xE74DFBC1, 0xE74EFBC1, 0xE74FFBC1, 0xE750FBC1, 0xE751FBC1, 0xE752FBC1, 0xE753FBC1, 0xE754FBC1, 0xE755FBC1, 0xE756FBC1, 0xE757FBC1, 0xE758FBC1, 0xE759FBC1, 0xE75AFBC1, 0xE75BFBC1, 0xE75CFBC1, 0xE75DFBC1, 0xE75EFBC1, 0xE75FFBC1,
+		0xE760FBC1, 0xE761FBC1, 0xE762FBC1, 0xE763FBC1, 0xE764FBC1, 0xE765FBC1, 0xE766FBC1, 0xE767FBC1, 0xE768FBC1, 0xE769FBC1, 0xE76AFBC1, 0xE76BFBC1, 0xE76CFBC1, 0xE76DFBC1, 0xE76EFBC1, 0xE76FFBC1, 0xE770FBC1, 0xE771FBC1, 0xE772FBC1, 0xE773FBC1, 0xE774FBC1, 0xE775FBC1, 0xE776FBC1, 0xE777FBC1, 0xE778FBC1, 0xE779FBC1, 0xE77AFBC1, 0xE77BFBC1, 0xE77CFBC1, 0xE77DFBC1, 0xE77EFBC1, 0xE77FFBC1,
+		0xE780FBC1, 0xE781FBC1, 0xE782FBC1, 0xE783FBC1, 0xE784FBC1, 0xE785FBC1, 0xE786FBC1, 0xE787FBC1, 0xE788FBC1, 0xE789FBC1, 0xE78AFBC1, 0xE78BFBC1, 0xE78CFBC1, 0xE78DFBC1, 0xE78EFBC1, 0xE78FFBC1, 0xE790FBC1, 0xE791FBC1, 0xE792FBC1, 0xE793FBC1, 0xE794FBC1, 0xE795FBC1, 0xE796FBC1, 0xE797FBC1, 0xE798FBC1, 0xE799FBC1, 0xE79AFBC1, 0xE79BFBC1, 0xE79CFBC1, 0xE79DFBC1, 0xE79EFBC1, 0xE79FFBC1,
+		0xE7A0FBC1, 0xE7A1FBC1, 0xE7A2FBC1, 0xE7A3FBC1, 0xE7A4FBC1, 0xE7A5FBC1, 0xE7A6FBC1, 0xE7A7FBC1, 0xE7A8FBC1, 0xE7A9FBC1, 0xE7AAFBC1, 0xE7ABFBC1, 0xE7ACFBC1, 0xE7ADFBC1, 0xE7AEFBC1, 0xE7AFFBC1, 0xE7B0FBC1, 0xE7B1FBC1, 0xE7B2FBC1, 0xE7B3FBC1, 0xE7B4FBC1, 0xE7B5FBC1, 0xE7B6FBC1, 0xE7B7FBC1, 0xE7B8FBC1, 0xE7B9FBC1, 0xE7BAFBC1, 0xE7BBFBC1, 0xE7BCFBC1, 0xE7BDFBC1, 0xE7BEFBC1, 0xE7BFFBC1,
+		0xE7C0FBC1, 0xE7C1FBC1, 0xE7C2FBC1, 0xE7C3FBC1, 0xE7C4FBC1, 0xE7C5FBC1, 0xE7C6FBC1, 0xE7C7FBC1, 0xE7C8FBC1, 0xE7C9FBC1, 0xE7CAFBC1, 0xE7CBFBC1, 0xE7CCFBC1, 0xE7CDFBC1, 0xE7CEFBC1, 0xE7CFFBC1, 0xE7D0FBC1, 0xE7D1FBC1, 0xE7D2FBC1, 0xE7D3FBC1, 0xE7D4FBC1, 0xE7D5FBC1, 0xE7D6FBC1, 0xE7D7FBC1, 0xE7D8FBC1, 0xE7D9FBC1, 0xE7DAFBC1, 0xE7DBFBC1, 0xE7DCFBC1, 0xE7DDFBC1, 0xE7DEFBC1, 0xE7DFFBC1,
+		0xE7E0FBC1, 0xE7E1FBC1, 0xE7E2FBC1, 0xE7E3FBC1, 0xE7E4FBC1, 0xE7E5FBC1, 0xE7E6FBC1, 0xE7E7FBC1, 0xE7E8FBC1, 0xE7E9FBC1, 0xE7EAFBC1, 0xE7EBFBC1, 0xE7ECFBC1, 0xE7EDFBC1, 0xE7EEFBC1, 0xE7EFFBC1, 0xE7F0FBC1, 0xE7F1FBC1, 0xE7F2FBC1, 0xE7F3FBC1, 0xE7F4FBC1, 0xE7F5FBC1, 0xE7F6FBC1, 0xE7F7FBC1, 0xE7F8FBC1, 0xE7F9FBC1, 0xE7FAFBC1, 0xE7FBFBC1, 0xE7FCFBC1, 0xE7FDFBC1, 0xE7FEFBC1, 0xE7FFFBC1,
+		0xE800FBC1, 0xE801FBC1, 0xE802FBC1, 0xE803FBC1, 0xE804FBC1, 0xE805FBC1, 0xE806FBC1, 0xE807FBC1, 0xE808FBC1, 0xE809FBC1, 0xE80AFBC1, 0xE80BFBC1, 0xE80CFBC1, 0xE80DFBC1, 0xE80EFBC1, 0xE80FFBC1, 0xE810FBC1, 0xE811FBC1, 0xE812FBC1, 0xE813FBC1, 0xE814FBC1, 0xE815FBC1, 0xE816FBC1, 0xE817FBC1, 0xE818FBC1, 0xE819FBC1, 0xE81AFBC1, 0xE81BFBC1, 0xE81CFBC1, 0xE81DFBC1, 0xE81EFBC1, 0xE81FFBC1,
+		0xE820FBC1, 0xE821FBC1, 0xE822FBC1, 0xE823FBC1, 0xE824FBC1, 0xE825FBC1, 0xE826FBC1, 0xE827FBC1, 0xE828FBC1, 0xE829FBC1, 0xE82AFBC1, 0xE82BFBC1, 0xE82CFBC1, 0xE82DFBC1, 0xE82EFBC1, 0xE82FFBC1, 0xE830FBC1, 0xE831FBC1, 0xE832FBC1, 0xE833FBC1, 0xE834FBC1, 0xE835FBC1, 0xE836FBC1, 0xE837FBC1, 0xE838FBC1, 0xE839FBC1, 0xE83AFBC1, 0xE83BFBC1, 0xE83CFBC1, 0xE83DFBC1, 0xE83EFBC1, 0xE83FFBC1,
+		0xE840FBC1, 0xE841FBC1, 0xE842FBC1, 0xE843FBC1, 0xE844FBC1, 0xE845FBC1, 0xE846FBC1, 0xE847FBC1, 0xE848FBC1, 0xE849FBC1, 0xE84AFBC1, 0xE84BFBC1, 0xE84CFBC1, 0xE84DFBC1, 0xE84EFBC1, 0xE84FFBC1, 0xE850FBC1, 0xE851FBC1, 0xE852FBC1, 0xE853FBC1, 0xE854FBC1, 0xE855FBC1, 0xE856FBC1, 0xE857FBC1, 0xE858FBC1, 0xE859FBC1, 0xE85AFBC1, 0xE85BFBC1, 0xE85CFBC1, 0xE85DFBC1, 0xE85EFBC1, 0xE85FFBC1,
+		0xE860FBC1, 0xE861FBC1, 0xE862FBC1, 0xE863FBC1, 0xE864FBC1, 0xE865FBC1, 0xE866FBC1, 0xE867FBC1, 0xE868FBC1, 0xE869FBC1, 0xE86AFBC1, 0xE86BFBC1, 0xE86CFBC1, 0xE86DFBC1, 0xE86EFBC1, 0xE86FFBC1, 0xE870FBC1, 0xE871FBC1, 0xE872FBC1, 0xE873FBC1, 0xE874FBC1, 0xE875FBC1, 0xE876FBC1, 0xE877FBC1, 0xE878FBC1, 0xE879FBC1, 0xE87AFBC1, 0xE87BFBC1, 0xE87CFBC1, 0xE87DFBC1, 0xE87EFBC1, 0xE87FFBC1,
+		0xE880FBC1, 0xE881FBC1, 0xE882FBC1, 0xE883FBC1, 0xE884FBC1, 0xE885FBC1, 0xE886FBC1, 0xE887FBC1, 0xE888FBC1, 0xE889FBC1, 0xE88AFBC1, 0xE88BFBC1, 0xE88CFBC1, 0xE88DFBC1, 0xE88EFBC1, 0xE88FFBC1, 0xE890FBC1, 0xE891FBC1, 0xE892FBC1, 0xE893FBC1, 0xE894FBC1, 0xE895FBC1, 0xE896FBC1, 0xE897FBC1, 0xE898FBC1, 0xE899FBC1, 0xE89AFBC1, 0xE89BFBC1, 0xE89CFBC1, 0xE89DFBC1, 0xE89EFBC1, 0xE89FFBC1,
+		0xE8A0FBC1, 0xE8A1FBC1, 0xE8A2FBC1, 0xE8A3FBC1, 0xE8A4FBC1, 0xE8A5FBC1, 0xE8A6FBC1, 0xE8A7FBC1, 0xE8A8FBC1, 0xE8A9FBC1, 0xE8AAFBC1, 0xE8ABFBC1, 0xE8ACFBC1, 0xE8ADFBC1, 0xE8AEFBC1, 0xE8AFFBC1, 0xE8B0FBC1, 0xE8B1FBC1, 0xE8B2FBC1, 0xE8B3FBC1, 0xE8B4FBC1, 0xE8B5FBC1, 0xE8B6FBC1, 0xE8B7FBC1, 0xE8B8FBC1, 0xE8B9FBC1, 0xE8BAFBC1, 0xE8BBFBC1, 0xE8BCFBC1, 0xE8BDFBC1, 0xE8BEFBC1, 0xE8BFFBC1,
+		0xE8C0FBC1, 0xE8C1FBC1, 0xE8C2FBC1, 0xE8C3FBC1, 0xE8C4FBC1, 0xE8C5FBC1, 0xE8C6FBC1, 0xE8C7FBC1, 0xE8C8FBC1, 0xE8C9FBC1, 0xE8CAFBC1, 0xE8CBFBC1, 0xE8CCFBC1, 0xE8CDFBC1, 0xE8CEFBC1, 0xE8CFFBC1, 0xE8D0FBC1, 0xE8D1FBC1, 0xE8D2FBC1, 0xE8D3FBC1, 0xE8D4FBC1, 0xE8D5FBC1, 0xE8D6FBC1, 0xE8D7FBC1, 0xE8D8FBC1, 0xE8D9FBC1, 0xE8DAFBC1, 0xE8DBFBC1, 0xE8DCFBC1, 0xE8DDFBC1, 0xE8DEFBC1, 0xE8DFFBC1,
+		0xE8E0FBC1, 0xE8E1FBC1, 0xE8E2FBC1, 0xE8E3FBC1, 0xE8E4FBC1, 0xE8E5FBC1, 0xE8E6FBC1, 0xE8E7FBC1, 0xE8E8FBC1, 0xE8E9FBC1, 0xE8EAFBC1, 0xE8EBFBC1, 0xE8ECFBC1, 0xE8EDFBC1, 0xE8EEFBC1, 0xE8EFFBC1, 0xE8F0FBC1, 0xE8F1FBC1, 0xE8F2FBC1, 0xE8F3FBC1, 0xE8F4FBC1, 0xE8F5FBC1, 0xE8F6FBC1, 0xE8F7FBC1, 0xE8F8FBC1, 0xE8F9FBC1, 0xE8FAFBC1, 0xE8FBFBC1, 0xE8FCFBC1, 0xE8FDFBC1, 0xE8FEFBC1, 0xE8FFFBC1,
+		0xE900FBC1, 0xE901FBC1, 0xE902FBC1, 0xE903FBC1, 0xE904FBC1, 0xE905FBC1, 0xE906FBC1, 0xE907FBC1, 0xE908FBC1, 0xE909FBC1, 0xE90AFBC1, 0xE90BFBC1, 0xE90CFBC1, 0xE90DFBC1, 0xE90EFBC1, 0xE90FFBC1, 0xE910FBC1, 0xE911FBC1, 0xE912FBC1, 0xE913FBC1, 0xE914FBC1, 0xE915FBC1, 0xE916FBC1, 0xE917FBC1, 0xE918FBC1, 0xE919FBC1, 0xE91AFBC1, 0xE91BFBC1, 0xE91CFBC1, 0xE91DFBC1, 0xE91EFBC1, 0xE91FFBC1,
+		0xE920FBC1, 0xE921FBC1, 0xE922FBC1, 0xE923FBC1, 0xE924FBC1, 0xE925FBC1, 0xE926FBC1, 0xE927FBC1, 0xE928FBC1, 0xE929FBC1, 0xE92AFBC1, 0xE92BFBC1, 0xE92CFBC1, 0xE92DFBC1, 0xE92EFBC1, 0xE92FFBC1, 0xE930FBC1, 0xE931FBC1, 0xE932FBC1, 0xE933FBC1, 0xE934FBC1, 0xE935FBC1, 0xE936FBC1, 0xE937FBC1, 0xE938FBC1, 0xE939FBC1, 0xE93AFBC1, 0xE93BFBC1, 0xE93CFBC1, 0xE93DFBC1, 0xE93EFBC1, 0xE93FFBC1,
+		0xE940FBC1, 0xE941FBC1, 0xE942FBC1, 0xE943FBC1, 0xE944FBC1, 0xE945FBC1, 0xE946FBC1, 0xE947FBC1, 0xE948FBC1, 0xE949FBC1, 0xE94AFBC1, 0xE94BFBC1, 0xE94CFBC1, 0xE94DFBC1, 0xE94EFBC1, 0xE94FFBC1, 0xE950FBC1, 0xE951FBC1, 0xE952FBC1, 0xE953FBC1, 0xE954FBC1, 0xE955FBC1, 0xE956FBC1, 0xE957FBC1, 0xE958FBC1, 0xE959FBC1, 0xE95AFBC1, 0xE95BFBC1, 0xE95CFBC1, 0xE95DFBC1, 0xE95EFBC1, 0xE95FFBC1,
+		0xE960FBC1, 0xE961FBC1, 0xE962FBC1, 0xE963FBC1, 0xE964FBC1, 0xE965FBC1, 0xE966FBC1, 0xE967FBC1, 0xE968FBC1, 0xE969FBC1, 0xE96AFBC1, 0xE96BFBC1, 0xE96CFBC1, 0xE96DFBC1, 0xE96EFBC1, 0xE96FFBC1, 0xE970FBC1, 0xE971FBC1, 0xE972FBC1, 0xE973FBC1, 0xE974FBC1, 0xE975FBC1, 0xE976FBC1, 0xE977FBC1, 0xE978FBC1, 0xE979FBC1, 0xE97AFBC1, 0xE97BFBC1, 0xE97CFBC1, 0xE97DFBC1, 0xE97EFBC1, 0xE97FFBC1,
+		0xE980FBC1, 0xE981FBC1, 0xE982FBC1, 0xE983FBC1, 0xE984FBC1, 0xE985FBC1, 0xE986FBC1, 0xE987FBC1, 0xE988FBC1, 0xE989FBC1, 0xE98AFBC1, 0xE98BFBC1, 0xE98CFBC1, 0xE98DFBC1, 0xE98EFBC1, 0xE98FFBC1, 0xE990FBC1, 0xE991FBC1, 0xE992FBC1, 0xE993FBC1, 0xE994FBC1, 0xE995FBC1, 0xE996FBC1, 0xE997FBC1, 0xE998FBC1, 0xE999FBC1, 0xE99AFBC1, 0xE99BFBC1, 0xE99CFBC1, 0xE99DFBC1, 0xE99EFBC1, 0xE99FFBC1,
+		0xE9A0FBC1, 0xE9A1FBC1, 0xE9A2FBC1, 0xE9A3FBC1, 0xE9A4FBC1, 0xE9A5FBC1, 0xE9A6FBC1, 0xE9A7FBC1, 0xE9A8FBC1, 0xE9A9FBC1, 0xE9AAFBC1, 0xE9ABFBC1, 0xE9ACFBC1, 0xE9ADFBC1, 0xE9AEFBC1, 0xE9AFFBC1, 0xE9B0FBC1, 0xE9B1FBC1, 0xE9B2FBC1, 0xE9B3FBC1, 0xE9B4FBC1, 0xE9B5FBC1, 0xE9B6FBC1, 0xE9B7FBC1, 0xE9B8FBC1, 0xE9B9FBC1, 0xE9BAFBC1, 0xE9BBFBC1, 0xE9BCFBC1, 0xE9BDFBC1, 0xE9BEFBC1, 0xE9BFFBC1,
+		0xE9C0FBC1, 0xE9C1FBC1, 0xE9C2FBC1, 0xE9C3FBC1, 0xE9C4FBC1, 0xE9C5FBC1, 0xE9C6FBC1, 0xE9C7FBC1, 0xE9C8FBC1, 0xE9C9FBC1, 0xE9CAFBC1, 0xE9CBFBC1, 0xE9CCFBC1, 0xE9CDFBC1, 0xE9CEFBC1, 0xE9CFFBC1, 0xE9D0FBC1, 0xE9D1FBC1, 0xE9D2FBC1, 0xE9D3FBC1, 0xE9D4FBC1, 0xE9D5FBC1, 0xE9D6FBC1, 0xE9D7FBC1, 0xE9D8FBC1, 0xE9D9FBC1, 0xE9DAFBC1, 0xE9DBFBC1, 0xE9DCFBC1, 0xE9DDFBC1, 0xE9DEFBC1, 0xE9DFFBC1,
+		0xE9E0FBC1, 0xE9E1FBC1, 0xE9E2FBC1, 0xE9E3FBC1, 0xE9E4FBC1, 0xE9E5FBC1, 0xE9E6FBC1, 0xE9E7FBC1, 0xE9E8FBC1, 0xE9E9FBC1, 0xE9EAFBC1, 0xE9EBFBC1, 0xE9ECFBC1, 0xE9EDFBC1, 0xE9EEFBC1, 0xE9EFFBC1, 0xE9F0FBC1, 0xE9F1FBC1, 0xE9F2FBC1, 0xE9F3FBC1, 0xE9F4FBC1, 0xE9F5FBC1, 0xE9F6FBC1, 0xE9F7FBC1, 0xE9F8FBC1, 0xE9F9FBC1, 0xE9FAFBC1, 0xE9FBFBC1, 0xE9FCFBC1, 0xE9FDFBC1, 0xE9FEFBC1, 0xE9FFFBC1,
+		0xEA00FBC1, 0xEA01FBC1, 0xEA02FBC1, 0xEA03FBC1, 0xEA04FBC1, 0xEA05FBC1, 0xEA06FBC1, 0xEA07FBC1, 0xEA08FBC1, 0xEA09FBC1, 0xEA0AFBC1, 0xEA0BFBC1, 0xEA0CFBC1, 0xEA0DFBC1, 0xEA0EFBC1, 0xEA0FFBC1, 0xEA10FBC1, 0xEA11FBC1, 0xEA12FBC1, 0xEA13FBC1, 0xEA14FBC1, 0xEA15FBC1, 0xEA16FBC1, 0xEA17FBC1, 0xEA18FBC1, 0xEA19FBC1, 0xEA1AFBC1, 0xEA1BFBC1, 0xEA1CFBC1, 0xEA1DFBC1, 0xEA1EFBC1, 0xEA1FFBC1,
+		0xEA20FBC1, 0xEA21FBC1, 0xEA22FBC1, 0xEA23FBC1, 0xEA24FBC1, 0xEA25FBC1, 0xEA26FBC1, 0xEA27FBC1, 0xEA28FBC1, 0xEA29FBC1, 0xEA2AFBC1, 0xEA2BFBC1, 0xEA2CFBC1, 0xEA2DFBC1, 0xEA2EFBC1, 0xEA2FFBC1, 0xEA30FBC1, 0xEA31FBC1, 0xEA32FBC1, 0xEA33FBC1, 0xEA34FBC1, 0xEA35FBC1, 0xEA36FBC1, 0xEA37FBC1, 0xEA38FBC1, 0xEA39FBC1, 0xEA3AFBC1, 0xEA3BFBC1, 0xEA3CFBC1, 0xEA3DFBC1, 0xEA3EFBC1, 0xEA3FFBC1,
+		0xEA40FBC1, 0xEA41FBC1, 0xEA42FBC1, 0xEA43FBC1, 0xEA44FBC1, 0xEA45FBC1, 0xEA46FBC1, 0xEA47FBC1, 0xEA48FBC1, 0xEA49FBC1, 0xEA4AFBC1, 0xEA4BFBC1, 0xEA4CFBC1, 0xEA4DFBC1, 0xEA4EFBC1, 0xEA4FFBC1, 0xEA50FBC1, 0xEA51FBC1, 0xEA52FBC1, 0xEA53FBC1, 0xEA54FBC1, 0xEA55FBC1, 0xEA56FBC1, 0xEA57FBC1, 0xEA58FBC1, 0xEA59FBC1, 0xEA5AFBC1, 0xEA5BFBC1, 0xEA5CFBC1, 0xEA5DFBC1, 0xEA5EFBC1, 0xEA5FFBC1,
+		0xEA60FBC1, 0xEA61FBC1, 0xEA62FBC1, 0xEA63FBC1, 0xEA64FBC1, 0xEA65FBC1, 0xEA66FBC1, 0xEA67FBC1, 0xEA68FBC1, 0xEA69FBC1, 0xEA6AFBC1, 0xEA6BFBC1, 0xEA6CFBC1, 0xEA6DFBC1, 0xEA6EFBC1, 0xEA6FFBC1, 0xEA70FBC1, 0xEA71FBC1, 0xEA72FBC1, 0xEA73FBC1, 0xEA74FBC1, 0xEA75FBC1, 0xEA76FBC1, 0xEA77FBC1, 0xEA78FBC1, 0xEA79FBC1, 0xEA7AFBC1, 0xEA7BFBC1, 0xEA7CFBC1, 0xEA7DFBC1, 0xEA7EFBC1, 0xEA7FFBC1,
+		0xEA80FBC1, 0xEA81FBC1, 0xEA82FBC1, 0xEA83FBC1, 0xEA84FBC1, 0xEA85FBC1, 0xEA86FBC1, 0xEA87FBC1, 0xEA88FBC1, 0xEA89FBC1, 0xEA8AFBC1, 0xEA8BFBC1, 0xEA8CFBC1, 0xEA8DFBC1, 0xEA8EFBC1, 0xEA8FFBC1, 0xEA90FBC1, 0xEA91FBC1, 0xEA92FBC1, 0xEA93FBC1, 0xEA94FBC1, 0xEA95FBC1, 0xEA96FBC1, 0xEA97FBC1, 0xEA98FBC1, 0xEA99FBC1, 0xEA9AFBC1, 0xEA9BFBC1, 0xEA9CFBC1, 0xEA9DFBC1, 0xEA9EFBC1, 0xEA9FFBC1,
+		0xEAA0FBC1, 0xEAA1FBC1, 0xEAA2FBC1, 0xEAA3FBC1, 0xEAA4FBC1, 0xEAA5FBC1, 0xEAA6FBC1, 0xEAA7FBC1, 0xEAA8FBC1, 0xEAA9FBC1, 0xEAAAFBC1, 0xEAABFBC1, 0xEAACFBC1, 0xEAADFBC1, 0xEAAEFBC1, 0xEAAFFBC1, 0xEAB0FBC1, 0xEAB1FBC1, 0xEAB2FBC1, 0xEAB3FBC1, 0xEAB4FBC1, 0xEAB5FBC1, 0xEAB6FBC1, 0xEAB7FBC1, 0xEAB8FBC1, 0xEAB9FBC1, 0xEABAFBC1, 0xEABBFBC1, 0xEABCFBC1, 0xEABDFBC1, 0xEABEFBC1, 0xEABFFBC1,
+		0xEAC0FBC1, 0xEAC1FBC1, 0xEAC2FBC1, 0xEAC3FBC1, 0xEAC4FBC1, 0xEAC5FBC1, 0xEAC6FBC1, 0xEAC7FBC1, 0xEAC8FBC1, 0xEAC9FBC1, 0xEACAFBC1, 0xEACBFBC1, 0xEACCFBC1, 0xEACDFBC1, 0xEACEFBC1, 0xEACFFBC1, 0xEAD0FBC1, 0xEAD1FBC1, 0xEAD2FBC1, 0xEAD3FBC1, 0xEAD4FBC1, 0xEAD5FBC1, 0xEAD6FBC1, 0xEAD7FBC1, 0xEAD8FBC1, 0xEAD9FBC1, 0xEADAFBC1, 0xEADBFBC1, 0xEADCFBC1, 0xEADDFBC1, 0xEADEFBC1, 0xEADFFBC1,
+		0xEAE0FBC1, 0xEAE1FBC1, 0xEAE2FBC1, 0xEAE3FBC1, 0xEAE4FBC1, 0xEAE5FBC1, 0xEAE6FBC1, 0xEAE7FBC1, 0xEAE8FBC1, 0xEAE9FBC1, 0xEAEAFBC1, 0xEAEBFBC1, 0xEAECFBC1, 0xEAEDFBC1, 0xEAEEFBC1, 0xEAEFFBC1, 0xEAF0FBC1, 0xEAF1FBC1, 0xEAF2FBC1, 0xEAF3FBC1, 0xEAF4FBC1, 0xEAF5FBC1, 0xEAF6FBC1, 0xEAF7FBC1, 0xEAF8FBC1, 0xEAF9FBC1, 0xEAFAFBC1, 0xEAFBFBC1, 0xEAFCFBC1, 0xEAFDFBC1, 0xEAFEFBC1, 0xEAFFFBC1,
+		0xEB00FBC1, 0xEB01FBC1, 0xEB02FBC1, 0xEB03FBC1, 0xEB04FBC1, 0xEB05FBC1, 0xEB06FBC1, 0xEB07FBC1, 0xEB08FBC1, 0xEB09FBC1, 0xEB0AFBC1, 0xEB0BFBC1, 0xEB0CFBC1, 0xEB0DFBC1, 0xEB0EFBC1, 0xEB0FFBC1, 0xEB10FBC1, 0xEB11FBC1, 0xEB12FBC1, 0xEB13FBC1, 0xEB14FBC1, 0xEB15FBC1, 0xEB16FBC1, 0xEB17FBC1, 0xEB18FBC1, 0xEB19FBC1, 0xEB1AFBC1, 0xEB1BFBC1, 0xEB1CFBC1, 0xEB1DFBC1, 0xEB1EFBC1, 0xEB1FFBC1,
+		0xEB20FBC1, 0xEB21FBC1, 0xEB22FBC1, 0xEB23FBC1, 0xEB24FBC1, 0xEB25FBC1, 0xEB26FBC1, 0xEB27FBC1, 0xEB28FBC1, 0xEB29FBC1, 0xEB2AFBC1, 0xEB2BFBC1, 0xEB2CFBC1, 0xEB2DFBC1, 0xEB2EFBC1, 0xEB2FFBC1, 0xEB30FBC1, 0xEB31FBC1, 0xEB32FBC1, 0xEB33FBC1, 0xEB34FBC1, 0xEB35FBC1, 0xEB36FBC1, 0xEB37FBC1, 0xEB38FBC1, 0xEB39FBC1, 0xEB3AFBC1, 0xEB3BFBC1, 0xEB3CFBC1, 0xEB3DFBC1, 0xEB3EFBC1, 0xEB3FFBC1,
+		0xEB40FBC1, 0xEB41FBC1, 0xEB42FBC1, 0xEB43FBC1, 0xEB44FBC1, 0xEB45FBC1, 0xEB46FBC1, 0xEB47FBC1, 0xEB48FBC1, 0xEB49FBC1, 0xEB4AFBC1, 0xEB4BFBC1, 0xEB4CFBC1, 0xEB4DFBC1, 0xEB4EFBC1, 0xEB4FFBC1, 0xEB50FBC1, 0xEB51FBC1, 0xEB52FBC1, 0xEB53FBC1, 0xEB54FBC1, 0xEB55FBC1, 0xEB56FBC1, 0xEB57FBC1, 0xEB58FBC1, 0xEB59FBC1, 0xEB5AFBC1, 0xEB5BFBC1, 0xEB5CFBC1, 0xEB5DFBC1, 0xEB5EFBC1, 0xEB5FFBC1,
+		0xEB60FBC1, 0xEB61FBC1, 0xEB62FBC1, 0xEB63FBC1, 0xEB64FBC1, 0xEB65FBC1, 0xEB66FBC1, 0xEB67FBC1, 0xEB68FBC1, 0xEB69FBC1, 0xEB6AFBC1, 0xEB6BFBC1, 0xEB6CFBC1, 0xEB6DFBC1, 0xEB6EFBC1, 0xEB6FFBC1, 0xEB70FBC1, 0xEB71FBC1, 0xEB72FBC1, 0xEB73FBC1, 0xEB74FBC1, 0xEB75FBC1, 0xEB76FBC1, 0xEB77FBC1, 0xEB78FBC1, 0xEB79FBC1, 0xEB7AFBC1, 0xEB7BFBC1, 0xEB7CFBC1, 0xEB7DFBC1, 0xEB7EFBC1, 0xEB7FFBC1,
+		0xEB80FBC1, 0xEB81FBC1, 0xEB82FBC1, 0xEB83FBC1, 0xEB84FBC1, 0xEB85FBC1, 0xEB86FBC1, 0xEB87FBC1, 0xEB88FBC1, 0xEB89FBC1, 0xEB8AFBC1, 0xEB8BFBC1, 0xEB8CFBC1, 0xEB8DFBC1, 0xEB8EFBC1, 0xEB8FFBC1, 0xEB90FBC1, 0xEB91FBC1, 0xEB92FBC1, 0xEB93FBC1, 0xEB94FBC1, 0xEB95FBC1, 0xEB96FBC1, 0xEB97FBC1, 0xEB98FBC1, 0xEB99FBC1, 0xEB9AFBC1, 0xEB9BFBC1, 0xEB9CFBC1, 0xEB9DFBC1, 0xEB9EFBC1, 0xEB9FFBC1,
+		0xEBA0FBC1, 0xEBA1FBC1, 0xEBA2FBC1, 0xEBA3FBC1, 0xEBA4FBC1, 0xEBA5FBC1, 0xEBA6FBC1, 0xEBA7FBC1, 0xEBA8FBC1, 0xEBA9FBC1, 0xEBAAFBC1, 0xEBABFBC1, 0xEBACFBC1, 0xEBADFBC1, 0xEBAEFBC1, 0xEBAFFBC1, 0xEBB0FBC1, 0xEBB1FBC1, 0xEBB2FBC1, 0xEBB3FBC1, 0xEBB4FBC1, 0xEBB5FBC1, 0xEBB6FBC1, 0xEBB7FBC1, 0xEBB8FBC1, 0xEBB9FBC1, 0xEBBAFBC1, 0xEBBBFBC1, 0xEBBCFBC1, 0xEBBDFBC1, 0xEBBEFBC1, 0xEBBFFBC1,
+		0xEBC0FBC1, 0xEBC1FBC1, 0xEBC2FBC1, 0xEBC3FBC1, 0xEBC4FBC1, 0xEBC5FBC1, 0xEBC6FBC1, 0xEBC7FBC1, 0xEBC8FBC1, 0xEBC9FBC1, 0xEBCAFBC1, 0xEBCBFBC1, 0xEBCCFBC1, 0xEBCDFBC1, 0xEBCEFBC1, 0xEBCFFBC1, 0xEBD0FBC1, 0xEBD1FBC1, 0xEBD2FBC1, 0xEBD3FBC1, 0xEBD4FBC1, 0xEBD5FBC1, 0xEBD6FBC1, 0xEBD7FBC1, 0xEBD8FBC1, 0xEBD9FBC1, 0xEBDAFBC1, 0xEBDBFBC1, 0xEBDCFBC1, 0xEBDDFBC1, 0xEBDEFBC1, 0xEBDFFBC1,
+		0xEBE0FBC1, 0xEBE1FBC1, 0xEBE2FBC1, 0xEBE3FBC1, 0xEBE4FBC1, 0xEBE5FBC1, 0xEBE6FBC1, 0xEBE7FBC1, 0xEBE8FBC1, 0xEBE9FBC1, 0xEBEAFBC1, 0xEBEBFBC1, 0xEBECFBC1, 0xEBEDFBC1, 0xEBEEFBC1, 0xEBEFFBC1, 0xEBF0FBC1, 0xEBF1FBC1, 0xEBF2FBC1, 0xEBF3FBC1, 0xEBF4FBC1, 0xEBF5FBC1, 0xEBF6FBC1, 0xEBF7FBC1, 0xEBF8FBC1, 0xEBF9FBC1, 0xEBFAFBC1, 0xEBFBFBC1, 0xEBFCFBC1, 0xEBFDFBC1, 0xEBFEFBC1, 0xEBFFFBC1,
+		0xEC00FBC1, 0xEC01FBC1, 0xEC02FBC1, 0xEC03FBC1, 0xEC04FBC1, 0xEC05FBC1, 0xEC06FBC1, 0xEC07FBC1, 0xEC08FBC1, 0xEC09FBC1, 0xEC0AFBC1, 0xEC0BFBC1, 0xEC0CFBC1, 0xEC0DFBC1, 0xEC0EFBC1, 0xEC0FFBC1, 0xEC10FBC1, 0xEC11FBC1, 0xEC12FBC1, 0xEC13FBC1, 0xEC14FBC1, 0xEC15FBC1, 0xEC16FBC1, 0xEC17FBC1, 0xEC18FBC1, 0xEC19FBC1, 0xEC1AFBC1, 0xEC1BFBC1, 0xEC1CFBC1, 0xEC1DFBC1, 0xEC1EFBC1, 0xEC1FFBC1,
+		0xEC20FBC1, 0xEC21FBC1, 0xEC22FBC1, 0xEC23FBC1, 0xEC24FBC1, 0xEC25FBC1, 0xEC26FBC1, 0xEC27FBC1, 0xEC28FBC1, 0xEC29FBC1, 0xEC2AFBC1, 0xEC2BFBC1, 0xEC2CFBC1, 0xEC2DFBC1, 0xEC2EFBC1, 0xEC2FFBC1, 0xEC30FBC1, 0xEC31FBC1, 0xEC32FBC1, 0xEC33FBC1, 0xEC34FBC1, 0xEC35FBC1, 0xEC36FBC1, 0xEC37FBC1, 0xEC38FBC1, 0xEC39FBC1, 0xEC3AFBC1, 0xEC3BFBC1, 0xEC3CFBC1, 0xEC3DFBC1, 0xEC3EFBC1, 0xEC3FFBC1,
+		0xEC40FBC1, 0xEC41FBC1, 0xEC42FBC1, 0xEC43FBC1, 0xEC44FBC1, 0xEC45FBC1, 0xEC46FBC1, 0xEC47FBC1, 0xEC48FBC1, 0xEC49FBC1, 0xEC4AFBC1, 0xEC4BFBC1, 0xEC4CFBC1, 0xEC4DFBC1, 0xEC4EFBC1, 0xEC4FFBC1, 0xEC50FBC1, 0xEC51FBC1, 0xEC52FBC1, 0xEC53FBC1, 0xEC54FBC1, 0xEC55FBC1, 0xEC56FBC1, 0xEC57FBC1, 0xEC58FBC1, 0xEC59FBC1, 0xEC5AFBC1, 0xEC5BFBC1, 0xEC5CFBC1, 0xEC5DFBC1, 0xEC5EFBC1, 0xEC5FFBC1,
+		0xEC60FBC1, 0xEC61FBC1, 0xEC62FBC1, 0xEC63FBC1, 0xEC64FBC1, 0xEC65FBC1, 0xEC66FBC1, 0xEC67FBC1, 0xEC68FBC1, 0xEC69FBC1, 0xEC6AFBC1, 0xEC6BFBC1, 0xEC6CFBC1, 0xEC6DFBC1, 0xEC6EFBC1, 0xEC6FFBC1, 0xEC70FBC1, 0xEC71FBC1, 0xEC72FBC1, 0xEC73FBC1, 0xEC74FBC1, 0xEC75FBC1, 0xEC76FBC1, 0xEC77FBC1, 0xEC78FBC1, 0xEC79FBC1, 0xEC7AFBC1, 0xEC7BFBC1, 0xEC7CFBC1, 0xEC7DFBC1, 0xEC7EFBC1, 0xEC7FFBC1,
+		0xEC80FBC1, 0xEC81FBC1, 0xEC82FBC1, 0xEC83FBC1, 0xEC84FBC1, 0xEC85FBC1, 0xEC86FBC1, 0xEC87FBC1, 0xEC88FBC1, 0xEC89FBC1, 0xEC8AFBC1, 0xEC8BFBC1, 0xEC8CFBC1, 0xEC8DFBC1, 0xEC8EFBC1, 0xEC8FFBC1, 0xEC90FBC1, 0xEC91FBC1, 0xEC92FBC1, 0xEC93FBC1, 0xEC94FBC1, 0xEC95FBC1, 0xEC96FBC1, 0xEC97FBC1, 0xEC98FBC1, 0xEC99FBC1, 0xEC9AFBC1, 0xEC9BFBC1, 0xEC9CFBC1, 0xEC9DFBC1, 0xEC9EFBC1, 0xEC9FFBC1,
+		0xECA0FBC1, 0xECA1FBC1, 0xECA2FBC1, 0xECA3FBC1, 0xECA4FBC1, 0xECA5FBC1, 0xECA6FBC1, 0xECA7FBC1, 0xECA8FBC1, 0xECA9FBC1, 0xECAAFBC1, 0xECABFBC1, 0xECACFBC1, 0xECADFBC1, 0xECAEFBC1, 0xECAFFBC1, 0xECB0FBC1, 0xECB1FBC1, 0xECB2FBC1, 0xECB3FBC1, 0xECB4FBC1, 0xECB5FBC1, 0xECB6FBC1, 0xECB7FBC1, 0xECB8FBC1, 0xECB9FBC1, 0xECBAFBC1, 0xECBBFBC1, 0xECBCFBC1, 0xECBDFBC1, 0xECBEFBC1, 0xECBFFBC1,
+		0xECC0FBC1, 0xECC1FBC1, 0xECC2FBC1, 0xECC3FBC1, 0xECC4FBC1, 0xECC5FBC1, 0xECC6FBC1, 0xECC7FBC1, 0xECC8FBC1, 0xECC9FBC1, 0xECCAFBC1, 0xECCBFBC1, 0xECCCFBC1, 0xECCDFBC1, 0xECCEFBC1, 0xECCFFBC1, 0xECD0FBC1, 0xECD1FBC1, 0xECD2FBC1, 0xECD3FBC1, 0xECD4FBC1, 0xECD5FBC1, 0xECD6FBC1, 0xECD7FBC1, 0xECD8FBC1, 0xECD9FBC1, 0xECDAFBC1, 0xECDBFBC1, 0xECDCFBC1, 0xECDDFBC1, 0xECDEFBC1, 0xECDFFBC1,
+		0xECE0FBC1, 0xECE1FBC1, 0xECE2FBC1, 0xECE3FBC1, 0xECE4FBC1, 0xECE5FBC1, 0xECE6FBC1, 0xECE7FBC1, 0xECE8FBC1, 0xECE9FBC1, 0xECEAFBC1, 0xECEBFBC1, 0xECECFBC1, 0xECEDFBC1, 0xECEEFBC1, 0xECEFFBC1, 0xECF0FBC1, 0xECF1FBC1, 0xECF2FBC1, 0xECF3FBC1, 0xECF4FBC1, 0xECF5FBC1, 0xECF6FBC1, 0xECF7FBC1, 0xECF8FBC1, 0xECF9FBC1, 0xECFAFBC1, 0xECFBFBC1, 0xECFCFBC1, 0xECFDFBC1, 0xECFEFBC1, 0xECFFFBC1,
+		0xED00FBC1, 0xED01FBC1, 0xED02FBC1, 0xED03FBC1, 0xED04FBC1, 0xED05FBC1, 0xED06FBC1, 0xED07FBC1, 0xED08FBC1, 0xED09FBC1, 0xED0AFBC1, 0xED0BFBC1, 0xED0CFBC1, 0xED0DFBC1, 0xED0EFBC1, 0xED0FFBC1, 0xED10FBC1, 0xED11FBC1, 0xED12FBC1, 0xED13FBC1, 0xED14FBC1, 0xED15FBC1, 0xED16FBC1, 0xED17FBC1, 0xED18FBC1, 0xED19FBC1, 0xED1AFBC1, 0xED1BFBC1, 0xED1CFBC1, 0xED1DFBC1, 0xED1EFBC1, 0xED1FFBC1,
+		0xED20FBC1, 0xED21FBC1, 0xED22FBC1, 0xED23FBC1, 0xED24FBC1, 0xED25FBC1, 0xED26FBC1, 0xED27FBC1, 0xED28FBC1, 0xED29FBC1, 0xED2AFBC1, 0xED2BFBC1, 0xED2CFBC1, 0xED2DFBC1, 0xED2EFBC1, 0xED2FFBC1, 0xED30FBC1, 0xED31FBC1, 0xED32FBC1, 0xED33FBC1, 0xED34FBC1, 0xED35FBC1, 0xED36FBC1, 0xED37FBC1, 0xED38FBC1, 0xED39FBC1, 0xED3AFBC1, 0xED3BFBC1, 0xED3CFBC1, 0xED3DFBC1, 0xED3EFBC1, 0xED3FFBC1,
+		0xED40FBC1, 0xED41FBC1, 0xED42FBC1, 0xED43FBC1, 0xED44FBC1, 0xED45FBC1, 0xED46FBC1, 0xED47FBC1, 0xED48FBC1, 0xED49FBC1, 0xED4AFBC1, 0xED4BFBC1, 0xED4CFBC1, 0xED4DFBC1, 0xED4EFBC1, 0xED4FFBC1, 0xED50FBC1, 0xED51FBC1, 0xED52FBC1, 0xED53FBC1, 0xED54FBC1, 0xED55FBC1, 0xED56FBC1, 0xED57FBC1, 0xED58FBC1, 0xED59FBC1, 0xED5AFBC1, 0xED5BFBC1, 0xED5CFBC1, 0xED5DFBC1, 0xED5EFBC1, 0xED5FFBC1,
+		0xED60FBC1, 0xED61FBC1, 0xED62FBC1, 0xED63FBC1, 0xED64FBC1, 0xED65FBC1, 0xED66FBC1, 0xED67FBC1, 0xED68FBC1, 0xED69FBC1, 0xED6AFBC1, 0xED6BFBC1, 0xED6CFBC1, 0xED6DFBC1, 0xED6EFBC1, 0xED6FFBC1, 0xED70FBC1, 0xED71FBC1, 0xED72FBC1, 0xED73FBC1, 0xED74FBC1, 0xED75FBC1, 0xED76FBC1, 0xED77FBC1, 0xED78FBC1, 0xED79FBC1, 0xED7AFBC1, 0xED7BFBC1, 0xED7CFBC1, 0xED7DFBC1, 0xED7EFBC1, 0xED7FFBC1,
+		0xED80FBC1, 0xED81FBC1, 0xED82FBC1, 0xED83FBC1, 0xED84FBC1, 0xED85FBC1, 0xED86FBC1, 0xED87FBC1, 0xED88FBC1, 0xED89FBC1, 0xED8AFBC1, 0xED8BFBC1, 0xED8CFBC1, 0xED8DFBC1, 0xED8EFBC1, 0xED8FFBC1, 0xED90FBC1, 0xED91FBC1, 0xED92FBC1, 0xED93FBC1, 0xED94FBC1, 0xED95FBC1, 0xED96FBC1, 0xED97FBC1, 0xED98FBC1, 0xED99FBC1, 0xED9AFBC1, 0xED9BFBC1, 0xED9CFBC1, 0xED9DFBC1, 0xED9EFBC1, 0xED9FFBC1,
+		0xEDA0FBC1, 0xEDA1FBC1, 0xEDA2FBC1, 0xEDA3FBC1, 0xEDA4FBC1, 0xEDA5FBC1, 0xEDA6FBC1, 0xEDA7FBC1, 0xEDA8FBC1, 0xEDA9FBC1, 0xEDAAFBC1, 0xEDABFBC1, 0xEDACFBC1, 0xEDADFBC1, 0xEDAEFBC1, 0xEDAFFBC1, 0xEDB0FBC1, 0xEDB1FBC1, 0xEDB2FBC1, 0xEDB3FBC1, 0xEDB4FBC1, 0xEDB5FBC1, 0xEDB6FBC1, 0xEDB7FBC1, 0xEDB8FBC1, 0xEDB9FBC1, 0xEDBAFBC1, 0xEDBBFBC1, 0xEDBCFBC1, 0xEDBDFBC1, 0xEDBEFBC1, 0xEDBFFBC1,
+		0xEDC0FBC1, 0xEDC1FBC1, 0xEDC2FBC1, 0xEDC3FBC1, 0xEDC4FBC1, 0xEDC5FBC1, 0xEDC6FBC1, 0xEDC7FBC1, 0xEDC8FBC1, 0xEDC9FBC1, 0xEDCAFBC1, 0xEDCBFBC1, 0xEDCCFBC1, 0xEDCDFBC1, 0xEDCEFBC1, 0xEDCFFBC1, 0xEDD0FBC1, 0xEDD1FBC1, 0xEDD2FBC1, 0xEDD3FBC1, 0xEDD4FBC1, 0xEDD5FBC1, 0xEDD6FBC1, 0xEDD7FBC1, 0xEDD8FBC1, 0xEDD9FBC1, 0xEDDAFBC1, 0xEDDBFBC1, 0xEDDCFBC1, 0xEDDDFBC1, 0xEDDEFBC1, 0xEDDFFBC1,
+		0xEDE0FBC1, 0xEDE1FBC1, 0xEDE2FBC1, 0xEDE3FBC1, 0xEDE4FBC1, 0xEDE5FBC1, 0xEDE6FBC1, 0xEDE7FBC1, 0xEDE8FBC1, 0xEDE9FBC1, 0xEDEAFBC1, 0xEDEBFBC1, 0xEDECFBC1, 0xEDEDFBC1, 0xEDEEFBC1, 0xEDEFFBC1, 0xEDF0FBC1, 0xEDF1FBC1, 0xEDF2FBC1, 0xEDF3FBC1, 0xEDF4FBC1, 0xEDF5FBC1, 0xEDF6FBC1, 0xEDF7FBC1, 0xEDF8FBC1, 0xEDF9FBC1, 0xEDFAFBC1, 0xEDFBFBC1, 0xEDFCFBC1, 0xEDFDFBC1, 0xEDFEFBC1, 0xEDFFFBC1,
+		0xEE00FBC1, 0xEE01FBC1, 0xEE02FBC1, 0xEE03FBC1, 0xEE04FBC1, 0xEE05FBC1, 0xEE06FBC1, 0xEE07FBC1, 0xEE08FBC1, 0xEE09FBC1, 0xEE0AFBC1, 0xEE0BFBC1, 0xEE0CFBC1, 0xEE0DFBC1, 0xEE0EFBC1, 0xEE0FFBC1, 0xEE10FBC1, 0xEE11FBC1, 0xEE12FBC1, 0xEE13FBC1, 0xEE14FBC1, 0xEE15FBC1, 0xEE16FBC1, 0xEE17FBC1, 0xEE18FBC1, 0xEE19FBC1, 0xEE1AFBC1, 0xEE1BFBC1, 0xEE1CFBC1, 0xEE1DFBC1, 0xEE1EFBC1, 0xEE1FFBC1,
+		0xEE20FBC1, 0xEE21FBC1, 0xEE22FBC1, 0xEE23FBC1, 0xEE24FBC1, 0xEE25FBC1, 0xEE26FBC1, 0xEE27FBC1, 0xEE28FBC1, 0xEE29FBC1, 0xEE2AFBC1, 0xEE2BFBC1, 0xEE2CFBC1, 0xEE2DFBC1, 0xEE2EFBC1, 0xEE2FFBC1, 0xEE30FBC1, 0xEE31FBC1, 0xEE32FBC1, 0xEE33FBC1, 0xEE34FBC1, 0xEE35FBC1, 0xEE36FBC1, 0xEE37FBC1, 0xEE38FBC1, 0xEE39FBC1, 0xEE3AFBC1, 0xEE3BFBC1, 0xEE3CFBC1, 0xEE3DFBC1, 0xEE3EFBC1, 0xEE3FFBC1,
+		0xEE40FBC1, 0xEE41FBC1, 0xEE42FBC1, 0xEE43FBC1, 0xEE44FBC1, 0xEE45FBC1, 0xEE46FBC1, 0xEE47FBC1, 0xEE48FBC1, 0xEE49FBC1, 0xEE4AFBC1, 0xEE4BFBC1, 0xEE4CFBC1, 0xEE4DFBC1, 0xEE4EFBC1, 0xEE4FFBC1, 0xEE50FBC1, 0xEE51FBC1, 0xEE52FBC1, 0xEE53FBC1, 0xEE54FBC1, 0xEE55FBC1, 0xEE56FBC1, 0xEE57FBC1, 0xEE58FBC1, 0xEE59FBC1, 0xEE5AFBC1, 0xEE5BFBC1, 0xEE5CFBC1, 0xEE5DFBC1, 0xEE5EFBC1, 0xEE5FFBC1,
+		0xEE60FBC1, 0xEE61FBC1, 0xEE62FBC1, 0xEE63FBC1, 0xEE64FBC1, 0xEE65FBC1, 0xEE66FBC1, 0xEE67FBC1, 0xEE68FBC1, 0xEE69FBC1, 0xEE6AFBC1, 0xEE6BFBC1, 0xEE6CFBC1, 0xEE6DFBC1, 0xEE6EFBC1, 0xEE6FFBC1, 0xEE70FBC1, 0xEE71FBC1, 0xEE72FBC1, 0xEE73FBC1, 0xEE74FBC1, 0xEE75FBC1, 0xEE76FBC1, 0xEE77FBC1, 0xEE78FBC1, 0xEE79FBC1, 0xEE7AFBC1, 0xEE7BFBC1, 0xEE7CFBC1, 0xEE7DFBC1, 0xEE7EFBC1, 0xEE7FFBC1,
+		0xEE80FBC1, 0xEE81FBC1, 0xEE82FBC1, 0xEE83FBC1, 0xEE84FBC1, 0xEE85FBC1, 0xEE86FBC1, 0xEE87FBC1, 0xEE88FBC1, 0xEE89FBC1, 0xEE8AFBC1, 0xEE8BFBC1, 0xEE8CFBC1, 0xEE8DFBC1, 0xEE8EFBC1, 0xEE8FFBC1, 0xEE90FBC1, 0xEE91FBC1, 0xEE92FBC1, 0xEE93FBC1, 0xEE94FBC1, 0xEE95FBC1, 0xEE96FBC1, 0xEE97FBC1, 0xEE98FBC1, 0xEE99FBC1, 0xEE9AFBC1, 0xEE9BFBC1, 0xEE9CFBC1, 0xEE9DFBC1, 0xEE9EFBC1, 0xEE9FFBC1,
+		0xEEA0FBC1, 0xEEA1FBC1, 0xEEA2FBC1, 0xEEA3FBC1, 0xEEA4FBC1, 0xEEA5FBC1, 0xEEA6FBC1, 0xEEA7FBC1, 0xEEA8FBC1, 0xEEA9FBC1, 0xEEAAFBC1, 0xEEABFBC1, 0xEEACFBC1, 0xEEADFBC1, 0xEEAEFBC1, 0xEEAFFBC1, 0xEEB0FBC1, 0xEEB1FBC1, 0xEEB2FBC1, 0xEEB3FBC1, 0xEEB4FBC1, 0xEEB5FBC1, 0xEEB6FBC1, 0xEEB7FBC1, 0xEEB8FBC1, 0xEEB9FBC1, 0xEEBAFBC1, 0xEEBBFBC1, 0xEEBCFBC1, 0xEEBDFBC1, 0xEEBEFBC1, 0xEEBFFBC1,
+		0xEEC0FBC1, 0xEEC1FBC1, 0xEEC2FBC1, 0xEEC3FBC1, 0xEEC4FBC1, 0xEEC5FBC1, 0xEEC6FBC1, 0xEEC7FBC1, 0xEEC8FBC1, 0xEEC9FBC1, 0xEECAFBC1, 0xEECBFBC1, 0xEECCFBC1, 0xEECDFBC1, 0xEECEFBC1, 0xEECFFBC1, 0xEED0FBC1, 0xEED1FBC1, 0xEED2FBC1, 0xEED3FBC1, 0xEED4FBC1, 0xEED5FBC1, 0xEED6FBC1, 0xEED7FBC1, 0xEED8FBC1, 0xEED9FBC1, 0xEEDAFBC1, 0xEEDBFBC1, 0xEEDCFBC1, 0xEEDDFBC1, 0xEEDEFBC1, 0xEEDFFBC1,
+		0xEEE0FBC1, 0xEEE1FBC1, 0xEEE2FBC1, 0xEEE3FBC1, 0xEEE4FBC1, 0xEEE5FBC1, 0xEEE6FBC1, 0xEEE7FBC1, 0xEEE8FBC1, 0xEEE9FBC1, 0xEEEAFBC1, 0xEEEBFBC1, 0xEEECFBC1, 0xEEEDFBC1, 0xEEEEFBC1, 0xEEEFFBC1, 0xEEF0FBC1, 0xEEF1FBC1, 0xEEF2FBC1, 0xEEF3FBC1, 0xEEF4FBC1, 0xEEF5FBC1, 0xEEF6FBC1, 0xEEF7FBC1, 0xEEF8FBC1, 0xEEF9FBC1, 0xEEFAFBC1, 0xEEFBFBC1, 0xEEFCFBC1, 0xEEFDFBC1, 0xEEFEFBC1, 0xEEFFFBC1,
+		0xEF00FBC1, 0xEF01FBC1, 0xEF02FBC1, 0xEF03FBC1, 0xEF04FBC1, 0xEF05FBC1, 0xEF06FBC1, 0xEF07FBC1, 0xEF08FBC1, 0xEF09FBC1, 0xEF0AFBC1, 0xEF0BFBC1, 0xEF0CFBC1, 0xEF0DFBC1, 0xEF0EFBC1, 0xEF0FFBC1, 0xEF10FBC1, 0xEF11FBC1, 0xEF12FBC1, 0xEF13FBC1, 0xEF14FBC1, 0xEF15FBC1, 0xEF16FBC1, 0xEF17FBC1, 0xEF18FBC1, 0xEF19FBC1, 0xEF1AFBC1, 0xEF1BFBC1, 0xEF1CFBC1, 0xEF1DFBC1, 0xEF1EFBC1, 0xEF1FFBC1,
+		0xEF20FBC1, 0xEF21FBC1, 0xEF22FBC1, 0xEF23FBC1, 0xEF24FBC1, 0xEF25FBC1, 0xEF26FBC1, 0xEF27FBC1, 0xEF28FBC1, 0xEF29FBC1, 0xEF2AFBC1, 0xEF2BFBC1, 0xEF2CFBC1, 0xEF2DFBC1, 0xEF2EFBC1, 0xEF2FFBC1, 0xEF30FBC1, 0xEF31FBC1, 0xEF32FBC1, 0xEF33FBC1, 0xEF34FBC1, 0xEF35FBC1, 0xEF36FBC1, 0xEF37FBC1, 0xEF38FBC1, 0xEF39FBC1, 0xEF3AFBC1, 0xEF3BFBC1, 0xEF3CFBC1, 0xEF3DFBC1, 0xEF3EFBC1, 0xEF3FFBC1,
+		0xEF40FBC1, 0xEF41FBC1, 0xEF42FBC1, 0xEF43FBC1, 0xEF44FBC1, 0xEF45FBC1, 0xEF46FBC1, 0xEF47FBC1, 0xEF48FBC1, 0xEF49FBC1, 0xEF4AFBC1, 0xEF4BFBC1, 0xEF4CFBC1, 0xEF4DFBC1, 0xEF4EFBC1, 0xEF4FFBC1, 0xEF50FBC1, 0xEF51FBC1, 0xEF52FBC1, 0xEF53FBC1, 0xEF54FBC1, 0xEF55FBC1, 0xEF56FBC1, 0xEF57FBC1, 0xEF58FBC1, 0xEF59FBC1, 0xEF5AFBC1, 0xEF5BFBC1, 0xEF5CFBC1, 0xEF5DFBC1, 0xEF5EFBC1, 0xEF5FFBC1,
+		0xEF60FBC1, 0xEF61FBC1, 0xEF62FBC1, 0xEF63FBC1, 0xEF64FBC1, 0xEF65FBC1, 0xEF66FBC1, 0xEF67FBC1, 0xEF68FBC1, 0xEF69FBC1, 0xEF6AFBC1, 0xEF6BFBC1, 0xEF6CFBC1, 0xEF6DFBC1, 0xEF6EFBC1, 0xEF6FFBC1, 0xEF70FBC1, 0xEF71FBC1, 0xEF72FBC1, 0xEF73FBC1, 0xEF74FBC1, 0xEF75FBC1, 0xEF76FBC1, 0xEF77FBC1, 0xEF78FBC1, 0xEF79FBC1, 0xEF7AFBC1, 0xEF7BFBC1, 0xEF7CFBC1, 0xEF7DFBC1, 0xEF7EFBC1, 0xEF7FFBC1,
+		0xEF80FBC1, 0xEF81FBC1, 0xEF82FBC1, 0xEF83FBC1, 0xEF84FBC1, 0xEF85FBC1, 0xEF86FBC1, 0xEF87FBC1, 0xEF88FBC1, 0xEF89FBC1, 0xEF8AFBC1, 0xEF8BFBC1, 0xEF8CFBC1, 0xEF8DFBC1, 0xEF8EFBC1, 0xEF8FFBC1, 0xEF90FBC1, 0xEF91FBC1, 0xEF92FBC1, 0xEF93FBC1, 0xEF94FBC1, 0xEF95FBC1, 0xEF96FBC1, 0xEF97FBC1, 0xEF98FBC1, 0xEF99FBC1, 0xEF9AFBC1, 0xEF9BFBC1, 0xEF9CFBC1, 0xEF9DFBC1, 0xEF9EFBC1, 0xEF9FFBC1,
+		0xEFA0FBC1, 0xEFA1FBC1, 0xEFA2FBC1, 0xEFA3FBC1, 0xEFA4FBC1, 0xEFA5FBC1, 0xEFA6FBC1, 0xEFA7FBC1, 0xEFA8FBC1, 0xEFA9FBC1, 0xEFAAFBC1, 0xEFABFBC1, 0xEFACFBC1, 0xEFADFBC1, 0xEFAEFBC1, 0xEFAFFBC1, 0xEFB0FBC1, 0xEFB1FBC1, 0xEFB2FBC1, 0xEFB3FBC1, 0xEFB4FBC1, 0xEFB5FBC1, 0xEFB6FBC1, 0xEFB7FBC1, 0xEFB8FBC1, 0xEFB9FBC1, 0xEFBAFBC1, 0xEFBBFBC1, 0xEFBCFBC1, 0xEFBDFBC1, 0xEFBEFBC1, 0xEFBFFBC1,
+		0xEFC0FBC1, 0xEFC1FBC1, 0xEFC2FBC1, 0xEFC3FBC1, 0xEFC4FBC1, 0xEFC5FBC1, 0xEFC6FBC1, 0xEFC7FBC1, 0xEFC8FBC1, 0xEFC9FBC1, 0xEFCAFBC1, 0xEFCBFBC1, 0xEFCCFBC1, 0xEFCDFBC1, 0xEFCEFBC1, 0xEFCFFBC1, 0xEFD0FBC1, 0xEFD1FBC1, 0xEFD2FBC1, 0xEFD3FBC1, 0xEFD4FBC1, 0xEFD5FBC1, 0xEFD6FBC1, 0xEFD7FBC1, 0xEFD8FBC1, 0xEFD9FBC1, 0xEFDAFBC1, 0xEFDBFBC1, 0xEFDCFBC1, 0xEFDDFBC1, 0xEFDEFBC1, 0xEFDFFBC1,
+		0xEFE0FBC1, 0xEFE1FBC1, 0xEFE2FBC1, 0xEFE3FBC1, 0xEFE4FBC1, 0xEFE5FBC1, 0xEFE6FBC1, 0xEFE7FBC1, 0xEFE8FBC1, 0xEFE9FBC1, 0xEFEAFBC1, 0xEFEBFBC1, 0xEFECFBC1, 0xEFEDFBC1, 0xEFEEFBC1, 0xEFEFFBC1, 0xEFF0FBC1, 0xEFF1FBC1, 0xEFF2FBC1, 0xEFF3FBC1, 0xEFF4FBC1, 0xEFF5FBC1, 0xEFF6FBC1, 0xEFF7FBC1, 0xEFF8FBC1, 0xEFF9FBC1, 0xEFFAFBC1, 0xEFFBFBC1, 0xEFFCFBC1, 0xEFFDFBC1, 0xEFFEFBC1, 0xEFFFFBC1,
+		0xF000FBC1, 0xF001FBC1, 0xF002FBC1, 0xF003FBC1, 0xF004FBC1, 0xF005FBC1, 0xF006FBC1, 0xF007FBC1, 0xF008FBC1, 0xF009FBC1, 0xF00AFBC1, 0xF00BFBC1, 0xF00CFBC1, 0xF00DFBC1, 0xF00EFBC1, 0xF00FFBC1, 0xF010FBC1, 0xF011FBC1, 0xF012FBC1, 0xF013FBC1, 0xF014FBC1, 0xF015FBC1, 0xF016FBC1, 0xF017FBC1, 0xF018FBC1, 0xF019FBC1, 0xF01AFBC1, 0xF01BFBC1, 0xF01CFBC1, 0xF01DFBC1, 0xF01EFBC1, 0xF01FFBC1,
+		0xF020FBC1, 0xF021FBC1, 0xF022FBC1, 0xF023FBC1, 0xF024FBC1, 0xF025FBC1, 0xF026FBC1, 0xF027FBC1, 0xF028FBC1, 0xF029FBC1, 0xF02AFBC1, 0xF02BFBC1, 0xF02CFBC1, 0xF02DFBC1, 0xF02EFBC1, 0xF02FFBC1, 0xF030FBC1, 0xF031FBC1, 0xF032FBC1, 0xF033FBC1, 0xF034FBC1, 0xF035FBC1, 0xF036FBC1, 0xF037FBC1, 0xF038FBC1, 0xF039FBC1, 0xF03AFBC1, 0xF03BFBC1, 0xF03CFBC1, 0xF03DFBC1, 0xF03EFBC1, 0xF03FFBC1,
+		0xF040FBC1, 0xF041FBC1, 0xF042FBC1, 0xF043FBC1, 0xF044FBC1, 0xF045FBC1, 0xF046FBC1, 0xF047FBC1, 0xF048FBC1, 0xF049FBC1, 0xF04AFBC1, 0xF04BFBC1, 0xF04CFBC1, 0xF04DFBC1, 0xF04EFBC1, 0xF04FFBC1, 0xF050FBC1, 0xF051FBC1, 0xF052FBC1, 0xF053FBC1, 0xF054FBC1, 0xF055FBC1, 0xF056FBC1, 0xF057FBC1, 0xF058FBC1, 0xF059FBC1, 0xF05AFBC1, 0xF05BFBC1, 0xF05CFBC1, 0xF05DFBC1, 0xF05EFBC1, 0xF05FFBC1,
+		0xF060FBC1, 0xF061FBC1, 0xF062FBC1, 0xF063FBC1, 0xF064FBC1, 0xF065FBC1, 0xF066FBC1, 0xF067FBC1, 0xF068FBC1, 0xF069FBC1, 0xF06AFBC1, 0xF06BFBC1, 0xF06CFBC1, 0xF06DFBC1, 0xF06EFBC1, 0xF06FFBC1, 0xF070FBC1, 0xF071FBC1, 0xF072FBC1, 0xF073FBC1, 0xF074FBC1, 0xF075FBC1, 0xF076FBC1, 0xF077FBC1, 0xF078FBC1, 0xF079FBC1, 0xF07AFBC1, 0xF07BFBC1, 0xF07CFBC1, 0xF07DFBC1, 0xF07EFBC1, 0xF07FFBC1,
+		0xF080FBC1, 0xF081FBC1, 0xF082FBC1, 0xF083FBC1, 0xF084FBC1, 0xF085FBC1, 0xF086FBC1, 0xF087FBC1, 0xF088FBC1, 0xF089FBC1, 0xF08AFBC1, 0xF08BFBC1, 0xF08CFBC1, 0xF08DFBC1, 0xF08EFBC1, 0xF08FFBC1, 0xF090FBC1, 0xF091FBC1, 0xF092FBC1, 0xF093FBC1, 0xF094FBC1, 0xF095FBC1, 0xF096FBC1, 0xF097FBC1, 0xF098FBC1, 0xF099FBC1, 0xF09AFBC1, 0xF09BFBC1, 0xF09CFBC1, 0xF09DFBC1, 0xF09EFBC1, 0xF09FFBC1,
+		0xF0A0FBC1, 0xF0A1FBC1, 0xF0A2FBC1, 0xF0A3FBC1, 0xF0A4FBC1, 0xF0A5FBC1, 0xF0A6FBC1, 0xF0A7FBC1, 0xF0A8FBC1, 0xF0A9FBC1, 0xF0AAFBC1, 0xF0ABFBC1, 0xF0ACFBC1, 0xF0ADFBC1, 0xF0AEFBC1, 0xF0AFFBC1, 0xF0B0FBC1, 0xF0B1FBC1, 0xF0B2FBC1, 0xF0B3FBC1, 0xF0B4FBC1, 0xF0B5FBC1, 0xF0B6FBC1, 0xF0B7FBC1, 0xF0B8FBC1, 0xF0B9FBC1, 0xF0BAFBC1, 0xF0BBFBC1, 0xF0BCFBC1, 0xF0BDFBC1, 0xF0BEFBC1, 0xF0BFFBC1,
+		0xF0C0FBC1, 0xF0C1FBC1, 0xF0C2FBC1, 0xF0C3FBC1, 0xF0C4FBC1, 0xF0C5FBC1, 0xF0C6FBC1, 0xF0C7FBC1, 0xF0C8FBC1, 0xF0C9FBC1, 0xF0CAFBC1, 0xF0CBFBC1, 0xF0CCFBC1, 0xF0CDFBC1, 0xF0CEFBC1, 0xF0CFFBC1, 0xF0D0FBC1, 0xF0D1FBC1, 0xF0D2FBC1, 0xF0D3FBC1, 0xF0D4FBC1, 0xF0D5FBC1, 0xF0D6FBC1, 0xF0D7FBC1, 0xF0D8FBC1, 0xF0D9FBC1, 0xF0DAFBC1, 0xF0DBFBC1, 0xF0DCFBC1, 0xF0DDFBC1, 0xF0DEFBC1, 0xF0DFFBC1,
+		0xF0E0FBC1, 0xF0E1FBC1, 0xF0E2FBC1, 0xF0E3FBC1, 0xF0E4FBC1, 0xF0E5FBC1, 0xF0E6FBC1, 0xF0E7FBC1, 0xF0E8FBC1, 0xF0E9FBC1, 0xF0EAFBC1, 0xF0EBFBC1, 0xF0ECFBC1, 0xF0EDFBC1, 0xF0EEFBC1, 0xF0EFFBC1, 0xF0F0FBC1, 0xF0F1FBC1, 0xF0F2FBC1, 0xF0F3FBC1, 0xF0F4FBC1, 0xF0F5FBC1, 0xF0F6FBC1, 0xF0F7FBC1, 0xF0F8FBC1, 0xF0F9FBC1, 0xF0FAFBC1, 0xF0FBFBC1, 0xF0FCFBC1, 0xF0FDFBC1, 0xF0FEFBC1, 0xF0FFFBC1,
+		0xF100FBC1, 0xF101FBC1, 0xF102FBC1, 0xF103FBC1, 0xF104FBC1, 0xF105FBC1, 0xF106FBC1, 0xF107FBC1, 0xF108FBC1, 0xF109FBC1, 0xF10AFBC1, 0xF10BFBC1, 0xF10CFBC1, 0xF10DFBC1, 0xF10EFBC1, 0xF10FFBC1, 0xF110FBC1, 0xF111FBC1, 0xF112FBC1, 0xF113FBC1, 0xF114FBC1, 0xF115FBC1, 0xF116FBC1, 0xF117FBC1, 0xF118FBC1, 0xF119FBC1, 0xF11AFBC1, 0xF11BFBC1, 0xF11CFBC1, 0xF11DFBC1, 0xF11EFBC1, 0xF11FFBC1,
+		0xF120FBC1, 0xF121FBC1, 0xF122FBC1, 0xF123FBC1, 0xF124FBC1, 0xF125FBC1, 0xF126FBC1, 0xF127FBC1, 0xF128FBC1, 0xF129FBC1, 0xF12AFBC1, 0xF12BFBC1, 0xF12CFBC1, 0xF12DFBC1, 0xF12EFBC1, 0xF12FFBC1, 0xF130FBC1, 0xF131FBC1, 0xF132FBC1, 0xF133FBC1, 0xF134FBC1, 0xF135FBC1, 0xF136FBC1, 0xF137FBC1, 0xF138FBC1, 0xF139FBC1, 0xF13AFBC1, 0xF13BFBC1, 0xF13CFBC1, 0xF13DFBC1, 0xF13EFBC1, 0xF13FFBC1,
+		0xF140FBC1, 0xF141FBC1, 0xF142FBC1, 0xF143FBC1, 0xF144FBC1, 0xF145FBC1, 0xF146FBC1, 0xF147FBC1, 0xF148FBC1, 0xF149FBC1, 0xF14AFBC1, 0xF14BFBC1, 0xF14CFBC1, 0xF14DFBC1, 0xF14EFBC1, 0xF14FFBC1, 0xF150FBC1, 0xF151FBC1, 0xF152FBC1, 0xF153FBC1, 0xF154FBC1, 0xF155FBC1, 0xF156FBC1, 0xF157FBC1, 0xF158FBC1, 0xF159FBC1, 0xF15AFBC1, 0xF15BFBC1, 0xF15CFBC1, 0xF15DFBC1, 0xF15EFBC1, 0xF15FFBC1,
+		0xF160FBC1, 0xF161FBC1, 0xF162FBC1, 0xF163FBC1, 0xF164FBC1, 0xF165FBC1, 0xF166FBC1, 0xF167FBC1, 0xF168FBC1, 0xF169FBC1, 0xF16AFBC1, 0xF16BFBC1, 0xF16CFBC1, 0xF16DFBC1, 0xF16EFBC1, 0xF16FFBC1, 0xF170FBC1, 0xF171FBC1, 0xF172FBC1, 0xF173FBC1, 0xF174FBC1, 0xF175FBC1, 0xF176FBC1, 0xF177FBC1, 0xF178FBC1, 0xF179FBC1, 0xF17AFBC1, 0xF17BFBC1, 0xF17CFBC1, 0xF17DFBC1, 0xF17EFBC1, 0xF17FFBC1,
+		0xF180FBC1, 0xF181FBC1, 0xF182FBC1, 0xF183FBC1, 0xF184FBC1, 0xF185FBC1, 0xF186FBC1, 0xF187FBC1, 0xF188FBC1, 0xF189FBC1, 0xF18AFBC1, 0xF18BFBC1, 0xF18CFBC1, 0xF18DFBC1, 0xF18EFBC1, 0xF18FFBC1, 0xF190FBC1, 0xF191FBC1, 0xF192FBC1, 0xF193FBC1, 0xF194FBC1, 0xF195FBC1, 0xF196FBC1, 0xF197FBC1, 0xF198FBC1, 0xF199FBC1, 0xF19AFBC1, 0xF19BFBC1, 0xF19CFBC1, 0xF19DFBC1, 0xF19EFBC1, 0xF19FFBC1,
+		0xF1A0FBC1, 0xF1A1FBC1, 0xF1A2FBC1, 0xF1A3FBC1, 0xF1A4FBC1, 0xF1A5FBC1, 0xF1A6FBC1, 0xF1A7FBC1, 0xF1A8FBC1, 0xF1A9FBC1, 0xF1AAFBC1, 0xF1ABFBC1, 0xF1ACFBC1, 0xF1ADFBC1, 0xF1AEFBC1, 0xF1AFFBC1, 0xF1B0FBC1, 0xF1B1FBC1, 0xF1B2FBC1, 0xF1B3FBC1, 0xF1B4FBC1, 0xF1B5FBC1, 0xF1B6FBC1, 0xF1B7FBC1, 0xF1B8FBC1, 0xF1B9FBC1, 0xF1BAFBC1, 0xF1BBFBC1, 0xF1BCFBC1, 0xF1BDFBC1, 0xF1BEFBC1, 0xF1BFFBC1,
+		0xF1C0FBC1, 0xF1C1FBC1, 0xF1C2FBC1, 0xF1C3FBC1, 0xF1C4FBC1, 0xF1C5FBC1, 0xF1C6FBC1, 0xF1C7FBC1, 0xF1C8FBC1, 0xF1C9FBC1, 0xF1CAFBC1, 0xF1CBFBC1, 0xF1CCFBC1, 0xF1CDFBC1, 0xF1CEFBC1, 0xF1CFFBC1, 0xF1D0FBC1, 0xF1D1FBC1, 0xF1D2FBC1, 0xF1D3FBC1, 0xF1D4FBC1, 0xF1D5FBC1, 0xF1D6FBC1, 0xF1D7FBC1, 0xF1D8FBC1, 0xF1D9FBC1, 0xF1DAFBC1, 0xF1DBFBC1, 0xF1DCFBC1, 0xF1DDFBC1, 0xF1DEFBC1, 0xF1DFFBC1,
+		0xF1E0FBC1, 0xF1E1FBC1, 0xF1E2FBC1, 0xF1E3FBC1, 0xF1E4FBC1, 0xF1E5FBC1, 0xF1E6FBC1, 0xF1E7FBC1, 0xF1E8FBC1, 0xF1E9FBC1, 0xF1EAFBC1, 0xF1EBFBC1, 0xF1ECFBC1, 0xF1EDFBC1, 0xF1EEFBC1, 0xF1EFFBC1, 0xF1F0FBC1, 0xF1F1FBC1, 0xF1F2FBC1, 0xF1F3FBC1, 0xF1F4FBC1, 0xF1F5FBC1, 0xF1F6FBC1, 0xF1F7FBC1, 0xF1F8FBC1, 0xF1F9FBC1, 0xF1FAFBC1, 0xF1FBFBC1, 0xF1FCFBC1, 0xF1FDFBC1, 0xF1FEFBC1, 0xF1FFFBC1,
+		0xF200FBC1, 0xF201FBC1, 0xF202FBC1, 0xF203FBC1, 0xF204FBC1, 0xF205FBC1, 0xF206FBC1, 0xF207FBC1, 0xF208FBC1, 0xF209FBC1, 0xF20AFBC1, 0xF20BFBC1, 0xF20CFBC1, 0xF20DFBC1, 0xF20EFBC1, 0xF20FFBC1, 0xF210FBC1, 0xF211FBC1, 0xF212FBC1, 0xF213FBC1, 0xF214FBC1, 0xF215FBC1, 0xF216FBC1, 0xF217FBC1, 0xF218FBC1, 0xF219FBC1, 0xF21AFBC1, 0xF21BFBC1, 0xF21CFBC1, 0xF21DFBC1, 0xF21EFBC1, 0xF21FFBC1,
+		0xF220FBC1, 0xF221FBC1, 0xF222FBC1, 0xF223FBC1, 0xF224FBC1, 0xF225FBC1, 0xF226FBC1, 0xF227FBC1, 0xF228FBC1, 0xF229FBC1, 0xF22AFBC1, 0xF22BFBC1, 0xF22CFBC1, 0xF22DFBC1, 0xF22EFBC1, 0xF22FFBC1, 0xF230FBC1, 0xF231FBC1, 0xF232FBC1, 0xF233FBC1, 0xF234FBC1, 0xF235FBC1, 0xF236FBC1, 0xF237FBC1, 0xF238FBC1, 0xF239FBC1, 0xF23AFBC1, 0xF23BFBC1, 0xF23CFBC1, 0xF23DFBC1, 0xF23EFBC1, 0xF23FFBC1,
+		0xF240FBC1, 0xF241FBC1, 0xF242FBC1, 0xF243FBC1, 0xF244FBC1, 0xF245FBC1, 0xF246FBC1, 0xF247FBC1, 0xF248FBC1, 0xF249FBC1, 0xF24AFBC1, 0xF24BFBC1, 0xF24CFBC1, 0xF24DFBC1, 0xF24EFBC1, 0xF24FFBC1, 0xF250FBC1, 0xF251FBC1, 0xF252FBC1, 0xF253FBC1, 0xF254FBC1, 0xF255FBC1, 0xF256FBC1, 0xF257FBC1, 0xF258FBC1, 0xF259FBC1, 0xF25AFBC1, 0xF25BFBC1, 0xF25CFBC1, 0xF25DFBC1, 0xF25EFBC1, 0xF25FFBC1,
+		0xF260FBC1, 0xF261FBC1, 0xF262FBC1, 0xF263FBC1, 0xF264FBC1, 0xF265FBC1, 0xF266FBC1, 0xF267FBC1, 0xF268FBC1, 0xF269FBC1, 0xF26AFBC1, 0xF26BFBC1, 0xF26CFBC1, 0xF26DFBC1, 0xF26EFBC1, 0xF26FFBC1, 0xF270FBC1, 0xF271FBC1, 0xF272FBC1, 0xF273FBC1, 0xF274FBC1, 0xF275FBC1, 0xF276FBC1, 0xF277FBC1, 0xF278FBC1, 0xF279FBC1, 0xF27AFBC1, 0xF27BFBC1, 0xF27CFBC1, 0xF27DFBC1, 0xF27EFBC1, 0xF27FFBC1,
+		0xF280FBC1, 0xF281FBC1, 0xF282FBC1, 0xF283FBC1, 0xF284FBC1, 0xF285FBC1, 0xF286FBC1, 0xF287FBC1, 0xF288FBC1, 0xF289FBC1, 0xF28AFBC1, 0xF28BFBC1, 0xF28CFBC1, 0xF28DFBC1, 0xF28EFBC1, 0xF28FFBC1, 0xF290FBC1, 0xF291FBC1, 0xF292FBC1, 0xF293FBC1, 0xF294FBC1, 0xF295FBC1, 0xF296FBC1, 0xF297FBC1, 0xF298FBC1, 0xF299FBC1, 0xF29AFBC1, 0xF29BFBC1, 0xF29CFBC1, 0xF29DFBC1, 0xF29EFBC1, 0xF29FFBC1,
+		0xF2A0FBC1, 0xF2A1FBC1, 0xF2A2FBC1, 0xF2A3FBC1, 0xF2A4FBC1, 0xF2A5FBC1, 0xF2A6FBC1, 0xF2A7FBC1, 0xF2A8FBC1, 0xF2A9FBC1, 0xF2AAFBC1, 0xF2ABFBC1, 0xF2ACFBC1, 0xF2ADFBC1, 0xF2AEFBC1, 0xF2AFFBC1, 0xF2B0FBC1, 0xF2B1FBC1, 0xF2B2FBC1, 0xF2B3FBC1, 0xF2B4FBC1, 0xF2B5FBC1, 0xF2B6FBC1, 0xF2B7FBC1, 0xF2B8FBC1, 0xF2B9FBC1, 0xF2BAFBC1, 0xF2BBFBC1, 0xF2BCFBC1, 0xF2BDFBC1, 0xF2BEFBC1, 0xF2BFFBC1,
+		0xF2C0FBC1, 0xF2C1FBC1, 0xF2C2FBC1, 0xF2C3FBC1, 0xF2C4FBC1, 0xF2C5FBC1, 0xF2C6FBC1, 0xF2C7FBC1, 0xF2C8FBC1, 0xF2C9FBC1, 0xF2CAFBC1, 0xF2CBFBC1, 0xF2CCFBC1, 0xF2CDFBC1, 0xF2CEFBC1, 0xF2CFFBC1, 0xF2D0FBC1, 0xF2D1FBC1, 0xF2D2FBC1, 0xF2D3FBC1, 0xF2D4FBC1, 0xF2D5FBC1, 0xF2D6FBC1, 0xF2D7FBC1, 0xF2D8FBC1, 0xF2D9FBC1, 0xF2DAFBC1, 0xF2DBFBC1, 0xF2DCFBC1, 0xF2DDFBC1, 0xF2DEFBC1, 0xF2DFFBC1,
+		0xF2E0FBC1, 0xF2E1FBC1, 0xF2E2FBC1, 0xF2E3FBC1, 0xF2E4FBC1, 0xF2E5FBC1, 0xF2E6FBC1, 0xF2E7FBC1, 0xF2E8FBC1, 0xF2E9FBC1, 0xF2EAFBC1, 0xF2EBFBC1, 0xF2ECFBC1, 0xF2EDFBC1, 0xF2EEFBC1, 0xF2EFFBC1, 0xF2F0FBC1, 0xF2F1FBC1, 0xF2F2FBC1, 0xF2F3FBC1, 0xF2F4FBC1, 0xF2F5FBC1, 0xF2F6FBC1, 0xF2F7FBC1, 0xF2F8FBC1, 0xF2F9FBC1, 0xF2FAFBC1, 0xF2FBFBC1, 0xF2FCFBC1, 0xF2FDFBC1, 0xF2FEFBC1, 0xF2FFFBC1,
+		0xF300FBC1, 0xF301FBC1, 0xF302FBC1, 0xF303FBC1, 0xF304FBC1, 0xF305FBC1, 0xF306FBC1, 0xF307FBC1, 0xF308FBC1, 0xF309FBC1, 0xF30AFBC1, 0xF30BFBC1, 0xF30CFBC1, 0xF30DFBC1, 0xF30EFBC1, 0xF30FFBC1, 0xF310FBC1, 0xF311FBC1, 0xF312FBC1, 0xF313FBC1, 0xF314FBC1, 0xF315FBC1, 0xF316FBC1, 0xF317FBC1, 0xF318FBC1, 0xF319FBC1, 0xF31AFBC1, 0xF31BFBC1, 0xF31CFBC1, 0xF31DFBC1, 0xF31EFBC1, 0xF31FFBC1,
+		0xF320FBC1, 0xF321FBC1, 0xF322FBC1, 0xF323FBC1, 0xF324FBC1, 0xF325FBC1, 0xF326FBC1, 0xF327FBC1, 0xF328FBC1, 0xF329FBC1, 0xF32AFBC1, 0xF32BFBC1, 0xF32CFBC1, 0xF32DFBC1, 0xF32EFBC1, 0xF32FFBC1, 0xF330FBC1, 0xF331FBC1, 0xF332FBC1, 0xF333FBC1, 0xF334FBC1, 0xF335FBC1, 0xF336FBC1, 0xF337FBC1, 0xF338FBC1, 0xF339FBC1, 0xF33AFBC1, 0xF33BFBC1, 0xF33CFBC1, 0xF33DFBC1, 0xF33EFBC1, 0xF33FFBC1,
+		0xF340FBC1, 0xF341FBC1, 0xF342FBC1, 0xF343FBC1, 0xF344FBC1, 0xF345FBC1, 0xF346FBC1, 0xF347FBC1, 0xF348FBC1, 0xF349FBC1, 0xF34AFBC1, 0xF34BFBC1, 0xF34CFBC1, 0xF34DFBC1, 0xF34EFBC1, 0xF34FFBC1, 0xF350FBC1, 0xF351FBC1, 0xF352FBC1, 0xF353FBC1, 0xF354FBC1, 0xF355FBC1, 0xF356FBC1, 0xF357FBC1, 0xF358FBC1, 0xF359FBC1, 0xF35AFBC1, 0xF35BFBC1, 0xF35CFBC1, 0xF35DFBC1, 0xF35EFBC1, 0xF35FFBC1,
+		0xF360FBC1, 0xF361FBC1, 0xF362FBC1, 0xF363FBC1, 0xF364FBC1, 0xF365FBC1, 0xF366FBC1, 0xF367FBC1, 0xF368FBC1, 0xF369FBC1, 0xF36AFBC1, 0xF36BFBC1, 0xF36CFBC1, 0xF36DFBC1, 0xF36EFBC1, 0xF36FFBC1, 0xF370FBC1, 0xF371FBC1, 0xF372FBC1, 0xF373FBC1, 0xF374FBC1, 0xF375FBC1, 0xF376FBC1, 0xF377FBC1, 0xF378FBC1, 0xF379FBC1, 0xF37AFBC1, 0xF37BFBC1, 0xF37CFBC1, 0xF37DFBC1, 0xF37EFBC1, 0xF37FFBC1,
+		0xF380FBC1, 0xF381FBC1, 0xF382FBC1, 0xF383FBC1, 0xF384FBC1, 0xF385FBC1, 0xF386FBC1, 0xF387FBC1, 0xF388FBC1, 0xF389FBC1, 0xF38AFBC1, 0xF38BFBC1, 0xF38CFBC1, 0xF38DFBC1, 0xF38EFBC1, 0xF38FFBC1, 0xF390FBC1, 0xF391FBC1, 0xF392FBC1, 0xF393FBC1, 0xF394FBC1, 0xF395FBC1, 0xF396FBC1, 0xF397FBC1, 0xF398FBC1, 0xF399FBC1, 0xF39AFBC1, 0xF39BFBC1, 0xF39CFBC1, 0xF39DFBC1, 0xF39EFBC1, 0xF39FFBC1,
+		0xF3A0FBC1, 0xF3A1FBC1, 0xF3A2FBC1, 0xF3A3FBC1, 0xF3A4FBC1, 0xF3A5FBC1, 0xF3A6FBC1, 0xF3A7FBC1, 0xF3A8FBC1, 0xF3A9FBC1, 0xF3AAFBC1, 0xF3ABFBC1, 0xF3ACFBC1, 0xF3ADFBC1, 0xF3AEFBC1, 0xF3AFFBC1, 0xF3B0FBC1, 0xF3B1FBC1, 0xF3B2FBC1, 0xF3B3FBC1, 0xF3B4FBC1, 0xF3B5FBC1, 0xF3B6FBC1, 0xF3B7FBC1, 0xF3B8FBC1, 0xF3B9FBC1, 0xF3BAFBC1, 0xF3BBFBC1, 0xF3BCFBC1, 0xF3BDFBC1, 0xF3BEFBC1, 0xF3BFFBC1,
+		0xF3C0FBC1, 0xF3C1FBC1, 0xF3C2FBC1, 0xF3C3FBC1, 0xF3C4FBC1, 0xF3C5FBC1, 0xF3C6FBC1, 0xF3C7FBC1, 0xF3C8FBC1, 0xF3C9FBC1, 0xF3CAFBC1, 0xF3CBFBC1, 0xF3CCFBC1, 0xF3CDFBC1, 0xF3CEFBC1, 0xF3CFFBC1, 0xF3D0FBC1, 0xF3D1FBC1, 0xF3D2FBC1, 0xF3D3FBC1, 0xF3D4FBC1, 0xF3D5FBC1, 0xF3D6FBC1, 0xF3D7FBC1, 0xF3D8FBC1, 0xF3D9FBC1, 0xF3DAFBC1, 0xF3DBFBC1, 0xF3DCFBC1, 0xF3DDFBC1, 0xF3DEFBC1, 0xF3DFFBC1,
+		0xF3E0FBC1, 0xF3E1FBC1, 0xF3E2FBC1, 0xF3E3FBC1, 0xF3E4FBC1, 0xF3E5FBC1, 0xF3E6FBC1, 0xF3E7FBC1, 0xF3E8FBC1, 0xF3E9FBC1, 0xF3EAFBC1, 0xF3EBFBC1, 0xF3ECFBC1, 0xF3EDFBC1, 0xF3EEFBC1, 0xF3EFFBC1, 0xF3F0FBC1, 0xF3F1FBC1, 0xF3F2FBC1, 0xF3F3FBC1, 0xF3F4FBC1, 0xF3F5FBC1, 0xF3F6FBC1, 0xF3F7FBC1, 0xF3F8FBC1, 0xF3F9FBC1, 0xF3FAFBC1, 0xF3FBFBC1, 0xF3FCFBC1, 0xF3FDFBC1, 0xF3FEFBC1, 0xF3FFFBC1,
+		0xF400FBC1, 0xF401FBC1, 0xF402FBC1, 0xF403FBC1, 0xF404FBC1, 0xF405FBC1, 0xF406FBC1, 0xF407FBC1, 0xF408FBC1, 0xF409FBC1, 0xF40AFBC1, 0xF40BFBC1, 0xF40CFBC1, 0xF40DFBC1, 0xF40EFBC1, 0xF40FFBC1, 0xF410FBC1, 0xF411FBC1, 0xF412FBC1, 0xF413FBC1, 0xF414FBC1, 0xF415FBC1, 0xF416FBC1, 0xF417FBC1, 0xF418FBC1, 0xF419FBC1, 0xF41AFBC1, 0xF41BFBC1, 0xF41CFBC1, 0xF41DFBC1, 0xF41EFBC1, 0xF41FFBC1,
+		0xF420FBC1, 0xF421FBC1, 0xF422FBC1, 0xF423FBC1, 0xF424FBC1, 0xF425FBC1, 0xF426FBC1, 0xF427FBC1, 0xF428FBC1, 0xF429FBC1, 0xF42AFBC1, 0xF42BFBC1, 0xF42CFBC1, 0xF42DFBC1, 0xF42EFBC1, 0xF42FFBC1, 0xF430FBC1, 0xF431FBC1, 0xF432FBC1, 0xF433FBC1, 0xF434FBC1, 0xF435FBC1, 0xF436FBC1, 0xF437FBC1, 0xF438FBC1, 0xF439FBC1, 0xF43AFBC1, 0xF43BFBC1, 0xF43CFBC1, 0xF43DFBC1, 0xF43EFBC1, 0xF43FFBC1,
+		0xF440FBC1, 0xF441FBC1, 0xF442FBC1, 0xF443FBC1, 0xF444FBC1, 0xF445FBC1, 0xF446FBC1, 0xF447FBC1, 0xF448FBC1, 0xF449FBC1, 0xF44AFBC1, 0xF44BFBC1, 0xF44CFBC1, 0xF44DFBC1, 0xF44EFBC1, 0xF44FFBC1, 0xF450FBC1, 0xF451FBC1, 0xF452FBC1, 0xF453FBC1, 0xF454FBC1, 0xF455FBC1, 0xF456FBC1, 0xF457FBC1, 0xF458FBC1, 0xF459FBC1, 0xF45AFBC1, 0xF45BFBC1, 0xF45CFBC1, 0xF45DFBC1, 0xF45EFBC1, 0xF45FFBC1,
+		0xF460FBC1, 0xF461FBC1, 0xF462FBC1, 0xF463FBC1, 0xF464FBC1, 0xF465FBC1, 0xF466FBC1, 0xF467FBC1, 0xF468FBC1, 0xF469FBC1, 0xF46AFBC1, 0xF46BFBC1, 0xF46CFBC1, 0xF46DFBC1, 0xF46EFBC1, 0xF46FFBC1, 0xF470FBC1, 0xF471FBC1, 0xF472FBC1, 0xF473FBC1, 0xF474FBC1, 0xF475FBC1, 0xF476FBC1, 0xF477FBC1, 0xF478FBC1, 0xF479FBC1, 0xF47AFBC1, 0xF47BFBC1, 0xF47CFBC1, 0xF47DFBC1, 0xF47EFBC1, 0xF47FFBC1,
+		0xF480FBC1, 0xF481FBC1, 0xF482FBC1, 0xF483FBC1, 0xF484FBC1, 0xF485FBC1, 0xF486FBC1, 0xF487FBC1, 0xF488FBC1, 0xF489FBC1, 0xF48AFBC1, 0xF48BFBC1, 0xF48CFBC1, 0xF48DFBC1, 0xF48EFBC1, 0xF48FFBC1, 0xF490FBC1, 0xF491FBC1, 0xF492FBC1, 0xF493FBC1, 0xF494FBC1, 0xF495FBC1, 0xF496FBC1, 0xF497FBC1, 0xF498FBC1, 0xF499FBC1, 0xF49AFBC1, 0xF49BFBC1, 0xF49CFBC1, 0xF49DFBC1, 0xF49EFBC1, 0xF49FFBC1,
+		0xF4A0FBC1, 0xF4A1FBC1, 0xF4A2FBC1, 0xF4A3FBC1, 0xF4A4FBC1, 0xF4A5FBC1, 0xF4A6FBC1, 0xF4A7FBC1, 0xF4A8FBC1, 0xF4A9FBC1, 0xF4AAFBC1, 0xF4ABFBC1, 0xF4ACFBC1, 0xF4ADFBC1, 0xF4AEFBC1, 0xF4AFFBC1, 0xF4B0FBC1, 0xF4B1FBC1, 0xF4B2FBC1, 0xF4B3FBC1, 0xF4B4FBC1, 0xF4B5FBC1, 0xF4B6FBC1, 0xF4B7FBC1, 0xF4B8FBC1, 0xF4B9FBC1, 0xF4BAFBC1, 0xF4BBFBC1, 0xF4BCFBC1, 0xF4BDFBC1, 0xF4BEFBC1, 0xF4BFFBC1,
+		0xF4C0FBC1, 0xF4C1FBC1, 0xF4C2FBC1, 0xF4C3FBC1, 0xF4C4FBC1, 0xF4C5FBC1, 0xF4C6FBC1, 0xF4C7FBC1, 0xF4C8FBC1, 0xF4C9FBC1, 0xF4CAFBC1, 0xF4CBFBC1, 0xF4CCFBC1, 0xF4CDFBC1, 0xF4CEFBC1, 0xF4CFFBC1, 0xF4D0FBC1, 0xF4D1FBC1, 0xF4D2FBC1, 0xF4D3FBC1, 0xF4D4FBC1, 0xF4D5FBC1, 0xF4D6FBC1, 0xF4D7FBC1, 0xF4D8FBC1, 0xF4D9FBC1, 0xF4DAFBC1, 0xF4DBFBC1, 0xF4DCFBC1, 0xF4DDFBC1, 0xF4DEFBC1, 0xF4DFFBC1,
+		0xF4E0FBC1, 0xF4E1FBC1, 0xF4E2FBC1, 0xF4E3FBC1, 0xF4E4FBC1, 0xF4E5FBC1, 0xF4E6FBC1, 0xF4E7FBC1, 0xF4E8FBC1, 0xF4E9FBC1, 0xF4EAFBC1, 0xF4EBFBC1, 0xF4ECFBC1, 0xF4EDFBC1, 0xF4EEFBC1, 0xF4EFFBC1, 0xF4F0FBC1, 0xF4F1FBC1, 0xF4F2FBC1, 0xF4F3FBC1, 0xF4F4FBC1, 0xF4F5FBC1, 0xF4F6FBC1, 0xF4F7FBC1, 0xF4F8FBC1, 0xF4F9FBC1, 0xF4FAFBC1, 0xF4FBFBC1, 0xF4FCFBC1, 0xF4FDFBC1, 0xF4FEFBC1, 0xF4FFFBC1,
+		0xF500FBC1, 0xF501FBC1, 0xF502FBC1, 0xF503FBC1, 0xF504FBC1, 0xF505FBC1, 0xF506FBC1, 0xF507FBC1, 0xF508FBC1, 0xF509FBC1, 0xF50AFBC1, 0xF50BFBC1, 0xF50CFBC1, 0xF50DFBC1, 0xF50EFBC1, 0xF50FFBC1, 0xF510FBC1, 0xF511FBC1, 0xF512FBC1, 0xF513FBC1, 0xF514FBC1, 0xF515FBC1, 0xF516FBC1, 0xF517FBC1, 0xF518FBC1, 0xF519FBC1, 0xF51AFBC1, 0xF51BFBC1, 0xF51CFBC1, 0xF51DFBC1, 0xF51EFBC1, 0xF51FFBC1,
+		0xF520FBC1, 0xF521FBC1, 0xF522FBC1, 0xF523FBC1, 0xF524FBC1, 0xF525FBC1, 0xF526FBC1, 0xF527FBC1, 0xF528FBC1, 0xF529FBC1, 0xF52AFBC1, 0xF52BFBC1, 0xF52CFBC1, 0xF52DFBC1, 0xF52EFBC1, 0xF52FFBC1, 0xF530FBC1, 0xF531FBC1, 0xF532FBC1, 0xF533FBC1, 0xF534FBC1, 0xF535FBC1, 0xF536FBC1, 0xF537FBC1, 0xF538FBC1, 0xF539FBC1, 0xF53AFBC1, 0xF53BFBC1, 0xF53CFBC1, 0xF53DFBC1, 0xF53EFBC1, 0xF53FFBC1,
+		0xF540FBC1, 0xF541FBC1, 0xF542FBC1, 0xF543FBC1, 0xF544FBC1, 0xF545FBC1, 0xF546FBC1, 0xF547FBC1, 0xF548FBC1, 0xF549FBC1, 0xF54AFBC1, 0xF54BFBC1, 0xF54CFBC1, 0xF54DFBC1, 0xF54EFBC1, 0xF54FFBC1, 0xF550FBC1, 0xF551FBC1, 0xF552FBC1, 0xF553FBC1, 0xF554FBC1, 0xF555FBC1, 0xF556FBC1, 0xF557FBC1, 0xF558FBC1, 0xF559FBC1, 0xF55AFBC1, 0xF55BFBC1, 0xF55CFBC1, 0xF55DFBC1, 0xF55EFBC1, 0xF55FFBC1,
+		0xF560FBC1, 0xF561FBC1, 0xF562FBC1, 0xF563FBC1, 0xF564FBC1, 0xF565FBC1, 0xF566FBC1, 0xF567FBC1, 0xF568FBC1, 0xF569FBC1, 0xF56AFBC1, 0xF56BFBC1, 0xF56CFBC1, 0xF56DFBC1, 0xF56EFBC1, 0xF56FFBC1, 0xF570FBC1, 0xF571FBC1, 0xF572FBC1, 0xF573FBC1, 0xF574FBC1, 0xF575FBC1, 0xF576FBC1, 0xF577FBC1, 0xF578FBC1, 0xF579FBC1, 0xF57AFBC1, 0xF57BFBC1, 0xF57CFBC1, 0xF57DFBC1, 0xF57EFBC1, 0xF57FFBC1,
+		0xF580FBC1, 0xF581FBC1, 0xF582FBC1, 0xF583FBC1, 0xF584FBC1, 0xF585FBC1, 0xF586FBC1, 0xF587FBC1, 0xF588FBC1, 0xF589FBC1, 0xF58AFBC1, 0xF58BFBC1, 0xF58CFBC1, 0xF58DFBC1, 0xF58EFBC1, 0xF58FFBC1, 0xF590FBC1, 0xF591FBC1, 0xF592FBC1, 0xF593FBC1, 0xF594FBC1, 0xF595FBC1, 0xF596FBC1, 0xF597FBC1, 0xF598FBC1, 0xF599FBC1, 0xF59AFBC1, 0xF59BFBC1, 0xF59CFBC1, 0xF59DFBC1, 0xF59EFBC1, 0xF59FFBC1,
+		0xF5A0FBC1, 0xF5A1FBC1, 0xF5A2FBC1, 0xF5A3FBC1, 0xF5A4FBC1, 0xF5A5FBC1, 0xF5A6FBC1, 0xF5A7FBC1, 0xF5A8FBC1, 0xF5A9FBC1, 0xF5AAFBC1, 0xF5ABFBC1, 0xF5ACFBC1, 0xF5ADFBC1, 0xF5AEFBC1, 0xF5AFFBC1, 0xF5B0FBC1, 0xF5B1FBC1, 0xF5B2FBC1, 0xF5B3FBC1, 0xF5B4FBC1, 0xF5B5FBC1, 0xF5B6FBC1, 0xF5B7FBC1, 0xF5B8FBC1, 0xF5B9FBC1, 0xF5BAFBC1, 0xF5BBFBC1, 0xF5BCFBC1, 0xF5BDFBC1, 0xF5BEFBC1, 0xF5BFFBC1,
+		0xF5C0FBC1, 0xF5C1FBC1, 0xF5C2FBC1, 0xF5C3FBC1, 0xF5C4FBC1, 0xF5C5FBC1, 0xF5C6FBC1, 0xF5C7FBC1, 0xF5C8FBC1, 0xF5C9FBC1, 0xF5CAFBC1, 0xF5CBFBC1, 0xF5CCFBC1, 0xF5CDFBC1, 0xF5CEFBC1, 0xF5CFFBC1, 0xF5D0FBC1, 0xF5D1FBC1, 0xF5D2FBC1, 0xF5D3FBC1, 0xF5D4FBC1, 0xF5D5FBC1, 0xF5D6FBC1, 0xF5D7FBC1, 0xF5D8FBC1, 0xF5D9FBC1, 0xF5DAFBC1, 0xF5DBFBC1, 0xF5DCFBC1, 0xF5DDFBC1, 0xF5DEFBC1, 0xF5DFFBC1,
+		0xF5E0FBC1, 0xF5E1FBC1, 0xF5E2FBC1, 0xF5E3FBC1, 0xF5E4FBC1, 0xF5E5FBC1, 0xF5E6FBC1, 0xF5E7FBC1, 0xF5E8FBC1, 0xF5E9FBC1, 0xF5EAFBC1, 0xF5EBFBC1, 0xF5ECFBC1, 0xF5EDFBC1, 0xF5EEFBC1, 0xF5EFFBC1, 0xF5F0FBC1, 0xF5F1FBC1, 0xF5F2FBC1, 0xF5F3FBC1, 0xF5F4FBC1, 0xF5F5FBC1, 0xF5F6FBC1, 0xF5F7FBC1, 0xF5F8FBC1, 0xF5F9FBC1, 0xF5FAFBC1, 0xF5FBFBC1, 0xF5FCFBC1, 0xF5FDFBC1, 0xF5FEFBC1, 0xF5FFFBC1,
+		0xF600FBC1, 0xF601FBC1, 0xF602FBC1, 0xF603FBC1, 0xF604FBC1, 0xF605FBC1, 0xF606FBC1, 0xF607FBC1, 0xF608FBC1, 0xF609FBC1, 0xF60AFBC1, 0xF60BFBC1, 0xF60CFBC1, 0xF60DFBC1, 0xF60EFBC1, 0xF60FFBC1, 0xF610FBC1, 0xF611FBC1, 0xF612FBC1, 0xF613FBC1, 0xF614FBC1, 0xF615FBC1, 0xF616FBC1, 0xF617FBC1, 0xF618FBC1, 0xF619FBC1, 0xF61AFBC1, 0xF61BFBC1, 0xF61CFBC1, 0xF61DFBC1, 0xF61EFBC1, 0xF61FFBC1,
+		0xF620FBC1, 0xF621FBC1, 0xF622FBC1, 0xF623FBC1, 0xF624FBC1, 0xF625FBC1, 0xF626FBC1, 0xF627FBC1, 0xF628FBC1, 0xF629FBC1, 0xF62AFBC1, 0xF62BFBC1, 0xF62CFBC1, 0xF62DFBC1, 0xF62EFBC1, 0xF62FFBC1, 0xF630FBC1, 0xF631FBC1, 0xF632FBC1, 0xF633FBC1, 0xF634FBC1, 0xF635FBC1, 0xF636FBC1, 0xF637FBC1, 0xF638FBC1, 0xF639FBC1, 0xF63AFBC1, 0xF63BFBC1, 0xF63CFBC1, 0xF63DFBC1, 0xF63EFBC1, 0xF63FFBC1,
+		0xF640FBC1, 0xF641FBC1, 0xF642FBC1, 0xF643FBC1, 0xF644FBC1, 0xF645FBC1, 0xF646FBC1, 0xF647FBC1, 0xF648FBC1, 0xF649FBC1, 0xF64AFBC1, 0xF64BFBC1, 0xF64CFBC1, 0xF64DFBC1, 0xF64EFBC1, 0xF64FFBC1, 0xF650FBC1, 0xF651FBC1, 0xF652FBC1, 0xF653FBC1, 0xF654FBC1, 0xF655FBC1, 0xF656FBC1, 0xF657FBC1, 0xF658FBC1, 0xF659FBC1, 0xF65AFBC1, 0xF65BFBC1, 0xF65CFBC1, 0xF65DFBC1, 0xF65EFBC1, 0xF65FFBC1,
+		0xF660FBC1, 0xF661FBC1, 0xF662FBC1, 0xF663FBC1, 0xF664FBC1, 0xF665FBC1, 0xF666FBC1, 0xF667FBC1, 0xF668FBC1, 0xF669FBC1, 0xF66AFBC1, 0xF66BFBC1, 0xF66CFBC1, 0xF66DFBC1, 0xF66EFBC1, 0xF66FFBC1, 0xF670FBC1, 0xF671FBC1, 0xF672FBC1, 0xF673FBC1, 0xF674FBC1, 0xF675FBC1, 0xF676FBC1, 0xF677FBC1, 0xF678FBC1, 0xF679FBC1, 0xF67AFBC1, 0xF67BFBC1, 0xF67CFBC1, 0xF67DFBC1, 0xF67EFBC1, 0xF67FFBC1,
+		0xF680FBC1, 0xF681FBC1, 0xF682FBC1, 0xF683FBC1, 0xF684FBC1, 0xF685FBC1, 0xF686FBC1, 0xF687FBC1, 0xF688FBC1, 0xF689FBC1, 0xF68AFBC1, 0xF68BFBC1, 0xF68CFBC1, 0xF68DFBC1, 0xF68EFBC1, 0xF68FFBC1, 0xF690FBC1, 0xF691FBC1, 0xF692FBC1, 0xF693FBC1, 0xF694FBC1, 0xF695FBC1, 0xF696FBC1, 0xF697FBC1, 0xF698FBC1, 0xF699FBC1, 0xF69AFBC1, 0xF69BFBC1, 0xF69CFBC1, 0xF69DFBC1, 0xF69EFBC1, 0xF69FFBC1,
+		0xF6A0FBC1, 0xF6A1FBC1, 0xF6A2FBC1, 0xF6A3FBC1, 0xF6A4FBC1, 0xF6A5FBC1, 0xF6A6FBC1, 0xF6A7FBC1, 0xF6A8FBC1, 0xF6A9FBC1, 0xF6AAFBC1, 0xF6ABFBC1, 0xF6ACFBC1, 0xF6ADFBC1, 0xF6AEFBC1, 0xF6AFFBC1, 0xF6B0FBC1, 0xF6B1FBC1, 0xF6B2FBC1, 0xF6B3FBC1, 0xF6B4FBC1, 0xF6B5FBC1, 0xF6B6FBC1, 0xF6B7FBC1, 0xF6B8FBC1, 0xF6B9FBC1, 0xF6BAFBC1, 0xF6BBFBC1, 0xF6BCFBC1, 0xF6BDFBC1, 0xF6BEFBC1, 0xF6BFFBC1,
+		0xF6C0FBC1, 0xF6C1FBC1, 0xF6C2FBC1, 0xF6C3FBC1, 0xF6C4FBC1, 0xF6C5FBC1, 0xF6C6FBC1, 0xF6C7FBC1, 0xF6C8FBC1, 0xF6C9FBC1, 0xF6CAFBC1, 0xF6CBFBC1, 0xF6CCFBC1, 0xF6CDFBC1, 0xF6CEFBC1, 0xF6CFFBC1, 0xF6D0FBC1, 0xF6D1FBC1, 0xF6D2FBC1, 0xF6D3FBC1, 0xF6D4FBC1, 0xF6D5FBC1, 0xF6D6FBC1, 0xF6D7FBC1, 0xF6D8FBC1, 0xF6D9FBC1, 0xF6DAFBC1, 0xF6DBFBC1, 0xF6DCFBC1, 0xF6DDFBC1, 0xF6DEFBC1, 0xF6DFFBC1,
+		0xF6E0FBC1, 0xF6E1FBC1, 0xF6E2FBC1, 0xF6E3FBC1, 0xF6E4FBC1, 0xF6E5FBC1, 0xF6E6FBC1, 0xF6E7FBC1, 0xF6E8FBC1, 0xF6E9FBC1, 0xF6EAFBC1, 0xF6EBFBC1, 0xF6ECFBC1, 0xF6EDFBC1, 0xF6EEFBC1, 0xF6EFFBC1, 0xF6F0FBC1, 0xF6F1FBC1, 0xF6F2FBC1, 0xF6F3FBC1, 0xF6F4FBC1, 0xF6F5FBC1, 0xF6F6FBC1, 0xF6F7FBC1, 0xF6F8FBC1, 0xF6F9FBC1, 0xF6FAFBC1, 0xF6FBFBC1, 0xF6FCFBC1, 0xF6FDFBC1, 0xF6FEFBC1, 0xF6FFFBC1,
+		0xF700FBC1, 0xF701FBC1, 0xF702FBC1, 0xF703FBC1, 0xF704FBC1, 0xF705FBC1, 0xF706FBC1, 0xF707FBC1, 0xF708FBC1, 0xF709FBC1, 0xF70AFBC1, 0xF70BFBC1, 0xF70CFBC1, 0xF70DFBC1, 0xF70EFBC1, 0xF70FFBC1, 0xF710FBC1, 0xF711FBC1, 0xF712FBC1, 0xF713FBC1, 0xF714FBC1, 0xF715FBC1, 0xF716FBC1, 0xF717FBC1, 0xF718FBC1, 0xF719FBC1, 0xF71AFBC1, 0xF71BFBC1, 0xF71CFBC1, 0xF71DFBC1, 0xF71EFBC1, 0xF71FFBC1,
+		0xF720FBC1, 0xF721FBC1, 0xF722FBC1, 0xF723FBC1, 0xF724FBC1, 0xF725FBC1, 0xF726FBC1, 0xF727FBC1, 0xF728FBC1, 0xF729FBC1, 0xF72AFBC1, 0xF72BFBC1, 0xF72CFBC1, 0xF72DFBC1, 0xF72EFBC1, 0xF72FFBC1, 0xF730FBC1, 0xF731FBC1, 0xF732FBC1, 0xF733FBC1, 0xF734FBC1, 0xF735FBC1, 0xF736FBC1, 0xF737FBC1, 0xF738FBC1, 0xF739FBC1, 0xF73AFBC1, 0xF73BFBC1, 0xF73CFBC1, 0xF73DFBC1, 0xF73EFBC1, 0xF73FFBC1,
+		0xF740FBC1, 0xF741FBC1, 0xF742FBC1, 0xF743FBC1, 0xF744FBC1, 0xF745FBC1, 0xF746FBC1, 0xF747FBC1, 0xF748FBC1, 0xF749FBC1, 0xF74AFBC1, 0xF74BFBC1, 0xF74CFBC1, 0xF74DFBC1, 0xF74EFBC1, 0xF74FFBC1, 0xF750FBC1, 0xF751FBC1, 0xF752FBC1, 0xF753FBC1, 0xF754FBC1, 0xF755FBC1, 0xF756FBC1, 0xF757FBC1, 0xF758FBC1, 0xF759FBC1, 0xF75AFBC1, 0xF75BFBC1, 0xF75CFBC1, 0xF75DFBC1, 0xF75EFBC1, 0xF75FFBC1,
+		0xF760FBC1, 0xF761FBC1, 0xF762FBC1, 0xF763FBC1, 0xF764FBC1, 0xF765FBC1, 0xF766FBC1, 0xF767FBC1, 0xF768FBC1, 0xF769FBC1, 0xF76AFBC1, 0xF76BFBC1, 0xF76CFBC1, 0xF76DFBC1, 0xF76EFBC1, 0xF76FFBC1, 0xF770FBC1, 0xF771FBC1, 0xF772FBC1, 0xF773FBC1, 0xF774FBC1, 0xF775FBC1, 0xF776FBC1, 0xF777FBC1, 0xF778FBC1, 0xF779FBC1, 0xF77AFBC1, 0xF77BFBC1, 0xF77CFBC1, 0xF77DFBC1, 0xF77EFBC1, 0xF77FFBC1,
+		0xF780FBC1, 0xF781FBC1, 0xF782FBC1, 0xF783FBC1, 0xF784FBC1, 0xF785FBC1, 0xF786FBC1, 0xF787FBC1, 0xF788FBC1, 0xF789FBC1, 0xF78AFBC1, 0xF78BFBC1, 0xF78CFBC1, 0xF78DFBC1, 0xF78EFBC1, 0xF78FFBC1, 0xF790FBC1, 0xF791FBC1, 0xF792FBC1, 0xF793FBC1, 0xF794FBC1, 0xF795FBC1, 0xF796FBC1, 0xF797FBC1, 0xF798FBC1, 0xF799FBC1, 0xF79AFBC1, 0xF79BFBC1, 0xF79CFBC1, 0xF79DFBC1, 0xF79EFBC1, 0xF79FFBC1,
+		0xF7A0FBC1, 0xF7A1FBC1, 0xF7A2FBC1, 0xF7A3FBC1, 0xF7A4FBC1, 0xF7A5FBC1, 0xF7A6FBC1, 0xF7A7FBC1, 0xF7A8FBC1, 0xF7A9FBC1, 0xF7AAFBC1, 0xF7ABFBC1, 0xF7ACFBC1, 0xF7ADFBC1, 0xF7AEFBC1, 0xF7AFFBC1, 0xF7B0FBC1, 0xF7B1FBC1, 0xF7B2FBC1, 0xF7B3FBC1, 0xF7B4FBC1, 0xF7B5FBC1, 0xF7B6FBC1, 0xF7B7FBC1, 0xF7B8FBC1, 0xF7B9FBC1, 0xF7BAFBC1, 0xF7BBFBC1, 0xF7BCFBC1, 0xF7BDFBC1, 0xF7BEFBC1, 0xF7BFFBC1,
+		0xF7C0FBC1, 0xF7C1FBC1, 0xF7C2FBC1, 0xF7C3FBC1, 0xF7C4FBC1, 0xF7C5FBC1, 0xF7C6FBC1, 0xF7C7FBC1, 0xF7C8FBC1, 0xF7C9FBC1, 0xF7CAFBC1, 0xF7CBFBC1, 0xF7CCFBC1, 0xF7CDFBC1, 0xF7CEFBC1, 0xF7CFFBC1, 0xF7D0FBC1, 0xF7D1FBC1, 0xF7D2FBC1, 0xF7D3FBC1, 0xF7D4FBC1, 0xF7D5FBC1, 0xF7D6FBC1, 0xF7D7FBC1, 0xF7D8FBC1, 0xF7D9FBC1, 0xF7DAFBC1, 0xF7DBFBC1, 0xF7DCFBC1, 0xF7DDFBC1, 0xF7DEFBC1, 0xF7DFFBC1,
+		0xF7E0FBC1, 0xF7E1FBC1, 0xF7E2FBC1, 0xF7E3FBC1, 0xF7E4FBC1, 0xF7E5FBC1, 0xF7E6FBC1, 0xF7E7FBC1, 0xF7E8FBC1, 0xF7E9FBC1, 0xF7EAFBC1, 0xF7EBFBC1, 0xF7ECFBC1, 0xF7EDFBC1, 0xF7EEFBC1, 0xF7EFFBC1, 0xF7F0FBC1, 0xF7F1FBC1, 0xF7F2FBC1, 0xF7F3FBC1, 0xF7F4FBC1, 0xF7F5FBC1, 0xF7F6FBC1, 0xF7F7FBC1, 0xF7F8FBC1, 0xF7F9FBC1, 0xF7FAFBC1, 0xF7FBFBC1, 0xF7FCFBC1, 0xF7FDFBC1, 0xF7FEFBC1, 0xF7FFFBC1,
+		0xF800FBC1, 0xF801FBC1, 0xF802FBC1, 0xF803FBC1, 0xF804FBC1, 0xF805FBC1, 0xF806FBC1, 0xF807FBC1, 0xF808FBC1, 0xF809FBC1, 0xF80AFBC1, 0xF80BFBC1, 0xF80CFBC1, 0xF80DFBC1, 0xF80EFBC1, 0xF80FFBC1, 0xF810FBC1, 0xF811FBC1, 0xF812FBC1, 0xF813FBC1, 0xF814FBC1, 0xF815FBC1, 0xF816FBC1, 0xF817FBC1, 0xF818FBC1, 0xF819FBC1, 0xF81AFBC1, 0xF81BFBC1, 0xF81CFBC1, 0xF81DFBC1, 0xF81EFBC1, 0xF81FFBC1,
+		0xF820FBC1, 0xF821FBC1, 0xF822FBC1, 0xF823FBC1, 0xF824FBC1, 0xF825FBC1, 0xF826FBC1, 0xF827FBC1, 0xF828FBC1, 0xF829FBC1, 0xF82AFBC1, 0xF82BFBC1, 0xF82CFBC1, 0xF82DFBC1, 0xF82EFBC1, 0xF82FFBC1, 0xF830FBC1, 0xF831FBC1, 0xF832FBC1, 0xF833FBC1, 0xF834FBC1, 0xF835FBC1, 0xF836FBC1, 0xF837FBC1, 0xF838FBC1, 0xF839FBC1, 0xF83AFBC1, 0xF83BFBC1, 0xF83CFBC1, 0xF83DFBC1, 0xF83EFBC1, 0xF83FFBC1,
+		0xF840FBC1, 0xF841FBC1, 0xF842FBC1, 0xF843FBC1, 0xF844FBC1, 0xF845FBC1, 0xF846FBC1, 0xF847FBC1, 0xF848FBC1, 0xF849FBC1, 0xF84AFBC1, 0xF84BFBC1, 0xF84CFBC1, 0xF84DFBC1, 0xF84EFBC1, 0xF84FFBC1, 0xF850FBC1, 0xF851FBC1, 0xF852FBC1, 0xF853FBC1, 0xF854FBC1, 0xF855FBC1, 0xF856FBC1, 0xF857FBC1, 0xF858FBC1, 0xF859FBC1, 0xF85AFBC1, 0xF85BFBC1, 0xF85CFBC1, 0xF85DFBC1, 0xF85EFBC1, 0xF85FFBC1,
+		0xF860FBC1, 0xF861FBC1, 0xF862FBC1, 0xF863FBC1, 0xF864FBC1, 0xF865FBC1, 0xF866FBC1, 0xF867FBC1, 0xF868FBC1, 0xF869FBC1, 0xF86AFBC1, 0xF86BFBC1, 0xF86CFBC1, 0xF86DFBC1, 0xF86EFBC1, 0xF86FFBC1, 0xF870FBC1, 0xF871FBC1, 0xF872FBC1, 0xF873FBC1, 0xF874FBC1, 0xF875FBC1, 0xF876FBC1, 0xF877FBC1, 0xF878FBC1, 0xF879FBC1, 0xF87AFBC1, 0xF87BFBC1, 0xF87CFBC1, 0xF87DFBC1, 0xF87EFBC1, 0xF87FFBC1,
+		0xF880FBC1, 0xF881FBC1, 0xF882FBC1, 0xF883FBC1, 0xF884FBC1, 0xF885FBC1, 0xF886FBC1, 0xF887FBC1, 0xF888FBC1, 0xF889FBC1, 0xF88AFBC1, 0xF88BFBC1, 0xF88CFBC1, 0xF88DFBC1, 0xF88EFBC1, 0xF88FFBC1, 0xF890FBC1, 0xF891FBC1, 0xF892FBC1, 0xF893FBC1, 0xF894FBC1, 0xF895FBC1, 0xF896FBC1, 0xF897FBC1, 0xF898FBC1, 0xF899FBC1, 0xF89AFBC1, 0xF89BFBC1, 0xF89CFBC1, 0xF89DFBC1, 0xF89EFBC1, 0xF89FFBC1,
+		0xF8A0FBC1, 0xF8A1FBC1, 0xF8A2FBC1, 0xF8A3FBC1, 0xF8A4FBC1, 0xF8A5FBC1, 0xF8A6FBC1, 0xF8A7FBC1, 0xF8A8FBC1, 0xF8A9FBC1, 0xF8AAFBC1, 0xF8ABFBC1, 0xF8ACFBC1, 0xF8ADFBC1, 0xF8AEFBC1, 0xF8AFFBC1, 0xF8B0FBC1, 0xF8B1FBC1, 0xF8B2FBC1, 0xF8B3FBC1, 0xF8B4FBC1, 0xF8B5FBC1, 0xF8B6FBC1, 0xF8B7FBC1, 0xF8B8FBC1, 0xF8B9FBC1, 0xF8BAFBC1, 0xF8BBFBC1, 0xF8BCFBC1, 0xF8BDFBC1, 0xF8BEFBC1, 0xF8BFFBC1,
+		0xF8C0FBC1, 0xF8C1FBC1, 0xF8C2FBC1, 0xF8C3FBC1, 0xF8C4FBC1, 0xF8C5FBC1, 0xF8C6FBC1, 0xF8C7FBC1, 0xF8C8FBC1, 0xF8C9FBC1, 0xF8CAFBC1, 0xF8CBFBC1, 0xF8CCFBC1, 0xF8CDFBC1, 0xF8CEFBC1, 0xF8CFFBC1, 0xF8D0FBC1, 0xF8D1FBC1, 0xF8D2FBC1, 0xF8D3FBC1, 0xF8D4FBC1, 0xF8D5FBC1, 0xF8D6FBC1, 0xF8D7FBC1, 0xF8D8FBC1, 0xF8D9FBC1, 0xF8DAFBC1, 0xF8DBFBC1, 0xF8DCFBC1, 0xF8DDFBC1, 0xF8DEFBC1, 0xF8DFFBC1,
+		0xF8E0FBC1, 0xF8E1FBC1, 0xF8E2FBC1, 0xF8E3FBC1, 0xF8E4FBC1, 0xF8E5FBC1, 0xF8E6FBC1, 0xF8E7FBC1, 0xF8E8FBC1, 0xF8E9FBC1, 0xF8EAFBC1, 0xF8EBFBC1, 0xF8ECFBC1, 0xF8EDFBC1, 0xF8EEFBC1, 0xF8EFFBC1, 0xF8F0FBC1, 0xF8F1FBC1, 0xF8F2FBC1, 0xF8F3FBC1, 0xF8F4FBC1, 0xF8F5FBC1, 0xF8F6FBC1, 0xF8F7FBC1, 0xF8F8FBC1, 0xF8F9FBC1, 0xF8FAFBC1, 0xF8FBFBC1, 0xF8FCFBC1, 0xF8FDFBC1, 0xF8FEFBC1, 0xF8FFFBC1,
+		0x8C48FB41, 0xE6F4FB40, 0x8ECAFB41, 0x8CC8FB41, 0xEED1FB40, 0xCE32FB40, 0xD3E5FB40, 0x9F9CFB41, 0x9F9CFB41, 0xD951FB40, 0x91D1FB41, 0xD587FB40, 0xD948FB40, 0xE1F6FB40, 0xF669FB40, 0xFF85FB40, 0x863FFB41, 0x87BAFB41, 0x88F8FB41, 0x908FFB41, 0xEA02FB40, 0xED1BFB40, 0xF0D9FB40, 0xF3DEFB40, 0x843DFB41, 0x916AFB41, 0x99F1FB41, 0xCE82FB40, 0xD375FB40, 0xEB04FB40, 0xF21BFB40, 0x862DFB41,
+		0x9E1EFB41, 0xDD50FB40, 0xEFEBFB40, 0x85CDFB41, 0x8964FB41, 0xE2C9FB40, 0x81D8FB41, 0x881FFB41, 0xDECAFB40, 0xE717FB40, 0xED6AFB40, 0xF2FCFB40, 0x90CEFB41, 0xCF86FB40, 0xD1B7FB40, 0xD2DEFB40, 0xE4C4FB40, 0xEAD3FB40, 0xF210FB40, 0xF6E7FB40, 0x8001FB41, 0x8606FB41, 0x865CFB41, 0x8DEFFB41, 0x9732FB41, 0x9B6FFB41, 0x9DFAFB41, 0xF88CFB40, 0xF97FFB40, 0xFDA0FB40, 0x83C9FB41, 0x9304FB41,
+		0x9E7FFB41, 0x8AD6FB41, 0xD8DFFB40, 0xDF04FB40, 0xFC60FB40, 0x807EFB41, 0xF262FB40, 0xF8CAFB40, 0x8CC2FB41, 0x96F7FB41, 0xD8D8FB40, 0xDC62FB40, 0xEA13FB40, 0xEDDAFB40, 0xEF0FFB40, 0xFD2FFB40, 0xFE37FB40, 0x964BFB41, 0xD2D2FB40, 0x808BFB41, 0xD1DCFB40, 0xD1CCFB40, 0xFA1CFB40, 0xFDBEFB40, 0x83F1FB41, 0x9675FB41, 0x8B80FB41, 0xE2CFFB40, 0xEA02FB40, 0x8AFEFB41, 0xCE39FB40, 0xDBE7FB40,
+		0xE012FB40, 0xF387FB40, 0xF570FB40, 0xD317FB40, 0xF8FBFB40, 0xCFBFFB40, 0xDFA9FB40, 0xCE0DFB40, 0xECCCFB40, 0xE578FB40, 0xFD22FB40, 0xD3C3FB40, 0xD85EFB40, 0xF701FB40, 0x8449FB41, 0x8AAAFB41, 0xEBBAFB40, 0x8FB0FB41, 0xEC88FB40, 0xE2FEFB40, 0x82E5FB41, 0xE3A0FB40, 0xF565FB40, 0xCEAEFB40, 0xD169FB40, 0xD1C9FB40, 0xE881FB40, 0xFCE7FB40, 0x826FFB41, 0x8AD2FB41, 0x91CFFB41, 0xD2F5FB40,
+		0xD442FB40, 0xD973FB40, 0xDEECFB40, 0xE5C5FB40, 0xEFFEFB40, 0xF92AFB40, 0x95ADFB41, 0x9A6AFB41, 0x9E97FB41, 0x9ECEFB41, 0xD29BFB40, 0xE6C6FB40, 0xEB77FB40, 0x8F62FB41, 0xDE74FB40, 0xE190FB40, 0xE200FB40, 0xE49AFB40, 0xEF23FB40, 0xF149FB40, 0xF489FB40, 0xF9CAFB40, 0xFDF4FB40, 0x806FFB41, 0x8F26FB41, 0x84EEFB41, 0x9023FB41, 0x934AFB41, 0xD217FB40, 0xD2A3FB40, 0xD4BDFB40, 0xF0C8FB40,
+		0x88C2FB41, 0x8AAAFB41, 0xDEC9FB40, 0xDFF5FB40, 0xE37BFB40, 0xEBAEFB40, 0xFC3EFB40, 0xF375FB40, 0xCEE4FB40, 0xD6F9FB40, 0xDBE7FB40, 0xDDBAFB40, 0xE01CFB40, 0xF3B2FB40, 0xF469FB40, 0xFF9AFB40, 0x8046FB41, 0x9234FB41, 0x96F6FB41, 0x9748FB41, 0x9818FB41, 0xCF8BFB40, 0xF9AEFB40, 0x91B4FB41, 0x96B8FB41, 0xE0E1FB40, 0xCE86FB40, 0xD0DAFB40, 0xDBEEFB40, 0xDC3FFB40, 0xE599FB40, 0xEA02FB40,
+		0xF1CEFB40, 0xF642FB40, 0x84FCFB41, 0x907CFB41, 0x9F8DFB41, 0xE688FB40, 0x962EFB41, 0xD289FB40, 0xE77BFB40, 0xE7F3FB40, 0xED41FB40, 0xEE9CFB40, 0xF409FB40, 0xF559FB40, 0xF86BFB40, 0xFD10FB40, 0x985EFB41, 0xD16DFB40, 0xE22EFB40, 0x9678FB41, 0xD02BFB40, 0xDD19FB40, 0xEDEAFB40, 0x8F2AFB41, 0xDF8BFB40, 0xE144FB40, 0xE817FB40, 0xF387FB40, 0x9686FB41, 0xD229FB40, 0xD40FFB40, 0xDC65FB40,
+		0xE613FB40, 0xE74EFB40, 0xE8A8FB40, 0xECE5FB40, 0xF406FB40, 0xF5E2FB40, 0xFF79FB40, 0x88CFFB41, 0x88E1FB41, 0x91CCFB41, 0x96E2FB41, 0xD33FFB40, 0xEEBAFB40, 0xD41DFB40, 0xF1D0FB40, 0xF498FB40, 0x85FAFB41, 0x96A3FB41, 0x9C57FB41, 0x9E9FFB41, 0xE797FB40, 0xEDCBFB40, 0x81E8FB41, 0xFACBFB40, 0xFB20FB40, 0xFC92FB40, 0xF2C0FB40, 0xF099FB40, 0x8B58FB41, 0xCEC0FB40, 0x8336FB41, 0xD23AFB40,
+		0xD207FB40, 0xDEA6FB40, 0xE2D3FB40, 0xFCD6FB40, 0xDB85FB40, 0xED1EFB40, 0xE6B4FB40, 0x8F3BFB41, 0x884CFB41, 0x964DFB41, 0x898BFB41, 0xDED3FB40, 0xD140FB40, 0xD5C0FB40, 0xFA0EFB41, 0xFA0FFB41, 0xD85AFB40, 0xFA11FB41, 0xE674FB40, 0xFA13FB41, 0xFA14FB41, 0xD1DEFB40, 0xF32AFB40, 0xF6CAFB40, 0xF93CFB40, 0xF95EFB40, 0xF965FB40, 0xF98FFB40, 0x9756FB41, 0xFCBEFB40, 0xFFBDFB40, 0xFA1FFB41,
+		0x8612FB41, 0xFA21FB41, 0x8AF8FB41, 0xFA23FB41, 0xFA24FB41, 0x9038FB41, 0x90FDFB41, 0xFA27FB41, 0xFA28FB41, 0xFA29FB41, 0x98EFFB41, 0x98FCFB41, 0x9928FB41, 0x9DB4FB41, 0x90DEFB41, 0x96B7FB41, 0xCFAEFB40, 0xD0E7FB40, 0xD14DFB40, 0xD2C9FB40, 0xD2E4FB40, 0xD351FB40, 0xD59DFB40, 0xD606FB40, 0xD668FB40, 0xD840FB40, 0xD8A8FB40, 0xDC64FB40, 0xDC6EFB40, 0xE094FB40, 0xE168FB40, 0xE18EFB40,
+		0xE1F2FB40, 0xE54FFB40, 0xE5E2FB40, 0xE691FB40, 0xE885FB40, 0xED77FB40, 0xEE1AFB40, 0xEF22FB40, 0xF16EFB40, 0xF22BFB40, 0xF422FB40, 0xF891FB40, 0xF93EFB40, 0xF949FB40, 0xF948FB40, 0xF950FB40, 0xF956FB40, 0xF95DFB40, 0xF98DFB40, 0xF98EFB40, 0xFA40FB40, 0xFA81FB40, 0xFBC0FB40, 0xFDF4FB40, 0xFE09FB40, 0xFE41FB40, 0xFF72FB40, 0x8005FB41, 0x81EDFB41, 0x8279FB41, 0x8279FB41, 0x8457FB41,
+		0x8910FB41, 0x8996FB41, 0x8B01FB41, 0x8B39FB41, 0x8CD3FB41, 0x8D08FB41, 0x8FB6FB41, 0x9038FB41, 0x96E3FB41, 0x97FFFB41, 0x983BFB41, 0xE075FB40, 0xC2EEFB84, 0x8218FB41, 0xFA6EFBC1, 0xFA6FFBC1, 0xCE26FB40, 0xD1B5FB40, 0xD168FB40, 0xCF80FB40, 0xD145FB40, 0xD180FB40, 0xD2C7FB40, 0xD2FAFB40, 0xD59DFB40, 0xD555FB40, 0xD599FB40, 0xD5E2FB40, 0xD85AFB40, 0xD8B3FB40, 0xD944FB40, 0xD954FB40,
+		0xDA62FB40, 0xDB28FB40, 0xDED2FB40, 0xDED9FB40, 0xDF69FB40, 0xDFADFB40, 0xE0D8FB40, 0xE14EFB40, 0xE108FB40, 0xE18EFB40, 0xE160FB40, 0xE1F2FB40, 0xE234FB40, 0xE3C4FB40, 0xE41CFB40, 0xE452FB40, 0xE556FB40, 0xE674FB40, 0xE717FB40, 0xE71BFB40, 0xE756FB40, 0xEB79FB40, 0xEBBAFB40, 0xED41FB40, 0xEEDBFB40, 0xEECBFB40, 0xEF22FB40, 0xF01EFB40, 0xF16EFB40, 0xF7A7FB40, 0xF235FB40, 0xF2AFFB40,
+		0xF32AFB40, 0xF471FB40, 0xF506FB40, 0xF53BFB40, 0xF61DFB40, 0xF61FFB40, 0xF6CAFB40, 0xF6DBFB40, 0xF6F4FB40, 0xF74AFB40, 0xF740FB40, 0xF8CCFB40, 0xFAB1FB40, 0xFBC0FB40, 0xFC7BFB40, 0xFD5BFB40, 0xFDF4FB40, 0xFF3EFB40, 0x8005FB41, 0x8352FB41, 0x83EFFB41, 0x8779FB41, 0x8941FB41, 0x8986FB41, 0x8996FB41, 0x8ABFFB41, 0x8AF8FB41, 0x8ACBFB41, 0x8B01FB41, 0x8AFEFB41, 0x8AEDFB41, 0x8B39FB41,
+		0x8B8AFB41, 0x8D08FB41, 0x8F38FB41, 0x9072FB41, 0x9199FB41, 0x9276FB41, 0x967CFB41, 0x96E3FB41, 0x9756FB41, 0x97DBFB41, 0x97FFFB41, 0x980BFB41, 0x983BFB41, 0x9B12FB41, 0x9F9CFB41, 0xA84AFB84, 0xA844FB84, 0xB3D5FB84, 0xBB9DFB80, 0xC018FB80, 0xC039FB80, 0xD249FB84, 0xDCD0FB84, 0xFED3FB84, 0x9F43FB41, 0x9F8EFB41, 0xFADAFBC1, 0xFADBFBC1, 0xFADCFBC1, 0xFADDFBC1, 0xFADEFBC1, 0xFADFFBC1,
+		0xFAE0FBC1, 0xFAE1FBC1, 0xFAE2FBC1, 0xFAE3FBC1, 0xFAE4FBC1, 0xFAE5FBC1, 0xFAE6FBC1, 0xFAE7FBC1, 0xFAE8FBC1, 0xFAE9FBC1, 0xFAEAFBC1, 0xFAEBFBC1, 0xFAECFBC1, 0xFAEDFBC1, 0xFAEEFBC1, 0xFAEFFBC1, 0xFAF0FBC1, 0xFAF1FBC1, 0xFAF2FBC1, 0xFAF3FBC1, 0xFAF4FBC1, 0xFAF5FBC1, 0xFAF6FBC1, 0xFAF7FBC1, 0xFAF8FBC1, 0xFAF9FBC1, 0xFAFAFBC1, 0xFAFBFBC1, 0xFAFCFBC1, 0xFAFDFBC1, 0xFAFEFBC1, 0xFAFFFBC1,
+		0x20422042, 0x20902042, 0x20D62042, 0x209020422042, 0x20D620422042, 0x21F721D2, 0x21F721D2, 0xFB07FBC1, 0xFB08FBC1, 0xFB09FBC1, 0xFB0AFBC1, 0xFB0BFBC1, 0xFB0CFBC1, 0xFB0DFBC1, 0xFB0EFBC1, 0xFB0FFBC1, 0xFB10FBC1, 0xFB11FBC1, 0xFB12FBC1, 0x260C2609, 0x25FA2609, 0x26002609, 0x260C2614, 0x26022609, 0xFB18FBC1, 0xFB19FBC1, 0xFB1AFBC1, 0xFB1BFBC1, 0xFB1CFBC1, 0x2627, 0, 0x26272627,
+		0x262D, 0x261E, 0x2621, 0x2622, 0x2628, 0x2629, 0x262A, 0x2631, 0x2633, 0x666, 0x2632, 0x2632, 0x2632, 0x2632, 0x261E, 0x261E, 0x261E, 0x261F, 0x2620, 0x2621, 0x2622, 0x2623, 0x2624, 0xFB37FBC1, 0x2626, 0x2627, 0x2628, 0x2628, 0x2629, 0xFB3DFBC1, 0x262A, 0xFB3FFBC1,
+		0x262B, 0x262C, 0xFB42FBC1, 0x262E, 0x262E, 0xFB45FBC1, 0x262F, 0x2630, 0x2631, 0x2632, 0x2633, 0x2623, 0x261F, 0x2628, 0x262E, 0x2629261E, 0x2668, 0x2668, 0x2675, 0x2675, 0x2675, 0x2675, 0x2676, 0x2676, 0x2676, 0x2676, 0x2677, 0x2677, 0x2677, 0x2677, 0x2688, 0x2688,
+		0x2688, 0x2688, 0x268B, 0x268B, 0x268B, 0x268B, 0x2687, 0x2687, 0x2687, 0x2687, 0x26E9, 0x26E9, 0x26E9, 0x26E9, 0x26EC, 0x26EC, 0x26EC, 0x26EC, 0x2691, 0x2691, 0x2691, 0x2691, 0x2690, 0x2690, 0x2690, 0x2690, 0x2692, 0x2692, 0x2692, 0x2692, 0x2694, 0x2694,
+		0x2694, 0x2694, 0x26AB, 0x26AB, 0x26AA, 0x26AA, 0x26AD, 0x26AD, 0x26A6, 0x26A6, 0x26BC, 0x26BC, 0x26B5, 0x26B5, 0x26F7, 0x26F7, 0x26F7, 0x26F7, 0x26FF, 0x26FF, 0x26FF, 0x26FF, 0x2704, 0x2704, 0x2704, 0x2704, 0x2702, 0x2702, 0x2702, 0x2702, 0x2719, 0x2719,
+		0x271B, 0x271B, 0x271B, 0x271B, 0x2727, 0x2727, 0x2724, 0x2724, 0x2724, 0x2724, 0x2723, 0x2723, 0x2723, 0x2723, 0x2745, 0x2745, 0x2745, 0x2745, 0x536, 0x537, 0x538, 0x539, 0x53A, 0x53B, 0x53C, 0x53D, 0x53E, 0x53F, 0x540, 0x541, 0x542, 0x543,
+		0x544, 0x545, 0xFBC2FBC1, 0xFBC3FBC1, 0xFBC4FBC1, 0xFBC5FBC1, 0xFBC6FBC1, 0xFBC7FBC1, 0xFBC8FBC1, 0xFBC9FBC1, 0xFBCAFBC1, 0xFBCBFBC1, 0xFBCCFBC1, 0xFBCDFBC1, 0xFBCEFBC1, 0xFBCFFBC1, 0xFBD0FBC1, 0xFBD1FBC1, 0xFBD2FBC1, 0x26FC, 0x26FC, 0x26FC, 0x26FC, 0x272C, 0x272C, 0x272B, 0x272B, 0x272D, 0x272D, 0x2664272C, 0x2730, 0x2730,
+		0x272A, 0x272A, 0x272E, 0x272E, 0x273B, 0x273B, 0x273B, 0x273B, 0x2736, 0x2736, 0x2672266E, 0x2672266E, 0x2727266E, 0x2727266E, 0x2728266E, 0x2728266E, 0x272C266E, 0x272C266E, 0x272B266E, 0x272B266E, 0x272D266E, 0x272D266E, 0x273B266E, 0x273B266E, 0x273B266E, 0x2736266E, 0x2736266E, 0x2736266E, 0x2738, 0x2738, 0x2738, 0x2738,
+		0x268F266E, 0x2699266E, 0x2714266E, 0x2736266E, 0x2737266E, 0x268F2674, 0x26992674, 0x269A2674, 0x27142674, 0x27362674, 0x27372674, 0x268F2685, 0x26992685, 0x269A2685, 0x27142685, 0x27362685, 0x27372685, 0x268F2686, 0x27142686, 0x27362686, 0x27372686, 0x2699268F, 0x2714268F, 0x268F2699, 0x27142699, 0x268F269A, 0x2699269A, 0x2714269A, 0x268F26C6, 0x269926C6, 0x269A26C6, 0x271426C6,
+		0x269926D1, 0x271426D1, 0x268F26D2, 0x269926D2, 0x269A26D2, 0x271426D2, 0x269926D7, 0x271426D7, 0x271426D8, 0x268F26DB, 0x271426DB, 0x268F26DC, 0x271426DC, 0x268F26E4, 0x269926E4, 0x269A26E4, 0x271426E4, 0x273626E4, 0x273726E4, 0x269926F0, 0x271426F0, 0x273626F0, 0x273726F0, 0x267226F6, 0x268F26F6, 0x269926F6, 0x269A26F6, 0x270C26F6, 0x271426F6, 0x273626F6, 0x273726F6, 0x268F270C,
+		0x2699270C, 0x269A270C, 0x2714270C, 0x2736270C, 0x2737270C, 0x268F2714, 0x26992714, 0x269A2714, 0x27142714, 0x27362714, 0x27372714, 0x268F2718, 0x26992718, 0x269A2718, 0x27142718, 0x27362718, 0x27372718, 0x268F2722, 0x27142722, 0x27362722, 0x27372722, 0x268F2737, 0x26992737, 0x269A2737, 0x27142737, 0x27362737, 0x27372737, 0x26A5, 0x26B3, 0x2736, 0, 0,
+		0, 0, 0, 0, 0x26B3266E, 0x26B4266E, 0x2714266E, 0x2718266E, 0x2736266E, 0x2737266E, 0x26B32674, 0x26B42674, 0x27142674, 0x27182674, 0x27362674, 0x27372674, 0x26B32685, 0x26B42685, 0x27142685, 0x27182685, 0x27362685, 0x27372685, 0x26B32686, 0x26B42686, 0x27142686, 0x27182686, 0x27362686, 0x27372686, 0x273626E4, 0x273726E4, 0x273626F0, 0x273726F0,
+		0x267226F6, 0x270C26F6, 0x271426F6, 0x273626F6, 0x273726F6, 0x2714270C, 0x2736270C, 0x2737270C, 0x26722714, 0x27142714, 0x26B32718, 0x26B42718, 0x27142718, 0x27182718, 0x27362718, 0x27372718, 0x2736, 0x26B32737, 0x26B42737, 0x27142737, 0x27182737, 0x27362737, 0x27372737, 0x268F266E, 0x2699266E, 0x269A266E, 0x2714266E, 0x2722266E, 0x268F2674, 0x26992674, 0x269A2674, 0x27142674,
+		0x27222674, 0x268F2685, 0x26992685, 0x269A2685, 0x27142685, 0x27222685, 0x27142686, 0x2699268F, 0x2714268F, 0x268F2699, 0x27142699, 0x268F269A, 0x2714269A, 0x268F26C6, 0x269926C6, 0x269A26C6, 0x271426C6, 0x269926D1, 0x269A26D1, 0x271426D1, 0x268F26D2, 0x269926D2, 0x269A26D2, 0x271426D2, 0x269926D7, 0x271426D8, 0x268F26DB, 0x271426DB, 0x268F26DC, 0x271426DC, 0x268F26E4, 0x269926E4,
+		0x269A26E4, 0x271426E4, 0x269926F0, 0x271426F0, 0x268F26F6, 0x269926F6, 0x269A26F6, 0x270C26F6, 0x271426F6, 0x268F270C, 0x2699270C, 0x269A270C, 0x2714270C, 0x2722270C, 0x268F2714, 0x26992714, 0x269A2714, 0x27142714, 0x268F2718, 0x26992718, 0x269A2718, 0x27142718, 0x27222718, 0x268F2722, 0x27142722, 0x2722, 0x268F2737, 0x26992737, 0x269A2737, 0x27142737, 0x27222737, 0x2714266E,
+		0x2722266E, 0x27142674, 0x27222674, 0x27142685, 0x27222685, 0x27142686, 0x27222686, 0x271426C6, 0x272226C6, 0x271426C7, 0x272226C7, 0x270C26F6, 0x271426F6, 0x2714270C, 0x27142718, 0x27222718, 0x27142737, 0x27222737, 0, 0, 0, 0x273626D7, 0x273726D7, 0x273626DB, 0x273726DB, 0x273626DC, 0x273726DC, 0x273626C6, 0x273726C6, 0x273626C7, 0x273726C7, 0x27362699,
+		0x27372699, 0x2736268F, 0x2737268F, 0x2736269A, 0x2737269A, 0x273626D1, 0x273726D1, 0x273626D2, 0x273726D2, 0x268F26C7, 0x269926C7, 0x269A26C7, 0x271426C7, 0x26B326C7, 0x26B326C6, 0x26B326D1, 0x26B326D2, 0x273626D7, 0x273726D7, 0x273626DB, 0x273726DB, 0x273626DC, 0x273726DC, 0x273626C6, 0x273726C6, 0x273626C7, 0x273726C7, 0x27362699, 0x27372699, 0x2736268F, 0x2737268F, 0x2736269A,
+		0x2737269A, 0x273626D1, 0x273726D1, 0x273626D2, 0x273726D2, 0x268F26C7, 0x269926C7, 0x269A26C7, 0x271426C7, 0x26B326C7, 0x26B326C6, 0x26B326D1, 0x26B326D2, 0x268F26C7, 0x269926C7, 0x269A26C7, 0x271426C7, 0x272226C6, 0x272226C7, 0x271426D7, 0x268F26C6, 0x269926C6, 0x269A26C6, 0x268F26C7, 0x269926C7, 0x269A26C7, 0x271426D7, 0x271426D8, 0x2672, 0x2672, 0x392, 0x393,
+		0xFD40FBC1, 0xFD41FBC1, 0xFD42FBC1, 0xFD43FBC1, 0xFD44FBC1, 0xFD45FBC1, 0xFD46FBC1, 0xFD47FBC1, 0xFD48FBC1, 0xFD49FBC1, 0xFD4AFBC1, 0xFD4BFBC1, 0xFD4CFBC1, 0xFD4DFBC1, 0xFD4EFBC1, 0xFD4FFBC1, 0x2714268F2685, 0x268F26992685, 0x268F26992685, 0x271426992685, 0x2714269A2685, 0x268F27142685, 0x269927142685, 0x269A27142685, 0x26992714268F, 0x26992714268F, 0x273727142699, 0x273627142699, 0x268F269926C6, 0x2699268F26C6, 0x2736268F26C6, 0x2699271426C6,
+		0x2699271426C6, 0x268F271426C6, 0x2714271426C6, 0x2714271426C6, 0x2699269926D1, 0x2699269926D1, 0x2714271426D1, 0x2714269926C7, 0x2714269926C7, 0x2737268F26C7, 0x269A271426C7, 0x269A271426C7, 0x2714271426C7, 0x2714271426C7, 0x2736269926D2, 0x2714269A26D2, 0x2714269A26D2, 0x2699271426D7, 0x2699271426D7, 0x2714271426D7, 0x2737271426D7, 0x2714268F26DB, 0x2714271426DB, 0x2714271426DB, 0x2736271426DB, 0x2714271426DC, 0x2737271426DC, 0x2736271426DC, 0x2714269A26E4, 0x2714269A26E4, 0x2699271426F0, 0x2714271426F0,
+		0x27142699270C, 0x27372699270C, 0x27362699270C, 0x268F268F270C, 0x268F268F270C, 0x2714269A270C, 0x2714269A270C, 0x26992714270C, 0x26992714270C, 0x268F26992714, 0x271426992714, 0x273726992714, 0x2699268F2714, 0x2714268F2714, 0x268F269A2714, 0x2714269A2714, 0xFD90FBC1, 0xFD91FBC1, 0x269A268F2714, 0x268F27142722, 0x271427142722, 0x271426992718, 0x273626992718, 0x2714268F2718, 0x2714268F2718, 0x2736268F2718, 0x273727142718, 0x273627142718, 0x271427142737, 0x271427142737, 0x2737269A2674, 0x2737268F2685,
+		0x2736268F2685, 0x2737269A2685, 0x2736269A2685, 0x273727142685, 0x273627142685, 0x27372714268F, 0x27362699268F, 0x27362714268F, 0x2736269A26C6, 0x2737269926D1, 0x2737269926C7, 0x2737269926D2, 0x2737268F270C, 0x27372714270C, 0x273726992737, 0x2737268F2737, 0x273727142737, 0x273727142714, 0x2737271426F0, 0x273726992718, 0x2699271426F0, 0x27142699270C, 0x2737271426DB, 0x2737271426F6, 0x2699268F2718, 0x2737269A2714, 0x2714268F270C, 0x2714271426F6, 0x2714268F270C, 0x2699268F2718, 0x27372699268F, 0x2737268F2699,
+		0x2737268F2714, 0x2737271426E4, 0x273726992674, 0x2714271426F6, 0x2714268F26DB, 0x2714271426D1, 0x2737269A26C6, 0x2737268F2718, 0xFDC8FBC1, 0xFDC9FBC1, 0xFDCAFBC1, 0xFDCBFBC1, 0xFDCCFBC1, 0xFDCDFBC1, 0xFDCEFBC1, 0xFDCFFBC1, 0xFDD0FBC1, 0xFDD1FBC1, 0xFDD2FBC1, 0xFDD3FBC1, 0xFDD4FBC1, 0xFDD5FBC1, 0xFDD6FBC1, 0xFDD7FBC1, 0xFDD8FBC1, 0xFDD9FBC1, 0xFDDAFBC1, 0xFDDBFBC1, 0xFDDCFBC1, 0xFDDDFBC1, 0xFDDEFBC1, 0xFDDFFBC1,
+		0xFDE0FBC1, 0xFDE1FBC1, 0xFDE2FBC1, 0xFDE3FBC1, 0xFDE4FBC1, 0xFDE5FBC1, 0xFDE6FBC1, 0xFDE7FBC1, 0xFDE8FBC1, 0xFDE9FBC1, 0xFDEAFBC1, 0xFDEBFBC1, 0xFDECFBC1, 0xFDEDFBC1, 0xFDEEFBC1, 0xFDEFFBC1, 0x2745270C26D1, 0x2745270C26F0, 0x2722270C270C2672, 0x26B3267426F62672, 0x26A4271426992714, 0x271426DB270C26D1, 0x270C272826C626B3, 0x27222737270C26DB, 0x2714270C26C62728, 0x2736270C26D1, 0xFFFD, 0xFFFD, 0x270C2672273826B3, 0x535, 0xFDFEFBC1, 0xFDFFFBC1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x223, 0x236, 0x292, 0x240, 0x23A, 0x267, 0x26D, 0x38C, 0x38D, 0x27E027E027E, 0xFE1AFBC1, 0xFE1BFBC1, 0xFE1CFBC1, 0xFE1DFBC1, 0xFE1EFBC1, 0xFE1FFBC1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x27E027E, 0x216, 0x215, 0x20B, 0x20B, 0x328, 0x329, 0x32C, 0x32D, 0x38A, 0x38B, 0x388, 0x389, 0x382, 0x383, 0x380,
+		0x381, 0x384, 0x385, 0x386, 0x387, 0x237, 0x238, 0x32A, 0x32B, 0x20A, 0x20A, 0x20A, 0x20A, 0x20B, 0x20B, 0x20B, 0x223, 0x236, 0x27E, 0xFE53FBC1, 0x23A, 0x240, 0x26D, 0x267, 0x216, 0x328, 0x329, 0x32C, 0x32D, 0x38A, 0x38B, 0x3AC,
+		0x3A9, 0x3A1, 0x666, 0x20D, 0x66A, 0x66C, 0x66B, 0xFE67FBC1, 0x3A7, 0x1F64, 0x3AD, 0x3A0, 0xFE6CFBC1, 0xFE6DFBC1, 0xFE6EFBC1, 0xFE6FFBC1, 0, 0, 0, 0, 0, 0xFE75FBC1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x2664, 0x2665, 0x2665, 0x2666, 0x2666, 0x2669, 0x2669, 0x266A, 0x266A, 0x266E, 0x266E, 0x266E, 0x266E, 0x2672, 0x2672, 0x2674, 0x2674, 0x2674, 0x2674, 0x2684, 0x2684, 0x2685, 0x2685, 0x2685, 0x2685, 0x2686, 0x2686, 0x2686, 0x2686, 0x268F, 0x268F, 0x268F,
+		0x268F, 0x2699, 0x2699, 0x2699, 0x2699, 0x269A, 0x269A, 0x269A, 0x269A, 0x26A4, 0x26A4, 0x26A5, 0x26A5, 0x26B3, 0x26B3, 0x26B4, 0x26B4, 0x26C6, 0x26C6, 0x26C6, 0x26C6, 0x26C7, 0x26C7, 0x26C7, 0x26C7, 0x26D1, 0x26D1, 0x26D1, 0x26D1, 0x26D2, 0x26D2, 0x26D2,
+		0x26D2, 0x26D7, 0x26D7, 0x26D7, 0x26D7, 0x26D8, 0x26D8, 0x26D8, 0x26D8, 0x26DB, 0x26DB, 0x26DB, 0x26DB, 0x26DC, 0x26DC, 0x26DC, 0x26DC, 0x26E4, 0x26E4, 0x26E4, 0x26E4, 0x26F0, 0x26F0, 0x26F0, 0x26F0, 0x26F6, 0x26F6, 0x26F6, 0x26F6, 0x270C, 0x270C, 0x270C,
+		0x270C, 0x2714, 0x2714, 0x2714, 0x2714, 0x2718, 0x2718, 0x2718, 0x2718, 0x2722, 0x2722, 0x2722, 0x2722, 0x2728, 0x2728, 0x2736, 0x2736, 0x2737, 0x2737, 0x2737, 0x2737, 0x2665270C, 0x2665270C, 0x2666270C, 0x2666270C, 0x266A270C, 0x266A270C, 0x2672270C, 0x2672270C, 0xFEFDFBC1, 0xFEFEFBC1, 0,
+		0xFF00FBC1, 0x267, 0x31D, 0x3AC, 0x1F64, 0x3AD, 0x3A9, 0x316, 0x328, 0x329, 0x3A1, 0x666, 0x223, 0x20D, 0x27E, 0x3A6, 0x1F98, 0x1F99, 0x1F9A, 0x1F9B, 0x1F9C, 0x1F9D, 0x1F9E, 0x1F9F, 0x1FA0, 0x1FA1, 0x240, 0x23A, 0x66A, 0x66B, 0x66C, 0x26D,
+		0x3A0, 0x1FA2, 0x1FBC, 0x1FD6, 0x1FEB, 0x2007, 0x2042, 0x2051, 0x2075, 0x2090, 0x20AB, 0x20C4, 0x20D6, 0x2109, 0x2118, 0x213C, 0x216B, 0x2180, 0x2193, 0x21D2, 0x21F7, 0x2217, 0x2247, 0x2259, 0x2264, 0x2270, 0x2286, 0x32A, 0x3A7, 0x32B, 0x4B7, 0x20B,
+		0x4B4, 0x1FA2, 0x1FBC, 0x1FD6, 0x1FEB, 0x2007, 0x2042, 0x2051, 0x2075, 0x2090, 0x20AB, 0x20C4, 0x20D6, 0x2109, 0x2118, 0x213C, 0x216B, 0x2180, 0x2193, 0x21D2, 0x21F7, 0x2217, 0x2247, 0x2259, 0x2264, 0x2270, 0x2286, 0x32C, 0x66E, 0x32D, 0x670, 0x33E,
+		0x33F, 0x292, 0x384, 0x385, 0x236, 0x221, 0x4309, 0x42DA, 0x42DB, 0x42DC, 0x42DE, 0x42DF, 0x42FE, 0x42FF, 0x4300, 0x42EC, 0x1F60, 0x42DA, 0x42DB, 0x42DC, 0x42DE, 0x42DF, 0x42E0, 0x42E1, 0x42E2, 0x42E3, 0x42E4, 0x42E5, 0x42E6, 0x42E7, 0x42E8, 0x42E9,
+		0x42EA, 0x42EB, 0x42EC, 0x42ED, 0x42EE, 0x42EF, 0x42F0, 0x42F1, 0x42F2, 0x42F3, 0x42F4, 0x42F5, 0x42F6, 0x42F7, 0x42F8, 0x42F9, 0x42FA, 0x42FB, 0x42FC, 0x42FD, 0x42FE, 0x42FF, 0x4300, 0x4301, 0x4302, 0x4303, 0x4304, 0x4305, 0x4306, 0x430A, 0, 0,
+		0x41F2, 0x4175, 0x4176, 0x4253, 0x4177, 0x4255, 0x4256, 0x4178, 0x4179, 0x417A, 0x4259, 0x425A, 0x425B, 0x425C, 0x425D, 0x425E, 0x418F, 0x417B, 0x417C, 0x417D, 0x4196, 0x417E, 0x417F, 0x4180, 0x4181, 0x4182, 0x4183, 0x4184, 0x4185, 0x4186, 0x4187, 0xFFBFFBC1,
+		0xFFC0FBC1, 0xFFC1FBC1, 0x41F3, 0x41F4, 0x41F5, 0x41F6, 0x41F7, 0x41F8, 0xFFC8FBC1, 0xFFC9FBC1, 0x41F9, 0x41FA, 0x41FB, 0x41FC, 0x41FD, 0x41FE, 0xFFD0FBC1, 0xFFD1FBC1, 0x41FF, 0x4200, 0x4201, 0x4202, 0x4203, 0x4204, 0xFFD8FBC1, 0xFFD9FBC1, 0x4205, 0x4206, 0x4207, 0xFFDDFBC1, 0xFFDEFBC1, 0xFFDFFBC1,
+		0x1F63, 0x1F65, 0x66D, 0x4B8, 0x66F, 0x1F66, 0x1F80, 0xFFE7FBC1, 0x86B, 0x5EC, 0x5EE, 0x5ED, 0x5EF, 0x909, 0x934, 0xFFEFFBC1, 0xFFF0FBC1, 0xFFF1FBC1, 0xFFF2FBC1, 0xFFF3FBC1, 0xFFF4FBC1, 0xFFF5FBC1, 0xFFF6FBC1, 0xFFF7FBC1, 0xFFF8FBC1, 0, 0, 0, 0x1D55, 0xFFFD, 0xFFFEFBC1, 0xFFFFFBC1,
+	}
+
+	longRuneMap0900 = map[rune][]uint64{
+		0x321D: {0x418141FB41800328, 0x329425441F7}, 0x321E: {0x418741FB41800328, 0x3294200}, 0x327C: {0x4175426041F34183, 0x41FB}, 0x3307: {0x1F6042E242E742DE, 0x42EE},
+		0x3315: {0x430142E2430542E1, 0x42FB}, 0x3316: {0x1F6042FC430542E1, 0x430342EE}, 0x3317: {0x42EC4306430542E1, 0x42EE}, 0x3319: {0x42EE42FB430142E2, 0x430A},
+		0x331A: {0x42DB42E8430342E2, 0x4305}, 0x3320: {0x1F6042EB430A42E5, 0x42FB}, 0x332B: {0x430A42E81F6042F4, 0x42EE}, 0x332E: {0x42EE42E742DA42F5, 0x4303},
+		0x3332: {0x42EC430142DA42F6, 0x42EE}, 0x3334: {0x42DE42E642EC42F6, 0x4303}, 0x3336: {0x1F6042EA42E242F7, 0x4303}, 0x3347: {0x430042E6430A42F9, 0x430A},
+		0x334A: {0x1F6042F4430242FA, 0x4303}, 0x3356: {0x42E342EE430A4304, 0x430A}, 0x337F: {0xDF0FFB40E82AFB40, 0xF93EFB40CF1AFB40}, 0x33AE: {0x6751FEB1FA22193, 0x21D2},
+		0x33AF: {0x6751FEB1FA22193, 0x1F9A21D2}, 0xFDFA: {0x2092736270C26D1, 0x2722270C270C2672, 0x2737270C26DB0209, 0x26C6272802092722, 0x2714270C}, 0xFDFB: {0x268F0209270C268F, 0x2722270C2672270C}, 0xFFFD: {0xFFFD},
+	}
+
+	supplementaryRanges0900 = []supplementaryRange0900{
+		{0x10000, 0x1000B, 0x4CFC}, {0x1000D, 0x10026, 0x4D08}, {0x10028, 0x1003A, 0x4D22}, {0x1003C, 0x1003D, 0x4D35}, {0x1003F, 0x1004D, 0x4D37}, {0x10050, 0x1005D, 0x4D46},
+		{0x10080, 0x100FA, 0x4D54}, {0x10100, 0x10102, 0x30B}, {0x10107, 0x1010F, 0x1F99}, {0x10110, 0x10133, 0x1DBE}, {0x10137, 0x1013F, 0x10C4}, {0x10140, 0x10141, 0x1DE2},
+		{0x10144, 0x10147, 0x1DE4}, {0x10149, 0x1014E, 0x1DE8}, {0x10150, 0x10157, 0x1DEE}, {0x1015A, 0x1015B, 0x1F99}, {0x10160, 0x10172, 0x1DF6}, {0x10174, 0x10178, 0x1E09},
+		{0x10179, 0x10189, 0x10CD}, {0x1018C, 0x1018E, 0x10DE}, {0x10190, 0x1019C, 0x10E1}, {0x101D0, 0x101FC, 0x10EF}, {0x10280, 0x1029C, 0x4AC1}, {0x102A0, 0x102D0, 0x4ADE},
+		{0x102E1, 0x102E9, 0x1F99}, {0x102EA, 0x102FB, 0x1E0F}, {0x10300, 0x1030E, 0x4B29}, {0x1030F, 0x1031E, 0x4B39}, {0x10322, 0x10323, 0x1DB8}, {0x1032D, 0x1034A, 0x4B49},
+		{0x10350, 0x10375, 0x2579}, {0x10380, 0x1039D, 0x50FF}, {0x103A0, 0x103C3, 0x511D}, {0x103C8, 0x103CF, 0x5141}, {0x103D1, 0x103D2, 0x1F99}, {0x103D3, 0x103D5, 0x1E21},
+		{0x10400, 0x10427, 0x4B67}, {0x10428, 0x1047F, 0x4B67}, {0x10480, 0x1049D, 0x4C4A}, {0x104A0, 0x104A9, 0x1F98}, {0x104B0, 0x104D3, 0x3934}, {0x104D8, 0x104FB, 0x3934},
+		{0x10500, 0x10527, 0x4C68}, {0x10530, 0x10563, 0x4C90}, {0x10600, 0x10736, 0x4DCF}, {0x10740, 0x10755, 0x4F06}, {0x10760, 0x10767, 0x4F1C}, {0x10800, 0x10805, 0x4F24},
+		{0x1080A, 0x10835, 0x4F2B}, {0x10837, 0x10838, 0x4F57}, {0x10840, 0x10855, 0x500B}, {0x10858, 0x1085A, 0x1F99}, {0x1085B, 0x1085F, 0x1E33}, {0x10860, 0x1086D, 0x4FCA},
+		{0x1086E, 0x10876, 0x4FD7}, {0x10877, 0x10878, 0x111C}, {0x10879, 0x1087D, 0x1F99}, {0x1087E, 0x1087F, 0x1E24}, {0x10881, 0x10882, 0x4FE0}, {0x10883, 0x10886, 0x4FE1},
+		{0x10887, 0x1088C, 0x4FE4}, {0x1088D, 0x1088E, 0x4FE9}, {0x1088F, 0x10890, 0x4FEA}, {0x10891, 0x10892, 0x4FEB}, {0x10893, 0x10894, 0x4FEC}, {0x10895, 0x1089C, 0x4FED},
+		{0x1089D, 0x1089E, 0x4FF4}, {0x108A7, 0x108AA, 0x1F99}, {0x108AB, 0x108AC, 0x1F9C}, {0x108AD, 0x108AF, 0x1E26}, {0x108E0, 0x108F2, 0x4FF6}, {0x108F4, 0x108F5, 0x5009},
+		{0x108FD, 0x108FF, 0x1E29}, {0x10900, 0x10915, 0x2634}, {0x10917, 0x10919, 0x1E30}, {0x1091A, 0x1091B, 0x1F9A}, {0x10920, 0x10939, 0x4B0F}, {0x10980, 0x10986, 0x59D6},
+		{0x10987, 0x1098A, 0x59DC}, {0x1098B, 0x1098C, 0x59DF}, {0x1098D, 0x1098E, 0x59E0}, {0x1098F, 0x10993, 0x59E1}, {0x10994, 0x10998, 0x59E5}, {0x10999, 0x1099A, 0x59E9},
+		{0x1099B, 0x1099D, 0x59EA}, {0x1099E, 0x1099F, 0x59EF}, {0x109A0, 0x109AF, 0x59D6}, {0x109B0, 0x109B7, 0x59E5}, {0x109BE, 0x109BF, 0x59ED}, {0x109C0, 0x109C8, 0x1F99},
+		{0x109C9, 0x109CF, 0x1EF5}, {0x109D2, 0x109F5, 0x1EFC}, {0x109F6, 0x109FF, 0x1F21}, {0x10A00, 0x10A03, 0x31AF}, {0x10A05, 0x10A06, 0x31B3}, {0x10A11, 0x10A13, 0x31B8},
+		{0x10A15, 0x10A17, 0x31BB}, {0x10A19, 0x10A1A, 0x31BE}, {0x10A1C, 0x10A2C, 0x31C3}, {0x10A2D, 0x10A31, 0x31D5}, {0x10A40, 0x10A43, 0x1F99}, {0x10A44, 0x10A48, 0x1E5E},
+		{0x10A50, 0x10A55, 0x464}, {0x10A56, 0x10A57, 0x2B2}, {0x10A60, 0x10A7C, 0x4F5B}, {0x10A7E, 0x10A7F, 0x1E2C}, {0x10A80, 0x10A9C, 0x4F78}, {0x10A9E, 0x10A9F, 0x1E2E},
+		{0x10AC0, 0x10AC7, 0x5072}, {0x10AC8, 0x10AE4, 0x5079}, {0x10AED, 0x10AEF, 0x1E38}, {0x10AF0, 0x10AF6, 0x46F}, {0x10B00, 0x10B2D, 0x4F95}, {0x10B2E, 0x10B35, 0x4FC2},
+		{0x10B3A, 0x10B3F, 0x2E3}, {0x10B40, 0x10B55, 0x5021}, {0x10B58, 0x10B5B, 0x1F99}, {0x10B5C, 0x10B5F, 0x1E3B}, {0x10B60, 0x10B72, 0x5037}, {0x10B78, 0x10B7B, 0x1F99},
+		{0x10B7C, 0x10B7F, 0x1E3F}, {0x10B80, 0x10B91, 0x504A}, {0x10B99, 0x10B9C, 0x476}, {0x10BA9, 0x10BAC, 0x1F99}, {0x10BAD, 0x10BAF, 0x1E43}, {0x10C01, 0x10C03, 0x3C8C},
+		{0x10C04, 0x10C07, 0x3C8E}, {0x10C08, 0x10C09, 0x3C91}, {0x10C0A, 0x10C0B, 0x3C92}, {0x10C0C, 0x10C0D, 0x3C93}, {0x10C0E, 0x10C0F, 0x3C94}, {0x10C10, 0x10C11, 0x3C95},
+		{0x10C12, 0x10C14, 0x3C96}, {0x10C15, 0x10C16, 0x3C98}, {0x10C17, 0x10C18, 0x3C99}, {0x10C19, 0x10C1A, 0x3C9A}, {0x10C1B, 0x10C1C, 0x3C9B}, {0x10C1D, 0x10C1E, 0x3C9C},
+		{0x10C1F, 0x10C24, 0x3C9D}, {0x10C25, 0x10C26, 0x3CA2}, {0x10C27, 0x10C28, 0x3CA3}, {0x10C29, 0x10C2A, 0x3CA4}, {0x10C2B, 0x10C2D, 0x3CA5}, {0x10C2E, 0x10C32, 0x3CA7},
+		{0x10C33, 0x10C34, 0x3CAB}, {0x10C35, 0x10C36, 0x3CAC}, {0x10C37, 0x10C38, 0x3CAD}, {0x10C39, 0x10C3A, 0x3CAE}, {0x10C3B, 0x10C3F, 0x3CAF}, {0x10C40, 0x10C41, 0x3CB3},
+		{0x10C42, 0x10C43, 0x3CB4}, {0x10C44, 0x10C45, 0x3CB5}, {0x10C46, 0x10C48, 0x3CB6}, {0x10C81, 0x10C89, 0x3C63}, {0x10C8B, 0x10C90, 0x3C6B}, {0x10C91, 0x10C9B, 0x3C70},
+		{0x10C9C, 0x10C9D, 0x3C7A}, {0x10C9F, 0x10CA2, 0x3C7B}, {0x10CA3, 0x10CAA, 0x3C7E}, {0x10CAB, 0x10CAC, 0x3C85}, {0x10CAD, 0x10CB2, 0x3C86}, {0x10CC1, 0x10CC9, 0x3C63},
+		{0x10CCB, 0x10CD0, 0x3C6B}, {0x10CD1, 0x10CDB, 0x3C70}, {0x10CDC, 0x10CDD, 0x3C7A}, {0x10CDF, 0x10CE2, 0x3C7B}, {0x10CE3, 0x10CEA, 0x3C7E}, {0x10CEB, 0x10CEC, 0x3C85},
+		{0x10CED, 0x10CF2, 0x3C86}, {0x10CFC, 0x10CFF, 0x1DBA}, {0x10D01, 0x10D1C, 0x3621}, {0x10D1D, 0x10D21, 0x361C}, {0x10D22, 0x10D23, 0x363D}, {0x10D30, 0x10D39, 0x1F98},
+		{0x10E60, 0x10E68, 0x1F99}, {0x10E69, 0x10E7E, 0x1DA1}, {0x10E80, 0x10EA0, 0x50BE}, {0x10EA1, 0x10EA8, 0x50E0}, {0x10F01, 0x10F02, 0x5096}, {0x10F03, 0x10F05, 0x5097},
+		{0x10F06, 0x10F0E, 0x5099}, {0x10F10, 0x10F12, 0x50A1}, {0x10F13, 0x10F15, 0x50A3}, {0x10F17, 0x10F1A, 0x50A5}, {0x10F1D, 0x10F21, 0x1F99}, {0x10F22, 0x10F26, 0x1E46},
+		{0x10F30, 0x10F44, 0x50A9}, {0x10F52, 0x10F54, 0x1E4B}, {0x10F55, 0x10F59, 0x2E9}, {0x10FB0, 0x10FC4, 0x50EA}, {0x10FC5, 0x10FC8, 0x1F99}, {0x10FC9, 0x10FCB, 0x1E4E},
+		{0x10FE0, 0x10FF5, 0x505C}, {0x11003, 0x11004, 0x3199}, {0x11005, 0x11033, 0x316A}, {0x11034, 0x11046, 0x319B}, {0x11047, 0x11048, 0x2B4}, {0x11049, 0x1104D, 0x45F},
+		{0x11052, 0x1105A, 0x1F99}, {0x1105B, 0x11065, 0x1E53}, {0x11066, 0x1106F, 0x1F98}, {0x11083, 0x11099, 0x2D47}, {0x1109A, 0x1109B, 0x2D5D}, {0x1109C, 0x110AA, 0x2D5E},
+		{0x110AC, 0x110B9, 0x2D6D}, {0x110BB, 0x110BC, 0x47A}, {0x110BE, 0x110BF, 0x2EE}, {0x110C0, 0x110C1, 0x2B6}, {0x110D0, 0x110E8, 0x4CC4}, {0x110F0, 0x110F9, 0x1F98},
+		{0x11103, 0x11123, 0x363F}, {0x11124, 0x11126, 0x3661}, {0x11127, 0x11132, 0x3665}, {0x11133, 0x11134, 0x3673}, {0x11136, 0x1113F, 0x1F98}, {0x11141, 0x11142, 0x2B8},
+		{0x11145, 0x11146, 0x3671}, {0x11150, 0x1116F, 0x2D7B}, {0x11170, 0x11172, 0x2D9C}, {0x11174, 0x11175, 0x47C}, {0x11183, 0x111B2, 0x2DA1}, {0x111B3, 0x111BC, 0x2DD5},
+		{0x111BD, 0x111C0, 0x2DE0}, {0x111C1, 0x111C3, 0x2DD1}, {0x111C5, 0x111C6, 0x2BA}, {0x111D0, 0x111D9, 0x1F98}, {0x111DE, 0x111DF, 0x2F2}, {0x111E1, 0x111E9, 0x1F99},
+		{0x111EA, 0x111F4, 0x1E6D}, {0x11200, 0x11211, 0x2DE4}, {0x11213, 0x11233, 0x2DF6}, {0x11238, 0x11239, 0x2BC}, {0x1123A, 0x1123D, 0x482}, {0x11280, 0x11283, 0x2E51},
+		{0x11284, 0x11286, 0x2E57}, {0x1128A, 0x1128D, 0x2E5B}, {0x1128F, 0x1129D, 0x2E5F}, {0x1129F, 0x112A4, 0x2E6E}, {0x112A5, 0x112A6, 0x2E55}, {0x112A7, 0x112A8, 0x2E74},
+		{0x112B0, 0x112DE, 0x2E18}, {0x112E0, 0x112E8, 0x2E47}, {0x112F0, 0x112F9, 0x1F98}, {0x11305, 0x1130B, 0x2E77}, {0x1130F, 0x11310, 0x2E81}, {0x11313, 0x11328, 0x2E83},
+		{0x1132A, 0x11330, 0x2E99}, {0x11332, 0x11333, 0x2EA0}, {0x11335, 0x11339, 0x2EA2}, {0x1133E, 0x11344, 0x2EAA}, {0x11347, 0x11348, 0x2EB3}, {0x1134B, 0x1134D, 0x2EB5},
+		{0x1135E, 0x1135F, 0x2EA8}, {0x11362, 0x11363, 0x2EB1}, {0x11400, 0x11434, 0x2EBC}, {0x11435, 0x11442, 0x2EF6}, {0x11449, 0x1144A, 0x2EBA}, {0x1144B, 0x1144C, 0x2BE},
+		{0x1144E, 0x1144F, 0x488}, {0x11450, 0x11459, 0x1F98}, {0x11460, 0x11461, 0x2EF2}, {0x11480, 0x114AF, 0x2F05}, {0x114B0, 0x114BE, 0x2F37}, {0x114C4, 0x114C5, 0x2F35},
+		{0x114D0, 0x114D9, 0x1F98}, {0x11580, 0x115B5, 0x2F47}, {0x115B8, 0x115BB, 0x2F7D}, {0x115C2, 0x115C3, 0x2C0}, {0x115C4, 0x115D7, 0x48E}, {0x115D9, 0x115DB, 0x2F49},
+		{0x115DC, 0x115DD, 0x2F79}, {0x11600, 0x1163C, 0x2F82}, {0x11641, 0x11642, 0x2C2}, {0x11650, 0x11659, 0x1F98}, {0x11660, 0x1166C, 0x3F3}, {0x11680, 0x11689, 0x2FC1},
+		{0x1168A, 0x1168B, 0x2FCE}, {0x1168C, 0x116A6, 0x2FD1}, {0x116AD, 0x116B6, 0x2FED}, {0x116C0, 0x116C9, 0x1F98}, {0x11700, 0x11704, 0x30A5}, {0x11705, 0x11715, 0x30A9},
+		{0x11716, 0x11719, 0x30B9}, {0x1171D, 0x1171F, 0x30C9}, {0x11720, 0x1172B, 0x30BD}, {0x11730, 0x11739, 0x1F98}, {0x1173A, 0x1173B, 0x1E51}, {0x1173C, 0x1173D, 0x2C4},
+		{0x11800, 0x11836, 0x306D}, {0x118A0, 0x118BF, 0x49AF}, {0x118C0, 0x118DF, 0x49AF}, {0x118E0, 0x118E9, 0x1F98}, {0x118EA, 0x118F2, 0x1E78}, {0x11900, 0x11906, 0x2FF7},
+		{0x1190C, 0x11910, 0x2FFF}, {0x11911, 0x11913, 0x3005}, {0x11915, 0x11916, 0x3008}, {0x11918, 0x11925, 0x300A}, {0x11928, 0x11935, 0x301D}, {0x11937, 0x11938, 0x302B},
+		{0x1193D, 0x1193E, 0x302D}, {0x11950, 0x11959, 0x1F98}, {0x119A0, 0x119A7, 0x302F}, {0x119AA, 0x119D0, 0x3037}, {0x119D1, 0x119D7, 0x3060}, {0x119DB, 0x119DD, 0x3069},
+		{0x11A00, 0x11A0A, 0x3361}, {0x11A0C, 0x11A31, 0x333B}, {0x11A3B, 0x11A3E, 0x3359}, {0x11A3F, 0x11A46, 0x426}, {0x11A50, 0x11A53, 0x336E}, {0x11A57, 0x11A58, 0x3377},
+		{0x11A59, 0x11A5A, 0x3372}, {0x11A5B, 0x11A5C, 0x3379}, {0x11A5D, 0x11A82, 0x337C}, {0x11A84, 0x11A85, 0x33A2}, {0x11A86, 0x11A87, 0x339B}, {0x11A8E, 0x11A8F, 0x338E},
+		{0x11A91, 0x11A92, 0x339B}, {0x11A9A, 0x11A9C, 0x42E}, {0x11A9E, 0x11AA2, 0x431}, {0x11AC0, 0x11AD4, 0x49D6}, {0x11AD5, 0x11ADB, 0x49CF}, {0x11ADC, 0x11ADD, 0x49EB},
+		{0x11ADF, 0x11AE3, 0x49ED}, {0x11C00, 0x11C08, 0x31DB}, {0x11C0A, 0x11C2E, 0x31E4}, {0x11C2F, 0x11C36, 0x320A}, {0x11C38, 0x11C3B, 0x3212}, {0x11C41, 0x11C42, 0x2C7},
+		{0x11C43, 0x11C45, 0x46B}, {0x11C50, 0x11C59, 0x1F98}, {0x11C5A, 0x11C62, 0x1F99}, {0x11C63, 0x11C6C, 0x1E63}, {0x11C70, 0x11C71, 0x436}, {0x11C88, 0x11C89, 0x33D2},
+		{0x11CAF, 0x11CB4, 0x33E0}, {0x11D00, 0x11D06, 0x30CC}, {0x11D08, 0x11D09, 0x30D3}, {0x11D0B, 0x11D36, 0x30D5}, {0x11D3C, 0x11D3D, 0x3102}, {0x11D44, 0x11D45, 0x3105},
+		{0x11D50, 0x11D59, 0x1F98}, {0x11D60, 0x11D65, 0x3108}, {0x11D67, 0x11D68, 0x310E}, {0x11D6A, 0x11D8E, 0x3110}, {0x11D90, 0x11D91, 0x3135}, {0x11D93, 0x11D94, 0x3137},
+		{0x11DA0, 0x11DA9, 0x1F98}, {0x11EE0, 0x11EF6, 0x34F1}, {0x11EF7, 0x11EF8, 0x2F7}, {0x11FC0, 0x11FD4, 0x1D6B}, {0x11FD5, 0x11FDC, 0x550}, {0x11FDD, 0x11FE0, 0x1F71},
+		{0x11FE1, 0x11FF1, 0x558}, {0x12000, 0x12262, 0x5149}, {0x12263, 0x122D3, 0x53AE}, {0x122D4, 0x122D5, 0x53AC}, {0x122D6, 0x12399, 0x541F}, {0x12400, 0x12407, 0x1F9A},
+		{0x12408, 0x1240E, 0x1F9B}, {0x1240F, 0x12414, 0x1F9C}, {0x12415, 0x1241D, 0x1F99}, {0x1241E, 0x12422, 0x1F99}, {0x12423, 0x12424, 0x1F9A}, {0x12425, 0x1242B, 0x1F9B},
+		{0x1242C, 0x1242E, 0x1F99}, {0x1242F, 0x12431, 0x1F9B}, {0x12432, 0x12433, 0x1F2C}, {0x12434, 0x12436, 0x1F99}, {0x12437, 0x12439, 0x1F9B}, {0x1243B, 0x1243C, 0x1F9B},
+		{0x12440, 0x12441, 0x1F9E}, {0x12443, 0x12444, 0x1F9F}, {0x12445, 0x12446, 0x1FA0}, {0x1244A, 0x1244E, 0x1F9A}, {0x1244F, 0x12452, 0x1F99}, {0x12453, 0x12454, 0x1F9C},
+		{0x12456, 0x12457, 0x1F9A}, {0x12458, 0x12459, 0x1F99}, {0x1245A, 0x12468, 0x1F2E}, {0x12469, 0x1246E, 0x1F9C}, {0x12470, 0x12474, 0x311}, {0x12480, 0x12543, 0x54E3},
+		{0x13000, 0x1342E, 0x55A7}, {0x14400, 0x14646, 0x59F1}, {0x16800, 0x16A38, 0x3E16}, {0x16A40, 0x16A5E, 0x4CDD}, {0x16A60, 0x16A69, 0x1F98}, {0x16A6E, 0x16A6F, 0x2C9},
+		{0x16AD0, 0x16AED, 0x404F}, {0x16B00, 0x16B2F, 0x4A08}, {0x16B37, 0x16B3B, 0x4A7}, {0x16B3C, 0x16B3F, 0x111E}, {0x16B40, 0x16B41, 0x4A38}, {0x16B42, 0x16B43, 0x1F53},
+		{0x16B50, 0x16B59, 0x1F98}, {0x16B5B, 0x16B61, 0x1E81}, {0x16B63, 0x16B77, 0x4A3A}, {0x16B7D, 0x16B8F, 0x4A4F}, {0x16E40, 0x16E5F, 0x4132}, {0x16E60, 0x16E7F, 0x4132},
+		{0x16E80, 0x16E89, 0x1F98}, {0x16E8A, 0x16E93, 0x1E88}, {0x16E94, 0x16E96, 0x1F99}, {0x16E99, 0x16E9A, 0x4AD}, {0x16F00, 0x16F03, 0x491D}, {0x16F04, 0x16F05, 0x4922},
+		{0x16F07, 0x16F0D, 0x4924}, {0x16F0E, 0x16F0F, 0x492C}, {0x16F10, 0x16F12, 0x492F}, {0x16F14, 0x16F24, 0x4932}, {0x16F26, 0x16F32, 0x4943}, {0x16F34, 0x16F39, 0x4953},
+		{0x16F3A, 0x16F3E, 0x495A}, {0x16F40, 0x16F44, 0x495F}, {0x16F50, 0x16F53, 0x4964}, {0x16F54, 0x16F58, 0x4969}, {0x16F59, 0x16F5C, 0x496F}, {0x16F5D, 0x16F60, 0x4974},
+		{0x16F61, 0x16F65, 0x4979}, {0x16F66, 0x16F6D, 0x497F}, {0x16F6E, 0x16F72, 0x4989}, {0x16F73, 0x16F74, 0x498F}, {0x16F75, 0x16F7E, 0x4993}, {0x16F8F, 0x16F9F, 0x499D},
+		{0x16FE0, 0x16FE1, 0x1F59}, {0x1B002, 0x1B00E, 0x430B}, {0x1B00F, 0x1B11E, 0x4319}, {0x1B150, 0x1B152, 0x4307}, {0x1B164, 0x1B167, 0x4307}, {0x1BC00, 0x1BC6A, 0x4BBF},
+		{0x1BC70, 0x1BC7C, 0x4C2A}, {0x1BC80, 0x1BC88, 0x4C37}, {0x1BC90, 0x1BC99, 0x4C40}, {0x1D000, 0x1D0F5, 0x1124}, {0x1D100, 0x1D126, 0x121A}, {0x1D12A, 0x1D139, 0x1244},
+		{0x1D13A, 0x1D15D, 0x1255}, {0x1D15E, 0x1D15F, 0x1272}, {0x1D16A, 0x1D16C, 0x1279}, {0x1D183, 0x1D184, 0x127C}, {0x1D18C, 0x1D1A9, 0x127E}, {0x1D1AE, 0x1D1BA, 0x129C},
+		{0x1D1BB, 0x1D1BC, 0x12A7}, {0x1D1BD, 0x1D1BE, 0x12A7}, {0x1D1BF, 0x1D1E8, 0x12A7}, {0x1D200, 0x1D241, 0x12D1}, {0x1D2E0, 0x1D2E9, 0x1F98}, {0x1D2EA, 0x1D2F3, 0x1E92},
+		{0x1D300, 0x1D356, 0x1036}, {0x1D360, 0x1D368, 0x1F99}, {0x1D369, 0x1D371, 0x1F3D}, {0x1D372, 0x1D376, 0x1F99}, {0x1D6A8, 0x1D6AA, 0x231E}, {0x1D6AB, 0x1D6AC, 0x2322},
+		{0x1D6AE, 0x1D6B0, 0x2329}, {0x1D6B1, 0x1D6B2, 0x232D}, {0x1D6B3, 0x1D6B7, 0x2330}, {0x1D6BB, 0x1D6BF, 0x2340}, {0x1D6C2, 0x1D6C4, 0x231E}, {0x1D6C5, 0x1D6C6, 0x2322},
+		{0x1D6C8, 0x1D6CA, 0x2329}, {0x1D6CB, 0x1D6CC, 0x232D}, {0x1D6CD, 0x1D6D1, 0x2330}, {0x1D6D5, 0x1D6D9, 0x2340}, {0x1D6E2, 0x1D6E4, 0x231E}, {0x1D6E5, 0x1D6E6, 0x2322},
+		{0x1D6E8, 0x1D6EA, 0x2329}, {0x1D6EB, 0x1D6EC, 0x232D}, {0x1D6ED, 0x1D6F1, 0x2330}, {0x1D6F5, 0x1D6F9, 0x2340}, {0x1D6FC, 0x1D6FE, 0x231E}, {0x1D6FF, 0x1D700, 0x2322},
+		{0x1D702, 0x1D704, 0x2329}, {0x1D705, 0x1D706, 0x232D}, {0x1D707, 0x1D70B, 0x2330}, {0x1D70F, 0x1D713, 0x2340}, {0x1D71C, 0x1D71E, 0x231E}, {0x1D71F, 0x1D720, 0x2322},
+		{0x1D722, 0x1D724, 0x2329}, {0x1D725, 0x1D726, 0x232D}, {0x1D727, 0x1D72B, 0x2330}, {0x1D72F, 0x1D733, 0x2340}, {0x1D736, 0x1D738, 0x231E}, {0x1D739, 0x1D73A, 0x2322},
+		{0x1D73C, 0x1D73E, 0x2329}, {0x1D73F, 0x1D740, 0x232D}, {0x1D741, 0x1D745, 0x2330}, {0x1D749, 0x1D74D, 0x2340}, {0x1D756, 0x1D758, 0x231E}, {0x1D759, 0x1D75A, 0x2322},
+		{0x1D75C, 0x1D75E, 0x2329}, {0x1D75F, 0x1D760, 0x232D}, {0x1D761, 0x1D765, 0x2330}, {0x1D769, 0x1D76D, 0x2340}, {0x1D770, 0x1D772, 0x231E}, {0x1D773, 0x1D774, 0x2322},
+		{0x1D776, 0x1D778, 0x2329}, {0x1D779, 0x1D77A, 0x232D}, {0x1D77B, 0x1D77F, 0x2330}, {0x1D783, 0x1D787, 0x2340}, {0x1D790, 0x1D792, 0x231E}, {0x1D793, 0x1D794, 0x2322},
+		{0x1D796, 0x1D798, 0x2329}, {0x1D799, 0x1D79A, 0x232D}, {0x1D79B, 0x1D79F, 0x2330}, {0x1D7A3, 0x1D7A7, 0x2340}, {0x1D7AA, 0x1D7AC, 0x231E}, {0x1D7AD, 0x1D7AE, 0x2322},
+		{0x1D7B0, 0x1D7B2, 0x2329}, {0x1D7B3, 0x1D7B4, 0x232D}, {0x1D7B5, 0x1D7B9, 0x2330}, {0x1D7BD, 0x1D7C1, 0x2340}, {0x1D7CE, 0x1D7D7, 0x1F98}, {0x1D7D8, 0x1D7E1, 0x1F98},
+		{0x1D7E2, 0x1D7EB, 0x1F98}, {0x1D7EC, 0x1D7F5, 0x1F98}, {0x1D7F6, 0x1D7FF, 0x1F98}, {0x1D800, 0x1D9FF, 0x1AFF}, {0x1DA37, 0x1DA3A, 0x1CFF}, {0x1DA6D, 0x1DA74, 0x1D03},
+		{0x1DA76, 0x1DA83, 0x1D0B}, {0x1DA85, 0x1DA86, 0x1D19}, {0x1DA87, 0x1DA8B, 0x4AF}, {0x1E000, 0x1E006, 0x254A}, {0x1E008, 0x1E018, 0x2552}, {0x1E01B, 0x1E021, 0x2565},
+		{0x1E023, 0x1E024, 0x256D}, {0x1E026, 0x1E02A, 0x2570}, {0x1E100, 0x1E12C, 0x4A62}, {0x1E137, 0x1E13B, 0x4A8F}, {0x1E13C, 0x1E13D, 0x1F55}, {0x1E140, 0x1E149, 0x1F98},
+		{0x1E2C0, 0x1E2EB, 0x4A95}, {0x1E2F0, 0x1E2F9, 0x1F98}, {0x1E800, 0x1E8C4, 0x406D}, {0x1E8C7, 0x1E8CF, 0x1F99}, {0x1E900, 0x1E921, 0x4152}, {0x1E922, 0x1E943, 0x4152},
+		{0x1E950, 0x1E959, 0x1F98}, {0x1EC71, 0x1EC79, 0x1F99}, {0x1EC7A, 0x1ECA2, 0x1E9C}, {0x1ECA3, 0x1ECAB, 0x1F99}, {0x1ECAD, 0x1ECAF, 0x1EC5}, {0x1ECB1, 0x1ECB2, 0x1F99},
+		{0x1ECB3, 0x1ECB4, 0x1EC8}, {0x1ED01, 0x1ED09, 0x1F99}, {0x1ED0A, 0x1ED2D, 0x1ECA}, {0x1ED2F, 0x1ED36, 0x1F9A}, {0x1ED37, 0x1ED3D, 0x1EEE}, {0x1EE15, 0x1EE16, 0x2685},
+		{0x1EE35, 0x1EE36, 0x2685}, {0x1EE75, 0x1EE76, 0x2685}, {0x1EE95, 0x1EE96, 0x2685}, {0x1EEB5, 0x1EEB6, 0x2685}, {0x1EEF0, 0x1EEF1, 0x52F}, {0x1F000, 0x1F02B, 0x1317},
+		{0x1F030, 0x1F093, 0x1343}, {0x1F0A0, 0x1F0AE, 0x13A7}, {0x1F0B1, 0x1F0BF, 0x13B6}, {0x1F0C1, 0x1F0CF, 0x13C5}, {0x1F0D1, 0x1F0F5, 0x13D4}, {0x1F10D, 0x1F10F, 0x145B},
+		{0x1F16D, 0x1F16F, 0x145E}, {0x1F1E6, 0x1F1FF, 0xB22}, {0x1F260, 0x1F265, 0x1461}, {0x1F300, 0x1F5FF, 0x1467}, {0x1F600, 0x1F6D7, 0x189E}, {0x1F6E0, 0x1F6EC, 0x1976},
+		{0x1F6F0, 0x1F6FC, 0x1983}, {0x1F700, 0x1F773, 0x1990}, {0x1F780, 0x1F7D8, 0x1A04}, {0x1F7E0, 0x1F7EB, 0x1A5D}, {0x1F800, 0x1F80B, 0x1A69}, {0x1F810, 0x1F847, 0x1A75},
+		{0x1F850, 0x1F859, 0x1AAD}, {0x1F860, 0x1F887, 0x1AB7}, {0x1F890, 0x1F8AD, 0x1ADF}, {0x1F8B0, 0x1F8B1, 0x1AFD}, {0x1F900, 0x1F978, 0x1767}, {0x1F97A, 0x1F9CB, 0x17E0},
+		{0x1F9CD, 0x1F9FF, 0x1832}, {0x1FA00, 0x1FA53, 0x13F9}, {0x1FA60, 0x1FA6D, 0x144D}, {0x1FA70, 0x1FA74, 0x1865}, {0x1FA78, 0x1FA7A, 0x186A}, {0x1FA80, 0x1FA86, 0x186D},
+		{0x1FA90, 0x1FAA8, 0x1874}, {0x1FAB0, 0x1FAB6, 0x188D}, {0x1FAC0, 0x1FAC2, 0x1894}, {0x1FAD0, 0x1FAD6, 0x1897}, {0x1FB00, 0x1FB92, 0x969}, {0x1FB94, 0x1FBCA, 0x9FC},
+		{0x1FBF0, 0x1FBF9, 0x1F98},
+	}
+
+	supplementaryMap0900 = map[rune][]uint64{
+		0x10142: {0x1F99}, 0x10143: {0x1F9D}, 0x10148: {0x1F9D}, 0x1014F: {0x1F9D}, 0x10158: {0x1F99}, 0x10159: {0x1F99},
+		0x1015C: {0x1F9A}, 0x1015D: {0x1F9A}, 0x1015E: {0x1F9A}, 0x1015F: {0x1F9D}, 0x10173: {0x1F9D}, 0x1018A: {0x1F98},
+		0x1018B: {0x1E0E}, 0x101A0: {0x10EE}, 0x101FD: {}, 0x102E0: {}, 0x1031F: {0x4B38}, 0x10320: {0x1F99},
+		0x10321: {0x1F9D}, 0x10376: {0x2579}, 0x10377: {0x257C}, 0x10378: {0x2580}, 0x10379: {0x2586}, 0x1037A: {0x258A},
+		0x1039F: {0x30E}, 0x103D0: {0x30F}, 0x1056F: {0x45E}, 0x10808: {0x4F2A}, 0x1083C: {0x4F59}, 0x1083F: {0x4F5A},
+		0x10857: {0x2E2}, 0x10880: {0x4FE0}, 0x108FB: {0x1F99}, 0x108FC: {0x1F9D}, 0x10916: {0x1F99}, 0x1091F: {0x310},
+		0x1093F: {0x30A}, 0x109BC: {0x1F2B}, 0x109BD: {0x1F20}, 0x10A0C: {0x31B5}, 0x10A0D: {}, 0x10A0E: {},
+		0x10A0F: {}, 0x10A10: {0x31B6}, 0x10A1B: {0x31C1}, 0x10A32: {0x31B7}, 0x10A33: {0x31C2}, 0x10A34: {0x31C0},
+		0x10A35: {0x31D4}, 0x10A38: {}, 0x10A39: {}, 0x10A3A: {}, 0x10A3F: {0x31DA}, 0x10A58: {0x46A},
+		0x10A7D: {0x1F99}, 0x10A9D: {0x1F99}, 0x10AE5: {}, 0x10AE6: {}, 0x10AEB: {0x1F99}, 0x10AEC: {0x1F9D},
+		0x10B39: {0x46E}, 0x10C00: {0x3C8C}, 0x10C80: {0x3C63}, 0x10C8A: {0x3C6B}, 0x10C9E: {0x3C7B}, 0x10CC0: {0x3C63},
+		0x10CCA: {0x3C6B}, 0x10CDE: {0x3C7B}, 0x10CFA: {0x1F99}, 0x10CFB: {0x1F9D}, 0x10D00: {0x361B}, 0x10D24: {},
+		0x10D25: {}, 0x10D26: {}, 0x10D27: {}, 0x10EA9: {0x50E9}, 0x10EAB: {}, 0x10EAC: {},
+		0x10EAD: {0x222}, 0x10EB0: {0x50DF}, 0x10EB1: {0x50E8}, 0x10F00: {0x5096}, 0x10F0F: {0x50A1}, 0x10F16: {0x50A5},
+		0x10F1B: {0x50A8}, 0x10F1C: {0x50A8}, 0x10F27: {0x50A650A3}, 0x10F45: {0x50BA}, 0x10F46: {}, 0x10F47: {},
+		0x10F48: {}, 0x10F49: {}, 0x10F4A: {}, 0x10F4B: {}, 0x10F4C: {}, 0x10F4D: {},
+		0x10F4E: {}, 0x10F4F: {}, 0x10F50: {}, 0x10F51: {0x1F99}, 0x10FF6: {0x50655062}, 0x11000: {},
+		0x11001: {}, 0x11002: {}, 0x1107F: {0x31AE}, 0x11080: {}, 0x11081: {}, 0x11082: {},
+		0x110AB: {0x2D67}, 0x110BA: {}, 0x110BD: {}, 0x110CD: {}, 0x11100: {}, 0x11101: {},
+		0x11102: {}, 0x11140: {0x2F0}, 0x11143: {0x27A}, 0x11144: {0x3664}, 0x11147: {0x3660}, 0x11173: {},
+		0x11176: {0x2D9B}, 0x11180: {}, 0x11181: {}, 0x11182: {}, 0x111C4: {0x2D9F}, 0x111C7: {0x47F},
+		0x111C8: {0x2F1}, 0x111C9: {}, 0x111CA: {}, 0x111CB: {}, 0x111CC: {}, 0x111CD: {0x47E},
+		0x111CE: {0x2DDF}, 0x111CF: {}, 0x111DA: {0x2DA0}, 0x111DB: {0x480}, 0x111DC: {0x2DD4}, 0x111DD: {0x481},
+		0x11234: {}, 0x11235: {0x2E17}, 0x11236: {}, 0x11237: {}, 0x1123E: {}, 0x11288: {0x2E5A},
+		0x112A9: {0x2F4}, 0x112DF: {}, 0x112E9: {}, 0x112EA: {0x2E50}, 0x11300: {}, 0x11301: {},
+		0x11302: {}, 0x11303: {}, 0x1130C: {0x2E7F}, 0x1133B: {}, 0x1133C: {}, 0x1133D: {0x2EA7},
+		0x11350: {0x2E76}, 0x11357: {0x2EB8}, 0x1135D: {0x2EB9}, 0x11360: {0x2E7E}, 0x11361: {0x2E80}, 0x11366: {},
+		0x11367: {}, 0x11368: {}, 0x11369: {}, 0x1136A: {}, 0x1136B: {}, 0x1136C: {},
+		0x11370: {}, 0x11371: {}, 0x11372: {}, 0x11373: {}, 0x11374: {}, 0x11443: {},
+		0x11444: {}, 0x11445: {}, 0x11446: {}, 0x11447: {0x2EF1}, 0x11448: {0x2EF4}, 0x1144D: {0x486},
+		0x1145A: {0x487}, 0x1145B: {0x48A}, 0x1145D: {0x48B}, 0x1145E: {}, 0x1145F: {0x2EF5}, 0x114BF: {},
+		0x114C0: {}, 0x114C1: {}, 0x114C2: {0x2F46}, 0x114C3: {}, 0x114C6: {0x48C}, 0x114C7: {0x2F04},
+		0x115BC: {}, 0x115BD: {}, 0x115BE: {}, 0x115BF: {0x2F81}, 0x115C0: {}, 0x115C1: {0x48D},
+		0x115D8: {0x2F49}, 0x1163D: {}, 0x1163E: {}, 0x1163F: {0x2FBF}, 0x11640: {}, 0x11643: {0x4A2},
+		0x11644: {0x2FC0}, 0x116A7: {0x2FCC}, 0x116A8: {0x2FCB}, 0x116A9: {0x2FCD}, 0x116AA: {0x2FEC}, 0x116AB: {},
+		0x116AC: {}, 0x116B7: {}, 0x116B8: {0x2FD0}, 0x1171A: {0x30AC}, 0x1173E: {0x2F5}, 0x1173F: {0x59E},
+		0x11837: {}, 0x11838: {}, 0x11839: {0x30A4}, 0x1183A: {}, 0x1183B: {0x4A3}, 0x118FF: {0x49AE},
+		0x11909: {0x2FFE}, 0x11926: {0x3019}, 0x11927: {0x301B}, 0x1193B: {}, 0x1193C: {}, 0x1193F: {0x3004},
+		0x11940: {0x3018}, 0x11941: {0x301A}, 0x11942: {0x301C}, 0x11943: {}, 0x11944: {0x2C6}, 0x11945: {0x4A4},
+		0x11946: {0x2F6}, 0x119DA: {0x3067}, 0x119DE: {}, 0x119DF: {}, 0x119E0: {0x306C}, 0x119E1: {0x305E},
+		0x119E2: {0x4A5}, 0x119E3: {0x305F}, 0x119E4: {0x3068}, 0x11A0B: {0x3339}, 0x11A32: {0x333A}, 0x11A33: {},
+		0x11A34: {0x336C}, 0x11A35: {}, 0x11A36: {}, 0x11A37: {}, 0x11A38: {}, 0x11A39: {},
+		0x11A3A: {0x335A}, 0x11A47: {0x336D}, 0x11A54: {0x3374}, 0x11A55: {0x3376}, 0x11A56: {0x3375}, 0x11A83: {0x337B},
+		0x11A88: {0x339E}, 0x11A89: {0x33A0}, 0x11A8A: {0x337A}, 0x11A8B: {0x337C}, 0x11A8C: {0x337F}, 0x11A8D: {0x338A},
+		0x11A90: {0x3393}, 0x11A93: {0x339E}, 0x11A94: {0x33A0}, 0x11A95: {0x3399}, 0x11A96: {}, 0x11A97: {},
+		0x11A98: {}, 0x11A99: {0x33A4}, 0x11A9D: {0x33A5}, 0x11ADE: {0x49F2}, 0x11AE4: {0x49F3}, 0x11AE5: {0x49F9},
+		0x11AE6: {0x49F7}, 0x11AE7: {0x4A05}, 0x11AE8: {0x49FA}, 0x11AE9: {0x49F8}, 0x11AEA: {0x4A06}, 0x11AEB: {0x4A01},
+		0x11AEC: {0x49FF}, 0x11AED: {0x4A02}, 0x11AEE: {0x4A00}, 0x11AEF: {0x49F4}, 0x11AF0: {0x4A07}, 0x11AF1: {0x49F6},
+		0x11AF2: {0x49F5}, 0x11AF3: {0x49FD}, 0x11AF4: {0x49FB}, 0x11AF5: {0x4A03}, 0x11AF6: {0x49FE}, 0x11AF7: {0x49FC},
+		0x11AF8: {0x4A04}, 0x11C3C: {}, 0x11C3D: {}, 0x11C3E: {}, 0x11C3F: {0x3216}, 0x11C40: {0x3209},
+		0x11C72: {0x33A6}, 0x11C73: {0x33A8}, 0x11C74: {0x33AA}, 0x11C75: {0x33AC}, 0x11C76: {0x33AE}, 0x11C77: {0x33B0},
+		0x11C78: {0x33B2}, 0x11C79: {0x33B4}, 0x11C7A: {0x33B6}, 0x11C7B: {0x33B8}, 0x11C7C: {0x33BA}, 0x11C7D: {0x33BC},
+		0x11C7E: {0x33BE}, 0x11C7F: {0x33C0}, 0x11C80: {0x33C2}, 0x11C81: {0x33C4}, 0x11C82: {0x33C6}, 0x11C83: {0x33C8},
+		0x11C84: {0x33CA}, 0x11C85: {0x33CC}, 0x11C86: {0x33CE}, 0x11C87: {0x33D0}, 0x11C8A: {0x33D5}, 0x11C8B: {0x33D7},
+		0x11C8C: {0x33D9}, 0x11C8D: {0x33DB}, 0x11C8E: {0x33DD}, 0x11C8F: {0x33DF}, 0x11C92: {0x33A7}, 0x11C93: {0x33A9},
+		0x11C94: {0x33AB}, 0x11C95: {0x33AD}, 0x11C96: {0x33AF}, 0x11C97: {0x33B1}, 0x11C98: {0x33B3}, 0x11C99: {0x33B5},
+		0x11C9A: {0x33B7}, 0x11C9B: {0x33B9}, 0x11C9C: {0x33BB}, 0x11C9D: {0x33BD}, 0x11C9E: {0x33BF}, 0x11C9F: {0x33C1},
+		0x11CA0: {0x33C3}, 0x11CA1: {0x33C5}, 0x11CA2: {0x33C7}, 0x11CA3: {0x33C9}, 0x11CA4: {0x33CB}, 0x11CA5: {0x33CD},
+		0x11CA6: {0x33CF}, 0x11CA7: {0x33D1}, 0x11CA9: {0x33D4}, 0x11CAA: {0x33D6}, 0x11CAB: {0x33D8}, 0x11CAC: {0x33DA},
+		0x11CAD: {0x33DC}, 0x11CAE: {0x33DE}, 0x11CB5: {}, 0x11CB6: {}, 0x11D3A: {0x3101}, 0x11D3F: {0x3104},
+		0x11D40: {}, 0x11D41: {}, 0x11D42: {}, 0x11D43: {}, 0x11D46: {0x30F0}, 0x11D47: {0x30F0},
+		0x11D95: {}, 0x11D96: {}, 0x11D97: {0x3139}, 0x11D98: {0x3107}, 0x11FB0: {0x4912}, 0x11FFF: {0x4A6},
+		0x1243A: {0x1F9B}, 0x1243D: {0x1F9C}, 0x1243E: {0x1F9C}, 0x1243F: {0x1F9C}, 0x12442: {0x1F9F}, 0x12447: {0x1FA1},
+		0x12448: {0x1FA1}, 0x12449: {0x1FA1}, 0x12455: {0x1F9D}, 0x13430: {}, 0x13431: {}, 0x13432: {},
+		0x13433: {}, 0x13434: {}, 0x13435: {}, 0x13436: {}, 0x13437: {}, 0x13438: {},
+		0x16AF0: {}, 0x16AF1: {}, 0x16AF2: {}, 0x16AF3: {}, 0x16AF4: {}, 0x16AF5: {0x28F},
+		0x16B30: {}, 0x16B31: {}, 0x16B32: {}, 0x16B33: {}, 0x16B34: {}, 0x16B35: {},
+		0x16B36: {}, 0x16B44: {0x4AC}, 0x16B45: {0x1122}, 0x16E97: {0x235}, 0x16E98: {0x290}, 0x16F06: {0x4922},
+		0x16F13: {0x492F}, 0x16F25: {0x4941}, 0x16F33: {0x4951}, 0x16F3F: {0x495D}, 0x16F45: {0x4921}, 0x16F46: {0x4952},
+		0x16F47: {0x4950}, 0x16F48: {0x492B}, 0x16F49: {0x4959}, 0x16F4A: {0x492E}, 0x16F4F: {0x4968}, 0x16F7F: {0x4987},
+		0x16F80: {0x498E}, 0x16F81: {0x496E}, 0x16F82: {0x4992}, 0x16F83: {0x4973}, 0x16F84: {0x4978}, 0x16F85: {0x4991},
+		0x16F86: {0x497E}, 0x16F87: {0x4988}, 0x16FE2: {0x239}, 0x16FE3: {0x1F5B}, 0x16FE4: {}, 0x16FF0: {},
+		0x16FF1: {}, 0x1B000: {0x42DD}, 0x1B001: {0x4318}, 0x1BC9C: {0x1314}, 0x1BC9D: {}, 0x1BC9E: {},
+		0x1BC9F: {0x291}, 0x1BCA0: {}, 0x1BCA1: {}, 0x1BCA2: {}, 0x1BCA3: {}, 0x1D129: {0x1254},
+		0x1D160: {0x1273}, 0x1D161: {0x1273}, 0x1D162: {0x1273}, 0x1D163: {0x1273}, 0x1D164: {0x1273}, 0x1D165: {},
+		0x1D166: {}, 0x1D167: {}, 0x1D168: {}, 0x1D169: {}, 0x1D16D: {}, 0x1D16E: {},
+		0x1D16F: {}, 0x1D170: {}, 0x1D171: {}, 0x1D172: {}, 0x1D173: {}, 0x1D174: {},
+		0x1D175: {}, 0x1D176: {}, 0x1D177: {}, 0x1D178: {}, 0x1D179: {}, 0x1D17A: {},
+		0x1D17B: {}, 0x1D17C: {}, 0x1D17D: {}, 0x1D17E: {}, 0x1D17F: {}, 0x1D180: {},
+		0x1D181: {}, 0x1D182: {}, 0x1D185: {}, 0x1D186: {}, 0x1D187: {}, 0x1D188: {},
+		0x1D189: {}, 0x1D18A: {}, 0x1D18B: {}, 0x1D1AA: {}, 0x1D1AB: {}, 0x1D1AC: {},
+		0x1D1AD: {}, 0x1D242: {}, 0x1D243: {}, 0x1D244: {}, 0x1D245: {0x1313}, 0x1D377: {0x1F99},
+		0x1D378: {0x1F9D}, 0x1D400: {0x1FA2}, 0x1D401: {0x1FBC}, 0x1D402: {0x1FD6}, 0x1D403: {0x1FEB}, 0x1D404: {0x2007},
+		0x1D405: {0x2042}, 0x1D406: {0x2051}, 0x1D407: {0x2075}, 0x1D408: {0x2090}, 0x1D409: {0x20AB}, 0x1D40A: {0x20C4},
+		0x1D40B: {0x20D6}, 0x1D40C: {0x2109}, 0x1D40D: {0x2118}, 0x1D40E: {0x213C}, 0x1D40F: {0x216B}, 0x1D410: {0x2180},
+		0x1D411: {0x2193}, 0x1D412: {0x21D2}, 0x1D413: {0x21F7}, 0x1D414: {0x2217}, 0x1D415: {0x2247}, 0x1D416: {0x2259},
+		0x1D417: {0x2264}, 0x1D418: {0x2270}, 0x1D419: {0x2286}, 0x1D41A: {0x1FA2}, 0x1D41B: {0x1FBC}, 0x1D41C: {0x1FD6},
+		0x1D41D: {0x1FEB}, 0x1D41E: {0x2007}, 0x1D41F: {0x2042}, 0x1D420: {0x2051}, 0x1D421: {0x2075}, 0x1D422: {0x2090},
+		0x1D423: {0x20AB}, 0x1D424: {0x20C4}, 0x1D425: {0x20D6}, 0x1D426: {0x2109}, 0x1D427: {0x2118}, 0x1D428: {0x213C},
+		0x1D429: {0x216B}, 0x1D42A: {0x2180}, 0x1D42B: {0x2193}, 0x1D42C: {0x21D2}, 0x1D42D: {0x21F7}, 0x1D42E: {0x2217},
+		0x1D42F: {0x2247}, 0x1D430: {0x2259}, 0x1D431: {0x2264}, 0x1D432: {0x2270}, 0x1D433: {0x2286}, 0x1D434: {0x1FA2},
+		0x1D435: {0x1FBC}, 0x1D436: {0x1FD6}, 0x1D437: {0x1FEB}, 0x1D438: {0x2007}, 0x1D439: {0x2042}, 0x1D43A: {0x2051},
+		0x1D43B: {0x2075}, 0x1D43C: {0x2090}, 0x1D43D: {0x20AB}, 0x1D43E: {0x20C4}, 0x1D43F: {0x20D6}, 0x1D440: {0x2109},
+		0x1D441: {0x2118}, 0x1D442: {0x213C}, 0x1D443: {0x216B}, 0x1D444: {0x2180}, 0x1D445: {0x2193}, 0x1D446: {0x21D2},
+		0x1D447: {0x21F7}, 0x1D448: {0x2217}, 0x1D449: {0x2247}, 0x1D44A: {0x2259}, 0x1D44B: {0x2264}, 0x1D44C: {0x2270},
+		0x1D44D: {0x2286}, 0x1D44E: {0x1FA2}, 0x1D44F: {0x1FBC}, 0x1D450: {0x1FD6}, 0x1D451: {0x1FEB}, 0x1D452: {0x2007},
+		0x1D453: {0x2042}, 0x1D454: {0x2051}, 0x1D456: {0x2090}, 0x1D457: {0x20AB}, 0x1D458: {0x20C4}, 0x1D459: {0x20D6},
+		0x1D45A: {0x2109}, 0x1D45B: {0x2118}, 0x1D45C: {0x213C}, 0x1D45D: {0x216B}, 0x1D45E: {0x2180}, 0x1D45F: {0x2193},
+		0x1D460: {0x21D2}, 0x1D461: {0x21F7}, 0x1D462: {0x2217}, 0x1D463: {0x2247}, 0x1D464: {0x2259}, 0x1D465: {0x2264},
+		0x1D466: {0x2270}, 0x1D467: {0x2286}, 0x1D468: {0x1FA2}, 0x1D469: {0x1FBC}, 0x1D46A: {0x1FD6}, 0x1D46B: {0x1FEB},
+		0x1D46C: {0x2007}, 0x1D46D: {0x2042}, 0x1D46E: {0x2051}, 0x1D46F: {0x2075}, 0x1D470: {0x2090}, 0x1D471: {0x20AB},
+		0x1D472: {0x20C4}, 0x1D473: {0x20D6}, 0x1D474: {0x2109}, 0x1D475: {0x2118}, 0x1D476: {0x213C}, 0x1D477: {0x216B},
+		0x1D478: {0x2180}, 0x1D479: {0x2193}, 0x1D47A: {0x21D2}, 0x1D47B: {0x21F7}, 0x1D47C: {0x2217}, 0x1D47D: {0x2247},
+		0x1D47E: {0x2259}, 0x1D47F: {0x2264}, 0x1D480: {0x2270}, 0x1D481: {0x2286}, 0x1D482: {0x1FA2}, 0x1D483: {0x1FBC},
+		0x1D484: {0x1FD6}, 0x1D485: {0x1FEB}, 0x1D486: {0x2007}, 0x1D487: {0x2042}, 0x1D488: {0x2051}, 0x1D489: {0x2075},
+		0x1D48A: {0x2090}, 0x1D48B: {0x20AB}, 0x1D48C: {0x20C4}, 0x1D48D: {0x20D6}, 0x1D48E: {0x2109}, 0x1D48F: {0x2118},
+		0x1D490: {0x213C}, 0x1D491: {0x216B}, 0x1D492: {0x2180}, 0x1D493: {0x2193}, 0x1D494: {0x21D2}, 0x1D495: {0x21F7},
+		0x1D496: {0x2217}, 0x1D497: {0x2247}, 0x1D498: {0x2259}, 0x1D499: {0x2264}, 0x1D49A: {0x2270}, 0x1D49B: {0x2286},
+		0x1D49C: {0x1FA2}, 0x1D49E: {0x1FD6}, 0x1D49F: {0x1FEB}, 0x1D4A2: {0x2051}, 0x1D4A5: {0x20AB}, 0x1D4A6: {0x20C4},
+		0x1D4A9: {0x2118}, 0x1D4AA: {0x213C}, 0x1D4AB: {0x216B}, 0x1D4AC: {0x2180}, 0x1D4AE: {0x21D2}, 0x1D4AF: {0x21F7},
+		0x1D4B0: {0x2217}, 0x1D4B1: {0x2247}, 0x1D4B2: {0x2259}, 0x1D4B3: {0x2264}, 0x1D4B4: {0x2270}, 0x1D4B5: {0x2286},
+		0x1D4B6: {0x1FA2}, 0x1D4B7: {0x1FBC}, 0x1D4B8: {0x1FD6}, 0x1D4B9: {0x1FEB}, 0x1D4BB: {0x2042}, 0x1D4BD: {0x2075},
+		0x1D4BE: {0x2090}, 0x1D4BF: {0x20AB}, 0x1D4C0: {0x20C4}, 0x1D4C1: {0x20D6}, 0x1D4C2: {0x2109}, 0x1D4C3: {0x2118},
+		0x1D4C5: {0x216B}, 0x1D4C6: {0x2180}, 0x1D4C7: {0x2193}, 0x1D4C8: {0x21D2}, 0x1D4C9: {0x21F7}, 0x1D4CA: {0x2217},
+		0x1D4CB: {0x2247}, 0x1D4CC: {0x2259}, 0x1D4CD: {0x2264}, 0x1D4CE: {0x2270}, 0x1D4CF: {0x2286}, 0x1D4D0: {0x1FA2},
+		0x1D4D1: {0x1FBC}, 0x1D4D2: {0x1FD6}, 0x1D4D3: {0x1FEB}, 0x1D4D4: {0x2007}, 0x1D4D5: {0x2042}, 0x1D4D6: {0x2051},
+		0x1D4D7: {0x2075}, 0x1D4D8: {0x2090}, 0x1D4D9: {0x20AB}, 0x1D4DA: {0x20C4}, 0x1D4DB: {0x20D6}, 0x1D4DC: {0x2109},
+		0x1D4DD: {0x2118}, 0x1D4DE: {0x213C}, 0x1D4DF: {0x216B}, 0x1D4E0: {0x2180}, 0x1D4E1: {0x2193}, 0x1D4E2: {0x21D2},
+		0x1D4E3: {0x21F7}, 0x1D4E4: {0x2217}, 0x1D4E5: {0x2247}, 0x1D4E6: {0x2259}, 0x1D4E7: {0x2264}, 0x1D4E8: {0x2270},
+		0x1D4E9: {0x2286}, 0x1D4EA: {0x1FA2}, 0x1D4EB: {0x1FBC}, 0x1D4EC: {0x1FD6}, 0x1D4ED: {0x1FEB}, 0x1D4EE: {0x2007},
+		0x1D4EF: {0x2042}, 0x1D4F0: {0x2051}, 0x1D4F1: {0x2075}, 0x1D4F2: {0x2090}, 0x1D4F3: {0x20AB}, 0x1D4F4: {0x20C4},
+		0x1D4F5: {0x20D6}, 0x1D4F6: {0x2109}, 0x1D4F7: {0x2118}, 0x1D4F8: {0x213C}, 0x1D4F9: {0x216B}, 0x1D4FA: {0x2180},
+		0x1D4FB: {0x2193}, 0x1D4FC: {0x21D2}, 0x1D4FD: {0x21F7}, 0x1D4FE: {0x2217}, 0x1D4FF: {0x2247}, 0x1D500: {0x2259},
+		0x1D501: {0x2264}, 0x1D502: {0x2270}, 0x1D503: {0x2286}, 0x1D504: {0x1FA2}, 0x1D505: {0x1FBC}, 0x1D507: {0x1FEB},
+		0x1D508: {0x2007}, 0x1D509: {0x2042}, 0x1D50A: {0x2051}, 0x1D50D: {0x20AB}, 0x1D50E: {0x20C4}, 0x1D50F: {0x20D6},
+		0x1D510: {0x2109}, 0x1D511: {0x2118}, 0x1D512: {0x213C}, 0x1D513: {0x216B}, 0x1D514: {0x2180}, 0x1D516: {0x21D2},
+		0x1D517: {0x21F7}, 0x1D518: {0x2217}, 0x1D519: {0x2247}, 0x1D51A: {0x2259}, 0x1D51B: {0x2264}, 0x1D51C: {0x2270},
+		0x1D51E: {0x1FA2}, 0x1D51F: {0x1FBC}, 0x1D520: {0x1FD6}, 0x1D521: {0x1FEB}, 0x1D522: {0x2007}, 0x1D523: {0x2042},
+		0x1D524: {0x2051}, 0x1D525: {0x2075}, 0x1D526: {0x2090}, 0x1D527: {0x20AB}, 0x1D528: {0x20C4}, 0x1D529: {0x20D6},
+		0x1D52A: {0x2109}, 0x1D52B: {0x2118}, 0x1D52C: {0x213C}, 0x1D52D: {0x216B}, 0x1D52E: {0x2180}, 0x1D52F: {0x2193},
+		0x1D530: {0x21D2}, 0x1D531: {0x21F7}, 0x1D532: {0x2217}, 0x1D533: {0x2247}, 0x1D534: {0x2259}, 0x1D535: {0x2264},
+		0x1D536: {0x2270}, 0x1D537: {0x2286}, 0x1D538: {0x1FA2}, 0x1D539: {0x1FBC}, 0x1D53B: {0x1FEB}, 0x1D53C: {0x2007},
+		0x1D53D: {0x2042}, 0x1D53E: {0x2051}, 0x1D540: {0x2090}, 0x1D541: {0x20AB}, 0x1D542: {0x20C4}, 0x1D543: {0x20D6},
+		0x1D544: {0x2109}, 0x1D546: {0x213C}, 0x1D54A: {0x21D2}, 0x1D54B: {0x21F7}, 0x1D54C: {0x2217}, 0x1D54D: {0x2247},
+		0x1D54E: {0x2259}, 0x1D54F: {0x2264}, 0x1D550: {0x2270}, 0x1D552: {0x1FA2}, 0x1D553: {0x1FBC}, 0x1D554: {0x1FD6},
+		0x1D555: {0x1FEB}, 0x1D556: {0x2007}, 0x1D557: {0x2042}, 0x1D558: {0x2051}, 0x1D559: {0x2075}, 0x1D55A: {0x2090},
+		0x1D55B: {0x20AB}, 0x1D55C: {0x20C4}, 0x1D55D: {0x20D6}, 0x1D55E: {0x2109}, 0x1D55F: {0x2118}, 0x1D560: {0x213C},
+		0x1D561: {0x216B}, 0x1D562: {0x2180}, 0x1D563: {0x2193}, 0x1D564: {0x21D2}, 0x1D565: {0x21F7}, 0x1D566: {0x2217},
+		0x1D567: {0x2247}, 0x1D568: {0x2259}, 0x1D569: {0x2264}, 0x1D56A: {0x2270}, 0x1D56B: {0x2286}, 0x1D56C: {0x1FA2},
+		0x1D56D: {0x1FBC}, 0x1D56E: {0x1FD6}, 0x1D56F: {0x1FEB}, 0x1D570: {0x2007}, 0x1D571: {0x2042}, 0x1D572: {0x2051},
+		0x1D573: {0x2075}, 0x1D574: {0x2090}, 0x1D575: {0x20AB}, 0x1D576: {0x20C4}, 0x1D577: {0x20D6}, 0x1D578: {0x2109},
+		0x1D579: {0x2118}, 0x1D57A: {0x213C}, 0x1D57B: {0x216B}, 0x1D57C: {0x2180}, 0x1D57D: {0x2193}, 0x1D57E: {0x21D2},
+		0x1D57F: {0x21F7}, 0x1D580: {0x2217}, 0x1D581: {0x2247}, 0x1D582: {0x2259}, 0x1D583: {0x2264}, 0x1D584: {0x2270},
+		0x1D585: {0x2286}, 0x1D586: {0x1FA2}, 0x1D587: {0x1FBC}, 0x1D588: {0x1FD6}, 0x1D589: {0x1FEB}, 0x1D58A: {0x2007},
+		0x1D58B: {0x2042}, 0x1D58C: {0x2051}, 0x1D58D: {0x2075}, 0x1D58E: {0x2090}, 0x1D58F: {0x20AB}, 0x1D590: {0x20C4},
+		0x1D591: {0x20D6}, 0x1D592: {0x2109}, 0x1D593: {0x2118}, 0x1D594: {0x213C}, 0x1D595: {0x216B}, 0x1D596: {0x2180},
+		0x1D597: {0x2193}, 0x1D598: {0x21D2}, 0x1D599: {0x21F7}, 0x1D59A: {0x2217}, 0x1D59B: {0x2247}, 0x1D59C: {0x2259},
+		0x1D59D: {0x2264}, 0x1D59E: {0x2270}, 0x1D59F: {0x2286}, 0x1D5A0: {0x1FA2}, 0x1D5A1: {0x1FBC}, 0x1D5A2: {0x1FD6},
+		0x1D5A3: {0x1FEB}, 0x1D5A4: {0x2007}, 0x1D5A5: {0x2042}, 0x1D5A6: {0x2051}, 0x1D5A7: {0x2075}, 0x1D5A8: {0x2090},
+		0x1D5A9: {0x20AB}, 0x1D5AA: {0x20C4}, 0x1D5AB: {0x20D6}, 0x1D5AC: {0x2109}, 0x1D5AD: {0x2118}, 0x1D5AE: {0x213C},
+		0x1D5AF: {0x216B}, 0x1D5B0: {0x2180}, 0x1D5B1: {0x2193}, 0x1D5B2: {0x21D2}, 0x1D5B3: {0x21F7}, 0x1D5B4: {0x2217},
+		0x1D5B5: {0x2247}, 0x1D5B6: {0x2259}, 0x1D5B7: {0x2264}, 0x1D5B8: {0x2270}, 0x1D5B9: {0x2286}, 0x1D5BA: {0x1FA2},
+		0x1D5BB: {0x1FBC}, 0x1D5BC: {0x1FD6}, 0x1D5BD: {0x1FEB}, 0x1D5BE: {0x2007}, 0x1D5BF: {0x2042}, 0x1D5C0: {0x2051},
+		0x1D5C1: {0x2075}, 0x1D5C2: {0x2090}, 0x1D5C3: {0x20AB}, 0x1D5C4: {0x20C4}, 0x1D5C5: {0x20D6}, 0x1D5C6: {0x2109},
+		0x1D5C7: {0x2118}, 0x1D5C8: {0x213C}, 0x1D5C9: {0x216B}, 0x1D5CA: {0x2180}, 0x1D5CB: {0x2193}, 0x1D5CC: {0x21D2},
+		0x1D5CD: {0x21F7}, 0x1D5CE: {0x2217}, 0x1D5CF: {0x2247}, 0x1D5D0: {0x2259}, 0x1D5D1: {0x2264}, 0x1D5D2: {0x2270},
+		0x1D5D3: {0x2286}, 0x1D5D4: {0x1FA2}, 0x1D5D5: {0x1FBC}, 0x1D5D6: {0x1FD6}, 0x1D5D7: {0x1FEB}, 0x1D5D8: {0x2007},
+		0x1D5D9: {0x2042}, 0x1D5DA: {0x2051}, 0x1D5DB: {0x2075}, 0x1D5DC: {0x2090}, 0x1D5DD: {0x20AB}, 0x1D5DE: {0x20C4},
+		0x1D5DF: {0x20D6}, 0x1D5E0: {0x2109}, 0x1D5E1: {0x2118}, 0x1D5E2: {0x213C}, 0x1D5E3: {0x216B}, 0x1D5E4: {0x2180},
+		0x1D5E5: {0x2193}, 0x1D5E6: {0x21D2}, 0x1D5E7: {0x21F7}, 0x1D5E8: {0x2217}, 0x1D5E9: {0x2247}, 0x1D5EA: {0x2259},
+		0x1D5EB: {0x2264}, 0x1D5EC: {0x2270}, 0x1D5ED: {0x2286}, 0x1D5EE: {0x1FA2}, 0x1D5EF: {0x1FBC}, 0x1D5F0: {0x1FD6},
+		0x1D5F1: {0x1FEB}, 0x1D5F2: {0x2007}, 0x1D5F3: {0x2042}, 0x1D5F4: {0x2051}, 0x1D5F5: {0x2075}, 0x1D5F6: {0x2090},
+		0x1D5F7: {0x20AB}, 0x1D5F8: {0x20C4}, 0x1D5F9: {0x20D6}, 0x1D5FA: {0x2109}, 0x1D5FB: {0x2118}, 0x1D5FC: {0x213C},
+		0x1D5FD: {0x216B}, 0x1D5FE: {0x2180}, 0x1D5FF: {0x2193}, 0x1D600: {0x21D2}, 0x1D601: {0x21F7}, 0x1D602: {0x2217},
+		0x1D603: {0x2247}, 0x1D604: {0x2259}, 0x1D605: {0x2264}, 0x1D606: {0x2270}, 0x1D607: {0x2286}, 0x1D608: {0x1FA2},
+		0x1D609: {0x1FBC}, 0x1D60A: {0x1FD6}, 0x1D60B: {0x1FEB}, 0x1D60C: {0x2007}, 0x1D60D: {0x2042}, 0x1D60E: {0x2051},
+		0x1D60F: {0x2075}, 0x1D610: {0x2090}, 0x1D611: {0x20AB}, 0x1D612: {0x20C4}, 0x1D613: {0x20D6}, 0x1D614: {0x2109},
+		0x1D615: {0x2118}, 0x1D616: {0x213C}, 0x1D617: {0x216B}, 0x1D618: {0x2180}, 0x1D619: {0x2193}, 0x1D61A: {0x21D2},
+		0x1D61B: {0x21F7}, 0x1D61C: {0x2217}, 0x1D61D: {0x2247}, 0x1D61E: {0x2259}, 0x1D61F: {0x2264}, 0x1D620: {0x2270},
+		0x1D621: {0x2286}, 0x1D622: {0x1FA2}, 0x1D623: {0x1FBC}, 0x1D624: {0x1FD6}, 0x1D625: {0x1FEB}, 0x1D626: {0x2007},
+		0x1D627: {0x2042}, 0x1D628: {0x2051}, 0x1D629: {0x2075}, 0x1D62A: {0x2090}, 0x1D62B: {0x20AB}, 0x1D62C: {0x20C4},
+		0x1D62D: {0x20D6}, 0x1D62E: {0x2109}, 0x1D62F: {0x2118}, 0x1D630: {0x213C}, 0x1D631: {0x216B}, 0x1D632: {0x2180},
+		0x1D633: {0x2193}, 0x1D634: {0x21D2}, 0x1D635: {0x21F7}, 0x1D636: {0x2217}, 0x1D637: {0x2247}, 0x1D638: {0x2259},
+		0x1D639: {0x2264}, 0x1D63A: {0x2270}, 0x1D63B: {0x2286}, 0x1D63C: {0x1FA2}, 0x1D63D: {0x1FBC}, 0x1D63E: {0x1FD6},
+		0x1D63F: {0x1FEB}, 0x1D640: {0x2007}, 0x1D641: {0x2042}, 0x1D642: {0x2051}, 0x1D643: {0x2075}, 0x1D644: {0x2090},
+		0x1D645: {0x20AB}, 0x1D646: {0x20C4}, 0x1D647: {0x20D6}, 0x1D648: {0x2109}, 0x1D649: {0x2118}, 0x1D64A: {0x213C},
+		0x1D64B: {0x216B}, 0x1D64C: {0x2180}, 0x1D64D: {0x2193}, 0x1D64E: {0x21D2}, 0x1D64F: {0x21F7}, 0x1D650: {0x2217},
+		0x1D651: {0x2247}, 0x1D652: {0x2259}, 0x1D653: {0x2264}, 0x1D654: {0x2270}, 0x1D655: {0x2286}, 0x1D656: {0x1FA2},
+		0x1D657: {0x1FBC}, 0x1D658: {0x1FD6}, 0x1D659: {0x1FEB}, 0x1D65A: {0x2007}, 0x1D65B: {0x2042}, 0x1D65C: {0x2051},
+		0x1D65D: {0x2075}, 0x1D65E: {0x2090}, 0x1D65F: {0x20AB}, 0x1D660: {0x20C4}, 0x1D661: {0x20D6}, 0x1D662: {0x2109},
+		0x1D663: {0x2118}, 0x1D664: {0x213C}, 0x1D665: {0x216B}, 0x1D666: {0x2180}, 0x1D667: {0x2193}, 0x1D668: {0x21D2},
+		0x1D669: {0x21F7}, 0x1D66A: {0x2217}, 0x1D66B: {0x2247}, 0x1D66C: {0x2259}, 0x1D66D: {0x2264}, 0x1D66E: {0x2270},
+		0x1D66F: {0x2286}, 0x1D670: {0x1FA2}, 0x1D671: {0x1FBC}, 0x1D672: {0x1FD6}, 0x1D673: {0x1FEB}, 0x1D674: {0x2007},
+		0x1D675: {0x2042}, 0x1D676: {0x2051}, 0x1D677: {0x2075}, 0x1D678: {0x2090}, 0x1D679: {0x20AB}, 0x1D67A: {0x20C4},
+		0x1D67B: {0x20D6}, 0x1D67C: {0x2109}, 0x1D67D: {0x2118}, 0x1D67E: {0x213C}, 0x1D67F: {0x216B}, 0x1D680: {0x2180},
+		0x1D681: {0x2193}, 0x1D682: {0x21D2}, 0x1D683: {0x21F7}, 0x1D684: {0x2217}, 0x1D685: {0x2247}, 0x1D686: {0x2259},
+		0x1D687: {0x2264}, 0x1D688: {0x2270}, 0x1D689: {0x2286}, 0x1D68A: {0x1FA2}, 0x1D68B: {0x1FBC}, 0x1D68C: {0x1FD6},
+		0x1D68D: {0x1FEB}, 0x1D68E: {0x2007}, 0x1D68F: {0x2042}, 0x1D690: {0x2051}, 0x1D691: {0x2075}, 0x1D692: {0x2090},
+		0x1D693: {0x20AB}, 0x1D694: {0x20C4}, 0x1D695: {0x20D6}, 0x1D696: {0x2109}, 0x1D697: {0x2118}, 0x1D698: {0x213C},
+		0x1D699: {0x216B}, 0x1D69A: {0x2180}, 0x1D69B: {0x2193}, 0x1D69C: {0x21D2}, 0x1D69D: {0x21F7}, 0x1D69E: {0x2217},
+		0x1D69F: {0x2247}, 0x1D6A0: {0x2259}, 0x1D6A1: {0x2264}, 0x1D6A2: {0x2270}, 0x1D6A3: {0x2286}, 0x1D6A4: {0x2094},
+		0x1D6A5: {0x20AF}, 0x1D6AD: {0x2327}, 0x1D6B8: {0x2339}, 0x1D6B9: {0x232A}, 0x1D6BA: {0x233C}, 0x1D6C0: {0x2346},
+		0x1D6C1: {0x65C}, 0x1D6C7: {0x2327}, 0x1D6D2: {0x2339}, 0x1D6D3: {0x233C}, 0x1D6D4: {0x233C}, 0x1D6DA: {0x2346},
+		0x1D6DB: {0x658}, 0x1D6DC: {0x2323}, 0x1D6DD: {0x232A}, 0x1D6DE: {0x232D}, 0x1D6DF: {0x2342}, 0x1D6E0: {0x2339},
+		0x1D6E1: {0x2334}, 0x1D6E7: {0x2327}, 0x1D6F2: {0x2339}, 0x1D6F3: {0x232A}, 0x1D6F4: {0x233C}, 0x1D6FA: {0x2346},
+		0x1D6FB: {0x65C}, 0x1D701: {0x2327}, 0x1D70C: {0x2339}, 0x1D70D: {0x233C}, 0x1D70E: {0x233C}, 0x1D714: {0x2346},
+		0x1D715: {0x658}, 0x1D716: {0x2323}, 0x1D717: {0x232A}, 0x1D718: {0x232D}, 0x1D719: {0x2342}, 0x1D71A: {0x2339},
+		0x1D71B: {0x2334}, 0x1D721: {0x2327}, 0x1D72C: {0x2339}, 0x1D72D: {0x232A}, 0x1D72E: {0x233C}, 0x1D734: {0x2346},
+		0x1D735: {0x65C}, 0x1D73B: {0x2327}, 0x1D746: {0x2339}, 0x1D747: {0x233C}, 0x1D748: {0x233C}, 0x1D74E: {0x2346},
+		0x1D74F: {0x658}, 0x1D750: {0x2323}, 0x1D751: {0x232A}, 0x1D752: {0x232D}, 0x1D753: {0x2342}, 0x1D754: {0x2339},
+		0x1D755: {0x2334}, 0x1D75B: {0x2327}, 0x1D766: {0x2339}, 0x1D767: {0x232A}, 0x1D768: {0x233C}, 0x1D76E: {0x2346},
+		0x1D76F: {0x65C}, 0x1D775: {0x2327}, 0x1D780: {0x2339}, 0x1D781: {0x233C}, 0x1D782: {0x233C}, 0x1D788: {0x2346},
+		0x1D789: {0x658}, 0x1D78A: {0x2323}, 0x1D78B: {0x232A}, 0x1D78C: {0x232D}, 0x1D78D: {0x2342}, 0x1D78E: {0x2339},
+		0x1D78F: {0x2334}, 0x1D795: {0x2327}, 0x1D7A0: {0x2339}, 0x1D7A1: {0x232A}, 0x1D7A2: {0x233C}, 0x1D7A8: {0x2346},
+		0x1D7A9: {0x65C}, 0x1D7AF: {0x2327}, 0x1D7BA: {0x2339}, 0x1D7BB: {0x233C}, 0x1D7BC: {0x233C}, 0x1D7C2: {0x2346},
+		0x1D7C3: {0x658}, 0x1D7C4: {0x2323}, 0x1D7C5: {0x232A}, 0x1D7C6: {0x232D}, 0x1D7C7: {0x2342}, 0x1D7C8: {0x2339},
+		0x1D7C9: {0x2334}, 0x1D7CA: {0x2324}, 0x1D7CB: {0x2324}, 0x1DA00: {}, 0x1DA01: {}, 0x1DA02: {},
+		0x1DA03: {}, 0x1DA04: {}, 0x1DA05: {}, 0x1DA06: {}, 0x1DA07: {}, 0x1DA08: {},
+		0x1DA09: {}, 0x1DA0A: {}, 0x1DA0B: {}, 0x1DA0C: {}, 0x1DA0D: {}, 0x1DA0E: {},
+		0x1DA0F: {}, 0x1DA10: {}, 0x1DA11: {}, 0x1DA12: {}, 0x1DA13: {}, 0x1DA14: {},
+		0x1DA15: {}, 0x1DA16: {}, 0x1DA17: {}, 0x1DA18: {}, 0x1DA19: {}, 0x1DA1A: {},
+		0x1DA1B: {}, 0x1DA1C: {}, 0x1DA1D: {}, 0x1DA1E: {}, 0x1DA1F: {}, 0x1DA20: {},
+		0x1DA21: {}, 0x1DA22: {}, 0x1DA23: {}, 0x1DA24: {}, 0x1DA25: {}, 0x1DA26: {},
+		0x1DA27: {}, 0x1DA28: {}, 0x1DA29: {}, 0x1DA2A: {}, 0x1DA2B: {}, 0x1DA2C: {},
+		0x1DA2D: {}, 0x1DA2E: {}, 0x1DA2F: {}, 0x1DA30: {}, 0x1DA31: {}, 0x1DA32: {},
+		0x1DA33: {}, 0x1DA34: {}, 0x1DA35: {}, 0x1DA36: {}, 0x1DA3B: {}, 0x1DA3C: {},
+		0x1DA3D: {}, 0x1DA3E: {}, 0x1DA3F: {}, 0x1DA40: {}, 0x1DA41: {}, 0x1DA42: {},
+		0x1DA43: {}, 0x1DA44: {}, 0x1DA45: {}, 0x1DA46: {}, 0x1DA47: {}, 0x1DA48: {},
+		0x1DA49: {}, 0x1DA4A: {}, 0x1DA4B: {}, 0x1DA4C: {}, 0x1DA4D: {}, 0x1DA4E: {},
+		0x1DA4F: {}, 0x1DA50: {}, 0x1DA51: {}, 0x1DA52: {}, 0x1DA53: {}, 0x1DA54: {},
+		0x1DA55: {}, 0x1DA56: {}, 0x1DA57: {}, 0x1DA58: {}, 0x1DA59: {}, 0x1DA5A: {},
+		0x1DA5B: {}, 0x1DA5C: {}, 0x1DA5D: {}, 0x1DA5E: {}, 0x1DA5F: {}, 0x1DA60: {},
+		0x1DA61: {}, 0x1DA62: {}, 0x1DA63: {}, 0x1DA64: {}, 0x1DA65: {}, 0x1DA66: {},
+		0x1DA67: {}, 0x1DA68: {}, 0x1DA69: {}, 0x1DA6A: {}, 0x1DA6B: {}, 0x1DA6C: {},
+		0x1DA75: {}, 0x1DA84: {}, 0x1DA9B: {}, 0x1DA9C: {}, 0x1DA9D: {}, 0x1DA9E: {},
+		0x1DA9F: {}, 0x1DAA1: {}, 0x1DAA2: {}, 0x1DAA3: {}, 0x1DAA4: {}, 0x1DAA5: {},
+		0x1DAA6: {}, 0x1DAA7: {}, 0x1DAA8: {}, 0x1DAA9: {}, 0x1DAAA: {}, 0x1DAAB: {},
+		0x1DAAC: {}, 0x1DAAD: {}, 0x1DAAE: {}, 0x1DAAF: {}, 0x1E130: {}, 0x1E131: {},
+		0x1E132: {}, 0x1E133: {}, 0x1E134: {}, 0x1E135: {}, 0x1E136: {}, 0x1E14E: {0x4A94},
+		0x1E14F: {0x1123}, 0x1E2EC: {}, 0x1E2ED: {}, 0x1E2EE: {}, 0x1E2EF: {}, 0x1E2FF: {0x1F77},
+		0x1E8D0: {}, 0x1E8D1: {}, 0x1E8D2: {}, 0x1E8D3: {}, 0x1E8D4: {}, 0x1E8D5: {},
+		0x1E8D6: {}, 0x1E944: {}, 0x1E945: {}, 0x1E946: {}, 0x1E947: {}, 0x1E948: {},
+		0x1E949: {}, 0x1E94A: {}, 0x1E94B: {0x4174}, 0x1E95E: {0x26C}, 0x1E95F: {0x27B}, 0x1ECAC: {0x1315},
+		0x1ECB0: {0x1F91}, 0x1ED2E: {0x1316}, 0x1EE00: {0x2672}, 0x1EE01: {0x2674}, 0x1EE02: {0x268F}, 0x1EE03: {0x26A4},
+		0x1EE05: {0x2728}, 0x1EE06: {0x26B4}, 0x1EE07: {0x2699}, 0x1EE08: {0x26D7}, 0x1EE09: {0x2737}, 0x1EE0A: {0x26F6},
+		0x1EE0B: {0x270C}, 0x1EE0C: {0x2714}, 0x1EE0D: {0x2718}, 0x1EE0E: {0x26C6}, 0x1EE0F: {0x26DB}, 0x1EE10: {0x26E4},
+		0x1EE11: {0x26D1}, 0x1EE12: {0x26F0}, 0x1EE13: {0x26B3}, 0x1EE14: {0x26C7}, 0x1EE17: {0x269A}, 0x1EE18: {0x26A5},
+		0x1EE19: {0x26D2}, 0x1EE1A: {0x26D8}, 0x1EE1B: {0x26DC}, 0x1EE1C: {0x2673}, 0x1EE1D: {0x2719}, 0x1EE1E: {0x26E5},
+		0x1EE1F: {0x26EF}, 0x1EE21: {0x2674}, 0x1EE22: {0x268F}, 0x1EE24: {0x2722}, 0x1EE27: {0x2699}, 0x1EE29: {0x2737},
+		0x1EE2A: {0x26F6}, 0x1EE2B: {0x270C}, 0x1EE2C: {0x2714}, 0x1EE2D: {0x2718}, 0x1EE2E: {0x26C6}, 0x1EE2F: {0x26DB},
+		0x1EE30: {0x26E4}, 0x1EE31: {0x26D1}, 0x1EE32: {0x26F0}, 0x1EE34: {0x26C7}, 0x1EE37: {0x269A}, 0x1EE39: {0x26D2},
+		0x1EE3B: {0x26DC}, 0x1EE42: {0x268F}, 0x1EE47: {0x2699}, 0x1EE49: {0x2737}, 0x1EE4B: {0x270C}, 0x1EE4D: {0x2718},
+		0x1EE4E: {0x26C6}, 0x1EE4F: {0x26DB}, 0x1EE51: {0x26D1}, 0x1EE52: {0x26F0}, 0x1EE54: {0x26C7}, 0x1EE57: {0x269A},
+		0x1EE59: {0x26D2}, 0x1EE5B: {0x26DC}, 0x1EE5D: {0x2719}, 0x1EE5F: {0x26EF}, 0x1EE61: {0x2674}, 0x1EE62: {0x268F},
+		0x1EE64: {0x2722}, 0x1EE67: {0x2699}, 0x1EE68: {0x26D7}, 0x1EE69: {0x2737}, 0x1EE6A: {0x26F6}, 0x1EE6C: {0x2714},
+		0x1EE6D: {0x2718}, 0x1EE6E: {0x26C6}, 0x1EE6F: {0x26DB}, 0x1EE70: {0x26E4}, 0x1EE71: {0x26D1}, 0x1EE72: {0x26F0},
+		0x1EE74: {0x26C7}, 0x1EE77: {0x269A}, 0x1EE79: {0x26D2}, 0x1EE7A: {0x26D8}, 0x1EE7B: {0x26DC}, 0x1EE7C: {0x2673},
+		0x1EE7E: {0x26E5}, 0x1EE80: {0x2672}, 0x1EE81: {0x2674}, 0x1EE82: {0x268F}, 0x1EE83: {0x26A4}, 0x1EE84: {0x2722},
+		0x1EE85: {0x2728}, 0x1EE86: {0x26B4}, 0x1EE87: {0x2699}, 0x1EE88: {0x26D7}, 0x1EE89: {0x2737}, 0x1EE8B: {0x270C},
+		0x1EE8C: {0x2714}, 0x1EE8D: {0x2718}, 0x1EE8E: {0x26C6}, 0x1EE8F: {0x26DB}, 0x1EE90: {0x26E4}, 0x1EE91: {0x26D1},
+		0x1EE92: {0x26F0}, 0x1EE93: {0x26B3}, 0x1EE94: {0x26C7}, 0x1EE97: {0x269A}, 0x1EE98: {0x26A5}, 0x1EE99: {0x26D2},
+		0x1EE9A: {0x26D8}, 0x1EE9B: {0x26DC}, 0x1EEA1: {0x2674}, 0x1EEA2: {0x268F}, 0x1EEA3: {0x26A4}, 0x1EEA5: {0x2728},
+		0x1EEA6: {0x26B4}, 0x1EEA7: {0x2699}, 0x1EEA8: {0x26D7}, 0x1EEA9: {0x2737}, 0x1EEAB: {0x270C}, 0x1EEAC: {0x2714},
+		0x1EEAD: {0x2718}, 0x1EEAE: {0x26C6}, 0x1EEAF: {0x26DB}, 0x1EEB0: {0x26E4}, 0x1EEB1: {0x26D1}, 0x1EEB2: {0x26F0},
+		0x1EEB3: {0x26B3}, 0x1EEB4: {0x26C7}, 0x1EEB7: {0x269A}, 0x1EEB8: {0x26A5}, 0x1EEB9: {0x26D2}, 0x1EEBA: {0x26D8},
+		0x1EEBB: {0x26DC}, 0x1F100: {0x27E1F98}, 0x1F101: {0x2231F98}, 0x1F102: {0x2231F99}, 0x1F103: {0x2231F9A}, 0x1F104: {0x2231F9B},
+		0x1F105: {0x2231F9C}, 0x1F106: {0x2231F9D}, 0x1F107: {0x2231F9E}, 0x1F108: {0x2231F9F}, 0x1F109: {0x2231FA0}, 0x1F10A: {0x2231FA1},
+		0x1F10B: {0x1F98}, 0x1F10C: {0x1F98}, 0x1F110: {0x3291FA20328}, 0x1F111: {0x3291FBC0328}, 0x1F112: {0x3291FD60328}, 0x1F113: {0x3291FEB0328},
+		0x1F114: {0x32920070328}, 0x1F115: {0x32920420328}, 0x1F116: {0x32920510328}, 0x1F117: {0x32920750328}, 0x1F118: {0x32920900328}, 0x1F119: {0x32920AB0328},
+		0x1F11A: {0x32920C40328}, 0x1F11B: {0x32920D60328}, 0x1F11C: {0x32921090328}, 0x1F11D: {0x32921180328}, 0x1F11E: {0x329213C0328}, 0x1F11F: {0x329216B0328},
+		0x1F120: {0x32921800328}, 0x1F121: {0x32921930328}, 0x1F122: {0x32921D20328}, 0x1F123: {0x32921F70328}, 0x1F124: {0x32922170328}, 0x1F125: {0x32922470328},
+		0x1F126: {0x32922590328}, 0x1F127: {0x32922640328}, 0x1F128: {0x32922700328}, 0x1F129: {0x32922860328}, 0x1F12A: {0x38B21D2038A}, 0x1F12B: {0x1FD6},
+		0x1F12C: {0x2193}, 0x1F12D: {0x1FEB1FD6}, 0x1F12E: {0x22862259}, 0x1F12F: {0x5D3}, 0x1F130: {0x1FA2}, 0x1F131: {0x1FBC},
+		0x1F132: {0x1FD6}, 0x1F133: {0x1FEB}, 0x1F134: {0x2007}, 0x1F135: {0x2042}, 0x1F136: {0x2051}, 0x1F137: {0x2075},
+		0x1F138: {0x2090}, 0x1F139: {0x20AB}, 0x1F13A: {0x20C4}, 0x1F13B: {0x20D6}, 0x1F13C: {0x2109}, 0x1F13D: {0x2118},
+		0x1F13E: {0x213C}, 0x1F13F: {0x216B}, 0x1F140: {0x2180}, 0x1F141: {0x2193}, 0x1F142: {0x21D2}, 0x1F143: {0x21F7},
+		0x1F144: {0x2217}, 0x1F145: {0x2247}, 0x1F146: {0x2259}, 0x1F147: {0x2264}, 0x1F148: {0x2270}, 0x1F149: {0x2286},
+		0x1F14A: {0x22472075}, 0x1F14B: {0x22472109}, 0x1F14C: {0x1FEB21D2}, 0x1F14D: {0x21D221D2}, 0x1F14E: {0x2247216B216B}, 0x1F14F: {0x1FD62259},
+		0x1F150: {0x1FA2}, 0x1F151: {0x1FBC}, 0x1F152: {0x1FD6}, 0x1F153: {0x1FEB}, 0x1F154: {0x2007}, 0x1F155: {0x2042},
+		0x1F156: {0x2051}, 0x1F157: {0x2075}, 0x1F158: {0x2090}, 0x1F159: {0x20AB}, 0x1F15A: {0x20C4}, 0x1F15B: {0x20D6},
+		0x1F15C: {0x2109}, 0x1F15D: {0x2118}, 0x1F15E: {0x213C}, 0x1F15F: {0x216B}, 0x1F160: {0x2180}, 0x1F161: {0x2193},
+		0x1F162: {0x21D2}, 0x1F163: {0x21F7}, 0x1F164: {0x2217}, 0x1F165: {0x2247}, 0x1F166: {0x2259}, 0x1F167: {0x2264},
+		0x1F168: {0x2270}, 0x1F169: {0x2286}, 0x1F16A: {0x1FD62109}, 0x1F16B: {0x1FEB2109}, 0x1F16C: {0x21932109}, 0x1F170: {0x1FA2},
+		0x1F171: {0x1FBC}, 0x1F172: {0x1FD6}, 0x1F173: {0x1FEB}, 0x1F174: {0x2007}, 0x1F175: {0x2042}, 0x1F176: {0x2051},
+		0x1F177: {0x2075}, 0x1F178: {0x2090}, 0x1F179: {0x20AB}, 0x1F17A: {0x20C4}, 0x1F17B: {0x20D6}, 0x1F17C: {0x2109},
+		0x1F17D: {0x2118}, 0x1F17E: {0x213C}, 0x1F17F: {0x216B}, 0x1F180: {0x2180}, 0x1F181: {0x2193}, 0x1F182: {0x21D2},
+		0x1F183: {0x21F7}, 0x1F184: {0x2217}, 0x1F185: {0x2247}, 0x1F186: {0x2259}, 0x1F187: {0x2264}, 0x1F188: {0x2270},
+		0x1F189: {0x2286}, 0x1F18A: {0x216B}, 0x1F18B: {0x1FD62090}, 0x1F18C: {0x1FA2216B}, 0x1F18D: {0x1FA221D2}, 0x1F18E: {0x1FBC1FA2},
+		0x1F18F: {0x1FD62259}, 0x1F190: {0x20AB1FEB}, 0x1F191: {0x20D61FD6}, 0x1F192: {0x20D6213C213C1FD6}, 0x1F193: {0x2007200721932042}, 0x1F194: {0x1FEB2090},
+		0x1F195: {0x225920072118}, 0x1F196: {0x20512118}, 0x1F197: {0x20C4213C}, 0x1F198: {0x21D2213C21D2}, 0x1F199: {0x267216B2217}, 0x1F19A: {0x21D22247},
+		0x1F19B: {0x1FEB1F9B}, 0x1F19C: {0x2091FEB21181F9A, 0x21931FD621D2}, 0x1F19D: {0x20C41F9A}, 0x1F19E: {0x20C41F9C}, 0x1F19F: {0x20C41FA0}, 0x1F1A0: {0x1F99027E1F9D},
+		0x1F1A1: {0x1F99027E1F9F}, 0x1F1A2: {0x1F9A027E1F9A1F9A}, 0x1F1A3: {0x216B1F981F9E}, 0x1F1A4: {0x216B1F981F9A1F99}, 0x1F1A5: {0x1FEB}, 0x1F1A6: {0x1FD62075},
+		0x1F1A7: {0x21931FEB2075}, 0x1F1A8: {0x2193020920902075, 0x21D22007}, 0x1F1A9: {0x21D221D2213C20D6, 0x21D221D2200720D6}, 0x1F1AA: {0x2247207521D2}, 0x1F1AB: {0x1FEB20752217}, 0x1F1AC: {0x1FEB213C2247},
+		0x1F1AD: {0x5D5}, 0x1F200: {0x42E042F8}, 0x1F201: {0x42E442E4}, 0x1F202: {0x42E5}, 0x1F210: {0xE24BFB40}, 0x1F211: {0xDB57FB40},
+		0x1F212: {0xD3CCFB40}, 0x1F213: {0x42ED}, 0x1F214: {0xCE8CFB40}, 0x1F215: {0xD91AFB40}, 0x1F216: {0x89E3FB41}, 0x1F217: {0xD929FB40},
+		0x1F218: {0xCEA4FB40}, 0x1F219: {0xE620FB40}, 0x1F21A: {0xF121FB40}, 0x1F21B: {0xE599FB40}, 0x1F21C: {0xD24DFB40}, 0x1F21D: {0xDF8CFB40},
+		0x1F21E: {0xD18DFB40}, 0x1F21F: {0xE5B0FB40}, 0x1F220: {0xD21DFB40}, 0x1F221: {0xFD42FB40}, 0x1F222: {0xF51FFB40}, 0x1F223: {0x8CA9FB41},
+		0x1F224: {0xD8F0FB40}, 0x1F225: {0xD439FB40}, 0x1F226: {0xEF14FB40}, 0x1F227: {0xE295FB40}, 0x1F228: {0xE355FB40}, 0x1F229: {0xCE00FB40},
+		0x1F22A: {0xCE09FB40}, 0x1F22B: {0x904AFB41}, 0x1F22C: {0xDDE6FB40}, 0x1F22D: {0xCE2DFB40}, 0x1F22E: {0xD3F3FB40}, 0x1F22F: {0xE307FB40},
+		0x1F230: {0x8D70FB41}, 0x1F231: {0xE253FB40}, 0x1F232: {0xF981FB40}, 0x1F233: {0xFA7AFB40}, 0x1F234: {0xD408FB40}, 0x1F235: {0xEE80FB40},
+		0x1F236: {0xE709FB40}, 0x1F237: {0xE708FB40}, 0x1F238: {0xF533FB40}, 0x1F239: {0xD272FB40}, 0x1F23A: {0xD5B6FB40}, 0x1F23B: {0x914DFB41},
+		0x1F240: {0x38BE72CFB40038A}, 0x1F241: {0x38BCE09FB40038A}, 0x1F242: {0x38BCE8CFB40038A}, 0x1F243: {0x38BDB89FB40038A}, 0x1F244: {0x38BF0B9FB40038A}, 0x1F245: {0x38BE253FB40038A},
+		0x1F246: {0x38BF6D7FB40038A}, 0x1F247: {0x38BD2DDFB40038A}, 0x1F248: {0x38BE557FB40038A}, 0x1F250: {0xDF97FB40}, 0x1F251: {0xD3EFFB40}, 0x2F800: {0xCE3DFB40},
+		0x2F801: {0xCE38FB40}, 0x2F802: {0xCE41FB40}, 0x2F803: {0x8122FB84}, 0x2F804: {0xCF60FB40}, 0x2F805: {0xCFAEFB40}, 0x2F806: {0xCFBBFB40},
+		0x2F807: {0xD002FB40}, 0x2F808: {0xD07AFB40}, 0x2F809: {0xD099FB40}, 0x2F80A: {0xD0E7FB40}, 0x2F80B: {0xD0CFFB40}, 0x2F80C: {0xB49EFB80},
+		0x2F80D: {0x863AFB84}, 0x2F80E: {0xD14DFB40}, 0x2F80F: {0xD154FB40}, 0x2F810: {0xD164FB40}, 0x2F811: {0xD177FB40}, 0x2F812: {0x851CFB84},
+		0x2F813: {0xB4B9FB80}, 0x2F814: {0xD167FB40}, 0x2F815: {0xD18DFB40}, 0x2F816: {0x854BFB84}, 0x2F817: {0xD197FB40}, 0x2F818: {0xD1A4FB40},
+		0x2F819: {0xCECCFB40}, 0x2F81A: {0xD1ACFB40}, 0x2F81B: {0xD1B5FB40}, 0x2F81C: {0x91DFFB85}, 0x2F81D: {0xD1F5FB40}, 0x2F81E: {0xD203FB40},
+		0x2F81F: {0xB4DFFB80}, 0x2F820: {0xD23BFB40}, 0x2F821: {0xD246FB40}, 0x2F822: {0xD272FB40}, 0x2F823: {0xD277FB40}, 0x2F824: {0xB515FB80},
+		0x2F825: {0xD2C7FB40}, 0x2F826: {0xD2C9FB40}, 0x2F827: {0xD2E4FB40}, 0x2F828: {0xD2FAFB40}, 0x2F829: {0xD305FB40}, 0x2F82A: {0xD306FB40},
+		0x2F82B: {0xD317FB40}, 0x2F82C: {0xD349FB40}, 0x2F82D: {0xD351FB40}, 0x2F82E: {0xD35AFB40}, 0x2F82F: {0xD373FB40}, 0x2F830: {0xD37DFB40},
+		0x2F831: {0xD37FFB40}, 0x2F832: {0xD37FFB40}, 0x2F833: {0xD37FFB40}, 0x2F834: {0x8A2CFB84}, 0x2F835: {0xF070FB40}, 0x2F836: {0xD3CAFB40},
+		0x2F837: {0xD3DFFB40}, 0x2F838: {0x8B63FB84}, 0x2F839: {0xD3EBFB40}, 0x2F83A: {0xD3F1FB40}, 0x2F83B: {0xD406FB40}, 0x2F83C: {0xD49EFB40},
+		0x2F83D: {0xD438FB40}, 0x2F83E: {0xD448FB40}, 0x2F83F: {0xD468FB40}, 0x2F840: {0xD4A2FB40}, 0x2F841: {0xD4F6FB40}, 0x2F842: {0xD510FB40},
+		0x2F843: {0xD553FB40}, 0x2F844: {0xD563FB40}, 0x2F845: {0xD584FB40}, 0x2F846: {0xD584FB40}, 0x2F847: {0xD599FB40}, 0x2F848: {0xD5ABFB40},
+		0x2F849: {0xD5B3FB40}, 0x2F84A: {0xD5C2FB40}, 0x2F84B: {0xD716FB40}, 0x2F84C: {0xD606FB40}, 0x2F84D: {0xD717FB40}, 0x2F84E: {0xD651FB40},
+		0x2F84F: {0xD674FB40}, 0x2F850: {0xD207FB40}, 0x2F851: {0xD8EEFB40}, 0x2F852: {0xD7CEFB40}, 0x2F853: {0xD7F4FB40}, 0x2F854: {0xD80DFB40},
+		0x2F855: {0xD78BFB40}, 0x2F856: {0xD832FB40}, 0x2F857: {0xD831FB40}, 0x2F858: {0xD8ACFB40}, 0x2F859: {0x94E4FB84}, 0x2F85A: {0xD8F2FB40},
+		0x2F85B: {0xD8F7FB40}, 0x2F85C: {0xD906FB40}, 0x2F85D: {0xD91AFB40}, 0x2F85E: {0xD922FB40}, 0x2F85F: {0xD962FB40}, 0x2F860: {0x96A8FB84},
+		0x2F861: {0x96EAFB84}, 0x2F862: {0xD9ECFB40}, 0x2F863: {0xDA1BFB40}, 0x2F864: {0xDA27FB40}, 0x2F865: {0xD9D8FB40}, 0x2F866: {0xDA66FB40},
+		0x2F867: {0xB6EEFB80}, 0x2F868: {0xB6FCFB80}, 0x2F869: {0xDB08FB40}, 0x2F86A: {0xDB3EFB40}, 0x2F86B: {0xDB3EFB40}, 0x2F86C: {0x99C8FB84},
+		0x2F86D: {0xDBC3FB40}, 0x2F86E: {0xDBD8FB40}, 0x2F86F: {0xDBE7FB40}, 0x2F870: {0xDBF3FB40}, 0x2F871: {0x9B18FB84}, 0x2F872: {0xDBFFFB40},
+		0x2F873: {0xDC06FB40}, 0x2F874: {0xDF53FB40}, 0x2F875: {0xDC22FB40}, 0x2F876: {0xB781FB80}, 0x2F877: {0xDC60FB40}, 0x2F878: {0xDC6EFB40},
+		0x2F879: {0xDCC0FB40}, 0x2F87A: {0xDC8DFB40}, 0x2F87B: {0x9DE4FB84}, 0x2F87C: {0xDD43FB40}, 0x2F87D: {0x9DE6FB84}, 0x2F87E: {0xDD6EFB40},
+		0x2F87F: {0xDD6BFB40}, 0x2F880: {0xDD7CFB40}, 0x2F881: {0xDDE1FB40}, 0x2F882: {0xDDE2FB40}, 0x2F883: {0xB82FFB80}, 0x2F884: {0xDDFDFB40},
+		0x2F885: {0xDE28FB40}, 0x2F886: {0xDE3DFB40}, 0x2F887: {0xDE69FB40}, 0x2F888: {0xB862FB80}, 0x2F889: {0xA183FB84}, 0x2F88A: {0xB87CFB80},
+		0x2F88B: {0xDEB0FB40}, 0x2F88C: {0xDEB3FB40}, 0x2F88D: {0xDEB6FB40}, 0x2F88E: {0xDECAFB40}, 0x2F88F: {0xA392FB85}, 0x2F890: {0xDEFEFB40},
+		0x2F891: {0xA331FB84}, 0x2F892: {0xA331FB84}, 0x2F893: {0x8201FB41}, 0x2F894: {0xDF22FB40}, 0x2F895: {0xDF22FB40}, 0x2F896: {0xB8C7FB80},
+		0x2F897: {0xB2B8FB84}, 0x2F898: {0xE1DAFB84}, 0x2F899: {0xDF62FB40}, 0x2F89A: {0xDF6BFB40}, 0x2F89B: {0xB8E3FB80}, 0x2F89C: {0xDF9AFB40},
+		0x2F89D: {0xDFCDFB40}, 0x2F89E: {0xDFD7FB40}, 0x2F89F: {0xDFF9FB40}, 0x2F8A0: {0xE081FB40}, 0x2F8A1: {0xB93AFB80}, 0x2F8A2: {0xB91CFB80},
+		0x2F8A3: {0xE094FB40}, 0x2F8A4: {0xA6D4FB84}, 0x2F8A5: {0xE0C7FB40}, 0x2F8A6: {0xE148FB40}, 0x2F8A7: {0xE14CFB40}, 0x2F8A8: {0xE14EFB40},
+		0x2F8A9: {0xE14CFB40}, 0x2F8AA: {0xE17AFB40}, 0x2F8AB: {0xE18EFB40}, 0x2F8AC: {0xE1B2FB40}, 0x2F8AD: {0xE1A4FB40}, 0x2F8AE: {0xE1AFFB40},
+		0x2F8AF: {0xE1DEFB40}, 0x2F8B0: {0xE1F2FB40}, 0x2F8B1: {0xE1F6FB40}, 0x2F8B2: {0xE210FB40}, 0x2F8B3: {0xE21BFB40}, 0x2F8B4: {0xE25DFB40},
+		0x2F8B5: {0xE2B1FB40}, 0x2F8B6: {0xE2D4FB40}, 0x2F8B7: {0xE350FB40}, 0x2F8B8: {0xAB0CFB84}, 0x2F8B9: {0xE33DFB40}, 0x2F8BA: {0xE2FCFB40},
+		0x2F8BB: {0xE368FB40}, 0x2F8BC: {0xE383FB40}, 0x2F8BD: {0xE3E4FB40}, 0x2F8BE: {0xABF1FB84}, 0x2F8BF: {0xE422FB40}, 0x2F8C0: {0xE3C5FB40},
+		0x2F8C1: {0xE3A9FB40}, 0x2F8C2: {0xBA2EFB80}, 0x2F8C3: {0xE469FB40}, 0x2F8C4: {0xE47EFB40}, 0x2F8C5: {0xE49DFB40}, 0x2F8C6: {0xE477FB40},
+		0x2F8C7: {0xBA6CFB80}, 0x2F8C8: {0xE54FFB40}, 0x2F8C9: {0xE56CFB40}, 0x2F8CA: {0xB00AFB84}, 0x2F8CB: {0xE5E3FB40}, 0x2F8CC: {0xE6F8FB40},
+		0x2F8CD: {0xE649FB40}, 0x2F8CE: {0xBB19FB80}, 0x2F8CF: {0xE691FB40}, 0x2F8D0: {0xBB08FB80}, 0x2F8D1: {0xBAE4FB80}, 0x2F8D2: {0xD192FB40},
+		0x2F8D3: {0xD195FB40}, 0x2F8D4: {0xE700FB40}, 0x2F8D5: {0xE69CFB40}, 0x2F8D6: {0x80ADFB41}, 0x2F8D7: {0xC3D9FB80}, 0x2F8D8: {0xE717FB40},
+		0x2F8D9: {0xE71BFB40}, 0x2F8DA: {0xE721FB40}, 0x2F8DB: {0xE75EFB40}, 0x2F8DC: {0xE753FB40}, 0x2F8DD: {0xB3C3FB84}, 0x2F8DE: {0xBB49FB80},
+		0x2F8DF: {0xE7FAFB40}, 0x2F8E0: {0xE785FB40}, 0x2F8E1: {0xE852FB40}, 0x2F8E2: {0xE885FB40}, 0x2F8E3: {0xB46DFB84}, 0x2F8E4: {0xE88EFB40},
+		0x2F8E5: {0xE81FFB40}, 0x2F8E6: {0xE914FB40}, 0x2F8E7: {0xBB9DFB80}, 0x2F8E8: {0xE942FB40}, 0x2F8E9: {0xE9A3FB40}, 0x2F8EA: {0xE9EAFB40},
+		0x2F8EB: {0xEAA8FB40}, 0x2F8EC: {0xB6A3FB84}, 0x2F8ED: {0xEADBFB40}, 0x2F8EE: {0xBC18FB80}, 0x2F8EF: {0xEB21FB40}, 0x2F8F0: {0xB8A7FB84},
+		0x2F8F1: {0xEB54FB40}, 0x2F8F2: {0xBC4EFB80}, 0x2F8F3: {0xEB72FB40}, 0x2F8F4: {0xEB9FFB40}, 0x2F8F5: {0xEBBAFB40}, 0x2F8F6: {0xEBBBFB40},
+		0x2F8F7: {0xBA8DFB84}, 0x2F8F8: {0x9D0BFB84}, 0x2F8F9: {0xBAFAFB84}, 0x2F8FA: {0xEC4EFB40}, 0x2F8FB: {0xBCBCFB84}, 0x2F8FC: {0xECBFFB40},
+		0x2F8FD: {0xECCDFB40}, 0x2F8FE: {0xEC67FB40}, 0x2F8FF: {0xED16FB40}, 0x2F900: {0xED3EFB40}, 0x2F901: {0xED77FB40}, 0x2F902: {0xED41FB40},
+		0x2F903: {0xED69FB40}, 0x2F904: {0xED78FB40}, 0x2F905: {0xED85FB40}, 0x2F906: {0xBD1EFB84}, 0x2F907: {0xED34FB40}, 0x2F908: {0xEE2FFB40},
+		0x2F909: {0xEE6EFB40}, 0x2F90A: {0xBD33FB80}, 0x2F90B: {0xEECBFB40}, 0x2F90C: {0xEEC7FB40}, 0x2F90D: {0xBED1FB84}, 0x2F90E: {0xEDF9FB40},
+		0x2F90F: {0xEF6EFB40}, 0x2F910: {0xBF5EFB84}, 0x2F911: {0xBF8EFB84}, 0x2F912: {0xEFC6FB40}, 0x2F913: {0xF039FB40}, 0x2F914: {0xF01EFB40},
+		0x2F915: {0xF01BFB40}, 0x2F916: {0xBD96FB80}, 0x2F917: {0xF04AFB40}, 0x2F918: {0xF07DFB40}, 0x2F919: {0xF077FB40}, 0x2F91A: {0xF0ADFB40},
+		0x2F91B: {0x8525FB84}, 0x2F91C: {0xF145FB40}, 0x2F91D: {0xC263FB84}, 0x2F91E: {0xF19CFB40}, 0x2F91F: {0xC3ABFB84}, 0x2F920: {0xF228FB40},
+		0x2F921: {0xF235FB40}, 0x2F922: {0xF250FB40}, 0x2F923: {0xC608FB84}, 0x2F924: {0xF280FB40}, 0x2F925: {0xF295FB40}, 0x2F926: {0xC735FB84},
+		0x2F927: {0xC814FB84}, 0x2F928: {0xF37AFB40}, 0x2F929: {0xF38BFB40}, 0x2F92A: {0xBEACFB80}, 0x2F92B: {0xF3A5FB40}, 0x2F92C: {0xBEB8FB80},
+		0x2F92D: {0xBEB8FB80}, 0x2F92E: {0xF447FB40}, 0x2F92F: {0xF45CFB40}, 0x2F930: {0xF471FB40}, 0x2F931: {0xF485FB40}, 0x2F932: {0xF4CAFB40},
+		0x2F933: {0xBF1BFB80}, 0x2F934: {0xF524FB40}, 0x2F935: {0xCC36FB84}, 0x2F936: {0xF53EFB40}, 0x2F937: {0xCC92FB84}, 0x2F938: {0xF570FB40},
+		0x2F939: {0xA19FFB84}, 0x2F93A: {0xF610FB40}, 0x2F93B: {0xCFA1FB84}, 0x2F93C: {0xCFB8FB84}, 0x2F93D: {0xD044FB84}, 0x2F93E: {0xBFFCFB80},
+		0x2F93F: {0xC008FB80}, 0x2F940: {0xF6F4FB40}, 0x2F941: {0xD0F3FB84}, 0x2F942: {0xD0F2FB84}, 0x2F943: {0xD119FB84}, 0x2F944: {0xD133FB84},
+		0x2F945: {0xF71EFB40}, 0x2F946: {0xF71FFB40}, 0x2F947: {0xF71FFB40}, 0x2F948: {0xF74AFB40}, 0x2F949: {0xC039FB80}, 0x2F94A: {0xF78BFB40},
+		0x2F94B: {0xC046FB80}, 0x2F94C: {0xC096FB80}, 0x2F94D: {0xD41DFB84}, 0x2F94E: {0xF84EFB40}, 0x2F94F: {0xF88CFB40}, 0x2F950: {0xF8CCFB40},
+		0x2F951: {0xC0E3FB80}, 0x2F952: {0xD626FB84}, 0x2F953: {0xF956FB40}, 0x2F954: {0xD69AFB84}, 0x2F955: {0xD6C5FB84}, 0x2F956: {0xF98FFB40},
+		0x2F957: {0xF9EBFB40}, 0x2F958: {0xC12FFB80}, 0x2F959: {0xFA40FB40}, 0x2F95A: {0xFA4AFB40}, 0x2F95B: {0xFA4FFB40}, 0x2F95C: {0xD97CFB84},
+		0x2F95D: {0xDAA7FB84}, 0x2F95E: {0xDAA7FB84}, 0x2F95F: {0xFAEEFB40}, 0x2F960: {0xC202FB80}, 0x2F961: {0xDBABFB84}, 0x2F962: {0xFBC6FB40},
+		0x2F963: {0xFBC9FB40}, 0x2F964: {0xC227FB80}, 0x2F965: {0xDC80FB84}, 0x2F966: {0xFCD2FB40}, 0x2F967: {0xC2A0FB80}, 0x2F968: {0xFCE8FB40},
+		0x2F969: {0xFCE3FB40}, 0x2F96A: {0xFD00FB40}, 0x2F96B: {0xDF86FB84}, 0x2F96C: {0xFD63FB40}, 0x2F96D: {0xC301FB80}, 0x2F96E: {0xFDC7FB40},
+		0x2F96F: {0xFE02FB40}, 0x2F970: {0xFE45FB40}, 0x2F971: {0xC334FB80}, 0x2F972: {0xE228FB84}, 0x2F973: {0xE247FB84}, 0x2F974: {0xC359FB80},
+		0x2F975: {0xE2D9FB84}, 0x2F976: {0xFF7AFB40}, 0x2F977: {0xE33EFB84}, 0x2F978: {0xFF95FB40}, 0x2F979: {0xFFFAFB40}, 0x2F97A: {0x8005FB41},
+		0x2F97B: {0xE4DAFB84}, 0x2F97C: {0xE523FB84}, 0x2F97D: {0x8060FB41}, 0x2F97E: {0xE5A8FB84}, 0x2F97F: {0x8070FB41}, 0x2F980: {0xB35FFB84},
+		0x2F981: {0xC3D5FB80}, 0x2F982: {0x80B2FB41}, 0x2F983: {0x8103FB41}, 0x2F984: {0xC40BFB80}, 0x2F985: {0x813EFB41}, 0x2F986: {0xDAB5FB40},
+		0x2F987: {0xE7A7FB84}, 0x2F988: {0xE7B5FB84}, 0x2F989: {0xB393FB84}, 0x2F98A: {0xB39CFB84}, 0x2F98B: {0x8201FB41}, 0x2F98C: {0x8204FB41},
+		0x2F98D: {0x8F9EFB41}, 0x2F98E: {0xC46BFB80}, 0x2F98F: {0x8291FB41}, 0x2F990: {0x828BFB41}, 0x2F991: {0x829DFB41}, 0x2F992: {0xD2B3FB40},
+		0x2F993: {0x82B1FB41}, 0x2F994: {0x82B3FB41}, 0x2F995: {0x82BDFB41}, 0x2F996: {0x82E6FB41}, 0x2F997: {0xEB3CFB84}, 0x2F998: {0x82E5FB41},
+		0x2F999: {0x831DFB41}, 0x2F99A: {0x8363FB41}, 0x2F99B: {0x83ADFB41}, 0x2F99C: {0x8323FB41}, 0x2F99D: {0x83BDFB41}, 0x2F99E: {0x83E7FB41},
+		0x2F99F: {0x8457FB41}, 0x2F9A0: {0x8353FB41}, 0x2F9A1: {0x83CAFB41}, 0x2F9A2: {0x83CCFB41}, 0x2F9A3: {0x83DCFB41}, 0x2F9A4: {0xEC36FB84},
+		0x2F9A5: {0xED6BFB84}, 0x2F9A6: {0xECD5FB84}, 0x2F9A7: {0xC52BFB80}, 0x2F9A8: {0x84F1FB41}, 0x2F9A9: {0x84F3FB41}, 0x2F9AA: {0x8516FB41},
+		0x2F9AB: {0xF3CAFB84}, 0x2F9AC: {0x8564FB41}, 0x2F9AD: {0xEF2CFB84}, 0x2F9AE: {0xC55DFB80}, 0x2F9AF: {0xC561FB80}, 0x2F9B0: {0xEFB1FB84},
+		0x2F9B1: {0xF0D2FB84}, 0x2F9B2: {0xC56BFB80}, 0x2F9B3: {0x8650FB41}, 0x2F9B4: {0x865CFB41}, 0x2F9B5: {0x8667FB41}, 0x2F9B6: {0x8669FB41},
+		0x2F9B7: {0x86A9FB41}, 0x2F9B8: {0x8688FB41}, 0x2F9B9: {0x870EFB41}, 0x2F9BA: {0x86E2FB41}, 0x2F9BB: {0x8779FB41}, 0x2F9BC: {0x8728FB41},
+		0x2F9BD: {0x876BFB41}, 0x2F9BE: {0x8786FB41}, 0x2F9BF: {0xC5D7FB80}, 0x2F9C0: {0x87E1FB41}, 0x2F9C1: {0x8801FB41}, 0x2F9C2: {0xC5F9FB80},
+		0x2F9C3: {0x8860FB41}, 0x2F9C4: {0x8863FB41}, 0x2F9C5: {0xF667FB84}, 0x2F9C6: {0x88D7FB41}, 0x2F9C7: {0x88DEFB41}, 0x2F9C8: {0xC635FB80},
+		0x2F9C9: {0x88FAFB41}, 0x2F9CA: {0xB4BBFB80}, 0x2F9CB: {0xF8AEFB84}, 0x2F9CC: {0xF966FB84}, 0x2F9CD: {0xC6BEFB80}, 0x2F9CE: {0xC6C7FB80},
+		0x2F9CF: {0x8AA0FB41}, 0x2F9D0: {0x8AEDFB41}, 0x2F9D1: {0x8B8AFB41}, 0x2F9D2: {0x8C55FB41}, 0x2F9D3: {0xFCA8FB84}, 0x2F9D4: {0x8CABFB41},
+		0x2F9D5: {0x8CC1FB41}, 0x2F9D6: {0x8D1BFB41}, 0x2F9D7: {0x8D77FB41}, 0x2F9D8: {0xFF2FFB84}, 0x2F9D9: {0x8804FB84}, 0x2F9DA: {0x8DCBFB41},
+		0x2F9DB: {0x8DBCFB41}, 0x2F9DC: {0x8DF0FB41}, 0x2F9DD: {0x88DEFB84}, 0x2F9DE: {0x8ED4FB41}, 0x2F9DF: {0x8F38FB41}, 0x2F9E0: {0x85D2FB85},
+		0x2F9E1: {0x85EDFB85}, 0x2F9E2: {0x9094FB41}, 0x2F9E3: {0x90F1FB41}, 0x2F9E4: {0x9111FB41}, 0x2F9E5: {0x872EFB85}, 0x2F9E6: {0x911BFB41},
+		0x2F9E7: {0x9238FB41}, 0x2F9E8: {0x92D7FB41}, 0x2F9E9: {0x92D8FB41}, 0x2F9EA: {0x927CFB41}, 0x2F9EB: {0x93F9FB41}, 0x2F9EC: {0x9415FB41},
+		0x2F9ED: {0x8BFAFB85}, 0x2F9EE: {0x958BFB41}, 0x2F9EF: {0xC995FB80}, 0x2F9F0: {0x95B7FB41}, 0x2F9F1: {0x8D77FB85}, 0x2F9F2: {0xC9E6FB80},
+		0x2F9F3: {0x96C3FB41}, 0x2F9F4: {0xDDB2FB40}, 0x2F9F5: {0x9723FB41}, 0x2F9F6: {0x9145FB85}, 0x2F9F7: {0x921AFB85}, 0x2F9F8: {0xCA6EFB80},
+		0x2F9F9: {0xCA76FB80}, 0x2F9FA: {0x97E0FB41}, 0x2F9FB: {0x940AFB85}, 0x2F9FC: {0xCAB2FB80}, 0x2F9FD: {0x9496FB85}, 0x2F9FE: {0x980BFB41},
+		0x2F9FF: {0x980BFB41}, 0x2FA00: {0x9829FB41}, 0x2FA01: {0x95B6FB85}, 0x2FA02: {0x98E2FB41}, 0x2FA03: {0xCB33FB80}, 0x2FA04: {0x9929FB41},
+		0x2FA05: {0x99A7FB41}, 0x2FA06: {0x99C2FB41}, 0x2FA07: {0x99FEFB41}, 0x2FA08: {0xCBCEFB80}, 0x2FA09: {0x9B30FB85}, 0x2FA0A: {0x9B12FB41},
+		0x2FA0B: {0x9C40FB41}, 0x2FA0C: {0x9CFDFB41}, 0x2FA0D: {0xCCCEFB80}, 0x2FA0E: {0xCCEDFB80}, 0x2FA0F: {0x9D67FB41}, 0x2FA10: {0xA0CEFB85},
+		0x2FA11: {0xCCF8FB80}, 0x2FA12: {0xA105FB85}, 0x2FA13: {0xA20EFB85}, 0x2FA14: {0xA291FB85}, 0x2FA15: {0x9EBBFB41}, 0x2FA16: {0xCD56FB80},
+		0x2FA17: {0x9EF9FB41}, 0x2FA18: {0x9EFEFB41}, 0x2FA19: {0x9F05FB41}, 0x2FA1A: {0x9F0FFB41}, 0x2FA1B: {0x9F16FB41}, 0x2FA1C: {0x9F3BFB41},
+		0x2FA1D: {0xA600FB85}, 0xE0001: {}, 0xE0020: {}, 0xE0021: {}, 0xE0022: {}, 0xE0023: {},
+		0xE0024: {}, 0xE0025: {}, 0xE0026: {}, 0xE0027: {}, 0xE0028: {}, 0xE0029: {},
+		0xE002A: {}, 0xE002B: {}, 0xE002C: {}, 0xE002D: {}, 0xE002E: {}, 0xE002F: {},
+		0xE0030: {}, 0xE0031: {}, 0xE0032: {}, 0xE0033: {}, 0xE0034: {}, 0xE0035: {},
+		0xE0036: {}, 0xE0037: {}, 0xE0038: {}, 0xE0039: {}, 0xE003A: {}, 0xE003B: {},
+		0xE003C: {}, 0xE003D: {}, 0xE003E: {}, 0xE003F: {}, 0xE0040: {}, 0xE0041: {},
+		0xE0042: {}, 0xE0043: {}, 0xE0044: {}, 0xE0045: {}, 0xE0046: {}, 0xE0047: {},
+		0xE0048: {}, 0xE0049: {}, 0xE004A: {}, 0xE004B: {}, 0xE004C: {}, 0xE004D: {},
+		0xE004E: {}, 0xE004F: {}, 0xE0050: {}, 0xE0051: {}, 0xE0052: {}, 0xE0053: {},
+		0xE0054: {}, 0xE0055: {}, 0xE0056: {}, 0xE0057: {}, 0xE0058: {}, 0xE0059: {},
+		0xE005A: {}, 0xE005B: {}, 0xE005C: {}, 0xE005D: {}, 0xE005E: {}, 0xE005F: {},
+		0xE0060: {}, 0xE0061: {}, 0xE0062: {}, 0xE0063: {}, 0xE0064: {}, 0xE0065: {},
+		0xE0066: {}, 0xE0067: {}, 0xE0068: {}, 0xE0069: {}, 0xE006A: {}, 0xE006B: {},
+		0xE006C: {}, 0xE006D: {}, 0xE006E: {}, 0xE006F: {}, 0xE0070: {}, 0xE0071: {},
+		0xE0072: {}, 0xE0073: {}, 0xE0074: {}, 0xE0075: {}, 0xE0076: {}, 0xE0077: {},
+		0xE0078: {}, 0xE0079: {}, 0xE007A: {}, 0xE007B: {}, 0xE007C: {}, 0xE007D: {},
+		0xE007E: {}, 0xE007F: {}, 0xE0100: {}, 0xE0101: {}, 0xE0102: {}, 0xE0103: {},
+		0xE0104: {}, 0xE0105: {}, 0xE0106: {}, 0xE0107: {}, 0xE0108: {}, 0xE0109: {},
+		0xE010A: {}, 0xE010B: {}, 0xE010C: {}, 0xE010D: {}, 0xE010E: {}, 0xE010F: {},
+		0xE0110: {}, 0xE0111: {}, 0xE0112: {}, 0xE0113: {}, 0xE0114: {}, 0xE0115: {},
+		0xE0116: {}, 0xE0117: {}, 0xE0118: {}, 0xE0119: {}, 0xE011A: {}, 0xE011B: {},
+		0xE011C: {}, 0xE011D: {}, 0xE011E: {}, 0xE011F: {}, 0xE0120: {}, 0xE0121: {},
+		0xE0122: {}, 0xE0123: {}, 0xE0124: {}, 0xE0125: {}, 0xE0126: {}, 0xE0127: {},
+		0xE0128: {}, 0xE0129: {}, 0xE012A: {}, 0xE012B: {}, 0xE012C: {}, 0xE012D: {},
+		0xE012E: {}, 0xE012F: {}, 0xE0130: {}, 0xE0131: {}, 0xE0132: {}, 0xE0133: {},
+		0xE0134: {}, 0xE0135: {}, 0xE0136: {}, 0xE0137: {}, 0xE0138: {}, 0xE0139: {},
+		0xE013A: {}, 0xE013B: {}, 0xE013C: {}, 0xE013D: {}, 0xE013E: {}, 0xE013F: {},
+		0xE0140: {}, 0xE0141: {}, 0xE0142: {}, 0xE0143: {}, 0xE0144: {}, 0xE0145: {},
+		0xE0146: {}, 0xE0147: {}, 0xE0148: {}, 0xE0149: {}, 0xE014A: {}, 0xE014B: {},
+		0xE014C: {}, 0xE014D: {}, 0xE014E: {}, 0xE014F: {}, 0xE0150: {}, 0xE0151: {},
+		0xE0152: {}, 0xE0153: {}, 0xE0154: {}, 0xE0155: {}, 0xE0156: {}, 0xE0157: {},
+		0xE0158: {}, 0xE0159: {}, 0xE015A: {}, 0xE015B: {}, 0xE015C: {}, 0xE015D: {},
+		0xE015E: {}, 0xE015F: {}, 0xE0160: {}, 0xE0161: {}, 0xE0162: {}, 0xE0163: {},
+		0xE0164: {}, 0xE0165: {}, 0xE0166: {}, 0xE0167: {}, 0xE0168: {}, 0xE0169: {},
+		0xE016A: {}, 0xE016B: {}, 0xE016C: {}, 0xE016D: {}, 0xE016E: {}, 0xE016F: {},
+		0xE0170: {}, 0xE0171: {}, 0xE0172: {}, 0xE0173: {}, 0xE0174: {}, 0xE0175: {},
+		0xE0176: {}, 0xE0177: {}, 0xE0178: {}, 0xE0179: {}, 0xE017A: {}, 0xE017B: {},
+		0xE017C: {}, 0xE017D: {}, 0xE017E: {}, 0xE017F: {}, 0xE0180: {}, 0xE0181: {},
+		0xE0182: {}, 0xE0183: {}, 0xE0184: {}, 0xE0185: {}, 0xE0186: {}, 0xE0187: {},
+		0xE0188: {}, 0xE0189: {}, 0xE018A: {}, 0xE018B: {}, 0xE018C: {}, 0xE018D: {},
+		0xE018E: {}, 0xE018F: {}, 0xE0190: {}, 0xE0191: {}, 0xE0192: {}, 0xE0193: {},
+		0xE0194: {}, 0xE0195: {}, 0xE0196: {}, 0xE0197: {}, 0xE0198: {}, 0xE0199: {},
+		0xE019A: {}, 0xE019B: {}, 0xE019C: {}, 0xE019D: {}, 0xE019E: {}, 0xE019F: {},
+		0xE01A0: {}, 0xE01A1: {}, 0xE01A2: {}, 0xE01A3: {}, 0xE01A4: {}, 0xE01A5: {},
+		0xE01A6: {}, 0xE01A7: {}, 0xE01A8: {}, 0xE01A9: {}, 0xE01AA: {}, 0xE01AB: {},
+		0xE01AC: {}, 0xE01AD: {}, 0xE01AE: {}, 0xE01AF: {}, 0xE01B0: {}, 0xE01B1: {},
+		0xE01B2: {}, 0xE01B3: {}, 0xE01B4: {}, 0xE01B5: {}, 0xE01B6: {}, 0xE01B7: {},
+		0xE01B8: {}, 0xE01B9: {}, 0xE01BA: {}, 0xE01BB: {}, 0xE01BC: {}, 0xE01BD: {},
+		0xE01BE: {}, 0xE01BF: {}, 0xE01C0: {}, 0xE01C1: {}, 0xE01C2: {}, 0xE01C3: {},
+		0xE01C4: {}, 0xE01C5: {}, 0xE01C6: {}, 0xE01C7: {}, 0xE01C8: {}, 0xE01C9: {},
+		0xE01CA: {}, 0xE01CB: {}, 0xE01CC: {}, 0xE01CD: {}, 0xE01CE: {}, 0xE01CF: {},
+		0xE01D0: {}, 0xE01D1: {}, 0xE01D2: {}, 0xE01D3: {}, 0xE01D4: {}, 0xE01D5: {},
+		0xE01D6: {}, 0xE01D7: {}, 0xE01D8: {}, 0xE01D9: {}, 0xE01DA: {}, 0xE01DB: {},
+		0xE01DC: {}, 0xE01DD: {}, 0xE01DE: {}, 0xE01DF: {}, 0xE01E0: {}, 0xE01E1: {},
+		0xE01E2: {}, 0xE01E3: {}, 0xE01E4: {}, 0xE01E5: {}, 0xE01E6: {}, 0xE01E7: {},
+		0xE01E8: {}, 0xE01E9: {}, 0xE01EA: {}, 0xE01EB: {}, 0xE01EC: {}, 0xE01ED: {},
+		0xE01EE: {}, 0xE01EF: {},
+	}
+)
@@ -77,3 +77,12 @@ func TestLogFormat(t *testing.T) {
 	logFields = genLogFields(costTime, info)
 	assert.Equal(t, "select * from table where `a` > ?", logFields[6].String)
 }
+
+func TestIsCommitting(t *testing.T) {
+	info := &util.ProcessInfo{}
+	assert.False(t, isCommitting(info))
+	info.StmtCtx = &stmtctx.StatementContext{}
+	assert.False(t, isCommitting(info))
+	info.StmtCtx.InCommitPhase.Store(true)
+	assert.True(t, isCommitting(info))
+}
@@ -71,7 +71,7 @@ func (eqh *Handle) Run() {
 					logExpensiveQuery(costTime, info)
 					info.ExceedExpensiveTimeThresh = true
 				}
-				if info.MaxExecutionTime > 0 && costTime > time.Duration(info.MaxExecutionTime)*time.Millisecond {
+				if info.MaxExecutionTime > 0 && costTime > time.Duration(info.MaxExecutionTime)*time.Millisecond && !isCommitting(info) {
 					logutil.BgLogger().Warn("execution timeout, kill it", zap.Duration("costTime", costTime),
 						zap.Duration("maxExecutionTime", time.Duration(info.MaxExecutionTime)*time.Millisecond), zap.String("processInfo", info.String()))
 					sm.Kill(info.ID, true)
@@ -97,6 +97,11 @@ func (eqh *Handle) Run() {
 	}
 }
 
+// isCommitting checks whether the statement is committing its transaction, which should not be killed.
+func isCommitting(info *util.ProcessInfo) bool {
+	return info.StmtCtx != nil && info.StmtCtx.InCommitPhase.Load()
+}
+
 // LogOnQueryExceedMemQuota prints a log when memory usage of connID is out of memory quota.
 func (eqh *Handle) LogOnQueryExceedMemQuota(connID uint64) {
 	if log.GetLevel() > zapcore.WarnLevel {
@@ -45,7 +45,7 @@ type ProcessInfo struct {
 	PlanExplainRows  [][]string
 	CurTxnStartTS    uint64
 	ID               uint64
-	// MaxExecutionTime is the timeout for select statement, in milliseconds.
+	// MaxExecutionTime is the timeout for the statement, in milliseconds.
 	// If the query takes too long, kill it.
 	MaxExecutionTime          uint64
 	State                     uint16
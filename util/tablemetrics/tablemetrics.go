@@ -0,0 +1,303 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tablemetrics
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/atomic"
+)
+
+// Default per-table metrics values.
+const (
+	DefTiDBEnableTableMetrics = false
+	DefTiDBTableMetricsTopK   = 100
+)
+
+// OtherTable is the table name of the statistics of the tables and indexes out of the top K.
+const OtherTable = "_other"
+
+var (
+	enable         = atomic.NewBool(DefTiDBEnableTableMetrics)
+	globalRecorder = newRecorder(DefTiDBTableMetricsTopK)
+)
+
+// Key identifies a table or an index of the table. Index is empty for the table itself.
+type Key struct {
+	DB    string
+	Table string
+	Index string
+}
+
+// Stats is the statistics of the statements accessing a table or an index.
+type Stats struct {
+	Key
+	Count      uint64
+	ErrorCount uint64
+	SumLatency time.Duration
+	MaxLatency time.Duration
+}
+
+// evictionSamples is the number of the recorded keys sampled to pick the one
+// to evict.
+const evictionSamples = 5
+
+// otherKey is the key of the statistics of the tables and indexes out of the top K.
+var otherKey = Key{Table: OtherTable}
+
+// entry is the statistics of a recorded key. It's updated without holding the
+// lock of the recorder, and its collectors are cached so a statement doesn't
+// look them up by the labels.
+type entry struct {
+	key        Key
+	count      atomic.Uint64
+	errorCount atomic.Uint64
+	sumLatency atomic.Int64
+	maxLatency atomic.Int64
+
+	okCounter    prometheus.Counter
+	errorCounter prometheus.Counter
+	duration     prometheus.Observer
+}
+
+func newEntry(k Key) *entry {
+	return &entry{
+		key:          k,
+		okCounter:    metrics.TableRequestCounter.WithLabelValues(k.DB, k.Table, k.Index, metrics.LblOK),
+		errorCounter: metrics.TableRequestCounter.WithLabelValues(k.DB, k.Table, k.Index, metrics.LblError),
+		duration:     metrics.TableRequestDurationHistogram.WithLabelValues(k.DB, k.Table, k.Index),
+	}
+}
+
+func (e *entry) observe(latency time.Duration, succ bool) {
+	e.count.Inc()
+	if succ {
+		e.okCounter.Inc()
+	} else {
+		e.errorCount.Inc()
+		e.errorCounter.Inc()
+	}
+	e.sumLatency.Add(int64(latency))
+	for {
+		maxLatency := e.maxLatency.Load()
+		if int64(latency) <= maxLatency || e.maxLatency.CAS(maxLatency, int64(latency)) {
+			break
+		}
+	}
+	e.duration.Observe(latency.Seconds())
+}
+
+func (e *entry) stats() Stats {
+	return Stats{
+		Key:        e.key,
+		Count:      e.count.Load(),
+		ErrorCount: e.errorCount.Load(),
+		SumLatency: time.Duration(e.sumLatency.Load()),
+		MaxLatency: time.Duration(e.maxLatency.Load()),
+	}
+}
+
+func (e *entry) deleteMetrics() {
+	metrics.TableRequestCounter.DeleteLabelValues(e.key.DB, e.key.Table, e.key.Index, metrics.LblOK)
+	metrics.TableRequestCounter.DeleteLabelValues(e.key.DB, e.key.Table, e.key.Index, metrics.LblError)
+	metrics.TableRequestDurationHistogram.DeleteLabelValues(e.key.DB, e.key.Table, e.key.Index)
+}
+
+// recorder records the statistics of at most topK tables and indexes, the others
+// are recorded together as OtherTable. A key out of the top K is counted as a
+// candidate, and it replaces a less accessed key once it's accessed more.
+//
+// The recorded keys are observed under the read lock, only the keys out of the
+// top K take the write lock.
+type recorder struct {
+	mu         sync.RWMutex
+	topK       int
+	entries    map[Key]*entry
+	other      *entry
+	candidates map[Key]uint64
+}
+
+func newRecorder(topK int) *recorder {
+	return &recorder{
+		topK:       topK,
+		entries:    make(map[Key]*entry),
+		other:      newEntry(otherKey),
+		candidates: make(map[Key]uint64),
+	}
+}
+
+func (r *recorder) observe(keys []Key, latency time.Duration, succ bool) {
+	otherObserved := false
+	for _, k := range keys {
+		r.mu.RLock()
+		e, ok := r.entries[k]
+		r.mu.RUnlock()
+		if !ok {
+			e = r.admit(k)
+		}
+		if e.key == otherKey {
+			// the statement is counted once in the other statistics.
+			if otherObserved {
+				continue
+			}
+			otherObserved = true
+		}
+		e.observe(latency, succ)
+	}
+}
+
+// admit returns the statistics of the new key, which is the other statistics if
+// the key is not in the top K.
+func (r *recorder) admit(k Key) *entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if e, ok := r.entries[k]; ok {
+		// admitted by another statement.
+		return e
+	}
+	if len(r.entries) < r.topK {
+		e := newEntry(k)
+		r.entries[k] = e
+		return e
+	}
+	r.candidates[k]++
+	if victim := r.sampleLeastAccessed(); victim != nil && r.candidates[k] > victim.count.Load() {
+		r.evict(victim)
+		delete(r.candidates, k)
+		e := newEntry(k)
+		r.entries[k] = e
+		return e
+	}
+	if len(r.candidates) > r.topK {
+		// the candidates are too many to be hot, start over.
+		r.candidates = make(map[Key]uint64)
+	}
+	return r.other
+}
+
+// sampleLeastAccessed returns the least accessed one of a few recorded keys,
+// the map iteration starts at a random entry, so it approximates the least
+// accessed key of all without scanning them.
+func (r *recorder) sampleLeastAccessed() *entry {
+	var least *entry
+	sampled := 0
+	for _, e := range r.entries {
+		if least == nil || e.count.Load() < least.count.Load() {
+			least = e
+		}
+		if sampled++; sampled >= evictionSamples {
+			break
+		}
+	}
+	return least
+}
+
+func (r *recorder) leastAccessed() *entry {
+	var least *entry
+	for _, e := range r.entries {
+		if least == nil || e.count.Load() < least.count.Load() {
+			least = e
+		}
+	}
+	return least
+}
+
+func (r *recorder) evict(e *entry) {
+	delete(r.entries, e.key)
+	e.deleteMetrics()
+}
+
+func (r *recorder) setTopK(topK int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.topK = topK
+	for len(r.entries) > r.topK {
+		r.evict(r.leastAccessed())
+	}
+}
+
+func (r *recorder) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, e := range r.entries {
+		e.deleteMetrics()
+	}
+	r.other.deleteMetrics()
+	r.entries = make(map[Key]*entry)
+	r.other = newEntry(otherKey)
+	r.candidates = make(map[Key]uint64)
+}
+
+func (r *recorder) getStats() []Stats {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	stats := make([]Stats, 0, len(r.entries)+1)
+	for _, e := range r.entries {
+		stats = append(stats, e.stats())
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Count > stats[j].Count
+	})
+	if other := r.other.stats(); other.Count > 0 {
+		stats = append(stats, other)
+	}
+	return stats
+}
+
+// Enable enables the per-table metrics.
+func Enable() {
+	enable.Store(true)
+}
+
+// Disable disables the per-table metrics, and clears the recorded statistics.
+func Disable() {
+	enable.Store(false)
+	globalRecorder.reset()
+}
+
+// Enabled returns whether the per-table metrics is enabled.
+func Enabled() bool {
+	return enable.Load()
+}
+
+// SetTopK sets the max number of the tables and indexes recorded individually.
+func SetTopK(topK int) {
+	globalRecorder.setTopK(topK)
+}
+
+// TopK returns the max number of the tables and indexes recorded individually.
+func TopK() int {
+	globalRecorder.mu.RLock()
+	defer globalRecorder.mu.RUnlock()
+	return globalRecorder.topK
+}
+
+// Observe records a statement accessing the tables and indexes.
+func Observe(keys []Key, latency time.Duration, succ bool) {
+	if !Enabled() || len(keys) == 0 {
+		return
+	}
+	globalRecorder.observe(keys, latency, succ)
+}
+
+// GetStats returns the statistics of the top tables and indexes ordered by the access count,
+// followed by the statistics of the others.
+func GetStats() []Stats {
+	return globalRecorder.getStats()
+}
@@ -0,0 +1,104 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tablemetrics
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorderTopK(t *testing.T) {
+	r := newRecorder(2)
+	t1 := Key{DB: "test", Table: "t1"}
+	t2 := Key{DB: "test", Table: "t2"}
+	t3 := Key{DB: "test", Table: "t3"}
+	idx := Key{DB: "test", Table: "t1", Index: "idx"}
+
+	r.observe([]Key{t1}, time.Millisecond, true)
+	r.observe([]Key{t1, t2}, 3*time.Millisecond, false)
+	stats := r.getStats()
+	require.Len(t, stats, 2)
+	require.Equal(t, Stats{Key: t1, Count: 2, ErrorCount: 1, SumLatency: 4 * time.Millisecond, MaxLatency: 3 * time.Millisecond}, stats[0])
+	require.Equal(t, t2, stats[1].Key)
+
+	// t3 and idx are out of the top 2, they are counted once in the others.
+	r.observe([]Key{t3, idx}, time.Millisecond, true)
+	stats = r.getStats()
+	require.Len(t, stats, 3)
+	require.Equal(t, Stats{Key: Key{Table: OtherTable}, Count: 1, SumLatency: time.Millisecond, MaxLatency: time.Millisecond}, stats[2])
+
+	// t3 replaces t2 once it's accessed more than t2.
+	r.observe([]Key{t3}, time.Millisecond, true)
+	stats = r.getStats()
+	require.Len(t, stats, 3)
+	require.Equal(t, []Key{t1, t3, {Table: OtherTable}}, []Key{stats[0].Key, stats[1].Key, stats[2].Key})
+
+	r.setTopK(1)
+	stats = r.getStats()
+	require.Len(t, stats, 2)
+	require.Equal(t, t1, stats[0].Key)
+
+	r.reset()
+	require.Len(t, r.getStats(), 0)
+}
+
+func TestRecorderConcurrent(t *testing.T) {
+	r := newRecorder(2)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				k := Key{DB: "test", Table: fmt.Sprintf("t%d", (i+j)%4)}
+				r.observe([]Key{k}, time.Duration(j)*time.Millisecond, j%10 != 0)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	var count, errorCount uint64
+	stats := r.getStats()
+	require.LessOrEqual(t, len(stats), 3)
+	for _, s := range stats {
+		count += s.Count
+		errorCount += s.ErrorCount
+		require.LessOrEqual(t, s.MaxLatency, 99*time.Millisecond)
+	}
+	// the evicted keys lose their statistics.
+	require.LessOrEqual(t, count, uint64(800))
+	require.LessOrEqual(t, errorCount, uint64(80))
+	require.Greater(t, count, uint64(0))
+	r.reset()
+}
+
+func TestObserve(t *testing.T) {
+	defer Disable()
+	Observe([]Key{{DB: "test", Table: "t"}}, time.Millisecond, true)
+	require.Len(t, GetStats(), 0)
+
+	Enable()
+	require.True(t, Enabled())
+	Observe([]Key{{DB: "test", Table: "t"}}, time.Millisecond, true)
+	require.Len(t, GetStats(), 1)
+
+	Disable()
+	require.False(t, Enabled())
+	require.Len(t, GetStats(), 0)
+}
@@ -0,0 +1,215 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporter
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb/util/hack"
+)
+
+var _ DataSink = &LocalDataSink{}
+
+// LocalRecord is a Top SQL record of a SQL and plan in one second, which is stored by LocalDataSink.
+type LocalRecord struct {
+	TimestampSec      uint64
+	SQLDigest         []byte
+	PlanDigest        []byte
+	NormalizedSQL     string
+	NormalizedPlan    string
+	IsInternalSQL     bool
+	CPUTimeMs         uint32
+	StmtExecCount     uint64
+	StmtKvExecCount   uint64
+	StmtDurationSumNs uint64
+	StmtDurationCount uint64
+}
+
+type localMeta struct {
+	text       string
+	isInternal bool
+	// lastSeen is used to evict the meta which is not referenced by any record.
+	lastSeen uint64
+}
+
+// LocalDataSink stores the Top SQL data in memory with a bounded ring buffer, so that
+// Top SQL can be queried without deploying ng-monitoring.
+type LocalDataSink struct {
+	retention time.Duration
+
+	mu struct {
+		sync.RWMutex
+		closed    bool
+		records   []LocalRecord
+		head      int
+		size      int
+		sqlMetas  map[string]*localMeta
+		planMetas map[string]*localMeta
+	}
+}
+
+// NewLocalDataSink creates a LocalDataSink which keeps at most maxRecords records within the retention.
+func NewLocalDataSink(retention time.Duration, maxRecords int) *LocalDataSink {
+	if maxRecords <= 0 {
+		maxRecords = 1
+	}
+	ds := &LocalDataSink{retention: retention}
+	ds.mu.records = make([]LocalRecord, maxRecords)
+	ds.mu.sqlMetas = make(map[string]*localMeta)
+	ds.mu.planMetas = make(map[string]*localMeta)
+	return ds
+}
+
+// TrySend implements DataSink.
+//
+// It never blocks, the data is stored before it returns.
+func (ds *LocalDataSink) TrySend(data *ReportData, _ time.Time) error {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	if ds.mu.closed {
+		return errors.New("local data sink is closed")
+	}
+
+	now := uint64(nowFunc().Unix())
+	for _, meta := range data.SQLMetas {
+		ds.mu.sqlMetas[string(meta.SqlDigest)] = &localMeta{
+			text:       meta.NormalizedSql,
+			isInternal: meta.IsInternalSql,
+			lastSeen:   now,
+		}
+	}
+	for _, meta := range data.PlanMetas {
+		ds.mu.planMetas[string(meta.PlanDigest)] = &localMeta{text: meta.NormalizedPlan, lastSeen: now}
+	}
+	for _, record := range data.DataRecords {
+		ds.touchMeta(record.SqlDigest, record.PlanDigest, now)
+		for _, item := range record.Items {
+			ds.push(LocalRecord{
+				TimestampSec:      item.TimestampSec,
+				SQLDigest:         record.SqlDigest,
+				PlanDigest:        record.PlanDigest,
+				CPUTimeMs:         item.CpuTimeMs,
+				StmtExecCount:     item.StmtExecCount,
+				StmtKvExecCount:   totalKvExecCount(item.StmtKvExecCount),
+				StmtDurationSumNs: item.StmtDurationSumNs,
+				StmtDurationCount: item.StmtDurationCount,
+			})
+		}
+	}
+	ds.evictLocked(now)
+	return nil
+}
+
+// OnReporterClosing implements DataSink.
+func (ds *LocalDataSink) OnReporterClosing() {
+	ds.Close()
+}
+
+// Close releases the stored data, the sink no longer accepts data after closed.
+func (ds *LocalDataSink) Close() {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	ds.mu.closed = true
+	ds.mu.records = nil
+	ds.mu.head, ds.mu.size = 0, 0
+	ds.mu.sqlMetas = make(map[string]*localMeta)
+	ds.mu.planMetas = make(map[string]*localMeta)
+}
+
+// Records returns the stored records within the retention, ordered by the time they are stored.
+func (ds *LocalDataSink) Records() []LocalRecord {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+	var minTs uint64
+	if now := uint64(nowFunc().Unix()); ds.retention > 0 && now > uint64(ds.retention/time.Second) {
+		minTs = now - uint64(ds.retention/time.Second)
+	}
+	result := make([]LocalRecord, 0, ds.mu.size)
+	for i := 0; i < ds.mu.size; i++ {
+		r := ds.mu.records[(ds.mu.head+i)%len(ds.mu.records)]
+		if r.TimestampSec < minTs {
+			continue
+		}
+		if meta, ok := ds.mu.sqlMetas[string(r.SQLDigest)]; ok {
+			r.NormalizedSQL, r.IsInternalSQL = meta.text, meta.isInternal
+		}
+		if meta, ok := ds.mu.planMetas[string(r.PlanDigest)]; ok {
+			r.NormalizedPlan = meta.text
+		}
+		result = append(result, r)
+	}
+	return result
+}
+
+func (ds *LocalDataSink) push(r LocalRecord) {
+	if len(ds.mu.records) == 0 {
+		return
+	}
+	if ds.mu.size < len(ds.mu.records) {
+		ds.mu.records[(ds.mu.head+ds.mu.size)%len(ds.mu.records)] = r
+		ds.mu.size++
+		return
+	}
+	// the buffer is full, overwrite the oldest record.
+	ds.mu.records[ds.mu.head] = r
+	ds.mu.head = (ds.mu.head + 1) % len(ds.mu.records)
+}
+
+func (ds *LocalDataSink) touchMeta(sqlDigest, planDigest []byte, now uint64) {
+	if meta, ok := ds.mu.sqlMetas[hack.String(sqlDigest)]; ok {
+		meta.lastSeen = now
+	}
+	if meta, ok := ds.mu.planMetas[hack.String(planDigest)]; ok {
+		meta.lastSeen = now
+	}
+}
+
+// evictLocked drops the records and metas out of the retention. Records are
+// roughly ordered by time, the ones missed here are filtered out by Records.
+func (ds *LocalDataSink) evictLocked(now uint64) {
+	if ds.retention <= 0 {
+		return
+	}
+	retention := uint64(ds.retention / time.Second)
+	if now <= retention {
+		return
+	}
+	minTs := now - retention
+	for ds.mu.size > 0 && ds.mu.records[ds.mu.head].TimestampSec < minTs {
+		ds.mu.records[ds.mu.head] = LocalRecord{}
+		ds.mu.head = (ds.mu.head + 1) % len(ds.mu.records)
+		ds.mu.size--
+	}
+	for digest, meta := range ds.mu.sqlMetas {
+		if meta.lastSeen < minTs {
+			delete(ds.mu.sqlMetas, digest)
+		}
+	}
+	for digest, meta := range ds.mu.planMetas {
+		if meta.lastSeen < minTs {
+			delete(ds.mu.planMetas, digest)
+		}
+	}
+}
+
+func totalKvExecCount(counts map[string]uint64) uint64 {
+	total := uint64(0)
+	for _, count := range counts {
+		total += count
+	}
+	return total
+}
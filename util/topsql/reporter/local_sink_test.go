@@ -0,0 +1,81 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tipb/go-tipb"
+	"github.com/stretchr/testify/require"
+)
+
+func mockLocalReportData(ts uint64, sqlDigest, planDigest string) *ReportData {
+	return &ReportData{
+		DataRecords: []tipb.TopSQLRecord{{
+			SqlDigest:  []byte(sqlDigest),
+			PlanDigest: []byte(planDigest),
+			Items: []*tipb.TopSQLRecordItem{{
+				TimestampSec:      ts,
+				CpuTimeMs:         10,
+				StmtExecCount:     2,
+				StmtKvExecCount:   map[string]uint64{"a": 1, "b": 2},
+				StmtDurationSumNs: 100,
+				StmtDurationCount: 2,
+			}},
+		}},
+		SQLMetas:  []tipb.SQLMeta{{SqlDigest: []byte(sqlDigest), NormalizedSql: "sql-" + sqlDigest}},
+		PlanMetas: []tipb.PlanMeta{{PlanDigest: []byte(planDigest), NormalizedPlan: "plan-" + planDigest}},
+	}
+}
+
+func TestLocalDataSink(t *testing.T) {
+	now := time.Unix(10000, 0)
+	nowFunc = func() time.Time { return now }
+	defer func() { nowFunc = time.Now }()
+
+	ds := NewLocalDataSink(100*time.Second, 3)
+	require.NoError(t, ds.TrySend(mockLocalReportData(9950, "S1", "P1"), time.Time{}))
+	records := ds.Records()
+	require.Len(t, records, 1)
+	require.Equal(t, uint64(9950), records[0].TimestampSec)
+	require.Equal(t, []byte("S1"), records[0].SQLDigest)
+	require.Equal(t, "sql-S1", records[0].NormalizedSQL)
+	require.Equal(t, "plan-P1", records[0].NormalizedPlan)
+	require.Equal(t, uint32(10), records[0].CPUTimeMs)
+	require.Equal(t, uint64(3), records[0].StmtKvExecCount)
+
+	// the oldest records are overwritten when the buffer is full.
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, ds.TrySend(mockLocalReportData(uint64(9950+i), "S2", "P2"), time.Time{}))
+	}
+	records = ds.Records()
+	require.Len(t, records, 3)
+	for i, r := range records {
+		require.Equal(t, uint64(9951+i), r.TimestampSec)
+		require.Equal(t, "sql-S2", r.NormalizedSQL)
+	}
+
+	// the records and metas out of the retention are evicted.
+	now = time.Unix(10060, 0)
+	require.NoError(t, ds.TrySend(&ReportData{}, time.Time{}))
+	require.Len(t, ds.Records(), 0)
+	require.Len(t, ds.mu.sqlMetas, 0)
+	require.Len(t, ds.mu.planMetas, 0)
+
+	ds.OnReporterClosing()
+	require.Error(t, ds.TrySend(mockLocalReportData(10060, "S1", "P1"), time.Time{}))
+	require.Len(t, ds.Records(), 0)
+}
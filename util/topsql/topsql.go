@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/config"
 	"github.com/pingcap/tidb/parser"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/plancodec"
@@ -42,6 +43,7 @@ const (
 var (
 	globalTopSQLReport   reporter.TopSQLReporter
 	singleTargetDataSink *reporter.SingleTargetDataSink
+	localDataSink        *reporter.LocalDataSink
 )
 
 func init() {
@@ -54,11 +56,38 @@ func init() {
 func SetupTopSQL() {
 	globalTopSQLReport.Start()
 	singleTargetDataSink.Start()
+	setupLocalDataSink()
 
 	stmtstats.RegisterCollector(globalTopSQLReport)
 	stmtstats.SetupAggregator()
 }
 
+func setupLocalDataSink() {
+	cfg := config.GetGlobalConfig().TopSQL
+	if !cfg.EnableLocalStorage {
+		return
+	}
+	register, ok := globalTopSQLReport.(reporter.DataSinkRegisterer)
+	if !ok {
+		return
+	}
+	ds := reporter.NewLocalDataSink(time.Duration(cfg.LocalRetention)*time.Second, int(cfg.LocalMaxRecords))
+	if err := register.Register(ds); err != nil {
+		logutil.BgLogger().Warn("failed to register local top sql datasink", zap.Error(err))
+		return
+	}
+	localDataSink = ds
+}
+
+// LocalRecords returns the Top SQL records stored in this tidb-server, it returns
+// nil if the local storage is not enabled.
+func LocalRecords() []reporter.LocalRecord {
+	if localDataSink == nil {
+		return nil
+	}
+	return localDataSink.Records()
+}
+
 // SetupTopSQLForTest sets up the global top-sql reporter, it's exporting for test.
 func SetupTopSQLForTest(r reporter.TopSQLReporter) {
 	globalTopSQLReport = r
@@ -75,6 +104,9 @@ func RegisterPubSubServer(s *grpc.Server) {
 // Close uses to close and release the top sql resource.
 func Close() {
 	singleTargetDataSink.Close()
+	if localDataSink != nil {
+		localDataSink.Close()
+	}
 	globalTopSQLReport.Close()
 	stmtstats.CloseAggregator()
 }
@@ -0,0 +1,339 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traffic
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	_ "github.com/pingcap/tidb/types/parser_driver" // for parser driver
+	"github.com/pingcap/tidb/util/logutil"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefCaptureFileSize is the default size of a single capture file.
+	DefCaptureFileSize = 256 * 1024 * 1024
+	// DefCaptureBufferSize is the default number of records buffered in memory.
+	DefCaptureBufferSize = 1 << 16
+
+	flushSize     = 1 << 20
+	flushInterval = time.Second
+	maxRecordSize = 64 * 1024 * 1024
+
+	// FilePrefix is the prefix of capture file names.
+	FilePrefix = "traffic-"
+	// FileSuffix is the suffix of capture file names.
+	FileSuffix = ".log"
+)
+
+// CaptureConfig is the config of a traffic capture.
+type CaptureConfig struct {
+	// Storage is where the capture files are written to.
+	Storage storage.ExternalStorage
+	// FileSize is the size after which a new capture file is started.
+	FileSize int64
+	// BufferSize is the number of records that can be buffered in memory.
+	// Records are dropped instead of blocking the connections when the buffer is full.
+	BufferSize int
+	// Duration stops capturing automatically after the duration, the buffered
+	// records are flushed and the last file is closed. Zero means capturing
+	// until StopCapture is called.
+	Duration time.Duration
+	// Vars are the names of session variables captured along with each record.
+	Vars []string
+}
+
+// CaptureStats is the statistics of a traffic capture.
+type CaptureStats struct {
+	StartTime time.Time `json:"start_time"`
+	URI       string    `json:"uri"`
+	Captured  uint64    `json:"captured"`
+	Dropped   uint64    `json:"dropped"`
+	Files     uint64    `json:"files"`
+	Bytes     uint64    `json:"bytes"`
+}
+
+// Capturer writes captured records to the external storage in the background.
+type Capturer struct {
+	cfg       CaptureConfig
+	startTime time.Time
+	deadline  time.Time
+
+	mu struct {
+		sync.RWMutex
+		closed bool
+	}
+	recordCh chan *Record
+	wg       sync.WaitGroup
+	err      error
+
+	captured atomic.Uint64
+	dropped  atomic.Uint64
+	files    atomic.Uint64
+	bytes    atomic.Uint64
+}
+
+// NewCapturer creates a Capturer and starts its background writer.
+func NewCapturer(cfg CaptureConfig) (*Capturer, error) {
+	if cfg.Storage == nil {
+		return nil, errors.New("traffic capture storage is not specified")
+	}
+	if cfg.FileSize <= 0 {
+		cfg.FileSize = DefCaptureFileSize
+	}
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = DefCaptureBufferSize
+	}
+	c := &Capturer{
+		cfg:       cfg,
+		startTime: time.Now(),
+		recordCh:  make(chan *Record, cfg.BufferSize),
+	}
+	if cfg.Duration > 0 {
+		c.deadline = c.startTime.Add(cfg.Duration)
+	}
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		c.err = c.run()
+	}()
+	return c, nil
+}
+
+// Vars returns the names of session variables to capture.
+func (c *Capturer) Vars() []string {
+	return c.cfg.Vars
+}
+
+// Capture enqueues a record. It never blocks; the record is dropped if the buffer is full.
+func (c *Capturer) Capture(r *Record) {
+	if !c.deadline.IsZero() && r.StartTime.After(c.deadline) {
+		return
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.mu.closed {
+		return
+	}
+	select {
+	case c.recordCh <- r:
+		c.captured.Add(1)
+	default:
+		c.dropped.Add(1)
+	}
+}
+
+// Stats returns the statistics of the capture.
+func (c *Capturer) Stats() CaptureStats {
+	return CaptureStats{
+		StartTime: c.startTime,
+		URI:       c.cfg.Storage.URI(),
+		Captured:  c.captured.Load(),
+		Dropped:   c.dropped.Load(),
+		Files:     c.files.Load(),
+		Bytes:     c.bytes.Load(),
+	}
+}
+
+// Close stops accepting records, flushes the buffered ones and closes the current file.
+func (c *Capturer) Close() error {
+	c.mu.Lock()
+	if !c.mu.closed {
+		c.mu.closed = true
+		close(c.recordCh)
+	}
+	c.mu.Unlock()
+	c.wg.Wait()
+	return c.err
+}
+
+// expire stops the capture whose duration elapses.
+func (c *Capturer) expire() {
+	globalCapturer.CompareAndSwap(c, nil)
+	err := c.Close()
+	stats := c.Stats()
+	logutil.BgLogger().Info("traffic capture finished", zap.Uint64("captured", stats.Captured),
+		zap.Uint64("dropped", stats.Dropped), zap.Uint64("files", stats.Files), zap.Error(err))
+}
+
+func (c *Capturer) run() error {
+	var (
+		ctx      = context.Background()
+		buf      bytes.Buffer
+		writer   storage.ExternalFileWriter
+		fileSize int64
+		ticker   = time.NewTicker(flushInterval)
+		expireCh <-chan time.Time
+		// p redacts the records, it's only used by this goroutine.
+		p = parser.New()
+	)
+	defer ticker.Stop()
+	if !c.deadline.IsZero() {
+		timer := time.NewTimer(time.Until(c.deadline))
+		defer timer.Stop()
+		expireCh = timer.C
+	}
+
+	flush := func() error {
+		if buf.Len() == 0 {
+			return nil
+		}
+		if writer == nil {
+			name := fmt.Sprintf("%s%05d%s", FilePrefix, c.files.Load(), FileSuffix)
+			w, err := c.cfg.Storage.Create(ctx, name)
+			if err != nil {
+				return errors.Annotatef(err, "create traffic file %s", name)
+			}
+			writer, fileSize = w, 0
+			c.files.Add(1)
+		}
+		n, err := writer.Write(ctx, buf.Bytes())
+		if err != nil {
+			return errors.Trace(err)
+		}
+		buf.Reset()
+		fileSize += int64(n)
+		c.bytes.Add(uint64(n))
+		if fileSize >= c.cfg.FileSize {
+			w := writer
+			writer = nil
+			return errors.Trace(w.Close(ctx))
+		}
+		return nil
+	}
+
+	var firstErr error
+	handleErr := func(err error) {
+		if err == nil {
+			return
+		}
+		logutil.BgLogger().Warn("failed to write traffic capture file", zap.Error(err))
+		if firstErr == nil {
+			firstErr = err
+		}
+		// discard the data to avoid accumulating memory on a broken storage.
+		buf.Reset()
+	}
+
+	for {
+		select {
+		case r, ok := <-c.recordCh:
+			if !ok {
+				handleErr(flush())
+				if writer != nil {
+					handleErr(writer.Close(ctx))
+				}
+				return firstErr
+			}
+			redactRecord(p, r)
+			if err := encodeRecord(&buf, r); err != nil {
+				handleErr(err)
+				continue
+			}
+			if buf.Len() >= flushSize {
+				handleErr(flush())
+			}
+		case <-ticker.C:
+			handleErr(flush())
+		case <-expireCh:
+			expireCh = nil
+			// Close waits for this goroutine to flush the records and close
+			// the file, so it's called in another goroutine.
+			go c.expire()
+		}
+	}
+}
+
+// redactRecord redacts the SQL of the record like the slow log and the
+// general log: the SQL is normalized and the params are dropped if the
+// session enables tidb_redact_log, otherwise the sensitive statements, e.g.
+// the passwords of CREATE USER, are replaced by their secure text.
+func redactRecord(p *parser.Parser, r *Record) {
+	if r.SQL == "" {
+		return
+	}
+	if r.Redact {
+		r.SQL = parser.Normalize(r.SQL)
+		r.Params = nil
+		return
+	}
+	stmts, _, err := p.ParseSQL(r.SQL)
+	if err != nil {
+		// the statements that can't be parsed may still contain secrets.
+		r.SQL = parser.Normalize(r.SQL)
+		return
+	}
+	sensitive := false
+	texts := make([]string, 0, len(stmts))
+	for _, stmt := range stmts {
+		if ss, ok := stmt.(ast.SensitiveStmtNode); ok {
+			sensitive = true
+			texts = append(texts, ss.SecureText())
+		} else {
+			texts = append(texts, stmt.Text())
+		}
+	}
+	if sensitive {
+		r.SQL = strings.Join(texts, "; ")
+	}
+}
+
+var globalCapturer atomic.Pointer[Capturer]
+
+// GlobalCapturer returns the running capturer, or nil if traffic capture is not running.
+func GlobalCapturer() *Capturer {
+	return globalCapturer.Load()
+}
+
+// StartCapture starts capturing traffic of this tidb-server.
+func StartCapture(cfg CaptureConfig) error {
+	c, err := NewCapturer(cfg)
+	if err != nil {
+		return err
+	}
+	if !globalCapturer.CompareAndSwap(nil, c) {
+		terr := c.Close()
+		if terr != nil {
+			logutil.BgLogger().Warn("failed to close traffic capturer", zap.Error(terr))
+		}
+		return errors.New("traffic capture is already running")
+	}
+	logutil.BgLogger().Info("traffic capture started", zap.String("uri", cfg.Storage.URI()),
+		zap.Duration("duration", cfg.Duration))
+	return nil
+}
+
+// StopCapture stops the running traffic capture and returns its statistics.
+func StopCapture() (CaptureStats, error) {
+	c := globalCapturer.Swap(nil)
+	if c == nil {
+		return CaptureStats{}, errors.New("traffic capture is not running")
+	}
+	err := c.Close()
+	stats := c.Stats()
+	logutil.BgLogger().Info("traffic capture stopped", zap.Uint64("captured", stats.Captured),
+		zap.Uint64("dropped", stats.Dropped), zap.Uint64("files", stats.Files), zap.Error(err))
+	return stats, err
+}
@@ -0,0 +1,127 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traffic
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/parser"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCaptureAndLoad(t *testing.T) {
+	store := storage.NewMemStorage()
+	c, err := NewCapturer(CaptureConfig{Storage: store, FileSize: 100})
+	require.NoError(t, err)
+
+	now := time.Now()
+	for i := 0; i < 10; i++ {
+		c.Capture(&Record{
+			ConnID:    uint64(i % 3),
+			StartTime: now.Add(time.Duration(10-i) * time.Millisecond),
+			Kind:      KindQuery,
+			SQL:       fmt.Sprintf("select %d", i),
+		})
+	}
+	require.NoError(t, c.Close())
+	// capturing after close is ignored.
+	c.Capture(&Record{Kind: KindQuery})
+
+	stats := c.Stats()
+	require.Equal(t, uint64(10), stats.Captured)
+	require.Equal(t, uint64(0), stats.Dropped)
+	require.Greater(t, stats.Files, uint64(0))
+
+	records, err := LoadRecords(context.Background(), store)
+	require.NoError(t, err)
+	require.Len(t, records, 10)
+	for i := 1; i < len(records); i++ {
+		require.False(t, records[i].StartTime.Before(records[i-1].StartTime))
+	}
+	require.Equal(t, "select 9", records[0].SQL)
+}
+
+func TestCaptureDeadline(t *testing.T) {
+	c, err := NewCapturer(CaptureConfig{Storage: storage.NewMemStorage(), Duration: time.Minute})
+	require.NoError(t, err)
+	c.Capture(&Record{StartTime: time.Now(), Kind: KindQuery})
+	c.Capture(&Record{StartTime: time.Now().Add(time.Hour), Kind: KindQuery})
+	require.NoError(t, c.Close())
+	require.Equal(t, uint64(1), c.Stats().Captured)
+}
+
+func TestCaptureDuration(t *testing.T) {
+	store := storage.NewMemStorage()
+	require.NoError(t, StartCapture(CaptureConfig{Storage: store, Duration: 100 * time.Millisecond}))
+	c := GlobalCapturer()
+	require.NotNil(t, c)
+	c.Capture(&Record{StartTime: time.Now(), Kind: KindQuery, SQL: "select 1"})
+
+	// the capture stops and closes the file once the duration elapses.
+	require.Eventually(t, func() bool {
+		records, err := LoadRecords(context.Background(), store)
+		return err == nil && len(records) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Nil(t, GlobalCapturer())
+	require.NoError(t, c.Close())
+	c.Capture(&Record{StartTime: time.Now(), Kind: KindQuery})
+	require.Equal(t, uint64(1), c.Stats().Captured)
+}
+
+func TestRedactRecord(t *testing.T) {
+	p := parser.New()
+	r := &Record{Kind: KindQuery, SQL: "select 'a'"}
+	redactRecord(p, r)
+	require.Equal(t, "select 'a'", r.SQL)
+
+	r = &Record{Kind: KindQuery, SQL: "create user 'u'@'%' identified by 'secret'"}
+	redactRecord(p, r)
+	require.Contains(t, r.SQL, "create user")
+	require.NotContains(t, r.SQL, "secret")
+
+	r = &Record{Kind: KindQuery, SQL: "select 1; set password = 'secret'"}
+	redactRecord(p, r)
+	require.Contains(t, r.SQL, "select 1")
+	require.NotContains(t, r.SQL, "secret")
+
+	// the statements that can't be parsed are normalized.
+	r = &Record{Kind: KindQuery, SQL: "create user identified by 'secret' bad"}
+	redactRecord(p, r)
+	require.NotContains(t, r.SQL, "secret")
+
+	r = &Record{Kind: KindExecute, SQL: "select * from t where a = 'secret' and b = ?", Params: []Param{{Value: "secret"}}, Redact: true}
+	redactRecord(p, r)
+	require.NotContains(t, r.SQL, "secret")
+	require.Empty(t, r.Params)
+}
+
+func TestGlobalCapture(t *testing.T) {
+	require.Nil(t, GlobalCapturer())
+	_, err := StopCapture()
+	require.Error(t, err)
+
+	cfg := CaptureConfig{Storage: storage.NewMemStorage()}
+	require.NoError(t, StartCapture(cfg))
+	require.Error(t, StartCapture(cfg))
+	GlobalCapturer().Capture(&Record{StartTime: time.Now(), Kind: KindInitDB, DB: "test"})
+	stats, err := StopCapture()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stats.Captured)
+	require.Nil(t, GlobalCapturer())
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traffic
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/pingcap/errors"
+)
+
+// Kind is the kind of a captured command.
+type Kind string
+
+// The kinds of commands that can be captured and replayed.
+const (
+	// KindQuery is a text protocol query (COM_QUERY).
+	KindQuery Kind = "query"
+	// KindInitDB switches the current database (COM_INIT_DB).
+	KindInitDB Kind = "init_db"
+	// KindPrepare prepares a statement (COM_STMT_PREPARE).
+	KindPrepare Kind = "prepare"
+	// KindExecute executes a prepared statement (COM_STMT_EXECUTE).
+	KindExecute Kind = "execute"
+	// KindClose closes a prepared statement (COM_STMT_CLOSE).
+	KindClose Kind = "close"
+	// KindQuit closes the connection (COM_QUIT).
+	KindQuit Kind = "quit"
+)
+
+// Param is a parameter of a prepared statement execution.
+type Param struct {
+	Null  bool   `json:"null,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// Record is a captured command together with its execution context.
+type Record struct {
+	ConnID    uint64        `json:"conn_id"`
+	StartTime time.Time     `json:"start_time"`
+	Duration  time.Duration `json:"duration"`
+	Kind      Kind          `json:"kind"`
+	// DB is the current database when the command is received.
+	DB string `json:"db,omitempty"`
+	// SQL is the query text, or the text of the prepared statement for
+	// prepare and execute commands.
+	SQL string `json:"sql,omitempty"`
+	// StmtID is the id of the prepared statement in the captured session.
+	StmtID uint32  `json:"stmt_id,omitempty"`
+	Params []Param `json:"params,omitempty"`
+	// Vars contains the captured session variables, see CaptureConfig.Vars.
+	Vars map[string]string `json:"vars,omitempty"`
+	// Err is the error message returned to the client, if any.
+	Err string `json:"err,omitempty"`
+	// Redact normalizes the SQL and drops the params when the record is
+	// written, it's set if the captured session enables tidb_redact_log.
+	Redact bool `json:"-"`
+}
+
+// EndTime returns the time when the command finished.
+func (r *Record) EndTime() time.Time {
+	return r.StartTime.Add(r.Duration)
+}
+
+// encodeRecord encodes a record as a single JSON line.
+func encodeRecord(buf *bytes.Buffer, r *Record) error {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	buf.Write(data)
+	buf.WriteByte('\n')
+	return nil
+}
+
+// DecodeRecords decodes all records from a JSON-lines stream produced by Capturer.
+func DecodeRecords(r io.Reader) ([]*Record, error) {
+	records := make([]*Record, 0, 1024)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRecordSize)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		rec := &Record{}
+		if err := json.Unmarshal(line, rec); err != nil {
+			return nil, errors.Annotatef(err, "decode traffic record #%d", len(records))
+		}
+		records = append(records, rec)
+	}
+	return records, errors.Trace(scanner.Err())
+}
@@ -0,0 +1,386 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traffic
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/util/logutil"
+	"go.uber.org/zap"
+)
+
+const maxSampleErrors = 100
+
+// Session replays the records of one captured connection.
+type Session interface {
+	// Execute executes a record. Records of a connection are executed sequentially.
+	Execute(ctx context.Context, r *Record) error
+	// Close closes the session.
+	Close() error
+}
+
+// SessionFactory creates a session for a captured connection.
+type SessionFactory func(ctx context.Context) (Session, error)
+
+// ReplayConfig is the config of a traffic replay.
+type ReplayConfig struct {
+	// Speed is the ratio of the replay speed to the captured speed, 2 means
+	// replaying twice as fast. Zero means the captured speed.
+	Speed float64
+	// ReadOnly only replays the commands that don't modify data.
+	ReadOnly bool
+}
+
+// ReplayStats is the statistics of a traffic replay.
+type ReplayStats struct {
+	Connections uint64 `json:"connections"`
+	Records     uint64 `json:"records"`
+	Skipped     uint64 `json:"skipped"`
+	Failed      uint64 `json:"failed"`
+	// Mismatched is the number of records whose replay result (succeeded or
+	// failed) is different from the captured one.
+	Mismatched uint64 `json:"mismatched"`
+	// CapturedDuration is the total execution time of the captured records.
+	CapturedDuration time.Duration `json:"captured_duration"`
+	// ReplayedDuration is the total execution time of the replayed records.
+	ReplayedDuration time.Duration `json:"replayed_duration"`
+	SampleErrors     []string      `json:"sample_errors,omitempty"`
+}
+
+// LoadRecords loads all captured records in the storage, sorted by their start time.
+func LoadRecords(ctx context.Context, store storage.ExternalStorage) ([]*Record, error) {
+	var names []string
+	err := store.WalkDir(ctx, &storage.WalkOption{ObjPrefix: FilePrefix}, func(path string, _ int64) error {
+		if strings.HasSuffix(path, FileSuffix) {
+			names = append(names, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	sort.Strings(names)
+	var records []*Record
+	for _, name := range names {
+		reader, err := store.Open(ctx, name)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		recs, err := DecodeRecords(reader)
+		if cerr := reader.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return nil, errors.Annotatef(err, "load traffic file %s", name)
+		}
+		records = append(records, recs...)
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].StartTime.Before(records[j].StartTime)
+	})
+	return records, nil
+}
+
+// Replayer replays captured records, keeping the concurrency of connections
+// and the pacing between commands.
+type Replayer struct {
+	cfg     ReplayConfig
+	factory SessionFactory
+
+	mu struct {
+		sync.Mutex
+		sampleErrors []string
+	}
+	records    atomic.Uint64
+	skipped    atomic.Uint64
+	failed     atomic.Uint64
+	mismatched atomic.Uint64
+	captured   atomic.Int64
+	replayed   atomic.Int64
+}
+
+// NewReplayer creates a Replayer.
+func NewReplayer(cfg ReplayConfig, factory SessionFactory) *Replayer {
+	if cfg.Speed <= 0 {
+		cfg.Speed = 1
+	}
+	return &Replayer{cfg: cfg, factory: factory}
+}
+
+// Replay replays the records, which must be sorted by the start time. It
+// returns when all the records are replayed or the context is canceled.
+func (r *Replayer) Replay(ctx context.Context, records []*Record) (ReplayStats, error) {
+	if len(records) == 0 {
+		return r.stats(0), nil
+	}
+	conns := make(map[uint64][]*Record)
+	var connIDs []uint64
+	for _, rec := range records {
+		if _, ok := conns[rec.ConnID]; !ok {
+			connIDs = append(connIDs, rec.ConnID)
+		}
+		conns[rec.ConnID] = append(conns[rec.ConnID], rec)
+	}
+
+	base := records[0].StartTime
+	start := time.Now()
+	var wg sync.WaitGroup
+	for _, connID := range connIDs {
+		recs := conns[connID]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.replayConn(ctx, base, start, recs)
+		}()
+	}
+	wg.Wait()
+	return r.stats(len(connIDs)), errors.Trace(ctx.Err())
+}
+
+func (r *Replayer) replayConn(ctx context.Context, base, start time.Time, records []*Record) {
+	var sess Session
+	defer func() {
+		if sess != nil {
+			if err := sess.Close(); err != nil {
+				logutil.BgLogger().Warn("failed to close replay session", zap.Error(err))
+			}
+		}
+	}()
+	for _, rec := range records {
+		if r.cfg.ReadOnly && !isReadOnly(rec) {
+			r.skipped.Add(1)
+			continue
+		}
+		offset := time.Duration(float64(rec.StartTime.Sub(base)) / r.cfg.Speed)
+		if wait := time.Until(start.Add(offset)); wait > 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(wait):
+			}
+		}
+		if rec.Kind == KindQuit {
+			return
+		}
+		if sess == nil {
+			var err error
+			if sess, err = r.factory(ctx); err != nil {
+				r.recordErr(rec, err)
+				return
+			}
+		}
+		begin := time.Now()
+		err := sess.Execute(ctx, rec)
+		r.replayed.Add(int64(time.Since(begin)))
+		r.captured.Add(int64(rec.Duration))
+		r.records.Add(1)
+		if err != nil {
+			r.failed.Add(1)
+			r.recordErr(rec, err)
+		}
+		if (err != nil) != (rec.Err != "") {
+			r.mismatched.Add(1)
+		}
+	}
+}
+
+func (r *Replayer) recordErr(rec *Record, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.mu.sampleErrors) < maxSampleErrors {
+		r.mu.sampleErrors = append(r.mu.sampleErrors,
+			fmt.Sprintf("conn %d, %s %q: %v", rec.ConnID, rec.Kind, rec.SQL, err))
+	}
+}
+
+func (r *Replayer) stats(conns int) ReplayStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return ReplayStats{
+		Connections:      uint64(conns),
+		Records:          r.records.Load(),
+		Skipped:          r.skipped.Load(),
+		Failed:           r.failed.Load(),
+		Mismatched:       r.mismatched.Load(),
+		CapturedDuration: time.Duration(r.captured.Load()),
+		ReplayedDuration: time.Duration(r.replayed.Load()),
+		SampleErrors:     append([]string(nil), r.mu.sampleErrors...),
+	}
+}
+
+// isReadOnly roughly checks whether the record doesn't modify data.
+func isReadOnly(rec *Record) bool {
+	switch rec.Kind {
+	case KindQuery, KindPrepare, KindExecute:
+	default:
+		return true
+	}
+	sqlText := strings.TrimLeft(rec.SQL, " \t\r\n(")
+	for _, prefix := range []string{"select", "show", "explain", "desc", "use", "set"} {
+		if len(sqlText) >= len(prefix) && strings.EqualFold(sqlText[:len(prefix)], prefix) {
+			return !strings.Contains(strings.ToLower(sqlText), "for update")
+		}
+	}
+	return false
+}
+
+type sqlSession struct {
+	conn  *sql.Conn
+	db    string
+	stmts map[uint32]*sql.Stmt
+	// vars are the session variables set by the replayed records.
+	vars map[string]string
+}
+
+// NewSQLSessionFactory returns a SessionFactory which replays records through database/sql.
+func NewSQLSessionFactory(db *sql.DB) SessionFactory {
+	return func(ctx context.Context) (Session, error) {
+		conn, err := db.Conn(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return &sqlSession{
+			conn:  conn,
+			stmts: make(map[uint32]*sql.Stmt),
+			vars:  make(map[string]string),
+		}, nil
+	}
+}
+
+// Execute implements the Session interface.
+func (s *sqlSession) Execute(ctx context.Context, r *Record) error {
+	if r.DB != "" && r.DB != s.db {
+		if _, err := s.conn.ExecContext(ctx, "USE "+quoteName(r.DB)); err != nil {
+			return errors.Trace(err)
+		}
+		s.db = r.DB
+	}
+	if err := s.setVars(ctx, r.Vars); err != nil {
+		return err
+	}
+	switch r.Kind {
+	case KindQuery:
+		return s.query(ctx, r.SQL)
+	case KindInitDB:
+		// the database is switched above.
+		return nil
+	case KindPrepare:
+		stmt, err := s.conn.PrepareContext(ctx, r.SQL)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		s.closeStmt(r.StmtID)
+		s.stmts[r.StmtID] = stmt
+		return nil
+	case KindExecute:
+		stmt, ok := s.stmts[r.StmtID]
+		if !ok {
+			// the statement may be prepared before capturing, prepare it now.
+			var err error
+			if stmt, err = s.conn.PrepareContext(ctx, r.SQL); err != nil {
+				return errors.Trace(err)
+			}
+			s.stmts[r.StmtID] = stmt
+		}
+		args := make([]interface{}, 0, len(r.Params))
+		for _, p := range r.Params {
+			if p.Null {
+				args = append(args, nil)
+			} else {
+				args = append(args, p.Value)
+			}
+		}
+		rows, err := stmt.QueryContext(ctx, args...)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		return drainRows(rows)
+	case KindClose:
+		s.closeStmt(r.StmtID)
+		return nil
+	default:
+		return errors.Errorf("unknown traffic record kind %s", r.Kind)
+	}
+}
+
+// setVars sets the captured session variables which are different from the
+// ones set before.
+func (s *sqlSession) setVars(ctx context.Context, vars map[string]string) error {
+	names := make([]string, 0, len(vars))
+	for name, val := range vars {
+		if cur, ok := s.vars[name]; !ok || cur != val {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		val := vars[name]
+		if _, err := s.conn.ExecContext(ctx, "SET SESSION "+quoteName(name)+" = "+quoteString(val)); err != nil {
+			return errors.Annotatef(err, "set session variable %s", name)
+		}
+		s.vars[name] = val
+	}
+	return nil
+}
+
+func (s *sqlSession) query(ctx context.Context, query string) error {
+	rows, err := s.conn.QueryContext(ctx, query)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return drainRows(rows)
+}
+
+func (s *sqlSession) closeStmt(stmtID uint32) {
+	if stmt, ok := s.stmts[stmtID]; ok {
+		_ = stmt.Close()
+		delete(s.stmts, stmtID)
+	}
+}
+
+// Close implements the Session interface.
+func (s *sqlSession) Close() error {
+	for id := range s.stmts {
+		s.closeStmt(id)
+	}
+	return errors.Trace(s.conn.Close())
+}
+
+func drainRows(rows *sql.Rows) error {
+	for rows.Next() {
+	}
+	if err := rows.Err(); err != nil {
+		_ = rows.Close()
+		return errors.Trace(err)
+	}
+	return errors.Trace(rows.Close())
+}
+
+func quoteName(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func quoteString(val string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, "'", "''").Replace(val) + "'"
+}
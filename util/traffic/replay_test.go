@@ -0,0 +1,129 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traffic
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSession struct {
+	mu       *sync.Mutex
+	executed *[]string
+	closed   *int
+}
+
+func (s *mockSession) Execute(_ context.Context, r *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	*s.executed = append(*s.executed, r.SQL)
+	if r.SQL == "bad" {
+		return errors.New("mock error")
+	}
+	return nil
+}
+
+func (s *mockSession) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	*s.closed++
+	return nil
+}
+
+func TestReplay(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		executed []string
+		closed   int
+	)
+	factory := func(context.Context) (Session, error) {
+		return &mockSession{mu: &mu, executed: &executed, closed: &closed}, nil
+	}
+	now := time.Now()
+	records := []*Record{
+		{ConnID: 1, StartTime: now, Kind: KindQuery, SQL: "select 1"},
+		{ConnID: 2, StartTime: now.Add(10 * time.Millisecond), Kind: KindQuery, SQL: "insert into t values (1)"},
+		{ConnID: 1, StartTime: now.Add(20 * time.Millisecond), Kind: KindQuery, SQL: "bad"},
+		{ConnID: 2, StartTime: now.Add(30 * time.Millisecond), Kind: KindQuery, SQL: "bad", Err: "captured error"},
+		{ConnID: 1, StartTime: now.Add(40 * time.Millisecond), Kind: KindQuit},
+		{ConnID: 1, StartTime: now.Add(50 * time.Millisecond), Kind: KindQuery, SQL: "select 2"},
+	}
+
+	start := time.Now()
+	stats, err := NewReplayer(ReplayConfig{}, factory).Replay(context.Background(), records)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), 30*time.Millisecond)
+	require.Equal(t, uint64(2), stats.Connections)
+	require.Equal(t, uint64(4), stats.Records)
+	require.Equal(t, uint64(2), stats.Failed)
+	require.Equal(t, uint64(1), stats.Mismatched)
+	require.Len(t, stats.SampleErrors, 2)
+	require.Equal(t, 2, closed)
+	require.NotContains(t, executed, "select 2")
+
+	executed, closed = nil, 0
+	stats, err = NewReplayer(ReplayConfig{Speed: 100, ReadOnly: true}, factory).Replay(context.Background(), records)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), stats.Skipped)
+	require.Equal(t, []string{"select 1"}, executed)
+}
+
+func TestSQLSessionVars(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	ctx := context.Background()
+	sess, err := NewSQLSessionFactory(db)(ctx)
+	require.NoError(t, err)
+
+	mock.ExpectExec("SET SESSION `sql_mode` = 'ANSI'").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("SET SESSION `time_zone` = '+08:00'").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("select 1").WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+	vars := map[string]string{"sql_mode": "ANSI", "time_zone": "+08:00"}
+	require.NoError(t, sess.Execute(ctx, &Record{Kind: KindQuery, SQL: "select 1", Vars: vars}))
+
+	// only the changed variables are set again.
+	mock.ExpectExec("SET SESSION `sql_mode` = 'it''s \\\\'").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("select 2").WillReturnRows(sqlmock.NewRows([]string{"2"}).AddRow(2))
+	vars = map[string]string{"sql_mode": `it's \`, "time_zone": "+08:00"}
+	require.NoError(t, sess.Execute(ctx, &Record{Kind: KindQuery, SQL: "select 2", Vars: vars}))
+
+	mock.ExpectClose()
+	require.NoError(t, sess.Close())
+	require.NoError(t, db.Close())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIsReadOnly(t *testing.T) {
+	cases := []struct {
+		rec      Record
+		readOnly bool
+	}{
+		{Record{Kind: KindQuery, SQL: "SELECT * FROM t"}, true},
+		{Record{Kind: KindQuery, SQL: " (select 1) union (select 2)"}, true},
+		{Record{Kind: KindQuery, SQL: "select * from t for update"}, false},
+		{Record{Kind: KindExecute, SQL: "update t set a = ?"}, false},
+		{Record{Kind: KindClose}, true},
+		{Record{Kind: KindInitDB, DB: "test"}, true},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.readOnly, isReadOnly(&c.rec), c.rec.SQL)
+	}
+}